@@ -19,9 +19,11 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"syscall"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
 	"github.com/dolthub/dolt/go/cmd/dolt/commands"
@@ -56,10 +58,12 @@ const (
 	stdOutAndErrParam = "out-and-err"
 	configParam       = "config"
 	dataDirParam      = "data-dir"
+	snapshotDirParam  = "snapshot-dir"
 	defaultCfgFile    = "config.yaml"
 
 	versionFlag    = "version"
 	configHelpFlag = "config-help"
+	inMemoryFlag   = "in-memory"
 
 	configHelpText = "Path to the config file.\n" +
 		"If not provided, ./config.yaml will be used if it exists."
@@ -67,6 +71,10 @@ const (
 		"If not provided, the value in config.yaml will be used. If that's not provided either, the value of the " +
 		"DOLTGRES_DATA_DIR environment variable will be used if set. Otherwise $HOME/doltgres/databases will be used. " +
 		"The directory will be created if it doesn't exist."
+	inMemoryHelpText = "Run the server using memory as the backing store. Nothing is written to the data directory, " +
+		"and all databases are lost when the server stops unless --snapshot-dir is given."
+	snapshotDirHelpText = "Path to the directory that every database is backed up to when an in-memory server stops. " +
+		"Each database is written as a Dolt backup to a directory of the same name. Requires --in-memory."
 )
 
 func parseArgs() (flags map[string]*bool, params map[string]*string) {
@@ -81,6 +89,7 @@ func parseArgs() (flags map[string]*bool, params map[string]*string) {
 
 	params[configParam] = flag.String(configParam, "", configHelpText)
 	params[dataDirParam] = flag.String(dataDirParam, "", dataDirHelpText)
+	params[snapshotDirParam] = flag.String(snapshotDirParam, "", snapshotDirHelpText)
 	params[chdirParam] = flag.String(chdirParam, "", "set the working directory for doltgres")
 	params[stdInParam] = flag.String(stdInParam, "", "file to use as stdin")
 	params[stdOutParam] = flag.String(stdOutParam, "", "file to use as stdout")
@@ -89,6 +98,7 @@ func parseArgs() (flags map[string]*bool, params map[string]*string) {
 
 	flags[versionFlag] = flag.Bool(versionFlag, false, "print the version")
 	flags[configHelpFlag] = flag.Bool(configHelpFlag, false, "print the config file help")
+	flags[inMemoryFlag] = flag.Bool(inMemoryFlag, false, inMemoryHelpText)
 
 	flag.Parse()
 
@@ -100,6 +110,8 @@ func PrintDefaults(fs *flag.FlagSet) {
 	helpOrder := []string{
 		configParam,
 		dataDirParam,
+		inMemoryFlag,
+		snapshotDirParam,
 		configHelpFlag,
 		versionFlag,
 		chdirParam,
@@ -169,9 +181,12 @@ func main() {
 		handleErrAndExitCode(err)
 	}
 
-	err = setupDataDir(params, cfg, loadedFromDisk, fs)
-	if err != nil {
-		handleErrAndExitCode(err)
+	applyInMemoryParams(flags, params, cfg)
+	if !cfg.InMemory() {
+		err = setupDataDir(params, cfg, loadedFromDisk, fs)
+		if err != nil {
+			handleErrAndExitCode(err)
+		}
 	}
 
 	// TODO: override other aspects of cfg with command line params
@@ -180,6 +195,24 @@ func main() {
 	handleErrAndExitCode(err)
 }
 
+// applyInMemoryParams overrides the in-memory settings of the config with any that were given on the command line.
+func applyInMemoryParams(flags map[string]*bool, params map[string]*string, cfg *servercfg.DoltgresConfig) {
+	inMemory := *flags[inMemoryFlag]
+	snapshotDir, snapshotDirSpecified := paramVal(params, snapshotDirParam)
+	if !inMemory && !snapshotDirSpecified {
+		return
+	}
+	if cfg.BehaviorConfig == nil {
+		cfg.BehaviorConfig = &servercfg.DoltgresBehaviorConfig{}
+	}
+	if inMemory {
+		cfg.BehaviorConfig.InMemory = &inMemory
+	}
+	if snapshotDirSpecified {
+		cfg.BehaviorConfig.SnapshotDir = &snapshotDir
+	}
+}
+
 func handleErrAndExitCode(err error) {
 	if err != nil {
 		cli.PrintErrln(err.Error())
@@ -285,13 +318,31 @@ func runServer(ctx context.Context, dEnv *env.DoltEnv, cfg *servercfg.DoltgresCo
 	//
 	// We also emit a heartbeat event every 24 hours the server is running.
 	// All events will be tagged with the doltgresql app id.
-	go emitUsageEvent(ctx, dEnv)
+	// Detailed usage reports are opt-in, and are written by the server itself when enabled through the telemetry
+	// config. No events of any kind are emitted when telemetry has been disabled.
+	go emitUsageEvent(ctx, dEnv, cfg)
 
-	controller, err := server.RunOnDisk(ctx, cfg, dEnv)
+	if !cfg.InMemory() {
+		controller, err := server.RunOnDisk(ctx, cfg, dEnv)
+		if err != nil {
+			return err
+		}
+		return controller.WaitForStop()
+	}
+
+	controller, err := server.RunInMemory(cfg)
 	if err != nil {
 		return err
 	}
-
+	// An in-memory server must be stopped gracefully so that its snapshot is taken, so we stop it ourselves when
+	// interrupted. A second interrupt will kill the process as usual.
+	signalCtx, stopSignals := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stopSignals()
+	go func() {
+		<-signalCtx.Done()
+		stopSignals()
+		controller.Stop()
+	}()
 	return controller.WaitForStop()
 }
 
@@ -361,8 +412,11 @@ func redirectStdio(params map[string]*string) error {
 	return nil
 }
 
-// emitUsageEvent emits a usage event to the event server
-func emitUsageEvent(ctx context.Context, dEnv *env.DoltEnv) {
+// emitUsageEvent emits a usage event to the event server, unless telemetry has been disabled
+func emitUsageEvent(ctx context.Context, dEnv *env.DoltEnv, cfg *servercfg.DoltgresConfig) {
+	if cfg.TelemetryDisabled() {
+		return
+	}
 	metricsDisabled := dEnv.Config.GetStringOrDefault(config.MetricsDisabled, "false")
 	disabled, err := strconv.ParseBool(metricsDisabled)
 	if err != nil || disabled {
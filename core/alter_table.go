@@ -0,0 +1,397 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/globalstate"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/resolve"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/writer"
+	"github.com/dolthub/dolt/go/store/types"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// AlteredColumn is a column of a table that is being altered. OldName is the name of the existing column that this
+// column replaces, and is empty for columns that are being added.
+type AlteredColumn struct {
+	Column  *sql.Column
+	OldName string
+}
+
+// AlterTableColumns replaces the columns of the table with the given columns, writing the updated table to the working
+// root. Existing columns that are not given are dropped, along with any indexes that use them and the given checks.
+// When rows are given, the table's data is replaced by the rows, which must match the given columns. Otherwise, only
+// the schema of the table is changed, so the stored data must remain valid for the new columns. If the table name does
+// not specify a schema, then the table is resolved using the search path. Dolt's own implementation does not take
+// schemas into account, which is why this exists.
+func AlterTableColumns(ctx *sql.Context, tableName doltdb.TableName, columns []AlteredColumn, droppedChecks []string, rows sql.RowIter) (err error) {
+	if rows != nil {
+		defer func() {
+			if closeErr := rows.Close(ctx); err == nil {
+				err = closeErr
+			}
+		}()
+	}
+	session, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	tableName, table, err := resolveTableForAlteration(ctx, root, tableName)
+	if err != nil {
+		return err
+	}
+	oldSch, err := table.GetSchema(ctx)
+	if err != nil {
+		return err
+	}
+	newSch, err := alteredSchema(ctx, session, root, tableName, oldSch, columns, droppedChecks)
+	if err != nil {
+		return err
+	}
+	if rows == nil {
+		table, err = table.UpdateSchema(ctx, newSch)
+		if err != nil {
+			return err
+		}
+		newRoot, err := root.PutTable(ctx, tableName, table)
+		if err != nil {
+			return err
+		}
+		return session.SetWorkingRoot(ctx, ctx.GetCurrentDatabase(), newRoot)
+	}
+	return rewriteTable(ctx, session, root, tableName, table, newSch, rows)
+}
+
+// RenameTable renames the given table, which may also move it to another schema. If the old name does not specify a
+// schema, then the table is resolved using the search path, and if the new name does not specify a schema, then the
+// table remains in its current schema. Returns false if the table does not exist.
+func RenameTable(ctx *sql.Context, oldName doltdb.TableName, newName doltdb.TableName) (bool, error) {
+	session, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+	if len(oldName.Schema) == 0 {
+		var ok bool
+		oldName, _, ok, err = resolve.Table(ctx, root, oldName.Name)
+		if err != nil || !ok {
+			return false, err
+		}
+	} else if ok, err := root.HasTable(ctx, oldName); err != nil || !ok {
+		return false, err
+	}
+	if len(newName.Schema) == 0 {
+		newName.Schema = oldName.Schema
+	}
+	if oldName.Schema != newName.Schema {
+		dbSchemas, err := root.GetDatabaseSchemas(ctx)
+		if err != nil {
+			return false, err
+		}
+		found := false
+		for _, dbSchema := range dbSchemas {
+			if dbSchema.Name == newName.Schema {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, fmt.Errorf(`schema "%s" does not exist`, newName.Schema)
+		}
+	}
+	if ok, err := root.HasTable(ctx, newName); err != nil {
+		return false, err
+	} else if ok {
+		if oldName.Schema != newName.Schema {
+			return false, fmt.Errorf(`relation "%s" already exists in schema "%s"`, newName.Name, newName.Schema)
+		}
+		return false, fmt.Errorf(`relation "%s" already exists`, newName.Name)
+	}
+	fkCollection, err := root.GetForeignKeyCollection(ctx)
+	if err != nil {
+		return false, err
+	}
+	if oldName.Schema != newName.Schema {
+		// Foreign keys and sequences refer to their tables by name alone, so they can't follow a table to another schema
+		declaredFks, referencedByFks := fkCollection.KeysForTable(oldName)
+		if len(declaredFks) > 0 || len(referencedByFks) > 0 {
+			return false, fmt.Errorf("ALTER TABLE SET SCHEMA is not yet supported for tables with foreign keys")
+		}
+		sequenceCollection, err := root.GetSequences(ctx)
+		if err != nil {
+			return false, err
+		}
+		if len(sequenceCollection.GetSequencesWithTable(oldName)) > 0 {
+			return false, fmt.Errorf("ALTER TABLE SET SCHEMA is not yet supported for tables that own sequences")
+		}
+	}
+	newRoot, err := root.RenameTable(ctx, oldName, newName)
+	if err != nil {
+		return false, err
+	}
+	if oldName.Name != newName.Name {
+		changed := false
+		for _, fk := range fkCollection.AllKeys() {
+			newFk := fk
+			if strings.EqualFold(fk.TableName, oldName.Name) {
+				newFk.TableName = newName.Name
+			}
+			if strings.EqualFold(fk.ReferencedTableName, oldName.Name) {
+				newFk.ReferencedTableName = newName.Name
+			}
+			if newFk.TableName != fk.TableName || newFk.ReferencedTableName != fk.ReferencedTableName {
+				fkCollection.RemoveKeys(fk)
+				if err = fkCollection.AddKeys(newFk); err != nil {
+					return false, err
+				}
+				changed = true
+			}
+		}
+		if changed {
+			newRoot, err = newRoot.PutForeignKeyCollection(ctx, fkCollection)
+			if err != nil {
+				return false, err
+			}
+		}
+	}
+	return true, session.SetWorkingRoot(ctx, ctx.GetCurrentDatabase(), newRoot)
+}
+
+// resolveTableForAlteration returns the table with the given name, resolving the name using the search path when it
+// does not specify a schema.
+func resolveTableForAlteration(ctx *sql.Context, root *RootValue, tableName doltdb.TableName) (doltdb.TableName, *doltdb.Table, error) {
+	var table *doltdb.Table
+	var ok bool
+	var err error
+	if len(tableName.Schema) == 0 {
+		tableName, table, ok, err = resolve.Table(ctx, root, tableName.Name)
+	} else {
+		table, ok, err = root.GetTable(ctx, tableName)
+	}
+	if err != nil {
+		return doltdb.TableName{}, nil, err
+	}
+	if !ok {
+		return doltdb.TableName{}, nil, fmt.Errorf(`relation "%s" does not exist`, tableName.Name)
+	}
+	return tableName, table, nil
+}
+
+// alteredSchema returns the schema of the table once its columns have been replaced by the given columns. Columns keep
+// their tags unless their storage has changed, and indexes and checks are carried over unless they use a dropped column.
+func alteredSchema(ctx *sql.Context, session *dsess.DoltSession, root *RootValue, tableName doltdb.TableName, oldSch schema.Schema, columns []AlteredColumn, droppedChecks []string) (schema.Schema, error) {
+	oldCols := oldSch.GetAllCols()
+	newCols := make([]schema.Column, len(columns))
+	oldTagToNewTag := make(map[uint64]uint64)
+	var untaggedIndexes []int
+	var untaggedNames []string
+	var untaggedKinds []types.NomsKind
+	for i, column := range columns {
+		newCol, err := sqlutil.ToDoltCol(schema.InvalidTag, column.Column)
+		if err != nil {
+			return nil, err
+		}
+		if oldCol, ok := oldCols.GetByName(column.OldName); ok && oldCol.Kind == newCol.Kind {
+			newCol.Tag = oldCol.Tag
+			oldTagToNewTag[oldCol.Tag] = newCol.Tag
+		} else {
+			untaggedIndexes = append(untaggedIndexes, i)
+			untaggedNames = append(untaggedNames, newCol.Name)
+			untaggedKinds = append(untaggedKinds, newCol.Kind)
+		}
+		newCols[i] = newCol
+	}
+	if len(untaggedIndexes) > 0 {
+		headCommit, err := session.GetHeadCommit(ctx, ctx.GetCurrentDatabase())
+		if err != nil {
+			return nil, err
+		}
+		headRoot, err := headCommit.GetRootValue(ctx)
+		if err != nil {
+			return nil, err
+		}
+		tags, err := doltdb.GenerateTagsForNewColumns(ctx, root, tableName.Name, untaggedNames, untaggedKinds, headRoot)
+		if err != nil {
+			return nil, err
+		}
+		for i, colIndex := range untaggedIndexes {
+			newCols[colIndex].Tag = tags[i]
+			if oldCol, ok := oldCols.GetByName(columns[colIndex].OldName); ok {
+				oldTagToNewTag[oldCol.Tag] = tags[i]
+			}
+		}
+	}
+	// Foreign keys reference their columns by tag, so those columns must keep their tags
+	fkCollection, err := root.GetForeignKeyCollection(ctx)
+	if err != nil {
+		return nil, err
+	}
+	declaredFks, referencedByFks := fkCollection.KeysForTable(tableName)
+	for _, fk := range append(declaredFks, referencedByFks...) {
+		fkTags := fk.TableColumns
+		if !strings.EqualFold(fk.TableName, tableName.Name) {
+			fkTags = fk.ReferencedTableColumns
+		}
+		for _, fkTag := range fkTags {
+			if newTag, ok := oldTagToNewTag[fkTag]; !ok || newTag != fkTag {
+				col, _ := oldCols.GetByTag(fkTag)
+				return nil, fmt.Errorf(`cannot alter column "%s" because it is used by foreign key constraint "%s"`,
+					col.Name, fk.Name)
+			}
+		}
+	}
+
+	var pkOrdinals []int
+	for _, oldOrdinal := range oldSch.GetPkOrdinals() {
+		oldTag := oldCols.GetByIndex(oldOrdinal).Tag
+		newTag, ok := oldTagToNewTag[oldTag]
+		if !ok {
+			return nil, fmt.Errorf(`cannot drop column "%s" because it is part of the primary key`,
+				oldCols.GetByIndex(oldOrdinal).Name)
+		}
+		for i, newCol := range newCols {
+			if newCol.Tag == newTag {
+				pkOrdinals = append(pkOrdinals, i)
+				break
+			}
+		}
+	}
+	newSch, err := schema.NewSchema(schema.NewColCollection(newCols...), pkOrdinals, oldSch.GetCollation(), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	newSch.SetComment(oldSch.GetComment())
+IndexLoop:
+	for _, index := range oldSch.Indexes().AllIndexes() {
+		oldTags := index.IndexedColumnTags()
+		newTags := make([]uint64, len(oldTags))
+		for i, oldTag := range oldTags {
+			newTag, ok := oldTagToNewTag[oldTag]
+			if !ok {
+				continue IndexLoop
+			}
+			newTags[i] = newTag
+		}
+		_, err = newSch.Indexes().AddIndexByColTags(index.Name(), newTags, index.PrefixLengths(), schema.IndexProperties{
+			IsUnique:           index.IsUnique(),
+			IsSpatial:          index.IsSpatial(),
+			IsFullText:         index.IsFullText(),
+			IsUserDefined:      index.IsUserDefined(),
+			Comment:            index.Comment(),
+			FullTextProperties: index.FullTextProperties(),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+CheckLoop:
+	for _, check := range oldSch.Checks().AllChecks() {
+		for _, droppedCheck := range droppedChecks {
+			if check.Name() == droppedCheck {
+				continue CheckLoop
+			}
+		}
+		if _, err = newSch.Checks().AddCheck(check.Name(), check.Expression(), check.Enforced()); err != nil {
+			return nil, err
+		}
+	}
+	return newSch, nil
+}
+
+// rewriteTable replaces the table's data with the given rows, using the given schema for the new table.
+func rewriteTable(ctx *sql.Context, session *dsess.DoltSession, root *RootValue, tableName doltdb.TableName, table *doltdb.Table, newSch schema.Schema, rows sql.RowIter) error {
+	dbName := ctx.GetCurrentDatabase()
+	state, ok, err := session.LookupDbState(ctx, dbName)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("cannot find the database while altering a table")
+	}
+	ws := state.WorkingSet()
+	if ws == nil || state.WriteSession() == nil {
+		return doltdb.ErrOperationNotSupportedInDetachedHead
+	}
+	db, err := session.Provider().Database(ctx, dbName)
+	if err != nil {
+		return err
+	}
+	stateProvider, ok := db.(globalstate.GlobalStateProvider)
+	if !ok {
+		return fmt.Errorf("database does not contain global state store")
+	}
+	aiTracker, err := stateProvider.GetGlobalState().AutoIncrementTracker(ctx)
+	if err != nil {
+		return err
+	}
+	// The table is replaced by an empty table with the new schema, which the rows are then written to
+	emptyRows, err := durable.NewEmptyIndex(ctx, table.ValueReadWriter(), table.NodeStore(), newSch)
+	if err != nil {
+		return err
+	}
+	emptyIndexes, err := durable.NewIndexSetWithEmptyIndexes(ctx, table.ValueReadWriter(), table.NodeStore(), newSch)
+	if err != nil {
+		return err
+	}
+	emptyTable, err := doltdb.NewTable(ctx, table.ValueReadWriter(), table.NodeStore(), newSch, emptyRows, emptyIndexes, nil)
+	if err != nil {
+		return err
+	}
+	newRoot, err := root.PutTable(ctx, tableName, emptyTable)
+	if err != nil {
+		return err
+	}
+	// We use our own write session, as the session's write session must not see the empty table until every row has
+	// been written
+	opts := state.WriteSession().GetOptions()
+	opts.ForeignKeyChecksDisabled = true
+	writeSession := writer.NewWriteSession(table.Format(), ws.WithWorkingRoot(newRoot), aiTracker, opts)
+	tableWriter, err := writeSession.GetTableWriter(ctx, tableName, dbName, session.SetWorkingRoot)
+	if err != nil {
+		return err
+	}
+	newCols := newSch.GetAllCols().GetColumns()
+	for {
+		row, err := rows.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			_ = tableWriter.DiscardChanges(ctx, err)
+			_ = tableWriter.Close(ctx)
+			return err
+		}
+		for i, col := range newCols {
+			if row[i] == nil && !col.IsNullable() {
+				err = fmt.Errorf(`column "%s" of relation "%s" contains null values`, col.Name, tableName.Name)
+				_ = tableWriter.DiscardChanges(ctx, err)
+				_ = tableWriter.Close(ctx)
+				return err
+			}
+		}
+		if err = tableWriter.Insert(ctx, row); err != nil {
+			_ = tableWriter.DiscardChanges(ctx, err)
+			_ = tableWriter.Close(ctx)
+			return err
+		}
+	}
+	return tableWriter.Close(ctx)
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/resolve"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// CreateCheck adds the given check to the table, writing the updated table to the working root. If the table name does
+// not specify a schema, then the table is resolved using the search path. Dolt's own implementation does not take
+// schemas into account, which is why this exists.
+func CreateCheck(ctx *sql.Context, tableName doltdb.TableName, check *sql.CheckDefinition) error {
+	session, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	var table *doltdb.Table
+	var ok bool
+	if len(tableName.Schema) == 0 {
+		tableName, table, ok, err = resolve.Table(ctx, root, tableName.Name)
+	} else {
+		table, ok, err = root.GetTable(ctx, tableName)
+	}
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf(`relation "%s" does not exist`, tableName.Name)
+	}
+	sch, err := table.GetSchema(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err = sch.Checks().AddCheck(check.Name, check.CheckExpression, check.Enforced); err != nil {
+		return err
+	}
+	table, err = table.UpdateSchema(ctx, sch)
+	if err != nil {
+		return err
+	}
+	newRoot, err := root.PutTable(ctx, tableName, table)
+	if err != nil {
+		return err
+	}
+	return session.SetWorkingRoot(ctx, ctx.GetCurrentDatabase(), newRoot)
+}
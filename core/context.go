@@ -22,12 +22,24 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/resolve"
 	"github.com/dolthub/go-mysql-server/sql"
 
+	"github.com/dolthub/doltgresql/core/exclusions"
+	"github.com/dolthub/doltgresql/core/foreign"
+	"github.com/dolthub/doltgresql/core/functions"
+	"github.com/dolthub/doltgresql/core/masking"
+	"github.com/dolthub/doltgresql/core/partitions"
+	"github.com/dolthub/doltgresql/core/publications"
 	"github.com/dolthub/doltgresql/core/sequences"
+	"github.com/dolthub/doltgresql/core/storageparams"
+	"github.com/dolthub/doltgresql/core/triggers"
+	"github.com/dolthub/doltgresql/core/unvalidated"
 )
 
 // contextValues contains a set of objects that will be passed alongside the context.
 type contextValues struct {
 	collection *sequences.Collection
+	// collectionDatabase is the database that the sequence collection was loaded from, which may be a revision
+	// database such as "mydb/feature".
+	collectionDatabase string
 }
 
 // getContextValues accesses the contextValues in the given context. If the context does not have a contextValues, then
@@ -84,6 +96,96 @@ func GetTableFromContext(ctx *sql.Context, tableName doltdb.TableName) (*doltdb.
 	return table, nil
 }
 
+// GetSqlTableFromContext returns the table with the given name within the given database, which may then be read from
+// and written to like any other table within a query. The table's schema must be given. Returns nil if no table was
+// found.
+func GetSqlTableFromContext(ctx *sql.Context, database string, tableName doltdb.TableName) (sql.Table, error) {
+	session := dsess.DSessFromSess(ctx.Session)
+	db, err := session.Provider().Database(ctx, database)
+	if err != nil {
+		return nil, err
+	}
+	schemaDb, ok := db.(sql.SchemaDatabase)
+	if !ok {
+		return nil, fmt.Errorf("database %s does not support schemas", db.Name())
+	}
+	schema, ok, err := schemaDb.GetSchema(ctx, tableName.Schema)
+	if err != nil || !ok {
+		return nil, err
+	}
+	table, ok, err := schema.GetTableInsensitive(ctx, tableName.Name)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return table, nil
+}
+
+// ResolveTableName returns the name of the table with the given name within the given database, resolving the name
+// using the search path when it does not specify a schema. Returns false if the table does not exist.
+func ResolveTableName(ctx *sql.Context, database string, tableName doltdb.TableName) (doltdb.TableName, bool, error) {
+	session := dsess.DSessFromSess(ctx.Session)
+	state, ok, err := session.LookupDbState(ctx, database)
+	if err != nil || !ok {
+		return doltdb.TableName{}, false, err
+	}
+	root := state.WorkingRoot().(*RootValue)
+	if len(tableName.Schema) == 0 {
+		resolvedName, _, ok, err := resolve.Table(ctx, root, tableName.Name)
+		return resolvedName, ok, err
+	}
+	ok, err = root.HasTable(ctx, tableName)
+	return tableName, ok, err
+}
+
+// GetSchemaNamesFromContext returns the names of the schemas in the current database.
+func GetSchemaNamesFromContext(ctx *sql.Context) ([]string, error) {
+	_, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dbSchemas, err := root.GetDatabaseSchemas(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(dbSchemas))
+	for i, dbSchema := range dbSchemas {
+		names[i] = dbSchema.Name
+	}
+	return names, nil
+}
+
+// DropSchema removes the schema with the given name from the working root within the context. The schema must not
+// contain any tables.
+func DropSchema(ctx *sql.Context, schemaName string) error {
+	session, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	newRoot, err := root.DropDatabaseSchema(ctx, schemaName)
+	if err != nil {
+		return err
+	}
+	return session.SetWorkingRoot(ctx, ctx.GetCurrentDatabase(), newRoot)
+}
+
+// GetTableNamesFromContext returns the names of the tables within the given schema of the current database.
+func GetTableNamesFromContext(ctx *sql.Context, schemaName string) ([]string, error) {
+	_, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return root.GetTableNames(ctx, schemaName)
+}
+
+// GetForeignKeyCollectionFromContext returns the foreign key collection of the working root from the context.
+func GetForeignKeyCollectionFromContext(ctx *sql.Context) (*doltdb.ForeignKeyCollection, error) {
+	_, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return root.GetForeignKeyCollection(ctx)
+}
+
 // GetCollectionFromContext returns the given sequence collection from the context. Will always return a collection if
 // no error is returned.
 func GetCollectionFromContext(ctx *sql.Context) (*sequences.Collection, error) {
@@ -91,7 +193,8 @@ func GetCollectionFromContext(ctx *sql.Context) (*sequences.Collection, error) {
 	if err != nil {
 		return nil, err
 	}
-	if cv.collection == nil {
+	// Each revision of a database has its own sequences, so the collection is reloaded when the database changes
+	if cv.collection == nil || cv.collectionDatabase != ctx.GetCurrentDatabase() {
 		_, root, err := getRootFromContext(ctx)
 		if err != nil {
 			return nil, err
@@ -100,10 +203,357 @@ func GetCollectionFromContext(ctx *sql.Context) (*sequences.Collection, error) {
 		if err != nil {
 			return nil, err
 		}
+		cv.collectionDatabase = ctx.GetCurrentDatabase()
 	}
 	return cv.collection, nil
 }
 
+// GetFunctionsCollectionFromContext returns the function collection of the working root from the context. Unlike
+// sequences, functions are only modified by DDL statements, so the collection is read from the root every time rather
+// than being held within the context.
+func GetFunctionsCollectionFromContext(ctx *sql.Context) (*functions.Collection, error) {
+	_, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return root.GetFunctions(ctx)
+}
+
+// UpdateFunctionsCollection writes the given function collection to the working root within the context.
+func UpdateFunctionsCollection(ctx *sql.Context, collection *functions.Collection) error {
+	session, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	newRoot, err := root.PutFunctions(ctx, collection)
+	if err != nil {
+		return err
+	}
+	return session.SetWorkingRoot(ctx, ctx.GetCurrentDatabase(), newRoot)
+}
+
+// GetMaskingPoliciesCollectionFromContext returns the masking policy collection of the working root from the context.
+func GetMaskingPoliciesCollectionFromContext(ctx *sql.Context) (*masking.Collection, error) {
+	_, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return root.GetMaskingPolicies(ctx)
+}
+
+// GetMaskingPoliciesForTable returns the masking policy collection of the working root of the given database, along
+// with the name of the given table with its schema resolved using the search path. Databases that are not stored on a
+// root, such as the system catalogs, return an empty collection.
+func GetMaskingPoliciesForTable(ctx *sql.Context, database string, tableName doltdb.TableName) (*masking.Collection, doltdb.TableName, error) {
+	session := dsess.DSessFromSess(ctx.Session)
+	state, ok, err := session.LookupDbState(ctx, database)
+	if err != nil || !ok {
+		collection, _ := masking.Deserialize(ctx, nil)
+		return collection, tableName, nil
+	}
+	root := state.WorkingRoot().(*RootValue)
+	if len(tableName.Schema) == 0 {
+		resolvedName, _, ok, err := resolve.Table(ctx, root, tableName.Name)
+		if err != nil {
+			return nil, doltdb.TableName{}, err
+		}
+		if ok {
+			tableName = resolvedName
+		}
+	}
+	collection, err := root.GetMaskingPolicies(ctx)
+	if err != nil {
+		return nil, doltdb.TableName{}, err
+	}
+	return collection, tableName, nil
+}
+
+// UpdateMaskingPoliciesCollection writes the given masking policy collection to the working root within the context.
+func UpdateMaskingPoliciesCollection(ctx *sql.Context, collection *masking.Collection) error {
+	session, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	newRoot, err := root.PutMaskingPolicies(ctx, collection)
+	if err != nil {
+		return err
+	}
+	return session.SetWorkingRoot(ctx, ctx.GetCurrentDatabase(), newRoot)
+}
+
+// GetStorageParametersCollectionFromContext returns the storage parameter collection of the working root from the
+// context.
+func GetStorageParametersCollectionFromContext(ctx *sql.Context) (*storageparams.Collection, error) {
+	_, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return root.GetStorageParameters(ctx)
+}
+
+// UpdateStorageParametersCollection writes the given storage parameter collection to the working root within the
+// context.
+func UpdateStorageParametersCollection(ctx *sql.Context, collection *storageparams.Collection) error {
+	session, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	newRoot, err := root.PutStorageParameters(ctx, collection)
+	if err != nil {
+		return err
+	}
+	return session.SetWorkingRoot(ctx, ctx.GetCurrentDatabase(), newRoot)
+}
+
+// GetForeignDataCollectionFromContext returns the foreign server and table collection of the working root from the
+// context.
+func GetForeignDataCollectionFromContext(ctx *sql.Context) (*foreign.Collection, error) {
+	_, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return root.GetForeignData(ctx)
+}
+
+// GetForeignTable returns the foreign definition of the given table within the given database, along with the server
+// that the table belongs to. Returns nil for both if the table is not a foreign table.
+func GetForeignTable(ctx *sql.Context, database string, tableName doltdb.TableName) (*foreign.Table, *foreign.Server, error) {
+	session := dsess.DSessFromSess(ctx.Session)
+	state, ok, err := session.LookupDbState(ctx, database)
+	if err != nil || !ok {
+		return nil, nil, nil
+	}
+	root := state.WorkingRoot().(*RootValue)
+	collection, err := root.GetForeignData(ctx)
+	if err != nil || collection.IsEmpty() {
+		return nil, nil, err
+	}
+	if len(tableName.Schema) == 0 {
+		resolvedName, _, ok, err := resolve.Table(ctx, root, tableName.Name)
+		if err != nil || !ok {
+			return nil, nil, err
+		}
+		tableName = resolvedName
+	}
+	table := collection.GetTable(tableName)
+	if table == nil {
+		return nil, nil, nil
+	}
+	return table, collection.GetServer(table.Server), nil
+}
+
+// UpdateForeignDataCollection writes the given foreign server and table collection to the working root within the
+// context.
+func UpdateForeignDataCollection(ctx *sql.Context, collection *foreign.Collection) error {
+	session, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	newRoot, err := root.PutForeignData(ctx, collection)
+	if err != nil {
+		return err
+	}
+	return session.SetWorkingRoot(ctx, ctx.GetCurrentDatabase(), newRoot)
+}
+
+// GetTriggersCollectionFromContext returns the triggers collection of the working root from the context.
+func GetTriggersCollectionFromContext(ctx *sql.Context) (*triggers.Collection, error) {
+	_, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return root.GetTriggers(ctx)
+}
+
+// GetTriggersForTable returns the triggers on the given table within the given database, in the order that they fire,
+// along with the name of the table with its schema resolved using the search path. Databases that are not stored on a
+// root, such as the system catalogs, do not have any triggers.
+func GetTriggersForTable(ctx *sql.Context, database string, tableName doltdb.TableName) ([]*triggers.Trigger, doltdb.TableName, error) {
+	session := dsess.DSessFromSess(ctx.Session)
+	state, ok, err := session.LookupDbState(ctx, database)
+	if err != nil || !ok {
+		return nil, tableName, nil
+	}
+	root, ok := state.WorkingRoot().(*RootValue)
+	if !ok {
+		return nil, tableName, nil
+	}
+	collection, err := root.GetTriggers(ctx)
+	if err != nil || collection.IsEmpty() {
+		return nil, tableName, err
+	}
+	if len(tableName.Schema) == 0 {
+		resolvedName, _, ok, err := resolve.Table(ctx, root, tableName.Name)
+		if err != nil {
+			return nil, doltdb.TableName{}, err
+		}
+		if ok {
+			tableName = resolvedName
+		}
+	}
+	return collection.GetTriggers(tableName), tableName, nil
+}
+
+// UpdateTriggersCollection writes the given triggers collection to the working root within the context.
+func UpdateTriggersCollection(ctx *sql.Context, collection *triggers.Collection) error {
+	session, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	newRoot, err := root.PutTriggers(ctx, collection)
+	if err != nil {
+		return err
+	}
+	return session.SetWorkingRoot(ctx, ctx.GetCurrentDatabase(), newRoot)
+}
+
+// GetPartitionsCollectionFromContext returns the partitions collection of the working root from the context.
+func GetPartitionsCollectionFromContext(ctx *sql.Context) (*partitions.Collection, error) {
+	_, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return root.GetPartitions(ctx)
+}
+
+// GetPartitionsForTable returns the partitions collection of the given database, along with the name of the table with
+// its schema resolved using the search path. Databases that are not stored on a root, such as the system catalogs, do
+// not have any partitioned tables, so this returns a nil collection for them.
+func GetPartitionsForTable(ctx *sql.Context, database string, tableName doltdb.TableName) (*partitions.Collection, doltdb.TableName, error) {
+	session := dsess.DSessFromSess(ctx.Session)
+	state, ok, err := session.LookupDbState(ctx, database)
+	if err != nil || !ok {
+		return nil, tableName, nil
+	}
+	root, ok := state.WorkingRoot().(*RootValue)
+	if !ok {
+		return nil, tableName, nil
+	}
+	collection, err := root.GetPartitions(ctx)
+	if err != nil || collection.IsEmpty() {
+		return nil, tableName, err
+	}
+	if len(tableName.Schema) == 0 {
+		resolvedName, _, ok, err := resolve.Table(ctx, root, tableName.Name)
+		if err != nil {
+			return nil, doltdb.TableName{}, err
+		}
+		if ok {
+			tableName = resolvedName
+		}
+	}
+	return collection, tableName, nil
+}
+
+// UpdatePartitionsCollection writes the given partitions collection to the working root within the context.
+func UpdatePartitionsCollection(ctx *sql.Context, collection *partitions.Collection) error {
+	session, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	newRoot, err := root.PutPartitions(ctx, collection)
+	if err != nil {
+		return err
+	}
+	return session.SetWorkingRoot(ctx, ctx.GetCurrentDatabase(), newRoot)
+}
+
+// GetExclusionsCollectionFromContext returns the exclusion constraints collection of the working root from the context.
+func GetExclusionsCollectionFromContext(ctx *sql.Context) (*exclusions.Collection, error) {
+	_, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return root.GetExclusionConstraints(ctx)
+}
+
+// GetExclusionConstraintsForTable returns the exclusion constraints on the given table within the given database, along
+// with the name of the table with its schema resolved using the search path. Databases that are not stored on a root,
+// such as the system catalogs, do not have any exclusion constraints.
+func GetExclusionConstraintsForTable(ctx *sql.Context, database string, tableName doltdb.TableName) ([]*exclusions.Constraint, doltdb.TableName, error) {
+	session := dsess.DSessFromSess(ctx.Session)
+	state, ok, err := session.LookupDbState(ctx, database)
+	if err != nil || !ok {
+		return nil, tableName, nil
+	}
+	root, ok := state.WorkingRoot().(*RootValue)
+	if !ok {
+		return nil, tableName, nil
+	}
+	collection, err := root.GetExclusionConstraints(ctx)
+	if err != nil || collection.IsEmpty() {
+		return nil, tableName, err
+	}
+	if len(tableName.Schema) == 0 {
+		resolvedName, _, ok, err := resolve.Table(ctx, root, tableName.Name)
+		if err != nil {
+			return nil, doltdb.TableName{}, err
+		}
+		if ok {
+			tableName = resolvedName
+		}
+	}
+	return collection.GetConstraints(tableName), tableName, nil
+}
+
+// UpdateExclusionsCollection writes the given exclusion constraints collection to the working root within the context.
+func UpdateExclusionsCollection(ctx *sql.Context, collection *exclusions.Collection) error {
+	session, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	newRoot, err := root.PutExclusionConstraints(ctx, collection)
+	if err != nil {
+		return err
+	}
+	return session.SetWorkingRoot(ctx, ctx.GetCurrentDatabase(), newRoot)
+}
+
+// GetUnvalidatedCollectionFromContext returns the unvalidated constraints collection of the working root from the
+// context.
+func GetUnvalidatedCollectionFromContext(ctx *sql.Context) (*unvalidated.Collection, error) {
+	_, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return root.GetUnvalidatedConstraints(ctx)
+}
+
+// UpdateUnvalidatedCollection writes the given unvalidated constraints collection to the working root within the context.
+func UpdateUnvalidatedCollection(ctx *sql.Context, collection *unvalidated.Collection) error {
+	session, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	newRoot, err := root.PutUnvalidatedConstraints(ctx, collection)
+	if err != nil {
+		return err
+	}
+	return session.SetWorkingRoot(ctx, ctx.GetCurrentDatabase(), newRoot)
+}
+
+// GetPublicationsCollectionFromContext returns the publication collection of the working root from the context.
+func GetPublicationsCollectionFromContext(ctx *sql.Context) (*publications.Collection, error) {
+	_, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return root.GetPublications(ctx)
+}
+
+// UpdatePublicationsCollection writes the given publication collection to the working root within the context.
+func UpdatePublicationsCollection(ctx *sql.Context, collection *publications.Collection) error {
+	session, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	newRoot, err := root.PutPublications(ctx, collection)
+	if err != nil {
+		return err
+	}
+	return session.SetWorkingRoot(ctx, ctx.GetCurrentDatabase(), newRoot)
+}
+
 // CloseContextRootFinalizer finalizes any changes persisted within the context by writing them to the working root.
 // This should ONLY be called by the ContextRootFinalizer node.
 func CloseContextRootFinalizer(ctx *sql.Context) error {
@@ -118,16 +568,21 @@ func CloseContextRootFinalizer(ctx *sql.Context) error {
 	if cv.collection == nil {
 		return nil
 	}
-	session, root, err := getRootFromContext(ctx)
+	session := dsess.DSessFromSess(ctx.Session)
+	state, ok, err := session.LookupDbState(ctx, cv.collectionDatabase)
 	if err != nil {
 		return err
 	}
-	newRoot, err := root.PutSequences(ctx, cv.collection)
+	// Revisions that are not branches (such as commits and tags) do not have a working set, as they are read-only
+	if !ok || state.WorkingSet() == nil {
+		return nil
+	}
+	newRoot, err := state.WorkingRoot().(*RootValue).PutSequences(ctx, cv.collection)
 	if err != nil {
 		return err
 	}
 	if newRoot != nil {
-		if err = session.SetWorkingRoot(ctx, ctx.GetCurrentDatabase(), newRoot); err != nil {
+		if err = session.SetWorkingRoot(ctx, cv.collectionDatabase, newRoot); err != nil {
 			return err
 		}
 	}
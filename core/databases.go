@@ -0,0 +1,80 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/dolt/go/store/util/tempfiles"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// CopyDatabaseRoot replaces the working root of the target database with the working root of the source database, as
+// it was last committed by any session. The target's working root is also staged, as it's expected that the target
+// was just created. This is used to create a database from a template.
+func CopyDatabaseRoot(ctx *sql.Context, source string, target string) error {
+	provider := dsess.DSessFromSess(ctx.Session).Provider()
+	sourceDb, ok := provider.BaseDatabase(ctx, source)
+	if !ok {
+		return sql.ErrDatabaseNotFound.New(source)
+	}
+	targetDb, ok := provider.BaseDatabase(ctx, target)
+	if !ok {
+		return sql.ErrDatabaseNotFound.New(target)
+	}
+	sourceData := sourceDb.DbData()
+	targetData := targetDb.DbData()
+	sourceWs, err := resolveHeadWorkingSet(ctx, sourceData.Ddb, sourceData.Rsr.CWBHeadRef)
+	if err != nil {
+		return err
+	}
+	targetWs, err := resolveHeadWorkingSet(ctx, targetData.Ddb, targetData.Rsr.CWBHeadRef)
+	if err != nil {
+		return err
+	}
+	rootHash, err := sourceWs.WorkingRoot().HashOf()
+	if err != nil {
+		return err
+	}
+	// The root's chunks live in the source's storage, so they're copied over before the root is read from the target
+	err = targetData.Ddb.PullChunks(ctx, tempfiles.MovableTempFileProvider.GetTempDir(), sourceData.Ddb, []hash.Hash{rootHash}, nil, nil)
+	if err != nil {
+		return err
+	}
+	root, err := targetData.Ddb.ReadRootValue(ctx, rootHash)
+	if err != nil {
+		return err
+	}
+	prevHash, err := targetWs.HashOf()
+	if err != nil {
+		return err
+	}
+	return targetData.Ddb.UpdateWorkingSet(ctx, targetWs.Ref(), targetWs.WithWorkingRoot(root).WithStagedRoot(root), prevHash, doltdb.TodoWorkingSetMeta(), nil)
+}
+
+// resolveHeadWorkingSet returns the working set of the database's checked out branch.
+func resolveHeadWorkingSet(ctx *sql.Context, ddb *doltdb.DoltDB, headRef func() (ref.DoltRef, error)) (*doltdb.WorkingSet, error) {
+	head, err := headRef()
+	if err != nil {
+		return nil, err
+	}
+	wsRef, err := ref.WorkingSetRefForHead(head)
+	if err != nil {
+		return nil, err
+	}
+	return ddb.ResolveWorkingSet(ctx, wsRef)
+}
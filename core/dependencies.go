@@ -0,0 +1,368 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/resolve"
+	"github.com/dolthub/go-mysql-server/sql"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core/dependencies"
+	"github.com/dolthub/doltgresql/core/functions"
+	"github.com/dolthub/doltgresql/core/sequences"
+	"github.com/dolthub/doltgresql/core/triggers"
+)
+
+// nextvalRegex matches the sequence name given to nextval within a column default.
+var nextvalRegex = regexp.MustCompile(`(?i)nextval\(\s*'((?:[^']|'')+)'`)
+
+// GetDependencyGraph returns the dependencies between the objects within the working root of the given database. As
+// with pg_depend, every object depends on its schema, while tables are depended on by the views that read from them,
+// the foreign keys that reference them, and the sequences that they own. Sequences are depended on by the column
+// defaults that call nextval on them, and functions are depended on by the triggers that call them.
+func GetDependencyGraph(ctx *sql.Context, database string) (*dependencies.Graph, error) {
+	session := dsess.DSessFromSess(ctx.Session)
+	state, ok, err := session.LookupDbState(ctx, database)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, sql.ErrDatabaseNotFound.New(database)
+	}
+	root, ok := state.WorkingRoot().(*RootValue)
+	if !ok {
+		return dependencies.NewGraph(), nil
+	}
+	builder := &dependencyBuilder{
+		ctx:          ctx,
+		root:         root,
+		graph:        dependencies.NewGraph(),
+		tableSchemas: make(map[string][]string),
+		views:        make(map[string]dependencies.Object),
+	}
+	// The sequences of the current database may have been modified within the context
+	if database == ctx.GetCurrentDatabase() {
+		builder.sequences, err = GetCollectionFromContext(ctx)
+	} else {
+		builder.sequences, err = root.GetSequences(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = builder.addTables(); err != nil {
+		return nil, err
+	}
+	if err = builder.addSequences(); err != nil {
+		return nil, err
+	}
+	if err = builder.addForeignKeys(); err != nil {
+		return nil, err
+	}
+	functionCollection, err := root.GetFunctions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = builder.addFunctions(functionCollection); err != nil {
+		return nil, err
+	}
+	triggerCollection, err := root.GetTriggers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = builder.addTriggers(triggerCollection); err != nil {
+		return nil, err
+	}
+	if err = builder.addViews(session, database); err != nil {
+		return nil, err
+	}
+	return builder.graph, nil
+}
+
+// dependencyBuilder builds the dependency graph of a root.
+type dependencyBuilder struct {
+	ctx       *sql.Context
+	root      *RootValue
+	graph     *dependencies.Graph
+	sequences *sequences.Collection
+	// tableSchemas holds the schemas that contain a table with the given name.
+	tableSchemas map[string][]string
+	// views holds every view, keyed by its lowercase name.
+	views map[string]dependencies.Object
+	// columnDefaults holds the columns whose defaults call nextval, along with the sequence names given to nextval.
+	columnDefaults []columnDefaultSequence
+}
+
+// columnDefaultSequence is a column default that calls nextval on the named sequence.
+type columnDefaultSequence struct {
+	defaultObj dependencies.Object
+	sequence   string
+}
+
+// schemaObject returns the object for the given schema.
+func schemaObject(schemaName string) dependencies.Object {
+	return dependencies.Object{Type: dependencies.ObjectType_Schema, Schema: schemaName, Name: schemaName}
+}
+
+// tableObject returns the object for the given table.
+func tableObject(tableName doltdb.TableName) dependencies.Object {
+	return dependencies.Object{Type: dependencies.ObjectType_Table, Schema: tableName.Schema, Name: tableName.Name}
+}
+
+// addTables adds every table, along with the column defaults that call nextval.
+func (b *dependencyBuilder) addTables() error {
+	return b.root.IterTables(b.ctx, func(name doltdb.TableName, _ *doltdb.Table, sch schema.Schema) (bool, error) {
+		// Tables without a schema predate schemas, and cannot be dropped through their schema
+		if len(name.Schema) == 0 {
+			return false, nil
+		}
+		table := tableObject(name)
+		b.graph.AddDependency(table, schemaObject(name.Schema), dependencies.DependencyType_Normal)
+		b.tableSchemas[name.Name] = append(b.tableSchemas[name.Name], name.Schema)
+		for _, col := range sch.GetAllCols().GetColumns() {
+			if match := nextvalRegex.FindStringSubmatch(col.Default); match != nil {
+				defaultObj := dependencies.Object{
+					Type:   dependencies.ObjectType_ColumnDefault,
+					Schema: name.Schema,
+					Table:  name.Name,
+					Name:   col.Name,
+				}
+				b.graph.AddDependency(defaultObj, table, dependencies.DependencyType_Auto)
+				b.columnDefaults = append(b.columnDefaults, columnDefaultSequence{
+					defaultObj: defaultObj,
+					sequence:   strings.ReplaceAll(match[1], "''", "'"),
+				})
+			}
+		}
+		return false, nil
+	})
+}
+
+// addSequences adds every sequence, along with the tables that own them and the column defaults that call them.
+func (b *dependencyBuilder) addSequences() error {
+	type schemaSequence struct {
+		schema string
+		seq    *sequences.Sequence
+	}
+	// Sequences are added in order of their names, so that dependents are always listed in the same order
+	var seqs []schemaSequence
+	if err := b.sequences.IterateSequences(func(schemaName string, seq *sequences.Sequence) error {
+		seqs = append(seqs, schemaSequence{schema: schemaName, seq: seq})
+		return nil
+	}); err != nil {
+		return err
+	}
+	sort.Slice(seqs, func(i, j int) bool {
+		if seqs[i].schema != seqs[j].schema {
+			return seqs[i].schema < seqs[j].schema
+		}
+		return seqs[i].seq.Name < seqs[j].seq.Name
+	})
+	for _, s := range seqs {
+		schemaName, seq := s.schema, s.seq
+		seqObj := dependencies.Object{Type: dependencies.ObjectType_Sequence, Schema: schemaName, Name: seq.Name}
+		b.graph.AddDependency(seqObj, schemaObject(schemaName), dependencies.DependencyType_Normal)
+		if len(seq.OwnerTable) == 0 {
+			continue
+		}
+		table := tableObject(doltdb.TableName{Name: seq.OwnerTable, Schema: schemaName})
+		if seq.Identity == sequences.Identity_None {
+			b.graph.AddDependency(seqObj, table, dependencies.DependencyType_Auto)
+			continue
+		}
+		// The sequence of an identity column is a part of the column, so it may only be dropped alongside the column
+		column := dependencies.Object{
+			Type:   dependencies.ObjectType_Column,
+			Schema: schemaName,
+			Table:  seq.OwnerTable,
+			Name:   seq.OwnerColumn,
+		}
+		b.graph.AddDependency(column, table, dependencies.DependencyType_Auto)
+		b.graph.AddDependency(seqObj, column, dependencies.DependencyType_Internal)
+	}
+	for _, columnDefault := range b.columnDefaults {
+		seqName := doltdb.TableName{Name: columnDefault.sequence, Schema: columnDefault.defaultObj.Schema}
+		if schemaName, name, ok := strings.Cut(columnDefault.sequence, "."); ok {
+			seqName = doltdb.TableName{Name: name, Schema: schemaName}
+		} else if !b.sequences.HasSequence(seqName) {
+			searchPath, err := resolve.SearchPath(b.ctx)
+			if err != nil {
+				return err
+			}
+			for _, schemaName := range searchPath {
+				if b.sequences.HasSequence(doltdb.TableName{Name: seqName.Name, Schema: schemaName}) {
+					seqName.Schema = schemaName
+					break
+				}
+			}
+		}
+		if b.sequences.HasSequence(seqName) {
+			seqObj := dependencies.Object{Type: dependencies.ObjectType_Sequence, Schema: seqName.Schema, Name: seqName.Name}
+			b.graph.AddDependency(columnDefault.defaultObj, seqObj, dependencies.DependencyType_Normal)
+		}
+	}
+	return nil
+}
+
+// addForeignKeys adds every foreign key, which belongs to its own table and depends on the table that it references.
+func (b *dependencyBuilder) addForeignKeys() error {
+	fkc, err := b.root.GetForeignKeyCollection(b.ctx)
+	if err != nil {
+		return err
+	}
+	for _, fk := range fkc.AllKeys() {
+		tableName, ok, err := b.resolveTable(fk.TableName)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		table := tableObject(tableName)
+		fkObj := dependencies.Object{
+			Type:   dependencies.ObjectType_ForeignKey,
+			Schema: tableName.Schema,
+			Table:  tableName.Name,
+			Name:   fk.Name,
+		}
+		b.graph.AddDependency(fkObj, table, dependencies.DependencyType_Auto)
+		referencedName, ok, err := b.resolveTable(fk.ReferencedTableName)
+		if err != nil {
+			return err
+		}
+		if ok && referencedName != tableName {
+			b.graph.AddDependency(fkObj, tableObject(referencedName), dependencies.DependencyType_Normal)
+		}
+	}
+	return nil
+}
+
+// addFunctions adds every function and procedure, in order of their names.
+func (b *dependencyBuilder) addFunctions(collection *functions.Collection) error {
+	var functionObjs []dependencies.Object
+	if err := collection.IterateFunctions(func(schemaName string, function *functions.Function) error {
+		functionObj := dependencies.Object{Type: dependencies.ObjectType_Function, Schema: schemaName, Name: function.Name}
+		if function.Kind == functions.Kind_Procedure {
+			functionObj.Type = dependencies.ObjectType_Procedure
+		}
+		functionObjs = append(functionObjs, functionObj)
+		return nil
+	}); err != nil {
+		return err
+	}
+	sort.Slice(functionObjs, func(i, j int) bool {
+		if functionObjs[i].Schema != functionObjs[j].Schema {
+			return functionObjs[i].Schema < functionObjs[j].Schema
+		}
+		return functionObjs[i].Name < functionObjs[j].Name
+	})
+	for _, functionObj := range functionObjs {
+		b.graph.AddDependency(functionObj, schemaObject(functionObj.Schema), dependencies.DependencyType_Normal)
+	}
+	return nil
+}
+
+// addTriggers adds every trigger, which belongs to its table and depends on the function that it calls.
+func (b *dependencyBuilder) addTriggers(collection *triggers.Collection) error {
+	return collection.IterateTriggers(func(trigger *triggers.Trigger) error {
+		triggerObj := dependencies.Object{
+			Type:   dependencies.ObjectType_Trigger,
+			Schema: trigger.Table.Schema,
+			Table:  trigger.Table.Name,
+			Name:   trigger.Name,
+		}
+		b.graph.AddDependency(triggerObj, tableObject(trigger.Table), dependencies.DependencyType_Auto)
+		functionObj := dependencies.Object{
+			Type:   dependencies.ObjectType_Function,
+			Schema: trigger.Function.Schema,
+			Name:   trigger.Function.Name,
+		}
+		b.graph.AddDependency(triggerObj, functionObj, dependencies.DependencyType_Normal)
+		return nil
+	})
+}
+
+// addViews adds every view, along with the tables and views that they read from.
+func (b *dependencyBuilder) addViews(session *dsess.DoltSession, database string) error {
+	views, err := getViews(b.ctx, session, database, b.root)
+	if err != nil {
+		return err
+	}
+	references := make(map[dependencies.Object][]vitess.TableName)
+	var viewObjs []dependencies.Object
+	for _, view := range views {
+		viewObj := dependencies.Object{Type: dependencies.ObjectType_View, Schema: view.Name.Schema, Name: view.Name.Name}
+		b.graph.AddDependency(viewObj, schemaObject(viewObj.Schema), dependencies.DependencyType_Normal)
+		b.views[strings.ToLower(view.Name.Name)] = viewObj
+		viewObjs = append(viewObjs, viewObj)
+		_ = vitess.Walk(func(node vitess.SQLNode) (bool, error) {
+			if aliased, ok := node.(*vitess.AliasedTableExpr); ok {
+				if tableName, ok := aliased.Expr.(vitess.TableName); ok {
+					references[viewObj] = append(references[viewObj], tableName)
+				}
+			}
+			return true, nil
+		}, view.Definition.ViewSpec.ViewExpr)
+	}
+	// References are resolved once every view is known, as views may read from other views
+	for _, viewObj := range viewObjs {
+		for _, reference := range references[viewObj] {
+			if dbName := reference.DbQualifier.String(); len(dbName) > 0 && !strings.EqualFold(dbName, database) {
+				continue
+			}
+			referenced, ok, err := b.resolveRelation(reference)
+			if err != nil {
+				return err
+			}
+			if ok {
+				b.graph.AddDependency(viewObj, referenced, dependencies.DependencyType_Normal)
+			}
+		}
+	}
+	return nil
+}
+
+// resolveRelation returns the table or view that the name refers to.
+func (b *dependencyBuilder) resolveRelation(name vitess.TableName) (dependencies.Object, bool, error) {
+	if schemaName := name.SchemaQualifier.String(); len(schemaName) > 0 {
+		tableName := doltdb.TableName{Name: name.Name.String(), Schema: schemaName}
+		if ok, err := b.root.HasTable(b.ctx, tableName); err != nil || ok {
+			return tableObject(tableName), ok, err
+		}
+	} else {
+		tableName, _, ok, err := resolve.Table(b.ctx, b.root, name.Name.String())
+		if err != nil || ok {
+			return tableObject(tableName), ok, err
+		}
+	}
+	view, ok := b.views[strings.ToLower(name.Name.String())]
+	return view, ok, nil
+}
+
+// resolveTable returns the schema-qualified name of the table with the given name. Tables are found by their name alone
+// when only one schema contains a table with the name, and otherwise by using the search path.
+func (b *dependencyBuilder) resolveTable(name string) (doltdb.TableName, bool, error) {
+	if schemas := b.tableSchemas[name]; len(schemas) == 1 {
+		return doltdb.TableName{Name: name, Schema: schemas[0]}, true, nil
+	}
+	tableName, _, ok, err := resolve.Table(b.ctx, b.root, name)
+	return tableName, ok, err
+}
@@ -0,0 +1,227 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dependencies
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ObjectType is the type of an object that may depend on, or be depended on by, other objects.
+type ObjectType uint8
+
+const (
+	ObjectType_Schema ObjectType = iota
+	ObjectType_Table
+	ObjectType_View
+	ObjectType_Sequence
+	ObjectType_Function
+	ObjectType_Procedure
+	ObjectType_Column
+	ObjectType_ColumnDefault
+	ObjectType_ForeignKey
+	ObjectType_Trigger
+)
+
+// DependencyType states how a dependent object behaves when the object that it depends on is dropped. These match the
+// dependency types of pg_depend.
+type DependencyType uint8
+
+const (
+	// DependencyType_Normal dependents may only be dropped alongside the referenced object when CASCADE is given.
+	DependencyType_Normal DependencyType = iota
+	// DependencyType_Auto dependents are always dropped alongside the referenced object, such as a sequence that is
+	// owned by a table.
+	DependencyType_Auto
+	// DependencyType_Internal dependents are a part of the referenced object, and may not be dropped on their own, such
+	// as the sequence of an identity column.
+	DependencyType_Internal
+)
+
+// Object is an object within a database. Objects that belong to a table, such as columns and constraints, hold the name
+// of their table alongside their own name. Schemas use their own name as their schema.
+type Object struct {
+	Type   ObjectType
+	Schema string
+	Table  string
+	Name   string
+}
+
+// Dependent is an object that will be dropped alongside the objects that were named by a DROP statement.
+type Dependent struct {
+	Object Object
+	// Referenced is the object that caused this one to be dropped.
+	Referenced Object
+	Type       DependencyType
+}
+
+// Graph contains the dependencies between the objects of a database, similar to pg_depend.
+type Graph struct {
+	objects    map[Object]struct{}
+	dependents map[Object][]dependency
+	owners     map[Object][]dependency
+}
+
+// dependency is a single edge within the graph, pointing to the object at the other end.
+type dependency struct {
+	object Object
+	typ    DependencyType
+}
+
+// NewGraph returns a new, empty *Graph.
+func NewGraph() *Graph {
+	return &Graph{
+		objects:    make(map[Object]struct{}),
+		dependents: make(map[Object][]dependency),
+		owners:     make(map[Object][]dependency),
+	}
+}
+
+// AddDependency records that the dependent object depends on the referenced object.
+func (g *Graph) AddDependency(dependent Object, referenced Object, typ DependencyType) {
+	if dependent == referenced {
+		return
+	}
+	g.objects[dependent] = struct{}{}
+	g.objects[referenced] = struct{}{}
+	for _, existing := range g.dependents[referenced] {
+		if existing.object == dependent {
+			return
+		}
+	}
+	g.dependents[referenced] = append(g.dependents[referenced], dependency{object: dependent, typ: typ})
+	if typ != DependencyType_Normal {
+		g.owners[dependent] = append(g.owners[dependent], dependency{object: referenced, typ: typ})
+	}
+}
+
+// Find returns the objects of the given type that have the given name, sorted by their schemas. An empty schema
+// matches objects within every schema.
+func (g *Graph) Find(typ ObjectType, schema string, name string) []Object {
+	var found []Object
+	for obj := range g.objects {
+		if obj.Type == typ && obj.Name == name && (len(schema) == 0 || obj.Schema == schema) {
+			found = append(found, obj)
+		}
+	}
+	sort.Slice(found, func(i, j int) bool {
+		return found[i].Schema < found[j].Schema
+	})
+	return found
+}
+
+// InternalOwner returns the object that the given object is an internal part of, if there is one.
+func (g *Graph) InternalOwner(obj Object) (Object, bool) {
+	for _, owner := range g.owners[obj] {
+		if owner.typ == DependencyType_Internal {
+			return owner.object, true
+		}
+	}
+	return Object{}, false
+}
+
+// Collect returns every object that must be dropped in order to drop the given objects, which includes the given
+// objects. Each object is ordered before the objects that it depends on, so that the objects may be dropped in the
+// returned order. The dependents are the objects that were not given, and that are only dropped when CASCADE is given,
+// in the order that they were found. Objects that are dropped alongside another dropped object regardless of CASCADE,
+// such as a table's own constraints, are not included within the dependents.
+func (g *Graph) Collect(targets []Object) (order []Object, dependents []Dependent) {
+	visited := make(map[Object]struct{})
+	isTarget := make(map[Object]struct{}, len(targets))
+	for _, target := range targets {
+		isTarget[target] = struct{}{}
+	}
+	var found []Dependent
+	var visit func(obj Object)
+	visit = func(obj Object) {
+		if _, ok := visited[obj]; ok {
+			return
+		}
+		visited[obj] = struct{}{}
+		for _, dep := range g.dependents[obj] {
+			_, seen := visited[dep.object]
+			_, targeted := isTarget[dep.object]
+			if !seen && !targeted {
+				found = append(found, Dependent{Object: dep.object, Referenced: obj, Type: dep.typ})
+			}
+			visit(dep.object)
+		}
+		order = append(order, obj)
+	}
+	for _, target := range targets {
+		visit(target)
+	}
+	for _, dependent := range found {
+		if dependent.Type == DependencyType_Normal && !g.ownedByAny(dependent.Object, visited) {
+			dependents = append(dependents, dependent)
+		}
+	}
+	return order, dependents
+}
+
+// DroppedWith returns whether the object is always dropped alongside any of the given objects, such as a constraint that
+// is dropped alongside its table.
+func (g *Graph) DroppedWith(obj Object, objects []Object) bool {
+	set := make(map[Object]struct{}, len(objects))
+	for _, other := range objects {
+		set[other] = struct{}{}
+	}
+	return g.ownedByAny(obj, set)
+}
+
+// ownedByAny returns whether the object is automatically dropped alongside any of the given objects.
+func (g *Graph) ownedByAny(obj Object, objects map[Object]struct{}) bool {
+	for _, owner := range g.owners[obj] {
+		if _, ok := objects[owner.object]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Description returns the description of the object that is used within messages, such as `table t`. Names within the
+// given visible schema are not qualified, which is the schema that unqualified names resolve to.
+func (o Object) Description(visibleSchema string) string {
+	name := o.Name
+	table := o.Table
+	if o.Schema != visibleSchema {
+		name = fmt.Sprintf("%s.%s", o.Schema, o.Name)
+		table = fmt.Sprintf("%s.%s", o.Schema, o.Table)
+	}
+	switch o.Type {
+	case ObjectType_Schema:
+		return fmt.Sprintf("schema %s", o.Name)
+	case ObjectType_Table:
+		return fmt.Sprintf("table %s", name)
+	case ObjectType_View:
+		return fmt.Sprintf("view %s", name)
+	case ObjectType_Sequence:
+		return fmt.Sprintf("sequence %s", name)
+	case ObjectType_Function:
+		return fmt.Sprintf("function %s()", name)
+	case ObjectType_Procedure:
+		return fmt.Sprintf("procedure %s()", name)
+	case ObjectType_Column:
+		return fmt.Sprintf("column %s of table %s", o.Name, table)
+	case ObjectType_ColumnDefault:
+		return fmt.Sprintf("default value for column %s of table %s", o.Name, table)
+	case ObjectType_ForeignKey:
+		return fmt.Sprintf("constraint %s on table %s", o.Name, table)
+	case ObjectType_Trigger:
+		return fmt.Sprintf("trigger %s on table %s", o.Name, table)
+	default:
+		return fmt.Sprintf("object %s", name)
+	}
+}
@@ -0,0 +1,264 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exclusions
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+// TableOptionPrefix is prepended to the position of each exclusion constraint when the constraint is carried through
+// CREATE TABLE as a table option.
+const TableOptionPrefix = "exclusion_constraint."
+
+// Element is a single column of an exclusion constraint, along with the operator that compares the column's values.
+type Element struct {
+	Column   string
+	Operator string
+}
+
+// Constraint is an exclusion constraint on a table. Two rows conflict when every element's operator returns true when
+// comparing the rows' values for that element's column.
+type Constraint struct {
+	Name     string
+	Table    doltdb.TableName
+	Using    string
+	Elements []Element
+}
+
+// Collection contains every exclusion constraint, grouped by the table that they are on.
+type Collection struct {
+	constraints map[doltdb.TableName]map[string]*Constraint
+	mutex       *sync.Mutex
+}
+
+// GetConstraint returns the exclusion constraint with the given name on the given table. Returns nil if the constraint
+// does not exist.
+func (pge *Collection) GetConstraint(table doltdb.TableName, name string) *Constraint {
+	pge.mutex.Lock()
+	defer pge.mutex.Unlock()
+	return pge.constraints[table][name]
+}
+
+// GetConstraints returns every exclusion constraint on the given table, in order of their names.
+func (pge *Collection) GetConstraints(table doltdb.TableName) []*Constraint {
+	pge.mutex.Lock()
+	defer pge.mutex.Unlock()
+	tableConstraints := pge.constraints[table]
+	constraints := make([]*Constraint, 0, len(tableConstraints))
+	for _, constraint := range tableConstraints {
+		constraints = append(constraints, constraint)
+	}
+	sort.Slice(constraints, func(i, j int) bool {
+		return constraints[i].Name < constraints[j].Name
+	})
+	return constraints
+}
+
+// AddConstraint adds the given exclusion constraint, returning an error if a constraint with the same name already
+// exists on the table.
+func (pge *Collection) AddConstraint(constraint *Constraint) error {
+	pge.mutex.Lock()
+	defer pge.mutex.Unlock()
+	tableConstraints, ok := pge.constraints[constraint.Table]
+	if !ok {
+		tableConstraints = make(map[string]*Constraint)
+		pge.constraints[constraint.Table] = tableConstraints
+	}
+	if _, ok = tableConstraints[constraint.Name]; ok {
+		return fmt.Errorf(`constraint "%s" for relation "%s" already exists`, constraint.Name, constraint.Table.Name)
+	}
+	tableConstraints[constraint.Name] = constraint
+	return nil
+}
+
+// ReplaceConstraint adds the given exclusion constraint, replacing any constraint with the same name on the table.
+func (pge *Collection) ReplaceConstraint(constraint *Constraint) {
+	pge.mutex.Lock()
+	defer pge.mutex.Unlock()
+	tableConstraints, ok := pge.constraints[constraint.Table]
+	if !ok {
+		tableConstraints = make(map[string]*Constraint)
+		pge.constraints[constraint.Table] = tableConstraints
+	}
+	tableConstraints[constraint.Name] = constraint
+}
+
+// DropConstraint removes the exclusion constraint with the given name from the given table. Returns an error if the
+// constraint does not exist.
+func (pge *Collection) DropConstraint(table doltdb.TableName, name string) error {
+	pge.mutex.Lock()
+	defer pge.mutex.Unlock()
+	tableConstraints := pge.constraints[table]
+	if _, ok := tableConstraints[name]; !ok {
+		return fmt.Errorf(`constraint "%s" of relation "%s" does not exist`, name, table.Name)
+	}
+	delete(tableConstraints, name)
+	if len(tableConstraints) == 0 {
+		delete(pge.constraints, table)
+	}
+	return nil
+}
+
+// DropTable removes every exclusion constraint on the given table.
+func (pge *Collection) DropTable(table doltdb.TableName) {
+	pge.mutex.Lock()
+	defer pge.mutex.Unlock()
+	delete(pge.constraints, table)
+}
+
+// RenameTable moves every exclusion constraint on the old table to the new table.
+func (pge *Collection) RenameTable(oldName doltdb.TableName, newName doltdb.TableName) {
+	pge.mutex.Lock()
+	defer pge.mutex.Unlock()
+	tableConstraints, ok := pge.constraints[oldName]
+	if !ok {
+		return
+	}
+	delete(pge.constraints, oldName)
+	newConstraints := make(map[string]*Constraint, len(tableConstraints))
+	for name, constraint := range tableConstraints {
+		newConstraint := constraint.clone()
+		newConstraint.Table = newName
+		newConstraints[name] = newConstraint
+	}
+	pge.constraints[newName] = newConstraints
+}
+
+// IsEmpty returns whether the collection contains any exclusion constraints.
+func (pge *Collection) IsEmpty() bool {
+	pge.mutex.Lock()
+	defer pge.mutex.Unlock()
+	return len(pge.constraints) == 0
+}
+
+// IterateConstraints iterates over every exclusion constraint, in order of the schema, table, and constraint names.
+func (pge *Collection) IterateConstraints(f func(constraint *Constraint) error) error {
+	pge.mutex.Lock()
+	defer pge.mutex.Unlock()
+
+	tables := make([]doltdb.TableName, 0, len(pge.constraints))
+	for table := range pge.constraints {
+		tables = append(tables, table)
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		if tables[i].Schema != tables[j].Schema {
+			return tables[i].Schema < tables[j].Schema
+		}
+		return tables[i].Name < tables[j].Name
+	})
+	for _, table := range tables {
+		names := make([]string, 0, len(pge.constraints[table]))
+		for name := range pge.constraints[table] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if err := f(pge.constraints[table][name]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Clone returns a new *Collection with the same contents as the original.
+func (pge *Collection) Clone() *Collection {
+	pge.mutex.Lock()
+	defer pge.mutex.Unlock()
+
+	newCollection := &Collection{
+		constraints: make(map[doltdb.TableName]map[string]*Constraint, len(pge.constraints)),
+		mutex:       &sync.Mutex{},
+	}
+	for table, tableConstraints := range pge.constraints {
+		newConstraints := make(map[string]*Constraint, len(tableConstraints))
+		for name, constraint := range tableConstraints {
+			newConstraints[name] = constraint.clone()
+		}
+		newCollection.constraints[table] = newConstraints
+	}
+	return newCollection
+}
+
+// ConstraintsFromOptions returns the exclusion constraints on the given table that are described by the given table
+// options, in the order that they were defined.
+func ConstraintsFromOptions(table doltdb.TableName, options map[string]any) ([]*Constraint, error) {
+	var constraints []*Constraint
+	for i := 0; ; i++ {
+		option, ok := options[fmt.Sprintf("%s%d", TableOptionPrefix, i)]
+		if !ok {
+			break
+		}
+		constraint := &Constraint{}
+		if err := json.Unmarshal([]byte(fmt.Sprint(option)), constraint); err != nil {
+			return nil, err
+		}
+		constraint.Table = table
+		constraints = append(constraints, constraint)
+	}
+	return constraints, nil
+}
+
+// OptionValue returns the constraint as the value of a table option, which is read by ConstraintsFromOptions. The
+// constraint's table is not included, as it is given by the table that the option belongs to.
+func (constraint *Constraint) OptionValue() (string, error) {
+	data, err := json.Marshal(struct {
+		Name     string
+		Using    string
+		Elements []Element
+	}{constraint.Name, constraint.Using, constraint.Elements})
+	return string(data), err
+}
+
+// Columns returns the columns of the constraint's elements, in the order that they were given.
+func (constraint *Constraint) Columns() []string {
+	columns := make([]string, len(constraint.Elements))
+	for i, element := range constraint.Elements {
+		columns[i] = element.Column
+	}
+	return columns
+}
+
+// Definition returns the constraint's definition, in the form that is displayed by the system catalogs.
+func (constraint *Constraint) Definition() string {
+	elements := make([]string, len(constraint.Elements))
+	for i, element := range constraint.Elements {
+		elements[i] = fmt.Sprintf("%s WITH %s", element.Column, element.Operator)
+	}
+	return fmt.Sprintf("EXCLUDE USING %s (%s)", constraint.Using, strings.Join(elements, ", "))
+}
+
+// clone returns a deep copy of the constraint.
+func (constraint *Constraint) clone() *Constraint {
+	newConstraint := *constraint
+	newConstraint.Elements = slices.Clone(constraint.Elements)
+	return &newConstraint
+}
+
+// equals returns whether both constraints have the same definition. Either constraint may be nil.
+func (constraint *Constraint) equals(other *Constraint) bool {
+	if constraint == nil || other == nil {
+		return constraint == other
+	}
+	return constraint.Name == other.Name && constraint.Table == other.Table && constraint.Using == other.Using &&
+		slices.Equal(constraint.Elements, other.Elements)
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exclusions
+
+import (
+	"context"
+)
+
+// Merge handles merging exclusion constraints on our root and their root. Constraints are each merged as a whole: when
+// only their side changed a constraint, their definition is taken, and otherwise ours is kept.
+func Merge(ctx context.Context, ourCollection, theirCollection, ancCollection *Collection) (*Collection, error) {
+	mergedCollection := ourCollection.Clone()
+	err := theirCollection.IterateConstraints(func(theirConstraint *Constraint) error {
+		ourConstraint := mergedCollection.GetConstraint(theirConstraint.Table, theirConstraint.Name)
+		ancConstraint := ancCollection.GetConstraint(theirConstraint.Table, theirConstraint.Name)
+		if ourConstraint.equals(ancConstraint) && !theirConstraint.equals(ancConstraint) {
+			mergedCollection.ReplaceConstraint(theirConstraint.clone())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Constraints that were dropped on their side are removed from the merged collection, as long as we didn't change them
+	err = ourCollection.IterateConstraints(func(ourConstraint *Constraint) error {
+		if theirCollection.GetConstraint(ourConstraint.Table, ourConstraint.Name) != nil {
+			return nil
+		}
+		if ourConstraint.equals(ancCollection.GetConstraint(ourConstraint.Table, ourConstraint.Name)) {
+			return mergedCollection.DropConstraint(ourConstraint.Table, ourConstraint.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mergedCollection, nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exclusions
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// Serialize returns the Collection as a byte slice. If the Collection is nil, then this returns a nil slice.
+func (pge *Collection) Serialize(ctx context.Context) ([]byte, error) {
+	if pge == nil {
+		return nil, nil
+	}
+
+	// Write all of the exclusion constraints to the writer
+	writer := utils.NewWriter(256)
+	writer.VariableUint(0) // Version
+	var constraints []*Constraint
+	_ = pge.IterateConstraints(func(constraint *Constraint) error {
+		constraints = append(constraints, constraint)
+		return nil
+	})
+	writer.VariableUint(uint64(len(constraints)))
+	for _, constraint := range constraints {
+		writer.String(constraint.Name)
+		writer.String(constraint.Table.Schema)
+		writer.String(constraint.Table.Name)
+		writer.String(constraint.Using)
+		writer.VariableUint(uint64(len(constraint.Elements)))
+		for _, element := range constraint.Elements {
+			writer.String(element.Column)
+			writer.String(element.Operator)
+		}
+	}
+
+	return writer.Data(), nil
+}
+
+// Deserialize returns the Collection that was serialized in the byte slice. Returns an empty Collection if data is nil
+// or empty.
+func Deserialize(ctx context.Context, data []byte) (*Collection, error) {
+	collection := &Collection{
+		constraints: make(map[doltdb.TableName]map[string]*Constraint),
+		mutex:       &sync.Mutex{},
+	}
+	if len(data) == 0 {
+		return collection, nil
+	}
+	reader := utils.NewReader(data)
+	version := reader.VariableUint()
+	if version != 0 {
+		return nil, fmt.Errorf("version %d of exclusion constraints is not supported, please upgrade the server", version)
+	}
+
+	// Read from the reader
+	numOfConstraints := reader.VariableUint()
+	for i := uint64(0); i < numOfConstraints; i++ {
+		constraint := &Constraint{}
+		constraint.Name = reader.String()
+		constraint.Table.Schema = reader.String()
+		constraint.Table.Name = reader.String()
+		constraint.Using = reader.String()
+		numOfElements := reader.VariableUint()
+		constraint.Elements = make([]Element, numOfElements)
+		for j := uint64(0); j < numOfElements; j++ {
+			constraint.Elements[j].Column = reader.String()
+			constraint.Elements[j].Operator = reader.String()
+		}
+		if err := collection.AddConstraint(constraint); err != nil {
+			return nil, err
+		}
+	}
+	if !reader.IsEmpty() {
+		return nil, fmt.Errorf("extra data found while deserializing exclusion constraints")
+	}
+
+	// Return the deserialized object
+	return collection, nil
+}
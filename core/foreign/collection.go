@@ -0,0 +1,238 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package foreign
+
+import (
+	"fmt"
+	"maps"
+	"sort"
+	"sync"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+// Server is a foreign server, which names the foreign-data wrapper that reads its tables along with the options that
+// are shared by each of its tables.
+type Server struct {
+	Name    string
+	Wrapper string
+	Options map[string]string
+}
+
+// Table is the foreign definition of a table. The table's columns are stored as a regular table, so only the options
+// that tell the wrapper how to read each column are stored here.
+type Table struct {
+	Name    doltdb.TableName
+	Server  string
+	Options map[string]string
+	// ColumnOptions contains the options of every column that has any, keyed by the column's name.
+	ColumnOptions map[string]map[string]string
+}
+
+// Collection contains every foreign server and foreign table.
+type Collection struct {
+	servers map[string]*Server
+	tables  map[doltdb.TableName]*Table
+	mutex   *sync.Mutex
+}
+
+// GetServer returns the server with the given name. Returns nil if the server does not exist.
+func (pgf *Collection) GetServer(name string) *Server {
+	pgf.mutex.Lock()
+	defer pgf.mutex.Unlock()
+	return pgf.servers[name]
+}
+
+// HasServer returns whether the server exists.
+func (pgf *Collection) HasServer(name string) bool {
+	return pgf.GetServer(name) != nil
+}
+
+// AddServer adds the given server, returning an error if a server with the same name already exists.
+func (pgf *Collection) AddServer(server *Server) error {
+	pgf.mutex.Lock()
+	defer pgf.mutex.Unlock()
+	if _, ok := pgf.servers[server.Name]; ok {
+		return fmt.Errorf(`server "%s" already exists`, server.Name)
+	}
+	pgf.servers[server.Name] = server
+	return nil
+}
+
+// DropServer removes the server with the given name. Returns an error if the server does not exist, or if any foreign
+// tables still belong to it.
+func (pgf *Collection) DropServer(name string) error {
+	pgf.mutex.Lock()
+	defer pgf.mutex.Unlock()
+	if _, ok := pgf.servers[name]; !ok {
+		return fmt.Errorf(`server "%s" does not exist`, name)
+	}
+	for _, table := range pgf.tables {
+		if table.Server == name {
+			return fmt.Errorf(`cannot drop server %s because foreign table %s depends on it`, name, table.Name.Name)
+		}
+	}
+	delete(pgf.servers, name)
+	return nil
+}
+
+// GetTable returns the foreign definition of the given table. Returns nil if the table is not a foreign table.
+func (pgf *Collection) GetTable(name doltdb.TableName) *Table {
+	pgf.mutex.Lock()
+	defer pgf.mutex.Unlock()
+	return pgf.tables[name]
+}
+
+// AddTable adds the given foreign table. The table's server must already exist.
+func (pgf *Collection) AddTable(table *Table) error {
+	pgf.mutex.Lock()
+	defer pgf.mutex.Unlock()
+	if _, ok := pgf.servers[table.Server]; !ok {
+		return fmt.Errorf(`server "%s" does not exist`, table.Server)
+	}
+	if _, ok := pgf.tables[table.Name]; ok {
+		return fmt.Errorf(`relation "%s" already exists`, table.Name.Name)
+	}
+	pgf.tables[table.Name] = table
+	return nil
+}
+
+// DropTable removes the foreign definition of the given table, if it has one.
+func (pgf *Collection) DropTable(name doltdb.TableName) {
+	pgf.mutex.Lock()
+	defer pgf.mutex.Unlock()
+	delete(pgf.tables, name)
+}
+
+// RenameTable moves the foreign definition of the old table to the new table.
+func (pgf *Collection) RenameTable(oldName doltdb.TableName, newName doltdb.TableName) {
+	pgf.mutex.Lock()
+	defer pgf.mutex.Unlock()
+	if table, ok := pgf.tables[oldName]; ok {
+		delete(pgf.tables, oldName)
+		newTable := *table
+		newTable.Name = newName
+		pgf.tables[newName] = &newTable
+	}
+}
+
+// IsEmpty returns whether the collection contains any servers or tables.
+func (pgf *Collection) IsEmpty() bool {
+	pgf.mutex.Lock()
+	defer pgf.mutex.Unlock()
+	return len(pgf.servers) == 0 && len(pgf.tables) == 0
+}
+
+// IterateServers iterates over every server, in order of their names.
+func (pgf *Collection) IterateServers(f func(server *Server) error) error {
+	pgf.mutex.Lock()
+	defer pgf.mutex.Unlock()
+
+	names := make([]string, 0, len(pgf.servers))
+	for name := range pgf.servers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := f(pgf.servers[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IterateTables iterates over every foreign table, in order of the schema and table names.
+func (pgf *Collection) IterateTables(f func(table *Table) error) error {
+	pgf.mutex.Lock()
+	defer pgf.mutex.Unlock()
+
+	names := make([]doltdb.TableName, 0, len(pgf.tables))
+	for name := range pgf.tables {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if names[i].Schema != names[j].Schema {
+			return names[i].Schema < names[j].Schema
+		}
+		return names[i].Name < names[j].Name
+	})
+	for _, name := range names {
+		if err := f(pgf.tables[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Clone returns a new *Collection with the same contents as the original.
+func (pgf *Collection) Clone() *Collection {
+	pgf.mutex.Lock()
+	defer pgf.mutex.Unlock()
+
+	newCollection := &Collection{
+		servers: make(map[string]*Server, len(pgf.servers)),
+		tables:  make(map[doltdb.TableName]*Table, len(pgf.tables)),
+		mutex:   &sync.Mutex{},
+	}
+	for name, server := range pgf.servers {
+		newCollection.servers[name] = server.clone()
+	}
+	for name, table := range pgf.tables {
+		newCollection.tables[name] = table.clone()
+	}
+	return newCollection
+}
+
+// clone returns a deep copy of the server.
+func (server *Server) clone() *Server {
+	return &Server{
+		Name:    server.Name,
+		Wrapper: server.Wrapper,
+		Options: maps.Clone(server.Options),
+	}
+}
+
+// equals returns whether both servers have the same definition. Either server may be nil.
+func (server *Server) equals(other *Server) bool {
+	if server == nil || other == nil {
+		return server == other
+	}
+	return server.Name == other.Name && server.Wrapper == other.Wrapper && maps.Equal(server.Options, other.Options)
+}
+
+// clone returns a deep copy of the table.
+func (table *Table) clone() *Table {
+	columnOptions := make(map[string]map[string]string, len(table.ColumnOptions))
+	for column, options := range table.ColumnOptions {
+		columnOptions[column] = maps.Clone(options)
+	}
+	return &Table{
+		Name:          table.Name,
+		Server:        table.Server,
+		Options:       maps.Clone(table.Options),
+		ColumnOptions: columnOptions,
+	}
+}
+
+// equals returns whether both tables have the same definition. Either table may be nil.
+func (table *Table) equals(other *Table) bool {
+	if table == nil || other == nil {
+		return table == other
+	}
+	return table.Name == other.Name && table.Server == other.Server && maps.Equal(table.Options, other.Options) &&
+		maps.EqualFunc(table.ColumnOptions, other.ColumnOptions, func(a, b map[string]string) bool {
+			return maps.Equal(a, b)
+		})
+}
@@ -0,0 +1,142 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package foreign
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// Serialize returns the Collection as a byte slice. If the Collection is nil, then this returns a nil slice.
+func (pgf *Collection) Serialize(ctx context.Context) ([]byte, error) {
+	if pgf == nil {
+		return nil, nil
+	}
+
+	// Write all of the servers and tables to the writer
+	writer := utils.NewWriter(256)
+	writer.VariableUint(0) // Version
+	var servers []*Server
+	_ = pgf.IterateServers(func(server *Server) error {
+		servers = append(servers, server)
+		return nil
+	})
+	writer.VariableUint(uint64(len(servers)))
+	for _, server := range servers {
+		writer.String(server.Name)
+		writer.String(server.Wrapper)
+		writeOptions(writer, server.Options)
+	}
+	var tables []*Table
+	_ = pgf.IterateTables(func(table *Table) error {
+		tables = append(tables, table)
+		return nil
+	})
+	writer.VariableUint(uint64(len(tables)))
+	for _, table := range tables {
+		writer.String(table.Name.Schema)
+		writer.String(table.Name.Name)
+		writer.String(table.Server)
+		writeOptions(writer, table.Options)
+		columns := utils.GetMapKeysSorted(table.ColumnOptions)
+		writer.VariableUint(uint64(len(columns)))
+		for _, column := range columns {
+			writer.String(column)
+			writeOptions(writer, table.ColumnOptions[column])
+		}
+	}
+
+	return writer.Data(), nil
+}
+
+// Deserialize returns the Collection that was serialized in the byte slice. Returns an empty Collection if data is nil
+// or empty.
+func Deserialize(ctx context.Context, data []byte) (*Collection, error) {
+	servers := make(map[string]*Server)
+	tables := make(map[doltdb.TableName]*Table)
+	if len(data) == 0 {
+		return &Collection{
+			servers: servers,
+			tables:  tables,
+			mutex:   &sync.Mutex{},
+		}, nil
+	}
+	reader := utils.NewReader(data)
+	version := reader.VariableUint()
+	if version != 0 {
+		return nil, fmt.Errorf("version %d of foreign data is not supported, please upgrade the server", version)
+	}
+
+	// Read from the reader
+	numOfServers := reader.VariableUint()
+	for i := uint64(0); i < numOfServers; i++ {
+		server := &Server{}
+		server.Name = reader.String()
+		server.Wrapper = reader.String()
+		server.Options = readOptions(reader)
+		servers[server.Name] = server
+	}
+	numOfTables := reader.VariableUint()
+	for i := uint64(0); i < numOfTables; i++ {
+		table := &Table{}
+		table.Name.Schema = reader.String()
+		table.Name.Name = reader.String()
+		table.Server = reader.String()
+		table.Options = readOptions(reader)
+		numOfColumns := reader.VariableUint()
+		table.ColumnOptions = make(map[string]map[string]string, numOfColumns)
+		for j := uint64(0); j < numOfColumns; j++ {
+			column := reader.String()
+			table.ColumnOptions[column] = readOptions(reader)
+		}
+		tables[table.Name] = table
+	}
+	if !reader.IsEmpty() {
+		return nil, fmt.Errorf("extra data found while deserializing foreign data")
+	}
+
+	// Return the deserialized object
+	return &Collection{
+		servers: servers,
+		tables:  tables,
+		mutex:   &sync.Mutex{},
+	}, nil
+}
+
+// writeOptions writes the given options to the writer, sorted by name.
+func writeOptions(writer *utils.Writer, options map[string]string) {
+	names := utils.GetMapKeysSorted(options)
+	writer.VariableUint(uint64(len(names)))
+	for _, name := range names {
+		writer.String(name)
+		writer.String(options[name])
+	}
+}
+
+// readOptions reads options that were written by writeOptions.
+func readOptions(reader *utils.Reader) map[string]string {
+	numOfOptions := reader.VariableUint()
+	options := make(map[string]string, numOfOptions)
+	for i := uint64(0); i < numOfOptions; i++ {
+		name := reader.String()
+		options[name] = reader.String()
+	}
+	return options
+}
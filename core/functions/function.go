@@ -0,0 +1,175 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+// Collection contains a collection of user-defined functions and procedures.
+type Collection struct {
+	schemaMap map[string]map[string]*Function
+	mutex     *sync.Mutex
+}
+
+// Kind distinguishes functions from procedures, as both are stored within the same collection (just like pg_proc).
+type Kind uint8
+
+const (
+	Kind_Function  Kind = 0
+	Kind_Procedure Kind = 1
+)
+
+// ParameterMode is the mode of a parameter, which determines whether it is an input, an output, or both.
+type ParameterMode uint8
+
+const (
+	ParameterMode_In       ParameterMode = 0
+	ParameterMode_Out      ParameterMode = 1
+	ParameterMode_InOut    ParameterMode = 2
+	ParameterMode_Variadic ParameterMode = 3
+)
+
+// Parameter is a single parameter of a Function.
+type Parameter struct {
+	Name string
+	Mode ParameterMode
+	// Type is the serialized form of the parameter's type.
+	Type []byte
+	// Default is the SQL text of the default expression, which is empty when there is no default.
+	Default string
+}
+
+// Function represents a single function or procedure within the pg_proc table.
+type Function struct {
+	Name       string
+	Kind       Kind
+	Parameters []Parameter
+	// ReturnType is the serialized form of the return type. This is empty for procedures, as well as for functions
+	// whose return type is determined by their output parameters.
+	ReturnType []byte
+	ReturnsSet bool
+	Language   string
+	// Definition is the body of the function, which is interpreted according to the language.
+	Definition string
+}
+
+// GetFunction returns the function with the given schema and name. Returns nil if the function cannot be found.
+func (pgf *Collection) GetFunction(name doltdb.TableName) *Function {
+	pgf.mutex.Lock()
+	defer pgf.mutex.Unlock()
+
+	if nameMap, ok := pgf.schemaMap[name.Schema]; ok {
+		if f, ok := nameMap[name.Name]; ok {
+			return f
+		}
+	}
+	return nil
+}
+
+// HasFunction returns whether the function is present.
+func (pgf *Collection) HasFunction(name doltdb.TableName) bool {
+	return pgf.GetFunction(name) != nil
+}
+
+// CreateFunction creates a new function.
+func (pgf *Collection) CreateFunction(schema string, f *Function) error {
+	pgf.mutex.Lock()
+	defer pgf.mutex.Unlock()
+
+	nameMap, ok := pgf.schemaMap[schema]
+	if !ok {
+		nameMap = make(map[string]*Function)
+		pgf.schemaMap[schema] = nameMap
+	}
+	if _, ok = nameMap[f.Name]; ok {
+		return fmt.Errorf(`function "%s" already exists with same argument types`, f.Name)
+	}
+	nameMap[f.Name] = f
+	return nil
+}
+
+// DropFunction drops an existing function.
+func (pgf *Collection) DropFunction(name doltdb.TableName) error {
+	pgf.mutex.Lock()
+	defer pgf.mutex.Unlock()
+
+	if nameMap, ok := pgf.schemaMap[name.Schema]; ok {
+		if _, ok = nameMap[name.Name]; ok {
+			delete(nameMap, name.Name)
+			return nil
+		}
+	}
+	return fmt.Errorf(`function %s does not exist`, name.Name)
+}
+
+// IterateFunctions iterates over all functions in the collection.
+func (pgf *Collection) IterateFunctions(f func(schema string, function *Function) error) error {
+	pgf.mutex.Lock()
+	defer pgf.mutex.Unlock()
+
+	for schema, nameMap := range pgf.schemaMap {
+		for _, function := range nameMap {
+			if err := f(schema, function); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Clone returns a new *Collection with the same contents as the original.
+func (pgf *Collection) Clone() *Collection {
+	pgf.mutex.Lock()
+	defer pgf.mutex.Unlock()
+
+	newCollection := &Collection{
+		schemaMap: make(map[string]map[string]*Function),
+		mutex:     &sync.Mutex{},
+	}
+	for schema, nameMap := range pgf.schemaMap {
+		if len(nameMap) == 0 {
+			continue
+		}
+		clonedNameMap := make(map[string]*Function)
+		for key, f := range nameMap {
+			clonedNameMap[key] = f.Clone()
+		}
+		newCollection.schemaMap[schema] = clonedNameMap
+	}
+	return newCollection
+}
+
+// Clone returns a deep copy of the function.
+func (f *Function) Clone() *Function {
+	newFunction := *f
+	newFunction.Parameters = make([]Parameter, len(f.Parameters))
+	copy(newFunction.Parameters, f.Parameters)
+	return &newFunction
+}
+
+// OutputParameters returns the indexes of all parameters that are OUT or INOUT parameters.
+func (f *Function) OutputParameters() []int {
+	var indexes []int
+	for i, param := range f.Parameters {
+		if param.Mode == ParameterMode_Out || param.Mode == ParameterMode_InOut {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
@@ -0,0 +1,66 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"context"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+// Merge handles merging functions on our root and their root.
+func Merge(ctx context.Context, ourCollection, theirCollection, ancCollection *Collection) (*Collection, error) {
+	mergedCollection := ourCollection.Clone()
+	err := theirCollection.IterateFunctions(func(schema string, theirFunc *Function) error {
+		name := doltdb.TableName{Name: theirFunc.Name, Schema: schema}
+		// If we don't have the function, then we add it unless it was deleted on our side
+		if !mergedCollection.HasFunction(name) {
+			if ancCollection.HasFunction(name) {
+				return nil
+			}
+			return mergedCollection.CreateFunction(schema, theirFunc.Clone())
+		}
+		// If the function only changed on their side, then we take their definition. When both sides changed the
+		// function, we keep our definition, as functions cannot be partially merged.
+		if ancFunc := ancCollection.GetFunction(name); ancFunc != nil {
+			ourFunc := mergedCollection.GetFunction(name)
+			if ourFunc.Definition == ancFunc.Definition && theirFunc.Definition != ancFunc.Definition {
+				if err := mergedCollection.DropFunction(name); err != nil {
+					return err
+				}
+				return mergedCollection.CreateFunction(schema, theirFunc.Clone())
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Functions that were deleted on their side are deleted from the merged collection, as long as we didn't change them
+	err = ourCollection.IterateFunctions(func(schema string, ourFunc *Function) error {
+		name := doltdb.TableName{Name: ourFunc.Name, Schema: schema}
+		if theirCollection.HasFunction(name) {
+			return nil
+		}
+		if ancFunc := ancCollection.GetFunction(name); ancFunc != nil && ancFunc.Definition == ourFunc.Definition {
+			return mergedCollection.DropFunction(name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mergedCollection, nil
+}
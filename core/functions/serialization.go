@@ -0,0 +1,115 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// Serialize returns the Collection as a byte slice. If the Collection is nil, then this returns a nil slice.
+func (pgf *Collection) Serialize(ctx context.Context) ([]byte, error) {
+	if pgf == nil {
+		return nil, nil
+	}
+	pgf.mutex.Lock()
+	defer pgf.mutex.Unlock()
+
+	// Write all of the functions to the writer
+	writer := utils.NewWriter(256)
+	writer.VariableUint(0) // Version
+	schemaMapKeys := utils.GetMapKeysSorted(pgf.schemaMap)
+	writer.VariableUint(uint64(len(schemaMapKeys)))
+	for _, schemaMapKey := range schemaMapKeys {
+		nameMap := pgf.schemaMap[schemaMapKey]
+		writer.String(schemaMapKey)
+		nameMapKeys := utils.GetMapKeysSorted(nameMap)
+		writer.VariableUint(uint64(len(nameMapKeys)))
+		for _, nameMapKey := range nameMapKeys {
+			function := nameMap[nameMapKey]
+			writer.String(function.Name)
+			writer.Uint8(uint8(function.Kind))
+			writer.VariableUint(uint64(len(function.Parameters)))
+			for _, param := range function.Parameters {
+				writer.String(param.Name)
+				writer.Uint8(uint8(param.Mode))
+				writer.ByteSlice(param.Type)
+				writer.String(param.Default)
+			}
+			writer.ByteSlice(function.ReturnType)
+			writer.Bool(function.ReturnsSet)
+			writer.String(function.Language)
+			writer.String(function.Definition)
+		}
+	}
+
+	return writer.Data(), nil
+}
+
+// Deserialize returns the Collection that was serialized in the byte slice. Returns an empty Collection if data is nil
+// or empty.
+func Deserialize(ctx context.Context, data []byte) (*Collection, error) {
+	if len(data) == 0 {
+		return &Collection{
+			schemaMap: make(map[string]map[string]*Function),
+			mutex:     &sync.Mutex{},
+		}, nil
+	}
+	schemaMap := make(map[string]map[string]*Function)
+	reader := utils.NewReader(data)
+	version := reader.VariableUint()
+	if version != 0 {
+		return nil, fmt.Errorf("version %d of functions is not supported, please upgrade the server", version)
+	}
+
+	// Read from the reader
+	numOfSchemas := reader.VariableUint()
+	for i := uint64(0); i < numOfSchemas; i++ {
+		schemaName := reader.String()
+		numOfFunctions := reader.VariableUint()
+		nameMap := make(map[string]*Function)
+		for j := uint64(0); j < numOfFunctions; j++ {
+			function := &Function{}
+			function.Name = reader.String()
+			function.Kind = Kind(reader.Uint8())
+			numOfParameters := reader.VariableUint()
+			function.Parameters = make([]Parameter, numOfParameters)
+			for k := uint64(0); k < numOfParameters; k++ {
+				function.Parameters[k].Name = reader.String()
+				function.Parameters[k].Mode = ParameterMode(reader.Uint8())
+				function.Parameters[k].Type = reader.ByteSlice()
+				function.Parameters[k].Default = reader.String()
+			}
+			function.ReturnType = reader.ByteSlice()
+			function.ReturnsSet = reader.Bool()
+			function.Language = reader.String()
+			function.Definition = reader.String()
+			nameMap[function.Name] = function
+		}
+		schemaMap[schemaName] = nameMap
+	}
+	if !reader.IsEmpty() {
+		return nil, fmt.Errorf("extra data found while deserializing functions")
+	}
+
+	// Return the deserialized object
+	return &Collection{
+		schemaMap: schemaMap,
+		mutex:     &sync.Mutex{},
+	}, nil
+}
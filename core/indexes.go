@@ -0,0 +1,216 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/resolve"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor/creation"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// CreateIndex builds the given index on the table, writing the updated table to the working root. If the table name
+// does not specify a schema, then the table is resolved using the search path. Dolt's own implementation does not take
+// schemas into account, which is why this exists.
+func CreateIndex(ctx *sql.Context, tableName doltdb.TableName, idx sql.IndexDef) error {
+	session, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	var table *doltdb.Table
+	var ok bool
+	if len(tableName.Schema) == 0 {
+		tableName, table, ok, err = resolve.Table(ctx, root, tableName.Name)
+	} else {
+		table, ok, err = root.GetTable(ctx, tableName)
+	}
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf(`relation "%s" does not exist`, tableName.Name)
+	}
+	state, ok, err := session.LookupDbState(ctx, ctx.GetCurrentDatabase())
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("cannot find the database while creating an index")
+	}
+	if len(idx.Name) == 0 {
+		idx.Name, err = generateIndexName(ctx, table, tableName.Name, idx.Columns)
+		if err != nil {
+			return err
+		}
+	}
+	columns := make([]string, len(idx.Columns))
+	var prefixLengths []uint16
+	for i, indexCol := range idx.Columns {
+		columns[i] = indexCol.Name
+		if indexCol.Length > 0 {
+			if prefixLengths == nil {
+				prefixLengths = make([]uint16, len(idx.Columns))
+			}
+			prefixLengths[i] = uint16(indexCol.Length)
+		}
+	}
+	ret, err := creation.CreateIndex(ctx, table, tableName.Name, idx.Name, columns, prefixLengths, schema.IndexProperties{
+		IsUnique:      idx.Constraint == sql.IndexConstraint_Unique,
+		IsUserDefined: true,
+		Comment:       idx.Comment,
+	}, state.EditOpts())
+	if err != nil {
+		return err
+	}
+	var newRoot doltdb.RootValue = root
+	// If an existing index was replaced, then any foreign keys using the old index need to reference the new one
+	if ret.OldIndex != nil && ret.OldIndex != ret.NewIndex {
+		fkCollection, err := root.GetForeignKeyCollection(ctx)
+		if err != nil {
+			return err
+		}
+		for _, fk := range fkCollection.AllKeys() {
+			newFk := fk
+			if fk.TableName == tableName.Name && fk.TableIndex == ret.OldIndex.Name() {
+				newFk.TableIndex = ret.NewIndex.Name()
+			}
+			if fk.ReferencedTableName == tableName.Name && fk.ReferencedTableIndex == ret.OldIndex.Name() {
+				newFk.ReferencedTableIndex = ret.NewIndex.Name()
+			}
+			fkCollection.RemoveKeys(fk)
+			if err = fkCollection.AddKeys(newFk); err != nil {
+				return err
+			}
+		}
+		newRoot, err = root.PutForeignKeyCollection(ctx, fkCollection)
+		if err != nil {
+			return err
+		}
+	}
+	newRoot, err = newRoot.PutTable(ctx, tableName, ret.NewTable)
+	if err != nil {
+		return err
+	}
+	return session.SetWorkingRoot(ctx, ctx.GetCurrentDatabase(), newRoot)
+}
+
+// generateIndexName returns an index name in the same format that Postgres uses when an index is not given a name.
+// A number is appended when the name is already used by another index on the table.
+func generateIndexName(ctx *sql.Context, table *doltdb.Table, tableName string, columns []sql.IndexColumn) (string, error) {
+	sch, err := table.GetSchema(ctx)
+	if err != nil {
+		return "", err
+	}
+	sb := strings.Builder{}
+	sb.WriteString(tableName)
+	for _, col := range columns {
+		sb.WriteRune('_')
+		sb.WriteString(col.Name)
+	}
+	sb.WriteString("_idx")
+	baseName := sb.String()
+	indexName := baseName
+	for i := 1; sch.Indexes().GetByName(indexName) != nil; i++ {
+		indexName = fmt.Sprintf("%s%d", baseName, i)
+	}
+	return indexName, nil
+}
+
+// GetIndexTable returns the name of the table in the given schema that has the index with the given name. Index names
+// are unique within a schema in Postgres, whereas Dolt only requires uniqueness within a table, so the first table found
+// with a matching index is returned. Returns false if no index was found.
+func GetIndexTable(ctx *sql.Context, schemaName string, indexName string) (string, bool, error) {
+	_, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return "", false, err
+	}
+	tableNames, err := root.GetTableNames(ctx, schemaName)
+	if err != nil {
+		return "", false, err
+	}
+	for _, name := range tableNames {
+		table, ok, err := root.GetTable(ctx, doltdb.TableName{Name: name, Schema: schemaName})
+		if err != nil {
+			return "", false, err
+		}
+		if !ok {
+			continue
+		}
+		sch, err := table.GetSchema(ctx)
+		if err != nil {
+			return "", false, err
+		}
+		if sch.Indexes().GetByName(indexName) != nil {
+			return name, true, nil
+		}
+	}
+	return "", false, nil
+}
+
+// DropIndex removes the index with the given name from the table in the given schema that owns it, as found by
+// GetIndexTable, writing the updated table to the working root. Returns false if no index was found.
+func DropIndex(ctx *sql.Context, schemaName string, indexName string) (bool, error) {
+	name, ok, err := GetIndexTable(ctx, schemaName, indexName)
+	if err != nil || !ok {
+		return false, err
+	}
+	session, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+	tableName := doltdb.TableName{Name: name, Schema: schemaName}
+	table, ok, err := root.GetTable(ctx, tableName)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		return false, nil
+	}
+	sch, err := table.GetSchema(ctx)
+	if err != nil {
+		return false, err
+	}
+	fkCollection, err := root.GetForeignKeyCollection(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, fk := range fkCollection.AllKeys() {
+		if (fk.TableName == name && fk.TableIndex == indexName) ||
+			(fk.ReferencedTableName == name && fk.ReferencedTableIndex == indexName) {
+			return false, fmt.Errorf(`cannot drop index %s because constraint %s on table %s requires it`,
+				indexName, fk.Name, fk.TableName)
+		}
+	}
+	if _, err = sch.Indexes().RemoveIndex(indexName); err != nil {
+		return false, err
+	}
+	table, err = table.UpdateSchema(ctx, sch)
+	if err != nil {
+		return false, err
+	}
+	table, err = table.DeleteIndexRowData(ctx, indexName)
+	if err != nil {
+		return false, err
+	}
+	newRoot, err := root.PutTable(ctx, tableName, table)
+	if err != nil {
+		return false, err
+	}
+	return true, session.SetWorkingRoot(ctx, ctx.GetCurrentDatabase(), newRoot)
+}
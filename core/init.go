@@ -21,6 +21,8 @@ import (
 
 // Init initializes this package.
 func Init() {
+	// Dolt refers to tables without a schema in many places (such as merges), and those tables live in the public schema
+	doltdb.DefaultSchemaName = "public"
 	doltdb.EmptyRootValue = emptyRootValue
 	doltdb.NewRootValue = newRootValue
 	types.DoltgresRootValueHumanReadableStringAtIndentationLevel = rootValueHumanReadableStringAtIndentationLevel
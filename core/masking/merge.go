@@ -0,0 +1,64 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package masking
+
+import (
+	"context"
+)
+
+// Merge handles merging masking policies on our root and their root.
+func Merge(ctx context.Context, ourCollection, theirCollection, ancCollection *Collection) (*Collection, error) {
+	mergedCollection := ourCollection.Clone()
+	err := theirCollection.IteratePolicies(func(theirPolicy *Policy) error {
+		// If we don't have the policy, then we add it unless it was deleted on our side
+		if !mergedCollection.HasPolicy(theirPolicy.Name) {
+			if ancCollection.HasPolicy(theirPolicy.Name) {
+				return nil
+			}
+			clonedPolicy := *theirPolicy
+			return mergedCollection.CreatePolicy(&clonedPolicy)
+		}
+		// If the policy only changed on their side, then we take their version. When both sides changed the policy, we
+		// keep our version.
+		if ancPolicy := ancCollection.GetPolicy(theirPolicy.Name); ancPolicy != nil {
+			ourPolicy := mergedCollection.GetPolicy(theirPolicy.Name)
+			if *ourPolicy == *ancPolicy && *theirPolicy != *ancPolicy {
+				if err := mergedCollection.DropPolicy(theirPolicy.Name); err != nil {
+					return err
+				}
+				clonedPolicy := *theirPolicy
+				return mergedCollection.CreatePolicy(&clonedPolicy)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Policies that were deleted on their side are deleted from the merged collection, as long as we didn't change them
+	err = ourCollection.IteratePolicies(func(ourPolicy *Policy) error {
+		if theirCollection.HasPolicy(ourPolicy.Name) {
+			return nil
+		}
+		if ancPolicy := ancCollection.GetPolicy(ourPolicy.Name); ancPolicy != nil && *ancPolicy == *ourPolicy {
+			return mergedCollection.DropPolicy(ourPolicy.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mergedCollection, nil
+}
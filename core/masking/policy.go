@@ -0,0 +1,148 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package masking
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// PublicRole is the role that applies a policy to every role.
+const PublicRole = "public"
+
+// Collection contains a collection of masking policies.
+type Collection struct {
+	policies map[string]*Policy
+	mutex    *sync.Mutex
+}
+
+// Policy replaces the values of a column with the result of an expression whenever the column is read by a role.
+type Policy struct {
+	Name   string
+	Schema string
+	Table  string
+	Column string
+	// Role is the role that the policy applies to. The public role applies the policy to every role.
+	Role string
+	// Expression is the SQL text of the expression that produces the masked value. It is evaluated against the
+	// unmasked row, so it may reference any column of the table.
+	Expression string
+}
+
+// GetPolicy returns the policy with the given name. Returns nil if the policy cannot be found.
+func (pgm *Collection) GetPolicy(name string) *Policy {
+	pgm.mutex.Lock()
+	defer pgm.mutex.Unlock()
+	return pgm.policies[name]
+}
+
+// HasPolicy returns whether the policy is present.
+func (pgm *Collection) HasPolicy(name string) bool {
+	return pgm.GetPolicy(name) != nil
+}
+
+// CreatePolicy creates a new policy.
+func (pgm *Collection) CreatePolicy(p *Policy) error {
+	pgm.mutex.Lock()
+	defer pgm.mutex.Unlock()
+
+	if _, ok := pgm.policies[p.Name]; ok {
+		return fmt.Errorf(`masking policy "%s" already exists`, p.Name)
+	}
+	for _, existing := range pgm.policies {
+		if existing.Schema == p.Schema && existing.Table == p.Table && existing.Column == p.Column && existing.Role == p.Role {
+			return fmt.Errorf(`column "%s" of relation "%s" is already masked for role "%s" by policy "%s"`,
+				p.Column, p.Table, p.Role, existing.Name)
+		}
+	}
+	pgm.policies[p.Name] = p
+	return nil
+}
+
+// DropPolicy drops an existing policy.
+func (pgm *Collection) DropPolicy(name string) error {
+	pgm.mutex.Lock()
+	defer pgm.mutex.Unlock()
+
+	if _, ok := pgm.policies[name]; !ok {
+		return fmt.Errorf(`masking policy "%s" does not exist`, name)
+	}
+	delete(pgm.policies, name)
+	return nil
+}
+
+// PoliciesForTable returns the policies on the given table that apply to the given role, sorted by name. A role may
+// match both a policy for itself and a policy for the public role on the same column, in which case only the policy
+// for the role itself is returned.
+func (pgm *Collection) PoliciesForTable(schema string, table string, role string) []*Policy {
+	pgm.mutex.Lock()
+	defer pgm.mutex.Unlock()
+
+	byColumn := make(map[string]*Policy)
+	for _, p := range pgm.policies {
+		if p.Schema != schema || p.Table != table || (p.Role != role && p.Role != PublicRole) {
+			continue
+		}
+		if existing, ok := byColumn[p.Column]; ok && existing.Role != PublicRole {
+			continue
+		}
+		byColumn[p.Column] = p
+	}
+	policies := make([]*Policy, 0, len(byColumn))
+	for _, p := range byColumn {
+		policies = append(policies, p)
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Name < policies[j].Name
+	})
+	return policies
+}
+
+// IsEmpty returns whether the collection contains any policies.
+func (pgm *Collection) IsEmpty() bool {
+	pgm.mutex.Lock()
+	defer pgm.mutex.Unlock()
+	return len(pgm.policies) == 0
+}
+
+// IteratePolicies iterates over all policies in the collection.
+func (pgm *Collection) IteratePolicies(f func(p *Policy) error) error {
+	pgm.mutex.Lock()
+	defer pgm.mutex.Unlock()
+
+	for _, p := range pgm.policies {
+		if err := f(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Clone returns a new *Collection with the same contents as the original.
+func (pgm *Collection) Clone() *Collection {
+	pgm.mutex.Lock()
+	defer pgm.mutex.Unlock()
+
+	newCollection := &Collection{
+		policies: make(map[string]*Policy, len(pgm.policies)),
+		mutex:    &sync.Mutex{},
+	}
+	for name, p := range pgm.policies {
+		clonedPolicy := *p
+		newCollection.policies[name] = &clonedPolicy
+	}
+	return newCollection
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package masking
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// Serialize returns the Collection as a byte slice. If the Collection is nil, then this returns a nil slice.
+func (pgm *Collection) Serialize(ctx context.Context) ([]byte, error) {
+	if pgm == nil {
+		return nil, nil
+	}
+	pgm.mutex.Lock()
+	defer pgm.mutex.Unlock()
+
+	// Write all of the policies to the writer
+	writer := utils.NewWriter(256)
+	writer.VariableUint(0) // Version
+	names := utils.GetMapKeysSorted(pgm.policies)
+	writer.VariableUint(uint64(len(names)))
+	for _, name := range names {
+		p := pgm.policies[name]
+		writer.String(p.Name)
+		writer.String(p.Schema)
+		writer.String(p.Table)
+		writer.String(p.Column)
+		writer.String(p.Role)
+		writer.String(p.Expression)
+	}
+
+	return writer.Data(), nil
+}
+
+// Deserialize returns the Collection that was serialized in the byte slice. Returns an empty Collection if data is nil
+// or empty.
+func Deserialize(ctx context.Context, data []byte) (*Collection, error) {
+	if len(data) == 0 {
+		return &Collection{
+			policies: make(map[string]*Policy),
+			mutex:    &sync.Mutex{},
+		}, nil
+	}
+	policies := make(map[string]*Policy)
+	reader := utils.NewReader(data)
+	version := reader.VariableUint()
+	if version != 0 {
+		return nil, fmt.Errorf("version %d of masking policies is not supported, please upgrade the server", version)
+	}
+
+	// Read from the reader
+	numOfPolicies := reader.VariableUint()
+	for i := uint64(0); i < numOfPolicies; i++ {
+		p := &Policy{}
+		p.Name = reader.String()
+		p.Schema = reader.String()
+		p.Table = reader.String()
+		p.Column = reader.String()
+		p.Role = reader.String()
+		p.Expression = reader.String()
+		policies[p.Name] = p
+	}
+	if !reader.IsEmpty() {
+		return nil, fmt.Errorf("extra data found while deserializing masking policies")
+	}
+
+	// Return the deserialized object
+	return &Collection{
+		policies: policies,
+		mutex:    &sync.Mutex{},
+	}, nil
+}
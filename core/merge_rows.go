@@ -0,0 +1,282 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/merge"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/dolt/go/store/pool"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+// MergeConflicts holds the keys of the rows of each table that conflict within a merge.
+type MergeConflicts map[doltdb.TableName][]val.Tuple
+
+// divergentRow is a row that was changed differently on both sides of a merge. The values are nil for the sides that
+// do not have the row.
+type divergentRow struct {
+	key    val.Tuple
+	base   val.Tuple
+	ours   val.Tuple
+	theirs val.Tuple
+}
+
+// ResolveDivergentRows prepares the roots of a three-way merge for Dolt's merge. Dolt merges a row that was changed on
+// both sides by comparing the values of its cells using the default tuple comparator, which does not handle the
+// extended encoding that every Doltgres type is stored with. The cells of such rows are merged here by comparing their
+// stored bytes instead. The ancestor is then given our version of each of these rows, and their side is given the
+// merged row (or our row when the row conflicts), so that Dolt applies each merged row as a change from their side.
+// Returns the rewritten roots of their side and of the ancestor, along with the keys of the conflicting rows, which
+// must be given to AddMergeConflicts once Dolt has merged the roots. Only tables whose schema is unchanged on all sides
+// are considered, as Dolt reports the rows of the remaining tables as schema conflicts.
+func ResolveDivergentRows(ctx context.Context, ourRoot, theirRoot, ancRoot doltdb.RootValue) (doltdb.RootValue, doltdb.RootValue, MergeConflicts, error) {
+	divergentTables, err := findDivergentRows(ctx, ourRoot, theirRoot, ancRoot)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	conflicts := make(MergeConflicts)
+	for tableName, rows := range divergentTables {
+		var tableConflicts []val.Tuple
+		theirRoot, ancRoot, tableConflicts, err = resolveDivergentRows(ctx, theirRoot, ancRoot, tableName, rows)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if len(tableConflicts) > 0 {
+			conflicts[tableName] = tableConflicts
+		}
+	}
+	return theirRoot, ancRoot, conflicts, nil
+}
+
+// AddMergeConflicts records the given conflicting rows within the merged root, in the same way that Dolt records the
+// conflicts that it finds, so that they may be read and resolved through the conflicts tables.
+func AddMergeConflicts(ctx context.Context, result *merge.Result, theirHash, ancHash hash.Hash, conflicts MergeConflicts) error {
+	meta, err := json.Marshal(prolly.ConflictMetadata{BaseRootIsh: ancHash})
+	if err != nil {
+		return err
+	}
+	for tableName, keys := range conflicts {
+		table, ok, err := result.Root.GetTable(ctx, tableName)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("table %s was not found in the merged root", tableName.Name)
+		}
+		artifacts, err := table.GetArtifacts(ctx)
+		if err != nil {
+			return err
+		}
+		editor := durable.ProllyMapFromArtifactIndex(artifacts).Editor()
+		for _, key := range keys {
+			if err = editor.Add(ctx, key, theirHash, prolly.ArtifactTypeConflict, meta); err != nil {
+				return err
+			}
+		}
+		artifactMap, err := editor.Flush(ctx)
+		if err != nil {
+			return err
+		}
+		if table, err = table.SetArtifacts(ctx, durable.ArtifactIndexFromProllyMap(artifactMap)); err != nil {
+			return err
+		}
+		if result.Root, err = result.Root.PutTable(ctx, tableName, table); err != nil {
+			return err
+		}
+		stats, ok := result.Stats[tableName.Name]
+		if !ok {
+			stats = &merge.MergeStats{Operation: merge.TableModified}
+			result.Stats[tableName.Name] = stats
+		}
+		stats.DataConflicts += len(keys)
+	}
+	return nil
+}
+
+// findDivergentRows returns the rows of each table that were changed differently on both sides of a merge.
+func findDivergentRows(ctx context.Context, ourRoot, theirRoot, ancRoot doltdb.RootValue) (map[doltdb.TableName][]divergentRow, error) {
+	divergentTables := make(map[doltdb.TableName][]divergentRow)
+	err := ourRoot.IterTables(ctx, func(tableName doltdb.TableName, ourTable *doltdb.Table, ourSch schema.Schema) (bool, error) {
+		if schema.IsKeyless(ourSch) {
+			return false, nil
+		}
+		theirRows, ok, err := tableRowsWithSchema(ctx, theirRoot, tableName, ourSch)
+		if err != nil || !ok {
+			return false, err
+		}
+		ancRows, ok, err := tableRowsWithSchema(ctx, ancRoot, tableName, ourSch)
+		if err != nil || !ok {
+			return false, err
+		}
+		ourIdx, err := ourTable.GetRowData(ctx)
+		if err != nil {
+			return false, err
+		}
+		ourRows := durable.ProllyMapFromIndex(ourIdx)
+		ourChanges := make(map[string]tree.Diff)
+		err = tree.DiffOrderedTrees(ctx, ancRows.Tuples(), ourRows.Tuples(), false, func(ctx context.Context, diff tree.Diff) error {
+			ourChanges[string(diff.Key)] = diff
+			return nil
+		})
+		if err != nil && err != io.EOF {
+			return false, err
+		}
+		if len(ourChanges) == 0 {
+			return false, nil
+		}
+		var rows []divergentRow
+		err = tree.DiffOrderedTrees(ctx, ancRows.Tuples(), theirRows.Tuples(), false, func(ctx context.Context, theirDiff tree.Diff) error {
+			ourDiff, ok := ourChanges[string(theirDiff.Key)]
+			if !ok || bytes.Equal(ourDiff.To, theirDiff.To) {
+				return nil
+			}
+			rows = append(rows, divergentRow{
+				key:    val.Tuple(theirDiff.Key),
+				base:   val.Tuple(theirDiff.From),
+				ours:   val.Tuple(ourDiff.To),
+				theirs: val.Tuple(theirDiff.To),
+			})
+			return nil
+		})
+		if err != nil && err != io.EOF {
+			return false, err
+		}
+		if len(rows) > 0 {
+			divergentTables[tableName] = rows
+		}
+		return false, nil
+	})
+	return divergentTables, err
+}
+
+// tableRowsWithSchema returns the rows of the given table from the root. Returns false if the table does not exist, or
+// if its schema does not match the given schema.
+func tableRowsWithSchema(ctx context.Context, root doltdb.RootValue, tableName doltdb.TableName, sch schema.Schema) (prolly.Map, bool, error) {
+	table, ok, err := root.GetTable(ctx, tableName)
+	if err != nil || !ok {
+		return prolly.Map{}, false, err
+	}
+	tableSch, err := table.GetSchema(ctx)
+	if err != nil {
+		return prolly.Map{}, false, err
+	}
+	if !schema.SchemasAreEqual(sch, tableSch) {
+		return prolly.Map{}, false, nil
+	}
+	idx, err := table.GetRowData(ctx)
+	if err != nil {
+		return prolly.Map{}, false, err
+	}
+	return durable.ProllyMapFromIndex(idx), true, nil
+}
+
+// resolveDivergentRows rewrites the divergent rows of the given table in the roots of their side and of the ancestor.
+// Returns the keys of the rows that conflict.
+func resolveDivergentRows(ctx context.Context, theirRoot, ancRoot doltdb.RootValue, tableName doltdb.TableName, rows []divergentRow) (doltdb.RootValue, doltdb.RootValue, []val.Tuple, error) {
+	theirTable, _, err := theirRoot.GetTable(ctx, tableName)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	ancTable, _, err := ancRoot.GetTable(ctx, tableName)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	theirIdx, err := theirTable.GetRowData(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	ancIdx, err := ancTable.GetRowData(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	theirRows := durable.ProllyMapFromIndex(theirIdx)
+	theirEditor := theirRows.Mutate()
+	ancEditor := durable.ProllyMapFromIndex(ancIdx).Mutate()
+	var conflicts []val.Tuple
+	for _, row := range rows {
+		merged, ok := mergeDivergentRow(theirRows.Pool(), row)
+		if !ok {
+			conflicts = append(conflicts, row.key)
+			merged = row.ours
+		}
+		if err = putOrDeleteRow(ctx, theirEditor, row.key, merged); err != nil {
+			return nil, nil, nil, err
+		}
+		if err = putOrDeleteRow(ctx, ancEditor, row.key, row.ours); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	if theirRoot, err = putTableRows(ctx, theirRoot, tableName, theirTable, theirEditor); err != nil {
+		return nil, nil, nil, err
+	}
+	if ancRoot, err = putTableRows(ctx, ancRoot, tableName, ancTable, ancEditor); err != nil {
+		return nil, nil, nil, err
+	}
+	return theirRoot, ancRoot, conflicts, nil
+}
+
+// mergeDivergentRow merges the cells of the given row, comparing the stored bytes of each cell. Returns false if the
+// row conflicts, which is the case when a row was deleted on one side and changed on the other, or when both sides
+// changed the same cell differently. Rows that were added on both sides conflict unless they are identical.
+func mergeDivergentRow(buffPool pool.BuffPool, row divergentRow) (val.Tuple, bool) {
+	if row.ours == nil || row.theirs == nil || row.base == nil {
+		return nil, false
+	}
+	fields := make([][]byte, row.base.Count())
+	for i := range fields {
+		base, ours, theirs := row.base.GetField(i), row.ours.GetField(i), row.theirs.GetField(i)
+		switch {
+		case bytes.Equal(ours, theirs), bytes.Equal(theirs, base):
+			fields[i] = ours
+		case bytes.Equal(ours, base):
+			fields[i] = theirs
+		default:
+			return nil, false
+		}
+	}
+	return val.NewTuple(buffPool, fields...), true
+}
+
+// putOrDeleteRow writes the given row to the editor, deleting the row with the given key if the row is nil.
+func putOrDeleteRow(ctx context.Context, editor *prolly.MutableMap, key val.Tuple, row val.Tuple) error {
+	if row == nil {
+		return editor.Delete(ctx, key)
+	}
+	return editor.Put(ctx, key, row)
+}
+
+// putTableRows writes the rows of the editor to the given table within the root.
+func putTableRows(ctx context.Context, root doltdb.RootValue, tableName doltdb.TableName, table *doltdb.Table, editor *prolly.MutableMap) (doltdb.RootValue, error) {
+	rows, err := editor.Map(ctx)
+	if err != nil {
+		return nil, err
+	}
+	table, err = table.UpdateRows(ctx, durable.IndexFromProllyMap(rows))
+	if err != nil {
+		return nil, err
+	}
+	return root.PutTable(ctx, tableName, table)
+}
@@ -91,6 +91,14 @@ func newRootValue(ctx context.Context, vrw types.ValueReadWriter, ns tree.NodeSt
 			RepoVer:   ver,
 		}
 	}
+	if ver < DoltgresFeatureVersion {
+		if storage, err = storage.migrateUnqualifiedTableNames(ctx, vrw, ns); err != nil {
+			return nil, err
+		}
+		if storage, err = storage.SetFeatureVersion(DoltgresFeatureVersion); err != nil {
+			return nil, err
+		}
+	}
 
 	return &RootValue{vrw, ns, storage, nil, hash.Hash{}}, nil
 }
@@ -120,10 +128,18 @@ func rootValueWalkAddrs(sm types.SerialMessage, cb func(addr hash.Hash) error) e
 	if err != nil {
 		return err
 	}
-	addr := hash.New(msg.ForeignKeyAddrBytes())
-	if !addr.IsEmpty() {
-		if err = cb(addr); err != nil {
-			return err
+	for _, addrBytes := range [][]byte{msg.ForeignKeyAddrBytes(), msg.SequencesBytes(), msg.FunctionsBytes(),
+		msg.MaskingPoliciesBytes(), msg.StorageParametersBytes(), msg.ForeignDataBytes(), msg.TriggersBytes(),
+		msg.PartitionsBytes(), msg.ExclusionConstraintsBytes(), msg.UnvalidatedConstraintsBytes(),
+		msg.PublicationsBytes()} {
+		if len(addrBytes) == 0 {
+			continue
+		}
+		addr := hash.New(addrBytes)
+		if !addr.IsEmpty() {
+			if err = cb(addr); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
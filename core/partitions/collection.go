@@ -0,0 +1,394 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package partitions
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+// Strategy is how the rows of a partitioned table are divided between its partitions.
+type Strategy uint8
+
+const (
+	Strategy_Range Strategy = 0
+	Strategy_List  Strategy = 1
+	Strategy_Hash  Strategy = 2
+)
+
+// StrategyTableOption is the name of the table option that carries the partitioning strategy through CREATE TABLE.
+const StrategyTableOption = "partition_strategy"
+
+// ColumnTableOptionPrefix is prepended to the position of each partition key column when the column is carried through
+// CREATE TABLE as a table option.
+const ColumnTableOptionPrefix = "partition_column."
+
+// ValueKind is the kind of a value within a partition bound.
+type ValueKind uint8
+
+const (
+	ValueKind_Literal  ValueKind = 0
+	ValueKind_Null     ValueKind = 1
+	ValueKind_MinValue ValueKind = 2
+	ValueKind_MaxValue ValueKind = 3
+)
+
+// Table is a partitioned table. Its rows are stored within its partitions, so the table itself never holds any rows.
+type Table struct {
+	Name     doltdb.TableName
+	Strategy Strategy
+	// Columns are the columns of the partition key, in order.
+	Columns []string
+}
+
+// Value is a value within a partition bound. Literals are stored using the output format of their column's type.
+type Value struct {
+	Kind    ValueKind
+	Literal string
+}
+
+// Partition is a table that holds the rows of its partitioned table that fall within the partition's bound.
+type Partition struct {
+	Name   doltdb.TableName
+	Parent doltdb.TableName
+	// IsDefault is true for the partition that holds the rows that do not fall within any other partition's bound.
+	IsDefault bool
+	// From and To are the inclusive lower bound and exclusive upper bound of a range partition.
+	From []Value
+	To   []Value
+	// Values are the values of a list partition.
+	Values []Value
+	// Modulus and Remainder select the rows of a hash partition, whose hashed partition key divided by the modulus
+	// leaves the remainder.
+	Modulus   uint64
+	Remainder uint64
+}
+
+// Collection contains every partitioned table and every partition.
+type Collection struct {
+	tables     map[doltdb.TableName]*Table
+	partitions map[doltdb.TableName]*Partition
+	mutex      *sync.Mutex
+}
+
+// GetTable returns the partitioned table with the given name. Returns nil if the table is not partitioned.
+func (pgp *Collection) GetTable(name doltdb.TableName) *Table {
+	pgp.mutex.Lock()
+	defer pgp.mutex.Unlock()
+	return pgp.tables[name]
+}
+
+// AddTable adds the given partitioned table, returning an error if the table is already partitioned.
+func (pgp *Collection) AddTable(table *Table) error {
+	pgp.mutex.Lock()
+	defer pgp.mutex.Unlock()
+	if _, ok := pgp.tables[table.Name]; ok {
+		return fmt.Errorf(`table "%s" is already partitioned`, table.Name.Name)
+	}
+	pgp.tables[table.Name] = table
+	return nil
+}
+
+// GetPartition returns the partition with the given name. Returns nil if the table is not a partition.
+func (pgp *Collection) GetPartition(name doltdb.TableName) *Partition {
+	pgp.mutex.Lock()
+	defer pgp.mutex.Unlock()
+	return pgp.partitions[name]
+}
+
+// GetPartitions returns the partitions of the given partitioned table, in order of their names.
+func (pgp *Collection) GetPartitions(parent doltdb.TableName) []*Partition {
+	pgp.mutex.Lock()
+	defer pgp.mutex.Unlock()
+	var partitions []*Partition
+	for _, partition := range pgp.partitions {
+		if partition.Parent == parent {
+			partitions = append(partitions, partition)
+		}
+	}
+	sort.Slice(partitions, func(i, j int) bool {
+		return partitions[i].Name.Name < partitions[j].Name.Name
+	})
+	return partitions
+}
+
+// AddPartition adds the given partition, returning an error if the table is already a partition. The partition's
+// parent must already be a partitioned table.
+func (pgp *Collection) AddPartition(partition *Partition) error {
+	pgp.mutex.Lock()
+	defer pgp.mutex.Unlock()
+	if _, ok := pgp.tables[partition.Parent]; !ok {
+		return fmt.Errorf(`table "%s" is not partitioned`, partition.Parent.Name)
+	}
+	if _, ok := pgp.partitions[partition.Name]; ok {
+		return fmt.Errorf(`"%s" is already a partition`, partition.Name.Name)
+	}
+	pgp.partitions[partition.Name] = partition
+	return nil
+}
+
+// DetachPartition removes the given partition from its partitioned table, leaving it as a standalone table. Returns an
+// error if the table is not a partition of the given parent.
+func (pgp *Collection) DetachPartition(parent doltdb.TableName, name doltdb.TableName) error {
+	pgp.mutex.Lock()
+	defer pgp.mutex.Unlock()
+	if partition, ok := pgp.partitions[name]; !ok || partition.Parent != parent {
+		return fmt.Errorf(`relation "%s" is not a partition of relation "%s"`, name.Name, parent.Name)
+	}
+	delete(pgp.partitions, name)
+	return nil
+}
+
+// Descendants returns the partitions of the given table, along with the partitions of any partitions that are
+// partitioned themselves.
+func (pgp *Collection) Descendants(name doltdb.TableName) []doltdb.TableName {
+	var descendants []doltdb.TableName
+	for _, partition := range pgp.GetPartitions(name) {
+		descendants = append(descendants, partition.Name)
+		descendants = append(descendants, pgp.Descendants(partition.Name)...)
+	}
+	return descendants
+}
+
+// DropTable removes the given table, whether it is partitioned, a partition, or both. Any partitions of the table are
+// also removed, as they're dropped alongside their partitioned table.
+func (pgp *Collection) DropTable(name doltdb.TableName) {
+	pgp.mutex.Lock()
+	defer pgp.mutex.Unlock()
+	delete(pgp.tables, name)
+	delete(pgp.partitions, name)
+	for partitionName, partition := range pgp.partitions {
+		if partition.Parent == name {
+			delete(pgp.partitions, partitionName)
+		}
+	}
+}
+
+// RenameTable updates every reference to the old table so that it refers to the new table.
+func (pgp *Collection) RenameTable(oldName doltdb.TableName, newName doltdb.TableName) {
+	pgp.mutex.Lock()
+	defer pgp.mutex.Unlock()
+	if table, ok := pgp.tables[oldName]; ok {
+		delete(pgp.tables, oldName)
+		newTable := table.clone()
+		newTable.Name = newName
+		pgp.tables[newName] = newTable
+	}
+	if partition, ok := pgp.partitions[oldName]; ok {
+		delete(pgp.partitions, oldName)
+		newPartition := partition.clone()
+		newPartition.Name = newName
+		pgp.partitions[newName] = newPartition
+	}
+	for name, partition := range pgp.partitions {
+		if partition.Parent == oldName {
+			newPartition := partition.clone()
+			newPartition.Parent = newName
+			pgp.partitions[name] = newPartition
+		}
+	}
+}
+
+// IsEmpty returns whether the collection contains any partitioned tables.
+func (pgp *Collection) IsEmpty() bool {
+	pgp.mutex.Lock()
+	defer pgp.mutex.Unlock()
+	return len(pgp.tables) == 0 && len(pgp.partitions) == 0
+}
+
+// IterateTables iterates over every partitioned table, in order of the schema and table names.
+func (pgp *Collection) IterateTables(f func(table *Table) error) error {
+	pgp.mutex.Lock()
+	defer pgp.mutex.Unlock()
+	for _, name := range sortedNames(pgp.tables) {
+		if err := f(pgp.tables[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IteratePartitions iterates over every partition, in order of the schema and table names.
+func (pgp *Collection) IteratePartitions(f func(partition *Partition) error) error {
+	pgp.mutex.Lock()
+	defer pgp.mutex.Unlock()
+	for _, name := range sortedNames(pgp.partitions) {
+		if err := f(pgp.partitions[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Clone returns a new *Collection with the same contents as the original.
+func (pgp *Collection) Clone() *Collection {
+	pgp.mutex.Lock()
+	defer pgp.mutex.Unlock()
+
+	newCollection := &Collection{
+		tables:     make(map[doltdb.TableName]*Table, len(pgp.tables)),
+		partitions: make(map[doltdb.TableName]*Partition, len(pgp.partitions)),
+		mutex:      &sync.Mutex{},
+	}
+	for name, table := range pgp.tables {
+		newCollection.tables[name] = table.clone()
+	}
+	for name, partition := range pgp.partitions {
+		newCollection.partitions[name] = partition.clone()
+	}
+	return newCollection
+}
+
+// String returns the strategy as it is written within PARTITION BY.
+func (strategy Strategy) String() string {
+	switch strategy {
+	case Strategy_Range:
+		return "RANGE"
+	case Strategy_List:
+		return "LIST"
+	case Strategy_Hash:
+		return "HASH"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// StrategyFromString returns the strategy that is written as the given string within PARTITION BY.
+func StrategyFromString(str string) (Strategy, error) {
+	switch strings.ToUpper(str) {
+	case "RANGE":
+		return Strategy_Range, nil
+	case "LIST":
+		return Strategy_List, nil
+	case "HASH":
+		return Strategy_Hash, nil
+	default:
+		return 0, fmt.Errorf(`unrecognized partitioning strategy "%s"`, str)
+	}
+}
+
+// TableFromOptions returns the partitioned table that is described by the given table options. Returns nil if the
+// options do not partition the table.
+func TableFromOptions(name doltdb.TableName, options map[string]any) (*Table, error) {
+	strategyOption, ok := options[StrategyTableOption]
+	if !ok {
+		return nil, nil
+	}
+	strategy, err := StrategyFromString(fmt.Sprint(strategyOption))
+	if err != nil {
+		return nil, err
+	}
+	table := &Table{Name: name, Strategy: strategy}
+	for i := 0; ; i++ {
+		column, ok := options[fmt.Sprintf("%s%d", ColumnTableOptionPrefix, i)]
+		if !ok {
+			break
+		}
+		table.Columns = append(table.Columns, fmt.Sprint(column))
+	}
+	return table, nil
+}
+
+// String returns the value as it is written within a partition bound.
+func (value Value) String() string {
+	switch value.Kind {
+	case ValueKind_Null:
+		return "NULL"
+	case ValueKind_MinValue:
+		return "MINVALUE"
+	case ValueKind_MaxValue:
+		return "MAXVALUE"
+	default:
+		return "'" + strings.ReplaceAll(value.Literal, "'", "''") + "'"
+	}
+}
+
+// BoundString returns the partition's bound as it is written within CREATE TABLE ... PARTITION OF.
+func (partition *Partition) BoundString() string {
+	switch {
+	case partition.IsDefault:
+		return "DEFAULT"
+	case partition.Modulus > 0:
+		return fmt.Sprintf("FOR VALUES WITH (modulus %d, remainder %d)", partition.Modulus, partition.Remainder)
+	case len(partition.From) > 0:
+		return fmt.Sprintf("FOR VALUES FROM (%s) TO (%s)", joinValues(partition.From), joinValues(partition.To))
+	default:
+		return fmt.Sprintf("FOR VALUES IN (%s)", joinValues(partition.Values))
+	}
+}
+
+// joinValues returns the given values separated by commas.
+func joinValues(values []Value) string {
+	strs := make([]string, len(values))
+	for i, value := range values {
+		strs[i] = value.String()
+	}
+	return strings.Join(strs, ", ")
+}
+
+// sortedNames returns the keys of the given map, in order of the schema and table names.
+func sortedNames[T any](m map[doltdb.TableName]T) []doltdb.TableName {
+	names := make([]doltdb.TableName, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if names[i].Schema != names[j].Schema {
+			return names[i].Schema < names[j].Schema
+		}
+		return names[i].Name < names[j].Name
+	})
+	return names
+}
+
+// clone returns a deep copy of the table.
+func (table *Table) clone() *Table {
+	newTable := *table
+	newTable.Columns = slices.Clone(table.Columns)
+	return &newTable
+}
+
+// equals returns whether both tables have the same definition. Either table may be nil.
+func (table *Table) equals(other *Table) bool {
+	if table == nil || other == nil {
+		return table == other
+	}
+	return table.Name == other.Name && table.Strategy == other.Strategy && slices.Equal(table.Columns, other.Columns)
+}
+
+// clone returns a deep copy of the partition.
+func (partition *Partition) clone() *Partition {
+	newPartition := *partition
+	newPartition.From = slices.Clone(partition.From)
+	newPartition.To = slices.Clone(partition.To)
+	newPartition.Values = slices.Clone(partition.Values)
+	return &newPartition
+}
+
+// equals returns whether both partitions have the same definition. Either partition may be nil.
+func (partition *Partition) equals(other *Partition) bool {
+	if partition == nil || other == nil {
+		return partition == other
+	}
+	return partition.Name == other.Name && partition.Parent == other.Parent && partition.IsDefault == other.IsDefault &&
+		slices.Equal(partition.From, other.From) && slices.Equal(partition.To, other.To) &&
+		slices.Equal(partition.Values, other.Values) && partition.Modulus == other.Modulus &&
+		partition.Remainder == other.Remainder
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package partitions
+
+import (
+	"context"
+)
+
+// Merge handles merging partitioned tables and partitions on our root and their root. Definitions are each merged as a
+// whole: when only their side changed a definition, their definition is taken, and otherwise ours is kept.
+func Merge(ctx context.Context, ourCollection, theirCollection, ancCollection *Collection) (*Collection, error) {
+	mergedCollection := ourCollection.Clone()
+	err := theirCollection.IterateTables(func(theirTable *Table) error {
+		ourTable := mergedCollection.tables[theirTable.Name]
+		ancTable := ancCollection.GetTable(theirTable.Name)
+		if ourTable.equals(ancTable) && !theirTable.equals(ancTable) {
+			mergedCollection.tables[theirTable.Name] = theirTable.clone()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = theirCollection.IteratePartitions(func(theirPartition *Partition) error {
+		ourPartition := mergedCollection.partitions[theirPartition.Name]
+		ancPartition := ancCollection.GetPartition(theirPartition.Name)
+		if ourPartition.equals(ancPartition) && !theirPartition.equals(ancPartition) {
+			mergedCollection.partitions[theirPartition.Name] = theirPartition.clone()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Definitions that were dropped on their side are removed from the merged collection, as long as we didn't change them
+	err = ourCollection.IterateTables(func(ourTable *Table) error {
+		if theirCollection.GetTable(ourTable.Name) != nil {
+			return nil
+		}
+		if ourTable.equals(ancCollection.GetTable(ourTable.Name)) {
+			delete(mergedCollection.tables, ourTable.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = ourCollection.IteratePartitions(func(ourPartition *Partition) error {
+		if theirCollection.GetPartition(ourPartition.Name) != nil {
+			return nil
+		}
+		if ourPartition.equals(ancCollection.GetPartition(ourPartition.Name)) {
+			delete(mergedCollection.partitions, ourPartition.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mergedCollection, nil
+}
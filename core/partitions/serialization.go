@@ -0,0 +1,141 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package partitions
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// Serialize returns the Collection as a byte slice. If the Collection is nil, then this returns a nil slice.
+func (pgp *Collection) Serialize(ctx context.Context) ([]byte, error) {
+	if pgp == nil {
+		return nil, nil
+	}
+
+	// Write all of the partitioned tables and partitions to the writer
+	writer := utils.NewWriter(256)
+	writer.VariableUint(0) // Version
+	var tables []*Table
+	_ = pgp.IterateTables(func(table *Table) error {
+		tables = append(tables, table)
+		return nil
+	})
+	writer.VariableUint(uint64(len(tables)))
+	for _, table := range tables {
+		writer.String(table.Name.Schema)
+		writer.String(table.Name.Name)
+		writer.Uint8(uint8(table.Strategy))
+		writer.StringSlice(table.Columns)
+	}
+	var partitions []*Partition
+	_ = pgp.IteratePartitions(func(partition *Partition) error {
+		partitions = append(partitions, partition)
+		return nil
+	})
+	writer.VariableUint(uint64(len(partitions)))
+	for _, partition := range partitions {
+		writer.String(partition.Name.Schema)
+		writer.String(partition.Name.Name)
+		writer.String(partition.Parent.Schema)
+		writer.String(partition.Parent.Name)
+		writer.Bool(partition.IsDefault)
+		writeValues(writer, partition.From)
+		writeValues(writer, partition.To)
+		writeValues(writer, partition.Values)
+		writer.Uint64(partition.Modulus)
+		writer.Uint64(partition.Remainder)
+	}
+
+	return writer.Data(), nil
+}
+
+// Deserialize returns the Collection that was serialized in the byte slice. Returns an empty Collection if data is nil
+// or empty.
+func Deserialize(ctx context.Context, data []byte) (*Collection, error) {
+	collection := &Collection{
+		tables:     make(map[doltdb.TableName]*Table),
+		partitions: make(map[doltdb.TableName]*Partition),
+		mutex:      &sync.Mutex{},
+	}
+	if len(data) == 0 {
+		return collection, nil
+	}
+	reader := utils.NewReader(data)
+	version := reader.VariableUint()
+	if version != 0 {
+		return nil, fmt.Errorf("version %d of partitions is not supported, please upgrade the server", version)
+	}
+
+	// Read from the reader
+	numOfTables := reader.VariableUint()
+	for i := uint64(0); i < numOfTables; i++ {
+		table := &Table{}
+		table.Name.Schema = reader.String()
+		table.Name.Name = reader.String()
+		table.Strategy = Strategy(reader.Uint8())
+		table.Columns = reader.StringSlice()
+		collection.tables[table.Name] = table
+	}
+	numOfPartitions := reader.VariableUint()
+	for i := uint64(0); i < numOfPartitions; i++ {
+		partition := &Partition{}
+		partition.Name.Schema = reader.String()
+		partition.Name.Name = reader.String()
+		partition.Parent.Schema = reader.String()
+		partition.Parent.Name = reader.String()
+		partition.IsDefault = reader.Bool()
+		partition.From = readValues(reader)
+		partition.To = readValues(reader)
+		partition.Values = readValues(reader)
+		partition.Modulus = reader.Uint64()
+		partition.Remainder = reader.Uint64()
+		collection.partitions[partition.Name] = partition
+	}
+	if !reader.IsEmpty() {
+		return nil, fmt.Errorf("extra data found while deserializing partitions")
+	}
+
+	// Return the deserialized object
+	return collection, nil
+}
+
+// writeValues writes the given bound values to the writer.
+func writeValues(writer *utils.Writer, values []Value) {
+	writer.VariableUint(uint64(len(values)))
+	for _, value := range values {
+		writer.Uint8(uint8(value.Kind))
+		writer.String(value.Literal)
+	}
+}
+
+// readValues reads bound values that were written by writeValues.
+func readValues(reader *utils.Reader) []Value {
+	numOfValues := reader.VariableUint()
+	if numOfValues == 0 {
+		return nil
+	}
+	values := make([]Value, numOfValues)
+	for i := range values {
+		values[i].Kind = ValueKind(reader.Uint8())
+		values[i].Literal = reader.String()
+	}
+	return values
+}
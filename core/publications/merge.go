@@ -0,0 +1,63 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package publications
+
+import (
+	"context"
+)
+
+// Merge handles merging publications on our root and their root.
+func Merge(ctx context.Context, ourCollection, theirCollection, ancCollection *Collection) (*Collection, error) {
+	mergedCollection := ourCollection.Clone()
+	err := theirCollection.IteratePublications(func(theirPublication *Publication) error {
+		// If we don't have the publication, then we add it unless it was deleted on our side
+		if !mergedCollection.HasPublication(theirPublication.Name) {
+			if ancCollection.HasPublication(theirPublication.Name) {
+				return nil
+			}
+			return mergedCollection.CreatePublication(theirPublication.Clone())
+		}
+		// If the publication only changed on their side, then we take their version. When both sides changed the
+		// publication, we keep our version.
+		if ancPublication := ancCollection.GetPublication(theirPublication.Name); ancPublication != nil {
+			ourPublication := mergedCollection.GetPublication(theirPublication.Name)
+			if ourPublication.Equals(ancPublication) && !theirPublication.Equals(ancPublication) {
+				if err := mergedCollection.DropPublication(theirPublication.Name); err != nil {
+					return err
+				}
+				return mergedCollection.CreatePublication(theirPublication.Clone())
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Publications that were deleted on their side are deleted from the merged collection, as long as we didn't change
+	// them
+	err = ourCollection.IteratePublications(func(ourPublication *Publication) error {
+		if theirCollection.HasPublication(ourPublication.Name) {
+			return nil
+		}
+		if ancPublication := ancCollection.GetPublication(ourPublication.Name); ancPublication != nil && ancPublication.Equals(ourPublication) {
+			return mergedCollection.DropPublication(ourPublication.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mergedCollection, nil
+}
@@ -0,0 +1,287 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package publications
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+// Collection contains a collection of publications.
+type Collection struct {
+	publications map[string]*Publication
+	mutex        *sync.Mutex
+}
+
+// Publication is a set of tables whose changes are published to logical decoding consumers.
+type Publication struct {
+	Name  string
+	Owner string
+	// AllTables publishes every table of the database, including tables that are created after the publication. Tables
+	// is always empty when this is set.
+	AllTables bool
+	// Tables are the published tables, sorted by their schema and name.
+	Tables []doltdb.TableName
+	// These determine which kinds of changes are published.
+	Insert   bool
+	Update   bool
+	Delete   bool
+	Truncate bool
+}
+
+// NewPublication returns a publication that publishes every kind of change.
+func NewPublication(name string, owner string) *Publication {
+	return &Publication{
+		Name:     name,
+		Owner:    owner,
+		Insert:   true,
+		Update:   true,
+		Delete:   true,
+		Truncate: true,
+	}
+}
+
+// GetPublication returns the publication with the given name. Returns nil if the publication cannot be found.
+func (pgp *Collection) GetPublication(name string) *Publication {
+	pgp.mutex.Lock()
+	defer pgp.mutex.Unlock()
+	return pgp.publications[name]
+}
+
+// HasPublication returns whether the publication is present.
+func (pgp *Collection) HasPublication(name string) bool {
+	return pgp.GetPublication(name) != nil
+}
+
+// CreatePublication creates a new publication.
+func (pgp *Collection) CreatePublication(p *Publication) error {
+	pgp.mutex.Lock()
+	defer pgp.mutex.Unlock()
+
+	if _, ok := pgp.publications[p.Name]; ok {
+		return fmt.Errorf(`publication "%s" already exists`, p.Name)
+	}
+	p.Tables = sortedTables(p.Tables)
+	pgp.publications[p.Name] = p
+	return nil
+}
+
+// DropPublication drops an existing publication.
+func (pgp *Collection) DropPublication(name string) error {
+	pgp.mutex.Lock()
+	defer pgp.mutex.Unlock()
+
+	if _, ok := pgp.publications[name]; !ok {
+		return fmt.Errorf(`publication "%s" does not exist`, name)
+	}
+	delete(pgp.publications, name)
+	return nil
+}
+
+// DropTable removes the given table from every publication.
+func (pgp *Collection) DropTable(table doltdb.TableName) {
+	pgp.mutex.Lock()
+	defer pgp.mutex.Unlock()
+
+	for _, p := range pgp.publications {
+		p.Tables = removeTable(p.Tables, table)
+	}
+}
+
+// RenameTable renames the given table within every publication that contains it.
+func (pgp *Collection) RenameTable(oldName doltdb.TableName, newName doltdb.TableName) {
+	pgp.mutex.Lock()
+	defer pgp.mutex.Unlock()
+
+	for _, p := range pgp.publications {
+		if p.HasTable(oldName) {
+			p.Tables = sortedTables(append(removeTable(p.Tables, oldName), newName))
+		}
+	}
+}
+
+// IsEmpty returns whether the collection contains any publications.
+func (pgp *Collection) IsEmpty() bool {
+	pgp.mutex.Lock()
+	defer pgp.mutex.Unlock()
+	return len(pgp.publications) == 0
+}
+
+// IteratePublications iterates over all publications in the collection, in order of their names.
+func (pgp *Collection) IteratePublications(f func(p *Publication) error) error {
+	pgp.mutex.Lock()
+	defer pgp.mutex.Unlock()
+
+	names := make([]string, 0, len(pgp.publications))
+	for name := range pgp.publications {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := f(pgp.publications[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Clone returns a new *Collection with the same contents as the original.
+func (pgp *Collection) Clone() *Collection {
+	pgp.mutex.Lock()
+	defer pgp.mutex.Unlock()
+
+	newCollection := &Collection{
+		publications: make(map[string]*Publication, len(pgp.publications)),
+		mutex:        &sync.Mutex{},
+	}
+	for name, p := range pgp.publications {
+		newCollection.publications[name] = p.Clone()
+	}
+	return newCollection
+}
+
+// Clone returns a copy of the publication that does not share its tables with the original.
+func (p *Publication) Clone() *Publication {
+	clonedPublication := *p
+	clonedPublication.Tables = append([]doltdb.TableName(nil), p.Tables...)
+	return &clonedPublication
+}
+
+// Equals returns whether both publications have the same definition.
+func (p *Publication) Equals(other *Publication) bool {
+	if p.Name != other.Name || p.Owner != other.Owner || p.AllTables != other.AllTables || len(p.Tables) != len(other.Tables) ||
+		p.Insert != other.Insert || p.Update != other.Update || p.Delete != other.Delete || p.Truncate != other.Truncate {
+		return false
+	}
+	for i := range p.Tables {
+		if p.Tables[i] != other.Tables[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// HasTable returns whether the given table was explicitly added to the publication.
+func (p *Publication) HasTable(table doltdb.TableName) bool {
+	return containsTable(p.Tables, table)
+}
+
+// Publishes returns whether the changes of the given table are published.
+func (p *Publication) Publishes(table doltdb.TableName) bool {
+	return p.AllTables || p.HasTable(table)
+}
+
+// AddTables adds the given tables to the publication. Returns an error if a table is already in the publication.
+func (p *Publication) AddTables(tables []doltdb.TableName) error {
+	for _, table := range tables {
+		if p.HasTable(table) {
+			return fmt.Errorf(`relation "%s" is already member of publication "%s"`, table.Name, p.Name)
+		}
+		p.Tables = append(p.Tables, table)
+	}
+	p.Tables = sortedTables(p.Tables)
+	return nil
+}
+
+// RemoveTables removes the given tables from the publication. Returns an error if a table is not in the publication.
+func (p *Publication) RemoveTables(tables []doltdb.TableName) error {
+	for _, table := range tables {
+		if !p.HasTable(table) {
+			return fmt.Errorf(`relation "%s" is not part of the publication`, table.Name)
+		}
+		p.Tables = removeTable(p.Tables, table)
+	}
+	return nil
+}
+
+// SetTables replaces the tables of the publication with the given tables.
+func (p *Publication) SetTables(tables []doltdb.TableName) {
+	p.Tables = sortedTables(tables)
+}
+
+// SetParameter sets the parameter of the given name, as given within the WITH clause of the publication.
+func (p *Publication) SetParameter(name string, value string) error {
+	switch strings.ToLower(name) {
+	case "publish":
+		p.Insert, p.Update, p.Delete, p.Truncate = false, false, false, false
+		for _, operation := range strings.Split(value, ",") {
+			switch strings.ToLower(strings.TrimSpace(operation)) {
+			case "insert":
+				p.Insert = true
+			case "update":
+				p.Update = true
+			case "delete":
+				p.Delete = true
+			case "truncate":
+				p.Truncate = true
+			case "":
+			default:
+				return fmt.Errorf(`unrecognized value for publication option "publish": "%s"`, strings.TrimSpace(operation))
+			}
+		}
+		return nil
+	case "publish_via_partition_root":
+		switch strings.ToLower(value) {
+		case "false", "off", "0":
+			return nil
+		default:
+			return fmt.Errorf("publish_via_partition_root is not yet supported")
+		}
+	default:
+		return fmt.Errorf(`unrecognized publication parameter: "%s"`, name)
+	}
+}
+
+// removeTable returns the tables without the given table.
+func removeTable(tables []doltdb.TableName, table doltdb.TableName) []doltdb.TableName {
+	newTables := make([]doltdb.TableName, 0, len(tables))
+	for _, t := range tables {
+		if t != table {
+			newTables = append(newTables, t)
+		}
+	}
+	return newTables
+}
+
+// sortedTables returns the given tables sorted by their schema and name, with any duplicates removed.
+func sortedTables(tables []doltdb.TableName) []doltdb.TableName {
+	sorted := make([]doltdb.TableName, 0, len(tables))
+	for _, table := range tables {
+		if !containsTable(sorted, table) {
+			sorted = append(sorted, table)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Schema != sorted[j].Schema {
+			return sorted[i].Schema < sorted[j].Schema
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+	return sorted
+}
+
+// containsTable returns whether the table is within the given tables.
+func containsTable(tables []doltdb.TableName, table doltdb.TableName) bool {
+	for _, t := range tables {
+		if t == table {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package publications
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// Serialize returns the Collection as a byte slice. If the Collection is nil, then this returns a nil slice.
+func (pgp *Collection) Serialize(ctx context.Context) ([]byte, error) {
+	if pgp == nil {
+		return nil, nil
+	}
+	pgp.mutex.Lock()
+	defer pgp.mutex.Unlock()
+
+	// Write all of the publications to the writer
+	writer := utils.NewWriter(256)
+	writer.VariableUint(0) // Version
+	names := utils.GetMapKeysSorted(pgp.publications)
+	writer.VariableUint(uint64(len(names)))
+	for _, name := range names {
+		p := pgp.publications[name]
+		writer.String(p.Name)
+		writer.String(p.Owner)
+		writer.Bool(p.AllTables)
+		writer.Bool(p.Insert)
+		writer.Bool(p.Update)
+		writer.Bool(p.Delete)
+		writer.Bool(p.Truncate)
+		writer.VariableUint(uint64(len(p.Tables)))
+		for _, table := range p.Tables {
+			writer.String(table.Schema)
+			writer.String(table.Name)
+		}
+	}
+
+	return writer.Data(), nil
+}
+
+// Deserialize returns the Collection that was serialized in the byte slice. Returns an empty Collection if data is nil
+// or empty.
+func Deserialize(ctx context.Context, data []byte) (*Collection, error) {
+	if len(data) == 0 {
+		return &Collection{
+			publications: make(map[string]*Publication),
+			mutex:        &sync.Mutex{},
+		}, nil
+	}
+	publications := make(map[string]*Publication)
+	reader := utils.NewReader(data)
+	version := reader.VariableUint()
+	if version != 0 {
+		return nil, fmt.Errorf("version %d of publications is not supported, please upgrade the server", version)
+	}
+
+	// Read from the reader
+	numOfPublications := reader.VariableUint()
+	for i := uint64(0); i < numOfPublications; i++ {
+		p := &Publication{}
+		p.Name = reader.String()
+		p.Owner = reader.String()
+		p.AllTables = reader.Bool()
+		p.Insert = reader.Bool()
+		p.Update = reader.Bool()
+		p.Delete = reader.Bool()
+		p.Truncate = reader.Bool()
+		numOfTables := reader.VariableUint()
+		p.Tables = make([]doltdb.TableName, numOfTables)
+		for j := range p.Tables {
+			p.Tables[j].Schema = reader.String()
+			p.Tables[j].Name = reader.String()
+		}
+		publications[p.Name] = p
+	}
+	if !reader.IsEmpty() {
+		return nil, fmt.Errorf("extra data found while deserializing publications")
+	}
+
+	// Return the deserialized object
+	return &Collection{
+		publications: publications,
+		mutex:        &sync.Mutex{},
+	}, nil
+}
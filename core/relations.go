@@ -16,11 +16,14 @@ package core
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
-
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/resolve"
 	"github.com/dolthub/go-mysql-server/sql"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 )
 
 // RelationType states the type of the relation.
@@ -78,3 +81,71 @@ func GetRelationTypeFromRoot(ctx *sql.Context, schema string, relation string, r
 	// TODO: the rest of the relations
 	return RelationType_DoesNotExist, nil
 }
+
+// View is a view within a database, along with the schema that it belongs to.
+type View struct {
+	Name doltdb.TableName
+	// Definition is the parsed CREATE VIEW statement of the view.
+	Definition *vitess.DDL
+}
+
+// GetViewsFromContext returns every view within the current database, in order of their names.
+func GetViewsFromContext(ctx *sql.Context) ([]View, error) {
+	session, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return getViews(ctx, session, ctx.GetCurrentDatabase(), root)
+}
+
+// IterateTablesFromContext calls the given function for every table within the current database, along with its schema.
+// Returning true from the function stops the iteration.
+func IterateTablesFromContext(ctx *sql.Context, cb func(name doltdb.TableName, table *doltdb.Table, sch schema.Schema) (bool, error)) error {
+	_, root, err := getRootFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	return root.IterTables(ctx, cb)
+}
+
+// getViews returns every view within the given database, in order of their names. Views do not belong to a schema
+// within storage, so a view belongs to the schema that its definition names, or the current schema when it does not
+// name one. Views whose definitions cannot be parsed are skipped.
+func getViews(ctx *sql.Context, session *dsess.DoltSession, database string, root *RootValue) ([]View, error) {
+	db, err := session.Provider().Database(ctx, database)
+	if err != nil {
+		return nil, err
+	}
+	viewDb, ok := db.(sql.ViewDatabase)
+	if !ok {
+		return nil, nil
+	}
+	viewDefinitions, err := viewDb.AllViews(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(viewDefinitions, func(i, j int) bool {
+		return viewDefinitions[i].Name < viewDefinitions[j].Name
+	})
+	currentSchema, err := resolve.FirstExistingSchemaOnSearchPath(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	views := make([]View, 0, len(viewDefinitions))
+	for _, viewDefinition := range viewDefinitions {
+		stmt, err := sql.GlobalParser.ParseSimple(viewDefinition.CreateViewStatement)
+		if err != nil {
+			continue
+		}
+		ddl, ok := stmt.(*vitess.DDL)
+		if !ok || ddl.ViewSpec == nil {
+			continue
+		}
+		view := View{Name: doltdb.TableName{Name: viewDefinition.Name, Schema: currentSchema}, Definition: ddl}
+		if schemaName := ddl.ViewSpec.ViewName.SchemaQualifier.String(); len(schemaName) > 0 {
+			view.Name.Schema = schemaName
+		}
+		views = append(views, view)
+	}
+	return views, nil
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"slices"
 	"sort"
 	"strings"
 
@@ -29,7 +30,16 @@ import (
 	"github.com/dolthub/dolt/go/store/prolly/tree"
 	"github.com/dolthub/dolt/go/store/types"
 
+	"github.com/dolthub/doltgresql/core/exclusions"
+	"github.com/dolthub/doltgresql/core/foreign"
+	"github.com/dolthub/doltgresql/core/functions"
+	"github.com/dolthub/doltgresql/core/masking"
+	"github.com/dolthub/doltgresql/core/partitions"
+	"github.com/dolthub/doltgresql/core/publications"
 	"github.com/dolthub/doltgresql/core/sequences"
+	"github.com/dolthub/doltgresql/core/storageparams"
+	"github.com/dolthub/doltgresql/core/triggers"
+	"github.com/dolthub/doltgresql/core/unvalidated"
 )
 
 const (
@@ -40,7 +50,9 @@ const (
 )
 
 // DoltgresFeatureVersion is Doltgres' feature version. We use Dolt's feature version added to our own.
-var DoltgresFeatureVersion = doltdb.DoltFeatureVersion + 0
+//
+// Our own version was last bumped when tables without a schema began to be stored in the default schema.
+var DoltgresFeatureVersion = doltdb.DoltFeatureVersion + 1
 
 // RootValue is Doltgres' implementation of doltdb.RootValue.
 type RootValue struct {
@@ -108,6 +120,35 @@ func (root *RootValue) DebugString(ctx context.Context, transitive bool) string
 	return buf.String()
 }
 
+// DropDatabaseSchema removes the schema with the given name. The schema must not contain any tables.
+func (root *RootValue) DropDatabaseSchema(ctx context.Context, schemaName string) (*RootValue, error) {
+	tableNames, err := root.GetTableNames(ctx, schemaName)
+	if err != nil {
+		return nil, err
+	}
+	if len(tableNames) > 0 {
+		return nil, fmt.Errorf("schema %s still contains tables", schemaName)
+	}
+	existingSchemas, err := root.st.GetSchemas(ctx)
+	if err != nil {
+		return nil, err
+	}
+	remainingSchemas := make([]schema.DatabaseSchema, 0, len(existingSchemas))
+	for _, s := range existingSchemas {
+		if s.Name != schemaName {
+			remainingSchemas = append(remainingSchemas, s)
+		}
+	}
+	if len(remainingSchemas) == len(existingSchemas) {
+		return nil, fmt.Errorf("schema %s does not exist", schemaName)
+	}
+	r, err := root.st.SetSchemas(ctx, remainingSchemas)
+	if err != nil {
+		return nil, err
+	}
+	return root.withStorage(r), nil
+}
+
 // GetCollation implements the interface doltdb.RootValue.
 func (root *RootValue) GetCollation(ctx context.Context) (schema.Collation, error) {
 	return root.st.GetCollation(ctx)
@@ -147,6 +188,52 @@ func (root *RootValue) GetForeignKeyCollection(ctx context.Context) (*doltdb.For
 	return root.fkc.Copy(), nil
 }
 
+// GetFunctions returns all functions that are on the root.
+func (root *RootValue) GetFunctions(ctx context.Context) (*functions.Collection, error) {
+	h := root.st.GetFunctions()
+	if h.IsEmpty() {
+		return functions.Deserialize(ctx, nil)
+	}
+	dataValue, err := root.vrw.ReadValue(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	dataBlob := dataValue.(types.Blob)
+	dataBlobLength := dataBlob.Len()
+	data := make([]byte, dataBlobLength)
+	n, err := dataBlob.ReadAt(context.Background(), data, 0)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if uint64(n) != dataBlobLength {
+		return nil, fmt.Errorf("wanted %d bytes from blob for functions, got %d", dataBlobLength, n)
+	}
+	return functions.Deserialize(ctx, data)
+}
+
+// GetMaskingPolicies returns all masking policies that are on the root.
+func (root *RootValue) GetMaskingPolicies(ctx context.Context) (*masking.Collection, error) {
+	h := root.st.GetMaskingPolicies()
+	if h.IsEmpty() {
+		return masking.Deserialize(ctx, nil)
+	}
+	dataValue, err := root.vrw.ReadValue(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	dataBlob := dataValue.(types.Blob)
+	dataBlobLength := dataBlob.Len()
+	data := make([]byte, dataBlobLength)
+	n, err := dataBlob.ReadAt(context.Background(), data, 0)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if uint64(n) != dataBlobLength {
+		return nil, fmt.Errorf("wanted %d bytes from blob for masking policies, got %d", dataBlobLength, n)
+	}
+	return masking.Deserialize(ctx, data)
+}
+
 // GetSequences returns all sequences that are on the root.
 func (root *RootValue) GetSequences(ctx context.Context) (*sequences.Collection, error) {
 	h := root.st.GetSequences()
@@ -170,180 +257,732 @@ func (root *RootValue) GetSequences(ctx context.Context) (*sequences.Collection,
 	return sequences.Deserialize(ctx, data)
 }
 
+// GetForeignData returns every foreign server and foreign table that is on the root.
+func (root *RootValue) GetForeignData(ctx context.Context) (*foreign.Collection, error) {
+	h := root.st.GetForeignData()
+	if h.IsEmpty() {
+		return foreign.Deserialize(ctx, nil)
+	}
+	dataValue, err := root.vrw.ReadValue(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	dataBlob := dataValue.(types.Blob)
+	dataBlobLength := dataBlob.Len()
+	data := make([]byte, dataBlobLength)
+	n, err := dataBlob.ReadAt(context.Background(), data, 0)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if uint64(n) != dataBlobLength {
+		return nil, fmt.Errorf("wanted %d bytes from blob for foreign data, got %d", dataBlobLength, n)
+	}
+	return foreign.Deserialize(ctx, data)
+}
+
+// GetTriggers returns every trigger that is on the root.
+func (root *RootValue) GetTriggers(ctx context.Context) (*triggers.Collection, error) {
+	h := root.st.GetTriggers()
+	if h.IsEmpty() {
+		return triggers.Deserialize(ctx, nil)
+	}
+	dataValue, err := root.vrw.ReadValue(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	dataBlob := dataValue.(types.Blob)
+	dataBlobLength := dataBlob.Len()
+	data := make([]byte, dataBlobLength)
+	n, err := dataBlob.ReadAt(context.Background(), data, 0)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if uint64(n) != dataBlobLength {
+		return nil, fmt.Errorf("wanted %d bytes from blob for triggers, got %d", dataBlobLength, n)
+	}
+	return triggers.Deserialize(ctx, data)
+}
+
+// GetPartitions returns every partitioned table and partition that is on the root.
+func (root *RootValue) GetPartitions(ctx context.Context) (*partitions.Collection, error) {
+	h := root.st.GetPartitions()
+	if h.IsEmpty() {
+		return partitions.Deserialize(ctx, nil)
+	}
+	dataValue, err := root.vrw.ReadValue(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	dataBlob := dataValue.(types.Blob)
+	dataBlobLength := dataBlob.Len()
+	data := make([]byte, dataBlobLength)
+	n, err := dataBlob.ReadAt(context.Background(), data, 0)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if uint64(n) != dataBlobLength {
+		return nil, fmt.Errorf("wanted %d bytes from blob for partitions, got %d", dataBlobLength, n)
+	}
+	return partitions.Deserialize(ctx, data)
+}
+
+// GetExclusionConstraints returns every exclusion constraint that is on the root.
+func (root *RootValue) GetExclusionConstraints(ctx context.Context) (*exclusions.Collection, error) {
+	h := root.st.GetExclusionConstraints()
+	if h.IsEmpty() {
+		return exclusions.Deserialize(ctx, nil)
+	}
+	dataValue, err := root.vrw.ReadValue(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	dataBlob := dataValue.(types.Blob)
+	dataBlobLength := dataBlob.Len()
+	data := make([]byte, dataBlobLength)
+	n, err := dataBlob.ReadAt(context.Background(), data, 0)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if uint64(n) != dataBlobLength {
+		return nil, fmt.Errorf("wanted %d bytes from blob for exclusion constraints, got %d", dataBlobLength, n)
+	}
+	return exclusions.Deserialize(ctx, data)
+}
+
+// GetUnvalidatedConstraints returns every constraint on the root that has not been validated.
+func (root *RootValue) GetUnvalidatedConstraints(ctx context.Context) (*unvalidated.Collection, error) {
+	h := root.st.GetUnvalidatedConstraints()
+	if h.IsEmpty() {
+		return unvalidated.Deserialize(ctx, nil)
+	}
+	dataValue, err := root.vrw.ReadValue(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	dataBlob := dataValue.(types.Blob)
+	dataBlobLength := dataBlob.Len()
+	data := make([]byte, dataBlobLength)
+	n, err := dataBlob.ReadAt(context.Background(), data, 0)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if uint64(n) != dataBlobLength {
+		return nil, fmt.Errorf("wanted %d bytes from blob for unvalidated constraints, got %d", dataBlobLength, n)
+	}
+	return unvalidated.Deserialize(ctx, data)
+}
+
+// GetPublications returns all publications that are on the root.
+func (root *RootValue) GetPublications(ctx context.Context) (*publications.Collection, error) {
+	h := root.st.GetPublications()
+	if h.IsEmpty() {
+		return publications.Deserialize(ctx, nil)
+	}
+	dataValue, err := root.vrw.ReadValue(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	dataBlob := dataValue.(types.Blob)
+	dataBlobLength := dataBlob.Len()
+	data := make([]byte, dataBlobLength)
+	n, err := dataBlob.ReadAt(context.Background(), data, 0)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if uint64(n) != dataBlobLength {
+		return nil, fmt.Errorf("wanted %d bytes from blob for publications, got %d", dataBlobLength, n)
+	}
+	return publications.Deserialize(ctx, data)
+}
+
+// GetStorageParameters returns the storage parameters of every table that is on the root.
+func (root *RootValue) GetStorageParameters(ctx context.Context) (*storageparams.Collection, error) {
+	h := root.st.GetStorageParameters()
+	if h.IsEmpty() {
+		return storageparams.Deserialize(ctx, nil)
+	}
+	dataValue, err := root.vrw.ReadValue(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	dataBlob := dataValue.(types.Blob)
+	dataBlobLength := dataBlob.Len()
+	data := make([]byte, dataBlobLength)
+	n, err := dataBlob.ReadAt(context.Background(), data, 0)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if uint64(n) != dataBlobLength {
+		return nil, fmt.Errorf("wanted %d bytes from blob for storage parameters, got %d", dataBlobLength, n)
+	}
+	return storageparams.Deserialize(ctx, data)
+}
+
 // GetTable implements the interface doltdb.RootValue.
 func (root *RootValue) GetTable(ctx context.Context, tName doltdb.TableName) (*doltdb.Table, bool, error) {
 	tableMap, err := root.getTableMap(ctx, tName.Schema)
 	if err != nil {
 		return nil, false, err
 	}
-
-	addr, err := tableMap.Get(ctx, tName.Name)
+
+	addr, err := tableMap.Get(ctx, tName.Name)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return doltdb.GetTable(ctx, root, addr)
+}
+
+// GetTableHash implements the interface doltdb.RootValue.
+func (root *RootValue) GetTableHash(ctx context.Context, tName doltdb.TableName) (hash.Hash, bool, error) {
+	tableMap, err := root.getTableMap(ctx, tName.Schema)
+	if err != nil {
+		return hash.Hash{}, false, err
+	}
+
+	tVal, err := tableMap.Get(ctx, tName.Name)
+	if err != nil {
+		return hash.Hash{}, false, err
+	}
+
+	return tVal, !tVal.IsEmpty(), nil
+}
+
+// GetTableNames implements the interface doltdb.RootValue.
+func (root *RootValue) GetTableNames(ctx context.Context, schemaName string) ([]string, error) {
+	tableMap, err := root.getTableMap(ctx, schemaName)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	err = tableMap.Iter(ctx, func(name string, _ hash.Hash) (bool, error) {
+		names = append(names, name)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return names, nil
+}
+
+// HandlePostMerge implements the interface doltdb.RootValue.
+func (root *RootValue) HandlePostMerge(ctx context.Context, ourRoot, theirRoot, ancRoot doltdb.RootValue) (doltdb.RootValue, error) {
+	// Handle sequences
+	ourSequence, err := ourRoot.(*RootValue).GetSequences(ctx)
+	if err != nil {
+		return nil, err
+	}
+	theirSequence, err := theirRoot.(*RootValue).GetSequences(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ancSequence, err := ancRoot.(*RootValue).GetSequences(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mergedSequence, err := sequences.Merge(ctx, ourSequence, theirSequence, ancSequence)
+	if err != nil {
+		return nil, err
+	}
+	newRoot, err := root.PutSequences(ctx, mergedSequence)
+	if err != nil {
+		return nil, err
+	}
+	// Handle functions
+	ourFunctions, err := ourRoot.(*RootValue).GetFunctions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	theirFunctions, err := theirRoot.(*RootValue).GetFunctions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ancFunctions, err := ancRoot.(*RootValue).GetFunctions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mergedFunctions, err := functions.Merge(ctx, ourFunctions, theirFunctions, ancFunctions)
+	if err != nil {
+		return nil, err
+	}
+	newRoot, err = newRoot.PutFunctions(ctx, mergedFunctions)
+	if err != nil {
+		return nil, err
+	}
+	// Handle masking policies
+	ourPolicies, err := ourRoot.(*RootValue).GetMaskingPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	theirPolicies, err := theirRoot.(*RootValue).GetMaskingPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ancPolicies, err := ancRoot.(*RootValue).GetMaskingPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mergedPolicies, err := masking.Merge(ctx, ourPolicies, theirPolicies, ancPolicies)
+	if err != nil {
+		return nil, err
+	}
+	newRoot, err = newRoot.PutMaskingPolicies(ctx, mergedPolicies)
+	if err != nil {
+		return nil, err
+	}
+	// Handle storage parameters
+	ourParams, err := ourRoot.(*RootValue).GetStorageParameters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	theirParams, err := theirRoot.(*RootValue).GetStorageParameters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ancParams, err := ancRoot.(*RootValue).GetStorageParameters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mergedParams, err := storageparams.Merge(ctx, ourParams, theirParams, ancParams)
+	if err != nil {
+		return nil, err
+	}
+	newRoot, err = newRoot.PutStorageParameters(ctx, mergedParams)
+	if err != nil {
+		return nil, err
+	}
+	// Handle foreign data
+	ourForeign, err := ourRoot.(*RootValue).GetForeignData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	theirForeign, err := theirRoot.(*RootValue).GetForeignData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ancForeign, err := ancRoot.(*RootValue).GetForeignData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mergedForeign, err := foreign.Merge(ctx, ourForeign, theirForeign, ancForeign)
+	if err != nil {
+		return nil, err
+	}
+	newRoot, err = newRoot.PutForeignData(ctx, mergedForeign)
+	if err != nil {
+		return nil, err
+	}
+	// Handle triggers
+	ourTriggers, err := ourRoot.(*RootValue).GetTriggers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	theirTriggers, err := theirRoot.(*RootValue).GetTriggers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ancTriggers, err := ancRoot.(*RootValue).GetTriggers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mergedTriggers, err := triggers.Merge(ctx, ourTriggers, theirTriggers, ancTriggers)
+	if err != nil {
+		return nil, err
+	}
+	newRoot, err = newRoot.PutTriggers(ctx, mergedTriggers)
+	if err != nil {
+		return nil, err
+	}
+	// Handle partitions
+	ourPartitions, err := ourRoot.(*RootValue).GetPartitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	theirPartitions, err := theirRoot.(*RootValue).GetPartitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ancPartitions, err := ancRoot.(*RootValue).GetPartitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mergedPartitions, err := partitions.Merge(ctx, ourPartitions, theirPartitions, ancPartitions)
+	if err != nil {
+		return nil, err
+	}
+	newRoot, err = newRoot.PutPartitions(ctx, mergedPartitions)
+	if err != nil {
+		return nil, err
+	}
+	// Handle exclusion constraints
+	ourExclusions, err := ourRoot.(*RootValue).GetExclusionConstraints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	theirExclusions, err := theirRoot.(*RootValue).GetExclusionConstraints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ancExclusions, err := ancRoot.(*RootValue).GetExclusionConstraints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mergedExclusions, err := exclusions.Merge(ctx, ourExclusions, theirExclusions, ancExclusions)
+	if err != nil {
+		return nil, err
+	}
+	newRoot, err = newRoot.PutExclusionConstraints(ctx, mergedExclusions)
+	if err != nil {
+		return nil, err
+	}
+	// Handle unvalidated constraints
+	ourUnvalidated, err := ourRoot.(*RootValue).GetUnvalidatedConstraints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	theirUnvalidated, err := theirRoot.(*RootValue).GetUnvalidatedConstraints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ancUnvalidated, err := ancRoot.(*RootValue).GetUnvalidatedConstraints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mergedUnvalidated, err := unvalidated.Merge(ctx, ourUnvalidated, theirUnvalidated, ancUnvalidated)
+	if err != nil {
+		return nil, err
+	}
+	newRoot, err = newRoot.PutUnvalidatedConstraints(ctx, mergedUnvalidated)
+	if err != nil {
+		return nil, err
+	}
+	// Handle publications
+	ourPublications, err := ourRoot.(*RootValue).GetPublications(ctx)
+	if err != nil {
+		return nil, err
+	}
+	theirPublications, err := theirRoot.(*RootValue).GetPublications(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ancPublications, err := ancRoot.(*RootValue).GetPublications(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mergedPublications, err := publications.Merge(ctx, ourPublications, theirPublications, ancPublications)
+	if err != nil {
+		return nil, err
+	}
+	return newRoot.PutPublications(ctx, mergedPublications)
+}
+
+// HashOf implements the interface doltdb.RootValue.
+func (root *RootValue) HashOf() (hash.Hash, error) {
+	if root.hash.IsEmpty() {
+		var err error
+		root.hash, err = root.st.nomsValue().Hash(root.vrw.Format())
+		if err != nil {
+			return hash.Hash{}, nil
+		}
+	}
+	return root.hash, nil
+}
+
+// HasTable implements the interface doltdb.RootValue.
+func (root *RootValue) HasTable(ctx context.Context, tName doltdb.TableName) (bool, error) {
+	tableMap, err := root.getTableMap(ctx, tName.Schema)
+	if err != nil {
+		return false, err
+	}
+	a, err := tableMap.Get(ctx, tName.Name)
+	if err != nil {
+		return false, err
+	}
+	return !a.IsEmpty(), nil
+}
+
+// IterTables implements the interface doltdb.RootValue.
+func (root *RootValue) IterTables(ctx context.Context, cb func(name doltdb.TableName, table *doltdb.Table, sch schema.Schema) (stop bool, err error)) error {
+	schemaNames, err := schemaNames(ctx, root)
+	if err != nil {
+		return err
+	}
+
+	for _, schemaName := range schemaNames {
+		tm, err := root.getTableMap(ctx, schemaName)
+		if err != nil {
+			return err
+		}
+
+		err = tm.Iter(ctx, func(name string, addr hash.Hash) (bool, error) {
+			nt, err := durable.TableFromAddr(ctx, root.VRW(), root.ns, addr)
+			if err != nil {
+				return true, err
+			}
+			tbl := doltdb.NewTableFromDurable(nt)
+
+			sch, err := tbl.GetSchema(ctx)
+			if err != nil {
+				return true, err
+			}
+
+			return cb(doltdb.TableName{
+				Name:   name,
+				Schema: schemaName,
+			}, tbl, sch)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// schemaNames returns all names of all schemas which may have tables
+func schemaNames(ctx context.Context, root doltdb.RootValue) ([]string, error) {
+	dbSchemas, err := root.GetDatabaseSchemas(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The default schema is only added when it isn't already a database schema, so that its tables aren't visited twice
+	schemaNames := make([]string, 0, len(dbSchemas)+1)
+	hasDefaultSchema := false
+	for _, dbSchema := range dbSchemas {
+		schemaNames = append(schemaNames, dbSchema.Name)
+		if dbSchema.Name == doltdb.DefaultSchemaName {
+			hasDefaultSchema = true
+		}
+	}
+	if !hasDefaultSchema {
+		schemaNames = append(schemaNames, doltdb.DefaultSchemaName)
+	}
+	return schemaNames, nil
+}
+
+// NodeStore implements the interface doltdb.RootValue.
+func (root *RootValue) NodeStore() tree.NodeStore {
+	return root.ns
+}
+
+// NomsValue implements the interface doltdb.RootValue.
+func (root *RootValue) NomsValue() types.Value {
+	return root.st.nomsValue()
+}
+
+// PutForeignKeyCollection implements the interface doltdb.RootValue.
+func (root *RootValue) PutForeignKeyCollection(ctx context.Context, fkc *doltdb.ForeignKeyCollection) (doltdb.RootValue, error) {
+	value, err := doltdb.SerializeForeignKeys(ctx, root.vrw, fkc)
+	if err != nil {
+		return nil, err
+	}
+	newStorage, err := root.st.SetForeignKeyMap(ctx, root.vrw, value)
+	if err != nil {
+		return nil, err
+	}
+	return root.withStorage(newStorage), nil
+}
+
+// PutFunctions writes the given functions to the returned root value.
+func (root *RootValue) PutFunctions(ctx context.Context, funcs *functions.Collection) (*RootValue, error) {
+	data, err := funcs.Serialize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dataBlob, err := types.NewBlob(ctx, root.vrw, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	ref, err := root.vrw.WriteValue(ctx, dataBlob)
+	if err != nil {
+		return nil, err
+	}
+	newStorage, err := root.st.SetFunctions(ctx, ref.TargetHash())
+	if err != nil {
+		return nil, err
+	}
+	return root.withStorage(newStorage), nil
+}
+
+// PutMaskingPolicies writes the given masking policies to the returned root value.
+func (root *RootValue) PutMaskingPolicies(ctx context.Context, policies *masking.Collection) (*RootValue, error) {
+	data, err := policies.Serialize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dataBlob, err := types.NewBlob(ctx, root.vrw, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	ref, err := root.vrw.WriteValue(ctx, dataBlob)
+	if err != nil {
+		return nil, err
+	}
+	newStorage, err := root.st.SetMaskingPolicies(ctx, ref.TargetHash())
+	if err != nil {
+		return nil, err
+	}
+	return root.withStorage(newStorage), nil
+}
+
+// PutSequences writes the given sequences to the returned root value.
+func (root *RootValue) PutSequences(ctx context.Context, seq *sequences.Collection) (*RootValue, error) {
+	data, err := seq.Serialize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Empty collections use an empty hash, so that writing no sequences does not change the root
+	var h hash.Hash
+	if len(data) > 0 {
+		dataBlob, err := types.NewBlob(ctx, root.vrw, bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		ref, err := root.vrw.WriteValue(ctx, dataBlob)
+		if err != nil {
+			return nil, err
+		}
+		h = ref.TargetHash()
+	}
+	newStorage, err := root.st.SetSequences(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	return root.withStorage(newStorage), nil
+}
+
+// PutForeignData writes the given foreign servers and tables to the returned root value.
+func (root *RootValue) PutForeignData(ctx context.Context, collection *foreign.Collection) (*RootValue, error) {
+	data, err := collection.Serialize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dataBlob, err := types.NewBlob(ctx, root.vrw, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	ref, err := root.vrw.WriteValue(ctx, dataBlob)
+	if err != nil {
+		return nil, err
+	}
+	newStorage, err := root.st.SetForeignData(ctx, ref.TargetHash())
 	if err != nil {
-		return nil, false, err
+		return nil, err
 	}
-
-	return doltdb.GetTable(ctx, root, addr)
+	return root.withStorage(newStorage), nil
 }
 
-// GetTableHash implements the interface doltdb.RootValue.
-func (root *RootValue) GetTableHash(ctx context.Context, tName doltdb.TableName) (hash.Hash, bool, error) {
-	tableMap, err := root.getTableMap(ctx, tName.Schema)
+// PutTriggers writes the given triggers to the returned root value.
+func (root *RootValue) PutTriggers(ctx context.Context, collection *triggers.Collection) (*RootValue, error) {
+	data, err := collection.Serialize(ctx)
 	if err != nil {
-		return hash.Hash{}, false, err
+		return nil, err
 	}
-
-	tVal, err := tableMap.Get(ctx, tName.Name)
+	dataBlob, err := types.NewBlob(ctx, root.vrw, bytes.NewReader(data))
 	if err != nil {
-		return hash.Hash{}, false, err
+		return nil, err
 	}
-
-	return tVal, !tVal.IsEmpty(), nil
-}
-
-// GetTableNames implements the interface doltdb.RootValue.
-func (root *RootValue) GetTableNames(ctx context.Context, schemaName string) ([]string, error) {
-	tableMap, err := root.getTableMap(ctx, schemaName)
+	ref, err := root.vrw.WriteValue(ctx, dataBlob)
 	if err != nil {
 		return nil, err
 	}
-
-	var names []string
-	err = tableMap.Iter(ctx, func(name string, _ hash.Hash) (bool, error) {
-		names = append(names, name)
-		return false, nil
-	})
+	newStorage, err := root.st.SetTriggers(ctx, ref.TargetHash())
 	if err != nil {
 		return nil, err
 	}
-
-	return names, nil
+	return root.withStorage(newStorage), nil
 }
 
-// HandlePostMerge implements the interface doltdb.RootValue.
-func (root *RootValue) HandlePostMerge(ctx context.Context, ourRoot, theirRoot, ancRoot doltdb.RootValue) (doltdb.RootValue, error) {
-	// Handle sequences
-	ourSequence, err := ourRoot.(*RootValue).GetSequences(ctx)
+// PutPartitions writes the given partitioned tables and partitions to the returned root value.
+func (root *RootValue) PutPartitions(ctx context.Context, collection *partitions.Collection) (*RootValue, error) {
+	data, err := collection.Serialize(ctx)
 	if err != nil {
 		return nil, err
 	}
-	theirSequence, err := theirRoot.(*RootValue).GetSequences(ctx)
+	dataBlob, err := types.NewBlob(ctx, root.vrw, bytes.NewReader(data))
 	if err != nil {
 		return nil, err
 	}
-	ancSequence, err := ancRoot.(*RootValue).GetSequences(ctx)
+	ref, err := root.vrw.WriteValue(ctx, dataBlob)
 	if err != nil {
 		return nil, err
 	}
-	mergedSequence, err := sequences.Merge(ctx, ourSequence, theirSequence, ancSequence)
+	newStorage, err := root.st.SetPartitions(ctx, ref.TargetHash())
 	if err != nil {
 		return nil, err
 	}
-	return root.PutSequences(ctx, mergedSequence)
+	return root.withStorage(newStorage), nil
 }
 
-// HashOf implements the interface doltdb.RootValue.
-func (root *RootValue) HashOf() (hash.Hash, error) {
-	if root.hash.IsEmpty() {
-		var err error
-		root.hash, err = root.st.nomsValue().Hash(root.vrw.Format())
-		if err != nil {
-			return hash.Hash{}, nil
-		}
+// PutExclusionConstraints writes the given exclusion constraints to the returned root value.
+func (root *RootValue) PutExclusionConstraints(ctx context.Context, collection *exclusions.Collection) (*RootValue, error) {
+	data, err := collection.Serialize(ctx)
+	if err != nil {
+		return nil, err
 	}
-	return root.hash, nil
-}
-
-// HasTable implements the interface doltdb.RootValue.
-func (root *RootValue) HasTable(ctx context.Context, tName doltdb.TableName) (bool, error) {
-	tableMap, err := root.st.GetTablesMap(ctx, root.vrw, root.ns, tName.Schema)
+	dataBlob, err := types.NewBlob(ctx, root.vrw, bytes.NewReader(data))
 	if err != nil {
-		return false, err
+		return nil, err
 	}
-	a, err := tableMap.Get(ctx, tName.Name)
+	ref, err := root.vrw.WriteValue(ctx, dataBlob)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
-	return !a.IsEmpty(), nil
+	newStorage, err := root.st.SetExclusionConstraints(ctx, ref.TargetHash())
+	if err != nil {
+		return nil, err
+	}
+	return root.withStorage(newStorage), nil
 }
 
-// IterTables implements the interface doltdb.RootValue.
-func (root *RootValue) IterTables(ctx context.Context, cb func(name doltdb.TableName, table *doltdb.Table, sch schema.Schema) (stop bool, err error)) error {
-	schemaNames, err := schemaNames(ctx, root)
+// PutUnvalidatedConstraints writes the given unvalidated constraints to the returned root value.
+func (root *RootValue) PutUnvalidatedConstraints(ctx context.Context, collection *unvalidated.Collection) (*RootValue, error) {
+	data, err := collection.Serialize(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	for _, schemaName := range schemaNames {
-		tm, err := root.getTableMap(ctx, schemaName)
-		if err != nil {
-			return err
-		}
-
-		err = tm.Iter(ctx, func(name string, addr hash.Hash) (bool, error) {
-			nt, err := durable.TableFromAddr(ctx, root.VRW(), root.ns, addr)
-			if err != nil {
-				return true, err
-			}
-			tbl := doltdb.NewTableFromDurable(nt)
-
-			sch, err := tbl.GetSchema(ctx)
-			if err != nil {
-				return true, err
-			}
-
-			return cb(doltdb.TableName{
-				Name:   name,
-				Schema: schemaName,
-			}, tbl, sch)
-		})
-		if err != nil {
-			return err
-		}
+	dataBlob, err := types.NewBlob(ctx, root.vrw, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
 	}
-
-	return nil
-}
-
-// schemaNames returns all names of all schemas which may have tables
-func schemaNames(ctx context.Context, root doltdb.RootValue) ([]string, error) {
-	dbSchemas, err := root.GetDatabaseSchemas(ctx)
+	ref, err := root.vrw.WriteValue(ctx, dataBlob)
 	if err != nil {
 		return nil, err
 	}
-
-	schemaNames := make([]string, len(dbSchemas)+1)
-	for i, dbSchema := range dbSchemas {
-		schemaNames[i] = dbSchema.Name
+	newStorage, err := root.st.SetUnvalidatedConstraints(ctx, ref.TargetHash())
+	if err != nil {
+		return nil, err
 	}
-	return schemaNames, nil
-}
-
-// NodeStore implements the interface doltdb.RootValue.
-func (root *RootValue) NodeStore() tree.NodeStore {
-	return root.ns
-}
-
-// NomsValue implements the interface doltdb.RootValue.
-func (root *RootValue) NomsValue() types.Value {
-	return root.st.nomsValue()
+	return root.withStorage(newStorage), nil
 }
 
-// PutForeignKeyCollection implements the interface doltdb.RootValue.
-func (root *RootValue) PutForeignKeyCollection(ctx context.Context, fkc *doltdb.ForeignKeyCollection) (doltdb.RootValue, error) {
-	value, err := doltdb.SerializeForeignKeys(ctx, root.vrw, fkc)
+// PutPublications writes the given publications to the returned root value.
+func (root *RootValue) PutPublications(ctx context.Context, collection *publications.Collection) (*RootValue, error) {
+	data, err := collection.Serialize(ctx)
 	if err != nil {
 		return nil, err
 	}
-	newStorage, err := root.st.SetForeignKeyMap(ctx, root.vrw, value)
+	dataBlob, err := types.NewBlob(ctx, root.vrw, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	ref, err := root.vrw.WriteValue(ctx, dataBlob)
+	if err != nil {
+		return nil, err
+	}
+	newStorage, err := root.st.SetPublications(ctx, ref.TargetHash())
 	if err != nil {
 		return nil, err
 	}
 	return root.withStorage(newStorage), nil
 }
 
-// PutSequences writes the given sequences to the returned root value.
-func (root *RootValue) PutSequences(ctx context.Context, seq *sequences.Collection) (*RootValue, error) {
-	data, err := seq.Serialize(ctx)
+// PutStorageParameters writes the given storage parameters to the returned root value.
+func (root *RootValue) PutStorageParameters(ctx context.Context, params *storageparams.Collection) (*RootValue, error) {
+	data, err := params.Serialize(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -355,7 +994,7 @@ func (root *RootValue) PutSequences(ctx context.Context, seq *sequences.Collecti
 	if err != nil {
 		return nil, err
 	}
-	newStorage, err := root.st.SetSequences(ctx, ref.TargetHash())
+	newStorage, err := root.st.SetStorageParameters(ctx, ref.TargetHash())
 	if err != nil {
 		return nil, err
 	}
@@ -388,6 +1027,20 @@ func (root *RootValue) RemoveTables(
 	if len(tables) == 0 {
 		return root, nil
 	}
+	// Partitions are dropped alongside their partitioned table
+	partitionCollection, err := root.GetPartitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !partitionCollection.IsEmpty() {
+		for _, tableName := range tables {
+			for _, descendant := range partitionCollection.Descendants(tableName) {
+				if !slices.Contains(tables, descendant) {
+					tables = append(tables, descendant)
+				}
+			}
+		}
+	}
 
 	// TODO: support multiple schemas in the same set
 	tableMap, err := root.getTableMap(ctx, tables[0].Schema)
@@ -428,6 +1081,95 @@ func (root *RootValue) RemoveTables(
 	if err != nil {
 		return nil, err
 	}
+	storageParams, err := newRoot.GetStorageParameters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !storageParams.IsEmpty() {
+		for _, tableName := range tables {
+			storageParams.DropTable(tableName)
+		}
+		newRoot, err = newRoot.PutStorageParameters(ctx, storageParams)
+		if err != nil {
+			return nil, err
+		}
+	}
+	foreignData, err := newRoot.GetForeignData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !foreignData.IsEmpty() {
+		for _, tableName := range tables {
+			foreignData.DropTable(tableName)
+		}
+		newRoot, err = newRoot.PutForeignData(ctx, foreignData)
+		if err != nil {
+			return nil, err
+		}
+	}
+	triggerCollection, err := newRoot.GetTriggers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !triggerCollection.IsEmpty() {
+		for _, tableName := range tables {
+			triggerCollection.DropTable(tableName)
+		}
+		newRoot, err = newRoot.PutTriggers(ctx, triggerCollection)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !partitionCollection.IsEmpty() {
+		for _, tableName := range tables {
+			partitionCollection.DropTable(tableName)
+		}
+		newRoot, err = newRoot.PutPartitions(ctx, partitionCollection)
+		if err != nil {
+			return nil, err
+		}
+	}
+	exclusionCollection, err := newRoot.GetExclusionConstraints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exclusionCollection.IsEmpty() {
+		for _, tableName := range tables {
+			exclusionCollection.DropTable(tableName)
+		}
+		newRoot, err = newRoot.PutExclusionConstraints(ctx, exclusionCollection)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	unvalidatedCollection, err := newRoot.GetUnvalidatedConstraints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !unvalidatedCollection.IsEmpty() {
+		for _, tableName := range tables {
+			unvalidatedCollection.DropTable(tableName)
+		}
+		newRoot, err = newRoot.PutUnvalidatedConstraints(ctx, unvalidatedCollection)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	publicationCollection, err := newRoot.GetPublications(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !publicationCollection.IsEmpty() {
+		for _, tableName := range tables {
+			publicationCollection.DropTable(tableName)
+		}
+		newRoot, err = newRoot.PutPublications(ctx, publicationCollection)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if skipFKHandling {
 		return newRoot, nil
@@ -467,6 +1209,83 @@ func (root *RootValue) RenameTable(ctx context.Context, oldName, newName doltdb.
 	if err != nil {
 		return nil, err
 	}
+	storageParams, err := newRoot.GetStorageParameters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !storageParams.IsEmpty() {
+		storageParams.RenameTable(oldName, newName)
+		newRoot, err = newRoot.PutStorageParameters(ctx, storageParams)
+		if err != nil {
+			return nil, err
+		}
+	}
+	foreignData, err := newRoot.GetForeignData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !foreignData.IsEmpty() {
+		foreignData.RenameTable(oldName, newName)
+		newRoot, err = newRoot.PutForeignData(ctx, foreignData)
+		if err != nil {
+			return nil, err
+		}
+	}
+	triggerCollection, err := newRoot.GetTriggers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !triggerCollection.IsEmpty() {
+		triggerCollection.RenameTable(oldName, newName)
+		newRoot, err = newRoot.PutTriggers(ctx, triggerCollection)
+		if err != nil {
+			return nil, err
+		}
+	}
+	partitionCollection, err := newRoot.GetPartitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !partitionCollection.IsEmpty() {
+		partitionCollection.RenameTable(oldName, newName)
+		newRoot, err = newRoot.PutPartitions(ctx, partitionCollection)
+		if err != nil {
+			return nil, err
+		}
+	}
+	exclusionCollection, err := newRoot.GetExclusionConstraints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exclusionCollection.IsEmpty() {
+		exclusionCollection.RenameTable(oldName, newName)
+		newRoot, err = newRoot.PutExclusionConstraints(ctx, exclusionCollection)
+		if err != nil {
+			return nil, err
+		}
+	}
+	unvalidatedCollection, err := newRoot.GetUnvalidatedConstraints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !unvalidatedCollection.IsEmpty() {
+		unvalidatedCollection.RenameTable(oldName, newName)
+		newRoot, err = newRoot.PutUnvalidatedConstraints(ctx, unvalidatedCollection)
+		if err != nil {
+			return nil, err
+		}
+	}
+	publicationCollection, err := newRoot.GetPublications(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !publicationCollection.IsEmpty() {
+		publicationCollection.RenameTable(oldName, newName)
+		newRoot, err = newRoot.PutPublications(ctx, publicationCollection)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return newRoot, nil
 }
@@ -517,6 +1336,10 @@ func (root *RootValue) SetCollation(ctx context.Context, collation schema.Collat
 
 // SetFeatureVersion implements the interface doltdb.RootValue.
 func (root *RootValue) SetFeatureVersion(v doltdb.FeatureVersion) (doltdb.RootValue, error) {
+	// Dolt writes its own feature version into every root that it writes, so we replace it with ours
+	if v == doltdb.DoltFeatureVersion {
+		v = DoltgresFeatureVersion
+	}
 	newStorage, err := root.st.SetFeatureVersion(v)
 	if err != nil {
 		return nil, err
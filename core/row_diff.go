@@ -0,0 +1,168 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"io"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+// DiffTableRows calls the given function for every row that differs within the delta. Tables that were added or
+// dropped are compared against an empty table.
+func DiffTableRows(ctx context.Context, td diff.TableDelta, cb tree.DiffFn) error {
+	from, to, err := td.GetRowData(ctx)
+	if err != nil {
+		return err
+	}
+	if from == nil {
+		if from, err = durable.NewEmptyIndex(ctx, td.ToVRW, td.ToNodeStore, td.ToSch); err != nil {
+			return err
+		}
+	}
+	if to == nil {
+		if to, err = durable.NewEmptyIndex(ctx, td.FromVRW, td.FromNodeStore, td.FromSch); err != nil {
+			return err
+		}
+	}
+	// The trees are diffed directly, as prolly.DiffMaps compares modified values using the value descriptor, which
+	// does not yet handle the serialized form of our types within value tuples.
+	fromMap, toMap := durable.ProllyMapFromIndex(from), durable.ProllyMapFromIndex(to)
+	err = tree.DiffOrderedTrees(ctx, fromMap.Tuples(), toMap.Tuples(), false, cb)
+	if err == io.EOF {
+		return nil
+	}
+	return err
+}
+
+// RowCardinality returns the number of identical rows represented by the value tuple. Keyless tables begin their values
+// with the row's cardinality, while the rows of tables with a primary key are always unique.
+func RowCardinality(valDesc val.TupleDesc, keyless bool, value val.Tuple) uint64 {
+	if !keyless {
+		return 1
+	}
+	count, _ := valDesc.GetUint64(0, value)
+	return count
+}
+
+// RowDecoder reads the values of a table's columns from the key and value tuples of its rows.
+type RowDecoder struct {
+	keyDesc val.TupleDesc
+	valDesc val.TupleDesc
+	ns      tree.NodeStore
+	keyless bool
+	columns []schema.Column
+	fields  []rowField
+}
+
+// rowField is the position of a column within its key or value tuple.
+type rowField struct {
+	inKey bool
+	index int
+}
+
+// DecodedRowDiff is a row diff whose tuples have been decoded. The values are nil for the sides that do not have the
+// row. Keyless tables store a count of identical rows, so a single diff may represent several rows, which are given by
+// the counts of each side.
+type DecodedRowDiff struct {
+	Type        tree.DiffType
+	Before      []any
+	After       []any
+	BeforeCount uint64
+	AfterCount  uint64
+}
+
+// NewRowDecoder returns a decoder for the rows of the given schema, which reads every column in the order that they
+// were declared.
+func NewRowDecoder(sch schema.Schema, ns tree.NodeStore) *RowDecoder {
+	decoder := &RowDecoder{
+		keyDesc: sch.GetKeyDescriptor(),
+		valDesc: sch.GetValueDescriptor(),
+		ns:      ns,
+		keyless: schema.IsKeyless(sch),
+		columns: sch.GetAllCols().GetColumns(),
+	}
+	// Keyless tables begin their values with the row's cardinality
+	valueOffset := 0
+	if decoder.keyless {
+		valueOffset = 1
+	}
+	decoder.fields = make([]rowField, len(decoder.columns))
+	for i, col := range decoder.columns {
+		if index, ok := sch.GetPKCols().TagToIdx[col.Tag]; ok {
+			decoder.fields[i] = rowField{inKey: true, index: index}
+		} else {
+			decoder.fields[i] = rowField{index: sch.GetNonPKCols().TagToIdx[col.Tag] + valueOffset}
+		}
+	}
+	return decoder
+}
+
+// Columns returns the columns that are read, in the order that their values are returned.
+func (decoder *RowDecoder) Columns() []schema.Column {
+	return decoder.columns
+}
+
+// IsKeyless returns whether the rows belong to a keyless table.
+func (decoder *RowDecoder) IsKeyless() bool {
+	return decoder.keyless
+}
+
+// DecodeRow returns the values of the row with the given key and value tuples, in the order of the decoder's columns.
+func (decoder *RowDecoder) DecodeRow(ctx context.Context, key val.Tuple, value val.Tuple) ([]any, error) {
+	row := make([]any, len(decoder.fields))
+	for i, field := range decoder.fields {
+		var err error
+		if field.inKey {
+			row[i], err = tree.GetField(ctx, decoder.keyDesc, field.index, key, decoder.ns)
+		} else {
+			row[i], err = tree.GetField(ctx, decoder.valDesc, field.index, value, decoder.ns)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return row, nil
+}
+
+// Cardinality returns the number of identical rows represented by the value tuple.
+func (decoder *RowDecoder) Cardinality(value val.Tuple) uint64 {
+	return RowCardinality(decoder.valDesc, decoder.keyless, value)
+}
+
+// DecodeRowDiff decodes both sides of the given diff. The decoder of a side is only used when that side has the row,
+// so it may be nil for a table that does not exist on that side.
+func DecodeRowDiff(ctx context.Context, d tree.Diff, fromDecoder *RowDecoder, toDecoder *RowDecoder) (DecodedRowDiff, error) {
+	decoded := DecodedRowDiff{Type: d.Type}
+	var err error
+	if d.Type != tree.AddedDiff {
+		if decoded.Before, err = fromDecoder.DecodeRow(ctx, val.Tuple(d.Key), val.Tuple(d.From)); err != nil {
+			return DecodedRowDiff{}, err
+		}
+		decoded.BeforeCount = fromDecoder.Cardinality(val.Tuple(d.From))
+	}
+	if d.Type != tree.RemovedDiff {
+		if decoded.After, err = toDecoder.DecodeRow(ctx, val.Tuple(d.Key), val.Tuple(d.To)); err != nil {
+			return DecodedRowDiff{}, err
+		}
+		decoded.AfterCount = toDecoder.Cardinality(val.Tuple(d.To))
+	}
+	return decoded, nil
+}
@@ -37,6 +37,16 @@ const (
 	Persistence_Unlogged  Persistence = 2
 )
 
+// Identity controls whether a Sequence generates the values of an identity column, and whether the column accepts
+// other values.
+type Identity uint8
+
+const (
+	Identity_None      Identity = 0
+	Identity_Always    Identity = 1
+	Identity_ByDefault Identity = 2
+)
+
 // Sequence represents a single sequence within the pg_sequence table.
 type Sequence struct {
 	Name        string
@@ -53,6 +63,7 @@ type Sequence struct {
 	OwnerUser   string
 	OwnerTable  string
 	OwnerColumn string
+	Identity    Identity
 }
 
 // GetSequence returns the sequence with the given schema and name. Returns nil if the sequence cannot be found.
@@ -233,3 +244,14 @@ func (sequence *Sequence) nextValForSequence() (int64, error) {
 	}
 	return valueToReturn, nil
 }
+
+// isEmpty returns whether the collection does not contain any sequences. This does not lock the mutex, so the caller
+// must hold it.
+func (pgs *Collection) isEmpty() bool {
+	for _, nameMap := range pgs.schemaMap {
+		if len(nameMap) > 0 {
+			return false
+		}
+	}
+	return true
+}
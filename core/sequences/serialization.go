@@ -29,10 +29,14 @@ func (pgs *Collection) Serialize(ctx context.Context) ([]byte, error) {
 	}
 	pgs.mutex.Lock()
 	defer pgs.mutex.Unlock()
+	// An empty collection is not written, so that it matches a root that has never had any sequences
+	if pgs.isEmpty() {
+		return nil, nil
+	}
 
 	// Write all of the sequences to the writer
 	writer := utils.NewWriter(256)
-	writer.VariableUint(0) // Version
+	writer.VariableUint(1) // Version
 	schemaMapKeys := utils.GetMapKeysSorted(pgs.schemaMap)
 	writer.VariableUint(uint64(len(schemaMapKeys)))
 	for _, schemaMapKey := range schemaMapKeys {
@@ -56,6 +60,7 @@ func (pgs *Collection) Serialize(ctx context.Context) ([]byte, error) {
 			writer.String(sequence.OwnerUser)
 			writer.String(sequence.OwnerTable)
 			writer.String(sequence.OwnerColumn)
+			writer.Uint8(uint8(sequence.Identity))
 		}
 	}
 
@@ -74,7 +79,7 @@ func Deserialize(ctx context.Context, data []byte) (*Collection, error) {
 	schemaMap := make(map[string]map[string]*Sequence)
 	reader := utils.NewReader(data)
 	version := reader.VariableUint()
-	if version != 0 {
+	if version > 1 {
 		return nil, fmt.Errorf("version %d of sequences is not supported, please upgrade the server", version)
 	}
 
@@ -100,6 +105,9 @@ func Deserialize(ctx context.Context, data []byte) (*Collection, error) {
 			sequence.OwnerUser = reader.String()
 			sequence.OwnerTable = reader.String()
 			sequence.OwnerColumn = reader.String()
+			if version >= 1 {
+				sequence.Identity = Identity(reader.Uint8())
+			}
 			nameMap[sequence.Name] = sequence
 		}
 		schemaMap[schemaName] = nameMap
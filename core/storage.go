@@ -38,6 +38,10 @@ type rootStorage struct {
 
 // SetSequences sets the sequence hash and returns a new storage object.
 func (r rootStorage) SetSequences(ctx context.Context, h hash.Hash) (rootStorage, error) {
+	// Roots without sequences do not store a hash, so an empty hash leaves them unchanged
+	if h.IsEmpty() && len(r.srv.SequencesBytes()) == 0 {
+		return r, nil
+	}
 	if len(r.srv.SequencesBytes()) > 0 {
 		ret := r.clone()
 		copy(ret.srv.SequencesBytes(), h[:])
@@ -47,7 +51,7 @@ func (r rootStorage) SetSequences(ctx context.Context, h hash.Hash) (rootStorage
 		if err != nil {
 			return rootStorage{}, err
 		}
-		msg, err := r.serializeRootValue(r.srv.TablesBytes(), dbSchemas, h[:])
+		msg, err := r.serializeRootValue(r.srv.TablesBytes(), dbSchemas, h[:], r.srv.FunctionsBytes(), r.srv.MaskingPoliciesBytes(), r.srv.StorageParametersBytes(), r.srv.ForeignDataBytes(), r.srv.TriggersBytes(), r.srv.PartitionsBytes(), r.srv.ExclusionConstraintsBytes(), r.srv.UnvalidatedConstraintsBytes(), r.srv.PublicationsBytes())
 		if err != nil {
 			return rootStorage{}, err
 		}
@@ -109,13 +113,265 @@ func (r rootStorage) GetSchemas(ctx context.Context) ([]schema.DatabaseSchema, e
 
 // SetSchemas sets the given schemas and returns a new storage object.
 func (r rootStorage) SetSchemas(ctx context.Context, dbSchemas []schema.DatabaseSchema) (rootStorage, error) {
-	msg, err := r.serializeRootValue(r.srv.TablesBytes(), dbSchemas, r.srv.SequencesBytes())
+	msg, err := r.serializeRootValue(r.srv.TablesBytes(), dbSchemas, r.srv.SequencesBytes(), r.srv.FunctionsBytes(), r.srv.MaskingPoliciesBytes(), r.srv.StorageParametersBytes(), r.srv.ForeignDataBytes(), r.srv.TriggersBytes(), r.srv.PartitionsBytes(), r.srv.ExclusionConstraintsBytes(), r.srv.UnvalidatedConstraintsBytes(), r.srv.PublicationsBytes())
 	if err != nil {
 		return rootStorage{}, err
 	}
 	return rootStorage{msg}, nil
 }
 
+// SetFunctions sets the function hash and returns a new storage object.
+func (r rootStorage) SetFunctions(ctx context.Context, h hash.Hash) (rootStorage, error) {
+	if len(r.srv.FunctionsBytes()) > 0 {
+		ret := r.clone()
+		copy(ret.srv.FunctionsBytes(), h[:])
+		return ret, nil
+	} else {
+		dbSchemas, err := r.GetSchemas(ctx)
+		if err != nil {
+			return rootStorage{}, err
+		}
+		msg, err := r.serializeRootValue(r.srv.TablesBytes(), dbSchemas, r.srv.SequencesBytes(), h[:], r.srv.MaskingPoliciesBytes(), r.srv.StorageParametersBytes(), r.srv.ForeignDataBytes(), r.srv.TriggersBytes(), r.srv.PartitionsBytes(), r.srv.ExclusionConstraintsBytes(), r.srv.UnvalidatedConstraintsBytes(), r.srv.PublicationsBytes())
+		if err != nil {
+			return rootStorage{}, err
+		}
+		return rootStorage{msg}, nil
+	}
+}
+
+// GetFunctions returns the function hash.
+func (r rootStorage) GetFunctions() hash.Hash {
+	hashBytes := r.srv.FunctionsBytes()
+	if len(hashBytes) == 0 {
+		return hash.Hash{}
+	}
+	return hash.New(hashBytes)
+}
+
+// SetMaskingPolicies sets the masking policy hash and returns a new storage object.
+func (r rootStorage) SetMaskingPolicies(ctx context.Context, h hash.Hash) (rootStorage, error) {
+	if len(r.srv.MaskingPoliciesBytes()) > 0 {
+		ret := r.clone()
+		copy(ret.srv.MaskingPoliciesBytes(), h[:])
+		return ret, nil
+	} else {
+		dbSchemas, err := r.GetSchemas(ctx)
+		if err != nil {
+			return rootStorage{}, err
+		}
+		msg, err := r.serializeRootValue(r.srv.TablesBytes(), dbSchemas, r.srv.SequencesBytes(), r.srv.FunctionsBytes(), h[:], r.srv.StorageParametersBytes(), r.srv.ForeignDataBytes(), r.srv.TriggersBytes(), r.srv.PartitionsBytes(), r.srv.ExclusionConstraintsBytes(), r.srv.UnvalidatedConstraintsBytes(), r.srv.PublicationsBytes())
+		if err != nil {
+			return rootStorage{}, err
+		}
+		return rootStorage{msg}, nil
+	}
+}
+
+// GetMaskingPolicies returns the masking policy hash.
+func (r rootStorage) GetMaskingPolicies() hash.Hash {
+	hashBytes := r.srv.MaskingPoliciesBytes()
+	if len(hashBytes) == 0 {
+		return hash.Hash{}
+	}
+	return hash.New(hashBytes)
+}
+
+// SetStorageParameters sets the storage parameter hash and returns a new storage object.
+func (r rootStorage) SetStorageParameters(ctx context.Context, h hash.Hash) (rootStorage, error) {
+	if len(r.srv.StorageParametersBytes()) > 0 {
+		ret := r.clone()
+		copy(ret.srv.StorageParametersBytes(), h[:])
+		return ret, nil
+	} else {
+		dbSchemas, err := r.GetSchemas(ctx)
+		if err != nil {
+			return rootStorage{}, err
+		}
+		msg, err := r.serializeRootValue(r.srv.TablesBytes(), dbSchemas, r.srv.SequencesBytes(), r.srv.FunctionsBytes(), r.srv.MaskingPoliciesBytes(), h[:], r.srv.ForeignDataBytes(), r.srv.TriggersBytes(), r.srv.PartitionsBytes(), r.srv.ExclusionConstraintsBytes(), r.srv.UnvalidatedConstraintsBytes(), r.srv.PublicationsBytes())
+		if err != nil {
+			return rootStorage{}, err
+		}
+		return rootStorage{msg}, nil
+	}
+}
+
+// GetStorageParameters returns the storage parameter hash.
+func (r rootStorage) GetStorageParameters() hash.Hash {
+	hashBytes := r.srv.StorageParametersBytes()
+	if len(hashBytes) == 0 {
+		return hash.Hash{}
+	}
+	return hash.New(hashBytes)
+}
+
+// SetForeignData sets the foreign data hash and returns a new storage object.
+func (r rootStorage) SetForeignData(ctx context.Context, h hash.Hash) (rootStorage, error) {
+	if len(r.srv.ForeignDataBytes()) > 0 {
+		ret := r.clone()
+		copy(ret.srv.ForeignDataBytes(), h[:])
+		return ret, nil
+	} else {
+		dbSchemas, err := r.GetSchemas(ctx)
+		if err != nil {
+			return rootStorage{}, err
+		}
+		msg, err := r.serializeRootValue(r.srv.TablesBytes(), dbSchemas, r.srv.SequencesBytes(), r.srv.FunctionsBytes(), r.srv.MaskingPoliciesBytes(), r.srv.StorageParametersBytes(), h[:], r.srv.TriggersBytes(), r.srv.PartitionsBytes(), r.srv.ExclusionConstraintsBytes(), r.srv.UnvalidatedConstraintsBytes(), r.srv.PublicationsBytes())
+		if err != nil {
+			return rootStorage{}, err
+		}
+		return rootStorage{msg}, nil
+	}
+}
+
+// SetTriggers sets the triggers hash and returns a new storage object.
+func (r rootStorage) SetTriggers(ctx context.Context, h hash.Hash) (rootStorage, error) {
+	if len(r.srv.TriggersBytes()) > 0 {
+		ret := r.clone()
+		copy(ret.srv.TriggersBytes(), h[:])
+		return ret, nil
+	} else {
+		dbSchemas, err := r.GetSchemas(ctx)
+		if err != nil {
+			return rootStorage{}, err
+		}
+		msg, err := r.serializeRootValue(r.srv.TablesBytes(), dbSchemas, r.srv.SequencesBytes(), r.srv.FunctionsBytes(), r.srv.MaskingPoliciesBytes(), r.srv.StorageParametersBytes(), r.srv.ForeignDataBytes(), h[:], r.srv.PartitionsBytes(), r.srv.ExclusionConstraintsBytes(), r.srv.UnvalidatedConstraintsBytes(), r.srv.PublicationsBytes())
+		if err != nil {
+			return rootStorage{}, err
+		}
+		return rootStorage{msg}, nil
+	}
+}
+
+// SetPartitions sets the partitions hash and returns a new storage object.
+func (r rootStorage) SetPartitions(ctx context.Context, h hash.Hash) (rootStorage, error) {
+	if len(r.srv.PartitionsBytes()) > 0 {
+		ret := r.clone()
+		copy(ret.srv.PartitionsBytes(), h[:])
+		return ret, nil
+	} else {
+		dbSchemas, err := r.GetSchemas(ctx)
+		if err != nil {
+			return rootStorage{}, err
+		}
+		msg, err := r.serializeRootValue(r.srv.TablesBytes(), dbSchemas, r.srv.SequencesBytes(), r.srv.FunctionsBytes(), r.srv.MaskingPoliciesBytes(), r.srv.StorageParametersBytes(), r.srv.ForeignDataBytes(), r.srv.TriggersBytes(), h[:], r.srv.ExclusionConstraintsBytes(), r.srv.UnvalidatedConstraintsBytes(), r.srv.PublicationsBytes())
+		if err != nil {
+			return rootStorage{}, err
+		}
+		return rootStorage{msg}, nil
+	}
+}
+
+// SetExclusionConstraints sets the exclusion constraints hash and returns a new storage object.
+func (r rootStorage) SetExclusionConstraints(ctx context.Context, h hash.Hash) (rootStorage, error) {
+	if len(r.srv.ExclusionConstraintsBytes()) > 0 {
+		ret := r.clone()
+		copy(ret.srv.ExclusionConstraintsBytes(), h[:])
+		return ret, nil
+	} else {
+		dbSchemas, err := r.GetSchemas(ctx)
+		if err != nil {
+			return rootStorage{}, err
+		}
+		msg, err := r.serializeRootValue(r.srv.TablesBytes(), dbSchemas, r.srv.SequencesBytes(), r.srv.FunctionsBytes(), r.srv.MaskingPoliciesBytes(), r.srv.StorageParametersBytes(), r.srv.ForeignDataBytes(), r.srv.TriggersBytes(), r.srv.PartitionsBytes(), h[:], r.srv.UnvalidatedConstraintsBytes(), r.srv.PublicationsBytes())
+		if err != nil {
+			return rootStorage{}, err
+		}
+		return rootStorage{msg}, nil
+	}
+}
+
+// GetExclusionConstraints returns the exclusion constraints hash.
+func (r rootStorage) GetExclusionConstraints() hash.Hash {
+	hashBytes := r.srv.ExclusionConstraintsBytes()
+	if len(hashBytes) == 0 {
+		return hash.Hash{}
+	}
+	return hash.New(hashBytes)
+}
+
+// SetUnvalidatedConstraints sets the unvalidated constraints hash and returns a new storage object.
+func (r rootStorage) SetUnvalidatedConstraints(ctx context.Context, h hash.Hash) (rootStorage, error) {
+	if len(r.srv.UnvalidatedConstraintsBytes()) > 0 {
+		ret := r.clone()
+		copy(ret.srv.UnvalidatedConstraintsBytes(), h[:])
+		return ret, nil
+	} else {
+		dbSchemas, err := r.GetSchemas(ctx)
+		if err != nil {
+			return rootStorage{}, err
+		}
+		msg, err := r.serializeRootValue(r.srv.TablesBytes(), dbSchemas, r.srv.SequencesBytes(), r.srv.FunctionsBytes(), r.srv.MaskingPoliciesBytes(), r.srv.StorageParametersBytes(), r.srv.ForeignDataBytes(), r.srv.TriggersBytes(), r.srv.PartitionsBytes(), r.srv.ExclusionConstraintsBytes(), h[:], r.srv.PublicationsBytes())
+		if err != nil {
+			return rootStorage{}, err
+		}
+		return rootStorage{msg}, nil
+	}
+}
+
+// GetUnvalidatedConstraints returns the unvalidated constraints hash.
+func (r rootStorage) GetUnvalidatedConstraints() hash.Hash {
+	hashBytes := r.srv.UnvalidatedConstraintsBytes()
+	if len(hashBytes) == 0 {
+		return hash.Hash{}
+	}
+	return hash.New(hashBytes)
+}
+
+// SetPublications sets the publications hash and returns a new storage object.
+func (r rootStorage) SetPublications(ctx context.Context, h hash.Hash) (rootStorage, error) {
+	if len(r.srv.PublicationsBytes()) > 0 {
+		ret := r.clone()
+		copy(ret.srv.PublicationsBytes(), h[:])
+		return ret, nil
+	} else {
+		dbSchemas, err := r.GetSchemas(ctx)
+		if err != nil {
+			return rootStorage{}, err
+		}
+		msg, err := r.serializeRootValue(r.srv.TablesBytes(), dbSchemas, r.srv.SequencesBytes(), r.srv.FunctionsBytes(), r.srv.MaskingPoliciesBytes(), r.srv.StorageParametersBytes(), r.srv.ForeignDataBytes(), r.srv.TriggersBytes(), r.srv.PartitionsBytes(), r.srv.ExclusionConstraintsBytes(), r.srv.UnvalidatedConstraintsBytes(), h[:])
+		if err != nil {
+			return rootStorage{}, err
+		}
+		return rootStorage{msg}, nil
+	}
+}
+
+// GetPublications returns the publications hash.
+func (r rootStorage) GetPublications() hash.Hash {
+	hashBytes := r.srv.PublicationsBytes()
+	if len(hashBytes) == 0 {
+		return hash.Hash{}
+	}
+	return hash.New(hashBytes)
+}
+
+// GetPartitions returns the partitions hash.
+func (r rootStorage) GetPartitions() hash.Hash {
+	hashBytes := r.srv.PartitionsBytes()
+	if len(hashBytes) == 0 {
+		return hash.Hash{}
+	}
+	return hash.New(hashBytes)
+}
+
+// GetTriggers returns the triggers hash.
+func (r rootStorage) GetTriggers() hash.Hash {
+	hashBytes := r.srv.TriggersBytes()
+	if len(hashBytes) == 0 {
+		return hash.Hash{}
+	}
+	return hash.New(hashBytes)
+}
+
+// GetForeignData returns the foreign data hash.
+func (r rootStorage) GetForeignData() hash.Hash {
+	hashBytes := r.srv.ForeignDataBytes()
+	if len(hashBytes) == 0 {
+		return hash.Hash{}
+	}
+	return hash.New(hashBytes)
+}
+
 // GetSequences returns the sequence hash.
 func (r rootStorage) GetSequences() hash.Hash {
 	hashBytes := r.srv.SequencesBytes()
@@ -203,7 +459,7 @@ func (r rootStorage) EditTablesMap(ctx context.Context, vrw types.ValueReadWrite
 	ae := am.Editor()
 	for _, e := range edits {
 		if e.old_name.Name != "" {
-			oldaddr, err := am.Get(ctx, e.old_name.Name)
+			oldaddr, err := am.Get(ctx, encodeTableNameForAddressMap(e.old_name))
 			if err != nil {
 				return rootStorage{}, err
 			}
@@ -217,7 +473,7 @@ func (r rootStorage) EditTablesMap(ctx context.Context, vrw types.ValueReadWrite
 			if !newaddr.IsEmpty() {
 				return rootStorage{}, doltdb.ErrTableExists
 			}
-			err = ae.Delete(ctx, e.old_name.Name)
+			err = ae.Delete(ctx, encodeTableNameForAddressMap(e.old_name))
 			if err != nil {
 				return rootStorage{}, err
 			}
@@ -243,14 +499,66 @@ func (r rootStorage) EditTablesMap(ctx context.Context, vrw types.ValueReadWrite
 	if err != nil {
 		return rootStorage{}, err
 	}
+	return r.setAddressMap(ctx, am)
+}
 
+// migrateUnqualifiedTableNames moves the tables that are stored without a schema into the default schema. Roots that
+// were written before feature version 8 stored the tables that Dolt creates without a schema (such as dolt_schemas)
+// under their bare name, while every table is now stored under a schema-qualified name.
+func (r rootStorage) migrateUnqualifiedTableNames(ctx context.Context, vrw types.ValueReadWriter, ns tree.NodeStore) (rootStorage, error) {
+	am, err := r.getAddressMap(vrw, ns)
+	if err != nil {
+		return rootStorage{}, err
+	}
+	var names []string
+	var addrs []hash.Hash
+	err = am.IterAll(ctx, func(name string, addr hash.Hash) error {
+		if len(name) > 0 && name[0] != 0 {
+			names = append(names, name)
+			addrs = append(addrs, addr)
+		}
+		return nil
+	})
+	if err != nil {
+		return rootStorage{}, err
+	}
+	if len(names) == 0 {
+		return r, nil
+	}
+	ae := am.Editor()
+	for i, name := range names {
+		encodedName := encodeTableNameForAddressMap(doltdb.TableName{Name: name})
+		existing, err := am.Get(ctx, encodedName)
+		if err != nil {
+			return rootStorage{}, err
+		}
+		if !existing.IsEmpty() {
+			return rootStorage{}, fmt.Errorf("cannot migrate table `%s`, as it also exists within the schema `%s`",
+				name, doltdb.DefaultSchemaName)
+		}
+		if err = ae.Delete(ctx, name); err != nil {
+			return rootStorage{}, err
+		}
+		if err = ae.Update(ctx, encodedName, addrs[i]); err != nil {
+			return rootStorage{}, err
+		}
+	}
+	am, err = ae.Flush(ctx)
+	if err != nil {
+		return rootStorage{}, err
+	}
+	return r.setAddressMap(ctx, am)
+}
+
+// setAddressMap sets the tables map and returns a new storage object.
+func (r rootStorage) setAddressMap(ctx context.Context, am prolly.AddressMap) (rootStorage, error) {
 	ambytes := []byte(tree.ValueFromNode(am.Node()).(types.SerialMessage))
 	dbSchemas, err := r.GetSchemas(ctx)
 	if err != nil {
 		return rootStorage{}, err
 	}
 
-	msg, err := r.serializeRootValue(ambytes, dbSchemas, r.srv.SequencesBytes())
+	msg, err := r.serializeRootValue(ambytes, dbSchemas, r.srv.SequencesBytes(), r.srv.FunctionsBytes(), r.srv.MaskingPoliciesBytes(), r.srv.StorageParametersBytes(), r.srv.ForeignDataBytes(), r.srv.TriggersBytes(), r.srv.PartitionsBytes(), r.srv.ExclusionConstraintsBytes(), r.srv.UnvalidatedConstraintsBytes(), r.srv.PublicationsBytes())
 	if err != nil {
 		return rootStorage{}, err
 	}
@@ -258,12 +566,48 @@ func (r rootStorage) EditTablesMap(ctx context.Context, vrw types.ValueReadWrite
 }
 
 // serializeRootValue serializes a new serial.RootValue object.
-func (r rootStorage) serializeRootValue(addressMapBytes []byte, dbSchemas []schema.DatabaseSchema, seqHash []byte) (*serial.RootValue, error) {
+func (r rootStorage) serializeRootValue(addressMapBytes []byte, dbSchemas []schema.DatabaseSchema, seqHash []byte, funcHash []byte, maskingHash []byte, storageParamsHash []byte, foreignDataHash []byte, triggersHash []byte, partitionsHash []byte, exclusionsHash []byte, unvalidatedHash []byte, publicationsHash []byte) (*serial.RootValue, error) {
 	builder := flatbuffers.NewBuilder(80)
 	tablesOffset := builder.CreateByteVector(addressMapBytes)
 	schemasOffset := serializeDatabaseSchemas(builder, dbSchemas)
 	fkOffset := builder.CreateByteVector(r.srv.ForeignKeyAddrBytes())
 	seqOffset := builder.CreateByteVector(seqHash)
+	var funcOffset flatbuffers.UOffsetT
+	if len(funcHash) > 0 {
+		funcOffset = builder.CreateByteVector(funcHash)
+	}
+	var maskingOffset flatbuffers.UOffsetT
+	if len(maskingHash) > 0 {
+		maskingOffset = builder.CreateByteVector(maskingHash)
+	}
+	var storageParamsOffset flatbuffers.UOffsetT
+	if len(storageParamsHash) > 0 {
+		storageParamsOffset = builder.CreateByteVector(storageParamsHash)
+	}
+	var foreignDataOffset flatbuffers.UOffsetT
+	if len(foreignDataHash) > 0 {
+		foreignDataOffset = builder.CreateByteVector(foreignDataHash)
+	}
+	var triggersOffset flatbuffers.UOffsetT
+	if len(triggersHash) > 0 {
+		triggersOffset = builder.CreateByteVector(triggersHash)
+	}
+	var partitionsOffset flatbuffers.UOffsetT
+	if len(partitionsHash) > 0 {
+		partitionsOffset = builder.CreateByteVector(partitionsHash)
+	}
+	var exclusionsOffset flatbuffers.UOffsetT
+	if len(exclusionsHash) > 0 {
+		exclusionsOffset = builder.CreateByteVector(exclusionsHash)
+	}
+	var unvalidatedOffset flatbuffers.UOffsetT
+	if len(unvalidatedHash) > 0 {
+		unvalidatedOffset = builder.CreateByteVector(unvalidatedHash)
+	}
+	var publicationsOffset flatbuffers.UOffsetT
+	if len(publicationsHash) > 0 {
+		publicationsOffset = builder.CreateByteVector(publicationsHash)
+	}
 
 	serial.RootValueStart(builder)
 	serial.RootValueAddFeatureVersion(builder, r.srv.FeatureVersion())
@@ -271,6 +615,33 @@ func (r rootStorage) serializeRootValue(addressMapBytes []byte, dbSchemas []sche
 	serial.RootValueAddTables(builder, tablesOffset)
 	serial.RootValueAddForeignKeyAddr(builder, fkOffset)
 	serial.RootValueAddSequences(builder, seqOffset)
+	if funcOffset > 0 {
+		serial.RootValueAddFunctions(builder, funcOffset)
+	}
+	if maskingOffset > 0 {
+		serial.RootValueAddMaskingPolicies(builder, maskingOffset)
+	}
+	if storageParamsOffset > 0 {
+		serial.RootValueAddStorageParameters(builder, storageParamsOffset)
+	}
+	if foreignDataOffset > 0 {
+		serial.RootValueAddForeignData(builder, foreignDataOffset)
+	}
+	if triggersOffset > 0 {
+		serial.RootValueAddTriggers(builder, triggersOffset)
+	}
+	if partitionsOffset > 0 {
+		serial.RootValueAddPartitions(builder, partitionsOffset)
+	}
+	if exclusionsOffset > 0 {
+		serial.RootValueAddExclusionConstraints(builder, exclusionsOffset)
+	}
+	if unvalidatedOffset > 0 {
+		serial.RootValueAddUnvalidatedConstraints(builder, unvalidatedOffset)
+	}
+	if publicationsOffset > 0 {
+		serial.RootValueAddPublications(builder, publicationsOffset)
+	}
 	if schemasOffset > 0 {
 		serial.RootValueAddSchemas(builder, schemasOffset)
 	}
@@ -307,21 +678,24 @@ func serializeDatabaseSchemas(b *flatbuffers.Builder, dbSchemas []schema.Databas
 	return b.EndVector(len(offsets))
 }
 
-// encodeTableNameForAddressMap encodes the given table name for writing into storage.
+// encodeTableNameForAddressMap encodes the given table name for writing into storage. Names without a schema are
+// written to the default schema.
 func encodeTableNameForAddressMap(name doltdb.TableName) string {
 	if name.Schema == "" {
-		return name.Name
+		name.Schema = doltdb.DefaultSchemaName
 	}
 	return fmt.Sprintf("\000%s\000%s", name.Schema, name.Name)
 }
 
 // decodeTableNameForAddressMap decodes a previously-encoded table name from storage.
 func decodeTableNameForAddressMap(encodedName, schemaName string) (string, bool) {
-	if schemaName == "" && encodedName[0] != 0 {
-		return encodedName, true
-	} else if schemaName != "" && encodedName[0] == 0 &&
-		len(encodedName) > len(schemaName)+2 &&
-		encodedName[1:len(schemaName)+1] == schemaName {
+	if schemaName == "" {
+		schemaName = doltdb.DefaultSchemaName
+	}
+	if len(encodedName) > len(schemaName)+2 &&
+		encodedName[0] == 0 &&
+		encodedName[1:len(schemaName)+1] == schemaName &&
+		encodedName[len(schemaName)+1] == 0 {
 		return encodedName[len(schemaName)+2:], true
 	}
 	return "", false
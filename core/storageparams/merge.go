@@ -0,0 +1,53 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storageparams
+
+import (
+	"context"
+	"maps"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+// Merge handles merging storage parameters on our root and their root. Each table's parameters are merged as a whole:
+// when only their side changed a table's parameters, their parameters are taken, and otherwise ours are kept.
+func Merge(ctx context.Context, ourCollection, theirCollection, ancCollection *Collection) (*Collection, error) {
+	mergedCollection := ourCollection.Clone()
+	err := theirCollection.IterateTables(func(table doltdb.TableName, theirParams map[string]string) error {
+		ourParams := mergedCollection.tables[table]
+		ancParams := ancCollection.GetParameters(table)
+		if maps.Equal(ourParams, ancParams) && !maps.Equal(theirParams, ancParams) {
+			mergedCollection.tables[table] = maps.Clone(theirParams)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Parameters that were reset on their side are removed from the merged collection, as long as we didn't change them
+	err = ourCollection.IterateTables(func(table doltdb.TableName, ourParams map[string]string) error {
+		if _, ok := theirCollection.tables[table]; ok {
+			return nil
+		}
+		if maps.Equal(ourParams, ancCollection.GetParameters(table)) {
+			delete(mergedCollection.tables, table)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mergedCollection, nil
+}
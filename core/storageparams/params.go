@@ -0,0 +1,219 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storageparams
+
+import (
+	"fmt"
+	"maps"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+// Parameter names that are accepted by CREATE TABLE ... WITH and ALTER TABLE ... SET.
+const (
+	// Compression chooses how the table's chunks are compressed. The chunk store currently compresses every chunk the
+	// same way regardless of the table that it belongs to, so this is recorded with the table but is not yet applied
+	// when its chunks are written.
+	Compression = "compression"
+	// CompressionLevel is the zstd level used when the compression is zstd.
+	CompressionLevel = "compression_level"
+)
+
+// Values of the compression parameter.
+const (
+	CompressionDefault = "default"
+	CompressionOff     = "off"
+	CompressionZstd    = "zstd"
+)
+
+// TableOptionPrefix is prepended to the name of each storage parameter when it is carried through CREATE TABLE as a
+// table option, which distinguishes it from the table options that the engine itself understands.
+const TableOptionPrefix = "storage_parameter."
+
+// Collection contains the storage parameters of every table that has any set.
+type Collection struct {
+	tables map[doltdb.TableName]map[string]string
+	mutex  *sync.Mutex
+}
+
+// CheckName returns an error if the parameter is not recognized.
+func CheckName(name string) error {
+	switch strings.ToLower(name) {
+	case Compression, CompressionLevel:
+		return nil
+	default:
+		return fmt.Errorf(`unrecognized parameter "%s"`, name)
+	}
+}
+
+// Validate checks that the parameter is recognized and that its value is valid, returning the normalized value.
+func Validate(name string, value string) (string, error) {
+	if err := CheckName(name); err != nil {
+		return "", err
+	}
+	switch strings.ToLower(name) {
+	case Compression:
+		value = strings.ToLower(value)
+		switch value {
+		case CompressionDefault, CompressionOff, CompressionZstd:
+			return value, nil
+		default:
+			return "", fmt.Errorf(`invalid value for enum option "%s": %s`, Compression, value)
+		}
+	case CompressionLevel:
+		level, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return "", fmt.Errorf(`invalid value for integer option "%s": %s`, CompressionLevel, value)
+		}
+		if level < 1 || level > 22 {
+			return "", fmt.Errorf(`value %s out of bounds for option "%s"`, value, CompressionLevel)
+		}
+		return strconv.FormatInt(level, 10), nil
+	default:
+		return value, nil
+	}
+}
+
+// GetParameters returns a copy of the parameters that are set on the given table. Returns an empty map if the table has
+// no parameters.
+func (pgsp *Collection) GetParameters(table doltdb.TableName) map[string]string {
+	pgsp.mutex.Lock()
+	defer pgsp.mutex.Unlock()
+	return maps.Clone(pgsp.tables[table])
+}
+
+// SetParameters sets the given parameters on the table, leaving any other parameters unchanged. The values must have
+// already been validated.
+func (pgsp *Collection) SetParameters(table doltdb.TableName, params map[string]string) error {
+	pgsp.mutex.Lock()
+	defer pgsp.mutex.Unlock()
+
+	merged := maps.Clone(pgsp.tables[table])
+	if merged == nil {
+		merged = make(map[string]string, len(params))
+	}
+	for name, value := range params {
+		merged[strings.ToLower(name)] = value
+	}
+	if err := checkCombination(merged); err != nil {
+		return err
+	}
+	pgsp.tables[table] = merged
+	return nil
+}
+
+// ResetParameters removes the given parameters from the table, so that they return to their defaults.
+func (pgsp *Collection) ResetParameters(table doltdb.TableName, names []string) error {
+	pgsp.mutex.Lock()
+	defer pgsp.mutex.Unlock()
+
+	remaining := maps.Clone(pgsp.tables[table])
+	for _, name := range names {
+		delete(remaining, strings.ToLower(name))
+	}
+	if err := checkCombination(remaining); err != nil {
+		return err
+	}
+	if len(remaining) == 0 {
+		delete(pgsp.tables, table)
+	} else {
+		pgsp.tables[table] = remaining
+	}
+	return nil
+}
+
+// DropTable removes every parameter belonging to the table.
+func (pgsp *Collection) DropTable(table doltdb.TableName) {
+	pgsp.mutex.Lock()
+	defer pgsp.mutex.Unlock()
+	delete(pgsp.tables, table)
+}
+
+// RenameTable moves the parameters of the old table to the new table.
+func (pgsp *Collection) RenameTable(oldName doltdb.TableName, newName doltdb.TableName) {
+	pgsp.mutex.Lock()
+	defer pgsp.mutex.Unlock()
+	if params, ok := pgsp.tables[oldName]; ok {
+		delete(pgsp.tables, oldName)
+		pgsp.tables[newName] = params
+	}
+}
+
+// IsEmpty returns whether the collection contains any parameters.
+func (pgsp *Collection) IsEmpty() bool {
+	pgsp.mutex.Lock()
+	defer pgsp.mutex.Unlock()
+	return len(pgsp.tables) == 0
+}
+
+// IterateTables iterates over every table that has parameters, in order of the schema and table names.
+func (pgsp *Collection) IterateTables(f func(table doltdb.TableName, params map[string]string) error) error {
+	pgsp.mutex.Lock()
+	defer pgsp.mutex.Unlock()
+
+	tables := make([]doltdb.TableName, 0, len(pgsp.tables))
+	for table := range pgsp.tables {
+		tables = append(tables, table)
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		if tables[i].Schema != tables[j].Schema {
+			return tables[i].Schema < tables[j].Schema
+		}
+		return tables[i].Name < tables[j].Name
+	})
+	for _, table := range tables {
+		if err := f(table, pgsp.tables[table]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Clone returns a new *Collection with the same contents as the original.
+func (pgsp *Collection) Clone() *Collection {
+	pgsp.mutex.Lock()
+	defer pgsp.mutex.Unlock()
+
+	newCollection := &Collection{
+		tables: make(map[doltdb.TableName]map[string]string, len(pgsp.tables)),
+		mutex:  &sync.Mutex{},
+	}
+	for table, params := range pgsp.tables {
+		newCollection.tables[table] = maps.Clone(params)
+	}
+	return newCollection
+}
+
+// Format returns the parameters in the "name=value" form that Postgres uses for reloptions, sorted by name.
+func Format(params map[string]string) []string {
+	formatted := make([]string, 0, len(params))
+	for name, value := range params {
+		formatted = append(formatted, name+"="+value)
+	}
+	sort.Strings(formatted)
+	return formatted
+}
+
+// checkCombination returns an error if the parameters are individually valid but cannot be used together.
+func checkCombination(params map[string]string) error {
+	if _, ok := params[CompressionLevel]; ok && params[Compression] != CompressionZstd {
+		return fmt.Errorf(`"%s" may only be set when "%s" is %s`, CompressionLevel, Compression, CompressionZstd)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storageparams
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// Serialize returns the Collection as a byte slice. If the Collection is nil, then this returns a nil slice.
+func (pgsp *Collection) Serialize(ctx context.Context) ([]byte, error) {
+	if pgsp == nil {
+		return nil, nil
+	}
+
+	// Write all of the tables to the writer
+	writer := utils.NewWriter(256)
+	writer.VariableUint(0) // Version
+	var numOfTables uint64
+	_ = pgsp.IterateTables(func(table doltdb.TableName, params map[string]string) error {
+		numOfTables++
+		return nil
+	})
+	writer.VariableUint(numOfTables)
+	_ = pgsp.IterateTables(func(table doltdb.TableName, params map[string]string) error {
+		writer.String(table.Schema)
+		writer.String(table.Name)
+		names := utils.GetMapKeysSorted(params)
+		writer.VariableUint(uint64(len(names)))
+		for _, name := range names {
+			writer.String(name)
+			writer.String(params[name])
+		}
+		return nil
+	})
+
+	return writer.Data(), nil
+}
+
+// Deserialize returns the Collection that was serialized in the byte slice. Returns an empty Collection if data is nil
+// or empty.
+func Deserialize(ctx context.Context, data []byte) (*Collection, error) {
+	if len(data) == 0 {
+		return &Collection{
+			tables: make(map[doltdb.TableName]map[string]string),
+			mutex:  &sync.Mutex{},
+		}, nil
+	}
+	tables := make(map[doltdb.TableName]map[string]string)
+	reader := utils.NewReader(data)
+	version := reader.VariableUint()
+	if version != 0 {
+		return nil, fmt.Errorf("version %d of storage parameters is not supported, please upgrade the server", version)
+	}
+
+	// Read from the reader
+	numOfTables := reader.VariableUint()
+	for i := uint64(0); i < numOfTables; i++ {
+		table := doltdb.TableName{}
+		table.Schema = reader.String()
+		table.Name = reader.String()
+		numOfParams := reader.VariableUint()
+		params := make(map[string]string, numOfParams)
+		for j := uint64(0); j < numOfParams; j++ {
+			name := reader.String()
+			params[name] = reader.String()
+		}
+		tables[table] = params
+	}
+	if !reader.IsEmpty() {
+		return nil, fmt.Errorf("extra data found while deserializing storage parameters")
+	}
+
+	// Return the deserialized object
+	return &Collection{
+		tables: tables,
+		mutex:  &sync.Mutex{},
+	}, nil
+}
@@ -0,0 +1,257 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package triggers
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+// Timing is when a trigger fires, relative to the operation on the row.
+type Timing uint8
+
+const (
+	Timing_Before Timing = 0
+	Timing_After  Timing = 1
+)
+
+// Event is an operation that fires a trigger. A trigger may fire for multiple events, so these are combined as flags.
+type Event uint8
+
+const (
+	Event_Insert Event = 1 << 0
+	Event_Update Event = 1 << 1
+	Event_Delete Event = 1 << 2
+)
+
+// Trigger is a row-level trigger on a table, which calls a function for every row that the trigger's events affect.
+type Trigger struct {
+	Name   string
+	Table  doltdb.TableName
+	Timing Timing
+	Events Event
+	// UpdateColumns are the columns given by UPDATE OF. When empty, the trigger fires for updates to any column.
+	UpdateColumns []string
+	Function      doltdb.TableName
+	// Arguments are given to the function through TG_ARGV.
+	Arguments []string
+	// When is the condition that must be true for the trigger to fire. This is empty when there is no condition.
+	When string
+}
+
+// Collection contains every trigger, grouped by the table that they are on.
+type Collection struct {
+	triggers map[doltdb.TableName]map[string]*Trigger
+	mutex    *sync.Mutex
+}
+
+// GetTrigger returns the trigger with the given name on the given table. Returns nil if the trigger does not exist.
+func (pgt *Collection) GetTrigger(table doltdb.TableName, name string) *Trigger {
+	pgt.mutex.Lock()
+	defer pgt.mutex.Unlock()
+	return pgt.triggers[table][name]
+}
+
+// GetTriggers returns every trigger on the given table, in order of their names. This is the order that triggers fire
+// in when multiple triggers fire for the same event.
+func (pgt *Collection) GetTriggers(table doltdb.TableName) []*Trigger {
+	pgt.mutex.Lock()
+	defer pgt.mutex.Unlock()
+	tableTriggers := pgt.triggers[table]
+	triggers := make([]*Trigger, 0, len(tableTriggers))
+	for _, trigger := range tableTriggers {
+		triggers = append(triggers, trigger)
+	}
+	sort.Slice(triggers, func(i, j int) bool {
+		return triggers[i].Name < triggers[j].Name
+	})
+	return triggers
+}
+
+// AddTrigger adds the given trigger, returning an error if a trigger with the same name already exists on the table.
+func (pgt *Collection) AddTrigger(trigger *Trigger) error {
+	pgt.mutex.Lock()
+	defer pgt.mutex.Unlock()
+	tableTriggers, ok := pgt.triggers[trigger.Table]
+	if !ok {
+		tableTriggers = make(map[string]*Trigger)
+		pgt.triggers[trigger.Table] = tableTriggers
+	}
+	if _, ok = tableTriggers[trigger.Name]; ok {
+		return fmt.Errorf(`trigger "%s" for relation "%s" already exists`, trigger.Name, trigger.Table.Name)
+	}
+	tableTriggers[trigger.Name] = trigger
+	return nil
+}
+
+// ReplaceTrigger adds the given trigger, replacing any trigger with the same name on the table.
+func (pgt *Collection) ReplaceTrigger(trigger *Trigger) {
+	pgt.mutex.Lock()
+	defer pgt.mutex.Unlock()
+	tableTriggers, ok := pgt.triggers[trigger.Table]
+	if !ok {
+		tableTriggers = make(map[string]*Trigger)
+		pgt.triggers[trigger.Table] = tableTriggers
+	}
+	tableTriggers[trigger.Name] = trigger
+}
+
+// DropTrigger removes the trigger with the given name from the given table. Returns an error if the trigger does not
+// exist.
+func (pgt *Collection) DropTrigger(table doltdb.TableName, name string) error {
+	pgt.mutex.Lock()
+	defer pgt.mutex.Unlock()
+	tableTriggers := pgt.triggers[table]
+	if _, ok := tableTriggers[name]; !ok {
+		return fmt.Errorf(`trigger "%s" for table "%s" does not exist`, name, table.Name)
+	}
+	delete(tableTriggers, name)
+	if len(tableTriggers) == 0 {
+		delete(pgt.triggers, table)
+	}
+	return nil
+}
+
+// DropTable removes every trigger on the given table.
+func (pgt *Collection) DropTable(table doltdb.TableName) {
+	pgt.mutex.Lock()
+	defer pgt.mutex.Unlock()
+	delete(pgt.triggers, table)
+}
+
+// RenameTable moves every trigger on the old table to the new table.
+func (pgt *Collection) RenameTable(oldName doltdb.TableName, newName doltdb.TableName) {
+	pgt.mutex.Lock()
+	defer pgt.mutex.Unlock()
+	tableTriggers, ok := pgt.triggers[oldName]
+	if !ok {
+		return
+	}
+	delete(pgt.triggers, oldName)
+	newTriggers := make(map[string]*Trigger, len(tableTriggers))
+	for name, trigger := range tableTriggers {
+		newTrigger := trigger.clone()
+		newTrigger.Table = newName
+		newTriggers[name] = newTrigger
+	}
+	pgt.triggers[newName] = newTriggers
+}
+
+// GetFunctionDependent returns the first trigger that calls the given function. Returns nil if no triggers call it.
+func (pgt *Collection) GetFunctionDependent(function doltdb.TableName) *Trigger {
+	var dependent *Trigger
+	_ = pgt.IterateTriggers(func(trigger *Trigger) error {
+		if dependent == nil && trigger.Function == function {
+			dependent = trigger
+		}
+		return nil
+	})
+	return dependent
+}
+
+// IsEmpty returns whether the collection contains any triggers.
+func (pgt *Collection) IsEmpty() bool {
+	pgt.mutex.Lock()
+	defer pgt.mutex.Unlock()
+	return len(pgt.triggers) == 0
+}
+
+// IterateTriggers iterates over every trigger, in order of the schema, table, and trigger names.
+func (pgt *Collection) IterateTriggers(f func(trigger *Trigger) error) error {
+	pgt.mutex.Lock()
+	defer pgt.mutex.Unlock()
+
+	tables := make([]doltdb.TableName, 0, len(pgt.triggers))
+	for table := range pgt.triggers {
+		tables = append(tables, table)
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		if tables[i].Schema != tables[j].Schema {
+			return tables[i].Schema < tables[j].Schema
+		}
+		return tables[i].Name < tables[j].Name
+	})
+	for _, table := range tables {
+		names := make([]string, 0, len(pgt.triggers[table]))
+		for name := range pgt.triggers[table] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if err := f(pgt.triggers[table][name]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Clone returns a new *Collection with the same contents as the original.
+func (pgt *Collection) Clone() *Collection {
+	pgt.mutex.Lock()
+	defer pgt.mutex.Unlock()
+
+	newCollection := &Collection{
+		triggers: make(map[doltdb.TableName]map[string]*Trigger, len(pgt.triggers)),
+		mutex:    &sync.Mutex{},
+	}
+	for table, tableTriggers := range pgt.triggers {
+		newTriggers := make(map[string]*Trigger, len(tableTriggers))
+		for name, trigger := range tableTriggers {
+			newTriggers[name] = trigger.clone()
+		}
+		newCollection.triggers[table] = newTriggers
+	}
+	return newCollection
+}
+
+// FiresFor returns whether the trigger fires for the given event. Updates only fire the trigger when they set one of
+// the trigger's update columns, if it has any.
+func (trigger *Trigger) FiresFor(event Event, updatedColumns []string) bool {
+	if trigger.Events&event == 0 {
+		return false
+	}
+	if event != Event_Update || len(trigger.UpdateColumns) == 0 {
+		return true
+	}
+	for _, column := range updatedColumns {
+		if slices.Contains(trigger.UpdateColumns, column) {
+			return true
+		}
+	}
+	return false
+}
+
+// clone returns a deep copy of the trigger.
+func (trigger *Trigger) clone() *Trigger {
+	newTrigger := *trigger
+	newTrigger.UpdateColumns = slices.Clone(trigger.UpdateColumns)
+	newTrigger.Arguments = slices.Clone(trigger.Arguments)
+	return &newTrigger
+}
+
+// equals returns whether both triggers have the same definition. Either trigger may be nil.
+func (trigger *Trigger) equals(other *Trigger) bool {
+	if trigger == nil || other == nil {
+		return trigger == other
+	}
+	return trigger.Name == other.Name && trigger.Table == other.Table && trigger.Timing == other.Timing &&
+		trigger.Events == other.Events && slices.Equal(trigger.UpdateColumns, other.UpdateColumns) &&
+		trigger.Function == other.Function && slices.Equal(trigger.Arguments, other.Arguments) && trigger.When == other.When
+}
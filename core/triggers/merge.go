@@ -0,0 +1,50 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package triggers
+
+import (
+	"context"
+)
+
+// Merge handles merging triggers on our root and their root. Triggers are each merged as a whole: when only their side
+// changed a trigger, their definition is taken, and otherwise ours is kept.
+func Merge(ctx context.Context, ourCollection, theirCollection, ancCollection *Collection) (*Collection, error) {
+	mergedCollection := ourCollection.Clone()
+	err := theirCollection.IterateTriggers(func(theirTrigger *Trigger) error {
+		ourTrigger := mergedCollection.GetTrigger(theirTrigger.Table, theirTrigger.Name)
+		ancTrigger := ancCollection.GetTrigger(theirTrigger.Table, theirTrigger.Name)
+		if ourTrigger.equals(ancTrigger) && !theirTrigger.equals(ancTrigger) {
+			mergedCollection.ReplaceTrigger(theirTrigger.clone())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Triggers that were dropped on their side are removed from the merged collection, as long as we didn't change them
+	err = ourCollection.IterateTriggers(func(ourTrigger *Trigger) error {
+		if theirCollection.GetTrigger(ourTrigger.Table, ourTrigger.Name) != nil {
+			return nil
+		}
+		if ourTrigger.equals(ancCollection.GetTrigger(ourTrigger.Table, ourTrigger.Name)) {
+			return mergedCollection.DropTrigger(ourTrigger.Table, ourTrigger.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mergedCollection, nil
+}
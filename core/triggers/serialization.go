@@ -0,0 +1,98 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package triggers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// Serialize returns the Collection as a byte slice. If the Collection is nil, then this returns a nil slice.
+func (pgt *Collection) Serialize(ctx context.Context) ([]byte, error) {
+	if pgt == nil {
+		return nil, nil
+	}
+
+	// Write all of the triggers to the writer
+	writer := utils.NewWriter(256)
+	writer.VariableUint(0) // Version
+	var triggers []*Trigger
+	_ = pgt.IterateTriggers(func(trigger *Trigger) error {
+		triggers = append(triggers, trigger)
+		return nil
+	})
+	writer.VariableUint(uint64(len(triggers)))
+	for _, trigger := range triggers {
+		writer.String(trigger.Name)
+		writer.String(trigger.Table.Schema)
+		writer.String(trigger.Table.Name)
+		writer.Uint8(uint8(trigger.Timing))
+		writer.Uint8(uint8(trigger.Events))
+		writer.StringSlice(trigger.UpdateColumns)
+		writer.String(trigger.Function.Schema)
+		writer.String(trigger.Function.Name)
+		writer.StringSlice(trigger.Arguments)
+		writer.String(trigger.When)
+	}
+
+	return writer.Data(), nil
+}
+
+// Deserialize returns the Collection that was serialized in the byte slice. Returns an empty Collection if data is nil
+// or empty.
+func Deserialize(ctx context.Context, data []byte) (*Collection, error) {
+	collection := &Collection{
+		triggers: make(map[doltdb.TableName]map[string]*Trigger),
+		mutex:    &sync.Mutex{},
+	}
+	if len(data) == 0 {
+		return collection, nil
+	}
+	reader := utils.NewReader(data)
+	version := reader.VariableUint()
+	if version != 0 {
+		return nil, fmt.Errorf("version %d of triggers is not supported, please upgrade the server", version)
+	}
+
+	// Read from the reader
+	numOfTriggers := reader.VariableUint()
+	for i := uint64(0); i < numOfTriggers; i++ {
+		trigger := &Trigger{}
+		trigger.Name = reader.String()
+		trigger.Table.Schema = reader.String()
+		trigger.Table.Name = reader.String()
+		trigger.Timing = Timing(reader.Uint8())
+		trigger.Events = Event(reader.Uint8())
+		trigger.UpdateColumns = reader.StringSlice()
+		trigger.Function.Schema = reader.String()
+		trigger.Function.Name = reader.String()
+		trigger.Arguments = reader.StringSlice()
+		trigger.When = reader.String()
+		if err := collection.AddTrigger(trigger); err != nil {
+			return nil, err
+		}
+	}
+	if !reader.IsEmpty() {
+		return nil, fmt.Errorf("extra data found while deserializing triggers")
+	}
+
+	// Return the deserialized object
+	return collection, nil
+}
@@ -0,0 +1,174 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unvalidated
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+// Kind is the kind of constraint that has not been validated.
+type Kind uint8
+
+const (
+	Kind_Check      Kind = 0
+	Kind_ForeignKey Kind = 1
+)
+
+// Constraint is a constraint that was added using NOT VALID, so the rows that existed when it was added have not been
+// verified against it. Rows that are written after the constraint was added are always verified.
+type Constraint struct {
+	Name  string
+	Table doltdb.TableName
+	Kind  Kind
+	// Expression is the expression of a CHECK constraint, as it was written when the constraint was added. This is empty
+	// for other kinds of constraints.
+	Expression string
+}
+
+// Collection contains every constraint that has not been validated, grouped by the table that they are on.
+type Collection struct {
+	constraints map[doltdb.TableName]map[string]*Constraint
+	mutex       *sync.Mutex
+}
+
+// GetConstraint returns the unvalidated constraint with the given name on the given table. Returns nil if the table does
+// not have an unvalidated constraint with the name.
+func (pgu *Collection) GetConstraint(table doltdb.TableName, name string) *Constraint {
+	pgu.mutex.Lock()
+	defer pgu.mutex.Unlock()
+	return pgu.constraints[table][name]
+}
+
+// AddConstraint adds the given constraint, replacing any unvalidated constraint with the same name on the table.
+func (pgu *Collection) AddConstraint(constraint *Constraint) {
+	pgu.mutex.Lock()
+	defer pgu.mutex.Unlock()
+	tableConstraints, ok := pgu.constraints[constraint.Table]
+	if !ok {
+		tableConstraints = make(map[string]*Constraint)
+		pgu.constraints[constraint.Table] = tableConstraints
+	}
+	tableConstraints[constraint.Name] = constraint
+}
+
+// RemoveConstraint removes the constraint with the given name from the given table, which is done once the constraint
+// has been validated. Does nothing if the constraint is not in the collection.
+func (pgu *Collection) RemoveConstraint(table doltdb.TableName, name string) {
+	pgu.mutex.Lock()
+	defer pgu.mutex.Unlock()
+	tableConstraints := pgu.constraints[table]
+	delete(tableConstraints, name)
+	if len(tableConstraints) == 0 {
+		delete(pgu.constraints, table)
+	}
+}
+
+// DropTable removes every unvalidated constraint on the given table.
+func (pgu *Collection) DropTable(table doltdb.TableName) {
+	pgu.mutex.Lock()
+	defer pgu.mutex.Unlock()
+	delete(pgu.constraints, table)
+}
+
+// RenameTable moves every unvalidated constraint on the old table to the new table.
+func (pgu *Collection) RenameTable(oldName doltdb.TableName, newName doltdb.TableName) {
+	pgu.mutex.Lock()
+	defer pgu.mutex.Unlock()
+	tableConstraints, ok := pgu.constraints[oldName]
+	if !ok {
+		return
+	}
+	delete(pgu.constraints, oldName)
+	newConstraints := make(map[string]*Constraint, len(tableConstraints))
+	for name, constraint := range tableConstraints {
+		newConstraint := *constraint
+		newConstraint.Table = newName
+		newConstraints[name] = &newConstraint
+	}
+	pgu.constraints[newName] = newConstraints
+}
+
+// IsEmpty returns whether the collection contains any unvalidated constraints.
+func (pgu *Collection) IsEmpty() bool {
+	pgu.mutex.Lock()
+	defer pgu.mutex.Unlock()
+	return len(pgu.constraints) == 0
+}
+
+// IterateConstraints iterates over every unvalidated constraint, in order of the schema, table, and constraint names.
+func (pgu *Collection) IterateConstraints(f func(constraint *Constraint) error) error {
+	pgu.mutex.Lock()
+	defer pgu.mutex.Unlock()
+
+	tables := make([]doltdb.TableName, 0, len(pgu.constraints))
+	for table := range pgu.constraints {
+		tables = append(tables, table)
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		if tables[i].Schema != tables[j].Schema {
+			return tables[i].Schema < tables[j].Schema
+		}
+		return tables[i].Name < tables[j].Name
+	})
+	for _, table := range tables {
+		names := make([]string, 0, len(pgu.constraints[table]))
+		for name := range pgu.constraints[table] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if err := f(pgu.constraints[table][name]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Clone returns a new *Collection with the same contents as the original.
+func (pgu *Collection) Clone() *Collection {
+	pgu.mutex.Lock()
+	defer pgu.mutex.Unlock()
+
+	newCollection := &Collection{
+		constraints: make(map[doltdb.TableName]map[string]*Constraint, len(pgu.constraints)),
+		mutex:       &sync.Mutex{},
+	}
+	for table, tableConstraints := range pgu.constraints {
+		newConstraints := make(map[string]*Constraint, len(tableConstraints))
+		for name, constraint := range tableConstraints {
+			newConstraint := *constraint
+			newConstraints[name] = &newConstraint
+		}
+		newCollection.constraints[table] = newConstraints
+	}
+	return newCollection
+}
+
+// String returns the name of the kind of constraint.
+func (kind Kind) String() string {
+	switch kind {
+	case Kind_Check:
+		return "check"
+	case Kind_ForeignKey:
+		return "foreign key"
+	default:
+		return fmt.Sprintf("unknown constraint kind %d", uint8(kind))
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unvalidated
+
+import (
+	"context"
+)
+
+// Merge handles merging unvalidated constraints on our root and their root. A constraint remains unvalidated when either
+// side added it without validating it, unless the other side validated it after it was added on the ancestor.
+func Merge(ctx context.Context, ourCollection, theirCollection, ancCollection *Collection) (*Collection, error) {
+	mergedCollection := ourCollection.Clone()
+	err := theirCollection.IterateConstraints(func(theirConstraint *Constraint) error {
+		ourConstraint := mergedCollection.GetConstraint(theirConstraint.Table, theirConstraint.Name)
+		ancConstraint := ancCollection.GetConstraint(theirConstraint.Table, theirConstraint.Name)
+		// When we've validated a constraint that was unvalidated on the ancestor, then it remains validated
+		if ourConstraint == nil && ancConstraint == nil {
+			newConstraint := *theirConstraint
+			mergedCollection.AddConstraint(&newConstraint)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Constraints that they've validated are removed, as long as we didn't add them again
+	err = ourCollection.IterateConstraints(func(ourConstraint *Constraint) error {
+		if theirCollection.GetConstraint(ourConstraint.Table, ourConstraint.Name) != nil {
+			return nil
+		}
+		if ancCollection.GetConstraint(ourConstraint.Table, ourConstraint.Name) != nil {
+			mergedCollection.RemoveConstraint(ourConstraint.Table, ourConstraint.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mergedCollection, nil
+}
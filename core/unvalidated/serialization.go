@@ -0,0 +1,86 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unvalidated
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// Serialize returns the Collection as a byte slice. If the Collection is nil, then this returns a nil slice.
+func (pgu *Collection) Serialize(ctx context.Context) ([]byte, error) {
+	if pgu == nil {
+		return nil, nil
+	}
+
+	// Write all of the unvalidated constraints to the writer
+	writer := utils.NewWriter(256)
+	writer.VariableUint(0) // Version
+	var constraints []*Constraint
+	_ = pgu.IterateConstraints(func(constraint *Constraint) error {
+		constraints = append(constraints, constraint)
+		return nil
+	})
+	writer.VariableUint(uint64(len(constraints)))
+	for _, constraint := range constraints {
+		writer.String(constraint.Name)
+		writer.String(constraint.Table.Schema)
+		writer.String(constraint.Table.Name)
+		writer.Uint8(uint8(constraint.Kind))
+		writer.String(constraint.Expression)
+	}
+
+	return writer.Data(), nil
+}
+
+// Deserialize returns the Collection that was serialized in the byte slice. Returns an empty Collection if data is nil
+// or empty.
+func Deserialize(ctx context.Context, data []byte) (*Collection, error) {
+	collection := &Collection{
+		constraints: make(map[doltdb.TableName]map[string]*Constraint),
+		mutex:       &sync.Mutex{},
+	}
+	if len(data) == 0 {
+		return collection, nil
+	}
+	reader := utils.NewReader(data)
+	version := reader.VariableUint()
+	if version != 0 {
+		return nil, fmt.Errorf("version %d of unvalidated constraints is not supported, please upgrade the server", version)
+	}
+
+	// Read from the reader
+	numOfConstraints := reader.VariableUint()
+	for i := uint64(0); i < numOfConstraints; i++ {
+		constraint := &Constraint{}
+		constraint.Name = reader.String()
+		constraint.Table.Schema = reader.String()
+		constraint.Table.Name = reader.String()
+		constraint.Kind = Kind(reader.Uint8())
+		constraint.Expression = reader.String()
+		collection.AddConstraint(constraint)
+	}
+	if !reader.IsEmpty() {
+		return nil, fmt.Errorf("extra data found while deserializing unvalidated constraints")
+	}
+
+	// Return the deserialized object
+	return collection, nil
+}
@@ -15,6 +15,7 @@
 package messages
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/dolthub/doltgresql/postgres/connection"
@@ -47,13 +48,25 @@ type ErrorResponse struct {
 	Optional     ErrorResponseOptionalFields
 }
 
-// ErrorResponseOptionalFields are optional fields that will not be sent if their values are empty strings.
+// ErrorResponseOptionalFields are optional fields that will not be sent if their values are empty strings (or zero for
+// positions).
 type ErrorResponseOptionalFields struct {
-	Schema     string
-	Table      string
-	Column     string
-	Constraint string
-	Routine    string
+	Detail string
+	Hint   string
+	// Position is the position of the error within the query string, counted in characters starting from 1.
+	Position int32
+	// InternalPosition is the same as Position, but for an internally-generated query given by InternalQuery.
+	InternalPosition int32
+	InternalQuery    string
+	Where            string
+	Schema           string
+	Table            string
+	Column           string
+	DataType         string
+	Constraint       string
+	File             string
+	Line             string
+	Routine          string
 }
 
 var errorResponseDefault = connection.MessageFormat{
@@ -109,8 +122,38 @@ func (m ErrorResponse) Encode() (connection.MessageFormat, error) {
 	outputMessage.Field("Fields").Child("Code", 3).MustWrite('M')
 	outputMessage.Field("Fields").Child("Value", 3).MustWrite(m.Message)
 
-	// Write the optional fields after the required fields
+	// Write the optional fields after the required fields, in the same order as Postgres
 	i := 4
+	if len(m.Optional.Detail) > 0 {
+		outputMessage.Field("Fields").Child("Code", i).MustWrite('D')
+		outputMessage.Field("Fields").Child("Value", i).MustWrite(m.Optional.Detail)
+		i++
+	}
+	if len(m.Optional.Hint) > 0 {
+		outputMessage.Field("Fields").Child("Code", i).MustWrite('H')
+		outputMessage.Field("Fields").Child("Value", i).MustWrite(m.Optional.Hint)
+		i++
+	}
+	if m.Optional.Position > 0 {
+		outputMessage.Field("Fields").Child("Code", i).MustWrite('P')
+		outputMessage.Field("Fields").Child("Value", i).MustWrite(strconv.Itoa(int(m.Optional.Position)))
+		i++
+	}
+	if m.Optional.InternalPosition > 0 {
+		outputMessage.Field("Fields").Child("Code", i).MustWrite('p')
+		outputMessage.Field("Fields").Child("Value", i).MustWrite(strconv.Itoa(int(m.Optional.InternalPosition)))
+		i++
+	}
+	if len(m.Optional.InternalQuery) > 0 {
+		outputMessage.Field("Fields").Child("Code", i).MustWrite('q')
+		outputMessage.Field("Fields").Child("Value", i).MustWrite(m.Optional.InternalQuery)
+		i++
+	}
+	if len(m.Optional.Where) > 0 {
+		outputMessage.Field("Fields").Child("Code", i).MustWrite('W')
+		outputMessage.Field("Fields").Child("Value", i).MustWrite(m.Optional.Where)
+		i++
+	}
 	if len(m.Optional.Schema) > 0 {
 		outputMessage.Field("Fields").Child("Code", i).MustWrite('s')
 		outputMessage.Field("Fields").Child("Value", i).MustWrite(m.Optional.Schema)
@@ -126,11 +169,26 @@ func (m ErrorResponse) Encode() (connection.MessageFormat, error) {
 		outputMessage.Field("Fields").Child("Value", i).MustWrite(m.Optional.Column)
 		i++
 	}
+	if len(m.Optional.DataType) > 0 {
+		outputMessage.Field("Fields").Child("Code", i).MustWrite('d')
+		outputMessage.Field("Fields").Child("Value", i).MustWrite(m.Optional.DataType)
+		i++
+	}
 	if len(m.Optional.Constraint) > 0 {
 		outputMessage.Field("Fields").Child("Code", i).MustWrite('n')
 		outputMessage.Field("Fields").Child("Value", i).MustWrite(m.Optional.Constraint)
 		i++
 	}
+	if len(m.Optional.File) > 0 {
+		outputMessage.Field("Fields").Child("Code", i).MustWrite('F')
+		outputMessage.Field("Fields").Child("Value", i).MustWrite(m.Optional.File)
+		i++
+	}
+	if len(m.Optional.Line) > 0 {
+		outputMessage.Field("Fields").Child("Code", i).MustWrite('L')
+		outputMessage.Field("Fields").Child("Value", i).MustWrite(m.Optional.Line)
+		i++
+	}
 	if len(m.Optional.Routine) > 0 {
 		outputMessage.Field("Fields").Child("Code", i).MustWrite('R')
 		outputMessage.Field("Fields").Child("Value", i).MustWrite(m.Optional.Routine)
@@ -157,14 +215,34 @@ func (m ErrorResponse) Decode(s connection.MessageFormat) (connection.Message, e
 			errorResponse.SqlStateCode = value
 		case 'M':
 			errorResponse.Message = value
+		case 'D':
+			errorResponse.Optional.Detail = value
+		case 'H':
+			errorResponse.Optional.Hint = value
+		case 'P':
+			position, _ := strconv.Atoi(value)
+			errorResponse.Optional.Position = int32(position)
+		case 'p':
+			position, _ := strconv.Atoi(value)
+			errorResponse.Optional.InternalPosition = int32(position)
+		case 'q':
+			errorResponse.Optional.InternalQuery = value
+		case 'W':
+			errorResponse.Optional.Where = value
 		case 's':
 			errorResponse.Optional.Schema = value
 		case 't':
 			errorResponse.Optional.Table = value
 		case 'c':
 			errorResponse.Optional.Column = value
+		case 'd':
+			errorResponse.Optional.DataType = value
 		case 'n':
 			errorResponse.Optional.Constraint = value
+		case 'F':
+			errorResponse.Optional.File = value
+		case 'L':
+			errorResponse.Optional.Line = value
 		case 'R':
 			errorResponse.Optional.Routine = value
 		}
@@ -88,6 +88,7 @@ const (
 	OidTimestampArray    = 1115
 	OidDateArray         = 1182
 	OidTimeArray         = 1183
+	OidTimestamptz       = 1184
 	OidNumeric           = 1700
 	OidRefcursor         = 1790
 	OidRegprocedure      = 2202
@@ -95,6 +96,7 @@ const (
 	OidRegoperator       = 2204
 	OidRegclass          = 2205
 	OidRegtype           = 2206
+	OidUuid              = 2950
 	OidRegrole           = 4096
 	OidRegnamespace      = 4097
 	OidRegnamespaceArray = 4098
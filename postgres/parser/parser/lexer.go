@@ -25,9 +25,9 @@
 package parser
 
 import (
-	"bytes"
 	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/cockroachdb/errors"
 
@@ -228,22 +228,13 @@ func (l *lexer) populateErrorDetails() {
 		l.lastError = errors.Wrapf(l.lastError, "at or near \"%s\"", lastTok.str)
 	}
 
-	// Find the end of the line containing the last token.
-	i := strings.IndexByte(l.in[lastTok.pos:], '\n')
-	if i == -1 {
-		i = len(l.in)
-	} else {
-		i += int(lastTok.pos)
+	// Clients display the query along with a marker at the error's position, so we only need to record where the last
+	// token starts. Token positions are byte offsets, while Postgres counts characters starting from 1.
+	pos := int(lastTok.pos)
+	if pos > len(l.in) {
+		pos = len(l.in)
 	}
-	// Find the beginning of the line containing the last token. Note that
-	// LastIndexByte returns -1 if '\n' could not be found.
-	j := strings.LastIndexByte(l.in[:lastTok.pos], '\n') + 1
-	// Output everything up to and including the line containing the last token.
-	var buf bytes.Buffer
-	fmt.Fprintf(&buf, "source SQL:\n%s\n", l.in[:i])
-	// Output a caret indicating where the last token starts.
-	fmt.Fprintf(&buf, "%s^", strings.Repeat(" ", int(lastTok.pos)-j))
-	l.lastError = errors.WithDetail(l.lastError, buf.String())
+	l.lastError = pgerror.WithPosition(l.lastError, int32(utf8.RuneCountInString(l.in[:pos])+1))
 }
 
 // SetHelp marks the "last error" field in the lexer to become a
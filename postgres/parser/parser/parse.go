@@ -145,15 +145,30 @@ func (p *Parser) scanOneStmt() (sql string, tokens []sqlSymType, done bool) {
 	// We make the resulting token positions match the returned string.
 	lval.pos = 0
 	tokens = append(tokens, lval)
+	// Semicolons within a BEGIN ATOMIC ... END block separate the statements of the block, rather than ending the
+	// statement that contains the block. CASE expressions also end with END, so we track them to find the block's END.
+	atomicDepth := 0
+	caseDepth := 0
 	for {
 		if lval.id == ERROR {
 			return p.scanner.in[startPos:], tokens, true
 		}
+		prevID := lval.id
 		posBeforeScan := p.scanner.pos
 		p.scanner.scan(&lval)
-		if lval.id == 0 || lval.id == ';' {
+		if lval.id == 0 || (lval.id == ';' && atomicDepth == 0) {
 			return p.scanner.in[startPos:posBeforeScan], tokens, (lval.id == 0)
 		}
+		switch {
+		case lval.id == ATOMIC && prevID == BEGIN:
+			atomicDepth++
+		case lval.id == CASE && atomicDepth > 0:
+			caseDepth++
+		case lval.id == END && caseDepth > 0:
+			caseDepth--
+		case lval.id == END && atomicDepth > 0:
+			atomicDepth--
+		}
 		lval.pos -= startPos
 		tokens = append(tokens, lval)
 	}
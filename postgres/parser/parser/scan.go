@@ -355,6 +355,9 @@ func (s *scanner) scan(lval *sqlSymType) {
 		case '@': //@@
 			s.pos++
 			lval.id = TEXTSEARCHMATCH
+		case '?': // @?
+			s.pos++
+			lval.id = JSON_PATH_EXISTS
 		}
 		return
 
@@ -144,6 +144,9 @@ func (u *sqlSymUnion) tableIndexName() tree.TableIndexName {
 func (u *sqlSymUnion) newTableIndexNames() tree.TableIndexNames {
     return u.val.(tree.TableIndexNames)
 }
+func (u *sqlSymUnion) insertOverriding() tree.InsertOverriding {
+    return u.val.(tree.InsertOverriding)
+}
 func (u *sqlSymUnion) nameList() tree.NameList {
     return u.val.(tree.NameList)
 }
@@ -213,6 +216,24 @@ func (u *sqlSymUnion) selectStmt() tree.SelectStatement {
 func (u *sqlSymUnion) colDef() *tree.ColumnTableDef {
     return u.val.(*tree.ColumnTableDef)
 }
+func (u *sqlSymUnion) foreignColumnDef() tree.ForeignColumnTableDef {
+    return u.val.(tree.ForeignColumnTableDef)
+}
+func (u *sqlSymUnion) foreignColumnDefs() []tree.ForeignColumnTableDef {
+    return u.val.([]tree.ForeignColumnTableDef)
+}
+func (u *sqlSymUnion) foreignOption() tree.ForeignOption {
+    return u.val.(tree.ForeignOption)
+}
+func (u *sqlSymUnion) foreignOptions() tree.ForeignOptions {
+    return u.val.(tree.ForeignOptions)
+}
+func (u *sqlSymUnion) publicationTables() *tree.PublicationTables {
+    if tables, ok := u.val.(*tree.PublicationTables); ok {
+        return tables
+    }
+    return nil
+}
 func (u *sqlSymUnion) constraintDef() tree.ConstraintTableDef {
     return u.val.(tree.ConstraintTableDef)
 }
@@ -675,6 +696,18 @@ func (u *sqlSymUnion) createAggOptions() []tree.CreateAggOption {
 func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
     return u.val.([]tree.AggregateToDrop)
 }
+func (u *sqlSymUnion) cursorSensitivity() tree.CursorSensitivity {
+    return u.val.(tree.CursorSensitivity)
+}
+func (u *sqlSymUnion) cursorScrollOption() tree.CursorScrollOption {
+    return u.val.(tree.CursorScrollOption)
+}
+func (u *sqlSymUnion) cursorStmt() tree.CursorStmt {
+    return u.val.(tree.CursorStmt)
+}
+func (u *sqlSymUnion) lockTableMode() tree.LockTableMode {
+    return u.val.(tree.LockTableMode)
+}
 %}
 
 // NB: the %token definitions must come before the %type definitions in this
@@ -695,13 +728,13 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 // below; search this file for "Keyword category lists".
 
 // Ordinary key words in alphabetical order.
-%token <str> ABORT ACCESS ACTION ADD ADMIN AFTER AGGREGATE
-%token <str> ALIGNMENT ALL ALLOW_CONNECTIONS ALTER ALWAYS ANALYSE ANALYZE AND AND_AND ANY ANNOTATE_TYPE ARRAY AS ASC
+%token <str> ABORT ABSOLUTE ACCESS ACTION ADD ADMIN AFTER AGGREGATE
+%token <str> ALIGNMENT ALL ALLOW_CONNECTIONS ALTER ALWAYS ANALYSE ANALYZE AND AND_AND ANY ANNOTATE_TYPE ARRAY AS ASC ASENSITIVE
 %token <str> ASYMMETRIC AT ATOMIC ATTACH ATTRIBUTE AUTHORIZATION AUTOMATIC
 
-%token <str> BACKUP BACKUPS BASETYPE BEFORE BEGIN BETWEEN BIGINT BIGSERIAL BINARY BIT
+%token <str> BACKUP BACKUPS BACKWARD BASETYPE BEFORE BEGIN BETWEEN BIGINT BIGSERIAL BINARY BIT
 %token <str> BUCKET_COUNT
-%token <str> BOOLEAN BOTH BOX2D BUNDLE BY
+%token <str> BOOLEAN BOTH BOX2D BUNDLE BY BYPASSRLS
 
 %token <str> CACHE CHAIN CALL CALLED CANCEL CANCELQUERY CANONICAL CASCADE CASCADED CASE CAST CATEGORY CBRT
 %token <str> CHANGEFEED CHAR CHARACTER CHARACTERISTICS CHECK CHECK_OPTION CLASS CLOSE
@@ -709,78 +742,78 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %token <str> COMMIT COMMITTED COMPACT COMPLETE COMPRESSION CONCAT CONCURRENTLY CONFIGURATION CONFIGURATIONS CONFIGURE
 %token <str> CONFLICT CONNECT CONNECTION CONSTRAINT CONSTRAINTS CONTAINS CONTROLCHANGEFEED
 %token <str> CONTROLJOB CONVERSION CONVERT COPY COST CREATE CREATEDB CREATELOGIN CREATEROLE
-%token <str> CROSS CUBE CURRENT CURRENT_CATALOG CURRENT_DATE CURRENT_SCHEMA
+%token <str> CROSS CSV CUBE CURRENT CURRENT_CATALOG CURRENT_DATE CURRENT_SCHEMA
 %token <str> CURRENT_ROLE CURRENT_TIME CURRENT_TIMESTAMP
-%token <str> CURRENT_USER CYCLE
+%token <str> CURRENT_USER CURSOR CYCLE
 
 %token <str> DATA DATABASE DATABASES DATE DAY DEALLOCATE DEC DECIMAL DECLARE
 %token <str> DEFAULT DEFAULTS DEFERRABLE DEFERRED DEFINER DELETE DELIMITER DEPENDS DESC DESERIALFUNC DESTINATION
 %token <str> DETACH DETACHED DICTIONARY DISABLE DISCARD DISTINCT DO DOMAIN DOUBLE DROP
 
-%token <str> EACH ELEMENT ELSE ENABLE ENCODING ENCRYPTION_PASSPHRASE END ENUM ENUMS ESCAPE EVENT
-%token <str> EXCEPT EXCLUDE EXCLUDING EXISTS EXECUTE EXECUTION EXPERIMENTAL
+%token <str> EACH ELEMENT ELSE ENABLE ENCODING ENCRYPTED ENCRYPTION_PASSPHRASE END ENUM ENUMS ESCAPE EVENT
+%token <str> EXCEPT EXCLUDE EXCLUDING EXCLUSIVE EXISTS EXECUTE EXECUTION EXPERIMENTAL
 %token <str> EXPERIMENTAL_FINGERPRINTS EXPERIMENTAL_REPLICA
 %token <str> EXPERIMENTAL_AUDIT EXPIRATION EXPLAIN EXPORT EXPRESSION
 %token <str> EXTENDED EXTENSION EXTERNAL EXTRACT EXTRACT_DURATION
 
 %token <str> FALSE FAMILY FETCH FETCHVAL FETCHTEXT FETCHVAL_PATH FETCHTEXT_PATH
 %token <str> FILES FILTER FINALFUNC FINALFUNC_EXTRA FINALFUNC_MODIFY FINALIZE FIRST FLOAT FLOAT4 FLOAT8 FLOORDIV
-%token <str> FOLLOWING FOR FORCE FORCE_INDEX FOREIGN FROM FULL FUNCTION FUNCTIONS
+%token <str> FOLLOWING FOR FORCE FORCE_INDEX FOREIGN FORMAT FORWARD FROM FULL FUNCTION FUNCTIONS
 
 %token <str> GENERATED GEOGRAPHY GEOMETRY GEOMETRYM GEOMETRYZ GEOMETRYZM
 %token <str> GEOMETRYCOLLECTION GEOMETRYCOLLECTIONM GEOMETRYCOLLECTIONZ GEOMETRYCOLLECTIONZM
 %token <str> GLOBAL GRANT GRANTED GRANTS GREATEST GROUP GROUPING GROUPS
 
-%token <str> HANDLER HASH HAVING HIGH HISTOGRAM HOUR HYPOTHETICAL
+%token <str> HANDLER HASH HAVING HEADER HIGH HISTOGRAM HOLD HOUR HYPOTHETICAL
 
 %token <str> ICU_LOCALE ICU_RULES IDENTITY
 %token <str> IF IFERROR IFNULL IGNORE_FOREIGN_KEYS ILIKE IMMEDIATE IMMUTABLE IMPORT
 %token <str> IN INCLUDE INCLUDING INCREMENT INCREMENTAL INET INET_CONTAINED_BY_OR_EQUALS
-%token <str> INET_CONTAINS_OR_EQUALS INDEX INDEXES INHERIT INHERITS INITCOND INJECT INLINE INPUT INTERLEAVE INITIALLY
+%token <str> INET_CONTAINS_OR_EQUALS INDEX INDEXES INHERIT INHERITS INITCOND INJECT INLINE INPUT INSENSITIVE INTERLEAVE INITIALLY
 %token <str> INNER INOUT INSERT INSTEAD INT INTEGER INTERNALLENGTH
 %token <str> INTERSECT INTERVAL INTO INTO_DB INVERTED INVOKER IS ISERROR ISNULL ISOLATION IS_TEMPLATE
 
-%token <str> JOB JOBS JOIN JSON JSONB JSON_SOME_EXISTS JSON_ALL_EXISTS
+%token <str> JOB JOBS JOIN JSON JSONB JSON_SOME_EXISTS JSON_ALL_EXISTS JSON_PATH_EXISTS
 
 %token <str> KEY KEYS KMS KV
 
 %token <str> LANGUAGE LARGE LAST LATERAL LATEST LC_CTYPE LC_COLLATE
 %token <str> LEADING LEAKPROOF LEASE LEAST LEFT LESS LEVEL LIKE LIMIT
-%token <str> LINESTRING LINESTRINGM LINESTRINGZ LINESTRINGZM LIST
-%token <str> LOCAL LOCALE LOCALE_PROVIDER LOCALTIME LOCALTIMESTAMP LOCKED LOGGED LOGIN LOOKUP LOW LSHIFT
+%token <str> LINESTRING LINESTRINGM LINESTRINGZ LINESTRINGZM LIST LISTEN
+%token <str> LOCAL LOCALE LOCALE_PROVIDER LOCALTIME LOCALTIMESTAMP LOCK LOCKED LOGGED LOGIN LOOKUP LOW LSHIFT
 
-%token <str> MAIN MATCH MATERIALIZED MAXVALUE MERGE METHOD MFINALFUNC MFINALFUNC_EXTRA MFINALFUNC_MODIFY
-%token <str> MINITCOND MINUTE MINVALUE MINVFUNC MODIFYCLUSTERSETTING MODULUS MONTH MSFUNC MSPACE MSSPACE MSTYPE
+%token <str> MAIN MASKING MATCH MATERIALIZED MAXVALUE MERGE METHOD MFINALFUNC MFINALFUNC_EXTRA MFINALFUNC_MODIFY
+%token <str> MINITCOND MINUTE MINVALUE MINVFUNC MODE MODIFYCLUSTERSETTING MODULUS MONTH MOVE MSFUNC MSPACE MSSPACE MSTYPE
 %token <str> MULTILINESTRING MULTILINESTRINGM MULTILINESTRINGZ MULTILINESTRINGZM MULTIPOINT MULTIPOINTM
 %token <str> MULTIPOINTZ MULTIPOINTZM MULTIPOLYGON MULTIPOLYGONM MULTIPOLYGONZ MULTIPOLYGONZM MULTIRANGE_TYPE_NAME
 
-%token <str> NAN NAME NAMES NATURAL NEVER NEW NEXT NO NOCANCELQUERY NOCONTROLCHANGEFEED NOCONTROLJOB
-%token <str> NOCREATEDB NOCREATELOGIN NOCREATEROLE NOLOGIN NOMODIFYCLUSTERSETTING NO_INDEX_JOIN
-%token <str> NONE NORMAL NOT NOTHING NOTNULL NOVIEWACTIVITY NOWAIT NULL NULLIF NULLS NUMERIC
+%token <str> NAN NAME NAMES NATURAL NEVER NEW NEXT NO NOBYPASSRLS NOCANCELQUERY NOCONTROLCHANGEFEED NOCONTROLJOB
+%token <str> NOCREATEDB NOCREATELOGIN NOCREATEROLE NOINHERIT NOLOGIN NOMODIFYCLUSTERSETTING NOREPLICATION NOSUPERUSER NO_INDEX_JOIN
+%token <str> NONE NORMAL NOT NOTHING NOTIFY NOTNULL NOVIEWACTIVITY NOWAIT NULL NULLIF NULLS NUMERIC
 
 %token <str> OBJECT OF OFF OFFSET OID OIDS OIDVECTOR OLD ON ONLY OPT OPTION OPTIONS OR
-%token <str> ORDER ORDINALITY OTHERS OUT OUTER OUTPUT OVER OVERLAPS OVERLAY OWNED OWNER OPERATOR
+%token <str> ORDER ORDINALITY OTHERS OUT OUTER OUTPUT OVER OVERLAPS OVERLAY OVERRIDING OWNED OWNER OPERATOR
 
 %token <str> PARALLEL PARAMETER PARENT PARSER PARTIAL PARTITION PARTITIONS PASSEDBYVALUE PASSWORD PAUSE PAUSED PHYSICAL
 %token <str> PLACING PLAIN PLAN PLANS POINT POINTM POINTZ POINTZM POLICY POLYGON POLYGONM POLYGONZ POLYGONZM
-%token <str> POSITION PRECEDING PRECISION PREFERRED PREPARE PRESERVE PRIMARY PRIORITY PRIVILEGES
+%token <str> POSITION PRECEDING PRECISION PREFERRED PREPARE PRESERVE PRIMARY PRIOR PRIORITY PRIVILEGES
 %token <str> PROCEDURAL PROCEDURE PROCEDURES PUBLIC PUBLICATION
 
-%token <str> QUERIES QUERY
+%token <str> QUERIES QUERY QUOTE
 
 %token <str> RANGE RANGES READ READ_ONLY READ_WRITE REAL RECEIVE RECURSIVE RECURRING REF REFERENCES REFERENCING REFRESH
-%token <str> REGCLASS REGPROC REGPROCEDURE REGNAMESPACE REGTYPE REINDEX RELEASE REMAINDER
-%token <str> REMOVE_PATH RENAME REPEATABLE REPLACE REPLICA RESET RESTART RESTORE RESTRICT RESTRICTED RESUME
+%token <str> REGCLASS REGPROC REGPROCEDURE REGNAMESPACE REGTYPE REINDEX RELATIVE RELEASE REMAINDER
+%token <str> REMOVE_PATH RENAME REPEATABLE REPLACE REPLICA REPLICATION RESET RESTART RESTORE RESTRICT RESTRICTED RESUME
 %token <str> RETRY RETURN RETURNING RETURNS REVISION_HISTORY REVOKE RIGHT
 %token <str> ROLE ROLES ROUTINE ROUTINES ROLLBACK ROLLUP ROW ROWS RSHIFT RULE RUNNING
 
-%token <str> SAFE SAVEPOINT SCATTER SCHEDULE SCHEDULES SCHEMA SCHEMAS SCRUB SEARCH SECOND SECURITY
+%token <str> SAFE SAVEPOINT SCATTER SCHEDULE SCHEDULES SCHEMA SCHEMAS SCROLL SCRUB SEARCH SECOND SECURITY
 %token <str> SECURITY_BARRIER SECURITY_INVOKER SEED SELECT SEND
 %token <str> SERIALFUNC SERIALIZABLE SERVER SESSION SESSIONS SESSION_USER SET SETOF SETTING SETTINGS SEQUENCE SEQUENCES SFUNC
 %token <str> SHARE SHAREABLE SHOW SIMILAR SIMPLE SKIP SKIP_MISSING_FOREIGN_KEYS
 %token <str> SKIP_MISSING_SEQUENCES SKIP_MISSING_SEQUENCE_OWNERS SKIP_MISSING_VIEWS SMALLINT SMALLSERIAL SNAPSHOT SOME
-%token <str> SORTOP SPLIT SQL SQRT SSPACE STABLE START STATEMENT STATISTICS STATUS STDIN STRATEGY STRICT STRING
-%token <str> STORAGE STORE STORED STYPE SUBSCRIPT SUBSCRIPTION SUBSTRING SUBTYPE SUBTYPE_DIFF SUBTYPE_OPCLASS SUPPORT
+%token <str> SORTOP SPLIT SQL SQRT SSPACE STABLE START STATEMENT STATISTICS STATUS STDIN STDOUT STRATEGY STRICT STRING
+%token <str> STORAGE STORE STORED STYPE SUBSCRIPT SUBSCRIPTION SUBSTRING SUBTYPE SUBTYPE_DIFF SUBTYPE_OPCLASS SUPERUSER SUPPORT
 %token <str> SYMMETRIC SYNTAX SYSTEM
 
 %token <str> TABLE TABLES TABLESPACE TEMP TEMPLATE TEMPORARY TEXT THEN
@@ -788,7 +821,7 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %token <str> TRANSACTION TRANSACTIONS TRANSFORM TREAT TRIGGER TRIM TRUE
 %token <str> TRUNCATE TRUSTED TYPE TYPES TYPMOD_IN TYPMOD_OUT
 
-%token <str> UNBOUNDED UNCOMMITTED UNION UNIQUE UNKNOWN UNLOGGED UNSAFE UNSPLIT
+%token <str> UNBOUNDED UNCOMMITTED UNION UNIQUE UNKNOWN UNLISTEN UNLOGGED UNSAFE UNSPLIT
 %token <str> UPDATE UPSERT UNTIL USAGE USE USER USERS USING UUID
 
 %token <str> VALID VALIDATE VALIDATOR VALUE VALUES
@@ -839,6 +872,7 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %type <tree.Statement> alter_type_stmt
 %type <tree.Statement> alter_schema_stmt
 %type <tree.Statement> alter_domain_stmt
+%type <tree.Statement> alter_publication_stmt
 
 // ALTER TABLE
 %type <tree.Statement> alter_onetable_stmt
@@ -897,8 +931,17 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %type <tree.Statement> comment_stmt
 %type <tree.Statement> commit_stmt
 %type <tree.Statement> copy_from_stmt
+%type <tree.Statement> copy_to_stmt
+%type <tree.Statement> listen_stmt
+%type <tree.Statement> notify_stmt
+%type <tree.Statement> unlisten_stmt
 
 %type <tree.Statement> create_stmt
+%type <tree.Statement> create_masking_policy_stmt
+%type <tree.Statement> create_server_stmt
+%type <tree.Statement> create_publication_stmt
+%type <*tree.PublicationTables> opt_publication_tables
+%type <tree.Statement> create_foreign_table_stmt
 %type <tree.Statement> create_changefeed_stmt
 %type <tree.Statement> create_ddl_stmt
 %type <tree.Statement> create_ddl_stmt_schema_element
@@ -934,6 +977,14 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %type <tree.Statement> discard_stmt
 
 %type <tree.Statement> drop_stmt
+%type <tree.Statement> drop_masking_policy_stmt
+%type <tree.Statement> drop_server_stmt
+%type <tree.Statement> drop_publication_stmt
+%type <tree.Statement> drop_foreign_table_stmt
+%type <tree.ForeignColumnTableDef> foreign_column_def
+%type <[]tree.ForeignColumnTableDef> foreign_column_list opt_foreign_column_list
+%type <tree.ForeignOption> foreign_option
+%type <tree.ForeignOptions> foreign_option_list opt_foreign_options
 %type <tree.Statement> drop_ddl_stmt
 %type <tree.Statement> drop_database_stmt
 %type <tree.Statement> drop_index_stmt
@@ -1027,6 +1078,7 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %type <tree.Statement> show_schedules_stmt
 
 %type <str> session_var
+%type <str> var_name
 %type <*string> comment_text
 
 %type <tree.Statement> transaction_stmt
@@ -1037,6 +1089,9 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 
 %type <tree.Statement> close_cursor_stmt
 %type <tree.Statement> declare_cursor_stmt
+%type <tree.Statement> fetch_cursor_stmt
+%type <tree.Statement> move_cursor_stmt
+%type <tree.Statement> lock_stmt
 %type <tree.Statement> reindex_stmt
 
 %type <[]string> opt_incremental
@@ -1045,6 +1100,8 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %type <*tree.BackupOptions> opt_with_backup_options backup_options backup_options_list
 %type <*tree.RestoreOptions> opt_with_restore_options restore_options restore_options_list
 %type <*tree.CopyOptions> opt_with_copy_options copy_options copy_options_list
+%type <*tree.CopyOptions> copy_generic_options_list copy_generic_option
+%type <str> copy_generic_option_arg
 %type <str> import_format
 %type <tree.StorageParam> storage_parameter
 %type <[]tree.StorageParam> storage_parameter_list opt_table_with opt_with_storage_parameter_list attribution_list
@@ -1134,7 +1191,7 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %type <[]string> schema_name_list role_spec_list opt_role_list opt_owned_by_list
 %type <*tree.UnresolvedName> table_pattern complex_table_pattern
 %type <*tree.UnresolvedName> column_path prefixed_column_path column_path_with_star
-%type <tree.TableExpr> insert_target create_stats_target analyze_target
+%type <tree.TableExpr> insert_target create_stats_target
 
 %type <*tree.UnresolvedObjectName> opt_handler_inline opt_handler_validator
 %type <*tree.LanguageHandler> opt_language_handler
@@ -1150,11 +1207,13 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %type <tree.TriggerEvent> trigger_event
 %type <tree.TriggerEvents> trigger_events
 %type <tree.TriggerTime> trigger_time
+%type <tree.NameList> opt_trigger_func_args trigger_func_args
+%type <str> trigger_func_arg
 
 %type <*tree.TableIndexName> table_index_name
 %type <tree.TableIndexNames> table_index_name_list
 
-%type <tree.Operator> math_op operator
+%type <tree.Operator> math_op operator qual_operator
 
 %type <tree.IsolationLevel> iso_level
 %type <tree.UserPriority> user_priority
@@ -1168,6 +1227,7 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %type <empty> opt_all_clause
 %type <bool> distinct_clause opt_external definer_or_invoker opt_not opt_col_with_options
 %type <tree.DistinctOn> distinct_on_clause
+%type <tree.InsertOverriding> override_kind
 %type <tree.NameList> opt_column_list insert_column_list opt_stats_columns opt_of_cols
 %type <tree.OrderBy> sort_clause single_sort_clause opt_sort_clause
 %type <[]*tree.Order> sortby_list
@@ -1254,7 +1314,7 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %type <[]*tree.When> when_clause_list
 %type <tree.ComparisonOperator> sub_type
 %type <tree.Expr> numeric_only opt_allow_connections opt_connection_limit opt_is_template opt_oid
-%type <tree.AliasClause> alias_clause opt_alias_clause
+%type <tree.AliasClause> alias_clause opt_alias_clause opt_func_alias_clause func_column_def_list
 %type <bool> opt_ordinality opt_compact
 %type <*tree.Order> sortby
 %type <tree.IndexElem> index_elem index_elem_name_only partition_index_elem
@@ -1286,6 +1346,11 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %type <*types.T> const_geo
 %type <str> extract_arg
 %type <bool> opt_varying opt_no_inherit
+%type <bool> opt_binary opt_hold
+%type <tree.LockTableMode> opt_lock_mode lock_mode
+%type <tree.CursorSensitivity> opt_cursor_sensitivity
+%type <tree.CursorScrollOption> opt_scroll
+%type <tree.CursorStmt> cursor_movement_specifier
 
 %type <*tree.NumVal> signed_iconst only_signed_iconst
 %type <*tree.NumVal> signed_fconst only_signed_fconst
@@ -1370,7 +1435,7 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 %nonassoc  '<' '>' '=' LESS_EQUALS GREATER_EQUALS NOT_EQUALS
 %nonassoc  '~' BETWEEN DEFERRABLE IN LIKE ILIKE SIMILAR NOT_REGMATCH REGIMATCH NOT_REGIMATCH NOT_LA TEXTSEARCHMATCH
 %nonassoc  ESCAPE              // ESCAPE must be just above LIKE/ILIKE/SIMILAR
-%nonassoc  CONTAINS CONTAINED_BY '?' JSON_SOME_EXISTS JSON_ALL_EXISTS
+%nonassoc  CONTAINS CONTAINED_BY '?' JSON_SOME_EXISTS JSON_ALL_EXISTS JSON_PATH_EXISTS
 %nonassoc  OVERLAPS
 %left      POSTFIXOP           // dummy for postfix OP rules
 // To support target_elem without AS, we must give IDENT an explicit priority
@@ -1398,7 +1463,7 @@ func (u *sqlSymUnion) aggregatesToDrop() []tree.AggregateToDrop {
 // funny behavior of UNBOUNDED on the SQL standard, though.
 %nonassoc  UNBOUNDED         // ideally should have same precedence as IDENT
 %nonassoc  IDENT NULL PARTITION RANGE ROWS GROUPS PRECEDING FOLLOWING CUBE ROLLUP
-%left      CONCAT FETCHVAL FETCHTEXT FETCHVAL_PATH FETCHTEXT_PATH REMOVE_PATH  // multi-character ops
+%left      CONCAT FETCHVAL FETCHTEXT FETCHVAL_PATH FETCHTEXT_PATH REMOVE_PATH OPERATOR  // multi-character ops
 %left      '|'
 %left      '#'
 %left      '&'
@@ -1446,19 +1511,26 @@ non_transaction_stmt:
 | analyze_stmt      // EXTEND WITH HELP: ANALYZE
 | call_stmt
 | copy_from_stmt
+| copy_to_stmt
 | comment_stmt
 | execute_stmt      // EXTEND WITH HELP: EXECUTE
 | deallocate_stmt   // EXTEND WITH HELP: DEALLOCATE
 | discard_stmt      // EXTEND WITH HELP: DISCARD
 | grant_stmt        // EXTEND WITH HELP: GRANT
+| listen_stmt       // EXTEND WITH HELP: LISTEN
+| notify_stmt       // EXTEND WITH HELP: NOTIFY
 | prepare_stmt      // EXTEND WITH HELP: PREPARE
 | revoke_stmt       // EXTEND WITH HELP: REVOKE
 | savepoint_stmt    // EXTEND WITH HELP: SAVEPOINT
 | release_stmt      // EXTEND WITH HELP: RELEASE
 | refresh_stmt      // EXTEND WITH HELP: REFRESH
 | set_stmt // help texts in sub-rule
-| close_cursor_stmt
-| declare_cursor_stmt
+| unlisten_stmt     // EXTEND WITH HELP: UNLISTEN
+| close_cursor_stmt // EXTEND WITH HELP: CLOSE
+| declare_cursor_stmt // EXTEND WITH HELP: DECLARE
+| fetch_cursor_stmt // EXTEND WITH HELP: FETCH
+| move_cursor_stmt // EXTEND WITH HELP: MOVE
+| lock_stmt // EXTEND WITH HELP: LOCK
 | reindex_stmt
 
 stmt_list:
@@ -1497,6 +1569,7 @@ alter_ddl_stmt:
 | alter_trigger_stmt            // EXTEND WITH HELP: ALTER TRIGGER
 | alter_language_stmt           // EXTEND WITH HELP: ALTER LANGUAGE
 | alter_domain_stmt             // EXTEND WITH HELP: ALTER DOMAIN
+| alter_publication_stmt        // EXTEND WITH HELP: ALTER PUBLICATION
 
 // %Help: ALTER TABLE - change the definition of a table
 // %Category: DDL
@@ -1829,6 +1902,43 @@ alter_language_stmt:
     $$.val = &tree.AlterLanguage{Name: tree.Name($4), Procedural: $2.bool(), Owner: $5}
   }
 
+// %Help: ALTER PUBLICATION - change the definition of a publication
+// %Category: DDL
+// %Text:
+// ALTER PUBLICATION <name> ADD TABLE <tablename> [, ...]
+// ALTER PUBLICATION <name> SET TABLE <tablename> [, ...]
+// ALTER PUBLICATION <name> DROP TABLE <tablename> [, ...]
+// ALTER PUBLICATION <name> SET ( <parameter> [= <value>] [, ...] )
+// ALTER PUBLICATION <name> OWNER TO <role>
+// ALTER PUBLICATION <name> RENAME TO <newname>
+// %SeeAlso: CREATE PUBLICATION, DROP PUBLICATION
+alter_publication_stmt:
+  ALTER PUBLICATION name ADD TABLE table_name_list
+  {
+    $$.val = &tree.AlterPublication{Name: tree.Name($3), Action: tree.AlterPublicationAddTable, Tables: $6.tableNames()}
+  }
+| ALTER PUBLICATION name SET TABLE table_name_list
+  {
+    $$.val = &tree.AlterPublication{Name: tree.Name($3), Action: tree.AlterPublicationSetTable, Tables: $6.tableNames()}
+  }
+| ALTER PUBLICATION name DROP TABLE table_name_list
+  {
+    $$.val = &tree.AlterPublication{Name: tree.Name($3), Action: tree.AlterPublicationDropTable, Tables: $6.tableNames()}
+  }
+| ALTER PUBLICATION name SET '(' storage_parameter_list ')'
+  {
+    $$.val = &tree.AlterPublication{Name: tree.Name($3), Action: tree.AlterPublicationSetParams, Params: $6.storageParams()}
+  }
+| ALTER PUBLICATION name owner_to
+  {
+    $$.val = &tree.AlterPublication{Name: tree.Name($3), Action: tree.AlterPublicationOwner, Owner: $4}
+  }
+| ALTER PUBLICATION name RENAME TO name
+  {
+    $$.val = &tree.AlterPublication{Name: tree.Name($3), Action: tree.AlterPublicationRename, NewName: tree.Name($6)}
+  }
+| ALTER PUBLICATION error // SHOW HELP: ALTER PUBLICATION
+
 alter_domain_stmt:
   ALTER DOMAIN type_name alter_domain_cmd
   {
@@ -3547,7 +3657,7 @@ call_stmt:
 // 1) The "really old" syntax from v7.2 and prior
 // 2) Pre 9.0 using hard-wired, space-separated options
 // 3) The current and preferred options using comma-separated generic identifiers instead of keywords.
-// We currently support only the #2 format.
+// We support the #2 and #3 formats.
 // See the comment for CopyStmt in https://github.com/postgres/postgres/blob/master/src/backend/parser/gram.y.
 copy_from_stmt:
   COPY table_name opt_column_list FROM STDIN opt_with_copy_options
@@ -3561,6 +3671,24 @@ copy_from_stmt:
     }
   }
 
+copy_to_stmt:
+  COPY table_name opt_column_list TO STDOUT opt_with_copy_options
+  {
+    name := $2.unresolvedObjectName().ToTableName()
+    $$.val = &tree.CopyTo{
+       Table: name,
+       Columns: $3.nameList(),
+       Options: *$6.copyOptions(),
+    }
+  }
+| COPY select_with_parens TO STDOUT opt_with_copy_options
+  {
+    $$.val = &tree.CopyTo{
+       Query: $2.selectStmt().(*tree.ParenSelect).Select,
+       Options: *$5.copyOptions(),
+    }
+  }
+
 opt_with_copy_options:
   opt_with copy_options_list
   {
@@ -3592,6 +3720,70 @@ copy_options:
   {
     $$.val = &tree.CopyOptions{CopyFormat: tree.CopyFormatBinary}
   }
+| CSV
+  {
+    $$.val = &tree.CopyOptions{CopyFormat: tree.CopyFormatCSV}
+  }
+| HEADER
+  {
+    $$.val = &tree.CopyOptions{Header: true}
+  }
+| DELIMITER opt_as SCONST
+  {
+    $$.val = &tree.CopyOptions{Delimiter: tree.NewStrVal($3)}
+  }
+| NULL opt_as SCONST
+  {
+    $$.val = &tree.CopyOptions{Null: tree.NewStrVal($3)}
+  }
+| QUOTE opt_as SCONST
+  {
+    $$.val = &tree.CopyOptions{Quote: tree.NewStrVal($3)}
+  }
+| ESCAPE opt_as SCONST
+  {
+    $$.val = &tree.CopyOptions{Escape: tree.NewStrVal($3)}
+  }
+| '(' copy_generic_options_list ')'
+  {
+    $$.val = $2.copyOptions()
+  }
+
+copy_generic_options_list:
+  copy_generic_option
+  {
+    $$.val = $1.copyOptions()
+  }
+| copy_generic_options_list ',' copy_generic_option
+  {
+    if err := $1.copyOptions().CombineWith($3.copyOptions()); err != nil {
+      return setErr(sqllex, err)
+    }
+  }
+
+copy_generic_option:
+  unrestricted_name
+  {
+    opts, err := tree.NewCopyGenericOption($1, "")
+    if err != nil {
+      return setErr(sqllex, err)
+    }
+    $$.val = opts
+  }
+| unrestricted_name copy_generic_option_arg
+  {
+    opts, err := tree.NewCopyGenericOption($1, $2)
+    if err != nil {
+      return setErr(sqllex, err)
+    }
+    $$.val = opts
+  }
+
+copy_generic_option_arg:
+  non_reserved_word_or_sconst
+| TRUE
+| FALSE
+| ON
 
 // %Help: CANCEL
 // %Category: Group
@@ -3934,18 +4126,162 @@ create_stmt:
 | create_extension_stmt // EXTEND WITH HELP: CREATE EXTENSION
 | create_language_stmt  // EXTEND WITH HELP: CREATE LANGUAGE
 | create_aggregate_stmt // EXTEND WITH HELP: CREATE AGGREGATE
+| create_masking_policy_stmt // EXTEND WITH HELP: CREATE MASKING POLICY
+| create_server_stmt    // EXTEND WITH HELP: CREATE SERVER
+| create_publication_stmt // EXTEND WITH HELP: CREATE PUBLICATION
+| create_foreign_table_stmt // EXTEND WITH HELP: CREATE FOREIGN TABLE
 | create_unsupported   {}
 | CREATE error         // SHOW HELP: CREATE
 
+// %Help: CREATE MASKING POLICY - mask a column's values for a role
+// %Category: DDL
+// %Text:
+// CREATE MASKING POLICY <name> ON [<schema>.]<table>.<column> FOR <role> USING <expr>
+// %SeeAlso: DROP MASKING POLICY
+create_masking_policy_stmt:
+  CREATE MASKING POLICY name ON prefixed_column_path FOR role_spec USING a_expr
+  {
+    $$.val = &tree.CreateMaskingPolicy{
+      Name: tree.Name($4),
+      Column: $6.unresolvedName(),
+      Role: $8,
+      Expr: $10.expr(),
+    }
+  }
+| CREATE MASKING error // SHOW HELP: CREATE MASKING POLICY
+
+// %Help: CREATE PUBLICATION - define a set of tables whose changes are published
+// %Category: DDL
+// %Text:
+// CREATE PUBLICATION <name>
+//   [FOR ALL TABLES | FOR TABLE <tablename> [, ...]]
+//   [WITH ( <parameter> [= <value>] [, ...] )]
+// %SeeAlso: ALTER PUBLICATION, DROP PUBLICATION
+create_publication_stmt:
+  CREATE PUBLICATION name opt_publication_tables opt_with_storage_parameter_list
+  {
+    $$.val = &tree.CreatePublication{Name: tree.Name($3), Tables: $4.publicationTables(), Params: $5.storageParams()}
+  }
+| CREATE PUBLICATION error // SHOW HELP: CREATE PUBLICATION
+
+opt_publication_tables:
+  FOR ALL TABLES
+  {
+    $$.val = &tree.PublicationTables{AllTables: true}
+  }
+| FOR TABLE table_name_list
+  {
+    $$.val = &tree.PublicationTables{Tables: $3.tableNames()}
+  }
+| /* EMPTY */
+  {
+    $$.val = nil
+  }
+
+// %Help: CREATE SERVER - define a foreign server
+// %Category: DDL
+// %Text:
+// CREATE SERVER [IF NOT EXISTS] <name> FOREIGN DATA WRAPPER <wrapper> [OPTIONS (<option> '<value>' [, ...])]
+// %SeeAlso: DROP SERVER, CREATE FOREIGN TABLE
+create_server_stmt:
+  CREATE SERVER name FOREIGN DATA WRAPPER name opt_foreign_options
+  {
+    $$.val = &tree.CreateServer{Name: tree.Name($3), Wrapper: tree.Name($7), Options: $8.foreignOptions()}
+  }
+| CREATE SERVER IF NOT EXISTS name FOREIGN DATA WRAPPER name opt_foreign_options
+  {
+    $$.val = &tree.CreateServer{Name: tree.Name($6), IfNotExists: true, Wrapper: tree.Name($10), Options: $11.foreignOptions()}
+  }
+| CREATE SERVER error // SHOW HELP: CREATE SERVER
+
+// %Help: CREATE FOREIGN TABLE - define a table whose rows are read from a foreign server
+// %Category: DDL
+// %Text:
+// CREATE FOREIGN TABLE [IF NOT EXISTS] <tablename> (
+//   <colname> <type> [OPTIONS (<option> '<value>' [, ...])] [<constraint>...] [, ...]
+// ) SERVER <server> [OPTIONS (<option> '<value>' [, ...])]
+// %SeeAlso: DROP FOREIGN TABLE, CREATE SERVER
+create_foreign_table_stmt:
+  CREATE FOREIGN TABLE table_name '(' opt_foreign_column_list ')' SERVER name opt_foreign_options
+  {
+    $$.val = &tree.CreateForeignTable{
+      Table: $4.unresolvedObjectName().ToTableName(),
+      Columns: $6.foreignColumnDefs(),
+      Server: tree.Name($9),
+      Options: $10.foreignOptions(),
+    }
+  }
+| CREATE FOREIGN TABLE IF NOT EXISTS table_name '(' opt_foreign_column_list ')' SERVER name opt_foreign_options
+  {
+    $$.val = &tree.CreateForeignTable{
+      Table: $7.unresolvedObjectName().ToTableName(),
+      IfNotExists: true,
+      Columns: $9.foreignColumnDefs(),
+      Server: tree.Name($12),
+      Options: $13.foreignOptions(),
+    }
+  }
+| CREATE FOREIGN TABLE error // SHOW HELP: CREATE FOREIGN TABLE
+
+opt_foreign_column_list:
+  foreign_column_list
+| /* EMPTY */
+  {
+    $$.val = []tree.ForeignColumnTableDef(nil)
+  }
+
+foreign_column_list:
+  foreign_column_def
+  {
+    $$.val = []tree.ForeignColumnTableDef{$1.foreignColumnDef()}
+  }
+| foreign_column_list ',' foreign_column_def
+  {
+    $$.val = append($1.foreignColumnDefs(), $3.foreignColumnDef())
+  }
+
+foreign_column_def:
+  column_name typename opt_foreign_options col_constraint_list
+  {
+    tableDef, err := tree.NewColumnTableDef(tree.Name($1), $2.typeReference(), "", "", $4.colQuals())
+    if err != nil {
+      return setErr(sqllex, err)
+    }
+    $$.val = tree.ForeignColumnTableDef{Column: tableDef, Options: $3.foreignOptions()}
+  }
+
+opt_foreign_options:
+  OPTIONS '(' foreign_option_list ')'
+  {
+    $$.val = $3.foreignOptions()
+  }
+| /* EMPTY */
+  {
+    $$.val = tree.ForeignOptions(nil)
+  }
+
+foreign_option_list:
+  foreign_option
+  {
+    $$.val = tree.ForeignOptions{$1.foreignOption()}
+  }
+| foreign_option_list ',' foreign_option
+  {
+    $$.val = append($1.foreignOptions(), $3.foreignOption())
+  }
+
+foreign_option:
+  unrestricted_name SCONST
+  {
+    $$.val = tree.ForeignOption{Name: tree.Name($1), Value: $2}
+  }
+
 create_unsupported:
   CREATE CAST error { return unimplemented(sqllex, "create cast") }
 | CREATE CONVERSION error { return unimplemented(sqllex, "create conversion") }
 | CREATE DEFAULT CONVERSION error { return unimplemented(sqllex, "create def conv") }
-| CREATE FOREIGN TABLE error { return unimplemented(sqllex, "create foreign table") }
 | CREATE OPERATOR error { return unimplemented(sqllex, "create operator") }
-| CREATE PUBLICATION error { return unimplemented(sqllex, "create publication") }
 | CREATE opt_or_replace RULE error { return unimplemented(sqllex, "create rule") }
-| CREATE SERVER error { return unimplemented(sqllex, "create server") }
 | CREATE SUBSCRIPTION error { return unimplemented(sqllex, "create subscription") }
 | CREATE TEXT error { return unimplementedWithIssueDetail(sqllex, 7821, "create text") }
 
@@ -4551,16 +4887,73 @@ opt_procedural:
     $$.val = true
   }
 
+// %Help: DROP MASKING POLICY - remove a masking policy
+// %Category: DDL
+// %Text: DROP MASKING POLICY [IF EXISTS] <name>
+// %SeeAlso: CREATE MASKING POLICY
+drop_masking_policy_stmt:
+  DROP MASKING POLICY name
+  {
+    $$.val = &tree.DropMaskingPolicy{Name: tree.Name($4), IfExists: false}
+  }
+| DROP MASKING POLICY IF EXISTS name
+  {
+    $$.val = &tree.DropMaskingPolicy{Name: tree.Name($6), IfExists: true}
+  }
+| DROP MASKING error // SHOW HELP: DROP MASKING POLICY
+
+// %Help: DROP PUBLICATION - remove a publication
+// %Category: DDL
+// %Text: DROP PUBLICATION [IF EXISTS] <name> [, ...] [CASCADE | RESTRICT]
+// %SeeAlso: CREATE PUBLICATION, ALTER PUBLICATION
+drop_publication_stmt:
+  DROP PUBLICATION name_list opt_drop_behavior
+  {
+    $$.val = &tree.DropPublication{Names: $3.nameList(), IfExists: false, DropBehavior: $4.dropBehavior()}
+  }
+| DROP PUBLICATION IF EXISTS name_list opt_drop_behavior
+  {
+    $$.val = &tree.DropPublication{Names: $5.nameList(), IfExists: true, DropBehavior: $6.dropBehavior()}
+  }
+| DROP PUBLICATION error // SHOW HELP: DROP PUBLICATION
+
+// %Help: DROP SERVER - remove a foreign server
+// %Category: DDL
+// %Text: DROP SERVER [IF EXISTS] <name> [, ...] [CASCADE | RESTRICT]
+// %SeeAlso: CREATE SERVER
+drop_server_stmt:
+  DROP SERVER name_list opt_drop_behavior
+  {
+    $$.val = &tree.DropServer{Names: $3.nameList(), IfExists: false, DropBehavior: $4.dropBehavior()}
+  }
+| DROP SERVER IF EXISTS name_list opt_drop_behavior
+  {
+    $$.val = &tree.DropServer{Names: $5.nameList(), IfExists: true, DropBehavior: $6.dropBehavior()}
+  }
+| DROP SERVER error // SHOW HELP: DROP SERVER
+
+// %Help: DROP FOREIGN TABLE - remove a foreign table
+// %Category: DDL
+// %Text: DROP FOREIGN TABLE [IF EXISTS] <tablename> [, ...] [CASCADE | RESTRICT]
+// %SeeAlso: CREATE FOREIGN TABLE
+drop_foreign_table_stmt:
+  DROP FOREIGN TABLE table_name_list opt_drop_behavior
+  {
+    $$.val = &tree.DropForeignTable{Names: $4.tableNames(), IfExists: false, DropBehavior: $5.dropBehavior()}
+  }
+| DROP FOREIGN TABLE IF EXISTS table_name_list opt_drop_behavior
+  {
+    $$.val = &tree.DropForeignTable{Names: $6.tableNames(), IfExists: true, DropBehavior: $7.dropBehavior()}
+  }
+| DROP FOREIGN TABLE error // SHOW HELP: DROP FOREIGN TABLE
+
 drop_unsupported:
   DROP CAST error { return unimplemented(sqllex, "drop cast") }
 | DROP COLLATION error { return unimplemented(sqllex, "drop collation") }
 | DROP CONVERSION error { return unimplemented(sqllex, "drop conversion") }
-| DROP FOREIGN TABLE error { return unimplemented(sqllex, "drop foreign table") }
 | DROP FOREIGN DATA error { return unimplemented(sqllex, "drop fdw") }
 | DROP OPERATOR error { return unimplemented(sqllex, "drop operator") }
-| DROP PUBLICATION error { return unimplemented(sqllex, "drop publication") }
 | DROP RULE error { return unimplemented(sqllex, "drop rule") }
-| DROP SERVER error { return unimplemented(sqllex, "drop server") }
 | DROP SUBSCRIPTION error { return unimplemented(sqllex, "drop subscription") }
 | DROP TEXT error { return unimplementedWithIssueDetail(sqllex, 7821, "drop text") }
 
@@ -4847,6 +5240,47 @@ discard_stmt:
 | DISCARD TEMPORARY { return unimplemented(sqllex, "discard temp") }
 | DISCARD error // SHOW HELP: DISCARD
 
+// %Help: LISTEN - listen for a notification
+// %Category: Misc
+// %Text: LISTEN <channel>
+// %SeeAlso: NOTIFY, UNLISTEN
+listen_stmt:
+  LISTEN name
+  {
+    $$.val = &tree.Listen{Channel: tree.Name($2)}
+  }
+| LISTEN error // SHOW HELP: LISTEN
+
+// %Help: NOTIFY - generate a notification
+// %Category: Misc
+// %Text: NOTIFY <channel> [ , <payload> ]
+// %SeeAlso: LISTEN, UNLISTEN
+notify_stmt:
+  NOTIFY name
+  {
+    $$.val = &tree.Notify{Channel: tree.Name($2)}
+  }
+| NOTIFY name ',' SCONST
+  {
+    $$.val = &tree.Notify{Channel: tree.Name($2), Payload: $4}
+  }
+| NOTIFY error // SHOW HELP: NOTIFY
+
+// %Help: UNLISTEN - stop listening for a notification
+// %Category: Misc
+// %Text: UNLISTEN { <channel> | * }
+// %SeeAlso: LISTEN, NOTIFY
+unlisten_stmt:
+  UNLISTEN name
+  {
+    $$.val = &tree.Unlisten{Channel: tree.Name($2)}
+  }
+| UNLISTEN '*'
+  {
+    $$.val = &tree.Unlisten{All: true}
+  }
+| UNLISTEN error // SHOW HELP: UNLISTEN
+
 // %Help: DROP
 // %Category: Group
 // %Text:
@@ -4862,6 +5296,10 @@ drop_stmt:
 | drop_extension_stmt // EXTEND WITH HELP: DROP EXTENSION
 | drop_language_stmt // EXTEND WITH HELP: DROP LANGUAGE
 | drop_aggregate_stmt // EXTEND WITH HELP: DROP AGGREGATE
+| drop_masking_policy_stmt // EXTEND WITH HELP: DROP MASKING POLICY
+| drop_server_stmt   // EXTEND WITH HELP: DROP SERVER
+| drop_publication_stmt // EXTEND WITH HELP: DROP PUBLICATION
+| drop_foreign_table_stmt // EXTEND WITH HELP: DROP FOREIGN TABLE
 | drop_unsupported   {}
 | DROP error         // SHOW HELP: DROP
 
@@ -4945,7 +5383,7 @@ drop_trigger_stmt:
   }
 | DROP TRIGGER IF EXISTS trigger_name ON table_name opt_drop_behavior
   {
-    $$.val = &tree.DropTrigger{Name: tree.Name($5), OnTable: $7.unresolvedObjectName().ToTableName(), DropBehavior: $8.dropBehavior()}
+    $$.val = &tree.DropTrigger{Name: tree.Name($5), IfExists: true, OnTable: $7.unresolvedObjectName().ToTableName(), DropBehavior: $8.dropBehavior()}
   }
 
 // %Help: DROP INDEX - remove an index
@@ -5104,31 +5542,33 @@ table_name_list:
 // %Help: ANALYZE - collect table statistics
 // %Category: Misc
 // %Text:
-// ANALYZE <tablename>
+// ANALYZE [<tablename> [, ...]]
 //
 // %SeeAlso: CREATE STATISTICS
 analyze_stmt:
-  ANALYZE analyze_target
+  ANALYZE
+  {
+    $$.val = &tree.Analyze{}
+  }
+| ANALYZE table_name_list
   {
     $$.val = &tree.Analyze{
-      Table: $2.tblExpr(),
+      Tables: $2.tableNames(),
     }
   }
 | ANALYZE error // SHOW HELP: ANALYZE
-| ANALYSE analyze_target
+| ANALYSE
+  {
+    $$.val = &tree.Analyze{}
+  }
+| ANALYSE table_name_list
   {
     $$.val = &tree.Analyze{
-      Table: $2.tblExpr(),
+      Tables: $2.tableNames(),
     }
   }
 | ANALYSE error // SHOW HELP: ANALYZE
 
-analyze_target:
-  table_name
-  {
-    $$.val = $1.unresolvedObjectName()
-  }
-
 // %Help: EXPLAIN - show the logical plan of a query
 // %Category: Misc
 // %Text:
@@ -5657,7 +6097,7 @@ privilege:
 // %Category: Cfg
 // %Text: RESET [SESSION] <var>
 reset_stmt:
-  RESET name
+  RESET var_name
   {
     name := $2
     if name == "role" {
@@ -5820,12 +6260,12 @@ set_exprs_internal:
 // %Help: SET TRANSACTION - configure the transaction settings
 // %Category: Txn
 // %Text:
-// SET [SESSION] TRANSACTION <txnparameters...>
+// SET TRANSACTION <txnparameters...>
+// SET SESSION CHARACTERISTICS AS TRANSACTION <txnparameters...>
 //
 // Transaction parameters:
-//    ISOLATION LEVEL { SNAPSHOT | SERIALIZABLE }
-//    PRIORITY { LOW | NORMAL | HIGH }
-//    AS OF SYSTEM TIME <expr>
+//    ISOLATION LEVEL { SERIALIZABLE | REPEATABLE READ | READ COMMITTED | READ UNCOMMITTED }
+//    READ { WRITE | ONLY }
 //    [NOT] DEFERRABLE
 set_transaction_stmt:
   SET TRANSACTION transaction_mode_list
@@ -5896,15 +6336,23 @@ set_session_or_local_cmd:
 
 generic_set_single_config:
   // var_value includes DEFAULT expr
-  name to_or_eq var_list
+  var_name to_or_eq var_list
   {
     $$.val = &tree.SetVar{Name: $1, Values: $3.exprs()}
   }
-| name FROM CURRENT
+| var_name FROM CURRENT
   {
     $$.val = &tree.SetVar{Name: $1, FromCurrent: true}
   }
 
+// Qualified names are used by extensions to namespace their own parameters, such as pg_hint_plan.enable_hint.
+var_name:
+  name
+| var_name '.' name
+  {
+    $$ = $1 + "." + $3
+  }
+
 var_list:
   var_value
   {
@@ -6002,19 +6450,19 @@ extra_var_value:
 iso_level:
   READ UNCOMMITTED
   {
-    $$.val = tree.SerializableIsolation
+    $$.val = tree.ReadUncommittedIsolation
   }
 | READ COMMITTED
   {
-    $$.val = tree.SerializableIsolation
+    $$.val = tree.ReadCommittedIsolation
   }
 | SNAPSHOT
   {
-    $$.val = tree.SerializableIsolation
+    $$.val = tree.RepeatableReadIsolation
   }
 | REPEATABLE READ
   {
-    $$.val = tree.SerializableIsolation
+    $$.val = tree.RepeatableReadIsolation
   }
 | SERIALIZABLE
   {
@@ -6104,14 +6552,234 @@ show_stmt:
 | SHOW error                // SHOW HELP: SHOW
 | show_last_query_stats_stmt // EXTEND WITH HELP: SHOW LAST QUERY STATISTICS
 
-// Cursors are not yet supported by CockroachDB. CLOSE ALL is safe to no-op
-// since there will be no open cursors.
+// %Help: CLOSE - close a cursor
+// %Category: Misc
+// %Text: CLOSE { <name> | ALL }
+// %SeeAlso: DECLARE, FETCH, MOVE
 close_cursor_stmt:
-	CLOSE ALL { }
-| CLOSE cursor_name { return unimplementedWithIssue(sqllex, 41412) }
+  CLOSE ALL
+  {
+    $$.val = &tree.CloseCursor{All: true}
+  }
+| CLOSE cursor_name
+  {
+    $$.val = &tree.CloseCursor{Name: tree.Name($2)}
+  }
+| CLOSE error // SHOW HELP: CLOSE
 
+// %Help: DECLARE - define a cursor
+// %Category: Misc
+// %Text:
+// DECLARE <name> [ BINARY ] [ ASENSITIVE | INSENSITIVE ] [ [ NO ] SCROLL ]
+//   CURSOR [ { WITH | WITHOUT } HOLD ] FOR <selectclause>
+// %SeeAlso: CLOSE, FETCH, MOVE
 declare_cursor_stmt:
-	DECLARE { return unimplementedWithIssue(sqllex, 41412) }
+  DECLARE cursor_name opt_binary opt_cursor_sensitivity opt_scroll CURSOR opt_hold FOR select_stmt
+  {
+    $$.val = &tree.DeclareCursor{
+      Name: tree.Name($2),
+      Binary: $3.bool(),
+      Sensitivity: $4.cursorSensitivity(),
+      Scroll: $5.cursorScrollOption(),
+      Hold: $7.bool(),
+      Select: $9.slct(),
+    }
+  }
+| DECLARE error // SHOW HELP: DECLARE
+
+opt_binary:
+  BINARY
+  {
+    $$.val = true
+  }
+| /* EMPTY */
+  {
+    $$.val = false
+  }
+
+opt_cursor_sensitivity:
+  INSENSITIVE
+  {
+    $$.val = tree.Insensitive
+  }
+| ASENSITIVE
+  {
+    $$.val = tree.Asensitive
+  }
+| /* EMPTY */
+  {
+    $$.val = tree.UnspecifiedSensitivity
+  }
+
+opt_scroll:
+  SCROLL
+  {
+    $$.val = tree.Scroll
+  }
+| NO SCROLL
+  {
+    $$.val = tree.NoScroll
+  }
+| /* EMPTY */
+  {
+    $$.val = tree.UnspecifiedScroll
+  }
+
+opt_hold:
+  WITH HOLD
+  {
+    $$.val = true
+  }
+| WITHOUT HOLD
+  {
+    $$.val = false
+  }
+| /* EMPTY */
+  {
+    $$.val = false
+  }
+
+// %Help: FETCH - retrieve rows from a query using a cursor
+// %Category: Misc
+// %Text:
+// FETCH [ <direction> ] [ FROM | IN ] <name>
+//
+// Direction:
+//   NEXT | PRIOR | FIRST | LAST | ABSOLUTE <count> | RELATIVE <count> | <count> | ALL
+//   | FORWARD | FORWARD <count> | FORWARD ALL | BACKWARD | BACKWARD <count> | BACKWARD ALL
+// %SeeAlso: CLOSE, DECLARE, MOVE
+fetch_cursor_stmt:
+  FETCH cursor_movement_specifier
+  {
+    $$.val = &tree.FetchCursor{CursorStmt: $2.cursorStmt()}
+  }
+| FETCH error // SHOW HELP: FETCH
+
+// %Help: MOVE - position a cursor
+// %Category: Misc
+// %Text:
+// MOVE [ <direction> ] [ FROM | IN ] <name>
+//
+// Direction:
+//   NEXT | PRIOR | FIRST | LAST | ABSOLUTE <count> | RELATIVE <count> | <count> | ALL
+//   | FORWARD | FORWARD <count> | FORWARD ALL | BACKWARD | BACKWARD <count> | BACKWARD ALL
+// %SeeAlso: CLOSE, DECLARE, FETCH
+move_cursor_stmt:
+  MOVE cursor_movement_specifier
+  {
+    $$.val = &tree.MoveCursor{CursorStmt: $2.cursorStmt()}
+  }
+| MOVE error // SHOW HELP: MOVE
+
+// %Help: LOCK - lock a table
+// %Category: Txn
+// %Text:
+// LOCK [ TABLE ] [ ONLY ] <name> [ * ] [, ...] [ IN <lockmode> MODE ] [ NOWAIT ]
+//
+// Lock modes:
+//   ACCESS SHARE | ROW SHARE | ROW EXCLUSIVE | SHARE UPDATE EXCLUSIVE
+//   | SHARE | SHARE ROW EXCLUSIVE | EXCLUSIVE | ACCESS EXCLUSIVE
+// %SeeAlso: BEGIN, SELECT
+lock_stmt:
+  LOCK opt_table relation_expr_list opt_lock_mode opt_nowait
+  {
+    $$.val = &tree.LockTable{Tables: $3.tableNames(), Mode: $4.lockTableMode(), NoWait: $5.bool()}
+  }
+| LOCK error // SHOW HELP: LOCK
+
+opt_lock_mode:
+  IN lock_mode MODE
+  {
+    $$.val = $2.lockTableMode()
+  }
+| /* EMPTY */
+  {
+    $$.val = tree.LockAccessExclusive
+  }
+
+lock_mode:
+  ACCESS SHARE           { $$.val = tree.LockAccessShare }
+| ROW SHARE              { $$.val = tree.LockRowShare }
+| ROW EXCLUSIVE          { $$.val = tree.LockRowExclusive }
+| SHARE UPDATE EXCLUSIVE { $$.val = tree.LockShareUpdateExclusive }
+| SHARE                  { $$.val = tree.LockShare }
+| SHARE ROW EXCLUSIVE    { $$.val = tree.LockShareRowExclusive }
+| EXCLUSIVE              { $$.val = tree.LockExclusive }
+| ACCESS EXCLUSIVE       { $$.val = tree.LockAccessExclusive }
+
+cursor_movement_specifier:
+  cursor_name
+  {
+    $$.val = tree.CursorStmt{Name: tree.Name($1), FetchType: tree.FetchNormal, Count: 1}
+  }
+| from_or_in cursor_name
+  {
+    $$.val = tree.CursorStmt{Name: tree.Name($2), FetchType: tree.FetchNormal, Count: 1}
+  }
+| NEXT opt_from_or_in cursor_name
+  {
+    $$.val = tree.CursorStmt{Name: tree.Name($3), FetchType: tree.FetchNormal, Count: 1}
+  }
+| PRIOR opt_from_or_in cursor_name
+  {
+    $$.val = tree.CursorStmt{Name: tree.Name($3), FetchType: tree.FetchNormal, Count: -1}
+  }
+| FIRST opt_from_or_in cursor_name
+  {
+    $$.val = tree.CursorStmt{Name: tree.Name($3), FetchType: tree.FetchFirst}
+  }
+| LAST opt_from_or_in cursor_name
+  {
+    $$.val = tree.CursorStmt{Name: tree.Name($3), FetchType: tree.FetchLast}
+  }
+| ABSOLUTE signed_iconst64 opt_from_or_in cursor_name
+  {
+    $$.val = tree.CursorStmt{Name: tree.Name($4), FetchType: tree.FetchAbsolute, Count: $2.int64()}
+  }
+| RELATIVE signed_iconst64 opt_from_or_in cursor_name
+  {
+    $$.val = tree.CursorStmt{Name: tree.Name($4), FetchType: tree.FetchRelative, Count: $2.int64()}
+  }
+| signed_iconst64 opt_from_or_in cursor_name
+  {
+    $$.val = tree.CursorStmt{Name: tree.Name($3), FetchType: tree.FetchNormal, Count: $1.int64()}
+  }
+| ALL opt_from_or_in cursor_name
+  {
+    $$.val = tree.CursorStmt{Name: tree.Name($3), FetchType: tree.FetchAll}
+  }
+| FORWARD opt_from_or_in cursor_name
+  {
+    $$.val = tree.CursorStmt{Name: tree.Name($3), FetchType: tree.FetchNormal, Count: 1}
+  }
+| FORWARD signed_iconst64 opt_from_or_in cursor_name
+  {
+    $$.val = tree.CursorStmt{Name: tree.Name($4), FetchType: tree.FetchNormal, Count: $2.int64()}
+  }
+| FORWARD ALL opt_from_or_in cursor_name
+  {
+    $$.val = tree.CursorStmt{Name: tree.Name($4), FetchType: tree.FetchAll}
+  }
+| BACKWARD opt_from_or_in cursor_name
+  {
+    $$.val = tree.CursorStmt{Name: tree.Name($3), FetchType: tree.FetchNormal, Count: -1}
+  }
+| BACKWARD signed_iconst64 opt_from_or_in cursor_name
+  {
+    $$.val = tree.CursorStmt{Name: tree.Name($4), FetchType: tree.FetchNormal, Count: -$2.int64()}
+  }
+| BACKWARD ALL opt_from_or_in cursor_name
+  {
+    $$.val = tree.CursorStmt{Name: tree.Name($4), FetchType: tree.FetchBackwardAll}
+  }
+
+from_or_in:
+  FROM {}
+| IN {}
+
+opt_from_or_in:
+  from_or_in {}
+| /* EMPTY */ {}
 
 reindex_stmt:
   REINDEX TABLE error
@@ -6146,6 +6814,10 @@ show_session_stmt:
 
 session_var:
   IDENT
+| IDENT '.' name
+  {
+    $$ = $1 + "." + $3
+  }
 // Although ALL, SESSION_USER and DATABASE are identifiers for the
 // purpose of SHOW, they lex as separate token types, so they need
 // separate rules.
@@ -6909,6 +7581,7 @@ targets_table:
     // of increasing (or attempting to modify) the grey magic occurring
     // here.
     $$.val = tree.TargetList{
+      TargetType: privilege.Table,
       Tables: tree.TablePatterns{&tree.UnresolvedName{NumParts:1, Parts: tree.NameParts{$1}}},
       ForRoles: $1 == "role", // backdoor for "SHOW GRANTS ON ROLE" (no name list)
     }
@@ -7818,6 +8491,7 @@ table_constraint_elem:
         Columns: $4.idxElems(),
 	IndexParams: $6.constraintIdxParams(),
       },
+      Using: $2,
       Predicate: $7.expr(),
     }
   }
@@ -7834,13 +8508,13 @@ table_constraint_elem:
   }
 
 exclude_elems:
-  index_elem WITH math_op
+  index_elem WITH operator
   {
     el := $1.idxElem()
     el.ExcludeOp = $3.op()
     $$.val = tree.IndexElemList{el}
   }
-| exclude_elems ',' index_elem WITH math_op
+| exclude_elems ',' index_elem WITH operator
   {
     el := $3.idxElem()
     el.ExcludeOp = $5.op()
@@ -8224,7 +8898,7 @@ password_clause:
 
 create_trigger_stmt:
   CREATE opt_constraint TRIGGER trigger_name trigger_time trigger_events ON table_name opt_from_ref_table
-  opt_trigger_deferrable_mode opt_trigger_relations opt_for_each opt_when EXECUTE function_or_procedure routine_name '(' name_list ')'
+  opt_trigger_deferrable_mode opt_trigger_relations opt_for_each opt_when EXECUTE function_or_procedure routine_name '(' opt_trigger_func_args ')'
   {
     $$.val = &tree.CreateTrigger{
       Replace: false,
@@ -8243,7 +8917,7 @@ create_trigger_stmt:
     }
   }
 | CREATE OR REPLACE opt_constraint TRIGGER trigger_name trigger_time trigger_events ON table_name opt_from_ref_table
-  opt_trigger_deferrable_mode opt_trigger_relations opt_for_each opt_when EXECUTE function_or_procedure routine_name '(' name_list ')'
+  opt_trigger_deferrable_mode opt_trigger_relations opt_for_each opt_when EXECUTE function_or_procedure routine_name '(' opt_trigger_func_args ')'
   {
     $$.val = &tree.CreateTrigger{
       Replace: true,
@@ -8266,6 +8940,39 @@ function_or_procedure:
   FUNCTION
 | PROCEDURE
 
+opt_trigger_func_args:
+  /* EMPTY */
+  {
+    $$.val = tree.NameList(nil)
+  }
+| trigger_func_args
+  {
+    $$.val = $1.nameList()
+  }
+
+trigger_func_args:
+  trigger_func_arg
+  {
+    $$.val = tree.NameList{tree.Name($1)}
+  }
+| trigger_func_args ',' trigger_func_arg
+  {
+    $$.val = append($1.nameList(), tree.Name($3))
+  }
+
+// Arguments to trigger functions are always given to the function as strings.
+trigger_func_arg:
+  ICONST
+  {
+    $$ = $1.numVal().OrigString()
+  }
+| FCONST
+  {
+    $$ = $1.numVal().OrigString()
+  }
+| SCONST
+| unrestricted_name
+
 opt_when:
   /* EMPTY */
   {
@@ -8458,6 +9165,10 @@ alter_role_stmt:
 {
   $$.val = &tree.AlterRole{Name: $5.expr(), IfExists: true, KVOptions: $6.kvOptions(), IsRole: $2.bool()}
 }
+| ALTER role_or_group_or_user string_or_placeholder RENAME TO string_or_placeholder
+{
+  $$.val = &tree.AlterRole{Name: $3.expr(), NewName: $6.expr(), IsRole: $2.bool()}
+}
 | ALTER role_or_group_or_user error // SHOW HELP: ALTER ROLE
 
 // "CREATE GROUP is now an alias for CREATE ROLE"
@@ -8672,6 +9383,46 @@ role_option:
   {
     $$.val = tree.KVOption{Key: tree.Name($1), Value: nil}
   }
+| SUPERUSER
+  {
+    $$.val = tree.KVOption{Key: tree.Name($1), Value: nil}
+  }
+| NOSUPERUSER
+  {
+    $$.val = tree.KVOption{Key: tree.Name($1), Value: nil}
+  }
+| INHERIT
+  {
+    $$.val = tree.KVOption{Key: tree.Name($1), Value: nil}
+  }
+| NOINHERIT
+  {
+    $$.val = tree.KVOption{Key: tree.Name($1), Value: nil}
+  }
+| REPLICATION
+  {
+    $$.val = tree.KVOption{Key: tree.Name($1), Value: nil}
+  }
+| NOREPLICATION
+  {
+    $$.val = tree.KVOption{Key: tree.Name($1), Value: nil}
+  }
+| BYPASSRLS
+  {
+    $$.val = tree.KVOption{Key: tree.Name($1), Value: nil}
+  }
+| NOBYPASSRLS
+  {
+    $$.val = tree.KVOption{Key: tree.Name($1), Value: nil}
+  }
+| CONNECTION LIMIT signed_iconst
+  {
+    $$.val = tree.KVOption{Key: tree.Name(fmt.Sprintf("%s_%s", $1, $2)), Value: $3.expr()}
+  }
+| ENCRYPTED password_clause
+  {
+    $$.val = $2.kvOption()
+  }
 | password_clause
 | valid_until_clause
 
@@ -9374,8 +10125,9 @@ transaction_stmt:
 // START TRANSACTION [ <txnparameter> [[,] ...] ]
 //
 // Transaction parameters:
-//    ISOLATION LEVEL { SNAPSHOT | SERIALIZABLE }
-//    PRIORITY { LOW | NORMAL | HIGH }
+//    ISOLATION LEVEL { SERIALIZABLE | REPEATABLE READ | READ COMMITTED | READ UNCOMMITTED }
+//    READ { WRITE | ONLY }
+//    [NOT] DEFERRABLE
 //
 // %SeeAlso: COMMIT, ROLLBACK, WEBDOCS/begin-transaction.html
 begin_stmt:
@@ -9477,6 +10229,14 @@ transaction_mode_list:
     if err != nil { return setErr(sqllex, err) }
     $$.val = a
   }
+| transaction_mode_list transaction_mode
+  {
+    a := $1.transactionModes()
+    b := $2.transactionModes()
+    err := a.Merge(b)
+    if err != nil { return setErr(sqllex, err) }
+    $$.val = a
+  }
 
 transaction_mode:
   transaction_iso_level
@@ -9492,10 +10252,6 @@ transaction_mode:
   {
     $$.val = tree.TransactionModes{ReadWriteMode: $1.readWriteMode()}
   }
-| as_of_clause
-  {
-    $$.val = tree.TransactionModes{AsOf: $1.asOfClause()}
-  }
 | deferrable_mode
   {
     $$.val = tree.TransactionModes{Deferrable: $1.deferrableMode()}
@@ -9826,11 +10582,29 @@ insert_rest:
   {
     $$.val = &tree.Insert{Columns: $2.nameList(), Rows: $4.slct()}
   }
+| OVERRIDING override_kind VALUE select_stmt
+  {
+    $$.val = &tree.Insert{Overriding: $2.insertOverriding(), Rows: $4.slct()}
+  }
+| '(' insert_column_list ')' OVERRIDING override_kind VALUE select_stmt
+  {
+    $$.val = &tree.Insert{Columns: $2.nameList(), Overriding: $5.insertOverriding(), Rows: $7.slct()}
+  }
 | DEFAULT VALUES
   {
     $$.val = &tree.Insert{Rows: &tree.Select{}}
   }
 
+override_kind:
+  USER
+  {
+    $$.val = tree.OverridingUserValue
+  }
+| SYSTEM
+  {
+    $$.val = tree.OverridingSystemValue
+  }
+
 insert_column_list:
   insert_column_item
   {
@@ -9884,7 +10658,21 @@ on_conflict:
       Where: tree.NewWhere(tree.AstWhere, $11.expr()),
     }
   }
-| ON CONFLICT ON CONSTRAINT constraint_name { return unimplementedWithIssue(sqllex, 28161) }
+| ON CONFLICT ON CONSTRAINT constraint_name DO NOTHING
+  {
+    $$.val = &tree.OnConflict{
+      Constraint: tree.Name($5),
+      DoNothing: true,
+    }
+  }
+| ON CONFLICT ON CONSTRAINT constraint_name DO UPDATE SET set_clause_list opt_where_clause
+  {
+    $$.val = &tree.OnConflict{
+      Constraint: tree.Name($5),
+      Exprs: $9.updateExprs(),
+      Where: tree.NewWhere(tree.AstWhere, $10.expr()),
+    }
+  }
 
 returning_clause:
   RETURNING target_list
@@ -10780,7 +11568,7 @@ table_ref:
   {
     $$.val = &tree.AliasedTableExpr{Expr: &tree.ParenTableExpr{Expr: $2.tblExpr()}, Ordinality: $4.bool(), As: $5.aliasClause()}
   }
-| func_table opt_ordinality opt_alias_clause
+| func_table opt_ordinality opt_func_alias_clause
   {
     f := $1.tblExpr()
     $$.val = &tree.AliasedTableExpr{
@@ -10791,7 +11579,7 @@ table_ref:
       As: $3.aliasClause(),
     }
   }
-| LATERAL func_table opt_ordinality opt_alias_clause
+| LATERAL func_table opt_ordinality opt_func_alias_clause
   {
     f := $2.tblExpr()
     $$.val = &tree.AliasedTableExpr{
@@ -10941,6 +11729,40 @@ opt_alias_clause:
     $$.val = tree.AliasClause{}
   }
 
+// Functions that return record must be given a column definition list, which
+// declares the name and type of each column that the function returns.
+opt_func_alias_clause:
+  opt_alias_clause
+| AS '(' func_column_def_list ')'
+  {
+    $$.val = $3.aliasClause()
+  }
+| AS table_alias_name '(' func_column_def_list ')'
+  {
+    aliasClause := $4.aliasClause()
+    aliasClause.Alias = tree.Name($2)
+    $$.val = aliasClause
+  }
+| table_alias_name '(' func_column_def_list ')'
+  {
+    aliasClause := $3.aliasClause()
+    aliasClause.Alias = tree.Name($1)
+    $$.val = aliasClause
+  }
+
+func_column_def_list:
+  name typename
+  {
+    $$.val = tree.AliasClause{Cols: tree.NameList{tree.Name($1)}, ColTypes: []tree.ResolvableTypeReference{$2.typeReference()}}
+  }
+| func_column_def_list ',' name typename
+  {
+    aliasClause := $1.aliasClause()
+    aliasClause.Cols = append(aliasClause.Cols, tree.Name($3))
+    aliasClause.ColTypes = append(aliasClause.ColTypes, $4.typeReference())
+    $$.val = aliasClause
+  }
+
 as_of_clause:
   AS_LA OF SYSTEM TIME a_expr
   {
@@ -11870,6 +12692,10 @@ a_expr:
   {
     $$.val = &tree.ComparisonExpr{Operator: tree.JSONAllExists, Left: $1.expr(), Right: $3.expr()}
   }
+| a_expr JSON_PATH_EXISTS a_expr
+  {
+    $$.val = &tree.ComparisonExpr{Operator: tree.JSONPathExists, Left: $1.expr(), Right: $3.expr()}
+  }
 | a_expr CONTAINS a_expr
   {
     $$.val = &tree.ComparisonExpr{Operator: tree.Contains, Left: $1.expr(), Right: $3.expr()}
@@ -12018,6 +12844,18 @@ a_expr:
   {
     $$.val = &tree.ComparisonExpr{Operator: tree.NotRegIMatch, Left: $1.expr(), Right: $3.expr()}
   }
+| a_expr OPERATOR '(' qual_operator ')' a_expr %prec OPERATOR
+  {
+    switch op := $4.op().(type) {
+    case tree.ComparisonOperator:
+      $$.val = &tree.ComparisonExpr{Operator: op, Left: $1.expr(), Right: $6.expr()}
+    case tree.BinaryOperator:
+      $$.val = &tree.BinaryExpr{Operator: op, Left: $1.expr(), Right: $6.expr()}
+    default:
+      sqllex.Error(fmt.Sprintf("operator does not exist: %s", op))
+      return 1
+    }
+  }
 | a_expr TEXTSEARCHMATCH a_expr
   {
     $$.val = &tree.ComparisonExpr{Operator: tree.TextSearchMatch, Left: $1.expr(), Right: $3.expr()}
@@ -13007,6 +13845,7 @@ operator:
 | '?' { $$.val = tree.JSONExists }
 | JSON_SOME_EXISTS { $$.val = tree.JSONSomeExists }
 | JSON_ALL_EXISTS { $$.val = tree.JSONAllExists }
+| JSON_PATH_EXISTS { $$.val = tree.JSONPathExists }
 | CONTAINS { $$.val = tree.Contains }
 | CONTAINED_BY { $$.val = tree.ContainedBy }
 | CONCAT { $$.val = tree.Concat }
@@ -13018,6 +13857,17 @@ operator:
 | FETCHTEXT_PATH { $$.val = tree.JSONFetchTextPath }
 | AND_AND { $$.val = tree.Overlaps }
 | TEXTSEARCHMATCH { $$.val = tree.TextSearchMatch }
+| NOT_REGMATCH { $$.val = tree.NotRegMatch }
+| REGIMATCH { $$.val = tree.RegIMatch }
+| NOT_REGIMATCH { $$.val = tree.NotRegIMatch }
+
+// qual_operator is an operator as written within OPERATOR(), which may be qualified by the schema that contains it.
+qual_operator:
+  operator
+| name '.' qual_operator
+  {
+    $$.val = $3.op()
+  }
 
 math_op:
   '+' { $$.val = tree.Plus  }
@@ -13630,7 +14480,12 @@ interval_value:
 
 // Note: newlines between non-terminals matter to the doc generator.
 
-collation_name:        unrestricted_name
+collation_name:
+  unrestricted_name
+| name '.' unrestricted_name
+  {
+    $$ = $1 + "." + $3
+  }
 
 index_name:            unrestricted_name
 
@@ -13872,6 +14727,7 @@ unrestricted_name:
 // "Unreserved" keywords --- available for use as any kind of name.
 unreserved_keyword:
   ABORT
+| ABSOLUTE
 | ACCESS
 | ACTION
 | ADD
@@ -13882,6 +14738,7 @@ unreserved_keyword:
 | ALLOW_CONNECTIONS
 | ALTER
 | ALWAYS
+| ASENSITIVE
 | AT
 | ATOMIC
 | ATTACH
@@ -13889,6 +14746,7 @@ unreserved_keyword:
 | AUTOMATIC
 | BACKUP
 | BACKUPS
+| BACKWARD
 | BASETYPE
 | BEFORE
 | BEGIN
@@ -13896,6 +14754,7 @@ unreserved_keyword:
 | BUCKET_COUNT
 | BUNDLE
 | BY
+| BYPASSRLS
 | CACHE
 | CHAIN
 | CHECK_OPTION
@@ -13937,8 +14796,10 @@ unreserved_keyword:
 | CREATEDB
 | CREATELOGIN
 | CREATEROLE
+| CSV
 | CUBE
 | CURRENT
+| CURSOR
 | CYCLE
 | DATA
 | DATABASE
@@ -13965,6 +14826,7 @@ unreserved_keyword:
 | EACH
 | ENABLE
 | ENCODING
+| ENCRYPTED
 | ENCRYPTION_PASSPHRASE
 | ENUM
 | ENUMS
@@ -13972,6 +14834,7 @@ unreserved_keyword:
 | EVENT
 | EXCLUDE
 | EXCLUDING
+| EXCLUSIVE
 | EXECUTE
 | EXECUTION
 | EXPERIMENTAL
@@ -13996,6 +14859,8 @@ unreserved_keyword:
 | FOLLOWING
 | FORCE
 | FORCE_INDEX
+| FORMAT
+| FORWARD
 | FUNCTION
 | FUNCTIONS
 | GENERATED
@@ -14012,8 +14877,10 @@ unreserved_keyword:
 | GROUPS
 | HANDLER
 | HASH
+| HEADER
 | HIGH
 | HISTOGRAM
+| HOLD
 | HOUR
 | HYPOTHETICAL
 | ICU_LOCALE
@@ -14033,6 +14900,7 @@ unreserved_keyword:
 | INJECT
 | INLINE
 | INPUT
+| INSENSITIVE
 | INSERT
 | INSTEAD
 | INTERLEAVE
@@ -14061,15 +14929,18 @@ unreserved_keyword:
 | LEVEL
 | LINESTRING
 | LIST
+| LISTEN
 | LOCAL
 | LOCALE
 | LOCALE_PROVIDER
+| LOCK
 | LOCKED
 | LOGGED
 | LOGIN
 | LOOKUP
 | LOW
 | MAIN
+| MASKING
 | MATCH
 | MATERIALIZED
 | MAXVALUE
@@ -14082,9 +14953,11 @@ unreserved_keyword:
 | MINUTE
 | MINVALUE
 | MINVFUNC
+| MODE
 | MODIFYCLUSTERSETTING
 | MODULUS
 | MONTH
+| MOVE
 | MSFUNC
 | MSPACE
 | MSSPACE
@@ -14110,14 +14983,19 @@ unreserved_keyword:
 | NO
 | NORMAL
 | NO_INDEX_JOIN
+| NOBYPASSRLS
 | NOCREATEDB
 | NOCREATELOGIN
 | NOCANCELQUERY
 | NOCREATEROLE
 | NOCONTROLCHANGEFEED
 | NOCONTROLJOB
+| NOINHERIT
 | NOLOGIN
 | NOMODIFYCLUSTERSETTING
+| NOREPLICATION
+| NOSUPERUSER
+| NOTIFY
 | NOVIEWACTIVITY
 | NOWAIT
 | NULLS
@@ -14136,6 +15014,7 @@ unreserved_keyword:
 | OTHERS
 | OUTPUT
 | OVER
+| OVERRIDING
 | OWNED
 | OWNER
 | PARALLEL
@@ -14164,6 +15043,7 @@ unreserved_keyword:
 | PREFERRED
 | PREPARE
 | PRESERVE
+| PRIOR
 | PRIORITY
 | PRIVILEGES
 | PROCEDURAL
@@ -14173,6 +15053,7 @@ unreserved_keyword:
 | PUBLICATION
 | QUERIES
 | QUERY
+| QUOTE
 | RANGE
 | RANGES
 | READ
@@ -14185,12 +15066,14 @@ unreserved_keyword:
 | REFERENCING
 | REFRESH
 | REINDEX
+| RELATIVE
 | RELEASE
 | REMAINDER
 | RENAME
 | REPEATABLE
 | REPLACE
 | REPLICA
+| REPLICATION
 | RESET
 | RESTART
 | RESTORE
@@ -14218,6 +15101,7 @@ unreserved_keyword:
 | SCHEDULES
 | SCHEMA
 | SCHEMAS
+| SCROLL
 | SCRUB
 | SEARCH
 | SECOND
@@ -14257,6 +15141,7 @@ unreserved_keyword:
 | STATISTICS
 | STATUS
 | STDIN
+| STDOUT
 | STORAGE
 | STORE
 | STORED
@@ -14268,6 +15153,7 @@ unreserved_keyword:
 | SUBTYPE
 | SUBTYPE_DIFF
 | SUBTYPE_OPCLASS
+| SUPERUSER
 | SUPPORT
 | SYNTAX
 | SYSTEM
@@ -14293,6 +15179,7 @@ unreserved_keyword:
 | UNBOUNDED
 | UNCOMMITTED
 | UNKNOWN
+| UNLISTEN
 | UNLOGGED
 | UNSPLIT
 | UNTIL
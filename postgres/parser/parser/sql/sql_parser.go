@@ -16,6 +16,7 @@ package sql
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/dolthub/doltgresql/postgres/parser/parser"
 	"github.com/dolthub/doltgresql/server/ast"
@@ -46,7 +47,7 @@ func (p *PostgresParser) Parse(_ *sql.Context, query string, multi bool) (vitess
 
 // ParseWithOptions implements sql.Parser interface.
 func (p *PostgresParser) ParseWithOptions(query string, delimiter rune, _ bool, _ vitess.ParserOptions) (vitess.Statement, string, string, error) {
-	q := sql.RemoveSpaceAndDelimiter(query, delimiter)
+	q := translateDoltBlameView(sql.RemoveSpaceAndDelimiter(query, delimiter))
 	stmts, err := parser.Parse(q)
 	if err != nil {
 		return nil, "", "", err
@@ -81,3 +82,15 @@ func (p *PostgresParser) ParseOneWithOptions(query string, _ vitess.ParserOption
 	}
 	return vitessAST, 0, nil
 }
+
+// doltBlameViewPrefix is the prefix of the statements that Dolt generates to define its dolt_blame_<table> system views.
+const doltBlameViewPrefix = "CREATE VIEW `dolt_blame_"
+
+// translateDoltBlameView returns the given query using Postgres syntax if it defines one of Dolt's dolt_blame_<table>
+// system views. Dolt quotes the identifiers of these views using backticks, but is otherwise compatible with Postgres.
+func translateDoltBlameView(query string) string {
+	if !strings.HasPrefix(query, doltBlameViewPrefix) {
+		return query
+	}
+	return strings.ReplaceAll(query, "`", `"`)
+}
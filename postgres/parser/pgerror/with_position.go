@@ -0,0 +1,63 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgerror
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+)
+
+// withPosition decorates an error with the position within the query string that caused it.
+type withPosition struct {
+	cause    error
+	position int32
+}
+
+var _ error = (*withPosition)(nil)
+var _ fmt.Formatter = (*withPosition)(nil)
+var _ errors.SafeFormatter = (*withPosition)(nil)
+
+func (w *withPosition) Error() string { return w.cause.Error() }
+func (w *withPosition) Cause() error  { return w.cause }
+func (w *withPosition) Unwrap() error { return w.cause }
+
+func (w *withPosition) Format(s fmt.State, verb rune) { errors.FormatError(w, s, verb) }
+
+func (w *withPosition) SafeFormatError(p errors.Printer) (next error) {
+	if p.Detail() {
+		p.Printf("position: %d", errors.Safe(w.position))
+	}
+	return w.cause
+}
+
+// WithPosition decorates the error with the position within the query string that caused it. Positions are counted in
+// characters starting from 1, which matches the position field of Postgres' error responses.
+func WithPosition(err error, position int32) error {
+	if err == nil || position <= 0 {
+		return err
+	}
+	return &withPosition{cause: err, position: position}
+}
+
+// GetPosition returns the outermost position that the error has been decorated with, or 0 if it does not have one.
+func GetPosition(err error) int32 {
+	for ; err != nil; err = errors.UnwrapOnce(err) {
+		if w, ok := err.(*withPosition); ok {
+			return w.position
+		}
+	}
+	return 0
+}
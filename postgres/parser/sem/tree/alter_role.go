@@ -41,6 +41,7 @@ type AlterRole struct {
 	IfExists  bool
 	IsRole    bool
 	KVOptions KVOptions
+	NewName   Expr
 }
 
 // Format implements the NodeFormatter interface.
@@ -55,6 +56,10 @@ func (node *AlterRole) Format(ctx *FmtCtx) {
 		ctx.WriteString("IF EXISTS ")
 	}
 	ctx.FormatNode(node.Name)
+	if node.NewName != nil {
+		ctx.WriteString(" RENAME TO ")
+		ctx.FormatNode(node.NewName)
+	}
 
 	if len(node.KVOptions) > 0 {
 		ctx.WriteString(" WITH")
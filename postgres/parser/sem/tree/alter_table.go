@@ -817,7 +817,6 @@ type AlterTableSetStorage struct {
 
 // Format implements the NodeFormatter interface.
 func (node *AlterTableSetStorage) Format(ctx *FmtCtx) {
-	ctx.WriteString(" SET TABLESPACE")
 	if node.IsReset {
 		ctx.WriteString(" RESET ( ")
 	} else {
@@ -26,13 +26,16 @@ package tree
 
 var _ Statement = &Analyze{}
 
-// Analyze represents an ANALYZE statement.
+// Analyze represents an ANALYZE statement. Every table is analyzed when no tables are given.
 type Analyze struct {
-	Table TableExpr
+	Tables TableNames
 }
 
 // Format implements the NodeFormatter interface.
 func (node *Analyze) Format(ctx *FmtCtx) {
-	ctx.WriteString("ANALYZE ")
-	ctx.FormatNode(node.Table)
+	ctx.WriteString("ANALYZE")
+	if len(node.Tables) > 0 {
+		ctx.WriteByte(' ')
+		ctx.FormatNode(&node.Tables)
+	}
 }
@@ -79,6 +79,10 @@ func ComputeColNameInternal(sp sessiondata.SearchPath, target Expr) (int, string
 		return ComputeColNameInternal(sp, e.Expr)
 
 	case *FuncExpr:
+		// Functions are resolved by the server rather than the parser, so the name is used as written.
+		if name, ok := e.Func.FunctionReference.(*UnresolvedName); ok {
+			return 2, name.Parts[0], nil
+		}
 		fd, err := e.Func.Resolve(sp)
 		if err != nil {
 			return 0, "", err
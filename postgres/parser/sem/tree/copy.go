@@ -24,7 +24,11 @@
 
 package tree
 
-import "github.com/cockroachdb/errors"
+import (
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
 
 // CopyFrom represents a COPY FROM statement.
 type CopyFrom struct {
@@ -34,10 +38,23 @@ type CopyFrom struct {
 	Options CopyOptions
 }
 
+// CopyTo represents a COPY TO statement. Either a table (with optional columns) or a query is the source of the data.
+type CopyTo struct {
+	Table   TableName
+	Columns NameList
+	Query   *Select
+	Options CopyOptions
+}
+
 // CopyOptions describes options for COPY execution.
 type CopyOptions struct {
 	Destination Expr
 	CopyFormat  CopyFormat
+	Header      bool
+	Delimiter   *StrVal
+	Null        *StrVal
+	Quote       *StrVal
+	Escape      *StrVal
 }
 
 var _ NodeFormatter = &CopyOptions{}
@@ -61,12 +78,34 @@ func (node *CopyFrom) Format(ctx *FmtCtx) {
 	}
 }
 
+// Format implements the NodeFormatter interface.
+func (node *CopyTo) Format(ctx *FmtCtx) {
+	ctx.WriteString("COPY ")
+	if node.Query != nil {
+		ctx.WriteString("(")
+		ctx.FormatNode(node.Query)
+		ctx.WriteString(")")
+	} else {
+		ctx.FormatNode(&node.Table)
+		if len(node.Columns) > 0 {
+			ctx.WriteString(" (")
+			ctx.FormatNode(&node.Columns)
+			ctx.WriteString(")")
+		}
+	}
+	ctx.WriteString(" TO STDOUT")
+	if !node.Options.IsDefault() {
+		ctx.WriteString(" WITH ")
+		ctx.FormatNode(&node.Options)
+	}
+}
+
 // Format implements the NodeFormatter interface
 func (o *CopyOptions) Format(ctx *FmtCtx) {
 	var addSep bool
 	maybeAddSep := func() {
 		if addSep {
-			ctx.WriteString(", ")
+			ctx.WriteString(" ")
 		}
 		addSep = true
 	}
@@ -83,8 +122,34 @@ func (o *CopyOptions) Format(ctx *FmtCtx) {
 		switch o.CopyFormat {
 		case CopyFormatBinary:
 			ctx.WriteString("BINARY")
+		case CopyFormatCSV:
+			ctx.WriteString("CSV")
 		}
 	}
+	if o.Header {
+		maybeAddSep()
+		ctx.WriteString("HEADER")
+	}
+	if o.Delimiter != nil {
+		maybeAddSep()
+		ctx.WriteString("DELIMITER ")
+		ctx.FormatNode(o.Delimiter)
+	}
+	if o.Null != nil {
+		maybeAddSep()
+		ctx.WriteString("NULL ")
+		ctx.FormatNode(o.Null)
+	}
+	if o.Quote != nil {
+		maybeAddSep()
+		ctx.WriteString("QUOTE ")
+		ctx.FormatNode(o.Quote)
+	}
+	if o.Escape != nil {
+		maybeAddSep()
+		ctx.WriteString("ESCAPE ")
+		ctx.FormatNode(o.Escape)
+	}
 }
 
 // IsDefault returns true if this struct has default value.
@@ -107,9 +172,76 @@ func (o *CopyOptions) CombineWith(other *CopyOptions) error {
 		}
 		o.CopyFormat = other.CopyFormat
 	}
+	if other.Header {
+		if o.Header {
+			return errors.New("header option specified multiple times")
+		}
+		o.Header = true
+	}
+	if other.Delimiter != nil {
+		if o.Delimiter != nil {
+			return errors.New("delimiter option specified multiple times")
+		}
+		o.Delimiter = other.Delimiter
+	}
+	if other.Null != nil {
+		if o.Null != nil {
+			return errors.New("null option specified multiple times")
+		}
+		o.Null = other.Null
+	}
+	if other.Quote != nil {
+		if o.Quote != nil {
+			return errors.New("quote option specified multiple times")
+		}
+		o.Quote = other.Quote
+	}
+	if other.Escape != nil {
+		if o.Escape != nil {
+			return errors.New("escape option specified multiple times")
+		}
+		o.Escape = other.Escape
+	}
 	return nil
 }
 
+// NewCopyGenericOption returns the CopyOptions for a single option from the parenthesized option list, such as
+// "FORMAT csv" or "DELIMITER ','". The value is empty when the option was given without one.
+func NewCopyGenericOption(name string, value string) (*CopyOptions, error) {
+	switch strings.ToLower(name) {
+	case "format":
+		switch strings.ToLower(value) {
+		case "text":
+			return &CopyOptions{}, nil
+		case "csv":
+			return &CopyOptions{CopyFormat: CopyFormatCSV}, nil
+		case "binary":
+			return &CopyOptions{CopyFormat: CopyFormatBinary}, nil
+		default:
+			return nil, errors.Newf(`COPY format "%s" not recognized`, value)
+		}
+	case "header":
+		switch strings.ToLower(value) {
+		case "", "true", "on", "1":
+			return &CopyOptions{Header: true}, nil
+		case "false", "off", "0":
+			return &CopyOptions{}, nil
+		default:
+			return nil, errors.Newf(`header requires a Boolean value`)
+		}
+	case "delimiter":
+		return &CopyOptions{Delimiter: NewStrVal(value)}, nil
+	case "null":
+		return &CopyOptions{Null: NewStrVal(value)}, nil
+	case "quote":
+		return &CopyOptions{Quote: NewStrVal(value)}, nil
+	case "escape":
+		return &CopyOptions{Escape: NewStrVal(value)}, nil
+	default:
+		return nil, errors.Newf(`option "%s" not recognized`, name)
+	}
+}
+
 // CopyFormat identifies a COPY data format.
 type CopyFormat int
 
@@ -117,4 +249,5 @@ type CopyFormat int
 const (
 	CopyFormatText CopyFormat = iota
 	CopyFormatBinary
+	CopyFormatCSV
 )
@@ -95,7 +95,11 @@ func (node RoutineArgs) Format(ctx *FmtCtx) {
 			ctx.FormatNode(&t.Name)
 			ctx.WriteByte(' ')
 		}
-		t.Type.SQLString()
+		ctx.WriteString(t.Type.SQLString())
+		if t.Default != nil {
+			ctx.WriteString(" = ")
+			ctx.FormatNode(t.Default)
+		}
 	}
 }
 
@@ -272,14 +276,12 @@ type BeginEndBlock struct {
 }
 
 func (node *BeginEndBlock) Format(ctx *FmtCtx) {
-	ctx.WriteString("BEGIN ATOMIC")
-	for i, s := range node.Statements {
-		if i != 0 {
-			ctx.WriteString("; ")
-		}
+	ctx.WriteString("BEGIN ATOMIC ")
+	for _, s := range node.Statements {
 		ctx.FormatNode(s)
+		ctx.WriteString("; ")
 	}
-	ctx.WriteString(" END")
+	ctx.WriteString("END")
 }
 
 var _ Statement = &Return{}
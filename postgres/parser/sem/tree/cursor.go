@@ -0,0 +1,191 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "strconv"
+
+// DeclareCursor represents a DECLARE statement.
+type DeclareCursor struct {
+	Name        Name
+	Select      *Select
+	Binary      bool
+	Sensitivity CursorSensitivity
+	Scroll      CursorScrollOption
+	Hold        bool
+}
+
+var _ Statement = &DeclareCursor{}
+
+// Format implements the NodeFormatter interface.
+func (node *DeclareCursor) Format(ctx *FmtCtx) {
+	ctx.WriteString("DECLARE ")
+	ctx.FormatNode(&node.Name)
+	ctx.WriteString(" ")
+	if node.Binary {
+		ctx.WriteString("BINARY ")
+	}
+	if node.Sensitivity != UnspecifiedSensitivity {
+		ctx.WriteString(node.Sensitivity.String())
+		ctx.WriteString(" ")
+	}
+	if node.Scroll != UnspecifiedScroll {
+		ctx.WriteString(node.Scroll.String())
+		ctx.WriteString(" ")
+	}
+	ctx.WriteString("CURSOR ")
+	if node.Hold {
+		ctx.WriteString("WITH HOLD ")
+	}
+	ctx.WriteString("FOR ")
+	ctx.FormatNode(node.Select)
+}
+
+// CursorSensitivity represents the ASENSITIVE or INSENSITIVE option of a cursor. Postgres cursors are always
+// insensitive, so the option does not change their behavior.
+type CursorSensitivity int8
+
+const (
+	UnspecifiedSensitivity CursorSensitivity = iota
+	Insensitive
+	Asensitive
+)
+
+// String returns the option as it is written in a DECLARE statement.
+func (o CursorSensitivity) String() string {
+	switch o {
+	case Insensitive:
+		return "INSENSITIVE"
+	case Asensitive:
+		return "ASENSITIVE"
+	default:
+		return ""
+	}
+}
+
+// CursorScrollOption represents the SCROLL or NO SCROLL option of a cursor.
+type CursorScrollOption int8
+
+const (
+	UnspecifiedScroll CursorScrollOption = iota
+	Scroll
+	NoScroll
+)
+
+// String returns the option as it is written in a DECLARE statement.
+func (o CursorScrollOption) String() string {
+	switch o {
+	case Scroll:
+		return "SCROLL"
+	case NoScroll:
+		return "NO SCROLL"
+	default:
+		return ""
+	}
+}
+
+// FetchType is the kind of movement of a FETCH or MOVE statement.
+type FetchType int8
+
+const (
+	// FetchNormal moves by Count rows, which moves backward when Count is negative.
+	FetchNormal FetchType = iota
+	// FetchRelative moves by Count rows, but only fetches the row that it ends on.
+	FetchRelative
+	// FetchAbsolute moves to the row at Count, which counts from the end when Count is negative.
+	FetchAbsolute
+	// FetchFirst moves to the first row.
+	FetchFirst
+	// FetchLast moves to the last row.
+	FetchLast
+	// FetchAll moves forward through all remaining rows.
+	FetchAll
+	// FetchBackwardAll moves backward through all preceding rows.
+	FetchBackwardAll
+)
+
+// CursorStmt is the movement that is shared by the FETCH and MOVE statements.
+type CursorStmt struct {
+	Name      Name
+	FetchType FetchType
+	Count     int64
+}
+
+// Format implements the NodeFormatter interface.
+func (node *CursorStmt) Format(ctx *FmtCtx) {
+	switch node.FetchType {
+	case FetchNormal:
+		ctx.WriteString(strconv.FormatInt(node.Count, 10))
+	case FetchRelative:
+		ctx.WriteString("RELATIVE ")
+		ctx.WriteString(strconv.FormatInt(node.Count, 10))
+	case FetchAbsolute:
+		ctx.WriteString("ABSOLUTE ")
+		ctx.WriteString(strconv.FormatInt(node.Count, 10))
+	case FetchFirst:
+		ctx.WriteString("FIRST")
+	case FetchLast:
+		ctx.WriteString("LAST")
+	case FetchAll:
+		ctx.WriteString("ALL")
+	case FetchBackwardAll:
+		ctx.WriteString("BACKWARD ALL")
+	}
+	ctx.WriteString(" FROM ")
+	ctx.FormatNode(&node.Name)
+}
+
+// FetchCursor represents a FETCH statement.
+type FetchCursor struct {
+	CursorStmt
+}
+
+var _ Statement = &FetchCursor{}
+
+// Format implements the NodeFormatter interface.
+func (node *FetchCursor) Format(ctx *FmtCtx) {
+	ctx.WriteString("FETCH ")
+	ctx.FormatNode(&node.CursorStmt)
+}
+
+// MoveCursor represents a MOVE statement.
+type MoveCursor struct {
+	CursorStmt
+}
+
+var _ Statement = &MoveCursor{}
+
+// Format implements the NodeFormatter interface.
+func (node *MoveCursor) Format(ctx *FmtCtx) {
+	ctx.WriteString("MOVE ")
+	ctx.FormatNode(&node.CursorStmt)
+}
+
+// CloseCursor represents a CLOSE statement. All is set for CLOSE ALL, in which case the name is empty.
+type CloseCursor struct {
+	Name Name
+	All  bool
+}
+
+var _ Statement = &CloseCursor{}
+
+// Format implements the NodeFormatter interface.
+func (node *CloseCursor) Format(ctx *FmtCtx) {
+	ctx.WriteString("CLOSE ")
+	if node.All {
+		ctx.WriteString("ALL")
+	} else {
+		ctx.FormatNode(&node.Name)
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "github.com/dolthub/doltgresql/postgres/parser/lex"
+
+// ForeignOption is a single option given to a foreign server, foreign table, or foreign table column.
+type ForeignOption struct {
+	Name  Name
+	Value string
+}
+
+// ForeignOptions is the list of options within an OPTIONS clause.
+type ForeignOptions []ForeignOption
+
+// Format implements the NodeFormatter interface.
+func (node *ForeignOptions) Format(ctx *FmtCtx) {
+	if len(*node) == 0 {
+		return
+	}
+	ctx.WriteString("OPTIONS (")
+	for i := range *node {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		ctx.FormatNode(&(*node)[i].Name)
+		ctx.WriteByte(' ')
+		lex.EncodeSQLString(&ctx.Buffer, (*node)[i].Value)
+	}
+	ctx.WriteByte(')')
+}
+
+// CreateServer represents a CREATE SERVER statement.
+type CreateServer struct {
+	Name        Name
+	IfNotExists bool
+	Wrapper     Name
+	Options     ForeignOptions
+}
+
+var _ Statement = &CreateServer{}
+
+// Format implements the NodeFormatter interface.
+func (node *CreateServer) Format(ctx *FmtCtx) {
+	ctx.WriteString("CREATE SERVER ")
+	if node.IfNotExists {
+		ctx.WriteString("IF NOT EXISTS ")
+	}
+	ctx.FormatNode(&node.Name)
+	ctx.WriteString(" FOREIGN DATA WRAPPER ")
+	ctx.FormatNode(&node.Wrapper)
+	if len(node.Options) > 0 {
+		ctx.WriteByte(' ')
+		ctx.FormatNode(&node.Options)
+	}
+}
+
+// DropServer represents a DROP SERVER statement.
+type DropServer struct {
+	Names        NameList
+	IfExists     bool
+	DropBehavior DropBehavior
+}
+
+var _ Statement = &DropServer{}
+
+// Format implements the NodeFormatter interface.
+func (node *DropServer) Format(ctx *FmtCtx) {
+	ctx.WriteString("DROP SERVER ")
+	if node.IfExists {
+		ctx.WriteString("IF EXISTS ")
+	}
+	ctx.FormatNode(&node.Names)
+	if node.DropBehavior != DropDefault {
+		ctx.WriteByte(' ')
+		ctx.WriteString(node.DropBehavior.String())
+	}
+}
+
+// ForeignColumnTableDef is a column of a foreign table, which may be given options that tell the foreign-data wrapper
+// how to read the column.
+type ForeignColumnTableDef struct {
+	Column  *ColumnTableDef
+	Options ForeignOptions
+}
+
+// CreateForeignTable represents a CREATE FOREIGN TABLE statement.
+type CreateForeignTable struct {
+	Table       TableName
+	IfNotExists bool
+	Columns     []ForeignColumnTableDef
+	Server      Name
+	Options     ForeignOptions
+}
+
+var _ Statement = &CreateForeignTable{}
+
+// Format implements the NodeFormatter interface.
+func (node *CreateForeignTable) Format(ctx *FmtCtx) {
+	ctx.WriteString("CREATE FOREIGN TABLE ")
+	if node.IfNotExists {
+		ctx.WriteString("IF NOT EXISTS ")
+	}
+	ctx.FormatNode(&node.Table)
+	ctx.WriteString(" (")
+	for i := range node.Columns {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		ctx.FormatNode(node.Columns[i].Column)
+		if len(node.Columns[i].Options) > 0 {
+			ctx.WriteByte(' ')
+			ctx.FormatNode(&node.Columns[i].Options)
+		}
+	}
+	ctx.WriteString(") SERVER ")
+	ctx.FormatNode(&node.Server)
+	if len(node.Options) > 0 {
+		ctx.WriteByte(' ')
+		ctx.FormatNode(&node.Options)
+	}
+}
+
+// DropForeignTable represents a DROP FOREIGN TABLE statement.
+type DropForeignTable struct {
+	Names        TableNames
+	IfExists     bool
+	DropBehavior DropBehavior
+}
+
+var _ Statement = &DropForeignTable{}
+
+// Format implements the NodeFormatter interface.
+func (node *DropForeignTable) Format(ctx *FmtCtx) {
+	ctx.WriteString("DROP FOREIGN TABLE ")
+	if node.IfExists {
+		ctx.WriteString("IF EXISTS ")
+	}
+	ctx.FormatNode(&node.Names)
+	if node.DropBehavior != DropDefault {
+		ctx.WriteByte(' ')
+		ctx.WriteString(node.DropBehavior.String())
+	}
+}
@@ -78,10 +78,13 @@ func (fn *ResolvableFunctionReference) Resolve(
 
 // WrapFunction creates a new ResolvableFunctionReference
 // holding a pre-resolved function. Helper for grammar rules.
+// Functions are resolved by the server rather than the parser,
+// so functions that have not been pre-defined are left unresolved.
 func WrapFunction(n string) ResolvableFunctionReference {
 	fd, ok := FunDefs[n]
 	if !ok {
-		panic(errors.AssertionFailedf("function %s() not defined", n))
+		name := MakeUnresolvedName(n)
+		return ResolvableFunctionReference{&name}
 	}
 	return ResolvableFunctionReference{fd}
 }
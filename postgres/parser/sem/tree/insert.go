@@ -38,11 +38,22 @@ type Insert struct {
 	With       *With
 	Table      TableExpr
 	Columns    NameList
+	Overriding InsertOverriding
 	Rows       *Select
 	OnConflict *OnConflict
 	Returning  ReturningClause
 }
 
+// InsertOverriding represents the OVERRIDING clause of an INSERT statement.
+type InsertOverriding int
+
+// InsertOverriding values.
+const (
+	OverridingNone InsertOverriding = iota
+	OverridingSystemValue
+	OverridingUserValue
+)
+
 // Format implements the NodeFormatter interface.
 func (node *Insert) Format(ctx *FmtCtx) {
 	ctx.FormatNode(node.With)
@@ -58,6 +69,12 @@ func (node *Insert) Format(ctx *FmtCtx) {
 		ctx.FormatNode(&node.Columns)
 		ctx.WriteByte(')')
 	}
+	switch node.Overriding {
+	case OverridingSystemValue:
+		ctx.WriteString(" OVERRIDING SYSTEM VALUE")
+	case OverridingUserValue:
+		ctx.WriteString(" OVERRIDING USER VALUE")
+	}
 	if node.DefaultValues() {
 		ctx.WriteString(" DEFAULT VALUES")
 	} else {
@@ -70,6 +87,9 @@ func (node *Insert) Format(ctx *FmtCtx) {
 			ctx.WriteString(" (")
 			ctx.FormatNode(&node.OnConflict.Columns)
 			ctx.WriteString(")")
+		} else if len(node.OnConflict.Constraint) > 0 {
+			ctx.WriteString(" ON CONSTRAINT ")
+			ctx.FormatNode(&node.OnConflict.Constraint)
 		}
 		if node.OnConflict.ArbiterPredicate != nil {
 			ctx.WriteString(" WHERE ")
@@ -98,13 +118,15 @@ func (node *Insert) DefaultValues() bool {
 }
 
 // OnConflict represents an `ON CONFLICT (columns) WHERE arbiter DO UPDATE SET
-// exprs WHERE where` clause.
+// exprs WHERE where` clause. The conflict target may instead be given as
+// `ON CONSTRAINT constraint`, in which case Constraint is set.
 //
 // The zero value for OnConflict is used to signal the UPSERT short form, which
 // uses the primary key for as the conflict index and the values being inserted
 // for Exprs.
 type OnConflict struct {
 	Columns          NameList
+	Constraint       Name
 	ArbiterPredicate Expr
 	Exprs            UpdateExprs
 	Where            *Where
@@ -113,5 +135,5 @@ type OnConflict struct {
 
 // IsUpsertAlias returns true if the UPSERT syntactic sugar was used.
 func (oc *OnConflict) IsUpsertAlias() bool {
-	return oc != nil && oc.Columns == nil && oc.ArbiterPredicate == nil && oc.Exprs == nil && oc.Where == nil && !oc.DoNothing
+	return oc != nil && oc.Columns == nil && oc.Constraint == "" && oc.ArbiterPredicate == nil && oc.Exprs == nil && oc.Where == nil && !oc.DoNothing
 }
@@ -0,0 +1,66 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+// LockTable represents a LOCK TABLE statement.
+type LockTable struct {
+	Tables TableNames
+	Mode   LockTableMode
+	NoWait bool
+}
+
+var _ Statement = &LockTable{}
+
+// Format implements the NodeFormatter interface.
+func (node *LockTable) Format(ctx *FmtCtx) {
+	ctx.WriteString("LOCK TABLE ")
+	ctx.FormatNode(&node.Tables)
+	ctx.WriteString(" IN ")
+	ctx.WriteString(node.Mode.String())
+	ctx.WriteString(" MODE")
+	if node.NoWait {
+		ctx.WriteString(" NOWAIT")
+	}
+}
+
+// LockTableMode represents the mode of a table-level lock. The modes are ordered from the weakest to the strongest.
+type LockTableMode int8
+
+const (
+	LockAccessShare LockTableMode = iota
+	LockRowShare
+	LockRowExclusive
+	LockShareUpdateExclusive
+	LockShare
+	LockShareRowExclusive
+	LockExclusive
+	LockAccessExclusive
+)
+
+var lockTableModeName = [...]string{
+	LockAccessShare:          "ACCESS SHARE",
+	LockRowShare:             "ROW SHARE",
+	LockRowExclusive:         "ROW EXCLUSIVE",
+	LockShareUpdateExclusive: "SHARE UPDATE EXCLUSIVE",
+	LockShare:                "SHARE",
+	LockShareRowExclusive:    "SHARE ROW EXCLUSIVE",
+	LockExclusive:            "EXCLUSIVE",
+	LockAccessExclusive:      "ACCESS EXCLUSIVE",
+}
+
+// String returns the mode as it is written in a LOCK TABLE statement.
+func (m LockTableMode) String() string {
+	return lockTableModeName[m]
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+// CreateMaskingPolicy represents a CREATE MASKING POLICY statement, which replaces a column's values with the result
+// of an expression whenever the column is read by the given role.
+type CreateMaskingPolicy struct {
+	Name Name
+	// Column is the column that is masked, which is qualified by at least the table name.
+	Column *UnresolvedName
+	Role   string
+	Expr   Expr
+}
+
+var _ Statement = &CreateMaskingPolicy{}
+
+// Format implements the NodeFormatter interface.
+func (node *CreateMaskingPolicy) Format(ctx *FmtCtx) {
+	ctx.WriteString("CREATE MASKING POLICY ")
+	ctx.FormatNode(&node.Name)
+	ctx.WriteString(" ON ")
+	ctx.FormatNode(node.Column)
+	ctx.WriteString(" FOR ")
+	ctx.FormatNameP(&node.Role)
+	ctx.WriteString(" USING ")
+	ctx.FormatNode(node.Expr)
+}
+
+// DropMaskingPolicy represents a DROP MASKING POLICY statement.
+type DropMaskingPolicy struct {
+	Name     Name
+	IfExists bool
+}
+
+var _ Statement = &DropMaskingPolicy{}
+
+// Format implements the NodeFormatter interface.
+func (node *DropMaskingPolicy) Format(ctx *FmtCtx) {
+	ctx.WriteString("DROP MASKING POLICY ")
+	if node.IfExists {
+		ctx.WriteString("IF EXISTS ")
+	}
+	ctx.FormatNode(&node.Name)
+}
@@ -0,0 +1,130 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+// PublicationTables are the tables that are published by a publication.
+type PublicationTables struct {
+	AllTables bool
+	Tables    TableNames
+}
+
+// Format implements the NodeFormatter interface.
+func (node *PublicationTables) Format(ctx *FmtCtx) {
+	if node.AllTables {
+		ctx.WriteString("ALL TABLES")
+		return
+	}
+	ctx.WriteString("TABLE ")
+	ctx.FormatNode(&node.Tables)
+}
+
+// CreatePublication represents a CREATE PUBLICATION statement.
+type CreatePublication struct {
+	Name Name
+	// Tables is nil when the publication does not yet publish any tables.
+	Tables *PublicationTables
+	Params StorageParams
+}
+
+var _ Statement = &CreatePublication{}
+
+// Format implements the NodeFormatter interface.
+func (node *CreatePublication) Format(ctx *FmtCtx) {
+	ctx.WriteString("CREATE PUBLICATION ")
+	ctx.FormatNode(&node.Name)
+	if node.Tables != nil {
+		ctx.WriteString(" FOR ")
+		ctx.FormatNode(node.Tables)
+	}
+	if len(node.Params) > 0 {
+		ctx.WriteString(" WITH (")
+		ctx.FormatNode(&node.Params)
+		ctx.WriteByte(')')
+	}
+}
+
+// AlterPublicationAction is the change that an ALTER PUBLICATION statement makes.
+type AlterPublicationAction uint8
+
+const (
+	AlterPublicationAddTable AlterPublicationAction = iota
+	AlterPublicationDropTable
+	AlterPublicationSetTable
+	AlterPublicationSetParams
+	AlterPublicationRename
+	AlterPublicationOwner
+)
+
+// AlterPublication represents an ALTER PUBLICATION statement.
+type AlterPublication struct {
+	Name   Name
+	Action AlterPublicationAction
+	// Tables are the tables that are added, dropped, or set.
+	Tables  TableNames
+	Params  StorageParams
+	NewName Name
+	Owner   string
+}
+
+var _ Statement = &AlterPublication{}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterPublication) Format(ctx *FmtCtx) {
+	ctx.WriteString("ALTER PUBLICATION ")
+	ctx.FormatNode(&node.Name)
+	switch node.Action {
+	case AlterPublicationAddTable:
+		ctx.WriteString(" ADD TABLE ")
+		ctx.FormatNode(&node.Tables)
+	case AlterPublicationDropTable:
+		ctx.WriteString(" DROP TABLE ")
+		ctx.FormatNode(&node.Tables)
+	case AlterPublicationSetTable:
+		ctx.WriteString(" SET TABLE ")
+		ctx.FormatNode(&node.Tables)
+	case AlterPublicationSetParams:
+		ctx.WriteString(" SET (")
+		ctx.FormatNode(&node.Params)
+		ctx.WriteByte(')')
+	case AlterPublicationRename:
+		ctx.WriteString(" RENAME TO ")
+		ctx.FormatNode(&node.NewName)
+	case AlterPublicationOwner:
+		ctx.WriteString(" OWNER TO ")
+		ctx.FormatNameP(&node.Owner)
+	}
+}
+
+// DropPublication represents a DROP PUBLICATION statement.
+type DropPublication struct {
+	Names        NameList
+	IfExists     bool
+	DropBehavior DropBehavior
+}
+
+var _ Statement = &DropPublication{}
+
+// Format implements the NodeFormatter interface.
+func (node *DropPublication) Format(ctx *FmtCtx) {
+	ctx.WriteString("DROP PUBLICATION ")
+	if node.IfExists {
+		ctx.WriteString("IF EXISTS ")
+	}
+	ctx.FormatNode(&node.Names)
+	if node.DropBehavior != DropDefault {
+		ctx.WriteByte(' ')
+		ctx.WriteString(node.DropBehavior.String())
+	}
+}
@@ -191,16 +191,31 @@ func (node *SelectExpr) Format(ctx *FmtCtx) {
 }
 
 // AliasClause represents an alias, optionally with a column list:
-// "AS name" or "AS name(col1, col2)".
+// "AS name" or "AS name(col1, col2)". Functions in the FROM clause may
+// instead be given a column definition list: "AS name(col1 type1, col2 type2)",
+// in which case ColTypes has the same length as Cols.
 type AliasClause struct {
-	Alias Name
-	Cols  NameList
+	Alias    Name
+	Cols     NameList
+	ColTypes []ResolvableTypeReference
 }
 
 // Format implements the NodeFormatter interface.
 func (a *AliasClause) Format(ctx *FmtCtx) {
 	ctx.FormatNode(&a.Alias)
-	if len(a.Cols) != 0 {
+	if len(a.ColTypes) != 0 {
+		// Format as "alias (col1 type1, col2 type2, ...)".
+		ctx.WriteString(" (")
+		for i := range a.Cols {
+			if i > 0 {
+				ctx.WriteString(", ")
+			}
+			ctx.FormatNode(&a.Cols[i])
+			ctx.WriteByte(' ')
+			ctx.WriteString(a.ColTypes[i].SQLString())
+		}
+		ctx.WriteByte(')')
+	} else if len(a.Cols) != 0 {
 		// Format as "alias (col1, col2, ...)".
 		ctx.WriteString(" (")
 		ctx.FormatNode(&a.Cols)
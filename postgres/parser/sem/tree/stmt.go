@@ -344,6 +344,12 @@ func (*AlterView) StatementTag() string { return "ALTER VIEW" }
 
 func (*AlterView) hiddenFromShowQueries() {}
 
+// StatementType implements the Statement interface.
+func (*AlterPublication) StatementType() StatementType { return DDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*AlterPublication) StatementTag() string { return "ALTER PUBLICATION" }
+
 // StatementType implements the Statement interface.
 func (*AlterSequence) StatementType() StatementType { return DDL }
 
@@ -446,6 +452,18 @@ func (*Comment) StatementType() StatementType { return DDL }
 // StatementTag returns a short string identifying the type of statement.
 func (*Comment) StatementTag() string { return "COMMENT" }
 
+// StatementType implements the Statement interface.
+func (*CloseCursor) StatementType() StatementType { return Ack }
+
+// StatementTag returns a short string identifying the type of statement.
+func (n *CloseCursor) StatementTag() string {
+	// Postgres distinguishes the command tags for these two cases of Close statements.
+	if n.All {
+		return "CLOSE CURSOR ALL"
+	}
+	return "CLOSE CURSOR"
+}
+
 // StatementType implements the Statement interface.
 func (*CommitTransaction) StatementType() StatementType { return Ack }
 
@@ -458,6 +476,12 @@ func (*CopyFrom) StatementType() StatementType { return CopyIn }
 // StatementTag returns a short string identifying the type of statement.
 func (*CopyFrom) StatementTag() string { return "COPY" }
 
+// StatementType implements the Statement interface.
+func (*CopyTo) StatementType() StatementType { return Rows }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*CopyTo) StatementTag() string { return "COPY" }
+
 // StatementType implements the Statement interface.
 func (*CreateAggregate) StatementType() StatementType { return DDL }
 
@@ -580,6 +604,30 @@ func (*CreateView) StatementType() StatementType { return DDL }
 // StatementTag returns a short string identifying the type of statement.
 func (*CreateView) StatementTag() string { return "CREATE VIEW" }
 
+// StatementType implements the Statement interface.
+func (*CreateForeignTable) StatementType() StatementType { return DDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*CreateForeignTable) StatementTag() string { return "CREATE FOREIGN TABLE" }
+
+// StatementType implements the Statement interface.
+func (*CreateMaskingPolicy) StatementType() StatementType { return DDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*CreateMaskingPolicy) StatementTag() string { return "CREATE MASKING POLICY" }
+
+// StatementType implements the Statement interface.
+func (*CreatePublication) StatementType() StatementType { return DDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*CreatePublication) StatementTag() string { return "CREATE PUBLICATION" }
+
+// StatementType implements the Statement interface.
+func (*CreateServer) StatementType() StatementType { return DDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*CreateServer) StatementTag() string { return "CREATE SERVER" }
+
 // StatementType implements the Statement interface.
 func (*CreateSequence) StatementType() StatementType { return DDL }
 
@@ -592,6 +640,12 @@ func (*CreateStats) StatementType() StatementType { return DDL }
 // StatementTag returns a short string identifying the type of statement.
 func (*CreateStats) StatementTag() string { return "CREATE STATISTICS" }
 
+// StatementType implements the Statement interface.
+func (*DeclareCursor) StatementType() StatementType { return Ack }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*DeclareCursor) StatementTag() string { return "DECLARE CURSOR" }
+
 // StatementType implements the Statement interface.
 func (*Deallocate) StatementType() StatementType { return Ack }
 
@@ -682,6 +736,30 @@ func (*DropView) StatementType() StatementType { return DDL }
 // StatementTag returns a short string identifying the type of statement.
 func (*DropView) StatementTag() string { return "DROP VIEW" }
 
+// StatementType implements the Statement interface.
+func (*DropForeignTable) StatementType() StatementType { return DDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*DropForeignTable) StatementTag() string { return "DROP FOREIGN TABLE" }
+
+// StatementType implements the Statement interface.
+func (*DropMaskingPolicy) StatementType() StatementType { return DDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*DropMaskingPolicy) StatementTag() string { return "DROP MASKING POLICY" }
+
+// StatementType implements the Statement interface.
+func (*DropPublication) StatementType() StatementType { return DDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*DropPublication) StatementTag() string { return "DROP PUBLICATION" }
+
+// StatementType implements the Statement interface.
+func (*DropServer) StatementType() StatementType { return DDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*DropServer) StatementTag() string { return "DROP SERVER" }
+
 // StatementType implements the Statement interface.
 func (*DropSequence) StatementType() StatementType { return DDL }
 
@@ -736,6 +814,12 @@ func (*Export) cclOnlyStatement() {}
 // StatementTag returns a short string identifying the type of statement.
 func (*Export) StatementTag() string { return "EXPORT" }
 
+// StatementType implements the Statement interface.
+func (*FetchCursor) StatementType() StatementType { return Rows }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*FetchCursor) StatementTag() string { return "FETCH" }
+
 // StatementType implements the Statement interface.
 func (*Grant) StatementType() StatementType { return DDL }
 
@@ -762,6 +846,30 @@ func (*Import) StatementTag() string { return "IMPORT" }
 
 func (*Import) cclOnlyStatement() {}
 
+// StatementType implements the Statement interface.
+func (*Listen) StatementType() StatementType { return Ack }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*Listen) StatementTag() string { return "LISTEN" }
+
+// StatementType implements the Statement interface.
+func (*LockTable) StatementType() StatementType { return Ack }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*LockTable) StatementTag() string { return "LOCK TABLE" }
+
+// StatementType implements the Statement interface.
+func (*MoveCursor) StatementType() StatementType { return RowsAffected }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*MoveCursor) StatementTag() string { return "MOVE" }
+
+// StatementType implements the Statement interface.
+func (*Notify) StatementType() StatementType { return Ack }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*Notify) StatementTag() string { return "NOTIFY" }
+
 // StatementType implements the Statement interface.
 func (*ParenSelect) StatementType() StatementType { return Rows }
 
@@ -835,10 +943,10 @@ func (n *Relocate) StatementTag() string {
 }
 
 // StatementType implements the Statement interface.
-func (*ResetAll) StatementType() StatementType { return Rows }
+func (*ResetAll) StatementType() StatementType { return Ack }
 
 // StatementTag returns a short string identifying the type of statement.
-func (*ResetAll) StatementTag() string { return "RESET ALL" }
+func (*ResetAll) StatementTag() string { return "RESET" }
 
 // StatementType implements the Statement interface.
 func (*Restore) StatementType() StatementType { return Rows }
@@ -932,7 +1040,7 @@ func (*SetRole) StatementTag() string { return "SET ROLE" }
 func (*SetTransaction) StatementType() StatementType { return Ack }
 
 // StatementTag returns a short string identifying the type of statement.
-func (*SetTransaction) StatementTag() string { return "SET TRANSACTION" }
+func (*SetTransaction) StatementTag() string { return "SET" }
 
 // StatementType implements the Statement interface.
 func (*SetSessionAuthorization) StatementType() StatementType { return Ack }
@@ -1163,6 +1271,12 @@ func (*Truncate) StatementTag() string { return "TRUNCATE" }
 // modifiesSchema implements the canModifySchema interface.
 func (*Truncate) modifiesSchema() bool { return true }
 
+// StatementType implements the Statement interface.
+func (*Unlisten) StatementType() StatementType { return Ack }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*Unlisten) StatementTag() string { return "UNLISTEN" }
+
 // StatementType implements the Statement interface.
 func (n *Update) StatementType() StatementType { return n.Returning.statementType() }
 
@@ -1202,6 +1316,7 @@ func (n *AlterTrigger) String() string              { return AsString(n) }
 func (n *AlterType) String() string                 { return AsString(n) }
 func (n *AlterView) String() string                 { return AsString(n) }
 func (n *AlterRole) String() string                 { return AsString(n) }
+func (n *AlterPublication) String() string          { return AsString(n) }
 func (n *AlterSequence) String() string             { return AsString(n) }
 func (n *Analyze) String() string                   { return AsString(n) }
 func (n *Backup) String() string                    { return AsString(n) }
@@ -1213,9 +1328,11 @@ func (n *ControlJobsForSchedules) String() string   { return AsString(n) }
 func (n *CancelQueries) String() string             { return AsString(n) }
 func (n *CancelSessions) String() string            { return AsString(n) }
 func (n *CannedOptPlan) String() string             { return AsString(n) }
+func (n *CloseCursor) String() string               { return AsString(n) }
 func (n *Comment) String() string                   { return AsString(n) }
 func (n *CommitTransaction) String() string         { return AsString(n) }
 func (n *CopyFrom) String() string                  { return AsString(n) }
+func (n *CopyTo) String() string                    { return AsString(n) }
 func (n *CreateAggregate) String() string           { return AsString(n) }
 func (n *CreateChangefeed) String() string          { return AsString(n) }
 func (n *CreateDatabase) String() string            { return AsString(n) }
@@ -1231,9 +1348,14 @@ func (n *CreateTable) String() string               { return AsString(n) }
 func (n *CreateTrigger) String() string             { return AsString(n) }
 func (n *CreateType) String() string                { return AsString(n) }
 func (n *CreateSchema) String() string              { return AsString(n) }
+func (n *CreateMaskingPolicy) String() string       { return AsString(n) }
+func (n *CreateForeignTable) String() string        { return AsString(n) }
+func (n *CreatePublication) String() string         { return AsString(n) }
+func (n *CreateServer) String() string              { return AsString(n) }
 func (n *CreateSequence) String() string            { return AsString(n) }
 func (n *CreateStats) String() string               { return AsString(n) }
 func (n *CreateView) String() string                { return AsString(n) }
+func (n *DeclareCursor) String() string             { return AsString(n) }
 func (n *Deallocate) String() string                { return AsString(n) }
 func (n *Delete) String() string                    { return AsString(n) }
 func (n *DropAggregate) String() string             { return AsString(n) }
@@ -1249,6 +1371,10 @@ func (n *DropTable) String() string                 { return AsString(n) }
 func (n *DropTrigger) String() string               { return AsString(n) }
 func (n *DropType) String() string                  { return AsString(n) }
 func (n *DropView) String() string                  { return AsString(n) }
+func (n *DropMaskingPolicy) String() string         { return AsString(n) }
+func (n *DropForeignTable) String() string          { return AsString(n) }
+func (n *DropPublication) String() string           { return AsString(n) }
+func (n *DropServer) String() string                { return AsString(n) }
 func (n *DropSequence) String() string              { return AsString(n) }
 func (n *DropRole) String() string                  { return AsString(n) }
 func (n *Execute) String() string                   { return AsString(n) }
@@ -1258,7 +1384,12 @@ func (n *Export) String() string                    { return AsString(n) }
 func (n *Grant) String() string                     { return AsString(n) }
 func (n *GrantRole) String() string                 { return AsString(n) }
 func (n *Insert) String() string                    { return AsString(n) }
+func (n *FetchCursor) String() string               { return AsString(n) }
 func (n *Import) String() string                    { return AsString(n) }
+func (n *Listen) String() string                    { return AsString(n) }
+func (n *LockTable) String() string                 { return AsString(n) }
+func (n *MoveCursor) String() string                { return AsString(n) }
+func (n *Notify) String() string                    { return AsString(n) }
 func (n *ParenSelect) String() string               { return AsString(n) }
 func (n *Prepare) String() string                   { return AsString(n) }
 func (n *ReleaseSavepoint) String() string          { return AsString(n) }
@@ -1323,5 +1454,6 @@ func (n *Split) String() string                     { return AsString(n) }
 func (n *Unsplit) String() string                   { return AsString(n) }
 func (n *Truncate) String() string                  { return AsString(n) }
 func (n *UnionClause) String() string               { return AsString(n) }
+func (n *Unlisten) String() string                  { return AsString(n) }
 func (n *Update) String() string                    { return AsString(n) }
 func (n *ValuesClause) String() string              { return AsString(n) }
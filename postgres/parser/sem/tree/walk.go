@@ -1400,6 +1400,12 @@ func walkStmt(v Visitor, stmt Statement) (newStmt Statement, changed bool) {
 	return newStmt, stmt != newStmt
 }
 
+// WalkStmt walks the statement, calling WalkExpr on each expression and replacing each expression with the one
+// returned by WalkExpr. The same caveats that apply to walkStmt also apply here.
+func WalkStmt(v Visitor, stmt Statement) (newStmt Statement, changed bool) {
+	return walkStmt(v, stmt)
+}
+
 type simpleVisitor struct {
 	fn  SimpleVisitFn
 	err error
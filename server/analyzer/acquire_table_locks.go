@@ -0,0 +1,160 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	"github.com/dolthub/doltgresql/server/locks"
+)
+
+// AcquireTableLocks acquires the table-level locks that Postgres takes on the tables of a statement while it's being
+// planned, which are held until the end of the transaction. Tables that are read are locked in ACCESS SHARE mode, tables
+// that are written are locked in ROW EXCLUSIVE mode, and tables that are altered or truncated are locked in ACCESS
+// EXCLUSIVE mode. These only conflict with each other and with LOCK TABLE, as Dolt's transactions already isolate the
+// rows that each transaction reads and writes.
+func AcquireTableLocks(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	if ctx.Session == nil {
+		return node, transform.SameTree, nil
+	}
+	locker := tableLocker{ctx: ctx}
+	if err := locker.lock(node); err != nil {
+		return nil, transform.SameTree, err
+	}
+	return node, transform.SameTree, nil
+}
+
+// tableLocker acquires the table-level locks of a statement.
+type tableLocker struct {
+	ctx *sql.Context
+}
+
+// lock acquires the locks required by the node and its descendants.
+func (l tableLocker) lock(node sql.Node) error {
+	var err error
+	transform.Inspect(node, func(node sql.Node) bool {
+		if err != nil {
+			return false
+		}
+		var descend bool
+		descend, err = l.lockNode(node)
+		if err == nil {
+			err = l.lockSubqueries(node)
+		}
+		return descend && err == nil
+	})
+	return err
+}
+
+// lockNode acquires the locks required by the node itself, returning whether its children should be locked.
+func (l tableLocker) lockNode(node sql.Node) (bool, error) {
+	switch node := node.(type) {
+	case *plan.ResolvedTable:
+		return false, l.lockTable(node, locks.AccessShare)
+	case *plan.InsertInto:
+		if err := l.lockTarget(node.Destination); err != nil {
+			return false, err
+		}
+		return false, l.lock(node.Source)
+	case *plan.Update:
+		return false, l.lockTargetAndSources(node.Child)
+	case *plan.DeleteFrom:
+		return false, l.lockTargetAndSources(node.Child)
+	default:
+		if plan.IsDDLNode(node) {
+			return false, l.lockAll(node, locks.AccessExclusive)
+		}
+		return true, nil
+	}
+}
+
+// lockSubqueries acquires the locks required by any subqueries within the expressions of the node.
+func (l tableLocker) lockSubqueries(node sql.Node) error {
+	expressioner, ok := node.(sql.Expressioner)
+	if !ok {
+		return nil
+	}
+	var err error
+	for _, expr := range expressioner.Expressions() {
+		transform.InspectExpr(expr, func(expr sql.Expression) bool {
+			if subquery, ok := expr.(*plan.Subquery); ok && err == nil {
+				err = l.lock(subquery.Query)
+			}
+			return err != nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lockTarget locks the table that is written by the node in ROW EXCLUSIVE mode.
+func (l tableLocker) lockTarget(node sql.Node) error {
+	rt := firstResolvedTable(node)
+	if rt == nil {
+		return nil
+	}
+	return l.lockTable(rt, locks.RowExclusive)
+}
+
+// lockTargetAndSources locks the table that is written by the node in ROW EXCLUSIVE mode, and every other table that
+// the node reads in ACCESS SHARE mode.
+func (l tableLocker) lockTargetAndSources(node sql.Node) error {
+	target := firstResolvedTable(node)
+	var err error
+	transform.Inspect(node, func(node sql.Node) bool {
+		if err != nil {
+			return false
+		}
+		if node == target {
+			err = l.lockTable(target, locks.RowExclusive)
+			return false
+		}
+		var descend bool
+		descend, err = l.lockNode(node)
+		if err == nil {
+			err = l.lockSubqueries(node)
+		}
+		return descend && err == nil
+	})
+	return err
+}
+
+// lockAll locks every table that the node references in the given mode.
+func (l tableLocker) lockAll(node sql.Node, mode locks.Mode) error {
+	var err error
+	transform.Inspect(node, func(node sql.Node) bool {
+		if rt, ok := node.(*plan.ResolvedTable); ok && err == nil {
+			err = l.lockTable(rt, mode)
+		}
+		return err == nil
+	})
+	return err
+}
+
+// lockTable locks the table in the given mode. Tables without privileges, such as those of the system schemas, are not
+// locked.
+func (l tableLocker) lockTable(rt *plan.ResolvedTable, mode locks.Mode) error {
+	obj, ok, err := tableObject(l.ctx, rt)
+	if err != nil || !ok {
+		return err
+	}
+	relation := locks.Relation{Database: obj.Database, Schema: obj.Schema, Name: obj.Name}
+	return locks.LockRelation(l.ctx, l.ctx.Session.ID(), relation, mode, false, locks.Timeout(l.ctx))
+}
@@ -0,0 +1,47 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// ApplyDistinctOn replaces the expressions of a DISTINCT ON clause, which are given as the last field of a sort, with a
+// DistinctOn node above the sort. This keeps the first row of each distinct group according to the remaining fields,
+// which are those of the ORDER BY clause.
+func ApplyDistinctOn(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	return transform.Node(node, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		sort, ok := node.(*plan.Sort)
+		if !ok || len(sort.SortFields) == 0 {
+			return node, transform.SameTree, nil
+		}
+		distinctOn, ok := sort.SortFields[len(sort.SortFields)-1].Column.(*pgexprs.DistinctOn)
+		if !ok {
+			return node, transform.SameTree, nil
+		}
+		child := sort.Child
+		// The sort is only needed when there was an ORDER BY clause
+		if sortFields := sort.SortFields[:len(sort.SortFields)-1]; len(sortFields) > 0 {
+			child = plan.NewSort(sortFields, sort.Child)
+		}
+		return pgnodes.NewDistinctOn(child, distinctOn), transform.NewTree, nil
+	})
+}
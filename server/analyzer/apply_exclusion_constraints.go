@@ -0,0 +1,90 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// ApplyExclusionConstraints verifies the rows written by INSERT and UPDATE statements against the exclusion constraints
+// of their table. This must run after the triggers have been applied, as the rows are verified once the BEFORE triggers
+// have changed them.
+func ApplyExclusionConstraints(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	return transform.Node(node, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		accumulator, ok := node.(*plan.RowUpdateAccumulator)
+		if !ok {
+			return node, transform.SameTree, nil
+		}
+		return applyExclusionConstraintsToAccumulator(ctx, accumulator)
+	})
+}
+
+// applyExclusionConstraintsToAccumulator adds the exclusion constraint check for the statement beneath the given
+// accumulator.
+func applyExclusionConstraintsToAccumulator(ctx *sql.Context, accumulator *plan.RowUpdateAccumulator) (sql.Node, transform.TreeIdentity, error) {
+	var tableNode sql.Node
+	var rowSource sql.Node
+	switch child := accumulator.Child().(type) {
+	case *plan.InsertInto:
+		tableNode = child.Destination
+		rowSource = child.Source
+	case *plan.Update:
+		tableNode = child.Child
+		rowSource = child.Child
+	default:
+		return accumulator, transform.SameTree, nil
+	}
+	table, _, ok := triggerTable(tableNode)
+	if !ok {
+		return accumulator, transform.SameTree, nil
+	}
+	constraints, tableName, err := core.GetExclusionConstraintsForTable(ctx, table.Database().Name(), doltdb.TableName{Name: table.Name(), Schema: tableSchema(table)})
+	if err != nil {
+		return nil, transform.NewTree, err
+	}
+	if len(constraints) == 0 {
+		return accumulator, transform.SameTree, nil
+	}
+	var newChild sql.Node
+	switch child := accumulator.Child().(type) {
+	case *plan.InsertInto:
+		if len(child.OnDupExprs) > 0 || child.IsReplace {
+			return nil, transform.NewTree, pgerrors.New(pgcode.WrongObjectType, "ON CONFLICT DO UPDATE not supported with exclusion constraints")
+		}
+		newChild = child.WithSource(pgnodes.NewExclusionCheck(rowSource, table.Database().Name(), tableName, constraints, false, child.Ignore))
+	case *plan.Update:
+		if accumulator.RowUpdateType != plan.UpdateTypeUpdate {
+			return nil, transform.NewTree, pgerrors.New(pgcode.FeatureNotSupported, "exclusion constraints are not yet supported for UPDATE with FROM")
+		}
+		newChild, err = child.WithChildren(pgnodes.NewExclusionCheck(rowSource, table.Database().Name(), tableName, constraints, true, false))
+		if err != nil {
+			return nil, transform.NewTree, err
+		}
+	}
+	newNode, err := accumulator.WithChildren(newChild)
+	if err != nil {
+		return nil, transform.NewTree, err
+	}
+	return newNode, transform.NewTree, nil
+}
@@ -0,0 +1,254 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/planbuilder"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/masking"
+	"github.com/dolthub/doltgresql/postgres/parser/parser"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/ast"
+)
+
+// maskingPolicyContextKey marks a context that is building the expressions of masking policies. The tables within
+// such a context are not masked, since the expressions are evaluated against the unmasked rows.
+type maskingPolicyContextKey struct{}
+
+// ApplyMaskingPolicies replaces the tables that are read by the current role with tables that return the masked values
+// of any columns that have a masking policy for the role. Tables that are the target of an UPDATE or DELETE are left
+// unmasked, as their rows are written back to the table.
+func ApplyMaskingPolicies(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	if ctx.Value(maskingPolicyContextKey{}) != nil {
+		return node, transform.SameTree, nil
+	}
+	return applyMaskingPolicies(ctx, a, node)
+}
+
+// applyMaskingPolicies handles the recursion of ApplyMaskingPolicies.
+func applyMaskingPolicies(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+	switch node := node.(type) {
+	case *plan.Update, *plan.DeleteFrom:
+		return node, transform.SameTree, nil
+	case *plan.InsertInto:
+		source, same, err := applyMaskingPolicies(ctx, a, node.Source)
+		if err != nil || same == transform.SameTree {
+			return node, transform.SameTree, err
+		}
+		return node.WithSource(source), transform.NewTree, nil
+	case *plan.TableCopier:
+		// The copier may copy the underlying table directly rather than reading its rows, so we can't allow it to read
+		// from a masked table
+		if _, same, err := applyMaskingPolicies(ctx, a, node.Source); err != nil || same == transform.SameTree {
+			return node, transform.SameTree, err
+		}
+		return nil, transform.SameTree, fmt.Errorf("CREATE TABLE AS is not supported when reading masked columns")
+	case *plan.ResolvedTable:
+		return maskResolvedTable(ctx, a, node)
+	}
+	children := node.Children()
+	newChildren := make([]sql.Node, len(children))
+	same := transform.SameTree
+	for i, child := range children {
+		newChild, childSame, err := applyMaskingPolicies(ctx, a, child)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		newChildren[i] = newChild
+		if childSame == transform.NewTree {
+			same = transform.NewTree
+		}
+	}
+	if same == transform.SameTree {
+		return node, transform.SameTree, nil
+	}
+	newNode, err := node.WithChildren(newChildren...)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	return newNode, transform.NewTree, nil
+}
+
+// maskResolvedTable returns the given table wrapped by a maskedTable if any policies apply to the table for the
+// current role.
+func maskResolvedTable(ctx *sql.Context, a *analyzer.Analyzer, rt *plan.ResolvedTable) (sql.Node, transform.TreeIdentity, error) {
+	if _, ok := rt.Table.(*maskedTable); ok {
+		return rt, transform.SameTree, nil
+	}
+	// Only tables that belong to a Doltgres database may have policies
+	db, ok := rt.UnwrappedDatabase().(interface{ Schema() string })
+	if !ok {
+		return rt, transform.SameTree, nil
+	}
+	collection, tableName, err := core.GetMaskingPoliciesForTable(ctx, rt.Database().Name(),
+		doltdb.TableName{Name: rt.Name(), Schema: db.Schema()})
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	if collection.IsEmpty() || len(tableName.Schema) == 0 {
+		return rt, transform.SameTree, nil
+	}
+	policies := collection.PoliciesForTable(tableName.Schema, tableName.Name, ctx.Client().User)
+	if len(policies) == 0 {
+		return rt, transform.SameTree, nil
+	}
+	masks, err := buildMaskingExpressions(ctx, a, tableName.Schema, rt.Table, policies)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	newRt, err := rt.ReplaceTable(&maskedTable{underlying: rt.Table, masks: masks})
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	return newRt, transform.NewTree, nil
+}
+
+// buildMaskingExpressions analyzes the expressions of the given policies, returning them by the index of the column
+// that they mask. The expressions are analyzed as part of a query that reads every column of the table, so that they
+// may be evaluated against the table's full rows.
+func buildMaskingExpressions(ctx *sql.Context, a *analyzer.Analyzer, schemaName string, table sql.Table, policies []*masking.Policy) (map[int]sql.Expression, error) {
+	sch := table.Schema()
+	indexes := make([]int, len(policies))
+	sb := strings.Builder{}
+	sb.WriteString("SELECT *")
+	for i, policy := range policies {
+		indexes[i] = sch.IndexOfColName(policy.Column)
+		if indexes[i] == -1 {
+			return nil, fmt.Errorf(`column "%s" of masking policy "%s" does not exist`, policy.Column, policy.Name)
+		}
+		sb.WriteString(fmt.Sprintf(", (%s)::%s", policy.Expression, sch[indexes[i]].Type.String()))
+	}
+	sb.WriteString(fmt.Sprintf(" FROM %s.%s", tree.NameString(schemaName), tree.NameString(table.Name())))
+	query := sb.String()
+
+	stmt, err := parser.ParseOne(query)
+	if err != nil {
+		return nil, err
+	}
+	vitessStmt, err := ast.Convert(stmt)
+	if err != nil {
+		return nil, err
+	}
+	maskingCtx := ctx.WithContext(context.WithValue(ctx.Context, maskingPolicyContextKey{}, true))
+	node, err := planbuilder.New(maskingCtx, a.Catalog, sql.GlobalParser).BindOnly(vitessStmt, query)
+	if err != nil {
+		return nil, err
+	}
+	node, err = a.Analyze(maskingCtx, node, nil)
+	if err != nil {
+		return nil, err
+	}
+	// The projection is wrapped by nodes that handle the query's process, transaction, and so on
+	project, ok := node.(*plan.Project)
+	for wrapper, isWrapper := node.(interface{ Child() sql.Node }); !ok && isWrapper; wrapper, isWrapper = node.(interface{ Child() sql.Node }) {
+		node = wrapper.Child()
+		project, ok = node.(*plan.Project)
+	}
+	if !ok || len(project.Projections) != len(sch)+len(policies) {
+		return nil, fmt.Errorf("unable to build the expressions of the masking policies on %s", table.Name())
+	}
+	masks := make(map[int]sql.Expression, len(policies))
+	for i, projection := range project.Projections[len(sch):] {
+		if alias, ok := projection.(*expression.Alias); ok {
+			projection = alias.Child
+		}
+		masks[indexes[i]] = projection
+	}
+	return masks, nil
+}
+
+// maskedTable is a table that replaces the values of its masked columns. It intentionally implements only sql.Table,
+// so that the analyzer cannot push filters, projections, or index lookups into the underlying table, which would
+// otherwise operate on the unmasked values.
+type maskedTable struct {
+	underlying sql.Table
+	masks      map[int]sql.Expression
+}
+
+var _ sql.Table = (*maskedTable)(nil)
+
+// Name implements the interface sql.Table.
+func (t *maskedTable) Name() string {
+	return t.underlying.Name()
+}
+
+// String implements the interface sql.Table.
+func (t *maskedTable) String() string {
+	return t.underlying.String()
+}
+
+// Schema implements the interface sql.Table.
+func (t *maskedTable) Schema() sql.Schema {
+	return t.underlying.Schema()
+}
+
+// Collation implements the interface sql.Table.
+func (t *maskedTable) Collation() sql.CollationID {
+	return t.underlying.Collation()
+}
+
+// Partitions implements the interface sql.Table.
+func (t *maskedTable) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	return t.underlying.Partitions(ctx)
+}
+
+// PartitionRows implements the interface sql.Table.
+func (t *maskedTable) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	iter, err := t.underlying.PartitionRows(ctx, partition)
+	if err != nil {
+		return nil, err
+	}
+	return &maskedRowIter{iter: iter, masks: t.masks}, nil
+}
+
+// maskedRowIter replaces the values of the masked columns in the rows of the wrapped iterator.
+type maskedRowIter struct {
+	iter  sql.RowIter
+	masks map[int]sql.Expression
+}
+
+var _ sql.RowIter = (*maskedRowIter)(nil)
+
+// Next implements the interface sql.RowIter.
+func (iter *maskedRowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := iter.iter.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	maskedRow := row.Copy()
+	for idx, mask := range iter.masks {
+		maskedRow[idx], err = mask.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return maskedRow, nil
+}
+
+// Close implements the interface sql.RowIter.
+func (iter *maskedRowIter) Close(ctx *sql.Context) error {
+	return iter.iter.Close(ctx)
+}
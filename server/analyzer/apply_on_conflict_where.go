@@ -0,0 +1,54 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+)
+
+// ApplyOnConflictWhere applies the WHERE clause of an INSERT ... ON CONFLICT DO UPDATE to all of its assignments. The
+// clause is attached to the first assignment while the statement is built, and GMS expects each assignment to be a
+// SetField until the execution indexes have been assigned, so this must run afterward.
+func ApplyOnConflictWhere(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	return transform.Node(node, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		insertInto, ok := node.(*plan.InsertInto)
+		if !ok || len(insertInto.OnDupExprs) == 0 {
+			return node, transform.SameTree, nil
+		}
+		var condition sql.Expression
+		firstAssignment, _, err := transform.Expr(insertInto.OnDupExprs[0], func(expr sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+			if where, ok := expr.(*pgexprs.OnConflictWhere); ok {
+				condition = where.Condition()
+				return where.Child(), transform.NewTree, nil
+			}
+			return expr, transform.SameTree, nil
+		})
+		if err != nil {
+			return nil, transform.NewTree, err
+		}
+		if condition == nil {
+			return node, transform.SameTree, nil
+		}
+		assignments := append([]sql.Expression{firstAssignment}, insertInto.OnDupExprs[1:]...)
+		newInsertInto := *insertInto
+		newInsertInto.OnDupExprs = []sql.Expression{pgexprs.NewOnConflictUpdate(condition, assignments)}
+		return &newInsertInto, transform.NewTree, nil
+	})
+}
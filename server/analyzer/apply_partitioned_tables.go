@@ -0,0 +1,733 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/partitions"
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// ApplyPartitionedTables replaces partitioned tables with tables that read from and write to their partitions. Filters
+// that compare a column of the partition key against a constant are used to skip the partitions that cannot contain any
+// matching rows, while writes are always routed to the partition that holds the row.
+func ApplyPartitionedTables(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	return applyPartitionedTables(ctx, node, nil)
+}
+
+// applyPartitionedTables handles the recursion of ApplyPartitionedTables. The filters are those of the enclosing Filter
+// nodes, which apply to every table beneath them as long as only joins and aliases are in between.
+func applyPartitionedTables(ctx *sql.Context, node sql.Node, filters []sql.Expression) (sql.Node, transform.TreeIdentity, error) {
+	switch node := node.(type) {
+	case *plan.InsertInto:
+		// The source is not a child, so it's handled separately from the destination
+		source, sourceSame, err := applyPartitionedTables(ctx, node.Source, nil)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		destination, destinationSame, err := applyPartitionedTables(ctx, node.Destination, nil)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		if sourceSame == transform.SameTree && destinationSame == transform.SameTree {
+			return node, transform.SameTree, nil
+		}
+		newNode, err := node.WithSource(source).WithChildren(destination)
+		return newNode, transform.NewTree, err
+	case *plan.Truncate:
+		// Truncation removes the rows of every partition, so it's handled even though it's a DDL node
+	case *plan.TableCountLookup:
+		// The row count of a partitioned table is taken before this rule runs, so it must be counted from its partitions
+		return applyPartitionedTableCount(ctx, node)
+	case *pgnodes.CreateTable:
+		return node, transform.SameTree, nil
+	case *plan.Filter:
+		filters = append(filters[:len(filters):len(filters)], expression.SplitConjunction(node.Expression)...)
+	case *plan.TableAlias:
+		if rt, ok := node.Child.(*plan.ResolvedTable); ok {
+			newRt, same, err := applyPartitionedTable(ctx, rt, node.Name(), filters)
+			if err != nil || same == transform.SameTree {
+				return node, transform.SameTree, err
+			}
+			newNode, err := node.WithChildren(newRt)
+			return newNode, transform.NewTree, err
+		}
+	case *plan.JoinNode:
+		// The filters above a join apply to the rows of both sides
+	case *plan.ResolvedTable:
+		return applyPartitionedTable(ctx, node, node.Name(), filters)
+	default:
+		// Statements that only change the table's definition operate on the partitioned table itself
+		if plan.IsNoRowNode(node) {
+			return node, transform.SameTree, nil
+		}
+		filters = nil
+	}
+	children := node.Children()
+	newChildren := make([]sql.Node, len(children))
+	same := transform.SameTree
+	for i, child := range children {
+		newChild, childSame, err := applyPartitionedTables(ctx, child, filters)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		newChildren[i] = newChild
+		if childSame == transform.NewTree {
+			same = transform.NewTree
+		}
+	}
+	if same == transform.SameTree {
+		return node, transform.SameTree, nil
+	}
+	newNode, err := node.WithChildren(newChildren...)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	return newNode, transform.NewTree, nil
+}
+
+// applyPartitionedTable returns the given table wrapped by a partitionedTable if it is a partitioned table. The name is
+// the name that the query uses for the table, which is how the filters refer to the table's columns.
+func applyPartitionedTable(ctx *sql.Context, rt *plan.ResolvedTable, name string, filters []sql.Expression) (sql.Node, transform.TreeIdentity, error) {
+	if _, ok := rt.Table.(*partitionedTable); ok {
+		return rt, transform.SameTree, nil
+	}
+	// Only tables that belong to a Doltgres database may be partitioned
+	db, ok := rt.UnwrappedDatabase().(interface{ Schema() string })
+	if !ok {
+		return rt, transform.SameTree, nil
+	}
+	database := rt.Database().Name()
+	collection, tableName, err := core.GetPartitionsForTable(ctx, database, doltdb.TableName{Name: rt.Name(), Schema: db.Schema()})
+	if err != nil || collection == nil {
+		return rt, transform.SameTree, err
+	}
+	parent := collection.GetTable(tableName)
+	if parent == nil {
+		return rt, transform.SameTree, nil
+	}
+	table, err := newPartitionedTable(ctx, database, collection, parent, rt.Table)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	if err = table.prune(ctx, name, filters); err != nil {
+		return nil, transform.SameTree, err
+	}
+	newRt, err := rt.ReplaceTable(table)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	return newRt, transform.NewTree, nil
+}
+
+// applyPartitionedTableCount replaces the given row count with the row count of the partitions, if it counts the rows
+// of a partitioned table.
+func applyPartitionedTableCount(ctx *sql.Context, node *plan.TableCountLookup) (sql.Node, transform.TreeIdentity, error) {
+	newRt, same, err := applyPartitionedTable(ctx, plan.NewResolvedTable(node.Table(), node.Db(), nil), node.Table().Name(), nil)
+	if err != nil || same == transform.SameTree {
+		return node, transform.SameTree, err
+	}
+	table := newRt.(*plan.ResolvedTable).Table.(*partitionedTable)
+	count, _, err := table.RowCount(ctx)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	return plan.NewTableCount(node.Name(), node.Db(), table, count, node.Id()), transform.NewTree, nil
+}
+
+// partitionedTable is a partitioned table, whose rows are read from and written to its partitions. Partitions that are
+// partitioned themselves are also represented by a partitionedTable.
+type partitionedTable struct {
+	underlying sql.Table
+	key        *pgnodes.PartitionKey
+	partitions []*tablePartition
+	// readable marks the partitions that may contain rows matching the query's filters.
+	readable []bool
+}
+
+// tablePartition is a single partition of a partitionedTable.
+type tablePartition struct {
+	bound *pgnodes.PartitionBound
+	table sql.Table
+	// columnMap converts between the column orders of the partition and its partitioned table, which is nil when they
+	// have the same order.
+	columnMap []int
+}
+
+var _ sql.Table = (*partitionedTable)(nil)
+var _ sql.InsertableTable = (*partitionedTable)(nil)
+var _ sql.UpdatableTable = (*partitionedTable)(nil)
+var _ sql.DeletableTable = (*partitionedTable)(nil)
+var _ sql.TruncateableTable = (*partitionedTable)(nil)
+var _ sql.PrimaryKeyTable = (*partitionedTable)(nil)
+var _ sql.StatisticsTable = (*partitionedTable)(nil)
+
+// newPartitionedTable returns a new *partitionedTable for the given partitioned table, whose own table is given.
+func newPartitionedTable(ctx *sql.Context, database string, collection *partitions.Collection, parent *partitions.Table, underlying sql.Table) (*partitionedTable, error) {
+	key, err := pgnodes.NewPartitionKey(parent, underlying.Schema())
+	if err != nil {
+		return nil, err
+	}
+	table := &partitionedTable{
+		underlying: underlying,
+		key:        key,
+	}
+	for _, partition := range collection.GetPartitions(parent.Name) {
+		bound, err := key.ResolveBound(partition)
+		if err != nil {
+			return nil, err
+		}
+		partitionTable, err := core.GetSqlTableFromContext(ctx, database, partition.Name)
+		if err != nil {
+			return nil, err
+		}
+		if partitionTable == nil {
+			return nil, fmt.Errorf(`partition "%s" of relation "%s" does not exist`, partition.Name.Name, parent.Name.Name)
+		}
+		columnMap, err := pgnodes.PartitionColumnMap(parent.Name.Name, underlying.Schema(), partition.Name.Name, partitionTable.Schema())
+		if err != nil {
+			return nil, err
+		}
+		if subpartitioned := collection.GetTable(partition.Name); subpartitioned != nil {
+			partitionTable, err = newPartitionedTable(ctx, database, collection, subpartitioned, partitionTable)
+			if err != nil {
+				return nil, err
+			}
+		}
+		table.partitions = append(table.partitions, &tablePartition{
+			bound:     bound,
+			table:     partitionTable,
+			columnMap: columnMap,
+		})
+		table.readable = append(table.readable, true)
+	}
+	return table, nil
+}
+
+// prune marks the partitions that cannot contain any rows matching the given filters as unreadable. The name is the
+// name that the query uses for the table. Partitions that are partitioned themselves are also pruned.
+func (t *partitionedTable) prune(ctx *sql.Context, name string, filters []sql.Expression) error {
+	var pruneFilters []pgnodes.PruneFilter
+	for _, filter := range filters {
+		if pruneFilter, ok := t.pruneFilter(ctx, name, filter); ok {
+			pruneFilters = append(pruneFilters, pruneFilter)
+		}
+	}
+	if len(pruneFilters) == 0 {
+		return nil
+	}
+	bounds := make([]*pgnodes.PartitionBound, len(t.partitions))
+	for i, partition := range t.partitions {
+		bounds[i] = partition.bound
+	}
+	readable, err := t.key.Prune(ctx, bounds, pruneFilters)
+	if err != nil {
+		return err
+	}
+	t.readable = readable
+	for i, partition := range t.partitions {
+		if subpartitioned, ok := partition.table.(*partitionedTable); ok && readable[i] {
+			if err = subpartitioned.prune(ctx, name, filters); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// pruneFilter converts the given filter into a pgnodes.PruneFilter. Returns false if the filter does not compare a
+// column of the partition key against constant values.
+func (t *partitionedTable) pruneFilter(ctx *sql.Context, name string, filter sql.Expression) (pgnodes.PruneFilter, bool) {
+	var op pgnodes.PruneOp
+	var left sql.Expression
+	var right []sql.Expression
+	switch filter := filter.(type) {
+	case *expression.Equals:
+		op, left, right = pgnodes.PruneOp_Equal, filter.Left(), []sql.Expression{filter.Right()}
+	case *expression.LessThan:
+		op, left, right = pgnodes.PruneOp_LessThan, filter.Left(), []sql.Expression{filter.Right()}
+	case *expression.LessThanOrEqual:
+		op, left, right = pgnodes.PruneOp_LessOrEqual, filter.Left(), []sql.Expression{filter.Right()}
+	case *expression.GreaterThan:
+		op, left, right = pgnodes.PruneOp_GreaterThan, filter.Left(), []sql.Expression{filter.Right()}
+	case *expression.GreaterThanOrEqual:
+		op, left, right = pgnodes.PruneOp_GreaterOrEqual, filter.Left(), []sql.Expression{filter.Right()}
+	case *pgexprs.InTuple:
+		tuple, ok := filter.Right().(expression.Tuple)
+		if !ok {
+			return pgnodes.PruneFilter{}, false
+		}
+		op, left, right = pgnodes.PruneOp_Equal, filter.Left(), tuple.Children()
+	default:
+		return pgnodes.PruneFilter{}, false
+	}
+	field, ok := left.(*expression.GetField)
+	if !ok && len(right) == 1 {
+		// The column may be on either side of the comparison, in which case the comparison is flipped
+		if field, ok = right[0].(*expression.GetField); ok {
+			right = []sql.Expression{left}
+			switch op {
+			case pgnodes.PruneOp_LessThan:
+				op = pgnodes.PruneOp_GreaterThan
+			case pgnodes.PruneOp_LessOrEqual:
+				op = pgnodes.PruneOp_GreaterOrEqual
+			case pgnodes.PruneOp_GreaterThan:
+				op = pgnodes.PruneOp_LessThan
+			case pgnodes.PruneOp_GreaterOrEqual:
+				op = pgnodes.PruneOp_LessOrEqual
+			}
+		}
+	}
+	if !ok || !strings.EqualFold(field.Table(), name) {
+		return pgnodes.PruneFilter{}, false
+	}
+	column := -1
+	for i, keyColumn := range t.key.Table.Columns {
+		if keyColumn == field.Name() {
+			column = i
+			break
+		}
+	}
+	if column == -1 {
+		return pgnodes.PruneFilter{}, false
+	}
+	pruneFilter := pgnodes.PruneFilter{Column: column, Op: op, Values: make([]any, len(right))}
+	for i, expr := range right {
+		// Values are converted through their text form, so that they have the same type as the key's column
+		text, ok := constantText(ctx, expr)
+		if !ok {
+			return pgnodes.PruneFilter{}, false
+		}
+		value, err := t.key.ConvertValue(column, text)
+		if err != nil {
+			return pgnodes.PruneFilter{}, false
+		}
+		pruneFilter.Values[i] = value
+	}
+	return pruneFilter, true
+}
+
+// Name implements the interface sql.Table.
+func (t *partitionedTable) Name() string {
+	return t.underlying.Name()
+}
+
+// String implements the interface sql.Table.
+func (t *partitionedTable) String() string {
+	return t.underlying.String()
+}
+
+// Schema implements the interface sql.Table.
+func (t *partitionedTable) Schema() sql.Schema {
+	return t.underlying.Schema()
+}
+
+// Collation implements the interface sql.Table.
+func (t *partitionedTable) Collation() sql.CollationID {
+	return t.underlying.Collation()
+}
+
+// PrimaryKeySchema implements the interface sql.PrimaryKeyTable.
+func (t *partitionedTable) PrimaryKeySchema() sql.PrimaryKeySchema {
+	if pkTable, ok := t.underlying.(sql.PrimaryKeyTable); ok {
+		return pkTable.PrimaryKeySchema()
+	}
+	return sql.NewPrimaryKeySchema(t.underlying.Schema())
+}
+
+// Partitions implements the interface sql.Table.
+func (t *partitionedTable) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	return &partitionedTableIter{table: t}, nil
+}
+
+// PartitionRows implements the interface sql.Table.
+func (t *partitionedTable) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	p, ok := partition.(*partitionedTablePartition)
+	if !ok {
+		return nil, fmt.Errorf("unexpected partition encountered while reading partitioned table %s", t.Name())
+	}
+	tablePartition := t.partitions[p.idx]
+	iter, err := tablePartition.table.PartitionRows(ctx, p.partition)
+	if err != nil {
+		return nil, err
+	}
+	if tablePartition.columnMap == nil {
+		return iter, nil
+	}
+	return &partitionedTableRowIter{iter: iter, columnMap: tablePartition.columnMap}, nil
+}
+
+// Inserter implements the interface sql.InsertableTable.
+func (t *partitionedTable) Inserter(ctx *sql.Context) sql.RowInserter {
+	return newPartitionedTableEditor(t)
+}
+
+// Updater implements the interface sql.UpdatableTable.
+func (t *partitionedTable) Updater(ctx *sql.Context) sql.RowUpdater {
+	return newPartitionedTableEditor(t)
+}
+
+// Deleter implements the interface sql.DeletableTable.
+func (t *partitionedTable) Deleter(ctx *sql.Context) sql.RowDeleter {
+	return newPartitionedTableEditor(t)
+}
+
+// Truncate implements the interface sql.TruncateableTable.
+func (t *partitionedTable) Truncate(ctx *sql.Context) (int, error) {
+	removed := 0
+	for _, partition := range t.partitions {
+		truncatable, ok := partition.table.(sql.TruncateableTable)
+		if !ok {
+			return 0, fmt.Errorf(`partition "%s" cannot be truncated`, partition.table.Name())
+		}
+		count, err := truncatable.Truncate(ctx)
+		if err != nil {
+			return 0, err
+		}
+		removed += count
+	}
+	return removed, nil
+}
+
+// DataLength implements the interface sql.StatisticsTable.
+func (t *partitionedTable) DataLength(ctx *sql.Context) (uint64, error) {
+	var length uint64
+	for _, partition := range t.partitions {
+		if statsTable, ok := partition.table.(sql.StatisticsTable); ok {
+			partitionLength, err := statsTable.DataLength(ctx)
+			if err != nil {
+				return 0, err
+			}
+			length += partitionLength
+		}
+	}
+	return length, nil
+}
+
+// RowCount implements the interface sql.StatisticsTable.
+func (t *partitionedTable) RowCount(ctx *sql.Context) (uint64, bool, error) {
+	var count uint64
+	exact := true
+	for _, partition := range t.partitions {
+		statsTable, ok := partition.table.(sql.StatisticsTable)
+		if !ok {
+			return 0, false, fmt.Errorf(`partition "%s" cannot be counted`, partition.table.Name())
+		}
+		partitionCount, partitionExact, err := statsTable.RowCount(ctx)
+		if err != nil {
+			return 0, false, err
+		}
+		count += partitionCount
+		exact = exact && partitionExact
+	}
+	return count, exact, nil
+}
+
+// route returns the partition that holds the given row, which is in the column order of the partitioned table.
+func (t *partitionedTable) route(ctx *sql.Context, row sql.Row) (int, error) {
+	bounds := make([]*pgnodes.PartitionBound, len(t.partitions))
+	for i, partition := range t.partitions {
+		bounds[i] = partition.bound
+	}
+	idx, err := t.key.Route(ctx, bounds, row)
+	if err != nil {
+		return -1, err
+	}
+	if idx == -1 {
+		return -1, t.key.NoPartitionError(row)
+	}
+	return idx, nil
+}
+
+// partitionedTablePartition is a partition of one of the partitions of a partitionedTable.
+type partitionedTablePartition struct {
+	idx       int
+	partition sql.Partition
+}
+
+var _ sql.Partition = (*partitionedTablePartition)(nil)
+
+// Key implements the interface sql.Partition.
+func (p *partitionedTablePartition) Key() []byte {
+	return append([]byte(fmt.Sprintf("%d:", p.idx)), p.partition.Key()...)
+}
+
+// partitionedTableIter iterates over the partitions of every readable partition of a partitionedTable.
+type partitionedTableIter struct {
+	table *partitionedTable
+	idx   int
+	iter  sql.PartitionIter
+}
+
+var _ sql.PartitionIter = (*partitionedTableIter)(nil)
+
+// Next implements the interface sql.PartitionIter.
+func (iter *partitionedTableIter) Next(ctx *sql.Context) (sql.Partition, error) {
+	for {
+		if iter.iter == nil {
+			for iter.idx < len(iter.table.partitions) && !iter.table.readable[iter.idx] {
+				iter.idx++
+			}
+			if iter.idx >= len(iter.table.partitions) {
+				return nil, io.EOF
+			}
+			var err error
+			iter.iter, err = iter.table.partitions[iter.idx].table.Partitions(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		partition, err := iter.iter.Next(ctx)
+		if err == io.EOF {
+			if err = iter.iter.Close(ctx); err != nil {
+				return nil, err
+			}
+			iter.iter = nil
+			iter.idx++
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		return &partitionedTablePartition{idx: iter.idx, partition: partition}, nil
+	}
+}
+
+// Close implements the interface sql.PartitionIter.
+func (iter *partitionedTableIter) Close(ctx *sql.Context) error {
+	if iter.iter != nil {
+		return iter.iter.Close(ctx)
+	}
+	return nil
+}
+
+// partitionedTableRowIter converts the rows of a partition into the column order of its partitioned table.
+type partitionedTableRowIter struct {
+	iter      sql.RowIter
+	columnMap []int
+}
+
+var _ sql.RowIter = (*partitionedTableRowIter)(nil)
+
+// Next implements the interface sql.RowIter.
+func (iter *partitionedTableRowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := iter.iter.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return pgnodes.RemapPartitionRow(row, iter.columnMap), nil
+}
+
+// Close implements the interface sql.RowIter.
+func (iter *partitionedTableRowIter) Close(ctx *sql.Context) error {
+	return iter.iter.Close(ctx)
+}
+
+// partitionedTableEditor routes the rows that are written to a partitionedTable to the partitions that hold them. The
+// editors of the partitions are opened as they're needed.
+type partitionedTableEditor struct {
+	table          *partitionedTable
+	editors        []*partitionEditors
+	statementBegun bool
+}
+
+// partitionEditors are the editors of a single partition, which are nil until they're needed.
+type partitionEditors struct {
+	inserter sql.RowInserter
+	updater  sql.RowUpdater
+	deleter  sql.RowDeleter
+}
+
+var _ sql.RowInserter = (*partitionedTableEditor)(nil)
+var _ sql.RowUpdater = (*partitionedTableEditor)(nil)
+var _ sql.RowDeleter = (*partitionedTableEditor)(nil)
+
+// newPartitionedTableEditor returns a new *partitionedTableEditor.
+func newPartitionedTableEditor(table *partitionedTable) *partitionedTableEditor {
+	editors := make([]*partitionEditors, len(table.partitions))
+	for i := range editors {
+		editors[i] = &partitionEditors{}
+	}
+	return &partitionedTableEditor{
+		table:   table,
+		editors: editors,
+	}
+}
+
+// StatementBegin implements the interface sql.EditOpenerCloser.
+func (e *partitionedTableEditor) StatementBegin(ctx *sql.Context) {
+	e.statementBegun = true
+	e.forEachEditor(func(editor sql.EditOpenerCloser) error {
+		editor.StatementBegin(ctx)
+		return nil
+	})
+}
+
+// DiscardChanges implements the interface sql.EditOpenerCloser.
+func (e *partitionedTableEditor) DiscardChanges(ctx *sql.Context, errorEncountered error) error {
+	return e.forEachEditor(func(editor sql.EditOpenerCloser) error {
+		return editor.DiscardChanges(ctx, errorEncountered)
+	})
+}
+
+// StatementComplete implements the interface sql.EditOpenerCloser.
+func (e *partitionedTableEditor) StatementComplete(ctx *sql.Context) error {
+	return e.forEachEditor(func(editor sql.EditOpenerCloser) error {
+		return editor.StatementComplete(ctx)
+	})
+}
+
+// Insert implements the interface sql.RowInserter.
+func (e *partitionedTableEditor) Insert(ctx *sql.Context, row sql.Row) error {
+	idx, err := e.table.route(ctx, row)
+	if err != nil {
+		return err
+	}
+	inserter, err := e.inserter(ctx, idx)
+	if err != nil {
+		return err
+	}
+	return inserter.Insert(ctx, pgnodes.UnmapPartitionRow(row, e.table.partitions[idx].columnMap))
+}
+
+// Update implements the interface sql.RowUpdater.
+func (e *partitionedTableEditor) Update(ctx *sql.Context, old sql.Row, new sql.Row) error {
+	oldIdx, err := e.table.route(ctx, old)
+	if err != nil {
+		return err
+	}
+	newIdx, err := e.table.route(ctx, new)
+	if err != nil {
+		return err
+	}
+	columnMap := e.table.partitions[newIdx].columnMap
+	if oldIdx == newIdx {
+		updater, err := e.updater(ctx, newIdx)
+		if err != nil {
+			return err
+		}
+		return updater.Update(ctx, pgnodes.UnmapPartitionRow(old, columnMap), pgnodes.UnmapPartitionRow(new, columnMap))
+	}
+	// The row no longer belongs to its partition, so it's moved to the partition that now holds it
+	deleter, err := e.deleter(ctx, oldIdx)
+	if err != nil {
+		return err
+	}
+	if err = deleter.Delete(ctx, pgnodes.UnmapPartitionRow(old, e.table.partitions[oldIdx].columnMap)); err != nil {
+		return err
+	}
+	inserter, err := e.inserter(ctx, newIdx)
+	if err != nil {
+		return err
+	}
+	return inserter.Insert(ctx, pgnodes.UnmapPartitionRow(new, columnMap))
+}
+
+// Delete implements the interface sql.RowDeleter.
+func (e *partitionedTableEditor) Delete(ctx *sql.Context, row sql.Row) error {
+	idx, err := e.table.route(ctx, row)
+	if err != nil {
+		return err
+	}
+	deleter, err := e.deleter(ctx, idx)
+	if err != nil {
+		return err
+	}
+	return deleter.Delete(ctx, pgnodes.UnmapPartitionRow(row, e.table.partitions[idx].columnMap))
+}
+
+// Close implements the interface sql.Closer.
+func (e *partitionedTableEditor) Close(ctx *sql.Context) error {
+	return e.forEachEditor(func(editor sql.EditOpenerCloser) error {
+		return editor.(sql.Closer).Close(ctx)
+	})
+}
+
+// inserter returns the inserter of the partition at the given index, opening it if needed.
+func (e *partitionedTableEditor) inserter(ctx *sql.Context, idx int) (sql.RowInserter, error) {
+	editors := e.editors[idx]
+	if editors.inserter == nil {
+		insertable, ok := e.table.partitions[idx].table.(sql.InsertableTable)
+		if !ok {
+			return nil, fmt.Errorf(`cannot insert into partition "%s"`, e.table.partitions[idx].table.Name())
+		}
+		editors.inserter = insertable.Inserter(ctx)
+		e.beginStatement(ctx, editors.inserter)
+	}
+	return editors.inserter, nil
+}
+
+// updater returns the updater of the partition at the given index, opening it if needed.
+func (e *partitionedTableEditor) updater(ctx *sql.Context, idx int) (sql.RowUpdater, error) {
+	editors := e.editors[idx]
+	if editors.updater == nil {
+		updatable, ok := e.table.partitions[idx].table.(sql.UpdatableTable)
+		if !ok {
+			return nil, fmt.Errorf(`cannot update partition "%s"`, e.table.partitions[idx].table.Name())
+		}
+		editors.updater = updatable.Updater(ctx)
+		e.beginStatement(ctx, editors.updater)
+	}
+	return editors.updater, nil
+}
+
+// deleter returns the deleter of the partition at the given index, opening it if needed.
+func (e *partitionedTableEditor) deleter(ctx *sql.Context, idx int) (sql.RowDeleter, error) {
+	editors := e.editors[idx]
+	if editors.deleter == nil {
+		deletable, ok := e.table.partitions[idx].table.(sql.DeletableTable)
+		if !ok {
+			return nil, fmt.Errorf(`cannot delete from partition "%s"`, e.table.partitions[idx].table.Name())
+		}
+		editors.deleter = deletable.Deleter(ctx)
+		e.beginStatement(ctx, editors.deleter)
+	}
+	return editors.deleter, nil
+}
+
+// beginStatement begins the statement on an editor that was opened after the statement had already begun.
+func (e *partitionedTableEditor) beginStatement(ctx *sql.Context, editor sql.EditOpenerCloser) {
+	if e.statementBegun {
+		editor.StatementBegin(ctx)
+	}
+}
+
+// forEachEditor calls the given function on every editor that has been opened, returning the first error encountered.
+// The function is still called on the remaining editors after an error, so that every editor is finalized.
+func (e *partitionedTableEditor) forEachEditor(f func(editor sql.EditOpenerCloser) error) error {
+	var firstErr error
+	for _, editors := range e.editors {
+		for _, editor := range []sql.EditOpenerCloser{editors.inserter, editors.updater, editors.deleter} {
+			if editor == nil {
+				continue
+			}
+			if err := f(editor); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
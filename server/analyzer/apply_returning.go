@@ -0,0 +1,98 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/planbuilder"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// ApplyReturning handles the SELECT that an INSERT, UPDATE, or DELETE with a RETURNING clause was converted to. The
+// statement is carried by the condition of the SELECT, which is replaced by reading the rows that the statement writes
+// from the table. This must run before any filters are moved or pushed into the tables.
+func ApplyReturning(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	return transform.Node(node, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		filter, ok := node.(*plan.Filter)
+		if !ok {
+			return node, transform.SameTree, nil
+		}
+		modification, ok := filter.Expression.(*pgexprs.DataModification)
+		if !ok {
+			return node, transform.SameTree, nil
+		}
+		modificationNode, err := buildDataModification(ctx, a, modification)
+		if err != nil {
+			return nil, transform.NewTree, err
+		}
+		// The table may be aliased, so we replace the table wherever it is beneath the filter
+		newChild, _, err := transform.Node(filter.Child, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+			rt, ok := node.(*plan.ResolvedTable)
+			if !ok {
+				return node, transform.SameTree, nil
+			}
+			newRt, err := rt.ReplaceTable(pgnodes.NewReturningTable(rt.Table, modificationNode))
+			if err != nil {
+				return nil, transform.NewTree, err
+			}
+			return newRt, transform.NewTree, nil
+		})
+		if err != nil {
+			return nil, transform.NewTree, err
+		}
+		return newChild, transform.NewTree, nil
+	})
+}
+
+// buildDataModification builds and analyzes the statement of the given DataModification. The accumulator that counts
+// the written rows is removed, so that the statement returns the rows themselves.
+func buildDataModification(ctx *sql.Context, a *analyzer.Analyzer, modification *pgexprs.DataModification) (sql.Node, error) {
+	node, err := planbuilder.New(ctx, a.Catalog, sql.GlobalParser).BindOnly(modification.Statement(), modification.Query())
+	if err != nil {
+		return nil, err
+	}
+	if bindings := modification.Bindings(); len(bindings) > 0 {
+		if node, _, err = plan.ApplyBindings(node, bindings); err != nil {
+			return nil, err
+		}
+	}
+	node, err = a.Analyze(ctx, node, nil)
+	if err != nil {
+		return nil, err
+	}
+	// The outer statement handles the transaction, the process tracking, and the finalization of the context, so we
+	// remove them from this one
+	if qp, ok := node.(*plan.QueryProcess); ok {
+		node = qp.Child()
+	}
+	if tc, ok := node.(*plan.TransactionCommittingNode); ok {
+		node = tc.Child()
+	}
+	if finalizer, ok := node.(*pgnodes.ContextRootFinalizer); ok {
+		node = finalizer.Child()
+	}
+	node, _, err = transform.Node(node, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		if accumulator, ok := node.(*plan.RowUpdateAccumulator); ok {
+			return accumulator.Child(), transform.NewTree, nil
+		}
+		return node, transform.SameTree, nil
+	})
+	return node, err
+}
@@ -0,0 +1,169 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	"github.com/dolthub/doltgresql/server/locks"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// ApplyRowLocking replaces the locking clauses of a SELECT statement, which are given as the last field of a sort, with
+// a LockRows node above the sort. This locks each row as it's read, after it has been filtered and sorted, and before
+// any limit is applied, so that rows that are skipped with SKIP LOCKED do not count towards the limit.
+func ApplyRowLocking(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	return transform.Node(node, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		sort, ok := node.(*plan.Sort)
+		if !ok || len(sort.SortFields) == 0 {
+			return node, transform.SameTree, nil
+		}
+		rowLocking, ok := sort.SortFields[len(sort.SortFields)-1].Column.(*pgexprs.RowLocking)
+		if !ok {
+			return node, transform.SameTree, nil
+		}
+		targets, err := rowLockTargets(ctx, rowLocking, sort.Child)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		child := sort.Child
+		// The sort is only needed when there was an ORDER BY clause
+		if sortFields := sort.SortFields[:len(sort.SortFields)-1]; len(sortFields) > 0 {
+			child = plan.NewSort(sortFields, sort.Child)
+		}
+		return pgnodes.NewLockRows(child, targets), transform.NewTree, nil
+	})
+}
+
+// lockableTable is a table in the FROM clause whose rows may be locked.
+type lockableTable struct {
+	name string
+	rt   *plan.ResolvedTable
+	id   sql.TableId
+	cols sql.ColSet
+}
+
+// rowLockTargets returns the tables whose rows are locked by the locking clauses, along with the expressions that
+// identify their rows. A table that is named by multiple clauses uses the strongest mode and wait policy among them.
+func rowLockTargets(ctx *sql.Context, rowLocking *pgexprs.RowLocking, node sql.Node) ([]pgnodes.RowLockTarget, error) {
+	clause := rowLocking.Clauses[0].Mode.String()
+	var tables []lockableTable
+	if err := findLockableTables(node, clause, &tables); err != nil {
+		return nil, err
+	}
+	targets := make([]*pgnodes.RowLockTarget, len(tables))
+	lockTable := func(i int, lockingClause pgexprs.RowLockingClause) {
+		if targets[i] == nil {
+			targets[i] = &pgnodes.RowLockTarget{Mode: lockingClause.Mode, Policy: lockingClause.Policy}
+		} else {
+			targets[i].Mode = max(targets[i].Mode, lockingClause.Mode)
+			targets[i].Policy = max(targets[i].Policy, lockingClause.Policy)
+		}
+	}
+	for _, lockingClause := range rowLocking.Clauses {
+		if len(lockingClause.Targets) == 0 {
+			for i := range tables {
+				lockTable(i, lockingClause)
+			}
+			continue
+		}
+	TargetLoop:
+		for _, target := range lockingClause.Targets {
+			for i, table := range tables {
+				if table.name == target {
+					lockTable(i, lockingClause)
+					continue TargetLoop
+				}
+			}
+			return nil, pgerrors.Newf(pgcode.UndefinedTable, `relation "%s" in %s clause not found in FROM clause`,
+				target, lockingClause.Mode.String())
+		}
+	}
+	var lockTargets []pgnodes.RowLockTarget
+	for i, table := range tables {
+		if targets[i] == nil {
+			continue
+		}
+		// The rows of the system tables are not locked
+		obj, ok, err := tableObject(ctx, table.rt)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		target := *targets[i]
+		target.Relation = locks.Relation{Database: obj.Database, Schema: obj.Schema, Name: obj.Name}
+		target.Key = rowKey(table)
+		lockTargets = append(lockTargets, target)
+	}
+	return lockTargets, nil
+}
+
+// findLockableTables finds the tables of the FROM clause beneath the node. The rows of subqueries are not locked, and
+// the clause may not lock the rows of aggregates or window functions, as they're not read from a single row.
+func findLockableTables(node sql.Node, clause string, tables *[]lockableTable) error {
+	switch node := node.(type) {
+	case *plan.GroupBy, *plan.TableCountLookup:
+		return pgerrors.Newf(pgcode.FeatureNotSupported, "%s is not allowed with aggregate functions", clause)
+	case *plan.Window:
+		return pgerrors.Newf(pgcode.FeatureNotSupported, "%s is not allowed with window functions", clause)
+	case *plan.SubqueryAlias:
+		return nil
+	case *plan.TableAlias:
+		if rt, ok := node.Child.(*plan.ResolvedTable); ok {
+			*tables = append(*tables, lockableTable{name: node.Name(), rt: rt, id: node.Id(), cols: node.Columns()})
+		}
+		return nil
+	case *plan.ResolvedTable:
+		*tables = append(*tables, lockableTable{name: node.Name(), rt: node, id: node.Id(), cols: node.Columns()})
+		return nil
+	}
+	for _, child := range node.Children() {
+		if err := findLockableTables(child, clause, tables); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rowKey returns the expressions that identify a row of the table, which are its primary key columns, or all of its
+// columns when it does not have a primary key.
+func rowKey(table lockableTable) []sql.Expression {
+	sch := table.rt.Schema()
+	hasPrimaryKey := false
+	for _, col := range sch {
+		hasPrimaryKey = hasPrimaryKey || col.PrimaryKey
+	}
+	var key []sql.Expression
+	i := 0
+	for colId, ok := table.cols.Next(1); ok && i < len(sch); colId, ok = table.cols.Next(colId + 1) {
+		col := sch[i]
+		i++
+		if hasPrimaryKey && !col.PrimaryKey {
+			continue
+		}
+		key = append(key, expression.NewGetFieldWithTable(int(colId), int(table.id), col.Type, col.DatabaseSource,
+			table.name, col.Name, col.Nullable))
+	}
+	return key
+}
@@ -0,0 +1,170 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/pgstat"
+)
+
+// ApplyStatisticsCounters counts the rows that each statement reads from and writes to the tables, which are reported
+// by the statistics views such as pg_stat_user_tables. Scans of tables and indexes are wrapped by a node that counts
+// the rows that they read, and the rows written by INSERT, UPDATE, and DELETE statements are counted in the same way.
+// This must run once the plan is otherwise complete, so that no other rule sees the counting nodes.
+func ApplyStatisticsCounters(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	return applyStatisticsCounters(ctx, node)
+}
+
+// applyStatisticsCounters adds the counting nodes to the given node, along with the subqueries of its expressions.
+func applyStatisticsCounters(ctx *sql.Context, node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+	newNode, sameNode, err := transform.NodeWithCtx(node, nil, func(c transform.Context) (sql.Node, transform.TreeIdentity, error) {
+		switch n := c.Node.(type) {
+		case *plan.ResolvedTable, *plan.IndexedTableAccess, *plan.TableAlias:
+			if !countsScans(c.Parent) {
+				return n, transform.SameTree, nil
+			}
+			return countScan(ctx, n)
+		case *plan.InsertInto:
+			// The source is not a child of the insert, so it's handled separately
+			source, sameSource, err := applyStatisticsCounters(ctx, n.Source)
+			if err != nil {
+				return nil, transform.NewTree, err
+			}
+			if rel, ok := modifiedRelation(ctx, n.Destination); ok && !isStatisticsCounter(source, pgnodes.StatisticsCounterKind_Insert) {
+				source, sameSource = pgnodes.NewStatisticsCounter(source, pgnodes.StatisticsCounterKind_Insert, rel, ""), transform.NewTree
+			}
+			if sameSource {
+				return n, transform.SameTree, nil
+			}
+			return n.WithSource(source), transform.NewTree, nil
+		case *plan.Update:
+			// The rows of an UPDATE with a FROM clause are joined rows, which may update multiple tables
+			rel, ok := modifiedRelation(ctx, n.Child)
+			if !ok || n.IsJoin || isStatisticsCounter(n.Child, pgnodes.StatisticsCounterKind_Update) {
+				return n, transform.SameTree, nil
+			}
+			newUpdate, err := n.WithChildren(pgnodes.NewStatisticsCounter(n.Child, pgnodes.StatisticsCounterKind_Update, rel, ""))
+			return newUpdate, transform.NewTree, err
+		case *plan.DeleteFrom:
+			rel, ok := modifiedRelation(ctx, n.Child)
+			if !ok || n.HasExplicitTargets() || isStatisticsCounter(n.Child, pgnodes.StatisticsCounterKind_Delete) {
+				return n, transform.SameTree, nil
+			}
+			newDelete, err := n.WithChildren(pgnodes.NewStatisticsCounter(n.Child, pgnodes.StatisticsCounterKind_Delete, rel, ""))
+			return newDelete, transform.NewTree, err
+		default:
+			return n, transform.SameTree, nil
+		}
+	})
+	if err != nil {
+		return nil, transform.NewTree, err
+	}
+	// Subqueries have already been finalized, so they're not visited by the rule on their own
+	newNode, sameExprs, err := transform.NodeExprs(newNode, func(expr sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		subquery, ok := expr.(*plan.Subquery)
+		if !ok {
+			return expr, transform.SameTree, nil
+		}
+		query, same, err := applyStatisticsCounters(ctx, subquery.Query)
+		if err != nil || same {
+			return expr, transform.SameTree, err
+		}
+		return subquery.WithQuery(query), transform.NewTree, nil
+	})
+	if err != nil {
+		return nil, transform.NewTree, err
+	}
+	return newNode, sameNode && sameExprs, nil
+}
+
+// countsScans returns whether the scans beneath the given parent are counted. Only relational nodes that read the rows
+// of their children are included, as many statements, such as those that alter a table, expect their table to be a
+// direct child.
+func countsScans(parent sql.Node) bool {
+	switch parent.(type) {
+	case nil, *plan.Filter, *plan.Project, *plan.JoinNode, *plan.Sort, *plan.TopN, *plan.Limit, *plan.Offset,
+		*plan.GroupBy, *plan.Having, *plan.Distinct, *plan.OrderedDistinct, *plan.Window, *plan.SubqueryAlias,
+		*plan.SetOp, *plan.HashLookup, *plan.CachedResults, *plan.Max1Row, *plan.QueryProcess, *plan.UpdateSource,
+		*plan.DeleteFrom, *pgnodes.LockRows:
+		return true
+	default:
+		return false
+	}
+}
+
+// isStatisticsCounter returns whether the node already counts the given activity, which is the case when a prepared
+// statement's plan is analyzed again.
+func isStatisticsCounter(node sql.Node, kind pgnodes.StatisticsCounterKind) bool {
+	counter, ok := node.(*pgnodes.StatisticsCounter)
+	return ok && counter.Kind() == kind
+}
+
+// countScan wraps the given table scan in a node that counts the rows that it reads. Scans of tables that are not
+// user tables, such as the system tables, are not counted.
+func countScan(ctx *sql.Context, node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+	scan := node
+	if alias, ok := node.(*plan.TableAlias); ok {
+		scan = alias.Child
+	}
+	var rt *plan.ResolvedTable
+	kind := pgnodes.StatisticsCounterKind_SeqScan
+	index := ""
+	switch scan := scan.(type) {
+	case *plan.ResolvedTable:
+		rt = scan
+	case *plan.IndexedTableAccess:
+		rt, _ = scan.TableNode.(*plan.ResolvedTable)
+		if rt != nil {
+			kind = pgnodes.StatisticsCounterKind_IndexScan
+			index = scan.Index().ID()
+			if strings.EqualFold(index, "PRIMARY") {
+				index = rt.Name() + "_pkey"
+			}
+		}
+	}
+	if rt == nil {
+		return node, transform.SameTree, nil
+	}
+	if _, ok := rt.Table.(*pgnodes.ReturningTable); ok {
+		return node, transform.SameTree, nil
+	}
+	obj, ok, err := tableObject(ctx, rt)
+	if err != nil || !ok {
+		return node, transform.SameTree, err
+	}
+	rel := pgstat.Relation{Database: obj.Database, Schema: obj.Schema, Name: obj.Name}
+	return pgnodes.NewStatisticsCounter(node, kind, rel, index), transform.NewTree, nil
+}
+
+// modifiedRelation returns the table that is written to by the INSERT, UPDATE, or DELETE with the given table node.
+// Returns false when the table is not a user table.
+func modifiedRelation(ctx *sql.Context, tableNode sql.Node) (pgstat.Relation, bool) {
+	rt, _, ok := triggerTable(tableNode)
+	if !ok {
+		return pgstat.Relation{}, false
+	}
+	obj, ok, err := tableObject(ctx, rt)
+	if err != nil || !ok {
+		return pgstat.Relation{}, false
+	}
+	return pgstat.Relation{Database: obj.Database, Schema: obj.Schema, Name: obj.Name}, true
+}
@@ -0,0 +1,215 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/triggers"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// ApplyTriggers adds the triggers that fire for the rows written by INSERT, UPDATE, and DELETE statements. BEFORE
+// triggers fire as each row is about to be written, while AFTER triggers fire once the statement has written every row.
+// This must run after the row update accumulators have been added, as the AFTER triggers wrap them.
+func ApplyTriggers(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	return transform.Node(node, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		accumulator, ok := node.(*plan.RowUpdateAccumulator)
+		if !ok {
+			return node, transform.SameTree, nil
+		}
+		return applyTriggersToAccumulator(ctx, a, accumulator)
+	})
+}
+
+// applyTriggersToAccumulator adds the triggers for the statement beneath the given accumulator.
+func applyTriggersToAccumulator(ctx *sql.Context, a *analyzer.Analyzer, accumulator *plan.RowUpdateAccumulator) (sql.Node, transform.TreeIdentity, error) {
+	var event triggers.Event
+	var tableNode sql.Node
+	var rowSource sql.Node
+	var updatedColumns []string
+	switch child := accumulator.Child().(type) {
+	case *plan.InsertInto:
+		event = triggers.Event_Insert
+		tableNode = child.Destination
+		rowSource = child.Source
+	case *plan.Update:
+		event = triggers.Event_Update
+		tableNode = child.Child
+		rowSource = child.Child
+		updatedColumns = updateSourceColumns(child.Child)
+	case *plan.DeleteFrom:
+		event = triggers.Event_Delete
+		tableNode = child.Child
+		rowSource = child.Child
+	default:
+		return accumulator, transform.SameTree, nil
+	}
+	table, columns, ok := triggerTable(tableNode)
+	if !ok {
+		return accumulator, transform.SameTree, nil
+	}
+	tableTriggers, tableName, err := core.GetTriggersForTable(ctx, table.Database().Name(), doltdb.TableName{Name: table.Name(), Schema: tableSchema(table)})
+	if err != nil {
+		return nil, transform.NewTree, err
+	}
+	var beforeTriggers, afterTriggers []pgnodes.RowTrigger
+	for _, trigger := range tableTriggers {
+		if !trigger.FiresFor(event, updatedColumns) {
+			continue
+		}
+		rowTrigger, err := resolveRowTrigger(ctx, trigger)
+		if err != nil {
+			return nil, transform.NewTree, err
+		}
+		if trigger.Timing == triggers.Timing_Before {
+			beforeTriggers = append(beforeTriggers, rowTrigger)
+		} else {
+			afterTriggers = append(afterTriggers, rowTrigger)
+		}
+	}
+	if len(beforeTriggers) == 0 && len(afterTriggers) == 0 {
+		return accumulator, transform.SameTree, nil
+	}
+	switch child := accumulator.Child().(type) {
+	case *plan.InsertInto:
+		if child.Ignore || len(child.OnDupExprs) > 0 || child.IsReplace {
+			return nil, transform.NewTree, pgerrors.New(pgcode.FeatureNotSupported, "triggers are not yet supported for INSERT with ON CONFLICT")
+		}
+	case *plan.Update:
+		if accumulator.RowUpdateType != plan.UpdateTypeUpdate {
+			return nil, transform.NewTree, pgerrors.New(pgcode.FeatureNotSupported, "triggers are not yet supported for UPDATE with FROM")
+		}
+	case *plan.DeleteFrom:
+		if child.HasExplicitTargets() {
+			return nil, transform.NewTree, pgerrors.New(pgcode.FeatureNotSupported, "triggers are not yet supported for DELETE with USING")
+		}
+	}
+
+	runner := NewStatementRunner(a)
+	target := pgnodes.TriggerTarget{Table: tableName, Columns: columns, Operation: event}
+	var written *pgnodes.WrittenRows
+	if len(afterTriggers) > 0 {
+		written = pgnodes.NewWrittenRows()
+	}
+	newSource := pgnodes.NewBeforeTriggers(rowSource, target, beforeTriggers, written, runner)
+	var newChild sql.Node
+	switch child := accumulator.Child().(type) {
+	case *plan.InsertInto:
+		newChild = child.WithSource(newSource)
+	default:
+		newChild, err = child.WithChildren(newSource)
+		if err != nil {
+			return nil, transform.NewTree, err
+		}
+	}
+	newNode, err := accumulator.WithChildren(newChild)
+	if err != nil {
+		return nil, transform.NewTree, err
+	}
+	if len(afterTriggers) > 0 {
+		newNode = pgnodes.NewAfterTriggers(newNode, target, afterTriggers, written, runner)
+	}
+	// Trigger functions may write to other tables, so those writes are rolled back when the statement fails. Statements
+	// that are run by functions are rolled back by the statement that called the function, and savepoints cannot be
+	// nested as they share the same name.
+	if _, ok := ctx.Session.(sql.TransactionSession); ok && !pgnodes.IsWithinFunctionCall(ctx) {
+		newNode = plan.NewTriggerRollback(newNode)
+	}
+	return newNode, transform.NewTree, nil
+}
+
+// resolveRowTrigger loads the function that the given trigger calls.
+func resolveRowTrigger(ctx *sql.Context, trigger *triggers.Trigger) (pgnodes.RowTrigger, error) {
+	collection, err := core.GetFunctionsCollectionFromContext(ctx)
+	if err != nil {
+		return pgnodes.RowTrigger{}, err
+	}
+	function := collection.GetFunction(trigger.Function)
+	if function == nil {
+		return pgnodes.RowTrigger{}, pgerrors.Newf(pgcode.UndefinedFunction, "function %s() does not exist", trigger.Function.Name)
+	}
+	block, err := userFunctionBlock(function)
+	if err != nil {
+		return pgnodes.RowTrigger{}, err
+	}
+	if block == nil {
+		return pgnodes.RowTrigger{}, pgerrors.Newf(pgcode.FeatureNotSupported,
+			`trigger functions cannot be written in %s`, function.Language)
+	}
+	return pgnodes.RowTrigger{Trigger: trigger, Function: function, Block: block}, nil
+}
+
+// triggerTable returns the table that is written to by the given node, along with the schema of the table's rows.
+// Returns false if the node does not write to a table that belongs to a Doltgres database, as only those tables may have
+// triggers.
+func triggerTable(node sql.Node) (*plan.ResolvedTable, sql.Schema, bool) {
+	// The table may be wrapped by other nodes, such as InsertDestination and ForeignKeyHandler
+	var rt *plan.ResolvedTable
+	transform.Inspect(node, func(node sql.Node) bool {
+		if rt != nil {
+			return false
+		}
+		switch node := node.(type) {
+		case *plan.ResolvedTable:
+			rt = node
+		case *plan.IndexedTableAccess:
+			rt, _ = node.TableNode.(*plan.ResolvedTable)
+		}
+		return rt == nil
+	})
+	if rt == nil {
+		return nil, nil, false
+	}
+	if _, ok := rt.UnwrappedDatabase().(interface{ Schema() string }); !ok {
+		return nil, nil, false
+	}
+	return rt, rt.Schema(), true
+}
+
+// tableSchema returns the schema that the given table belongs to. This is empty when the table was not qualified by a
+// schema, in which case the schema is resolved using the search path.
+func tableSchema(table *plan.ResolvedTable) string {
+	db, _ := table.UnwrappedDatabase().(interface{ Schema() string })
+	return db.Schema()
+}
+
+// updateSourceColumns returns the names of the columns that are set by the UPDATE with the given child.
+func updateSourceColumns(node sql.Node) []string {
+	var columns []string
+	transform.Inspect(node, func(node sql.Node) bool {
+		updateSource, ok := node.(*plan.UpdateSource)
+		if !ok {
+			return true
+		}
+		for _, updateExpr := range updateSource.UpdateExprs {
+			if setField, ok := updateExpr.(*expression.SetField); ok {
+				if field, ok := setField.LeftChild.(*expression.GetField); ok {
+					columns = append(columns, field.Name())
+				}
+			}
+		}
+		return false
+	})
+	return columns
+}
@@ -0,0 +1,79 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// AssignForeignKeyParentColumns assigns the referenced columns of foreign keys that did not list any, such as
+// `REFERENCES parent`. Postgres uses the primary key of the referenced table in such cases.
+func AssignForeignKeyParentColumns(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	return transform.Node(node, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		var fkDefs []*sql.ForeignKeyConstraint
+		var createTable *plan.CreateTable
+		switch node := node.(type) {
+		case *plan.CreateTable:
+			fkDefs = node.ForeignKeys()
+			createTable = node
+		case *plan.CreateForeignKey:
+			fkDefs = []*sql.ForeignKeyConstraint{node.FkDef}
+		default:
+			return node, transform.SameTree, nil
+		}
+		same := transform.SameTree
+		for _, fkDef := range fkDefs {
+			if len(fkDef.ParentColumns) > 0 {
+				continue
+			}
+			var pkSchema sql.PrimaryKeySchema
+			if createTable != nil && fkDef.IsSelfReferential() {
+				// The table does not exist yet, so we use the primary key that it's being created with
+				pkSchema = createTable.PkSchema()
+			} else {
+				parentTable, _, err := a.Catalog.Table(ctx, fkDef.ParentDatabase, fkDef.ParentTable)
+				if err != nil {
+					return nil, transform.NewTree, err
+				}
+				pkTable, ok := parentTable.(sql.PrimaryKeyTable)
+				if !ok {
+					return nil, transform.NewTree, missingPrimaryKeyError(fkDef.ParentTable)
+				}
+				pkSchema = pkTable.PrimaryKeySchema()
+			}
+			if len(pkSchema.PkOrdinals) == 0 {
+				return nil, transform.NewTree, missingPrimaryKeyError(fkDef.ParentTable)
+			}
+			// The definitions are only referenced by the node that we're analyzing, so they're modified in place
+			fkDef.ParentColumns = make([]string, len(pkSchema.PkOrdinals))
+			for i, ordinal := range pkSchema.PkOrdinals {
+				fkDef.ParentColumns[i] = pkSchema.Schema[ordinal].Name
+			}
+			same = transform.NewTree
+		}
+		return node, same, nil
+	})
+}
+
+// missingPrimaryKeyError returns the error for a foreign key that implicitly references a table without a primary key.
+func missingPrimaryKeyError(tableName string) error {
+	return pgerrors.Newf(pgcode.UndefinedObject, `there is no primary key for referenced table "%s"`, tableName)
+}
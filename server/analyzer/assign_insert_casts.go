@@ -72,7 +72,7 @@ func AssignInsertCasts(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, sc
 				}
 			}
 		}
-		return insertInto.WithSource(plan.NewValues(newValues)), transform.NewTree, nil
+		insertInto = insertInto.WithSource(plan.NewValues(newValues))
 	} else {
 		sourceSchema := insertInto.Source.Schema()
 		projections := make([]sql.Expression, len(sourceSchema))
@@ -90,6 +90,46 @@ func AssignInsertCasts(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, sc
 				projections[i] = pgexprs.NewAssignmentCast(getField, fromColType, toColType)
 			}
 		}
-		return insertInto.WithSource(plan.NewProject(projections, insertInto.Source)), transform.NewTree, nil
+		insertInto = insertInto.WithSource(plan.NewProject(projections, insertInto.Source))
 	}
+	if len(insertInto.OnDupExprs) > 0 {
+		newOnDupExprs, err := assignOnDupCasts(insertInto.OnDupExprs)
+		if err != nil {
+			return nil, transform.NewTree, err
+		}
+		newInsertInto := *insertInto
+		newInsertInto.OnDupExprs = newOnDupExprs
+		insertInto = &newInsertInto
+	}
+	return insertInto, transform.NewTree, nil
+}
+
+// assignOnDupCasts adds the appropriate assign casts for the assignments of an INSERT ... ON CONFLICT DO UPDATE.
+func assignOnDupCasts(onDupExprs []sql.Expression) ([]sql.Expression, error) {
+	newOnDupExprs := make([]sql.Expression, len(onDupExprs))
+	for i, onDupExpr := range onDupExprs {
+		setField, ok := onDupExpr.(*expression.SetField)
+		if !ok {
+			return nil, fmt.Errorf("INSERT: assumption that ON CONFLICT expression is always SetField is incorrect: %T", onDupExpr)
+		}
+		fromType, ok := setField.RightChild.Type().(pgtypes.DoltgresType)
+		if !ok {
+			return nil, fmt.Errorf("INSERT: non-Doltgres type found in ON CONFLICT source: %s", setField.RightChild.String())
+		}
+		toType, ok := setField.LeftChild.Type().(pgtypes.DoltgresType)
+		if !ok {
+			return nil, fmt.Errorf("INSERT: non-Doltgres type found in ON CONFLICT destination: %s", setField.LeftChild.String())
+		}
+		// We only assign the existing expression if the types perfectly match (same parameters), otherwise we'll cast
+		if fromType.Equals(toType) {
+			newOnDupExprs[i] = setField
+		} else {
+			newSetField, err := setField.WithChildren(setField.LeftChild, pgexprs.NewAssignmentCast(setField.RightChild, fromType, toType))
+			if err != nil {
+				return nil, err
+			}
+			newOnDupExprs[i] = newSetField
+		}
+	}
+	return newOnDupExprs, nil
 }
@@ -0,0 +1,295 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/postgres/parser/privilege"
+	"github.com/dolthub/doltgresql/server/auth"
+)
+
+// CheckPrivileges returns an error when the current role lacks a privilege that the statement requires. Tables that
+// are read require SELECT, tables that are written require the privilege matching the write, and most DDL requires
+// ownership of the objects that it modifies. Superusers, and sessions without a user, are not checked. Views are
+// expanded into the tables that they read, so reading a view requires SELECT on those tables. Doltgres's own nodes
+// check their privileges within RowIter instead, as most of them must first find the objects that they modify.
+func CheckPrivileges(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	roleName := ctx.Client().User
+	if len(roleName) == 0 {
+		return node, transform.SameTree, nil
+	}
+	role, ok := auth.GetRole(roleName)
+	if !ok {
+		role = auth.NewRole(roleName)
+	}
+	if role.IsSuperUser {
+		return node, transform.SameTree, nil
+	}
+	checker := privilegeChecker{ctx: ctx, role: role}
+	if err := checker.check(node); err != nil {
+		return nil, transform.SameTree, err
+	}
+	return node, transform.SameTree, nil
+}
+
+// privilegeChecker checks the privileges of a role against the nodes of a statement.
+type privilegeChecker struct {
+	ctx  *sql.Context
+	role auth.Role
+}
+
+// check returns an error if the role lacks any privilege that the node or its descendants require.
+func (c privilegeChecker) check(node sql.Node) error {
+	var err error
+	transform.Inspect(node, func(node sql.Node) bool {
+		if err != nil {
+			return false
+		}
+		var descend bool
+		descend, err = c.checkNode(node)
+		if err == nil {
+			err = c.checkSubqueries(node)
+		}
+		return descend && err == nil
+	})
+	return err
+}
+
+// checkNode checks the privileges required by the node itself, returning whether its children should be checked.
+func (c privilegeChecker) checkNode(node sql.Node) (bool, error) {
+	switch node := node.(type) {
+	case *plan.ResolvedTable:
+		return false, c.checkTable(node, privilege.SELECT)
+	case *plan.InsertInto:
+		kinds := []privilege.Kind{privilege.INSERT}
+		if len(node.OnDupExprs) > 0 {
+			kinds = append(kinds, privilege.UPDATE)
+		}
+		if err := c.checkTarget(node.Destination, kinds...); err != nil {
+			return false, err
+		}
+		return false, c.check(node.Source)
+	case *plan.Update:
+		return false, c.checkTargetAndSources(node.Child, privilege.UPDATE)
+	case *plan.DeleteFrom:
+		return false, c.checkTargetAndSources(node.Child, privilege.DELETE)
+	case *plan.Truncate:
+		return false, c.checkTarget(node.Child, privilege.TRUNCATE)
+	case *plan.CreateTable:
+		return true, c.checkSchemaPrivilege(node.Database(), privilege.CREATE)
+	case *plan.CreateView:
+		return true, c.checkSchemaPrivilege(node.Database(), privilege.CREATE)
+	case *plan.CreateSchema:
+		obj := auth.DatabaseObject(c.ctx.GetCurrentDatabase())
+		if !auth.HasPrivilege(c.role.Name, obj, privilege.CREATE) {
+			return false, auth.PermissionDeniedError(obj)
+		}
+		return false, nil
+	case *plan.SingleDropView:
+		schemaName, ok, err := creationSchema(c.ctx, node.Database())
+		if err != nil || !ok {
+			return false, err
+		}
+		obj := auth.TableObject(node.Database().Name(), schemaName, node.ViewName)
+		if !auth.IsOwner(c.role.Name, obj) {
+			return false, auth.NotOwnerError(obj)
+		}
+		return false, nil
+	default:
+		if plan.IsDDLNode(node) {
+			return false, c.checkOwnsTables(node)
+		}
+		return true, nil
+	}
+}
+
+// checkSubqueries checks the privileges required by any subqueries within the expressions of the node.
+func (c privilegeChecker) checkSubqueries(node sql.Node) error {
+	expressioner, ok := node.(sql.Expressioner)
+	if !ok {
+		return nil
+	}
+	var err error
+	for _, expr := range expressioner.Expressions() {
+		transform.InspectExpr(expr, func(expr sql.Expression) bool {
+			if subquery, ok := expr.(*plan.Subquery); ok && err == nil {
+				err = c.check(subquery.Query)
+			}
+			return err != nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkTarget checks the given privileges on the table that is written by the node.
+func (c privilegeChecker) checkTarget(node sql.Node, kinds ...privilege.Kind) error {
+	rt := firstResolvedTable(node)
+	if rt == nil {
+		return nil
+	}
+	return c.checkTable(rt, kinds...)
+}
+
+// checkTargetAndSources checks the given privilege on the table that is written by the node, and SELECT on every other
+// table that the node reads.
+func (c privilegeChecker) checkTargetAndSources(node sql.Node, kind privilege.Kind) error {
+	target := firstResolvedTable(node)
+	var err error
+	transform.Inspect(node, func(node sql.Node) bool {
+		if err != nil {
+			return false
+		}
+		if node == target {
+			err = c.checkTable(target, kind)
+			return false
+		}
+		var descend bool
+		descend, err = c.checkNode(node)
+		if err == nil {
+			err = c.checkSubqueries(node)
+		}
+		return descend && err == nil
+	})
+	return err
+}
+
+// checkOwnsTables checks that the role owns every table that the node references.
+func (c privilegeChecker) checkOwnsTables(node sql.Node) error {
+	var err error
+	transform.Inspect(node, func(node sql.Node) bool {
+		if rt, ok := node.(*plan.ResolvedTable); ok && err == nil {
+			var obj auth.Object
+			if obj, ok, err = tableObject(c.ctx, rt); ok && !auth.IsOwner(c.role.Name, obj) {
+				err = auth.NotOwnerError(obj)
+			}
+		}
+		return err == nil
+	})
+	return err
+}
+
+// checkTable checks that the role holds USAGE on the table's schema, along with the given privileges on the table.
+func (c privilegeChecker) checkTable(rt *plan.ResolvedTable, kinds ...privilege.Kind) error {
+	obj, ok, err := tableObject(c.ctx, rt)
+	if err != nil || !ok {
+		return err
+	}
+	if schemaObj := auth.SchemaObject(obj.Database, obj.Schema); !auth.HasPrivilege(c.role.Name, schemaObj, privilege.USAGE) {
+		return auth.PermissionDeniedError(schemaObj)
+	}
+	for _, kind := range kinds {
+		if !auth.HasPrivilege(c.role.Name, obj, kind) {
+			return auth.PermissionDeniedError(obj)
+		}
+	}
+	return nil
+}
+
+// checkSchemaPrivilege checks that the role holds the privilege on the schema of the given database.
+func (c privilegeChecker) checkSchemaPrivilege(db sql.Database, kind privilege.Kind) error {
+	schemaName, ok, err := creationSchema(c.ctx, db)
+	if err != nil || !ok {
+		return err
+	}
+	obj := auth.SchemaObject(db.Name(), schemaName)
+	if !auth.HasPrivilege(c.role.Name, obj, kind) {
+		return auth.PermissionDeniedError(obj)
+	}
+	return nil
+}
+
+// firstResolvedTable returns the first table found within the node, or nil if there are none.
+func firstResolvedTable(node sql.Node) *plan.ResolvedTable {
+	var rt *plan.ResolvedTable
+	transform.Inspect(node, func(node sql.Node) bool {
+		if resolvedTable, ok := node.(*plan.ResolvedTable); ok && rt == nil {
+			rt = resolvedTable
+		}
+		return rt == nil
+	})
+	return rt
+}
+
+// tableObject returns the auth.Object for the table. Returns false for tables whose privileges are not checked, which
+// are the tables of the system schemas, Dolt's system tables, and tables that do not belong to a Doltgres database.
+func tableObject(ctx *sql.Context, rt *plan.ResolvedTable) (auth.Object, bool, error) {
+	if doltdb.HasDoltPrefix(rt.Name()) {
+		return auth.Object{}, false, nil
+	}
+	schemaName, ok := databaseSchema(rt.UnwrappedDatabase())
+	if !ok {
+		return auth.Object{}, false, nil
+	}
+	// Tables that were found using the search path belong to a database without a schema
+	if len(schemaName) == 0 {
+		tableName, ok, err := core.ResolveTableName(ctx, rt.Database().Name(), doltdb.TableName{Name: rt.Name()})
+		if err != nil || !ok {
+			return auth.Object{}, false, err
+		}
+		schemaName = tableName.Schema
+	}
+	if isSystemSchema(schemaName) {
+		return auth.Object{}, false, nil
+	}
+	return auth.TableObject(rt.Database().Name(), schemaName, rt.Name()), true, nil
+}
+
+// creationSchema returns the schema that objects are created in for the given database, which is the current schema
+// when the database does not have a schema. Returns false for the system schemas, and for databases that are not
+// Doltgres databases.
+func creationSchema(ctx *sql.Context, db sql.Database) (string, bool, error) {
+	schemaName, ok := databaseSchema(db)
+	if !ok {
+		return "", false, nil
+	}
+	if len(schemaName) == 0 {
+		var err error
+		if schemaName, err = core.GetCurrentSchema(ctx); err != nil {
+			return "", false, err
+		}
+	}
+	return schemaName, len(schemaName) > 0 && !isSystemSchema(schemaName), nil
+}
+
+// databaseSchema returns the schema of the given database, which is empty when the database is not limited to a
+// single schema. Returns false for databases that are not Doltgres databases.
+func databaseSchema(db sql.Database) (string, bool) {
+	for {
+		wrapper, ok := db.(interface{ Unwrap() sql.Database })
+		if !ok {
+			break
+		}
+		db = wrapper.Unwrap()
+	}
+	schemaDatabase, ok := db.(interface{ Schema() string })
+	if !ok {
+		return "", false
+	}
+	return schemaDatabase.Schema(), true
+}
+
+// isSystemSchema returns whether the schema is one of the system schemas, whose privileges are not checked.
+func isSystemSchema(schemaName string) bool {
+	return schemaName == "pg_catalog" || schemaName == "information_schema"
+}
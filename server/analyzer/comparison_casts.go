@@ -100,6 +100,7 @@ func ComparisonCasts(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scop
 
 // comparisonCasts handles casting either side of a comparison.
 func comparisonCasts(left sql.Expression, right sql.Expression) (sql.Expression, sql.Expression, bool, error) {
+	leftIsStringLiteral, rightIsStringLiteral := isStringLiteral(left), isStringLiteral(right)
 	leftType, ok := left.Type().(pgtypes.DoltgresType)
 	if !ok {
 		left = pgexprs.NewGMSCast(left)
@@ -121,5 +122,25 @@ func comparisonCasts(left sql.Expression, right sql.Expression) (sql.Expression,
 	if leftToRightCast != nil {
 		return pgexprs.NewImplicitCast(left, leftType, rightType), right, true, nil
 	}
+	// String literals have an unknown type in Postgres, so they take on the type of the other side of the comparison
+	if rightIsStringLiteral && framework.GetAssignmentCast(rightType.BaseID(), leftType.BaseID()) != nil {
+		return left, pgexprs.NewAssignmentCast(right, rightType, leftType), true, nil
+	}
+	if leftIsStringLiteral && framework.GetAssignmentCast(leftType.BaseID(), rightType.BaseID()) != nil {
+		return pgexprs.NewAssignmentCast(left, leftType, rightType), right, true, nil
+	}
 	return nil, nil, false, fmt.Errorf("COMPARISON: types are incompatible: %s and %s", leftType.String(), rightType.String())
 }
+
+// isStringLiteral returns whether the expression is a string literal.
+func isStringLiteral(expr sql.Expression) bool {
+	switch literal := expr.(type) {
+	case *pgexprs.Literal:
+		return literal.GetDoltgresType().BaseID() == pgtypes.DoltgresTypeBaseID_Text
+	case *expression.Literal:
+		_, ok := literal.Value().(string)
+		return ok
+	default:
+		return false
+	}
+}
@@ -27,32 +27,110 @@ const (
 	ruleId_AssignUpdateCasts
 	ruleId_ReplaceSerial
 	ruleId_InsertContextRootFinalizer
+	ruleId_ReplaceCreateCheck
+	ruleId_ReplaceAlterIndex
+	ruleId_StripQueryHints
+	ruleId_ReplaceCall
+	ruleId_AssignStatementRunner
+	ruleId_ApplyMaskingPolicies
+	ruleId_ReadForeignTables
+	ruleId_RejectForeignTableWrites
+	ruleId_AssignForeignKeyParentColumns
+	ruleId_ReplaceIdentityValues
+	ruleId_ResolveUserFunctions
+	ruleId_ApplyTriggers
+	ruleId_ReplaceAlterTable
+	ruleId_ApplyOnConflictWhere
+	ruleId_ApplyReturning
+	ruleId_ApplyDistinctOn
+	ruleId_CheckPrivileges
+	ruleId_RecordObjectOwnership
+	ruleId_ApplyPartitionedTables
+	ruleId_AcquireTableLocks
+	ruleId_ApplyRowLocking
+	ruleId_ApplyExclusionConstraints
+	ruleId_ApplyPartitionConstraints
+	ruleId_ReplaceCreateForeignKey
+	ruleId_ApplyStatisticsCounters
+	ruleId_RetainDeleteTriggers
 )
 
 // Init adds additional rules to the analyzer to handle Doltgres-specific functionality.
 func Init() {
-	// IDs are basically arbitrary, we just need to ensure that they do not conflict with existing IDs
+	// IDs are basically arbitrary, we just need to ensure that they do not conflict with existing IDs. Privileges are
+	// checked before the default rules replace or move any tables, and simple inserts, updates, and deletes skip the
+	// OnceBeforeDefault rules, so the check must be one of the AlwaysBeforeDefault rules. Partitioned tables are replaced
+	// for the same reason, as their writes must be routed to their partitions, and table locks are acquired once the
+	// privileges have been checked, so that a statement that would be denied does not wait on a lock. Deletes that fire
+	// triggers are retained before the default rules for the same reason, as simple deletes may become truncates.
 	analyzer.AlwaysBeforeDefault = append(analyzer.AlwaysBeforeDefault,
+		analyzer.Rule{Id: ruleId_CheckPrivileges, Apply: CheckPrivileges},
+		analyzer.Rule{Id: ruleId_AcquireTableLocks, Apply: AcquireTableLocks},
 		analyzer.Rule{Id: ruleId_TypeSanitizer, Apply: TypeSanitizer},
 		getAnalyzerRule(analyzer.OnceBeforeDefault, analyzer.ValidateColumnDefaultsId),
 		analyzer.Rule{Id: ruleId_ComparisonCasts, Apply: ComparisonCasts},
 		analyzer.Rule{Id: ruleId_AssignInsertCasts, Apply: AssignInsertCasts},
 		analyzer.Rule{Id: ruleId_AssignUpdateCasts, Apply: AssignUpdateCasts},
+		analyzer.Rule{Id: ruleId_RejectForeignTableWrites, Apply: RejectForeignTableWrites},
+		analyzer.Rule{Id: ruleId_ApplyPartitionedTables, Apply: ApplyPartitionedTables},
+		analyzer.Rule{Id: ruleId_RetainDeleteTriggers, Apply: RetainDeleteTriggers},
 	)
 
 	// Column default validation was moved to occur after type sanitization, so we'll remove it from its original place
 	analyzer.OnceBeforeDefault = removeAnalyzerRules(analyzer.OnceBeforeDefault,
 		analyzer.ValidateColumnDefaultsId)
+	// RETURNING clauses must be applied before any filters are moved, as their statements are carried by a filter
+	analyzer.OnceBeforeDefault = append([]analyzer.Rule{{Id: ruleId_ApplyReturning, Apply: ApplyReturning}},
+		analyzer.OnceBeforeDefault...)
+	// DISTINCT ON must be applied before any sorts and limits are combined, as it must filter the sorted rows
+	analyzer.OnceBeforeDefault = append(analyzer.OnceBeforeDefault,
+		analyzer.Rule{Id: ruleId_ApplyDistinctOn, Apply: ApplyDistinctOn})
+	// Locking clauses are applied for the same reason, as the rows must be locked before they're limited
+	analyzer.OnceBeforeDefault = append(analyzer.OnceBeforeDefault,
+		analyzer.Rule{Id: ruleId_ApplyRowLocking, Apply: ApplyRowLocking})
+	// Hints must be removed before joins are planned, as the join planner reads them from the join nodes
+	analyzer.OnceBeforeDefault = append(analyzer.OnceBeforeDefault,
+		analyzer.Rule{Id: ruleId_StripQueryHints, Apply: StripQueryHints})
+	// Foreign tables must be replaced before masking, so that masking applies to the rows read from the server
+	analyzer.OnceBeforeDefault = append(analyzer.OnceBeforeDefault,
+		analyzer.Rule{Id: ruleId_ReadForeignTables, Apply: ReadForeignTables})
+	// User-defined functions must be resolved before any rules that depend on the types of expressions
+	analyzer.OnceBeforeDefault = append(analyzer.OnceBeforeDefault,
+		analyzer.Rule{Id: ruleId_ResolveUserFunctions, Apply: ResolveUserFunctions})
+	// Identity values must be replaced before the assignment casts are added to the inserted values
+	analyzer.OnceBeforeDefault = append(analyzer.OnceBeforeDefault,
+		analyzer.Rule{Id: ruleId_ReplaceIdentityValues, Apply: ReplaceIdentityValues})
+	// Masking must occur before filters and index lookups are pushed into the tables, as they'd bypass the masking
+	analyzer.OnceBeforeDefault = append(analyzer.OnceBeforeDefault,
+		analyzer.Rule{Id: ruleId_ApplyMaskingPolicies, Apply: ApplyMaskingPolicies})
 	// Remove all other validation rules that do not apply to Postgres
 	analyzer.DefaultValidationRules = removeAnalyzerRules(analyzer.DefaultValidationRules,
 		analyzer.ValidateOperandsId)
 
 	analyzer.OnceAfterDefault = append(analyzer.OnceAfterDefault,
+		analyzer.Rule{Id: ruleId_AssignForeignKeyParentColumns, Apply: AssignForeignKeyParentColumns},
 		analyzer.Rule{Id: ruleId_ReplaceSerial, Apply: ReplaceSerial},
+		analyzer.Rule{Id: ruleId_ReplaceCreateCheck, Apply: ReplaceCreateCheck},
+		analyzer.Rule{Id: ruleId_ReplaceCreateForeignKey, Apply: ReplaceCreateForeignKey},
+		analyzer.Rule{Id: ruleId_ReplaceAlterIndex, Apply: ReplaceAlterIndex},
+		analyzer.Rule{Id: ruleId_ReplaceAlterTable, Apply: ReplaceAlterTable},
+		analyzer.Rule{Id: ruleId_ReplaceCall, Apply: ReplaceCall},
+		analyzer.Rule{Id: ruleId_AssignStatementRunner, Apply: AssignStatementRunner},
 	)
 
-	// The auto-commit rule writes the contents of the context, so we need to insert our finalizer before that
+	// Triggers wrap the row update accumulators, so they must be applied after the accumulators have been added, and
+	// exclusion constraints verify the rows that the triggers return. The
+	// auto-commit rule writes the contents of the context, so we need to insert our finalizer before that. The WHERE
+	// clause of ON CONFLICT is applied once the execution indexes of the assignments have been assigned. Object ownership
+	// is recorded by a wrapper that must finish before the context's changes are finalized. Statistics counters are added
+	// once every other node is in place.
 	analyzer.OnceAfterAll = insertAnalyzerRules(analyzer.OnceAfterAll, analyzer.AutocommitId, true,
+		analyzer.Rule{Id: ruleId_RecordObjectOwnership, Apply: RecordObjectOwnership},
+		analyzer.Rule{Id: ruleId_ApplyOnConflictWhere, Apply: ApplyOnConflictWhere},
+		analyzer.Rule{Id: ruleId_ApplyTriggers, Apply: ApplyTriggers},
+		analyzer.Rule{Id: ruleId_ApplyExclusionConstraints, Apply: ApplyExclusionConstraints},
+		analyzer.Rule{Id: ruleId_ApplyPartitionConstraints, Apply: ApplyPartitionConstraints},
+		analyzer.Rule{Id: ruleId_ApplyStatisticsCounters, Apply: ApplyStatisticsCounters},
 		analyzer.Rule{Id: ruleId_InsertContextRootFinalizer, Apply: InsertContextRootFinalizer})
 }
 
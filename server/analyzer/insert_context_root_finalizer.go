@@ -41,7 +41,9 @@ func InsertContextRootFinalizer(ctx *sql.Context, a *analyzer.Analyzer, node sql
 // transformRemoveContextRootFinalizer is the function used by the transform from within InsertContextRootFinalizer.
 func transformRemoveContextRootFinalizer(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
 	if finalizer, ok := node.(*pgnodes.ContextRootFinalizer); ok {
-		return finalizer.Child(), transform.NewTree, nil
+		// The finalizer presents its child's children as its own, so the transform never reaches the child itself
+		child, _, err := removeSubqueryContextRootFinalizers(finalizer.Child())
+		return child, transform.NewTree, err
 	} else if disjointedNode, ok := node.(plan.DisjointedChildrenNode); ok {
 		var err error
 		same := transform.SameTree
@@ -66,5 +68,22 @@ func transformRemoveContextRootFinalizer(node sql.Node) (sql.Node, transform.Tre
 			}
 		}
 	}
-	return node, transform.SameTree, nil
+	return removeSubqueryContextRootFinalizers(node)
+}
+
+// removeSubqueryContextRootFinalizers removes the finalizers from the subqueries within the node's expressions, as
+// subqueries are analyzed separately from the node that contains them. A finalizer within a subquery would also hide
+// its child from the engine when the subquery prepends the outer row to its rows.
+func removeSubqueryContextRootFinalizers(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+	return transform.OneNodeExpressions(node, func(expr sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		subquery, ok := expr.(*plan.Subquery)
+		if !ok {
+			return expr, transform.SameTree, nil
+		}
+		newQuery, same, err := transform.NodeWithOpaque(subquery.Query, transformRemoveContextRootFinalizer)
+		if err != nil || same == transform.SameTree {
+			return expr, transform.SameTree, err
+		}
+		return subquery.WithQuery(newQuery), transform.NewTree, nil
+	})
 }
@@ -0,0 +1,293 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+
+	"github.com/dolthub/doltgresql/postgres/parser/parser"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// QueryDiffTable is the table function that is called in place of Dolt's dolt_query_diff. It runs two queries, which
+// are typically the same query as of two different refs, and returns the rows that were added, deleted, or modified
+// between the results of the first query and those of the second. Dolt's own function parses its queries as MySQL, so
+// this function exists to run the queries as Postgres.
+type QueryDiffTable struct {
+	analyzer *analyzer.Analyzer
+	database sql.Database
+	args     []sql.Expression
+	schema   sql.Schema
+	rows     []sql.Row
+}
+
+var _ sql.TableFunction = (*QueryDiffTable)(nil)
+var _ sql.ExecSourceRel = (*QueryDiffTable)(nil)
+
+// NewQueryDiffTable returns a new *QueryDiffTable that runs its queries using the given analyzer.
+func NewQueryDiffTable(a *analyzer.Analyzer) *QueryDiffTable {
+	return &QueryDiffTable{analyzer: a}
+}
+
+// NewInstance implements the interface sql.TableFunction.
+func (q *QueryDiffTable) NewInstance(ctx *sql.Context, db sql.Database, args []sql.Expression) (sql.Node, error) {
+	args, _ = framework.SplitColumnDefinitionList(args)
+	if len(args) != 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("dolt_query_diff", 2, len(args))
+	}
+	runner := NewStatementRunner(q.analyzer)
+	var schemas [2]sql.Schema
+	var results [2][]sql.Row
+	for i, arg := range args {
+		val, err := arg.Eval(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		query, ok := val.(string)
+		if !ok {
+			return nil, fmt.Errorf("dolt_query_diff requires two queries given as text")
+		}
+		stmt, err := parser.ParseOne(query)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok = stmt.AST.(*tree.Select); !ok {
+			return nil, fmt.Errorf("dolt_query_diff only supports SELECT queries")
+		}
+		if schemas[i], results[i], err = runner(ctx, stmt.AST); err != nil {
+			return nil, err
+		}
+	}
+	nq := *q
+	nq.database = db
+	nq.args = args
+	nq.schema = queryDiffSchema(schemas[0], schemas[1])
+	var err error
+	if nq.rows, err = queryDiffRows(schemas[0], schemas[1], results[0], results[1]); err != nil {
+		return nil, err
+	}
+	return &nq, nil
+}
+
+// queryDiffSchema returns the schema of the diff, which contains every column of the first query prefixed with
+// "from_", followed by every column of the second query prefixed with "to_", and ends with the type of the diff.
+func queryDiffSchema(fromSch sql.Schema, toSch sql.Schema) sql.Schema {
+	schema := make(sql.Schema, 0, len(fromSch)+len(toSch)+1)
+	for _, col := range fromSch {
+		schema = append(schema, &sql.Column{Name: "from_" + col.Name, Type: col.Type, Source: "dolt_query_diff", Nullable: true})
+	}
+	for _, col := range toSch {
+		schema = append(schema, &sql.Column{Name: "to_" + col.Name, Type: col.Type, Source: "dolt_query_diff", Nullable: true})
+	}
+	return append(schema, &sql.Column{Name: "diff_type", Type: pgtypes.Text, Source: "dolt_query_diff", Nullable: false})
+}
+
+// queryDiffRows returns the rows of the diff between the results of both queries. When both results have the same
+// columns and include a primary key, rows are matched by their key, so that changes to the other columns are reported
+// as a single modified row. Otherwise, whole rows are matched, so that changes are reported as deleted and added rows.
+func queryDiffRows(fromSch sql.Schema, toSch sql.Schema, fromRows []sql.Row, toRows []sql.Row) ([]sql.Row, error) {
+	var keyOrdinals []int
+	if queryDiffSchemasMatch(fromSch, toSch) {
+		// Projections and filters may drop the primary key marker from either side, so a column of either key is used
+		for i, col := range fromSch {
+			if col.PrimaryKey || toSch[i].PrimaryKey {
+				keyOrdinals = append(keyOrdinals, i)
+			}
+		}
+	}
+	// Rows of the second query are indexed by their key, with duplicate keys (which only occur for keyless results)
+	// matched in the order that they were returned.
+	toIndexes := make(map[string][]int, len(toRows))
+	matched := make([]bool, len(toRows))
+	for i, row := range toRows {
+		key, err := queryDiffKey(toSch, row, keyOrdinals)
+		if err != nil {
+			return nil, err
+		}
+		toIndexes[key] = append(toIndexes[key], i)
+	}
+	var diffRows []sql.Row
+	for _, fromRow := range fromRows {
+		key, err := queryDiffKey(fromSch, fromRow, keyOrdinals)
+		if err != nil {
+			return nil, err
+		}
+		indexes := toIndexes[key]
+		if len(indexes) == 0 {
+			diffRows = append(diffRows, queryDiffRow(fromRow, nil, len(fromSch), len(toSch), "deleted"))
+			continue
+		}
+		toIndexes[key] = indexes[1:]
+		matched[indexes[0]] = true
+		toRow := toRows[indexes[0]]
+		fromValue, err := queryDiffKey(fromSch, fromRow, nil)
+		if err != nil {
+			return nil, err
+		}
+		toValue, err := queryDiffKey(toSch, toRow, nil)
+		if err != nil {
+			return nil, err
+		}
+		if fromValue != toValue {
+			diffRows = append(diffRows, queryDiffRow(fromRow, toRow, len(fromSch), len(toSch), "modified"))
+		}
+	}
+	for i, toRow := range toRows {
+		if !matched[i] {
+			diffRows = append(diffRows, queryDiffRow(nil, toRow, len(fromSch), len(toSch), "added"))
+		}
+	}
+	return diffRows, nil
+}
+
+// queryDiffSchemasMatch returns whether both schemas have the same column names. Types are not compared, as values are
+// matched using their output form, and expressions may return a different (but compatible) type than a column.
+func queryDiffSchemasMatch(fromSch sql.Schema, toSch sql.Schema) bool {
+	if len(fromSch) != len(toSch) {
+		return false
+	}
+	for i := range fromSch {
+		if fromSch[i].Name != toSch[i].Name {
+			return false
+		}
+	}
+	return true
+}
+
+// queryDiffKey returns a string that uniquely identifies the values at the given ordinals of the row, or all values of
+// the row when no ordinals are given. Values are compared using their output form, so that values that are equal
+// produce the same key.
+func queryDiffKey(sch sql.Schema, row sql.Row, ordinals []int) (string, error) {
+	if len(ordinals) == 0 {
+		ordinals = make([]int, len(sch))
+		for i := range ordinals {
+			ordinals[i] = i
+		}
+	}
+	sb := strings.Builder{}
+	for _, ordinal := range ordinals {
+		if ordinal >= len(row) || row[ordinal] == nil {
+			sb.WriteString("N,")
+			continue
+		}
+		var text string
+		if dgType, ok := sch[ordinal].Type.(pgtypes.DoltgresType); ok {
+			var err error
+			if text, err = dgType.IoOutput(row[ordinal]); err != nil {
+				return "", err
+			}
+		} else {
+			text = fmt.Sprint(row[ordinal])
+		}
+		sb.WriteString(strconv.Quote(text))
+		sb.WriteRune(',')
+	}
+	return sb.String(), nil
+}
+
+// queryDiffRow returns a row of the diff, using NULL for the columns of a missing row.
+func queryDiffRow(fromRow sql.Row, toRow sql.Row, fromLen int, toLen int, diffType string) sql.Row {
+	row := make(sql.Row, fromLen+toLen+1)
+	copy(row, fromRow)
+	copy(row[fromLen:], toRow)
+	row[fromLen+toLen] = diffType
+	return row
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (q *QueryDiffTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	return sql.RowsToRowIter(q.rows...), nil
+}
+
+// Schema implements the interface sql.Node.
+func (q *QueryDiffTable) Schema() sql.Schema {
+	return q.schema
+}
+
+// Resolved implements the interface sql.Node.
+func (q *QueryDiffTable) Resolved() bool {
+	return q.schema != nil
+}
+
+// String implements the interface sql.Node.
+func (q *QueryDiffTable) String() string {
+	args := make([]string, len(q.args))
+	for i, arg := range q.args {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", framework.QueryDiffTableName, strings.Join(args, ", "))
+}
+
+// Children implements the interface sql.Node.
+func (q *QueryDiffTable) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the interface sql.Node.
+func (q *QueryDiffTable) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(q, len(children), 0)
+	}
+	return q, nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (q *QueryDiffTable) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// IsReadOnly implements the interface sql.Node.
+func (q *QueryDiffTable) IsReadOnly() bool {
+	return true
+}
+
+// Expressions implements the interface sql.Expressioner.
+func (q *QueryDiffTable) Expressions() []sql.Expression {
+	return q.args
+}
+
+// WithExpressions implements the interface sql.Expressioner.
+func (q *QueryDiffTable) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != len(q.args) {
+		return nil, sql.ErrInvalidChildrenNumber.New(q, len(exprs), len(q.args))
+	}
+	nq := *q
+	nq.args = exprs
+	return &nq, nil
+}
+
+// Name implements the interface sql.Nameable.
+func (q *QueryDiffTable) Name() string {
+	return framework.QueryDiffTableName
+}
+
+// Database implements the interface sql.Databaser.
+func (q *QueryDiffTable) Database() sql.Database {
+	return q.database
+}
+
+// WithDatabase implements the interface sql.Databaser.
+func (q *QueryDiffTable) WithDatabase(database sql.Database) (sql.Node, error) {
+	nq := *q
+	nq.database = database
+	return &nq, nil
+}
@@ -0,0 +1,173 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/foreign"
+	"github.com/dolthub/doltgresql/server/fdw"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// ReadForeignTables replaces foreign tables with tables that read their rows from the foreign server. Equality filters
+// on columns that have a query parameter are sent to the server as well, while the filters themselves remain in place,
+// so the server is free to return more rows than were asked for.
+func ReadForeignTables(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	return readForeignTables(ctx, node, nil)
+}
+
+// readForeignTables handles the recursion of ReadForeignTables. The filters are those of the enclosing Filter nodes,
+// which apply to every table beneath them as long as only joins and aliases are in between.
+func readForeignTables(ctx *sql.Context, node sql.Node, filters []sql.Expression) (sql.Node, transform.TreeIdentity, error) {
+	switch node := node.(type) {
+	case *plan.InsertInto:
+		// The destination is not a child, so only the source is searched for foreign tables
+		source, same, err := readForeignTables(ctx, node.Source, nil)
+		if err != nil || same == transform.SameTree {
+			return node, transform.SameTree, err
+		}
+		return node.WithSource(source), transform.NewTree, nil
+	case *plan.Filter:
+		filters = append(filters[:len(filters):len(filters)], expression.SplitConjunction(node.Expression)...)
+	case *plan.TableAlias:
+		if rt, ok := node.Child.(*plan.ResolvedTable); ok {
+			newRt, same, err := readForeignTable(ctx, rt, node.Name(), filters)
+			if err != nil || same == transform.SameTree {
+				return node, transform.SameTree, err
+			}
+			newNode, err := node.WithChildren(newRt)
+			return newNode, transform.NewTree, err
+		}
+	case *plan.JoinNode:
+		// The filters above a join apply to the rows of both sides
+	case *plan.ResolvedTable:
+		return readForeignTable(ctx, node, node.Name(), filters)
+	default:
+		filters = nil
+	}
+	children := node.Children()
+	newChildren := make([]sql.Node, len(children))
+	same := transform.SameTree
+	for i, child := range children {
+		newChild, childSame, err := readForeignTables(ctx, child, filters)
+		if err != nil {
+			return nil, transform.SameTree, err
+		}
+		newChildren[i] = newChild
+		if childSame == transform.NewTree {
+			same = transform.NewTree
+		}
+	}
+	if same == transform.SameTree {
+		return node, transform.SameTree, nil
+	}
+	newNode, err := node.WithChildren(newChildren...)
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	return newNode, transform.NewTree, nil
+}
+
+// readForeignTable returns the given table wrapped by an fdw.Table if it is a foreign table. The name is the name that
+// the query uses for the table, which is how the filters refer to the table's columns.
+func readForeignTable(ctx *sql.Context, rt *plan.ResolvedTable, name string, filters []sql.Expression) (sql.Node, transform.TreeIdentity, error) {
+	if _, ok := rt.Table.(*fdw.Table); ok {
+		return rt, transform.SameTree, nil
+	}
+	definition, server, err := getForeignTable(ctx, rt)
+	if err != nil || definition == nil {
+		return rt, transform.SameTree, err
+	}
+	newRt, err := rt.ReplaceTable(fdw.NewTable(rt.Table, server, definition, pushdownParams(ctx, definition, name, filters)))
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	return newRt, transform.NewTree, nil
+}
+
+// getForeignTable returns the foreign definition and server of the given table. Returns nil for both if the table is not
+// a foreign table.
+func getForeignTable(ctx *sql.Context, rt *plan.ResolvedTable) (*foreign.Table, *foreign.Server, error) {
+	// Only tables that belong to a Doltgres database may be foreign tables
+	db, ok := rt.UnwrappedDatabase().(interface{ Schema() string })
+	if !ok {
+		return nil, nil, nil
+	}
+	return core.GetForeignTable(ctx, rt.Database().Name(), doltdb.TableName{Name: rt.Name(), Schema: db.Schema()})
+}
+
+// pushdownParams returns the query parameters for every filter that compares a column with a query parameter to a
+// constant value.
+func pushdownParams(ctx *sql.Context, definition *foreign.Table, name string, filters []sql.Expression) url.Values {
+	params := make(url.Values)
+	for _, filter := range filters {
+		equals, ok := filter.(*expression.Equals)
+		if !ok {
+			continue
+		}
+		field, ok := equals.Left().(*expression.GetField)
+		value := equals.Right()
+		if !ok {
+			field, ok = equals.Right().(*expression.GetField)
+			value = equals.Left()
+		}
+		if !ok || !strings.EqualFold(field.Table(), name) {
+			continue
+		}
+		param, ok := definition.ColumnOptions[field.Name()][fdw.ColumnParam]
+		if !ok {
+			continue
+		}
+		if text, ok := constantText(ctx, value); ok {
+			params.Set(param, text)
+		}
+	}
+	return params
+}
+
+// constantText returns the text form of the expression's value, as long as the expression does not depend on the row.
+func constantText(ctx *sql.Context, expr sql.Expression) (string, bool) {
+	dependsOnRow := transform.InspectExpr(expr, func(expr sql.Expression) bool {
+		switch expr.(type) {
+		case *expression.GetField, *expression.BindVar, *plan.Subquery:
+			return true
+		default:
+			return false
+		}
+	})
+	if dependsOnRow {
+		return "", false
+	}
+	value, err := expr.Eval(ctx, nil)
+	if err != nil || value == nil {
+		return "", false
+	}
+	if dgType, ok := expr.Type().(pgtypes.DoltgresType); ok {
+		text, err := dgType.IoOutput(value)
+		return text, err == nil
+	}
+	return fmt.Sprint(value), true
+}
@@ -0,0 +1,139 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/server/auth"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// RecordObjectOwnership wraps statements that create or drop tables, views, or schemas with a node that records the
+// owners of the created objects, and forgets the owners and privileges of the dropped objects. Objects that already
+// exist when IF NOT EXISTS or OR REPLACE is given keep their current owner. Databases record their own owners, as they
+// may be given an owner other than the current role.
+func RecordObjectOwnership(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	if _, ok := node.(*pgnodes.ObjectOwnership); ok {
+		return node, transform.SameTree, nil
+	}
+	var created, dropped []auth.Object
+	var err error
+	transform.Inspect(node, func(n sql.Node) bool {
+		if err != nil {
+			return false
+		}
+		var obj auth.Object
+		var ok bool
+		switch n := n.(type) {
+		case *pgnodes.CreateTable:
+			obj, ok, err = createdTable(ctx, n.GMSCreateTable())
+			if ok {
+				created = append(created, obj)
+			}
+		case *plan.CreateTable:
+			obj, ok, err = createdTable(ctx, n)
+			if ok {
+				created = append(created, obj)
+			}
+		case *plan.CreateView:
+			obj, ok, err = createdView(ctx, n)
+			if ok {
+				created = append(created, obj)
+			}
+		case *plan.CreateSchema:
+			obj, ok, err = createdSchema(ctx, n)
+			if ok {
+				created = append(created, obj)
+			}
+		case *plan.DropTable:
+			for _, table := range n.Tables {
+				if rt, isTable := table.(*plan.ResolvedTable); isTable {
+					if obj, ok, err = tableObject(ctx, rt); ok {
+						dropped = append(dropped, obj)
+					}
+				}
+			}
+		case *plan.SingleDropView:
+			var schemaName string
+			if schemaName, ok, err = creationSchema(ctx, n.Database()); ok {
+				dropped = append(dropped, auth.TableObject(n.Database().Name(), schemaName, n.ViewName))
+			}
+		}
+		return err == nil
+	})
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	if len(created) == 0 && len(dropped) == 0 {
+		return node, transform.SameTree, nil
+	}
+	return pgnodes.NewObjectOwnership(node, created, dropped), transform.NewTree, nil
+}
+
+// createdTable returns the table that the node will create. Returns false if no table will be created, or if the table
+// belongs to a schema whose privileges are not tracked.
+func createdTable(ctx *sql.Context, node *plan.CreateTable) (auth.Object, bool, error) {
+	db := node.Database()
+	schemaName, ok, err := creationSchema(ctx, db)
+	if err != nil || !ok || node.Temporary() {
+		return auth.Object{}, false, err
+	}
+	if node.IfNotExists() {
+		if _, exists, err := db.GetTableInsensitive(ctx, node.Name()); err != nil || exists {
+			return auth.Object{}, false, err
+		}
+	}
+	return auth.TableObject(db.Name(), schemaName, node.Name()), true, nil
+}
+
+// createdView returns the view that the node will create. Returns false if the view already exists and is being
+// replaced, or if the view belongs to a schema whose privileges are not tracked.
+func createdView(ctx *sql.Context, node *plan.CreateView) (auth.Object, bool, error) {
+	db := node.Database()
+	schemaName, ok, err := creationSchema(ctx, db)
+	if err != nil || !ok {
+		return auth.Object{}, false, err
+	}
+	if node.IsReplace {
+		if viewDb, ok := db.(sql.ViewDatabase); ok {
+			if _, exists, err := viewDb.GetViewDefinition(ctx, node.Name); err != nil || exists {
+				return auth.Object{}, false, err
+			}
+		}
+	}
+	return auth.TableObject(db.Name(), schemaName, node.Name), true, nil
+}
+
+// createdSchema returns the schema that the node will create. Returns false if the schema already exists and IF NOT
+// EXISTS was given.
+func createdSchema(ctx *sql.Context, node *plan.CreateSchema) (auth.Object, bool, error) {
+	if node.IfNotExists {
+		schemaNames, err := core.GetSchemaNamesFromContext(ctx)
+		if err != nil {
+			return auth.Object{}, false, err
+		}
+		for _, schemaName := range schemaNames {
+			if schemaName == node.DbName {
+				return auth.Object{}, false, nil
+			}
+		}
+	}
+	return auth.SchemaObject(ctx.GetCurrentDatabase(), node.DbName), true, nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// RejectForeignTableWrites returns an error for statements that write to a foreign table, as the rows of a foreign
+// table belong to its server.
+func RejectForeignTableWrites(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	var err error
+	switch node := node.(type) {
+	case *plan.InsertInto:
+		err = checkForeignWrite(ctx, node.Destination, `cannot insert into foreign table "%s"`)
+	case *plan.Update:
+		err = checkForeignWrite(ctx, node.Child, `cannot update foreign table "%s"`)
+	case *plan.DeleteFrom:
+		err = checkForeignWrite(ctx, node.Child, `cannot delete from foreign table "%s"`)
+	}
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	return node, transform.SameTree, nil
+}
+
+// checkForeignWrite returns an error using the given message if the table that is written by the node is a foreign
+// table.
+func checkForeignWrite(ctx *sql.Context, node sql.Node, message string) error {
+	var rt *plan.ResolvedTable
+	transform.Inspect(node, func(node sql.Node) bool {
+		if resolvedTable, ok := node.(*plan.ResolvedTable); ok && rt == nil {
+			rt = resolvedTable
+		}
+		return rt == nil
+	})
+	if rt == nil {
+		return nil
+	}
+	definition, _, err := getForeignTable(ctx, rt)
+	if err != nil {
+		return err
+	}
+	if definition != nil {
+		return fmt.Errorf(message, rt.Name())
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// ReplaceAlterIndex replaces all AlterIndex nodes that create an index with a Doltgres-specific node that is able to
+// handle schemas. Full-text and spatial indexes are left as-is.
+func ReplaceAlterIndex(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	return transform.Node(node, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		alterIndex, ok := node.(*plan.AlterIndex)
+		if !ok || alterIndex.Action != plan.IndexAction_Create {
+			return node, transform.SameTree, nil
+		}
+		switch alterIndex.Constraint {
+		case sql.IndexConstraint_None, sql.IndexConstraint_Unique:
+			return pgnodes.NewCreateIndex(alterIndex), transform.NewTree, nil
+		default:
+			return node, transform.SameTree, nil
+		}
+	})
+}
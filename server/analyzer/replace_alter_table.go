@@ -0,0 +1,196 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// ReplaceAlterTable replaces the nodes that alter the columns of a table with a Doltgres-specific node that is able to
+// handle schemas.
+func ReplaceAlterTable(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	return transform.Node(node, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		var alteration pgnodes.ColumnAlteration
+		var tableNode sql.Node
+		switch node := node.(type) {
+		case *plan.AddColumn:
+			tableNode = node.Table
+			column := node.Column()
+			if column.PrimaryKey {
+				return nil, transform.NewTree, pgerrors.New(pgcode.FeatureNotSupported,
+					"adding a primary key column is not yet supported")
+			}
+			alteration = pgnodes.ColumnAlteration{Action: pgnodes.AlterColumnAction_Add, NewColumn: column}
+			if column.Default != nil {
+				value, err := assignmentCastForAlteration(column.Default.Expr, column.Type, column.Name, false)
+				if err != nil {
+					return nil, transform.NewTree, err
+				}
+				alteration.Value = value
+			}
+		case *plan.DropColumn:
+			tableNode = node.Table
+			alteration = pgnodes.ColumnAlteration{Action: pgnodes.AlterColumnAction_Drop, Column: node.Column}
+			// Checks that only use the dropped column are dropped along with it, which has already been validated
+			for _, check := range node.Checks() {
+				if expressionUsesColumn(check.Expr, node.Column) {
+					alteration.DroppedChecks = append(alteration.DroppedChecks, check.Name)
+				}
+			}
+		case *plan.RenameColumn:
+			tableNode = node.Table
+			alteration = pgnodes.ColumnAlteration{
+				Action:  pgnodes.AlterColumnAction_Rename,
+				Column:  node.ColumnName,
+				NewName: node.NewColumnName,
+			}
+		case *plan.AlterDefaultSet:
+			tableNode = node.Table
+			alteration = pgnodes.ColumnAlteration{
+				Action:  pgnodes.AlterColumnAction_SetDefault,
+				Column:  node.ColumnName,
+				Default: node.Default,
+			}
+		case *plan.AlterDefaultDrop:
+			tableNode = node.Table
+			alteration = pgnodes.ColumnAlteration{Action: pgnodes.AlterColumnAction_DropDefault, Column: node.ColumnName}
+		case *plan.ModifyColumn:
+			tableNode = node.Table
+			var err error
+			alteration, err = modifyColumnAlteration(node)
+			if err != nil {
+				return nil, transform.NewTree, err
+			}
+		default:
+			return node, transform.SameTree, nil
+		}
+		rt, ok := tableNode.(*plan.ResolvedTable)
+		if !ok {
+			return node, transform.SameTree, nil
+		}
+		if _, ok = rt.UnwrappedDatabase().(interface{ Schema() string }); !ok {
+			return node, transform.SameTree, nil
+		}
+		tableName := doltdb.TableName{Name: rt.Name(), Schema: tableSchema(rt)}
+		return pgnodes.NewAlterColumn(node, rt.SqlDatabase, tableName, alteration), transform.NewTree, nil
+	})
+}
+
+// modifyColumnAlteration returns the alteration for the given ModifyColumn node. ModifyColumn nodes are only created
+// for ALTER COLUMN ... TYPE and ALTER COLUMN ... SET/DROP NOT NULL. The latter uses the unknown type to signal that the
+// column's type is kept, while the former carries its USING expression as the column's default.
+func modifyColumnAlteration(node *plan.ModifyColumn) (pgnodes.ColumnAlteration, error) {
+	newColumn := node.NewColumn()
+	if newType, ok := newColumn.Type.(pgtypes.DoltgresType); ok && newType.BaseID() == pgtypes.DoltgresTypeBaseID_Unknown {
+		if newColumn.Nullable {
+			return pgnodes.ColumnAlteration{Action: pgnodes.AlterColumnAction_DropNotNull, Column: node.Column()}, nil
+		}
+		return pgnodes.ColumnAlteration{Action: pgnodes.AlterColumnAction_SetNotNull, Column: node.Column()}, nil
+	}
+	targetSchema := node.TargetSchema()
+	colIdx := targetSchema.IndexOfColName(node.Column())
+	if colIdx == -1 {
+		return pgnodes.ColumnAlteration{}, pgerrors.Newf(pgcode.UndefinedColumn, `column "%s" does not exist`, node.Column())
+	}
+	var value sql.Expression
+	if newColumn.Default != nil {
+		// The USING expression was resolved against the table, so we only need to point its fields to the table's row. As
+		// the expression is stored as a default, it may have already been given an assignment cast, which we replace with
+		// our own so that we return the correct error.
+		value = newColumn.Default.Expr
+		if cast, ok := value.(*pgexprs.AssignmentCast); ok {
+			value = cast.Children()[0]
+		}
+		var err error
+		value, _, err = transform.Expr(value, func(expr sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+			field, ok := expr.(*expression.GetField)
+			if !ok {
+				return expr, transform.SameTree, nil
+			}
+			fieldIdx := targetSchema.IndexOfColName(field.Name())
+			if fieldIdx == -1 {
+				return nil, transform.NewTree, pgerrors.Newf(pgcode.UndefinedColumn, `column "%s" does not exist`, field.Name())
+			}
+			return field.WithIndex(fieldIdx), transform.NewTree, nil
+		})
+		if err != nil {
+			return pgnodes.ColumnAlteration{}, err
+		}
+	} else {
+		column := targetSchema[colIdx]
+		value = expression.NewGetField(colIdx, column.Type, column.Name, column.Nullable)
+	}
+	value, err := assignmentCastForAlteration(value, newColumn.Type, node.Column(), newColumn.Default != nil)
+	if err != nil {
+		return pgnodes.ColumnAlteration{}, err
+	}
+	return pgnodes.ColumnAlteration{
+		Action: pgnodes.AlterColumnAction_SetType,
+		Column: node.Column(),
+		Type:   newColumn.Type,
+		Value:  value,
+	}, nil
+}
+
+// assignmentCastForAlteration returns the given expression cast to the given type using an assignment cast. Returns an
+// error if such a cast does not exist.
+func assignmentCastForAlteration(expr sql.Expression, toType sql.Type, columnName string, isUsing bool) (sql.Expression, error) {
+	fromType, ok := expr.Type().(pgtypes.DoltgresType)
+	if !ok {
+		return expr, nil
+	}
+	toDoltgresType, ok := toType.(pgtypes.DoltgresType)
+	if !ok || fromType.Equals(toDoltgresType) {
+		return expr, nil
+	}
+	if fromType.BaseID() != pgtypes.DoltgresTypeBaseID_Unknown &&
+		framework.GetAssignmentCast(fromType.BaseID(), toDoltgresType.BaseID()) == nil {
+		if isUsing {
+			return nil, pgerrors.Newf(pgcode.DatatypeMismatch, `result of USING clause for column "%s" cannot be cast automatically to type %s`,
+				columnName, toDoltgresType.String())
+		}
+		return nil, pgerrors.Newf(pgcode.DatatypeMismatch, `column "%s" cannot be cast automatically to type %s`,
+			columnName, toDoltgresType.String())
+	}
+	return pgexprs.NewAssignmentCast(expr, fromType, toDoltgresType), nil
+}
+
+// expressionUsesColumn returns whether the given expression references the given column.
+func expressionUsesColumn(expr sql.Expression, columnName string) bool {
+	return transform.InspectExpr(expr, func(expr sql.Expression) bool {
+		switch expr := expr.(type) {
+		case *expression.GetField:
+			return strings.EqualFold(expr.Name(), columnName)
+		case *expression.UnresolvedColumn:
+			return strings.EqualFold(expr.Name(), columnName)
+		default:
+			return false
+		}
+	})
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/functions"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// ReplaceCall replaces Call nodes that reference user-defined procedures with a Doltgres-specific node that is able to
+// execute them. Calls to Dolt's procedures (such as dolt_commit) are left as-is.
+func ReplaceCall(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	return transform.Node(node, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		call, ok := node.(*plan.Call)
+		if !ok {
+			return node, transform.SameTree, nil
+		}
+		if externalProc, err := a.Catalog.ExternalStoredProcedure(ctx, call.Name, len(call.Params)); err != nil {
+			return nil, transform.NewTree, err
+		} else if externalProc != nil {
+			return node, transform.SameTree, nil
+		}
+		collection, err := core.GetFunctionsCollectionFromContext(ctx)
+		if err != nil {
+			return nil, transform.NewTree, err
+		}
+		schema, err := core.GetCurrentSchema(ctx)
+		if err != nil {
+			return nil, transform.NewTree, err
+		}
+		procedure := collection.GetFunction(doltdb.TableName{Name: call.Name, Schema: schema})
+		if procedure == nil || procedure.Kind != functions.Kind_Procedure || !callArgCountMatches(procedure, len(call.Params)) {
+			argTypes := make([]string, len(call.Params))
+			for i, param := range call.Params {
+				argTypes[i] = param.Type().String()
+			}
+			return nil, transform.NewTree, fmt.Errorf("procedure %s(%s) does not exist", call.Name, strings.Join(argTypes, ", "))
+		}
+		paramTypes := make([]pgtypes.DoltgresType, len(procedure.Parameters))
+		for i, param := range procedure.Parameters {
+			paramType, err := pgtypes.DeserializeType(param.Type)
+			if err != nil {
+				return nil, transform.NewTree, err
+			}
+			paramTypes[i] = paramType.(pgtypes.DoltgresType)
+		}
+		block, err := userFunctionBlock(procedure)
+		if err != nil {
+			return nil, transform.NewTree, err
+		}
+		return pgnodes.NewCall(procedure, schema, block, paramTypes, call.Params, NewStatementRunner(a)), transform.NewTree, nil
+	})
+}
+
+// callArgCountMatches returns whether the given number of arguments may be used to call the procedure. Parameters that
+// have a default may be omitted, which are always trailing parameters.
+func callArgCountMatches(procedure *functions.Function, argCount int) bool {
+	required := 0
+	for i, param := range procedure.Parameters {
+		if len(param.Default) == 0 {
+			required = i + 1
+		}
+	}
+	return argCount >= required && argCount <= len(procedure.Parameters)
+}
@@ -0,0 +1,39 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// ReplaceCreateForeignKey replaces CreateForeignKey nodes with a Doltgres-specific node that does not verify the
+// existing rows, which only occurs while a foreign key is being added using NOT VALID.
+func ReplaceCreateForeignKey(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	if !pgnodes.SkipsConstraintValidation(ctx) {
+		return node, transform.SameTree, nil
+	}
+	return transform.Node(node, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		createForeignKey, ok := node.(*plan.CreateForeignKey)
+		if !ok {
+			return node, transform.SameTree, nil
+		}
+		return pgnodes.NewCreateForeignKeyNotValid(createForeignKey), transform.NewTree, nil
+	})
+}
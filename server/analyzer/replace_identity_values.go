@@ -0,0 +1,139 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/sequences"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// ReplaceIdentityValues handles the values that are inserted into identity columns. Values may not be given for columns
+// that are GENERATED ALWAYS unless the insert uses OVERRIDING SYSTEM VALUE, while OVERRIDING USER VALUE replaces the
+// given values with those generated by the column's sequence.
+func ReplaceIdentityValues(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	insertInto, ok := node.(*plan.InsertInto)
+	if !ok {
+		return node, transform.SameTree, nil
+	}
+	identities, err := identityColumns(ctx, insertInto.Destination)
+	if err != nil {
+		return nil, transform.NewTree, err
+	}
+	destinationSchema := insertInto.Destination.Schema()
+	values, ok := insertInto.Source.(*plan.Values)
+	if !ok {
+		// OVERRIDING is only accepted alongside VALUES, so we only need to check for GENERATED ALWAYS columns here
+		for _, colName := range insertInto.ColumnNames {
+			if identities[colName] == sequences.Identity_Always {
+				return nil, transform.NewTree, identityAlwaysError(colName)
+			}
+		}
+		return node, transform.SameTree, nil
+	}
+	newValues := make([][]sql.Expression, len(values.ExpressionTuples))
+	same := transform.SameTree
+	for rowIndex, rowExprs := range values.ExpressionTuples {
+		newValues[rowIndex] = make([]sql.Expression, len(rowExprs))
+		for columnIndex, colExpr := range rowExprs {
+			newValues[rowIndex][columnIndex] = colExpr
+			colName := insertInto.ColumnNames[columnIndex]
+			identity := identities[colName]
+			if _, ok := colExpr.(*expression.Wrapper); ok {
+				// This is an explicit DEFAULT, which is always allowed
+				continue
+			}
+			overriding, ok := colExpr.(*pgexprs.Overriding)
+			if !ok {
+				if identity == sequences.Identity_Always {
+					return nil, transform.NewTree, identityAlwaysError(colName)
+				}
+				continue
+			}
+			same = transform.NewTree
+			if overriding.Kind == pgexprs.OverridingUserValue && identity != sequences.Identity_None {
+				destinationIndex := destinationSchema.IndexOfColName(colName)
+				if destinationIndex < 0 {
+					return nil, transform.NewTree, fmt.Errorf("INSERT: cannot find destination column with name `%s`", colName)
+				}
+				newValues[rowIndex][columnIndex] = expression.WrapExpression(destinationSchema[destinationIndex].Default)
+			} else {
+				newValues[rowIndex][columnIndex] = overriding.Child()
+			}
+		}
+	}
+	if same == transform.SameTree {
+		return node, transform.SameTree, nil
+	}
+	return insertInto.WithSource(plan.NewValues(newValues)), transform.NewTree, nil
+}
+
+// identityColumns returns the identity columns of the given table, mapping each column name to the kind of identity.
+func identityColumns(ctx *sql.Context, destination sql.Node) (map[string]sequences.Identity, error) {
+	// The destination may be wrapped by other nodes, such as InsertDestination
+	var rt *plan.ResolvedTable
+	transform.Inspect(destination, func(node sql.Node) bool {
+		if rt != nil {
+			return false
+		}
+		rt, _ = node.(*plan.ResolvedTable)
+		return rt == nil
+	})
+	if rt == nil {
+		return nil, nil
+	}
+	// Only tables that belong to a Doltgres database may have identity columns
+	db, ok := rt.UnwrappedDatabase().(interface{ Schema() string })
+	if !ok {
+		return nil, nil
+	}
+	schemaName := db.Schema()
+	if len(schemaName) == 0 {
+		var err error
+		schemaName, err = core.GetCurrentSchema(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	collection, err := core.GetCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	identities := make(map[string]sequences.Identity)
+	for _, sequence := range collection.GetSequencesWithTable(doltdb.TableName{Name: rt.Name(), Schema: schemaName}) {
+		if sequence.Identity != sequences.Identity_None {
+			identities[sequence.OwnerColumn] = sequence.Identity
+		}
+	}
+	return identities, nil
+}
+
+// identityAlwaysError returns the error for a value that was given for a GENERATED ALWAYS column.
+func identityAlwaysError(colName string) error {
+	return pgerrors.Newf(pgcode.GeneratedAlways, `cannot insert a non-DEFAULT value into column "%s"`, colName).
+		WithDetail(fmt.Sprintf(`Column "%s" is an identity column defined as GENERATED ALWAYS.`, colName)).
+		WithHint("Use OVERRIDING SYSTEM VALUE to override.")
+}
@@ -0,0 +1,169 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/functions"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/ast"
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+	"github.com/dolthub/doltgresql/server/plpgsql"
+	"github.com/dolthub/doltgresql/server/procedures"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// ResolveUserFunctions replaces calls to functions that are not built in with calls to the matching user-defined
+// functions. The arguments are matched to the parameters using the same rules as built-in functions.
+func ResolveUserFunctions(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	if disjointedNode, ok := node.(plan.DisjointedChildrenNode); ok {
+		return handleDisjointedNodes(ctx, a, disjointedNode, scope, selector, ResolveUserFunctions)
+	}
+	var collection *functions.Collection
+	return transform.NodeExprsWithOpaque(node, func(expr sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		unresolved, ok := expr.(*pgexprs.UnresolvedFunction)
+		if !ok {
+			return expr, transform.SameTree, nil
+		}
+		if collection == nil {
+			var err error
+			collection, err = core.GetFunctionsCollectionFromContext(ctx)
+			if err != nil {
+				return nil, transform.NewTree, err
+			}
+		}
+		schema := unresolved.Schema()
+		if len(schema) == 0 {
+			var err error
+			schema, err = core.GetCurrentSchema(ctx)
+			if err != nil {
+				return nil, transform.NewTree, err
+			}
+		}
+		function := collection.GetFunction(doltdb.TableName{Name: unresolved.Name(), Schema: schema})
+		if function == nil && len(unresolved.Schema()) == 0 {
+			// Some of Dolt's procedures may also be called as functions, which user-defined functions take precedence over
+			if procedureFunction, ok := procedures.DoltProcedureFunction(unresolved.Name(), len(unresolved.Children())); ok {
+				return framework.NewCompiledFunctionFromOverloads(unresolved.Name(), unresolved.Children(),
+					[]framework.FunctionInterface{procedureFunction}), transform.NewTree, nil
+			}
+		}
+		if function == nil || function.Kind != functions.Kind_Function {
+			argTypes := make([]string, len(unresolved.Children()))
+			for i, arg := range unresolved.Children() {
+				argTypes[i] = arg.Type().String()
+			}
+			return nil, transform.NewTree, pgerrors.Raise(ctx, pgerrors.Newf(pgcode.UndefinedFunction,
+				"function %s(%s) does not exist", unresolved.Name(), strings.Join(argTypes, ", ")))
+		}
+		overloads, err := userFunctionOverloads(function, NewStatementRunner(a))
+		if err != nil {
+			return nil, transform.NewTree, err
+		}
+		return framework.NewCompiledFunctionFromOverloads(function.Name, unresolved.Children(), overloads), transform.NewTree, nil
+	})
+}
+
+// userFunctionOverloads returns an overload for each number of arguments that the given function may be called with.
+// Parameters that have a default may be omitted, which are always trailing parameters.
+func userFunctionOverloads(function *functions.Function, runner pgnodes.StatementRunner) ([]framework.FunctionInterface, error) {
+	paramTypes := make([]pgtypes.DoltgresType, len(function.Parameters))
+	var inputTypes []pgtypes.DoltgresType
+	required := 0
+	for i, param := range function.Parameters {
+		paramType, err := pgtypes.DeserializeType(param.Type)
+		if err != nil {
+			return nil, err
+		}
+		paramTypes[i] = paramType.(pgtypes.DoltgresType)
+		if param.Mode == functions.ParameterMode_Out {
+			continue
+		}
+		inputTypes = append(inputTypes, paramTypes[i])
+		if len(param.Default) == 0 {
+			required = len(inputTypes)
+		}
+	}
+	deserializedReturnType, err := pgtypes.DeserializeType(function.ReturnType)
+	if err != nil {
+		return nil, err
+	}
+	returnType := deserializedReturnType.(pgtypes.DoltgresType)
+	if returnType.BaseID() == pgtypes.DoltgresTypeBaseID_Trigger {
+		return nil, pgerrors.New(pgcode.FeatureNotSupported, "trigger functions can only be called as triggers")
+	}
+	block, err := userFunctionBlock(function)
+	if err != nil {
+		return nil, err
+	}
+	overloads := make([]framework.FunctionInterface, 0, len(inputTypes)-required+1)
+	for argCount := required; argCount <= len(inputTypes); argCount++ {
+		if !function.ReturnsSet {
+			overloads = append(overloads, framework.FunctionN{
+				Name:               function.Name,
+				Return:             returnType,
+				Parameters:         inputTypes[:argCount],
+				IsNonDeterministic: true,
+				Callable: func(ctx *sql.Context, paramsAndReturn []pgtypes.DoltgresType, vals []any) (any, error) {
+					return pgnodes.CallFunction(ctx, function, block, paramTypes, paramsAndReturn[len(paramsAndReturn)-1], vals, runner)
+				},
+			})
+			continue
+		}
+		// Functions that return a set are modeled the same as built-in functions that return a set of single values
+		overloads = append(overloads, framework.RecordFunction{
+			FunctionInterface: framework.FunctionN{
+				Name:               function.Name,
+				Return:             pgtypes.Record,
+				Parameters:         inputTypes[:argCount],
+				IsNonDeterministic: true,
+				Callable: func(ctx *sql.Context, _ []pgtypes.DoltgresType, vals []any) (any, error) {
+					values, err := pgnodes.CallSetReturningFunction(ctx, function, block, paramTypes, returnType, vals, runner)
+					if err != nil {
+						return nil, err
+					}
+					rows := make([][]any, len(values))
+					for i, val := range values {
+						rows[i] = []any{val}
+					}
+					return rows, nil
+				},
+			},
+			Columns:    []framework.RecordColumn{{Name: function.Name, Type: returnType}},
+			ReturnsSet: true,
+		})
+	}
+	return overloads, nil
+}
+
+// userFunctionBlock returns the parsed body of the given routine when it is written in PL/pgSQL, and nil for all other
+// languages.
+func userFunctionBlock(function *functions.Function) (*plpgsql.Block, error) {
+	if function.Language != "plpgsql" {
+		return nil, nil
+	}
+	return plpgsql.Parse(function.Definition, ast.ResolveType)
+}
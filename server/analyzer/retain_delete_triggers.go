@@ -0,0 +1,57 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/triggers"
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+)
+
+// RetainDeleteTriggers prevents a DELETE of every row from being converted into a TRUNCATE when the table has DELETE
+// triggers, as a TRUNCATE does not fire them. The conversion only applies when the DELETE reads directly from the table,
+// so the table is wrapped in a filter that keeps every row.
+func RetainDeleteTriggers(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	return transform.Node(node, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		deleteFrom, ok := node.(*plan.DeleteFrom)
+		if !ok || !deleteFrom.Resolved() {
+			return node, transform.SameTree, nil
+		}
+		table, ok := deleteFrom.Child.(*plan.ResolvedTable)
+		if !ok {
+			return node, transform.SameTree, nil
+		}
+		if _, ok = table.UnwrappedDatabase().(interface{ Schema() string }); !ok {
+			return node, transform.SameTree, nil
+		}
+		tableTriggers, _, err := core.GetTriggersForTable(ctx, table.Database().Name(), doltdb.TableName{Name: table.Name(), Schema: tableSchema(table)})
+		if err != nil {
+			return nil, transform.NewTree, err
+		}
+		for _, trigger := range tableTriggers {
+			if trigger.FiresFor(triggers.Event_Delete, nil) {
+				newNode, err := deleteFrom.WithChildren(plan.NewFilter(pgexprs.NewRawLiteralBool(true), table))
+				return newNode, transform.NewTree, err
+			}
+		}
+		return node, transform.SameTree, nil
+	})
+}
@@ -16,22 +16,27 @@ package analyzer
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/analyzer"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/transform"
 
 	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/exclusions"
+	"github.com/dolthub/doltgresql/core/partitions"
 	"github.com/dolthub/doltgresql/core/sequences"
+	"github.com/dolthub/doltgresql/core/storageparams"
 	pgexprs "github.com/dolthub/doltgresql/server/expression"
 	"github.com/dolthub/doltgresql/server/functions/framework"
 	pgnodes "github.com/dolthub/doltgresql/server/node"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
-// ReplaceSerial replaces a CreateTable node containing a SERIAL type with a node that can create sequences alongside
-// the table.
+// ReplaceSerial replaces a CreateTable node containing a SERIAL type or an identity column with a node that can create
+// sequences alongside the table. The node also handles tables that were given storage parameters or a partition key.
 func ReplaceSerial(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
 	createTable, ok := node.(*plan.CreateTable)
 	if !ok {
@@ -40,83 +45,119 @@ func ReplaceSerial(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope
 
 	var ctSequences []*pgnodes.CreateSequence
 	for _, col := range createTable.PkSchema().Schema {
-		if doltgresType, ok := col.Type.(pgtypes.DoltgresType); ok {
-			isSerial := false
+		var sequence *sequences.Sequence
+		if identity, ok := columnIdentityDefault(col); ok {
+			seqCopy := *identity.Sequence
+			sequence = &seqCopy
+		} else if doltgresType, ok := col.Type.(pgtypes.DoltgresType); ok {
 			var maxValue int64
 			switch doltgresType.BaseID() {
 			case pgtypes.DoltgresTypeBaseID_Int16Serial:
-				isSerial = true
 				col.Type = pgtypes.Int16
 				maxValue = 32767
 			case pgtypes.DoltgresTypeBaseID_Int32Serial:
-				isSerial = true
 				col.Type = pgtypes.Int32
 				maxValue = 2147483647
 			case pgtypes.DoltgresTypeBaseID_Int64Serial:
-				isSerial = true
 				col.Type = pgtypes.Int64
 				maxValue = 9223372036854775807
+			default:
+				continue
 			}
-			if isSerial {
-				baseSequenceName := fmt.Sprintf("%s_%s_seq", createTable.Name(), col.Name)
-				sequenceName := baseSequenceName
-				// TODO: schema name needs to be fetched from the CreateTable node
-				relationType, err := core.GetRelationType(ctx, "", baseSequenceName)
-				if err != nil {
-					return nil, transform.NewTree, err
-				}
-				if relationType != core.RelationType_DoesNotExist {
-					seqIndex := 1
-					for ; seqIndex <= 100; seqIndex++ {
-						sequenceName = fmt.Sprintf("%s%d", baseSequenceName, seqIndex)
-						// TODO: figure out what the schema should be here
-						relationType, err = core.GetRelationType(ctx, "", baseSequenceName)
-						if err != nil {
-							return nil, transform.NewTree, err
-						}
-						if relationType == core.RelationType_DoesNotExist {
-							break
-						}
-					}
-					if seqIndex > 100 {
-						return nil, transform.NewTree, fmt.Errorf("SERIAL sequence name reached max iterations")
-					}
-				}
-				nextVal, ok, err := framework.GetFunction("nextval", pgexprs.NewStringLiteral(sequenceName))
-				if err != nil {
-					return nil, transform.NewTree, err
-				}
-				if !ok {
-					return nil, transform.NewTree, fmt.Errorf(`function "nextval" could not be found for SERIAL default`)
-				}
-				col.Default = &sql.ColumnDefaultValue{
-					Expr:          nextVal,
-					OutType:       pgtypes.Int64,
-					Literal:       false,
-					ReturnNil:     false,
-					Parenthesized: false,
-				}
-				ctSequences = append(ctSequences, pgnodes.NewCreateSequence(false, "", &sequences.Sequence{
-					Name:        sequenceName,
-					DataTypeOID: col.Type.(pgtypes.DoltgresType).OID(),
-					Persistence: sequences.Persistence_Permanent,
-					Start:       1,
-					Current:     1,
-					Increment:   1,
-					Minimum:     1,
-					Maximum:     maxValue,
-					Cache:       1,
-					Cycle:       false,
-					IsAtEnd:     false,
-					OwnerUser:   "",
-					OwnerTable:  createTable.Name(),
-					OwnerColumn: col.Name,
-				}))
+			sequence = &sequences.Sequence{
+				DataTypeOID: col.Type.(pgtypes.DoltgresType).OID(),
+				Persistence: sequences.Persistence_Permanent,
+				Start:       1,
+				Current:     1,
+				Increment:   1,
+				Minimum:     1,
+				Maximum:     maxValue,
+				Cache:       1,
+				Cycle:       false,
+				IsAtEnd:     false,
+				OwnerUser:   "",
 			}
 		}
+		if sequence == nil {
+			continue
+		}
+		sequenceName, err := ownedSequenceName(ctx, createTable.Name(), col.Name)
+		if err != nil {
+			return nil, transform.NewTree, err
+		}
+		nextVal, ok, err := framework.GetFunction("nextval", pgexprs.NewStringLiteral(sequenceName))
+		if err != nil {
+			return nil, transform.NewTree, err
+		}
+		if !ok {
+			return nil, transform.NewTree, fmt.Errorf(`function "nextval" could not be found for SERIAL default`)
+		}
+		col.Default = &sql.ColumnDefaultValue{
+			Expr:          nextVal,
+			OutType:       pgtypes.Int64,
+			Literal:       false,
+			ReturnNil:     false,
+			Parenthesized: false,
+		}
+		sequence.Name = sequenceName
+		sequence.OwnerTable = createTable.Name()
+		sequence.OwnerColumn = col.Name
+		ctSequences = append(ctSequences, pgnodes.NewCreateSequence(false, "", sequence))
 	}
-	if len(ctSequences) == 0 {
+	// Storage parameters, partition keys, and exclusion constraints are also written by our node, so we take them from
+	// the table options
+	storageParams := make(map[string]string)
+	for name, value := range createTable.TableOpts {
+		if paramName, ok := strings.CutPrefix(name, storageparams.TableOptionPrefix); ok {
+			storageParams[paramName] = fmt.Sprint(value)
+		}
+	}
+	partitionKey, err := partitions.TableFromOptions(doltdb.TableName{Name: createTable.Name()}, createTable.TableOpts)
+	if err != nil {
+		return nil, transform.NewTree, err
+	}
+	exclusionConstraints, err := exclusions.ConstraintsFromOptions(doltdb.TableName{Name: createTable.Name()}, createTable.TableOpts)
+	if err != nil {
+		return nil, transform.NewTree, err
+	}
+	if len(ctSequences) == 0 && len(storageParams) == 0 && partitionKey == nil && len(exclusionConstraints) == 0 {
 		return node, transform.SameTree, nil
 	}
-	return pgnodes.NewCreateTable(createTable, ctSequences), transform.NewTree, nil
+	return pgnodes.NewCreateTable(createTable, ctSequences, storageParams, partitionKey, exclusionConstraints), transform.NewTree, nil
+}
+
+// columnIdentityDefault returns the identity default of the column, if the column is an identity column.
+func columnIdentityDefault(col *sql.Column) (*pgexprs.IdentityDefault, bool) {
+	if col.Default == nil {
+		return nil, false
+	}
+	identity, ok := col.Default.Expr.(*pgexprs.IdentityDefault)
+	return identity, ok
+}
+
+// ownedSequenceName returns an unused name for the sequence that generates the values of the given column, following
+// the same naming scheme as Postgres.
+func ownedSequenceName(ctx *sql.Context, tableName string, columnName string) (string, error) {
+	baseSequenceName := fmt.Sprintf("%s_%s_seq", tableName, columnName)
+	sequenceName := baseSequenceName
+	// TODO: schema name needs to be fetched from the CreateTable node
+	relationType, err := core.GetRelationType(ctx, "", baseSequenceName)
+	if err != nil {
+		return "", err
+	}
+	if relationType == core.RelationType_DoesNotExist {
+		return sequenceName, nil
+	}
+	for seqIndex := 1; seqIndex <= 100; seqIndex++ {
+		sequenceName = fmt.Sprintf("%s%d", baseSequenceName, seqIndex)
+		// TODO: figure out what the schema should be here
+		relationType, err = core.GetRelationType(ctx, "", sequenceName)
+		if err != nil {
+			return "", err
+		}
+		if relationType == core.RelationType_DoesNotExist {
+			return sequenceName, nil
+		}
+	}
+	return "", fmt.Errorf("SERIAL sequence name reached max iterations")
 }
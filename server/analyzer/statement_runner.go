@@ -0,0 +1,112 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/planbuilder"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/parser"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/ast"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// AssignStatementRunner gives nodes that execute their own statements, such as COPY FROM, the ability to do so.
+func AssignStatementRunner(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	return transform.Node(node, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		switch node := node.(type) {
+		case *pgnodes.AddConstraintNotValid:
+			return node.WithStatementRunner(NewConvertedStatementRunner(a)), transform.NewTree, nil
+		case *pgnodes.CopyFrom:
+			return node.WithStatementRunner(NewStatementRunner(a)), transform.NewTree, nil
+		case *pgnodes.CreateForeignTable:
+			return node.WithStatementRunner(NewStatementRunner(a)), transform.NewTree, nil
+		case *pgnodes.CreatePartition:
+			return node.WithStatementRunner(NewStatementRunner(a)), transform.NewTree, nil
+		case *pgnodes.DropForeignTable:
+			return node.WithStatementRunner(NewStatementRunner(a)), transform.NewTree, nil
+		case *pgnodes.DropSchema:
+			return node.WithStatementRunner(NewConvertedStatementRunner(a)), transform.NewTree, nil
+		case *pgnodes.DropSequence:
+			return node.WithStatementRunner(NewConvertedStatementRunner(a)), transform.NewTree, nil
+		case *pgnodes.DropTable:
+			return node.WithStatementRunner(NewConvertedStatementRunner(a)), transform.NewTree, nil
+		case *pgnodes.DropView:
+			return node.WithStatementRunner(NewConvertedStatementRunner(a)), transform.NewTree, nil
+		case *pgnodes.ValidateConstraint:
+			return node.WithStatementRunner(NewStatementRunner(a)), transform.NewTree, nil
+		default:
+			return node, transform.SameTree, nil
+		}
+	})
+}
+
+// NewStatementRunner returns a pgnodes.StatementRunner that executes statements using the given analyzer.
+func NewStatementRunner(a *analyzer.Analyzer) pgnodes.StatementRunner {
+	return func(ctx *sql.Context, stmt tree.Statement) (sql.Schema, []sql.Row, error) {
+		query := tree.AsString(stmt)
+		vitessStmt, err := ast.Convert(parser.Statement{AST: stmt, SQL: query})
+		if err != nil {
+			return nil, nil, err
+		}
+		if vitessStmt == nil {
+			return nil, nil, fmt.Errorf("statement is not yet supported: %s", query)
+		}
+		return runConvertedStatement(ctx, a, vitessStmt, query)
+	}
+}
+
+// NewConvertedStatementRunner returns a pgnodes.ConvertedStatementRunner that executes statements using the given
+// analyzer.
+func NewConvertedStatementRunner(a *analyzer.Analyzer) pgnodes.ConvertedStatementRunner {
+	return func(ctx *sql.Context, stmt vitess.Statement, query string) (sql.Schema, []sql.Row, error) {
+		return runConvertedStatement(ctx, a, stmt, query)
+	}
+}
+
+// runConvertedStatement executes the converted statement using the given analyzer.
+func runConvertedStatement(ctx *sql.Context, a *analyzer.Analyzer, vitessStmt vitess.Statement, query string) (sql.Schema, []sql.Row, error) {
+	node, err := planbuilder.New(ctx, a.Catalog, sql.GlobalParser).BindOnly(vitessStmt, query)
+	if err != nil {
+		return nil, nil, err
+	}
+	node, err = a.Analyze(ctx, node, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	// The outer statement handles both the transaction and the process tracking, so we remove them from this one
+	if qp, ok := node.(*plan.QueryProcess); ok {
+		node = qp.Child()
+	}
+	if tc, ok := node.(*plan.TransactionCommittingNode); ok {
+		node = tc.Child()
+	}
+	iter, err := a.ExecBuilder.Build(ctx, node, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	rows, err := sql.RowIterToRows(ctx, iter)
+	if err != nil {
+		return nil, nil, err
+	}
+	return node.Schema(), rows, nil
+}
@@ -0,0 +1,43 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/transform"
+)
+
+// StripQueryHints removes the planner hints from joins when pg_hint_plan.enable_hint is disabled, so that hints given
+// in query comments are only honored when a user has opted into them.
+func StripQueryHints(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope *plan.Scope, selector analyzer.RuleSelector) (sql.Node, transform.TreeIdentity, error) {
+	enabled, err := ctx.GetSessionVariable(ctx, "pg_hint_plan.enable_hint")
+	if err != nil {
+		return nil, transform.SameTree, err
+	}
+	if isEnabled, err := sql.ConvertToBool(ctx, enabled); err != nil {
+		return nil, transform.SameTree, err
+	} else if isEnabled {
+		return node, transform.SameTree, nil
+	}
+	return transform.Node(node, func(node sql.Node) (sql.Node, transform.TreeIdentity, error) {
+		joinNode, ok := node.(*plan.JoinNode)
+		if !ok || len(joinNode.Comment()) == 0 {
+			return node, transform.SameTree, nil
+		}
+		return joinNode.WithComment(""), transform.NewTree, nil
+	})
+}
@@ -46,18 +46,27 @@ func TypeSanitizer(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope
 	if err != nil {
 		return nil, transform.NewTree, err
 	}
-	node, exprsSame, err := transform.NodeExprsWithOpaque(node, func(expr sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+	node, exprsSame, err := transform.NodeExprsWithNodeWithOpaque(node, func(node sql.Node, expr sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
 		// This can be updated if we find more expressions that return GMS types.
 		// These should eventually be replaced with Doltgres-equivalents over time, rendering this function unnecessary.
 		switch expr := expr.(type) {
 		case *expression.Literal:
+			// GMS validates LIMIT and OFFSET as integer literals, which happens again when subqueries are analyzed
+			switch node.(type) {
+			case *plan.Limit, *plan.Offset:
+				return expr, transform.SameTree, nil
+			}
 			return typeSanitizerLiterals(expr)
 		case sql.FunctionExpression:
 			// Compiled functions are Doltgres functions. We're only concerned with GMS functions.
 			if _, ok := expr.(*framework.CompiledFunction); !ok {
+				// Aggregate and window functions are found by the nodes that evaluate them, so they cannot be wrapped
+				if _, ok = expr.(sql.WindowAdaptableExpression); ok {
+					return expr, transform.SameTree, nil
+				}
 				// The COUNT functions cannot be wrapped due to expectations in the analyzer, so we exclude them here.
 				switch expr.FunctionName() {
-				case "Count", "CountDistinct", "GroupConcat", "JSONObjectAgg":
+				case "Count", "CountDistinct", "group_concat", "json_objectagg":
 				default:
 					return pgexprs.NewGMSCast(expr), transform.NewTree, nil
 				}
@@ -91,6 +100,10 @@ func TypeSanitizer(ctx *sql.Context, a *analyzer.Analyzer, node sql.Node, scope
 
 // typeSanitizerLiterals handles literal expressions for TypeSanitizer.
 func typeSanitizerLiterals(gmsLiteral *expression.Literal) (sql.Expression, transform.TreeIdentity, error) {
+	// GMS may fold a Doltgres expression into a literal, such as a cast of a bound parameter, which already has our type
+	if _, ok := gmsLiteral.Type().(pgtypes.DoltgresType); ok {
+		return gmsLiteral, transform.SameTree, nil
+	}
 	switch gmsLiteral.Type().Type() {
 	case query.Type_INT8, query.Type_INT16, query.Type_INT24, query.Type_INT32, query.Type_INT64, query.Type_YEAR, query.Type_ENUM:
 		newVal, _, err := types.Int64.Convert(gmsLiteral.Value())
@@ -0,0 +1,153 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/analyzer"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/functions"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// UserFunctionTable is the table function that calls user-defined functions from the FROM clause. Table functions
+// must be known to the engine by name ahead of time, so calls to user-defined functions are rewritten to call this
+// function, with the schema and name of the user-defined function given as the leading arguments.
+type UserFunctionTable struct {
+	analyzer *analyzer.Analyzer
+	database sql.Database
+}
+
+var _ sql.TableFunction = (*UserFunctionTable)(nil)
+
+// NewUserFunctionTable returns a new *UserFunctionTable that runs the bodies of functions using the given analyzer.
+func NewUserFunctionTable(a *analyzer.Analyzer) *UserFunctionTable {
+	return &UserFunctionTable{analyzer: a}
+}
+
+// NewInstance implements the interface sql.TableFunction.
+func (u *UserFunctionTable) NewInstance(ctx *sql.Context, db sql.Database, args []sql.Expression) (sql.Node, error) {
+	args, definitions := framework.SplitColumnDefinitionList(args)
+	if len(args) < 2 {
+		return nil, fmt.Errorf("%s requires the schema and name of a function", framework.UserFunctionTableName)
+	}
+	schema, err := args[0].Eval(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	name, err := args[1].Eval(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	args = args[2:]
+	collection, err := core.GetFunctionsCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	schemaName, _ := schema.(string)
+	if len(schemaName) == 0 {
+		if schemaName, err = core.GetCurrentSchema(ctx); err != nil {
+			return nil, err
+		}
+	}
+	functionName, _ := name.(string)
+	function := collection.GetFunction(doltdb.TableName{Name: functionName, Schema: schemaName})
+	if function == nil || function.Kind != functions.Kind_Function {
+		argTypes := make([]string, len(args))
+		for i, arg := range args {
+			argTypes[i] = arg.Type().String()
+		}
+		return nil, pgerrors.Raise(ctx, pgerrors.Newf(pgcode.UndefinedFunction,
+			"function %s(%s) does not exist", functionName, strings.Join(argTypes, ", ")))
+	}
+	overloads, err := userFunctionOverloads(function, NewStatementRunner(u.analyzer))
+	if err != nil {
+		return nil, err
+	}
+	compiledFunction := framework.NewCompiledFunctionFromOverloads(function.Name, args, overloads)
+	return framework.NewTableFunction(function.Name, db, compiledFunction, definitions)
+}
+
+// Schema implements the interface sql.Node.
+func (u *UserFunctionTable) Schema() sql.Schema {
+	return nil
+}
+
+// Resolved implements the interface sql.Node.
+func (u *UserFunctionTable) Resolved() bool {
+	return false
+}
+
+// String implements the interface sql.Node.
+func (u *UserFunctionTable) String() string {
+	return framework.UserFunctionTableName + "()"
+}
+
+// Children implements the interface sql.Node.
+func (u *UserFunctionTable) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the interface sql.Node.
+func (u *UserFunctionTable) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(u, len(children), 0)
+	}
+	return u, nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (u *UserFunctionTable) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// IsReadOnly implements the interface sql.Node.
+func (u *UserFunctionTable) IsReadOnly() bool {
+	return true
+}
+
+// Expressions implements the interface sql.Expressioner.
+func (u *UserFunctionTable) Expressions() []sql.Expression {
+	return nil
+}
+
+// WithExpressions implements the interface sql.Expressioner.
+func (u *UserFunctionTable) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	return nil, fmt.Errorf("table function %s has not been called", framework.UserFunctionTableName)
+}
+
+// Name implements the interface sql.Nameable.
+func (u *UserFunctionTable) Name() string {
+	return framework.UserFunctionTableName
+}
+
+// Database implements the interface sql.Databaser.
+func (u *UserFunctionTable) Database() sql.Database {
+	return u.database
+}
+
+// WithDatabase implements the interface sql.Databaser.
+func (u *UserFunctionTable) WithDatabase(database sql.Database) (sql.Node, error) {
+	nu := *u
+	nu.database = database
+	return &nu, nil
+}
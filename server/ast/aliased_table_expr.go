@@ -16,10 +16,14 @@ package ast
 
 import (
 	"fmt"
+	"strings"
 
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
 	"github.com/dolthub/doltgresql/utils"
 )
 
@@ -31,6 +35,62 @@ func nodeAliasedTableExpr(node *tree.AliasedTableExpr) (*vitess.AliasedTableExpr
 	if node.IndexFlags != nil {
 		return nil, fmt.Errorf("index flags are not yet supported")
 	}
+	if tableName, ok := node.Expr.(*tree.TableName); ok {
+		if viewFunction, ok := systemViewFunction(tableName); ok {
+			// System views that are defined over a function read from the function directly, under the view's name
+			viewNode := *node
+			viewNode.Expr = &tree.RowsFromExpr{Items: tree.Exprs{&tree.FuncExpr{Func: tree.WrapFunction(viewFunction)}}}
+			if len(viewNode.As.Alias) == 0 {
+				viewNode.As.Alias = tableName.ObjectName
+			}
+			viewExpr, err := nodeAliasedTableExpr(&viewNode)
+			if err != nil {
+				return nil, err
+			}
+			// System views never reference the preceding tables, so they are not lateral. Filters on the nullable side
+			// of a lateral left join are pushed beneath the join, which would filter the view rather than the join.
+			viewExpr.Lateral = node.Lateral
+			return viewExpr, nil
+		}
+	}
+	if rowsFromExpr, ok := node.Expr.(*tree.RowsFromExpr); ok && len(rowsFromExpr.Items) == 1 {
+		if funcExpr, ok := rowsFromExpr.Items[0].(*tree.FuncExpr); ok {
+			if doltgresFunction, ok := doltTableFunctions[strings.ToLower(funcExpr.Func.String())]; ok {
+				// Dolt's table function is replaced by our own, which is referenced by the name of Dolt's function
+				replacedFuncExpr := *funcExpr
+				replacedFuncExpr.Func = tree.WrapFunction(doltgresFunction)
+				replacedNode := *node
+				replacedNode.Expr = &tree.RowsFromExpr{Items: tree.Exprs{&replacedFuncExpr}}
+				if len(replacedNode.As.Alias) == 0 {
+					replacedNode.As.Alias = tree.Name(funcExpr.Func.String())
+				}
+				return nodeAliasedTableExpr(&replacedNode)
+			}
+		}
+	}
+	var rowType string
+	if rowsFromExpr, ok := node.Expr.(*tree.RowsFromExpr); ok && len(rowsFromExpr.Items) == 1 {
+		if funcExpr, ok := rowsFromExpr.Items[0].(*tree.FuncExpr); ok {
+			if toRecordFunction, ok := populateRecordFunctions[strings.ToLower(funcExpr.Func.String())]; ok {
+				// The populate functions are the same as the to_record functions, except that the columns are taken
+				// from the row type of the given table rather than a column definition list
+				replacedFuncExpr, typeName, err := nodePopulateRecordFunction(funcExpr, toRecordFunction)
+				if err != nil {
+					return nil, err
+				}
+				if len(node.As.ColTypes) > 0 {
+					return nil, fmt.Errorf("a column definition list is redundant for a function returning a named composite type")
+				}
+				replacedNode := *node
+				replacedNode.Expr = &tree.RowsFromExpr{Items: tree.Exprs{replacedFuncExpr}}
+				if len(replacedNode.As.Alias) == 0 {
+					replacedNode.As.Alias = tree.Name(funcExpr.Func.String())
+				}
+				node = &replacedNode
+				rowType = typeName
+			}
+		}
+	}
 	var aliasExpr vitess.SimpleTableExpr
 	switch expr := node.Expr.(type) {
 	case *tree.TableName:
@@ -39,6 +99,16 @@ func nodeAliasedTableExpr(node *tree.AliasedTableExpr) (*vitess.AliasedTableExpr
 		if err != nil {
 			return nil, err
 		}
+	case *tree.Subquery:
+		subquery, err := nodeSubquery(expr)
+		if err != nil {
+			return nil, err
+		}
+		if len(node.As.ColTypes) > 0 {
+			return nil, fmt.Errorf(`a column definition list is only allowed for functions returning "record"`)
+		}
+		subquery.Columns = nodeAliasColumns(node.As)
+		aliasExpr = subquery
 	default:
 		tableExpr, err := nodeTableExpr(expr)
 		if err != nil {
@@ -49,24 +119,229 @@ func nodeAliasedTableExpr(node *tree.AliasedTableExpr) (*vitess.AliasedTableExpr
 				From: vitess.TableExprs{tableExpr},
 			},
 		}
-		//TODO: make sure that this actually works
-		if len(node.As.Cols) > 0 {
-			columns := make([]vitess.ColIdent, len(node.As.Cols))
-			for i := range node.As.Cols {
-				columns[i] = vitess.NewColIdent(string(node.As.Cols[i]))
+		funcExpr, isOurFunction := aliasedFuncExpr(tableExpr)
+		if len(node.As.ColTypes) > 0 && !isOurFunction {
+			return nil, fmt.Errorf(`a column definition list is only allowed for functions returning "record"`)
+		}
+		if isOurFunction && (len(node.As.Alias) > 0 || len(node.As.Cols) > 0) {
+			// Our functions receive the alias clause as their last argument, as the column definition list determines
+			// the columns of functions that return records
+			definitions, err := nodeColumnDefinitionList(node.As)
+			if err != nil {
+				return nil, err
 			}
-			subquery.Columns = columns
+			definitions.RowType = rowType
+			funcExpr.Exprs = append(funcExpr.Exprs, &vitess.AliasedExpr{
+				Expr: vitess.InjectedExpr{Expression: definitions},
+			})
+		} else {
+			//TODO: make sure that this actually works
+			subquery.Columns = nodeAliasColumns(node.As)
 		}
 		aliasExpr = subquery
 	}
 	alias := string(node.As.Alias)
 	if len(alias) == 0 {
+		// Functions in the FROM clause are referenced by their name when they haven't been given an alias
+		if rowsFromExpr, ok := node.Expr.(*tree.RowsFromExpr); ok && len(rowsFromExpr.Items) == 1 {
+			if funcExpr, ok := rowsFromExpr.Items[0].(*tree.FuncExpr); ok {
+				alias = funcExpr.Func.String()
+			}
+		}
+	}
+	if _, ok := node.Expr.(*tree.TableName); !ok && len(alias) == 0 {
+		// Tables are referenced by their name, while everything else requires an alias
 		alias = utils.GenerateUniqueAlias()
 	}
+	// Functions in the FROM clause may always reference the columns of the preceding tables
+	_, isFunction := node.Expr.(*tree.RowsFromExpr)
 	return &vitess.AliasedTableExpr{
 		Expr:    aliasExpr,
 		As:      vitess.NewTableIdent(alias),
 		AsOf:    nil,
-		Lateral: node.Lateral,
+		Lateral: node.Lateral || isFunction,
 	}, nil
 }
+
+// nodeAliasColumns returns the column names from the given alias clause, which rename the columns of a table expression.
+func nodeAliasColumns(node tree.AliasClause) vitess.Columns {
+	if len(node.Cols) == 0 {
+		return nil
+	}
+	columns := make(vitess.Columns, len(node.Cols))
+	for i := range node.Cols {
+		columns[i] = vitess.NewColIdent(string(node.Cols[i]))
+	}
+	return columns
+}
+
+// systemViews maps the system views that are implemented by a set-returning function to the name of the function.
+var systemViews = map[string]string{
+	"dolt_reflog":              "dolt_reflog",
+	"dolt_staged":              "dolt_staged_list",
+	"dolt_stashes":             "dolt_stash_list",
+	"dolt_unstaged":            "dolt_unstaged_list",
+	"pg_am":                    "pg_am_list",
+	"pg_attrdef":               "pg_attrdef_list",
+	"pg_attribute":             "pg_attribute_list",
+	"pg_auth_members":          "pg_auth_member_list",
+	"pg_cast":                  "pg_cast_list",
+	"pg_class":                 "pg_class_list",
+	"pg_collation":             "pg_collation_list",
+	"pg_constraint":            "pg_constraint_list",
+	"pg_cursors":               "pg_cursor",
+	"pg_database":              "pg_database_list",
+	"pg_enum":                  "pg_enum_list",
+	"pg_index":                 "pg_index_list",
+	"pg_inherits":              "pg_inherits_list",
+	"pg_locks":                 "pg_lock_list",
+	"pg_namespace":             "pg_namespace_list",
+	"pg_operator":              "pg_operator_list",
+	"pg_policy":                "pg_policy_list",
+	"pg_prepared_statements":   "pg_prepared_statement",
+	"pg_proc":                  "pg_proc_list",
+	"pg_publication":           "pg_publication_list",
+	"pg_publication_namespace": "pg_publication_namespace_list",
+	"pg_publication_rel":       "pg_publication_rel_list",
+	"pg_publication_tables":    "pg_publication_table_list",
+	"pg_range":                 "pg_range_list",
+	"pg_roles":                 "pg_role_list",
+	"pg_sequences":             "pg_sequence_list",
+	"pg_settings":              "pg_settings_list",
+	"pg_stat_user_indexes":     "pg_stat_user_index_list",
+	"pg_stat_user_tables":      "pg_stat_user_table_list",
+	"pg_statio_user_indexes":   "pg_statio_user_index_list",
+	"pg_statio_user_tables":    "pg_statio_user_table_list",
+	"pg_statistic_ext":         "pg_statistic_ext_list",
+	"pg_tablespace":            "pg_tablespace_list",
+	"pg_type":                  "pg_type_list",
+}
+
+// doltTableFunctions maps the table functions of Dolt that are replaced by our own functions to the name of our function.
+// These produce statements, which must be written for Postgres rather than MySQL.
+var doltTableFunctions = map[string]string{
+	"dolt_patch":       "doltgres_patch",
+	"dolt_query_diff":  framework.QueryDiffTableName,
+	"dolt_schema_diff": "doltgres_schema_diff",
+}
+
+// populateRecordFunctions maps the functions that populate the row type of a table to the function that takes the same
+// JSON value alongside a column definition list.
+var populateRecordFunctions = map[string]string{
+	"json_populate_record":     "json_to_record",
+	"json_populate_recordset":  "json_to_recordset",
+	"jsonb_populate_record":    "jsonb_to_record",
+	"jsonb_populate_recordset": "jsonb_to_recordset",
+}
+
+// nodePopulateRecordFunction returns the call to the given to_record function that replaces the call to a populate
+// function, along with the name of the table whose row type is populated. The base row must be a NULL that is cast to
+// the row type, as the fields of a base row are not yet supported.
+func nodePopulateRecordFunction(funcExpr *tree.FuncExpr, toRecordFunction string) (*tree.FuncExpr, string, error) {
+	if len(funcExpr.Exprs) != 2 {
+		return nil, "", fmt.Errorf("function %s expects 2 arguments", funcExpr.Func.String())
+	}
+	castExpr, ok := funcExpr.Exprs[0].(*tree.CastExpr)
+	if !ok {
+		return nil, "", fmt.Errorf("%s requires the base row to be cast to a table's row type", funcExpr.Func.String())
+	}
+	typeName, ok := castExpr.Type.(*tree.UnresolvedObjectName)
+	if !ok || typeName.NumParts != 1 {
+		return nil, "", fmt.Errorf("%s requires the base row to be cast to a table's row type", funcExpr.Func.String())
+	}
+	if castExpr.Expr != tree.DNull {
+		return nil, "", fmt.Errorf("%s with a non-NULL base row is not yet supported", funcExpr.Func.String())
+	}
+	replacedFuncExpr := *funcExpr
+	replacedFuncExpr.Func = tree.WrapFunction(toRecordFunction)
+	replacedFuncExpr.Exprs = tree.Exprs{funcExpr.Exprs[1]}
+	return &replacedFuncExpr, typeName.Parts[0], nil
+}
+
+// informationSchemaViews maps the views of information_schema that are implemented by a set-returning function to the
+// name of the function. These views must always be qualified by their schema.
+var informationSchemaViews = map[string]string{
+	"columns":                 "column_list",
+	"key_column_usage":        "key_column_usage_list",
+	"referential_constraints": "referential_constraint_list",
+	"role_table_grants":       "role_table_grant_list",
+	"routines":                "routine_list",
+	"table_constraints":       "table_constraint_list",
+	"table_privileges":        "table_privilege_list",
+	"tables":                  "table_list",
+	"views":                   "view_list",
+}
+
+// systemViewFunction returns the function that implements the given table name, if it refers to a system view that is
+// implemented by a function.
+func systemViewFunction(tableName *tree.TableName) (string, bool) {
+	if tableName.ExplicitCatalog {
+		return "", false
+	}
+	if tableName.ExplicitSchema && string(tableName.SchemaName) == "information_schema" {
+		viewFunction, ok := informationSchemaViews[string(tableName.ObjectName)]
+		return viewFunction, ok
+	}
+	if tableName.ExplicitSchema && string(tableName.SchemaName) != "pg_catalog" {
+		return "", false
+	}
+	viewFunction, ok := systemViews[string(tableName.ObjectName)]
+	return viewFunction, ok
+}
+
+// aliasedFuncExpr returns the function call when the given table expression was produced from a function in the FROM
+// clause, along with whether the function is one of our functions (rather than a table function from Dolt).
+func aliasedFuncExpr(tableExpr vitess.TableExpr) (*vitess.FuncExpr, bool) {
+	valuesStatement, ok := tableExpr.(*vitess.ValuesStatement)
+	if !ok || len(valuesStatement.Columns) != 0 || len(valuesStatement.Rows) != 1 || len(valuesStatement.Rows[0]) != 1 {
+		return nil, false
+	}
+	// User-defined functions are called through a table function that is given the function's schema and name
+	if injectedExpr, ok := valuesStatement.Rows[0][0].(vitess.InjectedExpr); ok {
+		unresolved, ok := injectedExpr.Expression.(*pgexprs.UnresolvedFunction)
+		if !ok {
+			return nil, false
+		}
+		exprs := vitess.SelectExprs{
+			&vitess.AliasedExpr{Expr: vitess.InjectedExpr{Expression: pgexprs.NewStringLiteral(unresolved.Schema())}},
+			&vitess.AliasedExpr{Expr: vitess.InjectedExpr{Expression: pgexprs.NewStringLiteral(unresolved.Name())}},
+		}
+		for _, child := range injectedExpr.Children {
+			exprs = append(exprs, &vitess.AliasedExpr{Expr: child})
+		}
+		funcExpr := &vitess.FuncExpr{Name: vitess.NewColIdent(framework.UserFunctionTableName), Exprs: exprs}
+		valuesStatement.Rows[0][0] = funcExpr
+		return funcExpr, true
+	}
+	funcExpr, ok := valuesStatement.Rows[0][0].(*vitess.FuncExpr)
+	if !ok {
+		return nil, false
+	}
+	if name := funcExpr.Name.Lowered(); name == framework.UserFunctionTableName || name == framework.QueryDiffTableName {
+		return funcExpr, true
+	}
+	_, isOurFunction := framework.Catalog[funcExpr.Name.Lowered()]
+	return funcExpr, isOurFunction
+}
+
+// nodeColumnDefinitionList converts the alias clause of a function in the FROM clause to a column definition list.
+func nodeColumnDefinitionList(node tree.AliasClause) (*framework.ColumnDefinitionList, error) {
+	definitions := &framework.ColumnDefinitionList{
+		Alias: string(node.Alias),
+		Names: make([]string, len(node.Cols)),
+	}
+	for i := range node.Cols {
+		definitions.Names[i] = string(node.Cols[i])
+	}
+	if len(node.ColTypes) > 0 {
+		definitions.Types = make([]pgtypes.DoltgresType, len(node.ColTypes))
+		for i, colType := range node.ColTypes {
+			_, resolvedType, err := nodeResolvableTypeReference(colType)
+			if err != nil {
+				return nil, err
+			}
+			definitions.Types[i] = resolvedType
+		}
+	}
+	return definitions, nil
+}
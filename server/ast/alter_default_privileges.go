@@ -0,0 +1,50 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+	"strings"
+
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/privilege"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/auth"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// nodeAlterDefaultPrivileges handles *tree.AlterDefaultPrivileges nodes.
+func nodeAlterDefaultPrivileges(node *tree.AlterDefaultPrivileges) (vitess.Statement, error) {
+	if node == nil {
+		return nil, nil
+	}
+	if node.Target.TargetType != privilege.Table {
+		return nil, fmt.Errorf("ALTER DEFAULT PRIVILEGES on %sS is not yet supported",
+			strings.ToUpper(string(node.Target.TargetType)))
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewAlterDefaultPrivileges(
+			node.TargetRoles,
+			node.Target.InSchema,
+			auth.ObjectKind_Table,
+			node.Privileges,
+			node.Grantees,
+			node.Grant,
+			node.GrantOption,
+		),
+		Children: nil,
+	}, nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// nodeAlterPublication handles *tree.AlterPublication nodes.
+func nodeAlterPublication(node *tree.AlterPublication) (vitess.Statement, error) {
+	if node == nil {
+		return nil, nil
+	}
+	tables, err := nodePublicationTables(node.Tables)
+	if err != nil {
+		return nil, err
+	}
+	alter := pgnodes.NewAlterPublication(string(node.Name))
+	switch node.Action {
+	case tree.AlterPublicationAddTable:
+		alter.AddTables = tables
+	case tree.AlterPublicationDropTable:
+		alter.DropTables = tables
+	case tree.AlterPublicationSetTable:
+		alter.SetTables = tables
+		alter.ReplaceTables = true
+	case tree.AlterPublicationSetParams:
+		alter.Params = nodePublicationParams(node.Params)
+	case tree.AlterPublicationRename:
+		alter.NewName = string(node.NewName)
+	case tree.AlterPublicationOwner:
+		alter.Owner = node.Owner
+	default:
+		return nil, fmt.Errorf("unknown ALTER PUBLICATION action")
+	}
+	return vitess.InjectedStatement{
+		Statement: alter,
+		Children:  nil,
+	}, nil
+}
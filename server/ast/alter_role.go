@@ -1,4 +1,4 @@
-// Copyright 2023 Dolthub, Inc.
+// Copyright 2024 Dolthub, Inc.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -15,11 +15,10 @@
 package ast
 
 import (
-	"fmt"
-
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeAlterRole handles *tree.AlterRole nodes.
@@ -27,5 +26,26 @@ func nodeAlterRole(node *tree.AlterRole) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	return nil, fmt.Errorf("ALTER ROLE is not yet supported")
+	name, err := nodeRoleName(node.Name)
+	if err != nil {
+		return nil, err
+	}
+	if node.NewName != nil {
+		newName, err := nodeRoleName(node.NewName)
+		if err != nil {
+			return nil, err
+		}
+		return vitess.InjectedStatement{
+			Statement: pgnodes.NewRenameRole(name, newName),
+			Children:  nil,
+		}, nil
+	}
+	options, err := nodeRoleOptions(node.KVOptions)
+	if err != nil {
+		return nil, err
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewAlterRole(name, node.IfExists, options),
+		Children:  nil,
+	}, nil
 }
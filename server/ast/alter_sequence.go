@@ -20,6 +20,8 @@ import (
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
 // nodeAlterSequence handles *tree.AlterSequence nodes.
@@ -27,5 +29,76 @@ func nodeAlterSequence(node *tree.AlterSequence) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	return nil, fmt.Errorf("ALTER SEQUENCE is not yet supported")
+	if node.SetLog {
+		return nil, fmt.Errorf("ALTER SEQUENCE SET LOGGED and SET UNLOGGED are not yet supported")
+	}
+	if len(node.Owner) > 0 {
+		return nil, fmt.Errorf("ALTER SEQUENCE OWNER TO is not yet supported")
+	}
+	name, err := nodeUnresolvedObjectName(node.Name)
+	if err != nil {
+		return nil, err
+	}
+	if len(name.DbQualifier.String()) > 0 {
+		return nil, fmt.Errorf("ALTER SEQUENCE is currently only supported for the current database")
+	}
+	var options pgnodes.AlterSequenceOptions
+	seen := make(map[string]struct{})
+	for _, option := range node.Options {
+		// CYCLE and NO CYCLE set the same option
+		optionName := option.Name
+		if optionName == tree.SeqOptNoCycle {
+			optionName = tree.SeqOptCycle
+		}
+		if _, ok := seen[optionName]; ok {
+			return nil, fmt.Errorf("conflicting or redundant options")
+		}
+		seen[optionName] = struct{}{}
+		switch option.Name {
+		case tree.SeqOptAs:
+			_, dataType, err := nodeResolvableTypeReference(option.AsType)
+			if err != nil {
+				return nil, err
+			}
+			switch dataType.BaseID() {
+			case pgtypes.DoltgresTypeBaseID_Int16, pgtypes.DoltgresTypeBaseID_Int32, pgtypes.DoltgresTypeBaseID_Int64:
+				options.DataTypeOID = dataType.OID()
+			default:
+				return nil, fmt.Errorf("sequence type must be smallint, integer, or bigint")
+			}
+		case tree.SeqOptCycle:
+			cycle := true
+			options.Cycle = &cycle
+		case tree.SeqOptNoCycle:
+			cycle := false
+			options.Cycle = &cycle
+		case tree.SeqOptOwnedBy:
+			options.SetOwner = true
+			options.OwnerTable, options.OwnerColumn, err = nodeSequenceOwner(option.ColumnItemVal)
+			if err != nil {
+				return nil, err
+			}
+		case tree.SeqOptCache:
+			options.Cache = option.IntVal
+		case tree.SeqOptIncrement:
+			options.Increment = option.IntVal
+		case tree.SeqOptMinValue:
+			options.SetMinimum = true
+			options.Minimum = option.IntVal
+		case tree.SeqOptMaxValue:
+			options.SetMaximum = true
+			options.Maximum = option.IntVal
+		case tree.SeqOptStart:
+			options.Start = option.IntVal
+		case tree.SeqOptRestart:
+			options.Restart = true
+			options.RestartValue = option.IntVal
+		default:
+			return nil, fmt.Errorf("unknown ALTER SEQUENCE option")
+		}
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewAlterSequence(node.IfExists, name.SchemaQualifier.String(), name.Name.String(), options),
+		Children:  nil,
+	}, nil
 }
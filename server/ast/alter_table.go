@@ -19,15 +19,307 @@ import (
 
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
+	"github.com/dolthub/doltgresql/core/storageparams"
+	"github.com/dolthub/doltgresql/core/unvalidated"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
 // nodeAlterTable handles *tree.AlterTable nodes.
-func nodeAlterTable(node *tree.AlterTable) (*vitess.AlterTable, error) {
+func nodeAlterTable(node *tree.AlterTable) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	return nil, fmt.Errorf("ALTER TABLE is not yet supported")
+	if node.IfExists {
+		return nil, fmt.Errorf("IF EXISTS for ALTER TABLE is not yet supported")
+	}
+	treeTableName := node.Table.ToTableName()
+	tableName, err := nodeTableName(&treeTableName)
+	if err != nil {
+		return nil, err
+	}
+	if len(node.Cmds) == 1 {
+		switch cmd := node.Cmds[0].(type) {
+		case *tree.AlterTableValidateConstraint:
+			if len(tableName.DbQualifier.String()) > 0 {
+				return nil, fmt.Errorf("VALIDATE CONSTRAINT is currently only supported for the current database")
+			}
+			return vitess.InjectedStatement{
+				Statement: pgnodes.NewValidateConstraint(tableName.SchemaQualifier.String(), tableName.Name.String(),
+					string(cmd.Constraint)),
+				Children: nil,
+			}, nil
+		case *tree.AlterTableSetStorage:
+			return nodeAlterTableSetStorage(cmd, tableName)
+		case *tree.AlterTableAddConstraint:
+			if excludeDef, ok := cmd.ConstraintDef.(*tree.ExcludeConstraintTableDef); ok {
+				return nodeAlterTableAddExclusion(cmd, excludeDef, tableName)
+			}
+			if cmd.ValidationBehavior == tree.ValidationSkip {
+				switch cmd.ConstraintDef.(type) {
+				case *tree.CheckConstraintTableDef, *tree.ForeignKeyConstraintTableDef:
+					return nodeAlterTableAddConstraintNotValid(cmd, tableName)
+				}
+			}
+		}
+	}
+	statements := make([]*vitess.DDL, len(node.Cmds))
+	for i, cmd := range node.Cmds {
+		switch cmd := cmd.(type) {
+		case *tree.AlterTableAddConstraint:
+			statements[i], err = nodeAlterTableAddConstraint(cmd, tableName, false)
+		case *tree.AlterTableAddColumn:
+			statements[i], err = nodeAlterTableAddColumn(cmd, tableName)
+		case *tree.AlterTableDropColumn:
+			statements[i], err = nodeAlterTableDropColumn(cmd, tableName)
+		case *tree.AlterTableRenameColumn:
+			statements[i] = &vitess.DDL{
+				Action:       vitess.AlterStr,
+				ColumnAction: vitess.RenameStr,
+				Table:        tableName,
+				Column:       vitess.NewColIdent(string(cmd.Column)),
+				ToColumn:     vitess.NewColIdent(string(cmd.NewName)),
+			}
+		case *tree.AlterTableSetDefault:
+			statements[i], err = nodeAlterTableSetDefault(cmd, tableName)
+		case *tree.AlterTableAlterColumnType:
+			statements[i], err = nodeAlterTableAlterColumnType(cmd, tableName)
+		case *tree.AlterTableSetNotNull:
+			statements[i] = nodeAlterTableNullability(cmd.Column, true, tableName)
+		case *tree.AlterTableDropNotNull:
+			statements[i] = nodeAlterTableNullability(cmd.Column, false, tableName)
+		case *tree.AlterTableValidateConstraint:
+			return nil, fmt.Errorf("VALIDATE CONSTRAINT alongside other ALTER TABLE commands is not yet supported")
+		case *tree.AlterTableSetStorage:
+			return nil, fmt.Errorf("storage parameters alongside other ALTER TABLE commands are not yet supported")
+		default:
+			return nil, fmt.Errorf("ALTER TABLE with the given command is not yet supported")
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &vitess.AlterTable{
+		Table:      tableName,
+		Statements: statements,
+	}, nil
+}
+
+// nodeAlterTableAddColumn handles *tree.AlterTableAddColumn nodes.
+func nodeAlterTableAddColumn(node *tree.AlterTableAddColumn, tableName vitess.TableName) (*vitess.DDL, error) {
+	if node.IfNotExists {
+		return nil, fmt.Errorf("IF NOT EXISTS for ADD COLUMN is not yet supported")
+	}
+	ddl := &vitess.DDL{
+		Action:       vitess.AlterStr,
+		ColumnAction: vitess.AddStr,
+		Table:        tableName,
+		Column:       vitess.NewColIdent(string(node.ColumnDef.Name)),
+	}
+	if err := assignTableDef(node.ColumnDef, ddl); err != nil {
+		return nil, err
+	}
+	if len(ddl.TableSpec.Constraints) > 0 {
+		// TODO: GMS only reads the column from the table spec, so a REFERENCES on the new column would be ignored
+		return nil, fmt.Errorf("REFERENCES on a column added using ALTER TABLE is not yet supported")
+	}
+	return ddl, nil
+}
+
+// nodeAlterTableDropColumn handles *tree.AlterTableDropColumn nodes.
+func nodeAlterTableDropColumn(node *tree.AlterTableDropColumn, tableName vitess.TableName) (*vitess.DDL, error) {
+	if node.IfExists {
+		return nil, fmt.Errorf("IF EXISTS for DROP COLUMN is not yet supported")
+	}
+	if node.DropBehavior == tree.DropCascade {
+		return nil, fmt.Errorf("CASCADE is not yet supported")
+	}
+	return &vitess.DDL{
+		Action:       vitess.AlterStr,
+		ColumnAction: vitess.DropStr,
+		Table:        tableName,
+		Column:       vitess.NewColIdent(string(node.Column)),
+	}, nil
+}
+
+// nodeAlterTableSetDefault handles *tree.AlterTableSetDefault nodes. A missing default represents DROP DEFAULT.
+func nodeAlterTableSetDefault(node *tree.AlterTableSetDefault, tableName vitess.TableName) (*vitess.DDL, error) {
+	if node.Default == nil {
+		return &vitess.DDL{
+			Action: vitess.AlterStr,
+			Table:  tableName,
+			DefaultSpec: &vitess.DefaultSpec{
+				Action: vitess.DropStr,
+				Column: vitess.NewColIdent(string(node.Column)),
+			},
+		}, nil
+	}
+	defaultExpr, err := nodeExpr(node.Default)
+	if err != nil {
+		return nil, err
+	}
+	return &vitess.DDL{
+		Action: vitess.AlterStr,
+		Table:  tableName,
+		DefaultSpec: &vitess.DefaultSpec{
+			Action: vitess.SetStr,
+			Column: vitess.NewColIdent(string(node.Column)),
+			Value:  defaultExpr,
+		},
+	}, nil
+}
+
+// nodeAlterTableAlterColumnType handles *tree.AlterTableAlterColumnType nodes. The column keeps its nullability and
+// default, which are read from the table during analysis. The USING expression is given as the column's default, as it
+// must be resolved against the table's existing columns. The new column is left unnamed so that it does not hide the
+// existing column of the same name while the expression is resolved.
+func nodeAlterTableAlterColumnType(node *tree.AlterTableAlterColumnType, tableName vitess.TableName) (*vitess.DDL, error) {
+	if len(node.Collation) > 0 {
+		return nil, fmt.Errorf("COLLATE is not yet supported")
+	}
+	convertType, resolvedType, err := nodeResolvableTypeReference(node.ToType)
+	if err != nil {
+		return nil, err
+	}
+	var using vitess.Expr
+	if node.Using != nil {
+		usingExpr, err := nodeExpr(node.Using)
+		if err != nil {
+			return nil, err
+		}
+		using = &vitess.ParenExpr{Expr: usingExpr}
+	}
+	return &vitess.DDL{
+		Action:       vitess.AlterStr,
+		ColumnAction: vitess.ModifyStr,
+		Table:        tableName,
+		Column:       vitess.NewColIdent(string(node.Column)),
+		TableSpec: &vitess.TableSpec{
+			Columns: []*vitess.ColumnDefinition{{
+				Name: vitess.NewColIdent(""),
+				Type: vitess.ColumnType{
+					Type:         convertType.Type,
+					ResolvedType: resolvedType,
+					Length:       convertType.Length,
+					Scale:        convertType.Scale,
+					Default:      using,
+				},
+			}},
+		},
+	}, nil
+}
+
+// nodeAlterTableNullability handles SET NOT NULL and DROP NOT NULL. The column's type is not known until the table has
+// been resolved, so the column is given the unknown type, which signals that the existing type is kept.
+func nodeAlterTableNullability(column tree.Name, notNull bool, tableName vitess.TableName) *vitess.DDL {
+	return &vitess.DDL{
+		Action:       vitess.AlterStr,
+		ColumnAction: vitess.ModifyStr,
+		Table:        tableName,
+		Column:       vitess.NewColIdent(string(column)),
+		TableSpec: &vitess.TableSpec{
+			Columns: []*vitess.ColumnDefinition{{
+				Name: vitess.NewColIdent(string(column)),
+				Type: vitess.ColumnType{
+					Type:         "unknown",
+					ResolvedType: pgtypes.Unknown,
+					Null:         vitess.BoolVal(!notNull),
+					NotNull:      vitess.BoolVal(notNull),
+				},
+			}},
+		},
+	}
+}
+
+// nodeAlterTableAddConstraint handles *tree.AlterTableAddConstraint nodes. The notValid parameter states whether the
+// caller handles NOT VALID by skipping the existing rows.
+func nodeAlterTableAddConstraint(node *tree.AlterTableAddConstraint, tableName vitess.TableName, notValid bool) (*vitess.DDL, error) {
+	if node == nil {
+		return nil, nil
+	}
+	ddl := &vitess.DDL{
+		Action: vitess.AlterStr,
+		Table:  tableName,
+	}
+	switch constraintDef := node.ConstraintDef.(type) {
+	case *tree.CheckConstraintTableDef, *tree.ForeignKeyConstraintTableDef:
+		// NOT VALID is handled separately when it's the only command, as the constraint must skip the existing rows
+		if node.ValidationBehavior == tree.ValidationSkip && !notValid {
+			return nil, fmt.Errorf("NOT VALID alongside other ALTER TABLE commands is not yet supported")
+		}
+		if err := assignTableDef(constraintDef, ddl); err != nil {
+			return nil, err
+		}
+		ddl.ConstraintAction = vitess.AddStr
+		return ddl, nil
+	case *tree.UniqueConstraintTableDef:
+		if node.ValidationBehavior == tree.ValidationSkip {
+			if constraintDef.PrimaryKey {
+				return nil, fmt.Errorf("PRIMARY KEY constraints cannot be marked NOT VALID")
+			}
+			return nil, fmt.Errorf("UNIQUE constraints cannot be marked NOT VALID")
+		}
+		// TODO: Dolt's index creation on existing tables does not yet handle schemas
+		return nil, fmt.Errorf("adding UNIQUE and PRIMARY KEY constraints using ALTER TABLE is not yet supported")
+	case *tree.ExcludeConstraintTableDef:
+		return nil, fmt.Errorf("exclusion constraints alongside other ALTER TABLE commands are not yet supported")
+	default:
+		return nil, fmt.Errorf("ALTER TABLE with the given constraint is not yet supported")
+	}
+}
+
+// nodeAlterTableAddConstraintNotValid handles *tree.AlterTableAddConstraint nodes that add a CHECK or FOREIGN KEY
+// constraint using NOT VALID. The constraint is added without verifying the existing rows, which are verified later by
+// VALIDATE CONSTRAINT. A CHECK constraint is named here if a name was not given, as the name is needed to track it.
+func nodeAlterTableAddConstraintNotValid(node *tree.AlterTableAddConstraint, tableName vitess.TableName) (vitess.Statement, error) {
+	if len(tableName.DbQualifier.String()) > 0 {
+		return nil, fmt.Errorf("NOT VALID is currently only supported for tables in the current database")
+	}
+	var kind unvalidated.Kind
+	var expression string
+	switch constraintDef := node.ConstraintDef.(type) {
+	case *tree.CheckConstraintTableDef:
+		if len(constraintDef.Name) == 0 {
+			constraintDef.Name = tree.Name(fmt.Sprintf("%s_check", tableName.Name.String()))
+		}
+		kind = unvalidated.Kind_Check
+		expression = tree.AsString(constraintDef.Expr)
+	case *tree.ForeignKeyConstraintTableDef:
+		kind = unvalidated.Kind_ForeignKey
+	}
+	ddl, err := nodeAlterTableAddConstraint(node, tableName, true)
+	if err != nil {
+		return nil, err
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewAddConstraintNotValid(tableName.SchemaQualifier.String(), tableName.Name.String(),
+			ddl.TableSpec.Constraints[0].Name, kind, expression, &vitess.AlterTable{
+				Table:      tableName,
+				Statements: []*vitess.DDL{ddl},
+			}),
+		Children: nil,
+	}, nil
+}
+
+// nodeAlterTableAddExclusion handles *tree.AlterTableAddConstraint nodes that add an exclusion constraint.
+func nodeAlterTableAddExclusion(node *tree.AlterTableAddConstraint, excludeDef *tree.ExcludeConstraintTableDef, tableName vitess.TableName) (vitess.Statement, error) {
+	if len(tableName.DbQualifier.String()) > 0 {
+		return nil, fmt.Errorf("exclusion constraints are currently only supported for tables in the current database")
+	}
+	if node.ValidationBehavior == tree.ValidationSkip {
+		return nil, pgerrors.New(pgcode.FeatureNotSupported, "EXCLUDE constraints cannot be marked NOT VALID")
+	}
+	constraint, err := nodeExcludeConstraintTableDef(excludeDef, tableName.Name.String())
+	if err != nil {
+		return nil, err
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewAddExclusionConstraint(tableName.SchemaQualifier.String(), tableName.Name.String(), constraint),
+		Children:  nil,
+	}, nil
 }
 
 // nodeAlterTableSetSchema handles *tree.AlterTableSetSchema nodes.
@@ -35,5 +327,51 @@ func nodeAlterTableSetSchema(node *tree.AlterTableSetSchema) (vitess.Statement,
 	if node == nil {
 		return nil, nil
 	}
-	return nil, fmt.Errorf("ALTER TABLE SET SCHEMA is not yet supported")
+	if node.IsSequence {
+		return nil, fmt.Errorf("ALTER SEQUENCE SET SCHEMA is not yet supported")
+	}
+	if node.IsMaterialized {
+		return nil, fmt.Errorf("ALTER MATERIALIZED VIEW SET SCHEMA is not yet supported")
+	}
+	treeName := node.Name.ToTableName()
+	tableName, err := nodeTableName(&treeName)
+	if err != nil {
+		return nil, err
+	}
+	if len(tableName.DbQualifier.String()) > 0 {
+		return nil, fmt.Errorf("ALTER TABLE SET SCHEMA is currently only supported for tables in the current database")
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewRenameTable(tableName.SchemaQualifier.String(), tableName.Name.String(),
+			node.Schema, tableName.Name.String(), node.IfExists),
+		Children: nil,
+	}, nil
+}
+
+// nodeAlterTableSetStorage handles *tree.AlterTableSetStorage nodes.
+func nodeAlterTableSetStorage(node *tree.AlterTableSetStorage, tableName vitess.TableName) (vitess.Statement, error) {
+	if len(tableName.DbQualifier.String()) > 0 {
+		return nil, fmt.Errorf("storage parameters are currently only supported for tables in the current database")
+	}
+	if node.IsReset {
+		names := make([]string, len(node.Params))
+		for i, param := range node.Params {
+			if err := storageparams.CheckName(string(param.Key)); err != nil {
+				return nil, err
+			}
+			names[i] = string(param.Key)
+		}
+		return vitess.InjectedStatement{
+			Statement: pgnodes.NewResetStorageParameters(tableName.SchemaQualifier.String(), tableName.Name.String(), names),
+			Children:  nil,
+		}, nil
+	}
+	params, err := nodeStorageParams(node.Params)
+	if err != nil {
+		return nil, err
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewSetStorageParameters(tableName.SchemaQualifier.String(), tableName.Name.String(), params),
+		Children:  nil,
+	}, nil
 }
@@ -0,0 +1,57 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// nodeAlterTablePartition handles *tree.AlterTablePartition nodes.
+func nodeAlterTablePartition(node *tree.AlterTablePartition) (vitess.Statement, error) {
+	if node == nil {
+		return nil, nil
+	}
+	if node.Name.NumParts > 2 {
+		return nil, fmt.Errorf("referencing items outside the database is not yet supported")
+	}
+	tableName := doltdb.TableName{Name: node.Name.Parts[0]}
+	if node.Name.NumParts == 2 {
+		tableName.Schema = node.Name.Parts[1]
+	}
+	if node.IsDetach {
+		if node.DetachType == tree.DetachPartitionFinalize {
+			return nil, fmt.Errorf("DETACH PARTITION ... FINALIZE is not yet supported")
+		}
+		// Detaching only changes the partition's metadata, so CONCURRENTLY behaves the same as a regular detach
+		return vitess.InjectedStatement{
+			Statement: pgnodes.NewDetachPartition(tableName, string(node.Partition), node.IfExists),
+			Children:  nil,
+		}, nil
+	}
+	partition, boundStrategy, err := nodePartitionBoundSpec(node.Spec)
+	if err != nil {
+		return nil, err
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewAttachPartition(tableName, string(node.Partition), partition, boundStrategy, node.IfExists),
+		Children:  nil,
+	}, nil
+}
@@ -17,9 +17,11 @@ package ast
 import (
 	"fmt"
 
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeAnalyze handles *tree.Analyze nodes.
@@ -27,6 +29,15 @@ func nodeAnalyze(node *tree.Analyze) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	// This functions a bit differently compared to the GMS version, so it's unsupported for now
-	return nil, fmt.Errorf("ANALYZE is not yet supported")
+	tables := make([]doltdb.TableName, len(node.Tables))
+	for i, table := range node.Tables {
+		if table.ExplicitCatalog {
+			return nil, fmt.Errorf("referencing items outside the database is not yet supported")
+		}
+		tables[i] = doltdb.TableName{Name: table.Object(), Schema: table.Schema()}
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewAnalyze(tables),
+		Children:  nil,
+	}, nil
 }
@@ -16,41 +16,47 @@ package ast
 
 import (
 	"fmt"
+	"strings"
 
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeBeginTransaction handles *tree.BeginTransaction nodes.
-func nodeBeginTransaction(node *tree.BeginTransaction) (*vitess.Begin, error) {
+func nodeBeginTransaction(node *tree.BeginTransaction) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	if node.Modes.Isolation != tree.UnspecifiedIsolation {
-		return nil, fmt.Errorf("isolation levels are not yet supported")
+	modes, err := nodeTransactionModes(node.Modes)
+	if err != nil {
+		return nil, err
 	}
-	if node.Modes.UserPriority != tree.UnspecifiedUserPriority {
-		return nil, fmt.Errorf("user priority is not yet supported")
-	}
-	if node.Modes.AsOf.Expr != nil {
-		return nil, fmt.Errorf("AS OF is not yet supported")
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewBeginTransaction(modes),
+		Children:  nil,
+	}, nil
+}
+
+// nodeTransactionModes handles tree.TransactionModes nodes. DEFERRABLE only affects transactions that are both
+// SERIALIZABLE and READ ONLY, which never wait for serialization failures here, so it is accepted and ignored.
+func nodeTransactionModes(node tree.TransactionModes) (pgnodes.TransactionModes, error) {
+	if node.UserPriority != tree.UnspecifiedUserPriority {
+		return pgnodes.TransactionModes{}, fmt.Errorf("user priority is not yet supported")
 	}
-	if node.Modes.Deferrable != tree.UnspecifiedDeferrableMode {
-		return nil, fmt.Errorf("deferrability is not yet supported")
+	var modes pgnodes.TransactionModes
+	if node.Isolation != tree.UnspecifiedIsolation {
+		modes.Isolation = strings.ToLower(node.Isolation.String())
 	}
-	var characteristic string
-	switch node.Modes.ReadWriteMode {
+	switch node.ReadWriteMode {
 	case tree.UnspecifiedReadWriteMode:
-		characteristic = vitess.TxReadWrite
 	case tree.ReadOnly:
-		characteristic = vitess.TxReadOnly
+		modes.ReadOnly = "on"
 	case tree.ReadWrite:
-		characteristic = vitess.TxReadWrite
+		modes.ReadOnly = "off"
 	default:
-		return nil, fmt.Errorf("unknown READ/WRITE setting")
+		return pgnodes.TransactionModes{}, fmt.Errorf("unknown READ/WRITE setting")
 	}
-	return &vitess.Begin{
-		TransactionCharacteristic: characteristic,
-	}, nil
+	return modes, nil
 }
@@ -36,7 +36,7 @@ func nodeCall(node *tree.Call) (*vitess.Call, error) {
 	if node.Procedure.WindowDef != nil {
 		return nil, fmt.Errorf("procedure window definitions are not yet supported")
 	}
-	if node.Procedure.AggType != tree.GeneralAgg {
+	if node.Procedure.AggType == tree.OrderedSetAgg {
 		return nil, fmt.Errorf("procedure aggregation is not yet supported")
 	}
 	if len(node.Procedure.OrderBy) > 0 {
@@ -19,7 +19,9 @@ import (
 
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
+	"github.com/dolthub/doltgresql/core/sequences"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
@@ -70,14 +72,15 @@ func nodeColumnTableDef(node *tree.ColumnTableDef) (_ *vitess.ColumnDefinition,
 	}
 	var fkDef *vitess.ForeignKeyDefinition
 	if node.References.Table != nil {
-		if len(node.References.Col) == 0 {
-			return nil, fmt.Errorf("implicit primary key matching on column foreign key is not yet supported")
+		var toCols tree.NameList
+		if len(node.References.Col) > 0 {
+			toCols = tree.NameList{node.References.Col}
 		}
 		fkDef, err = nodeForeignKeyConstraintTableDef(&tree.ForeignKeyConstraintTableDef{
 			Name:     node.References.ConstraintName,
 			Table:    *node.References.Table,
 			FromCols: tree.NameList{node.Name},
-			ToCols:   tree.NameList{node.References.Col},
+			ToCols:   toCols,
 			Actions:  node.References.Actions,
 			Match:    node.References.Match,
 		})
@@ -87,7 +90,18 @@ func nodeColumnTableDef(node *tree.ColumnTableDef) (_ *vitess.ColumnDefinition,
 	}
 	var generated vitess.Expr
 	var generatedStored vitess.BoolVal
-	if node.Computed.Computed {
+	if node.Computed.Computed && node.Computed.Expr == nil {
+		// Identity columns are the only computed columns without an expression
+		if defaultExpr != nil {
+			return nil, fmt.Errorf(`multiple default values specified for column "%s"`, node.Name)
+		}
+		defaultExpr, err = nodeIdentityDefault(node, resolvedType)
+		if err != nil {
+			return nil, err
+		}
+		isNull = false
+		isNotNull = true
+	} else if node.Computed.Computed {
 		generated, err = nodeExpr(node.Computed.Expr)
 		if err != nil {
 			return nil, err
@@ -131,3 +145,33 @@ func nodeColumnTableDef(node *tree.ColumnTableDef) (_ *vitess.ColumnDefinition,
 		},
 	}, nil
 }
+
+// nodeIdentityDefault returns the default value of an identity column, which creates the column's sequence once the
+// table has been created.
+func nodeIdentityDefault(node *tree.ColumnTableDef, resolvedType pgtypes.DoltgresType) (vitess.Expr, error) {
+	if resolvedType == nil {
+		return nil, fmt.Errorf("identity column type was not resolvable")
+	}
+	switch resolvedType.BaseID() {
+	case pgtypes.DoltgresTypeBaseID_Int16, pgtypes.DoltgresTypeBaseID_Int32, pgtypes.DoltgresTypeBaseID_Int64:
+	default:
+		return nil, fmt.Errorf("identity column type must be smallint, integer, or bigint")
+	}
+	sequence, err := nodeSequenceOptions(node.Computed.Options, resolvedType)
+	if err != nil {
+		return nil, err
+	}
+	if len(sequence.OwnerTable) > 0 {
+		return nil, fmt.Errorf("OWNED BY is not supported for identity columns")
+	}
+	sequence.Identity = sequences.Identity_Always
+	if node.Computed.ByDefault {
+		sequence.Identity = sequences.Identity_ByDefault
+	}
+	// Defaults that aren't literals must be parenthesized
+	return &vitess.ParenExpr{
+		Expr: vitess.InjectedExpr{
+			Expression: pgexprs.NewIdentityDefault(sequence, resolvedType),
+		},
+	}, nil
+}
@@ -30,12 +30,16 @@ func Convert(postgresStmt parser.Statement) (vitess.Statement, error) {
 		return nodeAlterAggregate(stmt)
 	case *tree.AlterDatabase:
 		return nodeAlterDatabase(stmt)
+	case *tree.AlterDefaultPrivileges:
+		return nodeAlterDefaultPrivileges(stmt)
 	case *tree.AlterFunction:
 		return nodeAlterFunction(stmt)
 	case *tree.AlterIndex:
 		return nodeAlterIndex(stmt)
 	case *tree.AlterProcedure:
 		return nodeAlterProcedure(stmt)
+	case *tree.AlterPublication:
+		return nodeAlterPublication(stmt)
 	case *tree.AlterRole:
 		return nodeAlterRole(stmt)
 	case *tree.AlterSchema:
@@ -44,6 +48,8 @@ func Convert(postgresStmt parser.Statement) (vitess.Statement, error) {
 		return nodeAlterSequence(stmt)
 	case *tree.AlterTable:
 		return nodeAlterTable(stmt)
+	case *tree.AlterTablePartition:
+		return nodeAlterTablePartition(stmt)
 	case *tree.AlterTableSetSchema:
 		return nodeAlterTableSetSchema(stmt)
 	case *tree.AlterType:
@@ -62,6 +68,8 @@ func Convert(postgresStmt parser.Statement) (vitess.Statement, error) {
 		return nodeCancelSessions(stmt)
 	case *tree.CannedOptPlan:
 		return nodeCannedOptPlan(stmt)
+	case *tree.CloseCursor:
+		return nodeCloseCursor(stmt)
 	case *tree.Comment:
 		return nodeComment(stmt)
 	case *tree.CommitTransaction:
@@ -74,24 +82,34 @@ func Convert(postgresStmt parser.Statement) (vitess.Statement, error) {
 		return nodeControlSchedules(stmt)
 	case *tree.CopyFrom:
 		return nodeCopyFrom(stmt)
+	case *tree.CopyTo:
+		return nodeCopyTo(stmt)
 	case *tree.CreateAggregate:
 		return nodeCreateAggregate(stmt)
 	case *tree.CreateChangefeed:
 		return nodeCreateChangefeed(stmt)
 	case *tree.CreateDatabase:
 		return nodeCreateDatabase(stmt)
+	case *tree.CreateForeignTable:
+		return nodeCreateForeignTable(stmt)
 	case *tree.CreateFunction:
 		return nodeCreateFunction(stmt)
 	case *tree.CreateIndex:
 		return nodeCreateIndex(stmt)
+	case *tree.CreateMaskingPolicy:
+		return nodeCreateMaskingPolicy(stmt)
 	case *tree.CreateProcedure:
 		return nodeCreateProcedure(stmt)
+	case *tree.CreatePublication:
+		return nodeCreatePublication(stmt)
 	case *tree.CreateRole:
 		return nodeCreateRole(stmt)
 	case *tree.CreateSchema:
 		return nodeCreateSchema(stmt)
 	case *tree.CreateSequence:
 		return nodeCreateSequence(stmt)
+	case *tree.CreateServer:
+		return nodeCreateServer(stmt)
 	case *tree.CreateStats:
 		return nodeCreateStats(stmt)
 	case *tree.CreateTable:
@@ -104,6 +122,8 @@ func Convert(postgresStmt parser.Statement) (vitess.Statement, error) {
 		return nodeCreateView(stmt)
 	case *tree.Deallocate:
 		return nodeDeallocate(stmt)
+	case *tree.DeclareCursor:
+		return nodeDeclareCursor(stmt)
 	case *tree.Delete:
 		return nodeDelete(stmt)
 	case *tree.Discard:
@@ -112,14 +132,26 @@ func Convert(postgresStmt parser.Statement) (vitess.Statement, error) {
 		return nodeDropAggregate(stmt)
 	case *tree.DropDatabase:
 		return nodeDropDatabase(stmt)
+	case *tree.DropForeignTable:
+		return nodeDropForeignTable(stmt)
+	case *tree.DropFunction:
+		return nodeDropFunction(stmt)
 	case *tree.DropIndex:
 		return nodeDropIndex(stmt)
+	case *tree.DropMaskingPolicy:
+		return nodeDropMaskingPolicy(stmt)
+	case *tree.DropProcedure:
+		return nodeDropProcedure(stmt)
+	case *tree.DropPublication:
+		return nodeDropPublication(stmt)
 	case *tree.DropRole:
 		return nodeDropRole(stmt)
 	case *tree.DropSchema:
 		return nodeDropSchema(stmt)
 	case *tree.DropSequence:
 		return nodeDropSequence(stmt)
+	case *tree.DropServer:
+		return nodeDropServer(stmt)
 	case *tree.DropTable:
 		return nodeDropTable(stmt)
 	case *tree.DropTrigger:
@@ -136,6 +168,8 @@ func Convert(postgresStmt parser.Statement) (vitess.Statement, error) {
 		return nodeExplainAnalyzeDebug(stmt)
 	case *tree.Export:
 		return nodeExport(stmt)
+	case *tree.FetchCursor:
+		return nodeFetchCursor(stmt)
 	case *tree.Grant:
 		return nodeGrant(stmt)
 	case *tree.GrantRole:
@@ -144,6 +178,14 @@ func Convert(postgresStmt parser.Statement) (vitess.Statement, error) {
 		return nodeImport(stmt)
 	case *tree.Insert:
 		return nodeInsert(stmt)
+	case *tree.Listen:
+		return nodeListen(stmt)
+	case *tree.LockTable:
+		return nodeLockTable(stmt)
+	case *tree.MoveCursor:
+		return nodeMoveCursor(stmt)
+	case *tree.Notify:
+		return nodeNotify(stmt)
 	case *tree.ParenSelect:
 		return nodeParenSelect(stmt)
 	case *tree.Prepare:
@@ -164,6 +206,8 @@ func Convert(postgresStmt parser.Statement) (vitess.Statement, error) {
 		return nodeRenameTable(stmt)
 	case *tree.ReparentDatabase:
 		return nodeReparentDatabase(stmt)
+	case *tree.ResetAll:
+		return nodeResetAll(stmt)
 	case *tree.Restore:
 		return nodeRestore(stmt)
 	case *tree.Revoke:
@@ -183,7 +227,17 @@ func Convert(postgresStmt parser.Statement) (vitess.Statement, error) {
 	case *tree.Scrub:
 		return nodeScrub(stmt)
 	case *tree.Select:
-		return nodeSelect(stmt)
+		// The locking clauses are applied once the statement has been converted, as they're only supported here
+		unlocked := *stmt
+		unlocked.Locking = nil
+		selectStmt, err := nodeSelect(&unlocked)
+		if err != nil {
+			return nil, err
+		}
+		if err = labelResultColumns(stmt, selectStmt); err != nil {
+			return nil, err
+		}
+		return selectStmt, nodeLockingClause(stmt, selectStmt)
 	case *tree.SelectClause:
 		return nodeSelectClause(stmt)
 	case *tree.SetSessionAuthorization:
@@ -262,6 +316,8 @@ func Convert(postgresStmt parser.Statement) (vitess.Statement, error) {
 		return nodeTruncate(stmt)
 	case *tree.UnionClause:
 		return nodeUnionClause(stmt)
+	case *tree.Unlisten:
+		return nodeUnlisten(stmt)
 	case *tree.Unsplit:
 		return nodeUnsplit(stmt)
 	case *tree.Update:
@@ -1,4 +1,4 @@
-// Copyright 2023 Dolthub, Inc.
+// Copyright 2024 Dolthub, Inc.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -20,6 +20,8 @@ import (
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/dataloader"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeCopyFrom handles *tree.CopyFrom nodes.
@@ -27,5 +29,86 @@ func nodeCopyFrom(node *tree.CopyFrom) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	return nil, fmt.Errorf("COPY FROM is not yet supported")
+	if !node.Stdin {
+		return nil, fmt.Errorf("COPY FROM is only supported using STDIN")
+	}
+	options, err := nodeCopyOptions(node.Options)
+	if err != nil {
+		return nil, err
+	}
+	if options.Format == dataloader.Format_Binary {
+		return nil, fmt.Errorf("COPY FROM with the BINARY format is not yet supported")
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewCopyFrom(node.Table, node.Columns, options),
+		Children:  nil,
+	}, nil
+}
+
+// nodeCopyOptions converts the given options to the options that are used by the data loader.
+func nodeCopyOptions(node tree.CopyOptions) (dataloader.Options, error) {
+	if node.Destination != nil {
+		return dataloader.Options{}, fmt.Errorf("COPY DESTINATION is not yet supported")
+	}
+	var options dataloader.Options
+	switch node.CopyFormat {
+	case tree.CopyFormatText:
+		options = dataloader.NewOptions(dataloader.Format_Text)
+	case tree.CopyFormatCSV:
+		options = dataloader.NewOptions(dataloader.Format_CSV)
+	case tree.CopyFormatBinary:
+		// None of the other options apply to the binary format
+		switch {
+		case node.Header:
+			return dataloader.Options{}, fmt.Errorf("cannot specify HEADER in BINARY mode")
+		case node.Delimiter != nil:
+			return dataloader.Options{}, fmt.Errorf("cannot specify DELIMITER in BINARY mode")
+		case node.Null != nil:
+			return dataloader.Options{}, fmt.Errorf("cannot specify NULL in BINARY mode")
+		case node.Quote != nil:
+			return dataloader.Options{}, fmt.Errorf("COPY quote available only in CSV mode")
+		case node.Escape != nil:
+			return dataloader.Options{}, fmt.Errorf("COPY escape available only in CSV mode")
+		}
+		return dataloader.NewOptions(dataloader.Format_Binary), nil
+	default:
+		return dataloader.Options{}, fmt.Errorf("unknown COPY format")
+	}
+	options.Header = node.Header
+	if node.Delimiter != nil {
+		delimiter := node.Delimiter.RawString()
+		if len(delimiter) != 1 {
+			return dataloader.Options{}, fmt.Errorf("COPY delimiter must be a single one-byte character")
+		}
+		options.Delimiter = delimiter[0]
+	}
+	if node.Null != nil {
+		options.Null = node.Null.RawString()
+	}
+	if node.Quote != nil {
+		if options.Format != dataloader.Format_CSV {
+			return dataloader.Options{}, fmt.Errorf("COPY quote available only in CSV mode")
+		}
+		quote := node.Quote.RawString()
+		if len(quote) != 1 {
+			return dataloader.Options{}, fmt.Errorf("COPY quote must be a single one-byte character")
+		}
+		options.Quote = quote[0]
+		// The escape character defaults to the quote character
+		options.Escape = quote[0]
+	}
+	if node.Escape != nil {
+		if options.Format != dataloader.Format_CSV {
+			return dataloader.Options{}, fmt.Errorf("COPY escape available only in CSV mode")
+		}
+		escape := node.Escape.RawString()
+		if len(escape) != 1 {
+			return dataloader.Options{}, fmt.Errorf("COPY escape must be a single one-byte character")
+		}
+		options.Escape = escape[0]
+	}
+	if err := options.Validate(); err != nil {
+		return dataloader.Options{}, err
+	}
+	return options, nil
 }
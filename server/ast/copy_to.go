@@ -0,0 +1,59 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// nodeCopyTo handles *tree.CopyTo nodes.
+func nodeCopyTo(node *tree.CopyTo) (vitess.Statement, error) {
+	if node == nil {
+		return nil, nil
+	}
+	options, err := nodeCopyOptions(node.Options)
+	if err != nil {
+		return nil, err
+	}
+	query := node.Query
+	if query == nil {
+		// Copying from a table is the same as copying the results of a SELECT over its columns
+		exprs := tree.SelectExprs{tree.StarSelectExpr()}
+		if len(node.Columns) > 0 {
+			exprs = make(tree.SelectExprs, len(node.Columns))
+			for i, column := range node.Columns {
+				exprs[i] = tree.SelectExpr{Expr: tree.NewUnresolvedName(string(column))}
+			}
+		}
+		table := node.Table
+		query = &tree.Select{
+			Select: &tree.SelectClause{
+				Exprs: exprs,
+				From:  tree.From{Tables: tree.TableExprs{&table}},
+			},
+		}
+	}
+	selectStmt, err := nodeSelect(query)
+	if err != nil {
+		return nil, err
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewCopyTo(query.String(), selectStmt, options),
+		Children:  nil,
+	}, nil
+}
@@ -16,22 +16,43 @@ package ast
 
 import (
 	"fmt"
+	"strings"
+	"unicode"
 
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/pgerrors"
 )
 
+// serverEncodings maps the names of the encodings that Postgres allows for a database, along with their aliases, to
+// the encoding's canonical name. Names are normalized by removing every character that is not a letter or digit, and
+// lowercasing the rest, which matches how Postgres compares encoding names.
+var serverEncodings = map[string]string{
+	"sqlascii": "SQL_ASCII", "eucjp": "EUC_JP", "euccn": "EUC_CN", "euckr": "EUC_KR", "euctw": "EUC_TW",
+	"eucjis2004": "EUC_JIS_2004", "utf8": "UTF8", "unicode": "UTF8", "muleinternal": "MULE_INTERNAL",
+	"latin1": "LATIN1", "latin2": "LATIN2", "latin3": "LATIN3", "latin4": "LATIN4", "latin5": "LATIN5",
+	"latin6": "LATIN6", "latin7": "LATIN7", "latin8": "LATIN8", "latin9": "LATIN9", "latin10": "LATIN10",
+	"iso88591": "LATIN1", "iso88592": "LATIN2", "iso88593": "LATIN3", "iso88594": "LATIN4", "iso88599": "LATIN5",
+	"iso885910": "LATIN6", "iso885913": "LATIN7", "iso885914": "LATIN8", "iso885915": "LATIN9",
+	"iso885916": "LATIN10", "iso88595": "ISO_8859_5", "iso88596": "ISO_8859_6", "iso88597": "ISO_8859_7",
+	"iso88598": "ISO_8859_8", "koi8": "KOI8R", "koi8r": "KOI8R", "koi8u": "KOI8U", "win866": "WIN866",
+	"alt": "WIN866", "win874": "WIN874", "win1250": "WIN1250", "win1251": "WIN1251", "win": "WIN1251",
+	"win1252": "WIN1252", "win1253": "WIN1253", "win1254": "WIN1254", "win1255": "WIN1255", "win1256": "WIN1256",
+	"win1257": "WIN1257", "win1258": "WIN1258", "abc": "WIN1258", "tcvn": "WIN1258", "tcvn5712": "WIN1258",
+	"vscii": "WIN1258", "windows866": "WIN866", "windows874": "WIN874", "windows1250": "WIN1250",
+	"windows1251": "WIN1251", "windows1252": "WIN1252", "windows1253": "WIN1253", "windows1254": "WIN1254",
+	"windows1255": "WIN1255", "windows1256": "WIN1256", "windows1257": "WIN1257", "windows1258": "WIN1258",
+}
+
 // nodeCreateDatabase handles *tree.CreateDatabase nodes.
-func nodeCreateDatabase(node *tree.CreateDatabase) (*vitess.DBDDL, error) {
-	if len(node.Owner) > 0 {
-		return nil, fmt.Errorf("OWNER clause is not yet supported")
-	}
-	if len(node.Template) > 0 {
-		return nil, fmt.Errorf("TEMPLATE clause is not yet supported")
-	}
+func nodeCreateDatabase(node *tree.CreateDatabase) (vitess.Statement, error) {
 	if len(node.Encoding) > 0 {
-		return nil, fmt.Errorf("ENCODING clause is not yet supported")
+		if err := validateDatabaseEncoding(node.Encoding); err != nil {
+			return nil, err
+		}
 	}
 	if len(node.Strategy) > 0 {
 		return nil, fmt.Errorf("STRATEGY clause is not yet supported")
@@ -49,7 +70,7 @@ func nodeCreateDatabase(node *tree.CreateDatabase) (*vitess.DBDDL, error) {
 		return nil, fmt.Errorf("ICU_LOCALE clause is not yet supported")
 	}
 	if len(node.IcuRules) > 0 {
-		return nil, fmt.Errorf("TEMPLATE clause is not yet supported")
+		return nil, fmt.Errorf("ICU_RULES clause is not yet supported")
 	}
 	if len(node.LocaleProvider) > 0 {
 		return nil, fmt.Errorf("LOCALE_PROVIDER clause is not yet supported")
@@ -77,10 +98,28 @@ func nodeCreateDatabase(node *tree.CreateDatabase) (*vitess.DBDDL, error) {
 		return nil, fmt.Errorf("OID clause is not yet supported")
 	}
 
-	return &vitess.DBDDL{
-		Action:           vitess.CreateStr,
-		SchemaOrDatabase: "database",
-		DBName:           node.Name.String(),
-		IfNotExists:      node.IfNotExists,
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewCreateDatabase(node.Name.String(), node.IfNotExists, node.Owner, node.Template),
+		Children:  nil,
 	}, nil
 }
+
+// validateDatabaseEncoding returns an error if the encoding may not be used for a database. Only UTF8 is supported, so
+// the other encodings that Postgres allows are rejected as unsupported, rather than as invalid.
+func validateDatabaseEncoding(encoding string) error {
+	normalized := strings.Map(func(r rune) rune {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, encoding)
+	name, ok := serverEncodings[normalized]
+	if !ok {
+		return pgerrors.Newf(pgcode.UndefinedObject, "%s is not a valid encoding name", encoding)
+	}
+	if name != "UTF8" {
+		return pgerrors.Newf(pgcode.FeatureNotSupported, `encoding "%s" is not supported`, name).
+			WithDetail("Only the UTF8 encoding is supported.")
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// nodeCreateForeignTable handles *tree.CreateForeignTable nodes.
+func nodeCreateForeignTable(node *tree.CreateForeignTable) (vitess.Statement, error) {
+	if node == nil {
+		return nil, nil
+	}
+	if node.Table.ExplicitCatalog {
+		return nil, fmt.Errorf("CREATE FOREIGN TABLE is currently only supported for the current database")
+	}
+	options, err := nodeForeignOptions(node.Options)
+	if err != nil {
+		return nil, err
+	}
+	createTable := &tree.CreateTable{
+		Table: node.Table,
+		Defs:  make(tree.TableDefs, len(node.Columns)),
+	}
+	columnOptions := make(map[string]map[string]string)
+	for i, column := range node.Columns {
+		createTable.Defs[i] = column.Column
+		if len(column.Options) > 0 {
+			columnOptions[string(column.Column.Name)], err = nodeForeignOptions(column.Options)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewCreateForeignTable(createTable, node.IfNotExists, string(node.Server), options, columnOptions),
+		Children:  nil,
+	}, nil
+}
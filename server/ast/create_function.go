@@ -15,11 +15,18 @@
 package ast
 
 import (
+	"bytes"
 	"fmt"
+	"strings"
 
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
+	"github.com/dolthub/doltgresql/core/functions"
+	"github.com/dolthub/doltgresql/postgres/parser/parser"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/plpgsql"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
 // nodeCreateFunction handles *tree.CreateFunction nodes.
@@ -28,7 +35,87 @@ func nodeCreateFunction(node *tree.CreateFunction) (vitess.Statement, error) {
 	if err != nil {
 		return nil, err
 	}
-	return nil, fmt.Errorf("CREATE FUNCTION statement is not yet supported")
+	if len(node.RetType) > 1 || (len(node.RetType) == 1 && len(node.RetType[0].Name) > 0) {
+		return nil, fmt.Errorf("functions returning tables are not yet supported")
+	}
+	schema, name, err := nodeRoutineName(node.Name)
+	if err != nil {
+		return nil, err
+	}
+	params, err := nodeRoutineParameters(node.Args)
+	if err != nil {
+		return nil, err
+	}
+	language, definition, err := nodeRoutineBody(node.Options)
+	if err != nil {
+		return nil, err
+	}
+	function := &functions.Function{
+		Name:       name,
+		Kind:       functions.Kind_Function,
+		Parameters: params,
+		ReturnsSet: node.SetOf,
+		Language:   language,
+		Definition: definition,
+	}
+	outputParams := function.OutputParameters()
+	if len(outputParams) > 1 {
+		return nil, fmt.Errorf("functions with multiple output parameters are not yet supported")
+	}
+	if len(node.RetType) == 1 {
+		returnType, err := nodeFunctionReturnType(node.RetType[0].Type)
+		if err != nil {
+			return nil, err
+		}
+		if returnType.BaseID() == pgtypes.DoltgresTypeBaseID_Trigger {
+			if err = verifyTriggerFunction(function); err != nil {
+				return nil, err
+			}
+		}
+		function.ReturnType, err = pgtypes.SerializeType(returnType)
+		if err != nil {
+			return nil, err
+		}
+		if len(outputParams) == 1 && !bytes.Equal(function.ReturnType, params[outputParams[0]].Type) {
+			outputType, err := pgtypes.DeserializeType(params[outputParams[0]].Type)
+			if err != nil {
+				return nil, err
+			}
+			return nil, fmt.Errorf("function result type must be %s because of OUT parameters", outputType.String())
+		}
+	} else if len(outputParams) == 1 {
+		// A single output parameter determines the return type
+		function.ReturnType = params[outputParams[0]].Type
+	} else {
+		return nil, fmt.Errorf("function result type must be specified")
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewCreateFunction(node.Replace, schema, function),
+		Children:  nil,
+	}, nil
+}
+
+// nodeFunctionReturnType returns the return type of a function. Functions may also return the trigger pseudo-type,
+// which cannot be used anywhere else.
+func nodeFunctionReturnType(typ tree.ResolvableTypeReference) (pgtypes.DoltgresType, error) {
+	if name, ok := typ.(*tree.UnresolvedObjectName); ok && name.NumParts == 1 && strings.ToLower(name.Parts[0]) == "trigger" {
+		return pgtypes.Trigger, nil
+	}
+	_, returnType, err := nodeResolvableTypeReference(typ)
+	return returnType, err
+}
+
+// verifyTriggerFunction checks that a function returning trigger is able to be called by a trigger.
+func verifyTriggerFunction(function *functions.Function) error {
+	switch {
+	case function.Language == "sql":
+		return fmt.Errorf("SQL functions cannot return type trigger")
+	case function.ReturnsSet:
+		return fmt.Errorf("trigger functions cannot return a set")
+	case len(function.Parameters) > 0:
+		return fmt.Errorf("trigger functions cannot have declared arguments")
+	}
+	return nil
 }
 
 // verifyRedundantRoutineOption checks for each option defined only once.
@@ -44,3 +131,125 @@ func verifyRedundantRoutineOption(options []tree.RoutineOption) error {
 	}
 	return nil
 }
+
+// nodeRoutineName returns the schema and name of a routine. The schema is empty when the name is not qualified.
+func nodeRoutineName(name *tree.UnresolvedObjectName) (schema string, routineName string, err error) {
+	switch name.NumParts {
+	case 1:
+		return "", name.Parts[0], nil
+	case 2:
+		return name.Parts[1], name.Parts[0], nil
+	default:
+		return "", "", fmt.Errorf("referencing items outside the schema or database is not yet supported")
+	}
+}
+
+// nodeRoutineParameters converts the arguments of a routine into the parameters that are persisted alongside it.
+func nodeRoutineParameters(args tree.RoutineArgs) ([]functions.Parameter, error) {
+	params := make([]functions.Parameter, len(args))
+	hasDefault := false
+	for i, arg := range args {
+		switch arg.Mode {
+		case tree.RoutineArgModeIn:
+			params[i].Mode = functions.ParameterMode_In
+		case tree.RoutineArgModeOut:
+			params[i].Mode = functions.ParameterMode_Out
+		case tree.RoutineArgModeInout:
+			params[i].Mode = functions.ParameterMode_InOut
+		case tree.RoutineArgModeVariadic:
+			return nil, fmt.Errorf("VARIADIC parameters are not yet supported")
+		default:
+			return nil, fmt.Errorf("unknown parameter mode")
+		}
+		params[i].Name = string(arg.Name)
+		_, resolvedType, err := nodeResolvableTypeReference(arg.Type)
+		if err != nil {
+			return nil, err
+		}
+		params[i].Type, err = pgtypes.SerializeType(resolvedType)
+		if err != nil {
+			return nil, err
+		}
+		if arg.Default != nil {
+			if params[i].Mode == functions.ParameterMode_Out {
+				return nil, fmt.Errorf("only input parameters can have default values")
+			}
+			params[i].Default = tree.AsString(arg.Default)
+			hasDefault = true
+		} else if hasDefault && params[i].Mode != functions.ParameterMode_Out {
+			return nil, fmt.Errorf("input parameters after one with a default value must also have defaults")
+		}
+		for j := 0; j < i; j++ {
+			if len(params[i].Name) > 0 && params[i].Name == params[j].Name {
+				return nil, fmt.Errorf(`parameter name "%s" used more than once`, params[i].Name)
+			}
+		}
+	}
+	return params, nil
+}
+
+// nodeRoutineBody returns the language and definition of a routine from its options. The definition is verified to
+// be parseable, so that errors are reported when the routine is created rather than when it is called.
+func nodeRoutineBody(options []tree.RoutineOption) (language string, definition string, err error) {
+	hasDefinition := false
+	hasSqlBody := false
+	for _, option := range options {
+		switch option.OptionType {
+		case tree.OptionLanguage:
+			language = strings.ToLower(option.Language)
+		case tree.OptionAs1:
+			definition = option.Definition
+			hasDefinition = true
+		case tree.OptionSqlBody:
+			definition, err = nodeRoutineSqlBody(option.SqlBody)
+			if err != nil {
+				return "", "", err
+			}
+			hasDefinition = true
+			hasSqlBody = true
+		}
+	}
+	if !hasDefinition {
+		return "", "", fmt.Errorf("no function body specified")
+	}
+	if hasSqlBody {
+		// Bodies written in SQL (rather than as a string) imply the language
+		if len(language) == 0 {
+			language = "sql"
+		} else if language != "sql" {
+			return "", "", fmt.Errorf("inline SQL function body only valid for language SQL")
+		}
+	}
+	switch language {
+	case "":
+		return "", "", fmt.Errorf("no language specified")
+	case "sql":
+		if _, err = parser.Parse(definition); err != nil {
+			return "", "", err
+		}
+	case "plpgsql":
+		if _, err = plpgsql.Parse(definition, ResolveType); err != nil {
+			return "", "", err
+		}
+	default:
+		return "", "", fmt.Errorf(`language "%s" is not yet supported`, language)
+	}
+	return language, definition, nil
+}
+
+// nodeRoutineSqlBody returns the definition of a routine whose body is written in SQL, rather than given as a string.
+// RETURN bodies are stored as the equivalent SELECT, while BEGIN ATOMIC bodies store each of their statements.
+func nodeRoutineSqlBody(body tree.Statement) (string, error) {
+	switch body := body.(type) {
+	case *tree.Return:
+		return tree.AsString(&tree.Select{Select: &tree.SelectClause{Exprs: tree.SelectExprs{{Expr: body.Expr}}}}), nil
+	case *tree.BeginEndBlock:
+		stmts := make([]string, len(body.Statements))
+		for i, stmt := range body.Statements {
+			stmts[i] = tree.AsString(stmt)
+		}
+		return strings.Join(stmts, "; "), nil
+	default:
+		return "", fmt.Errorf("unknown routine body")
+	}
+}
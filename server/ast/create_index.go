@@ -15,12 +15,14 @@
 package ast
 
 import (
-	"fmt"
 	"strings"
 
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/sirupsen/logrus"
 
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/pgerrors"
 )
 
 // nodeCreateIndex handles *tree.CreateIndex nodes.
@@ -28,14 +30,19 @@ func nodeCreateIndex(node *tree.CreateIndex) (*vitess.AlterTable, error) {
 	if node == nil {
 		return nil, nil
 	}
-	if node.Concurrently {
-		return nil, fmt.Errorf("concurrent indexes are not yet supported")
-	}
-	if node.Using != "" && strings.ToLower(node.Using) != "btree" {
-		return nil, fmt.Errorf("index tablespace is not yet supported")
+	// Building an index does not block concurrent reads or writes, so CONCURRENTLY only differs in that it may not run
+	// inside a transaction block, which the connection handler enforces.
+	if err := validateIndexAccessMethod(node); err != nil {
+		return nil, err
 	}
 	if node.Predicate != nil {
-		return nil, fmt.Errorf("WHERE is not yet supported")
+		// A partial index only differs from a full index in which rows it covers, which does not change the results of a
+		// query. This does not hold for uniqueness, which only applies to the rows that match the predicate.
+		if node.Unique {
+			return nil, pgerrors.New(pgcode.FeatureNotSupported, "unique partial indexes are not yet supported")
+		}
+		logrus.Warnf("partial indexes are not yet supported, index covers every row rather than WHERE %s",
+			tree.AsString(node.Predicate))
 	}
 	indexDef, err := nodeIndexTableDef(&tree.IndexTableDef{
 		Name:        node.Name,
@@ -72,3 +79,25 @@ func nodeCreateIndex(node *tree.CreateIndex) (*vitess.AlterTable, error) {
 		},
 	}, nil
 }
+
+// validateIndexAccessMethod returns an error if the index uses an access method that is not supported. Indexes are
+// always B-trees, which may also stand in for hash indexes, as they support every lookup that a hash index does.
+func validateIndexAccessMethod(node *tree.CreateIndex) error {
+	method := strings.ToLower(node.Using)
+	switch method {
+	case "", "btree":
+		return nil
+	case "hash":
+		if node.Unique {
+			return pgerrors.New(pgcode.FeatureNotSupported, `access method "hash" does not support unique indexes`)
+		}
+		if len(node.Columns) > 1 {
+			return pgerrors.New(pgcode.FeatureNotSupported, `access method "hash" does not support multicolumn indexes`)
+		}
+		return nil
+	case "gin", "gist", "spgist", "brin":
+		return pgerrors.Newf(pgcode.FeatureNotSupported, `access method "%s" is not yet supported`, method)
+	default:
+		return pgerrors.Newf(pgcode.UndefinedObject, `access method "%s" does not exist`, method)
+	}
+}
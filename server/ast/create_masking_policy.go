@@ -0,0 +1,47 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// nodeCreateMaskingPolicy handles *tree.CreateMaskingPolicy nodes.
+func nodeCreateMaskingPolicy(node *tree.CreateMaskingPolicy) (vitess.Statement, error) {
+	if node == nil {
+		return nil, nil
+	}
+	var schemaName, tableName, columnName string
+	switch node.Column.NumParts {
+	case 2:
+		tableName, columnName = node.Column.Parts[1], node.Column.Parts[0]
+	case 3:
+		schemaName, tableName, columnName = node.Column.Parts[2], node.Column.Parts[1], node.Column.Parts[0]
+	case 4:
+		return nil, fmt.Errorf("CREATE MASKING POLICY is currently only supported for the current database")
+	default:
+		return nil, fmt.Errorf(`masking policy column "%s" must be qualified by its table`, node.Column.String())
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewCreateMaskingPolicy(string(node.Name), schemaName, tableName, columnName, node.Role,
+			tree.AsString(node.Expr)),
+		Children: nil,
+	}, nil
+}
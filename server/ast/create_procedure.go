@@ -15,11 +15,11 @@
 package ast
 
 import (
-	"fmt"
-
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
+	"github.com/dolthub/doltgresql/core/functions"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeCreateProcedure handles *tree.CreateProcedure nodes.
@@ -28,5 +28,26 @@ func nodeCreateProcedure(node *tree.CreateProcedure) (vitess.Statement, error) {
 	if err != nil {
 		return nil, err
 	}
-	return nil, fmt.Errorf("CREATE PROCEDURE statement is not yet supported")
+	schema, name, err := nodeRoutineName(node.Name)
+	if err != nil {
+		return nil, err
+	}
+	params, err := nodeRoutineParameters(node.Args)
+	if err != nil {
+		return nil, err
+	}
+	language, definition, err := nodeRoutineBody(node.Options)
+	if err != nil {
+		return nil, err
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewCreateFunction(node.Replace, schema, &functions.Function{
+			Name:       name,
+			Kind:       functions.Kind_Procedure,
+			Parameters: params,
+			Language:   language,
+			Definition: definition,
+		}),
+		Children: nil,
+	}, nil
 }
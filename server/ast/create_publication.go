@@ -0,0 +1,76 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// nodeCreatePublication handles *tree.CreatePublication nodes.
+func nodeCreatePublication(node *tree.CreatePublication) (vitess.Statement, error) {
+	if node == nil {
+		return nil, nil
+	}
+	var allTables bool
+	var tables []doltdb.TableName
+	if node.Tables != nil {
+		allTables = node.Tables.AllTables
+		var err error
+		if tables, err = nodePublicationTables(node.Tables.Tables); err != nil {
+			return nil, err
+		}
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewCreatePublication(string(node.Name), allTables, tables, nodePublicationParams(node.Params)),
+		Children:  nil,
+	}, nil
+}
+
+// nodePublicationTables returns the tables of a publication statement. Tables without a schema are resolved when the
+// statement is executed.
+func nodePublicationTables(names tree.TableNames) ([]doltdb.TableName, error) {
+	tables := make([]doltdb.TableName, len(names))
+	for i, name := range names {
+		if name.ExplicitCatalog {
+			return nil, fmt.Errorf("publications are currently only supported for the current database")
+		}
+		tables[i] = doltdb.TableName{Name: string(name.ObjectName), Schema: string(name.SchemaName)}
+	}
+	return tables, nil
+}
+
+// nodePublicationParams returns the parameters of a publication statement keyed by their names. These are validated
+// when the statement is executed.
+func nodePublicationParams(node tree.StorageParams) map[string]string {
+	params := make(map[string]string, len(node))
+	for _, param := range node {
+		// A parameter without a value is shorthand for setting a boolean parameter to true
+		value := "true"
+		if strVal, ok := param.Value.(*tree.StrVal); ok {
+			// String values may hold a list, such as "insert, update", which would be quoted when formatted
+			value = strVal.RawString()
+		} else if param.Value != nil {
+			value = tree.AsStringWithFlags(param.Value, tree.FmtBareStrings)
+		}
+		params[string(param.Key)] = value
+	}
+	return params
+}
@@ -1,4 +1,4 @@
-// Copyright 2023 Dolthub, Inc.
+// Copyright 2024 Dolthub, Inc.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -16,10 +16,16 @@ package ast
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgdate"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/pgerrors"
 )
 
 // nodeCreateRole handles *tree.CreateRole nodes.
@@ -27,5 +33,110 @@ func nodeCreateRole(node *tree.CreateRole) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	return nil, fmt.Errorf("CREATE ROLE is not yet supported")
+	name, err := nodeRoleName(node.Name)
+	if err != nil {
+		return nil, err
+	}
+	options, err := nodeRoleOptions(node.KVOptions)
+	if err != nil {
+		return nil, err
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewCreateRole(name, node.IfNotExists, !node.IsRole, options),
+		Children:  nil,
+	}, nil
+}
+
+// nodeRoleName returns the name of the role from the given expression.
+func nodeRoleName(expr tree.Expr) (string, error) {
+	switch expr := expr.(type) {
+	case *tree.StrVal:
+		return expr.RawString(), nil
+	case *tree.UnresolvedName:
+		return expr.String(), nil
+	default:
+		return "", fmt.Errorf("role names of type %T are not yet supported", expr)
+	}
+}
+
+// nodeRoleOptions converts the options given to CREATE ROLE or ALTER ROLE.
+func nodeRoleOptions(kvOptions tree.KVOptions) (pgnodes.RoleOptions, error) {
+	options := pgnodes.RoleOptions{}
+	seen := make(map[string]struct{})
+	for _, kvOption := range kvOptions {
+		key := strings.ToLower(string(kvOption.Key))
+		// An option and its negation, such as LOGIN and NOLOGIN, set the same attribute
+		attribute := strings.TrimPrefix(key, "no")
+		if _, ok := seen[attribute]; ok {
+			return pgnodes.RoleOptions{}, pgerrors.New(pgcode.Syntax, "conflicting or redundant options")
+		}
+		seen[attribute] = struct{}{}
+
+		var boolField **bool
+		switch attribute {
+		case "superuser":
+			boolField = &options.SuperUser
+		case "createdb":
+			boolField = &options.CreateDB
+		case "createrole":
+			boolField = &options.CreateRole
+		case "inherit":
+			boolField = &options.Inherit
+		case "login":
+			boolField = &options.Login
+		case "replication":
+			boolField = &options.Replication
+		case "bypassrls":
+			boolField = &options.BypassRLS
+		case "connection_limit":
+			numVal, ok := kvOption.Value.(*tree.NumVal)
+			if !ok {
+				return pgnodes.RoleOptions{}, fmt.Errorf("invalid connection limit: %s", kvOption.Value)
+			}
+			limit, err := numVal.AsInt32()
+			if err != nil {
+				return pgnodes.RoleOptions{}, err
+			}
+			if limit < -1 {
+				return pgnodes.RoleOptions{}, pgerrors.Newf(pgcode.InvalidParameterValue, "invalid connection limit: %d", limit)
+			}
+			options.ConnectionLimit = &limit
+			continue
+		case "password":
+			password := ""
+			switch value := kvOption.Value.(type) {
+			case *tree.StrVal:
+				password = value.RawString()
+			default:
+				if kvOption.Value != tree.DNull {
+					return pgnodes.RoleOptions{}, fmt.Errorf("passwords of type %T are not yet supported", value)
+				}
+			}
+			options.Password = &password
+			continue
+		case "valid_until":
+			validUntil := time.Time{}
+			switch value := kvOption.Value.(type) {
+			case *tree.StrVal:
+				parsed, _, err := pgdate.ParseTimestamp(time.Now(), pgdate.ParseModeYMD, value.RawString())
+				if err != nil {
+					return pgnodes.RoleOptions{}, err
+				}
+				if !parsed.Equal(pgdate.TimeInfinity) {
+					validUntil = parsed
+				}
+			default:
+				if kvOption.Value != tree.DNull {
+					return pgnodes.RoleOptions{}, fmt.Errorf("VALID UNTIL values of type %T are not yet supported", value)
+				}
+			}
+			options.ValidUntil = &validUntil
+			continue
+		default:
+			return pgnodes.RoleOptions{}, fmt.Errorf("role option %s is not yet supported", strings.ToUpper(key))
+		}
+		value := !strings.HasPrefix(key, "no")
+		*boolField = &value
+	}
+	return options, nil
 }
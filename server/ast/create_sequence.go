@@ -44,67 +44,71 @@ func nodeCreateSequence(node *tree.CreateSequence) (vitess.Statement, error) {
 	if len(name.DbQualifier.String()) > 0 {
 		return nil, fmt.Errorf("CREATE SEQUENCE is currently only supported for the current database")
 	}
+	sequence, err := nodeSequenceOptions(node.Options, nil)
+	if err != nil {
+		return nil, err
+	}
+	sequence.Name = name.Name.String()
+	// Returns the stored procedure call with all of the options
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewCreateSequence(node.IfNotExists, name.SchemaQualifier.String(), sequence),
+		Children:  nil,
+	}, nil
+}
+
+// nodeSequenceOptions returns a sequence using the given options, which is shared between CREATE SEQUENCE and identity
+// columns. The data type is used when the options do not contain AS, and defaults to bigint when nil. The returned
+// sequence does not have a name.
+func nodeSequenceOptions(options tree.SequenceOptions, dataType pgtypes.DoltgresType) (*sequences.Sequence, error) {
 	// Read all of the options and check whether they've been set (if not, we'll use the defaults)
-	minValueLimit := int64(math.MinInt64)
-	maxValueLimit := int64(math.MaxInt64)
+	var minValueLimit int64
+	var maxValueLimit int64
 	increment := int64(1)
 	var minValue int64
 	var maxValue int64
 	var start int64
-	var dataType pgtypes.DoltgresType
 	var ownerTableName string
 	var ownerColumnName string
 	minValueSet := false
 	maxValueSet := false
 	incrementSet := false
 	startSet := false
+	dataTypeSet := false
+	cacheSet := false
+	cache := int64(1)
 	cycle := false
-	for _, option := range node.Options {
+	for _, option := range options {
 		switch option.Name {
 		case tree.SeqOptAs:
-			if dataType != nil {
+			if dataTypeSet {
 				return nil, fmt.Errorf("conflicting or redundant options")
 			}
+			var err error
 			_, dataType, err = nodeResolvableTypeReference(option.AsType)
 			if err != nil {
 				return nil, err
 			}
-			switch dataType.BaseID() {
-			case pgtypes.DoltgresTypeBaseID_Int16:
-				minValueLimit = int64(math.MinInt16)
-				maxValueLimit = int64(math.MaxInt16)
-			case pgtypes.DoltgresTypeBaseID_Int32:
-				minValueLimit = int64(math.MinInt32)
-				maxValueLimit = int64(math.MaxInt32)
-			case pgtypes.DoltgresTypeBaseID_Int64:
-				minValueLimit = int64(math.MinInt64)
-				maxValueLimit = int64(math.MaxInt64)
-			default:
-				return nil, fmt.Errorf("sequence type must be smallint, integer, or bigint")
-			}
+			dataTypeSet = true
 		case tree.SeqOptCycle:
 			cycle = true
 		case tree.SeqOptNoCycle:
 			cycle = false
 		case tree.SeqOptOwnedBy:
-			expr, err := nodeExpr(option.ColumnItemVal)
+			// OWNED BY NONE does not have a column, and is the same as not specifying an owner
+			var err error
+			ownerTableName, ownerColumnName, err = nodeSequenceOwner(option.ColumnItemVal)
 			if err != nil {
 				return nil, err
 			}
-			colName, ok := expr.(*vitess.ColName)
-			if !ok {
-				return nil, fmt.Errorf("expected sequence owner to be a table and column name")
-			}
-			if len(colName.Qualifier.SchemaQualifier.String()) > 0 || len(colName.Qualifier.DbQualifier.String()) > 0 {
-				return nil, fmt.Errorf("sequence owner must be in the same schema as the sequence")
-			}
-			ownerTableName = colName.Qualifier.Name.String()
-			ownerColumnName = colName.Name.String()
 		case tree.SeqOptCache:
-			// TODO: implement caching
-			if *option.IntVal != 1 {
-				return nil, fmt.Errorf("sequence caching for values other than 1 are not yet supported")
+			if cacheSet {
+				return nil, fmt.Errorf("conflicting or redundant options")
 			}
+			cache = *option.IntVal
+			if cache <= 0 {
+				return nil, fmt.Errorf("CACHE (%d) must be greater than zero", cache)
+			}
+			cacheSet = true
 		case tree.SeqOptIncrement:
 			increment = *option.IntVal
 			if incrementSet {
@@ -140,6 +144,22 @@ func nodeCreateSequence(node *tree.CreateSequence) (vitess.Statement, error) {
 			return nil, fmt.Errorf("unknown CREATE SEQUENCE option")
 		}
 	}
+	if dataType == nil {
+		dataType = pgtypes.Int64
+	}
+	switch dataType.BaseID() {
+	case pgtypes.DoltgresTypeBaseID_Int16:
+		minValueLimit = int64(math.MinInt16)
+		maxValueLimit = int64(math.MaxInt16)
+	case pgtypes.DoltgresTypeBaseID_Int32:
+		minValueLimit = int64(math.MinInt32)
+		maxValueLimit = int64(math.MaxInt32)
+	case pgtypes.DoltgresTypeBaseID_Int64:
+		minValueLimit = int64(math.MinInt64)
+		maxValueLimit = int64(math.MaxInt64)
+	default:
+		return nil, fmt.Errorf("sequence type must be smallint, integer, or bigint")
+	}
 	// Determine what all of the values should be based on what was set and what is inferred, as well as perform
 	// validation for options that make sense
 	if minValueSet {
@@ -162,7 +182,7 @@ func nodeCreateSequence(node *tree.CreateSequence) (vitess.Statement, error) {
 	}
 	if startSet {
 		if start < minValue {
-			return nil, fmt.Errorf("START value (%d) cannot be less than MINVALUE (%d))", start, minValue)
+			return nil, fmt.Errorf("START value (%d) cannot be less than MINVALUE (%d)", start, minValue)
 		}
 		if start > maxValue {
 			return nil, fmt.Errorf("START value (%d) cannot be greater than MAXVALUE (%d)", start, maxValue)
@@ -172,27 +192,39 @@ func nodeCreateSequence(node *tree.CreateSequence) (vitess.Statement, error) {
 	} else {
 		start = maxValue
 	}
-	if dataType == nil {
-		dataType = pgtypes.Int64
-	}
-	// Returns the stored procedure call with all of the options
-	return vitess.InjectedStatement{
-		Statement: pgnodes.NewCreateSequence(node.IfNotExists, name.SchemaQualifier.String(), &sequences.Sequence{
-			Name:        name.Name.String(),
-			DataTypeOID: dataType.OID(),
-			Persistence: sequences.Persistence_Permanent,
-			Start:       start,
-			Current:     start,
-			Increment:   increment,
-			Minimum:     minValue,
-			Maximum:     maxValue,
-			Cache:       1,
-			Cycle:       cycle,
-			IsAtEnd:     false,
-			OwnerUser:   "",
-			OwnerTable:  ownerTableName,
-			OwnerColumn: ownerColumnName,
-		}),
-		Children: nil,
+	return &sequences.Sequence{
+		DataTypeOID: dataType.OID(),
+		Persistence: sequences.Persistence_Permanent,
+		Start:       start,
+		Current:     start,
+		Increment:   increment,
+		Minimum:     minValue,
+		Maximum:     maxValue,
+		Cache:       cache,
+		Cycle:       cycle,
+		IsAtEnd:     false,
+		OwnerUser:   "",
+		OwnerTable:  ownerTableName,
+		OwnerColumn: ownerColumnName,
 	}, nil
 }
+
+// nodeSequenceOwner returns the table and column names of an OWNED BY sequence option. Returns empty names for OWNED BY
+// NONE.
+func nodeSequenceOwner(node *tree.ColumnItem) (tableName string, columnName string, err error) {
+	if node == nil {
+		return "", "", nil
+	}
+	expr, err := nodeExpr(node)
+	if err != nil {
+		return "", "", err
+	}
+	colName, ok := expr.(*vitess.ColName)
+	if !ok {
+		return "", "", fmt.Errorf("expected sequence owner to be a table and column name")
+	}
+	if len(colName.Qualifier.SchemaQualifier.String()) > 0 || len(colName.Qualifier.DbQualifier.String()) > 0 {
+		return "", "", fmt.Errorf("sequence owner must be in the same schema as the sequence")
+	}
+	return colName.Qualifier.Name.String(), colName.Name.String(), nil
+}
@@ -17,27 +17,31 @@ package ast
 import (
 	"fmt"
 
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
+	"github.com/dolthub/doltgresql/core/partitions"
+	"github.com/dolthub/doltgresql/core/storageparams"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/utils"
 )
 
 // nodeCreateTable handles *tree.CreateTable nodes.
-func nodeCreateTable(node *tree.CreateTable) (*vitess.DDL, error) {
+func nodeCreateTable(node *tree.CreateTable) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	if node.PartitionBy != nil {
-		switch node.PartitionBy.Type {
-		case tree.PartitionByList:
-			if len(node.PartitionBy.Elems) != 1 {
-				return nil, fmt.Errorf("PARTITION BY LIST must have a single column or expression")
-			}
-		}
-		return nil, fmt.Errorf("PARTITION BY is not yet supported")
+	if len(node.PartitionOf.ObjectName) > 0 {
+		return nodeCreatePartition(node)
 	}
-	if len(node.StorageParams) > 0 {
-		return nil, fmt.Errorf("storage parameters are not yet supported")
+	partitionKey, err := nodePartitionBy(node.PartitionBy)
+	if err != nil {
+		return nil, err
+	}
+	storageParams, err := nodeStorageParams(node.StorageParams)
+	if err != nil {
+		return nil, err
 	}
 	if node.OnCommit != tree.CreateTableOnCommitUnset {
 		return nil, fmt.Errorf("ON COMMIT is not yet supported")
@@ -86,5 +90,82 @@ func nodeCreateTable(node *tree.CreateTable) (*vitess.DDL, error) {
 	if err = assignTableDefs(node.Defs, ddl); err != nil {
 		return nil, err
 	}
+	// The partition key and storage parameters are carried as table options, which are written alongside the table once
+	// it has been created
+	if partitionKey != nil {
+		if node.AsSource != nil {
+			return nil, fmt.Errorf("PARTITION BY is not yet supported for CREATE TABLE AS")
+		}
+		if ddl.TableSpec == nil {
+			ddl.TableSpec = &vitess.TableSpec{}
+		}
+		ddl.TableSpec.TableOpts = append(ddl.TableSpec.TableOpts, &vitess.TableOption{
+			Name:  partitions.StrategyTableOption,
+			Value: partitionKey.Strategy.String(),
+		})
+		for i, column := range partitionKey.Columns {
+			ddl.TableSpec.TableOpts = append(ddl.TableSpec.TableOpts, &vitess.TableOption{
+				Name:  fmt.Sprintf("%s%d", partitions.ColumnTableOptionPrefix, i),
+				Value: column,
+			})
+		}
+	}
+	if len(storageParams) > 0 {
+		if node.AsSource != nil {
+			return nil, fmt.Errorf("storage parameters are not yet supported for CREATE TABLE AS")
+		}
+		if ddl.TableSpec == nil {
+			ddl.TableSpec = &vitess.TableSpec{}
+		}
+	}
+	for _, name := range utils.GetMapKeysSorted(storageParams) {
+		ddl.TableSpec.TableOpts = append(ddl.TableSpec.TableOpts, &vitess.TableOption{
+			Name:  storageparams.TableOptionPrefix + name,
+			Value: storageParams[name],
+		})
+	}
 	return ddl, nil
 }
+
+// nodeCreatePartition handles *tree.CreateTable nodes that create a partition of another table. The partition's columns
+// are copied from its partitioned table.
+func nodeCreatePartition(node *tree.CreateTable) (vitess.Statement, error) {
+	if len(node.Defs) > 0 {
+		return nil, fmt.Errorf("column definitions for partitions are not yet supported")
+	}
+	if len(node.StorageParams) > 0 {
+		return nil, fmt.Errorf("storage parameters for partitions are not yet supported")
+	}
+	if node.Persistence != tree.PersistencePermanent {
+		return nil, fmt.Errorf("temporary and unlogged partitions are not yet supported")
+	}
+	if node.OnCommit != tree.CreateTableOnCommitUnset {
+		return nil, fmt.Errorf("ON COMMIT is not yet supported")
+	}
+	if node.Using != "" {
+		return nil, fmt.Errorf("USING is not yet supported")
+	}
+	if node.Tablespace != "" {
+		return nil, fmt.Errorf("TABLESPACE is not yet supported")
+	}
+	if node.Table.ExplicitCatalog || node.PartitionOf.ExplicitCatalog {
+		return nil, fmt.Errorf("CREATE TABLE ... PARTITION OF is currently only supported for the current database")
+	}
+	partition, boundStrategy, err := nodePartitionBoundSpec(node.PartitionBoundSpec)
+	if err != nil {
+		return nil, err
+	}
+	subpartitionKey, err := nodePartitionBy(node.PartitionBy)
+	if err != nil {
+		return nil, err
+	}
+	createTable := &tree.CreateTable{
+		Table: node.Table,
+		Defs:  tree.TableDefs{&tree.LikeTableDef{Name: node.PartitionOf}},
+	}
+	parentName := doltdb.TableName{Name: string(node.PartitionOf.ObjectName), Schema: string(node.PartitionOf.SchemaName)}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewCreatePartition(createTable, parentName, partition, boundStrategy, subpartitionKey, node.IfNotExists),
+		Children:  nil,
+	}, nil
+}
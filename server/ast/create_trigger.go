@@ -17,12 +17,75 @@ package ast
 import (
 	"fmt"
 
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
+	"github.com/dolthub/doltgresql/core/triggers"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeCreateTrigger handles *tree.CreateTrigger nodes.
 func nodeCreateTrigger(node *tree.CreateTrigger) (vitess.Statement, error) {
-	return nil, fmt.Errorf("CREATE TRIGGER statement is not yet supported")
+	if node == nil {
+		return nil, nil
+	}
+	if node.Constraint {
+		return nil, fmt.Errorf("CREATE CONSTRAINT TRIGGER is not yet supported")
+	}
+	if !node.ForEachRow {
+		return nil, fmt.Errorf("FOR EACH STATEMENT triggers are not yet supported")
+	}
+	if len(node.Relations) > 0 {
+		return nil, fmt.Errorf("REFERENCING is not yet supported")
+	}
+	if node.OnTable.ExplicitCatalog {
+		return nil, fmt.Errorf("CREATE TRIGGER is currently only supported for the current database")
+	}
+	trigger := triggers.Trigger{
+		Name:  string(node.Name),
+		Table: doltdb.TableName{Name: string(node.OnTable.ObjectName), Schema: string(node.OnTable.SchemaName)},
+	}
+	switch node.Time {
+	case tree.TriggerTimeBefore:
+		trigger.Timing = triggers.Timing_Before
+	case tree.TriggerTimeAfter:
+		trigger.Timing = triggers.Timing_After
+	case tree.TriggerTimeInsteadOf:
+		return nil, fmt.Errorf("INSTEAD OF triggers are not yet supported")
+	default:
+		return nil, fmt.Errorf("unknown trigger timing")
+	}
+	for _, event := range node.Events {
+		switch event.Type {
+		case tree.TriggerEventInsert:
+			trigger.Events |= triggers.Event_Insert
+		case tree.TriggerEventUpdate:
+			trigger.Events |= triggers.Event_Update
+			for _, col := range event.Cols {
+				trigger.UpdateColumns = append(trigger.UpdateColumns, string(col))
+			}
+		case tree.TriggerEventDelete:
+			trigger.Events |= triggers.Event_Delete
+		case tree.TriggerEventTruncate:
+			return nil, fmt.Errorf("TRUNCATE triggers are not yet supported")
+		default:
+			return nil, fmt.Errorf("unknown trigger event")
+		}
+	}
+	schema, name, err := nodeRoutineName(node.FuncName)
+	if err != nil {
+		return nil, err
+	}
+	trigger.Function = doltdb.TableName{Name: name, Schema: schema}
+	for _, arg := range node.Args {
+		trigger.Arguments = append(trigger.Arguments, string(arg))
+	}
+	if node.When != nil {
+		trigger.When = tree.AsString(node.When)
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewCreateTrigger(node.Replace, trigger),
+		Children:  nil,
+	}, nil
 }
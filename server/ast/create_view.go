@@ -81,7 +81,8 @@ func nodeCreateView(node *tree.CreateView) (*vitess.DDL, error) {
 	if err != nil {
 		return nil, err
 	}
-	selectStmt, err := nodeSelectStatement(node.AsSource.Select)
+	// The entire source is used so that any WITH, ORDER BY, and LIMIT clauses are kept
+	selectStmt, err := nodeSelect(node.AsSource)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +101,7 @@ func nodeCreateView(node *tree.CreateView) (*vitess.DDL, error) {
 			Security:    sqlSecurity,
 			CheckOption: vCheckOpt,
 		},
-		SubStatementStr: node.AsSource.Select.String(),
+		SubStatementStr: node.AsSource.String(),
 	}
 	return stmt, nil
 }
@@ -0,0 +1,45 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// nodeDeclareCursor handles *tree.DeclareCursor nodes.
+func nodeDeclareCursor(node *tree.DeclareCursor) (vitess.Statement, error) {
+	if node == nil {
+		return nil, nil
+	}
+	if node.Binary {
+		return nil, pgerrors.New(pgcode.FeatureNotSupported, "binary cursors are not yet supported")
+	}
+	// The query is re-parsed by the connection handler, which runs it when the cursor is declared. Cursors are always
+	// materialized, so they may scroll backward unless NO SCROLL was given.
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewDeclareCursor(
+			string(node.Name),
+			tree.AsString(node.Select),
+			node.Scroll != tree.NoScroll,
+			node.Hold,
+		),
+		Children: nil,
+	}, nil
+}
@@ -15,21 +15,16 @@
 package ast
 
 import (
-	"fmt"
-
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
 )
 
 // nodeDelete handles *tree.Delete nodes.
-func nodeDelete(node *tree.Delete) (*vitess.Delete, error) {
+func nodeDelete(node *tree.Delete) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	if _, ok := node.Returning.(*tree.NoReturningClause); !ok {
-		return nil, fmt.Errorf("RETURNING is not yet supported")
-	}
 	with, err := nodeWith(node.With)
 	if err != nil {
 		return nil, err
@@ -50,11 +45,17 @@ func nodeDelete(node *tree.Delete) (*vitess.Delete, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &vitess.Delete{
+	del := &vitess.Delete{
 		TableExprs: vitess.TableExprs{table},
 		With:       with,
 		Where:      where,
 		OrderBy:    orderBy,
 		Limit:      limit,
-	}, nil
+	}
+	// The SELECT that reads the returned rows needs its own copy of the table expression
+	returningTable, err := nodeTableExpr(node.Table)
+	if err != nil {
+		return nil, err
+	}
+	return nodeReturning(node, del, node.Returning, returningTable)
 }
@@ -15,24 +15,19 @@
 package ast
 
 import (
-	"fmt"
-
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeDropDatabase handles *tree.DropDatabase nodes.
-func nodeDropDatabase(node *tree.DropDatabase) (*vitess.DBDDL, error) {
+func nodeDropDatabase(node *tree.DropDatabase) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	if node.Force {
-		return nil, fmt.Errorf("WITH ( FORCE ) is not yet supported")
-	}
-	return &vitess.DBDDL{
-		Action:   vitess.DropStr,
-		DBName:   string(node.Name),
-		IfExists: node.IfExists,
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewDropDatabase(string(node.Name), node.IfExists, node.Force),
+		Children:  nil,
 	}, nil
 }
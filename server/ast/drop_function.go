@@ -0,0 +1,48 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core/functions"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// nodeDropFunction handles *tree.DropFunction nodes.
+func nodeDropFunction(node *tree.DropFunction) (vitess.Statement, error) {
+	if node == nil {
+		return nil, nil
+	}
+	if node.DropBehavior == tree.DropCascade {
+		return nil, fmt.Errorf("CASCADE is not yet supported")
+	}
+	names := make([]doltdb.TableName, len(node.Functions))
+	for i, function := range node.Functions {
+		schema, name, err := nodeRoutineName(function.Name)
+		if err != nil {
+			return nil, err
+		}
+		names[i] = doltdb.TableName{Name: name, Schema: schema}
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewDropFunction(node.IfExists, functions.Kind_Function, names),
+		Children:  nil,
+	}, nil
+}
@@ -20,13 +20,24 @@ import (
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeDropIndex handles *tree.DropIndex nodes.
-func nodeDropIndex(node *tree.DropIndex) (*vitess.AlterTable, error) {
+func nodeDropIndex(node *tree.DropIndex) (vitess.Statement, error) {
 	if node == nil || len(node.IndexList) == 0 {
 		return nil, nil
 	}
+	if node.Concurrently {
+		// Dropping an index does not block concurrent reads or writes, as each transaction operates on its own root,
+		// so we only need to enforce the same restrictions that Postgres places on the statement.
+		if len(node.IndexList) > 1 {
+			return nil, fmt.Errorf("DROP INDEX CONCURRENTLY does not support dropping multiple objects")
+		}
+		if node.DropBehavior == tree.DropCascade {
+			return nil, fmt.Errorf("DROP INDEX CONCURRENTLY does not support CASCADE")
+		}
+	}
 	switch node.DropBehavior {
 	case tree.DropDefault:
 		// Default behavior, nothing to do
@@ -38,8 +49,16 @@ func nodeDropIndex(node *tree.DropIndex) (*vitess.AlterTable, error) {
 	if len(node.IndexList) > 1 {
 		return nil, fmt.Errorf("multi-index dropping is not yet supported")
 	}
-	if node.Concurrently {
-		return nil, fmt.Errorf("concurrent indexes are not yet supported")
+	// Postgres does not reference the table, so we find the table that owns the index during execution
+	if index := node.IndexList[0]; len(index.Table.ObjectName) == 0 {
+		if len(index.Table.CatalogName) > 0 {
+			return nil, fmt.Errorf("DROP INDEX is currently only supported for the current database")
+		}
+		return vitess.InjectedStatement{
+			Statement: pgnodes.NewDropIndex(string(index.Table.SchemaName), string(index.Index), node.IfExists,
+				node.Concurrently),
+			Children: nil,
+		}, nil
 	}
 	var tableName vitess.TableName
 	ddls := make([]*vitess.DDL, len(node.IndexList))
@@ -0,0 +1,34 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// nodeDropPublication handles *tree.DropPublication nodes. Nothing depends on a publication, so CASCADE and RESTRICT
+// behave the same.
+func nodeDropPublication(node *tree.DropPublication) (vitess.Statement, error) {
+	if node == nil {
+		return nil, nil
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewDropPublication(node.Names.ToStrings(), node.IfExists),
+		Children:  nil,
+	}, nil
+}
@@ -1,4 +1,4 @@
-// Copyright 2023 Dolthub, Inc.
+// Copyright 2024 Dolthub, Inc.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -15,11 +15,10 @@
 package ast
 
 import (
-	"fmt"
-
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeDropRole handles *tree.DropRole nodes.
@@ -27,5 +26,16 @@ func nodeDropRole(node *tree.DropRole) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	return nil, fmt.Errorf("DROP ROLE is not yet supported")
+	names := make([]string, len(node.Names))
+	for i, expr := range node.Names {
+		name, err := nodeRoleName(expr)
+		if err != nil {
+			return nil, err
+		}
+		names[i] = name
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewDropRole(names, node.IfExists),
+		Children:  nil,
+	}, nil
 }
@@ -15,11 +15,10 @@
 package ast
 
 import (
-	"fmt"
-
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeDropSchema handles *tree.DropSchema nodes.
@@ -27,5 +26,8 @@ func nodeDropSchema(node *tree.DropSchema) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	return nil, fmt.Errorf("DROP SCHEMA is not yet supported")
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewDropSchema(node.Names, node.IfExists, node.DropBehavior == tree.DropCascade),
+		Children:  nil,
+	}, nil
 }
@@ -17,6 +17,7 @@ package ast
 import (
 	"fmt"
 
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
@@ -28,19 +29,19 @@ func nodeDropSequence(node *tree.DropSequence) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	if len(node.Names) != 1 {
-		return nil, fmt.Errorf("dropping multiple sequences in DROP SEQUENCE is not yet supported")
-	}
-	name, err := nodeTableName(&node.Names[0])
-	if err != nil {
-		return nil, err
-	}
-	if len(name.DbQualifier.String()) > 0 {
-		return nil, fmt.Errorf("DROP SEQUENCE is currently only supported for the current database")
+	names := make([]doltdb.TableName, len(node.Names))
+	for i := range node.Names {
+		name, err := nodeTableName(&node.Names[i])
+		if err != nil {
+			return nil, err
+		}
+		if len(name.DbQualifier.String()) > 0 {
+			return nil, fmt.Errorf("DROP SEQUENCE is currently only supported for the current database")
+		}
+		names[i] = doltdb.TableName{Name: name.Name.String(), Schema: name.SchemaQualifier.String()}
 	}
 	return vitess.InjectedStatement{
-		Statement: pgnodes.NewDropSequence(node.IfExists, name.SchemaQualifier.String(), name.Name.String(),
-			node.DropBehavior == tree.DropCascade),
-		Children: nil,
+		Statement: pgnodes.NewDropSequence(node.IfExists, names, node.DropBehavior == tree.DropCascade),
+		Children:  nil,
 	}, nil
 }
@@ -15,26 +15,17 @@
 package ast
 
 import (
-	"fmt"
-
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeDropTable handles *tree.DropTable nodes.
-func nodeDropTable(node *tree.DropTable) (*vitess.DDL, error) {
+func nodeDropTable(node *tree.DropTable) (vitess.Statement, error) {
 	if node == nil || len(node.Names) == 0 {
 		return nil, nil
 	}
-	switch node.DropBehavior {
-	case tree.DropDefault:
-		// Default behavior, nothing to do
-	case tree.DropRestrict:
-		return nil, fmt.Errorf("RESTRICT is not yet supported")
-	case tree.DropCascade:
-		return nil, fmt.Errorf("CASCADE is not yet supported")
-	}
 	tableNames := make([]vitess.TableName, len(node.Names))
 	for i := range node.Names {
 		var err error
@@ -43,9 +34,13 @@ func nodeDropTable(node *tree.DropTable) (*vitess.DDL, error) {
 			return nil, err
 		}
 	}
-	return &vitess.DDL{
+	ddl := &vitess.DDL{
 		Action:     vitess.DropStr,
 		FromTables: tableNames,
 		IfExists:   node.IfExists,
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewDropTable(ddl, node.DropBehavior == tree.DropCascade),
+		Children:  nil,
 	}, nil
 }
@@ -17,29 +17,27 @@ package ast
 import (
 	"fmt"
 
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeDropTrigger handles *tree.DropTrigger nodes.
-func nodeDropTrigger(node *tree.DropTrigger) (*vitess.DDL, error) {
-	// NOTE: specific table that the trigger is on cannot be specified
-	switch node.DropBehavior {
-	case tree.DropDefault:
-		// Default behavior, nothing to do
-	case tree.DropRestrict:
-		return nil, fmt.Errorf("RESTRICT is not yet supported")
-	case tree.DropCascade:
+func nodeDropTrigger(node *tree.DropTrigger) (vitess.Statement, error) {
+	if node == nil {
+		return nil, nil
+	}
+	if node.DropBehavior == tree.DropCascade {
 		return nil, fmt.Errorf("CASCADE is not yet supported")
 	}
-	return &vitess.DDL{
-		Action: vitess.DropStr,
-		TriggerSpec: &vitess.TriggerSpec{
-			TrigName: vitess.TriggerName{
-				Name: vitess.NewColIdent(string(node.Name)),
-			},
-		},
-		IfExists: node.IfExists,
+	if node.OnTable.ExplicitCatalog {
+		return nil, fmt.Errorf("DROP TRIGGER is currently only supported for the current database")
+	}
+	table := doltdb.TableName{Name: string(node.OnTable.ObjectName), Schema: string(node.OnTable.SchemaName)}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewDropTrigger(node.IfExists, string(node.Name), table),
+		Children:  nil,
 	}, nil
 }
@@ -15,26 +15,17 @@
 package ast
 
 import (
-	"fmt"
-
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeDropView handles *tree.DropView nodes.
-func nodeDropView(node *tree.DropView) (*vitess.DDL, error) {
+func nodeDropView(node *tree.DropView) (vitess.Statement, error) {
 	if node == nil || len(node.Names) == 0 {
 		return nil, nil
 	}
-	switch node.DropBehavior {
-	case tree.DropDefault:
-		// Default behavior, nothing to do
-	case tree.DropRestrict:
-		return nil, fmt.Errorf("RESTRICT is not yet supported")
-	case tree.DropCascade:
-		return nil, fmt.Errorf("CASCADE is not yet supported")
-	}
 	tableNames := make([]vitess.TableName, len(node.Names))
 	for i := range node.Names {
 		var err error
@@ -44,9 +35,13 @@ func nodeDropView(node *tree.DropView) (*vitess.DDL, error) {
 		}
 	}
 	//TODO: handle IsMaterialized
-	return &vitess.DDL{
+	ddl := &vitess.DDL{
 		Action:    vitess.DropStr,
 		IfExists:  node.IfExists,
 		FromViews: tableNames,
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewDropView(ddl, node.DropBehavior == tree.DropCascade),
+		Children:  nil,
 	}, nil
 }
@@ -0,0 +1,116 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/doltgresql/core/exclusions"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// nodeExcludeConstraintTableDef handles *tree.ExcludeConstraintTableDef nodes for the table with the given name. The
+// returned constraint does not have its table set, as the table's schema is only known once the statement runs.
+func nodeExcludeConstraintTableDef(node *tree.ExcludeConstraintTableDef, tableName string) (*exclusions.Constraint, error) {
+	if node == nil {
+		return nil, nil
+	}
+	if node.Predicate != nil {
+		return nil, pgerrors.New(pgcode.FeatureNotSupported, "WHERE for exclusion constraints is not yet supported")
+	}
+	if node.IndexParams.IncludeColumns != nil {
+		return nil, fmt.Errorf("include columns is not yet supported")
+	}
+	if len(node.IndexParams.StorageParams) > 0 {
+		return nil, fmt.Errorf("storage parameters is not yet supported")
+	}
+	if node.IndexParams.Tablespace != "" {
+		return nil, fmt.Errorf("tablespace is not yet supported")
+	}
+	using := strings.ToLower(node.Using)
+	switch using {
+	case "":
+		using = "btree"
+	case "btree", "hash", "gist":
+	case "gin", "brin":
+		return nil, pgerrors.Newf(pgcode.FeatureNotSupported, `access method "%s" does not support exclusion constraints`, using)
+	default:
+		return nil, pgerrors.Newf(pgcode.UndefinedObject, `access method "%s" does not exist`, using)
+	}
+	elements := make([]exclusions.Element, len(node.Columns))
+	for i, indexElem := range node.Columns {
+		column := indexElem.Column
+		if indexElem.Expr != nil {
+			var ok bool
+			if column, ok = indexExprColumn(indexElem.Expr); !ok {
+				return nil, pgerrors.Newf(pgcode.FeatureNotSupported,
+					"expressions in exclusion constraints are not yet supported: %s", tree.AsString(indexElem.Expr))
+			}
+		}
+		if indexElem.Collation != "" {
+			return nil, fmt.Errorf("index attribute collation is not yet supported")
+		}
+		if indexElem.OpClass != nil {
+			return nil, fmt.Errorf("index attribute operator class is not yet supported")
+		}
+		operator, err := nodeExcludeOperator(indexElem.ExcludeOp, using)
+		if err != nil {
+			return nil, err
+		}
+		elements[i] = exclusions.Element{Column: string(column), Operator: operator}
+	}
+	name := string(node.Name)
+	if len(name) == 0 {
+		columns := make([]string, len(elements))
+		for i, element := range elements {
+			columns[i] = element.Column
+		}
+		name = fmt.Sprintf("%s_%s_excl", tableName, strings.Join(columns, "_"))
+	}
+	return &exclusions.Constraint{
+		Name:     name,
+		Using:    using,
+		Elements: elements,
+	}, nil
+}
+
+// nodeExcludeOperator returns the name of the operator that compares the values of an exclusion constraint's element.
+// Only operators that are commutative may be used, as a conflict between two rows must not depend on which row was
+// written first.
+func nodeExcludeOperator(op tree.Operator, using string) (string, error) {
+	var operator string
+	switch op {
+	case tree.EQ:
+		return "=", nil
+	case tree.NE:
+		operator = "<>"
+	case tree.Overlaps:
+		operator = "&&"
+	case tree.LT, tree.GT, tree.LE, tree.GE:
+		return "", pgerrors.Newf(pgcode.WrongObjectType, "operator %s is not commutative", op).
+			WithDetail("Only commutative operators can be used in exclusion constraints.")
+	default:
+		return "", pgerrors.Newf(pgcode.FeatureNotSupported,
+			"operator %s is not yet supported for exclusion constraints", op)
+	}
+	// B-tree and hash indexes only support equality, while the other operators require a GiST index
+	if using != "gist" {
+		return "", pgerrors.Newf(pgcode.WrongObjectType, `operator %s is not supported by access method "%s"`, operator, using)
+	}
+	return operator, nil
+}
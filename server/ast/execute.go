@@ -15,11 +15,10 @@
 package ast
 
 import (
-	"fmt"
-
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeExecute handles *tree.Execute nodes.
@@ -27,5 +26,13 @@ func nodeExecute(node *tree.Execute) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	return nil, fmt.Errorf("EXECUTE is not yet supported")
+	// The parameters are evaluated by the connection handler before they're bound to the prepared statement
+	parameters := make([]string, len(node.Params))
+	for i, param := range node.Params {
+		parameters[i] = tree.AsString(param)
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewExecute(string(node.Name), parameters),
+		Children:  nil,
+	}, nil
 }
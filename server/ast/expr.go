@@ -128,7 +128,18 @@ func nodeExpr(node tree.Expr) (vitess.Expr, error) {
 			Children:   unresolvedChildren,
 		}, nil
 	case *tree.ArrayFlatten:
-		return nil, fmt.Errorf("flattening arrays is not yet supported")
+		subquery, ok := node.Subquery.(*tree.Subquery)
+		if !ok {
+			return nil, fmt.Errorf("ARRAY expects a subquery")
+		}
+		vitessSubquery, err := nodeSubquery(subquery)
+		if err != nil {
+			return nil, err
+		}
+		return vitess.InjectedExpr{
+			Expression: pgexprs.NewArrayFlatten(),
+			Children:   vitess.Exprs{vitessSubquery},
+		}, nil
 	case *tree.BinaryExpr:
 		left, err := nodeExpr(node.Left)
 		if err != nil {
@@ -265,7 +276,13 @@ func nodeExpr(node tree.Expr) (vitess.Expr, error) {
 			Exprs: exprs,
 		}, nil
 	case *tree.CollateExpr:
-		return nil, fmt.Errorf("collations are not yet supported")
+		// Strings are always compared by their bytes, which is what the default and C collations do
+		switch strings.TrimPrefix(node.Locale, "pg_catalog.") {
+		case "default", "C", "POSIX", "ucs_basic":
+			return nodeExpr(node.Expr)
+		default:
+			return nil, fmt.Errorf("collations are not yet supported")
+		}
 	case *tree.ColumnAccessExpr:
 		return nil, fmt.Errorf("(E).x is not yet supported")
 	case *tree.ColumnItem:
@@ -306,6 +323,10 @@ func nodeExpr(node tree.Expr) (vitess.Expr, error) {
 		case tree.NE:
 			operator = vitess.NotEqualStr
 		case tree.In:
+			if _, ok := right.(*vitess.Subquery); ok {
+				operator = vitess.InStr
+				break
+			}
 			return vitess.InjectedExpr{
 				Expression: pgexprs.NewInTuple(),
 				Children:   vitess.Exprs{left, right},
@@ -333,7 +354,11 @@ func nodeExpr(node tree.Expr) (vitess.Expr, error) {
 		case tree.NotRegIMatch:
 			return nil, fmt.Errorf("~* is not yet supported")
 		case tree.TextSearchMatch:
-			return nil, fmt.Errorf("@@ is not yet supported")
+			// TODO: text search also uses this operator, but only JSON paths are currently supported
+			return vitess.InjectedExpr{
+				Expression: pgexprs.NewBinaryOperator(framework.Operator_BinaryJSONPathMatch),
+				Children:   vitess.Exprs{left, right},
+			}, nil
 		case tree.IsDistinctFrom:
 			return nil, fmt.Errorf("IS DISTINCT FROM is not yet supported")
 		case tree.IsNotDistinctFrom:
@@ -363,14 +388,15 @@ func nodeExpr(node tree.Expr) (vitess.Expr, error) {
 				Expression: pgexprs.NewBinaryOperator(framework.Operator_BinaryJSONTopLevelAll),
 				Children:   vitess.Exprs{left, right},
 			}, nil
+		case tree.JSONPathExists:
+			return vitess.InjectedExpr{
+				Expression: pgexprs.NewBinaryOperator(framework.Operator_BinaryJSONPathExists),
+				Children:   vitess.Exprs{left, right},
+			}, nil
 		case tree.Overlaps:
 			return nil, fmt.Errorf("&& is not yet supported")
-		case tree.Any:
-			return nil, fmt.Errorf("ANY is not yet supported")
-		case tree.Some:
-			return nil, fmt.Errorf("SOME is not yet supported")
-		case tree.All:
-			return nil, fmt.Errorf("ALL is not yet supported")
+		case tree.Any, tree.Some, tree.All:
+			return nodeArrayComparison(node, left, right)
 		default:
 			return nil, fmt.Errorf("unknown comparison operator used")
 		}
@@ -411,7 +437,9 @@ func nodeExpr(node tree.Expr) (vitess.Expr, error) {
 	case *tree.DInt:
 		return nil, fmt.Errorf("the statement is not yet supported")
 	case *tree.DInterval:
-		return nil, fmt.Errorf("the statement is not yet supported")
+		return vitess.InjectedExpr{
+			Expression: pgexprs.NewRawLiteralInterval(node.Duration),
+		}, nil
 	case *tree.DJSON:
 		return nil, fmt.Errorf("the statement is not yet supported")
 	case *tree.DOid:
@@ -447,7 +475,24 @@ func nodeExpr(node tree.Expr) (vitess.Expr, error) {
 		//TODO: figure out if I can delete this
 		return nil, fmt.Errorf("this should probably be deleted (internal error, IndexedVar)")
 	case *tree.IndirectionExpr:
-		return nil, fmt.Errorf("subscripts are not yet supported")
+		expr, err := nodeExpr(node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		for _, subscript := range node.Indirection {
+			if subscript.Slice {
+				return nil, fmt.Errorf("array slices are not yet supported")
+			}
+			index, err := nodeExpr(subscript.Begin)
+			if err != nil {
+				return nil, err
+			}
+			expr = vitess.InjectedExpr{
+				Expression: pgexprs.NewSubscript(),
+				Children:   vitess.Exprs{expr, index},
+			}
+		}
+		return expr, nil
 	case *tree.IsNotNullExpr:
 		expr, err := nodeExpr(node.Expr)
 		if err != nil {
@@ -589,14 +634,17 @@ func nodeExpr(node tree.Expr) (vitess.Expr, error) {
 		if len(node.Labels) > 0 {
 			return nil, fmt.Errorf("tuple labels are not yet supported")
 		}
-		if node.Row {
-			return nil, fmt.Errorf("ROW keyword for tuples not yet supported")
-		}
 
 		valTuple, err := nodeExprs(node.Exprs)
 		if err != nil {
 			return nil, err
 		}
+		if node.Row {
+			return vitess.InjectedExpr{
+				Expression: pgexprs.NewRecord(),
+				Children:   valTuple,
+			}, nil
+		}
 		return vitess.ValTuple(valTuple), nil
 	case *tree.TupleStar:
 		return nil, fmt.Errorf("(E).* is not yet supported")
@@ -655,6 +703,49 @@ func nodeExpr(node tree.Expr) (vitess.Expr, error) {
 	}
 }
 
+// nodeArrayComparison handles the ANY, SOME, and ALL comparisons of *tree.ComparisonExpr nodes, which compare the left
+// value against every element of an array or every row of a subquery.
+func nodeArrayComparison(node *tree.ComparisonExpr, left vitess.Expr, right vitess.Expr) (vitess.Expr, error) {
+	all := node.Operator == tree.All
+	var operator string
+	switch node.SubOperator {
+	case tree.EQ:
+		operator = vitess.EqualStr
+	case tree.NE:
+		operator = vitess.NotEqualStr
+	case tree.LT:
+		operator = vitess.LessThanStr
+	case tree.LE:
+		operator = vitess.LessEqualStr
+	case tree.GT:
+		operator = vitess.GreaterThanStr
+	case tree.GE:
+		operator = vitess.GreaterEqualStr
+	default:
+		return nil, fmt.Errorf("%s %s is not yet supported", node.SubOperator.String(), node.Operator.String())
+	}
+	if _, ok := right.(*vitess.Subquery); ok {
+		// = ANY and <> ALL are the only subquery comparisons with an equivalent in GMS
+		switch {
+		case operator == vitess.EqualStr && !all:
+			operator = vitess.InStr
+		case operator == vitess.NotEqualStr && all:
+			operator = vitess.NotInStr
+		default:
+			return nil, fmt.Errorf("%s %s with a subquery is not yet supported", node.SubOperator.String(), node.Operator.String())
+		}
+		return &vitess.ComparisonExpr{
+			Operator: operator,
+			Left:     left,
+			Right:    right,
+		}, nil
+	}
+	return vitess.InjectedExpr{
+		Expression: pgexprs.NewArrayComparison(operator, all),
+		Children:   vitess.Exprs{left, right},
+	}, nil
+}
+
 // translateConvertType translates the *vitess.ConvertType expression given to a new one, substituting type names as
 // appropriate. An error is returned if the type named cannot be supported.
 func translateConvertType(convertType *vitess.ConvertType) (*vitess.ConvertType, error) {
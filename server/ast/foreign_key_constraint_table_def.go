@@ -16,10 +16,13 @@ package ast
 
 import (
 	"fmt"
+	"strings"
 
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/pgerrors"
 )
 
 // nodeForeignKeyConstraintTableDef handles *tree.ForeignKeyConstraintTableDef nodes.
@@ -45,6 +48,8 @@ func nodeForeignKeyConstraintTableDef(node *tree.ForeignKeyConstraintTableDef) (
 	for i := range node.FromCols {
 		fromCols[i] = vitess.NewColIdent(string(node.FromCols[i]))
 	}
+	// Referenced columns may be omitted, in which case they're filled in with the primary key of the referenced table
+	// during analysis
 	toCols := make([]vitess.ColIdent, len(node.ToCols))
 	for i := range node.ToCols {
 		toCols[i] = vitess.NewColIdent(string(node.ToCols[i]))
@@ -63,10 +68,8 @@ func nodeForeignKeyConstraintTableDef(node *tree.ForeignKeyConstraintTableDef) (
 			}
 		case tree.SetDefault:
 			// GMS doesn't support this as MySQL doesn't support this
-			refActions[i] = vitess.SetDefault
-			if refAction.Columns != nil {
-				return nil, fmt.Errorf("SET NULL <columns> is not yet supported")
-			}
+			return nil, pgerrors.Newf(pgcode.FeatureNotSupported, "ON %s SET DEFAULT is not yet supported",
+				[]string{"DELETE", "UPDATE"}[i])
 		case tree.Cascade:
 			refActions[i] = vitess.Cascade
 		default:
@@ -81,3 +84,13 @@ func nodeForeignKeyConstraintTableDef(node *tree.ForeignKeyConstraintTableDef) (
 		OnUpdate:          refActions[1],
 	}, nil
 }
+
+// foreignKeyName returns the name that Postgres gives to an unnamed foreign key on the given columns of the table.
+func foreignKeyName(tableName string, columns tree.NameList) string {
+	parts := make([]string, 0, len(columns)+2)
+	parts = append(parts, tableName)
+	for _, column := range columns {
+		parts = append(parts, string(column))
+	}
+	return strings.Join(append(parts, "fkey"), "_")
+}
@@ -0,0 +1,33 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+)
+
+// nodeForeignOptions handles tree.ForeignOptions nodes, returning the value of each option keyed by the option's name.
+func nodeForeignOptions(node tree.ForeignOptions) (map[string]string, error) {
+	options := make(map[string]string, len(node))
+	for _, option := range node {
+		if _, ok := options[string(option.Name)]; ok {
+			return nil, fmt.Errorf(`option "%s" provided more than once`, option.Name)
+		}
+		options[string(option.Name)] = option.Value
+	}
+	return options, nil
+}
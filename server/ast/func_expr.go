@@ -16,14 +16,21 @@ package ast
 
 import (
 	"fmt"
+	"strings"
+	"sync"
 
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dfunctions"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/procedures"
 )
 
 // nodeFuncExpr handles *tree.FuncExpr nodes.
-func nodeFuncExpr(node *tree.FuncExpr) (*vitess.FuncExpr, error) {
+func nodeFuncExpr(node *tree.FuncExpr) (vitess.Expr, error) {
 	if node == nil {
 		return nil, nil
 	}
@@ -33,9 +40,6 @@ func nodeFuncExpr(node *tree.FuncExpr) (*vitess.FuncExpr, error) {
 	if node.AggType == tree.OrderedSetAgg {
 		return nil, fmt.Errorf("WITHIN GROUP is not yet supported")
 	}
-	if len(node.OrderBy) > 0 {
-		return nil, fmt.Errorf("function ORDER BY is not yet supported")
-	}
 	var qualifier vitess.TableIdent
 	var name vitess.ColIdent
 	switch funcRef := node.Func.FunctionReference.(type) {
@@ -52,6 +56,16 @@ func nodeFuncExpr(node *tree.FuncExpr) (*vitess.FuncExpr, error) {
 	default:
 		return nil, fmt.Errorf("unknown function reference")
 	}
+	if name.Lowered() == "string_agg" && (qualifier.IsEmpty() || qualifier.String() == "pg_catalog") {
+		return nodeStringAgg(node)
+	}
+	if doltgresFunction, ok := doltFunctions[name.Lowered()]; ok && qualifier.IsEmpty() {
+		// Dolt's function is replaced by our own, since Dolt's function cannot accept our types
+		name = vitess.NewColIdent(doltgresFunction)
+	}
+	if len(node.OrderBy) > 0 {
+		return nil, fmt.Errorf("function ORDER BY is not yet supported")
+	}
 	var distinct bool
 	switch node.Type {
 	case 0, tree.AllFuncType:
@@ -65,6 +79,20 @@ func nodeFuncExpr(node *tree.FuncExpr) (*vitess.FuncExpr, error) {
 	if err != nil {
 		return nil, err
 	}
+	// Functions that are not built in are expected to be user-defined functions, which are resolved by the analyzer
+	if !isBuiltInFunction(name.Lowered()) {
+		if distinct || windowDef != nil {
+			return nil, fmt.Errorf("calling user-defined functions as aggregates or window functions is not yet supported")
+		}
+		args, err := nodeExprs(node.Exprs)
+		if err != nil {
+			return nil, err
+		}
+		return vitess.InjectedExpr{
+			Expression: pgexprs.NewUnresolvedFunction(qualifier.String(), name.String()),
+			Children:   args,
+		}, nil
+	}
 	exprs, err := nodeExprsToSelectExprs(node.Exprs)
 	if err != nil {
 		return nil, err
@@ -77,3 +105,72 @@ func nodeFuncExpr(node *tree.FuncExpr) (*vitess.FuncExpr, error) {
 		Over:      (*vitess.Over)(windowDef),
 	}, nil
 }
+
+// nodeStringAgg handles calls to the string_agg aggregate function, which is equivalent to GROUP_CONCAT in GMS.
+func nodeStringAgg(node *tree.FuncExpr) (vitess.Expr, error) {
+	if len(node.Exprs) != 2 {
+		return nil, fmt.Errorf("function string_agg must be given exactly 2 arguments")
+	}
+	if node.WindowDef != nil {
+		return nil, fmt.Errorf("string_agg is not yet supported as a window function")
+	}
+	// GROUP_CONCAT only accepts a constant separator
+	separator, ok := node.Exprs[1].(*tree.StrVal)
+	if !ok {
+		return nil, fmt.Errorf("string_agg is not yet supported with a delimiter that is not a string constant")
+	}
+	exprs, err := nodeExprsToSelectExprs(node.Exprs[:1])
+	if err != nil {
+		return nil, err
+	}
+	orderBy, err := nodeOrderBy(node.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	var distinct string
+	if node.Type == tree.DistinctFuncType {
+		distinct = vitess.DistinctStr
+	}
+	return &vitess.GroupConcatExpr{
+		Distinct:  distinct,
+		Exprs:     exprs,
+		OrderBy:   orderBy,
+		Separator: vitess.Separator{SeparatorString: separator.RawString()},
+	}, nil
+}
+
+// doltFunctions maps the functions of Dolt that are replaced by our own functions to the name of our function.
+var doltFunctions = map[string]string{
+	"dolt_merge_base": "doltgres_merge_base",
+}
+
+// builtInFunctionNames contains the names of every function that is built into the engine, including those provided
+// by Dolt.
+var builtInFunctionNames map[string]struct{}
+
+// builtInFunctionNamesOnce ensures that builtInFunctionNames is only populated once.
+var builtInFunctionNamesOnce sync.Once
+
+// isBuiltInFunction returns whether the given (lowercase) name refers to a built-in function. Dolt's table functions
+// are only known to the database provider, so every name that begins with "dolt_" is considered to be built in, except
+// for the Dolt procedures that may be called as functions, which are resolved alongside user-defined functions.
+func isBuiltInFunction(name string) bool {
+	if strings.HasPrefix(name, "dolt_") {
+		return !procedures.IsDoltProcedureFunction(name)
+	}
+	builtInFunctionNamesOnce.Do(func() {
+		builtInFunctionNames = make(map[string]struct{})
+		for funcName := range framework.Catalog {
+			builtInFunctionNames[funcName] = struct{}{}
+		}
+		builtInFunctionNames[framework.QueryDiffTableName] = struct{}{}
+		for _, f := range function.BuiltIns {
+			builtInFunctionNames[strings.ToLower(f.FunctionName())] = struct{}{}
+		}
+		for _, f := range dfunctions.DoltFunctions {
+			builtInFunctionNames[strings.ToLower(f.FunctionName())] = struct{}{}
+		}
+	})
+	_, ok := builtInFunctionNames[name]
+	return ok
+}
@@ -1,4 +1,4 @@
-// Copyright 2023 Dolthub, Inc.
+// Copyright 2024 Dolthub, Inc.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -17,9 +17,13 @@ package ast
 import (
 	"fmt"
 
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
+	"github.com/dolthub/doltgresql/postgres/parser/privilege"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/auth"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeGrant handles *tree.Grant nodes.
@@ -27,5 +31,57 @@ func nodeGrant(node *tree.Grant) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	return nil, fmt.Errorf("GRANT is not yet supported")
+	if len(node.PrivsWithCols) > 0 {
+		return nil, fmt.Errorf("GRANT on columns is not yet supported")
+	}
+	target, err := nodePrivilegeTarget(node.Targets)
+	if err != nil {
+		return nil, err
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewGrant(target, node.Privileges, node.Grantees, node.WithGrantOption, node.GrantedBy),
+		Children:  nil,
+	}, nil
+}
+
+// nodePrivilegeTarget converts the targets of GRANT and REVOKE.
+func nodePrivilegeTarget(targets tree.TargetList) (pgnodes.PrivilegeTarget, error) {
+	switch targets.TargetType {
+	case privilege.Table:
+		target := pgnodes.PrivilegeTarget{Kind: auth.ObjectKind_Table}
+		if targets.Tables == nil {
+			target.AllTablesInSchemas = targets.InSchema
+			return target, nil
+		}
+		for _, pattern := range targets.Tables {
+			normalized, err := pattern.NormalizeTablePattern()
+			if err != nil {
+				return pgnodes.PrivilegeTarget{}, err
+			}
+			tableName, ok := normalized.(*tree.TableName)
+			if !ok {
+				return pgnodes.PrivilegeTarget{}, fmt.Errorf("table patterns such as %s are not yet supported", normalized)
+			}
+			if tableName.ExplicitCatalog {
+				return pgnodes.PrivilegeTarget{}, fmt.Errorf("database-qualified table names are not yet supported")
+			}
+			target.Tables = append(target.Tables, doltdb.TableName{
+				Name:   string(tableName.ObjectName),
+				Schema: string(tableName.SchemaName),
+			})
+		}
+		return target, nil
+	case privilege.Schema:
+		return pgnodes.PrivilegeTarget{Kind: auth.ObjectKind_Schema, Names: targets.Names}, nil
+	case privilege.Database:
+		return pgnodes.PrivilegeTarget{Kind: auth.ObjectKind_Database, Names: targets.Databases.ToStrings()}, nil
+	case privilege.Function, privilege.Procedure, privilege.Routine:
+		target := pgnodes.PrivilegeTarget{Kind: auth.ObjectKind_Function, AllRoutinesInSchemas: targets.InSchema}
+		for _, routine := range targets.Routines {
+			target.Names = append(target.Names, string(routine.Name))
+		}
+		return target, nil
+	default:
+		return pgnodes.PrivilegeTarget{}, fmt.Errorf("privileges on %s are not yet supported", targets.TargetType)
+	}
 }
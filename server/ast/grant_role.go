@@ -16,10 +16,13 @@ package ast
 
 import (
 	"fmt"
+	"strings"
 
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/auth"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeGrantRole handles *tree.GrantRole nodes.
@@ -27,5 +30,33 @@ func nodeGrantRole(node *tree.GrantRole) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	return nil, fmt.Errorf("GRANT ROLE is not yet supported")
+	var options auth.MembershipOptions
+	if len(node.WithOption) > 0 {
+		// The option is written as the option's name followed by its value, such as "admin option" or "inherit false"
+		name, value, _ := strings.Cut(node.WithOption, " ")
+		enabled := value != "false"
+		option, err := nodeMembershipOption(&options, name)
+		if err != nil {
+			return nil, err
+		}
+		*option = &enabled
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewGrantRole(node.Roles.ToStrings(), node.Members, options, node.GrantedBy),
+		Children:  nil,
+	}, nil
+}
+
+// nodeMembershipOption returns the field of the options that matches the name of a membership option.
+func nodeMembershipOption(options *auth.MembershipOptions, name string) (**bool, error) {
+	switch strings.ToLower(name) {
+	case "admin":
+		return &options.Admin, nil
+	case "inherit":
+		return &options.Inherit, nil
+	case "set":
+		return &options.Set, nil
+	default:
+		return nil, fmt.Errorf("unrecognized role option \"%s\"", name)
+	}
 }
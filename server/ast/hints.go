@@ -0,0 +1,175 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// queryHint is a single hint that was read from a pg_hint_plan comment, such as HashJoin(a b).
+type queryHint struct {
+	name string
+	args []string
+}
+
+// ApplyQueryHints reads the pg_hint_plan hint comment from the beginning of the query (such as
+// "/*+ HashJoin(a b) Leading(a b) */"), and attaches the hints that the planner understands to the statement. Just like
+// pg_hint_plan, hints that are malformed or unknown are ignored rather than returning an error. Hints that are well
+// formed but which the planner cannot honor, such as the scan method hints, are returned so that the client may be
+// warned. Whether the attached hints are honored is decided by the analyzer, as it depends on the
+// pg_hint_plan.enable_hint parameter.
+func ApplyQueryHints(query string, stmt vitess.Statement) (vitess.Statement, []string) {
+	selectStmt, ok := stmt.(*vitess.Select)
+	if !ok {
+		return stmt, nil
+	}
+	comment := leadingHintComment(query)
+	if len(comment) == 0 {
+		return stmt, nil
+	}
+	hints, err := parseQueryHints(comment)
+	if err != nil || len(hints) == 0 {
+		return stmt, nil
+	}
+	var translated []string
+	var ignored []string
+	for _, hint := range hints {
+		count := len(translated)
+		switch strings.ToLower(hint.name) {
+		case "leading":
+			if len(hint.args) >= 2 {
+				translated = append(translated, fmt.Sprintf("JOIN_ORDER(%s)", strings.Join(hint.args, ",")))
+			}
+		case "hashjoin":
+			if len(hint.args) == 2 {
+				translated = append(translated, fmt.Sprintf("HASH_JOIN(%s)", strings.Join(hint.args, ",")))
+			}
+		case "mergejoin":
+			if len(hint.args) == 2 {
+				translated = append(translated, fmt.Sprintf("MERGE_JOIN(%s)", strings.Join(hint.args, ",")))
+			}
+		case "nestloop":
+			if len(hint.args) == 2 {
+				translated = append(translated, fmt.Sprintf("INNER_JOIN(%s)", strings.Join(hint.args, ",")))
+			}
+		}
+		// The planner has no scan method hints (SeqScan, IndexScan, etc.), and its join hints only cover two tables
+		if len(translated) == count {
+			ignored = append(ignored, hint.String())
+		}
+	}
+	if len(translated) == 0 {
+		return stmt, ignored
+	}
+	selectStmt.Comments = vitess.Comments{[]byte("/*+ " + strings.Join(translated, " ") + " */")}
+	return selectStmt, ignored
+}
+
+// String returns the hint as it would be written in a hint comment.
+func (hint queryHint) String() string {
+	return fmt.Sprintf("%s(%s)", hint.name, strings.Join(hint.args, " "))
+}
+
+// leadingHintComment returns the contents of the hint comment, which must be the first comment in the query and must
+// appear before any other tokens. Returns an empty string if there is no hint comment.
+func leadingHintComment(query string) string {
+	for {
+		query = strings.TrimLeftFunc(query, unicode.IsSpace)
+		if !strings.HasPrefix(query, "--") {
+			break
+		}
+		newline := strings.IndexByte(query, '\n')
+		if newline == -1 {
+			return ""
+		}
+		query = query[newline+1:]
+	}
+	if !strings.HasPrefix(query, "/*+") {
+		return ""
+	}
+	end := strings.Index(query, "*/")
+	if end == -1 {
+		return ""
+	}
+	return query[3:end]
+}
+
+// parseQueryHints parses the contents of a hint comment. Hints are a name followed by a parenthesized list of
+// arguments, which are separated by whitespace. Parentheses nested within the arguments (which are used by Leading to
+// specify join direction) are flattened, as the join direction is not yet honored.
+func parseQueryHints(comment string) ([]queryHint, error) {
+	var hints []queryHint
+	pos := 0
+	for {
+		for pos < len(comment) && unicode.IsSpace(rune(comment[pos])) {
+			pos++
+		}
+		if pos >= len(comment) {
+			return hints, nil
+		}
+		start := pos
+		for pos < len(comment) && (unicode.IsLetter(rune(comment[pos])) || comment[pos] == '_') {
+			pos++
+		}
+		hint := queryHint{name: comment[start:pos]}
+		for pos < len(comment) && unicode.IsSpace(rune(comment[pos])) {
+			pos++
+		}
+		if len(hint.name) == 0 || pos >= len(comment) || comment[pos] != '(' {
+			return nil, fmt.Errorf("malformed hint at position %d", start)
+		}
+		depth := 0
+		for ; ; pos++ {
+			if pos >= len(comment) {
+				return nil, fmt.Errorf("unterminated hint at position %d", start)
+			}
+			c := comment[pos]
+			if c == '(' {
+				depth++
+				continue
+			} else if c == ')' {
+				depth--
+				if depth == 0 {
+					pos++
+					break
+				}
+				continue
+			} else if unicode.IsSpace(rune(c)) {
+				continue
+			}
+			argStart := pos
+			if c == '"' {
+				end := strings.IndexByte(comment[pos+1:], '"')
+				if end == -1 {
+					return nil, fmt.Errorf("unterminated identifier at position %d", pos)
+				}
+				pos += end + 1
+				hint.args = append(hint.args, comment[argStart+1:pos])
+				continue
+			}
+			for pos+1 < len(comment) && !unicode.IsSpace(rune(comment[pos+1])) &&
+				comment[pos+1] != '(' && comment[pos+1] != ')' {
+				pos++
+			}
+			// Unquoted identifiers are folded to lowercase, just like the rest of the query
+			hint.args = append(hint.args, strings.ToLower(comment[argStart:pos+1]))
+		}
+		hints = append(hints, hint)
+	}
+}
@@ -20,7 +20,9 @@ import (
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 	"github.com/sirupsen/logrus"
 
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/pgerrors"
 )
 
 // nodeIndexTableDef handles *tree.IndexTableDef nodes. The parser does not store type information in the index
@@ -40,8 +42,14 @@ func nodeIndexTableDef(node *tree.IndexTableDef) (*vitess.IndexDefinition, error
 	}
 	columns := make([]*vitess.IndexColumn, len(node.Columns))
 	for i, indexElem := range node.Columns {
+		column := indexElem.Column
 		if indexElem.Expr != nil {
-			return nil, fmt.Errorf("expression index attribute is not yet supported")
+			// An expression that only references a column is the same as the column itself
+			var ok bool
+			if column, ok = indexExprColumn(indexElem.Expr); !ok {
+				return nil, pgerrors.Newf(pgcode.FeatureNotSupported,
+					"expression indexes are not yet supported: %s", tree.AsString(indexElem.Expr))
+			}
 		}
 		if indexElem.Collation != "" {
 			return nil, fmt.Errorf("index attribute collation is not yet supported")
@@ -55,13 +63,11 @@ func nodeIndexTableDef(node *tree.IndexTableDef) (*vitess.IndexDefinition, error
 		case tree.Ascending:
 			// The only default supported in GMS for now
 		case tree.Descending:
+			// The sort order of an index does not change the results of a query, only how quickly they're found
 			logrus.Warn("descending indexes are not yet supported, ignoring sort order")
 		default:
 			return nil, fmt.Errorf("unknown index sorting direction encountered")
 		}
-		if indexElem.Direction != tree.Ascending {
-			logrus.Warn("descending indexes are not yet supported, ignoring sort order")
-		}
 		switch indexElem.NullsOrder {
 		case tree.DefaultNullsOrder:
 			//TODO: the default NULL order is reversed compared to MySQL, so the default is technically always wrong.
@@ -77,7 +83,7 @@ func nodeIndexTableDef(node *tree.IndexTableDef) (*vitess.IndexDefinition, error
 			return nil, fmt.Errorf("index attribute exclude operator is not yet supported")
 		}
 		columns[i] = &vitess.IndexColumn{
-			Column: vitess.NewColIdent(string(indexElem.Column)),
+			Column: vitess.NewColIdent(string(column)),
 			Order:  vitess.AscScr,
 		}
 	}
@@ -89,3 +95,21 @@ func nodeIndexTableDef(node *tree.IndexTableDef) (*vitess.IndexDefinition, error
 		Columns: columns,
 	}, nil
 }
+
+// indexExprColumn returns the column that the index expression refers to, when the expression is nothing more than a
+// (possibly parenthesized) column reference.
+func indexExprColumn(expr tree.Expr) (tree.Name, bool) {
+	for {
+		switch e := expr.(type) {
+		case *tree.ParenExpr:
+			expr = e.Expr
+		case *tree.UnresolvedName:
+			if e.Star || e.NumParts != 1 {
+				return "", false
+			}
+			return tree.Name(e.Parts[0]), true
+		default:
+			return "", false
+		}
+	}
+}
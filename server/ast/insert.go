@@ -17,30 +17,36 @@ package ast
 import (
 	"fmt"
 
+	"github.com/dolthub/go-mysql-server/sql/planbuilder"
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
 )
 
 // nodeInsert handles *tree.Insert nodes.
-func nodeInsert(node *tree.Insert) (*vitess.Insert, error) {
+func nodeInsert(node *tree.Insert) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	if _, ok := node.Returning.(*tree.NoReturningClause); !ok {
-		return nil, fmt.Errorf("RETURNING is not yet supported")
-	}
 	var ignore string
+	var onDup vitess.OnDup
 	if node.OnConflict != nil {
-		// Currently, only ON CONFLICT DO NOTHING is supported, which is equivalent to INSERT IGNORE in GMS
-		if node.OnConflict.Columns != nil ||
-			node.OnConflict.ArbiterPredicate != nil ||
-			node.OnConflict.Exprs != nil ||
-			node.OnConflict.Where != nil ||
-			!node.OnConflict.DoNothing {
+		if node.OnConflict.IsUpsertAlias() {
 			return nil, fmt.Errorf("the ON CONFLICT clause provided is not yet supported")
 		}
-		ignore = vitess.IgnoreStr
+		// TODO: GMS checks every unique index for conflicts, so the conflict target is not yet used to limit which
+		//  conflicts are handled. As all indexes are non-partial, any arbiter predicate is satisfied by them.
+		if node.OnConflict.DoNothing {
+			// ON CONFLICT DO NOTHING is equivalent to INSERT IGNORE in GMS
+			ignore = vitess.IgnoreStr
+		} else {
+			var err error
+			onDup, err = nodeOnConflictUpdate(node.OnConflict)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 	var tableName vitess.TableName
 	switch node := node.Table.(type) {
@@ -76,18 +82,94 @@ func nodeInsert(node *tree.Insert) (*vitess.Insert, error) {
 
 	// GMS For a ValuesStatement with simple rows, GMS expects AliasedValues
 	if vSelect, ok := rows.(*vitess.Select); ok && len(vSelect.From) == 1 {
-		if valsStmt, ok := vSelect.From[0].(*vitess.ValuesStatement); ok {
-			rows = &vitess.AliasedValues{
-				Values: valsStmt.Rows,
+		if aliasedStmt, ok := vSelect.From[0].(*vitess.AliasedTableExpr); ok {
+			if valsStmt, ok := aliasedStmt.Expr.(*vitess.ValuesStatement); ok {
+				rows = &vitess.AliasedValues{
+					Values: valsStmt.Rows,
+				}
 			}
 		}
 	}
-	return &vitess.Insert{
+	if node.Overriding != tree.OverridingNone {
+		aliasedValues, ok := rows.(*vitess.AliasedValues)
+		if !ok {
+			return nil, fmt.Errorf("OVERRIDING is not yet supported for INSERT statements without VALUES")
+		}
+		aliasedValues.Values = nodeInsertOverriding(node.Overriding, aliasedValues.Values)
+	}
+	insert := &vitess.Insert{
 		Action:  vitess.InsertStr,
 		Ignore:  ignore,
 		Table:   tableName,
 		With:    with,
 		Columns: columns,
 		Rows:    rows,
-	}, nil
+		OnDup:   onDup,
+	}
+	return nodeReturning(node, insert, node.Returning, &vitess.AliasedTableExpr{Expr: tableName})
+}
+
+// nodeOnConflictUpdate handles the assignments of ON CONFLICT DO UPDATE, which are equivalent to ON DUPLICATE KEY
+// UPDATE in GMS. References to the EXCLUDED pseudo-table are references to the values that GMS would have inserted.
+// The WHERE clause wraps the first assignment, and is applied to all of the assignments during analysis.
+func nodeOnConflictUpdate(node *tree.OnConflict) (vitess.OnDup, error) {
+	assignments, err := nodeUpdateExprs(node.Exprs)
+	if err != nil {
+		return nil, err
+	}
+	if node.Where != nil && node.Where.Expr != nil && len(assignments) > 0 {
+		condition, err := nodeExpr(node.Where.Expr)
+		if err != nil {
+			return nil, err
+		}
+		assignments[0].Expr = vitess.InjectedExpr{
+			Expression: pgexprs.NewOnConflictWhere(),
+			Children:   vitess.Exprs{condition, assignments[0].Expr},
+		}
+	}
+	if err = vitess.Walk(replaceExcludedQualifier, assignments); err != nil {
+		return nil, err
+	}
+	return vitess.OnDup(assignments), nil
+}
+
+// replaceExcludedQualifier is a vitess.Visit function that qualifies column references to the EXCLUDED pseudo-table
+// with the name that GMS gives to the values that would have been inserted.
+func replaceExcludedQualifier(node vitess.SQLNode) (bool, error) {
+	switch node := node.(type) {
+	case *vitess.ColName:
+		if node.Qualifier.Name.String() == "excluded" && node.Qualifier.DbQualifier.IsEmpty() &&
+			node.Qualifier.SchemaQualifier.IsEmpty() {
+			node.Qualifier.Name = vitess.NewTableIdent(planbuilder.OnDupValuesPrefix)
+		}
+	case vitess.InjectedExpr:
+		// Injected expressions do not walk their own children
+		for _, child := range node.Children {
+			if err := vitess.Walk(replaceExcludedQualifier, child); err != nil {
+				return false, err
+			}
+		}
+	}
+	return true, nil
+}
+
+// nodeInsertOverriding wraps each inserted value with a marker for the given OVERRIDING clause. The markers are resolved
+// during analysis, as that is when we know which columns are identity columns.
+func nodeInsertOverriding(overriding tree.InsertOverriding, values vitess.Values) vitess.Values {
+	kind := pgexprs.OverridingSystemValue
+	if overriding == tree.OverridingUserValue {
+		kind = pgexprs.OverridingUserValue
+	}
+	for _, row := range values {
+		for i, value := range row {
+			if _, ok := value.(*vitess.Default); ok {
+				continue
+			}
+			row[i] = vitess.InjectedExpr{
+				Expression: pgexprs.NewOverriding(kind),
+				Children:   vitess.Exprs{value},
+			}
+		}
+	}
+	return values
 }
@@ -0,0 +1,69 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/locks"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// nodeLockTable handles *tree.LockTable nodes.
+func nodeLockTable(node *tree.LockTable) (vitess.Statement, error) {
+	if node == nil {
+		return nil, nil
+	}
+	tables := make([]doltdb.TableName, len(node.Tables))
+	for i := range node.Tables {
+		tableName, err := nodeTableName(&node.Tables[i])
+		if err != nil {
+			return nil, err
+		}
+		if len(tableName.DbQualifier.String()) > 0 {
+			return nil, fmt.Errorf("LOCK is currently only supported for tables in the current database")
+		}
+		tables[i] = doltdb.TableName{Name: tableName.Name.String(), Schema: tableName.SchemaQualifier.String()}
+	}
+	var mode locks.Mode
+	switch node.Mode {
+	case tree.LockAccessShare:
+		mode = locks.AccessShare
+	case tree.LockRowShare:
+		mode = locks.RowShare
+	case tree.LockRowExclusive:
+		mode = locks.RowExclusive
+	case tree.LockShareUpdateExclusive:
+		mode = locks.ShareUpdateExclusive
+	case tree.LockShare:
+		mode = locks.Share
+	case tree.LockShareRowExclusive:
+		mode = locks.ShareRowExclusive
+	case tree.LockExclusive:
+		mode = locks.Exclusive
+	case tree.LockAccessExclusive:
+		mode = locks.AccessExclusive
+	default:
+		return nil, fmt.Errorf("unknown lock mode: `%s`", node.Mode.String())
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewLockTable(tables, mode, node.NoWait),
+		Children:  nil,
+	}, nil
+}
@@ -19,13 +19,81 @@ import (
 
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	"github.com/dolthub/doltgresql/server/locks"
+	"github.com/dolthub/doltgresql/server/pgerrors"
 )
 
-// nodeLockingClause handles *tree.LockingClause nodes.
-func nodeLockingClause(node tree.LockingClause) (vitess.Statement, error) {
-	if len(node) == 0 {
-		return nil, nil
+// nodeLockingClause handles the tree.LockingClause of a top-level SELECT statement, which has already been converted
+// without it. The clauses are added as the statement's last ORDER BY expression, which is removed during analysis, where
+// the rows of the locked tables are locked as they're read. Locking clauses within subqueries are not yet supported.
+func nodeLockingClause(node *tree.Select, stmt vitess.SelectStatement) error {
+	if len(node.Locking) == 0 {
+		return nil
 	}
-	return nil, fmt.Errorf("locking clauses are not yet supported")
+	clause := node.Locking[0].Strength.String()
+	var selectStmt *vitess.Select
+	switch selectClause := node.Select.(type) {
+	case *tree.SelectClause:
+		switch {
+		case selectClause.Distinct:
+			return pgerrors.Newf(pgcode.FeatureNotSupported, "%s is not allowed with DISTINCT clause", clause)
+		case len(selectClause.GroupBy) > 0:
+			return pgerrors.Newf(pgcode.FeatureNotSupported, "%s is not allowed with GROUP BY clause", clause)
+		case selectClause.Having != nil:
+			return pgerrors.Newf(pgcode.FeatureNotSupported, "%s is not allowed with HAVING clause", clause)
+		}
+		selectStmt, _ = stmt.(*vitess.Select)
+	case *tree.UnionClause:
+		return pgerrors.Newf(pgcode.FeatureNotSupported, "%s is not allowed with UNION/INTERSECT/EXCEPT", clause)
+	case *tree.ValuesClause:
+		return pgerrors.Newf(pgcode.FeatureNotSupported, "%s cannot be applied to VALUES", clause)
+	}
+	if selectStmt == nil {
+		return fmt.Errorf("%s is not yet supported for this SELECT statement", clause)
+	}
+	clauses := make([]pgexprs.RowLockingClause, len(node.Locking))
+	for i, item := range node.Locking {
+		var mode locks.RowMode
+		switch item.Strength {
+		case tree.ForKeyShare:
+			mode = locks.ForKeyShare
+		case tree.ForShare:
+			mode = locks.ForShare
+		case tree.ForNoKeyUpdate:
+			mode = locks.ForNoKeyUpdate
+		case tree.ForUpdate:
+			mode = locks.ForUpdate
+		default:
+			return fmt.Errorf("unknown locking strength: `%s`", item.Strength.String())
+		}
+		var policy locks.WaitPolicy
+		switch item.WaitPolicy {
+		case tree.LockWaitBlock:
+			policy = locks.WaitBlock
+		case tree.LockWaitSkip:
+			policy = locks.WaitSkip
+		case tree.LockWaitError:
+			policy = locks.WaitError
+		default:
+			return fmt.Errorf("unknown locking wait policy: `%s`", item.WaitPolicy.String())
+		}
+		targets := make([]string, len(item.Targets))
+		for j, target := range item.Targets {
+			if target.ExplicitSchema || target.ExplicitCatalog {
+				return pgerrors.Newf(pgcode.Syntax, "%s must specify unqualified relation names", item.Strength.String())
+			}
+			targets[j] = string(target.ObjectName)
+		}
+		clauses[i] = pgexprs.RowLockingClause{Mode: mode, Policy: policy, Targets: targets}
+	}
+	selectStmt.OrderBy = append(selectStmt.OrderBy, &vitess.Order{
+		Expr: vitess.InjectedExpr{
+			Expression: pgexprs.NewRowLocking(clauses),
+		},
+		Direction: vitess.AscScr,
+	})
+	return nil
 }
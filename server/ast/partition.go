@@ -0,0 +1,155 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/doltgresql/core/partitions"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+)
+
+// nodePartitionBy handles *tree.PartitionBy nodes, returning the partitioned table that it describes. The table's name
+// is not set, as it is determined by the statement.
+func nodePartitionBy(node *tree.PartitionBy) (*partitions.Table, error) {
+	if node == nil {
+		return nil, nil
+	}
+	strategy, err := partitions.StrategyFromString(string(node.Type))
+	if err != nil {
+		return nil, err
+	}
+	if strategy == partitions.Strategy_List && len(node.Elems) != 1 {
+		return nil, fmt.Errorf("PARTITION BY LIST must have a single column or expression")
+	}
+	table := &partitions.Table{
+		Strategy: strategy,
+		Columns:  make([]string, len(node.Elems)),
+	}
+	for i, elem := range node.Elems {
+		if len(elem.Column) == 0 {
+			return nil, fmt.Errorf("partition key expressions are not yet supported")
+		}
+		if len(elem.Collation) > 0 || elem.OpClass != nil {
+			return nil, fmt.Errorf("collations and operator classes within a partition key are not yet supported")
+		}
+		table.Columns[i] = string(elem.Column)
+	}
+	return table, nil
+}
+
+// nodePartitionBoundSpec handles tree.PartitionBoundSpec nodes, returning the partition that it describes along with
+// the partitioning strategy that the bound was written for. The partition's name and parent are not set, as they're
+// determined by the statement.
+func nodePartitionBoundSpec(node tree.PartitionBoundSpec) (*partitions.Partition, partitions.Strategy, error) {
+	partition := &partitions.Partition{IsDefault: node.IsDefault}
+	if node.IsDefault {
+		return partition, 0, nil
+	}
+	var err error
+	switch node.Type {
+	case tree.PartitionBoundIn:
+		if partition.Values, err = nodePartitionBoundValues(node.From); err != nil {
+			return nil, 0, err
+		}
+		return partition, partitions.Strategy_List, nil
+	case tree.PartitionBoundFromTo:
+		if partition.From, err = nodePartitionBoundValues(node.From); err != nil {
+			return nil, 0, err
+		}
+		if partition.To, err = nodePartitionBoundValues(node.To); err != nil {
+			return nil, 0, err
+		}
+		return partition, partitions.Strategy_Range, nil
+	case tree.PartitionBoundWith:
+		if partition.Modulus, err = nodePartitionBoundInteger(node.From, "modulus"); err != nil {
+			return nil, 0, err
+		}
+		if partition.Remainder, err = nodePartitionBoundInteger(node.To, "remainder"); err != nil {
+			return nil, 0, err
+		}
+		return partition, partitions.Strategy_Hash, nil
+	default:
+		return nil, 0, fmt.Errorf("unknown partition bound encountered")
+	}
+}
+
+// nodePartitionBoundValues converts the expressions of a partition bound to their values. Only constants, along with
+// MINVALUE and MAXVALUE, are supported.
+func nodePartitionBoundValues(exprs tree.Exprs) ([]partitions.Value, error) {
+	values := make([]partitions.Value, len(exprs))
+	for i, expr := range exprs {
+		var err error
+		if values[i], err = nodePartitionBoundValue(expr); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// nodePartitionBoundValue converts a single expression of a partition bound to its value.
+func nodePartitionBoundValue(expr tree.Expr) (partitions.Value, error) {
+	switch expr := expr.(type) {
+	case *tree.StrVal:
+		return partitions.Value{Kind: partitions.ValueKind_Literal, Literal: expr.RawString()}, nil
+	case *tree.NumVal:
+		return partitions.Value{Kind: partitions.ValueKind_Literal, Literal: expr.FormattedString()}, nil
+	case *tree.DBool:
+		return partitions.Value{Kind: partitions.ValueKind_Literal, Literal: expr.String()}, nil
+	case *tree.CastExpr:
+		// The value is converted to the type of the partition key's column, so the cast itself is not needed
+		return nodePartitionBoundValue(expr.Expr)
+	case *tree.ParenExpr:
+		return nodePartitionBoundValue(expr.Expr)
+	case tree.NullLiteral:
+		return partitions.Value{Kind: partitions.ValueKind_Null}, nil
+	case tree.PartitionMinVal:
+		return partitions.Value{Kind: partitions.ValueKind_MinValue}, nil
+	case tree.PartitionMaxVal:
+		return partitions.Value{Kind: partitions.ValueKind_MaxValue}, nil
+	case *tree.UnresolvedName:
+		if expr.NumParts == 1 {
+			switch strings.ToLower(expr.Parts[0]) {
+			case "minvalue":
+				return partitions.Value{Kind: partitions.ValueKind_MinValue}, nil
+			case "maxvalue":
+				return partitions.Value{Kind: partitions.ValueKind_MaxValue}, nil
+			}
+		}
+		return partitions.Value{}, fmt.Errorf("cannot use column reference in partition bound expression")
+	default:
+		return partitions.Value{}, fmt.Errorf("partition bound expressions other than constants are not yet supported")
+	}
+}
+
+// nodePartitionBoundInteger converts the single expression of a hash partition's modulus or remainder to its value.
+func nodePartitionBoundInteger(exprs tree.Exprs, name string) (uint64, error) {
+	if len(exprs) != 1 {
+		return 0, fmt.Errorf("%s for hash partition must be an integer value", name)
+	}
+	numVal, ok := exprs[0].(*tree.NumVal)
+	if !ok {
+		return 0, fmt.Errorf("%s for hash partition must be an integer value", name)
+	}
+	value, err := numVal.AsInt64()
+	if err != nil {
+		return 0, err
+	}
+	if value < 0 {
+		return 0, fmt.Errorf("%s for hash partition must be an integer value greater than or equal to zero", name)
+	}
+	return uint64(value), nil
+}
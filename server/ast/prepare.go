@@ -15,11 +15,10 @@
 package ast
 
 import (
-	"fmt"
-
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodePrepare handles *tree.Prepare nodes.
@@ -27,5 +26,17 @@ func nodePrepare(node *tree.Prepare) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	return nil, fmt.Errorf("PREPARE is not yet supported")
+	// The statement is re-parsed by the connection handler, in the same way as statements from the extended protocol
+	parameterTypes := make([]uint32, len(node.Types))
+	for i, typ := range node.Types {
+		_, resolvedType, err := nodeResolvableTypeReference(typ)
+		if err != nil {
+			return nil, err
+		}
+		parameterTypes[i] = resolvedType.OID()
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewPrepare(string(node.Name), parameterTypes, tree.AsString(node.Statement)),
+		Children:  nil,
+	}, nil
 }
@@ -15,17 +15,25 @@
 package ast
 
 import (
-	"fmt"
-
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/pgerrors"
 )
 
-// nodeRefreshMaterializedView handles *tree.RefreshMaterializedView nodes.
+// nodeRefreshMaterializedView handles *tree.RefreshMaterializedView nodes. Materialized views cannot be created yet, so
+// there is never a view to refresh. CONCURRENTLY, which requires a unique index on the view so that the refreshed rows
+// may be applied as a diff against the existing rows, is reported separately so that clients may tell the two apart.
 func nodeRefreshMaterializedView(node *tree.RefreshMaterializedView) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	return nil, fmt.Errorf("REFRESH MATERIALIZED VIEW is not yet supported")
+	if node.Concurrently && node.RefreshDataOption == tree.RefreshDataClear {
+		return nil, pgerrors.New(pgcode.Syntax, "REFRESH options CONCURRENTLY and WITH NO DATA cannot be used together")
+	}
+	if node.Concurrently {
+		return nil, pgerrors.New(pgcode.FeatureNotSupported, "REFRESH MATERIALIZED VIEW CONCURRENTLY is not yet supported")
+	}
+	return nil, pgerrors.New(pgcode.FeatureNotSupported, "REFRESH MATERIALIZED VIEW is not yet supported")
 }
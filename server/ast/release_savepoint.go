@@ -18,14 +18,16 @@ import (
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeReleaseSavepoint handles *tree.ReleaseSavepoint nodes.
-func nodeReleaseSavepoint(node *tree.ReleaseSavepoint) (*vitess.ReleaseSavepoint, error) {
+func nodeReleaseSavepoint(node *tree.ReleaseSavepoint) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	return &vitess.ReleaseSavepoint{
-		Identifier: string(node.Savepoint),
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewReleaseSavepoint(string(node.Savepoint)),
+		Children:  nil,
 	}, nil
 }
@@ -20,10 +20,11 @@ import (
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeRenameTable handles *tree.RenameTable nodes.
-func nodeRenameTable(node *tree.RenameTable) (*vitess.DDL, error) {
+func nodeRenameTable(node *tree.RenameTable) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
@@ -33,18 +34,20 @@ func nodeRenameTable(node *tree.RenameTable) (*vitess.DDL, error) {
 	if node.IsMaterialized {
 		return nil, fmt.Errorf("RENAME MATERIALIZED VIEW is not yet supported")
 	}
-	fromName, err := nodeUnresolvedObjectName(node.Name)
+	treeName := node.Name.ToTableName()
+	fromName, err := nodeTableName(&treeName)
 	if err != nil {
 		return nil, err
 	}
-	toName, err := nodeUnresolvedObjectName(node.NewName)
-	if err != nil {
-		return nil, err
+	if len(fromName.DbQualifier.String()) > 0 {
+		return nil, fmt.Errorf("RENAME is currently only supported for tables in the current database")
+	}
+	if node.NewName.HasExplicitSchema() || node.NewName.HasExplicitCatalog() {
+		return nil, fmt.Errorf("cannot specify a schema for the new name of a table")
 	}
-	return &vitess.DDL{
-		Action:     vitess.RenameStr,
-		FromTables: vitess.TableNames{fromName},
-		ToTables:   vitess.TableNames{toName},
-		IfExists:   node.IfExists,
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewRenameTable(fromName.SchemaQualifier.String(), fromName.Name.String(), "",
+			node.NewName.Object(), node.IfExists),
+		Children: nil,
 	}, nil
 }
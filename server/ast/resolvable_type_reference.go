@@ -25,6 +25,13 @@ import (
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
+// ResolveType returns the type that the given type reference refers to. This is used by code that parses types outside
+// of a statement, such as the variable declarations within PL/pgSQL routines.
+func ResolveType(typ tree.ResolvableTypeReference) (pgtypes.DoltgresType, error) {
+	_, resolvedType, err := nodeResolvableTypeReference(typ)
+	return resolvedType, err
+}
+
 // nodeResolvableTypeReference handles tree.ResolvableTypeReference nodes.
 func nodeResolvableTypeReference(typ tree.ResolvableTypeReference) (*vitess.ConvertType, pgtypes.DoltgresType, error) {
 	if typ == nil {
@@ -37,11 +44,23 @@ func nodeResolvableTypeReference(typ tree.ResolvableTypeReference) (*vitess.Conv
 	var resolvedType pgtypes.DoltgresType
 	switch columnType := typ.(type) {
 	case *tree.ArrayTypeReference:
-		return nil, nil, fmt.Errorf("the given array type is not yet supported")
+		convertType, baseResolvedType, err := nodeResolvableTypeReference(columnType.ElementType)
+		if err != nil {
+			return nil, nil, err
+		}
+		return convertType, baseResolvedType.ToArrayType(), nil
 	case *tree.OIDTypeReference:
 		return nil, nil, fmt.Errorf("referencing types by their OID is not yet supported")
 	case *tree.UnresolvedObjectName:
-		return nil, nil, fmt.Errorf("type declaration format is not yet supported")
+		// Built-in types may be qualified by the pg_catalog schema
+		if columnType.NumParts > 2 || (columnType.NumParts == 2 && columnType.Parts[1] != "pg_catalog") {
+			return nil, nil, fmt.Errorf("type declaration format is not yet supported")
+		}
+		builtinType, ok, _ := types.TypeForNonKeywordTypeName(columnType.Parts[0])
+		if !ok {
+			return nil, nil, fmt.Errorf(`type "%s" does not exist`, columnType.String())
+		}
+		return nodeResolvableTypeReference(builtinType)
 	case *types.GeoMetadata:
 		return nil, nil, fmt.Errorf("geometry types are not yet supported")
 	case *types.T:
@@ -89,6 +108,8 @@ func nodeResolvableTypeReference(typ tree.ResolvableTypeReference) (*vitess.Conv
 				resolvedType = pgtypes.Int32
 			case oid.T_int8:
 				resolvedType = pgtypes.Int64
+			case oid.T_interval:
+				resolvedType = pgtypes.Interval
 			case oid.T_json:
 				resolvedType = pgtypes.Json
 			case oid.T_jsonb:
@@ -106,6 +127,12 @@ func nodeResolvableTypeReference(typ tree.ResolvableTypeReference) (*vitess.Conv
 				}
 			case oid.T_oid:
 				resolvedType = pgtypes.Oid
+			case oid.T_regclass:
+				resolvedType = pgtypes.Regclass
+			case oid.T_regnamespace:
+				resolvedType = pgtypes.Regnamespace
+			case oid.T_regtype:
+				resolvedType = pgtypes.Regtype
 			case oid.T_text:
 				resolvedType = pgtypes.Text
 			case oid.T_time:
@@ -0,0 +1,112 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"fmt"
+
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+)
+
+// nodeReturning handles the RETURNING clause of INSERT, UPDATE, and DELETE statements. GMS does not support RETURNING,
+// so a statement with one is converted to a SELECT of the returned expressions from the table that it writes to. The
+// statement is carried by the SELECT's condition, and the analyzer replaces the table's rows with the rows that the
+// statement writes. Statements without a RETURNING clause are returned as-is.
+func nodeReturning(node tree.Statement, stmt vitess.Statement, returning tree.ReturningClause, table vitess.TableExpr) (vitess.Statement, error) {
+	var returningExprs *tree.ReturningExprs
+	switch returning := returning.(type) {
+	case *tree.NoReturningClause:
+		return stmt, nil
+	case *tree.ReturningNothing:
+		return nil, fmt.Errorf("RETURNING NOTHING is not yet supported")
+	case *tree.ReturningExprs:
+		returningExprs = returning
+	default:
+		return nil, fmt.Errorf("unknown RETURNING clause: `%T`", returning)
+	}
+	selectExprs, err := nodeSelectExprs(tree.SelectExprs(*returningExprs))
+	if err != nil {
+		return nil, err
+	}
+	parameters, children, err := statementParameters(stmt)
+	if err != nil {
+		return nil, err
+	}
+	// The text of the statement excludes the RETURNING clause, as it describes only the statement that writes the rows
+	var query string
+	switch node := node.(type) {
+	case *tree.Insert:
+		modification := *node
+		modification.Returning = tree.AbsentReturningClause
+		query = tree.AsString(&modification)
+	case *tree.Update:
+		modification := *node
+		modification.Returning = tree.AbsentReturningClause
+		query = tree.AsString(&modification)
+	case *tree.Delete:
+		modification := *node
+		modification.Returning = tree.AbsentReturningClause
+		query = tree.AsString(&modification)
+	default:
+		return nil, fmt.Errorf("RETURNING is not supported for `%T`", node)
+	}
+	return &vitess.Select{
+		SelectExprs: selectExprs,
+		From:        vitess.TableExprs{table},
+		Where: vitess.NewWhere(vitess.WhereStr, vitess.InjectedExpr{
+			Expression: pgexprs.NewDataModification(stmt, query, parameters),
+			Children:   children,
+		}),
+	}, nil
+}
+
+// statementParameters returns the names of the bind variables that are used by the given statement, along with an
+// expression for each one.
+func statementParameters(stmt vitess.Statement) ([]string, vitess.Exprs, error) {
+	var parameters []string
+	var children vitess.Exprs
+	seen := make(map[string]struct{})
+	var visit func(node vitess.SQLNode) (bool, error)
+	visit = func(node vitess.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *vitess.SQLVal:
+			if node.Type != vitess.ValArg {
+				break
+			}
+			// Bind variables are written as ":name", while their bindings only use the name
+			name := string(node.Val[1:])
+			if _, ok := seen[name]; !ok {
+				seen[name] = struct{}{}
+				parameters = append(parameters, name)
+				children = append(children, vitess.NewValArg(node.Val))
+			}
+		case vitess.InjectedExpr:
+			// Injected expressions do not walk their own children
+			for _, child := range node.Children {
+				if err := vitess.Walk(visit, child); err != nil {
+					return false, err
+				}
+			}
+		}
+		return true, nil
+	}
+	if err := vitess.Walk(visit, stmt); err != nil {
+		return nil, nil, err
+	}
+	return parameters, children, nil
+}
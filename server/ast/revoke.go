@@ -1,4 +1,4 @@
-// Copyright 2023 Dolthub, Inc.
+// Copyright 2024 Dolthub, Inc.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -20,6 +20,7 @@ import (
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeRevoke handles *tree.Revoke nodes.
@@ -27,5 +28,16 @@ func nodeRevoke(node *tree.Revoke) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	return nil, fmt.Errorf("REVOKE is not yet supported")
+	if len(node.PrivsWithCols) > 0 {
+		return nil, fmt.Errorf("REVOKE on columns is not yet supported")
+	}
+	target, err := nodePrivilegeTarget(node.Targets)
+	if err != nil {
+		return nil, err
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewRevoke(target, node.Privileges, node.Grantees, node.GrantOptionFor,
+			node.GrantedBy, node.DropBehavior == tree.DropCascade),
+		Children: nil,
+	}, nil
 }
@@ -15,11 +15,11 @@
 package ast
 
 import (
-	"fmt"
-
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/auth"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeRevokeRole handles *tree.RevokeRole nodes.
@@ -27,5 +27,18 @@ func nodeRevokeRole(node *tree.RevokeRole) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	return nil, fmt.Errorf("REVOKE ROLE is not yet supported")
+	var options auth.MembershipOptions
+	if len(node.Option) > 0 {
+		option, err := nodeMembershipOption(&options, node.Option)
+		if err != nil {
+			return nil, err
+		}
+		revoked := true
+		*option = &revoked
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewRevokeRole(node.Roles.ToStrings(), node.Members, options, node.GrantedBy,
+			node.DropBehavior == tree.DropCascade),
+		Children: nil,
+	}, nil
 }
@@ -18,14 +18,16 @@ import (
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeRollbackToSavepoint handles *tree.RollbackToSavepoint nodes.
-func nodeRollbackToSavepoint(node *tree.RollbackToSavepoint) (*vitess.RollbackSavepoint, error) {
+func nodeRollbackToSavepoint(node *tree.RollbackToSavepoint) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	return &vitess.RollbackSavepoint{
-		Identifier: string(node.Savepoint),
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewRollbackToSavepoint(string(node.Savepoint)),
+		Children:  nil,
 	}, nil
 }
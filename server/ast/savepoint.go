@@ -18,14 +18,16 @@ import (
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeSavepoint handles *tree.Savepoint nodes.
-func nodeSavepoint(node *tree.Savepoint) (*vitess.Savepoint, error) {
+func nodeSavepoint(node *tree.Savepoint) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	return &vitess.Savepoint{
-		Identifier: string(node.Name),
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewSavepoint(string(node.Name)),
+		Children:  nil,
 	}, nil
 }
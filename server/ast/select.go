@@ -20,6 +20,7 @@ import (
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/postgres/parser/sessiondata"
 )
 
 // nodeSelect handles *tree.Select nodes.
@@ -30,6 +31,11 @@ func nodeSelect(node *tree.Select) (vitess.SelectStatement, error) {
 	if node.Select == nil {
 		return nil, fmt.Errorf("internal: select clause should not be null")
 	}
+	if selectClause, ok := node.Select.(*tree.SelectClause); ok && len(selectClause.DistinctOn) > 0 {
+		if err := validateDistinctOn(selectClause, node.OrderBy); err != nil {
+			return nil, err
+		}
+	}
 	selectStmt, err := nodeSelectStatement(node.Select)
 	if err != nil {
 		return nil, err
@@ -46,9 +52,9 @@ func nodeSelect(node *tree.Select) (vitess.SelectStatement, error) {
 	if err != nil {
 		return nil, err
 	}
-	_, err = nodeLockingClause(node.Locking)
-	if err != nil {
-		return nil, err
+	// Only the locking clauses of the top-level statement are supported, which are removed before it's converted
+	if len(node.Locking) > 0 {
+		return nil, fmt.Errorf("locking clauses are not yet supported within subqueries")
 	}
 
 	switch selectStmt := selectStmt.(type) {
@@ -75,7 +81,8 @@ func nodeSelect(node *tree.Select) (vitess.SelectStatement, error) {
 			Limit:   limit,
 		}, nil
 	case *vitess.Select:
-		selectStmt.OrderBy = orderBy
+		// The clause may have already added the DISTINCT ON expressions, which are ordered after everything else
+		selectStmt.OrderBy = append(orderBy, selectStmt.OrderBy...)
 		selectStmt.With = with
 		selectStmt.Limit = limit
 		return selectStmt, nil
@@ -139,13 +146,14 @@ func nodeSelectExpr(node tree.SelectExpr) (vitess.SelectExpr, error) {
 			if expr.NumParts == 2 {
 				tableName.Name = vitess.NewTableIdent(expr.Parts[1])
 			}
+			// The input expression is omitted so that the column is labeled by its (case-folded) name, rather than by
+			// the text of the reference, which may include quotes or a table qualifier.
 			return &vitess.AliasedExpr{
 				Expr: &vitess.ColName{
 					Name:      vitess.NewColIdent(expr.Parts[0]),
 					Qualifier: tableName,
 				},
-				As:              vitess.NewColIdent(string(node.As)),
-				InputExpression: tree.AsString(&node),
+				As: vitess.NewColIdent(string(node.As)),
 			}, nil
 		}
 	default:
@@ -165,6 +173,76 @@ func nodeSelectExpr(node tree.SelectExpr) (vitess.SelectExpr, error) {
 	}
 }
 
+// labelResultColumns assigns the labels that Postgres would give to the result columns of a top-level SELECT
+// statement, which is what clients see in the RowDescription. Postgres derives the label from the expression (such as
+// the function name for function calls, or "?column?" when nothing better is available), whereas the planner uses the
+// expression's text. This is only applied to the outermost SELECT, as Postgres labels may repeat, and the planner
+// matches the columns of nested queries by name. The planner also matches the columns of set operations by name, so a
+// label that repeats within a set operation is not applied.
+func labelResultColumns(node *tree.Select, stmt vitess.SelectStatement) error {
+	selectClause := innermostSelectClause(node.Select)
+	vitessSelect := innermostVitessSelect(stmt)
+	if selectClause == nil || vitessSelect == nil || len(selectClause.Exprs) != len(vitessSelect.SelectExprs) {
+		return nil
+	}
+	_, isSetOp := stmt.(*vitess.SetOp)
+	usedLabels := make(map[string]struct{})
+	for i, selectExpr := range selectClause.Exprs {
+		aliasedExpr, ok := vitessSelect.SelectExprs[i].(*vitess.AliasedExpr)
+		if !ok || len(selectExpr.As) > 0 {
+			continue
+		}
+		if _, ok = aliasedExpr.Expr.(*vitess.ColName); ok {
+			continue
+		}
+		label, err := tree.GetRenderColName(sessiondata.EmptySearchPath, selectExpr)
+		if err != nil {
+			return err
+		}
+		if _, ok = usedLabels[label]; ok && isSetOp {
+			continue
+		}
+		usedLabels[label] = struct{}{}
+		if _, ok = selectExpr.Expr.(*tree.CastExpr); ok {
+			aliasedExpr.As = vitess.NewColIdent(label)
+		} else {
+			aliasedExpr.InputExpression = label
+		}
+	}
+	return nil
+}
+
+// innermostSelectClause returns the SELECT clause that determines the result columns of the given statement. For set
+// operations, this is the leftmost SELECT clause.
+func innermostSelectClause(node tree.SelectStatement) *tree.SelectClause {
+	switch node := node.(type) {
+	case *tree.SelectClause:
+		return node
+	case *tree.ParenSelect:
+		if node.Select != nil {
+			return innermostSelectClause(node.Select.Select)
+		}
+	case *tree.UnionClause:
+		if node.Left != nil {
+			return innermostSelectClause(node.Left.Select)
+		}
+	}
+	return nil
+}
+
+// innermostVitessSelect is the counterpart to innermostSelectClause for converted statements.
+func innermostVitessSelect(stmt vitess.SelectStatement) *vitess.Select {
+	switch stmt := stmt.(type) {
+	case *vitess.Select:
+		return stmt
+	case *vitess.ParenSelect:
+		return innermostVitessSelect(stmt.Select)
+	case *vitess.SetOp:
+		return innermostVitessSelect(stmt.Left)
+	}
+	return nil
+}
+
 // nodeSelectExprs handles tree.SelectExprs nodes.
 func nodeSelectExprs(node tree.SelectExprs) (vitess.SelectExprs, error) {
 	if len(node) == 0 {
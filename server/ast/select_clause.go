@@ -15,12 +15,14 @@
 package ast
 
 import (
-	"fmt"
-
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
 	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+	"github.com/dolthub/doltgresql/utils"
 )
 
 // nodeSelectClause handles tree.SelectClause nodes.
@@ -28,11 +30,7 @@ func nodeSelectClause(node *tree.SelectClause) (*vitess.Select, error) {
 	if node == nil {
 		return nil, nil
 	}
-	selectExprs, err := nodeSelectExprs(node.Exprs)
-	if err != nil {
-		return nil, err
-	}
-	from, err := nodeFrom(node.From)
+	selectExprs, from, err := nodeSelectExprsAndFrom(node.Exprs, node.From)
 	if err != nil {
 		return nil, err
 	}
@@ -40,50 +38,15 @@ func nodeSelectClause(node *tree.SelectClause) (*vitess.Select, error) {
 	// that we have to situationally support, as inner nodes do not have the proper context to output a TableFuncExpr,
 	// since TableFuncExprs pertain only to SELECT statements.
 	for i, fromExpr := range from {
-		// Nodes are very liberal in wrapping themselves within other nodes, which gives them a technically correct
-		// tree, however GMS makes assumptions about the makeup of the trees that it receives. We'll eventually
-		// generalize this on the GMS side, but for now we need to transform our tree in case we need to use a TableFuncExpr.
-		if aliasedTableExpr, ok := fromExpr.(*vitess.AliasedTableExpr); ok {
-			subquery, ok := aliasedTableExpr.Expr.(*vitess.Subquery)
-			// If all of these are true, then the AliasedTableExpr is probably a wrapper around a subquery, but we have
-			// to confirm that the subquery contains a *Select with a single child in its From expressions.
-			if !aliasedTableExpr.Lateral &&
-				aliasedTableExpr.Hints == nil &&
-				len(aliasedTableExpr.Partitions) == 0 &&
-				ok && len(subquery.Columns) == 0 {
-				// If this is true, then we can confirm that it's just a wrapper (and not an explicit AliasedTableExpr).
-				// This may seem like a lot of fragile checks, but AliasedTableExpr explicitly sets its state to this in
-				// this circumstance. We do not want to create a TableFuncExpr except under very specific circumstances.
-				if subquerySelect, ok := subquery.Select.(*vitess.Select); ok && len(subquerySelect.From) == 1 {
-					if valuesStatement, ok := subquerySelect.From[0].(*vitess.ValuesStatement); ok {
-						if len(valuesStatement.Columns) == 0 && len(valuesStatement.Rows) == 1 && len(valuesStatement.Rows[0]) == 1 {
-							if funcExpr, ok := valuesStatement.Rows[0][0].(*vitess.FuncExpr); ok {
-								// It appears that GMS hardcodes the expectation of vitess literals here, so we have to
-								// convert from Doltgres literals to GMS literals. Eventually we need to remove this
-								// hardcoded behavior.
-								for _, fExpr := range funcExpr.Exprs {
-									if aliasedExpr, ok := fExpr.(*vitess.AliasedExpr); ok {
-										if injectedExpr, ok := aliasedExpr.Expr.(vitess.InjectedExpr); ok {
-											if literal, ok := injectedExpr.Expression.(*pgexprs.Literal); ok {
-												aliasedExpr.Expr = literal.ToVitessLiteral()
-											}
-										}
-									}
-								}
-								from[i] = &vitess.TableFuncExpr{
-									Name:  funcExpr.Name.String(),
-									Exprs: funcExpr.Exprs,
-									Alias: aliasedTableExpr.As,
-								}
-							}
-						}
-					}
-				}
-			}
-		}
+		from[i] = nodeTableFuncExpr(fromExpr)
 	}
+	var orderBy vitess.OrderBy
 	if len(node.DistinctOn) > 0 {
-		return nil, fmt.Errorf("DISTINCT ON is not yet supported")
+		distinctOn, err := nodeDistinctOn(node.DistinctOn, node.Exprs)
+		if err != nil {
+			return nil, err
+		}
+		orderBy = vitess.OrderBy{distinctOn}
 	}
 	where, err := nodeWhere(node.Where)
 	if err != nil {
@@ -101,13 +64,255 @@ func nodeSelectClause(node *tree.SelectClause) (*vitess.Select, error) {
 	if err != nil {
 		return nil, err
 	}
+	// DISTINCT ON clauses are also marked as DISTINCT, which would otherwise apply to the entire row
 	return &vitess.Select{
-		QueryOpts:   vitess.QueryOpts{Distinct: node.Distinct},
+		QueryOpts:   vitess.QueryOpts{Distinct: node.Distinct && len(node.DistinctOn) == 0},
 		SelectExprs: selectExprs,
 		From:        from,
 		Where:       where,
 		GroupBy:     groupBy,
 		Having:      having,
 		Window:      window,
+		OrderBy:     orderBy,
 	}, nil
 }
+
+// nodeDistinctOn handles tree.DistinctOn nodes. The expressions are returned as an additional ORDER BY expression, as
+// they are then resolved like any other expression without adding a result column. It is removed during analysis, after
+// it has been used to keep the first row of each distinct group.
+func nodeDistinctOn(node tree.DistinctOn, selectExprs tree.SelectExprs) (*vitess.Order, error) {
+	exprs := make(tree.Exprs, len(node))
+	for i, expr := range node {
+		exprs[i] = distinctOnTarget(expr, selectExprs)
+	}
+	children, err := nodeExprs(exprs)
+	if err != nil {
+		return nil, err
+	}
+	return &vitess.Order{
+		Expr: vitess.InjectedExpr{
+			Expression: pgexprs.NewDistinctOn(),
+			Children:   children,
+		},
+		Direction: vitess.AscScr,
+	}, nil
+}
+
+// validateDistinctOn returns an error if the leading ORDER BY expressions do not match the DISTINCT ON expressions, as
+// the ordering would otherwise not determine which row is the first of each distinct group.
+func validateDistinctOn(node *tree.SelectClause, orderBy tree.OrderBy) error {
+	distinctOn := make(map[string]struct{}, len(node.DistinctOn))
+	for _, expr := range node.DistinctOn {
+		distinctOn[tree.AsString(tree.StripParens(distinctOnTarget(expr, node.Exprs)))] = struct{}{}
+	}
+	unmatched := len(distinctOn)
+	matched := make(map[string]struct{}, len(distinctOn))
+	for _, order := range orderBy {
+		if unmatched == 0 {
+			break
+		}
+		var key string
+		if order.OrderType == tree.OrderByColumn {
+			key = tree.AsString(tree.StripParens(distinctOnTarget(order.Expr, node.Exprs)))
+		}
+		if _, ok := distinctOn[key]; !ok {
+			return pgerrors.New(pgcode.InvalidColumnReference, "SELECT DISTINCT ON expressions must match initial ORDER BY expressions")
+		}
+		if _, ok := matched[key]; !ok {
+			matched[key] = struct{}{}
+			unmatched--
+		}
+	}
+	return nil
+}
+
+// distinctOnTarget returns the select expression that is referenced by position from a DISTINCT ON or ORDER BY
+// expression, such as the 2 in DISTINCT ON (2). All other expressions are returned unchanged.
+func distinctOnTarget(expr tree.Expr, selectExprs tree.SelectExprs) tree.Expr {
+	numVal, ok := tree.StripParens(expr).(*tree.NumVal)
+	if !ok {
+		return expr
+	}
+	position, err := numVal.AsInt64()
+	if err != nil || position < 1 || position > int64(len(selectExprs)) {
+		return expr
+	}
+	target := selectExprs[position-1].Expr
+	switch target := target.(type) {
+	case tree.UnqualifiedStar, *tree.AllColumnsSelector:
+		return expr
+	case *tree.UnresolvedName:
+		if target.Star {
+			return expr
+		}
+	}
+	return target
+}
+
+// nodeTableFuncExpr returns a TableFuncExpr if the given table expression is a function in the FROM clause, otherwise
+// returns the table expression unchanged. Joins are searched for functions as well.
+func nodeTableFuncExpr(fromExpr vitess.TableExpr) vitess.TableExpr {
+	switch fromExpr := fromExpr.(type) {
+	case *vitess.JoinTableExpr:
+		fromExpr.LeftExpr = nodeTableFuncExpr(fromExpr.LeftExpr)
+		fromExpr.RightExpr = nodeTableFuncExpr(fromExpr.RightExpr)
+		return fromExpr
+	case *vitess.ParenTableExpr:
+		for i := range fromExpr.Exprs {
+			fromExpr.Exprs[i] = nodeTableFuncExpr(fromExpr.Exprs[i])
+		}
+		return fromExpr
+	case *vitess.AliasedTableExpr:
+		// Nodes are very liberal in wrapping themselves within other nodes, which gives them a technically correct
+		// tree, however GMS makes assumptions about the makeup of the trees that it receives. We'll eventually
+		// generalize this on the GMS side, but for now we need to transform our tree in case we need to use a TableFuncExpr.
+		subquery, ok := fromExpr.Expr.(*vitess.Subquery)
+		// If all of these are true, then the AliasedTableExpr is probably a wrapper around a subquery, but we have
+		// to confirm that the subquery contains a *Select with a single child in its From expressions.
+		if fromExpr.Hints == nil &&
+			len(fromExpr.Partitions) == 0 &&
+			ok && len(subquery.Columns) == 0 {
+			// If this is true, then we can confirm that it's just a wrapper (and not an explicit AliasedTableExpr).
+			// This may seem like a lot of fragile checks, but AliasedTableExpr explicitly sets its state to this in
+			// this circumstance. We do not want to create a TableFuncExpr except under very specific circumstances.
+			if subquerySelect, ok := subquery.Select.(*vitess.Select); ok && len(subquerySelect.From) == 1 {
+				if funcExpr, isOurFunction := aliasedFuncExpr(subquerySelect.From[0]); funcExpr != nil {
+					// It appears that GMS hardcodes the expectation of vitess literals for its own table functions,
+					// so we have to convert from Doltgres literals to GMS literals. Eventually we need to remove this
+					// hardcoded behavior. Our own functions take their arguments as-is, however GMS rejects arguments
+					// that have an alias (such as the one given to casts), or whose input expression does not match
+					// their string form, so we clear both.
+					for _, fExpr := range funcExpr.Exprs {
+						if aliasedExpr, ok := fExpr.(*vitess.AliasedExpr); ok {
+							if isOurFunction {
+								aliasedExpr.As = vitess.ColIdent{}
+								aliasedExpr.InputExpression = ""
+							} else if injectedExpr, ok := aliasedExpr.Expr.(vitess.InjectedExpr); ok {
+								if literal, ok := injectedExpr.Expression.(*pgexprs.Literal); ok {
+									aliasedExpr.Expr = literal.ToVitessLiteral()
+								}
+							}
+						}
+					}
+					tableFuncExpr := &vitess.TableFuncExpr{
+						Name:  funcExpr.Name.String(),
+						Exprs: funcExpr.Exprs,
+						Alias: fromExpr.As,
+					}
+					if !fromExpr.Lateral {
+						return tableFuncExpr
+					}
+					// GMS only allows subqueries to be lateral, so we wrap the function within one
+					return &vitess.AliasedTableExpr{
+						Expr: &vitess.Subquery{
+							Select: &vitess.Select{
+								SelectExprs: vitess.SelectExprs{&vitess.StarExpr{}},
+								From:        vitess.TableExprs{tableFuncExpr},
+							},
+						},
+						As:      fromExpr.As,
+						Lateral: true,
+					}
+				}
+			}
+		}
+		return fromExpr
+	default:
+		return fromExpr
+	}
+}
+
+// nodeSelectExprsAndFrom handles the select expressions along with the FROM clause. Accessing the columns of a function
+// call, such as (f(x)).* or (f(x)).col, is rewritten to call the function from the FROM clause instead, as functions in
+// the FROM clause are able to return multiple columns. A lone call to a set-returning function without a FROM clause,
+// such as SELECT generate_series(1, 3), is rewritten the same way, as it returns the same rows either way.
+func nodeSelectExprsAndFrom(exprs tree.SelectExprs, from tree.From) (vitess.SelectExprs, vitess.TableExprs, error) {
+	var newExprs tree.SelectExprs
+	var newTables tree.TableExprs
+	aliases := make(map[string]string)
+	for i, selectExpr := range exprs {
+		var funcExpr *tree.FuncExpr
+		var colName string
+		var setColumn tree.Name
+		switch expr := tree.StripParens(selectExpr.Expr).(type) {
+		case *tree.TupleStar:
+			funcExpr, _ = tree.StripParens(expr.Expr).(*tree.FuncExpr)
+		case *tree.ColumnAccessExpr:
+			if !expr.ByIndex {
+				funcExpr, _ = tree.StripParens(expr.Expr).(*tree.FuncExpr)
+				colName = expr.ColName
+			}
+		case *tree.FuncExpr:
+			if len(exprs) == 1 && len(from.Tables) == 0 {
+				if name, ok := setReturningFunctionName(expr); ok {
+					funcExpr = expr
+					setColumn = tree.Name(name)
+					colName = name
+				}
+			}
+		}
+		if funcExpr == nil {
+			continue
+		}
+		if newExprs == nil {
+			newExprs = append(tree.SelectExprs{}, exprs...)
+		}
+		// Identical calls refer to the same function in the FROM clause, so that their columns belong to the same rows
+		funcStr := tree.AsString(funcExpr)
+		alias, ok := aliases[funcStr]
+		if !ok {
+			alias = utils.GenerateUniqueAlias()
+			aliases[funcStr] = alias
+			aliasClause := tree.AliasClause{Alias: tree.Name(alias)}
+			if len(setColumn) > 0 {
+				aliasClause.Cols = tree.NameList{setColumn}
+			}
+			newTables = append(newTables, &tree.AliasedTableExpr{
+				Expr: &tree.RowsFromExpr{Items: tree.Exprs{funcExpr}},
+				As:   aliasClause,
+			})
+		}
+		tableName := &tree.UnresolvedObjectName{NumParts: 1, Parts: [3]string{alias}}
+		if len(colName) == 0 {
+			newExprs[i] = tree.SelectExpr{Expr: &tree.AllColumnsSelector{TableName: tableName}}
+		} else {
+			newExprs[i] = tree.SelectExpr{
+				Expr: &tree.ColumnItem{TableName: tableName, ColumnName: tree.Name(colName)},
+				As:   selectExpr.As,
+			}
+		}
+	}
+	if newExprs != nil {
+		exprs = newExprs
+		from.Tables = append(append(tree.TableExprs{}, from.Tables...), newTables...)
+	}
+	selectExprs, err := nodeSelectExprs(exprs)
+	if err != nil {
+		return nil, nil, err
+	}
+	fromExprs, err := nodeFrom(from)
+	if err != nil {
+		return nil, nil, err
+	}
+	return selectExprs, fromExprs, nil
+}
+
+// setReturningFunctionName returns the name of the called function when it is a built-in function that returns a set
+// of single values, such as generate_series.
+func setReturningFunctionName(funcExpr *tree.FuncExpr) (string, bool) {
+	name, ok := funcExpr.Func.FunctionReference.(*tree.UnresolvedName)
+	if !ok || name.NumParts > 2 || (name.NumParts == 2 && name.Parts[1] != "pg_catalog") {
+		return "", false
+	}
+	overloads, ok := framework.Catalog[name.Parts[0]]
+	if !ok || len(overloads) == 0 {
+		return "", false
+	}
+	for _, overload := range overloads {
+		recordFunction, ok := overload.(framework.RecordFunction)
+		if !ok || !recordFunction.ReturnsSet || len(recordFunction.Columns) != 1 || len(recordFunction.Columns[0].Name) > 0 {
+			return "", false
+		}
+	}
+	return name.Parts[0], true
+}
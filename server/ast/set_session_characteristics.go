@@ -15,11 +15,10 @@
 package ast
 
 import (
-	"fmt"
-
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeSetSessionCharacteristics handles *tree.SetSessionCharacteristics nodes.
@@ -27,5 +26,12 @@ func nodeSetSessionCharacteristics(node *tree.SetSessionCharacteristics) (vitess
 	if node == nil {
 		return nil, nil
 	}
-	return nil, fmt.Errorf("SET SESSION CHARACTERISTICS is not yet supported")
+	modes, err := nodeTransactionModes(node.Modes)
+	if err != nil {
+		return nil, err
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewSetTransaction(modes, true),
+		Children:  nil,
+	}, nil
 }
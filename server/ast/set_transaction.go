@@ -15,11 +15,10 @@
 package ast
 
 import (
-	"fmt"
-
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
 )
 
 // nodeSetTransaction handles *tree.SetTransaction nodes.
@@ -27,5 +26,12 @@ func nodeSetTransaction(node *tree.SetTransaction) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	return nil, fmt.Errorf("SET TRANSACTION is not yet supported")
+	modes, err := nodeTransactionModes(node.Modes)
+	if err != nil {
+		return nil, err
+	}
+	return vitess.InjectedStatement{
+		Statement: pgnodes.NewSetTransaction(modes, false),
+		Children:  nil,
+	}, nil
 }
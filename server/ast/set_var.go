@@ -20,8 +20,12 @@ import (
 
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
 	"github.com/dolthub/doltgresql/server/config"
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/pgerrors"
 )
 
 // nodeSetVar handles *tree.SetVar nodes.
@@ -35,11 +39,10 @@ func nodeSetVar(node *tree.SetVar) (vitess.Statement, error) {
 		return &vitess.Use{DBName: vitess.NewTableIdent(dbName)}, nil
 	}
 	if !config.IsValidPostgresConfigParameter(node.Name) {
-		return nil, fmt.Errorf(`ERROR: syntax error at or near "%s"'`, node.Name)
-	}
-	if node.IsLocal {
-		// TODO: takes effect for only the current transaction rather than the current session.
-		return nil, fmt.Errorf("SET LOCAL is not yet supported")
+		if !config.IsCustomParameterName(node.Name) {
+			return nil, pgerrors.Newf(pgcode.UndefinedObject, `unrecognized configuration parameter "%s"`, node.Name)
+		}
+		config.AddCustomParameter(node.Name)
 	}
 	var expr vitess.Expr
 	var err error
@@ -54,6 +57,11 @@ func nodeSetVar(node *tree.SetVar) (vitess.Statement, error) {
 		expr = &vitess.ColName{
 			Name: vitess.NewColIdent(strings.Join(vals, ", ")),
 		}
+	} else if name, ok := node.Values[0].(*tree.UnresolvedName); ok && name.NumParts == 1 && !name.Star {
+		// Unquoted values, such as SET datestyle = german, are given to the parameter as strings
+		expr = vitess.InjectedExpr{
+			Expression: pgexprs.NewStringLiteral(name.Parts[0]),
+		}
 	} else {
 		expr, err = nodeExpr(node.Values[0])
 		if err != nil {
@@ -69,5 +77,12 @@ func nodeSetVar(node *tree.SetVar) (vitess.Statement, error) {
 			Expr: expr,
 		}},
 	}
+	if node.IsLocal {
+		// The connection handler restores the parameter once the transaction ends
+		return vitess.InjectedStatement{
+			Statement: pgnodes.NewSetLocal(node.Name, setStmt),
+			Children:  nil,
+		}, nil
+	}
 	return setStmt, nil
 }
@@ -20,7 +20,11 @@ import (
 
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/config"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/pgerrors"
 )
 
 // nodeShowVar handles *tree.ShowVar nodes.
@@ -32,8 +36,12 @@ func nodeShowVar(node *tree.ShowVar) (vitess.Statement, error) {
 	if strings.ToLower(node.Name) == "is_superuser" {
 		return nil, fmt.Errorf("SHOW IS_SUPERUSER is not yet supported")
 	} else if strings.ToLower(node.Name) == "all" {
-		// TODO: need this soon
-		return nil, fmt.Errorf("SHOW ALL is not yet supported")
+		return vitess.InjectedStatement{
+			Statement: pgnodes.NewShowAll(),
+			Children:  nil,
+		}, nil
+	} else if !config.IsValidPostgresConfigParameter(node.Name) && !config.IsCustomParameter(node.Name) {
+		return nil, pgerrors.Newf(pgcode.UndefinedObject, `unrecognized configuration parameter "%s"`, node.Name)
 	}
 
 	// TODO: this is a temporary way to get the param value for the current implementation
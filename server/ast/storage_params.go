@@ -0,0 +1,39 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"github.com/dolthub/doltgresql/core/storageparams"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+)
+
+// nodeStorageParams handles tree.StorageParams nodes, returning the validated value of each parameter keyed by the
+// parameter's name.
+func nodeStorageParams(node tree.StorageParams) (map[string]string, error) {
+	params := make(map[string]string, len(node))
+	for _, param := range node {
+		// A parameter without a value is shorthand for setting a boolean parameter to true
+		value := "true"
+		if param.Value != nil {
+			value = tree.AsStringWithFlags(param.Value, tree.FmtBareStrings)
+		}
+		validated, err := storageparams.Validate(string(param.Key), value)
+		if err != nil {
+			return nil, err
+		}
+		params[string(param.Key)] = validated
+	}
+	return params, nil
+}
@@ -17,9 +17,11 @@ package ast
 import (
 	"fmt"
 	"sort"
+	"strings"
 
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
+	"github.com/dolthub/doltgresql/core/exclusions"
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
 	"github.com/dolthub/doltgresql/utils"
 )
@@ -55,6 +57,43 @@ func assignTableDef(node tree.TableDef, target *vitess.DDL) error {
 			return err
 		}
 		target.TableSpec.Columns = append(target.TableSpec.Columns, columnDef)
+		// GMS only creates foreign keys from constraints, as MySQL ignores REFERENCES on columns
+		if columnDef.Type.ForeignKeyDef != nil {
+			name := string(node.References.ConstraintName)
+			if len(name) == 0 {
+				name = foreignKeyName(target.Table.Name.String(), tree.NameList{node.Name})
+			}
+			target.TableSpec.Constraints = append(target.TableSpec.Constraints, &vitess.ConstraintDefinition{
+				Name:    name,
+				Details: columnDef.Type.ForeignKeyDef,
+			})
+			columnDef.Type.ForeignKeyDef = nil
+		}
+		return nil
+	case *tree.ExcludeConstraintTableDef:
+		if target.TableSpec == nil {
+			target.TableSpec = &vitess.TableSpec{}
+		}
+		constraint, err := nodeExcludeConstraintTableDef(node, target.Table.Name.String())
+		if err != nil {
+			return err
+		}
+		// Exclusion constraints are written by our own node once the table has been created, so they're carried as
+		// table options
+		value, err := constraint.OptionValue()
+		if err != nil {
+			return err
+		}
+		position := 0
+		for _, option := range target.TableSpec.TableOpts {
+			if strings.HasPrefix(option.Name, exclusions.TableOptionPrefix) {
+				position++
+			}
+		}
+		target.TableSpec.TableOpts = append(target.TableSpec.TableOpts, &vitess.TableOption{
+			Name:  fmt.Sprintf("%s%d", exclusions.TableOptionPrefix, position),
+			Value: value,
+		})
 		return nil
 	case *tree.ForeignKeyConstraintTableDef:
 		if target.TableSpec == nil {
@@ -64,8 +103,12 @@ func assignTableDef(node tree.TableDef, target *vitess.DDL) error {
 		if err != nil {
 			return err
 		}
+		name := string(node.Name)
+		if len(name) == 0 {
+			name = foreignKeyName(target.Table.Name.String(), node.FromCols)
+		}
 		target.TableSpec.Constraints = append(target.TableSpec.Constraints, &vitess.ConstraintDefinition{
-			Name:    string(node.Name),
+			Name:    name,
 			Details: fkDef,
 		})
 		return nil
@@ -23,13 +23,10 @@ import (
 )
 
 // nodeUpdate handles *tree.Update nodes.
-func nodeUpdate(node *tree.Update) (*vitess.Update, error) {
+func nodeUpdate(node *tree.Update) (vitess.Statement, error) {
 	if node == nil {
 		return nil, nil
 	}
-	if _, ok := node.Returning.(*tree.NoReturningClause); !ok {
-		return nil, fmt.Errorf("RETURNING is not yet supported")
-	}
 	if len(node.From) > 0 {
 		return nil, fmt.Errorf("FROM is not yet supported")
 	}
@@ -57,12 +54,18 @@ func nodeUpdate(node *tree.Update) (*vitess.Update, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &vitess.Update{
+	update := &vitess.Update{
 		TableExprs: vitess.TableExprs{table},
 		With:       with,
 		Exprs:      exprs,
 		Where:      where,
 		OrderBy:    orderBy,
 		Limit:      limit,
-	}, nil
+	}
+	// The SELECT that reads the returned rows needs its own copy of the table expression
+	returningTable, err := nodeTableExpr(node.Table)
+	if err != nil {
+		return nil, err
+	}
+	return nodeReturning(node, update, node.Returning, returningTable)
 }
@@ -15,9 +15,12 @@
 package ast
 
 import (
+	"fmt"
+
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/utils"
 )
 
 // nodeValuesClause handles tree.ValuesClause nodes.
@@ -33,19 +36,25 @@ func nodeValuesClause(node *tree.ValuesClause) (*vitess.Select, error) {
 		}
 		valTuples[i] = vitess.ValTuple(exprs)
 	}
-	//TODO: ValuesStatement might need to be aliased
-	//TODO: is the SelectExprs necessary?
+	// Derived tables require an alias, and the columns of a VALUES list are named column1, column2, etc.
+	var columns vitess.Columns
+	if len(node.Rows) > 0 {
+		columns = make(vitess.Columns, len(node.Rows[0]))
+		for i := range columns {
+			columns[i] = vitess.NewColIdent(fmt.Sprintf("column%d", i+1))
+		}
+	}
 	return &vitess.Select{
 		SelectExprs: vitess.SelectExprs{
-			&vitess.StarExpr{
-				TableName: vitess.TableName{
-					Name: vitess.NewTableIdent("*"),
-				},
-			},
+			&vitess.StarExpr{},
 		},
 		From: vitess.TableExprs{
-			&vitess.ValuesStatement{
-				Rows: valTuples,
+			&vitess.AliasedTableExpr{
+				Expr: &vitess.ValuesStatement{
+					Rows:    valTuples,
+					Columns: columns,
+				},
+				As: vitess.NewTableIdent(utils.GenerateUniqueAlias()),
 			},
 		},
 	}, nil
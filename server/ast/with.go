@@ -27,5 +27,56 @@ func nodeWith(node *tree.With) (*vitess.With, error) {
 	if node == nil {
 		return nil, nil
 	}
-	return &vitess.With{}, fmt.Errorf("WITH is not yet supported")
+	ctes := make([]vitess.TableExpr, len(node.CTEList))
+	for i, cte := range node.CTEList {
+		var err error
+		ctes[i], err = nodeCTE(cte)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &vitess.With{
+		Ctes:      ctes,
+		Recursive: node.Recursive,
+	}, nil
+}
+
+// nodeCTE handles *tree.CTE nodes. The materialization clause is only a hint to the planner, so it is ignored.
+func nodeCTE(node *tree.CTE) (*vitess.CommonTableExpr, error) {
+	var stmt vitess.Statement
+	var err error
+	switch cteStmt := node.Stmt.(type) {
+	case *tree.Select:
+		stmt, err = nodeSelect(cteStmt)
+	case *tree.Insert:
+		stmt, err = nodeInsert(cteStmt)
+	case *tree.Update:
+		stmt, err = nodeUpdate(cteStmt)
+	case *tree.Delete:
+		stmt, err = nodeDelete(cteStmt)
+	default:
+		return nil, fmt.Errorf("WITH query is not yet supported: `%T`", cteStmt)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// Data-modifying statements are only converted to a SELECT when they have a RETURNING clause
+	selectStmt, ok := stmt.(vitess.SelectStatement)
+	if !ok {
+		return nil, fmt.Errorf(`WITH query "%s" does not have a RETURNING clause`, node.Name.Alias)
+	}
+	var columns vitess.Columns
+	if len(node.Name.Cols) > 0 {
+		columns = make(vitess.Columns, len(node.Name.Cols))
+		for i := range node.Name.Cols {
+			columns[i] = vitess.NewColIdent(string(node.Name.Cols[i]))
+		}
+	}
+	return &vitess.CommonTableExpr{
+		AliasedTableExpr: &vitess.AliasedTableExpr{
+			Expr: &vitess.Subquery{Select: selectStmt},
+			As:   vitess.NewTableIdent(string(node.Name.Alias)),
+		},
+		Columns: columns,
+	}, nil
 }
@@ -0,0 +1,175 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Class is the class of statement that an event records.
+type Class string
+
+const (
+	// ClassNone is the class of every statement that is not audited.
+	ClassNone Class = ""
+	// ClassDDL contains statements that define or change objects, such as CREATE, ALTER, and DROP.
+	ClassDDL Class = "ddl"
+	// ClassPrivilege contains statements that change roles or privileges, such as GRANT, REVOKE, and CREATE ROLE.
+	ClassPrivilege Class = "privilege"
+	// ClassDolt contains statements that call a Dolt version control procedure, such as dolt_commit or dolt_merge.
+	ClassDolt Class = "dolt"
+)
+
+// Classes are every class that may be audited.
+var Classes = []Class{ClassDDL, ClassPrivilege, ClassDolt}
+
+// ParseClass returns the class with the given name.
+func ParseClass(name string) (Class, error) {
+	for _, class := range Classes {
+		if string(class) == name {
+			return class, nil
+		}
+	}
+	return ClassNone, fmt.Errorf(`unknown audit class "%s", expected "ddl", "privilege", or "dolt"`, name)
+}
+
+// Event records a single audited statement, along with who ran it and whether it succeeded.
+type Event struct {
+	Time         time.Time `json:"time"`
+	ConnectionID uint32    `json:"connection_id"`
+	User         string    `json:"user"`
+	Database     string    `json:"database,omitempty"`
+	RemoteAddr   string    `json:"remote_addr,omitempty"`
+	Class        Class     `json:"class"`
+	CommandTag   string    `json:"command_tag"`
+	Statement    string    `json:"statement"`
+	Succeeded    bool      `json:"succeeded"`
+	Error        string    `json:"error,omitempty"`
+}
+
+// Sink is a destination that events are written to.
+type Sink interface {
+	// Write writes the event to the sink.
+	Write(event Event) error
+	// Close releases the sink's resources, once every event that was written has been delivered.
+	Close() error
+}
+
+// registry holds the sinks that events are written to, along with the classes that are audited and the connections
+// that are not.
+var registry = struct {
+	sync.RWMutex
+	sinks   []Sink
+	classes map[Class]struct{}
+	ignored map[uint32]struct{}
+}{
+	classes: make(map[Class]struct{}),
+	ignored: make(map[uint32]struct{}),
+}
+
+// Configure sets the classes that are audited. Every class is audited when none are given. Events are only recorded
+// once a sink has been added.
+func Configure(classes []Class) {
+	registry.Lock()
+	defer registry.Unlock()
+	if len(classes) == 0 {
+		classes = Classes
+	}
+	clear(registry.classes)
+	for _, class := range classes {
+		registry.classes[class] = struct{}{}
+	}
+}
+
+// AddSink adds a sink that every later event is written to.
+func AddSink(sink Sink) {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.sinks = append(registry.sinks, sink)
+}
+
+// RemoveSink removes the given sink, and then closes it. Events that are recorded afterward are not written to the sink.
+func RemoveSink(sink Sink) error {
+	registry.Lock()
+	for i, existing := range registry.sinks {
+		if existing == sink {
+			registry.sinks = append(registry.sinks[:i], registry.sinks[i+1:]...)
+			break
+		}
+	}
+	registry.Unlock()
+	return sink.Close()
+}
+
+// Close removes and closes every sink.
+func Close() error {
+	registry.Lock()
+	sinks := registry.sinks
+	registry.sinks = nil
+	registry.Unlock()
+	var errs []error
+	for _, sink := range sinks {
+		errs = append(errs, sink.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// Enabled returns whether statements of the given class are audited for the given connection.
+func Enabled(class Class, connectionID uint32) bool {
+	if class == ClassNone {
+		return false
+	}
+	registry.RLock()
+	defer registry.RUnlock()
+	if len(registry.sinks) == 0 {
+		return false
+	}
+	if _, ok := registry.ignored[connectionID]; ok {
+		return false
+	}
+	_, ok := registry.classes[class]
+	return ok
+}
+
+// Record writes the event to every sink. Failures are logged rather than returned, as the statement has already run.
+func Record(event Event) {
+	registry.RLock()
+	defer registry.RUnlock()
+	for _, sink := range registry.sinks {
+		if err := sink.Write(event); err != nil {
+			logrus.WithError(err).WithField("connection_id", event.ConnectionID).Errorf("unable to write audit event: %s", event.Statement)
+		}
+	}
+}
+
+// Ignore stops auditing the given connection, which is used by the server's own connections that write the audit log.
+func Ignore(connectionID uint32) {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.ignored[connectionID] = struct{}{}
+}
+
+// Unignore resumes auditing the given connection. Connection IDs are not reused while the server runs, so this only
+// releases the memory held by Ignore.
+func Unignore(connectionID uint32) {
+	registry.Lock()
+	defer registry.Unlock()
+	delete(registry.ignored, connectionID)
+}
@@ -0,0 +1,83 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows && !plan9
+// +build !windows,!plan9
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/syslog"
+	"net/url"
+)
+
+// SyslogSink sends each event to syslog as a line of JSON, using the auth facility.
+type SyslogSink struct {
+	writer *syslog.Writer
+}
+
+var _ Sink = (*SyslogSink)(nil)
+
+// OpenSyslogSink connects to syslog. The address is either "local" for the local syslog daemon, or the URL of a remote
+// daemon, such as "udp://logs:514" or "tcp://logs:514".
+func OpenSyslogSink(address string, tag string) (*SyslogSink, error) {
+	network, host, err := parseSyslogAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	writer, err := syslog.Dial(network, host, syslog.LOG_INFO|syslog.LOG_AUTH, tag)
+	if err != nil {
+		return nil, err
+	}
+	return &SyslogSink{writer: writer}, nil
+}
+
+// Write implements the interface Sink.
+func (s *SyslogSink) Write(event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if event.Succeeded {
+		return s.writer.Info(string(data))
+	}
+	return s.writer.Warning(string(data))
+}
+
+// Close implements the interface Sink.
+func (s *SyslogSink) Close() error {
+	return s.writer.Close()
+}
+
+// parseSyslogAddress returns the network and host of the given address, where both are empty for the local daemon.
+func parseSyslogAddress(address string) (network string, host string, err error) {
+	if address == "local" {
+		return "", "", nil
+	}
+	parsed, err := url.Parse(address)
+	if err != nil {
+		return "", "", err
+	}
+	switch parsed.Scheme {
+	case "udp", "tcp":
+		if len(parsed.Host) == 0 {
+			return "", "", fmt.Errorf(`syslog address "%s" is missing a host`, address)
+		}
+		return parsed.Scheme, parsed.Host, nil
+	default:
+		return "", "", fmt.Errorf(`invalid syslog address "%s", expected "local", "udp://host:port", or "tcp://host:port"`, address)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build windows || plan9
+// +build windows plan9
+
+package audit
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// SyslogSink is not supported on this platform.
+type SyslogSink struct{}
+
+var _ Sink = (*SyslogSink)(nil)
+
+// OpenSyslogSink returns an error, as syslog is not supported on this platform.
+func OpenSyslogSink(address string, tag string) (*SyslogSink, error) {
+	return nil, fmt.Errorf("syslog is not supported on %s", runtime.GOOS)
+}
+
+// Write implements the interface Sink.
+func (s *SyslogSink) Write(event Event) error {
+	return fmt.Errorf("syslog is not supported on %s", runtime.GOOS)
+}
+
+// Close implements the interface Sink.
+func (s *SyslogSink) Close() error {
+	return nil
+}
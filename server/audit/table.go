@@ -0,0 +1,262 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/sirupsen/logrus"
+)
+
+// TableName is the name of the table that the TableSink writes events to.
+const TableName = "audit_log"
+
+// maxBatchSize is the largest number of events that are inserted by a single statement.
+const maxBatchSize = 256
+
+// timestampFormat is the text format of each event's time, which is written as text since it's unambiguous.
+const timestampFormat = "2006-01-02 15:04:05.999999-07:00"
+
+// createTableQuery creates the table that events are written to, if it does not already exist.
+const createTableQuery = `CREATE TABLE IF NOT EXISTS ` + TableName + ` (
+	id BIGINT PRIMARY KEY,
+	event_time TIMESTAMPTZ NOT NULL,
+	connection_id BIGINT NOT NULL,
+	user_name TEXT NOT NULL,
+	database_name TEXT,
+	remote_addr TEXT,
+	class TEXT NOT NULL,
+	command_tag TEXT NOT NULL,
+	statement TEXT NOT NULL,
+	succeeded BOOLEAN NOT NULL,
+	error TEXT
+);`
+
+// Connector opens a connection to the running server, connected to the given database. An empty database connects to
+// the server without choosing a database.
+type Connector func(ctx context.Context, database string) (*pgx.Conn, error)
+
+// TableSink writes events to a table within a database on the running server, which is created along with the table if
+// it does not already exist. Events are written in the background, in the order that they were recorded. When
+// committing, each batch of events is committed to the database's history, so that the log is versioned and any later
+// change to a recorded event is visible in the history.
+type TableSink struct {
+	database   string
+	doltCommit bool
+	connect    Connector
+	events     chan Event
+	done       chan struct{}
+	closeOnce  sync.Once
+	started    bool
+	conn       *pgx.Conn
+	lastID     int64
+}
+
+var _ Sink = (*TableSink)(nil)
+
+// NewTableSink returns a sink that writes to the audit table of the given database. Events are buffered until Start is
+// called, as the server must be running before the sink can connect to it.
+func NewTableSink(database string, doltCommit bool) *TableSink {
+	return &TableSink{
+		database:   database,
+		doltCommit: doltCommit,
+		events:     make(chan Event, 1024),
+		done:       make(chan struct{}),
+	}
+}
+
+// Start creates the database and table if they do not exist, and then writes events in the background until the sink
+// is closed.
+func (s *TableSink) Start(ctx context.Context, connect Connector) error {
+	s.connect = connect
+	err := s.ensureConnected(ctx)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "3D000" {
+		if err = s.createDatabase(ctx); err != nil {
+			return err
+		}
+		err = s.ensureConnected(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	s.started = true
+	go s.run()
+	return nil
+}
+
+// Write implements the interface Sink. This blocks while the buffer is full, so that events are never dropped.
+func (s *TableSink) Write(event Event) error {
+	select {
+	case <-s.done:
+		return fmt.Errorf("the audit table sink has been closed")
+	default:
+	}
+	s.events <- event
+	return nil
+}
+
+// Close implements the interface Sink. Every buffered event is written before this returns.
+func (s *TableSink) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.events)
+		if !s.started {
+			// The sink was never started, so there's nothing that could write the buffered events
+			close(s.done)
+		}
+	})
+	<-s.done
+	return nil
+}
+
+// run writes each batch of events as they're recorded, until the sink is closed.
+func (s *TableSink) run() {
+	defer close(s.done)
+	defer func() {
+		if s.conn != nil {
+			s.disconnect(s.conn)
+		}
+	}()
+	for event := range s.events {
+		batch := []Event{event}
+	drain:
+		for len(batch) < maxBatchSize {
+			select {
+			case next, ok := <-s.events:
+				if !ok {
+					break drain
+				}
+				batch = append(batch, next)
+			default:
+				break drain
+			}
+		}
+		if err := s.writeBatch(context.Background(), batch); err != nil {
+			logrus.WithError(err).Errorf("unable to write %d events to the audit table", len(batch))
+			if s.conn != nil {
+				s.disconnect(s.conn)
+				s.conn = nil
+			}
+		}
+	}
+}
+
+// writeBatch inserts the events into the table, committing them when configured to do so.
+func (s *TableSink) writeBatch(ctx context.Context, batch []Event) error {
+	if err := s.ensureConnected(ctx); err != nil {
+		return err
+	}
+	var sb strings.Builder
+	sb.WriteString("INSERT INTO " + TableName + " (id, event_time, connection_id, user_name, database_name, remote_addr, class, command_tag, statement, succeeded, error) VALUES ")
+	args := make([]any, 0, len(batch)*11)
+	for i, event := range batch {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString("(")
+		for j := 1; j <= 11; j++ {
+			if j > 1 {
+				sb.WriteString(", ")
+			}
+			fmt.Fprintf(&sb, "$%d", i*11+j)
+		}
+		sb.WriteString(")")
+		// IDs are derived from the event's time, so that they increase across restarts of the server
+		id := event.Time.UnixMicro()
+		if id <= s.lastID {
+			id = s.lastID + 1
+		}
+		s.lastID = id
+		args = append(args, id, event.Time.Format(timestampFormat), int64(event.ConnectionID), event.User, nullableText(event.Database),
+			nullableText(event.RemoteAddr), string(event.Class), event.CommandTag, event.Statement, event.Succeeded,
+			nullableText(event.Error))
+	}
+	sb.WriteString(";")
+	if _, err := s.conn.Exec(ctx, sb.String(), args...); err != nil {
+		return err
+	}
+	if !s.doltCommit {
+		return nil
+	}
+	if _, err := s.conn.Exec(ctx, fmt.Sprintf("SELECT dolt_add('%s');", TableName)); err != nil {
+		return err
+	}
+	message := fmt.Sprintf("Record %d audit events", len(batch))
+	if len(batch) == 1 {
+		message = "Record 1 audit event"
+	}
+	_, err := s.conn.Exec(ctx, "SELECT dolt_commit('-m', $1::text, '--author', 'Doltgres Audit <audit@doltgres>');", message)
+	return err
+}
+
+// createDatabase creates the database that the table belongs to.
+func (s *TableSink) createDatabase(ctx context.Context) error {
+	conn, err := s.connectTo(ctx, "")
+	if err != nil {
+		return err
+	}
+	defer s.disconnect(conn)
+	if _, err = conn.Exec(ctx, fmt.Sprintf(`CREATE DATABASE "%s";`, strings.ReplaceAll(s.database, `"`, `""`))); err != nil {
+		return fmt.Errorf("unable to create the audit database: %w", err)
+	}
+	return nil
+}
+
+// ensureConnected connects to the database and creates the table, unless the sink is already connected.
+func (s *TableSink) ensureConnected(ctx context.Context) error {
+	if s.conn != nil {
+		return nil
+	}
+	conn, err := s.connectTo(ctx, s.database)
+	if err != nil {
+		return err
+	}
+	if _, err = conn.Exec(ctx, createTableQuery); err != nil {
+		s.disconnect(conn)
+		return fmt.Errorf("unable to create the audit table: %w", err)
+	}
+	s.conn = conn
+	return nil
+}
+
+// connectTo opens a connection to the given database, which is excluded from auditing.
+func (s *TableSink) connectTo(ctx context.Context, database string) (*pgx.Conn, error) {
+	conn, err := s.connect(ctx, database)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to the audit database: %w", err)
+	}
+	Ignore(conn.PgConn().PID())
+	return conn, nil
+}
+
+// disconnect closes the given connection.
+func (s *TableSink) disconnect(conn *pgx.Conn) {
+	Unignore(conn.PgConn().PID())
+	_ = conn.Close(context.Background())
+}
+
+// nullableText returns nil for empty strings, so that they're written as NULL.
+func nullableText(value string) any {
+	if len(value) == 0 {
+		return nil
+	}
+	return value
+}
@@ -0,0 +1,215 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	doltservercfg "github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+	"github.com/dolthub/dolt/go/libraries/utils/svcs"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/audit"
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+// versionControlProcedures are the Dolt procedures that are audited, as they change the history, branches, or remotes
+// of a database.
+var versionControlProcedures = map[string]struct{}{
+	"dolt_add":                     {},
+	"dolt_backup":                  {},
+	"dolt_branch":                  {},
+	"dolt_checkout":                {},
+	"dolt_cherry_pick":             {},
+	"dolt_clean":                   {},
+	"dolt_clone":                   {},
+	"dolt_commit":                  {},
+	"dolt_conflicts_resolve":       {},
+	"dolt_fetch":                   {},
+	"dolt_gc":                      {},
+	"dolt_merge":                   {},
+	"dolt_pull":                    {},
+	"dolt_purge_dropped_databases": {},
+	"dolt_push":                    {},
+	"dolt_rebase":                  {},
+	"dolt_remote":                  {},
+	"dolt_reset":                   {},
+	"dolt_revert":                  {},
+	"dolt_stash":                   {},
+	"dolt_tag":                     {},
+	"dolt_undrop":                  {},
+}
+
+// auditConfig holds the validated audit config, which is applied once the server starts.
+type auditConfig struct {
+	classes   []audit.Class
+	file      string
+	syslog    string
+	syslogTag string
+	// table is nil when events are not written to a table.
+	table *audit.TableSink
+}
+
+// newAuditConfig validates the audit config. Returns nil if auditing has not been configured.
+func newAuditConfig(cfg *servercfg.DoltgresAuditConfig) (*auditConfig, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	ac := &auditConfig{
+		syslogTag: "doltgres",
+	}
+	for _, name := range cfg.Classes {
+		class, err := audit.ParseClass(name)
+		if err != nil {
+			return nil, err
+		}
+		ac.classes = append(ac.classes, class)
+	}
+	if cfg.File != nil {
+		ac.file = *cfg.File
+	}
+	if cfg.Syslog != nil {
+		ac.syslog = *cfg.Syslog
+	}
+	if cfg.SyslogTag != nil {
+		ac.syslogTag = *cfg.SyslogTag
+	}
+	doltCommit := cfg.DoltCommit != nil && *cfg.DoltCommit
+	if cfg.Database != nil && len(*cfg.Database) > 0 {
+		ac.table = audit.NewTableSink(*cfg.Database, doltCommit)
+	} else if doltCommit {
+		return nil, fmt.Errorf("the audit log's dolt_commit is set, but a database has not been set")
+	}
+	if len(ac.file) == 0 && len(ac.syslog) == 0 && ac.table == nil {
+		return nil, fmt.Errorf("the audit log has been configured without a file, syslog, or database")
+	}
+	return ac, nil
+}
+
+// newAuditService returns a service that opens the audit log's file and syslog sinks when the server starts, and closes
+// every sink once the server stops. This must be registered before the engine's services, so that every statement
+// that runs is audited.
+func newAuditService(ac *auditConfig) *svcs.AnonService {
+	return &svcs.AnonService{
+		InitF: func(context.Context) error {
+			audit.Configure(ac.classes)
+			if len(ac.file) > 0 {
+				sink, err := audit.OpenFileSink(ac.file)
+				if err != nil {
+					return errors.Join(fmt.Errorf("unable to open the audit file: %w", err), audit.Close())
+				}
+				audit.AddSink(sink)
+			}
+			if len(ac.syslog) > 0 {
+				sink, err := audit.OpenSyslogSink(ac.syslog, ac.syslogTag)
+				if err != nil {
+					return errors.Join(fmt.Errorf("unable to connect to syslog: %w", err), audit.Close())
+				}
+				audit.AddSink(sink)
+			}
+			// Events are buffered by the table until it's started, which happens once the server is running
+			if ac.table != nil {
+				audit.AddSink(ac.table)
+			}
+			return nil
+		},
+		StopF: func() error {
+			return audit.Close()
+		},
+	}
+}
+
+// newAuditTableService returns a service that writes any buffered events to the audit table when the server stops. This
+// must be registered after the engine's services, as the table is written through the running server.
+func newAuditTableService(ac *auditConfig) *svcs.AnonService {
+	return &svcs.AnonService{
+		StopF: func() error {
+			return audit.RemoveSink(ac.table)
+		},
+	}
+}
+
+// startAuditTable begins writing events to the audit table. This must be called once the server is running.
+func startAuditTable(ctx context.Context, ac *auditConfig, cfg doltservercfg.ServerConfig) error {
+	return ac.table.Start(ctx, func(ctx context.Context, database string) (*pgx.Conn, error) {
+		return pgx.Connect(ctx, fmt.Sprintf(
+			"postgres://%s:%s@localhost:%d/%s",
+			cfg.User(),
+			cfg.Password(),
+			cfg.Port(),
+			database,
+		))
+	})
+}
+
+// auditClass returns the audit class of the given statement, using the converted statement to find the Dolt procedures
+// that it calls.
+func auditClass(stmt tree.Statement, converted sqlparser.Statement) audit.Class {
+	switch stmt.(type) {
+	case *tree.Grant, *tree.Revoke, *tree.GrantRole, *tree.RevokeRole, *tree.CreateRole, *tree.AlterRole,
+		*tree.DropRole, *tree.AlterDefaultPrivileges:
+		return audit.ClassPrivilege
+	}
+	if stmt.StatementType() == tree.DDL {
+		return audit.ClassDDL
+	}
+	if converted != nil && len(calledProcedure(converted, versionControlProcedures)) > 0 {
+		return audit.ClassDolt
+	}
+	return audit.ClassNone
+}
+
+// auditStatement records the query in the audit log if its class is audited. This must be called once the query has
+// finished, with the query's error.
+func (h *ConnectionHandler) auditStatement(query ConvertedQuery, err error) {
+	if !audit.Enabled(query.AuditClass, h.mysqlConn.ConnectionID) {
+		return
+	}
+	event := audit.Event{
+		Time:         time.Now().UTC(),
+		ConnectionID: h.mysqlConn.ConnectionID,
+		User:         h.mysqlConn.User,
+		Database:     h.currentDatabase(),
+		Class:        query.AuditClass,
+		CommandTag:   query.StatementTag,
+		Statement:    query.String,
+		Succeeded:    err == nil,
+	}
+	if remoteAddr := h.Conn().RemoteAddr(); remoteAddr != nil {
+		event.RemoteAddr = remoteAddr.String()
+	}
+	if err != nil {
+		event.Error = err.Error()
+	}
+	audit.Record(event)
+}
+
+// currentDatabase returns the session's current database, or an empty string if it could not be read.
+func (h *ConnectionHandler) currentDatabase() string {
+	var database string
+	_ = h.handler.ComQuery(h.mysqlConn, "SELECT database();", func(res *sqltypes.Result, more bool) error {
+		if len(res.Rows) > 0 && len(res.Rows[0]) > 0 && !res.Rows[0][0].IsNull() {
+			database = res.Rows[0][0].ToString()
+		}
+		return nil
+	})
+	return database
+}
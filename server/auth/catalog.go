@@ -0,0 +1,626 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/privilege"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+const (
+	// PublicRole is the name that refers to every role when granting privileges.
+	PublicRole = "public"
+	// BootstrapRole is the superuser that always exists, and which owns every object that does not have a recorded
+	// owner.
+	BootstrapRole = "postgres"
+	// bootstrapRoleOID is the OID of the bootstrap role, which matches Postgres.
+	bootstrapRoleOID = 10
+	// firstRoleOID is the OID of the first role that is created, which matches the first OID that Postgres assigns to
+	// objects that were not created during initialization.
+	firstRoleOID = 16384
+)
+
+// catalogState is the content of the role catalog, which is persisted as a whole whenever it changes.
+type catalogState struct {
+	nextOID uint32
+	roles   map[string]Role
+	// owners contains the owner of each object that was created by a role. Objects that are missing are owned by the
+	// bootstrap role.
+	owners map[Object]string
+	// acls contains the grants of every object whose privileges have been changed. Objects that are missing use the
+	// default privileges for their kind.
+	acls map[Object][]Grant
+	// memberships contains every membership of a role within another role.
+	memberships []Membership
+	// defaultACLs contains the grants that are given to objects when they're created.
+	defaultACLs map[DefaultACLKey][]Grant
+}
+
+// catalog holds the roles and privileges of the server. Roles are shared by every database, so changes to the catalog
+// are not transactional, and are persisted as soon as they're made.
+var catalog = struct {
+	sync.Mutex
+	path string
+	catalogState
+}{
+	catalogState: newCatalogState(),
+}
+
+// newCatalogState returns an empty catalogState.
+func newCatalogState() catalogState {
+	return catalogState{
+		nextOID:     firstRoleOID,
+		roles:       make(map[string]Role),
+		owners:      make(map[Object]string),
+		acls:        make(map[Object][]Grant),
+		defaultACLs: make(map[DefaultACLKey][]Grant),
+	}
+}
+
+// Load replaces the current catalog with the one persisted at the given path. The catalog is held in memory only when
+// the path is empty. The bootstrap role and the given superuser are created if they do not exist, and the superuser's
+// password is set to the given password when one is given.
+func Load(path string, superuser string, password string) error {
+	state := newCatalogState()
+	if len(path) > 0 {
+		data, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if len(data) > 0 {
+			if state, err = deserialize(data); err != nil {
+				return fmt.Errorf("unable to read the roles at %s: %w", path, err)
+			}
+		}
+	}
+	catalog.Lock()
+	defer catalog.Unlock()
+	catalog.path = path
+	catalog.catalogState = state
+	ensureSuperuser(BootstrapRole, bootstrapRoleOID)
+	if len(superuser) > 0 && superuser != BootstrapRole {
+		ensureSuperuser(superuser, 0)
+	}
+	if len(superuser) > 0 && len(password) > 0 {
+		role := catalog.roles[superuser]
+		if !VerifyPassword(superuser, role.Password, password) {
+			encrypted, err := EncryptPassword(password)
+			if err != nil {
+				return err
+			}
+			role.Password = encrypted
+			catalog.roles[superuser] = role
+		}
+	}
+	return persist()
+}
+
+// ensureSuperuser creates the named role as a superuser if it does not exist. An OID of zero assigns the next OID. The
+// catalog's lock must be held.
+func ensureSuperuser(name string, oid uint32) {
+	if _, ok := catalog.roles[name]; ok {
+		return
+	}
+	role := NewRole(name)
+	role.IsSuperUser = true
+	role.CanCreateRoles = true
+	role.CanCreateDB = true
+	role.CanLogin = true
+	role.IsReplication = true
+	role.BypassRLS = true
+	if oid == 0 {
+		oid = catalog.nextOID
+		catalog.nextOID++
+	}
+	role.OID = oid
+	catalog.roles[name] = role
+}
+
+// GetRole returns the role with the given name.
+func GetRole(name string) (Role, bool) {
+	catalog.Lock()
+	defer catalog.Unlock()
+	role, ok := catalog.roles[name]
+	return role, ok
+}
+
+// Roles returns every role, sorted by name.
+func Roles() []Role {
+	catalog.Lock()
+	defer catalog.Unlock()
+	roles := make([]Role, 0, len(catalog.roles))
+	for _, role := range catalog.roles {
+		roles = append(roles, role)
+	}
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].Name < roles[j].Name
+	})
+	return roles
+}
+
+// CreateRole adds the given role to the catalog, assigning its OID.
+func CreateRole(role Role) error {
+	if err := checkRoleName(role.Name); err != nil {
+		return err
+	}
+	catalog.Lock()
+	defer catalog.Unlock()
+	if _, ok := catalog.roles[role.Name]; ok {
+		return pgerrors.Newf(pgcode.DuplicateObject, `role "%s" already exists`, role.Name)
+	}
+	role.OID = catalog.nextOID
+	catalog.nextOID++
+	catalog.roles[role.Name] = role
+	return persist()
+}
+
+// UpdateRole replaces the attributes of the role with the same name.
+func UpdateRole(role Role) error {
+	catalog.Lock()
+	defer catalog.Unlock()
+	existing, ok := catalog.roles[role.Name]
+	if !ok {
+		return pgerrors.Newf(pgcode.UndefinedObject, `role "%s" does not exist`, role.Name)
+	}
+	role.OID = existing.OID
+	catalog.roles[role.Name] = role
+	return persist()
+}
+
+// RenameRole renames the role, along with every reference to it. As the MD5 hash of a password includes the role's
+// name, an MD5 password is cleared by the rename, in which case this returns true.
+func RenameRole(name string, newName string) (passwordCleared bool, err error) {
+	if err = checkRoleName(newName); err != nil {
+		return false, err
+	}
+	catalog.Lock()
+	defer catalog.Unlock()
+	role, ok := catalog.roles[name]
+	if !ok {
+		return false, pgerrors.Newf(pgcode.UndefinedObject, `role "%s" does not exist`, name)
+	}
+	if _, ok = catalog.roles[newName]; ok {
+		return false, pgerrors.Newf(pgcode.DuplicateObject, `role "%s" already exists`, newName)
+	}
+	if name == BootstrapRole {
+		return false, pgerrors.Newf(pgcode.FeatureNotSupported, `role "%s" cannot be renamed`, name)
+	}
+	if IsMD5Password(role.Password) {
+		role.Password = ""
+		passwordCleared = true
+	}
+	delete(catalog.roles, name)
+	role.Name = newName
+	catalog.roles[newName] = role
+	for obj, owner := range catalog.owners {
+		if owner == name {
+			catalog.owners[obj] = newName
+		}
+	}
+	for _, acl := range catalog.acls {
+		for i := range acl {
+			if acl[i].Grantee == name {
+				acl[i].Grantee = newName
+			}
+			if acl[i].Grantor == name {
+				acl[i].Grantor = newName
+			}
+		}
+	}
+	for i := range catalog.memberships {
+		membership := &catalog.memberships[i]
+		for _, field := range []*string{&membership.Role, &membership.Member, &membership.Grantor} {
+			if *field == name {
+				*field = newName
+			}
+		}
+	}
+	for key, acl := range catalog.defaultACLs {
+		for i := range acl {
+			if acl[i].Grantee == name {
+				acl[i].Grantee = newName
+			}
+			if acl[i].Grantor == name {
+				acl[i].Grantor = newName
+			}
+		}
+		if key.Role == name {
+			delete(catalog.defaultACLs, key)
+			key.Role = newName
+			catalog.defaultACLs[key] = acl
+		}
+	}
+	return passwordCleared, persist()
+}
+
+// DropRole removes the role from the catalog, along with its memberships. Roles that own objects, have been granted
+// privileges, or have default privileges cannot be dropped.
+func DropRole(name string) error {
+	catalog.Lock()
+	defer catalog.Unlock()
+	if _, ok := catalog.roles[name]; !ok {
+		return pgerrors.Newf(pgcode.UndefinedObject, `role "%s" does not exist`, name)
+	}
+	if name == BootstrapRole {
+		return pgerrors.Newf(pgcode.ObjectInUse, `cannot drop role %s because it is required by the database system`, name)
+	}
+	var dependencies []string
+	for obj, owner := range catalog.owners {
+		if owner == name {
+			dependencies = append(dependencies, "owner of "+obj.String())
+		}
+	}
+	for obj, acl := range catalog.acls {
+		for _, grant := range acl {
+			if grant.Grantee == name || grant.Grantor == name {
+				dependencies = append(dependencies, "privileges for "+obj.String())
+				break
+			}
+		}
+	}
+	for key, acl := range catalog.defaultACLs {
+		for _, grant := range acl {
+			if key.Role == name || grant.Grantee == name {
+				dependencies = append(dependencies, key.String())
+				break
+			}
+		}
+	}
+	if len(dependencies) > 0 {
+		sort.Strings(dependencies)
+		return pgerrors.Newf(pgcode.DependentObjectsStillExist,
+			`role "%s" cannot be dropped because some objects depend on it`, name).
+			WithDetail(strings.Join(dependencies, "\n"))
+	}
+	delete(catalog.roles, name)
+	remaining := catalog.memberships[:0]
+	for _, membership := range catalog.memberships {
+		if membership.Role != name && membership.Member != name && membership.Grantor != name {
+			remaining = append(remaining, membership)
+		}
+	}
+	catalog.memberships = remaining
+	return persist()
+}
+
+// checkRoleName returns an error if the name is reserved.
+func checkRoleName(name string) error {
+	if IsReservedRoleName(name) {
+		err := pgerrors.Newf(pgcode.ReservedName, `role name "%s" is reserved`, name)
+		if name != PublicRole {
+			err = err.WithDetail(`Role names starting with "pg_" are reserved.`)
+		}
+		return err
+	}
+	return nil
+}
+
+// Owner returns the owner of the object.
+func Owner(obj Object) string {
+	catalog.Lock()
+	defer catalog.Unlock()
+	return ownerOf(obj)
+}
+
+// ownerOf returns the owner of the object. The catalog's lock must be held.
+func ownerOf(obj Object) string {
+	if owner, ok := catalog.owners[obj]; ok {
+		return owner
+	}
+	return BootstrapRole
+}
+
+// CreateObject records that the object was created by the given role, which becomes its owner. Any privileges that
+// were left over from an object with the same name are removed, and the owner's default privileges are given instead.
+// Objects without a recorded owner are owned by the bootstrap role, so objects that it creates are only recorded when
+// they replace a leftover entry or have default privileges.
+func CreateObject(obj Object, owner string) error {
+	catalog.Lock()
+	defer catalog.Unlock()
+	changed := removeObject(obj)
+	if owner != BootstrapRole {
+		catalog.owners[obj] = owner
+		changed = true
+	}
+	if applyDefaultACLs(obj, owner) {
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return persist()
+}
+
+// DropObject removes the owner and privileges of the object, along with those of every object that it contains.
+func DropObject(obj Object) error {
+	catalog.Lock()
+	defer catalog.Unlock()
+	if !removeObject(obj) {
+		return nil
+	}
+	return persist()
+}
+
+// RenameObject moves the owner and privileges of the object to its new name.
+func RenameObject(obj Object, newObj Object) error {
+	catalog.Lock()
+	defer catalog.Unlock()
+	changed := removeObject(newObj)
+	if owner, ok := catalog.owners[obj]; ok {
+		catalog.owners[newObj] = owner
+		delete(catalog.owners, obj)
+		changed = true
+	}
+	if acl, ok := catalog.acls[obj]; ok {
+		catalog.acls[newObj] = acl
+		delete(catalog.acls, obj)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return persist()
+}
+
+// removeObject removes the owner and privileges of the object and every object that it contains, returning whether
+// anything was removed. The catalog's lock must be held.
+func removeObject(obj Object) bool {
+	removed := false
+	for o := range catalog.owners {
+		if obj.Contains(o) {
+			delete(catalog.owners, o)
+			removed = true
+		}
+	}
+	for o := range catalog.acls {
+		if obj.Contains(o) {
+			delete(catalog.acls, o)
+			removed = true
+		}
+	}
+	if obj.Kind == ObjectKind_Database || obj.Kind == ObjectKind_Schema {
+		for key := range catalog.defaultACLs {
+			if key.Database == obj.Database && (obj.Kind == ObjectKind_Database || key.Schema == obj.Schema) {
+				delete(catalog.defaultACLs, key)
+				removed = true
+			}
+		}
+	}
+	return removed
+}
+
+// ACL returns the grants on the object, beginning with the owner's implicit grant of every privilege.
+func ACL(obj Object) []Grant {
+	catalog.Lock()
+	defer catalog.Unlock()
+	owner := ownerOf(obj)
+	allPrivileges := obj.Kind.Privileges()
+	acl := []Grant{{Grantee: owner, Grantor: owner, Privileges: allPrivileges, GrantOptions: allPrivileges}}
+	return append(acl, aclOf(obj)...)
+}
+
+// aclOf returns the grants that have been made on the object, or the default grants if none have been made. The
+// catalog's lock must be held.
+func aclOf(obj Object) []Grant {
+	if acl, ok := catalog.acls[obj]; ok {
+		return acl
+	}
+	switch obj.Kind {
+	case ObjectKind_Database:
+		return []Grant{{Grantee: PublicRole, Grantor: ownerOf(obj),
+			Privileges: NewPrivileges(privilege.CONNECT, privilege.TEMPORARY)}}
+	case ObjectKind_Schema:
+		switch obj.Name {
+		case "public", "pg_catalog", "information_schema":
+			return []Grant{{Grantee: PublicRole, Grantor: ownerOf(obj), Privileges: NewPrivileges(privilege.USAGE)}}
+		}
+	case ObjectKind_Function:
+		return []Grant{{Grantee: PublicRole, Grantor: ownerOf(obj), Privileges: NewPrivileges(privilege.EXECUTE)}}
+	}
+	return nil
+}
+
+// HasPrivilege returns whether the role holds the privilege on the object, either directly, through a role whose
+// privileges it inherits, or through PUBLIC. Superusers and owners hold every privilege.
+func HasPrivilege(roleName string, obj Object, kind privilege.Kind) bool {
+	catalog.Lock()
+	defer catalog.Unlock()
+	roles := memberRoles(roleName, inherits)
+	if isSuperUser(roleName) || hasRole(roles, ownerOf(obj)) {
+		return true
+	}
+	for _, grant := range aclOf(obj) {
+		if (hasRole(roles, grant.Grantee) || grant.Grantee == PublicRole) && grant.Privileges.Has(kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAnyPrivilege returns whether the role holds any privilege on the object.
+func HasAnyPrivilege(roleName string, obj Object) bool {
+	catalog.Lock()
+	defer catalog.Unlock()
+	roles := memberRoles(roleName, inherits)
+	if isSuperUser(roleName) || hasRole(roles, ownerOf(obj)) {
+		return true
+	}
+	for _, grant := range aclOf(obj) {
+		if (hasRole(roles, grant.Grantee) || grant.Grantee == PublicRole) && grant.Privileges != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOwner returns whether the role owns the object, either directly or through a role whose privileges it inherits.
+// Superusers are treated as owning every object.
+func IsOwner(roleName string, obj Object) bool {
+	catalog.Lock()
+	defer catalog.Unlock()
+	return isSuperUser(roleName) || hasRole(memberRoles(roleName, inherits), ownerOf(obj))
+}
+
+// hasRole returns whether the role is within the set of roles.
+func hasRole(roles map[string]struct{}, roleName string) bool {
+	_, ok := roles[roleName]
+	return ok
+}
+
+// isSuperUser returns whether the named role is a superuser. The catalog's lock must be held.
+func isSuperUser(roleName string) bool {
+	role, ok := catalog.roles[roleName]
+	return ok && role.IsSuperUser
+}
+
+// grantable returns the privileges on the object that the role may grant or revoke, along with the grantor that is
+// recorded for them. The owner is recorded as the grantor when the role is a superuser, or has the privileges of the
+// owner. The catalog's lock must be held.
+func grantable(roleName string, obj Object) (Privileges, string) {
+	owner := ownerOf(obj)
+	roles := memberRoles(roleName, inherits)
+	if isSuperUser(roleName) || hasRole(roles, owner) {
+		return obj.Kind.Privileges(), owner
+	}
+	var options Privileges
+	for _, grant := range aclOf(obj) {
+		if hasRole(roles, grant.Grantee) {
+			options |= grant.GrantOptions
+		}
+	}
+	return options, roleName
+}
+
+// GrantPrivileges gives the privileges on the object to each grantee on behalf of the grantor. Only the privileges that the
+// grantor may grant are given, and those are returned.
+func GrantPrivileges(obj Object, grantor string, grantees []string, privileges Privileges, withGrantOption bool) (Privileges, error) {
+	catalog.Lock()
+	defer catalog.Unlock()
+	options, recordedGrantor := grantable(grantor, obj)
+	privileges &= options
+	if privileges == 0 {
+		return 0, nil
+	}
+	acl := append([]Grant(nil), aclOf(obj)...)
+	for _, grantee := range grantees {
+		idx := findGrant(acl, grantee, recordedGrantor)
+		if idx == -1 {
+			acl = append(acl, Grant{Grantee: grantee, Grantor: recordedGrantor})
+			idx = len(acl) - 1
+		}
+		acl[idx].Privileges |= privileges
+		if withGrantOption {
+			acl[idx].GrantOptions |= privileges
+		}
+	}
+	catalog.acls[obj] = acl
+	return privileges, persist()
+}
+
+// RevokePrivileges removes the privileges on the object from each grantee, which were given by the revoker. When grantOptionOnly
+// is true, then only the grant options are removed. Grants that depend on a removed grant option are also removed when
+// cascade is true, and cause an error otherwise. Returns the privileges that the revoker was able to revoke.
+func RevokePrivileges(obj Object, revoker string, grantees []string, privileges Privileges, grantOptionOnly bool, cascade bool) (Privileges, error) {
+	catalog.Lock()
+	defer catalog.Unlock()
+	options, recordedGrantor := grantable(revoker, obj)
+	privileges &= options
+	if privileges == 0 {
+		return 0, nil
+	}
+	acl := append([]Grant(nil), aclOf(obj)...)
+	var err error
+	for _, grantee := range grantees {
+		if acl, err = revokeGrant(acl, grantee, recordedGrantor, privileges, grantOptionOnly, cascade); err != nil {
+			return 0, err
+		}
+	}
+	remaining := acl[:0]
+	for _, grant := range acl {
+		if grant.Privileges != 0 {
+			remaining = append(remaining, grant)
+		}
+	}
+	catalog.acls[obj] = remaining
+	return privileges, persist()
+}
+
+// revokeGrant removes the privileges that the grantor gave to the grantee, along with any grants that the grantee made
+// using a removed grant option when cascade is true.
+func revokeGrant(acl []Grant, grantee string, grantor string, privileges Privileges, grantOptionOnly bool, cascade bool) ([]Grant, error) {
+	idx := findGrant(acl, grantee, grantor)
+	if idx == -1 {
+		return acl, nil
+	}
+	lostOptions := acl[idx].GrantOptions & privileges
+	acl[idx].GrantOptions &^= privileges
+	if !grantOptionOnly {
+		acl[idx].Privileges &^= privileges
+	}
+	if lostOptions == 0 {
+		return acl, nil
+	}
+	var dependents []string
+	for _, grant := range acl {
+		if grant.Grantor == grantee && grant.Privileges&lostOptions != 0 {
+			dependents = append(dependents, grant.Grantee)
+		}
+	}
+	if len(dependents) > 0 && !cascade {
+		return nil, pgerrors.New(pgcode.DependentObjectsStillExist, "dependent privileges exist").
+			WithHint("Use CASCADE to revoke them too.")
+	}
+	var err error
+	for _, dependent := range dependents {
+		if acl, err = revokeGrant(acl, dependent, grantee, lostOptions, false, cascade); err != nil {
+			return nil, err
+		}
+	}
+	return acl, nil
+}
+
+// findGrant returns the index of the grant from the grantor to the grantee, or -1 if there is no such grant.
+func findGrant(acl []Grant, grantee string, grantor string) int {
+	for i, grant := range acl {
+		if grant.Grantee == grantee && grant.Grantor == grantor {
+			return i
+		}
+	}
+	return -1
+}
+
+// persist writes the catalog to its path. The catalog's lock must be held.
+func persist() error {
+	if len(catalog.path) == 0 {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(catalog.path), 0755); err != nil {
+		return err
+	}
+	// The catalog is written to a temporary file first, so that a failed write does not lose the existing catalog
+	tempPath := catalog.path + ".tmp"
+	if err := os.WriteFile(tempPath, catalog.serialize(), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tempPath, catalog.path)
+}
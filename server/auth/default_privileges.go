@@ -0,0 +1,122 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+)
+
+// DefaultACLKey identifies the default privileges that are given to objects of a kind when they're created by a role.
+// An empty schema applies to objects in every schema of the database.
+type DefaultACLKey struct {
+	Role     string
+	Database string
+	Schema   string
+	Kind     ObjectKind
+}
+
+// NewDefaultACLKey returns the DefaultACLKey for objects of the given kind that the role creates in the schema.
+func NewDefaultACLKey(role string, database string, schema string, kind ObjectKind) DefaultACLKey {
+	database, _ = dsess.SplitRevisionDbName(database)
+	return DefaultACLKey{Role: role, Database: database, Schema: schema, Kind: kind}
+}
+
+// String returns the default privileges as they're referred to in error messages.
+func (key DefaultACLKey) String() string {
+	str := fmt.Sprintf("privileges for default privileges on new relations belonging to role %s", key.Role)
+	if len(key.Schema) > 0 {
+		str += " in schema " + key.Schema
+	}
+	return str
+}
+
+// GrantDefaultPrivileges adds the privileges to the default privileges of each grantee for the key. The grants are
+// recorded as being given by the key's role, as it owns the objects that the privileges are applied to.
+func GrantDefaultPrivileges(key DefaultACLKey, grantees []string, privileges Privileges, withGrantOption bool) error {
+	catalog.Lock()
+	defer catalog.Unlock()
+	acl := append([]Grant(nil), catalog.defaultACLs[key]...)
+	for _, grantee := range grantees {
+		idx := findGrant(acl, grantee, key.Role)
+		if idx == -1 {
+			acl = append(acl, Grant{Grantee: grantee, Grantor: key.Role})
+			idx = len(acl) - 1
+		}
+		acl[idx].Privileges |= privileges
+		if withGrantOption {
+			acl[idx].GrantOptions |= privileges
+		}
+	}
+	catalog.defaultACLs[key] = acl
+	return persist()
+}
+
+// RevokeDefaultPrivileges removes the privileges from the default privileges of each grantee for the key. When
+// grantOptionOnly is true, then only the grant options are removed.
+func RevokeDefaultPrivileges(key DefaultACLKey, grantees []string, privileges Privileges, grantOptionOnly bool) error {
+	catalog.Lock()
+	defer catalog.Unlock()
+	acl, ok := catalog.defaultACLs[key]
+	if !ok {
+		return nil
+	}
+	remaining := make([]Grant, 0, len(acl))
+	for _, grant := range acl {
+		for _, grantee := range grantees {
+			if grant.Grantee == grantee {
+				grant.GrantOptions &^= privileges
+				if !grantOptionOnly {
+					grant.Privileges &^= privileges
+				}
+			}
+		}
+		if grant.Privileges != 0 {
+			remaining = append(remaining, grant)
+		}
+	}
+	if len(remaining) == 0 {
+		delete(catalog.defaultACLs, key)
+	} else {
+		catalog.defaultACLs[key] = remaining
+	}
+	return persist()
+}
+
+// applyDefaultACLs gives the object the default privileges of its owner, combining those for every schema with those
+// for the object's schema. Returns whether any privileges were given. The catalog's lock must be held.
+func applyDefaultACLs(obj Object, owner string) bool {
+	if obj.Kind != ObjectKind_Table {
+		return false
+	}
+	var acl []Grant
+	for _, schema := range []string{"", obj.Schema} {
+		for _, grant := range catalog.defaultACLs[DefaultACLKey{Role: owner, Database: obj.Database, Schema: schema, Kind: obj.Kind}] {
+			idx := findGrant(acl, grant.Grantee, grant.Grantor)
+			if idx == -1 {
+				acl = append(acl, Grant{Grantee: grant.Grantee, Grantor: grant.Grantor})
+				idx = len(acl) - 1
+			}
+			acl[idx].Privileges |= grant.Privileges
+			acl[idx].GrantOptions |= grant.GrantOptions
+		}
+	}
+	if len(acl) == 0 {
+		return false
+	}
+	catalog.acls[obj] = acl
+	return true
+}
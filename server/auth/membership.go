@@ -0,0 +1,254 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"sort"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// Membership is the membership of a member within a role, which was granted by the grantor.
+type Membership struct {
+	Role    string
+	Member  string
+	Grantor string
+	// AdminOption allows the member to grant and revoke membership in the role.
+	AdminOption bool
+	// InheritOption gives the member the privileges of the role.
+	InheritOption bool
+	// SetOption allows the member to act as the role, such as when changing the default privileges of the role.
+	SetOption bool
+}
+
+// MembershipOptions are the options given when granting a membership. Options that were not given are nil, so that
+// granting an existing membership only changes the given options.
+type MembershipOptions struct {
+	Admin   *bool
+	Inherit *bool
+	Set     *bool
+}
+
+// Memberships returns every membership, sorted by role and then member.
+func Memberships() []Membership {
+	catalog.Lock()
+	defer catalog.Unlock()
+	memberships := append([]Membership(nil), catalog.memberships...)
+	sort.Slice(memberships, func(i, j int) bool {
+		if memberships[i].Role != memberships[j].Role {
+			return memberships[i].Role < memberships[j].Role
+		}
+		if memberships[i].Member != memberships[j].Member {
+			return memberships[i].Member < memberships[j].Member
+		}
+		return memberships[i].Grantor < memberships[j].Grantor
+	})
+	return memberships
+}
+
+// GrantMembership makes the member a member of the role on behalf of the grantor. Options that were not given use the
+// defaults of Postgres for a new membership, and are left unchanged for an existing membership. Returns false if the
+// membership already existed with the given options.
+func GrantMembership(role string, member string, grantor string, options MembershipOptions) (bool, error) {
+	catalog.Lock()
+	defer catalog.Unlock()
+	memberRole, ok := catalog.roles[member]
+	if !ok {
+		return false, pgerrors.Newf(pgcode.UndefinedObject, `role "%s" does not exist`, member)
+	}
+	if _, ok = catalog.roles[role]; !ok {
+		return false, pgerrors.Newf(pgcode.UndefinedObject, `role "%s" does not exist`, role)
+	}
+	// Memberships may not form a cycle, which includes a role being a member of itself
+	if role == member || isMemberOf(role, member) {
+		return false, pgerrors.Newf(pgcode.InvalidGrantOperation, `role "%s" is a member of role "%s"`, role, member)
+	}
+	idx := findMembership(role, member, grantor)
+	if idx == -1 {
+		catalog.memberships = append(catalog.memberships, Membership{
+			Role:          role,
+			Member:        member,
+			Grantor:       grantor,
+			InheritOption: memberRole.Inherit,
+			SetOption:     true,
+		})
+		idx = len(catalog.memberships) - 1
+	} else if options.unchanged(catalog.memberships[idx]) {
+		return false, nil
+	}
+	membership := &catalog.memberships[idx]
+	if options.Admin != nil {
+		membership.AdminOption = *options.Admin
+	}
+	if options.Inherit != nil {
+		membership.InheritOption = *options.Inherit
+	}
+	if options.Set != nil {
+		membership.SetOption = *options.Set
+	}
+	return true, persist()
+}
+
+// unchanged returns whether the options would not change the given membership.
+func (o MembershipOptions) unchanged(membership Membership) bool {
+	return (o.Admin == nil || *o.Admin == membership.AdminOption) &&
+		(o.Inherit == nil || *o.Inherit == membership.InheritOption) &&
+		(o.Set == nil || *o.Set == membership.SetOption)
+}
+
+// RevokeMembership removes the membership of the member within the role that was granted by the grantor. When
+// options are given, only those options are removed from the membership. Memberships that the member granted using a
+// removed admin option are also removed when cascade is true, and cause an error otherwise. Returns false if the
+// membership did not exist.
+func RevokeMembership(role string, member string, grantor string, options MembershipOptions, cascade bool) (bool, error) {
+	catalog.Lock()
+	defer catalog.Unlock()
+	idx := findMembership(role, member, grantor)
+	if idx == -1 {
+		return false, nil
+	}
+	onlyOptions := options.Admin != nil || options.Inherit != nil || options.Set != nil
+	if !onlyOptions || catalog.memberships[idx].AdminOption && options.Admin != nil {
+		if err := revokeDependentMemberships(role, member, cascade); err != nil {
+			return false, err
+		}
+		// The index may have moved if any dependent memberships were removed
+		idx = findMembership(role, member, grantor)
+	}
+	if !onlyOptions {
+		catalog.memberships = append(catalog.memberships[:idx], catalog.memberships[idx+1:]...)
+	} else {
+		membership := &catalog.memberships[idx]
+		membership.AdminOption = membership.AdminOption && options.Admin == nil
+		membership.InheritOption = membership.InheritOption && options.Inherit == nil
+		membership.SetOption = membership.SetOption && options.Set == nil
+	}
+	return true, persist()
+}
+
+// revokeDependentMemberships removes the memberships in the role that were granted by the grantor, along with any that
+// depend on those, when cascade is true. Returns an error if there are any such memberships and cascade is false. The
+// catalog's lock must be held.
+func revokeDependentMemberships(role string, grantor string, cascade bool) error {
+	var dependents []string
+	for _, membership := range catalog.memberships {
+		if membership.Role == role && membership.Grantor == grantor {
+			dependents = append(dependents, membership.Member)
+		}
+	}
+	if len(dependents) == 0 {
+		return nil
+	}
+	if !cascade {
+		return pgerrors.New(pgcode.DependentObjectsStillExist, "dependent privileges exist").
+			WithHint("Use CASCADE to revoke them too.")
+	}
+	for _, dependent := range dependents {
+		if err := revokeDependentMemberships(role, dependent, cascade); err != nil {
+			return err
+		}
+		if idx := findMembership(role, dependent, grantor); idx != -1 {
+			catalog.memberships = append(catalog.memberships[:idx], catalog.memberships[idx+1:]...)
+		}
+	}
+	return nil
+}
+
+// findMembership returns the index of the membership of the member in the role that was granted by the grantor, or
+// -1 if there is no such membership. The catalog's lock must be held.
+func findMembership(role string, member string, grantor string) int {
+	for i, membership := range catalog.memberships {
+		if membership.Role == role && membership.Member == member && membership.Grantor == grantor {
+			return i
+		}
+	}
+	return -1
+}
+
+// IsMemberOf returns whether the member is the role, or is a member of the role either directly or through other
+// roles, regardless of whether the member inherits the role's privileges. Superusers are members of every role.
+func IsMemberOf(member string, role string) bool {
+	catalog.Lock()
+	defer catalog.Unlock()
+	return member == role || isSuperUser(member) || isMemberOf(member, role)
+}
+
+// isMemberOf returns whether the member is a member of the role either directly or through other roles. The catalog's
+// lock must be held.
+func isMemberOf(member string, role string) bool {
+	return hasRole(memberRoles(member, nil), role)
+}
+
+// HasPrivilegesOf returns whether the member is the role, or inherits the privileges of the role either directly or
+// through other roles. Superusers have the privileges of every role.
+func HasPrivilegesOf(member string, role string) bool {
+	catalog.Lock()
+	defer catalog.Unlock()
+	return isSuperUser(member) || hasRole(memberRoles(member, inherits), role)
+}
+
+// CanSetRole returns whether the member may act as the role, which requires the set option on every membership
+// between them. Superusers may act as every role.
+func CanSetRole(member string, role string) bool {
+	catalog.Lock()
+	defer catalog.Unlock()
+	return isSuperUser(member) || hasRole(memberRoles(member, func(m Membership) bool { return m.SetOption }), role)
+}
+
+// HasAdminOption returns whether the member may grant and revoke membership in the role. Superusers may do so for
+// every role.
+func HasAdminOption(member string, role string) bool {
+	catalog.Lock()
+	defer catalog.Unlock()
+	if isSuperUser(member) {
+		return true
+	}
+	// The admin option may be held by any role whose privileges the member has
+	for name := range memberRoles(member, inherits) {
+		for _, membership := range catalog.memberships {
+			if membership.Role == role && membership.Member == name && membership.AdminOption {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// memberRoles returns the names of the roles that the member belongs to, including the member itself. When follow is
+// not nil, only the memberships that it returns true for are followed. The catalog's lock must be held.
+func memberRoles(member string, follow func(Membership) bool) map[string]struct{} {
+	roles := map[string]struct{}{member: {}}
+	pending := []string{member}
+	for len(pending) > 0 {
+		current := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		for _, membership := range catalog.memberships {
+			if membership.Member != current || (follow != nil && !follow(membership)) {
+				continue
+			}
+			if _, ok := roles[membership.Role]; !ok {
+				roles[membership.Role] = struct{}{}
+				pending = append(pending, membership.Role)
+			}
+		}
+	}
+	return roles
+}
+
+// inherits returns whether the membership gives the member the privileges of the role.
+func inherits(membership Membership) bool {
+	return membership.InheritOption
+}
@@ -0,0 +1,178 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/privilege"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// ObjectKind is the kind of object that privileges may be granted on.
+type ObjectKind uint8
+
+const (
+	ObjectKind_Database ObjectKind = iota + 1
+	ObjectKind_Schema
+	ObjectKind_Table
+	ObjectKind_Function
+	ObjectKind_ForeignServer
+)
+
+// Object is an object that privileges may be granted on. Objects are identified by their names, and the database is
+// always the name of the database without any revision, so that every branch of a database shares its privileges.
+type Object struct {
+	Kind     ObjectKind
+	Database string
+	Schema   string
+	Name     string
+}
+
+// Privileges is a set of privileges, with each privilege represented by the bit from its privilege.Kind.
+type Privileges uint32
+
+// Grant is the set of privileges that a grantor has given to a grantee on an object. The grant options are the subset
+// of the privileges that the grantee may grant to others.
+type Grant struct {
+	Grantee      string
+	Grantor      string
+	Privileges   Privileges
+	GrantOptions Privileges
+}
+
+// DatabaseObject returns the Object for the given database.
+func DatabaseObject(database string) Object {
+	database, _ = dsess.SplitRevisionDbName(database)
+	return Object{Kind: ObjectKind_Database, Database: database, Name: database}
+}
+
+// SchemaObject returns the Object for the given schema.
+func SchemaObject(database string, schema string) Object {
+	database, _ = dsess.SplitRevisionDbName(database)
+	return Object{Kind: ObjectKind_Schema, Database: database, Schema: schema, Name: schema}
+}
+
+// TableObject returns the Object for the given table.
+func TableObject(database string, schema string, table string) Object {
+	database, _ = dsess.SplitRevisionDbName(database)
+	return Object{Kind: ObjectKind_Table, Database: database, Schema: schema, Name: table}
+}
+
+// FunctionObject returns the Object for the given function or procedure.
+func FunctionObject(database string, schema string, function string) Object {
+	database, _ = dsess.SplitRevisionDbName(database)
+	return Object{Kind: ObjectKind_Function, Database: database, Schema: schema, Name: function}
+}
+
+// ForeignServerObject returns the Object for the given foreign server, which does not belong to a schema.
+func ForeignServerObject(database string, server string) Object {
+	database, _ = dsess.SplitRevisionDbName(database)
+	return Object{Kind: ObjectKind_ForeignServer, Database: database, Name: server}
+}
+
+// Contains returns whether the given object is this object, or is contained within this object, such as a table that
+// is within a schema.
+func (o Object) Contains(other Object) bool {
+	switch o.Kind {
+	case ObjectKind_Database:
+		return other.Database == o.Database
+	case ObjectKind_Schema:
+		return other.Database == o.Database && other.Schema == o.Schema && other.Kind != ObjectKind_Database &&
+			other.Kind != ObjectKind_ForeignServer
+	default:
+		return other == o
+	}
+}
+
+// String returns the object as it is referred to in error messages, such as `table t`.
+func (o Object) String() string {
+	return fmt.Sprintf("%s %s", o.Kind, o.Name)
+}
+
+// Privileges returns every privilege that may be granted on this kind of object.
+func (k ObjectKind) Privileges() Privileges {
+	switch k {
+	case ObjectKind_Database:
+		return NewPrivileges(privilege.CREATE, privilege.CONNECT, privilege.TEMPORARY)
+	case ObjectKind_Schema:
+		return NewPrivileges(privilege.CREATE, privilege.USAGE)
+	case ObjectKind_Table:
+		return NewPrivileges(privilege.SELECT, privilege.INSERT, privilege.UPDATE, privilege.DELETE, privilege.TRUNCATE,
+			privilege.REFERENCES, privilege.TRIGGER)
+	case ObjectKind_Function:
+		return NewPrivileges(privilege.EXECUTE)
+	case ObjectKind_ForeignServer:
+		return NewPrivileges(privilege.USAGE)
+	default:
+		return 0
+	}
+}
+
+// String returns the name of the object kind as it is written in statements.
+func (k ObjectKind) String() string {
+	switch k {
+	case ObjectKind_Database:
+		return "database"
+	case ObjectKind_Schema:
+		return "schema"
+	case ObjectKind_Table:
+		return "table"
+	case ObjectKind_Function:
+		return "function"
+	case ObjectKind_ForeignServer:
+		return "foreign server"
+	default:
+		return "unknown"
+	}
+}
+
+// NewPrivileges returns the set containing the given privileges.
+func NewPrivileges(kinds ...privilege.Kind) Privileges {
+	var privileges Privileges
+	for _, kind := range kinds {
+		privileges |= Privileges(kind.Mask())
+	}
+	return privileges
+}
+
+// Has returns whether the set contains the given privilege.
+func (p Privileges) Has(kind privilege.Kind) bool {
+	return p&Privileges(kind.Mask()) != 0
+}
+
+// Kinds returns the privileges in the set, in the order that Postgres displays them.
+func (p Privileges) Kinds() privilege.List {
+	var kinds privilege.List
+	for _, kind := range privilege.ByValue {
+		if kind != privilege.ALL && p.Has(kind) {
+			kinds = append(kinds, kind)
+		}
+	}
+	return kinds
+}
+
+// PermissionDeniedError returns the error for a role that lacks a privilege on the object.
+func PermissionDeniedError(obj Object) *pgerrors.Error {
+	return pgerrors.Newf(pgcode.InsufficientPrivilege, "permission denied for %s", obj)
+}
+
+// NotOwnerError returns the error for a role that must own the object, but does not.
+func NotOwnerError(obj Object) *pgerrors.Error {
+	return pgerrors.Newf(pgcode.InsufficientPrivilege, "must be owner of %s", obj)
+}
@@ -0,0 +1,193 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// scramIterations is the number of iterations used when salting passwords for SCRAM-SHA-256, which matches the
+// default of Postgres' scram_iterations setting.
+const scramIterations = 4096
+
+// scramPrefix is the prefix of a password that has been encrypted as a SCRAM-SHA-256 verifier.
+const scramPrefix = "SCRAM-SHA-256$"
+
+// mockSecret is used to derive the salt of the mock verifiers that are given to roles without a password, so that the
+// salt is the same for every attempt.
+var mockSecret = func() []byte {
+	secret := make([]byte, 32)
+	_, _ = rand.Read(secret)
+	return secret
+}()
+
+// SCRAMVerifier holds the values that are needed to verify a SCRAM-SHA-256 exchange, without holding the password
+// itself.
+type SCRAMVerifier struct {
+	Iterations int
+	Salt       []byte
+	StoredKey  []byte
+	ServerKey  []byte
+}
+
+// NewSCRAMVerifier returns the verifier for the given password, salt, and iteration count.
+func NewSCRAMVerifier(password string, salt []byte, iterations int) SCRAMVerifier {
+	saltedPassword := pbkdf2.Key([]byte(password), salt, iterations, sha256.Size, sha256.New)
+	storedKey := sha256.Sum256(scramHMAC(saltedPassword, []byte("Client Key")))
+	return SCRAMVerifier{
+		Iterations: iterations,
+		Salt:       salt,
+		StoredKey:  storedKey[:],
+		ServerKey:  scramHMAC(saltedPassword, []byte("Server Key")),
+	}
+}
+
+// MockSCRAMVerifier returns a verifier that no client can satisfy, which is used for roles that do not have a
+// password, so that the client cannot tell whether the role exists.
+func MockSCRAMVerifier(user string) SCRAMVerifier {
+	salt := scramHMAC(mockSecret, []byte(user))[:16]
+	return SCRAMVerifier{
+		Iterations: scramIterations,
+		Salt:       salt,
+		StoredKey:  make([]byte, sha256.Size),
+		ServerKey:  make([]byte, sha256.Size),
+	}
+}
+
+// ParseSCRAMVerifier parses a verifier that uses the same format as Postgres, which is
+// "SCRAM-SHA-256$<iterations>:<salt>$<StoredKey>:<ServerKey>" with each binary value encoded in base64.
+func ParseSCRAMVerifier(encrypted string) (SCRAMVerifier, bool) {
+	if !strings.HasPrefix(encrypted, scramPrefix) {
+		return SCRAMVerifier{}, false
+	}
+	parts := strings.Split(encrypted[len(scramPrefix):], "$")
+	if len(parts) != 2 {
+		return SCRAMVerifier{}, false
+	}
+	iterationsAndSalt := strings.Split(parts[0], ":")
+	keys := strings.Split(parts[1], ":")
+	if len(iterationsAndSalt) != 2 || len(keys) != 2 {
+		return SCRAMVerifier{}, false
+	}
+	iterations, err := strconv.Atoi(iterationsAndSalt[0])
+	if err != nil || iterations <= 0 {
+		return SCRAMVerifier{}, false
+	}
+	salt, err := base64.StdEncoding.DecodeString(iterationsAndSalt[1])
+	if err != nil {
+		return SCRAMVerifier{}, false
+	}
+	storedKey, err := base64.StdEncoding.DecodeString(keys[0])
+	if err != nil || len(storedKey) != sha256.Size {
+		return SCRAMVerifier{}, false
+	}
+	serverKey, err := base64.StdEncoding.DecodeString(keys[1])
+	if err != nil || len(serverKey) != sha256.Size {
+		return SCRAMVerifier{}, false
+	}
+	return SCRAMVerifier{
+		Iterations: iterations,
+		Salt:       salt,
+		StoredKey:  storedKey,
+		ServerKey:  serverKey,
+	}, true
+}
+
+// String returns the verifier in the same format as Postgres.
+func (v SCRAMVerifier) String() string {
+	return fmt.Sprintf("%s%d:%s$%s:%s", scramPrefix, v.Iterations, base64.StdEncoding.EncodeToString(v.Salt),
+		base64.StdEncoding.EncodeToString(v.StoredKey), base64.StdEncoding.EncodeToString(v.ServerKey))
+}
+
+// VerifyProof returns whether the client proof of a SCRAM exchange, computed over the given authentication message,
+// shows that the client knows the password.
+func (v SCRAMVerifier) VerifyProof(authMessage []byte, proof []byte) bool {
+	if len(proof) != sha256.Size {
+		return false
+	}
+	// The proof is the client key XOR'd with the client signature, so XOR'ing it again should recover the client key
+	clientSignature := scramHMAC(v.StoredKey, authMessage)
+	clientKey := make([]byte, len(proof))
+	for i := range proof {
+		clientKey[i] = proof[i] ^ clientSignature[i]
+	}
+	recoveredKey := sha256.Sum256(clientKey)
+	return hmac.Equal(recoveredKey[:], v.StoredKey)
+}
+
+// ServerSignature returns the signature that proves to the client that the server knows the password.
+func (v SCRAMVerifier) ServerSignature(authMessage []byte) []byte {
+	return scramHMAC(v.ServerKey, authMessage)
+}
+
+// IsMD5Password returns whether the encrypted password is an MD5 hash, which is "md5" followed by the hexadecimal
+// encoding of md5(password + user).
+func IsMD5Password(encrypted string) bool {
+	if len(encrypted) != 35 || !strings.HasPrefix(encrypted, "md5") {
+		return false
+	}
+	_, err := hex.DecodeString(encrypted[3:])
+	return err == nil
+}
+
+// EncryptPassword returns the password as a SCRAM-SHA-256 verifier. Passwords that have already been encrypted, either
+// as a verifier or as an MD5 hash, are returned unchanged, as Postgres does.
+func EncryptPassword(password string) (string, error) {
+	if _, ok := ParseSCRAMVerifier(password); ok || IsMD5Password(password) {
+		return password, nil
+	}
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	return NewSCRAMVerifier(password, salt, scramIterations).String(), nil
+}
+
+// VerifyPassword returns whether the cleartext password matches the encrypted password of the given user.
+func VerifyPassword(user string, encrypted string, password string) bool {
+	if IsMD5Password(encrypted) {
+		return subtle.ConstantTimeCompare([]byte(MD5Password(user, password)), []byte(encrypted)) == 1
+	}
+	verifier, ok := ParseSCRAMVerifier(encrypted)
+	if !ok {
+		return false
+	}
+	computed := NewSCRAMVerifier(password, verifier.Salt, verifier.Iterations)
+	return hmac.Equal(computed.StoredKey, verifier.StoredKey) && hmac.Equal(computed.ServerKey, verifier.ServerKey)
+}
+
+// MD5Password returns the MD5 hash of the password for the given user, in the same format as Postgres.
+func MD5Password(user string, password string) string {
+	hash := md5.Sum([]byte(password + user))
+	return "md5" + hex.EncodeToString(hash[:])
+}
+
+// scramHMAC returns the HMAC-SHA-256 of the data using the given key.
+func scramHMAC(key []byte, data []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write(data)
+	return mac.Sum(nil)
+}
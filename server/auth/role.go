@@ -0,0 +1,60 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"strings"
+	"time"
+)
+
+// Role is a role from the role catalog. Roles are shared by every database on the server.
+type Role struct {
+	OID            uint32
+	Name           string
+	IsSuperUser    bool
+	Inherit        bool
+	CanCreateRoles bool
+	CanCreateDB    bool
+	CanLogin       bool
+	IsReplication  bool
+	BypassRLS      bool
+	// ConnectionLimit is the number of concurrent connections that the role may have, with -1 meaning no limit.
+	ConnectionLimit int32
+	// Password is the encrypted password, which is either a SCRAM-SHA-256 verifier or an MD5 hash. This is empty when
+	// the role does not have a password.
+	Password string
+	// ValidUntil is the time after which the password is no longer valid. The zero time means that it never expires.
+	ValidUntil time.Time
+}
+
+// NewRole returns a role with the given name that has the same attributes as a role that was created without any
+// options.
+func NewRole(name string) Role {
+	return Role{
+		Name:            name,
+		Inherit:         true,
+		ConnectionLimit: -1,
+	}
+}
+
+// PasswordValid returns whether the role has a password that has not yet expired.
+func (r Role) PasswordValid(now time.Time) bool {
+	return len(r.Password) > 0 && (r.ValidUntil.IsZero() || now.Before(r.ValidUntil))
+}
+
+// IsReservedRoleName returns whether the name may not be used for a new role.
+func IsReservedRoleName(name string) bool {
+	return name == PublicRole || strings.HasPrefix(name, "pg_")
+}
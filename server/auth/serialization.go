@@ -0,0 +1,244 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// serialize returns the catalog's state as a byte slice.
+func (state *catalogState) serialize() []byte {
+	writer := utils.NewWriter(256)
+	writer.VariableUint(1) // Version
+	writer.Uint32(state.nextOID)
+
+	roleNames := utils.GetMapKeysSorted(state.roles)
+	writer.VariableUint(uint64(len(roleNames)))
+	for _, name := range roleNames {
+		role := state.roles[name]
+		writer.Uint32(role.OID)
+		writer.String(role.Name)
+		writer.Bool(role.IsSuperUser)
+		writer.Bool(role.Inherit)
+		writer.Bool(role.CanCreateRoles)
+		writer.Bool(role.CanCreateDB)
+		writer.Bool(role.CanLogin)
+		writer.Bool(role.IsReplication)
+		writer.Bool(role.BypassRLS)
+		writer.Int32(role.ConnectionLimit)
+		writer.String(role.Password)
+		writer.Bool(!role.ValidUntil.IsZero())
+		if !role.ValidUntil.IsZero() {
+			writer.Int64(role.ValidUntil.UnixMicro())
+		}
+	}
+
+	owned := sortedObjects(state.owners)
+	writer.VariableUint(uint64(len(owned)))
+	for _, obj := range owned {
+		writeObject(writer, obj)
+		writer.String(state.owners[obj])
+	}
+
+	granted := sortedObjects(state.acls)
+	writer.VariableUint(uint64(len(granted)))
+	for _, obj := range granted {
+		writeObject(writer, obj)
+		writeACL(writer, state.acls[obj])
+	}
+
+	writer.VariableUint(uint64(len(state.memberships)))
+	for _, membership := range state.memberships {
+		writer.String(membership.Role)
+		writer.String(membership.Member)
+		writer.String(membership.Grantor)
+		writer.Bool(membership.AdminOption)
+		writer.Bool(membership.InheritOption)
+		writer.Bool(membership.SetOption)
+	}
+
+	defaultKeys := sortedDefaultACLKeys(state.defaultACLs)
+	writer.VariableUint(uint64(len(defaultKeys)))
+	for _, key := range defaultKeys {
+		writer.String(key.Role)
+		writer.String(key.Database)
+		writer.String(key.Schema)
+		writer.Uint8(uint8(key.Kind))
+		writeACL(writer, state.defaultACLs[key])
+	}
+	return writer.Data()
+}
+
+// deserialize returns the catalog's state that was serialized in the byte slice.
+func deserialize(data []byte) (catalogState, error) {
+	state := newCatalogState()
+	reader := utils.NewReader(data)
+	version := reader.VariableUint()
+	if version > 1 {
+		return catalogState{}, fmt.Errorf("version %d of roles is not supported, please upgrade the server", version)
+	}
+	state.nextOID = reader.Uint32()
+
+	numOfRoles := reader.VariableUint()
+	for i := uint64(0); i < numOfRoles; i++ {
+		role := Role{}
+		role.OID = reader.Uint32()
+		role.Name = reader.String()
+		role.IsSuperUser = reader.Bool()
+		role.Inherit = reader.Bool()
+		role.CanCreateRoles = reader.Bool()
+		role.CanCreateDB = reader.Bool()
+		role.CanLogin = reader.Bool()
+		role.IsReplication = reader.Bool()
+		role.BypassRLS = reader.Bool()
+		role.ConnectionLimit = reader.Int32()
+		role.Password = reader.String()
+		if reader.Bool() {
+			role.ValidUntil = time.UnixMicro(reader.Int64()).UTC()
+		}
+		state.roles[role.Name] = role
+	}
+
+	numOfOwners := reader.VariableUint()
+	for i := uint64(0); i < numOfOwners; i++ {
+		obj := readObject(reader)
+		state.owners[obj] = reader.String()
+	}
+
+	numOfACLs := reader.VariableUint()
+	for i := uint64(0); i < numOfACLs; i++ {
+		obj := readObject(reader)
+		state.acls[obj] = readACL(reader)
+	}
+
+	// Memberships and default privileges were added in version 1
+	if version >= 1 {
+		numOfMemberships := reader.VariableUint()
+		state.memberships = make([]Membership, numOfMemberships)
+		for i := range state.memberships {
+			membership := &state.memberships[i]
+			membership.Role = reader.String()
+			membership.Member = reader.String()
+			membership.Grantor = reader.String()
+			membership.AdminOption = reader.Bool()
+			membership.InheritOption = reader.Bool()
+			membership.SetOption = reader.Bool()
+		}
+
+		numOfDefaultACLs := reader.VariableUint()
+		for i := uint64(0); i < numOfDefaultACLs; i++ {
+			key := DefaultACLKey{}
+			key.Role = reader.String()
+			key.Database = reader.String()
+			key.Schema = reader.String()
+			key.Kind = ObjectKind(reader.Uint8())
+			state.defaultACLs[key] = readACL(reader)
+		}
+	}
+	if !reader.IsEmpty() {
+		return catalogState{}, fmt.Errorf("extra data found while deserializing roles")
+	}
+	return state, nil
+}
+
+// writeObject writes the object to the writer.
+func writeObject(writer *utils.Writer, obj Object) {
+	writer.Uint8(uint8(obj.Kind))
+	writer.String(obj.Database)
+	writer.String(obj.Schema)
+	writer.String(obj.Name)
+}
+
+// readObject reads an object that was written by writeObject.
+func readObject(reader *utils.Reader) Object {
+	obj := Object{}
+	obj.Kind = ObjectKind(reader.Uint8())
+	obj.Database = reader.String()
+	obj.Schema = reader.String()
+	obj.Name = reader.String()
+	return obj
+}
+
+// writeACL writes the grants to the writer.
+func writeACL(writer *utils.Writer, acl []Grant) {
+	writer.VariableUint(uint64(len(acl)))
+	for _, grant := range acl {
+		writer.String(grant.Grantee)
+		writer.String(grant.Grantor)
+		writer.Uint32(uint32(grant.Privileges))
+		writer.Uint32(uint32(grant.GrantOptions))
+	}
+}
+
+// readACL reads grants that were written by writeACL.
+func readACL(reader *utils.Reader) []Grant {
+	acl := make([]Grant, reader.VariableUint())
+	for i := range acl {
+		acl[i].Grantee = reader.String()
+		acl[i].Grantor = reader.String()
+		acl[i].Privileges = Privileges(reader.Uint32())
+		acl[i].GrantOptions = Privileges(reader.Uint32())
+	}
+	return acl
+}
+
+// sortedDefaultACLKeys returns the keys of the default privileges in a stable order.
+func sortedDefaultACLKeys(m map[DefaultACLKey][]Grant) []DefaultACLKey {
+	keys := make([]DefaultACLKey, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.Role != b.Role {
+			return a.Role < b.Role
+		}
+		if a.Database != b.Database {
+			return a.Database < b.Database
+		}
+		if a.Schema != b.Schema {
+			return a.Schema < b.Schema
+		}
+		return a.Kind < b.Kind
+	})
+	return keys
+}
+
+// sortedObjects returns the keys of the map in a stable order, so that the same catalog is always serialized the same
+// way.
+func sortedObjects[V any](m map[Object]V) []Object {
+	objects := make([]Object, 0, len(m))
+	for obj := range m {
+		objects = append(objects, obj)
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		a, b := objects[i], objects[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Database != b.Database {
+			return a.Database < b.Database
+		}
+		if a.Schema != b.Schema {
+			return a.Schema < b.Schema
+		}
+		return a.Name < b.Name
+	})
+	return objects
+}
@@ -0,0 +1,407 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+
+	"github.com/dolthub/doltgresql/postgres/connection"
+	"github.com/dolthub/doltgresql/postgres/messages"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+// authenticationMethod is the method that a client must use to authenticate, which is chosen by the first matching
+// hbaRule.
+type authenticationMethod string
+
+const (
+	authenticationMethod_Trust    authenticationMethod = "trust"
+	authenticationMethod_Reject   authenticationMethod = "reject"
+	authenticationMethod_Password authenticationMethod = "password"
+	authenticationMethod_MD5      authenticationMethod = "md5"
+	authenticationMethod_SCRAM    authenticationMethod = "scram-sha-256"
+)
+
+// hbaRule is a parsed client authentication rule from the server's configuration.
+type hbaRule struct {
+	// local matches connections over a Unix socket. When false, network decides which hosts match.
+	local bool
+	// network matches connections from the given addresses. A nil network matches every connection.
+	network *net.IPNet
+	// databases contains the names of the matching databases. A nil slice matches every database.
+	databases []string
+	// users contains the names of the matching users. A nil slice matches every user.
+	users  []string
+	method authenticationMethod
+}
+
+// authenticator decides how each connection must authenticate. Clients are verified against the passwords of the
+// roles in the role catalog.
+type authenticator struct {
+	rules []hbaRule
+}
+
+// newAuthenticator returns an authenticator for the rules in the given config. Returns nil if no rules have been
+// configured, in which case every connection is trusted.
+func newAuthenticator(cfg *servercfg.DoltgresConfig) (*authenticator, error) {
+	hbaConfigs := cfg.HBARules()
+	if len(hbaConfigs) == 0 {
+		return nil, nil
+	}
+	authenticator := &authenticator{
+		rules: make([]hbaRule, len(hbaConfigs)),
+	}
+	for i, hbaConfig := range hbaConfigs {
+		rule, err := parseHBARule(hbaConfig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hba rule %d: %w", i+1, err)
+		}
+		authenticator.rules[i] = rule
+	}
+	return authenticator, nil
+}
+
+// parseHBARule converts the rule from the config into an hbaRule.
+func parseHBARule(hbaConfig servercfg.DoltgresHBAConfig) (hbaRule, error) {
+	var rule hbaRule
+	if hbaConfig.Method == nil {
+		return hbaRule{}, fmt.Errorf("an authentication method must be given")
+	}
+	switch method := authenticationMethod(strings.ToLower(*hbaConfig.Method)); method {
+	case authenticationMethod_Trust, authenticationMethod_Reject, authenticationMethod_Password,
+		authenticationMethod_MD5, authenticationMethod_SCRAM:
+		rule.method = method
+	default:
+		return hbaRule{}, fmt.Errorf(`invalid authentication method "%s"`, *hbaConfig.Method)
+	}
+	if hbaConfig.Host != nil {
+		switch host := strings.TrimSpace(*hbaConfig.Host); strings.ToLower(host) {
+		case "all":
+		case "local":
+			rule.local = true
+		default:
+			if strings.Contains(host, "/") {
+				_, network, err := net.ParseCIDR(host)
+				if err != nil {
+					return hbaRule{}, fmt.Errorf(`invalid CIDR mask in address "%s"`, host)
+				}
+				rule.network = network
+			} else {
+				ip := net.ParseIP(host)
+				if ip == nil {
+					return hbaRule{}, fmt.Errorf(`invalid IP address "%s"`, host)
+				}
+				bits := 8 * net.IPv6len
+				if ip.To4() != nil {
+					ip = ip.To4()
+					bits = 8 * net.IPv4len
+				}
+				rule.network = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+			}
+		}
+	}
+	rule.databases = parseHBANames(hbaConfig.Database)
+	rule.users = parseHBANames(hbaConfig.User)
+	return rule, nil
+}
+
+// parseHBANames parses a comma-separated list of names, returning nil when every name should match.
+func parseHBANames(names *string) []string {
+	if names == nil {
+		return nil
+	}
+	var parsed []string
+	for _, name := range strings.Split(*names, ",") {
+		name = strings.TrimSpace(name)
+		if name == "all" {
+			return nil
+		}
+		if len(name) > 0 {
+			parsed = append(parsed, name)
+		}
+	}
+	return parsed
+}
+
+// matches returns whether the rule applies to a connection with the given properties. A nil address represents a
+// Unix socket connection.
+func (rule hbaRule) matches(address net.IP, database string, user string) bool {
+	if rule.local && address != nil {
+		return false
+	}
+	if rule.network != nil && (address == nil || !rule.network.Contains(address)) {
+		return false
+	}
+	return matchesHBAName(rule.databases, database) && matchesHBAName(rule.users, user)
+}
+
+// matchesHBAName returns whether the name is contained in the names, with a nil slice matching every name.
+func matchesHBAName(names []string, name string) bool {
+	if names == nil {
+		return true
+	}
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// method returns the authentication method of the first rule that matches the connection. Returns false if no rules
+// match.
+func (a *authenticator) method(address net.IP, database string, user string) (authenticationMethod, bool) {
+	for _, rule := range a.rules {
+		if rule.matches(address, database, user) {
+			return rule.method, true
+		}
+	}
+	return "", false
+}
+
+// authenticate runs the authentication exchange that the server's rules require for the given user and database. If
+// the client fails to authenticate, then it is sent an error and this returns an error.
+func (h *ConnectionHandler) authenticate(user string, database string) error {
+	if h.authenticator == nil {
+		return nil
+	}
+	var address net.IP
+	host := "[local]"
+	if h.Conn().RemoteAddr().Network() != "unix" {
+		host, _, _ = net.SplitHostPort(h.Conn().RemoteAddr().String())
+		address = net.ParseIP(host)
+	}
+	encryption := "no encryption"
+	if _, ok := h.Conn().(*tls.Conn); ok {
+		encryption = "SSL encryption"
+	}
+
+	// Revision databases, such as "mydb/feature", use the rules of the database that they belong to
+	baseDatabase, _ := dsess.SplitRevisionDbName(database)
+	method, ok := h.authenticator.method(address, baseDatabase, user)
+	if !ok {
+		return h.sendAuthenticationError("28000", fmt.Errorf(`no pg_hba.conf entry for host "%s", user "%s", database "%s", %s`,
+			host, user, database, encryption))
+	}
+	// Users without a password are still taken through the exchange, so that the client can't tell whether the user exists
+	var password string
+	role, hasPassword := auth.GetRole(user)
+	if hasPassword = hasPassword && role.PasswordValid(time.Now()); hasPassword {
+		password = role.Password
+	}
+	var authenticated bool
+	var err error
+	switch method {
+	case authenticationMethod_Trust:
+		return nil
+	case authenticationMethod_Reject:
+		return h.sendAuthenticationError("28000", fmt.Errorf(`pg_hba.conf rejects connection for host "%s", user "%s", database "%s", %s`,
+			host, user, database, encryption))
+	case authenticationMethod_Password:
+		authenticated, err = h.authenticatePassword(user, password)
+	case authenticationMethod_MD5:
+		// As with Postgres, the MD5 method uses SCRAM-SHA-256 when the password was not stored as an MD5 hash
+		if auth.IsMD5Password(password) {
+			authenticated, err = h.authenticateMD5(password)
+		} else {
+			authenticated, err = h.authenticateSCRAM(user, password)
+		}
+	case authenticationMethod_SCRAM:
+		authenticated, err = h.authenticateSCRAM(user, password)
+	default:
+		return fmt.Errorf("unknown authentication method: %s", method)
+	}
+	if err != nil {
+		return err
+	}
+	if !authenticated || !hasPassword {
+		return h.sendAuthenticationError("28P01", fmt.Errorf(`password authentication failed for user "%s"`, user))
+	}
+	return nil
+}
+
+// authenticatePassword asks the client for its password in cleartext, returning whether it matches the given encrypted
+// password.
+func (h *ConnectionHandler) authenticatePassword(user string, password string) (bool, error) {
+	if err := connection.Send(h.Conn(), messages.AuthenticationCleartextPassword{}); err != nil {
+		return false, err
+	}
+	response, err := connection.ReceiveInto(h.Conn(), messages.PasswordMessage{})
+	if err != nil {
+		return false, err
+	}
+	return auth.VerifyPassword(user, password, response.Password), nil
+}
+
+// authenticateMD5 asks the client for its password, hashed with MD5 using the user name and a random salt, returning
+// whether it matches the given MD5 hash of the password.
+func (h *ConnectionHandler) authenticateMD5(password string) (bool, error) {
+	salt := make([]byte, 4)
+	if _, err := rand.Read(salt); err != nil {
+		return false, err
+	}
+	if err := connection.Send(h.Conn(), messages.AuthenticationMD5Password{
+		Salt: int32(binary.BigEndian.Uint32(salt)),
+	}); err != nil {
+		return false, err
+	}
+	response, err := connection.ReceiveInto(h.Conn(), messages.PasswordMessage{})
+	if err != nil {
+		return false, err
+	}
+	// The client sends "md5" followed by md5(md5(password + user) + salt), with each hash written in hexadecimal. The
+	// stored hash is "md5" followed by the inner hash.
+	outer := md5.Sum(append([]byte(password[3:]), salt...))
+	expected := "md5" + hex.EncodeToString(outer[:])
+	return subtle.ConstantTimeCompare([]byte(response.Password), []byte(expected)) == 1, nil
+}
+
+// authenticateSCRAM runs a SCRAM-SHA-256 exchange with the client, as described in RFC 5802 and RFC 7677, returning
+// whether the client proved that it knows the password of the given SCRAM-SHA-256 verifier. Channel binding is not
+// supported.
+func (h *ConnectionHandler) authenticateSCRAM(user string, password string) (bool, error) {
+	verifier, ok := auth.ParseSCRAMVerifier(password)
+	if !ok {
+		verifier = auth.MockSCRAMVerifier(user)
+	}
+	if err := connection.Send(h.Conn(), messages.AuthenticationSASL{
+		Mechanisms: []string{"SCRAM-SHA-256"},
+	}); err != nil {
+		return false, err
+	}
+	initialResponse, err := connection.ReceiveInto(h.Conn(), messages.SASLInitialResponse{})
+	if err != nil {
+		return false, err
+	}
+	if initialResponse.Name != "SCRAM-SHA-256" {
+		return false, h.sendAuthenticationError("08P01", fmt.Errorf("client selected an invalid SASL authentication mechanism"))
+	}
+
+	// The client's first message is a GS2 header followed by the user name (which is ignored, as the user was given in
+	// the startup message) and the client's nonce, such as "n,,n=user,r=nonce".
+	clientFirst := strings.SplitN(string(initialResponse.Response), ",", 3)
+	if len(clientFirst) != 3 {
+		return false, h.sendAuthenticationError("08P01", fmt.Errorf("malformed SCRAM message"))
+	}
+	if strings.HasPrefix(clientFirst[0], "p=") {
+		return false, h.sendAuthenticationError("08P01", fmt.Errorf("channel binding is not supported"))
+	}
+	gs2Header := clientFirst[0] + "," + clientFirst[1] + ","
+	clientFirstBare := clientFirst[2]
+	clientNonce, ok := scramAttribute(clientFirstBare, 'r')
+	if !ok || len(clientNonce) == 0 {
+		return false, h.sendAuthenticationError("08P01", fmt.Errorf("malformed SCRAM message"))
+	}
+
+	randomBytes := make([]byte, 18)
+	if _, err = rand.Read(randomBytes); err != nil {
+		return false, err
+	}
+	nonce := clientNonce + base64.StdEncoding.EncodeToString(randomBytes)
+	serverFirst := fmt.Sprintf("r=%s,s=%s,i=%d", nonce, base64.StdEncoding.EncodeToString(verifier.Salt), verifier.Iterations)
+	if err = connection.Send(h.Conn(), messages.AuthenticationSASLContinue{
+		Data: []byte(serverFirst),
+	}); err != nil {
+		return false, err
+	}
+
+	// The client's final message contains the channel binding, the combined nonce, and the proof, such as
+	// "c=biws,r=nonce,p=proof".
+	response, err := connection.ReceiveInto(h.Conn(), messages.SASLResponse{})
+	if err != nil {
+		return false, err
+	}
+	clientFinal := string(response.Data)
+	proofStart := strings.LastIndex(clientFinal, ",p=")
+	if proofStart == -1 {
+		return false, h.sendAuthenticationError("08P01", fmt.Errorf("malformed SCRAM message"))
+	}
+	clientFinalWithoutProof := clientFinal[:proofStart]
+	channelBinding, _ := scramAttribute(clientFinalWithoutProof, 'c')
+	finalNonce, _ := scramAttribute(clientFinalWithoutProof, 'r')
+	proof, err := base64.StdEncoding.DecodeString(clientFinal[proofStart+3:])
+	if err != nil || len(proof) != sha256.Size {
+		return false, h.sendAuthenticationError("08P01", fmt.Errorf("malformed SCRAM message"))
+	}
+	if channelBinding != base64.StdEncoding.EncodeToString([]byte(gs2Header)) {
+		return false, h.sendAuthenticationError("08P01", fmt.Errorf("SCRAM channel binding check failed"))
+	}
+	if finalNonce != nonce {
+		return false, h.sendAuthenticationError("08P01", fmt.Errorf("SCRAM nonce mismatch"))
+	}
+
+	authMessage := []byte(clientFirstBare + "," + serverFirst + "," + clientFinalWithoutProof)
+	if !verifier.VerifyProof(authMessage, proof) {
+		return false, nil
+	}
+
+	serverSignature := verifier.ServerSignature(authMessage)
+	if err = connection.Send(h.Conn(), messages.AuthenticationSASLFinal{
+		AdditionalData: []byte("v=" + base64.StdEncoding.EncodeToString(serverSignature)),
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// authorizeLogin returns an error, after sending it to the client, if the given role does not exist or may not log in.
+func (h *ConnectionHandler) authorizeLogin(user string) error {
+	role, ok := auth.GetRole(user)
+	if !ok {
+		return h.sendAuthenticationError("28000", fmt.Errorf(`role "%s" does not exist`, user))
+	}
+	if !role.CanLogin {
+		return h.sendAuthenticationError("28000", fmt.Errorf(`role "%s" is not permitted to log in`, user))
+	}
+	return nil
+}
+
+// scramAttribute returns the value of the attribute with the given name from a SCRAM message.
+func scramAttribute(message string, name byte) (string, bool) {
+	for _, attribute := range strings.Split(message, ",") {
+		if len(attribute) >= 2 && attribute[0] == name && attribute[1] == '=' {
+			return attribute[2:], true
+		}
+	}
+	return "", false
+}
+
+// sendAuthenticationError sends the client a fatal error with the given SQLSTATE code, returning the error so that the
+// connection may be closed.
+func (h *ConnectionHandler) sendAuthenticationError(sqlStateCode string, err error) error {
+	_ = connection.Send(h.Conn(), messages.ErrorResponse{
+		Severity:     messages.ErrorResponseSeverity_Fatal,
+		SqlStateCode: sqlStateCode,
+		Message:      err.Error(),
+		Optional: messages.ErrorResponseOptionalFields{
+			Routine: "ClientAuthentication",
+		},
+	})
+	return err
+}
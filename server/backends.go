@@ -0,0 +1,60 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"time"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+
+	"github.com/dolthub/doltgresql/postgres/connection"
+	"github.com/dolthub/doltgresql/postgres/messages"
+	"github.com/dolthub/doltgresql/server/backends"
+)
+
+// terminationMessage is sent to the client when its session is terminated by another session.
+const terminationMessage = "terminating connection due to administrator command"
+
+// registerBackend records that the connection is using the given database, so that other sessions may find it, such as
+// a session that drops the database.
+func (h *ConnectionHandler) registerBackend(database string) {
+	// Sessions that are connected to a revision, such as "mydb/feature", are connected to the database that it belongs to
+	database, _ = dsess.SplitRevisionDbName(database)
+	backends.Register(h.mysqlConn.ConnectionID, h.mysqlConn.User, database, h.terminate)
+}
+
+// terminate ends the session on behalf of another session. Any running query is canceled, and the session then closes
+// once it next waits on the client. This may be called from any goroutine.
+func (h *ConnectionHandler) terminate() {
+	h.terminated.Store(true)
+	h.cancelQuery(terminationMessage)
+	// Expiring the read deadline ends the pending receive, which is where the session notices the termination
+	_ = h.Conn().SetReadDeadline(time.Now())
+}
+
+// sendTermination tells the client that its session has been terminated by another session.
+func (h *ConnectionHandler) sendTermination() {
+	// Notifications may be sent at the same time, so we hold their lock to avoid interleaved messages
+	h.notificationMutex.Lock()
+	defer h.notificationMutex.Unlock()
+	_ = connection.Send(h.Conn(), messages.ErrorResponse{
+		Severity:     messages.ErrorResponseSeverity_Fatal,
+		SqlStateCode: "57P01",
+		Message:      terminationMessage,
+		Optional: messages.ErrorResponseOptionalFields{
+			Routine: "ProcessInterrupts",
+		},
+	})
+}
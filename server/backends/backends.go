@@ -0,0 +1,122 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backends
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Backend is a client session that is connected to a database.
+type Backend struct {
+	ProcessID uint32
+	User      string
+	Database  string
+}
+
+// registeredBackend is a backend along with the means to terminate it.
+type registeredBackend struct {
+	Backend
+	terminate func()
+	// exited is closed once the backend has been unregistered.
+	exited chan struct{}
+}
+
+// registry contains every session that is connected to a database, keyed by its process ID.
+var registry = struct {
+	sync.Mutex
+	backends map[uint32]*registeredBackend
+}{backends: make(map[uint32]*registeredBackend)}
+
+// Register records that the session is connected to the database. The given function is called when another session
+// terminates this one, and the session must call Unregister once it has fully closed.
+func Register(processID uint32, user string, database string, terminate func()) {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.backends[processID] = &registeredBackend{
+		Backend:   Backend{ProcessID: processID, User: user, Database: database},
+		terminate: terminate,
+		exited:    make(chan struct{}),
+	}
+}
+
+// Unregister removes the session from the registry, which wakes any session that is waiting on its termination.
+func Unregister(processID uint32) {
+	registry.Lock()
+	defer registry.Unlock()
+	if backend, ok := registry.backends[processID]; ok {
+		close(backend.exited)
+		delete(registry.backends, processID)
+	}
+}
+
+// ConnectedTo returns every session, other than the given session, that is connected to the database.
+func ConnectedTo(database string, except uint32) []Backend {
+	registry.Lock()
+	defer registry.Unlock()
+	var backends []Backend
+	for _, backend := range registry.backends {
+		if backend.ProcessID != except && strings.EqualFold(backend.Database, database) {
+			backends = append(backends, backend.Backend)
+		}
+	}
+	return backends
+}
+
+// AwaitDisconnect waits for every session, other than the given session, to disconnect from the database, as a session
+// that has just been closed by its client may not yet have been unregistered. Returns the sessions that are still
+// connected once the timeout expires.
+func AwaitDisconnect(ctx context.Context, database string, except uint32, timeout time.Duration) []Backend {
+	deadline := time.Now().Add(timeout)
+	for {
+		backends := ConnectedTo(database, except)
+		if len(backends) == 0 || time.Now().After(deadline) {
+			return backends
+		}
+		select {
+		case <-time.After(100 * time.Millisecond):
+		case <-ctx.Done():
+			return backends
+		}
+	}
+}
+
+// Terminate terminates the given sessions, and waits until they have closed. Returns false if any of the sessions are
+// still open once the timeout expires.
+func Terminate(ctx context.Context, processIDs []uint32, timeout time.Duration) bool {
+	registry.Lock()
+	var exited []chan struct{}
+	for _, processID := range processIDs {
+		if backend, ok := registry.backends[processID]; ok {
+			backend.terminate()
+			exited = append(exited, backend.exited)
+		}
+	}
+	registry.Unlock()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for _, ch := range exited {
+		select {
+		case <-ch:
+		case <-timer.C:
+			return false
+		case <-ctx.Done():
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dolthub/dolt/go/libraries/utils/svcs"
+
+	"github.com/dolthub/doltgresql/server/backup"
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+// configureBackups sets the location that databases are backed up to, returning a service that takes scheduled backups
+// when an interval has been configured. The service must be registered after the services of the SQL server, so that
+// backups have stopped before the databases close.
+func configureBackups(cfg *servercfg.DoltgresBackupConfig) (*svcs.AnonService, error) {
+	var config backup.Config
+	if cfg != nil {
+		if cfg.Destination != nil {
+			config.Destination = *cfg.Destination
+		}
+		if cfg.Interval != nil {
+			interval, err := time.ParseDuration(*cfg.Interval)
+			if err != nil || interval <= 0 {
+				return nil, fmt.Errorf(`invalid backup interval "%s"`, *cfg.Interval)
+			}
+			config.Interval = interval
+		}
+		config.Databases = cfg.Databases
+	}
+	if err := backup.SetConfig(config); err != nil {
+		return nil, err
+	}
+	if config.Interval == 0 {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	started, stopped := make(chan struct{}), make(chan struct{})
+	return &svcs.AnonService{
+		RunF: func(context.Context) {
+			close(started)
+			defer close(stopped)
+			backup.Run(ctx, backupDatabases)
+		},
+		StopF: func() error {
+			cancel()
+			// The service may be stopped without having run, such as when another service fails to start
+			select {
+			case <-started:
+				<-stopped
+			default:
+			}
+			return nil
+		},
+	}, nil
+}
+
+// backupDatabases returns every database of the running server.
+func backupDatabases() []backup.Database {
+	provider := runningProvider()
+	if provider == nil {
+		return nil
+	}
+	var databases []backup.Database
+	for _, db := range provider.DoltDatabases() {
+		databases = append(databases, backup.Database{Name: db.Name(), Data: db.DbData()})
+	}
+	return databases
+}
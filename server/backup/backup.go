@@ -0,0 +1,207 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/dbfactory"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
+	"github.com/dolthub/dolt/go/store/datas/pull"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// DatabasePlaceholder is replaced in a destination by the name of the database that is being backed up.
+const DatabasePlaceholder = "{database}"
+
+// Config is the configuration of backups.
+type Config struct {
+	// Destination is the template of the location that each database is backed up to, which is either a local directory
+	// or a URL such as "aws://[table:bucket]/backups/{database}". DatabasePlaceholder is replaced by the name of the
+	// database. When the placeholder is omitted, each database is backed up to a directory named after the database.
+	Destination string
+	// Interval is the time between scheduled backups. Backups are only taken on request when this is zero.
+	Interval time.Duration
+	// Databases are glob patterns of the databases that scheduled backups include. All databases are included when
+	// empty.
+	Databases []string
+}
+
+// Database is a database that may be backed up.
+type Database struct {
+	Name string
+	Data env.DbData
+}
+
+// config is the configuration of the running server's backups.
+var config struct {
+	sync.RWMutex
+	Config
+}
+
+// SetConfig sets the configuration of the running server's backups. An empty configuration disables backups to a
+// configured destination.
+func SetConfig(newConfig Config) error {
+	if len(newConfig.Destination) == 0 && (newConfig.Interval != 0 || len(newConfig.Databases) > 0) {
+		return fmt.Errorf("a backup destination must be given")
+	}
+	if newConfig.Interval < 0 {
+		return fmt.Errorf("the backup interval cannot be negative")
+	}
+	for _, pattern := range newConfig.Databases {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf(`invalid backup database pattern "%s"`, pattern)
+		}
+	}
+	if len(newConfig.Destination) > 0 {
+		if _, err := destinationURL(newConfig.Destination, "database"); err != nil {
+			return err
+		}
+	}
+	config.Lock()
+	defer config.Unlock()
+	config.Config = newConfig
+	return nil
+}
+
+// DestinationURL returns the URL that the given database is backed up to. Returns false if no destination has been
+// configured.
+func DestinationURL(database string) (string, bool, error) {
+	config.RLock()
+	destination := config.Destination
+	config.RUnlock()
+	if len(destination) == 0 {
+		return "", false, nil
+	}
+	destinationUrl, err := destinationURL(destination, database)
+	return destinationUrl, true, err
+}
+
+// destinationURL returns the URL that the database is backed up to, given the destination's template. Local
+// directories are converted to "file" URLs.
+func destinationURL(destination string, database string) (string, error) {
+	if strings.Contains(destination, DatabasePlaceholder) {
+		destination = strings.ReplaceAll(destination, DatabasePlaceholder, database)
+	} else {
+		destination = strings.TrimRight(destination, "/") + "/" + database
+	}
+	if strings.Contains(destination, "://") {
+		if _, err := url.Parse(destination); err != nil {
+			return "", fmt.Errorf(`invalid backup destination "%s": %w`, destination, err)
+		}
+		return destination, nil
+	}
+	absPath, err := filepath.Abs(destination)
+	if err != nil {
+		return "", fmt.Errorf(`invalid backup destination "%s": %w`, destination, err)
+	}
+	return dbfactory.FileScheme + "://" + filepath.ToSlash(absPath), nil
+}
+
+// Sync backs up every commit, branch, tag, and working set of the database to its configured destination, returning
+// the URL that it was backed up to. Only the chunks that the destination is missing are written.
+func Sync(ctx context.Context, db Database) (string, error) {
+	destinationUrl, ok, err := DestinationURL(db.Name)
+	if err != nil {
+		return "", err
+	} else if !ok {
+		return "", fmt.Errorf("no backup destination has been configured")
+	}
+	remote := env.NewRemote("__backup__", destinationUrl, nil)
+	format := db.Data.Ddb.Format()
+	if strings.HasPrefix(destinationUrl, dbfactory.FileScheme+"://") {
+		// Local directories are created if they do not yet exist
+		if err = remote.Prepare(ctx, format, nil); err != nil {
+			return "", fmt.Errorf("error preparing backup destination: %w", err)
+		}
+	}
+	destination, err := remote.GetRemoteDB(ctx, format, nil)
+	if err != nil {
+		return "", fmt.Errorf("error loading backup destination: %w", err)
+	}
+	tempDir, err := db.Data.Rsw.TempTableFilesDir()
+	if err != nil {
+		return "", err
+	}
+	err = actions.SyncRoots(ctx, db.Data.Ddb, destination, tempDir, startProgress, stopProgress)
+	if err != nil && !errors.Is(err, pull.ErrDBUpToDate) {
+		return "", fmt.Errorf("error syncing backup: %w", err)
+	}
+	return destinationUrl, nil
+}
+
+// Run backs up the databases returned by the given function on every configured interval, until the context is
+// canceled. A failure does not prevent the remaining databases from being backed up.
+func Run(ctx context.Context, databases func() []Database) {
+	config.RLock()
+	interval, patterns := config.Interval, config.Databases
+	config.RUnlock()
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		for _, db := range databases() {
+			if !utils.MatchesAnyGlob(patterns, db.Name) {
+				continue
+			}
+			destinationUrl, err := Sync(ctx, db)
+			if err != nil {
+				if ctx.Err() == nil {
+					logrus.WithField("database", db.Name).Errorf("scheduled backup failed: %v", err)
+				}
+				continue
+			}
+			logrus.WithField("database", db.Name).Infof("Backed up to %s", destinationUrl)
+		}
+	}
+}
+
+// startProgress discards the progress of a sync, as there is nowhere to report it.
+func startProgress(ctx context.Context) (*sync.WaitGroup, chan pull.Stats) {
+	statsCh := make(chan pull.Stats)
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for range statsCh {
+		}
+	}()
+	return wg, statsCh
+}
+
+// stopProgress stops the progress that was started by startProgress.
+func stopProgress(cancel context.CancelFunc, wg *sync.WaitGroup, statsCh chan pull.Stats) {
+	cancel()
+	close(statsCh)
+	wg.Wait()
+}
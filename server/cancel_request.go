@@ -0,0 +1,81 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"strconv"
+	"sync"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/sqltypes"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/messages"
+)
+
+// cancelableConnections contains every connection that may be the target of a CancelRequest, keyed by the process ID
+// that was sent to the client in BackendKeyData.
+var cancelableConnections = struct {
+	sync.Mutex
+	handlers map[int32]*ConnectionHandler
+}{handlers: make(map[int32]*ConnectionHandler)}
+
+// registerCancelKey generates the secret key that a client must present to cancel this connection's queries, and
+// registers the connection so that it may be found by a CancelRequest. Returns the process ID and secret key that are
+// sent to the client.
+func (h *ConnectionHandler) registerCancelKey() (processID int32, secretKey int32, err error) {
+	var secret [4]byte
+	if _, err = rand.Read(secret[:]); err != nil {
+		return 0, 0, err
+	}
+	processID = int32(h.mysqlConn.ConnectionID)
+	h.cancelSecretKey = int32(binary.BigEndian.Uint32(secret[:]))
+	cancelableConnections.Lock()
+	defer cancelableConnections.Unlock()
+	cancelableConnections.handlers[processID] = h
+	return processID, h.cancelSecretKey, nil
+}
+
+// unregisterCancelKey removes the connection from the set of connections that may be canceled.
+func (h *ConnectionHandler) unregisterCancelKey() {
+	processID := int32(h.mysqlConn.ConnectionID)
+	cancelableConnections.Lock()
+	defer cancelableConnections.Unlock()
+	if cancelableConnections.handlers[processID] == h {
+		delete(cancelableConnections.handlers, processID)
+	}
+}
+
+// handleCancelRequest cancels the query that is running on the connection named by the request. The request arrives on
+// its own connection, and Postgres never responds to it, even when the process ID or secret key are wrong, so the only
+// effect is on the target connection.
+func (h *ConnectionHandler) handleCancelRequest(request messages.CancelRequest) {
+	cancelableConnections.Lock()
+	target, ok := cancelableConnections.handlers[request.ProcessID]
+	cancelableConnections.Unlock()
+	if !ok || target.cancelSecretKey != request.SecretKey {
+		return
+	}
+	cancelMessage := "canceling statement due to user request"
+	target.queryCanceled.Store(&cancelMessage)
+	// The target's query runs within GMS, which only allows queries to be interrupted through its process list
+	kill := &vitess.Kill{ConnID: vitess.NewIntVal([]byte(strconv.FormatInt(int64(request.ProcessID), 10)))}
+	_ = h.handler.(mysql.ExtendedHandler).ComParsedQuery(h.mysqlConn, "KILL QUERY "+strconv.FormatInt(int64(request.ProcessID), 10), kill,
+		func(*sqltypes.Result, bool) error {
+			return nil
+		})
+}
@@ -14,7 +14,8 @@
 
 package cast
 
-// Init initializes all casts in this package.
+// Init initializes all casts in this package. Casts between array types are not registered here, as they're derived
+// from the casts between their element types, which are applied to each element.
 func Init() {
 	initBool()
 	initChar()
@@ -23,11 +24,15 @@ func Init() {
 	initInt16()
 	initInt32()
 	initInt64()
+	initInterval()
 	initJson()
 	initJsonB()
 	initName()
 	initNumeric()
 	initOid()
+	initRegclass()
+	initRegnamespace()
+	initRegtype()
 	initText()
 	initVarChar()
 }
@@ -0,0 +1,46 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cast
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initInterval handles all casts that are built-in. This comprises only the "From" types.
+func initInterval() {
+	intervalAssignment()
+}
+
+// intervalAssignment registers all assignment casts. This comprises only the "From" types.
+func intervalAssignment() {
+	// The I/O conversion that is used for other types always uses the "postgres" style, so the string types are
+	// registered here so that the session's IntervalStyle is honored.
+	for _, toType := range []pgtypes.DoltgresType{pgtypes.BpChar, pgtypes.Name, pgtypes.Text, pgtypes.VarChar} {
+		framework.MustAddAssignmentTypeCast(framework.TypeCast{
+			FromType: pgtypes.Interval,
+			ToType:   toType,
+			Function: func(ctx *sql.Context, val any, targetType pgtypes.DoltgresType) (any, error) {
+				str, err := pgtypes.Interval.SessionOutput(ctx, val)
+				if err != nil {
+					return nil, err
+				}
+				return targetType.IoInput(str)
+			},
+		})
+	}
+}
@@ -36,7 +36,7 @@ func numericAssignment() {
 		FromType: pgtypes.Numeric,
 		ToType:   pgtypes.Int16,
 		Function: func(ctx *sql.Context, val any, targetType pgtypes.DoltgresType) (any, error) {
-			d := val.(decimal.Decimal)
+			d := val.(decimal.Decimal).Round(0)
 			if d.LessThan(pgtypes.NumericValueMinInt16) || d.GreaterThan(pgtypes.NumericValueMaxInt16) {
 				return nil, fmt.Errorf("smallint out of range")
 			}
@@ -47,7 +47,7 @@ func numericAssignment() {
 		FromType: pgtypes.Numeric,
 		ToType:   pgtypes.Int32,
 		Function: func(ctx *sql.Context, val any, targetType pgtypes.DoltgresType) (any, error) {
-			d := val.(decimal.Decimal)
+			d := val.(decimal.Decimal).Round(0)
 			if d.LessThan(pgtypes.NumericValueMinInt32) || d.GreaterThan(pgtypes.NumericValueMaxInt32) {
 				return nil, fmt.Errorf("integer out of range")
 			}
@@ -58,7 +58,7 @@ func numericAssignment() {
 		FromType: pgtypes.Numeric,
 		ToType:   pgtypes.Int64,
 		Function: func(ctx *sql.Context, val any, targetType pgtypes.DoltgresType) (any, error) {
-			d := val.(decimal.Decimal)
+			d := val.(decimal.Decimal).Round(0)
 			if d.LessThan(pgtypes.NumericValueMinInt64) || d.GreaterThan(pgtypes.NumericValueMaxInt64) {
 				return nil, fmt.Errorf("bigint out of range")
 			}
@@ -89,8 +89,7 @@ func numericImplicit() {
 		FromType: pgtypes.Numeric,
 		ToType:   pgtypes.Numeric,
 		Function: func(ctx *sql.Context, val any, targetType pgtypes.DoltgresType) (any, error) {
-			// TODO: handle precision and scale
-			return val, nil
+			return handleNumericCast(val.(decimal.Decimal), targetType)
 		},
 	})
 }
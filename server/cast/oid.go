@@ -24,6 +24,7 @@ import (
 // initOid handles all casts that are built-in. This comprises only the "From" types.
 func initOid() {
 	oidAssignment()
+	oidImplicit()
 }
 
 // oidAssignment registers all assignment casts. This comprises only the "From" types.
@@ -44,3 +45,28 @@ func oidAssignment() {
 		},
 	})
 }
+
+// oidImplicit registers all implicit casts. This comprises only the "From" types.
+func oidImplicit() {
+	framework.MustAddImplicitTypeCast(framework.TypeCast{
+		FromType: pgtypes.Oid,
+		ToType:   pgtypes.Regclass,
+		Function: func(ctx *sql.Context, val any, targetType pgtypes.DoltgresType) (any, error) {
+			return val.(uint32), nil
+		},
+	})
+	framework.MustAddImplicitTypeCast(framework.TypeCast{
+		FromType: pgtypes.Oid,
+		ToType:   pgtypes.Regnamespace,
+		Function: func(ctx *sql.Context, val any, targetType pgtypes.DoltgresType) (any, error) {
+			return val.(uint32), nil
+		},
+	})
+	framework.MustAddImplicitTypeCast(framework.TypeCast{
+		FromType: pgtypes.Oid,
+		ToType:   pgtypes.Regtype,
+		Function: func(ctx *sql.Context, val any, targetType pgtypes.DoltgresType) (any, error) {
+			return val.(uint32), nil
+		},
+	})
+}
@@ -23,10 +23,36 @@ import (
 
 // initText handles all casts that are built-in. This comprises only the "From" types.
 func initText() {
+	textExplicit()
 	textAssignment()
 	textImplicit()
 }
 
+// textExplicit registers all explicit casts. This comprises only the "From" types.
+func textExplicit() {
+	framework.MustAddExplicitTypeCast(framework.TypeCast{
+		FromType: pgtypes.Text,
+		ToType:   pgtypes.Regclass,
+		Function: func(ctx *sql.Context, val any, targetType pgtypes.DoltgresType) (any, error) {
+			return pgtypes.RegNameInput(ctx, targetType.BaseID(), val.(string))
+		},
+	})
+	framework.MustAddExplicitTypeCast(framework.TypeCast{
+		FromType: pgtypes.Text,
+		ToType:   pgtypes.Regnamespace,
+		Function: func(ctx *sql.Context, val any, targetType pgtypes.DoltgresType) (any, error) {
+			return pgtypes.RegNameInput(ctx, targetType.BaseID(), val.(string))
+		},
+	})
+	framework.MustAddExplicitTypeCast(framework.TypeCast{
+		FromType: pgtypes.Text,
+		ToType:   pgtypes.Regtype,
+		Function: func(ctx *sql.Context, val any, targetType pgtypes.DoltgresType) (any, error) {
+			return pgtypes.RegNameInput(ctx, targetType.BaseID(), val.(string))
+		},
+	})
+}
+
 // textAssignment registers all assignment casts. This comprises only the "From" types.
 func textAssignment() {
 	framework.MustAddAssignmentTypeCast(framework.TypeCast{
@@ -19,6 +19,7 @@ import (
 	"strings"
 	"unicode/utf8"
 
+	"github.com/shopspring/decimal"
 	"gopkg.in/src-d/go-errors.v1"
 
 	pgtypes "github.com/dolthub/doltgresql/server/types"
@@ -63,6 +64,21 @@ func handleStringCast(str string, targetType pgtypes.DoltgresType) (string, erro
 	}
 }
 
+// handleNumericCast handles casts to a numeric type that may have a precision and scale. The value is rounded to the
+// scale, and an error is returned if the digits before the decimal point do not fit within the precision. Numeric types
+// without a precision return the value unchanged.
+func handleNumericCast(val decimal.Decimal, targetType pgtypes.DoltgresType) (decimal.Decimal, error) {
+	numericType, ok := targetType.(pgtypes.NumericType)
+	if !ok || numericType.Precision <= 0 {
+		return val, nil
+	}
+	val = val.Round(numericType.Scale)
+	if val.Abs().Cmp(decimal.New(1, numericType.Precision-numericType.Scale)) >= 0 {
+		return val, fmt.Errorf("numeric field overflow")
+	}
+	return val, nil
+}
+
 // truncateString returns a string that has been truncated to the given length. Uses the rune count rather than the
 // byte count. Returns the input string if it's smaller than the length. Also returns the rune count of the string.
 func truncateString(val string, runeLimit uint32) (string, uint32) {
@@ -0,0 +1,45 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/doltgresql/postgres/connection"
+	"github.com/dolthub/doltgresql/server/notices"
+)
+
+// loadClientMinMessages reads the session's client_min_messages parameter, which filters the notices that the handler
+// sends itself. This is called on startup and whenever a statement may have changed the parameter.
+func (h *ConnectionHandler) loadClientMinMessages() {
+	value, err := h.showParameter("client_min_messages")
+	if err != nil {
+		logrus.WithError(err).Warn("unable to read parameter client_min_messages")
+		value = "notice"
+	}
+	h.clientMinMessages = value
+}
+
+// sendNotice sends the notice to the client, unless it is below the session's client_min_messages. Notices raised
+// during execution are filtered by notices.Raise instead.
+func (h *ConnectionHandler) sendNotice(notice notices.Notice) error {
+	if !notices.IsSent(h.clientMinMessages, notice.Severity) {
+		return nil
+	}
+	if len(notice.SqlStateCode) == 0 {
+		notice.SqlStateCode = "00000"
+	}
+	return connection.Send(h.Conn(), notice.ToMessage())
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// configuredDefaults holds the prior state of each parameter whose default was set by SetParameterDefaults, so that
+// the defaults are restored if the server is started again within the same process.
+var configuredDefaults = make(map[*Parameter]Parameter)
+
+// SetParameterDefaults sets the defaults of the given parameters, keyed by name, which are read from the server's
+// config file. Values are given as they would be given to SET, so "5s" is a valid default of statement_timeout.
+// Sessions may still override any default using SET, and RESET returns to the default. This must be called before any
+// sessions are created.
+func SetParameterDefaults(defaults map[string]string) error {
+	for param, prior := range configuredDefaults {
+		param.Default = prior.Default
+		param.ResetVal = prior.ResetVal
+		param.Source = prior.Source
+		sql.SystemVariables.AddSystemVariables([]sql.SystemVariable{param})
+	}
+	clear(configuredDefaults)
+	names := make([]string, 0, len(defaults))
+	for name := range defaults {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value := defaults[name]
+		if IsCustomParameterName(name) {
+			AddCustomParameter(name)
+		}
+		sysVar, _, ok := sql.SystemVariables.GetGlobal(strings.ToLower(name))
+		param, isParam := sysVar.(*Parameter)
+		if !ok || !isParam {
+			return fmt.Errorf(`unrecognized configuration parameter "%s"`, name)
+		}
+		if param.Context == ParameterContextInternal {
+			return fmt.Errorf(`parameter "%s" cannot be changed`, name)
+		}
+		parsedValue, err := param.parseValue(value)
+		if err != nil {
+			return err
+		}
+		svv, err := param.InitValue(parsedValue, false)
+		if err != nil {
+			return fmt.Errorf(`invalid value for parameter "%s": "%s"`, name, value)
+		}
+		if _, ok = configuredDefaults[param]; !ok {
+			configuredDefaults[param] = *param
+		}
+		param.Default = svv.Val
+		param.ResetVal = svv.Val
+		param.Source = ParameterSourceConfigurationFile
+		sql.SystemVariables.AddSystemVariables([]sql.SystemVariable{param})
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// SetStatementLogging sets the defaults of the log_statement and log_min_duration_statement parameters, the latter of
+// which is in milliseconds. Sessions may still override either parameter using SET. This must be called before any
+// sessions are created.
+func SetStatementLogging(logStatement string, logMinDurationStatement int64) error {
+	switch logStatement {
+	case "none", "ddl", "mod", "all":
+	default:
+		return fmt.Errorf(`invalid value for parameter "log_statement": "%s"`, logStatement)
+	}
+	if logMinDurationStatement < -1 || logMinDurationStatement > math.MaxInt32 {
+		return fmt.Errorf("%d ms is outside the valid range for parameter \"log_min_duration_statement\" (-1 .. %d)",
+			logMinDurationStatement, math.MaxInt32)
+	}
+	logStatementParam := postgresConfigParameters["log_statement"].(*Parameter)
+	logStatementParam.Default = logStatement
+	logStatementParam.ResetVal = logStatement
+	durationParam := postgresConfigParameters["log_min_duration_statement"].(*Parameter)
+	durationParam.Default = logMinDurationStatement
+	durationParam.ResetVal = logMinDurationStatement
+	sql.SystemVariables.AddSystemVariables([]sql.SystemVariable{logStatementParam, durationParam})
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"math"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// defaultMaxConnections is the default value of the max_connections parameter.
+const defaultMaxConnections = 100
+
+// SetMaxConnections sets the value that is reported by the max_connections parameter, which is the limit that the
+// server enforces on concurrent connections. A limit of zero means that connections are not limited, which Postgres
+// cannot represent, so the Postgres default is reported in that case. This must be called before any sessions are
+// created.
+func SetMaxConnections(maxConnections uint64) {
+	value := int64(defaultMaxConnections)
+	if maxConnections > 0 && maxConnections <= math.MaxInt32 {
+		value = int64(maxConnections)
+	}
+	maxConnectionsParam := postgresConfigParameters["max_connections"].(*Parameter)
+	maxConnectionsParam.Default = value
+	maxConnectionsParam.ResetVal = value
+	sql.SystemVariables.AddSystemVariables([]sql.SystemVariable{maxConnectionsParam})
+}
@@ -17,12 +17,14 @@ package config
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
 	"github.com/dolthub/go-mysql-server/sql/variables"
 )
 
@@ -46,6 +48,70 @@ func Init() {
 	sql.SystemVariables.AddSystemVariables(params)
 }
 
+// customParameterCategory is the category of customized parameters, which are described by AddCustomParameter.
+const customParameterCategory = "Customized Options"
+
+// AddCustomParameter defines a customized parameter if it has not yet been defined. Customized parameters have names
+// that contain a dot, such as "myapp.user_id", and Postgres allows applications and extensions to set them without
+// defining them beforehand. They hold strings, and may be set by any user.
+func AddCustomParameter(name string) {
+	name = strings.ToLower(name)
+	if _, _, ok := sql.SystemVariables.GetGlobal(name); ok {
+		return
+	}
+	sql.SystemVariables.AddSystemVariables([]sql.SystemVariable{&Parameter{
+		Name:      name,
+		Default:   "",
+		Category:  customParameterCategory,
+		ShortDesc: "Customized option.",
+		Context:   ParameterContextUser,
+		Type:      types.NewSystemStringType(name),
+		Source:    ParameterSourceDefault,
+		ResetVal:  "",
+		Scope:     GetPgsqlScope(PsqlScopeSession),
+	}})
+}
+
+// IsCustomParameterName returns whether the name is that of a customized parameter.
+func IsCustomParameterName(name string) bool {
+	return strings.Contains(name, ".")
+}
+
+// IsCustomParameter returns whether the customized parameter has been defined.
+func IsCustomParameter(name string) bool {
+	sysVar, _, ok := sql.SystemVariables.GetGlobal(name)
+	if !ok {
+		return false
+	}
+	param, ok := sysVar.(*Parameter)
+	return ok && param.Category == customParameterCategory
+}
+
+// Parameters returns every configuration parameter, other than customized parameters, sorted by name.
+func Parameters() []*Parameter {
+	names := make([]string, 0, len(postgresConfigParameters))
+	for name := range postgresConfigParameters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	params := make([]*Parameter, len(names))
+	for i, name := range names {
+		params[i] = postgresConfigParameters[name].(*Parameter)
+	}
+	return params
+}
+
+// DisplayValue returns the value of the system variable as Postgres displays it, which shows booleans as "on" and "off".
+func DisplayValue(sysVar sql.SystemVariable, value any) string {
+	if _, ok := sysVar.GetType().(types.SystemBoolType); ok {
+		if value == int8(1) {
+			return "on"
+		}
+		return "off"
+	}
+	return fmt.Sprint(value)
+}
+
 var (
 	ErrInvalidValue          = errors.NewKind("ERROR:  invalid value for parameter \"%s\": \"%s\"")
 	ErrCannotChangeAtRuntime = errors.NewKind("ERROR:  parameter \"%s\" cannot be changed now")
@@ -54,8 +120,11 @@ var (
 var _ sql.SystemVariable = (*Parameter)(nil)
 
 type Parameter struct {
-	Name         string
-	Default      any
+	Name    string
+	Default any
+	// Unit is the unit of integer and floating-point parameters that measure memory or time, such as "kB" or "ms".
+	// Values may be given in any unit of the same kind, and are converted to this unit.
+	Unit         string
 	Category     string
 	ShortDesc    string
 	Context      ParameterContext
@@ -124,7 +193,10 @@ func (p *Parameter) SetValue(val any, global bool) (sql.SystemVarValue, error) {
 	if p.IsReadOnly() {
 		return sql.SystemVarValue{}, ErrCannotChangeAtRuntime.New(p.Name)
 	}
-	// TODO: Do parsing of units for memory and time parameters
+	val, err := p.parseValue(val)
+	if err != nil {
+		return sql.SystemVarValue{}, err
+	}
 	return p.InitValue(val, global)
 }
 
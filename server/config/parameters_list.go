@@ -108,9 +108,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"archive_timeout": &Parameter{
-		Name:    "archive_timeout",
-		Default: int64(0),
-		// Unit: "s",
+		Name:      "archive_timeout",
+		Default:   int64(0),
+		Unit:      "s",
 		Category:  "Write-Ahead Log / Archiving",
 		ShortDesc: "Sets the amount of time to wait before forcing a switch to the next WAL file.",
 		Context:   ParameterContextSighup,
@@ -131,9 +131,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"authentication_timeout": &Parameter{
-		Name:    "authentication_timeout",
-		Default: int64(60),
-		// Unit: "s",
+		Name:      "authentication_timeout",
+		Default:   int64(60),
+		Unit:      "s",
 		Category:  "Connections and Authentication / Authentication",
 		ShortDesc: "Sets the maximum allowed time to complete client authentication.",
 		Context:   ParameterContextSighup,
@@ -209,9 +209,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"autovacuum_naptime": &Parameter{
-		Name:    "autovacuum_naptime",
-		Default: int64(60),
-		// Unit: "s",
+		Name:      "autovacuum_naptime",
+		Default:   int64(60),
+		Unit:      "s",
 		Category:  "Autovacuum",
 		ShortDesc: "Time to sleep between autovacuum runs.",
 		Context:   ParameterContextSighup,
@@ -221,9 +221,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"autovacuum_vacuum_cost_delay": &Parameter{
-		Name:    "autovacuum_vacuum_cost_delay",
-		Default: float64(2),
-		// Unit: "ms",
+		Name:      "autovacuum_vacuum_cost_delay",
+		Default:   float64(2),
+		Unit:      "ms",
 		Category:  "Autovacuum",
 		ShortDesc: "Vacuum cost delay in milliseconds, for autovacuum.",
 		Context:   ParameterContextSighup,
@@ -288,9 +288,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"autovacuum_work_mem": &Parameter{
-		Name:    "autovacuum_work_mem",
-		Default: int64(-1),
-		// Unit: "kB",
+		Name:      "autovacuum_work_mem",
+		Default:   int64(-1),
+		Unit:      "kB",
 		Category:  "Resource Usage / Memory",
 		ShortDesc: "Sets the maximum memory to be used by each autovacuum worker process.",
 		Context:   ParameterContextSighup,
@@ -300,9 +300,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"backend_flush_after": &Parameter{
-		Name:    "backend_flush_after",
-		Default: int64(0),
-		// Unit: "8kB",
+		Name:      "backend_flush_after",
+		Default:   int64(0),
+		Unit:      "8kB",
 		Category:  "Resource Usage / Asynchronous Behavior",
 		ShortDesc: "Number of pages after which previously performed writes are flushed to disk.",
 		Context:   ParameterContextUser,
@@ -345,9 +345,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"bgwriter_flush_after": &Parameter{
-		Name:    "bgwriter_flush_after",
-		Default: int64(0),
-		// Unit: "8kB",
+		Name:      "bgwriter_flush_after",
+		Default:   int64(0),
+		Unit:      "8kB",
 		Category:  "Resource Usage / Background Writer",
 		ShortDesc: "Number of pages after which previously performed writes are flushed to disk.",
 		Context:   ParameterContextSighup,
@@ -445,9 +445,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"checkpoint_flush_after": &Parameter{
-		Name:    "checkpoint_flush_after",
-		Default: int64(0),
-		// Unit: "8kB",
+		Name:      "checkpoint_flush_after",
+		Default:   int64(0),
+		Unit:      "8kB",
 		Category:  "Write-Ahead Log / Checkpoints",
 		ShortDesc: "Number of pages after which previously performed writes are flushed to disk.",
 		Context:   ParameterContextSighup,
@@ -457,9 +457,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"checkpoint_timeout": &Parameter{
-		Name:    "checkpoint_timeout",
-		Default: int64(300),
-		// Unit: "s",
+		Name:      "checkpoint_timeout",
+		Default:   int64(300),
+		Unit:      "s",
 		Category:  "Write-Ahead Log / Checkpoints",
 		ShortDesc: "Sets the maximum time between automatic WAL checkpoints.",
 		Context:   ParameterContextSighup,
@@ -469,9 +469,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"checkpoint_warning": &Parameter{
-		Name:    "checkpoint_warning",
-		Default: int64(30),
-		// Unit: "s",
+		Name:      "checkpoint_warning",
+		Default:   int64(30),
+		Unit:      "s",
 		Category:  "Write-Ahead Log / Checkpoints",
 		ShortDesc: "Sets the maximum time before warning if checkpoints triggered by WAL volume happen too frequently.",
 		Context:   ParameterContextSighup,
@@ -481,9 +481,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"client_connection_check_interval": &Parameter{
-		Name:    "client_connection_check_interval",
-		Default: int64(0),
-		// Unit: "ms",
+		Name:      "client_connection_check_interval",
+		Default:   int64(0),
+		Unit:      "ms",
 		Category:  "Connections and Authentication / TCP Settings",
 		ShortDesc: "Sets the time interval between checks for disconnection while running queries.",
 		Context:   ParameterContextUser,
@@ -705,9 +705,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"deadlock_timeout": &Parameter{
-		Name:    "deadlock_timeout",
-		Default: int64(1000),
-		// Unit: "ms",
+		Name:      "deadlock_timeout",
+		Default:   int64(1000),
+		Unit:      "ms",
 		Category:  "Lock Management",
 		ShortDesc: "Sets the time to wait on a lock before checking for deadlock.",
 		Context:   ParameterContextSuperUser,
@@ -904,6 +904,50 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		ResetVal:  int8(0),
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
+	"dolt.remote_password": &Parameter{
+		Name:      "dolt.remote_password",
+		Default:   "",
+		Category:  "Dolt / Remotes",
+		ShortDesc: "Sets the password used to authenticate with remotes as dolt.remote_user.",
+		Context:   ParameterContextUser,
+		Type:      types.NewSystemStringType("dolt.remote_password"),
+		Source:    ParameterSourceDefault,
+		ResetVal:  "",
+		Scope:     GetPgsqlScope(PsqlScopeSession),
+	},
+	"dolt.remote_user": &Parameter{
+		Name:      "dolt.remote_user",
+		Default:   "",
+		Category:  "Dolt / Remotes",
+		ShortDesc: "Sets the user that authenticates with remotes when one is not given to a remote function.",
+		Context:   ParameterContextUser,
+		Type:      types.NewSystemStringType("dolt.remote_user"),
+		Source:    ParameterSourceDefault,
+		ResetVal:  "",
+		Scope:     GetPgsqlScope(PsqlScopeSession),
+	},
+	"dolt.transaction_commit": &Parameter{
+		Name:      "dolt.transaction_commit",
+		Default:   int8(0),
+		Category:  "Dolt / Transactions",
+		ShortDesc: "Creates a Dolt commit whenever a transaction commits.",
+		Context:   ParameterContextUser,
+		Type:      types.NewSystemBoolType("dolt.transaction_commit"),
+		Source:    ParameterSourceDefault,
+		ResetVal:  int8(0),
+		Scope:     GetPgsqlScope(PsqlScopeSession),
+	},
+	"dolt.transaction_commit_message": &Parameter{
+		Name:      "dolt.transaction_commit_message",
+		Default:   DefaultTransactionCommitMessage,
+		Category:  "Dolt / Transactions",
+		ShortDesc: "Sets the message of the Dolt commits created by dolt.transaction_commit.",
+		Context:   ParameterContextUser,
+		Type:      types.NewSystemStringType("dolt.transaction_commit_message"),
+		Source:    ParameterSourceDefault,
+		ResetVal:  DefaultTransactionCommitMessage,
+		Scope:     GetPgsqlScope(PsqlScopeSession),
+	},
 	"dynamic_library_path": &Parameter{
 		Name:      "dynamic_library_path",
 		Default:   "$libdir",
@@ -927,9 +971,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"effective_cache_size": &Parameter{
-		Name:    "effective_cache_size",
-		Default: int64(524288),
-		// Unit: "8kB",
+		Name:      "effective_cache_size",
+		Default:   int64(524288),
+		Unit:      "8kB",
 		Category:  "Query Tuning / Planner Cost Constants",
 		ShortDesc: "Sets the planner's assumption about the total size of the data caches.",
 		Context:   ParameterContextUser,
@@ -1357,9 +1401,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"gin_pending_list_limit": &Parameter{
-		Name:    "gin_pending_list_limit",
-		Default: int64(4096),
-		// Unit: "kB",
+		Name:      "gin_pending_list_limit",
+		Default:   int64(4096),
+		Unit:      "kB",
 		Category:  "Client Connection Defaults / Statement Behavior",
 		ShortDesc: "Sets the maximum size of the pending list for GIN index.",
 		Context:   ParameterContextUser,
@@ -1424,9 +1468,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"huge_page_size": &Parameter{
-		Name:    "huge_page_size",
-		Default: int64(0),
-		// Unit: "kB",
+		Name:      "huge_page_size",
+		Default:   int64(0),
+		Unit:      "kB",
 		Category:  "Resource Usage / Memory",
 		ShortDesc: "The size of huge page that should be requested.",
 		Context:   ParameterContextPostmaster,
@@ -1469,9 +1513,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"idle_in_transaction_session_timeout": &Parameter{
-		Name:    "idle_in_transaction_session_timeout",
-		Default: int64(0),
-		// Unit: "ms",
+		Name:      "idle_in_transaction_session_timeout",
+		Default:   int64(0),
+		Unit:      "ms",
 		Category:  "Client Connection Defaults / Statement Behavior",
 		ShortDesc: "Sets the maximum allowed idle time between queries, when in a transaction.",
 		Context:   ParameterContextUser,
@@ -1481,9 +1525,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"idle_session_timeout": &Parameter{
-		Name:    "idle_session_timeout",
-		Default: int64(0),
-		// Unit: "ms",
+		Name:      "idle_session_timeout",
+		Default:   int64(0),
+		Unit:      "ms",
 		Category:  "Client Connection Defaults / Statement Behavior",
 		ShortDesc: "Sets the maximum allowed idle time between queries, when not in a transaction.",
 		Context:   ParameterContextUser,
@@ -1782,9 +1826,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"lock_timeout": &Parameter{
-		Name:    "lock_timeout",
-		Default: int64(0),
-		// Unit: "ms",
+		Name:      "lock_timeout",
+		Default:   int64(0),
+		Unit:      "ms",
 		Category:  "Client Connection Defaults / Statement Behavior",
 		ShortDesc: "Sets the maximum allowed duration of any wait for a lock.",
 		Context:   ParameterContextUser,
@@ -1949,9 +1993,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"log_min_duration_sample": &Parameter{
-		Name:    "log_min_duration_sample",
-		Default: int64(-1),
-		// Unit: "ms",
+		Name:      "log_min_duration_sample",
+		Default:   int64(-1),
+		Unit:      "ms",
 		Category:  "Reporting and Logging / When to Log",
 		ShortDesc: "Sets the minimum execution time above which a sample of statements will be logged. Sampling is determined by log_statement_sample_rate.",
 		Context:   ParameterContextSuperUser,
@@ -1961,9 +2005,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"log_min_duration_statement": &Parameter{
-		Name:    "log_min_duration_statement",
-		Default: int64(-1),
-		// Unit: "ms",
+		Name:      "log_min_duration_statement",
+		Default:   int64(-1),
+		Unit:      "ms",
 		Category:  "Reporting and Logging / When to Log",
 		ShortDesc: "Sets the minimum execution time above which all statements will be logged.",
 		Context:   ParameterContextSuperUser,
@@ -1995,9 +2039,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"log_parameter_max_length": &Parameter{
-		Name:    "log_parameter_max_length",
-		Default: int64(-1),
-		// Unit: "B",
+		Name:      "log_parameter_max_length",
+		Default:   int64(-1),
+		Unit:      "B",
 		Category:  "Reporting and Logging / What to Log",
 		ShortDesc: "Sets the maximum length in bytes of data logged for bind parameter values when logging statements.",
 		Context:   ParameterContextSuperUser,
@@ -2007,9 +2051,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"log_parameter_max_length_on_error": &Parameter{
-		Name:    "log_parameter_max_length_on_error",
-		Default: int64(0),
-		// Unit: "B",
+		Name:      "log_parameter_max_length_on_error",
+		Default:   int64(0),
+		Unit:      "B",
 		Category:  "Reporting and Logging / What to Log",
 		ShortDesc: "Sets the maximum length in bytes of data logged for bind parameter values when logging statements, on error.",
 		Context:   ParameterContextUser,
@@ -2063,9 +2107,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"log_rotation_age": &Parameter{
-		Name:    "log_rotation_age",
-		Default: int64(1440),
-		// Unit: "min",
+		Name:      "log_rotation_age",
+		Default:   int64(1440),
+		Unit:      "min",
 		Category:  "Reporting and Logging / Where to Log",
 		ShortDesc: "Sets the amount of time to wait before forcing log file rotation.",
 		Context:   ParameterContextSighup,
@@ -2075,9 +2119,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"log_rotation_size": &Parameter{
-		Name:    "log_rotation_size",
-		Default: int64(10240),
-		// Unit: "kB",
+		Name:      "log_rotation_size",
+		Default:   int64(10240),
+		Unit:      "kB",
 		Category:  "Reporting and Logging / Where to Log",
 		ShortDesc: "Sets the maximum size a log file can reach before being rotated.",
 		Context:   ParameterContextSighup,
@@ -2131,9 +2175,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"log_temp_files": &Parameter{
-		Name:    "log_temp_files",
-		Default: int64(-1),
-		// Unit: "kB",
+		Name:      "log_temp_files",
+		Default:   int64(-1),
+		Unit:      "kB",
 		Category:  "Reporting and Logging / What to Log",
 		ShortDesc: "Log the use of temporary files larger than this number of kilobytes.",
 		Context:   ParameterContextSuperUser,
@@ -2188,9 +2232,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"logical_decoding_work_mem": &Parameter{
-		Name:    "logical_decoding_work_mem",
-		Default: int64(65536),
-		// Unit: "kB",
+		Name:      "logical_decoding_work_mem",
+		Default:   int64(65536),
+		Unit:      "kB",
 		Category:  "Resource Usage / Memory",
 		ShortDesc: "Sets the maximum memory to be used for logical decoding.",
 		Context:   ParameterContextUser,
@@ -2211,9 +2255,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"maintenance_work_mem": &Parameter{
-		Name:    "maintenance_work_mem",
-		Default: int64(65536),
-		// Unit: "kB",
+		Name:      "maintenance_work_mem",
+		Default:   int64(65536),
+		Unit:      "kB",
 		Category:  "Resource Usage / Memory",
 		ShortDesc: "Sets the maximum memory to be used for maintenance operations.",
 		Context:   ParameterContextUser,
@@ -2228,7 +2272,7 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Category:  "Connections and Authentication / Connection Settings",
 		ShortDesc: "Sets the maximum number of concurrent connections.",
 		Context:   ParameterContextPostmaster,
-		Type:      types.NewSystemIntType("max_connections", 64, math.MaxInt32, false),
+		Type:      types.NewSystemIntType("max_connections", 1, math.MaxInt32, false),
 		Source:    ParameterSourceConfigurationFile,
 		ResetVal:  int64(100),
 		Scope:     GetPgsqlScope(PsqlScopeSession),
@@ -2399,9 +2443,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"max_slot_wal_keep_size": &Parameter{
-		Name:    "max_slot_wal_keep_size",
-		Default: int64(-1),
-		// Unit: "MB",
+		Name:      "max_slot_wal_keep_size",
+		Default:   int64(-1),
+		Unit:      "MB",
 		Category:  "Replication / Sending Servers",
 		ShortDesc: "Sets the maximum WAL size that can be reserved by replication slots.",
 		Context:   ParameterContextSighup,
@@ -2411,9 +2455,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"max_stack_depth": &Parameter{
-		Name:    "max_stack_depth",
-		Default: int64(2048),
-		// Unit: "kB",
+		Name:      "max_stack_depth",
+		Default:   int64(2048),
+		Unit:      "kB",
 		Category:  "Resource Usage / Memory",
 		ShortDesc: "Sets the maximum stack depth, in kilobytes.",
 		Context:   ParameterContextSuperUser,
@@ -2423,9 +2467,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"max_standby_archive_delay": &Parameter{
-		Name:    "max_standby_archive_delay",
-		Default: int64(30000),
-		// Unit: "ms",
+		Name:      "max_standby_archive_delay",
+		Default:   int64(30000),
+		Unit:      "ms",
 		Category:  "Replication / Sending Servers",
 		ShortDesc: "Sets the maximum delay before canceling queries when a hot standby server is processing archived WAL data.",
 		Context:   ParameterContextSighup,
@@ -2435,9 +2479,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"max_standby_streaming_delay": &Parameter{
-		Name:    "max_standby_streaming_delay",
-		Default: int64(30000),
-		// Unit: "ms",
+		Name:      "max_standby_streaming_delay",
+		Default:   int64(30000),
+		Unit:      "ms",
 		Category:  "Replication / Standby Servers",
 		ShortDesc: "Sets the maximum delay before canceling queries when a hot standby server is processing streamed WAL data.",
 		Context:   ParameterContextSighup,
@@ -2469,9 +2513,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"max_wal_size": &Parameter{
-		Name:    "max_wal_size",
-		Default: int64(1024),
-		// Unit: "MB",
+		Name:      "max_wal_size",
+		Default:   int64(1024),
+		Unit:      "MB",
 		Category:  "Write-Ahead Log / Checkpoints",
 		ShortDesc: "Sets the WAL size that triggers a checkpoint.",
 		Context:   ParameterContextSighup,
@@ -2503,9 +2547,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"min_parallel_index_scan_size": &Parameter{
-		Name:    "min_parallel_index_scan_size",
-		Default: int64(1024),
-		// Unit: "8kB",
+		Name:      "min_parallel_index_scan_size",
+		Default:   int64(1024),
+		Unit:      "8kB",
 		Category:  "Query Tuning / Planner Cost Constants",
 		ShortDesc: "Sets the minimum amount of table data for a parallel scan.",
 		Context:   ParameterContextUser,
@@ -2515,9 +2559,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"min_parallel_table_scan_size": &Parameter{
-		Name:    "min_parallel_table_scan_size",
-		Default: int64(1024),
-		// Unit: "8kB",
+		Name:      "min_parallel_table_scan_size",
+		Default:   int64(1024),
+		Unit:      "8kB",
 		Category:  "Query Tuning / Planner Cost Constants",
 		ShortDesc: "Sets the minimum amount of table data for a parallel scan.",
 		Context:   ParameterContextUser,
@@ -2527,9 +2571,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"min_wal_size": &Parameter{
-		Name:    "min_wal_size",
-		Default: int64(80),
-		// Unit: "MB",
+		Name:      "min_wal_size",
+		Default:   int64(80),
+		Unit:      "MB",
 		Category:  "Write-Ahead Log / Checkpoints",
 		ShortDesc: "Sets the minimum size to shrink the WAL to.",
 		Context:   ParameterContextSighup,
@@ -2593,6 +2637,17 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		ResetVal:  "scram-sha-256",
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
+	"pg_hint_plan.enable_hint": &Parameter{
+		Name:      "pg_hint_plan.enable_hint",
+		Default:   int8(0),
+		Category:  "Query Tuning / Other Planner Options",
+		ShortDesc: "Lets the planner honor pg_hint_plan style hints given in a comment at the start of a query.",
+		Context:   ParameterContextUser,
+		Type:      types.NewSystemBoolType("pg_hint_plan.enable_hint"),
+		Source:    ParameterSourceDefault,
+		ResetVal:  int8(0),
+		Scope:     GetPgsqlScope(PsqlScopeSession),
+	},
 	"plan_cache_mode": &Parameter{
 		Name:      "plan_cache_mode",
 		Default:   "auto",
@@ -2616,9 +2671,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"post_auth_delay": &Parameter{
-		Name:    "post_auth_delay",
-		Default: int64(0),
-		// Unit: "s",
+		Name:      "post_auth_delay",
+		Default:   int64(0),
+		Unit:      "s",
 		Category:  "Developer Options",
 		ShortDesc: "Sets the amount of time to wait after authentication on connection startup.",
 		Context:   ParameterContextBackend,
@@ -2628,9 +2683,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"pre_auth_delay": &Parameter{
-		Name:    "pre_auth_delay",
-		Default: int64(0),
-		// Unit: "s",
+		Name:      "pre_auth_delay",
+		Default:   int64(0),
+		Unit:      "s",
 		Category:  "Developer Options",
 		ShortDesc: "Sets the amount of time to wait before authentication on connection startup.",
 		Context:   ParameterContextSighup,
@@ -2706,9 +2761,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"recovery_min_apply_delay": &Parameter{
-		Name:    "recovery_min_apply_delay",
-		Default: int64(0),
-		// Unit: "ms",
+		Name:      "recovery_min_apply_delay",
+		Default:   int64(0),
+		Unit:      "ms",
 		Category:  "Replication / Standby Servers",
 		ShortDesc: "Sets the minimum delay for applying changes during recovery.",
 		Context:   ParameterContextSighup,
@@ -2903,11 +2958,18 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Source:    ParameterSourceDefault,
 		ResetVal:  "\"$user\", public",
 		Scope:     GetPgsqlScope(PsqlScopeSession),
+		ValidateFunc: func(a any) (any, bool) {
+			// An empty search path is displayed as an empty quoted identifier, which never matches a schema
+			if v, ok := a.(string); ok && strings.TrimSpace(v) == "" {
+				return `""`, true
+			}
+			return a, true
+		},
 	},
 	"segment_size": &Parameter{
-		Name:    "segment_size",
-		Default: int64(131072),
-		// Unit: "8kB",
+		Name:      "segment_size",
+		Default:   int64(131072),
+		Unit:      "8kB",
 		Category:  "Preset Options",
 		ShortDesc: "Shows the number of pages per disk file.",
 		Context:   ParameterContextInternal,
@@ -2963,24 +3025,24 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 	},
 	"server_version": &Parameter{
 		Name:      "server_version",
-		Default:   "16.1 (Homebrew)",
+		Default:   "15.0",
 		Category:  "Preset Options",
 		ShortDesc: "Shows the server version.",
 		Context:   ParameterContextInternal,
 		Type:      types.NewSystemStringType("server_version"),
 		Source:    ParameterSourceDefault,
-		ResetVal:  "16.1 (Homebrew)",
+		ResetVal:  "15.0",
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"server_version_num": &Parameter{
 		Name:      "server_version_num",
-		Default:   int64(160001),
+		Default:   int64(150000),
 		Category:  "Preset Options",
 		ShortDesc: "Shows the server version as an integer.",
 		Context:   ParameterContextInternal,
-		Type:      types.NewSystemIntType("server_version_num", 160001, 160001, false),
+		Type:      types.NewSystemIntType("server_version_num", 150000, 150000, false),
 		Source:    ParameterSourceDefault,
-		ResetVal:  int64(160001),
+		ResetVal:  int64(150000),
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"session_preload_libraries": &Parameter{
@@ -3006,9 +3068,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"shared_buffers": &Parameter{
-		Name:    "shared_buffers",
-		Default: int64(16384),
-		// Unit: "8kB",
+		Name:      "shared_buffers",
+		Default:   int64(16384),
+		Unit:      "8kB",
 		Category:  "Resource Usage / Memory",
 		ShortDesc: "Sets the number of shared memory buffers used by the server.",
 		Context:   ParameterContextPostmaster,
@@ -3018,9 +3080,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"shared_memory_size": &Parameter{
-		Name:    "shared_memory_size",
-		Default: int64(143),
-		// Unit: "MB",
+		Name:      "shared_memory_size",
+		Default:   int64(143),
+		Unit:      "MB",
 		Category:  "Preset Options",
 		ShortDesc: "Shows the size of the server's main shared memory area (rounded up to the nearest MB).",
 		Context:   ParameterContextInternal,
@@ -3240,12 +3302,12 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"statement_timeout": &Parameter{
-		Name:    "statement_timeout",
-		Default: int64(0),
-		// Unit: "ms",
+		Name:      "statement_timeout",
+		Default:   int64(0),
+		Unit:      "ms",
 		Category:  "Client Connection Defaults / Statement Behavior",
 		ShortDesc: "Sets the maximum allowed duration of any statement.",
-		Context:   ParameterContextSighup,
+		Context:   ParameterContextUser,
 		Type:      types.NewSystemIntType("statement_timeout", 0, math.MaxInt32, false),
 		Source:    ParameterSourceDefault,
 		ResetVal:  int64(0),
@@ -3362,9 +3424,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"tcp_keepalives_idle": &Parameter{
-		Name:    "tcp_keepalives_idle",
-		Default: int64(0),
-		// Unit: "s",
+		Name:      "tcp_keepalives_idle",
+		Default:   int64(0),
+		Unit:      "s",
 		Category:  "Connections and Authentication / TCP Settings",
 		ShortDesc: "Time between issuing TCP keepalives.",
 		Context:   ParameterContextUser,
@@ -3374,9 +3436,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"tcp_keepalives_interval": &Parameter{
-		Name:    "tcp_keepalives_interval",
-		Default: int64(0),
-		// Unit: "s",
+		Name:      "tcp_keepalives_interval",
+		Default:   int64(0),
+		Unit:      "s",
 		Category:  "Connections and Authentication / TCP Settings",
 		ShortDesc: "Time between TCP keepalive retransmits.",
 		Context:   ParameterContextUser,
@@ -3386,9 +3448,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"tcp_user_timeout": &Parameter{
-		Name:    "tcp_user_timeout",
-		Default: int64(0),
-		// Unit: "ms",
+		Name:      "tcp_user_timeout",
+		Default:   int64(0),
+		Unit:      "ms",
 		Category:  "Connections and Authentication / TCP Settings",
 		ShortDesc: "TCP user timeout.",
 		Context:   ParameterContextUser,
@@ -3398,9 +3460,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"temp_buffers": &Parameter{
-		Name:    "temp_buffers",
-		Default: int64(1024),
-		// Unit: "8kB",
+		Name:      "temp_buffers",
+		Default:   int64(1024),
+		Unit:      "8kB",
 		Category:  "Resource Usage / Memory",
 		ShortDesc: "Sets the maximum number of temporary buffers used by each session.",
 		Context:   ParameterContextUser,
@@ -3410,9 +3472,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"temp_file_limit": &Parameter{
-		Name:    "temp_file_limit",
-		Default: int64(-1),
-		// Unit: "kB",
+		Name:      "temp_file_limit",
+		Default:   int64(-1),
+		Unit:      "kB",
 		Category:  "Resource Usage / Disk",
 		ShortDesc: "Limits the total size of all temporary files used by each process.",
 		Context:   ParameterContextSuperUser,
@@ -3521,9 +3583,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"track_activity_query_size": &Parameter{
-		Name:    "track_activity_query_size",
-		Default: int64(1024),
-		// Unit: "B",
+		Name:      "track_activity_query_size",
+		Default:   int64(1024),
+		Unit:      "B",
 		Category:  "Statistics / Cumulative Query and Index Statistics",
 		ShortDesc: "Sets the size reserved for pg_stat_activity.query, in bytes.",
 		Context:   ParameterContextPostmaster,
@@ -3676,9 +3738,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"vacuum_buffer_usage_limit": &Parameter{
-		Name:    "vacuum_buffer_usage_limit",
-		Default: int64(256),
-		// Unit: "kB",
+		Name:      "vacuum_buffer_usage_limit",
+		Default:   int64(256),
+		Unit:      "kB",
 		Category:  "Resource Usage / Memory",
 		ShortDesc: "Sets the buffer pool size for VACUUM, ANALYZE, and autovacuum.",
 		Context:   ParameterContextUser,
@@ -3688,9 +3750,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"vacuum_cost_delay": &Parameter{
-		Name:    "vacuum_cost_delay",
-		Default: float64(0),
-		// Unit: "ms",
+		Name:      "vacuum_cost_delay",
+		Default:   float64(0),
+		Unit:      "ms",
 		Category:  "Resource Usage / Cost-Based Vacuum Delay",
 		ShortDesc: "Vacuum cost delay in milliseconds.",
 		Context:   ParameterContextUser,
@@ -3821,9 +3883,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"wal_buffers": &Parameter{
-		Name:    "wal_buffers",
-		Default: int64(512),
-		// Unit: "8kB",
+		Name:      "wal_buffers",
+		Default:   int64(512),
+		Unit:      "8kB",
 		Category:  "Write-Ahead Log / Settings",
 		ShortDesc: "Sets the number of disk-page buffers in shared memory for WAL.",
 		Context:   ParameterContextPostmaster,
@@ -3856,9 +3918,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"wal_decode_buffer_size": &Parameter{
-		Name:    "wal_decode_buffer_size",
-		Default: int64(524288),
-		// Unit: "B",
+		Name:      "wal_decode_buffer_size",
+		Default:   int64(524288),
+		Unit:      "B",
 		Category:  "Write-Ahead Log / Recovery",
 		ShortDesc: "Buffer size for reading ahead in the WAL during recovery.",
 		Context:   ParameterContextPostmaster,
@@ -3879,9 +3941,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"wal_keep_size": &Parameter{
-		Name:    "wal_keep_size",
-		Default: int64(0),
-		// Unit: "MB",
+		Name:      "wal_keep_size",
+		Default:   int64(0),
+		Unit:      "MB",
 		Category:  "Replication / Sending Servers",
 		ShortDesc: "Sets the size of WAL files held for standby servers.",
 		Context:   ParameterContextSighup,
@@ -3924,9 +3986,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"wal_receiver_status_interval": &Parameter{
-		Name:    "wal_receiver_status_interval",
-		Default: int64(10),
-		// Unit: "s",
+		Name:      "wal_receiver_status_interval",
+		Default:   int64(10),
+		Unit:      "s",
 		Category:  "Replication / Standby Servers",
 		ShortDesc: "Sets the maximum interval between WAL receiver status reports to the sending server.",
 		Context:   ParameterContextSighup,
@@ -3969,9 +4031,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"wal_segment_size": &Parameter{
-		Name:    "wal_segment_size",
-		Default: int64(16777216),
-		// Unit: "B",
+		Name:      "wal_segment_size",
+		Default:   int64(16777216),
+		Unit:      "B",
 		Category:  "Preset Options",
 		ShortDesc: "Shows the size of write ahead log segments.",
 		Context:   ParameterContextInternal,
@@ -3981,9 +4043,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"wal_sender_timeout": &Parameter{
-		Name:    "wal_sender_timeout",
-		Default: int64(60000),
-		// Unit: "ms",
+		Name:      "wal_sender_timeout",
+		Default:   int64(60000),
+		Unit:      "ms",
 		Category:  "Replication / Sending Servers",
 		ShortDesc: "Sets the maximum time to wait for WAL replication.",
 		Context:   ParameterContextUser,
@@ -3993,9 +4055,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"wal_skip_threshold": &Parameter{
-		Name:    "wal_skip_threshold",
-		Default: int64(2048),
-		// Unit: "kB",
+		Name:      "wal_skip_threshold",
+		Default:   int64(2048),
+		Unit:      "kB",
 		Category:  "Write-Ahead Log / Settings",
 		ShortDesc: "Minimum size of new file to fsync instead of writing WAL.",
 		Context:   ParameterContextUser,
@@ -4016,9 +4078,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"wal_writer_delay": &Parameter{
-		Name:    "wal_writer_delay",
-		Default: int64(200),
-		// Unit: "ms",
+		Name:      "wal_writer_delay",
+		Default:   int64(200),
+		Unit:      "ms",
 		Category:  "Write-Ahead Log / Settings",
 		ShortDesc: "Time between WAL flushes performed in the WAL writer.",
 		Context:   ParameterContextSighup,
@@ -4028,9 +4090,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"wal_writer_flush_after": &Parameter{
-		Name:    "wal_writer_flush_after",
-		Default: int64(128),
-		// Unit: "8kB",
+		Name:      "wal_writer_flush_after",
+		Default:   int64(128),
+		Unit:      "8kB",
 		Category:  "Write-Ahead Log / Settings",
 		ShortDesc: "Amount of WAL written out by WAL writer that triggers a flush.",
 		Context:   ParameterContextSighup,
@@ -4040,9 +4102,9 @@ var postgresConfigParameters = map[string]sql.SystemVariable{
 		Scope:     GetPgsqlScope(PsqlScopeSession),
 	},
 	"work_mem": &Parameter{
-		Name:    "work_mem",
-		Default: int64(4096),
-		// Unit: "kB",
+		Name:      "work_mem",
+		Default:   int64(4096),
+		Unit:      "kB",
 		Category:  "Resource Usage / Memory",
 		ShortDesc: "Sets the maximum memory to be used for query workspaces.",
 		Context:   ParameterContextUser,
@@ -0,0 +1,79 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// serverVersionRegex matches the numeric portion of a version string, such as "15.4" in "15.4 (Debian)". Versions
+// before 10 used three components, such as "9.6.24".
+var serverVersionRegex = regexp.MustCompile(`^(\d+)(?:\.(\d+))?(?:\.(\d+))?`)
+
+// SetServerVersion sets the version of Postgres that the server reports to clients, which is exposed through both the
+// server_version and server_version_num parameters. This must be called before any sessions are created, as existing
+// sessions retain the version that they started with.
+func SetServerVersion(version string) error {
+	versionNum, err := ParseServerVersionNum(version)
+	if err != nil {
+		return err
+	}
+	serverVersion := postgresConfigParameters["server_version"].(*Parameter)
+	serverVersion.Default = version
+	serverVersion.ResetVal = version
+	serverVersionNum := postgresConfigParameters["server_version_num"].(*Parameter)
+	serverVersionNum.Default = versionNum
+	serverVersionNum.ResetVal = versionNum
+	serverVersionNum.Type = types.NewSystemIntType("server_version_num", versionNum, versionNum, false)
+	sql.SystemVariables.AddSystemVariables([]sql.SystemVariable{serverVersion, serverVersionNum})
+	return nil
+}
+
+// ServerVersion returns the version of Postgres that the server reports to clients.
+func ServerVersion() string {
+	return postgresConfigParameters["server_version"].GetDefault().(string)
+}
+
+// ParseServerVersionNum converts a version string into the integer form used by server_version_num. For example,
+// "15.4" becomes 150004, while "9.6.24" becomes 90624.
+func ParseServerVersionNum(version string) (int64, error) {
+	matches := serverVersionRegex.FindStringSubmatch(version)
+	if matches == nil {
+		return 0, ErrInvalidValue.New("server_version", version)
+	}
+	components := make([]int64, 3)
+	for i, match := range matches[1:] {
+		if len(match) == 0 {
+			continue
+		}
+		component, err := strconv.ParseInt(match, 10, 64)
+		if err != nil || component > 99 {
+			return 0, ErrInvalidValue.New("server_version", version)
+		}
+		components[i] = component
+	}
+	if components[0] >= 10 {
+		// Versions 10 and later only have a major and minor component
+		if len(matches[3]) > 0 {
+			return 0, ErrInvalidValue.New("server_version", version)
+		}
+		return components[0]*10000 + components[1], nil
+	}
+	return components[0]*10000 + components[1]*100 + components[2], nil
+}
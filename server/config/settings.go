@@ -0,0 +1,107 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+)
+
+// VarType returns the type of the parameter as pg_settings displays it, which is one of "bool", "integer", "real",
+// "enum", or "string".
+func (p *Parameter) VarType() string {
+	if _, ok := p.Type.(types.SystemBoolType); ok {
+		return "bool"
+	}
+	if p.enumValues().IsValid() {
+		return "enum"
+	}
+	switch p.Type.Type() {
+	case sqltypes.Int64, sqltypes.Uint64:
+		return "integer"
+	case sqltypes.Float64:
+		return "real"
+	default:
+		return "string"
+	}
+}
+
+// Bounds returns the minimum and maximum values of integer and real parameters. Parameters of other types have no
+// bounds, so this returns false for them. Integer parameters that accept -1 as a special value have a minimum of -1,
+// as in Postgres.
+func (p *Parameter) Bounds() (minVal string, maxVal string, ok bool) {
+	// The engine's system variable types do not expose their bounds, so they're read from the type's fields
+	fields := reflect.ValueOf(p.Type)
+	if fields.Kind() != reflect.Struct {
+		return "", "", false
+	}
+	lower := fields.FieldByName("lowerbound")
+	upper := fields.FieldByName("upperbound")
+	if !lower.IsValid() || !upper.IsValid() {
+		return "", "", false
+	}
+	switch lower.Kind() {
+	case reflect.Int64:
+		lowerInt := lower.Int()
+		if negativeOne := fields.FieldByName("negativeOne"); negativeOne.IsValid() && negativeOne.Bool() && lowerInt > -1 {
+			lowerInt = -1
+		}
+		return strconv.FormatInt(lowerInt, 10), strconv.FormatInt(upper.Int(), 10), true
+	case reflect.Uint64:
+		return strconv.FormatUint(lower.Uint(), 10), strconv.FormatUint(upper.Uint(), 10), true
+	case reflect.Float64:
+		return strconv.FormatFloat(lower.Float(), 'g', -1, 64), strconv.FormatFloat(upper.Float(), 'g', -1, 64), true
+	default:
+		return "", "", false
+	}
+}
+
+// EnumValues returns the values that an enum parameter accepts, in the order that they were declared. Parameters of
+// other types return nil.
+func (p *Parameter) EnumValues() []string {
+	indexToVal := p.enumValues()
+	if !indexToVal.IsValid() {
+		return nil
+	}
+	values := make([]string, indexToVal.Len())
+	for i := range values {
+		values[i] = indexToVal.Index(i).String()
+	}
+	return values
+}
+
+// BootValue returns the value that the parameter has when the server's config file does not set it.
+func (p *Parameter) BootValue() any {
+	if prior, ok := configuredDefaults[p]; ok {
+		return prior.Default
+	}
+	return p.Default
+}
+
+// enumValues returns the field of an enum parameter's type that holds its values, which is not valid for parameters of
+// other types. The engine's enum type reports itself as a string type, so this also identifies enum parameters.
+func (p *Parameter) enumValues() reflect.Value {
+	fields := reflect.ValueOf(p.Type)
+	if fields.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	if indexToVal := fields.FieldByName("indexToVal"); indexToVal.Kind() == reflect.Slice {
+		return indexToVal
+	}
+	return reflect.Value{}
+}
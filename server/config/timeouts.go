@@ -0,0 +1,37 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// SetTimeouts sets the defaults of the statement_timeout and lock_timeout parameters, which are both in milliseconds.
+// Sessions may still override either timeout using SET. This must be called before any sessions are created.
+func SetTimeouts(statementTimeout uint64, lockTimeout uint64) error {
+	for name, value := range map[string]uint64{"statement_timeout": statementTimeout, "lock_timeout": lockTimeout} {
+		if value > math.MaxInt32 {
+			return fmt.Errorf("%d ms is outside the valid range for parameter \"%s\" (0 .. %d)", value, name, math.MaxInt32)
+		}
+		param := postgresConfigParameters[name].(*Parameter)
+		param.Default = int64(value)
+		param.ResetVal = int64(value)
+		sql.SystemVariables.AddSystemVariables([]sql.SystemVariable{param})
+	}
+	return nil
+}
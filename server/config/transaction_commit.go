@@ -0,0 +1,58 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// DefaultTransactionCommitMessage is the default template of the dolt.transaction_commit_message parameter.
+const DefaultTransactionCommitMessage = "Transaction commit by {user} from {application_name}"
+
+// SetTransactionCommitDefaults sets the defaults of the dolt.transaction_commit and dolt.transaction_commit_message
+// parameters. An empty message keeps the default template. Sessions may still override either default using SET. This
+// must be called before any sessions are created.
+func SetTransactionCommitDefaults(enabled bool, message string) {
+	transactionCommit := postgresConfigParameters["dolt.transaction_commit"].(*Parameter)
+	if enabled {
+		transactionCommit.Default = int8(1)
+		transactionCommit.ResetVal = int8(1)
+	} else {
+		transactionCommit.Default = int8(0)
+		transactionCommit.ResetVal = int8(0)
+	}
+	transactionCommitMessage := postgresConfigParameters["dolt.transaction_commit_message"].(*Parameter)
+	if len(message) == 0 {
+		message = DefaultTransactionCommitMessage
+	}
+	transactionCommitMessage.Default = message
+	transactionCommitMessage.ResetVal = message
+	sql.SystemVariables.AddSystemVariables([]sql.SystemVariable{transactionCommit, transactionCommitMessage})
+}
+
+// ExpandTransactionCommitMessage returns the message template with its {user}, {application_name}, and {database}
+// placeholders replaced by the given values.
+func ExpandTransactionCommitMessage(template string, user string, applicationName string, database string) string {
+	if !strings.Contains(template, "{") {
+		return template
+	}
+	return strings.NewReplacer(
+		"{user}", user,
+		"{application_name}", applicationName,
+		"{database}", database,
+	).Replace(template)
+}
@@ -0,0 +1,102 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+)
+
+// memoryUnits are the units of memory parameters, measured in bytes. Postgres matches units case-sensitively.
+var memoryUnits = map[string]float64{
+	"B":   1,
+	"kB":  1 << 10,
+	"8kB": 8 << 10,
+	"MB":  1 << 20,
+	"GB":  1 << 30,
+	"TB":  1 << 40,
+}
+
+// timeUnits are the units of time parameters, measured in microseconds.
+var timeUnits = map[string]float64{
+	"us":  1,
+	"ms":  1000,
+	"s":   1000 * 1000,
+	"min": 60 * 1000 * 1000,
+	"h":   60 * 60 * 1000 * 1000,
+	"d":   24 * 60 * 60 * 1000 * 1000,
+}
+
+// boolValues are the spellings of boolean values that Postgres accepts, other than those accepted by the engine.
+var boolValues = map[string]string{
+	"t":     "on",
+	"true":  "on",
+	"y":     "on",
+	"yes":   "on",
+	"1":     "on",
+	"f":     "off",
+	"false": "off",
+	"n":     "off",
+	"no":    "off",
+	"0":     "off",
+}
+
+// parseValue converts a value that was given as a string into a form that the parameter's type accepts. Boolean
+// parameters accept all of the spellings that Postgres accepts, and parameters that have a unit accept values with any
+// unit of the same kind, such as "5s" or "64MB". All other values are returned unchanged.
+func (p *Parameter) parseValue(val any) (any, error) {
+	str, ok := val.(string)
+	if !ok {
+		return val, nil
+	}
+	if _, ok = p.Type.(types.SystemBoolType); ok {
+		if boolVal, ok := boolValues[strings.ToLower(strings.TrimSpace(str))]; ok {
+			return boolVal, nil
+		}
+		return val, nil
+	}
+	if len(p.Unit) == 0 {
+		return val, nil
+	}
+	str = strings.TrimSpace(str)
+	numberEnd := strings.IndexFunc(str, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.' && r != '-' && r != '+'
+	})
+	if numberEnd == -1 {
+		return val, nil
+	}
+	number, err := strconv.ParseFloat(str[:numberEnd], 64)
+	if err != nil {
+		return nil, ErrInvalidValue.New(p.Name, str)
+	}
+	unit := strings.TrimSpace(str[numberEnd:])
+	units := memoryUnits
+	if _, ok = timeUnits[p.Unit]; ok {
+		units = timeUnits
+	}
+	givenSize, ok := units[unit]
+	if !ok {
+		return nil, ErrInvalidValue.New(p.Name, str)
+	}
+	converted := number * givenSize / units[p.Unit]
+	if p.Type.Type() == sqltypes.Float64 {
+		return converted, nil
+	}
+	return int64(math.Round(converted)), nil
+}
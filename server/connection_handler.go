@@ -20,12 +20,15 @@ import (
 	"io"
 	"net"
 	"strings"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/transform"
+	"github.com/dolthub/go-mysql-server/sql/types"
 	"github.com/dolthub/vitess/go/mysql"
 	"github.com/dolthub/vitess/go/sqltypes"
 	querypb "github.com/dolthub/vitess/go/vt/proto/query"
@@ -36,8 +39,21 @@ import (
 	"github.com/dolthub/doltgresql/postgres/connection"
 	"github.com/dolthub/doltgresql/postgres/messages"
 	"github.com/dolthub/doltgresql/postgres/parser/parser"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/privilege"
 	"github.com/dolthub/doltgresql/server/ast"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/backends"
+	"github.com/dolthub/doltgresql/server/dataloader"
 	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	"github.com/dolthub/doltgresql/server/killswitch"
+	"github.com/dolthub/doltgresql/server/locks"
+	"github.com/dolthub/doltgresql/server/logging"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/notices"
+	"github.com/dolthub/doltgresql/server/notifications"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+	"github.com/dolthub/doltgresql/server/telemetry"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
@@ -50,6 +66,59 @@ type ConnectionHandler struct {
 	handler            mysql.Handler
 	pgTypeMap          *pgtype.Map
 	waitForSync        bool
+	inTransaction      bool
+	tlsConfig          *tls.Config
+	requireTLS         bool
+	authenticator      *authenticator
+	connectionLimits   *connectionLimits
+	cancelSecretKey    int32
+	// queryCanceled holds the message that is sent to the client when the running query has been canceled.
+	queryCanceled atomic.Pointer[string]
+	// terminated is set once another session has terminated this one, which closes the session once it next waits on
+	// the client.
+	terminated        atomic.Bool
+	notificationMutex sync.Mutex
+	idle              bool
+	// reportedParameters are the parameter values that have been reported to the client through ParameterStatus
+	// messages, keyed by the parameter name.
+	reportedParameters map[string]string
+	// parametersChanged is set when a statement may have changed a reported parameter.
+	parametersChanged bool
+	// idleSessionTimeout and idleInTransactionSessionTimeout are the session's idle timeouts, where zero disables the
+	// timeout. The idleTimer terminates the connection once the relevant timeout expires.
+	idleSessionTimeout              time.Duration
+	idleInTransactionSessionTimeout time.Duration
+	idleTimer                       *time.Timer
+	// implicitTransaction is set while the statements since the last Sync are running within an implicit transaction,
+	// which commits at the next Sync, or rolls back if any of the statements fail.
+	implicitTransaction bool
+	// statementTimeout is the session's statement timeout, where zero disables the timeout.
+	statementTimeout time.Duration
+	// savepoints are the savepoints of the current transaction block, ordered from the oldest to the newest.
+	savepoints []savepoint
+	// settingChanges records the prior value of every setting that has been changed within the current transaction
+	// block, in the order that they were changed, so that the changes may be undone by a rollback.
+	settingChanges []settingChange
+	// defaultTransaction holds the characteristics that each new transaction starts with, while transaction holds the
+	// characteristics of the current transaction.
+	defaultTransaction transactionCharacteristics
+	transaction        transactionCharacteristics
+	// transactionQueried is set once a query has run within the current transaction block, after which its isolation
+	// level may no longer change.
+	transactionQueried bool
+	// localSettings holds the values that the settings changed by SET LOCAL are restored to once the current
+	// transaction block commits, keyed by the setting's name.
+	localSettings map[string]string
+	// logStatement is the broadest class of statements that is logged, while logMinDuration is the duration at which a
+	// statement is logged along with its duration, where a negative duration disables the slow-query log.
+	logStatement   logging.StatementClass
+	logMinDuration time.Duration
+	// transactionCommit is set once Dolt has been told to create a commit whenever a transaction commits.
+	transactionCommit bool
+	// clientMinMessages is the session's client_min_messages, which filters the notices that the handler sends itself.
+	clientMinMessages string
+	// queryHintsEnabled mirrors pg_hint_plan.enable_hint, as the handler warns about hints that cannot be honored.
+	queryHintsEnabled bool
 }
 
 // NewConnectionHandler returns a new ConnectionHandler for the connection provided
@@ -82,7 +151,7 @@ func (h *ConnectionHandler) HandleConnection() {
 	var returnErr error
 	defer func() {
 		if r := recover(); r != nil {
-			fmt.Printf("Listener recovered panic: %v", r)
+			h.logger().Errorf("Listener recovered panic: %v", r)
 
 			var eomErr error
 			if returnErr != nil {
@@ -93,25 +162,38 @@ func (h *ConnectionHandler) HandleConnection() {
 				eomErr = fmt.Errorf("panic: %v", r)
 			}
 
-			h.endOfMessages(eomErr)
+			h.endOfMessages(eomErr, false)
 		}
 
 		if returnErr != nil {
-			fmt.Println(returnErr.Error())
+			h.logger().WithError(returnErr).Warn("Connection closed with an error")
 		}
 
 		h.handler.ConnectionClosed(h.mysqlConn)
+		// Sessions that terminated this one wait until it has fully closed
+		backends.Unregister(h.mysqlConn.ConnectionID)
 		if err := h.Conn().Close(); err != nil {
-			fmt.Printf("Failed to properly close connection:\n%v\n", err)
+			h.logger().WithError(err).Warn("Failed to properly close connection")
 		}
 	}()
 	h.handler.NewConnection(h.mysqlConn)
-
-	startupMessage, err := h.receiveStartupMessage()
+	defer h.unregisterCancelKey()
+	h.registerNotifications()
+	defer h.unregisterNotifications()
+	h.registerPreparedStatements()
+	defer h.unregisterPreparedStatements()
+	defer pgerrors.TakeContext(h.mysqlConn.ConnectionID)
+	defer notices.Take(h.mysqlConn.ConnectionID)
+	defer locks.ReleaseAll(h.mysqlConn.ConnectionID)
+
+	startupMessage, ok, err := h.receiveStartupMessage()
 	if err != nil {
 		returnErr = err
 		return
 	}
+	if !ok {
+		return
+	}
 
 	err = h.sendClientStartupMessages(startupMessage)
 	if err != nil {
@@ -119,22 +201,48 @@ func (h *ConnectionHandler) HandleConnection() {
 		return
 	}
 
+	releaseConnectionSlot, err := h.acquireConnectionSlot(startupMessage)
+	if err != nil {
+		returnErr = err
+		return
+	}
+	defer releaseConnectionSlot()
+	defer telemetry.ConnectionOpened()()
+
 	err = h.chooseInitialDatabase(startupMessage)
 	if err != nil {
 		returnErr = err
 		return
 	}
 
+	if err = h.applyStartupParameters(startupMessage); err != nil {
+		returnErr = err
+		return
+	}
+
+	if err = h.reportParameterStatus(); err != nil {
+		returnErr = err
+		return
+	}
+	h.loadTimeouts()
+	h.loadTransactionCharacteristics()
+	h.loadStatementLogging()
+	h.loadTransactionCommit()
+	h.loadClientMinMessages()
+	h.loadQueryHints()
+
 	if err := connection.Send(h.Conn(), messages.ReadyForQuery{
 		Indicator: messages.ReadyForQueryTransactionIndicator_Idle,
 	}); err != nil {
 		returnErr = err
 		return
 	}
+	h.setIdle(true)
 
 	// Main session loop: read messages one at a time off the connection until we receive a |Terminate| message, in
 	// which case we hang up, or the connection is closed by the client, which generates an io.EOF from the connection.
 	for {
+		h.startIdleTimer()
 		stop, err := h.receiveMessage()
 		if err != nil {
 			returnErr = err
@@ -163,22 +271,34 @@ func (h *ConnectionHandler) receiveMessage() (bool, error) {
 	// rethink our posture over whether panics should terminate a connection.
 	defer func() {
 		if r := recover(); r != nil {
-			fmt.Printf("Listener recovered panic: %v", r)
+			h.logger().Errorf("Listener recovered panic: %v", r)
 
-			var eomErr error
 			if !endOfMessages && h.waitForSync {
 				if syncErr := connection.DiscardToSync(h.Conn()); syncErr != nil {
-					fmt.Println(syncErr.Error())
+					h.logger().WithError(syncErr).Warn("Unable to discard messages up to the next Sync")
 				}
 			}
-			h.endOfMessages(eomErr)
+			h.endOfMessages(nil, true)
 		}
 	}()
 
 	message, err := connection.Receive(h.Conn())
+	if !h.stopIdleTimer() {
+		// The connection was terminated for being idle, which the client has already been told about
+		return true, nil
+	}
+	if h.terminated.Load() {
+		h.sendTermination()
+		return true, nil
+	}
 	if err != nil {
 		return false, err
 	}
+	h.setIdle(false)
+	// A cancellation only applies to the query that was running when it was received
+	h.queryCanceled.Store(nil)
+	// Likewise, any error context that was not sent with an error should not be attached to a later error
+	pgerrors.TakeContext(h.mysqlConn.ConnectionID)
 
 	if ds, ok := message.(sql.DebugStringer); ok && logrus.IsLevelEnabled(logrus.DebugLevel) {
 		logrus.Debugf("Received message: %s", ds.DebugString())
@@ -190,21 +310,27 @@ func (h *ConnectionHandler) receiveMessage() (bool, error) {
 	stop, endOfMessages, err = h.handleMessage(message)
 	if err != nil {
 		if !endOfMessages && h.waitForSync {
+			// Within an extended query, the error is sent immediately, as a pipelining client may be waiting on it
+			// before it sends the Sync. Every message up to the Sync is then skipped.
+			h.sendNoticesAndError(err)
 			if syncErr := connection.DiscardToSync(h.Conn()); syncErr != nil {
-				fmt.Println(syncErr.Error())
+				h.logger().WithError(syncErr).Warn("Unable to discard messages up to the next Sync")
 			}
+			h.endOfMessages(nil, true)
+		} else {
+			h.endOfMessages(err, false)
 		}
-		h.endOfMessages(err)
 	} else if endOfMessages {
-		h.endOfMessages(nil)
+		h.endOfMessages(nil, false)
 	}
 
 	return stop, nil
 }
 
 // receiveStarupMessage reads a startup message from the connection given and returns it. Some startup messages will
-// result in the establishment of a new connection, which is also returned.
-func (h *ConnectionHandler) receiveStartupMessage() (messages.StartupMessage, error) {
+// result in the establishment of a new connection, which is also returned. Returns false if the connection should be
+// closed without continuing the startup, such as when the client sent a CancelRequest.
+func (h *ConnectionHandler) receiveStartupMessage() (messages.StartupMessage, bool, error) {
 	var startupMessage messages.StartupMessage
 	// The initial message may be one of a few different messages, so we'll check for those.
 InitialMessageLoop:
@@ -212,51 +338,74 @@ InitialMessageLoop:
 		initialMessages, err := connection.ReceiveIntoAny(h.Conn(),
 			messages.StartupMessage{},
 			messages.SSLRequest{},
-			messages.GSSENCRequest{})
+			messages.GSSENCRequest{},
+			messages.CancelRequest{})
 		if err != nil {
 			if err == io.EOF {
-				return messages.StartupMessage{}, nil
+				return messages.StartupMessage{}, false, nil
 			}
-			return messages.StartupMessage{}, err
+			return messages.StartupMessage{}, false, err
 		}
 
 		if len(initialMessages) != 1 {
-			return messages.StartupMessage{}, fmt.Errorf("expected a single message upon starting connection, terminating connection")
+			return messages.StartupMessage{}, false, fmt.Errorf("expected a single message upon starting connection, terminating connection")
 		}
 
 		initialMessage := initialMessages[0]
 		switch initialMessage := initialMessage.(type) {
 		case messages.StartupMessage:
+			if err = h.verifyTransport(initialMessage); err != nil {
+				return messages.StartupMessage{}, false, err
+			}
 			startupMessage = initialMessage
 			break InitialMessageLoop
 		case messages.SSLRequest:
-			hasCertificate := len(certificate.Certificate) > 0
+			hasCertificate := h.tlsConfig != nil
 			if err := connection.Send(h.Conn(), messages.SSLResponse{
 				SupportsSSL: hasCertificate,
 			}); err != nil {
-				return messages.StartupMessage{}, err
+				return messages.StartupMessage{}, false, err
 			}
 			// If we have a certificate and the client has asked for SSL support, then we switch here.
 			// This involves swapping out our underlying net connection for a new one.
 			// We can't start in SSL mode, as the client does not attempt the handshake until after our response.
 			if hasCertificate {
-				conn := tls.Server(h.Conn(), &tls.Config{
-					Certificates: []tls.Certificate{certificate},
-				})
+				conn := tls.Server(h.Conn(), h.tlsConfig)
+				if err = conn.Handshake(); err != nil {
+					return messages.StartupMessage{}, false, err
+				}
 				h.mysqlConn.Conn = conn
+				telemetry.UseFeature(telemetry.FeatureTLS)
 			}
 		case messages.GSSENCRequest:
 			if err = connection.Send(h.Conn(), messages.GSSENCResponse{
 				SupportsGSSAPI: false,
 			}); err != nil {
-				return messages.StartupMessage{}, err
+				return messages.StartupMessage{}, false, err
 			}
+		case messages.CancelRequest:
+			h.handleCancelRequest(initialMessage)
+			return messages.StartupMessage{}, false, nil
 		default:
-			return messages.StartupMessage{}, fmt.Errorf("unexpected initial message, terminating connection")
+			return messages.StartupMessage{}, false, fmt.Errorf("unexpected initial message, terminating connection")
 		}
 	}
 
-	return startupMessage, nil
+	return startupMessage, true, nil
+}
+
+// verifyTransport returns an error if the connection must use TLS but has not been upgraded, in which case the client
+// is also sent an error explaining why it was turned away.
+func (h *ConnectionHandler) verifyTransport(startupMessage messages.StartupMessage) error {
+	if !h.requireTLS || h.Conn().RemoteAddr().Network() == "unix" {
+		return nil
+	}
+	if _, ok := h.Conn().(*tls.Conn); ok {
+		return nil
+	}
+	host, _, _ := net.SplitHostPort(h.Conn().RemoteAddr().String())
+	return h.sendAuthenticationError("28000", fmt.Errorf(`no pg_hba.conf entry for host "%s", user "%s", database "%s", no encryption`,
+		host, startupMessage.Parameters["user"], startupMessage.Parameters["database"]))
 }
 
 // chooseInitialDatabase attempts to choose the initial database for the connection, if one is specified in the
@@ -277,12 +426,28 @@ func (h *ConnectionHandler) chooseInitialDatabase(startupMessage messages.Startu
 			})
 			return err
 		}
+		if !auth.HasPrivilege(h.mysqlConn.User, auth.DatabaseObject(db), privilege.CONNECT) {
+			_ = connection.Send(h.Conn(), messages.ErrorResponse{
+				Severity:     messages.ErrorResponseSeverity_Fatal,
+				SqlStateCode: "42501",
+				Message:      fmt.Sprintf(`permission denied for database "%s"`, db),
+				Optional: messages.ErrorResponseOptionalFields{
+					Detail:  "User does not have CONNECT privilege.",
+					Routine: "InitPostgres",
+				},
+			})
+			return fmt.Errorf(`permission denied for database "%s"`, db)
+		}
+		h.registerBackend(db)
 	} else {
 		// If a database isn't specified, then we attempt to connect to a database with the same name as the user,
 		// ignoring any error
-		_ = h.handler.ComQuery(h.mysqlConn, fmt.Sprintf("USE `%s`;", h.mysqlConn.User), func(*sqltypes.Result, bool) error {
+		err := h.handler.ComQuery(h.mysqlConn, fmt.Sprintf("USE `%s`;", h.mysqlConn.User), func(*sqltypes.Result, bool) error {
 			return nil
 		})
+		if err == nil {
+			h.registerBackend(h.mysqlConn.User)
+		}
 	}
 	return nil
 }
@@ -295,6 +460,9 @@ func (h *ConnectionHandler) handleMessage(message connection.Message) (stop, end
 	case messages.Sync:
 		h.waitForSync = false
 		return false, true, nil
+	case messages.Flush:
+		// Every message is written to the connection as soon as it's created, so there's nothing to flush
+		return false, false, nil
 	case messages.Query:
 		return false, true, h.handleQuery(message)
 	case messages.Parse:
@@ -313,22 +481,25 @@ func (h *ConnectionHandler) handleMessage(message connection.Message) (stop, end
 		}
 
 		return false, false, connection.Send(h.Conn(), messages.CloseComplete{})
+	case messages.CopyData, messages.CopyDone, messages.CopyFail:
+		// A client may still be sending COPY data after the COPY statement has failed, so these are ignored
+		return false, false, nil
 	default:
 		return false, true, fmt.Errorf(`Unhandled message "%s"`, message.DefaultMessage().Name)
 	}
 }
 
 // handleQuery handles a query message, returning any error that occurs
-func (h *ConnectionHandler) handleQuery(message messages.Query) error {
-	handled, err := h.handledPSQLCommands(message.String)
-	if handled || err != nil {
-		return err
-	}
-
+func (h *ConnectionHandler) handleQuery(message messages.Query) (err error) {
 	query, err := h.convertQuery(message.String)
 	if err != nil {
 		return err
 	}
+	finishStatementLog := h.startStatementLog(query, "statement")
+	defer func() {
+		finishStatementLog(err)
+		h.auditStatement(query, err)
+	}()
 
 	// A query message destroys the unnamed statement and the unnamed portal
 	delete(h.preparedStatements, "")
@@ -338,58 +509,239 @@ func (h *ConnectionHandler) handleQuery(message messages.Query) error {
 	// prepared statements at this layer
 	switch stmt := query.AST.(type) {
 	case *sqlparser.Deallocate:
-		// TODO: handle ALL keyword
 		return h.deallocatePreparedStatement(stmt.Name, h.preparedStatements, query, h.Conn())
+	case sqlparser.InjectedStatement:
+		switch injectedStmt := stmt.Statement.(type) {
+		case *pgnodes.CopyFrom:
+			return h.handleCopyFromStdin(query, injectedStmt)
+		case *pgnodes.CopyTo:
+			return h.handleCopyToStdout(query, injectedStmt)
+		case *pgnodes.Prepare:
+			return h.handlePrepare(query, injectedStmt)
+		case *pgnodes.Execute:
+			return h.handleExecuteStatement(injectedStmt, false)
+		case *pgnodes.DeclareCursor:
+			return h.handleDeclareCursor(query, injectedStmt)
+		case *pgnodes.FetchCursor:
+			return h.handleFetchCursor(query, injectedStmt, false)
+		case *pgnodes.CloseCursor:
+			return h.handleCloseCursor(query, injectedStmt)
+		case *pgnodes.Savepoint:
+			return h.handleSavepoint(query, injectedStmt)
+		case *pgnodes.RollbackToSavepoint:
+			return h.handleRollbackToSavepoint(query, injectedStmt)
+		case *pgnodes.ReleaseSavepoint:
+			return h.handleReleaseSavepoint(query, injectedStmt)
+		case *pgnodes.BeginTransaction:
+			return h.handleBeginTransaction(query, injectedStmt)
+		case *pgnodes.SetTransaction:
+			return h.handleSetTransaction(query, injectedStmt)
+		case *pgnodes.SetLocal:
+			return h.handleSetLocal(query, injectedStmt)
+		case *pgnodes.ResetAll:
+			return h.handleResetAll(query)
+		}
 	}
 
+	h.recordSettingChanges(query.AST)
 	return h.query(query)
 }
 
-// handleParse handles a parse message, returning any error that occurs
-func (h *ConnectionHandler) handleParse(message messages.Parse) error {
-	h.waitForSync = true
+// handleCopyFromStdin handles the COPY FROM STDIN sub-protocol. The data that the client sends is streamed to the
+// statement while it executes, and every message up to the client's CopyDone or CopyFail is consumed, even when
+// execution fails early.
+func (h *ConnectionHandler) handleCopyFromStdin(query ConvertedQuery, copyFrom *pgnodes.CopyFrom) error {
+	telemetry.UseFeature(telemetry.FeatureCopyFrom)
+	if err := h.checkReadOnlyTransaction(query); err != nil {
+		return err
+	}
+	if err := connection.Send(h.Conn(), messages.CopyInResponse{
+		IsTextual:   true,
+		FormatCodes: make([]int32, copyFrom.ColumnCount()),
+	}); err != nil {
+		return err
+	}
 
-	// TODO: "Named prepared statements must be explicitly closed before they can be redefined by another Parse message, but this is not required for the unnamed statement"
-	query, err := h.convertQuery(message.Query)
+	pipeReader, pipeWriter := io.Pipe()
+	copyFrom.SetReader(pipeReader)
+	receiveErr := make(chan error, 1)
+	go func() {
+		receiveErr <- h.receiveCopyData(pipeWriter)
+	}()
+
+	commandComplete := messages.CommandComplete{
+		Query: query.String,
+		Tag:   query.StatementTag,
+	}
+	err := h.comQuery(query, func(res *sqltypes.Result, more bool) error {
+		commandComplete.Rows += int32(res.RowsAffected)
+		return nil
+	})
+	// Closing the reader allows the receiving goroutine to discard any data that was not read
+	if err != nil {
+		pipeReader.CloseWithError(err)
+	} else {
+		pipeReader.Close()
+	}
+	// An error from the client (such as CopyFail) takes precedence, as it is likely the cause of the execution error
+	if copyErr := <-receiveErr; copyErr != nil {
+		return copyErr
+	}
 	if err != nil {
 		return err
 	}
+	return connection.Send(h.Conn(), commandComplete)
+}
 
-	if query.AST == nil {
-		// special case: empty query
-		h.preparedStatements[message.Name] = PreparedStatementData{
-			Query: query,
+// handleCopyToStdout handles the COPY TO STDOUT sub-protocol. The results of the query are sent to the client as they
+// are produced, with each row contained in its own CopyData message.
+func (h *ConnectionHandler) handleCopyToStdout(query ConvertedQuery, copyTo *pgnodes.CopyTo) error {
+	telemetry.UseFeature(telemetry.FeatureCopyTo)
+	options := copyTo.Options()
+	writer, err := dataloader.NewWriter(options)
+	if err != nil {
+		return err
+	}
+	isBinary := options.Format == dataloader.Format_Binary
+	commandComplete := messages.CommandComplete{
+		Query: query.String,
+		Tag:   query.StatementTag,
+	}
+	var oids []uint32
+	copyQueryString, copyQueryAST := copyTo.Query()
+	err = h.comQuery(ConvertedQuery{
+		String:       copyQueryString,
+		AST:          copyQueryAST,
+		StatementTag: "SELECT",
+	}, func(res *sqltypes.Result, more bool) error {
+		// The response can only be sent once we know the columns, which we have by the first callback
+		if oids == nil {
+			oids = make([]uint32, len(res.Fields))
+			columnNames := make([]string, len(res.Fields))
+			formatCodes := make([]int32, len(res.Fields))
+			for i, field := range res.Fields {
+				oid, err := messages.VitessFieldToDataTypeObjectID(field)
+				if err != nil {
+					return err
+				}
+				oids[i] = uint32(oid)
+				columnNames[i] = field.Name
+				if isBinary {
+					formatCodes[i] = 1
+				}
+			}
+			if err := connection.Send(h.Conn(), messages.CopyOutResponse{
+				IsTextual:   !isBinary,
+				FormatCodes: formatCodes,
+			}); err != nil {
+				return err
+			}
+			if data := writer.Begin(columnNames); len(data) > 0 {
+				if err := connection.Send(h.Conn(), messages.CopyData{Data: data}); err != nil {
+					return err
+				}
+			}
+		}
+		for _, row := range res.Rows {
+			fields := make([][]byte, len(row))
+			for i, value := range row {
+				if value.IsNull() {
+					continue
+				}
+				fields[i] = value.Raw()
+				if isBinary {
+					var err error
+					if fields[i], err = h.textToBinary(oids[i], fields[i]); err != nil {
+						return err
+					}
+				}
+				// A nil field represents NULL, so empty values must be non-nil
+				if fields[i] == nil {
+					fields[i] = []byte{}
+				}
+			}
+			if err := connection.Send(h.Conn(), messages.CopyData{Data: writer.Row(fields)}); err != nil {
+				return err
+			}
+			commandComplete.Rows++
 		}
 		return nil
-	}
-
-	plan, fields, err := h.getPlanAndFields(query)
+	})
 	if err != nil {
 		return err
 	}
+	if data := writer.End(); len(data) > 0 {
+		if err = connection.Send(h.Conn(), messages.CopyData{Data: data}); err != nil {
+			return err
+		}
+	}
+	if err = connection.Send(h.Conn(), messages.CopyDone{}); err != nil {
+		return err
+	}
+	return connection.Send(h.Conn(), commandComplete)
+}
 
-	// TODO: bindvar types can be specified directly in the message, need tests of this
-	bindVarTypes, err := extractBindVarTypes(plan)
+// textToBinary converts a value from its text representation to its binary representation.
+func (h *ConnectionHandler) textToBinary(oid uint32, text []byte) ([]byte, error) {
+	typ, ok := h.pgTypeMap.TypeForOID(oid)
+	if !ok {
+		return nil, fmt.Errorf("binary format is not supported for the type with OID %d", oid)
+	}
+	value, err := typ.Codec.DecodeValue(h.pgTypeMap, oid, pgtype.TextFormatCode, text)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return h.pgTypeMap.Encode(oid, pgtype.BinaryFormatCode, value, nil)
+}
 
-	// Nil fields means an OKResult, fill one in here
-	if fields == nil {
-		fields = []*querypb.Field{
-			{
-				Name: "Rows",
-				Type: sqltypes.Int32,
-			},
+// receiveCopyData receives the messages that a client sends during COPY FROM STDIN, writing all data to the given
+// writer. Returns once the client has sent either CopyDone or CopyFail.
+func (h *ConnectionHandler) receiveCopyData(writer *io.PipeWriter) error {
+	for {
+		message, err := connection.Receive(h.Conn())
+		if err != nil {
+			writer.CloseWithError(err)
+			return err
+		}
+		switch message := message.(type) {
+		case messages.CopyData:
+			// Once the statement stops reading (such as from an error), the remaining data is discarded
+			_, _ = writer.Write(message.Data)
+		case messages.CopyDone:
+			return writer.Close()
+		case messages.CopyFail:
+			err = fmt.Errorf("COPY from stdin failed: %s", message.ErrorMessage)
+			writer.CloseWithError(err)
+			return err
+		case messages.Flush, messages.Sync:
+			// These are allowed during COPY, but they're ignored
+		default:
+			err = fmt.Errorf(`unexpected message "%s" during COPY from stdin`, message.DefaultMessage().Name)
+			writer.CloseWithError(err)
+			return err
 		}
 	}
+}
 
-	h.preparedStatements[message.Name] = PreparedStatementData{
-		Query:        query,
-		ReturnFields: fields,
-		BindVarTypes: bindVarTypes,
-	}
+// handleParse handles a parse message, returning any error that occurs
+func (h *ConnectionHandler) handleParse(message messages.Parse) error {
+	h.waitForSync = true
+	telemetry.UseFeature(telemetry.FeatureExtendedQuery)
 
+	// Named prepared statements must be explicitly closed before they can be redefined, while the unnamed statement is
+	// simply replaced
+	if _, ok := h.preparedStatements[message.Name]; ok && len(message.Name) > 0 {
+		return pgerrors.Newf(pgcode.DuplicatePreparedStatement, `prepared statement "%s" already exists`, message.Name)
+	}
+	preparedData, err := h.prepareStatement(message.Query, nil)
+	if err != nil {
+		return err
+	}
+	h.preparedStatements[message.Name] = preparedData
+	if preparedData.Query.AST == nil {
+		// special case: empty query
+		return nil
+	}
 	return connection.Send(h.Conn(), messages.ParseComplete{})
 }
 
@@ -426,8 +778,11 @@ func (h *ConnectionHandler) handleDescribe(message messages.Describe) error {
 func (h *ConnectionHandler) handleBind(message messages.Bind) error {
 	h.waitForSync = true
 
-	// TODO: a named portal object lasts till the end of the current transaction, unless explicitly destroyed
-	//  we need to destroy the named portal as a side effect of the transaction ending
+	// A named portal lasts until the end of the current transaction (see endOfMessages), or until it is explicitly
+	// closed, so it cannot be redefined before then. The unnamed portal is simply replaced.
+	if _, ok := h.portals[message.DestinationPortal]; ok && len(message.DestinationPortal) > 0 {
+		return fmt.Errorf(`portal "%s" already exists`, message.DestinationPortal)
+	}
 	logrus.Tracef("binding portal %q to prepared statement %s", message.DestinationPortal, message.SourcePreparedStatement)
 	preparedData, ok := h.preparedStatements[message.SourcePreparedStatement]
 	if !ok {
@@ -439,6 +794,16 @@ func (h *ConnectionHandler) handleBind(message messages.Bind) error {
 		h.portals[message.DestinationPortal] = PortalData{
 			Query:        preparedData.Query,
 			IsEmptyQuery: true,
+			CreationTime: time.Now(),
+		}
+		return connection.Send(h.Conn(), messages.BindComplete{})
+	}
+	if connectionStatement(preparedData.Query) != nil {
+		// Statements that the connection handles are not planned by the engine, so there is nothing to bind
+		h.portals[message.DestinationPortal] = PortalData{
+			Query:        preparedData.Query,
+			Fields:       preparedData.ReturnFields,
+			CreationTime: time.Now(),
 		}
 		return connection.Send(h.Conn(), messages.BindComplete{})
 	}
@@ -453,19 +818,21 @@ func (h *ConnectionHandler) handleBind(message messages.Bind) error {
 		return err
 	}
 
+	preparedData.Executions++
+	h.preparedStatements[message.SourcePreparedStatement] = preparedData
 	h.portals[message.DestinationPortal] = PortalData{
-		Query:     preparedData.Query,
-		Fields:    fields,
-		BoundPlan: boundPlan,
+		Query:        preparedData.Query,
+		Fields:       fields,
+		BoundPlan:    boundPlan,
+		CreationTime: time.Now(),
 	}
 	return connection.Send(h.Conn(), messages.BindComplete{})
 }
 
 // handleExecute handles an execute message, returning any error that occurs
-func (h *ConnectionHandler) handleExecute(message messages.Execute) error {
+func (h *ConnectionHandler) handleExecute(message messages.Execute) (err error) {
 	h.waitForSync = true
 
-	// TODO: implement the RowMax
 	portalData, ok := h.portals[message.Portal]
 	if !ok {
 		return fmt.Errorf("portal %s does not exist", message.Portal)
@@ -483,21 +850,167 @@ func (h *ConnectionHandler) handleExecute(message messages.Execute) error {
 	if portalData.IsEmptyQuery {
 		return connection.Send(h.Conn(), messages.EmptyQueryResponse{})
 	}
+	portalName := message.Portal
+	if len(portalName) == 0 {
+		portalName = "<unnamed>"
+	}
+	finishStatementLog := h.startStatementLog(query, "execute "+portalName)
+	defer func() {
+		finishStatementLog(err)
+		h.auditStatement(query, err)
+	}()
+	switch stmt := connectionStatement(query).(type) {
+	case *pgnodes.Prepare:
+		return h.handlePrepare(query, stmt)
+	case *pgnodes.Execute:
+		return h.handleExecuteStatement(stmt, true)
+	case *pgnodes.DeclareCursor:
+		return h.handleDeclareCursor(query, stmt)
+	case *pgnodes.FetchCursor:
+		return h.handleFetchCursor(query, stmt, true)
+	case *pgnodes.CloseCursor:
+		return h.handleCloseCursor(query, stmt)
+	case *pgnodes.Savepoint:
+		return h.handleSavepoint(query, stmt)
+	case *pgnodes.RollbackToSavepoint:
+		return h.handleRollbackToSavepoint(query, stmt)
+	case *pgnodes.ReleaseSavepoint:
+		return h.handleReleaseSavepoint(query, stmt)
+	case *pgnodes.BeginTransaction:
+		return h.handleBeginTransaction(query, stmt)
+	case *pgnodes.SetTransaction:
+		return h.handleSetTransaction(query, stmt)
+	case *pgnodes.SetLocal:
+		return h.handleSetLocal(query, stmt)
+	case *pgnodes.ResetAll:
+		return h.handleResetAll(query)
+	}
+	// A cursor's rows are fetched from wherever the cursor is positioned
+	if portalData.Cursor != nil {
+		return h.handleExecuteCursor(portalData, message.RowMax)
+	}
+	// A portal that has already been executed resumes from wherever it was suspended
+	if portalData.Results != nil {
+		return h.sendPortalRows(portalData, message.RowMax)
+	}
+
+	callback := spoolRowsCallback(h.Conn(), &complete, true)
+	if message.RowMax > 0 && returnsRow(query.StatementTag, portalData.Fields) {
+		// The query is run to completion, with the rows held by the portal so that they may be sent over multiple
+		// Execute messages
+		results := &PortalResults{}
+		callback = func(res *sqltypes.Result, more bool) error {
+			results.Rows = append(results.Rows, res.Rows...)
+			return nil
+		}
+		portalData.Results = results
+	}
 
-	err := h.handler.(mysql.ExtendedHandler).ComExecuteBound(h.mysqlConn, query.String, portalData.BoundPlan, spoolRowsCallback(h.Conn(), &complete, true))
+	if err := h.checkReadOnlyTransaction(query); err != nil {
+		return err
+	}
+	finishKillSwitch, err := h.startKillSwitch(query)
+	if err != nil {
+		return err
+	}
+	if h.needsImplicitTransaction(query.AST) {
+		if err = h.beginImplicitTransaction(); err != nil {
+			finishKillSwitch()
+			return err
+		}
+	}
+	h.recordSettingChanges(query.AST)
+	stopStatementTimer := h.startStatementTimer()
+	op := h.startDoltOperation(query.AST)
+	err = h.handler.(mysql.ExtendedHandler).ComExecuteBound(h.mysqlConn, query.String, portalData.BoundPlan, callback)
+	if op != nil {
+		op.finish(err)
+	}
+	stopStatementTimer()
+	finishKillSwitch()
 	if err != nil {
 		return err
 	}
 
+	if err = h.sendNotices(); err != nil {
+		return err
+	}
+	if portalData.Results != nil {
+		h.portals[message.Portal] = portalData
+		return h.sendPortalRows(portalData, message.RowMax)
+	}
+	h.markTransactionQueried(query)
+	h.updateTransactionStatus(query.AST)
 	return connection.Send(h.Conn(), complete)
 }
 
+// sendPortalRows sends the portal's remaining rows, limited to |rowMax| rows when it is greater than zero. Matching
+// Postgres, a PortalSuspended message is sent whenever the limit is reached, even if no rows remain, in which case the
+// next Execute completes the portal with zero rows.
+func (h *ConnectionHandler) sendPortalRows(portalData PortalData, rowMax int32) error {
+	rows := portalData.Results.Rows
+	if rowMax > 0 && int(rowMax) < len(rows) {
+		rows = rows[:rowMax]
+	}
+	for _, row := range rows {
+		if err := connection.Send(h.Conn(), messages.DataRow{
+			Values: row,
+		}); err != nil {
+			return err
+		}
+	}
+	portalData.Results.Rows = portalData.Results.Rows[len(rows):]
+
+	if rowMax > 0 && int(rowMax) == len(rows) {
+		return connection.Send(h.Conn(), messages.PortalSuspended{})
+	}
+	return connection.Send(h.Conn(), messages.CommandComplete{
+		Query: portalData.Query.String,
+		Tag:   portalData.Query.StatementTag,
+		Rows:  int32(len(rows)),
+	})
+}
+
+// updateTransactionStatus records whether the connection is within a transaction block after the given statement has
+// successfully executed. Ending a transaction destroys its savepoints and its portals, other than holdable cursors,
+// releases its table and row locks, and resets the transaction characteristics to the session's defaults. This also
+// records whether the statement may have changed a parameter that is reported to the client, or the current database
+// that the transaction commit message may refer to.
+func (h *ConnectionHandler) updateTransactionStatus(stmt sqlparser.Statement) {
+	switch stmt.(type) {
+	case *sqlparser.Set, *sqlparser.Use:
+		h.parametersChanged = true
+	case *sqlparser.Begin:
+		// Beginning a transaction block commits the implicit transaction
+		h.inTransaction = true
+		h.implicitTransaction = false
+		h.transactionQueried = false
+	case *sqlparser.Commit, *sqlparser.Rollback:
+		h.inTransaction = false
+		h.implicitTransaction = false
+		h.transactionQueried = false
+		_, isRollback := stmt.(*sqlparser.Rollback)
+		h.closePortals(!isRollback)
+		h.endTransactionSavepoints(!isRollback)
+		h.endLocalSettings(!isRollback)
+		h.resetTransactionCharacteristics()
+		locks.ReleaseTransaction(h.mysqlConn.ConnectionID)
+		if isRollback {
+			notifications.Rollback(h.mysqlConn.ConnectionID)
+		}
+	}
+}
+
+// deallocatePreparedStatement removes the named prepared statement, or every prepared statement when the name is empty,
+// which is how DEALLOCATE ALL is represented.
 func (h *ConnectionHandler) deallocatePreparedStatement(name string, preparedStatements map[string]PreparedStatementData, query ConvertedQuery, conn net.Conn) error {
-	_, ok := preparedStatements[name]
-	if !ok {
-		return fmt.Errorf("prepared statement %s does not exist", name)
+	if len(name) == 0 {
+		clear(preparedStatements)
+	} else if _, ok := preparedStatements[name]; !ok {
+		return pgerrors.Newf(pgcode.InvalidSQLStatementName, `prepared statement "%s" does not exist`, name)
+	} else {
+		delete(preparedStatements, name)
 	}
-	delete(preparedStatements, name)
 
 	commandComplete := messages.CommandComplete{
 		Query: query.String,
@@ -507,7 +1020,7 @@ func (h *ConnectionHandler) deallocatePreparedStatement(name string, preparedSta
 	return connection.Send(conn, commandComplete)
 }
 
-func extractBindVarTypes(queryPlan sql.Node) ([]int32, error) {
+func (h *ConnectionHandler) extractBindVarTypes(queryPlan sql.Node) ([]int32, error) {
 	inspectNode := queryPlan
 	switch queryPlan := queryPlan.(type) {
 	case *plan.InsertInto:
@@ -521,6 +1034,12 @@ func extractBindVarTypes(queryPlan sql.Node) ([]int32, error) {
 			return false
 		}
 		switch e := expr.(type) {
+		case *pgexprs.DataModification:
+			// The parameters of an INSERT, UPDATE, or DELETE with a RETURNING clause take their types from the statement
+			var modificationTypes []int32
+			modificationTypes, err = h.extractDataModificationBindVarTypes(e)
+			types = append(types, modificationTypes...)
+			return false
 		case *expression.BindVar:
 			var oid int32
 			if doltgresType, ok := e.Type().(pgtypes.DoltgresType); ok {
@@ -535,8 +1054,9 @@ func extractBindVarTypes(queryPlan sql.Node) ([]int32, error) {
 			types = append(types, oid)
 		case *pgexprs.ExplicitCast:
 			if bindVar, ok := e.Child().(*expression.BindVar); ok {
+				// Matching Postgres, a parameter that is immediately cast takes on the type of the cast
 				var oid int32
-				if doltgresType, ok := bindVar.Type().(pgtypes.DoltgresType); ok {
+				if doltgresType, ok := e.Type().(pgtypes.DoltgresType); ok {
 					oid = int32(doltgresType.OID())
 				} else {
 					oid, err = messages.VitessTypeToObjectID(e.Type().Type())
@@ -569,27 +1089,80 @@ func extractBindVarTypes(queryPlan sql.Node) ([]int32, error) {
 	return types, err
 }
 
-// convertBindParameters handles the conversion from bind parameters to variable values.
+// extractDataModificationBindVarTypes returns the types of the parameters of the statement that is carried by the given
+// DataModification, which is prepared separately from the SELECT that reads its returned rows.
+func (h *ConnectionHandler) extractDataModificationBindVarTypes(modification *pgexprs.DataModification) ([]int32, error) {
+	parsedQuery, _, err := h.handler.(mysql.ExtendedHandler).ComPrepareParsed(h.mysqlConn, modification.Query(), modification.Statement(), &mysql.PrepareData{
+		PrepareStmt: modification.Query(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	modificationPlan, ok := parsedQuery.(sql.Node)
+	if !ok {
+		return nil, fmt.Errorf("expected a sql.Node, got %T", parsedQuery)
+	}
+	return h.extractBindVarTypes(modificationPlan)
+}
+
+// convertBindParameters handles the conversion from bind parameters to variable values. Parameters may be sent in either
+// the text or binary format, with binary parameters being converted to their text representation.
 func (h *ConnectionHandler) convertBindParameters(types []int32, formatCodes []int32, values []messages.BindParameterValue) (map[string]*querypb.BindVariable, error) {
+	if len(values) != len(types) {
+		return nil, fmt.Errorf("bind message supplies %d parameters, but prepared statement requires %d", len(values), len(types))
+	}
 	bindings := make(map[string]*querypb.BindVariable, len(values))
 	for i := range values {
 		bindingName := fmt.Sprintf("v%d", i+1)
 		typ := convertType(types[i])
-		var bindVarString string
-		// We'll rely on a library to decode each format, which will deal with text and binary representations for us
-		if err := h.pgTypeMap.Scan(uint32(types[i]), int16(formatCodes[i]), values[i].Data, &bindVarString); err != nil {
-			return nil, err
+		if values[i].IsNull {
+			bindings[bindingName] = &querypb.BindVariable{
+				Type: sqltypes.Null,
+			}
+			continue
 		}
-		bindVar := &querypb.BindVariable{
+		bindVarData := values[i].Data
+		// A single format code applies to every parameter, while no format codes means that every parameter is text
+		var formatCode int16
+		switch len(formatCodes) {
+		case 0:
+			formatCode = pgtype.TextFormatCode
+		case 1:
+			formatCode = int16(formatCodes[0])
+		default:
+			formatCode = int16(formatCodes[i])
+		}
+		if formatCode == pgtype.BinaryFormatCode {
+			var err error
+			if bindVarData, err = h.binaryToText(uint32(types[i]), bindVarData); err != nil {
+				return nil, fmt.Errorf("incorrect binary data format in bind parameter %d: %w", i+1, err)
+			}
+		} else if formatCode != pgtype.TextFormatCode {
+			return nil, fmt.Errorf("unsupported format code %d", formatCode)
+		}
+		bindings[bindingName] = &querypb.BindVariable{
 			Type:   typ,
-			Value:  []byte(bindVarString),
+			Value:  bindVarData,
 			Values: nil, // TODO
 		}
-		bindings[bindingName] = bindVar
 	}
 	return bindings, nil
 }
 
+// binaryToText converts the binary representation of a value of the given type to its text representation. This is the
+// inverse of textToBinary.
+func (h *ConnectionHandler) binaryToText(oid uint32, data []byte) ([]byte, error) {
+	typ, ok := h.pgTypeMap.TypeForOID(oid)
+	if !ok {
+		return nil, fmt.Errorf("binary format is not supported for the type with OID %d", oid)
+	}
+	value, err := typ.Codec.DecodeValue(h.pgTypeMap, oid, pgtype.BinaryFormatCode, data)
+	if err != nil {
+		return nil, err
+	}
+	return h.pgTypeMap.Encode(oid, pgtype.TextFormatCode, value, nil)
+}
+
 // TODO: we need to migrate this away from vitess types and deal strictly with OIDs which are compatible with Postgres types
 func convertType(oid int32) querypb.Type {
 	switch oid {
@@ -611,15 +1184,17 @@ func convertType(oid int32) querypb.Type {
 	case messages.OidText:
 		return sqltypes.Text
 	case messages.OidBool:
-		return sqltypes.Bit
+		return sqltypes.Text
 	case messages.OidDate:
 		return sqltypes.Date
-	case messages.OidTimestamp:
+	case messages.OidTimestamp, messages.OidTimestamptz:
 		return sqltypes.Timestamp
-	case messages.OidVarchar:
+	case messages.OidVarchar, messages.OidUuid:
 		return sqltypes.Text
 	default:
-		panic(fmt.Sprintf("convertType(oid): unhandled type %d", oid))
+		// Parameters are given to the engine using their text representation, so a parameter of any other type (such as
+		// one that is cast to a type without a mapping) is bound as text and converted by the expression that uses it
+		return sqltypes.Text
 	}
 }
 
@@ -650,27 +1225,29 @@ func (h *ConnectionHandler) sendClientStartupMessages(startupMessage messages.St
 		}
 	}
 
-	if err := connection.Send(h.Conn(), messages.AuthenticationOk{}); err != nil {
+	// When a database isn't specified, Postgres uses the user's name for the database that is matched against the rules
+	database := startupMessage.Parameters["database"]
+	if len(database) == 0 {
+		database = h.mysqlConn.User
+	}
+	if err := h.authenticate(h.mysqlConn.User, database); err != nil {
 		return err
 	}
-
-	if err := connection.Send(h.Conn(), messages.ParameterStatus{
-		Name:  "server_version",
-		Value: "15.0",
-	}); err != nil {
+	if err := h.authorizeLogin(h.mysqlConn.User); err != nil {
 		return err
 	}
 
-	if err := connection.Send(h.Conn(), messages.ParameterStatus{
-		Name:  "client_encoding",
-		Value: "UTF8",
-	}); err != nil {
+	if err := connection.Send(h.Conn(), messages.AuthenticationOk{}); err != nil {
 		return err
 	}
 
+	processID, secretKey, err := h.registerCancelKey()
+	if err != nil {
+		return err
+	}
 	if err := connection.Send(h.Conn(), messages.BackendKeyData{
 		ProcessID: processID,
-		SecretKey: 0,
+		SecretKey: secretKey,
 	}); err != nil {
 		return err
 	}
@@ -680,12 +1257,40 @@ func (h *ConnectionHandler) sendClientStartupMessages(startupMessage messages.St
 
 // query runs the given query and sends a CommandComplete message to the client
 func (h *ConnectionHandler) query(query ConvertedQuery) error {
+	return h.runQuery(query, false, func(callback mysql.ResultSpoolFn) error {
+		return h.comQuery(query, callback)
+	})
+}
+
+// runQuery runs the given query using the given function, sending the results to the client. The row description is
+// only sent when |isExecute| is false, as an Execute message relies on an earlier Describe message for it.
+func (h *ConnectionHandler) runQuery(query ConvertedQuery, isExecute bool, run func(callback mysql.ResultSpoolFn) error) error {
+	if err := h.checkReadOnlyTransaction(query); err != nil {
+		return err
+	}
+	if err := h.checkTransactionBlock(query); err != nil {
+		return err
+	}
+	if err := h.warnIgnoredHints(query); err != nil {
+		return err
+	}
 	commandComplete := messages.CommandComplete{
 		Query: query.String,
 		Tag:   query.StatementTag,
 	}
 
-	err := h.comQuery(query, spoolRowsCallback(h.Conn(), &commandComplete, false))
+	finishKillSwitch, err := h.startKillSwitch(query)
+	if err != nil {
+		return err
+	}
+	stopStatementTimer := h.startStatementTimer()
+	op := h.startDoltOperation(query.AST)
+	err = run(spoolRowsCallback(h.Conn(), &commandComplete, isExecute))
+	if op != nil {
+		op.finish(err)
+	}
+	stopStatementTimer()
+	finishKillSwitch()
 
 	if err != nil {
 		if strings.HasPrefix(err.Error(), "syntax error at position") {
@@ -693,7 +1298,12 @@ func (h *ConnectionHandler) query(query ConvertedQuery) error {
 		}
 		return err
 	}
+	h.markTransactionQueried(query)
+	h.updateTransactionStatus(query.AST)
 
+	if err = h.sendNotices(); err != nil {
+		return err
+	}
 	if err := connection.Send(h.Conn(), commandComplete); err != nil {
 		return err
 	}
@@ -705,7 +1315,7 @@ func (h *ConnectionHandler) query(query ConvertedQuery) error {
 // each row in the result set.
 func spoolRowsCallback(conn net.Conn, commandComplete *messages.CommandComplete, isExecute bool) mysql.ResultSpoolFn {
 	return func(res *sqltypes.Result, more bool) error {
-		if messages.ReturnsRow(commandComplete.Tag) {
+		if returnsRow(commandComplete.Tag, res.Fields) {
 			// EXECUTE does not send RowDescription; instead it should be sent from DESCRIBE prior to it
 			if !isExecute {
 				if err := connection.Send(conn, messages.RowDescription{
@@ -724,7 +1334,8 @@ func spoolRowsCallback(conn net.Conn, commandComplete *messages.CommandComplete,
 			}
 		}
 
-		if commandComplete.IsIUD() {
+		// Statements with a RETURNING clause report the number of rows that they return
+		if commandComplete.IsIUD() && !returnsRow(commandComplete.Tag, res.Fields) {
 			commandComplete.Rows = int32(res.RowsAffected)
 		} else {
 			commandComplete.Rows += int32(len(res.Rows))
@@ -733,6 +1344,20 @@ func spoolRowsCallback(conn net.Conn, commandComplete *messages.CommandComplete,
 	}
 }
 
+// returnsRow returns whether a statement with the given tag and fields sends its rows to the client. CALL only returns a
+// row when the procedure has output parameters, while INSERT, UPDATE, and DELETE only return rows when they have a
+// RETURNING clause. Otherwise, the engine describes their results using a single OK result field.
+func returnsRow(tag string, fields []*querypb.Field) bool {
+	switch tag {
+	case "CALL":
+		return len(fields) > 0
+	case "INSERT", "UPDATE", "DELETE":
+		return len(fields) > 0 && fields[0].Name != types.OkResultColumnName
+	default:
+		return messages.ReturnsRow(tag)
+	}
+}
+
 // sendDescribeResponse sends a response message for a Describe message
 func (h *ConnectionHandler) sendDescribeResponse(conn net.Conn, fields []*querypb.Field, types []int32, tag string) (err error) {
 	// The prepared statement variant of the describe command returns the OIDs of the parameters.
@@ -744,7 +1369,7 @@ func (h *ConnectionHandler) sendDescribeResponse(conn net.Conn, fields []*queryp
 		}
 	}
 
-	if messages.ReturnsRow(tag) {
+	if returnsRow(tag, fields) {
 		// Both variants finish with a row description.
 		return connection.Send(conn, messages.RowDescription{
 			Fields: fields,
@@ -754,112 +1379,98 @@ func (h *ConnectionHandler) sendDescribeResponse(conn net.Conn, fields []*queryp
 	}
 }
 
-// handledPSQLCommands handles the special PSQL commands, such as \l and \dt.
-func (h *ConnectionHandler) handledPSQLCommands(statement string) (bool, error) {
-	statement = strings.ToLower(statement)
-	// Command: \l
-	if statement == "select d.datname as \"name\",\n       pg_catalog.pg_get_userbyid(d.datdba) as \"owner\",\n       pg_catalog.pg_encoding_to_char(d.encoding) as \"encoding\",\n       d.datcollate as \"collate\",\n       d.datctype as \"ctype\",\n       d.daticulocale as \"icu locale\",\n       case d.datlocprovider when 'c' then 'libc' when 'i' then 'icu' end as \"locale provider\",\n       pg_catalog.array_to_string(d.datacl, e'\\n') as \"access privileges\"\nfrom pg_catalog.pg_database d\norder by 1;" {
-		return true, h.query(ConvertedQuery{
-			String:       `SELECT SCHEMA_NAME AS 'Name', 'postgres' AS 'Owner', 'UTF8' AS 'Encoding', 'English_United States.1252' AS 'Collate', 'English_United States.1252' AS 'Ctype', '' AS 'ICU Locale', 'libc' AS 'Locale Provider', '' AS 'Access privileges' FROM INFORMATION_SCHEMA.SCHEMATA ORDER BY 1;`,
-			StatementTag: "SELECT",
-		})
-	}
-	// Command: \l on psql 16
-	if statement == "select\n  d.datname as \"name\",\n  pg_catalog.pg_get_userbyid(d.datdba) as \"owner\",\n  pg_catalog.pg_encoding_to_char(d.encoding) as \"encoding\",\n  case d.datlocprovider when 'c' then 'libc' when 'i' then 'icu' end as \"locale provider\",\n  d.datcollate as \"collate\",\n  d.datctype as \"ctype\",\n  d.daticulocale as \"icu locale\",\n  null as \"icu rules\",\n  pg_catalog.array_to_string(d.datacl, e'\\n') as \"access privileges\"\nfrom pg_catalog.pg_database d\norder by 1;" {
-		return true, h.query(ConvertedQuery{
-			String:       `SELECT SCHEMA_NAME AS 'Name', 'postgres' AS 'Owner', 'UTF8' AS 'Encoding', 'English_United States.1252' AS 'Collate', 'English_United States.1252' AS 'Ctype', '' AS 'ICU Locale', 'libc' AS 'Locale Provider', '' AS 'Access privileges' FROM INFORMATION_SCHEMA.SCHEMATA ORDER BY 1;`,
-			StatementTag: "SELECT",
-		})
-	}
-	// Command: \dt
-	if statement == "select n.nspname as \"schema\",\n  c.relname as \"name\",\n  case c.relkind when 'r' then 'table' when 'v' then 'view' when 'm' then 'materialized view' when 'i' then 'index' when 's' then 'sequence' when 't' then 'toast table' when 'f' then 'foreign table' when 'p' then 'partitioned table' when 'i' then 'partitioned index' end as \"type\",\n  pg_catalog.pg_get_userbyid(c.relowner) as \"owner\"\nfrom pg_catalog.pg_class c\n     left join pg_catalog.pg_namespace n on n.oid = c.relnamespace\n     left join pg_catalog.pg_am am on am.oid = c.relam\nwhere c.relkind in ('r','p','')\n      and n.nspname <> 'pg_catalog'\n      and n.nspname !~ '^pg_toast'\n      and n.nspname <> 'information_schema'\n  and pg_catalog.pg_table_is_visible(c.oid)\norder by 1,2;" {
-		return true, h.query(ConvertedQuery{
-			String:       `SELECT 'public' AS 'Schema', TABLE_NAME AS 'Name', 'table' AS 'Type', 'postgres' AS 'Owner' FROM INFORMATION_SCHEMA.TABLES WHERE TABLE_SCHEMA = database() AND TABLE_TYPE = 'BASE TABLE' ORDER BY 2;`,
-			StatementTag: "SELECT",
-		})
-	}
-	// Command: \d
-	if statement == "select n.nspname as \"schema\",\n  c.relname as \"name\",\n  case c.relkind when 'r' then 'table' when 'v' then 'view' when 'm' then 'materialized view' when 'i' then 'index' when 's' then 'sequence' when 't' then 'toast table' when 'f' then 'foreign table' when 'p' then 'partitioned table' when 'i' then 'partitioned index' end as \"type\",\n  pg_catalog.pg_get_userbyid(c.relowner) as \"owner\"\nfrom pg_catalog.pg_class c\n     left join pg_catalog.pg_namespace n on n.oid = c.relnamespace\n     left join pg_catalog.pg_am am on am.oid = c.relam\nwhere c.relkind in ('r','p','v','m','s','f','')\n      and n.nspname <> 'pg_catalog'\n      and n.nspname !~ '^pg_toast'\n      and n.nspname <> 'information_schema'\n  and pg_catalog.pg_table_is_visible(c.oid)\norder by 1,2;" {
-		return true, h.query(ConvertedQuery{
-			String:       `SELECT 'public' AS 'Schema', TABLE_NAME AS 'Name', 'table' AS 'Type', 'postgres' AS 'Owner' FROM INFORMATION_SCHEMA.TABLES WHERE TABLE_SCHEMA = database() AND TABLE_TYPE = 'BASE TABLE' ORDER BY 2;`,
-			StatementTag: "SELECT",
-		})
-	}
-	// Alternate \d for psql 14
-	if statement == "select n.nspname as \"schema\",\n  c.relname as \"name\",\n  case c.relkind when 'r' then 'table' when 'v' then 'view' when 'm' then 'materialized view' when 'i' then 'index' when 's' then 'sequence' when 's' then 'special' when 't' then 'toast table' when 'f' then 'foreign table' when 'p' then 'partitioned table' when 'i' then 'partitioned index' end as \"type\",\n  pg_catalog.pg_get_userbyid(c.relowner) as \"owner\"\nfrom pg_catalog.pg_class c\n     left join pg_catalog.pg_namespace n on n.oid = c.relnamespace\n     left join pg_catalog.pg_am am on am.oid = c.relam\nwhere c.relkind in ('r','p','v','m','s','f','')\n      and n.nspname <> 'pg_catalog'\n      and n.nspname !~ '^pg_toast'\n      and n.nspname <> 'information_schema'\n  and pg_catalog.pg_table_is_visible(c.oid)\norder by 1,2;" {
-		return true, h.query(ConvertedQuery{
-			String:       `SELECT 'public' AS 'Schema', TABLE_NAME AS 'Name', 'table' AS 'Type', 'postgres' AS 'Owner' FROM INFORMATION_SCHEMA.TABLES WHERE TABLE_SCHEMA = database() AND TABLE_TYPE = 'BASE TABLE' ORDER BY 2;`,
-			StatementTag: "SELECT",
-		})
-	}
-	// Command: \d table_name
-	if strings.HasPrefix(statement, "select c.oid,\n  n.nspname,\n  c.relname\nfrom pg_catalog.pg_class c\n     left join pg_catalog.pg_namespace n on n.oid = c.relnamespace\nwhere c.relname operator(pg_catalog.~) '^(") && strings.HasSuffix(statement, ")$' collate pg_catalog.default\n  and pg_catalog.pg_table_is_visible(c.oid)\norder by 2, 3;") {
-		// There are >at least< 15 separate statements sent for this command, which is far too much to validate and
-		// implement, so we'll just return an error for now
-		return true, fmt.Errorf("PSQL command not yet supported")
-	}
-	// Command: \dn
-	if statement == "select n.nspname as \"name\",\n  pg_catalog.pg_get_userbyid(n.nspowner) as \"owner\"\nfrom pg_catalog.pg_namespace n\nwhere n.nspname !~ '^pg_' and n.nspname <> 'information_schema'\norder by 1;" {
-		return true, h.query(ConvertedQuery{
-			String:       `SELECT 'public' AS 'Name', 'pg_database_owner' AS 'Owner';`,
-			StatementTag: "SELECT",
-		})
-	}
-	// Command: \df
-	if statement == "select n.nspname as \"schema\",\n  p.proname as \"name\",\n  pg_catalog.pg_get_function_result(p.oid) as \"result data type\",\n  pg_catalog.pg_get_function_arguments(p.oid) as \"argument data types\",\n case p.prokind\n  when 'a' then 'agg'\n  when 'w' then 'window'\n  when 'p' then 'proc'\n  else 'func'\n end as \"type\"\nfrom pg_catalog.pg_proc p\n     left join pg_catalog.pg_namespace n on n.oid = p.pronamespace\nwhere pg_catalog.pg_function_is_visible(p.oid)\n      and n.nspname <> 'pg_catalog'\n      and n.nspname <> 'information_schema'\norder by 1, 2, 4;" {
-		return true, h.query(ConvertedQuery{
-			String:       `SELECT '' AS 'Schema', '' AS 'Name', '' AS 'Result data type', '' AS 'Argument data types', '' AS 'Type' FROM dual LIMIT 0;`,
-			StatementTag: "SELECT",
-		})
-	}
-	// Command: \dv
-	if statement == "select n.nspname as \"schema\",\n  c.relname as \"name\",\n  case c.relkind when 'r' then 'table' when 'v' then 'view' when 'm' then 'materialized view' when 'i' then 'index' when 's' then 'sequence' when 't' then 'toast table' when 'f' then 'foreign table' when 'p' then 'partitioned table' when 'i' then 'partitioned index' end as \"type\",\n  pg_catalog.pg_get_userbyid(c.relowner) as \"owner\"\nfrom pg_catalog.pg_class c\n     left join pg_catalog.pg_namespace n on n.oid = c.relnamespace\nwhere c.relkind in ('v','')\n      and n.nspname <> 'pg_catalog'\n      and n.nspname !~ '^pg_toast'\n      and n.nspname <> 'information_schema'\n  and pg_catalog.pg_table_is_visible(c.oid)\norder by 1,2;" {
-		return true, h.query(ConvertedQuery{
-			String:       `SELECT 'public' AS 'Schema', TABLE_NAME AS 'Name', 'view' AS 'Type', 'postgres' AS 'Owner' FROM INFORMATION_SCHEMA.TABLES WHERE TABLE_SCHEMA = database() AND TABLE_TYPE = 'VIEW' ORDER BY 2;`,
-			StatementTag: "SELECT",
-		})
-	}
-	// Command: \du
-	if statement == "select r.rolname, r.rolsuper, r.rolinherit,\n  r.rolcreaterole, r.rolcreatedb, r.rolcanlogin,\n  r.rolconnlimit, r.rolvaliduntil,\n  array(select b.rolname\n        from pg_catalog.pg_auth_members m\n        join pg_catalog.pg_roles b on (m.roleid = b.oid)\n        where m.member = r.oid) as memberof\n, r.rolreplication\n, r.rolbypassrls\nfrom pg_catalog.pg_roles r\nwhere r.rolname !~ '^pg_'\norder by 1;" {
-		// We don't support users yet, so we'll just return nothing for now
-		return true, h.query(ConvertedQuery{
-			String:       `SELECT '' FROM dual LIMIT 0;`,
-			StatementTag: "SELECT",
-		})
-	}
-	return false, nil
-}
-
 // endOfMessages should be called from HandleConnection or a function within HandleConnection. This represents the end
 // of the message slice, which may occur naturally (all relevant response messages have been sent) or on error. Once
 // endOfMessages has been called, no further messages should be sent, and the connection loop should wait for the next
-// query. A nil error should be provided if this is being called naturally.
-func (h *ConnectionHandler) endOfMessages(err error) {
+// query. A nil error should be provided if this is being called naturally, while |failed| indicates that an error has
+// already been sent, such as when an extended query failed before the client sent its Sync.
+func (h *ConnectionHandler) endOfMessages(err error, failed bool) {
 	if err != nil {
-		h.sendError(h.Conn(), err)
+		h.sendNoticesAndError(err)
+		failed = true
+	} else if noticeErr := h.sendNotices(); noticeErr != nil {
+		panic(noticeErr)
+	}
+	if txErr := h.endImplicitTransaction(!failed); txErr != nil {
+		h.sendNoticesAndError(txErr)
+		failed = true
+	}
+	indicator := messages.ReadyForQueryTransactionIndicator_TransactionBlock
+	if !h.inTransaction {
+		// Outside of a transaction block, each Sync or Query ends an implicit transaction, which destroys its portals,
+		// delivers its notifications, and releases its locks
+		indicator = messages.ReadyForQueryTransactionIndicator_Idle
+		h.closePortals(!failed)
+		h.endTransactionNotifications(!failed)
+		locks.ReleaseTransaction(h.mysqlConn.ConnectionID)
+	}
+	// Postgres reports changed parameters immediately before ReadyForQuery
+	if h.parametersChanged {
+		h.parametersChanged = false
+		if reportErr := h.reportParameterStatus(); reportErr != nil {
+			panic(reportErr)
+		}
+		h.loadTimeouts()
+		h.loadTransactionCharacteristics()
+		h.loadStatementLogging()
+		h.loadTransactionCommit()
+		h.loadClientMinMessages()
+		h.loadQueryHints()
 	}
 	if sendErr := connection.Send(h.Conn(), messages.ReadyForQuery{
-		Indicator: messages.ReadyForQueryTransactionIndicator_Idle,
+		Indicator: indicator,
 	}); sendErr != nil {
 		// We panic here for the same reason as above.
 		panic(sendErr)
 	}
+	h.setIdle(!h.inTransaction)
+}
+
+// sendNoticesAndError sends every pending notice followed by the given error. Notices are sent before the error, as they
+// were raised before the error occurred.
+func (h *ConnectionHandler) sendNoticesAndError(err error) {
+	if noticeErr := h.sendNotices(); noticeErr != nil {
+		panic(noticeErr)
+	}
+	h.sendError(h.Conn(), err)
 }
 
 // sendError sends the given error to the client. This should generally never be called directly.
 func (h *ConnectionHandler) sendError(conn net.Conn, err error) {
-	fmt.Println(err.Error())
-	if sendErr := connection.Send(conn, messages.ErrorResponse{
-		Severity:     messages.ErrorResponseSeverity_Error,
-		SqlStateCode: "XX000", // internal_error for now
-		Message:      err.Error(),
-	}); sendErr != nil {
+	pgErr := pgerrors.ForSession(h.mysqlConn.ConnectionID, err)
+	if cancelMessage := h.queryCanceled.Swap(nil); cancelMessage != nil {
+		pgErr = pgerrors.New(pgcode.QueryCanceled, *cancelMessage)
+	} else if isLockTimeout(err) {
+		pgErr = pgerrors.New(pgcode.QueryCanceled, locks.ErrLockTimeout.Message)
+	}
+	response := pgErr.ToMessage()
+	// Errors caused by the client, such as constraint violations and syntax errors, are expected during normal operation,
+	// so only internal errors (the SQLSTATE class XX) are logged as errors.
+	logger := h.logger().WithError(err).WithField("sqlstate", response.SqlStateCode)
+	if strings.HasPrefix(response.SqlStateCode, "XX") {
+		logger.Error("Error sent to client")
+	} else {
+		logger.Debug("Error sent to client")
+	}
+	response.Optional.Where = strings.Join(pgerrors.TakeContext(h.mysqlConn.ConnectionID), "\n")
+	telemetry.Error(response.SqlStateCode)
+	if sendErr := connection.Send(conn, response); sendErr != nil {
 		// If we're unable to send anything to the connection, then there's something wrong with the connection and
 		// we should terminate it. This will be caught in HandleConnection's defer block.
 		panic(sendErr)
 	}
 }
 
+// sendNotices sends every notice that has been raised by the connection's session.
+func (h *ConnectionHandler) sendNotices() error {
+	for _, notice := range notices.Take(h.mysqlConn.ConnectionID) {
+		if err := connection.Send(h.Conn(), notice.ToMessage()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // convertQuery takes the given Postgres query, and converts it as an ast.ConvertedQuery that will work with the handler.
 func (h *ConnectionHandler) convertQuery(query string) (ConvertedQuery, error) {
 	s, err := parser.Parse(query)
@@ -881,12 +1492,20 @@ func (h *ConnectionHandler) convertQuery(query string) (ConvertedQuery, error) {
 		return ConvertedQuery{
 			String:       s[0].AST.String(),
 			StatementTag: stmtTag,
+			LogClass:     logging.ClassifyStatement(s[0].AST),
+			AuditClass:   auditClass(s[0].AST, nil),
 		}, nil
 	}
+	hintedAST, ignoredHints := ast.ApplyQueryHints(query, vitessAST)
 	return ConvertedQuery{
-		String:       query,
-		AST:          vitessAST,
-		StatementTag: stmtTag,
+		String:             query,
+		AST:                hintedAST,
+		IgnoredHints:       ignoredHints,
+		StatementTag:       stmtTag,
+		Fingerprint:        killswitch.Fingerprint(s[0].AST),
+		LogClass:           logging.ClassifyStatement(s[0].AST),
+		AuditClass:         auditClass(s[0].AST, vitessAST),
+		NoTransactionBlock: noTransactionBlock(s[0].AST),
 	}, nil
 }
 
@@ -908,10 +1527,85 @@ func (h *ConnectionHandler) getPlanAndFields(query ConvertedQuery) (sql.Node, []
 	if !ok {
 		return nil, nil, fmt.Errorf("expected a sql.Node, got %T", parsedQuery)
 	}
+	// The engine describes an INSERT, UPDATE, or DELETE using the rows that it writes, but only the number of rows is
+	// returned, so we drop the fields to describe them as an OK result
+	if isDataModification(plan) {
+		fields = nil
+	}
+	// The engine only returns fields for statements that return an OK result, so we build the fields ourselves for
+	// statements that return rows, which a Describe of the prepared statement needs.
+	if fields == nil && len(plan.Schema()) > 0 && !types.IsOkResultSchema(plan.Schema()) && !isDataModification(plan) {
+		// User-defined functions are resolved by the analyzer, so their types are unknown until the plan is analyzed. The
+		// plan can only be analyzed here when it has no parameters, otherwise the types are reported as unknown.
+		if hasUntypedColumns(plan.Schema()) {
+			_, fields, _ = h.handler.(mysql.ExtendedHandler).ComBind(h.mysqlConn, query.String, query.AST, &mysql.PrepareData{
+				PrepareStmt: query.String,
+			})
+		}
+		if fields == nil {
+			fields = schemaToFields(sql.NewEmptyContext(), plan.Schema())
+		}
+	}
 
 	return plan, fields, nil
 }
 
+// isDataModification returns whether the given plan is an INSERT, UPDATE, or DELETE without a RETURNING clause. The
+// schemas of these nodes describe the rows that they write, while their results only contain the number of rows.
+func isDataModification(node sql.Node) bool {
+	switch node.(type) {
+	case *plan.InsertInto, *plan.Update, *plan.DeleteFrom, *plan.RowUpdateAccumulator:
+		return true
+	default:
+		return false
+	}
+}
+
+// hasUntypedColumns returns whether any column in the given schema does not yet have a type.
+func hasUntypedColumns(sch sql.Schema) bool {
+	for _, col := range sch {
+		if col.Type == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// schemaToFields returns the fields that describe the given schema, matching the fields that the engine returns when a
+// portal is bound. Columns that do not yet have a type are described as unknown.
+func schemaToFields(ctx *sql.Context, sch sql.Schema) []*querypb.Field {
+	fields := make([]*querypb.Field, len(sch))
+	for i, col := range sch {
+		if col.Type == nil {
+			col = col.Copy()
+			col.Type = pgtypes.Unknown
+		}
+		charset := uint32(sql.Collation_Default.CharacterSet())
+		if collatedType, ok := col.Type.(sql.TypeWithCollation); ok {
+			charset = uint32(collatedType.Collation().CharacterSet())
+		}
+		if types.IsBinaryType(col.Type) {
+			charset = uint32(sql.Collation_binary)
+		}
+		fields[i] = &querypb.Field{
+			Name:         col.Name,
+			OrgName:      col.Name,
+			Table:        col.Source,
+			OrgTable:     col.Source,
+			Database:     col.DatabaseSource,
+			Type:         col.Type.Type(),
+			Charset:      charset,
+			ColumnLength: col.Type.MaxTextResponseByteLength(ctx),
+		}
+		if decimalType, ok := col.Type.(sql.DecimalType); ok {
+			fields[i].Decimals = uint32(decimalType.Scale())
+		} else if datetimeType, ok := col.Type.(sql.DatetimeType); ok {
+			fields[i].Decimals = uint32(datetimeType.Precision())
+		}
+	}
+	return fields
+}
+
 // comQuery is a shortcut that determines which version of ComQuery to call based on whether the query has been parsed.
 func (h *ConnectionHandler) comQuery(query ConvertedQuery, callback func(res *sqltypes.Result, more bool) error) error {
 	if query.AST == nil {
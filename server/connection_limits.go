@@ -0,0 +1,109 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/dolthub/doltgresql/postgres/connection"
+	"github.com/dolthub/doltgresql/postgres/messages"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+// connectionLimits tracks the open connections of the server, so that connections beyond the configured limits may be
+// turned away. A limit of zero means that there is no limit.
+type connectionLimits struct {
+	mutex          sync.Mutex
+	maxConnections uint64
+	maxPerUser     map[string]uint64
+	maxPerDatabase map[string]uint64
+	total          uint64
+	users          map[string]uint64
+	databases      map[string]uint64
+}
+
+// newConnectionLimits returns the connection limits from the given config.
+func newConnectionLimits(cfg *servercfg.DoltgresConfig) *connectionLimits {
+	return &connectionLimits{
+		maxConnections: cfg.MaxConnections(),
+		maxPerUser:     cfg.MaxConnectionsPerUser(),
+		maxPerDatabase: cfg.MaxConnectionsPerDatabase(),
+		users:          make(map[string]uint64),
+		databases:      make(map[string]uint64),
+	}
+}
+
+// acquire reserves a connection for the given user and database. The role limit is the connection limit of the user's
+// role, with a negative limit meaning that there is no limit. Returns an error if doing so would exceed a limit.
+// Otherwise, the returned function must be called once the connection has closed.
+func (cl *connectionLimits) acquire(user string, database string, roleLimit int32) (release func(), err error) {
+	cl.mutex.Lock()
+	defer cl.mutex.Unlock()
+	if cl.maxConnections > 0 && cl.total >= cl.maxConnections {
+		return nil, fmt.Errorf("sorry, too many clients already")
+	}
+	if limit, ok := cl.maxPerDatabase[database]; ok && cl.databases[database] >= limit {
+		return nil, fmt.Errorf(`too many connections for database "%s"`, database)
+	}
+	if limit, ok := cl.maxPerUser[user]; (ok && cl.users[user] >= limit) || (roleLimit >= 0 && cl.users[user] >= uint64(roleLimit)) {
+		return nil, fmt.Errorf(`too many connections for role "%s"`, user)
+	}
+	cl.total++
+	cl.users[user]++
+	cl.databases[database]++
+	return func() {
+		cl.mutex.Lock()
+		defer cl.mutex.Unlock()
+		cl.total--
+		if cl.users[user]--; cl.users[user] == 0 {
+			delete(cl.users, user)
+		}
+		if cl.databases[database]--; cl.databases[database] == 0 {
+			delete(cl.databases, database)
+		}
+	}, nil
+}
+
+// acquireConnectionSlot reserves the connection against the server's limits, sending the client an error if a limit
+// has been reached. The returned function must be called once the connection has closed.
+func (h *ConnectionHandler) acquireConnectionSlot(startupMessage messages.StartupMessage) (func(), error) {
+	if h.connectionLimits == nil {
+		return func() {}, nil
+	}
+	database := startupMessage.Parameters["database"]
+	if len(database) == 0 {
+		database = h.mysqlConn.User
+	}
+	// As with Postgres, the connection limit of a role does not apply to superusers
+	roleLimit := int32(-1)
+	if role, ok := auth.GetRole(h.mysqlConn.User); ok && !role.IsSuperUser {
+		roleLimit = role.ConnectionLimit
+	}
+	release, err := h.connectionLimits.acquire(h.mysqlConn.User, database, roleLimit)
+	if err != nil {
+		_ = connection.Send(h.Conn(), messages.ErrorResponse{
+			Severity:     messages.ErrorResponseSeverity_Fatal,
+			SqlStateCode: "53300",
+			Message:      err.Error(),
+			Optional: messages.ErrorResponseOptionalFields{
+				Routine: "InitPostgres",
+			},
+		})
+		return nil, err
+	}
+	return release, nil
+}
@@ -15,9 +15,15 @@
 package server
 
 import (
+	"time"
+
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/vitess/go/sqltypes"
 	querypb "github.com/dolthub/vitess/go/vt/proto/query"
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/server/audit"
+	"github.com/dolthub/doltgresql/server/logging"
 )
 
 // ConvertedQuery represents a query that has been converted from the Postgres representation to the Vitess
@@ -28,12 +34,31 @@ type ConvertedQuery struct {
 	String       string
 	AST          vitess.Statement
 	StatementTag string
+	// Fingerprint identifies the query regardless of its constants, which is used by the kill switch.
+	Fingerprint string
+	// LogClass is the class of the query as used by the log_statement parameter.
+	LogClass logging.StatementClass
+	// AuditClass is the class of the query as used by the audit log, which is empty when the query is not audited.
+	AuditClass audit.Class
+	// IgnoredHints are the pg_hint_plan hints in the query's hint comment that the planner cannot honor.
+	IgnoredHints []string
+	// NoTransactionBlock is the name of the statement when it may not run inside a transaction block, such as
+	// CREATE INDEX CONCURRENTLY, and is empty otherwise.
+	NoTransactionBlock string
 }
 
 type PreparedStatementData struct {
 	Query        ConvertedQuery
 	ReturnFields []*querypb.Field
 	BindVarTypes []int32
+	// Statement is the query string that created the prepared statement, which is the PREPARE statement itself for
+	// statements that were created using PREPARE. Empty when it matches the query.
+	Statement   string
+	PrepareTime time.Time
+	// FromSQL is set for statements that were created using PREPARE, rather than a Parse message.
+	FromSQL bool
+	// Executions is the number of times that the statement has been bound.
+	Executions int64
 }
 
 type PortalData struct {
@@ -41,4 +66,30 @@ type PortalData struct {
 	IsEmptyQuery bool
 	Fields       []*querypb.Field
 	BoundPlan    sql.Node
+	CreationTime time.Time
+	// Results is set once the portal has been executed with a row limit, and is nil otherwise.
+	Results *PortalResults
+	// Cursor is set for portals that were created by a DECLARE statement, and is nil otherwise.
+	Cursor *PortalCursor
+}
+
+// PortalResults holds the rows of a portal that was executed with a row limit. The rows that have not yet been sent are
+// retained, so that later Execute messages may resume the portal where the previous one was suspended.
+type PortalResults struct {
+	Rows [][]sqltypes.Value
+}
+
+// PortalCursor holds every row of a cursor that was created by a DECLARE statement, along with the cursor's position.
+// Matching Postgres, the position is zero when the cursor is before the first row, and one more than the number of rows
+// when the cursor is after the last row.
+type PortalCursor struct {
+	// Statement is the DECLARE statement that created the cursor.
+	Statement  string
+	Rows       [][]sqltypes.Value
+	Position   int64
+	Scrollable bool
+	Hold       bool
+	// Committed is set once the transaction that created a holdable cursor has committed, as the cursor is no longer
+	// destroyed when a later transaction is rolled back.
+	Committed bool
 }
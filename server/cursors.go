@@ -0,0 +1,261 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"time"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	querypb "github.com/dolthub/vitess/go/vt/proto/query"
+
+	"github.com/dolthub/doltgresql/postgres/connection"
+	"github.com/dolthub/doltgresql/postgres/messages"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// handleDeclareCursor handles the DECLARE statement. The cursor's query is run to completion, and its rows are held by a
+// portal with the cursor's name, so that they may be fetched by FETCH statements and Execute messages.
+func (h *ConnectionHandler) handleDeclareCursor(query ConvertedQuery, declare *pgnodes.DeclareCursor) error {
+	if _, ok := h.portals[declare.Name()]; ok {
+		return pgerrors.Newf(pgcode.DuplicateCursor, `cursor "%s" already exists`, declare.Name())
+	}
+	if !declare.Hold() && !h.inTransaction {
+		return pgerrors.New(pgcode.NoActiveSQLTransaction, "DECLARE CURSOR can only be used in transaction blocks")
+	}
+	cursorQuery, err := h.convertQuery(declare.Query())
+	if err != nil {
+		return err
+	}
+	cursor := &PortalCursor{
+		Statement:  query.String,
+		Scrollable: declare.Scrollable(),
+		Hold:       declare.Hold(),
+	}
+	var fields []*querypb.Field
+	err = h.comQuery(cursorQuery, func(res *sqltypes.Result, more bool) error {
+		if fields == nil {
+			fields = res.Fields
+		}
+		cursor.Rows = append(cursor.Rows, res.Rows...)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	h.portals[declare.Name()] = PortalData{
+		Query:        cursorQuery,
+		Fields:       fields,
+		CreationTime: time.Now(),
+		Cursor:       cursor,
+	}
+	return connection.Send(h.Conn(), messages.CommandComplete{
+		Query: query.String,
+		Tag:   query.StatementTag,
+	})
+}
+
+// handleFetchCursor handles the FETCH and MOVE statements, which move the cursor and report how many rows were moved
+// over. FETCH also sends those rows, where the row description is only sent when |isExecute| is false, as an Execute
+// message relies on an earlier Describe message for it.
+func (h *ConnectionHandler) handleFetchCursor(query ConvertedQuery, fetch *pgnodes.FetchCursor, isExecute bool) error {
+	portalData, err := h.getCursor(fetch.Name())
+	if err != nil {
+		return err
+	}
+	rows, err := portalData.Cursor.move(fetch.FetchType(), fetch.Count())
+	if err != nil {
+		return err
+	}
+	if !fetch.IsMove() {
+		if !isExecute {
+			if err = connection.Send(h.Conn(), messages.RowDescription{
+				Fields: portalData.Fields,
+			}); err != nil {
+				return err
+			}
+		}
+		if err = h.sendCursorRows(rows); err != nil {
+			return err
+		}
+	}
+	return connection.Send(h.Conn(), messages.CommandComplete{
+		Query: query.String,
+		Tag:   query.StatementTag,
+		Rows:  int32(len(rows)),
+	})
+}
+
+// handleExecuteCursor handles an Execute message that targets a cursor, which fetches up to |rowMax| rows from the
+// cursor's current position, or every remaining row when |rowMax| is zero. This behaves in the same way as
+// sendPortalRows, so a PortalSuspended message is sent whenever the limit is reached.
+func (h *ConnectionHandler) handleExecuteCursor(portalData PortalData, rowMax int32) error {
+	fetchType, count := tree.FetchAll, int64(0)
+	if rowMax > 0 {
+		fetchType, count = tree.FetchNormal, int64(rowMax)
+	}
+	rows, err := portalData.Cursor.move(fetchType, count)
+	if err != nil {
+		return err
+	}
+	if err = h.sendCursorRows(rows); err != nil {
+		return err
+	}
+	if rowMax > 0 && int(rowMax) == len(rows) {
+		return connection.Send(h.Conn(), messages.PortalSuspended{})
+	}
+	return connection.Send(h.Conn(), messages.CommandComplete{
+		Query: portalData.Query.String,
+		Tag:   portalData.Query.StatementTag,
+		Rows:  int32(len(rows)),
+	})
+}
+
+// handleCloseCursor handles the CLOSE statement. CLOSE ALL closes every portal, including those that were created by
+// Bind messages, matching Postgres.
+func (h *ConnectionHandler) handleCloseCursor(query ConvertedQuery, closeCursor *pgnodes.CloseCursor) error {
+	if len(closeCursor.Name()) == 0 {
+		clear(h.portals)
+	} else if _, ok := h.portals[closeCursor.Name()]; ok {
+		delete(h.portals, closeCursor.Name())
+	} else {
+		return pgerrors.Newf(pgcode.InvalidCursorName, `cursor "%s" does not exist`, closeCursor.Name())
+	}
+	return connection.Send(h.Conn(), messages.CommandComplete{
+		Query: query.String,
+		Tag:   query.StatementTag,
+	})
+}
+
+// getCursor returns the portal of the cursor with the given name.
+func (h *ConnectionHandler) getCursor(name string) (PortalData, error) {
+	portalData, ok := h.portals[name]
+	if !ok || portalData.Cursor == nil {
+		return PortalData{}, pgerrors.Newf(pgcode.InvalidCursorName, `cursor "%s" does not exist`, name)
+	}
+	return portalData, nil
+}
+
+// sendCursorRows sends a DataRow message for each of the given rows.
+func (h *ConnectionHandler) sendCursorRows(rows [][]sqltypes.Value) error {
+	for _, row := range rows {
+		if err := connection.Send(h.Conn(), messages.DataRow{
+			Values: row,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// closePortals destroys the portals of a transaction that has ended. Cursors that were declared WITH HOLD survive the
+// commit of the transaction that created them, but are destroyed if that transaction is rolled back.
+func (h *ConnectionHandler) closePortals(committed bool) {
+	for name, portalData := range h.portals {
+		if cursor := portalData.Cursor; cursor != nil && cursor.Hold && (committed || cursor.Committed) {
+			cursor.Committed = true
+			continue
+		}
+		delete(h.portals, name)
+	}
+}
+
+// move moves the cursor as described by a FETCH or MOVE statement, returning the rows that the cursor fetches. Moving
+// by a count returns every row that is moved over, in the order that they're moved over, while moving to a specific
+// row only returns that row. Moving beyond either end leaves the cursor before the first row or after the last row.
+func (c *PortalCursor) move(fetchType tree.FetchType, count int64) ([][]sqltypes.Value, error) {
+	// Counts beyond the number of rows behave the same as moving past the end, which also avoids overflowing
+	limit := int64(len(c.Rows)) + 1
+	count = max(min(count, limit), -limit)
+	switch fetchType {
+	case tree.FetchNormal:
+		if count == 0 {
+			// A count of zero fetches the current row again
+			return c.moveTo(c.Position)
+		}
+		return c.moveBy(count)
+	case tree.FetchRelative:
+		return c.moveTo(c.Position + count)
+	case tree.FetchAbsolute:
+		if count < 0 {
+			// Negative positions count backward from the last row
+			count = limit + count
+		}
+		return c.moveTo(count)
+	case tree.FetchFirst:
+		return c.moveTo(1)
+	case tree.FetchLast:
+		return c.moveTo(limit - 1)
+	case tree.FetchAll:
+		return c.moveBy(limit)
+	case tree.FetchBackwardAll:
+		return c.moveBy(-limit)
+	default:
+		return nil, pgerrors.Newf(pgcode.FeatureNotSupported, "unsupported fetch type: %d", fetchType)
+	}
+}
+
+// moveBy moves the cursor forward by the given number of rows, or backward when the count is negative, returning every
+// row that was moved over.
+func (c *PortalCursor) moveBy(count int64) ([][]sqltypes.Value, error) {
+	rowCount := int64(len(c.Rows))
+	if count > 0 {
+		start, end := min(c.Position, rowCount), min(c.Position+count, rowCount)
+		rows := c.Rows[start:end]
+		c.Position = min(c.Position+count, rowCount+1)
+		return rows, nil
+	}
+	if err := c.checkScrollable(); err != nil {
+		return nil, err
+	}
+	var rows [][]sqltypes.Value
+	for position := c.Position - 1; position >= 1 && position >= c.Position+count; position-- {
+		rows = append(rows, c.Rows[position-1])
+	}
+	c.Position = max(c.Position+count, 0)
+	return rows, nil
+}
+
+// moveTo moves the cursor to the given position, returning the row at that position if there is one.
+func (c *PortalCursor) moveTo(position int64) ([][]sqltypes.Value, error) {
+	rowCount := int64(len(c.Rows))
+	// Returning to the current row requires moving backward as well
+	if position < c.Position || (position == c.Position && position >= 1 && position <= rowCount) {
+		if err := c.checkScrollable(); err != nil {
+			return nil, err
+		}
+	}
+	if position <= 0 {
+		c.Position = 0
+		return nil, nil
+	}
+	if position > rowCount {
+		c.Position = rowCount + 1
+		return nil, nil
+	}
+	c.Position = position
+	return [][]sqltypes.Value{c.Rows[position-1]}, nil
+}
+
+// checkScrollable returns an error when the cursor cannot move backward.
+func (c *PortalCursor) checkScrollable() error {
+	if !c.Scrollable {
+		return pgerrors.New(pgcode.ObjectNotInPrerequisiteState, "cursor can only scan forward").
+			WithHint("Declare it with SCROLL option to enable backward scan.")
+	}
+	return nil
+}
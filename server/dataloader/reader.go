@@ -0,0 +1,335 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataloader
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Format is the format of the data that is being loaded.
+type Format uint8
+
+const (
+	Format_Text Format = iota
+	Format_CSV
+	Format_Binary
+)
+
+// Options contains the options that control how data is read. Options should be created using NewOptions, as the
+// defaults differ between formats.
+type Options struct {
+	Format    Format
+	Header    bool
+	Delimiter byte
+	Null      string
+	Quote     byte
+	Escape    byte
+}
+
+// NewOptions returns the default Options for the given format.
+func NewOptions(format Format) Options {
+	switch format {
+	case Format_Binary:
+		return Options{Format: Format_Binary}
+	case Format_CSV:
+		return Options{
+			Format:    Format_CSV,
+			Delimiter: ',',
+			Null:      "",
+			Quote:     '"',
+			Escape:    '"',
+		}
+	default:
+		return Options{
+			Format:    Format_Text,
+			Delimiter: '\t',
+			Null:      `\N`,
+		}
+	}
+}
+
+// Validate returns an error if the options cannot be used together.
+func (opts Options) Validate() error {
+	if opts.Format == Format_Binary {
+		// The binary format does not make use of any of the other options
+		return nil
+	}
+	if opts.Delimiter == '\n' || opts.Delimiter == '\r' {
+		return errors.New("COPY delimiter cannot be newline or carriage return")
+	}
+	if strings.ContainsAny(opts.Null, "\r\n") {
+		return errors.New("COPY null representation cannot use newline or carriage return")
+	}
+	if opts.Format == Format_Text && opts.Delimiter == '\\' {
+		return errors.New(`COPY delimiter cannot be "\"`)
+	}
+	if strings.IndexByte(opts.Null, opts.Delimiter) >= 0 {
+		return errors.New("COPY delimiter must not appear in the NULL specification")
+	}
+	if opts.Format == Format_CSV {
+		if opts.Delimiter == opts.Quote {
+			return errors.New("COPY delimiter and quote must be different")
+		}
+		if strings.IndexByte(opts.Null, opts.Quote) >= 0 {
+			return errors.New("CSV quote character must not appear in the NULL specification")
+		}
+	}
+	return nil
+}
+
+// Reader reads rows from COPY data. Each row is returned as a slice of fields, where a nil field represents NULL.
+type Reader struct {
+	reader        *bufio.Reader
+	opts          Options
+	line          int
+	skippedHeader bool
+	done          bool
+}
+
+// NewReader returns a new *Reader that reads rows from the given io.Reader.
+func NewReader(r io.Reader, opts Options) (*Reader, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if opts.Format == Format_Binary {
+		return nil, errors.New("reading the binary COPY format is not yet supported")
+	}
+	return &Reader{
+		reader: bufio.NewReader(r),
+		opts:   opts,
+	}, nil
+}
+
+// Line returns the line number of the most recently read row. For CSV data, a row may span multiple lines, in which
+// case this returns the line that the row ended on.
+func (r *Reader) Line() int {
+	return r.line
+}
+
+// Next returns the next row. Returns io.EOF once all rows have been read, which includes reaching the end-of-data
+// marker.
+func (r *Reader) Next() ([]*string, error) {
+	for {
+		if r.done {
+			return nil, io.EOF
+		}
+		var row []*string
+		var err error
+		if r.opts.Format == Format_CSV {
+			row, err = r.nextCSV()
+		} else {
+			row, err = r.nextText()
+		}
+		if err != nil {
+			if err == io.EOF {
+				r.done = true
+			}
+			return nil, err
+		}
+		// The header is always the first row, and its contents are ignored
+		if r.opts.Header && !r.skippedHeader {
+			r.skippedHeader = true
+			continue
+		}
+		return row, nil
+	}
+}
+
+// readLine returns the next line without its line terminator. Returns io.EOF when there are no more lines.
+func (r *Reader) readLine() (string, error) {
+	line, err := r.reader.ReadString('\n')
+	if err != nil && (err != io.EOF || len(line) == 0) {
+		return "", err
+	}
+	r.line++
+	line = strings.TrimSuffix(line, "\n")
+	line = strings.TrimSuffix(line, "\r")
+	return line, nil
+}
+
+// nextText reads a row that is in the text format.
+func (r *Reader) nextText() ([]*string, error) {
+	line, err := r.readLine()
+	if err != nil {
+		return nil, err
+	}
+	if line == `\.` {
+		return nil, io.EOF
+	}
+	var row []*string
+	start := 0
+	for i := 0; i <= len(line); i++ {
+		if i < len(line) && line[i] == '\\' {
+			// Escaped characters never end a field, even when they're the delimiter
+			i++
+			continue
+		}
+		if i == len(line) || line[i] == r.opts.Delimiter {
+			// An escape at the very end of the line may have pushed us past the end
+			rawField := line[start:min(i, len(line))]
+			if rawField == r.opts.Null {
+				row = append(row, nil)
+			} else {
+				field := unescapeText(rawField)
+				row = append(row, &field)
+			}
+			start = i + 1
+		}
+	}
+	return row, nil
+}
+
+// unescapeText converts the backslash escape sequences that are allowed in the text format.
+func unescapeText(field string) string {
+	if strings.IndexByte(field, '\\') == -1 {
+		return field
+	}
+	sb := strings.Builder{}
+	sb.Grow(len(field))
+	for i := 0; i < len(field); i++ {
+		if field[i] != '\\' || i+1 == len(field) {
+			sb.WriteByte(field[i])
+			continue
+		}
+		i++
+		switch c := field[i]; c {
+		case 'b':
+			sb.WriteByte('\b')
+		case 'f':
+			sb.WriteByte('\f')
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 't':
+			sb.WriteByte('\t')
+		case 'v':
+			sb.WriteByte('\v')
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			val := c - '0'
+			for j := 0; j < 2 && i+1 < len(field) && field[i+1] >= '0' && field[i+1] <= '7'; j++ {
+				i++
+				val = val*8 + (field[i] - '0')
+			}
+			sb.WriteByte(val)
+		case 'x':
+			val, digits := byte(0), 0
+			for ; digits < 2 && i+1 < len(field) && isHexDigit(field[i+1]); digits++ {
+				i++
+				val = val*16 + hexValue(field[i])
+			}
+			if digits == 0 {
+				// A lone "\x" is just the letter x
+				sb.WriteByte('x')
+			} else {
+				sb.WriteByte(val)
+			}
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+// nextCSV reads a row that is in the CSV format. Quoted fields may contain newlines, so a single row may span multiple
+// lines.
+func (r *Reader) nextCSV() ([]*string, error) {
+	line, err := r.readLine()
+	if err != nil {
+		return nil, err
+	}
+	if line == `\.` {
+		return nil, io.EOF
+	}
+	var row []*string
+	field := bytes.Buffer{}
+	quoted := false
+	inQuotes := false
+	for i := 0; ; i++ {
+		if i == len(line) {
+			if !inQuotes {
+				break
+			}
+			// The quoted field continues onto the next line
+			nextLine, err := r.readLine()
+			if err == io.EOF {
+				return nil, fmt.Errorf("unterminated CSV quoted field (line %d)", r.line)
+			} else if err != nil {
+				return nil, err
+			}
+			field.WriteByte('\n')
+			line = nextLine
+			i = -1
+			continue
+		}
+		c := line[i]
+		if inQuotes {
+			if c == r.opts.Escape && i+1 < len(line) && (line[i+1] == r.opts.Quote || line[i+1] == r.opts.Escape) {
+				i++
+				field.WriteByte(line[i])
+			} else if c == r.opts.Quote {
+				inQuotes = false
+			} else {
+				field.WriteByte(c)
+			}
+			continue
+		}
+		switch c {
+		case r.opts.Quote:
+			inQuotes = true
+			quoted = true
+		case r.opts.Delimiter:
+			row = append(row, r.csvField(&field, quoted))
+			quoted = false
+		default:
+			field.WriteByte(c)
+		}
+	}
+	row = append(row, r.csvField(&field, quoted))
+	return row, nil
+}
+
+// csvField returns the contents of the buffer as a field, and resets the buffer. Only unquoted fields may match the
+// NULL string.
+func (r *Reader) csvField(buffer *bytes.Buffer, quoted bool) *string {
+	str := buffer.String()
+	buffer.Reset()
+	if !quoted && str == r.opts.Null {
+		return nil
+	}
+	return &str
+}
+
+// isHexDigit returns whether the given character is a hexadecimal digit.
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+// hexValue returns the value of the given hexadecimal digit.
+func hexValue(c byte) byte {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0'
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10
+	default:
+		return c - 'A' + 10
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataloader
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// binarySignature is the signature that begins all data in the binary format.
+var binarySignature = []byte("PGCOPY\n\377\r\n\000")
+
+// Writer formats rows as COPY data. Each method returns the bytes that should be sent, which are only valid until the
+// next call.
+type Writer struct {
+	opts   Options
+	buffer bytes.Buffer
+}
+
+// NewWriter returns a new *Writer that formats rows according to the given options.
+func NewWriter(opts Options) (*Writer, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return &Writer{opts: opts}, nil
+}
+
+// Begin returns the data that precedes all rows. For the binary format this is the file header, while the text and CSV
+// formats return a header row containing the column names when one was requested.
+func (w *Writer) Begin(columnNames []string) []byte {
+	w.buffer.Reset()
+	switch {
+	case w.opts.Format == Format_Binary:
+		w.buffer.Write(binarySignature)
+		// Both the flags field and the header extension length are zero
+		w.buffer.Write(make([]byte, 8))
+	case w.opts.Header:
+		fields := make([][]byte, len(columnNames))
+		for i, name := range columnNames {
+			fields[i] = []byte(name)
+		}
+		w.writeRow(fields)
+	}
+	return w.buffer.Bytes()
+}
+
+// Row returns the given row as COPY data. A nil field represents NULL, so empty values must be non-nil. For the text
+// and CSV formats, each field must be in its text representation. For the binary format, each field must be in its
+// binary representation.
+func (w *Writer) Row(fields [][]byte) []byte {
+	w.buffer.Reset()
+	w.writeRow(fields)
+	return w.buffer.Bytes()
+}
+
+// End returns the data that follows all rows.
+func (w *Writer) End() []byte {
+	w.buffer.Reset()
+	if w.opts.Format == Format_Binary {
+		// The trailer is a field count of -1
+		w.buffer.Write([]byte{0xFF, 0xFF})
+	}
+	return w.buffer.Bytes()
+}
+
+// writeRow writes the given row to the buffer.
+func (w *Writer) writeRow(fields [][]byte) {
+	if w.opts.Format == Format_Binary {
+		w.buffer.Write(binary.BigEndian.AppendUint16(nil, uint16(len(fields))))
+		for _, field := range fields {
+			if field == nil {
+				w.buffer.Write(binary.BigEndian.AppendUint32(nil, 0xFFFFFFFF))
+				continue
+			}
+			w.buffer.Write(binary.BigEndian.AppendUint32(nil, uint32(len(field))))
+			w.buffer.Write(field)
+		}
+		return
+	}
+	for i, field := range fields {
+		if i > 0 {
+			w.buffer.WriteByte(w.opts.Delimiter)
+		}
+		switch {
+		case field == nil:
+			w.buffer.WriteString(w.opts.Null)
+		case w.opts.Format == Format_CSV:
+			w.writeCSVField(field, len(fields) == 1)
+		default:
+			w.writeTextField(field)
+		}
+	}
+	w.buffer.WriteByte('\n')
+}
+
+// writeTextField writes the field using the escape sequences of the text format.
+func (w *Writer) writeTextField(field []byte) {
+	for _, c := range field {
+		switch c {
+		case '\b':
+			w.buffer.WriteString(`\b`)
+		case '\f':
+			w.buffer.WriteString(`\f`)
+		case '\n':
+			w.buffer.WriteString(`\n`)
+		case '\r':
+			w.buffer.WriteString(`\r`)
+		case '\t':
+			w.buffer.WriteString(`\t`)
+		case '\v':
+			w.buffer.WriteString(`\v`)
+		case '\\', w.opts.Delimiter:
+			w.buffer.WriteByte('\\')
+			w.buffer.WriteByte(c)
+		default:
+			w.buffer.WriteByte(c)
+		}
+	}
+}
+
+// writeCSVField writes the field, quoting it when it would otherwise be ambiguous. A lone field of "\." must be quoted
+// so that it is not mistaken for the end-of-data marker.
+func (w *Writer) writeCSVField(field []byte, onlyField bool) {
+	needsQuotes := string(field) == w.opts.Null || (onlyField && string(field) == `\.`)
+	for i := 0; i < len(field) && !needsQuotes; i++ {
+		switch field[i] {
+		case w.opts.Delimiter, w.opts.Quote, '\n', '\r':
+			needsQuotes = true
+		}
+	}
+	if !needsQuotes {
+		w.buffer.Write(field)
+		return
+	}
+	w.buffer.WriteByte(w.opts.Quote)
+	for _, c := range field {
+		if c == w.opts.Quote || c == w.opts.Escape {
+			w.buffer.WriteByte(w.opts.Escape)
+		}
+		w.buffer.WriteByte(c)
+	}
+	w.buffer.WriteByte(w.opts.Quote)
+}
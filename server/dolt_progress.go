@@ -0,0 +1,128 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/sirupsen/logrus"
+
+	pgexprs "github.com/dolthub/doltgresql/server/expression"
+	"github.com/dolthub/doltgresql/server/procedures"
+)
+
+// doltOperation tracks the execution of a long-running Dolt procedure. The procedure raises its own progress notices,
+// which are sent to the client while the operation is executing, rather than once the statement has completed.
+type doltOperation struct {
+	handler   *ConnectionHandler
+	procedure string
+	start     time.Time
+	done      chan struct{}
+	wg        sync.WaitGroup
+}
+
+// startDoltOperation begins tracking the given statement if it calls a long-running Dolt procedure, either directly or
+// as a function. Returns nil if the statement is not tracked. The returned operation must be finished once the statement
+// has completed.
+func (h *ConnectionHandler) startDoltOperation(stmt sqlparser.Statement) *doltOperation {
+	procedure := longRunningDoltProcedure(stmt)
+	if len(procedure) == 0 {
+		return nil
+	}
+	op := &doltOperation{
+		handler:   h,
+		procedure: procedure,
+		start:     time.Now(),
+		done:      make(chan struct{}),
+	}
+	op.wg.Add(1)
+	go op.sendNotices()
+	return op
+}
+
+// longRunningDoltProcedure returns the name of the long-running Dolt procedure that the given statement calls, or an
+// empty string if it does not call one.
+func longRunningDoltProcedure(stmt sqlparser.Statement) string {
+	return calledProcedure(stmt, procedures.LongRunningProcedures)
+}
+
+// calledProcedure returns the name of the first procedure from the given set that the statement calls, either directly
+// or as a function. Returns an empty string if the statement does not call any of them.
+func calledProcedure(stmt sqlparser.Statement, procedureNames map[string]struct{}) string {
+	if call, ok := stmt.(*sqlparser.Call); ok {
+		procedure := strings.ToLower(call.ProcName.Name.String())
+		if _, ok = procedureNames[procedure]; ok {
+			return procedure
+		}
+		return ""
+	}
+	// Procedures that are called as functions, such as SELECT dolt_push('origin', 'main'), are resolved by the analyzer
+	procedure := ""
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		injected, ok := node.(sqlparser.InjectedExpr)
+		if !ok {
+			return true, nil
+		}
+		if function, ok := injected.Expression.(*pgexprs.UnresolvedFunction); ok {
+			name := strings.ToLower(function.Name())
+			if _, ok = procedureNames[name]; ok {
+				procedure = name
+				return false, errFoundProcedure
+			}
+		}
+		return true, nil
+	}, stmt)
+	return procedure
+}
+
+// errFoundProcedure stops the walk of a statement once a procedure has been found.
+var errFoundProcedure = errors.New("found procedure")
+
+// sendNotices periodically sends the notices that have been raised by the operation until it has finished.
+func (op *doltOperation) sendNotices() {
+	defer op.wg.Done()
+	ticker := time.NewTicker(procedures.DoltProgressInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-op.done:
+			return
+		case <-ticker.C:
+			if err := op.handler.sendNotices(); err != nil {
+				logrus.WithError(err).Warn("unable to send progress notice")
+			}
+		}
+	}
+}
+
+// finish stops sending notices, and logs the outcome of the operation. Any notices that remain are sent along with
+// the statement's results.
+func (op *doltOperation) finish(err error) {
+	close(op.done)
+	op.wg.Wait()
+	fields := logrus.Fields{
+		"procedure": op.procedure,
+		"duration":  time.Since(op.start).Round(time.Millisecond),
+	}
+	if err != nil {
+		logrus.WithFields(fields).WithError(err).Info("Dolt operation failed")
+		return
+	}
+	logrus.WithFields(fields).Info("Dolt operation completed")
+}
@@ -0,0 +1,211 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+	"github.com/dolthub/dolt/go/libraries/utils/svcs"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+// Server is a Doltgres server that may be embedded within a Go application, such as a test harness. A Server is created
+// with NewServer, and runs from the call to Start until the call to Stop.
+//
+// The engine and the services that it relies on are process-wide, so only a single Server may run at a time.
+type Server struct {
+	cfg    *servercfg.DoltgresConfig
+	dEnv   *env.DoltEnv
+	logger *logrus.Logger
+
+	mu         sync.Mutex
+	controller *svcs.Controller
+	addr       net.Addr
+	ready      chan struct{}
+	stopped    bool
+}
+
+// ServerOption configures a Server.
+type ServerOption func(*Server)
+
+// WithLogger sends the server's log output to the given logger. The engine logs through logrus' standard logger, so the
+// standard logger takes on the output, formatter, level, and hooks of the given logger while the server is running. The
+// log level of the config takes precedence over the logger's level when one has been set.
+func WithLogger(logger *logrus.Logger) ServerOption {
+	return func(s *Server) {
+		s.logger = logger
+	}
+}
+
+// WithDoltEnv runs the server using the given environment, rather than loading one from the local disk. This has no
+// effect when the server runs in memory.
+func WithDoltEnv(dEnv *env.DoltEnv) ServerOption {
+	return func(s *Server) {
+		s.dEnv = dEnv
+	}
+}
+
+// NewServer returns a Server that runs using the given config, which is not modified. The server runs in memory when
+// in_memory has been set in the config, and on disk otherwise. A port of 0 chooses an unused port, which may be
+// retrieved using Port once the server is ready.
+func NewServer(cfg *servercfg.DoltgresConfig, opts ...ServerOption) (*Server, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("a config is required to create a server")
+	}
+	// The listener config is modified when choosing a port, so it's copied along with the config
+	cfgCopy := *cfg
+	if cfg.ListenerConfig != nil {
+		listenerConfig := *cfg.ListenerConfig
+		cfgCopy.ListenerConfig = &listenerConfig
+	}
+	s := &Server{
+		cfg:   &cfgCopy,
+		ready: make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+// Start starts the server, returning once it's ready to accept connections. The server stops when the context is
+// canceled, or when Stop is called.
+func (s *Server) Start(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.controller != nil || s.stopped {
+		return fmt.Errorf("the server has already been started")
+	}
+	// The listener is bound here so that the address is known once the server has started, and the config is given the
+	// bound port, as the server connects to itself using the configured port
+	listener, err := net.Listen("tcp", net.JoinHostPort(s.cfg.Host(), strconv.Itoa(s.cfg.Port())))
+	if err != nil {
+		return err
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+	if s.cfg.ListenerConfig == nil {
+		s.cfg.ListenerConfig = &servercfg.DoltgresListenerConfig{}
+	}
+	s.cfg.ListenerConfig.PortNumber = &port
+	if s.logger != nil {
+		s.useLogger()
+	}
+
+	var controller *svcs.Controller
+	if s.cfg.InMemory() {
+		controller, err = runInMemory(s.cfg, listener)
+	} else {
+		dEnv := s.dEnv
+		if dEnv == nil {
+			// The environment is reloaded from the data directory when the server starts
+			dEnv = env.Load(ctx, env.GetCurrentUserHomeDir, filesys.LocalFS, doltdb.LocalDirDoltDB, Version)
+		}
+		controller, err = runServer(ctx, s.cfg, dEnv, false, listener)
+	}
+	if err != nil {
+		_ = listener.Close()
+		return err
+	}
+	s.controller = controller
+	s.addr = listener.Addr()
+	if ctx.Done() != nil {
+		stopped := make(chan struct{})
+		go func() {
+			_ = controller.WaitForStop()
+			close(stopped)
+		}()
+		go func() {
+			select {
+			case <-ctx.Done():
+				controller.Stop()
+			case <-stopped:
+			}
+		}()
+	}
+	close(s.ready)
+	return nil
+}
+
+// Ready returns a channel that is closed once the server is ready to accept connections.
+func (s *Server) Ready() <-chan struct{} {
+	return s.ready
+}
+
+// Addr returns the address that the server is listening on. Returns nil if the server is not ready.
+func (s *Server) Addr() net.Addr {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addr
+}
+
+// Port returns the port that the server is listening on, which is useful when the port was chosen by the server.
+// Returns 0 if the server is not ready.
+func (s *Server) Port() int {
+	if tcpAddr, ok := s.Addr().(*net.TCPAddr); ok {
+		return tcpAddr.Port
+	}
+	return 0
+}
+
+// Stop stops the server, returning once it has stopped. Calling Stop on a server that has already stopped does nothing.
+func (s *Server) Stop() error {
+	s.mu.Lock()
+	controller := s.controller
+	alreadyStopped := s.stopped
+	s.stopped = true
+	s.mu.Unlock()
+	if controller == nil || alreadyStopped {
+		return nil
+	}
+	controller.Stop()
+	return controller.WaitForStop()
+}
+
+// Wait blocks until the server stops, returning any error that caused it to stop.
+func (s *Server) Wait() error {
+	s.mu.Lock()
+	controller := s.controller
+	s.mu.Unlock()
+	if controller == nil {
+		return fmt.Errorf("the server has not been started")
+	}
+	return controller.WaitForStop()
+}
+
+// useLogger sends the output of the standard logger, along with the output of the CLI, to the server's logger. The
+// engine points the standard logger at the CLI's error output while starting, so both share the logger's output.
+func (s *Server) useLogger() {
+	cli.CliOut = s.logger.Out
+	cli.CliErr = s.logger.Out
+	standardLogger := logrus.StandardLogger()
+	standardLogger.SetOutput(s.logger.Out)
+	standardLogger.SetFormatter(s.logger.Formatter)
+	standardLogger.SetLevel(s.logger.GetLevel())
+	standardLogger.ReplaceHooks(s.logger.Hooks)
+	if s.cfg.LogLevelStr == nil {
+		level := s.logger.GetLevel().String()
+		s.cfg.LogLevelStr = &level
+	}
+}
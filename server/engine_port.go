@@ -0,0 +1,71 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+// +build !windows
+
+package server
+
+import (
+	"net"
+
+	"golang.org/x/sys/unix"
+)
+
+// reserveEnginePort reserves an unused port on the given host for the engine's own listener, returning the port along
+// with a function that releases the reservation. The engine binds its listener using SO_REUSEPORT, so the port is held
+// by a socket that sets the same option and is bound without listening. This allows the engine to bind the port, while
+// any other socket is refused until the reservation is released.
+func reserveEnginePort(host string) (port int, release func(), err error) {
+	addr, err := net.ResolveTCPAddr("tcp", net.JoinHostPort(host, "0"))
+	if err != nil {
+		return 0, nil, err
+	}
+	family := unix.AF_INET
+	var sockaddr unix.Sockaddr = &unix.SockaddrInet4{}
+	if ip4 := addr.IP.To4(); ip4 != nil {
+		copy(sockaddr.(*unix.SockaddrInet4).Addr[:], ip4)
+	} else if len(addr.IP) == net.IPv6len {
+		family = unix.AF_INET6
+		sockaddr = &unix.SockaddrInet6{}
+		copy(sockaddr.(*unix.SockaddrInet6).Addr[:], addr.IP)
+	}
+	fd, err := unix.Socket(family, unix.SOCK_STREAM, 0)
+	if err != nil {
+		return 0, nil, err
+	}
+	release = func() {
+		_ = unix.Close(fd)
+	}
+	if err = unix.SetsockoptInt(fd, unix.SOL_SOCKET, unix.SO_REUSEADDR, 1); err == nil {
+		err = unix.SetsockoptInt(fd, unix.SOL_SOCKET, unix.SO_REUSEPORT, 1)
+	}
+	if err == nil {
+		err = unix.Bind(fd, sockaddr)
+	}
+	if err == nil {
+		sockaddr, err = unix.Getsockname(fd)
+	}
+	if err != nil {
+		release()
+		return 0, nil, err
+	}
+	switch sockaddr := sockaddr.(type) {
+	case *unix.SockaddrInet4:
+		port = sockaddr.Port
+	case *unix.SockaddrInet6:
+		port = sockaddr.Port
+	}
+	return port, release, nil
+}
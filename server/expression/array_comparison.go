@@ -0,0 +1,190 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// ArrayComparison represents a VALUE OPERATOR ANY(ARRAY) or VALUE OPERATOR ALL(ARRAY) expression.
+type ArrayComparison struct {
+	operator string
+	all      bool
+	left     sql.Expression
+	right    sql.Expression
+}
+
+var _ vitess.Injectable = (*ArrayComparison)(nil)
+var _ sql.Expression = (*ArrayComparison)(nil)
+
+// NewArrayComparison returns a new *ArrayComparison. The operator is one of the comparison operators from Vitess, and
+// `all` determines whether every element must satisfy the comparison, rather than at least one.
+func NewArrayComparison(operator string, all bool) *ArrayComparison {
+	return &ArrayComparison{
+		operator: operator,
+		all:      all,
+		left:     nil,
+		right:    nil,
+	}
+}
+
+// Children implements the sql.Expression interface.
+func (ac *ArrayComparison) Children() []sql.Expression {
+	return []sql.Expression{ac.left, ac.right}
+}
+
+// Eval implements the sql.Expression interface.
+func (ac *ArrayComparison) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	left, err := ac.left.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	rightInterface, err := ac.right.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if left == nil || rightInterface == nil {
+		return nil, nil
+	}
+	rightValues, ok := rightInterface.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%T: expected right child to return `%T` but returned `%T`", ac, []any{}, rightInterface)
+	}
+	leftType := ac.left.Type().(pgtypes.DoltgresType)
+	rightType := ac.right.Type().(pgtypes.DoltgresArrayType).BaseType()
+	var castFunc framework.TypeCastFunction
+	if !leftType.Equals(rightType) {
+		castFunc = framework.GetImplicitCast(rightType.BaseID(), leftType.BaseID())
+	}
+	// Following the rules of boolean logic, a NULL element only matters when no other element decides the result
+	sawNull := false
+	for _, rightValue := range rightValues {
+		if rightValue == nil {
+			sawNull = true
+			continue
+		}
+		if castFunc != nil {
+			rightValue, err = castFunc(ctx, rightValue, leftType)
+			if err != nil {
+				return nil, err
+			}
+		}
+		res, err := leftType.Compare(left, rightValue)
+		if err != nil {
+			return nil, err
+		}
+		if ac.satisfies(res) != ac.all {
+			return !ac.all, nil
+		}
+	}
+	if sawNull {
+		return nil, nil
+	}
+	return ac.all, nil
+}
+
+// IsNullable implements the sql.Expression interface.
+func (ac *ArrayComparison) IsNullable() bool {
+	return true
+}
+
+// Resolved implements the sql.Expression interface.
+func (ac *ArrayComparison) Resolved() bool {
+	return ac.left != nil && ac.left.Resolved() && ac.right != nil && ac.right.Resolved()
+}
+
+// String implements the sql.Expression interface.
+func (ac *ArrayComparison) String() string {
+	quantifier := "ANY"
+	if ac.all {
+		quantifier = "ALL"
+	}
+	if ac.left == nil || ac.right == nil {
+		return fmt.Sprintf("? %s %s(?)", ac.operator, quantifier)
+	}
+	return fmt.Sprintf("%s %s %s(%s)", ac.left.String(), ac.operator, quantifier, ac.right.String())
+}
+
+// Type implements the sql.Expression interface.
+func (ac *ArrayComparison) Type() sql.Type {
+	return pgtypes.Bool
+}
+
+// WithChildren implements the sql.Expression interface.
+func (ac *ArrayComparison) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(ac, len(children), 2)
+	}
+	leftType, ok := children[0].Type().(pgtypes.DoltgresType)
+	if !ok {
+		return nil, fmt.Errorf("%T: GMS type `%s` on left child", ac, children[0].Type().String())
+	}
+	rightType, ok := children[1].Type().(pgtypes.DoltgresArrayType)
+	if !ok {
+		return nil, fmt.Errorf("op ANY/ALL (array) requires array on right side")
+	}
+	if baseType := rightType.BaseType(); !leftType.Equals(baseType) &&
+		framework.GetImplicitCast(baseType.BaseID(), leftType.BaseID()) == nil {
+		return nil, fmt.Errorf("operator does not exist: %s %s %s", leftType.String(), ac.operator, baseType.String())
+	}
+	return &ArrayComparison{
+		operator: ac.operator,
+		all:      ac.all,
+		left:     children[0],
+		right:    children[1],
+	}, nil
+}
+
+// WithResolvedChildren implements the vitess.InjectableExpression interface.
+func (ac *ArrayComparison) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 2 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `2` but got `%d`", len(children))
+	}
+	left, ok := children[0].(sql.Expression)
+	if !ok {
+		return nil, fmt.Errorf("expected vitess child to be an expression but has type `%T`", children[0])
+	}
+	right, ok := children[1].(sql.Expression)
+	if !ok {
+		return nil, fmt.Errorf("expected vitess child to be an expression but has type `%T`", children[1])
+	}
+	return ac.WithChildren(left, right)
+}
+
+// satisfies returns whether the result of comparing the left value to an element satisfies the operator.
+func (ac *ArrayComparison) satisfies(res int) bool {
+	switch ac.operator {
+	case vitess.EqualStr:
+		return res == 0
+	case vitess.NotEqualStr:
+		return res != 0
+	case vitess.LessThanStr:
+		return res < 0
+	case vitess.LessEqualStr:
+		return res <= 0
+	case vitess.GreaterThanStr:
+		return res > 0
+	case vitess.GreaterEqualStr:
+		return res >= 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// ArrayFlatten represents an ARRAY(subquery) expression, which returns an array containing the value of every row that
+// the subquery returns.
+type ArrayFlatten struct {
+	subquery *plan.Subquery
+}
+
+var _ vitess.Injectable = (*ArrayFlatten)(nil)
+var _ sql.Expression = (*ArrayFlatten)(nil)
+
+// NewArrayFlatten returns a new *ArrayFlatten.
+func NewArrayFlatten() *ArrayFlatten {
+	return &ArrayFlatten{subquery: nil}
+}
+
+// Children implements the sql.Expression interface.
+func (af *ArrayFlatten) Children() []sql.Expression {
+	return []sql.Expression{af.subquery}
+}
+
+// Eval implements the sql.Expression interface.
+func (af *ArrayFlatten) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	if len(af.subquery.Query.Schema()) != 1 {
+		return nil, fmt.Errorf("subquery must return only one column")
+	}
+	values, err := af.subquery.EvalMultiple(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	subqueryType := af.subquery.Type()
+	if _, ok := subqueryType.(pgtypes.DoltgresType); ok {
+		return values, nil
+	}
+	// Values of GMS types are converted to the values of their closest Doltgres type
+	for i := range values {
+		values[i], err = NewGMSCast(expression.NewLiteral(values[i], subqueryType)).Eval(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// IsNullable implements the sql.Expression interface.
+func (af *ArrayFlatten) IsNullable() bool {
+	return false
+}
+
+// Resolved implements the sql.Expression interface.
+func (af *ArrayFlatten) Resolved() bool {
+	return af.subquery != nil && af.subquery.Resolved()
+}
+
+// String implements the sql.Expression interface.
+func (af *ArrayFlatten) String() string {
+	if af.subquery == nil {
+		return "ARRAY(...)"
+	}
+	return fmt.Sprintf("ARRAY(%s)", af.subquery.String())
+}
+
+// Type implements the sql.Expression interface.
+func (af *ArrayFlatten) Type() sql.Type {
+	// GMS replaces subqueries with placeholders when it builds the names of the projected columns, and placeholders do
+	// not have a type
+	if _, ok := af.subquery.Query.(*plan.StrExpr); ok {
+		return pgtypes.Unknown
+	}
+	subqueryType := af.subquery.Type()
+	if doltgresType, ok := subqueryType.(pgtypes.DoltgresType); ok {
+		return doltgresType.ToArrayType()
+	}
+	return NewGMSCast(expression.NewLiteral(nil, subqueryType)).DoltgresType().ToArrayType()
+}
+
+// WithChildren implements the sql.Expression interface.
+func (af *ArrayFlatten) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(af, len(children), 1)
+	}
+	subquery, ok := children[0].(*plan.Subquery)
+	if !ok {
+		return nil, fmt.Errorf("ARRAY expected a subquery but received `%T`", children[0])
+	}
+	return &ArrayFlatten{subquery: subquery}, nil
+}
+
+// WithResolvedChildren implements the vitess.InjectableExpression interface.
+func (af *ArrayFlatten) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 1 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `1` but got `%d`", len(children))
+	}
+	subquery, ok := children[0].(*plan.Subquery)
+	if !ok {
+		return nil, fmt.Errorf("expected vitess child to be a subquery but has type `%T`", children[0])
+	}
+	return &ArrayFlatten{subquery: subquery}, nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// DataModification is the condition of the SELECT that an INSERT, UPDATE, or DELETE with a RETURNING clause is
+// converted to. The SELECT reads from the table that the statement writes to, and its rows are replaced during analysis
+// with the rows that the statement writes, at which point this condition is removed. The children are the parameters of
+// the statement, so that they are bound alongside the parameters of the SELECT.
+type DataModification struct {
+	statement  vitess.Statement
+	query      string
+	parameters []string
+	children   []sql.Expression
+}
+
+var _ vitess.Injectable = (*DataModification)(nil)
+var _ sql.Expression = (*DataModification)(nil)
+
+// NewDataModification returns a new *DataModification for the given statement. The parameters are the names of the
+// statement's bind variables, which are given as the children using WithResolvedChildren.
+func NewDataModification(statement vitess.Statement, query string, parameters []string) *DataModification {
+	return &DataModification{
+		statement:  statement,
+		query:      query,
+		parameters: parameters,
+	}
+}
+
+// Bindings returns the values of the statement's parameters that have been bound. Parameters that have not been bound
+// are excluded.
+func (d *DataModification) Bindings() map[string]sql.Expression {
+	bindings := make(map[string]sql.Expression)
+	for i, child := range d.children {
+		if _, ok := child.(*expression.BindVar); !ok {
+			bindings[d.parameters[i]] = child
+		}
+	}
+	return bindings
+}
+
+// Children implements the sql.Expression interface.
+func (d *DataModification) Children() []sql.Expression {
+	return d.children
+}
+
+// Eval implements the sql.Expression interface.
+func (d *DataModification) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	return nil, fmt.Errorf("RETURNING clause was not replaced during analysis")
+}
+
+// IsNullable implements the sql.Expression interface.
+func (d *DataModification) IsNullable() bool {
+	return false
+}
+
+// Query returns the text of the statement.
+func (d *DataModification) Query() string {
+	return d.query
+}
+
+// Resolved implements the sql.Expression interface.
+func (d *DataModification) Resolved() bool {
+	for _, child := range d.children {
+		if !child.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// Statement returns the statement that writes the rows.
+func (d *DataModification) Statement() vitess.Statement {
+	return d.statement
+}
+
+// String implements the sql.Expression interface.
+func (d *DataModification) String() string {
+	return d.query
+}
+
+// Type implements the sql.Expression interface.
+func (d *DataModification) Type() sql.Type {
+	return pgtypes.Bool
+}
+
+// WithChildren implements the sql.Expression interface.
+func (d *DataModification) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(d.parameters) {
+		return nil, sql.ErrInvalidChildrenNumber.New(d, len(children), len(d.parameters))
+	}
+	nd := *d
+	nd.children = children
+	return &nd, nil
+}
+
+// WithResolvedChildren implements the vitess.InjectableExpression interface.
+func (d *DataModification) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != len(d.parameters) {
+		return nil, fmt.Errorf("invalid vitess child count, expected `%d` but got `%d`", len(d.parameters), len(children))
+	}
+	expressions := make([]sql.Expression, len(children))
+	for i, child := range children {
+		var ok bool
+		if expressions[i], ok = child.(sql.Expression); !ok {
+			return nil, fmt.Errorf("expected vitess child to be an expression but has type `%T`", child)
+		}
+	}
+	return d.WithChildren(expressions...)
+}
@@ -0,0 +1,118 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// DistinctOn holds the expressions of a DISTINCT ON clause. It is added as the last ORDER BY expression, so that its
+// expressions are resolved in the same way as the others, and evaluates to the values of its expressions. The sort
+// field is removed during analysis, and its values are used to keep the first row of each distinct group.
+type DistinctOn struct {
+	children []sql.Expression
+}
+
+var _ vitess.Injectable = (*DistinctOn)(nil)
+var _ sql.Expression = (*DistinctOn)(nil)
+
+// NewDistinctOn returns a new *DistinctOn.
+func NewDistinctOn() *DistinctOn {
+	return &DistinctOn{}
+}
+
+// Children implements the sql.Expression interface.
+func (d *DistinctOn) Children() []sql.Expression {
+	return d.children
+}
+
+// Eval implements the sql.Expression interface.
+func (d *DistinctOn) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	values := make(sql.Row, len(d.children))
+	for i, child := range d.children {
+		val, err := child.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = val
+	}
+	return values, nil
+}
+
+// IsNullable implements the sql.Expression interface.
+func (d *DistinctOn) IsNullable() bool {
+	return false
+}
+
+// Resolved implements the sql.Expression interface.
+func (d *DistinctOn) Resolved() bool {
+	for _, child := range d.children {
+		if child == nil || !child.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// String implements the sql.Expression interface.
+func (d *DistinctOn) String() string {
+	sb := strings.Builder{}
+	sb.WriteString("DISTINCT ON (")
+	for i, child := range d.children {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		if child == nil {
+			sb.WriteString("...")
+		} else {
+			sb.WriteString(child.String())
+		}
+	}
+	sb.WriteRune(')')
+	return sb.String()
+}
+
+// Type implements the sql.Expression interface.
+func (d *DistinctOn) Type() sql.Type {
+	return pgtypes.Record
+}
+
+// WithChildren implements the sql.Expression interface.
+func (d *DistinctOn) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return &DistinctOn{
+		children: children,
+	}, nil
+}
+
+// WithResolvedChildren implements the vitess.InjectableExpression interface.
+func (d *DistinctOn) WithResolvedChildren(children []any) (any, error) {
+	newExpressions := make([]sql.Expression, len(children))
+	for i, resolvedChild := range children {
+		resolvedExpression, ok := resolvedChild.(sql.Expression)
+		if !ok {
+			return nil, fmt.Errorf("expected vitess child to be an expression but has type `%T`", resolvedChild)
+		}
+		newExpressions[i] = resolvedExpression
+	}
+	return &DistinctOn{
+		children: newExpressions,
+	}, nil
+}
@@ -114,6 +114,9 @@ func (c *ExplicitCast) Resolved() bool {
 
 // String implements the sql.Expression interface.
 func (c *ExplicitCast) String() string {
+	if c.sqlChild == nil {
+		return "?::" + c.castToType.String()
+	}
 	return c.sqlChild.String() + "::" + c.castToType.String()
 }
 
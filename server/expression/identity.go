@@ -0,0 +1,179 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core/sequences"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// IdentityDefault is the default value of an identity column while its table is being created. It holds the options
+// of the sequence that will generate the column's values, and is replaced by a call to nextval once the sequence has
+// been named during analysis.
+type IdentityDefault struct {
+	Sequence *sequences.Sequence
+	typ      pgtypes.DoltgresType
+}
+
+var _ vitess.Injectable = (*IdentityDefault)(nil)
+var _ sql.Expression = (*IdentityDefault)(nil)
+
+// NewIdentityDefault returns a new *IdentityDefault for a column of the given type.
+func NewIdentityDefault(sequence *sequences.Sequence, typ pgtypes.DoltgresType) *IdentityDefault {
+	return &IdentityDefault{
+		Sequence: sequence,
+		typ:      typ,
+	}
+}
+
+// Children implements the sql.Expression interface.
+func (i *IdentityDefault) Children() []sql.Expression {
+	return nil
+}
+
+// Eval implements the sql.Expression interface.
+func (i *IdentityDefault) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	return nil, fmt.Errorf("identity column default was not replaced during analysis")
+}
+
+// IsNullable implements the sql.Expression interface.
+func (i *IdentityDefault) IsNullable() bool {
+	return false
+}
+
+// Resolved implements the sql.Expression interface.
+func (i *IdentityDefault) Resolved() bool {
+	return true
+}
+
+// String implements the sql.Expression interface.
+func (i *IdentityDefault) String() string {
+	if i.Sequence.Identity == sequences.Identity_Always {
+		return "GENERATED ALWAYS AS IDENTITY"
+	}
+	return "GENERATED BY DEFAULT AS IDENTITY"
+}
+
+// Type implements the sql.Expression interface.
+func (i *IdentityDefault) Type() sql.Type {
+	return i.typ
+}
+
+// WithChildren implements the sql.Expression interface.
+func (i *IdentityDefault) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(i, len(children), 0)
+	}
+	return i, nil
+}
+
+// WithResolvedChildren implements the vitess.InjectableExpression interface.
+func (i *IdentityDefault) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return i, nil
+}
+
+// OverridingKind is the kind of value that takes precedence when inserting into an identity column.
+type OverridingKind uint8
+
+const (
+	// OverridingSystemValue uses the inserted values, even for columns that are GENERATED ALWAYS.
+	OverridingSystemValue OverridingKind = iota
+	// OverridingUserValue ignores the inserted values, using the values generated by the column's sequence instead.
+	OverridingUserValue
+)
+
+// Overriding wraps a value of an INSERT that used OVERRIDING SYSTEM VALUE or OVERRIDING USER VALUE. The wrapper is
+// removed during analysis, once the identity columns of the destination table are known.
+type Overriding struct {
+	Kind  OverridingKind
+	child sql.Expression
+}
+
+var _ vitess.Injectable = (*Overriding)(nil)
+var _ sql.Expression = (*Overriding)(nil)
+
+// NewOverriding returns a new *Overriding of the given kind. The child is assigned using WithResolvedChildren.
+func NewOverriding(kind OverridingKind) *Overriding {
+	return &Overriding{Kind: kind}
+}
+
+// Child returns the inserted value.
+func (o *Overriding) Child() sql.Expression {
+	return o.child
+}
+
+// Children implements the sql.Expression interface.
+func (o *Overriding) Children() []sql.Expression {
+	return []sql.Expression{o.child}
+}
+
+// Eval implements the sql.Expression interface.
+func (o *Overriding) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	return o.child.Eval(ctx, row)
+}
+
+// IsNullable implements the sql.Expression interface.
+func (o *Overriding) IsNullable() bool {
+	return o.child.IsNullable()
+}
+
+// Resolved implements the sql.Expression interface.
+func (o *Overriding) Resolved() bool {
+	return o.child != nil && o.child.Resolved()
+}
+
+// String implements the sql.Expression interface.
+func (o *Overriding) String() string {
+	if o.child == nil {
+		return "..."
+	}
+	return o.child.String()
+}
+
+// Type implements the sql.Expression interface.
+func (o *Overriding) Type() sql.Type {
+	return o.child.Type()
+}
+
+// WithChildren implements the sql.Expression interface.
+func (o *Overriding) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(o, len(children), 1)
+	}
+	return &Overriding{
+		Kind:  o.Kind,
+		child: children[0],
+	}, nil
+}
+
+// WithResolvedChildren implements the vitess.InjectableExpression interface.
+func (o *Overriding) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 1 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `1` but got `%d`", len(children))
+	}
+	child, ok := children[0].(sql.Expression)
+	if !ok {
+		return nil, fmt.Errorf("expected vitess child to be an expression but has type `%T`", children[0])
+	}
+	return o.WithChildren(child)
+}
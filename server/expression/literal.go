@@ -23,6 +23,7 @@ import (
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 	"github.com/shopspring/decimal"
 
+	"github.com/dolthub/doltgresql/postgres/parser/duration"
 	"github.com/dolthub/doltgresql/server/functions/framework"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
@@ -136,6 +137,14 @@ func NewRawLiteralTimestamp(val time.Time) *Literal {
 	}
 }
 
+// NewRawLiteralInterval returns a new *Literal containing a duration.Duration value.
+func NewRawLiteralInterval(val duration.Duration) *Literal {
+	return &Literal{
+		value: val,
+		typ:   pgtypes.Interval,
+	}
+}
+
 // Children implements the sql.Expression interface.
 func (l *Literal) Children() []sql.Expression {
 	return nil
@@ -0,0 +1,191 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// OnConflictWhere wraps the first assignment of an INSERT ... ON CONFLICT DO UPDATE that has a WHERE clause, so that
+// the condition is resolved alongside the assignments. The wrapper is replaced by an OnConflictUpdate during analysis,
+// which evaluates the condition once for each conflicting row.
+type OnConflictWhere struct {
+	condition sql.Expression
+	child     sql.Expression
+}
+
+var _ vitess.Injectable = (*OnConflictWhere)(nil)
+var _ sql.Expression = (*OnConflictWhere)(nil)
+
+// NewOnConflictWhere returns a new *OnConflictWhere. The condition and child are assigned using WithResolvedChildren.
+func NewOnConflictWhere() *OnConflictWhere {
+	return &OnConflictWhere{}
+}
+
+// Child returns the value of the wrapped assignment.
+func (o *OnConflictWhere) Child() sql.Expression {
+	return o.child
+}
+
+// Children implements the sql.Expression interface.
+func (o *OnConflictWhere) Children() []sql.Expression {
+	return []sql.Expression{o.condition, o.child}
+}
+
+// Condition returns the condition of the WHERE clause.
+func (o *OnConflictWhere) Condition() sql.Expression {
+	return o.condition
+}
+
+// Eval implements the sql.Expression interface.
+func (o *OnConflictWhere) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	return nil, fmt.Errorf("ON CONFLICT WHERE clause was not replaced during analysis")
+}
+
+// IsNullable implements the sql.Expression interface.
+func (o *OnConflictWhere) IsNullable() bool {
+	return o.child.IsNullable()
+}
+
+// Resolved implements the sql.Expression interface.
+func (o *OnConflictWhere) Resolved() bool {
+	return o.condition != nil && o.condition.Resolved() && o.child != nil && o.child.Resolved()
+}
+
+// String implements the sql.Expression interface.
+func (o *OnConflictWhere) String() string {
+	if o.child == nil {
+		return "..."
+	}
+	return o.child.String()
+}
+
+// Type implements the sql.Expression interface.
+func (o *OnConflictWhere) Type() sql.Type {
+	return o.child.Type()
+}
+
+// WithChildren implements the sql.Expression interface.
+func (o *OnConflictWhere) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(o, len(children), 2)
+	}
+	return &OnConflictWhere{
+		condition: children[0],
+		child:     children[1],
+	}, nil
+}
+
+// WithResolvedChildren implements the vitess.InjectableExpression interface.
+func (o *OnConflictWhere) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 2 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `2` but got `%d`", len(children))
+	}
+	condition, ok := children[0].(sql.Expression)
+	if !ok {
+		return nil, fmt.Errorf("expected vitess child to be an expression but has type `%T`", children[0])
+	}
+	child, ok := children[1].(sql.Expression)
+	if !ok {
+		return nil, fmt.Errorf("expected vitess child to be an expression but has type `%T`", children[1])
+	}
+	return o.WithChildren(condition, child)
+}
+
+// OnConflictUpdate applies the assignments of an INSERT ... ON CONFLICT DO UPDATE to a conflicting row, but only when
+// the condition of its WHERE clause holds. The condition is evaluated against the row before any assignments are made.
+// Like the assignments that it contains, this evaluates to the updated row.
+type OnConflictUpdate struct {
+	condition   sql.Expression
+	assignments []sql.Expression
+}
+
+var _ sql.Expression = (*OnConflictUpdate)(nil)
+
+// NewOnConflictUpdate returns a new *OnConflictUpdate.
+func NewOnConflictUpdate(condition sql.Expression, assignments []sql.Expression) *OnConflictUpdate {
+	return &OnConflictUpdate{
+		condition:   condition,
+		assignments: assignments,
+	}
+}
+
+// Children implements the sql.Expression interface.
+func (o *OnConflictUpdate) Children() []sql.Expression {
+	return append([]sql.Expression{o.condition}, o.assignments...)
+}
+
+// Eval implements the sql.Expression interface.
+func (o *OnConflictUpdate) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	condition, err := o.condition.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if condition, ok := condition.(bool); !ok || !condition {
+		return row, nil
+	}
+	for _, assignment := range o.assignments {
+		val, err := assignment.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		var ok bool
+		if row, ok = val.(sql.Row); !ok {
+			return nil, fmt.Errorf("ON CONFLICT assignment returned `%T` rather than a row", val)
+		}
+	}
+	return row, nil
+}
+
+// IsNullable implements the sql.Expression interface.
+func (o *OnConflictUpdate) IsNullable() bool {
+	return false
+}
+
+// Resolved implements the sql.Expression interface.
+func (o *OnConflictUpdate) Resolved() bool {
+	for _, child := range o.Children() {
+		if !child.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// String implements the sql.Expression interface.
+func (o *OnConflictUpdate) String() string {
+	assignments := make([]string, len(o.assignments))
+	for i, assignment := range o.assignments {
+		assignments[i] = assignment.String()
+	}
+	return fmt.Sprintf("%s WHERE %s", strings.Join(assignments, ", "), o.condition.String())
+}
+
+// Type implements the sql.Expression interface.
+func (o *OnConflictUpdate) Type() sql.Type {
+	return o.assignments[0].Type()
+}
+
+// WithChildren implements the sql.Expression interface.
+func (o *OnConflictUpdate) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(o.assignments)+1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(o, len(children), len(o.assignments)+1)
+	}
+	return NewOnConflictUpdate(children[0], children[1:]), nil
+}
@@ -0,0 +1,121 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// Record represents a ROW(...) expression, which produces a value of the anonymous record type.
+type Record struct {
+	children []sql.Expression
+}
+
+var _ vitess.Injectable = (*Record)(nil)
+var _ sql.Expression = (*Record)(nil)
+
+// NewRecord returns a new *Record.
+func NewRecord() *Record {
+	return &Record{}
+}
+
+// Children implements the sql.Expression interface.
+func (record *Record) Children() []sql.Expression {
+	return record.children
+}
+
+// Eval implements the sql.Expression interface.
+func (record *Record) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	values := make([]pgtypes.RecordValue, len(record.children))
+	for i, expr := range record.children {
+		fieldType, ok := expr.Type().(pgtypes.DoltgresType)
+		if !ok {
+			// TODO: we need to remove GMS types from all of our expressions so that we can remove this
+			return nil, fmt.Errorf("ROW fields of type %s are not yet supported", expr.Type().String())
+		}
+		val, err := expr.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = pgtypes.RecordValue{Type: fieldType, Value: val}
+	}
+	return values, nil
+}
+
+// IsNullable implements the sql.Expression interface.
+func (record *Record) IsNullable() bool {
+	return false
+}
+
+// Resolved implements the sql.Expression interface.
+func (record *Record) Resolved() bool {
+	for _, child := range record.children {
+		if child == nil || !child.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// String implements the sql.Expression interface.
+func (record *Record) String() string {
+	sb := strings.Builder{}
+	sb.WriteString("ROW(")
+	for i, child := range record.children {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		if child == nil {
+			sb.WriteString("...")
+		} else {
+			sb.WriteString(child.String())
+		}
+	}
+	sb.WriteRune(')')
+	return sb.String()
+}
+
+// Type implements the sql.Expression interface.
+func (record *Record) Type() sql.Type {
+	return pgtypes.Record
+}
+
+// WithChildren implements the sql.Expression interface.
+func (record *Record) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return &Record{
+		children: children,
+	}, nil
+}
+
+// WithResolvedChildren implements the vitess.InjectableExpression interface.
+func (record *Record) WithResolvedChildren(children []any) (any, error) {
+	newExpressions := make([]sql.Expression, len(children))
+	for i, resolvedChild := range children {
+		resolvedExpression, ok := resolvedChild.(sql.Expression)
+		if !ok {
+			return nil, fmt.Errorf("expected vitess child to be an expression but has type `%T`", resolvedChild)
+		}
+		newExpressions[i] = resolvedExpression
+	}
+	return &Record{
+		children: newExpressions,
+	}, nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/server/locks"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// RowLocking holds the locking clauses of a SELECT statement, such as FOR UPDATE. It is added as the last ORDER BY
+// expression, so that it's carried into the plan directly above the rows that it locks. The sort field is removed during
+// analysis, and its clauses are applied to the rows that are read before they're projected.
+type RowLocking struct {
+	Clauses []RowLockingClause
+}
+
+// RowLockingClause is a single locking clause of a SELECT statement.
+type RowLockingClause struct {
+	Mode   locks.RowMode
+	Policy locks.WaitPolicy
+	// Targets are the names of the tables whose rows are locked. An empty set locks the rows of every table.
+	Targets []string
+}
+
+var _ vitess.Injectable = (*RowLocking)(nil)
+var _ sql.Expression = (*RowLocking)(nil)
+
+// NewRowLocking returns a new *RowLocking.
+func NewRowLocking(clauses []RowLockingClause) *RowLocking {
+	return &RowLocking{
+		Clauses: clauses,
+	}
+}
+
+// Children implements the sql.Expression interface.
+func (r *RowLocking) Children() []sql.Expression {
+	return nil
+}
+
+// Eval implements the sql.Expression interface.
+func (r *RowLocking) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	return nil, fmt.Errorf("%s was not applied during analysis", r.String())
+}
+
+// IsNullable implements the sql.Expression interface.
+func (r *RowLocking) IsNullable() bool {
+	return false
+}
+
+// Resolved implements the sql.Expression interface.
+func (r *RowLocking) Resolved() bool {
+	return true
+}
+
+// String implements the sql.Expression interface.
+func (r *RowLocking) String() string {
+	sb := strings.Builder{}
+	for i, clause := range r.Clauses {
+		if i > 0 {
+			sb.WriteRune(' ')
+		}
+		sb.WriteString(clause.Mode.String())
+		if len(clause.Targets) > 0 {
+			sb.WriteString(" OF ")
+			sb.WriteString(strings.Join(clause.Targets, ", "))
+		}
+		switch clause.Policy {
+		case locks.WaitSkip:
+			sb.WriteString(" SKIP LOCKED")
+		case locks.WaitError:
+			sb.WriteString(" NOWAIT")
+		}
+	}
+	return sb.String()
+}
+
+// Type implements the sql.Expression interface.
+func (r *RowLocking) Type() sql.Type {
+	return pgtypes.Bool
+}
+
+// WithChildren implements the sql.Expression interface.
+func (r *RowLocking) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), 0)
+	}
+	return r, nil
+}
+
+// WithResolvedChildren implements the vitess.InjectableExpression interface.
+func (r *RowLocking) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return r, nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// Subscript represents an ARRAY[INDEX] expression, which returns the element at the given index. Arrays begin at index
+// 1, and indexes outside of the array return NULL.
+type Subscript struct {
+	array sql.Expression
+	index sql.Expression
+}
+
+var _ vitess.Injectable = (*Subscript)(nil)
+var _ sql.Expression = (*Subscript)(nil)
+
+// NewSubscript returns a new *Subscript.
+func NewSubscript() *Subscript {
+	return &Subscript{
+		array: nil,
+		index: nil,
+	}
+}
+
+// Children implements the sql.Expression interface.
+func (s *Subscript) Children() []sql.Expression {
+	return []sql.Expression{s.array, s.index}
+}
+
+// Eval implements the sql.Expression interface.
+func (s *Subscript) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	array, err := s.array.Eval(ctx, row)
+	if err != nil || array == nil {
+		return nil, err
+	}
+	index, err := s.index.Eval(ctx, row)
+	if err != nil || index == nil {
+		return nil, err
+	}
+	var i int64
+	switch index := index.(type) {
+	case int16:
+		i = int64(index)
+	case int32:
+		i = int64(index)
+	case int64:
+		i = index
+	default:
+		return nil, fmt.Errorf("array subscript must have type integer")
+	}
+	values, ok := array.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%T: expected array to return `%T` but returned `%T`", s, []any{}, array)
+	}
+	if i < 1 || i > int64(len(values)) {
+		return nil, nil
+	}
+	return values[i-1], nil
+}
+
+// IsNullable implements the sql.Expression interface.
+func (s *Subscript) IsNullable() bool {
+	return true
+}
+
+// Resolved implements the sql.Expression interface.
+func (s *Subscript) Resolved() bool {
+	return s.array != nil && s.array.Resolved() && s.index != nil && s.index.Resolved()
+}
+
+// String implements the sql.Expression interface.
+func (s *Subscript) String() string {
+	if s.array == nil || s.index == nil {
+		return "?[?]"
+	}
+	return fmt.Sprintf("%s[%s]", s.array.String(), s.index.String())
+}
+
+// Type implements the sql.Expression interface.
+func (s *Subscript) Type() sql.Type {
+	return s.array.Type().(pgtypes.DoltgresArrayType).BaseType()
+}
+
+// WithChildren implements the sql.Expression interface.
+func (s *Subscript) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 2)
+	}
+	if _, ok := children[0].Type().(pgtypes.DoltgresArrayType); !ok {
+		return nil, fmt.Errorf("cannot subscript type %s because it does not support subscripting", children[0].Type().String())
+	}
+	return &Subscript{
+		array: children[0],
+		index: children[1],
+	}, nil
+}
+
+// WithResolvedChildren implements the vitess.InjectableExpression interface.
+func (s *Subscript) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 2 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `2` but got `%d`", len(children))
+	}
+	array, ok := children[0].(sql.Expression)
+	if !ok {
+		return nil, fmt.Errorf("expected vitess child to be an expression but has type `%T`", children[0])
+	}
+	index, ok := children[1].(sql.Expression)
+	if !ok {
+		return nil, fmt.Errorf("expected vitess child to be an expression but has type `%T`", children[1])
+	}
+	return s.WithChildren(array, index)
+}
@@ -0,0 +1,117 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// UnresolvedFunction represents a call to a function that is not built in, and is therefore expected to be a
+// user-defined function. User-defined functions depend on the database and branch that is being used, so they're
+// resolved by the analyzer rather than when the query is converted.
+type UnresolvedFunction struct {
+	schema   string
+	name     string
+	children []sql.Expression
+}
+
+var _ vitess.Injectable = (*UnresolvedFunction)(nil)
+var _ sql.Expression = (*UnresolvedFunction)(nil)
+
+// NewUnresolvedFunction returns a new *UnresolvedFunction. The schema is empty when the name is not qualified.
+func NewUnresolvedFunction(schema string, name string) *UnresolvedFunction {
+	return &UnresolvedFunction{
+		schema: schema,
+		name:   name,
+	}
+}
+
+// Children implements the sql.Expression interface.
+func (uf *UnresolvedFunction) Children() []sql.Expression {
+	return uf.children
+}
+
+// Eval implements the sql.Expression interface.
+func (uf *UnresolvedFunction) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	return nil, fmt.Errorf("function %s was not resolved", uf.name)
+}
+
+// IsNullable implements the sql.Expression interface.
+func (uf *UnresolvedFunction) IsNullable() bool {
+	return true
+}
+
+// Name returns the name of the function.
+func (uf *UnresolvedFunction) Name() string {
+	return uf.name
+}
+
+// Resolved implements the sql.Expression interface.
+func (uf *UnresolvedFunction) Resolved() bool {
+	return false
+}
+
+// Schema returns the schema of the function, which is empty when the name was not qualified.
+func (uf *UnresolvedFunction) Schema() string {
+	return uf.schema
+}
+
+// String implements the sql.Expression interface.
+func (uf *UnresolvedFunction) String() string {
+	sb := strings.Builder{}
+	sb.WriteString(uf.name)
+	sb.WriteRune('(')
+	for i, child := range uf.children {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(child.String())
+	}
+	sb.WriteRune(')')
+	return sb.String()
+}
+
+// Type implements the sql.Expression interface.
+func (uf *UnresolvedFunction) Type() sql.Type {
+	return pgtypes.Unknown
+}
+
+// WithChildren implements the sql.Expression interface.
+func (uf *UnresolvedFunction) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return &UnresolvedFunction{
+		schema:   uf.schema,
+		name:     uf.name,
+		children: children,
+	}, nil
+}
+
+// WithResolvedChildren implements the vitess.InjectableExpression interface.
+func (uf *UnresolvedFunction) WithResolvedChildren(children []any) (any, error) {
+	newExpressions := make([]sql.Expression, len(children))
+	for i, resolvedChild := range children {
+		resolvedExpression, ok := resolvedChild.(sql.Expression)
+		if !ok {
+			return nil, fmt.Errorf("expected vitess child to be an expression but has type `%T`", resolvedChild)
+		}
+		newExpressions[i] = resolvedExpression
+	}
+	return uf.WithChildren(newExpressions...)
+}
@@ -0,0 +1,237 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fdw
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core/foreign"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// httpClient is shared by every scan, so that connections to the same endpoint are reused.
+var httpClient = &http.Client{Timeout: 30 * time.Second}
+
+// httpJSONRowIter reads the rows of a foreign table from a JSON HTTP endpoint, requesting each page only once the rows of
+// the previous page have been consumed.
+type httpJSONRowIter struct {
+	table    *foreign.Table
+	schema   sql.Schema
+	nextURL  *url.URL
+	page     int
+	pages    int
+	maxPages int
+	rows     []any
+	rowIdx   int
+	done     bool
+}
+
+var _ sql.RowIter = (*httpJSONRowIter)(nil)
+
+// newHTTPJSONRowIter returns an iterator over the rows of the given table. The params are added to the query string of
+// the first page, and contain the filters that were pushed down to the endpoint.
+func newHTTPJSONRowIter(server *foreign.Server, table *foreign.Table, sch sql.Schema, params url.Values) (*httpJSONRowIter, error) {
+	rawURL := server.Options[ServerURL]
+	if path := table.Options[TablePath]; len(path) > 0 {
+		rawURL = strings.TrimSuffix(rawURL, "/") + "/" + strings.TrimPrefix(path, "/")
+	}
+	firstURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	query := firstURL.Query()
+	for name, values := range params {
+		query[name] = values
+	}
+	firstURL.RawQuery = query.Encode()
+	maxPages := defaultMaxPages
+	if value, ok := table.Options[TableMaxPages]; ok {
+		if maxPages, err = strconv.Atoi(value); err != nil {
+			return nil, err
+		}
+	}
+	iter := &httpJSONRowIter{
+		table:    table,
+		schema:   sch,
+		nextURL:  firstURL,
+		page:     1,
+		maxPages: maxPages,
+	}
+	if pageParam := table.Options[TablePageParam]; len(pageParam) > 0 && len(table.Options[TableNext]) == 0 {
+		iter.setPage(1)
+	}
+	return iter, nil
+}
+
+// Next implements the interface sql.RowIter.
+func (iter *httpJSONRowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for iter.rowIdx >= len(iter.rows) {
+		if iter.done || iter.pages >= iter.maxPages {
+			return nil, io.EOF
+		}
+		if err := iter.readPage(ctx); err != nil {
+			return nil, err
+		}
+	}
+	jsonRow := iter.rows[iter.rowIdx]
+	iter.rowIdx++
+	row := make(sql.Row, len(iter.schema))
+	for i, column := range iter.schema {
+		path := column.Name
+		if columnPath, ok := iter.table.ColumnOptions[column.Name][ColumnPath]; ok {
+			path = columnPath
+		}
+		var err error
+		row[i], err = convertJSONValue(lookupPath(jsonRow, splitPath(path)), column.Type)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid value for column "%s" of foreign table "%s": %w`,
+				column.Name, iter.table.Name.Name, err)
+		}
+	}
+	return row, nil
+}
+
+// Close implements the interface sql.RowIter.
+func (iter *httpJSONRowIter) Close(ctx *sql.Context) error {
+	return nil
+}
+
+// readPage requests the next page, replacing the current rows with the page's rows and determining the page after it.
+func (iter *httpJSONRowIter) readPage(ctx *sql.Context) error {
+	currentURL := iter.nextURL
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, currentURL.String(), nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json")
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("could not read foreign table \"%s\": %w", iter.table.Name.Name, err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf(`could not read foreign table "%s": %s returned %s`,
+			iter.table.Name.Name, currentURL.Redacted(), response.Status)
+	}
+	decoder := json.NewDecoder(response.Body)
+	decoder.UseNumber()
+	var body any
+	if err = decoder.Decode(&body); err != nil {
+		return fmt.Errorf(`could not read foreign table "%s": invalid JSON from %s: %w`,
+			iter.table.Name.Name, currentURL.Redacted(), err)
+	}
+	rows, ok := lookupPath(body, splitPath(iter.table.Options[TableRows])).([]any)
+	if !ok {
+		return fmt.Errorf(`could not read foreign table "%s": response from %s does not contain an array of rows`,
+			iter.table.Name.Name, currentURL.Redacted())
+	}
+	iter.rows = rows
+	iter.rowIdx = 0
+	iter.pages++
+
+	// Determine the next page, if there is one
+	if nextPath := iter.table.Options[TableNext]; len(nextPath) > 0 {
+		next, _ := lookupPath(body, splitPath(nextPath)).(string)
+		if len(next) == 0 {
+			iter.done = true
+			return nil
+		}
+		nextURL, err := url.Parse(next)
+		if err != nil {
+			return fmt.Errorf(`could not read foreign table "%s": invalid next page URL: %s`, iter.table.Name.Name, next)
+		}
+		iter.nextURL = currentURL.ResolveReference(nextURL)
+	} else if len(iter.table.Options[TablePageParam]) > 0 && len(rows) > 0 {
+		iter.setPage(iter.page + 1)
+	} else {
+		iter.done = true
+	}
+	return nil
+}
+
+// setPage sets the page number within the query string of the next URL.
+func (iter *httpJSONRowIter) setPage(page int) {
+	iter.page = page
+	nextURL := *iter.nextURL
+	query := nextURL.Query()
+	query.Set(iter.table.Options[TablePageParam], strconv.Itoa(page))
+	nextURL.RawQuery = query.Encode()
+	iter.nextURL = &nextURL
+}
+
+// lookupPath returns the value at the given path, where each key indexes into an object, or into an array when the key
+// is a number. Returns nil if the path does not exist.
+func lookupPath(value any, keys []string) any {
+	for _, key := range keys {
+		switch v := value.(type) {
+		case map[string]any:
+			value = v[key]
+		case []any:
+			idx, err := strconv.Atoi(key)
+			if err != nil || idx < 0 || idx >= len(v) {
+				return nil
+			}
+			value = v[idx]
+		default:
+			return nil
+		}
+	}
+	return value
+}
+
+// convertJSONValue converts a decoded JSON value to the given type, using the type's input function on the value's
+// text. JSON columns receive the value as a document, so objects and arrays may be read as a whole.
+func convertJSONValue(value any, typ sql.Type) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	dgType, ok := typ.(pgtypes.DoltgresType)
+	if !ok {
+		return nil, fmt.Errorf("unsupported type: %s", typ.String())
+	}
+	var text string
+	if baseID := dgType.BaseID(); baseID == pgtypes.Json.BaseID() || baseID == pgtypes.JsonB.BaseID() {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		text = string(data)
+	} else {
+		switch value := value.(type) {
+		case string:
+			text = value
+		case json.Number:
+			text = value.String()
+		case bool:
+			text = strconv.FormatBool(value)
+		default:
+			data, err := json.Marshal(value)
+			if err != nil {
+				return nil, err
+			}
+			text = string(data)
+		}
+	}
+	return dgType.IoInput(text)
+}
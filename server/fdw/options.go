@@ -0,0 +1,124 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fdw
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// HTTPJSON is the name of the foreign-data wrapper that reads rows from a JSON HTTP endpoint.
+const HTTPJSON = "http_json"
+
+// Options of a server that uses the HTTPJSON wrapper.
+const (
+	// ServerURL is the base URL of the endpoint, which the path of each table is appended to.
+	ServerURL = "url"
+)
+
+// Options of a table whose server uses the HTTPJSON wrapper.
+const (
+	// TablePath is appended to the server's URL to form the URL of the table's first page.
+	TablePath = "path"
+	// TableRows is the dot-separated path to the array of rows within each response. When omitted, the response itself
+	// must be the array.
+	TableRows = "rows"
+	// TableNext is the dot-separated path to the URL of the next page within each response. Reading stops once a
+	// response does not contain one.
+	TableNext = "next"
+	// TablePageParam is the query parameter that holds the page number, starting at 1. Reading stops at the first page
+	// without any rows. This is ignored when TableNext is given.
+	TablePageParam = "page_param"
+	// TableMaxPages limits the number of pages that are read for a single scan of the table.
+	TableMaxPages = "max_pages"
+)
+
+// Options of a column of a table whose server uses the HTTPJSON wrapper.
+const (
+	// ColumnPath is the dot-separated path to the column's value within each row. Defaults to the column's name.
+	ColumnPath = "path"
+	// ColumnParam is the query parameter that equality filters on the column are sent as, so that the endpoint may
+	// filter the rows itself.
+	ColumnParam = "param"
+)
+
+// defaultMaxPages is used when a table does not set TableMaxPages.
+const defaultMaxPages = 100
+
+// ValidateServer returns an error if the wrapper does not exist, or if the options are not valid for the wrapper.
+func ValidateServer(wrapper string, options map[string]string) error {
+	if wrapper != HTTPJSON {
+		return fmt.Errorf(`foreign-data wrapper "%s" does not exist`, wrapper)
+	}
+	for name, value := range options {
+		switch name {
+		case ServerURL:
+			parsed, err := url.Parse(value)
+			if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || len(parsed.Host) == 0 {
+				return fmt.Errorf(`invalid value for option "%s": %s`, ServerURL, value)
+			}
+		default:
+			return invalidOption(name)
+		}
+	}
+	if _, ok := options[ServerURL]; !ok {
+		return fmt.Errorf(`option "%s" is required for servers using foreign-data wrapper "%s"`, ServerURL, HTTPJSON)
+	}
+	return nil
+}
+
+// ValidateTable returns an error if the options of the table or its columns are not valid for the wrapper.
+func ValidateTable(options map[string]string, columnOptions map[string]map[string]string) error {
+	for name, value := range options {
+		switch name {
+		case TablePath, TableRows, TableNext, TablePageParam:
+		case TableMaxPages:
+			if maxPages, err := strconv.ParseInt(value, 10, 32); err != nil || maxPages < 1 {
+				return fmt.Errorf(`invalid value for option "%s": %s`, TableMaxPages, value)
+			}
+		default:
+			return invalidOption(name)
+		}
+	}
+	for _, options := range columnOptions {
+		for name, value := range options {
+			switch name {
+			case ColumnPath:
+			case ColumnParam:
+				if len(value) == 0 {
+					return fmt.Errorf(`invalid value for option "%s": %s`, ColumnParam, value)
+				}
+			default:
+				return invalidOption(name)
+			}
+		}
+	}
+	return nil
+}
+
+// invalidOption returns the error for an option that the wrapper does not recognize.
+func invalidOption(name string) error {
+	return fmt.Errorf(`invalid option "%s"`, name)
+}
+
+// splitPath splits a dot-separated path into its keys. An empty path has no keys.
+func splitPath(path string) []string {
+	if len(path) == 0 {
+		return nil
+	}
+	return strings.Split(path, ".")
+}
@@ -0,0 +1,122 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fdw
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core/foreign"
+)
+
+// Table reads the rows of a foreign table through its server's wrapper. The underlying table only supplies the columns,
+// as a foreign table does not store any rows of its own. This intentionally implements only sql.Table, so that the
+// analyzer cannot push filters or index lookups into the underlying table.
+type Table struct {
+	underlying sql.Table
+	server     *foreign.Server
+	table      *foreign.Table
+	params     url.Values
+}
+
+var _ sql.Table = (*Table)(nil)
+
+// NewTable returns a new *Table that reads the rows of the given foreign table. The params are sent to the server
+// alongside each request.
+func NewTable(underlying sql.Table, server *foreign.Server, table *foreign.Table, params url.Values) *Table {
+	return &Table{
+		underlying: underlying,
+		server:     server,
+		table:      table,
+		params:     params,
+	}
+}
+
+// Definition returns the foreign definition of the table.
+func (t *Table) Definition() *foreign.Table {
+	return t.table
+}
+
+// Name implements the interface sql.Table.
+func (t *Table) Name() string {
+	return t.underlying.Name()
+}
+
+// String implements the interface sql.Table.
+func (t *Table) String() string {
+	return t.underlying.String()
+}
+
+// Schema implements the interface sql.Table.
+func (t *Table) Schema() sql.Schema {
+	return t.underlying.Schema()
+}
+
+// Collation implements the interface sql.Table.
+func (t *Table) Collation() sql.CollationID {
+	return t.underlying.Collation()
+}
+
+// Partitions implements the interface sql.Table.
+func (t *Table) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	return &partitionIter{}, nil
+}
+
+// PartitionRows implements the interface sql.Table.
+func (t *Table) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	if t.server == nil {
+		return nil, fmt.Errorf(`server "%s" does not exist`, t.table.Server)
+	}
+	switch t.server.Wrapper {
+	case HTTPJSON:
+		return newHTTPJSONRowIter(t.server, t.table, t.Schema(), t.params)
+	default:
+		return nil, fmt.Errorf(`foreign-data wrapper "%s" does not exist`, t.server.Wrapper)
+	}
+}
+
+// partition is the only partition of a foreign table, as the rows are read in a single pass.
+type partition struct{}
+
+var _ sql.Partition = partition{}
+
+// Key implements the interface sql.Partition.
+func (partition) Key() []byte {
+	return []byte("foreign")
+}
+
+// partitionIter returns the single partition of a foreign table.
+type partitionIter struct {
+	done bool
+}
+
+var _ sql.PartitionIter = (*partitionIter)(nil)
+
+// Next implements the interface sql.PartitionIter.
+func (iter *partitionIter) Next(ctx *sql.Context) (sql.Partition, error) {
+	if iter.done {
+		return nil, io.EOF
+	}
+	iter.done = true
+	return partition{}, nil
+}
+
+// Close implements the interface sql.PartitionIter.
+func (iter *partitionIter) Close(ctx *sql.Context) error {
+	return nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initArrayPosition registers the functions to the catalog.
+func initArrayPosition() {
+	framework.RegisterFunction(array_position_anyarray_anyelement)
+	framework.RegisterFunction(array_position_anyarray_anyelement_int32)
+}
+
+// array_position_anyarray_anyelement represents the PostgreSQL function of the same name, taking the same parameters.
+var array_position_anyarray_anyelement = framework.Function2{
+	Name:       "array_position",
+	Return:     pgtypes.Int32,
+	Parameters: []pgtypes.DoltgresType{pgtypes.AnyArray, pgtypes.AnyElement},
+	Callable: func(ctx *sql.Context, paramsAndReturn [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return arrayPosition(paramsAndReturn[0], val1, val2, 1)
+	},
+}
+
+// array_position_anyarray_anyelement_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var array_position_anyarray_anyelement_int32 = framework.Function3{
+	Name:       "array_position",
+	Return:     pgtypes.Int32,
+	Parameters: []pgtypes.DoltgresType{pgtypes.AnyArray, pgtypes.AnyElement, pgtypes.Int32},
+	Callable: func(ctx *sql.Context, paramsAndReturn [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		if val3 == nil {
+			return nil, fmt.Errorf("initial position must not be null")
+		}
+		return arrayPosition(paramsAndReturn[0], val1, val2, val3.(int32))
+	},
+}
+
+// arrayPosition returns the subscript of the first element of the array that matches the target, beginning the search
+// at the given subscript. Returns NULL if there is no match.
+func arrayPosition(arrayType pgtypes.DoltgresType, arr any, target any, start int32) (any, error) {
+	if arr == nil {
+		return nil, nil
+	}
+	elementType := arrayType.(pgtypes.DoltgresArrayType).BaseType()
+	elements := arr.([]any)
+	for i := max(start, 1) - 1; int(i) < len(elements); i++ {
+		equal, err := arrayElementsEqual(elementType, elements[i], target)
+		if err != nil {
+			return nil, err
+		}
+		if equal {
+			return i + 1, nil
+		}
+	}
+	return nil, nil
+}
+
+// arrayElementsEqual returns whether the two array elements are equal. Elements are compared using IS NOT DISTINCT
+// FROM semantics, so two NULLs are considered equal.
+func arrayElementsEqual(elementType pgtypes.DoltgresType, v1 any, v2 any) (bool, error) {
+	if v1 == nil || v2 == nil {
+		return v1 == nil && v2 == nil, nil
+	}
+	res, err := elementType.Compare(v1, v2)
+	if err != nil {
+		return false, err
+	}
+	return res == 0, nil
+}
@@ -0,0 +1,51 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initArrayPositions registers the functions to the catalog.
+func initArrayPositions() {
+	framework.RegisterFunction(array_positions_anyarray_anyelement)
+}
+
+// array_positions_anyarray_anyelement represents the PostgreSQL function of the same name, taking the same parameters.
+var array_positions_anyarray_anyelement = framework.Function2{
+	Name:       "array_positions",
+	Return:     pgtypes.Int32Array,
+	Parameters: []pgtypes.DoltgresType{pgtypes.AnyArray, pgtypes.AnyElement},
+	Callable: func(ctx *sql.Context, paramsAndReturn [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		if val1 == nil {
+			return nil, nil
+		}
+		elementType := paramsAndReturn[0].(pgtypes.DoltgresArrayType).BaseType()
+		positions := []any{}
+		for i, element := range val1.([]any) {
+			equal, err := arrayElementsEqual(elementType, element, val2)
+			if err != nil {
+				return nil, err
+			}
+			if equal {
+				positions = append(positions, int32(i+1))
+			}
+		}
+		return positions, nil
+	},
+}
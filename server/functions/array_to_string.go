@@ -0,0 +1,85 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initArrayToString registers the functions to the catalog.
+func initArrayToString() {
+	framework.RegisterFunction(array_to_string_anyarray_text)
+	framework.RegisterFunction(array_to_string_anyarray_text_text)
+}
+
+// array_to_string_anyarray_text represents the PostgreSQL function of the same name, taking the same parameters.
+var array_to_string_anyarray_text = framework.Function2{
+	Name:       "array_to_string",
+	Return:     pgtypes.Text,
+	Parameters: []pgtypes.DoltgresType{pgtypes.AnyArray, pgtypes.Text},
+	Callable: func(ctx *sql.Context, paramsAndReturn [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		if val1 == nil || val2 == nil {
+			return nil, nil
+		}
+		return arrayToString(paramsAndReturn[0], val1.([]any), val2.(string), nil)
+	},
+}
+
+// array_to_string_anyarray_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var array_to_string_anyarray_text_text = framework.Function3{
+	Name:       "array_to_string",
+	Return:     pgtypes.Text,
+	Parameters: []pgtypes.DoltgresType{pgtypes.AnyArray, pgtypes.Text, pgtypes.Text},
+	Callable: func(ctx *sql.Context, paramsAndReturn [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		if val1 == nil || val2 == nil {
+			return nil, nil
+		}
+		return arrayToString(paramsAndReturn[0], val1.([]any), val2.(string), val3)
+	},
+}
+
+// arrayToString joins the text representation of each element using the delimiter. NULL elements are written as the
+// nullString, or are skipped when the nullString is NULL.
+func arrayToString(arrayType pgtypes.DoltgresType, elements []any, delimiter string, nullString any) (any, error) {
+	elementType := arrayType.(pgtypes.DoltgresArrayType).BaseType()
+	sb := strings.Builder{}
+	written := false
+	for _, element := range elements {
+		var str string
+		if element == nil {
+			if nullString == nil {
+				continue
+			}
+			str = nullString.(string)
+		} else {
+			var err error
+			str, err = elementType.IoOutput(element)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if written {
+			sb.WriteString(delimiter)
+		}
+		sb.WriteString(str)
+		written = true
+	}
+	return sb.String(), nil
+}
@@ -31,7 +31,7 @@ var ascii_varchar = framework.Function1{
 	Name:       "ascii",
 	Return:     pgtypes.Int32,
 	Parameters: []pgtypes.DoltgresType{pgtypes.VarChar},
-	Callable: func(ctx *sql.Context, val1Interface any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1Interface any) (any, error) {
 		if val1Interface == nil {
 			return nil, nil
 		}
@@ -34,7 +34,7 @@ var atan2_float64 = framework.Function2{
 	Name:       "atan2",
 	Return:     pgtypes.Float64,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Float64, pgtypes.Float64},
-	Callable: func(ctx *sql.Context, y any, x any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, y any, x any) (any, error) {
 		if y == nil || x == nil {
 			return nil, nil
 		}
@@ -22,6 +22,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 
 	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/functions/jsonpath"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
@@ -48,6 +49,8 @@ func initJSON() {
 	framework.RegisterBinaryFunction(framework.Operator_BinaryJSONTopLevel, jsonb_exists)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryJSONTopLevelAny, jsonb_exists_any)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryJSONTopLevelAll, jsonb_exists_all)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryJSONPathExists, jsonb_path_exists_opr)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryJSONPathMatch, jsonb_path_match_opr)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryConcatenate, jsonb_concat)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryMinus, jsonb_delete_text)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryMinus, jsonb_delete_text_array)
@@ -59,7 +62,7 @@ var json_array_element = framework.Function2{
 	Name:       "json_array_element",
 	Return:     pgtypes.Json,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Json, pgtypes.Int32},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -68,7 +71,7 @@ var json_array_element = framework.Function2{
 		if err != nil {
 			return nil, err
 		}
-		retVal, err := jsonb_array_element.Callable(ctx, newVal, val2)
+		retVal, err := jsonb_array_element.Callable(ctx, [3]pgtypes.DoltgresType{}, newVal, val2)
 		if err != nil {
 			return nil, err
 		}
@@ -81,7 +84,7 @@ var jsonb_array_element = framework.Function2{
 	Name:       "jsonb_array_element",
 	Return:     pgtypes.JsonB,
 	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Int32},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -105,7 +108,7 @@ var json_object_field = framework.Function2{
 	Name:       "json_object_field",
 	Return:     pgtypes.Json,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Json, pgtypes.Text},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -114,7 +117,7 @@ var json_object_field = framework.Function2{
 		if err != nil {
 			return nil, err
 		}
-		retVal, err := jsonb_object_field.Callable(ctx, newVal, val2)
+		retVal, err := jsonb_object_field.Callable(ctx, [3]pgtypes.DoltgresType{}, newVal, val2)
 		if err != nil {
 			return nil, err
 		}
@@ -127,7 +130,7 @@ var jsonb_object_field = framework.Function2{
 	Name:       "jsonb_object_field",
 	Return:     pgtypes.JsonB,
 	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Text},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -151,7 +154,7 @@ var json_array_element_text = framework.Function2{
 	Name:       "json_array_element_text",
 	Return:     pgtypes.Text,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Json, pgtypes.Int32},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -160,7 +163,7 @@ var json_array_element_text = framework.Function2{
 		if err != nil {
 			return nil, err
 		}
-		return jsonb_array_element_text.Callable(ctx, newVal, val2)
+		return jsonb_array_element_text.Callable(ctx, [3]pgtypes.DoltgresType{}, newVal, val2)
 	},
 }
 
@@ -169,11 +172,11 @@ var jsonb_array_element_text = framework.Function2{
 	Name:       "jsonb_array_element_text",
 	Return:     pgtypes.Text,
 	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Int32},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
-		doc, err := jsonb_array_element.Callable(ctx, val1, val2)
+		doc, err := jsonb_array_element.Callable(ctx, [3]pgtypes.DoltgresType{}, val1, val2)
 		if err != nil || doc == nil {
 			return nil, err
 		}
@@ -191,7 +194,7 @@ var json_object_field_text = framework.Function2{
 	Name:       "json_object_field_text",
 	Return:     pgtypes.Text,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Json, pgtypes.Text},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -200,7 +203,7 @@ var json_object_field_text = framework.Function2{
 		if err != nil {
 			return nil, err
 		}
-		return jsonb_object_field_text.Callable(ctx, newVal, val2)
+		return jsonb_object_field_text.Callable(ctx, [3]pgtypes.DoltgresType{}, newVal, val2)
 	},
 }
 
@@ -209,11 +212,11 @@ var jsonb_object_field_text = framework.Function2{
 	Name:       "jsonb_object_field_text",
 	Return:     pgtypes.Text,
 	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Text},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
-		doc, err := jsonb_object_field.Callable(ctx, val1, val2)
+		doc, err := jsonb_object_field.Callable(ctx, [3]pgtypes.DoltgresType{}, val1, val2)
 		if err != nil || doc == nil {
 			return nil, err
 		}
@@ -231,7 +234,7 @@ var json_extract_path = framework.Function2{
 	Name:       "json_extract_path",
 	Return:     pgtypes.Json,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Json, pgtypes.TextArray},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -240,7 +243,7 @@ var json_extract_path = framework.Function2{
 		if err != nil {
 			return nil, err
 		}
-		retVal, err := jsonb_extract_path.Callable(ctx, newVal, val2)
+		retVal, err := jsonb_extract_path.Callable(ctx, [3]pgtypes.DoltgresType{}, newVal, val2)
 		if err != nil {
 			return nil, err
 		}
@@ -253,7 +256,7 @@ var jsonb_extract_path = framework.Function2{
 	Name:       "jsonb_extract_path",
 	Return:     pgtypes.JsonB,
 	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.TextArray},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -277,6 +280,12 @@ var jsonb_extract_path = framework.Function2{
 					// We don't return the error here, a bad parse is treated as an object key which isn't valid
 					return nil, nil
 				}
+				if idx < 0 {
+					idx += len(currentValue)
+				}
+				if idx < 0 || idx >= len(currentValue) {
+					return nil, nil
+				}
 				value = currentValue[idx]
 			default:
 				return nil, nil
@@ -291,7 +300,7 @@ var json_extract_path_text = framework.Function2{
 	Name:       "json_extract_path_text",
 	Return:     pgtypes.Text,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Json, pgtypes.TextArray},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -300,7 +309,7 @@ var json_extract_path_text = framework.Function2{
 		if err != nil {
 			return nil, err
 		}
-		return jsonb_extract_path_text.Callable(ctx, newVal, val2)
+		return jsonb_extract_path_text.Callable(ctx, [3]pgtypes.DoltgresType{}, newVal, val2)
 	},
 }
 
@@ -309,11 +318,11 @@ var jsonb_extract_path_text = framework.Function2{
 	Name:       "jsonb_extract_path_text",
 	Return:     pgtypes.Text,
 	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.TextArray},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
-		doc, err := jsonb_extract_path.Callable(ctx, val1, val2)
+		doc, err := jsonb_extract_path.Callable(ctx, [3]pgtypes.DoltgresType{}, val1, val2)
 		if err != nil || doc == nil {
 			return nil, err
 		}
@@ -331,7 +340,7 @@ var jsonb_contains = framework.Function2{
 	Name:       "jsonb_contains",
 	Return:     pgtypes.Bool,
 	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.JsonB},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -344,11 +353,11 @@ var jsonb_contained = framework.Function2{
 	Name:       "jsonb_contained",
 	Return:     pgtypes.Bool,
 	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.JsonB},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
-		return jsonb_contains.Callable(ctx, val2, val1)
+		return jsonb_contains.Callable(ctx, [3]pgtypes.DoltgresType{}, val2, val1)
 	},
 }
 
@@ -357,7 +366,7 @@ var jsonb_exists = framework.Function2{
 	Name:       "jsonb_exists",
 	Return:     pgtypes.Bool,
 	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Text},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -387,7 +396,7 @@ var jsonb_exists_any = framework.Function2{
 	Name:       "jsonb_exists_any",
 	Return:     pgtypes.Bool,
 	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.TextArray},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -429,7 +438,7 @@ var jsonb_exists_all = framework.Function2{
 	Name:       "jsonb_exists_all",
 	Return:     pgtypes.Bool,
 	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.TextArray},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -471,12 +480,50 @@ var jsonb_exists_all = framework.Function2{
 	},
 }
 
+// jsonb_path_exists_opr represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_path_exists_opr = framework.Function2{
+	Name:       "jsonb_path_exists_opr",
+	Return:     pgtypes.Bool,
+	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Text},
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		if val1 == nil || val2 == nil {
+			return nil, nil
+		}
+		// The operator form suppresses evaluation errors, returning NULL instead
+		results, ok, err := jsonpath.Evaluate(val1.(pgtypes.JsonDocument).Value, val2.(string), nil, true)
+		if err != nil || !ok {
+			return nil, err
+		}
+		return len(results) > 0, nil
+	},
+}
+
+// jsonb_path_match_opr represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_path_match_opr = framework.Function2{
+	Name:       "jsonb_path_match_opr",
+	Return:     pgtypes.Bool,
+	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Text},
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		if val1 == nil || val2 == nil {
+			return nil, nil
+		}
+		results, ok, err := jsonpath.Evaluate(val1.(pgtypes.JsonDocument).Value, val2.(string), nil, true)
+		if err != nil || !ok || len(results) != 1 {
+			return nil, err
+		}
+		if result, isBool := results[0].(pgtypes.JsonValueBoolean); isBool {
+			return bool(result), nil
+		}
+		return nil, nil
+	},
+}
+
 // jsonb_concat represents the PostgreSQL function of the same name, taking the same parameters.
 var jsonb_concat = framework.Function2{
 	Name:       "jsonb_concat",
 	Return:     pgtypes.JsonB,
 	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.JsonB},
-	Callable: func(ctx *sql.Context, val1Interface any, val2Interface any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1Interface any, val2Interface any) (any, error) {
 		if val1Interface == nil || val2Interface == nil {
 			return nil, nil
 		}
@@ -532,7 +579,7 @@ var jsonb_delete_text = framework.Function2{
 	Name:       "jsonb_delete",
 	Return:     pgtypes.JsonB,
 	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Text},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -545,7 +592,7 @@ var jsonb_delete_text_array = framework.Function2{
 	Name:       "jsonb_delete",
 	Return:     pgtypes.JsonB,
 	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.TextArray},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -558,7 +605,7 @@ var jsonb_delete_int32 = framework.Function2{
 	Name:       "jsonb_delete",
 	Return:     pgtypes.JsonB,
 	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Int32},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
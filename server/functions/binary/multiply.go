@@ -21,6 +21,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/shopspring/decimal"
 
+	"github.com/dolthub/doltgresql/postgres/parser/duration"
 	"github.com/dolthub/doltgresql/server/functions/framework"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
@@ -43,6 +44,8 @@ func initBinaryMultiply() {
 	framework.RegisterBinaryFunction(framework.Operator_BinaryMultiply, int8mul)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryMultiply, int82mul)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryMultiply, int84mul)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryMultiply, interval_mul)
+	framework.RegisterBinaryFunction(framework.Operator_BinaryMultiply, mul_d_interval)
 	framework.RegisterBinaryFunction(framework.Operator_BinaryMultiply, numeric_mul)
 }
 
@@ -51,7 +54,7 @@ var float4mul = framework.Function2{
 	Name:       "float4mul",
 	Return:     pgtypes.Float32,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Float32, pgtypes.Float32},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -64,7 +67,7 @@ var float48mul = framework.Function2{
 	Name:       "float48mul",
 	Return:     pgtypes.Float64,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Float32, pgtypes.Float64},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -77,7 +80,7 @@ var float8mul = framework.Function2{
 	Name:       "float8mul",
 	Return:     pgtypes.Float64,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Float64, pgtypes.Float64},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -90,7 +93,7 @@ var float84mul = framework.Function2{
 	Name:       "float84mul",
 	Return:     pgtypes.Float64,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Float64, pgtypes.Float32},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -103,7 +106,7 @@ var int2mul = framework.Function2{
 	Name:       "int2mul",
 	Return:     pgtypes.Int16,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Int16, pgtypes.Int16},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -120,7 +123,7 @@ var int24mul = framework.Function2{
 	Name:       "int24mul",
 	Return:     pgtypes.Int32,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Int16, pgtypes.Int32},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -137,7 +140,7 @@ var int28mul = framework.Function2{
 	Name:       "int28mul",
 	Return:     pgtypes.Int64,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Int16, pgtypes.Int64},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -150,7 +153,7 @@ var int4mul = framework.Function2{
 	Name:       "int4mul",
 	Return:     pgtypes.Int32,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -167,7 +170,7 @@ var int42mul = framework.Function2{
 	Name:       "int42mul",
 	Return:     pgtypes.Int32,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int16},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -184,7 +187,7 @@ var int48mul = framework.Function2{
 	Name:       "int48mul",
 	Return:     pgtypes.Int64,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int64},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -197,7 +200,7 @@ var int8mul = framework.Function2{
 	Name:       "int8mul",
 	Return:     pgtypes.Int64,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Int64, pgtypes.Int64},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -210,7 +213,7 @@ var int82mul = framework.Function2{
 	Name:       "int82mul",
 	Return:     pgtypes.Int64,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Int64, pgtypes.Int16},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -223,7 +226,7 @@ var int84mul = framework.Function2{
 	Name:       "int84mul",
 	Return:     pgtypes.Int64,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Int64, pgtypes.Int32},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -231,12 +234,40 @@ var int84mul = framework.Function2{
 	},
 }
 
+// interval_mul represents the PostgreSQL function of the same name, taking the same parameters.
+var interval_mul = framework.Function2{
+	Name:       "interval_mul",
+	Return:     pgtypes.Interval,
+	Parameters: []pgtypes.DoltgresType{pgtypes.Interval, pgtypes.Float64},
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		if val1 == nil || val2 == nil {
+			return nil, nil
+		}
+		factor := val2.(float64)
+		return scaleInterval(val1.(duration.Duration), func(val float64) float64 { return val * factor })
+	},
+}
+
+// mul_d_interval represents the PostgreSQL function of the same name, taking the same parameters.
+var mul_d_interval = framework.Function2{
+	Name:       "mul_d_interval",
+	Return:     pgtypes.Interval,
+	Parameters: []pgtypes.DoltgresType{pgtypes.Float64, pgtypes.Interval},
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		if val1 == nil || val2 == nil {
+			return nil, nil
+		}
+		factor := val1.(float64)
+		return scaleInterval(val2.(duration.Duration), func(val float64) float64 { return val * factor })
+	},
+}
+
 // numeric_mul represents the PostgreSQL function of the same name, taking the same parameters.
 var numeric_mul = framework.Function2{
 	Name:       "numeric_mul",
 	Return:     pgtypes.Numeric,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Numeric, pgtypes.Numeric},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
 		if val1 == nil || val2 == nil {
 			return nil, nil
 		}
@@ -252,3 +283,36 @@ func multiplyOverflow(val1 int64, val2 int64) (any, error) {
 	}
 	return result, nil
 }
+
+// scaleInterval applies the scaling function (such as multiplying by a factor) to each field of the interval.
+// Fractional months and days cascade down into the smaller units, using the same 30-day month that Postgres uses, and
+// the cascaded amounts are rounded to microseconds to avoid floating-point artifacts such as "11 days 24:00:00".
+func scaleInterval(interval duration.Duration, scale func(float64) float64) (any, error) {
+	scaledMonths := scale(float64(interval.Months))
+	scaledDays := scale(float64(interval.Days))
+	if math.IsNaN(scaledMonths) || math.IsNaN(scaledDays) ||
+		scaledMonths > math.MaxInt32 || scaledMonths < math.MinInt32 ||
+		scaledDays > math.MaxInt32 || scaledDays < math.MinInt32 {
+		return nil, fmt.Errorf("interval out of range")
+	}
+	months := int64(scaledMonths)
+	days := int64(scaledDays)
+	monthRemainderDays := roundToMicroseconds((scaledMonths - float64(months)) * duration.DaysPerMonth)
+	secondRemainder := roundToMicroseconds((scaledDays - float64(days) + monthRemainderDays -
+		float64(int64(monthRemainderDays))) * duration.SecsPerDay)
+	if math.Abs(secondRemainder) >= duration.SecsPerDay {
+		days += int64(secondRemainder / duration.SecsPerDay)
+		secondRemainder -= float64(int64(secondRemainder/duration.SecsPerDay) * duration.SecsPerDay)
+	}
+	days += int64(monthRemainderDays)
+	micros := math.RoundToEven(scale(float64(interval.Nanos()/1000)) + secondRemainder*1000000)
+	if math.IsNaN(micros) || micros > math.MaxInt64/1000 || micros < math.MinInt64/1000 {
+		return nil, fmt.Errorf("interval out of range")
+	}
+	return duration.DecodeDuration(months, days, int64(micros)*1000), nil
+}
+
+// roundToMicroseconds rounds the value to six decimal places.
+func roundToMicroseconds(val float64) float64 {
+	return math.RoundToEven(val*1000000) / 1000000
+}
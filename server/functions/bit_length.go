@@ -31,11 +31,11 @@ var bit_length_varchar = framework.Function1{
 	Name:       "bit_length",
 	Return:     pgtypes.Int32,
 	Parameters: []pgtypes.DoltgresType{pgtypes.VarChar},
-	Callable: func(ctx *sql.Context, val1 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
 		if val1 == nil {
 			return nil, nil
 		}
-		result, err := octet_length_varchar.Callable(ctx, val1)
+		result, err := octet_length_varchar.Callable(ctx, [2]pgtypes.DoltgresType{}, val1)
 		if err != nil {
 			return nil, err
 		}
@@ -31,14 +31,14 @@ var btrim_varchar_varchar = framework.Function2{
 	Name:       "btrim",
 	Return:     pgtypes.VarChar,
 	Parameters: []pgtypes.DoltgresType{pgtypes.VarChar, pgtypes.VarChar},
-	Callable: func(ctx *sql.Context, str any, characters any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, str any, characters any) (any, error) {
 		if str == nil || characters == nil {
 			return nil, nil
 		}
-		result, err := ltrim_varchar_varchar.Callable(ctx, str, characters)
+		result, err := ltrim_varchar_varchar.Callable(ctx, [3]pgtypes.DoltgresType{}, str, characters)
 		if err != nil {
 			return nil, err
 		}
-		return rtrim_varchar_varchar.Callable(ctx, result, characters)
+		return rtrim_varchar_varchar.Callable(ctx, [3]pgtypes.DoltgresType{}, result, characters)
 	},
 }
@@ -34,7 +34,7 @@ var chr_int32 = framework.Function1{
 	Name:       "chr",
 	Return:     pgtypes.VarChar,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Int32},
-	Callable: func(ctx *sql.Context, val1Interface any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1Interface any) (any, error) {
 		if val1Interface == nil {
 			return nil, nil
 		}
@@ -0,0 +1,160 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"strconv"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/sequences"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initColumnList registers the functions to the catalog.
+func initColumnList() {
+	framework.RegisterFunction(column_list)
+}
+
+// column_list is the source of the information_schema.columns view, returning the columns of the tables in the current
+// database that the current role has access to. This function is specific to Doltgres.
+var column_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "column_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			tables, err := catalogTables(ctx)
+			if err != nil {
+				return nil, err
+			}
+			sequenceCollection, err := core.GetCollectionFromContext(ctx)
+			if err != nil {
+				return nil, err
+			}
+			roleName := informationSchemaRole(ctx)
+			database := ctx.GetCurrentDatabase()
+			var rows [][]any
+			for _, table := range tables {
+				if !isVisibleTable(ctx, roleName, table.name) {
+					continue
+				}
+				identities := make(map[string]*sequences.Sequence)
+				for _, seq := range sequenceCollection.GetSequencesWithTable(table.name) {
+					if seq.Identity != sequences.Identity_None {
+						identities[seq.OwnerColumn] = seq
+					}
+				}
+				for i, col := range table.columns {
+					typ := catalogColumnType(col)
+					if typ == nil {
+						typ = pgtypes.Unknown
+					}
+					var columnDefault any
+					if len(col.Default) > 0 {
+						columnDefault = col.Default
+					}
+					isIdentity := "NO"
+					var identityGeneration, identityStart, identityIncrement, identityMaximum, identityMinimum any
+					identityCycle := "NO"
+					if seq, ok := identities[col.Name]; ok {
+						// Identity columns are given a default that calls their sequence, which Postgres does not show
+						columnDefault = nil
+						isIdentity = "YES"
+						identityGeneration = "BY DEFAULT"
+						if seq.Identity == sequences.Identity_Always {
+							identityGeneration = "ALWAYS"
+						}
+						identityStart = strconv.FormatInt(seq.Start, 10)
+						identityIncrement = strconv.FormatInt(seq.Increment, 10)
+						identityMaximum = strconv.FormatInt(seq.Maximum, 10)
+						identityMinimum = strconv.FormatInt(seq.Minimum, 10)
+						identityCycle = yesOrNo(seq.Cycle)
+					}
+					isGenerated := "NEVER"
+					var generationExpression any
+					if len(col.Generated) > 0 {
+						isGenerated = "ALWAYS"
+						generationExpression = col.Generated
+					}
+					precision, radix, scale := informationSchemaNumericDetails(typ)
+					rows = append(rows, []any{
+						database,
+						table.name.Schema,
+						table.name.Name,
+						col.Name,
+						int32(i + 1),
+						columnDefault,
+						yesOrNo(col.IsNullable()),
+						informationSchemaDataType(typ),
+						informationSchemaCharacterLength(typ),
+						precision,
+						radix,
+						scale,
+						informationSchemaDatetimePrecision(typ),
+						database,
+						"pg_catalog",
+						catalogTypeName(typ),
+						strconv.Itoa(i + 1),
+						isIdentity,
+						identityGeneration,
+						identityStart,
+						identityIncrement,
+						identityMaximum,
+						identityMinimum,
+						identityCycle,
+						isGenerated,
+						generationExpression,
+						"YES",
+					})
+				}
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "table_catalog", Type: pgtypes.Name},
+		{Name: "table_schema", Type: pgtypes.Name},
+		{Name: "table_name", Type: pgtypes.Name},
+		{Name: "column_name", Type: pgtypes.Name},
+		{Name: "ordinal_position", Type: pgtypes.Int32},
+		{Name: "column_default", Type: pgtypes.Text},
+		{Name: "is_nullable", Type: pgtypes.Text},
+		{Name: "data_type", Type: pgtypes.Text},
+		{Name: "character_maximum_length", Type: pgtypes.Int32},
+		{Name: "numeric_precision", Type: pgtypes.Int32},
+		{Name: "numeric_precision_radix", Type: pgtypes.Int32},
+		{Name: "numeric_scale", Type: pgtypes.Int32},
+		{Name: "datetime_precision", Type: pgtypes.Int32},
+		{Name: "udt_catalog", Type: pgtypes.Name},
+		{Name: "udt_schema", Type: pgtypes.Name},
+		{Name: "udt_name", Type: pgtypes.Name},
+		{Name: "dtd_identifier", Type: pgtypes.Name},
+		{Name: "is_identity", Type: pgtypes.Text},
+		{Name: "identity_generation", Type: pgtypes.Text},
+		{Name: "identity_start", Type: pgtypes.Text},
+		{Name: "identity_increment", Type: pgtypes.Text},
+		{Name: "identity_maximum", Type: pgtypes.Text},
+		{Name: "identity_minimum", Type: pgtypes.Text},
+		{Name: "identity_cycle", Type: pgtypes.Text},
+		{Name: "is_generated", Type: pgtypes.Text},
+		{Name: "generation_expression", Type: pgtypes.Text},
+		{Name: "is_updatable", Type: pgtypes.Text},
+	},
+	ReturnsSet: true,
+}
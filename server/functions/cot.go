@@ -33,7 +33,7 @@ var cot_float64 = framework.Function1{
 	Name:       "cot",
 	Return:     pgtypes.Float64,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Float64},
-	Callable: func(ctx *sql.Context, val1Interface any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1Interface any) (any, error) {
 		if val1Interface == nil {
 			return nil, nil
 		}
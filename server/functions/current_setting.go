@@ -0,0 +1,77 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/config"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initCurrentSetting registers the functions to the catalog.
+func initCurrentSetting() {
+	framework.RegisterFunction(current_setting_text)
+	framework.RegisterFunction(current_setting_text_bool)
+}
+
+// current_setting_text represents the PostgreSQL function of the same name, taking the same parameters.
+var current_setting_text = framework.Function1{
+	Name:               "current_setting",
+	Return:             pgtypes.Text,
+	Parameters:         []pgtypes.DoltgresType{pgtypes.Text},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+		if val1 == nil {
+			return nil, nil
+		}
+		return currentSetting(ctx, val1.(string), false)
+	},
+}
+
+// current_setting_text_bool represents the PostgreSQL function of the same name, taking the same parameters.
+var current_setting_text_bool = framework.Function2{
+	Name:               "current_setting",
+	Return:             pgtypes.Text,
+	Parameters:         []pgtypes.DoltgresType{pgtypes.Text, pgtypes.Bool},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		if val1 == nil || val2 == nil {
+			return nil, nil
+		}
+		return currentSetting(ctx, val1.(string), val2.(bool))
+	},
+}
+
+// currentSetting returns the session's value of the given configuration parameter, as displayed by SHOW. Unknown
+// parameters return NULL when |missingOk| is set, and an error otherwise.
+func currentSetting(ctx *sql.Context, name string, missingOk bool) (any, error) {
+	name = strings.ToLower(name)
+	sysVar, _, ok := sql.SystemVariables.GetGlobal(name)
+	if ok && (config.IsValidPostgresConfigParameter(name) || config.IsCustomParameter(name)) {
+		if value, err := ctx.GetSessionVariable(ctx, name); err == nil {
+			return config.DisplayValue(sysVar, value), nil
+		}
+	}
+	if missingOk {
+		return nil, nil
+	}
+	return nil, pgerrors.Raise(ctx, pgerrors.Newf(pgcode.UndefinedObject, `unrecognized configuration parameter "%s"`, name))
+}
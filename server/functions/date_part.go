@@ -0,0 +1,46 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/duration"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initDatePart registers the functions to the catalog.
+func initDatePart() {
+	framework.RegisterFunction(date_part_text_interval)
+}
+
+// date_part_text_interval represents the PostgreSQL function of the same name, taking the same parameters.
+var date_part_text_interval = framework.Function2{
+	Name:       "date_part",
+	Return:     pgtypes.Float64,
+	Parameters: []pgtypes.DoltgresType{pgtypes.Text, pgtypes.Interval},
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, field any, source any) (any, error) {
+		if field == nil || source == nil {
+			return nil, nil
+		}
+		result, err := extractFromInterval(field.(string), source.(duration.Duration))
+		if err != nil {
+			return nil, err
+		}
+		f, _ := result.Float64()
+		return f, nil
+	},
+}
@@ -0,0 +1,91 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/merge"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initDoltMergeBase registers the functions to the catalog.
+func initDoltMergeBase() {
+	framework.RegisterFunction(doltgres_merge_base_text_text)
+}
+
+// doltgres_merge_base_text_text replaces Dolt's dolt_merge_base, which only accepts MySQL's string types. It returns the
+// hash of the closest common ancestor of the two given refs.
+var doltgres_merge_base_text_text = framework.Function2{
+	Name:               "doltgres_merge_base",
+	Return:             pgtypes.Text,
+	Parameters:         []pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, left any, right any) (any, error) {
+		if left == nil || right == nil {
+			return nil, nil
+		}
+		return mergeBase(ctx, left.(string), right.(string))
+	},
+}
+
+// mergeBase returns the hash of the closest common ancestor of the commits referenced by the given refs, which are
+// resolved against the current database and branch.
+func mergeBase(ctx *sql.Context, leftRef string, rightRef string) (string, error) {
+	left, err := resolveCommit(ctx, leftRef)
+	if err != nil {
+		return "", err
+	}
+	right, err := resolveCommit(ctx, rightRef)
+	if err != nil {
+		return "", err
+	}
+	ancestor, err := merge.MergeBase(ctx, left, right)
+	if err != nil {
+		return "", err
+	}
+	return ancestor.String(), nil
+}
+
+// resolveCommit returns the commit referenced by the given ref, which is resolved against the current database and
+// branch.
+func resolveCommit(ctx *sql.Context, ref string) (*doltdb.Commit, error) {
+	session := dsess.DSessFromSess(ctx.Session)
+	dbName := ctx.GetCurrentDatabase()
+	dbData, ok := session.GetDbData(ctx, dbName)
+	if !ok {
+		return nil, sql.ErrDatabaseNotFound.New(dbName)
+	}
+	headRef, err := dbData.Rsr.CWBHeadRef()
+	if err != nil {
+		return nil, err
+	}
+	commitSpec, err := doltdb.NewCommitSpec(ref)
+	if err != nil {
+		return nil, err
+	}
+	optCommit, err := dbData.Ddb.Resolve(ctx, commitSpec, headRef)
+	if err != nil {
+		return nil, err
+	}
+	commit, ok := optCommit.ToCommit()
+	if !ok {
+		return nil, doltdb.ErrGhostCommitEncountered
+	}
+	return commit, nil
+}
@@ -0,0 +1,324 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	pgtree "github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/notices"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initDoltPatch registers the functions to the catalog.
+func initDoltPatch() {
+	framework.RegisterFunction(doltgres_patch_text)
+	framework.RegisterFunction(doltgres_patch_text_text)
+	framework.RegisterFunction(doltgres_patch_text_text_text)
+}
+
+// patchColumns are the columns returned by the patch functions, which match those of Dolt's dolt_patch.
+var patchColumns = []framework.RecordColumn{
+	{Name: "statement_order", Type: pgtypes.Int64},
+	{Name: "from_commit_hash", Type: pgtypes.Text},
+	{Name: "to_commit_hash", Type: pgtypes.Text},
+	{Name: "table_name", Type: pgtypes.Text},
+	{Name: "diff_type", Type: pgtypes.Text},
+	{Name: "statement", Type: pgtypes.Text},
+}
+
+// doltgres_patch_text is called in place of dolt_patch, returning the statements that change every table from one ref
+// to another, which are given as a single "from..to" argument. This function is specific to Doltgres.
+var doltgres_patch_text = framework.RecordFunction{
+	FunctionInterface: framework.Function1{
+		Name:               "doltgres_patch",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{pgtypes.Text},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+			return patchRows(ctx, val)
+		},
+	},
+	Columns:    patchColumns,
+	ReturnsSet: true,
+}
+
+// doltgres_patch_text_text is called in place of dolt_patch, returning the statements that change every table from
+// one ref to the other. A "from..to" argument may instead be followed by a table name. This function is specific to
+// Doltgres.
+var doltgres_patch_text_text = framework.RecordFunction{
+	FunctionInterface: framework.Function2{
+		Name:               "doltgres_patch",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+			return patchRows(ctx, val1, val2)
+		},
+	},
+	Columns:    patchColumns,
+	ReturnsSet: true,
+}
+
+// doltgres_patch_text_text_text is called in place of dolt_patch, returning the statements that change the given table
+// from one ref to the other. This function is specific to Doltgres.
+var doltgres_patch_text_text_text = framework.RecordFunction{
+	FunctionInterface: framework.Function3{
+		Name:               "doltgres_patch",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text, pgtypes.Text},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+			return patchRows(ctx, val1, val2, val3)
+		},
+	},
+	Columns:    patchColumns,
+	ReturnsSet: true,
+}
+
+// patchRows returns a row for every statement that is needed to change the tables from one ref to the other, as named
+// by the arguments. The schema statements of each table are followed by its data statements.
+func patchRows(ctx *sql.Context, args ...any) ([][]any, error) {
+	refDiff, err := newRefDiff(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	rows := [][]any{}
+	addRows := func(tableName string, diffType string, statements []string) {
+		for _, statement := range statements {
+			rows = append(rows, []any{int64(len(rows) + 1), refDiff.fromHash, refDiff.toHash, tableName, diffType, statement})
+		}
+	}
+	for _, td := range refDiff.deltas {
+		tableName := td.ToName.Name
+		var schemaStatements []string
+		switch {
+		case td.IsAdd():
+			schemaStatements = tableDefinition(td.ToName, td.ToSch, td.ToFks, td.ToFksParentSch)
+		case td.IsDrop():
+			tableName = td.FromName.Name
+			schemaStatements = []string{fmt.Sprintf("DROP TABLE %s;", qualifiedTableName(td.FromName))}
+		default:
+			schemaStatements = alterTableStatements(td)
+		}
+		addRows(tableName, "schema", schemaStatements)
+		if td.IsDrop() {
+			// The rows are removed by DROP TABLE
+			continue
+		}
+		if !td.IsAdd() && !schema.ArePrimaryKeySetsDiffable(td.Format(), td.FromSch, td.ToSch) {
+			notices.RaiseWarning(ctx, fmt.Sprintf("Primary key sets differ between revisions for table '%s', skipping data diff", tableName))
+			continue
+		}
+		dataStatements, err := dataPatchStatements(ctx, td)
+		if err != nil {
+			return nil, err
+		}
+		addRows(tableName, "data", dataStatements)
+	}
+	return rows, nil
+}
+
+// dataPatchStatements returns the INSERT, UPDATE, and DELETE statements that change the rows of the table from the
+// delta's from side to its to side.
+func dataPatchStatements(ctx *sql.Context, td diff.TableDelta) ([]string, error) {
+	var fromDecoder, toDecoder *patchRowDecoder
+	var fromRows *core.RowDecoder
+	if td.FromTable != nil {
+		fromDecoder = newPatchRowDecoder(td.FromSch, td.FromTable.NodeStore())
+		fromRows = fromDecoder.rows
+	}
+	toDecoder = newPatchRowDecoder(td.ToSch, td.ToTable.NodeStore())
+	tableName := qualifiedTableName(td.ToName)
+	var statements []string
+	err := core.DiffTableRows(ctx, td, func(ctx context.Context, d tree.Diff) error {
+		decoded, err := core.DecodeRowDiff(ctx, d, fromRows, toDecoder.rows)
+		if err != nil {
+			return err
+		}
+		var before, after map[string]string
+		if decoded.Before != nil {
+			if before, err = fromDecoder.literals(decoded.Before); err != nil {
+				return err
+			}
+		}
+		if decoded.After != nil {
+			if after, err = toDecoder.literals(decoded.After); err != nil {
+				return err
+			}
+		}
+		if toDecoder.rows.IsKeyless() {
+			// Keyless tables store a count of identical rows, so a single diff may represent several rows
+			for afterCount := decoded.AfterCount; afterCount > decoded.BeforeCount; afterCount-- {
+				statements = append(statements, toDecoder.insertStatement(tableName, after))
+			}
+			for beforeCount := decoded.BeforeCount; beforeCount > decoded.AfterCount; beforeCount-- {
+				statements = append(statements, toDecoder.keylessDeleteStatement(tableName, before))
+			}
+			return nil
+		}
+		switch d.Type {
+		case tree.AddedDiff:
+			statements = append(statements, toDecoder.insertStatement(tableName, after))
+		case tree.RemovedDiff:
+			statements = append(statements, toDecoder.deleteStatement(tableName, before))
+		default:
+			if statement := toDecoder.updateStatement(tableName, before, after); len(statement) > 0 {
+				statements = append(statements, statement)
+			}
+		}
+		return nil
+	})
+	return statements, err
+}
+
+// patchRowDecoder reads the values of a table's rows, keyed by their column names, and writes the statements that
+// modify those rows.
+type patchRowDecoder struct {
+	rows    *core.RowDecoder
+	columns []schema.Column
+	pkNames []string
+}
+
+// newPatchRowDecoder returns a decoder for rows of the given schema.
+func newPatchRowDecoder(sch schema.Schema, ns tree.NodeStore) *patchRowDecoder {
+	rows := core.NewRowDecoder(sch, ns)
+	return &patchRowDecoder{
+		rows:    rows,
+		columns: rows.Columns(),
+		pkNames: sch.GetPKCols().GetColumnNames(),
+	}
+}
+
+// literals returns the given values of a decoded row, keyed by their column names. The values are written as they
+// would be within a statement.
+func (decoder *patchRowDecoder) literals(fields []any) (map[string]string, error) {
+	row := make(map[string]string, len(decoder.columns))
+	for i, col := range decoder.columns {
+		var err error
+		if row[col.Name], err = patchLiteral(col.TypeInfo.ToSqlType(), fields[i]); err != nil {
+			return nil, err
+		}
+	}
+	return row, nil
+}
+
+// insertStatement returns the statement that inserts the given row.
+func (decoder *patchRowDecoder) insertStatement(tableName string, row map[string]string) string {
+	names := make([]string, len(decoder.columns))
+	values := make([]string, len(decoder.columns))
+	for i, col := range decoder.columns {
+		names[i] = pgtree.NameString(col.Name)
+		values[i] = row[col.Name]
+	}
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s);", tableName, strings.Join(names, ", "), strings.Join(values, ", "))
+}
+
+// updateStatement returns the statement that changes the columns of the row that differ between the two versions.
+// Returns an empty string if no column differs.
+func (decoder *patchRowDecoder) updateStatement(tableName string, before map[string]string, after map[string]string) string {
+	var assignments []string
+	for _, col := range decoder.columns {
+		value := after[col.Name]
+		if beforeValue, ok := before[col.Name]; ok && beforeValue == value {
+			continue
+		} else if !ok && value == "NULL" {
+			continue
+		}
+		assignments = append(assignments, fmt.Sprintf("%s = %s", pgtree.NameString(col.Name), value))
+	}
+	if len(assignments) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("UPDATE %s SET %s WHERE %s;", tableName, strings.Join(assignments, ", "), decoder.keyCondition(after))
+}
+
+// deleteStatement returns the statement that deletes the given row.
+func (decoder *patchRowDecoder) deleteStatement(tableName string, row map[string]string) string {
+	return fmt.Sprintf("DELETE FROM %s WHERE %s;", tableName, decoder.keyCondition(row))
+}
+
+// keylessDeleteStatement returns the statement that deletes a single copy of the given row from a table without a
+// primary key. Postgres does not allow a limit on DELETE, so the row is found by its ctid.
+func (decoder *patchRowDecoder) keylessDeleteStatement(tableName string, row map[string]string) string {
+	conditions := make([]string, 0, len(row))
+	for _, col := range decoder.columns {
+		value, ok := row[col.Name]
+		if !ok {
+			continue
+		}
+		if value == "NULL" {
+			conditions = append(conditions, fmt.Sprintf("%s IS NULL", pgtree.NameString(col.Name)))
+		} else {
+			conditions = append(conditions, fmt.Sprintf("%s = %s", pgtree.NameString(col.Name), value))
+		}
+	}
+	return fmt.Sprintf("DELETE FROM %s WHERE ctid = (SELECT ctid FROM %s WHERE %s LIMIT 1);", tableName, tableName,
+		strings.Join(conditions, " AND "))
+}
+
+// keyCondition returns the condition that matches the primary key of the given row.
+func (decoder *patchRowDecoder) keyCondition(row map[string]string) string {
+	conditions := make([]string, len(decoder.pkNames))
+	for i, name := range decoder.pkNames {
+		conditions[i] = fmt.Sprintf("%s = %s", pgtree.NameString(name), row[name])
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+// patchLiteral returns the value as it would be written within a statement. Numbers and booleans are written as-is,
+// while all other values are written as string literals, which Postgres assigns to the column's type.
+func patchLiteral(typ sql.Type, value any) (string, error) {
+	if value == nil {
+		return "NULL", nil
+	}
+	dgType, ok := typ.(pgtypes.DoltgresType)
+	if !ok {
+		return quoteLiteral(fmt.Sprint(value)), nil
+	}
+	text, err := dgType.IoOutput(value)
+	if err != nil {
+		return "", err
+	}
+	switch dgType.BaseID() {
+	case pgtypes.DoltgresTypeBaseID_Bool:
+		if text == "t" || text == "true" {
+			return "true", nil
+		}
+		return "false", nil
+	case pgtypes.DoltgresTypeBaseID_Int16, pgtypes.DoltgresTypeBaseID_Int32, pgtypes.DoltgresTypeBaseID_Int64,
+		pgtypes.DoltgresTypeBaseID_Int16Serial, pgtypes.DoltgresTypeBaseID_Int32Serial, pgtypes.DoltgresTypeBaseID_Int64Serial,
+		pgtypes.DoltgresTypeBaseID_Float32, pgtypes.DoltgresTypeBaseID_Float64, pgtypes.DoltgresTypeBaseID_Numeric:
+		// Special values, such as NaN and Infinity, are only accepted as strings
+		if len(text) > 0 && (text[0] == '-' || (text[0] >= '0' && text[0] <= '9')) && text != "-Infinity" {
+			return text, nil
+		}
+	}
+	return quoteLiteral(text), nil
+}
+
+// quoteLiteral returns the given string as a string literal.
+func quoteLiteral(str string) string {
+	return "'" + strings.ReplaceAll(str, "'", "''") + "'"
+}
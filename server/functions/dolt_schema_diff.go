@@ -0,0 +1,528 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initDoltSchemaDiff registers the functions to the catalog.
+func initDoltSchemaDiff() {
+	framework.RegisterFunction(doltgres_schema_diff_text)
+	framework.RegisterFunction(doltgres_schema_diff_text_text)
+	framework.RegisterFunction(doltgres_schema_diff_text_text_text)
+}
+
+// schemaDiffColumns are the columns returned by the schema diff functions, which match those of Dolt's dolt_schema_diff.
+var schemaDiffColumns = []framework.RecordColumn{
+	{Name: "from_table_name", Type: pgtypes.Text},
+	{Name: "to_table_name", Type: pgtypes.Text},
+	{Name: "from_create_statement", Type: pgtypes.Text},
+	{Name: "to_create_statement", Type: pgtypes.Text},
+}
+
+// doltgres_schema_diff_text is called in place of dolt_schema_diff, returning the definitions of every table whose
+// schema differs between two refs, which are given as a single "from..to" argument. This function is specific to
+// Doltgres.
+var doltgres_schema_diff_text = framework.RecordFunction{
+	FunctionInterface: framework.Function1{
+		Name:               "doltgres_schema_diff",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{pgtypes.Text},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+			return schemaDiffRows(ctx, val)
+		},
+	},
+	Columns:    schemaDiffColumns,
+	ReturnsSet: true,
+}
+
+// doltgres_schema_diff_text_text is called in place of dolt_schema_diff, returning the definitions of every table whose
+// schema differs between the two refs. A "from..to" argument may instead be followed by a table name. This function is
+// specific to Doltgres.
+var doltgres_schema_diff_text_text = framework.RecordFunction{
+	FunctionInterface: framework.Function2{
+		Name:               "doltgres_schema_diff",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+			return schemaDiffRows(ctx, val1, val2)
+		},
+	},
+	Columns:    schemaDiffColumns,
+	ReturnsSet: true,
+}
+
+// doltgres_schema_diff_text_text_text is called in place of dolt_schema_diff, returning the definitions of the given
+// table when its schema differs between the two refs. This function is specific to Doltgres.
+var doltgres_schema_diff_text_text_text = framework.RecordFunction{
+	FunctionInterface: framework.Function3{
+		Name:               "doltgres_schema_diff",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text, pgtypes.Text},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+			return schemaDiffRows(ctx, val1, val2, val3)
+		},
+	},
+	Columns:    schemaDiffColumns,
+	ReturnsSet: true,
+}
+
+// schemaDiffRows returns a row for every table whose schema differs between the refs named by the arguments.
+func schemaDiffRows(ctx *sql.Context, args ...any) ([][]any, error) {
+	refDiff, err := newRefDiff(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	rows := [][]any{}
+	for _, td := range refDiff.deltas {
+		changed, err := td.HasSchemaChanged(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !changed && !td.IsRename() {
+			continue
+		}
+		var fromName, toName, fromCreate, toCreate string
+		if td.FromTable != nil {
+			fromName = td.FromName.Name
+			fromCreate = strings.Join(tableDefinition(td.FromName, td.FromSch, td.FromFks, td.FromFksParentSch), "\n")
+		}
+		if td.ToTable != nil {
+			toName = td.ToName.Name
+			toCreate = strings.Join(tableDefinition(td.ToName, td.ToSch, td.ToFks, td.ToFksParentSch), "\n")
+		}
+		rows = append(rows, []any{fromName, toName, fromCreate, toCreate})
+	}
+	return rows, nil
+}
+
+// refDiff holds the tables that differ between two refs, as given to the functions that are called in place of Dolt's
+// diff table functions.
+type refDiff struct {
+	fromHash string
+	toHash   string
+	deltas   []diff.TableDelta
+}
+
+// newRefDiff returns the differences between the refs named by the arguments, which are either the two refs followed
+// by an optional table name, or a single "from..to" argument followed by an optional table name. A "from...to" argument
+// compares "to" against the merge base of both refs, so that only the changes made on "to" are returned. Dolt's system
+// tables are not included, and the deltas are sorted by their schema and table name.
+func newRefDiff(ctx *sql.Context, args []any) (*refDiff, error) {
+	for _, arg := range args {
+		if arg == nil {
+			return nil, fmt.Errorf("ref and table name arguments cannot be null")
+		}
+	}
+	var fromRef, toRef, tableName string
+	if refs := args[0].(string); strings.Contains(refs, "..") {
+		if len(args) > 2 {
+			return nil, fmt.Errorf("a table name must directly follow the \"from..to\" argument")
+		}
+		if left, right, ok := strings.Cut(refs, "..."); ok {
+			ancestor, err := mergeBase(ctx, left, right)
+			if err != nil {
+				return nil, err
+			}
+			fromRef, toRef = ancestor, right
+		} else {
+			fromRef, toRef, _ = strings.Cut(refs, "..")
+		}
+		if len(args) == 2 {
+			tableName = args[1].(string)
+		}
+	} else {
+		if len(args) < 2 {
+			return nil, fmt.Errorf("two refs, or a single \"from..to\" argument, must be given")
+		}
+		fromRef, toRef = refs, args[1].(string)
+		if len(args) == 3 {
+			tableName = args[2].(string)
+		}
+	}
+	session := dsess.DSessFromSess(ctx.Session)
+	fromRoot, _, fromHash, err := session.ResolveRootForRef(ctx, ctx.GetCurrentDatabase(), fromRef)
+	if err != nil {
+		return nil, err
+	}
+	toRoot, _, toHash, err := session.ResolveRootForRef(ctx, ctx.GetCurrentDatabase(), toRef)
+	if err != nil {
+		return nil, err
+	}
+	deltas, err := diff.GetTableDeltas(ctx, fromRoot, toRoot)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := refDiffTableFilter(ctx, tableName)
+	if err != nil {
+		return nil, err
+	}
+	rd := &refDiff{fromHash: fromHash, toHash: toHash}
+	for _, td := range deltas {
+		if td.FromTable == nil && td.ToTable == nil {
+			// Collation changes are reported as a delta without any tables
+			continue
+		}
+		name := td.ToName
+		if td.ToTable == nil {
+			name = td.FromName
+		}
+		if doltdb.HasDoltPrefix(name.Name) || doltdb.IsFullTextTable(name.Name) {
+			continue
+		}
+		if filter(td.FromName) || filter(td.ToName) {
+			rd.deltas = append(rd.deltas, td)
+		}
+	}
+	sort.Slice(rd.deltas, func(i, j int) bool {
+		iName, jName := rd.deltas[i].ToName, rd.deltas[j].ToName
+		if rd.deltas[i].ToTable == nil {
+			iName = rd.deltas[i].FromName
+		}
+		if rd.deltas[j].ToTable == nil {
+			jName = rd.deltas[j].FromName
+		}
+		if iName.Schema != jName.Schema {
+			return iName.Schema < jName.Schema
+		}
+		return iName.Name < jName.Name
+	})
+	return rd, nil
+}
+
+// refDiffTableFilter returns a function that reports whether a table matches the given name, which may be qualified by
+// its schema. Unqualified names refer to the current schema, and an empty name matches every table.
+func refDiffTableFilter(ctx *sql.Context, tableName string) (func(doltdb.TableName) bool, error) {
+	if len(tableName) == 0 {
+		return func(doltdb.TableName) bool { return true }, nil
+	}
+	schemaName, name, ok := strings.Cut(tableName, ".")
+	if !ok {
+		var err error
+		if schemaName, err = core.GetCurrentSchema(ctx); err != nil {
+			return nil, err
+		}
+		name = tableName
+	}
+	schemaName, name = unquoteIdentifier(schemaName), unquoteIdentifier(name)
+	return func(tn doltdb.TableName) bool {
+		return tn.Name == name && tn.Schema == schemaName
+	}, nil
+}
+
+// tableDefinition returns the statements that create the given table, which begin with CREATE TABLE and are followed
+// by a CREATE INDEX for each of the table's non-unique indexes. Primary keys, unique indexes, checks, and foreign keys
+// are written as constraints within CREATE TABLE.
+func tableDefinition(name doltdb.TableName, sch schema.Schema, fks []doltdb.ForeignKey, parentSchs map[doltdb.TableName]schema.Schema) []string {
+	var lines []string
+	for _, col := range sch.GetAllCols().GetColumns() {
+		lines = append(lines, "    "+columnDefinition(col))
+	}
+	if pkCols := sch.GetPKCols(); pkCols.Size() > 0 {
+		lines = append(lines, fmt.Sprintf("    PRIMARY KEY (%s)", quoteIdentifiers(pkCols.GetColumnNames())))
+	}
+	for _, index := range userIndexes(sch) {
+		if index.IsUnique() {
+			lines = append(lines, "    "+indexConstraintDefinition(index))
+		}
+	}
+	for _, check := range sch.Checks().AllChecks() {
+		lines = append(lines, "    "+checkConstraintDefinition(check))
+	}
+	for _, fk := range sortedForeignKeys(fks) {
+		lines = append(lines, "    "+foreignKeyConstraintDefinition(name, sch, parentSchs, fk))
+	}
+	statements := []string{fmt.Sprintf("CREATE TABLE %s (\n%s\n);", qualifiedTableName(name), strings.Join(lines, ",\n"))}
+	for _, index := range userIndexes(sch) {
+		if !index.IsUnique() {
+			statements = append(statements, createIndexStatement(name, index))
+		}
+	}
+	return statements
+}
+
+// alterTableStatements returns the statements that change the definition of the table from the delta's from schema to
+// its to schema. Everything that is removed is dropped before anything is added, so that names may be reused.
+func alterTableStatements(td diff.TableDelta) []string {
+	var statements []string
+	alterTable := func(name doltdb.TableName, format string, args ...any) {
+		statements = append(statements, fmt.Sprintf("ALTER TABLE %s %s;", qualifiedTableName(name), fmt.Sprintf(format, args...)))
+	}
+	from, to := td.FromSch, td.ToSch
+	// Removals use the table's original name, as it is only renamed once they're done
+	fromFks := foreignKeysByName(td.FromFks)
+	toFks := foreignKeysByName(td.ToFks)
+	for _, fk := range sortedForeignKeys(td.FromFks) {
+		if toFk, ok := toFks[fk.Name]; !ok || foreignKeyConstraintDefinition(td.FromName, from, td.FromFksParentSch, fk) != foreignKeyConstraintDefinition(td.ToName, to, td.ToFksParentSch, toFk) {
+			alterTable(td.FromName, "DROP CONSTRAINT %s", tree.NameString(fk.Name))
+		}
+	}
+	toChecks := checksByName(to)
+	for _, check := range from.Checks().AllChecks() {
+		if toCheck, ok := toChecks[check.Name()]; !ok || toCheck.Expression() != check.Expression() {
+			alterTable(td.FromName, "DROP CONSTRAINT %s", tree.NameString(check.Name()))
+		}
+	}
+	toIndexes := indexesByName(to)
+	for _, index := range userIndexes(from) {
+		if toIndex, ok := toIndexes[index.Name()]; ok && sameIndex(toIndex, index) {
+			continue
+		}
+		if index.IsUnique() {
+			alterTable(td.FromName, "DROP CONSTRAINT %s", tree.NameString(index.Name()))
+		} else {
+			statements = append(statements, fmt.Sprintf("DROP INDEX %s.%s;", tree.NameString(td.FromName.Schema), tree.NameString(index.Name())))
+		}
+	}
+	fromPk, toPk := from.GetPKCols().GetColumnNames(), to.GetPKCols().GetColumnNames()
+	pkChanged := quoteIdentifiers(fromPk) != quoteIdentifiers(toPk)
+	if pkChanged && len(fromPk) > 0 {
+		alterTable(td.FromName, "DROP CONSTRAINT %s", tree.NameString(td.FromName.Name+"_pkey"))
+	}
+	toCols := to.GetAllCols()
+	for _, col := range from.GetAllCols().GetColumns() {
+		if _, ok := toCols.GetByTag(col.Tag); !ok {
+			alterTable(td.FromName, "DROP COLUMN %s", tree.NameString(col.Name))
+		}
+	}
+	if td.IsRename() {
+		if td.FromName.Schema != td.ToName.Schema {
+			alterTable(td.FromName, "SET SCHEMA %s", tree.NameString(td.ToName.Schema))
+		}
+		if td.FromName.Name != td.ToName.Name {
+			alterTable(doltdb.TableName{Name: td.FromName.Name, Schema: td.ToName.Schema}, "RENAME TO %s", tree.NameString(td.ToName.Name))
+		}
+	}
+	fromCols := from.GetAllCols()
+	for _, col := range toCols.GetColumns() {
+		fromCol, ok := fromCols.GetByTag(col.Tag)
+		if !ok {
+			alterTable(td.ToName, "ADD COLUMN %s", columnDefinition(col))
+			continue
+		}
+		if fromCol.Name != col.Name {
+			alterTable(td.ToName, "RENAME COLUMN %s TO %s", tree.NameString(fromCol.Name), tree.NameString(col.Name))
+		}
+		if typeName := columnTypeName(col); columnTypeName(fromCol) != typeName {
+			alterTable(td.ToName, "ALTER COLUMN %s TYPE %s", tree.NameString(col.Name), typeName)
+		}
+		if fromCol.IsNullable() && !col.IsNullable() {
+			alterTable(td.ToName, "ALTER COLUMN %s SET NOT NULL", tree.NameString(col.Name))
+		} else if !fromCol.IsNullable() && col.IsNullable() {
+			alterTable(td.ToName, "ALTER COLUMN %s DROP NOT NULL", tree.NameString(col.Name))
+		}
+		if fromCol.Default != col.Default {
+			if len(col.Default) > 0 {
+				alterTable(td.ToName, "ALTER COLUMN %s SET DEFAULT %s", tree.NameString(col.Name), col.Default)
+			} else {
+				alterTable(td.ToName, "ALTER COLUMN %s DROP DEFAULT", tree.NameString(col.Name))
+			}
+		}
+	}
+	if pkChanged && len(toPk) > 0 {
+		alterTable(td.ToName, "ADD PRIMARY KEY (%s)", quoteIdentifiers(toPk))
+	}
+	fromIndexes := indexesByName(from)
+	for _, index := range userIndexes(to) {
+		if fromIndex, ok := fromIndexes[index.Name()]; ok && sameIndex(fromIndex, index) {
+			continue
+		}
+		if index.IsUnique() {
+			alterTable(td.ToName, "ADD %s", indexConstraintDefinition(index))
+		} else {
+			statements = append(statements, createIndexStatement(td.ToName, index))
+		}
+	}
+	fromChecks := checksByName(from)
+	for _, check := range to.Checks().AllChecks() {
+		if fromCheck, ok := fromChecks[check.Name()]; !ok || fromCheck.Expression() != check.Expression() {
+			alterTable(td.ToName, "ADD %s", checkConstraintDefinition(check))
+		}
+	}
+	for _, fk := range sortedForeignKeys(td.ToFks) {
+		if fromFk, ok := fromFks[fk.Name]; !ok || foreignKeyConstraintDefinition(td.FromName, from, td.FromFksParentSch, fromFk) != foreignKeyConstraintDefinition(td.ToName, to, td.ToFksParentSch, fk) {
+			alterTable(td.ToName, "ADD %s", foreignKeyConstraintDefinition(td.ToName, to, td.ToFksParentSch, fk))
+		}
+	}
+	return statements
+}
+
+// qualifiedTableName returns the name of the table qualified by its schema, quoting each name when needed.
+func qualifiedTableName(name doltdb.TableName) string {
+	if len(name.Schema) == 0 {
+		return tree.NameString(name.Name)
+	}
+	return tree.NameString(name.Schema) + "." + tree.NameString(name.Name)
+}
+
+// quoteIdentifiers returns the given names separated by commas, quoting each name when needed.
+func quoteIdentifiers(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = tree.NameString(name)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// columnDefinition returns the definition of the column as it is written within CREATE TABLE.
+func columnDefinition(col schema.Column) string {
+	def := tree.NameString(col.Name) + " " + columnTypeName(col)
+	if len(col.Generated) > 0 {
+		def += fmt.Sprintf(" GENERATED ALWAYS AS (%s) STORED", col.Generated)
+	} else if len(col.Default) > 0 {
+		def += " DEFAULT " + col.Default
+	}
+	if !col.IsNullable() {
+		def += " NOT NULL"
+	}
+	return def
+}
+
+// columnTypeName returns the name of the column's type, as it is shown by format_type.
+func columnTypeName(col schema.Column) string {
+	typ := catalogColumnType(col)
+	if typ == nil {
+		return strings.ToLower(col.TypeInfo.ToSqlType().String())
+	}
+	return formatType(typ.OID(), catalogTypeModifier(typ), true)
+}
+
+// userIndexes returns the indexes of the schema that were created by the user, sorted by their names.
+func userIndexes(sch schema.Schema) []schema.Index {
+	var indexes []schema.Index
+	for _, index := range sch.Indexes().AllIndexes() {
+		if index.IsUserDefined() {
+			indexes = append(indexes, index)
+		}
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		return indexes[i].Name() < indexes[j].Name()
+	})
+	return indexes
+}
+
+// indexesByName returns the user-defined indexes of the schema, keyed by their names.
+func indexesByName(sch schema.Schema) map[string]schema.Index {
+	indexes := make(map[string]schema.Index)
+	for _, index := range userIndexes(sch) {
+		indexes[index.Name()] = index
+	}
+	return indexes
+}
+
+// sameIndex returns whether the two indexes have the same definition.
+func sameIndex(index1 schema.Index, index2 schema.Index) bool {
+	return index1.IsUnique() == index2.IsUnique() && quoteIdentifiers(index1.ColumnNames()) == quoteIdentifiers(index2.ColumnNames())
+}
+
+// indexConstraintDefinition returns the definition of the unique index as a constraint.
+func indexConstraintDefinition(index schema.Index) string {
+	return fmt.Sprintf("CONSTRAINT %s UNIQUE (%s)", tree.NameString(index.Name()), quoteIdentifiers(index.ColumnNames()))
+}
+
+// createIndexStatement returns the statement that creates the given non-unique index, matching pg_get_indexdef.
+func createIndexStatement(name doltdb.TableName, index schema.Index) string {
+	return fmt.Sprintf("CREATE INDEX %s ON %s USING btree (%s);", tree.NameString(index.Name()), qualifiedTableName(name),
+		quoteIdentifiers(index.ColumnNames()))
+}
+
+// checksByName returns the checks of the schema, keyed by their names.
+func checksByName(sch schema.Schema) map[string]schema.Check {
+	checks := make(map[string]schema.Check)
+	for _, check := range sch.Checks().AllChecks() {
+		checks[check.Name()] = check
+	}
+	return checks
+}
+
+// checkConstraintDefinition returns the definition of the check as a constraint.
+func checkConstraintDefinition(check schema.Check) string {
+	return fmt.Sprintf("CONSTRAINT %s CHECK (%s)", tree.NameString(check.Name()), stripEnclosingParens(check.Expression()))
+}
+
+// foreignKeysByName returns the given foreign keys, keyed by their names.
+func foreignKeysByName(fks []doltdb.ForeignKey) map[string]doltdb.ForeignKey {
+	byName := make(map[string]doltdb.ForeignKey, len(fks))
+	for _, fk := range fks {
+		byName[fk.Name] = fk
+	}
+	return byName
+}
+
+// sortedForeignKeys returns the given foreign keys sorted by their names.
+func sortedForeignKeys(fks []doltdb.ForeignKey) []doltdb.ForeignKey {
+	sorted := append([]doltdb.ForeignKey(nil), fks...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+	return sorted
+}
+
+// foreignKeyConstraintDefinition returns the definition of the foreign key as a constraint of the given table. Foreign
+// keys only record the name of their referenced table, which is resolved using the schemas of the referenced tables,
+// preferring the one in the same schema as the given table.
+func foreignKeyConstraintDefinition(name doltdb.TableName, sch schema.Schema, parentSchs map[doltdb.TableName]schema.Schema, fk doltdb.ForeignKey) string {
+	columns := make([]string, len(fk.TableColumns))
+	for i, tag := range fk.TableColumns {
+		if col, ok := sch.GetAllCols().GetByTag(tag); ok {
+			columns[i] = col.Name
+		}
+	}
+	parentName := doltdb.TableName{Name: fk.ReferencedTableName, Schema: name.Schema}
+	var parentSch schema.Schema
+	for tableName, tableSch := range parentSchs {
+		if tableName.Name == fk.ReferencedTableName && (parentSch == nil || tableName.Schema == name.Schema) {
+			parentName, parentSch = tableName, tableSch
+		}
+	}
+	parentColumns := fk.UnresolvedFKDetails.ReferencedTableColumns
+	if parentSch != nil && len(fk.ReferencedTableColumns) > 0 {
+		parentColumns = make([]string, len(fk.ReferencedTableColumns))
+		for i, tag := range fk.ReferencedTableColumns {
+			if col, ok := parentSch.GetAllCols().GetByTag(tag); ok {
+				parentColumns[i] = col.Name
+			}
+		}
+	}
+	if len(parentName.Schema) == 0 {
+		parentName.Schema = name.Schema
+	}
+	def := fmt.Sprintf("CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s(%s)", tree.NameString(fk.Name), quoteIdentifiers(columns),
+		qualifiedTableName(parentName), quoteIdentifiers(parentColumns))
+	if action := referentialActionClause(fk.OnUpdate); len(action) > 0 {
+		def += " ON UPDATE " + action
+	}
+	if action := referentialActionClause(fk.OnDelete); len(action) > 0 {
+		def += " ON DELETE " + action
+	}
+	return def
+}
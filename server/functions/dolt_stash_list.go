@@ -0,0 +1,65 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initDoltStashList registers the functions to the catalog.
+func initDoltStashList() {
+	framework.RegisterFunction(dolt_stash_list)
+}
+
+// dolt_stash_list is the source of the dolt_stashes view, returning every stash that was made using dolt_stash, with
+// the most recent stash first. This function is specific to Doltgres.
+var dolt_stash_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "dolt_stash_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			dbData, ok := dsess.DSessFromSess(ctx.Session).GetDbData(ctx, ctx.GetCurrentDatabase())
+			if !ok {
+				return nil, sql.ErrDatabaseNotFound.New(ctx.GetCurrentDatabase())
+			}
+			stashes, err := dbData.Ddb.GetStashes(ctx)
+			if err != nil {
+				return nil, err
+			}
+			rows := make([][]any, len(stashes))
+			for i, stash := range stashes {
+				commitHash, err := stash.HeadCommit.HashOf()
+				if err != nil {
+					return nil, err
+				}
+				rows[i] = []any{stash.Name, stash.BranchName, commitHash.String(), stash.Description}
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "stash_id", Type: pgtypes.Text},
+		{Name: "branch", Type: pgtypes.Text},
+		{Name: "commit_hash", Type: pgtypes.Text},
+		{Name: "message", Type: pgtypes.Text},
+	},
+	ReturnsSet: true,
+}
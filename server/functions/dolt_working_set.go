@@ -0,0 +1,139 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"context"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initDoltWorkingSet registers the functions to the catalog.
+func initDoltWorkingSet() {
+	framework.RegisterFunction(dolt_staged_list)
+	framework.RegisterFunction(dolt_unstaged_list)
+}
+
+// workingSetColumns are the columns returned by the working set functions.
+var workingSetColumns = []framework.RecordColumn{
+	{Name: "schema_name", Type: pgtypes.Text},
+	{Name: "table_name", Type: pgtypes.Text},
+	{Name: "status", Type: pgtypes.Text},
+	{Name: "rows_added", Type: pgtypes.Int64},
+	{Name: "rows_deleted", Type: pgtypes.Int64},
+	{Name: "rows_modified", Type: pgtypes.Int64},
+}
+
+// dolt_staged_list is the source of the dolt_staged view, returning every table with changes that have been staged
+// for the next commit, along with the number of rows that were changed. This function is specific to Doltgres.
+var dolt_staged_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "dolt_staged_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			staged, _, err := workingSetDeltas(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return workingSetRows(ctx, staged)
+		},
+	},
+	Columns:    workingSetColumns,
+	ReturnsSet: true,
+}
+
+// dolt_unstaged_list is the source of the dolt_unstaged view, returning every table with changes in the working set
+// that have not been staged, along with the number of rows that were changed. This function is specific to Doltgres.
+var dolt_unstaged_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "dolt_unstaged_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			_, unstaged, err := workingSetDeltas(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return workingSetRows(ctx, unstaged)
+		},
+	},
+	Columns:    workingSetColumns,
+	ReturnsSet: true,
+}
+
+// workingSetDeltas returns the staged (HEAD to STAGED) and unstaged (STAGED to WORKING) table deltas of the current
+// database.
+func workingSetDeltas(ctx *sql.Context) (staged []diff.TableDelta, unstaged []diff.TableDelta, err error) {
+	session := dsess.DSessFromSess(ctx.Session)
+	roots, ok := session.GetRoots(ctx, ctx.GetCurrentDatabase())
+	if !ok {
+		return nil, nil, sql.ErrDatabaseNotFound.New(ctx.GetCurrentDatabase())
+	}
+	return diff.GetStagedUnstagedTableDeltas(ctx, roots)
+}
+
+// workingSetRows returns a row for each table within the deltas. The status matches the one reported by dolt_status.
+func workingSetRows(ctx *sql.Context, deltas []diff.TableDelta) ([][]any, error) {
+	rows := make([][]any, 0, len(deltas))
+	for _, td := range deltas {
+		if td.FromTable == nil && td.ToTable == nil {
+			// Collation changes are reported as a delta without any tables
+			continue
+		}
+		name := td.ToName
+		if td.ToTable == nil {
+			name = td.FromName
+		}
+		if doltdb.IsFullTextTable(name.Name) {
+			continue
+		}
+		var added, deleted, modified int64
+		err := core.DiffTableRows(ctx, td, func(_ context.Context, d tree.Diff) error {
+			switch d.Type {
+			case tree.AddedDiff:
+				added++
+			case tree.RemovedDiff:
+				deleted++
+			case tree.ModifiedDiff:
+				modified++
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		status := "modified"
+		if td.IsAdd() {
+			status = "new table"
+		} else if td.IsDrop() {
+			status = "deleted"
+		} else if td.IsRename() {
+			status = "renamed"
+		}
+		rows = append(rows, []any{name.Schema, name.Name, status, added, deleted, modified})
+	}
+	return rows, nil
+}
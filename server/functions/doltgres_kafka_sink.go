@@ -0,0 +1,145 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/kafkasink"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initDoltgresKafkaSink registers the functions to the catalog.
+func initDoltgresKafkaSink() {
+	framework.RegisterFunction(doltgres_kafka_sink_subscribe_text_text)
+	framework.RegisterFunction(doltgres_kafka_sink_unsubscribe_text_text)
+	framework.RegisterFunction(doltgres_kafka_sink_subscriptions)
+	framework.RegisterFunction(doltgres_kafka_sink_status)
+}
+
+// doltgres_kafka_sink_subscribe_text_text publishes the committed changes of the tables matching the given branch and
+// table patterns within the current database, returning false if an identical subscription already exists.
+var doltgres_kafka_sink_subscribe_text_text = framework.Function2{
+	Name:               "doltgres_kafka_sink_subscribe",
+	Return:             pgtypes.Bool,
+	Parameters:         []pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		subscription, err := kafkaSinkSubscription(ctx, val1.(string), val2.(string))
+		if err != nil {
+			return nil, err
+		}
+		return kafkasink.Subscribe(subscription)
+	},
+}
+
+// doltgres_kafka_sink_unsubscribe_text_text removes the subscription with the given branch and table patterns within
+// the current database, returning whether it existed.
+var doltgres_kafka_sink_unsubscribe_text_text = framework.Function2{
+	Name:               "doltgres_kafka_sink_unsubscribe",
+	Return:             pgtypes.Bool,
+	Parameters:         []pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		subscription, err := kafkaSinkSubscription(ctx, val1.(string), val2.(string))
+		if err != nil {
+			return nil, err
+		}
+		return kafkasink.Unsubscribe(subscription)
+	},
+}
+
+// doltgres_kafka_sink_subscriptions returns every subscription of the Kafka sink. Empty patterns, which match
+// everything, are returned as NULL.
+var doltgres_kafka_sink_subscriptions = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "doltgres_kafka_sink_subscriptions",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			subscriptions, err := kafkasink.Subscriptions()
+			if err != nil {
+				return nil, err
+			}
+			rows := make([][]any, len(subscriptions))
+			for i, subscription := range subscriptions {
+				rows[i] = []any{nullIfEmpty(subscription.Database), nullIfEmpty(subscription.Branch), nullIfEmpty(subscription.Table)}
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "database", Type: pgtypes.Text},
+		{Name: "branch", Type: pgtypes.Text},
+		{Name: "table", Type: pgtypes.Text},
+	},
+	ReturnsSet: true,
+}
+
+// doltgres_kafka_sink_status returns the status of the Kafka sink.
+var doltgres_kafka_sink_status = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "doltgres_kafka_sink_status",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			status := kafkasink.GetStatus()
+			var lastSentAt any
+			if !status.LastSentAt.IsZero() {
+				lastSentAt = status.LastSentAt
+			}
+			return [][]any{{
+				status.Running,
+				status.Published,
+				status.Failed,
+				nullIfEmpty(status.LastError),
+				nullIfEmpty(status.LastCommit),
+				lastSentAt,
+			}}, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "running", Type: pgtypes.Bool},
+		{Name: "published", Type: pgtypes.Int64},
+		{Name: "failed", Type: pgtypes.Int64},
+		{Name: "last_error", Type: pgtypes.Text},
+		{Name: "last_commit", Type: pgtypes.Text},
+		{Name: "last_sent_at", Type: pgtypes.TimestampTZ},
+	},
+}
+
+// kafkaSinkSubscription returns a subscription for the given patterns within the current database. The revision of the
+// current database is ignored, as the branch is given by its pattern.
+func kafkaSinkSubscription(ctx *sql.Context, branch string, table string) (kafkasink.Subscription, error) {
+	database, _ := dsess.SplitRevisionDbName(ctx.GetCurrentDatabase())
+	if len(database) == 0 {
+		return kafkasink.Subscription{}, fmt.Errorf("no database selected")
+	}
+	return kafkasink.Subscription{Database: database, Branch: branch, Table: table}, nil
+}
+
+// nullIfEmpty returns nil for empty strings, and the string otherwise.
+func nullIfEmpty(s string) any {
+	if len(s) == 0 {
+		return nil
+	}
+	return s
+}
@@ -0,0 +1,140 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/killswitch"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initDoltgresKillSwitch registers the functions to the catalog.
+func initDoltgresKillSwitch() {
+	framework.RegisterFunction(doltgres_query_fingerprint_text)
+	framework.RegisterFunction(doltgres_kill_switch_add_text_text)
+	framework.RegisterFunction(doltgres_kill_switch_add_text_text_int64)
+	framework.RegisterFunction(doltgres_kill_switch_remove_text)
+	framework.RegisterFunction(doltgres_kill_switch_rules)
+}
+
+// doltgres_query_fingerprint_text returns the fingerprint of the given query, which is used by the kill switch.
+var doltgres_query_fingerprint_text = framework.Function1{
+	Name:       "doltgres_query_fingerprint",
+	Return:     pgtypes.Text,
+	Parameters: []pgtypes.DoltgresType{pgtypes.Text},
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		fingerprint, _, err := killswitch.FingerprintQuery(val.(string))
+		return fingerprint, err
+	},
+}
+
+// doltgres_kill_switch_add_text_text adds a kill switch rule for the given query or fingerprint, returning the
+// fingerprint that the rule applies to. Queries are canceled immediately when using the cancel action.
+var doltgres_kill_switch_add_text_text = framework.Function2{
+	Name:               "doltgres_kill_switch_add",
+	Return:             pgtypes.Text,
+	Parameters:         []pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return addKillSwitchRule(val1.(string), val2.(string), 0)
+	},
+}
+
+// doltgres_kill_switch_add_text_text_int64 adds a kill switch rule for the given query or fingerprint, returning the
+// fingerprint that the rule applies to. The last parameter is the number of milliseconds that a query may run before
+// it is canceled.
+var doltgres_kill_switch_add_text_text_int64 = framework.Function3{
+	Name:               "doltgres_kill_switch_add",
+	Return:             pgtypes.Text,
+	Parameters:         []pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text, pgtypes.Int64},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		return addKillSwitchRule(val1.(string), val2.(string), time.Duration(val3.(int64))*time.Millisecond)
+	},
+}
+
+// doltgres_kill_switch_remove_text removes the kill switch rule for the given query or fingerprint, returning whether
+// a rule was removed.
+var doltgres_kill_switch_remove_text = framework.Function1{
+	Name:               "doltgres_kill_switch_remove",
+	Return:             pgtypes.Bool,
+	Parameters:         []pgtypes.DoltgresType{pgtypes.Text},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		fingerprint, _, err := resolveKillSwitchFingerprint(val.(string))
+		if err != nil {
+			return nil, err
+		}
+		return killswitch.RemoveRule(fingerprint)
+	},
+}
+
+// doltgres_kill_switch_rules returns every kill switch rule.
+var doltgres_kill_switch_rules = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "doltgres_kill_switch_rules",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			rules := killswitch.Rules()
+			rows := make([][]any, len(rules))
+			for i, rule := range rules {
+				var query any
+				if len(rule.Query) > 0 {
+					query = rule.Query
+				}
+				rows[i] = []any{rule.Fingerprint, string(rule.Action), rule.CancelAfter.Milliseconds(), query, rule.CreatedAt}
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "fingerprint", Type: pgtypes.Text},
+		{Name: "action", Type: pgtypes.Text},
+		{Name: "cancel_after_ms", Type: pgtypes.Int64},
+		{Name: "query", Type: pgtypes.Text},
+		{Name: "created_at", Type: pgtypes.TimestampTZ},
+	},
+	ReturnsSet: true,
+}
+
+// addKillSwitchRule adds a rule for the given query or fingerprint, returning the fingerprint.
+func addKillSwitchRule(queryOrFingerprint string, action string, cancelAfter time.Duration) (string, error) {
+	fingerprint, normalized, err := resolveKillSwitchFingerprint(queryOrFingerprint)
+	if err != nil {
+		return "", err
+	}
+	err = killswitch.AddRule(killswitch.Rule{
+		Fingerprint: fingerprint,
+		Action:      killswitch.Action(action),
+		CancelAfter: cancelAfter,
+		Query:       normalized,
+	})
+	return fingerprint, err
+}
+
+// resolveKillSwitchFingerprint returns the fingerprint of the given query, or the input if it's already a fingerprint.
+// The normalized query is empty when given a fingerprint.
+func resolveKillSwitchFingerprint(queryOrFingerprint string) (fingerprint string, normalized string, err error) {
+	if killswitch.IsFingerprint(queryOrFingerprint) {
+		return queryOrFingerprint, "", nil
+	}
+	return killswitch.FingerprintQuery(queryOrFingerprint)
+}
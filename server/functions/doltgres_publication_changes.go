@@ -0,0 +1,388 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/publications"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/notices"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initDoltgresPublicationChanges registers the functions to the catalog.
+func initDoltgresPublicationChanges() {
+	framework.RegisterFunction(doltgres_publication_changes_text_text)
+	framework.RegisterFunction(doltgres_publication_changes_text_text_text)
+}
+
+// publicationChangeColumns are the columns returned by the publication change functions.
+var publicationChangeColumns = []framework.RecordColumn{
+	{Name: "commit_hash", Type: pgtypes.Text},
+	{Name: "data", Type: pgtypes.Text},
+}
+
+// doltgres_publication_changes_text_text returns the changes to the tables of the given publication that were made by
+// every commit after the given ref, up to and including HEAD. This function is specific to Doltgres.
+var doltgres_publication_changes_text_text = framework.RecordFunction{
+	FunctionInterface: framework.Function2{
+		Name:               "doltgres_publication_changes",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+			return publicationChangeRows(ctx, val1, val2, "HEAD")
+		},
+	},
+	Columns:    publicationChangeColumns,
+	ReturnsSet: true,
+}
+
+// doltgres_publication_changes_text_text_text returns the changes to the tables of the given publication that were
+// made by every commit after the first ref, up to and including the second ref. This function is specific to Doltgres.
+var doltgres_publication_changes_text_text_text = framework.RecordFunction{
+	FunctionInterface: framework.Function3{
+		Name:               "doltgres_publication_changes",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text, pgtypes.Text},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+			return publicationChangeRows(ctx, val1, val2, val3)
+		},
+	},
+	Columns:    publicationChangeColumns,
+	ReturnsSet: true,
+}
+
+// walChange is a single message of the change feed, which matches version 2 of the format written by wal2json. Each
+// commit is written as a transaction, beginning with a "B" message and ending with a "C" message, and each changed row
+// is written as an "I", "U", or "D" message in between.
+type walChange struct {
+	Action    string      `json:"action"`
+	Timestamp string      `json:"timestamp,omitempty"`
+	Schema    string      `json:"schema,omitempty"`
+	Table     string      `json:"table,omitempty"`
+	Columns   []walColumn `json:"columns,omitempty"`
+	Identity  []walColumn `json:"identity,omitempty"`
+}
+
+// walColumn is a column value within a walChange.
+type walColumn struct {
+	Name  string          `json:"name"`
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// walTableEncoder writes the columns of a table's rows, as decoded by its decoder.
+type walTableEncoder struct {
+	decoder   *core.RowDecoder
+	typeNames []string
+	// identity holds the indexes of the columns that identify a row, which are the primary key columns, or every column
+	// for tables without a primary key.
+	identity []int
+}
+
+// publicationChangeRows returns a row for every message of the change feed of the given publication, covering the
+// commits after the from ref up to and including the to ref. Only commits along the first parents of the to ref are
+// followed, and commits that do not change any published table are skipped. Messages are returned in order, beginning
+// with the oldest commit.
+func publicationChangeRows(ctx *sql.Context, publicationName any, fromRef any, toRef any) ([][]any, error) {
+	if publicationName == nil || fromRef == nil || toRef == nil {
+		return nil, fmt.Errorf("publication and ref arguments cannot be null")
+	}
+	collection, err := core.GetPublicationsCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	publication := collection.GetPublication(publicationName.(string))
+	if publication == nil {
+		return nil, fmt.Errorf(`publication "%s" does not exist`, publicationName.(string))
+	}
+	commits, err := publicationCommits(ctx, fromRef.(string), toRef.(string))
+	if err != nil {
+		return nil, err
+	}
+	rows := [][]any{}
+	for _, commit := range commits {
+		commitHash, err := commit.HashOf()
+		if err != nil {
+			return nil, err
+		}
+		changes, err := publicationCommitChanges(ctx, publication, commit)
+		if err != nil {
+			return nil, err
+		}
+		for _, change := range changes {
+			data, err := json.Marshal(change)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, []any{commitHash.String(), string(data)})
+		}
+	}
+	return rows, nil
+}
+
+// publicationCommits returns the commits after the from ref, up to and including the to ref, beginning with the oldest
+// commit. Returns an error if the from ref is not reached by following the first parents of the to ref.
+func publicationCommits(ctx *sql.Context, fromRef string, toRef string) ([]*doltdb.Commit, error) {
+	fromCommit, err := resolveCommit(ctx, fromRef)
+	if err != nil {
+		return nil, err
+	}
+	fromHash, err := fromCommit.HashOf()
+	if err != nil {
+		return nil, err
+	}
+	commit, err := resolveCommit(ctx, toRef)
+	if err != nil {
+		return nil, err
+	}
+	var commits []*doltdb.Commit
+	for {
+		commitHash, err := commit.HashOf()
+		if err != nil {
+			return nil, err
+		}
+		if commitHash == fromHash {
+			break
+		}
+		if commit.NumParents() == 0 {
+			return nil, fmt.Errorf(`"%s" is not an ancestor of "%s"`, fromRef, toRef)
+		}
+		commits = append(commits, commit)
+		optParent, err := commit.GetParent(ctx, 0)
+		if err != nil {
+			return nil, err
+		}
+		var ok bool
+		if commit, ok = optParent.ToCommit(); !ok {
+			return nil, doltdb.ErrGhostCommitEncountered
+		}
+	}
+	for i, j := 0, len(commits)-1; i < j; i, j = i+1, j-1 {
+		commits[i], commits[j] = commits[j], commits[i]
+	}
+	return commits, nil
+}
+
+// publicationCommitChanges returns the messages for the changes that the commit made to the published tables, relative
+// to its first parent. Returns no messages if the commit did not change any published table.
+func publicationCommitChanges(ctx *sql.Context, publication *publications.Publication, commit *doltdb.Commit) ([]walChange, error) {
+	optParent, err := commit.GetParent(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	parent, ok := optParent.ToCommit()
+	if !ok {
+		return nil, doltdb.ErrGhostCommitEncountered
+	}
+	fromRoot, err := parent.GetRootValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	toRoot, err := commit.GetRootValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deltas, err := diff.GetTableDeltas(ctx, fromRoot, toRoot)
+	if err != nil {
+		return nil, err
+	}
+	// Dropped tables are not published, as Postgres does not publish schema changes
+	var publishedDeltas []diff.TableDelta
+	for _, td := range deltas {
+		if td.ToTable == nil || doltdb.HasDoltPrefix(td.ToName.Name) || doltdb.IsFullTextTable(td.ToName.Name) {
+			continue
+		}
+		if publication.Publishes(td.ToName) {
+			publishedDeltas = append(publishedDeltas, td)
+		}
+	}
+	sort.Slice(publishedDeltas, func(i, j int) bool {
+		iName, jName := publishedDeltas[i].ToName, publishedDeltas[j].ToName
+		if iName.Schema != jName.Schema {
+			return iName.Schema < jName.Schema
+		}
+		return iName.Name < jName.Name
+	})
+	var changes []walChange
+	for _, td := range publishedDeltas {
+		if !td.IsAdd() && !schema.ArePrimaryKeySetsDiffable(td.Format(), td.FromSch, td.ToSch) {
+			notices.RaiseWarning(ctx, fmt.Sprintf("Primary key sets differ between revisions for table '%s', skipping data diff", td.ToName.Name))
+			continue
+		}
+		tableChanges, err := publicationTableChanges(ctx, publication, td)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, tableChanges...)
+	}
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	meta, err := commit.GetCommitMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+	timestamp := meta.Time().UTC().Format("2006-01-02 15:04:05.999999-07")
+	changes = append([]walChange{{Action: "B", Timestamp: timestamp}}, changes...)
+	return append(changes, walChange{Action: "C", Timestamp: timestamp}), nil
+}
+
+// publicationTableChanges returns the messages for the rows that differ within the delta, limited to the kinds of
+// changes that are published.
+func publicationTableChanges(ctx *sql.Context, publication *publications.Publication, td diff.TableDelta) ([]walChange, error) {
+	var fromEncoder *walTableEncoder
+	var fromDecoder *core.RowDecoder
+	if td.FromTable != nil {
+		fromEncoder = newWalTableEncoder(td.FromSch, td.FromTable.NodeStore())
+		fromDecoder = fromEncoder.decoder
+	}
+	toEncoder := newWalTableEncoder(td.ToSch, td.ToTable.NodeStore())
+	var changes []walChange
+	addChange := func(action string, columns []walColumn, identity []walColumn) {
+		changes = append(changes, walChange{
+			Action:   action,
+			Schema:   td.ToName.Schema,
+			Table:    td.ToName.Name,
+			Columns:  columns,
+			Identity: identity,
+		})
+	}
+	err := core.DiffTableRows(ctx, td, func(ctx context.Context, d tree.Diff) error {
+		decoded, err := core.DecodeRowDiff(ctx, d, fromDecoder, toEncoder.decoder)
+		if err != nil {
+			return err
+		}
+		before, after := decoded.Before, decoded.After
+		if toEncoder.decoder.IsKeyless() {
+			// Keyless tables store a count of identical rows, so a single diff may represent several rows
+			for afterCount := decoded.AfterCount; afterCount > decoded.BeforeCount && publication.Insert; afterCount-- {
+				columns, err := toEncoder.columns(after, nil)
+				if err != nil {
+					return err
+				}
+				addChange("I", columns, nil)
+			}
+			for beforeCount := decoded.BeforeCount; beforeCount > decoded.AfterCount && publication.Delete; beforeCount-- {
+				identity, err := fromEncoder.columns(before, fromEncoder.identity)
+				if err != nil {
+					return err
+				}
+				addChange("D", nil, identity)
+			}
+			return nil
+		}
+		switch {
+		case d.Type == tree.AddedDiff && publication.Insert:
+			columns, err := toEncoder.columns(after, nil)
+			if err != nil {
+				return err
+			}
+			addChange("I", columns, nil)
+		case d.Type == tree.RemovedDiff && publication.Delete:
+			identity, err := fromEncoder.columns(before, fromEncoder.identity)
+			if err != nil {
+				return err
+			}
+			addChange("D", nil, identity)
+		case d.Type == tree.ModifiedDiff && publication.Update:
+			columns, err := toEncoder.columns(after, nil)
+			if err != nil {
+				return err
+			}
+			identity, err := fromEncoder.columns(before, fromEncoder.identity)
+			if err != nil {
+				return err
+			}
+			addChange("U", columns, identity)
+		}
+		return nil
+	})
+	return changes, err
+}
+
+// newWalTableEncoder returns an encoder for rows of the given schema.
+func newWalTableEncoder(sch schema.Schema, ns tree.NodeStore) *walTableEncoder {
+	encoder := &walTableEncoder{decoder: core.NewRowDecoder(sch, ns)}
+	for i, col := range encoder.decoder.Columns() {
+		encoder.typeNames = append(encoder.typeNames, columnTypeName(col))
+		if encoder.decoder.IsKeyless() || col.IsPartOfPK {
+			encoder.identity = append(encoder.identity, i)
+		}
+	}
+	return encoder
+}
+
+// columns returns the given row's values of the columns with the given indexes, or of every column when the indexes
+// are nil.
+func (encoder *walTableEncoder) columns(row []any, indexes []int) ([]walColumn, error) {
+	if indexes == nil {
+		indexes = make([]int, len(row))
+		for i := range indexes {
+			indexes[i] = i
+		}
+	}
+	columns := make([]walColumn, len(indexes))
+	for i, index := range indexes {
+		col := encoder.decoder.Columns()[index]
+		value, err := walValue(col.TypeInfo.ToSqlType(), row[index])
+		if err != nil {
+			return nil, err
+		}
+		columns[i] = walColumn{Name: col.Name, Type: encoder.typeNames[index], Value: value}
+	}
+	return columns, nil
+}
+
+// walValue returns the JSON form of the value, as written by wal2json. Booleans and numbers that JSON can represent
+// keep their type, while all other values use their Postgres text form.
+func walValue(typ sql.Type, value any) (json.RawMessage, error) {
+	switch v := value.(type) {
+	case nil:
+		return json.RawMessage("null"), nil
+	case bool, int16, int32, int64:
+		return json.Marshal(v)
+	case float32:
+		if !math.IsNaN(float64(v)) && !math.IsInf(float64(v), 0) {
+			return json.Marshal(v)
+		}
+	case float64:
+		if !math.IsNaN(v) && !math.IsInf(v, 0) {
+			return json.Marshal(v)
+		}
+	}
+	if dgType, ok := typ.(pgtypes.DoltgresType); ok {
+		text, err := dgType.IoOutput(value)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(text)
+	}
+	return json.Marshal(fmt.Sprint(value))
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/storageparams"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initDoltgresStorageParameters registers the functions to the catalog.
+func initDoltgresStorageParameters() {
+	framework.RegisterFunction(doltgres_storage_parameters_text)
+}
+
+// doltgres_storage_parameters_text returns the storage parameters of the given table, in the same "name=value" form
+// that Postgres uses for reloptions. Returns NULL when the table has no storage parameters.
+var doltgres_storage_parameters_text = framework.Function1{
+	Name:               "doltgres_storage_parameters",
+	Return:             pgtypes.TextArray,
+	Parameters:         []pgtypes.DoltgresType{pgtypes.Text},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		if val == nil {
+			return nil, nil
+		}
+		// TODO: this should take a regclass as the parameter to determine the schema
+		tableName := doltdb.TableName{Name: val.(string)}
+		if schema, table, ok := strings.Cut(tableName.Name, "."); ok {
+			tableName = doltdb.TableName{Name: table, Schema: schema}
+		} else {
+			var err error
+			tableName.Schema, err = core.GetCurrentSchema(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		collection, err := core.GetStorageParametersCollectionFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		params := collection.GetParameters(tableName)
+		if len(params) == 0 {
+			return nil, nil
+		}
+		formatted := storageparams.Format(params)
+		elements := make([]any, len(formatted))
+		for i, param := range formatted {
+			elements[i] = param
+		}
+		return elements, nil
+	},
+}
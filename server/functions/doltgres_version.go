@@ -0,0 +1,39 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dfunctions"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initDoltgresVersion registers the functions to the catalog.
+func initDoltgresVersion() {
+	framework.RegisterFunction(doltgres_version)
+}
+
+// doltgres_version returns the version of Doltgres that is running. Unlike server_version, which reports the version of
+// Postgres that the server is compatible with, this cannot be configured.
+var doltgres_version = framework.Function0{
+	Name:       "doltgres_version",
+	Return:     pgtypes.Text,
+	Parameters: []pgtypes.DoltgresType{},
+	Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+		return dfunctions.VersionString, nil
+	},
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/shopspring/decimal"
+
+	"github.com/dolthub/doltgresql/postgres/parser/duration"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initExtract registers the functions to the catalog.
+func initExtract() {
+	framework.RegisterFunction(extract_text_interval)
+}
+
+// extract_text_interval represents the PostgreSQL function of the same name, taking the same parameters.
+var extract_text_interval = framework.Function2{
+	Name:       "extract",
+	Return:     pgtypes.Numeric,
+	Parameters: []pgtypes.DoltgresType{pgtypes.Text, pgtypes.Interval},
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, field any, source any) (any, error) {
+		if field == nil || source == nil {
+			return nil, nil
+		}
+		return extractFromInterval(field.(string), source.(duration.Duration))
+	},
+}
+
+// extractFromInterval returns the given field from the interval. Months are treated as 30 days, and years are treated
+// as 365.25 days, which matches Postgres.
+func extractFromInterval(field string, interval duration.Duration) (decimal.Decimal, error) {
+	micros := interval.Nanos() / 1000
+	years := interval.Months / 12
+	switch strings.ToLower(field) {
+	case "microsecond", "microseconds", "us", "usec", "usecs":
+		return decimal.NewFromInt(micros % 60000000), nil
+	case "millisecond", "milliseconds", "ms", "msec", "msecs":
+		return decimal.New(micros%60000000, -3), nil
+	case "second", "seconds", "s", "sec", "secs":
+		return decimal.New(micros%60000000, -6), nil
+	case "minute", "minutes", "m", "min", "mins":
+		return decimal.NewFromInt((micros / 60000000) % 60), nil
+	case "hour", "hours", "h", "hr", "hrs":
+		return decimal.NewFromInt(micros / 3600000000), nil
+	case "day", "days", "d":
+		return decimal.NewFromInt(interval.Days), nil
+	case "month", "months", "mon", "mons":
+		return decimal.NewFromInt(interval.Months % 12), nil
+	case "quarter":
+		return decimal.NewFromInt((interval.Months%12)/3 + 1), nil
+	case "year", "years", "y", "yr", "yrs":
+		return decimal.NewFromInt(years), nil
+	case "decade", "decades":
+		return decimal.NewFromInt(years / 10), nil
+	case "century", "centuries":
+		return decimal.NewFromInt(years / 100), nil
+	case "millennium", "millennia":
+		return decimal.NewFromInt(years / 1000), nil
+	case "epoch":
+		// The days in a year are fractional, so everything is multiplied by 4 to keep the calculation in integers
+		secondsFromDaysAndMonths := (int64(4*duration.DaysPerYear)*years +
+			int64(4*duration.DaysPerMonth)*(interval.Months%12) +
+			4*interval.Days) * (duration.SecsPerDay / 4)
+		return decimal.New(micros+secondsFromDaysAndMonths*1000000, -6), nil
+	case "dow", "doy", "isodow", "isoyear", "julian", "timezone", "timezone_hour", "timezone_minute", "week":
+		return decimal.Decimal{}, fmt.Errorf(`unit "%s" not supported for type interval`, field)
+	default:
+		return decimal.Decimal{}, fmt.Errorf(`unit "%s" not recognized for type interval`, field)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/lib/pq/oid"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initFormatType registers the functions to the catalog.
+func initFormatType() {
+	framework.RegisterFunction(format_type_oid_int32)
+}
+
+// format_type_oid_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var format_type_oid_int32 = framework.Function2{
+	Name:       "format_type",
+	Return:     pgtypes.Text,
+	Parameters: []pgtypes.DoltgresType{pgtypes.Oid, pgtypes.Int32},
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, typeOid any, typmod any) (any, error) {
+		if typeOid == nil {
+			return nil, nil
+		}
+		if typmod == nil {
+			return formatType(typeOid.(uint32), -1, false), nil
+		}
+		return formatType(typeOid.(uint32), typmod.(int32), true), nil
+	},
+}
+
+// formatType returns the SQL name of the type with the given OID, as it is shown by format_type. The type modifier is
+// only shown when one is given, and a given modifier of -1 may change the name for types whose modifier is implied when
+// it is omitted (such as bpchar, which is char(1) when written without a length).
+func formatType(typeOid uint32, typmod int32, typmodGiven bool) string {
+	if typeOid == 0 {
+		return "-"
+	}
+	typ, ok := catalogTypeByOid(typeOid)
+	if !ok {
+		return "???"
+	}
+	if arrayType, ok := typ.(pgtypes.DoltgresArrayType); ok && catalogTypeCategory(typ) == "A" {
+		return formatType(arrayType.BaseType().OID(), typmod, typmodGiven) + "[]"
+	}
+	withTypmod := typmodGiven && typmod >= 0
+	switch oid.Oid(typeOid) {
+	case oid.T_bool:
+		return "boolean"
+	case oid.T_bpchar:
+		if withTypmod {
+			return fmt.Sprintf("character(%d)", typmod-4)
+		} else if typmodGiven {
+			return "bpchar"
+		}
+		return "character"
+	case oid.T_float4:
+		return "real"
+	case oid.T_float8:
+		return "double precision"
+	case oid.T_int2:
+		return "smallint"
+	case oid.T_int4:
+		return "integer"
+	case oid.T_int8:
+		return "bigint"
+	case oid.T_numeric:
+		if withTypmod {
+			return fmt.Sprintf("numeric(%d,%d)", ((typmod-4)>>16)&0xffff, (typmod-4)&0xffff)
+		}
+		return "numeric"
+	case oid.T_time:
+		return formatTimeType("time", "without", typmod, withTypmod)
+	case oid.T_timetz:
+		return formatTimeType("time", "with", typmod, withTypmod)
+	case oid.T_timestamp:
+		return formatTimeType("timestamp", "without", typmod, withTypmod)
+	case oid.T_timestamptz:
+		return formatTimeType("timestamp", "with", typmod, withTypmod)
+	case oid.T_varchar:
+		if withTypmod {
+			return fmt.Sprintf("character varying(%d)", typmod-4)
+		}
+		return "character varying"
+	default:
+		return catalogTypeName(typ)
+	}
+}
+
+// formatTimeType returns the name of a time or timestamp type, which places its precision before the time zone.
+func formatTimeType(name string, zone string, typmod int32, withTypmod bool) string {
+	if withTypmod {
+		return fmt.Sprintf("%s(%d) %s time zone", name, typmod, zone)
+	}
+	return fmt.Sprintf("%s %s time zone", name, zone)
+}
@@ -210,6 +210,32 @@ func GetImplicitCast(fromType pgtypes.DoltgresTypeBaseID, toType pgtypes.Doltgre
 	return nil
 }
 
+// TypeCastContext is the context in which a registered type cast may be applied.
+type TypeCastContext byte
+
+const (
+	TypeCastContext_Explicit TypeCastContext = iota
+	TypeCastContext_Assignment
+	TypeCastContext_Implicit
+)
+
+// RegisteredTypeCast is a type cast that has been registered, along with the context in which it may be applied.
+type RegisteredTypeCast struct {
+	FromType pgtypes.DoltgresTypeBaseID
+	ToType   pgtypes.DoltgresType
+	Context  TypeCastContext
+}
+
+// GetAllTypeCasts returns every registered type cast. This does not include the casts that every type has, such as the
+// identity cast and the I/O conversions to and from the string types.
+func GetAllTypeCasts() []RegisteredTypeCast {
+	var casts []RegisteredTypeCast
+	casts = appendRegisteredTypeCasts(casts, explicitTypeCastMutex, explicitTypeCastsArray, TypeCastContext_Explicit)
+	casts = appendRegisteredTypeCasts(casts, assignmentTypeCastMutex, assignmentTypeCastsArray, TypeCastContext_Assignment)
+	casts = appendRegisteredTypeCasts(casts, implicitTypeCastMutex, implicitTypeCastsArray, TypeCastContext_Implicit)
+	return casts
+}
+
 // addTypeCast registers the given type cast.
 func addTypeCast(mutex *sync.RWMutex,
 	castMap map[pgtypes.DoltgresTypeBaseID]map[pgtypes.DoltgresTypeBaseID]TypeCastFunction,
@@ -232,6 +258,24 @@ func addTypeCast(mutex *sync.RWMutex,
 	return nil
 }
 
+// appendRegisteredTypeCasts appends the type casts within the given array to the given slice.
+func appendRegisteredTypeCasts(casts []RegisteredTypeCast, mutex *sync.RWMutex,
+	castArray map[pgtypes.DoltgresTypeBaseID][]pgtypes.DoltgresType, context TypeCastContext) []RegisteredTypeCast {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	for fromType, toTypes := range castArray {
+		for _, toType := range toTypes {
+			casts = append(casts, RegisteredTypeCast{
+				FromType: fromType,
+				ToType:   toType,
+				Context:  context,
+			})
+		}
+	}
+	return casts
+}
+
 // getPotentialCasts returns all registered type casts from the given type.
 func getPotentialCasts(mutex *sync.RWMutex, castArray map[pgtypes.DoltgresTypeBaseID][]pgtypes.DoltgresType, fromType pgtypes.DoltgresTypeBaseID) []pgtypes.DoltgresType {
 	mutex.RLock()
@@ -298,5 +342,9 @@ func stringLiteralCast(ctx *sql.Context, val any, targetType pgtypes.DoltgresTyp
 	if !ok {
 		return nil, fmt.Errorf("string literal was expected in I/O cast, but received: `%T`", val)
 	}
+	// The reg* types resolve names using the catalog, which their I/O input function does not have access to
+	if pgtypes.IsRegNameType(targetType) && pgtypes.RegNameInput != nil {
+		return pgtypes.RegNameInput(ctx, targetType.BaseID(), str)
+	}
 	return targetType.IoInput(str)
 }
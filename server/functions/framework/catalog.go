@@ -59,6 +59,9 @@ func RegisterFunction(f FunctionInterface) {
 	case Function4:
 		name := strings.ToLower(f.Name)
 		Catalog[name] = append(Catalog[name], f)
+	case RecordFunction:
+		name := strings.ToLower(f.GetName())
+		Catalog[name] = append(Catalog[name], f)
 	default:
 		panic("unhandled function type")
 	}
@@ -22,6 +22,8 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/vitess/go/vt/proto/query"
 
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/pgerrors"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
@@ -35,6 +37,7 @@ type CompiledFunction struct {
 	callableFunc  FunctionInterface
 	casts         []TypeCastFunction
 	originalTypes []pgtypes.DoltgresType
+	resolvedTypes []pgtypes.DoltgresType
 	stashedErr    error
 }
 
@@ -46,6 +49,17 @@ func NewCompiledFunction(name string, parameters []sql.Expression, functions *Ov
 	return newCompiledFunctionInternal(name, parameters, functions, functions.collectOverloadPermutations(), isOperator)
 }
 
+// NewCompiledFunctionFromOverloads returns a newly compiled function that resolves to one of the given overloads, rather
+// than to the overloads within the catalog. This is used for functions that are not built in, such as user-defined
+// functions.
+func NewCompiledFunctionFromOverloads(name string, parameters []sql.Expression, overloads []FunctionInterface) *CompiledFunction {
+	baseOverload := &OverloadDeduction{Parameter: make(map[pgtypes.DoltgresTypeBaseID]*OverloadDeduction)}
+	for _, overload := range overloads {
+		buildOverload(name, baseOverload, overload)
+	}
+	return NewCompiledFunction(name, parameters, baseOverload, false)
+}
+
 // newCompiledFunctionInternal is called internally, which skips steps that may have already been processed.
 func newCompiledFunctionInternal(name string, params []sql.Expression, funcs *OverloadDeduction, allFuncs [][]pgtypes.DoltgresTypeBaseID, isOperator bool) *CompiledFunction {
 	c := &CompiledFunction{
@@ -70,12 +84,14 @@ func newCompiledFunctionInternal(name string, params []sql.Expression, funcs *Ov
 	}
 	// If we do not receive an overload, then the parameters given did not result in a valid match
 	if overload == nil || overload.Function == nil {
-		c.stashedErr = fmt.Errorf("function %s does not exist", c.OverloadString(originalTypes))
+		c.stashedErr = pgerrors.Newf(pgcode.UndefinedFunction, "function %s does not exist", c.OverloadString(originalTypes)).
+			WithHint("No function matches the given name and argument types. You might need to add explicit type casts.")
 		return c
 	}
 	c.callableFunc = overload.Function
 	c.casts = casts
 	c.originalTypes = originalTypes
+	c.resolvedTypes = resolvePolymorphicTypes(overload.Function, originalTypes, sources)
 	return c
 }
 
@@ -139,7 +155,8 @@ func (c *CompiledFunction) OverloadString(types []pgtypes.DoltgresType) string {
 func (c *CompiledFunction) Type() sql.Type {
 	parameters, sources := c.possibleParameterTypes()
 	if resolvedFunction, _, _ := c.resolve(parameters, sources); resolvedFunction != nil {
-		return resolvedFunction.Function.GetReturn()
+		resolvedTypes := resolvePolymorphicTypes(resolvedFunction.Function, parameters, sources)
+		return resolvedTypes[len(resolvedTypes)-1]
 	}
 	// We can't resolve to a function before evaluation in this case, so we'll return something arbitrary
 	return pgtypes.Unknown
@@ -167,19 +184,28 @@ func (c *CompiledFunction) Eval(ctx *sql.Context, row sql.Row) (interface{}, err
 	// If we have a stashed error, then we should return that now. Errors are stashed when they're supposed to be
 	// returned during the call to Eval. This helps to ensure consistency with how errors are returned in Postgres.
 	if c.stashedErr != nil {
-		return nil, c.stashedErr
+		return nil, pgerrors.Raise(ctx, c.stashedErr)
 	}
+	// Functions that return rows may only be called from the FROM clause, which evaluates them through a TableFunction
+	if f, ok := c.callableFunc.(RecordFunction); ok {
+		return nil, f.scalarContextError()
+	}
+	return c.call(ctx, row)
+}
+
+// call evaluates the parameters and passes them to the resolved function, returning the function's result as-is. Unlike
+// Eval, this does not check whether the function returns rows, and the caller must check for a stashed error.
+func (c *CompiledFunction) call(ctx *sql.Context, row sql.Row) (any, error) {
 	// Evaluate all of the parameters.
 	parameters, err := c.evalParameters(ctx, row)
 	if err != nil {
 		return nil, err
 	}
 	// Convert the parameter values into their correct types
-	resultTypes := c.callableFunc.GetParameters()
 	if len(c.casts) > 0 {
 		for i := range parameters {
 			if c.casts[i] != nil {
-				parameters[i], err = c.casts[i](ctx, parameters[i], resultTypes[i])
+				parameters[i], err = c.casts[i](ctx, parameters[i], c.resolvedTypes[i])
 				if err != nil {
 					return nil, err
 				}
@@ -189,19 +215,25 @@ func (c *CompiledFunction) Eval(ctx *sql.Context, row sql.Row) (interface{}, err
 		}
 	}
 	// Pass the parameters to the function
-	switch f := c.callableFunc.(type) {
+	callableFunc := c.callableFunc
+	if f, ok := callableFunc.(RecordFunction); ok {
+		callableFunc = f.FunctionInterface
+	}
+	switch f := callableFunc.(type) {
 	case Function0:
-		return f.Callable(ctx)
+		return f.Callable(ctx, ([1]pgtypes.DoltgresType)(c.resolvedTypes))
 	case Function1:
-		return f.Callable(ctx, parameters[0])
+		return f.Callable(ctx, ([2]pgtypes.DoltgresType)(c.resolvedTypes), parameters[0])
 	case Function2:
-		return f.Callable(ctx, parameters[0], parameters[1])
+		return f.Callable(ctx, ([3]pgtypes.DoltgresType)(c.resolvedTypes), parameters[0], parameters[1])
 	case Function3:
-		return f.Callable(ctx, parameters[0], parameters[1], parameters[2])
+		return f.Callable(ctx, ([4]pgtypes.DoltgresType)(c.resolvedTypes), parameters[0], parameters[1], parameters[2])
 	case Function4:
-		return f.Callable(ctx, parameters[0], parameters[1], parameters[2], parameters[3])
+		return f.Callable(ctx, ([5]pgtypes.DoltgresType)(c.resolvedTypes), parameters[0], parameters[1], parameters[2], parameters[3])
+	case FunctionN:
+		return f.Callable(ctx, c.resolvedTypes, parameters)
 	default:
-		return nil, fmt.Errorf("unknown function type in CompiledFunction::Eval")
+		return nil, fmt.Errorf("unknown function type in CompiledFunction::call")
 	}
 }
 
@@ -247,9 +279,18 @@ func (c *CompiledFunction) resolveFunction(parameters []pgtypes.DoltgresType, so
 	for _, overload := range c.AllOverloads {
 		if len(overload) == len(parameters) {
 			isConvertible := true
+			hasPolymorphic := false
 			overloadCasts := make([]TypeCastFunction, len(overload))
 			for i, overloadParam := range overload {
-				if overloadCasts[i] = GetImplicitCast(parameters[i].BaseID(), overloadParam); overloadCasts[i] == nil {
+				// Polymorphic parameters are checked together once all other parameters have been checked
+				if isPolymorphic(overloadParam) {
+					hasPolymorphic = true
+					continue
+				}
+				if parameters[i].BaseID() == pgtypes.DoltgresTypeBaseID_Null {
+					// NULL arguments have an unknown type, and therefore may be given to a parameter of any type
+					overloadCasts[i] = identityCast
+				} else if overloadCasts[i] = GetImplicitCast(parameters[i].BaseID(), overloadParam); overloadCasts[i] == nil {
 					if sources[i] == Source_Constant && parameters[i].BaseID().GetTypeCategory() == pgtypes.TypeCategory_StringTypes {
 						overloadCasts[i] = stringLiteralCast
 					} else {
@@ -258,6 +299,9 @@ func (c *CompiledFunction) resolveFunction(parameters []pgtypes.DoltgresType, so
 					}
 				}
 			}
+			if isConvertible && hasPolymorphic {
+				isConvertible = resolvePolymorphicCasts(overload, parameters, sources, overloadCasts)
+			}
 			if isConvertible {
 				convertibles = append(convertibles, overload)
 				casts = append(casts, overloadCasts)
@@ -20,7 +20,9 @@ import (
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
-// FunctionInterface is an interface for PostgreSQL functions.
+// FunctionInterface is an interface for PostgreSQL functions. The Callable of each function receives the types of its
+// parameters followed by its return type. These are the declared types, except for polymorphic types (such as
+// anyarray), which are replaced by the concrete types that were resolved from the arguments of the call.
 type FunctionInterface interface {
 	// GetName returns the name of the function. The name is case-insensitive, so the casing does not matter.
 	GetName() string
@@ -42,7 +44,7 @@ type Function0 struct {
 	Return             pgtypes.DoltgresType
 	Parameters         []pgtypes.DoltgresType
 	IsNonDeterministic bool
-	Callable           func(ctx *sql.Context) (any, error)
+	Callable           func(ctx *sql.Context, paramsAndReturn [1]pgtypes.DoltgresType) (any, error)
 }
 
 // Function1 is a function that takes one parameter.
@@ -51,7 +53,7 @@ type Function1 struct {
 	Return             pgtypes.DoltgresType
 	Parameters         []pgtypes.DoltgresType
 	IsNonDeterministic bool
-	Callable           func(ctx *sql.Context, val1 any) (any, error)
+	Callable           func(ctx *sql.Context, paramsAndReturn [2]pgtypes.DoltgresType, val1 any) (any, error)
 }
 
 // Function2 is a function that takes two parameters.
@@ -60,7 +62,7 @@ type Function2 struct {
 	Return             pgtypes.DoltgresType
 	Parameters         []pgtypes.DoltgresType
 	IsNonDeterministic bool
-	Callable           func(ctx *sql.Context, val1 any, val2 any) (any, error)
+	Callable           func(ctx *sql.Context, paramsAndReturn [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error)
 }
 
 // Function3 is a function that takes three parameters.
@@ -69,7 +71,7 @@ type Function3 struct {
 	Return             pgtypes.DoltgresType
 	Parameters         []pgtypes.DoltgresType
 	IsNonDeterministic bool
-	Callable           func(ctx *sql.Context, val1 any, val2 any, val3 any) (any, error)
+	Callable           func(ctx *sql.Context, paramsAndReturn [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error)
 }
 
 // Function4 is a function that takes four parameters.
@@ -78,7 +80,17 @@ type Function4 struct {
 	Return             pgtypes.DoltgresType
 	Parameters         []pgtypes.DoltgresType
 	IsNonDeterministic bool
-	Callable           func(ctx *sql.Context, val1 any, val2 any, val3 any, val4 any) (any, error)
+	Callable           func(ctx *sql.Context, paramsAndReturn [5]pgtypes.DoltgresType, val1 any, val2 any, val3 any, val4 any) (any, error)
+}
+
+// FunctionN is a function that takes any number of parameters. These are not registered to the catalog, and are
+// instead used by user-defined functions, as their parameter counts are not known ahead of time.
+type FunctionN struct {
+	Name               string
+	Return             pgtypes.DoltgresType
+	Parameters         []pgtypes.DoltgresType
+	IsNonDeterministic bool
+	Callable           func(ctx *sql.Context, paramsAndReturn []pgtypes.DoltgresType, vals []any) (any, error)
 }
 
 var _ FunctionInterface = Function0{}
@@ -86,6 +98,7 @@ var _ FunctionInterface = Function1{}
 var _ FunctionInterface = Function2{}
 var _ FunctionInterface = Function3{}
 var _ FunctionInterface = Function4{}
+var _ FunctionInterface = FunctionN{}
 
 // GetName implements the FunctionInterface interface.
 func (f Function0) GetName() string { return f.Name }
@@ -176,3 +189,21 @@ func (f Function4) GetIsNonDeterministic() bool { return f.IsNonDeterministic }
 
 // enforceInterfaceInheritance implements the FunctionInterface interface.
 func (f Function4) enforceInterfaceInheritance(error) {}
+
+// GetName implements the FunctionInterface interface.
+func (f FunctionN) GetName() string { return f.Name }
+
+// GetReturn implements the FunctionInterface interface.
+func (f FunctionN) GetReturn() pgtypes.DoltgresType { return f.Return }
+
+// GetParameters implements the FunctionInterface interface.
+func (f FunctionN) GetParameters() []pgtypes.DoltgresType { return f.Parameters }
+
+// GetExpectedParameterCount implements the FunctionInterface interface.
+func (f FunctionN) GetExpectedParameterCount() int { return len(f.Parameters) }
+
+// GetIsNonDeterministic implements the FunctionInterface interface.
+func (f FunctionN) GetIsNonDeterministic() bool { return f.IsNonDeterministic }
+
+// enforceInterfaceInheritance implements the FunctionInterface interface.
+func (f FunctionN) enforceInterfaceInheritance(error) {}
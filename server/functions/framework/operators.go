@@ -44,6 +44,8 @@ const (
 	Operator_BinaryJSONTopLevel                        // ?
 	Operator_BinaryJSONTopLevelAny                     // ?|
 	Operator_BinaryJSONTopLevelAll                     // ?&
+	Operator_BinaryJSONPathExists                      // @?
+	Operator_BinaryJSONPathMatch                       // @@
 	Operator_UnaryPlus                                 // +
 	Operator_UnaryMinus                                // -
 )
@@ -137,6 +139,24 @@ func GetBinaryFunction(operator Operator) IntermediateFunction {
 	}
 }
 
+// OperatorFunction is a function that implements an operator for a specific set of parameter types.
+type OperatorFunction struct {
+	Operator Operator
+	Function FunctionInterface
+}
+
+// GetAllOperatorFunctions returns every registered unary and binary function.
+func GetAllOperatorFunctions() []OperatorFunction {
+	functions := make([]OperatorFunction, 0, len(unaryFunctions)+len(binaryFunctions))
+	for sig, f := range unaryFunctions {
+		functions = append(functions, OperatorFunction{Operator: sig.Operator, Function: f})
+	}
+	for sig, f := range binaryFunctions {
+		functions = append(functions, OperatorFunction{Operator: sig.Operator, Function: f})
+	}
+	return functions
+}
+
 // String returns the string form of the operator.
 func (o Operator) String() string {
 	switch o {
@@ -160,6 +180,30 @@ func (o Operator) String() string {
 		return "|"
 	case Operator_BinaryBitXor:
 		return "#"
+	case Operator_BinaryConcatenate:
+		return "||"
+	case Operator_BinaryJSONExtractJson:
+		return "->"
+	case Operator_BinaryJSONExtractText:
+		return "->>"
+	case Operator_BinaryJSONExtractPathJson:
+		return "#>"
+	case Operator_BinaryJSONExtractPathText:
+		return "#>>"
+	case Operator_BinaryJSONContainsRight:
+		return "@>"
+	case Operator_BinaryJSONContainsLeft:
+		return "<@"
+	case Operator_BinaryJSONTopLevel:
+		return "?"
+	case Operator_BinaryJSONTopLevelAny:
+		return "?|"
+	case Operator_BinaryJSONTopLevelAll:
+		return "?&"
+	case Operator_BinaryJSONPathExists:
+		return "@?"
+	case Operator_BinaryJSONPathMatch:
+		return "@@"
 	default:
 		return "unknown operator"
 	}
@@ -0,0 +1,140 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// isPolymorphic returns whether the given base ID belongs to a polymorphic type. Parameters with a polymorphic type
+// accept a range of types, and the concrete type is determined by the arguments that are given to the function.
+// https://www.postgresql.org/docs/15/extend-type-system.html#EXTEND-TYPES-POLYMORPHIC
+func isPolymorphic(baseID pgtypes.DoltgresTypeBaseID) bool {
+	switch baseID {
+	case pgtypes.DoltgresTypeBaseID_AnyElement, pgtypes.DoltgresTypeBaseID_AnyArray:
+		return true
+	default:
+		return false
+	}
+}
+
+// isConcreteArray returns whether the given type is an array with a known element type.
+func isConcreteArray(t pgtypes.DoltgresType) (pgtypes.DoltgresArrayType, bool) {
+	switch t.BaseID() {
+	case pgtypes.DoltgresTypeBaseID_AnyArray, pgtypes.DoltgresTypeBaseID_Unknown:
+		return nil, false
+	}
+	arrayType, ok := t.(pgtypes.DoltgresArrayType)
+	return arrayType, ok
+}
+
+// polymorphicElementType returns the element type that the polymorphic parameters of an overload resolve to, given the
+// types of the arguments. Every polymorphic parameter refers to the same element type, so anyarray parameters take
+// precedence, as their element type is unambiguous. Returns nil if the element type cannot be determined, which is the
+// case when all of the polymorphic arguments are NULL.
+func polymorphicElementType(overload []pgtypes.DoltgresTypeBaseID, parameters []pgtypes.DoltgresType, sources []Source) pgtypes.DoltgresType {
+	for i, overloadParam := range overload {
+		if overloadParam == pgtypes.DoltgresTypeBaseID_AnyArray {
+			if arrayType, ok := isConcreteArray(parameters[i]); ok {
+				return arrayType.BaseType()
+			}
+		}
+	}
+	// String literals are only used when there are no other arguments, as they're otherwise cast to the element type
+	var literalType pgtypes.DoltgresType
+	for i, overloadParam := range overload {
+		if overloadParam == pgtypes.DoltgresTypeBaseID_AnyElement && parameters[i].BaseID() != pgtypes.DoltgresTypeBaseID_Null {
+			if sources[i] == Source_Constant && parameters[i].BaseID().GetTypeCategory() == pgtypes.TypeCategory_StringTypes {
+				if literalType == nil {
+					literalType = parameters[i]
+				}
+				continue
+			}
+			return parameters[i]
+		}
+	}
+	return literalType
+}
+
+// resolvePolymorphicCasts verifies that the arguments given to the polymorphic parameters of an overload agree on a
+// single element type, replacing the given casts for any arguments that must be cast to that type. Returns false if
+// the arguments are not compatible with the overload.
+func resolvePolymorphicCasts(overload []pgtypes.DoltgresTypeBaseID, parameters []pgtypes.DoltgresType, sources []Source, casts []TypeCastFunction) bool {
+	elementType := polymorphicElementType(overload, parameters, sources)
+	if elementType == nil {
+		return false
+	}
+	for i, overloadParam := range overload {
+		if parameters[i].BaseID() == pgtypes.DoltgresTypeBaseID_Null {
+			if isPolymorphic(overloadParam) {
+				casts[i] = identityCast
+			}
+			continue
+		}
+		var targetType pgtypes.DoltgresType
+		switch overloadParam {
+		case pgtypes.DoltgresTypeBaseID_AnyArray:
+			if _, ok := isConcreteArray(parameters[i]); !ok {
+				return false
+			}
+			targetType = elementType.ToArrayType()
+		case pgtypes.DoltgresTypeBaseID_AnyElement:
+			targetType = elementType
+		default:
+			continue
+		}
+		if parameters[i].BaseID() == targetType.BaseID() {
+			casts[i] = identityCast
+		} else if casts[i] = GetImplicitCast(parameters[i].BaseID(), targetType.BaseID()); casts[i] == nil {
+			if sources[i] == Source_Constant && parameters[i].BaseID().GetTypeCategory() == pgtypes.TypeCategory_StringTypes {
+				casts[i] = stringLiteralCast
+			} else {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// resolvePolymorphicTypes returns the parameter types of the given function followed by its return type, with all
+// polymorphic types replaced by the concrete types that they resolve to for the given arguments.
+func resolvePolymorphicTypes(function FunctionInterface, parameters []pgtypes.DoltgresType, sources []Source) []pgtypes.DoltgresType {
+	declaredTypes := function.GetParameters()
+	resolvedTypes := make([]pgtypes.DoltgresType, len(declaredTypes)+1)
+	copy(resolvedTypes, declaredTypes)
+	resolvedTypes[len(declaredTypes)] = function.GetReturn()
+	overload := make([]pgtypes.DoltgresTypeBaseID, len(declaredTypes))
+	hasPolymorphic := isPolymorphic(function.GetReturn().BaseID())
+	for i, declaredType := range declaredTypes {
+		overload[i] = declaredType.BaseID()
+		hasPolymorphic = hasPolymorphic || isPolymorphic(overload[i])
+	}
+	if !hasPolymorphic {
+		return resolvedTypes
+	}
+	elementType := polymorphicElementType(overload, parameters, sources)
+	if elementType == nil {
+		return resolvedTypes
+	}
+	for i, resolvedType := range resolvedTypes {
+		switch resolvedType.BaseID() {
+		case pgtypes.DoltgresTypeBaseID_AnyArray:
+			resolvedTypes[i] = elementType.ToArrayType()
+		case pgtypes.DoltgresTypeBaseID_AnyElement:
+			resolvedTypes[i] = elementType
+		}
+	}
+	return resolvedTypes
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// RecordFunction wraps a function that returns rows rather than a single value, which are functions that are declared
+// as RETURNS record, RETURNS SETOF record, or RETURNS TABLE(...). The Callable of the wrapped function must return
+// either [][]any, which are the rows matching the declared Columns, or RecordRows when the rows depend on the columns
+// that the caller asked for. The wrapped function's return type should be pgtypes.Record.
+type RecordFunction struct {
+	FunctionInterface
+	// Columns are the output columns of the function, as declared by RETURNS TABLE. Functions without any columns
+	// return an anonymous record, and must be called with a column definition list.
+	Columns []RecordColumn
+	// ReturnsSet is true when the function may return any number of rows, otherwise it always returns a single row.
+	ReturnsSet bool
+}
+
+// RecordColumn is a column of the rows returned by a RecordFunction. A column without a name is named the same as the
+// column of a function that returns a single value, which is used by functions that return a set of a single type.
+type RecordColumn struct {
+	Name string
+	Type pgtypes.DoltgresType
+}
+
+// RecordRows returns the rows of a RecordFunction, where each row matches the given columns. The columns are taken from
+// the column definition list for functions that return an anonymous record.
+type RecordRows func(ctx *sql.Context, columns []RecordColumn) ([][]any, error)
+
+var _ FunctionInterface = RecordFunction{}
+
+// scalarContextError returns the error for when the function is used somewhere that only accepts a single value.
+func (f RecordFunction) scalarContextError() error {
+	if f.ReturnsSet {
+		return fmt.Errorf("set-valued function called in context that cannot accept a set")
+	}
+	return fmt.Errorf("function returning record called in context that cannot accept type record")
+}
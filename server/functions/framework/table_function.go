@@ -0,0 +1,394 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// TableFunction is a function that has been called from the FROM clause. Functions that return rows produce a table
+// with one column for each column of the rows, while all other functions produce a table with a single row and column.
+type TableFunction struct {
+	name        string
+	database    sql.Database
+	function    *CompiledFunction
+	definitions *ColumnDefinitionList
+	schema      sql.Schema
+}
+
+var _ sql.TableFunction = (*TableFunction)(nil)
+var _ sql.ExecSourceRel = (*TableFunction)(nil)
+
+// UserFunctionTableName is the name of the table function that calls user-defined functions from the FROM clause. The
+// schema and name of the user-defined function are given as its first two arguments, followed by the function's own
+// arguments.
+const UserFunctionTableName = "doltgres_user_function"
+
+// QueryDiffTableName is the name of the table function that is called in place of Dolt's dolt_query_diff, which runs
+// its queries using Postgres syntax rather than MySQL syntax.
+const QueryDiffTableName = "doltgres_query_diff"
+
+// TableFunctions returns a TableFunction for every function in the catalog, so that they may be given to the engine.
+// Initialize must have been called beforehand.
+func TableFunctions() []sql.TableFunction {
+	tableFunctions := make([]sql.TableFunction, 0, len(compiledCatalog))
+	for name := range compiledCatalog {
+		tableFunctions = append(tableFunctions, &TableFunction{name: name})
+	}
+	return tableFunctions
+}
+
+// NewInstance implements the interface sql.TableFunction.
+func (t *TableFunction) NewInstance(ctx *sql.Context, db sql.Database, args []sql.Expression) (sql.Node, error) {
+	args, definitions := SplitColumnDefinitionList(args)
+	function, ok, err := GetFunction(t.name, args...)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, sql.ErrTableFunctionNotFound.New(t.name)
+	}
+	if definitions != nil && len(definitions.RowType) > 0 {
+		if definitions, err = definitions.withRowType(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	return NewTableFunction(t.name, db, function, definitions)
+}
+
+// withRowType returns a copy of the column definition list, with the columns of the table that is named by the row
+// type. Column aliases rename the leading columns of the table.
+func (c *ColumnDefinitionList) withRowType(ctx *sql.Context, db sql.Database) (*ColumnDefinitionList, error) {
+	if db == nil {
+		return nil, fmt.Errorf(`type "%s" does not exist`, c.RowType)
+	}
+	table, ok, err := db.GetTableInsensitive(ctx, c.RowType)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, fmt.Errorf(`type "%s" does not exist`, c.RowType)
+	}
+	tableSchema := table.Schema()
+	if len(c.Names) > len(tableSchema) {
+		return nil, fmt.Errorf(`table "%s" has %d columns available but %d columns specified`,
+			c.Alias, len(tableSchema), len(c.Names))
+	}
+	definitions := &ColumnDefinitionList{
+		Alias:   c.Alias,
+		Names:   make([]string, len(tableSchema)),
+		Types:   make([]pgtypes.DoltgresType, len(tableSchema)),
+		RowType: c.RowType,
+		Fields:  make([]string, len(tableSchema)),
+	}
+	for i, column := range tableSchema {
+		columnType, ok := column.Type.(pgtypes.DoltgresType)
+		if !ok {
+			return nil, fmt.Errorf(`column "%s" of table "%s" has an unsupported type`, column.Name, c.RowType)
+		}
+		definitions.Names[i] = column.Name
+		definitions.Types[i] = columnType
+		definitions.Fields[i] = column.Name
+	}
+	copy(definitions.Names, c.Names)
+	return definitions, nil
+}
+
+// SplitColumnDefinitionList separates the column definition list from the arguments of a function in the FROM clause.
+// The column definition list is nil when the function was not given an alias clause.
+func SplitColumnDefinitionList(args []sql.Expression) ([]sql.Expression, *ColumnDefinitionList) {
+	if len(args) > 0 {
+		if definitions, ok := args[len(args)-1].(*ColumnDefinitionList); ok {
+			return args[:len(args)-1], definitions
+		}
+	}
+	return args, nil
+}
+
+// NewTableFunction returns a new *TableFunction for the given function, which has already been compiled. The column
+// definition list may be nil.
+func NewTableFunction(name string, db sql.Database, function *CompiledFunction, definitions *ColumnDefinitionList) (*TableFunction, error) {
+	tableFunction := &TableFunction{
+		name:        name,
+		database:    db,
+		function:    function,
+		definitions: definitions,
+	}
+	var err error
+	if tableFunction.schema, err = tableFunction.buildSchema(); err != nil {
+		return nil, err
+	}
+	return tableFunction, nil
+}
+
+// buildSchema returns the schema of the table that is produced by the function. The column definition list must match
+// the kind of function that was called, which is also validated here.
+func (t *TableFunction) buildSchema() (sql.Schema, error) {
+	if t.function.stashedErr != nil {
+		return nil, t.function.stashedErr
+	}
+	var columns []RecordColumn
+	hasTypes := t.definitions != nil && len(t.definitions.Types) > 0
+	if recordFunction, ok := t.function.callableFunc.(RecordFunction); ok {
+		if len(recordFunction.Columns) > 0 {
+			if hasTypes {
+				return nil, fmt.Errorf("a column definition list is redundant for a function with OUT parameters")
+			}
+			columns = append(columns, recordFunction.Columns...)
+		} else {
+			if !hasTypes {
+				return nil, fmt.Errorf(`a column definition list is required for functions returning "record"`)
+			}
+			for i, name := range t.definitions.Names {
+				columns = append(columns, RecordColumn{Name: name, Type: t.definitions.Types[i]})
+			}
+		}
+	} else {
+		if hasTypes {
+			return nil, fmt.Errorf(`a column definition list is only allowed for functions returning "record"`)
+		}
+		columns = append(columns, RecordColumn{Type: t.function.resolvedTypes[len(t.function.resolvedTypes)-1]})
+	}
+	// Unnamed columns are named after the function, unless an alias was given for the table
+	for i := range columns {
+		if len(columns[i].Name) > 0 {
+			continue
+		}
+		columns[i].Name = t.name
+		if t.definitions != nil && len(t.definitions.Alias) > 0 {
+			columns[i].Name = t.definitions.Alias
+		}
+	}
+	// Column aliases without types rename the leading columns
+	if t.definitions != nil && !hasTypes {
+		if len(t.definitions.Names) > len(columns) {
+			return nil, fmt.Errorf(`table "%s" has %d columns available but %d columns specified`,
+				t.definitions.Alias, len(columns), len(t.definitions.Names))
+		}
+		for i, name := range t.definitions.Names {
+			columns[i].Name = name
+		}
+	}
+	schema := make(sql.Schema, len(columns))
+	for i, column := range columns {
+		schema[i] = &sql.Column{
+			Name:     column.Name,
+			Type:     column.Type,
+			Source:   t.name,
+			Nullable: true,
+		}
+	}
+	return schema, nil
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (t *TableFunction) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if t.function.stashedErr != nil {
+		return nil, t.function.stashedErr
+	}
+	result, err := t.function.call(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	recordFunction, ok := t.function.callableFunc.(RecordFunction)
+	if !ok {
+		return sql.RowsToRowIter(sql.NewRow(result)), nil
+	}
+	var rows [][]any
+	switch result := result.(type) {
+	case nil:
+		// A NULL result still produces a row when a single row is always returned
+		if !recordFunction.ReturnsSet {
+			rows = [][]any{make([]any, len(t.schema))}
+		}
+	case [][]any:
+		rows = result
+	case RecordRows:
+		columns := make([]RecordColumn, len(t.schema))
+		for i, column := range t.schema {
+			columns[i] = RecordColumn{Name: column.Name, Type: column.Type.(pgtypes.DoltgresType)}
+			if t.definitions != nil && len(t.definitions.Fields) > 0 {
+				columns[i].Name = t.definitions.Fields[i]
+			}
+		}
+		if rows, err = result(ctx, columns); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("function %s returned an unexpected type: %T", t.name, result)
+	}
+	sqlRows := make([]sql.Row, len(rows))
+	for i, row := range rows {
+		sqlRows[i] = row
+	}
+	return sql.RowsToRowIter(sqlRows...), nil
+}
+
+// Schema implements the interface sql.Node.
+func (t *TableFunction) Schema() sql.Schema {
+	return t.schema
+}
+
+// Resolved implements the interface sql.Node.
+func (t *TableFunction) Resolved() bool {
+	return t.function != nil && t.function.Resolved()
+}
+
+// String implements the interface sql.Node.
+func (t *TableFunction) String() string {
+	if t.function == nil {
+		return t.name + "()"
+	}
+	return t.function.String()
+}
+
+// Children implements the interface sql.Node.
+func (t *TableFunction) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the interface sql.Node.
+func (t *TableFunction) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(t, len(children), 0)
+	}
+	return t, nil
+}
+
+// CheckPrivileges implements the interface sql.Node.
+func (t *TableFunction) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// IsReadOnly implements the interface sql.Node.
+func (t *TableFunction) IsReadOnly() bool {
+	return true
+}
+
+// Expressions implements the interface sql.Expressioner.
+func (t *TableFunction) Expressions() []sql.Expression {
+	if t.function == nil {
+		return nil
+	}
+	return t.function.Parameters
+}
+
+// WithExpressions implements the interface sql.Expressioner.
+func (t *TableFunction) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if t.function == nil {
+		return nil, fmt.Errorf("table function %s has not been called", t.name)
+	}
+	function, err := t.function.WithChildren(exprs...)
+	if err != nil {
+		return nil, err
+	}
+	nt := *t
+	nt.function = function.(*CompiledFunction)
+	return &nt, nil
+}
+
+// Name implements the interface sql.Nameable.
+func (t *TableFunction) Name() string {
+	return t.name
+}
+
+// Database implements the interface sql.Databaser.
+func (t *TableFunction) Database() sql.Database {
+	return t.database
+}
+
+// WithDatabase implements the interface sql.Databaser.
+func (t *TableFunction) WithDatabase(database sql.Database) (sql.Node, error) {
+	nt := *t
+	nt.database = database
+	return &nt, nil
+}
+
+// ColumnDefinitionList is given as the last argument of a TableFunction, and contains the alias clause that followed
+// the function call. The types are only set for column definition lists, otherwise the names are column aliases. The
+// row type names a table whose columns are used as the column definition list, which is set for the functions that
+// populate a table's row type. The rows of such functions are built from the names of the table's columns, which are
+// held in the fields, as the names may have been replaced by column aliases.
+type ColumnDefinitionList struct {
+	Alias   string
+	Names   []string
+	Types   []pgtypes.DoltgresType
+	RowType string
+	Fields  []string
+}
+
+var _ sql.Expression = (*ColumnDefinitionList)(nil)
+
+// Resolved implements the interface sql.Expression.
+func (c *ColumnDefinitionList) Resolved() bool {
+	return true
+}
+
+// String implements the interface sql.Expression.
+func (c *ColumnDefinitionList) String() string {
+	sb := strings.Builder{}
+	sb.WriteString("AS " + c.Alias + "(")
+	for i, name := range c.Names {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(name)
+		if len(c.Types) > 0 {
+			sb.WriteString(" " + c.Types[i].String())
+		}
+	}
+	sb.WriteString(")")
+	return sb.String()
+}
+
+// Type implements the interface sql.Expression.
+func (c *ColumnDefinitionList) Type() sql.Type {
+	return types.Null
+}
+
+// IsNullable implements the interface sql.Expression.
+func (c *ColumnDefinitionList) IsNullable() bool {
+	return true
+}
+
+// Eval implements the interface sql.Expression.
+func (c *ColumnDefinitionList) Eval(ctx *sql.Context, row sql.Row) (any, error) {
+	return nil, fmt.Errorf("column definition lists may only be given to functions in the FROM clause")
+}
+
+// Children implements the interface sql.Expression.
+func (c *ColumnDefinitionList) Children() []sql.Expression {
+	return nil
+}
+
+// WithChildren implements the interface sql.Expression.
+func (c *ColumnDefinitionList) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 0)
+	}
+	return c, nil
+}
+
+// WithResolvedChildren implements the interface vitess.InjectableExpression.
+func (c *ColumnDefinitionList) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
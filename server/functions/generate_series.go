@@ -0,0 +1,174 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/shopspring/decimal"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initGenerateSeries registers the functions to the catalog.
+func initGenerateSeries() {
+	framework.RegisterFunction(generate_series_int32_int32)
+	framework.RegisterFunction(generate_series_int32_int32_int32)
+	framework.RegisterFunction(generate_series_int64_int64)
+	framework.RegisterFunction(generate_series_int64_int64_int64)
+	framework.RegisterFunction(generate_series_numeric_numeric)
+	framework.RegisterFunction(generate_series_numeric_numeric_numeric)
+}
+
+// generate_series_int32_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var generate_series_int32_int32 = framework.RecordFunction{
+	FunctionInterface: framework.Function2{
+		Name:       "generate_series",
+		Return:     pgtypes.Record,
+		Parameters: []pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32},
+		Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+			if val1 == nil || val2 == nil {
+				return nil, nil
+			}
+			return generateIntegerSeries(int64(val1.(int32)), int64(val2.(int32)), 1, func(val int64) any {
+				return int32(val)
+			})
+		},
+	},
+	Columns:    []framework.RecordColumn{{Type: pgtypes.Int32}},
+	ReturnsSet: true,
+}
+
+// generate_series_int32_int32_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var generate_series_int32_int32_int32 = framework.RecordFunction{
+	FunctionInterface: framework.Function3{
+		Name:       "generate_series",
+		Return:     pgtypes.Record,
+		Parameters: []pgtypes.DoltgresType{pgtypes.Int32, pgtypes.Int32, pgtypes.Int32},
+		Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+			if val1 == nil || val2 == nil || val3 == nil {
+				return nil, nil
+			}
+			return generateIntegerSeries(int64(val1.(int32)), int64(val2.(int32)), int64(val3.(int32)), func(val int64) any {
+				return int32(val)
+			})
+		},
+	},
+	Columns:    []framework.RecordColumn{{Type: pgtypes.Int32}},
+	ReturnsSet: true,
+}
+
+// generate_series_int64_int64 represents the PostgreSQL function of the same name, taking the same parameters.
+var generate_series_int64_int64 = framework.RecordFunction{
+	FunctionInterface: framework.Function2{
+		Name:       "generate_series",
+		Return:     pgtypes.Record,
+		Parameters: []pgtypes.DoltgresType{pgtypes.Int64, pgtypes.Int64},
+		Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+			if val1 == nil || val2 == nil {
+				return nil, nil
+			}
+			return generateIntegerSeries(val1.(int64), val2.(int64), 1, func(val int64) any {
+				return val
+			})
+		},
+	},
+	Columns:    []framework.RecordColumn{{Type: pgtypes.Int64}},
+	ReturnsSet: true,
+}
+
+// generate_series_int64_int64_int64 represents the PostgreSQL function of the same name, taking the same parameters.
+var generate_series_int64_int64_int64 = framework.RecordFunction{
+	FunctionInterface: framework.Function3{
+		Name:       "generate_series",
+		Return:     pgtypes.Record,
+		Parameters: []pgtypes.DoltgresType{pgtypes.Int64, pgtypes.Int64, pgtypes.Int64},
+		Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+			if val1 == nil || val2 == nil || val3 == nil {
+				return nil, nil
+			}
+			return generateIntegerSeries(val1.(int64), val2.(int64), val3.(int64), func(val int64) any {
+				return val
+			})
+		},
+	},
+	Columns:    []framework.RecordColumn{{Type: pgtypes.Int64}},
+	ReturnsSet: true,
+}
+
+// generate_series_numeric_numeric represents the PostgreSQL function of the same name, taking the same parameters.
+var generate_series_numeric_numeric = framework.RecordFunction{
+	FunctionInterface: framework.Function2{
+		Name:       "generate_series",
+		Return:     pgtypes.Record,
+		Parameters: []pgtypes.DoltgresType{pgtypes.Numeric, pgtypes.Numeric},
+		Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+			if val1 == nil || val2 == nil {
+				return nil, nil
+			}
+			return generateNumericSeries(val1.(decimal.Decimal), val2.(decimal.Decimal), decimal.NewFromInt(1))
+		},
+	},
+	Columns:    []framework.RecordColumn{{Type: pgtypes.Numeric}},
+	ReturnsSet: true,
+}
+
+// generate_series_numeric_numeric_numeric represents the PostgreSQL function of the same name, taking the same parameters.
+var generate_series_numeric_numeric_numeric = framework.RecordFunction{
+	FunctionInterface: framework.Function3{
+		Name:       "generate_series",
+		Return:     pgtypes.Record,
+		Parameters: []pgtypes.DoltgresType{pgtypes.Numeric, pgtypes.Numeric, pgtypes.Numeric},
+		Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+			if val1 == nil || val2 == nil || val3 == nil {
+				return nil, nil
+			}
+			return generateNumericSeries(val1.(decimal.Decimal), val2.(decimal.Decimal), val3.(decimal.Decimal))
+		},
+	},
+	Columns:    []framework.RecordColumn{{Type: pgtypes.Numeric}},
+	ReturnsSet: true,
+}
+
+// generateIntegerSeries returns a row for each value from start to stop (inclusive), incrementing by step. Each value is
+// converted to the function's type using the given function.
+func generateIntegerSeries(start int64, stop int64, step int64, convert func(int64) any) ([][]any, error) {
+	if step == 0 {
+		return nil, fmt.Errorf("step size cannot equal zero")
+	}
+	var rows [][]any
+	for val := start; (step > 0 && val <= stop) || (step < 0 && val >= stop); val += step {
+		rows = append(rows, []any{convert(val)})
+		// The next value would overflow, so there cannot be any more values within the bounds
+		if (step > 0 && val > stop-step) || (step < 0 && val < stop-step) {
+			break
+		}
+	}
+	return rows, nil
+}
+
+// generateNumericSeries returns a row for each value from start to stop (inclusive), incrementing by step.
+func generateNumericSeries(start decimal.Decimal, stop decimal.Decimal, step decimal.Decimal) ([][]any, error) {
+	if step.IsZero() {
+		return nil, fmt.Errorf("step size cannot equal zero")
+	}
+	var rows [][]any
+	for val := start; (step.IsPositive() && val.LessThanOrEqual(stop)) || (step.IsNegative() && val.GreaterThanOrEqual(stop)); val = val.Add(step) {
+		rows = append(rows, []any{val})
+	}
+	return rows, nil
+}
@@ -0,0 +1,121 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/lib/pq/oid"
+
+	"github.com/dolthub/doltgresql/postgres/parser/types"
+	"github.com/dolthub/doltgresql/server/auth"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// informationSchemaRole returns the role whose privileges determine which objects the information_schema views show.
+func informationSchemaRole(ctx *sql.Context) string {
+	if roleName := ctx.Client().User; len(roleName) > 0 {
+		return roleName
+	}
+	return auth.BootstrapRole
+}
+
+// isVisibleTable returns whether the information_schema views show the given table or view to the given role, which
+// is only the case when the role owns the table or holds a privilege on it.
+func isVisibleTable(ctx *sql.Context, roleName string, name doltdb.TableName) bool {
+	return auth.HasAnyPrivilege(roleName, auth.TableObject(ctx.GetCurrentDatabase(), name.Schema, name.Name))
+}
+
+// informationSchemaDataType returns the data_type of the given type as it is shown by the information_schema views.
+// Arrays are shown as ARRAY, as their element types are described elsewhere.
+func informationSchemaDataType(typ pgtypes.DoltgresType) string {
+	switch catalogTypeCategory(typ) {
+	case "A":
+		return "ARRAY"
+	case "P", "X":
+		return catalogTypeName(typ)
+	}
+	if t, ok := types.OidToType[oid.Oid(typ.OID())]; ok {
+		return t.SQLStandardName()
+	}
+	return catalogTypeName(typ)
+}
+
+// informationSchemaNumericDetails returns the numeric_precision, numeric_precision_radix, and numeric_scale of the given
+// type, each of which is NULL when it does not apply to the type.
+func informationSchemaNumericDetails(typ pgtypes.DoltgresType) (precision any, radix any, scale any) {
+	switch oid.Oid(typ.OID()) {
+	case oid.T_int2:
+		return int32(16), int32(2), int32(0)
+	case oid.T_int4:
+		return int32(32), int32(2), int32(0)
+	case oid.T_int8:
+		return int32(64), int32(2), int32(0)
+	case oid.T_float4:
+		return int32(24), int32(2), nil
+	case oid.T_float8:
+		return int32(53), int32(2), nil
+	case oid.T_numeric:
+		if numericType, ok := typ.(pgtypes.NumericType); ok && !typ.IsUnbounded() {
+			return numericType.Precision, int32(10), numericType.Scale
+		}
+		return nil, int32(10), nil
+	default:
+		return nil, nil, nil
+	}
+}
+
+// informationSchemaCharacterLength returns the character_maximum_length of the given type, which is NULL for types that
+// are not bounded strings.
+func informationSchemaCharacterLength(typ pgtypes.DoltgresType) any {
+	if typ.IsUnbounded() {
+		return nil
+	}
+	switch typ := typ.(type) {
+	case pgtypes.VarCharType:
+		return int32(typ.Length)
+	case pgtypes.CharType:
+		return int32(typ.Length)
+	default:
+		return nil
+	}
+}
+
+// informationSchemaDatetimePrecision returns the datetime_precision of the given type, which is NULL for types that do
+// not hold dates or times.
+func informationSchemaDatetimePrecision(typ pgtypes.DoltgresType) any {
+	switch oid.Oid(typ.OID()) {
+	case oid.T_date:
+		return int32(0)
+	case oid.T_time, oid.T_timetz, oid.T_timestamp, oid.T_timestamptz, oid.T_interval:
+		return int32(6)
+	default:
+		return nil
+	}
+}
+
+// referentialActionRule returns the update_rule or delete_rule of a foreign key with the given referential action.
+func referentialActionRule(action doltdb.ForeignKeyReferentialAction) string {
+	switch action {
+	case doltdb.ForeignKeyReferentialAction_Cascade:
+		return "CASCADE"
+	case doltdb.ForeignKeyReferentialAction_Restrict:
+		return "RESTRICT"
+	case doltdb.ForeignKeyReferentialAction_SetNull:
+		return "SET NULL"
+	default:
+		return "NO ACTION"
+	}
+}
@@ -20,6 +20,15 @@ func Init() {
 	initAcos()
 	initAcosd()
 	initAcosh()
+	initArrayCat()
+	initArrayLength()
+	initArrayLower()
+	initArrayPosition()
+	initArrayPositions()
+	initArrayRemove()
+	initArrayReplace()
+	initArrayToString()
+	initArrayUpper()
 	initAscii()
 	initAsin()
 	initAsind()
@@ -31,22 +40,55 @@ func Init() {
 	initAtanh()
 	initBitLength()
 	initBtrim()
+	initCardinality()
 	initCbrt()
 	initCeil()
 	initCharLength()
 	initChr()
+	initColumnList()
 	initCos()
 	initCosd()
 	initCosh()
 	initCot()
 	initCotd()
+	initCurrentSetting()
+	initDatePart()
 	initDegrees()
 	initDiv()
+	initDoltMergeBase()
+	initDoltPatch()
+	initDoltSchemaDiff()
+	initDoltStashList()
+	initDoltWorkingSet()
+	initDoltgresKafkaSink()
+	initDoltgresKillSwitch()
+	initDoltgresPublicationChanges()
+	initDoltgresStorageParameters()
+	initDoltgresVersion()
 	initExp()
+	initExtract()
 	initFactorial()
 	initFloor()
+	initFormatType()
 	initGcd()
+	initGenerateSeries()
 	initInitcap()
+	initJsonExtractPath()
+	initJsonbArrayElements()
+	initJsonbEach()
+	initJsonbInsert()
+	initJsonbPathExists()
+	initJsonbPathMatch()
+	initJsonbPathQueryArray()
+	initJsonbPathQueryFirst()
+	initJsonbPretty()
+	initJsonbSet()
+	initJsonbStripNulls()
+	initJsonbToRecord()
+	initJustifyDays()
+	initJustifyHours()
+	initJustifyInterval()
+	initKeyColumnUsageList()
 	initLcm()
 	initLeft()
 	initLength()
@@ -61,14 +103,69 @@ func Init() {
 	initMod()
 	initNextVal()
 	initOctetLength()
+	initPgAdvisoryLock()
+	initPgAmList()
+	initPgAttrdefList()
+	initPgAttributeList()
+	initPgAuthMemberList()
+	initPgCastList()
+	initPgClassList()
+	initPgCollationList()
+	initPgConstraintList()
+	initPgCursor()
+	initPgDatabaseList()
+	initPgEncodingToChar()
+	initPgEnumList()
+	initPgFunctionIsVisible()
+	initPgGetConstraintdef()
+	initPgGetExpr()
+	initPgGetFunctionArguments()
+	initPgGetFunctionResult()
+	initPgGetIndexdef()
+	initPgGetPartkeydef()
+	initPgGetStatisticsobjdefColumns()
+	initPgGetUserById()
+	initPgHasRole()
+	initPgIndexList()
+	initPgInheritsList()
+	initPgLockList()
+	initPgNamespaceList()
+	initPgNotify()
+	initPgOperatorList()
+	initPgPartitionAncestors()
+	initPgPolicyList()
+	initPgPreparedStatement()
+	initPgProcList()
+	initPgPublicationList()
+	initPgPublicationNamespaceList()
+	initPgPublicationRelList()
+	initPgPublicationTableList()
+	initPgRangeList()
+	initPgRelationIsPublishable()
+	initPgRoleList()
+	initPgSequenceList()
+	initPgSettingsList()
+	initPgSleep()
+	initPgStatReset()
+	initPgStatUserIndexList()
+	initPgStatUserTableList()
+	initPgStatioUserIndexList()
+	initPgStatioUserTableList()
+	initPgStatisticExtList()
+	initPgTableIsVisible()
+	initPgTablespaceList()
+	initPgTypeList()
 	initPi()
 	initPower()
 	initRadians()
 	initRandom()
+	initReferentialConstraintList()
+	initRegNames()
 	initRepeat()
 	initReplace()
 	initReverse()
 	initRight()
+	initRoutineList()
 	initRound()
 	initRpad()
 	initRtrim()
@@ -80,8 +177,12 @@ func Init() {
 	initSinh()
 	initSplitPart()
 	initSqrt()
+	initStringToArray()
 	initStrpos()
 	initSubstr()
+	initTableConstraintList()
+	initTableList()
+	initTablePrivilegeList()
 	initTan()
 	initTand()
 	initTanh()
@@ -89,5 +190,6 @@ func Init() {
 	initTrimScale()
 	initTrunc()
 	initUpper()
+	initViewList()
 	initWidthBucket()
 }
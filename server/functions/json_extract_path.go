@@ -0,0 +1,258 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"strconv"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initJsonExtractPath registers the functions to the catalog.
+func initJsonExtractPath() {
+	framework.RegisterFunction(json_extract_path_json_text)
+	framework.RegisterFunction(json_extract_path_json_text_text)
+	framework.RegisterFunction(json_extract_path_json_text_text_text)
+	framework.RegisterFunction(json_extract_path_text_json_text)
+	framework.RegisterFunction(json_extract_path_text_json_text_text)
+	framework.RegisterFunction(json_extract_path_text_json_text_text_text)
+	framework.RegisterFunction(jsonb_extract_path_jsonb_text)
+	framework.RegisterFunction(jsonb_extract_path_jsonb_text_text)
+	framework.RegisterFunction(jsonb_extract_path_jsonb_text_text_text)
+	framework.RegisterFunction(jsonb_extract_path_text_jsonb_text)
+	framework.RegisterFunction(jsonb_extract_path_text_jsonb_text_text)
+	framework.RegisterFunction(jsonb_extract_path_text_jsonb_text_text_text)
+}
+
+// These functions take a VARIADIC parameter in Postgres. Variadic parameters are not yet supported, so up to three
+// separate path elements are accepted. The overloads taking a text array are declared alongside the path operators.
+
+// jsonExtractPath implements json_extract_path, with the path elements given as a slice.
+func jsonExtractPath(ctx *sql.Context, val1 any, path []any) (any, error) {
+	if val1 == nil {
+		return nil, nil
+	}
+	// TODO: make a bespoke implementation that preserves whitespace
+	newVal, err := pgtypes.JsonB.IoInput(val1.(string))
+	if err != nil {
+		return nil, err
+	}
+	retVal, err := jsonbExtractPathJsonb(ctx, newVal, path)
+	if err != nil || retVal == nil {
+		return nil, err
+	}
+	return pgtypes.JsonB.FormatValue(retVal)
+}
+
+// json_extract_path_json_text represents the PostgreSQL function of the same name, taking the same parameters.
+var json_extract_path_json_text = framework.Function2{
+	Name:       "json_extract_path",
+	Return:     pgtypes.Json,
+	Parameters: []pgtypes.DoltgresType{pgtypes.Json, pgtypes.Text},
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return jsonExtractPath(ctx, val1, []any{val2})
+	},
+}
+
+// json_extract_path_json_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var json_extract_path_json_text_text = framework.Function3{
+	Name:       "json_extract_path",
+	Return:     pgtypes.Json,
+	Parameters: []pgtypes.DoltgresType{pgtypes.Json, pgtypes.Text, pgtypes.Text},
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		return jsonExtractPath(ctx, val1, []any{val2, val3})
+	},
+}
+
+// json_extract_path_json_text_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var json_extract_path_json_text_text_text = framework.Function4{
+	Name:       "json_extract_path",
+	Return:     pgtypes.Json,
+	Parameters: []pgtypes.DoltgresType{pgtypes.Json, pgtypes.Text, pgtypes.Text, pgtypes.Text},
+	Callable: func(ctx *sql.Context, _ [5]pgtypes.DoltgresType, val1 any, val2 any, val3 any, val4 any) (any, error) {
+		return jsonExtractPath(ctx, val1, []any{val2, val3, val4})
+	},
+}
+
+// jsonExtractPathText implements json_extract_path_text, with the path elements given as a slice.
+func jsonExtractPathText(ctx *sql.Context, val1 any, path []any) (any, error) {
+	if val1 == nil {
+		return nil, nil
+	}
+	// TODO: make a bespoke implementation that preserves whitespace
+	newVal, err := pgtypes.JsonB.IoInput(val1.(string))
+	if err != nil {
+		return nil, err
+	}
+	return jsonbExtractPathText(ctx, newVal, path)
+}
+
+// json_extract_path_text_json_text represents the PostgreSQL function of the same name, taking the same parameters.
+var json_extract_path_text_json_text = framework.Function2{
+	Name:       "json_extract_path_text",
+	Return:     pgtypes.Text,
+	Parameters: []pgtypes.DoltgresType{pgtypes.Json, pgtypes.Text},
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return jsonExtractPathText(ctx, val1, []any{val2})
+	},
+}
+
+// json_extract_path_text_json_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var json_extract_path_text_json_text_text = framework.Function3{
+	Name:       "json_extract_path_text",
+	Return:     pgtypes.Text,
+	Parameters: []pgtypes.DoltgresType{pgtypes.Json, pgtypes.Text, pgtypes.Text},
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		return jsonExtractPathText(ctx, val1, []any{val2, val3})
+	},
+}
+
+// json_extract_path_text_json_text_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var json_extract_path_text_json_text_text_text = framework.Function4{
+	Name:       "json_extract_path_text",
+	Return:     pgtypes.Text,
+	Parameters: []pgtypes.DoltgresType{pgtypes.Json, pgtypes.Text, pgtypes.Text, pgtypes.Text},
+	Callable: func(ctx *sql.Context, _ [5]pgtypes.DoltgresType, val1 any, val2 any, val3 any, val4 any) (any, error) {
+		return jsonExtractPathText(ctx, val1, []any{val2, val3, val4})
+	},
+}
+
+// jsonbExtractPathJsonb implements jsonb_extract_path, with the path elements given as a slice.
+func jsonbExtractPathJsonb(ctx *sql.Context, val1 any, path []any) (any, error) {
+	if val1 == nil {
+		return nil, nil
+	}
+	value, ok := jsonbExtractPath(val1.(pgtypes.JsonDocument).Value, path)
+	if !ok {
+		return nil, nil
+	}
+	return pgtypes.JsonDocument{Value: value}, nil
+}
+
+// jsonb_extract_path_jsonb_text represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_extract_path_jsonb_text = framework.Function2{
+	Name:       "jsonb_extract_path",
+	Return:     pgtypes.JsonB,
+	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Text},
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return jsonbExtractPathJsonb(ctx, val1, []any{val2})
+	},
+}
+
+// jsonb_extract_path_jsonb_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_extract_path_jsonb_text_text = framework.Function3{
+	Name:       "jsonb_extract_path",
+	Return:     pgtypes.JsonB,
+	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Text, pgtypes.Text},
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		return jsonbExtractPathJsonb(ctx, val1, []any{val2, val3})
+	},
+}
+
+// jsonb_extract_path_jsonb_text_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_extract_path_jsonb_text_text_text = framework.Function4{
+	Name:       "jsonb_extract_path",
+	Return:     pgtypes.JsonB,
+	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Text, pgtypes.Text, pgtypes.Text},
+	Callable: func(ctx *sql.Context, _ [5]pgtypes.DoltgresType, val1 any, val2 any, val3 any, val4 any) (any, error) {
+		return jsonbExtractPathJsonb(ctx, val1, []any{val2, val3, val4})
+	},
+}
+
+// jsonbExtractPathText implements jsonb_extract_path_text, with the path elements given as a slice.
+func jsonbExtractPathText(ctx *sql.Context, val1 any, path []any) (any, error) {
+	if val1 == nil {
+		return nil, nil
+	}
+	value, ok := jsonbExtractPath(val1.(pgtypes.JsonDocument).Value, path)
+	if !ok {
+		return nil, nil
+	}
+	switch value := value.(type) {
+	case pgtypes.JsonValueString:
+		return string(value), nil
+	case pgtypes.JsonValueNull:
+		return nil, nil
+	default:
+		return pgtypes.JsonB.FormatValue(pgtypes.JsonDocument{Value: value})
+	}
+}
+
+// jsonb_extract_path_text_jsonb_text represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_extract_path_text_jsonb_text = framework.Function2{
+	Name:       "jsonb_extract_path_text",
+	Return:     pgtypes.Text,
+	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Text},
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return jsonbExtractPathText(ctx, val1, []any{val2})
+	},
+}
+
+// jsonb_extract_path_text_jsonb_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_extract_path_text_jsonb_text_text = framework.Function3{
+	Name:       "jsonb_extract_path_text",
+	Return:     pgtypes.Text,
+	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Text, pgtypes.Text},
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		return jsonbExtractPathText(ctx, val1, []any{val2, val3})
+	},
+}
+
+// jsonb_extract_path_text_jsonb_text_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_extract_path_text_jsonb_text_text_text = framework.Function4{
+	Name:       "jsonb_extract_path_text",
+	Return:     pgtypes.Text,
+	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Text, pgtypes.Text, pgtypes.Text},
+	Callable: func(ctx *sql.Context, _ [5]pgtypes.DoltgresType, val1 any, val2 any, val3 any, val4 any) (any, error) {
+		return jsonbExtractPathText(ctx, val1, []any{val2, val3, val4})
+	},
+}
+
+// jsonbExtractPath returns the value located at the given path. Returns false if any path element is null, or if the
+// path does not exist within the value. Array indexes may be negative, in which case they count from the end.
+func jsonbExtractPath(value pgtypes.JsonValue, path []any) (pgtypes.JsonValue, bool) {
+	for _, pathElement := range path {
+		textPath, ok := pathElement.(string)
+		if !ok {
+			return nil, false
+		}
+		switch currentValue := value.(type) {
+		case pgtypes.JsonValueObject:
+			idx, ok := currentValue.Index[textPath]
+			if !ok {
+				return nil, false
+			}
+			value = currentValue.Items[idx].Value
+		case pgtypes.JsonValueArray:
+			idx, err := strconv.Atoi(textPath)
+			if err != nil {
+				return nil, false
+			}
+			if idx < 0 {
+				idx += len(currentValue)
+			}
+			if idx < 0 || idx >= len(currentValue) {
+				return nil, false
+			}
+			value = currentValue[idx]
+		default:
+			return nil, false
+		}
+	}
+	return value, true
+}
@@ -0,0 +1,134 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initJsonbArrayElements registers the functions to the catalog.
+func initJsonbArrayElements() {
+	framework.RegisterFunction(json_array_elements_json)
+	framework.RegisterFunction(json_array_elements_text_json)
+	framework.RegisterFunction(jsonb_array_elements_jsonb)
+	framework.RegisterFunction(jsonb_array_elements_text_jsonb)
+}
+
+// json_array_elements_json represents the PostgreSQL function of the same name, taking the same parameters.
+var json_array_elements_json = framework.RecordFunction{
+	FunctionInterface: framework.Function1{
+		Name:       "json_array_elements",
+		Return:     pgtypes.Record,
+		Parameters: []pgtypes.DoltgresType{pgtypes.Json},
+		Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+			if val1 == nil {
+				return nil, nil
+			}
+			// TODO: make a bespoke implementation that preserves whitespace
+			doc, err := pgtypes.JsonB.IoInput(val1.(string))
+			if err != nil {
+				return nil, err
+			}
+			return jsonbArrayElements(doc.(pgtypes.JsonDocument).Value, func(value pgtypes.JsonValue) (any, error) {
+				return pgtypes.JsonB.FormatValue(pgtypes.JsonDocument{Value: value})
+			})
+		},
+	},
+	Columns:    []framework.RecordColumn{{Name: "value", Type: pgtypes.Json}},
+	ReturnsSet: true,
+}
+
+// json_array_elements_text_json represents the PostgreSQL function of the same name, taking the same parameters.
+var json_array_elements_text_json = framework.RecordFunction{
+	FunctionInterface: framework.Function1{
+		Name:       "json_array_elements_text",
+		Return:     pgtypes.Record,
+		Parameters: []pgtypes.DoltgresType{pgtypes.Json},
+		Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+			if val1 == nil {
+				return nil, nil
+			}
+			doc, err := pgtypes.JsonB.IoInput(val1.(string))
+			if err != nil {
+				return nil, err
+			}
+			return jsonbArrayElements(doc.(pgtypes.JsonDocument).Value, jsonbValueAsText)
+		},
+	},
+	Columns:    []framework.RecordColumn{{Name: "value", Type: pgtypes.Text}},
+	ReturnsSet: true,
+}
+
+// jsonb_array_elements_jsonb represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_array_elements_jsonb = framework.RecordFunction{
+	FunctionInterface: framework.Function1{
+		Name:       "jsonb_array_elements",
+		Return:     pgtypes.Record,
+		Parameters: []pgtypes.DoltgresType{pgtypes.JsonB},
+		Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+			if val1 == nil {
+				return nil, nil
+			}
+			return jsonbArrayElements(val1.(pgtypes.JsonDocument).Value, func(value pgtypes.JsonValue) (any, error) {
+				return pgtypes.JsonDocument{Value: value}, nil
+			})
+		},
+	},
+	Columns:    []framework.RecordColumn{{Name: "value", Type: pgtypes.JsonB}},
+	ReturnsSet: true,
+}
+
+// jsonb_array_elements_text_jsonb represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_array_elements_text_jsonb = framework.RecordFunction{
+	FunctionInterface: framework.Function1{
+		Name:       "jsonb_array_elements_text",
+		Return:     pgtypes.Record,
+		Parameters: []pgtypes.DoltgresType{pgtypes.JsonB},
+		Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+			if val1 == nil {
+				return nil, nil
+			}
+			return jsonbArrayElements(val1.(pgtypes.JsonDocument).Value, jsonbValueAsText)
+		},
+	},
+	Columns:    []framework.RecordColumn{{Name: "value", Type: pgtypes.Text}},
+	ReturnsSet: true,
+}
+
+// jsonbArrayElements returns a row for each element of the given array, containing the element as converted by the
+// given function.
+func jsonbArrayElements(value pgtypes.JsonValue, convert func(pgtypes.JsonValue) (any, error)) ([][]any, error) {
+	switch value := value.(type) {
+	case pgtypes.JsonValueArray:
+		rows := make([][]any, len(value))
+		for i, element := range value {
+			convertedValue, err := convert(element)
+			if err != nil {
+				return nil, err
+			}
+			rows[i] = []any{convertedValue}
+		}
+		return rows, nil
+	case pgtypes.JsonValueObject:
+		return nil, fmt.Errorf("cannot extract elements from an object")
+	default:
+		return nil, fmt.Errorf("cannot extract elements from a scalar")
+	}
+}
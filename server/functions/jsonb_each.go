@@ -0,0 +1,143 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initJsonbEach registers the functions to the catalog.
+func initJsonbEach() {
+	framework.RegisterFunction(json_each_json)
+	framework.RegisterFunction(json_each_text_json)
+	framework.RegisterFunction(jsonb_each_jsonb)
+	framework.RegisterFunction(jsonb_each_text_jsonb)
+}
+
+// json_each_json represents the PostgreSQL function of the same name, taking the same parameters.
+var json_each_json = framework.RecordFunction{
+	FunctionInterface: framework.Function1{
+		Name:       "json_each",
+		Return:     pgtypes.Record,
+		Parameters: []pgtypes.DoltgresType{pgtypes.Json},
+		Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+			if val1 == nil {
+				return nil, nil
+			}
+			// TODO: make a bespoke implementation that preserves whitespace and duplicate keys
+			doc, err := pgtypes.JsonB.IoInput(val1.(string))
+			if err != nil {
+				return nil, err
+			}
+			return jsonbEach("json_each", doc.(pgtypes.JsonDocument).Value, func(value pgtypes.JsonValue) (any, error) {
+				return pgtypes.JsonB.FormatValue(pgtypes.JsonDocument{Value: value})
+			})
+		},
+	},
+	Columns:    []framework.RecordColumn{{Name: "key", Type: pgtypes.Text}, {Name: "value", Type: pgtypes.Json}},
+	ReturnsSet: true,
+}
+
+// json_each_text_json represents the PostgreSQL function of the same name, taking the same parameters.
+var json_each_text_json = framework.RecordFunction{
+	FunctionInterface: framework.Function1{
+		Name:       "json_each_text",
+		Return:     pgtypes.Record,
+		Parameters: []pgtypes.DoltgresType{pgtypes.Json},
+		Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+			if val1 == nil {
+				return nil, nil
+			}
+			doc, err := pgtypes.JsonB.IoInput(val1.(string))
+			if err != nil {
+				return nil, err
+			}
+			return jsonbEach("json_each_text", doc.(pgtypes.JsonDocument).Value, jsonbValueAsText)
+		},
+	},
+	Columns:    []framework.RecordColumn{{Name: "key", Type: pgtypes.Text}, {Name: "value", Type: pgtypes.Text}},
+	ReturnsSet: true,
+}
+
+// jsonb_each_jsonb represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_each_jsonb = framework.RecordFunction{
+	FunctionInterface: framework.Function1{
+		Name:       "jsonb_each",
+		Return:     pgtypes.Record,
+		Parameters: []pgtypes.DoltgresType{pgtypes.JsonB},
+		Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+			if val1 == nil {
+				return nil, nil
+			}
+			return jsonbEach("jsonb_each", val1.(pgtypes.JsonDocument).Value, func(value pgtypes.JsonValue) (any, error) {
+				return pgtypes.JsonDocument{Value: value}, nil
+			})
+		},
+	},
+	Columns:    []framework.RecordColumn{{Name: "key", Type: pgtypes.Text}, {Name: "value", Type: pgtypes.JsonB}},
+	ReturnsSet: true,
+}
+
+// jsonb_each_text_jsonb represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_each_text_jsonb = framework.RecordFunction{
+	FunctionInterface: framework.Function1{
+		Name:       "jsonb_each_text",
+		Return:     pgtypes.Record,
+		Parameters: []pgtypes.DoltgresType{pgtypes.JsonB},
+		Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+			if val1 == nil {
+				return nil, nil
+			}
+			return jsonbEach("jsonb_each_text", val1.(pgtypes.JsonDocument).Value, jsonbValueAsText)
+		},
+	},
+	Columns:    []framework.RecordColumn{{Name: "key", Type: pgtypes.Text}, {Name: "value", Type: pgtypes.Text}},
+	ReturnsSet: true,
+}
+
+// jsonbEach returns a row for each field of the given object, containing the key and the value as converted by the
+// given function.
+func jsonbEach(functionName string, value pgtypes.JsonValue, convert func(pgtypes.JsonValue) (any, error)) ([][]any, error) {
+	object, ok := value.(pgtypes.JsonValueObject)
+	if !ok {
+		return nil, fmt.Errorf("cannot call %s on a non-object", functionName)
+	}
+	rows := make([][]any, len(object.Items))
+	for i, item := range object.Items {
+		convertedValue, err := convert(item.Value)
+		if err != nil {
+			return nil, err
+		}
+		rows[i] = []any{item.Key, convertedValue}
+	}
+	return rows, nil
+}
+
+// jsonbValueAsText returns the text form of the value, which is unquoted for strings, and NULL for JSON nulls.
+func jsonbValueAsText(value pgtypes.JsonValue) (any, error) {
+	switch value := value.(type) {
+	case pgtypes.JsonValueNull:
+		return nil, nil
+	case pgtypes.JsonValueString:
+		return string(value), nil
+	default:
+		return pgtypes.JsonB.FormatValue(pgtypes.JsonDocument{Value: value})
+	}
+}
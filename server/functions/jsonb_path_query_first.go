@@ -0,0 +1,69 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initJsonbPathQueryFirst registers the functions to the catalog.
+func initJsonbPathQueryFirst() {
+	framework.RegisterFunction(jsonb_path_query_first_jsonb_text)
+	framework.RegisterFunction(jsonb_path_query_first_jsonb_text_jsonb)
+	framework.RegisterFunction(jsonb_path_query_first_jsonb_text_jsonb_bool)
+}
+
+// jsonb_path_query_first_jsonb_text represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_path_query_first_jsonb_text = framework.Function2{
+	Name:       "jsonb_path_query_first",
+	Return:     pgtypes.JsonB,
+	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Text},
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return jsonb_path_query_first_jsonb_text_jsonb_bool.Callable(ctx, [5]pgtypes.DoltgresType{}, val1, val2, pgtypes.JsonDocument{Value: pgtypes.JsonValueObject{}}, false)
+	},
+}
+
+// jsonb_path_query_first_jsonb_text_jsonb represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_path_query_first_jsonb_text_jsonb = framework.Function3{
+	Name:       "jsonb_path_query_first",
+	Return:     pgtypes.JsonB,
+	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Text, pgtypes.JsonB},
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		return jsonb_path_query_first_jsonb_text_jsonb_bool.Callable(ctx, [5]pgtypes.DoltgresType{}, val1, val2, val3, false)
+	},
+}
+
+// jsonb_path_query_first_jsonb_text_jsonb_bool represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_path_query_first_jsonb_text_jsonb_bool = framework.Function4{
+	Name:       "jsonb_path_query_first",
+	Return:     pgtypes.JsonB,
+	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.Text, pgtypes.JsonB, pgtypes.Bool},
+	Callable: func(ctx *sql.Context, _ [5]pgtypes.DoltgresType, val1 any, val2 any, val3 any, val4 any) (any, error) {
+		if val1 == nil || val2 == nil || val3 == nil || val4 == nil {
+			return nil, nil
+		}
+		results, _, err := jsonbPathQuery(val1, val2, val3, val4)
+		if err != nil {
+			return nil, err
+		}
+		if len(results) == 0 {
+			return nil, nil
+		}
+		return pgtypes.JsonDocument{Value: results[0]}, nil
+	},
+}
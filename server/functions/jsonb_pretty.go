@@ -0,0 +1,101 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initJsonbPretty registers the functions to the catalog.
+func initJsonbPretty() {
+	framework.RegisterFunction(jsonb_pretty_jsonb)
+}
+
+// jsonb_pretty_jsonb represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_pretty_jsonb = framework.Function1{
+	Name:       "jsonb_pretty",
+	Return:     pgtypes.Text,
+	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB},
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+		if val1 == nil {
+			return nil, nil
+		}
+		sb := strings.Builder{}
+		sb.Grow(256)
+		if err := jsonbPrettyFormatter(&sb, val1.(pgtypes.JsonDocument).Value, 0); err != nil {
+			return nil, err
+		}
+		return sb.String(), nil
+	},
+}
+
+// jsonbPrettyFormatter writes the value to the string builder using the indentation of jsonb_pretty. Postgres places
+// the closing brace or bracket of an empty object or array on its own line, which is also replicated here.
+func jsonbPrettyFormatter(sb *strings.Builder, value pgtypes.JsonValue, level int) error {
+	switch value := value.(type) {
+	case pgtypes.JsonValueObject:
+		sb.WriteRune('{')
+		for i, item := range value.Items {
+			if i > 0 {
+				sb.WriteRune(',')
+			}
+			jsonbPrettyIndent(sb, level+1)
+			key, err := pgtypes.JsonB.FormatValue(pgtypes.JsonDocument{Value: pgtypes.JsonValueString(item.Key)})
+			if err != nil {
+				return err
+			}
+			sb.WriteString(key)
+			sb.WriteString(": ")
+			if err = jsonbPrettyFormatter(sb, item.Value, level+1); err != nil {
+				return err
+			}
+		}
+		jsonbPrettyIndent(sb, level)
+		sb.WriteRune('}')
+	case pgtypes.JsonValueArray:
+		sb.WriteRune('[')
+		for i, element := range value {
+			if i > 0 {
+				sb.WriteRune(',')
+			}
+			jsonbPrettyIndent(sb, level+1)
+			if err := jsonbPrettyFormatter(sb, element, level+1); err != nil {
+				return err
+			}
+		}
+		jsonbPrettyIndent(sb, level)
+		sb.WriteRune(']')
+	default:
+		str, err := pgtypes.JsonB.FormatValue(pgtypes.JsonDocument{Value: value})
+		if err != nil {
+			return err
+		}
+		sb.WriteString(str)
+	}
+	return nil
+}
+
+// jsonbPrettyIndent writes a newline, followed by four spaces for each level.
+func jsonbPrettyIndent(sb *strings.Builder, level int) {
+	sb.WriteRune('\n')
+	for i := 0; i < level; i++ {
+		sb.WriteString("    ")
+	}
+}
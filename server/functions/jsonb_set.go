@@ -0,0 +1,207 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initJsonbSet registers the functions to the catalog.
+func initJsonbSet() {
+	framework.RegisterFunction(jsonb_set_jsonb_text_array_jsonb)
+	framework.RegisterFunction(jsonb_set_jsonb_text_array_jsonb_bool)
+}
+
+// jsonb_set_jsonb_text_array_jsonb represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_set_jsonb_text_array_jsonb = framework.Function3{
+	Name:       "jsonb_set",
+	Return:     pgtypes.JsonB,
+	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.TextArray, pgtypes.JsonB},
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		// create_if_missing defaults to true
+		return jsonb_set_jsonb_text_array_jsonb_bool.Callable(ctx, [5]pgtypes.DoltgresType{}, val1, val2, val3, true)
+	},
+}
+
+// jsonb_set_jsonb_text_array_jsonb_bool represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_set_jsonb_text_array_jsonb_bool = framework.Function4{
+	Name:       "jsonb_set",
+	Return:     pgtypes.JsonB,
+	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB, pgtypes.TextArray, pgtypes.JsonB, pgtypes.Bool},
+	Callable: func(ctx *sql.Context, _ [5]pgtypes.DoltgresType, val1 any, val2 any, val3 any, val4 any) (any, error) {
+		if val1 == nil || val2 == nil || val3 == nil || val4 == nil {
+			return nil, nil
+		}
+		operation := jsonbPathOperation_Replace
+		if val4.(bool) {
+			operation = jsonbPathOperation_ReplaceOrCreate
+		}
+		return jsonbApplyPath(val1.(pgtypes.JsonDocument), val2.([]any), val3.(pgtypes.JsonDocument), operation)
+	},
+}
+
+// jsonbPathOperation states how a new value is applied to the location that a path points to.
+type jsonbPathOperation uint8
+
+const (
+	// jsonbPathOperation_Replace only replaces existing values.
+	jsonbPathOperation_Replace jsonbPathOperation = iota
+	// jsonbPathOperation_ReplaceOrCreate replaces existing values, adding the value if the final path element is missing.
+	jsonbPathOperation_ReplaceOrCreate
+	// jsonbPathOperation_InsertBefore inserts the value before an array element, or adds a new object key.
+	jsonbPathOperation_InsertBefore
+	// jsonbPathOperation_InsertAfter inserts the value after an array element, or adds a new object key.
+	jsonbPathOperation_InsertAfter
+)
+
+// jsonbApplyPath applies the new value to the location within the document that is pointed to by the path. This
+// implements the shared logic of jsonb_set and jsonb_insert.
+func jsonbApplyPath(doc pgtypes.JsonDocument, pathArray []any, newValue pgtypes.JsonDocument, operation jsonbPathOperation) (any, error) {
+	switch doc.Value.(type) {
+	case pgtypes.JsonValueObject, pgtypes.JsonValueArray:
+	default:
+		return nil, fmt.Errorf("cannot set path in scalar")
+	}
+	path := make([]string, len(pathArray))
+	for i, pathElement := range pathArray {
+		if pathElement == nil {
+			return nil, fmt.Errorf("path element at position %d is null", i+1)
+		}
+		path[i] = pathElement.(string)
+	}
+	if len(path) == 0 {
+		return doc, nil
+	}
+	value, err := jsonbApplyPathElement(doc.Value, path, 0, pgtypes.JsonValueCopy(newValue.Value), operation)
+	if err != nil {
+		return nil, err
+	}
+	return pgtypes.JsonDocument{Value: value}, nil
+}
+
+// jsonbApplyPathElement is the recursive portion of jsonbApplyPath, handling the path element at the given index. The
+// given value is never modified, as it may be shared with other documents.
+func jsonbApplyPathElement(value pgtypes.JsonValue, path []string, pathIdx int, newValue pgtypes.JsonValue, operation jsonbPathOperation) (pgtypes.JsonValue, error) {
+	isLastElement := pathIdx == len(path)-1
+	switch value := value.(type) {
+	case pgtypes.JsonValueObject:
+		key := path[pathIdx]
+		itemIdx, exists := value.Index[key]
+		if !exists {
+			if !isLastElement || operation == jsonbPathOperation_Replace {
+				return value, nil
+			}
+			return jsonbObjectWithItem(value, key, newValue), nil
+		}
+		if isLastElement {
+			if operation == jsonbPathOperation_InsertBefore || operation == jsonbPathOperation_InsertAfter {
+				return nil, fmt.Errorf("cannot replace existing key")
+			}
+		} else {
+			var err error
+			newValue, err = jsonbApplyPathElement(value.Items[itemIdx].Value, path, pathIdx+1, newValue, operation)
+			if err != nil {
+				return nil, err
+			}
+		}
+		newItems := make([]pgtypes.JsonValueObjectItem, len(value.Items))
+		copy(newItems, value.Items)
+		newItems[itemIdx].Value = newValue
+		return pgtypes.JsonValueObject{Items: newItems, Index: value.Index}, nil
+	case pgtypes.JsonValueArray:
+		idx, err := strconv.Atoi(path[pathIdx])
+		if err != nil {
+			return nil, fmt.Errorf(`path element at position %d is not an integer: "%s"`, pathIdx+1, path[pathIdx])
+		}
+		// Negative indexes count from the end, and any index beyond the bounds of the array is clamped
+		prepend := false
+		if idx < 0 {
+			if -idx > len(value) {
+				prepend = true
+				idx = 0
+			} else {
+				idx += len(value)
+			}
+		}
+		appending := idx >= len(value)
+		if !isLastElement {
+			if prepend || appending {
+				return value, nil
+			}
+			newElement, err := jsonbApplyPathElement(value[idx], path, pathIdx+1, newValue, operation)
+			if err != nil {
+				return nil, err
+			}
+			newArray := make(pgtypes.JsonValueArray, len(value))
+			copy(newArray, value)
+			newArray[idx] = newElement
+			return newArray, nil
+		}
+		insertIdx := idx
+		switch {
+		case prepend:
+			if operation == jsonbPathOperation_Replace {
+				return value, nil
+			}
+			insertIdx = 0
+		case appending:
+			if operation == jsonbPathOperation_Replace {
+				return value, nil
+			}
+			insertIdx = len(value)
+		case operation == jsonbPathOperation_Replace || operation == jsonbPathOperation_ReplaceOrCreate:
+			newArray := make(pgtypes.JsonValueArray, len(value))
+			copy(newArray, value)
+			newArray[idx] = newValue
+			return newArray, nil
+		case operation == jsonbPathOperation_InsertAfter:
+			insertIdx = idx + 1
+		}
+		newArray := make(pgtypes.JsonValueArray, 0, len(value)+1)
+		newArray = append(newArray, value[:insertIdx]...)
+		newArray = append(newArray, newValue)
+		newArray = append(newArray, value[insertIdx:]...)
+		return newArray, nil
+	default:
+		// Paths that continue through scalars are ignored
+		return value, nil
+	}
+}
+
+// jsonbObjectWithItem returns a new object that contains the new key and value. This assumes that the key does not
+// already exist within the object. Items are sorted in the same order as JSONB objects in Postgres.
+func jsonbObjectWithItem(object pgtypes.JsonValueObject, key string, value pgtypes.JsonValue) pgtypes.JsonValueObject {
+	newItems := make([]pgtypes.JsonValueObjectItem, len(object.Items), len(object.Items)+1)
+	copy(newItems, object.Items)
+	newItems = append(newItems, pgtypes.JsonValueObjectItem{Key: key, Value: value})
+	sort.Slice(newItems, func(i, j int) bool {
+		if len(newItems[i].Key) != len(newItems[j].Key) {
+			return len(newItems[i].Key) < len(newItems[j].Key)
+		}
+		return newItems[i].Key < newItems[j].Key
+	})
+	newIndex := make(map[string]int, len(newItems))
+	for i, item := range newItems {
+		newIndex[item.Key] = i
+	}
+	return pgtypes.JsonValueObject{Items: newItems, Index: newIndex}
+}
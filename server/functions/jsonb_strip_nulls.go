@@ -0,0 +1,89 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initJsonbStripNulls registers the functions to the catalog.
+func initJsonbStripNulls() {
+	framework.RegisterFunction(json_strip_nulls_json)
+	framework.RegisterFunction(jsonb_strip_nulls_jsonb)
+}
+
+// json_strip_nulls_json represents the PostgreSQL function of the same name, taking the same parameters.
+var json_strip_nulls_json = framework.Function1{
+	Name:       "json_strip_nulls",
+	Return:     pgtypes.Json,
+	Parameters: []pgtypes.DoltgresType{pgtypes.Json},
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+		if val1 == nil {
+			return nil, nil
+		}
+		// TODO: make a bespoke implementation that preserves whitespace
+		newVal, err := pgtypes.JsonB.IoInput(val1.(string))
+		if err != nil {
+			return nil, err
+		}
+		retVal, err := jsonb_strip_nulls_jsonb.Callable(ctx, [2]pgtypes.DoltgresType{}, newVal)
+		if err != nil {
+			return nil, err
+		}
+		return pgtypes.JsonB.FormatValue(retVal)
+	},
+}
+
+// jsonb_strip_nulls_jsonb represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_strip_nulls_jsonb = framework.Function1{
+	Name:       "jsonb_strip_nulls",
+	Return:     pgtypes.JsonB,
+	Parameters: []pgtypes.DoltgresType{pgtypes.JsonB},
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+		if val1 == nil {
+			return nil, nil
+		}
+		return pgtypes.JsonDocument{Value: jsonbStripNulls(val1.(pgtypes.JsonDocument).Value)}, nil
+	},
+}
+
+// jsonbStripNulls returns a copy of the value with all object fields that have null values removed, recursing into
+// nested objects and arrays. Null values that are array elements are kept.
+func jsonbStripNulls(value pgtypes.JsonValue) pgtypes.JsonValue {
+	switch value := value.(type) {
+	case pgtypes.JsonValueObject:
+		newItems := make([]pgtypes.JsonValueObjectItem, 0, len(value.Items))
+		newIndex := make(map[string]int, len(value.Items))
+		for _, item := range value.Items {
+			if _, ok := item.Value.(pgtypes.JsonValueNull); ok {
+				continue
+			}
+			newIndex[item.Key] = len(newItems)
+			newItems = append(newItems, pgtypes.JsonValueObjectItem{Key: item.Key, Value: jsonbStripNulls(item.Value)})
+		}
+		return pgtypes.JsonValueObject{Items: newItems, Index: newIndex}
+	case pgtypes.JsonValueArray:
+		newArray := make(pgtypes.JsonValueArray, len(value))
+		for i := range value {
+			newArray[i] = jsonbStripNulls(value[i])
+		}
+		return newArray
+	default:
+		return value
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initJsonbToRecord registers the functions to the catalog.
+func initJsonbToRecord() {
+	framework.RegisterFunction(json_to_record_json)
+	framework.RegisterFunction(json_to_recordset_json)
+	framework.RegisterFunction(jsonb_to_record_jsonb)
+	framework.RegisterFunction(jsonb_to_recordset_jsonb)
+}
+
+// json_to_record_json represents the PostgreSQL function of the same name, taking the same parameters.
+var json_to_record_json = framework.RecordFunction{
+	FunctionInterface: framework.Function1{
+		Name:       "json_to_record",
+		Return:     pgtypes.Record,
+		Parameters: []pgtypes.DoltgresType{pgtypes.Json},
+		Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+			if val1 == nil {
+				return nil, nil
+			}
+			doc, err := pgtypes.JsonB.IoInput(val1.(string))
+			if err != nil {
+				return nil, err
+			}
+			return jsonbToRecordRows("json_to_record", doc.(pgtypes.JsonDocument).Value, false), nil
+		},
+	},
+}
+
+// json_to_recordset_json represents the PostgreSQL function of the same name, taking the same parameters.
+var json_to_recordset_json = framework.RecordFunction{
+	FunctionInterface: framework.Function1{
+		Name:       "json_to_recordset",
+		Return:     pgtypes.Record,
+		Parameters: []pgtypes.DoltgresType{pgtypes.Json},
+		Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+			if val1 == nil {
+				return nil, nil
+			}
+			doc, err := pgtypes.JsonB.IoInput(val1.(string))
+			if err != nil {
+				return nil, err
+			}
+			return jsonbToRecordRows("json_to_recordset", doc.(pgtypes.JsonDocument).Value, true), nil
+		},
+	},
+	ReturnsSet: true,
+}
+
+// jsonb_to_record_jsonb represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_to_record_jsonb = framework.RecordFunction{
+	FunctionInterface: framework.Function1{
+		Name:       "jsonb_to_record",
+		Return:     pgtypes.Record,
+		Parameters: []pgtypes.DoltgresType{pgtypes.JsonB},
+		Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+			if val1 == nil {
+				return nil, nil
+			}
+			return jsonbToRecordRows("jsonb_to_record", val1.(pgtypes.JsonDocument).Value, false), nil
+		},
+	},
+}
+
+// jsonb_to_recordset_jsonb represents the PostgreSQL function of the same name, taking the same parameters.
+var jsonb_to_recordset_jsonb = framework.RecordFunction{
+	FunctionInterface: framework.Function1{
+		Name:       "jsonb_to_recordset",
+		Return:     pgtypes.Record,
+		Parameters: []pgtypes.DoltgresType{pgtypes.JsonB},
+		Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+			if val1 == nil {
+				return nil, nil
+			}
+			return jsonbToRecordRows("jsonb_to_recordset", val1.(pgtypes.JsonDocument).Value, true), nil
+		},
+	},
+	ReturnsSet: true,
+}
+
+// jsonbToRecordRows returns the rows for the given value, which must be an object, or an array of objects when isSet
+// is true. The rows are built once the columns from the column definition list are known, as each column is matched to
+// the object field of the same name.
+func jsonbToRecordRows(functionName string, value pgtypes.JsonValue, isSet bool) framework.RecordRows {
+	return func(ctx *sql.Context, columns []framework.RecordColumn) ([][]any, error) {
+		if !isSet {
+			object, ok := value.(pgtypes.JsonValueObject)
+			if !ok {
+				return nil, fmt.Errorf("cannot call %s on a non-object", functionName)
+			}
+			row, err := jsonbObjectToRow(object, columns)
+			if err != nil {
+				return nil, err
+			}
+			return [][]any{row}, nil
+		}
+		array, ok := value.(pgtypes.JsonValueArray)
+		if !ok {
+			return nil, fmt.Errorf("cannot call %s on a non-array", functionName)
+		}
+		rows := make([][]any, len(array))
+		for i, element := range array {
+			object, ok := element.(pgtypes.JsonValueObject)
+			if !ok {
+				return nil, fmt.Errorf("argument of %s must be an array of objects", functionName)
+			}
+			row, err := jsonbObjectToRow(object, columns)
+			if err != nil {
+				return nil, err
+			}
+			rows[i] = row
+		}
+		return rows, nil
+	}
+}
+
+// jsonbObjectToRow converts the fields of the object into a row matching the given columns. Columns that do not have a
+// matching field are NULL.
+func jsonbObjectToRow(object pgtypes.JsonValueObject, columns []framework.RecordColumn) ([]any, error) {
+	row := make([]any, len(columns))
+	for i, column := range columns {
+		idx, ok := object.Index[column.Name]
+		if !ok {
+			continue
+		}
+		var err error
+		if row[i], err = jsonbValueToType(object.Items[idx].Value, column.Type); err != nil {
+			return nil, err
+		}
+	}
+	return row, nil
+}
+
+// jsonbValueToType converts the JSON value into a value of the given type. JSON arrays are converted element-wise for
+// array types, while all other values are converted from their text form.
+func jsonbValueToType(value pgtypes.JsonValue, typ pgtypes.DoltgresType) (any, error) {
+	if _, ok := value.(pgtypes.JsonValueNull); ok {
+		return nil, nil
+	}
+	switch typ.BaseID() {
+	case pgtypes.JsonB.BaseID():
+		return pgtypes.JsonDocument{Value: value}, nil
+	case pgtypes.Json.BaseID():
+		return pgtypes.JsonB.FormatValue(pgtypes.JsonDocument{Value: value})
+	}
+	if arrayType, ok := typ.(pgtypes.DoltgresArrayType); ok {
+		if array, ok := value.(pgtypes.JsonValueArray); ok {
+			values := make([]any, len(array))
+			for i, element := range array {
+				var err error
+				if values[i], err = jsonbValueToType(element, arrayType.BaseType()); err != nil {
+					return nil, err
+				}
+			}
+			return values, nil
+		}
+	}
+	text, err := jsonbValueAsText(value)
+	if err != nil {
+		return nil, err
+	}
+	return typ.IoInput(text.(string))
+}
@@ -0,0 +1,614 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonpath
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// predicateResult is the three-valued result of a predicate.
+type predicateResult uint8
+
+const (
+	predicateResult_False predicateResult = iota
+	predicateResult_True
+	predicateResult_Unknown
+)
+
+// evaluator holds the state needed to evaluate a path.
+type evaluator struct {
+	strict bool
+	root   pgtypes.JsonValue
+	vars   pgtypes.JsonValueObject
+	// missingVariable is set when a variable could not be found. This is always an error, even within a predicate that
+	// would otherwise treat errors as unknown.
+	missingVariable error
+}
+
+// eval evaluates the node, returning the sequence of items that it produced. The current item is the one referenced by
+// @, while last is the last index of the array being subscripted (which is -1 outside of subscripts).
+func (e *evaluator) eval(n node, current pgtypes.JsonValue, last int) ([]pgtypes.JsonValue, error) {
+	if n.isPredicate() {
+		// Predicates in a value context return their result as a boolean, with unknown represented as null
+		switch e.evalPredicate(n, current, last) {
+		case predicateResult_True:
+			return []pgtypes.JsonValue{pgtypes.JsonValueBoolean(true)}, nil
+		case predicateResult_False:
+			return []pgtypes.JsonValue{pgtypes.JsonValueBoolean(false)}, nil
+		default:
+			return []pgtypes.JsonValue{pgtypes.JsonValueNull(0)}, nil
+		}
+	}
+	switch n := n.(type) {
+	case rootNode:
+		return []pgtypes.JsonValue{e.root}, nil
+	case currentNode:
+		return []pgtypes.JsonValue{current}, nil
+	case variableNode:
+		idx, ok := e.vars.Index[n.name]
+		if !ok {
+			e.missingVariable = fmt.Errorf(`could not find jsonpath variable "%s"`, n.name)
+			return nil, e.missingVariable
+		}
+		return []pgtypes.JsonValue{e.vars.Items[idx].Value}, nil
+	case lastNode:
+		return []pgtypes.JsonValue{pgtypes.JsonValueNumber(decimal.NewFromInt(int64(last)))}, nil
+	case literalNode:
+		return []pgtypes.JsonValue{n.value}, nil
+	case memberNode:
+		return e.evalEach(n.base, current, last, true, func(item pgtypes.JsonValue) ([]pgtypes.JsonValue, error) {
+			object, ok := item.(pgtypes.JsonValueObject)
+			if !ok {
+				if e.strict {
+					return nil, fmt.Errorf("jsonpath member accessor can only be applied to an object")
+				}
+				return nil, nil
+			}
+			idx, ok := object.Index[n.key]
+			if !ok {
+				if e.strict {
+					return nil, fmt.Errorf(`JSON object does not contain key "%s"`, n.key)
+				}
+				return nil, nil
+			}
+			return []pgtypes.JsonValue{object.Items[idx].Value}, nil
+		})
+	case wildcardMemberNode:
+		return e.evalEach(n.base, current, last, true, func(item pgtypes.JsonValue) ([]pgtypes.JsonValue, error) {
+			object, ok := item.(pgtypes.JsonValueObject)
+			if !ok {
+				if e.strict {
+					return nil, fmt.Errorf("jsonpath wildcard member accessor can only be applied to an object")
+				}
+				return nil, nil
+			}
+			results := make([]pgtypes.JsonValue, len(object.Items))
+			for i, objectItem := range object.Items {
+				results[i] = objectItem.Value
+			}
+			return results, nil
+		})
+	case wildcardArrayNode:
+		return e.evalEach(n.base, current, last, false, func(item pgtypes.JsonValue) ([]pgtypes.JsonValue, error) {
+			array, ok := item.(pgtypes.JsonValueArray)
+			if !ok {
+				if e.strict {
+					return nil, fmt.Errorf("jsonpath wildcard array accessor can only be applied to an array")
+				}
+				return []pgtypes.JsonValue{item}, nil
+			}
+			return array, nil
+		})
+	case arrayNode:
+		return e.evalEach(n.base, current, last, false, func(item pgtypes.JsonValue) ([]pgtypes.JsonValue, error) {
+			return e.evalSubscripts(n, item, current)
+		})
+	case recursiveNode:
+		return e.evalEach(n.base, current, last, false, func(item pgtypes.JsonValue) ([]pgtypes.JsonValue, error) {
+			var results []pgtypes.JsonValue
+			e.collectRecursive(item, 0, n.from, n.to, &results)
+			return results, nil
+		})
+	case filterNode:
+		return e.evalEach(n.base, current, last, true, func(item pgtypes.JsonValue) ([]pgtypes.JsonValue, error) {
+			if e.evalPredicate(n.predicate, item, last) == predicateResult_True {
+				return []pgtypes.JsonValue{item}, nil
+			}
+			return nil, nil
+		})
+	case methodNode:
+		// The type and size methods operate on arrays directly, while the rest unwrap them
+		unwrap := n.method != "type" && n.method != "size"
+		return e.evalEach(n.base, current, last, unwrap, func(item pgtypes.JsonValue) ([]pgtypes.JsonValue, error) {
+			return e.evalMethod(n.method, item)
+		})
+	case unaryNode:
+		return e.evalEach(n.operand, current, last, true, func(item pgtypes.JsonValue) ([]pgtypes.JsonValue, error) {
+			number, ok := item.(pgtypes.JsonValueNumber)
+			if !ok {
+				return nil, fmt.Errorf("operand of unary jsonpath operator %s is not a numeric value", n.operator)
+			}
+			if n.operator == "-" {
+				return []pgtypes.JsonValue{pgtypes.JsonValueNumber(decimal.Decimal(number).Neg())}, nil
+			}
+			return []pgtypes.JsonValue{number}, nil
+		})
+	case arithmeticNode:
+		return e.evalArithmetic(n, current, last)
+	default:
+		return nil, fmt.Errorf("unknown jsonpath node: %T", n)
+	}
+}
+
+// evalEach evaluates the base node, and then calls the given function on each resulting item. In lax mode, arrays are
+// unwrapped before calling the function when unwrap is true.
+func (e *evaluator) evalEach(base node, current pgtypes.JsonValue, last int, unwrap bool,
+	f func(item pgtypes.JsonValue) ([]pgtypes.JsonValue, error)) ([]pgtypes.JsonValue, error) {
+	items, err := e.eval(base, current, last)
+	if err != nil {
+		return nil, err
+	}
+	if unwrap {
+		items = e.unwrap(items)
+	}
+	var results []pgtypes.JsonValue
+	for _, item := range items {
+		itemResults, err := f(item)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, itemResults...)
+	}
+	return results, nil
+}
+
+// unwrap replaces every array in the items with its elements when in lax mode.
+func (e *evaluator) unwrap(items []pgtypes.JsonValue) []pgtypes.JsonValue {
+	if e.strict {
+		return items
+	}
+	hasArray := false
+	for _, item := range items {
+		if _, ok := item.(pgtypes.JsonValueArray); ok {
+			hasArray = true
+			break
+		}
+	}
+	if !hasArray {
+		return items
+	}
+	var unwrapped []pgtypes.JsonValue
+	for _, item := range items {
+		if array, ok := item.(pgtypes.JsonValueArray); ok {
+			unwrapped = append(unwrapped, array...)
+		} else {
+			unwrapped = append(unwrapped, item)
+		}
+	}
+	return unwrapped
+}
+
+// evalSubscripts evaluates the subscripts of an array accessor against the given item.
+func (e *evaluator) evalSubscripts(n arrayNode, item pgtypes.JsonValue, current pgtypes.JsonValue) ([]pgtypes.JsonValue, error) {
+	array, ok := item.(pgtypes.JsonValueArray)
+	if !ok {
+		if e.strict {
+			return nil, fmt.Errorf("jsonpath array accessor can only be applied to an array")
+		}
+		// Lax mode treats non-arrays as though they were single-element arrays
+		array = pgtypes.JsonValueArray{item}
+	}
+	var results []pgtypes.JsonValue
+	for _, sub := range n.subscripts {
+		from, err := e.evalIndex(sub.from, current, len(array)-1)
+		if err != nil {
+			return nil, err
+		}
+		to := from
+		if sub.to != nil {
+			to, err = e.evalIndex(sub.to, current, len(array)-1)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if e.strict && (from < 0 || from > to || to >= len(array)) {
+			return nil, fmt.Errorf("jsonpath array subscript is out of bounds")
+		}
+		if from < 0 {
+			from = 0
+		}
+		if to >= len(array) {
+			to = len(array) - 1
+		}
+		for i := from; i <= to; i++ {
+			results = append(results, array[i])
+		}
+	}
+	return results, nil
+}
+
+// evalIndex evaluates a single array subscript, which must produce a single numeric value.
+func (e *evaluator) evalIndex(n node, current pgtypes.JsonValue, last int) (int, error) {
+	items, err := e.eval(n, current, last)
+	if err != nil {
+		return 0, err
+	}
+	if len(items) != 1 {
+		return 0, fmt.Errorf("jsonpath array subscript is not a single numeric value")
+	}
+	number, ok := items[0].(pgtypes.JsonValueNumber)
+	if !ok {
+		return 0, fmt.Errorf("jsonpath array subscript is not a single numeric value")
+	}
+	return int(decimal.Decimal(number).Truncate(0).IntPart()), nil
+}
+
+// collectRecursive appends the item and all of its descendants that are within the given levels to the results. A "to"
+// level of -1 represents the last level.
+func (e *evaluator) collectRecursive(item pgtypes.JsonValue, level int, from int, to int, results *[]pgtypes.JsonValue) {
+	if to != -1 && level > to {
+		return
+	}
+	if level >= from {
+		*results = append(*results, item)
+	}
+	switch item := item.(type) {
+	case pgtypes.JsonValueObject:
+		for _, objectItem := range item.Items {
+			e.collectRecursive(objectItem.Value, level+1, from, to, results)
+		}
+	case pgtypes.JsonValueArray:
+		for _, element := range item {
+			e.collectRecursive(element, level+1, from, to, results)
+		}
+	}
+}
+
+// evalMethod evaluates the item method with the given name against the item.
+func (e *evaluator) evalMethod(method string, item pgtypes.JsonValue) ([]pgtypes.JsonValue, error) {
+	switch method {
+	case "type":
+		return []pgtypes.JsonValue{pgtypes.JsonValueString(typeName(item))}, nil
+	case "size":
+		array, ok := item.(pgtypes.JsonValueArray)
+		if !ok {
+			if e.strict {
+				return nil, fmt.Errorf("jsonpath item method .size() can only be applied to an array")
+			}
+			return []pgtypes.JsonValue{pgtypes.JsonValueNumber(decimal.NewFromInt(1))}, nil
+		}
+		return []pgtypes.JsonValue{pgtypes.JsonValueNumber(decimal.NewFromInt(int64(len(array))))}, nil
+	case "double":
+		switch item := item.(type) {
+		case pgtypes.JsonValueNumber:
+			return []pgtypes.JsonValue{item}, nil
+		case pgtypes.JsonValueString:
+			number, err := decimal.NewFromString(strings.TrimSpace(string(item)))
+			if err != nil {
+				return nil, fmt.Errorf("string argument of jsonpath item method .double() is not a valid representation of a double precision number")
+			}
+			return []pgtypes.JsonValue{pgtypes.JsonValueNumber(number)}, nil
+		default:
+			return nil, fmt.Errorf("jsonpath item method .double() can only be applied to a string or numeric value")
+		}
+	case "abs", "floor", "ceiling":
+		number, ok := item.(pgtypes.JsonValueNumber)
+		if !ok {
+			return nil, fmt.Errorf("jsonpath item method .%s() can only be applied to a numeric value", method)
+		}
+		d := decimal.Decimal(number)
+		switch method {
+		case "abs":
+			d = d.Abs()
+		case "floor":
+			d = d.Floor()
+		case "ceiling":
+			d = d.Ceil()
+		}
+		return []pgtypes.JsonValue{pgtypes.JsonValueNumber(d)}, nil
+	case "keyvalue":
+		object, ok := item.(pgtypes.JsonValueObject)
+		if !ok {
+			return nil, fmt.Errorf("jsonpath item method .keyvalue() can only be applied to an object")
+		}
+		results := make([]pgtypes.JsonValue, len(object.Items))
+		for i, objectItem := range object.Items {
+			// TODO: Postgres sets the id to the object's offset within the document, which we do not track
+			results[i] = pgtypes.JsonValueObject{
+				Items: []pgtypes.JsonValueObjectItem{
+					{Key: "id", Value: pgtypes.JsonValueNumber(decimal.Zero)},
+					{Key: "key", Value: pgtypes.JsonValueString(objectItem.Key)},
+					{Key: "value", Value: objectItem.Value},
+				},
+				Index: map[string]int{"id": 0, "key": 1, "value": 2},
+			}
+		}
+		return results, nil
+	default:
+		return nil, fmt.Errorf(`jsonpath item method .%s() is not yet supported`, method)
+	}
+}
+
+// evalArithmetic evaluates a binary arithmetic operation, which requires each operand to be a single numeric value.
+func (e *evaluator) evalArithmetic(n arithmeticNode, current pgtypes.JsonValue, last int) ([]pgtypes.JsonValue, error) {
+	leftItems, err := e.eval(n.left, current, last)
+	if err != nil {
+		return nil, err
+	}
+	leftItems = e.unwrap(leftItems)
+	if len(leftItems) != 1 {
+		return nil, fmt.Errorf("left operand of jsonpath operator %s is not a single numeric value", n.operator)
+	}
+	left, ok := leftItems[0].(pgtypes.JsonValueNumber)
+	if !ok {
+		return nil, fmt.Errorf("left operand of jsonpath operator %s is not a single numeric value", n.operator)
+	}
+	rightItems, err := e.eval(n.right, current, last)
+	if err != nil {
+		return nil, err
+	}
+	rightItems = e.unwrap(rightItems)
+	if len(rightItems) != 1 {
+		return nil, fmt.Errorf("right operand of jsonpath operator %s is not a single numeric value", n.operator)
+	}
+	right, ok := rightItems[0].(pgtypes.JsonValueNumber)
+	if !ok {
+		return nil, fmt.Errorf("right operand of jsonpath operator %s is not a single numeric value", n.operator)
+	}
+	l, r := decimal.Decimal(left), decimal.Decimal(right)
+	var result decimal.Decimal
+	switch n.operator {
+	case "+":
+		result = l.Add(r)
+	case "-":
+		result = l.Sub(r)
+	case "*":
+		result = l.Mul(r)
+	case "/", "%":
+		if r.IsZero() {
+			return nil, fmt.Errorf("division by zero")
+		}
+		if n.operator == "/" {
+			result = l.Div(r)
+		} else {
+			result = l.Mod(r)
+		}
+	default:
+		return nil, fmt.Errorf("unknown jsonpath operator: %s", n.operator)
+	}
+	return []pgtypes.JsonValue{pgtypes.JsonValueNumber(result)}, nil
+}
+
+// evalPredicate evaluates a predicate. Errors that occur while evaluating the operands of a predicate cause the
+// predicate to be unknown.
+func (e *evaluator) evalPredicate(n node, current pgtypes.JsonValue, last int) predicateResult {
+	switch n := n.(type) {
+	case andNode:
+		left := e.evalPredicate(n.left, current, last)
+		if left == predicateResult_False {
+			return predicateResult_False
+		}
+		right := e.evalPredicate(n.right, current, last)
+		if right == predicateResult_True {
+			return left
+		}
+		return right
+	case orNode:
+		left := e.evalPredicate(n.left, current, last)
+		if left == predicateResult_True {
+			return predicateResult_True
+		}
+		right := e.evalPredicate(n.right, current, last)
+		if right == predicateResult_False {
+			return left
+		}
+		return right
+	case notNode:
+		switch e.evalPredicate(n.predicate, current, last) {
+		case predicateResult_True:
+			return predicateResult_False
+		case predicateResult_False:
+			return predicateResult_True
+		default:
+			return predicateResult_Unknown
+		}
+	case isUnknownNode:
+		if e.evalPredicate(n.predicate, current, last) == predicateResult_Unknown {
+			return predicateResult_True
+		}
+		return predicateResult_False
+	case existsNode:
+		items, err := e.eval(n.path, current, last)
+		if err != nil {
+			return predicateResult_Unknown
+		}
+		if len(items) > 0 {
+			return predicateResult_True
+		}
+		return predicateResult_False
+	case comparisonNode:
+		leftItems, err := e.eval(n.left, current, last)
+		if err != nil {
+			return predicateResult_Unknown
+		}
+		rightItems, err := e.eval(n.right, current, last)
+		if err != nil {
+			return predicateResult_Unknown
+		}
+		return e.anyPair(e.unwrap(leftItems), e.unwrap(rightItems), func(left pgtypes.JsonValue, right pgtypes.JsonValue) predicateResult {
+			return compareItems(n.operator, left, right)
+		})
+	case likeRegexNode:
+		items, err := e.eval(n.operand, current, last)
+		if err != nil {
+			return predicateResult_Unknown
+		}
+		return e.anyPair(e.unwrap(items), []pgtypes.JsonValue{nil}, func(item pgtypes.JsonValue, _ pgtypes.JsonValue) predicateResult {
+			str, ok := item.(pgtypes.JsonValueString)
+			if !ok {
+				return predicateResult_Unknown
+			}
+			if n.pattern.MatchString(string(str)) {
+				return predicateResult_True
+			}
+			return predicateResult_False
+		})
+	case startsWithNode:
+		items, err := e.eval(n.operand, current, last)
+		if err != nil {
+			return predicateResult_Unknown
+		}
+		prefixItems, err := e.eval(n.prefix, current, last)
+		if err != nil || len(prefixItems) != 1 {
+			return predicateResult_Unknown
+		}
+		prefix, ok := prefixItems[0].(pgtypes.JsonValueString)
+		if !ok {
+			return predicateResult_Unknown
+		}
+		return e.anyPair(e.unwrap(items), []pgtypes.JsonValue{prefix}, func(item pgtypes.JsonValue, _ pgtypes.JsonValue) predicateResult {
+			str, ok := item.(pgtypes.JsonValueString)
+			if !ok {
+				return predicateResult_Unknown
+			}
+			if strings.HasPrefix(string(str), string(prefix)) {
+				return predicateResult_True
+			}
+			return predicateResult_False
+		})
+	default:
+		return predicateResult_Unknown
+	}
+}
+
+// anyPair returns true if the function returns true for any pair of items. In lax mode, the first true result is
+// returned immediately, while strict mode requires that every pair is checked, as any unknown pair makes the entire
+// result unknown.
+func (e *evaluator) anyPair(leftItems []pgtypes.JsonValue, rightItems []pgtypes.JsonValue,
+	f func(left pgtypes.JsonValue, right pgtypes.JsonValue) predicateResult) predicateResult {
+	found := false
+	unknown := false
+	for _, left := range leftItems {
+		for _, right := range rightItems {
+			switch f(left, right) {
+			case predicateResult_True:
+				if !e.strict {
+					return predicateResult_True
+				}
+				found = true
+			case predicateResult_Unknown:
+				if e.strict {
+					return predicateResult_Unknown
+				}
+				unknown = true
+			}
+		}
+	}
+	if found {
+		return predicateResult_True
+	}
+	if unknown {
+		return predicateResult_Unknown
+	}
+	return predicateResult_False
+}
+
+// compareItems compares two scalar items using the given operator. Comparing a null to a non-null is always false
+// (except for inequality), while comparing containers or items of differing types is unknown.
+func compareItems(operator string, left pgtypes.JsonValue, right pgtypes.JsonValue) predicateResult {
+	_, leftIsNull := left.(pgtypes.JsonValueNull)
+	_, rightIsNull := right.(pgtypes.JsonValueNull)
+	var cmp int
+	switch {
+	case leftIsNull && rightIsNull:
+		cmp = 0
+	case leftIsNull || rightIsNull:
+		if operator == "!=" {
+			return predicateResult_True
+		}
+		return predicateResult_False
+	default:
+		switch left := left.(type) {
+		case pgtypes.JsonValueBoolean:
+			right, ok := right.(pgtypes.JsonValueBoolean)
+			if !ok {
+				return predicateResult_Unknown
+			}
+			if left == right {
+				cmp = 0
+			} else if left {
+				cmp = 1
+			} else {
+				cmp = -1
+			}
+		case pgtypes.JsonValueNumber:
+			right, ok := right.(pgtypes.JsonValueNumber)
+			if !ok {
+				return predicateResult_Unknown
+			}
+			cmp = decimal.Decimal(left).Cmp(decimal.Decimal(right))
+		case pgtypes.JsonValueString:
+			right, ok := right.(pgtypes.JsonValueString)
+			if !ok {
+				return predicateResult_Unknown
+			}
+			cmp = strings.Compare(string(left), string(right))
+		default:
+			return predicateResult_Unknown
+		}
+	}
+	var result bool
+	switch operator {
+	case "==":
+		result = cmp == 0
+	case "!=":
+		result = cmp != 0
+	case "<":
+		result = cmp < 0
+	case "<=":
+		result = cmp <= 0
+	case ">":
+		result = cmp > 0
+	case ">=":
+		result = cmp >= 0
+	}
+	if result {
+		return predicateResult_True
+	}
+	return predicateResult_False
+}
+
+// typeName returns the name of the item's type, as returned by the type() item method.
+func typeName(item pgtypes.JsonValue) string {
+	switch item.(type) {
+	case pgtypes.JsonValueObject:
+		return "object"
+	case pgtypes.JsonValueArray:
+		return "array"
+	case pgtypes.JsonValueString:
+		return "string"
+	case pgtypes.JsonValueNumber:
+		return "number"
+	case pgtypes.JsonValueBoolean:
+		return "boolean"
+	default:
+		return "null"
+	}
+}
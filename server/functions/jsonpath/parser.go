@@ -0,0 +1,699 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonpath
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/shopspring/decimal"
+
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// itemMethods contains all of the item methods that are supported.
+var itemMethods = map[string]struct{}{
+	"abs":      {},
+	"ceiling":  {},
+	"double":   {},
+	"floor":    {},
+	"keyvalue": {},
+	"size":     {},
+	"type":     {},
+}
+
+// parser is a recursive descent parser for the SQL/JSON path language.
+type parser struct {
+	input          string
+	pos            int
+	filterDepth    int
+	subscriptDepth int
+}
+
+// Parse parses the given string into a Path.
+func Parse(input string) (*Path, error) {
+	p := &parser{input: input}
+	path := &Path{}
+	p.skipWhitespace()
+	if p.consumeKeyword("strict") {
+		path.strict = true
+	} else {
+		p.consumeKeyword("lax")
+	}
+	root, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	p.skipWhitespace()
+	if p.pos < len(p.input) {
+		return nil, p.syntaxError()
+	}
+	path.root = root
+	return path, nil
+}
+
+// parseExpression parses an expression, which has the lowest precedence.
+func (p *parser) parseExpression() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.consumeSymbol("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		if !left.isPredicate() || !right.isPredicate() {
+			return nil, p.syntaxError()
+		}
+		left = orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseAnd parses the && predicate.
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.consumeSymbol("&&") {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		if !left.isPredicate() || !right.isPredicate() {
+			return nil, p.syntaxError()
+		}
+		left = andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseNot parses the ! predicate, which must be followed by a parenthesized predicate.
+func (p *parser) parseNot() (node, error) {
+	p.skipWhitespace()
+	if p.peek() == '!' && !strings.HasPrefix(p.input[p.pos:], "!=") {
+		p.pos++
+		if !p.consumeSymbol("(") {
+			return nil, p.syntaxError()
+		}
+		predicate, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if !predicate.isPredicate() || !p.consumeSymbol(")") {
+			return nil, p.syntaxError()
+		}
+		return notNode{predicate: predicate}, nil
+	}
+	return p.parsePredicate()
+}
+
+// parsePredicate parses the comparison, like_regex, starts with, and exists predicates.
+func (p *parser) parsePredicate() (node, error) {
+	p.skipWhitespace()
+	if p.consumeKeyword("exists") {
+		if !p.consumeSymbol("(") {
+			return nil, p.syntaxError()
+		}
+		path, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if path.isPredicate() || !p.consumeSymbol(")") {
+			return nil, p.syntaxError()
+		}
+		return existsNode{path: path}, nil
+	}
+	left, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	p.skipWhitespace()
+	for _, operator := range []string{"==", "!=", "<>", "<=", ">=", "<", ">"} {
+		if p.consumeSymbol(operator) {
+			right, err := p.parseAdditive()
+			if err != nil {
+				return nil, err
+			}
+			if left.isPredicate() || right.isPredicate() {
+				return nil, p.syntaxError()
+			}
+			if operator == "<>" {
+				operator = "!="
+			}
+			return comparisonNode{operator: operator, left: left, right: right}, nil
+		}
+	}
+	if p.consumeKeyword("like_regex") {
+		return p.parseLikeRegex(left)
+	}
+	if p.consumeKeyword("starts") {
+		if !p.consumeKeyword("with") {
+			return nil, p.syntaxError()
+		}
+		p.skipWhitespace()
+		var prefix node
+		if p.peek() == '"' {
+			str, err := p.parseString()
+			if err != nil {
+				return nil, err
+			}
+			prefix = literalNode{value: pgtypes.JsonValueString(str)}
+		} else if p.peek() == '$' {
+			prefix, err = p.parsePrimary()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := prefix.(variableNode); !ok {
+				return nil, p.syntaxError()
+			}
+		} else {
+			return nil, p.syntaxError()
+		}
+		return startsWithNode{operand: left, prefix: prefix}, nil
+	}
+	return left, nil
+}
+
+// parseLikeRegex parses the pattern and flags of a like_regex predicate.
+func (p *parser) parseLikeRegex(operand node) (node, error) {
+	p.skipWhitespace()
+	if p.peek() != '"' {
+		return nil, p.syntaxError()
+	}
+	pattern, err := p.parseString()
+	if err != nil {
+		return nil, err
+	}
+	flags := ""
+	if p.consumeKeyword("flag") {
+		p.skipWhitespace()
+		if p.peek() != '"' {
+			return nil, p.syntaxError()
+		}
+		flags, err = p.parseString()
+		if err != nil {
+			return nil, err
+		}
+	}
+	goFlags := ""
+	for _, flag := range flags {
+		switch flag {
+		case 'i':
+			goFlags += "i"
+		case 's':
+			goFlags += "s"
+		case 'm':
+			goFlags += "m"
+		case 'q':
+			pattern = regexp.QuoteMeta(pattern)
+		case 'x':
+			return nil, fmt.Errorf(`XQuery "x" flag (expanded regular expressions) is not implemented`)
+		default:
+			return nil, fmt.Errorf(`invalid input syntax for type jsonpath`)
+		}
+	}
+	if len(goFlags) > 0 {
+		pattern = "(?" + goFlags + ")" + pattern
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression: %s", err.Error())
+	}
+	return likeRegexNode{operand: operand, pattern: re}, nil
+}
+
+// parseAdditive parses the + and - arithmetic operators.
+func (p *parser) parseAdditive() (node, error) {
+	left, err := p.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p.skipWhitespace()
+		operator := p.peek()
+		if operator != '+' && operator != '-' {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+		if left.isPredicate() || right.isPredicate() {
+			return nil, p.syntaxError()
+		}
+		left = arithmeticNode{operator: string(operator), left: left, right: right}
+	}
+}
+
+// parseMultiplicative parses the *, /, and % arithmetic operators.
+func (p *parser) parseMultiplicative() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p.skipWhitespace()
+		operator := p.peek()
+		if operator != '*' && operator != '/' && operator != '%' {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if left.isPredicate() || right.isPredicate() {
+			return nil, p.syntaxError()
+		}
+		left = arithmeticNode{operator: string(operator), left: left, right: right}
+	}
+}
+
+// parseUnary parses the unary + and - operators.
+func (p *parser) parseUnary() (node, error) {
+	p.skipWhitespace()
+	operator := p.peek()
+	if operator == '+' || operator == '-' {
+		p.pos++
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if operand.isPredicate() {
+			return nil, p.syntaxError()
+		}
+		// Numeric literals are folded, so that negative subscripts and comparisons remain simple
+		if literal, ok := operand.(literalNode); ok {
+			if number, ok := literal.value.(pgtypes.JsonValueNumber); ok {
+				if operator == '-' {
+					return literalNode{value: pgtypes.JsonValueNumber(decimal.Decimal(number).Neg())}, nil
+				}
+				return literal, nil
+			}
+		}
+		return unaryNode{operator: string(operator), operand: operand}, nil
+	}
+	return p.parseAccessors()
+}
+
+// parseAccessors parses a primary expression, followed by any number of accessors, filters, and item methods.
+func (p *parser) parseAccessors() (node, error) {
+	base, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p.skipWhitespace()
+		switch p.peek() {
+		case '.':
+			p.pos++
+			base, err = p.parseDotAccessor(base)
+			if err != nil {
+				return nil, err
+			}
+		case '[':
+			p.pos++
+			base, err = p.parseArrayAccessor(base)
+			if err != nil {
+				return nil, err
+			}
+		case '?':
+			p.pos++
+			if !p.consumeSymbol("(") {
+				return nil, p.syntaxError()
+			}
+			p.filterDepth++
+			predicate, err := p.parseExpression()
+			p.filterDepth--
+			if err != nil {
+				return nil, err
+			}
+			if !predicate.isPredicate() || !p.consumeSymbol(")") {
+				return nil, p.syntaxError()
+			}
+			base = filterNode{base: base, predicate: predicate}
+		default:
+			return base, nil
+		}
+		if base.isPredicate() {
+			return nil, p.syntaxError()
+		}
+	}
+}
+
+// parseDotAccessor parses everything that follows a period, which may be a member accessor or an item method.
+func (p *parser) parseDotAccessor(base node) (node, error) {
+	p.skipWhitespace()
+	switch p.peek() {
+	case '*':
+		p.pos++
+		if p.peek() != '*' {
+			return wildcardMemberNode{base: base}, nil
+		}
+		p.pos++
+		from, to := 0, -1
+		if p.consumeSymbol("{") {
+			var err error
+			from, err = p.parseLevel()
+			if err != nil {
+				return nil, err
+			}
+			to = from
+			if p.consumeKeyword("to") {
+				to, err = p.parseLevel()
+				if err != nil {
+					return nil, err
+				}
+			}
+			if !p.consumeSymbol("}") {
+				return nil, p.syntaxError()
+			}
+		}
+		return recursiveNode{base: base, from: from, to: to}, nil
+	case '"':
+		key, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+		return memberNode{base: base, key: key}, nil
+	}
+	key := p.parseIdentifier()
+	if len(key) == 0 {
+		return nil, p.syntaxError()
+	}
+	if p.consumeSymbol("(") {
+		if _, ok := itemMethods[key]; !ok {
+			return nil, fmt.Errorf(`jsonpath item method .%s() is not yet supported`, key)
+		}
+		if !p.consumeSymbol(")") {
+			return nil, p.syntaxError()
+		}
+		return methodNode{base: base, method: key}, nil
+	}
+	return memberNode{base: base, key: key}, nil
+}
+
+// parseLevel parses a single level of the recursive wildcard member accessor.
+func (p *parser) parseLevel() (int, error) {
+	if p.consumeKeyword("last") {
+		return -1, nil
+	}
+	p.skipWhitespace()
+	start := p.pos
+	for p.pos < len(p.input) && p.input[p.pos] >= '0' && p.input[p.pos] <= '9' {
+		p.pos++
+	}
+	if start == p.pos {
+		return 0, p.syntaxError()
+	}
+	level, err := strconv.Atoi(p.input[start:p.pos])
+	if err != nil {
+		return 0, p.syntaxError()
+	}
+	return level, nil
+}
+
+// parseArrayAccessor parses everything that follows an opening bracket.
+func (p *parser) parseArrayAccessor(base node) (node, error) {
+	if p.consumeSymbol("*") {
+		if !p.consumeSymbol("]") {
+			return nil, p.syntaxError()
+		}
+		return wildcardArrayNode{base: base}, nil
+	}
+	p.subscriptDepth++
+	defer func() { p.subscriptDepth-- }()
+	var subscripts []subscript
+	for {
+		from, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		sub := subscript{from: from}
+		if p.consumeKeyword("to") {
+			sub.to, err = p.parseAdditive()
+			if err != nil {
+				return nil, err
+			}
+		}
+		subscripts = append(subscripts, sub)
+		if p.consumeSymbol("]") {
+			return arrayNode{base: base, subscripts: subscripts}, nil
+		}
+		if !p.consumeSymbol(",") {
+			return nil, p.syntaxError()
+		}
+	}
+}
+
+// parsePrimary parses the root, the current item, variables, literals, and parenthesized expressions.
+func (p *parser) parsePrimary() (node, error) {
+	p.skipWhitespace()
+	if p.pos >= len(p.input) {
+		return nil, p.syntaxError()
+	}
+	switch c := p.input[p.pos]; {
+	case c == '$':
+		p.pos++
+		if p.peek() == '"' {
+			name, err := p.parseString()
+			if err != nil {
+				return nil, err
+			}
+			return variableNode{name: name}, nil
+		}
+		if name := p.parseIdentifier(); len(name) > 0 {
+			return variableNode{name: name}, nil
+		}
+		return rootNode{}, nil
+	case c == '@':
+		if p.filterDepth == 0 {
+			return nil, fmt.Errorf("@ is not allowed in root expressions")
+		}
+		p.pos++
+		return currentNode{}, nil
+	case c == '"':
+		str, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+		return literalNode{value: pgtypes.JsonValueString(str)}, nil
+	case c == '(':
+		p.pos++
+		expr, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if !p.consumeSymbol(")") {
+			return nil, p.syntaxError()
+		}
+		if expr.isPredicate() && p.consumeKeyword("is") {
+			if !p.consumeKeyword("unknown") {
+				return nil, p.syntaxError()
+			}
+			return isUnknownNode{predicate: expr}, nil
+		}
+		return expr, nil
+	case (c >= '0' && c <= '9') || c == '.':
+		return p.parseNumber()
+	}
+	switch {
+	case p.consumeKeyword("true"):
+		return literalNode{value: pgtypes.JsonValueBoolean(true)}, nil
+	case p.consumeKeyword("false"):
+		return literalNode{value: pgtypes.JsonValueBoolean(false)}, nil
+	case p.consumeKeyword("null"):
+		return literalNode{value: pgtypes.JsonValueNull(0)}, nil
+	case p.consumeKeyword("last"):
+		if p.subscriptDepth == 0 {
+			return nil, fmt.Errorf("LAST is allowed only in array subscripts")
+		}
+		return lastNode{}, nil
+	}
+	return nil, p.syntaxError()
+}
+
+// parseNumber parses a numeric literal.
+func (p *parser) parseNumber() (node, error) {
+	start := p.pos
+	p.consumeDigits()
+	// A period is only part of the number when followed by a digit, as it may otherwise be an accessor
+	if p.peek() == '.' && p.pos+1 < len(p.input) && p.input[p.pos+1] >= '0' && p.input[p.pos+1] <= '9' {
+		p.pos++
+		p.consumeDigits()
+	}
+	if start == p.pos {
+		return nil, p.syntaxError()
+	}
+	if c := p.peek(); c == 'e' || c == 'E' {
+		p.pos++
+		if c = p.peek(); c == '+' || c == '-' {
+			p.pos++
+		}
+		if digitStart := p.pos; p.consumeDigits() == digitStart {
+			return nil, p.syntaxError()
+		}
+	}
+	number, err := decimal.NewFromString(p.input[start:p.pos])
+	if err != nil {
+		return nil, p.syntaxError()
+	}
+	return literalNode{value: pgtypes.JsonValueNumber(number)}, nil
+}
+
+// consumeDigits advances past any digits, returning the new position.
+func (p *parser) consumeDigits() int {
+	for p.pos < len(p.input) && p.input[p.pos] >= '0' && p.input[p.pos] <= '9' {
+		p.pos++
+	}
+	return p.pos
+}
+
+// parseString parses a double-quoted string, handling the same escape sequences as JSON.
+func (p *parser) parseString() (string, error) {
+	if p.peek() != '"' {
+		return "", p.syntaxError()
+	}
+	p.pos++
+	sb := strings.Builder{}
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		p.pos++
+		switch c {
+		case '"':
+			return sb.String(), nil
+		case '\\':
+			if p.pos >= len(p.input) {
+				return "", p.syntaxError()
+			}
+			escaped := p.input[p.pos]
+			p.pos++
+			switch escaped {
+			case 'b':
+				sb.WriteByte('\b')
+			case 'f':
+				sb.WriteByte('\f')
+			case 'n':
+				sb.WriteByte('\n')
+			case 'r':
+				sb.WriteByte('\r')
+			case 't':
+				sb.WriteByte('\t')
+			case 'v':
+				sb.WriteByte('\v')
+			case 'u':
+				if p.pos+4 > len(p.input) {
+					return "", p.syntaxError()
+				}
+				r, err := strconv.ParseUint(p.input[p.pos:p.pos+4], 16, 32)
+				if err != nil {
+					return "", p.syntaxError()
+				}
+				p.pos += 4
+				sb.WriteRune(rune(r))
+			default:
+				sb.WriteByte(escaped)
+			}
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return "", fmt.Errorf(`syntax error at end of jsonpath input`)
+}
+
+// parseIdentifier parses an unquoted key or variable name, returning an empty string if one is not present.
+func (p *parser) parseIdentifier() string {
+	start := p.pos
+	for p.pos < len(p.input) {
+		r, size := utf8.DecodeRuneInString(p.input[p.pos:])
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			break
+		}
+		p.pos += size
+	}
+	return p.input[start:p.pos]
+}
+
+// consumeKeyword advances past the given keyword if it is next in the input, returning whether it was found. The
+// keyword must not be immediately followed by characters that would make it part of a longer identifier.
+func (p *parser) consumeKeyword(keyword string) bool {
+	p.skipWhitespace()
+	if !strings.HasPrefix(p.input[p.pos:], keyword) {
+		return false
+	}
+	if end := p.pos + len(keyword); end < len(p.input) {
+		r, _ := utf8.DecodeRuneInString(p.input[end:])
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return false
+		}
+	}
+	p.pos += len(keyword)
+	return true
+}
+
+// consumeSymbol advances past the given symbol if it is next in the input, returning whether it was found.
+func (p *parser) consumeSymbol(symbol string) bool {
+	p.skipWhitespace()
+	if strings.HasPrefix(p.input[p.pos:], symbol) {
+		p.pos += len(symbol)
+		return true
+	}
+	return false
+}
+
+// peek returns the next byte without advancing, or zero if at the end of the input.
+func (p *parser) peek() byte {
+	if p.pos < len(p.input) {
+		return p.input[p.pos]
+	}
+	return 0
+}
+
+// skipWhitespace advances past any whitespace.
+func (p *parser) skipWhitespace() {
+	for p.pos < len(p.input) {
+		switch p.input[p.pos] {
+		case ' ', '\t', '\n', '\r', '\f':
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+// syntaxError returns an error for the input at the current position.
+func (p *parser) syntaxError() error {
+	p.skipWhitespace()
+	if p.pos >= len(p.input) {
+		return fmt.Errorf(`syntax error at end of jsonpath input`)
+	}
+	end := p.pos + 1
+	for end < len(p.input) {
+		r, _ := utf8.DecodeRuneInString(p.input[end:])
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			break
+		}
+		end++
+	}
+	return fmt.Errorf(`syntax error at or near "%s" of jsonpath input`, p.input[p.pos:end])
+}
@@ -0,0 +1,223 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonpath
+
+import (
+	"fmt"
+	"regexp"
+
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// Path is a parsed SQL/JSON path expression.
+type Path struct {
+	strict bool
+	root   node
+}
+
+// Evaluate parses the path and evaluates it against the target, returning every item that the path produced. The vars
+// may be nil, otherwise it must be an object that contains the values of the path's variables. When silent is true,
+// errors that occur during evaluation are suppressed, which is signaled by returning false. Errors in the syntax of the
+// path are never suppressed.
+func Evaluate(target pgtypes.JsonValue, path string, vars pgtypes.JsonValue, silent bool) ([]pgtypes.JsonValue, bool, error) {
+	parsedPath, err := Parse(path)
+	if err != nil {
+		return nil, false, err
+	}
+	results, err := parsedPath.Query(target, vars)
+	if err != nil {
+		if silent {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	return results, true, nil
+}
+
+// Query evaluates the path against the target, returning every item that the path produced. The vars may be nil,
+// otherwise it must be an object that contains the values of the path's variables.
+func (p *Path) Query(target pgtypes.JsonValue, vars pgtypes.JsonValue) ([]pgtypes.JsonValue, error) {
+	e := &evaluator{
+		strict: p.strict,
+		root:   target,
+	}
+	if vars != nil {
+		varsObject, ok := vars.(pgtypes.JsonValueObject)
+		if !ok {
+			return nil, fmt.Errorf(`"vars" argument is not an object`)
+		}
+		e.vars = varsObject
+	}
+	results, err := e.eval(p.root, nil, -1)
+	if e.missingVariable != nil {
+		return nil, e.missingVariable
+	}
+	return results, err
+}
+
+// node is an element of a parsed path.
+type node interface {
+	// isPredicate returns whether the node evaluates to a boolean condition rather than a sequence of items.
+	isPredicate() bool
+}
+
+// rootNode represents the root item, which is written as $.
+type rootNode struct{}
+
+// currentNode represents the item currently being filtered, which is written as @.
+type currentNode struct{}
+
+// variableNode represents a named variable, which is written as $name.
+type variableNode struct {
+	name string
+}
+
+// lastNode represents the last index of the array that is being subscripted.
+type lastNode struct{}
+
+// literalNode represents a string, numeric, boolean, or null literal.
+type literalNode struct {
+	value pgtypes.JsonValue
+}
+
+// memberNode represents a member accessor, such as .key or ."key".
+type memberNode struct {
+	base node
+	key  string
+}
+
+// wildcardMemberNode represents the wildcard member accessor, which is written as .*.
+type wildcardMemberNode struct {
+	base node
+}
+
+// subscript is a single subscript of an array accessor, which may be a range when "to" is not nil.
+type subscript struct {
+	from node
+	to   node
+}
+
+// arrayNode represents an array accessor, such as [1], [1 to 3], or [last].
+type arrayNode struct {
+	base       node
+	subscripts []subscript
+}
+
+// wildcardArrayNode represents the wildcard array accessor, which is written as [*].
+type wildcardArrayNode struct {
+	base node
+}
+
+// recursiveNode represents the recursive wildcard member accessor, which is written as .** and may specify levels.
+// A level of -1 represents the last level.
+type recursiveNode struct {
+	base node
+	from int
+	to   int
+}
+
+// filterNode represents a filter expression, which is written as ?(predicate).
+type filterNode struct {
+	base      node
+	predicate node
+}
+
+// methodNode represents an item method, such as .size().
+type methodNode struct {
+	base   node
+	method string
+}
+
+// arithmeticNode represents a binary arithmetic operation.
+type arithmeticNode struct {
+	operator string
+	left     node
+	right    node
+}
+
+// unaryNode represents a unary plus or minus.
+type unaryNode struct {
+	operator string
+	operand  node
+}
+
+// comparisonNode represents a comparison predicate.
+type comparisonNode struct {
+	operator string
+	left     node
+	right    node
+}
+
+// andNode represents the && predicate.
+type andNode struct {
+	left  node
+	right node
+}
+
+// orNode represents the || predicate.
+type orNode struct {
+	left  node
+	right node
+}
+
+// notNode represents the ! predicate.
+type notNode struct {
+	predicate node
+}
+
+// isUnknownNode represents the "is unknown" predicate.
+type isUnknownNode struct {
+	predicate node
+}
+
+// existsNode represents the exists() predicate.
+type existsNode struct {
+	path node
+}
+
+// likeRegexNode represents the like_regex predicate.
+type likeRegexNode struct {
+	operand node
+	pattern *regexp.Regexp
+}
+
+// startsWithNode represents the "starts with" predicate.
+type startsWithNode struct {
+	operand node
+	prefix  node
+}
+
+func (rootNode) isPredicate() bool           { return false }
+func (currentNode) isPredicate() bool        { return false }
+func (variableNode) isPredicate() bool       { return false }
+func (lastNode) isPredicate() bool           { return false }
+func (literalNode) isPredicate() bool        { return false }
+func (memberNode) isPredicate() bool         { return false }
+func (wildcardMemberNode) isPredicate() bool { return false }
+func (arrayNode) isPredicate() bool          { return false }
+func (wildcardArrayNode) isPredicate() bool  { return false }
+func (recursiveNode) isPredicate() bool      { return false }
+func (filterNode) isPredicate() bool         { return false }
+func (methodNode) isPredicate() bool         { return false }
+func (arithmeticNode) isPredicate() bool     { return false }
+func (unaryNode) isPredicate() bool          { return false }
+func (comparisonNode) isPredicate() bool     { return true }
+func (andNode) isPredicate() bool            { return true }
+func (orNode) isPredicate() bool             { return true }
+func (notNode) isPredicate() bool            { return true }
+func (isUnknownNode) isPredicate() bool      { return true }
+func (existsNode) isPredicate() bool         { return true }
+func (likeRegexNode) isPredicate() bool      { return true }
+func (startsWithNode) isPredicate() bool     { return true }
@@ -0,0 +1,58 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/duration"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initJustifyDays registers the functions to the catalog.
+func initJustifyDays() {
+	framework.RegisterFunction(justify_days_interval)
+}
+
+// justify_days_interval represents the PostgreSQL function of the same name, taking the same parameters.
+var justify_days_interval = framework.Function1{
+	Name:       "justify_days",
+	Return:     pgtypes.Interval,
+	Parameters: []pgtypes.DoltgresType{pgtypes.Interval},
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		if val == nil {
+			return nil, nil
+		}
+		interval := val.(duration.Duration)
+		months, days := justifyDays(interval.Months, interval.Days)
+		return duration.DecodeDuration(months, days, interval.Nanos()), nil
+	},
+}
+
+// justifyDays moves every 30 days into a month, ensuring that the months and days share the same sign.
+func justifyDays(months int64, days int64) (int64, int64) {
+	wholeMonths := days / duration.DaysPerMonth
+	days -= wholeMonths * duration.DaysPerMonth
+	months += wholeMonths
+	if months > 0 && days < 0 {
+		days += duration.DaysPerMonth
+		months--
+	} else if months < 0 && days > 0 {
+		days -= duration.DaysPerMonth
+		months++
+	}
+	return months, days
+}
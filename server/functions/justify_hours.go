@@ -0,0 +1,63 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/duration"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// nanosPerDay is the number of nanoseconds in a 24-hour day.
+const nanosPerDay = int64(24 * time.Hour)
+
+// initJustifyHours registers the functions to the catalog.
+func initJustifyHours() {
+	framework.RegisterFunction(justify_hours_interval)
+}
+
+// justify_hours_interval represents the PostgreSQL function of the same name, taking the same parameters.
+var justify_hours_interval = framework.Function1{
+	Name:       "justify_hours",
+	Return:     pgtypes.Interval,
+	Parameters: []pgtypes.DoltgresType{pgtypes.Interval},
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		if val == nil {
+			return nil, nil
+		}
+		interval := val.(duration.Duration)
+		days, nanos := justifyHours(interval.Days, interval.Nanos())
+		return duration.DecodeDuration(interval.Months, days, nanos), nil
+	},
+}
+
+// justifyHours moves every 24 hours into a day, ensuring that the days and time share the same sign.
+func justifyHours(days int64, nanos int64) (int64, int64) {
+	wholeDays := nanos / nanosPerDay
+	nanos -= wholeDays * nanosPerDay
+	days += wholeDays
+	if days > 0 && nanos < 0 {
+		nanos += nanosPerDay
+		days--
+	} else if days < 0 && nanos > 0 {
+		nanos -= nanosPerDay
+		days++
+	}
+	return days, nanos
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/duration"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initJustifyInterval registers the functions to the catalog.
+func initJustifyInterval() {
+	framework.RegisterFunction(justify_interval_interval)
+}
+
+// justify_interval_interval represents the PostgreSQL function of the same name, taking the same parameters.
+var justify_interval_interval = framework.Function1{
+	Name:       "justify_interval",
+	Return:     pgtypes.Interval,
+	Parameters: []pgtypes.DoltgresType{pgtypes.Interval},
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		if val == nil {
+			return nil, nil
+		}
+		interval := val.(duration.Duration)
+		months, days, nanos := interval.Months, interval.Days, interval.Nanos()
+		// Days are moved into months first when it cannot change the result, which avoids overflowing the days
+		if (days > 0 && nanos > 0) || (days < 0 && nanos < 0) {
+			wholeMonths := days / duration.DaysPerMonth
+			days -= wholeMonths * duration.DaysPerMonth
+			months += wholeMonths
+		}
+		wholeDays := nanos / nanosPerDay
+		nanos -= wholeDays * nanosPerDay
+		days += wholeDays
+		wholeMonths := days / duration.DaysPerMonth
+		days -= wholeMonths * duration.DaysPerMonth
+		months += wholeMonths
+		if months > 0 && (days < 0 || (days == 0 && nanos < 0)) {
+			days += duration.DaysPerMonth
+			months--
+		} else if months < 0 && (days > 0 || (days == 0 && nanos > 0)) {
+			days -= duration.DaysPerMonth
+			months++
+		}
+		if days > 0 && nanos < 0 {
+			nanos += nanosPerDay
+			days--
+		} else if days < 0 && nanos > 0 {
+			nanos -= nanosPerDay
+			days++
+		}
+		return duration.DecodeDuration(months, days, nanos), nil
+	},
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initKeyColumnUsageList registers the functions to the catalog.
+func initKeyColumnUsageList() {
+	framework.RegisterFunction(key_column_usage_list)
+}
+
+// key_column_usage_list is the source of the information_schema.key_column_usage view, returning a row for each column
+// of the primary key, unique, and foreign key constraints of the tables that the current role has access to. This
+// function is specific to Doltgres.
+var key_column_usage_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "key_column_usage_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			constraints, err := informationSchemaConstraints(ctx)
+			if err != nil {
+				return nil, err
+			}
+			database := ctx.GetCurrentDatabase()
+			var rows [][]any
+			for _, constraint := range constraints {
+				if constraint.contype == "c" {
+					continue
+				}
+				for i, attnum := range constraint.attnums {
+					attnum, ok := attnum.(int16)
+					if !ok || attnum < 1 {
+						continue
+					}
+					var positionInUniqueConstraint any
+					if constraint.contype == "f" {
+						positionInUniqueConstraint = int32(i + 1)
+					}
+					rows = append(rows, []any{
+						database,
+						constraint.table.name.Schema,
+						constraint.name,
+						database,
+						constraint.table.name.Schema,
+						constraint.table.name.Name,
+						constraint.table.columns[attnum-1].Name,
+						int32(i + 1),
+						positionInUniqueConstraint,
+					})
+				}
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "constraint_catalog", Type: pgtypes.Name},
+		{Name: "constraint_schema", Type: pgtypes.Name},
+		{Name: "constraint_name", Type: pgtypes.Name},
+		{Name: "table_catalog", Type: pgtypes.Name},
+		{Name: "table_schema", Type: pgtypes.Name},
+		{Name: "table_name", Type: pgtypes.Name},
+		{Name: "column_name", Type: pgtypes.Name},
+		{Name: "ordinal_position", Type: pgtypes.Int32},
+		{Name: "position_in_unique_constraint", Type: pgtypes.Int32},
+	},
+	ReturnsSet: true,
+}
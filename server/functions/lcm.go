@@ -34,7 +34,7 @@ var lcm_int64_int64 = framework.Function2{
 	Name:       "lcm",
 	Return:     pgtypes.Int64,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Int64, pgtypes.Int64},
-	Callable: func(ctx *sql.Context, val1Int any, val2Int any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1Int any, val2Int any) (any, error) {
 		if val1Int == nil || val2Int == nil {
 			return nil, nil
 		}
@@ -43,7 +43,7 @@ var lcm_int64_int64 = framework.Function2{
 		if val1 == val2 {
 			return utils.Abs(val1), nil
 		}
-		gcdResultInterface, err := gcd_int64_int64.Callable(ctx, val1, val2)
+		gcdResultInterface, err := gcd_int64_int64.Callable(ctx, [3]pgtypes.DoltgresType{}, val1, val2)
 		if err != nil {
 			return nil, err
 		}
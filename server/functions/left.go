@@ -31,7 +31,7 @@ var left_varchar_int32 = framework.Function2{
 	Name:       "left",
 	Return:     pgtypes.VarChar,
 	Parameters: []pgtypes.DoltgresType{pgtypes.VarChar, pgtypes.Int32},
-	Callable: func(ctx *sql.Context, strInt any, nInt any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, strInt any, nInt any) (any, error) {
 		if strInt == nil || nInt == nil {
 			return nil, nil
 		}
@@ -32,8 +32,8 @@ var ltrim_varchar = framework.Function1{
 	Name:       "ltrim",
 	Return:     pgtypes.VarChar,
 	Parameters: []pgtypes.DoltgresType{pgtypes.VarChar},
-	Callable: func(ctx *sql.Context, val1 any) (any, error) {
-		return ltrim_varchar_varchar.Callable(ctx, val1, " ")
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+		return ltrim_varchar_varchar.Callable(ctx, [3]pgtypes.DoltgresType{}, val1, " ")
 	},
 }
 
@@ -42,7 +42,7 @@ var ltrim_varchar_varchar = framework.Function2{
 	Name:       "ltrim",
 	Return:     pgtypes.VarChar,
 	Parameters: []pgtypes.DoltgresType{pgtypes.VarChar, pgtypes.VarChar},
-	Callable: func(ctx *sql.Context, str any, characters any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, str any, characters any) (any, error) {
 		if str == nil || characters == nil {
 			return nil, nil
 		}
@@ -33,7 +33,7 @@ var nextval_text = framework.Function1{
 	Return:             pgtypes.Int64,
 	Parameters:         []pgtypes.DoltgresType{pgtypes.Text},
 	IsNonDeterministic: true,
-	Callable: func(ctx *sql.Context, val1 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
 		if val1 == nil {
 			return nil, nil
 		}
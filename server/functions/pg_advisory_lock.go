@@ -0,0 +1,88 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/messages"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/locks"
+	"github.com/dolthub/doltgresql/server/notices"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgAdvisoryLock registers the functions to the catalog.
+func initPgAdvisoryLock() {
+	framework.RegisterFunction(pg_advisory_lock_int64)
+	framework.RegisterFunction(pg_try_advisory_lock_int64)
+	framework.RegisterFunction(pg_advisory_unlock_int64)
+	framework.RegisterFunction(pg_advisory_unlock_all)
+}
+
+// pg_advisory_lock_int64 represents the PostgreSQL function of the same name, taking the same parameters.
+var pg_advisory_lock_int64 = framework.Function1{
+	Name:               "pg_advisory_lock",
+	Return:             pgtypes.Void,
+	Parameters:         []pgtypes.DoltgresType{pgtypes.Int64},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		// The wait is bounded by the session's lock_timeout, and also ends if the statement is canceled
+		if err := locks.Acquire(ctx, ctx.Session.ID(), val.(int64), locks.Timeout(ctx)); err != nil {
+			return nil, err
+		}
+		return "", nil
+	},
+}
+
+// pg_try_advisory_lock_int64 represents the PostgreSQL function of the same name, taking the same parameters.
+var pg_try_advisory_lock_int64 = framework.Function1{
+	Name:               "pg_try_advisory_lock",
+	Return:             pgtypes.Bool,
+	Parameters:         []pgtypes.DoltgresType{pgtypes.Int64},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		return locks.TryAcquire(ctx.Session.ID(), val.(int64)), nil
+	},
+}
+
+// pg_advisory_unlock_int64 represents the PostgreSQL function of the same name, taking the same parameters.
+var pg_advisory_unlock_int64 = framework.Function1{
+	Name:               "pg_advisory_unlock",
+	Return:             pgtypes.Bool,
+	Parameters:         []pgtypes.DoltgresType{pgtypes.Int64},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		if !locks.Release(ctx.Session.ID(), val.(int64)) {
+			notices.Raise(ctx, notices.Notice{
+				Severity: messages.ErrorResponseSeverity_Warning,
+				Message:  "you don't own a lock of type ExclusiveLock",
+			})
+			return false, nil
+		}
+		return true, nil
+	},
+}
+
+// pg_advisory_unlock_all represents the PostgreSQL function of the same name, taking the same parameters.
+var pg_advisory_unlock_all = framework.Function0{
+	Name:               "pg_advisory_unlock_all",
+	Return:             pgtypes.Void,
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+		locks.ReleaseAll(ctx.Session.ID())
+		return "", nil
+	},
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgAmList registers the functions to the catalog.
+func initPgAmList() {
+	framework.RegisterFunction(pg_am_list)
+}
+
+// pg_am_list is the source of the pg_am table, returning the access methods that tables and indexes may use. Every
+// table uses the heap access method, and every index uses the btree access method. This function is specific to
+// Doltgres.
+var pg_am_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_am_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			return [][]any{
+				{uint32(heapAccessMethodOid), "heap", "heap_tableam_handler", "t"},
+				{uint32(btreeAccessMethodOid), "btree", "bthandler", "i"},
+			}, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "oid", Type: pgtypes.Oid},
+		{Name: "amname", Type: pgtypes.Name},
+		{Name: "amhandler", Type: pgtypes.Text},
+		{Name: "amtype", Type: pgtypes.Text},
+	},
+	ReturnsSet: true,
+}
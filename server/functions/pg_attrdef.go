@@ -0,0 +1,77 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgAttrdefList registers the functions to the catalog.
+func initPgAttrdefList() {
+	framework.RegisterFunction(pg_attrdef_list)
+}
+
+// pg_attrdef_list is the source of the pg_attrdef table, returning the default value of every column that has one, as
+// well as the expression of every generated column. Rows exist exactly for the columns that have atthasdef set in
+// pg_attribute. This function is specific to Doltgres.
+var pg_attrdef_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_attrdef_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			tables, err := catalogTables(ctx)
+			if err != nil {
+				return nil, err
+			}
+			sequenceCollection, err := core.GetCollectionFromContext(ctx)
+			if err != nil {
+				return nil, err
+			}
+			var rows [][]any
+			for _, table := range tables {
+				identities := columnIdentities(sequenceCollection, table)
+				for i, col := range table.columns {
+					expr := col.Default
+					if len(col.Generated) > 0 {
+						expr = col.Generated
+					}
+					if len(expr) == 0 || len(identities[col.Name]) > 0 {
+						continue
+					}
+					rows = append(rows, []any{
+						attrdefOid(table.name, col.Name),
+						table.oid,
+						int16(i + 1),
+						expr,
+					})
+				}
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "oid", Type: pgtypes.Oid},
+		{Name: "adrelid", Type: pgtypes.Oid},
+		{Name: "adnum", Type: pgtypes.Int16},
+		{Name: "adbin", Type: pgtypes.Text},
+	},
+	ReturnsSet: true,
+}
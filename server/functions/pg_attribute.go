@@ -0,0 +1,134 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/sequences"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgAttributeList registers the functions to the catalog.
+func initPgAttributeList() {
+	framework.RegisterFunction(pg_attribute_list)
+}
+
+// pg_attribute_list is the source of the pg_attribute table, returning every column of every table in the current
+// database. System columns are not returned, as we do not have them. This function is specific to Doltgres.
+var pg_attribute_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_attribute_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			tables, err := catalogTables(ctx)
+			if err != nil {
+				return nil, err
+			}
+			sequenceCollection, err := core.GetCollectionFromContext(ctx)
+			if err != nil {
+				return nil, err
+			}
+			var rows [][]any
+			for _, table := range tables {
+				identities := columnIdentities(sequenceCollection, table)
+				for i, col := range table.columns {
+					typ := catalogColumnType(col)
+					if typ == nil {
+						typ = pgtypes.Unknown
+					}
+					attndims := int32(0)
+					if catalogTypeCategory(typ) == "A" {
+						attndims = 1
+					}
+					// Identity columns are given a default that calls their sequence, which Postgres does not report
+					atthasdef := (len(col.Default) > 0 || len(col.Generated) > 0) && len(identities[col.Name]) == 0
+					attgenerated := ""
+					if len(col.Generated) > 0 {
+						attgenerated = "s"
+					}
+					rows = append(rows, []any{
+						table.oid,
+						col.Name,
+						typ.OID(),
+						catalogTypeLength(typ),
+						int16(i + 1),
+						catalogTypeModifier(typ),
+						attndims,
+						catalogTypeByValue(typ),
+						catalogTypeAlignment(typ),
+						catalogTypeStorage(typ),
+						!col.IsNullable(),
+						atthasdef,
+						false,
+						identities[col.Name],
+						attgenerated,
+						false,
+						true,
+						int32(0),
+						catalogTypeCollation(typ),
+						nil,
+						nil,
+						nil,
+					})
+				}
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "attrelid", Type: pgtypes.Oid},
+		{Name: "attname", Type: pgtypes.Name},
+		{Name: "atttypid", Type: pgtypes.Oid},
+		{Name: "attlen", Type: pgtypes.Int16},
+		{Name: "attnum", Type: pgtypes.Int16},
+		{Name: "atttypmod", Type: pgtypes.Int32},
+		{Name: "attndims", Type: pgtypes.Int32},
+		{Name: "attbyval", Type: pgtypes.Bool},
+		{Name: "attalign", Type: pgtypes.Text},
+		{Name: "attstorage", Type: pgtypes.Text},
+		{Name: "attnotnull", Type: pgtypes.Bool},
+		{Name: "atthasdef", Type: pgtypes.Bool},
+		{Name: "atthasmissing", Type: pgtypes.Bool},
+		{Name: "attidentity", Type: pgtypes.Text},
+		{Name: "attgenerated", Type: pgtypes.Text},
+		{Name: "attisdropped", Type: pgtypes.Bool},
+		{Name: "attislocal", Type: pgtypes.Bool},
+		{Name: "attinhcount", Type: pgtypes.Int32},
+		{Name: "attcollation", Type: pgtypes.Oid},
+		{Name: "attacl", Type: pgtypes.TextArray},
+		{Name: "attoptions", Type: pgtypes.TextArray},
+		{Name: "attfdwoptions", Type: pgtypes.TextArray},
+	},
+	ReturnsSet: true,
+}
+
+// columnIdentities returns the attidentity of each identity column of the given table, keyed by the column's name.
+func columnIdentities(sequenceCollection *sequences.Collection, table catalogTable) map[string]string {
+	identities := make(map[string]string)
+	for _, seq := range sequenceCollection.GetSequencesWithTable(table.name) {
+		switch seq.Identity {
+		case sequences.Identity_Always:
+			identities[seq.OwnerColumn] = "a"
+		case sequences.Identity_ByDefault:
+			identities[seq.OwnerColumn] = "d"
+		}
+	}
+	return identities
+}
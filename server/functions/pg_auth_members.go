@@ -0,0 +1,75 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgAuthMemberList registers the functions to the catalog.
+func initPgAuthMemberList() {
+	framework.RegisterFunction(pg_auth_member_list)
+}
+
+// pg_auth_member_list is the source of the pg_auth_members view, returning every role membership. Roles are referred
+// to by their OIDs, so that the view may be joined with pg_roles. This function is specific to Doltgres.
+var pg_auth_member_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_auth_member_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			memberships := auth.Memberships()
+			rows := make([][]any, 0, len(memberships))
+			for _, membership := range memberships {
+				role, ok := auth.GetRole(membership.Role)
+				if !ok {
+					continue
+				}
+				member, ok := auth.GetRole(membership.Member)
+				if !ok {
+					continue
+				}
+				grantor, ok := auth.GetRole(membership.Grantor)
+				if !ok {
+					continue
+				}
+				rows = append(rows, []any{
+					role.OID,
+					member.OID,
+					grantor.OID,
+					membership.AdminOption,
+					membership.InheritOption,
+					membership.SetOption,
+				})
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "roleid", Type: pgtypes.Oid},
+		{Name: "member", Type: pgtypes.Oid},
+		{Name: "grantor", Type: pgtypes.Oid},
+		{Name: "admin_option", Type: pgtypes.Bool},
+		{Name: "inherit_option", Type: pgtypes.Bool},
+		{Name: "set_option", Type: pgtypes.Bool},
+	},
+	ReturnsSet: true,
+}
@@ -0,0 +1,84 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"sort"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgCastList registers the functions to the catalog.
+func initPgCastList() {
+	framework.RegisterFunction(pg_cast_list)
+}
+
+// pg_cast_list is the source of the pg_cast table, returning every registered cast. Casts are implemented internally
+// rather than by functions within pg_proc, so castfunc is always zero. This function is specific to Doltgres.
+var pg_cast_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_cast_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			casts := framework.GetAllTypeCasts()
+			rows := make([][]any, len(casts))
+			for i, cast := range casts {
+				source := cast.FromType.GetRepresentativeType().OID()
+				target := cast.ToType.OID()
+				rows[i] = []any{
+					castOid(source, target),
+					source,
+					target,
+					uint32(0),
+					catalogCastContext(cast.Context),
+					"i",
+				}
+			}
+			sort.Slice(rows, func(i, j int) bool {
+				if rows[i][1].(uint32) != rows[j][1].(uint32) {
+					return rows[i][1].(uint32) < rows[j][1].(uint32)
+				}
+				return rows[i][2].(uint32) < rows[j][2].(uint32)
+			})
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "oid", Type: pgtypes.Oid},
+		{Name: "castsource", Type: pgtypes.Oid},
+		{Name: "casttarget", Type: pgtypes.Oid},
+		{Name: "castfunc", Type: pgtypes.Oid},
+		{Name: "castcontext", Type: pgtypes.Text},
+		{Name: "castmethod", Type: pgtypes.Text},
+	},
+	ReturnsSet: true,
+}
+
+// catalogCastContext returns the castcontext of a cast that may be applied in the given context.
+func catalogCastContext(context framework.TypeCastContext) string {
+	switch context {
+	case framework.TypeCastContext_Assignment:
+		return "a"
+	case framework.TypeCastContext_Implicit:
+		return "i"
+	default:
+		return "e"
+	}
+}
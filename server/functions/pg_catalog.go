@@ -0,0 +1,466 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/resolve"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/lib/pq/oid"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/auth"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// These are the OIDs of the objects that Postgres creates with fixed OIDs, which clients commonly compare against.
+const (
+	pgCatalogNamespaceOid = 11
+	publicNamespaceOid    = 2200
+	heapAccessMethodOid   = 2
+	btreeAccessMethodOid  = 403
+	defaultCollationOid   = 100
+	cCollationOid         = 950
+	posixCollationOid     = 951
+	defaultTablespaceOid  = 1663
+	globalTablespaceOid   = 1664
+	// firstNormalObjectOid is the first OID that Postgres assigns to objects that are created after initialization.
+	firstNormalObjectOid = 16384
+)
+
+// We do not store OIDs for the objects in a database, so the catalog tables derive them from each object's identity.
+// This keeps an object's OID stable for as long as the object keeps its name, which allows clients to join the catalog
+// tables on their OIDs.
+const (
+	catalogOidKind_Database       = "database"
+	catalogOidKind_Namespace      = "namespace"
+	catalogOidKind_Relation       = "relation"
+	catalogOidKind_Index          = "index"
+	catalogOidKind_Constraint     = "constraint"
+	catalogOidKind_Function       = "function"
+	catalogOidKind_AttrDef        = "attrdef"
+	catalogOidKind_Cast           = "cast"
+	catalogOidKind_Operator       = "operator"
+	catalogOidKind_Publication    = "publication"
+	catalogOidKind_PublicationRel = "publicationrel"
+)
+
+// catalogOid returns the OID of the object of the given kind that is identified by the given names. OIDs are always at
+// least firstNormalObjectOid, so they never match the OID of a built-in object.
+func catalogOid(kind string, names ...string) uint32 {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(kind))
+	for _, name := range names {
+		_, _ = hash.Write([]byte{0})
+		_, _ = hash.Write([]byte(name))
+	}
+	return firstNormalObjectOid + hash.Sum32()%(^uint32(0)-firstNormalObjectOid)
+}
+
+// namespaceOid returns the OID of the given schema.
+func namespaceOid(schemaName string) uint32 {
+	switch schemaName {
+	case "pg_catalog":
+		return pgCatalogNamespaceOid
+	case "public":
+		return publicNamespaceOid
+	default:
+		return catalogOid(catalogOidKind_Namespace, schemaName)
+	}
+}
+
+// relationOid returns the OID of the table, view, or sequence with the given name. These share a namespace in
+// pg_class, so they share the way that their OIDs are derived.
+func relationOid(name doltdb.TableName) uint32 {
+	return catalogOid(catalogOidKind_Relation, name.Schema, name.Name)
+}
+
+// indexOid returns the OID of the index with the given name on the given table. Index names are only unique within
+// their table, so the table is part of the index's identity.
+func indexOid(table doltdb.TableName, name string) uint32 {
+	return catalogOid(catalogOidKind_Index, table.Schema, table.Name, name)
+}
+
+// constraintOid returns the OID of the constraint with the given name on the given table.
+func constraintOid(table doltdb.TableName, name string) uint32 {
+	return catalogOid(catalogOidKind_Constraint, table.Schema, table.Name, name)
+}
+
+// attrdefOid returns the OID of the default value of the given column.
+func attrdefOid(table doltdb.TableName, column string) uint32 {
+	return catalogOid(catalogOidKind_AttrDef, table.Schema, table.Name, column)
+}
+
+// castOid returns the OID of the cast from the given source type to the given target type.
+func castOid(source uint32, target uint32) uint32 {
+	return catalogOid(catalogOidKind_Cast, strconv.FormatUint(uint64(source), 10), strconv.FormatUint(uint64(target), 10))
+}
+
+// operatorOid returns the OID of the operator with the given operand types. The left operand type is zero for prefix
+// operators.
+func operatorOid(name string, left uint32, right uint32) uint32 {
+	return catalogOid(catalogOidKind_Operator, name, strconv.FormatUint(uint64(left), 10), strconv.FormatUint(uint64(right), 10))
+}
+
+// functionOid returns the OID of the function with the given argument types. Functions may be overloaded, so their
+// argument types are part of their identity.
+func functionOid(schema string, name string, argTypes []uint32) uint32 {
+	identity := make([]string, 0, len(argTypes)+2)
+	identity = append(identity, schema, name)
+	for _, argType := range argTypes {
+		identity = append(identity, strconv.FormatUint(uint64(argType), 10))
+	}
+	return catalogOid(catalogOidKind_Function, identity...)
+}
+
+// publicationOid returns the OID of the publication with the given name.
+func publicationOid(name string) uint32 {
+	return catalogOid(catalogOidKind_Publication, name)
+}
+
+// publicationRelOid returns the OID of the membership of the given table within the given publication.
+func publicationRelOid(publication string, table doltdb.TableName) uint32 {
+	return catalogOid(catalogOidKind_PublicationRel, publication, table.Schema, table.Name)
+}
+
+// ownerOid returns the OID of the role that owns the given object, or the OID of the bootstrap role when the owner no
+// longer exists.
+func ownerOid(obj auth.Object) uint32 {
+	return roleOid(auth.Owner(obj))
+}
+
+// roleOid returns the OID of the given role, or the OID of the bootstrap role when the role does not exist.
+func roleOid(name string) uint32 {
+	if role, ok := auth.GetRole(name); ok {
+		return role.OID
+	}
+	if role, ok := auth.GetRole(auth.BootstrapRole); ok {
+		return role.OID
+	}
+	return 10
+}
+
+// catalogSearchPath returns the schemas that are searched for unqualified names, in the order that they are searched.
+// The pg_catalog schema is always searched, and is searched first unless the search_path places it elsewhere.
+func catalogSearchPath(ctx *sql.Context) ([]string, error) {
+	searchPath, err := resolve.SearchPath(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, schemaName := range searchPath {
+		if schemaName == "pg_catalog" {
+			return searchPath, nil
+		}
+	}
+	return append([]string{"pg_catalog"}, searchPath...), nil
+}
+
+// catalogSchemaNames returns the system schemas followed by every schema of the current database.
+func catalogSchemaNames(ctx *sql.Context) ([]string, error) {
+	schemaNames, err := core.GetSchemaNamesFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(schemaNames)+2)
+	names = append(names, "pg_catalog", "information_schema")
+	for _, schemaName := range schemaNames {
+		if schemaName != "pg_catalog" && schemaName != "information_schema" {
+			names = append(names, schemaName)
+		}
+	}
+	return names, nil
+}
+
+// catalogTable is a table within the current database, as it is presented by the catalog tables.
+type catalogTable struct {
+	name  doltdb.TableName
+	oid   uint32
+	table *doltdb.Table
+	sch   schema.Schema
+	// columns are the columns of the table in order of their attribute numbers, which begin at 1.
+	columns []schema.Column
+	// attnums maps each column's tag to its attribute number.
+	attnums map[uint64]int16
+}
+
+// catalogIndex is an index on a table, as it is presented by the catalog tables. Primary keys are presented as an index
+// named after their table, as they are in Postgres.
+type catalogIndex struct {
+	name      string
+	oid       uint32
+	attnums   []any
+	isUnique  bool
+	isPrimary bool
+}
+
+// catalogTables returns every table within the current database, in order of their schemas and names. Dolt's system
+// tables are not included.
+func catalogTables(ctx *sql.Context) ([]catalogTable, error) {
+	var tables []catalogTable
+	err := core.IterateTablesFromContext(ctx, func(name doltdb.TableName, doltTable *doltdb.Table, sch schema.Schema) (bool, error) {
+		if len(name.Schema) == 0 || doltdb.HasDoltPrefix(name.Name) {
+			return false, nil
+		}
+		table := catalogTable{
+			name:    name,
+			oid:     relationOid(name),
+			table:   doltTable,
+			sch:     sch,
+			columns: sch.GetAllCols().GetColumns(),
+			attnums: make(map[uint64]int16),
+		}
+		for i, col := range table.columns {
+			table.attnums[col.Tag] = int16(i + 1)
+		}
+		tables = append(tables, table)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		if tables[i].name.Schema != tables[j].name.Schema {
+			return tables[i].name.Schema < tables[j].name.Schema
+		}
+		return tables[i].name.Name < tables[j].name.Name
+	})
+	return tables, nil
+}
+
+// rowCount returns the number of rows in the table.
+func (table catalogTable) rowCount(ctx *sql.Context) (int64, error) {
+	rows, err := table.table.GetRowData(ctx)
+	if err != nil {
+		return 0, err
+	}
+	count, err := rows.Count()
+	return int64(count), err
+}
+
+// indexes returns the indexes of the table, beginning with the primary key. Indexes that Dolt creates on its own, such
+// as those that back foreign keys, are not included.
+func (table catalogTable) indexes() []catalogIndex {
+	var indexes []catalogIndex
+	if pkCols := table.sch.GetPKCols(); pkCols.Size() > 0 {
+		name := table.name.Name + "_pkey"
+		indexes = append(indexes, catalogIndex{
+			name:      name,
+			oid:       indexOid(table.name, name),
+			attnums:   table.attnumsOf(pkCols.Tags),
+			isUnique:  true,
+			isPrimary: true,
+		})
+	}
+	userIndexes := table.sch.Indexes().AllIndexes()
+	sort.Slice(userIndexes, func(i, j int) bool {
+		return userIndexes[i].Name() < userIndexes[j].Name()
+	})
+	for _, index := range userIndexes {
+		if !index.IsUserDefined() {
+			continue
+		}
+		indexes = append(indexes, catalogIndex{
+			name:     index.Name(),
+			oid:      indexOid(table.name, index.Name()),
+			attnums:  table.attnumsOf(index.IndexedColumnTags()),
+			isUnique: index.IsUnique(),
+		})
+	}
+	return indexes
+}
+
+// attnumsOf returns the attribute numbers of the columns with the given tags.
+func (table catalogTable) attnumsOf(tags []uint64) []any {
+	attnums := make([]any, len(tags))
+	for i, tag := range tags {
+		attnums[i] = table.attnums[tag]
+	}
+	return attnums
+}
+
+// columnNames returns the names of the columns with the given attribute numbers, separated by commas. Names are quoted
+// when needed.
+func (table catalogTable) columnNames(attnums []any) string {
+	names := make([]string, len(attnums))
+	for i, attnum := range attnums {
+		if attnum, ok := attnum.(int16); ok && attnum > 0 && int(attnum) <= len(table.columns) {
+			names[i] = tree.NameString(table.columns[attnum-1].Name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// findCatalogTable returns the table that the given name refers to. Foreign keys only record the names of their tables,
+// so a name that exists in several schemas is resolved to the one in the given schema.
+func findCatalogTable(tables []catalogTable, name string, schemaName string) (catalogTable, bool) {
+	var found catalogTable
+	matches := 0
+	for _, table := range tables {
+		if table.name.Name != name {
+			continue
+		}
+		if table.name.Schema == schemaName {
+			return table, true
+		}
+		found = table
+		matches++
+	}
+	return found, matches == 1
+}
+
+// catalogTypeName returns the name of the given type as it appears in pg_type.
+func catalogTypeName(typ pgtypes.DoltgresType) string {
+	if name, ok := oid.TypeName[oid.Oid(typ.OID())]; ok {
+		return strings.ToLower(name)
+	}
+	return strings.ToLower(typ.String())
+}
+
+// catalogTypeByOid returns the type with the given OID.
+func catalogTypeByOid(typeOid uint32) (pgtypes.DoltgresType, bool) {
+	for _, typ := range pgtypes.GetAllTypes() {
+		if typ.OID() == typeOid {
+			return typ, true
+		}
+	}
+	return nil, false
+}
+
+// catalogTypeLength returns the typlen of the given type, which is -1 for types that have a variable length.
+func catalogTypeLength(typ pgtypes.DoltgresType) int16 {
+	switch oid.Oid(typ.OID()) {
+	case oid.T_bool:
+		return 1
+	case oid.T_int2:
+		return 2
+	case oid.T_int4, oid.T_float4, oid.T_oid, oid.T_regclass, oid.T_regnamespace, oid.T_regtype, oid.T_date, oid.T_xid:
+		return 4
+	case oid.T_int8, oid.T_float8, oid.T_time, oid.T_timestamp, oid.T_timestamptz:
+		return 8
+	case oid.T_timetz:
+		return 12
+	case oid.T_interval, oid.T_uuid:
+		return 16
+	case oid.T_name:
+		return 64
+	default:
+		return -1
+	}
+}
+
+// catalogTypeModifier returns the type modifier of the given type, which is -1 for types without one.
+func catalogTypeModifier(typ pgtypes.DoltgresType) int32 {
+	if typ.IsUnbounded() {
+		return -1
+	}
+	switch typ := typ.(type) {
+	case pgtypes.VarCharType:
+		return int32(typ.Length) + 4
+	case pgtypes.CharType:
+		return int32(typ.Length) + 4
+	case pgtypes.NumericType:
+		return ((typ.Precision << 16) | typ.Scale) + 4
+	default:
+		return -1
+	}
+}
+
+// catalogColumnType returns the type of the given column, or nil if the column does not have a Doltgres type.
+func catalogColumnType(col schema.Column) pgtypes.DoltgresType {
+	typ, _ := col.TypeInfo.ToSqlType().(pgtypes.DoltgresType)
+	return typ
+}
+
+// catalogTypeCategory returns the typcategory of the given type.
+func catalogTypeCategory(typ pgtypes.DoltgresType) string {
+	switch oid.Oid(typ.OID()) {
+	case oid.T_anyarray, oid.T_anyelement, oid.T_record, oid.T_trigger, oid.T_void:
+		return "P"
+	case oid.T_unknown:
+		return "X"
+	}
+	if _, ok := typ.(pgtypes.DoltgresArrayType); ok {
+		return "A"
+	}
+	switch oid.Oid(typ.OID()) {
+	case oid.T_bool:
+		return "B"
+	case oid.T_int2, oid.T_int4, oid.T_int8, oid.T_float4, oid.T_float8, oid.T_numeric, oid.T_oid:
+		return "N"
+	case oid.T_text, oid.T_varchar, oid.T_bpchar, oid.T_name:
+		return "S"
+	case oid.T_date, oid.T_time, oid.T_timetz, oid.T_timestamp, oid.T_timestamptz:
+		return "D"
+	case oid.T_interval:
+		return "T"
+	default:
+		return "U"
+	}
+}
+
+// catalogTypeAlignment returns the typalign of the given type.
+func catalogTypeAlignment(typ pgtypes.DoltgresType) string {
+	if typ.OID() == uint32(oid.T_uuid) {
+		return "c"
+	}
+	switch catalogTypeLength(typ) {
+	case 1, 64:
+		return "c"
+	case 2:
+		return "s"
+	case 8, 12, 16:
+		return "d"
+	default:
+		if catalogTypeCategory(typ) == "A" {
+			if alignment := catalogTypeAlignment(typ.(pgtypes.DoltgresArrayType).BaseType()); alignment == "d" {
+				return alignment
+			}
+		}
+		return "i"
+	}
+}
+
+// catalogTypeStorage returns the typstorage of the given type, which is plain for types with a fixed length.
+func catalogTypeStorage(typ pgtypes.DoltgresType) string {
+	if catalogTypeLength(typ) > 0 {
+		return "p"
+	}
+	return "x"
+}
+
+// catalogTypeCollation returns the collation of the given type, which is only set for the string types.
+func catalogTypeCollation(typ pgtypes.DoltgresType) uint32 {
+	if catalogTypeCategory(typ) == "S" && typ.OID() != uint32(oid.T_name) {
+		return defaultCollationOid
+	}
+	return 0
+}
+
+// catalogTypeByValue returns whether values of the given type are passed by value, rather than by reference.
+func catalogTypeByValue(typ pgtypes.DoltgresType) bool {
+	switch catalogTypeLength(typ) {
+	case 1, 2, 4, 8:
+		return true
+	default:
+		return false
+	}
+}
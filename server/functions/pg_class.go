@@ -0,0 +1,248 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/sequences"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgClassList registers the functions to the catalog.
+func initPgClassList() {
+	framework.RegisterFunction(pg_class_list)
+}
+
+// pg_class_list is the source of the pg_class table, returning every table, index, view, and sequence of the current
+// database. Each table is immediately followed by its indexes. This function is specific to Doltgres.
+var pg_class_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_class_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			return pgClassRows(ctx)
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "oid", Type: pgtypes.Oid},
+		{Name: "relname", Type: pgtypes.Name},
+		{Name: "relnamespace", Type: pgtypes.Oid},
+		{Name: "reltype", Type: pgtypes.Oid},
+		{Name: "reloftype", Type: pgtypes.Oid},
+		{Name: "relowner", Type: pgtypes.Oid},
+		{Name: "relam", Type: pgtypes.Oid},
+		{Name: "relfilenode", Type: pgtypes.Oid},
+		{Name: "reltablespace", Type: pgtypes.Oid},
+		{Name: "relpages", Type: pgtypes.Int32},
+		{Name: "reltuples", Type: pgtypes.Float32},
+		{Name: "relallvisible", Type: pgtypes.Int32},
+		{Name: "reltoastrelid", Type: pgtypes.Oid},
+		{Name: "relhasindex", Type: pgtypes.Bool},
+		{Name: "relisshared", Type: pgtypes.Bool},
+		{Name: "relpersistence", Type: pgtypes.Text},
+		{Name: "relkind", Type: pgtypes.Text},
+		{Name: "relnatts", Type: pgtypes.Int16},
+		{Name: "relchecks", Type: pgtypes.Int16},
+		{Name: "relhasrules", Type: pgtypes.Bool},
+		{Name: "relhastriggers", Type: pgtypes.Bool},
+		{Name: "relhassubclass", Type: pgtypes.Bool},
+		{Name: "relrowsecurity", Type: pgtypes.Bool},
+		{Name: "relforcerowsecurity", Type: pgtypes.Bool},
+		{Name: "relispopulated", Type: pgtypes.Bool},
+		{Name: "relreplident", Type: pgtypes.Text},
+		{Name: "relispartition", Type: pgtypes.Bool},
+		{Name: "relrewrite", Type: pgtypes.Oid},
+		{Name: "relacl", Type: pgtypes.TextArray},
+		{Name: "reloptions", Type: pgtypes.TextArray},
+		{Name: "relpartbound", Type: pgtypes.Text},
+	},
+	ReturnsSet: true,
+}
+
+// pgClassRows returns the rows of pg_class.
+func pgClassRows(ctx *sql.Context) ([][]any, error) {
+	tables, err := catalogTables(ctx)
+	if err != nil {
+		return nil, err
+	}
+	partitionCollection, err := core.GetPartitionsCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	foreignCollection, err := core.GetForeignDataCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	triggerCollection, err := core.GetTriggersCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	paramCollection, err := core.GetStorageParametersCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	database := ctx.GetCurrentDatabase()
+	var rows [][]any
+	for _, table := range tables {
+		owner := ownerOid(auth.TableObject(database, table.name.Schema, table.name.Name))
+		indexes := table.indexes()
+		relkind := "r"
+		relhassubclass := false
+		if partitionCollection.GetTable(table.name) != nil {
+			relkind = "p"
+			relhassubclass = len(partitionCollection.GetPartitions(table.name)) > 0
+		} else if foreignCollection.GetTable(table.name) != nil {
+			relkind = "f"
+		}
+		var relpartbound any
+		partition := partitionCollection.GetPartition(table.name)
+		if partition != nil {
+			relpartbound = partition.BoundString()
+		}
+		row := pgClassRow(table.oid, table.name, owner, heapAccessMethodOid, relkind, "p", int16(len(table.columns)))
+		row[pgClassColumn_relhasindex] = len(indexes) > 0
+		row[pgClassColumn_relchecks] = int16(len(table.sch.Checks().AllChecks()))
+		row[pgClassColumn_relhastriggers] = len(triggerCollection.GetTriggers(table.name)) > 0
+		row[pgClassColumn_relhassubclass] = relhassubclass
+		row[pgClassColumn_relispartition] = partition != nil
+		row[pgClassColumn_reloptions] = storageParameterOptions(paramCollection.GetParameters(table.name))
+		row[pgClassColumn_relpartbound] = relpartbound
+		rows = append(rows, row)
+		for _, index := range indexes {
+			rows = append(rows, pgClassRow(index.oid, doltdb.TableName{Name: index.name, Schema: table.name.Schema},
+				owner, btreeAccessMethodOid, "i", "p", int16(len(index.attnums))))
+		}
+	}
+	views, err := core.GetViewsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, view := range views {
+		owner := ownerOid(auth.TableObject(database, view.Name.Schema, view.Name.Name))
+		row := pgClassRow(relationOid(view.Name), view.Name, owner, 0, "v", "p", 0)
+		row[pgClassColumn_relfilenode] = uint32(0)
+		rows = append(rows, row)
+	}
+	sequenceCollection, err := core.GetCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var sequenceRows [][]any
+	err = sequenceCollection.IterateSequences(func(schema string, seq *sequences.Sequence) error {
+		name := doltdb.TableName{Name: seq.Name, Schema: schema}
+		relpersistence := "p"
+		switch seq.Persistence {
+		case sequences.Persistence_Temporary:
+			relpersistence = "t"
+		case sequences.Persistence_Unlogged:
+			relpersistence = "u"
+		}
+		sequenceRows = append(sequenceRows, pgClassRow(relationOid(name), name, roleOid(seq.OwnerUser),
+			0, "S", relpersistence, 3))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(sequenceRows, func(i, j int) bool {
+		if sequenceRows[i][pgClassColumn_relnamespace] != sequenceRows[j][pgClassColumn_relnamespace] {
+			return sequenceRows[i][pgClassColumn_relnamespace].(uint32) < sequenceRows[j][pgClassColumn_relnamespace].(uint32)
+		}
+		return sequenceRows[i][pgClassColumn_relname].(string) < sequenceRows[j][pgClassColumn_relname].(string)
+	})
+	return append(rows, sequenceRows...), nil
+}
+
+// These are the positions of the pg_class columns that differ between relations of the same kind.
+const (
+	pgClassColumn_relname        = 1
+	pgClassColumn_relnamespace   = 2
+	pgClassColumn_relfilenode    = 7
+	pgClassColumn_relhasindex    = 13
+	pgClassColumn_relpersistence = 15
+	pgClassColumn_relkind        = 16
+	pgClassColumn_relchecks      = 18
+	pgClassColumn_relhastriggers = 20
+	pgClassColumn_relhassubclass = 21
+	pgClassColumn_relispartition = 26
+	pgClassColumn_reloptions     = 29
+	pgClassColumn_relpartbound   = 30
+)
+
+// pgClassRow returns a pg_class row for the given relation, with every column that is not given set to the value that
+// Postgres uses for a new relation.
+func pgClassRow(relOid uint32, name doltdb.TableName, owner uint32, am uint32, relkind string, relpersistence string, natts int16) []any {
+	return []any{
+		relOid,
+		name.Name,
+		namespaceOid(name.Schema),
+		uint32(0),
+		uint32(0),
+		owner,
+		am,
+		relOid,
+		uint32(0),
+		int32(0),
+		float32(-1),
+		int32(0),
+		uint32(0),
+		false,
+		false,
+		relpersistence,
+		relkind,
+		natts,
+		int16(0),
+		false,
+		false,
+		false,
+		false,
+		false,
+		true,
+		"d",
+		false,
+		uint32(0),
+		nil,
+		nil,
+		nil,
+	}
+}
+
+// storageParameterOptions returns the given storage parameters as they appear in reloptions, or NULL when there are
+// none.
+func storageParameterOptions(params map[string]string) any {
+	if len(params) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	options := make([]any, len(names))
+	for i, name := range names {
+		options[i] = fmt.Sprintf("%s=%s", name, params[name])
+	}
+	return options
+}
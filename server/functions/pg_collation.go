@@ -0,0 +1,61 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgCollationList registers the functions to the catalog.
+func initPgCollationList() {
+	framework.RegisterFunction(pg_collation_list)
+}
+
+// pg_collation_list is the source of the pg_collation table, returning the collations that Postgres always creates. We
+// do not support creating collations, so these are the only collations. This function is specific to Doltgres.
+var pg_collation_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_collation_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			owner := roleOid(auth.BootstrapRole)
+			return [][]any{
+				{uint32(defaultCollationOid), "default", uint32(pgCatalogNamespaceOid), owner, "d", true, int32(-1), nil, nil, nil, nil},
+				{uint32(cCollationOid), "C", uint32(pgCatalogNamespaceOid), owner, "c", true, int32(-1), "C", "C", nil, nil},
+				{uint32(posixCollationOid), "POSIX", uint32(pgCatalogNamespaceOid), owner, "c", true, int32(-1), "POSIX", "POSIX", nil, nil},
+			}, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "oid", Type: pgtypes.Oid},
+		{Name: "collname", Type: pgtypes.Name},
+		{Name: "collnamespace", Type: pgtypes.Oid},
+		{Name: "collowner", Type: pgtypes.Oid},
+		{Name: "collprovider", Type: pgtypes.Text},
+		{Name: "collisdeterministic", Type: pgtypes.Bool},
+		{Name: "collencoding", Type: pgtypes.Int32},
+		{Name: "collcollate", Type: pgtypes.Text},
+		{Name: "collctype", Type: pgtypes.Text},
+		{Name: "colliculocale", Type: pgtypes.Text},
+		{Name: "collversion", Type: pgtypes.Text},
+	},
+	ReturnsSet: true,
+}
@@ -0,0 +1,241 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/exclusions"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgConstraintList registers the functions to the catalog.
+func initPgConstraintList() {
+	framework.RegisterFunction(pg_constraint_list)
+}
+
+// pg_constraint_list is the source of the pg_constraint table, returning the primary key, unique, check, foreign key,
+// and exclusion constraints of every table in the current database. This function is specific to Doltgres.
+var pg_constraint_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_constraint_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			tables, err := catalogTables(ctx)
+			if err != nil {
+				return nil, err
+			}
+			constraints, err := catalogConstraints(ctx, tables)
+			if err != nil {
+				return nil, err
+			}
+			rows := make([][]any, len(constraints))
+			for i, constraint := range constraints {
+				var conkey any
+				if len(constraint.attnums) > 0 {
+					conkey = constraint.attnums
+				}
+				row := []any{
+					constraintOid(constraint.table.name, constraint.name),
+					constraint.name,
+					namespaceOid(constraint.table.name.Schema),
+					constraint.contype,
+					false,
+					false,
+					constraint.validated,
+					constraint.table.oid,
+					uint32(0),
+					constraint.index,
+					uint32(0),
+					uint32(0),
+					" ",
+					" ",
+					" ",
+					true,
+					int32(0),
+					false,
+					conkey,
+					nil,
+				}
+				if fk := constraint.foreignKey; fk != nil {
+					if constraint.parent != nil {
+						row[pgConstraintColumn_confrelid] = constraint.parent.oid
+						row[pgConstraintColumn_confkey] = constraint.parentAttnums
+					}
+					row[pgConstraintColumn_confupdtype] = referentialActionCode(fk.OnUpdate)
+					row[pgConstraintColumn_confdeltype] = referentialActionCode(fk.OnDelete)
+					row[pgConstraintColumn_confmatchtype] = "s"
+				}
+				rows[i] = row
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "oid", Type: pgtypes.Oid},
+		{Name: "conname", Type: pgtypes.Name},
+		{Name: "connamespace", Type: pgtypes.Oid},
+		{Name: "contype", Type: pgtypes.Text},
+		{Name: "condeferrable", Type: pgtypes.Bool},
+		{Name: "condeferred", Type: pgtypes.Bool},
+		{Name: "convalidated", Type: pgtypes.Bool},
+		{Name: "conrelid", Type: pgtypes.Oid},
+		{Name: "contypid", Type: pgtypes.Oid},
+		{Name: "conindid", Type: pgtypes.Oid},
+		{Name: "conparentid", Type: pgtypes.Oid},
+		{Name: "confrelid", Type: pgtypes.Oid},
+		{Name: "confupdtype", Type: pgtypes.Text},
+		{Name: "confdeltype", Type: pgtypes.Text},
+		{Name: "confmatchtype", Type: pgtypes.Text},
+		{Name: "conislocal", Type: pgtypes.Bool},
+		{Name: "coninhcount", Type: pgtypes.Int32},
+		{Name: "connoinherit", Type: pgtypes.Bool},
+		{Name: "conkey", Type: pgtypes.Int16Array},
+		{Name: "confkey", Type: pgtypes.Int16Array},
+	},
+	ReturnsSet: true,
+}
+
+// These are the positions of the pg_constraint columns that are only set for foreign keys.
+const (
+	pgConstraintColumn_confrelid     = 11
+	pgConstraintColumn_confupdtype   = 12
+	pgConstraintColumn_confdeltype   = 13
+	pgConstraintColumn_confmatchtype = 14
+	pgConstraintColumn_confkey       = 19
+)
+
+// referentialActionCode returns the code that pg_constraint uses for the given referential action.
+func referentialActionCode(action doltdb.ForeignKeyReferentialAction) string {
+	switch action {
+	case doltdb.ForeignKeyReferentialAction_Cascade:
+		return "c"
+	case doltdb.ForeignKeyReferentialAction_Restrict:
+		return "r"
+	case doltdb.ForeignKeyReferentialAction_SetNull:
+		return "n"
+	default:
+		return "a"
+	}
+}
+
+// catalogConstraint is a constraint on a table, as it is presented by the catalog tables.
+type catalogConstraint struct {
+	table   catalogTable
+	name    string
+	contype string
+	attnums []any
+	// index is the OID of the index that enforces a primary key or unique constraint.
+	index     uint32
+	validated bool
+	// foreignKey is only set for foreign keys, while parent and parentAttnums are only set for foreign keys whose
+	// referenced table exists.
+	foreignKey    *doltdb.ForeignKey
+	parent        *catalogTable
+	parentAttnums []any
+	// check is only set for check constraints, and exclusion is only set for exclusion constraints.
+	check     schema.Check
+	exclusion *exclusions.Constraint
+}
+
+// catalogConstraints returns the primary key, unique, check, foreign key, and exclusion constraints of the given tables,
+// in that order for each table.
+func catalogConstraints(ctx *sql.Context, tables []catalogTable) ([]catalogConstraint, error) {
+	currentSchema, err := core.GetCurrentSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fkCollection, err := core.GetForeignKeyCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	exclusionCollection, err := core.GetExclusionsCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	unvalidatedCollection, err := core.GetUnvalidatedCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	foreignKeys := make(map[doltdb.TableName][]doltdb.ForeignKey)
+	for _, fk := range fkCollection.AllKeys() {
+		if table, ok := findCatalogTable(tables, fk.TableName, currentSchema); ok {
+			foreignKeys[table.name] = append(foreignKeys[table.name], fk)
+		}
+	}
+	var constraints []catalogConstraint
+	for _, table := range tables {
+		for _, index := range table.indexes() {
+			constraint := catalogConstraint{table: table, name: index.name, attnums: index.attnums, index: index.oid, validated: true}
+			switch {
+			case index.isPrimary:
+				constraint.contype = "p"
+			case index.isUnique:
+				constraint.contype = "u"
+			default:
+				continue
+			}
+			constraints = append(constraints, constraint)
+		}
+		for _, check := range table.sch.Checks().AllChecks() {
+			constraints = append(constraints, catalogConstraint{
+				table:     table,
+				name:      check.Name(),
+				contype:   "c",
+				validated: unvalidatedCollection.GetConstraint(table.name, check.Name()) == nil,
+				check:     check,
+			})
+		}
+		for _, fk := range foreignKeys[table.name] {
+			fk := fk
+			constraint := catalogConstraint{
+				table:      table,
+				name:       fk.Name,
+				contype:    "f",
+				attnums:    table.attnumsOf(fk.TableColumns),
+				validated:  unvalidatedCollection.GetConstraint(table.name, fk.Name) == nil,
+				foreignKey: &fk,
+			}
+			if parent, ok := findCatalogTable(tables, fk.ReferencedTableName, table.name.Schema); ok {
+				constraint.parent = &parent
+				constraint.parentAttnums = parent.attnumsOf(fk.ReferencedTableColumns)
+			}
+			constraints = append(constraints, constraint)
+		}
+		for _, exclusion := range exclusionCollection.GetConstraints(table.name) {
+			attnums := make([]any, len(exclusion.Elements))
+			for i, element := range exclusion.Elements {
+				if col, ok := table.sch.GetAllCols().GetByName(element.Column); ok {
+					attnums[i] = table.attnums[col.Tag]
+				}
+			}
+			constraints = append(constraints, catalogConstraint{
+				table:     table,
+				name:      exclusion.Name,
+				contype:   "x",
+				attnums:   attnums,
+				validated: true,
+				exclusion: exclusion,
+			})
+		}
+	}
+	return constraints, nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/prepared"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgCursor registers the functions to the catalog.
+func initPgCursor() {
+	framework.RegisterFunction(pg_cursor)
+}
+
+// pg_cursor represents the PostgreSQL function of the same name, which is the source of the pg_cursors view.
+var pg_cursor = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_cursor",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			cursors := prepared.Cursors(ctx.Session.ID())
+			rows := make([][]any, len(cursors))
+			for i, cursor := range cursors {
+				rows[i] = []any{
+					cursor.Name,
+					cursor.Statement,
+					cursor.IsHoldable,
+					cursor.IsBinary,
+					cursor.IsScrollable,
+					cursor.CreationTime,
+				}
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "name", Type: pgtypes.Text},
+		{Name: "statement", Type: pgtypes.Text},
+		{Name: "is_holdable", Type: pgtypes.Bool},
+		{Name: "is_binary", Type: pgtypes.Bool},
+		{Name: "is_scrollable", Type: pgtypes.Bool},
+		{Name: "creation_time", Type: pgtypes.TimestampTZ},
+	},
+	ReturnsSet: true,
+}
@@ -0,0 +1,95 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgDatabaseList registers the functions to the catalog.
+func initPgDatabaseList() {
+	framework.RegisterFunction(pg_database_list)
+}
+
+// pg_database_list is the source of the pg_database table, returning every database on the server. Only UTF8 databases
+// with the C locale are supported, and the template0 and template1 databases are reported as templates when they
+// exist. This function is specific to Doltgres.
+var pg_database_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_database_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			provider := dsess.DSessFromSess(ctx.Session).Provider()
+			var names []string
+			for _, db := range provider.AllDatabases(ctx) {
+				name := db.Name()
+				if strings.EqualFold(name, "information_schema") || strings.EqualFold(name, "pg_catalog") {
+					continue
+				}
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			rows := make([][]any, len(names))
+			for i, name := range names {
+				rows[i] = []any{
+					catalogOid(catalogOidKind_Database, name),
+					name,
+					ownerOid(auth.DatabaseObject(name)),
+					int32(6), // UTF8
+					"c",
+					name == "template0" || name == "template1",
+					true,
+					int32(-1),
+					uint32(defaultTablespaceOid),
+					"C",
+					"C",
+					nil,
+					nil,
+					nil,
+					nil,
+				}
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "oid", Type: pgtypes.Oid},
+		{Name: "datname", Type: pgtypes.Name},
+		{Name: "datdba", Type: pgtypes.Oid},
+		{Name: "encoding", Type: pgtypes.Int32},
+		{Name: "datlocprovider", Type: pgtypes.Text},
+		{Name: "datistemplate", Type: pgtypes.Bool},
+		{Name: "datallowconn", Type: pgtypes.Bool},
+		{Name: "datconnlimit", Type: pgtypes.Int32},
+		{Name: "dattablespace", Type: pgtypes.Oid},
+		{Name: "datcollate", Type: pgtypes.Text},
+		{Name: "datctype", Type: pgtypes.Text},
+		{Name: "daticulocale", Type: pgtypes.Text},
+		{Name: "daticurules", Type: pgtypes.Text},
+		{Name: "datcollversion", Type: pgtypes.Text},
+		{Name: "datacl", Type: pgtypes.TextArray},
+	},
+	ReturnsSet: true,
+}
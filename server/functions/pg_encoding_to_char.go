@@ -0,0 +1,53 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgEncodingToChar registers the functions to the catalog.
+func initPgEncodingToChar() {
+	framework.RegisterFunction(pg_encoding_to_char_int32)
+}
+
+// pg_encoding_to_char_int32 represents the PostgreSQL function of the same name, taking the same parameters.
+var pg_encoding_to_char_int32 = framework.Function1{
+	Name:       "pg_encoding_to_char",
+	Return:     pgtypes.Name,
+	Parameters: []pgtypes.DoltgresType{pgtypes.Int32},
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		if val == nil {
+			return nil, nil
+		}
+		encoding := val.(int32)
+		if encoding < 0 || int(encoding) >= len(encodingNames) {
+			return "", nil
+		}
+		return encodingNames[encoding], nil
+	},
+}
+
+// encodingNames are the names of the encodings that Postgres supports, in order of their numbers.
+var encodingNames = []string{
+	"SQL_ASCII", "EUC_JP", "EUC_CN", "EUC_KR", "EUC_TW", "EUC_JIS_2004", "UTF8", "MULE_INTERNAL", "LATIN1", "LATIN2",
+	"LATIN3", "LATIN4", "LATIN5", "LATIN6", "LATIN7", "LATIN8", "LATIN9", "LATIN10", "WIN1256", "WIN1258", "WIN866",
+	"WIN874", "KOI8R", "WIN1251", "WIN1252", "ISO_8859_5", "ISO_8859_6", "ISO_8859_7", "ISO_8859_8", "WIN1250",
+	"WIN1253", "WIN1254", "WIN1255", "WIN1257", "KOI8U", "SJIS", "BIG5", "GBK", "UHC", "GB18030", "JOHAB",
+	"SHIFT_JIS_2004",
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"slices"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgFunctionIsVisible registers the functions to the catalog.
+func initPgFunctionIsVisible() {
+	framework.RegisterFunction(pg_function_is_visible_oid)
+}
+
+// pg_function_is_visible_oid represents the PostgreSQL function of the same name, taking the same parameters.
+var pg_function_is_visible_oid = framework.Function1{
+	Name:               "pg_function_is_visible",
+	Return:             pgtypes.Bool,
+	Parameters:         []pgtypes.DoltgresType{pgtypes.Oid},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		if val == nil {
+			return nil, nil
+		}
+		functions, err := catalogFunctions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		idx := slices.IndexFunc(functions, func(function catalogFunction) bool {
+			return function.oid == val.(uint32)
+		})
+		if idx == -1 {
+			return nil, nil
+		}
+		function := functions[idx]
+		searchPath, err := catalogSearchPath(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// A function is visible when no function with the same name and argument types is found in a schema that is
+		// searched before its own
+		for _, schemaName := range searchPath {
+			if schemaName == function.schema {
+				return true, nil
+			}
+			for _, other := range functions {
+				if other.schema == schemaName && other.name == function.name && slices.Equal(other.argTypes(), function.argTypes()) {
+					return false, nil
+				}
+			}
+		}
+		return false, nil
+	},
+}
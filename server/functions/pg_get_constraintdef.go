@@ -0,0 +1,171 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgGetConstraintdef registers the functions to the catalog.
+func initPgGetConstraintdef() {
+	framework.RegisterFunction(pg_get_constraintdef_oid)
+	framework.RegisterFunction(pg_get_constraintdef_oid_bool)
+}
+
+// pg_get_constraintdef_oid represents the PostgreSQL function of the same name, taking the same parameters.
+var pg_get_constraintdef_oid = framework.Function1{
+	Name:               "pg_get_constraintdef",
+	Return:             pgtypes.Text,
+	Parameters:         []pgtypes.DoltgresType{pgtypes.Oid},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		if val == nil {
+			return nil, nil
+		}
+		return constraintDefinition(ctx, val.(uint32), false)
+	},
+}
+
+// pg_get_constraintdef_oid_bool represents the PostgreSQL function of the same name, taking the same parameters.
+var pg_get_constraintdef_oid_bool = framework.Function2{
+	Name:               "pg_get_constraintdef",
+	Return:             pgtypes.Text,
+	Parameters:         []pgtypes.DoltgresType{pgtypes.Oid, pgtypes.Bool},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		if val1 == nil || val2 == nil {
+			return nil, nil
+		}
+		return constraintDefinition(ctx, val1.(uint32), val2.(bool))
+	},
+}
+
+// constraintDefinition returns the definition of the constraint with the given OID, as it would be written within
+// ALTER TABLE ... ADD CONSTRAINT. Pretty-printed definitions omit the parentheses that surround a check constraint's
+// expression. Returns nil when the constraint does not exist.
+func constraintDefinition(ctx *sql.Context, constraintOidVal uint32, pretty bool) (any, error) {
+	tables, err := catalogTables(ctx)
+	if err != nil {
+		return nil, err
+	}
+	constraints, err := catalogConstraints(ctx, tables)
+	if err != nil {
+		return nil, err
+	}
+	for _, constraint := range constraints {
+		if constraintOid(constraint.table.name, constraint.name) != constraintOidVal {
+			continue
+		}
+		var def string
+		switch constraint.contype {
+		case "p":
+			def = fmt.Sprintf("PRIMARY KEY (%s)", constraint.table.columnNames(constraint.attnums))
+		case "u":
+			def = fmt.Sprintf("UNIQUE (%s)", constraint.table.columnNames(constraint.attnums))
+		case "c":
+			expr := constraint.check.Expression()
+			if pretty {
+				expr = stripEnclosingParens(expr)
+			}
+			def = fmt.Sprintf("CHECK (%s)", expr)
+		case "f":
+			def, err = foreignKeyDefinition(ctx, constraint)
+			if err != nil {
+				return nil, err
+			}
+		case "x":
+			elements := make([]string, len(constraint.exclusion.Elements))
+			for i, element := range constraint.exclusion.Elements {
+				elements[i] = fmt.Sprintf("%s WITH %s", tree.NameString(element.Column), element.Operator)
+			}
+			def = fmt.Sprintf("EXCLUDE USING %s (%s)", constraint.exclusion.Using, strings.Join(elements, ", "))
+		}
+		if !constraint.validated {
+			def += " NOT VALID"
+		}
+		return def, nil
+	}
+	return nil, nil
+}
+
+// foreignKeyDefinition returns the definition of the given foreign key constraint. The referenced table is qualified
+// by its schema when it is not visible.
+func foreignKeyDefinition(ctx *sql.Context, constraint catalogConstraint) (string, error) {
+	fk := constraint.foreignKey
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("FOREIGN KEY (%s) REFERENCES ", constraint.table.columnNames(constraint.attnums)))
+	if constraint.parent != nil {
+		parentName, err := regclassOutput(ctx, constraint.parent.oid)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(fmt.Sprintf("%s(%s)", parentName, constraint.parent.columnNames(constraint.parentAttnums)))
+	} else {
+		sb.WriteString(fmt.Sprintf("%s(%s)", tree.NameString(fk.ReferencedTableName), strings.Join(fk.UnresolvedFKDetails.ReferencedTableColumns, ", ")))
+	}
+	if action := referentialActionClause(fk.OnUpdate); len(action) > 0 {
+		sb.WriteString(" ON UPDATE ")
+		sb.WriteString(action)
+	}
+	if action := referentialActionClause(fk.OnDelete); len(action) > 0 {
+		sb.WriteString(" ON DELETE ")
+		sb.WriteString(action)
+	}
+	return sb.String(), nil
+}
+
+// referentialActionClause returns the given referential action as it is written within a foreign key's definition.
+// NO ACTION is the default, so it returns an empty string for NO ACTION.
+func referentialActionClause(action doltdb.ForeignKeyReferentialAction) string {
+	switch action {
+	case doltdb.ForeignKeyReferentialAction_Cascade:
+		return "CASCADE"
+	case doltdb.ForeignKeyReferentialAction_Restrict:
+		return "RESTRICT"
+	case doltdb.ForeignKeyReferentialAction_SetNull:
+		return "SET NULL"
+	default:
+		return ""
+	}
+}
+
+// stripEnclosingParens removes the parentheses that enclose the entire expression, if there are any.
+func stripEnclosingParens(expr string) string {
+	for len(expr) >= 2 && expr[0] == '(' && expr[len(expr)-1] == ')' {
+		depth := 0
+		for i := 0; i < len(expr)-1; i++ {
+			switch expr[i] {
+			case '(':
+				depth++
+			case ')':
+				depth--
+			}
+			// The opening parenthesis closes before the end, so it does not enclose the entire expression
+			if depth == 0 {
+				return expr
+			}
+		}
+		expr = expr[1 : len(expr)-1]
+	}
+	return expr
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgGetExpr registers the functions to the catalog.
+func initPgGetExpr() {
+	framework.RegisterFunction(pg_get_expr_text_oid)
+	framework.RegisterFunction(pg_get_expr_text_oid_bool)
+}
+
+// pg_get_expr_text_oid represents the PostgreSQL function of the same name, taking the same parameters. The catalog
+// tables store expressions as the text that they were written with, rather than as parse trees, so the expression is
+// returned as-is.
+var pg_get_expr_text_oid = framework.Function2{
+	Name:       "pg_get_expr",
+	Return:     pgtypes.Text,
+	Parameters: []pgtypes.DoltgresType{pgtypes.Text, pgtypes.Oid},
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		if val1 == nil || val2 == nil {
+			return nil, nil
+		}
+		return val1, nil
+	},
+}
+
+// pg_get_expr_text_oid_bool represents the PostgreSQL function of the same name, taking the same parameters.
+var pg_get_expr_text_oid_bool = framework.Function3{
+	Name:       "pg_get_expr",
+	Return:     pgtypes.Text,
+	Parameters: []pgtypes.DoltgresType{pgtypes.Text, pgtypes.Oid, pgtypes.Bool},
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		if val1 == nil || val2 == nil || val3 == nil {
+			return nil, nil
+		}
+		return val1, nil
+	},
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	corefunctions "github.com/dolthub/doltgresql/core/functions"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgGetFunctionArguments registers the functions to the catalog.
+func initPgGetFunctionArguments() {
+	framework.RegisterFunction(pg_get_function_arguments_oid)
+}
+
+// pg_get_function_arguments_oid represents the PostgreSQL function of the same name, taking the same parameters.
+var pg_get_function_arguments_oid = framework.Function1{
+	Name:               "pg_get_function_arguments",
+	Return:             pgtypes.Text,
+	Parameters:         []pgtypes.DoltgresType{pgtypes.Oid},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		if val == nil {
+			return nil, nil
+		}
+		function, ok, err := findCatalogFunction(ctx, val.(uint32))
+		if err != nil || !ok {
+			return nil, err
+		}
+		args := make([]string, len(function.params))
+		for i, param := range function.params {
+			var sb strings.Builder
+			switch param.mode {
+			case corefunctions.ParameterMode_Out:
+				sb.WriteString("OUT ")
+			case corefunctions.ParameterMode_InOut:
+				sb.WriteString("INOUT ")
+			case corefunctions.ParameterMode_Variadic:
+				sb.WriteString("VARIADIC ")
+			}
+			if len(param.name) > 0 {
+				sb.WriteString(param.name)
+				sb.WriteString(" ")
+			}
+			sb.WriteString(formatType(param.typ.OID(), -1, false))
+			if len(param.defaultExpr) > 0 {
+				sb.WriteString(" DEFAULT ")
+				sb.WriteString(param.defaultExpr)
+			}
+			args[i] = sb.String()
+		}
+		return strings.Join(args, ", "), nil
+	},
+}
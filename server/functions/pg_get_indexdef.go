@@ -0,0 +1,88 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgGetIndexdef registers the functions to the catalog.
+func initPgGetIndexdef() {
+	framework.RegisterFunction(pg_get_indexdef_oid)
+	framework.RegisterFunction(pg_get_indexdef_oid_int32_bool)
+}
+
+// pg_get_indexdef_oid represents the PostgreSQL function of the same name, taking the same parameters.
+var pg_get_indexdef_oid = framework.Function1{
+	Name:               "pg_get_indexdef",
+	Return:             pgtypes.Text,
+	Parameters:         []pgtypes.DoltgresType{pgtypes.Oid},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		if val == nil {
+			return nil, nil
+		}
+		return indexDefinition(ctx, val.(uint32), 0)
+	},
+}
+
+// pg_get_indexdef_oid_int32_bool represents the PostgreSQL function of the same name, taking the same parameters.
+var pg_get_indexdef_oid_int32_bool = framework.Function3{
+	Name:               "pg_get_indexdef",
+	Return:             pgtypes.Text,
+	Parameters:         []pgtypes.DoltgresType{pgtypes.Oid, pgtypes.Int32, pgtypes.Bool},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		if val1 == nil || val2 == nil || val3 == nil {
+			return nil, nil
+		}
+		return indexDefinition(ctx, val1.(uint32), val2.(int32))
+	},
+}
+
+// indexDefinition returns the CREATE INDEX statement of the index with the given OID. When column is not zero, this
+// instead returns the name of the index's column at that position. Returns nil when the index does not exist.
+func indexDefinition(ctx *sql.Context, indexOidVal uint32, column int32) (any, error) {
+	tables, err := catalogTables(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, table := range tables {
+		for _, index := range table.indexes() {
+			if index.oid != indexOidVal {
+				continue
+			}
+			if column != 0 {
+				if column < 0 || int(column) > len(index.attnums) {
+					return "", nil
+				}
+				return table.columnNames(index.attnums[column-1 : column]), nil
+			}
+			unique := ""
+			if index.isUnique {
+				unique = "UNIQUE "
+			}
+			return fmt.Sprintf("CREATE %sINDEX %s ON %s.%s USING btree (%s)", unique, tree.NameString(index.name),
+				tree.NameString(table.name.Schema), tree.NameString(table.name.Name), table.columnNames(index.attnums)), nil
+		}
+	}
+	return nil, nil
+}
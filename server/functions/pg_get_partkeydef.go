@@ -0,0 +1,68 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgGetPartkeydef registers the functions to the catalog.
+func initPgGetPartkeydef() {
+	framework.RegisterFunction(pg_get_partkeydef_oid)
+}
+
+// pg_get_partkeydef_oid represents the PostgreSQL function of the same name, taking the same parameters.
+var pg_get_partkeydef_oid = framework.Function1{
+	Name:               "pg_get_partkeydef",
+	Return:             pgtypes.Text,
+	Parameters:         []pgtypes.DoltgresType{pgtypes.Oid},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		if val == nil {
+			return nil, nil
+		}
+		tables, err := catalogTables(ctx)
+		if err != nil {
+			return nil, err
+		}
+		partitionCollection, err := core.GetPartitionsCollectionFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, table := range tables {
+			if table.oid != val.(uint32) {
+				continue
+			}
+			partitionedTable := partitionCollection.GetTable(table.name)
+			if partitionedTable == nil {
+				return nil, nil
+			}
+			columns := make([]string, len(partitionedTable.Columns))
+			for i, column := range partitionedTable.Columns {
+				columns[i] = tree.NameString(column)
+			}
+			return fmt.Sprintf("%s (%s)", partitionedTable.Strategy.String(), strings.Join(columns, ", ")), nil
+		}
+		return nil, nil
+	},
+}
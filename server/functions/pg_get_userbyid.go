@@ -0,0 +1,49 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgGetUserById registers the functions to the catalog.
+func initPgGetUserById() {
+	framework.RegisterFunction(pg_get_userbyid_oid)
+}
+
+// pg_get_userbyid_oid represents the PostgreSQL function of the same name, taking the same parameters.
+var pg_get_userbyid_oid = framework.Function1{
+	Name:               "pg_get_userbyid",
+	Return:             pgtypes.Name,
+	Parameters:         []pgtypes.DoltgresType{pgtypes.Oid},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		if val == nil {
+			return nil, nil
+		}
+		for _, role := range auth.Roles() {
+			if role.OID == val.(uint32) {
+				return role.Name, nil
+			}
+		}
+		return fmt.Sprintf("unknown (OID=%d)", val.(uint32)), nil
+	},
+}
@@ -0,0 +1,97 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgHasRole registers the functions to the catalog.
+func initPgHasRole() {
+	framework.RegisterFunction(pg_has_role_name_text)
+	framework.RegisterFunction(pg_has_role_name_name_text)
+}
+
+// pg_has_role_name_text represents the PostgreSQL function of the same name, taking the same parameters.
+var pg_has_role_name_text = framework.Function2{
+	Name:               "pg_has_role",
+	Return:             pgtypes.Bool,
+	Parameters:         []pgtypes.DoltgresType{pgtypes.Name, pgtypes.Text},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, role any, privileges any) (any, error) {
+		user := ctx.Client().User
+		if len(user) == 0 {
+			user = auth.BootstrapRole
+		}
+		return pgHasRole(user, role.(string), privileges.(string))
+	},
+}
+
+// pg_has_role_name_name_text represents the PostgreSQL function of the same name, taking the same parameters.
+var pg_has_role_name_name_text = framework.Function3{
+	Name:               "pg_has_role",
+	Return:             pgtypes.Bool,
+	Parameters:         []pgtypes.DoltgresType{pgtypes.Name, pgtypes.Name, pgtypes.Text},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, user any, role any, privileges any) (any, error) {
+		return pgHasRole(user.(string), role.(string), privileges.(string))
+	},
+}
+
+// pgHasRole returns whether the user holds any of the comma-separated privileges on the role.
+func pgHasRole(user string, role string, privileges string) (bool, error) {
+	for _, name := range []string{user, role} {
+		if _, ok := auth.GetRole(name); !ok {
+			return false, pgerrors.Newf(pgcode.UndefinedObject, `role "%s" does not exist`, name)
+		}
+	}
+	for _, p := range strings.Split(privileges, ",") {
+		p = strings.ToUpper(strings.TrimSpace(p))
+		withAdminOption := false
+		if before, ok := strings.CutSuffix(p, " WITH ADMIN OPTION"); ok {
+			p = before
+			withAdminOption = true
+		} else if before, ok = strings.CutSuffix(p, " WITH GRANT OPTION"); ok {
+			p = before
+			withAdminOption = true
+		}
+		var has bool
+		switch p {
+		case "MEMBER":
+			has = auth.IsMemberOf(user, role)
+		case "USAGE":
+			has = auth.HasPrivilegesOf(user, role)
+		case "SET":
+			has = auth.CanSetRole(user, role)
+		default:
+			return false, pgerrors.Newf(pgcode.InvalidParameterValue, `unrecognized privilege type: "%s"`, strings.TrimSpace(privileges))
+		}
+		if withAdminOption {
+			has = auth.HasAdminOption(user, role)
+		}
+		if has {
+			return true, nil
+		}
+	}
+	return false, nil
+}
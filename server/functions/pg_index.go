@@ -0,0 +1,91 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgIndexList registers the functions to the catalog.
+func initPgIndexList() {
+	framework.RegisterFunction(pg_index_list)
+}
+
+// pg_index_list is the source of the pg_index table, returning every index of every table in the current database,
+// including the index of each primary key. This function is specific to Doltgres.
+var pg_index_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_index_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			tables, err := catalogTables(ctx)
+			if err != nil {
+				return nil, err
+			}
+			var rows [][]any
+			for _, table := range tables {
+				for _, index := range table.indexes() {
+					rows = append(rows, []any{
+						index.oid,
+						table.oid,
+						int16(len(index.attnums)),
+						int16(len(index.attnums)),
+						index.isUnique,
+						false,
+						index.isPrimary,
+						false,
+						true,
+						false,
+						true,
+						false,
+						true,
+						true,
+						false,
+						index.attnums,
+						nil,
+						nil,
+					})
+				}
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "indexrelid", Type: pgtypes.Oid},
+		{Name: "indrelid", Type: pgtypes.Oid},
+		{Name: "indnatts", Type: pgtypes.Int16},
+		{Name: "indnkeyatts", Type: pgtypes.Int16},
+		{Name: "indisunique", Type: pgtypes.Bool},
+		{Name: "indnullsnotdistinct", Type: pgtypes.Bool},
+		{Name: "indisprimary", Type: pgtypes.Bool},
+		{Name: "indisexclusion", Type: pgtypes.Bool},
+		{Name: "indimmediate", Type: pgtypes.Bool},
+		{Name: "indisclustered", Type: pgtypes.Bool},
+		{Name: "indisvalid", Type: pgtypes.Bool},
+		{Name: "indcheckxmin", Type: pgtypes.Bool},
+		{Name: "indisready", Type: pgtypes.Bool},
+		{Name: "indislive", Type: pgtypes.Bool},
+		{Name: "indisreplident", Type: pgtypes.Bool},
+		{Name: "indkey", Type: pgtypes.Int16Array},
+		{Name: "indexprs", Type: pgtypes.Text},
+		{Name: "indpred", Type: pgtypes.Text},
+	},
+	ReturnsSet: true,
+}
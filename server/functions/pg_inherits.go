@@ -0,0 +1,59 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/partitions"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgInheritsList registers the functions to the catalog.
+func initPgInheritsList() {
+	framework.RegisterFunction(pg_inherits_list)
+}
+
+// pg_inherits_list is the source of the pg_inherits table, returning the parent of every partition. Partitions are the
+// only tables that inherit from another table, as we do not support INHERITS. This function is specific to Doltgres.
+var pg_inherits_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_inherits_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			partitionCollection, err := core.GetPartitionsCollectionFromContext(ctx)
+			if err != nil {
+				return nil, err
+			}
+			var rows [][]any
+			err = partitionCollection.IteratePartitions(func(partition *partitions.Partition) error {
+				rows = append(rows, []any{relationOid(partition.Name), relationOid(partition.Parent), int32(1), false})
+				return nil
+			})
+			return rows, err
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "inhrelid", Type: pgtypes.Oid},
+		{Name: "inhparent", Type: pgtypes.Oid},
+		{Name: "inhseqno", Type: pgtypes.Int32},
+		{Name: "inhdetachpending", Type: pgtypes.Bool},
+	},
+	ReturnsSet: true,
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/locks"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgLockList registers the functions to the catalog.
+func initPgLockList() {
+	framework.RegisterFunction(pg_lock_list)
+}
+
+// pg_lock_list is the source of the pg_locks view, which returns the table, row, and advisory locks that every session
+// holds or is waiting on. Row locks are reported as tuple locks, although their page and tuple are not known, as rows
+// are identified by their keys rather than their physical location. Advisory locks are not tied to a database. This
+// function is specific to Doltgres.
+var pg_lock_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_lock_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			statuses := locks.Status()
+			rows := make([][]any, len(statuses))
+			for i, status := range statuses {
+				var waitStart any
+				if !status.Granted {
+					waitStart = status.WaitStart
+				}
+				row := []any{
+					"relation",
+					nil, // database
+					nil, // relation
+					nil, // page
+					nil, // tuple
+					nil, // virtualxid
+					nil, // transactionid
+					nil, // classid
+					nil, // objid
+					nil, // objsubid
+					nil, // virtualtransaction
+					int32(status.SessionID),
+					status.Mode,
+					status.Granted,
+					false,
+					waitStart,
+				}
+				switch status.Kind {
+				case locks.KindRelation, locks.KindRow:
+					if status.Kind == locks.KindRow {
+						row[0] = "tuple"
+					}
+					database, _ := dsess.SplitRevisionDbName(status.Relation.Database)
+					row[1] = catalogOid(catalogOidKind_Database, database)
+					row[2] = relationOid(doltdb.TableName{Schema: status.Relation.Schema, Name: status.Relation.Name})
+				case locks.KindAdvisory:
+					row[0] = "advisory"
+					row[7] = uint32(uint64(status.Key) >> 32)
+					row[8] = uint32(status.Key)
+					row[9] = int16(1)
+				}
+				rows[i] = row
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "locktype", Type: pgtypes.Text},
+		{Name: "database", Type: pgtypes.Oid},
+		{Name: "relation", Type: pgtypes.Oid},
+		{Name: "page", Type: pgtypes.Int32},
+		{Name: "tuple", Type: pgtypes.Int16},
+		{Name: "virtualxid", Type: pgtypes.Text},
+		{Name: "transactionid", Type: pgtypes.Xid},
+		{Name: "classid", Type: pgtypes.Oid},
+		{Name: "objid", Type: pgtypes.Oid},
+		{Name: "objsubid", Type: pgtypes.Int16},
+		{Name: "virtualtransaction", Type: pgtypes.Text},
+		{Name: "pid", Type: pgtypes.Int32},
+		{Name: "mode", Type: pgtypes.Text},
+		{Name: "granted", Type: pgtypes.Bool},
+		{Name: "fastpath", Type: pgtypes.Bool},
+		{Name: "waitstart", Type: pgtypes.TimestampTZ},
+	},
+	ReturnsSet: true,
+}
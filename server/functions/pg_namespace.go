@@ -0,0 +1,63 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgNamespaceList registers the functions to the catalog.
+func initPgNamespaceList() {
+	framework.RegisterFunction(pg_namespace_list)
+}
+
+// pg_namespace_list is the source of the pg_namespace table, returning the system schemas followed by every schema of
+// the current database. This function is specific to Doltgres.
+var pg_namespace_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_namespace_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			schemaNames, err := catalogSchemaNames(ctx)
+			if err != nil {
+				return nil, err
+			}
+			database := ctx.GetCurrentDatabase()
+			rows := make([][]any, 0, len(schemaNames))
+			for _, schemaName := range schemaNames {
+				rows = append(rows, []any{
+					namespaceOid(schemaName),
+					schemaName,
+					ownerOid(auth.SchemaObject(database, schemaName)),
+					nil,
+				})
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "oid", Type: pgtypes.Oid},
+		{Name: "nspname", Type: pgtypes.Name},
+		{Name: "nspowner", Type: pgtypes.Oid},
+		{Name: "nspacl", Type: pgtypes.TextArray},
+	},
+	ReturnsSet: true,
+}
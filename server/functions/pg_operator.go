@@ -0,0 +1,99 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"sort"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgOperatorList registers the functions to the catalog.
+func initPgOperatorList() {
+	framework.RegisterFunction(pg_operator_list)
+}
+
+// pg_operator_list is the source of the pg_operator table, returning every registered unary and binary operator. Each
+// operator's oprcode is the name of the function that implements it. This function is specific to Doltgres.
+var pg_operator_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_operator_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			operatorFunctions := framework.GetAllOperatorFunctions()
+			bootstrapOid := roleOid(auth.BootstrapRole)
+			rows := make([][]any, len(operatorFunctions))
+			for i, operatorFunction := range operatorFunctions {
+				name := operatorFunction.Operator.String()
+				params := operatorFunction.Function.GetParameters()
+				kind := "b"
+				left := uint32(0)
+				if operatorFunction.Operator.IsUnary() {
+					kind = "l"
+				} else {
+					left = params[0].OID()
+				}
+				right := params[len(params)-1].OID()
+				rows[i] = []any{
+					operatorOid(name, left, right),
+					name,
+					uint32(pgCatalogNamespaceOid),
+					bootstrapOid,
+					kind,
+					false,
+					false,
+					left,
+					right,
+					operatorFunction.Function.GetReturn().OID(),
+					uint32(0),
+					uint32(0),
+					operatorFunction.Function.GetName(),
+				}
+			}
+			sort.Slice(rows, func(i, j int) bool {
+				if rows[i][1].(string) != rows[j][1].(string) {
+					return rows[i][1].(string) < rows[j][1].(string)
+				}
+				if rows[i][7].(uint32) != rows[j][7].(uint32) {
+					return rows[i][7].(uint32) < rows[j][7].(uint32)
+				}
+				return rows[i][8].(uint32) < rows[j][8].(uint32)
+			})
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "oid", Type: pgtypes.Oid},
+		{Name: "oprname", Type: pgtypes.Name},
+		{Name: "oprnamespace", Type: pgtypes.Oid},
+		{Name: "oprowner", Type: pgtypes.Oid},
+		{Name: "oprkind", Type: pgtypes.Text},
+		{Name: "oprcanmerge", Type: pgtypes.Bool},
+		{Name: "oprcanhash", Type: pgtypes.Bool},
+		{Name: "oprleft", Type: pgtypes.Oid},
+		{Name: "oprright", Type: pgtypes.Oid},
+		{Name: "oprresult", Type: pgtypes.Oid},
+		{Name: "oprcom", Type: pgtypes.Oid},
+		{Name: "oprnegate", Type: pgtypes.Oid},
+		{Name: "oprcode", Type: pgtypes.Text},
+	},
+	ReturnsSet: true,
+}
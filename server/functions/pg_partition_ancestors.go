@@ -0,0 +1,70 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgPartitionAncestors registers the functions to the catalog.
+func initPgPartitionAncestors() {
+	framework.RegisterFunction(pg_partition_ancestors_regclass)
+}
+
+// pg_partition_ancestors_regclass represents the PostgreSQL function of the same name, taking the same parameters.
+var pg_partition_ancestors_regclass = framework.RecordFunction{
+	FunctionInterface: framework.Function1{
+		Name:               "pg_partition_ancestors",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{pgtypes.Regclass},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+			if val == nil {
+				return nil, nil
+			}
+			tables, err := catalogTables(ctx)
+			if err != nil {
+				return nil, err
+			}
+			partitionCollection, err := core.GetPartitionsCollectionFromContext(ctx)
+			if err != nil {
+				return nil, err
+			}
+			rows := [][]any{}
+			for _, table := range tables {
+				if table.oid != val.(uint32) {
+					continue
+				}
+				// Only partitions and partitioned tables belong to a partition tree, so other tables have no ancestors
+				// (not even themselves)
+				if partitionCollection.GetTable(table.name) == nil && partitionCollection.GetPartition(table.name) == nil {
+					break
+				}
+				rows = append(rows, []any{table.oid})
+				for partition := partitionCollection.GetPartition(table.name); partition != nil; partition = partitionCollection.GetPartition(partition.Parent) {
+					rows = append(rows, []any{relationOid(partition.Parent)})
+				}
+				break
+			}
+			return rows, nil
+		},
+	},
+	Columns:    []framework.RecordColumn{{Type: pgtypes.Regclass}},
+	ReturnsSet: true,
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/lib/pq/oid"
+
+	"github.com/dolthub/doltgresql/postgres/parser/types"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/prepared"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgPreparedStatement registers the functions to the catalog.
+func initPgPreparedStatement() {
+	framework.RegisterFunction(pg_prepared_statement)
+}
+
+// pg_prepared_statement represents the PostgreSQL function of the same name, which is the source of the
+// pg_prepared_statements view. The parameter and result types are reported using their names, as there is no regtype.
+var pg_prepared_statement = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_prepared_statement",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			statements := prepared.Statements(ctx.Session.ID())
+			rows := make([][]any, len(statements))
+			for i, stmt := range statements {
+				rows[i] = []any{
+					stmt.Name,
+					stmt.Statement,
+					stmt.PrepareTime,
+					typeNames(stmt.ParameterTypes),
+					typeNames(stmt.ResultTypes),
+					stmt.FromSQL,
+					stmt.GenericPlans,
+					stmt.CustomPlans,
+				}
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "name", Type: pgtypes.Text},
+		{Name: "statement", Type: pgtypes.Text},
+		{Name: "prepare_time", Type: pgtypes.TimestampTZ},
+		{Name: "parameter_types", Type: pgtypes.TextArray},
+		{Name: "result_types", Type: pgtypes.TextArray},
+		{Name: "from_sql", Type: pgtypes.Bool},
+		{Name: "generic_plans", Type: pgtypes.Int64},
+		{Name: "custom_plans", Type: pgtypes.Int64},
+	},
+	ReturnsSet: true,
+}
+
+// typeNames returns the names of the types with the given OIDs, or NULL when there are no types.
+func typeNames(oids []uint32) any {
+	if len(oids) == 0 {
+		return nil
+	}
+	names := make([]any, len(oids))
+	for i, typeOid := range oids {
+		if typ, ok := types.OidToType[oid.Oid(typeOid)]; ok {
+			names[i] = typ.SQLStandardName()
+		} else {
+			names[i] = "unknown"
+		}
+	}
+	return names
+}
@@ -0,0 +1,328 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"sort"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/lib/pq/oid"
+
+	"github.com/dolthub/doltgresql/core"
+	corefunctions "github.com/dolthub/doltgresql/core/functions"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgProcList registers the functions to the catalog.
+func initPgProcList() {
+	framework.RegisterFunction(pg_proc_list)
+}
+
+// pg_proc_list is the source of the pg_proc table, returning every built-in function followed by the functions and
+// procedures of the current database. This function is specific to Doltgres.
+var pg_proc_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_proc_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			functions, err := catalogFunctions(ctx)
+			if err != nil {
+				return nil, err
+			}
+			rows := make([][]any, len(functions))
+			for i, function := range functions {
+				rows[i] = function.pgProcRow()
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "oid", Type: pgtypes.Oid},
+		{Name: "proname", Type: pgtypes.Name},
+		{Name: "pronamespace", Type: pgtypes.Oid},
+		{Name: "proowner", Type: pgtypes.Oid},
+		{Name: "prokind", Type: pgtypes.Text},
+		{Name: "prosecdef", Type: pgtypes.Bool},
+		{Name: "proleakproof", Type: pgtypes.Bool},
+		{Name: "proretset", Type: pgtypes.Bool},
+		{Name: "provolatile", Type: pgtypes.Text},
+		{Name: "pronargs", Type: pgtypes.Int16},
+		{Name: "pronargdefaults", Type: pgtypes.Int16},
+		{Name: "prorettype", Type: pgtypes.Oid},
+		{Name: "proargtypes", Type: pgtypes.OidArray},
+		{Name: "proallargtypes", Type: pgtypes.OidArray},
+		{Name: "proargmodes", Type: pgtypes.TextArray},
+		{Name: "proargnames", Type: pgtypes.TextArray},
+		{Name: "prosrc", Type: pgtypes.Text},
+	},
+	ReturnsSet: true,
+}
+
+// catalogFunction is a function or procedure, as it is presented by pg_proc.
+type catalogFunction struct {
+	oid        uint32
+	schema     string
+	name       string
+	owner      uint32
+	prokind    string
+	volatile   bool
+	params     []catalogFunctionParameter
+	returnType pgtypes.DoltgresType
+	returnsSet bool
+	// definition is the body of a user-defined function, which is empty for built-in functions.
+	definition string
+}
+
+// catalogFunctionParameter is a parameter of a catalogFunction.
+type catalogFunctionParameter struct {
+	name        string
+	mode        corefunctions.ParameterMode
+	typ         pgtypes.DoltgresType
+	defaultExpr string
+}
+
+// catalogFunctions returns every built-in function in order of their names, followed by the functions and procedures
+// of the current database in order of their schemas and names.
+func catalogFunctions(ctx *sql.Context) ([]catalogFunction, error) {
+	var functions []catalogFunction
+	bootstrapOid := roleOid(auth.BootstrapRole)
+	names := make([]string, 0, len(framework.Catalog))
+	for name := range framework.Catalog {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, overload := range framework.Catalog[name] {
+			params := make([]catalogFunctionParameter, len(overload.GetParameters()))
+			for i, param := range overload.GetParameters() {
+				params[i] = catalogFunctionParameter{mode: corefunctions.ParameterMode_In, typ: param}
+			}
+			recordFunction, ok := overload.(framework.RecordFunction)
+			function := catalogFunction{
+				schema:     "pg_catalog",
+				name:       name,
+				owner:      bootstrapOid,
+				prokind:    "f",
+				volatile:   overload.GetIsNonDeterministic(),
+				params:     params,
+				returnType: overload.GetReturn(),
+				returnsSet: ok && recordFunction.ReturnsSet,
+			}
+			function.oid = functionOid(function.schema, function.name, function.argTypes())
+			functions = append(functions, function)
+		}
+	}
+	collection, err := core.GetFunctionsCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	database := ctx.GetCurrentDatabase()
+	var userFunctions []catalogFunction
+	err = collection.IterateFunctions(func(schema string, userFunction *corefunctions.Function) error {
+		function := catalogFunction{
+			schema: schema,
+			name:   userFunction.Name,
+			// Functions do not have their own owners, so they are owned by the owner of their schema
+			owner:      ownerOid(auth.SchemaObject(database, schema)),
+			prokind:    "f",
+			volatile:   true,
+			params:     make([]catalogFunctionParameter, len(userFunction.Parameters)),
+			returnsSet: userFunction.ReturnsSet,
+			definition: userFunction.Definition,
+		}
+		if userFunction.Kind == corefunctions.Kind_Procedure {
+			function.prokind = "p"
+		}
+		for i, param := range userFunction.Parameters {
+			typ, err := deserializeCatalogType(param.Type)
+			if err != nil {
+				return err
+			}
+			function.params[i] = catalogFunctionParameter{
+				name:        param.Name,
+				mode:        param.Mode,
+				typ:         typ,
+				defaultExpr: param.Default,
+			}
+		}
+		if len(userFunction.ReturnType) > 0 {
+			typ, err := deserializeCatalogType(userFunction.ReturnType)
+			if err != nil {
+				return err
+			}
+			function.returnType = typ
+		}
+		function.oid = functionOid(function.schema, function.name, function.argTypes())
+		userFunctions = append(userFunctions, function)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(userFunctions, func(i, j int) bool {
+		if namespaceOid(userFunctions[i].schema) != namespaceOid(userFunctions[j].schema) {
+			return namespaceOid(userFunctions[i].schema) < namespaceOid(userFunctions[j].schema)
+		}
+		return userFunctions[i].name < userFunctions[j].name
+	})
+	return append(functions, userFunctions...), nil
+}
+
+// findCatalogFunction returns the function or procedure with the given OID.
+func findCatalogFunction(ctx *sql.Context, functionOid uint32) (catalogFunction, bool, error) {
+	functions, err := catalogFunctions(ctx)
+	if err != nil {
+		return catalogFunction{}, false, err
+	}
+	for _, function := range functions {
+		if function.oid == functionOid {
+			return function, true, nil
+		}
+	}
+	return catalogFunction{}, false, nil
+}
+
+// argTypes returns the OIDs of the types of the function's input parameters, which identify the function alongside its
+// name.
+func (function catalogFunction) argTypes() []uint32 {
+	var argTypes []uint32
+	for _, param := range function.params {
+		if param.mode != corefunctions.ParameterMode_Out {
+			argTypes = append(argTypes, param.typ.OID())
+		}
+	}
+	return argTypes
+}
+
+// returnTypeOid returns the prorettype of the function. Functions whose return type is determined by their output
+// parameters return a record, while procedures do not return anything.
+func (function catalogFunction) returnTypeOid() uint32 {
+	if function.returnType != nil {
+		return function.returnType.OID()
+	} else if function.prokind == "p" {
+		return 0
+	}
+	return uint32(oid.T_record)
+}
+
+// pgProcRow returns the pg_proc row of the function.
+func (function catalogFunction) pgProcRow() []any {
+	provolatile := "i"
+	if function.volatile {
+		provolatile = "v"
+	}
+	row := pgProcRow(function.schema, function.name, function.owner, function.prokind, function.returnsSet, provolatile,
+		function.returnTypeOid(), function.argTypes())
+	argDefaults := int16(0)
+	hasOutputs := false
+	hasNames := false
+	allArgTypes := make([]any, len(function.params))
+	argModes := make([]any, len(function.params))
+	argNames := make([]any, len(function.params))
+	for i, param := range function.params {
+		if param.mode != corefunctions.ParameterMode_In {
+			hasOutputs = true
+		}
+		if len(param.name) > 0 {
+			hasNames = true
+		}
+		if len(param.defaultExpr) > 0 {
+			argDefaults++
+		}
+		allArgTypes[i] = param.typ.OID()
+		argModes[i] = parameterModeCode(param.mode)
+		argNames[i] = param.name
+	}
+	row[pgProcColumn_pronargdefaults] = argDefaults
+	if hasOutputs {
+		row[pgProcColumn_proallargtypes] = allArgTypes
+		row[pgProcColumn_proargmodes] = argModes
+	}
+	if hasNames {
+		row[pgProcColumn_proargnames] = argNames
+	}
+	if len(function.definition) > 0 {
+		row[pgProcColumn_prosrc] = function.definition
+	}
+	return row
+}
+
+// These are the positions of the pg_proc columns that are only set for some functions.
+const (
+	pgProcColumn_proname         = 1
+	pgProcColumn_pronamespace    = 2
+	pgProcColumn_pronargdefaults = 10
+	pgProcColumn_proallargtypes  = 13
+	pgProcColumn_proargmodes     = 14
+	pgProcColumn_proargnames     = 15
+	pgProcColumn_prosrc          = 16
+)
+
+// pgProcRow returns a pg_proc row for the given function.
+func pgProcRow(schema string, name string, owner uint32, prokind string, proretset bool, provolatile string, prorettype uint32, argTypes []uint32) []any {
+	proargtypes := make([]any, len(argTypes))
+	for i, argType := range argTypes {
+		proargtypes[i] = argType
+	}
+	return []any{
+		functionOid(schema, name, argTypes),
+		name,
+		namespaceOid(schema),
+		owner,
+		prokind,
+		false,
+		false,
+		proretset,
+		provolatile,
+		int16(len(argTypes)),
+		int16(0),
+		prorettype,
+		proargtypes,
+		nil,
+		nil,
+		nil,
+		nil,
+	}
+}
+
+// deserializeCatalogType returns the type from the given serialized type, as stored by user-defined functions.
+func deserializeCatalogType(serializedType []byte) (pgtypes.DoltgresType, error) {
+	typ, err := pgtypes.DeserializeType(serializedType)
+	if err != nil {
+		return nil, err
+	}
+	if doltgresType, ok := typ.(pgtypes.DoltgresType); ok {
+		return doltgresType, nil
+	}
+	return pgtypes.Unknown, nil
+}
+
+// parameterModeCode returns the code that pg_proc uses for the given parameter mode.
+func parameterModeCode(mode corefunctions.ParameterMode) string {
+	switch mode {
+	case corefunctions.ParameterMode_Out:
+		return "o"
+	case corefunctions.ParameterMode_InOut:
+		return "b"
+	case corefunctions.ParameterMode_Variadic:
+		return "v"
+	default:
+		return "i"
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/publications"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgPublicationList registers the functions to the catalog.
+func initPgPublicationList() {
+	framework.RegisterFunction(pg_publication_list)
+}
+
+// pg_publication_list is the source of the pg_publication table. This function is specific to Doltgres.
+var pg_publication_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_publication_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			collection, err := core.GetPublicationsCollectionFromContext(ctx)
+			if err != nil {
+				return nil, err
+			}
+			var rows [][]any
+			err = collection.IteratePublications(func(p *publications.Publication) error {
+				rows = append(rows, []any{publicationOid(p.Name), p.Name, roleOid(p.Owner), p.AllTables,
+					p.Insert, p.Update, p.Delete, p.Truncate, false})
+				return nil
+			})
+			return rows, err
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "oid", Type: pgtypes.Oid},
+		{Name: "pubname", Type: pgtypes.Name},
+		{Name: "pubowner", Type: pgtypes.Oid},
+		{Name: "puballtables", Type: pgtypes.Bool},
+		{Name: "pubinsert", Type: pgtypes.Bool},
+		{Name: "pubupdate", Type: pgtypes.Bool},
+		{Name: "pubdelete", Type: pgtypes.Bool},
+		{Name: "pubtruncate", Type: pgtypes.Bool},
+		{Name: "pubviaroot", Type: pgtypes.Bool},
+	},
+	ReturnsSet: true,
+}
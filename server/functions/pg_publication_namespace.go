@@ -0,0 +1,46 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgPublicationNamespaceList registers the functions to the catalog.
+func initPgPublicationNamespaceList() {
+	framework.RegisterFunction(pg_publication_namespace_list)
+}
+
+// pg_publication_namespace_list is the source of the pg_publication_namespace table. We do not support publications, so this always returns an empty set. This function is specific to Doltgres.
+var pg_publication_namespace_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_publication_namespace_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			return [][]any{}, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "oid", Type: pgtypes.Oid},
+		{Name: "pnpubid", Type: pgtypes.Oid},
+		{Name: "pnnspid", Type: pgtypes.Oid},
+	},
+	ReturnsSet: true,
+}
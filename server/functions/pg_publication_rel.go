@@ -0,0 +1,63 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/publications"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgPublicationRelList registers the functions to the catalog.
+func initPgPublicationRelList() {
+	framework.RegisterFunction(pg_publication_rel_list)
+}
+
+// pg_publication_rel_list is the source of the pg_publication_rel table, returning the tables that were explicitly added
+// to a publication. Publications of all tables do not have any rows, as in Postgres. This function is specific to
+// Doltgres.
+var pg_publication_rel_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_publication_rel_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			collection, err := core.GetPublicationsCollectionFromContext(ctx)
+			if err != nil {
+				return nil, err
+			}
+			var rows [][]any
+			err = collection.IteratePublications(func(p *publications.Publication) error {
+				for _, table := range p.Tables {
+					rows = append(rows, []any{publicationRelOid(p.Name, table), publicationOid(p.Name), relationOid(table), nil, nil})
+				}
+				return nil
+			})
+			return rows, err
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "oid", Type: pgtypes.Oid},
+		{Name: "prpubid", Type: pgtypes.Oid},
+		{Name: "prrelid", Type: pgtypes.Oid},
+		{Name: "prqual", Type: pgtypes.Text},
+		{Name: "prattrs", Type: pgtypes.Int16Array},
+	},
+	ReturnsSet: true,
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/publications"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgPublicationTableList registers the functions to the catalog.
+func initPgPublicationTableList() {
+	framework.RegisterFunction(pg_publication_table_list)
+}
+
+// pg_publication_table_list is the source of the pg_publication_tables view, returning every table that is published
+// by each publication. Publications of all tables return every table of the current database. This function is
+// specific to Doltgres.
+var pg_publication_table_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_publication_table_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			collection, err := core.GetPublicationsCollectionFromContext(ctx)
+			if err != nil {
+				return nil, err
+			}
+			tables, err := catalogTables(ctx)
+			if err != nil {
+				return nil, err
+			}
+			var rows [][]any
+			err = collection.IteratePublications(func(p *publications.Publication) error {
+				for _, table := range tables {
+					if !p.Publishes(table.name) {
+						continue
+					}
+					attnames := make([]any, len(table.columns))
+					for i, col := range table.columns {
+						attnames[i] = col.Name
+					}
+					rows = append(rows, []any{p.Name, table.name.Schema, table.name.Name, attnames, nil})
+				}
+				return nil
+			})
+			return rows, err
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "pubname", Type: pgtypes.Name},
+		{Name: "schemaname", Type: pgtypes.Name},
+		{Name: "tablename", Type: pgtypes.Name},
+		{Name: "attnames", Type: pgtypes.NameArray},
+		{Name: "rowfilter", Type: pgtypes.Text},
+	},
+	ReturnsSet: true,
+}
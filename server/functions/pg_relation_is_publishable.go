@@ -0,0 +1,57 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgRelationIsPublishable registers the functions to the catalog.
+func initPgRelationIsPublishable() {
+	framework.RegisterFunction(pg_relation_is_publishable_regclass)
+}
+
+// pg_relation_is_publishable_regclass represents the PostgreSQL function of the same name, taking the same parameters.
+// Permanent tables and partitioned tables are publishable, while every other kind of relation is not.
+var pg_relation_is_publishable_regclass = framework.Function1{
+	Name:               "pg_relation_is_publishable",
+	Return:             pgtypes.Bool,
+	Parameters:         []pgtypes.DoltgresType{pgtypes.Regclass},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		if val == nil {
+			return nil, nil
+		}
+		rows, err := pgClassRows(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			if row[0] != val.(uint32) {
+				continue
+			}
+			switch row[pgClassColumn_relkind] {
+			case "r", "p":
+				return row[pgClassColumn_relpersistence] == "p", nil
+			default:
+				return false, nil
+			}
+		}
+		return nil, nil
+	},
+}
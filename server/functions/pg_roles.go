@@ -0,0 +1,86 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgRoleList registers the functions to the catalog.
+func initPgRoleList() {
+	framework.RegisterFunction(pg_role_list)
+}
+
+// pg_role_list is the source of the pg_roles view, returning every role. Postgres builds the view from its catalogs,
+// which we do not have, so this function is specific to Doltgres.
+var pg_role_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_role_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			roles := auth.Roles()
+			rows := make([][]any, len(roles))
+			for i, role := range roles {
+				// The password itself is never shown, only whether one has been set
+				var password any
+				if len(role.Password) > 0 {
+					password = "********"
+				}
+				var validUntil any
+				if !role.ValidUntil.IsZero() {
+					validUntil = role.ValidUntil
+				}
+				rows[i] = []any{
+					role.Name,
+					role.IsSuperUser,
+					role.Inherit,
+					role.CanCreateRoles,
+					role.CanCreateDB,
+					role.CanLogin,
+					role.IsReplication,
+					role.ConnectionLimit,
+					password,
+					validUntil,
+					role.BypassRLS,
+					nil,
+					role.OID,
+				}
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "rolname", Type: pgtypes.Name},
+		{Name: "rolsuper", Type: pgtypes.Bool},
+		{Name: "rolinherit", Type: pgtypes.Bool},
+		{Name: "rolcreaterole", Type: pgtypes.Bool},
+		{Name: "rolcreatedb", Type: pgtypes.Bool},
+		{Name: "rolcanlogin", Type: pgtypes.Bool},
+		{Name: "rolreplication", Type: pgtypes.Bool},
+		{Name: "rolconnlimit", Type: pgtypes.Int32},
+		{Name: "rolpassword", Type: pgtypes.Text},
+		{Name: "rolvaliduntil", Type: pgtypes.TimestampTZ},
+		{Name: "rolbypassrls", Type: pgtypes.Bool},
+		{Name: "rolconfig", Type: pgtypes.TextArray},
+		{Name: "oid", Type: pgtypes.Oid},
+	},
+	ReturnsSet: true,
+}
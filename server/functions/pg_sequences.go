@@ -0,0 +1,102 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"sort"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/sequences"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgSequenceList registers the functions to the catalog.
+func initPgSequenceList() {
+	framework.RegisterFunction(pg_sequence_list)
+}
+
+// pg_sequence_list is the source of the pg_sequences view, returning every sequence of the current database. Postgres
+// builds the view from its catalogs, which we do not have, so this function is specific to Doltgres.
+var pg_sequence_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_sequence_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			collection, err := core.GetCollectionFromContext(ctx)
+			if err != nil {
+				return nil, err
+			}
+			var rows [][]any
+			err = collection.IterateSequences(func(schema string, seq *sequences.Sequence) error {
+				rows = append(rows, []any{
+					schema,
+					seq.Name,
+					seq.OwnerUser,
+					typeNames([]uint32{seq.DataTypeOID}).([]any)[0],
+					seq.Start,
+					seq.Minimum,
+					seq.Maximum,
+					seq.Increment,
+					seq.Cycle,
+					seq.Cache,
+					sequenceLastValue(seq),
+				})
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			sort.Slice(rows, func(i, j int) bool {
+				if rows[i][0] != rows[j][0] {
+					return rows[i][0].(string) < rows[j][0].(string)
+				}
+				return rows[i][1].(string) < rows[j][1].(string)
+			})
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "schemaname", Type: pgtypes.Name},
+		{Name: "sequencename", Type: pgtypes.Name},
+		{Name: "sequenceowner", Type: pgtypes.Name},
+		{Name: "data_type", Type: pgtypes.Text},
+		{Name: "start_value", Type: pgtypes.Int64},
+		{Name: "min_value", Type: pgtypes.Int64},
+		{Name: "max_value", Type: pgtypes.Int64},
+		{Name: "increment_by", Type: pgtypes.Int64},
+		{Name: "cycle", Type: pgtypes.Bool},
+		{Name: "cache_size", Type: pgtypes.Int64},
+		{Name: "last_value", Type: pgtypes.Int64},
+	},
+	ReturnsSet: true,
+}
+
+// sequenceLastValue returns the value that was last returned by the sequence, or NULL if the sequence has not returned
+// a value since it was created or restarted. The sequence only tracks the next value to return, so a sequence whose next
+// value is its start value is treated as not having returned a value.
+func sequenceLastValue(seq *sequences.Sequence) any {
+	if seq.IsAtEnd {
+		return seq.Current
+	}
+	if seq.Current == seq.Start {
+		return nil
+	}
+	return seq.Current - seq.Increment
+}
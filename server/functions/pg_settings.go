@@ -0,0 +1,118 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/config"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgSettingsList registers the functions to the catalog.
+func initPgSettingsList() {
+	framework.RegisterFunction(pg_settings_list)
+}
+
+// pg_settings_list is the source of the pg_settings view, returning every configuration parameter along with the
+// session's value of each. A parameter's source is "session" when the session has changed its value. This function is
+// specific to Doltgres.
+var pg_settings_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_settings_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			params := config.Parameters()
+			rows := make([][]any, 0, len(params))
+			for _, param := range params {
+				value, err := ctx.GetSessionVariable(ctx, param.Name)
+				if err != nil {
+					return nil, err
+				}
+				setting := settingDisplayValue(param, value)
+				resetVal := settingDisplayValue(param, param.ResetVal)
+				source := string(param.Source)
+				if setting != resetVal {
+					source = "session"
+				}
+				var unit, minVal, maxVal, enumVals any
+				if len(param.Unit) > 0 {
+					unit = param.Unit
+				}
+				if lower, upper, ok := param.Bounds(); ok {
+					minVal, maxVal = lower, upper
+				}
+				if values := param.EnumValues(); values != nil {
+					enumValues := make([]any, len(values))
+					for i, value := range values {
+						enumValues[i] = value
+					}
+					enumVals = enumValues
+				}
+				rows = append(rows, []any{
+					param.Name,
+					setting,
+					unit,
+					param.Category,
+					param.ShortDesc,
+					nil,
+					string(param.Context),
+					param.VarType(),
+					source,
+					minVal,
+					maxVal,
+					enumVals,
+					settingDisplayValue(param, param.BootValue()),
+					resetVal,
+					nil,
+					nil,
+					false,
+				})
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "name", Type: pgtypes.Text},
+		{Name: "setting", Type: pgtypes.Text},
+		{Name: "unit", Type: pgtypes.Text},
+		{Name: "category", Type: pgtypes.Text},
+		{Name: "short_desc", Type: pgtypes.Text},
+		{Name: "extra_desc", Type: pgtypes.Text},
+		{Name: "context", Type: pgtypes.Text},
+		{Name: "vartype", Type: pgtypes.Text},
+		{Name: "source", Type: pgtypes.Text},
+		{Name: "min_val", Type: pgtypes.Text},
+		{Name: "max_val", Type: pgtypes.Text},
+		{Name: "enumvals", Type: pgtypes.TextArray},
+		{Name: "boot_val", Type: pgtypes.Text},
+		{Name: "reset_val", Type: pgtypes.Text},
+		{Name: "sourcefile", Type: pgtypes.Text},
+		{Name: "sourceline", Type: pgtypes.Int32},
+		{Name: "pending_restart", Type: pgtypes.Bool},
+	},
+	ReturnsSet: true,
+}
+
+// settingDisplayValue returns the value of the parameter as pg_settings displays it, which is NULL for NULL values.
+func settingDisplayValue(param *config.Parameter, value any) any {
+	if value == nil {
+		return nil
+	}
+	return config.DisplayValue(param, value)
+}
@@ -0,0 +1,49 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+	"github.com/dolthub/doltgresql/server/pgstat"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgStatReset registers the functions to the catalog.
+func initPgStatReset() {
+	framework.RegisterFunction(pg_stat_reset)
+}
+
+// pg_stat_reset represents the PostgreSQL function of the same name, taking the same parameters. Only superusers may
+// reset the statistics.
+var pg_stat_reset = framework.Function0{
+	Name:               "pg_stat_reset",
+	Return:             pgtypes.Void,
+	Parameters:         []pgtypes.DoltgresType{},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+		if role, ok := auth.GetRole(informationSchemaRole(ctx)); !ok || !role.IsSuperUser {
+			return nil, pgerrors.Raise(ctx, pgerrors.New(pgcode.InsufficientPrivilege, "permission denied for function pg_stat_reset"))
+		}
+		database, _ := dsess.SplitRevisionDbName(ctx.GetCurrentDatabase())
+		pgstat.Reset(database)
+		return "", nil
+	},
+}
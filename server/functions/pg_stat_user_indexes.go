@@ -0,0 +1,77 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/pgstat"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgStatUserIndexList registers the functions to the catalog.
+func initPgStatUserIndexList() {
+	framework.RegisterFunction(pg_stat_user_index_list)
+}
+
+// pg_stat_user_index_list is the source of the pg_stat_user_indexes view, returning the statistics of every index in
+// the current database. Every row that an index scan reads is fetched from the table, so both tuple counts are the
+// same. This function is specific to Doltgres.
+var pg_stat_user_index_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_stat_user_index_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			tables, err := catalogTables(ctx)
+			if err != nil {
+				return nil, err
+			}
+			var rows [][]any
+			for _, table := range tables {
+				rel := statisticsRelation(ctx, table.name)
+				for _, index := range table.indexes() {
+					counters := pgstat.Index(rel, index.name)
+					rows = append(rows, []any{
+						table.oid,
+						index.oid,
+						table.name.Schema,
+						table.name.Name,
+						index.name,
+						counters.IdxScan,
+						statisticsTime(counters.LastIdxScan),
+						counters.IdxTupRead,
+						counters.IdxTupFetch,
+					})
+				}
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "relid", Type: pgtypes.Oid},
+		{Name: "indexrelid", Type: pgtypes.Oid},
+		{Name: "schemaname", Type: pgtypes.Name},
+		{Name: "relname", Type: pgtypes.Name},
+		{Name: "indexrelname", Type: pgtypes.Name},
+		{Name: "idx_scan", Type: pgtypes.Int64},
+		{Name: "last_idx_scan", Type: pgtypes.TimestampTZ},
+		{Name: "idx_tup_read", Type: pgtypes.Int64},
+		{Name: "idx_tup_fetch", Type: pgtypes.Int64},
+	},
+	ReturnsSet: true,
+}
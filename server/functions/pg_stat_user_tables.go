@@ -0,0 +1,147 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"time"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/pgstat"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgStatUserTableList registers the functions to the catalog.
+func initPgStatUserTableList() {
+	framework.RegisterFunction(pg_stat_user_table_list)
+}
+
+// pg_stat_user_table_list is the source of the pg_stat_user_tables view, returning the statistics of every table in
+// the current database. Index scans are the sums of the scans of the table's indexes, which are NULL for tables
+// without indexes. Rows are never left behind by updates and deletes, and tables are never vacuumed, so the columns
+// that count them are always zero. This function is specific to Doltgres.
+var pg_stat_user_table_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_stat_user_table_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			tables, err := catalogTables(ctx)
+			if err != nil {
+				return nil, err
+			}
+			rows := make([][]any, 0, len(tables))
+			for _, table := range tables {
+				rel := statisticsRelation(ctx, table.name)
+				counters := pgstat.Table(rel)
+				liveRows, err := table.rowCount(ctx)
+				if err != nil {
+					return nil, err
+				}
+				var idxScan, idxTupFetch any
+				var lastIdxScan time.Time
+				if indexes := table.indexes(); len(indexes) > 0 {
+					var scans, fetches int64
+					for _, index := range indexes {
+						indexCounters := pgstat.Index(rel, index.name)
+						scans += indexCounters.IdxScan
+						fetches += indexCounters.IdxTupFetch
+						if indexCounters.LastIdxScan.After(lastIdxScan) {
+							lastIdxScan = indexCounters.LastIdxScan
+						}
+					}
+					idxScan, idxTupFetch = scans, fetches
+				}
+				rows = append(rows, []any{
+					table.oid,
+					table.name.Schema,
+					table.name.Name,
+					counters.SeqScan,
+					statisticsTime(counters.LastSeqScan),
+					counters.SeqTupRead,
+					idxScan,
+					statisticsTime(lastIdxScan),
+					idxTupFetch,
+					counters.TupIns,
+					counters.TupUpd,
+					counters.TupDel,
+					int64(0),
+					int64(0),
+					liveRows,
+					int64(0),
+					counters.ModSinceAnalyze,
+					counters.TupIns,
+					nil,
+					nil,
+					statisticsTime(counters.LastAnalyze),
+					nil,
+					int64(0),
+					int64(0),
+					counters.AnalyzeCount,
+					int64(0),
+				})
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "relid", Type: pgtypes.Oid},
+		{Name: "schemaname", Type: pgtypes.Name},
+		{Name: "relname", Type: pgtypes.Name},
+		{Name: "seq_scan", Type: pgtypes.Int64},
+		{Name: "last_seq_scan", Type: pgtypes.TimestampTZ},
+		{Name: "seq_tup_read", Type: pgtypes.Int64},
+		{Name: "idx_scan", Type: pgtypes.Int64},
+		{Name: "last_idx_scan", Type: pgtypes.TimestampTZ},
+		{Name: "idx_tup_fetch", Type: pgtypes.Int64},
+		{Name: "n_tup_ins", Type: pgtypes.Int64},
+		{Name: "n_tup_upd", Type: pgtypes.Int64},
+		{Name: "n_tup_del", Type: pgtypes.Int64},
+		{Name: "n_tup_hot_upd", Type: pgtypes.Int64},
+		{Name: "n_tup_newpage_upd", Type: pgtypes.Int64},
+		{Name: "n_live_tup", Type: pgtypes.Int64},
+		{Name: "n_dead_tup", Type: pgtypes.Int64},
+		{Name: "n_mod_since_analyze", Type: pgtypes.Int64},
+		{Name: "n_ins_since_vacuum", Type: pgtypes.Int64},
+		{Name: "last_vacuum", Type: pgtypes.TimestampTZ},
+		{Name: "last_autovacuum", Type: pgtypes.TimestampTZ},
+		{Name: "last_analyze", Type: pgtypes.TimestampTZ},
+		{Name: "last_autoanalyze", Type: pgtypes.TimestampTZ},
+		{Name: "vacuum_count", Type: pgtypes.Int64},
+		{Name: "autovacuum_count", Type: pgtypes.Int64},
+		{Name: "analyze_count", Type: pgtypes.Int64},
+		{Name: "autoanalyze_count", Type: pgtypes.Int64},
+	},
+	ReturnsSet: true,
+}
+
+// statisticsRelation returns the relation that the statistics of the given table in the current database are kept
+// under.
+func statisticsRelation(ctx *sql.Context, name doltdb.TableName) pgstat.Relation {
+	database, _ := dsess.SplitRevisionDbName(ctx.GetCurrentDatabase())
+	return pgstat.Relation{Database: database, Schema: name.Schema, Name: name.Name}
+}
+
+// statisticsTime returns the given time of a statistic, which is NULL when the statistic has never been recorded.
+func statisticsTime(t time.Time) any {
+	if t.IsZero() {
+		return nil
+	}
+	return t
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgStatioUserIndexList registers the functions to the catalog.
+func initPgStatioUserIndexList() {
+	framework.RegisterFunction(pg_statio_user_index_list)
+}
+
+// pg_statio_user_index_list is the source of the pg_statio_user_indexes view, returning the block statistics of every
+// index in the current database. As with pg_statio_user_tables, there is no buffer cache, so every count is zero. This
+// function is specific to Doltgres.
+var pg_statio_user_index_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_statio_user_index_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			tables, err := catalogTables(ctx)
+			if err != nil {
+				return nil, err
+			}
+			var rows [][]any
+			for _, table := range tables {
+				for _, index := range table.indexes() {
+					rows = append(rows, []any{
+						table.oid,
+						index.oid,
+						table.name.Schema,
+						table.name.Name,
+						index.name,
+						int64(0),
+						int64(0),
+					})
+				}
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "relid", Type: pgtypes.Oid},
+		{Name: "indexrelid", Type: pgtypes.Oid},
+		{Name: "schemaname", Type: pgtypes.Name},
+		{Name: "relname", Type: pgtypes.Name},
+		{Name: "indexrelname", Type: pgtypes.Name},
+		{Name: "idx_blks_read", Type: pgtypes.Int64},
+		{Name: "idx_blks_hit", Type: pgtypes.Int64},
+	},
+	ReturnsSet: true,
+}
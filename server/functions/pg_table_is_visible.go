@@ -0,0 +1,77 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgTableIsVisible registers the functions to the catalog.
+func initPgTableIsVisible() {
+	framework.RegisterFunction(pg_table_is_visible_oid)
+}
+
+// pg_table_is_visible_oid represents the PostgreSQL function of the same name, taking the same parameters.
+var pg_table_is_visible_oid = framework.Function1{
+	Name:               "pg_table_is_visible",
+	Return:             pgtypes.Bool,
+	Parameters:         []pgtypes.DoltgresType{pgtypes.Oid},
+	IsNonDeterministic: true,
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val any) (any, error) {
+		if val == nil {
+			return nil, nil
+		}
+		rows, err := pgClassRows(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var relation []any
+		for _, row := range rows {
+			if row[0] == val.(uint32) {
+				relation = row
+				break
+			}
+		}
+		if relation == nil {
+			return nil, nil
+		}
+		return pgClassRowIsVisible(ctx, rows, relation)
+	},
+}
+
+// pgClassRowIsVisible returns whether the given row of pg_class is visible, meaning that its unqualified name refers to
+// it. The given rows must be every row of pg_class.
+func pgClassRowIsVisible(ctx *sql.Context, rows [][]any, relation []any) (bool, error) {
+	searchPath, err := catalogSearchPath(ctx)
+	if err != nil {
+		return false, err
+	}
+	// A relation is visible when no relation with the same name is found in a schema that is searched before its own
+	for _, schemaName := range searchPath {
+		namespace := namespaceOid(schemaName)
+		if namespace == relation[pgClassColumn_relnamespace] {
+			return true, nil
+		}
+		for _, row := range rows {
+			if row[pgClassColumn_relnamespace] == namespace && row[pgClassColumn_relname] == relation[pgClassColumn_relname] {
+				return false, nil
+			}
+		}
+	}
+	return false, nil
+}
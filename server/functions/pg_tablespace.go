@@ -0,0 +1,55 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgTablespaceList registers the functions to the catalog.
+func initPgTablespaceList() {
+	framework.RegisterFunction(pg_tablespace_list)
+}
+
+// pg_tablespace_list is the source of the pg_tablespace table. Tablespaces are not supported, so this returns the two
+// tablespaces that every Postgres cluster has, which are owned by the bootstrap role. This function is specific to
+// Doltgres.
+var pg_tablespace_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_tablespace_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			owner := roleOid(auth.BootstrapRole)
+			return [][]any{
+				{uint32(defaultTablespaceOid), "pg_default", owner, nil, nil},
+				{uint32(globalTablespaceOid), "pg_global", owner, nil, nil},
+			}, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "oid", Type: pgtypes.Oid},
+		{Name: "spcname", Type: pgtypes.Name},
+		{Name: "spcowner", Type: pgtypes.Oid},
+		{Name: "spcacl", Type: pgtypes.TextArray},
+		{Name: "spcoptions", Type: pgtypes.TextArray},
+	},
+	ReturnsSet: true,
+}
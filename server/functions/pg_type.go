@@ -0,0 +1,121 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/lib/pq/oid"
+
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initPgTypeList registers the functions to the catalog.
+func initPgTypeList() {
+	framework.RegisterFunction(pg_type_list)
+}
+
+// pg_type_list is the source of the pg_type table, returning every built-in type. This function is specific to
+// Doltgres.
+var pg_type_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "pg_type_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			allTypes := pgtypes.GetAllTypes()
+			rows := make([][]any, len(allTypes))
+			for i, typ := range allTypes {
+				category := catalogTypeCategory(typ)
+				typtype := "b"
+				if category == "P" {
+					typtype = "p"
+				}
+				typelem := uint32(0)
+				typarray := uint32(0)
+				if category == "A" {
+					typelem = typ.(pgtypes.DoltgresArrayType).BaseType().OID()
+				} else if arrayType := typ.ToArrayType(); catalogTypeCategory(arrayType) == "A" {
+					typarray = arrayType.OID()
+				}
+				rows[i] = []any{
+					typ.OID(),
+					catalogTypeName(typ),
+					uint32(pgCatalogNamespaceOid),
+					roleOid(auth.BootstrapRole),
+					catalogTypeLength(typ),
+					catalogTypeByValue(typ),
+					typtype,
+					category,
+					isPreferredType(typ),
+					true,
+					",",
+					uint32(0),
+					typelem,
+					typarray,
+					catalogTypeAlignment(typ),
+					catalogTypeStorage(typ),
+					false,
+					uint32(0),
+					int32(-1),
+					int32(0),
+					catalogTypeCollation(typ),
+					nil,
+					nil,
+				}
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "oid", Type: pgtypes.Oid},
+		{Name: "typname", Type: pgtypes.Name},
+		{Name: "typnamespace", Type: pgtypes.Oid},
+		{Name: "typowner", Type: pgtypes.Oid},
+		{Name: "typlen", Type: pgtypes.Int16},
+		{Name: "typbyval", Type: pgtypes.Bool},
+		{Name: "typtype", Type: pgtypes.Text},
+		{Name: "typcategory", Type: pgtypes.Text},
+		{Name: "typispreferred", Type: pgtypes.Bool},
+		{Name: "typisdefined", Type: pgtypes.Bool},
+		{Name: "typdelim", Type: pgtypes.Text},
+		{Name: "typrelid", Type: pgtypes.Oid},
+		{Name: "typelem", Type: pgtypes.Oid},
+		{Name: "typarray", Type: pgtypes.Oid},
+		{Name: "typalign", Type: pgtypes.Text},
+		{Name: "typstorage", Type: pgtypes.Text},
+		{Name: "typnotnull", Type: pgtypes.Bool},
+		{Name: "typbasetype", Type: pgtypes.Oid},
+		{Name: "typtypmod", Type: pgtypes.Int32},
+		{Name: "typndims", Type: pgtypes.Int32},
+		{Name: "typcollation", Type: pgtypes.Oid},
+		{Name: "typdefault", Type: pgtypes.Text},
+		{Name: "typacl", Type: pgtypes.TextArray},
+	},
+	ReturnsSet: true,
+}
+
+// isPreferredType returns whether the given type is the preferred type of its category, which Postgres favors when
+// resolving ambiguous function calls.
+func isPreferredType(typ pgtypes.DoltgresType) bool {
+	switch oid.Oid(typ.OID()) {
+	case oid.T_bool, oid.T_text, oid.T_float8, oid.T_oid, oid.T_timestamptz, oid.T_interval:
+		return true
+	default:
+		return false
+	}
+}
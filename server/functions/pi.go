@@ -33,7 +33,7 @@ var pi = framework.Function0{
 	Name:       "pi",
 	Return:     pgtypes.Float64,
 	Parameters: []pgtypes.DoltgresType{},
-	Callable: func(ctx *sql.Context) (any, error) {
+	Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
 		return float64(math.Pi), nil
 	},
 }
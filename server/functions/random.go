@@ -33,7 +33,7 @@ var random = framework.Function0{
 	Name:       "random",
 	Return:     pgtypes.Float64,
 	Parameters: []pgtypes.DoltgresType{},
-	Callable: func(ctx *sql.Context) (any, error) {
+	Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
 		return rand.Float64(), nil
 	},
 }
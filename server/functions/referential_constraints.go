@@ -0,0 +1,108 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initReferentialConstraintList registers the functions to the catalog.
+func initReferentialConstraintList() {
+	framework.RegisterFunction(referential_constraint_list)
+}
+
+// referential_constraint_list is the source of the information_schema.referential_constraints view, returning the
+// foreign keys of the tables that the current role has access to. This function is specific to Doltgres.
+var referential_constraint_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "referential_constraint_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			constraints, err := informationSchemaConstraints(ctx)
+			if err != nil {
+				return nil, err
+			}
+			database := ctx.GetCurrentDatabase()
+			var rows [][]any
+			for _, constraint := range constraints {
+				if constraint.foreignKey == nil {
+					continue
+				}
+				var uniqueCatalog, uniqueSchema, uniqueName any
+				if constraint.parent != nil {
+					if index, ok := referencedIndex(*constraint.parent, constraint.parentAttnums); ok {
+						uniqueCatalog = database
+						uniqueSchema = constraint.parent.name.Schema
+						uniqueName = index.name
+					}
+				}
+				rows = append(rows, []any{
+					database,
+					constraint.table.name.Schema,
+					constraint.name,
+					uniqueCatalog,
+					uniqueSchema,
+					uniqueName,
+					"NONE",
+					referentialActionRule(constraint.foreignKey.OnUpdate),
+					referentialActionRule(constraint.foreignKey.OnDelete),
+				})
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "constraint_catalog", Type: pgtypes.Name},
+		{Name: "constraint_schema", Type: pgtypes.Name},
+		{Name: "constraint_name", Type: pgtypes.Name},
+		{Name: "unique_constraint_catalog", Type: pgtypes.Name},
+		{Name: "unique_constraint_schema", Type: pgtypes.Name},
+		{Name: "unique_constraint_name", Type: pgtypes.Name},
+		{Name: "match_option", Type: pgtypes.Text},
+		{Name: "update_rule", Type: pgtypes.Text},
+		{Name: "delete_rule", Type: pgtypes.Text},
+	},
+	ReturnsSet: true,
+}
+
+// referencedIndex returns the primary key or unique index of the table that covers exactly the given columns, which is
+// the constraint that a foreign key referencing those columns depends on.
+func referencedIndex(table catalogTable, attnums []any) (catalogIndex, bool) {
+	for _, index := range table.indexes() {
+		if !index.isUnique || len(index.attnums) != len(attnums) {
+			continue
+		}
+		matches := true
+		for _, attnum := range attnums {
+			found := false
+			for _, indexAttnum := range index.attnums {
+				if indexAttnum == attnum {
+					found = true
+					break
+				}
+			}
+			matches = matches && found
+		}
+		if matches {
+			return index, true
+		}
+	}
+	return catalogIndex{}, false
+}
@@ -0,0 +1,183 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/parser"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initRegNames sets the functions that convert between the OIDs of the reg* types and the names of the objects that
+// they identify, as these conversions require access to the catalog.
+func initRegNames() {
+	pgtypes.RegNameInput = regNameInput
+	pgtypes.RegNameOutput = regNameOutput
+}
+
+// regNameInput returns the OID of the object with the given name, which is the input function of the reg* types. The
+// kind of object is determined by the base ID of the reg* type. OIDs may also be given directly as numbers.
+func regNameInput(ctx *sql.Context, baseID pgtypes.DoltgresTypeBaseID, name string) (uint32, error) {
+	name = strings.TrimSpace(name)
+	if val, err := strconv.ParseUint(name, 10, 32); err == nil {
+		return uint32(val), nil
+	}
+	switch baseID {
+	case pgtypes.DoltgresTypeBaseID_Regclass:
+		return regclassInput(ctx, name)
+	case pgtypes.DoltgresTypeBaseID_Regnamespace:
+		return regnamespaceInput(ctx, name)
+	case pgtypes.DoltgresTypeBaseID_Regtype:
+		return regtypeInput(name)
+	default:
+		return 0, pgerrors.Newf(pgcode.InvalidTextRepresentation, `invalid input syntax for type oid: "%s"`, name)
+	}
+}
+
+// regNameOutput returns the name of the object with the given OID, which is the output function of the reg* types.
+// The kind of object is determined by the base ID of the reg* type. OIDs that do not identify an object are returned as
+// numbers.
+func regNameOutput(ctx *sql.Context, baseID pgtypes.DoltgresTypeBaseID, oid uint32) (string, error) {
+	if oid == 0 {
+		return "-", nil
+	}
+	var name string
+	var err error
+	switch baseID {
+	case pgtypes.DoltgresTypeBaseID_Regclass:
+		name, err = regclassOutput(ctx, oid)
+	case pgtypes.DoltgresTypeBaseID_Regnamespace:
+		name, err = regnamespaceOutput(ctx, oid)
+	case pgtypes.DoltgresTypeBaseID_Regtype:
+		if _, ok := catalogTypeByOid(oid); ok {
+			name = formatType(oid, -1, false)
+		}
+	}
+	if err != nil || len(name) > 0 {
+		return name, err
+	}
+	return strconv.FormatUint(uint64(oid), 10), nil
+}
+
+// regclassInput returns the OID of the relation with the given name, which may be qualified by its schema.
+// Unqualified names are found using the search path.
+func regclassInput(ctx *sql.Context, name string) (uint32, error) {
+	tableName, err := parser.ParseQualifiedTableName(name)
+	if err != nil {
+		return 0, pgerrors.Newf(pgcode.InvalidName, `invalid name syntax`)
+	}
+	rows, err := pgClassRows(ctx)
+	if err != nil {
+		return 0, err
+	}
+	relname := string(tableName.ObjectName)
+	var schemaNames []string
+	if tableName.ExplicitSchema {
+		schemaNames = []string{string(tableName.SchemaName)}
+	} else if schemaNames, err = catalogSearchPath(ctx); err != nil {
+		return 0, err
+	}
+	for _, schemaName := range schemaNames {
+		namespace := namespaceOid(schemaName)
+		for _, row := range rows {
+			if row[pgClassColumn_relnamespace] == namespace && row[pgClassColumn_relname] == relname {
+				return row[0].(uint32), nil
+			}
+		}
+	}
+	return 0, pgerrors.Newf(pgcode.UndefinedTable, `relation "%s" does not exist`, name)
+}
+
+// regclassOutput returns the name of the relation with the given OID, which is qualified by its schema when it is not
+// visible. Returns an empty string when the relation does not exist.
+func regclassOutput(ctx *sql.Context, oid uint32) (string, error) {
+	rows, err := pgClassRows(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, row := range rows {
+		if row[0] != oid {
+			continue
+		}
+		relname := tree.NameString(row[pgClassColumn_relname].(string))
+		if visible, err := pgClassRowIsVisible(ctx, rows, row); err != nil || visible {
+			return relname, err
+		}
+		schemaName, err := regnamespaceOutput(ctx, row[pgClassColumn_relnamespace].(uint32))
+		if err != nil {
+			return "", err
+		}
+		return schemaName + "." + relname, nil
+	}
+	return "", nil
+}
+
+// regnamespaceInput returns the OID of the schema with the given name.
+func regnamespaceInput(ctx *sql.Context, name string) (uint32, error) {
+	schemaNames, err := catalogSchemaNames(ctx)
+	if err != nil {
+		return 0, err
+	}
+	unquoted := unquoteIdentifier(name)
+	for _, schemaName := range schemaNames {
+		if schemaName == unquoted {
+			return namespaceOid(schemaName), nil
+		}
+	}
+	return 0, pgerrors.Newf(pgcode.InvalidSchemaName, `schema "%s" does not exist`, unquoted)
+}
+
+// regnamespaceOutput returns the name of the schema with the given OID. Returns an empty string when the schema does
+// not exist.
+func regnamespaceOutput(ctx *sql.Context, oid uint32) (string, error) {
+	schemaNames, err := catalogSchemaNames(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, schemaName := range schemaNames {
+		if namespaceOid(schemaName) == oid {
+			return tree.NameString(schemaName), nil
+		}
+	}
+	return "", nil
+}
+
+// regtypeInput returns the OID of the type with the given name. Types may be named by either their catalog name (such
+// as int4) or their SQL name (such as integer), and may be qualified by the pg_catalog schema.
+func regtypeInput(name string) (uint32, error) {
+	typeName := strings.ToLower(strings.TrimPrefix(name, "pg_catalog."))
+	for _, typ := range pgtypes.GetAllTypes() {
+		if typeName == catalogTypeName(typ) || typeName == formatType(typ.OID(), -1, false) {
+			return typ.OID(), nil
+		}
+	}
+	return 0, pgerrors.Newf(pgcode.UndefinedObject, `type "%s" does not exist`, name)
+}
+
+// unquoteIdentifier returns the identifier that the given name refers to. Quoted names are case-sensitive, while
+// unquoted names are folded to lowercase.
+func unquoteIdentifier(name string) string {
+	if len(name) >= 2 && name[0] == '"' && name[len(name)-1] == '"' {
+		return strings.ReplaceAll(name[1:len(name)-1], `""`, `"`)
+	}
+	return strings.ToLower(name)
+}
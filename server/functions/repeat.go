@@ -33,7 +33,7 @@ var repeat_varchar_int32 = framework.Function2{
 	Name:       "repeat",
 	Return:     pgtypes.VarChar,
 	Parameters: []pgtypes.DoltgresType{pgtypes.VarChar, pgtypes.Int32},
-	Callable: func(ctx *sql.Context, str any, num any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, str any, num any) (any, error) {
 		if str == nil || num == nil {
 			return nil, nil
 		}
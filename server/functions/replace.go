@@ -33,7 +33,7 @@ var replace_varchar_varchar_varchar = framework.Function3{
 	Name:       "replace",
 	Return:     pgtypes.VarChar,
 	Parameters: []pgtypes.DoltgresType{pgtypes.VarChar, pgtypes.VarChar, pgtypes.VarChar},
-	Callable: func(ctx *sql.Context, str any, from any, to any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, str any, from any, to any) (any, error) {
 		if str == nil || from == nil || to == nil {
 			return nil, nil
 		}
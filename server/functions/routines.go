@@ -0,0 +1,171 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	corefunctions "github.com/dolthub/doltgresql/core/functions"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initRoutineList registers the functions to the catalog.
+func initRoutineList() {
+	framework.RegisterFunction(routine_list)
+}
+
+// routine_list is the source of the information_schema.routines view, returning every built-in function followed by
+// the functions and procedures of the current database. This function is specific to Doltgres.
+var routine_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "routine_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			database := ctx.GetCurrentDatabase()
+			var rows [][]any
+			names := make([]string, 0, len(framework.Catalog))
+			for name := range framework.Catalog {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				for _, overload := range framework.Catalog[name] {
+					argTypes := make([]uint32, len(overload.GetParameters()))
+					for i, param := range overload.GetParameters() {
+						argTypes[i] = param.OID()
+					}
+					rows = append(rows, routineRow(database, "pg_catalog", name, functionOid("pg_catalog", name, argTypes),
+						"FUNCTION", overload.GetReturn(), "EXTERNAL", name, "INTERNAL",
+						yesOrNo(!overload.GetIsNonDeterministic()), "YES"))
+				}
+			}
+			collection, err := core.GetFunctionsCollectionFromContext(ctx)
+			if err != nil {
+				return nil, err
+			}
+			var userRows [][]any
+			err = collection.IterateFunctions(func(schema string, function *corefunctions.Function) error {
+				var argTypes []uint32
+				for _, param := range function.Parameters {
+					if param.Mode == corefunctions.ParameterMode_Out {
+						continue
+					}
+					typ, err := deserializeCatalogType(param.Type)
+					if err != nil {
+						return err
+					}
+					argTypes = append(argTypes, typ.OID())
+				}
+				routineType := "FUNCTION"
+				var returnType pgtypes.DoltgresType = pgtypes.Record
+				if function.Kind == corefunctions.Kind_Procedure {
+					routineType = "PROCEDURE"
+					returnType = nil
+				} else if len(function.ReturnType) > 0 {
+					typ, err := deserializeCatalogType(function.ReturnType)
+					if err != nil {
+						return err
+					}
+					returnType = typ
+				}
+				routineBody := "EXTERNAL"
+				if strings.EqualFold(function.Language, "sql") {
+					routineBody = "SQL"
+				}
+				userRows = append(userRows, routineRow(database, schema, function.Name, functionOid(schema, function.Name, argTypes),
+					routineType, returnType, routineBody, function.Definition, strings.ToUpper(function.Language), "NO", "NO"))
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			sort.Slice(userRows, func(i, j int) bool {
+				if userRows[i][routineColumn_routineSchema] != userRows[j][routineColumn_routineSchema] {
+					return userRows[i][routineColumn_routineSchema].(string) < userRows[j][routineColumn_routineSchema].(string)
+				}
+				return userRows[i][routineColumn_routineName].(string) < userRows[j][routineColumn_routineName].(string)
+			})
+			return append(rows, userRows...), nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "specific_catalog", Type: pgtypes.Name},
+		{Name: "specific_schema", Type: pgtypes.Name},
+		{Name: "specific_name", Type: pgtypes.Name},
+		{Name: "routine_catalog", Type: pgtypes.Name},
+		{Name: "routine_schema", Type: pgtypes.Name},
+		{Name: "routine_name", Type: pgtypes.Name},
+		{Name: "routine_type", Type: pgtypes.Text},
+		{Name: "data_type", Type: pgtypes.Text},
+		{Name: "type_udt_catalog", Type: pgtypes.Name},
+		{Name: "type_udt_schema", Type: pgtypes.Name},
+		{Name: "type_udt_name", Type: pgtypes.Name},
+		{Name: "routine_body", Type: pgtypes.Text},
+		{Name: "routine_definition", Type: pgtypes.Text},
+		{Name: "external_language", Type: pgtypes.Text},
+		{Name: "is_deterministic", Type: pgtypes.Text},
+		{Name: "sql_data_access", Type: pgtypes.Text},
+		{Name: "is_null_call", Type: pgtypes.Text},
+		{Name: "security_type", Type: pgtypes.Text},
+	},
+	ReturnsSet: true,
+}
+
+// These are the positions of the routines columns that the rows are sorted by.
+const (
+	routineColumn_routineSchema = 4
+	routineColumn_routineName   = 5
+)
+
+// routineRow returns a row of the routines view for the given function. Procedures do not have a return type, so the
+// return type is nil for them. The specific name matches Postgres, which appends the function's OID to its name.
+func routineRow(database string, schema string, name string, funcOid uint32, routineType string, returnType pgtypes.DoltgresType,
+	routineBody string, definition string, language string, isDeterministic string, isNullCall string) []any {
+	var dataType, udtCatalog, udtSchema, udtName any
+	if returnType != nil {
+		dataType = informationSchemaDataType(returnType)
+		udtCatalog = database
+		udtSchema = "pg_catalog"
+		udtName = catalogTypeName(returnType)
+	}
+	return []any{
+		database,
+		schema,
+		fmt.Sprintf("%s_%d", name, funcOid),
+		database,
+		schema,
+		name,
+		routineType,
+		dataType,
+		udtCatalog,
+		udtSchema,
+		udtName,
+		routineBody,
+		definition,
+		language,
+		isDeterministic,
+		"MODIFIES",
+		isNullCall,
+		"INVOKER",
+	}
+}
@@ -32,8 +32,8 @@ var rpad_varchar_int32 = framework.Function2{
 	Name:       "rpad",
 	Return:     pgtypes.VarChar,
 	Parameters: []pgtypes.DoltgresType{pgtypes.VarChar, pgtypes.Int32},
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
-		return rpad_varchar_int32_varchar.Callable(ctx, val1, val2, " ")
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return rpad_varchar_int32_varchar.Callable(ctx, [4]pgtypes.DoltgresType{}, val1, val2, " ")
 	},
 }
 
@@ -42,7 +42,7 @@ var rpad_varchar_int32_varchar = framework.Function3{
 	Name:       "rpad",
 	Return:     pgtypes.VarChar,
 	Parameters: []pgtypes.DoltgresType{pgtypes.VarChar, pgtypes.Int32, pgtypes.VarChar},
-	Callable: func(ctx *sql.Context, str any, length any, fill any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, str any, length any, fill any) (any, error) {
 		if str == nil || length == nil || fill == nil {
 			return nil, nil
 		}
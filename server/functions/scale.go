@@ -32,8 +32,8 @@ var scale_numeric = framework.Function1{
 	Name:       "scale",
 	Return:     pgtypes.Int32,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Numeric},
-	Callable: func(ctx *sql.Context, val1 any) (any, error) {
-		res, err := min_scale_numeric.Callable(ctx, val1)
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
+		res, err := min_scale_numeric.Callable(ctx, [2]pgtypes.DoltgresType{}, val1)
 		if res != nil {
 			return res.(int32), err
 		}
@@ -34,8 +34,8 @@ var setval_text_int64 = framework.Function2{
 	Return:             pgtypes.Int64,
 	Parameters:         []pgtypes.DoltgresType{pgtypes.Text, pgtypes.Int64},
 	IsNonDeterministic: true,
-	Callable: func(ctx *sql.Context, val1 any, val2 any) (any, error) {
-		return setval_text_int64_boolean.Callable(ctx, val1, val2, true)
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return setval_text_int64_boolean.Callable(ctx, [4]pgtypes.DoltgresType{}, val1, val2, true)
 	},
 }
 
@@ -45,7 +45,7 @@ var setval_text_int64_boolean = framework.Function3{
 	Return:             pgtypes.Int64,
 	Parameters:         []pgtypes.DoltgresType{pgtypes.Text, pgtypes.Int64, pgtypes.Bool},
 	IsNonDeterministic: true,
-	Callable: func(ctx *sql.Context, val1 any, val2 any, val3 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
 		if val1 == nil || val2 == nil || val3 == nil {
 			return nil, nil
 		}
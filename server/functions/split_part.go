@@ -35,7 +35,7 @@ var split_part_varchar_varchar_int32 = framework.Function3{
 	Name:       "split_part",
 	Return:     pgtypes.VarChar,
 	Parameters: []pgtypes.DoltgresType{pgtypes.VarChar, pgtypes.VarChar, pgtypes.Int32},
-	Callable: func(ctx *sql.Context, str any, delimiter any, n any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, str any, delimiter any, n any) (any, error) {
 		if str == nil || delimiter == nil || n == nil {
 			return nil, nil
 		}
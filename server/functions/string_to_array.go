@@ -0,0 +1,81 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initStringToArray registers the functions to the catalog.
+func initStringToArray() {
+	framework.RegisterFunction(string_to_array_text_text)
+	framework.RegisterFunction(string_to_array_text_text_text)
+}
+
+// string_to_array_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var string_to_array_text_text = framework.Function2{
+	Name:       "string_to_array",
+	Return:     pgtypes.TextArray,
+	Parameters: []pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text},
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, val1 any, val2 any) (any, error) {
+		return stringToArray(val1, val2, nil)
+	},
+}
+
+// string_to_array_text_text_text represents the PostgreSQL function of the same name, taking the same parameters.
+var string_to_array_text_text_text = framework.Function3{
+	Name:       "string_to_array",
+	Return:     pgtypes.TextArray,
+	Parameters: []pgtypes.DoltgresType{pgtypes.Text, pgtypes.Text, pgtypes.Text},
+	Callable: func(ctx *sql.Context, _ [4]pgtypes.DoltgresType, val1 any, val2 any, val3 any) (any, error) {
+		return stringToArray(val1, val2, val3)
+	},
+}
+
+// stringToArray splits the string into an array using the delimiter. A NULL delimiter splits the string into its
+// characters, while an empty delimiter returns the entire string as the only element. Elements that match the
+// nullString are replaced with NULL.
+func stringToArray(str any, delimiter any, nullString any) (any, error) {
+	if str == nil {
+		return nil, nil
+	}
+	input := str.(string)
+	if len(input) == 0 {
+		return []any{}, nil
+	}
+	var parts []string
+	if delimiter == nil {
+		for _, r := range input {
+			parts = append(parts, string(r))
+		}
+	} else if len(delimiter.(string)) == 0 {
+		parts = []string{input}
+	} else {
+		parts = strings.Split(input, delimiter.(string))
+	}
+	elements := make([]any, len(parts))
+	for i, part := range parts {
+		if nullString != nil && part == nullString.(string) {
+			continue
+		}
+		elements[i] = part
+	}
+	return elements, nil
+}
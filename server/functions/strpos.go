@@ -33,7 +33,7 @@ var strpos_varchar = framework.Function2{
 	Name:       "strpos",
 	Return:     pgtypes.Int32,
 	Parameters: []pgtypes.DoltgresType{pgtypes.VarChar, pgtypes.VarChar},
-	Callable: func(ctx *sql.Context, str any, substring any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, str any, substring any) (any, error) {
 		if str == nil || substring == nil {
 			return nil, nil
 		}
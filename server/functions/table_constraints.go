@@ -0,0 +1,111 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initTableConstraintList registers the functions to the catalog.
+func initTableConstraintList() {
+	framework.RegisterFunction(table_constraint_list)
+}
+
+// table_constraint_list is the source of the information_schema.table_constraints view, returning the constraints of
+// the tables in the current database that the current role has access to. Exclusion constraints are not returned, as
+// they are not part of the SQL standard. This function is specific to Doltgres.
+var table_constraint_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "table_constraint_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			constraints, err := informationSchemaConstraints(ctx)
+			if err != nil {
+				return nil, err
+			}
+			database := ctx.GetCurrentDatabase()
+			rows := make([][]any, len(constraints))
+			for i, constraint := range constraints {
+				var constraintType string
+				var nullsDistinct any
+				switch constraint.contype {
+				case "p":
+					constraintType = "PRIMARY KEY"
+				case "u":
+					constraintType = "UNIQUE"
+					nullsDistinct = "YES"
+				case "f":
+					constraintType = "FOREIGN KEY"
+				case "c":
+					constraintType = "CHECK"
+				}
+				rows[i] = []any{
+					database,
+					constraint.table.name.Schema,
+					constraint.name,
+					database,
+					constraint.table.name.Schema,
+					constraint.table.name.Name,
+					constraintType,
+					"NO",
+					"NO",
+					"YES",
+					nullsDistinct,
+				}
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "constraint_catalog", Type: pgtypes.Name},
+		{Name: "constraint_schema", Type: pgtypes.Name},
+		{Name: "constraint_name", Type: pgtypes.Name},
+		{Name: "table_catalog", Type: pgtypes.Name},
+		{Name: "table_schema", Type: pgtypes.Name},
+		{Name: "table_name", Type: pgtypes.Name},
+		{Name: "constraint_type", Type: pgtypes.Text},
+		{Name: "is_deferrable", Type: pgtypes.Text},
+		{Name: "initially_deferred", Type: pgtypes.Text},
+		{Name: "enforced", Type: pgtypes.Text},
+		{Name: "nulls_distinct", Type: pgtypes.Text},
+	},
+	ReturnsSet: true,
+}
+
+// informationSchemaConstraints returns the primary key, unique, check, and foreign key constraints of the tables that
+// the current role has access to.
+func informationSchemaConstraints(ctx *sql.Context) ([]catalogConstraint, error) {
+	tables, err := catalogTables(ctx)
+	if err != nil {
+		return nil, err
+	}
+	constraints, err := catalogConstraints(ctx, tables)
+	if err != nil {
+		return nil, err
+	}
+	roleName := informationSchemaRole(ctx)
+	visible := constraints[:0]
+	for _, constraint := range constraints {
+		if constraint.contype != "x" && isVisibleTable(ctx, roleName, constraint.table.name) {
+			visible = append(visible, constraint)
+		}
+	}
+	return visible, nil
+}
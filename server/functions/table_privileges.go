@@ -0,0 +1,137 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/postgres/parser/privilege"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initTablePrivilegeList registers the functions to the catalog.
+func initTablePrivilegeList() {
+	framework.RegisterFunction(table_privilege_list)
+	framework.RegisterFunction(role_table_grant_list)
+}
+
+// table_privilege_list is the source of the information_schema.table_privileges view, returning the privileges on the
+// tables of the current database that were granted to or by the current role or its inherited roles, or granted to
+// PUBLIC. This function is
+// specific to Doltgres.
+var table_privilege_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "table_privilege_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			return tablePrivilegeRows(ctx, true)
+		},
+	},
+	Columns:    tablePrivilegeColumns,
+	ReturnsSet: true,
+}
+
+// role_table_grant_list is the source of the information_schema.role_table_grants view, which matches the
+// table_privileges view without the privileges that were granted to PUBLIC. This function is specific to Doltgres.
+var role_table_grant_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "role_table_grant_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			return tablePrivilegeRows(ctx, false)
+		},
+	},
+	Columns:    tablePrivilegeColumns,
+	ReturnsSet: true,
+}
+
+// tablePrivilegeColumns are the columns of the table privilege views.
+var tablePrivilegeColumns = []framework.RecordColumn{
+	{Name: "grantor", Type: pgtypes.Name},
+	{Name: "grantee", Type: pgtypes.Name},
+	{Name: "table_catalog", Type: pgtypes.Name},
+	{Name: "table_schema", Type: pgtypes.Name},
+	{Name: "table_name", Type: pgtypes.Name},
+	{Name: "privilege_type", Type: pgtypes.Text},
+	{Name: "is_grantable", Type: pgtypes.Text},
+	{Name: "with_hierarchy", Type: pgtypes.Text},
+}
+
+// tablePrivilegeRows returns a row for each privilege on the tables of the current database that was granted to or by
+// a role whose privileges the current role has, including the implicit privileges of each table's owner. Privileges that were granted to PUBLIC
+// are only included when includePublic is true.
+func tablePrivilegeRows(ctx *sql.Context, includePublic bool) ([][]any, error) {
+	roleName := ctx.Client().User
+	if len(roleName) == 0 {
+		roleName = auth.BootstrapRole
+	}
+	database := ctx.GetCurrentDatabase()
+	schemaNames, err := core.GetSchemaNamesFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var rows [][]any
+	for _, schemaName := range schemaNames {
+		tableNames, err := core.GetTableNamesFromContext(ctx, schemaName)
+		if err != nil {
+			return nil, err
+		}
+		for _, tableName := range tableNames {
+			for _, grant := range auth.ACL(auth.TableObject(database, schemaName, tableName)) {
+				var visible bool
+				if grant.Grantee == auth.PublicRole {
+					visible = includePublic || auth.HasPrivilegesOf(roleName, grant.Grantor)
+				} else {
+					visible = auth.HasPrivilegesOf(roleName, grant.Grantor) || auth.HasPrivilegesOf(roleName, grant.Grantee)
+				}
+				if !visible {
+					continue
+				}
+				grantee := grant.Grantee
+				if grantee == auth.PublicRole {
+					grantee = "PUBLIC"
+				}
+				for _, kind := range grant.Privileges.Kinds() {
+					rows = append(rows, []any{
+						grant.Grantor,
+						grantee,
+						database,
+						schemaName,
+						tableName,
+						kind.String(),
+						yesOrNo(grant.GrantOptions.Has(kind)),
+						yesOrNo(kind == privilege.SELECT),
+					})
+				}
+			}
+		}
+	}
+	return rows, nil
+}
+
+// yesOrNo returns the value of the yes_or_no domain that is used by the information_schema views.
+func yesOrNo(value bool) string {
+	if value {
+		return "YES"
+	}
+	return "NO"
+}
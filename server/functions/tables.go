@@ -0,0 +1,114 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initTableList registers the functions to the catalog.
+func initTableList() {
+	framework.RegisterFunction(table_list)
+}
+
+// table_list is the source of the information_schema.tables view, returning the tables and views of the current
+// database that the current role has access to. This function is specific to Doltgres.
+var table_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "table_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			tables, err := catalogTables(ctx)
+			if err != nil {
+				return nil, err
+			}
+			foreignCollection, err := core.GetForeignDataCollectionFromContext(ctx)
+			if err != nil {
+				return nil, err
+			}
+			roleName := informationSchemaRole(ctx)
+			database := ctx.GetCurrentDatabase()
+			var rows [][]any
+			for _, table := range tables {
+				if !isVisibleTable(ctx, roleName, table.name) {
+					continue
+				}
+				tableType := "BASE TABLE"
+				if foreignCollection.GetTable(table.name) != nil {
+					tableType = "FOREIGN"
+				}
+				rows = append(rows, []any{
+					database,
+					table.name.Schema,
+					table.name.Name,
+					tableType,
+					nil,
+					nil,
+					nil,
+					nil,
+					nil,
+					"YES",
+					"NO",
+					nil,
+				})
+			}
+			views, err := core.GetViewsFromContext(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, view := range views {
+				if !isVisibleTable(ctx, roleName, view.Name) {
+					continue
+				}
+				rows = append(rows, []any{
+					database,
+					view.Name.Schema,
+					view.Name.Name,
+					"VIEW",
+					nil,
+					nil,
+					nil,
+					nil,
+					nil,
+					"NO",
+					"NO",
+					nil,
+				})
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "table_catalog", Type: pgtypes.Name},
+		{Name: "table_schema", Type: pgtypes.Name},
+		{Name: "table_name", Type: pgtypes.Name},
+		{Name: "table_type", Type: pgtypes.Text},
+		{Name: "self_referencing_column_name", Type: pgtypes.Name},
+		{Name: "reference_generation", Type: pgtypes.Text},
+		{Name: "user_defined_type_catalog", Type: pgtypes.Name},
+		{Name: "user_defined_type_schema", Type: pgtypes.Name},
+		{Name: "user_defined_type_name", Type: pgtypes.Name},
+		{Name: "is_insertable_into", Type: pgtypes.Text},
+		{Name: "is_typed", Type: pgtypes.Text},
+		{Name: "commit_action", Type: pgtypes.Text},
+	},
+	ReturnsSet: true,
+}
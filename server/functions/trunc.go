@@ -36,7 +36,7 @@ var trunc_float64 = framework.Function1{
 	Name:       "trunc",
 	Return:     pgtypes.Float64,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Float64},
-	Callable: func(ctx *sql.Context, val1 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
 		if val1 == nil {
 			return nil, nil
 		}
@@ -49,7 +49,7 @@ var trunc_numeric = framework.Function1{
 	Name:       "trunc",
 	Return:     pgtypes.Numeric,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Numeric},
-	Callable: func(ctx *sql.Context, val1 any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [2]pgtypes.DoltgresType, val1 any) (any, error) {
 		if val1 == nil {
 			return nil, nil
 		}
@@ -62,7 +62,7 @@ var trunc_numeric_int64 = framework.Function2{
 	Name:       "trunc",
 	Return:     pgtypes.Numeric,
 	Parameters: []pgtypes.DoltgresType{pgtypes.Numeric, pgtypes.Int32},
-	Callable: func(ctx *sql.Context, num any, places any) (any, error) {
+	Callable: func(ctx *sql.Context, _ [3]pgtypes.DoltgresType, num any, places any) (any, error) {
 		if num == nil || places == nil {
 			return nil, nil
 		}
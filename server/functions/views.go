@@ -0,0 +1,91 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// initViewList registers the functions to the catalog.
+func initViewList() {
+	framework.RegisterFunction(view_list)
+}
+
+// view_list is the source of the information_schema.views view, returning the views of the current database that the
+// current role has access to. This function is specific to Doltgres.
+var view_list = framework.RecordFunction{
+	FunctionInterface: framework.Function0{
+		Name:               "view_list",
+		Return:             pgtypes.Record,
+		Parameters:         []pgtypes.DoltgresType{},
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ [1]pgtypes.DoltgresType) (any, error) {
+			views, err := core.GetViewsFromContext(ctx)
+			if err != nil {
+				return nil, err
+			}
+			roleName := informationSchemaRole(ctx)
+			database := ctx.GetCurrentDatabase()
+			var rows [][]any
+			for _, view := range views {
+				if !isVisibleTable(ctx, roleName, view.Name) {
+					continue
+				}
+				definition := view.Definition.SubStatementStr
+				if len(definition) == 0 {
+					definition = vitess.String(view.Definition.ViewSpec.ViewExpr)
+				}
+				checkOption := "NONE"
+				switch view.Definition.ViewSpec.CheckOption {
+				case vitess.ViewCheckOptionCascaded:
+					checkOption = "CASCADED"
+				case vitess.ViewCheckOptionLocal:
+					checkOption = "LOCAL"
+				}
+				rows = append(rows, []any{
+					database,
+					view.Name.Schema,
+					view.Name.Name,
+					definition,
+					checkOption,
+					"NO",
+					"NO",
+					"NO",
+					"NO",
+					"NO",
+				})
+			}
+			return rows, nil
+		},
+	},
+	Columns: []framework.RecordColumn{
+		{Name: "table_catalog", Type: pgtypes.Name},
+		{Name: "table_schema", Type: pgtypes.Name},
+		{Name: "table_name", Type: pgtypes.Name},
+		{Name: "view_definition", Type: pgtypes.Text},
+		{Name: "check_option", Type: pgtypes.Text},
+		{Name: "is_updatable", Type: pgtypes.Text},
+		{Name: "is_insertable_into", Type: pgtypes.Text},
+		{Name: "is_trigger_updatable", Type: pgtypes.Text},
+		{Name: "is_trigger_deletable", Type: pgtypes.Text},
+		{Name: "is_trigger_insertable_into", Type: pgtypes.Text},
+	},
+	ReturnsSet: true,
+}
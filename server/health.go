@@ -0,0 +1,189 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	doltservercfg "github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+	"github.com/dolthub/dolt/go/libraries/utils/svcs"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/doltgresql/server/health"
+	"github.com/dolthub/doltgresql/server/logrepl"
+	"github.com/dolthub/doltgresql/server/replica"
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+// defaultMaxReplicationLag is the longest time that a replica may go without matching its primary before it's no longer
+// ready, when a maximum has not been configured.
+const defaultMaxReplicationLag = 5 * time.Minute
+
+// serverHealth holds the checks of the health endpoints, along with the state that they report on.
+type serverHealth struct {
+	checker           *health.Checker
+	maxReplicationLag time.Duration
+	// started is set once the server has finished starting, which includes creating the default database and bringing
+	// a read replica up to date.
+	started atomic.Bool
+}
+
+// configureHealth returns the health endpoints' checks, along with the service that serves them. Returns nil if a port
+// has not been configured. The service should be registered before the services of the SQL server, so that the
+// endpoints report on the server while it starts and stops.
+func configureHealth(cfg *servercfg.DoltgresHealthConfig, ssCfg doltservercfg.ServerConfig) (*serverHealth, *svcs.AnonService, error) {
+	if cfg == nil || cfg.Port == nil {
+		return nil, nil, nil
+	}
+	sh := &serverHealth{
+		checker:           health.NewChecker(health.DefaultTimeout),
+		maxReplicationLag: defaultMaxReplicationLag,
+	}
+	if cfg.MaxReplicationLag != nil {
+		maxReplicationLag, err := time.ParseDuration(*cfg.MaxReplicationLag)
+		if err != nil || maxReplicationLag <= 0 {
+			return nil, nil, fmt.Errorf(`invalid health max_replication_lag "%s"`, *cfg.MaxReplicationLag)
+		}
+		sh.maxReplicationLag = maxReplicationLag
+	}
+	sh.checker.AddLivenessCheck("listener", health.ListenerCheck(listenerProbeAddress(ssCfg)))
+	sh.checker.AddLivenessCheck("storage", checkStorage)
+	sh.checker.AddReadinessCheck("startup", func(context.Context) error {
+		if !sh.started.Load() {
+			return fmt.Errorf("the server is starting")
+		}
+		return nil
+	})
+
+	host := ""
+	if cfg.Host != nil {
+		host = *cfg.Host
+	}
+	address := net.JoinHostPort(host, strconv.Itoa(*cfg.Port))
+	endpoints := &http.Server{
+		Handler:           sh.checker,
+		ReadHeaderTimeout: health.DefaultTimeout,
+	}
+	var listener net.Listener
+	started := make(chan struct{})
+	return sh, &svcs.AnonService{
+		InitF: func(context.Context) (err error) {
+			if listener, err = net.Listen("tcp", address); err != nil {
+				return fmt.Errorf("error starting the health endpoints: %w", err)
+			}
+			return nil
+		},
+		RunF: func(context.Context) {
+			close(started)
+			if err := endpoints.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logrus.Errorf("health endpoints failed: %v", err)
+			}
+		},
+		StopF: func() error {
+			// The service may be stopped without having run, such as when another service fails to start
+			select {
+			case <-started:
+				_ = endpoints.Close()
+			default:
+				if listener != nil {
+					_ = listener.Close()
+				}
+			}
+			return nil
+		},
+	}, nil
+}
+
+// checkReadReplica adds a readiness check for the lag of the read replica. Returns an error if the maximum lag could be
+// exceeded by the replica's interval alone, as the server would regularly not be ready.
+func (sh *serverHealth) checkReadReplica(replicator *replica.Replicator) error {
+	if sh.maxReplicationLag <= replicator.Interval() {
+		return fmt.Errorf("the health max_replication_lag of %s must be longer than the read replica interval of %s",
+			sh.maxReplicationLag, replicator.Interval())
+	}
+	sh.checker.AddReadinessCheck("read_replica", func(context.Context) error {
+		replicated := replicator.LastReplicated()
+		if replicated.IsZero() {
+			return fmt.Errorf("the databases have not been replicated")
+		}
+		return sh.checkLag(time.Since(replicated))
+	})
+	return nil
+}
+
+// checkPostgresReplication adds a readiness check for the lag of replication from Postgres.
+func (sh *serverHealth) checkPostgresReplication(replicator *logrepl.LogicalReplicator) {
+	sh.checker.AddReadinessCheck("postgres_replication", func(context.Context) error {
+		if !replicator.Running() {
+			return fmt.Errorf("replication is not running")
+		}
+		lag, ok := replicator.Lag()
+		if !ok {
+			return fmt.Errorf("the replica has not caught up with the primary")
+		}
+		return sh.checkLag(lag)
+	})
+}
+
+// checkLag returns an error if the given replication lag exceeds the maximum.
+func (sh *serverHealth) checkLag(lag time.Duration) error {
+	if lag > sh.maxReplicationLag {
+		return fmt.Errorf("replication lag of %s exceeds the maximum of %s", lag.Round(time.Millisecond), sh.maxReplicationLag)
+	}
+	return nil
+}
+
+// listenerProbeAddress returns the address that the listener is probed at. A listener on every address is probed
+// through the loopback interface.
+func listenerProbeAddress(cfg doltservercfg.ServerConfig) string {
+	host := cfg.Host()
+	if ip := net.ParseIP(host); len(host) == 0 || (ip != nil && ip.IsUnspecified()) {
+		host = "localhost"
+	}
+	return net.JoinHostPort(host, strconv.Itoa(cfg.Port()))
+}
+
+// checkStorage verifies that the storage of each database may be read, by loading the root of a branch's head commit.
+func checkStorage(ctx context.Context) error {
+	provider := runningProvider()
+	if provider == nil {
+		return fmt.Errorf("the server is not running")
+	}
+	for _, db := range provider.DoltDatabases() {
+		ddb := db.DbData().Ddb
+		branches, err := ddb.GetBranches(ctx)
+		if err != nil {
+			return fmt.Errorf("database %s: %w", db.Name(), err)
+		}
+		if len(branches) == 0 {
+			continue
+		}
+		commit, err := ddb.ResolveCommitRef(ctx, branches[0])
+		if err != nil {
+			return fmt.Errorf("database %s: %w", db.Name(), err)
+		}
+		if _, err = commit.GetRootValue(ctx); err != nil {
+			return fmt.Errorf("database %s: %w", db.Name(), err)
+		}
+	}
+	return nil
+}
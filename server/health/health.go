@@ -0,0 +1,165 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// LivenessPath is the path of the endpoint that reports whether the server is alive, and should be restarted when it's
+// not.
+const LivenessPath = "/healthz"
+
+// ReadinessPath is the path of the endpoint that reports whether the server is ready to accept connections.
+const ReadinessPath = "/readyz"
+
+// DefaultTimeout is the longest time that the checks of a single request may run before they're considered to have
+// failed.
+const DefaultTimeout = 5 * time.Second
+
+// StatusOK is the status of a check that passed, along with the status of an endpoint whose checks all passed.
+const StatusOK = "ok"
+
+// StatusFailed is the status of an endpoint that had a failed check.
+const StatusFailed = "failed"
+
+// Check reports whether a part of the server is healthy, returning an error that describes the problem when it's not.
+type Check func(ctx context.Context) error
+
+// Response is the JSON body that each endpoint responds with.
+type Response struct {
+	// Status is either StatusOK or StatusFailed.
+	Status string `json:"status"`
+	// Checks contains the result of each check by name, which is StatusOK or the error of a failed check.
+	Checks map[string]string `json:"checks"`
+}
+
+// namedCheck is a check along with the name that its result is reported under.
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Checker serves the liveness and readiness endpoints, running their checks on each request. Checks may be added while
+// the endpoints are served, as some parts of the server only exist once the server is running.
+type Checker struct {
+	timeout   time.Duration
+	mu        sync.RWMutex
+	liveness  []namedCheck
+	readiness []namedCheck
+}
+
+var _ http.Handler = (*Checker)(nil)
+
+// NewChecker returns a new *Checker without any checks. A timeout of zero uses DefaultTimeout.
+func NewChecker(timeout time.Duration) *Checker {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Checker{timeout: timeout}
+}
+
+// AddLivenessCheck adds a check to both endpoints, as a server that isn't alive cannot be ready.
+func (c *Checker) AddLivenessCheck(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.liveness = append(c.liveness, namedCheck{name: name, check: check})
+}
+
+// AddReadinessCheck adds a check to the readiness endpoint.
+func (c *Checker) AddReadinessCheck(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.readiness = append(c.readiness, namedCheck{name: name, check: check})
+}
+
+// Run runs the checks of the liveness endpoint, or of the readiness endpoint when readiness is true. The checks are run
+// concurrently, and any check that has not finished by the timeout fails.
+func (c *Checker) Run(ctx context.Context, readiness bool) Response {
+	c.mu.RLock()
+	checks := append([]namedCheck(nil), c.liveness...)
+	if readiness {
+		checks = append(checks, c.readiness...)
+	}
+	c.mu.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	results := make([]chan error, len(checks))
+	for i, check := range checks {
+		results[i] = make(chan error, 1)
+		go func(check Check, result chan<- error) {
+			result <- check(ctx)
+		}(check.check, results[i])
+	}
+	response := Response{
+		Status: StatusOK,
+		Checks: make(map[string]string, len(checks)),
+	}
+	for i, check := range checks {
+		var err error
+		select {
+		case err = <-results[i]:
+		case <-ctx.Done():
+			// The check may have finished at the same time as the timeout, in which case we use its result
+			select {
+			case err = <-results[i]:
+			default:
+				err = ctx.Err()
+			}
+		}
+		if err != nil {
+			response.Status = StatusFailed
+			response.Checks[check.name] = err.Error()
+		} else {
+			response.Checks[check.name] = StatusOK
+		}
+	}
+	return response
+}
+
+// ServeHTTP implements the interface http.Handler. A GET or HEAD request to either endpoint responds with the result of
+// its checks, using the status code 503 when any check failed.
+func (c *Checker) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	var readiness bool
+	switch req.URL.Path {
+	case LivenessPath:
+	case ReadinessPath:
+		readiness = true
+	default:
+		http.NotFound(w, req)
+		return
+	}
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	response := c.Run(req.Context(), readiness)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if response.Status == StatusOK {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if req.Method == http.MethodGet {
+		_ = json.NewEncoder(w).Encode(response)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecker(t *testing.T) {
+	checker := NewChecker(100 * time.Millisecond)
+	lagging := true
+	checker.AddLivenessCheck("alive", func(context.Context) error {
+		return nil
+	})
+	checker.AddReadinessCheck("replication", func(context.Context) error {
+		if lagging {
+			return fmt.Errorf("replication lag of 2m0s exceeds the maximum of 1m0s")
+		}
+		return nil
+	})
+	probe := func(method string, path string) (int, Response) {
+		recorder := httptest.NewRecorder()
+		checker.ServeHTTP(recorder, httptest.NewRequest(method, path, nil))
+		var response Response
+		if method == http.MethodGet && recorder.Code != http.StatusNotFound && recorder.Code != http.StatusMethodNotAllowed {
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		}
+		return recorder.Code, response
+	}
+
+	// Readiness checks do not affect liveness
+	code, response := probe(http.MethodGet, LivenessPath)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, Response{Status: StatusOK, Checks: map[string]string{"alive": StatusOK}}, response)
+	code, response = probe(http.MethodGet, ReadinessPath)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, Response{Status: StatusFailed, Checks: map[string]string{
+		"alive":       StatusOK,
+		"replication": "replication lag of 2m0s exceeds the maximum of 1m0s",
+	}}, response)
+	lagging = false
+	code, _ = probe(http.MethodGet, ReadinessPath)
+	assert.Equal(t, http.StatusOK, code)
+	code, _ = probe(http.MethodHead, ReadinessPath)
+	assert.Equal(t, http.StatusOK, code)
+
+	// A check that does not finish within the timeout fails, without holding up the response
+	checker.AddLivenessCheck("stuck", func(ctx context.Context) error {
+		<-ctx.Done()
+		time.Sleep(time.Second)
+		return nil
+	})
+	start := time.Now()
+	code, response = probe(http.MethodGet, LivenessPath)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, context.DeadlineExceeded.Error(), response.Checks["stuck"])
+	assert.Equal(t, StatusOK, response.Checks["alive"])
+
+	code, _ = probe(http.MethodPost, LivenessPath)
+	assert.Equal(t, http.StatusMethodNotAllowed, code)
+	code, _ = probe(http.MethodGet, "/metrics")
+	assert.Equal(t, http.StatusNotFound, code)
+}
@@ -0,0 +1,60 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package health
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+)
+
+// gssEncRequestCode is the request code of a GSSENCRequest message, which asks the server whether it supports GSSAPI
+// encryption.
+const gssEncRequestCode = 80877104
+
+// ListenerCheck returns a check that connects to the Postgres listener at the given address, and verifies that the
+// connection is served. The check asks whether GSSAPI encryption is supported and then hangs up, since the server
+// answers that request before the client authenticates, which means that no credentials are needed.
+func ListenerCheck(address string) Check {
+	return func(ctx context.Context) error {
+		var dialer net.Dialer
+		conn, err := dialer.DialContext(ctx, "tcp", address)
+		if err != nil {
+			return fmt.Errorf("unable to connect to the listener: %w", err)
+		}
+		defer conn.Close()
+		if deadline, ok := ctx.Deadline(); ok {
+			if err = conn.SetDeadline(deadline); err != nil {
+				return err
+			}
+		}
+		request := make([]byte, 8)
+		binary.BigEndian.PutUint32(request[0:4], 8)
+		binary.BigEndian.PutUint32(request[4:8], gssEncRequestCode)
+		if _, err = conn.Write(request); err != nil {
+			return fmt.Errorf("unable to write to the listener: %w", err)
+		}
+		response := make([]byte, 1)
+		if _, err = io.ReadFull(conn, response); err != nil {
+			return fmt.Errorf("the listener did not respond: %w", err)
+		}
+		if response[0] != 'N' && response[0] != 'G' {
+			return fmt.Errorf("the listener responded with an unexpected message: %q", response[0])
+		}
+		return nil
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// needsImplicitTransaction returns whether the statement must run within the implicit transaction that Postgres wraps
+// around every statement executed between two Sync messages. Only statements that may write are included, as reads
+// have nothing to roll back, and transaction control statements manage the transaction themselves.
+func (h *ConnectionHandler) needsImplicitTransaction(stmt sqlparser.Statement) bool {
+	if h.inTransaction || h.implicitTransaction {
+		return false
+	}
+	switch stmt := stmt.(type) {
+	case sqlparser.InjectedStatement:
+		// Statements that refuse to run within a transaction block are left to run on their own
+		switch node := stmt.Statement.(type) {
+		case *pgnodes.DropIndex:
+			return !node.Concurrently()
+		case *pgnodes.CreateDatabase, *pgnodes.DropDatabase:
+			return false
+		case *pgnodes.LockTable:
+			// This must be able to tell that it's outside of a transaction block, where it would be released right away
+			return false
+		}
+		return true
+	case *sqlparser.Call:
+		// Garbage collection refuses to run within a transaction block
+		return stmt.ProcName.Name.Lowered() != "dolt_gc"
+	case nil, *sqlparser.Select, *sqlparser.SetOp, *sqlparser.Show, *sqlparser.Explain, *sqlparser.Set,
+		*sqlparser.Begin, *sqlparser.Commit, *sqlparser.Rollback:
+		return false
+	default:
+		return true
+	}
+}
+
+// beginImplicitTransaction starts the implicit transaction that lasts until the next Sync message. A pipeline may send
+// many Execute messages before a Sync, and if any of them fail, then the statements that already ran are rolled back.
+func (h *ConnectionHandler) beginImplicitTransaction() error {
+	if err := h.runTransactionStatement("BEGIN", &sqlparser.Begin{}); err != nil {
+		return err
+	}
+	h.implicitTransaction = true
+	return nil
+}
+
+// endImplicitTransaction ends the implicit transaction, if one is open, by committing it when every statement since the
+// last Sync succeeded, and rolling it back otherwise.
+func (h *ConnectionHandler) endImplicitTransaction(succeeded bool) error {
+	if !h.implicitTransaction {
+		return nil
+	}
+	h.implicitTransaction = false
+	if succeeded {
+		return h.runTransactionStatement("COMMIT", &sqlparser.Commit{})
+	}
+	return h.runTransactionStatement("ROLLBACK", &sqlparser.Rollback{})
+}
+
+// runTransactionStatement runs the given transaction control statement on the engine.
+func (h *ConnectionHandler) runTransactionStatement(query string, stmt sqlparser.Statement) error {
+	return h.handler.(mysql.ExtendedHandler).ComParsedQuery(h.mysqlConn, query, stmt, func(*sqltypes.Result, bool) error {
+		return nil
+	})
+}
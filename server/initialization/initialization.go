@@ -28,6 +28,7 @@ import (
 	"github.com/dolthub/doltgresql/server/functions/binary"
 	"github.com/dolthub/doltgresql/server/functions/framework"
 	"github.com/dolthub/doltgresql/server/functions/unary"
+	"github.com/dolthub/doltgresql/server/procedures"
 	pgtypes "github.com/dolthub/doltgresql/server/types"
 )
 
@@ -45,6 +46,7 @@ func Initialize() {
 		functions.Init()
 		cast.Init()
 		framework.Initialize()
+		procedures.Init()
 		sql.GlobalParser = pgsql.NewPostgresParser()
 	})
 }
@@ -0,0 +1,111 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/utils/svcs"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/kafkasink"
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+// serverKafkaSinkConfig is the configuration of the Kafka sink, and is nil when the sink has not been configured. This
+// is set when the server starts, and the sink is started once the engine exists.
+var serverKafkaSinkConfig *kafkasink.Config
+
+// newKafkaSinkConfig returns the sink configuration from the server's configuration. Returns nil if the sink has not
+// been configured.
+func newKafkaSinkConfig(cfg *servercfg.DoltgresConfig) (*kafkasink.Config, error) {
+	if cfg.KafkaSink == nil {
+		return nil, nil
+	}
+	if len(cfg.KafkaSink.Brokers) == 0 {
+		return nil, fmt.Errorf("kafka_sink requires at least one broker")
+	}
+	config := &kafkasink.Config{
+		Brokers: cfg.KafkaSink.Brokers,
+	}
+	if cfg.KafkaSink.Topic != nil {
+		config.Topic = *cfg.KafkaSink.Topic
+	}
+	if cfg.KafkaSink.KeyFormat != nil {
+		config.KeyFormat = kafkasink.Format(*cfg.KafkaSink.KeyFormat)
+	}
+	if cfg.KafkaSink.ValueFormat != nil {
+		config.ValueFormat = kafkasink.Format(*cfg.KafkaSink.ValueFormat)
+	}
+	for _, subscription := range cfg.KafkaSink.Subscriptions {
+		var s kafkasink.Subscription
+		if subscription.Database != nil {
+			s.Database = *subscription.Database
+		}
+		if subscription.Branch != nil {
+			s.Branch = *subscription.Branch
+		}
+		if subscription.Table != nil {
+			s.Table = *subscription.Table
+		}
+		config.Subscriptions = append(config.Subscriptions, s)
+	}
+	return config, nil
+}
+
+// newKafkaSinkService returns a service that stops the Kafka sink when the server stops. Services are stopped in the
+// reverse order that they were registered, so this must be registered after the services of the SQL server, which
+// ensures that the commits made before shutting down are published.
+func newKafkaSinkService() *svcs.AnonService {
+	return &svcs.AnonService{
+		InitF: func(context.Context) error {
+			return nil
+		},
+		StopF: func() error {
+			return kafkasink.Stop()
+		},
+	}
+}
+
+// registerKafkaSink attaches the Kafka sink's commit hook to every database, including those created later, and then
+// starts the sink if it has been configured. The hooks are always attached so that the sink may be started by other
+// means, such as by tests.
+func registerKafkaSink() error {
+	provider := runningProvider()
+	if provider == nil {
+		return nil
+	}
+	ctx := context.Background()
+	for _, db := range provider.DoltDatabases() {
+		ddb := db.DbData().Ddb
+		ddb.PrependCommitHook(ctx, kafkasink.RegisterDatabase(db.Name(), ddb))
+	}
+	provider.InitDatabaseHooks = append(provider.InitDatabaseHooks, func(ctx *sql.Context, _ *sqle.DoltDatabaseProvider, name string, _ *env.DoltEnv, db dsess.SqlDatabase) error {
+		ddb := db.DbData().Ddb
+		ddb.PrependCommitHook(ctx, kafkasink.RegisterDatabase(name, ddb))
+		return nil
+	})
+	provider.DropDatabaseHooks = append(provider.DropDatabaseHooks, func(_ *sql.Context, name string) {
+		kafkasink.UnregisterDatabase(name)
+	})
+	if serverKafkaSinkConfig == nil || kafkasink.Running() {
+		return nil
+	}
+	return kafkasink.Start(*serverKafkaSinkConfig, nil)
+}
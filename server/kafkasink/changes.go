@@ -0,0 +1,208 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkasink
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/segmentio/kafka-go"
+
+	"github.com/dolthub/doltgresql/core"
+)
+
+// Operations that a change may represent.
+const (
+	opInsert = "insert"
+	opUpdate = "update"
+	opDelete = "delete"
+)
+
+// change is a change to a single row. The before and after values match the columns of the table's encoder, and are
+// nil when the row did not exist on that side.
+type change struct {
+	op     string
+	before []any
+	after  []any
+}
+
+// rowDecoder reads the values of a table's rows, placing each value at the position of its column within the encoder.
+type rowDecoder struct {
+	decoder *core.RowDecoder
+	// targets holds the position within the encoder of each of the decoder's columns, which is -1 when the encoder does
+	// not have the column.
+	targets []int
+	width   int
+}
+
+// buildMessages returns the messages for every change to the subscribed tables of the branch, between the two commits.
+func (hook *CommitHook) buildMessages(ctx context.Context, config Config, branch string, from hash.Hash, to hash.Hash) ([]kafka.Message, error) {
+	isSubscribed := subscribedTables(hook.database, branch)
+	fromRoot, err := hook.readRoot(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	toRoot, err := hook.readRoot(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+	deltas, err := diff.GetTableDeltas(ctx, fromRoot, toRoot)
+	if err != nil {
+		return nil, err
+	}
+	var messages []kafka.Message
+	for _, td := range deltas {
+		name, sch := td.ToName, td.ToSch
+		if td.ToTable == nil {
+			name, sch = td.FromName, td.FromSch
+		}
+		if !isSubscribed(name.Schema, name.Name) {
+			continue
+		}
+		encoder, err := newTableEncoder(config, hook.database, branch, to.String(), name, sch)
+		if err != nil {
+			return nil, err
+		}
+		var fromDecoder, toDecoder *rowDecoder
+		if td.FromTable != nil {
+			fromDecoder = newRowDecoder(td.FromSch, td.FromTable.NodeStore(), encoder.columns)
+		}
+		if td.ToTable != nil {
+			toDecoder = newRowDecoder(td.ToSch, td.ToTable.NodeStore(), encoder.columns)
+		}
+		err = core.DiffTableRows(ctx, td, func(ctx context.Context, d tree.Diff) error {
+			changes, err := rowChanges(ctx, d, fromDecoder, toDecoder)
+			if err != nil {
+				return err
+			}
+			for _, c := range changes {
+				message, err := encoder.message(c)
+				if err != nil {
+					return err
+				}
+				messages = append(messages, message)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the changes of table %s: %w", name.String(), err)
+		}
+	}
+	return messages, nil
+}
+
+// readRoot returns the root value of the given commit.
+func (hook *CommitHook) readRoot(ctx context.Context, h hash.Hash) (doltdb.RootValue, error) {
+	optCommit, err := hook.ddb.ReadCommit(ctx, h)
+	if err != nil {
+		return nil, err
+	}
+	commit, ok := optCommit.ToCommit()
+	if !ok {
+		return nil, fmt.Errorf("commit %s is not available", h.String())
+	}
+	return commit.GetRootValue(ctx)
+}
+
+// subscribedTables returns a function that reports whether a table of the given branch is subscribed.
+func subscribedTables(database string, branch string) func(schema string, table string) bool {
+	sink.Lock()
+	subscriptions := append([]Subscription(nil), sink.subscriptions...)
+	sink.Unlock()
+	return func(schema string, table string) bool {
+		for _, subscription := range subscriptions {
+			if subscription.matchesTable(database, branch, schema, table) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// rowChanges returns the changes represented by the given diff. Keyless tables store a count of identical rows, so a
+// single diff may represent several inserted or deleted rows.
+func rowChanges(ctx context.Context, d tree.Diff, fromDecoder *rowDecoder, toDecoder *rowDecoder) ([]change, error) {
+	var from, to *core.RowDecoder
+	if fromDecoder != nil {
+		from = fromDecoder.decoder
+	}
+	if toDecoder != nil {
+		to = toDecoder.decoder
+	}
+	decoded, err := core.DecodeRowDiff(ctx, d, from, to)
+	if err != nil {
+		return nil, err
+	}
+	before, after := fromDecoder.arrange(decoded.Before), toDecoder.arrange(decoded.After)
+	if (from == nil || !from.IsKeyless()) && (to == nil || !to.IsKeyless()) {
+		switch d.Type {
+		case tree.AddedDiff:
+			return []change{{op: opInsert, after: after}}, nil
+		case tree.RemovedDiff:
+			return []change{{op: opDelete, before: before}}, nil
+		default:
+			return []change{{op: opUpdate, before: before, after: after}}, nil
+		}
+	}
+	var changes []change
+	for afterCount := decoded.AfterCount; afterCount > decoded.BeforeCount; afterCount-- {
+		changes = append(changes, change{op: opInsert, after: after})
+	}
+	for beforeCount := decoded.BeforeCount; beforeCount > decoded.AfterCount; beforeCount-- {
+		changes = append(changes, change{op: opDelete, before: before})
+	}
+	return changes, nil
+}
+
+// newRowDecoder returns a decoder for rows of the given schema, placing values at the position of the column with the
+// same name.
+func newRowDecoder(sch schema.Schema, ns tree.NodeStore, columns []column) *rowDecoder {
+	targets := make(map[string]int, len(columns))
+	for i, col := range columns {
+		targets[col.name] = i
+	}
+	decoder := &rowDecoder{
+		decoder: core.NewRowDecoder(sch, ns),
+		width:   len(columns),
+	}
+	for _, col := range decoder.decoder.Columns() {
+		target, ok := targets[col.Name]
+		if !ok {
+			target = -1
+		}
+		decoder.targets = append(decoder.targets, target)
+	}
+	return decoder
+}
+
+// arrange places the decoded values of a row at the positions of their columns within the encoder. Returns nil when
+// the row is nil.
+func (decoder *rowDecoder) arrange(values []any) []any {
+	if values == nil {
+		return nil
+	}
+	row := make([]any, decoder.width)
+	for i, target := range decoder.targets {
+		if target >= 0 {
+			row[target] = values[i]
+		}
+	}
+	return row
+}
@@ -0,0 +1,382 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkasink
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/segmentio/kafka-go"
+
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// column is a column of a table whose changes are published.
+type column struct {
+	name string
+	typ  sql.Type
+	// avroType is the Avro primitive type that values of the column are written as.
+	avroType string
+}
+
+// tableEncoder builds the messages for the changes of a single table within a commit.
+type tableEncoder struct {
+	config   Config
+	database string
+	branch   string
+	commit   string
+	schema   string
+	table    string
+	topic    string
+	columns  []column
+	// keys are the indexes of the primary key columns. Keyless tables do not have any, and their messages do not have a
+	// key.
+	keys           []int
+	avroKeySchema  string
+	avroValSchema  string
+	avroNamespace  string
+	avroKeyColumns []column
+}
+
+// jsonChange is the JSON form of a message value.
+type jsonChange struct {
+	Database string          `json:"database"`
+	Branch   string          `json:"branch"`
+	Commit   string          `json:"commit"`
+	Schema   string          `json:"schema"`
+	Table    string          `json:"table"`
+	Op       string          `json:"op"`
+	Before   json.RawMessage `json:"before"`
+	After    json.RawMessage `json:"after"`
+}
+
+// newTableEncoder returns an encoder for the changes of the given table, whose columns are taken from the schema.
+func newTableEncoder(config Config, database string, branch string, commit string, name doltdb.TableName, sch schema.Schema) (*tableEncoder, error) {
+	encoder := &tableEncoder{
+		config:   config,
+		database: database,
+		branch:   branch,
+		commit:   commit,
+		schema:   name.Schema,
+		table:    name.Name,
+		topic:    topicName(config.Topic, database, branch, name.Schema, name.Name),
+	}
+	for i, col := range sch.GetAllCols().GetColumns() {
+		typ := col.TypeInfo.ToSqlType()
+		encoder.columns = append(encoder.columns, column{name: col.Name, typ: typ, avroType: avroPrimitive(typ)})
+		if col.IsPartOfPK {
+			encoder.keys = append(encoder.keys, i)
+			encoder.avroKeyColumns = append(encoder.avroKeyColumns, encoder.columns[i])
+		}
+	}
+	if config.KeyFormat == FormatAvro || config.ValueFormat == FormatAvro {
+		if err := encoder.buildAvroSchemas(); err != nil {
+			return nil, err
+		}
+	}
+	return encoder, nil
+}
+
+// message returns the message for the given change.
+func (encoder *tableEncoder) message(c change) (kafka.Message, error) {
+	message := kafka.Message{Topic: encoder.topic}
+	var err error
+	if len(encoder.keys) > 0 {
+		row := c.after
+		if row == nil {
+			row = c.before
+		}
+		keyValues := make([]any, len(encoder.keys))
+		for i, keyIdx := range encoder.keys {
+			keyValues[i] = row[keyIdx]
+		}
+		switch encoder.config.KeyFormat {
+		case FormatAvro:
+			var buf bytes.Buffer
+			if err = writeAvroRecord(&buf, encoder.avroKeyColumns, keyValues, false); err != nil {
+				return kafka.Message{}, err
+			}
+			message.Key = buf.Bytes()
+			message.Headers = append(message.Headers, kafka.Header{Key: AvroKeySchemaHeader, Value: []byte(encoder.avroKeySchema)})
+		default:
+			if message.Key, err = jsonRow(encoder.avroKeyColumns, keyValues); err != nil {
+				return kafka.Message{}, err
+			}
+		}
+	}
+	switch encoder.config.ValueFormat {
+	case FormatAvro:
+		var buf bytes.Buffer
+		for _, field := range []string{encoder.database, encoder.branch, encoder.commit, encoder.schema, encoder.table, c.op} {
+			writeAvroString(&buf, field)
+		}
+		for _, row := range [][]any{c.before, c.after} {
+			if row == nil {
+				writeAvroLong(&buf, 0)
+				continue
+			}
+			writeAvroLong(&buf, 1)
+			if err = writeAvroRecord(&buf, encoder.columns, row, true); err != nil {
+				return kafka.Message{}, err
+			}
+		}
+		message.Value = buf.Bytes()
+		message.Headers = append(message.Headers, kafka.Header{Key: AvroSchemaHeader, Value: []byte(encoder.avroValSchema)})
+	default:
+		value := jsonChange{
+			Database: encoder.database,
+			Branch:   encoder.branch,
+			Commit:   encoder.commit,
+			Schema:   encoder.schema,
+			Table:    encoder.table,
+			Op:       c.op,
+		}
+		if c.before != nil {
+			if value.Before, err = jsonRow(encoder.columns, c.before); err != nil {
+				return kafka.Message{}, err
+			}
+		}
+		if c.after != nil {
+			if value.After, err = jsonRow(encoder.columns, c.after); err != nil {
+				return kafka.Message{}, err
+			}
+		}
+		if message.Value, err = json.Marshal(value); err != nil {
+			return kafka.Message{}, err
+		}
+	}
+	return message, nil
+}
+
+// jsonRow returns the row as a JSON object, with the fields in the order of the columns.
+func jsonRow(columns []column, row []any) (json.RawMessage, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, col := range columns {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(col.name)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		value, err := jsonValue(col.typ, row[i])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// jsonValue returns the JSON form of the value. Booleans and numbers that JSON can represent keep their type, json and
+// jsonb values are embedded as-is, and all other values use their Postgres text form.
+func jsonValue(typ sql.Type, value any) (json.RawMessage, error) {
+	if value == nil {
+		return json.RawMessage("null"), nil
+	}
+	switch v := value.(type) {
+	case bool, int16, int32, int64:
+		return json.Marshal(v)
+	case float32:
+		if !math.IsNaN(float64(v)) && !math.IsInf(float64(v), 0) {
+			return json.Marshal(v)
+		}
+	case float64:
+		if !math.IsNaN(v) && !math.IsInf(v, 0) {
+			return json.Marshal(v)
+		}
+	}
+	text, err := textValue(typ, value)
+	if err != nil {
+		return nil, err
+	}
+	if dgType, ok := typ.(pgtypes.DoltgresType); ok {
+		switch dgType.BaseID() {
+		case pgtypes.DoltgresTypeBaseID_Json, pgtypes.DoltgresTypeBaseID_JsonB:
+			return json.RawMessage(text), nil
+		}
+	}
+	return json.Marshal(text)
+}
+
+// textValue returns the Postgres text form of the value.
+func textValue(typ sql.Type, value any) (string, error) {
+	if dgType, ok := typ.(pgtypes.DoltgresType); ok {
+		return dgType.IoOutput(value)
+	}
+	return fmt.Sprint(value), nil
+}
+
+// avroPrimitive returns the Avro primitive type that values of the given type are written as.
+func avroPrimitive(typ sql.Type) string {
+	dgType, ok := typ.(pgtypes.DoltgresType)
+	if !ok {
+		return "string"
+	}
+	switch dgType.BaseID() {
+	case pgtypes.DoltgresTypeBaseID_Bool:
+		return "boolean"
+	case pgtypes.DoltgresTypeBaseID_Int16, pgtypes.DoltgresTypeBaseID_Int16Serial,
+		pgtypes.DoltgresTypeBaseID_Int32, pgtypes.DoltgresTypeBaseID_Int32Serial:
+		return "int"
+	case pgtypes.DoltgresTypeBaseID_Int64, pgtypes.DoltgresTypeBaseID_Int64Serial:
+		return "long"
+	case pgtypes.DoltgresTypeBaseID_Float32:
+		return "float"
+	case pgtypes.DoltgresTypeBaseID_Float64:
+		return "double"
+	default:
+		return "string"
+	}
+}
+
+// buildAvroSchemas builds the Avro schemas of the key, the row, and the value. The row schema is nested within the value
+// schema for the before and after fields.
+func (encoder *tableEncoder) buildAvroSchemas() error {
+	encoder.avroNamespace = strings.Join([]string{"doltgres", avroName(encoder.database), avroName(encoder.schema), avroName(encoder.table)}, ".")
+	rowFields := make([]map[string]any, len(encoder.columns))
+	for i, col := range encoder.columns {
+		rowFields[i] = map[string]any{"name": avroName(col.name), "type": []any{"null", col.avroType}, "default": nil}
+	}
+	rowSchema := map[string]any{"type": "record", "name": "Row", "namespace": encoder.avroNamespace, "fields": rowFields}
+	valueFields := []map[string]any{
+		{"name": "database", "type": "string"},
+		{"name": "branch", "type": "string"},
+		{"name": "commit", "type": "string"},
+		{"name": "schema", "type": "string"},
+		{"name": "table", "type": "string"},
+		{"name": "op", "type": "string"},
+		{"name": "before", "type": []any{"null", rowSchema}, "default": nil},
+		{"name": "after", "type": []any{"null", "Row"}, "default": nil},
+	}
+	valueSchema, err := json.Marshal(map[string]any{"type": "record", "name": "Change", "namespace": encoder.avroNamespace, "fields": valueFields})
+	if err != nil {
+		return err
+	}
+	keyFields := make([]map[string]any, len(encoder.avroKeyColumns))
+	for i, col := range encoder.avroKeyColumns {
+		keyFields[i] = map[string]any{"name": avroName(col.name), "type": col.avroType}
+	}
+	keySchema, err := json.Marshal(map[string]any{"type": "record", "name": "Key", "namespace": encoder.avroNamespace, "fields": keyFields})
+	if err != nil {
+		return err
+	}
+	encoder.avroValSchema = string(valueSchema)
+	encoder.avroKeySchema = string(keySchema)
+	return nil
+}
+
+// avroName returns the given name with every character that Avro does not allow in names replaced by an underscore.
+func avroName(name string) string {
+	var sb strings.Builder
+	for i, r := range name {
+		switch {
+		case r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z'):
+			sb.WriteRune(r)
+		case r >= '0' && r <= '9':
+			if i == 0 {
+				sb.WriteRune('_')
+			}
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune('_')
+		}
+	}
+	if sb.Len() == 0 {
+		return "_"
+	}
+	return sb.String()
+}
+
+// writeAvroRecord writes the row as an Avro record of the given columns. When nullable is true, every field is a union
+// of null and the column's type.
+func writeAvroRecord(buf *bytes.Buffer, columns []column, row []any, nullable bool) error {
+	for i, col := range columns {
+		value := row[i]
+		if nullable {
+			if value == nil {
+				writeAvroLong(buf, 0)
+				continue
+			}
+			writeAvroLong(buf, 1)
+		}
+		if err := writeAvroValue(buf, col, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeAvroValue writes a non-null value using the column's Avro type.
+func writeAvroValue(buf *bytes.Buffer, col column, value any) error {
+	switch col.avroType {
+	case "boolean":
+		if value.(bool) {
+			buf.WriteByte(1)
+		} else {
+			buf.WriteByte(0)
+		}
+	case "int", "long":
+		switch v := value.(type) {
+		case int16:
+			writeAvroLong(buf, int64(v))
+		case int32:
+			writeAvroLong(buf, int64(v))
+		case int64:
+			writeAvroLong(buf, v)
+		default:
+			return fmt.Errorf("unexpected value of type %T for column %s", value, col.name)
+		}
+	case "float":
+		var b [4]byte
+		binary.LittleEndian.PutUint32(b[:], math.Float32bits(value.(float32)))
+		buf.Write(b[:])
+	case "double":
+		var b [8]byte
+		binary.LittleEndian.PutUint64(b[:], math.Float64bits(value.(float64)))
+		buf.Write(b[:])
+	default:
+		text, err := textValue(col.typ, value)
+		if err != nil {
+			return err
+		}
+		writeAvroString(buf, text)
+	}
+	return nil
+}
+
+// writeAvroLong writes an Avro int or long, which use a zig-zag variable-length encoding.
+func writeAvroLong(buf *bytes.Buffer, value int64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(b[:], value)
+	buf.Write(b[:n])
+}
+
+// writeAvroString writes an Avro string, which is its length followed by its UTF-8 bytes.
+func writeAvroString(buf *bytes.Buffer, value string) {
+	writeAvroLong(buf, int64(len(value)))
+	buf.WriteString(value)
+}
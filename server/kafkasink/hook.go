@@ -0,0 +1,117 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkasink
+
+import (
+	"context"
+	"io"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/sirupsen/logrus"
+)
+
+// CommitHook queues the commits of a database's branches to be published by the sink.
+type CommitHook struct {
+	database string
+	ddb      *doltdb.DoltDB
+}
+
+var _ doltdb.CommitHook = (*CommitHook)(nil)
+
+// RegisterDatabase returns the hook for the given database, which must be attached to the database's commit hooks. The
+// hook does nothing while the sink is not running.
+func RegisterDatabase(database string, ddb *doltdb.DoltDB) *CommitHook {
+	hook := &CommitHook{
+		database: database,
+		ddb:      ddb,
+	}
+	sink.Lock()
+	defer sink.Unlock()
+	sink.hooks[database] = hook
+	if sink.heads != nil {
+		hook.seedHeads(sink.heads)
+	}
+	return hook
+}
+
+// UnregisterDatabase stops publishing the commits of the given database.
+func UnregisterDatabase(database string) {
+	sink.Lock()
+	defer sink.Unlock()
+	delete(sink.hooks, database)
+}
+
+// Execute implements the interface doltdb.CommitHook.
+func (hook *CommitHook) Execute(ctx context.Context, ds datas.Dataset, db datas.Database) (func(context.Context) error, error) {
+	if !ref.IsRef(ds.ID()) {
+		return nil, nil
+	}
+	doltRef, err := ref.Parse(ds.ID())
+	if err != nil || doltRef.GetType() != ref.BranchRefType {
+		return nil, nil
+	}
+	head, ok := ds.MaybeHeadAddr()
+	if !ok {
+		return nil, nil
+	}
+	sink.Lock()
+	registered := sink.hooks[hook.database] == hook
+	sink.Unlock()
+	if registered {
+		enqueue(commitJob{hook: hook, branch: doltRef.GetPath(), head: head})
+	}
+	return nil, nil
+}
+
+// HandleError implements the interface doltdb.CommitHook.
+func (hook *CommitHook) HandleError(ctx context.Context, err error) error {
+	logrus.WithField("database", hook.database).Errorf("kafka sink commit hook failed: %v", err)
+	return nil
+}
+
+// SetLogger implements the interface doltdb.CommitHook.
+func (hook *CommitHook) SetLogger(ctx context.Context, wr io.Writer) error {
+	return nil
+}
+
+// ExecuteForWorkingSets implements the interface doltdb.CommitHook.
+func (hook *CommitHook) ExecuteForWorkingSets() bool {
+	return false
+}
+
+// seedHeads records the current head of each branch, so that only the commits made afterward are published.
+func (hook *CommitHook) seedHeads(heads map[string]hash.Hash) {
+	ctx := context.Background()
+	branches, err := hook.ddb.GetBranches(ctx)
+	if err != nil {
+		logrus.WithField("database", hook.database).Errorf("kafka sink failed to read branches: %v", err)
+		return
+	}
+	for _, branch := range branches {
+		commit, err := hook.ddb.ResolveCommitRef(ctx, branch)
+		if err != nil {
+			logrus.WithField("database", hook.database).Errorf("kafka sink failed to read branch %s: %v", branch.GetPath(), err)
+			continue
+		}
+		head, err := commit.HashOf()
+		if err != nil {
+			continue
+		}
+		heads[hook.database+"/"+branch.GetPath()] = head
+	}
+}
@@ -0,0 +1,384 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkasink
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/segmentio/kafka-go"
+	"github.com/sirupsen/logrus"
+)
+
+// Format is the serialization format of message keys or values.
+type Format string
+
+const (
+	// FormatJSON serializes messages as JSON objects.
+	FormatJSON Format = "json"
+	// FormatAvro serializes messages using the Avro binary encoding. The writer schema is sent in the AvroSchemaHeader
+	// (or AvroKeySchemaHeader) header of each message, as there is no schema registry to reference.
+	FormatAvro Format = "avro"
+)
+
+const (
+	// DefaultTopic is the topic template used when one has not been configured.
+	DefaultTopic = "doltgres.{database}.{branch}.{schema}.{table}"
+	// AvroSchemaHeader is the message header holding the Avro schema of the value.
+	AvroSchemaHeader = "avro.schema"
+	// AvroKeySchemaHeader is the message header holding the Avro schema of the key.
+	AvroKeySchemaHeader = "avro.key.schema"
+	// queueSize is the number of commits that may wait to be published before commits begin to block.
+	queueSize = 1024
+)
+
+// Config is the configuration of the sink.
+type Config struct {
+	// Brokers are the addresses of the Kafka brokers.
+	Brokers []string
+	// Topic is the template of the topic that a table's changes are published to. The placeholders {database},
+	// {branch}, {schema}, and {table} are replaced, and any characters that Kafka does not allow become underscores.
+	Topic string
+	// KeyFormat is the format of message keys, which hold the primary key of the changed row.
+	KeyFormat Format
+	// ValueFormat is the format of message values, which hold the change itself.
+	ValueFormat Format
+	// Subscriptions determine which changes are published.
+	Subscriptions []Subscription
+}
+
+// Subscription matches the tables whose committed changes are published. Each field is a glob pattern, as used by
+// path.Match, where an empty pattern matches everything. Table patterns match either the table's name or its
+// schema-qualified name.
+type Subscription struct {
+	Database string
+	Branch   string
+	Table    string
+}
+
+// Status is the state of the sink.
+type Status struct {
+	Running    bool
+	Published  int64
+	Failed     int64
+	LastError  string
+	LastCommit string
+	LastSentAt time.Time
+}
+
+// Writer delivers messages to Kafka. This is implemented by *kafka.Writer.
+type Writer interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+// commitJob is a branch head that has moved, and whose changes have not yet been published.
+type commitJob struct {
+	hook   *CommitHook
+	branch string
+	head   hash.Hash
+}
+
+// sink holds the running state of the sink. The hooks are always registered, as they're attached to each database when
+// the server starts, while the remaining fields are only set while the sink is running.
+var sink = struct {
+	sync.Mutex
+	hooks         map[string]*CommitHook
+	config        Config
+	subscriptions []Subscription
+	writer        Writer
+	queue         chan commitJob
+	done          chan struct{}
+	stopped       chan struct{}
+	heads         map[string]hash.Hash
+	status        Status
+}{
+	hooks: make(map[string]*CommitHook),
+}
+
+// Start starts publishing committed changes using the given configuration. When the writer is nil, a writer is created
+// for the configured brokers.
+func Start(config Config, writer Writer) error {
+	if len(config.Topic) == 0 {
+		config.Topic = DefaultTopic
+	}
+	if len(config.KeyFormat) == 0 {
+		config.KeyFormat = FormatJSON
+	}
+	if len(config.ValueFormat) == 0 {
+		config.ValueFormat = FormatJSON
+	}
+	for _, format := range []Format{config.KeyFormat, config.ValueFormat} {
+		if format != FormatJSON && format != FormatAvro {
+			return fmt.Errorf(`invalid kafka sink format "%s", expected "%s" or "%s"`, format, FormatJSON, FormatAvro)
+		}
+	}
+	for _, subscription := range config.Subscriptions {
+		if err := subscription.validate(); err != nil {
+			return err
+		}
+	}
+	if writer == nil {
+		if len(config.Brokers) == 0 {
+			return fmt.Errorf("the kafka sink requires at least one broker")
+		}
+		writer = &kafka.Writer{
+			Addr:                   kafka.TCP(config.Brokers...),
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			BatchTimeout:           10 * time.Millisecond,
+			AllowAutoTopicCreation: true,
+		}
+	}
+
+	sink.Lock()
+	defer sink.Unlock()
+	if sink.writer != nil {
+		return fmt.Errorf("the kafka sink is already running")
+	}
+	sink.config = config
+	sink.subscriptions = append([]Subscription(nil), config.Subscriptions...)
+	sink.writer = writer
+	sink.queue = make(chan commitJob, queueSize)
+	sink.done = make(chan struct{})
+	sink.stopped = make(chan struct{})
+	sink.heads = make(map[string]hash.Hash)
+	sink.status = Status{Running: true}
+	// Changes are published relative to the heads that exist when the sink starts
+	for _, hook := range sink.hooks {
+		hook.seedHeads(sink.heads)
+	}
+	go publishCommits(sink.queue, sink.done, sink.stopped)
+	return nil
+}
+
+// Stop publishes the commits that have already been queued, and then stops the sink. Does nothing if the sink is not
+// running.
+func Stop() error {
+	sink.Lock()
+	if sink.writer == nil {
+		sink.Unlock()
+		return nil
+	}
+	writer := sink.writer
+	done, stopped := sink.done, sink.stopped
+	close(done)
+	sink.Unlock()
+
+	<-stopped
+	sink.Lock()
+	defer sink.Unlock()
+	sink.writer = nil
+	sink.queue = nil
+	sink.status.Running = false
+	return writer.Close()
+}
+
+// Running returns whether the sink is running.
+func Running() bool {
+	sink.Lock()
+	defer sink.Unlock()
+	return sink.writer != nil
+}
+
+// GetStatus returns the current status of the sink.
+func GetStatus() Status {
+	sink.Lock()
+	defer sink.Unlock()
+	return sink.status
+}
+
+// Subscribe adds the given subscription. Returns false if an identical subscription already exists. Subscriptions
+// added while the server is running are not persisted.
+func Subscribe(subscription Subscription) (bool, error) {
+	if err := subscription.validate(); err != nil {
+		return false, err
+	}
+	sink.Lock()
+	defer sink.Unlock()
+	if sink.writer == nil {
+		return false, errNotRunning
+	}
+	for _, existing := range sink.subscriptions {
+		if existing == subscription {
+			return false, nil
+		}
+	}
+	sink.subscriptions = append(sink.subscriptions, subscription)
+	return true, nil
+}
+
+// Unsubscribe removes the given subscription. Returns whether it existed.
+func Unsubscribe(subscription Subscription) (bool, error) {
+	sink.Lock()
+	defer sink.Unlock()
+	if sink.writer == nil {
+		return false, errNotRunning
+	}
+	for i, existing := range sink.subscriptions {
+		if existing == subscription {
+			sink.subscriptions = append(sink.subscriptions[:i], sink.subscriptions[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Subscriptions returns every subscription, sorted by database, branch, and table.
+func Subscriptions() ([]Subscription, error) {
+	sink.Lock()
+	defer sink.Unlock()
+	if sink.writer == nil {
+		return nil, errNotRunning
+	}
+	subscriptions := append([]Subscription(nil), sink.subscriptions...)
+	sort.Slice(subscriptions, func(i, j int) bool {
+		if subscriptions[i].Database != subscriptions[j].Database {
+			return subscriptions[i].Database < subscriptions[j].Database
+		}
+		if subscriptions[i].Branch != subscriptions[j].Branch {
+			return subscriptions[i].Branch < subscriptions[j].Branch
+		}
+		return subscriptions[i].Table < subscriptions[j].Table
+	})
+	return subscriptions, nil
+}
+
+// errNotRunning is returned when managing the sink while it is not running.
+var errNotRunning = fmt.Errorf("the kafka sink is not running")
+
+// validate returns an error if any of the subscription's patterns are malformed.
+func (s Subscription) validate() error {
+	for _, pattern := range []string{s.Database, s.Branch, s.Table} {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf(`invalid kafka sink subscription pattern "%s"`, pattern)
+		}
+	}
+	return nil
+}
+
+// matchesBranch returns whether the subscription applies to any table of the given branch.
+func (s Subscription) matchesBranch(database string, branch string) bool {
+	return matchPattern(s.Database, database) && matchPattern(s.Branch, branch)
+}
+
+// matchesTable returns whether the subscription applies to the given table.
+func (s Subscription) matchesTable(database string, branch string, schema string, table string) bool {
+	if !s.matchesBranch(database, branch) {
+		return false
+	}
+	return matchPattern(s.Table, table) || matchPattern(s.Table, schema+"."+table)
+}
+
+// matchPattern returns whether the value matches the glob pattern. Empty patterns match everything.
+func matchPattern(pattern string, value string) bool {
+	if len(pattern) == 0 {
+		return true
+	}
+	matched, _ := path.Match(pattern, value)
+	return matched
+}
+
+// enqueue adds the moved branch head to the queue of commits to publish. This blocks while the queue is full, so that
+// changes are not dropped when Kafka falls behind.
+func enqueue(job commitJob) {
+	sink.Lock()
+	queue, done := sink.queue, sink.done
+	sink.Unlock()
+	if queue == nil {
+		return
+	}
+	select {
+	case queue <- job:
+	case <-done:
+	}
+}
+
+// publishCommits publishes each queued commit in order, until the sink is stopped and the queue has been drained.
+func publishCommits(queue chan commitJob, done chan struct{}, stopped chan struct{}) {
+	defer close(stopped)
+	for {
+		select {
+		case job := <-queue:
+			publishCommit(job)
+		case <-done:
+			for {
+				select {
+				case job := <-queue:
+					publishCommit(job)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// publishCommit publishes the changes between the last published head of the job's branch and its new head.
+func publishCommit(job commitJob) {
+	ctx := context.Background()
+	headKey := job.hook.database + "/" + job.branch
+	sink.Lock()
+	config, writer := sink.config, sink.writer
+	from, known := sink.heads[headKey]
+	sink.heads[headKey] = job.head
+	sink.Unlock()
+	// Branches that did not exist when the sink started have nothing to compare against, so we only track their head
+	if !known || from == job.head {
+		return
+	}
+
+	messages, err := job.hook.buildMessages(ctx, config, job.branch, from, job.head)
+	if err == nil && len(messages) > 0 {
+		err = writer.WriteMessages(ctx, messages...)
+	}
+
+	sink.Lock()
+	defer sink.Unlock()
+	if err != nil {
+		sink.status.Failed += int64(len(messages))
+		sink.status.LastError = err.Error()
+		logrus.WithField("database", job.hook.database).WithField("branch", job.branch).
+			Errorf("kafka sink failed to publish commit %s: %v", job.head.String(), err)
+		return
+	}
+	sink.status.Published += int64(len(messages))
+	sink.status.LastCommit = job.head.String()
+	if len(messages) > 0 {
+		sink.status.LastSentAt = time.Now().UTC()
+	}
+}
+
+// topicName returns the topic for the given table, using the configured template.
+func topicName(template string, database string, branch string, schema string, table string) string {
+	topic := strings.NewReplacer(
+		"{database}", database,
+		"{branch}", branch,
+		"{schema}", schema,
+		"{table}", table,
+	).Replace(template)
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '.' || r == '_' || r == '-' {
+			return r
+		}
+		return '_'
+	}, topic)
+}
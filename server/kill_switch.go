@@ -0,0 +1,46 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+
+	doltsqlserver "github.com/dolthub/dolt/go/libraries/doltcore/sqlserver"
+
+	"github.com/dolthub/doltgresql/server/killswitch"
+)
+
+// startKillSwitch checks the query against the kill switch rules, returning an error if the query has been rejected.
+// Otherwise, the query may be canceled by a rule while it runs, and the returned function must be called once the query
+// has finished.
+func (h *ConnectionHandler) startKillSwitch(query ConvertedQuery) (func(), error) {
+	if len(query.Fingerprint) == 0 {
+		return func() {}, nil
+	}
+	return killswitch.Start(query.Fingerprint, func() {
+		h.cancelQuery(fmt.Sprintf("canceling statement due to kill switch (fingerprint %s)", query.Fingerprint))
+	})
+}
+
+// cancelQuery cancels the query that is running on this connection, with the given message sent to the client in place
+// of the query's error.
+func (h *ConnectionHandler) cancelQuery(message string) {
+	runningServer := doltsqlserver.GetRunningServer()
+	if runningServer == nil || runningServer.Engine == nil {
+		return
+	}
+	h.queryCanceled.Store(&message)
+	runningServer.Engine.ProcessList.Kill(h.mysqlConn.ConnectionID)
+}
@@ -0,0 +1,280 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package killswitch
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dolthub/doltgresql/postgres/parser/parser"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+)
+
+// Action is the action that is taken against the queries that match a rule.
+type Action string
+
+const (
+	// ActionReject rejects matching queries before they're executed.
+	ActionReject Action = "reject"
+	// ActionCancel cancels matching queries once they've run for the rule's duration.
+	ActionCancel Action = "cancel"
+)
+
+// Rule is a kill switch that applies to every query with the given fingerprint.
+type Rule struct {
+	Fingerprint string `json:"fingerprint"`
+	Action      Action `json:"action"`
+	// CancelAfter is how long a query may run before it is canceled. This only applies to the cancel action.
+	CancelAfter time.Duration `json:"cancel_after,omitempty"`
+	// Query is the normalized text of the query that the rule was created from, if the rule was created from a query
+	// rather than a fingerprint.
+	Query     string    `json:"query,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// runningQuery is a query that is currently executing, which may be canceled if a rule matches it.
+type runningQuery struct {
+	fingerprint string
+	started     time.Time
+	cancel      func()
+	timer       *time.Timer
+}
+
+// registry holds the rules, along with every running query that they may apply to.
+var registry = struct {
+	sync.Mutex
+	path    string
+	rules   map[string]Rule
+	running map[*runningQuery]struct{}
+}{
+	rules:   make(map[string]Rule),
+	running: make(map[*runningQuery]struct{}),
+}
+
+// Fingerprint returns the fingerprint of the given statement. Constants are removed before the statement is hashed, so
+// that statements that only differ by their literals share a fingerprint.
+func Fingerprint(stmt tree.Statement) string {
+	return fingerprintNormalized(normalize(stmt))
+}
+
+// FingerprintQuery returns the fingerprint of the given query text, along with its normalized form.
+func FingerprintQuery(query string) (fingerprint string, normalized string, err error) {
+	stmts, err := parser.Parse(query)
+	if err != nil {
+		return "", "", err
+	}
+	if len(stmts) == 0 {
+		return "", "", fmt.Errorf("cannot fingerprint an empty query")
+	}
+	normalizedStmts := make([]string, len(stmts))
+	for i, stmt := range stmts {
+		normalizedStmts[i] = normalize(stmt.AST)
+	}
+	normalized = strings.Join(normalizedStmts, "; ")
+	return fingerprintNormalized(normalized), normalized, nil
+}
+
+// IsFingerprint returns whether the given string has the form of a fingerprint.
+func IsFingerprint(s string) bool {
+	if len(s) != 16 {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil && strings.ToLower(s) == s
+}
+
+// normalize returns the text of the statement with its constants removed.
+func normalize(stmt tree.Statement) string {
+	return tree.AsStringWithFlags(stmt, tree.FmtHideConstants)
+}
+
+// fingerprintNormalized returns the fingerprint of normalized query text.
+func fingerprintNormalized(normalized string) string {
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:8])
+}
+
+// Load replaces the current rules with those persisted at the given path. Rules are held in memory only when the path
+// is empty. Changes to the rules are written back to the path.
+func Load(path string) error {
+	rules := make(map[string]Rule)
+	if len(path) > 0 {
+		data, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if len(data) > 0 {
+			var loadedRules []Rule
+			if err = json.Unmarshal(data, &loadedRules); err != nil {
+				return fmt.Errorf("unable to read the kill switch rules at %s: %w", path, err)
+			}
+			for _, rule := range loadedRules {
+				rules[rule.Fingerprint] = rule
+			}
+		}
+	}
+	registry.Lock()
+	defer registry.Unlock()
+	registry.path = path
+	registry.rules = rules
+	return nil
+}
+
+// AddRule adds the given rule, replacing any rule with the same fingerprint. The rule applies to matching queries that
+// are already running, along with those that run later.
+func AddRule(rule Rule) error {
+	switch rule.Action {
+	case ActionReject, ActionCancel:
+	default:
+		return fmt.Errorf(`invalid kill switch action "%s", expected "%s" or "%s"`, rule.Action, ActionReject, ActionCancel)
+	}
+	if !IsFingerprint(rule.Fingerprint) {
+		return fmt.Errorf(`invalid query fingerprint "%s"`, rule.Fingerprint)
+	}
+	if rule.CancelAfter < 0 {
+		return fmt.Errorf("the duration before a query is canceled cannot be negative")
+	}
+	if rule.Action == ActionReject {
+		rule.CancelAfter = 0
+	}
+	if rule.CreatedAt.IsZero() {
+		rule.CreatedAt = time.Now().UTC()
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+	registry.rules[rule.Fingerprint] = rule
+	if err := persist(); err != nil {
+		return err
+	}
+	for query := range registry.running {
+		if query.fingerprint == rule.Fingerprint {
+			scheduleCancel(query, rule)
+		}
+	}
+	return nil
+}
+
+// RemoveRule removes the rule with the given fingerprint. Returns whether a rule was removed. Queries that were
+// scheduled to be canceled by the rule are no longer canceled.
+func RemoveRule(fingerprint string) (bool, error) {
+	registry.Lock()
+	defer registry.Unlock()
+	if _, ok := registry.rules[fingerprint]; !ok {
+		return false, nil
+	}
+	delete(registry.rules, fingerprint)
+	if err := persist(); err != nil {
+		return false, err
+	}
+	for query := range registry.running {
+		if query.fingerprint == fingerprint && query.timer != nil {
+			query.timer.Stop()
+			query.timer = nil
+		}
+	}
+	return true, nil
+}
+
+// Rules returns every rule, sorted by fingerprint.
+func Rules() []Rule {
+	registry.Lock()
+	defer registry.Unlock()
+	rules := make([]Rule, 0, len(registry.rules))
+	for _, rule := range registry.rules {
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].Fingerprint < rules[j].Fingerprint
+	})
+	return rules
+}
+
+// Start is called before a query with the given fingerprint is executed. Returns an error if a rule rejects the query.
+// Otherwise, the given function is used to cancel the query if a rule requires it, and the returned function must be
+// called once the query has finished.
+func Start(fingerprint string, cancel func()) (finish func(), err error) {
+	registry.Lock()
+	defer registry.Unlock()
+	rule, hasRule := registry.rules[fingerprint]
+	if hasRule && rule.Action == ActionReject {
+		return nil, fmt.Errorf("query rejected by kill switch (fingerprint %s)", fingerprint)
+	}
+	query := &runningQuery{
+		fingerprint: fingerprint,
+		started:     time.Now(),
+		cancel:      cancel,
+	}
+	if hasRule {
+		scheduleCancel(query, rule)
+	}
+	registry.running[query] = struct{}{}
+	return func() {
+		registry.Lock()
+		defer registry.Unlock()
+		if query.timer != nil {
+			query.timer.Stop()
+		}
+		delete(registry.running, query)
+	}, nil
+}
+
+// scheduleCancel schedules the cancellation of the query according to the rule. The registry's lock must be held.
+func scheduleCancel(query *runningQuery, rule Rule) {
+	if query.timer != nil {
+		query.timer.Stop()
+	}
+	// Queries that are running when a reject rule is added are canceled immediately
+	remaining := rule.CancelAfter - time.Since(query.started)
+	if remaining < 0 {
+		remaining = 0
+	}
+	query.timer = time.AfterFunc(remaining, query.cancel)
+}
+
+// persist writes the rules to the registry's path. The registry's lock must be held.
+func persist() error {
+	if len(registry.path) == 0 {
+		return nil
+	}
+	rules := make([]Rule, 0, len(registry.rules))
+	for _, rule := range registry.rules {
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].Fingerprint < rules[j].Fingerprint
+	})
+	data, err := json.MarshalIndent(rules, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(registry.path), 0755); err != nil {
+		return err
+	}
+	// The rules are written to a temporary file first, so that a failed write does not lose the existing rules
+	tempPath := registry.path + ".tmp"
+	if err = os.WriteFile(tempPath, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tempPath, registry.path)
+}
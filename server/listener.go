@@ -16,34 +16,73 @@ package server
 
 import (
 	"crypto/tls"
-	"fmt"
+	"errors"
 	"net"
-	"os"
 
 	"github.com/dolthub/go-mysql-server/server"
 	"github.com/dolthub/vitess/go/mysql"
 	"github.com/dolthub/vitess/go/netutil"
+	"github.com/sirupsen/logrus"
 )
 
 var (
 	connectionIDCounter uint32
-	processID           = int32(os.Getpid())
-	certificate         tls.Certificate //TODO: move this into the mysql.ListenerConfig
+	// serverTLSConfig is used by listeners that were not given their own TLS configuration. This is set when the server
+	// starts, as listeners are created through a factory that only receives the mysql.ListenerConfig.
+	serverTLSConfig *tls.Config
+	// serverRequireTLS is used by listeners that were not told whether to require TLS. This is set alongside
+	// serverTLSConfig.
+	serverRequireTLS bool
+	// serverAuthenticator decides how clients authenticate, and is nil when every connection is trusted. This is set
+	// alongside serverTLSConfig.
+	serverAuthenticator *authenticator
+	// serverConnectionLimits is shared by every listener, as the limits apply to the server as a whole. This is set
+	// alongside serverTLSConfig.
+	serverConnectionLimits *connectionLimits
+	// serverBoundListener is the listener that was bound before the server started, such as by an embedded server, and
+	// is nil otherwise. This is set alongside serverTLSConfig.
+	serverBoundListener net.Listener
 )
 
 // Listener listens for connections to process PostgreSQL requests into Dolt requests.
 type Listener struct {
 	listener net.Listener
-	cfg      mysql.ListenerConfig
+	// boundListener is the listener that was bound before the server started, which is used for the server's address.
+	// The engine's listener is still served alongside it, as it also accepts connections on any Unix socket.
+	boundListener net.Listener
+	cfg           mysql.ListenerConfig
+	tlsConfig     *tls.Config
+	requireTLS    bool
+	authenticator *authenticator
+	limits        *connectionLimits
 }
 
 var _ server.ProtocolListener = (*Listener)(nil)
 
 type ListenerOpt func(*Listener)
 
+// WithCertificate configures the listener to accept TLS connections using the given certificate.
 func WithCertificate(cert tls.Certificate) ListenerOpt {
 	return func(l *Listener) {
-		certificate = cert
+		l.tlsConfig = &tls.Config{
+			Certificates: []tls.Certificate{cert},
+		}
+	}
+}
+
+// WithTLSConfig configures the listener to accept TLS connections using the given configuration. A nil configuration
+// disables TLS.
+func WithTLSConfig(tlsConfig *tls.Config) ListenerOpt {
+	return func(l *Listener) {
+		l.tlsConfig = tlsConfig
+	}
+}
+
+// WithRequireTLS configures whether the listener turns away connections that have not been upgraded to TLS.
+// Connections over a Unix socket are always allowed, just as Postgres does not apply its hostssl rules to them.
+func WithRequireTLS(requireTLS bool) ListenerOpt {
+	return func(l *Listener) {
+		l.requireTLS = requireTLS
 	}
 }
 
@@ -54,8 +93,13 @@ func NewListener(listenerCfg mysql.ListenerConfig) (server.ProtocolListener, err
 
 func NewListenerWithOpts(listenerCfg mysql.ListenerConfig, opts ...ListenerOpt) (server.ProtocolListener, error) {
 	l := &Listener{
-		listener: listenerCfg.Listener,
-		cfg:      listenerCfg,
+		listener:      listenerCfg.Listener,
+		boundListener: serverBoundListener,
+		cfg:           listenerCfg,
+		tlsConfig:     serverTLSConfig,
+		requireTLS:    serverRequireTLS,
+		authenticator: serverAuthenticator,
+		limits:        serverConnectionLimits,
 	}
 
 	for _, opt := range opts {
@@ -67,13 +111,30 @@ func NewListenerWithOpts(listenerCfg mysql.ListenerConfig, opts ...ListenerOpt)
 
 // Accept handles incoming connections.
 func (l *Listener) Accept() {
+	// The engine exists by the time that connections are accepted, so this is the earliest that we can add our functions
+	if err := registerTableFunctions(); err != nil {
+		logrus.WithError(err).Error("Unable to register table functions")
+	}
+	registerStatementRunner()
+	if err := registerKafkaSink(); err != nil {
+		logrus.WithError(err).Error("Unable to start the kafka sink")
+	}
+	registerPushReplication()
+	if l.boundListener != nil {
+		go l.acceptConnections(l.boundListener)
+	}
+	l.acceptConnections(l.listener)
+}
+
+// acceptConnections handles the connections from the given listener until it has been closed.
+func (l *Listener) acceptConnections(listener net.Listener) {
 	for {
-		conn, err := l.listener.Accept()
+		conn, err := listener.Accept()
 		if err != nil {
-			if err.Error() == "use of closed network connection" {
+			if errors.Is(err, net.ErrClosed) {
 				break
 			}
-			fmt.Printf("Unable to accept connection:\n%v\n", err)
+			logrus.WithError(err).Error("Unable to accept connection")
 			continue
 		}
 
@@ -84,6 +145,10 @@ func (l *Listener) Accept() {
 		}
 
 		connectionHandler := NewConnectionHandler(conn, l.cfg.Handler)
+		connectionHandler.tlsConfig = l.tlsConfig
+		connectionHandler.requireTLS = l.requireTLS
+		connectionHandler.authenticator = l.authenticator
+		connectionHandler.connectionLimits = l.limits
 		go connectionHandler.HandleConnection()
 	}
 }
@@ -91,9 +156,16 @@ func (l *Listener) Accept() {
 // Close stops the handling of incoming connections.
 func (l *Listener) Close() {
 	_ = l.listener.Close()
+	if l.boundListener != nil {
+		_ = l.boundListener.Close()
+	}
 }
 
-// Addr returns the address that the listener is listening on.
+// Addr returns the address that the listener is listening on, which is the address of the listener that was bound
+// before the server started when there is one.
 func (l *Listener) Addr() net.Addr {
+	if l.boundListener != nil {
+		return l.boundListener.Addr()
+	}
 	return l.listener.Addr()
 }
@@ -0,0 +1,142 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package locks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrLockTimeout is returned when a lock could not be acquired before the session's lock timeout expired.
+var ErrLockTimeout = errors.NewKind("canceling statement due to lock timeout")
+
+// lock is a single held lock. A session may acquire the same lock multiple times, in which case it must be released
+// the same number of times.
+type lock struct {
+	owner uint32
+	count int
+	// released is closed once the lock has been fully released, waking any sessions that are waiting on it.
+	released chan struct{}
+}
+
+// advisoryWaiter is an advisory lock that a session is waiting on.
+type advisoryWaiter struct {
+	key   int64
+	since time.Time
+}
+
+// held contains every lock that is currently held, keyed by the lock's key, along with the locks that sessions are
+// waiting on.
+var held = struct {
+	sync.Mutex
+	byKey   map[int64]*lock
+	waiting map[uint32]advisoryWaiter
+}{byKey: make(map[int64]*lock), waiting: make(map[uint32]advisoryWaiter)}
+
+// Acquire acquires the lock with the given key for the session, waiting for as long as another session holds it. The
+// wait ends early if the context is canceled, or once the timeout expires, where a timeout of zero waits indefinitely.
+func Acquire(ctx context.Context, sessionID uint32, key int64, timeout time.Duration) error {
+	var timeoutC <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		timeoutC = timer.C
+	}
+	for {
+		released, ok := tryAcquire(sessionID, key)
+		if ok {
+			return nil
+		}
+		select {
+		case <-released:
+		case <-timeoutC:
+			stopWaitingAdvisory(sessionID)
+			return ErrLockTimeout.New()
+		case <-ctx.Done():
+			stopWaitingAdvisory(sessionID)
+			return ctx.Err()
+		}
+	}
+}
+
+// TryAcquire acquires the lock with the given key for the session if it's available, without waiting. Returns whether
+// the lock was acquired.
+func TryAcquire(sessionID uint32, key int64) bool {
+	_, ok := tryAcquire(sessionID, key)
+	return ok
+}
+
+// tryAcquire acquires the lock if it's available. If it's held by another session, the session is recorded as waiting
+// on the lock, and this returns a channel that is closed once that session releases it.
+func tryAcquire(sessionID uint32, key int64) (<-chan struct{}, bool) {
+	held.Lock()
+	defer held.Unlock()
+	l, ok := held.byKey[key]
+	if !ok {
+		delete(held.waiting, sessionID)
+		held.byKey[key] = &lock{owner: sessionID, count: 1, released: make(chan struct{})}
+		return nil, true
+	}
+	if l.owner == sessionID {
+		delete(held.waiting, sessionID)
+		l.count++
+		return nil, true
+	}
+	if w, ok := held.waiting[sessionID]; !ok || w.key != key {
+		held.waiting[sessionID] = advisoryWaiter{key: key, since: time.Now()}
+	}
+	return l.released, false
+}
+
+// stopWaitingAdvisory records that the session is no longer waiting on an advisory lock.
+func stopWaitingAdvisory(sessionID uint32) {
+	held.Lock()
+	defer held.Unlock()
+	delete(held.waiting, sessionID)
+}
+
+// Release releases one acquisition of the lock with the given key. Returns false if the session does not hold the lock.
+func Release(sessionID uint32, key int64) bool {
+	held.Lock()
+	defer held.Unlock()
+	l, ok := held.byKey[key]
+	if !ok || l.owner != sessionID {
+		return false
+	}
+	l.count--
+	if l.count == 0 {
+		delete(held.byKey, key)
+		close(l.released)
+	}
+	return true
+}
+
+// ReleaseAll releases every lock held by the session, including its table and row locks. This is called when the
+// session ends.
+func ReleaseAll(sessionID uint32) {
+	ReleaseTransaction(sessionID)
+	held.Lock()
+	defer held.Unlock()
+	delete(held.waiting, sessionID)
+	for key, l := range held.byKey {
+		if l.owner == sessionID {
+			delete(held.byKey, key)
+			close(l.released)
+		}
+	}
+}
@@ -0,0 +1,351 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package locks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrDeadlockDetected is returned when waiting for a lock would never end, as the sessions holding the lock are
+// themselves waiting, directly or indirectly, on a lock held by the waiting session.
+var ErrDeadlockDetected = errors.NewKind("deadlock detected")
+
+// ErrRelationLockNotAvailable is returned when a relation lock is requested with NOWAIT while another session holds a
+// conflicting lock.
+var ErrRelationLockNotAvailable = errors.NewKind(`could not obtain lock on relation "%s"`)
+
+// ErrRowLockNotAvailable is returned when a row lock is requested with NOWAIT while another session holds a conflicting
+// lock.
+var ErrRowLockNotAvailable = errors.NewKind(`could not obtain lock on row in relation "%s"`)
+
+// Relation identifies a table that may be locked.
+type Relation struct {
+	Database string
+	Schema   string
+	Name     string
+}
+
+// Mode is a table-level lock mode, which determines the modes that other sessions may hold on the same table at the
+// same time. The modes are ordered from the weakest to the strongest.
+type Mode uint8
+
+const (
+	AccessShare Mode = iota
+	RowShare
+	RowExclusive
+	ShareUpdateExclusive
+	Share
+	ShareRowExclusive
+	Exclusive
+	AccessExclusive
+)
+
+// modeConflicts contains, for each table-level lock mode, the set of modes that it conflicts with.
+var modeConflicts = [...]uint8{
+	AccessShare:          1 << AccessExclusive,
+	RowShare:             1<<Exclusive | 1<<AccessExclusive,
+	RowExclusive:         1<<Share | 1<<ShareRowExclusive | 1<<Exclusive | 1<<AccessExclusive,
+	ShareUpdateExclusive: 1<<ShareUpdateExclusive | 1<<Share | 1<<ShareRowExclusive | 1<<Exclusive | 1<<AccessExclusive,
+	Share:                1<<RowExclusive | 1<<ShareUpdateExclusive | 1<<ShareRowExclusive | 1<<Exclusive | 1<<AccessExclusive,
+	ShareRowExclusive:    1<<RowExclusive | 1<<ShareUpdateExclusive | 1<<Share | 1<<ShareRowExclusive | 1<<Exclusive | 1<<AccessExclusive,
+	Exclusive:            0xFF &^ (1 << AccessShare),
+	AccessExclusive:      0xFF,
+}
+
+// String returns the name of the mode as it's written in a LOCK statement.
+func (m Mode) String() string {
+	switch m {
+	case AccessShare:
+		return "ACCESS SHARE"
+	case RowShare:
+		return "ROW SHARE"
+	case RowExclusive:
+		return "ROW EXCLUSIVE"
+	case ShareUpdateExclusive:
+		return "SHARE UPDATE EXCLUSIVE"
+	case Share:
+		return "SHARE"
+	case ShareRowExclusive:
+		return "SHARE ROW EXCLUSIVE"
+	case Exclusive:
+		return "EXCLUSIVE"
+	case AccessExclusive:
+		return "ACCESS EXCLUSIVE"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// RowMode is a row-level lock mode, as taken by the locking clauses of a SELECT statement. The modes are ordered from
+// the weakest to the strongest.
+type RowMode uint8
+
+const (
+	ForKeyShare RowMode = iota
+	ForShare
+	ForNoKeyUpdate
+	ForUpdate
+)
+
+// String returns the locking clause that takes the mode.
+func (m RowMode) String() string {
+	switch m {
+	case ForKeyShare:
+		return "FOR KEY SHARE"
+	case ForShare:
+		return "FOR SHARE"
+	case ForNoKeyUpdate:
+		return "FOR NO KEY UPDATE"
+	case ForUpdate:
+		return "FOR UPDATE"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// rowModeConflicts contains, for each row-level lock mode, the set of modes that it conflicts with.
+var rowModeConflicts = [...]uint8{
+	ForKeyShare:    1 << ForUpdate,
+	ForShare:       1<<ForNoKeyUpdate | 1<<ForUpdate,
+	ForNoKeyUpdate: 1<<ForShare | 1<<ForNoKeyUpdate | 1<<ForUpdate,
+	ForUpdate:      1<<ForKeyShare | 1<<ForShare | 1<<ForNoKeyUpdate | 1<<ForUpdate,
+}
+
+// WaitPolicy determines what happens when a lock is held by another session in a conflicting mode.
+type WaitPolicy uint8
+
+const (
+	// WaitBlock waits until the lock is available.
+	WaitBlock WaitPolicy = iota
+	// WaitSkip gives up on the lock without an error, so that a locked row may be skipped.
+	WaitSkip
+	// WaitError gives up on the lock with an error.
+	WaitError
+)
+
+// object is either a relation or a single row of a relation, which is identified by the hash of its key.
+type object struct {
+	relation Relation
+	row      uint64
+	isRow    bool
+}
+
+// conflicts returns the set of modes that conflict with the given mode on the object.
+func (o object) conflicts(mode uint8) uint8 {
+	if o.isRow {
+		return rowModeConflicts[mode]
+	}
+	return modeConflicts[mode]
+}
+
+// lockedObject holds the modes that each session holds on an object.
+type lockedObject struct {
+	holders map[uint32]uint8
+	// changed is closed whenever a session releases its locks on the object, waking any sessions that are waiting on
+	// it, and is then replaced.
+	changed chan struct{}
+}
+
+// waiter is a lock that a session is waiting on.
+type waiter struct {
+	obj   object
+	mode  uint8
+	since time.Time
+}
+
+// objects contains every table and row lock that is currently held, along with the locks that sessions are waiting on.
+// Unlike advisory locks, these are held until the end of the session's transaction.
+var objects = struct {
+	sync.Mutex
+	byObject  map[object]*lockedObject
+	bySession map[uint32][]object
+	waiting   map[uint32]waiter
+}{
+	byObject:  make(map[object]*lockedObject),
+	bySession: make(map[uint32][]object),
+	waiting:   make(map[uint32]waiter),
+}
+
+// LockRelation acquires a lock on the relation in the given mode, which is held until the session's transaction ends.
+// A session's own locks never conflict with each other. When another session holds a conflicting lock, this either waits
+// for that lock's release, or returns an error when |nowait| is set.
+func LockRelation(ctx context.Context, sessionID uint32, relation Relation, mode Mode, nowait bool, timeout time.Duration) error {
+	policy := WaitBlock
+	if nowait {
+		policy = WaitError
+	}
+	_, err := acquireObject(ctx, sessionID, object{relation: relation}, uint8(mode), policy, timeout)
+	return err
+}
+
+// LockRow acquires a lock on the row of the relation with the given key hash, which is held until the session's
+// transaction ends. Returns false if the row is locked by another session and the policy is WaitSkip.
+func LockRow(ctx context.Context, sessionID uint32, relation Relation, row uint64, mode RowMode, policy WaitPolicy, timeout time.Duration) (bool, error) {
+	return acquireObject(ctx, sessionID, object{relation: relation, row: row, isRow: true}, uint8(mode), policy, timeout)
+}
+
+// ReleaseTransaction releases every table and row lock held by the session. This is called when the session's
+// transaction ends.
+func ReleaseTransaction(sessionID uint32) {
+	objects.Lock()
+	defer objects.Unlock()
+	releaseObjects(sessionID)
+}
+
+// Timeout returns the session's lock_timeout, where zero means that lock waits are not limited.
+func Timeout(ctx *sql.Context) time.Duration {
+	if lockTimeout, err := ctx.GetSessionVariable(ctx, "lock_timeout"); err == nil {
+		if milliseconds, ok := lockTimeout.(int64); ok {
+			return time.Duration(milliseconds) * time.Millisecond
+		}
+	}
+	return 0
+}
+
+// acquireObject acquires the lock on the object, following the wait policy when it's held by other sessions.
+func acquireObject(ctx context.Context, sessionID uint32, obj object, mode uint8, policy WaitPolicy, timeout time.Duration) (bool, error) {
+	var timeoutC <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		timeoutC = timer.C
+	}
+	for {
+		changed, ok, err := tryAcquireObject(sessionID, obj, mode, policy)
+		if ok || err != nil {
+			return ok, err
+		}
+		if changed == nil {
+			return false, nil
+		}
+		select {
+		case <-changed:
+		case <-timeoutC:
+			stopWaiting(sessionID)
+			return false, ErrLockTimeout.New()
+		case <-ctx.Done():
+			stopWaiting(sessionID)
+			return false, ctx.Err()
+		}
+	}
+}
+
+// tryAcquireObject acquires the lock on the object if no other session holds a conflicting lock. Otherwise, the session
+// is recorded as waiting on the object, and this returns a channel that is closed once the object's holders change. No
+// channel is returned when the policy does not allow waiting.
+func tryAcquireObject(sessionID uint32, obj object, mode uint8, policy WaitPolicy) (<-chan struct{}, bool, error) {
+	objects.Lock()
+	defer objects.Unlock()
+	locked, ok := objects.byObject[obj]
+	if !ok {
+		locked = &lockedObject{holders: make(map[uint32]uint8), changed: make(chan struct{})}
+		objects.byObject[obj] = locked
+	}
+	if len(blockers(locked, sessionID, obj.conflicts(mode))) == 0 {
+		delete(objects.waiting, sessionID)
+		if _, ok = locked.holders[sessionID]; !ok {
+			objects.bySession[sessionID] = append(objects.bySession[sessionID], obj)
+		}
+		locked.holders[sessionID] |= 1 << mode
+		return nil, true, nil
+	}
+	switch policy {
+	case WaitSkip:
+		return nil, false, nil
+	case WaitError:
+		if obj.isRow {
+			return nil, false, ErrRowLockNotAvailable.New(obj.relation.Name)
+		}
+		return nil, false, ErrRelationLockNotAvailable.New(obj.relation.Name)
+	}
+	since := time.Now()
+	if w, ok := objects.waiting[sessionID]; ok && w.obj == obj && w.mode == mode {
+		since = w.since
+	}
+	objects.waiting[sessionID] = waiter{obj: obj, mode: mode, since: since}
+	if waitsOn(sessionID, sessionID, make(map[uint32]struct{})) {
+		delete(objects.waiting, sessionID)
+		return nil, false, ErrDeadlockDetected.New()
+	}
+	return locked.changed, false, nil
+}
+
+// blockers returns the sessions, other than the given session, that hold a lock on the object in one of the given
+// modes. This must be called while holding the mutex.
+func blockers(locked *lockedObject, sessionID uint32, conflicts uint8) []uint32 {
+	var sessions []uint32
+	for holder, modes := range locked.holders {
+		if holder != sessionID && modes&conflicts != 0 {
+			sessions = append(sessions, holder)
+		}
+	}
+	return sessions
+}
+
+// waitsOn returns whether the session is waiting, directly or indirectly, on a lock held by the target session. This is
+// used to find cycles of waiting sessions, which would otherwise wait forever. This must be called while holding the
+// mutex.
+func waitsOn(sessionID uint32, target uint32, visited map[uint32]struct{}) bool {
+	w, ok := objects.waiting[sessionID]
+	if !ok {
+		return false
+	}
+	visited[sessionID] = struct{}{}
+	locked, ok := objects.byObject[w.obj]
+	if !ok {
+		return false
+	}
+	for _, blocker := range blockers(locked, sessionID, w.obj.conflicts(w.mode)) {
+		if blocker == target {
+			return true
+		}
+		if _, ok = visited[blocker]; !ok && waitsOn(blocker, target, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// stopWaiting records that the session is no longer waiting on a lock.
+func stopWaiting(sessionID uint32) {
+	objects.Lock()
+	defer objects.Unlock()
+	delete(objects.waiting, sessionID)
+}
+
+// releaseObjects releases every table and row lock held by the session, waking the sessions that wait on them. This
+// must be called while holding the mutex.
+func releaseObjects(sessionID uint32) {
+	for _, obj := range objects.bySession[sessionID] {
+		locked, ok := objects.byObject[obj]
+		if !ok {
+			continue
+		}
+		delete(locked.holders, sessionID)
+		close(locked.changed)
+		if len(locked.holders) == 0 {
+			delete(objects.byObject, obj)
+		} else {
+			locked.changed = make(chan struct{})
+		}
+	}
+	delete(objects.bySession, sessionID)
+	delete(objects.waiting, sessionID)
+}
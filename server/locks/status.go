@@ -0,0 +1,170 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package locks
+
+import (
+	"sort"
+	"time"
+)
+
+// Kind is the kind of object that a lock is taken on.
+type Kind uint8
+
+const (
+	KindRelation Kind = iota
+	KindRow
+	KindAdvisory
+)
+
+// LockStatus describes a single mode of a lock that a session either holds or is waiting on.
+type LockStatus struct {
+	SessionID uint32
+	Kind      Kind
+	// Relation is the locked table, which is only set for relation and row locks.
+	Relation Relation
+	// Key is the key of an advisory lock.
+	Key int64
+	// Mode is the name of the lock mode, using the names of the table-level modes.
+	Mode    string
+	Granted bool
+	// WaitStart is the time that the session started waiting on the lock, which is only set when it's not granted.
+	WaitStart time.Time
+}
+
+// LockName returns the name of the mode as it's reported by pg_locks.
+func (m Mode) LockName() string {
+	switch m {
+	case AccessShare:
+		return "AccessShareLock"
+	case RowShare:
+		return "RowShareLock"
+	case RowExclusive:
+		return "RowExclusiveLock"
+	case ShareUpdateExclusive:
+		return "ShareUpdateExclusiveLock"
+	case Share:
+		return "ShareLock"
+	case ShareRowExclusive:
+		return "ShareRowExclusiveLock"
+	case Exclusive:
+		return "ExclusiveLock"
+	case AccessExclusive:
+		return "AccessExclusiveLock"
+	default:
+		return "UnknownLock"
+	}
+}
+
+// LockName returns the name of the table-level mode that Postgres uses for row locks of this mode when they're
+// reported by pg_locks.
+func (m RowMode) LockName() string {
+	switch m {
+	case ForKeyShare:
+		return AccessShare.LockName()
+	case ForShare:
+		return RowShare.LockName()
+	case ForNoKeyUpdate:
+		return Exclusive.LockName()
+	case ForUpdate:
+		return AccessExclusive.LockName()
+	default:
+		return "UnknownLock"
+	}
+}
+
+// Status returns every lock that is currently held or waited on, with one entry for each mode that a session holds on
+// an object. Entries are ordered by session, then by kind, relation, key, and mode, with granted entries first.
+func Status() []LockStatus {
+	var statuses []LockStatus
+	objects.Lock()
+	for obj, locked := range objects.byObject {
+		for sessionID, modes := range locked.holders {
+			for mode := uint8(0); mode < 8; mode++ {
+				if modes&(1<<mode) != 0 {
+					statuses = append(statuses, objectStatus(sessionID, obj, mode, true, time.Time{}))
+				}
+			}
+		}
+	}
+	for sessionID, w := range objects.waiting {
+		statuses = append(statuses, objectStatus(sessionID, w.obj, w.mode, false, w.since))
+	}
+	objects.Unlock()
+
+	held.Lock()
+	for key, l := range held.byKey {
+		statuses = append(statuses, advisoryStatus(l.owner, key, true, time.Time{}))
+	}
+	for sessionID, w := range held.waiting {
+		statuses = append(statuses, advisoryStatus(sessionID, w.key, false, w.since))
+	}
+	held.Unlock()
+
+	sort.Slice(statuses, func(i, j int) bool {
+		a, b := statuses[i], statuses[j]
+		if a.SessionID != b.SessionID {
+			return a.SessionID < b.SessionID
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Relation != b.Relation {
+			if a.Relation.Database != b.Relation.Database {
+				return a.Relation.Database < b.Relation.Database
+			}
+			if a.Relation.Schema != b.Relation.Schema {
+				return a.Relation.Schema < b.Relation.Schema
+			}
+			return a.Relation.Name < b.Relation.Name
+		}
+		if a.Key != b.Key {
+			return a.Key < b.Key
+		}
+		if a.Granted != b.Granted {
+			return a.Granted
+		}
+		return a.Mode < b.Mode
+	})
+	return statuses
+}
+
+// objectStatus returns the status of a relation or row lock.
+func objectStatus(sessionID uint32, obj object, mode uint8, granted bool, since time.Time) LockStatus {
+	status := LockStatus{
+		SessionID: sessionID,
+		Kind:      KindRelation,
+		Relation:  obj.relation,
+		Mode:      Mode(mode).LockName(),
+		Granted:   granted,
+		WaitStart: since,
+	}
+	if obj.isRow {
+		status.Kind = KindRow
+		status.Mode = RowMode(mode).LockName()
+	}
+	return status
+}
+
+// advisoryStatus returns the status of an advisory lock.
+func advisoryStatus(sessionID uint32, key int64, granted bool, since time.Time) LockStatus {
+	return LockStatus{
+		SessionID: sessionID,
+		Kind:      KindAdvisory,
+		Key:       key,
+		Mode:      "ExclusiveLock",
+		Granted:   granted,
+		WaitStart: since,
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Config is the format and destination of the server's log.
+type Config struct {
+	// Format is either "text" or "json".
+	Format string
+	// File is the file that the log is written to. The logger's output is left unchanged when this is empty.
+	File string
+	// MaxSize is the size, in bytes, that the file may reach before it is rotated. Zero disables rotation.
+	MaxSize int64
+	// MaxBackups is the number of rotated files that are retained. Zero retains every rotated file.
+	MaxBackups int
+	// MaxAge is how long rotated files are retained. Zero retains files regardless of their age.
+	MaxAge time.Duration
+}
+
+// Validate returns an error if the config is invalid.
+func (cfg Config) Validate() error {
+	if _, err := cfg.formatter(); err != nil {
+		return err
+	}
+	if cfg.MaxSize < 0 || cfg.MaxBackups < 0 || cfg.MaxAge < 0 {
+		return fmt.Errorf("the log rotation limits cannot be negative")
+	}
+	return nil
+}
+
+// Apply sets the formatter and output of the given logger. The returned function restores the logger's previous
+// formatter and output, closing the file if one was opened.
+func Apply(logger *logrus.Logger, cfg Config) (restore func() error, err error) {
+	formatter, err := cfg.formatter()
+	if err != nil {
+		return nil, err
+	}
+	var file *RotatingFile
+	if len(cfg.File) > 0 {
+		file, err = OpenRotatingFile(cfg.File, cfg.MaxSize, cfg.MaxBackups, cfg.MaxAge)
+		if err != nil {
+			return nil, fmt.Errorf("unable to open the log file: %w", err)
+		}
+	}
+
+	priorFormatter := logger.Formatter
+	priorOutput := logger.Out
+	logger.SetFormatter(formatter)
+	if file != nil {
+		logger.SetOutput(file)
+	}
+	return func() error {
+		logger.SetFormatter(priorFormatter)
+		if file == nil {
+			return nil
+		}
+		logger.SetOutput(priorOutput)
+		return file.Close()
+	}, nil
+}
+
+// formatter returns the formatter for the configured format.
+func (cfg Config) formatter() (logrus.Formatter, error) {
+	switch cfg.Format {
+	case "", "text":
+		return &logrus.TextFormatter{
+			FullTimestamp:   true,
+			TimestampFormat: time.RFC3339Nano,
+			// Colors are only useful in a terminal, and would otherwise be written to the file as escape sequences
+			DisableColors: len(cfg.File) > 0,
+		}, nil
+	case "json":
+		return &logrus.JSONFormatter{TimestampFormat: time.RFC3339Nano}, nil
+	default:
+		return nil, fmt.Errorf(`unknown log format "%s", expected "text" or "json"`, cfg.Format)
+	}
+}
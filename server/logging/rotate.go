@@ -0,0 +1,161 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// backupTimeFormat is the format of the timestamp that is appended to the name of a rotated file. Timestamps in this
+// format sort in the same order as the times that they represent.
+const backupTimeFormat = "2006-01-02T15-04-05.000000000"
+
+// RotatingFile is a file that is rotated once it reaches its maximum size. The rotated file is renamed using the time
+// of its rotation, and old rotated files are removed according to the retention limits.
+type RotatingFile struct {
+	mu         sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	maxAge     time.Duration
+	file       *os.File
+	size       int64
+}
+
+var _ io.WriteCloser = (*RotatingFile)(nil)
+
+// OpenRotatingFile opens the file at the given path for appending, creating it if it does not exist. A |maxSize| of
+// zero disables rotation, while a |maxBackups| or |maxAge| of zero retains rotated files regardless of their number or
+// age respectively.
+func OpenRotatingFile(path string, maxSize int64, maxBackups int, maxAge time.Duration) (*RotatingFile, error) {
+	r := &RotatingFile{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+		maxAge:     maxAge,
+	}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Write implements the interface io.Writer. The file is rotated before the write if the write would exceed the maximum
+// size, unless the file is empty, so that a single large entry is never split between files.
+func (r *RotatingFile) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.maxSize > 0 && r.size > 0 && r.size+int64(len(p)) > r.maxSize {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := r.file.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+// Close implements the interface io.Closer.
+func (r *RotatingFile) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.file.Close()
+}
+
+// Backups returns the paths of the rotated files, from oldest to newest.
+func (r *RotatingFile) Backups() ([]string, error) {
+	dir, name := filepath.Split(r.path)
+	entries, err := os.ReadDir(filepath.Clean(dir))
+	if err != nil {
+		return nil, err
+	}
+	var backups []string
+	for _, entry := range entries {
+		suffix, ok := strings.CutPrefix(entry.Name(), name+".")
+		if !ok || entry.IsDir() {
+			continue
+		}
+		if _, err = time.Parse(backupTimeFormat, suffix); err == nil {
+			backups = append(backups, filepath.Join(dir, entry.Name()))
+		}
+	}
+	sort.Strings(backups)
+	return backups, nil
+}
+
+// open opens the file for appending, recording its current size.
+func (r *RotatingFile) open() error {
+	if dir := filepath.Dir(r.path); len(dir) > 0 {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+	}
+	file, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	r.file = file
+	r.size = info.Size()
+	return nil
+}
+
+// rotate renames the current file and opens a new one, then removes any rotated files that exceed the retention limits.
+func (r *RotatingFile) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return err
+	}
+	backup := r.path + "." + time.Now().UTC().Format(backupTimeFormat)
+	if err := os.Rename(r.path, backup); err != nil {
+		return err
+	}
+	if err := r.open(); err != nil {
+		return err
+	}
+	return r.removeExpired()
+}
+
+// removeExpired removes the oldest rotated files that exceed the maximum number of backups, along with every rotated
+// file that exceeds the maximum age.
+func (r *RotatingFile) removeExpired() error {
+	backups, err := r.Backups()
+	if err != nil {
+		return err
+	}
+	for i, backup := range backups {
+		remove := r.maxBackups > 0 && len(backups)-i > r.maxBackups
+		if !remove && r.maxAge > 0 {
+			if info, err := os.Stat(backup); err == nil && time.Since(info.ModTime()) > r.maxAge {
+				remove = true
+			}
+		}
+		if remove {
+			if err = os.Remove(backup); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+	return nil
+}
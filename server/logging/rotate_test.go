@@ -0,0 +1,65 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRotatingFile verifies that the file is rotated once it reaches its maximum size, and that only the newest rotated
+// files are retained.
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "doltgres.log")
+	file, err := OpenRotatingFile(path, 16, 2, 0)
+	require.NoError(t, err)
+	for _, entry := range []string{"first entry\n", "second entry\n", "third entry\n", "an entry that exceeds the size\n", "fifth\n"} {
+		_, err = file.Write([]byte(entry))
+		require.NoError(t, err)
+	}
+	require.NoError(t, file.Close())
+
+	current, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "fifth\n", string(current))
+	backups, err := file.Backups()
+	require.NoError(t, err)
+	require.Len(t, backups, 2)
+	var contents []string
+	for _, backup := range backups {
+		assert.True(t, strings.HasPrefix(filepath.Base(backup), "doltgres.log."))
+		data, err := os.ReadFile(backup)
+		require.NoError(t, err)
+		contents = append(contents, string(data))
+	}
+	assert.Equal(t, []string{"third entry\n", "an entry that exceeds the size\n"}, contents)
+
+	// Reopening the file appends to it, counting its existing contents toward its size
+	file, err = OpenRotatingFile(path, 16, 2, 0)
+	require.NoError(t, err)
+	_, err = file.Write([]byte("sixth\n"))
+	require.NoError(t, err)
+	_, err = file.Write([]byte("seventh\n"))
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	current, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "seventh\n", string(current))
+}
@@ -0,0 +1,91 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+)
+
+// StatementClass is the class of a statement, as used by the log_statement parameter. The classes are ordered, so that
+// a statement is logged whenever the parameter is at least as broad as the statement's class.
+type StatementClass uint8
+
+const (
+	// StatementClassNone logs no statements. No statement belongs to this class.
+	StatementClassNone StatementClass = iota
+	// StatementClassDDL contains statements that define or change objects, such as CREATE, ALTER, DROP, and GRANT.
+	StatementClassDDL
+	// StatementClassMod contains statements that modify data, such as INSERT, UPDATE, DELETE, TRUNCATE, and COPY FROM.
+	StatementClassMod
+	// StatementClassAll contains every other statement.
+	StatementClassAll
+)
+
+// String returns the name of the class, which is the value of the log_statement parameter that logs the class.
+func (class StatementClass) String() string {
+	switch class {
+	case StatementClassNone:
+		return "none"
+	case StatementClassDDL:
+		return "ddl"
+	case StatementClassMod:
+		return "mod"
+	case StatementClassAll:
+		return "all"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseStatementClass returns the class with the given name, which is the value of the log_statement parameter.
+// Returns false if the name is not recognized.
+func ParseStatementClass(name string) (StatementClass, bool) {
+	switch name {
+	case "none":
+		return StatementClassNone, true
+	case "ddl":
+		return StatementClassDDL, true
+	case "mod":
+		return StatementClassMod, true
+	case "all":
+		return StatementClassAll, true
+	default:
+		return StatementClassNone, false
+	}
+}
+
+// ClassifyStatement returns the class of the given statement. Matching Postgres, prepared statements and EXPLAIN
+// ANALYZE take the class of the statement that they contain, since the contained statement is executed.
+func ClassifyStatement(stmt tree.Statement) StatementClass {
+	switch stmt := stmt.(type) {
+	case nil:
+		return StatementClassAll
+	case *tree.Prepare:
+		return ClassifyStatement(stmt.Statement)
+	case *tree.Explain:
+		if stmt.Flags[tree.ExplainFlagAnalyze] {
+			return ClassifyStatement(stmt.Statement)
+		}
+		return StatementClassAll
+	case *tree.Insert, *tree.Update, *tree.Delete, *tree.Truncate, *tree.CopyFrom:
+		return StatementClassMod
+	case *tree.CreateRole, *tree.AlterRole, *tree.DropRole:
+		return StatementClassDDL
+	}
+	if stmt.StatementType() == tree.DDL {
+		return StatementClassDDL
+	}
+	return StatementClassAll
+}
@@ -21,6 +21,7 @@ import (
 	"log"
 	"math"
 	"os"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -45,23 +46,64 @@ type LogicalReplicator struct {
 	primaryDns     string
 	replicationDns string
 
+	// publications are the names of the publications that are subscribed to. When empty, the publication with the same
+	// name as the slot is used.
+	publications []string
+	// commitMessage is the message template of the Dolt commit that is created for each replicated transaction. Dolt
+	// commits are only created when this is non-empty.
+	commitMessage string
+
 	walFilePath     string
 	running         bool
 	messageReceived bool
-	stop            chan struct{}
-	mu              *sync.Mutex
+	// caughtUpAt is the latest time that the replica is known to have matched the primary.
+	caughtUpAt time.Time
+	stop       chan struct{}
+	mu         *sync.Mutex
+}
+
+// DefaultCommitMessage is the default message template of the Dolt commits that are created for replicated
+// transactions.
+const DefaultCommitMessage = "Replicated transaction {xid} at LSN {lsn}"
+
+// ReplicatorOption configures optional behavior of a LogicalReplicator.
+type ReplicatorOption func(r *LogicalReplicator)
+
+// WithPublications subscribes to the publications with the given names, rather than the publication with the same name
+// as the replication slot.
+func WithPublications(names ...string) ReplicatorOption {
+	return func(r *LogicalReplicator) {
+		r.publications = names
+	}
+}
+
+// WithDoltCommits creates a Dolt commit for each replicated transaction, so that every transaction on the primary may
+// be found in the replica's history. The message template may contain the {xid}, {lsn}, and {commit_time}
+// placeholders, which are replaced by the values of the primary's transaction, along with any placeholder of the
+// dolt.transaction_commit_message parameter. An empty template uses DefaultCommitMessage.
+func WithDoltCommits(messageTemplate string) ReplicatorOption {
+	return func(r *LogicalReplicator) {
+		if len(messageTemplate) == 0 {
+			messageTemplate = DefaultCommitMessage
+		}
+		r.commitMessage = messageTemplate
+	}
 }
 
 // NewLogicalReplicator creates a new logical replicator instance which connects to the primary and replication
 // databases using the connection strings provided. The connection to the replica is established immediately, and the
 // connection to the primary is established when StartReplication is called.
-func NewLogicalReplicator(walFilePath string, primaryDns string, replicationDns string) (*LogicalReplicator, error) {
-	return &LogicalReplicator{
+func NewLogicalReplicator(walFilePath string, primaryDns string, replicationDns string, options ...ReplicatorOption) (*LogicalReplicator, error) {
+	r := &LogicalReplicator{
 		primaryDns:     primaryDns,
 		replicationDns: replicationDns,
 		walFilePath:    walFilePath,
 		mu:             &sync.Mutex{},
-	}, nil
+	}
+	for _, option := range options {
+		option(r)
+	}
+	return r, nil
 }
 
 // PrimaryDns returns the DNS for the primary database. Not suitable for RPCs used in replication e.g.
@@ -132,6 +174,29 @@ func (r *LogicalReplicator) CaughtUp(threshold int) (bool, error) {
 	return true, nil
 }
 
+// Lag returns the time since the replica last matched the primary. Transactions are applied in the order that they
+// were committed, so applying a transaction means that the replica matched the primary as of the transaction's commit
+// time. The replica also matches the primary whenever nothing is sent before the next standby status update, so this
+// remains below the interval of those updates while an idle replica is healthy. Returns false if the replica has never
+// matched the primary.
+func (r *LogicalReplicator) Lag() (time.Duration, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.caughtUpAt.IsZero() {
+		return 0, false
+	}
+	return time.Since(r.caughtUpAt), true
+}
+
+// markCaughtUp records that the replica matched the primary as of the given time.
+func (r *LogicalReplicator) markCaughtUp(at time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if at.After(r.caughtUpAt) {
+		r.caughtUpAt = at
+	}
+}
+
 // maxConsecutiveFailures is the maximum number of consecutive RPC errors that can occur before we stop
 // the replication thread
 const maxConsecutiveFailures = 10
@@ -154,6 +219,9 @@ type replicationState struct {
 	// when we get a CommitMessage
 	currentTransactionLSN pglogrepl.LSN
 
+	// currentCommitTime is the time that the current transaction was committed on the primary.
+	currentCommitTime time.Time
+
 	// inStream tracks the state of the replication stream. When we receive a StreamStartMessage, we set inStream to
 	// true, and then back to false when we receive a StreamStopMessage.
 	inStream bool
@@ -187,6 +255,14 @@ func (r *LogicalReplicator) StartReplication(slotName string) error {
 		return err
 	}
 
+	if len(r.commitMessage) > 0 {
+		// Each replicated transaction is committed using the transaction commit of the replica's session
+		if err = r.replicateQuery(replicationConn, "SET dolt.transaction_commit = on"); err != nil {
+			_ = replicationConn.Close(context.Background())
+			return err
+		}
+	}
+
 	state := &replicationState{
 		lastWrittenLSN: lastWrittenLsn,
 		replicaConn:    replicationConn,
@@ -295,6 +371,10 @@ func (r *LogicalReplicator) StartReplication(slotName string) error {
 				return errShutdownRequested
 			case <-ctx.Done():
 				cancel()
+				// Nothing was sent by the primary before the deadline, so we've applied everything outside of a transaction
+				if !state.processMessages {
+					r.markCaughtUp(time.Now())
+				}
 				return nil
 			case msgAndErr = <-receiveMsgChan:
 				cancel()
@@ -302,6 +382,9 @@ func (r *LogicalReplicator) StartReplication(slotName string) error {
 
 			if msgAndErr.err != nil {
 				if pgconn.Timeout(msgAndErr.err) {
+					if !state.processMessages {
+						r.markCaughtUp(time.Now())
+					}
 					return nil
 				} else {
 					return handleErrWithRetry(msgAndErr.err)
@@ -360,6 +443,7 @@ func (r *LogicalReplicator) StartReplication(slotName string) error {
 					if err != nil {
 						return err
 					}
+					r.markCaughtUp(state.currentCommitTime)
 				}
 
 				return sendStandbyStatusUpdate(state)
@@ -427,9 +511,13 @@ func (r *LogicalReplicator) beginReplication(slotName string, lastFlushLsn pglog
 
 	// streaming of large transactions is available since PG 14 (protocol version 2)
 	// we also need to set 'streaming' to 'true'
+	publications := r.publications
+	if len(publications) == 0 {
+		publications = []string{slotName}
+	}
 	pluginArguments := []string{
 		"proto_version '2'",
-		fmt.Sprintf("publication_names '%s'", slotName),
+		"publication_names " + publicationNamesArgument(publications),
 		"messages 'true'",
 		"streaming 'true'",
 	}
@@ -450,6 +538,17 @@ func (r *LogicalReplicator) beginReplication(slotName string, lastFlushLsn pglog
 	return conn, nil
 }
 
+// publicationNamesArgument returns the value of the publication_names plugin argument, which is a string literal that
+// contains a comma-separated list of identifiers. Each name is quoted as an identifier, and the list is then quoted as
+// a literal, so that names containing quotes or commas are passed through unchanged.
+func publicationNamesArgument(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+	}
+	return "'" + strings.ReplaceAll(strings.Join(quoted, ","), "'", "''") + "'"
+}
+
 // DropPublication drops the publication with the given name if it exists. Mostly useful for testing.
 func DropPublication(primaryDns, slotName string) error {
 	conn, err := pgconn.Connect(context.Background(), primaryDns)
@@ -572,8 +671,16 @@ func (r *LogicalReplicator) processMessage(
 
 		state.processMessages = true
 		state.currentTransactionLSN = logicalMsg.FinalLSN
+		state.currentCommitTime = logicalMsg.CommitTime
 
 		log.Printf("BeginMessage: %v", logicalMsg)
+		if len(r.commitMessage) > 0 {
+			err = r.replicateQuery(state.replicaConn, fmt.Sprintf("SET dolt.transaction_commit_message = '%s'",
+				strings.ReplaceAll(r.expandCommitMessage(logicalMsg), "'", "''")))
+			if err != nil {
+				return false, err
+			}
+		}
 		err = r.replicateQuery(state.replicaConn, "START TRANSACTION")
 		if err != nil {
 			return false, err
@@ -764,6 +871,15 @@ func (r *LogicalReplicator) processMessage(
 	return false, nil
 }
 
+// expandCommitMessage returns the message of the Dolt commit for the transaction that begins with the given message.
+func (r *LogicalReplicator) expandCommitMessage(begin *pglogrepl.BeginMessage) string {
+	return strings.NewReplacer(
+		"{xid}", strconv.FormatUint(uint64(begin.Xid), 10),
+		"{lsn}", begin.FinalLSN.String(),
+		"{commit_time}", begin.CommitTime.UTC().Format(time.RFC3339),
+	).Replace(r.commitMessage)
+}
+
 // readWALPosition reads the recorded WAL position from the WAL position file
 func (r *LogicalReplicator) readWALPosition() (pglogrepl.LSN, error) {
 	walFileContents, err := os.ReadFile(r.walFilePath)
@@ -0,0 +1,29 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logrepl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublicationNamesArgument(t *testing.T) {
+	assert.Equal(t, `'"pub"'`, publicationNamesArgument([]string{"pub"}))
+	assert.Equal(t, `'"pub1","Pub2"'`, publicationNamesArgument([]string{"pub1", "Pub2"}))
+	assert.Equal(t, `'"a,b"'`, publicationNamesArgument([]string{"a,b"}))
+	assert.Equal(t, `'"it''s","say ""hi"""'`, publicationNamesArgument([]string{"it's", `say "hi"`}))
+	assert.Equal(t, `'"x'') , streaming ''false"'`, publicationNamesArgument([]string{"x') , streaming 'false"}))
+}
@@ -0,0 +1,150 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/unvalidated"
+)
+
+// skipConstraintValidationKey is the context key that is set while a constraint is being added using NOT VALID.
+type skipConstraintValidationKey struct{}
+
+// SkipsConstraintValidation returns whether the constraints that are added within the context should not be verified
+// against the rows that already exist in the table.
+func SkipsConstraintValidation(ctx *sql.Context) bool {
+	skip, _ := ctx.Value(skipConstraintValidationKey{}).(bool)
+	return skip
+}
+
+// AddConstraintNotValid handles ALTER TABLE ... ADD CONSTRAINT ... NOT VALID for CHECK and FOREIGN KEY constraints.
+// The constraint is added without verifying the existing rows, and is recorded as unvalidated until the rows are
+// verified by VALIDATE CONSTRAINT. Rows that are written after the constraint has been added are always verified.
+type AddConstraintNotValid struct {
+	schema     string
+	table      string
+	constraint string
+	kind       unvalidated.Kind
+	expression string
+	alter      *vitess.AlterTable
+	runner     ConvertedStatementRunner
+}
+
+var _ sql.ExecSourceRel = (*AddConstraintNotValid)(nil)
+var _ vitess.Injectable = (*AddConstraintNotValid)(nil)
+
+// NewAddConstraintNotValid returns a new *AddConstraintNotValid. The expression is only used by CHECK constraints.
+func NewAddConstraintNotValid(schema string, table string, constraint string, kind unvalidated.Kind, expression string, alter *vitess.AlterTable) *AddConstraintNotValid {
+	return &AddConstraintNotValid{
+		schema:     schema,
+		table:      table,
+		constraint: constraint,
+		kind:       kind,
+		expression: expression,
+		alter:      alter,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *AddConstraintNotValid) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// The ALTER TABLE statement that is executed will check its own privileges
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *AddConstraintNotValid) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *AddConstraintNotValid) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *AddConstraintNotValid) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *AddConstraintNotValid) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if c.runner == nil {
+		return nil, fmt.Errorf("ALTER TABLE is missing its statement runner")
+	}
+	schema := c.schema
+	if len(schema) == 0 {
+		var err error
+		schema, err = core.GetCurrentSchema(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	skipCtx := ctx.WithContext(context.WithValue(ctx.Context, skipConstraintValidationKey{}, true))
+	if err := runDDL(skipCtx, c.runner, c.alter); err != nil {
+		return nil, err
+	}
+	collection, err := core.GetUnvalidatedCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	collection.AddConstraint(&unvalidated.Constraint{
+		Name:       c.constraint,
+		Table:      doltdb.TableName{Name: c.table, Schema: schema},
+		Kind:       c.kind,
+		Expression: c.expression,
+	})
+	if err = core.UpdateUnvalidatedCollection(ctx, collection); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *AddConstraintNotValid) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *AddConstraintNotValid) String() string {
+	return "ADD CONSTRAINT NOT VALID"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *AddConstraintNotValid) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *AddConstraintNotValid) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
+
+// WithStatementRunner returns a copy of this node that adds the constraint using the given runner.
+func (c *AddConstraintNotValid) WithStatementRunner(runner ConvertedStatementRunner) *AddConstraintNotValid {
+	nc := *c
+	nc.runner = runner
+	return &nc
+}
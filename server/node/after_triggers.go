@@ -0,0 +1,145 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/rowexec"
+)
+
+// AfterTriggers fires the AFTER triggers for each row that was written by an INSERT, UPDATE, or DELETE. The triggers
+// fire once the statement has written every row, so that the trigger functions are able to see every change that the
+// statement made. The rows are collected by a BeforeTriggers node that shares the same WrittenRows.
+type AfterTriggers struct {
+	child    sql.Node
+	target   TriggerTarget
+	triggers []RowTrigger
+	written  *WrittenRows
+	runner   StatementRunner
+}
+
+var _ sql.ExecSourceRel = (*AfterTriggers)(nil)
+
+// NewAfterTriggers returns a new *AfterTriggers.
+func NewAfterTriggers(child sql.Node, target TriggerTarget, triggers []RowTrigger, written *WrittenRows, runner StatementRunner) *AfterTriggers {
+	return &AfterTriggers{
+		child:    child,
+		target:   target,
+		triggers: triggers,
+		written:  written,
+		runner:   runner,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (at *AfterTriggers) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return at.child.CheckPrivileges(ctx, opChecker)
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (at *AfterTriggers) Children() []sql.Node {
+	return []sql.Node{at.child}
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (at *AfterTriggers) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (at *AfterTriggers) Resolved() bool {
+	return at.child.Resolved()
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (at *AfterTriggers) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	at.written.rows = nil
+	childIter, err := rowexec.DefaultBuilder.Build(ctx, at.child, r)
+	if err != nil {
+		return nil, err
+	}
+	return &afterTriggersIter{node: at, childIter: childIter}, nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (at *AfterTriggers) Schema() sql.Schema {
+	return at.child.Schema()
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (at *AfterTriggers) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("AfterTriggers")
+	_ = pr.WriteChildren(at.child.String())
+	return pr.String()
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (at *AfterTriggers) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(at, len(children), 1)
+	}
+	nat := *at
+	nat.child = children[0]
+	return &nat, nil
+}
+
+// afterTriggersIter is the iterator for *AfterTriggers. The child is read in its entirety and closed on the first call
+// to Next, which writes its changes, and the triggers then fire before the child's rows are returned.
+type afterTriggersIter struct {
+	node      *AfterTriggers
+	childIter sql.RowIter
+	rows      []sql.Row
+	fired     bool
+}
+
+var _ sql.RowIter = (*afterTriggersIter)(nil)
+
+// Next implements the interface sql.RowIter.
+func (iter *afterTriggersIter) Next(ctx *sql.Context) (sql.Row, error) {
+	if !iter.fired {
+		iter.fired = true
+		rows, err := sql.RowIterToRows(ctx, iter.childIter)
+		iter.childIter = nil
+		if err != nil {
+			return nil, err
+		}
+		iter.rows = rows
+		for _, written := range iter.node.written.rows {
+			for _, trigger := range iter.node.triggers {
+				if _, err = trigger.fire(ctx, iter.node.target, "AFTER", written.Old, written.New, iter.node.runner); err != nil {
+					return nil, err
+				}
+			}
+		}
+		iter.node.written.rows = nil
+	}
+	if len(iter.rows) == 0 {
+		return nil, io.EOF
+	}
+	row := iter.rows[0]
+	iter.rows = iter.rows[1:]
+	return row, nil
+}
+
+// Close implements the interface sql.RowIter.
+func (iter *afterTriggersIter) Close(ctx *sql.Context) error {
+	if iter.childIter != nil {
+		return iter.childIter.Close(ctx)
+	}
+	return nil
+}
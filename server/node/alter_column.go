@@ -0,0 +1,280 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/doltgresql/core"
+)
+
+// AlterColumnAction is the change that AlterColumn makes to a column.
+type AlterColumnAction uint8
+
+const (
+	AlterColumnAction_Add AlterColumnAction = iota
+	AlterColumnAction_Drop
+	AlterColumnAction_Rename
+	AlterColumnAction_SetDefault
+	AlterColumnAction_DropDefault
+	AlterColumnAction_SetNotNull
+	AlterColumnAction_DropNotNull
+	AlterColumnAction_SetType
+)
+
+// ColumnAlteration describes a change to a single column of a table.
+type ColumnAlteration struct {
+	Action AlterColumnAction
+	// Column is the name of the existing column. This is unused when adding a column.
+	Column string
+	// NewName is the new name of the column when renaming a column.
+	NewName string
+	// NewColumn is the column that is being added.
+	NewColumn *sql.Column
+	// Type is the new type of the column when changing its type.
+	Type sql.Type
+	// Default is the new default of the column when setting its default.
+	Default *sql.ColumnDefaultValue
+	// Value returns the column's value for each existing row, and is evaluated against the row as it exists before the
+	// alteration. This is used when changing the type of a column, and when adding a column that has a default.
+	Value sql.Expression
+	// DroppedChecks are the names of the checks that are dropped along with the column.
+	DroppedChecks []string
+}
+
+// AlterColumn handles the alteration of the columns of existing tables. This replaces the GMS implementations, as they
+// rely on Dolt's table alteration, which does not account for schemas.
+type AlterColumn struct {
+	gmsNode    sql.Node
+	database   sql.Database
+	tableName  doltdb.TableName
+	alteration ColumnAlteration
+}
+
+var _ sql.ExecSourceRel = (*AlterColumn)(nil)
+
+// NewAlterColumn returns a new *AlterColumn. The GMS node is the node that is being replaced, while the database is
+// the one that contains the table.
+func NewAlterColumn(gmsNode sql.Node, database sql.Database, tableName doltdb.TableName, alteration ColumnAlteration) *AlterColumn {
+	return &AlterColumn{
+		gmsNode:    gmsNode,
+		database:   database,
+		tableName:  tableName,
+		alteration: alteration,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (ac *AlterColumn) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return ac.gmsNode.CheckPrivileges(ctx, opChecker)
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (ac *AlterColumn) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (ac *AlterColumn) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (ac *AlterColumn) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (ac *AlterColumn) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	// Earlier alterations in the same statement may have changed the table, so we load the table as it exists now
+	table, ok, err := ac.database.GetTableInsensitive(ctx, ac.tableName.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf(`relation "%s" does not exist`, ac.tableName.Name)
+	}
+	sch := table.Schema()
+	alteration := ac.alteration
+	columns := make([]core.AlteredColumn, len(sch))
+	for i, col := range sch {
+		columns[i] = core.AlteredColumn{Column: col.Copy(), OldName: col.Name}
+	}
+	colIdx := -1
+	if alteration.Action != AlterColumnAction_Add {
+		colIdx = sch.IndexOfColName(alteration.Column)
+		if colIdx == -1 {
+			return nil, fmt.Errorf(`column "%s" of relation "%s" does not exist`, alteration.Column, table.Name())
+		}
+	}
+	var rows sql.RowIter
+	switch alteration.Action {
+	case AlterColumnAction_Add:
+		newColumn := alteration.NewColumn.Copy()
+		newColumn.Source = table.Name()
+		columns = append(columns, core.AlteredColumn{Column: newColumn})
+		// A nullable column without a default is NULL for all existing rows, which does not require a rewrite
+		if alteration.Value != nil || !newColumn.Nullable {
+			rows, err = ac.projectRows(ctx, table, func(ctx *sql.Context, row sql.Row) (sql.Row, error) {
+				var value any
+				if alteration.Value != nil {
+					var err error
+					if value, err = alteration.Value.Eval(ctx, row); err != nil {
+						return nil, err
+					}
+				}
+				return append(row.Copy(), value), nil
+			})
+		}
+	case AlterColumnAction_Drop:
+		if sch[colIdx].PrimaryKey {
+			return nil, fmt.Errorf("dropping a primary key column is not yet supported")
+		}
+		columns = append(columns[:colIdx], columns[colIdx+1:]...)
+		rows, err = ac.projectRows(ctx, table, func(ctx *sql.Context, row sql.Row) (sql.Row, error) {
+			newRow := make(sql.Row, 0, len(row)-1)
+			newRow = append(newRow, row[:colIdx]...)
+			return append(newRow, row[colIdx+1:]...), nil
+		})
+	case AlterColumnAction_Rename:
+		columns[colIdx].Column.Name = alteration.NewName
+	case AlterColumnAction_SetDefault:
+		columns[colIdx].Column.Default = alteration.Default
+	case AlterColumnAction_DropDefault:
+		columns[colIdx].Column.Default = nil
+	case AlterColumnAction_SetNotNull:
+		if err = ac.validateNotNull(ctx, table, colIdx); err != nil {
+			return nil, err
+		}
+		columns[colIdx].Column.Nullable = false
+	case AlterColumnAction_DropNotNull:
+		if sch[colIdx].PrimaryKey {
+			return nil, fmt.Errorf(`column "%s" is in a primary key`, alteration.Column)
+		}
+		columns[colIdx].Column.Nullable = true
+	case AlterColumnAction_SetType:
+		columns[colIdx].Column.Type = alteration.Type
+		rows, err = ac.projectRows(ctx, table, func(ctx *sql.Context, row sql.Row) (sql.Row, error) {
+			value, err := alteration.Value.Eval(ctx, row)
+			if err != nil {
+				return nil, err
+			}
+			newRow := row.Copy()
+			newRow[colIdx] = value
+			return newRow, nil
+		})
+	default:
+		return nil, fmt.Errorf("unknown column alteration: %d", alteration.Action)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = core.AlterTableColumns(ctx, ac.tableName, columns, alteration.DroppedChecks, rows); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (ac *AlterColumn) Schema() sql.Schema {
+	return types.OkResultSchema
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (ac *AlterColumn) String() string {
+	return ac.gmsNode.String()
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (ac *AlterColumn) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(ac, children...)
+}
+
+// projectRows returns an iterator over the table's existing rows, which are passed through the given function. The
+// table's partitions are loaded immediately, so the rows are unaffected by any changes made to the table afterward.
+func (ac *AlterColumn) projectRows(ctx *sql.Context, table sql.Table, project func(*sql.Context, sql.Row) (sql.Row, error)) (sql.RowIter, error) {
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var partitionSlice []sql.Partition
+	for {
+		partition, err := partitions.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			_ = partitions.Close(ctx)
+			return nil, err
+		}
+		partitionSlice = append(partitionSlice, partition)
+	}
+	if err = partitions.Close(ctx); err != nil {
+		return nil, err
+	}
+	return &projectedRowIter{
+		childIter: sql.NewTableRowIter(ctx, table, sql.PartitionsToPartitionIter(partitionSlice...)),
+		project:   project,
+	}, nil
+}
+
+// validateNotNull returns an error if the column at the given index contains any NULL values.
+func (ac *AlterColumn) validateNotNull(ctx *sql.Context, table sql.Table, colIdx int) error {
+	rows, err := ac.projectRows(ctx, table, func(ctx *sql.Context, row sql.Row) (sql.Row, error) {
+		return row, nil
+	})
+	if err != nil {
+		return err
+	}
+	defer rows.Close(ctx)
+	for {
+		row, err := rows.Next(ctx)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if row[colIdx] == nil {
+			return fmt.Errorf(`column "%s" of relation "%s" contains null values`, ac.alteration.Column, table.Name())
+		}
+	}
+}
+
+// projectedRowIter passes each row from its child through a projection function.
+type projectedRowIter struct {
+	childIter sql.RowIter
+	project   func(*sql.Context, sql.Row) (sql.Row, error)
+}
+
+var _ sql.RowIter = (*projectedRowIter)(nil)
+
+// Next implements the interface sql.RowIter.
+func (iter *projectedRowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := iter.childIter.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return iter.project(ctx, row)
+}
+
+// Close implements the interface sql.RowIter.
+func (iter *projectedRowIter) Close(ctx *sql.Context) error {
+	return iter.childIter.Close(ctx)
+}
@@ -0,0 +1,169 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/privilege"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// AlterDefaultPrivileges handles the ALTER DEFAULT PRIVILEGES statement.
+type AlterDefaultPrivileges struct {
+	roles       []string
+	schemas     []string
+	kind        auth.ObjectKind
+	privileges  privilege.List
+	grantees    []string
+	grant       bool
+	grantOption bool
+}
+
+var _ sql.ExecSourceRel = (*AlterDefaultPrivileges)(nil)
+var _ vitess.Injectable = (*AlterDefaultPrivileges)(nil)
+
+// NewAlterDefaultPrivileges returns a new *AlterDefaultPrivileges. The privileges are granted when grant is true, and
+// revoked otherwise. The default privileges of the current role are changed when no roles are given, and those for
+// every schema are changed when no schemas are given.
+func NewAlterDefaultPrivileges(roles []string, schemas []string, kind auth.ObjectKind, privileges privilege.List, grantees []string, grant bool, grantOption bool) *AlterDefaultPrivileges {
+	return &AlterDefaultPrivileges{
+		roles:       roles,
+		schemas:     schemas,
+		kind:        kind,
+		privileges:  privileges,
+		grantees:    grantees,
+		grant:       grant,
+		grantOption: grantOption,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (a *AlterDefaultPrivileges) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (a *AlterDefaultPrivileges) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (a *AlterDefaultPrivileges) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (a *AlterDefaultPrivileges) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (a *AlterDefaultPrivileges) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if err := a.execute(ctx); err != nil {
+		return nil, pgerrors.Raise(ctx, err)
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (a *AlterDefaultPrivileges) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (a *AlterDefaultPrivileges) String() string {
+	return "ALTER DEFAULT PRIVILEGES"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (a *AlterDefaultPrivileges) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(a, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (a *AlterDefaultPrivileges) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return a, nil
+}
+
+// execute changes the default privileges.
+func (a *AlterDefaultPrivileges) execute(ctx *sql.Context) error {
+	current := currentRole(ctx).Name
+	roles := append([]string(nil), a.roles...)
+	if len(roles) == 0 {
+		roles = []string{current}
+	}
+	for i, role := range roles {
+		if isCurrentRoleName(role) {
+			roles[i] = current
+		} else if _, ok := auth.GetRole(role); !ok {
+			return pgerrors.Newf(pgcode.UndefinedObject, `role "%s" does not exist`, role)
+		}
+		// Default privileges may only be changed for roles that the current role may act as
+		if !auth.CanSetRole(current, roles[i]) {
+			return pgerrors.New(pgcode.InsufficientPrivilege, "permission denied to change default privileges")
+		}
+	}
+	schemas := a.schemas
+	if len(schemas) == 0 {
+		schemas = []string{""}
+	}
+	for _, schema := range schemas {
+		if len(schema) > 0 {
+			if err := checkSchemaExists(ctx, schema); err != nil {
+				return err
+			}
+		}
+	}
+	privileges, _, err := resolvePrivileges(a.kind, a.privileges)
+	if err != nil {
+		return err
+	}
+	grantees, err := resolveGrantees(ctx, a.grantees)
+	if err != nil {
+		return err
+	}
+	if a.grant && a.grantOption {
+		for _, grantee := range grantees {
+			if grantee == auth.PublicRole {
+				return pgerrors.New(pgcode.InvalidGrantOperation, "grant options can only be granted to roles")
+			}
+		}
+	}
+	database := ctx.GetCurrentDatabase()
+	for _, role := range roles {
+		for _, schema := range schemas {
+			key := auth.NewDefaultACLKey(role, database, schema, a.kind)
+			if a.grant {
+				err = auth.GrantDefaultPrivileges(key, grantees, privileges, a.grantOption)
+			} else {
+				err = auth.RevokeDefaultPrivileges(key, grantees, privileges, a.grantOption)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// AlterPublication handles the ALTER PUBLICATION statement. Only one of the changes is set by each statement.
+type AlterPublication struct {
+	Name       string
+	AddTables  []doltdb.TableName
+	DropTables []doltdb.TableName
+	// SetTables replaces the tables of the publication when ReplaceTables is set, which allows the tables to be
+	// replaced with an empty list.
+	SetTables     []doltdb.TableName
+	ReplaceTables bool
+	Params        map[string]string
+	NewName       string
+	Owner         string
+}
+
+var _ sql.ExecSourceRel = (*AlterPublication)(nil)
+var _ vitess.Injectable = (*AlterPublication)(nil)
+
+// NewAlterPublication returns a new *AlterPublication for the publication with the given name.
+func NewAlterPublication(name string) *AlterPublication {
+	return &AlterPublication{
+		Name: name,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *AlterPublication) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// Only the owner of the publication may alter it, which is checked in RowIter once the publication has been loaded
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *AlterPublication) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *AlterPublication) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *AlterPublication) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *AlterPublication) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	collection, err := core.GetPublicationsCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	publication := collection.GetPublication(c.Name)
+	if publication == nil {
+		return nil, fmt.Errorf(`publication "%s" does not exist`, c.Name)
+	}
+	if err = checkPublicationOwner(ctx, publication); err != nil {
+		return nil, err
+	}
+	if (len(c.AddTables) > 0 || len(c.DropTables) > 0 || c.ReplaceTables) && publication.AllTables {
+		return nil, fmt.Errorf(`publication "%s" is defined as FOR ALL TABLES`, c.Name)
+	}
+	switch {
+	case len(c.AddTables) > 0:
+		tables, err := resolvePublicationTables(ctx, c.AddTables)
+		if err != nil {
+			return nil, err
+		}
+		if err = checkOwnsPublicationTables(ctx, tables); err != nil {
+			return nil, err
+		}
+		if err = publication.AddTables(tables); err != nil {
+			return nil, err
+		}
+	case len(c.DropTables) > 0:
+		tables, err := resolvePublicationTables(ctx, c.DropTables)
+		if err != nil {
+			return nil, err
+		}
+		if err = publication.RemoveTables(tables); err != nil {
+			return nil, err
+		}
+	case c.ReplaceTables:
+		tables, err := resolvePublicationTables(ctx, c.SetTables)
+		if err != nil {
+			return nil, err
+		}
+		if err = checkOwnsPublicationTables(ctx, tables); err != nil {
+			return nil, err
+		}
+		publication.SetTables(tables)
+	case len(c.Params) > 0:
+		if err = setPublicationParameters(publication, c.Params); err != nil {
+			return nil, err
+		}
+	case len(c.NewName) > 0:
+		if collection.HasPublication(c.NewName) {
+			return nil, fmt.Errorf(`publication "%s" already exists`, c.NewName)
+		}
+		if err = collection.DropPublication(publication.Name); err != nil {
+			return nil, err
+		}
+		publication.Name = c.NewName
+		if err = collection.CreatePublication(publication); err != nil {
+			return nil, err
+		}
+	case len(c.Owner) > 0:
+		if _, ok := auth.GetRole(c.Owner); !ok {
+			return nil, fmt.Errorf(`role "%s" does not exist`, c.Owner)
+		}
+		if !auth.CanSetRole(currentRole(ctx).Name, c.Owner) {
+			return nil, pgerrors.Newf(pgcode.InsufficientPrivilege, `must be able to SET ROLE "%s"`, c.Owner)
+		}
+		publication.Owner = c.Owner
+	}
+	if err = core.UpdatePublicationsCollection(ctx, collection); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *AlterPublication) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *AlterPublication) String() string {
+	return "ALTER PUBLICATION"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *AlterPublication) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *AlterPublication) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
@@ -0,0 +1,149 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/notices"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// AlterRole handles the ALTER ROLE and ALTER USER statements.
+type AlterRole struct {
+	name     string
+	ifExists bool
+	newName  string
+	options  RoleOptions
+}
+
+var _ sql.ExecSourceRel = (*AlterRole)(nil)
+var _ vitess.Injectable = (*AlterRole)(nil)
+
+// NewAlterRole returns a new *AlterRole that changes the given options of the role.
+func NewAlterRole(name string, ifExists bool, options RoleOptions) *AlterRole {
+	return &AlterRole{
+		name:     name,
+		ifExists: ifExists,
+		options:  options,
+	}
+}
+
+// NewRenameRole returns a new *AlterRole that renames the role.
+func NewRenameRole(name string, newName string) *AlterRole {
+	return &AlterRole{
+		name:    name,
+		newName: newName,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *AlterRole) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *AlterRole) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *AlterRole) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *AlterRole) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *AlterRole) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if err := c.execute(ctx); err != nil {
+		return nil, pgerrors.Raise(ctx, err)
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *AlterRole) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *AlterRole) String() string {
+	return "ALTER ROLE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *AlterRole) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *AlterRole) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
+
+// execute alters or renames the role.
+func (c *AlterRole) execute(ctx *sql.Context) error {
+	role, ok := auth.GetRole(c.name)
+	if !ok {
+		if c.ifExists {
+			notices.RaiseNotice(ctx, fmt.Sprintf(`role "%s" does not exist, skipping`, c.name))
+			return nil
+		}
+		return pgerrors.Newf(pgcode.UndefinedObject, `role "%s" does not exist`, c.name)
+	}
+	current := currentRole(ctx)
+	if len(c.newName) > 0 {
+		if c.name == current.Name {
+			return pgerrors.New(pgcode.FeatureNotSupported, "session user cannot be renamed")
+		}
+		if err := checkCanManageRole(current, "rename", role, RoleOptions{}); err != nil {
+			return err
+		}
+		passwordCleared, err := auth.RenameRole(c.name, c.newName)
+		if err != nil {
+			return err
+		}
+		if passwordCleared {
+			notices.RaiseNotice(ctx, "MD5 password cleared because of role rename")
+		}
+		return nil
+	}
+	// Roles may always change their own password
+	if !(c.name == current.Name && c.options.onlyPassword()) {
+		if err := checkCanManageRole(current, "alter", role, c.options); err != nil {
+			return err
+		}
+	}
+	if err := c.options.apply(ctx, &role); err != nil {
+		return err
+	}
+	if err := auth.UpdateRole(role); err != nil {
+		return err
+	}
+	return nil
+}
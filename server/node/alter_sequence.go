@@ -0,0 +1,296 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/lib/pq/oid"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/sequences"
+	"github.com/dolthub/doltgresql/server/notices"
+)
+
+// AlterSequenceOptions contains the options of an ALTER SEQUENCE statement. Options that were not given are left unset,
+// so that they keep the sequence's existing value.
+type AlterSequenceOptions struct {
+	// DataTypeOID is zero when the type does not change.
+	DataTypeOID uint32
+	Increment   *int64
+	// SetMinimum is true when MINVALUE or NO MINVALUE was given, with NO MINVALUE leaving Minimum as nil.
+	SetMinimum bool
+	Minimum    *int64
+	// SetMaximum is true when MAXVALUE or NO MAXVALUE was given, with NO MAXVALUE leaving Maximum as nil.
+	SetMaximum bool
+	Maximum    *int64
+	Start      *int64
+	// Restart is true when RESTART was given, with RestartValue being nil when the start value should be used.
+	Restart      bool
+	RestartValue *int64
+	Cache        *int64
+	Cycle        *bool
+	// SetOwner is true when OWNED BY was given, with OWNED BY NONE leaving the owner names empty.
+	SetOwner    bool
+	OwnerTable  string
+	OwnerColumn string
+}
+
+// AlterSequence handles the ALTER SEQUENCE statement.
+type AlterSequence struct {
+	schema   string
+	sequence string
+	ifExists bool
+	options  AlterSequenceOptions
+}
+
+var _ sql.ExecSourceRel = (*AlterSequence)(nil)
+var _ vitess.Injectable = (*AlterSequence)(nil)
+
+// NewAlterSequence returns a new *AlterSequence.
+func NewAlterSequence(ifExists bool, schema string, sequence string, options AlterSequenceOptions) *AlterSequence {
+	return &AlterSequence{
+		schema:   schema,
+		sequence: sequence,
+		ifExists: ifExists,
+		options:  options,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *AlterSequence) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// Only the owner of the sequence may alter it, which is checked in RowIter once the sequence has been loaded
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *AlterSequence) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *AlterSequence) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *AlterSequence) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *AlterSequence) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	schema := c.schema
+	if len(c.schema) == 0 {
+		var err error
+		schema, err = core.GetCurrentSchema(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	relationType, err := core.GetRelationType(ctx, schema, c.sequence)
+	if err != nil {
+		return nil, err
+	}
+	switch relationType {
+	case core.RelationType_DoesNotExist:
+		if c.ifExists {
+			notices.RaiseNotice(ctx, fmt.Sprintf(`relation "%s" does not exist, skipping`, c.sequence))
+			return sql.RowsToRowIter(), nil
+		}
+		return nil, fmt.Errorf(`relation "%s" does not exist`, c.sequence)
+	case core.RelationType_Sequence:
+	default:
+		return nil, fmt.Errorf(`"%s" is not a sequence`, c.sequence)
+	}
+	collection, err := core.GetCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	name := doltdb.TableName{Name: c.sequence, Schema: schema}
+	sequence := collection.GetSequence(name)
+	if sequence == nil {
+		return nil, fmt.Errorf(`relation "%s" does not exist`, c.sequence)
+	}
+	if err = checkSequenceOwner(ctx, schema, sequence); err != nil {
+		return nil, err
+	}
+	newSequence, err := c.options.apply(*sequence)
+	if err != nil {
+		return nil, err
+	}
+	if c.options.SetOwner && len(newSequence.OwnerTable) > 0 {
+		if err = validateSequenceOwner(ctx, schema, newSequence.OwnerTable, newSequence.OwnerColumn); err != nil {
+			return nil, err
+		}
+	}
+	// The sequence is replaced as a whole, so that a failed statement does not leave the sequence partially altered
+	if err = collection.DropSequence(name); err != nil {
+		return nil, err
+	}
+	if err = collection.CreateSequence(schema, &newSequence); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *AlterSequence) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *AlterSequence) String() string {
+	return "ALTER SEQUENCE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *AlterSequence) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *AlterSequence) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
+
+// apply returns the given sequence with the options applied. Validation follows the same rules as CREATE SEQUENCE,
+// using the existing values of the sequence for any options that were not given.
+func (options AlterSequenceOptions) apply(sequence sequences.Sequence) (sequences.Sequence, error) {
+	oldMinLimit, oldMaxLimit, err := sequenceTypeLimits(sequence.DataTypeOID)
+	if err != nil {
+		return sequences.Sequence{}, err
+	}
+	minLimit, maxLimit := oldMinLimit, oldMaxLimit
+	if options.DataTypeOID != 0 {
+		minLimit, maxLimit, err = sequenceTypeLimits(options.DataTypeOID)
+		if err != nil {
+			return sequences.Sequence{}, err
+		}
+		// Bounds that were at the limits of the old type are moved to the limits of the new type
+		if sequence.Minimum == oldMinLimit {
+			sequence.Minimum = minLimit
+		}
+		if sequence.Maximum == oldMaxLimit {
+			sequence.Maximum = maxLimit
+		}
+		sequence.DataTypeOID = options.DataTypeOID
+	}
+	if options.Increment != nil {
+		if *options.Increment == 0 {
+			return sequences.Sequence{}, fmt.Errorf("INCREMENT must not be zero")
+		}
+		sequence.Increment = *options.Increment
+	}
+	if options.SetMinimum {
+		if options.Minimum != nil {
+			sequence.Minimum = *options.Minimum
+		} else if sequence.Increment > 0 {
+			sequence.Minimum = 1
+		} else {
+			sequence.Minimum = minLimit
+		}
+	}
+	if options.SetMaximum {
+		if options.Maximum != nil {
+			sequence.Maximum = *options.Maximum
+		} else if sequence.Increment > 0 {
+			sequence.Maximum = maxLimit
+		} else {
+			sequence.Maximum = -1
+		}
+	}
+	typeName := sequenceTypeName(sequence.DataTypeOID)
+	if sequence.Minimum < minLimit || sequence.Minimum > maxLimit {
+		return sequences.Sequence{}, fmt.Errorf("MINVALUE (%d) is out of range for sequence data type %s", sequence.Minimum, typeName)
+	}
+	if sequence.Maximum < minLimit || sequence.Maximum > maxLimit {
+		return sequences.Sequence{}, fmt.Errorf("MAXVALUE (%d) is out of range for sequence data type %s", sequence.Maximum, typeName)
+	}
+	if sequence.Minimum >= sequence.Maximum {
+		return sequences.Sequence{}, fmt.Errorf("MINVALUE (%d) must be less than MAXVALUE (%d)", sequence.Minimum, sequence.Maximum)
+	}
+	if options.Start != nil {
+		sequence.Start = *options.Start
+	}
+	if sequence.Start < sequence.Minimum {
+		return sequences.Sequence{}, fmt.Errorf("START value (%d) cannot be less than MINVALUE (%d)", sequence.Start, sequence.Minimum)
+	}
+	if sequence.Start > sequence.Maximum {
+		return sequences.Sequence{}, fmt.Errorf("START value (%d) cannot be greater than MAXVALUE (%d)", sequence.Start, sequence.Maximum)
+	}
+	if options.Restart {
+		restart := sequence.Start
+		if options.RestartValue != nil {
+			restart = *options.RestartValue
+		}
+		if restart < sequence.Minimum {
+			return sequences.Sequence{}, fmt.Errorf("RESTART value (%d) cannot be less than MINVALUE (%d)", restart, sequence.Minimum)
+		}
+		if restart > sequence.Maximum {
+			return sequences.Sequence{}, fmt.Errorf("RESTART value (%d) cannot be greater than MAXVALUE (%d)", restart, sequence.Maximum)
+		}
+		sequence.Current = restart
+		sequence.IsAtEnd = false
+	}
+	if options.Cache != nil {
+		if *options.Cache <= 0 {
+			return sequences.Sequence{}, fmt.Errorf("CACHE (%d) must be greater than zero", *options.Cache)
+		}
+		sequence.Cache = *options.Cache
+	}
+	if options.Cycle != nil {
+		sequence.Cycle = *options.Cycle
+	}
+	if options.SetOwner {
+		sequence.OwnerTable = options.OwnerTable
+		sequence.OwnerColumn = options.OwnerColumn
+	}
+	return sequence, nil
+}
+
+// sequenceTypeLimits returns the smallest and largest values of the given sequence data type.
+func sequenceTypeLimits(typeOID uint32) (int64, int64, error) {
+	switch oid.Oid(typeOID) {
+	case oid.T_int2:
+		return math.MinInt16, math.MaxInt16, nil
+	case oid.T_int4:
+		return math.MinInt32, math.MaxInt32, nil
+	case oid.T_int8:
+		return math.MinInt64, math.MaxInt64, nil
+	default:
+		return 0, 0, fmt.Errorf("sequence type must be smallint, integer, or bigint")
+	}
+}
+
+// sequenceTypeName returns the name of the given sequence data type, as used in error messages.
+func sequenceTypeName(typeOID uint32) string {
+	switch oid.Oid(typeOID) {
+	case oid.T_int2:
+		return "smallint"
+	case oid.T_int4:
+		return "integer"
+	default:
+		return "bigint"
+	}
+}
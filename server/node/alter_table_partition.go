@@ -0,0 +1,226 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/partitions"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/notices"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// AlterTablePartition handles the ALTER TABLE ... ATTACH PARTITION and ALTER TABLE ... DETACH PARTITION statements.
+type AlterTablePartition struct {
+	table         doltdb.TableName
+	partitionName string
+	ifExists      bool
+	// partition is the partition that is attached, which is nil when detaching.
+	partition     *partitions.Partition
+	boundStrategy partitions.Strategy
+}
+
+var _ sql.ExecSourceRel = (*AlterTablePartition)(nil)
+var _ vitess.Injectable = (*AlterTablePartition)(nil)
+
+// NewAttachPartition returns a new *AlterTablePartition that attaches the named table as a partition of the given table.
+// The partition's bound was written for the given partitioning strategy.
+func NewAttachPartition(table doltdb.TableName, partitionName string, partition *partitions.Partition, boundStrategy partitions.Strategy, ifExists bool) *AlterTablePartition {
+	return &AlterTablePartition{
+		table:         table,
+		partitionName: partitionName,
+		ifExists:      ifExists,
+		partition:     partition,
+		boundStrategy: boundStrategy,
+	}
+}
+
+// NewDetachPartition returns a new *AlterTablePartition that detaches the named partition from the given table.
+func NewDetachPartition(table doltdb.TableName, partitionName string, ifExists bool) *AlterTablePartition {
+	return &AlterTablePartition{
+		table:         table,
+		partitionName: partitionName,
+		ifExists:      ifExists,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *AlterTablePartition) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// Only the owner of both tables may change a partition, which is checked in RowIter once the tables' schemas have
+	// been resolved
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *AlterTablePartition) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *AlterTablePartition) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *AlterTablePartition) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *AlterTablePartition) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	database := ctx.GetCurrentDatabase()
+	parentName, ok, err := core.ResolveTableName(ctx, database, c.table)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if c.ifExists {
+			notices.RaiseNotice(ctx, fmt.Sprintf(`relation "%s" does not exist, skipping`, c.table.Name))
+			return sql.RowsToRowIter(), nil
+		}
+		return nil, pgerrors.Newf(pgcode.UndefinedTable, `relation "%s" does not exist`, c.table.Name)
+	}
+	partitionName, ok, err := core.ResolveTableName(ctx, database, doltdb.TableName{Name: c.partitionName})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, pgerrors.Newf(pgcode.UndefinedTable, `relation "%s" does not exist`, c.partitionName)
+	}
+	role := currentRole(ctx).Name
+	for _, tableName := range []doltdb.TableName{parentName, partitionName} {
+		obj := auth.TableObject(database, tableName.Schema, tableName.Name)
+		if !auth.IsOwner(role, obj) {
+			return nil, pgerrors.Raise(ctx, auth.NotOwnerError(obj))
+		}
+	}
+	collection, err := core.GetPartitionsCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	parent := collection.GetTable(parentName)
+	if parent == nil {
+		return nil, pgerrors.Newf(pgcode.WrongObjectType, `table "%s" is not partitioned`, parentName.Name)
+	}
+	if c.partition == nil {
+		if err = collection.DetachPartition(parentName, partitionName); err != nil {
+			return nil, pgerrors.Wrap(pgcode.UndefinedTable, err)
+		}
+	} else {
+		if err = c.attach(ctx, collection, parent, partitionName); err != nil {
+			return nil, err
+		}
+	}
+	if err = core.UpdatePartitionsCollection(ctx, collection); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// attach adds the existing table as a partition of the given partitioned table. The table must have the same columns
+// as its partitioned table, and all of its rows must fall within the partition's bound.
+func (c *AlterTablePartition) attach(ctx *sql.Context, collection *partitions.Collection, parent *partitions.Table, partitionName doltdb.TableName) error {
+	if partitionName == parent.Name {
+		return pgerrors.New(pgcode.DuplicateRelation, "circular inheritance not allowed")
+	}
+	if collection.GetPartition(partitionName) != nil {
+		return pgerrors.Newf(pgcode.ObjectNotInPrerequisiteState, `"%s" is already a partition`, partitionName.Name)
+	}
+	database := ctx.GetCurrentDatabase()
+	parentTable, err := core.GetSqlTableFromContext(ctx, database, parent.Name)
+	if err != nil {
+		return err
+	}
+	partitionTable, err := core.GetSqlTableFromContext(ctx, database, partitionName)
+	if err != nil {
+		return err
+	}
+	if parentTable == nil || partitionTable == nil {
+		return pgerrors.Newf(pgcode.UndefinedTable, `relation "%s" does not exist`, partitionName.Name)
+	}
+	if _, err = PartitionColumnMap(parent.Name.Name, parentTable.Schema(), partitionName.Name, partitionTable.Schema()); err != nil {
+		return err
+	}
+	partition := *c.partition
+	partition.Name = partitionName
+	partition.Parent = parent.Name
+	normalized, key, bound, err := prepareNewPartition(ctx, collection, parent, &partition, c.boundStrategy)
+	if err != nil {
+		return err
+	}
+	// A default partition may only hold the rows that no other partition holds
+	var otherBounds []*PartitionBound
+	if normalized.IsDefault {
+		for _, otherPartition := range collection.GetPartitions(parent.Name) {
+			otherBound, err := key.ResolveBound(otherPartition)
+			if err != nil {
+				return err
+			}
+			otherBounds = append(otherBounds, otherBound)
+		}
+	}
+	var violated bool
+	err = iterateLeafRows(ctx, collection, partitionName, parentTable.Schema(), func(row sql.Row) (bool, error) {
+		if normalized.IsDefault {
+			idx, err := key.Route(ctx, otherBounds, row)
+			violated = idx != -1
+			return !violated, err
+		}
+		contains, err := key.Contains(ctx, bound, row)
+		violated = !contains
+		return contains, err
+	})
+	if err != nil {
+		return err
+	}
+	if violated {
+		return pgerrors.Newf(pgcode.CheckViolation, `partition constraint of relation "%s" is violated by some row`, partitionName.Name)
+	}
+	return collection.AddPartition(normalized)
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *AlterTablePartition) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *AlterTablePartition) String() string {
+	if c.partition == nil {
+		return "ALTER TABLE DETACH PARTITION"
+	}
+	return "ALTER TABLE ATTACH PARTITION"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *AlterTablePartition) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *AlterTablePartition) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
@@ -0,0 +1,281 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/statspro"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/postgres/messages"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/notices"
+	"github.com/dolthub/doltgresql/server/pgstat"
+)
+
+// Analyze handles the ANALYZE statement.
+type Analyze struct {
+	tables []doltdb.TableName
+}
+
+var _ sql.ExecSourceRel = (*Analyze)(nil)
+var _ vitess.Injectable = (*Analyze)(nil)
+
+// NewAnalyze returns a new *Analyze. When no tables are given, every table in the current database is analyzed.
+func NewAnalyze(tables []doltdb.TableName) *Analyze {
+	return &Analyze{tables: tables}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (a *Analyze) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// Tables that the role does not own are skipped rather than causing an error, which is done in RowIter
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (a *Analyze) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (a *Analyze) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (a *Analyze) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (a *Analyze) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	sess := dsess.DSessFromSess(ctx.Session)
+	dbName := ctx.GetCurrentDatabase()
+	db, err := sess.Provider().Database(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	schemaDb, ok := db.(sql.SchemaDatabase)
+	if !ok {
+		return nil, fmt.Errorf("database %s does not support schemas", db.Name())
+	}
+	branch, err := sess.GetBranch()
+	if err != nil {
+		return nil, err
+	}
+	tables, err := a.resolveTables(ctx, schemaDb)
+	if err != nil {
+		return nil, err
+	}
+	database, _ := dsess.SplitRevisionDbName(dbName)
+	role := currentRole(ctx).Name
+	ownsDatabase := auth.IsOwner(role, auth.DatabaseObject(dbName))
+	for _, table := range tables {
+		// Only the owners of the table or database may analyze the table, with a warning given for the tables that
+		// were named explicitly
+		if !ownsDatabase && !auth.IsOwner(role, auth.TableObject(dbName, table.schema, table.table.Name())) {
+			if len(a.tables) > 0 {
+				raiseWarning(ctx, pgcode.Warning, fmt.Sprintf(`permission denied to analyze "%s", skipping it`, table.table.Name()))
+			}
+			continue
+		}
+		if err = analyzeTable(ctx, sess.StatsProvider(), dbName, branch, table); err != nil {
+			return nil, err
+		}
+		pgstat.CountAnalyze(pgstat.Relation{Database: database, Schema: table.schema, Name: table.table.Name()})
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// resolveTables returns the tables that should be analyzed.
+func (a *Analyze) resolveTables(ctx *sql.Context, schemaDb sql.SchemaDatabase) ([]analyzedTable, error) {
+	var tables []analyzedTable
+	if len(a.tables) == 0 {
+		schemas, err := schemaDb.AllSchemas(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, schema := range schemas {
+			tableNames, err := schema.GetTableNames(ctx)
+			if err != nil {
+				return nil, err
+			}
+			schemaName := schema.Name()
+			if namedSchema, ok := schema.(interface{ Schema() string }); ok {
+				schemaName = namedSchema.Schema()
+			}
+			for _, tableName := range tableNames {
+				table, ok, err := schema.GetTableInsensitive(ctx, tableName)
+				if err != nil {
+					return nil, err
+				}
+				if ok {
+					tables = append(tables, analyzedTable{schema: schemaName, table: table})
+				}
+			}
+		}
+		return tables, nil
+	}
+	for _, tableName := range a.tables {
+		schemaName := tableName.Schema
+		if len(schemaName) == 0 {
+			var err error
+			schemaName, err = core.GetCurrentSchema(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		schema, ok, err := schemaDb.GetSchema(ctx, schemaName)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, fmt.Errorf(`schema "%s" does not exist`, schemaName)
+		}
+		table, ok, err := schema.GetTableInsensitive(ctx, tableName.Name)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, fmt.Errorf(`relation "%s" does not exist`, tableName.Name)
+		}
+		tables = append(tables, analyzedTable{schema: schemaName, table: table})
+	}
+	return tables, nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (a *Analyze) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (a *Analyze) String() string {
+	if len(a.tables) == 0 {
+		return "ANALYZE"
+	}
+	names := make([]string, len(a.tables))
+	for i, table := range a.tables {
+		names[i] = table.String()
+	}
+	return "ANALYZE " + strings.Join(names, ", ")
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (a *Analyze) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(a, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (a *Analyze) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return a, nil
+}
+
+// analyzedTable is a table that is being analyzed, along with the schema that it belongs to.
+type analyzedTable struct {
+	schema string
+	table  sql.Table
+}
+
+// lastAnalyzed holds the hash of each table as of the last time that it was analyzed, keyed by the database, branch,
+// schema, and table. A table whose hash has not changed has the same statistics, so it does not need to be analyzed
+// again.
+var lastAnalyzed = struct {
+	sync.Mutex
+	hashes map[string]hash.Hash
+}{hashes: make(map[string]hash.Hash)}
+
+// analyzeTable refreshes the statistics of the given table. Statistics are refreshed incrementally: a table that is
+// unchanged since it was last analyzed is skipped entirely, and otherwise the histogram buckets whose chunks are shared
+// with the previously analyzed version of the table are reused, so that only the portions of the table that differ
+// are read.
+func analyzeTable(ctx *sql.Context, statsProvider sql.StatsProvider, dbName string, branch string, t analyzedTable) error {
+	doltTable, err := core.GetTableFromContext(ctx, doltdb.TableName{Name: t.table.Name(), Schema: t.schema})
+	if err != nil {
+		return err
+	}
+	if doltTable == nil {
+		return statsProvider.RefreshTableStats(ctx, t.table, dbName)
+	}
+	tableHash, err := doltTable.HashOf()
+	if err != nil {
+		return err
+	}
+	qualifiedName := fmt.Sprintf("%s.%s", t.schema, t.table.Name())
+	key := strings.ToLower(fmt.Sprintf("%s/%s/%s", dbName, branch, qualifiedName))
+
+	previousStats, err := statsProvider.GetTableStats(ctx, dbName, t.table)
+	if err != nil {
+		return err
+	}
+	lastAnalyzed.Lock()
+	previousHash, analyzed := lastAnalyzed.hashes[key]
+	lastAnalyzed.Unlock()
+	if analyzed && previousHash == tableHash && len(previousStats) > 0 {
+		notices.Raise(ctx, notices.Notice{
+			Severity: messages.ErrorResponseSeverity_Debug,
+			Message:  fmt.Sprintf(`skipping analyze of "%s" --- unchanged since last analyze`, qualifiedName),
+		})
+		return nil
+	}
+	previousChunks := make(map[hash.Hash]struct{})
+	for _, stat := range previousStats {
+		if doltStats, ok := stat.(*statspro.DoltStats); ok {
+			for _, chunk := range doltStats.Chunks {
+				previousChunks[chunk] = struct{}{}
+			}
+		}
+	}
+
+	if err = statsProvider.RefreshTableStats(ctx, t.table, dbName); err != nil {
+		return err
+	}
+	currentStats, err := statsProvider.GetTableStats(ctx, dbName, t.table)
+	if err != nil {
+		return err
+	}
+	reused, total := 0, 0
+	for _, stat := range currentStats {
+		if doltStats, ok := stat.(*statspro.DoltStats); ok {
+			for _, chunk := range doltStats.Chunks {
+				if _, ok = previousChunks[chunk]; ok {
+					reused++
+				}
+				total++
+			}
+		}
+	}
+	notices.Raise(ctx, notices.Notice{
+		Severity: messages.ErrorResponseSeverity_Debug,
+		Message:  fmt.Sprintf(`analyzed "%s": reused %d of %d histogram buckets`, qualifiedName, reused, total),
+	})
+	lastAnalyzed.Lock()
+	lastAnalyzed.hashes[key] = tableHash
+	lastAnalyzed.Unlock()
+	return nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/rowexec"
+
+	"github.com/dolthub/doltgresql/core/triggers"
+)
+
+// BeforeTriggers fires the BEFORE triggers for each row that is about to be written by an INSERT, UPDATE, or DELETE.
+// This wraps the node that produces the rows to write, which are the new rows for inserts, the old rows followed by the
+// new rows for updates, and the old rows for deletes. A trigger that returns NULL skips the row, while a trigger that
+// returns a row replaces the new row of inserts and updates.
+type BeforeTriggers struct {
+	child    sql.Node
+	target   TriggerTarget
+	triggers []RowTrigger
+	written  *WrittenRows
+	runner   StatementRunner
+}
+
+var _ sql.ExecSourceRel = (*BeforeTriggers)(nil)
+
+// NewBeforeTriggers returns a new *BeforeTriggers. The written rows are only given when AFTER triggers need to fire for
+// the rows that were written, and may be nil otherwise.
+func NewBeforeTriggers(child sql.Node, target TriggerTarget, triggers []RowTrigger, written *WrittenRows, runner StatementRunner) *BeforeTriggers {
+	return &BeforeTriggers{
+		child:    child,
+		target:   target,
+		triggers: triggers,
+		written:  written,
+		runner:   runner,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (bt *BeforeTriggers) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return bt.child.CheckPrivileges(ctx, opChecker)
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (bt *BeforeTriggers) Children() []sql.Node {
+	return []sql.Node{bt.child}
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (bt *BeforeTriggers) IsReadOnly() bool {
+	return bt.child.IsReadOnly()
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (bt *BeforeTriggers) Resolved() bool {
+	return bt.child.Resolved()
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (bt *BeforeTriggers) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	childIter, err := rowexec.DefaultBuilder.Build(ctx, bt.child, r)
+	if err != nil {
+		return nil, err
+	}
+	return &beforeTriggersIter{node: bt, childIter: childIter}, nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (bt *BeforeTriggers) Schema() sql.Schema {
+	return bt.child.Schema()
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (bt *BeforeTriggers) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("BeforeTriggers")
+	_ = pr.WriteChildren(bt.child.String())
+	return pr.String()
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (bt *BeforeTriggers) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(bt, len(children), 1)
+	}
+	nbt := *bt
+	nbt.child = children[0]
+	return &nbt, nil
+}
+
+// beforeTriggersIter is the iterator for *BeforeTriggers.
+type beforeTriggersIter struct {
+	node      *BeforeTriggers
+	childIter sql.RowIter
+}
+
+var _ sql.RowIter = (*beforeTriggersIter)(nil)
+
+// Next implements the interface sql.RowIter.
+func (iter *beforeTriggersIter) Next(ctx *sql.Context) (sql.Row, error) {
+	target := iter.node.target
+	for {
+		row, err := iter.childIter.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var oldRow, newRow sql.Row
+		switch target.Operation {
+		case triggers.Event_Insert:
+			newRow = row
+		case triggers.Event_Update:
+			oldRow, newRow = row[:len(row)/2], row[len(row)/2:]
+		case triggers.Event_Delete:
+			oldRow = row
+		}
+		skipped := false
+		for _, trigger := range iter.node.triggers {
+			result, err := trigger.fire(ctx, target, "BEFORE", oldRow, newRow, iter.node.runner)
+			if err != nil {
+				return nil, err
+			}
+			if result == nil {
+				skipped = true
+				break
+			}
+			// The row returned by DELETE triggers only determines whether the row is skipped
+			if target.Operation != triggers.Event_Delete {
+				newRow = result
+			}
+		}
+		if skipped {
+			continue
+		}
+		if iter.node.written != nil {
+			iter.node.written.rows = append(iter.node.written.rows, writtenRow{Old: oldRow, New: newRow})
+		}
+		switch target.Operation {
+		case triggers.Event_Insert:
+			return newRow, nil
+		case triggers.Event_Update:
+			return append(oldRow.Copy(), newRow...), nil
+		default:
+			return oldRow, nil
+		}
+	}
+}
+
+// Close implements the interface sql.RowIter.
+func (iter *beforeTriggersIter) Close(ctx *sql.Context) error {
+	return iter.childIter.Close(ctx)
+}
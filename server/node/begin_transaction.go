@@ -0,0 +1,102 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// TransactionModes are the characteristics that are given to a transaction by BEGIN or SET TRANSACTION. Each field holds
+// the value of the matching configuration parameter, and is empty when the characteristic was not given.
+type TransactionModes struct {
+	// Isolation is the isolation level, in the same format as the transaction_isolation parameter.
+	Isolation string
+	// ReadOnly is either "on" or "off", in the same format as the transaction_read_only parameter.
+	ReadOnly string
+}
+
+// BeginTransaction handles the BEGIN and START TRANSACTION statements. The characteristics of a transaction are tracked
+// by the connection handler, as they're reported through configuration parameters and decide which statements may run,
+// so this node only exists to carry them to the handler.
+type BeginTransaction struct {
+	modes TransactionModes
+}
+
+var _ sql.ExecSourceRel = (*BeginTransaction)(nil)
+var _ vitess.Injectable = (*BeginTransaction)(nil)
+
+// NewBeginTransaction returns a new *BeginTransaction.
+func NewBeginTransaction(modes TransactionModes) *BeginTransaction {
+	return &BeginTransaction{
+		modes: modes,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (b *BeginTransaction) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (b *BeginTransaction) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (b *BeginTransaction) IsReadOnly() bool {
+	return true
+}
+
+// Modes returns the characteristics that were given to the transaction.
+func (b *BeginTransaction) Modes() TransactionModes {
+	return b.modes
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (b *BeginTransaction) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (b *BeginTransaction) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	return nil, fmt.Errorf("BEGIN is only supported as a top-level statement")
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (b *BeginTransaction) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (b *BeginTransaction) String() string {
+	return "BEGIN"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (b *BeginTransaction) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(b, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (b *BeginTransaction) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return b, nil
+}
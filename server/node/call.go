@@ -0,0 +1,356 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+
+	"github.com/dolthub/doltgresql/core/functions"
+	"github.com/dolthub/doltgresql/postgres/parser/parser"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/privilege"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+	"github.com/dolthub/doltgresql/server/plpgsql"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// Call handles the CALL statement for user-defined procedures.
+type Call struct {
+	procedure *functions.Function
+	// procedureSchema is the schema that contains the procedure.
+	procedureSchema string
+	block           *plpgsql.Block
+	paramTypes      []pgtypes.DoltgresType
+	args            []sql.Expression
+	schema          sql.Schema
+	runner          StatementRunner
+}
+
+var _ sql.ExecSourceRel = (*Call)(nil)
+var _ sql.Expressioner = (*Call)(nil)
+
+// NewCall returns a new *Call for the procedure within the given schema. The arguments are matched to the procedure's
+// parameters in order. The block is the parsed body of procedures written in PL/pgSQL, and is nil for all other
+// languages.
+func NewCall(procedure *functions.Function, procedureSchema string, block *plpgsql.Block, paramTypes []pgtypes.DoltgresType, args []sql.Expression, runner StatementRunner) *Call {
+	var schema sql.Schema
+	for _, paramIdx := range procedure.OutputParameters() {
+		name := procedure.Parameters[paramIdx].Name
+		if len(name) == 0 {
+			name = fmt.Sprintf("column%d", len(schema)+1)
+		}
+		schema = append(schema, &sql.Column{
+			Name:     name,
+			Type:     paramTypes[paramIdx],
+			Nullable: true,
+		})
+	}
+	return &Call{
+		procedure:       procedure,
+		procedureSchema: procedureSchema,
+		block:           block,
+		paramTypes:      paramTypes,
+		args:            args,
+		schema:          schema,
+		runner:          runner,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *Call) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// Calling a procedure requires EXECUTE, which is checked in RowIter. PUBLIC holds EXECUTE on every procedure until
+	// it is revoked.
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *Call) Children() []sql.Node {
+	return nil
+}
+
+// Expressions implements the interface sql.Expressioner.
+func (c *Call) Expressions() []sql.Expression {
+	return c.args
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *Call) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *Call) Resolved() bool {
+	for _, arg := range c.args {
+		if !arg.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *Call) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	obj := auth.FunctionObject(ctx.GetCurrentDatabase(), c.procedureSchema, c.procedure.Name)
+	if !auth.HasPrivilege(currentRole(ctx).Name, obj, privilege.EXECUTE) {
+		return nil, pgerrors.Newf(pgcode.InsufficientPrivilege, "permission denied for procedure %s", c.procedure.Name)
+	}
+	args := make([]any, len(c.args))
+	argTypes := make([]sql.Type, len(c.args))
+	for i, arg := range c.args {
+		// Arguments given for OUT parameters are not evaluated, as they're always NULL within the body
+		if i < len(c.procedure.Parameters) && c.procedure.Parameters[i].Mode == functions.ParameterMode_Out {
+			continue
+		}
+		var err error
+		args[i], err = arg.Eval(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+		argTypes[i] = arg.Type()
+	}
+	if c.block != nil {
+		return c.plpgsqlRowIter(ctx, args, argTypes)
+	}
+	lastSchema, lastRows, err := runRoutineBody(ctx, c.procedure, c.paramTypes, args, argTypes, c.runner)
+	if err != nil {
+		return nil, err
+	}
+	if len(c.schema) == 0 {
+		return sql.RowsToRowIter(), nil
+	}
+	// The output parameters are taken from the first row of the last statement
+	outputRow := make(sql.Row, len(c.schema))
+	if len(lastRows) == 0 {
+		return sql.RowsToRowIter(outputRow), nil
+	}
+	if len(lastSchema) != len(c.schema) {
+		return nil, fmt.Errorf("procedure %s returns %d columns but has %d output parameters",
+			c.procedure.Name, len(lastSchema), len(c.schema))
+	}
+	for i, col := range c.schema {
+		val := lastRows[0][i]
+		if val == nil {
+			continue
+		}
+		targetType := col.Type.(pgtypes.DoltgresType)
+		if sourceType, ok := lastSchema[i].Type.(pgtypes.DoltgresType); ok && sourceType.BaseID() != targetType.BaseID() {
+			castFunc := framework.GetAssignmentCast(sourceType.BaseID(), targetType.BaseID())
+			if castFunc == nil {
+				return nil, fmt.Errorf("return type mismatch in procedure %s: output parameter %s is of type %s but expression is of type %s",
+					c.procedure.Name, col.Name, targetType.String(), sourceType.String())
+			}
+			val, err = castFunc(ctx, val, targetType)
+			if err != nil {
+				return nil, err
+			}
+		}
+		outputRow[i] = val
+	}
+	return sql.RowsToRowIter(outputRow), nil
+}
+
+// plpgsqlRowIter runs the body of a procedure written in PL/pgSQL. The output parameters are taken from the final values
+// of the parameters within the body.
+func (c *Call) plpgsqlRowIter(ctx *sql.Context, args []any, argTypes []sql.Type) (sql.RowIter, error) {
+	for i := range args {
+		if i >= len(c.paramTypes) {
+			break
+		}
+		var err error
+		if args[i], err = argumentValue(args[i], argTypes[i], c.paramTypes[i]); err != nil {
+			return nil, err
+		}
+	}
+	routine, err := plpgsqlRoutine(ctx, c.procedure, c.paramTypes, nil, args, c.runner)
+	if err != nil {
+		return nil, err
+	}
+	result, err := plpgsql.Execute(ctx, c.block, routine, plpgsql.StatementRunner(c.runner))
+	if err != nil {
+		return nil, plpgsqlError(ctx, err)
+	}
+	if len(c.schema) == 0 {
+		return sql.RowsToRowIter(), nil
+	}
+	outputRow := make(sql.Row, len(c.schema))
+	for i, paramIdx := range c.procedure.OutputParameters() {
+		outputRow[i] = result.Parameters[paramIdx]
+	}
+	return sql.RowsToRowIter(outputRow), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *Call) Schema() sql.Schema {
+	return c.schema
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *Call) String() string {
+	return "CALL"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *Call) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithExpressions implements the interface sql.Expressioner.
+func (c *Call) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != len(c.args) {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(exprs), len(c.args))
+	}
+	nc := *c
+	nc.args = exprs
+	return &nc, nil
+}
+
+// argumentValue converts an argument into the type of its parameter. Arguments of a different type are converted using
+// their text representation, which matches how arguments are given to routines written in SQL.
+func argumentValue(val any, argType sql.Type, paramType pgtypes.DoltgresType) (any, error) {
+	if val == nil {
+		return nil, nil
+	}
+	var str string
+	if doltgresType, ok := argType.(pgtypes.DoltgresType); ok {
+		if doltgresType.BaseID() == paramType.BaseID() {
+			return val, nil
+		}
+		var err error
+		if str, err = doltgresType.IoOutput(val); err != nil {
+			return nil, err
+		}
+	} else {
+		str = fmt.Sprint(val)
+	}
+	return paramType.IoInput(str)
+}
+
+// runRoutineBody runs each statement within the body of the given routine, returning the schema and rows of the last
+// statement. The arguments are matched to the routine's parameters in order, with each argument's type being used to
+// convert its value into the routine's body.
+func runRoutineBody(ctx *sql.Context, routine *functions.Function, paramTypes []pgtypes.DoltgresType, args []any, argTypes []sql.Type, runner StatementRunner) (sql.Schema, []sql.Row, error) {
+	substitutions, placeholders, err := parameterSubstitutions(routine, paramTypes, args, argTypes)
+	if err != nil {
+		return nil, nil, err
+	}
+	stmts, err := parser.Parse(routine.Definition)
+	if err != nil {
+		return nil, nil, err
+	}
+	visitor := &parameterVisitor{
+		procedure:     routine.Name,
+		substitutions: substitutions,
+		placeholders:  placeholders,
+	}
+	var lastSchema sql.Schema
+	var lastRows []sql.Row
+	for i, stmt := range stmts {
+		newStmt, _ := tree.WalkStmt(visitor, stmt.AST)
+		lastSchema, lastRows, err = runner(ctx, newStmt)
+		if err != nil {
+			pgerrors.AddContext(ctx, fmt.Sprintf(`SQL function "%s" statement %d`, routine.Name, i+1))
+			return nil, nil, err
+		}
+	}
+	return lastSchema, lastRows, nil
+}
+
+// parameterSubstitutions returns the expressions that will replace each parameter within the body of a routine. The
+// first return value maps parameter names to expressions, while the second contains an expression for each positional
+// parameter ($1, $2, etc.).
+func parameterSubstitutions(routine *functions.Function, paramTypes []pgtypes.DoltgresType, args []any, argTypes []sql.Type) (map[string]tree.Expr, []tree.Expr, error) {
+	substitutions := make(map[string]tree.Expr)
+	placeholders := make([]tree.Expr, len(routine.Parameters))
+	argIdx := 0
+	for i, param := range routine.Parameters {
+		typeRef, err := parser.ParseType(paramTypes[i].String())
+		if err != nil {
+			return nil, nil, err
+		}
+		var expr tree.Expr = tree.DNull
+		// Procedures take an argument for their OUT parameters, while functions do not. In both cases, OUT parameters
+		// are always NULL within the body.
+		takesArg := param.Mode != functions.ParameterMode_Out || routine.Kind == functions.Kind_Procedure
+		switch {
+		case !takesArg:
+		case argIdx < len(args):
+			val := args[argIdx]
+			argType := argTypes[argIdx]
+			argIdx++
+			if param.Mode == functions.ParameterMode_Out || val == nil {
+				break
+			}
+			var str string
+			if doltgresType, ok := argType.(pgtypes.DoltgresType); ok {
+				str, err = doltgresType.IoOutput(val)
+				if err != nil {
+					return nil, nil, err
+				}
+			} else {
+				str = fmt.Sprint(val)
+			}
+			expr = tree.NewStrVal(str)
+		case len(param.Default) > 0:
+			expr, err = parser.ParseExpr(param.Default)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		castExpr := &tree.CastExpr{Expr: expr, Type: typeRef, SyntaxMode: tree.CastShort}
+		if len(param.Name) > 0 {
+			substitutions[param.Name] = castExpr
+		}
+		placeholders[i] = castExpr
+	}
+	return substitutions, placeholders, nil
+}
+
+// parameterVisitor replaces references to a routine's parameters with their values.
+type parameterVisitor struct {
+	procedure     string
+	substitutions map[string]tree.Expr
+	placeholders  []tree.Expr
+}
+
+var _ tree.Visitor = (*parameterVisitor)(nil)
+
+// VisitPre implements the interface tree.Visitor.
+func (v *parameterVisitor) VisitPre(expr tree.Expr) (recurse bool, newExpr tree.Expr) {
+	switch expr := expr.(type) {
+	case *tree.UnresolvedName:
+		// Parameters may be referenced by name, or qualified with the name of the routine
+		if !expr.Star && (expr.NumParts == 1 || (expr.NumParts == 2 && expr.Parts[1] == v.procedure)) {
+			if substitution, ok := v.substitutions[expr.Parts[0]]; ok {
+				return false, substitution
+			}
+		}
+	case *tree.Placeholder:
+		if int(expr.Idx) < len(v.placeholders) {
+			return false, v.placeholders[expr.Idx]
+		}
+	}
+	return true, expr
+}
+
+// VisitPost implements the interface tree.Visitor.
+func (v *parameterVisitor) VisitPost(expr tree.Expr) (newNode tree.Expr) {
+	return expr
+}
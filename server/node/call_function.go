@@ -0,0 +1,156 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core/functions"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+	"github.com/dolthub/doltgresql/server/plpgsql"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// maxFunctionCallDepth is the number of user-defined function calls that may be nested within each other, which stops
+// functions that call themselves from running forever.
+const maxFunctionCallDepth = 256
+
+// functionCallDepthKey is the context key that holds the number of user-defined function calls that are currently
+// nested.
+type functionCallDepthKey struct{}
+
+// CallFunction runs the body of the given user-defined function, returning its result. Functions written in SQL return
+// the first column of the first row of the last statement, or NULL when the last statement does not return any rows.
+// The block is the parsed body of functions written in PL/pgSQL, and is nil for all other languages. The arguments are
+// only given for the input parameters, and have already been converted to the types of those parameters.
+func CallFunction(ctx *sql.Context, function *functions.Function, block *plpgsql.Block, paramTypes []pgtypes.DoltgresType, returnType pgtypes.DoltgresType, args []any, runner StatementRunner) (any, error) {
+	val, _, err := callFunction(ctx, function, block, paramTypes, returnType, args, runner)
+	return val, err
+}
+
+// CallSetReturningFunction runs the body of the given user-defined function, which returns a set. Each value in the set
+// is returned, which are taken from the first column of every row of the last statement for functions written in SQL.
+// Otherwise, this is the same as CallFunction.
+func CallSetReturningFunction(ctx *sql.Context, function *functions.Function, block *plpgsql.Block, paramTypes []pgtypes.DoltgresType, returnType pgtypes.DoltgresType, args []any, runner StatementRunner) ([]any, error) {
+	_, rows, err := callFunction(ctx, function, block, paramTypes, returnType, args, runner)
+	return rows, err
+}
+
+// callFunction runs the body of the given user-defined function, returning the single value for functions that do not
+// return a set, and the values of the set otherwise.
+func callFunction(ctx *sql.Context, function *functions.Function, block *plpgsql.Block, paramTypes []pgtypes.DoltgresType, returnType pgtypes.DoltgresType, args []any, runner StatementRunner) (any, []any, error) {
+	ctx, err := enterFunctionCall(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if block != nil {
+		routine, err := plpgsqlRoutine(ctx, function, paramTypes, returnType, args, runner)
+		if err != nil {
+			return nil, nil, err
+		}
+		result, err := plpgsql.Execute(ctx, block, routine, plpgsql.StatementRunner(runner))
+		if err != nil {
+			return nil, nil, plpgsqlError(ctx, err)
+		}
+		return result.Value, result.Rows, nil
+	}
+
+	argTypes := make([]sql.Type, 0, len(args))
+	for i, param := range function.Parameters {
+		if param.Mode != functions.ParameterMode_Out && len(argTypes) < len(args) {
+			argTypes = append(argTypes, paramTypes[i])
+		}
+	}
+	lastSchema, lastRows, err := runRoutineBody(ctx, function, paramTypes, args, argTypes, runner)
+	if err != nil {
+		return nil, nil, err
+	}
+	if returnType.BaseID() == pgtypes.DoltgresTypeBaseID_Void || len(lastRows) == 0 || len(lastSchema) == 0 {
+		return nil, nil, nil
+	}
+	if !function.ReturnsSet {
+		val, err := functionResult(ctx, lastRows[0][0], lastSchema[0].Type, returnType)
+		return val, nil, err
+	}
+	rows := make([]any, len(lastRows))
+	for i, row := range lastRows {
+		if rows[i], err = functionResult(ctx, row[0], lastSchema[0].Type, returnType); err != nil {
+			return nil, nil, err
+		}
+	}
+	return nil, rows, nil
+}
+
+// CallTriggerFunction runs the body of the given trigger function for the row that the trigger fired for, returning the
+// row that the function returned. A nil row means that the function returned NULL. Trigger functions may only be written
+// in PL/pgSQL, so the block must always be given.
+func CallTriggerFunction(ctx *sql.Context, function *functions.Function, block *plpgsql.Block, trigger *plpgsql.TriggerData, runner StatementRunner) (sql.Row, error) {
+	ctx, err := enterFunctionCall(ctx)
+	if err != nil {
+		return nil, err
+	}
+	routine := plpgsql.Routine{
+		Name:       function.Name,
+		Signature:  function.Name + "()",
+		ReturnType: pgtypes.Trigger,
+		Trigger:    trigger,
+	}
+	result, err := plpgsql.Execute(ctx, block, routine, plpgsql.StatementRunner(runner))
+	if err != nil {
+		return nil, plpgsqlError(ctx, err)
+	}
+	row, _ := result.Value.([]any)
+	return row, nil
+}
+
+// IsWithinFunctionCall returns whether the context belongs to a statement that is being run by a user-defined function.
+func IsWithinFunctionCall(ctx *sql.Context) bool {
+	depth, _ := ctx.Value(functionCallDepthKey{}).(int)
+	return depth > 0
+}
+
+// enterFunctionCall returns a context that records another nested call to a user-defined function, returning an error
+// when too many calls are nested.
+func enterFunctionCall(ctx *sql.Context) (*sql.Context, error) {
+	depth, _ := ctx.Value(functionCallDepthKey{}).(int)
+	if depth >= maxFunctionCallDepth {
+		return nil, pgerrors.Raise(ctx, pgerrors.Newf(pgcode.ProgramLimitExceeded, "stack depth limit exceeded").
+			WithHint("Check for functions that call themselves without ending."))
+	}
+	return ctx.WithContext(context.WithValue(ctx.Context, functionCallDepthKey{}, depth+1)), nil
+}
+
+// functionResult converts a value returned by the last statement of a function written in SQL into the function's
+// return type.
+func functionResult(ctx *sql.Context, val any, valType sql.Type, returnType pgtypes.DoltgresType) (any, error) {
+	if val == nil {
+		return nil, nil
+	}
+	if sourceType, ok := valType.(pgtypes.DoltgresType); ok && sourceType.BaseID() != returnType.BaseID() {
+		castFunc := framework.GetAssignmentCast(sourceType.BaseID(), returnType.BaseID())
+		if castFunc == nil {
+			return nil, pgerrors.Raise(ctx, pgerrors.Newf(pgcode.InvalidFunctionDefinition, "return type mismatch in function declared to return %s",
+				returnType.String()).WithDetail(fmt.Sprintf("Actual return type is %s.", sourceType.String())))
+		}
+		return castFunc(ctx, val, returnType)
+	}
+	return val, nil
+}
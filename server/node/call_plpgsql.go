@@ -0,0 +1,101 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core/functions"
+	"github.com/dolthub/doltgresql/postgres/parser/parser"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+	"github.com/dolthub/doltgresql/server/plpgsql"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// plpgsqlRoutine returns the routine that is given to the PL/pgSQL interpreter. Functions are not given arguments for
+// their OUT parameters, while procedures are given an argument for every parameter. Parameters without an argument use
+// their default, and are otherwise NULL.
+func plpgsqlRoutine(ctx *sql.Context, routine *functions.Function, paramTypes []pgtypes.DoltgresType, returnType pgtypes.DoltgresType, args []any, runner StatementRunner) (plpgsql.Routine, error) {
+	isProcedure := routine.Kind == functions.Kind_Procedure
+	result := plpgsql.Routine{
+		Name:             routine.Name,
+		Parameters:       make([]plpgsql.Parameter, len(routine.Parameters)),
+		OutputParameters: routine.OutputParameters(),
+		ReturnType:       returnType,
+		ReturnsSet:       routine.ReturnsSet,
+		IsProcedure:      isProcedure,
+	}
+	var signatureTypes []string
+	argIdx := 0
+	for i, param := range routine.Parameters {
+		result.Parameters[i] = plpgsql.Parameter{Name: param.Name, Type: paramTypes[i]}
+		if param.Mode != functions.ParameterMode_Out {
+			signatureTypes = append(signatureTypes, paramTypes[i].String())
+		} else if !isProcedure {
+			continue
+		}
+		switch {
+		case argIdx < len(args):
+			// Arguments given for OUT parameters are ignored, as they're always NULL within the body
+			if param.Mode != functions.ParameterMode_Out {
+				result.Parameters[i].Value = args[argIdx]
+			}
+			argIdx++
+		case len(param.Default) > 0:
+			val, err := evaluateParameterDefault(ctx, param.Default, paramTypes[i], runner)
+			if err != nil {
+				return plpgsql.Routine{}, err
+			}
+			result.Parameters[i].Value = val
+		}
+	}
+	result.Signature = fmt.Sprintf("%s(%s)", routine.Name, strings.Join(signatureTypes, ","))
+	return result, nil
+}
+
+// evaluateParameterDefault evaluates the default of a parameter, returning a value of the parameter's type.
+func evaluateParameterDefault(ctx *sql.Context, defaultExpr string, paramType pgtypes.DoltgresType, runner StatementRunner) (any, error) {
+	expr, err := parser.ParseExpr(defaultExpr)
+	if err != nil {
+		return nil, err
+	}
+	typeRef, err := parser.ParseType(paramType.String())
+	if err != nil {
+		return nil, err
+	}
+	castExpr := &tree.CastExpr{Expr: expr, Type: typeRef, SyntaxMode: tree.CastShort}
+	_, rows, err := runner(ctx, &tree.Select{Select: &tree.SelectClause{Exprs: tree.SelectExprs{{Expr: castExpr}}}})
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) != 1 || len(rows[0]) != 1 {
+		return nil, fmt.Errorf("default value of a parameter must return a single value")
+	}
+	return rows[0][0], nil
+}
+
+// plpgsqlError adds the context of an error that occurred within the body of a PL/pgSQL routine, returning the error
+// that occurred.
+func plpgsqlError(ctx *sql.Context, err error) error {
+	if plpgsqlErr, ok := err.(*plpgsql.Error); ok {
+		pgerrors.AddContext(ctx, plpgsqlErr.Context())
+		return plpgsqlErr.Err
+	}
+	return err
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// CloseCursor handles the CLOSE statement. Cursors belong to the connection, so the connection handler closes the
+// cursor. This node only exists to carry the name to the handler.
+type CloseCursor struct {
+	name string
+}
+
+var _ sql.ExecSourceRel = (*CloseCursor)(nil)
+var _ vitess.Injectable = (*CloseCursor)(nil)
+
+// NewCloseCursor returns a new *CloseCursor. An empty name closes every cursor, which is how CLOSE ALL is represented.
+func NewCloseCursor(name string) *CloseCursor {
+	return &CloseCursor{
+		name: name,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *CloseCursor) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *CloseCursor) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *CloseCursor) IsReadOnly() bool {
+	return true
+}
+
+// Name returns the name of the cursor, which is empty when every cursor should be closed.
+func (c *CloseCursor) Name() string {
+	return c.name
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *CloseCursor) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *CloseCursor) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	return nil, fmt.Errorf("CLOSE is only supported as a top-level statement")
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *CloseCursor) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *CloseCursor) String() string {
+	return "CLOSE CURSOR"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *CloseCursor) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *CloseCursor) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
@@ -61,7 +61,7 @@ func (rf *ContextRootFinalizer) Expressions() []sql.Expression {
 
 // IsReadOnly implements the interface sql.ExecSourceRel.
 func (rf *ContextRootFinalizer) IsReadOnly() bool {
-	return false
+	return rf.child.IsReadOnly()
 }
 
 // Resolved implements the interface sql.ExecSourceRel.
@@ -0,0 +1,194 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"go/constant"
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/dataloader"
+)
+
+// copyFromBatchSize is the number of rows that are inserted by each INSERT statement that COPY FROM executes.
+const copyFromBatchSize = 1000
+
+// CopyFrom handles the COPY ... FROM STDIN statement. The data is streamed from the client by the connection handler,
+// which must supply the reader before the statement is executed.
+type CopyFrom struct {
+	table   tree.TableName
+	columns tree.NameList
+	options dataloader.Options
+	reader  io.Reader
+	runner  StatementRunner
+}
+
+var _ sql.ExecSourceRel = (*CopyFrom)(nil)
+var _ vitess.Injectable = (*CopyFrom)(nil)
+
+// NewCopyFrom returns a new *CopyFrom.
+func NewCopyFrom(table tree.TableName, columns tree.NameList, options dataloader.Options) *CopyFrom {
+	return &CopyFrom{
+		table:   table,
+		columns: columns,
+		options: options,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (cf *CopyFrom) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// The INSERT statements that are executed will check their own privileges
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (cf *CopyFrom) Children() []sql.Node {
+	return nil
+}
+
+// ColumnCount returns the number of columns that were explicitly named. Returns zero when all columns are used.
+func (cf *CopyFrom) ColumnCount() int {
+	return len(cf.columns)
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (cf *CopyFrom) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (cf *CopyFrom) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (cf *CopyFrom) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if cf.reader == nil {
+		return nil, fmt.Errorf("COPY FROM STDIN is only supported using the simple query protocol")
+	}
+	if cf.runner == nil {
+		return nil, fmt.Errorf("COPY FROM STDIN has not been analyzed")
+	}
+	reader, err := dataloader.NewReader(cf.reader, cf.options)
+	if err != nil {
+		return nil, err
+	}
+	columnCount := len(cf.columns)
+	if columnCount == 0 {
+		// Without a column list, the data contains every column of the table
+		sch, _, err := cf.runner(ctx, &tree.Select{
+			Select: &tree.SelectClause{
+				Exprs: tree.SelectExprs{tree.StarSelectExpr()},
+				From:  tree.From{Tables: tree.TableExprs{&cf.table}},
+			},
+			Limit: &tree.Limit{Count: tree.NewNumVal(constant.MakeInt64(0), "0", false)},
+		})
+		if err != nil {
+			return nil, err
+		}
+		columnCount = len(sch)
+	}
+
+	var rowCount uint64
+	var batch []tree.Exprs
+	for {
+		row, err := reader.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if len(row) > columnCount {
+			return nil, fmt.Errorf("extra data after last expected column (COPY %s, line %d)", cf.table.Table(), reader.Line())
+		} else if len(row) < columnCount {
+			return nil, fmt.Errorf("missing data for column %d (COPY %s, line %d)", len(row)+1, cf.table.Table(), reader.Line())
+		}
+		exprs := make(tree.Exprs, len(row))
+		for i, field := range row {
+			if field == nil {
+				exprs[i] = tree.DNull
+			} else {
+				exprs[i] = tree.NewStrVal(*field)
+			}
+		}
+		batch = append(batch, exprs)
+		if len(batch) >= copyFromBatchSize {
+			if err = cf.insertBatch(ctx, batch); err != nil {
+				return nil, err
+			}
+			rowCount += uint64(len(batch))
+			batch = batch[:0]
+		}
+	}
+	if len(batch) > 0 {
+		if err = cf.insertBatch(ctx, batch); err != nil {
+			return nil, err
+		}
+		rowCount += uint64(len(batch))
+	}
+	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(int(rowCount)))), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (cf *CopyFrom) Schema() sql.Schema {
+	return types.OkResultSchema
+}
+
+// SetReader sets the reader that the data will be read from.
+func (cf *CopyFrom) SetReader(reader io.Reader) {
+	cf.reader = reader
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (cf *CopyFrom) String() string {
+	return "COPY FROM STDIN"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (cf *CopyFrom) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(cf, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (cf *CopyFrom) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return cf, nil
+}
+
+// WithStatementRunner returns a copy of this node that executes its INSERT statements using the given runner.
+func (cf *CopyFrom) WithStatementRunner(runner StatementRunner) *CopyFrom {
+	ncf := *cf
+	ncf.runner = runner
+	return &ncf
+}
+
+// insertBatch inserts the given rows into the table.
+func (cf *CopyFrom) insertBatch(ctx *sql.Context, rows []tree.Exprs) error {
+	_, _, err := cf.runner(ctx, &tree.Insert{
+		Table:     &cf.table,
+		Columns:   cf.columns,
+		Rows:      &tree.Select{Select: &tree.ValuesClause{Rows: rows}},
+		Returning: tree.AbsentReturningClause,
+	})
+	return err
+}
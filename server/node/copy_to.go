@@ -0,0 +1,104 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/server/dataloader"
+)
+
+// CopyTo handles the COPY ... TO STDOUT statement. The connection handler executes the query directly so that the
+// results may be streamed to the client, therefore this node only exists to carry the query and options to the handler.
+type CopyTo struct {
+	queryString string
+	query       vitess.Statement
+	options     dataloader.Options
+}
+
+var _ sql.ExecSourceRel = (*CopyTo)(nil)
+var _ vitess.Injectable = (*CopyTo)(nil)
+
+// NewCopyTo returns a new *CopyTo.
+func NewCopyTo(queryString string, query vitess.Statement, options dataloader.Options) *CopyTo {
+	return &CopyTo{
+		queryString: queryString,
+		query:       query,
+		options:     options,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (ct *CopyTo) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// The query that is executed will check its own privileges
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (ct *CopyTo) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (ct *CopyTo) IsReadOnly() bool {
+	return true
+}
+
+// Options returns the options that determine how the results are formatted.
+func (ct *CopyTo) Options() dataloader.Options {
+	return ct.options
+}
+
+// Query returns the query whose results are copied, along with its string form.
+func (ct *CopyTo) Query() (string, vitess.Statement) {
+	return ct.queryString, ct.query
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (ct *CopyTo) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (ct *CopyTo) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	return nil, fmt.Errorf("COPY TO STDOUT is only supported using the simple query protocol")
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (ct *CopyTo) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (ct *CopyTo) String() string {
+	return "COPY TO STDOUT"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (ct *CopyTo) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(ct, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (ct *CopyTo) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return ct, nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/rowexec"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/doltgresql/core"
+)
+
+// CreateCheck handles the creation of CHECK constraints on existing tables. This replaces the GMS implementation, as it
+// relies on Dolt's table alteration, which does not account for schemas.
+type CreateCheck struct {
+	gmsCreateCheck *plan.CreateCheck
+}
+
+var _ sql.ExecSourceRel = (*CreateCheck)(nil)
+var _ sql.Expressioner = (*CreateCheck)(nil)
+
+// NewCreateCheck returns a new *CreateCheck.
+func NewCreateCheck(createCheck *plan.CreateCheck) *CreateCheck {
+	return &CreateCheck{
+		gmsCreateCheck: createCheck,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *CreateCheck) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return c.gmsCreateCheck.CheckPrivileges(ctx, opChecker)
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *CreateCheck) Children() []sql.Node {
+	return c.gmsCreateCheck.Children()
+}
+
+// Expressions implements the interface sql.Expressioner.
+func (c *CreateCheck) Expressions() []sql.Expression {
+	return c.gmsCreateCheck.Expressions()
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *CreateCheck) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *CreateCheck) Resolved() bool {
+	return c.gmsCreateCheck != nil && c.gmsCreateCheck.Resolved()
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *CreateCheck) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	// Existing rows must satisfy the check before it may be added, unless it's being added using NOT VALID
+	if err := c.verifyExistingRows(ctx, r); err != nil {
+		return nil, err
+	}
+	check, err := plan.NewCheckDefinition(ctx, c.gmsCreateCheck.Check)
+	if err != nil {
+		return nil, err
+	}
+	if len(check.Name) == 0 {
+		check.Name = fmt.Sprintf("%s_check", c.gmsCreateCheck.Table.Name())
+	}
+	// TODO: get the schema from the table, not the search path
+	if err = core.CreateCheck(ctx, doltdb.TableName{Name: c.gmsCreateCheck.Table.Name()}, check); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *CreateCheck) Schema() sql.Schema {
+	return c.gmsCreateCheck.Schema()
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *CreateCheck) String() string {
+	return c.gmsCreateCheck.String()
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *CreateCheck) WithChildren(children ...sql.Node) (sql.Node, error) {
+	gmsCreateCheck, err := c.gmsCreateCheck.WithChildren(children...)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateCheck{
+		gmsCreateCheck: gmsCreateCheck.(*plan.CreateCheck),
+	}, nil
+}
+
+// WithExpressions implements the interface sql.Expressioner.
+func (c *CreateCheck) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	gmsCreateCheck, err := c.gmsCreateCheck.WithExpressions(exprs...)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateCheck{
+		gmsCreateCheck: gmsCreateCheck.(*plan.CreateCheck),
+	}, nil
+}
+
+// verifyExistingRows returns an error if any of the rows in the table violate the check. Rows are not verified while a
+// constraint is being added using NOT VALID.
+func (c *CreateCheck) verifyExistingRows(ctx *sql.Context, r sql.Row) error {
+	if SkipsConstraintValidation(ctx) {
+		return nil
+	}
+	rowIter, err := rowexec.DefaultBuilder.Build(ctx, c.gmsCreateCheck.Table, r)
+	if err != nil {
+		return err
+	}
+	for {
+		row, err := rowIter.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			_ = rowIter.Close(ctx)
+			return err
+		}
+		res, err := sql.EvaluateCondition(ctx, c.gmsCreateCheck.Check.Expr, row)
+		if err != nil {
+			_ = rowIter.Close(ctx)
+			return err
+		}
+		if sql.IsFalse(res) {
+			_ = rowIter.Close(ctx)
+			return fmt.Errorf(`check constraint "%s" of relation "%s" is violated by some row`,
+				c.gmsCreateCheck.Check.Name, c.gmsCreateCheck.Table.Name())
+		}
+	}
+	return rowIter.Close(ctx)
+}
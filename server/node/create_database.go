@@ -0,0 +1,180 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/backends"
+	"github.com/dolthub/doltgresql/server/notices"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// CreateDatabase handles the CREATE DATABASE statement. The new database is owned by the given owner, or the current
+// role when no owner is given, and starts as a copy of the template database when one is given.
+type CreateDatabase struct {
+	name        string
+	ifNotExists bool
+	owner       string
+	template    string
+}
+
+var _ sql.ExecSourceRel = (*CreateDatabase)(nil)
+var _ vitess.Injectable = (*CreateDatabase)(nil)
+
+// NewCreateDatabase returns a new *CreateDatabase.
+func NewCreateDatabase(name string, ifNotExists bool, owner string, template string) *CreateDatabase {
+	return &CreateDatabase{
+		name:        name,
+		ifNotExists: ifNotExists,
+		owner:       owner,
+		template:    template,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *CreateDatabase) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *CreateDatabase) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *CreateDatabase) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *CreateDatabase) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *CreateDatabase) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if err := c.execute(ctx); err != nil {
+		return nil, pgerrors.Raise(ctx, err)
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *CreateDatabase) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *CreateDatabase) String() string {
+	return "CREATE DATABASE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *CreateDatabase) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *CreateDatabase) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
+
+// execute creates the database.
+func (c *CreateDatabase) execute(ctx *sql.Context) error {
+	// An explicit transaction causes autocommit to be ignored, which is how we determine that we're in a block
+	if ctx.GetIgnoreAutoCommit() {
+		return pgerrors.New(pgcode.ActiveSQLTransaction, "CREATE DATABASE cannot run inside a transaction block")
+	}
+	current := currentRole(ctx)
+	if !current.CanCreateDB {
+		return pgerrors.New(pgcode.InsufficientPrivilege, "permission denied to create database")
+	}
+	owner, err := c.resolveOwner(current)
+	if err != nil {
+		return err
+	}
+	provider := dsess.DSessFromSess(ctx.Session).Provider()
+	template, err := c.resolveTemplate(ctx, provider, current)
+	if err != nil {
+		return err
+	}
+	if provider.HasDatabase(ctx, c.name) {
+		if c.ifNotExists {
+			notices.RaiseNotice(ctx, fmt.Sprintf(`database "%s" already exists, skipping`, c.name))
+			return nil
+		}
+		return pgerrors.Newf(pgcode.DuplicateDatabase, `database "%s" already exists`, c.name)
+	}
+	if err = provider.CreateDatabase(ctx, c.name); err != nil {
+		return err
+	}
+	if len(template) > 0 {
+		if err = core.CopyDatabaseRoot(ctx, template, c.name); err != nil {
+			return err
+		}
+	}
+	return auth.CreateObject(auth.DatabaseObject(c.name), owner)
+}
+
+// resolveOwner returns the role that will own the database. Only roles that the current role may act as may be given
+// ownership.
+func (c *CreateDatabase) resolveOwner(current auth.Role) (string, error) {
+	if len(c.owner) == 0 || isCurrentRoleName(c.owner) {
+		return current.Name, nil
+	}
+	if _, ok := auth.GetRole(c.owner); !ok {
+		return "", pgerrors.Newf(pgcode.UndefinedObject, `role "%s" does not exist`, c.owner)
+	}
+	if !auth.CanSetRole(current.Name, c.owner) {
+		return "", pgerrors.Newf(pgcode.InsufficientPrivilege, `must be able to SET ROLE "%s"`, c.owner)
+	}
+	return c.owner, nil
+}
+
+// resolveTemplate returns the database that the new database is copied from, which is empty when the new database
+// starts empty. The template0 and template1 databases are the default templates in Postgres, which are empty here
+// unless they've been created. A template may only be copied by its owner, and only while no other session is using
+// it, as the copy would otherwise miss their changes.
+func (c *CreateDatabase) resolveTemplate(ctx *sql.Context, provider dsess.DoltDatabaseProvider, current auth.Role) (string, error) {
+	if len(c.template) == 0 {
+		return "", nil
+	}
+	if !provider.HasDatabase(ctx, c.template) {
+		if c.template == "template0" || c.template == "template1" {
+			return "", nil
+		}
+		return "", pgerrors.Newf(pgcode.UndefinedDatabase, `template database "%s" does not exist`, c.template)
+	}
+	if !auth.IsOwner(current.Name, auth.DatabaseObject(c.template)) {
+		return "", pgerrors.Newf(pgcode.InsufficientPrivilege, `permission denied to copy database "%s"`, c.template)
+	}
+	if others := backends.AwaitDisconnect(ctx, c.template, ctx.Session.ID(), disconnectTimeout); len(others) > 0 {
+		return "", pgerrors.Newf(pgcode.ObjectInUse, `source database "%s" is being accessed by other users`, c.template).
+			WithDetail(otherSessionsDetail(len(others)))
+	}
+	return c.template, nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// CreateForeignKeyNotValid creates a foreign key without verifying that the existing rows of the table reference rows
+// in the parent table, which is used when the foreign key is added using NOT VALID. This otherwise mirrors the GMS
+// implementation.
+type CreateForeignKeyNotValid struct {
+	gmsCreateForeignKey *plan.CreateForeignKey
+}
+
+var _ sql.ExecSourceRel = (*CreateForeignKeyNotValid)(nil)
+
+// NewCreateForeignKeyNotValid returns a new *CreateForeignKeyNotValid.
+func NewCreateForeignKeyNotValid(createForeignKey *plan.CreateForeignKey) *CreateForeignKeyNotValid {
+	return &CreateForeignKeyNotValid{
+		gmsCreateForeignKey: createForeignKey,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *CreateForeignKeyNotValid) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return c.gmsCreateForeignKey.CheckPrivileges(ctx, opChecker)
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *CreateForeignKeyNotValid) Children() []sql.Node {
+	return c.gmsCreateForeignKey.Children()
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *CreateForeignKeyNotValid) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *CreateForeignKeyNotValid) Resolved() bool {
+	return c.gmsCreateForeignKey.Resolved()
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *CreateForeignKeyNotValid) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	fkDef := c.gmsCreateForeignKey.FkDef
+	if fkDef.OnUpdate == sql.ForeignKeyReferentialAction_SetDefault || fkDef.OnDelete == sql.ForeignKeyReferentialAction_SetDefault {
+		return nil, sql.ErrForeignKeySetDefault.New()
+	}
+	fkTbl, err := c.foreignKeyTable(ctx, fkDef.Database, fkDef.Table)
+	if err != nil {
+		return nil, err
+	}
+	refFkTbl, err := c.foreignKeyTable(ctx, fkDef.ParentDatabase, fkDef.ParentTable)
+	if err != nil {
+		return nil, err
+	}
+	fkChecks, err := ctx.GetSessionVariable(ctx, "foreign_key_checks")
+	if err != nil {
+		return nil, err
+	}
+	// The final argument determines whether the existing rows are verified, which is skipped for NOT VALID
+	err = plan.ResolveForeignKey(ctx, fkTbl, refFkTbl, *fkDef, true, fkChecks.(int8) == 1, false)
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *CreateForeignKeyNotValid) Schema() sql.Schema {
+	return c.gmsCreateForeignKey.Schema()
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *CreateForeignKeyNotValid) String() string {
+	return c.gmsCreateForeignKey.String()
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *CreateForeignKeyNotValid) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// foreignKeyTable returns the table with the given name from the given database as a sql.ForeignKeyTable.
+func (c *CreateForeignKeyNotValid) foreignKeyTable(ctx *sql.Context, database string, table string) (sql.ForeignKeyTable, error) {
+	db, err := c.gmsCreateForeignKey.DbProvider.Database(ctx, database)
+	if err != nil {
+		return nil, err
+	}
+	tbl, ok, err := db.GetTableInsensitive(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, sql.ErrTableNotFound.New(table)
+	}
+	fkTbl, ok := tbl.(sql.ForeignKeyTable)
+	if !ok {
+		return nil, sql.ErrNoForeignKeySupport.New(table)
+	}
+	return fkTbl, nil
+}
@@ -0,0 +1,157 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/foreign"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/fdw"
+	"github.com/dolthub/doltgresql/server/notices"
+)
+
+// CreateForeignTable handles the CREATE FOREIGN TABLE statement. The table's columns are created as a regular table,
+// which never holds any rows, while the foreign definition is stored alongside it so that reads go to the server.
+type CreateForeignTable struct {
+	createTable *tree.CreateTable
+	definition  foreign.Table
+	ifNotExists bool
+	runner      StatementRunner
+}
+
+var _ sql.ExecSourceRel = (*CreateForeignTable)(nil)
+var _ vitess.Injectable = (*CreateForeignTable)(nil)
+
+// NewCreateForeignTable returns a new *CreateForeignTable. The statement creates the table that holds the columns.
+func NewCreateForeignTable(createTable *tree.CreateTable, ifNotExists bool, server string, options map[string]string, columnOptions map[string]map[string]string) *CreateForeignTable {
+	return &CreateForeignTable{
+		createTable: createTable,
+		definition: foreign.Table{
+			Name:          doltdb.TableName{Name: string(createTable.Table.ObjectName), Schema: string(createTable.Table.SchemaName)},
+			Server:        server,
+			Options:       options,
+			ColumnOptions: columnOptions,
+		},
+		ifNotExists: ifNotExists,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *CreateForeignTable) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// The CREATE TABLE statement that is executed will check its own privileges
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *CreateForeignTable) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *CreateForeignTable) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *CreateForeignTable) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *CreateForeignTable) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if c.runner == nil {
+		return nil, fmt.Errorf("CREATE FOREIGN TABLE is missing its statement runner")
+	}
+	definition := c.definition
+	if len(definition.Name.Schema) == 0 {
+		var err error
+		definition.Name.Schema, err = core.GetCurrentSchema(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	collection, err := core.GetForeignDataCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !collection.HasServer(definition.Server) {
+		return nil, fmt.Errorf(`server "%s" does not exist`, definition.Server)
+	}
+	if err = fdw.ValidateTable(definition.Options, definition.ColumnOptions); err != nil {
+		return nil, err
+	}
+	if c.ifNotExists {
+		table, err := core.GetTableFromContext(ctx, definition.Name)
+		if err != nil {
+			return nil, err
+		}
+		if table != nil {
+			notices.RaiseNotice(ctx, fmt.Sprintf(`relation "%s" already exists, skipping`, definition.Name.Name))
+			return sql.RowsToRowIter(), nil
+		}
+	}
+	if _, _, err = c.runner(ctx, c.createTable); err != nil {
+		return nil, err
+	}
+	// Creating the table changed the root, so we fetch the collection again
+	collection, err = core.GetForeignDataCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = collection.AddTable(&definition); err != nil {
+		return nil, err
+	}
+	if err = core.UpdateForeignDataCollection(ctx, collection); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *CreateForeignTable) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *CreateForeignTable) String() string {
+	return "CREATE FOREIGN TABLE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *CreateForeignTable) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *CreateForeignTable) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
+
+// WithStatementRunner returns a copy of this node that creates the table using the given runner.
+func (c *CreateForeignTable) WithStatementRunner(runner StatementRunner) *CreateForeignTable {
+	nc := *c
+	nc.runner = runner
+	return &nc
+}
@@ -0,0 +1,152 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/functions"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/privilege"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// CreateFunction handles the CREATE FUNCTION and CREATE PROCEDURE statements.
+type CreateFunction struct {
+	replace  bool
+	schema   string
+	function *functions.Function
+}
+
+var _ sql.ExecSourceRel = (*CreateFunction)(nil)
+var _ vitess.Injectable = (*CreateFunction)(nil)
+
+// NewCreateFunction returns a new *CreateFunction.
+func NewCreateFunction(replace bool, schema string, function *functions.Function) *CreateFunction {
+	return &CreateFunction{
+		replace:  replace,
+		schema:   schema,
+		function: function,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *CreateFunction) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// Creating a function requires CREATE on its schema, and replacing one requires its ownership, which are checked in
+	// RowIter once the schema has been resolved
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *CreateFunction) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *CreateFunction) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *CreateFunction) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *CreateFunction) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	schema := c.schema
+	if len(c.schema) == 0 {
+		var err error
+		schema, err = core.GetCurrentSchema(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	collection, err := core.GetFunctionsCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	role := currentRole(ctx).Name
+	if obj := auth.SchemaObject(ctx.GetCurrentDatabase(), schema); !auth.HasPrivilege(role, obj, privilege.CREATE) {
+		return nil, auth.PermissionDeniedError(obj)
+	}
+	name := doltdb.TableName{Name: c.function.Name, Schema: schema}
+	obj := auth.FunctionObject(ctx.GetCurrentDatabase(), schema, c.function.Name)
+	existing := collection.GetFunction(name)
+	if existing != nil {
+		if !c.replace {
+			return nil, fmt.Errorf(`function "%s" already exists with same argument types`, c.function.Name)
+		}
+		if !auth.IsOwner(role, obj) {
+			kindName := "function"
+			if existing.Kind == functions.Kind_Procedure {
+				kindName = "procedure"
+			}
+			return nil, pgerrors.Newf(pgcode.InsufficientPrivilege, "must be owner of %s %s", kindName, c.function.Name)
+		}
+		if existing.Kind != c.function.Kind {
+			return nil, fmt.Errorf(`cannot change routine kind`)
+		}
+		if err = collection.DropFunction(name); err != nil {
+			return nil, err
+		}
+	}
+	if err = collection.CreateFunction(schema, c.function.Clone()); err != nil {
+		return nil, err
+	}
+	if err = core.UpdateFunctionsCollection(ctx, collection); err != nil {
+		return nil, err
+	}
+	// A replaced function keeps its owner and privileges
+	if existing == nil {
+		if err = auth.CreateObject(obj, role); err != nil {
+			return nil, err
+		}
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *CreateFunction) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *CreateFunction) String() string {
+	if c.function.Kind == functions.Kind_Procedure {
+		return "CREATE PROCEDURE"
+	}
+	return "CREATE FUNCTION"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *CreateFunction) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *CreateFunction) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
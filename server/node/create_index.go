@@ -0,0 +1,122 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/doltgresql/core"
+)
+
+// CreateIndex handles the creation of indexes on existing tables. This replaces the GMS implementation, as it relies on
+// Dolt's table alteration, which does not account for schemas.
+type CreateIndex struct {
+	gmsAlterIndex *plan.AlterIndex
+}
+
+var _ sql.ExecSourceRel = (*CreateIndex)(nil)
+var _ sql.Expressioner = (*CreateIndex)(nil)
+
+// NewCreateIndex returns a new *CreateIndex.
+func NewCreateIndex(alterIndex *plan.AlterIndex) *CreateIndex {
+	return &CreateIndex{
+		gmsAlterIndex: alterIndex,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *CreateIndex) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return c.gmsAlterIndex.CheckPrivileges(ctx, opChecker)
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *CreateIndex) Children() []sql.Node {
+	return c.gmsAlterIndex.Children()
+}
+
+// Expressions implements the interface sql.Expressioner.
+func (c *CreateIndex) Expressions() []sql.Expression {
+	return c.gmsAlterIndex.Expressions()
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *CreateIndex) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *CreateIndex) Resolved() bool {
+	return c.gmsAlterIndex != nil && c.gmsAlterIndex.Resolved()
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *CreateIndex) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if len(c.gmsAlterIndex.Columns) == 0 {
+		return nil, plan.ErrCreateIndexMissingColumns.New()
+	}
+	nameable, ok := c.gmsAlterIndex.Table.(sql.Nameable)
+	if !ok {
+		return nil, fmt.Errorf("unable to determine the table for the index")
+	}
+	// TODO: get the schema from the table, not the search path
+	err := core.CreateIndex(ctx, doltdb.TableName{Name: nameable.Name()}, sql.IndexDef{
+		Name:       c.gmsAlterIndex.IndexName,
+		Columns:    c.gmsAlterIndex.Columns,
+		Constraint: c.gmsAlterIndex.Constraint,
+		Storage:    c.gmsAlterIndex.Using,
+		Comment:    c.gmsAlterIndex.Comment,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *CreateIndex) Schema() sql.Schema {
+	return c.gmsAlterIndex.Schema()
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *CreateIndex) String() string {
+	return c.gmsAlterIndex.String()
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *CreateIndex) WithChildren(children ...sql.Node) (sql.Node, error) {
+	gmsAlterIndex, err := c.gmsAlterIndex.WithChildren(children...)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateIndex{
+		gmsAlterIndex: gmsAlterIndex.(*plan.AlterIndex),
+	}, nil
+}
+
+// WithExpressions implements the interface sql.Expressioner.
+func (c *CreateIndex) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	gmsAlterIndex, err := c.gmsAlterIndex.WithExpressions(exprs...)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateIndex{
+		gmsAlterIndex: gmsAlterIndex.(*plan.AlterIndex),
+	}, nil
+}
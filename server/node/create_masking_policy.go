@@ -0,0 +1,140 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/masking"
+	"github.com/dolthub/doltgresql/server/auth"
+)
+
+// CreateMaskingPolicy handles the CREATE MASKING POLICY statement.
+type CreateMaskingPolicy struct {
+	policy masking.Policy
+}
+
+var _ sql.ExecSourceRel = (*CreateMaskingPolicy)(nil)
+var _ vitess.Injectable = (*CreateMaskingPolicy)(nil)
+
+// NewCreateMaskingPolicy returns a new *CreateMaskingPolicy.
+func NewCreateMaskingPolicy(name string, schema string, table string, column string, role string, expression string) *CreateMaskingPolicy {
+	return &CreateMaskingPolicy{
+		policy: masking.Policy{
+			Name:       name,
+			Schema:     schema,
+			Table:      table,
+			Column:     column,
+			Role:       role,
+			Expression: expression,
+		},
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *CreateMaskingPolicy) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// Only the owner of the table may mask its columns, which is checked in RowIter once the table's schema has been
+	// resolved
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *CreateMaskingPolicy) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *CreateMaskingPolicy) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *CreateMaskingPolicy) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *CreateMaskingPolicy) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	policy := c.policy
+	if len(policy.Schema) == 0 {
+		var err error
+		policy.Schema, err = core.GetCurrentSchema(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	switch strings.ToLower(policy.Role) {
+	case "current_user", "current_role", "session_user":
+		policy.Role = ctx.Client().User
+	}
+	table, err := core.GetTableFromContext(ctx, doltdb.TableName{Name: policy.Table, Schema: policy.Schema})
+	if err != nil {
+		return nil, err
+	}
+	if table == nil {
+		return nil, fmt.Errorf(`relation "%s" does not exist`, policy.Table)
+	}
+	if obj := auth.TableObject(ctx.GetCurrentDatabase(), policy.Schema, policy.Table); !auth.IsOwner(currentRole(ctx).Name, obj) {
+		return nil, auth.NotOwnerError(obj)
+	}
+	sch, err := table.GetSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := sch.GetAllCols().GetByName(policy.Column); !ok {
+		return nil, fmt.Errorf(`column "%s" of relation "%s" does not exist`, policy.Column, policy.Table)
+	}
+	collection, err := core.GetMaskingPoliciesCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = collection.CreatePolicy(&policy); err != nil {
+		return nil, err
+	}
+	if err = core.UpdateMaskingPoliciesCollection(ctx, collection); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *CreateMaskingPolicy) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *CreateMaskingPolicy) String() string {
+	return "CREATE MASKING POLICY"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *CreateMaskingPolicy) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *CreateMaskingPolicy) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
@@ -0,0 +1,192 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/partitions"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/notices"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// CreatePartition handles the CREATE TABLE ... PARTITION OF statement. The partition is created as a regular table with
+// the same columns as its partitioned table, while its bound is stored alongside it so that rows are routed to it.
+type CreatePartition struct {
+	createTable     *tree.CreateTable
+	parent          doltdb.TableName
+	partition       partitions.Partition
+	boundStrategy   partitions.Strategy
+	subpartitionKey *partitions.Table
+	ifNotExists     bool
+	runner          StatementRunner
+}
+
+var _ sql.ExecSourceRel = (*CreatePartition)(nil)
+var _ vitess.Injectable = (*CreatePartition)(nil)
+
+// NewCreatePartition returns a new *CreatePartition. The statement creates the table that holds the partition's rows,
+// while the bound was written for the given partitioning strategy. The subpartition key is nil unless the partition is
+// partitioned itself.
+func NewCreatePartition(createTable *tree.CreateTable, parent doltdb.TableName, partition *partitions.Partition, boundStrategy partitions.Strategy, subpartitionKey *partitions.Table, ifNotExists bool) *CreatePartition {
+	return &CreatePartition{
+		createTable:     createTable,
+		parent:          parent,
+		partition:       *partition,
+		boundStrategy:   boundStrategy,
+		subpartitionKey: subpartitionKey,
+		ifNotExists:     ifNotExists,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *CreatePartition) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// The CREATE TABLE statement that is executed will check its own privileges
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *CreatePartition) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *CreatePartition) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *CreatePartition) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *CreatePartition) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if c.runner == nil {
+		return nil, fmt.Errorf("CREATE TABLE ... PARTITION OF is missing its statement runner")
+	}
+	collection, parentName, err := core.GetPartitionsForTable(ctx, ctx.GetCurrentDatabase(), c.parent)
+	if err != nil {
+		return nil, err
+	}
+	var parent *partitions.Table
+	if collection != nil {
+		parent = collection.GetTable(parentName)
+	}
+	if parent == nil {
+		if _, ok, err := core.ResolveTableName(ctx, ctx.GetCurrentDatabase(), c.parent); err != nil {
+			return nil, err
+		} else if !ok {
+			return nil, pgerrors.Newf(pgcode.UndefinedTable, `relation "%s" does not exist`, c.parent.Name)
+		}
+		return nil, pgerrors.Newf(pgcode.WrongObjectType, `table "%s" is not partitioned`, c.parent.Name)
+	}
+	partition := c.partition
+	partition.Parent = parentName
+	partition.Name = doltdb.TableName{Name: string(c.createTable.Table.ObjectName), Schema: string(c.createTable.Table.SchemaName)}
+	if len(partition.Name.Schema) == 0 {
+		partition.Name.Schema, err = core.GetCurrentSchema(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	existingTable, err := core.GetTableFromContext(ctx, partition.Name)
+	if err != nil {
+		return nil, err
+	}
+	if existingTable != nil {
+		if c.ifNotExists {
+			notices.RaiseNotice(ctx, fmt.Sprintf(`relation "%s" already exists, skipping`, partition.Name.Name))
+			return sql.RowsToRowIter(), nil
+		}
+		return nil, pgerrors.Newf(pgcode.DuplicateRelation, `relation "%s" already exists`, partition.Name.Name)
+	}
+	normalized, key, _, err := prepareNewPartition(ctx, collection, parent, &partition, c.boundStrategy)
+	if err != nil {
+		return nil, err
+	}
+	var subpartitionKey *partitions.Table
+	if c.subpartitionKey != nil {
+		subpartitionKey = &partitions.Table{
+			Name:     partition.Name,
+			Strategy: c.subpartitionKey.Strategy,
+			Columns:  c.subpartitionKey.Columns,
+		}
+		if _, err = NewPartitionKey(subpartitionKey, key.schema); err != nil {
+			return nil, err
+		}
+		if err = CheckPrimaryKeyIncludesPartitionKey(subpartitionKey, key.schema); err != nil {
+			return nil, err
+		}
+	}
+	if _, _, err = c.runner(ctx, c.createTable); err != nil {
+		return nil, err
+	}
+	// Creating the table changed the root, so we fetch the collection again
+	collection, err = core.GetPartitionsCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = collection.AddPartition(normalized); err != nil {
+		return nil, err
+	}
+	if subpartitionKey != nil {
+		if err = collection.AddTable(subpartitionKey); err != nil {
+			return nil, err
+		}
+	}
+	if err = core.UpdatePartitionsCollection(ctx, collection); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *CreatePartition) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *CreatePartition) String() string {
+	return "CREATE TABLE PARTITION OF"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *CreatePartition) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *CreatePartition) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
+
+// WithStatementRunner returns a copy of this node that creates the table using the given runner.
+func (c *CreatePartition) WithStatementRunner(runner StatementRunner) *CreatePartition {
+	nc := *c
+	nc.runner = runner
+	return &nc
+}
@@ -0,0 +1,188 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/publications"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/privilege"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// CreatePublication handles the CREATE PUBLICATION statement.
+type CreatePublication struct {
+	name      string
+	allTables bool
+	tables    []doltdb.TableName
+	params    map[string]string
+}
+
+var _ sql.ExecSourceRel = (*CreatePublication)(nil)
+var _ vitess.Injectable = (*CreatePublication)(nil)
+
+// NewCreatePublication returns a new *CreatePublication.
+func NewCreatePublication(name string, allTables bool, tables []doltdb.TableName, params map[string]string) *CreatePublication {
+	return &CreatePublication{
+		name:      name,
+		allTables: allTables,
+		tables:    tables,
+		params:    params,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *CreatePublication) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// Creating a publication requires CREATE on the database and ownership of its tables, which is checked in RowIter
+	// once the tables have been resolved
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *CreatePublication) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *CreatePublication) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *CreatePublication) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *CreatePublication) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	current := currentRole(ctx)
+	if obj := auth.DatabaseObject(ctx.GetCurrentDatabase()); !auth.HasPrivilege(current.Name, obj, privilege.CREATE) {
+		return nil, auth.PermissionDeniedError(obj)
+	}
+	if c.allTables && !current.IsSuperUser {
+		return nil, pgerrors.New(pgcode.InsufficientPrivilege, "must be superuser to create FOR ALL TABLES publication")
+	}
+	publication := publications.NewPublication(c.name, ctx.Client().User)
+	publication.AllTables = c.allTables
+	if err := setPublicationParameters(publication, c.params); err != nil {
+		return nil, err
+	}
+	tables, err := resolvePublicationTables(ctx, c.tables)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkOwnsPublicationTables(ctx, tables); err != nil {
+		return nil, err
+	}
+	if err = publication.AddTables(tables); err != nil {
+		return nil, err
+	}
+	collection, err := core.GetPublicationsCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = collection.CreatePublication(publication); err != nil {
+		return nil, err
+	}
+	if err = core.UpdatePublicationsCollection(ctx, collection); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *CreatePublication) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *CreatePublication) String() string {
+	return "CREATE PUBLICATION"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *CreatePublication) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *CreatePublication) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
+
+// resolvePublicationTables returns the given tables with their schemas resolved using the search path. Returns an error
+// if a table does not exist.
+func resolvePublicationTables(ctx *sql.Context, tables []doltdb.TableName) ([]doltdb.TableName, error) {
+	resolved := make([]doltdb.TableName, len(tables))
+	for i, table := range tables {
+		resolvedName, ok, err := core.ResolveTableName(ctx, ctx.GetCurrentDatabase(), table)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, fmt.Errorf(`relation "%s" does not exist`, table.Name)
+		}
+		resolved[i] = resolvedName
+	}
+	return resolved, nil
+}
+
+// setPublicationParameters sets the given parameters on the publication, in order of their names so that errors are
+// reported consistently.
+func setPublicationParameters(publication *publications.Publication, params map[string]string) error {
+	for _, name := range utils.GetMapKeysSorted(params) {
+		if err := publication.SetParameter(name, params[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkOwnsPublicationTables returns an error if the current role does not own every one of the given tables, which
+// must already be resolved.
+func checkOwnsPublicationTables(ctx *sql.Context, tables []doltdb.TableName) error {
+	role := currentRole(ctx).Name
+	for _, table := range tables {
+		if obj := auth.TableObject(ctx.GetCurrentDatabase(), table.Schema, table.Name); !auth.IsOwner(role, obj) {
+			return auth.NotOwnerError(obj)
+		}
+	}
+	return nil
+}
+
+// checkPublicationOwner returns an error if the current role does not have the privileges of the publication's owner.
+// Publications without a recorded owner are owned by the bootstrap role.
+func checkPublicationOwner(ctx *sql.Context, publication *publications.Publication) error {
+	owner := publication.Owner
+	if len(owner) == 0 {
+		owner = auth.BootstrapRole
+	}
+	if !auth.HasPrivilegesOf(currentRole(ctx).Name, owner) {
+		return pgerrors.Newf(pgcode.InsufficientPrivilege, "must be owner of publication %s", publication.Name)
+	}
+	return nil
+}
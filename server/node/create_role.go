@@ -0,0 +1,130 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/notices"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// CreateRole handles the CREATE ROLE and CREATE USER statements.
+type CreateRole struct {
+	name        string
+	ifNotExists bool
+	options     RoleOptions
+}
+
+var _ sql.ExecSourceRel = (*CreateRole)(nil)
+var _ vitess.Injectable = (*CreateRole)(nil)
+
+// NewCreateRole returns a new *CreateRole. CREATE USER is the same as CREATE ROLE, except that the role may log in
+// unless the options state otherwise.
+func NewCreateRole(name string, ifNotExists bool, isUser bool, options RoleOptions) *CreateRole {
+	if isUser && options.Login == nil {
+		login := true
+		options.Login = &login
+	}
+	return &CreateRole{
+		name:        name,
+		ifNotExists: ifNotExists,
+		options:     options,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *CreateRole) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *CreateRole) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *CreateRole) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *CreateRole) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *CreateRole) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if err := c.execute(ctx); err != nil {
+		return nil, pgerrors.Raise(ctx, err)
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *CreateRole) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *CreateRole) String() string {
+	return "CREATE ROLE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *CreateRole) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *CreateRole) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
+
+// execute creates the role.
+func (c *CreateRole) execute(ctx *sql.Context) error {
+	role := auth.NewRole(c.name)
+	if err := c.options.apply(ctx, &role); err != nil {
+		return err
+	}
+	if err := checkCanManageRole(currentRole(ctx), "create", role, c.options); err != nil {
+		return err
+	}
+	if _, ok := auth.GetRole(c.name); ok && c.ifNotExists {
+		notices.RaiseNotice(ctx, fmt.Sprintf(`role "%s" already exists, skipping`, c.name))
+		return nil
+	}
+	if err := auth.CreateRole(role); err != nil {
+		return err
+	}
+	// A role that is created by a non-superuser is granted to its creator with the admin option, so that the creator
+	// may manage the role's memberships. The creator does not inherit the role's privileges, which matches Postgres.
+	if current := currentRole(ctx); !current.IsSuperUser {
+		admin, inherit, set := true, false, false
+		options := auth.MembershipOptions{Admin: &admin, Inherit: &inherit, Set: &set}
+		if _, err := auth.GrantMembership(role.Name, current.Name, auth.BootstrapRole, options); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -25,6 +25,11 @@ import (
 
 	"github.com/dolthub/doltgresql/core"
 	"github.com/dolthub/doltgresql/core/sequences"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/privilege"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/notices"
+	"github.com/dolthub/doltgresql/server/pgerrors"
 )
 
 // CreateSequence handles the CREATE SEQUENCE statement, along with SERIAL type definitions.
@@ -48,7 +53,7 @@ func NewCreateSequence(ifNotExists bool, schema string, sequence *sequences.Sequ
 
 // CheckPrivileges implements the interface sql.ExecSourceRel.
 func (c *CreateSequence) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
-	// TODO: implement privilege checking
+	// Creating a sequence requires CREATE on its schema, which is checked in RowIter once the schema has been resolved
 	return true
 }
 
@@ -72,6 +77,9 @@ func (c *CreateSequence) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, erro
 	if strings.HasPrefix(strings.ToLower(c.sequence.Name), "dolt") {
 		return nil, fmt.Errorf("sequences cannot be prefixed with 'dolt'")
 	}
+	if c.sequence.Minimum >= c.sequence.Maximum {
+		return nil, fmt.Errorf("MINVALUE (%d) must be less than MAXVALUE (%d)", c.sequence.Minimum, c.sequence.Maximum)
+	}
 	schema := c.schema
 	if len(c.schema) == 0 {
 		var err error
@@ -81,51 +89,29 @@ func (c *CreateSequence) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, erro
 		}
 	}
 
+	if obj := auth.SchemaObject(ctx.GetCurrentDatabase(), schema); !auth.HasPrivilege(currentRole(ctx).Name, obj, privilege.CREATE) {
+		return nil, auth.PermissionDeniedError(obj)
+	}
 	// Check that the sequence name is free
 	relationType, err := core.GetRelationType(ctx, schema, c.sequence.Name)
 	if err != nil {
 		return nil, err
 	}
-	if relationType != core.RelationType_DoesNotExist && c.ifNotExists {
+	if relationType != core.RelationType_DoesNotExist {
 		if c.ifNotExists {
-			// TODO: issue a notice
+			notices.RaiseNotice(ctx, fmt.Sprintf(`relation "%s" already exists, skipping`, c.sequence.Name))
 			return sql.RowsToRowIter(), nil
 		}
 		return nil, fmt.Errorf(`relation "%s" already exists`, c.sequence.Name)
 	}
 	// Check that the OWNED BY is valid, if it exists
 	if len(c.sequence.OwnerTable) > 0 {
-		relationType, err = core.GetRelationType(ctx, schema, c.sequence.OwnerTable)
-		if err != nil {
+		if err = validateSequenceOwner(ctx, schema, c.sequence.OwnerTable, c.sequence.OwnerColumn); err != nil {
 			return nil, err
 		}
-		if relationType == core.RelationType_DoesNotExist {
-			return nil, fmt.Errorf(`relation "%s" does not exist`, c.sequence.OwnerTable)
-		} else if relationType != core.RelationType_Table {
-			return nil, fmt.Errorf(`sequence cannot be owned by relation "%s"`, c.sequence.OwnerTable)
-		}
-
-		table, err := core.GetTableFromContext(ctx, doltdb.TableName{Name: c.sequence.OwnerTable})
-		if err != nil {
-			return nil, err
-		}
-		if table == nil {
-			return nil, fmt.Errorf(`table "%s" cannot be found but says it exists`, c.sequence.OwnerTable)
-		}
-		tableSch, err := table.GetSchema(ctx)
-		if err != nil {
-			return nil, err
-		}
-		colFound := false
-		for _, col := range tableSch.GetAllCols().GetColumns() {
-			if col.Name == c.sequence.OwnerColumn {
-				colFound = true
-				break
-			}
-		}
-		if !colFound {
-			return nil, fmt.Errorf(`column "%s" of relation "%s" does not exist`, c.sequence.OwnerColumn, c.sequence.OwnerTable)
-		}
+	}
+	if len(c.sequence.OwnerUser) == 0 {
+		c.sequence.OwnerUser = ctx.Client().User
 	}
 	// Create the sequence since we know it's completely valid
 	collection, err := core.GetCollectionFromContext(ctx)
@@ -160,3 +146,50 @@ func (c *CreateSequence) WithResolvedChildren(children []any) (any, error) {
 	}
 	return c, nil
 }
+
+// validateSequenceOwner returns an error if the given column cannot own a sequence, such as when the column does not
+// exist.
+func validateSequenceOwner(ctx *sql.Context, schema string, ownerTable string, ownerColumn string) error {
+	relationType, err := core.GetRelationType(ctx, schema, ownerTable)
+	if err != nil {
+		return err
+	}
+	if relationType == core.RelationType_DoesNotExist {
+		return fmt.Errorf(`relation "%s" does not exist`, ownerTable)
+	} else if relationType != core.RelationType_Table {
+		return fmt.Errorf(`sequence cannot be owned by relation "%s"`, ownerTable)
+	}
+
+	table, err := core.GetTableFromContext(ctx, doltdb.TableName{Name: ownerTable})
+	if err != nil {
+		return err
+	}
+	if table == nil {
+		return fmt.Errorf(`table "%s" cannot be found but says it exists`, ownerTable)
+	}
+	tableSch, err := table.GetSchema(ctx)
+	if err != nil {
+		return err
+	}
+	for _, col := range tableSch.GetAllCols().GetColumns() {
+		if col.Name == ownerColumn {
+			return nil
+		}
+	}
+	return fmt.Errorf(`column "%s" of relation "%s" does not exist`, ownerColumn, ownerTable)
+}
+
+// checkSequenceOwner returns an error if the current role does not have the privileges of the sequence's owner. The
+// sequences of serial columns do not record an owner, and are owned by the owner of their table instead.
+func checkSequenceOwner(ctx *sql.Context, schema string, sequence *sequences.Sequence) error {
+	owner := sequence.OwnerUser
+	if len(owner) == 0 && len(sequence.OwnerTable) > 0 {
+		owner = auth.Owner(auth.TableObject(ctx.GetCurrentDatabase(), schema, sequence.OwnerTable))
+	} else if len(owner) == 0 {
+		owner = auth.BootstrapRole
+	}
+	if !auth.HasPrivilegesOf(currentRole(ctx).Name, owner) {
+		return pgerrors.Newf(pgcode.InsufficientPrivilege, "must be owner of sequence %s", sequence.Name)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/foreign"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/fdw"
+	"github.com/dolthub/doltgresql/server/notices"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// CreateServer handles the CREATE SERVER statement.
+type CreateServer struct {
+	server      foreign.Server
+	ifNotExists bool
+}
+
+var _ sql.ExecSourceRel = (*CreateServer)(nil)
+var _ vitess.Injectable = (*CreateServer)(nil)
+
+// NewCreateServer returns a new *CreateServer.
+func NewCreateServer(name string, ifNotExists bool, wrapper string, options map[string]string) *CreateServer {
+	return &CreateServer{
+		server: foreign.Server{
+			Name:    name,
+			Wrapper: wrapper,
+			Options: options,
+		},
+		ifNotExists: ifNotExists,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *CreateServer) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// Foreign-data wrappers belong to the bootstrap superuser, and USAGE on them cannot be granted, so only superusers may
+	// create servers. This is checked in RowIter.
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *CreateServer) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *CreateServer) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *CreateServer) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *CreateServer) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	current := currentRole(ctx)
+	if !current.IsSuperUser {
+		return nil, pgerrors.Newf(pgcode.InsufficientPrivilege, "permission denied for foreign-data wrapper %s", c.server.Wrapper)
+	}
+	if err := fdw.ValidateServer(c.server.Wrapper, c.server.Options); err != nil {
+		return nil, err
+	}
+	collection, err := core.GetForeignDataCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if collection.HasServer(c.server.Name) && c.ifNotExists {
+		notices.RaiseNotice(ctx, fmt.Sprintf(`server "%s" already exists, skipping`, c.server.Name))
+		return sql.RowsToRowIter(), nil
+	}
+	server := c.server
+	if err = collection.AddServer(&server); err != nil {
+		return nil, err
+	}
+	if err = core.UpdateForeignDataCollection(ctx, collection); err != nil {
+		return nil, err
+	}
+	if err = auth.CreateObject(auth.ForeignServerObject(ctx.GetCurrentDatabase(), server.Name), current.Name); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *CreateServer) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *CreateServer) String() string {
+	return "CREATE SERVER"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *CreateServer) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *CreateServer) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
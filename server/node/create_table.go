@@ -15,26 +15,36 @@
 package node
 
 import (
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 	"github.com/dolthub/go-mysql-server/sql/rowexec"
 
 	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/exclusions"
+	"github.com/dolthub/doltgresql/core/partitions"
+	"github.com/dolthub/doltgresql/server/pgerrors"
 )
 
 // CreateTable is a node that implements functionality specifically relevant to Doltgres' table creation needs.
 type CreateTable struct {
 	gmsCreateTable *plan.CreateTable
 	sequences      []*CreateSequence
+	storageParams  map[string]string
+	partitionKey   *partitions.Table
+	exclusions     []*exclusions.Constraint
 }
 
 var _ sql.ExecSourceRel = (*CreateTable)(nil)
 
-// NewCreateTable returns a new *CreateTable.
-func NewCreateTable(createTable *plan.CreateTable, sequences []*CreateSequence) *CreateTable {
+// NewCreateTable returns a new *CreateTable. The partition key is nil unless the table is partitioned.
+func NewCreateTable(createTable *plan.CreateTable, sequences []*CreateSequence, storageParams map[string]string, partitionKey *partitions.Table, exclusionConstraints []*exclusions.Constraint) *CreateTable {
 	return &CreateTable{
 		gmsCreateTable: createTable,
 		sequences:      sequences,
+		storageParams:  storageParams,
+		partitionKey:   partitionKey,
+		exclusions:     exclusionConstraints,
 	}
 }
 
@@ -48,6 +58,11 @@ func (c *CreateTable) Children() []sql.Node {
 	return c.gmsCreateTable.Children()
 }
 
+// GMSCreateTable returns the GMS node that creates the table.
+func (c *CreateTable) GMSCreateTable() *plan.CreateTable {
+	return c.gmsCreateTable
+}
+
 // IsReadOnly implements the interface sql.ExecSourceRel.
 func (c *CreateTable) IsReadOnly() bool {
 	return false
@@ -60,13 +75,57 @@ func (c *CreateTable) Resolved() bool {
 
 // RowIter implements the interface sql.ExecSourceRel.
 func (c *CreateTable) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
-	createTableIter, err := rowexec.DefaultBuilder.Build(ctx, c.gmsCreateTable, r)
+	// TODO: get the schema from the table, not the current schema
+	schemaName, err := core.GetCurrentSchema(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// TODO: get the schema from the table, not the current schema
-	schemaName, err := core.GetCurrentSchema(ctx)
+	// A partitioned table is only recorded when this statement creates it, as IF NOT EXISTS leaves existing tables alone
+	var partitionKey *partitions.Table
+	if c.partitionKey != nil {
+		partitionKey = &partitions.Table{
+			Name:     doltdb.TableName{Name: c.gmsCreateTable.Name(), Schema: c.tableSchemaName(schemaName)},
+			Strategy: c.partitionKey.Strategy,
+			Columns:  c.partitionKey.Columns,
+		}
+		if _, err = NewPartitionKey(partitionKey, c.gmsCreateTable.PkSchema().Schema); err != nil {
+			return nil, err
+		}
+		if err = CheckPrimaryKeyIncludesPartitionKey(partitionKey, c.gmsCreateTable.PkSchema().Schema); err != nil {
+			return nil, err
+		}
+		existingTable, err := core.GetTableFromContext(ctx, partitionKey.Name)
+		if err != nil {
+			return nil, err
+		}
+		if existingTable != nil {
+			partitionKey = nil
+		}
+	}
+
+	// Exclusion constraints are likewise only recorded when this statement creates the table
+	var exclusionConstraints []*exclusions.Constraint
+	if len(c.exclusions) > 0 {
+		tableName := doltdb.TableName{Name: c.gmsCreateTable.Name(), Schema: c.tableSchemaName(schemaName)}
+		for _, constraint := range c.exclusions {
+			newConstraint := *constraint
+			newConstraint.Table = tableName
+			if _, err = resolveExclusionConstraint(&newConstraint, c.gmsCreateTable.PkSchema().Schema); err != nil {
+				return nil, pgerrors.Raise(ctx, err)
+			}
+			exclusionConstraints = append(exclusionConstraints, &newConstraint)
+		}
+		existingTable, err := core.GetTableFromContext(ctx, tableName)
+		if err != nil {
+			return nil, err
+		}
+		if existingTable != nil {
+			exclusionConstraints = nil
+		}
+	}
+
+	createTableIter, err := rowexec.DefaultBuilder.Build(ctx, c.gmsCreateTable, r)
 	if err != nil {
 		return nil, err
 	}
@@ -79,6 +138,56 @@ func (c *CreateTable) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error)
 			return nil, err
 		}
 	}
+
+	if len(c.storageParams) > 0 {
+		schemaName = c.tableSchemaName(schemaName)
+		collection, err := core.GetStorageParametersCollectionFromContext(ctx)
+		if err != nil {
+			_ = createTableIter.Close(ctx)
+			return nil, err
+		}
+		tableName := doltdb.TableName{Name: c.gmsCreateTable.Name(), Schema: schemaName}
+		if err = collection.SetParameters(tableName, c.storageParams); err != nil {
+			_ = createTableIter.Close(ctx)
+			return nil, err
+		}
+		if err = core.UpdateStorageParametersCollection(ctx, collection); err != nil {
+			_ = createTableIter.Close(ctx)
+			return nil, err
+		}
+	}
+	if partitionKey != nil {
+		collection, err := core.GetPartitionsCollectionFromContext(ctx)
+		if err != nil {
+			_ = createTableIter.Close(ctx)
+			return nil, err
+		}
+		if err = collection.AddTable(partitionKey); err != nil {
+			_ = createTableIter.Close(ctx)
+			return nil, err
+		}
+		if err = core.UpdatePartitionsCollection(ctx, collection); err != nil {
+			_ = createTableIter.Close(ctx)
+			return nil, err
+		}
+	}
+	if len(exclusionConstraints) > 0 {
+		collection, err := core.GetExclusionsCollectionFromContext(ctx)
+		if err != nil {
+			_ = createTableIter.Close(ctx)
+			return nil, err
+		}
+		for _, constraint := range exclusionConstraints {
+			if err = collection.AddConstraint(constraint); err != nil {
+				_ = createTableIter.Close(ctx)
+				return nil, err
+			}
+		}
+		if err = core.UpdateExclusionsCollection(ctx, collection); err != nil {
+			_ = createTableIter.Close(ctx)
+			return nil, err
+		}
+	}
 	return createTableIter, err
 }
 
@@ -101,5 +210,17 @@ func (c *CreateTable) WithChildren(children ...sql.Node) (sql.Node, error) {
 	return &CreateTable{
 		gmsCreateTable: gmsCreateTable.(*plan.CreateTable),
 		sequences:      c.sequences,
+		storageParams:  c.storageParams,
+		partitionKey:   c.partitionKey,
+		exclusions:     c.exclusions,
 	}, nil
 }
+
+// tableSchemaName returns the name of the schema that the table is created in, which is the given current schema
+// unless the table's name was qualified.
+func (c *CreateTable) tableSchemaName(currentSchema string) string {
+	if namedSchema, ok := c.gmsCreateTable.Db.(interface{ Schema() string }); ok && len(namedSchema.Schema()) > 0 {
+		return namedSchema.Schema()
+	}
+	return currentSchema
+}
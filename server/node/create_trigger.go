@@ -0,0 +1,163 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/functions"
+	"github.com/dolthub/doltgresql/core/triggers"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/privilege"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// CreateTrigger handles the CREATE TRIGGER statement.
+type CreateTrigger struct {
+	replace bool
+	trigger triggers.Trigger
+}
+
+var _ sql.ExecSourceRel = (*CreateTrigger)(nil)
+var _ vitess.Injectable = (*CreateTrigger)(nil)
+
+// NewCreateTrigger returns a new *CreateTrigger. The schemas of the table and function are empty when they were not
+// given.
+func NewCreateTrigger(replace bool, trigger triggers.Trigger) *CreateTrigger {
+	return &CreateTrigger{
+		replace: replace,
+		trigger: trigger,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *CreateTrigger) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// Creating a trigger requires TRIGGER on the table and EXECUTE on the function, which are checked in RowIter once
+	// their schemas have been resolved
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *CreateTrigger) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *CreateTrigger) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *CreateTrigger) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *CreateTrigger) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	trigger := c.trigger
+	currentSchema, err := core.GetCurrentSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(trigger.Table.Schema) == 0 {
+		trigger.Table.Schema = currentSchema
+	}
+	if len(trigger.Function.Schema) == 0 {
+		trigger.Function.Schema = currentSchema
+	}
+	table, err := core.GetTableFromContext(ctx, trigger.Table)
+	if err != nil {
+		return nil, err
+	}
+	if table == nil {
+		return nil, pgerrors.Newf(pgcode.UndefinedTable, `relation "%s" does not exist`, trigger.Table.Name)
+	}
+	role := currentRole(ctx).Name
+	tableObj := auth.TableObject(ctx.GetCurrentDatabase(), trigger.Table.Schema, trigger.Table.Name)
+	if !auth.HasPrivilege(role, tableObj, privilege.TRIGGER) {
+		return nil, auth.PermissionDeniedError(tableObj)
+	}
+	sch, err := table.GetSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, column := range trigger.UpdateColumns {
+		if _, ok := sch.GetAllCols().GetByName(column); !ok {
+			return nil, pgerrors.Newf(pgcode.UndefinedColumn, `column "%s" of relation "%s" does not exist`, column, trigger.Table.Name)
+		}
+	}
+	functionCollection, err := core.GetFunctionsCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	function := functionCollection.GetFunction(trigger.Function)
+	if function == nil || function.Kind != functions.Kind_Function {
+		return nil, pgerrors.Newf(pgcode.UndefinedFunction, `function %s() does not exist`, trigger.Function.Name)
+	}
+	functionObj := auth.FunctionObject(ctx.GetCurrentDatabase(), trigger.Function.Schema, trigger.Function.Name)
+	if !auth.HasPrivilege(role, functionObj, privilege.EXECUTE) {
+		return nil, auth.PermissionDeniedError(functionObj)
+	}
+	returnType, err := pgtypes.DeserializeType(function.ReturnType)
+	if err != nil {
+		return nil, err
+	}
+	if returnType.(pgtypes.DoltgresType).BaseID() != pgtypes.DoltgresTypeBaseID_Trigger {
+		return nil, pgerrors.Newf(pgcode.InvalidObjectDefinition, `function %s must return type trigger`, trigger.Function.Name)
+	}
+	collection, err := core.GetTriggersCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if c.replace {
+		collection.ReplaceTrigger(&trigger)
+	} else if err = collection.AddTrigger(&trigger); err != nil {
+		return nil, pgerrors.Wrap(pgcode.DuplicateObject, err)
+	}
+	if err = core.UpdateTriggersCollection(ctx, collection); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *CreateTrigger) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *CreateTrigger) String() string {
+	return "CREATE TRIGGER"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *CreateTrigger) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *CreateTrigger) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// DeclareCursor handles the DECLARE statement. Cursors are portals that belong to the connection, and are shared with
+// the extended query protocol, so the connection handler runs the query and creates the portal. This node only exists
+// to carry the cursor's definition to the handler.
+type DeclareCursor struct {
+	name       string
+	query      string
+	scrollable bool
+	hold       bool
+}
+
+var _ sql.ExecSourceRel = (*DeclareCursor)(nil)
+var _ vitess.Injectable = (*DeclareCursor)(nil)
+
+// NewDeclareCursor returns a new *DeclareCursor. The query is the SELECT statement that the cursor reads from.
+func NewDeclareCursor(name string, query string, scrollable bool, hold bool) *DeclareCursor {
+	return &DeclareCursor{
+		name:       name,
+		query:      query,
+		scrollable: scrollable,
+		hold:       hold,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (d *DeclareCursor) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// The query will check its own privileges when it's executed
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (d *DeclareCursor) Children() []sql.Node {
+	return nil
+}
+
+// Hold returns whether the cursor may continue to be used after the transaction that created it has committed.
+func (d *DeclareCursor) Hold() bool {
+	return d.hold
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (d *DeclareCursor) IsReadOnly() bool {
+	return true
+}
+
+// Name returns the name of the cursor.
+func (d *DeclareCursor) Name() string {
+	return d.name
+}
+
+// Query returns the query that the cursor reads from.
+func (d *DeclareCursor) Query() string {
+	return d.query
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (d *DeclareCursor) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (d *DeclareCursor) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	return nil, fmt.Errorf("DECLARE is only supported as a top-level statement")
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (d *DeclareCursor) Schema() sql.Schema {
+	return nil
+}
+
+// Scrollable returns whether the cursor may move backward.
+func (d *DeclareCursor) Scrollable() bool {
+	return d.scrollable
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (d *DeclareCursor) String() string {
+	return "DECLARE CURSOR"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (d *DeclareCursor) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(d, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (d *DeclareCursor) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return d, nil
+}
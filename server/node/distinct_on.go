@@ -0,0 +1,154 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/rowexec"
+)
+
+// DistinctOn keeps the first row of each group of rows that have the same values for the expressions of a DISTINCT ON
+// clause. This is placed above any sorting, so that the ORDER BY clause determines which row is the first of its group.
+type DistinctOn struct {
+	child sql.Node
+	key   sql.Expression
+}
+
+var _ sql.ExecSourceRel = (*DistinctOn)(nil)
+var _ sql.Expressioner = (*DistinctOn)(nil)
+
+// NewDistinctOn returns a new *DistinctOn. The key must evaluate to a sql.Row that holds the values of the DISTINCT ON
+// expressions.
+func NewDistinctOn(child sql.Node, key sql.Expression) *DistinctOn {
+	return &DistinctOn{
+		child: child,
+		key:   key,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (d *DistinctOn) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return d.child.CheckPrivileges(ctx, opChecker)
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (d *DistinctOn) Children() []sql.Node {
+	return []sql.Node{d.child}
+}
+
+// Expressions implements the interface sql.Expressioner.
+func (d *DistinctOn) Expressions() []sql.Expression {
+	return []sql.Expression{d.key}
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (d *DistinctOn) IsReadOnly() bool {
+	return d.child.IsReadOnly()
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (d *DistinctOn) Resolved() bool {
+	return d.child.Resolved() && d.key.Resolved()
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (d *DistinctOn) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	childIter, err := rowexec.DefaultBuilder.Build(ctx, d.child, r)
+	if err != nil {
+		return nil, err
+	}
+	return &distinctOnIter{
+		key:       d.key,
+		childIter: childIter,
+		seen:      make(map[uint64]struct{}),
+	}, nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (d *DistinctOn) Schema() sql.Schema {
+	return d.child.Schema()
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (d *DistinctOn) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("DistinctOn(%s)", d.key.String())
+	_ = pr.WriteChildren(d.child.String())
+	return pr.String()
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (d *DistinctOn) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(d, len(children), 1)
+	}
+	nd := *d
+	nd.child = children[0]
+	return &nd, nil
+}
+
+// WithExpressions implements the interface sql.Expressioner.
+func (d *DistinctOn) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(d, len(exprs), 1)
+	}
+	nd := *d
+	nd.key = exprs[0]
+	return &nd, nil
+}
+
+// distinctOnIter is the iterator for *DistinctOn. It keeps track of the hashes of the keys of all rows that have been
+// returned.
+type distinctOnIter struct {
+	key       sql.Expression
+	childIter sql.RowIter
+	seen      map[uint64]struct{}
+}
+
+var _ sql.RowIter = (*distinctOnIter)(nil)
+
+// Next implements the interface sql.RowIter.
+func (iter *distinctOnIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for {
+		row, err := iter.childIter.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		key, err := iter.key.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		keyRow, ok := key.(sql.Row)
+		if !ok {
+			return nil, fmt.Errorf("DISTINCT ON key returned `%T` rather than a row", key)
+		}
+		hash, err := sql.HashOf(keyRow)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok = iter.seen[hash]; ok {
+			continue
+		}
+		iter.seen[hash] = struct{}{}
+		return row, nil
+	}
+}
+
+// Close implements the interface sql.RowIter.
+func (iter *distinctOnIter) Close(ctx *sql.Context) error {
+	return iter.childIter.Close(ctx)
+}
@@ -0,0 +1,187 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/backends"
+	"github.com/dolthub/doltgresql/server/notices"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// disconnectTimeout is how long a database statement waits for other sessions to disconnect from a database that it
+// requires exclusive access to, which includes sessions that it terminates.
+const disconnectTimeout = 5 * time.Second
+
+// DropDatabase handles the DROP DATABASE statement. A database may not be dropped while other sessions are connected
+// to it, unless FORCE is given, in which case those sessions are terminated first.
+type DropDatabase struct {
+	name     string
+	ifExists bool
+	force    bool
+}
+
+var _ sql.ExecSourceRel = (*DropDatabase)(nil)
+var _ vitess.Injectable = (*DropDatabase)(nil)
+
+// NewDropDatabase returns a new *DropDatabase.
+func NewDropDatabase(name string, ifExists bool, force bool) *DropDatabase {
+	return &DropDatabase{
+		name:     name,
+		ifExists: ifExists,
+		force:    force,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (d *DropDatabase) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (d *DropDatabase) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (d *DropDatabase) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (d *DropDatabase) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (d *DropDatabase) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if err := d.execute(ctx); err != nil {
+		return nil, pgerrors.Raise(ctx, err)
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (d *DropDatabase) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (d *DropDatabase) String() string {
+	return "DROP DATABASE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (d *DropDatabase) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(d, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (d *DropDatabase) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return d, nil
+}
+
+// execute drops the database.
+func (d *DropDatabase) execute(ctx *sql.Context) error {
+	// An explicit transaction causes autocommit to be ignored, which is how we determine that we're in a block
+	if ctx.GetIgnoreAutoCommit() {
+		return pgerrors.New(pgcode.ActiveSQLTransaction, "DROP DATABASE cannot run inside a transaction block")
+	}
+	provider := dsess.DSessFromSess(ctx.Session).Provider()
+	if !provider.HasDatabase(ctx, d.name) {
+		if d.ifExists {
+			notices.RaiseNotice(ctx, fmt.Sprintf(`database "%s" does not exist, skipping`, d.name))
+			return nil
+		}
+		return pgerrors.Newf(pgcode.UndefinedDatabase, `database "%s" does not exist`, d.name)
+	}
+	current := currentRole(ctx)
+	obj := auth.DatabaseObject(d.name)
+	if !auth.IsOwner(current.Name, obj) {
+		return auth.NotOwnerError(obj)
+	}
+	if strings.EqualFold(ctx.GetCurrentDatabase(), d.name) {
+		return pgerrors.New(pgcode.ObjectInUse, "cannot drop the currently open database")
+	}
+	if err := d.closeOtherSessions(ctx, current); err != nil {
+		return err
+	}
+	if err := provider.DropDatabase(ctx, d.name); err != nil {
+		return err
+	}
+	return auth.DropObject(obj)
+}
+
+// closeOtherSessions ensures that no other session is connected to the database, terminating them when FORCE was given.
+// As with pg_terminate_backend, a session may only be terminated by a role with the privileges of the session's role,
+// and only superusers may terminate the sessions of superusers.
+func (d *DropDatabase) closeOtherSessions(ctx *sql.Context, current auth.Role) error {
+	if !d.force {
+		if others := backends.AwaitDisconnect(ctx, d.name, ctx.Session.ID(), disconnectTimeout); len(others) > 0 {
+			return inUseErr(d.name, len(others))
+		}
+		return nil
+	}
+	others := backends.ConnectedTo(d.name, ctx.Session.ID())
+	if len(others) == 0 {
+		return nil
+	}
+	processIDs := make([]uint32, len(others))
+	for i, other := range others {
+		if !current.IsSuperUser {
+			if role, ok := auth.GetRole(other.User); ok && role.IsSuperUser {
+				return pgerrors.New(pgcode.InsufficientPrivilege, "permission denied to terminate process").
+					WithDetail(`Only roles with the SUPERUSER attribute may terminate processes of roles with the SUPERUSER attribute.`)
+			}
+			if !auth.HasPrivilegesOf(current.Name, other.User) {
+				return pgerrors.New(pgcode.InsufficientPrivilege, "permission denied to terminate process").
+					WithDetail(`Only roles with privileges of the role whose process is being terminated may terminate this process.`)
+			}
+		}
+		processIDs[i] = other.ProcessID
+	}
+	if !backends.Terminate(ctx, processIDs, disconnectTimeout) {
+		return inUseErr(d.name, len(others))
+	}
+	return nil
+}
+
+// inUseErr returns the error for a database that is being used by the given number of other sessions.
+func inUseErr(database string, count int) error {
+	return pgerrors.Newf(pgcode.ObjectInUse, `database "%s" is being accessed by other users`, database).
+		WithDetail(otherSessionsDetail(count))
+}
+
+// otherSessionsDetail returns the detail of the error for a database that is being used by the given number of other
+// sessions.
+func otherSessionsDetail(count int) string {
+	if count == 1 {
+		return "There is 1 other session using the database."
+	}
+	return fmt.Sprintf("There are %d other sessions using the database.", count)
+}
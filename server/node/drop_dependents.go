@@ -0,0 +1,214 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/dependencies"
+	"github.com/dolthub/doltgresql/postgres/messages"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/notices"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// dropDependents drops every object of the current database that depends on the given targets, without dropping the
+// targets themselves. When CASCADE is not given, an error listing the dependents is returned if any exist, in which
+// case nothing is dropped. When CASCADE is given, a notice lists the dependents that will be dropped. Objects that are
+// always dropped alongside a target, such as a table's own constraints, do not require CASCADE.
+func dropDependents(ctx *sql.Context, runner ConvertedStatementRunner, graph *dependencies.Graph, targets []dependencies.Object, cascade bool) error {
+	visibleSchema, err := core.GetCurrentSchema(ctx)
+	if err != nil {
+		return err
+	}
+	for _, target := range targets {
+		owner, ok := graph.InternalOwner(target)
+		if !ok || containsObject(targets, owner) {
+			continue
+		}
+		return pgerrors.Newf(pgcode.DependentObjectsStillExist, "cannot drop %s because %s requires it",
+			target.Description(visibleSchema), owner.Description(visibleSchema)).
+			WithHint(fmt.Sprintf("You can drop %s instead.", owner.Description(visibleSchema)))
+	}
+	order, dependents := graph.Collect(targets)
+	if len(dependents) > 0 {
+		if !cascade {
+			return dependentsError(targets, dependents, visibleSchema)
+		}
+		raiseCascadeNotice(ctx, dependents, visibleSchema)
+	}
+	for _, obj := range order {
+		if containsObject(targets, obj) {
+			continue
+		}
+		if err = dropObject(ctx, runner, graph, obj, order); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dependentsError returns the error for targets that may not be dropped without CASCADE due to the given dependents.
+func dependentsError(targets []dependencies.Object, dependents []dependencies.Dependent, visibleSchema string) error {
+	details := make([]string, len(dependents))
+	for i, dependent := range dependents {
+		details[i] = fmt.Sprintf("%s depends on %s",
+			dependent.Object.Description(visibleSchema), dependent.Referenced.Description(visibleSchema))
+	}
+	var err *pgerrors.Error
+	if len(targets) == 1 {
+		err = pgerrors.Newf(pgcode.DependentObjectsStillExist, "cannot drop %s because other objects depend on it",
+			targets[0].Description(visibleSchema))
+	} else {
+		err = pgerrors.New(pgcode.DependentObjectsStillExist,
+			"cannot drop desired object(s) because other objects depend on them")
+	}
+	return err.WithDetail(strings.Join(details, "\n")).
+		WithHint("Use DROP ... CASCADE to drop the dependent objects too.")
+}
+
+// raiseCascadeNotice sends the notice that lists the dependents that will be dropped due to CASCADE.
+func raiseCascadeNotice(ctx *sql.Context, dependents []dependencies.Dependent, visibleSchema string) {
+	if len(dependents) == 1 {
+		notices.RaiseNotice(ctx, fmt.Sprintf("drop cascades to %s", dependents[0].Object.Description(visibleSchema)))
+		return
+	}
+	details := make([]string, len(dependents))
+	for i, dependent := range dependents {
+		details[i] = fmt.Sprintf("drop cascades to %s", dependent.Object.Description(visibleSchema))
+	}
+	notices.Raise(ctx, notices.Notice{
+		Severity: messages.ErrorResponseSeverity_Notice,
+		Message:  fmt.Sprintf("drop cascades to %d other objects", len(dependents)),
+		Detail:   strings.Join(details, "\n"),
+	})
+}
+
+// dropObject drops a single object of the current database. The dropped objects are every object that the statement
+// drops, as objects that belong to another dropped object are dropped alongside it.
+func dropObject(ctx *sql.Context, runner ConvertedStatementRunner, graph *dependencies.Graph, obj dependencies.Object, dropped []dependencies.Object) error {
+	switch obj.Type {
+	case dependencies.ObjectType_Table:
+		// Partitions are dropped alongside their partitioned table, so they may no longer exist
+		_, ok, err := core.ResolveTableName(ctx, ctx.GetCurrentDatabase(), doltdb.TableName{Name: obj.Name, Schema: obj.Schema})
+		if err != nil || !ok {
+			return err
+		}
+		return runDDL(ctx, runner, &vitess.DDL{
+			Action:     vitess.DropStr,
+			FromTables: vitess.TableNames{objectTableName(obj.Schema, obj.Name)},
+		})
+	case dependencies.ObjectType_View:
+		return runDDL(ctx, runner, &vitess.DDL{
+			Action:    vitess.DropStr,
+			FromViews: vitess.TableNames{vitess.TableName{Name: vitess.NewTableIdent(obj.Name)}},
+		})
+	case dependencies.ObjectType_Sequence:
+		// Sequences are always dropped from the collection within the context, even when they are dropped alongside
+		// their table, as the context's collection would otherwise restore them
+		collection, err := core.GetCollectionFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		name := doltdb.TableName{Name: obj.Name, Schema: obj.Schema}
+		if !collection.HasSequence(name) {
+			return nil
+		}
+		return collection.DropSequence(name)
+	case dependencies.ObjectType_Function, dependencies.ObjectType_Procedure:
+		collection, err := core.GetFunctionsCollectionFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		if err = collection.DropFunction(doltdb.TableName{Name: obj.Name, Schema: obj.Schema}); err != nil {
+			return err
+		}
+		return core.UpdateFunctionsCollection(ctx, collection)
+	case dependencies.ObjectType_ColumnDefault:
+		if graph.DroppedWith(obj, dropped) {
+			return nil
+		}
+		tableName := objectTableName(obj.Schema, obj.Table)
+		return runDDL(ctx, runner, &vitess.AlterTable{
+			Table: tableName,
+			Statements: []*vitess.DDL{{
+				Action: vitess.AlterStr,
+				Table:  tableName,
+				DefaultSpec: &vitess.DefaultSpec{
+					Action: vitess.DropStr,
+					Column: vitess.NewColIdent(obj.Name),
+				},
+			}},
+		})
+	case dependencies.ObjectType_ForeignKey:
+		// Foreign keys are dropped even when their table is also being dropped, as the tables may be dropped in an order
+		// that would otherwise violate the foreign key
+		table, err := core.GetSqlTableFromContext(ctx, ctx.GetCurrentDatabase(), doltdb.TableName{Name: obj.Table, Schema: obj.Schema})
+		if err != nil || table == nil {
+			return err
+		}
+		fkTable, ok := table.(sql.ForeignKeyTable)
+		if !ok {
+			return fmt.Errorf("table %s does not support foreign keys", obj.Table)
+		}
+		return fkTable.DropForeignKey(ctx, obj.Name)
+	case dependencies.ObjectType_Trigger:
+		if graph.DroppedWith(obj, dropped) {
+			return nil
+		}
+		collection, err := core.GetTriggersCollectionFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		if err = collection.DropTrigger(doltdb.TableName{Name: obj.Table, Schema: obj.Schema}, obj.Name); err != nil {
+			return err
+		}
+		return core.UpdateTriggersCollection(ctx, collection)
+	default:
+		// Columns and schemas are dropped by their own statements, as they are only dependents of objects that are
+		// dropped alongside them
+		return nil
+	}
+}
+
+// runDDL executes the given DDL statement using the runner.
+func runDDL(ctx *sql.Context, runner ConvertedStatementRunner, stmt vitess.Statement) error {
+	if runner == nil {
+		return fmt.Errorf("statement runner has not been assigned")
+	}
+	_, _, err := runner(ctx, stmt, vitess.String(stmt))
+	return err
+}
+
+// objectTableName returns the vitess.TableName for the table with the given schema and name.
+func objectTableName(schemaName string, name string) vitess.TableName {
+	return vitess.TableName{Name: vitess.NewTableIdent(name), SchemaQualifier: vitess.NewTableIdent(schemaName)}
+}
+
+// containsObject returns whether the object is within the given objects.
+func containsObject(objects []dependencies.Object, obj dependencies.Object) bool {
+	for _, other := range objects {
+		if other == obj {
+			return true
+		}
+	}
+	return false
+}
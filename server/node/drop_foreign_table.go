@@ -0,0 +1,140 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/notices"
+)
+
+// DropForeignTable handles the DROP FOREIGN TABLE statement. Dropping the table that holds the columns also removes
+// the foreign definition.
+type DropForeignTable struct {
+	names    tree.TableNames
+	ifExists bool
+	runner   StatementRunner
+}
+
+var _ sql.ExecSourceRel = (*DropForeignTable)(nil)
+var _ vitess.Injectable = (*DropForeignTable)(nil)
+
+// NewDropForeignTable returns a new *DropForeignTable.
+func NewDropForeignTable(names tree.TableNames, ifExists bool) *DropForeignTable {
+	return &DropForeignTable{
+		names:    names,
+		ifExists: ifExists,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *DropForeignTable) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// The DROP TABLE statement that is executed will check its own privileges
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *DropForeignTable) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *DropForeignTable) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *DropForeignTable) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *DropForeignTable) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if c.runner == nil {
+		return nil, fmt.Errorf("DROP FOREIGN TABLE is missing its statement runner")
+	}
+	collection, err := core.GetForeignDataCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var names tree.TableNames
+	for _, name := range c.names {
+		tableName := doltdb.TableName{Name: string(name.ObjectName), Schema: string(name.SchemaName)}
+		if len(tableName.Schema) == 0 {
+			tableName.Schema, err = core.GetCurrentSchema(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if collection.GetTable(tableName) != nil {
+			names = append(names, name)
+			continue
+		}
+		table, err := core.GetTableFromContext(ctx, tableName)
+		if err != nil {
+			return nil, err
+		}
+		if table != nil {
+			return nil, fmt.Errorf(`"%s" is not a foreign table`, tableName.Name)
+		}
+		if !c.ifExists {
+			return nil, fmt.Errorf(`foreign table "%s" does not exist`, tableName.Name)
+		}
+		notices.RaiseNotice(ctx, fmt.Sprintf(`foreign table "%s" does not exist, skipping`, tableName.Name))
+	}
+	if len(names) > 0 {
+		if _, _, err = c.runner(ctx, &tree.DropTable{Names: names}); err != nil {
+			return nil, err
+		}
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *DropForeignTable) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *DropForeignTable) String() string {
+	return "DROP FOREIGN TABLE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *DropForeignTable) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *DropForeignTable) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
+
+// WithStatementRunner returns a copy of this node that drops the tables using the given runner.
+func (c *DropForeignTable) WithStatementRunner(runner StatementRunner) *DropForeignTable {
+	nc := *c
+	nc.runner = runner
+	return &nc
+}
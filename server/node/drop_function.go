@@ -0,0 +1,164 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/functions"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/notices"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// DropFunction handles the DROP FUNCTION and DROP PROCEDURE statements.
+type DropFunction struct {
+	ifExists bool
+	kind     functions.Kind
+	names    []doltdb.TableName
+}
+
+var _ sql.ExecSourceRel = (*DropFunction)(nil)
+var _ vitess.Injectable = (*DropFunction)(nil)
+
+// NewDropFunction returns a new *DropFunction.
+func NewDropFunction(ifExists bool, kind functions.Kind, names []doltdb.TableName) *DropFunction {
+	return &DropFunction{
+		ifExists: ifExists,
+		kind:     kind,
+		names:    names,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *DropFunction) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// Only the owner of a function may drop it, which is checked in RowIter once the function has been found
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *DropFunction) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *DropFunction) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *DropFunction) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *DropFunction) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	collection, err := core.GetFunctionsCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	currentSchema, err := core.GetCurrentSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	role := currentRole(ctx).Name
+	kindName := "function"
+	if c.kind == functions.Kind_Procedure {
+		kindName = "procedure"
+	}
+	// All names are validated before any are dropped, so that a failure does not leave a partial drop behind
+	names := make([]doltdb.TableName, 0, len(c.names))
+	for _, name := range c.names {
+		if len(name.Schema) == 0 {
+			name.Schema = currentSchema
+		}
+		function := collection.GetFunction(name)
+		if function == nil {
+			if c.ifExists {
+				notices.RaiseNotice(ctx, fmt.Sprintf(`%s %s does not exist, skipping`, kindName, name.Name))
+				continue
+			}
+			return nil, fmt.Errorf(`%s %s does not exist`, kindName, name.Name)
+		}
+		if function.Kind != c.kind {
+			return nil, fmt.Errorf(`%s is not a %s`, name.Name, kindName)
+		}
+		if !auth.IsOwner(role, auth.FunctionObject(ctx.GetCurrentDatabase(), name.Schema, name.Name)) {
+			return nil, pgerrors.Newf(pgcode.InsufficientPrivilege, "must be owner of %s %s", kindName, name.Name)
+		}
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return sql.RowsToRowIter(), nil
+	}
+	triggerCollection, err := core.GetTriggersCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		if trigger := triggerCollection.GetFunctionDependent(name); trigger != nil {
+			return nil, pgerrors.Newf(pgcode.DependentObjectsStillExist, `cannot drop %s %s() because other objects depend on it`, kindName, name.Name).
+				WithDetail(fmt.Sprintf(`trigger %s on table %s depends on %s %s()`, trigger.Name, trigger.Table.Name, kindName, name.Name)).
+				WithHint("Use DROP ... CASCADE to drop the dependent objects too.")
+		}
+	}
+	for _, name := range names {
+		if err = collection.DropFunction(name); err != nil {
+			return nil, err
+		}
+	}
+	if err = core.UpdateFunctionsCollection(ctx, collection); err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		if err = auth.DropObject(auth.FunctionObject(ctx.GetCurrentDatabase(), name.Schema, name.Name)); err != nil {
+			return nil, err
+		}
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *DropFunction) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *DropFunction) String() string {
+	if c.kind == functions.Kind_Procedure {
+		return "DROP PROCEDURE"
+	}
+	return "DROP FUNCTION"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *DropFunction) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *DropFunction) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
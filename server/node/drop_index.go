@@ -0,0 +1,136 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/notices"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// DropIndex handles the DROP INDEX statement. Postgres does not require the table name when dropping an index, as index
+// names are unique within a schema, so the owning table is found when the statement is executed.
+type DropIndex struct {
+	schema       string
+	index        string
+	ifExists     bool
+	concurrently bool
+}
+
+var _ sql.ExecSourceRel = (*DropIndex)(nil)
+var _ vitess.Injectable = (*DropIndex)(nil)
+
+// NewDropIndex returns a new *DropIndex.
+func NewDropIndex(schema string, index string, ifExists bool, concurrently bool) *DropIndex {
+	return &DropIndex{
+		schema:       schema,
+		index:        index,
+		ifExists:     ifExists,
+		concurrently: concurrently,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (d *DropIndex) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// Only the owner of the index's table may drop the index, which is checked in RowIter once the table has been found
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (d *DropIndex) Children() []sql.Node {
+	return nil
+}
+
+// Concurrently returns whether the index is dropped concurrently, which may not happen within a transaction block.
+func (d *DropIndex) Concurrently() bool {
+	return d.concurrently
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (d *DropIndex) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (d *DropIndex) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (d *DropIndex) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	// An explicit transaction causes autocommit to be ignored, which is how we determine that we're in a block
+	if d.concurrently && ctx.GetIgnoreAutoCommit() {
+		return nil, fmt.Errorf("DROP INDEX CONCURRENTLY cannot run inside a transaction block")
+	}
+	schema := d.schema
+	if len(d.schema) == 0 {
+		var err error
+		schema, err = core.GetCurrentSchema(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	tableName, ok, err := core.GetIndexTable(ctx, schema, d.index)
+	if err != nil {
+		return nil, err
+	}
+	// Indexes are owned by the owner of their table
+	if obj := auth.TableObject(ctx.GetCurrentDatabase(), schema, tableName); ok && !auth.IsOwner(currentRole(ctx).Name, obj) {
+		return nil, pgerrors.Newf(pgcode.InsufficientPrivilege, "must be owner of index %s", d.index)
+	}
+	found, err := core.DropIndex(ctx, schema, d.index)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		if !d.ifExists {
+			return nil, fmt.Errorf(`index "%s" does not exist`, d.index)
+		}
+		notices.RaiseNotice(ctx, fmt.Sprintf(`index "%s" does not exist, skipping`, d.index))
+	}
+	return sql.RowsToRowIter(sql.NewRow(types.NewOkResult(0))), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (d *DropIndex) Schema() sql.Schema {
+	return types.OkResultSchema
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (d *DropIndex) String() string {
+	return "DROP INDEX"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (d *DropIndex) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(d, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (d *DropIndex) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return d, nil
+}
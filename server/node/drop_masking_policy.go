@@ -0,0 +1,114 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/notices"
+)
+
+// DropMaskingPolicy handles the DROP MASKING POLICY statement.
+type DropMaskingPolicy struct {
+	ifExists bool
+	name     string
+}
+
+var _ sql.ExecSourceRel = (*DropMaskingPolicy)(nil)
+var _ vitess.Injectable = (*DropMaskingPolicy)(nil)
+
+// NewDropMaskingPolicy returns a new *DropMaskingPolicy.
+func NewDropMaskingPolicy(ifExists bool, name string) *DropMaskingPolicy {
+	return &DropMaskingPolicy{
+		ifExists: ifExists,
+		name:     name,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *DropMaskingPolicy) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// Only the owner of the masked table may drop the policy, which is checked in RowIter once the policy has been found
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *DropMaskingPolicy) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *DropMaskingPolicy) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *DropMaskingPolicy) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *DropMaskingPolicy) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	collection, err := core.GetMaskingPoliciesCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policy := collection.GetPolicy(c.name)
+	if policy == nil {
+		if c.ifExists {
+			notices.RaiseNotice(ctx, fmt.Sprintf(`masking policy "%s" does not exist, skipping`, c.name))
+			return sql.RowsToRowIter(), nil
+		}
+		return nil, fmt.Errorf(`masking policy "%s" does not exist`, c.name)
+	}
+	if obj := auth.TableObject(ctx.GetCurrentDatabase(), policy.Schema, policy.Table); !auth.IsOwner(currentRole(ctx).Name, obj) {
+		return nil, auth.NotOwnerError(obj)
+	}
+	if err = collection.DropPolicy(c.name); err != nil {
+		return nil, err
+	}
+	if err = core.UpdateMaskingPoliciesCollection(ctx, collection); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *DropMaskingPolicy) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *DropMaskingPolicy) String() string {
+	return "DROP MASKING POLICY"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *DropMaskingPolicy) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *DropMaskingPolicy) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/server/notices"
+)
+
+// DropPublication handles the DROP PUBLICATION statement.
+type DropPublication struct {
+	names    []string
+	ifExists bool
+}
+
+var _ sql.ExecSourceRel = (*DropPublication)(nil)
+var _ vitess.Injectable = (*DropPublication)(nil)
+
+// NewDropPublication returns a new *DropPublication.
+func NewDropPublication(names []string, ifExists bool) *DropPublication {
+	return &DropPublication{
+		names:    names,
+		ifExists: ifExists,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *DropPublication) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// Only the owner of a publication may drop it, which is checked in RowIter once the publication has been loaded
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *DropPublication) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *DropPublication) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *DropPublication) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *DropPublication) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	collection, err := core.GetPublicationsCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range c.names {
+		if !collection.HasPublication(name) && c.ifExists {
+			notices.RaiseNotice(ctx, fmt.Sprintf(`publication "%s" does not exist, skipping`, name))
+			continue
+		}
+		if publication := collection.GetPublication(name); publication != nil {
+			if err = checkPublicationOwner(ctx, publication); err != nil {
+				return nil, err
+			}
+		}
+		if err = collection.DropPublication(name); err != nil {
+			return nil, err
+		}
+	}
+	if err = core.UpdatePublicationsCollection(ctx, collection); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *DropPublication) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *DropPublication) String() string {
+	return "DROP PUBLICATION"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *DropPublication) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *DropPublication) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
@@ -0,0 +1,157 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/dependencies"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/notices"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// DropSchema handles the DROP SCHEMA statement. A schema that contains objects may only be dropped when CASCADE is
+// given, in which case its objects are dropped first, along with every object elsewhere that depends on them.
+type DropSchema struct {
+	names    []string
+	ifExists bool
+	cascade  bool
+	runner   ConvertedStatementRunner
+}
+
+var _ sql.ExecSourceRel = (*DropSchema)(nil)
+var _ vitess.Injectable = (*DropSchema)(nil)
+
+// NewDropSchema returns a new *DropSchema.
+func NewDropSchema(names []string, ifExists bool, cascade bool) *DropSchema {
+	return &DropSchema{
+		names:    names,
+		ifExists: ifExists,
+		cascade:  cascade,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *DropSchema) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *DropSchema) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *DropSchema) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *DropSchema) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *DropSchema) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if err := c.execute(ctx); err != nil {
+		return nil, pgerrors.Raise(ctx, err)
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *DropSchema) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *DropSchema) String() string {
+	return "DROP SCHEMA"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *DropSchema) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *DropSchema) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
+
+// WithStatementRunner returns a copy of this node that drops the schemas' objects using the given runner.
+func (c *DropSchema) WithStatementRunner(runner ConvertedStatementRunner) *DropSchema {
+	nc := *c
+	nc.runner = runner
+	return &nc
+}
+
+// execute drops the schemas.
+func (c *DropSchema) execute(ctx *sql.Context) error {
+	database := ctx.GetCurrentDatabase()
+	existing, err := core.GetSchemaNamesFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	current := currentRole(ctx)
+	var targets []dependencies.Object
+	for _, name := range c.names {
+		if name == "pg_catalog" || name == "information_schema" {
+			return pgerrors.Newf(pgcode.DependentObjectsStillExist,
+				"cannot drop schema %s because it is required by the database system", name)
+		}
+		if !slices.Contains(existing, name) {
+			if c.ifExists {
+				notices.RaiseNotice(ctx, fmt.Sprintf(`schema "%s" does not exist, skipping`, name))
+				continue
+			}
+			return pgerrors.Newf(pgcode.UndefinedSchema, `schema "%s" does not exist`, name)
+		}
+		if obj := auth.SchemaObject(database, name); !auth.IsOwner(current.Name, obj) {
+			return auth.NotOwnerError(obj)
+		}
+		targets = append(targets, dependencies.Object{Type: dependencies.ObjectType_Schema, Schema: name, Name: name})
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+	graph, err := core.GetDependencyGraph(ctx, database)
+	if err != nil {
+		return err
+	}
+	if err = dropDependents(ctx, c.runner, graph, targets, c.cascade); err != nil {
+		return err
+	}
+	for _, target := range targets {
+		if err = core.DropSchema(ctx, target.Name); err != nil {
+			return err
+		}
+		if err = auth.DropObject(auth.SchemaObject(database, target.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -23,24 +23,27 @@ import (
 	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
 
 	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/dependencies"
+	"github.com/dolthub/doltgresql/server/notices"
+	"github.com/dolthub/doltgresql/server/pgerrors"
 )
 
-// DropSequence handles the DROP SEQUENCE statement.
+// DropSequence handles the DROP SEQUENCE statement. Column defaults that call nextval on the sequences are dropped
+// first when CASCADE is given, and otherwise prevent the sequences from being dropped.
 type DropSequence struct {
-	schema   string
-	sequence string
+	names    []doltdb.TableName
 	ifExists bool
 	cascade  bool
+	runner   ConvertedStatementRunner
 }
 
 var _ sql.ExecSourceRel = (*DropSequence)(nil)
 var _ vitess.Injectable = (*DropSequence)(nil)
 
-// NewDropSequence returns a new *DropSequence.
-func NewDropSequence(ifExists bool, schema string, sequence string, cascade bool) *DropSequence {
+// NewDropSequence returns a new *DropSequence. Names without a schema use the current schema.
+func NewDropSequence(ifExists bool, names []doltdb.TableName, cascade bool) *DropSequence {
 	return &DropSequence{
-		schema:   schema,
-		sequence: sequence,
+		names:    names,
 		ifExists: ifExists,
 		cascade:  cascade,
 	}
@@ -48,7 +51,7 @@ func NewDropSequence(ifExists bool, schema string, sequence string, cascade bool
 
 // CheckPrivileges implements the interface sql.ExecSourceRel.
 func (c *DropSequence) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
-	// TODO: implement privilege checking
+	// Only the owner of a sequence may drop it, which is checked in RowIter once the sequence has been loaded
 	return true
 }
 
@@ -69,40 +72,60 @@ func (c *DropSequence) Resolved() bool {
 
 // RowIter implements the interface sql.ExecSourceRel.
 func (c *DropSequence) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
-	schema := c.schema
-	if len(c.schema) == 0 {
-		var err error
-		schema, err = core.GetCurrentSchema(ctx)
+	collection, err := core.GetCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// All sequences are validated before any are dropped, so that an error does not leave some of them dropped
+	var toDrop []doltdb.TableName
+	for _, name := range c.names {
+		if len(name.Schema) == 0 {
+			name.Schema, err = core.GetCurrentSchema(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		relationType, err := core.GetRelationType(ctx, name.Schema, name.Name)
 		if err != nil {
 			return nil, err
 		}
+		switch relationType {
+		case core.RelationType_DoesNotExist:
+			if c.ifExists {
+				notices.RaiseNotice(ctx, fmt.Sprintf(`sequence "%s" does not exist, skipping`, name.Name))
+				continue
+			}
+			return nil, fmt.Errorf(`sequence "%s" does not exist`, name.Name)
+		case core.RelationType_Sequence:
+		default:
+			return nil, fmt.Errorf(`"%s" is not a sequence`, name.Name)
+		}
+		if sequence := collection.GetSequence(name); sequence != nil {
+			if err = checkSequenceOwner(ctx, name.Schema, sequence); err != nil {
+				return nil, err
+			}
+		}
+		toDrop = append(toDrop, name)
 	}
-	relationType, err := core.GetRelationType(ctx, schema, c.sequence)
+	if len(toDrop) == 0 {
+		return sql.RowsToRowIter(), nil
+	}
+	graph, err := core.GetDependencyGraph(ctx, ctx.GetCurrentDatabase())
 	if err != nil {
 		return nil, err
 	}
-	if relationType == core.RelationType_DoesNotExist {
-		if c.ifExists {
-			// TODO: issue a notice
-			return sql.RowsToRowIter(), nil
-		}
-		return nil, fmt.Errorf(`sequence "%s" does not exist`, c.sequence)
+	targets := make([]dependencies.Object, len(toDrop))
+	for i, name := range toDrop {
+		targets[i] = dependencies.Object{Type: dependencies.ObjectType_Sequence, Schema: name.Schema, Name: name.Name}
 	}
-	collection, err := core.GetCollectionFromContext(ctx)
-	if err != nil {
-		return nil, err
+	if err = dropDependents(ctx, c.runner, graph, targets, c.cascade); err != nil {
+		return nil, pgerrors.Raise(ctx, err)
 	}
-	if sequence := collection.GetSequence(doltdb.TableName{Name: c.sequence, Schema: schema}); len(sequence.OwnerTable) > 0 {
-		if c.cascade {
-			// TODO: handle cascade
-			return nil, fmt.Errorf(`cascading sequence drops are not yet supported`)
-		} else {
-			return nil, fmt.Errorf(`cannot drop sequence %s because other objects depend on it`, c.sequence)
+	for _, name := range toDrop {
+		if err = collection.DropSequence(name); err != nil {
+			return nil, err
 		}
 	}
-	if err = collection.DropSequence(doltdb.TableName{Name: c.sequence, Schema: schema}); err != nil {
-		return nil, err
-	}
 	return sql.RowsToRowIter(), nil
 }
 
@@ -128,3 +151,10 @@ func (c *DropSequence) WithResolvedChildren(children []any) (any, error) {
 	}
 	return c, nil
 }
+
+// WithStatementRunner returns a copy of this node that drops the sequences' dependents using the given runner.
+func (c *DropSequence) WithStatementRunner(runner ConvertedStatementRunner) *DropSequence {
+	nc := *c
+	nc.runner = runner
+	return &nc
+}
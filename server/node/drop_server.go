@@ -0,0 +1,118 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/notices"
+)
+
+// DropServer handles the DROP SERVER statement.
+type DropServer struct {
+	names    []string
+	ifExists bool
+}
+
+var _ sql.ExecSourceRel = (*DropServer)(nil)
+var _ vitess.Injectable = (*DropServer)(nil)
+
+// NewDropServer returns a new *DropServer.
+func NewDropServer(names []string, ifExists bool) *DropServer {
+	return &DropServer{
+		names:    names,
+		ifExists: ifExists,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *DropServer) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// Only the owner of a server may drop it, which is checked in RowIter
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *DropServer) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *DropServer) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *DropServer) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *DropServer) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	collection, err := core.GetForeignDataCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	role := currentRole(ctx).Name
+	for _, name := range c.names {
+		if !collection.HasServer(name) && c.ifExists {
+			notices.RaiseNotice(ctx, fmt.Sprintf(`server "%s" does not exist, skipping`, name))
+			continue
+		}
+		if obj := auth.ForeignServerObject(ctx.GetCurrentDatabase(), name); collection.HasServer(name) && !auth.IsOwner(role, obj) {
+			return nil, auth.NotOwnerError(obj)
+		}
+		if err = collection.DropServer(name); err != nil {
+			return nil, err
+		}
+	}
+	if err = core.UpdateForeignDataCollection(ctx, collection); err != nil {
+		return nil, err
+	}
+	for _, name := range c.names {
+		if err = auth.DropObject(auth.ForeignServerObject(ctx.GetCurrentDatabase(), name)); err != nil {
+			return nil, err
+		}
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *DropServer) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *DropServer) String() string {
+	return "DROP SERVER"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *DropServer) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *DropServer) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
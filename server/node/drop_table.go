@@ -0,0 +1,172 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/dependencies"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+	"github.com/dolthub/doltgresql/server/pgstat"
+)
+
+// DropTable handles the DROP TABLE statement. The objects that depend on the tables, such as views and the foreign keys
+// of other tables, are dropped first when CASCADE is given, and otherwise prevent the tables from being dropped. The
+// tables themselves are dropped by the wrapped DDL statement.
+type DropTable struct {
+	ddl     *vitess.DDL
+	cascade bool
+	runner  ConvertedStatementRunner
+}
+
+var _ sql.ExecSourceRel = (*DropTable)(nil)
+var _ vitess.Injectable = (*DropTable)(nil)
+
+// NewDropTable returns a new *DropTable.
+func NewDropTable(ddl *vitess.DDL, cascade bool) *DropTable {
+	return &DropTable{
+		ddl:     ddl,
+		cascade: cascade,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *DropTable) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// The DROP TABLE statement that is executed will check its own privileges
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *DropTable) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *DropTable) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *DropTable) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *DropTable) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if c.runner == nil {
+		return nil, fmt.Errorf("DROP TABLE is missing its statement runner")
+	}
+	targets, ok, err := c.targets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ok && len(targets) > 0 {
+		current := currentRole(ctx)
+		for _, target := range targets {
+			if obj := auth.TableObject(ctx.GetCurrentDatabase(), target.Schema, target.Name); !auth.IsOwner(current.Name, obj) {
+				return nil, auth.NotOwnerError(obj)
+			}
+		}
+		graph, err := core.GetDependencyGraph(ctx, ctx.GetCurrentDatabase())
+		if err != nil {
+			return nil, err
+		}
+		if err = dropDependents(ctx, c.runner, graph, targets, c.cascade); err != nil {
+			return nil, pgerrors.Raise(ctx, err)
+		}
+	}
+	if err = runDDL(ctx, c.runner, c.ddl); err != nil {
+		return nil, err
+	}
+	// A table that is later created with the same name starts without any statistics
+	if ok {
+		database, _ := dsess.SplitRevisionDbName(ctx.GetCurrentDatabase())
+		for _, target := range targets {
+			pgstat.DropTable(pgstat.Relation{Database: database, Schema: target.Schema, Name: target.Name})
+		}
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *DropTable) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *DropTable) String() string {
+	return "DROP TABLE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *DropTable) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *DropTable) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
+
+// WithStatementRunner returns a copy of this node that drops the tables using the given runner.
+func (c *DropTable) WithStatementRunner(runner ConvertedStatementRunner) *DropTable {
+	nc := *c
+	nc.runner = runner
+	return &nc
+}
+
+// targets returns the tables that will be dropped. Returns false when the dependents of the tables should not be
+// handled, which is when a table belongs to another database, or when a table is missing and the DDL statement will
+// return an error for it.
+func (c *DropTable) targets(ctx *sql.Context) ([]dependencies.Object, bool, error) {
+	database := ctx.GetCurrentDatabase()
+	var targets []dependencies.Object
+	for _, name := range c.ddl.FromTables {
+		if dbName := name.DbQualifier.String(); len(dbName) > 0 && !strings.EqualFold(dbName, database) {
+			return nil, false, nil
+		}
+		tableName, ok, err := core.ResolveTableName(ctx, database, doltdb.TableName{
+			Name:   name.Name.String(),
+			Schema: name.SchemaQualifier.String(),
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		if !ok {
+			if !c.ddl.IfExists {
+				return nil, false, nil
+			}
+			continue
+		}
+		targets = append(targets, dependencies.Object{
+			Type:   dependencies.ObjectType_Table,
+			Schema: tableName.Schema,
+			Name:   tableName.Name,
+		})
+	}
+	return targets, true, nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/notices"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// DropTrigger handles the DROP TRIGGER statement.
+type DropTrigger struct {
+	ifExists bool
+	name     string
+	table    doltdb.TableName
+}
+
+var _ sql.ExecSourceRel = (*DropTrigger)(nil)
+var _ vitess.Injectable = (*DropTrigger)(nil)
+
+// NewDropTrigger returns a new *DropTrigger.
+func NewDropTrigger(ifExists bool, name string, table doltdb.TableName) *DropTrigger {
+	return &DropTrigger{
+		ifExists: ifExists,
+		name:     name,
+		table:    table,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *DropTrigger) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// Only the owner of the table may drop its triggers, which is checked in RowIter once the table's schema has been
+	// resolved
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *DropTrigger) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *DropTrigger) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *DropTrigger) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *DropTrigger) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	table := c.table
+	if len(table.Schema) == 0 {
+		var err error
+		table.Schema, err = core.GetCurrentSchema(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	collection, err := core.GetTriggersCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if collection.GetTrigger(table, c.name) == nil {
+		if c.ifExists {
+			notices.RaiseNotice(ctx, fmt.Sprintf(`trigger "%s" for relation "%s" does not exist, skipping`, c.name, table.Name))
+			return sql.RowsToRowIter(), nil
+		}
+		return nil, pgerrors.Newf(pgcode.UndefinedObject, `trigger "%s" for table "%s" does not exist`, c.name, table.Name)
+	}
+	if obj := auth.TableObject(ctx.GetCurrentDatabase(), table.Schema, table.Name); !auth.IsOwner(currentRole(ctx).Name, obj) {
+		return nil, auth.NotOwnerError(obj)
+	}
+	if err = collection.DropTrigger(table, c.name); err != nil {
+		return nil, err
+	}
+	if err = core.UpdateTriggersCollection(ctx, collection); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *DropTrigger) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *DropTrigger) String() string {
+	return "DROP TRIGGER"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *DropTrigger) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *DropTrigger) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
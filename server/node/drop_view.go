@@ -0,0 +1,151 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/dependencies"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// DropView handles the DROP VIEW statement. The views that read from the views are dropped first when CASCADE is given,
+// and otherwise prevent the views from being dropped. The views themselves are dropped by the wrapped DDL statement.
+type DropView struct {
+	ddl     *vitess.DDL
+	cascade bool
+	runner  ConvertedStatementRunner
+}
+
+var _ sql.ExecSourceRel = (*DropView)(nil)
+var _ vitess.Injectable = (*DropView)(nil)
+
+// NewDropView returns a new *DropView.
+func NewDropView(ddl *vitess.DDL, cascade bool) *DropView {
+	return &DropView{
+		ddl:     ddl,
+		cascade: cascade,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *DropView) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// The DROP VIEW statement that is executed will check its own privileges
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *DropView) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *DropView) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *DropView) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *DropView) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if c.runner == nil {
+		return nil, fmt.Errorf("DROP VIEW is missing its statement runner")
+	}
+	graph, err := core.GetDependencyGraph(ctx, ctx.GetCurrentDatabase())
+	if err != nil {
+		return nil, err
+	}
+	if targets, ok := c.targets(ctx, graph); ok && len(targets) > 0 {
+		current := currentRole(ctx)
+		for _, target := range targets {
+			if obj := auth.TableObject(ctx.GetCurrentDatabase(), target.Schema, target.Name); !auth.IsOwner(current.Name, obj) {
+				return nil, auth.NotOwnerError(obj)
+			}
+		}
+		if err = dropDependents(ctx, c.runner, graph, targets, c.cascade); err != nil {
+			return nil, pgerrors.Raise(ctx, err)
+		}
+	}
+	if err = runDDL(ctx, c.runner, c.ddl); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *DropView) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *DropView) String() string {
+	return "DROP VIEW"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *DropView) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *DropView) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
+
+// WithStatementRunner returns a copy of this node that drops the views using the given runner.
+func (c *DropView) WithStatementRunner(runner ConvertedStatementRunner) *DropView {
+	nc := *c
+	nc.runner = runner
+	return &nc
+}
+
+// targets returns the views that will be dropped. Returns false when the dependents of the views should not be
+// handled, which is when a view belongs to another database, or when a view is missing and the DDL statement will
+// return an error for it.
+func (c *DropView) targets(ctx *sql.Context, graph *dependencies.Graph) ([]dependencies.Object, bool) {
+	database := ctx.GetCurrentDatabase()
+	var targets []dependencies.Object
+	for _, name := range c.ddl.FromViews {
+		if dbName := name.DbQualifier.String(); len(dbName) > 0 && !strings.EqualFold(dbName, database) {
+			return nil, false
+		}
+		// Views are not stored within a schema, so a view that was created in another schema is still found by name
+		found := graph.Find(dependencies.ObjectType_View, name.SchemaQualifier.String(), name.Name.String())
+		if len(found) == 0 {
+			found = graph.Find(dependencies.ObjectType_View, "", name.Name.String())
+		}
+		if len(found) == 0 {
+			if !c.ddl.IfExists {
+				return nil, false
+			}
+			continue
+		}
+		targets = append(targets, found[0])
+	}
+	return targets, true
+}
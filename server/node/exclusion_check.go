@@ -0,0 +1,217 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/rowexec"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/exclusions"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// ExclusionCheck verifies that each row that is about to be written by an INSERT or UPDATE does not conflict with any
+// other row of the table under the table's exclusion constraints. This wraps the node that produces the rows to write,
+// which are the new rows for inserts, and the old rows followed by the new rows for updates. The table's rows are read
+// once the first row is about to be written, and every written row is compared against them and the rows that were
+// written before it.
+type ExclusionCheck struct {
+	child         sql.Node
+	database      string
+	table         doltdb.TableName
+	constraints   []*exclusions.Constraint
+	isUpdate      bool
+	skipConflicts bool
+}
+
+var _ sql.ExecSourceRel = (*ExclusionCheck)(nil)
+
+// NewExclusionCheck returns a new *ExclusionCheck. When skipConflicts is true, rows that conflict are not written
+// instead of returning an error, which is used by ON CONFLICT DO NOTHING.
+func NewExclusionCheck(child sql.Node, database string, table doltdb.TableName, constraints []*exclusions.Constraint, isUpdate bool, skipConflicts bool) *ExclusionCheck {
+	return &ExclusionCheck{
+		child:         child,
+		database:      database,
+		table:         table,
+		constraints:   constraints,
+		isUpdate:      isUpdate,
+		skipConflicts: skipConflicts,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (ec *ExclusionCheck) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return ec.child.CheckPrivileges(ctx, opChecker)
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (ec *ExclusionCheck) Children() []sql.Node {
+	return []sql.Node{ec.child}
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (ec *ExclusionCheck) IsReadOnly() bool {
+	return ec.child.IsReadOnly()
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (ec *ExclusionCheck) Resolved() bool {
+	return ec.child.Resolved()
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (ec *ExclusionCheck) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	// The table is loaded by its name, as the table that is written to may only support reading the rows of an index
+	table, err := core.GetSqlTableFromContext(ctx, ec.database, ec.table)
+	if err != nil {
+		return nil, err
+	}
+	if table == nil {
+		return nil, pgerrors.Newf(pgcode.UndefinedTable, `relation "%s" does not exist`, ec.table.Name)
+	}
+	sch := table.Schema()
+	resolved := make([]resolvedExclusion, len(ec.constraints))
+	for i, constraint := range ec.constraints {
+		if resolved[i], err = resolveExclusionConstraint(constraint, sch); err != nil {
+			return nil, err
+		}
+	}
+	childIter, err := rowexec.DefaultBuilder.Build(ctx, ec.child, r)
+	if err != nil {
+		return nil, err
+	}
+	return &exclusionCheckIter{node: ec, table: table, childIter: childIter, resolved: resolved}, nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (ec *ExclusionCheck) Schema() sql.Schema {
+	return ec.child.Schema()
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (ec *ExclusionCheck) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("ExclusionCheck")
+	_ = pr.WriteChildren(ec.child.String())
+	return pr.String()
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (ec *ExclusionCheck) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(ec, len(children), 1)
+	}
+	nec := *ec
+	nec.child = children[0]
+	return &nec, nil
+}
+
+// exclusionCheckIter is the iterator for *ExclusionCheck.
+type exclusionCheckIter struct {
+	node      *ExclusionCheck
+	table     sql.Table
+	childIter sql.RowIter
+	resolved  []resolvedExclusion
+	// rows are the rows of the table as they'll be once the statement has written the rows returned so far. This is nil
+	// until the first row is about to be written.
+	rows []sql.Row
+}
+
+var _ sql.RowIter = (*exclusionCheckIter)(nil)
+
+// Next implements the interface sql.RowIter.
+func (iter *exclusionCheckIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for {
+		row, err := iter.childIter.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if iter.rows == nil {
+			iter.rows = make([]sql.Row, 0)
+			err = iterateTableRows(ctx, iter.table, func(row sql.Row) (bool, error) {
+				iter.rows = append(iter.rows, row)
+				return true, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		newRow := row
+		if iter.node.isUpdate {
+			var oldRow sql.Row
+			oldRow, newRow = row[:len(row)/2], row[len(row)/2:]
+			if err = iter.removeRow(oldRow); err != nil {
+				return nil, err
+			}
+		}
+		conflictErr, err := iter.findConflict(newRow)
+		if err != nil {
+			return nil, err
+		}
+		if conflictErr != nil {
+			if iter.node.skipConflicts {
+				continue
+			}
+			return nil, pgerrors.Raise(ctx, conflictErr)
+		}
+		iter.rows = append(iter.rows, newRow.Copy())
+		return row, nil
+	}
+}
+
+// Close implements the interface sql.RowIter.
+func (iter *exclusionCheckIter) Close(ctx *sql.Context) error {
+	return iter.childIter.Close(ctx)
+}
+
+// findConflict returns the error for the first exclusion constraint that the given row violates. Returns a nil error if
+// the row does not conflict with any other row.
+func (iter *exclusionCheckIter) findConflict(row sql.Row) (*pgerrors.Error, error) {
+	for _, resolved := range iter.resolved {
+		for _, existing := range iter.rows {
+			conflicts, err := resolved.conflicts(row, existing)
+			if err != nil {
+				return nil, err
+			}
+			if conflicts {
+				return pgerrors.Newf(pgcode.ExclusionViolation, `conflicting key value violates exclusion constraint "%s"`,
+					resolved.constraint.Name).
+					WithDetail(fmt.Sprintf("Key %s conflicts with existing key %s.", resolved.key(row), resolved.key(existing))), nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// removeRow removes the given row from the table's rows, as it is being replaced by an update.
+func (iter *exclusionCheckIter) removeRow(row sql.Row) error {
+	sch := iter.table.Schema()
+	for i, existing := range iter.rows {
+		equal, err := existing.Equals(row, sch)
+		if err != nil {
+			return err
+		}
+		if equal {
+			iter.rows = append(iter.rows[:i], iter.rows[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,273 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/exclusions"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// AddExclusionConstraint handles the ALTER TABLE ... ADD CONSTRAINT statement for exclusion constraints. The existing
+// rows of the table are verified against the constraint before it is added.
+type AddExclusionConstraint struct {
+	schema     string
+	table      string
+	constraint *exclusions.Constraint
+}
+
+var _ sql.ExecSourceRel = (*AddExclusionConstraint)(nil)
+var _ vitess.Injectable = (*AddExclusionConstraint)(nil)
+
+// NewAddExclusionConstraint returns a new *AddExclusionConstraint.
+func NewAddExclusionConstraint(schema string, table string, constraint *exclusions.Constraint) *AddExclusionConstraint {
+	return &AddExclusionConstraint{
+		schema:     schema,
+		table:      table,
+		constraint: constraint,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *AddExclusionConstraint) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// Only the owner of the table may add a constraint to it, which is checked in RowIter once the table's schema has
+	// been resolved
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *AddExclusionConstraint) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *AddExclusionConstraint) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *AddExclusionConstraint) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *AddExclusionConstraint) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	schema := c.schema
+	if len(c.schema) == 0 {
+		var err error
+		schema, err = core.GetCurrentSchema(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	tableName := doltdb.TableName{Name: c.table, Schema: schema}
+	table, err := core.GetSqlTableFromContext(ctx, ctx.GetCurrentDatabase(), tableName)
+	if err != nil {
+		return nil, err
+	}
+	if table == nil {
+		return nil, pgerrors.Newf(pgcode.UndefinedTable, `relation "%s" does not exist`, c.table)
+	}
+	if obj := auth.TableObject(ctx.GetCurrentDatabase(), schema, c.table); !auth.IsOwner(currentRole(ctx).Name, obj) {
+		return nil, auth.NotOwnerError(obj)
+	}
+	constraint := *c.constraint
+	constraint.Table = tableName
+	resolved, err := resolveExclusionConstraint(&constraint, table.Schema())
+	if err != nil {
+		return nil, pgerrors.Raise(ctx, err)
+	}
+	var rows []sql.Row
+	err = iterateTableRows(ctx, table, func(row sql.Row) (bool, error) {
+		for _, existing := range rows {
+			conflicts, err := resolved.conflicts(existing, row)
+			if err != nil {
+				return false, err
+			}
+			if conflicts {
+				return false, pgerrors.Newf(pgcode.ExclusionViolation, `could not create exclusion constraint "%s"`, constraint.Name).
+					WithDetail(fmt.Sprintf("Key %s conflicts with key %s.", resolved.key(row), resolved.key(existing)))
+			}
+		}
+		rows = append(rows, row)
+		return true, nil
+	})
+	if err != nil {
+		return nil, pgerrors.Raise(ctx, err)
+	}
+	collection, err := core.GetExclusionsCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = collection.AddConstraint(&constraint); err != nil {
+		return nil, err
+	}
+	if err = core.UpdateExclusionsCollection(ctx, collection); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *AddExclusionConstraint) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *AddExclusionConstraint) String() string {
+	return "ADD CONSTRAINT EXCLUDE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *AddExclusionConstraint) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *AddExclusionConstraint) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
+
+// resolvedExclusion is an exclusion constraint whose columns have been found within the schema of its table.
+type resolvedExclusion struct {
+	constraint *exclusions.Constraint
+	indexes    []int
+	columns    sql.Schema
+}
+
+// resolveExclusionConstraint returns the given constraint resolved against the given schema of its table. Returns an
+// error if the constraint's columns do not exist, or if an operator cannot compare its column's type.
+func resolveExclusionConstraint(constraint *exclusions.Constraint, sch sql.Schema) (resolvedExclusion, error) {
+	resolved := resolvedExclusion{
+		constraint: constraint,
+		indexes:    make([]int, len(constraint.Elements)),
+		columns:    make(sql.Schema, len(constraint.Elements)),
+	}
+	for i, element := range constraint.Elements {
+		idx := sch.IndexOfColName(element.Column)
+		if idx == -1 {
+			return resolvedExclusion{}, pgerrors.Newf(pgcode.UndefinedColumn, `column "%s" named in key does not exist`, element.Column)
+		}
+		column := sch[idx]
+		if element.Operator == "&&" {
+			if _, ok := column.Type.(pgtypes.DoltgresArrayType); !ok {
+				return resolvedExclusion{}, pgerrors.Newf(pgcode.UndefinedFunction, "operator does not exist: %s && %s",
+					column.Type.String(), column.Type.String())
+			}
+		}
+		resolved.indexes[i] = idx
+		resolved.columns[i] = column
+	}
+	return resolved, nil
+}
+
+// conflicts returns whether the two rows conflict with each other, which is when every element's operator returns true
+// for the rows' values. Operators never return true for NULL values.
+func (resolved resolvedExclusion) conflicts(row sql.Row, other sql.Row) (bool, error) {
+	for i, element := range resolved.constraint.Elements {
+		left, right := row[resolved.indexes[i]], other[resolved.indexes[i]]
+		if left == nil || right == nil {
+			return false, nil
+		}
+		colType := resolved.columns[i].Type
+		var result bool
+		switch element.Operator {
+		case "=", "<>":
+			cmp, err := colType.Compare(left, right)
+			if err != nil {
+				return false, err
+			}
+			result = (cmp == 0) == (element.Operator == "=")
+		case "&&":
+			var err error
+			result, err = arraysOverlap(colType.(pgtypes.DoltgresArrayType).BaseType(), left, right)
+			if err != nil {
+				return false, err
+			}
+		default:
+			return false, fmt.Errorf(`unknown exclusion operator "%s"`, element.Operator)
+		}
+		if !result {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// key returns the values of the constraint's columns within the given row, as they're displayed in the detail of errors.
+func (resolved resolvedExclusion) key(row sql.Row) string {
+	values := make([]string, len(resolved.indexes))
+	for i, idx := range resolved.indexes {
+		values[i] = exclusionKeyValue(resolved.columns[i].Type, row[idx])
+	}
+	return fmt.Sprintf("(%s)=(%s)", strings.Join(resolved.constraint.Columns(), ", "), strings.Join(values, ", "))
+}
+
+// arraysOverlap returns whether the two arrays have any non-NULL elements in common.
+func arraysOverlap(baseType sql.Type, left any, right any) (bool, error) {
+	leftValues, ok := left.([]any)
+	if !ok {
+		return false, fmt.Errorf("expected an array value but got %T", left)
+	}
+	rightValues, ok := right.([]any)
+	if !ok {
+		return false, fmt.Errorf("expected an array value but got %T", right)
+	}
+	for _, leftValue := range leftValues {
+		if leftValue == nil {
+			continue
+		}
+		for _, rightValue := range rightValues {
+			if rightValue == nil {
+				continue
+			}
+			cmp, err := baseType.Compare(leftValue, rightValue)
+			if err != nil {
+				return false, err
+			}
+			if cmp == 0 {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// exclusionKeyValue returns the given value as it is displayed within a key.
+func exclusionKeyValue(colType sql.Type, value any) string {
+	if value == nil {
+		return "null"
+	}
+	if doltgresType, ok := colType.(pgtypes.DoltgresType); ok {
+		if str, err := doltgresType.IoOutput(value); err == nil {
+			return str
+		}
+	}
+	return fmt.Sprint(value)
+}
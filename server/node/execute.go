@@ -0,0 +1,101 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// Execute handles the EXECUTE statement. The connection handler owns the prepared statements, so it binds the
+// parameters and executes the statement, therefore this node only exists to carry the name and parameters to the
+// handler.
+type Execute struct {
+	name       string
+	parameters []string
+}
+
+var _ sql.ExecSourceRel = (*Execute)(nil)
+var _ vitess.Injectable = (*Execute)(nil)
+
+// NewExecute returns a new *Execute. The parameters are the expressions given for each parameter, in their string form.
+func NewExecute(name string, parameters []string) *Execute {
+	return &Execute{
+		name:       name,
+		parameters: parameters,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (e *Execute) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// The prepared statement will check its own privileges
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (e *Execute) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (e *Execute) IsReadOnly() bool {
+	return false
+}
+
+// Name returns the name of the prepared statement to execute.
+func (e *Execute) Name() string {
+	return e.name
+}
+
+// Parameters returns the expressions that were given for each parameter, in their string form.
+func (e *Execute) Parameters() []string {
+	return e.parameters
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (e *Execute) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (e *Execute) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	return nil, fmt.Errorf("EXECUTE is only supported using the simple query protocol")
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (e *Execute) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (e *Execute) String() string {
+	return "EXECUTE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (e *Execute) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(e, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (e *Execute) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return e, nil
+}
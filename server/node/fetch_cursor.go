@@ -0,0 +1,120 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+)
+
+// FetchCursor handles the FETCH and MOVE statements. Cursors belong to the connection, so the connection handler moves
+// the cursor and sends its rows. This node only exists to carry the movement to the handler.
+type FetchCursor struct {
+	name      string
+	fetchType tree.FetchType
+	count     int64
+	isMove    bool
+}
+
+var _ sql.ExecSourceRel = (*FetchCursor)(nil)
+var _ vitess.Injectable = (*FetchCursor)(nil)
+
+// NewFetchCursor returns a new *FetchCursor. |isMove| is set for MOVE statements, which move the cursor without
+// returning any rows.
+func NewFetchCursor(name string, fetchType tree.FetchType, count int64, isMove bool) *FetchCursor {
+	return &FetchCursor{
+		name:      name,
+		fetchType: fetchType,
+		count:     count,
+		isMove:    isMove,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (f *FetchCursor) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// The cursor's query checked its privileges when the cursor was declared
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (f *FetchCursor) Children() []sql.Node {
+	return nil
+}
+
+// Count returns the count of the movement, whose meaning depends on the fetch type.
+func (f *FetchCursor) Count() int64 {
+	return f.count
+}
+
+// FetchType returns the kind of movement.
+func (f *FetchCursor) FetchType() tree.FetchType {
+	return f.fetchType
+}
+
+// IsMove returns whether this is a MOVE statement rather than a FETCH statement.
+func (f *FetchCursor) IsMove() bool {
+	return f.isMove
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (f *FetchCursor) IsReadOnly() bool {
+	return true
+}
+
+// Name returns the name of the cursor.
+func (f *FetchCursor) Name() string {
+	return f.name
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (f *FetchCursor) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (f *FetchCursor) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	return nil, fmt.Errorf("%s is only supported as a top-level statement", f.String())
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (f *FetchCursor) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (f *FetchCursor) String() string {
+	if f.isMove {
+		return "MOVE"
+	}
+	return "FETCH"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (f *FetchCursor) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(f, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (f *FetchCursor) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return f, nil
+}
@@ -0,0 +1,328 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/functions"
+	"github.com/dolthub/doltgresql/postgres/messages"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/privilege"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/notices"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// PrivilegeTarget is the set of objects that GRANT and REVOKE apply to.
+type PrivilegeTarget struct {
+	Kind auth.ObjectKind
+	// Tables are the tables that are named directly. A table without a schema is resolved using the search path.
+	Tables []doltdb.TableName
+	// AllTablesInSchemas are the schemas whose tables are all targeted.
+	AllTablesInSchemas []string
+	// AllRoutinesInSchemas are the schemas whose functions and procedures are all targeted.
+	AllRoutinesInSchemas []string
+	// Names are the names of the targeted schemas, databases, or routines. Routines are found in the current schema.
+	Names []string
+}
+
+// Grant handles the GRANT statement for privileges on objects.
+type Grant struct {
+	target          PrivilegeTarget
+	privileges      privilege.List
+	grantees        []string
+	withGrantOption bool
+	grantedBy       string
+}
+
+var _ sql.ExecSourceRel = (*Grant)(nil)
+var _ vitess.Injectable = (*Grant)(nil)
+
+// NewGrant returns a new *Grant. The grantor is the current role when grantedBy is empty.
+func NewGrant(target PrivilegeTarget, privileges privilege.List, grantees []string, withGrantOption bool, grantedBy string) *Grant {
+	return &Grant{
+		target:          target,
+		privileges:      privileges,
+		grantees:        grantees,
+		withGrantOption: withGrantOption,
+		grantedBy:       grantedBy,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (g *Grant) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (g *Grant) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (g *Grant) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (g *Grant) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (g *Grant) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if err := g.execute(ctx); err != nil {
+		return nil, pgerrors.Raise(ctx, err)
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (g *Grant) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (g *Grant) String() string {
+	return "GRANT"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (g *Grant) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(g, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (g *Grant) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return g, nil
+}
+
+// execute grants the privileges.
+func (g *Grant) execute(ctx *sql.Context) error {
+	grantor, err := resolveGrantor(ctx, g.grantedBy)
+	if err != nil {
+		return err
+	}
+	privileges, allPrivileges, err := resolvePrivileges(g.target.Kind, g.privileges)
+	if err != nil {
+		return err
+	}
+	grantees, err := resolveGrantees(ctx, g.grantees)
+	if err != nil {
+		return err
+	}
+	if g.withGrantOption {
+		for _, grantee := range grantees {
+			if grantee == auth.PublicRole {
+				return pgerrors.New(pgcode.InvalidGrantOperation, "grant options can only be granted to roles")
+			}
+		}
+	}
+	objects, err := g.target.resolve(ctx)
+	if err != nil {
+		return err
+	}
+	for _, obj := range objects {
+		granted, err := auth.GrantPrivileges(obj, grantor, grantees, privileges, g.withGrantOption)
+		if err != nil {
+			return err
+		}
+		if granted == 0 {
+			if !auth.HasAnyPrivilege(grantor, obj) {
+				return auth.PermissionDeniedError(obj)
+			}
+			raiseWarning(ctx, pgcode.WarningPrivilegeNotGranted, fmt.Sprintf(`no privileges were granted for "%s"`, obj.Name))
+		} else if !allPrivileges && granted != privileges {
+			raiseWarning(ctx, pgcode.WarningPrivilegeNotGranted, fmt.Sprintf(`not all privileges were granted for "%s"`, obj.Name))
+		}
+	}
+	return nil
+}
+
+// resolve returns every object that the target refers to, returning an error if any of them do not exist.
+func (t PrivilegeTarget) resolve(ctx *sql.Context) ([]auth.Object, error) {
+	database := ctx.GetCurrentDatabase()
+	var objects []auth.Object
+	switch t.Kind {
+	case auth.ObjectKind_Table:
+		for _, tableName := range t.Tables {
+			resolvedName, ok, err := core.ResolveTableName(ctx, database, tableName)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				return nil, pgerrors.Newf(pgcode.UndefinedTable, `relation "%s" does not exist`, tableName.Name)
+			}
+			objects = append(objects, auth.TableObject(database, resolvedName.Schema, resolvedName.Name))
+		}
+		for _, schemaName := range t.AllTablesInSchemas {
+			if err := checkSchemaExists(ctx, schemaName); err != nil {
+				return nil, err
+			}
+			tableNames, err := core.GetTableNamesFromContext(ctx, schemaName)
+			if err != nil {
+				return nil, err
+			}
+			for _, tableName := range tableNames {
+				if !doltdb.HasDoltPrefix(tableName) {
+					objects = append(objects, auth.TableObject(database, schemaName, tableName))
+				}
+			}
+		}
+	case auth.ObjectKind_Schema:
+		for _, schemaName := range t.Names {
+			if err := checkSchemaExists(ctx, schemaName); err != nil {
+				return nil, err
+			}
+			objects = append(objects, auth.SchemaObject(database, schemaName))
+		}
+	case auth.ObjectKind_Function:
+		return resolveRoutines(ctx, t.Names, t.AllRoutinesInSchemas)
+	case auth.ObjectKind_Database:
+		provider := dsess.DSessFromSess(ctx.Session).Provider()
+		for _, databaseName := range t.Names {
+			if !provider.HasDatabase(ctx, databaseName) {
+				return nil, pgerrors.Newf(pgcode.InvalidCatalogName, `database "%s" does not exist`, databaseName)
+			}
+			objects = append(objects, auth.DatabaseObject(databaseName))
+		}
+	}
+	return objects, nil
+}
+
+// resolveRoutines returns the objects for the named functions and procedures, which are found in the current schema,
+// along with every function and procedure in the given schemas.
+func resolveRoutines(ctx *sql.Context, names []string, schemaNames []string) ([]auth.Object, error) {
+	database := ctx.GetCurrentDatabase()
+	collection, err := core.GetFunctionsCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var objects []auth.Object
+	if len(names) > 0 {
+		currentSchema, err := core.GetCurrentSchema(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			if !collection.HasFunction(doltdb.TableName{Name: name, Schema: currentSchema}) {
+				return nil, pgerrors.Newf(pgcode.UndefinedFunction, `function %s does not exist`, name)
+			}
+			objects = append(objects, auth.FunctionObject(database, currentSchema, name))
+		}
+	}
+	for _, schemaName := range schemaNames {
+		if err = checkSchemaExists(ctx, schemaName); err != nil {
+			return nil, err
+		}
+		err = collection.IterateFunctions(func(schema string, function *functions.Function) error {
+			if schema == schemaName {
+				objects = append(objects, auth.FunctionObject(database, schema, function.Name))
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return objects, nil
+}
+
+// checkSchemaExists returns an error if the schema does not exist in the current database.
+func checkSchemaExists(ctx *sql.Context, schemaName string) error {
+	schemaNames, err := core.GetSchemaNamesFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	for _, name := range schemaNames {
+		if name == schemaName {
+			return nil
+		}
+	}
+	return pgerrors.Newf(pgcode.InvalidSchemaName, `schema "%s" does not exist`, schemaName)
+}
+
+// resolveGrantor returns the role that grants or revokes privileges, which must be the current role.
+func resolveGrantor(ctx *sql.Context, grantedBy string) (string, error) {
+	current := currentRole(ctx).Name
+	if len(grantedBy) > 0 && grantedBy != current && !isCurrentRoleName(grantedBy) {
+		return "", pgerrors.New(pgcode.FeatureNotSupported, "grantor must be current user")
+	}
+	return current, nil
+}
+
+// resolvePrivileges returns the given privileges as a set, along with whether ALL was given. Returns an error if a
+// privilege does not apply to the kind of object.
+func resolvePrivileges(kind auth.ObjectKind, privileges privilege.List) (auth.Privileges, bool, error) {
+	valid := kind.Privileges()
+	var resolved auth.Privileges
+	for _, p := range privileges {
+		if p == privilege.ALL {
+			return valid, true, nil
+		}
+		if !valid.Has(p) {
+			return 0, false, pgerrors.Newf(pgcode.InvalidGrantOperation, "invalid privilege type %s for %s", p, kind)
+		}
+		resolved |= auth.NewPrivileges(p)
+	}
+	return resolved, false, nil
+}
+
+// resolveGrantees returns the names of the given grantees, returning an error if a grantee does not exist.
+func resolveGrantees(ctx *sql.Context, grantees []string) ([]string, error) {
+	resolved := make([]string, len(grantees))
+	for i, grantee := range grantees {
+		if isCurrentRoleName(grantee) {
+			grantee = currentRole(ctx).Name
+		} else if grantee != auth.PublicRole {
+			if _, ok := auth.GetRole(grantee); !ok {
+				return nil, pgerrors.Newf(pgcode.UndefinedObject, `role "%s" does not exist`, grantee)
+			}
+		}
+		resolved[i] = grantee
+	}
+	return resolved, nil
+}
+
+// isCurrentRoleName returns whether the name is one of the keywords that refer to the current role.
+func isCurrentRoleName(name string) bool {
+	switch strings.ToLower(name) {
+	case "current_user", "current_role", "session_user":
+		return true
+	default:
+		return false
+	}
+}
+
+// raiseWarning sends the client a warning with the given code.
+func raiseWarning(ctx *sql.Context, code pgcode.Code, message string) {
+	notices.Raise(ctx, notices.Notice{
+		Severity:     messages.ErrorResponseSeverity_Warning,
+		SqlStateCode: code.String(),
+		Message:      message,
+	})
+}
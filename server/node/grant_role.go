@@ -0,0 +1,179 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/notices"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// GrantRole handles the GRANT statement for role memberships.
+type GrantRole struct {
+	roles     []string
+	members   []string
+	options   auth.MembershipOptions
+	grantedBy string
+}
+
+var _ sql.ExecSourceRel = (*GrantRole)(nil)
+var _ vitess.Injectable = (*GrantRole)(nil)
+
+// NewGrantRole returns a new *GrantRole. The grantor is the current role when grantedBy is empty.
+func NewGrantRole(roles []string, members []string, options auth.MembershipOptions, grantedBy string) *GrantRole {
+	return &GrantRole{
+		roles:     roles,
+		members:   members,
+		options:   options,
+		grantedBy: grantedBy,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (g *GrantRole) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (g *GrantRole) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (g *GrantRole) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (g *GrantRole) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (g *GrantRole) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if err := g.execute(ctx); err != nil {
+		return nil, pgerrors.Raise(ctx, err)
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (g *GrantRole) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (g *GrantRole) String() string {
+	return "GRANT ROLE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (g *GrantRole) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(g, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (g *GrantRole) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return g, nil
+}
+
+// execute grants the memberships.
+func (g *GrantRole) execute(ctx *sql.Context) error {
+	grantor, err := resolveMembershipGrantor(ctx, g.grantedBy)
+	if err != nil {
+		return err
+	}
+	members, err := resolveMembers(ctx, g.members)
+	if err != nil {
+		return err
+	}
+	for _, role := range g.roles {
+		if err = checkCanManageMembership(ctx, "grant", role); err != nil {
+			return err
+		}
+		for _, member := range members {
+			granted, err := auth.GrantMembership(role, member, grantor, g.options)
+			if err != nil {
+				return err
+			}
+			if !granted {
+				notices.RaiseNotice(ctx, fmt.Sprintf(`role "%s" has already been granted membership in role "%s" by role "%s"`,
+					member, role, grantor))
+			}
+		}
+	}
+	return nil
+}
+
+// resolveMembershipGrantor returns the role that is recorded as granting or revoking memberships, which is the
+// bootstrap role when the current role is a superuser, matching Postgres.
+func resolveMembershipGrantor(ctx *sql.Context, grantedBy string) (string, error) {
+	grantor, err := resolveGrantor(ctx, grantedBy)
+	if err != nil {
+		return "", err
+	}
+	if currentRole(ctx).IsSuperUser {
+		return auth.BootstrapRole, nil
+	}
+	return grantor, nil
+}
+
+// resolveMembers returns the names of the given members, returning an error if a member does not exist. Unlike
+// privileges, memberships cannot be granted to PUBLIC.
+func resolveMembers(ctx *sql.Context, members []string) ([]string, error) {
+	resolved := make([]string, len(members))
+	for i, member := range members {
+		if isCurrentRoleName(member) {
+			member = currentRole(ctx).Name
+		} else if _, ok := auth.GetRole(member); !ok {
+			return nil, pgerrors.Newf(pgcode.UndefinedObject, `role "%s" does not exist`, member)
+		}
+		resolved[i] = member
+	}
+	return resolved, nil
+}
+
+// checkCanManageMembership returns an error if the current role may not grant or revoke membership in the role, with
+// the action being either "grant" or "revoke". Only superusers may manage membership in superusers, and other roles
+// must hold the admin option on the role.
+func checkCanManageMembership(ctx *sql.Context, action string, roleName string) error {
+	role, ok := auth.GetRole(roleName)
+	if !ok {
+		return pgerrors.Newf(pgcode.UndefinedObject, `role "%s" does not exist`, roleName)
+	}
+	current := currentRole(ctx)
+	if current.IsSuperUser {
+		return nil
+	}
+	if role.IsSuperUser {
+		return pgerrors.Newf(pgcode.InsufficientPrivilege, `permission denied to %s role "%s"`, action, roleName).
+			WithDetail(fmt.Sprintf("Only roles with the SUPERUSER attribute may %s roles with the SUPERUSER attribute.", action))
+	}
+	if !auth.HasAdminOption(current.Name, roleName) {
+		return pgerrors.Newf(pgcode.InsufficientPrivilege, `permission denied to %s role "%s"`, action, roleName).
+			WithDetail(fmt.Sprintf(`Only roles with the ADMIN option on role "%s" may %s this role.`, roleName, action))
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/server/notifications"
+	"github.com/dolthub/doltgresql/server/telemetry"
+)
+
+// Listen handles the LISTEN statement.
+type Listen struct {
+	channel string
+}
+
+var _ sql.ExecSourceRel = (*Listen)(nil)
+var _ vitess.Injectable = (*Listen)(nil)
+
+// NewListen returns a new *Listen.
+func NewListen(channel string) *Listen {
+	return &Listen{channel: channel}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (l *Listen) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (l *Listen) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (l *Listen) IsReadOnly() bool {
+	return true
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (l *Listen) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (l *Listen) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if err := notifications.Listen(ctx.Session.ID(), l.channel); err != nil {
+		return nil, err
+	}
+	telemetry.UseFeature(telemetry.FeatureListen)
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (l *Listen) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (l *Listen) String() string {
+	return "LISTEN"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (l *Listen) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(l, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (l *Listen) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return l, nil
+}
@@ -0,0 +1,219 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/rowexec"
+
+	"github.com/dolthub/doltgresql/postgres/parser/privilege"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/locks"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// LockRows locks the rows of the tables named by the locking clauses of a SELECT statement, such as FOR UPDATE, as they
+// are read. The locks are held until the end of the transaction, and only conflict with the row locks of other sessions.
+// Rows that were changed by a transaction that committed while waiting on its lock are still read from the transaction's
+// own snapshot, as Dolt detects conflicting writes once the transaction commits.
+type LockRows struct {
+	child   sql.Node
+	targets []RowLockTarget
+}
+
+// RowLockTarget is a table whose rows are locked by a LockRows node.
+type RowLockTarget struct {
+	Relation locks.Relation
+	// Key holds the expressions that identify a row of the table, which are its primary key columns, or all of its
+	// columns when it does not have a primary key.
+	Key    []sql.Expression
+	Mode   locks.RowMode
+	Policy locks.WaitPolicy
+}
+
+var _ sql.ExecSourceRel = (*LockRows)(nil)
+var _ sql.Expressioner = (*LockRows)(nil)
+
+// NewLockRows returns a new *LockRows.
+func NewLockRows(child sql.Node, targets []RowLockTarget) *LockRows {
+	return &LockRows{
+		child:   child,
+		targets: targets,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (l *LockRows) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return l.child.CheckPrivileges(ctx, opChecker)
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (l *LockRows) Children() []sql.Node {
+	return []sql.Node{l.child}
+}
+
+// Expressions implements the interface sql.Expressioner.
+func (l *LockRows) Expressions() []sql.Expression {
+	var exprs []sql.Expression
+	for _, target := range l.targets {
+		exprs = append(exprs, target.Key...)
+	}
+	return exprs
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (l *LockRows) IsReadOnly() bool {
+	return l.child.IsReadOnly()
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (l *LockRows) Resolved() bool {
+	for _, expr := range l.Expressions() {
+		if !expr.Resolved() {
+			return false
+		}
+	}
+	return l.child.Resolved()
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (l *LockRows) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	// Every locking clause requires UPDATE on its tables, and takes a ROW SHARE lock on them
+	role := currentRole(ctx).Name
+	for _, target := range l.targets {
+		obj := auth.TableObject(target.Relation.Database, target.Relation.Schema, target.Relation.Name)
+		if !auth.HasPrivilege(role, obj, privilege.UPDATE) {
+			return nil, pgerrors.Raise(ctx, auth.PermissionDeniedError(obj))
+		}
+		if err := locks.LockRelation(ctx, ctx.Session.ID(), target.Relation, locks.RowShare, false, locks.Timeout(ctx)); err != nil {
+			return nil, err
+		}
+	}
+	childIter, err := rowexec.DefaultBuilder.Build(ctx, l.child, r)
+	if err != nil {
+		return nil, err
+	}
+	return &lockRowsIter{
+		targets:   l.targets,
+		childIter: childIter,
+		timeout:   locks.Timeout(ctx),
+	}, nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (l *LockRows) Schema() sql.Schema {
+	return l.child.Schema()
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (l *LockRows) String() string {
+	targets := make([]string, len(l.targets))
+	for i, target := range l.targets {
+		targets[i] = target.Mode.String() + " OF " + target.Relation.Name
+	}
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("LockRows(%s)", strings.Join(targets, ", "))
+	_ = pr.WriteChildren(l.child.String())
+	return pr.String()
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (l *LockRows) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(l, len(children), 1)
+	}
+	nl := *l
+	nl.child = children[0]
+	return &nl, nil
+}
+
+// WithExpressions implements the interface sql.Expressioner.
+func (l *LockRows) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != len(l.Expressions()) {
+		return nil, sql.ErrInvalidChildrenNumber.New(l, len(exprs), len(l.Expressions()))
+	}
+	nl := *l
+	nl.targets = make([]RowLockTarget, len(l.targets))
+	for i, target := range l.targets {
+		target.Key = exprs[:len(target.Key):len(target.Key)]
+		exprs = exprs[len(target.Key):]
+		nl.targets[i] = target
+	}
+	return &nl, nil
+}
+
+// lockRowsIter is the iterator for *LockRows.
+type lockRowsIter struct {
+	targets   []RowLockTarget
+	childIter sql.RowIter
+	timeout   time.Duration
+}
+
+var _ sql.RowIter = (*lockRowsIter)(nil)
+
+// Next implements the interface sql.RowIter.
+func (iter *lockRowsIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for {
+		row, err := iter.childIter.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		locked, err := iter.lockRow(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if locked {
+			return row, nil
+		}
+	}
+}
+
+// lockRow locks the row of each target table that the given row was read from. Returns false if any of them is locked
+// by another session and should be skipped.
+func (iter *lockRowsIter) lockRow(ctx *sql.Context, row sql.Row) (bool, error) {
+	for _, target := range iter.targets {
+		key := make(sql.Row, len(target.Key))
+		hasValue := false
+		for i, expr := range target.Key {
+			val, err := expr.Eval(ctx, row)
+			if err != nil {
+				return false, err
+			}
+			key[i] = val
+			hasValue = hasValue || val != nil
+		}
+		// The table does not have a row here when it's on the nullable side of an outer join
+		if !hasValue {
+			continue
+		}
+		hash, err := sql.HashOf(key)
+		if err != nil {
+			return false, err
+		}
+		locked, err := locks.LockRow(ctx, ctx.Session.ID(), target.Relation, hash, target.Mode, target.Policy, iter.timeout)
+		if err != nil || !locked {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// Close implements the interface sql.RowIter.
+func (iter *lockRowsIter) Close(ctx *sql.Context) error {
+	return iter.childIter.Close(ctx)
+}
@@ -0,0 +1,146 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/privilege"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/locks"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// LockTable handles the LOCK TABLE statement, which acquires a table-level lock on each of its tables that is held
+// until the end of the transaction.
+type LockTable struct {
+	tables []doltdb.TableName
+	mode   locks.Mode
+	nowait bool
+}
+
+var _ sql.ExecSourceRel = (*LockTable)(nil)
+var _ vitess.Injectable = (*LockTable)(nil)
+
+// NewLockTable returns a new *LockTable. Tables without a schema are resolved using the search path.
+func NewLockTable(tables []doltdb.TableName, mode locks.Mode, nowait bool) *LockTable {
+	return &LockTable{
+		tables: tables,
+		mode:   mode,
+		nowait: nowait,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (l *LockTable) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// The privileges depend on the lock mode, which is checked in RowIter once the tables have been resolved
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (l *LockTable) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (l *LockTable) IsReadOnly() bool {
+	return true
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (l *LockTable) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (l *LockTable) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	// An explicit transaction causes autocommit to be ignored, which is how we determine that we're in a block
+	if !ctx.GetIgnoreAutoCommit() {
+		return nil, pgerrors.Raise(ctx, pgerrors.New(pgcode.NoActiveSQLTransaction,
+			"LOCK TABLE can only be used in transaction blocks"))
+	}
+	database := ctx.GetCurrentDatabase()
+	role := currentRole(ctx).Name
+	// Every table is resolved and checked before any of them are locked
+	relations := make([]locks.Relation, len(l.tables))
+	for i, table := range l.tables {
+		tableName, ok, err := core.ResolveTableName(ctx, database, table)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, pgerrors.Raise(ctx, pgerrors.Newf(pgcode.UndefinedTable, `relation "%s" does not exist`, table.Name))
+		}
+		obj := auth.TableObject(database, tableName.Schema, tableName.Name)
+		if !hasLockPrivilege(role, obj, l.mode) {
+			return nil, pgerrors.Raise(ctx, auth.PermissionDeniedError(obj))
+		}
+		relations[i] = locks.Relation{Database: database, Schema: tableName.Schema, Name: tableName.Name}
+	}
+	for _, relation := range relations {
+		if err := locks.LockRelation(ctx, ctx.Session.ID(), relation, l.mode, l.nowait, locks.Timeout(ctx)); err != nil {
+			return nil, err
+		}
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// hasLockPrivilege returns whether the role may lock the table in the given mode. ACCESS SHARE only requires SELECT,
+// ROW EXCLUSIVE also allows INSERT, and every mode is allowed by UPDATE, DELETE, or TRUNCATE.
+func hasLockPrivilege(role string, obj auth.Object, mode locks.Mode) bool {
+	kinds := []privilege.Kind{privilege.UPDATE, privilege.DELETE, privilege.TRUNCATE}
+	switch mode {
+	case locks.AccessShare:
+		kinds = append(kinds, privilege.SELECT)
+	case locks.RowExclusive:
+		kinds = append(kinds, privilege.INSERT)
+	}
+	for _, kind := range kinds {
+		if auth.HasPrivilege(role, obj, kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (l *LockTable) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (l *LockTable) String() string {
+	return "LOCK TABLE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (l *LockTable) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(l, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (l *LockTable) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return l, nil
+}
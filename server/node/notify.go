@@ -0,0 +1,93 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/server/notifications"
+)
+
+// Notify handles the NOTIFY statement. The notification is not sent until the transaction commits.
+type Notify struct {
+	channel string
+	payload string
+}
+
+var _ sql.ExecSourceRel = (*Notify)(nil)
+var _ vitess.Injectable = (*Notify)(nil)
+
+// NewNotify returns a new *Notify.
+func NewNotify(channel string, payload string) *Notify {
+	return &Notify{
+		channel: channel,
+		payload: payload,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (n *Notify) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (n *Notify) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (n *Notify) IsReadOnly() bool {
+	return true
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (n *Notify) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (n *Notify) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	if err := notifications.Notify(ctx.Session.ID(), n.channel, n.payload); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (n *Notify) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (n *Notify) String() string {
+	return "NOTIFY"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (n *Notify) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(n, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (n *Notify) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return n, nil
+}
@@ -0,0 +1,163 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/rowexec"
+
+	"github.com/dolthub/doltgresql/server/auth"
+)
+
+// ObjectOwnership is a node that records the owners of the objects that its child creates, and removes the owners and
+// privileges of the objects that its child drops. The changes are only recorded once the child has finished without
+// an error.
+type ObjectOwnership struct {
+	child   sql.Node
+	created []auth.Object
+	dropped []auth.Object
+}
+
+var _ sql.ExecSourceRel = (*ObjectOwnership)(nil)
+var _ sql.Expressioner = (*ObjectOwnership)(nil)
+
+// NewObjectOwnership returns a new *ObjectOwnership.
+func NewObjectOwnership(child sql.Node, created []auth.Object, dropped []auth.Object) *ObjectOwnership {
+	return &ObjectOwnership{
+		child:   child,
+		created: created,
+		dropped: dropped,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (oo *ObjectOwnership) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return oo.child.CheckPrivileges(ctx, opChecker)
+}
+
+// Child returns the child of the node.
+func (oo *ObjectOwnership) Child() sql.Node {
+	return oo.child
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (oo *ObjectOwnership) Children() []sql.Node {
+	return oo.child.Children()
+}
+
+// Expressions implements the interface sql.Expressioner.
+func (oo *ObjectOwnership) Expressions() []sql.Expression {
+	if expressioner, ok := oo.child.(sql.Expressioner); ok {
+		return expressioner.Expressions()
+	}
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (oo *ObjectOwnership) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (oo *ObjectOwnership) Resolved() bool {
+	return oo.child.Resolved()
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (oo *ObjectOwnership) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	childIter, err := rowexec.DefaultBuilder.Build(ctx, oo.child, r)
+	if err != nil {
+		return nil, err
+	}
+	return &objectOwnershipIter{node: oo, childIter: childIter}, nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (oo *ObjectOwnership) Schema() sql.Schema {
+	return oo.child.Schema()
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (oo *ObjectOwnership) String() string {
+	return oo.child.String()
+}
+
+// DebugString implements the interface sql.DebugStringer.
+func (oo *ObjectOwnership) DebugString() string {
+	return sql.DebugString(oo.child)
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (oo *ObjectOwnership) WithChildren(children ...sql.Node) (sql.Node, error) {
+	newChild, err := oo.child.WithChildren(children...)
+	if err != nil {
+		return nil, err
+	}
+	return NewObjectOwnership(newChild, oo.created, oo.dropped), nil
+}
+
+// WithExpressions implements the interface sql.Expressioner.
+func (oo *ObjectOwnership) WithExpressions(expressions ...sql.Expression) (sql.Node, error) {
+	if expressioner, ok := oo.child.(sql.Expressioner); ok {
+		newExpressioner, err := expressioner.WithExpressions(expressions...)
+		if err != nil {
+			return nil, err
+		}
+		return NewObjectOwnership(newExpressioner, oo.created, oo.dropped), nil
+	}
+	if len(expressions) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(oo, len(expressions), 0)
+	}
+	return oo, nil
+}
+
+// objectOwnershipIter is the iterator for *ObjectOwnership that records the changes once its child has closed.
+type objectOwnershipIter struct {
+	node      *ObjectOwnership
+	childIter sql.RowIter
+	failed    bool
+}
+
+var _ sql.RowIter = (*objectOwnershipIter)(nil)
+
+// Next implements the interface sql.RowIter.
+func (o *objectOwnershipIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := o.childIter.Next(ctx)
+	if err != nil && err != io.EOF {
+		o.failed = true
+	}
+	return row, err
+}
+
+// Close implements the interface sql.RowIter.
+func (o *objectOwnershipIter) Close(ctx *sql.Context) error {
+	if err := o.childIter.Close(ctx); err != nil || o.failed {
+		return err
+	}
+	for _, obj := range o.node.dropped {
+		if err := auth.DropObject(obj); err != nil {
+			return err
+		}
+	}
+	owner := currentRole(ctx).Name
+	for _, obj := range o.node.created {
+		if err := auth.CreateObject(obj, owner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
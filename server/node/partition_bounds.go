@@ -0,0 +1,840 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"hash/fnv"
+	"io"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/partitions"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// PartitionKey is the partition key of a partitioned table, resolved against the table's schema so that rows may be
+// matched against the bounds of the table's partitions.
+type PartitionKey struct {
+	Table   *partitions.Table
+	schema  sql.Schema
+	indexes []int
+	types   []pgtypes.DoltgresType
+}
+
+// PartitionBound is the bound of a partition, with its values converted to the types of the partition key.
+type PartitionBound struct {
+	Partition *partitions.Partition
+	from      []boundValue
+	to        []boundValue
+	values    []boundValue
+}
+
+// boundValue is a value within a partition bound. The value is nil unless the kind is a literal.
+type boundValue struct {
+	kind  partitions.ValueKind
+	value any
+}
+
+// NewPartitionKey returns the partition key of the given table, whose columns are found within the given schema.
+func NewPartitionKey(table *partitions.Table, sch sql.Schema) (*PartitionKey, error) {
+	key := &PartitionKey{
+		Table:   table,
+		schema:  sch,
+		indexes: make([]int, len(table.Columns)),
+		types:   make([]pgtypes.DoltgresType, len(table.Columns)),
+	}
+	for i, column := range table.Columns {
+		key.indexes[i] = sch.IndexOfColName(column)
+		if key.indexes[i] == -1 {
+			return nil, pgerrors.Newf(pgcode.UndefinedColumn, `column "%s" named in partition key does not exist`, column)
+		}
+		var ok bool
+		key.types[i], ok = sch[key.indexes[i]].Type.(pgtypes.DoltgresType)
+		if !ok {
+			return nil, fmt.Errorf(`column "%s" has a type that cannot be used in a partition key`, column)
+		}
+	}
+	return key, nil
+}
+
+// ColumnIndexes returns the indexes of the partition key's columns within the table's schema.
+func (key *PartitionKey) ColumnIndexes() []int {
+	return key.indexes
+}
+
+// ResolveBound converts the values of the given partition's bound to the types of the partition key.
+func (key *PartitionKey) ResolveBound(partition *partitions.Partition) (*PartitionBound, error) {
+	bound := &PartitionBound{Partition: partition}
+	var err error
+	if bound.from, err = key.resolveValues(partition.From, false); err != nil {
+		return nil, err
+	}
+	if bound.to, err = key.resolveValues(partition.To, false); err != nil {
+		return nil, err
+	}
+	if bound.values, err = key.resolveValues(partition.Values, true); err != nil {
+		return nil, err
+	}
+	return bound, nil
+}
+
+// resolveValues converts the given values to the types of the partition key. The values of a list partition all
+// belong to the key's only column, while the values of a range bound each belong to the column at the same position.
+func (key *PartitionKey) resolveValues(values []partitions.Value, isList bool) ([]boundValue, error) {
+	resolved := make([]boundValue, len(values))
+	for i, value := range values {
+		resolved[i].kind = value.Kind
+		if value.Kind != partitions.ValueKind_Literal {
+			continue
+		}
+		typIdx := i
+		if isList {
+			typIdx = 0
+		}
+		if typIdx >= len(key.types) {
+			return nil, pgerrors.New(pgcode.InvalidTableDefinition, "too many values in partition bound")
+		}
+		var err error
+		resolved[i].value, err = key.types[typIdx].IoInput(value.Literal)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resolved, nil
+}
+
+// ValidateBound checks that the bound of the given partition may be used with the partition key, returning the
+// partition with its literal values written in the output format of their column's types. The partition's bound was
+// written for the given strategy.
+func (key *PartitionKey) ValidateBound(partition *partitions.Partition, boundStrategy partitions.Strategy) (*partitions.Partition, error) {
+	if partition.IsDefault {
+		if key.Table.Strategy == partitions.Strategy_Hash {
+			return nil, pgerrors.New(pgcode.InvalidTableDefinition, "a hash-partitioned table may not have a default partition")
+		}
+		return partition, nil
+	}
+	if boundStrategy != key.Table.Strategy {
+		return nil, pgerrors.Newf(pgcode.InvalidTableDefinition, "invalid bound specification for a %s partition",
+			strings.ToLower(key.Table.Strategy.String()))
+	}
+	normalized := *partition
+	switch key.Table.Strategy {
+	case partitions.Strategy_Range:
+		for _, side := range []struct {
+			name   string
+			values *[]partitions.Value
+		}{{"FROM", &normalized.From}, {"TO", &normalized.To}} {
+			if len(*side.values) != len(key.types) {
+				return nil, pgerrors.Newf(pgcode.InvalidTableDefinition, "%s must specify exactly one value per partitioning column", side.name)
+			}
+			var infinite partitions.ValueKind
+			for _, value := range *side.values {
+				switch value.Kind {
+				case partitions.ValueKind_Null:
+					return nil, pgerrors.New(pgcode.InvalidTableDefinition, "cannot specify NULL in range bound")
+				case partitions.ValueKind_MinValue, partitions.ValueKind_MaxValue:
+					if infinite == 0 {
+						infinite = value.Kind
+					}
+				}
+				if infinite != 0 && value.Kind != infinite {
+					return nil, pgerrors.Newf(pgcode.InvalidTableDefinition, "every bound following %s must also be %s",
+						partitions.Value{Kind: infinite}.String(), partitions.Value{Kind: infinite}.String())
+				}
+			}
+			var err error
+			if *side.values, err = key.normalizeValues(*side.values, false); err != nil {
+				return nil, err
+			}
+		}
+		bound, err := key.ResolveBound(&normalized)
+		if err != nil {
+			return nil, err
+		}
+		if cmp, err := key.compareBounds(bound.from, bound.to); err != nil {
+			return nil, err
+		} else if cmp >= 0 {
+			return nil, pgerrors.Newf(pgcode.InvalidObjectDefinition, `empty range bound specified for partition "%s"`, partition.Name.Name).
+				WithDetail(fmt.Sprintf("Specified lower bound (%s) is greater than or equal to upper bound (%s).",
+					joinBoundValues(normalized.From), joinBoundValues(normalized.To)))
+		}
+	case partitions.Strategy_List:
+		for _, value := range normalized.Values {
+			if value.Kind == partitions.ValueKind_MinValue || value.Kind == partitions.ValueKind_MaxValue {
+				return nil, pgerrors.New(pgcode.InvalidTableDefinition, "cannot use column reference in partition bound expression")
+			}
+		}
+		var err error
+		if normalized.Values, err = key.normalizeValues(normalized.Values, true); err != nil {
+			return nil, err
+		}
+	case partitions.Strategy_Hash:
+		if normalized.Modulus == 0 {
+			return nil, pgerrors.New(pgcode.InvalidParameterValue, "modulus for hash partition must be an integer value greater than zero")
+		}
+		if normalized.Remainder >= normalized.Modulus {
+			return nil, pgerrors.New(pgcode.InvalidParameterValue, "remainder for hash partition must be less than modulus")
+		}
+	}
+	return &normalized, nil
+}
+
+// normalizeValues returns the given values with their literals written in the output format of their column's types.
+func (key *PartitionKey) normalizeValues(values []partitions.Value, isList bool) ([]partitions.Value, error) {
+	resolved, err := key.resolveValues(values, isList)
+	if err != nil {
+		return nil, err
+	}
+	normalized := make([]partitions.Value, len(values))
+	for i, value := range resolved {
+		normalized[i].Kind = value.kind
+		if value.kind != partitions.ValueKind_Literal {
+			continue
+		}
+		typIdx := i
+		if isList {
+			typIdx = 0
+		}
+		normalized[i].Literal, err = key.types[typIdx].IoOutput(value.value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return normalized, nil
+}
+
+// CheckOverlap returns an error if the given bound would overlap any of the existing bounds, or if it would otherwise
+// conflict with them.
+func (key *PartitionKey) CheckOverlap(bound *PartitionBound, existing []*PartitionBound) error {
+	partition := bound.Partition
+	for _, other := range existing {
+		otherPartition := other.Partition
+		if partition.IsDefault || otherPartition.IsDefault {
+			if partition.IsDefault && otherPartition.IsDefault {
+				return pgerrors.Newf(pgcode.InvalidObjectDefinition, `partition "%s" conflicts with existing default partition "%s"`,
+					partition.Name.Name, otherPartition.Name.Name)
+			}
+			continue
+		}
+		overlaps := false
+		switch key.Table.Strategy {
+		case partitions.Strategy_Range:
+			lowerCmp, err := key.compareBounds(bound.from, other.to)
+			if err != nil {
+				return err
+			}
+			upperCmp, err := key.compareBounds(other.from, bound.to)
+			if err != nil {
+				return err
+			}
+			overlaps = lowerCmp < 0 && upperCmp < 0
+		case partitions.Strategy_List:
+			for _, value := range bound.values {
+				contains, err := key.listContains(other, value)
+				if err != nil {
+					return err
+				}
+				if contains {
+					overlaps = true
+					break
+				}
+			}
+		case partitions.Strategy_Hash:
+			smaller, larger := partition.Modulus, otherPartition.Modulus
+			if smaller > larger {
+				smaller, larger = larger, smaller
+			}
+			if larger%smaller != 0 {
+				return pgerrors.New(pgcode.InvalidObjectDefinition, "every hash partition modulus must be a factor of the next larger modulus")
+			}
+			overlaps = partition.Remainder%smaller == otherPartition.Remainder%smaller
+		}
+		if overlaps {
+			return pgerrors.Newf(pgcode.InvalidObjectDefinition, `partition "%s" would overlap partition "%s"`,
+				partition.Name.Name, otherPartition.Name.Name)
+		}
+	}
+	return nil
+}
+
+// Contains returns whether the given row of the partitioned table falls within the given bound. Default partitions do
+// not contain any rows by their bound, as they only hold the rows that no other partition contains.
+func (key *PartitionKey) Contains(ctx *sql.Context, bound *PartitionBound, row sql.Row) (bool, error) {
+	partition := bound.Partition
+	if partition.IsDefault {
+		return false, nil
+	}
+	switch key.Table.Strategy {
+	case partitions.Strategy_Range:
+		keyValues := make([]boundValue, len(key.indexes))
+		for i, idx := range key.indexes {
+			// Range partitions never contain NULL values, so such rows may only go into the default partition
+			if row[idx] == nil {
+				return false, nil
+			}
+			keyValues[i] = boundValue{kind: partitions.ValueKind_Literal, value: row[idx]}
+		}
+		lowerCmp, err := key.compareBounds(keyValues, bound.from)
+		if err != nil || lowerCmp < 0 {
+			return false, err
+		}
+		upperCmp, err := key.compareBounds(keyValues, bound.to)
+		return upperCmp < 0, err
+	case partitions.Strategy_List:
+		value := boundValue{kind: partitions.ValueKind_Literal, value: row[key.indexes[0]]}
+		if value.value == nil {
+			value.kind = partitions.ValueKind_Null
+		}
+		return key.listContains(bound, value)
+	case partitions.Strategy_Hash:
+		hash, err := key.hashRow(row)
+		if err != nil {
+			return false, err
+		}
+		return hash%partition.Modulus == partition.Remainder, nil
+	default:
+		return false, fmt.Errorf("unknown partitioning strategy encountered")
+	}
+}
+
+// Route returns the index of the bound that the given row of the partitioned table belongs to, falling back to the
+// default partition if there is one. Returns -1 if the row does not belong to any partition.
+func (key *PartitionKey) Route(ctx *sql.Context, bounds []*PartitionBound, row sql.Row) (int, error) {
+	defaultIdx := -1
+	for i, bound := range bounds {
+		if bound.Partition.IsDefault {
+			defaultIdx = i
+			continue
+		}
+		contains, err := key.Contains(ctx, bound, row)
+		if err != nil {
+			return -1, err
+		}
+		if contains {
+			return i, nil
+		}
+	}
+	return defaultIdx, nil
+}
+
+// NoPartitionError returns the error for a row of the partitioned table that does not belong to any partition.
+func (key *PartitionKey) NoPartitionError(row sql.Row) error {
+	names := make([]string, len(key.indexes))
+	values := make([]string, len(key.indexes))
+	for i, idx := range key.indexes {
+		names[i] = key.Table.Columns[i]
+		values[i] = "null"
+		if row[idx] != nil {
+			if output, err := key.types[i].IoOutput(row[idx]); err == nil {
+				values[i] = output
+			}
+		}
+	}
+	return pgerrors.Newf(pgcode.CheckViolation, `no partition of relation "%s" found for row`, key.Table.Name.Name).
+		WithDetail(fmt.Sprintf("Partition key of the failing row contains (%s) = (%s).",
+			strings.Join(names, ", "), strings.Join(values, ", ")))
+}
+
+// ConvertValue converts the given text into a value of the type of the partition key's column at the given position.
+func (key *PartitionKey) ConvertValue(column int, text string) (any, error) {
+	return key.types[column].IoInput(text)
+}
+
+// PruneOp is the comparison that a PruneFilter makes against a column of the partition key.
+type PruneOp uint8
+
+const (
+	PruneOp_Equal PruneOp = iota
+	PruneOp_LessThan
+	PruneOp_LessOrEqual
+	PruneOp_GreaterThan
+	PruneOp_GreaterOrEqual
+)
+
+// PruneFilter is a filter from a query that compares a column of the partition key against constant values. Only
+// PruneOp_Equal may have multiple values, which come from an IN list.
+type PruneFilter struct {
+	// Column is the position of the column within the partition key.
+	Column int
+	Op     PruneOp
+	Values []any
+}
+
+// maxPruneCombinations is the largest number of value combinations that Prune will route directly.
+const maxPruneCombinations = 64
+
+// Prune returns whether each of the given bounds may contain rows that match all of the given filters. When every
+// column of the partition key is compared for equality, each combination of values is routed to the bound that would
+// hold it. Otherwise, range and list bounds are excluded when none of their values match a filter, while the default
+// partition and hash partitions are always read.
+func (key *PartitionKey) Prune(ctx *sql.Context, bounds []*PartitionBound, filters []PruneFilter) ([]bool, error) {
+	readable := make([]bool, len(bounds))
+	if rows := key.equalityRows(filters); rows != nil {
+		for _, row := range rows {
+			idx, err := key.Route(ctx, bounds, row)
+			if err != nil {
+				return nil, err
+			}
+			if idx >= 0 {
+				readable[idx] = true
+			}
+		}
+		return readable, nil
+	}
+	for i, bound := range bounds {
+		readable[i] = true
+		if bound.Partition.IsDefault || key.Table.Strategy == partitions.Strategy_Hash {
+			continue
+		}
+		for _, filter := range filters {
+			mayMatch, err := key.mayMatch(bound, filter)
+			if err != nil {
+				return nil, err
+			}
+			if !mayMatch {
+				readable[i] = false
+				break
+			}
+		}
+	}
+	return readable, nil
+}
+
+// equalityRows returns a row for every combination of values from the equality filters, with each row only containing
+// the partition key's values. Returns nil if a column of the key does not have an equality filter, or if there are too
+// many combinations.
+func (key *PartitionKey) equalityRows(filters []PruneFilter) []sql.Row {
+	columnValues := make([][]any, len(key.indexes))
+	combinations := 1
+	for column := range columnValues {
+		for _, filter := range filters {
+			// Rows must match every filter, so any single equality filter on the column bounds the possible values
+			if filter.Column == column && filter.Op == PruneOp_Equal {
+				columnValues[column] = filter.Values
+				break
+			}
+		}
+		combinations *= len(columnValues[column])
+		if combinations == 0 || combinations > maxPruneCombinations {
+			return nil
+		}
+	}
+	rows := []sql.Row{make(sql.Row, len(key.schema))}
+	for column, values := range columnValues {
+		var newRows []sql.Row
+		for _, row := range rows {
+			for _, value := range values {
+				newRow := row.Copy()
+				newRow[key.indexes[column]] = value
+				newRows = append(newRows, newRow)
+			}
+		}
+		rows = newRows
+	}
+	return rows
+}
+
+// mayMatch returns whether the given range or list bound may contain rows that match the given filter.
+func (key *PartitionKey) mayMatch(bound *PartitionBound, filter PruneFilter) (bool, error) {
+	switch key.Table.Strategy {
+	case partitions.Strategy_List:
+		for _, boundValue := range bound.values {
+			// NULL values never match a comparison
+			if boundValue.kind != partitions.ValueKind_Literal {
+				continue
+			}
+			for _, value := range filter.Values {
+				cmp, err := key.types[0].Compare(boundValue.value, value)
+				if err != nil {
+					return false, err
+				}
+				if pruneOpMatches(filter.Op, cmp) {
+					return true, nil
+				}
+			}
+		}
+		return false, nil
+	case partitions.Strategy_Range:
+		// Only the first column is checked, as the later columns are only compared when the earlier columns are equal
+		if filter.Column != 0 {
+			return true, nil
+		}
+		// With multiple columns, the first column of a row may equal the first column of the upper bound
+		upperInclusive := len(key.types) > 1
+		for _, value := range filter.Values {
+			lowerCmp, err := key.compareBoundValue(bound.from[0], value)
+			if err != nil {
+				return false, err
+			}
+			upperCmp, err := key.compareBoundValue(bound.to[0], value)
+			if err != nil {
+				return false, err
+			}
+			aboveLower := lowerCmp <= 0
+			belowUpper := upperCmp > 0 || (upperInclusive && upperCmp == 0)
+			var mayMatch bool
+			switch filter.Op {
+			case PruneOp_Equal, PruneOp_GreaterOrEqual:
+				mayMatch = belowUpper && (filter.Op != PruneOp_Equal || aboveLower)
+			case PruneOp_GreaterThan:
+				mayMatch = upperCmp > 0
+			case PruneOp_LessThan:
+				mayMatch = lowerCmp < 0
+			case PruneOp_LessOrEqual:
+				mayMatch = aboveLower
+			}
+			if mayMatch {
+				return true, nil
+			}
+		}
+		return false, nil
+	default:
+		return true, nil
+	}
+}
+
+// compareBoundValue compares a value from a range bound against a value of the partition key's first column. MINVALUE
+// is less than every value, and MAXVALUE is greater than every value.
+func (key *PartitionKey) compareBoundValue(boundVal boundValue, value any) (int, error) {
+	switch boundVal.kind {
+	case partitions.ValueKind_MinValue:
+		return -1, nil
+	case partitions.ValueKind_MaxValue:
+		return 1, nil
+	default:
+		return key.types[0].Compare(boundVal.value, value)
+	}
+}
+
+// pruneOpMatches returns whether the result of comparing a bound's value against a filter's value satisfies the
+// filter.
+func pruneOpMatches(op PruneOp, cmp int) bool {
+	switch op {
+	case PruneOp_Equal:
+		return cmp == 0
+	case PruneOp_LessThan:
+		return cmp < 0
+	case PruneOp_LessOrEqual:
+		return cmp <= 0
+	case PruneOp_GreaterThan:
+		return cmp > 0
+	case PruneOp_GreaterOrEqual:
+		return cmp >= 0
+	default:
+		return true
+	}
+}
+
+// listContains returns whether the given list bound contains the given value.
+func (key *PartitionKey) listContains(bound *PartitionBound, value boundValue) (bool, error) {
+	for _, boundVal := range bound.values {
+		if boundVal.kind != value.kind {
+			continue
+		}
+		if value.kind == partitions.ValueKind_Null {
+			return true, nil
+		}
+		cmp, err := key.types[0].Compare(boundVal.value, value.value)
+		if err != nil {
+			return false, err
+		}
+		if cmp == 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// compareBounds compares two range bounds, or a row's key values against a range bound. MINVALUE is less than every
+// value and MAXVALUE is greater than every value, and every value following either of them is ignored.
+func (key *PartitionKey) compareBounds(left []boundValue, right []boundValue) (int, error) {
+	for i := range left {
+		if i >= len(right) {
+			break
+		}
+		leftRank, rightRank := boundRank(left[i].kind), boundRank(right[i].kind)
+		if leftRank != 0 || rightRank != 0 {
+			if leftRank < rightRank {
+				return -1, nil
+			} else if leftRank > rightRank {
+				return 1, nil
+			}
+			return 0, nil
+		}
+		cmp, err := key.types[i].Compare(left[i].value, right[i].value)
+		if err != nil || cmp != 0 {
+			return cmp, err
+		}
+	}
+	return 0, nil
+}
+
+// boundRank returns -1 for MINVALUE, 1 for MAXVALUE, and 0 for all other values.
+func boundRank(kind partitions.ValueKind) int {
+	switch kind {
+	case partitions.ValueKind_MinValue:
+		return -1
+	case partitions.ValueKind_MaxValue:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// hashRow returns the hash of the partition key's values within the given row. NULL values do not contribute to the
+// hash.
+func (key *PartitionKey) hashRow(row sql.Row) (uint64, error) {
+	var hash uint64
+	for i, idx := range key.indexes {
+		var valueHash uint64
+		if row[idx] != nil {
+			output, err := key.types[i].IoOutput(row[idx])
+			if err != nil {
+				return 0, err
+			}
+			hasher := fnv.New64a()
+			_, _ = io.WriteString(hasher, output)
+			valueHash = hasher.Sum64()
+		}
+		// This combines the hashes in the same way as Postgres' hash_combine64
+		hash ^= valueHash + 0x49a0f4dd15e5a8e3 + (hash << 54) + (hash >> 7)
+	}
+	return hash, nil
+}
+
+// joinBoundValues returns the given values separated by commas, as they're displayed within error messages.
+func joinBoundValues(values []partitions.Value) string {
+	strs := make([]string, len(values))
+	for i, value := range values {
+		if value.Kind == partitions.ValueKind_Literal {
+			strs[i] = value.Literal
+		} else {
+			strs[i] = value.String()
+		}
+	}
+	return strings.Join(strs, ", ")
+}
+
+// PartitionColumnMap returns the index of each of the partitioned table's columns within the given partition's schema.
+// Returns nil when both schemas have the same columns in the same order. Returns an error if the partition does not
+// have the same columns as its partitioned table.
+func PartitionColumnMap(parentName string, parentSch sql.Schema, partitionName string, partitionSch sql.Schema) ([]int, error) {
+	for _, col := range partitionSch {
+		if parentSch.IndexOfColName(col.Name) == -1 {
+			return nil, pgerrors.Newf(pgcode.DatatypeMismatch, `table "%s" contains column "%s" not found in parent "%s"`,
+				partitionName, col.Name, parentName).WithDetail("The new partition may contain only the columns present in parent.")
+		}
+	}
+	columnMap := make([]int, len(parentSch))
+	identical := len(parentSch) == len(partitionSch)
+	for i, col := range parentSch {
+		columnMap[i] = partitionSch.IndexOfColName(col.Name)
+		if columnMap[i] == -1 {
+			return nil, pgerrors.Newf(pgcode.DatatypeMismatch, `child table is missing column "%s"`, col.Name)
+		}
+		if !col.Type.Equals(partitionSch[columnMap[i]].Type) {
+			return nil, pgerrors.Newf(pgcode.DatatypeMismatch, `child table "%s" has different type for column "%s"`,
+				partitionName, col.Name)
+		}
+		identical = identical && columnMap[i] == i
+	}
+	if identical {
+		return nil, nil
+	}
+	return columnMap, nil
+}
+
+// iterateTableRows calls the given function for every row of the given table, stopping early if the function returns
+// false.
+func iterateTableRows(ctx *sql.Context, table sql.Table, f func(row sql.Row) (bool, error)) error {
+	partitionIter, err := table.Partitions(ctx)
+	if err != nil {
+		return err
+	}
+	defer partitionIter.Close(ctx)
+	for {
+		partition, err := partitionIter.Next(ctx)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		rowIter, err := table.PartitionRows(ctx, partition)
+		if err != nil {
+			return err
+		}
+		for {
+			row, err := rowIter.Next(ctx)
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				_ = rowIter.Close(ctx)
+				return err
+			}
+			cont, err := f(row)
+			if err != nil || !cont {
+				_ = rowIter.Close(ctx)
+				return err
+			}
+		}
+		if err = rowIter.Close(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+// CheckPrimaryKeyIncludesPartitionKey returns an error if the given schema has a primary key that does not include
+// every column of the partition key. Rows with the same primary key could otherwise be stored in different partitions.
+func CheckPrimaryKeyIncludesPartitionKey(table *partitions.Table, sch sql.Schema) error {
+	hasPrimaryKey := false
+	for _, col := range sch {
+		hasPrimaryKey = hasPrimaryKey || col.PrimaryKey
+	}
+	if !hasPrimaryKey {
+		return nil
+	}
+	for _, column := range table.Columns {
+		idx := sch.IndexOfColName(column)
+		if idx == -1 || !sch[idx].PrimaryKey {
+			return pgerrors.New(pgcode.FeatureNotSupported, "unique constraint on partitioned table must include all partitioning columns").
+				WithDetail(fmt.Sprintf(`PRIMARY KEY constraint on table "%s" lacks column "%s" which is part of the partition key.`,
+					table.Name.Name, column))
+		}
+	}
+	return nil
+}
+
+// prepareNewPartition validates the given partition before it is added to its partitioned table, returning the
+// partition with its bound normalized. The partition's bound must not overlap the bounds of the existing partitions,
+// and the default partition must not contain any rows that belong to the new partition.
+func prepareNewPartition(ctx *sql.Context, collection *partitions.Collection, parent *partitions.Table, partition *partitions.Partition, boundStrategy partitions.Strategy) (*partitions.Partition, *PartitionKey, *PartitionBound, error) {
+	database := ctx.GetCurrentDatabase()
+	parentTable, err := core.GetSqlTableFromContext(ctx, database, parent.Name)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if parentTable == nil {
+		return nil, nil, nil, pgerrors.Newf(pgcode.UndefinedTable, `relation "%s" does not exist`, parent.Name.Name)
+	}
+	key, err := NewPartitionKey(parent, parentTable.Schema())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	partition, err = key.ValidateBound(partition, boundStrategy)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	bound, err := key.ResolveBound(partition)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var existing []*PartitionBound
+	var defaultPartition *partitions.Partition
+	for _, existingPartition := range collection.GetPartitions(parent.Name) {
+		existingBound, err := key.ResolveBound(existingPartition)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		existing = append(existing, existingBound)
+		if existingPartition.IsDefault {
+			defaultPartition = existingPartition
+		}
+	}
+	if err = key.CheckOverlap(bound, existing); err != nil {
+		return nil, nil, nil, err
+	}
+	if defaultPartition != nil && !partition.IsDefault {
+		var violated bool
+		err = iterateLeafRows(ctx, collection, defaultPartition.Name, parentTable.Schema(), func(row sql.Row) (bool, error) {
+			contains, err := key.Contains(ctx, bound, row)
+			violated = contains
+			return !contains, err
+		})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if violated {
+			return nil, nil, nil, pgerrors.Newf(pgcode.CheckViolation,
+				`updated partition constraint for default partition "%s" would be violated by some row`, defaultPartition.Name.Name)
+		}
+	}
+	return partition, key, bound, nil
+}
+
+// iterateLeafRows calls the given function for every row of the given table, stopping early if the function returns
+// false. If the table is partitioned, then the rows of each partition are read instead. Each row is given in the
+// column order of the given schema.
+func iterateLeafRows(ctx *sql.Context, collection *partitions.Collection, tableName doltdb.TableName, sch sql.Schema, f func(row sql.Row) (bool, error)) error {
+	if collection.GetTable(tableName) != nil {
+		stopped := false
+		for _, partition := range collection.GetPartitions(tableName) {
+			err := iterateLeafRows(ctx, collection, partition.Name, sch, func(row sql.Row) (bool, error) {
+				cont, err := f(row)
+				stopped = !cont
+				return cont, err
+			})
+			if err != nil || stopped {
+				return err
+			}
+		}
+		return nil
+	}
+	table, err := core.GetSqlTableFromContext(ctx, ctx.GetCurrentDatabase(), tableName)
+	if err != nil {
+		return err
+	}
+	if table == nil {
+		return pgerrors.Newf(pgcode.UndefinedTable, `relation "%s" does not exist`, tableName.Name)
+	}
+	columnMap, err := PartitionColumnMap("", sch, tableName.Name, table.Schema())
+	if err != nil {
+		return err
+	}
+	return iterateTableRows(ctx, table, func(row sql.Row) (bool, error) {
+		return f(RemapPartitionRow(row, columnMap))
+	})
+}
+
+// RemapPartitionRow converts a row of a partition into the column order of its partitioned table, using the column
+// map from PartitionColumnMap.
+func RemapPartitionRow(row sql.Row, columnMap []int) sql.Row {
+	if columnMap == nil {
+		return row
+	}
+	newRow := make(sql.Row, len(columnMap))
+	for i, idx := range columnMap {
+		newRow[i] = row[idx]
+	}
+	return newRow
+}
+
+// UnmapPartitionRow converts a row of a partitioned table into the column order of one of its partitions, using the
+// column map from PartitionColumnMap.
+func UnmapPartitionRow(row sql.Row, columnMap []int) sql.Row {
+	if columnMap == nil {
+		return row
+	}
+	newRow := make(sql.Row, len(columnMap))
+	for i, idx := range columnMap {
+		newRow[idx] = row[i]
+	}
+	return newRow
+}
@@ -0,0 +1,220 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/rowexec"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/partitions"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// PartitionCheck verifies that each row that is written directly to a partition by an INSERT or UPDATE falls within
+// the partition's bound, along with the bounds of every partition that the partition belongs to. This wraps the node
+// that produces the rows to write, which are the new rows for inserts, and the old rows followed by the new rows for
+// updates. Rows written through the partitioned table are routed to the partition that holds them, so they do not need
+// this check.
+type PartitionCheck struct {
+	child      sql.Node
+	database   string
+	table      doltdb.TableName
+	collection *partitions.Collection
+	isUpdate   bool
+}
+
+var _ sql.ExecSourceRel = (*PartitionCheck)(nil)
+
+// NewPartitionCheck returns a new *PartitionCheck.
+func NewPartitionCheck(child sql.Node, database string, table doltdb.TableName, collection *partitions.Collection, isUpdate bool) *PartitionCheck {
+	return &PartitionCheck{
+		child:      child,
+		database:   database,
+		table:      table,
+		collection: collection,
+		isUpdate:   isUpdate,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (pc *PartitionCheck) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return pc.child.CheckPrivileges(ctx, opChecker)
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (pc *PartitionCheck) Children() []sql.Node {
+	return []sql.Node{pc.child}
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (pc *PartitionCheck) IsReadOnly() bool {
+	return pc.child.IsReadOnly()
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (pc *PartitionCheck) Resolved() bool {
+	return pc.child.Resolved()
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (pc *PartitionCheck) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	table, err := core.GetSqlTableFromContext(ctx, pc.database, pc.table)
+	if err != nil {
+		return nil, err
+	}
+	if table == nil {
+		return nil, pgerrors.Newf(pgcode.UndefinedTable, `relation "%s" does not exist`, pc.table.Name)
+	}
+	var constraints []partitionConstraint
+	for partition := pc.collection.GetPartition(pc.table); partition != nil; partition = pc.collection.GetPartition(partition.Parent) {
+		constraint, err := pc.resolveConstraint(ctx, partition, table.Schema())
+		if err != nil {
+			return nil, err
+		}
+		constraints = append(constraints, constraint)
+	}
+	childIter, err := rowexec.DefaultBuilder.Build(ctx, pc.child, r)
+	if err != nil {
+		return nil, err
+	}
+	return &partitionCheckIter{node: pc, childIter: childIter, constraints: constraints}, nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (pc *PartitionCheck) Schema() sql.Schema {
+	return pc.child.Schema()
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (pc *PartitionCheck) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("PartitionCheck")
+	_ = pr.WriteChildren(pc.child.String())
+	return pr.String()
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (pc *PartitionCheck) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(pc, len(children), 1)
+	}
+	npc := *pc
+	npc.child = children[0]
+	return &npc, nil
+}
+
+// resolveConstraint returns the constraint that the bound of the given partition places on the rows of the written
+// table, whose schema is given.
+func (pc *PartitionCheck) resolveConstraint(ctx *sql.Context, partition *partitions.Partition, sch sql.Schema) (partitionConstraint, error) {
+	parent := pc.collection.GetTable(partition.Parent)
+	if parent == nil {
+		return partitionConstraint{}, fmt.Errorf(`relation "%s" is not partitioned`, partition.Parent.Name)
+	}
+	parentTable, err := core.GetSqlTableFromContext(ctx, pc.database, parent.Name)
+	if err != nil {
+		return partitionConstraint{}, err
+	}
+	if parentTable == nil {
+		return partitionConstraint{}, pgerrors.Newf(pgcode.UndefinedTable, `relation "%s" does not exist`, parent.Name.Name)
+	}
+	key, err := NewPartitionKey(parent, parentTable.Schema())
+	if err != nil {
+		return partitionConstraint{}, err
+	}
+	columnMap, err := PartitionColumnMap(parent.Name.Name, parentTable.Schema(), pc.table.Name, sch)
+	if err != nil {
+		return partitionConstraint{}, err
+	}
+	constraint := partitionConstraint{key: key, columnMap: columnMap}
+	if constraint.bound, err = key.ResolveBound(partition); err != nil {
+		return partitionConstraint{}, err
+	}
+	// A default partition may only hold the rows that no other partition holds
+	if partition.IsDefault {
+		for _, otherPartition := range pc.collection.GetPartitions(parent.Name) {
+			if otherPartition.IsDefault {
+				continue
+			}
+			otherBound, err := key.ResolveBound(otherPartition)
+			if err != nil {
+				return partitionConstraint{}, err
+			}
+			constraint.otherBounds = append(constraint.otherBounds, otherBound)
+		}
+	}
+	return constraint, nil
+}
+
+// partitionConstraint is the bound of a partition, which applies to the rows of the written table.
+type partitionConstraint struct {
+	key   *PartitionKey
+	bound *PartitionBound
+	// otherBounds are the bounds of the other partitions of the same partitioned table, which are only set for the
+	// default partition.
+	otherBounds []*PartitionBound
+	// columnMap converts the rows of the written table into the column order of the partitioned table.
+	columnMap []int
+}
+
+// contains returns whether the given row of the written table satisfies the constraint.
+func (constraint partitionConstraint) contains(ctx *sql.Context, row sql.Row) (bool, error) {
+	parentRow := RemapPartitionRow(row, constraint.columnMap)
+	if constraint.bound.Partition.IsDefault {
+		idx, err := constraint.key.Route(ctx, constraint.otherBounds, parentRow)
+		return idx == -1, err
+	}
+	return constraint.key.Contains(ctx, constraint.bound, parentRow)
+}
+
+// partitionCheckIter is the iterator for *PartitionCheck.
+type partitionCheckIter struct {
+	node        *PartitionCheck
+	childIter   sql.RowIter
+	constraints []partitionConstraint
+}
+
+var _ sql.RowIter = (*partitionCheckIter)(nil)
+
+// Next implements the interface sql.RowIter.
+func (iter *partitionCheckIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := iter.childIter.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	newRow := row
+	if iter.node.isUpdate {
+		newRow = row[len(row)/2:]
+	}
+	for _, constraint := range iter.constraints {
+		contains, err := constraint.contains(ctx, newRow)
+		if err != nil {
+			return nil, err
+		}
+		if !contains {
+			return nil, pgerrors.Newf(pgcode.CheckViolation, `new row for relation "%s" violates partition constraint`, iter.node.table.Name)
+		}
+	}
+	return row, nil
+}
+
+// Close implements the interface sql.RowIter.
+func (iter *partitionCheckIter) Close(ctx *sql.Context) error {
+	return iter.childIter.Close(ctx)
+}
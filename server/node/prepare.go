@@ -0,0 +1,108 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// Prepare handles the PREPARE statement. Prepared statements belong to the connection, and are shared with the extended
+// query protocol, so the connection handler creates the prepared statement. This node only exists to carry the
+// statement to the handler.
+type Prepare struct {
+	name           string
+	parameterTypes []uint32
+	statement      string
+}
+
+var _ sql.ExecSourceRel = (*Prepare)(nil)
+var _ vitess.Injectable = (*Prepare)(nil)
+
+// NewPrepare returns a new *Prepare. The parameter types are OIDs, where zero means that the type should be inferred.
+func NewPrepare(name string, parameterTypes []uint32, statement string) *Prepare {
+	return &Prepare{
+		name:           name,
+		parameterTypes: parameterTypes,
+		statement:      statement,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (p *Prepare) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// The statement will check its own privileges when it's executed
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (p *Prepare) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (p *Prepare) IsReadOnly() bool {
+	return true
+}
+
+// Name returns the name of the prepared statement.
+func (p *Prepare) Name() string {
+	return p.name
+}
+
+// ParameterTypes returns the OIDs of the types that were given for the parameters.
+func (p *Prepare) ParameterTypes() []uint32 {
+	return p.parameterTypes
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (p *Prepare) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (p *Prepare) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	return nil, fmt.Errorf("PREPARE is only supported using the simple query protocol")
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (p *Prepare) Schema() sql.Schema {
+	return nil
+}
+
+// Statement returns the statement that is being prepared.
+func (p *Prepare) Statement() string {
+	return p.statement
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (p *Prepare) String() string {
+	return "PREPARE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (p *Prepare) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(p, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (p *Prepare) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return p, nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// ReleaseSavepoint handles the RELEASE SAVEPOINT statement. Savepoints are managed by the connection handler, as they
+// also hold session state that the engine does not track. This node only exists to carry the savepoint's name to the
+// handler.
+type ReleaseSavepoint struct {
+	name string
+}
+
+var _ sql.ExecSourceRel = (*ReleaseSavepoint)(nil)
+var _ vitess.Injectable = (*ReleaseSavepoint)(nil)
+
+// NewReleaseSavepoint returns a new *ReleaseSavepoint.
+func NewReleaseSavepoint(name string) *ReleaseSavepoint {
+	return &ReleaseSavepoint{
+		name: name,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (s *ReleaseSavepoint) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (s *ReleaseSavepoint) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (s *ReleaseSavepoint) IsReadOnly() bool {
+	return true
+}
+
+// Name returns the name of the savepoint.
+func (s *ReleaseSavepoint) Name() string {
+	return s.name
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (s *ReleaseSavepoint) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (s *ReleaseSavepoint) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	return nil, fmt.Errorf("RELEASE SAVEPOINT is only supported as a top-level statement")
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (s *ReleaseSavepoint) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (s *ReleaseSavepoint) String() string {
+	return "RELEASE SAVEPOINT"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (s *ReleaseSavepoint) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(s, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (s *ReleaseSavepoint) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return s, nil
+}
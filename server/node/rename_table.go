@@ -0,0 +1,141 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/postgres/parser/privilege"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// RenameTable handles the ALTER TABLE ... RENAME TO and ALTER TABLE ... SET SCHEMA statements. This replaces the GMS
+// implementation, as it relies on Dolt's table renaming, which does not account for schemas.
+type RenameTable struct {
+	schema    string
+	table     string
+	newSchema string
+	newTable  string
+	ifExists  bool
+}
+
+var _ sql.ExecSourceRel = (*RenameTable)(nil)
+var _ vitess.Injectable = (*RenameTable)(nil)
+
+// NewRenameTable returns a new *RenameTable. An empty schema resolves the table using the search path, while an empty
+// new schema keeps the table in its current schema.
+func NewRenameTable(schema string, table string, newSchema string, newTable string, ifExists bool) *RenameTable {
+	return &RenameTable{
+		schema:    schema,
+		table:     table,
+		newSchema: newSchema,
+		newTable:  newTable,
+		ifExists:  ifExists,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (rt *RenameTable) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// Only the owner may rename a table, which is checked in RowIter once the table's schema has been resolved
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (rt *RenameTable) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (rt *RenameTable) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (rt *RenameTable) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (rt *RenameTable) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	database := ctx.GetCurrentDatabase()
+	tableName, ok, err := core.ResolveTableName(ctx, database, doltdb.TableName{Name: rt.table, Schema: rt.schema})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if rt.ifExists {
+			return sql.RowsToRowIter(), nil
+		}
+		return nil, fmt.Errorf(`relation "%s" does not exist`, rt.table)
+	}
+	obj := auth.TableObject(database, tableName.Schema, tableName.Name)
+	role := currentRole(ctx).Name
+	if !auth.IsOwner(role, obj) {
+		return nil, pgerrors.Raise(ctx, auth.NotOwnerError(obj))
+	}
+	newTableName := doltdb.TableName{Name: rt.newTable, Schema: rt.newSchema}
+	if len(newTableName.Schema) == 0 {
+		newTableName.Schema = tableName.Schema
+	}
+	// Moving a table into another schema creates it within that schema
+	if newSchema := auth.SchemaObject(database, newTableName.Schema); newTableName.Schema != tableName.Schema &&
+		!auth.HasPrivilege(role, newSchema, privilege.CREATE) {
+		return nil, pgerrors.Raise(ctx, auth.PermissionDeniedError(newSchema))
+	}
+	renamed, err := core.RenameTable(ctx, tableName, newTableName)
+	if err != nil {
+		return nil, err
+	}
+	if !renamed {
+		return nil, fmt.Errorf(`relation "%s" does not exist`, rt.table)
+	}
+	if err = auth.RenameObject(obj, auth.TableObject(database, newTableName.Schema, newTableName.Name)); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (rt *RenameTable) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (rt *RenameTable) String() string {
+	if rt.table == rt.newTable {
+		return "ALTER TABLE SET SCHEMA"
+	}
+	return "ALTER TABLE RENAME"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (rt *RenameTable) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(rt, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (rt *RenameTable) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return rt, nil
+}
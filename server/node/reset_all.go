@@ -0,0 +1,107 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/server/config"
+)
+
+// resetAllExcluded are the parameters that RESET ALL does not reset, which matches Postgres. The characteristics of the
+// current transaction cannot change mid-transaction, and the role is reset by RESET ROLE.
+var resetAllExcluded = map[string]struct{}{
+	"role":                   {},
+	"session_authorization":  {},
+	"transaction_deferrable": {},
+	"transaction_isolation":  {},
+	"transaction_read_only":  {},
+}
+
+// ResetAll handles the RESET ALL statement, which sets every parameter that may be changed back to its default.
+type ResetAll struct{}
+
+var _ sql.ExecSourceRel = (*ResetAll)(nil)
+var _ vitess.Injectable = (*ResetAll)(nil)
+
+// NewResetAll returns a new *ResetAll.
+func NewResetAll() *ResetAll {
+	return &ResetAll{}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (r *ResetAll) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (r *ResetAll) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (r *ResetAll) IsReadOnly() bool {
+	return true
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (r *ResetAll) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (r *ResetAll) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, error) {
+	for _, param := range config.Parameters() {
+		if _, ok := resetAllExcluded[strings.ToLower(param.Name)]; ok || param.IsReadOnly() {
+			continue
+		}
+		value, err := ctx.GetSessionVariable(ctx, param.Name)
+		if err == nil && value == param.GetDefault() {
+			continue
+		}
+		if err = ctx.SetSessionVariable(ctx, param.Name, param.GetDefault()); err != nil {
+			return nil, err
+		}
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (r *ResetAll) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (r *ResetAll) String() string {
+	return "RESET ALL"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (r *ResetAll) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(r, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (r *ResetAll) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return r, nil
+}
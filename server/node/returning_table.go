@@ -0,0 +1,152 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/rowexec"
+)
+
+// ReturningTable reads the rows that an INSERT, UPDATE, or DELETE writes to a table, which are the rows returned by its
+// RETURNING clause. The statement runs when the rows are first read, and the underlying table only supplies the columns.
+// This intentionally implements only sql.Table, so that the analyzer cannot push filters or index lookups into the
+// underlying table.
+// TODO: a WITH query that is referenced multiple times runs its statement once per reference, and one that is never
+// referenced does not run at all
+type ReturningTable struct {
+	underlying   sql.Table
+	modification sql.Node
+}
+
+var _ sql.Table = (*ReturningTable)(nil)
+
+// NewReturningTable returns a new *ReturningTable. The modification is the analyzed statement, without the accumulator
+// that counts its rows, so that it returns the rows that it writes.
+func NewReturningTable(underlying sql.Table, modification sql.Node) *ReturningTable {
+	return &ReturningTable{
+		underlying:   underlying,
+		modification: modification,
+	}
+}
+
+// Name implements the interface sql.Table.
+func (t *ReturningTable) Name() string {
+	return t.underlying.Name()
+}
+
+// String implements the interface sql.Table.
+func (t *ReturningTable) String() string {
+	return t.underlying.String()
+}
+
+// Schema implements the interface sql.Table.
+func (t *ReturningTable) Schema() sql.Schema {
+	return t.underlying.Schema()
+}
+
+// Collation implements the interface sql.Table.
+func (t *ReturningTable) Collation() sql.CollationID {
+	return t.underlying.Collation()
+}
+
+// Partitions implements the interface sql.Table.
+func (t *ReturningTable) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	return sql.PartitionsToPartitionIter(returningPartition{}), nil
+}
+
+// PartitionRows implements the interface sql.Table.
+func (t *ReturningTable) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	iter, err := rowexec.DefaultBuilder.Build(ctx, t.modification, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &returningIter{childIter: iter, columns: len(t.Schema())}, nil
+}
+
+// returningPartition is the only partition of a ReturningTable, as the statement is run in a single pass.
+type returningPartition struct{}
+
+var _ sql.Partition = returningPartition{}
+
+// Key implements the interface sql.Partition.
+func (returningPartition) Key() []byte {
+	return []byte("returning")
+}
+
+// returningIter is the iterator for *ReturningTable. The statement is run in its entirety on the first call to Next, so
+// that every row is written even when only some are read.
+type returningIter struct {
+	childIter sql.RowIter
+	columns   int
+	rows      []sql.Row
+	ran       bool
+}
+
+var _ sql.RowIter = (*returningIter)(nil)
+
+// Next implements the interface sql.RowIter.
+func (iter *returningIter) Next(ctx *sql.Context) (sql.Row, error) {
+	if !iter.ran {
+		iter.ran = true
+		if err := iter.run(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if len(iter.rows) == 0 {
+		return nil, io.EOF
+	}
+	row := iter.rows[0]
+	iter.rows = iter.rows[1:]
+	return row, nil
+}
+
+// run reads every row from the statement, and closes it so that its changes are written.
+func (iter *returningIter) run(ctx *sql.Context) (err error) {
+	childIter := iter.childIter
+	iter.childIter = nil
+	defer func() {
+		if closeErr := childIter.Close(ctx); err == nil {
+			err = closeErr
+		}
+	}()
+	for {
+		row, err := childIter.Next(ctx)
+		if err == io.EOF {
+			return nil
+		}
+		if _, ok := err.(sql.IgnorableError); ok {
+			// Ignored rows, such as those skipped by ON CONFLICT DO NOTHING, were not written
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		// Updated rows contain both the old and new values, and only the new values are returned
+		if len(row) == 2*iter.columns {
+			row = row[iter.columns:]
+		}
+		iter.rows = append(iter.rows, row)
+	}
+}
+
+// Close implements the interface sql.RowIter.
+func (iter *returningIter) Close(ctx *sql.Context) error {
+	if iter.childIter != nil {
+		return iter.childIter.Close(ctx)
+	}
+	return nil
+}
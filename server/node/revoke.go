@@ -0,0 +1,139 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/privilege"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// Revoke handles the REVOKE statement for privileges on objects.
+type Revoke struct {
+	target          PrivilegeTarget
+	privileges      privilege.List
+	grantees        []string
+	grantOptionOnly bool
+	grantedBy       string
+	cascade         bool
+}
+
+var _ sql.ExecSourceRel = (*Revoke)(nil)
+var _ vitess.Injectable = (*Revoke)(nil)
+
+// NewRevoke returns a new *Revoke. When grantOptionOnly is true, only the grant options of the privileges are revoked.
+func NewRevoke(target PrivilegeTarget, privileges privilege.List, grantees []string, grantOptionOnly bool, grantedBy string, cascade bool) *Revoke {
+	return &Revoke{
+		target:          target,
+		privileges:      privileges,
+		grantees:        grantees,
+		grantOptionOnly: grantOptionOnly,
+		grantedBy:       grantedBy,
+		cascade:         cascade,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (r *Revoke) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (r *Revoke) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (r *Revoke) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (r *Revoke) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (r *Revoke) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if err := r.execute(ctx); err != nil {
+		return nil, pgerrors.Raise(ctx, err)
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (r *Revoke) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (r *Revoke) String() string {
+	return "REVOKE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (r *Revoke) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(r, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (r *Revoke) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return r, nil
+}
+
+// execute revokes the privileges.
+func (r *Revoke) execute(ctx *sql.Context) error {
+	revoker, err := resolveGrantor(ctx, r.grantedBy)
+	if err != nil {
+		return err
+	}
+	privileges, allPrivileges, err := resolvePrivileges(r.target.Kind, r.privileges)
+	if err != nil {
+		return err
+	}
+	grantees, err := resolveGrantees(ctx, r.grantees)
+	if err != nil {
+		return err
+	}
+	objects, err := r.target.resolve(ctx)
+	if err != nil {
+		return err
+	}
+	for _, obj := range objects {
+		revoked, err := auth.RevokePrivileges(obj, revoker, grantees, privileges, r.grantOptionOnly, r.cascade)
+		if err != nil {
+			return err
+		}
+		if revoked == 0 {
+			if !auth.HasAnyPrivilege(revoker, obj) {
+				return auth.PermissionDeniedError(obj)
+			}
+			raiseWarning(ctx, pgcode.WarningPrivilegeNotRevoked, fmt.Sprintf(`no privileges could be revoked for "%s"`, obj.Name))
+		} else if !allPrivileges && revoked != privileges {
+			raiseWarning(ctx, pgcode.WarningPrivilegeNotRevoked, fmt.Sprintf(`not all privileges could be revoked for "%s"`, obj.Name))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// RevokeRole handles the REVOKE statement for role memberships.
+type RevokeRole struct {
+	roles     []string
+	members   []string
+	options   auth.MembershipOptions
+	grantedBy string
+	cascade   bool
+}
+
+var _ sql.ExecSourceRel = (*RevokeRole)(nil)
+var _ vitess.Injectable = (*RevokeRole)(nil)
+
+// NewRevokeRole returns a new *RevokeRole. When any options are given, only those options are revoked, rather than the
+// memberships themselves.
+func NewRevokeRole(roles []string, members []string, options auth.MembershipOptions, grantedBy string, cascade bool) *RevokeRole {
+	return &RevokeRole{
+		roles:     roles,
+		members:   members,
+		options:   options,
+		grantedBy: grantedBy,
+		cascade:   cascade,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (r *RevokeRole) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (r *RevokeRole) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (r *RevokeRole) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (r *RevokeRole) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (r *RevokeRole) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if err := r.execute(ctx); err != nil {
+		return nil, pgerrors.Raise(ctx, err)
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (r *RevokeRole) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (r *RevokeRole) String() string {
+	return "REVOKE ROLE"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (r *RevokeRole) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(r, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (r *RevokeRole) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return r, nil
+}
+
+// execute revokes the memberships.
+func (r *RevokeRole) execute(ctx *sql.Context) error {
+	grantor, err := resolveMembershipGrantor(ctx, r.grantedBy)
+	if err != nil {
+		return err
+	}
+	members, err := resolveMembers(ctx, r.members)
+	if err != nil {
+		return err
+	}
+	for _, role := range r.roles {
+		if err = checkCanManageMembership(ctx, "revoke", role); err != nil {
+			return err
+		}
+		for _, member := range members {
+			revoked, err := auth.RevokeMembership(role, member, grantor, r.options, r.cascade)
+			if err != nil {
+				return err
+			}
+			if !revoked {
+				raiseWarning(ctx, pgcode.Warning, fmt.Sprintf(`role "%s" has not been granted membership in role "%s" by role "%s"`,
+					member, role, grantor))
+			}
+		}
+	}
+	return nil
+}
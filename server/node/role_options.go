@@ -0,0 +1,138 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/notices"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// RoleOptions are the options that were given to CREATE ROLE or ALTER ROLE. Options that were not given are nil, so
+// that ALTER ROLE only changes the given options.
+type RoleOptions struct {
+	SuperUser       *bool
+	CreateDB        *bool
+	CreateRole      *bool
+	Inherit         *bool
+	Login           *bool
+	Replication     *bool
+	BypassRLS       *bool
+	ConnectionLimit *int32
+	// Password is the unencrypted or encrypted password. An empty password removes the role's password.
+	Password *string
+	// ValidUntil is the time that the password expires. The zero time means that it never expires.
+	ValidUntil *time.Time
+}
+
+// apply sets the given options on the role.
+func (o RoleOptions) apply(ctx *sql.Context, role *auth.Role) error {
+	for _, attribute := range []struct {
+		option *bool
+		field  *bool
+	}{
+		{o.SuperUser, &role.IsSuperUser},
+		{o.CreateDB, &role.CanCreateDB},
+		{o.CreateRole, &role.CanCreateRoles},
+		{o.Inherit, &role.Inherit},
+		{o.Login, &role.CanLogin},
+		{o.Replication, &role.IsReplication},
+		{o.BypassRLS, &role.BypassRLS},
+	} {
+		if attribute.option != nil {
+			*attribute.field = *attribute.option
+		}
+	}
+	if o.ConnectionLimit != nil {
+		role.ConnectionLimit = *o.ConnectionLimit
+	}
+	if o.Password != nil {
+		if len(*o.Password) == 0 {
+			notices.RaiseNotice(ctx, "empty string is not a valid password, clearing password")
+			role.Password = ""
+		} else {
+			encrypted, err := auth.EncryptPassword(*o.Password)
+			if err != nil {
+				return err
+			}
+			role.Password = encrypted
+		}
+	}
+	if o.ValidUntil != nil {
+		role.ValidUntil = *o.ValidUntil
+	}
+	return nil
+}
+
+// requiresSuperUser returns the name of the attribute that only a superuser may give, or an empty string if no such
+// attribute was given.
+func (o RoleOptions) requiresSuperUser() string {
+	switch {
+	case o.SuperUser != nil:
+		return "SUPERUSER"
+	case o.Replication != nil:
+		return "REPLICATION"
+	case o.BypassRLS != nil:
+		return "BYPASSRLS"
+	default:
+		return ""
+	}
+}
+
+// onlyPassword returns whether the password is the only option that was given.
+func (o RoleOptions) onlyPassword() bool {
+	return o.Password != nil && o.SuperUser == nil && o.CreateDB == nil && o.CreateRole == nil && o.Inherit == nil &&
+		o.Login == nil && o.Replication == nil && o.BypassRLS == nil && o.ConnectionLimit == nil && o.ValidUntil == nil
+}
+
+// currentRole returns the role of the session. Sessions without a user, such as those that are used internally, act
+// as the bootstrap superuser.
+func currentRole(ctx *sql.Context) auth.Role {
+	user := ctx.Client().User
+	if len(user) == 0 {
+		user = auth.BootstrapRole
+	}
+	role, ok := auth.GetRole(user)
+	if !ok {
+		return auth.NewRole(user)
+	}
+	return role
+}
+
+// checkCanManageRole returns an error if the current role may not create, alter, or drop roles with the given action,
+// such as "create". Only superusers may manage superusers, or give the attributes that only superusers may give.
+func checkCanManageRole(current auth.Role, action string, target auth.Role, options RoleOptions) error {
+	if current.IsSuperUser {
+		return nil
+	}
+	if !current.CanCreateRoles {
+		return pgerrors.Newf(pgcode.InsufficientPrivilege, "permission denied to %s role", action).
+			WithDetail("Only roles with the CREATEROLE attribute may " + action + " roles.")
+	}
+	if target.IsSuperUser {
+		return pgerrors.Newf(pgcode.InsufficientPrivilege, "permission denied to %s role", action).
+			WithDetail("Only roles with the SUPERUSER attribute may " + action + " roles with the SUPERUSER attribute.")
+	}
+	if attribute := options.requiresSuperUser(); len(attribute) > 0 {
+		return pgerrors.Newf(pgcode.InsufficientPrivilege, "permission denied to %s role", action).
+			WithDetail("Only roles with the SUPERUSER attribute may " + action + " roles with the " + attribute + " attribute.")
+	}
+	return nil
+}
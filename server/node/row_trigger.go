@@ -0,0 +1,99 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core/functions"
+	"github.com/dolthub/doltgresql/core/triggers"
+	"github.com/dolthub/doltgresql/server/plpgsql"
+)
+
+// RowTrigger is a trigger that fires for each row written by a statement, along with the function that it calls.
+type RowTrigger struct {
+	Trigger  *triggers.Trigger
+	Function *functions.Function
+	Block    *plpgsql.Block
+}
+
+// TriggerTarget is the table that a statement writes to, along with the operation that the statement performs.
+type TriggerTarget struct {
+	Table     doltdb.TableName
+	Columns   sql.Schema
+	Operation triggers.Event
+}
+
+// WrittenRows holds the rows that a statement has written, so that AFTER triggers may fire for them once the statement
+// has written every row.
+type WrittenRows struct {
+	rows []writtenRow
+}
+
+// writtenRow is a row that was written by a statement. Old is nil for inserts, while New is nil for deletes.
+type writtenRow struct {
+	Old sql.Row
+	New sql.Row
+}
+
+// NewWrittenRows returns a new *WrittenRows.
+func NewWrittenRows() *WrittenRows {
+	return &WrittenRows{}
+}
+
+// fire calls the trigger's function for the given rows, returning the row that the function returned. When the
+// trigger's WHEN condition is not true, then the function is not called, and the row that the trigger would have
+// modified is returned as-is. That is the new row for inserts and updates, and the old row for deletes.
+func (rt RowTrigger) fire(ctx *sql.Context, target TriggerTarget, timing string, oldRow sql.Row, newRow sql.Row, runner StatementRunner) (sql.Row, error) {
+	data := &plpgsql.TriggerData{
+		Name:        rt.Trigger.Name,
+		When:        timing,
+		Level:       "ROW",
+		Operation:   target.operationName(),
+		TableName:   target.Table.Name,
+		TableSchema: target.Table.Schema,
+		Arguments:   rt.Trigger.Arguments,
+		Columns:     target.Columns,
+		New:         newRow,
+		Old:         oldRow,
+	}
+	unmodifiedRow := newRow
+	if target.Operation == triggers.Event_Delete {
+		unmodifiedRow = oldRow
+	}
+	if len(rt.Trigger.When) > 0 {
+		ok, err := plpgsql.EvaluateTriggerCondition(ctx, rt.Trigger.When, data, plpgsql.StatementRunner(runner))
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return unmodifiedRow, nil
+		}
+	}
+	return CallTriggerFunction(ctx, rt.Function, rt.Block, data, runner)
+}
+
+// operationName returns the name of the operation, as given to trigger functions through TG_OP.
+func (target TriggerTarget) operationName() string {
+	switch target.Operation {
+	case triggers.Event_Insert:
+		return "INSERT"
+	case triggers.Event_Update:
+		return "UPDATE"
+	default:
+		return "DELETE"
+	}
+}
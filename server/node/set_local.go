@@ -0,0 +1,100 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// SetLocal handles the SET LOCAL statement, which changes a parameter until the end of the current transaction. The
+// connection handler restores the parameter once the transaction ends, so this node only exists to carry the parameter
+// and the equivalent session-level SET statement to the handler.
+type SetLocal struct {
+	name string
+	set  *vitess.Set
+}
+
+var _ sql.ExecSourceRel = (*SetLocal)(nil)
+var _ vitess.Injectable = (*SetLocal)(nil)
+
+// NewSetLocal returns a new *SetLocal. |set| changes the parameter for the session.
+func NewSetLocal(name string, set *vitess.Set) *SetLocal {
+	return &SetLocal{
+		name: name,
+		set:  set,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (s *SetLocal) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (s *SetLocal) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (s *SetLocal) IsReadOnly() bool {
+	return true
+}
+
+// Name returns the name of the parameter.
+func (s *SetLocal) Name() string {
+	return s.name
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (s *SetLocal) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (s *SetLocal) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	return nil, fmt.Errorf("SET LOCAL is only supported as a top-level statement")
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (s *SetLocal) Schema() sql.Schema {
+	return nil
+}
+
+// Set returns the statement that changes the parameter for the session.
+func (s *SetLocal) Set() *vitess.Set {
+	return s.set
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (s *SetLocal) String() string {
+	return "SET LOCAL"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (s *SetLocal) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(s, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (s *SetLocal) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return s, nil
+}
@@ -0,0 +1,145 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/server/auth"
+)
+
+// SetStorageParameters handles the ALTER TABLE ... SET ( ... ) and ALTER TABLE ... RESET ( ... ) statements.
+type SetStorageParameters struct {
+	schema string
+	table  string
+	// params are the parameters that are set, which is nil when resetting.
+	params map[string]string
+	// reset are the names of the parameters that are reset.
+	reset []string
+}
+
+var _ sql.ExecSourceRel = (*SetStorageParameters)(nil)
+var _ vitess.Injectable = (*SetStorageParameters)(nil)
+
+// NewSetStorageParameters returns a new *SetStorageParameters that sets the given parameters on the table.
+func NewSetStorageParameters(schema string, table string, params map[string]string) *SetStorageParameters {
+	return &SetStorageParameters{
+		schema: schema,
+		table:  table,
+		params: params,
+	}
+}
+
+// NewResetStorageParameters returns a new *SetStorageParameters that returns the given parameters to their defaults.
+func NewResetStorageParameters(schema string, table string, names []string) *SetStorageParameters {
+	return &SetStorageParameters{
+		schema: schema,
+		table:  table,
+		reset:  names,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *SetStorageParameters) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// Only the owner of the table may change its storage parameters, which is checked in RowIter once the table's
+	// schema has been resolved
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *SetStorageParameters) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *SetStorageParameters) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *SetStorageParameters) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *SetStorageParameters) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	schema := c.schema
+	if len(c.schema) == 0 {
+		var err error
+		schema, err = core.GetCurrentSchema(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	tableName := doltdb.TableName{Name: c.table, Schema: schema}
+	table, err := core.GetTableFromContext(ctx, tableName)
+	if err != nil {
+		return nil, err
+	}
+	if table == nil {
+		return nil, fmt.Errorf(`relation "%s" does not exist`, c.table)
+	}
+	if obj := auth.TableObject(ctx.GetCurrentDatabase(), schema, c.table); !auth.IsOwner(currentRole(ctx).Name, obj) {
+		return nil, auth.NotOwnerError(obj)
+	}
+	collection, err := core.GetStorageParametersCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if c.params != nil {
+		err = collection.SetParameters(tableName, c.params)
+	} else {
+		err = collection.ResetParameters(tableName, c.reset)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = core.UpdateStorageParametersCollection(ctx, collection); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *SetStorageParameters) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *SetStorageParameters) String() string {
+	if c.params == nil {
+		return "RESET STORAGE PARAMETERS"
+	}
+	return "SET STORAGE PARAMETERS"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *SetStorageParameters) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *SetStorageParameters) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// SetTransaction handles the SET TRANSACTION statement, which changes the characteristics of the current transaction,
+// and the SET SESSION CHARACTERISTICS statement, which changes the characteristics of every later transaction. This node
+// only exists to carry the characteristics to the connection handler.
+type SetTransaction struct {
+	modes   TransactionModes
+	session bool
+}
+
+var _ sql.ExecSourceRel = (*SetTransaction)(nil)
+var _ vitess.Injectable = (*SetTransaction)(nil)
+
+// NewSetTransaction returns a new *SetTransaction. |session| is set for SET SESSION CHARACTERISTICS.
+func NewSetTransaction(modes TransactionModes, session bool) *SetTransaction {
+	return &SetTransaction{
+		modes:   modes,
+		session: session,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (s *SetTransaction) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (s *SetTransaction) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (s *SetTransaction) IsReadOnly() bool {
+	return true
+}
+
+// Modes returns the characteristics that were given.
+func (s *SetTransaction) Modes() TransactionModes {
+	return s.modes
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (s *SetTransaction) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (s *SetTransaction) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	return nil, fmt.Errorf("SET TRANSACTION is only supported as a top-level statement")
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (s *SetTransaction) Schema() sql.Schema {
+	return nil
+}
+
+// Session returns whether the characteristics apply to the session's later transactions, rather than the current one.
+func (s *SetTransaction) Session() bool {
+	return s.session
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (s *SetTransaction) String() string {
+	if s.session {
+		return "SET SESSION CHARACTERISTICS"
+	}
+	return "SET TRANSACTION"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (s *SetTransaction) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(s, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (s *SetTransaction) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return s, nil
+}
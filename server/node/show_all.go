@@ -0,0 +1,105 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/server/config"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// ShowAll handles the SHOW ALL statement, which returns the name, current value, and description of every parameter.
+type ShowAll struct{}
+
+var _ sql.ExecSourceRel = (*ShowAll)(nil)
+var _ vitess.Injectable = (*ShowAll)(nil)
+
+// showAllSchema is the schema of SHOW ALL, which matches Postgres.
+var showAllSchema = sql.Schema{
+	{Name: "name", Type: pgtypes.Text, Source: "show_all"},
+	{Name: "setting", Type: pgtypes.Text, Source: "show_all", Nullable: true},
+	{Name: "description", Type: pgtypes.Text, Source: "show_all", Nullable: true},
+}
+
+// NewShowAll returns a new *ShowAll.
+func NewShowAll() *ShowAll {
+	return &ShowAll{}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (s *ShowAll) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (s *ShowAll) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (s *ShowAll) IsReadOnly() bool {
+	return true
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (s *ShowAll) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (s *ShowAll) RowIter(ctx *sql.Context, _ sql.Row) (sql.RowIter, error) {
+	params := config.Parameters()
+	rows := make([]sql.Row, 0, len(params))
+	for _, param := range params {
+		value, err := ctx.GetSessionVariable(ctx, param.Name)
+		if err != nil {
+			return nil, err
+		}
+		var setting any
+		if value != nil {
+			setting = config.DisplayValue(param, value)
+		}
+		rows = append(rows, sql.Row{param.Name, setting, param.ShortDesc})
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (s *ShowAll) Schema() sql.Schema {
+	return showAllSchema
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (s *ShowAll) String() string {
+	return "SHOW ALL"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (s *ShowAll) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(s, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (s *ShowAll) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return s, nil
+}
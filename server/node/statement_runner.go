@@ -0,0 +1,31 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+)
+
+// StatementRunner executes a single statement on behalf of a node, returning the schema and rows of the result. This is
+// used by nodes that are built on top of other statements, such as procedures that run the statements in their body.
+type StatementRunner func(ctx *sql.Context, stmt tree.Statement) (sql.Schema, []sql.Row, error)
+
+// ConvertedStatementRunner executes a single statement that has already been converted, returning the schema and rows
+// of the result. This is used by nodes that execute statements that cannot be expressed by the Postgres AST, such as
+// those that wrap a statement that the node would otherwise be built from.
+type ConvertedStatementRunner func(ctx *sql.Context, stmt vitess.Statement, query string) (sql.Schema, []sql.Row, error)
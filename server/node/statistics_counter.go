@@ -0,0 +1,178 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/rowexec"
+
+	"github.com/dolthub/doltgresql/server/pgstat"
+)
+
+// StatisticsCounterKind is the activity that a StatisticsCounter counts.
+type StatisticsCounterKind uint8
+
+const (
+	StatisticsCounterKind_SeqScan StatisticsCounterKind = iota
+	StatisticsCounterKind_IndexScan
+	StatisticsCounterKind_Insert
+	StatisticsCounterKind_Update
+	StatisticsCounterKind_Delete
+)
+
+// StatisticsCounter counts the rows of its child, which reads from or writes to a table, and adds them to the table's
+// statistics once the child has been read. Rows are passed through unchanged, and the node is omitted when a plan is
+// displayed, so that it has no effect on the statement besides its statistics.
+type StatisticsCounter struct {
+	child    sql.Node
+	kind     StatisticsCounterKind
+	relation pgstat.Relation
+	index    string
+}
+
+var _ sql.ExecSourceRel = (*StatisticsCounter)(nil)
+var _ sql.Describable = (*StatisticsCounter)(nil)
+
+// NewStatisticsCounter returns a new *StatisticsCounter. The index is only used by index scans.
+func NewStatisticsCounter(child sql.Node, kind StatisticsCounterKind, relation pgstat.Relation, index string) *StatisticsCounter {
+	return &StatisticsCounter{
+		child:    child,
+		kind:     kind,
+		relation: relation,
+		index:    index,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (s *StatisticsCounter) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return s.child.CheckPrivileges(ctx, opChecker)
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (s *StatisticsCounter) Children() []sql.Node {
+	return []sql.Node{s.child}
+}
+
+// Describe implements the interface sql.Describable.
+func (s *StatisticsCounter) Describe(options sql.DescribeOptions) string {
+	return sql.Describe(s.child, options)
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (s *StatisticsCounter) IsReadOnly() bool {
+	return s.child.IsReadOnly()
+}
+
+// Kind returns the activity that is counted.
+func (s *StatisticsCounter) Kind() StatisticsCounterKind {
+	return s.kind
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (s *StatisticsCounter) Resolved() bool {
+	return s.child.Resolved()
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (s *StatisticsCounter) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	childIter, err := rowexec.DefaultBuilder.Build(ctx, s.child, r)
+	if err != nil {
+		return nil, err
+	}
+	return &statisticsCounterIter{
+		counter:   s,
+		childIter: childIter,
+	}, nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (s *StatisticsCounter) Schema() sql.Schema {
+	return s.child.Schema()
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (s *StatisticsCounter) String() string {
+	return s.child.String()
+}
+
+// DebugString returns the debug string of the child, as the node is omitted when a plan is displayed.
+func (s *StatisticsCounter) DebugString() string {
+	return sql.DebugString(s.child)
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (s *StatisticsCounter) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 1)
+	}
+	ns := *s
+	ns.child = children[0]
+	return &ns, nil
+}
+
+// record adds the given number of rows to the statistics of the counter's table.
+func (s *StatisticsCounter) record(rows int64) {
+	switch s.kind {
+	case StatisticsCounterKind_SeqScan:
+		pgstat.CountSeqScan(s.relation, rows)
+	case StatisticsCounterKind_IndexScan:
+		pgstat.CountIndexScan(s.relation, s.index, rows)
+	case StatisticsCounterKind_Insert:
+		pgstat.CountInserts(s.relation, rows)
+	case StatisticsCounterKind_Update:
+		pgstat.CountUpdates(s.relation, rows)
+	case StatisticsCounterKind_Delete:
+		pgstat.CountDeletes(s.relation, rows)
+	}
+}
+
+// statisticsCounterIter is the iterator for *StatisticsCounter.
+type statisticsCounterIter struct {
+	counter   *StatisticsCounter
+	childIter sql.RowIter
+	rows      int64
+	recorded  bool
+}
+
+var _ sql.RowIter = (*statisticsCounterIter)(nil)
+
+// Next implements the interface sql.RowIter.
+func (iter *statisticsCounterIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := iter.childIter.Next(ctx)
+	if err != nil {
+		if err == io.EOF {
+			iter.recordOnce()
+		}
+		return nil, err
+	}
+	iter.rows++
+	return row, nil
+}
+
+// Close implements the interface sql.RowIter.
+func (iter *statisticsCounterIter) Close(ctx *sql.Context) error {
+	iter.recordOnce()
+	return iter.childIter.Close(ctx)
+}
+
+// recordOnce adds the counted rows to the table's statistics, unless they've already been added.
+func (iter *statisticsCounterIter) recordOnce() {
+	if !iter.recorded {
+		iter.recorded = true
+		iter.counter.record(iter.rows)
+	}
+}
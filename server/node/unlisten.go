@@ -0,0 +1,95 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/server/notifications"
+)
+
+// Unlisten handles the UNLISTEN statement. An empty channel stops listening on all channels.
+type Unlisten struct {
+	channel string
+}
+
+var _ sql.ExecSourceRel = (*Unlisten)(nil)
+var _ vitess.Injectable = (*Unlisten)(nil)
+
+// NewUnlisten returns a new *Unlisten. An empty channel represents UNLISTEN *.
+func NewUnlisten(channel string) *Unlisten {
+	return &Unlisten{channel: channel}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (u *Unlisten) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (u *Unlisten) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (u *Unlisten) IsReadOnly() bool {
+	return true
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (u *Unlisten) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (u *Unlisten) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	var err error
+	if len(u.channel) == 0 {
+		err = notifications.UnlistenAll(ctx.Session.ID())
+	} else {
+		err = notifications.Unlisten(ctx.Session.ID(), u.channel)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (u *Unlisten) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (u *Unlisten) String() string {
+	return "UNLISTEN"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (u *Unlisten) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(u, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (u *Unlisten) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return u, nil
+}
@@ -0,0 +1,271 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	vitess "github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/core/unvalidated"
+	"github.com/dolthub/doltgresql/postgres/parser/parser"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// ValidateConstraint handles the ALTER TABLE ... VALIDATE CONSTRAINT statement. Constraints that were added using NOT
+// VALID are verified against the existing rows, and are considered validated once every row satisfies them.
+type ValidateConstraint struct {
+	schema     string
+	table      string
+	constraint string
+	runner     StatementRunner
+}
+
+var _ sql.ExecSourceRel = (*ValidateConstraint)(nil)
+var _ vitess.Injectable = (*ValidateConstraint)(nil)
+
+// NewValidateConstraint returns a new *ValidateConstraint.
+func NewValidateConstraint(schema string, table string, constraint string) *ValidateConstraint {
+	return &ValidateConstraint{
+		schema:     schema,
+		table:      table,
+		constraint: constraint,
+	}
+}
+
+// CheckPrivileges implements the interface sql.ExecSourceRel.
+func (c *ValidateConstraint) CheckPrivileges(ctx *sql.Context, opChecker sql.PrivilegedOperationChecker) bool {
+	// Only the owner of the table may validate its constraints, which is checked in RowIter once the table's schema has
+	// been resolved
+	return true
+}
+
+// Children implements the interface sql.ExecSourceRel.
+func (c *ValidateConstraint) Children() []sql.Node {
+	return nil
+}
+
+// IsReadOnly implements the interface sql.ExecSourceRel.
+func (c *ValidateConstraint) IsReadOnly() bool {
+	return false
+}
+
+// Resolved implements the interface sql.ExecSourceRel.
+func (c *ValidateConstraint) Resolved() bool {
+	return true
+}
+
+// RowIter implements the interface sql.ExecSourceRel.
+func (c *ValidateConstraint) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
+	schema := c.schema
+	if len(c.schema) == 0 {
+		var err error
+		schema, err = core.GetCurrentSchema(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	tableName := doltdb.TableName{Name: c.table, Schema: schema}
+	table, err := core.GetTableFromContext(ctx, tableName)
+	if err != nil {
+		return nil, err
+	}
+	if table == nil {
+		return nil, fmt.Errorf(`relation "%s" does not exist`, c.table)
+	}
+	if obj := auth.TableObject(ctx.GetCurrentDatabase(), schema, c.table); !auth.IsOwner(currentRole(ctx).Name, obj) {
+		return nil, auth.NotOwnerError(obj)
+	}
+	sch, err := table.GetSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, check := range sch.Checks().AllChecks() {
+		if check.Name() == c.constraint {
+			return c.validateRows(ctx, tableName)
+		}
+	}
+	fkCollection, err := core.GetForeignKeyCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	declaredFks, _ := fkCollection.KeysForTable(tableName)
+	for _, fk := range declaredFks {
+		if fk.Name == c.constraint {
+			return c.validateRows(ctx, tableName)
+		}
+	}
+	if idx := sch.Indexes().GetByName(c.constraint); idx != nil && idx.IsUnique() {
+		return nil, fmt.Errorf(`constraint "%s" of relation "%s" is not a foreign key or check constraint`,
+			c.constraint, c.table)
+	}
+	return nil, fmt.Errorf(`constraint "%s" of relation "%s" does not exist`, c.constraint, c.table)
+}
+
+// Schema implements the interface sql.ExecSourceRel.
+func (c *ValidateConstraint) Schema() sql.Schema {
+	return nil
+}
+
+// String implements the interface sql.ExecSourceRel.
+func (c *ValidateConstraint) String() string {
+	return "VALIDATE CONSTRAINT"
+}
+
+// WithChildren implements the interface sql.ExecSourceRel.
+func (c *ValidateConstraint) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return plan.NillaryWithChildren(c, children...)
+}
+
+// WithResolvedChildren implements the interface vitess.Injectable.
+func (c *ValidateConstraint) WithResolvedChildren(children []any) (any, error) {
+	if len(children) != 0 {
+		return nil, fmt.Errorf("invalid vitess child count, expected `0` but got `%d`", len(children))
+	}
+	return c, nil
+}
+
+// WithStatementRunner returns a copy of this node that verifies the existing rows using the given runner.
+func (c *ValidateConstraint) WithStatementRunner(runner StatementRunner) *ValidateConstraint {
+	nc := *c
+	nc.runner = runner
+	return &nc
+}
+
+// validateRows verifies the existing rows of the table against the constraint, if it was added using NOT VALID. Other
+// constraints have already been verified against every row, so there is nothing to validate.
+func (c *ValidateConstraint) validateRows(ctx *sql.Context, tableName doltdb.TableName) (sql.RowIter, error) {
+	collection, err := core.GetUnvalidatedCollectionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	constraint := collection.GetConstraint(tableName, c.constraint)
+	if constraint == nil {
+		return sql.RowsToRowIter(), nil
+	}
+	if c.runner == nil {
+		return nil, fmt.Errorf("VALIDATE CONSTRAINT is missing its statement runner")
+	}
+	switch constraint.Kind {
+	case unvalidated.Kind_Check:
+		err = c.validateCheck(ctx, constraint)
+	case unvalidated.Kind_ForeignKey:
+		err = c.validateForeignKey(ctx, constraint)
+	default:
+		err = fmt.Errorf("cannot validate constraints of kind: %s", constraint.Kind.String())
+	}
+	if err != nil {
+		return nil, pgerrors.Raise(ctx, err)
+	}
+	collection.RemoveConstraint(tableName, c.constraint)
+	if err = core.UpdateUnvalidatedCollection(ctx, collection); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(), nil
+}
+
+// validateCheck returns an error if any existing row violates the CHECK constraint.
+func (c *ValidateConstraint) validateCheck(ctx *sql.Context, constraint *unvalidated.Constraint) error {
+	_, rows, err := c.runQuery(ctx, fmt.Sprintf("SELECT 1 FROM %s WHERE NOT (%s) LIMIT 1;",
+		qualifiedTableName(constraint.Table), constraint.Expression))
+	if err != nil {
+		return err
+	}
+	if len(rows) > 0 {
+		return pgerrors.Newf(pgcode.CheckViolation, `check constraint "%s" of relation "%s" is violated by some row`,
+			constraint.Name, constraint.Table.Name)
+	}
+	return nil
+}
+
+// validateForeignKey returns an error if any existing row references a row that does not exist in the parent table.
+func (c *ValidateConstraint) validateForeignKey(ctx *sql.Context, constraint *unvalidated.Constraint) error {
+	table, err := core.GetSqlTableFromContext(ctx, ctx.GetCurrentDatabase(), constraint.Table)
+	if err != nil {
+		return err
+	}
+	fkTable, ok := table.(sql.ForeignKeyTable)
+	if !ok {
+		return fmt.Errorf(`relation "%s" does not support foreign keys`, constraint.Table.Name)
+	}
+	fks, err := fkTable.GetDeclaredForeignKeys(ctx)
+	if err != nil {
+		return err
+	}
+	var fk *sql.ForeignKeyConstraint
+	for i := range fks {
+		if fks[i].Name == constraint.Name {
+			fk = &fks[i]
+			break
+		}
+	}
+	if fk == nil {
+		return fmt.Errorf(`constraint "%s" of relation "%s" does not exist`, constraint.Name, constraint.Table.Name)
+	}
+	// Rows that have a NULL in any of the columns do not reference the parent table, so they're always valid
+	columns := make([]string, len(fk.Columns))
+	joins := make([]string, len(fk.Columns))
+	filters := make([]string, len(fk.Columns)+1)
+	for i, column := range fk.Columns {
+		columns[i] = "c." + tree.NameString(column)
+		joins[i] = fmt.Sprintf("c.%s = p.%s", tree.NameString(column), tree.NameString(fk.ParentColumns[i]))
+		filters[i] = fmt.Sprintf("c.%s IS NOT NULL", tree.NameString(column))
+	}
+	filters[len(fk.Columns)] = fmt.Sprintf("p.%s IS NULL", tree.NameString(fk.ParentColumns[0]))
+	// Foreign keys may only reference tables in the same schema
+	parentTable := doltdb.TableName{Name: fk.ParentTable, Schema: constraint.Table.Schema}
+	sch, rows, err := c.runQuery(ctx, fmt.Sprintf("SELECT %s FROM %s AS c LEFT JOIN %s AS p ON %s WHERE %s LIMIT 1;",
+		strings.Join(columns, ", "), qualifiedTableName(constraint.Table), qualifiedTableName(parentTable),
+		strings.Join(joins, " AND "), strings.Join(filters, " AND ")))
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	values := make([]string, len(fk.Columns))
+	for i := range fk.Columns {
+		values[i] = exclusionKeyValue(sch[i].Type, rows[0][i])
+	}
+	return pgerrors.Newf(pgcode.ForeignKeyViolation, `insert or update on table "%s" violates foreign key constraint "%s"`,
+		constraint.Table.Name, constraint.Name).
+		WithDetail(fmt.Sprintf(`Key (%s)=(%s) is not present in table "%s".`,
+			strings.Join(fk.Columns, ", "), strings.Join(values, ", "), fk.ParentTable))
+}
+
+// runQuery parses and runs the given query using the statement runner.
+func (c *ValidateConstraint) runQuery(ctx *sql.Context, query string) (sql.Schema, []sql.Row, error) {
+	stmt, err := parser.ParseOne(query)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.runner(ctx, stmt.AST)
+}
+
+// qualifiedTableName returns the table name as it would be written in a query, qualified by its schema if it has one.
+func qualifiedTableName(tableName doltdb.TableName) string {
+	if len(tableName.Schema) == 0 {
+		return tree.NameString(tableName.Name)
+	}
+	return tree.NameString(tableName.Schema) + "." + tree.NameString(tableName.Name)
+}
@@ -0,0 +1,140 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notices
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/messages"
+)
+
+// Notice is a non-error message that is raised during execution, which is sent to the client as a NoticeResponse.
+type Notice struct {
+	Severity messages.ErrorResponseSeverity
+	// SqlStateCode defaults to "00000" (successful_completion) when empty.
+	SqlStateCode string
+	Message      string
+	Detail       string
+	Hint         string
+}
+
+// severityRanks orders the severities that client_min_messages may filter, from least to most severe. INFO is not
+// included, as Postgres always sends it to the client.
+var severityRanks = map[string]int{
+	"debug5":  0,
+	"debug4":  1,
+	"debug3":  2,
+	"debug2":  3,
+	"debug1":  4,
+	"debug":   4,
+	"log":     5,
+	"notice":  6,
+	"warning": 7,
+	"error":   8,
+}
+
+// notices holds the notices that each session has raised but which have not yet been sent, keyed by the session's ID.
+var notices = struct {
+	sync.Mutex
+	pending map[uint32][]Notice
+}{pending: make(map[uint32][]Notice)}
+
+// Raise queues the notice to be sent to the session's client. Notices that are below the session's client_min_messages
+// are discarded.
+func Raise(ctx *sql.Context, notice Notice) {
+	RaiseForSession(ctx.Session.ID(), ClientMinMessages(ctx), notice)
+}
+
+// RaiseForSession queues the notice to be sent to the client of the session with the given ID, whose
+// client_min_messages is the given value. This is for notices that are raised from outside of the goroutine that is
+// executing the session's statement, as the session may not be read concurrently.
+func RaiseForSession(sessionID uint32, clientMinMessages string, notice Notice) {
+	if !IsSent(clientMinMessages, notice.Severity) {
+		return
+	}
+	if len(notice.SqlStateCode) == 0 {
+		notice.SqlStateCode = "00000"
+	}
+	notices.Lock()
+	defer notices.Unlock()
+	notices.pending[sessionID] = append(notices.pending[sessionID], notice)
+}
+
+// RaiseNotice is a convenience function that raises a NOTICE with the given message.
+func RaiseNotice(ctx *sql.Context, message string) {
+	Raise(ctx, Notice{Severity: messages.ErrorResponseSeverity_Notice, Message: message})
+}
+
+// RaiseWarning is a convenience function that raises a WARNING with the given message.
+func RaiseWarning(ctx *sql.Context, message string) {
+	Raise(ctx, Notice{Severity: messages.ErrorResponseSeverity_Warning, Message: message})
+}
+
+// Take returns the notices that the session has raised, removing them so that they're only sent once.
+func Take(sessionID uint32) []Notice {
+	notices.Lock()
+	defer notices.Unlock()
+	pending := notices.pending[sessionID]
+	delete(notices.pending, sessionID)
+	return pending
+}
+
+// ToMessage returns the notice as a NoticeResponse message.
+func (n Notice) ToMessage() messages.NoticeResponse {
+	fields := []messages.NoticeResponseField{
+		{Code: 'S', Value: string(n.Severity)},
+		{Code: 'V', Value: string(n.Severity)},
+		{Code: 'C', Value: n.SqlStateCode},
+		{Code: 'M', Value: n.Message},
+	}
+	if len(n.Detail) > 0 {
+		fields = append(fields, messages.NoticeResponseField{Code: 'D', Value: n.Detail})
+	}
+	if len(n.Hint) > 0 {
+		fields = append(fields, messages.NoticeResponseField{Code: 'H', Value: n.Hint})
+	}
+	return messages.NoticeResponse{Fields: fields}
+}
+
+// ClientMinMessages returns the session's client_min_messages, which is the least severe notice that is sent to the
+// session's client.
+func ClientMinMessages(ctx *sql.Context) string {
+	if val, err := ctx.GetSessionVariable(ctx, "client_min_messages"); err == nil {
+		if str, ok := val.(string); ok {
+			return str
+		}
+	}
+	return "notice"
+}
+
+// IsSent returns whether a notice with the given severity should be sent to a client whose client_min_messages is the
+// given value. This is for notices that are sent outside of execution, where there is no context to read it from.
+func IsSent(clientMinMessages string, severity messages.ErrorResponseSeverity) bool {
+	if severity == messages.ErrorResponseSeverity_Info {
+		return true
+	}
+	minimumRank, ok := severityRanks[strings.ToLower(clientMinMessages)]
+	if !ok {
+		minimumRank = severityRanks["notice"]
+	}
+	rank, ok := severityRanks[strings.ToLower(string(severity))]
+	if !ok {
+		return true
+	}
+	return rank >= minimumRank
+}
@@ -0,0 +1,84 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/doltgresql/postgres/connection"
+	"github.com/dolthub/doltgresql/postgres/messages"
+	"github.com/dolthub/doltgresql/server/notifications"
+)
+
+// registerNotifications allows the connection to LISTEN on channels. Notifications that arrive while the connection is
+// idle are sent immediately, and otherwise they're sent once the connection's current transaction has ended.
+func (h *ConnectionHandler) registerNotifications() {
+	notifications.Register(h.mysqlConn.ConnectionID, func() {
+		// Sending happens on its own goroutine so that a slow client cannot block the session that notified it
+		go func() {
+			h.notificationMutex.Lock()
+			defer h.notificationMutex.Unlock()
+			if h.idle {
+				h.sendNotifications()
+			}
+		}()
+	})
+}
+
+// unregisterNotifications stops the connection from listening on all channels.
+func (h *ConnectionHandler) unregisterNotifications() {
+	notifications.Unregister(h.mysqlConn.ConnectionID)
+}
+
+// endTransactionNotifications handles the notifications at the end of a transaction, or at the end of a message cycle
+// that was not within a transaction block. Notifications that were sent are delivered when the transaction succeeded,
+// and discarded otherwise, followed by sending all notifications that have been delivered to this connection.
+func (h *ConnectionHandler) endTransactionNotifications(succeeded bool) {
+	if succeeded {
+		notifications.Commit(h.mysqlConn.ConnectionID)
+	} else {
+		notifications.Rollback(h.mysqlConn.ConnectionID)
+	}
+	h.notificationMutex.Lock()
+	defer h.notificationMutex.Unlock()
+	h.sendNotifications()
+}
+
+// setIdle sets whether the connection is waiting for the client's next query outside of a transaction block, which is
+// the only time that notifications may be sent as they arrive.
+func (h *ConnectionHandler) setIdle(idle bool) {
+	h.notificationMutex.Lock()
+	defer h.notificationMutex.Unlock()
+	h.idle = idle
+	if idle {
+		// Notifications may have arrived between the end of the transaction and now
+		h.sendNotifications()
+	}
+}
+
+// sendNotifications sends every notification that has been delivered to this connection. The notification mutex must
+// be held by the caller.
+func (h *ConnectionHandler) sendNotifications() {
+	for _, notification := range notifications.Take(h.mysqlConn.ConnectionID) {
+		if err := connection.Send(h.Conn(), messages.NotificationResponse{
+			ProcessID: notification.ProcessID,
+			Channel:   notification.Channel,
+			Payload:   notification.Payload,
+		}); err != nil {
+			logrus.WithError(err).Warn("unable to send notification")
+			return
+		}
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifications
+
+import (
+	"fmt"
+	"sync"
+)
+
+// MaxPayloadLength is the longest payload that a notification may have, which matches the Postgres default.
+const MaxPayloadLength = 7999
+
+// Notification is a single notification that was sent on a channel.
+type Notification struct {
+	// ProcessID is the process ID of the session that sent the notification.
+	ProcessID int32
+	Channel   string
+	Payload   string
+}
+
+// session holds the notification state of a single session.
+type session struct {
+	// channels are the channels that the session is listening on.
+	channels map[string]struct{}
+	// pending are the notifications that the session has sent within its current transaction. They are delivered once
+	// the transaction commits, and discarded if it rolls back.
+	pending []Notification
+	// inbox contains the notifications that have been delivered to the session, but which have not yet been sent to
+	// the client.
+	inbox []Notification
+	// wake is called whenever notifications are added to the inbox.
+	wake func()
+}
+
+// sessions holds the notification state of every session, keyed by the session's ID. Sessions only exist here while
+// they're registered, so notifications are never delivered to a session that has disconnected.
+var sessions = struct {
+	sync.Mutex
+	byID map[uint32]*session
+}{byID: make(map[uint32]*session)}
+
+// Register adds the session so that it may listen on channels. The given function is called whenever notifications are
+// delivered to the session, and must not block.
+func Register(sessionID uint32, wake func()) {
+	sessions.Lock()
+	defer sessions.Unlock()
+	sessions.byID[sessionID] = &session{
+		channels: make(map[string]struct{}),
+		wake:     wake,
+	}
+}
+
+// Unregister removes the session, which stops it from listening on all channels.
+func Unregister(sessionID uint32) {
+	sessions.Lock()
+	defer sessions.Unlock()
+	delete(sessions.byID, sessionID)
+}
+
+// Listen adds the channel to the channels that the session is listening on.
+func Listen(sessionID uint32, channel string) error {
+	sessions.Lock()
+	defer sessions.Unlock()
+	s, err := getSession(sessionID)
+	if err != nil {
+		return err
+	}
+	s.channels[channel] = struct{}{}
+	return nil
+}
+
+// Unlisten removes the channel from the channels that the session is listening on. It is not an error if the session
+// was not listening on the channel.
+func Unlisten(sessionID uint32, channel string) error {
+	sessions.Lock()
+	defer sessions.Unlock()
+	s, err := getSession(sessionID)
+	if err != nil {
+		return err
+	}
+	delete(s.channels, channel)
+	return nil
+}
+
+// UnlistenAll stops the session from listening on every channel.
+func UnlistenAll(sessionID uint32) error {
+	sessions.Lock()
+	defer sessions.Unlock()
+	s, err := getSession(sessionID)
+	if err != nil {
+		return err
+	}
+	clear(s.channels)
+	return nil
+}
+
+// Notify queues a notification from the session, which will be delivered to all listening sessions once the session's
+// transaction commits. As with Postgres, a notification that duplicates one already queued within the same transaction
+// is dropped.
+func Notify(sessionID uint32, channel string, payload string) error {
+	if len(channel) == 0 {
+		return fmt.Errorf("channel name cannot be empty")
+	}
+	if len(payload) > MaxPayloadLength {
+		return fmt.Errorf("payload string too long")
+	}
+	sessions.Lock()
+	defer sessions.Unlock()
+	s, err := getSession(sessionID)
+	if err != nil {
+		return err
+	}
+	notification := Notification{
+		ProcessID: int32(sessionID),
+		Channel:   channel,
+		Payload:   payload,
+	}
+	for _, pending := range s.pending {
+		if pending == notification {
+			return nil
+		}
+	}
+	s.pending = append(s.pending, notification)
+	return nil
+}
+
+// Commit delivers all notifications that the session has queued to every session that is listening on their channels,
+// including the session itself.
+func Commit(sessionID uint32) {
+	var toWake []func()
+	func() {
+		sessions.Lock()
+		defer sessions.Unlock()
+		s, ok := sessions.byID[sessionID]
+		if !ok || len(s.pending) == 0 {
+			return
+		}
+		woken := make(map[uint32]struct{})
+		for _, notification := range s.pending {
+			for id, listener := range sessions.byID {
+				if _, ok = listener.channels[notification.Channel]; !ok {
+					continue
+				}
+				listener.inbox = append(listener.inbox, notification)
+				if _, ok = woken[id]; !ok && listener.wake != nil {
+					woken[id] = struct{}{}
+					toWake = append(toWake, listener.wake)
+				}
+			}
+		}
+		s.pending = nil
+	}()
+	// The sessions are woken outside of the lock, as waking may take notifications from the inbox
+	for _, wake := range toWake {
+		wake()
+	}
+}
+
+// Rollback discards all notifications that the session has queued.
+func Rollback(sessionID uint32) {
+	sessions.Lock()
+	defer sessions.Unlock()
+	if s, ok := sessions.byID[sessionID]; ok {
+		s.pending = nil
+	}
+}
+
+// Savepoint returns the number of notifications that the session has queued within its current transaction, which
+// marks the notifications that a later RollbackToSavepoint keeps.
+func Savepoint(sessionID uint32) int {
+	sessions.Lock()
+	defer sessions.Unlock()
+	if s, ok := sessions.byID[sessionID]; ok {
+		return len(s.pending)
+	}
+	return 0
+}
+
+// RollbackToSavepoint discards the notifications that the session has queued since the savepoint was created, where
+// |pending| is the number of notifications that Savepoint returned.
+func RollbackToSavepoint(sessionID uint32, pending int) {
+	sessions.Lock()
+	defer sessions.Unlock()
+	if s, ok := sessions.byID[sessionID]; ok && pending < len(s.pending) {
+		s.pending = s.pending[:pending]
+	}
+}
+
+// Take returns all notifications that have been delivered to the session, removing them from its inbox.
+func Take(sessionID uint32) []Notification {
+	sessions.Lock()
+	defer sessions.Unlock()
+	s, ok := sessions.byID[sessionID]
+	if !ok {
+		return nil
+	}
+	inbox := s.inbox
+	s.inbox = nil
+	return inbox
+}
+
+// getSession returns the session with the given ID. The lock must be held by the caller.
+func getSession(sessionID uint32) (*session, error) {
+	s, ok := sessions.byID[sessionID]
+	if !ok {
+		return nil, fmt.Errorf("notifications are not supported on this connection")
+	}
+	return s, nil
+}
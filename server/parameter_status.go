@@ -0,0 +1,266 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/doltgresql/postgres/connection"
+	"github.com/dolthub/doltgresql/postgres/messages"
+	"github.com/dolthub/doltgresql/postgres/parser/lex"
+	pgconfig "github.com/dolthub/doltgresql/server/config"
+)
+
+// reportedParameter is a configuration parameter whose value is reported to the client through a ParameterStatus
+// message, both on startup and whenever the value changes.
+type reportedParameter struct {
+	// name is the name as reported to the client, which uses the same casing as Postgres.
+	name string
+	// isBool is set for parameters whose values are reported as "on" or "off".
+	isBool bool
+}
+
+// reportedParameters are the parameters that are reported to the client. Drivers depend on several of these, such as
+// integer_datetimes, to determine how values should be interpreted.
+var reportedParameters = []reportedParameter{
+	{name: "application_name"},
+	{name: "client_encoding"},
+	{name: "DateStyle"},
+	{name: "integer_datetimes", isBool: true},
+	{name: "server_encoding"},
+	{name: "server_version"},
+	{name: "standard_conforming_strings", isBool: true},
+	{name: "TimeZone"},
+}
+
+// startupConnectionParameters are the parameters of a startup message that do not name configuration parameters.
+var startupConnectionParameters = map[string]struct{}{
+	"user":        {},
+	"database":    {},
+	"options":     {},
+	"replication": {},
+}
+
+// applyStartupParameters sets the configuration parameters that were given in the startup message, either directly or
+// through the command-line style "options" parameter. As with Postgres, an invalid parameter terminates the connection.
+func (h *ConnectionHandler) applyStartupParameters(startupMessage messages.StartupMessage) error {
+	var names []string
+	settings := make(map[string]string)
+	for name, value := range startupMessage.Parameters {
+		if _, ok := startupConnectionParameters[name]; ok {
+			continue
+		}
+		names = append(names, name)
+		settings[name] = value
+	}
+	optionSettings, err := parseStartupOptions(startupMessage.Parameters["options"])
+	if err != nil {
+		return h.sendStartupParameterError(err)
+	}
+	// Settings from the options override any that were given directly, which matches the order that Postgres uses
+	for _, setting := range optionSettings {
+		if _, ok := settings[setting[0]]; !ok {
+			names = append(names, setting[0])
+		}
+		settings[setting[0]] = setting[1]
+	}
+	for _, name := range names {
+		if !pgconfig.IsValidPostgresConfigParameter(name) {
+			return h.sendStartupParameterError(fmt.Errorf(`unrecognized configuration parameter "%s"`, name))
+		}
+		var query bytes.Buffer
+		query.WriteString("SET ")
+		query.WriteString(strings.ToLower(name))
+		query.WriteString(" TO ")
+		lex.EncodeSQLString(&query, settings[name])
+		convertedQuery, err := h.convertQuery(query.String())
+		if err != nil {
+			return h.sendStartupParameterError(err)
+		}
+		if err = h.comQuery(convertedQuery, func(*sqltypes.Result, bool) error { return nil }); err != nil {
+			return h.sendStartupParameterError(fmt.Errorf(`invalid value for parameter "%s": "%s"`, name, settings[name]))
+		}
+	}
+	return nil
+}
+
+// sendStartupParameterError sends the error to the client as a fatal error, since the connection will be terminated.
+// The given error is returned.
+func (h *ConnectionHandler) sendStartupParameterError(err error) error {
+	_ = connection.Send(h.Conn(), messages.ErrorResponse{
+		Severity:     messages.ErrorResponseSeverity_Fatal,
+		SqlStateCode: "22023",
+		Message:      err.Error(),
+		Optional: messages.ErrorResponseOptionalFields{
+			Routine: "InitPostgres",
+		},
+	})
+	return err
+}
+
+// parseStartupOptions parses the "options" startup parameter, returning the name and value of each configuration
+// parameter that it sets. Options are separated by spaces, with a backslash escaping the following character, and
+// parameters are given as either "-c name=value" or "--name=value".
+func parseStartupOptions(options string) ([][2]string, error) {
+	var args []string
+	var current strings.Builder
+	inArg := false
+	for i := 0; i < len(options); i++ {
+		switch c := options[i]; {
+		case c == '\\' && i+1 < len(options):
+			i++
+			current.WriteByte(options[i])
+			inArg = true
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteByte(c)
+			inArg = true
+		}
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+
+	var settings [][2]string
+	for i := 0; i < len(args); i++ {
+		var setting string
+		switch arg := args[i]; {
+		case arg == "-c":
+			if i+1 >= len(args) {
+				return nil, fmt.Errorf("invalid command-line argument for server process: %s", arg)
+			}
+			i++
+			setting = args[i]
+		case strings.HasPrefix(arg, "-c"):
+			setting = arg[2:]
+		case strings.HasPrefix(arg, "--"):
+			setting = arg[2:]
+		default:
+			return nil, fmt.Errorf("invalid command-line argument for server process: %s", arg)
+		}
+		name, value, ok := strings.Cut(setting, "=")
+		if !ok {
+			if strings.HasPrefix(args[i], "--") {
+				return nil, fmt.Errorf("--%s requires a value", name)
+			}
+			return nil, fmt.Errorf("-c %s requires a value", name)
+		}
+		// Postgres treats dashes in parameter names given on the command line as underscores
+		settings = append(settings, [2]string{strings.ReplaceAll(name, "-", "_"), value})
+	}
+	return settings, nil
+}
+
+// reportParameterStatus sends a ParameterStatus message for each reported parameter whose value differs from the value
+// that was last reported to the client.
+func (h *ConnectionHandler) reportParameterStatus() error {
+	if h.reportedParameters == nil {
+		h.reportedParameters = make(map[string]string)
+	}
+	for _, param := range reportedParameters {
+		value, err := h.getReportedParameterValue(param)
+		if err != nil {
+			logrus.WithError(err).Warnf("unable to read parameter %s", param.name)
+			continue
+		}
+		if reported, ok := h.reportedParameters[param.name]; ok && reported == value {
+			continue
+		}
+		if err = connection.Send(h.Conn(), messages.ParameterStatus{
+			Name:  param.name,
+			Value: value,
+		}); err != nil {
+			return err
+		}
+		h.reportedParameters[param.name] = value
+	}
+	return nil
+}
+
+// getReportedParameterValue returns the session's current value of the parameter, formatted as Postgres reports it.
+func (h *ConnectionHandler) getReportedParameterValue(param reportedParameter) (string, error) {
+	if param.name == "server_version" {
+		return pgconfig.ServerVersion(), nil
+	}
+	value, err := h.showParameter(param.name)
+	if err != nil {
+		return "", err
+	}
+	if param.isBool {
+		if isParameterOn(value) {
+			value = "on"
+		} else {
+			value = "off"
+		}
+	}
+	return value, nil
+}
+
+// isParameterOn returns whether the value of a boolean configuration parameter, as displayed by SHOW, is on.
+func isParameterOn(value string) bool {
+	switch strings.ToLower(value) {
+	case "1", "on", "true":
+		return true
+	default:
+		return false
+	}
+}
+
+// showParameter returns the session's current value of the given configuration parameter, as displayed by SHOW.
+func (h *ConnectionHandler) showParameter(name string) (string, error) {
+	query, err := h.convertQuery("SHOW " + strings.ToLower(name))
+	if err != nil {
+		return "", err
+	}
+	var value string
+	err = h.comQuery(query, func(res *sqltypes.Result, more bool) error {
+		if len(res.Rows) > 0 && len(res.Rows[0]) > 0 {
+			value = res.Rows[0][0].ToString()
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+// setParameter sets the session's value of the given configuration parameter, as though by a SET statement.
+func (h *ConnectionHandler) setParameter(name string, value string) error {
+	var query bytes.Buffer
+	query.WriteString("SET ")
+	query.WriteString(name)
+	query.WriteString(" TO ")
+	lex.EncodeSQLString(&query, value)
+	convertedQuery, err := h.convertQuery(query.String())
+	if err != nil {
+		return err
+	}
+	if err = h.comQuery(convertedQuery, func(*sqltypes.Result, bool) error { return nil }); err != nil {
+		return err
+	}
+	h.parametersChanged = true
+	return nil
+}
@@ -0,0 +1,210 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgerrors
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	cerrors "github.com/cockroachdb/errors"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/vitess/go/mysql"
+	goerrors "gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/pgerror"
+	"github.com/dolthub/doltgresql/server/locks"
+)
+
+// mysqlCodes maps the MySQL error numbers that the engine reports to their SQLSTATE codes.
+var mysqlCodes = map[int]pgcode.Code{
+	mysql.ERNoDb:               pgcode.InvalidCatalogName,
+	mysql.ERBadDb:              pgcode.InvalidCatalogName,
+	mysql.ERDbCreateExists:     pgcode.DuplicateDatabase,
+	mysql.ERNoSuchTable:        pgcode.UndefinedTable,
+	mysql.ERBadTable:           pgcode.UndefinedTable,
+	mysql.ERUnknownTable:       pgcode.UndefinedTable,
+	mysql.ERTableExists:        pgcode.DuplicateRelation,
+	mysql.ERBadFieldError:      pgcode.UndefinedColumn,
+	mysql.ERDupFieldName:       pgcode.DuplicateColumn,
+	mysql.ERDupEntry:           pgcode.UniqueViolation,
+	mysql.ERBadNullError:       pgcode.NotNullViolation,
+	mysql.ErNoReferencedRow2:   pgcode.ForeignKeyViolation,
+	mysql.ERRowIsReferenced2:   pgcode.ForeignKeyViolation,
+	mysql.ERSubqueryNo1Row:     pgcode.CardinalityViolation,
+	mysql.ERLockDeadlock:       pgcode.SerializationFailure,
+	mysql.ERLockWaitTimeout:    pgcode.LockNotAvailable,
+	mysql.ERParseError:         pgcode.Syntax,
+	mysql.ERSyntaxError:        pgcode.Syntax,
+	mysql.ERSPDoesNotExist:     pgcode.UndefinedFunction,
+	mysql.ERDataTooLong:        pgcode.StringDataRightTruncation,
+	mysql.ERWarnDataOutOfRange: pgcode.NumericValueOutOfRange,
+	mysql.ERQueryInterrupted:   pgcode.QueryCanceled,
+}
+
+// kindCodes maps the engine's error kinds to their SQLSTATE codes.
+var kindCodes = []struct {
+	kind *goerrors.Kind
+	code pgcode.Code
+}{
+	{sql.ErrPrimaryKeyViolation, pgcode.UniqueViolation},
+	{sql.ErrUniqueKeyViolation, pgcode.UniqueViolation},
+	{sql.ErrDuplicateEntry, pgcode.UniqueViolation},
+	{sql.ErrForeignKeyChildViolation, pgcode.ForeignKeyViolation},
+	{sql.ErrForeignKeyParentViolation, pgcode.ForeignKeyViolation},
+	{sql.ErrInsertIntoNonNullableProvidedNull, pgcode.NotNullViolation},
+	{sql.ErrInsertIntoNonNullableDefaultNullColumn, pgcode.NotNullViolation},
+	{sql.ErrCheckConstraintViolated, pgcode.CheckViolation},
+	{sql.ErrTableNotFound, pgcode.UndefinedTable},
+	{sql.ErrTableAlreadyExists, pgcode.DuplicateRelation},
+	{sql.ErrColumnNotFound, pgcode.UndefinedColumn},
+	{sql.ErrUnknownColumn, pgcode.UndefinedColumn},
+	{sql.ErrColumnExists, pgcode.DuplicateColumn},
+	{sql.ErrDatabaseNotFound, pgcode.InvalidCatalogName},
+	{sql.ErrDatabaseExists, pgcode.DuplicateDatabase},
+	{sql.ErrFunctionNotFound, pgcode.UndefinedFunction},
+	{sql.ErrExpectedSingleRow, pgcode.CardinalityViolation},
+	{sql.ErrLockDeadlock, pgcode.SerializationFailure},
+	{sql.ErrReadOnly, pgcode.ReadOnlySQLTransaction},
+	{sql.ErrReadOnlyTransaction, pgcode.ReadOnlySQLTransaction},
+	{locks.ErrDeadlockDetected, pgcode.DeadlockDetected},
+	{locks.ErrRelationLockNotAvailable, pgcode.LockNotAvailable},
+	{locks.ErrRowLockNotAvailable, pgcode.LockNotAvailable},
+}
+
+// kindPatterns match the messages of each error kind in kindCodes, in the same order. The kind of an error is lost once
+// the engine converts it into a MySQL error, so the message is all that remains.
+var kindPatterns []*regexp.Regexp
+
+func init() {
+	// Formatting verbs are replaced after quoting the message, which leaves the verbs unchanged
+	verbs := regexp.MustCompile(`%[-+# 0-9.]*[a-zA-Z]`)
+	kindPatterns = make([]*regexp.Regexp, len(kindCodes))
+	for i, kc := range kindCodes {
+		kindPatterns[i] = regexp.MustCompile("^" + verbs.ReplaceAllString(regexp.QuoteMeta(kc.kind.Message), ".*"))
+	}
+}
+
+var (
+	// errnoSuffix matches the suffix that MySQL errors add to their message.
+	errnoSuffix = regexp.MustCompile(` \(errno \d+\) \(sqlstate \w+\)$`)
+	// checkViolation matches the message of a check constraint violation, capturing the constraint's name.
+	checkViolation = regexp.MustCompile(`^Check constraint "(.*)" violated`)
+	// foreignKeyViolation matches the message of a foreign key violation, capturing whether the child or parent row was
+	// changed, along with the names of the constraint, the table, the referenced table, and the key.
+	foreignKeyViolation = regexp.MustCompile("cannot (?:add or update a (child)|delete or update a parent) row - " +
+		"Foreign key violation on fk: `([^`]*)`, table: `([^`]*)`, referenced table: `([^`]*)`, key: `\\[(.*)\\]`")
+	// notNullViolation matches the messages of not-null violations, capturing the column's name.
+	notNullViolation = regexp.MustCompile(`^(?:column name '(.*)' is non-nullable|Field '(.*)' doesn't have a default value)`)
+)
+
+// FromError returns the given error as an *Error, with the most specific SQLSTATE code that could be determined.
+// Errors without a more specific code are reported as internal errors. Returns nil if the error is nil.
+func FromError(err error) *Error {
+	if err == nil {
+		return nil
+	}
+	if pgErr, ok := As(err); ok {
+		return pgErr
+	}
+	// Errors from the parser already carry Postgres fields
+	if code := pgerror.GetPGCode(err); code != pgcode.Uncategorized && code != pgcode.Internal && len(code.String()) > 0 {
+		return &Error{
+			Code:     code,
+			Message:  err.Error(),
+			Detail:   cerrors.FlattenDetails(err),
+			Hint:     cerrors.FlattenHints(err),
+			Position: pgerror.GetPosition(err),
+			cause:    err,
+		}
+	}
+
+	pgErr := &Error{Code: pgcode.Internal, Message: err.Error(), cause: err}
+	var mysqlErr *mysql.SQLError
+	switch {
+	case errors.As(err, &mysqlErr):
+		pgErr.Message = errnoSuffix.ReplaceAllString(mysqlErr.Message, "")
+		if code, ok := mysqlCodes[mysqlErr.Num]; ok {
+			pgErr.Code = code
+		} else {
+			for i, pattern := range kindPatterns {
+				if pattern.MatchString(pgErr.Message) {
+					pgErr.Code = kindCodes[i].code
+					break
+				}
+			}
+		}
+	case errors.Is(err, dsess.ErrRetryTransaction):
+		pgErr.Code = pgcode.SerializationFailure
+	case errors.Is(err, dsess.ErrUnresolvedConflictsCommit) || errors.Is(err, dsess.ErrUnresolvedConflictsAutoCommit):
+		pgErr.Code = pgcode.TransactionRollback
+	default:
+		kindErr := unwrapEngineError(err)
+		for _, kc := range kindCodes {
+			if kc.kind.Is(kindErr) {
+				pgErr.Code = kc.code
+				break
+			}
+		}
+	}
+	// Some errors are only distinguishable by their message, as the engine reports them using a generic error number
+	if matches := checkViolation.FindStringSubmatch(pgErr.Message); matches != nil {
+		pgErr.Code = pgcode.CheckViolation
+		pgErr.Constraint = matches[1]
+	} else if matches = foreignKeyViolation.FindStringSubmatch(pgErr.Message); matches != nil {
+		isChild, constraint, table, parentTable, key := len(matches[1]) > 0, matches[2], matches[3], matches[4], matches[5]
+		pgErr.Code = pgcode.ForeignKeyViolation
+		pgErr.Constraint = constraint
+		pgErr.Table = table
+		// The engine only reports the values of the key, so the detail does not name the key's columns
+		if isChild {
+			pgErr.Message = fmt.Sprintf(`insert or update on table "%s" violates foreign key constraint "%s"`, table, constraint)
+			pgErr.Detail = fmt.Sprintf(`Key (%s) is not present in table "%s".`, key, parentTable)
+		} else {
+			pgErr.Message = fmt.Sprintf(`update or delete on table "%s" violates foreign key constraint "%s" on table "%s"`,
+				parentTable, constraint, table)
+			pgErr.Detail = fmt.Sprintf(`Key (%s) is still referenced from table "%s".`, key, table)
+		}
+	} else if matches = notNullViolation.FindStringSubmatch(pgErr.Message); matches != nil {
+		pgErr.Code = pgcode.NotNullViolation
+		pgErr.Column = matches[1] + matches[2]
+	} else if pgErr.Message == dsess.ErrRetryTransaction.Error() {
+		pgErr.Code = pgcode.SerializationFailure
+	} else if pgErr.Message == dsess.ErrUnresolvedConflictsCommit.Error() ||
+		pgErr.Message == dsess.ErrUnresolvedConflictsAutoCommit.Error() {
+		// Merge conflicts that are not resolved roll back the transaction
+		pgErr.Code = pgcode.TransactionRollback
+	} else if strings.Contains(pgErr.Message, "division by zero") {
+		pgErr.Code = pgcode.DivisionByZero
+	}
+	return pgErr
+}
+
+// unwrapEngineError returns the error that the engine's wrapper errors were created from.
+func unwrapEngineError(err error) error {
+	for {
+		switch e := err.(type) {
+		case sql.WrappedInsertError:
+			err = e.Cause
+		case sql.WrappedTypeConversionError:
+			err = e.Err
+		default:
+			return err
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgerrors
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dolthub/doltgresql/postgres/messages"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+)
+
+// Error is an error that carries every field of a Postgres error response. Clients rely on the SQLSTATE code in
+// particular to decide whether to retry a transaction, or to detect constraint violations, so errors that are sent to a
+// client should have the most specific code that applies.
+type Error struct {
+	// Code is the SQLSTATE code of the error.
+	Code pgcode.Code
+	// Severity defaults to ERROR when empty.
+	Severity messages.ErrorResponseSeverity
+	// Message is the primary, human-readable message.
+	Message string
+	// Detail is an optional secondary message that gives more information about the problem.
+	Detail string
+	// Hint is an optional suggestion of what to do about the problem.
+	Hint string
+	// Position is the position of the error within the query string, counted in characters starting from 1. Zero means
+	// that there is no position.
+	Position int32
+	// Schema, Table, Column, DataType, and Constraint name the object that the error is associated with, if any.
+	Schema     string
+	Table      string
+	Column     string
+	DataType   string
+	Constraint string
+	// cause is the error that this error was created from, if any.
+	cause error
+}
+
+var _ error = (*Error)(nil)
+
+// New returns a new *Error with the given code and message.
+func New(code pgcode.Code, message string) *Error {
+	return &Error{Code: code, Message: message}
+}
+
+// Newf returns a new *Error with the given code, and a message using the given format.
+func Newf(code pgcode.Code, format string, args ...any) *Error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// Wrap returns a new *Error with the given code, using the message of the given error. The original error may still be
+// found using errors.Is and errors.As.
+func Wrap(code pgcode.Code, err error) *Error {
+	return &Error{Code: code, Message: err.Error(), cause: err}
+}
+
+// Error implements the interface error.
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the error that this error was created from, if any.
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// WithDetail returns a copy of the error with the given detail.
+func (e *Error) WithDetail(detail string) *Error {
+	nerr := *e
+	nerr.Detail = detail
+	return &nerr
+}
+
+// WithHint returns a copy of the error with the given hint.
+func (e *Error) WithHint(hint string) *Error {
+	nerr := *e
+	nerr.Hint = hint
+	return &nerr
+}
+
+// WithPosition returns a copy of the error with the given position.
+func (e *Error) WithPosition(position int32) *Error {
+	nerr := *e
+	nerr.Position = position
+	return &nerr
+}
+
+// WithTable returns a copy of the error that is associated with the given table.
+func (e *Error) WithTable(schema string, table string) *Error {
+	nerr := *e
+	nerr.Schema = schema
+	nerr.Table = table
+	return &nerr
+}
+
+// WithColumn returns a copy of the error that is associated with the given column.
+func (e *Error) WithColumn(column string) *Error {
+	nerr := *e
+	nerr.Column = column
+	return &nerr
+}
+
+// WithConstraint returns a copy of the error that is associated with the given constraint.
+func (e *Error) WithConstraint(constraint string) *Error {
+	nerr := *e
+	nerr.Constraint = constraint
+	return &nerr
+}
+
+// ToMessage returns the error as an ErrorResponse message.
+func (e *Error) ToMessage() messages.ErrorResponse {
+	severity := e.Severity
+	if len(severity) == 0 {
+		severity = messages.ErrorResponseSeverity_Error
+	}
+	code := e.Code.String()
+	if len(code) == 0 {
+		code = pgcode.Internal.String()
+	}
+	return messages.ErrorResponse{
+		Severity:     severity,
+		SqlStateCode: code,
+		Message:      e.Message,
+		Optional: messages.ErrorResponseOptionalFields{
+			Detail:     e.Detail,
+			Hint:       e.Hint,
+			Position:   e.Position,
+			Schema:     e.Schema,
+			Table:      e.Table,
+			Column:     e.Column,
+			DataType:   e.DataType,
+			Constraint: e.Constraint,
+		},
+	}
+}
+
+// As returns the given error as an *Error, if it is one or wraps one.
+func As(err error) (*Error, bool) {
+	var pgErr *Error
+	if errors.As(err, &pgErr) {
+		return pgErr, true
+	}
+	return nil, false
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgerrors
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// raisedErrors holds the most recent *Error that each session has raised, keyed by the session's ID. The engine converts
+// every error that it returns into a MySQL error, which loses all of the fields besides the message, so errors that are
+// raised during execution are tracked here and retrieved when the error is sent to the client.
+var raisedErrors = struct {
+	sync.Mutex
+	errs map[uint32]*Error
+}{errs: make(map[uint32]*Error)}
+
+// Raise records the given error for the session, if it is an *Error, so that all of its fields are sent to the client.
+// The error is returned unchanged, so this may wrap the return value of a function that is called during execution.
+func Raise(ctx *sql.Context, err error) error {
+	pgErr, ok := As(err)
+	if !ok || ctx == nil || ctx.Session == nil {
+		return err
+	}
+	raisedErrors.Lock()
+	defer raisedErrors.Unlock()
+	raisedErrors.errs[ctx.Session.ID()] = pgErr
+	return err
+}
+
+// ForSession returns the error as an *Error, using the fields of the error that the session raised if it is the same
+// error. Any raised error is cleared, so that it does not apply to any later errors.
+func ForSession(sessionID uint32, err error) *Error {
+	raisedErrors.Lock()
+	raised, ok := raisedErrors.errs[sessionID]
+	delete(raisedErrors.errs, sessionID)
+	raisedErrors.Unlock()
+	// A raised error may have been handled without reaching the client, so we only use it when the messages match
+	if ok && err != nil && strings.Contains(err.Error(), raised.Message) {
+		if _, isPgErr := As(err); !isPgErr {
+			return raised
+		}
+	}
+	return FromError(err)
+}
+
+// errorContexts holds the context lines of the error that each session is in the process of returning, keyed by the
+// session's ID. Like the fields of raised errors, the context would otherwise be lost as the error passes through the
+// engine, so it is retrieved by the connection handler when it sends the error to the client.
+var errorContexts = struct {
+	sync.Mutex
+	lines map[uint32][]string
+}{lines: make(map[uint32][]string)}
+
+// AddContext adds a line to the context of the error that the session is returning. Lines should be added as the error
+// propagates outward, so that the innermost context comes first, which matches the order used by Postgres.
+func AddContext(ctx *sql.Context, line string) {
+	sessionID := ctx.Session.ID()
+	errorContexts.Lock()
+	defer errorContexts.Unlock()
+	errorContexts.lines[sessionID] = append(errorContexts.lines[sessionID], line)
+}
+
+// TakeContext returns the context lines of the error that the session is returning, removing them so that they do not
+// apply to any later errors.
+func TakeContext(sessionID uint32) []string {
+	errorContexts.Lock()
+	defer errorContexts.Unlock()
+	lines := errorContexts.lines[sessionID]
+	delete(errorContexts.lines, sessionID)
+	return lines
+}
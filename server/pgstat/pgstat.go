@@ -0,0 +1,188 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pgstat
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// Relation identifies a table by its database, schema, and name.
+type Relation struct {
+	Database string
+	Schema   string
+	Name     string
+}
+
+// TableCounters are the cumulative statistics of a table, which are reported by pg_stat_user_tables. The counters
+// are kept for the server as a whole, so they include the activity of every session, and of every transaction
+// regardless of whether it committed. Index scans are counted by the table's IndexCounters.
+type TableCounters struct {
+	SeqScan     int64
+	LastSeqScan time.Time
+	SeqTupRead  int64
+	TupIns      int64
+	TupUpd      int64
+	TupDel      int64
+	// ModSinceAnalyze is the number of rows that have been written since the table was last analyzed.
+	ModSinceAnalyze int64
+	LastAnalyze     time.Time
+	AnalyzeCount    int64
+}
+
+// IndexCounters are the cumulative statistics of an index, which are reported by pg_stat_user_indexes.
+type IndexCounters struct {
+	IdxScan     int64
+	LastIdxScan time.Time
+	IdxTupRead  int64
+	IdxTupFetch int64
+}
+
+// indexKey identifies an index by its table and name.
+type indexKey struct {
+	Relation
+	index string
+}
+
+// counters contains the statistics of every table and index that has been used since the server started, or since
+// the statistics of its database were last reset.
+var counters = struct {
+	sync.Mutex
+	tables  map[Relation]*TableCounters
+	indexes map[indexKey]*IndexCounters
+}{tables: make(map[Relation]*TableCounters), indexes: make(map[indexKey]*IndexCounters)}
+
+// CountSeqScan records a sequential scan of the table that read the given number of rows.
+func CountSeqScan(rel Relation, rows int64) {
+	counters.Lock()
+	defer counters.Unlock()
+	table := tableCounters(rel)
+	table.SeqScan++
+	table.LastSeqScan = time.Now()
+	table.SeqTupRead += rows
+}
+
+// CountIndexScan records a scan of the table's index that read the given number of rows.
+func CountIndexScan(rel Relation, index string, rows int64) {
+	counters.Lock()
+	defer counters.Unlock()
+	key := indexKey{Relation: rel, index: index}
+	idx, ok := counters.indexes[key]
+	if !ok {
+		idx = &IndexCounters{}
+		counters.indexes[key] = idx
+	}
+	idx.IdxScan++
+	idx.LastIdxScan = time.Now()
+	idx.IdxTupRead += rows
+	idx.IdxTupFetch += rows
+}
+
+// CountInserts records that the given number of rows were inserted into the table.
+func CountInserts(rel Relation, rows int64) {
+	counters.Lock()
+	defer counters.Unlock()
+	table := tableCounters(rel)
+	table.TupIns += rows
+	table.ModSinceAnalyze += rows
+}
+
+// CountUpdates records that the given number of rows were updated in the table.
+func CountUpdates(rel Relation, rows int64) {
+	counters.Lock()
+	defer counters.Unlock()
+	table := tableCounters(rel)
+	table.TupUpd += rows
+	table.ModSinceAnalyze += rows
+}
+
+// CountDeletes records that the given number of rows were deleted from the table.
+func CountDeletes(rel Relation, rows int64) {
+	counters.Lock()
+	defer counters.Unlock()
+	table := tableCounters(rel)
+	table.TupDel += rows
+	table.ModSinceAnalyze += rows
+}
+
+// CountAnalyze records that the table was analyzed.
+func CountAnalyze(rel Relation) {
+	counters.Lock()
+	defer counters.Unlock()
+	table := tableCounters(rel)
+	table.ModSinceAnalyze = 0
+	table.LastAnalyze = time.Now()
+	table.AnalyzeCount++
+}
+
+// Table returns the statistics of the table, which are all zero for a table that has not been used.
+func Table(rel Relation) TableCounters {
+	counters.Lock()
+	defer counters.Unlock()
+	if table, ok := counters.tables[rel]; ok {
+		return *table
+	}
+	return TableCounters{}
+}
+
+// Index returns the statistics of the table's index, which are all zero for an index that has not been used.
+func Index(rel Relation, index string) IndexCounters {
+	counters.Lock()
+	defer counters.Unlock()
+	if idx, ok := counters.indexes[indexKey{Relation: rel, index: index}]; ok {
+		return *idx
+	}
+	return IndexCounters{}
+}
+
+// DropTable removes the statistics of the table and its indexes, so that a table that is later created with the same
+// name starts without any.
+func DropTable(rel Relation) {
+	counters.Lock()
+	defer counters.Unlock()
+	delete(counters.tables, rel)
+	for key := range counters.indexes {
+		if key.Relation == rel {
+			delete(counters.indexes, key)
+		}
+	}
+}
+
+// Reset removes the statistics of every table and index in the database.
+func Reset(database string) {
+	counters.Lock()
+	defer counters.Unlock()
+	for rel := range counters.tables {
+		if strings.EqualFold(rel.Database, database) {
+			delete(counters.tables, rel)
+		}
+	}
+	for key := range counters.indexes {
+		if strings.EqualFold(key.Database, database) {
+			delete(counters.indexes, key)
+		}
+	}
+}
+
+// tableCounters returns the statistics of the table, creating them if they do not yet exist. The lock must be held.
+func tableCounters(rel Relation) *TableCounters {
+	table, ok := counters.tables[rel]
+	if !ok {
+		table = &TableCounters{}
+		counters.tables[rel] = table
+	}
+	return table
+}
@@ -0,0 +1,168 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plpgsql
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// handledError is an error that is being handled by an exception handler, which is described by GET STACKED
+// DIAGNOSTICS and raised again by RAISE without parameters.
+type handledError struct {
+	err     *pgerrors.Error
+	context []string
+}
+
+// savepointCount is used to give each block that has an EXCEPTION section a unique savepoint name, as the savepoints of
+// nested routines share the same transaction.
+var savepointCount atomic.Uint64
+
+// executeHandledStatements runs the statements of a block that has an EXCEPTION section. Postgres runs such blocks
+// within a subtransaction, so the changes that the statements made are rolled back when an error is handled, which is
+// done here using a savepoint. The values of variables are not rolled back.
+func (e *executor) executeHandledStatements(block *Block, sc *scope) (control, error) {
+	savepoint := fmt.Sprintf("plpgsql_exception_block_%d", savepointCount.Add(1))
+	txSession, _ := e.ctx.Session.(sql.TransactionSession)
+	tx := e.ctx.GetTransaction()
+	if txSession == nil || tx == nil {
+		return control{}, fmt.Errorf("EXCEPTION sections require an active transaction")
+	}
+	if err := txSession.CreateSavepoint(e.ctx, tx, savepoint); err != nil {
+		return control{}, err
+	}
+	ctrl, err := e.executeStatements(block.statements, sc)
+	if err == nil {
+		return ctrl, txSession.ReleaseSavepoint(e.ctx, tx, savepoint)
+	}
+	handled, ok := e.handledError(err)
+	var handler *exceptionHandler
+	if ok {
+		handler = findExceptionHandler(block.handlers, handled.err.Code)
+	}
+	if handler == nil {
+		if releaseErr := txSession.ReleaseSavepoint(e.ctx, tx, savepoint); releaseErr != nil {
+			return control{}, releaseErr
+		}
+		return control{}, err
+	}
+	if err = txSession.RollbackToSavepoint(e.ctx, tx, savepoint); err != nil {
+		return control{}, err
+	}
+	if err = txSession.ReleaseSavepoint(e.ctx, tx, savepoint); err != nil {
+		return control{}, err
+	}
+	e.handling = append(e.handling, handled)
+	defer func() { e.handling = e.handling[:len(e.handling)-1] }()
+	handlerScope := newScope("", sc)
+	handlerScope.variables["sqlstate"] = &variable{name: "sqlstate", typ: pgtypes.Text, typeRef: textTypeRef, value: handled.err.Code.String()}
+	handlerScope.variables["sqlerrm"] = &variable{name: "sqlerrm", typ: pgtypes.Text, typeRef: textTypeRef, value: handled.err.Message}
+	return e.executeStatements(handler.statements, handlerScope)
+}
+
+// handledError returns the given error as it is seen by an exception handler, which includes the context of the
+// routines that it passed through. The error's fields and context are taken from the session, as they no longer apply
+// to the error that the session returns. Returns false if the error may not be handled, such as when the statement was
+// canceled.
+func (e *executor) handledError(err error) (handledError, bool) {
+	if e.ctx.Err() != nil {
+		return handledError{}, false
+	}
+	handled := handledError{context: pgerrors.TakeContext(e.ctx.Session.ID())}
+	if plpgsqlErr, ok := err.(*Error); ok {
+		handled.context = append(handled.context, plpgsqlErr.Context())
+		err = plpgsqlErr.Err
+	}
+	handled.err = pgerrors.ForSession(e.ctx.Session.ID(), err)
+	return handled, true
+}
+
+// findExceptionHandler returns the first handler with a condition that matches the given code. Conditions that name a
+// class of errors match every code within the class. OTHERS matches every code besides those for a canceled statement
+// and a failed assertion, which must be named explicitly. Returns nil if no handler matches.
+func findExceptionHandler(handlers []exceptionHandler, code pgcode.Code) *exceptionHandler {
+	codeStr := code.String()
+	for i := range handlers {
+		for _, condition := range handlers[i].conditions {
+			switch {
+			case condition == "others":
+				if code != pgcode.QueryCanceled && code != pgcode.AssertFailure {
+					return &handlers[i]
+				}
+			case condition == codeStr:
+				return &handlers[i]
+			case strings.HasSuffix(condition, "000") && strings.HasPrefix(codeStr, condition[:2]):
+				return &handlers[i]
+			}
+		}
+	}
+	return nil
+}
+
+// executeGetDiagnostics runs a GET DIAGNOSTICS statement. Stacked diagnostics are only parsed within an exception
+// handler, so there is always an error that is being handled when they're retrieved.
+func (e *executor) executeGetDiagnostics(stmt *stmtGetDiagnostics, sc *scope) error {
+	for i, target := range stmt.targets {
+		v, err := e.lookupTarget(sc, target)
+		if err != nil {
+			return err
+		}
+		if !stmt.isStacked {
+			if err = e.assignValue(v, e.rowCount, pgtypes.Int64, false); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = e.assignValue(v, e.handling[len(e.handling)-1].item(stmt.items[i]), pgtypes.Text, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// item returns the value of the given GET STACKED DIAGNOSTICS item.
+func (h handledError) item(name string) string {
+	switch name {
+	case "returned_sqlstate":
+		return h.err.Code.String()
+	case "message_text":
+		return h.err.Message
+	case "pg_exception_detail":
+		return h.err.Detail
+	case "pg_exception_hint":
+		return h.err.Hint
+	case "pg_exception_context":
+		return strings.Join(h.context, "\n")
+	case "column_name":
+		return h.err.Column
+	case "constraint_name":
+		return h.err.Constraint
+	case "pg_datatype_name":
+		return h.err.DataType
+	case "table_name":
+		return h.err.Table
+	case "schema_name":
+		return h.err.Schema
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,872 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plpgsql
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/doltgresql/postgres/parser/parser"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// StatementRunner executes a single statement, returning the schema and rows of the result. This has the same form as
+// the runner that nodes use to execute the statements within the bodies of routines.
+type StatementRunner func(ctx *sql.Context, stmt tree.Statement) (sql.Schema, []sql.Row, error)
+
+// Parameter is a parameter of a routine, along with the value of the argument that it was called with.
+type Parameter struct {
+	Name  string
+	Type  pgtypes.DoltgresType
+	Value any
+}
+
+// Routine describes the function or procedure whose body is being executed.
+type Routine struct {
+	// Name is the name of the routine, which may be used to qualify references to its parameters.
+	Name string
+	// Signature is the name of the routine along with its parameter types, which is used in the context of errors.
+	Signature  string
+	Parameters []Parameter
+	// OutputParameters are the indexes of the OUT and INOUT parameters.
+	OutputParameters []int
+	// ReturnType is the return type of a function. This is ignored for procedures.
+	ReturnType  pgtypes.DoltgresType
+	ReturnsSet  bool
+	IsProcedure bool
+	// Trigger is only given when a trigger function is called by a trigger.
+	Trigger *TriggerData
+}
+
+// Result is the result of executing the body of a routine.
+type Result struct {
+	// Value is the value returned by a function that does not return a set. Trigger functions return the row as an
+	// []any, or nil when the row should be skipped.
+	Value any
+	// Rows are the values returned by a function that returns a set, using RETURN NEXT and RETURN QUERY.
+	Rows []any
+	// Parameters are the final values of the routine's parameters, which are returned for the output parameters of
+	// procedures.
+	Parameters []any
+}
+
+// Error is an error that occurred while executing a statement within the body of a routine, and contains where the
+// error occurred.
+type Error struct {
+	Err       error
+	Signature string
+	Line      int
+	Statement string
+}
+
+var _ error = (*Error)(nil)
+
+// Error implements the interface error.
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the error that occurred.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Context returns the line that describes where the error occurred, which matches the context given by Postgres.
+func (e *Error) Context() string {
+	return fmt.Sprintf("PL/pgSQL function %s line %d at %s", e.Signature, e.Line, e.Statement)
+}
+
+// controlKind is the kind of control flow change that a statement causes.
+type controlKind uint8
+
+const (
+	controlKind_None controlKind = iota
+	controlKind_Exit
+	controlKind_Continue
+	controlKind_Return
+)
+
+// control is returned by each statement to change the flow of execution, such as exiting a loop or returning from the
+// routine. The label is empty when the innermost loop is the target.
+type control struct {
+	kind  controlKind
+	label string
+}
+
+// executor holds the state of a single execution of a routine's body.
+type executor struct {
+	ctx     *sql.Context
+	runner  StatementRunner
+	routine Routine
+	params  []*variable
+	found   *variable
+	// rowCount is the number of rows processed by the most recent SQL statement, which is returned by GET DIAGNOSTICS.
+	rowCount int64
+	// handling holds the errors that are being handled by exception handlers, with the innermost handler's error last.
+	handling []handledError
+	result   Result
+	returned bool
+}
+
+// Execute runs the given block as the body of the given routine.
+func Execute(ctx *sql.Context, block *Block, routine Routine, runner StatementRunner) (Result, error) {
+	e := &executor{
+		ctx:     ctx,
+		runner:  runner,
+		routine: routine,
+	}
+	// The parameters belong to an outer scope that is labeled with the routine's name, along with FOUND
+	routineScope := newScope(routine.Name, nil)
+	e.params = make([]*variable, len(routine.Parameters))
+	for i, param := range routine.Parameters {
+		typeRef, err := parser.ParseType(param.Type.String())
+		if err != nil {
+			return Result{}, err
+		}
+		e.params[i] = &variable{
+			name:    param.Name,
+			typ:     param.Type,
+			typeRef: typeRef,
+			value:   param.Value,
+		}
+		if len(param.Name) > 0 {
+			routineScope.variables[param.Name] = e.params[i]
+		}
+	}
+	e.found = &variable{name: "found", typ: pgtypes.Bool, typeRef: booleanTypeRef, value: false}
+	routineScope.variables["found"] = e.found
+	if routine.Trigger != nil {
+		declareTriggerVariables(routineScope, routine.Trigger)
+	}
+
+	if _, err := e.executeBlock(block, routineScope); err != nil {
+		return Result{}, err
+	}
+	e.result.Parameters = make([]any, len(e.params))
+	for i, param := range e.params {
+		e.result.Parameters[i] = param.value
+	}
+	if !e.returned && !routine.IsProcedure && !routine.ReturnsSet {
+		if routine.Trigger != nil {
+			return Result{}, pgerrors.Raise(ctx, pgerrors.New(pgcode.RoutineExceptionFunctionExecutedNoReturnStatement,
+				"control reached end of trigger procedure without RETURN"))
+		} else if len(routine.OutputParameters) > 0 {
+			e.result.Value = e.params[routine.OutputParameters[0]].value
+		} else if routine.ReturnType.BaseID() != pgtypes.DoltgresTypeBaseID_Void {
+			return Result{}, pgerrors.Raise(ctx, pgerrors.New(pgcode.RoutineExceptionFunctionExecutedNoReturnStatement,
+				"control reached end of function without RETURN"))
+		}
+	}
+	return e.result, nil
+}
+
+// executeBlock declares the block's variables and runs its statements.
+func (e *executor) executeBlock(block *Block, parent *scope) (control, error) {
+	sc := newScope(block.label, parent)
+	for _, decl := range block.declarations {
+		if err := e.declare(sc, decl); err != nil {
+			return control{}, &Error{Err: err, Signature: e.routine.Signature, Line: decl.line, Statement: "initialization of variable"}
+		}
+	}
+	var ctrl control
+	var err error
+	if len(block.handlers) > 0 {
+		ctrl, err = e.executeHandledStatements(block, sc)
+	} else {
+		ctrl, err = e.executeStatements(block.statements, sc)
+	}
+	if err != nil {
+		return control{}, err
+	}
+	// Exiting a block by its label continues after the block
+	if ctrl.kind == controlKind_Exit && len(block.label) > 0 && ctrl.label == block.label {
+		return control{}, nil
+	}
+	return ctrl, nil
+}
+
+// declare adds the given declaration to the scope, initializing it to its default value.
+func (e *executor) declare(sc *scope, decl declaration) error {
+	if len(decl.aliasFor) > 0 {
+		v, err := e.lookupAlias(sc, decl.aliasFor)
+		if err != nil {
+			return err
+		}
+		sc.variables[decl.name] = v
+		return nil
+	}
+	v := &variable{
+		name:       decl.name,
+		typ:        decl.typ,
+		typeRef:    decl.typeRef,
+		isRecord:   decl.typ == nil,
+		isNotNull:  decl.isNotNull,
+		isConstant: decl.isConstant,
+	}
+	if len(decl.defaultExpr) > 0 {
+		if v.isRecord {
+			return pgerrors.New(pgcode.FeatureNotSupported, "default values for record variables are not yet supported")
+		}
+		// The variable is only visible after its declaration, so the default may reference a variable of an outer block
+		// with the same name
+		val, valType, err := e.evaluate(sc, decl.defaultExpr, v.typeRef)
+		if err != nil {
+			return err
+		}
+		if err = e.assignValue(v, val, valType, true); err != nil {
+			return err
+		}
+	}
+	sc.variables[decl.name] = v
+	return nil
+}
+
+// lookupAlias returns the variable that an ALIAS FOR declaration refers to, which may be a positional parameter.
+func (e *executor) lookupAlias(sc *scope, name string) (*variable, error) {
+	if len(name) > 1 && name[0] == '$' {
+		var idx int
+		if _, err := fmt.Sscanf(name[1:], "%d", &idx); err == nil && idx >= 1 && idx <= len(e.params) {
+			return e.params[idx-1], nil
+		}
+	} else if v := sc.lookup(name); v != nil {
+		return v, nil
+	}
+	return nil, pgerrors.Newf(pgcode.UndefinedObject, `variable "%s" does not exist`, name)
+}
+
+// executeStatements runs each statement in order, stopping early when a statement changes the flow of execution.
+func (e *executor) executeStatements(statements []statement, sc *scope) (control, error) {
+	for _, stmt := range statements {
+		if err := e.ctx.Err(); err != nil {
+			return control{}, err
+		}
+		ctrl, err := e.executeStatement(stmt, sc)
+		if err != nil {
+			if _, ok := err.(*Error); !ok {
+				err = &Error{Err: err, Signature: e.routine.Signature, Line: stmt.statementLine(), Statement: stmt.statementName()}
+			}
+			return control{}, err
+		}
+		if ctrl.kind != controlKind_None {
+			return ctrl, nil
+		}
+	}
+	return control{}, nil
+}
+
+// executeStatement runs a single statement.
+func (e *executor) executeStatement(stmt statement, sc *scope) (control, error) {
+	switch stmt := stmt.(type) {
+	case *stmtAssign:
+		return control{}, e.executeAssign(stmt, sc)
+	case *stmtBlock:
+		return e.executeBlock(stmt.block, sc)
+	case *stmtIf:
+		for _, branch := range stmt.branches {
+			ok, err := e.evaluateCondition(sc, branch.condition)
+			if err != nil {
+				return control{}, err
+			}
+			if ok {
+				return e.executeStatements(branch.statements, sc)
+			}
+		}
+		return e.executeStatements(stmt.elseBody, sc)
+	case *stmtCase:
+		return e.executeCase(stmt, sc)
+	case *stmtLoop:
+		for {
+			ctrl, err := e.executeStatements(stmt.body, sc)
+			if err != nil {
+				return control{}, err
+			}
+			if done, result := loopControl(ctrl, stmt.label); done {
+				return result, nil
+			}
+		}
+	case *stmtWhile:
+		for {
+			ok, err := e.evaluateCondition(sc, stmt.condition)
+			if err != nil || !ok {
+				return control{}, err
+			}
+			ctrl, err := e.executeStatements(stmt.body, sc)
+			if err != nil {
+				return control{}, err
+			}
+			if done, result := loopControl(ctrl, stmt.label); done {
+				return result, nil
+			}
+		}
+	case *stmtForInteger:
+		return e.executeForInteger(stmt, sc)
+	case *stmtForQuery:
+		return e.executeForQuery(stmt, sc)
+	case *stmtExit:
+		if len(stmt.condition) > 0 {
+			ok, err := e.evaluateCondition(sc, stmt.condition)
+			if err != nil || !ok {
+				return control{}, err
+			}
+		}
+		if stmt.isContinue {
+			return control{kind: controlKind_Continue, label: stmt.label}, nil
+		}
+		return control{kind: controlKind_Exit, label: stmt.label}, nil
+	case *stmtReturn:
+		return e.executeReturn(stmt, sc)
+	case *stmtReturnNext:
+		return control{}, e.executeReturnNext(stmt, sc)
+	case *stmtReturnQuery:
+		return control{}, e.executeReturnQuery(stmt, sc)
+	case *stmtRaise:
+		return control{}, e.executeRaise(stmt, sc)
+	case *stmtAssert:
+		return control{}, e.executeAssert(stmt, sc)
+	case *stmtPerform:
+		_, rows, err := e.runQuery(sc, stmt.query)
+		if err != nil {
+			return control{}, err
+		}
+		e.setRowCount(int64(len(rows)))
+		return control{}, nil
+	case *stmtGetDiagnostics:
+		return control{}, e.executeGetDiagnostics(stmt, sc)
+	case *stmtNull:
+		return control{}, nil
+	case *stmtSQL:
+		return control{}, e.executeSQL(stmt, sc)
+	default:
+		return control{}, fmt.Errorf("unknown PL/pgSQL statement: %T", stmt)
+	}
+}
+
+// loopControl determines how a loop proceeds after its body returns the given control. Returns true when the loop
+// should stop, along with the control that the loop itself should return.
+func loopControl(ctrl control, label string) (bool, control) {
+	switch ctrl.kind {
+	case controlKind_None:
+		return false, control{}
+	case controlKind_Exit:
+		if len(ctrl.label) == 0 || ctrl.label == label {
+			return true, control{}
+		}
+	case controlKind_Continue:
+		if len(ctrl.label) == 0 || ctrl.label == label {
+			return false, control{}
+		}
+	}
+	return true, ctrl
+}
+
+// executeAssign runs an assignment statement.
+func (e *executor) executeAssign(stmt *stmtAssign, sc *scope) error {
+	v, err := e.lookupTarget(sc, stmt.target)
+	if err != nil {
+		return err
+	}
+	if len(stmt.field) > 0 {
+		return e.assignField(v, stmt.field, stmt.expr, sc)
+	}
+	if v.isRecord {
+		return pgerrors.Newf(pgcode.FeatureNotSupported, `assigning to record variable "%s" is not yet supported`, v.name)
+	}
+	val, valType, err := e.evaluate(sc, stmt.expr, v.typeRef)
+	if err != nil {
+		return err
+	}
+	return e.assignValue(v, val, valType, false)
+}
+
+// assignField assigns the expression to the given field of a record variable, converting it to the field's type.
+func (e *executor) assignField(v *variable, field string, expr string, sc *scope) error {
+	if !v.isRecord {
+		return pgerrors.Newf(pgcode.Syntax, `"%s" is not a record variable`, v.name)
+	}
+	if v.record == nil {
+		return pgerrors.Newf(pgcode.ObjectNotInPrerequisiteState, `record "%s" is not assigned yet`, v.name).
+			WithDetail("The tuple structure of a not-yet-assigned record is indeterminate.")
+	}
+	for i, name := range v.record.names {
+		if name != field {
+			continue
+		}
+		fieldType, _ := v.record.types[i].(pgtypes.DoltgresType)
+		var typeRef tree.ResolvableTypeReference
+		if fieldType != nil {
+			typeRef, _ = parser.ParseType(fieldType.String())
+		}
+		val, valType, err := e.evaluate(sc, expr, typeRef)
+		if err != nil {
+			return err
+		}
+		if v.record.values[i], err = convertValue(e.ctx, val, valType, fieldType); err != nil {
+			return err
+		}
+		return nil
+	}
+	return pgerrors.Newf(pgcode.UndefinedColumn, `record "%s" has no field "%s"`, v.name, field)
+}
+
+// executeCase runs a CASE statement.
+func (e *executor) executeCase(stmt *stmtCase, sc *scope) (control, error) {
+	var subject tree.Expr
+	if len(stmt.subject) > 0 {
+		val, valType, err := e.evaluate(sc, stmt.subject, nil)
+		if err != nil {
+			return control{}, err
+		}
+		// The subject is only evaluated once, so its value is compared to each branch's values
+		if subject, err = valueExpr(val, valType); err != nil {
+			return control{}, err
+		}
+	}
+	for _, when := range stmt.whens {
+		for _, expr := range when.exprs {
+			var ok bool
+			var err error
+			if subject != nil {
+				ok, err = e.evaluateComparison(sc, subject, expr)
+			} else {
+				ok, err = e.evaluateCondition(sc, expr)
+			}
+			if err != nil {
+				return control{}, err
+			}
+			if ok {
+				return e.executeStatements(when.statements, sc)
+			}
+		}
+	}
+	if !stmt.hasElse {
+		return control{}, pgerrors.Raise(e.ctx, pgerrors.New(pgcode.CaseNotFound, "case not found").
+			WithHint("CASE statement is missing ELSE part."))
+	}
+	return e.executeStatements(stmt.elseBody, sc)
+}
+
+// executeForInteger runs a FOR loop over a range of integers.
+func (e *executor) executeForInteger(stmt *stmtForInteger, sc *scope) (control, error) {
+	bounds := make([]int64, 3)
+	for i, expr := range []string{stmt.lower, stmt.upper, stmt.step} {
+		if len(expr) == 0 {
+			bounds[i] = 1
+			continue
+		}
+		val, _, err := e.evaluate(sc, expr, integerTypeRef)
+		if err != nil {
+			return control{}, err
+		}
+		if val == nil {
+			switch i {
+			case 0:
+				return control{}, pgerrors.New(pgcode.NullValueNotAllowed, "lower bound of FOR loop cannot be null")
+			case 1:
+				return control{}, pgerrors.New(pgcode.NullValueNotAllowed, "upper bound of FOR loop cannot be null")
+			default:
+				return control{}, pgerrors.New(pgcode.NullValueNotAllowed, "BY value of FOR loop cannot be null")
+			}
+		}
+		bounds[i] = int64(val.(int32))
+	}
+	lower, upper, step := bounds[0], bounds[1], bounds[2]
+	if step <= 0 {
+		return control{}, pgerrors.New(pgcode.InvalidParameterValue, "BY value of FOR loop must be greater than zero")
+	}
+	if stmt.isReverse {
+		step = -step
+	}
+	loopScope := newScope(stmt.label, sc)
+	loopVar := &variable{name: stmt.variable, typ: pgtypes.Int32, typeRef: integerTypeRef}
+	loopScope.variables[stmt.variable] = loopVar
+	found := false
+	for i := lower; (step > 0 && i <= upper) || (step < 0 && i >= upper); i += step {
+		if err := e.ctx.Err(); err != nil {
+			return control{}, err
+		}
+		found = true
+		loopVar.value = int32(i)
+		ctrl, err := e.executeStatements(stmt.body, loopScope)
+		if err != nil {
+			return control{}, err
+		}
+		if done, result := loopControl(ctrl, stmt.label); done {
+			e.found.value = true
+			return result, nil
+		}
+	}
+	e.found.value = found
+	return control{}, nil
+}
+
+// executeForQuery runs a FOR loop over the rows of a query.
+func (e *executor) executeForQuery(stmt *stmtForQuery, sc *scope) (control, error) {
+	targets, err := e.lookupTargets(sc, stmt.targets)
+	if err != nil {
+		return control{}, err
+	}
+	sch, rows, err := e.runQuery(sc, stmt.query)
+	if err != nil {
+		return control{}, err
+	}
+	loopScope := newScope(stmt.label, sc)
+	for _, row := range rows {
+		if err = e.ctx.Err(); err != nil {
+			return control{}, err
+		}
+		if err = e.assignRow(targets, sch, row); err != nil {
+			return control{}, err
+		}
+		ctrl, err := e.executeStatements(stmt.body, loopScope)
+		if err != nil {
+			return control{}, err
+		}
+		if done, result := loopControl(ctrl, stmt.label); done {
+			e.found.value = true
+			return result, nil
+		}
+	}
+	e.found.value = len(rows) > 0
+	return control{}, nil
+}
+
+// executeReturn runs a RETURN statement.
+func (e *executor) executeReturn(stmt *stmtReturn, sc *scope) (control, error) {
+	e.returned = true
+	switch {
+	case e.routine.IsProcedure:
+		if len(stmt.expr) > 0 {
+			return control{}, pgerrors.New(pgcode.Syntax, "RETURN cannot have a parameter in a procedure")
+		}
+	case e.routine.ReturnsSet:
+		if len(stmt.expr) > 0 {
+			return control{}, pgerrors.New(pgcode.Syntax, "RETURN cannot have a parameter in function returning set").
+				WithHint("Use RETURN NEXT or RETURN QUERY.")
+		}
+	case len(e.routine.OutputParameters) > 0:
+		if len(stmt.expr) > 0 {
+			return control{}, pgerrors.New(pgcode.Syntax, "RETURN cannot have a parameter in function with OUT parameters")
+		}
+		e.result.Value = e.params[e.routine.OutputParameters[0]].value
+	case e.routine.Trigger != nil:
+		val, err := e.triggerReturnValue(stmt.expr, sc)
+		if err != nil {
+			return control{}, err
+		}
+		e.result.Value = val
+	case e.routine.ReturnType.BaseID() == pgtypes.DoltgresTypeBaseID_Void:
+		if len(stmt.expr) > 0 {
+			return control{}, pgerrors.New(pgcode.Syntax, "RETURN cannot have a parameter in function returning void")
+		}
+	default:
+		if len(stmt.expr) == 0 {
+			return control{}, pgerrors.New(pgcode.Syntax, `missing expression at or near ";"`)
+		}
+		typeRef, err := parser.ParseType(e.routine.ReturnType.String())
+		if err != nil {
+			return control{}, err
+		}
+		val, valType, err := e.evaluate(sc, stmt.expr, typeRef)
+		if err != nil {
+			return control{}, err
+		}
+		if e.result.Value, err = convertValue(e.ctx, val, valType, e.routine.ReturnType); err != nil {
+			return control{}, err
+		}
+	}
+	return control{kind: controlKind_Return}, nil
+}
+
+// executeReturnNext runs a RETURN NEXT statement.
+func (e *executor) executeReturnNext(stmt *stmtReturnNext, sc *scope) error {
+	if !e.routine.ReturnsSet {
+		return pgerrors.New(pgcode.Syntax, "cannot use RETURN NEXT in a non-SETOF function")
+	}
+	if len(stmt.expr) == 0 {
+		if len(e.routine.OutputParameters) == 0 {
+			return pgerrors.New(pgcode.Syntax, "RETURN NEXT must have a parameter")
+		}
+		e.result.Rows = append(e.result.Rows, e.params[e.routine.OutputParameters[0]].value)
+		return nil
+	}
+	typeRef, err := parser.ParseType(e.routine.ReturnType.String())
+	if err != nil {
+		return err
+	}
+	val, valType, err := e.evaluate(sc, stmt.expr, typeRef)
+	if err != nil {
+		return err
+	}
+	if val, err = convertValue(e.ctx, val, valType, e.routine.ReturnType); err != nil {
+		return err
+	}
+	e.result.Rows = append(e.result.Rows, val)
+	return nil
+}
+
+// executeReturnQuery runs a RETURN QUERY statement, which adds every row of the query to the function's result.
+func (e *executor) executeReturnQuery(stmt *stmtReturnQuery, sc *scope) error {
+	if !e.routine.ReturnsSet {
+		return pgerrors.New(pgcode.Syntax, "cannot use RETURN QUERY in a non-SETOF function")
+	}
+	sch, rows, err := e.runQuery(sc, stmt.query)
+	if err != nil {
+		return err
+	}
+	if len(sch) != 1 {
+		return pgerrors.Raise(e.ctx, pgerrors.New(pgcode.DatatypeMismatch, "structure of query does not match function result type").
+			WithDetail(fmt.Sprintf("Number of returned columns (%d) does not match expected column count (1).", len(sch))))
+	}
+	for _, row := range rows {
+		val, err := convertValue(e.ctx, row[0], sch[0].Type, e.routine.ReturnType)
+		if err != nil {
+			return err
+		}
+		e.result.Rows = append(e.result.Rows, val)
+	}
+	e.setRowCount(int64(len(rows)))
+	return nil
+}
+
+// executeAssert runs an ASSERT statement.
+func (e *executor) executeAssert(stmt *stmtAssert, sc *scope) error {
+	ok, err := e.evaluateCondition(sc, stmt.condition)
+	if err != nil || ok {
+		return err
+	}
+	message := "assertion failed"
+	if len(stmt.message) > 0 {
+		val, valType, err := e.evaluate(sc, stmt.message, nil)
+		if err != nil {
+			return err
+		}
+		if val != nil {
+			if message, err = outputValue(val, valType); err != nil {
+				return err
+			}
+		}
+	}
+	return pgerrors.Raise(e.ctx, pgerrors.New(pgcode.AssertFailure, message))
+}
+
+// executeSQL runs a SQL statement, assigning the returned row to the INTO targets when they're given.
+func (e *executor) executeSQL(stmt *stmtSQL, sc *scope) error {
+	parsed, err := parser.ParseOne(stmt.query)
+	if err != nil {
+		return err
+	}
+	if _, isSelect := parsed.AST.(*tree.Select); isSelect && stmt.into == nil {
+		return pgerrors.Raise(e.ctx, pgerrors.New(pgcode.Syntax, "query has no destination for result data").
+			WithHint("If you want to discard the results of a SELECT, use PERFORM instead."))
+	}
+	var targets []*variable
+	if stmt.into != nil {
+		if targets, err = e.lookupTargets(sc, stmt.into.targets); err != nil {
+			return err
+		}
+	}
+	sch, rows, err := e.run(sc, parsed.AST)
+	if err != nil {
+		return err
+	}
+	// Statements that do not return rows return the number of rows that they affected
+	if len(rows) == 1 && len(rows[0]) == 1 {
+		if okResult, ok := rows[0][0].(types.OkResult); ok {
+			if stmt.into != nil {
+				return pgerrors.New(pgcode.Syntax, "INTO used with a command that cannot return data")
+			}
+			e.setRowCount(int64(okResult.RowsAffected))
+			return nil
+		}
+	}
+	e.setRowCount(int64(len(rows)))
+	if stmt.into == nil {
+		return nil
+	}
+	if stmt.into.isStrict {
+		if len(rows) == 0 {
+			return pgerrors.Raise(e.ctx, pgerrors.New(pgcode.NoDataFound, "query returned no rows"))
+		} else if len(rows) > 1 {
+			return pgerrors.Raise(e.ctx, pgerrors.New(pgcode.TooManyRows, "query returned more than one row").
+				WithHint("Make sure the query returns a single row, or use LIMIT 1."))
+		}
+	}
+	var row sql.Row
+	if len(rows) > 0 {
+		row = rows[0]
+	}
+	return e.assignRow(targets, sch, row)
+}
+
+// setRowCount sets the number of rows processed by the most recent statement, which also determines FOUND.
+func (e *executor) setRowCount(rowCount int64) {
+	e.rowCount = rowCount
+	e.found.value = rowCount > 0
+}
+
+// lookupTarget returns the variable that will be assigned a value. Constants may not be assigned.
+func (e *executor) lookupTarget(sc *scope, name string) (*variable, error) {
+	v := sc.lookup(name)
+	if v == nil {
+		return nil, pgerrors.Newf(pgcode.Syntax, `"%s" is not a known variable`, name)
+	}
+	if v.isConstant {
+		return nil, pgerrors.Newf(pgcode.Syntax, `variable "%s" is declared CONSTANT`, name)
+	}
+	return v, nil
+}
+
+// lookupTargets returns the variables that will be assigned the columns of a row. A record variable must be the only
+// target, as it receives every column.
+func (e *executor) lookupTargets(sc *scope, names []string) ([]*variable, error) {
+	targets := make([]*variable, len(names))
+	for i, name := range names {
+		v, err := e.lookupTarget(sc, name)
+		if err != nil {
+			return nil, err
+		}
+		if v.isRecord && len(names) > 1 {
+			return nil, pgerrors.Newf(pgcode.Syntax, `record variable cannot be part of multiple-item INTO list`)
+		}
+		targets[i] = v
+	}
+	return targets, nil
+}
+
+// assignRow assigns the columns of the given row to the targets. A nil row assigns NULL to every target.
+func (e *executor) assignRow(targets []*variable, sch sql.Schema, row sql.Row) error {
+	if len(targets) == 1 && targets[0].isRecord {
+		rec := &record{
+			names:  make([]string, len(sch)),
+			types:  make([]sql.Type, len(sch)),
+			values: make([]any, len(sch)),
+		}
+		for i, col := range sch {
+			rec.names[i] = col.Name
+			rec.types[i] = col.Type
+			if row != nil {
+				rec.values[i] = row[i]
+			}
+		}
+		targets[0].record = rec
+		return nil
+	}
+	for i, target := range targets {
+		var val any
+		var valType sql.Type
+		if row != nil && i < len(row) {
+			val = row[i]
+			valType = sch[i].Type
+		}
+		if err := e.assignValue(target, val, valType, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// assignValue assigns the value to the variable, converting it to the variable's type. Constants may only be assigned
+// when they're being initialized.
+func (e *executor) assignValue(v *variable, val any, valType sql.Type, isInitialization bool) error {
+	if v.isRecord {
+		return pgerrors.Newf(pgcode.FeatureNotSupported, `assigning to record variable "%s" is not yet supported`, v.name)
+	}
+	if v.isConstant && !isInitialization {
+		return pgerrors.Newf(pgcode.Syntax, `variable "%s" is declared CONSTANT`, v.name)
+	}
+	if val == nil && v.isNotNull {
+		return pgerrors.Raise(e.ctx, pgerrors.Newf(pgcode.NullValueNotAllowed,
+			`null value cannot be assigned to variable "%s" declared NOT NULL`, v.name))
+	}
+	converted, err := convertValue(e.ctx, val, valType, v.typ)
+	if err != nil {
+		return err
+	}
+	v.value = converted
+	return nil
+}
+
+// evaluateCondition evaluates the expression as a boolean, with NULL being treated as false.
+func (e *executor) evaluateCondition(sc *scope, expr string) (bool, error) {
+	val, _, err := e.evaluate(sc, expr, booleanTypeRef)
+	if err != nil || val == nil {
+		return false, err
+	}
+	return val.(bool), nil
+}
+
+// evaluateComparison returns whether the subject of a simple CASE statement is equal to the given expression.
+func (e *executor) evaluateComparison(sc *scope, subject tree.Expr, expr string) (bool, error) {
+	parsed, err := parser.ParseExpr(expr)
+	if err != nil {
+		return false, err
+	}
+	comparison := &tree.ComparisonExpr{Operator: tree.EQ, Left: subject, Right: parsed}
+	val, _, err := e.evaluateExpr(sc, &tree.CastExpr{Expr: comparison, Type: booleanTypeRef, SyntaxMode: tree.CastShort})
+	if err != nil || val == nil {
+		return false, err
+	}
+	return val.(bool), nil
+}
+
+// evaluate evaluates the expression, returning its value and type. When a type is given, then the expression is cast
+// to that type.
+func (e *executor) evaluate(sc *scope, expr string, castTo tree.ResolvableTypeReference) (any, sql.Type, error) {
+	parsed, err := parser.ParseExpr(expr)
+	if err != nil {
+		return nil, nil, err
+	}
+	if castTo != nil {
+		parsed = &tree.CastExpr{Expr: parsed, Type: castTo, SyntaxMode: tree.CastShort}
+	}
+	return e.evaluateExpr(sc, parsed)
+}
+
+// evaluateExpr evaluates the parsed expression by selecting it, returning its value and type.
+func (e *executor) evaluateExpr(sc *scope, expr tree.Expr) (any, sql.Type, error) {
+	stmt := &tree.Select{Select: &tree.SelectClause{Exprs: tree.SelectExprs{{Expr: expr}}}}
+	sch, rows, err := e.run(sc, stmt)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(rows) != 1 || len(sch) != 1 {
+		return nil, nil, pgerrors.Newf(pgcode.Syntax, "query returned %d columns", len(sch))
+	}
+	return rows[0][0], sch[0].Type, nil
+}
+
+// runQuery parses and runs the given query.
+func (e *executor) runQuery(sc *scope, query string) (sql.Schema, []sql.Row, error) {
+	parsed, err := parser.ParseOne(query)
+	if err != nil {
+		return nil, nil, err
+	}
+	return e.run(sc, parsed.AST)
+}
+
+// run replaces every reference to a variable within the statement with its current value, and then runs it.
+func (e *executor) run(sc *scope, stmt tree.Statement) (sql.Schema, []sql.Row, error) {
+	visitor := &variableVisitor{executor: e, scope: sc}
+	newStmt, _ := tree.WalkStmt(visitor, stmt)
+	if visitor.err != nil {
+		return nil, nil, visitor.err
+	}
+	return e.runner(e.ctx, newStmt)
+}
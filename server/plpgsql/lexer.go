@@ -0,0 +1,278 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plpgsql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// tokenKind is the kind of a token within a PL/pgSQL body.
+type tokenKind uint8
+
+const (
+	tokenKind_EOF tokenKind = iota
+	tokenKind_Word
+	tokenKind_QuotedIdentifier
+	tokenKind_String
+	tokenKind_Number
+	tokenKind_Parameter
+	tokenKind_Operator
+)
+
+// token is a single token within a PL/pgSQL body. Expressions and SQL statements are not tokenized beyond what is
+// needed to find where they end, as they're taken from the source and given to the SQL parser.
+type token struct {
+	kind tokenKind
+	// value is the lowercased text for words, the unescaped contents for strings and quoted identifiers, and the text
+	// for everything else.
+	value string
+	start int
+	end   int
+}
+
+// isWord returns whether the token is the given (lowercase) unquoted word.
+func (t token) isWord(word string) bool {
+	return t.kind == tokenKind_Word && t.value == word
+}
+
+// isOperator returns whether the token is the given operator or punctuation.
+func (t token) isOperator(op string) bool {
+	return t.kind == tokenKind_Operator && t.value == op
+}
+
+// isIdentifier returns whether the token may be used as the name of a variable or label.
+func (t token) isIdentifier() bool {
+	return t.kind == tokenKind_Word || t.kind == tokenKind_QuotedIdentifier
+}
+
+// multiCharOperators are the operators that must be recognized as a single token, as they have a meaning within
+// PL/pgSQL itself. All other operators are only passed through to the SQL parser, so they may be split.
+var multiCharOperators = []string{":=", "..", "<<", ">>", "::"}
+
+// tokenize splits the given PL/pgSQL body into tokens, skipping whitespace and comments. The last token is always EOF.
+func tokenize(source string) ([]token, error) {
+	var tokens []token
+	i := 0
+	for i < len(source) {
+		c := source[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f':
+			i++
+		case strings.HasPrefix(source[i:], "--"):
+			for i < len(source) && source[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(source[i:], "/*"):
+			depth := 0
+			start := i
+			for i < len(source) {
+				if strings.HasPrefix(source[i:], "/*") {
+					depth++
+					i += 2
+				} else if strings.HasPrefix(source[i:], "*/") {
+					depth--
+					i += 2
+					if depth == 0 {
+						break
+					}
+				} else {
+					i++
+				}
+			}
+			if depth != 0 {
+				return nil, syntaxError(source, start, "unterminated /* comment")
+			}
+		case c == '\'' || ((c == 'e' || c == 'E') && i+1 < len(source) && source[i+1] == '\''):
+			tok, err := lexString(source, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, tok)
+			i = tok.end
+		case c == '$' && i+1 < len(source) && isDigit(source[i+1]):
+			start := i
+			i++
+			for i < len(source) && isDigit(source[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenKind_Parameter, value: source[start:i], start: start, end: i})
+		case c == '$':
+			tok, err := lexDollarString(source, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, tok)
+			i = tok.end
+		case c == '"':
+			start := i
+			sb := strings.Builder{}
+			i++
+			for {
+				if i >= len(source) {
+					return nil, syntaxError(source, start, "unterminated quoted identifier")
+				}
+				if source[i] == '"' {
+					if i+1 < len(source) && source[i+1] == '"' {
+						sb.WriteByte('"')
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				sb.WriteByte(source[i])
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenKind_QuotedIdentifier, value: sb.String(), start: start, end: i})
+		case isDigit(c) || (c == '.' && i+1 < len(source) && isDigit(source[i+1])):
+			start := i
+			for i < len(source) && isDigit(source[i]) {
+				i++
+			}
+			// A period that is followed by another period is the range operator of an integer FOR loop
+			if i < len(source) && source[i] == '.' && !strings.HasPrefix(source[i:], "..") {
+				i++
+				for i < len(source) && isDigit(source[i]) {
+					i++
+				}
+			}
+			if i < len(source) && (source[i] == 'e' || source[i] == 'E') {
+				j := i + 1
+				if j < len(source) && (source[j] == '+' || source[j] == '-') {
+					j++
+				}
+				if j < len(source) && isDigit(source[j]) {
+					i = j
+					for i < len(source) && isDigit(source[i]) {
+						i++
+					}
+				}
+			}
+			tokens = append(tokens, token{kind: tokenKind_Number, value: source[start:i], start: start, end: i})
+		case isIdentifierStart(c):
+			start := i
+			for i < len(source) && isIdentifierPart(source[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenKind_Word, value: strings.ToLower(source[start:i]), start: start, end: i})
+		default:
+			op := source[i : i+1]
+			for _, multiCharOp := range multiCharOperators {
+				if strings.HasPrefix(source[i:], multiCharOp) {
+					op = multiCharOp
+					break
+				}
+			}
+			tokens = append(tokens, token{kind: tokenKind_Operator, value: op, start: i, end: i + len(op)})
+			i += len(op)
+		}
+	}
+	return append(tokens, token{kind: tokenKind_EOF, start: len(source), end: len(source)}), nil
+}
+
+// lexString reads the string literal that begins at the given position, which may be an escape string (E'...').
+func lexString(source string, start int) (token, error) {
+	i := start
+	escapes := false
+	if source[i] != '\'' {
+		escapes = true
+		i++
+	}
+	i++
+	sb := strings.Builder{}
+	for {
+		if i >= len(source) {
+			return token{}, syntaxError(source, start, "unterminated quoted string")
+		}
+		c := source[i]
+		switch {
+		case c == '\'':
+			if i+1 < len(source) && source[i+1] == '\'' {
+				sb.WriteByte('\'')
+				i += 2
+				continue
+			}
+			return token{kind: tokenKind_String, value: sb.String(), start: start, end: i + 1}, nil
+		case c == '\\' && escapes && i+1 < len(source):
+			i++
+			switch source[i] {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			case 'r':
+				sb.WriteByte('\r')
+			case 'b':
+				sb.WriteByte('\b')
+			case 'f':
+				sb.WriteByte('\f')
+			default:
+				sb.WriteByte(source[i])
+			}
+			i++
+		default:
+			sb.WriteByte(c)
+			i++
+		}
+	}
+}
+
+// lexDollarString reads the dollar-quoted string that begins at the given position.
+func lexDollarString(source string, start int) (token, error) {
+	i := start + 1
+	for i < len(source) && isIdentifierPart(source[i]) && source[i] != '$' {
+		i++
+	}
+	if i >= len(source) || source[i] != '$' {
+		return token{}, syntaxError(source, start, `syntax error at or near "$"`)
+	}
+	tag := source[start : i+1]
+	end := strings.Index(source[i+1:], tag)
+	if end == -1 {
+		return token{}, syntaxError(source, start, "unterminated dollar-quoted string")
+	}
+	contentStart := i + 1
+	contentEnd := contentStart + end
+	return token{kind: tokenKind_String, value: source[contentStart:contentEnd], start: start, end: contentEnd + len(tag)}, nil
+}
+
+// syntaxError returns an error for the given position within the source, which includes the line of the error.
+func syntaxError(source string, position int, message string) error {
+	return fmt.Errorf("%s at line %d", message, lineOf(source, position))
+}
+
+// lineOf returns the line (starting from 1) that contains the given position within the source.
+func lineOf(source string, position int) int {
+	if position > len(source) {
+		position = len(source)
+	}
+	return strings.Count(source[:position], "\n") + 1
+}
+
+// isDigit returns whether the character is an ASCII digit.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// isIdentifierStart returns whether the character may begin an unquoted identifier.
+func isIdentifierStart(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '_' || c >= 0x80
+}
+
+// isIdentifierPart returns whether the character may be within an unquoted identifier.
+func isIdentifierPart(c byte) bool {
+	return isIdentifierStart(c) || isDigit(c) || c == '$'
+}
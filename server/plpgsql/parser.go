@@ -0,0 +1,1101 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plpgsql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/doltgresql/postgres/parser/parser"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// TypeResolver returns the type that the given type reference refers to.
+type TypeResolver func(typ tree.ResolvableTypeReference) (pgtypes.DoltgresType, error)
+
+// blockParser parses the body of a PL/pgSQL routine into a *Block.
+type blockParser struct {
+	source      string
+	tokens      []token
+	pos         int
+	resolveType TypeResolver
+	// labels are the labels of the blocks and loops that enclose the statement being parsed, from outermost to
+	// innermost. Unlabeled loops are included with an empty label.
+	labels []enclosingLabel
+	// handlerDepth is the number of exception handlers that enclose the statement being parsed.
+	handlerDepth int
+}
+
+// enclosingLabel is the label of a block or loop that encloses the statement being parsed.
+type enclosingLabel struct {
+	name   string
+	isLoop bool
+}
+
+// raiseLevels are the levels that may be given to RAISE.
+var raiseLevels = map[string]struct{}{
+	"debug":     {},
+	"log":       {},
+	"info":      {},
+	"notice":    {},
+	"warning":   {},
+	"exception": {},
+}
+
+// currentDiagnosticsItems are the items that may be retrieved using GET [CURRENT] DIAGNOSTICS.
+var currentDiagnosticsItems = map[string]struct{}{
+	"row_count": {},
+}
+
+// stackedDiagnosticsItems are the items that may be retrieved using GET STACKED DIAGNOSTICS, which describe the error
+// that is being handled.
+var stackedDiagnosticsItems = map[string]struct{}{
+	"returned_sqlstate":    {},
+	"message_text":         {},
+	"pg_exception_detail":  {},
+	"pg_exception_hint":    {},
+	"pg_exception_context": {},
+	"column_name":          {},
+	"constraint_name":      {},
+	"pg_datatype_name":     {},
+	"table_name":           {},
+	"schema_name":          {},
+}
+
+// raiseOptionNames are the options that may be given within the USING clause of RAISE.
+var raiseOptionNames = map[string]struct{}{
+	"message":    {},
+	"detail":     {},
+	"hint":       {},
+	"errcode":    {},
+	"column":     {},
+	"constraint": {},
+	"datatype":   {},
+	"table":      {},
+	"schema":     {},
+}
+
+// Parse parses the body of a PL/pgSQL function or procedure. Every expression and SQL statement within the body is
+// verified to be parseable, so that errors are reported when the routine is created rather than when it is called.
+func Parse(definition string, resolveType TypeResolver) (*Block, error) {
+	tokens, err := tokenize(definition)
+	if err != nil {
+		return nil, err
+	}
+	p := &blockParser{
+		source:      definition,
+		tokens:      tokens,
+		resolveType: resolveType,
+	}
+	label, err := p.parseLabel()
+	if err != nil {
+		return nil, err
+	}
+	block, err := p.parseBlock(label)
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().isOperator(";") {
+		p.next()
+	}
+	if tok := p.peek(); tok.kind != tokenKind_EOF {
+		return nil, p.unexpected(tok)
+	}
+	return block, nil
+}
+
+// peek returns the current token without consuming it.
+func (p *blockParser) peek() token {
+	return p.tokens[p.pos]
+}
+
+// peekAhead returns the token that is the given number of tokens after the current token, without consuming anything.
+func (p *blockParser) peekAhead(n int) token {
+	if p.pos+n >= len(p.tokens) {
+		return p.tokens[len(p.tokens)-1]
+	}
+	return p.tokens[p.pos+n]
+}
+
+// next consumes and returns the current token. The EOF token is never consumed.
+func (p *blockParser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenKind_EOF {
+		p.pos++
+	}
+	return tok
+}
+
+// line returns the line of the given token.
+func (p *blockParser) line(tok token) int {
+	return lineOf(p.source, tok.start)
+}
+
+// unexpected returns a syntax error for the given token.
+func (p *blockParser) unexpected(tok token) error {
+	if tok.kind == tokenKind_EOF {
+		return fmt.Errorf("syntax error at end of input")
+	}
+	return syntaxError(p.source, tok.start, fmt.Sprintf(`syntax error at or near "%s"`, p.source[tok.start:tok.end]))
+}
+
+// expectWord consumes the given word, returning an error if it is not the current token.
+func (p *blockParser) expectWord(word string) error {
+	if tok := p.peek(); !tok.isWord(word) {
+		return p.unexpected(tok)
+	}
+	p.next()
+	return nil
+}
+
+// expectOperator consumes the given operator, returning an error if it is not the current token.
+func (p *blockParser) expectOperator(op string) error {
+	if tok := p.peek(); !tok.isOperator(op) {
+		return p.unexpected(tok)
+	}
+	p.next()
+	return nil
+}
+
+// identifier consumes an identifier, returning its name.
+func (p *blockParser) identifier() (string, error) {
+	tok := p.peek()
+	if !tok.isIdentifier() {
+		return "", p.unexpected(tok)
+	}
+	p.next()
+	return tok.value, nil
+}
+
+// parseLabel parses a label in the form <<label>>, returning an empty string if there isn't one.
+func (p *blockParser) parseLabel() (string, error) {
+	if !p.peek().isOperator("<<") {
+		return "", nil
+	}
+	p.next()
+	label, err := p.identifier()
+	if err != nil {
+		return "", err
+	}
+	if err = p.expectOperator(">>"); err != nil {
+		return "", err
+	}
+	return label, nil
+}
+
+// parseEndLabel parses the optional label that follows the END of a block or loop, which must match the label that
+// was given at the start.
+func (p *blockParser) parseEndLabel(label string) error {
+	tok := p.peek()
+	if !tok.isIdentifier() {
+		return nil
+	}
+	p.next()
+	if len(label) == 0 {
+		return syntaxError(p.source, tok.start, fmt.Sprintf(`end label "%s" specified for unlabeled block`, tok.value))
+	}
+	if tok.value != label {
+		return syntaxError(p.source, tok.start, fmt.Sprintf(`end label "%s" differs from block's label "%s"`, tok.value, label))
+	}
+	return nil
+}
+
+// scanUntil consumes tokens until one matches the stop condition outside of any parentheses, brackets, or CASE
+// expressions, returning the source text of the consumed tokens. The matching token is not consumed.
+func (p *blockParser) scanUntil(stop func(tok token) bool) (string, error) {
+	start := p.peek().start
+	depth := 0
+	caseDepth := 0
+	for {
+		tok := p.peek()
+		if tok.kind == tokenKind_EOF {
+			return "", p.unexpected(tok)
+		}
+		if depth == 0 && caseDepth == 0 && stop(tok) {
+			return strings.TrimSpace(p.source[start:tok.start]), nil
+		}
+		switch {
+		case tok.isOperator("(") || tok.isOperator("["):
+			depth++
+		case tok.isOperator(")") || tok.isOperator("]"):
+			depth--
+			if depth < 0 {
+				return "", p.unexpected(tok)
+			}
+		case tok.isWord("case"):
+			caseDepth++
+		case tok.isWord("end") && caseDepth > 0:
+			caseDepth--
+		}
+		p.next()
+	}
+}
+
+// parseExpr parses an expression that ends at the first token matching the stop condition, which is not consumed.
+func (p *blockParser) parseExpr(stop func(tok token) bool) (string, error) {
+	tok := p.peek()
+	expr, err := p.scanUntil(stop)
+	if err != nil {
+		return "", err
+	}
+	if len(expr) == 0 {
+		return "", p.unexpected(tok)
+	}
+	if _, err = parser.ParseExpr(expr); err != nil {
+		return "", err
+	}
+	return expr, nil
+}
+
+// parseQuery parses a SQL statement that ends at the first token matching the stop condition, which is not consumed.
+func (p *blockParser) parseQuery(stop func(tok token) bool) (string, error) {
+	tok := p.peek()
+	query, err := p.scanUntil(stop)
+	if err != nil {
+		return "", err
+	}
+	if len(query) == 0 {
+		return "", p.unexpected(tok)
+	}
+	if _, err = parser.ParseOne(query); err != nil {
+		return "", err
+	}
+	return query, nil
+}
+
+// isWordStop returns a stop condition for scanUntil that matches any of the given words.
+func isWordStop(words ...string) func(tok token) bool {
+	return func(tok token) bool {
+		for _, word := range words {
+			if tok.isWord(word) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// isOperatorStop returns a stop condition for scanUntil that matches any of the given operators.
+func isOperatorStop(ops ...string) func(tok token) bool {
+	return func(tok token) bool {
+		for _, op := range ops {
+			if tok.isOperator(op) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// isSemicolon is a stop condition for scanUntil that matches the end of a statement.
+var isSemicolon = isOperatorStop(";")
+
+// parseBlock parses a block, beginning at either DECLARE or BEGIN. The semicolon that follows END is not consumed.
+func (p *blockParser) parseBlock(label string) (*Block, error) {
+	block := &Block{
+		label: label,
+		line:  p.line(p.peek()),
+	}
+	if p.peek().isWord("declare") {
+		p.next()
+		for !p.peek().isWord("begin") {
+			decl, err := p.parseDeclaration()
+			if err != nil {
+				return nil, err
+			}
+			block.declarations = append(block.declarations, decl)
+		}
+	}
+	if err := p.expectWord("begin"); err != nil {
+		return nil, err
+	}
+	p.labels = append(p.labels, enclosingLabel{name: label})
+	defer func() { p.labels = p.labels[:len(p.labels)-1] }()
+	var err error
+	if block.statements, err = p.parseStatements("end", "exception"); err != nil {
+		return nil, err
+	}
+	if p.peek().isWord("exception") {
+		p.next()
+		if block.handlers, err = p.parseExceptionHandlers(); err != nil {
+			return nil, err
+		}
+	}
+	if err = p.expectWord("end"); err != nil {
+		return nil, err
+	}
+	if err = p.parseEndLabel(label); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// parseExceptionHandlers parses the WHEN clauses of an EXCEPTION section, ending before the END of the block.
+func (p *blockParser) parseExceptionHandlers() ([]exceptionHandler, error) {
+	var handlers []exceptionHandler
+	for {
+		if err := p.expectWord("when"); err != nil {
+			return nil, err
+		}
+		var handler exceptionHandler
+		for {
+			condition, err := p.parseExceptionCondition()
+			if err != nil {
+				return nil, err
+			}
+			handler.conditions = append(handler.conditions, condition)
+			if !p.peek().isWord("or") {
+				break
+			}
+			p.next()
+		}
+		if err := p.expectWord("then"); err != nil {
+			return nil, err
+		}
+		p.handlerDepth++
+		statements, err := p.parseStatements("when", "end")
+		p.handlerDepth--
+		if err != nil {
+			return nil, err
+		}
+		handler.statements = statements
+		handlers = append(handlers, handler)
+		if p.peek().isWord("end") {
+			return handlers, nil
+		}
+	}
+}
+
+// parseExceptionCondition parses a condition of an exception handler, which is either the name of a condition or a
+// SQLSTATE code, returning the code. OTHERS is returned as-is, as it matches every code.
+func (p *blockParser) parseExceptionCondition() (string, error) {
+	tok := p.next()
+	if tok.isWord("sqlstate") {
+		codeTok := p.next()
+		if codeTok.kind != tokenKind_String || !isSqlState(codeTok.value) {
+			return "", syntaxError(p.source, codeTok.start, "invalid SQLSTATE code")
+		}
+		return codeTok.value, nil
+	}
+	if !tok.isIdentifier() {
+		return "", p.unexpected(tok)
+	}
+	if tok.value == "others" {
+		return tok.value, nil
+	}
+	code, ok := conditionNames[tok.value]
+	if !ok {
+		return "", syntaxError(p.source, tok.start, fmt.Sprintf(`unrecognized exception condition "%s"`, tok.value))
+	}
+	return code, nil
+}
+
+// parseDeclaration parses a single variable declaration within the DECLARE section of a block.
+func (p *blockParser) parseDeclaration() (declaration, error) {
+	nameTok := p.peek()
+	name, err := p.identifier()
+	if err != nil {
+		return declaration{}, err
+	}
+	decl := declaration{
+		name: name,
+		line: p.line(nameTok),
+	}
+	if p.peek().isWord("alias") {
+		p.next()
+		if err = p.expectWord("for"); err != nil {
+			return declaration{}, err
+		}
+		tok := p.next()
+		if !tok.isIdentifier() && tok.kind != tokenKind_Parameter {
+			return declaration{}, p.unexpected(tok)
+		}
+		decl.aliasFor = tok.value
+		return decl, p.expectOperator(";")
+	}
+	if p.peek().isWord("constant") {
+		p.next()
+		decl.isConstant = true
+	}
+	typeTok := p.peek()
+	typeText, err := p.scanUntil(func(tok token) bool {
+		return tok.isOperator(";") || tok.isOperator(":=") || tok.isOperator("=") || tok.isWord("default") ||
+			tok.isWord("collate") || (tok.isWord("not") && p.peekAhead(1).isWord("null"))
+	})
+	if err != nil {
+		return declaration{}, err
+	}
+	switch {
+	case len(typeText) == 0:
+		return declaration{}, p.unexpected(typeTok)
+	case strings.Contains(typeText, "%"):
+		return declaration{}, syntaxError(p.source, typeTok.start, "%TYPE and %ROWTYPE are not yet supported")
+	case strings.EqualFold(typeText, "record"):
+	default:
+		if decl.typeRef, err = parser.ParseType(typeText); err != nil {
+			return declaration{}, err
+		}
+		if decl.typ, err = p.resolveType(decl.typeRef); err != nil {
+			return declaration{}, err
+		}
+	}
+	if p.peek().isWord("collate") {
+		return declaration{}, syntaxError(p.source, p.peek().start, "COLLATE is not yet supported for variables")
+	}
+	if p.peek().isWord("not") {
+		p.next()
+		p.next()
+		decl.isNotNull = true
+	}
+	if tok := p.peek(); tok.isWord("default") || tok.isOperator(":=") || tok.isOperator("=") {
+		p.next()
+		if decl.defaultExpr, err = p.parseExpr(isSemicolon); err != nil {
+			return declaration{}, err
+		}
+	}
+	if decl.isNotNull && len(decl.defaultExpr) == 0 {
+		return declaration{}, syntaxError(p.source, nameTok.start,
+			fmt.Sprintf(`variable "%s" must have a default value, since it's declared NOT NULL`, name))
+	}
+	return decl, p.expectOperator(";")
+}
+
+// parseStatements parses statements until one of the given words is found, which is not consumed.
+func (p *blockParser) parseStatements(terminators ...string) ([]statement, error) {
+	var statements []statement
+	for {
+		tok := p.peek()
+		if tok.kind == tokenKind_EOF {
+			return nil, p.unexpected(tok)
+		}
+		for _, terminator := range terminators {
+			if tok.isWord(terminator) {
+				return statements, nil
+			}
+		}
+		stmt, err := p.parseStatement()
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, stmt)
+	}
+}
+
+// parseStatement parses a single statement, including its trailing semicolon.
+func (p *blockParser) parseStatement() (statement, error) {
+	startTok := p.peek()
+	line := p.line(startTok)
+	label, err := p.parseLabel()
+	if err != nil {
+		return nil, err
+	}
+	tok := p.peek()
+	if len(label) > 0 && !tok.isWord("declare") && !tok.isWord("begin") && !tok.isWord("loop") &&
+		!tok.isWord("while") && !tok.isWord("for") {
+		return nil, p.unexpected(tok)
+	}
+	if tok.kind == tokenKind_Word {
+		switch tok.value {
+		case "declare", "begin":
+			block, err := p.parseBlock(label)
+			if err != nil {
+				return nil, err
+			}
+			return &stmtBlock{line: line, block: block}, p.expectOperator(";")
+		case "if":
+			return p.parseIf(line)
+		case "case":
+			return p.parseCase(line)
+		case "loop":
+			body, err := p.parseLoopBody(label)
+			if err != nil {
+				return nil, err
+			}
+			return &stmtLoop{line: line, label: label, body: body}, nil
+		case "while":
+			p.next()
+			condition, err := p.parseExpr(isWordStop("loop"))
+			if err != nil {
+				return nil, err
+			}
+			body, err := p.parseLoopBody(label)
+			if err != nil {
+				return nil, err
+			}
+			return &stmtWhile{line: line, label: label, condition: condition, body: body}, nil
+		case "for":
+			return p.parseFor(line, label)
+		case "exit", "continue":
+			return p.parseExit(line)
+		case "return":
+			return p.parseReturn(line)
+		case "raise":
+			return p.parseRaise(line)
+		case "assert":
+			p.next()
+			condition, err := p.parseExpr(isOperatorStop(",", ";"))
+			if err != nil {
+				return nil, err
+			}
+			stmt := &stmtAssert{line: line, condition: condition}
+			if p.peek().isOperator(",") {
+				p.next()
+				if stmt.message, err = p.parseExpr(isSemicolon); err != nil {
+					return nil, err
+				}
+			}
+			return stmt, p.expectOperator(";")
+		case "perform":
+			p.next()
+			rest, err := p.scanUntil(isSemicolon)
+			if err != nil {
+				return nil, err
+			}
+			query := "SELECT " + rest
+			if _, err = parser.ParseOne(query); err != nil {
+				return nil, err
+			}
+			return &stmtPerform{line: line, query: query}, p.expectOperator(";")
+		case "get":
+			return p.parseGetDiagnostics(line)
+		case "null":
+			if p.peekAhead(1).isOperator(";") {
+				p.next()
+				p.next()
+				return &stmtNull{line: line}, nil
+			}
+		case "execute":
+			return nil, syntaxError(p.source, tok.start, "EXECUTE is not yet supported")
+		case "foreach":
+			return nil, syntaxError(p.source, tok.start, "FOREACH is not yet supported")
+		case "open", "fetch", "move", "close":
+			return nil, syntaxError(p.source, tok.start, "cursors are not yet supported")
+		}
+	}
+	if tok.isIdentifier() {
+		if nextTok := p.peekAhead(1); nextTok.isOperator(":=") || nextTok.isOperator("=") {
+			p.next()
+			p.next()
+			expr, err := p.parseExpr(isSemicolon)
+			if err != nil {
+				return nil, err
+			}
+			return &stmtAssign{line: line, target: tok.value, expr: expr}, p.expectOperator(";")
+		} else if fieldTok := p.peekAhead(2); nextTok.isOperator(".") && fieldTok.isIdentifier() {
+			if afterTok := p.peekAhead(3); afterTok.isOperator(":=") || afterTok.isOperator("=") {
+				p.next()
+				p.next()
+				p.next()
+				p.next()
+				expr, err := p.parseExpr(isSemicolon)
+				if err != nil {
+					return nil, err
+				}
+				return &stmtAssign{line: line, target: tok.value, field: fieldTok.value, expr: expr}, p.expectOperator(";")
+			}
+		} else if nextTok.isOperator("[") {
+			return nil, syntaxError(p.source, tok.start, "assigning to array elements is not yet supported")
+		}
+	}
+	return p.parseSQL(line)
+}
+
+// parseIf parses an IF statement, beginning at IF.
+func (p *blockParser) parseIf(line int) (statement, error) {
+	p.next()
+	stmt := &stmtIf{line: line}
+	for {
+		condition, err := p.parseExpr(isWordStop("then"))
+		if err != nil {
+			return nil, err
+		}
+		p.next()
+		body, err := p.parseStatements("elsif", "elseif", "else", "end")
+		if err != nil {
+			return nil, err
+		}
+		stmt.branches = append(stmt.branches, conditionalBranch{condition: condition, statements: body})
+		if tok := p.peek(); !tok.isWord("elsif") && !tok.isWord("elseif") {
+			break
+		}
+		p.next()
+	}
+	if p.peek().isWord("else") {
+		p.next()
+		var err error
+		if stmt.elseBody, err = p.parseStatements("end"); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.expectWord("end"); err != nil {
+		return nil, err
+	}
+	if err := p.expectWord("if"); err != nil {
+		return nil, err
+	}
+	return stmt, p.expectOperator(";")
+}
+
+// parseCase parses a CASE statement, beginning at CASE.
+func (p *blockParser) parseCase(line int) (statement, error) {
+	p.next()
+	stmt := &stmtCase{line: line}
+	var err error
+	if !p.peek().isWord("when") {
+		if stmt.subject, err = p.parseExpr(isWordStop("when")); err != nil {
+			return nil, err
+		}
+	}
+	if tok := p.peek(); !tok.isWord("when") {
+		return nil, p.unexpected(tok)
+	}
+	for p.peek().isWord("when") {
+		p.next()
+		var exprs []string
+		for {
+			stop := isWordStop("then")
+			if len(stmt.subject) > 0 {
+				// Simple CASE statements may compare the subject to multiple values in a single branch
+				stop = func(tok token) bool { return tok.isWord("then") || tok.isOperator(",") }
+			}
+			expr, err := p.parseExpr(stop)
+			if err != nil {
+				return nil, err
+			}
+			exprs = append(exprs, expr)
+			if p.next().isWord("then") {
+				break
+			}
+		}
+		body, err := p.parseStatements("when", "else", "end")
+		if err != nil {
+			return nil, err
+		}
+		stmt.whens = append(stmt.whens, caseWhen{exprs: exprs, statements: body})
+	}
+	if p.peek().isWord("else") {
+		p.next()
+		stmt.hasElse = true
+		if stmt.elseBody, err = p.parseStatements("end"); err != nil {
+			return nil, err
+		}
+	}
+	if err = p.expectWord("end"); err != nil {
+		return nil, err
+	}
+	if err = p.expectWord("case"); err != nil {
+		return nil, err
+	}
+	return stmt, p.expectOperator(";")
+}
+
+// parseLoopBody parses the body of a loop, beginning at LOOP and ending after the semicolon that follows END LOOP.
+func (p *blockParser) parseLoopBody(label string) ([]statement, error) {
+	if err := p.expectWord("loop"); err != nil {
+		return nil, err
+	}
+	p.labels = append(p.labels, enclosingLabel{name: label, isLoop: true})
+	defer func() { p.labels = p.labels[:len(p.labels)-1] }()
+	body, err := p.parseStatements("end")
+	if err != nil {
+		return nil, err
+	}
+	p.next()
+	if err = p.expectWord("loop"); err != nil {
+		return nil, err
+	}
+	if err = p.parseEndLabel(label); err != nil {
+		return nil, err
+	}
+	return body, p.expectOperator(";")
+}
+
+// parseFor parses a FOR loop, beginning at FOR. Loops over a range of integers are distinguished from loops over the
+// rows of a query by the presence of the range operator (..).
+func (p *blockParser) parseFor(line int, label string) (statement, error) {
+	p.next()
+	var targets []string
+	for {
+		target, err := p.identifier()
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, target)
+		if !p.peek().isOperator(",") {
+			break
+		}
+		p.next()
+	}
+	if err := p.expectWord("in"); err != nil {
+		return nil, err
+	}
+	isReverse := false
+	if p.peek().isWord("reverse") {
+		p.next()
+		isReverse = true
+	}
+	if tok := p.peek(); tok.isWord("execute") {
+		return nil, syntaxError(p.source, tok.start, "EXECUTE is not yet supported")
+	}
+	// We scan ahead to determine which kind of loop this is, and then start again from the same position
+	start := p.pos
+	_, err := p.scanUntil(func(tok token) bool { return tok.isOperator("..") || tok.isWord("loop") })
+	if err == nil && p.peek().isOperator("..") {
+		p.pos = start
+		if len(targets) > 1 {
+			return nil, p.unexpected(p.peek())
+		}
+		stmt := &stmtForInteger{line: line, label: label, variable: targets[0], isReverse: isReverse}
+		if stmt.lower, err = p.parseExpr(isOperatorStop("..")); err != nil {
+			return nil, err
+		}
+		p.next()
+		if stmt.upper, err = p.parseExpr(isWordStop("by", "loop")); err != nil {
+			return nil, err
+		}
+		if p.peek().isWord("by") {
+			p.next()
+			if stmt.step, err = p.parseExpr(isWordStop("loop")); err != nil {
+				return nil, err
+			}
+		}
+		if stmt.body, err = p.parseLoopBody(label); err != nil {
+			return nil, err
+		}
+		return stmt, nil
+	}
+	p.pos = start
+	if isReverse {
+		return nil, p.unexpected(p.peek())
+	}
+	query, err := p.parseQuery(isWordStop("loop"))
+	if err != nil {
+		return nil, err
+	}
+	body, err := p.parseLoopBody(label)
+	if err != nil {
+		return nil, err
+	}
+	return &stmtForQuery{line: line, label: label, targets: targets, query: query, body: body}, nil
+}
+
+// parseExit parses either an EXIT or CONTINUE statement. The label must belong to an enclosing block or loop.
+func (p *blockParser) parseExit(line int) (statement, error) {
+	exitTok := p.next()
+	stmt := &stmtExit{line: line, isContinue: exitTok.isWord("continue")}
+	if tok := p.peek(); tok.isIdentifier() && !tok.isWord("when") {
+		p.next()
+		stmt.label = tok.value
+		found := false
+		for i := len(p.labels) - 1; i >= 0; i-- {
+			if p.labels[i].name == stmt.label {
+				if stmt.isContinue && !p.labels[i].isLoop {
+					return nil, syntaxError(p.source, tok.start,
+						fmt.Sprintf(`block label "%s" cannot be used in CONTINUE`, stmt.label))
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, syntaxError(p.source, tok.start,
+				fmt.Sprintf(`there is no label "%s" attached to any block or loop enclosing this statement`, stmt.label))
+		}
+	} else {
+		inLoop := false
+		for _, enclosing := range p.labels {
+			inLoop = inLoop || enclosing.isLoop
+		}
+		if !inLoop {
+			if stmt.isContinue {
+				return nil, syntaxError(p.source, exitTok.start, "CONTINUE cannot be used outside a loop")
+			}
+			return nil, syntaxError(p.source, exitTok.start, "EXIT cannot be used outside a loop, unless it has a label")
+		}
+	}
+	if p.peek().isWord("when") {
+		p.next()
+		var err error
+		if stmt.condition, err = p.parseExpr(isSemicolon); err != nil {
+			return nil, err
+		}
+	}
+	return stmt, p.expectOperator(";")
+}
+
+// parseReturn parses RETURN, RETURN NEXT, and RETURN QUERY statements, beginning at RETURN.
+func (p *blockParser) parseReturn(line int) (statement, error) {
+	p.next()
+	var err error
+	switch tok := p.peek(); {
+	case tok.isWord("next"):
+		p.next()
+		stmt := &stmtReturnNext{line: line}
+		if !p.peek().isOperator(";") {
+			if stmt.expr, err = p.parseExpr(isSemicolon); err != nil {
+				return nil, err
+			}
+		}
+		return stmt, p.expectOperator(";")
+	case tok.isWord("query"):
+		p.next()
+		if tok = p.peek(); tok.isWord("execute") {
+			return nil, syntaxError(p.source, tok.start, "EXECUTE is not yet supported")
+		}
+		stmt := &stmtReturnQuery{line: line}
+		if stmt.query, err = p.parseQuery(isSemicolon); err != nil {
+			return nil, err
+		}
+		return stmt, p.expectOperator(";")
+	default:
+		stmt := &stmtReturn{line: line}
+		if !tok.isOperator(";") {
+			if stmt.expr, err = p.parseExpr(isSemicolon); err != nil {
+				return nil, err
+			}
+		}
+		return stmt, p.expectOperator(";")
+	}
+}
+
+// parseRaise parses a RAISE statement, beginning at RAISE.
+func (p *blockParser) parseRaise(line int) (statement, error) {
+	raiseTok := p.next()
+	stmt := &stmtRaise{line: line, level: "exception"}
+	if tok := p.peek(); tok.kind == tokenKind_Word {
+		if _, ok := raiseLevels[tok.value]; ok {
+			p.next()
+			stmt.level = tok.value
+		}
+	}
+	switch tok := p.peek(); {
+	case tok.isOperator(";"):
+		if p.handlerDepth == 0 || stmt.level != "exception" {
+			return nil, syntaxError(p.source, raiseTok.start, "RAISE without parameters cannot be used outside an exception handler")
+		}
+		p.next()
+		stmt.isReraise = true
+		return stmt, nil
+	case tok.kind == tokenKind_String:
+		p.next()
+		stmt.format = tok.value
+		for p.peek().isOperator(",") {
+			p.next()
+			param, err := p.parseExpr(func(tok token) bool {
+				return tok.isOperator(",") || tok.isOperator(";") || tok.isWord("using")
+			})
+			if err != nil {
+				return nil, err
+			}
+			stmt.params = append(stmt.params, param)
+		}
+		placeholders := countFormatPlaceholders(stmt.format)
+		if placeholders > len(stmt.params) {
+			return nil, syntaxError(p.source, raiseTok.start, "too few parameters specified for RAISE")
+		} else if placeholders < len(stmt.params) {
+			return nil, syntaxError(p.source, raiseTok.start, "too many parameters specified for RAISE")
+		}
+	case tok.isWord("sqlstate"):
+		p.next()
+		codeTok := p.next()
+		if codeTok.kind != tokenKind_String || !isSqlState(codeTok.value) {
+			return nil, syntaxError(p.source, codeTok.start, "invalid SQLSTATE code")
+		}
+		stmt.sqlState = codeTok.value
+		stmt.condition = codeTok.value
+	case tok.isWord("using"):
+	case tok.isIdentifier():
+		p.next()
+		code, ok := conditionNames[tok.value]
+		if !ok {
+			return nil, syntaxError(p.source, tok.start, fmt.Sprintf(`unrecognized exception condition "%s"`, tok.value))
+		}
+		stmt.sqlState = code
+		stmt.condition = tok.value
+	default:
+		return nil, p.unexpected(tok)
+	}
+	if p.peek().isWord("using") {
+		p.next()
+		for {
+			optionTok := p.peek()
+			name, err := p.identifier()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := raiseOptionNames[name]; !ok {
+				return nil, syntaxError(p.source, optionTok.start, fmt.Sprintf(`unrecognized RAISE statement option "%s"`, name))
+			}
+			for _, option := range stmt.options {
+				if option.name == name {
+					return nil, syntaxError(p.source, optionTok.start, fmt.Sprintf("RAISE option already specified: %s", strings.ToUpper(name)))
+				}
+			}
+			if (name == "message" && len(stmt.format) > 0) || (name == "errcode" && len(stmt.sqlState) > 0) {
+				return nil, syntaxError(p.source, optionTok.start, fmt.Sprintf("RAISE option already specified: %s", strings.ToUpper(name)))
+			}
+			if tok := p.next(); !tok.isOperator("=") && !tok.isOperator(":=") {
+				return nil, p.unexpected(tok)
+			}
+			expr, err := p.parseExpr(isOperatorStop(",", ";"))
+			if err != nil {
+				return nil, err
+			}
+			stmt.options = append(stmt.options, raiseOption{name: name, expr: expr})
+			if !p.peek().isOperator(",") {
+				break
+			}
+			p.next()
+		}
+	}
+	if len(stmt.format) == 0 && len(stmt.sqlState) == 0 && len(stmt.options) == 0 {
+		return nil, p.unexpected(p.peek())
+	}
+	return stmt, p.expectOperator(";")
+}
+
+// parseGetDiagnostics parses a GET [CURRENT | STACKED] DIAGNOSTICS statement, beginning at GET.
+func (p *blockParser) parseGetDiagnostics(line int) (statement, error) {
+	p.next()
+	stmt := &stmtGetDiagnostics{line: line}
+	if p.peek().isWord("current") {
+		p.next()
+	} else if tok := p.peek(); tok.isWord("stacked") {
+		if p.handlerDepth == 0 {
+			return nil, syntaxError(p.source, tok.start, "GET STACKED DIAGNOSTICS cannot be used outside an exception handler")
+		}
+		p.next()
+		stmt.isStacked = true
+	}
+	if err := p.expectWord("diagnostics"); err != nil {
+		return nil, err
+	}
+	items := currentDiagnosticsItems
+	if stmt.isStacked {
+		items = stackedDiagnosticsItems
+	}
+	for {
+		target, err := p.identifier()
+		if err != nil {
+			return nil, err
+		}
+		if tok := p.next(); !tok.isOperator("=") && !tok.isOperator(":=") {
+			return nil, p.unexpected(tok)
+		}
+		itemTok := p.next()
+		if _, ok := items[itemTok.value]; itemTok.kind != tokenKind_Word || !ok {
+			if stmt.isStacked {
+				return nil, syntaxError(p.source, itemTok.start,
+					fmt.Sprintf(`unrecognized GET STACKED DIAGNOSTICS item "%s"`, p.source[itemTok.start:itemTok.end]))
+			}
+			return nil, syntaxError(p.source, itemTok.start,
+				fmt.Sprintf(`GET DIAGNOSTICS item "%s" is not yet supported`, p.source[itemTok.start:itemTok.end]))
+		}
+		stmt.targets = append(stmt.targets, target)
+		stmt.items = append(stmt.items, itemTok.value)
+		if !p.peek().isOperator(",") {
+			break
+		}
+		p.next()
+	}
+	return stmt, p.expectOperator(";")
+}
+
+// parseSQL parses a SQL statement that is run as-is, other than removing its INTO clause, which assigns the returned
+// row to variables.
+func (p *blockParser) parseSQL(line int) (statement, error) {
+	startTok := p.peek()
+	// INSERT and MERGE use INTO as part of their syntax, so the first INTO is skipped for them
+	skipInto := startTok.isWord("insert") || startTok.isWord("merge")
+	var into *selectInto
+	intoStart, intoEnd := 0, 0
+	for {
+		if _, err := p.scanUntil(func(tok token) bool { return tok.isOperator(";") || tok.isWord("into") }); err != nil {
+			return nil, err
+		}
+		intoTok := p.peek()
+		if intoTok.isOperator(";") {
+			break
+		}
+		p.next()
+		if skipInto {
+			skipInto = false
+			continue
+		}
+		if into != nil {
+			return nil, syntaxError(p.source, intoTok.start, "INTO specified more than once")
+		}
+		into = &selectInto{}
+		intoStart = intoTok.start
+		if p.peek().isWord("strict") {
+			p.next()
+			into.isStrict = true
+		}
+		for {
+			target, err := p.identifier()
+			if err != nil {
+				return nil, err
+			}
+			into.targets = append(into.targets, target)
+			if !p.peek().isOperator(",") {
+				break
+			}
+			p.next()
+		}
+		intoEnd = p.peek().start
+	}
+	endTok := p.peek()
+	query := p.source[startTok.start:endTok.start]
+	if into != nil {
+		query = p.source[startTok.start:intoStart] + " " + p.source[intoEnd:endTok.start]
+	}
+	query = strings.TrimSpace(query)
+	if _, err := parser.ParseOne(query); err != nil {
+		return nil, err
+	}
+	return &stmtSQL{line: line, query: query, into: into}, p.expectOperator(";")
+}
+
+// countFormatPlaceholders returns the number of placeholders within a RAISE format string. Two consecutive percent
+// signs are an escaped percent sign rather than a placeholder.
+func countFormatPlaceholders(format string) int {
+	count := 0
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+		if i+1 < len(format) && format[i+1] == '%' {
+			i++
+			continue
+		}
+		count++
+	}
+	return count
+}
+
+// isSqlState returns whether the given string is a valid SQLSTATE code, which consists of five digits or uppercase
+// letters.
+func isSqlState(code string) bool {
+	if len(code) != 5 {
+		return false
+	}
+	for i := 0; i < len(code); i++ {
+		if !isDigit(code[i]) && (code[i] < 'A' || code[i] > 'Z') {
+			return false
+		}
+	}
+	return true
+}
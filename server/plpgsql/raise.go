@@ -0,0 +1,152 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plpgsql
+
+import (
+	"strings"
+
+	"github.com/dolthub/doltgresql/postgres/messages"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/notices"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// conditionNames maps the names of the conditions that may be raised to their SQLSTATE codes. This only contains the
+// conditions that are most commonly raised by routines.
+var conditionNames = map[string]string{
+	"raise_exception":         pgcode.RaiseException.String(),
+	"no_data_found":           pgcode.NoDataFound.String(),
+	"too_many_rows":           pgcode.TooManyRows.String(),
+	"assert_failure":          pgcode.AssertFailure.String(),
+	"case_not_found":          pgcode.CaseNotFound.String(),
+	"feature_not_supported":   pgcode.FeatureNotSupported.String(),
+	"division_by_zero":        pgcode.DivisionByZero.String(),
+	"invalid_parameter_value": pgcode.InvalidParameterValue.String(),
+	"null_value_not_allowed":  pgcode.NullValueNotAllowed.String(),
+	"not_null_violation":      pgcode.NotNullViolation.String(),
+	"foreign_key_violation":   pgcode.ForeignKeyViolation.String(),
+	"unique_violation":        pgcode.UniqueViolation.String(),
+	"check_violation":         pgcode.CheckViolation.String(),
+	"undefined_object":        pgcode.UndefinedObject.String(),
+	"query_canceled":          pgcode.QueryCanceled.String(),
+	// Conditions for a class of errors match every error within the class when they're handled
+	"data_exception":                 pgcode.DataException.String(),
+	"integrity_constraint_violation": pgcode.IntegrityConstraintViolation.String(),
+}
+
+// raiseSeverities maps the levels of RAISE to the severity of the notice that they send. EXCEPTION raises an error
+// rather than a notice, so it is not included.
+var raiseSeverities = map[string]messages.ErrorResponseSeverity{
+	"debug":   messages.ErrorResponseSeverity_Debug,
+	"log":     messages.ErrorResponseSeverity_Log,
+	"info":    messages.ErrorResponseSeverity_Info,
+	"notice":  messages.ErrorResponseSeverity_Notice,
+	"warning": messages.ErrorResponseSeverity_Warning,
+}
+
+// executeRaise runs a RAISE statement, which either sends a notice to the client or raises an error.
+func (e *executor) executeRaise(stmt *stmtRaise, sc *scope) error {
+	if stmt.isReraise {
+		return pgerrors.Raise(e.ctx, e.handling[len(e.handling)-1].err)
+	}
+	// The condition is the message when one is not otherwise given
+	message := stmt.condition
+	if len(stmt.format) > 0 {
+		params := make([]string, len(stmt.params))
+		for i, param := range stmt.params {
+			val, valType, err := e.evaluate(sc, param, nil)
+			if err != nil {
+				return err
+			}
+			if val == nil {
+				params[i] = "<NULL>"
+			} else if params[i], err = outputValue(val, valType); err != nil {
+				return err
+			}
+		}
+		message = formatRaiseMessage(stmt.format, params)
+	}
+	code := stmt.sqlState
+	var detail, hint string
+	for _, option := range stmt.options {
+		val, valType, err := e.evaluate(sc, option.expr, nil)
+		if err != nil {
+			return err
+		}
+		if val == nil {
+			return pgerrors.Raise(e.ctx, pgerrors.New(pgcode.NullValueNotAllowed, "RAISE statement option cannot be null"))
+		}
+		str, err := outputValue(val, valType)
+		if err != nil {
+			return err
+		}
+		switch option.name {
+		case "message":
+			message = str
+		case "detail":
+			detail = str
+		case "hint":
+			hint = str
+		case "errcode":
+			if isSqlState(str) {
+				code = str
+			} else if conditionCode, ok := conditionNames[strings.ToLower(str)]; ok {
+				code = conditionCode
+			} else {
+				return pgerrors.Raise(e.ctx, pgerrors.Newf(pgcode.UndefinedObject, `unrecognized exception condition "%s"`, str))
+			}
+			if len(message) == 0 {
+				message = str
+			}
+		}
+	}
+	if stmt.level != "exception" {
+		notices.Raise(e.ctx, notices.Notice{
+			Severity:     raiseSeverities[stmt.level],
+			SqlStateCode: code,
+			Message:      message,
+			Detail:       detail,
+			Hint:         hint,
+		})
+		return nil
+	}
+	if len(code) == 0 {
+		code = pgcode.RaiseException.String()
+	}
+	return pgerrors.Raise(e.ctx, pgerrors.New(pgcode.MakeCode(code), message).WithDetail(detail).WithHint(hint))
+}
+
+// formatRaiseMessage replaces each placeholder within the format with the matching parameter. The parameters have
+// already been validated to match the placeholders.
+func formatRaiseMessage(format string, params []string) string {
+	sb := strings.Builder{}
+	paramIdx := 0
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			sb.WriteByte(format[i])
+			continue
+		}
+		if i+1 < len(format) && format[i+1] == '%' {
+			sb.WriteByte('%')
+			i++
+			continue
+		}
+		if paramIdx < len(params) {
+			sb.WriteString(params[paramIdx])
+			paramIdx++
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,257 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plpgsql
+
+import (
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// Block is a BEGIN...END block, which is also the body of every PL/pgSQL function and procedure. Expressions and SQL
+// statements within the block are stored as text, as they're parsed again each time they're run so that variables may
+// be substituted with their current values.
+type Block struct {
+	label        string
+	declarations []declaration
+	statements   []statement
+	// handlers are the WHEN clauses of the block's EXCEPTION section, which handle errors raised by its statements.
+	handlers []exceptionHandler
+	line     int
+}
+
+// declaration is a variable that is declared within the DECLARE section of a block.
+type declaration struct {
+	name string
+	// typ and typeRef are nil for RECORD variables.
+	typ        pgtypes.DoltgresType
+	typeRef    tree.ResolvableTypeReference
+	isConstant bool
+	isNotNull  bool
+	// defaultExpr is the expression that initializes the variable, which is empty when the variable starts as NULL.
+	defaultExpr string
+	// aliasFor is the name of the variable or parameter that this declaration is an alias for, if declared using ALIAS FOR.
+	aliasFor string
+	line     int
+}
+
+// exceptionHandler is a WHEN clause within the EXCEPTION section of a block. The conditions are SQLSTATE codes, or
+// OTHERS, which matches every error besides those that may not be handled.
+type exceptionHandler struct {
+	conditions []string
+	statements []statement
+}
+
+// statement is a single statement within a block.
+type statement interface {
+	// statementLine returns the line of the body that the statement begins on.
+	statementLine() int
+	// statementName returns the name of the statement, which is used in the context of errors.
+	statementName() string
+}
+
+// conditionalBranch is a condition and the statements that are run when it is true, such as an IF or ELSIF branch.
+type conditionalBranch struct {
+	condition  string
+	statements []statement
+}
+
+// caseWhen is a WHEN branch of a CASE statement. Searched CASE statements have a single condition per branch, while
+// simple CASE statements may have multiple values that are compared to the subject.
+type caseWhen struct {
+	exprs      []string
+	statements []statement
+}
+
+// raiseOption is an option given to RAISE within its USING clause.
+type raiseOption struct {
+	name string
+	expr string
+}
+
+// selectInto contains the targets of a SELECT INTO, or any other statement that returns rows using INTO.
+type selectInto struct {
+	targets  []string
+	isStrict bool
+}
+
+type (
+	// stmtAssign is an assignment of an expression to a variable. The field is only given when assigning to a field of
+	// a record variable.
+	stmtAssign struct {
+		line   int
+		target string
+		field  string
+		expr   string
+	}
+	// stmtBlock is a block that is nested within another block.
+	stmtBlock struct {
+		line  int
+		block *Block
+	}
+	// stmtIf is an IF statement, including all of its ELSIF branches.
+	stmtIf struct {
+		line     int
+		branches []conditionalBranch
+		elseBody []statement
+	}
+	// stmtCase is a CASE statement. The subject is empty for a searched CASE.
+	stmtCase struct {
+		line     int
+		subject  string
+		whens    []caseWhen
+		elseBody []statement
+		hasElse  bool
+	}
+	// stmtLoop is an unconditional LOOP.
+	stmtLoop struct {
+		line  int
+		label string
+		body  []statement
+	}
+	// stmtWhile is a WHILE loop.
+	stmtWhile struct {
+		line      int
+		label     string
+		condition string
+		body      []statement
+	}
+	// stmtForInteger is a FOR loop over a range of integers.
+	stmtForInteger struct {
+		line      int
+		label     string
+		variable  string
+		isReverse bool
+		lower     string
+		upper     string
+		step      string
+		body      []statement
+	}
+	// stmtForQuery is a FOR loop over the rows of a query.
+	stmtForQuery struct {
+		line    int
+		label   string
+		targets []string
+		query   string
+		body    []statement
+	}
+	// stmtExit is either an EXIT or CONTINUE statement.
+	stmtExit struct {
+		line       int
+		isContinue bool
+		label      string
+		condition  string
+	}
+	// stmtReturn is a RETURN statement. The expression is empty when no value is given.
+	stmtReturn struct {
+		line int
+		expr string
+	}
+	// stmtReturnNext is a RETURN NEXT statement.
+	stmtReturnNext struct {
+		line int
+		expr string
+	}
+	// stmtReturnQuery is a RETURN QUERY statement.
+	stmtReturnQuery struct {
+		line  int
+		query string
+	}
+	// stmtRaise is a RAISE statement.
+	stmtRaise struct {
+		line    int
+		level   string
+		format  string
+		params  []string
+		options []raiseOption
+		// sqlState is the code of the condition that was given instead of a format, which is named by condition.
+		sqlState  string
+		condition string
+		// isReraise is set for a RAISE without parameters, which raises the error that is being handled again.
+		isReraise bool
+	}
+	// stmtAssert is an ASSERT statement.
+	stmtAssert struct {
+		line      int
+		condition string
+		message   string
+	}
+	// stmtPerform is a PERFORM statement, which runs a query while discarding its results. The query has PERFORM
+	// replaced by SELECT.
+	stmtPerform struct {
+		line  int
+		query string
+	}
+	// stmtGetDiagnostics is a GET DIAGNOSTICS statement. Each target is assigned the item at the same index. Stacked
+	// diagnostics describe the error that is being handled, rather than the most recent statement.
+	stmtGetDiagnostics struct {
+		line      int
+		isStacked bool
+		targets   []string
+		items     []string
+	}
+	// stmtNull is the NULL statement, which does nothing.
+	stmtNull struct {
+		line int
+	}
+	// stmtSQL is a SQL statement, which may return its rows into variables using INTO.
+	stmtSQL struct {
+		line  int
+		query string
+		into  *selectInto
+	}
+)
+
+func (s *stmtAssign) statementLine() int         { return s.line }
+func (s *stmtBlock) statementLine() int          { return s.line }
+func (s *stmtIf) statementLine() int             { return s.line }
+func (s *stmtCase) statementLine() int           { return s.line }
+func (s *stmtLoop) statementLine() int           { return s.line }
+func (s *stmtWhile) statementLine() int          { return s.line }
+func (s *stmtForInteger) statementLine() int     { return s.line }
+func (s *stmtForQuery) statementLine() int       { return s.line }
+func (s *stmtExit) statementLine() int           { return s.line }
+func (s *stmtReturn) statementLine() int         { return s.line }
+func (s *stmtReturnNext) statementLine() int     { return s.line }
+func (s *stmtReturnQuery) statementLine() int    { return s.line }
+func (s *stmtRaise) statementLine() int          { return s.line }
+func (s *stmtAssert) statementLine() int         { return s.line }
+func (s *stmtPerform) statementLine() int        { return s.line }
+func (s *stmtGetDiagnostics) statementLine() int { return s.line }
+func (s *stmtNull) statementLine() int           { return s.line }
+func (s *stmtSQL) statementLine() int            { return s.line }
+
+func (s *stmtAssign) statementName() string     { return "assignment" }
+func (s *stmtBlock) statementName() string      { return "statement block" }
+func (s *stmtIf) statementName() string         { return "IF" }
+func (s *stmtCase) statementName() string       { return "CASE" }
+func (s *stmtLoop) statementName() string       { return "LOOP" }
+func (s *stmtWhile) statementName() string      { return "WHILE" }
+func (s *stmtForInteger) statementName() string { return "FOR with integer loop variable" }
+func (s *stmtForQuery) statementName() string   { return "FOR over SELECT rows" }
+func (s *stmtExit) statementName() string {
+	if s.isContinue {
+		return "CONTINUE"
+	}
+	return "EXIT"
+}
+func (s *stmtReturn) statementName() string         { return "RETURN" }
+func (s *stmtReturnNext) statementName() string     { return "RETURN NEXT" }
+func (s *stmtReturnQuery) statementName() string    { return "RETURN QUERY" }
+func (s *stmtRaise) statementName() string          { return "RAISE" }
+func (s *stmtAssert) statementName() string         { return "ASSERT" }
+func (s *stmtPerform) statementName() string        { return "PERFORM" }
+func (s *stmtGetDiagnostics) statementName() string { return "GET DIAGNOSTICS" }
+func (s *stmtNull) statementName() string           { return "NULL" }
+func (s *stmtSQL) statementName() string            { return "SQL statement" }
@@ -0,0 +1,156 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plpgsql
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/parser"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// nameTypeRef is the type of the trigger variables that hold names.
+var nameTypeRef = mustParseType("name")
+
+// textTypeRef is the type of the trigger variables that hold text.
+var textTypeRef = mustParseType("text")
+
+// textArrayTypeRef is the type of TG_ARGV.
+var textArrayTypeRef = mustParseType("text[]")
+
+// TriggerData describes the trigger that is calling a trigger function, along with the row that it fired for.
+type TriggerData struct {
+	Name string
+	// When is either BEFORE or AFTER.
+	When string
+	// Level is always ROW, as statement-level triggers are not yet supported.
+	Level string
+	// Operation is INSERT, UPDATE, or DELETE.
+	Operation   string
+	TableName   string
+	TableSchema string
+	Arguments   []string
+	// Columns is the schema of the table's rows. New and Old are nil when the operation does not have that row.
+	Columns sql.Schema
+	New     sql.Row
+	Old     sql.Row
+}
+
+// declareTriggerVariables adds the special variables that are available within trigger functions to the scope.
+func declareTriggerVariables(sc *scope, trigger *TriggerData) {
+	sc.variables["new"] = &variable{name: "new", isRecord: true, record: triggerRecord(trigger.Columns, trigger.New)}
+	sc.variables["old"] = &variable{name: "old", isRecord: true, record: triggerRecord(trigger.Columns, trigger.Old)}
+	for name, val := range map[string]string{
+		"tg_name":         trigger.Name,
+		"tg_table_name":   trigger.TableName,
+		"tg_relname":      trigger.TableName,
+		"tg_table_schema": trigger.TableSchema,
+	} {
+		sc.variables[name] = &variable{name: name, typ: pgtypes.Name, typeRef: nameTypeRef, value: val, isConstant: true}
+	}
+	for name, val := range map[string]string{
+		"tg_when":  trigger.When,
+		"tg_level": trigger.Level,
+		"tg_op":    trigger.Operation,
+	} {
+		sc.variables[name] = &variable{name: name, typ: pgtypes.Text, typeRef: textTypeRef, value: val, isConstant: true}
+	}
+	args := make([]any, len(trigger.Arguments))
+	for i, arg := range trigger.Arguments {
+		args[i] = arg
+	}
+	sc.variables["tg_nargs"] = &variable{name: "tg_nargs", typ: pgtypes.Int32, typeRef: integerTypeRef,
+		value: int32(len(args)), isConstant: true}
+	// TG_ARGV is indexed starting from zero, unlike other arrays
+	sc.variables["tg_argv"] = &variable{name: "tg_argv", typ: pgtypes.TextArray, typeRef: textArrayTypeRef,
+		value: args, isConstant: true, zeroBasedSubscripts: true}
+}
+
+// triggerRecord returns the record that holds the given row. Returns nil when the row is nil, which leaves the record
+// unassigned.
+func triggerRecord(columns sql.Schema, row sql.Row) *record {
+	if row == nil {
+		return nil
+	}
+	rec := &record{
+		names:  make([]string, len(columns)),
+		types:  make([]sql.Type, len(columns)),
+		values: make([]any, len(columns)),
+	}
+	for i, col := range columns {
+		rec.names[i] = col.Name
+		rec.types[i] = col.Type
+	}
+	copy(rec.values, row)
+	return rec
+}
+
+// triggerReturnValue evaluates the expression of a RETURN statement within a trigger function, which must be either a
+// record variable or NULL. Returns the row held by the record, which is nil when the record is NULL.
+func (e *executor) triggerReturnValue(expr string, sc *scope) ([]any, error) {
+	if len(expr) == 0 {
+		return nil, pgerrors.New(pgcode.Syntax, `missing expression at or near ";"`)
+	}
+	parsed, err := parser.ParseExpr(expr)
+	if err != nil {
+		return nil, err
+	}
+	if parsed == tree.DNull {
+		return nil, nil
+	}
+	var v *variable
+	if name, ok := parsed.(*tree.UnresolvedName); ok && name.NumParts == 1 && !name.Star {
+		v = sc.lookup(name.Parts[0])
+	}
+	if v == nil || !v.isRecord {
+		return nil, pgerrors.New(pgcode.FeatureNotSupported,
+			"trigger functions may only return a record variable or NULL")
+	}
+	if v.record == nil {
+		return nil, nil
+	}
+	columns := e.routine.Trigger.Columns
+	if len(v.record.values) != len(columns) {
+		return nil, pgerrors.Raise(e.ctx, pgerrors.New(pgcode.DatatypeMismatch, "returned row structure does not match the structure of the triggering table").
+			WithDetail(fmt.Sprintf("Number of returned columns (%d) does not match expected column count (%d).", len(v.record.values), len(columns))))
+	}
+	row := make([]any, len(columns))
+	for i, col := range columns {
+		colType, _ := col.Type.(pgtypes.DoltgresType)
+		if row[i], err = convertValue(e.ctx, v.record.values[i], v.record.types[i], colType); err != nil {
+			return nil, err
+		}
+	}
+	return row, nil
+}
+
+// EvaluateTriggerCondition evaluates the WHEN condition of a trigger, which may reference the NEW and OLD rows. NULL is
+// treated as false.
+func EvaluateTriggerCondition(ctx *sql.Context, condition string, trigger *TriggerData, runner StatementRunner) (bool, error) {
+	e := &executor{
+		ctx:     ctx,
+		runner:  runner,
+		routine: Routine{Name: trigger.Name, Trigger: trigger},
+	}
+	sc := newScope("", nil)
+	sc.variables["new"] = &variable{name: "new", isRecord: true, record: triggerRecord(trigger.Columns, trigger.New)}
+	sc.variables["old"] = &variable{name: "old", isRecord: true, record: triggerRecord(trigger.Columns, trigger.Old)}
+	return e.evaluateCondition(sc, condition)
+}
@@ -0,0 +1,285 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plpgsql
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/parser"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// booleanTypeRef is the type that conditions are cast to.
+var booleanTypeRef = mustParseType("boolean")
+
+// integerTypeRef is the type of the variable of an integer FOR loop, which its bounds are also cast to.
+var integerTypeRef = mustParseType("integer")
+
+// variable is a variable within a routine's body, which includes the routine's parameters.
+type variable struct {
+	name string
+	// typ and typeRef are nil for record variables.
+	typ        pgtypes.DoltgresType
+	typeRef    tree.ResolvableTypeReference
+	value      any
+	isRecord   bool
+	isConstant bool
+	isNotNull  bool
+	// record holds the value of a record variable, which is nil until the variable is assigned a row.
+	record *record
+	// zeroBasedSubscripts is set for array variables whose first element has the subscript zero rather than one.
+	zeroBasedSubscripts bool
+}
+
+// record is the value of a record variable, which takes on the structure of the row that it is assigned.
+type record struct {
+	names  []string
+	types  []sql.Type
+	values []any
+}
+
+// scope holds the variables that are declared within a block or loop.
+type scope struct {
+	label     string
+	variables map[string]*variable
+	parent    *scope
+}
+
+// newScope returns a new *scope that is nested within the given parent, which may be nil.
+func newScope(label string, parent *scope) *scope {
+	return &scope{
+		label:     label,
+		variables: make(map[string]*variable),
+		parent:    parent,
+	}
+}
+
+// lookup returns the variable with the given name, searching from the innermost scope outward. Returns nil if the
+// variable does not exist.
+func (s *scope) lookup(name string) *variable {
+	for sc := s; sc != nil; sc = sc.parent {
+		if v, ok := sc.variables[name]; ok {
+			return v
+		}
+	}
+	return nil
+}
+
+// lookupQualified returns the variable with the given name that belongs to the scope with the given label. Returns nil
+// if the variable does not exist.
+func (s *scope) lookupQualified(label string, name string) *variable {
+	for sc := s; sc != nil; sc = sc.parent {
+		if sc.label != label {
+			continue
+		}
+		if v, ok := sc.variables[name]; ok {
+			return v
+		}
+	}
+	return nil
+}
+
+// valueExpr returns an expression that represents the current value of the variable.
+func (v *variable) valueExpr() (tree.Expr, error) {
+	if v.isRecord {
+		return nil, pgerrors.Newf(pgcode.FeatureNotSupported, `using record variable "%s" as a value is not yet supported`, v.name)
+	}
+	if v.value == nil {
+		return &tree.CastExpr{Expr: tree.DNull, Type: v.typeRef, SyntaxMode: tree.CastShort}, nil
+	}
+	str, err := v.typ.IoOutput(v.value)
+	if err != nil {
+		return nil, err
+	}
+	return &tree.CastExpr{Expr: tree.NewStrVal(str), Type: v.typeRef, SyntaxMode: tree.CastShort}, nil
+}
+
+// fieldExpr returns an expression that represents the current value of the given field of a record variable.
+func (v *variable) fieldExpr(field string) (tree.Expr, error) {
+	if v.record == nil {
+		return nil, pgerrors.Newf(pgcode.ObjectNotInPrerequisiteState, `record "%s" is not assigned yet`, v.name).
+			WithDetail("The tuple structure of a not-yet-assigned record is indeterminate.")
+	}
+	for i, name := range v.record.names {
+		if name == field {
+			return valueExpr(v.record.values[i], v.record.types[i])
+		}
+	}
+	return nil, pgerrors.Newf(pgcode.UndefinedColumn, `record "%s" has no field "%s"`, v.name, field)
+}
+
+// valueExpr returns an expression that represents the given value, which is cast to its type.
+func valueExpr(val any, typ sql.Type) (tree.Expr, error) {
+	doltgresType, ok := typ.(pgtypes.DoltgresType)
+	if !ok {
+		if val == nil {
+			return tree.DNull, nil
+		}
+		return tree.NewStrVal(fmt.Sprint(val)), nil
+	}
+	var expr tree.Expr = tree.DNull
+	if val != nil {
+		str, err := doltgresType.IoOutput(val)
+		if err != nil {
+			return nil, err
+		}
+		expr = tree.NewStrVal(str)
+	}
+	typeRef, err := parser.ParseType(doltgresType.String())
+	if err != nil {
+		// Types that cannot be named, such as unknown, are left for the engine to resolve
+		return expr, nil
+	}
+	return &tree.CastExpr{Expr: expr, Type: typeRef, SyntaxMode: tree.CastShort}, nil
+}
+
+// convertValue converts the value from its type into the target type, using the same conversions as assignments.
+func convertValue(ctx *sql.Context, val any, valType sql.Type, targetType pgtypes.DoltgresType) (any, error) {
+	if val == nil || targetType == nil {
+		return val, nil
+	}
+	sourceType, ok := valType.(pgtypes.DoltgresType)
+	if !ok {
+		return targetType.IoInput(fmt.Sprint(val))
+	}
+	if sourceType.BaseID() == targetType.BaseID() {
+		return val, nil
+	}
+	if castFunc := framework.GetAssignmentCast(sourceType.BaseID(), targetType.BaseID()); castFunc != nil {
+		return castFunc(ctx, val, targetType)
+	}
+	if castFunc := framework.GetExplicitCast(sourceType.BaseID(), targetType.BaseID()); castFunc != nil {
+		return castFunc(ctx, val, targetType)
+	}
+	// PL/pgSQL falls back to converting through the text representation of the value
+	str, err := sourceType.IoOutput(val)
+	if err != nil {
+		return nil, err
+	}
+	return targetType.IoInput(str)
+}
+
+// outputValue returns the text representation of the given value, which must not be NULL.
+func outputValue(val any, valType sql.Type) (string, error) {
+	if doltgresType, ok := valType.(pgtypes.DoltgresType); ok {
+		return doltgresType.IoOutput(val)
+	}
+	return fmt.Sprint(val), nil
+}
+
+// mustParseType parses the given type, panicking if it cannot be parsed. This is only used for built-in types.
+func mustParseType(typ string) tree.ResolvableTypeReference {
+	typeRef, err := parser.ParseType(typ)
+	if err != nil {
+		panic(err)
+	}
+	return typeRef
+}
+
+// variableVisitor replaces references to variables with their current values.
+type variableVisitor struct {
+	executor *executor
+	scope    *scope
+	err      error
+}
+
+var _ tree.Visitor = (*variableVisitor)(nil)
+
+// VisitPre implements the interface tree.Visitor.
+func (v *variableVisitor) VisitPre(expr tree.Expr) (recurse bool, newExpr tree.Expr) {
+	if v.err != nil {
+		return false, expr
+	}
+	switch expr := expr.(type) {
+	case *tree.UnresolvedName:
+		if expr.Star {
+			break
+		}
+		switch expr.NumParts {
+		case 1:
+			if variable := v.scope.lookup(expr.Parts[0]); variable != nil {
+				newExpr, v.err = variable.valueExpr()
+			}
+		case 2:
+			// Qualified names are either fields of a record, or variables that are qualified by a label
+			if variable := v.scope.lookup(expr.Parts[1]); variable != nil && variable.isRecord {
+				newExpr, v.err = variable.fieldExpr(expr.Parts[0])
+			} else if variable = v.scope.lookupQualified(expr.Parts[1], expr.Parts[0]); variable != nil {
+				newExpr, v.err = variable.valueExpr()
+			}
+		}
+	case *tree.IndirectionExpr:
+		if name, ok := expr.Expr.(*tree.UnresolvedName); ok && name.NumParts == 1 && !name.Star && len(expr.Indirection) == 1 {
+			if variable := v.scope.lookup(name.Parts[0]); variable != nil && !variable.isRecord {
+				newExpr, v.err = v.elementExpr(variable, expr.Indirection[0])
+			}
+		}
+	case *tree.Placeholder:
+		if int(expr.Idx) < len(v.executor.params) {
+			newExpr, v.err = v.executor.params[expr.Idx].valueExpr()
+		}
+	}
+	if v.err != nil {
+		return false, expr
+	}
+	if newExpr != nil {
+		return false, newExpr
+	}
+	return true, expr
+}
+
+// VisitPost implements the interface tree.Visitor.
+func (v *variableVisitor) VisitPost(expr tree.Expr) tree.Expr {
+	return expr
+}
+
+// elementExpr returns an expression that represents the element of an array variable at the given subscript. Elements
+// that are out of range are NULL.
+func (v *variableVisitor) elementExpr(variable *variable, subscript *tree.ArraySubscript) (tree.Expr, error) {
+	arrayType, ok := variable.typ.(pgtypes.DoltgresArrayType)
+	if !ok {
+		return nil, pgerrors.Newf(pgcode.DatatypeMismatch, "cannot subscript type %s because it is not an array", variable.typ.String())
+	}
+	if subscript.Slice {
+		return nil, pgerrors.New(pgcode.FeatureNotSupported, "array slices are not yet supported")
+	}
+	// The subscript may reference other variables, so they're replaced before it is evaluated
+	subscriptExpr, _ := tree.WalkExpr(v, subscript.Begin)
+	if v.err != nil {
+		return nil, v.err
+	}
+	idx, _, err := v.executor.evaluateExpr(v.scope, &tree.CastExpr{Expr: subscriptExpr, Type: integerTypeRef, SyntaxMode: tree.CastShort})
+	if err != nil {
+		return nil, err
+	}
+	elements, _ := variable.value.([]any)
+	if idx == nil {
+		return valueExpr(nil, arrayType.BaseType())
+	}
+	i := int(idx.(int32))
+	if !variable.zeroBasedSubscripts {
+		i--
+	}
+	if i < 0 || i >= len(elements) {
+		return valueExpr(nil, arrayType.BaseType())
+	}
+	return valueExpr(elements[i], arrayType.BaseType())
+}
@@ -0,0 +1,114 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prepared
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Statement describes a prepared statement of a session, as reported by pg_prepared_statements.
+type Statement struct {
+	Name string
+	// Statement is the query string that created the prepared statement. For statements created using PREPARE, this is
+	// the PREPARE statement itself.
+	Statement   string
+	PrepareTime time.Time
+	// ParameterTypes and ResultTypes are the OIDs of the types of the parameters and result columns.
+	ParameterTypes []uint32
+	ResultTypes    []uint32
+	// FromSQL is true when the statement was created using PREPARE, and false when it was created using the extended
+	// query protocol.
+	FromSQL bool
+	// GenericPlans and CustomPlans are the number of times that each kind of plan was chosen. Parameters are always
+	// bound into the plan, so every execution is counted as a custom plan.
+	GenericPlans int64
+	CustomPlans  int64
+}
+
+// Cursor describes an open portal of a session, as reported by pg_cursors.
+type Cursor struct {
+	Name         string
+	Statement    string
+	IsHoldable   bool
+	IsBinary     bool
+	IsScrollable bool
+	CreationTime time.Time
+}
+
+// Source returns the prepared statements and cursors of a session. The methods are called while the session is running
+// a query, so they do not need to synchronize with the session itself.
+type Source interface {
+	// PreparedStatements returns the session's named prepared statements.
+	PreparedStatements() []Statement
+	// Cursors returns the session's named portals.
+	Cursors() []Cursor
+}
+
+// sources holds the Source of every session, keyed by the session's ID.
+var sources = struct {
+	sync.Mutex
+	byID map[uint32]Source
+}{byID: make(map[uint32]Source)}
+
+// Register sets the Source that reports the prepared statements and cursors of the session.
+func Register(sessionID uint32, source Source) {
+	sources.Lock()
+	defer sources.Unlock()
+	sources.byID[sessionID] = source
+}
+
+// Unregister removes the session's Source.
+func Unregister(sessionID uint32) {
+	sources.Lock()
+	defer sources.Unlock()
+	delete(sources.byID, sessionID)
+}
+
+// Statements returns the prepared statements of the session, sorted by name. Returns nothing for sessions that have not
+// been registered, such as those that do not come from a client connection.
+func Statements(sessionID uint32) []Statement {
+	source := getSource(sessionID)
+	if source == nil {
+		return nil
+	}
+	statements := source.PreparedStatements()
+	sort.Slice(statements, func(i, j int) bool {
+		return statements[i].Name < statements[j].Name
+	})
+	return statements
+}
+
+// Cursors returns the cursors of the session, sorted by name. Returns nothing for sessions that have not been
+// registered.
+func Cursors(sessionID uint32) []Cursor {
+	source := getSource(sessionID)
+	if source == nil {
+		return nil
+	}
+	cursors := source.Cursors()
+	sort.Slice(cursors, func(i, j int) bool {
+		return cursors[i].Name < cursors[j].Name
+	})
+	return cursors
+}
+
+// getSource returns the Source of the session, or nil if it has not been registered.
+func getSource(sessionID uint32) Source {
+	sources.Lock()
+	defer sources.Unlock()
+	return sources.byID[sessionID]
+}
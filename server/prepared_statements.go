@@ -0,0 +1,291 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/dolthub/vitess/go/sqltypes"
+	querypb "github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/doltgresql/postgres/connection"
+	"github.com/dolthub/doltgresql/postgres/messages"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+	"github.com/dolthub/doltgresql/server/prepared"
+)
+
+var _ prepared.Source = (*ConnectionHandler)(nil)
+
+// registerPreparedStatements reports the connection's prepared statements and portals to pg_prepared_statements and
+// pg_cursors.
+func (h *ConnectionHandler) registerPreparedStatements() {
+	prepared.Register(h.mysqlConn.ConnectionID, h)
+}
+
+// unregisterPreparedStatements stops reporting the connection's prepared statements and portals.
+func (h *ConnectionHandler) unregisterPreparedStatements() {
+	prepared.Unregister(h.mysqlConn.ConnectionID)
+}
+
+// PreparedStatements implements the interface prepared.Source. The unnamed statement is not included, matching Postgres.
+func (h *ConnectionHandler) PreparedStatements() []prepared.Statement {
+	statements := make([]prepared.Statement, 0, len(h.preparedStatements))
+	for name, data := range h.preparedStatements {
+		if len(name) == 0 {
+			continue
+		}
+		statement := data.Statement
+		if len(statement) == 0 {
+			statement = data.Query.String
+		}
+		parameterTypes := make([]uint32, len(data.BindVarTypes))
+		for i, typ := range data.BindVarTypes {
+			parameterTypes[i] = uint32(typ)
+		}
+		var resultTypes []uint32
+		if returnsRow(data.Query.StatementTag, data.ReturnFields) {
+			resultTypes = make([]uint32, len(data.ReturnFields))
+			for i, field := range data.ReturnFields {
+				typ, err := messages.VitessFieldToDataTypeObjectID(field)
+				if err != nil {
+					continue
+				}
+				resultTypes[i] = uint32(typ)
+			}
+		}
+		statements = append(statements, prepared.Statement{
+			Name:           name,
+			Statement:      statement,
+			PrepareTime:    data.PrepareTime,
+			ParameterTypes: parameterTypes,
+			ResultTypes:    resultTypes,
+			FromSQL:        data.FromSQL,
+			CustomPlans:    data.Executions,
+		})
+	}
+	return statements
+}
+
+// Cursors implements the interface prepared.Source. The unnamed portal is not included, matching Postgres.
+func (h *ConnectionHandler) Cursors() []prepared.Cursor {
+	cursors := make([]prepared.Cursor, 0, len(h.portals))
+	for name, data := range h.portals {
+		if len(name) == 0 {
+			continue
+		}
+		cursor := prepared.Cursor{
+			Name:         name,
+			Statement:    data.Query.String,
+			CreationTime: data.CreationTime,
+		}
+		if data.Cursor != nil {
+			cursor.Statement = data.Cursor.Statement
+			cursor.IsHoldable = data.Cursor.Hold
+			cursor.IsScrollable = data.Cursor.Scrollable
+		}
+		cursors = append(cursors, cursor)
+	}
+	return cursors
+}
+
+// prepareStatement parses and plans the given query, returning a prepared statement that portals may be bound to. The
+// parameter types are OIDs, and any that are missing or zero are inferred from the query.
+func (h *ConnectionHandler) prepareStatement(queryString string, parameterTypes []uint32) (PreparedStatementData, error) {
+	query, err := h.convertQuery(queryString)
+	if err != nil {
+		return PreparedStatementData{}, err
+	}
+	preparedData := PreparedStatementData{
+		Query:       query,
+		PrepareTime: time.Now(),
+	}
+	if query.AST == nil {
+		// special case: empty query
+		return preparedData, nil
+	}
+	switch stmt := connectionStatement(query).(type) {
+	case *pgnodes.Prepare, *pgnodes.DeclareCursor, *pgnodes.CloseCursor, *pgnodes.Savepoint,
+		*pgnodes.RollbackToSavepoint, *pgnodes.ReleaseSavepoint, *pgnodes.BeginTransaction, *pgnodes.SetTransaction,
+		*pgnodes.SetLocal, *pgnodes.ResetAll:
+		return preparedData, nil
+	case *pgnodes.Execute:
+		// The statement is described by the prepared statement that it executes
+		target, ok := h.preparedStatements[stmt.Name()]
+		if !ok {
+			return PreparedStatementData{}, pgerrors.Newf(pgcode.InvalidSQLStatementName, `prepared statement "%s" does not exist`, stmt.Name())
+		}
+		preparedData.Query.StatementTag = target.Query.StatementTag
+		preparedData.ReturnFields = target.ReturnFields
+		return preparedData, nil
+	case *pgnodes.FetchCursor:
+		// FETCH returns the rows of its cursor, while MOVE does not return any rows
+		if !stmt.IsMove() {
+			portalData, err := h.getCursor(stmt.Name())
+			if err != nil {
+				return PreparedStatementData{}, err
+			}
+			preparedData.ReturnFields = portalData.Fields
+		}
+		return preparedData, nil
+	}
+
+	plan, fields, err := h.getPlanAndFields(query)
+	if err != nil {
+		return PreparedStatementData{}, err
+	}
+
+	// TODO: bindvar types can be specified directly in the message, need tests of this
+	bindVarTypes, err := h.extractBindVarTypes(plan)
+	if err != nil {
+		return PreparedStatementData{}, err
+	}
+	for i, typ := range parameterTypes {
+		if i >= len(bindVarTypes) {
+			bindVarTypes = append(bindVarTypes, int32(typ))
+		} else if typ != 0 {
+			bindVarTypes[i] = int32(typ)
+		}
+	}
+
+	// Nil fields means an OKResult, fill one in here
+	if fields == nil {
+		fields = []*querypb.Field{
+			{
+				Name: types.OkResultColumnName,
+				Type: sqltypes.Int32,
+			},
+		}
+	}
+	preparedData.ReturnFields = fields
+	preparedData.BindVarTypes = bindVarTypes
+	return preparedData, nil
+}
+
+// handlePrepare handles the PREPARE statement, which creates a prepared statement in the same way as a Parse message.
+func (h *ConnectionHandler) handlePrepare(query ConvertedQuery, prepare *pgnodes.Prepare) error {
+	if _, ok := h.preparedStatements[prepare.Name()]; ok {
+		return pgerrors.Newf(pgcode.DuplicatePreparedStatement, `prepared statement "%s" already exists`, prepare.Name())
+	}
+	preparedData, err := h.prepareStatement(prepare.Statement(), prepare.ParameterTypes())
+	if err != nil {
+		return err
+	}
+	preparedData.Statement = query.String
+	preparedData.FromSQL = true
+	h.preparedStatements[prepare.Name()] = preparedData
+	return connection.Send(h.Conn(), messages.CommandComplete{
+		Query: query.String,
+		Tag:   query.StatementTag,
+	})
+}
+
+// handleExecuteStatement handles the EXECUTE statement, which binds the given parameters to a prepared statement and
+// runs it. The prepared statement may have been created by either PREPARE or a Parse message. The results are sent in
+// the same way as the prepared statement's query would be sent, where |isExecute| is set when the EXECUTE statement
+// itself was run by an Execute message.
+func (h *ConnectionHandler) handleExecuteStatement(execute *pgnodes.Execute, isExecute bool) error {
+	preparedData, ok := h.preparedStatements[execute.Name()]
+	if !ok {
+		return pgerrors.Newf(pgcode.InvalidSQLStatementName, `prepared statement "%s" does not exist`, execute.Name())
+	}
+	if preparedData.Query.AST == nil {
+		return connection.Send(h.Conn(), messages.EmptyQueryResponse{})
+	}
+	parameters := execute.Parameters()
+	if len(parameters) != len(preparedData.BindVarTypes) {
+		return pgerrors.Newf(pgcode.Syntax, `wrong number of parameters for prepared statement "%s"`, execute.Name()).
+			WithDetail(fmt.Sprintf("Expected %d parameters but got %d.", len(preparedData.BindVarTypes), len(parameters)))
+	}
+	values, err := h.evaluateParameters(parameters)
+	if err != nil {
+		return err
+	}
+	bindVars, err := h.convertBindParameters(preparedData.BindVarTypes, nil, values)
+	if err != nil {
+		return err
+	}
+	boundPlan, _, err := h.bindParams(preparedData.Query.String, preparedData.Query.AST, bindVars)
+	if err != nil {
+		return err
+	}
+	preparedData.Executions++
+	h.preparedStatements[execute.Name()] = preparedData
+
+	if isExecute && h.needsImplicitTransaction(preparedData.Query.AST) {
+		if err = h.beginImplicitTransaction(); err != nil {
+			return err
+		}
+	}
+	return h.runQuery(preparedData.Query, isExecute, func(callback mysql.ResultSpoolFn) error {
+		return h.handler.(mysql.ExtendedHandler).ComExecuteBound(h.mysqlConn, preparedData.Query.String, boundPlan, callback)
+	})
+}
+
+// connectionStatement returns the statement's node when the statement is handled by the connection, rather than the
+// engine, regardless of which protocol is used to run it. Returns nil for all other statements.
+func connectionStatement(query ConvertedQuery) sql.Node {
+	injectedStmt, ok := query.AST.(sqlparser.InjectedStatement)
+	if !ok {
+		return nil
+	}
+	switch stmt := injectedStmt.Statement.(type) {
+	case *pgnodes.Prepare, *pgnodes.Execute, *pgnodes.DeclareCursor, *pgnodes.FetchCursor, *pgnodes.CloseCursor,
+		*pgnodes.Savepoint, *pgnodes.RollbackToSavepoint, *pgnodes.ReleaseSavepoint, *pgnodes.BeginTransaction,
+		*pgnodes.SetTransaction, *pgnodes.SetLocal, *pgnodes.ResetAll:
+		return stmt.(sql.Node)
+	default:
+		return nil
+	}
+}
+
+// evaluateParameters evaluates the parameters of an EXECUTE statement, returning their values in the text format that
+// Bind messages use. Parameters may be any expression that does not reference a table, so they're evaluated by the
+// engine.
+func (h *ConnectionHandler) evaluateParameters(parameters []string) ([]messages.BindParameterValue, error) {
+	if len(parameters) == 0 {
+		return nil, nil
+	}
+	query, err := h.convertQuery(fmt.Sprintf("SELECT %s;", strings.Join(parameters, ", ")))
+	if err != nil {
+		return nil, err
+	}
+	var values []messages.BindParameterValue
+	err = h.comQuery(query, func(res *sqltypes.Result, more bool) error {
+		for _, row := range res.Rows {
+			for _, value := range row {
+				values = append(values, messages.BindParameterValue{
+					Data:   value.ToBytes(),
+					IsNull: value.IsNull(),
+				})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != len(parameters) {
+		return nil, fmt.Errorf("expected %d parameter values but found %d", len(parameters), len(values))
+	}
+	return values, nil
+}
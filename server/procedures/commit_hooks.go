@@ -0,0 +1,465 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procedures
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/doltgresql/postgres/parser/parser"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	"github.com/dolthub/doltgresql/server/notices"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// CommitHooksTable is the name of the table that stores the hooks that are run after each commit.
+const CommitHooksTable = "commit_hooks"
+
+const (
+	// commitHookTypeHTTP is the type of hook that POSTs the commit's metadata to a URL.
+	commitHookTypeHTTP = "http"
+	// commitHookTypeSQL is the type of hook that runs a statement within the session that created the commit.
+	commitHookTypeSQL = "sql"
+)
+
+// commitHookTimeout is the amount of time that an HTTP hook has to respond.
+const commitHookTimeout = 10 * time.Second
+
+// commitHookProcedures are the Dolt procedures that may create commits on the current branch, and therefore run the
+// commit hooks.
+var commitHookProcedures = map[string]struct{}{
+	"dolt_cherry_pick": {},
+	"dolt_commit":      {},
+	"dolt_merge":       {},
+	"dolt_revert":      {},
+}
+
+// commitHooksTableSchema is the schema of the commit hooks table.
+var commitHooksTableSchema = sql.Schema{
+	{Name: "id", Type: pgtypes.Int64, Source: CommitHooksTable, PrimaryKey: true},
+	{Name: "name", Type: pgtypes.Text, Source: CommitHooksTable},
+	{Name: "type", Type: pgtypes.Text, Source: CommitHooksTable},
+	{Name: "target", Type: pgtypes.Text, Source: CommitHooksTable},
+	{Name: "branch", Type: pgtypes.Text, Source: CommitHooksTable, Nullable: true},
+}
+
+// commitHook is a single row of the commit hooks table. The target is either the URL that is POSTed to, or the
+// statement that is run, depending on the type. When a branch pattern is given, the hook only runs for commits on
+// matching branches. Hooks are run in order of their IDs.
+type commitHook struct {
+	ID     int64
+	Name   string
+	Type   string
+	Target string
+	Branch any
+}
+
+// commitHookEvent is the metadata of a commit that is given to the hooks. HTTP hooks receive it as JSON.
+type commitHookEvent struct {
+	Database      string   `json:"database"`
+	Branch        string   `json:"branch"`
+	Hash          string   `json:"hash"`
+	Parents       []string `json:"parents"`
+	Message       string   `json:"message"`
+	Author        string   `json:"author"`
+	Email         string   `json:"email"`
+	Timestamp     string   `json:"timestamp"`
+	TablesChanged []string `json:"tables_changed"`
+}
+
+// toRow returns the hook as a row of the commit hooks table.
+func (ch commitHook) toRow() sql.Row {
+	return sql.Row{ch.ID, ch.Name, ch.Type, ch.Target, ch.Branch}
+}
+
+// matchesBranch returns whether the hook runs for commits on the given branch.
+func (ch commitHook) matchesBranch(branch string) bool {
+	pattern, ok := ch.Branch.(string)
+	if !ok || len(pattern) == 0 {
+		return true
+	}
+	matched, err := path.Match(pattern, branch)
+	return err == nil && matched
+}
+
+// commitHookFromRow returns the hook represented by the given row of the commit hooks table.
+func commitHookFromRow(row sql.Row) (commitHook, error) {
+	if len(row) != len(commitHooksTableSchema) {
+		return commitHook{}, fmt.Errorf("%s.%s has an unexpected number of columns: %d",
+			SystemSchema, CommitHooksTable, len(row))
+	}
+	return commitHook{
+		ID:     row[0].(int64),
+		Name:   row[1].(string),
+		Type:   row[2].(string),
+		Target: row[3].(string),
+		Branch: row[4],
+	}, nil
+}
+
+// doltCommitHookAdd adds a hook that runs after each commit that is created through a session. Takes the name of the
+// hook, its type (either 'http' or 'sql'), the URL or statement that it targets, and an optional branch pattern.
+func doltCommitHookAdd(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	if len(args) < 3 || len(args) > 4 {
+		return nil, fmt.Errorf("usage: dolt_commit_hook_add('name', 'http' | 'sql', 'url' | 'statement', ['branch'])")
+	}
+	hook := commitHook{Name: args[0], Type: strings.ToLower(args[1]), Target: args[2]}
+	if len(args) == 4 {
+		if _, err := path.Match(args[3], ""); err != nil {
+			return nil, fmt.Errorf(`invalid branch pattern "%s": %w`, args[3], err)
+		}
+		hook.Branch = args[3]
+	}
+	if len(hook.Name) == 0 {
+		return nil, fmt.Errorf("commit hook name cannot be empty")
+	}
+	switch hook.Type {
+	case commitHookTypeHTTP:
+		parsedURL, err := url.Parse(hook.Target)
+		if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || len(parsedURL.Host) == 0 {
+			return nil, fmt.Errorf(`commit hook URL must be an http or https URL: "%s"`, hook.Target)
+		}
+	case commitHookTypeSQL:
+		if _, err := parseCommitHookStatement(hook.Target, commitHookEvent{}); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf(`commit hook type must be either "%s" or "%s"`, commitHookTypeHTTP, commitHookTypeSQL)
+	}
+	table, err := getSystemTable(ctx, CommitHooksTable, commitHooksTableSchema, true)
+	if err != nil {
+		return nil, err
+	}
+	hooks, err := readCommitHooks(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	hook.ID = 1
+	for _, existing := range hooks {
+		if existing.Name == hook.Name {
+			return nil, fmt.Errorf(`commit hook "%s" already exists`, hook.Name)
+		}
+		if existing.ID >= hook.ID {
+			hook.ID = existing.ID + 1
+		}
+	}
+	if err = insertRow(ctx, table, hook.toRow()); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{int64(0)}), nil
+}
+
+// doltCommitHookDrop removes the hook with the given name.
+func doltCommitHookDrop(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("usage: dolt_commit_hook_drop('name')")
+	}
+	table, err := getSystemTable(ctx, CommitHooksTable, commitHooksTableSchema, false)
+	if err != nil {
+		return nil, err
+	}
+	if table != nil {
+		hooks, err := readCommitHooks(ctx, table)
+		if err != nil {
+			return nil, err
+		}
+		for _, hook := range hooks {
+			if hook.Name == args[0] {
+				if err = deleteRow(ctx, table, hook.toRow()); err != nil {
+					return nil, err
+				}
+				return sql.RowsToRowIter(sql.Row{int64(0)}), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf(`commit hook "%s" does not exist`, args[0])
+}
+
+// wrapCommitHooks returns an implementation of a commit-creating procedure that runs every commit hook once the given
+// implementation has moved the head of the current branch. The hooks are read beforehand, as procedures such as merges
+// may replace the working set that contains them. The head is read from the database rather than the session, as
+// fast-forward merges update the branch directly. The commit has already been made by the time the hooks run, so hook
+// failures are reported as warnings rather than errors.
+func wrapCommitHooks(procedure func(*sql.Context, ...string) (sql.RowIter, error)) func(*sql.Context, ...string) (sql.RowIter, error) {
+	return func(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+		dbName := ctx.GetCurrentDatabase()
+		if len(dbName) == 0 {
+			return procedure(ctx, args...)
+		}
+		hooks, err := loadCommitHooks(ctx)
+		if err != nil {
+			notices.RaiseWarning(ctx, fmt.Sprintf("unable to read commit hooks: %s", err.Error()))
+			return procedure(ctx, args...)
+		}
+		if len(hooks) == 0 {
+			return procedure(ctx, args...)
+		}
+		headBefore, err := resolveBranchHead(ctx, dbName)
+		if err != nil {
+			return procedure(ctx, args...)
+		}
+		hashBefore, err := headBefore.HashOf()
+		if err != nil {
+			return nil, err
+		}
+		iter, err := procedure(ctx, args...)
+		if err != nil {
+			return nil, err
+		}
+		headAfter, err := resolveBranchHead(ctx, dbName)
+		if err != nil {
+			return iter, nil
+		}
+		if hashAfter, err := headAfter.HashOf(); err != nil || hashAfter == hashBefore {
+			return iter, nil
+		}
+		runCommitHooks(ctx, dbName, hooks, headAfter)
+		return iter, nil
+	}
+}
+
+// resolveBranchHead returns the commit that the session's current branch points to within the database.
+func resolveBranchHead(ctx *sql.Context, dbName string) (*doltdb.Commit, error) {
+	sess := dsess.DSessFromSess(ctx.Session)
+	dbData, ok := sess.GetDbData(ctx, dbName)
+	if !ok {
+		return nil, sql.ErrDatabaseNotFound.New(dbName)
+	}
+	headRef, err := sess.CWBHeadRef(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	return dbData.Ddb.ResolveCommitRef(ctx, headRef)
+}
+
+// loadCommitHooks returns every hook of the current database, or nil if none have been added.
+func loadCommitHooks(ctx *sql.Context) ([]commitHook, error) {
+	table, err := getSystemTable(ctx, CommitHooksTable, commitHooksTableSchema, false)
+	if err != nil || table == nil {
+		return nil, err
+	}
+	return readCommitHooks(ctx, table)
+}
+
+// runCommitHooks runs every hook that matches the current branch for the given commit. HTTP hooks are sent in the
+// background, while SQL hooks are run within the session.
+func runCommitHooks(ctx *sql.Context, dbName string, hooks []commitHook, commit *doltdb.Commit) {
+	headRef, err := dsess.DSessFromSess(ctx.Session).CWBHeadRef(ctx, dbName)
+	if err != nil {
+		notices.RaiseWarning(ctx, fmt.Sprintf("unable to run commit hooks: %s", err.Error()))
+		return
+	}
+	branch := headRef.GetPath()
+	var event commitHookEvent
+	var eventErr error
+	eventLoaded := false
+	transactionRestarted := false
+	for _, hook := range hooks {
+		if !hook.matchesBranch(branch) {
+			continue
+		}
+		if !eventLoaded {
+			event, eventErr = newCommitHookEvent(ctx, dbName, branch, commit)
+			eventLoaded = true
+		}
+		if eventErr != nil {
+			notices.RaiseWarning(ctx, fmt.Sprintf("unable to run commit hooks: %s", eventErr.Error()))
+			return
+		}
+		switch hook.Type {
+		case commitHookTypeHTTP:
+			go postCommitHook(hook, event)
+		case commitHookTypeSQL:
+			// The commit has ended the transaction, so writes made by the statement would otherwise be discarded
+			if !transactionRestarted {
+				if err = restartTransaction(ctx); err != nil {
+					notices.RaiseWarning(ctx, fmt.Sprintf("unable to run commit hooks: %s", err.Error()))
+					return
+				}
+				transactionRestarted = true
+			}
+			if err := runCommitHookStatement(ctx, hook, event); err != nil {
+				notices.RaiseWarning(ctx, fmt.Sprintf(`commit hook "%s" failed: %s`, hook.Name, err.Error()))
+			}
+		default:
+			notices.RaiseWarning(ctx, fmt.Sprintf(`commit hook "%s" has an unknown type: %s`, hook.Name, hook.Type))
+		}
+	}
+}
+
+// newCommitHookEvent returns the metadata of the given commit. The changed tables are found by comparing the commit
+// against its first parent.
+func newCommitHookEvent(ctx *sql.Context, dbName string, branch string, commit *doltdb.Commit) (commitHookEvent, error) {
+	commitHash, err := commit.HashOf()
+	if err != nil {
+		return commitHookEvent{}, err
+	}
+	meta, err := commit.GetCommitMeta(ctx)
+	if err != nil {
+		return commitHookEvent{}, err
+	}
+	parentHashes, err := commit.ParentHashes(ctx)
+	if err != nil {
+		return commitHookEvent{}, err
+	}
+	event := commitHookEvent{
+		Database:      dbName,
+		Branch:        branch,
+		Hash:          commitHash.String(),
+		Parents:       make([]string, len(parentHashes)),
+		Message:       meta.Description,
+		Author:        meta.Name,
+		Email:         meta.Email,
+		Timestamp:     meta.Time().UTC().Format(time.RFC3339),
+		TablesChanged: []string{},
+	}
+	for i, parentHash := range parentHashes {
+		event.Parents[i] = parentHash.String()
+	}
+	if len(parentHashes) == 0 {
+		return event, nil
+	}
+	optParent, err := commit.GetParent(ctx, 0)
+	if err != nil {
+		return commitHookEvent{}, err
+	}
+	parent, ok := optParent.ToCommit()
+	if !ok {
+		return commitHookEvent{}, doltdb.ErrGhostCommitRuntimeFailure
+	}
+	parentRoot, err := parent.GetRootValue(ctx)
+	if err != nil {
+		return commitHookEvent{}, err
+	}
+	commitRoot, err := commit.GetRootValue(ctx)
+	if err != nil {
+		return commitHookEvent{}, err
+	}
+	deltas, err := diff.GetTableDeltas(ctx, parentRoot, commitRoot)
+	if err != nil {
+		return commitHookEvent{}, err
+	}
+	for _, td := range deltas {
+		if td.FromTable == nil && td.ToTable == nil {
+			// Collation changes are reported as a delta without any tables
+			continue
+		}
+		event.TablesChanged = append(event.TablesChanged, tableDeltaName(td).String())
+	}
+	sort.Strings(event.TablesChanged)
+	return event, nil
+}
+
+// postCommitHook sends the event to the URL of the hook as JSON. As this runs in the background, failures are logged.
+func postCommitHook(hook commitHook, event commitHookEvent) {
+	logger := logrus.WithField("database", event.Database).WithField("hook", hook.Name)
+	body, err := json.Marshal(event)
+	if err != nil {
+		logger.Errorf("commit hook failed: %v", err)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), commitHookTimeout)
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.Target, bytes.NewReader(body))
+	if err != nil {
+		logger.Errorf("commit hook failed: %v", err)
+		return
+	}
+	request.Header.Set("Content-Type", "application/json")
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		logger.Errorf("commit hook failed: %v", err)
+		return
+	}
+	_ = response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		logger.Errorf("commit hook failed: %s responded with %s", hook.Target, response.Status)
+	}
+}
+
+// runCommitHookStatement runs the statement of the SQL hook within the session, with the event's placeholders
+// substituted.
+func runCommitHookStatement(ctx *sql.Context, hook commitHook, event commitHookEvent) error {
+	runner := statementRunner.Load()
+	if runner == nil {
+		return fmt.Errorf("commit hooks cannot be run until the server has started")
+	}
+	stmt, err := parseCommitHookStatement(hook.Target, event)
+	if err != nil {
+		return err
+	}
+	_, _, err = (*runner)(ctx, stmt)
+	return err
+}
+
+// parseCommitHookStatement parses the statement of a SQL hook, which must be a single statement. The placeholders
+// {database}, {branch}, {hash}, {message}, {author}, {email}, and {tables} are replaced with string literals holding the
+// event's values, with {tables} being a comma-separated list.
+func parseCommitHookStatement(statement string, event commitHookEvent) (tree.Statement, error) {
+	if len(strings.TrimSpace(statement)) == 0 {
+		return nil, fmt.Errorf("commit hook statement cannot be empty")
+	}
+	replacer := strings.NewReplacer(
+		"{database}", quoteCommitHookLiteral(event.Database),
+		"{branch}", quoteCommitHookLiteral(event.Branch),
+		"{hash}", quoteCommitHookLiteral(event.Hash),
+		"{message}", quoteCommitHookLiteral(event.Message),
+		"{author}", quoteCommitHookLiteral(event.Author),
+		"{email}", quoteCommitHookLiteral(event.Email),
+		"{tables}", quoteCommitHookLiteral(strings.Join(event.TablesChanged, ",")),
+	)
+	stmt, err := parser.ParseOne(replacer.Replace(statement))
+	if err != nil {
+		return nil, err
+	}
+	return stmt.AST, nil
+}
+
+// quoteCommitHookLiteral returns the value as a string literal.
+func quoteCommitHookLiteral(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// readCommitHooks returns every hook within the table.
+func readCommitHooks(ctx *sql.Context, table sql.Table) ([]commitHook, error) {
+	rows, err := readRows(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	hooks := make([]commitHook, len(rows))
+	for i, row := range rows {
+		if hooks[i], err = commitHookFromRow(row); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(hooks, func(i, j int) bool {
+		return hooks[i].ID < hooks[j].ID
+	})
+	return hooks, nil
+}
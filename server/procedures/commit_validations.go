@@ -0,0 +1,224 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procedures
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/parser"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// CommitValidationsTable is the name of the table that stores the validations that are run before each commit.
+const CommitValidationsTable = "commit_validations"
+
+// commitValidationsTableSchema is the schema of the commit validations table.
+var commitValidationsTableSchema = sql.Schema{
+	{Name: "id", Type: pgtypes.Int64, Source: CommitValidationsTable, PrimaryKey: true},
+	{Name: "name", Type: pgtypes.Text, Source: CommitValidationsTable},
+	{Name: "query", Type: pgtypes.Text, Source: CommitValidationsTable},
+	{Name: "message", Type: pgtypes.Text, Source: CommitValidationsTable, Nullable: true},
+}
+
+// commitValidation is a single row of the commit validations table. A validation passes when its query returns zero
+// rows, so queries are written to return the rows that violate the rule. Validations are run in order of their IDs.
+type commitValidation struct {
+	ID      int64
+	Name    string
+	Query   string
+	Message any
+}
+
+// statementRunner executes the queries of the commit validations. This is set once the server has started, as the
+// runner requires the engine's analyzer.
+var statementRunner atomic.Pointer[pgnodes.StatementRunner]
+
+// SetStatementRunner sets the runner that is used to execute the queries of the commit validations.
+func SetStatementRunner(runner pgnodes.StatementRunner) {
+	statementRunner.Store(&runner)
+}
+
+// toRow returns the validation as a row of the commit validations table.
+func (cv commitValidation) toRow() sql.Row {
+	return sql.Row{cv.ID, cv.Name, cv.Query, cv.Message}
+}
+
+// commitValidationFromRow returns the validation represented by the given row of the commit validations table.
+func commitValidationFromRow(row sql.Row) (commitValidation, error) {
+	if len(row) != len(commitValidationsTableSchema) {
+		return commitValidation{}, fmt.Errorf("%s.%s has an unexpected number of columns: %d",
+			SystemSchema, CommitValidationsTable, len(row))
+	}
+	return commitValidation{
+		ID:      row[0].(int64),
+		Name:    row[1].(string),
+		Query:   row[2].(string),
+		Message: row[3],
+	}, nil
+}
+
+// doltCommitValidationAdd adds a validation that must pass before a commit may be made. Takes the name of the
+// validation, a SELECT query that returns the rows that violate the validation, and an optional message that is
+// reported when the validation fails.
+func doltCommitValidationAdd(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	if len(args) < 2 || len(args) > 3 {
+		return nil, fmt.Errorf("usage: dolt_commit_validation_add('name', 'query', ['message'])")
+	}
+	validation := commitValidation{Name: args[0], Query: args[1]}
+	if len(args) == 3 {
+		validation.Message = args[2]
+	}
+	if len(validation.Name) == 0 {
+		return nil, fmt.Errorf("commit validation name cannot be empty")
+	}
+	if _, err := parseCommitValidationQuery(validation.Query); err != nil {
+		return nil, err
+	}
+	table, err := getSystemTable(ctx, CommitValidationsTable, commitValidationsTableSchema, true)
+	if err != nil {
+		return nil, err
+	}
+	validations, err := readCommitValidations(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	validation.ID = 1
+	for _, existing := range validations {
+		if existing.Name == validation.Name {
+			return nil, fmt.Errorf(`commit validation "%s" already exists`, validation.Name)
+		}
+		if existing.ID >= validation.ID {
+			validation.ID = existing.ID + 1
+		}
+	}
+	if err = insertRow(ctx, table, validation.toRow()); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{int64(0)}), nil
+}
+
+// doltCommitValidationDrop removes the validation with the given name.
+func doltCommitValidationDrop(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("usage: dolt_commit_validation_drop('name')")
+	}
+	table, err := getSystemTable(ctx, CommitValidationsTable, commitValidationsTableSchema, false)
+	if err != nil {
+		return nil, err
+	}
+	if table != nil {
+		validations, err := readCommitValidations(ctx, table)
+		if err != nil {
+			return nil, err
+		}
+		for _, validation := range validations {
+			if validation.Name == args[0] {
+				if err = deleteRow(ctx, table, validation.toRow()); err != nil {
+					return nil, err
+				}
+				return sql.RowsToRowIter(sql.Row{int64(0)}), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf(`commit validation "%s" does not exist`, args[0])
+}
+
+// wrapDoltCommit returns a dolt_commit implementation that runs every commit validation before calling the given
+// implementation, which only occurs if all validations pass.
+func wrapDoltCommit(doltCommit func(*sql.Context, ...string) (sql.RowIter, error)) func(*sql.Context, ...string) (sql.RowIter, error) {
+	return func(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+		if err := runCommitValidations(ctx); err != nil {
+			return nil, err
+		}
+		return doltCommit(ctx, args...)
+	}
+}
+
+// runCommitValidations runs every commit validation against the working set of the current database, returning an
+// error describing the first validation that fails.
+func runCommitValidations(ctx *sql.Context) error {
+	if len(ctx.GetCurrentDatabase()) == 0 {
+		return nil
+	}
+	table, err := getSystemTable(ctx, CommitValidationsTable, commitValidationsTableSchema, false)
+	if err != nil || table == nil {
+		return err
+	}
+	validations, err := readCommitValidations(ctx, table)
+	if err != nil || len(validations) == 0 {
+		return err
+	}
+	runner := statementRunner.Load()
+	if runner == nil {
+		return fmt.Errorf("commit validations cannot be run until the server has started")
+	}
+	for _, validation := range validations {
+		stmt, err := parseCommitValidationQuery(validation.Query)
+		if err != nil {
+			return fmt.Errorf(`commit validation "%s" has an invalid query: %w`, validation.Name, err)
+		}
+		_, rows, err := (*runner)(ctx, stmt)
+		if err != nil {
+			return fmt.Errorf(`commit validation "%s" could not be run: %w`, validation.Name, err)
+		}
+		if len(rows) > 0 {
+			if message, ok := validation.Message.(string); ok && len(message) > 0 {
+				return fmt.Errorf(`commit validation "%s" failed: %s`, validation.Name, message)
+			}
+			return fmt.Errorf(`commit validation "%s" failed: query returned %d violating row(s)`, validation.Name, len(rows))
+		}
+	}
+	return nil
+}
+
+// readCommitValidations returns every validation within the table.
+func readCommitValidations(ctx *sql.Context, table sql.Table) ([]commitValidation, error) {
+	rows, err := readRows(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	validations := make([]commitValidation, len(rows))
+	for i, row := range rows {
+		if validations[i], err = commitValidationFromRow(row); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(validations, func(i, j int) bool {
+		return validations[i].ID < validations[j].ID
+	})
+	return validations, nil
+}
+
+// parseCommitValidationQuery parses the query of a commit validation, which must be a single SELECT statement.
+func parseCommitValidationQuery(query string) (tree.Statement, error) {
+	if len(strings.TrimSpace(query)) == 0 {
+		return nil, fmt.Errorf("commit validation query cannot be empty")
+	}
+	stmt, err := parser.ParseOne(query)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := stmt.AST.(*tree.Select); !ok {
+		return nil, fmt.Errorf("commit validation query must be a SELECT statement")
+	}
+	return stmt.AST, nil
+}
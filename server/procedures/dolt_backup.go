@@ -0,0 +1,66 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procedures
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/backup"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// wrapDoltBackup returns an implementation of dolt_backup that backs up the current database to the server's configured
+// backup destination when called as dolt_backup('sync'), without naming a backup. All other arguments are handled by
+// Dolt.
+func wrapDoltBackup(procedure func(*sql.Context, ...string) (sql.RowIter, error)) func(*sql.Context, ...string) (sql.RowIter, error) {
+	return func(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+		if len(args) != 1 || strings.ToLower(args[0]) != "sync" {
+			return procedure(ctx, args...)
+		}
+		if err := syncConfiguredBackup(ctx); err != nil {
+			return nil, pgerrors.Raise(ctx, err)
+		}
+		return sql.RowsToRowIter(sql.Row{int64(0)}), nil
+	}
+}
+
+// syncConfiguredBackup backs up the current database to the server's configured backup destination.
+func syncConfiguredBackup(ctx *sql.Context) error {
+	dbName := ctx.GetCurrentDatabase()
+	if len(dbName) == 0 {
+		return fmt.Errorf("Empty database name.")
+	}
+	if err := branch_control.CheckAccess(ctx, branch_control.Permissions_Write); err != nil {
+		return err
+	}
+	if _, ok, err := backup.DestinationURL(dbName); err != nil {
+		return err
+	} else if !ok {
+		return pgerrors.New(pgcode.ObjectNotInPrerequisiteState, "no backup destination has been configured").
+			WithHint("Set backup.destination in the server's config, or name a backup to sync to.")
+	}
+	dbData, ok := dsess.DSessFromSess(ctx.Session).GetDbData(ctx, dbName)
+	if !ok {
+		return sql.ErrDatabaseNotFound.New(dbName)
+	}
+	_, err := backup.Sync(ctx, backup.Database{Name: dbName, Data: dbData})
+	return err
+}
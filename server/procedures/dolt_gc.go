@@ -0,0 +1,106 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procedures
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dprocedures"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/store/chunks"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/notices"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// gcDryRunFlag is the argument of dolt_gc that reports on the database's storage without collecting any garbage.
+const gcDryRunFlag = "--dry-run"
+
+// wrapDoltGC returns an implementation of dolt_gc that accepts the --dry-run argument, and that leaves the calling
+// connection usable once the garbage has been collected. Dolt disables the calling session after a full collection, as
+// it may hold chunks that have not been written to a ref. The collection cannot run inside a transaction block (much
+// like VACUUM), so the calling session holds no such chunks.
+func wrapDoltGC(procedure func(*sql.Context, ...string) (sql.RowIter, error)) func(*sql.Context, ...string) (sql.RowIter, error) {
+	return func(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+		// An explicit transaction causes autocommit to be ignored, which is how we determine that we're in a block
+		if ctx.GetIgnoreAutoCommit() {
+			return nil, pgerrors.Raise(ctx, pgerrors.New(pgcode.ActiveSQLTransaction, "dolt_gc cannot run inside a transaction block"))
+		}
+		var gcArgs []string
+		dryRun := false
+		for _, arg := range args {
+			if arg == gcDryRunFlag {
+				dryRun = true
+			} else {
+				gcArgs = append(gcArgs, arg)
+			}
+		}
+		if dryRun {
+			return doltGCDryRun(ctx, gcArgs)
+		}
+		iter, err := procedure(ctx, gcArgs...)
+		if err != nil {
+			return nil, err
+		}
+		dsess.DSessFromSess(ctx.Session).SetValidateErr(nil)
+		return iter, nil
+	}
+}
+
+// doltGCDryRun performs the same checks as dolt_gc, and raises a notice with the size of the database's storage rather
+// than collecting any garbage.
+func doltGCDryRun(ctx *sql.Context, args []string) (sql.RowIter, error) {
+	if !dprocedures.DoltGCFeatureFlag {
+		return nil, fmt.Errorf("DOLT_GC() stored procedure disabled")
+	}
+	dbName := ctx.GetCurrentDatabase()
+	if len(dbName) == 0 {
+		return nil, fmt.Errorf("Empty database name.")
+	}
+	if err := branch_control.CheckAccess(ctx, branch_control.Permissions_Write); err != nil {
+		return nil, err
+	}
+	apr, err := cli.CreateGCArgParser().Parse(args)
+	if err != nil {
+		return nil, err
+	}
+	if apr.NArg() != 0 {
+		return nil, dprocedures.InvalidArgErr
+	}
+	ddb, ok := dsess.DSessFromSess(ctx.Session).GetDoltDB(ctx, dbName)
+	if !ok {
+		return nil, fmt.Errorf("Could not load database %s", dbName)
+	}
+	kind := "full"
+	if apr.Contains(cli.ShallowFlag) {
+		kind = "shallow"
+	}
+	message := fmt.Sprintf("dolt_gc dry run: a %s garbage collection of database %s would be performed", kind, dbName)
+	if store, ok := datas.ChunkStoreFromDatabase(doltdb.HackDatasDatabaseFromDoltDB(ddb)).(chunks.TableFileStore); ok {
+		size, err := store.Size(ctx)
+		if err != nil {
+			return nil, err
+		}
+		message += fmt.Sprintf(", whose storage currently uses %d bytes", size)
+	}
+	notices.RaiseNotice(ctx, message)
+	return sql.RowsToRowIter(sql.Row{int64(0)}), nil
+}
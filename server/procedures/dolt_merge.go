@@ -0,0 +1,241 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procedures
+
+import (
+	"fmt"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/dconfig"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/merge"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dprocedures"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+)
+
+// wrapDoltMerge returns an implementation of dolt_merge that resolves the rows that were changed on both sides of a
+// three-way merge before Dolt merges the roots, as Dolt cannot compare the cells of such rows (see
+// core.ResolveDivergentRows). Dolt's merge compares cells using a fixed comparator rather than the handlers of the
+// table's tuple descriptors, so there is no way to provide the comparison from here. Every other form of merge,
+// including each one that Dolt rejects, is performed by Dolt's own procedure.
+func wrapDoltMerge(procedure func(*sql.Context, ...string) (sql.RowIter, error)) func(*sql.Context, ...string) (sql.RowIter, error) {
+	return func(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+		apr, spec, ok, err := threeWayMergeSpec(ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return procedure(ctx, args...)
+		}
+		return doltMergeThreeWay(ctx, apr, spec)
+	}
+}
+
+// threeWayMergeSpec returns the merge spec when the given dolt_merge arguments describe a valid three-way merge.
+// Returns false for every other merge, which are left to Dolt so that it may perform them or report their errors.
+func threeWayMergeSpec(ctx *sql.Context, args []string) (*argparser.ArgParseResults, *merge.MergeSpec, bool, error) {
+	dbName := ctx.GetCurrentDatabase()
+	apr, err := cli.CreateMergeArgParser().Parse(args)
+	if len(dbName) == 0 || err != nil || apr.NArg() == 0 || apr.Contains(cli.AbortParam) ||
+		apr.ContainsAll(cli.SquashParam, cli.NoFFParam) {
+		return nil, nil, false, nil
+	}
+	if err = branch_control.CheckAccess(ctx, branch_control.Permissions_Write); err != nil {
+		return nil, nil, false, err
+	}
+	sess := dsess.DSessFromSess(ctx.Session)
+	ws, err := sess.WorkingSet(ctx, dbName)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	spec, err := createMergeSpec(ctx, sess, dbName, apr, apr.Arg(0))
+	if err != nil {
+		return nil, nil, false, err
+	}
+	if ws.MergeActive() || len(spec.StompedTblNames) != 0 {
+		return nil, nil, false, nil
+	}
+	canFF, err := spec.HeadC.CanFastForwardTo(ctx, spec.MergeC)
+	if err != nil {
+		switch err {
+		case doltdb.ErrIsAhead, doltdb.ErrUpToDate:
+			return nil, nil, false, nil
+		default:
+			return nil, nil, false, err
+		}
+	}
+	return apr, spec, !canFF, nil
+}
+
+// doltMergeThreeWay performs a three-way merge in the same way as Dolt's dolt_merge, resolving the rows that were
+// changed on both sides before the roots are merged.
+func doltMergeThreeWay(ctx *sql.Context, apr *argparser.ArgParseResults, spec *merge.MergeSpec) (sql.RowIter, error) {
+	dbName := ctx.GetCurrentDatabase()
+	sess := dsess.DSessFromSess(ctx.Session)
+	ws, err := sess.WorkingSet(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	optCmt, err := doltdb.GetCommitAncestor(ctx, spec.HeadC, spec.MergeC)
+	if err != nil {
+		return nil, err
+	}
+	ancCommit, ok := optCmt.ToCommit()
+	if !ok {
+		return nil, doltdb.ErrGhostCommitEncountered
+	}
+	ourRoot, err := spec.HeadC.GetRootValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	theirRoot, err := spec.MergeC.GetRootValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ancRoot, err := ancCommit.GetRootValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	theirRoot, ancRoot, conflicts, err := core.ResolveDivergentRows(ctx, ourRoot, theirRoot, ancRoot)
+	if err != nil {
+		return nil, err
+	}
+	dbState, ok, err := sess.LookupDbState(ctx, dbName)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, sql.ErrDatabaseNotFound.New(dbName)
+	}
+	result, err := merge.MergeRoots(ctx, ourRoot, theirRoot, ancRoot, spec.MergeC, ancCommit, dbState.EditOpts(),
+		merge.MergeOpts{IsCherryPick: false, KeepSchemaConflicts: true})
+	if err != nil {
+		return nil, err
+	}
+	theirHash, err := spec.MergeC.HashOf()
+	if err != nil {
+		return nil, err
+	}
+	ancHash, err := ancCommit.HashOf()
+	if err != nil {
+		return nil, err
+	}
+	if err = core.AddMergeConflicts(ctx, result, theirHash, ancHash, conflicts); err != nil {
+		return nil, err
+	}
+	if err = mergeRootToWorking(ctx, sess, dbName, ws, spec, result); err != nil {
+		return nil, err
+	}
+	if result.HasMergeArtifacts() && !spec.Force {
+		ctx.Warn(dprocedures.DoltMergeWarningCode, doltdb.ErrUnresolvedConflictsOrViolations.Error())
+		return sql.RowsToRowIter(sql.Row{"", int64(0), int64(1), "conflicts found"}), nil
+	}
+	var commitHash string
+	if !apr.Contains(cli.NoCommitFlag) {
+		dbData, ok := sess.GetDbData(ctx, dbName)
+		if !ok {
+			return nil, sql.ErrDatabaseNotFound.New(dbName)
+		}
+		headRef, err := dbData.Rsr.CWBHeadRef()
+		if err != nil {
+			return nil, err
+		}
+		message := fmt.Sprintf("Merge branch '%s' into %s", apr.Arg(0), headRef.GetPath())
+		if userMessage, ok := apr.GetValue(cli.MessageArg); ok {
+			message = userMessage
+		}
+		commitArgs := []string{"-m", message, "--author", fmt.Sprintf("%s <%s>", spec.Name, spec.Email)}
+		if spec.Force {
+			commitArgs = append(commitArgs, "--force")
+		}
+		commitIter, err := callDoltProcedure(ctx, "dolt_commit", commitArgs...)
+		if err != nil {
+			return nil, err
+		}
+		commitRows, err := sql.RowIterToRows(ctx, commitIter)
+		if err != nil {
+			return nil, err
+		}
+		if len(commitRows) > 0 && len(commitRows[0]) > 0 {
+			commitHash, _ = commitRows[0][0].(string)
+		}
+	}
+	return sql.RowsToRowIter(sql.Row{commitHash, int64(0), int64(0), "merge successful"}), nil
+}
+
+// mergeRootToWorking writes the merged root to the working set in the same way as Dolt's dolt_merge, starting a merge
+// that remains active until it is committed.
+func mergeRootToWorking(ctx *sql.Context, sess *dsess.DoltSession, dbName string, ws *doltdb.WorkingSet, spec *merge.MergeSpec, result *merge.Result) error {
+	var err error
+	staged, working := result.Root, result.Root
+	for tableName, tableHash := range spec.WorkingDiffs {
+		if working, err = working.SetTableHash(ctx, tableName, tableHash); err != nil {
+			return fmt.Errorf("failed to update table; %w", err)
+		}
+	}
+	if !spec.Squash || result.HasSchemaConflicts() {
+		ws = ws.StartMerge(spec.MergeC, spec.MergeCSpecStr)
+		ws = ws.WithUnmergableTables(merge.SchemaConflictTableNames(result.SchemaConflicts))
+	}
+	ws = ws.WithWorkingRoot(working)
+	if !result.HasMergeArtifacts() && !spec.Force {
+		ws = ws.WithStagedRoot(staged)
+	}
+	return sess.SetWorkingSet(ctx, dbName, ws)
+}
+
+// createMergeSpec returns the merge spec for the given dolt_merge arguments, in the same way as Dolt's dolt_merge.
+func createMergeSpec(ctx *sql.Context, sess *dsess.DoltSession, dbName string, apr *argparser.ArgParseResults, commitSpecStr string) (*merge.MergeSpec, error) {
+	ddb, ok := sess.GetDoltDB(ctx, dbName)
+	if !ok {
+		return nil, sql.ErrDatabaseNotFound.New(dbName)
+	}
+	dbData, ok := sess.GetDbData(ctx, dbName)
+	if !ok {
+		return nil, sql.ErrDatabaseNotFound.New(dbName)
+	}
+	roots, ok := sess.GetRoots(ctx, dbName)
+	if !ok {
+		return nil, sql.ErrDatabaseNotFound.New(dbName)
+	}
+	var name, email string
+	if author, ok := apr.GetValue(cli.AuthorParam); ok {
+		var err error
+		if name, email, err = cli.ParseAuthor(author); err != nil {
+			return nil, err
+		}
+	} else {
+		name = ctx.Client().User
+		email = fmt.Sprintf("%s@%s", ctx.Client().User, ctx.Client().Address)
+	}
+	t := ctx.QueryTime()
+	if date, ok := apr.GetValue(cli.DateParam); ok {
+		var err error
+		if t, err = dconfig.ParseDate(date); err != nil {
+			return nil, err
+		}
+	}
+	return merge.NewMergeSpec(ctx, dbData.Rsr, ddb, roots, name, email, commitSpecStr, t,
+		merge.WithSquash(apr.Contains(cli.SquashParam)),
+		merge.WithNoFF(apr.Contains(cli.NoFFParam)),
+		merge.WithForce(apr.Contains(cli.ForceFlag)),
+		merge.WithNoCommit(apr.Contains(cli.NoCommitFlag)),
+		merge.WithNoEdit(apr.Contains(cli.NoEditFlag)),
+	)
+}
@@ -0,0 +1,295 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procedures
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dprocedures"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// doltPullRequestCreate proposes merging the source branch into the target branch, returning the ID of the new pull
+// request. Takes the source branch, the target branch, and an optional description.
+func doltPullRequestCreate(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	if len(args) < 2 || len(args) > 3 {
+		return nil, fmt.Errorf("usage: dolt_pull_request_create('source_branch', 'target_branch', ['description'])")
+	}
+	sourceBranch, targetBranch := args[0], args[1]
+	var description any
+	if len(args) == 3 {
+		description = args[2]
+	}
+	if sourceBranch == targetBranch {
+		return nil, fmt.Errorf("a pull request cannot merge branch %s into itself", sourceBranch)
+	}
+	dbData, err := getDbData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, branch := range []string{sourceBranch, targetBranch} {
+		if _, exists, err := dbData.Ddb.HasBranch(ctx, branch); err != nil {
+			return nil, err
+		} else if !exists {
+			return nil, fmt.Errorf("branch not found: %s", branch)
+		}
+	}
+
+	table, err := getPullRequestsTable(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	pullRequests, err := readPullRequests(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	id := int64(1)
+	for _, pr := range pullRequests {
+		if pr.ID >= id {
+			id = pr.ID + 1
+		}
+	}
+	err = insertPullRequest(ctx, table, pullRequest{
+		ID:           id,
+		SourceBranch: sourceBranch,
+		TargetBranch: targetBranch,
+		Description:  description,
+		Status:       pullRequestStatus_Open,
+		Author:       ctx.Client().User,
+		CreatedAt:    time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{id}), nil
+}
+
+// doltPullRequestPreview returns the conflicts and changes that merging the pull request would produce, without
+// applying the merge.
+func doltPullRequestPreview(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("usage: dolt_pull_request_preview(id)")
+	}
+	_, pr, err := getPullRequest(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+	preview, err := previewPullRequest(ctx, pr)
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{
+		preview.Conflicts,
+		preview.TablesChanged,
+		preview.RowsAdded,
+		preview.RowsDeleted,
+		preview.RowsModified,
+	}), nil
+}
+
+// doltPullRequestApprove approves an open pull request, which allows it to be merged.
+func doltPullRequestApprove(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("usage: dolt_pull_request_approve(id)")
+	}
+	table, pr, err := getPullRequest(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+	if pr.Status != pullRequestStatus_Open {
+		return nil, fmt.Errorf("pull request %d cannot be approved as it is %s", pr.ID, pr.Status)
+	}
+	newPr := pr
+	newPr.Status = pullRequestStatus_Approved
+	newPr.ApprovedBy = ctx.Client().User
+	if err = updatePullRequest(ctx, table, pr, newPr); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{int64(0)}), nil
+}
+
+// doltPullRequestMerge merges an approved pull request into its target branch, which must be the current branch. The
+// merge is always recorded with a merge commit, whose hash is returned.
+func doltPullRequestMerge(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("usage: dolt_pull_request_merge(id)")
+	}
+	table, pr, err := getPullRequest(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+	if pr.Status != pullRequestStatus_Approved {
+		return nil, fmt.Errorf("pull request %d must be approved before it can be merged, but it is %s", pr.ID, pr.Status)
+	}
+	dbData, err := getDbData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	headRef, err := dbData.Rsr.CWBHeadRef()
+	if err != nil {
+		return nil, err
+	}
+	if headRef.GetPath() != pr.TargetBranch {
+		return nil, fmt.Errorf("pull request %d must be merged from its target branch %s, but the current branch is %s",
+			pr.ID, pr.TargetBranch, headRef.GetPath())
+	}
+	preview, err := previewPullRequest(ctx, pr)
+	if err != nil {
+		return nil, err
+	}
+	if preview.Conflicts > 0 {
+		return nil, fmt.Errorf("pull request %d cannot be merged as it has %d conflicts", pr.ID, preview.Conflicts)
+	}
+	pullRequests, err := readPullRequests(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	message := fmt.Sprintf("Merge pull request #%d from %s", pr.ID, pr.SourceBranch)
+	if description, ok := pr.Description.(string); ok && len(description) > 0 {
+		message += "\n\n" + description
+	}
+	mergeIter, err := callDoltProcedure(ctx, "dolt_merge", "--no-ff", "-m", message, pr.SourceBranch)
+	if err != nil {
+		return nil, err
+	}
+	mergeRows, err := sql.RowIterToRows(ctx, mergeIter)
+	if err != nil {
+		return nil, err
+	}
+	if len(mergeRows) != 1 || mergeRows[0][0] == nil || mergeRows[0][0] == "" {
+		return nil, fmt.Errorf("pull request %d did not produce a merge commit", pr.ID)
+	}
+	commitHash := mergeRows[0][0].(string)
+
+	newPr := pr
+	newPr.Status = pullRequestStatus_Merged
+	newPr.MergeCommit = commitHash
+	// The merge replaces the working root, which drops the pull requests table if it has not been committed yet. In
+	// that case, the table is created again so that no pull requests are lost. The transaction is restarted first, as
+	// committing it would otherwise merge the working root with the merged one, which drops the table again.
+	if err = restartTransaction(ctx); err != nil {
+		return nil, err
+	}
+	mergedTable, err := getPullRequestsTable(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	if mergedTable == nil {
+		if mergedTable, err = getPullRequestsTable(ctx, true); err != nil {
+			return nil, err
+		}
+		for _, existingPr := range pullRequests {
+			if existingPr.ID == pr.ID {
+				existingPr = newPr
+			}
+			if err = insertPullRequest(ctx, mergedTable, existingPr); err != nil {
+				return nil, err
+			}
+		}
+	} else if err = updatePullRequest(ctx, mergedTable, pr, newPr); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{commitHash}), nil
+}
+
+// doltPullRequestClose closes a pull request that has not been merged.
+func doltPullRequestClose(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("usage: dolt_pull_request_close(id)")
+	}
+	table, pr, err := getPullRequest(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+	if pr.Status == pullRequestStatus_Merged || pr.Status == pullRequestStatus_Closed {
+		return nil, fmt.Errorf("pull request %d cannot be closed as it is %s", pr.ID, pr.Status)
+	}
+	newPr := pr
+	newPr.Status = pullRequestStatus_Closed
+	if err = updatePullRequest(ctx, table, pr, newPr); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{int64(0)}), nil
+}
+
+// getPullRequest returns the pull request with the given ID, along with the table that it was read from.
+func getPullRequest(ctx *sql.Context, idArg string) (sql.Table, pullRequest, error) {
+	id, err := strconv.ParseInt(idArg, 10, 64)
+	if err != nil {
+		return nil, pullRequest{}, fmt.Errorf("invalid pull request id: %s", idArg)
+	}
+	table, err := getPullRequestsTable(ctx, false)
+	if err != nil {
+		return nil, pullRequest{}, err
+	}
+	if table != nil {
+		pullRequests, err := readPullRequests(ctx, table)
+		if err != nil {
+			return nil, pullRequest{}, err
+		}
+		for _, pr := range pullRequests {
+			if pr.ID == id {
+				return table, pr, nil
+			}
+		}
+	}
+	return nil, pullRequest{}, fmt.Errorf("pull request %d does not exist", id)
+}
+
+// getDbData returns the data of the current database.
+func getDbData(ctx *sql.Context) (env.DbData, error) {
+	sess := dsess.DSessFromSess(ctx.Session)
+	dbData, ok := sess.GetDbData(ctx, ctx.GetCurrentDatabase())
+	if !ok {
+		return env.DbData{}, sql.ErrDatabaseNotFound.New(ctx.GetCurrentDatabase())
+	}
+	return dbData, nil
+}
+
+// restartTransaction commits the current transaction, if there is one, and starts a new one.
+func restartTransaction(ctx *sql.Context) error {
+	sess := dsess.DSessFromSess(ctx.Session)
+	if currentTx := ctx.GetTransaction(); currentTx != nil {
+		if err := sess.CommitTransaction(ctx, currentTx); err != nil {
+			return err
+		}
+	}
+	tx, err := sess.StartTransaction(ctx, sql.ReadWrite)
+	if err != nil {
+		return err
+	}
+	ctx.SetTransaction(tx)
+	return nil
+}
+
+// callDoltProcedure calls the Dolt procedure with the given name.
+func callDoltProcedure(ctx *sql.Context, name string, args ...string) (sql.RowIter, error) {
+	for _, procedure := range dprocedures.DoltProcedures {
+		if procedure.Name != name {
+			continue
+		}
+		function, ok := procedure.Function.(func(*sql.Context, ...string) (sql.RowIter, error))
+		if !ok {
+			return nil, fmt.Errorf("procedure %s has an unexpected signature: %T", name, procedure.Function)
+		}
+		return function(ctx, args...)
+	}
+	return nil, sql.ErrStoredProcedureDoesNotExist.New(name)
+}
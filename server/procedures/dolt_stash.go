@@ -0,0 +1,331 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procedures
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
+	"github.com/dolthub/dolt/go/libraries/doltcore/merge"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/notices"
+)
+
+// doltStashUsage is returned when dolt_stash is given invalid arguments.
+const doltStashUsage = "usage: dolt_stash('push', ['-u'], ['-m', 'message']) | dolt_stash('pop' | 'drop', ['stash@{n}']) | dolt_stash('clear')"
+
+// doltStash shelves the changes of the working set so that they may be restored later, even on another branch. The
+// first argument is the subcommand, which is one of:
+//   - push: stashes every staged change, along with unstaged changes to tracked tables. Untracked tables are also
+//     stashed when given -u (or --include-untracked). The stash's message defaults to the message of the HEAD commit.
+//   - pop: applies the given stash (the most recent by default) to the working set, then drops it.
+//   - drop: drops the given stash (the most recent by default) without applying it.
+//   - clear: drops every stash.
+//
+// Stashes are shared by every branch of the database, and are listed by the dolt_stashes view.
+func doltStash(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf(doltStashUsage)
+	}
+	dbName := ctx.GetCurrentDatabase()
+	if len(dbName) == 0 {
+		return nil, fmt.Errorf("Empty database name.")
+	}
+	if err := branch_control.CheckAccess(ctx, branch_control.Permissions_Write); err != nil {
+		return nil, err
+	}
+	var err error
+	switch subcommand, subArgs := strings.ToLower(args[0]), args[1:]; subcommand {
+	case "push":
+		err = doltStashPush(ctx, dbName, subArgs)
+	case "pop":
+		err = doltStashPop(ctx, dbName, subArgs)
+	case "drop":
+		var idx int
+		if idx, err = parseStashIndex(subArgs); err == nil {
+			err = dropStash(ctx, idx)
+		}
+	case "clear":
+		if len(subArgs) != 0 {
+			return nil, fmt.Errorf(doltStashUsage)
+		}
+		dbData, err := getDbData(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err = dbData.Ddb.RemoveAllStashes(ctx); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf(`unknown dolt_stash subcommand "%s", %s`, args[0], doltStashUsage)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{int64(0)}), nil
+}
+
+// doltStashPush stashes the changes of the working set, and resets the working set to match HEAD. This mirrors the
+// behavior of Dolt's "stash" command.
+func doltStashPush(ctx *sql.Context, dbName string, args []string) error {
+	includeUntracked := false
+	message := ""
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case "-u", "--include-untracked":
+			includeUntracked = true
+		case "-m", "--message":
+			if i+1 >= len(args) {
+				return fmt.Errorf(doltStashUsage)
+			}
+			i++
+			message = args[i]
+		default:
+			return fmt.Errorf(doltStashUsage)
+		}
+	}
+	sess := dsess.DSessFromSess(ctx.Session)
+	dbData, ok := sess.GetDbData(ctx, dbName)
+	if !ok {
+		return sql.ErrDatabaseNotFound.New(dbName)
+	}
+	roots, ok := sess.GetRoots(ctx, dbName)
+	if !ok {
+		return sql.ErrDatabaseNotFound.New(dbName)
+	}
+	hasChanges, err := hasStashableChanges(ctx, roots, includeUntracked)
+	if err != nil {
+		return err
+	}
+	if !hasChanges {
+		notices.RaiseNotice(ctx, "No local changes to save")
+		return nil
+	}
+	if roots, err = actions.StageModifiedAndDeletedTables(ctx, roots); err != nil {
+		return err
+	}
+	// Every table with changes is now staged, so the stash is made from the staged root
+	stashedTables, addedTables, err := stashedTableSets(ctx, roots)
+	if err != nil {
+		return err
+	}
+	// Untracked tables are included in the stash, but are not added to the tables that will be staged once popped
+	if includeUntracked {
+		if stashedTables, err = doltdb.UnionTableNames(ctx, roots.Staged, roots.Working); err != nil {
+			return err
+		}
+		if roots, err = actions.StageTables(ctx, roots, stashedTables, true); err != nil {
+			return err
+		}
+	}
+	headRef, err := sess.CWBHeadRef(ctx, dbName)
+	if err != nil {
+		return err
+	}
+	headCommit, err := dbData.Ddb.ResolveCommitRef(ctx, headRef)
+	if err != nil {
+		return err
+	}
+	if len(message) == 0 {
+		commitMeta, err := headCommit.GetCommitMeta(ctx)
+		if err != nil {
+			return err
+		}
+		message = commitMeta.Description
+	}
+	tablesToStage := make([]string, len(addedTables))
+	for i, tableName := range addedTables {
+		tablesToStage[i] = tableName.String()
+	}
+	err = dbData.Ddb.AddStash(ctx, headCommit, roots.Staged, datas.NewStashMeta(headRef.GetPath(), message, tablesToStage))
+	if err != nil {
+		return err
+	}
+	// Resetting the staged root to HEAD moves all stashed changes into the working root, where they're then reverted
+	roots.Staged = roots.Head
+	if roots, err = actions.MoveTablesFromHeadToWorking(ctx, roots, stashedTables); err != nil {
+		return err
+	}
+	return sess.SetRoots(ctx, dbName, roots)
+}
+
+// doltStashPop applies the given stash to the working set, then drops the stash. The stash is kept if it conflicts
+// with the changes in the working set.
+func doltStashPop(ctx *sql.Context, dbName string, args []string) error {
+	idx, err := parseStashIndex(args)
+	if err != nil {
+		return err
+	}
+	sess := dsess.DSessFromSess(ctx.Session)
+	dbData, ok := sess.GetDbData(ctx, dbName)
+	if !ok {
+		return sql.ErrDatabaseNotFound.New(dbName)
+	}
+	dbState, ok, err := sess.LookupDbState(ctx, dbName)
+	if err != nil {
+		return err
+	} else if !ok {
+		return sql.ErrDatabaseNotFound.New(dbName)
+	}
+	roots, ok := sess.GetRoots(ctx, dbName)
+	if !ok {
+		return sql.ErrDatabaseNotFound.New(dbName)
+	}
+	stashRoot, parentCommit, meta, err := dbData.Ddb.GetStashRootAndHeadCommitAtIdx(ctx, idx)
+	if err != nil {
+		return err
+	}
+	parentRoot, err := parentCommit.GetRootValue(ctx)
+	if err != nil {
+		return err
+	}
+	result, err := merge.MergeRoots(ctx, roots.Working, stashRoot, parentRoot, stashRoot, parentCommit, dbState.EditOpts(), merge.MergeOpts{})
+	if err != nil {
+		return err
+	}
+	var conflictedTables []string
+	for tableName, stats := range result.Stats {
+		if stats.HasConflicts() {
+			conflictedTables = append(conflictedTables, tableName)
+		}
+	}
+	if len(conflictedTables) > 0 {
+		sort.Strings(conflictedTables)
+		return fmt.Errorf("your local changes to the following tables would be overwritten by applying stash %d: %s; "+
+			"commit or stash your changes first, the stash entry is kept in case you need it again",
+			idx, strings.Join(conflictedTables, ", "))
+	}
+	roots.Working = result.Root
+	// Tables that were added and staged when stashed are staged once again, even when they are ignored
+	tablesToStage := make([]doltdb.TableName, len(meta.TablesToStage))
+	for i, tableName := range meta.TablesToStage {
+		tablesToStage[i] = parseStashTableName(tableName)
+	}
+	if roots, err = actions.StageTables(ctx, roots, tablesToStage, false); err != nil {
+		return err
+	}
+	if err = sess.SetRoots(ctx, dbName, roots); err != nil {
+		return err
+	}
+	return dropStash(ctx, idx)
+}
+
+// dropStash removes the stash at the given index.
+func dropStash(ctx *sql.Context, idx int) error {
+	dbData, err := getDbData(ctx)
+	if err != nil {
+		return err
+	}
+	stashes, err := dbData.Ddb.GetStashes(ctx)
+	if err != nil {
+		return err
+	}
+	if idx >= len(stashes) {
+		return fmt.Errorf("stash@{%d} is not a valid reference", idx)
+	}
+	return dbData.Ddb.RemoveStashAtIdx(ctx, idx)
+}
+
+// hasStashableChanges returns whether the working set has any changes that would be stashed.
+func hasStashableChanges(ctx *sql.Context, roots doltdb.Roots, includeUntracked bool) (bool, error) {
+	headHash, err := roots.Head.HashOf()
+	if err != nil {
+		return false, err
+	}
+	stagedHash, err := roots.Staged.HashOf()
+	if err != nil {
+		return false, err
+	}
+	workingHash, err := roots.Working.HashOf()
+	if err != nil {
+		return false, err
+	}
+	if !headHash.Equal(stagedHash) {
+		return true, nil
+	}
+	if headHash.Equal(workingHash) {
+		return false, nil
+	}
+	if allIgnored, err := diff.WorkingSetContainsOnlyIgnoredTables(ctx, roots); err != nil || allIgnored {
+		return false, err
+	}
+	if includeUntracked {
+		return true, nil
+	}
+	// Without untracked tables, only changes to tables that exist in HEAD are stashed
+	_, unstaged, err := diff.GetStagedUnstagedTableDeltas(ctx, roots)
+	if err != nil {
+		return false, err
+	}
+	for _, td := range unstaged {
+		if !td.IsAdd() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// stashedTableSets returns the name of every staged table, which are the tables being stashed, along with the names of
+// the staged tables that were added.
+func stashedTableSets(ctx *sql.Context, roots doltdb.Roots) (stashed []doltdb.TableName, added []doltdb.TableName, err error) {
+	staged, _, err := diff.GetStagedUnstagedTableDeltas(ctx, roots)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, td := range staged {
+		tableName := td.ToName
+		if td.IsAdd() {
+			added = append(added, td.ToName)
+		}
+		if td.IsDrop() {
+			tableName = td.FromName
+		}
+		stashed = append(stashed, tableName)
+	}
+	return stashed, added, nil
+}
+
+// parseStashIndex returns the index of the stash referenced by the arguments, which may be given as either "stash@{n}"
+// or "n". The most recent stash, which has an index of zero, is used when no stash is given.
+func parseStashIndex(args []string) (int, error) {
+	if len(args) == 0 {
+		return 0, nil
+	} else if len(args) > 1 {
+		return 0, fmt.Errorf(doltStashUsage)
+	}
+	stashName := strings.TrimSuffix(strings.TrimPrefix(args[0], "stash@{"), "}")
+	idx, err := strconv.Atoi(stashName)
+	if err != nil || idx < 0 {
+		return 0, fmt.Errorf("%s is not a valid reference", args[0])
+	}
+	return idx, nil
+}
+
+// parseStashTableName returns the table name that was written to a stash's metadata, which includes its schema.
+func parseStashTableName(name string) doltdb.TableName {
+	if schemaName, tableName, ok := strings.Cut(name, "."); ok {
+		return doltdb.TableName{Name: tableName, Schema: schemaName}
+	}
+	return doltdb.TableName{Name: name}
+}
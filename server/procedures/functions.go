@@ -0,0 +1,115 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procedures
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/functions/framework"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// functionReturnTypes maps the Dolt procedures that may also be called as functions to the type that the function
+// returns, which is the type of the procedure's first column. Procedures whose columns are all meaningful return a text
+// array containing every column instead.
+var functionReturnTypes = map[string]pgtypes.DoltgresType{
+	"dolt_add":               pgtypes.Int64,
+	"dolt_backup":            pgtypes.Int64,
+	"dolt_branch":            pgtypes.Int64,
+	"dolt_checkout":          pgtypes.Int64,
+	"dolt_cherry_pick":       pgtypes.TextArray,
+	"dolt_clone":             pgtypes.Int64,
+	"dolt_commit":            pgtypes.Text,
+	"dolt_conflicts_resolve": pgtypes.Int64,
+	"dolt_fetch":             pgtypes.Int64,
+	"dolt_gc":                pgtypes.Int64,
+	"dolt_merge":             pgtypes.TextArray,
+	"dolt_pull":              pgtypes.TextArray,
+	"dolt_push":              pgtypes.TextArray,
+	"dolt_remote":            pgtypes.Int64,
+	"dolt_reset":             pgtypes.Int64,
+	"dolt_revert":            pgtypes.Int64,
+	"dolt_stash":             pgtypes.Int64,
+	"dolt_tag":               pgtypes.Int64,
+}
+
+// IsDoltProcedureFunction returns whether the Dolt procedure with the given name may also be called as a function.
+func IsDoltProcedureFunction(name string) bool {
+	_, ok := functionReturnTypes[name]
+	return ok
+}
+
+// DoltProcedureFunction returns a function that calls the Dolt procedure with the given name using the given number of
+// text arguments, such as SELECT dolt_commit('-am', 'message'). The function returns the value of the procedure's first
+// column (or every column as text for text array functions), or NULL when the procedure does not return a row. Returns false if the procedure may not be called as a
+// function.
+func DoltProcedureFunction(name string, argCount int) (framework.FunctionInterface, bool) {
+	returnType, ok := functionReturnTypes[name]
+	if !ok {
+		return nil, false
+	}
+	parameters := make([]pgtypes.DoltgresType, argCount)
+	for i := range parameters {
+		parameters[i] = pgtypes.Text
+	}
+	return framework.FunctionN{
+		Name:               name,
+		Return:             returnType,
+		Parameters:         parameters,
+		IsNonDeterministic: true,
+		Callable: func(ctx *sql.Context, _ []pgtypes.DoltgresType, vals []any) (any, error) {
+			args := make([]string, len(vals))
+			for i, val := range vals {
+				if val == nil {
+					return nil, nil
+				}
+				args[i] = val.(string)
+			}
+			iter, err := callDoltProcedure(ctx, name, args...)
+			if err != nil || iter == nil {
+				return nil, err
+			}
+			defer iter.Close(ctx)
+			row, err := iter.Next(ctx)
+			if err == io.EOF {
+				return nil, nil
+			} else if err != nil {
+				return nil, err
+			}
+			if returnType.BaseID() == pgtypes.TextArray.BaseID() {
+				return rowToTextArray(row), nil
+			}
+			// Some procedures report their status as an int rather than an int64
+			if status, ok := row[0].(int); ok {
+				return int64(status), nil
+			}
+			return row[0], nil
+		},
+	}, true
+}
+
+// rowToTextArray converts every column of the given row to its text representation, retaining NULL columns.
+func rowToTextArray(row sql.Row) []any {
+	elements := make([]any, len(row))
+	for i, val := range row {
+		if val != nil {
+			elements[i] = fmt.Sprint(val)
+		}
+	}
+	return elements
+}
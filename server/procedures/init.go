@@ -0,0 +1,88 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procedures
+
+import (
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dprocedures"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// Init adds the Doltgres procedures to the procedures that Dolt provides. This must be called before the database
+// provider has been created, as that is when the procedures are read.
+func Init() {
+	for i, procedure := range dprocedures.DoltProcedures {
+		function, ok := procedure.Function.(func(*sql.Context, ...string) (sql.RowIter, error))
+		if !ok {
+			continue
+		}
+		if _, ok := commitHookProcedures[procedure.Name]; ok {
+			function = wrapCommitHooks(function)
+		}
+		if procedure.Name == "dolt_commit" {
+			function = wrapDoltCommit(function)
+		} else if procedure.Name == "dolt_backup" {
+			function = wrapDoltBackup(function)
+		} else if procedure.Name == "dolt_gc" {
+			function = wrapDoltGC(function)
+		} else if procedure.Name == "dolt_merge" {
+			function = wrapDoltMerge(function)
+		} else if acceptsUser, ok := remoteProcedures[procedure.Name]; ok {
+			function = wrapRemoteProcedure(function, acceptsUser)
+		}
+		if _, ok := LongRunningProcedures[procedure.Name]; ok {
+			function = wrapDoltProgress(procedure.Name, function)
+		}
+		dprocedures.DoltProcedures[i].Function = function
+	}
+	dprocedures.DoltProcedures = append(dprocedures.DoltProcedures,
+		sql.ExternalStoredProcedureDetails{Name: "dolt_commit_validation_add", Schema: int64Schema("status"), Function: doltCommitValidationAdd},
+		sql.ExternalStoredProcedureDetails{Name: "dolt_commit_validation_drop", Schema: int64Schema("status"), Function: doltCommitValidationDrop},
+		sql.ExternalStoredProcedureDetails{Name: "dolt_commit_hook_add", Schema: int64Schema("status"), Function: doltCommitHookAdd},
+		sql.ExternalStoredProcedureDetails{Name: "dolt_commit_hook_drop", Schema: int64Schema("status"), Function: doltCommitHookDrop},
+		sql.ExternalStoredProcedureDetails{Name: "dolt_stash", Schema: int64Schema("status"), Function: doltStash},
+		sql.ExternalStoredProcedureDetails{Name: "dolt_pull_request_create", Schema: int64Schema("id"), Function: doltPullRequestCreate},
+		sql.ExternalStoredProcedureDetails{Name: "dolt_pull_request_preview", Schema: pullRequestPreviewSchema, Function: doltPullRequestPreview, ReadOnly: true},
+		sql.ExternalStoredProcedureDetails{Name: "dolt_pull_request_approve", Schema: int64Schema("status"), Function: doltPullRequestApprove},
+		sql.ExternalStoredProcedureDetails{Name: "dolt_pull_request_merge", Schema: stringSchema("hash"), Function: doltPullRequestMerge},
+		sql.ExternalStoredProcedureDetails{Name: "dolt_pull_request_close", Schema: int64Schema("status"), Function: doltPullRequestClose},
+	)
+}
+
+// int64Schema returns a non-nullable schema with all columns as BIGINT.
+func int64Schema(columnNames ...string) sql.Schema {
+	sch := make(sql.Schema, len(columnNames))
+	for i, colName := range columnNames {
+		sch[i] = &sql.Column{
+			Name:     colName,
+			Type:     types.Int64,
+			Nullable: false,
+		}
+	}
+	return sch
+}
+
+// stringSchema returns a non-nullable schema with all columns as LONGTEXT.
+func stringSchema(columnNames ...string) sql.Schema {
+	sch := make(sql.Schema, len(columnNames))
+	for i, colName := range columnNames {
+		sch[i] = &sql.Column{
+			Name:     colName,
+			Type:     types.LongText,
+			Nullable: false,
+		}
+	}
+	return sch
+}
@@ -0,0 +1,404 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procedures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	iofs "io/fs"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+	"github.com/dolthub/dolt/go/libraries/utils/config"
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+	"github.com/dolthub/dolt/go/store/chunks"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/val"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/doltgresql/core"
+	"github.com/dolthub/doltgresql/postgres/messages"
+	"github.com/dolthub/doltgresql/server/notices"
+)
+
+// DoltProgressInterval is how often a progress notice is raised while a long-running Dolt procedure is executing.
+var DoltProgressInterval = 5 * time.Second
+
+// LongRunningProcedures are the Dolt procedures that may take long enough that a client could believe that the
+// connection has hung, so they raise progress notices while they execute.
+var LongRunningProcedures = map[string]struct{}{
+	"dolt_backup": {},
+	"dolt_clone":  {},
+	"dolt_fetch":  {},
+	"dolt_gc":     {},
+	"dolt_merge":  {},
+	"dolt_pull":   {},
+	"dolt_push":   {},
+}
+
+// progressArgParsers return the argument parsers of the long-running procedures whose progress may be measured.
+var progressArgParsers = map[string]func() *argparser.ArgParser{
+	"dolt_clone": cli.CreateCloneArgParser,
+	"dolt_fetch": cli.CreateFetchArgParser,
+	"dolt_merge": cli.CreateMergeArgParser,
+	"dolt_pull":  cli.CreatePullArgParser,
+	"dolt_push":  cli.CreatePushArgParser,
+}
+
+// doltProgress tracks the execution of a long-running Dolt procedure, raising progress notices until the procedure has
+// finished. The notices are raised for the session rather than through its context, as they're raised from another
+// goroutine, and the connection handler sends them while the procedure is still executing.
+type doltProgress struct {
+	procedure         string
+	sessionID         uint32
+	clientMinMessages string
+	start             time.Time
+	// written returns the number of bytes that the procedure has written to the storage that it writes to, and is nil
+	// when that storage cannot be measured.
+	written func() (int64, error)
+	// total is the number of bytes that the procedure is expected to write, which is zero when it is not known.
+	total int64
+	// database and working are the current database and its working root before the procedure executed, which are only
+	// set for the procedures that merge into the working set.
+	database string
+	working  doltdb.RootValue
+	done     chan struct{}
+	wg       sync.WaitGroup
+}
+
+// wrapDoltProgress returns an implementation of a long-running procedure that raises progress notices while it
+// executes, followed by a summary once it has completed. No notices are raised when the --silent argument is given.
+func wrapDoltProgress(name string, procedure func(*sql.Context, ...string) (sql.RowIter, error)) func(*sql.Context, ...string) (sql.RowIter, error) {
+	return func(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+		for _, arg := range args {
+			if arg == "--"+cli.SilentFlag {
+				return procedure(ctx, args...)
+			}
+		}
+		progress := startDoltProgress(ctx, name, args)
+		iter, err := procedure(ctx, args...)
+		progress.finish(ctx, err)
+		return iter, err
+	}
+}
+
+// startDoltProgress begins raising progress notices for the given procedure. Progress that cannot be measured, such as
+// the bytes written to a remote that is not on the local filesystem, is left out of the notices.
+func startDoltProgress(ctx *sql.Context, name string, args []string) *doltProgress {
+	progress := &doltProgress{
+		procedure:         name,
+		sessionID:         ctx.Session.ID(),
+		clientMinMessages: notices.ClientMinMessages(ctx),
+		start:             time.Now(),
+		done:              make(chan struct{}),
+	}
+	if err := progress.measure(ctx, args); err != nil {
+		logrus.WithError(err).Debugf("unable to measure the progress of %s", name)
+		progress.written = nil
+		progress.total = 0
+	}
+	progress.wg.Add(1)
+	go progress.raiseProgress()
+	return progress
+}
+
+// measure determines how the progress of the procedure is measured. Procedures that copy data measure the bytes that
+// they've written to their destination, while procedures that merge into the working set also record the working root,
+// so that the tables that they've processed may be summarized.
+func (p *doltProgress) measure(ctx *sql.Context, args []string) error {
+	newArgParser, ok := progressArgParsers[p.procedure]
+	if !ok {
+		return nil
+	}
+	apr, err := newArgParser().Parse(args)
+	if err != nil {
+		// The procedure reports invalid arguments itself
+		return nil
+	}
+	sess := dsess.DSessFromSess(ctx.Session)
+	if p.procedure == "dolt_clone" {
+		if apr.NArg() < 1 {
+			return nil
+		}
+		// The database is cloned into a new directory, which is named the same way that Dolt names it
+		dir := path.Base(apr.Arg(0))
+		if apr.NArg() == 2 {
+			dir = apr.Arg(1)
+		}
+		p.written, err = directoryGrowth(sess.Provider().FileSystem(), dir)
+		if err != nil {
+			return err
+		}
+		// A database that is cloned from the local filesystem is expected to grow to the size of its source
+		if localPath, ok := localRemotePath(sess.Provider().FileSystem(), apr.Arg(0)); ok {
+			p.total, err = directorySize(filesys.LocalFS, localPath)
+		}
+		return err
+	}
+	dbName := ctx.GetCurrentDatabase()
+	if p.procedure == "dolt_push" {
+		remoteName := "origin"
+		if apr.NArg() > 0 {
+			remoteName = apr.Arg(0)
+		}
+		dbData, ok := sess.GetDbData(ctx, dbName)
+		if !ok {
+			return nil
+		}
+		remotes, err := dbData.Rsr.GetRemotes()
+		if err != nil {
+			return err
+		}
+		remote, ok := remotes.Get(remoteName)
+		if !ok {
+			return nil
+		}
+		if localPath, ok := localRemotePath(sess.Provider().FileSystem(), remote.Url); ok {
+			p.written, err = directoryGrowth(filesys.LocalFS, localPath)
+		}
+		return err
+	}
+	ddb, ok := sess.GetDoltDB(ctx, dbName)
+	if !ok {
+		return nil
+	}
+	if store, ok := datas.ChunkStoreFromDatabase(doltdb.HackDatasDatabaseFromDoltDB(ddb)).(chunks.TableFileStore); ok {
+		initial, err := store.Size(ctx)
+		if err != nil {
+			return err
+		}
+		p.written = func() (int64, error) {
+			size, err := store.Size(ctx)
+			return int64(size) - int64(initial), err
+		}
+	}
+	if p.procedure == "dolt_merge" || p.procedure == "dolt_pull" {
+		if roots, ok := sess.GetRoots(ctx, dbName); ok {
+			p.database = dbName
+			p.working = roots.Working
+		}
+	}
+	return nil
+}
+
+// raiseProgress periodically raises a progress notice until the procedure has finished.
+func (p *doltProgress) raiseProgress() {
+	defer p.wg.Done()
+	ticker := time.NewTicker(DoltProgressInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.done:
+			return
+		case <-ticker.C:
+			p.raise(fmt.Sprintf("%s is still running (%s)", p.procedure, strings.Join(p.status(true), ", ")), "")
+		}
+	}
+}
+
+// finish stops the progress notices. Procedures that ran for at least one interval also raise a summary, so that the
+// client knows that the procedure is no longer running. The summary of a merge lists the rows that were changed in each
+// table that it processed.
+func (p *doltProgress) finish(ctx *sql.Context, err error) {
+	close(p.done)
+	p.wg.Wait()
+	if err != nil || time.Since(p.start) < DoltProgressInterval {
+		return
+	}
+	status := p.status(false)
+	var detail []string
+	if p.working != nil {
+		if tables, err := p.summarizeTables(ctx); err == nil {
+			status = append(status, fmt.Sprintf("%d tables processed", len(tables)))
+			detail = tables
+		} else {
+			logrus.WithError(err).Debugf("unable to summarize the tables processed by %s", p.procedure)
+		}
+	}
+	p.raise(fmt.Sprintf("%s completed (%s)", p.procedure, strings.Join(status, ", ")), strings.Join(detail, "\n"))
+}
+
+// status returns the parts of the procedure's progress that may be measured. The percentage is only included while
+// the procedure is running, as the expected size is only an estimate.
+func (p *doltProgress) status(running bool) []string {
+	status := []string{fmt.Sprintf("%s elapsed", time.Since(p.start).Round(time.Millisecond))}
+	if p.written == nil {
+		return status
+	}
+	written, err := p.written()
+	if err != nil {
+		return status
+	}
+	if written < 0 {
+		written = 0
+	}
+	if running && p.total > 0 {
+		// The final size may differ from the estimate, so we never claim that the procedure is complete
+		percent := written * 100 / p.total
+		if percent > 99 {
+			percent = 99
+		}
+		status = append(status, fmt.Sprintf("%d%% complete", percent))
+	}
+	return append(status, fmt.Sprintf("%d bytes written", written))
+}
+
+// summarizeTables returns a line for each table that the procedure changed in the working set, with the number of rows
+// that were added, modified, and deleted.
+func (p *doltProgress) summarizeTables(ctx *sql.Context) ([]string, error) {
+	roots, ok := dsess.DSessFromSess(ctx.Session).GetRoots(ctx, p.database)
+	if !ok {
+		return nil, fmt.Errorf("unable to read the working set of database %s", p.database)
+	}
+	deltas, err := diff.GetTableDeltas(ctx, p.working, roots.Working)
+	if err != nil {
+		return nil, err
+	}
+	var tables []string
+	for _, td := range deltas {
+		name := td.ToName
+		if td.ToTable == nil {
+			name = td.FromName
+		}
+		var added, modified, deleted uint64
+		err = core.DiffTableRows(ctx, td, func(_ context.Context, d tree.Diff) error {
+			before := rowCount(td.FromSch, val.Tuple(d.From))
+			after := rowCount(td.ToSch, val.Tuple(d.To))
+			switch {
+			case d.Type == tree.AddedDiff:
+				added += after
+			case d.Type == tree.RemovedDiff:
+				deleted += before
+			case schema.IsKeyless(td.ToSch):
+				// Keyless tables store a count of identical rows, so a modification adds or deletes copies of a row
+				if after > before {
+					added += after - before
+				} else {
+					deleted += before - after
+				}
+			default:
+				modified++
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, fmt.Sprintf(`table "%s": %d rows added, %d rows modified, %d rows deleted`,
+			name.Name, added, modified, deleted))
+	}
+	return tables, nil
+}
+
+// raise raises a progress notice with the given message and detail.
+func (p *doltProgress) raise(message string, detail string) {
+	notices.RaiseForSession(p.sessionID, p.clientMinMessages, notices.Notice{
+		Severity: messages.ErrorResponseSeverity_Notice,
+		Message:  message,
+		Detail:   detail,
+	})
+}
+
+// rowCount returns the number of rows that the value of a row represents in a table with the given schema.
+func rowCount(sch schema.Schema, value val.Tuple) uint64 {
+	if sch == nil || value == nil {
+		return 0
+	}
+	return core.RowCardinality(sch.GetValueDescriptor(), schema.IsKeyless(sch), value)
+}
+
+// localRemotePath returns the path of the given remote URL when the remote is on the local filesystem.
+func localRemotePath(fs filesys.Filesys, remoteUrl string) (string, bool) {
+	scheme, absUrl, err := env.GetAbsRemoteUrl(fs, &config.MapConfig{}, remoteUrl)
+	if err != nil || scheme != "file" {
+		return "", false
+	}
+	parsed, err := url.Parse(absUrl)
+	if err != nil {
+		return "", false
+	}
+	return parsed.Path, true
+}
+
+// directoryGrowth returns a function that reports how much the given directory has grown since this was called. The
+// directory does not need to exist yet.
+func directoryGrowth(fs filesys.Filesys, dir string) (func() (int64, error), error) {
+	initial, err := directorySize(fs, dir)
+	if err != nil {
+		return nil, err
+	}
+	return func() (int64, error) {
+		size, err := directorySize(fs, dir)
+		return size - initial, err
+	}, nil
+}
+
+// directorySize returns the total size of the files within the given directory, which is zero if it does not exist.
+func directorySize(fs filesys.Filesys, dir string) (int64, error) {
+	if exists, isDir := fs.Exists(dir); !exists || !isDir {
+		return 0, nil
+	}
+	var size int64
+	if _, ok := fs.(*filesys.InMemFS); ok {
+		err := fs.Iter(dir, true, func(_ string, fileSize int64, isDir bool) bool {
+			if !isDir {
+				size += fileSize
+			}
+			return false
+		})
+		return size, err
+	}
+	// The directory is being written while it's walked, so files that are removed during the walk are skipped, which
+	// Iter does not handle
+	absDir, err := fs.Abs(dir)
+	if err != nil {
+		return 0, err
+	}
+	err = filepath.WalkDir(absDir, func(_ string, entry iofs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, iofs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			if errors.Is(err, iofs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
@@ -0,0 +1,164 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procedures
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/core"
+)
+
+// pullRequestPreviewSchema is the schema returned by dolt_pull_request_preview.
+var pullRequestPreviewSchema = int64Schema("conflicts", "tables_changed", "rows_added", "rows_deleted", "rows_modified")
+
+// pullRequestPreview summarizes the result of merging a pull request, without the merge having been applied.
+type pullRequestPreview struct {
+	Conflicts     int64
+	TablesChanged int64
+	RowsAdded     int64
+	RowsDeleted   int64
+	RowsModified  int64
+}
+
+// rowChange is a change to a single row, relative to the merge base.
+type rowChange struct {
+	Type tree.DiffType
+	To   []byte
+}
+
+// previewPullRequest computes the changes that the pull request's source branch introduces relative to the merge base
+// with the target branch, along with the number of changes that conflict with those made on the target branch. This
+// is a three-way diff that is computed directly from the commits, as Dolt's merge stats do not yet account for tables
+// within schemas.
+func previewPullRequest(ctx *sql.Context, pr pullRequest) (pullRequestPreview, error) {
+	dbData, err := getDbData(ctx)
+	if err != nil {
+		return pullRequestPreview{}, err
+	}
+	targetCommit, err := dbData.Ddb.ResolveCommitRef(ctx, ref.NewBranchRef(pr.TargetBranch))
+	if err != nil {
+		return pullRequestPreview{}, fmt.Errorf("unable to resolve target branch %s: %w", pr.TargetBranch, err)
+	}
+	sourceCommit, err := dbData.Ddb.ResolveCommitRef(ctx, ref.NewBranchRef(pr.SourceBranch))
+	if err != nil {
+		return pullRequestPreview{}, fmt.Errorf("unable to resolve source branch %s: %w", pr.SourceBranch, err)
+	}
+	optAncestor, err := doltdb.GetCommitAncestor(ctx, targetCommit, sourceCommit)
+	if err != nil {
+		return pullRequestPreview{}, err
+	}
+	ancestorCommit, ok := optAncestor.ToCommit()
+	if !ok {
+		return pullRequestPreview{}, doltdb.ErrGhostCommitRuntimeFailure
+	}
+	ancestorRoot, err := ancestorCommit.GetRootValue(ctx)
+	if err != nil {
+		return pullRequestPreview{}, err
+	}
+	targetRoot, err := targetCommit.GetRootValue(ctx)
+	if err != nil {
+		return pullRequestPreview{}, err
+	}
+	sourceRoot, err := sourceCommit.GetRootValue(ctx)
+	if err != nil {
+		return pullRequestPreview{}, err
+	}
+
+	targetDeltas, err := diff.GetTableDeltas(ctx, ancestorRoot, targetRoot)
+	if err != nil {
+		return pullRequestPreview{}, err
+	}
+	targetDeltasByName := make(map[doltdb.TableName]diff.TableDelta, len(targetDeltas))
+	for _, td := range targetDeltas {
+		targetDeltasByName[tableDeltaName(td)] = td
+	}
+	sourceDeltas, err := diff.GetTableDeltas(ctx, ancestorRoot, sourceRoot)
+	if err != nil {
+		return pullRequestPreview{}, err
+	}
+
+	var preview pullRequestPreview
+	for _, sourceDelta := range sourceDeltas {
+		if sourceDelta.FromTable == nil && sourceDelta.ToTable == nil {
+			// Collation changes are reported as a delta without any tables
+			continue
+		}
+		preview.TablesChanged++
+		targetDelta, changedOnTarget := targetDeltasByName[tableDeltaName(sourceDelta)]
+		// Row changes can only be compared when both sides agree on the table's existence and schema, so anything else
+		// that was changed on both sides is counted as a single conflict for the table.
+		compareRows := changedOnTarget && rowsAreComparable(sourceDelta, targetDelta)
+		if changedOnTarget && !compareRows {
+			preview.Conflicts++
+		}
+		var targetChanges map[string]rowChange
+		if compareRows {
+			targetChanges = make(map[string]rowChange)
+			err = core.DiffTableRows(ctx, targetDelta, func(_ context.Context, d tree.Diff) error {
+				targetChanges[string(d.Key)] = rowChange{Type: d.Type, To: d.To}
+				return nil
+			})
+			if err != nil {
+				return pullRequestPreview{}, err
+			}
+		}
+		err = core.DiffTableRows(ctx, sourceDelta, func(_ context.Context, d tree.Diff) error {
+			switch d.Type {
+			case tree.AddedDiff:
+				preview.RowsAdded++
+			case tree.RemovedDiff:
+				preview.RowsDeleted++
+			case tree.ModifiedDiff:
+				preview.RowsModified++
+			}
+			if targetChange, ok := targetChanges[string(d.Key)]; ok {
+				if targetChange.Type != d.Type || !bytes.Equal(targetChange.To, d.To) {
+					preview.Conflicts++
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return pullRequestPreview{}, err
+		}
+	}
+	return preview, nil
+}
+
+// tableDeltaName returns the name that identifies the table within the delta.
+func tableDeltaName(td diff.TableDelta) doltdb.TableName {
+	if td.ToTable != nil {
+		return td.ToName
+	}
+	return td.FromName
+}
+
+// rowsAreComparable returns whether the row changes from both deltas may be compared against one another, which
+// requires that both sides modified an existing table and ended up with the same schema.
+func rowsAreComparable(sourceDelta diff.TableDelta, targetDelta diff.TableDelta) bool {
+	if sourceDelta.IsAdd() || sourceDelta.IsDrop() || targetDelta.IsAdd() || targetDelta.IsDrop() {
+		return false
+	}
+	return schema.SchemasAreEqual(sourceDelta.ToSch, targetDelta.ToSch)
+}
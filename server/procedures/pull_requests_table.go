@@ -0,0 +1,125 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procedures
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// PullRequestsTable is the name of the table that stores pull requests.
+const PullRequestsTable = "pull_requests"
+
+const (
+	pullRequestStatus_Open     = "open"
+	pullRequestStatus_Approved = "approved"
+	pullRequestStatus_Merged   = "merged"
+	pullRequestStatus_Closed   = "closed"
+)
+
+// pullRequestsTableSchema is the schema of the pull requests table.
+var pullRequestsTableSchema = sql.Schema{
+	{Name: "id", Type: pgtypes.Int64, Source: PullRequestsTable, PrimaryKey: true},
+	{Name: "source_branch", Type: pgtypes.Text, Source: PullRequestsTable},
+	{Name: "target_branch", Type: pgtypes.Text, Source: PullRequestsTable},
+	{Name: "description", Type: pgtypes.Text, Source: PullRequestsTable, Nullable: true},
+	{Name: "status", Type: pgtypes.Text, Source: PullRequestsTable},
+	{Name: "author", Type: pgtypes.Text, Source: PullRequestsTable},
+	{Name: "approved_by", Type: pgtypes.Text, Source: PullRequestsTable, Nullable: true},
+	{Name: "merge_commit", Type: pgtypes.Text, Source: PullRequestsTable, Nullable: true},
+	{Name: "created_at", Type: pgtypes.Timestamp, Source: PullRequestsTable},
+}
+
+// pullRequest is a single row of the pull requests table. Nullable columns use nil to represent NULL.
+type pullRequest struct {
+	ID           int64
+	SourceBranch string
+	TargetBranch string
+	Description  any
+	Status       string
+	Author       string
+	ApprovedBy   any
+	MergeCommit  any
+	CreatedAt    time.Time
+}
+
+// toRow returns the pull request as a row of the pull requests table.
+func (pr pullRequest) toRow() sql.Row {
+	return sql.Row{
+		pr.ID,
+		pr.SourceBranch,
+		pr.TargetBranch,
+		pr.Description,
+		pr.Status,
+		pr.Author,
+		pr.ApprovedBy,
+		pr.MergeCommit,
+		pr.CreatedAt,
+	}
+}
+
+// pullRequestFromRow returns the pull request represented by the given row of the pull requests table.
+func pullRequestFromRow(row sql.Row) (pullRequest, error) {
+	if len(row) != len(pullRequestsTableSchema) {
+		return pullRequest{}, fmt.Errorf("%s.%s has an unexpected number of columns: %d",
+			SystemSchema, PullRequestsTable, len(row))
+	}
+	return pullRequest{
+		ID:           row[0].(int64),
+		SourceBranch: row[1].(string),
+		TargetBranch: row[2].(string),
+		Description:  row[3],
+		Status:       row[4].(string),
+		Author:       row[5].(string),
+		ApprovedBy:   row[6],
+		MergeCommit:  row[7],
+		CreatedAt:    row[8].(time.Time),
+	}, nil
+}
+
+// getPullRequestsTable returns the pull requests table from the current database. If the table does not exist, then
+// it is created when requested, and otherwise nil is returned.
+func getPullRequestsTable(ctx *sql.Context, create bool) (sql.Table, error) {
+	return getSystemTable(ctx, PullRequestsTable, pullRequestsTableSchema, create)
+}
+
+// readPullRequests returns every pull request within the table.
+func readPullRequests(ctx *sql.Context, table sql.Table) ([]pullRequest, error) {
+	rows, err := readRows(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	pullRequests := make([]pullRequest, len(rows))
+	for i, row := range rows {
+		if pullRequests[i], err = pullRequestFromRow(row); err != nil {
+			return nil, err
+		}
+	}
+	return pullRequests, nil
+}
+
+// insertPullRequest adds the pull request to the table.
+func insertPullRequest(ctx *sql.Context, table sql.Table, pr pullRequest) error {
+	return insertRow(ctx, table, pr.toRow())
+}
+
+// updatePullRequest replaces the old pull request with the new one.
+func updatePullRequest(ctx *sql.Context, table sql.Table, oldPr pullRequest, newPr pullRequest) error {
+	return updateRow(ctx, table, oldPr.toRow(), newPr.toRow())
+}
@@ -0,0 +1,138 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procedures
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/dconfig"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dprocedures"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// remoteProcedures are the Dolt procedures that work with remotes, mapped to whether they accept the --user argument.
+var remoteProcedures = map[string]bool{
+	"dolt_clone":  true,
+	"dolt_fetch":  true,
+	"dolt_pull":   true,
+	"dolt_push":   true,
+	"dolt_remote": false,
+}
+
+// remotePasswordMutex serializes the remote operations that use a password from the session, as Dolt only reads the
+// password from the environment of the process.
+var remotePasswordMutex sync.Mutex
+
+// wrapRemoteProcedure returns an implementation of a remote procedure that authenticates using the credentials from the
+// session's dolt.remote_user and dolt.remote_password parameters when the user has not been given as an argument.
+// Errors are reported using the SQLSTATE that best describes them.
+func wrapRemoteProcedure(procedure func(*sql.Context, ...string) (sql.RowIter, error), acceptsUser bool) func(*sql.Context, ...string) (sql.RowIter, error) {
+	return func(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+		iter, err := callRemoteProcedure(ctx, procedure, acceptsUser, args)
+		return iter, pgerrors.Raise(ctx, remoteError(err))
+	}
+}
+
+// callRemoteProcedure calls the given remote procedure, adding the credentials from the session when the user has not
+// been given as an argument.
+func callRemoteProcedure(ctx *sql.Context, procedure func(*sql.Context, ...string) (sql.RowIter, error), acceptsUser bool, args []string) (sql.RowIter, error) {
+	if !acceptsUser || hasUserArgument(args) {
+		return procedure(ctx, args...)
+	}
+	user, err := remoteSessionParameter(ctx, "dolt.remote_user")
+	if err != nil {
+		return nil, err
+	} else if len(user) == 0 {
+		return procedure(ctx, args...)
+	}
+	password, err := remoteSessionParameter(ctx, "dolt.remote_password")
+	if err != nil {
+		return nil, err
+	}
+	args = append([]string{"--user", user}, args...)
+	if len(password) == 0 {
+		return procedure(ctx, args...)
+	}
+	remotePasswordMutex.Lock()
+	defer remotePasswordMutex.Unlock()
+	originalPassword, hasOriginalPassword := os.LookupEnv(dconfig.EnvDoltRemotePassword)
+	if err = os.Setenv(dconfig.EnvDoltRemotePassword, password); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if hasOriginalPassword {
+			_ = os.Setenv(dconfig.EnvDoltRemotePassword, originalPassword)
+		} else {
+			_ = os.Unsetenv(dconfig.EnvDoltRemotePassword)
+		}
+	}()
+	return procedure(ctx, args...)
+}
+
+// hasUserArgument returns whether the arguments of a remote procedure contain the user to authenticate as.
+func hasUserArgument(args []string) bool {
+	for _, arg := range args {
+		if arg == "--user" || arg == "-u" || strings.HasPrefix(arg, "--user=") {
+			return true
+		}
+	}
+	return false
+}
+
+// remoteSessionParameter returns the value of the given parameter, which is empty if it has not been set.
+func remoteSessionParameter(ctx *sql.Context, name string) (string, error) {
+	value, err := ctx.GetSessionVariable(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	str, _ := value.(string)
+	return str, nil
+}
+
+// remoteError returns the given error from a remote procedure with the SQLSTATE that best describes it. Some errors are
+// only distinguishable by their message, as Dolt formats them into other errors.
+func remoteError(err error) error {
+	if err == nil {
+		return nil
+	}
+	message := err.Error()
+	switch {
+	case errors.Is(err, env.ErrRemoteAlreadyExists):
+		return pgerrors.Wrap(pgcode.DuplicateObject, err)
+	case errors.Is(err, env.ErrUnknownRemote) || env.ErrInvalidRepository.Is(err) ||
+		strings.HasPrefix(message, "error: unknown remote:"):
+		return pgerrors.Wrap(pgcode.UndefinedObject, err)
+	case errors.Is(err, datas.ErrMergeNeeded) || strings.Contains(message, "[rejected]"):
+		// The remote has changes that must be pulled before pushing, which is the same as a concurrent update
+		return pgerrors.Wrap(pgcode.SerializationFailure, err).
+			WithHint("Pull the changes from the remote before pushing again.")
+	case dprocedures.ErrUncommittedChanges.Is(err) || errors.Is(err, actions.ErrCantFF):
+		return pgerrors.Wrap(pgcode.ObjectNotInPrerequisiteState, err)
+	case strings.Contains(message, dconfig.EnvDoltRemotePassword):
+		return pgerrors.New(pgcode.InvalidPassword, "a password is required to authenticate with the remote").
+			WithHint("Set dolt.remote_password to the password of the remote user.")
+	default:
+		return err
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procedures
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// SystemSchema is the schema that contains the tables that are managed by our procedures. These are regular tables on
+// the current branch, so they are versioned alongside the rest of the data once they've been committed.
+const SystemSchema = "dolt"
+
+// getSystemTable returns the named table from the system schema of the current database. If the table does not exist,
+// then it is created with the given schema when requested, and otherwise nil is returned.
+func getSystemTable(ctx *sql.Context, name string, sch sql.Schema, create bool) (sql.Table, error) {
+	sess := dsess.DSessFromSess(ctx.Session)
+	db, err := sess.Provider().Database(ctx, ctx.GetCurrentDatabase())
+	if err != nil {
+		return nil, err
+	}
+	schemaDb, ok := db.(sql.SchemaDatabase)
+	if !ok {
+		return nil, fmt.Errorf("database %s does not support schemas", db.Name())
+	}
+	dbSchema, ok, err := schemaDb.GetSchema(ctx, SystemSchema)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if !create {
+			return nil, nil
+		}
+		if err = schemaDb.CreateSchema(ctx, SystemSchema); err != nil {
+			return nil, err
+		}
+		if dbSchema, _, err = schemaDb.GetSchema(ctx, SystemSchema); err != nil {
+			return nil, err
+		}
+	}
+	table, ok, err := dbSchema.GetTableInsensitive(ctx, name)
+	if err != nil || ok || !create {
+		return table, err
+	}
+	tableCreator, ok := dbSchema.(sql.TableCreator)
+	if !ok {
+		return nil, fmt.Errorf("unable to create %s.%s", SystemSchema, name)
+	}
+	err = tableCreator.CreateTable(ctx, name, sql.NewPrimaryKeySchema(sch), sql.Collation_Default, "")
+	if err != nil {
+		return nil, err
+	}
+	table, _, err = dbSchema.GetTableInsensitive(ctx, name)
+	return table, err
+}
+
+// readRows returns every row within the table.
+func readRows(ctx *sql.Context, table sql.Table) ([]sql.Row, error) {
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer partitions.Close(ctx)
+	var rows []sql.Row
+	for {
+		partition, err := partitions.Next(ctx)
+		if err == io.EOF {
+			return rows, nil
+		} else if err != nil {
+			return nil, err
+		}
+		partitionIter, err := table.PartitionRows(ctx, partition)
+		if err != nil {
+			return nil, err
+		}
+		partitionRows, err := sql.RowIterToRows(ctx, partitionIter)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, partitionRows...)
+	}
+}
+
+// insertRow adds the row to the table.
+func insertRow(ctx *sql.Context, table sql.Table, row sql.Row) error {
+	insertable, ok := table.(sql.InsertableTable)
+	if !ok {
+		return fmt.Errorf("%s.%s does not support inserts", SystemSchema, table.Name())
+	}
+	inserter := insertable.Inserter(ctx)
+	inserter.StatementBegin(ctx)
+	if err := inserter.Insert(ctx, row); err != nil {
+		_ = inserter.DiscardChanges(ctx, err)
+		_ = inserter.Close(ctx)
+		return err
+	}
+	if err := inserter.StatementComplete(ctx); err != nil {
+		_ = inserter.Close(ctx)
+		return err
+	}
+	return inserter.Close(ctx)
+}
+
+// updateRow replaces the old row with the new one.
+func updateRow(ctx *sql.Context, table sql.Table, oldRow sql.Row, newRow sql.Row) error {
+	updatable, ok := table.(sql.UpdatableTable)
+	if !ok {
+		return fmt.Errorf("%s.%s does not support updates", SystemSchema, table.Name())
+	}
+	updater := updatable.Updater(ctx)
+	updater.StatementBegin(ctx)
+	if err := updater.Update(ctx, oldRow, newRow); err != nil {
+		_ = updater.DiscardChanges(ctx, err)
+		_ = updater.Close(ctx)
+		return err
+	}
+	if err := updater.StatementComplete(ctx); err != nil {
+		_ = updater.Close(ctx)
+		return err
+	}
+	return updater.Close(ctx)
+}
+
+// deleteRow removes the row from the table.
+func deleteRow(ctx *sql.Context, table sql.Table, row sql.Row) error {
+	deletable, ok := table.(sql.DeletableTable)
+	if !ok {
+		return fmt.Errorf("%s.%s does not support deletes", SystemSchema, table.Name())
+	}
+	deleter := deletable.Deleter(ctx)
+	deleter.StatementBegin(ctx)
+	if err := deleter.Delete(ctx, row); err != nil {
+		_ = deleter.DiscardChanges(ctx, err)
+		_ = deleter.Close(ctx)
+		return err
+	}
+	if err := deleter.StatementComplete(ctx); err != nil {
+		_ = deleter.Close(ctx)
+		return err
+	}
+	return deleter.Close(ctx)
+}
@@ -0,0 +1,97 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	doltsqlserver "github.com/dolthub/dolt/go/libraries/doltcore/sqlserver"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/server/replica"
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+// newPushReplicationConfig returns the push replication configuration from the server's configuration. Returns nil if
+// push replication has not been configured.
+func newPushReplicationConfig(cfg *servercfg.DoltgresPushReplicationConfig) (*replica.PushConfig, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	config := &replica.PushConfig{
+		Remotes:   cfg.Remotes,
+		Databases: cfg.Databases,
+	}
+	if cfg.Async != nil {
+		config.Async = *cfg.Async
+	}
+	if cfg.FailurePolicy != nil {
+		config.FailurePolicy = replica.FailurePolicy(*cfg.FailurePolicy)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// serverPushReplicationConfig is the configuration of push replication, and is nil when push replication has not been
+// configured. This is set when the server starts.
+var serverPushReplicationConfig *replica.PushConfig
+
+// startPushReplication attaches the hooks that push each new commit to every existing database. This must be called
+// once the server is running, and returns an error if the failure policy does not permit a remote to be skipped.
+func startPushReplication() error {
+	provider := runningProvider()
+	if serverPushReplicationConfig == nil || provider == nil {
+		return nil
+	}
+	bThreads := doltsqlserver.GetRunningServer().Engine.BackgroundThreads
+	ctx := context.Background()
+	for _, db := range provider.DoltDatabases() {
+		if err := attachPushHooks(ctx, *serverPushReplicationConfig, db.Name(), db, bThreads); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// registerPushReplication attaches the hooks that push each new commit to every database that is created later.
+func registerPushReplication() {
+	provider := runningProvider()
+	if serverPushReplicationConfig == nil || provider == nil {
+		return
+	}
+	config := *serverPushReplicationConfig
+	bThreads := doltsqlserver.GetRunningServer().Engine.BackgroundThreads
+	provider.InitDatabaseHooks = append(provider.InitDatabaseHooks, func(ctx *sql.Context, _ *sqle.DoltDatabaseProvider, name string, _ *env.DoltEnv, db dsess.SqlDatabase) error {
+		return attachPushHooks(ctx, config, name, db, bThreads)
+	})
+}
+
+// attachPushHooks adds the hooks that push the commits of the database to its configured remotes.
+func attachPushHooks(ctx context.Context, config replica.PushConfig, name string, db dsess.SqlDatabase, bThreads *sql.BackgroundThreads) error {
+	ddb := db.DbData().Ddb
+	hooks, err := replica.NewPushHooks(ctx, config, replica.Database{Name: name, Data: db.DbData()}, bThreads)
+	if err != nil {
+		return err
+	}
+	for _, hook := range hooks {
+		ddb.PrependCommitHook(ctx, hook)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/doltgresql/postgres/messages"
+	"github.com/dolthub/doltgresql/server/notices"
+)
+
+// loadQueryHints reads the session's pg_hint_plan.enable_hint parameter. This is called on startup and whenever a
+// statement may have changed the parameter.
+func (h *ConnectionHandler) loadQueryHints() {
+	value, err := h.showParameter("pg_hint_plan.enable_hint")
+	if err != nil {
+		logrus.WithError(err).Warn("unable to read parameter pg_hint_plan.enable_hint")
+		h.queryHintsEnabled = false
+		return
+	}
+	h.queryHintsEnabled = isParameterOn(value)
+}
+
+// warnIgnoredHints sends a warning for each hint in the query that the planner cannot honor, so that the hint is not
+// silently dropped. Nothing is sent while hints are disabled, as no hints are honored at all.
+func (h *ConnectionHandler) warnIgnoredHints(query ConvertedQuery) error {
+	if !h.queryHintsEnabled {
+		return nil
+	}
+	for _, hint := range query.IgnoredHints {
+		if err := h.sendNotice(notices.Notice{
+			Severity: messages.ErrorResponseSeverity_Warning,
+			Message:  fmt.Sprintf(`hint "%s" is not supported and was ignored`, hint),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
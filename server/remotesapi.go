@@ -0,0 +1,202 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	remotesapi "github.com/dolthub/dolt/go/gen/proto/dolt/services/remotesapi/v1alpha1"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/remotesrv"
+	doltservercfg "github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+	"github.com/dolthub/dolt/go/libraries/utils/svcs"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dolthub/doltgresql/server/auth"
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+// sqlServerConfig is the configuration that is given to Dolt. Doltgres serves the remotesapi endpoint itself, so that
+// clients authenticate as Doltgres roles, and therefore the endpoint's port is hidden from Dolt.
+type sqlServerConfig struct {
+	doltservercfg.ServerConfig
+	// enginePort replaces the configured port when it's not zero. The engine always binds its own listener, so this is
+	// used when the server's listener was bound before the server started, as the configured port is then taken.
+	enginePort int
+}
+
+// Port implements the interface doltservercfg.ServerConfig.
+func (cfg sqlServerConfig) Port() int {
+	if cfg.enginePort != 0 {
+		return cfg.enginePort
+	}
+	return cfg.ServerConfig.Port()
+}
+
+// RemotesapiPort implements the interface doltservercfg.ServerConfig.
+func (sqlServerConfig) RemotesapiPort() *int {
+	return nil
+}
+
+// configureRemotesapi returns the service that serves the Dolt remotesapi endpoint, which allows Dolt and Doltgres
+// instances to clone, fetch from, and push to the server's databases. Table files are served from, and uploaded to, the
+// given file system, which must be rooted at the data directory. Returns nil if no port has been configured.
+func configureRemotesapi(cfg *servercfg.DoltgresConfig, dataDirFs filesys.Filesys) (*svcs.AnonService, error) {
+	port := cfg.RemotesapiPort()
+	if port == nil {
+		return nil, nil
+	}
+	var tlsConfig *tls.Config
+	keyPath, certPath := cfg.RemotesapiTLSKey(), cfg.RemotesapiTLSCert()
+	if len(keyPath) > 0 || len(certPath) > 0 {
+		if len(keyPath) == 0 || len(certPath) == 0 {
+			return nil, fmt.Errorf("the remotesapi endpoint requires both tls_key and tls_cert to be set")
+		}
+		certificate, err := tls.LoadX509KeyPair(certPath, keyPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load the remotesapi TLS certificate: %w", err)
+		}
+		tlsConfig = &tls.Config{
+			Certificates: []tls.Certificate{certificate},
+			MinVersion:   tls.VersionTLS12,
+		}
+	}
+	readOnly := cfg.ReadOnly()
+	if apiReadOnly := cfg.RemotesapiReadOnly(); apiReadOnly != nil && *apiReadOnly {
+		readOnly = true
+	}
+	listenAddr := net.JoinHostPort(cfg.RemotesapiHost(), strconv.Itoa(*port))
+
+	var srv *remotesrv.Server
+	var listeners remotesrv.Listeners
+	started := make(chan struct{})
+	return &svcs.AnonService{
+		InitF: func(ctx context.Context) (err error) {
+			args := remotesrv.ServerArgs{
+				Logger:             logrus.NewEntry(logrus.StandardLogger()),
+				HttpListenAddr:     listenAddr,
+				GrpcListenAddr:     listenAddr,
+				FS:                 dataDirFs,
+				DBCache:            remotesapiDBCache{},
+				ReadOnly:           readOnly,
+				ConcurrencyControl: remotesapi.PushConcurrencyControl_PUSH_CONCURRENCY_CONTROL_ASSERT_WORKING_SET,
+				TLSConfig:          tlsConfig,
+			}
+			interceptor := remotesrv.ServerInterceptor{
+				Lgr:              args.Logger,
+				AccessController: remotesapiAccessControl{},
+			}
+			args.Options = append(args.Options, interceptor.Options()...)
+			if srv, err = remotesrv.NewServer(args); err != nil {
+				return fmt.Errorf("unable to create the remotesapi endpoint: %w", err)
+			}
+			if listeners, err = srv.Listeners(); err != nil {
+				return fmt.Errorf("unable to listen on %s for the remotesapi endpoint: %w", listenAddr, err)
+			}
+			return nil
+		},
+		RunF: func(ctx context.Context) {
+			close(started)
+			srv.Serve(listeners)
+		},
+		StopF: func() error {
+			// The service may be stopped without having run, such as when another service fails to start
+			select {
+			case <-started:
+				srv.GracefulStop()
+			default:
+				if srv != nil {
+					return listeners.Close()
+				}
+			}
+			return nil
+		},
+	}, nil
+}
+
+// remotesapiDBCache returns the chunk stores of the running server's databases.
+type remotesapiDBCache struct{}
+
+var _ remotesrv.DBCache = remotesapiDBCache{}
+
+// Get implements the interface remotesrv.DBCache. Databases are never created by the endpoint, so that a mistyped
+// remote URL is reported rather than creating an empty database.
+func (remotesapiDBCache) Get(ctx context.Context, path string, nbfVerStr string) (remotesrv.RemoteSrvStore, error) {
+	provider := runningProvider()
+	if provider == nil {
+		return nil, status.Error(codes.Unavailable, "the server is not running")
+	}
+	for _, db := range provider.DoltDatabases() {
+		if db.Name() != path {
+			continue
+		}
+		store, ok := datas.ChunkStoreFromDatabase(doltdb.HackDatasDatabaseFromDoltDB(db.DbData().Ddb)).(remotesrv.RemoteSrvStore)
+		if !ok {
+			return nil, remotesrv.ErrUnimplemented
+		}
+		return store, nil
+	}
+	return nil, status.Errorf(codes.NotFound, `database "%s" does not exist`, path)
+}
+
+// remotesapiAccessControl authenticates endpoint requests as Doltgres roles. Requests use password authentication
+// regardless of the server's hba rules, since the endpoint does not speak the Postgres protocol.
+type remotesapiAccessControl struct{}
+
+var _ remotesrv.AccessControl = remotesapiAccessControl{}
+
+// remotesapiRoleKey is the context key that holds the name of the authenticated role.
+type remotesapiRoleKey struct{}
+
+// ApiAuthenticate implements the interface remotesrv.AccessControl.
+func (remotesapiAccessControl) ApiAuthenticate(ctx context.Context) (context.Context, error) {
+	creds, err := remotesrv.ExtractBasicAuthCreds(ctx)
+	if err != nil {
+		return nil, err
+	}
+	role, ok := auth.GetRole(creds.Username)
+	if !ok || !role.CanLogin || !role.PasswordValid(time.Now()) || !auth.VerifyPassword(role.Name, role.Password, creds.Password) {
+		return nil, fmt.Errorf(`password authentication failed for user "%s"`, creds.Username)
+	}
+	return context.WithValue(ctx, remotesapiRoleKey{}, role.Name), nil
+}
+
+// ApiAuthorize implements the interface remotesrv.AccessControl. Pushing requires a superuser, while cloning and
+// fetching also permit roles with the REPLICATION attribute.
+func (remotesapiAccessControl) ApiAuthorize(ctx context.Context, superUserRequired bool) (bool, error) {
+	name, _ := ctx.Value(remotesapiRoleKey{}).(string)
+	role, ok := auth.GetRole(name)
+	switch {
+	case !ok:
+		return false, fmt.Errorf(`role "%s" does not exist`, name)
+	case role.IsSuperUser:
+		return true, nil
+	case superUserRequired:
+		return false, fmt.Errorf(`permission denied: role "%s" must be a superuser to push`, name)
+	case !role.IsReplication:
+		return false, fmt.Errorf(`permission denied: role "%s" must be a superuser or have the REPLICATION attribute`, name)
+	default:
+		return true, nil
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	doltservercfg "github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+	doltsqlserver "github.com/dolthub/dolt/go/libraries/doltcore/sqlserver"
+	"github.com/dolthub/dolt/go/libraries/utils/svcs"
+	"github.com/jackc/pgx/v5"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/doltgresql/server/replica"
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+// configureReadReplica returns the replicator of a read replica, along with a service that replicates its databases on
+// every interval and serves its webhook. Returns nil if the server is not a read replica. The service must be
+// registered after the services of the SQL server, so that replication has stopped before the databases close.
+func configureReadReplica(cfg *servercfg.DoltgresReadReplicaConfig) (*replica.Replicator, *svcs.AnonService, error) {
+	if cfg == nil {
+		return nil, nil, nil
+	}
+	var config replica.Config
+	if cfg.RemoteURL != nil {
+		config.RemoteURL = *cfg.RemoteURL
+	}
+	if cfg.Interval != nil {
+		interval, err := time.ParseDuration(*cfg.Interval)
+		if err != nil || interval <= 0 {
+			return nil, nil, fmt.Errorf(`invalid read replica interval "%s"`, *cfg.Interval)
+		}
+		config.Interval = interval
+	}
+	config.Databases = cfg.Databases
+	config.Branches = cfg.Branches
+	replicator, err := replica.NewReplicator(config, replicaDatabases)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var webhook *http.Server
+	var listener net.Listener
+	ctx, cancel := context.WithCancel(context.Background())
+	started, stopped := make(chan struct{}), make(chan struct{})
+	return replicator, &svcs.AnonService{
+		InitF: func(context.Context) error {
+			if cfg.WebhookAddress == nil {
+				return nil
+			}
+			mux := http.NewServeMux()
+			mux.Handle("/replicate", replicator)
+			webhook = &http.Server{Handler: mux}
+			var err error
+			if listener, err = net.Listen("tcp", *cfg.WebhookAddress); err != nil {
+				return fmt.Errorf("error starting read replica webhook: %w", err)
+			}
+			return nil
+		},
+		RunF: func(context.Context) {
+			close(started)
+			defer close(stopped)
+			if webhook != nil {
+				go func() {
+					if err := webhook.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+						logrus.Errorf("read replica webhook failed: %v", err)
+					}
+				}()
+			}
+			replicator.Run(ctx)
+		},
+		StopF: func() error {
+			cancel()
+			if webhook != nil {
+				_ = webhook.Close()
+			} else if listener != nil {
+				_ = listener.Close()
+			}
+			// The service may be stopped without having run, such as when another service fails to start
+			select {
+			case <-started:
+				<-stopped
+			default:
+			}
+			return nil
+		},
+	}, nil
+}
+
+// startReadReplica clones every replicated database that does not yet exist, brings every replicated database up to
+// date with its remote, and then makes the server read-only. This must be called once the server is running.
+func startReadReplica(ctx context.Context, replicator *replica.Replicator, cfg doltservercfg.ServerConfig) error {
+	existing := make(map[string]struct{})
+	for _, db := range replicaDatabases() {
+		existing[db.Name] = struct{}{}
+	}
+	urls := replicator.Databases()
+	names := make([]string, 0, len(urls))
+	for name := range urls {
+		if _, ok := existing[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	if len(names) > 0 {
+		sort.Strings(names)
+		dns := fmt.Sprintf("postgres://%s:%s@localhost:%d", cfg.User(), cfg.Password(), cfg.Port())
+		conn, err := pgx.Connect(ctx, dns)
+		if err != nil {
+			return err
+		}
+		defer conn.Close(ctx)
+		for _, name := range names {
+			query := fmt.Sprintf("SELECT dolt_clone('%s', '%s');", quoteLiteral(urls[name]), quoteLiteral(name))
+			if _, err = conn.Exec(ctx, query); err != nil {
+				return fmt.Errorf("error cloning read replica database %s: %w", name, err)
+			}
+		}
+	}
+	if err := replicator.PullAll(ctx, ""); err != nil {
+		return fmt.Errorf("error replicating databases: %w", err)
+	}
+	runningServer := doltsqlserver.GetRunningServer()
+	if runningServer == nil || runningServer.Engine == nil {
+		return fmt.Errorf("read replica server is not running")
+	}
+	runningServer.Engine.ReadOnly.Store(true)
+	return nil
+}
+
+// replicaDatabases returns every database of the running server.
+func replicaDatabases() []replica.Database {
+	provider := runningProvider()
+	if provider == nil {
+		return nil
+	}
+	var databases []replica.Database
+	for _, db := range provider.DoltDatabases() {
+		databases = append(databases, replica.Database{Name: db.Name(), Data: db.DbData()})
+	}
+	return databases
+}
+
+// quoteLiteral escapes the value so that it may be placed within a single-quoted string literal.
+func quoteLiteral(value string) string {
+	return strings.ReplaceAll(value, "'", "''")
+}
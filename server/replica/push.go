@@ -0,0 +1,186 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replica
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/dbfactory"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/doltgresql/server/notices"
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// FailurePolicy decides how failures to replicate commits to a remote are handled.
+type FailurePolicy string
+
+const (
+	// FailurePolicyError refuses to replicate a database whose remotes cannot be loaded, by returning an error.
+	FailurePolicyError FailurePolicy = "error"
+	// FailurePolicyWarn logs the remotes that cannot be loaded, and leaves their databases unreplicated.
+	FailurePolicyWarn FailurePolicy = "warn"
+	// FailurePolicyIgnore behaves as FailurePolicyWarn, except that clients are never warned of failed pushes.
+	FailurePolicyIgnore FailurePolicy = "ignore"
+)
+
+// PushConfig is the configuration of the remotes that every new commit is pushed to.
+type PushConfig struct {
+	// Remotes are the templates of the remotes that each database's commits are pushed to. DatabasePlaceholder is
+	// replaced by the name of the database, and when the placeholder is omitted, the name is appended as a path element.
+	Remotes []string
+	// Databases are glob patterns of the databases that are replicated. All databases are replicated when empty.
+	Databases []string
+	// Async pushes commits in the background, rather than while the commit is being made.
+	Async bool
+	// FailurePolicy decides how failures are handled. Defaults to FailurePolicyError.
+	FailurePolicy FailurePolicy
+}
+
+// Validate returns an error if the configuration is invalid, and sets the defaults of any omitted fields.
+func (config *PushConfig) Validate() error {
+	if len(config.Remotes) == 0 {
+		return fmt.Errorf("push replication requires at least one remote")
+	}
+	for _, remote := range config.Remotes {
+		if !strings.Contains(remote, "://") {
+			return fmt.Errorf(`invalid push replication remote "%s": remotes must be URLs`, remote)
+		}
+		if _, err := url.Parse(RemoteURL(remote, "database")); err != nil {
+			return fmt.Errorf(`invalid push replication remote "%s": %w`, remote, err)
+		}
+	}
+	for _, pattern := range config.Databases {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf(`invalid push replication database pattern "%s"`, pattern)
+		}
+	}
+	switch config.FailurePolicy {
+	case "":
+		config.FailurePolicy = FailurePolicyError
+	case FailurePolicyError, FailurePolicyWarn, FailurePolicyIgnore:
+	default:
+		return fmt.Errorf(`invalid push replication failure policy "%s"`, config.FailurePolicy)
+	}
+	return nil
+}
+
+// NewPushHooks returns the commit hooks that push the commits of the given database to each configured remote.
+// Returns no hooks if the database is not replicated. Remotes that cannot be loaded return an error when the failure
+// policy is FailurePolicyError, and are otherwise logged and skipped. Asynchronous hooks run using the given background
+// threads, so they continue to push until the threads are shut down.
+func NewPushHooks(ctx context.Context, config PushConfig, db Database, bThreads *sql.BackgroundThreads) ([]doltdb.CommitHook, error) {
+	if !utils.MatchesAnyGlob(config.Databases, db.Name) {
+		return nil, nil
+	}
+	tempDir, err := db.Data.Rsw.TempTableFilesDir()
+	if err != nil {
+		return nil, err
+	}
+	var hooks []doltdb.CommitHook
+	for _, template := range config.Remotes {
+		remoteUrl := RemoteURL(template, db.Name)
+		hook, err := newPushHook(ctx, config, db, remoteUrl, tempDir, bThreads)
+		if err != nil {
+			err = fmt.Errorf("error loading push replication remote %s for database %s: %w", remoteUrl, db.Name, err)
+			if config.FailurePolicy == FailurePolicyError {
+				return nil, err
+			}
+			logrus.Error(err.Error())
+			continue
+		}
+		hooks = append(hooks, hook)
+	}
+	return hooks, nil
+}
+
+// newPushHook returns a hook that pushes the commits of the database to the remote with the given URL.
+func newPushHook(ctx context.Context, config PushConfig, db Database, remoteUrl string, tempDir string, bThreads *sql.BackgroundThreads) (doltdb.CommitHook, error) {
+	remote := env.NewRemote("__push_replication__", remoteUrl, nil)
+	format := db.Data.Ddb.Format()
+	if strings.HasPrefix(remoteUrl, dbfactory.FileScheme+"://") {
+		// Local directories are created if they do not yet exist
+		if err := remote.Prepare(ctx, format, nil); err != nil {
+			return nil, err
+		}
+	}
+	destDB, err := remote.GetRemoteDB(ctx, format, nil)
+	if err != nil {
+		return nil, err
+	}
+	hook := &pushHook{
+		database: db.Name,
+		url:      remoteUrl,
+		policy:   config.FailurePolicy,
+	}
+	if config.Async {
+		logger := pushLogWriter{database: db.Name, url: remoteUrl}
+		if hook.CommitHook, err = doltdb.NewAsyncPushOnWriteHook(bThreads, destDB, tempDir, logger); err != nil {
+			return nil, err
+		}
+		hook.async = true
+	} else {
+		hook.CommitHook = doltdb.NewPushOnWriteHook(destDB, tempDir)
+	}
+	return hook, nil
+}
+
+// pushHook wraps one of Dolt's push hooks, so that failed pushes are handled according to the failure policy.
+type pushHook struct {
+	doltdb.CommitHook
+	database string
+	url      string
+	policy   FailurePolicy
+	async    bool
+}
+
+var _ doltdb.CommitHook = (*pushHook)(nil)
+
+// HandleError implements the interface doltdb.CommitHook. Synchronous pushes run within the session that made the
+// commit, so that session's client is warned unless failures are ignored.
+func (ph *pushHook) HandleError(ctx context.Context, err error) error {
+	logrus.WithField("database", ph.database).Errorf("failed to push to %s: %v", ph.url, err)
+	if sqlCtx, ok := ctx.(*sql.Context); ok && !ph.async && ph.policy != FailurePolicyIgnore && sqlCtx.Session != nil {
+		notices.RaiseWarning(sqlCtx, fmt.Sprintf("the commit was made, but could not be pushed to %s: %v", ph.url, err))
+	}
+	return nil
+}
+
+// SetLogger implements the interface doltdb.CommitHook. Failures are written to the server's log instead.
+func (ph *pushHook) SetLogger(ctx context.Context, wr io.Writer) error {
+	return nil
+}
+
+// pushLogWriter writes the failures of asynchronous pushes to the server's log.
+type pushLogWriter struct {
+	database string
+	url      string
+}
+
+var _ io.Writer = pushLogWriter{}
+
+// Write implements the interface io.Writer.
+func (w pushLogWriter) Write(p []byte) (int, error) {
+	logrus.WithField("database", w.database).Errorf("failed to push to %s: %s", w.url, strings.TrimSpace(string(p)))
+	return len(p), nil
+}
@@ -0,0 +1,310 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replica
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// DatabasePlaceholder is replaced in the remote URL by the name of the database that is being replicated.
+const DatabasePlaceholder = "{database}"
+
+// RemoteName is the name of the remote that replicated branches are tracked under, which matches the remote that
+// dolt_clone creates.
+const RemoteName = "origin"
+
+// DefaultInterval is the time between fetches when no interval has been configured.
+const DefaultInterval = time.Minute
+
+// Config is the configuration of a read replica.
+type Config struct {
+	// RemoteURL is the template of the remote that each database replicates from. DatabasePlaceholder is replaced by
+	// the name of the database, and when the placeholder is omitted, the name is appended as a path element.
+	RemoteURL string
+	// Databases are the names of the databases to replicate.
+	Databases []string
+	// Branches are glob patterns of the branches to replicate. All branches are replicated when empty.
+	Branches []string
+	// Interval is the time between fetches.
+	Interval time.Duration
+}
+
+// Database is a database that may be replicated.
+type Database struct {
+	Name string
+	Data env.DbData
+}
+
+// Replicator fetches branches from the remote of each replicated database, fast-forwarding the local branches to match.
+type Replicator struct {
+	config    Config
+	databases func() []Database
+	// mu ensures that a database is only replicated by a single fetch at a time
+	mu sync.Mutex
+	// replicatedAt is the time, in Unix nanoseconds, that the last replication of every database without a failure
+	// began. This is zero until the first such replication.
+	replicatedAt atomic.Int64
+}
+
+// NewReplicator returns a new *Replicator that replicates the matching databases returned by the given function.
+func NewReplicator(config Config, databases func() []Database) (*Replicator, error) {
+	if len(config.RemoteURL) == 0 {
+		return nil, fmt.Errorf("a read replica remote URL must be given")
+	}
+	if len(config.Databases) == 0 {
+		return nil, fmt.Errorf("a read replica must replicate at least one database")
+	}
+	if config.Interval < 0 {
+		return nil, fmt.Errorf("the read replica interval cannot be negative")
+	} else if config.Interval == 0 {
+		config.Interval = DefaultInterval
+	}
+	for _, pattern := range config.Branches {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf(`invalid read replica branch pattern "%s"`, pattern)
+		}
+	}
+	if _, err := url.Parse(RemoteURL(config.RemoteURL, "database")); err != nil {
+		return nil, fmt.Errorf(`invalid read replica remote URL "%s": %w`, config.RemoteURL, err)
+	}
+	return &Replicator{config: config, databases: databases}, nil
+}
+
+// RemoteURL returns the URL of the remote that the given database replicates from, given the URL's template.
+func RemoteURL(template string, database string) string {
+	if strings.Contains(template, DatabasePlaceholder) {
+		return strings.ReplaceAll(template, DatabasePlaceholder, database)
+	}
+	return strings.TrimRight(template, "/") + "/" + database
+}
+
+// Interval returns the time between fetches.
+func (r *Replicator) Interval() time.Duration {
+	return r.config.Interval
+}
+
+// LastReplicated returns the time that the last replication of every database without a failure began, as every
+// database was caught up with its remote as of that time. Returns the zero time if no such replication has finished.
+func (r *Replicator) LastReplicated() time.Time {
+	replicatedAt := r.replicatedAt.Load()
+	if replicatedAt == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, replicatedAt)
+}
+
+// Databases returns the names of the databases that are replicated, along with the URL that each replicates from.
+func (r *Replicator) Databases() map[string]string {
+	urls := make(map[string]string, len(r.config.Databases))
+	for _, database := range r.config.Databases {
+		urls[database] = RemoteURL(r.config.RemoteURL, database)
+	}
+	return urls
+}
+
+// PullAll replicates every configured database, or only the named database when a name is given. A failure does not
+// prevent the remaining databases from being replicated, and all failures are returned together.
+func (r *Replicator) PullAll(ctx context.Context, name string) error {
+	start := time.Now()
+	var errs []error
+	for _, db := range r.databases() {
+		if !r.replicates(db.Name) || (len(name) > 0 && db.Name != name) {
+			continue
+		}
+		if err := r.Pull(ctx, db); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", db.Name, err))
+		}
+	}
+	if len(name) == 0 && len(errs) == 0 {
+		r.replicatedAt.Store(start.UnixNano())
+	}
+	return errors.Join(errs...)
+}
+
+// Pull fetches the matching branches of the database's remote, then fast-forwards each local branch, and its working
+// set, to the remote's commit. Branches that are new to the remote are created. A branch that cannot be fast-forwarded
+// is skipped, as it has diverged from the remote.
+func (r *Replicator) Pull(ctx context.Context, db Database) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ddb := db.Data.Ddb
+	remote := env.NewRemote(RemoteName, RemoteURL(r.config.RemoteURL, db.Name), nil)
+	srcDB, err := remote.GetRemoteDB(ctx, ddb.Format(), nil)
+	if err != nil {
+		return fmt.Errorf("error loading remote: %w", err)
+	}
+	remoteRefs, err := srcDB.GetRefsWithHashes(ctx)
+	if err != nil {
+		return err
+	}
+	localRefs, err := ddb.GetRefsWithHashes(ctx)
+	if err != nil {
+		return err
+	}
+	localHashes := make(map[string]hash.Hash, len(localRefs))
+	for _, localRef := range localRefs {
+		if localRef.Ref.GetType() == ref.BranchRefType {
+			localHashes[localRef.Ref.GetPath()] = localRef.Hash
+		}
+	}
+	var branches []doltdb.RefWithHash
+	var hashes []hash.Hash
+	for _, remoteRef := range remoteRefs {
+		if remoteRef.Ref.GetType() != ref.BranchRefType || !utils.MatchesAnyGlob(r.config.Branches, remoteRef.Ref.GetPath()) {
+			continue
+		}
+		if localHash, ok := localHashes[remoteRef.Ref.GetPath()]; ok && localHash == remoteRef.Hash {
+			continue
+		}
+		branches = append(branches, remoteRef)
+		hashes = append(hashes, remoteRef.Hash)
+	}
+	if len(branches) == 0 {
+		return nil
+	}
+	tempDir, err := db.Data.Rsw.TempTableFilesDir()
+	if err != nil {
+		return err
+	}
+	if err = ddb.PullChunks(ctx, tempDir, srcDB, hashes, nil, nil); err != nil {
+		return fmt.Errorf("error fetching remote: %w", err)
+	}
+	for _, branch := range branches {
+		if _, ok := localHashes[branch.Ref.GetPath()]; ok {
+			err = pullBranch(ctx, ddb, branch)
+		} else {
+			err = createBranch(ctx, ddb, branch)
+		}
+		if errors.Is(err, datas.ErrMergeNeeded) {
+			logrus.WithField("database", db.Name).Warnf("branch %s has diverged from the remote and cannot be replicated", branch.Ref.GetPath())
+			continue
+		} else if err != nil {
+			return err
+		}
+		if err = ddb.SetHead(ctx, ref.NewRemoteRef(RemoteName, branch.Ref.GetPath()), branch.Hash); err != nil {
+			return err
+		}
+		logrus.WithField("database", db.Name).Debugf("Replicated branch %s at %s", branch.Ref.GetPath(), branch.Hash.String())
+	}
+	return nil
+}
+
+// Run replicates every configured database on each interval, until the context is canceled.
+func (r *Replicator) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := r.PullAll(ctx, ""); err != nil && ctx.Err() == nil {
+			logrus.Errorf("read replication failed: %v", err)
+		}
+	}
+}
+
+// ServeHTTP implements the interface http.Handler. A POST request replicates every configured database, or only the
+// database named by the "database" query parameter.
+func (r *Replicator) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	name := req.URL.Query().Get("database")
+	if len(name) > 0 && !r.replicates(name) {
+		http.Error(w, fmt.Sprintf(`database "%s" is not replicated`, name), http.StatusNotFound)
+		return
+	}
+	if err := r.PullAll(req.Context(), name); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// replicates returns whether the database with the given name is replicated.
+func (r *Replicator) replicates(name string) bool {
+	for _, database := range r.config.Databases {
+		if database == name {
+			return true
+		}
+	}
+	return false
+}
+
+// pullBranch fast-forwards the local branch to the remote branch's commit, then resets the branch's working set to
+// match.
+func pullBranch(ctx context.Context, ddb *doltdb.DoltDB, branch doltdb.RefWithHash) error {
+	if err := ddb.FastForwardToHash(ctx, branch.Ref, branch.Hash); err != nil {
+		return err
+	}
+	wsRef, err := ref.WorkingSetRefForHead(branch.Ref)
+	if err != nil {
+		return err
+	}
+	var wsHash hash.Hash
+	ws, err := ddb.ResolveWorkingSet(ctx, wsRef)
+	if err == nil {
+		if wsHash, err = ws.HashOf(); err != nil {
+			return err
+		}
+	} else if !errors.Is(err, doltdb.ErrWorkingSetNotFound) {
+		return err
+	}
+	commit, err := ddb.ResolveCommitRef(ctx, branch.Ref)
+	if err != nil {
+		return err
+	}
+	root, err := commit.GetRootValue(ctx)
+	if err != nil {
+		return err
+	}
+	newWs := doltdb.EmptyWorkingSet(wsRef).WithWorkingRoot(root).WithStagedRoot(root)
+	return ddb.UpdateWorkingSet(ctx, wsRef, newWs, wsHash, doltdb.TodoWorkingSetMeta(), nil)
+}
+
+// createBranch creates a local branch, along with its working set, at the remote branch's commit.
+func createBranch(ctx context.Context, ddb *doltdb.DoltDB, branch doltdb.RefWithHash) error {
+	optCommit, err := ddb.ReadCommit(ctx, branch.Hash)
+	if err != nil {
+		return err
+	}
+	commit, ok := optCommit.ToCommit()
+	if !ok {
+		return doltdb.ErrGhostCommitEncountered
+	}
+	return ddb.NewBranchAtCommit(ctx, branch.Ref, commit, nil)
+}
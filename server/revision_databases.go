@@ -0,0 +1,100 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"reflect"
+	"strings"
+	"unsafe"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// detachedRevisionDatabase is a tag or commit database, such as "mydb/v1". Dolt resolves a database's schemas from its
+// working set, which these databases do not have as their head is detached, so a schema-qualified table such as
+// "mydb/v1".public.tbl could not be found. This resolves the schemas from the head's root instead.
+type detachedRevisionDatabase struct {
+	sqle.ReadOnlyDatabase
+}
+
+var _ sql.SchemaDatabase = detachedRevisionDatabase{}
+
+// wrapDetachedRevision returns the database wrapped as a detachedRevisionDatabase if it is a tag or commit database.
+// All other databases are returned as-is.
+func wrapDetachedRevision(db sql.Database) sql.Database {
+	readOnlyDb, ok := db.(sqle.ReadOnlyDatabase)
+	if !ok {
+		return db
+	}
+	switch readOnlyDb.RevisionType() {
+	case dsess.RevisionTypeTag, dsess.RevisionTypeCommit:
+		return detachedRevisionDatabase{readOnlyDb}
+	default:
+		return db
+	}
+}
+
+// GetSchema implements the interface sql.SchemaDatabase.
+func (db detachedRevisionDatabase) GetSchema(ctx *sql.Context, schemaName string) (sql.DatabaseSchema, bool, error) {
+	schemas, err := db.headSchemas(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, dbSchema := range schemas {
+		if strings.EqualFold(dbSchema.Name, schemaName) {
+			return withSchemaName(db.ReadOnlyDatabase, dbSchema.Name), true, nil
+		}
+	}
+	// Dolt always reports the public schema as existing, as databases made before schemas were supported do not have it
+	if strings.EqualFold(schemaName, "public") {
+		return withSchemaName(db.ReadOnlyDatabase, "public"), true, nil
+	}
+	return nil, false, nil
+}
+
+// AllSchemas implements the interface sql.SchemaDatabase.
+func (db detachedRevisionDatabase) AllSchemas(ctx *sql.Context) ([]sql.DatabaseSchema, error) {
+	schemas, err := db.headSchemas(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dbSchemas := make([]sql.DatabaseSchema, len(schemas))
+	for i, dbSchema := range schemas {
+		dbSchemas[i] = withSchemaName(db.ReadOnlyDatabase, dbSchema.Name)
+	}
+	return dbSchemas, nil
+}
+
+// headSchemas returns the schemas within the root of the database's head, which is the session's root for detached
+// databases.
+func (db detachedRevisionDatabase) headSchemas(ctx *sql.Context) ([]schema.DatabaseSchema, error) {
+	root, err := db.GetRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return root.GetDatabaseSchemas(ctx)
+}
+
+// withSchemaName returns a copy of the database that is scoped to the given schema. Dolt only scopes its databases to a
+// schema from within its own schema resolution, which is what requires the working set, so the unexported field is set
+// here directly.
+func withSchemaName(db sqle.ReadOnlyDatabase, schemaName string) sqle.ReadOnlyDatabase {
+	field := reflect.ValueOf(&db.Database).Elem().FieldByName("schemaName")
+	reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem().SetString(schemaName)
+	return db
+}
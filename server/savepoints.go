@@ -0,0 +1,237 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/doltgresql/postgres/connection"
+	"github.com/dolthub/doltgresql/postgres/messages"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	pgconfig "github.com/dolthub/doltgresql/server/config"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/notifications"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// savepoint is a savepoint within the current transaction block. The engine restores the data when rolling back to the
+// savepoint, while the remaining fields record the session state that the connection restores.
+type savepoint struct {
+	name string
+	// settingChanges is the number of setting changes that had been recorded when the savepoint was created.
+	settingChanges int
+	// notifications is the number of notifications that had been queued when the savepoint was created.
+	notifications int
+	// portals are the names of the portals that existed when the savepoint was created.
+	portals map[string]struct{}
+	// localSettings are the settings that SET LOCAL had changed when the savepoint was created.
+	localSettings map[string]string
+}
+
+// settingChange is the value that a setting had before it was changed within a transaction block.
+type settingChange struct {
+	name  string
+	value string
+}
+
+// engineSavepointName returns the name of the engine's savepoint for the savepoint at the given depth. Postgres allows
+// a savepoint to reuse the name of an earlier savepoint, which hides the earlier savepoint until the later one is
+// released, whereas the engine replaces the earlier savepoint. Naming the engine's savepoints by their depth keeps both.
+func engineSavepointName(depth int) string {
+	return fmt.Sprintf("__doltgres_savepoint_%d", depth)
+}
+
+// handleSavepoint handles the SAVEPOINT statement.
+func (h *ConnectionHandler) handleSavepoint(query ConvertedQuery, stmt *pgnodes.Savepoint) error {
+	if !h.inTransaction {
+		return pgerrors.New(pgcode.NoActiveSQLTransaction, "SAVEPOINT can only be used in transaction blocks")
+	}
+	err := h.runTransactionStatement(query.String, &sqlparser.Savepoint{Identifier: engineSavepointName(len(h.savepoints))})
+	if err != nil {
+		return err
+	}
+	portals := make(map[string]struct{}, len(h.portals))
+	for name := range h.portals {
+		portals[name] = struct{}{}
+	}
+	h.savepoints = append(h.savepoints, savepoint{
+		name:           stmt.Name(),
+		settingChanges: len(h.settingChanges),
+		notifications:  notifications.Savepoint(h.mysqlConn.ConnectionID),
+		portals:        portals,
+		localSettings:  copyLocalSettings(h.localSettings),
+	})
+	return connection.Send(h.Conn(), messages.CommandComplete{
+		Query: query.String,
+		Tag:   query.StatementTag,
+	})
+}
+
+// handleRollbackToSavepoint handles the ROLLBACK TO SAVEPOINT statement. Along with the data, this restores the settings
+// and queued notifications to their state when the savepoint was created, and closes the cursors that were opened since
+// then. The savepoint itself remains, while every later savepoint is destroyed.
+func (h *ConnectionHandler) handleRollbackToSavepoint(query ConvertedQuery, stmt *pgnodes.RollbackToSavepoint) error {
+	if !h.inTransaction {
+		return pgerrors.New(pgcode.NoActiveSQLTransaction, "ROLLBACK TO SAVEPOINT can only be used in transaction blocks")
+	}
+	depth, err := h.findSavepoint(stmt.Name())
+	if err != nil {
+		return err
+	}
+	err = h.runTransactionStatement(query.String, &sqlparser.RollbackSavepoint{Identifier: engineSavepointName(depth)})
+	if err != nil {
+		return err
+	}
+	target := h.savepoints[depth]
+	h.savepoints = h.savepoints[:depth+1]
+	if err = h.undoSettingChanges(target.settingChanges); err != nil {
+		return err
+	}
+	h.localSettings = copyLocalSettings(target.localSettings)
+	notifications.RollbackToSavepoint(h.mysqlConn.ConnectionID, target.notifications)
+	for name := range h.portals {
+		if _, ok := target.portals[name]; !ok {
+			delete(h.portals, name)
+		}
+	}
+	return connection.Send(h.Conn(), messages.CommandComplete{
+		Query: query.String,
+		Tag:   query.StatementTag,
+	})
+}
+
+// handleReleaseSavepoint handles the RELEASE SAVEPOINT statement, which destroys the savepoint and every later
+// savepoint, while keeping all changes that were made since they were created.
+func (h *ConnectionHandler) handleReleaseSavepoint(query ConvertedQuery, stmt *pgnodes.ReleaseSavepoint) error {
+	if !h.inTransaction {
+		return pgerrors.New(pgcode.NoActiveSQLTransaction, "RELEASE SAVEPOINT can only be used in transaction blocks")
+	}
+	depth, err := h.findSavepoint(stmt.Name())
+	if err != nil {
+		return err
+	}
+	for i := len(h.savepoints) - 1; i >= depth; i-- {
+		err = h.runTransactionStatement(query.String, &sqlparser.ReleaseSavepoint{Identifier: engineSavepointName(i)})
+		if err != nil {
+			return err
+		}
+	}
+	h.savepoints = h.savepoints[:depth]
+	return connection.Send(h.Conn(), messages.CommandComplete{
+		Query: query.String,
+		Tag:   query.StatementTag,
+	})
+}
+
+// findSavepoint returns the depth of the newest savepoint with the given name.
+func (h *ConnectionHandler) findSavepoint(name string) (int, error) {
+	for i := len(h.savepoints) - 1; i >= 0; i-- {
+		if h.savepoints[i].name == name {
+			return i, nil
+		}
+	}
+	return 0, pgerrors.Newf(pgcode.InvalidSavepointSpecification, `savepoint "%s" does not exist`, name)
+}
+
+// recordSettingChanges records the current value of each setting that the statement changes, so that a rollback may
+// restore them. Changes are only recorded within a transaction block, as there is nothing to roll back otherwise.
+func (h *ConnectionHandler) recordSettingChanges(stmt sqlparser.Statement) {
+	set, ok := stmt.(*sqlparser.Set)
+	if !ok || !h.inTransaction {
+		return
+	}
+	for _, expr := range set.Exprs {
+		if expr.Scope != sqlparser.SetScope_Session || expr.Name == nil {
+			continue
+		}
+		name := expr.Name.Name.String()
+		h.recordSettingChange(name)
+		// A session-level change outlasts the transaction, even if SET LOCAL changed the setting beforehand
+		delete(h.localSettings, strings.ToLower(name))
+	}
+}
+
+// recordSettingChange records the current value of the given setting, which is about to be changed, so that a rollback
+// may restore it. Changes are only recorded within a transaction block.
+func (h *ConnectionHandler) recordSettingChange(name string) {
+	if !h.inTransaction {
+		return
+	}
+	value, err := h.settingValue(name)
+	if err != nil {
+		// The change will fail on its own if the setting does not exist
+		return
+	}
+	h.settingChanges = append(h.settingChanges, settingChange{name: name, value: value})
+}
+
+// settingValue returns the current value of the given setting in a form that SET accepts.
+func (h *ConnectionHandler) settingValue(name string) (string, error) {
+	value, err := h.showParameter(name)
+	if err != nil {
+		// Customized settings that have not been set within this session are empty
+		if pgconfig.IsCustomParameter(name) {
+			return "", nil
+		}
+		return "", err
+	}
+	// Boolean settings are displayed as numbers, which SET does not accept
+	if sysVar, _, ok := sql.SystemVariables.GetGlobal(strings.ToLower(name)); ok {
+		if _, ok = sysVar.GetType().(types.SystemBoolType); ok {
+			if isParameterOn(value) {
+				value = "on"
+			} else {
+				value = "off"
+			}
+		}
+	}
+	return value, nil
+}
+
+// undoSettingChanges restores every setting that was changed after the given number of changes had been recorded, in
+// the reverse order that they were changed.
+func (h *ConnectionHandler) undoSettingChanges(count int) error {
+	restoredTransaction := false
+	for i := len(h.settingChanges) - 1; i >= count; i-- {
+		change := h.settingChanges[i]
+		if err := h.setParameter(change.name, change.value); err != nil {
+			return err
+		}
+		restoredTransaction = restoredTransaction || isTransactionParameter(change.name)
+	}
+	h.settingChanges = h.settingChanges[:count]
+	if restoredTransaction {
+		return h.loadCurrentTransactionCharacteristics()
+	}
+	return nil
+}
+
+// endTransactionSavepoints discards the savepoints of a transaction block that has ended. Rolling back the transaction
+// also restores every setting that was changed within it.
+func (h *ConnectionHandler) endTransactionSavepoints(committed bool) {
+	if !committed {
+		if err := h.undoSettingChanges(0); err != nil {
+			logrus.WithError(err).Warn("unable to restore settings after rollback")
+		}
+	}
+	h.savepoints = nil
+	h.settingChanges = nil
+}
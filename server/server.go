@@ -16,18 +16,27 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"net"
 	_ "net/http/pprof"
+	"net/url"
+	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
 	"github.com/dolthub/dolt/go/cmd/dolt/commands/sqlserver"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
 	doltservercfg "github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dfunctions"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/resolve"
+	doltsqlserver "github.com/dolthub/dolt/go/libraries/doltcore/sqlserver"
 	"github.com/dolthub/dolt/go/libraries/utils/argparser"
 	"github.com/dolthub/dolt/go/libraries/utils/config"
 	"github.com/dolthub/dolt/go/libraries/utils/filesys"
@@ -37,8 +46,14 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/jackc/pgx/v5"
 
+	pganalyzer "github.com/dolthub/doltgresql/server/analyzer"
+	"github.com/dolthub/doltgresql/server/auth"
+	pgconfig "github.com/dolthub/doltgresql/server/config"
+	"github.com/dolthub/doltgresql/server/functions/framework"
 	"github.com/dolthub/doltgresql/server/initialization"
+	"github.com/dolthub/doltgresql/server/killswitch"
 	"github.com/dolthub/doltgresql/server/logrepl"
+	"github.com/dolthub/doltgresql/server/procedures"
 	"github.com/dolthub/doltgresql/servercfg"
 )
 
@@ -59,13 +74,27 @@ func init() {
 // RunOnDisk starts the server based on the given args, while also using the local disk as the backing store.
 // The returned WaitGroup may be used to wait for the server to close.
 func RunOnDisk(ctx context.Context, cfg *servercfg.DoltgresConfig, dEnv *env.DoltEnv) (*svcs.Controller, error) {
-	return runServer(ctx, cfg, dEnv)
+	if cfg.InMemory() {
+		return nil, fmt.Errorf("in_memory is enabled, so the server cannot be run on disk")
+	}
+	return runServer(ctx, cfg, dEnv, false, nil)
 }
 
-// RunInMemory starts the server based on the given args, while also using RAM as the backing store.
+// RunInMemory starts the server based on the given args, while also using RAM as the backing store. The data directory
+// is ignored, and if a snapshot directory has been set, then all databases are backed up to it when the server stops.
 // The returned WaitGroup may be used to wait for the server to close.
 func RunInMemory(cfg *servercfg.DoltgresConfig) (*svcs.Controller, error) {
+	return runInMemory(cfg, nil)
+}
+
+// runInMemory starts the server in memory, as described by RunInMemory. Connections are accepted from the given
+// listener when it's not nil, rather than from a listener that's bound once the server starts.
+func runInMemory(cfg *servercfg.DoltgresConfig, listener net.Listener) (*svcs.Controller, error) {
 	ctx := context.Background()
+	// Dolt reloads the environment from disk whenever a data directory is set, so we clear it from a copy of the config
+	memCfg := *cfg
+	memCfg.DataDirStr = nil
+	cfg = &memCfg
 	fs := filesys.EmptyInMemFS("")
 	dEnv := env.Load(ctx, env.GetCurrentUserHomeDir, fs, doltdb.InMemDoltDB, Version)
 	globalConfig, _ := dEnv.Config.GetConfig(env.GlobalConfig)
@@ -76,13 +105,128 @@ func RunInMemory(cfg *servercfg.DoltgresConfig) (*svcs.Controller, error) {
 		})
 	}
 
-	return runServer(ctx, cfg, dEnv)
+	return runServer(ctx, cfg, dEnv, true, listener)
+}
+
+// loadTLSConfig returns the TLS configuration for the server, or nil if TLS has not been configured. When a CA has been
+// configured, any certificate presented by a client must have been signed by that CA.
+func loadTLSConfig(cfg *servercfg.DoltgresConfig) (*tls.Config, error) {
+	tlsConfig, err := doltservercfg.LoadTLSConfig(cfg.ToSqlServerConfig())
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig == nil {
+		switch {
+		case len(cfg.TLSCA()) > 0:
+			return nil, fmt.Errorf("tls_ca is set, but tls_cert and tls_key have not been set")
+		case cfg.RequireClientCert():
+			return nil, fmt.Errorf("require_client_cert is enabled, but tls_cert and tls_key have not been set")
+		case len(cfg.TLSMinVersion()) > 0 || len(cfg.TLSMaxVersion()) > 0 || len(cfg.TLSCipherSuites()) > 0:
+			return nil, fmt.Errorf("TLS versions or cipher suites are set, but tls_cert and tls_key have not been set")
+		}
+		return nil, nil
+	}
+
+	tlsConfig.MinVersion = tls.VersionTLS12
+	if minVersion := cfg.TLSMinVersion(); len(minVersion) > 0 {
+		if tlsConfig.MinVersion, err = parseTLSVersion("tls_min_version", minVersion); err != nil {
+			return nil, err
+		}
+	}
+	if maxVersion := cfg.TLSMaxVersion(); len(maxVersion) > 0 {
+		if tlsConfig.MaxVersion, err = parseTLSVersion("tls_max_version", maxVersion); err != nil {
+			return nil, err
+		}
+		if tlsConfig.MaxVersion < tlsConfig.MinVersion {
+			return nil, fmt.Errorf("tls_max_version %s is older than the minimum TLS version", maxVersion)
+		}
+	}
+	if cipherSuites := cfg.TLSCipherSuites(); len(cipherSuites) > 0 {
+		if tlsConfig.CipherSuites, err = parseCipherSuites(cipherSuites); err != nil {
+			return nil, err
+		}
+	}
+
+	caPath := cfg.TLSCA()
+	if len(caPath) == 0 {
+		if cfg.RequireClientCert() {
+			return nil, fmt.Errorf("require_client_cert is enabled, but tls_ca has not been set")
+		}
+		return tlsConfig, nil
+	}
+	caData, err := os.ReadFile(caPath)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig.ClientCAs = x509.NewCertPool()
+	if !tlsConfig.ClientCAs.AppendCertsFromPEM(caData) {
+		return nil, fmt.Errorf("no certificates were found in the tls_ca file: %s", caPath)
+	}
+	if cfg.RequireClientCert() {
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	} else {
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return tlsConfig, nil
+}
+
+// parseTLSVersion returns the TLS version with the given name, using the same names as Postgres'
+// ssl_min_protocol_version. The setting is only used in the error message.
+func parseTLSVersion(setting string, name string) (uint16, error) {
+	switch name {
+	case "TLSv1":
+		return tls.VersionTLS10, nil
+	case "TLSv1.1":
+		return tls.VersionTLS11, nil
+	case "TLSv1.2":
+		return tls.VersionTLS12, nil
+	case "TLSv1.3":
+		return tls.VersionTLS13, nil
+	default:
+		return 0, fmt.Errorf(`invalid %s "%s", expected one of TLSv1, TLSv1.1, TLSv1.2, or TLSv1.3`, setting, name)
+	}
+}
+
+// parseCipherSuites returns the IDs of the cipher suites with the given names. Only secure cipher suites may be used,
+// and TLS 1.3 cipher suites are rejected since they cannot be configured.
+func parseCipherSuites(names []string) ([]uint16, error) {
+	suitesByName := make(map[string]*tls.CipherSuite)
+	for _, suite := range tls.CipherSuites() {
+		suitesByName[suite.Name] = suite
+	}
+	ids := make([]uint16, len(names))
+	for i, name := range names {
+		suite, ok := suitesByName[name]
+		if !ok {
+			return nil, fmt.Errorf(`unsupported cipher suite "%s" in tls_cipher_suites`, name)
+		}
+		if len(suite.SupportedVersions) == 1 && suite.SupportedVersions[0] == tls.VersionTLS13 {
+			return nil, fmt.Errorf(`cipher suite "%s" in tls_cipher_suites is only used by TLS 1.3, which cannot be configured`, name)
+		}
+		ids[i] = suite.ID
+	}
+	return ids, nil
 }
 
 // runServer starts the server based on the given args, using the provided file system as the backing store.
-// The returned WaitGroup may be used to wait for the server to close.
-func runServer(ctx context.Context, cfg *servercfg.DoltgresConfig, dEnv *env.DoltEnv) (*svcs.Controller, error) {
+// Connections are also accepted from the given listener when it's not nil. The returned WaitGroup may be used to wait
+// for the server to close.
+func runServer(ctx context.Context, cfg *servercfg.DoltgresConfig, dEnv *env.DoltEnv, inMemory bool, listener net.Listener) (*svcs.Controller, error) {
 	initialization.Initialize()
+	if err := pgconfig.SetServerVersion(cfg.ServerVersion()); err != nil {
+		return nil, err
+	}
+	pgconfig.SetMaxConnections(cfg.MaxConnections())
+	if err := pgconfig.SetTimeouts(cfg.StatementTimeout(), cfg.LockTimeout()); err != nil {
+		return nil, err
+	}
+	pgconfig.SetTransactionCommitDefaults(cfg.DoltTransactionCommit(), cfg.DoltTransactionCommitMessage())
+	if err := pgconfig.SetStatementLogging(cfg.LogStatement(), cfg.LogMinDurationStatement()); err != nil {
+		return nil, err
+	}
+	if err := pgconfig.SetParameterDefaults(cfg.Parameters()); err != nil {
+		return nil, err
+	}
 
 	if dEnv.HasDoltDataDir() {
 		cwd, _ := dEnv.FS.Abs(".")
@@ -103,13 +247,53 @@ func runServer(ctx context.Context, cfg *servercfg.DoltgresConfig, dEnv *env.Dol
 		return nil, err
 	}
 
-	tlsConfig, err := doltservercfg.LoadTLSConfig(ssCfg)
+	serverTLSConfig, err = loadTLSConfig(cfg)
 	if err != nil {
 		return nil, err
 	}
-
-	if tlsConfig != nil && len(tlsConfig.Certificates) > 0 {
-		certificate = tlsConfig.Certificates[0]
+	serverRequireTLS = cfg.RequireSecureTransport()
+	if serverRequireTLS && serverTLSConfig == nil {
+		return nil, fmt.Errorf("require_secure_transport is enabled, but tls_cert and tls_key have not been set")
+	}
+	serverAuthenticator, err = newAuthenticator(cfg)
+	if err != nil {
+		return nil, err
+	}
+	serverConnectionLimits = newConnectionLimits(cfg)
+	serverBoundListener = listener
+	killSwitchFile := cfg.KillSwitchFilePath()
+	if len(killSwitchFile) == 0 && !inMemory {
+		killSwitchFile = filepath.Join(ssCfg.CfgDir(), servercfg.DefaultKillSwitchFilePath)
+	}
+	if err = killswitch.Load(killSwitchFile); err != nil {
+		return nil, fmt.Errorf("failed to load kill switch rules: %w", err)
+	}
+	authFile := cfg.AuthFilePath()
+	if len(authFile) == 0 && !inMemory {
+		authFile = filepath.Join(ssCfg.CfgDir(), servercfg.DefaultAuthFilePath)
+	}
+	if err = auth.Load(authFile, cfg.User(), cfg.Password()); err != nil {
+		return nil, fmt.Errorf("failed to load roles: %w", err)
+	}
+	serverKafkaSinkConfig, err = newKafkaSinkConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	serverPushReplicationConfig, err = newPushReplicationConfig(cfg.PushReplication)
+	if err != nil {
+		return nil, err
+	}
+	loggingConfig, configureLogging, err := newLoggingConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	serverAuditConfig, err := newAuditConfig(cfg.Audit)
+	if err != nil {
+		return nil, err
+	}
+	healthEndpoints, healthService, err := configureHealth(cfg.Health, ssCfg)
+	if err != nil {
+		return nil, err
 	}
 
 	// We need a username and password for many SQL commands, so set defaults if they don't exist
@@ -118,7 +302,11 @@ func runServer(ctx context.Context, cfg *servercfg.DoltgresConfig, dEnv *env.Dol
 		config.UserEmailKey: DefUserEmail,
 	})
 
-	dataDirFs, err := filesys.LocalFS.WithWorkingDir(ssCfg.DataDir())
+	if len(cfg.SnapshotDir()) > 0 && !inMemory {
+		return nil, fmt.Errorf("snapshot_dir is set, but the server is not running in memory")
+	}
+
+	dataDirFs, err := dEnv.FS.WithWorkingDir(ssCfg.DataDir())
 	if err != nil {
 		return nil, err
 	}
@@ -153,7 +341,109 @@ func runServer(ctx context.Context, cfg *servercfg.DoltgresConfig, dEnv *env.Dol
 		}
 	}()
 
-	sqlserver.ConfigureServices(ssCfg, controller, Version, dEnv)
+	// Telemetry is registered before the SQL server, so that it begins recording before any connections are accepted,
+	// and writes its final report after they have all closed
+	if cfg.TelemetryDisabled() {
+		if err = disableUsageEvents(); err != nil {
+			return nil, err
+		}
+	}
+	telemetryService, err := newTelemetryService(cfg, inMemory)
+	if err != nil {
+		return nil, err
+	}
+	if telemetryService != nil {
+		if err = controller.Register(telemetryService); err != nil {
+			return nil, err
+		}
+	}
+	// The health endpoints are registered before the SQL server, so that they report on the server while it starts and
+	// stops
+	if healthService != nil {
+		if err = controller.Register(healthService); err != nil {
+			return nil, err
+		}
+	}
+	// The audit log is registered before the SQL server, so that it's open before any connections are accepted, and
+	// remains open until they have all closed
+	if serverAuditConfig != nil {
+		if err = controller.Register(newAuditService(serverAuditConfig)); err != nil {
+			return nil, err
+		}
+	}
+	doltCfg := sqlServerConfig{ServerConfig: ssCfg}
+	if listener != nil {
+		// The engine's own listener is given a reserved port, which is held until the engine has bound it
+		var releaseEnginePort func()
+		if doltCfg.enginePort, releaseEnginePort, err = reserveEnginePort(ssCfg.Host()); err != nil {
+			return nil, err
+		}
+		defer releaseEnginePort()
+	}
+	sqlserver.ConfigureServices(doltCfg, controller, Version, dEnv)
+	if serverAuditConfig != nil && serverAuditConfig.table != nil {
+		if err = controller.Register(newAuditTableService(serverAuditConfig)); err != nil {
+			return nil, err
+		}
+	}
+	if configureLogging {
+		if err = controller.Register(newLoggingService(loggingConfig)); err != nil {
+			return nil, err
+		}
+	}
+	if snapshotDir := cfg.SnapshotDir(); len(snapshotDir) > 0 {
+		snapshotService, err := newSnapshotService(ssCfg, snapshotDir)
+		if err != nil {
+			return nil, err
+		}
+		if err = controller.Register(snapshotService); err != nil {
+			return nil, err
+		}
+	}
+	if err = controller.Register(newKafkaSinkService()); err != nil {
+		return nil, err
+	}
+	if cfg.SnapshotExport != nil {
+		snapshotExportService, err := newSnapshotExportService(cfg.SnapshotExport)
+		if err != nil {
+			return nil, err
+		}
+		if err = controller.Register(snapshotExportService); err != nil {
+			return nil, err
+		}
+	}
+	backupService, err := configureBackups(cfg.Backup)
+	if err != nil {
+		return nil, err
+	}
+	if backupService != nil {
+		if err = controller.Register(backupService); err != nil {
+			return nil, err
+		}
+	}
+	remotesapiService, err := configureRemotesapi(cfg, dataDirFs)
+	if err != nil {
+		return nil, err
+	}
+	if remotesapiService != nil {
+		if err = controller.Register(remotesapiService); err != nil {
+			return nil, err
+		}
+	}
+	replicator, replicaService, err := configureReadReplica(cfg.ReadReplica)
+	if err != nil {
+		return nil, err
+	}
+	if replicaService != nil {
+		if err = controller.Register(replicaService); err != nil {
+			return nil, err
+		}
+	}
+	if healthEndpoints != nil && replicator != nil {
+		if err = healthEndpoints.checkReadReplica(replicator); err != nil {
+			return nil, err
+		}
+	}
 	go controller.Start(newCtx)
 
 	err = controller.WaitForStart()
@@ -161,6 +451,20 @@ func runServer(ctx context.Context, cfg *servercfg.DoltgresConfig, dEnv *env.Dol
 		return nil, err
 	}
 
+	if err = startPushReplication(); err != nil {
+		return nil, err
+	}
+	if serverAuditConfig != nil && serverAuditConfig.table != nil {
+		if err = startAuditTable(newCtx, serverAuditConfig, ssCfg); err != nil {
+			return nil, err
+		}
+	}
+
+	// A replicated database is cloned from its remote, so the default database is only created when not replicated
+	if createDoltgresDatabase && replicator != nil {
+		_, replicated := replicator.Databases()["doltgres"]
+		createDoltgresDatabase = !replicated
+	}
 	if createDoltgresDatabase {
 		err = createDatabase(ssCfg, "doltgres")
 		if err != nil {
@@ -168,15 +472,108 @@ func runServer(ctx context.Context, cfg *servercfg.DoltgresConfig, dEnv *env.Dol
 		}
 	}
 
+	if replicator != nil {
+		if err = startReadReplica(newCtx, replicator, ssCfg); err != nil {
+			return nil, err
+		}
+	}
+
 	// TODO: shutdown replication cleanly when we stop the server
-	_, err = startReplication(cfg, ssCfg)
+	postgresReplicator, err := startReplication(cfg, ssCfg)
 	if err != nil {
 		return nil, err
 	}
 
+	if healthEndpoints != nil {
+		if postgresReplicator != nil {
+			healthEndpoints.checkPostgresReplication(postgresReplicator)
+		}
+		healthEndpoints.started.Store(true)
+	}
 	return controller, nil
 }
 
+// registerTableFunctions adds every function to the running server's database provider as a table function, so that
+// functions may be called from the FROM clause. User-defined functions are called through a single table function.
+// The engine is created within Dolt, so this must wait until the server is running, and it must be called before any
+// connections are accepted.
+func registerTableFunctions() error {
+	runningServer := doltsqlserver.GetRunningServer()
+	provider := runningProvider()
+	if provider == nil {
+		return nil
+	}
+	tableFunctions := append(framework.TableFunctions(),
+		pganalyzer.NewUserFunctionTable(runningServer.Engine.Analyzer),
+		pganalyzer.NewQueryDiffTable(runningServer.Engine.Analyzer))
+	functionProvider := &tableFunctionProvider{
+		DoltDatabaseProvider: provider,
+		tableFunctions:       make(map[string]sql.TableFunction, len(tableFunctions)),
+	}
+	for _, tableFunction := range tableFunctions {
+		functionProvider.tableFunctions[strings.ToLower(tableFunction.Name())] = tableFunction
+	}
+	runningServer.Engine.Analyzer.Catalog.DbProvider = functionProvider
+	return nil
+}
+
+// tableFunctionProvider adds our table functions to Dolt's database provider, and resolves the schemas of tag and commit
+// databases. The provider is referenced throughout Dolt, so it's embedded rather than copied, which leaves every
+// reference sharing the same provider.
+type tableFunctionProvider struct {
+	*sqle.DoltDatabaseProvider
+	tableFunctions map[string]sql.TableFunction
+}
+
+var _ sql.TableFunctionProvider = (*tableFunctionProvider)(nil)
+
+// TableFunction implements the interface sql.TableFunctionProvider.
+func (p *tableFunctionProvider) TableFunction(ctx *sql.Context, name string) (sql.TableFunction, error) {
+	tableFunction, err := p.DoltDatabaseProvider.TableFunction(ctx, name)
+	if err == nil || !sql.ErrTableFunctionNotFound.Is(err) {
+		return tableFunction, err
+	}
+	if tableFunction, ok := p.tableFunctions[strings.ToLower(name)]; ok {
+		return tableFunction, nil
+	}
+	return nil, err
+}
+
+// Database implements the interface sql.DatabaseProvider.
+func (p *tableFunctionProvider) Database(ctx *sql.Context, name string) (sql.Database, error) {
+	db, err := p.DoltDatabaseProvider.Database(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return wrapDetachedRevision(db), nil
+}
+
+// runningProvider returns the database provider of the running server, or nil if the server is not running.
+func runningProvider() *sqle.DoltDatabaseProvider {
+	runningServer := doltsqlserver.GetRunningServer()
+	if runningServer == nil || runningServer.Engine == nil {
+		return nil
+	}
+	switch provider := runningServer.Engine.Analyzer.Catalog.DbProvider.(type) {
+	case *sqle.DoltDatabaseProvider:
+		return provider
+	case *tableFunctionProvider:
+		return provider.DoltDatabaseProvider
+	default:
+		return nil
+	}
+}
+
+// registerStatementRunner gives the procedures that execute their own statements, such as those that run commit
+// validations, the ability to do so using the running server's analyzer.
+func registerStatementRunner() {
+	runningServer := doltsqlserver.GetRunningServer()
+	if runningServer == nil || runningServer.Engine == nil {
+		return
+	}
+	procedures.SetStatementRunner(pganalyzer.NewStatementRunner(runningServer.Engine.Analyzer))
+}
+
 // createDatabase creates the database named on the local server using the configuration values to connect, returning
 // any error
 func createDatabase(cfg doltservercfg.ServerConfig, dbName string) error {
@@ -214,28 +611,60 @@ func startReplication(cfg *servercfg.DoltgresConfig, ssCfg doltservercfg.ServerC
 	} else if cfg.PostgresReplicationConfig.SlotName == nil || *cfg.PostgresReplicationConfig.SlotName == "" {
 		return nil, fmt.Errorf("postgres replication slot name must be specified and not empty for replication")
 	}
+	replicationCfg := cfg.PostgresReplicationConfig
+	doltCommit := replicationCfg.DoltCommit != nil && *replicationCfg.DoltCommit
+	if replicationCfg.DoltCommitMessage != nil && len(*replicationCfg.DoltCommitMessage) > 0 && !doltCommit {
+		return nil, fmt.Errorf("postgres replication dolt_commit_message is set, but dolt_commit has not been enabled")
+	}
+	primaryAddress := "127.0.0.1"
+	if replicationCfg.PostgresServerAddress != nil && len(*replicationCfg.PostgresServerAddress) > 0 {
+		primaryAddress = *replicationCfg.PostgresServerAddress
+	}
+	replicaDatabase := "doltgres"
+	if replicationCfg.Database != nil && len(*replicationCfg.Database) > 0 {
+		replicaDatabase = *replicationCfg.Database
+	}
 
 	walFilePath := filepath.Join(ssCfg.CfgDir(), "pg_wal_location")
 	primaryDns := fmt.Sprintf(
-		"postgres://%s:%s@127.0.0.1:%d/%s",
-		*cfg.PostgresReplicationConfig.PostgresUser,
-		*cfg.PostgresReplicationConfig.PostgresPassword,
-		*cfg.PostgresReplicationConfig.PostgresPort,
-		*cfg.PostgresReplicationConfig.PostgresDatabase,
+		"postgres://%s:%s@%s/%s",
+		*replicationCfg.PostgresUser,
+		*replicationCfg.PostgresPassword,
+		net.JoinHostPort(primaryAddress, strconv.Itoa(*replicationCfg.PostgresPort)),
+		*replicationCfg.PostgresDatabase,
 	)
+	if sslParams := replicationSSLParams(replicationCfg); len(sslParams) > 0 {
+		primaryDns += "?" + sslParams.Encode()
+	}
 
 	replicationDns := fmt.Sprintf(
 		"postgres://%s:%s@localhost:%d/%s",
 		ssCfg.User(),
 		ssCfg.Password(),
 		ssCfg.Port(),
-		"doltgres", // TODO: this needs to come from config
+		replicaDatabase,
 	)
 
-	replicator, err := logrepl.NewLogicalReplicator(walFilePath, primaryDns, replicationDns)
+	var options []logrepl.ReplicatorOption
+	if len(replicationCfg.PublicationNames) > 0 {
+		options = append(options, logrepl.WithPublications(replicationCfg.PublicationNames...))
+	}
+	if doltCommit {
+		commitMessage := ""
+		if replicationCfg.DoltCommitMessage != nil {
+			commitMessage = *replicationCfg.DoltCommitMessage
+		}
+		options = append(options, logrepl.WithDoltCommits(commitMessage))
+	}
+	replicator, err := logrepl.NewLogicalReplicator(walFilePath, primaryDns, replicationDns, options...)
 	if err != nil {
 		return nil, err
 	}
+	if replicationCfg.CreateSlot != nil && *replicationCfg.CreateSlot {
+		if err = replicator.CreateReplicationSlotIfNecessary(*replicationCfg.SlotName); err != nil {
+			return nil, fmt.Errorf("unable to create the postgres replication slot: %w", err)
+		}
+	}
 
 	cli.Println("Starting replication")
 	go replicator.StartReplication(*cfg.PostgresReplicationConfig.SlotName)
@@ -260,3 +689,22 @@ func (c configCliContext) QueryEngine(ctx context.Context) (cli.Queryist, *sql.C
 }
 
 var _ cli.CliContext = configCliContext{}
+
+// replicationSSLParams returns the connection parameters that configure TLS for the connection to the primary. A client
+// certificate and key are presented to the primary when both are given, which allows for mutual TLS.
+func replicationSSLParams(cfg *servercfg.PostgresReplicationConfig) url.Values {
+	params := url.Values{}
+	if cfg.SSLMode != nil && len(*cfg.SSLMode) > 0 {
+		params.Set("sslmode", *cfg.SSLMode)
+	}
+	if cfg.SSLCert != nil && len(*cfg.SSLCert) > 0 {
+		params.Set("sslcert", *cfg.SSLCert)
+	}
+	if cfg.SSLKey != nil && len(*cfg.SSLKey) > 0 {
+		params.Set("sslkey", *cfg.SSLKey)
+	}
+	if cfg.SSLRootCert != nil && len(*cfg.SSLRootCert) > 0 {
+		params.Set("sslrootcert", *cfg.SSLRootCert)
+	}
+	return params
+}
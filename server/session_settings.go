@@ -0,0 +1,146 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/doltgresql/postgres/connection"
+	"github.com/dolthub/doltgresql/postgres/messages"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+)
+
+// handleSetLocal handles the SET LOCAL statement, which changes a setting until the end of the current transaction
+// block. Once the transaction commits, the setting is restored to its value from before the first SET LOCAL, while a
+// rollback restores it along with every other setting that was changed within the transaction.
+func (h *ConnectionHandler) handleSetLocal(query ConvertedQuery, stmt *pgnodes.SetLocal) error {
+	if !h.inTransaction {
+		// Each statement outside of a transaction block runs in its own transaction, so this has no effect
+		if err := h.sendWarning(pgcode.NoActiveSQLTransaction, "SET LOCAL can only be used in transaction blocks"); err != nil {
+			return err
+		}
+		return connection.Send(h.Conn(), messages.CommandComplete{
+			Query: query.String,
+			Tag:   query.StatementTag,
+		})
+	}
+	name := strings.ToLower(stmt.Name())
+	if _, ok := h.localSettings[name]; !ok {
+		value, err := h.settingValue(name)
+		if err != nil {
+			return err
+		}
+		if h.localSettings == nil {
+			h.localSettings = make(map[string]string)
+		}
+		h.localSettings[name] = value
+	}
+	h.recordSettingChange(name)
+	if err := h.runTransactionStatement(query.String, stmt.Set()); err != nil {
+		return err
+	}
+	h.parametersChanged = true
+	return connection.Send(h.Conn(), messages.CommandComplete{
+		Query: query.String,
+		Tag:   query.StatementTag,
+	})
+}
+
+// handleResetAll handles the RESET ALL statement. Within a transaction block, the prior value of every setting that it
+// changes is recorded so that a rollback may restore them.
+func (h *ConnectionHandler) handleResetAll(query ConvertedQuery) error {
+	var before []settingChange
+	if h.inTransaction {
+		var err error
+		if before, err = h.showAllParameters(); err != nil {
+			return err
+		}
+	}
+	if err := h.runTransactionStatement(query.String, query.AST); err != nil {
+		return err
+	}
+	if h.inTransaction {
+		after, err := h.showAllParameters()
+		if err != nil {
+			return err
+		}
+		for i, change := range before {
+			if i < len(after) && after[i] != change {
+				h.settingChanges = append(h.settingChanges, change)
+			}
+		}
+	}
+	// Resetting is a session-level change, so it outlasts the transaction
+	h.localSettings = nil
+	h.parametersChanged = true
+	return connection.Send(h.Conn(), messages.CommandComplete{
+		Query: query.String,
+		Tag:   query.StatementTag,
+	})
+}
+
+// showAllParameters returns the name and current value of every setting, as displayed by SHOW ALL.
+func (h *ConnectionHandler) showAllParameters() ([]settingChange, error) {
+	query, err := h.convertQuery("SHOW ALL")
+	if err != nil {
+		return nil, err
+	}
+	var settings []settingChange
+	err = h.comQuery(query, func(res *sqltypes.Result, more bool) error {
+		for _, row := range res.Rows {
+			settings = append(settings, settingChange{name: row[0].ToString(), value: row[1].ToString()})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// endLocalSettings restores the settings that SET LOCAL changed once a transaction block commits. A rollback has
+// already restored every setting that was changed within the transaction.
+func (h *ConnectionHandler) endLocalSettings(committed bool) {
+	if committed {
+		names := make([]string, 0, len(h.localSettings))
+		for name := range h.localSettings {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if err := h.setParameter(name, h.localSettings[name]); err != nil {
+				logrus.WithError(err).Warnf("unable to restore setting %s after commit", name)
+			}
+		}
+	}
+	h.localSettings = nil
+}
+
+// copyLocalSettings returns a copy of the given settings that were changed by SET LOCAL.
+func copyLocalSettings(localSettings map[string]string) map[string]string {
+	if len(localSettings) == 0 {
+		return nil
+	}
+	copied := make(map[string]string, len(localSettings))
+	for name, value := range localSettings {
+		copied[name] = value
+	}
+	return copied
+}
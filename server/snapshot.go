@@ -0,0 +1,89 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	doltservercfg "github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+	"github.com/dolthub/dolt/go/libraries/utils/svcs"
+	"github.com/jackc/pgx/v5"
+	"github.com/sirupsen/logrus"
+)
+
+// newSnapshotService returns a service that backs up every database to the given directory when the server stops.
+// Services are stopped in the reverse order that they were registered, so this must be registered after the services
+// of the SQL server, as the backups are taken through the still-running server.
+func newSnapshotService(cfg doltservercfg.ServerConfig, snapshotDir string) (*svcs.AnonService, error) {
+	absDir, err := filepath.Abs(snapshotDir)
+	if err != nil {
+		return nil, err
+	}
+	return &svcs.AnonService{
+		InitF: func(context.Context) error {
+			if err := os.MkdirAll(absDir, 0755); err != nil {
+				return fmt.Errorf("failed to make snapshot dir '%s': %w", absDir, err)
+			}
+			return nil
+		},
+		StopF: func() error {
+			return snapshotDatabases(cfg, absDir)
+		},
+	}, nil
+}
+
+// snapshotDatabases backs up each database to a directory of the same name within the snapshot directory. A failure
+// does not prevent the remaining databases from being backed up.
+func snapshotDatabases(cfg doltservercfg.ServerConfig, snapshotDir string) error {
+	provider := runningProvider()
+	if provider == nil {
+		return fmt.Errorf("unable to take a snapshot as the server is not running")
+	}
+	var errs []error
+	for _, db := range provider.DoltDatabases() {
+		dbDir := filepath.Join(snapshotDir, db.Name())
+		if err := snapshotDatabase(cfg, db.Name(), dbDir); err != nil {
+			errs = append(errs, fmt.Errorf("failed to snapshot database %s: %w", db.Name(), err))
+			continue
+		}
+		logrus.WithField("database", db.Name()).Infof("Saved snapshot to %s", dbDir)
+	}
+	return errors.Join(errs...)
+}
+
+// snapshotDatabase writes a backup of the named database to the given directory.
+func snapshotDatabase(cfg doltservercfg.ServerConfig, dbName string, dbDir string) error {
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, fmt.Sprintf(
+		"postgres://%s:%s@localhost:%d/%s",
+		cfg.User(),
+		cfg.Password(),
+		cfg.Port(),
+		dbName,
+	))
+	if err != nil {
+		return err
+	}
+	defer conn.Close(ctx)
+
+	backupUrl := "file://" + filepath.ToSlash(dbDir)
+	_, err = conn.Exec(ctx, fmt.Sprintf("CALL dolt_backup('sync-url', '%s');", strings.ReplaceAll(backupUrl, "'", "''")))
+	return err
+}
@@ -0,0 +1,95 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dolthub/dolt/go/libraries/utils/svcs"
+
+	"github.com/dolthub/doltgresql/server/snapshotexport"
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+// defaultSnapshotExportInterval is the time between snapshot exports when an interval has not been configured.
+const defaultSnapshotExportInterval = time.Hour
+
+// newSnapshotExportService returns a service that periodically exports a snapshot of each branch, as configured. This
+// must be registered after the services of the SQL server, so that exports have stopped before the databases close.
+func newSnapshotExportService(cfg *servercfg.DoltgresSnapshotExportConfig) (*svcs.AnonService, error) {
+	config := snapshotexport.Config{
+		Interval:  defaultSnapshotExportInterval,
+		Databases: cfg.Databases,
+		Branches:  cfg.Branches,
+	}
+	if cfg.Destination != nil {
+		config.Destination = *cfg.Destination
+	}
+	if cfg.Format != nil {
+		config.Format = snapshotexport.Format(*cfg.Format)
+	}
+	if cfg.Interval != nil {
+		interval, err := time.ParseDuration(*cfg.Interval)
+		if err != nil || interval <= 0 {
+			return nil, fmt.Errorf(`invalid snapshot_export interval "%s"`, *cfg.Interval)
+		}
+		config.Interval = interval
+	}
+	if cfg.Region != nil {
+		config.Region = *cfg.Region
+	}
+	if cfg.Endpoint != nil {
+		config.Endpoint = *cfg.Endpoint
+	}
+	exporter, err := snapshotexport.NewExporter(config)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	started, stopped := make(chan struct{}), make(chan struct{})
+	return &svcs.AnonService{
+		RunF: func(context.Context) {
+			close(started)
+			defer close(stopped)
+			exporter.Run(ctx, snapshotExportDatabases)
+		},
+		StopF: func() error {
+			cancel()
+			// The service may be stopped without having run, such as when another service fails to start
+			select {
+			case <-started:
+				<-stopped
+			default:
+			}
+			return nil
+		},
+	}, nil
+}
+
+// snapshotExportDatabases returns every database of the running server.
+func snapshotExportDatabases() []snapshotexport.Database {
+	provider := runningProvider()
+	if provider == nil {
+		return nil
+	}
+	var databases []snapshotexport.Database
+	for _, db := range provider.DoltDatabases() {
+		databases = append(databases, snapshotexport.Database{Name: db.Name(), DDB: db.DbData().Ddb})
+	}
+	return databases
+}
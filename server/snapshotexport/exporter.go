@@ -0,0 +1,286 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshotexport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/doltgresql/utils"
+)
+
+// Format is the file format that tables are exported as.
+type Format string
+
+const (
+	// FormatCSV exports each table as a CSV file with a header row. NULL values are written as empty fields.
+	FormatCSV Format = "csv"
+	// FormatParquet exports each table as a Parquet file.
+	FormatParquet Format = "parquet"
+)
+
+// ManifestFile is the name of the manifest within each snapshot. The manifest is written after every table, so its
+// presence signals that the snapshot is complete.
+const ManifestFile = "manifest.json"
+
+// Config is the configuration of an Exporter.
+type Config struct {
+	// Destination is the template of the location that each snapshot is written to, such as
+	// "s3://bucket/exports/{database}/{branch}/{commit}". Destinations without a scheme, or with the "file" scheme, are
+	// directories on the local file system. The placeholders {database}, {branch}, {commit}, and {timestamp} are
+	// replaced, and may only be used within the path.
+	Destination string
+	// Format is the format of the exported tables, which defaults to FormatCSV.
+	Format Format
+	// Interval is the time between exports.
+	Interval time.Duration
+	// Databases are glob patterns of the databases to export. All databases are exported when empty.
+	Databases []string
+	// Branches are glob patterns of the branches to export. All branches are exported when empty.
+	Branches []string
+	// Region is the region of the S3 bucket. The region is otherwise taken from the environment.
+	Region string
+	// Endpoint overrides the S3 endpoint, which allows for S3-compatible object stores.
+	Endpoint string
+}
+
+// Database is a database that may be exported.
+type Database struct {
+	Name string
+	DDB  *doltdb.DoltDB
+}
+
+// Manifest describes a snapshot of a single branch.
+type Manifest struct {
+	Database   string          `json:"database"`
+	Branch     string          `json:"branch"`
+	Commit     string          `json:"commit"`
+	ExportedAt time.Time       `json:"exported_at"`
+	Format     Format          `json:"format"`
+	Tables     []ManifestTable `json:"tables"`
+}
+
+// ManifestTable describes an exported table.
+type ManifestTable struct {
+	Schema  string           `json:"schema"`
+	Name    string           `json:"name"`
+	File    string           `json:"file"`
+	Rows    int64            `json:"rows"`
+	Columns []ManifestColumn `json:"columns"`
+}
+
+// ManifestColumn describes a column of an exported table.
+type ManifestColumn struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// Exporter writes snapshots of each branch to an object store. A branch is only exported when its head has moved since
+// its last export.
+type Exporter struct {
+	config   Config
+	store    objectStore
+	template string
+	mutex    sync.Mutex
+	exported map[string]hash.Hash
+}
+
+// NewExporter returns a new Exporter for the given configuration.
+func NewExporter(config Config) (*Exporter, error) {
+	if len(config.Format) == 0 {
+		config.Format = FormatCSV
+	}
+	if config.Format != FormatCSV && config.Format != FormatParquet {
+		return nil, fmt.Errorf(`invalid snapshot export format "%s", expected "%s" or "%s"`, config.Format, FormatCSV, FormatParquet)
+	}
+	for _, pattern := range append(append([]string(nil), config.Databases...), config.Branches...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf(`invalid snapshot export pattern "%s"`, pattern)
+		}
+	}
+	store, template, err := newObjectStore(config)
+	if err != nil {
+		return nil, err
+	}
+	return &Exporter{
+		config:   config,
+		store:    store,
+		template: template,
+		exported: make(map[string]hash.Hash),
+	}, nil
+}
+
+// Run exports the databases returned by the given function on every interval, until the context is canceled. An export
+// also runs immediately.
+func (e *Exporter) Run(ctx context.Context, databases func() []Database) {
+	ticker := time.NewTicker(e.config.Interval)
+	defer ticker.Stop()
+	for {
+		if _, err := e.Export(ctx, databases()); err != nil && ctx.Err() == nil {
+			logrus.Errorf("snapshot export failed: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Export writes a snapshot of every matching branch whose head has moved since its last export, returning the
+// manifests of the snapshots that were written. A failure does not prevent the remaining branches from being exported.
+func (e *Exporter) Export(ctx context.Context, databases []Database) ([]Manifest, error) {
+	// Exports are serialized so that a slow export cannot overlap with the next
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	var manifests []Manifest
+	var errs []error
+	for _, db := range databases {
+		if !utils.MatchesAnyGlob(e.config.Databases, db.Name) {
+			continue
+		}
+		branches, err := db.DDB.GetBranches(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to read the branches of database %s: %w", db.Name, err))
+			continue
+		}
+		sort.Slice(branches, func(i, j int) bool {
+			return branches[i].GetPath() < branches[j].GetPath()
+		})
+		for _, branch := range branches {
+			if !utils.MatchesAnyGlob(e.config.Branches, branch.GetPath()) {
+				continue
+			}
+			commit, err := db.DDB.ResolveCommitRef(ctx, branch)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to read branch %s of database %s: %w", branch.GetPath(), db.Name, err))
+				continue
+			}
+			head, err := commit.HashOf()
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			exportedKey := db.Name + "/" + branch.GetPath()
+			if e.exported[exportedKey] == head {
+				continue
+			}
+			manifest, err := e.exportCommit(ctx, db.Name, branch.GetPath(), head, commit)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to export branch %s of database %s: %w", branch.GetPath(), db.Name, err))
+				continue
+			}
+			e.exported[exportedKey] = head
+			manifests = append(manifests, manifest)
+			logrus.WithField("database", db.Name).WithField("branch", branch.GetPath()).
+				Infof("Exported snapshot of commit %s", head.String())
+		}
+	}
+	return manifests, errors.Join(errs...)
+}
+
+// exportCommit writes every table of the commit, followed by the manifest. All tables are read from the commit's root,
+// so the snapshot is consistent even as the branch moves.
+func (e *Exporter) exportCommit(ctx context.Context, database string, branch string, head hash.Hash, commit *doltdb.Commit) (Manifest, error) {
+	exportedAt := time.Now().UTC()
+	prefix := strings.TrimRight(strings.NewReplacer(
+		"{database}", database,
+		"{branch}", branch,
+		"{commit}", head.String(),
+		"{timestamp}", exportedAt.Format("20060102T150405Z"),
+	).Replace(e.template), "/")
+	root, err := commit.GetRootValue(ctx)
+	if err != nil {
+		return Manifest{}, err
+	}
+	manifest := Manifest{
+		Database:   database,
+		Branch:     branch,
+		Commit:     head.String(),
+		ExportedAt: exportedAt,
+		Format:     e.config.Format,
+		Tables:     []ManifestTable{},
+	}
+	tableNames, err := userTableNames(ctx, root)
+	if err != nil {
+		return Manifest{}, err
+	}
+	for _, tableName := range tableNames {
+		table, ok, err := root.GetTable(ctx, tableName)
+		if err != nil {
+			return Manifest{}, err
+		}
+		if !ok {
+			continue
+		}
+		file := fmt.Sprintf("%s.%s.%s", tableName.Schema, tableName.Name, e.config.Format)
+		manifestTable, err := e.exportTable(ctx, table, path.Join(prefix, file))
+		if err != nil {
+			return Manifest{}, fmt.Errorf("table %s: %w", tableName.String(), err)
+		}
+		manifestTable.Schema = tableName.Schema
+		manifestTable.Name = tableName.Name
+		manifestTable.File = file
+		manifest.Tables = append(manifest.Tables, manifestTable)
+	}
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return Manifest{}, err
+	}
+	if err = e.store.put(ctx, path.Join(prefix, ManifestFile), strings.NewReader(string(manifestData))); err != nil {
+		return Manifest{}, err
+	}
+	return manifest, nil
+}
+
+// userTableNames returns the names of every table in the root, excluding Dolt's system tables, in order by schema and
+// name.
+func userTableNames(ctx context.Context, root doltdb.RootValue) ([]doltdb.TableName, error) {
+	schemas, err := root.GetDatabaseSchemas(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var tableNames []doltdb.TableName
+	for _, dbSchema := range schemas {
+		names, err := root.GetTableNames(ctx, dbSchema.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			if doltdb.HasDoltPrefix(name) {
+				continue
+			}
+			tableNames = append(tableNames, doltdb.TableName{Name: name, Schema: dbSchema.Name})
+		}
+	}
+	sort.Slice(tableNames, func(i, j int) bool {
+		if tableNames[i].Schema != tableNames[j].Schema {
+			return tableNames[i].Schema < tableNames[j].Schema
+		}
+		return tableNames[i].Name < tableNames[j].Name
+	})
+	return tableNames, nil
+}
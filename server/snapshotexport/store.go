@@ -0,0 +1,116 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshotexport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+// objectStore writes the files of a snapshot.
+type objectStore interface {
+	// put writes the contents of the reader to the given key.
+	put(ctx context.Context, key string, r io.Reader) error
+}
+
+// newObjectStore returns the store for the configured destination, along with the template of the keys within the
+// store.
+func newObjectStore(config Config) (objectStore, string, error) {
+	if len(config.Destination) == 0 {
+		return nil, "", fmt.Errorf("a snapshot export destination must be given")
+	}
+	if !strings.Contains(config.Destination, "://") {
+		return fileStore{}, config.Destination, nil
+	}
+	destination, err := url.Parse(config.Destination)
+	if err != nil {
+		return nil, "", fmt.Errorf(`invalid snapshot export destination "%s": %w`, config.Destination, err)
+	}
+	switch destination.Scheme {
+	case "file":
+		return fileStore{}, destination.Path, nil
+	case "s3":
+		if len(destination.Host) == 0 || strings.Contains(destination.Host, "{") {
+			return nil, "", fmt.Errorf(`the snapshot export destination "%s" must name a bucket`, config.Destination)
+		}
+		awsConfig := aws.Config{}
+		if len(config.Region) > 0 {
+			awsConfig.Region = aws.String(config.Region)
+		}
+		if len(config.Endpoint) > 0 {
+			awsConfig.Endpoint = aws.String(config.Endpoint)
+			awsConfig.S3ForcePathStyle = aws.Bool(true)
+		}
+		sess, err := session.NewSessionWithOptions(session.Options{
+			Config:            awsConfig,
+			SharedConfigState: session.SharedConfigEnable,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return s3Store{bucket: destination.Host, uploader: s3manager.NewUploader(sess)}, destination.Path, nil
+	default:
+		return nil, "", fmt.Errorf(`unsupported snapshot export destination scheme "%s"`, destination.Scheme)
+	}
+}
+
+// fileStore writes files to the local file system.
+type fileStore struct{}
+
+var _ objectStore = fileStore{}
+
+// put implements the interface objectStore.
+func (fileStore) put(ctx context.Context, key string, r io.Reader) error {
+	filePath := filepath.FromSlash(key)
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return err
+	}
+	file, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(file, r); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// s3Store writes files to an S3 bucket.
+type s3Store struct {
+	bucket   string
+	uploader *s3manager.Uploader
+}
+
+var _ objectStore = s3Store{}
+
+// put implements the interface objectStore.
+func (store s3Store) put(ctx context.Context, key string, r io.Reader) error {
+	_, err := store.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(strings.TrimPrefix(key, "/")),
+		Body:   r,
+	})
+	return err
+}
@@ -0,0 +1,275 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshotexport
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/xitongsys/parquet-go/writer"
+
+	"github.com/dolthub/doltgresql/core"
+	pgtypes "github.com/dolthub/doltgresql/server/types"
+)
+
+// column is a column of an exported table.
+type column struct {
+	name string
+	typ  sql.Type
+}
+
+// rowWriter writes the rows of a table to a file.
+type rowWriter interface {
+	// writeRow writes a single row, whose values match the table's columns.
+	writeRow(row []any) error
+	// close finishes the file.
+	close() error
+}
+
+// exportTable writes every row of the table to the given key, returning the description of the exported table.
+func (e *Exporter) exportTable(ctx context.Context, table *doltdb.Table, key string) (ManifestTable, error) {
+	sch, err := table.GetSchema(ctx)
+	if err != nil {
+		return ManifestTable{}, err
+	}
+	decoder := core.NewRowDecoder(sch, table.NodeStore())
+	columns := tableColumns(decoder)
+	manifestTable := ManifestTable{Columns: make([]ManifestColumn, len(columns))}
+	for i, col := range columns {
+		manifestTable.Columns[i] = ManifestColumn{Name: col.name, Type: col.typ.String()}
+	}
+
+	// The rows are streamed to the store, so that large tables do not need to fit within memory
+	pr, pw := io.Pipe()
+	rowCount := make(chan int64, 1)
+	go func() {
+		count, err := e.writeRows(ctx, table, decoder, columns, pw)
+		rowCount <- count
+		_ = pw.CloseWithError(err)
+	}()
+	err = e.store.put(ctx, key, pr)
+	// Closing the reader unblocks the writer when the store stopped reading early
+	_ = pr.CloseWithError(io.ErrClosedPipe)
+	manifestTable.Rows = <-rowCount
+	if err != nil {
+		return ManifestTable{}, err
+	}
+	return manifestTable, nil
+}
+
+// writeRows writes every row of the table in the exporter's format, returning the number of rows written.
+func (e *Exporter) writeRows(ctx context.Context, table *doltdb.Table, decoder *core.RowDecoder, columns []column, w io.Writer) (int64, error) {
+	var rw rowWriter
+	var err error
+	if e.config.Format == FormatParquet {
+		rw, err = newParquetWriter(columns, w)
+	} else {
+		rw, err = newCSVWriter(columns, w)
+	}
+	if err != nil {
+		return 0, err
+	}
+	idx, err := table.GetRowData(ctx)
+	if err != nil {
+		return 0, err
+	}
+	iter, err := durable.ProllyMapFromIndex(idx).IterAll(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	for {
+		key, value, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return count, err
+		}
+		row, err := decoder.DecodeRow(ctx, key, value)
+		if err != nil {
+			return count, err
+		}
+		// Keyless tables store identical rows once, along with the number of times that the row appears
+		for cardinality := decoder.Cardinality(value); cardinality > 0; cardinality-- {
+			if err = rw.writeRow(row); err != nil {
+				return count, err
+			}
+			count++
+		}
+	}
+	return count, rw.close()
+}
+
+// tableColumns returns the columns that the decoder reads, in the order that they were declared.
+func tableColumns(decoder *core.RowDecoder) []column {
+	columns := make([]column, len(decoder.Columns()))
+	for i, col := range decoder.Columns() {
+		columns[i] = column{name: col.Name, typ: col.TypeInfo.ToSqlType()}
+	}
+	return columns
+}
+
+// textValue returns the Postgres text form of the value.
+func textValue(typ sql.Type, value any) (string, error) {
+	if dgType, ok := typ.(pgtypes.DoltgresType); ok {
+		return dgType.IoOutput(value)
+	}
+	return fmt.Sprint(value), nil
+}
+
+// csvWriter writes rows as CSV, using the text form of each value.
+type csvWriter struct {
+	columns []column
+	writer  *csv.Writer
+	record  []string
+}
+
+var _ rowWriter = (*csvWriter)(nil)
+
+// newCSVWriter returns a rowWriter that writes CSV, beginning with a header of the column names.
+func newCSVWriter(columns []column, w io.Writer) (*csvWriter, error) {
+	cw := &csvWriter{
+		columns: columns,
+		writer:  csv.NewWriter(w),
+		record:  make([]string, len(columns)),
+	}
+	for i, col := range columns {
+		cw.record[i] = col.name
+	}
+	return cw, cw.writer.Write(cw.record)
+}
+
+// writeRow implements the interface rowWriter.
+func (cw *csvWriter) writeRow(row []any) error {
+	for i, value := range row {
+		if value == nil {
+			cw.record[i] = ""
+			continue
+		}
+		text, err := textValue(cw.columns[i].typ, value)
+		if err != nil {
+			return err
+		}
+		cw.record[i] = text
+	}
+	return cw.writer.Write(cw.record)
+}
+
+// close implements the interface rowWriter.
+func (cw *csvWriter) close() error {
+	cw.writer.Flush()
+	return cw.writer.Error()
+}
+
+// parquetWriter writes rows as Parquet. Booleans, integers, and floating-point numbers keep their types, while all
+// other values are written as strings using their text form.
+type parquetWriter struct {
+	columns []column
+	kinds   []string
+	writer  *writer.CSVWriter
+}
+
+var _ rowWriter = (*parquetWriter)(nil)
+
+// newParquetWriter returns a rowWriter that writes Parquet.
+func newParquetWriter(columns []column, w io.Writer) (*parquetWriter, error) {
+	pw := &parquetWriter{columns: columns, kinds: make([]string, len(columns))}
+	metadata := make([]string, len(columns))
+	for i, col := range columns {
+		pw.kinds[i] = parquetKind(col.typ)
+		metadata[i] = fmt.Sprintf("name=%s, type=%s, repetitiontype=OPTIONAL", parquetName(col.name), pw.kinds[i])
+		if pw.kinds[i] == "BYTE_ARRAY" {
+			metadata[i] += ", convertedtype=UTF8"
+		}
+	}
+	var err error
+	pw.writer, err = writer.NewCSVWriterFromWriter(metadata, w, 1)
+	if err != nil {
+		return nil, err
+	}
+	return pw, nil
+}
+
+// writeRow implements the interface rowWriter.
+func (pw *parquetWriter) writeRow(row []any) error {
+	// The writer buffers each record until the row group is flushed, so every row needs its own record
+	record := make([]any, len(row))
+	for i, value := range row {
+		if value == nil {
+			continue
+		}
+		switch pw.kinds[i] {
+		case "BYTE_ARRAY":
+			text, err := textValue(pw.columns[i].typ, value)
+			if err != nil {
+				return err
+			}
+			record[i] = text
+		case "INT32":
+			if v, ok := value.(int16); ok {
+				value = int32(v)
+			}
+			record[i] = value
+		default:
+			record[i] = value
+		}
+	}
+	return pw.writer.Write(record)
+}
+
+// close implements the interface rowWriter.
+func (pw *parquetWriter) close() error {
+	return pw.writer.WriteStop()
+}
+
+// parquetKind returns the Parquet physical type that values of the given type are written as.
+func parquetKind(typ sql.Type) string {
+	dgType, ok := typ.(pgtypes.DoltgresType)
+	if !ok {
+		return "BYTE_ARRAY"
+	}
+	switch dgType.BaseID() {
+	case pgtypes.DoltgresTypeBaseID_Bool:
+		return "BOOLEAN"
+	case pgtypes.DoltgresTypeBaseID_Int16, pgtypes.DoltgresTypeBaseID_Int16Serial,
+		pgtypes.DoltgresTypeBaseID_Int32, pgtypes.DoltgresTypeBaseID_Int32Serial:
+		return "INT32"
+	case pgtypes.DoltgresTypeBaseID_Int64, pgtypes.DoltgresTypeBaseID_Int64Serial:
+		return "INT64"
+	case pgtypes.DoltgresTypeBaseID_Float32:
+		return "FLOAT"
+	case pgtypes.DoltgresTypeBaseID_Float64:
+		return "DOUBLE"
+	default:
+		return "BYTE_ARRAY"
+	}
+}
+
+// parquetName returns the column name with every character that cannot appear within Parquet's schema metadata
+// replaced by an underscore.
+func parquetName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+}
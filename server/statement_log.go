@@ -0,0 +1,136 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/dolthub/dolt/go/libraries/utils/svcs"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/doltgresql/server/logging"
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+// newLoggingConfig validates the logging section of the config. Returns false if the log's format and destination have
+// not been configured, in which case the logger is left as the engine configured it.
+func newLoggingConfig(cfg *servercfg.DoltgresConfig) (logging.Config, bool, error) {
+	if cfg.Logging == nil || (cfg.Logging.Format == nil && cfg.Logging.File == nil) {
+		return logging.Config{}, false, nil
+	}
+	loggingConfig := logging.Config{
+		Format:     cfg.LogFormat(),
+		File:       cfg.LogFile(),
+		MaxSize:    int64(cfg.LogMaxSizeMB()) * 1024 * 1024,
+		MaxBackups: cfg.LogMaxBackups(),
+		MaxAge:     time.Duration(cfg.LogMaxAgeDays()) * 24 * time.Hour,
+	}
+	if err := loggingConfig.Validate(); err != nil {
+		return logging.Config{}, false, err
+	}
+	return loggingConfig, true, nil
+}
+
+// newLoggingService returns a service that sets the format and destination of the standard logger once the server
+// starts, and restores them once the server stops. This must be registered after the engine's services, as the engine
+// points the standard logger at the CLI's error output.
+func newLoggingService(loggingConfig logging.Config) *svcs.AnonService {
+	var restore func() error
+	return &svcs.AnonService{
+		InitF: func(context.Context) (err error) {
+			restore, err = logging.Apply(logrus.StandardLogger(), loggingConfig)
+			return err
+		},
+		StopF: func() error {
+			if restore == nil {
+				return nil
+			}
+			return restore()
+		},
+	}
+}
+
+// logger returns the standard logger with fields that identify this connection.
+func (h *ConnectionHandler) logger() *logrus.Entry {
+	fields := logrus.Fields{
+		"connection_id": h.mysqlConn.ConnectionID,
+	}
+	if len(h.mysqlConn.User) > 0 {
+		fields["user"] = h.mysqlConn.User
+	}
+	if remoteAddr := h.Conn().RemoteAddr(); remoteAddr != nil {
+		fields["remote_addr"] = remoteAddr.String()
+	}
+	return logrus.WithFields(fields)
+}
+
+// loadStatementLogging reads the log_statement and log_min_duration_statement parameters. This is called on startup and
+// whenever a statement may have changed the parameters.
+func (h *ConnectionHandler) loadStatementLogging() {
+	h.logStatement = logging.StatementClassNone
+	h.logMinDuration = -1
+	if value, err := h.showParameter("log_statement"); err != nil {
+		logrus.WithError(err).Warn("unable to read parameter log_statement")
+	} else if class, ok := logging.ParseStatementClass(value); ok {
+		h.logStatement = class
+	}
+	value, err := h.showParameter("log_min_duration_statement")
+	if err != nil {
+		logrus.WithError(err).Warn("unable to read parameter log_min_duration_statement")
+		return
+	}
+	milliseconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		logrus.WithError(err).Warn("unable to read parameter log_min_duration_statement")
+		return
+	}
+	if milliseconds >= 0 {
+		h.logMinDuration = time.Duration(milliseconds) * time.Millisecond
+	}
+}
+
+// startStatementLog logs the query if its class is covered by the session's log_statement parameter. The returned
+// function must be called with the query's error once the query has finished, which logs the query's duration if it
+// reached the session's log_min_duration_statement. The |label| describes how the query was run, such as "statement"
+// for a simple query, or "execute <portal>" for a portal.
+func (h *ConnectionHandler) startStatementLog(query ConvertedQuery, label string) func(error) {
+	logged := h.logStatement != logging.StatementClassNone && query.LogClass <= h.logStatement
+	if logged {
+		h.logger().WithField("statement_class", query.LogClass.String()).Infof("%s: %s", label, query.String)
+	}
+	if h.logMinDuration < 0 {
+		return func(error) {}
+	}
+	start := time.Now()
+	return func(err error) {
+		duration := time.Since(start)
+		if duration < h.logMinDuration {
+			return
+		}
+		entry := h.logger().WithField("duration_ms", float64(duration.Microseconds())/1000)
+		if err != nil {
+			entry = entry.WithError(err)
+		}
+		// Matching Postgres, the query is omitted if it has already been logged
+		message := fmt.Sprintf("duration: %.3f ms", float64(duration.Microseconds())/1000)
+		if !logged {
+			message = fmt.Sprintf("%s  %s: %s", message, label, query.String)
+		}
+		entry.Info(message)
+	}
+}
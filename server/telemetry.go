@@ -0,0 +1,95 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dolthub/dolt/go/libraries/events"
+	"github.com/dolthub/dolt/go/libraries/utils/svcs"
+
+	"github.com/dolthub/doltgresql/server/telemetry"
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+// defaultTelemetryInterval is the time between telemetry reports when an interval has not been configured.
+const defaultTelemetryInterval = 24 * time.Hour
+
+// disableUsageEvents stops Dolt's heartbeat service from sending usage events. The heartbeat reads its emitter from the
+// environment, so this must be called before the services are configured.
+func disableUsageEvents() error {
+	return os.Setenv(events.EmitterTypeEnvVar, events.EmitterTypeNull)
+}
+
+// newTelemetryService returns a service that records usage and periodically writes reports, or nil if detailed
+// telemetry has not been enabled.
+func newTelemetryService(cfg *servercfg.DoltgresConfig, inMemory bool) (*svcs.AnonService, error) {
+	if !cfg.TelemetryEnabled() {
+		return nil, nil
+	}
+	config := telemetry.Config{
+		Version:  Version,
+		Interval: defaultTelemetryInterval,
+	}
+	if cfg.Telemetry.File != nil {
+		config.File = *cfg.Telemetry.File
+	}
+	if cfg.Telemetry.Endpoint != nil {
+		config.Endpoint = *cfg.Telemetry.Endpoint
+	}
+	if cfg.Telemetry.Interval != nil {
+		interval, err := time.ParseDuration(*cfg.Telemetry.Interval)
+		if err != nil || interval <= 0 {
+			return nil, fmt.Errorf(`invalid telemetry interval "%s"`, *cfg.Telemetry.Interval)
+		}
+		config.Interval = interval
+	}
+	if len(config.File) == 0 && len(config.Endpoint) == 0 {
+		return nil, fmt.Errorf("telemetry is enabled, but neither a file nor an endpoint has been set")
+	}
+
+	var reporter *telemetry.Reporter
+	return &svcs.AnonService{
+		InitF: func(context.Context) (err error) {
+			reporter, err = telemetry.Start(config)
+			if err != nil {
+				return err
+			}
+			// Features that are enabled through the config are recorded once, as they apply for the server's lifetime
+			if inMemory {
+				telemetry.UseFeature(telemetry.FeatureInMemory)
+			}
+			if cfg.PostgresReplicationConfig != nil {
+				telemetry.UseFeature(telemetry.FeatureReplication)
+			}
+			if cfg.KafkaSink != nil {
+				telemetry.UseFeature(telemetry.FeatureKafkaSink)
+			}
+			if cfg.SnapshotExport != nil {
+				telemetry.UseFeature(telemetry.FeatureSnapshotExport)
+			}
+			return nil
+		},
+		StopF: func() error {
+			if reporter == nil {
+				return nil
+			}
+			return reporter.Stop()
+		},
+	}, nil
+}
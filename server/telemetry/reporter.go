@@ -0,0 +1,137 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Config is the configuration of a Reporter.
+type Config struct {
+	// Version is the version of the server, which is included in each report.
+	Version string
+	// Interval is the time between reports.
+	Interval time.Duration
+	// File is a file that each report is appended to as a line of JSON.
+	File string
+	// Endpoint is an HTTP URL that each report is sent to as a JSON POST request.
+	Endpoint string
+}
+
+// Reporter periodically writes usage reports to the configured sinks. A final report is written when it stops.
+type Reporter struct {
+	config  Config
+	client  *http.Client
+	cancel  context.CancelFunc
+	stopped chan struct{}
+}
+
+// Start begins recording usage, and returns a Reporter that writes reports on every interval. At least one sink must be
+// configured.
+func Start(config Config) (*Reporter, error) {
+	if len(config.File) == 0 && len(config.Endpoint) == 0 {
+		return nil, fmt.Errorf("telemetry requires a file or an endpoint to send reports to")
+	}
+	if config.Interval <= 0 {
+		return nil, fmt.Errorf("the telemetry interval must be positive")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	reporter := &Reporter{
+		config:  config,
+		client:  &http.Client{Timeout: 10 * time.Second},
+		cancel:  cancel,
+		stopped: make(chan struct{}),
+	}
+	enable(config.Version)
+	go reporter.run(ctx)
+	return reporter, nil
+}
+
+// Stop writes a final report, and then stops recording usage.
+func (reporter *Reporter) Stop() error {
+	reporter.cancel()
+	<-reporter.stopped
+	err := reporter.write(Snapshot())
+	disable()
+	return err
+}
+
+// run writes a report on every interval until the context is canceled.
+func (reporter *Reporter) run(ctx context.Context) {
+	defer close(reporter.stopped)
+	ticker := time.NewTicker(reporter.config.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := reporter.write(Snapshot()); err != nil {
+				logrus.Warnf("failed to write telemetry report: %v", err)
+			}
+		}
+	}
+}
+
+// write sends the report to every configured sink.
+func (reporter *Reporter) write(report Report) error {
+	data, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	if len(reporter.config.File) > 0 {
+		errs = append(errs, appendLine(reporter.config.File, data))
+	}
+	if len(reporter.config.Endpoint) > 0 {
+		errs = append(errs, reporter.post(data))
+	}
+	return errors.Join(errs...)
+}
+
+// appendLine appends the data to the file, followed by a newline. The file is created if it does not exist.
+func appendLine(file string, data []byte) error {
+	f, err := os.OpenFile(file, os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(append(data, '\n')); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// post sends the data to the endpoint.
+func (reporter *Reporter) post(data []byte) error {
+	response, err := reporter.client.Post(reporter.config.Endpoint, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	_ = response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("telemetry endpoint responded with status %s", response.Status)
+	}
+	return nil
+}
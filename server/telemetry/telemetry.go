@@ -0,0 +1,164 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Feature is a server feature whose usage is counted.
+type Feature string
+
+const (
+	FeatureCopyFrom       Feature = "copy_from"
+	FeatureCopyTo         Feature = "copy_to"
+	FeatureExtendedQuery  Feature = "extended_query"
+	FeatureListen         Feature = "listen"
+	FeatureTLS            Feature = "tls"
+	FeatureReplication    Feature = "replication"
+	FeatureKafkaSink      Feature = "kafka_sink"
+	FeatureSnapshotExport Feature = "snapshot_export"
+	FeatureInMemory       Feature = "in_memory"
+)
+
+// Report is a summary of the server's usage since it started. Reports never contain queries, data, or names of any
+// kind, only counts.
+type Report struct {
+	InstanceID    string            `json:"instance_id"`
+	Version       string            `json:"version"`
+	Timestamp     time.Time         `json:"timestamp"`
+	UptimeSeconds int64             `json:"uptime_seconds"`
+	Connections   ConnectionCounts  `json:"connections"`
+	Features      map[Feature]int64 `json:"features"`
+	ErrorClasses  map[string]int64  `json:"error_classes"`
+}
+
+// ConnectionCounts are the counts of client connections.
+type ConnectionCounts struct {
+	// Current is the number of connections that are open.
+	Current int64 `json:"current"`
+	// Peak is the largest number of connections that have been open at once.
+	Peak int64 `json:"peak"`
+	// Total is the number of connections that have been opened.
+	Total int64 `json:"total"`
+}
+
+// collector holds the counts of the running server. Recording is skipped entirely while telemetry is disabled, which is
+// the default.
+var collector = struct {
+	enabled atomic.Bool
+	sync.Mutex
+	instanceID   string
+	version      string
+	startedAt    time.Time
+	connections  ConnectionCounts
+	features     map[Feature]int64
+	errorClasses map[string]int64
+}{}
+
+// enable resets every count and begins recording.
+func enable(version string) {
+	collector.Lock()
+	defer collector.Unlock()
+	var id [8]byte
+	_, _ = rand.Read(id[:])
+	collector.instanceID = hex.EncodeToString(id[:])
+	collector.version = version
+	collector.startedAt = time.Now().UTC()
+	collector.connections = ConnectionCounts{}
+	collector.features = make(map[Feature]int64)
+	collector.errorClasses = make(map[string]int64)
+	collector.enabled.Store(true)
+}
+
+// disable stops recording.
+func disable() {
+	collector.enabled.Store(false)
+}
+
+// Enabled returns whether usage is being recorded.
+func Enabled() bool {
+	return collector.enabled.Load()
+}
+
+// ConnectionOpened records that a client connection has been established. The returned function must be called once
+// the connection has closed.
+func ConnectionOpened() func() {
+	if !collector.enabled.Load() {
+		return func() {}
+	}
+	collector.Lock()
+	collector.connections.Current++
+	collector.connections.Total++
+	if collector.connections.Current > collector.connections.Peak {
+		collector.connections.Peak = collector.connections.Current
+	}
+	collector.Unlock()
+	return func() {
+		collector.Lock()
+		defer collector.Unlock()
+		if collector.connections.Current > 0 {
+			collector.connections.Current--
+		}
+	}
+}
+
+// UseFeature records a single use of the given feature.
+func UseFeature(feature Feature) {
+	if !collector.enabled.Load() {
+		return
+	}
+	collector.Lock()
+	defer collector.Unlock()
+	collector.features[feature]++
+}
+
+// Error records an error that was sent to a client. Errors are counted by the class of their SQLSTATE code, which is its
+// first two characters.
+func Error(sqlState string) {
+	if !collector.enabled.Load() || len(sqlState) < 2 {
+		return
+	}
+	collector.Lock()
+	defer collector.Unlock()
+	collector.errorClasses[sqlState[:2]]++
+}
+
+// Snapshot returns a report of the counts that have been recorded so far.
+func Snapshot() Report {
+	collector.Lock()
+	defer collector.Unlock()
+	now := time.Now().UTC()
+	report := Report{
+		InstanceID:    collector.instanceID,
+		Version:       collector.version,
+		Timestamp:     now,
+		UptimeSeconds: int64(now.Sub(collector.startedAt).Seconds()),
+		Connections:   collector.connections,
+		Features:      make(map[Feature]int64, len(collector.features)),
+		ErrorClasses:  make(map[string]int64, len(collector.errorClasses)),
+	}
+	for feature, count := range collector.features {
+		report.Features[feature] = count
+	}
+	for class, count := range collector.errorClasses {
+		report.ErrorClasses[class] = count
+	}
+	return report
+}
@@ -0,0 +1,116 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/doltgresql/postgres/connection"
+	"github.com/dolthub/doltgresql/postgres/messages"
+	"github.com/dolthub/doltgresql/server/locks"
+)
+
+// loadTimeouts reads the idle and statement timeouts from the session's configuration parameters. This is called on
+// startup and whenever a statement may have changed the parameters.
+func (h *ConnectionHandler) loadTimeouts() {
+	h.statementTimeout = h.getTimeoutParameter("statement_timeout")
+	h.idleSessionTimeout = h.getTimeoutParameter("idle_session_timeout")
+	h.idleInTransactionSessionTimeout = h.getTimeoutParameter("idle_in_transaction_session_timeout")
+}
+
+// getTimeoutParameter returns the value of the given parameter, which is in milliseconds. Returns zero, which disables
+// the timeout, if the parameter could not be read.
+func (h *ConnectionHandler) getTimeoutParameter(name string) time.Duration {
+	value, err := h.showParameter(name)
+	if err != nil {
+		logrus.WithError(err).Warnf("unable to read parameter %s", name)
+		return 0
+	}
+	milliseconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		logrus.WithError(err).Warnf("unable to read parameter %s", name)
+		return 0
+	}
+	return time.Duration(milliseconds) * time.Millisecond
+}
+
+// startIdleTimer starts the timer that terminates the connection if the client does not send a message before the
+// session's idle timeout expires. Sessions within a transaction use the idle-in-transaction timeout instead.
+func (h *ConnectionHandler) startIdleTimer() {
+	timeout := h.idleSessionTimeout
+	sqlStateCode := "57P05"
+	message := "terminating connection due to idle-session timeout"
+	if h.inTransaction {
+		timeout = h.idleInTransactionSessionTimeout
+		sqlStateCode = "25P03"
+		message = "terminating connection due to idle-in-transaction timeout"
+	}
+	if timeout <= 0 {
+		return
+	}
+	h.idleTimer = time.AfterFunc(timeout, func() {
+		// Notifications may be sent while the connection is idle, so we hold their lock to avoid interleaved messages
+		h.notificationMutex.Lock()
+		defer h.notificationMutex.Unlock()
+		_ = connection.Send(h.Conn(), messages.ErrorResponse{
+			Severity:     messages.ErrorResponseSeverity_Fatal,
+			SqlStateCode: sqlStateCode,
+			Message:      message,
+			Optional: messages.ErrorResponseOptionalFields{
+				Routine: "ProcessInterrupts",
+			},
+		})
+		// Expiring the read deadline ends the pending receive, which ends the session
+		_ = h.Conn().SetReadDeadline(time.Now())
+	})
+}
+
+// startStatementTimer starts the timer that cancels the running statement once the session's statement timeout expires.
+// The returned function must be called once the statement has finished.
+func (h *ConnectionHandler) startStatementTimer() func() {
+	if h.statementTimeout <= 0 {
+		return func() {}
+	}
+	timer := time.AfterFunc(h.statementTimeout, func() {
+		h.cancelQuery("canceling statement due to statement timeout")
+	})
+	return func() {
+		timer.Stop()
+	}
+}
+
+// isLockTimeout returns whether the error was caused by a lock wait exceeding the session's lock timeout. The engine
+// converts errors into MySQL errors before they reach the handler, which only preserves the message.
+func isLockTimeout(err error) bool {
+	if sqlErr, ok := err.(*mysql.SQLError); ok {
+		return sqlErr.Message == locks.ErrLockTimeout.Message
+	}
+	return locks.ErrLockTimeout.Is(err)
+}
+
+// stopIdleTimer stops the idle timer. Returns false if the timer has already expired, in which case the connection has
+// been terminated.
+func (h *ConnectionHandler) stopIdleTimer() bool {
+	if h.idleTimer == nil {
+		return true
+	}
+	stopped := h.idleTimer.Stop()
+	h.idleTimer = nil
+	return stopped
+}
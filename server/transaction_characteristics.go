@@ -0,0 +1,305 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"strings"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/doltgresql/postgres/connection"
+	"github.com/dolthub/doltgresql/postgres/messages"
+	"github.com/dolthub/doltgresql/postgres/parser/pgcode"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+	pgnodes "github.com/dolthub/doltgresql/server/node"
+	"github.com/dolthub/doltgresql/server/notices"
+	"github.com/dolthub/doltgresql/server/pgerrors"
+)
+
+// transactionCharacteristics are the isolation level and read-only status of a transaction. The characteristics of the
+// current transaction mirror the transaction_isolation and transaction_read_only parameters, while the characteristics
+// that new transactions start with mirror default_transaction_isolation and default_transaction_read_only.
+//
+// Dolt transactions always read from the snapshot that was taken when the transaction started, so every isolation level
+// behaves as REPEATABLE READ. The isolation level is still tracked so that it is reported as Postgres would.
+type transactionCharacteristics struct {
+	isolation string
+	readOnly  bool
+}
+
+// readOnlyStatementPrefixes are the statement tags, matched by their first word, of the statements that may not run
+// within a read-only transaction.
+var readOnlyStatementPrefixes = map[string]struct{}{
+	"ALTER":    {},
+	"COMMENT":  {},
+	"COPY":     {},
+	"CREATE":   {},
+	"DELETE":   {},
+	"DROP":     {},
+	"GRANT":    {},
+	"INSERT":   {},
+	"MERGE":    {},
+	"REFRESH":  {},
+	"REVOKE":   {},
+	"TRUNCATE": {},
+	"UPDATE":   {},
+}
+
+// isTransactionParameter returns whether the parameter holds a characteristic of the current transaction.
+func isTransactionParameter(name string) bool {
+	switch strings.ToLower(name) {
+	case "transaction_isolation", "transaction_read_only":
+		return true
+	default:
+		return false
+	}
+}
+
+// handleBeginTransaction handles the BEGIN and START TRANSACTION statements. Characteristics that are not given are
+// taken from the session's defaults.
+func (h *ConnectionHandler) handleBeginTransaction(query ConvertedQuery, stmt *pgnodes.BeginTransaction) error {
+	if h.inTransaction {
+		// Matching Postgres, this does not commit the current transaction
+		if err := h.sendWarning(pgcode.ActiveSQLTransaction, "there is already a transaction in progress"); err != nil {
+			return err
+		}
+		return connection.Send(h.Conn(), messages.CommandComplete{
+			Query: query.String,
+			Tag:   query.StatementTag,
+		})
+	}
+	characteristics := h.defaultTransaction
+	modes := stmt.Modes()
+	if len(modes.Isolation) > 0 {
+		characteristics.isolation = modes.Isolation
+	}
+	if len(modes.ReadOnly) > 0 {
+		characteristics.readOnly = isParameterOn(modes.ReadOnly)
+	}
+	if err := h.runTransactionStatement(query.String, &sqlparser.Begin{}); err != nil {
+		return err
+	}
+	if err := h.setTransactionCharacteristics(characteristics); err != nil {
+		return err
+	}
+	h.updateTransactionStatus(&sqlparser.Begin{})
+	return connection.Send(h.Conn(), messages.CommandComplete{
+		Query: query.String,
+		Tag:   query.StatementTag,
+	})
+}
+
+// handleSetTransaction handles the SET TRANSACTION and SET SESSION CHARACTERISTICS statements.
+func (h *ConnectionHandler) handleSetTransaction(query ConvertedQuery, stmt *pgnodes.SetTransaction) error {
+	modes := stmt.Modes()
+	if stmt.Session() {
+		if err := h.setSessionCharacteristics(modes); err != nil {
+			return err
+		}
+		return connection.Send(h.Conn(), messages.CommandComplete{
+			Query: query.String,
+			Tag:   query.StatementTag,
+		})
+	}
+	if !h.inTransaction {
+		// Each statement outside of a transaction block runs in its own transaction, so this has no effect
+		if err := h.sendWarning(pgcode.NoActiveSQLTransaction, "SET TRANSACTION can only be used in transaction blocks"); err != nil {
+			return err
+		}
+		return connection.Send(h.Conn(), messages.CommandComplete{
+			Query: query.String,
+			Tag:   query.StatementTag,
+		})
+	}
+	characteristics := h.transaction
+	if len(modes.Isolation) > 0 {
+		if h.transactionQueried {
+			return pgerrors.New(pgcode.ActiveSQLTransaction, "SET TRANSACTION ISOLATION LEVEL must be called before any query")
+		}
+		if len(h.savepoints) > 0 {
+			return pgerrors.New(pgcode.ActiveSQLTransaction, "SET TRANSACTION ISOLATION LEVEL must not be called in a subtransaction")
+		}
+		characteristics.isolation = modes.Isolation
+	}
+	if len(modes.ReadOnly) > 0 {
+		characteristics.readOnly = isParameterOn(modes.ReadOnly)
+		if !characteristics.readOnly && h.transaction.readOnly && h.transactionQueried {
+			return pgerrors.New(pgcode.ActiveSQLTransaction, "transaction read-write mode must be set before any query")
+		}
+	}
+	if err := h.setTransactionCharacteristics(characteristics); err != nil {
+		return err
+	}
+	return connection.Send(h.Conn(), messages.CommandComplete{
+		Query: query.String,
+		Tag:   query.StatementTag,
+	})
+}
+
+// setSessionCharacteristics sets the characteristics that each new transaction starts with.
+func (h *ConnectionHandler) setSessionCharacteristics(modes pgnodes.TransactionModes) error {
+	if len(modes.Isolation) > 0 {
+		h.recordSettingChange("default_transaction_isolation")
+		if err := h.setParameter("default_transaction_isolation", modes.Isolation); err != nil {
+			return err
+		}
+		h.defaultTransaction.isolation = modes.Isolation
+	}
+	if len(modes.ReadOnly) > 0 {
+		h.recordSettingChange("default_transaction_read_only")
+		if err := h.setParameter("default_transaction_read_only", modes.ReadOnly); err != nil {
+			return err
+		}
+		h.defaultTransaction.readOnly = isParameterOn(modes.ReadOnly)
+	}
+	return nil
+}
+
+// setTransactionCharacteristics changes the characteristics of the current transaction. Within a transaction block, the
+// prior characteristics are recorded so that a rollback may restore them.
+func (h *ConnectionHandler) setTransactionCharacteristics(characteristics transactionCharacteristics) error {
+	if characteristics.isolation != h.transaction.isolation {
+		h.recordSettingChange("transaction_isolation")
+		if err := h.setParameter("transaction_isolation", characteristics.isolation); err != nil {
+			return err
+		}
+		h.transaction.isolation = characteristics.isolation
+	}
+	if characteristics.readOnly != h.transaction.readOnly {
+		readOnly := "off"
+		if characteristics.readOnly {
+			readOnly = "on"
+		}
+		h.recordSettingChange("transaction_read_only")
+		if err := h.setParameter("transaction_read_only", readOnly); err != nil {
+			return err
+		}
+		h.transaction.readOnly = characteristics.readOnly
+	}
+	return nil
+}
+
+// loadTransactionCharacteristics reads the characteristics of new transactions and of the current transaction from the
+// session's configuration parameters. Outside of a transaction block, each statement runs in a new transaction, so the
+// current characteristics are reset to the defaults. This is called on startup and whenever a statement may have
+// changed the parameters.
+func (h *ConnectionHandler) loadTransactionCharacteristics() {
+	defaultTransaction, err := h.readTransactionCharacteristics("default_transaction_isolation", "default_transaction_read_only")
+	if err != nil {
+		logrus.WithError(err).Warn("unable to read the default transaction characteristics")
+		return
+	}
+	h.defaultTransaction = defaultTransaction
+	if err = h.loadCurrentTransactionCharacteristics(); err != nil {
+		logrus.WithError(err).Warn("unable to read the transaction characteristics")
+		return
+	}
+	if !h.inTransaction {
+		h.resetTransactionCharacteristics()
+	}
+}
+
+// loadCurrentTransactionCharacteristics reads the characteristics of the current transaction from the session's
+// configuration parameters.
+func (h *ConnectionHandler) loadCurrentTransactionCharacteristics() error {
+	transaction, err := h.readTransactionCharacteristics("transaction_isolation", "transaction_read_only")
+	if err != nil {
+		return err
+	}
+	h.transaction = transaction
+	return nil
+}
+
+// readTransactionCharacteristics returns the characteristics that are held by the given configuration parameters.
+func (h *ConnectionHandler) readTransactionCharacteristics(isolationName string, readOnlyName string) (transactionCharacteristics, error) {
+	isolation, err := h.showParameter(isolationName)
+	if err != nil {
+		return transactionCharacteristics{}, err
+	}
+	readOnly, err := h.showParameter(readOnlyName)
+	if err != nil {
+		return transactionCharacteristics{}, err
+	}
+	return transactionCharacteristics{
+		isolation: isolation,
+		readOnly:  isParameterOn(readOnly),
+	}, nil
+}
+
+// resetTransactionCharacteristics resets the characteristics of the current transaction to the session's defaults,
+// which is done whenever a transaction block ends.
+func (h *ConnectionHandler) resetTransactionCharacteristics() {
+	if err := h.setTransactionCharacteristics(h.defaultTransaction); err != nil {
+		logrus.WithError(err).Warn("unable to reset the transaction characteristics")
+	}
+}
+
+// markTransactionQueried records that the query has run within the current transaction block. SET and SHOW do not
+// count as queries, as they do not read from the transaction's snapshot.
+func (h *ConnectionHandler) markTransactionQueried(query ConvertedQuery) {
+	if !h.inTransaction || query.StatementTag == "SHOW" {
+		return
+	}
+	switch query.AST.(type) {
+	case *sqlparser.Set, *sqlparser.Begin, *sqlparser.Commit, *sqlparser.Rollback:
+		return
+	}
+	h.transactionQueried = true
+}
+
+// checkTransactionBlock returns an error if the query may not run inside a transaction block, and the session is within
+// one.
+func (h *ConnectionHandler) checkTransactionBlock(query ConvertedQuery) error {
+	if !h.inTransaction || len(query.NoTransactionBlock) == 0 {
+		return nil
+	}
+	return pgerrors.Newf(pgcode.ActiveSQLTransaction, "%s cannot run inside a transaction block", query.NoTransactionBlock)
+}
+
+// noTransactionBlock returns the name of the statement if it may not run inside a transaction block, and an empty
+// string otherwise. Statements that are handled by nodes check this during execution instead.
+func noTransactionBlock(stmt tree.Statement) string {
+	if createIndex, ok := stmt.(*tree.CreateIndex); ok && createIndex.Concurrently {
+		return "CREATE INDEX CONCURRENTLY"
+	}
+	return ""
+}
+
+// checkReadOnlyTransaction returns an error if the current transaction is read-only and the query may write.
+func (h *ConnectionHandler) checkReadOnlyTransaction(query ConvertedQuery) error {
+	if !h.transaction.readOnly {
+		return nil
+	}
+	if injectedStmt, ok := query.AST.(sqlparser.InjectedStatement); ok {
+		if _, ok = injectedStmt.Statement.(*pgnodes.CopyTo); ok {
+			return nil
+		}
+	}
+	command, _, _ := strings.Cut(query.StatementTag, " ")
+	if _, ok := readOnlyStatementPrefixes[command]; !ok {
+		return nil
+	}
+	return pgerrors.Newf(pgcode.ReadOnlySQLTransaction, "cannot execute %s in a read-only transaction", query.StatementTag)
+}
+
+// sendWarning sends a warning to the client, which does not interrupt the statement.
+func (h *ConnectionHandler) sendWarning(code pgcode.Code, message string) error {
+	return h.sendNotice(notices.Notice{
+		Severity:     messages.ErrorResponseSeverity_Warning,
+		SqlStateCode: code.String(),
+		Message:      message,
+	})
+}
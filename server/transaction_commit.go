@@ -0,0 +1,77 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/sirupsen/logrus"
+
+	pgconfig "github.com/dolthub/doltgresql/server/config"
+)
+
+// loadTransactionCommit copies the session's dolt.transaction_commit and dolt.transaction_commit_message parameters to
+// the variables that Dolt reads when a transaction commits, which are not exposed to SET. The message's template is
+// expanded here, as Dolt uses the message as-is. This is called on startup and whenever a statement may have changed the
+// parameters or the current database, so that the statement that next ends a transaction uses the current settings.
+func (h *ConnectionHandler) loadTransactionCommit() {
+	enabled, err := h.showParameter("dolt.transaction_commit")
+	if err != nil {
+		logrus.WithError(err).Warn("unable to read parameter dolt.transaction_commit")
+		return
+	}
+	if !isParameterOn(enabled) {
+		if h.transactionCommit {
+			if err = h.setDoltVariable(dsess.DoltCommitOnTransactionCommit, sqlparser.NewIntVal([]byte("0"))); err != nil {
+				logrus.WithError(err).Warn("unable to disable transaction commits")
+				return
+			}
+			h.transactionCommit = false
+		}
+		return
+	}
+	template, err := h.showParameter("dolt.transaction_commit_message")
+	if err != nil {
+		logrus.WithError(err).Warn("unable to read parameter dolt.transaction_commit_message")
+		return
+	}
+	applicationName, err := h.showParameter("application_name")
+	if err != nil {
+		logrus.WithError(err).Warn("unable to read parameter application_name")
+	}
+	message := pgconfig.ExpandTransactionCommitMessage(template, h.mysqlConn.User, applicationName, h.currentDatabase())
+	if err = h.setDoltVariable(dsess.DoltCommitOnTransactionCommitMessage, sqlparser.NewStrVal([]byte(message))); err != nil {
+		logrus.WithError(err).Warn("unable to set the transaction commit message")
+		return
+	}
+	if !h.transactionCommit {
+		if err = h.setDoltVariable(dsess.DoltCommitOnTransactionCommit, sqlparser.NewIntVal([]byte("1"))); err != nil {
+			logrus.WithError(err).Warn("unable to enable transaction commits")
+			return
+		}
+		h.transactionCommit = true
+	}
+}
+
+// setDoltVariable sets the session's value of one of Dolt's system variables.
+func (h *ConnectionHandler) setDoltVariable(name string, value sqlparser.Expr) error {
+	return h.runTransactionStatement("SET "+name, &sqlparser.Set{
+		Exprs: sqlparser.SetVarExprs{&sqlparser.SetVarExpr{
+			Scope: sqlparser.SetScope_Session,
+			Name:  &sqlparser.ColName{Name: sqlparser.NewColIdent(name)},
+			Expr:  value,
+		}},
+	})
+}
@@ -53,29 +53,33 @@ const (
 )
 
 const (
-	DoltgresTypeBaseID_Bool        = DoltgresTypeBaseID(SerializationID_Bool)
-	DoltgresTypeBaseID_Bytea       = DoltgresTypeBaseID(SerializationID_Bytea)
-	DoltgresTypeBaseID_Char        = DoltgresTypeBaseID(SerializationID_Char)
-	DoltgresTypeBaseID_Date        = DoltgresTypeBaseID(SerializationID_Date)
-	DoltgresTypeBaseID_Float32     = DoltgresTypeBaseID(SerializationID_Float32)
-	DoltgresTypeBaseID_Float64     = DoltgresTypeBaseID(SerializationID_Float64)
-	DoltgresTypeBaseID_Int16       = DoltgresTypeBaseID(SerializationID_Int16)
-	DoltgresTypeBaseID_Int32       = DoltgresTypeBaseID(SerializationID_Int32)
-	DoltgresTypeBaseID_Int64       = DoltgresTypeBaseID(SerializationID_Int64)
-	DoltgresTypeBaseID_Json        = DoltgresTypeBaseID(SerializationID_Json)
-	DoltgresTypeBaseID_JsonB       = DoltgresTypeBaseID(SerializationID_JsonB)
-	DoltgresTypeBaseID_Name        = DoltgresTypeBaseID(SerializationID_Name)
-	DoltgresTypeBaseID_Null        = DoltgresTypeBaseID(SerializationID_Null)
-	DoltgresTypeBaseID_Numeric     = DoltgresTypeBaseID(SerializationID_Numeric)
-	DoltgresTypeBaseID_Oid         = DoltgresTypeBaseID(SerializationID_Oid)
-	DoltgresTypeBaseID_Text        = DoltgresTypeBaseID(SerializationID_Text)
-	DoltgresTypeBaseID_Time        = DoltgresTypeBaseID(SerializationID_Time)
-	DoltgresTypeBaseID_Timestamp   = DoltgresTypeBaseID(SerializationID_Timestamp)
-	DoltgresTypeBaseID_TimestampTZ = DoltgresTypeBaseID(SerializationID_TimestampTZ)
-	DoltgresTypeBaseID_TimeTZ      = DoltgresTypeBaseID(SerializationID_TimeTZ)
-	DoltgresTypeBaseID_Uuid        = DoltgresTypeBaseID(SerializationID_Uuid)
-	DoltgresTypeBaseID_VarChar     = DoltgresTypeBaseID(SerializationID_VarChar)
-	DoltgresTypeBaseID_Xid         = DoltgresTypeBaseID(SerializationID_Xid)
+	DoltgresTypeBaseID_Bool         = DoltgresTypeBaseID(SerializationID_Bool)
+	DoltgresTypeBaseID_Bytea        = DoltgresTypeBaseID(SerializationID_Bytea)
+	DoltgresTypeBaseID_Char         = DoltgresTypeBaseID(SerializationID_Char)
+	DoltgresTypeBaseID_Date         = DoltgresTypeBaseID(SerializationID_Date)
+	DoltgresTypeBaseID_Float32      = DoltgresTypeBaseID(SerializationID_Float32)
+	DoltgresTypeBaseID_Float64      = DoltgresTypeBaseID(SerializationID_Float64)
+	DoltgresTypeBaseID_Int16        = DoltgresTypeBaseID(SerializationID_Int16)
+	DoltgresTypeBaseID_Int32        = DoltgresTypeBaseID(SerializationID_Int32)
+	DoltgresTypeBaseID_Int64        = DoltgresTypeBaseID(SerializationID_Int64)
+	DoltgresTypeBaseID_Interval     = DoltgresTypeBaseID(SerializationID_Interval)
+	DoltgresTypeBaseID_Json         = DoltgresTypeBaseID(SerializationID_Json)
+	DoltgresTypeBaseID_JsonB        = DoltgresTypeBaseID(SerializationID_JsonB)
+	DoltgresTypeBaseID_Name         = DoltgresTypeBaseID(SerializationID_Name)
+	DoltgresTypeBaseID_Null         = DoltgresTypeBaseID(SerializationID_Null)
+	DoltgresTypeBaseID_Numeric      = DoltgresTypeBaseID(SerializationID_Numeric)
+	DoltgresTypeBaseID_Oid          = DoltgresTypeBaseID(SerializationID_Oid)
+	DoltgresTypeBaseID_Regclass     = DoltgresTypeBaseID(SerializationID_Regclass)
+	DoltgresTypeBaseID_Regnamespace = DoltgresTypeBaseID(SerializationID_Regnamespace)
+	DoltgresTypeBaseID_Regtype      = DoltgresTypeBaseID(SerializationID_Regtype)
+	DoltgresTypeBaseID_Text         = DoltgresTypeBaseID(SerializationID_Text)
+	DoltgresTypeBaseID_Time         = DoltgresTypeBaseID(SerializationID_Time)
+	DoltgresTypeBaseID_Timestamp    = DoltgresTypeBaseID(SerializationID_Timestamp)
+	DoltgresTypeBaseID_TimestampTZ  = DoltgresTypeBaseID(SerializationID_TimestampTZ)
+	DoltgresTypeBaseID_TimeTZ       = DoltgresTypeBaseID(SerializationID_TimeTZ)
+	DoltgresTypeBaseID_Uuid         = DoltgresTypeBaseID(SerializationID_Uuid)
+	DoltgresTypeBaseID_VarChar      = DoltgresTypeBaseID(SerializationID_VarChar)
+	DoltgresTypeBaseID_Xid          = DoltgresTypeBaseID(SerializationID_Xid)
 )
 
 // TypeCategory represents the type category that a type belongs to. These are used by Postgres to group similar types
@@ -107,18 +111,22 @@ var baseIDArrayTypes = map[DoltgresTypeBaseID]DoltgresArrayType{}
 // baseIDCategories contains a map from all base IDs to their respective categories
 // TODO: add all of the types to each category
 var baseIDCategories = map[DoltgresTypeBaseID]TypeCategory{
-	Bool.BaseID():    TypeCategory_BooleanTypes,
-	BpChar.BaseID():  TypeCategory_StringTypes,
-	Float32.BaseID(): TypeCategory_NumericTypes,
-	Float64.BaseID(): TypeCategory_NumericTypes,
-	Int16.BaseID():   TypeCategory_NumericTypes,
-	Int32.BaseID():   TypeCategory_NumericTypes,
-	Int64.BaseID():   TypeCategory_NumericTypes,
-	Name.BaseID():    TypeCategory_StringTypes,
-	Numeric.BaseID(): TypeCategory_NumericTypes,
-	Oid.BaseID():     TypeCategory_NumericTypes,
-	Text.BaseID():    TypeCategory_StringTypes,
-	VarChar.BaseID(): TypeCategory_StringTypes,
+	Bool.BaseID():         TypeCategory_BooleanTypes,
+	BpChar.BaseID():       TypeCategory_StringTypes,
+	Float32.BaseID():      TypeCategory_NumericTypes,
+	Float64.BaseID():      TypeCategory_NumericTypes,
+	Int16.BaseID():        TypeCategory_NumericTypes,
+	Int32.BaseID():        TypeCategory_NumericTypes,
+	Int64.BaseID():        TypeCategory_NumericTypes,
+	Interval.BaseID():     TypeCategory_TimespanTypes,
+	Name.BaseID():         TypeCategory_StringTypes,
+	Numeric.BaseID():      TypeCategory_NumericTypes,
+	Oid.BaseID():          TypeCategory_NumericTypes,
+	Regclass.BaseID():     TypeCategory_NumericTypes,
+	Regnamespace.BaseID(): TypeCategory_NumericTypes,
+	Regtype.BaseID():      TypeCategory_NumericTypes,
+	Text.BaseID():         TypeCategory_StringTypes,
+	VarChar.BaseID():      TypeCategory_StringTypes,
 }
 
 // preferredTypeInCategory contains a map from each type category to that category's preferred type.
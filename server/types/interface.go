@@ -14,7 +14,11 @@
 
 package types
 
-import "github.com/dolthub/go-mysql-server/sql/types"
+import (
+	"sort"
+
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
 
 // DoltgresType is a type that is distinct from the MySQL types in GMS.
 type DoltgresType interface {
@@ -62,54 +66,85 @@ type DoltgresArrayType interface {
 
 // typesFromBaseID contains a map from a DoltgresTypeBaseID to its originating type.
 var typesFromBaseID = map[DoltgresTypeBaseID]DoltgresType{
-	AnyArray.BaseID():         AnyArray,
-	BpChar.BaseID():           BpChar,
-	BpCharArray.BaseID():      BpCharArray,
-	Bool.BaseID():             Bool,
-	BoolArray.BaseID():        BoolArray,
-	Bytea.BaseID():            Bytea,
-	ByteaArray.BaseID():       ByteaArray,
-	Date.BaseID():             Date,
-	DateArray.BaseID():        DateArray,
-	Float32.BaseID():          Float32,
-	Float32Array.BaseID():     Float32Array,
-	Float64.BaseID():          Float64,
-	Float64Array.BaseID():     Float64Array,
-	Int16.BaseID():            Int16,
-	Int16Array.BaseID():       Int16Array,
-	Int16Serial.BaseID():      Int16Serial,
-	Int32.BaseID():            Int32,
-	Int32Array.BaseID():       Int32Array,
-	Int32Serial.BaseID():      Int32Serial,
-	Int64.BaseID():            Int64,
-	Int64Array.BaseID():       Int64Array,
-	Int64Serial.BaseID():      Int64Serial,
-	Json.BaseID():             Json,
-	JsonArray.BaseID():        JsonArray,
-	JsonB.BaseID():            JsonB,
-	JsonBArray.BaseID():       JsonBArray,
-	Name.BaseID():             Name,
-	NameArray.BaseID():        NameArray,
-	Null.BaseID():             Null,
-	Numeric.BaseID():          Numeric,
-	NumericArray.BaseID():     NumericArray,
-	Oid.BaseID():              Oid,
-	OidArray.BaseID():         OidArray,
-	Text.BaseID():             Text,
-	TextArray.BaseID():        TextArray,
-	Time.BaseID():             Time,
-	TimeArray.BaseID():        TimeArray,
-	Timestamp.BaseID():        Timestamp,
-	TimestampArray.BaseID():   TimestampArray,
-	TimestampTZ.BaseID():      TimestampTZ,
-	TimestampTZArray.BaseID(): TimestampTZArray,
-	TimeTZ.BaseID():           TimeTZ,
-	TimeTZArray.BaseID():      TimeTZArray,
-	Uuid.BaseID():             Uuid,
-	UuidArray.BaseID():        UuidArray,
-	Unknown.BaseID():          Unknown,
-	VarChar.BaseID():          VarChar,
-	VarCharArray.BaseID():     VarCharArray,
-	Xid.BaseID():              Xid,
-	XidArray.BaseID():         XidArray,
+	AnyArray.BaseID():          AnyArray,
+	AnyElement.BaseID():        AnyElement,
+	BpChar.BaseID():            BpChar,
+	BpCharArray.BaseID():       BpCharArray,
+	Bool.BaseID():              Bool,
+	BoolArray.BaseID():         BoolArray,
+	Bytea.BaseID():             Bytea,
+	ByteaArray.BaseID():        ByteaArray,
+	Date.BaseID():              Date,
+	DateArray.BaseID():         DateArray,
+	Float32.BaseID():           Float32,
+	Float32Array.BaseID():      Float32Array,
+	Float64.BaseID():           Float64,
+	Float64Array.BaseID():      Float64Array,
+	Int16.BaseID():             Int16,
+	Int16Array.BaseID():        Int16Array,
+	Int16Serial.BaseID():       Int16Serial,
+	Int32.BaseID():             Int32,
+	Int32Array.BaseID():        Int32Array,
+	Int32Serial.BaseID():       Int32Serial,
+	Int64.BaseID():             Int64,
+	Int64Array.BaseID():        Int64Array,
+	Int64Serial.BaseID():       Int64Serial,
+	Interval.BaseID():          Interval,
+	IntervalArray.BaseID():     IntervalArray,
+	Json.BaseID():              Json,
+	JsonArray.BaseID():         JsonArray,
+	JsonB.BaseID():             JsonB,
+	JsonBArray.BaseID():        JsonBArray,
+	Name.BaseID():              Name,
+	NameArray.BaseID():         NameArray,
+	Null.BaseID():              Null,
+	Numeric.BaseID():           Numeric,
+	NumericArray.BaseID():      NumericArray,
+	Oid.BaseID():               Oid,
+	OidArray.BaseID():          OidArray,
+	Record.BaseID():            Record,
+	Regclass.BaseID():          Regclass,
+	RegclassArray.BaseID():     RegclassArray,
+	Regnamespace.BaseID():      Regnamespace,
+	RegnamespaceArray.BaseID(): RegnamespaceArray,
+	Regtype.BaseID():           Regtype,
+	RegtypeArray.BaseID():      RegtypeArray,
+	Text.BaseID():              Text,
+	TextArray.BaseID():         TextArray,
+	Time.BaseID():              Time,
+	TimeArray.BaseID():         TimeArray,
+	Timestamp.BaseID():         Timestamp,
+	TimestampArray.BaseID():    TimestampArray,
+	TimestampTZ.BaseID():       TimestampTZ,
+	TimestampTZArray.BaseID():  TimestampTZArray,
+	TimeTZ.BaseID():            TimeTZ,
+	TimeTZArray.BaseID():       TimeTZArray,
+	Trigger.BaseID():           Trigger,
+	Uuid.BaseID():              Uuid,
+	UuidArray.BaseID():         UuidArray,
+	Unknown.BaseID():           Unknown,
+	VarChar.BaseID():           VarChar,
+	VarCharArray.BaseID():      VarCharArray,
+	Void.BaseID():              Void,
+	Xid.BaseID():               Xid,
+	XidArray.BaseID():          XidArray,
+}
+
+// GetAllTypes returns every type that has an OID, in order of their OIDs. The serial types are excluded, as they are
+// stored as the integer types that share their OIDs.
+func GetAllTypes() []DoltgresType {
+	allTypes := make([]DoltgresType, 0, len(typesFromBaseID))
+	for _, t := range typesFromBaseID {
+		switch t.BaseID() {
+		case DoltgresTypeBaseID_Int16Serial, DoltgresTypeBaseID_Int32Serial, DoltgresTypeBaseID_Int64Serial:
+			continue
+		}
+		if t.OID() != 0 {
+			allTypes = append(allTypes, t)
+		}
+	}
+	sort.Slice(allTypes, func(i, j int) bool {
+		return allTypes[i].OID() < allTypes[j].OID()
+	})
+	return allTypes
 }
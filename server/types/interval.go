@@ -0,0 +1,533 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/lib/pq/oid"
+
+	"github.com/dolthub/doltgresql/postgres/parser/duration"
+	"github.com/dolthub/doltgresql/postgres/parser/sem/tree"
+)
+
+// Interval is a span of time, which is stored as months, days, and nanoseconds.
+var Interval = IntervalType{}
+
+// IntervalType is the extended type implementation of the PostgreSQL interval.
+type IntervalType struct{}
+
+var _ DoltgresType = IntervalType{}
+
+// These are the values of the IntervalStyle parameter, which determine how intervals are written as text.
+const (
+	IntervalStylePostgres        = "postgres"
+	IntervalStylePostgresVerbose = "postgres_verbose"
+	IntervalStyleSQLStandard     = "sql_standard"
+	IntervalStyleISO8601         = "iso_8601"
+)
+
+// BaseID implements the DoltgresType interface.
+func (b IntervalType) BaseID() DoltgresTypeBaseID {
+	return DoltgresTypeBaseID_Interval
+}
+
+// CollationCoercibility implements the DoltgresType interface.
+func (b IntervalType) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// Compare implements the DoltgresType interface.
+func (b IntervalType) Compare(v1 any, v2 any) (int, error) {
+	if v1 == nil && v2 == nil {
+		return 0, nil
+	} else if v1 != nil && v2 == nil {
+		return 1, nil
+	} else if v1 == nil && v2 != nil {
+		return -1, nil
+	}
+
+	ac, _, err := b.Convert(v1)
+	if err != nil {
+		return 0, err
+	}
+	bc, _, err := b.Convert(v2)
+	if err != nil {
+		return 0, err
+	}
+
+	ab := ac.(duration.Duration)
+	bb := bc.(duration.Duration)
+	return ab.Compare(bb), nil
+}
+
+// Convert implements the DoltgresType interface.
+func (b IntervalType) Convert(val any) (any, sql.ConvertInRange, error) {
+	switch val := val.(type) {
+	case duration.Duration:
+		return val, sql.InRange, nil
+	case nil:
+		return nil, sql.InRange, nil
+	default:
+		return nil, sql.OutOfRange, fmt.Errorf("%s: unhandled type: %T", b.String(), val)
+	}
+}
+
+// Equals implements the DoltgresType interface.
+func (b IntervalType) Equals(otherType sql.Type) bool {
+	if otherExtendedType, ok := otherType.(types.ExtendedType); ok {
+		return bytes.Equal(MustSerializeType(b), MustSerializeType(otherExtendedType))
+	}
+	return false
+}
+
+// FormatSerializedValue implements the DoltgresType interface.
+func (b IntervalType) FormatSerializedValue(val []byte) (string, error) {
+	deserialized, err := b.DeserializeValue(val)
+	if err != nil {
+		return "", err
+	}
+	return b.FormatValue(deserialized)
+}
+
+// FormatValue implements the DoltgresType interface.
+func (b IntervalType) FormatValue(val any) (string, error) {
+	if val == nil {
+		return "", nil
+	}
+	return b.IoOutput(val)
+}
+
+// GetSerializationID implements the DoltgresType interface.
+func (b IntervalType) GetSerializationID() SerializationID {
+	return SerializationID_Interval
+}
+
+// IoInput implements the DoltgresType interface.
+func (b IntervalType) IoInput(input string) (any, error) {
+	dInterval, err := tree.ParseDInterval(strings.TrimSpace(input))
+	if err != nil {
+		return nil, fmt.Errorf(`invalid input syntax for type interval: "%s"`, input)
+	}
+	return dInterval.Duration, nil
+}
+
+// IoOutput implements the DoltgresType interface. This always uses the "postgres" style, as the IntervalStyle is a
+// session setting. Use SessionOutput to honor the setting.
+func (b IntervalType) IoOutput(output any) (string, error) {
+	converted, _, err := b.Convert(output)
+	if err != nil {
+		return "", err
+	}
+	return FormatInterval(converted.(duration.Duration), IntervalStylePostgres), nil
+}
+
+// IsUnbounded implements the DoltgresType interface.
+func (b IntervalType) IsUnbounded() bool {
+	return false
+}
+
+// MaxSerializedWidth implements the DoltgresType interface.
+func (b IntervalType) MaxSerializedWidth() types.ExtendedTypeSerializedWidth {
+	return types.ExtendedTypeSerializedWidth_64K
+}
+
+// MaxTextResponseByteLength implements the DoltgresType interface.
+func (b IntervalType) MaxTextResponseByteLength(ctx *sql.Context) uint32 {
+	return 64
+}
+
+// OID implements the DoltgresType interface.
+func (b IntervalType) OID() uint32 {
+	return uint32(oid.T_interval)
+}
+
+// Promote implements the DoltgresType interface.
+func (b IntervalType) Promote() sql.Type {
+	return Interval
+}
+
+// SerializedCompare implements the DoltgresType interface.
+func (b IntervalType) SerializedCompare(v1 []byte, v2 []byte) (int, error) {
+	if len(v1) == 0 && len(v2) == 0 {
+		return 0, nil
+	} else if len(v1) > 0 && len(v2) == 0 {
+		return 1, nil
+	} else if len(v1) == 0 && len(v2) > 0 {
+		return -1, nil
+	}
+
+	// Intervals compare by their total length, so we have to deserialize them
+	ab, err := b.DeserializeValue(v1)
+	if err != nil {
+		return 0, err
+	}
+	bb, err := b.DeserializeValue(v2)
+	if err != nil {
+		return 0, err
+	}
+	return ab.(duration.Duration).Compare(bb.(duration.Duration)), nil
+}
+
+// SessionOutput returns the string representation of the interval, using the session's IntervalStyle.
+func (b IntervalType) SessionOutput(ctx *sql.Context, val any) (string, error) {
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return "", err
+	}
+	style := IntervalStylePostgres
+	if ctx != nil {
+		if sessionStyle, err := ctx.GetSessionVariable(ctx, "intervalstyle"); err == nil {
+			if sessionStyle, ok := sessionStyle.(string); ok {
+				style = strings.ToLower(sessionStyle)
+			}
+		}
+	}
+	return FormatInterval(converted.(duration.Duration), style), nil
+}
+
+// SQL implements the DoltgresType interface.
+func (b IntervalType) SQL(ctx *sql.Context, dest []byte, v any) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+	value, err := b.SessionOutput(ctx, v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+	return sqltypes.MakeTrusted(sqltypes.Text, types.AppendAndSliceBytes(dest, []byte(value))), nil
+}
+
+// String implements the DoltgresType interface.
+func (b IntervalType) String() string {
+	return "interval"
+}
+
+// ToArrayType implements the DoltgresType interface.
+func (b IntervalType) ToArrayType() DoltgresArrayType {
+	return IntervalArray
+}
+
+// Type implements the DoltgresType interface.
+func (b IntervalType) Type() query.Type {
+	return sqltypes.Text
+}
+
+// ValueType implements the DoltgresType interface.
+func (b IntervalType) ValueType() reflect.Type {
+	return reflect.TypeOf(duration.Duration{})
+}
+
+// Zero implements the DoltgresType interface.
+func (b IntervalType) Zero() any {
+	return duration.Duration{}
+}
+
+// SerializeType implements the DoltgresType interface.
+func (b IntervalType) SerializeType() ([]byte, error) {
+	return SerializationID_Interval.ToByteSlice(0), nil
+}
+
+// deserializeType implements the DoltgresType interface.
+func (b IntervalType) deserializeType(version uint16, metadata []byte) (DoltgresType, error) {
+	switch version {
+	case 0:
+		return Interval, nil
+	default:
+		return nil, fmt.Errorf("version %d is not yet supported for %s", version, b.String())
+	}
+}
+
+// SerializeValue implements the DoltgresType interface.
+func (b IntervalType) SerializeValue(val any) ([]byte, error) {
+	if val == nil {
+		return nil, nil
+	}
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	d := converted.(duration.Duration)
+	retVal := make([]byte, 24)
+	binary.BigEndian.PutUint64(retVal, uint64(d.Months))
+	binary.BigEndian.PutUint64(retVal[8:], uint64(d.Days))
+	binary.BigEndian.PutUint64(retVal[16:], uint64(d.Nanos()))
+	return retVal, nil
+}
+
+// DeserializeValue implements the DoltgresType interface.
+func (b IntervalType) DeserializeValue(val []byte) (any, error) {
+	if len(val) == 0 {
+		return nil, nil
+	}
+	if len(val) != 24 {
+		return nil, fmt.Errorf("%s: invalid serialized length: %d", b.String(), len(val))
+	}
+	months := int64(binary.BigEndian.Uint64(val))
+	days := int64(binary.BigEndian.Uint64(val[8:]))
+	nanos := int64(binary.BigEndian.Uint64(val[16:]))
+	return duration.DecodeDuration(months, days, nanos), nil
+}
+
+// FormatInterval returns the string representation of the interval using the given IntervalStyle. This matches the
+// output of Postgres, which only displays up to microsecond precision.
+func FormatInterval(d duration.Duration, style string) string {
+	parts := splitInterval(d)
+	sb := strings.Builder{}
+	switch style {
+	case IntervalStyleSQLStandard:
+		hasNegative := parts.years < 0 || parts.months < 0 || parts.days < 0 || parts.micros < 0
+		hasPositive := parts.years > 0 || parts.months > 0 || parts.days > 0 || parts.micros > 0
+		hasYearMonth := parts.years != 0 || parts.months != 0
+		hasDayTime := parts.days != 0 || parts.micros != 0
+		isStandardValue := !(hasNegative && hasPositive) && !(hasYearMonth && hasDayTime)
+		if hasNegative && isStandardValue {
+			sb.WriteRune('-')
+			parts = parts.negate()
+		}
+		if !hasNegative && !hasPositive {
+			sb.WriteRune('0')
+		} else if !isStandardValue {
+			yearSign, daySign, timeSign := '+', '+', '+'
+			if parts.years < 0 || parts.months < 0 {
+				yearSign = '-'
+			}
+			if parts.days < 0 {
+				daySign = '-'
+			}
+			if parts.micros < 0 {
+				timeSign = '-'
+			}
+			fmt.Fprintf(&sb, "%c%d-%d %c%d %c", yearSign, absInt64(parts.years), absInt64(parts.months),
+				daySign, absInt64(parts.days), timeSign)
+			writeIntervalTime(&sb, parts, false)
+		} else if hasYearMonth {
+			fmt.Fprintf(&sb, "%d-%d", parts.years, parts.months)
+		} else if parts.days != 0 {
+			fmt.Fprintf(&sb, "%d ", parts.days)
+			writeIntervalTime(&sb, parts, false)
+		} else {
+			writeIntervalTime(&sb, parts, false)
+		}
+	case IntervalStyleISO8601:
+		if parts.isZero() {
+			return "PT0S"
+		}
+		sb.WriteRune('P')
+		writeISO8601Part(&sb, parts.years, 'Y')
+		writeISO8601Part(&sb, parts.months, 'M')
+		writeISO8601Part(&sb, parts.days, 'D')
+		if parts.hours != 0 || parts.minutes != 0 || parts.seconds != 0 || parts.fraction != 0 {
+			sb.WriteRune('T')
+		}
+		writeISO8601Part(&sb, parts.hours, 'H')
+		writeISO8601Part(&sb, parts.minutes, 'M')
+		if parts.seconds != 0 || parts.fraction != 0 {
+			if parts.seconds < 0 || parts.fraction < 0 {
+				sb.WriteRune('-')
+			}
+			writeIntervalSeconds(&sb, parts, false)
+			sb.WriteRune('S')
+		}
+	case IntervalStylePostgresVerbose:
+		isZero, isBefore := true, false
+		sb.WriteRune('@')
+		writeVerbosePart(&sb, parts.years, "year", &isZero, &isBefore)
+		writeVerbosePart(&sb, parts.months, "mon", &isZero, &isBefore)
+		writeVerbosePart(&sb, parts.days, "day", &isZero, &isBefore)
+		writeVerbosePart(&sb, parts.hours, "hour", &isZero, &isBefore)
+		writeVerbosePart(&sb, parts.minutes, "min", &isZero, &isBefore)
+		if parts.seconds != 0 || parts.fraction != 0 {
+			sb.WriteRune(' ')
+			if parts.seconds < 0 || (parts.seconds == 0 && parts.fraction < 0) {
+				if isZero {
+					isBefore = true
+				} else if !isBefore {
+					sb.WriteRune('-')
+				}
+			} else if isBefore {
+				sb.WriteRune('-')
+			}
+			writeIntervalSeconds(&sb, parts, false)
+			if absInt64(parts.seconds) != 1 || parts.fraction != 0 {
+				sb.WriteString(" secs")
+			} else {
+				sb.WriteString(" sec")
+			}
+			isZero = false
+		}
+		if isZero {
+			sb.WriteString(" 0")
+		}
+		if isBefore {
+			sb.WriteString(" ago")
+		}
+	default:
+		isZero, isBefore := true, false
+		writePostgresPart(&sb, parts.years, "year", &isZero, &isBefore)
+		writePostgresPart(&sb, parts.months, "mon", &isZero, &isBefore)
+		writePostgresPart(&sb, parts.days, "day", &isZero, &isBefore)
+		if isZero || parts.micros != 0 {
+			if !isZero {
+				sb.WriteRune(' ')
+			}
+			if parts.micros < 0 {
+				sb.WriteRune('-')
+			} else if isBefore {
+				sb.WriteRune('+')
+			}
+			writeIntervalTime(&sb, parts, true)
+		}
+	}
+	return sb.String()
+}
+
+// intervalParts contains the fields of an interval, split in the same way that Postgres splits them for output. All
+// fields share the sign of the value that they were derived from.
+type intervalParts struct {
+	years    int64
+	months   int64
+	days     int64
+	micros   int64
+	hours    int64
+	minutes  int64
+	seconds  int64
+	fraction int64
+}
+
+// splitInterval splits the interval into the parts that are used for its output.
+func splitInterval(d duration.Duration) intervalParts {
+	micros := d.Nanos() / 1000
+	return intervalParts{
+		years:    d.Months / 12,
+		months:   d.Months % 12,
+		days:     d.Days,
+		micros:   micros,
+		hours:    micros / 3600000000,
+		minutes:  (micros / 60000000) % 60,
+		seconds:  (micros / 1000000) % 60,
+		fraction: micros % 1000000,
+	}
+}
+
+// negate returns the parts with every field negated.
+func (p intervalParts) negate() intervalParts {
+	return intervalParts{
+		years:    -p.years,
+		months:   -p.months,
+		days:     -p.days,
+		micros:   -p.micros,
+		hours:    -p.hours,
+		minutes:  -p.minutes,
+		seconds:  -p.seconds,
+		fraction: -p.fraction,
+	}
+}
+
+// isZero returns whether every field is zero.
+func (p intervalParts) isZero() bool {
+	return p.years == 0 && p.months == 0 && p.days == 0 && p.micros == 0
+}
+
+// writeIntervalTime writes the time portion as unsigned hours, minutes, and seconds, such as "04:05:06.789". Only the
+// "postgres" style pads the hours to two digits.
+func writeIntervalTime(sb *strings.Builder, parts intervalParts, padHours bool) {
+	if parts.micros < 0 {
+		parts = parts.negate()
+	}
+	if padHours {
+		fmt.Fprintf(sb, "%02d:%02d:", parts.hours, parts.minutes)
+	} else {
+		fmt.Fprintf(sb, "%d:%02d:", parts.hours, parts.minutes)
+	}
+	writeIntervalSeconds(sb, parts, true)
+}
+
+// writeIntervalSeconds writes the unsigned seconds, along with the fractional seconds when they are not zero. Trailing
+// zeros are removed from the fractional seconds.
+func writeIntervalSeconds(sb *strings.Builder, parts intervalParts, fillZero bool) {
+	if fillZero {
+		fmt.Fprintf(sb, "%02d", absInt64(parts.seconds))
+	} else {
+		fmt.Fprintf(sb, "%d", absInt64(parts.seconds))
+	}
+	if parts.fraction != 0 {
+		sb.WriteString(strings.TrimRight(fmt.Sprintf(".%06d", absInt64(parts.fraction)), "0"))
+	}
+}
+
+// writeISO8601Part writes a single part of an ISO 8601 interval, such as "3D". Zero values are not written.
+func writeISO8601Part(sb *strings.Builder, value int64, designator rune) {
+	if value == 0 {
+		return
+	}
+	fmt.Fprintf(sb, "%d%c", value, designator)
+}
+
+// writePostgresPart writes a single part of an interval using the "postgres" style, such as "3 days". Zero values are
+// not written.
+func writePostgresPart(sb *strings.Builder, value int64, units string, isZero *bool, isBefore *bool) {
+	if value == 0 {
+		return
+	}
+	if !*isZero {
+		sb.WriteRune(' ')
+	}
+	if *isBefore && value > 0 {
+		sb.WriteRune('+')
+	}
+	fmt.Fprintf(sb, "%d %s", value, units)
+	if value != 1 {
+		sb.WriteRune('s')
+	}
+	*isBefore = value < 0
+	*isZero = false
+}
+
+// writeVerbosePart writes a single part of an interval using the "postgres_verbose" style, such as "3 days". Zero
+// values are not written. The sign of the first part determines whether the interval is written with "ago", with
+// the remaining parts being written relative to that sign.
+func writeVerbosePart(sb *strings.Builder, value int64, units string, isZero *bool, isBefore *bool) {
+	if value == 0 {
+		return
+	}
+	if *isZero {
+		*isBefore = value < 0
+		value = absInt64(value)
+	} else if *isBefore {
+		value = -value
+	}
+	fmt.Fprintf(sb, " %d %s", value, units)
+	if value != 1 {
+		sb.WriteRune('s')
+	}
+	*isZero = false
+}
+
+// absInt64 returns the absolute value of the given int64.
+func absInt64(val int64) int64 {
+	if val < 0 {
+		return -val
+	}
+	return val
+}
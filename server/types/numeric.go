@@ -218,7 +218,7 @@ func (b NumericType) String() string {
 
 // ToArrayType implements the DoltgresType interface.
 func (b NumericType) ToArrayType() DoltgresArrayType {
-	return NumericArray
+	return createArrayType(b, SerializationID_NumericArray, oid.T__numeric)
 }
 
 // Type implements the DoltgresType interface.
@@ -0,0 +1,263 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/lib/pq/oid"
+)
+
+// Record is the anonymous composite type, which is the type of row constructors and of functions that return rows.
+var Record = RecordType{}
+
+// RecordType is the extended type implementation of the PostgreSQL record type.
+type RecordType struct{}
+
+// RecordValue is a single field of a record, along with the type of the field.
+type RecordValue struct {
+	Type  DoltgresType
+	Value any
+}
+
+var _ DoltgresType = RecordType{}
+
+// BaseID implements the DoltgresType interface.
+func (r RecordType) BaseID() DoltgresTypeBaseID {
+	return DoltgresTypeBaseID_Record
+}
+
+// CollationCoercibility implements the DoltgresType interface.
+func (r RecordType) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// Compare implements the DoltgresType interface.
+func (r RecordType) Compare(v1 any, v2 any) (int, error) {
+	if v1 == nil && v2 == nil {
+		return 0, nil
+	} else if v1 != nil && v2 == nil {
+		return 1, nil
+	} else if v1 == nil && v2 != nil {
+		return -1, nil
+	}
+
+	ac, _, err := r.Convert(v1)
+	if err != nil {
+		return 0, err
+	}
+	bc, _, err := r.Convert(v2)
+	if err != nil {
+		return 0, err
+	}
+	ab := ac.([]RecordValue)
+	bb := bc.([]RecordValue)
+	if len(ab) != len(bb) {
+		return 0, fmt.Errorf("cannot compare record types with different numbers of columns")
+	}
+	for i := range ab {
+		if !ab[i].Type.Equals(bb[i].Type) {
+			return 0, fmt.Errorf("cannot compare dissimilar column types %s and %s at record column %d",
+				ab[i].Type.String(), bb[i].Type.String(), i+1)
+		}
+		res, err := ab[i].Type.Compare(ab[i].Value, bb[i].Value)
+		if err != nil || res != 0 {
+			return res, err
+		}
+	}
+	return 0, nil
+}
+
+// Convert implements the DoltgresType interface.
+func (r RecordType) Convert(val any) (any, sql.ConvertInRange, error) {
+	switch val := val.(type) {
+	case []RecordValue:
+		return val, sql.InRange, nil
+	case nil:
+		return nil, sql.InRange, nil
+	default:
+		return nil, sql.OutOfRange, fmt.Errorf("%s: unhandled type: %T", r.String(), val)
+	}
+}
+
+// Equals implements the DoltgresType interface.
+func (r RecordType) Equals(otherType sql.Type) bool {
+	_, ok := otherType.(RecordType)
+	return ok
+}
+
+// FormatSerializedValue implements the DoltgresType interface.
+func (r RecordType) FormatSerializedValue(val []byte) (string, error) {
+	return "", fmt.Errorf("%s cannot format serialized values", r.String())
+}
+
+// FormatValue implements the DoltgresType interface.
+func (r RecordType) FormatValue(val any) (string, error) {
+	if val == nil {
+		return "", nil
+	}
+	return r.IoOutput(val)
+}
+
+// GetSerializationID implements the DoltgresType interface.
+func (r RecordType) GetSerializationID() SerializationID {
+	return SerializationID_Invalid
+}
+
+// IoInput implements the DoltgresType interface.
+func (r RecordType) IoInput(input string) (any, error) {
+	return nil, fmt.Errorf("input of anonymous composite types is not implemented")
+}
+
+// IoOutput implements the DoltgresType interface.
+func (r RecordType) IoOutput(output any) (string, error) {
+	converted, _, err := r.Convert(output)
+	if err != nil {
+		return "", err
+	}
+	sb := strings.Builder{}
+	sb.WriteRune('(')
+	for i, field := range converted.([]RecordValue) {
+		if i > 0 {
+			sb.WriteRune(',')
+		}
+		// NULL fields are written as nothing at all, which is distinct from an empty string as those are quoted
+		if field.Value == nil {
+			continue
+		}
+		str, err := field.Type.IoOutput(field.Value)
+		if err != nil {
+			return "", err
+		}
+		if len(str) == 0 || strings.ContainsAny(str, "\"\\(),") || strings.IndexFunc(str, isRecordSpace) != -1 {
+			sb.WriteRune('"')
+			for _, c := range str {
+				if c == '"' || c == '\\' {
+					sb.WriteRune(c)
+				}
+				sb.WriteRune(c)
+			}
+			sb.WriteRune('"')
+		} else {
+			sb.WriteString(str)
+		}
+	}
+	sb.WriteRune(')')
+	return sb.String(), nil
+}
+
+// IsUnbounded implements the DoltgresType interface.
+func (r RecordType) IsUnbounded() bool {
+	return true
+}
+
+// MaxSerializedWidth implements the DoltgresType interface.
+func (r RecordType) MaxSerializedWidth() types.ExtendedTypeSerializedWidth {
+	return types.ExtendedTypeSerializedWidth_Unbounded
+}
+
+// MaxTextResponseByteLength implements the DoltgresType interface.
+func (r RecordType) MaxTextResponseByteLength(ctx *sql.Context) uint32 {
+	return math.MaxUint32
+}
+
+// OID implements the DoltgresType interface.
+func (r RecordType) OID() uint32 {
+	return uint32(oid.T_record)
+}
+
+// Promote implements the DoltgresType interface.
+func (r RecordType) Promote() sql.Type {
+	return r
+}
+
+// SerializedCompare implements the DoltgresType interface.
+func (r RecordType) SerializedCompare(v1 []byte, v2 []byte) (int, error) {
+	return 0, fmt.Errorf("%s cannot compare serialized values", r.String())
+}
+
+// SQL implements the DoltgresType interface.
+func (r RecordType) SQL(ctx *sql.Context, dest []byte, val any) (sqltypes.Value, error) {
+	if val == nil {
+		return sqltypes.NULL, nil
+	}
+	value, err := r.IoOutput(val)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+	return sqltypes.MakeTrusted(r.Type(), types.AppendAndSliceBytes(dest, []byte(value))), nil
+}
+
+// String implements the DoltgresType interface.
+func (r RecordType) String() string {
+	return "record"
+}
+
+// ToArrayType implements the DoltgresType interface.
+func (r RecordType) ToArrayType() DoltgresArrayType {
+	return Unknown
+}
+
+// Type implements the DoltgresType interface.
+func (r RecordType) Type() query.Type {
+	return sqltypes.Text
+}
+
+// ValueType implements the DoltgresType interface.
+func (r RecordType) ValueType() reflect.Type {
+	return reflect.TypeOf([]RecordValue{})
+}
+
+// Zero implements the DoltgresType interface.
+func (r RecordType) Zero() any {
+	return []RecordValue{}
+}
+
+// SerializeType implements the DoltgresType interface.
+func (r RecordType) SerializeType() ([]byte, error) {
+	return nil, fmt.Errorf("%s cannot be serialized", r.String())
+}
+
+// deserializeType implements the DoltgresType interface.
+func (r RecordType) deserializeType(version uint16, metadata []byte) (DoltgresType, error) {
+	return nil, fmt.Errorf("%s cannot be deserialized", r.String())
+}
+
+// SerializeValue implements the DoltgresType interface.
+func (r RecordType) SerializeValue(val any) ([]byte, error) {
+	return nil, fmt.Errorf("%s cannot serialize values", r.String())
+}
+
+// DeserializeValue implements the DoltgresType interface.
+func (r RecordType) DeserializeValue(val []byte) (any, error) {
+	return nil, fmt.Errorf("%s cannot deserialize values", r.String())
+}
+
+// isRecordSpace returns whether the rune is considered whitespace when deciding whether a record field must be quoted.
+func isRecordSpace(r rune) bool {
+	switch r {
+	case ' ', '\t', '\n', '\r', '\v', '\f':
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "github.com/dolthub/go-mysql-server/sql"
+
+// RegNameInput resolves the name of an object to the OID that identifies it, using the reg* type with the given base
+// ID to determine the kind of object. Names are resolved using the catalog, so this is set by the functions package.
+var RegNameInput func(ctx *sql.Context, baseID DoltgresTypeBaseID, name string) (uint32, error)
+
+// RegNameOutput returns the name of the object identified by the given OID, using the reg* type with the given base ID
+// to determine the kind of object. Names are found using the catalog, so this is set by the functions package.
+var RegNameOutput func(ctx *sql.Context, baseID DoltgresTypeBaseID, oid uint32) (string, error)
+
+// regNameOutput returns the name of the object identified by the given value of a reg* type. The OID is returned as a
+// number when names cannot be found.
+func regNameOutput(ctx *sql.Context, typ DoltgresType, val any) (string, error) {
+	if RegNameOutput == nil {
+		return typ.IoOutput(val)
+	}
+	converted, _, err := typ.Convert(val)
+	if err != nil {
+		return "", err
+	}
+	return RegNameOutput(ctx, typ.BaseID(), converted.(uint32))
+}
+
+// IsRegNameType returns whether the given type is a reg* type, whose values are written using the names of the
+// objects that they identify.
+func IsRegNameType(typ DoltgresType) bool {
+	switch typ.BaseID() {
+	case DoltgresTypeBaseID_Regclass, DoltgresTypeBaseID_Regnamespace, DoltgresTypeBaseID_Regtype:
+		return true
+	default:
+		return false
+	}
+}
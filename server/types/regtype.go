@@ -0,0 +1,231 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/lib/pq/oid"
+)
+
+// Regtype is an alias of Oid that identifies a data type. It is written and displayed as the name of the data type,
+// however it is implemented as an unsigned 32 bit integer.
+var Regtype = RegtypeType{}
+
+// RegtypeType is the extended type implementation of the PostgreSQL regtype.
+type RegtypeType struct{}
+
+var _ DoltgresType = RegtypeType{}
+
+// BaseID implements the DoltgresType interface.
+func (b RegtypeType) BaseID() DoltgresTypeBaseID {
+	return DoltgresTypeBaseID_Regtype
+}
+
+// CollationCoercibility implements the DoltgresType interface.
+func (b RegtypeType) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// Compare implements the DoltgresType interface.
+func (b RegtypeType) Compare(v1 any, v2 any) (int, error) {
+	return compareUint32(b, v1, v2)
+}
+
+// Convert implements the DoltgresType interface.
+func (b RegtypeType) Convert(val any) (any, sql.ConvertInRange, error) {
+	switch val := val.(type) {
+	case uint32:
+		return val, sql.InRange, nil
+	case nil:
+		return nil, sql.InRange, nil
+	default:
+		return nil, sql.OutOfRange, fmt.Errorf("%s: unhandled type: %T", b.String(), val)
+	}
+}
+
+// Equals implements the DoltgresType interface.
+func (b RegtypeType) Equals(otherType sql.Type) bool {
+	if otherExtendedType, ok := otherType.(types.ExtendedType); ok {
+		return bytes.Equal(MustSerializeType(b), MustSerializeType(otherExtendedType))
+	}
+	return false
+}
+
+// FormatSerializedValue implements the DoltgresType interface.
+func (b RegtypeType) FormatSerializedValue(val []byte) (string, error) {
+	deserialized, err := b.DeserializeValue(val)
+	if err != nil {
+		return "", err
+	}
+	return b.FormatValue(deserialized)
+}
+
+// FormatValue implements the DoltgresType interface.
+func (b RegtypeType) FormatValue(val any) (string, error) {
+	if val == nil {
+		return "", nil
+	}
+	return b.IoOutput(val)
+}
+
+// GetSerializationID implements the DoltgresType interface.
+func (b RegtypeType) GetSerializationID() SerializationID {
+	return SerializationID_Regtype
+}
+
+// IoInput implements the DoltgresType interface. Only numeric OIDs are handled here, as resolving a name requires
+// access to the catalog, which is done by RegNameInput.
+func (b RegtypeType) IoInput(input string) (any, error) {
+	val, err := strconv.ParseInt(strings.TrimSpace(input), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid input syntax for type %s: %q", b.String(), input)
+	}
+	// Note: This minimum is different (-4294967295) for Postgres 15.4 compiled by Visual C++
+	if val > MaxUint32 || val < MinInt32 {
+		return nil, fmt.Errorf("value %q is out of range for type %s", input, b.String())
+	}
+	return uint32(val), nil
+}
+
+// IoOutput implements the DoltgresType interface.
+func (b RegtypeType) IoOutput(output any) (string, error) {
+	converted, _, err := b.Convert(output)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatUint(uint64(converted.(uint32)), 10), nil
+}
+
+// IsUnbounded implements the DoltgresType interface.
+func (b RegtypeType) IsUnbounded() bool {
+	return false
+}
+
+// MaxSerializedWidth implements the DoltgresType interface.
+func (b RegtypeType) MaxSerializedWidth() types.ExtendedTypeSerializedWidth {
+	return types.ExtendedTypeSerializedWidth_64K
+}
+
+// MaxTextResponseByteLength implements the DoltgresType interface.
+func (b RegtypeType) MaxTextResponseByteLength(ctx *sql.Context) uint32 {
+	return 4
+}
+
+// OID implements the DoltgresType interface.
+func (b RegtypeType) OID() uint32 {
+	return uint32(oid.T_regtype)
+}
+
+// Promote implements the DoltgresType interface.
+func (b RegtypeType) Promote() sql.Type {
+	return b
+}
+
+// SerializedCompare implements the DoltgresType interface.
+func (b RegtypeType) SerializedCompare(v1 []byte, v2 []byte) (int, error) {
+	if len(v1) == 0 && len(v2) == 0 {
+		return 0, nil
+	} else if len(v1) > 0 && len(v2) == 0 {
+		return 1, nil
+	} else if len(v1) == 0 && len(v2) > 0 {
+		return -1, nil
+	}
+
+	return bytes.Compare(v1, v2), nil
+}
+
+// SQL implements the DoltgresType interface.
+func (b RegtypeType) SQL(ctx *sql.Context, dest []byte, v any) (sqltypes.Value, error) {
+	if v == nil {
+		return sqltypes.NULL, nil
+	}
+	value, err := regNameOutput(ctx, b, v)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+	return sqltypes.MakeTrusted(sqltypes.Text, types.AppendAndSliceBytes(dest, []byte(value))), nil
+}
+
+// String implements the DoltgresType interface.
+func (b RegtypeType) String() string {
+	return "regtype"
+}
+
+// ToArrayType implements the DoltgresType interface.
+func (b RegtypeType) ToArrayType() DoltgresArrayType {
+	return RegtypeArray
+}
+
+// Type implements the DoltgresType interface. Values are sent to clients as names, so they are described as text.
+func (b RegtypeType) Type() query.Type {
+	return sqltypes.Text
+}
+
+// ValueType implements the DoltgresType interface.
+func (b RegtypeType) ValueType() reflect.Type {
+	return reflect.TypeOf(uint32(0))
+}
+
+// Zero implements the DoltgresType interface.
+func (b RegtypeType) Zero() any {
+	return uint32(0)
+}
+
+// SerializeType implements the DoltgresType interface.
+func (b RegtypeType) SerializeType() ([]byte, error) {
+	return SerializationID_Regtype.ToByteSlice(0), nil
+}
+
+// deserializeType implements the DoltgresType interface.
+func (b RegtypeType) deserializeType(version uint16, metadata []byte) (DoltgresType, error) {
+	switch version {
+	case 0:
+		return Regtype, nil
+	default:
+		return nil, fmt.Errorf("version %d is not yet supported for %s", version, b.String())
+	}
+}
+
+// SerializeValue implements the DoltgresType interface.
+func (b RegtypeType) SerializeValue(val any) ([]byte, error) {
+	if val == nil {
+		return nil, nil
+	}
+	converted, _, err := b.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	retVal := make([]byte, 4)
+	binary.BigEndian.PutUint32(retVal, converted.(uint32))
+	return retVal, nil
+}
+
+// DeserializeValue implements the DoltgresType interface.
+func (b RegtypeType) DeserializeValue(val []byte) (any, error) {
+	if len(val) == 0 {
+		return nil, nil
+	}
+	return binary.BigEndian.Uint32(val), nil
+}
@@ -126,6 +126,13 @@ const (
 	SerializationID_OidArray              SerializationID = 93
 	SerializationID_Xid                   SerializationID = 94
 	SerializationID_XidArray              SerializationID = 95
+	SerializationID_Trigger               SerializationID = 96
+	SerializationID_Regclass              SerializationID = 97
+	SerializationID_RegclassArray         SerializationID = 98
+	SerializationID_Regtype               SerializationID = 99
+	SerializationID_RegtypeArray          SerializationID = 100
+	SerializationID_Regnamespace          SerializationID = 101
+	SerializationID_RegnamespaceArray     SerializationID = 102
 )
 
 // serializationIDToType is a map from each SerializationID to its matching DoltgresType.
@@ -166,6 +173,12 @@ func MustSerializeType(extendedType types.ExtendedType) []byte {
 		return []byte{0}
 	case UnknownType:
 		return []byte{1}
+	case AnyElementType:
+		return []byte{2}
+	case VoidType:
+		return []byte{3}
+	case RecordType:
+		return []byte{4}
 	}
 	serializedType, err := SerializeType(extendedType)
 	if err != nil {
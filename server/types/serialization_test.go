@@ -124,6 +124,13 @@ func TestSerialization(t *testing.T) {
 		{SerializationID_OidArray, 93, "OidArray"},
 		{SerializationID_Xid, 94, "Xid"},
 		{SerializationID_XidArray, 95, "XidArray"},
+		{SerializationID_Trigger, 96, "Trigger"},
+		{SerializationID_Regclass, 97, "Regclass"},
+		{SerializationID_RegclassArray, 98, "RegclassArray"},
+		{SerializationID_Regtype, 99, "Regtype"},
+		{SerializationID_RegtypeArray, 100, "RegtypeArray"},
+		{SerializationID_Regnamespace, 101, "Regnamespace"},
+		{SerializationID_RegnamespaceArray, 102, "RegnamespaceArray"},
 	}
 	allIds := make(map[uint16]string)
 	for _, id := range ids {
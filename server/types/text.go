@@ -249,11 +249,20 @@ func (b TextType) DeserializeValue(val []byte) (any, error) {
 // serializedStringCompare handles the efficient comparison of two strings that have been serialized using utils.Writer.
 // The writer writes the string by prepending the string length, which prevents direct comparison of the byte slices. We
 // thus read the string length manually, and extract the byte slices without converting to a string. This function
-// assumes that neither byte slice is nil or empty.
+// assumes that neither byte slice is nil or empty. Some callers (such as diffs) pass the address of a value that was
+// stored out-of-band rather than the serialized value, so anything that is not a valid serialized string is compared
+// directly.
 func serializedStringCompare(v1 []byte, v2 []byte) int {
-	readerV1 := utils.NewReader(v1)
-	readerV2 := utils.NewReader(v2)
-	v1Bytes := utils.UnsafeAdvanceReader(readerV1, readerV1.VariableUint())
-	v2Bytes := utils.UnsafeAdvanceReader(readerV2, readerV2.VariableUint())
-	return bytes.Compare(v1Bytes, v2Bytes)
+	return bytes.Compare(serializedStringBytes(v1), serializedStringBytes(v2))
+}
+
+// serializedStringBytes returns the string portion of a string serialized using utils.Writer. If the given byte slice is
+// not a valid serialized string, then the byte slice is returned as-is.
+func serializedStringBytes(v []byte) []byte {
+	reader := utils.NewReader(v)
+	length := reader.VariableUint()
+	if length != reader.RemainingBytes() {
+		return v
+	}
+	return utils.UnsafeAdvanceReader(reader, length)
 }
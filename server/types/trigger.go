@@ -0,0 +1,189 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/lib/pq/oid"
+)
+
+// Trigger is the type returned by trigger functions. Trigger functions return the row that the trigger operates on,
+// which is never displayed, so the values of this type are the rows themselves.
+var Trigger = TriggerType{}
+
+// TriggerType is the extended type implementation of the PostgreSQL trigger pseudo-type.
+type TriggerType struct{}
+
+var _ DoltgresType = TriggerType{}
+
+// BaseID implements the DoltgresType interface.
+func (t TriggerType) BaseID() DoltgresTypeBaseID {
+	return DoltgresTypeBaseID_Trigger
+}
+
+// CollationCoercibility implements the DoltgresType interface.
+func (t TriggerType) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// Compare implements the DoltgresType interface.
+func (t TriggerType) Compare(v1 any, v2 any) (int, error) {
+	return 0, fmt.Errorf("%s cannot compare values", t.String())
+}
+
+// Convert implements the DoltgresType interface.
+func (t TriggerType) Convert(val any) (any, sql.ConvertInRange, error) {
+	switch val := val.(type) {
+	case []any:
+		return val, sql.InRange, nil
+	case nil:
+		return nil, sql.InRange, nil
+	default:
+		return nil, sql.OutOfRange, sql.ErrInvalidType.New(t)
+	}
+}
+
+// Equals implements the DoltgresType interface.
+func (t TriggerType) Equals(otherType sql.Type) bool {
+	_, ok := otherType.(TriggerType)
+	return ok
+}
+
+// FormatSerializedValue implements the DoltgresType interface.
+func (t TriggerType) FormatSerializedValue(val []byte) (string, error) {
+	return "", fmt.Errorf("%s cannot format serialized values", t.String())
+}
+
+// FormatValue implements the DoltgresType interface.
+func (t TriggerType) FormatValue(val any) (string, error) {
+	if val == nil {
+		return "", nil
+	}
+	return t.IoOutput(val)
+}
+
+// GetSerializationID implements the DoltgresType interface.
+func (t TriggerType) GetSerializationID() SerializationID {
+	return SerializationID_Trigger
+}
+
+// IoInput implements the DoltgresType interface.
+func (t TriggerType) IoInput(input string) (any, error) {
+	return nil, fmt.Errorf("cannot accept a value of type %s", t.String())
+}
+
+// IoOutput implements the DoltgresType interface.
+func (t TriggerType) IoOutput(output any) (string, error) {
+	return "", fmt.Errorf("cannot display a value of type %s", t.String())
+}
+
+// IsUnbounded implements the DoltgresType interface.
+func (t TriggerType) IsUnbounded() bool {
+	return false
+}
+
+// MaxSerializedWidth implements the DoltgresType interface.
+func (t TriggerType) MaxSerializedWidth() types.ExtendedTypeSerializedWidth {
+	return types.ExtendedTypeSerializedWidth_Unbounded
+}
+
+// MaxTextResponseByteLength implements the DoltgresType interface.
+func (t TriggerType) MaxTextResponseByteLength(ctx *sql.Context) uint32 {
+	return math.MaxUint32
+}
+
+// OID implements the DoltgresType interface.
+func (t TriggerType) OID() uint32 {
+	return uint32(oid.T_trigger)
+}
+
+// Promote implements the DoltgresType interface.
+func (t TriggerType) Promote() sql.Type {
+	return t
+}
+
+// SerializedCompare implements the DoltgresType interface.
+func (t TriggerType) SerializedCompare(v1 []byte, v2 []byte) (int, error) {
+	return 0, fmt.Errorf("%s cannot compare serialized values", t.String())
+}
+
+// SQL implements the DoltgresType interface.
+func (t TriggerType) SQL(ctx *sql.Context, dest []byte, val any) (sqltypes.Value, error) {
+	if val == nil {
+		return sqltypes.NULL, nil
+	}
+	value, err := t.IoOutput(val)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+	return sqltypes.MakeTrusted(t.Type(), types.AppendAndSliceBytes(dest, []byte(value))), nil
+}
+
+// String implements the DoltgresType interface.
+func (t TriggerType) String() string {
+	return "trigger"
+}
+
+// ToArrayType implements the DoltgresType interface.
+func (t TriggerType) ToArrayType() DoltgresArrayType {
+	return Unknown
+}
+
+// Type implements the DoltgresType interface.
+func (t TriggerType) Type() query.Type {
+	return sqltypes.Text
+}
+
+// ValueType implements the DoltgresType interface.
+func (t TriggerType) ValueType() reflect.Type {
+	return reflect.TypeOf([]any{})
+}
+
+// Zero implements the DoltgresType interface.
+func (t TriggerType) Zero() any {
+	return []any{}
+}
+
+// SerializeType implements the DoltgresType interface.
+func (t TriggerType) SerializeType() ([]byte, error) {
+	return SerializationID_Trigger.ToByteSlice(0), nil
+}
+
+// deserializeType implements the DoltgresType interface.
+func (t TriggerType) deserializeType(version uint16, metadata []byte) (DoltgresType, error) {
+	switch version {
+	case 0:
+		return Trigger, nil
+	default:
+		return nil, fmt.Errorf("version %d is not yet supported for %s", version, t.String())
+	}
+}
+
+// SerializeValue implements the DoltgresType interface.
+func (t TriggerType) SerializeValue(val any) ([]byte, error) {
+	return nil, fmt.Errorf("%s cannot serialize values", t.String())
+}
+
+// DeserializeValue implements the DoltgresType interface.
+func (t TriggerType) DeserializeValue(val []byte) (any, error) {
+	return nil, fmt.Errorf("%s cannot deserialize values", t.String())
+}
@@ -0,0 +1,184 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/lib/pq/oid"
+)
+
+// Void is the type returned by functions that do not return a value, such as pg_sleep.
+var Void = VoidType{}
+
+// VoidType is the extended type implementation of the PostgreSQL void type.
+type VoidType struct{}
+
+var _ DoltgresType = VoidType{}
+
+// BaseID implements the DoltgresType interface.
+func (v VoidType) BaseID() DoltgresTypeBaseID {
+	return DoltgresTypeBaseID_Void
+}
+
+// CollationCoercibility implements the DoltgresType interface.
+func (v VoidType) CollationCoercibility(ctx *sql.Context) (collation sql.CollationID, coercibility byte) {
+	return sql.Collation_binary, 5
+}
+
+// Compare implements the DoltgresType interface.
+func (v VoidType) Compare(v1 any, v2 any) (int, error) {
+	// All void values are equal, so only NULLs affect the ordering
+	if v1 == nil && v2 == nil {
+		return 0, nil
+	} else if v1 != nil && v2 == nil {
+		return 1, nil
+	} else if v1 == nil && v2 != nil {
+		return -1, nil
+	}
+	return 0, nil
+}
+
+// Convert implements the DoltgresType interface.
+func (v VoidType) Convert(val any) (any, sql.ConvertInRange, error) {
+	if val == nil {
+		return nil, sql.InRange, nil
+	}
+	return "", sql.InRange, nil
+}
+
+// Equals implements the DoltgresType interface.
+func (v VoidType) Equals(otherType sql.Type) bool {
+	_, ok := otherType.(VoidType)
+	return ok
+}
+
+// FormatSerializedValue implements the DoltgresType interface.
+func (v VoidType) FormatSerializedValue(val []byte) (string, error) {
+	return "", fmt.Errorf("%s cannot format serialized values", v.String())
+}
+
+// FormatValue implements the DoltgresType interface.
+func (v VoidType) FormatValue(val any) (string, error) {
+	if val == nil {
+		return "", nil
+	}
+	return v.IoOutput(val)
+}
+
+// GetSerializationID implements the DoltgresType interface.
+func (v VoidType) GetSerializationID() SerializationID {
+	return SerializationID_Invalid
+}
+
+// IoInput implements the DoltgresType interface.
+func (v VoidType) IoInput(input string) (any, error) {
+	return "", nil
+}
+
+// IoOutput implements the DoltgresType interface.
+func (v VoidType) IoOutput(output any) (string, error) {
+	// Void values are always written as an empty string
+	return "", nil
+}
+
+// IsUnbounded implements the DoltgresType interface.
+func (v VoidType) IsUnbounded() bool {
+	return false
+}
+
+// MaxSerializedWidth implements the DoltgresType interface.
+func (v VoidType) MaxSerializedWidth() types.ExtendedTypeSerializedWidth {
+	return types.ExtendedTypeSerializedWidth_Unbounded
+}
+
+// MaxTextResponseByteLength implements the DoltgresType interface.
+func (v VoidType) MaxTextResponseByteLength(ctx *sql.Context) uint32 {
+	return math.MaxUint32
+}
+
+// OID implements the DoltgresType interface.
+func (v VoidType) OID() uint32 {
+	return uint32(oid.T_void)
+}
+
+// Promote implements the DoltgresType interface.
+func (v VoidType) Promote() sql.Type {
+	return v
+}
+
+// SerializedCompare implements the DoltgresType interface.
+func (v VoidType) SerializedCompare(v1 []byte, v2 []byte) (int, error) {
+	return 0, fmt.Errorf("%s cannot compare serialized values", v.String())
+}
+
+// SQL implements the DoltgresType interface.
+func (v VoidType) SQL(ctx *sql.Context, dest []byte, val any) (sqltypes.Value, error) {
+	if val == nil {
+		return sqltypes.NULL, nil
+	}
+	return sqltypes.MakeTrusted(v.Type(), types.AppendAndSliceBytes(dest, nil)), nil
+}
+
+// String implements the DoltgresType interface.
+func (v VoidType) String() string {
+	return "void"
+}
+
+// ToArrayType implements the DoltgresType interface.
+func (v VoidType) ToArrayType() DoltgresArrayType {
+	return Unknown
+}
+
+// Type implements the DoltgresType interface.
+func (v VoidType) Type() query.Type {
+	return sqltypes.Text
+}
+
+// ValueType implements the DoltgresType interface.
+func (v VoidType) ValueType() reflect.Type {
+	return reflect.TypeOf("")
+}
+
+// Zero implements the DoltgresType interface.
+func (v VoidType) Zero() any {
+	return ""
+}
+
+// SerializeType implements the DoltgresType interface.
+func (v VoidType) SerializeType() ([]byte, error) {
+	return nil, fmt.Errorf("%s cannot be serialized", v.String())
+}
+
+// deserializeType implements the DoltgresType interface.
+func (v VoidType) deserializeType(version uint16, metadata []byte) (DoltgresType, error) {
+	return nil, fmt.Errorf("%s cannot be deserialized", v.String())
+}
+
+// SerializeValue implements the DoltgresType interface.
+func (v VoidType) SerializeValue(val any) ([]byte, error) {
+	return nil, fmt.Errorf("%s cannot serialize values", v.String())
+}
+
+// DeserializeValue implements the DoltgresType interface.
+func (v VoidType) DeserializeValue(val []byte) (any, error) {
+	return nil, fmt.Errorf("%s cannot deserialize values", v.String())
+}
@@ -16,7 +16,9 @@ package servercfg
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -61,17 +63,27 @@ const (
 	DefaultCfgDir                  = ".doltcfg"
 	DefaultPrivilegeFilePath       = "privileges.db"
 	DefaultBranchControlFilePath   = "branch_control.db"
+	DefaultKillSwitchFilePath      = "kill_switch.json"
+	DefaultAuthFilePath            = "auth.db"
 	DefaultMetricsHost             = ""
 	DefaultMetricsPort             = -1
 	DefaultAllowCleartextPasswords = false
 	DefaultUnixSocketFilePath      = "/tmp/mysql.sock"
 	DefaultMaxLoggedQueryLen       = 0
 	DefaultEncodeLoggedQuery       = false
+	DefaultServerVersion           = "15.0"
+	DefaultLogFormat               = "text"
+	DefaultLogStatement            = "none"
+	DefaultLogMinDurationStatement = -1
 )
 
 // DOLTGRES_DATA_DIR is an environment variable that defines the location of DoltgreSQL databases
 const DOLTGRES_DATA_DIR = "DOLTGRES_DATA_DIR"
 
+// DOLTGRES_DISABLE_TELEMETRY is an environment variable that, when set to a true value, disables all telemetry in the
+// same way as the disabled field of the telemetry config
+const DOLTGRES_DISABLE_TELEMETRY = "DOLTGRES_DISABLE_TELEMETRY"
+
 // DOLTGRES_DATA_DIR_DEFAULT is the portion to append to the user's home directory if DOLTGRES_DATA_DIR has not been specified
 const DOLTGRES_DATA_DIR_DEFAULT = "doltgres/databases"
 
@@ -90,6 +102,27 @@ type PostgresReplicationConfig struct {
 	PostgresDatabase      *string `yaml:"postgres_database,omitempty" minver:"0.7.4"`
 	PostgresPort          *int    `yaml:"postgres_port,omitempty" minver:"0.7.4"`
 	SlotName              *string `yaml:"slot_name,omitempty" minver:"0.7.4"`
+	// SSLMode is the sslmode used when connecting to the primary, using the same values as libpq, such as "require" or
+	// "verify-full".
+	SSLMode *string `yaml:"ssl_mode,omitempty" minver:"TBD"`
+	// SSLCert is a file system path to the client certificate presented to the primary, for mutual TLS.
+	SSLCert *string `yaml:"ssl_cert,omitempty" minver:"TBD"`
+	// SSLKey is a file system path to the private key of SSLCert.
+	SSLKey *string `yaml:"ssl_key,omitempty" minver:"TBD"`
+	// SSLRootCert is a file system path to the CA certificates that the primary's certificate is verified against.
+	SSLRootCert *string `yaml:"ssl_root_cert,omitempty" minver:"TBD"`
+	// Database is the Doltgres database that replicated changes are applied to. Defaults to "doltgres".
+	Database *string `yaml:"database,omitempty" minver:"TBD"`
+	// PublicationNames are the publications on the primary that are subscribed to. Names are matched exactly, as though
+	// they were quoted identifiers. Defaults to the publication with the same name as the slot.
+	PublicationNames []string `yaml:"publication_names,omitempty" minver:"TBD"`
+	// CreateSlot creates the replication slot on the primary when it does not yet exist.
+	CreateSlot *bool `yaml:"create_slot,omitempty" minver:"TBD"`
+	// DoltCommit creates a Dolt commit for every replicated transaction.
+	DoltCommit *bool `yaml:"dolt_commit,omitempty" minver:"TBD"`
+	// DoltCommitMessage is the message template of the Dolt commits created for replicated transactions. The {xid},
+	// {lsn}, and {commit_time} placeholders are replaced by the values of the primary's transaction.
+	DoltCommitMessage *string `yaml:"dolt_commit_message,omitempty" minver:"TBD"`
 }
 
 // BehaviorYAMLConfig contains server configuration regarding how the server should behave
@@ -105,6 +138,28 @@ type DoltgresBehaviorConfig struct {
 	// DoltTransactionCommit enables the @@dolt_transaction_commit system variable, which
 	// automatically creates a Dolt commit when any SQL transaction is committed.
 	DoltTransactionCommit *bool `yaml:"dolt_transaction_commit,omitempty" minver:"0.7.4"`
+	// DoltTransactionCommitMessage is the default message template of the commits created by DoltTransactionCommit.
+	// The placeholders {user}, {application_name}, and {database} are replaced by the values of the committing session.
+	DoltTransactionCommitMessage *string `yaml:"dolt_transaction_commit_message,omitempty" minver:"TBD"`
+	// ServerVersion is the version of Postgres that the server reports to clients, such as "15.4". Some drivers and
+	// tools enable or disable features depending on the reported version.
+	ServerVersion *string `yaml:"server_version,omitempty" minver:"TBD"`
+	// InMemory runs the server using memory as the backing store, so that nothing is written to the data directory.
+	// All databases are lost when the server stops, unless a snapshot directory has been given.
+	InMemory *bool `yaml:"in_memory,omitempty" minver:"TBD"`
+	// SnapshotDir is a file system path that every database is backed up to when an in-memory server stops. Each
+	// database is written as a Dolt backup to a directory of the same name.
+	SnapshotDir *string `yaml:"snapshot_dir,omitempty" minver:"TBD"`
+	// StatementTimeout is the default value of the statement_timeout parameter, in milliseconds. Statements that run
+	// for longer are canceled. Zero, the default, disables the timeout.
+	StatementTimeout *uint64 `yaml:"statement_timeout,omitempty" minver:"TBD"`
+	// LockTimeout is the default value of the lock_timeout parameter, in milliseconds. Statements that wait on a lock
+	// for longer are canceled. Zero, the default, disables the timeout.
+	LockTimeout *uint64 `yaml:"lock_timeout,omitempty" minver:"TBD"`
+	// Parameters are the defaults of configuration parameters, keyed by name, such as "DateStyle: ISO, DMY". Values
+	// are given as they would be given to SET, and override statement_timeout and lock_timeout. Sessions may still
+	// override any default using SET.
+	Parameters map[string]string `yaml:"parameters,omitempty" minver:"TBD"`
 }
 
 type DoltgresUserConfig struct {
@@ -122,12 +177,33 @@ type DoltgresListenerConfig struct {
 	TLSKey *string `yaml:"tls_key,omitempty" minver:"0.7.4"`
 	// TLSCert is a file system path to a TLS certificate chain in PEM format.
 	TLSCert *string `yaml:"tls_cert,omitempty" minver:"0.7.4"`
+	// TLSCA is a file system path to one or more CA certificates in PEM format. Client certificates are verified
+	// against these when a client presents one.
+	TLSCA *string `yaml:"tls_ca,omitempty" minver:"TBD"`
+	// RequireClientCert turns away TLS clients that do not present a certificate signed by TLSCA, for mutual TLS.
+	RequireClientCert *bool `yaml:"require_client_cert,omitempty" minver:"TBD"`
+	// TLSMinVersion is the minimum TLS version that clients may use, which is one of "TLSv1", "TLSv1.1", "TLSv1.2", or
+	// "TLSv1.3". Defaults to "TLSv1.2".
+	TLSMinVersion *string `yaml:"tls_min_version,omitempty" minver:"TBD"`
+	// TLSMaxVersion is the maximum TLS version that clients may use, using the same values as TLSMinVersion. Defaults to
+	// the newest version that is supported.
+	TLSMaxVersion *string `yaml:"tls_max_version,omitempty" minver:"TBD"`
+	// TLSCipherSuites are the names of the cipher suites that may be used with TLS 1.2 and earlier, such as
+	// "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256". The cipher suites of TLS 1.3 are not configurable. Defaults to a secure
+	// set of cipher suites.
+	TLSCipherSuites []string `yaml:"tls_cipher_suites,omitempty" minver:"TBD"`
 	// RequireSecureTransport can enable a mode where non-TLS connections are turned away.
 	RequireSecureTransport *bool `yaml:"require_secure_transport,omitempty" minver:"0.7.4"`
 	// AllowCleartextPasswords enables use of cleartext passwords.
 	AllowCleartextPasswords *bool `yaml:"allow_cleartext_passwords,omitempty" minver:"0.7.4"`
 	// Socket is unix socket file path
 	Socket *string `yaml:"socket,omitempty" minver:"0.7.4"`
+	// MaxConnections is the maximum number of concurrent client connections. Defaults to 100, matching Postgres.
+	MaxConnections *uint64 `yaml:"max_connections,omitempty" minver:"TBD"`
+	// MaxConnectionsPerUser limits the number of concurrent connections for each of the given users.
+	MaxConnectionsPerUser map[string]uint64 `yaml:"max_connections_per_user,omitempty" minver:"TBD"`
+	// MaxConnectionsPerDatabase limits the number of concurrent connections to each of the given databases.
+	MaxConnectionsPerDatabase map[string]uint64 `yaml:"max_connections_per_database,omitempty" minver:"TBD"`
 }
 
 // DoltgresPerformanceConfig contains configuration parameters for performance tweaking
@@ -141,9 +217,206 @@ type DoltgesMetricsConfig struct {
 	Port   *int              `yaml:"port,omitempty" minver:"0.7.4"`
 }
 
+// DoltgresRemotesapiConfig configures the Dolt remotesapi endpoint, which allows Dolt and Doltgres instances to clone,
+// fetch from, and push to the server's databases. The endpoint is only served when a port is given.
 type DoltgresRemotesapiConfig struct {
 	Port     *int  `yaml:"port,omitempty" minver:"0.7.4"`
 	ReadOnly *bool `yaml:"read_only,omitempty" minver:"0.7.4"`
+	// Host is the address that the endpoint listens on. Defaults to every address.
+	Host *string `yaml:"host,omitempty" minver:"TBD"`
+	// TLSKey is a file system path to an unencrypted private TLS key in PEM format. When neither TLSKey nor TLSCert
+	// are set, the endpoint uses the listener's key and certificate.
+	TLSKey *string `yaml:"tls_key,omitempty" minver:"TBD"`
+	// TLSCert is a file system path to a TLS certificate chain in PEM format.
+	TLSCert *string `yaml:"tls_cert,omitempty" minver:"TBD"`
+}
+
+// DoltgresHBAConfig is a single client authentication rule, which is modeled on a line of Postgres' pg_hba.conf file.
+// Rules are checked in order, and the first rule that matches the connection's host, database, and user decides the
+// authentication method.
+type DoltgresHBAConfig struct {
+	// Host is "all", "local" (for Unix socket connections), an IP address, or a CIDR range such as "10.0.0.0/8".
+	// Defaults to "all".
+	Host *string `yaml:"host,omitempty" minver:"TBD"`
+	// Database is "all", or a comma-separated list of database names. Defaults to "all".
+	Database *string `yaml:"database,omitempty" minver:"TBD"`
+	// User is "all", or a comma-separated list of user names. Defaults to "all".
+	User *string `yaml:"user,omitempty" minver:"TBD"`
+	// Method is one of "trust", "reject", "password", "md5", or "scram-sha-256".
+	Method *string `yaml:"method,omitempty" minver:"TBD"`
+}
+
+// DoltgresKafkaSinkConfig configures the publishing of committed row changes to Kafka.
+type DoltgresKafkaSinkConfig struct {
+	// Brokers are the addresses of the Kafka brokers, such as "localhost:9092".
+	Brokers []string `yaml:"brokers,omitempty" minver:"TBD"`
+	// Topic is the template of the topic that each table's changes are published to. The placeholders {database},
+	// {branch}, {schema}, and {table} are replaced. Defaults to "doltgres.{database}.{branch}.{schema}.{table}".
+	Topic *string `yaml:"topic,omitempty" minver:"TBD"`
+	// KeyFormat is the serialization of message keys, either "json" or "avro". Defaults to "json".
+	KeyFormat *string `yaml:"key_format,omitempty" minver:"TBD"`
+	// ValueFormat is the serialization of message values, either "json" or "avro". Defaults to "json".
+	ValueFormat *string `yaml:"value_format,omitempty" minver:"TBD"`
+	// Subscriptions decide which tables have their changes published. Nothing is published without a subscription.
+	Subscriptions []DoltgresKafkaSubscriptionConfig `yaml:"subscriptions,omitempty" minver:"TBD"`
+}
+
+// DoltgresKafkaSubscriptionConfig matches the tables whose committed changes are published. Each field is a glob
+// pattern, and an omitted field matches everything.
+type DoltgresKafkaSubscriptionConfig struct {
+	Database *string `yaml:"database,omitempty" minver:"TBD"`
+	Branch   *string `yaml:"branch,omitempty" minver:"TBD"`
+	// Table matches either the table's name or its schema-qualified name, such as "public.*".
+	Table *string `yaml:"table,omitempty" minver:"TBD"`
+}
+
+// DoltgresSnapshotExportConfig configures the scheduled export of branch snapshots to an object store.
+type DoltgresSnapshotExportConfig struct {
+	// Destination is the template of the location that each snapshot is written to, such as
+	// "s3://bucket/exports/{database}/{branch}/{commit}". Destinations without a scheme are local directories. The
+	// placeholders {database}, {branch}, {commit}, and {timestamp} are replaced.
+	Destination *string `yaml:"destination,omitempty" minver:"TBD"`
+	// Format is the format of the exported tables, either "csv" or "parquet". Defaults to "csv".
+	Format *string `yaml:"format,omitempty" minver:"TBD"`
+	// Interval is the time between exports, such as "15m". Defaults to one hour.
+	Interval *string `yaml:"interval,omitempty" minver:"TBD"`
+	// Databases are glob patterns of the databases to export. All databases are exported when omitted.
+	Databases []string `yaml:"databases,omitempty" minver:"TBD"`
+	// Branches are glob patterns of the branches to export. All branches are exported when omitted.
+	Branches []string `yaml:"branches,omitempty" minver:"TBD"`
+	// Region is the region of the S3 bucket, which is otherwise taken from the environment.
+	Region *string `yaml:"region,omitempty" minver:"TBD"`
+	// Endpoint overrides the S3 endpoint, for use with S3-compatible object stores.
+	Endpoint *string `yaml:"endpoint,omitempty" minver:"TBD"`
+}
+
+// DoltgresBackupConfig configures the location that databases are backed up to, which dolt_backup('sync') and scheduled
+// backups write to.
+type DoltgresBackupConfig struct {
+	// Destination is the template of the location that each database is backed up to, which is either a local directory
+	// or a remote URL such as "aws://[table:bucket]/backups/{database}". The placeholder {database} is replaced, and
+	// when it is omitted, each database is backed up to a directory of the destination named after the database.
+	Destination *string `yaml:"destination,omitempty" minver:"TBD"`
+	// Interval is the time between scheduled backups, such as "6h". Backups are only taken by calling
+	// dolt_backup('sync') when omitted.
+	Interval *string `yaml:"interval,omitempty" minver:"TBD"`
+	// Databases are glob patterns of the databases that scheduled backups include. All databases are included when
+	// omitted.
+	Databases []string `yaml:"databases,omitempty" minver:"TBD"`
+}
+
+// DoltgresReadReplicaConfig turns the server into a read-only replica of databases that are pushed to a Dolt remote.
+// The replica fetches the remote on an interval, or whenever its webhook is called, and fast-forwards its branches.
+type DoltgresReadReplicaConfig struct {
+	// RemoteURL is the template of the remote that each database replicates from, such as
+	// "http://primary:50051/{database}" or "file:///remotes/{database}". The placeholder {database} is replaced, and
+	// when it is omitted, the name of the database is appended to the URL as a path element.
+	RemoteURL *string `yaml:"remote_url,omitempty" minver:"TBD"`
+	// Databases are the names of the databases to replicate. Databases that do not exist are cloned from the remote
+	// when the server starts.
+	Databases []string `yaml:"databases,omitempty" minver:"TBD"`
+	// Branches are glob patterns of the branches to replicate. All branches are replicated when omitted.
+	Branches []string `yaml:"branches,omitempty" minver:"TBD"`
+	// Interval is the time between fetches, such as "30s". Defaults to one minute.
+	Interval *string `yaml:"interval,omitempty" minver:"TBD"`
+	// WebhookAddress is the address, such as "0.0.0.0:8080", of an HTTP listener that fetches the remote immediately
+	// when sent a POST request to /replicate. A single database may be fetched using the "database" query parameter.
+	WebhookAddress *string `yaml:"webhook_address,omitempty" minver:"TBD"`
+}
+
+// DoltgresPushReplicationConfig configures the remotes that every new commit is pushed to, which mirrors Dolt's
+// dolt_replicate_to_remote and related system variables.
+type DoltgresPushReplicationConfig struct {
+	// Remotes are the templates of the remotes that each database's commits are pushed to, such as
+	// "aws://[table:bucket]/replicas/{database}" or "file:///replicas/{database}". The placeholder {database} is
+	// replaced, and when it is omitted, the name of the database is appended to the URL as a path element.
+	Remotes []string `yaml:"remotes,omitempty" minver:"TBD"`
+	// Databases are glob patterns of the databases that are replicated. All databases are replicated when omitted.
+	Databases []string `yaml:"databases,omitempty" minver:"TBD"`
+	// Async pushes commits in the background, rather than before the statement that made the commit returns.
+	Async *bool `yaml:"async,omitempty" minver:"TBD"`
+	// FailurePolicy is either "error", "warn", or "ignore". With "error", the server does not start, and databases
+	// cannot be created, when a remote cannot be loaded. With "warn", such databases are logged and not replicated.
+	// Failed pushes are always logged, and with either policy the client that made the commit receives a warning when
+	// pushing synchronously. With "ignore", failures are only logged. Defaults to "error".
+	FailurePolicy *string `yaml:"failure_policy,omitempty" minver:"TBD"`
+}
+
+// DoltgresTelemetryConfig configures the usage information that the server reports.
+type DoltgresTelemetryConfig struct {
+	// Disabled turns off all telemetry, including the usage events that are otherwise sent when the server starts and
+	// periodically while it runs. This takes precedence over every other field.
+	Disabled *bool `yaml:"disabled,omitempty" minver:"TBD"`
+	// Enabled opts in to detailed usage reports, which contain connection counts, feature usage, and counts of errors
+	// by SQLSTATE class. Reports never contain queries, data, or names.
+	Enabled *bool `yaml:"enabled,omitempty" minver:"TBD"`
+	// File is a local file that each report is appended to as a line of JSON, for self-hosted analysis.
+	File *string `yaml:"file,omitempty" minver:"TBD"`
+	// Endpoint is an HTTP URL that each report is sent to as a JSON POST request.
+	Endpoint *string `yaml:"endpoint,omitempty" minver:"TBD"`
+	// Interval is the time between reports, such as "1h". Defaults to 24 hours.
+	Interval *string `yaml:"interval,omitempty" minver:"TBD"`
+}
+
+// DoltgresLoggingConfig configures the server's log, along with the statements that are logged.
+type DoltgresLoggingConfig struct {
+	// Format is either "text" or "json". Defaults to "text".
+	Format *string `yaml:"format,omitempty" minver:"TBD"`
+	// File is the file that the log is written to. The log is written to standard error when omitted.
+	File *string `yaml:"file,omitempty" minver:"TBD"`
+	// LogStatement is the default value of the log_statement parameter, which is either "none", "ddl", "mod", or
+	// "all". Defaults to "none".
+	LogStatement *string `yaml:"log_statement,omitempty" minver:"TBD"`
+	// LogMinDurationStatement is the default value of the log_min_duration_statement parameter, in milliseconds.
+	// Statements that run for at least this long are logged along with their duration. Zero logs the duration of every
+	// statement, while -1, the default, disables the slow-query log.
+	LogMinDurationStatement *int64 `yaml:"log_min_duration_statement,omitempty" minver:"TBD"`
+	// MaxSizeMB is the size, in megabytes, that the file may reach before it is rotated. Zero, the default, disables
+	// rotation.
+	MaxSizeMB *int `yaml:"max_size_mb,omitempty" minver:"TBD"`
+	// MaxBackups is the number of rotated files that are retained. Zero, the default, retains every rotated file.
+	MaxBackups *int `yaml:"max_backups,omitempty" minver:"TBD"`
+	// MaxAgeDays is the number of days that rotated files are retained. Zero, the default, retains files regardless
+	// of their age.
+	MaxAgeDays *int `yaml:"max_age_days,omitempty" minver:"TBD"`
+}
+
+// DoltgresAuditConfig configures the audit log, which records who ran each DDL statement, privilege change, and Dolt
+// version control operation, along with whether it succeeded. Events may be written to any combination of a file,
+// syslog, and a table.
+type DoltgresAuditConfig struct {
+	// File is a file that each event is appended to as a line of JSON.
+	File *string `yaml:"file,omitempty" minver:"TBD"`
+	// Syslog sends each event to syslog as a line of JSON. This is either "local" for the local syslog daemon, or the
+	// URL of a remote daemon, such as "udp://logs:514" or "tcp://logs:514".
+	Syslog *string `yaml:"syslog,omitempty" minver:"TBD"`
+	// SyslogTag is the tag of each message sent to syslog. Defaults to "doltgres".
+	SyslogTag *string `yaml:"syslog_tag,omitempty" minver:"TBD"`
+	// Database writes each event to the audit_log table of the named database. The database and table are
+	// created when they do not exist.
+	Database *string `yaml:"database,omitempty" minver:"TBD"`
+	// DoltCommit commits the audit table after events are written to it, so that the log is versioned. This should be
+	// used with a database that is dedicated to the audit log, as every staged change is committed.
+	DoltCommit *bool `yaml:"dolt_commit,omitempty" minver:"TBD"`
+	// Classes are the classes of statements that are audited, which are "ddl", "privilege", and "dolt". Every class is
+	// audited when omitted.
+	Classes []string `yaml:"classes,omitempty" minver:"TBD"`
+}
+
+// DoltgresHealthConfig configures the HTTP endpoints that orchestrators, such as Kubernetes, use to probe the server.
+// They're served on their own port, so that they may be probed without credentials. /healthz checks that the listener
+// accepts connections and that each database's storage may be read, while /readyz also checks that the server has
+// finished starting, and that a replica is within the maximum replication lag. Each responds with 503 when a check
+// fails, along with the result of each check as JSON.
+type DoltgresHealthConfig struct {
+	// Port is the port that the endpoints are served on. The endpoints are only served when a port is given.
+	Port *int `yaml:"port,omitempty" minver:"TBD"`
+	// Host is the address that the endpoints listen on. Defaults to every address.
+	Host *string `yaml:"host,omitempty" minver:"TBD"`
+	// MaxReplicationLag is the longest time, such as "30s", that a read replica or a Postgres replica may go without
+	// matching its primary before the server is no longer ready. This must be longer than the read replica's interval.
+	// Defaults to five minutes.
+	MaxReplicationLag *string `yaml:"max_replication_lag,omitempty" minver:"TBD"`
 }
 
 type DoltgresUserSessionVars struct {
@@ -171,6 +444,33 @@ type DoltgresConfig struct {
 	SystemVariables map[string]interface{}    `yaml:"system_variables,omitempty" minver:"0.7.4"`
 	Jwks            []servercfg.JwksConfig    `yaml:"jwks,omitempty" minver:"0.7.4"`
 	GoldenMysqlConn *string                   `yaml:"golden_mysql_conn,omitempty" minver:"0.7.4"`
+	// KillSwitchFile is the file that query kill switch rules are persisted to. When not set, the rules are stored in the
+	// config directory, or only kept in memory when the server is running in memory.
+	KillSwitchFile *string `yaml:"kill_switch_file,omitempty" minver:"TBD"`
+	// AuthFile is the file that roles and privileges are persisted to. When not set, they are stored in the config
+	// directory, or only kept in memory when the server is running in memory.
+	AuthFile *string `yaml:"auth_file,omitempty" minver:"TBD"`
+	// HBA contains the client authentication rules. When no rules are given, all connections are trusted. The server
+	// connects to itself as the configured user when creating the default database, so the rules must permit it.
+	HBA []DoltgresHBAConfig `yaml:"hba,omitempty" minver:"TBD"`
+	// KafkaSink publishes committed row changes to Kafka when set.
+	KafkaSink *DoltgresKafkaSinkConfig `yaml:"kafka_sink,omitempty" minver:"TBD"`
+	// SnapshotExport periodically exports a snapshot of each branch when set.
+	SnapshotExport *DoltgresSnapshotExportConfig `yaml:"snapshot_export,omitempty" minver:"TBD"`
+	// Telemetry configures the usage information that the server reports.
+	Telemetry *DoltgresTelemetryConfig `yaml:"telemetry,omitempty" minver:"TBD"`
+	// Backup configures the location that databases are backed up to, and optionally a schedule for backing them up.
+	Backup *DoltgresBackupConfig `yaml:"backup,omitempty" minver:"TBD"`
+	// ReadReplica makes the server a read-only replica of databases on a Dolt remote when set.
+	ReadReplica *DoltgresReadReplicaConfig `yaml:"read_replica,omitempty" minver:"TBD"`
+	// PushReplication pushes every new commit to one or more remotes when set.
+	PushReplication *DoltgresPushReplicationConfig `yaml:"push_replication,omitempty" minver:"TBD"`
+	// Logging configures the format and destination of the log, along with the statements that are logged.
+	Logging *DoltgresLoggingConfig `yaml:"logging,omitempty" minver:"TBD"`
+	// Audit records DDL, privilege changes, and Dolt version control operations when set.
+	Audit *DoltgresAuditConfig `yaml:"audit,omitempty" minver:"TBD"`
+	// Health serves the liveness and readiness endpoints when set.
+	Health *DoltgresHealthConfig `yaml:"health,omitempty" minver:"TBD"`
 
 	PostgresReplicationConfig *PostgresReplicationConfig `yaml:"postgres_replication,omitempty" minver:"0.7.4"`
 }
@@ -193,6 +493,71 @@ func (cfg *DoltgresConfig) DoltTransactionCommit() bool {
 	return *cfg.BehaviorConfig.DoltTransactionCommit
 }
 
+// DoltTransactionCommitMessage returns the default message template of the commits created for each transaction. An
+// empty template uses the server's default.
+func (cfg *DoltgresConfig) DoltTransactionCommitMessage() string {
+	if cfg.BehaviorConfig == nil || cfg.BehaviorConfig.DoltTransactionCommitMessage == nil {
+		return ""
+	}
+
+	return *cfg.BehaviorConfig.DoltTransactionCommitMessage
+}
+
+// ServerVersion returns the version of Postgres that the server reports to clients.
+func (cfg *DoltgresConfig) ServerVersion() string {
+	if cfg.BehaviorConfig == nil || cfg.BehaviorConfig.ServerVersion == nil {
+		return DefaultServerVersion
+	}
+
+	return *cfg.BehaviorConfig.ServerVersion
+}
+
+// InMemory returns whether the server uses memory as the backing store rather than the data directory.
+func (cfg *DoltgresConfig) InMemory() bool {
+	if cfg.BehaviorConfig == nil || cfg.BehaviorConfig.InMemory == nil {
+		return false
+	}
+
+	return *cfg.BehaviorConfig.InMemory
+}
+
+// SnapshotDir returns the directory that an in-memory server backs up its databases to when it stops. Returns an empty
+// string when no snapshot should be taken.
+func (cfg *DoltgresConfig) SnapshotDir() string {
+	if cfg.BehaviorConfig == nil || cfg.BehaviorConfig.SnapshotDir == nil {
+		return ""
+	}
+
+	return *cfg.BehaviorConfig.SnapshotDir
+}
+
+// StatementTimeout returns the default statement timeout of each session, in milliseconds.
+func (cfg *DoltgresConfig) StatementTimeout() uint64 {
+	if cfg.BehaviorConfig == nil || cfg.BehaviorConfig.StatementTimeout == nil {
+		return 0
+	}
+
+	return *cfg.BehaviorConfig.StatementTimeout
+}
+
+// LockTimeout returns the default lock timeout of each session, in milliseconds.
+func (cfg *DoltgresConfig) LockTimeout() uint64 {
+	if cfg.BehaviorConfig == nil || cfg.BehaviorConfig.LockTimeout == nil {
+		return 0
+	}
+
+	return *cfg.BehaviorConfig.LockTimeout
+}
+
+// Parameters returns the defaults of configuration parameters, keyed by the parameter's name.
+func (cfg *DoltgresConfig) Parameters() map[string]string {
+	if cfg.BehaviorConfig == nil {
+		return nil
+	}
+
+	return cfg.BehaviorConfig.Parameters
+}
+
 func (cfg *DoltgresConfig) DataDir() string {
 	if cfg.DataDirStr == nil {
 		return ""
@@ -289,7 +654,29 @@ func (cfg *DoltgresConfig) LogLevel() servercfg.LogLevel {
 }
 
 func (cfg *DoltgresConfig) MaxConnections() uint64 {
-	return 0
+	if cfg.ListenerConfig == nil || cfg.ListenerConfig.MaxConnections == nil {
+		return DefaultMaxConnections
+	}
+
+	return *cfg.ListenerConfig.MaxConnections
+}
+
+// MaxConnectionsPerUser returns the connection limits of specific users, keyed by the user's name.
+func (cfg *DoltgresConfig) MaxConnectionsPerUser() map[string]uint64 {
+	if cfg.ListenerConfig == nil {
+		return nil
+	}
+
+	return cfg.ListenerConfig.MaxConnectionsPerUser
+}
+
+// MaxConnectionsPerDatabase returns the connection limits of specific databases, keyed by the database's name.
+func (cfg *DoltgresConfig) MaxConnectionsPerDatabase() map[string]uint64 {
+	if cfg.ListenerConfig == nil {
+		return nil
+	}
+
+	return cfg.ListenerConfig.MaxConnectionsPerDatabase
 }
 
 func (cfg *DoltgresConfig) QueryParallelism() int {
@@ -316,6 +703,46 @@ func (cfg *DoltgresConfig) TLSCert() string {
 	return *cfg.ListenerConfig.TLSCert
 }
 
+func (cfg *DoltgresConfig) TLSCA() string {
+	if cfg.ListenerConfig == nil || cfg.ListenerConfig.TLSCA == nil {
+		return ""
+	}
+
+	return *cfg.ListenerConfig.TLSCA
+}
+
+func (cfg *DoltgresConfig) RequireClientCert() bool {
+	if cfg.ListenerConfig == nil || cfg.ListenerConfig.RequireClientCert == nil {
+		return false
+	}
+
+	return *cfg.ListenerConfig.RequireClientCert
+}
+
+func (cfg *DoltgresConfig) TLSMinVersion() string {
+	if cfg.ListenerConfig == nil || cfg.ListenerConfig.TLSMinVersion == nil {
+		return ""
+	}
+
+	return *cfg.ListenerConfig.TLSMinVersion
+}
+
+func (cfg *DoltgresConfig) TLSMaxVersion() string {
+	if cfg.ListenerConfig == nil || cfg.ListenerConfig.TLSMaxVersion == nil {
+		return ""
+	}
+
+	return *cfg.ListenerConfig.TLSMaxVersion
+}
+
+func (cfg *DoltgresConfig) TLSCipherSuites() []string {
+	if cfg.ListenerConfig == nil {
+		return nil
+	}
+
+	return cfg.ListenerConfig.TLSCipherSuites
+}
+
 func (cfg *DoltgresConfig) RequireSecureTransport() bool {
 	if cfg.ListenerConfig == nil || cfg.ListenerConfig.RequireSecureTransport == nil {
 		return false
@@ -392,6 +819,100 @@ func (cfg *DoltgresConfig) BranchControlFilePath() string {
 	return *cfg.BranchControlFile
 }
 
+// KillSwitchFilePath returns the configured kill switch file, or an empty string if one was not configured.
+func (cfg *DoltgresConfig) KillSwitchFilePath() string {
+	if cfg.KillSwitchFile == nil {
+		return ""
+	}
+
+	return *cfg.KillSwitchFile
+}
+
+// AuthFilePath returns the configured file for roles and privileges, or an empty string if one was not configured.
+func (cfg *DoltgresConfig) AuthFilePath() string {
+	if cfg.AuthFile == nil {
+		return ""
+	}
+
+	return *cfg.AuthFile
+}
+
+// TelemetryDisabled returns whether all telemetry has been turned off, either through the config or through the
+// DOLTGRES_DISABLE_TELEMETRY environment variable.
+func (cfg *DoltgresConfig) TelemetryDisabled() bool {
+	if disabled, err := strconv.ParseBool(os.Getenv(DOLTGRES_DISABLE_TELEMETRY)); err == nil && disabled {
+		return true
+	}
+	return cfg.Telemetry != nil && cfg.Telemetry.Disabled != nil && *cfg.Telemetry.Disabled
+}
+
+// TelemetryEnabled returns whether detailed usage reports have been opted in to. This is always false when telemetry
+// has been disabled.
+func (cfg *DoltgresConfig) TelemetryEnabled() bool {
+	if cfg.TelemetryDisabled() || cfg.Telemetry == nil || cfg.Telemetry.Enabled == nil {
+		return false
+	}
+	return *cfg.Telemetry.Enabled
+}
+
+// LogFormat returns the format that the log is written in, which is "text" unless configured otherwise.
+func (cfg *DoltgresConfig) LogFormat() string {
+	if cfg.Logging == nil || cfg.Logging.Format == nil {
+		return DefaultLogFormat
+	}
+	return *cfg.Logging.Format
+}
+
+// LogFile returns the file that the log is written to, or an empty string if the log is written to standard error.
+func (cfg *DoltgresConfig) LogFile() string {
+	if cfg.Logging == nil || cfg.Logging.File == nil {
+		return ""
+	}
+	return *cfg.Logging.File
+}
+
+// LogStatement returns the default value of the log_statement parameter.
+func (cfg *DoltgresConfig) LogStatement() string {
+	if cfg.Logging == nil || cfg.Logging.LogStatement == nil {
+		return DefaultLogStatement
+	}
+	return *cfg.Logging.LogStatement
+}
+
+// LogMinDurationStatement returns the default value of the log_min_duration_statement parameter, in milliseconds.
+func (cfg *DoltgresConfig) LogMinDurationStatement() int64 {
+	if cfg.Logging == nil || cfg.Logging.LogMinDurationStatement == nil {
+		return DefaultLogMinDurationStatement
+	}
+	return *cfg.Logging.LogMinDurationStatement
+}
+
+// LogMaxSizeMB returns the size, in megabytes, that the log file may reach before it is rotated. Zero disables
+// rotation.
+func (cfg *DoltgresConfig) LogMaxSizeMB() int {
+	if cfg.Logging == nil || cfg.Logging.MaxSizeMB == nil {
+		return 0
+	}
+	return *cfg.Logging.MaxSizeMB
+}
+
+// LogMaxBackups returns the number of rotated log files that are retained. Zero retains every rotated file.
+func (cfg *DoltgresConfig) LogMaxBackups() int {
+	if cfg.Logging == nil || cfg.Logging.MaxBackups == nil {
+		return 0
+	}
+	return *cfg.Logging.MaxBackups
+}
+
+// LogMaxAgeDays returns the number of days that rotated log files are retained. Zero retains files regardless of their
+// age.
+func (cfg *DoltgresConfig) LogMaxAgeDays() int {
+	if cfg.Logging == nil || cfg.Logging.MaxAgeDays == nil {
+		return 0
+	}
+	return *cfg.Logging.MaxAgeDays
+}
+
 func (cfg *DoltgresConfig) UserVars() []servercfg.UserSessionVars {
 	var userVars []servercfg.UserSessionVars
 	for _, uv := range cfg.Vars {
@@ -416,6 +937,11 @@ func (cfg *DoltgresConfig) JwksConfig() []servercfg.JwksConfig {
 	return cfg.Jwks
 }
 
+// HBARules returns the client authentication rules, in the order that they should be checked.
+func (cfg *DoltgresConfig) HBARules() []DoltgresHBAConfig {
+	return cfg.HBA
+}
+
 func (cfg *DoltgresConfig) AllowCleartextPasswords() bool {
 	if cfg.ListenerConfig == nil || cfg.ListenerConfig.AllowCleartextPasswords == nil {
 		return false
@@ -448,6 +974,39 @@ func (cfg *DoltgresConfig) RemotesapiReadOnly() *bool {
 	return cfg.RemotesapiConfig.ReadOnly
 }
 
+// RemotesapiHost returns the address that the remotesapi endpoint listens on, or an empty string for every address.
+func (cfg *DoltgresConfig) RemotesapiHost() string {
+	if cfg.RemotesapiConfig == nil || cfg.RemotesapiConfig.Host == nil {
+		return ""
+	}
+
+	return *cfg.RemotesapiConfig.Host
+}
+
+// RemotesapiTLSKey returns the path to the remotesapi endpoint's TLS key, falling back to the listener's key when
+// neither the endpoint's key nor certificate have been set.
+func (cfg *DoltgresConfig) RemotesapiTLSKey() string {
+	if cfg.RemotesapiConfig == nil || (cfg.RemotesapiConfig.TLSKey == nil && cfg.RemotesapiConfig.TLSCert == nil) {
+		return cfg.TLSKey()
+	} else if cfg.RemotesapiConfig.TLSKey == nil {
+		return ""
+	}
+
+	return *cfg.RemotesapiConfig.TLSKey
+}
+
+// RemotesapiTLSCert returns the path to the remotesapi endpoint's TLS certificate, falling back to the listener's
+// certificate when neither the endpoint's key nor certificate have been set.
+func (cfg *DoltgresConfig) RemotesapiTLSCert() string {
+	if cfg.RemotesapiConfig == nil || (cfg.RemotesapiConfig.TLSKey == nil && cfg.RemotesapiConfig.TLSCert == nil) {
+		return cfg.TLSCert()
+	} else if cfg.RemotesapiConfig.TLSCert == nil {
+		return ""
+	}
+
+	return *cfg.RemotesapiConfig.TLSCert
+}
+
 func (cfg *DoltgresConfig) ClusterConfig() servercfg.ClusterConfig {
 	return nil
 }
@@ -463,6 +1022,8 @@ func (cfg *DoltgresConfig) ValueSet(value string) bool {
 	case writeTimeoutKey:
 		return cfg.ListenerConfig != nil && cfg.ListenerConfig.WriteTimeoutMillis != nil
 	case maxConnectionsKey:
+		// The limit is reported through the max_connections parameter when the server starts, which cannot be set
+		// globally afterward
 		return false
 	case eventSchedulerKey:
 		return false
@@ -490,6 +1051,7 @@ func DefaultServerConfig() *DoltgresConfig {
 		BehaviorConfig: &DoltgresBehaviorConfig{
 			ReadOnly:              Ptr(DefaultReadOnly),
 			DoltTransactionCommit: Ptr(DefaultDoltTransactionCommit),
+			ServerVersion:         Ptr(DefaultServerVersion),
 		},
 		UserConfig: &DoltgresUserConfig{
 			Name:     Ptr(DefaultUser),
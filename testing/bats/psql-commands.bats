@@ -20,13 +20,11 @@ teardown() {
 @test 'psql-commands: \l' {
     run query_server -c "\l"
     [ "$status" -eq 0 ]
-    [[ "$output" =~ "information_schema" ]] || false
     [[ "$output" =~ "postgres" ]] || false
     [[ "$output" =~ "doltgres" ]] || false
 }
 
 @test 'psql-commands: \dt' {
-    skip "Need to implement comparison operators"
     run query_server --csv -c "\dt"
     [ "$status" -eq 0 ]
     [[ "$output" =~ "public,test1,table,postgres" ]] || false
@@ -35,22 +33,35 @@ teardown() {
 }
 
 @test 'psql-commands: \d' {
-    skip "Need to implement comparison operators"
     run query_server --csv -c "\d"
     [ "$status" -eq 0 ]
     [[ "$output" =~ "public,test1,table,postgres" ]] || false
     [[ "$output" =~ "public,test2,table,postgres" ]] || false
-    [ "${#lines[@]}" -eq 3 ]
+    [[ "$output" =~ "public,testview,view,postgres" ]] || false
+    [ "${#lines[@]}" -eq 4 ]
 }
 
 @test 'psql-commands: \d table' {
-    skip "this command has not yet been implemented"
+    run query_server -c "\d test1"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ 'Table "public.test1"' ]] || false
+    [[ "$output" =~ "pk     | bigint   |           | not null |" ]] || false
+    [[ "$output" =~ "v1     | smallint |           |          |" ]] || false
+    [[ "$output" =~ '"test1_pkey" PRIMARY KEY, btree (pk)' ]] || false
+}
+
+@test 'psql-commands: \di' {
+    run query_server --csv -c "\di"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "public,test1_pkey,index,postgres,test1" ]] || false
+    [[ "$output" =~ "public,test2_pkey,index,postgres,test2" ]] || false
+    [ "${#lines[@]}" -eq 3 ]
 }
 
 @test 'psql-commands: \dn' {
     run query_server --csv -c "\dn"
     [ "$status" -eq 0 ]
-    [[ "$output" =~ "public,pg_database_owner" ]] || false
+    [[ "$output" =~ "public,postgres" ]] || false
     [ "${#lines[@]}" -eq 2 ]
 }
 
@@ -58,10 +69,15 @@ teardown() {
     run query_server -c "\df"
     [ "$status" -eq 0 ]
     [[ "$output" =~ "0 rows" ]] || false
+
+    query_server -c "CREATE FUNCTION add1(x INTEGER) RETURNS INTEGER AS \$\$ SELECT x + 1 \$\$ LANGUAGE sql;"
+    run query_server --csv -c "\df"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "public,add1,integer,x integer,func" ]] || false
+    [ "${#lines[@]}" -eq 2 ]
 }
 
 @test 'psql-commands: \dv' {
-    skip "need to reimplement CREATE VIEW support"
     run query_server --csv -c "\dv"
     [ "$status" -eq 0 ]
     [[ "$output" =~ "public,testview,view,postgres" ]] || false
@@ -69,5 +85,8 @@ teardown() {
 }
 
 @test 'psql-commands: \du' {
-    skip "users have not yet been implemented"
+    run query_server -c "\du"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "postgres" ]] || false
+    [[ "$output" =~ "Superuser" ]] || false
 }
@@ -34,7 +34,7 @@ func TestAlterGroup(t *testing.T) {
 		Unimplemented("ALTER GROUP CURRENT_ROLE DROP USER user_name , user_name"),
 		Unimplemented("ALTER GROUP CURRENT_USER DROP USER user_name , user_name"),
 		Unimplemented("ALTER GROUP SESSION_USER DROP USER user_name , user_name"),
-		Unimplemented("ALTER GROUP group_name RENAME TO new_name"),
+		Converts("ALTER GROUP group_name RENAME TO new_name"),
 	}
 	RunTests(t, tests)
 }
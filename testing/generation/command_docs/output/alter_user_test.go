@@ -18,147 +18,147 @@ import "testing"
 
 func TestAlterUser(t *testing.T) {
 	tests := []QueryParses{
-		Unimplemented("ALTER USER role_name SUPERUSER"),
+		Converts("ALTER USER role_name SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER SUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH SUPERUSER"),
+		Converts("ALTER USER role_name WITH SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH SUPERUSER"),
-		Unimplemented("ALTER USER role_name NOSUPERUSER"),
+		Converts("ALTER USER role_name NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH NOSUPERUSER"),
+		Converts("ALTER USER role_name WITH NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOSUPERUSER"),
-		Parses("ALTER USER role_name CREATEDB"),
+		Converts("ALTER USER role_name CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER CREATEDB"),
-		Parses("ALTER USER role_name WITH CREATEDB"),
+		Converts("ALTER USER role_name WITH CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEDB"),
-		Parses("ALTER USER role_name NOCREATEDB"),
+		Converts("ALTER USER role_name NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEDB"),
-		Parses("ALTER USER role_name WITH NOCREATEDB"),
+		Converts("ALTER USER role_name WITH NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEDB"),
-		Parses("ALTER USER role_name CREATEROLE"),
+		Converts("ALTER USER role_name CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER CREATEROLE"),
-		Parses("ALTER USER role_name WITH CREATEROLE"),
+		Converts("ALTER USER role_name WITH CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEROLE"),
-		Parses("ALTER USER role_name NOCREATEROLE"),
+		Converts("ALTER USER role_name NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEROLE"),
-		Parses("ALTER USER role_name WITH NOCREATEROLE"),
+		Converts("ALTER USER role_name WITH NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEROLE"),
-		Unimplemented("ALTER USER role_name INHERIT"),
+		Converts("ALTER USER role_name INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER INHERIT"),
-		Unimplemented("ALTER USER role_name WITH INHERIT"),
+		Converts("ALTER USER role_name WITH INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH INHERIT"),
-		Unimplemented("ALTER USER role_name NOINHERIT"),
+		Converts("ALTER USER role_name NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER NOINHERIT"),
-		Unimplemented("ALTER USER role_name WITH NOINHERIT"),
+		Converts("ALTER USER role_name WITH NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOINHERIT"),
-		Parses("ALTER USER role_name LOGIN"),
+		Converts("ALTER USER role_name LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER LOGIN"),
-		Parses("ALTER USER role_name WITH LOGIN"),
+		Converts("ALTER USER role_name WITH LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH LOGIN"),
-		Parses("ALTER USER role_name NOLOGIN"),
+		Converts("ALTER USER role_name NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER NOLOGIN"),
-		Parses("ALTER USER role_name WITH NOLOGIN"),
+		Converts("ALTER USER role_name WITH NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOLOGIN"),
-		Unimplemented("ALTER USER role_name REPLICATION"),
+		Converts("ALTER USER role_name REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER REPLICATION"),
-		Unimplemented("ALTER USER role_name WITH REPLICATION"),
+		Converts("ALTER USER role_name WITH REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH REPLICATION"),
-		Unimplemented("ALTER USER role_name NOREPLICATION"),
+		Converts("ALTER USER role_name NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER NOREPLICATION"),
-		Unimplemented("ALTER USER role_name WITH NOREPLICATION"),
+		Converts("ALTER USER role_name WITH NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOREPLICATION"),
-		Unimplemented("ALTER USER role_name BYPASSRLS"),
+		Converts("ALTER USER role_name BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER BYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH BYPASSRLS"),
+		Converts("ALTER USER role_name WITH BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH BYPASSRLS"),
-		Unimplemented("ALTER USER role_name NOBYPASSRLS"),
+		Converts("ALTER USER role_name NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH NOBYPASSRLS"),
+		Converts("ALTER USER role_name WITH NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name WITH CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name WITH CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER WITH CONNECTION LIMIT -1"),
-		Parses("ALTER USER role_name PASSWORD ' password '"),
+		Converts("ALTER USER role_name PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD ' password '"),
-		Parses("ALTER USER role_name WITH PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH ENCRYPTED PASSWORD ' password '"),
-		Parses("ALTER USER role_name PASSWORD NULL"),
+		Converts("ALTER USER role_name PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD NULL"),
-		Parses("ALTER USER role_name WITH PASSWORD NULL"),
+		Converts("ALTER USER role_name WITH PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD NULL"),
@@ -170,323 +170,323 @@ func TestAlterUser(t *testing.T) {
 		Unimplemented("ALTER USER CURRENT_ROLE WITH VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER WITH VALID UNTIL ' timestamp '"),
-		Unimplemented("ALTER USER role_name SUPERUSER SUPERUSER"),
+		Parses("ALTER USER role_name SUPERUSER SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE SUPERUSER SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER SUPERUSER SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER SUPERUSER SUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH SUPERUSER SUPERUSER"),
+		Parses("ALTER USER role_name WITH SUPERUSER SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH SUPERUSER SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH SUPERUSER SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH SUPERUSER SUPERUSER"),
-		Unimplemented("ALTER USER role_name NOSUPERUSER SUPERUSER"),
+		Parses("ALTER USER role_name NOSUPERUSER SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOSUPERUSER SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER NOSUPERUSER SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER NOSUPERUSER SUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH NOSUPERUSER SUPERUSER"),
+		Parses("ALTER USER role_name WITH NOSUPERUSER SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOSUPERUSER SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOSUPERUSER SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOSUPERUSER SUPERUSER"),
-		Unimplemented("ALTER USER role_name CREATEDB SUPERUSER"),
+		Converts("ALTER USER role_name CREATEDB SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEDB SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEDB SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER CREATEDB SUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH CREATEDB SUPERUSER"),
+		Converts("ALTER USER role_name WITH CREATEDB SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEDB SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEDB SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEDB SUPERUSER"),
-		Unimplemented("ALTER USER role_name NOCREATEDB SUPERUSER"),
+		Converts("ALTER USER role_name NOCREATEDB SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEDB SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEDB SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEDB SUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH NOCREATEDB SUPERUSER"),
+		Converts("ALTER USER role_name WITH NOCREATEDB SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEDB SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEDB SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEDB SUPERUSER"),
-		Unimplemented("ALTER USER role_name CREATEROLE SUPERUSER"),
+		Converts("ALTER USER role_name CREATEROLE SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEROLE SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEROLE SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER CREATEROLE SUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH CREATEROLE SUPERUSER"),
+		Converts("ALTER USER role_name WITH CREATEROLE SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEROLE SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEROLE SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEROLE SUPERUSER"),
-		Unimplemented("ALTER USER role_name NOCREATEROLE SUPERUSER"),
+		Converts("ALTER USER role_name NOCREATEROLE SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEROLE SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEROLE SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEROLE SUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH NOCREATEROLE SUPERUSER"),
+		Converts("ALTER USER role_name WITH NOCREATEROLE SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEROLE SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEROLE SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEROLE SUPERUSER"),
-		Unimplemented("ALTER USER role_name INHERIT SUPERUSER"),
+		Converts("ALTER USER role_name INHERIT SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE INHERIT SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER INHERIT SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER INHERIT SUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH INHERIT SUPERUSER"),
+		Converts("ALTER USER role_name WITH INHERIT SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH INHERIT SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH INHERIT SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH INHERIT SUPERUSER"),
-		Unimplemented("ALTER USER role_name NOINHERIT SUPERUSER"),
+		Converts("ALTER USER role_name NOINHERIT SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOINHERIT SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER NOINHERIT SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER NOINHERIT SUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH NOINHERIT SUPERUSER"),
+		Converts("ALTER USER role_name WITH NOINHERIT SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOINHERIT SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOINHERIT SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOINHERIT SUPERUSER"),
-		Unimplemented("ALTER USER role_name LOGIN SUPERUSER"),
+		Converts("ALTER USER role_name LOGIN SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE LOGIN SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER LOGIN SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER LOGIN SUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH LOGIN SUPERUSER"),
+		Converts("ALTER USER role_name WITH LOGIN SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH LOGIN SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH LOGIN SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH LOGIN SUPERUSER"),
-		Unimplemented("ALTER USER role_name NOLOGIN SUPERUSER"),
+		Converts("ALTER USER role_name NOLOGIN SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOLOGIN SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER NOLOGIN SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER NOLOGIN SUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH NOLOGIN SUPERUSER"),
+		Converts("ALTER USER role_name WITH NOLOGIN SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOLOGIN SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOLOGIN SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOLOGIN SUPERUSER"),
-		Unimplemented("ALTER USER role_name REPLICATION SUPERUSER"),
+		Converts("ALTER USER role_name REPLICATION SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE REPLICATION SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER REPLICATION SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER REPLICATION SUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH REPLICATION SUPERUSER"),
+		Converts("ALTER USER role_name WITH REPLICATION SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH REPLICATION SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH REPLICATION SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH REPLICATION SUPERUSER"),
-		Unimplemented("ALTER USER role_name NOREPLICATION SUPERUSER"),
+		Converts("ALTER USER role_name NOREPLICATION SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOREPLICATION SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER NOREPLICATION SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER NOREPLICATION SUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH NOREPLICATION SUPERUSER"),
+		Converts("ALTER USER role_name WITH NOREPLICATION SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOREPLICATION SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOREPLICATION SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOREPLICATION SUPERUSER"),
-		Unimplemented("ALTER USER role_name BYPASSRLS SUPERUSER"),
+		Converts("ALTER USER role_name BYPASSRLS SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE BYPASSRLS SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER BYPASSRLS SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER BYPASSRLS SUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH BYPASSRLS SUPERUSER"),
+		Converts("ALTER USER role_name WITH BYPASSRLS SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH BYPASSRLS SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH BYPASSRLS SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH BYPASSRLS SUPERUSER"),
-		Unimplemented("ALTER USER role_name NOBYPASSRLS SUPERUSER"),
+		Converts("ALTER USER role_name NOBYPASSRLS SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOBYPASSRLS SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER NOBYPASSRLS SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER NOBYPASSRLS SUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH NOBYPASSRLS SUPERUSER"),
+		Converts("ALTER USER role_name WITH NOBYPASSRLS SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOBYPASSRLS SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOBYPASSRLS SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOBYPASSRLS SUPERUSER"),
-		Unimplemented("ALTER USER role_name CONNECTION LIMIT -1 SUPERUSER"),
+		Converts("ALTER USER role_name CONNECTION LIMIT -1 SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE CONNECTION LIMIT -1 SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER CONNECTION LIMIT -1 SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER CONNECTION LIMIT -1 SUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH CONNECTION LIMIT -1 SUPERUSER"),
+		Converts("ALTER USER role_name WITH CONNECTION LIMIT -1 SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CONNECTION LIMIT -1 SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CONNECTION LIMIT -1 SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH CONNECTION LIMIT -1 SUPERUSER"),
-		Unimplemented("ALTER USER role_name PASSWORD ' password ' SUPERUSER"),
+		Converts("ALTER USER role_name PASSWORD ' password ' SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD ' password ' SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD ' password ' SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD ' password ' SUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH PASSWORD ' password ' SUPERUSER"),
+		Converts("ALTER USER role_name WITH PASSWORD ' password ' SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD ' password ' SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD ' password ' SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD ' password ' SUPERUSER"),
-		Unimplemented("ALTER USER role_name ENCRYPTED PASSWORD ' password ' SUPERUSER"),
+		Converts("ALTER USER role_name ENCRYPTED PASSWORD ' password ' SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE ENCRYPTED PASSWORD ' password ' SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER ENCRYPTED PASSWORD ' password ' SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER ENCRYPTED PASSWORD ' password ' SUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' SUPERUSER"),
+		Converts("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH ENCRYPTED PASSWORD ' password ' SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH ENCRYPTED PASSWORD ' password ' SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH ENCRYPTED PASSWORD ' password ' SUPERUSER"),
-		Unimplemented("ALTER USER role_name PASSWORD NULL SUPERUSER"),
+		Converts("ALTER USER role_name PASSWORD NULL SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD NULL SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD NULL SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD NULL SUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH PASSWORD NULL SUPERUSER"),
+		Converts("ALTER USER role_name WITH PASSWORD NULL SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD NULL SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD NULL SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD NULL SUPERUSER"),
-		Unimplemented("ALTER USER role_name VALID UNTIL ' timestamp ' SUPERUSER"),
+		Parses("ALTER USER role_name VALID UNTIL ' timestamp ' SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE VALID UNTIL ' timestamp ' SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER VALID UNTIL ' timestamp ' SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER VALID UNTIL ' timestamp ' SUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH VALID UNTIL ' timestamp ' SUPERUSER"),
+		Parses("ALTER USER role_name WITH VALID UNTIL ' timestamp ' SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH VALID UNTIL ' timestamp ' SUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH VALID UNTIL ' timestamp ' SUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH VALID UNTIL ' timestamp ' SUPERUSER"),
-		Unimplemented("ALTER USER role_name SUPERUSER NOSUPERUSER"),
+		Parses("ALTER USER role_name SUPERUSER NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE SUPERUSER NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER SUPERUSER NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER SUPERUSER NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH SUPERUSER NOSUPERUSER"),
+		Parses("ALTER USER role_name WITH SUPERUSER NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH SUPERUSER NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH SUPERUSER NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH SUPERUSER NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name NOSUPERUSER NOSUPERUSER"),
+		Parses("ALTER USER role_name NOSUPERUSER NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOSUPERUSER NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER NOSUPERUSER NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER NOSUPERUSER NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH NOSUPERUSER NOSUPERUSER"),
+		Parses("ALTER USER role_name WITH NOSUPERUSER NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOSUPERUSER NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOSUPERUSER NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOSUPERUSER NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name CREATEDB NOSUPERUSER"),
+		Converts("ALTER USER role_name CREATEDB NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEDB NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEDB NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER CREATEDB NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH CREATEDB NOSUPERUSER"),
+		Converts("ALTER USER role_name WITH CREATEDB NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEDB NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEDB NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEDB NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name NOCREATEDB NOSUPERUSER"),
+		Converts("ALTER USER role_name NOCREATEDB NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEDB NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEDB NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEDB NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH NOCREATEDB NOSUPERUSER"),
+		Converts("ALTER USER role_name WITH NOCREATEDB NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEDB NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEDB NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEDB NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name CREATEROLE NOSUPERUSER"),
+		Converts("ALTER USER role_name CREATEROLE NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEROLE NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEROLE NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER CREATEROLE NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH CREATEROLE NOSUPERUSER"),
+		Converts("ALTER USER role_name WITH CREATEROLE NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEROLE NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEROLE NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEROLE NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name NOCREATEROLE NOSUPERUSER"),
+		Converts("ALTER USER role_name NOCREATEROLE NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEROLE NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEROLE NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEROLE NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH NOCREATEROLE NOSUPERUSER"),
+		Converts("ALTER USER role_name WITH NOCREATEROLE NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEROLE NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEROLE NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEROLE NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name INHERIT NOSUPERUSER"),
+		Converts("ALTER USER role_name INHERIT NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE INHERIT NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER INHERIT NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER INHERIT NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH INHERIT NOSUPERUSER"),
+		Converts("ALTER USER role_name WITH INHERIT NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH INHERIT NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH INHERIT NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH INHERIT NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name NOINHERIT NOSUPERUSER"),
+		Converts("ALTER USER role_name NOINHERIT NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOINHERIT NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER NOINHERIT NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER NOINHERIT NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH NOINHERIT NOSUPERUSER"),
+		Converts("ALTER USER role_name WITH NOINHERIT NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOINHERIT NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOINHERIT NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOINHERIT NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name LOGIN NOSUPERUSER"),
+		Converts("ALTER USER role_name LOGIN NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE LOGIN NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER LOGIN NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER LOGIN NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH LOGIN NOSUPERUSER"),
+		Converts("ALTER USER role_name WITH LOGIN NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH LOGIN NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH LOGIN NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH LOGIN NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name NOLOGIN NOSUPERUSER"),
+		Converts("ALTER USER role_name NOLOGIN NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOLOGIN NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER NOLOGIN NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER NOLOGIN NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH NOLOGIN NOSUPERUSER"),
+		Converts("ALTER USER role_name WITH NOLOGIN NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOLOGIN NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOLOGIN NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOLOGIN NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name REPLICATION NOSUPERUSER"),
+		Converts("ALTER USER role_name REPLICATION NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE REPLICATION NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER REPLICATION NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER REPLICATION NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH REPLICATION NOSUPERUSER"),
+		Converts("ALTER USER role_name WITH REPLICATION NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH REPLICATION NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH REPLICATION NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH REPLICATION NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name NOREPLICATION NOSUPERUSER"),
+		Converts("ALTER USER role_name NOREPLICATION NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOREPLICATION NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER NOREPLICATION NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER NOREPLICATION NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH NOREPLICATION NOSUPERUSER"),
+		Converts("ALTER USER role_name WITH NOREPLICATION NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOREPLICATION NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOREPLICATION NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOREPLICATION NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name BYPASSRLS NOSUPERUSER"),
+		Converts("ALTER USER role_name BYPASSRLS NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE BYPASSRLS NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER BYPASSRLS NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER BYPASSRLS NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH BYPASSRLS NOSUPERUSER"),
+		Converts("ALTER USER role_name WITH BYPASSRLS NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH BYPASSRLS NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH BYPASSRLS NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH BYPASSRLS NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name NOBYPASSRLS NOSUPERUSER"),
+		Converts("ALTER USER role_name NOBYPASSRLS NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOBYPASSRLS NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER NOBYPASSRLS NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER NOBYPASSRLS NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH NOBYPASSRLS NOSUPERUSER"),
+		Converts("ALTER USER role_name WITH NOBYPASSRLS NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOBYPASSRLS NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOBYPASSRLS NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOBYPASSRLS NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name CONNECTION LIMIT -1 NOSUPERUSER"),
+		Converts("ALTER USER role_name CONNECTION LIMIT -1 NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE CONNECTION LIMIT -1 NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER CONNECTION LIMIT -1 NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER CONNECTION LIMIT -1 NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH CONNECTION LIMIT -1 NOSUPERUSER"),
+		Converts("ALTER USER role_name WITH CONNECTION LIMIT -1 NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CONNECTION LIMIT -1 NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CONNECTION LIMIT -1 NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH CONNECTION LIMIT -1 NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name PASSWORD ' password ' NOSUPERUSER"),
+		Converts("ALTER USER role_name PASSWORD ' password ' NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD ' password ' NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD ' password ' NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD ' password ' NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH PASSWORD ' password ' NOSUPERUSER"),
+		Converts("ALTER USER role_name WITH PASSWORD ' password ' NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD ' password ' NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD ' password ' NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD ' password ' NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name ENCRYPTED PASSWORD ' password ' NOSUPERUSER"),
+		Converts("ALTER USER role_name ENCRYPTED PASSWORD ' password ' NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE ENCRYPTED PASSWORD ' password ' NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER ENCRYPTED PASSWORD ' password ' NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER ENCRYPTED PASSWORD ' password ' NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' NOSUPERUSER"),
+		Converts("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH ENCRYPTED PASSWORD ' password ' NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH ENCRYPTED PASSWORD ' password ' NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH ENCRYPTED PASSWORD ' password ' NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name PASSWORD NULL NOSUPERUSER"),
+		Converts("ALTER USER role_name PASSWORD NULL NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD NULL NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD NULL NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD NULL NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH PASSWORD NULL NOSUPERUSER"),
+		Converts("ALTER USER role_name WITH PASSWORD NULL NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD NULL NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD NULL NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD NULL NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name VALID UNTIL ' timestamp ' NOSUPERUSER"),
+		Parses("ALTER USER role_name VALID UNTIL ' timestamp ' NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE VALID UNTIL ' timestamp ' NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER VALID UNTIL ' timestamp ' NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER VALID UNTIL ' timestamp ' NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name WITH VALID UNTIL ' timestamp ' NOSUPERUSER"),
+		Parses("ALTER USER role_name WITH VALID UNTIL ' timestamp ' NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH VALID UNTIL ' timestamp ' NOSUPERUSER"),
 		Unimplemented("ALTER USER CURRENT_USER WITH VALID UNTIL ' timestamp ' NOSUPERUSER"),
 		Unimplemented("ALTER USER SESSION_USER WITH VALID UNTIL ' timestamp ' NOSUPERUSER"),
-		Unimplemented("ALTER USER role_name SUPERUSER CREATEDB"),
+		Converts("ALTER USER role_name SUPERUSER CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE SUPERUSER CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER SUPERUSER CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER SUPERUSER CREATEDB"),
-		Unimplemented("ALTER USER role_name WITH SUPERUSER CREATEDB"),
+		Converts("ALTER USER role_name WITH SUPERUSER CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH SUPERUSER CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH SUPERUSER CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH SUPERUSER CREATEDB"),
-		Unimplemented("ALTER USER role_name NOSUPERUSER CREATEDB"),
+		Converts("ALTER USER role_name NOSUPERUSER CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOSUPERUSER CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER NOSUPERUSER CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER NOSUPERUSER CREATEDB"),
-		Unimplemented("ALTER USER role_name WITH NOSUPERUSER CREATEDB"),
+		Converts("ALTER USER role_name WITH NOSUPERUSER CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOSUPERUSER CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOSUPERUSER CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOSUPERUSER CREATEDB"),
@@ -506,115 +506,115 @@ func TestAlterUser(t *testing.T) {
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEDB CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEDB CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEDB CREATEDB"),
-		Parses("ALTER USER role_name CREATEROLE CREATEDB"),
+		Converts("ALTER USER role_name CREATEROLE CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEROLE CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEROLE CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER CREATEROLE CREATEDB"),
-		Parses("ALTER USER role_name WITH CREATEROLE CREATEDB"),
+		Converts("ALTER USER role_name WITH CREATEROLE CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEROLE CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEROLE CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEROLE CREATEDB"),
-		Parses("ALTER USER role_name NOCREATEROLE CREATEDB"),
+		Converts("ALTER USER role_name NOCREATEROLE CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEROLE CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEROLE CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEROLE CREATEDB"),
-		Parses("ALTER USER role_name WITH NOCREATEROLE CREATEDB"),
+		Converts("ALTER USER role_name WITH NOCREATEROLE CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEROLE CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEROLE CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEROLE CREATEDB"),
-		Unimplemented("ALTER USER role_name INHERIT CREATEDB"),
+		Converts("ALTER USER role_name INHERIT CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE INHERIT CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER INHERIT CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER INHERIT CREATEDB"),
-		Unimplemented("ALTER USER role_name WITH INHERIT CREATEDB"),
+		Converts("ALTER USER role_name WITH INHERIT CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH INHERIT CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH INHERIT CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH INHERIT CREATEDB"),
-		Unimplemented("ALTER USER role_name NOINHERIT CREATEDB"),
+		Converts("ALTER USER role_name NOINHERIT CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOINHERIT CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER NOINHERIT CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER NOINHERIT CREATEDB"),
-		Unimplemented("ALTER USER role_name WITH NOINHERIT CREATEDB"),
+		Converts("ALTER USER role_name WITH NOINHERIT CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOINHERIT CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOINHERIT CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOINHERIT CREATEDB"),
-		Parses("ALTER USER role_name LOGIN CREATEDB"),
+		Converts("ALTER USER role_name LOGIN CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE LOGIN CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER LOGIN CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER LOGIN CREATEDB"),
-		Parses("ALTER USER role_name WITH LOGIN CREATEDB"),
+		Converts("ALTER USER role_name WITH LOGIN CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH LOGIN CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH LOGIN CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH LOGIN CREATEDB"),
-		Parses("ALTER USER role_name NOLOGIN CREATEDB"),
+		Converts("ALTER USER role_name NOLOGIN CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOLOGIN CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER NOLOGIN CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER NOLOGIN CREATEDB"),
-		Parses("ALTER USER role_name WITH NOLOGIN CREATEDB"),
+		Converts("ALTER USER role_name WITH NOLOGIN CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOLOGIN CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOLOGIN CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOLOGIN CREATEDB"),
-		Unimplemented("ALTER USER role_name REPLICATION CREATEDB"),
+		Converts("ALTER USER role_name REPLICATION CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE REPLICATION CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER REPLICATION CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER REPLICATION CREATEDB"),
-		Unimplemented("ALTER USER role_name WITH REPLICATION CREATEDB"),
+		Converts("ALTER USER role_name WITH REPLICATION CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH REPLICATION CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH REPLICATION CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH REPLICATION CREATEDB"),
-		Unimplemented("ALTER USER role_name NOREPLICATION CREATEDB"),
+		Converts("ALTER USER role_name NOREPLICATION CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOREPLICATION CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER NOREPLICATION CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER NOREPLICATION CREATEDB"),
-		Unimplemented("ALTER USER role_name WITH NOREPLICATION CREATEDB"),
+		Converts("ALTER USER role_name WITH NOREPLICATION CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOREPLICATION CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOREPLICATION CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOREPLICATION CREATEDB"),
-		Unimplemented("ALTER USER role_name BYPASSRLS CREATEDB"),
+		Converts("ALTER USER role_name BYPASSRLS CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE BYPASSRLS CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER BYPASSRLS CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER BYPASSRLS CREATEDB"),
-		Unimplemented("ALTER USER role_name WITH BYPASSRLS CREATEDB"),
+		Converts("ALTER USER role_name WITH BYPASSRLS CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH BYPASSRLS CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH BYPASSRLS CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH BYPASSRLS CREATEDB"),
-		Unimplemented("ALTER USER role_name NOBYPASSRLS CREATEDB"),
+		Converts("ALTER USER role_name NOBYPASSRLS CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOBYPASSRLS CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER NOBYPASSRLS CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER NOBYPASSRLS CREATEDB"),
-		Unimplemented("ALTER USER role_name WITH NOBYPASSRLS CREATEDB"),
+		Converts("ALTER USER role_name WITH NOBYPASSRLS CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOBYPASSRLS CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOBYPASSRLS CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOBYPASSRLS CREATEDB"),
-		Unimplemented("ALTER USER role_name CONNECTION LIMIT -1 CREATEDB"),
+		Converts("ALTER USER role_name CONNECTION LIMIT -1 CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE CONNECTION LIMIT -1 CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER CONNECTION LIMIT -1 CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER CONNECTION LIMIT -1 CREATEDB"),
-		Unimplemented("ALTER USER role_name WITH CONNECTION LIMIT -1 CREATEDB"),
+		Converts("ALTER USER role_name WITH CONNECTION LIMIT -1 CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CONNECTION LIMIT -1 CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CONNECTION LIMIT -1 CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH CONNECTION LIMIT -1 CREATEDB"),
-		Parses("ALTER USER role_name PASSWORD ' password ' CREATEDB"),
+		Converts("ALTER USER role_name PASSWORD ' password ' CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD ' password ' CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD ' password ' CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD ' password ' CREATEDB"),
-		Parses("ALTER USER role_name WITH PASSWORD ' password ' CREATEDB"),
+		Converts("ALTER USER role_name WITH PASSWORD ' password ' CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD ' password ' CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD ' password ' CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD ' password ' CREATEDB"),
-		Unimplemented("ALTER USER role_name ENCRYPTED PASSWORD ' password ' CREATEDB"),
+		Converts("ALTER USER role_name ENCRYPTED PASSWORD ' password ' CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE ENCRYPTED PASSWORD ' password ' CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER ENCRYPTED PASSWORD ' password ' CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER ENCRYPTED PASSWORD ' password ' CREATEDB"),
-		Unimplemented("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' CREATEDB"),
+		Converts("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH ENCRYPTED PASSWORD ' password ' CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH ENCRYPTED PASSWORD ' password ' CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH ENCRYPTED PASSWORD ' password ' CREATEDB"),
-		Parses("ALTER USER role_name PASSWORD NULL CREATEDB"),
+		Converts("ALTER USER role_name PASSWORD NULL CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD NULL CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD NULL CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD NULL CREATEDB"),
-		Parses("ALTER USER role_name WITH PASSWORD NULL CREATEDB"),
+		Converts("ALTER USER role_name WITH PASSWORD NULL CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD NULL CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD NULL CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD NULL CREATEDB"),
@@ -626,19 +626,19 @@ func TestAlterUser(t *testing.T) {
 		Unimplemented("ALTER USER CURRENT_ROLE WITH VALID UNTIL ' timestamp ' CREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH VALID UNTIL ' timestamp ' CREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH VALID UNTIL ' timestamp ' CREATEDB"),
-		Unimplemented("ALTER USER role_name SUPERUSER NOCREATEDB"),
+		Converts("ALTER USER role_name SUPERUSER NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE SUPERUSER NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER SUPERUSER NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER SUPERUSER NOCREATEDB"),
-		Unimplemented("ALTER USER role_name WITH SUPERUSER NOCREATEDB"),
+		Converts("ALTER USER role_name WITH SUPERUSER NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH SUPERUSER NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH SUPERUSER NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH SUPERUSER NOCREATEDB"),
-		Unimplemented("ALTER USER role_name NOSUPERUSER NOCREATEDB"),
+		Converts("ALTER USER role_name NOSUPERUSER NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOSUPERUSER NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER NOSUPERUSER NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER NOSUPERUSER NOCREATEDB"),
-		Unimplemented("ALTER USER role_name WITH NOSUPERUSER NOCREATEDB"),
+		Converts("ALTER USER role_name WITH NOSUPERUSER NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOSUPERUSER NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOSUPERUSER NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOSUPERUSER NOCREATEDB"),
@@ -658,115 +658,115 @@ func TestAlterUser(t *testing.T) {
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEDB NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEDB NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEDB NOCREATEDB"),
-		Parses("ALTER USER role_name CREATEROLE NOCREATEDB"),
+		Converts("ALTER USER role_name CREATEROLE NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEROLE NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEROLE NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER CREATEROLE NOCREATEDB"),
-		Parses("ALTER USER role_name WITH CREATEROLE NOCREATEDB"),
+		Converts("ALTER USER role_name WITH CREATEROLE NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEROLE NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEROLE NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEROLE NOCREATEDB"),
-		Parses("ALTER USER role_name NOCREATEROLE NOCREATEDB"),
+		Converts("ALTER USER role_name NOCREATEROLE NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEROLE NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEROLE NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEROLE NOCREATEDB"),
-		Parses("ALTER USER role_name WITH NOCREATEROLE NOCREATEDB"),
+		Converts("ALTER USER role_name WITH NOCREATEROLE NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEROLE NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEROLE NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEROLE NOCREATEDB"),
-		Unimplemented("ALTER USER role_name INHERIT NOCREATEDB"),
+		Converts("ALTER USER role_name INHERIT NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE INHERIT NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER INHERIT NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER INHERIT NOCREATEDB"),
-		Unimplemented("ALTER USER role_name WITH INHERIT NOCREATEDB"),
+		Converts("ALTER USER role_name WITH INHERIT NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH INHERIT NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH INHERIT NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH INHERIT NOCREATEDB"),
-		Unimplemented("ALTER USER role_name NOINHERIT NOCREATEDB"),
+		Converts("ALTER USER role_name NOINHERIT NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOINHERIT NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER NOINHERIT NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER NOINHERIT NOCREATEDB"),
-		Unimplemented("ALTER USER role_name WITH NOINHERIT NOCREATEDB"),
+		Converts("ALTER USER role_name WITH NOINHERIT NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOINHERIT NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOINHERIT NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOINHERIT NOCREATEDB"),
-		Parses("ALTER USER role_name LOGIN NOCREATEDB"),
+		Converts("ALTER USER role_name LOGIN NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE LOGIN NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER LOGIN NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER LOGIN NOCREATEDB"),
-		Parses("ALTER USER role_name WITH LOGIN NOCREATEDB"),
+		Converts("ALTER USER role_name WITH LOGIN NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH LOGIN NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH LOGIN NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH LOGIN NOCREATEDB"),
-		Parses("ALTER USER role_name NOLOGIN NOCREATEDB"),
+		Converts("ALTER USER role_name NOLOGIN NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOLOGIN NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER NOLOGIN NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER NOLOGIN NOCREATEDB"),
-		Parses("ALTER USER role_name WITH NOLOGIN NOCREATEDB"),
+		Converts("ALTER USER role_name WITH NOLOGIN NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOLOGIN NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOLOGIN NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOLOGIN NOCREATEDB"),
-		Unimplemented("ALTER USER role_name REPLICATION NOCREATEDB"),
+		Converts("ALTER USER role_name REPLICATION NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE REPLICATION NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER REPLICATION NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER REPLICATION NOCREATEDB"),
-		Unimplemented("ALTER USER role_name WITH REPLICATION NOCREATEDB"),
+		Converts("ALTER USER role_name WITH REPLICATION NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH REPLICATION NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH REPLICATION NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH REPLICATION NOCREATEDB"),
-		Unimplemented("ALTER USER role_name NOREPLICATION NOCREATEDB"),
+		Converts("ALTER USER role_name NOREPLICATION NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOREPLICATION NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER NOREPLICATION NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER NOREPLICATION NOCREATEDB"),
-		Unimplemented("ALTER USER role_name WITH NOREPLICATION NOCREATEDB"),
+		Converts("ALTER USER role_name WITH NOREPLICATION NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOREPLICATION NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOREPLICATION NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOREPLICATION NOCREATEDB"),
-		Unimplemented("ALTER USER role_name BYPASSRLS NOCREATEDB"),
+		Converts("ALTER USER role_name BYPASSRLS NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE BYPASSRLS NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER BYPASSRLS NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER BYPASSRLS NOCREATEDB"),
-		Unimplemented("ALTER USER role_name WITH BYPASSRLS NOCREATEDB"),
+		Converts("ALTER USER role_name WITH BYPASSRLS NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH BYPASSRLS NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH BYPASSRLS NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH BYPASSRLS NOCREATEDB"),
-		Unimplemented("ALTER USER role_name NOBYPASSRLS NOCREATEDB"),
+		Converts("ALTER USER role_name NOBYPASSRLS NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOBYPASSRLS NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER NOBYPASSRLS NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER NOBYPASSRLS NOCREATEDB"),
-		Unimplemented("ALTER USER role_name WITH NOBYPASSRLS NOCREATEDB"),
+		Converts("ALTER USER role_name WITH NOBYPASSRLS NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOBYPASSRLS NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOBYPASSRLS NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOBYPASSRLS NOCREATEDB"),
-		Unimplemented("ALTER USER role_name CONNECTION LIMIT -1 NOCREATEDB"),
+		Converts("ALTER USER role_name CONNECTION LIMIT -1 NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE CONNECTION LIMIT -1 NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER CONNECTION LIMIT -1 NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER CONNECTION LIMIT -1 NOCREATEDB"),
-		Unimplemented("ALTER USER role_name WITH CONNECTION LIMIT -1 NOCREATEDB"),
+		Converts("ALTER USER role_name WITH CONNECTION LIMIT -1 NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CONNECTION LIMIT -1 NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CONNECTION LIMIT -1 NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH CONNECTION LIMIT -1 NOCREATEDB"),
-		Parses("ALTER USER role_name PASSWORD ' password ' NOCREATEDB"),
+		Converts("ALTER USER role_name PASSWORD ' password ' NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD ' password ' NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD ' password ' NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD ' password ' NOCREATEDB"),
-		Parses("ALTER USER role_name WITH PASSWORD ' password ' NOCREATEDB"),
+		Converts("ALTER USER role_name WITH PASSWORD ' password ' NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD ' password ' NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD ' password ' NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD ' password ' NOCREATEDB"),
-		Unimplemented("ALTER USER role_name ENCRYPTED PASSWORD ' password ' NOCREATEDB"),
+		Converts("ALTER USER role_name ENCRYPTED PASSWORD ' password ' NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE ENCRYPTED PASSWORD ' password ' NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER ENCRYPTED PASSWORD ' password ' NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER ENCRYPTED PASSWORD ' password ' NOCREATEDB"),
-		Unimplemented("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' NOCREATEDB"),
+		Converts("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH ENCRYPTED PASSWORD ' password ' NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH ENCRYPTED PASSWORD ' password ' NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH ENCRYPTED PASSWORD ' password ' NOCREATEDB"),
-		Parses("ALTER USER role_name PASSWORD NULL NOCREATEDB"),
+		Converts("ALTER USER role_name PASSWORD NULL NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD NULL NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD NULL NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD NULL NOCREATEDB"),
-		Parses("ALTER USER role_name WITH PASSWORD NULL NOCREATEDB"),
+		Converts("ALTER USER role_name WITH PASSWORD NULL NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD NULL NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD NULL NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD NULL NOCREATEDB"),
@@ -778,35 +778,35 @@ func TestAlterUser(t *testing.T) {
 		Unimplemented("ALTER USER CURRENT_ROLE WITH VALID UNTIL ' timestamp ' NOCREATEDB"),
 		Unimplemented("ALTER USER CURRENT_USER WITH VALID UNTIL ' timestamp ' NOCREATEDB"),
 		Unimplemented("ALTER USER SESSION_USER WITH VALID UNTIL ' timestamp ' NOCREATEDB"),
-		Unimplemented("ALTER USER role_name SUPERUSER CREATEROLE"),
+		Converts("ALTER USER role_name SUPERUSER CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE SUPERUSER CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER SUPERUSER CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER SUPERUSER CREATEROLE"),
-		Unimplemented("ALTER USER role_name WITH SUPERUSER CREATEROLE"),
+		Converts("ALTER USER role_name WITH SUPERUSER CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH SUPERUSER CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH SUPERUSER CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH SUPERUSER CREATEROLE"),
-		Unimplemented("ALTER USER role_name NOSUPERUSER CREATEROLE"),
+		Converts("ALTER USER role_name NOSUPERUSER CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOSUPERUSER CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER NOSUPERUSER CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER NOSUPERUSER CREATEROLE"),
-		Unimplemented("ALTER USER role_name WITH NOSUPERUSER CREATEROLE"),
+		Converts("ALTER USER role_name WITH NOSUPERUSER CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOSUPERUSER CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOSUPERUSER CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOSUPERUSER CREATEROLE"),
-		Parses("ALTER USER role_name CREATEDB CREATEROLE"),
+		Converts("ALTER USER role_name CREATEDB CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEDB CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEDB CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER CREATEDB CREATEROLE"),
-		Parses("ALTER USER role_name WITH CREATEDB CREATEROLE"),
+		Converts("ALTER USER role_name WITH CREATEDB CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEDB CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEDB CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEDB CREATEROLE"),
-		Parses("ALTER USER role_name NOCREATEDB CREATEROLE"),
+		Converts("ALTER USER role_name NOCREATEDB CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEDB CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEDB CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEDB CREATEROLE"),
-		Parses("ALTER USER role_name WITH NOCREATEDB CREATEROLE"),
+		Converts("ALTER USER role_name WITH NOCREATEDB CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEDB CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEDB CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEDB CREATEROLE"),
@@ -826,99 +826,99 @@ func TestAlterUser(t *testing.T) {
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEROLE CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEROLE CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEROLE CREATEROLE"),
-		Unimplemented("ALTER USER role_name INHERIT CREATEROLE"),
+		Converts("ALTER USER role_name INHERIT CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE INHERIT CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER INHERIT CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER INHERIT CREATEROLE"),
-		Unimplemented("ALTER USER role_name WITH INHERIT CREATEROLE"),
+		Converts("ALTER USER role_name WITH INHERIT CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH INHERIT CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH INHERIT CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH INHERIT CREATEROLE"),
-		Unimplemented("ALTER USER role_name NOINHERIT CREATEROLE"),
+		Converts("ALTER USER role_name NOINHERIT CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOINHERIT CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER NOINHERIT CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER NOINHERIT CREATEROLE"),
-		Unimplemented("ALTER USER role_name WITH NOINHERIT CREATEROLE"),
+		Converts("ALTER USER role_name WITH NOINHERIT CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOINHERIT CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOINHERIT CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOINHERIT CREATEROLE"),
-		Parses("ALTER USER role_name LOGIN CREATEROLE"),
+		Converts("ALTER USER role_name LOGIN CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE LOGIN CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER LOGIN CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER LOGIN CREATEROLE"),
-		Parses("ALTER USER role_name WITH LOGIN CREATEROLE"),
+		Converts("ALTER USER role_name WITH LOGIN CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH LOGIN CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH LOGIN CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH LOGIN CREATEROLE"),
-		Parses("ALTER USER role_name NOLOGIN CREATEROLE"),
+		Converts("ALTER USER role_name NOLOGIN CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOLOGIN CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER NOLOGIN CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER NOLOGIN CREATEROLE"),
-		Parses("ALTER USER role_name WITH NOLOGIN CREATEROLE"),
+		Converts("ALTER USER role_name WITH NOLOGIN CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOLOGIN CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOLOGIN CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOLOGIN CREATEROLE"),
-		Unimplemented("ALTER USER role_name REPLICATION CREATEROLE"),
+		Converts("ALTER USER role_name REPLICATION CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE REPLICATION CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER REPLICATION CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER REPLICATION CREATEROLE"),
-		Unimplemented("ALTER USER role_name WITH REPLICATION CREATEROLE"),
+		Converts("ALTER USER role_name WITH REPLICATION CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH REPLICATION CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH REPLICATION CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH REPLICATION CREATEROLE"),
-		Unimplemented("ALTER USER role_name NOREPLICATION CREATEROLE"),
+		Converts("ALTER USER role_name NOREPLICATION CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOREPLICATION CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER NOREPLICATION CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER NOREPLICATION CREATEROLE"),
-		Unimplemented("ALTER USER role_name WITH NOREPLICATION CREATEROLE"),
+		Converts("ALTER USER role_name WITH NOREPLICATION CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOREPLICATION CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOREPLICATION CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOREPLICATION CREATEROLE"),
-		Unimplemented("ALTER USER role_name BYPASSRLS CREATEROLE"),
+		Converts("ALTER USER role_name BYPASSRLS CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE BYPASSRLS CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER BYPASSRLS CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER BYPASSRLS CREATEROLE"),
-		Unimplemented("ALTER USER role_name WITH BYPASSRLS CREATEROLE"),
+		Converts("ALTER USER role_name WITH BYPASSRLS CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH BYPASSRLS CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH BYPASSRLS CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH BYPASSRLS CREATEROLE"),
-		Unimplemented("ALTER USER role_name NOBYPASSRLS CREATEROLE"),
+		Converts("ALTER USER role_name NOBYPASSRLS CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOBYPASSRLS CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER NOBYPASSRLS CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER NOBYPASSRLS CREATEROLE"),
-		Unimplemented("ALTER USER role_name WITH NOBYPASSRLS CREATEROLE"),
+		Converts("ALTER USER role_name WITH NOBYPASSRLS CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOBYPASSRLS CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOBYPASSRLS CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOBYPASSRLS CREATEROLE"),
-		Unimplemented("ALTER USER role_name CONNECTION LIMIT -1 CREATEROLE"),
+		Converts("ALTER USER role_name CONNECTION LIMIT -1 CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE CONNECTION LIMIT -1 CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER CONNECTION LIMIT -1 CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER CONNECTION LIMIT -1 CREATEROLE"),
-		Unimplemented("ALTER USER role_name WITH CONNECTION LIMIT -1 CREATEROLE"),
+		Converts("ALTER USER role_name WITH CONNECTION LIMIT -1 CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CONNECTION LIMIT -1 CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CONNECTION LIMIT -1 CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH CONNECTION LIMIT -1 CREATEROLE"),
-		Parses("ALTER USER role_name PASSWORD ' password ' CREATEROLE"),
+		Converts("ALTER USER role_name PASSWORD ' password ' CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD ' password ' CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD ' password ' CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD ' password ' CREATEROLE"),
-		Parses("ALTER USER role_name WITH PASSWORD ' password ' CREATEROLE"),
+		Converts("ALTER USER role_name WITH PASSWORD ' password ' CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD ' password ' CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD ' password ' CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD ' password ' CREATEROLE"),
-		Unimplemented("ALTER USER role_name ENCRYPTED PASSWORD ' password ' CREATEROLE"),
+		Converts("ALTER USER role_name ENCRYPTED PASSWORD ' password ' CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE ENCRYPTED PASSWORD ' password ' CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER ENCRYPTED PASSWORD ' password ' CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER ENCRYPTED PASSWORD ' password ' CREATEROLE"),
-		Unimplemented("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' CREATEROLE"),
+		Converts("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH ENCRYPTED PASSWORD ' password ' CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH ENCRYPTED PASSWORD ' password ' CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH ENCRYPTED PASSWORD ' password ' CREATEROLE"),
-		Parses("ALTER USER role_name PASSWORD NULL CREATEROLE"),
+		Converts("ALTER USER role_name PASSWORD NULL CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD NULL CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD NULL CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD NULL CREATEROLE"),
-		Parses("ALTER USER role_name WITH PASSWORD NULL CREATEROLE"),
+		Converts("ALTER USER role_name WITH PASSWORD NULL CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD NULL CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD NULL CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD NULL CREATEROLE"),
@@ -930,35 +930,35 @@ func TestAlterUser(t *testing.T) {
 		Unimplemented("ALTER USER CURRENT_ROLE WITH VALID UNTIL ' timestamp ' CREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH VALID UNTIL ' timestamp ' CREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH VALID UNTIL ' timestamp ' CREATEROLE"),
-		Unimplemented("ALTER USER role_name SUPERUSER NOCREATEROLE"),
+		Converts("ALTER USER role_name SUPERUSER NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE SUPERUSER NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER SUPERUSER NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER SUPERUSER NOCREATEROLE"),
-		Unimplemented("ALTER USER role_name WITH SUPERUSER NOCREATEROLE"),
+		Converts("ALTER USER role_name WITH SUPERUSER NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH SUPERUSER NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH SUPERUSER NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH SUPERUSER NOCREATEROLE"),
-		Unimplemented("ALTER USER role_name NOSUPERUSER NOCREATEROLE"),
+		Converts("ALTER USER role_name NOSUPERUSER NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOSUPERUSER NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER NOSUPERUSER NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER NOSUPERUSER NOCREATEROLE"),
-		Unimplemented("ALTER USER role_name WITH NOSUPERUSER NOCREATEROLE"),
+		Converts("ALTER USER role_name WITH NOSUPERUSER NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOSUPERUSER NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOSUPERUSER NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOSUPERUSER NOCREATEROLE"),
-		Parses("ALTER USER role_name CREATEDB NOCREATEROLE"),
+		Converts("ALTER USER role_name CREATEDB NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEDB NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEDB NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER CREATEDB NOCREATEROLE"),
-		Parses("ALTER USER role_name WITH CREATEDB NOCREATEROLE"),
+		Converts("ALTER USER role_name WITH CREATEDB NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEDB NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEDB NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEDB NOCREATEROLE"),
-		Parses("ALTER USER role_name NOCREATEDB NOCREATEROLE"),
+		Converts("ALTER USER role_name NOCREATEDB NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEDB NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEDB NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEDB NOCREATEROLE"),
-		Parses("ALTER USER role_name WITH NOCREATEDB NOCREATEROLE"),
+		Converts("ALTER USER role_name WITH NOCREATEDB NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEDB NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEDB NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEDB NOCREATEROLE"),
@@ -978,99 +978,99 @@ func TestAlterUser(t *testing.T) {
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEROLE NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEROLE NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEROLE NOCREATEROLE"),
-		Unimplemented("ALTER USER role_name INHERIT NOCREATEROLE"),
+		Converts("ALTER USER role_name INHERIT NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE INHERIT NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER INHERIT NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER INHERIT NOCREATEROLE"),
-		Unimplemented("ALTER USER role_name WITH INHERIT NOCREATEROLE"),
+		Converts("ALTER USER role_name WITH INHERIT NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH INHERIT NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH INHERIT NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH INHERIT NOCREATEROLE"),
-		Unimplemented("ALTER USER role_name NOINHERIT NOCREATEROLE"),
+		Converts("ALTER USER role_name NOINHERIT NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOINHERIT NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER NOINHERIT NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER NOINHERIT NOCREATEROLE"),
-		Unimplemented("ALTER USER role_name WITH NOINHERIT NOCREATEROLE"),
+		Converts("ALTER USER role_name WITH NOINHERIT NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOINHERIT NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOINHERIT NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOINHERIT NOCREATEROLE"),
-		Parses("ALTER USER role_name LOGIN NOCREATEROLE"),
+		Converts("ALTER USER role_name LOGIN NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE LOGIN NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER LOGIN NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER LOGIN NOCREATEROLE"),
-		Parses("ALTER USER role_name WITH LOGIN NOCREATEROLE"),
+		Converts("ALTER USER role_name WITH LOGIN NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH LOGIN NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH LOGIN NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH LOGIN NOCREATEROLE"),
-		Parses("ALTER USER role_name NOLOGIN NOCREATEROLE"),
+		Converts("ALTER USER role_name NOLOGIN NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOLOGIN NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER NOLOGIN NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER NOLOGIN NOCREATEROLE"),
-		Parses("ALTER USER role_name WITH NOLOGIN NOCREATEROLE"),
+		Converts("ALTER USER role_name WITH NOLOGIN NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOLOGIN NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOLOGIN NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOLOGIN NOCREATEROLE"),
-		Unimplemented("ALTER USER role_name REPLICATION NOCREATEROLE"),
+		Converts("ALTER USER role_name REPLICATION NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE REPLICATION NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER REPLICATION NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER REPLICATION NOCREATEROLE"),
-		Unimplemented("ALTER USER role_name WITH REPLICATION NOCREATEROLE"),
+		Converts("ALTER USER role_name WITH REPLICATION NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH REPLICATION NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH REPLICATION NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH REPLICATION NOCREATEROLE"),
-		Unimplemented("ALTER USER role_name NOREPLICATION NOCREATEROLE"),
+		Converts("ALTER USER role_name NOREPLICATION NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOREPLICATION NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER NOREPLICATION NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER NOREPLICATION NOCREATEROLE"),
-		Unimplemented("ALTER USER role_name WITH NOREPLICATION NOCREATEROLE"),
+		Converts("ALTER USER role_name WITH NOREPLICATION NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOREPLICATION NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOREPLICATION NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOREPLICATION NOCREATEROLE"),
-		Unimplemented("ALTER USER role_name BYPASSRLS NOCREATEROLE"),
+		Converts("ALTER USER role_name BYPASSRLS NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE BYPASSRLS NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER BYPASSRLS NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER BYPASSRLS NOCREATEROLE"),
-		Unimplemented("ALTER USER role_name WITH BYPASSRLS NOCREATEROLE"),
+		Converts("ALTER USER role_name WITH BYPASSRLS NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH BYPASSRLS NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH BYPASSRLS NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH BYPASSRLS NOCREATEROLE"),
-		Unimplemented("ALTER USER role_name NOBYPASSRLS NOCREATEROLE"),
+		Converts("ALTER USER role_name NOBYPASSRLS NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOBYPASSRLS NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER NOBYPASSRLS NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER NOBYPASSRLS NOCREATEROLE"),
-		Unimplemented("ALTER USER role_name WITH NOBYPASSRLS NOCREATEROLE"),
+		Converts("ALTER USER role_name WITH NOBYPASSRLS NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOBYPASSRLS NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOBYPASSRLS NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOBYPASSRLS NOCREATEROLE"),
-		Unimplemented("ALTER USER role_name CONNECTION LIMIT -1 NOCREATEROLE"),
+		Converts("ALTER USER role_name CONNECTION LIMIT -1 NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE CONNECTION LIMIT -1 NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER CONNECTION LIMIT -1 NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER CONNECTION LIMIT -1 NOCREATEROLE"),
-		Unimplemented("ALTER USER role_name WITH CONNECTION LIMIT -1 NOCREATEROLE"),
+		Converts("ALTER USER role_name WITH CONNECTION LIMIT -1 NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CONNECTION LIMIT -1 NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CONNECTION LIMIT -1 NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH CONNECTION LIMIT -1 NOCREATEROLE"),
-		Parses("ALTER USER role_name PASSWORD ' password ' NOCREATEROLE"),
+		Converts("ALTER USER role_name PASSWORD ' password ' NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD ' password ' NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD ' password ' NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD ' password ' NOCREATEROLE"),
-		Parses("ALTER USER role_name WITH PASSWORD ' password ' NOCREATEROLE"),
+		Converts("ALTER USER role_name WITH PASSWORD ' password ' NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD ' password ' NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD ' password ' NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD ' password ' NOCREATEROLE"),
-		Unimplemented("ALTER USER role_name ENCRYPTED PASSWORD ' password ' NOCREATEROLE"),
+		Converts("ALTER USER role_name ENCRYPTED PASSWORD ' password ' NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE ENCRYPTED PASSWORD ' password ' NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER ENCRYPTED PASSWORD ' password ' NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER ENCRYPTED PASSWORD ' password ' NOCREATEROLE"),
-		Unimplemented("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' NOCREATEROLE"),
+		Converts("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH ENCRYPTED PASSWORD ' password ' NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH ENCRYPTED PASSWORD ' password ' NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH ENCRYPTED PASSWORD ' password ' NOCREATEROLE"),
-		Parses("ALTER USER role_name PASSWORD NULL NOCREATEROLE"),
+		Converts("ALTER USER role_name PASSWORD NULL NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD NULL NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD NULL NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD NULL NOCREATEROLE"),
-		Parses("ALTER USER role_name WITH PASSWORD NULL NOCREATEROLE"),
+		Converts("ALTER USER role_name WITH PASSWORD NULL NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD NULL NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD NULL NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD NULL NOCREATEROLE"),
@@ -1082,371 +1082,371 @@ func TestAlterUser(t *testing.T) {
 		Unimplemented("ALTER USER CURRENT_ROLE WITH VALID UNTIL ' timestamp ' NOCREATEROLE"),
 		Unimplemented("ALTER USER CURRENT_USER WITH VALID UNTIL ' timestamp ' NOCREATEROLE"),
 		Unimplemented("ALTER USER SESSION_USER WITH VALID UNTIL ' timestamp ' NOCREATEROLE"),
-		Unimplemented("ALTER USER role_name SUPERUSER INHERIT"),
+		Converts("ALTER USER role_name SUPERUSER INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE SUPERUSER INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER SUPERUSER INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER SUPERUSER INHERIT"),
-		Unimplemented("ALTER USER role_name WITH SUPERUSER INHERIT"),
+		Converts("ALTER USER role_name WITH SUPERUSER INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH SUPERUSER INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH SUPERUSER INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH SUPERUSER INHERIT"),
-		Unimplemented("ALTER USER role_name NOSUPERUSER INHERIT"),
+		Converts("ALTER USER role_name NOSUPERUSER INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOSUPERUSER INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER NOSUPERUSER INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER NOSUPERUSER INHERIT"),
-		Unimplemented("ALTER USER role_name WITH NOSUPERUSER INHERIT"),
+		Converts("ALTER USER role_name WITH NOSUPERUSER INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOSUPERUSER INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOSUPERUSER INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOSUPERUSER INHERIT"),
-		Unimplemented("ALTER USER role_name CREATEDB INHERIT"),
+		Converts("ALTER USER role_name CREATEDB INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEDB INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEDB INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER CREATEDB INHERIT"),
-		Unimplemented("ALTER USER role_name WITH CREATEDB INHERIT"),
+		Converts("ALTER USER role_name WITH CREATEDB INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEDB INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEDB INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEDB INHERIT"),
-		Unimplemented("ALTER USER role_name NOCREATEDB INHERIT"),
+		Converts("ALTER USER role_name NOCREATEDB INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEDB INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEDB INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEDB INHERIT"),
-		Unimplemented("ALTER USER role_name WITH NOCREATEDB INHERIT"),
+		Converts("ALTER USER role_name WITH NOCREATEDB INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEDB INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEDB INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEDB INHERIT"),
-		Unimplemented("ALTER USER role_name CREATEROLE INHERIT"),
+		Converts("ALTER USER role_name CREATEROLE INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEROLE INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEROLE INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER CREATEROLE INHERIT"),
-		Unimplemented("ALTER USER role_name WITH CREATEROLE INHERIT"),
+		Converts("ALTER USER role_name WITH CREATEROLE INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEROLE INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEROLE INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEROLE INHERIT"),
-		Unimplemented("ALTER USER role_name NOCREATEROLE INHERIT"),
+		Converts("ALTER USER role_name NOCREATEROLE INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEROLE INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEROLE INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEROLE INHERIT"),
-		Unimplemented("ALTER USER role_name WITH NOCREATEROLE INHERIT"),
+		Converts("ALTER USER role_name WITH NOCREATEROLE INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEROLE INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEROLE INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEROLE INHERIT"),
-		Unimplemented("ALTER USER role_name INHERIT INHERIT"),
+		Parses("ALTER USER role_name INHERIT INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE INHERIT INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER INHERIT INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER INHERIT INHERIT"),
-		Unimplemented("ALTER USER role_name WITH INHERIT INHERIT"),
+		Parses("ALTER USER role_name WITH INHERIT INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH INHERIT INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH INHERIT INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH INHERIT INHERIT"),
-		Unimplemented("ALTER USER role_name NOINHERIT INHERIT"),
+		Parses("ALTER USER role_name NOINHERIT INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOINHERIT INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER NOINHERIT INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER NOINHERIT INHERIT"),
-		Unimplemented("ALTER USER role_name WITH NOINHERIT INHERIT"),
+		Parses("ALTER USER role_name WITH NOINHERIT INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOINHERIT INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOINHERIT INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOINHERIT INHERIT"),
-		Unimplemented("ALTER USER role_name LOGIN INHERIT"),
+		Converts("ALTER USER role_name LOGIN INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE LOGIN INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER LOGIN INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER LOGIN INHERIT"),
-		Unimplemented("ALTER USER role_name WITH LOGIN INHERIT"),
+		Converts("ALTER USER role_name WITH LOGIN INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH LOGIN INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH LOGIN INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH LOGIN INHERIT"),
-		Unimplemented("ALTER USER role_name NOLOGIN INHERIT"),
+		Converts("ALTER USER role_name NOLOGIN INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOLOGIN INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER NOLOGIN INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER NOLOGIN INHERIT"),
-		Unimplemented("ALTER USER role_name WITH NOLOGIN INHERIT"),
+		Converts("ALTER USER role_name WITH NOLOGIN INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOLOGIN INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOLOGIN INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOLOGIN INHERIT"),
-		Unimplemented("ALTER USER role_name REPLICATION INHERIT"),
+		Converts("ALTER USER role_name REPLICATION INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE REPLICATION INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER REPLICATION INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER REPLICATION INHERIT"),
-		Unimplemented("ALTER USER role_name WITH REPLICATION INHERIT"),
+		Converts("ALTER USER role_name WITH REPLICATION INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH REPLICATION INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH REPLICATION INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH REPLICATION INHERIT"),
-		Unimplemented("ALTER USER role_name NOREPLICATION INHERIT"),
+		Converts("ALTER USER role_name NOREPLICATION INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOREPLICATION INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER NOREPLICATION INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER NOREPLICATION INHERIT"),
-		Unimplemented("ALTER USER role_name WITH NOREPLICATION INHERIT"),
+		Converts("ALTER USER role_name WITH NOREPLICATION INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOREPLICATION INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOREPLICATION INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOREPLICATION INHERIT"),
-		Unimplemented("ALTER USER role_name BYPASSRLS INHERIT"),
+		Converts("ALTER USER role_name BYPASSRLS INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE BYPASSRLS INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER BYPASSRLS INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER BYPASSRLS INHERIT"),
-		Unimplemented("ALTER USER role_name WITH BYPASSRLS INHERIT"),
+		Converts("ALTER USER role_name WITH BYPASSRLS INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH BYPASSRLS INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH BYPASSRLS INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH BYPASSRLS INHERIT"),
-		Unimplemented("ALTER USER role_name NOBYPASSRLS INHERIT"),
+		Converts("ALTER USER role_name NOBYPASSRLS INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOBYPASSRLS INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER NOBYPASSRLS INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER NOBYPASSRLS INHERIT"),
-		Unimplemented("ALTER USER role_name WITH NOBYPASSRLS INHERIT"),
+		Converts("ALTER USER role_name WITH NOBYPASSRLS INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOBYPASSRLS INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOBYPASSRLS INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOBYPASSRLS INHERIT"),
-		Unimplemented("ALTER USER role_name CONNECTION LIMIT -1 INHERIT"),
+		Converts("ALTER USER role_name CONNECTION LIMIT -1 INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE CONNECTION LIMIT -1 INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER CONNECTION LIMIT -1 INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER CONNECTION LIMIT -1 INHERIT"),
-		Unimplemented("ALTER USER role_name WITH CONNECTION LIMIT -1 INHERIT"),
+		Converts("ALTER USER role_name WITH CONNECTION LIMIT -1 INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CONNECTION LIMIT -1 INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CONNECTION LIMIT -1 INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH CONNECTION LIMIT -1 INHERIT"),
-		Unimplemented("ALTER USER role_name PASSWORD ' password ' INHERIT"),
+		Converts("ALTER USER role_name PASSWORD ' password ' INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD ' password ' INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD ' password ' INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD ' password ' INHERIT"),
-		Unimplemented("ALTER USER role_name WITH PASSWORD ' password ' INHERIT"),
+		Converts("ALTER USER role_name WITH PASSWORD ' password ' INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD ' password ' INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD ' password ' INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD ' password ' INHERIT"),
-		Unimplemented("ALTER USER role_name ENCRYPTED PASSWORD ' password ' INHERIT"),
+		Converts("ALTER USER role_name ENCRYPTED PASSWORD ' password ' INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE ENCRYPTED PASSWORD ' password ' INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER ENCRYPTED PASSWORD ' password ' INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER ENCRYPTED PASSWORD ' password ' INHERIT"),
-		Unimplemented("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' INHERIT"),
+		Converts("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH ENCRYPTED PASSWORD ' password ' INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH ENCRYPTED PASSWORD ' password ' INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH ENCRYPTED PASSWORD ' password ' INHERIT"),
-		Unimplemented("ALTER USER role_name PASSWORD NULL INHERIT"),
+		Converts("ALTER USER role_name PASSWORD NULL INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD NULL INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD NULL INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD NULL INHERIT"),
-		Unimplemented("ALTER USER role_name WITH PASSWORD NULL INHERIT"),
+		Converts("ALTER USER role_name WITH PASSWORD NULL INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD NULL INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD NULL INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD NULL INHERIT"),
-		Unimplemented("ALTER USER role_name VALID UNTIL ' timestamp ' INHERIT"),
+		Parses("ALTER USER role_name VALID UNTIL ' timestamp ' INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE VALID UNTIL ' timestamp ' INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER VALID UNTIL ' timestamp ' INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER VALID UNTIL ' timestamp ' INHERIT"),
-		Unimplemented("ALTER USER role_name WITH VALID UNTIL ' timestamp ' INHERIT"),
+		Parses("ALTER USER role_name WITH VALID UNTIL ' timestamp ' INHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH VALID UNTIL ' timestamp ' INHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH VALID UNTIL ' timestamp ' INHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH VALID UNTIL ' timestamp ' INHERIT"),
-		Unimplemented("ALTER USER role_name SUPERUSER NOINHERIT"),
+		Converts("ALTER USER role_name SUPERUSER NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE SUPERUSER NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER SUPERUSER NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER SUPERUSER NOINHERIT"),
-		Unimplemented("ALTER USER role_name WITH SUPERUSER NOINHERIT"),
+		Converts("ALTER USER role_name WITH SUPERUSER NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH SUPERUSER NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH SUPERUSER NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH SUPERUSER NOINHERIT"),
-		Unimplemented("ALTER USER role_name NOSUPERUSER NOINHERIT"),
+		Converts("ALTER USER role_name NOSUPERUSER NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOSUPERUSER NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER NOSUPERUSER NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER NOSUPERUSER NOINHERIT"),
-		Unimplemented("ALTER USER role_name WITH NOSUPERUSER NOINHERIT"),
+		Converts("ALTER USER role_name WITH NOSUPERUSER NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOSUPERUSER NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOSUPERUSER NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOSUPERUSER NOINHERIT"),
-		Unimplemented("ALTER USER role_name CREATEDB NOINHERIT"),
+		Converts("ALTER USER role_name CREATEDB NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEDB NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEDB NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER CREATEDB NOINHERIT"),
-		Unimplemented("ALTER USER role_name WITH CREATEDB NOINHERIT"),
+		Converts("ALTER USER role_name WITH CREATEDB NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEDB NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEDB NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEDB NOINHERIT"),
-		Unimplemented("ALTER USER role_name NOCREATEDB NOINHERIT"),
+		Converts("ALTER USER role_name NOCREATEDB NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEDB NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEDB NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEDB NOINHERIT"),
-		Unimplemented("ALTER USER role_name WITH NOCREATEDB NOINHERIT"),
+		Converts("ALTER USER role_name WITH NOCREATEDB NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEDB NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEDB NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEDB NOINHERIT"),
-		Unimplemented("ALTER USER role_name CREATEROLE NOINHERIT"),
+		Converts("ALTER USER role_name CREATEROLE NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEROLE NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEROLE NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER CREATEROLE NOINHERIT"),
-		Unimplemented("ALTER USER role_name WITH CREATEROLE NOINHERIT"),
+		Converts("ALTER USER role_name WITH CREATEROLE NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEROLE NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEROLE NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEROLE NOINHERIT"),
-		Unimplemented("ALTER USER role_name NOCREATEROLE NOINHERIT"),
+		Converts("ALTER USER role_name NOCREATEROLE NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEROLE NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEROLE NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEROLE NOINHERIT"),
-		Unimplemented("ALTER USER role_name WITH NOCREATEROLE NOINHERIT"),
+		Converts("ALTER USER role_name WITH NOCREATEROLE NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEROLE NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEROLE NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEROLE NOINHERIT"),
-		Unimplemented("ALTER USER role_name INHERIT NOINHERIT"),
+		Parses("ALTER USER role_name INHERIT NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE INHERIT NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER INHERIT NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER INHERIT NOINHERIT"),
-		Unimplemented("ALTER USER role_name WITH INHERIT NOINHERIT"),
+		Parses("ALTER USER role_name WITH INHERIT NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH INHERIT NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH INHERIT NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH INHERIT NOINHERIT"),
-		Unimplemented("ALTER USER role_name NOINHERIT NOINHERIT"),
+		Parses("ALTER USER role_name NOINHERIT NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOINHERIT NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER NOINHERIT NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER NOINHERIT NOINHERIT"),
-		Unimplemented("ALTER USER role_name WITH NOINHERIT NOINHERIT"),
+		Parses("ALTER USER role_name WITH NOINHERIT NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOINHERIT NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOINHERIT NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOINHERIT NOINHERIT"),
-		Unimplemented("ALTER USER role_name LOGIN NOINHERIT"),
+		Converts("ALTER USER role_name LOGIN NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE LOGIN NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER LOGIN NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER LOGIN NOINHERIT"),
-		Unimplemented("ALTER USER role_name WITH LOGIN NOINHERIT"),
+		Converts("ALTER USER role_name WITH LOGIN NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH LOGIN NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH LOGIN NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH LOGIN NOINHERIT"),
-		Unimplemented("ALTER USER role_name NOLOGIN NOINHERIT"),
+		Converts("ALTER USER role_name NOLOGIN NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOLOGIN NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER NOLOGIN NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER NOLOGIN NOINHERIT"),
-		Unimplemented("ALTER USER role_name WITH NOLOGIN NOINHERIT"),
+		Converts("ALTER USER role_name WITH NOLOGIN NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOLOGIN NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOLOGIN NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOLOGIN NOINHERIT"),
-		Unimplemented("ALTER USER role_name REPLICATION NOINHERIT"),
+		Converts("ALTER USER role_name REPLICATION NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE REPLICATION NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER REPLICATION NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER REPLICATION NOINHERIT"),
-		Unimplemented("ALTER USER role_name WITH REPLICATION NOINHERIT"),
+		Converts("ALTER USER role_name WITH REPLICATION NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH REPLICATION NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH REPLICATION NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH REPLICATION NOINHERIT"),
-		Unimplemented("ALTER USER role_name NOREPLICATION NOINHERIT"),
+		Converts("ALTER USER role_name NOREPLICATION NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOREPLICATION NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER NOREPLICATION NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER NOREPLICATION NOINHERIT"),
-		Unimplemented("ALTER USER role_name WITH NOREPLICATION NOINHERIT"),
+		Converts("ALTER USER role_name WITH NOREPLICATION NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOREPLICATION NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOREPLICATION NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOREPLICATION NOINHERIT"),
-		Unimplemented("ALTER USER role_name BYPASSRLS NOINHERIT"),
+		Converts("ALTER USER role_name BYPASSRLS NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE BYPASSRLS NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER BYPASSRLS NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER BYPASSRLS NOINHERIT"),
-		Unimplemented("ALTER USER role_name WITH BYPASSRLS NOINHERIT"),
+		Converts("ALTER USER role_name WITH BYPASSRLS NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH BYPASSRLS NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH BYPASSRLS NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH BYPASSRLS NOINHERIT"),
-		Unimplemented("ALTER USER role_name NOBYPASSRLS NOINHERIT"),
+		Converts("ALTER USER role_name NOBYPASSRLS NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOBYPASSRLS NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER NOBYPASSRLS NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER NOBYPASSRLS NOINHERIT"),
-		Unimplemented("ALTER USER role_name WITH NOBYPASSRLS NOINHERIT"),
+		Converts("ALTER USER role_name WITH NOBYPASSRLS NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOBYPASSRLS NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOBYPASSRLS NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOBYPASSRLS NOINHERIT"),
-		Unimplemented("ALTER USER role_name CONNECTION LIMIT -1 NOINHERIT"),
+		Converts("ALTER USER role_name CONNECTION LIMIT -1 NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE CONNECTION LIMIT -1 NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER CONNECTION LIMIT -1 NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER CONNECTION LIMIT -1 NOINHERIT"),
-		Unimplemented("ALTER USER role_name WITH CONNECTION LIMIT -1 NOINHERIT"),
+		Converts("ALTER USER role_name WITH CONNECTION LIMIT -1 NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CONNECTION LIMIT -1 NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CONNECTION LIMIT -1 NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH CONNECTION LIMIT -1 NOINHERIT"),
-		Unimplemented("ALTER USER role_name PASSWORD ' password ' NOINHERIT"),
+		Converts("ALTER USER role_name PASSWORD ' password ' NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD ' password ' NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD ' password ' NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD ' password ' NOINHERIT"),
-		Unimplemented("ALTER USER role_name WITH PASSWORD ' password ' NOINHERIT"),
+		Converts("ALTER USER role_name WITH PASSWORD ' password ' NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD ' password ' NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD ' password ' NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD ' password ' NOINHERIT"),
-		Unimplemented("ALTER USER role_name ENCRYPTED PASSWORD ' password ' NOINHERIT"),
+		Converts("ALTER USER role_name ENCRYPTED PASSWORD ' password ' NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE ENCRYPTED PASSWORD ' password ' NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER ENCRYPTED PASSWORD ' password ' NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER ENCRYPTED PASSWORD ' password ' NOINHERIT"),
-		Unimplemented("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' NOINHERIT"),
+		Converts("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH ENCRYPTED PASSWORD ' password ' NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH ENCRYPTED PASSWORD ' password ' NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH ENCRYPTED PASSWORD ' password ' NOINHERIT"),
-		Unimplemented("ALTER USER role_name PASSWORD NULL NOINHERIT"),
+		Converts("ALTER USER role_name PASSWORD NULL NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD NULL NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD NULL NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD NULL NOINHERIT"),
-		Unimplemented("ALTER USER role_name WITH PASSWORD NULL NOINHERIT"),
+		Converts("ALTER USER role_name WITH PASSWORD NULL NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD NULL NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD NULL NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD NULL NOINHERIT"),
-		Unimplemented("ALTER USER role_name VALID UNTIL ' timestamp ' NOINHERIT"),
+		Parses("ALTER USER role_name VALID UNTIL ' timestamp ' NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE VALID UNTIL ' timestamp ' NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER VALID UNTIL ' timestamp ' NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER VALID UNTIL ' timestamp ' NOINHERIT"),
-		Unimplemented("ALTER USER role_name WITH VALID UNTIL ' timestamp ' NOINHERIT"),
+		Parses("ALTER USER role_name WITH VALID UNTIL ' timestamp ' NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH VALID UNTIL ' timestamp ' NOINHERIT"),
 		Unimplemented("ALTER USER CURRENT_USER WITH VALID UNTIL ' timestamp ' NOINHERIT"),
 		Unimplemented("ALTER USER SESSION_USER WITH VALID UNTIL ' timestamp ' NOINHERIT"),
-		Unimplemented("ALTER USER role_name SUPERUSER LOGIN"),
+		Converts("ALTER USER role_name SUPERUSER LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE SUPERUSER LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER SUPERUSER LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER SUPERUSER LOGIN"),
-		Unimplemented("ALTER USER role_name WITH SUPERUSER LOGIN"),
+		Converts("ALTER USER role_name WITH SUPERUSER LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH SUPERUSER LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH SUPERUSER LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH SUPERUSER LOGIN"),
-		Unimplemented("ALTER USER role_name NOSUPERUSER LOGIN"),
+		Converts("ALTER USER role_name NOSUPERUSER LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOSUPERUSER LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER NOSUPERUSER LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER NOSUPERUSER LOGIN"),
-		Unimplemented("ALTER USER role_name WITH NOSUPERUSER LOGIN"),
+		Converts("ALTER USER role_name WITH NOSUPERUSER LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOSUPERUSER LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOSUPERUSER LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOSUPERUSER LOGIN"),
-		Parses("ALTER USER role_name CREATEDB LOGIN"),
+		Converts("ALTER USER role_name CREATEDB LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEDB LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEDB LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER CREATEDB LOGIN"),
-		Parses("ALTER USER role_name WITH CREATEDB LOGIN"),
+		Converts("ALTER USER role_name WITH CREATEDB LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEDB LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEDB LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEDB LOGIN"),
-		Parses("ALTER USER role_name NOCREATEDB LOGIN"),
+		Converts("ALTER USER role_name NOCREATEDB LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEDB LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEDB LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEDB LOGIN"),
-		Parses("ALTER USER role_name WITH NOCREATEDB LOGIN"),
+		Converts("ALTER USER role_name WITH NOCREATEDB LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEDB LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEDB LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEDB LOGIN"),
-		Parses("ALTER USER role_name CREATEROLE LOGIN"),
+		Converts("ALTER USER role_name CREATEROLE LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEROLE LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEROLE LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER CREATEROLE LOGIN"),
-		Parses("ALTER USER role_name WITH CREATEROLE LOGIN"),
+		Converts("ALTER USER role_name WITH CREATEROLE LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEROLE LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEROLE LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEROLE LOGIN"),
-		Parses("ALTER USER role_name NOCREATEROLE LOGIN"),
+		Converts("ALTER USER role_name NOCREATEROLE LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEROLE LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEROLE LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEROLE LOGIN"),
-		Parses("ALTER USER role_name WITH NOCREATEROLE LOGIN"),
+		Converts("ALTER USER role_name WITH NOCREATEROLE LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEROLE LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEROLE LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEROLE LOGIN"),
-		Unimplemented("ALTER USER role_name INHERIT LOGIN"),
+		Converts("ALTER USER role_name INHERIT LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE INHERIT LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER INHERIT LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER INHERIT LOGIN"),
-		Unimplemented("ALTER USER role_name WITH INHERIT LOGIN"),
+		Converts("ALTER USER role_name WITH INHERIT LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH INHERIT LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH INHERIT LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH INHERIT LOGIN"),
-		Unimplemented("ALTER USER role_name NOINHERIT LOGIN"),
+		Converts("ALTER USER role_name NOINHERIT LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOINHERIT LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER NOINHERIT LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER NOINHERIT LOGIN"),
-		Unimplemented("ALTER USER role_name WITH NOINHERIT LOGIN"),
+		Converts("ALTER USER role_name WITH NOINHERIT LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOINHERIT LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOINHERIT LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOINHERIT LOGIN"),
@@ -1466,67 +1466,67 @@ func TestAlterUser(t *testing.T) {
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOLOGIN LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOLOGIN LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOLOGIN LOGIN"),
-		Unimplemented("ALTER USER role_name REPLICATION LOGIN"),
+		Converts("ALTER USER role_name REPLICATION LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE REPLICATION LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER REPLICATION LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER REPLICATION LOGIN"),
-		Unimplemented("ALTER USER role_name WITH REPLICATION LOGIN"),
+		Converts("ALTER USER role_name WITH REPLICATION LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH REPLICATION LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH REPLICATION LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH REPLICATION LOGIN"),
-		Unimplemented("ALTER USER role_name NOREPLICATION LOGIN"),
+		Converts("ALTER USER role_name NOREPLICATION LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOREPLICATION LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER NOREPLICATION LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER NOREPLICATION LOGIN"),
-		Unimplemented("ALTER USER role_name WITH NOREPLICATION LOGIN"),
+		Converts("ALTER USER role_name WITH NOREPLICATION LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOREPLICATION LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOREPLICATION LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOREPLICATION LOGIN"),
-		Unimplemented("ALTER USER role_name BYPASSRLS LOGIN"),
+		Converts("ALTER USER role_name BYPASSRLS LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE BYPASSRLS LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER BYPASSRLS LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER BYPASSRLS LOGIN"),
-		Unimplemented("ALTER USER role_name WITH BYPASSRLS LOGIN"),
+		Converts("ALTER USER role_name WITH BYPASSRLS LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH BYPASSRLS LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH BYPASSRLS LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH BYPASSRLS LOGIN"),
-		Unimplemented("ALTER USER role_name NOBYPASSRLS LOGIN"),
+		Converts("ALTER USER role_name NOBYPASSRLS LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOBYPASSRLS LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER NOBYPASSRLS LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER NOBYPASSRLS LOGIN"),
-		Unimplemented("ALTER USER role_name WITH NOBYPASSRLS LOGIN"),
+		Converts("ALTER USER role_name WITH NOBYPASSRLS LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOBYPASSRLS LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOBYPASSRLS LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOBYPASSRLS LOGIN"),
-		Unimplemented("ALTER USER role_name CONNECTION LIMIT -1 LOGIN"),
+		Converts("ALTER USER role_name CONNECTION LIMIT -1 LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE CONNECTION LIMIT -1 LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER CONNECTION LIMIT -1 LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER CONNECTION LIMIT -1 LOGIN"),
-		Unimplemented("ALTER USER role_name WITH CONNECTION LIMIT -1 LOGIN"),
+		Converts("ALTER USER role_name WITH CONNECTION LIMIT -1 LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CONNECTION LIMIT -1 LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CONNECTION LIMIT -1 LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH CONNECTION LIMIT -1 LOGIN"),
-		Parses("ALTER USER role_name PASSWORD ' password ' LOGIN"),
+		Converts("ALTER USER role_name PASSWORD ' password ' LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD ' password ' LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD ' password ' LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD ' password ' LOGIN"),
-		Parses("ALTER USER role_name WITH PASSWORD ' password ' LOGIN"),
+		Converts("ALTER USER role_name WITH PASSWORD ' password ' LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD ' password ' LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD ' password ' LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD ' password ' LOGIN"),
-		Unimplemented("ALTER USER role_name ENCRYPTED PASSWORD ' password ' LOGIN"),
+		Converts("ALTER USER role_name ENCRYPTED PASSWORD ' password ' LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE ENCRYPTED PASSWORD ' password ' LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER ENCRYPTED PASSWORD ' password ' LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER ENCRYPTED PASSWORD ' password ' LOGIN"),
-		Unimplemented("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' LOGIN"),
+		Converts("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH ENCRYPTED PASSWORD ' password ' LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH ENCRYPTED PASSWORD ' password ' LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH ENCRYPTED PASSWORD ' password ' LOGIN"),
-		Parses("ALTER USER role_name PASSWORD NULL LOGIN"),
+		Converts("ALTER USER role_name PASSWORD NULL LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD NULL LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD NULL LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD NULL LOGIN"),
-		Parses("ALTER USER role_name WITH PASSWORD NULL LOGIN"),
+		Converts("ALTER USER role_name WITH PASSWORD NULL LOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD NULL LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD NULL LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD NULL LOGIN"),
@@ -1538,67 +1538,67 @@ func TestAlterUser(t *testing.T) {
 		Unimplemented("ALTER USER CURRENT_ROLE WITH VALID UNTIL ' timestamp ' LOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH VALID UNTIL ' timestamp ' LOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH VALID UNTIL ' timestamp ' LOGIN"),
-		Unimplemented("ALTER USER role_name SUPERUSER NOLOGIN"),
+		Converts("ALTER USER role_name SUPERUSER NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE SUPERUSER NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER SUPERUSER NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER SUPERUSER NOLOGIN"),
-		Unimplemented("ALTER USER role_name WITH SUPERUSER NOLOGIN"),
+		Converts("ALTER USER role_name WITH SUPERUSER NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH SUPERUSER NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH SUPERUSER NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH SUPERUSER NOLOGIN"),
-		Unimplemented("ALTER USER role_name NOSUPERUSER NOLOGIN"),
+		Converts("ALTER USER role_name NOSUPERUSER NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOSUPERUSER NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER NOSUPERUSER NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER NOSUPERUSER NOLOGIN"),
-		Unimplemented("ALTER USER role_name WITH NOSUPERUSER NOLOGIN"),
+		Converts("ALTER USER role_name WITH NOSUPERUSER NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOSUPERUSER NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOSUPERUSER NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOSUPERUSER NOLOGIN"),
-		Parses("ALTER USER role_name CREATEDB NOLOGIN"),
+		Converts("ALTER USER role_name CREATEDB NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEDB NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEDB NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER CREATEDB NOLOGIN"),
-		Parses("ALTER USER role_name WITH CREATEDB NOLOGIN"),
+		Converts("ALTER USER role_name WITH CREATEDB NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEDB NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEDB NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEDB NOLOGIN"),
-		Parses("ALTER USER role_name NOCREATEDB NOLOGIN"),
+		Converts("ALTER USER role_name NOCREATEDB NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEDB NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEDB NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEDB NOLOGIN"),
-		Parses("ALTER USER role_name WITH NOCREATEDB NOLOGIN"),
+		Converts("ALTER USER role_name WITH NOCREATEDB NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEDB NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEDB NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEDB NOLOGIN"),
-		Parses("ALTER USER role_name CREATEROLE NOLOGIN"),
+		Converts("ALTER USER role_name CREATEROLE NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEROLE NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEROLE NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER CREATEROLE NOLOGIN"),
-		Parses("ALTER USER role_name WITH CREATEROLE NOLOGIN"),
+		Converts("ALTER USER role_name WITH CREATEROLE NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEROLE NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEROLE NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEROLE NOLOGIN"),
-		Parses("ALTER USER role_name NOCREATEROLE NOLOGIN"),
+		Converts("ALTER USER role_name NOCREATEROLE NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEROLE NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEROLE NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEROLE NOLOGIN"),
-		Parses("ALTER USER role_name WITH NOCREATEROLE NOLOGIN"),
+		Converts("ALTER USER role_name WITH NOCREATEROLE NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEROLE NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEROLE NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEROLE NOLOGIN"),
-		Unimplemented("ALTER USER role_name INHERIT NOLOGIN"),
+		Converts("ALTER USER role_name INHERIT NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE INHERIT NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER INHERIT NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER INHERIT NOLOGIN"),
-		Unimplemented("ALTER USER role_name WITH INHERIT NOLOGIN"),
+		Converts("ALTER USER role_name WITH INHERIT NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH INHERIT NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH INHERIT NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH INHERIT NOLOGIN"),
-		Unimplemented("ALTER USER role_name NOINHERIT NOLOGIN"),
+		Converts("ALTER USER role_name NOINHERIT NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOINHERIT NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER NOINHERIT NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER NOINHERIT NOLOGIN"),
-		Unimplemented("ALTER USER role_name WITH NOINHERIT NOLOGIN"),
+		Converts("ALTER USER role_name WITH NOINHERIT NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOINHERIT NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOINHERIT NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOINHERIT NOLOGIN"),
@@ -1618,67 +1618,67 @@ func TestAlterUser(t *testing.T) {
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOLOGIN NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOLOGIN NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOLOGIN NOLOGIN"),
-		Unimplemented("ALTER USER role_name REPLICATION NOLOGIN"),
+		Converts("ALTER USER role_name REPLICATION NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE REPLICATION NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER REPLICATION NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER REPLICATION NOLOGIN"),
-		Unimplemented("ALTER USER role_name WITH REPLICATION NOLOGIN"),
+		Converts("ALTER USER role_name WITH REPLICATION NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH REPLICATION NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH REPLICATION NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH REPLICATION NOLOGIN"),
-		Unimplemented("ALTER USER role_name NOREPLICATION NOLOGIN"),
+		Converts("ALTER USER role_name NOREPLICATION NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOREPLICATION NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER NOREPLICATION NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER NOREPLICATION NOLOGIN"),
-		Unimplemented("ALTER USER role_name WITH NOREPLICATION NOLOGIN"),
+		Converts("ALTER USER role_name WITH NOREPLICATION NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOREPLICATION NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOREPLICATION NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOREPLICATION NOLOGIN"),
-		Unimplemented("ALTER USER role_name BYPASSRLS NOLOGIN"),
+		Converts("ALTER USER role_name BYPASSRLS NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE BYPASSRLS NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER BYPASSRLS NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER BYPASSRLS NOLOGIN"),
-		Unimplemented("ALTER USER role_name WITH BYPASSRLS NOLOGIN"),
+		Converts("ALTER USER role_name WITH BYPASSRLS NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH BYPASSRLS NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH BYPASSRLS NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH BYPASSRLS NOLOGIN"),
-		Unimplemented("ALTER USER role_name NOBYPASSRLS NOLOGIN"),
+		Converts("ALTER USER role_name NOBYPASSRLS NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOBYPASSRLS NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER NOBYPASSRLS NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER NOBYPASSRLS NOLOGIN"),
-		Unimplemented("ALTER USER role_name WITH NOBYPASSRLS NOLOGIN"),
+		Converts("ALTER USER role_name WITH NOBYPASSRLS NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOBYPASSRLS NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOBYPASSRLS NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOBYPASSRLS NOLOGIN"),
-		Unimplemented("ALTER USER role_name CONNECTION LIMIT -1 NOLOGIN"),
+		Converts("ALTER USER role_name CONNECTION LIMIT -1 NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE CONNECTION LIMIT -1 NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER CONNECTION LIMIT -1 NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER CONNECTION LIMIT -1 NOLOGIN"),
-		Unimplemented("ALTER USER role_name WITH CONNECTION LIMIT -1 NOLOGIN"),
+		Converts("ALTER USER role_name WITH CONNECTION LIMIT -1 NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CONNECTION LIMIT -1 NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CONNECTION LIMIT -1 NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH CONNECTION LIMIT -1 NOLOGIN"),
-		Parses("ALTER USER role_name PASSWORD ' password ' NOLOGIN"),
+		Converts("ALTER USER role_name PASSWORD ' password ' NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD ' password ' NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD ' password ' NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD ' password ' NOLOGIN"),
-		Parses("ALTER USER role_name WITH PASSWORD ' password ' NOLOGIN"),
+		Converts("ALTER USER role_name WITH PASSWORD ' password ' NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD ' password ' NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD ' password ' NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD ' password ' NOLOGIN"),
-		Unimplemented("ALTER USER role_name ENCRYPTED PASSWORD ' password ' NOLOGIN"),
+		Converts("ALTER USER role_name ENCRYPTED PASSWORD ' password ' NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE ENCRYPTED PASSWORD ' password ' NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER ENCRYPTED PASSWORD ' password ' NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER ENCRYPTED PASSWORD ' password ' NOLOGIN"),
-		Unimplemented("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' NOLOGIN"),
+		Converts("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH ENCRYPTED PASSWORD ' password ' NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH ENCRYPTED PASSWORD ' password ' NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH ENCRYPTED PASSWORD ' password ' NOLOGIN"),
-		Parses("ALTER USER role_name PASSWORD NULL NOLOGIN"),
+		Converts("ALTER USER role_name PASSWORD NULL NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD NULL NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD NULL NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD NULL NOLOGIN"),
-		Parses("ALTER USER role_name WITH PASSWORD NULL NOLOGIN"),
+		Converts("ALTER USER role_name WITH PASSWORD NULL NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD NULL NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD NULL NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD NULL NOLOGIN"),
@@ -1690,883 +1690,883 @@ func TestAlterUser(t *testing.T) {
 		Unimplemented("ALTER USER CURRENT_ROLE WITH VALID UNTIL ' timestamp ' NOLOGIN"),
 		Unimplemented("ALTER USER CURRENT_USER WITH VALID UNTIL ' timestamp ' NOLOGIN"),
 		Unimplemented("ALTER USER SESSION_USER WITH VALID UNTIL ' timestamp ' NOLOGIN"),
-		Unimplemented("ALTER USER role_name SUPERUSER REPLICATION"),
+		Converts("ALTER USER role_name SUPERUSER REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE SUPERUSER REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER SUPERUSER REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER SUPERUSER REPLICATION"),
-		Unimplemented("ALTER USER role_name WITH SUPERUSER REPLICATION"),
+		Converts("ALTER USER role_name WITH SUPERUSER REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH SUPERUSER REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH SUPERUSER REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH SUPERUSER REPLICATION"),
-		Unimplemented("ALTER USER role_name NOSUPERUSER REPLICATION"),
+		Converts("ALTER USER role_name NOSUPERUSER REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOSUPERUSER REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER NOSUPERUSER REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER NOSUPERUSER REPLICATION"),
-		Unimplemented("ALTER USER role_name WITH NOSUPERUSER REPLICATION"),
+		Converts("ALTER USER role_name WITH NOSUPERUSER REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOSUPERUSER REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOSUPERUSER REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOSUPERUSER REPLICATION"),
-		Unimplemented("ALTER USER role_name CREATEDB REPLICATION"),
+		Converts("ALTER USER role_name CREATEDB REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEDB REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEDB REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER CREATEDB REPLICATION"),
-		Unimplemented("ALTER USER role_name WITH CREATEDB REPLICATION"),
+		Converts("ALTER USER role_name WITH CREATEDB REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEDB REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEDB REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEDB REPLICATION"),
-		Unimplemented("ALTER USER role_name NOCREATEDB REPLICATION"),
+		Converts("ALTER USER role_name NOCREATEDB REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEDB REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEDB REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEDB REPLICATION"),
-		Unimplemented("ALTER USER role_name WITH NOCREATEDB REPLICATION"),
+		Converts("ALTER USER role_name WITH NOCREATEDB REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEDB REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEDB REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEDB REPLICATION"),
-		Unimplemented("ALTER USER role_name CREATEROLE REPLICATION"),
+		Converts("ALTER USER role_name CREATEROLE REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEROLE REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEROLE REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER CREATEROLE REPLICATION"),
-		Unimplemented("ALTER USER role_name WITH CREATEROLE REPLICATION"),
+		Converts("ALTER USER role_name WITH CREATEROLE REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEROLE REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEROLE REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEROLE REPLICATION"),
-		Unimplemented("ALTER USER role_name NOCREATEROLE REPLICATION"),
+		Converts("ALTER USER role_name NOCREATEROLE REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEROLE REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEROLE REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEROLE REPLICATION"),
-		Unimplemented("ALTER USER role_name WITH NOCREATEROLE REPLICATION"),
+		Converts("ALTER USER role_name WITH NOCREATEROLE REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEROLE REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEROLE REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEROLE REPLICATION"),
-		Unimplemented("ALTER USER role_name INHERIT REPLICATION"),
+		Converts("ALTER USER role_name INHERIT REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE INHERIT REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER INHERIT REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER INHERIT REPLICATION"),
-		Unimplemented("ALTER USER role_name WITH INHERIT REPLICATION"),
+		Converts("ALTER USER role_name WITH INHERIT REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH INHERIT REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH INHERIT REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH INHERIT REPLICATION"),
-		Unimplemented("ALTER USER role_name NOINHERIT REPLICATION"),
+		Converts("ALTER USER role_name NOINHERIT REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOINHERIT REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER NOINHERIT REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER NOINHERIT REPLICATION"),
-		Unimplemented("ALTER USER role_name WITH NOINHERIT REPLICATION"),
+		Converts("ALTER USER role_name WITH NOINHERIT REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOINHERIT REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOINHERIT REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOINHERIT REPLICATION"),
-		Unimplemented("ALTER USER role_name LOGIN REPLICATION"),
+		Converts("ALTER USER role_name LOGIN REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE LOGIN REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER LOGIN REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER LOGIN REPLICATION"),
-		Unimplemented("ALTER USER role_name WITH LOGIN REPLICATION"),
+		Converts("ALTER USER role_name WITH LOGIN REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH LOGIN REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH LOGIN REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH LOGIN REPLICATION"),
-		Unimplemented("ALTER USER role_name NOLOGIN REPLICATION"),
+		Converts("ALTER USER role_name NOLOGIN REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOLOGIN REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER NOLOGIN REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER NOLOGIN REPLICATION"),
-		Unimplemented("ALTER USER role_name WITH NOLOGIN REPLICATION"),
+		Converts("ALTER USER role_name WITH NOLOGIN REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOLOGIN REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOLOGIN REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOLOGIN REPLICATION"),
-		Unimplemented("ALTER USER role_name REPLICATION REPLICATION"),
+		Parses("ALTER USER role_name REPLICATION REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE REPLICATION REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER REPLICATION REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER REPLICATION REPLICATION"),
-		Unimplemented("ALTER USER role_name WITH REPLICATION REPLICATION"),
+		Parses("ALTER USER role_name WITH REPLICATION REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH REPLICATION REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH REPLICATION REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH REPLICATION REPLICATION"),
-		Unimplemented("ALTER USER role_name NOREPLICATION REPLICATION"),
+		Parses("ALTER USER role_name NOREPLICATION REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOREPLICATION REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER NOREPLICATION REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER NOREPLICATION REPLICATION"),
-		Unimplemented("ALTER USER role_name WITH NOREPLICATION REPLICATION"),
+		Parses("ALTER USER role_name WITH NOREPLICATION REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOREPLICATION REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOREPLICATION REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOREPLICATION REPLICATION"),
-		Unimplemented("ALTER USER role_name BYPASSRLS REPLICATION"),
+		Converts("ALTER USER role_name BYPASSRLS REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE BYPASSRLS REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER BYPASSRLS REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER BYPASSRLS REPLICATION"),
-		Unimplemented("ALTER USER role_name WITH BYPASSRLS REPLICATION"),
+		Converts("ALTER USER role_name WITH BYPASSRLS REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH BYPASSRLS REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH BYPASSRLS REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH BYPASSRLS REPLICATION"),
-		Unimplemented("ALTER USER role_name NOBYPASSRLS REPLICATION"),
+		Converts("ALTER USER role_name NOBYPASSRLS REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOBYPASSRLS REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER NOBYPASSRLS REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER NOBYPASSRLS REPLICATION"),
-		Unimplemented("ALTER USER role_name WITH NOBYPASSRLS REPLICATION"),
+		Converts("ALTER USER role_name WITH NOBYPASSRLS REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOBYPASSRLS REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOBYPASSRLS REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOBYPASSRLS REPLICATION"),
-		Unimplemented("ALTER USER role_name CONNECTION LIMIT -1 REPLICATION"),
+		Converts("ALTER USER role_name CONNECTION LIMIT -1 REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE CONNECTION LIMIT -1 REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER CONNECTION LIMIT -1 REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER CONNECTION LIMIT -1 REPLICATION"),
-		Unimplemented("ALTER USER role_name WITH CONNECTION LIMIT -1 REPLICATION"),
+		Converts("ALTER USER role_name WITH CONNECTION LIMIT -1 REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CONNECTION LIMIT -1 REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CONNECTION LIMIT -1 REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH CONNECTION LIMIT -1 REPLICATION"),
-		Unimplemented("ALTER USER role_name PASSWORD ' password ' REPLICATION"),
+		Converts("ALTER USER role_name PASSWORD ' password ' REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD ' password ' REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD ' password ' REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD ' password ' REPLICATION"),
-		Unimplemented("ALTER USER role_name WITH PASSWORD ' password ' REPLICATION"),
+		Converts("ALTER USER role_name WITH PASSWORD ' password ' REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD ' password ' REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD ' password ' REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD ' password ' REPLICATION"),
-		Unimplemented("ALTER USER role_name ENCRYPTED PASSWORD ' password ' REPLICATION"),
+		Converts("ALTER USER role_name ENCRYPTED PASSWORD ' password ' REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE ENCRYPTED PASSWORD ' password ' REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER ENCRYPTED PASSWORD ' password ' REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER ENCRYPTED PASSWORD ' password ' REPLICATION"),
-		Unimplemented("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' REPLICATION"),
+		Converts("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH ENCRYPTED PASSWORD ' password ' REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH ENCRYPTED PASSWORD ' password ' REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH ENCRYPTED PASSWORD ' password ' REPLICATION"),
-		Unimplemented("ALTER USER role_name PASSWORD NULL REPLICATION"),
+		Converts("ALTER USER role_name PASSWORD NULL REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD NULL REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD NULL REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD NULL REPLICATION"),
-		Unimplemented("ALTER USER role_name WITH PASSWORD NULL REPLICATION"),
+		Converts("ALTER USER role_name WITH PASSWORD NULL REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD NULL REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD NULL REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD NULL REPLICATION"),
-		Unimplemented("ALTER USER role_name VALID UNTIL ' timestamp ' REPLICATION"),
+		Parses("ALTER USER role_name VALID UNTIL ' timestamp ' REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE VALID UNTIL ' timestamp ' REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER VALID UNTIL ' timestamp ' REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER VALID UNTIL ' timestamp ' REPLICATION"),
-		Unimplemented("ALTER USER role_name WITH VALID UNTIL ' timestamp ' REPLICATION"),
+		Parses("ALTER USER role_name WITH VALID UNTIL ' timestamp ' REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH VALID UNTIL ' timestamp ' REPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH VALID UNTIL ' timestamp ' REPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH VALID UNTIL ' timestamp ' REPLICATION"),
-		Unimplemented("ALTER USER role_name SUPERUSER NOREPLICATION"),
+		Converts("ALTER USER role_name SUPERUSER NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE SUPERUSER NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER SUPERUSER NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER SUPERUSER NOREPLICATION"),
-		Unimplemented("ALTER USER role_name WITH SUPERUSER NOREPLICATION"),
+		Converts("ALTER USER role_name WITH SUPERUSER NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH SUPERUSER NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH SUPERUSER NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH SUPERUSER NOREPLICATION"),
-		Unimplemented("ALTER USER role_name NOSUPERUSER NOREPLICATION"),
+		Converts("ALTER USER role_name NOSUPERUSER NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOSUPERUSER NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER NOSUPERUSER NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER NOSUPERUSER NOREPLICATION"),
-		Unimplemented("ALTER USER role_name WITH NOSUPERUSER NOREPLICATION"),
+		Converts("ALTER USER role_name WITH NOSUPERUSER NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOSUPERUSER NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOSUPERUSER NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOSUPERUSER NOREPLICATION"),
-		Unimplemented("ALTER USER role_name CREATEDB NOREPLICATION"),
+		Converts("ALTER USER role_name CREATEDB NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEDB NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEDB NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER CREATEDB NOREPLICATION"),
-		Unimplemented("ALTER USER role_name WITH CREATEDB NOREPLICATION"),
+		Converts("ALTER USER role_name WITH CREATEDB NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEDB NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEDB NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEDB NOREPLICATION"),
-		Unimplemented("ALTER USER role_name NOCREATEDB NOREPLICATION"),
+		Converts("ALTER USER role_name NOCREATEDB NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEDB NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEDB NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEDB NOREPLICATION"),
-		Unimplemented("ALTER USER role_name WITH NOCREATEDB NOREPLICATION"),
+		Converts("ALTER USER role_name WITH NOCREATEDB NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEDB NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEDB NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEDB NOREPLICATION"),
-		Unimplemented("ALTER USER role_name CREATEROLE NOREPLICATION"),
+		Converts("ALTER USER role_name CREATEROLE NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEROLE NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEROLE NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER CREATEROLE NOREPLICATION"),
-		Unimplemented("ALTER USER role_name WITH CREATEROLE NOREPLICATION"),
+		Converts("ALTER USER role_name WITH CREATEROLE NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEROLE NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEROLE NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEROLE NOREPLICATION"),
-		Unimplemented("ALTER USER role_name NOCREATEROLE NOREPLICATION"),
+		Converts("ALTER USER role_name NOCREATEROLE NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEROLE NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEROLE NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEROLE NOREPLICATION"),
-		Unimplemented("ALTER USER role_name WITH NOCREATEROLE NOREPLICATION"),
+		Converts("ALTER USER role_name WITH NOCREATEROLE NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEROLE NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEROLE NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEROLE NOREPLICATION"),
-		Unimplemented("ALTER USER role_name INHERIT NOREPLICATION"),
+		Converts("ALTER USER role_name INHERIT NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE INHERIT NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER INHERIT NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER INHERIT NOREPLICATION"),
-		Unimplemented("ALTER USER role_name WITH INHERIT NOREPLICATION"),
+		Converts("ALTER USER role_name WITH INHERIT NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH INHERIT NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH INHERIT NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH INHERIT NOREPLICATION"),
-		Unimplemented("ALTER USER role_name NOINHERIT NOREPLICATION"),
+		Converts("ALTER USER role_name NOINHERIT NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOINHERIT NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER NOINHERIT NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER NOINHERIT NOREPLICATION"),
-		Unimplemented("ALTER USER role_name WITH NOINHERIT NOREPLICATION"),
+		Converts("ALTER USER role_name WITH NOINHERIT NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOINHERIT NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOINHERIT NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOINHERIT NOREPLICATION"),
-		Unimplemented("ALTER USER role_name LOGIN NOREPLICATION"),
+		Converts("ALTER USER role_name LOGIN NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE LOGIN NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER LOGIN NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER LOGIN NOREPLICATION"),
-		Unimplemented("ALTER USER role_name WITH LOGIN NOREPLICATION"),
+		Converts("ALTER USER role_name WITH LOGIN NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH LOGIN NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH LOGIN NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH LOGIN NOREPLICATION"),
-		Unimplemented("ALTER USER role_name NOLOGIN NOREPLICATION"),
+		Converts("ALTER USER role_name NOLOGIN NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOLOGIN NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER NOLOGIN NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER NOLOGIN NOREPLICATION"),
-		Unimplemented("ALTER USER role_name WITH NOLOGIN NOREPLICATION"),
+		Converts("ALTER USER role_name WITH NOLOGIN NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOLOGIN NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOLOGIN NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOLOGIN NOREPLICATION"),
-		Unimplemented("ALTER USER role_name REPLICATION NOREPLICATION"),
+		Parses("ALTER USER role_name REPLICATION NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE REPLICATION NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER REPLICATION NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER REPLICATION NOREPLICATION"),
-		Unimplemented("ALTER USER role_name WITH REPLICATION NOREPLICATION"),
+		Parses("ALTER USER role_name WITH REPLICATION NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH REPLICATION NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH REPLICATION NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH REPLICATION NOREPLICATION"),
-		Unimplemented("ALTER USER role_name NOREPLICATION NOREPLICATION"),
+		Parses("ALTER USER role_name NOREPLICATION NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOREPLICATION NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER NOREPLICATION NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER NOREPLICATION NOREPLICATION"),
-		Unimplemented("ALTER USER role_name WITH NOREPLICATION NOREPLICATION"),
+		Parses("ALTER USER role_name WITH NOREPLICATION NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOREPLICATION NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOREPLICATION NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOREPLICATION NOREPLICATION"),
-		Unimplemented("ALTER USER role_name BYPASSRLS NOREPLICATION"),
+		Converts("ALTER USER role_name BYPASSRLS NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE BYPASSRLS NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER BYPASSRLS NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER BYPASSRLS NOREPLICATION"),
-		Unimplemented("ALTER USER role_name WITH BYPASSRLS NOREPLICATION"),
+		Converts("ALTER USER role_name WITH BYPASSRLS NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH BYPASSRLS NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH BYPASSRLS NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH BYPASSRLS NOREPLICATION"),
-		Unimplemented("ALTER USER role_name NOBYPASSRLS NOREPLICATION"),
+		Converts("ALTER USER role_name NOBYPASSRLS NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOBYPASSRLS NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER NOBYPASSRLS NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER NOBYPASSRLS NOREPLICATION"),
-		Unimplemented("ALTER USER role_name WITH NOBYPASSRLS NOREPLICATION"),
+		Converts("ALTER USER role_name WITH NOBYPASSRLS NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOBYPASSRLS NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOBYPASSRLS NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOBYPASSRLS NOREPLICATION"),
-		Unimplemented("ALTER USER role_name CONNECTION LIMIT -1 NOREPLICATION"),
+		Converts("ALTER USER role_name CONNECTION LIMIT -1 NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE CONNECTION LIMIT -1 NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER CONNECTION LIMIT -1 NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER CONNECTION LIMIT -1 NOREPLICATION"),
-		Unimplemented("ALTER USER role_name WITH CONNECTION LIMIT -1 NOREPLICATION"),
+		Converts("ALTER USER role_name WITH CONNECTION LIMIT -1 NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CONNECTION LIMIT -1 NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CONNECTION LIMIT -1 NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH CONNECTION LIMIT -1 NOREPLICATION"),
-		Unimplemented("ALTER USER role_name PASSWORD ' password ' NOREPLICATION"),
+		Converts("ALTER USER role_name PASSWORD ' password ' NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD ' password ' NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD ' password ' NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD ' password ' NOREPLICATION"),
-		Unimplemented("ALTER USER role_name WITH PASSWORD ' password ' NOREPLICATION"),
+		Converts("ALTER USER role_name WITH PASSWORD ' password ' NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD ' password ' NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD ' password ' NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD ' password ' NOREPLICATION"),
-		Unimplemented("ALTER USER role_name ENCRYPTED PASSWORD ' password ' NOREPLICATION"),
+		Converts("ALTER USER role_name ENCRYPTED PASSWORD ' password ' NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE ENCRYPTED PASSWORD ' password ' NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER ENCRYPTED PASSWORD ' password ' NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER ENCRYPTED PASSWORD ' password ' NOREPLICATION"),
-		Unimplemented("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' NOREPLICATION"),
+		Converts("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH ENCRYPTED PASSWORD ' password ' NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH ENCRYPTED PASSWORD ' password ' NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH ENCRYPTED PASSWORD ' password ' NOREPLICATION"),
-		Unimplemented("ALTER USER role_name PASSWORD NULL NOREPLICATION"),
+		Converts("ALTER USER role_name PASSWORD NULL NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD NULL NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD NULL NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD NULL NOREPLICATION"),
-		Unimplemented("ALTER USER role_name WITH PASSWORD NULL NOREPLICATION"),
+		Converts("ALTER USER role_name WITH PASSWORD NULL NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD NULL NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD NULL NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD NULL NOREPLICATION"),
-		Unimplemented("ALTER USER role_name VALID UNTIL ' timestamp ' NOREPLICATION"),
+		Parses("ALTER USER role_name VALID UNTIL ' timestamp ' NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE VALID UNTIL ' timestamp ' NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER VALID UNTIL ' timestamp ' NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER VALID UNTIL ' timestamp ' NOREPLICATION"),
-		Unimplemented("ALTER USER role_name WITH VALID UNTIL ' timestamp ' NOREPLICATION"),
+		Parses("ALTER USER role_name WITH VALID UNTIL ' timestamp ' NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH VALID UNTIL ' timestamp ' NOREPLICATION"),
 		Unimplemented("ALTER USER CURRENT_USER WITH VALID UNTIL ' timestamp ' NOREPLICATION"),
 		Unimplemented("ALTER USER SESSION_USER WITH VALID UNTIL ' timestamp ' NOREPLICATION"),
-		Unimplemented("ALTER USER role_name SUPERUSER BYPASSRLS"),
+		Converts("ALTER USER role_name SUPERUSER BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE SUPERUSER BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER SUPERUSER BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER SUPERUSER BYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH SUPERUSER BYPASSRLS"),
+		Converts("ALTER USER role_name WITH SUPERUSER BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH SUPERUSER BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH SUPERUSER BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH SUPERUSER BYPASSRLS"),
-		Unimplemented("ALTER USER role_name NOSUPERUSER BYPASSRLS"),
+		Converts("ALTER USER role_name NOSUPERUSER BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOSUPERUSER BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER NOSUPERUSER BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER NOSUPERUSER BYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH NOSUPERUSER BYPASSRLS"),
+		Converts("ALTER USER role_name WITH NOSUPERUSER BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOSUPERUSER BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOSUPERUSER BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOSUPERUSER BYPASSRLS"),
-		Unimplemented("ALTER USER role_name CREATEDB BYPASSRLS"),
+		Converts("ALTER USER role_name CREATEDB BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEDB BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEDB BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER CREATEDB BYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH CREATEDB BYPASSRLS"),
+		Converts("ALTER USER role_name WITH CREATEDB BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEDB BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEDB BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEDB BYPASSRLS"),
-		Unimplemented("ALTER USER role_name NOCREATEDB BYPASSRLS"),
+		Converts("ALTER USER role_name NOCREATEDB BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEDB BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEDB BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEDB BYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH NOCREATEDB BYPASSRLS"),
+		Converts("ALTER USER role_name WITH NOCREATEDB BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEDB BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEDB BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEDB BYPASSRLS"),
-		Unimplemented("ALTER USER role_name CREATEROLE BYPASSRLS"),
+		Converts("ALTER USER role_name CREATEROLE BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEROLE BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEROLE BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER CREATEROLE BYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH CREATEROLE BYPASSRLS"),
+		Converts("ALTER USER role_name WITH CREATEROLE BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEROLE BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEROLE BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEROLE BYPASSRLS"),
-		Unimplemented("ALTER USER role_name NOCREATEROLE BYPASSRLS"),
+		Converts("ALTER USER role_name NOCREATEROLE BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEROLE BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEROLE BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEROLE BYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH NOCREATEROLE BYPASSRLS"),
+		Converts("ALTER USER role_name WITH NOCREATEROLE BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEROLE BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEROLE BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEROLE BYPASSRLS"),
-		Unimplemented("ALTER USER role_name INHERIT BYPASSRLS"),
+		Converts("ALTER USER role_name INHERIT BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE INHERIT BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER INHERIT BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER INHERIT BYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH INHERIT BYPASSRLS"),
+		Converts("ALTER USER role_name WITH INHERIT BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH INHERIT BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH INHERIT BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH INHERIT BYPASSRLS"),
-		Unimplemented("ALTER USER role_name NOINHERIT BYPASSRLS"),
+		Converts("ALTER USER role_name NOINHERIT BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOINHERIT BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER NOINHERIT BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER NOINHERIT BYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH NOINHERIT BYPASSRLS"),
+		Converts("ALTER USER role_name WITH NOINHERIT BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOINHERIT BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOINHERIT BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOINHERIT BYPASSRLS"),
-		Unimplemented("ALTER USER role_name LOGIN BYPASSRLS"),
+		Converts("ALTER USER role_name LOGIN BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE LOGIN BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER LOGIN BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER LOGIN BYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH LOGIN BYPASSRLS"),
+		Converts("ALTER USER role_name WITH LOGIN BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH LOGIN BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH LOGIN BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH LOGIN BYPASSRLS"),
-		Unimplemented("ALTER USER role_name NOLOGIN BYPASSRLS"),
+		Converts("ALTER USER role_name NOLOGIN BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOLOGIN BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER NOLOGIN BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER NOLOGIN BYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH NOLOGIN BYPASSRLS"),
+		Converts("ALTER USER role_name WITH NOLOGIN BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOLOGIN BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOLOGIN BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOLOGIN BYPASSRLS"),
-		Unimplemented("ALTER USER role_name REPLICATION BYPASSRLS"),
+		Converts("ALTER USER role_name REPLICATION BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE REPLICATION BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER REPLICATION BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER REPLICATION BYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH REPLICATION BYPASSRLS"),
+		Converts("ALTER USER role_name WITH REPLICATION BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH REPLICATION BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH REPLICATION BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH REPLICATION BYPASSRLS"),
-		Unimplemented("ALTER USER role_name NOREPLICATION BYPASSRLS"),
+		Converts("ALTER USER role_name NOREPLICATION BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOREPLICATION BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER NOREPLICATION BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER NOREPLICATION BYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH NOREPLICATION BYPASSRLS"),
+		Converts("ALTER USER role_name WITH NOREPLICATION BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOREPLICATION BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOREPLICATION BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOREPLICATION BYPASSRLS"),
-		Unimplemented("ALTER USER role_name BYPASSRLS BYPASSRLS"),
+		Parses("ALTER USER role_name BYPASSRLS BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE BYPASSRLS BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER BYPASSRLS BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER BYPASSRLS BYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH BYPASSRLS BYPASSRLS"),
+		Parses("ALTER USER role_name WITH BYPASSRLS BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH BYPASSRLS BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH BYPASSRLS BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH BYPASSRLS BYPASSRLS"),
-		Unimplemented("ALTER USER role_name NOBYPASSRLS BYPASSRLS"),
+		Parses("ALTER USER role_name NOBYPASSRLS BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOBYPASSRLS BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER NOBYPASSRLS BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER NOBYPASSRLS BYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH NOBYPASSRLS BYPASSRLS"),
+		Parses("ALTER USER role_name WITH NOBYPASSRLS BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOBYPASSRLS BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOBYPASSRLS BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOBYPASSRLS BYPASSRLS"),
-		Unimplemented("ALTER USER role_name CONNECTION LIMIT -1 BYPASSRLS"),
+		Converts("ALTER USER role_name CONNECTION LIMIT -1 BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE CONNECTION LIMIT -1 BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER CONNECTION LIMIT -1 BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER CONNECTION LIMIT -1 BYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH CONNECTION LIMIT -1 BYPASSRLS"),
+		Converts("ALTER USER role_name WITH CONNECTION LIMIT -1 BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CONNECTION LIMIT -1 BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CONNECTION LIMIT -1 BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH CONNECTION LIMIT -1 BYPASSRLS"),
-		Unimplemented("ALTER USER role_name PASSWORD ' password ' BYPASSRLS"),
+		Converts("ALTER USER role_name PASSWORD ' password ' BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD ' password ' BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD ' password ' BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD ' password ' BYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH PASSWORD ' password ' BYPASSRLS"),
+		Converts("ALTER USER role_name WITH PASSWORD ' password ' BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD ' password ' BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD ' password ' BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD ' password ' BYPASSRLS"),
-		Unimplemented("ALTER USER role_name ENCRYPTED PASSWORD ' password ' BYPASSRLS"),
+		Converts("ALTER USER role_name ENCRYPTED PASSWORD ' password ' BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE ENCRYPTED PASSWORD ' password ' BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER ENCRYPTED PASSWORD ' password ' BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER ENCRYPTED PASSWORD ' password ' BYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' BYPASSRLS"),
+		Converts("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH ENCRYPTED PASSWORD ' password ' BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH ENCRYPTED PASSWORD ' password ' BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH ENCRYPTED PASSWORD ' password ' BYPASSRLS"),
-		Unimplemented("ALTER USER role_name PASSWORD NULL BYPASSRLS"),
+		Converts("ALTER USER role_name PASSWORD NULL BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD NULL BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD NULL BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD NULL BYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH PASSWORD NULL BYPASSRLS"),
+		Converts("ALTER USER role_name WITH PASSWORD NULL BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD NULL BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD NULL BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD NULL BYPASSRLS"),
-		Unimplemented("ALTER USER role_name VALID UNTIL ' timestamp ' BYPASSRLS"),
+		Parses("ALTER USER role_name VALID UNTIL ' timestamp ' BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE VALID UNTIL ' timestamp ' BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER VALID UNTIL ' timestamp ' BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER VALID UNTIL ' timestamp ' BYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH VALID UNTIL ' timestamp ' BYPASSRLS"),
+		Parses("ALTER USER role_name WITH VALID UNTIL ' timestamp ' BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH VALID UNTIL ' timestamp ' BYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH VALID UNTIL ' timestamp ' BYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH VALID UNTIL ' timestamp ' BYPASSRLS"),
-		Unimplemented("ALTER USER role_name SUPERUSER NOBYPASSRLS"),
+		Converts("ALTER USER role_name SUPERUSER NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE SUPERUSER NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER SUPERUSER NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER SUPERUSER NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH SUPERUSER NOBYPASSRLS"),
+		Converts("ALTER USER role_name WITH SUPERUSER NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH SUPERUSER NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH SUPERUSER NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH SUPERUSER NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name NOSUPERUSER NOBYPASSRLS"),
+		Converts("ALTER USER role_name NOSUPERUSER NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOSUPERUSER NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER NOSUPERUSER NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER NOSUPERUSER NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH NOSUPERUSER NOBYPASSRLS"),
+		Converts("ALTER USER role_name WITH NOSUPERUSER NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOSUPERUSER NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOSUPERUSER NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOSUPERUSER NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name CREATEDB NOBYPASSRLS"),
+		Converts("ALTER USER role_name CREATEDB NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEDB NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEDB NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER CREATEDB NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH CREATEDB NOBYPASSRLS"),
+		Converts("ALTER USER role_name WITH CREATEDB NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEDB NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEDB NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEDB NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name NOCREATEDB NOBYPASSRLS"),
+		Converts("ALTER USER role_name NOCREATEDB NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEDB NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEDB NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEDB NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH NOCREATEDB NOBYPASSRLS"),
+		Converts("ALTER USER role_name WITH NOCREATEDB NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEDB NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEDB NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEDB NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name CREATEROLE NOBYPASSRLS"),
+		Converts("ALTER USER role_name CREATEROLE NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEROLE NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEROLE NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER CREATEROLE NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH CREATEROLE NOBYPASSRLS"),
+		Converts("ALTER USER role_name WITH CREATEROLE NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEROLE NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEROLE NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEROLE NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name NOCREATEROLE NOBYPASSRLS"),
+		Converts("ALTER USER role_name NOCREATEROLE NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEROLE NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEROLE NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEROLE NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH NOCREATEROLE NOBYPASSRLS"),
+		Converts("ALTER USER role_name WITH NOCREATEROLE NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEROLE NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEROLE NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEROLE NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name INHERIT NOBYPASSRLS"),
+		Converts("ALTER USER role_name INHERIT NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE INHERIT NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER INHERIT NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER INHERIT NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH INHERIT NOBYPASSRLS"),
+		Converts("ALTER USER role_name WITH INHERIT NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH INHERIT NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH INHERIT NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH INHERIT NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name NOINHERIT NOBYPASSRLS"),
+		Converts("ALTER USER role_name NOINHERIT NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOINHERIT NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER NOINHERIT NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER NOINHERIT NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH NOINHERIT NOBYPASSRLS"),
+		Converts("ALTER USER role_name WITH NOINHERIT NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOINHERIT NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOINHERIT NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOINHERIT NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name LOGIN NOBYPASSRLS"),
+		Converts("ALTER USER role_name LOGIN NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE LOGIN NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER LOGIN NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER LOGIN NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH LOGIN NOBYPASSRLS"),
+		Converts("ALTER USER role_name WITH LOGIN NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH LOGIN NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH LOGIN NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH LOGIN NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name NOLOGIN NOBYPASSRLS"),
+		Converts("ALTER USER role_name NOLOGIN NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOLOGIN NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER NOLOGIN NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER NOLOGIN NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH NOLOGIN NOBYPASSRLS"),
+		Converts("ALTER USER role_name WITH NOLOGIN NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOLOGIN NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOLOGIN NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOLOGIN NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name REPLICATION NOBYPASSRLS"),
+		Converts("ALTER USER role_name REPLICATION NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE REPLICATION NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER REPLICATION NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER REPLICATION NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH REPLICATION NOBYPASSRLS"),
+		Converts("ALTER USER role_name WITH REPLICATION NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH REPLICATION NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH REPLICATION NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH REPLICATION NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name NOREPLICATION NOBYPASSRLS"),
+		Converts("ALTER USER role_name NOREPLICATION NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOREPLICATION NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER NOREPLICATION NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER NOREPLICATION NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH NOREPLICATION NOBYPASSRLS"),
+		Converts("ALTER USER role_name WITH NOREPLICATION NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOREPLICATION NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOREPLICATION NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOREPLICATION NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name BYPASSRLS NOBYPASSRLS"),
+		Parses("ALTER USER role_name BYPASSRLS NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE BYPASSRLS NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER BYPASSRLS NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER BYPASSRLS NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH BYPASSRLS NOBYPASSRLS"),
+		Parses("ALTER USER role_name WITH BYPASSRLS NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH BYPASSRLS NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH BYPASSRLS NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH BYPASSRLS NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name NOBYPASSRLS NOBYPASSRLS"),
+		Parses("ALTER USER role_name NOBYPASSRLS NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOBYPASSRLS NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER NOBYPASSRLS NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER NOBYPASSRLS NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH NOBYPASSRLS NOBYPASSRLS"),
+		Parses("ALTER USER role_name WITH NOBYPASSRLS NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOBYPASSRLS NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOBYPASSRLS NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOBYPASSRLS NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name CONNECTION LIMIT -1 NOBYPASSRLS"),
+		Converts("ALTER USER role_name CONNECTION LIMIT -1 NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE CONNECTION LIMIT -1 NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER CONNECTION LIMIT -1 NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER CONNECTION LIMIT -1 NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH CONNECTION LIMIT -1 NOBYPASSRLS"),
+		Converts("ALTER USER role_name WITH CONNECTION LIMIT -1 NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CONNECTION LIMIT -1 NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CONNECTION LIMIT -1 NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH CONNECTION LIMIT -1 NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name PASSWORD ' password ' NOBYPASSRLS"),
+		Converts("ALTER USER role_name PASSWORD ' password ' NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD ' password ' NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD ' password ' NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD ' password ' NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH PASSWORD ' password ' NOBYPASSRLS"),
+		Converts("ALTER USER role_name WITH PASSWORD ' password ' NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD ' password ' NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD ' password ' NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD ' password ' NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name ENCRYPTED PASSWORD ' password ' NOBYPASSRLS"),
+		Converts("ALTER USER role_name ENCRYPTED PASSWORD ' password ' NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE ENCRYPTED PASSWORD ' password ' NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER ENCRYPTED PASSWORD ' password ' NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER ENCRYPTED PASSWORD ' password ' NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' NOBYPASSRLS"),
+		Converts("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH ENCRYPTED PASSWORD ' password ' NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH ENCRYPTED PASSWORD ' password ' NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH ENCRYPTED PASSWORD ' password ' NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name PASSWORD NULL NOBYPASSRLS"),
+		Converts("ALTER USER role_name PASSWORD NULL NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD NULL NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD NULL NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD NULL NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH PASSWORD NULL NOBYPASSRLS"),
+		Converts("ALTER USER role_name WITH PASSWORD NULL NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD NULL NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD NULL NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD NULL NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name VALID UNTIL ' timestamp ' NOBYPASSRLS"),
+		Parses("ALTER USER role_name VALID UNTIL ' timestamp ' NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE VALID UNTIL ' timestamp ' NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER VALID UNTIL ' timestamp ' NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER VALID UNTIL ' timestamp ' NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name WITH VALID UNTIL ' timestamp ' NOBYPASSRLS"),
+		Parses("ALTER USER role_name WITH VALID UNTIL ' timestamp ' NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH VALID UNTIL ' timestamp ' NOBYPASSRLS"),
 		Unimplemented("ALTER USER CURRENT_USER WITH VALID UNTIL ' timestamp ' NOBYPASSRLS"),
 		Unimplemented("ALTER USER SESSION_USER WITH VALID UNTIL ' timestamp ' NOBYPASSRLS"),
-		Unimplemented("ALTER USER role_name SUPERUSER CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name SUPERUSER CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE SUPERUSER CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER SUPERUSER CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER SUPERUSER CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name WITH SUPERUSER CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name WITH SUPERUSER CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH SUPERUSER CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER WITH SUPERUSER CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER WITH SUPERUSER CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name NOSUPERUSER CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name NOSUPERUSER CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOSUPERUSER CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER NOSUPERUSER CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER NOSUPERUSER CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name WITH NOSUPERUSER CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name WITH NOSUPERUSER CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOSUPERUSER CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOSUPERUSER CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOSUPERUSER CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name CREATEDB CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name CREATEDB CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEDB CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEDB CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER CREATEDB CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name WITH CREATEDB CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name WITH CREATEDB CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEDB CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEDB CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEDB CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name NOCREATEDB CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name NOCREATEDB CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEDB CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEDB CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEDB CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name WITH NOCREATEDB CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name WITH NOCREATEDB CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEDB CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEDB CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEDB CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name CREATEROLE CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name CREATEROLE CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEROLE CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEROLE CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER CREATEROLE CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name WITH CREATEROLE CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name WITH CREATEROLE CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEROLE CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEROLE CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEROLE CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name NOCREATEROLE CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name NOCREATEROLE CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEROLE CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEROLE CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEROLE CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name WITH NOCREATEROLE CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name WITH NOCREATEROLE CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEROLE CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEROLE CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEROLE CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name INHERIT CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name INHERIT CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE INHERIT CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER INHERIT CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER INHERIT CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name WITH INHERIT CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name WITH INHERIT CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH INHERIT CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER WITH INHERIT CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER WITH INHERIT CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name NOINHERIT CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name NOINHERIT CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOINHERIT CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER NOINHERIT CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER NOINHERIT CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name WITH NOINHERIT CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name WITH NOINHERIT CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOINHERIT CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOINHERIT CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOINHERIT CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name LOGIN CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name LOGIN CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE LOGIN CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER LOGIN CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER LOGIN CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name WITH LOGIN CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name WITH LOGIN CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH LOGIN CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER WITH LOGIN CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER WITH LOGIN CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name NOLOGIN CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name NOLOGIN CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOLOGIN CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER NOLOGIN CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER NOLOGIN CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name WITH NOLOGIN CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name WITH NOLOGIN CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOLOGIN CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOLOGIN CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOLOGIN CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name REPLICATION CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name REPLICATION CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE REPLICATION CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER REPLICATION CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER REPLICATION CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name WITH REPLICATION CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name WITH REPLICATION CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH REPLICATION CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER WITH REPLICATION CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER WITH REPLICATION CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name NOREPLICATION CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name NOREPLICATION CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOREPLICATION CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER NOREPLICATION CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER NOREPLICATION CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name WITH NOREPLICATION CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name WITH NOREPLICATION CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOREPLICATION CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOREPLICATION CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOREPLICATION CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name BYPASSRLS CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name BYPASSRLS CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE BYPASSRLS CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER BYPASSRLS CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER BYPASSRLS CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name WITH BYPASSRLS CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name WITH BYPASSRLS CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH BYPASSRLS CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER WITH BYPASSRLS CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER WITH BYPASSRLS CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name NOBYPASSRLS CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name NOBYPASSRLS CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOBYPASSRLS CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER NOBYPASSRLS CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER NOBYPASSRLS CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name WITH NOBYPASSRLS CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name WITH NOBYPASSRLS CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOBYPASSRLS CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOBYPASSRLS CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOBYPASSRLS CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
+		Parses("ALTER USER role_name CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name WITH CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
+		Parses("ALTER USER role_name WITH CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER WITH CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name PASSWORD ' password ' CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name PASSWORD ' password ' CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD ' password ' CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD ' password ' CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD ' password ' CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name WITH PASSWORD ' password ' CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name WITH PASSWORD ' password ' CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD ' password ' CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD ' password ' CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD ' password ' CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name ENCRYPTED PASSWORD ' password ' CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name ENCRYPTED PASSWORD ' password ' CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE ENCRYPTED PASSWORD ' password ' CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER ENCRYPTED PASSWORD ' password ' CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER ENCRYPTED PASSWORD ' password ' CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH ENCRYPTED PASSWORD ' password ' CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER WITH ENCRYPTED PASSWORD ' password ' CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER WITH ENCRYPTED PASSWORD ' password ' CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name PASSWORD NULL CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name PASSWORD NULL CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD NULL CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD NULL CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD NULL CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name WITH PASSWORD NULL CONNECTION LIMIT -1"),
+		Converts("ALTER USER role_name WITH PASSWORD NULL CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD NULL CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD NULL CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD NULL CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name VALID UNTIL ' timestamp ' CONNECTION LIMIT -1"),
+		Parses("ALTER USER role_name VALID UNTIL ' timestamp ' CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE VALID UNTIL ' timestamp ' CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER VALID UNTIL ' timestamp ' CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER VALID UNTIL ' timestamp ' CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name WITH VALID UNTIL ' timestamp ' CONNECTION LIMIT -1"),
+		Parses("ALTER USER role_name WITH VALID UNTIL ' timestamp ' CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH VALID UNTIL ' timestamp ' CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER CURRENT_USER WITH VALID UNTIL ' timestamp ' CONNECTION LIMIT -1"),
 		Unimplemented("ALTER USER SESSION_USER WITH VALID UNTIL ' timestamp ' CONNECTION LIMIT -1"),
-		Unimplemented("ALTER USER role_name SUPERUSER PASSWORD ' password '"),
+		Converts("ALTER USER role_name SUPERUSER PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE SUPERUSER PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER SUPERUSER PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER SUPERUSER PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH SUPERUSER PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH SUPERUSER PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH SUPERUSER PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH SUPERUSER PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH SUPERUSER PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name NOSUPERUSER PASSWORD ' password '"),
+		Converts("ALTER USER role_name NOSUPERUSER PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOSUPERUSER PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER NOSUPERUSER PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER NOSUPERUSER PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH NOSUPERUSER PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH NOSUPERUSER PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOSUPERUSER PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOSUPERUSER PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOSUPERUSER PASSWORD ' password '"),
-		Parses("ALTER USER role_name CREATEDB PASSWORD ' password '"),
+		Converts("ALTER USER role_name CREATEDB PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEDB PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEDB PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER CREATEDB PASSWORD ' password '"),
-		Parses("ALTER USER role_name WITH CREATEDB PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH CREATEDB PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEDB PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEDB PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEDB PASSWORD ' password '"),
-		Parses("ALTER USER role_name NOCREATEDB PASSWORD ' password '"),
+		Converts("ALTER USER role_name NOCREATEDB PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEDB PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEDB PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEDB PASSWORD ' password '"),
-		Parses("ALTER USER role_name WITH NOCREATEDB PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH NOCREATEDB PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEDB PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEDB PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEDB PASSWORD ' password '"),
-		Parses("ALTER USER role_name CREATEROLE PASSWORD ' password '"),
+		Converts("ALTER USER role_name CREATEROLE PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEROLE PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEROLE PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER CREATEROLE PASSWORD ' password '"),
-		Parses("ALTER USER role_name WITH CREATEROLE PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH CREATEROLE PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEROLE PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEROLE PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEROLE PASSWORD ' password '"),
-		Parses("ALTER USER role_name NOCREATEROLE PASSWORD ' password '"),
+		Converts("ALTER USER role_name NOCREATEROLE PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEROLE PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEROLE PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEROLE PASSWORD ' password '"),
-		Parses("ALTER USER role_name WITH NOCREATEROLE PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH NOCREATEROLE PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEROLE PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEROLE PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEROLE PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name INHERIT PASSWORD ' password '"),
+		Converts("ALTER USER role_name INHERIT PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE INHERIT PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER INHERIT PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER INHERIT PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH INHERIT PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH INHERIT PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH INHERIT PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH INHERIT PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH INHERIT PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name NOINHERIT PASSWORD ' password '"),
+		Converts("ALTER USER role_name NOINHERIT PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOINHERIT PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER NOINHERIT PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER NOINHERIT PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH NOINHERIT PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH NOINHERIT PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOINHERIT PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOINHERIT PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOINHERIT PASSWORD ' password '"),
-		Parses("ALTER USER role_name LOGIN PASSWORD ' password '"),
+		Converts("ALTER USER role_name LOGIN PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE LOGIN PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER LOGIN PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER LOGIN PASSWORD ' password '"),
-		Parses("ALTER USER role_name WITH LOGIN PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH LOGIN PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH LOGIN PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH LOGIN PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH LOGIN PASSWORD ' password '"),
-		Parses("ALTER USER role_name NOLOGIN PASSWORD ' password '"),
+		Converts("ALTER USER role_name NOLOGIN PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOLOGIN PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER NOLOGIN PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER NOLOGIN PASSWORD ' password '"),
-		Parses("ALTER USER role_name WITH NOLOGIN PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH NOLOGIN PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOLOGIN PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOLOGIN PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOLOGIN PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name REPLICATION PASSWORD ' password '"),
+		Converts("ALTER USER role_name REPLICATION PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE REPLICATION PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER REPLICATION PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER REPLICATION PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH REPLICATION PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH REPLICATION PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH REPLICATION PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH REPLICATION PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH REPLICATION PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name NOREPLICATION PASSWORD ' password '"),
+		Converts("ALTER USER role_name NOREPLICATION PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOREPLICATION PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER NOREPLICATION PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER NOREPLICATION PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH NOREPLICATION PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH NOREPLICATION PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOREPLICATION PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOREPLICATION PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOREPLICATION PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name BYPASSRLS PASSWORD ' password '"),
+		Converts("ALTER USER role_name BYPASSRLS PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE BYPASSRLS PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER BYPASSRLS PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER BYPASSRLS PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH BYPASSRLS PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH BYPASSRLS PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH BYPASSRLS PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH BYPASSRLS PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH BYPASSRLS PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name NOBYPASSRLS PASSWORD ' password '"),
+		Converts("ALTER USER role_name NOBYPASSRLS PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOBYPASSRLS PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER NOBYPASSRLS PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER NOBYPASSRLS PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH NOBYPASSRLS PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH NOBYPASSRLS PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOBYPASSRLS PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOBYPASSRLS PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOBYPASSRLS PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name CONNECTION LIMIT -1 PASSWORD ' password '"),
+		Converts("ALTER USER role_name CONNECTION LIMIT -1 PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE CONNECTION LIMIT -1 PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER CONNECTION LIMIT -1 PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER CONNECTION LIMIT -1 PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH CONNECTION LIMIT -1 PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH CONNECTION LIMIT -1 PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CONNECTION LIMIT -1 PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CONNECTION LIMIT -1 PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH CONNECTION LIMIT -1 PASSWORD ' password '"),
@@ -2578,11 +2578,11 @@ func TestAlterUser(t *testing.T) {
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD ' password ' PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD ' password ' PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD ' password ' PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name ENCRYPTED PASSWORD ' password ' PASSWORD ' password '"),
+		Parses("ALTER USER role_name ENCRYPTED PASSWORD ' password ' PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE ENCRYPTED PASSWORD ' password ' PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER ENCRYPTED PASSWORD ' password ' PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER ENCRYPTED PASSWORD ' password ' PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' PASSWORD ' password '"),
+		Parses("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH ENCRYPTED PASSWORD ' password ' PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH ENCRYPTED PASSWORD ' password ' PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH ENCRYPTED PASSWORD ' password ' PASSWORD ' password '"),
@@ -2602,275 +2602,275 @@ func TestAlterUser(t *testing.T) {
 		Unimplemented("ALTER USER CURRENT_ROLE WITH VALID UNTIL ' timestamp ' PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH VALID UNTIL ' timestamp ' PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH VALID UNTIL ' timestamp ' PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name SUPERUSER ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name SUPERUSER ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE SUPERUSER ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER SUPERUSER ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER SUPERUSER ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH SUPERUSER ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH SUPERUSER ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH SUPERUSER ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH SUPERUSER ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH SUPERUSER ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name NOSUPERUSER ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name NOSUPERUSER ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOSUPERUSER ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER NOSUPERUSER ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER NOSUPERUSER ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH NOSUPERUSER ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH NOSUPERUSER ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOSUPERUSER ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOSUPERUSER ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOSUPERUSER ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name CREATEDB ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name CREATEDB ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEDB ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEDB ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER CREATEDB ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH CREATEDB ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH CREATEDB ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEDB ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEDB ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEDB ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name NOCREATEDB ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name NOCREATEDB ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEDB ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEDB ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEDB ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH NOCREATEDB ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH NOCREATEDB ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEDB ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEDB ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEDB ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name CREATEROLE ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name CREATEROLE ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEROLE ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEROLE ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER CREATEROLE ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH CREATEROLE ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH CREATEROLE ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEROLE ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEROLE ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEROLE ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name NOCREATEROLE ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name NOCREATEROLE ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEROLE ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEROLE ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEROLE ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH NOCREATEROLE ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH NOCREATEROLE ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEROLE ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEROLE ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEROLE ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name INHERIT ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name INHERIT ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE INHERIT ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER INHERIT ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER INHERIT ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH INHERIT ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH INHERIT ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH INHERIT ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH INHERIT ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH INHERIT ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name NOINHERIT ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name NOINHERIT ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOINHERIT ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER NOINHERIT ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER NOINHERIT ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH NOINHERIT ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH NOINHERIT ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOINHERIT ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOINHERIT ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOINHERIT ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name LOGIN ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name LOGIN ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE LOGIN ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER LOGIN ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER LOGIN ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH LOGIN ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH LOGIN ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH LOGIN ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH LOGIN ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH LOGIN ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name NOLOGIN ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name NOLOGIN ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOLOGIN ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER NOLOGIN ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER NOLOGIN ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH NOLOGIN ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH NOLOGIN ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOLOGIN ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOLOGIN ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOLOGIN ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name REPLICATION ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name REPLICATION ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE REPLICATION ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER REPLICATION ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER REPLICATION ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH REPLICATION ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH REPLICATION ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH REPLICATION ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH REPLICATION ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH REPLICATION ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name NOREPLICATION ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name NOREPLICATION ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOREPLICATION ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER NOREPLICATION ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER NOREPLICATION ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH NOREPLICATION ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH NOREPLICATION ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOREPLICATION ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOREPLICATION ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOREPLICATION ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name BYPASSRLS ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name BYPASSRLS ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE BYPASSRLS ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER BYPASSRLS ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER BYPASSRLS ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH BYPASSRLS ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH BYPASSRLS ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH BYPASSRLS ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH BYPASSRLS ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH BYPASSRLS ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name NOBYPASSRLS ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name NOBYPASSRLS ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOBYPASSRLS ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER NOBYPASSRLS ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER NOBYPASSRLS ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH NOBYPASSRLS ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH NOBYPASSRLS ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOBYPASSRLS ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOBYPASSRLS ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOBYPASSRLS ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name CONNECTION LIMIT -1 ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name CONNECTION LIMIT -1 ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE CONNECTION LIMIT -1 ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER CONNECTION LIMIT -1 ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER CONNECTION LIMIT -1 ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH CONNECTION LIMIT -1 ENCRYPTED PASSWORD ' password '"),
+		Converts("ALTER USER role_name WITH CONNECTION LIMIT -1 ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CONNECTION LIMIT -1 ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CONNECTION LIMIT -1 ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH CONNECTION LIMIT -1 ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
+		Parses("ALTER USER role_name PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
+		Parses("ALTER USER role_name WITH PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name ENCRYPTED PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
+		Parses("ALTER USER role_name ENCRYPTED PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE ENCRYPTED PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER ENCRYPTED PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER ENCRYPTED PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
+		Parses("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH ENCRYPTED PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH ENCRYPTED PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH ENCRYPTED PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name PASSWORD NULL ENCRYPTED PASSWORD ' password '"),
+		Parses("ALTER USER role_name PASSWORD NULL ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE PASSWORD NULL ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER PASSWORD NULL ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER PASSWORD NULL ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH PASSWORD NULL ENCRYPTED PASSWORD ' password '"),
+		Parses("ALTER USER role_name WITH PASSWORD NULL ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD NULL ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD NULL ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD NULL ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name VALID UNTIL ' timestamp ' ENCRYPTED PASSWORD ' password '"),
+		Parses("ALTER USER role_name VALID UNTIL ' timestamp ' ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE VALID UNTIL ' timestamp ' ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER VALID UNTIL ' timestamp ' ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER VALID UNTIL ' timestamp ' ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name WITH VALID UNTIL ' timestamp ' ENCRYPTED PASSWORD ' password '"),
+		Parses("ALTER USER role_name WITH VALID UNTIL ' timestamp ' ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH VALID UNTIL ' timestamp ' ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH VALID UNTIL ' timestamp ' ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("ALTER USER SESSION_USER WITH VALID UNTIL ' timestamp ' ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("ALTER USER role_name SUPERUSER PASSWORD NULL"),
+		Converts("ALTER USER role_name SUPERUSER PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE SUPERUSER PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER SUPERUSER PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER SUPERUSER PASSWORD NULL"),
-		Unimplemented("ALTER USER role_name WITH SUPERUSER PASSWORD NULL"),
+		Converts("ALTER USER role_name WITH SUPERUSER PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH SUPERUSER PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER WITH SUPERUSER PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER WITH SUPERUSER PASSWORD NULL"),
-		Unimplemented("ALTER USER role_name NOSUPERUSER PASSWORD NULL"),
+		Converts("ALTER USER role_name NOSUPERUSER PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOSUPERUSER PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER NOSUPERUSER PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER NOSUPERUSER PASSWORD NULL"),
-		Unimplemented("ALTER USER role_name WITH NOSUPERUSER PASSWORD NULL"),
+		Converts("ALTER USER role_name WITH NOSUPERUSER PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOSUPERUSER PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOSUPERUSER PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOSUPERUSER PASSWORD NULL"),
-		Parses("ALTER USER role_name CREATEDB PASSWORD NULL"),
+		Converts("ALTER USER role_name CREATEDB PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEDB PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEDB PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER CREATEDB PASSWORD NULL"),
-		Parses("ALTER USER role_name WITH CREATEDB PASSWORD NULL"),
+		Converts("ALTER USER role_name WITH CREATEDB PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEDB PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEDB PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEDB PASSWORD NULL"),
-		Parses("ALTER USER role_name NOCREATEDB PASSWORD NULL"),
+		Converts("ALTER USER role_name NOCREATEDB PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEDB PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEDB PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEDB PASSWORD NULL"),
-		Parses("ALTER USER role_name WITH NOCREATEDB PASSWORD NULL"),
+		Converts("ALTER USER role_name WITH NOCREATEDB PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEDB PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEDB PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEDB PASSWORD NULL"),
-		Parses("ALTER USER role_name CREATEROLE PASSWORD NULL"),
+		Converts("ALTER USER role_name CREATEROLE PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE CREATEROLE PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER CREATEROLE PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER CREATEROLE PASSWORD NULL"),
-		Parses("ALTER USER role_name WITH CREATEROLE PASSWORD NULL"),
+		Converts("ALTER USER role_name WITH CREATEROLE PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CREATEROLE PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CREATEROLE PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER WITH CREATEROLE PASSWORD NULL"),
-		Parses("ALTER USER role_name NOCREATEROLE PASSWORD NULL"),
+		Converts("ALTER USER role_name NOCREATEROLE PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOCREATEROLE PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER NOCREATEROLE PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER NOCREATEROLE PASSWORD NULL"),
-		Parses("ALTER USER role_name WITH NOCREATEROLE PASSWORD NULL"),
+		Converts("ALTER USER role_name WITH NOCREATEROLE PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEROLE PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEROLE PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEROLE PASSWORD NULL"),
-		Unimplemented("ALTER USER role_name INHERIT PASSWORD NULL"),
+		Converts("ALTER USER role_name INHERIT PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE INHERIT PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER INHERIT PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER INHERIT PASSWORD NULL"),
-		Unimplemented("ALTER USER role_name WITH INHERIT PASSWORD NULL"),
+		Converts("ALTER USER role_name WITH INHERIT PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH INHERIT PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER WITH INHERIT PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER WITH INHERIT PASSWORD NULL"),
-		Unimplemented("ALTER USER role_name NOINHERIT PASSWORD NULL"),
+		Converts("ALTER USER role_name NOINHERIT PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOINHERIT PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER NOINHERIT PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER NOINHERIT PASSWORD NULL"),
-		Unimplemented("ALTER USER role_name WITH NOINHERIT PASSWORD NULL"),
+		Converts("ALTER USER role_name WITH NOINHERIT PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOINHERIT PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOINHERIT PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOINHERIT PASSWORD NULL"),
-		Parses("ALTER USER role_name LOGIN PASSWORD NULL"),
+		Converts("ALTER USER role_name LOGIN PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE LOGIN PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER LOGIN PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER LOGIN PASSWORD NULL"),
-		Parses("ALTER USER role_name WITH LOGIN PASSWORD NULL"),
+		Converts("ALTER USER role_name WITH LOGIN PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH LOGIN PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER WITH LOGIN PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER WITH LOGIN PASSWORD NULL"),
-		Parses("ALTER USER role_name NOLOGIN PASSWORD NULL"),
+		Converts("ALTER USER role_name NOLOGIN PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOLOGIN PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER NOLOGIN PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER NOLOGIN PASSWORD NULL"),
-		Parses("ALTER USER role_name WITH NOLOGIN PASSWORD NULL"),
+		Converts("ALTER USER role_name WITH NOLOGIN PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOLOGIN PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOLOGIN PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOLOGIN PASSWORD NULL"),
-		Unimplemented("ALTER USER role_name REPLICATION PASSWORD NULL"),
+		Converts("ALTER USER role_name REPLICATION PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE REPLICATION PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER REPLICATION PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER REPLICATION PASSWORD NULL"),
-		Unimplemented("ALTER USER role_name WITH REPLICATION PASSWORD NULL"),
+		Converts("ALTER USER role_name WITH REPLICATION PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH REPLICATION PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER WITH REPLICATION PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER WITH REPLICATION PASSWORD NULL"),
-		Unimplemented("ALTER USER role_name NOREPLICATION PASSWORD NULL"),
+		Converts("ALTER USER role_name NOREPLICATION PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOREPLICATION PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER NOREPLICATION PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER NOREPLICATION PASSWORD NULL"),
-		Unimplemented("ALTER USER role_name WITH NOREPLICATION PASSWORD NULL"),
+		Converts("ALTER USER role_name WITH NOREPLICATION PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOREPLICATION PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOREPLICATION PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOREPLICATION PASSWORD NULL"),
-		Unimplemented("ALTER USER role_name BYPASSRLS PASSWORD NULL"),
+		Converts("ALTER USER role_name BYPASSRLS PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE BYPASSRLS PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER BYPASSRLS PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER BYPASSRLS PASSWORD NULL"),
-		Unimplemented("ALTER USER role_name WITH BYPASSRLS PASSWORD NULL"),
+		Converts("ALTER USER role_name WITH BYPASSRLS PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH BYPASSRLS PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER WITH BYPASSRLS PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER WITH BYPASSRLS PASSWORD NULL"),
-		Unimplemented("ALTER USER role_name NOBYPASSRLS PASSWORD NULL"),
+		Converts("ALTER USER role_name NOBYPASSRLS PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOBYPASSRLS PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER NOBYPASSRLS PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER NOBYPASSRLS PASSWORD NULL"),
-		Unimplemented("ALTER USER role_name WITH NOBYPASSRLS PASSWORD NULL"),
+		Converts("ALTER USER role_name WITH NOBYPASSRLS PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOBYPASSRLS PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOBYPASSRLS PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOBYPASSRLS PASSWORD NULL"),
-		Unimplemented("ALTER USER role_name CONNECTION LIMIT -1 PASSWORD NULL"),
+		Converts("ALTER USER role_name CONNECTION LIMIT -1 PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE CONNECTION LIMIT -1 PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER CONNECTION LIMIT -1 PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER CONNECTION LIMIT -1 PASSWORD NULL"),
-		Unimplemented("ALTER USER role_name WITH CONNECTION LIMIT -1 PASSWORD NULL"),
+		Converts("ALTER USER role_name WITH CONNECTION LIMIT -1 PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CONNECTION LIMIT -1 PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CONNECTION LIMIT -1 PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER WITH CONNECTION LIMIT -1 PASSWORD NULL"),
@@ -2882,11 +2882,11 @@ func TestAlterUser(t *testing.T) {
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD ' password ' PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD ' password ' PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD ' password ' PASSWORD NULL"),
-		Unimplemented("ALTER USER role_name ENCRYPTED PASSWORD ' password ' PASSWORD NULL"),
+		Parses("ALTER USER role_name ENCRYPTED PASSWORD ' password ' PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE ENCRYPTED PASSWORD ' password ' PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER ENCRYPTED PASSWORD ' password ' PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER ENCRYPTED PASSWORD ' password ' PASSWORD NULL"),
-		Unimplemented("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' PASSWORD NULL"),
+		Parses("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH ENCRYPTED PASSWORD ' password ' PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER WITH ENCRYPTED PASSWORD ' password ' PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER WITH ENCRYPTED PASSWORD ' password ' PASSWORD NULL"),
@@ -2906,19 +2906,19 @@ func TestAlterUser(t *testing.T) {
 		Unimplemented("ALTER USER CURRENT_ROLE WITH VALID UNTIL ' timestamp ' PASSWORD NULL"),
 		Unimplemented("ALTER USER CURRENT_USER WITH VALID UNTIL ' timestamp ' PASSWORD NULL"),
 		Unimplemented("ALTER USER SESSION_USER WITH VALID UNTIL ' timestamp ' PASSWORD NULL"),
-		Unimplemented("ALTER USER role_name SUPERUSER VALID UNTIL ' timestamp '"),
+		Parses("ALTER USER role_name SUPERUSER VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_ROLE SUPERUSER VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER SUPERUSER VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER SUPERUSER VALID UNTIL ' timestamp '"),
-		Unimplemented("ALTER USER role_name WITH SUPERUSER VALID UNTIL ' timestamp '"),
+		Parses("ALTER USER role_name WITH SUPERUSER VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH SUPERUSER VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH SUPERUSER VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER WITH SUPERUSER VALID UNTIL ' timestamp '"),
-		Unimplemented("ALTER USER role_name NOSUPERUSER VALID UNTIL ' timestamp '"),
+		Parses("ALTER USER role_name NOSUPERUSER VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOSUPERUSER VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER NOSUPERUSER VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER NOSUPERUSER VALID UNTIL ' timestamp '"),
-		Unimplemented("ALTER USER role_name WITH NOSUPERUSER VALID UNTIL ' timestamp '"),
+		Parses("ALTER USER role_name WITH NOSUPERUSER VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOSUPERUSER VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOSUPERUSER VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOSUPERUSER VALID UNTIL ' timestamp '"),
@@ -2954,19 +2954,19 @@ func TestAlterUser(t *testing.T) {
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOCREATEROLE VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOCREATEROLE VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOCREATEROLE VALID UNTIL ' timestamp '"),
-		Unimplemented("ALTER USER role_name INHERIT VALID UNTIL ' timestamp '"),
+		Parses("ALTER USER role_name INHERIT VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_ROLE INHERIT VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER INHERIT VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER INHERIT VALID UNTIL ' timestamp '"),
-		Unimplemented("ALTER USER role_name WITH INHERIT VALID UNTIL ' timestamp '"),
+		Parses("ALTER USER role_name WITH INHERIT VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH INHERIT VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH INHERIT VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER WITH INHERIT VALID UNTIL ' timestamp '"),
-		Unimplemented("ALTER USER role_name NOINHERIT VALID UNTIL ' timestamp '"),
+		Parses("ALTER USER role_name NOINHERIT VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOINHERIT VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER NOINHERIT VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER NOINHERIT VALID UNTIL ' timestamp '"),
-		Unimplemented("ALTER USER role_name WITH NOINHERIT VALID UNTIL ' timestamp '"),
+		Parses("ALTER USER role_name WITH NOINHERIT VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOINHERIT VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOINHERIT VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOINHERIT VALID UNTIL ' timestamp '"),
@@ -2986,43 +2986,43 @@ func TestAlterUser(t *testing.T) {
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOLOGIN VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOLOGIN VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOLOGIN VALID UNTIL ' timestamp '"),
-		Unimplemented("ALTER USER role_name REPLICATION VALID UNTIL ' timestamp '"),
+		Parses("ALTER USER role_name REPLICATION VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_ROLE REPLICATION VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER REPLICATION VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER REPLICATION VALID UNTIL ' timestamp '"),
-		Unimplemented("ALTER USER role_name WITH REPLICATION VALID UNTIL ' timestamp '"),
+		Parses("ALTER USER role_name WITH REPLICATION VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH REPLICATION VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH REPLICATION VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER WITH REPLICATION VALID UNTIL ' timestamp '"),
-		Unimplemented("ALTER USER role_name NOREPLICATION VALID UNTIL ' timestamp '"),
+		Parses("ALTER USER role_name NOREPLICATION VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOREPLICATION VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER NOREPLICATION VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER NOREPLICATION VALID UNTIL ' timestamp '"),
-		Unimplemented("ALTER USER role_name WITH NOREPLICATION VALID UNTIL ' timestamp '"),
+		Parses("ALTER USER role_name WITH NOREPLICATION VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOREPLICATION VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOREPLICATION VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOREPLICATION VALID UNTIL ' timestamp '"),
-		Unimplemented("ALTER USER role_name BYPASSRLS VALID UNTIL ' timestamp '"),
+		Parses("ALTER USER role_name BYPASSRLS VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_ROLE BYPASSRLS VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER BYPASSRLS VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER BYPASSRLS VALID UNTIL ' timestamp '"),
-		Unimplemented("ALTER USER role_name WITH BYPASSRLS VALID UNTIL ' timestamp '"),
+		Parses("ALTER USER role_name WITH BYPASSRLS VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH BYPASSRLS VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH BYPASSRLS VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER WITH BYPASSRLS VALID UNTIL ' timestamp '"),
-		Unimplemented("ALTER USER role_name NOBYPASSRLS VALID UNTIL ' timestamp '"),
+		Parses("ALTER USER role_name NOBYPASSRLS VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_ROLE NOBYPASSRLS VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER NOBYPASSRLS VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER NOBYPASSRLS VALID UNTIL ' timestamp '"),
-		Unimplemented("ALTER USER role_name WITH NOBYPASSRLS VALID UNTIL ' timestamp '"),
+		Parses("ALTER USER role_name WITH NOBYPASSRLS VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH NOBYPASSRLS VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH NOBYPASSRLS VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER WITH NOBYPASSRLS VALID UNTIL ' timestamp '"),
-		Unimplemented("ALTER USER role_name CONNECTION LIMIT -1 VALID UNTIL ' timestamp '"),
+		Parses("ALTER USER role_name CONNECTION LIMIT -1 VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_ROLE CONNECTION LIMIT -1 VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER CONNECTION LIMIT -1 VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER CONNECTION LIMIT -1 VALID UNTIL ' timestamp '"),
-		Unimplemented("ALTER USER role_name WITH CONNECTION LIMIT -1 VALID UNTIL ' timestamp '"),
+		Parses("ALTER USER role_name WITH CONNECTION LIMIT -1 VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH CONNECTION LIMIT -1 VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH CONNECTION LIMIT -1 VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER WITH CONNECTION LIMIT -1 VALID UNTIL ' timestamp '"),
@@ -3034,11 +3034,11 @@ func TestAlterUser(t *testing.T) {
 		Unimplemented("ALTER USER CURRENT_ROLE WITH PASSWORD ' password ' VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH PASSWORD ' password ' VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER WITH PASSWORD ' password ' VALID UNTIL ' timestamp '"),
-		Unimplemented("ALTER USER role_name ENCRYPTED PASSWORD ' password ' VALID UNTIL ' timestamp '"),
+		Parses("ALTER USER role_name ENCRYPTED PASSWORD ' password ' VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_ROLE ENCRYPTED PASSWORD ' password ' VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER ENCRYPTED PASSWORD ' password ' VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER ENCRYPTED PASSWORD ' password ' VALID UNTIL ' timestamp '"),
-		Unimplemented("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' VALID UNTIL ' timestamp '"),
+		Parses("ALTER USER role_name WITH ENCRYPTED PASSWORD ' password ' VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_ROLE WITH ENCRYPTED PASSWORD ' password ' VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH ENCRYPTED PASSWORD ' password ' VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER WITH ENCRYPTED PASSWORD ' password ' VALID UNTIL ' timestamp '"),
@@ -3058,7 +3058,7 @@ func TestAlterUser(t *testing.T) {
 		Unimplemented("ALTER USER CURRENT_ROLE WITH VALID UNTIL ' timestamp ' VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER CURRENT_USER WITH VALID UNTIL ' timestamp ' VALID UNTIL ' timestamp '"),
 		Unimplemented("ALTER USER SESSION_USER WITH VALID UNTIL ' timestamp ' VALID UNTIL ' timestamp '"),
-		Unimplemented("ALTER USER name RENAME TO new_name"),
+		Converts("ALTER USER name RENAME TO new_name"),
 		Unimplemented("ALTER USER role_name SET configuration_parameter TO value"),
 		Unimplemented("ALTER USER CURRENT_ROLE SET configuration_parameter TO value"),
 		Unimplemented("ALTER USER CURRENT_USER SET configuration_parameter TO value"),
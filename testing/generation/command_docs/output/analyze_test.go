@@ -18,7 +18,7 @@ import "testing"
 
 func TestAnalyze(t *testing.T) {
 	tests := []QueryParses{
-		Unimplemented("ANALYZE"),
+		Converts("ANALYZE"),
 		Unimplemented("ANALYZE ( VERBOSE )"),
 		Unimplemented("ANALYZE ( VERBOSE true )"),
 		Unimplemented("ANALYZE ( SKIP_LOCKED )"),
@@ -39,7 +39,7 @@ func TestAnalyze(t *testing.T) {
 		Unimplemented("ANALYZE ( VERBOSE true , SKIP_LOCKED true )"),
 		Unimplemented("ANALYZE ( SKIP_LOCKED , SKIP_LOCKED true )"),
 		Unimplemented("ANALYZE ( SKIP_LOCKED true , SKIP_LOCKED true )"),
-		Parses("ANALYZE table_name"),
+		Converts("ANALYZE table_name"),
 		Unimplemented("ANALYZE ( VERBOSE ) table_name"),
 		Unimplemented("ANALYZE ( VERBOSE true ) table_name"),
 		Unimplemented("ANALYZE ( SKIP_LOCKED ) table_name"),
@@ -102,7 +102,7 @@ func TestAnalyze(t *testing.T) {
 		Unimplemented("ANALYZE ( VERBOSE true , SKIP_LOCKED true ) table_name ( column_name , column_name )"),
 		Unimplemented("ANALYZE ( SKIP_LOCKED , SKIP_LOCKED true ) table_name ( column_name , column_name )"),
 		Unimplemented("ANALYZE ( SKIP_LOCKED true , SKIP_LOCKED true ) table_name ( column_name , column_name )"),
-		Unimplemented("ANALYZE table_name , table_name"),
+		Converts("ANALYZE table_name , table_name"),
 		Unimplemented("ANALYZE ( VERBOSE ) table_name , table_name"),
 		Unimplemented("ANALYZE ( VERBOSE true ) table_name , table_name"),
 		Unimplemented("ANALYZE ( SKIP_LOCKED ) table_name , table_name"),
@@ -291,15 +291,15 @@ func TestAnalyze(t *testing.T) {
 		Unimplemented("ANALYZE ( VERBOSE true , SKIP_LOCKED true ) table_name ( column_name , column_name ) , table_name ( column_name , column_name )"),
 		Unimplemented("ANALYZE ( SKIP_LOCKED , SKIP_LOCKED true ) table_name ( column_name , column_name ) , table_name ( column_name , column_name )"),
 		Unimplemented("ANALYZE ( SKIP_LOCKED true , SKIP_LOCKED true ) table_name ( column_name , column_name ) , table_name ( column_name , column_name )"),
-		Unimplemented("ANALYZE"),
-		Parses("ANALYZE VERBOSE"),
-		Parses("ANALYZE table_name"),
+		Converts("ANALYZE"),
+		Converts("ANALYZE VERBOSE"),
+		Converts("ANALYZE table_name"),
 		Unimplemented("ANALYZE VERBOSE table_name"),
 		Unimplemented("ANALYZE table_name ( column_name )"),
 		Unimplemented("ANALYZE VERBOSE table_name ( column_name )"),
 		Unimplemented("ANALYZE table_name ( column_name , column_name )"),
 		Unimplemented("ANALYZE VERBOSE table_name ( column_name , column_name )"),
-		Unimplemented("ANALYZE table_name , table_name"),
+		Converts("ANALYZE table_name , table_name"),
 		Unimplemented("ANALYZE VERBOSE table_name , table_name"),
 		Unimplemented("ANALYZE table_name ( column_name ) , table_name"),
 		Unimplemented("ANALYZE VERBOSE table_name ( column_name ) , table_name"),
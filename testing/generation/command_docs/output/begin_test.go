@@ -21,30 +21,30 @@ func TestBegin(t *testing.T) {
 		Converts("BEGIN"),
 		Converts("BEGIN WORK"),
 		Converts("BEGIN TRANSACTION"),
-		Parses("BEGIN ISOLATION LEVEL SERIALIZABLE"),
-		Parses("BEGIN WORK ISOLATION LEVEL SERIALIZABLE"),
-		Parses("BEGIN TRANSACTION ISOLATION LEVEL SERIALIZABLE"),
-		Parses("BEGIN ISOLATION LEVEL REPEATABLE READ"),
-		Parses("BEGIN WORK ISOLATION LEVEL REPEATABLE READ"),
-		Parses("BEGIN TRANSACTION ISOLATION LEVEL REPEATABLE READ"),
-		Parses("BEGIN ISOLATION LEVEL READ COMMITTED"),
-		Parses("BEGIN WORK ISOLATION LEVEL READ COMMITTED"),
-		Parses("BEGIN TRANSACTION ISOLATION LEVEL READ COMMITTED"),
-		Parses("BEGIN ISOLATION LEVEL READ UNCOMMITTED"),
-		Parses("BEGIN WORK ISOLATION LEVEL READ UNCOMMITTED"),
-		Parses("BEGIN TRANSACTION ISOLATION LEVEL READ UNCOMMITTED"),
+		Converts("BEGIN ISOLATION LEVEL SERIALIZABLE"),
+		Converts("BEGIN WORK ISOLATION LEVEL SERIALIZABLE"),
+		Converts("BEGIN TRANSACTION ISOLATION LEVEL SERIALIZABLE"),
+		Converts("BEGIN ISOLATION LEVEL REPEATABLE READ"),
+		Converts("BEGIN WORK ISOLATION LEVEL REPEATABLE READ"),
+		Converts("BEGIN TRANSACTION ISOLATION LEVEL REPEATABLE READ"),
+		Converts("BEGIN ISOLATION LEVEL READ COMMITTED"),
+		Converts("BEGIN WORK ISOLATION LEVEL READ COMMITTED"),
+		Converts("BEGIN TRANSACTION ISOLATION LEVEL READ COMMITTED"),
+		Converts("BEGIN ISOLATION LEVEL READ UNCOMMITTED"),
+		Converts("BEGIN WORK ISOLATION LEVEL READ UNCOMMITTED"),
+		Converts("BEGIN TRANSACTION ISOLATION LEVEL READ UNCOMMITTED"),
 		Converts("BEGIN READ WRITE"),
 		Converts("BEGIN WORK READ WRITE"),
 		Converts("BEGIN TRANSACTION READ WRITE"),
 		Converts("BEGIN READ ONLY"),
 		Converts("BEGIN WORK READ ONLY"),
 		Converts("BEGIN TRANSACTION READ ONLY"),
-		Parses("BEGIN DEFERRABLE"),
-		Parses("BEGIN WORK DEFERRABLE"),
-		Parses("BEGIN TRANSACTION DEFERRABLE"),
-		Parses("BEGIN NOT DEFERRABLE"),
-		Parses("BEGIN WORK NOT DEFERRABLE"),
-		Parses("BEGIN TRANSACTION NOT DEFERRABLE"),
+		Converts("BEGIN DEFERRABLE"),
+		Converts("BEGIN WORK DEFERRABLE"),
+		Converts("BEGIN TRANSACTION DEFERRABLE"),
+		Converts("BEGIN NOT DEFERRABLE"),
+		Converts("BEGIN WORK NOT DEFERRABLE"),
+		Converts("BEGIN TRANSACTION NOT DEFERRABLE"),
 		Unimplemented("BEGIN ISOLATION LEVEL SERIALIZABLE , ISOLATION LEVEL SERIALIZABLE"),
 		Unimplemented("BEGIN WORK ISOLATION LEVEL SERIALIZABLE , ISOLATION LEVEL SERIALIZABLE"),
 		Unimplemented("BEGIN TRANSACTION ISOLATION LEVEL SERIALIZABLE , ISOLATION LEVEL SERIALIZABLE"),
@@ -57,18 +57,18 @@ func TestBegin(t *testing.T) {
 		Unimplemented("BEGIN ISOLATION LEVEL READ UNCOMMITTED , ISOLATION LEVEL SERIALIZABLE"),
 		Unimplemented("BEGIN WORK ISOLATION LEVEL READ UNCOMMITTED , ISOLATION LEVEL SERIALIZABLE"),
 		Unimplemented("BEGIN TRANSACTION ISOLATION LEVEL READ UNCOMMITTED , ISOLATION LEVEL SERIALIZABLE"),
-		Parses("BEGIN READ WRITE , ISOLATION LEVEL SERIALIZABLE"),
-		Parses("BEGIN WORK READ WRITE , ISOLATION LEVEL SERIALIZABLE"),
-		Parses("BEGIN TRANSACTION READ WRITE , ISOLATION LEVEL SERIALIZABLE"),
-		Parses("BEGIN READ ONLY , ISOLATION LEVEL SERIALIZABLE"),
-		Parses("BEGIN WORK READ ONLY , ISOLATION LEVEL SERIALIZABLE"),
-		Parses("BEGIN TRANSACTION READ ONLY , ISOLATION LEVEL SERIALIZABLE"),
-		Parses("BEGIN DEFERRABLE , ISOLATION LEVEL SERIALIZABLE"),
-		Parses("BEGIN WORK DEFERRABLE , ISOLATION LEVEL SERIALIZABLE"),
-		Parses("BEGIN TRANSACTION DEFERRABLE , ISOLATION LEVEL SERIALIZABLE"),
-		Parses("BEGIN NOT DEFERRABLE , ISOLATION LEVEL SERIALIZABLE"),
-		Parses("BEGIN WORK NOT DEFERRABLE , ISOLATION LEVEL SERIALIZABLE"),
-		Parses("BEGIN TRANSACTION NOT DEFERRABLE , ISOLATION LEVEL SERIALIZABLE"),
+		Converts("BEGIN READ WRITE , ISOLATION LEVEL SERIALIZABLE"),
+		Converts("BEGIN WORK READ WRITE , ISOLATION LEVEL SERIALIZABLE"),
+		Converts("BEGIN TRANSACTION READ WRITE , ISOLATION LEVEL SERIALIZABLE"),
+		Converts("BEGIN READ ONLY , ISOLATION LEVEL SERIALIZABLE"),
+		Converts("BEGIN WORK READ ONLY , ISOLATION LEVEL SERIALIZABLE"),
+		Converts("BEGIN TRANSACTION READ ONLY , ISOLATION LEVEL SERIALIZABLE"),
+		Converts("BEGIN DEFERRABLE , ISOLATION LEVEL SERIALIZABLE"),
+		Converts("BEGIN WORK DEFERRABLE , ISOLATION LEVEL SERIALIZABLE"),
+		Converts("BEGIN TRANSACTION DEFERRABLE , ISOLATION LEVEL SERIALIZABLE"),
+		Converts("BEGIN NOT DEFERRABLE , ISOLATION LEVEL SERIALIZABLE"),
+		Converts("BEGIN WORK NOT DEFERRABLE , ISOLATION LEVEL SERIALIZABLE"),
+		Converts("BEGIN TRANSACTION NOT DEFERRABLE , ISOLATION LEVEL SERIALIZABLE"),
 		Unimplemented("BEGIN ISOLATION LEVEL SERIALIZABLE , ISOLATION LEVEL REPEATABLE READ"),
 		Unimplemented("BEGIN WORK ISOLATION LEVEL SERIALIZABLE , ISOLATION LEVEL REPEATABLE READ"),
 		Unimplemented("BEGIN TRANSACTION ISOLATION LEVEL SERIALIZABLE , ISOLATION LEVEL REPEATABLE READ"),
@@ -81,18 +81,18 @@ func TestBegin(t *testing.T) {
 		Unimplemented("BEGIN ISOLATION LEVEL READ UNCOMMITTED , ISOLATION LEVEL REPEATABLE READ"),
 		Unimplemented("BEGIN WORK ISOLATION LEVEL READ UNCOMMITTED , ISOLATION LEVEL REPEATABLE READ"),
 		Unimplemented("BEGIN TRANSACTION ISOLATION LEVEL READ UNCOMMITTED , ISOLATION LEVEL REPEATABLE READ"),
-		Parses("BEGIN READ WRITE , ISOLATION LEVEL REPEATABLE READ"),
-		Parses("BEGIN WORK READ WRITE , ISOLATION LEVEL REPEATABLE READ"),
-		Parses("BEGIN TRANSACTION READ WRITE , ISOLATION LEVEL REPEATABLE READ"),
-		Parses("BEGIN READ ONLY , ISOLATION LEVEL REPEATABLE READ"),
-		Parses("BEGIN WORK READ ONLY , ISOLATION LEVEL REPEATABLE READ"),
-		Parses("BEGIN TRANSACTION READ ONLY , ISOLATION LEVEL REPEATABLE READ"),
-		Parses("BEGIN DEFERRABLE , ISOLATION LEVEL REPEATABLE READ"),
-		Parses("BEGIN WORK DEFERRABLE , ISOLATION LEVEL REPEATABLE READ"),
-		Parses("BEGIN TRANSACTION DEFERRABLE , ISOLATION LEVEL REPEATABLE READ"),
-		Parses("BEGIN NOT DEFERRABLE , ISOLATION LEVEL REPEATABLE READ"),
-		Parses("BEGIN WORK NOT DEFERRABLE , ISOLATION LEVEL REPEATABLE READ"),
-		Parses("BEGIN TRANSACTION NOT DEFERRABLE , ISOLATION LEVEL REPEATABLE READ"),
+		Converts("BEGIN READ WRITE , ISOLATION LEVEL REPEATABLE READ"),
+		Converts("BEGIN WORK READ WRITE , ISOLATION LEVEL REPEATABLE READ"),
+		Converts("BEGIN TRANSACTION READ WRITE , ISOLATION LEVEL REPEATABLE READ"),
+		Converts("BEGIN READ ONLY , ISOLATION LEVEL REPEATABLE READ"),
+		Converts("BEGIN WORK READ ONLY , ISOLATION LEVEL REPEATABLE READ"),
+		Converts("BEGIN TRANSACTION READ ONLY , ISOLATION LEVEL REPEATABLE READ"),
+		Converts("BEGIN DEFERRABLE , ISOLATION LEVEL REPEATABLE READ"),
+		Converts("BEGIN WORK DEFERRABLE , ISOLATION LEVEL REPEATABLE READ"),
+		Converts("BEGIN TRANSACTION DEFERRABLE , ISOLATION LEVEL REPEATABLE READ"),
+		Converts("BEGIN NOT DEFERRABLE , ISOLATION LEVEL REPEATABLE READ"),
+		Converts("BEGIN WORK NOT DEFERRABLE , ISOLATION LEVEL REPEATABLE READ"),
+		Converts("BEGIN TRANSACTION NOT DEFERRABLE , ISOLATION LEVEL REPEATABLE READ"),
 		Unimplemented("BEGIN ISOLATION LEVEL SERIALIZABLE , ISOLATION LEVEL READ COMMITTED"),
 		Unimplemented("BEGIN WORK ISOLATION LEVEL SERIALIZABLE , ISOLATION LEVEL READ COMMITTED"),
 		Unimplemented("BEGIN TRANSACTION ISOLATION LEVEL SERIALIZABLE , ISOLATION LEVEL READ COMMITTED"),
@@ -105,18 +105,18 @@ func TestBegin(t *testing.T) {
 		Unimplemented("BEGIN ISOLATION LEVEL READ UNCOMMITTED , ISOLATION LEVEL READ COMMITTED"),
 		Unimplemented("BEGIN WORK ISOLATION LEVEL READ UNCOMMITTED , ISOLATION LEVEL READ COMMITTED"),
 		Unimplemented("BEGIN TRANSACTION ISOLATION LEVEL READ UNCOMMITTED , ISOLATION LEVEL READ COMMITTED"),
-		Parses("BEGIN READ WRITE , ISOLATION LEVEL READ COMMITTED"),
-		Parses("BEGIN WORK READ WRITE , ISOLATION LEVEL READ COMMITTED"),
-		Parses("BEGIN TRANSACTION READ WRITE , ISOLATION LEVEL READ COMMITTED"),
-		Parses("BEGIN READ ONLY , ISOLATION LEVEL READ COMMITTED"),
-		Parses("BEGIN WORK READ ONLY , ISOLATION LEVEL READ COMMITTED"),
-		Parses("BEGIN TRANSACTION READ ONLY , ISOLATION LEVEL READ COMMITTED"),
-		Parses("BEGIN DEFERRABLE , ISOLATION LEVEL READ COMMITTED"),
-		Parses("BEGIN WORK DEFERRABLE , ISOLATION LEVEL READ COMMITTED"),
-		Parses("BEGIN TRANSACTION DEFERRABLE , ISOLATION LEVEL READ COMMITTED"),
-		Parses("BEGIN NOT DEFERRABLE , ISOLATION LEVEL READ COMMITTED"),
-		Parses("BEGIN WORK NOT DEFERRABLE , ISOLATION LEVEL READ COMMITTED"),
-		Parses("BEGIN TRANSACTION NOT DEFERRABLE , ISOLATION LEVEL READ COMMITTED"),
+		Converts("BEGIN READ WRITE , ISOLATION LEVEL READ COMMITTED"),
+		Converts("BEGIN WORK READ WRITE , ISOLATION LEVEL READ COMMITTED"),
+		Converts("BEGIN TRANSACTION READ WRITE , ISOLATION LEVEL READ COMMITTED"),
+		Converts("BEGIN READ ONLY , ISOLATION LEVEL READ COMMITTED"),
+		Converts("BEGIN WORK READ ONLY , ISOLATION LEVEL READ COMMITTED"),
+		Converts("BEGIN TRANSACTION READ ONLY , ISOLATION LEVEL READ COMMITTED"),
+		Converts("BEGIN DEFERRABLE , ISOLATION LEVEL READ COMMITTED"),
+		Converts("BEGIN WORK DEFERRABLE , ISOLATION LEVEL READ COMMITTED"),
+		Converts("BEGIN TRANSACTION DEFERRABLE , ISOLATION LEVEL READ COMMITTED"),
+		Converts("BEGIN NOT DEFERRABLE , ISOLATION LEVEL READ COMMITTED"),
+		Converts("BEGIN WORK NOT DEFERRABLE , ISOLATION LEVEL READ COMMITTED"),
+		Converts("BEGIN TRANSACTION NOT DEFERRABLE , ISOLATION LEVEL READ COMMITTED"),
 		Unimplemented("BEGIN ISOLATION LEVEL SERIALIZABLE , ISOLATION LEVEL READ UNCOMMITTED"),
 		Unimplemented("BEGIN WORK ISOLATION LEVEL SERIALIZABLE , ISOLATION LEVEL READ UNCOMMITTED"),
 		Unimplemented("BEGIN TRANSACTION ISOLATION LEVEL SERIALIZABLE , ISOLATION LEVEL READ UNCOMMITTED"),
@@ -129,108 +129,108 @@ func TestBegin(t *testing.T) {
 		Unimplemented("BEGIN ISOLATION LEVEL READ UNCOMMITTED , ISOLATION LEVEL READ UNCOMMITTED"),
 		Unimplemented("BEGIN WORK ISOLATION LEVEL READ UNCOMMITTED , ISOLATION LEVEL READ UNCOMMITTED"),
 		Unimplemented("BEGIN TRANSACTION ISOLATION LEVEL READ UNCOMMITTED , ISOLATION LEVEL READ UNCOMMITTED"),
-		Parses("BEGIN READ WRITE , ISOLATION LEVEL READ UNCOMMITTED"),
-		Parses("BEGIN WORK READ WRITE , ISOLATION LEVEL READ UNCOMMITTED"),
-		Parses("BEGIN TRANSACTION READ WRITE , ISOLATION LEVEL READ UNCOMMITTED"),
-		Parses("BEGIN READ ONLY , ISOLATION LEVEL READ UNCOMMITTED"),
-		Parses("BEGIN WORK READ ONLY , ISOLATION LEVEL READ UNCOMMITTED"),
-		Parses("BEGIN TRANSACTION READ ONLY , ISOLATION LEVEL READ UNCOMMITTED"),
-		Parses("BEGIN DEFERRABLE , ISOLATION LEVEL READ UNCOMMITTED"),
-		Parses("BEGIN WORK DEFERRABLE , ISOLATION LEVEL READ UNCOMMITTED"),
-		Parses("BEGIN TRANSACTION DEFERRABLE , ISOLATION LEVEL READ UNCOMMITTED"),
-		Parses("BEGIN NOT DEFERRABLE , ISOLATION LEVEL READ UNCOMMITTED"),
-		Parses("BEGIN WORK NOT DEFERRABLE , ISOLATION LEVEL READ UNCOMMITTED"),
-		Parses("BEGIN TRANSACTION NOT DEFERRABLE , ISOLATION LEVEL READ UNCOMMITTED"),
-		Parses("BEGIN ISOLATION LEVEL SERIALIZABLE , READ WRITE"),
-		Parses("BEGIN WORK ISOLATION LEVEL SERIALIZABLE , READ WRITE"),
-		Parses("BEGIN TRANSACTION ISOLATION LEVEL SERIALIZABLE , READ WRITE"),
-		Parses("BEGIN ISOLATION LEVEL REPEATABLE READ , READ WRITE"),
-		Parses("BEGIN WORK ISOLATION LEVEL REPEATABLE READ , READ WRITE"),
-		Parses("BEGIN TRANSACTION ISOLATION LEVEL REPEATABLE READ , READ WRITE"),
-		Parses("BEGIN ISOLATION LEVEL READ COMMITTED , READ WRITE"),
-		Parses("BEGIN WORK ISOLATION LEVEL READ COMMITTED , READ WRITE"),
-		Parses("BEGIN TRANSACTION ISOLATION LEVEL READ COMMITTED , READ WRITE"),
-		Parses("BEGIN ISOLATION LEVEL READ UNCOMMITTED , READ WRITE"),
-		Parses("BEGIN WORK ISOLATION LEVEL READ UNCOMMITTED , READ WRITE"),
-		Parses("BEGIN TRANSACTION ISOLATION LEVEL READ UNCOMMITTED , READ WRITE"),
+		Converts("BEGIN READ WRITE , ISOLATION LEVEL READ UNCOMMITTED"),
+		Converts("BEGIN WORK READ WRITE , ISOLATION LEVEL READ UNCOMMITTED"),
+		Converts("BEGIN TRANSACTION READ WRITE , ISOLATION LEVEL READ UNCOMMITTED"),
+		Converts("BEGIN READ ONLY , ISOLATION LEVEL READ UNCOMMITTED"),
+		Converts("BEGIN WORK READ ONLY , ISOLATION LEVEL READ UNCOMMITTED"),
+		Converts("BEGIN TRANSACTION READ ONLY , ISOLATION LEVEL READ UNCOMMITTED"),
+		Converts("BEGIN DEFERRABLE , ISOLATION LEVEL READ UNCOMMITTED"),
+		Converts("BEGIN WORK DEFERRABLE , ISOLATION LEVEL READ UNCOMMITTED"),
+		Converts("BEGIN TRANSACTION DEFERRABLE , ISOLATION LEVEL READ UNCOMMITTED"),
+		Converts("BEGIN NOT DEFERRABLE , ISOLATION LEVEL READ UNCOMMITTED"),
+		Converts("BEGIN WORK NOT DEFERRABLE , ISOLATION LEVEL READ UNCOMMITTED"),
+		Converts("BEGIN TRANSACTION NOT DEFERRABLE , ISOLATION LEVEL READ UNCOMMITTED"),
+		Converts("BEGIN ISOLATION LEVEL SERIALIZABLE , READ WRITE"),
+		Converts("BEGIN WORK ISOLATION LEVEL SERIALIZABLE , READ WRITE"),
+		Converts("BEGIN TRANSACTION ISOLATION LEVEL SERIALIZABLE , READ WRITE"),
+		Converts("BEGIN ISOLATION LEVEL REPEATABLE READ , READ WRITE"),
+		Converts("BEGIN WORK ISOLATION LEVEL REPEATABLE READ , READ WRITE"),
+		Converts("BEGIN TRANSACTION ISOLATION LEVEL REPEATABLE READ , READ WRITE"),
+		Converts("BEGIN ISOLATION LEVEL READ COMMITTED , READ WRITE"),
+		Converts("BEGIN WORK ISOLATION LEVEL READ COMMITTED , READ WRITE"),
+		Converts("BEGIN TRANSACTION ISOLATION LEVEL READ COMMITTED , READ WRITE"),
+		Converts("BEGIN ISOLATION LEVEL READ UNCOMMITTED , READ WRITE"),
+		Converts("BEGIN WORK ISOLATION LEVEL READ UNCOMMITTED , READ WRITE"),
+		Converts("BEGIN TRANSACTION ISOLATION LEVEL READ UNCOMMITTED , READ WRITE"),
 		Unimplemented("BEGIN READ WRITE , READ WRITE"),
 		Unimplemented("BEGIN WORK READ WRITE , READ WRITE"),
 		Unimplemented("BEGIN TRANSACTION READ WRITE , READ WRITE"),
 		Unimplemented("BEGIN READ ONLY , READ WRITE"),
 		Unimplemented("BEGIN WORK READ ONLY , READ WRITE"),
 		Unimplemented("BEGIN TRANSACTION READ ONLY , READ WRITE"),
-		Parses("BEGIN DEFERRABLE , READ WRITE"),
-		Parses("BEGIN WORK DEFERRABLE , READ WRITE"),
-		Parses("BEGIN TRANSACTION DEFERRABLE , READ WRITE"),
-		Parses("BEGIN NOT DEFERRABLE , READ WRITE"),
-		Parses("BEGIN WORK NOT DEFERRABLE , READ WRITE"),
-		Parses("BEGIN TRANSACTION NOT DEFERRABLE , READ WRITE"),
-		Parses("BEGIN ISOLATION LEVEL SERIALIZABLE , READ ONLY"),
-		Parses("BEGIN WORK ISOLATION LEVEL SERIALIZABLE , READ ONLY"),
-		Parses("BEGIN TRANSACTION ISOLATION LEVEL SERIALIZABLE , READ ONLY"),
-		Parses("BEGIN ISOLATION LEVEL REPEATABLE READ , READ ONLY"),
-		Parses("BEGIN WORK ISOLATION LEVEL REPEATABLE READ , READ ONLY"),
-		Parses("BEGIN TRANSACTION ISOLATION LEVEL REPEATABLE READ , READ ONLY"),
-		Parses("BEGIN ISOLATION LEVEL READ COMMITTED , READ ONLY"),
-		Parses("BEGIN WORK ISOLATION LEVEL READ COMMITTED , READ ONLY"),
-		Parses("BEGIN TRANSACTION ISOLATION LEVEL READ COMMITTED , READ ONLY"),
-		Parses("BEGIN ISOLATION LEVEL READ UNCOMMITTED , READ ONLY"),
-		Parses("BEGIN WORK ISOLATION LEVEL READ UNCOMMITTED , READ ONLY"),
-		Parses("BEGIN TRANSACTION ISOLATION LEVEL READ UNCOMMITTED , READ ONLY"),
+		Converts("BEGIN DEFERRABLE , READ WRITE"),
+		Converts("BEGIN WORK DEFERRABLE , READ WRITE"),
+		Converts("BEGIN TRANSACTION DEFERRABLE , READ WRITE"),
+		Converts("BEGIN NOT DEFERRABLE , READ WRITE"),
+		Converts("BEGIN WORK NOT DEFERRABLE , READ WRITE"),
+		Converts("BEGIN TRANSACTION NOT DEFERRABLE , READ WRITE"),
+		Converts("BEGIN ISOLATION LEVEL SERIALIZABLE , READ ONLY"),
+		Converts("BEGIN WORK ISOLATION LEVEL SERIALIZABLE , READ ONLY"),
+		Converts("BEGIN TRANSACTION ISOLATION LEVEL SERIALIZABLE , READ ONLY"),
+		Converts("BEGIN ISOLATION LEVEL REPEATABLE READ , READ ONLY"),
+		Converts("BEGIN WORK ISOLATION LEVEL REPEATABLE READ , READ ONLY"),
+		Converts("BEGIN TRANSACTION ISOLATION LEVEL REPEATABLE READ , READ ONLY"),
+		Converts("BEGIN ISOLATION LEVEL READ COMMITTED , READ ONLY"),
+		Converts("BEGIN WORK ISOLATION LEVEL READ COMMITTED , READ ONLY"),
+		Converts("BEGIN TRANSACTION ISOLATION LEVEL READ COMMITTED , READ ONLY"),
+		Converts("BEGIN ISOLATION LEVEL READ UNCOMMITTED , READ ONLY"),
+		Converts("BEGIN WORK ISOLATION LEVEL READ UNCOMMITTED , READ ONLY"),
+		Converts("BEGIN TRANSACTION ISOLATION LEVEL READ UNCOMMITTED , READ ONLY"),
 		Unimplemented("BEGIN READ WRITE , READ ONLY"),
 		Unimplemented("BEGIN WORK READ WRITE , READ ONLY"),
 		Unimplemented("BEGIN TRANSACTION READ WRITE , READ ONLY"),
 		Unimplemented("BEGIN READ ONLY , READ ONLY"),
 		Unimplemented("BEGIN WORK READ ONLY , READ ONLY"),
 		Unimplemented("BEGIN TRANSACTION READ ONLY , READ ONLY"),
-		Parses("BEGIN DEFERRABLE , READ ONLY"),
-		Parses("BEGIN WORK DEFERRABLE , READ ONLY"),
-		Parses("BEGIN TRANSACTION DEFERRABLE , READ ONLY"),
-		Parses("BEGIN NOT DEFERRABLE , READ ONLY"),
-		Parses("BEGIN WORK NOT DEFERRABLE , READ ONLY"),
-		Parses("BEGIN TRANSACTION NOT DEFERRABLE , READ ONLY"),
-		Parses("BEGIN ISOLATION LEVEL SERIALIZABLE , DEFERRABLE"),
-		Parses("BEGIN WORK ISOLATION LEVEL SERIALIZABLE , DEFERRABLE"),
-		Parses("BEGIN TRANSACTION ISOLATION LEVEL SERIALIZABLE , DEFERRABLE"),
-		Parses("BEGIN ISOLATION LEVEL REPEATABLE READ , DEFERRABLE"),
-		Parses("BEGIN WORK ISOLATION LEVEL REPEATABLE READ , DEFERRABLE"),
-		Parses("BEGIN TRANSACTION ISOLATION LEVEL REPEATABLE READ , DEFERRABLE"),
-		Parses("BEGIN ISOLATION LEVEL READ COMMITTED , DEFERRABLE"),
-		Parses("BEGIN WORK ISOLATION LEVEL READ COMMITTED , DEFERRABLE"),
-		Parses("BEGIN TRANSACTION ISOLATION LEVEL READ COMMITTED , DEFERRABLE"),
-		Parses("BEGIN ISOLATION LEVEL READ UNCOMMITTED , DEFERRABLE"),
-		Parses("BEGIN WORK ISOLATION LEVEL READ UNCOMMITTED , DEFERRABLE"),
-		Parses("BEGIN TRANSACTION ISOLATION LEVEL READ UNCOMMITTED , DEFERRABLE"),
-		Parses("BEGIN READ WRITE , DEFERRABLE"),
-		Parses("BEGIN WORK READ WRITE , DEFERRABLE"),
-		Parses("BEGIN TRANSACTION READ WRITE , DEFERRABLE"),
-		Parses("BEGIN READ ONLY , DEFERRABLE"),
-		Parses("BEGIN WORK READ ONLY , DEFERRABLE"),
-		Parses("BEGIN TRANSACTION READ ONLY , DEFERRABLE"),
+		Converts("BEGIN DEFERRABLE , READ ONLY"),
+		Converts("BEGIN WORK DEFERRABLE , READ ONLY"),
+		Converts("BEGIN TRANSACTION DEFERRABLE , READ ONLY"),
+		Converts("BEGIN NOT DEFERRABLE , READ ONLY"),
+		Converts("BEGIN WORK NOT DEFERRABLE , READ ONLY"),
+		Converts("BEGIN TRANSACTION NOT DEFERRABLE , READ ONLY"),
+		Converts("BEGIN ISOLATION LEVEL SERIALIZABLE , DEFERRABLE"),
+		Converts("BEGIN WORK ISOLATION LEVEL SERIALIZABLE , DEFERRABLE"),
+		Converts("BEGIN TRANSACTION ISOLATION LEVEL SERIALIZABLE , DEFERRABLE"),
+		Converts("BEGIN ISOLATION LEVEL REPEATABLE READ , DEFERRABLE"),
+		Converts("BEGIN WORK ISOLATION LEVEL REPEATABLE READ , DEFERRABLE"),
+		Converts("BEGIN TRANSACTION ISOLATION LEVEL REPEATABLE READ , DEFERRABLE"),
+		Converts("BEGIN ISOLATION LEVEL READ COMMITTED , DEFERRABLE"),
+		Converts("BEGIN WORK ISOLATION LEVEL READ COMMITTED , DEFERRABLE"),
+		Converts("BEGIN TRANSACTION ISOLATION LEVEL READ COMMITTED , DEFERRABLE"),
+		Converts("BEGIN ISOLATION LEVEL READ UNCOMMITTED , DEFERRABLE"),
+		Converts("BEGIN WORK ISOLATION LEVEL READ UNCOMMITTED , DEFERRABLE"),
+		Converts("BEGIN TRANSACTION ISOLATION LEVEL READ UNCOMMITTED , DEFERRABLE"),
+		Converts("BEGIN READ WRITE , DEFERRABLE"),
+		Converts("BEGIN WORK READ WRITE , DEFERRABLE"),
+		Converts("BEGIN TRANSACTION READ WRITE , DEFERRABLE"),
+		Converts("BEGIN READ ONLY , DEFERRABLE"),
+		Converts("BEGIN WORK READ ONLY , DEFERRABLE"),
+		Converts("BEGIN TRANSACTION READ ONLY , DEFERRABLE"),
 		Unimplemented("BEGIN DEFERRABLE , DEFERRABLE"),
 		Unimplemented("BEGIN WORK DEFERRABLE , DEFERRABLE"),
 		Unimplemented("BEGIN TRANSACTION DEFERRABLE , DEFERRABLE"),
 		Unimplemented("BEGIN NOT DEFERRABLE , DEFERRABLE"),
 		Unimplemented("BEGIN WORK NOT DEFERRABLE , DEFERRABLE"),
 		Unimplemented("BEGIN TRANSACTION NOT DEFERRABLE , DEFERRABLE"),
-		Parses("BEGIN ISOLATION LEVEL SERIALIZABLE , NOT DEFERRABLE"),
-		Parses("BEGIN WORK ISOLATION LEVEL SERIALIZABLE , NOT DEFERRABLE"),
-		Parses("BEGIN TRANSACTION ISOLATION LEVEL SERIALIZABLE , NOT DEFERRABLE"),
-		Parses("BEGIN ISOLATION LEVEL REPEATABLE READ , NOT DEFERRABLE"),
-		Parses("BEGIN WORK ISOLATION LEVEL REPEATABLE READ , NOT DEFERRABLE"),
-		Parses("BEGIN TRANSACTION ISOLATION LEVEL REPEATABLE READ , NOT DEFERRABLE"),
-		Parses("BEGIN ISOLATION LEVEL READ COMMITTED , NOT DEFERRABLE"),
-		Parses("BEGIN WORK ISOLATION LEVEL READ COMMITTED , NOT DEFERRABLE"),
-		Parses("BEGIN TRANSACTION ISOLATION LEVEL READ COMMITTED , NOT DEFERRABLE"),
-		Parses("BEGIN ISOLATION LEVEL READ UNCOMMITTED , NOT DEFERRABLE"),
-		Parses("BEGIN WORK ISOLATION LEVEL READ UNCOMMITTED , NOT DEFERRABLE"),
-		Parses("BEGIN TRANSACTION ISOLATION LEVEL READ UNCOMMITTED , NOT DEFERRABLE"),
-		Parses("BEGIN READ WRITE , NOT DEFERRABLE"),
-		Parses("BEGIN WORK READ WRITE , NOT DEFERRABLE"),
-		Parses("BEGIN TRANSACTION READ WRITE , NOT DEFERRABLE"),
-		Parses("BEGIN READ ONLY , NOT DEFERRABLE"),
-		Parses("BEGIN WORK READ ONLY , NOT DEFERRABLE"),
-		Parses("BEGIN TRANSACTION READ ONLY , NOT DEFERRABLE"),
+		Converts("BEGIN ISOLATION LEVEL SERIALIZABLE , NOT DEFERRABLE"),
+		Converts("BEGIN WORK ISOLATION LEVEL SERIALIZABLE , NOT DEFERRABLE"),
+		Converts("BEGIN TRANSACTION ISOLATION LEVEL SERIALIZABLE , NOT DEFERRABLE"),
+		Converts("BEGIN ISOLATION LEVEL REPEATABLE READ , NOT DEFERRABLE"),
+		Converts("BEGIN WORK ISOLATION LEVEL REPEATABLE READ , NOT DEFERRABLE"),
+		Converts("BEGIN TRANSACTION ISOLATION LEVEL REPEATABLE READ , NOT DEFERRABLE"),
+		Converts("BEGIN ISOLATION LEVEL READ COMMITTED , NOT DEFERRABLE"),
+		Converts("BEGIN WORK ISOLATION LEVEL READ COMMITTED , NOT DEFERRABLE"),
+		Converts("BEGIN TRANSACTION ISOLATION LEVEL READ COMMITTED , NOT DEFERRABLE"),
+		Converts("BEGIN ISOLATION LEVEL READ UNCOMMITTED , NOT DEFERRABLE"),
+		Converts("BEGIN WORK ISOLATION LEVEL READ UNCOMMITTED , NOT DEFERRABLE"),
+		Converts("BEGIN TRANSACTION ISOLATION LEVEL READ UNCOMMITTED , NOT DEFERRABLE"),
+		Converts("BEGIN READ WRITE , NOT DEFERRABLE"),
+		Converts("BEGIN WORK READ WRITE , NOT DEFERRABLE"),
+		Converts("BEGIN TRANSACTION READ WRITE , NOT DEFERRABLE"),
+		Converts("BEGIN READ ONLY , NOT DEFERRABLE"),
+		Converts("BEGIN WORK READ ONLY , NOT DEFERRABLE"),
+		Converts("BEGIN TRANSACTION READ ONLY , NOT DEFERRABLE"),
 		Unimplemented("BEGIN DEFERRABLE , NOT DEFERRABLE"),
 		Unimplemented("BEGIN WORK DEFERRABLE , NOT DEFERRABLE"),
 		Unimplemented("BEGIN TRANSACTION DEFERRABLE , NOT DEFERRABLE"),
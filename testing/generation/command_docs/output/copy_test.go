@@ -22,7 +22,7 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename '"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename '"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command '"),
-		Parses("COPY table_name FROM STDIN"),
+		Converts("COPY table_name FROM STDIN"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' ( FORMAT format_name )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( FORMAT format_name )"),
 		Unimplemented("COPY table_name FROM STDIN ( FORMAT format_name )"),
@@ -59,43 +59,43 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' ( DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' ( DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN ( DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN ( DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN ( DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) FROM STDIN ( DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' WITH ( DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name FROM STDIN WITH ( DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name FROM STDIN WITH ( DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name FROM ' filename ' ( NULL ' null_string ' )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' ( NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( NULL ' null_string ' )"),
-		Unimplemented("COPY table_name FROM STDIN ( NULL ' null_string ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN ( NULL ' null_string ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN ( NULL ' null_string ' )"),
+		Converts("COPY table_name FROM STDIN ( NULL ' null_string ' )"),
+		Converts("COPY table_name ( column_name ) FROM STDIN ( NULL ' null_string ' )"),
+		Converts("COPY table_name ( column_name , column_name ) FROM STDIN ( NULL ' null_string ' )"),
 		Unimplemented("COPY table_name FROM ' filename ' WITH ( NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' WITH ( NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' WITH ( NULL ' null_string ' )"),
-		Unimplemented("COPY table_name FROM STDIN WITH ( NULL ' null_string ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN WITH ( NULL ' null_string ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( NULL ' null_string ' )"),
+		Converts("COPY table_name FROM STDIN WITH ( NULL ' null_string ' )"),
+		Converts("COPY table_name ( column_name ) FROM STDIN WITH ( NULL ' null_string ' )"),
+		Converts("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( NULL ' null_string ' )"),
 		Unimplemented("COPY table_name FROM ' filename ' ( HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( HEADER )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' ( HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' ( HEADER )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN ( HEADER )"),
+		Converts("COPY table_name ( column_name , column_name ) FROM STDIN ( HEADER )"),
 		Unimplemented("COPY table_name FROM ' filename ' WITH ( HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' WITH ( HEADER )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' WITH ( HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' WITH ( HEADER )"),
-		Unimplemented("COPY table_name FROM STDIN WITH ( HEADER )"),
+		Converts("COPY table_name FROM STDIN WITH ( HEADER )"),
 		Unimplemented("COPY table_name FROM ' filename ' ( HEADER true )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( HEADER true )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' ( HEADER true )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' ( HEADER true )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN ( HEADER true )"),
+		Converts("COPY table_name ( column_name , column_name ) FROM STDIN ( HEADER true )"),
 		Unimplemented("COPY table_name FROM ' filename ' WITH ( HEADER true )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' WITH ( HEADER true )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' WITH ( HEADER true )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' WITH ( HEADER true )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' WITH ( HEADER true )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN WITH ( HEADER true )"),
+		Converts("COPY table_name ( column_name ) FROM STDIN WITH ( HEADER true )"),
 		Unimplemented("COPY table_name FROM ' filename ' ( HEADER MATCH )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' ( HEADER MATCH )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( HEADER MATCH )"),
@@ -109,18 +109,18 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( HEADER MATCH )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' ( QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' ( QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name FROM STDIN ( QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN ( QUOTE ' quote_character ' )"),
+		Parses("COPY table_name FROM STDIN ( QUOTE ' quote_character ' )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN ( QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name FROM ' filename ' WITH ( QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' WITH ( QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' WITH ( QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name FROM STDIN WITH ( QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN WITH ( QUOTE ' quote_character ' )"),
+		Parses("COPY table_name FROM STDIN WITH ( QUOTE ' quote_character ' )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN WITH ( QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' ( ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN ( ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN ( ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN ( ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) FROM STDIN ( ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( FORCE_QUOTE ( column_name ) )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' ( FORCE_QUOTE ( column_name ) )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' ( FORCE_QUOTE ( column_name ) )"),
@@ -687,34 +687,34 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name FROM STDIN WITH ( DELIMITER ' delimiter_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM STDIN WITH ( DELIMITER ' delimiter_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( NULL ' null_string ' , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name FROM STDIN ( NULL ' null_string ' , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN ( NULL ' null_string ' , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name FROM STDIN ( NULL ' null_string ' , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN ( NULL ' null_string ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name FROM ' filename ' WITH ( NULL ' null_string ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' WITH ( NULL ' null_string ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' WITH ( NULL ' null_string ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name FROM ' filename ' ( HEADER , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( HEADER , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name FROM STDIN ( HEADER , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN ( HEADER , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN ( HEADER , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name FROM STDIN ( HEADER , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN ( HEADER , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) FROM STDIN ( HEADER , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' WITH ( HEADER , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' WITH ( HEADER , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' WITH ( HEADER , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' WITH ( HEADER , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN WITH ( HEADER , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN WITH ( HEADER , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name FROM ' filename ' ( HEADER true , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' ( HEADER true , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( HEADER true , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' ( HEADER true , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( HEADER true , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' ( HEADER true , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN ( HEADER true , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN ( HEADER true , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN ( HEADER true , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) FROM STDIN ( HEADER true , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' WITH ( HEADER true , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' WITH ( HEADER true , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' WITH ( HEADER true , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name FROM STDIN WITH ( HEADER true , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN WITH ( HEADER true , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name FROM STDIN WITH ( HEADER true , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN WITH ( HEADER true , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name FROM ' filename ' ( HEADER MATCH , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' ( HEADER MATCH , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( HEADER MATCH , DELIMITER ' delimiter_character ' )"),
@@ -726,22 +726,22 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name FROM STDIN ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name FROM STDIN ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' WITH ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' WITH ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name FROM STDIN WITH ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name FROM STDIN WITH ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) FROM STDIN ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name FROM ' filename ' WITH ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' WITH ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' WITH ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' WITH ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' WITH ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name FROM STDIN WITH ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN WITH ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name FROM STDIN WITH ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN WITH ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name FROM ' filename ' ( FORCE_QUOTE ( column_name ) , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' ( FORCE_QUOTE ( column_name ) , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( FORCE_QUOTE ( column_name ) , DELIMITER ' delimiter_character ' )"),
@@ -835,12 +835,12 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' ( DELIMITER ' delimiter_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( DELIMITER ' delimiter_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' ( DELIMITER ' delimiter_character ' , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name FROM STDIN ( DELIMITER ' delimiter_character ' , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN ( DELIMITER ' delimiter_character ' , NULL ' null_string ' )"),
+		Parses("COPY table_name FROM STDIN ( DELIMITER ' delimiter_character ' , NULL ' null_string ' )"),
+		Parses("COPY table_name ( column_name , column_name ) FROM STDIN ( DELIMITER ' delimiter_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' WITH ( DELIMITER ' delimiter_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' WITH ( DELIMITER ' delimiter_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' WITH ( DELIMITER ' delimiter_character ' , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( DELIMITER ' delimiter_character ' , NULL ' null_string ' )"),
+		Parses("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( DELIMITER ' delimiter_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( NULL ' null_string ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' ( NULL ' null_string ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM STDIN ( NULL ' null_string ' , NULL ' null_string ' )"),
@@ -848,17 +848,17 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' WITH ( NULL ' null_string ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( NULL ' null_string ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' ( HEADER , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name FROM STDIN ( HEADER , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN ( HEADER , NULL ' null_string ' )"),
+		Converts("COPY table_name FROM STDIN ( HEADER , NULL ' null_string ' )"),
+		Converts("COPY table_name ( column_name ) FROM STDIN ( HEADER , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name FROM ' filename ' WITH ( HEADER , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' WITH ( HEADER , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( HEADER , NULL ' null_string ' )"),
+		Converts("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( HEADER , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' ( HEADER true , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( HEADER true , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' ( HEADER true , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name FROM STDIN ( HEADER true , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN ( HEADER true , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN ( HEADER true , NULL ' null_string ' )"),
+		Converts("COPY table_name FROM STDIN ( HEADER true , NULL ' null_string ' )"),
+		Converts("COPY table_name ( column_name ) FROM STDIN ( HEADER true , NULL ' null_string ' )"),
+		Converts("COPY table_name ( column_name , column_name ) FROM STDIN ( HEADER true , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name FROM ' filename ' WITH ( HEADER true , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' WITH ( HEADER true , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' WITH ( HEADER true , NULL ' null_string ' )"),
@@ -868,21 +868,21 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name FROM STDIN WITH ( HEADER MATCH , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( HEADER MATCH , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
+		Parses("COPY table_name ( column_name , column_name ) FROM STDIN ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name FROM ' filename ' WITH ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' WITH ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' WITH ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' WITH ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' WITH ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name FROM STDIN WITH ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN WITH ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
+		Parses("COPY table_name FROM STDIN WITH ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN WITH ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name FROM ' filename ' ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
+		Parses("COPY table_name ( column_name , column_name ) FROM STDIN ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' WITH ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' WITH ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' WITH ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
@@ -990,27 +990,27 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' ( DELIMITER ' delimiter_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( DELIMITER ' delimiter_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( DELIMITER ' delimiter_character ' , HEADER )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN ( DELIMITER ' delimiter_character ' , HEADER )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN ( DELIMITER ' delimiter_character ' , HEADER )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN ( DELIMITER ' delimiter_character ' , HEADER )"),
+		Parses("COPY table_name ( column_name , column_name ) FROM STDIN ( DELIMITER ' delimiter_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' WITH ( DELIMITER ' delimiter_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' WITH ( DELIMITER ' delimiter_character ' , HEADER )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' WITH ( DELIMITER ' delimiter_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' WITH ( DELIMITER ' delimiter_character ' , HEADER )"),
-		Unimplemented("COPY table_name FROM STDIN WITH ( DELIMITER ' delimiter_character ' , HEADER )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN WITH ( DELIMITER ' delimiter_character ' , HEADER )"),
+		Parses("COPY table_name FROM STDIN WITH ( DELIMITER ' delimiter_character ' , HEADER )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN WITH ( DELIMITER ' delimiter_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' ( NULL ' null_string ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( NULL ' null_string ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( NULL ' null_string ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' ( NULL ' null_string ' , HEADER )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN ( NULL ' null_string ' , HEADER )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN ( NULL ' null_string ' , HEADER )"),
+		Converts("COPY table_name ( column_name ) FROM STDIN ( NULL ' null_string ' , HEADER )"),
+		Converts("COPY table_name ( column_name , column_name ) FROM STDIN ( NULL ' null_string ' , HEADER )"),
 		Unimplemented("COPY table_name FROM ' filename ' WITH ( NULL ' null_string ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' WITH ( NULL ' null_string ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' WITH ( NULL ' null_string ' , HEADER )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' WITH ( NULL ' null_string ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' WITH ( NULL ' null_string ' , HEADER )"),
-		Unimplemented("COPY table_name FROM STDIN WITH ( NULL ' null_string ' , HEADER )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN WITH ( NULL ' null_string ' , HEADER )"),
+		Converts("COPY table_name FROM STDIN WITH ( NULL ' null_string ' , HEADER )"),
+		Converts("COPY table_name ( column_name ) FROM STDIN WITH ( NULL ' null_string ' , HEADER )"),
 		Unimplemented("COPY table_name FROM ' filename ' ( HEADER , HEADER )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' ( HEADER , HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' WITH ( HEADER , HEADER )"),
@@ -1034,27 +1034,27 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name FROM ' filename ' ( QUOTE ' quote_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' ( QUOTE ' quote_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( QUOTE ' quote_character ' , HEADER )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN ( QUOTE ' quote_character ' , HEADER )"),
+		Parses("COPY table_name ( column_name , column_name ) FROM STDIN ( QUOTE ' quote_character ' , HEADER )"),
 		Unimplemented("COPY table_name FROM ' filename ' WITH ( QUOTE ' quote_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' WITH ( QUOTE ' quote_character ' , HEADER )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' WITH ( QUOTE ' quote_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' WITH ( QUOTE ' quote_character ' , HEADER )"),
-		Unimplemented("COPY table_name FROM STDIN WITH ( QUOTE ' quote_character ' , HEADER )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN WITH ( QUOTE ' quote_character ' , HEADER )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( QUOTE ' quote_character ' , HEADER )"),
+		Parses("COPY table_name FROM STDIN WITH ( QUOTE ' quote_character ' , HEADER )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN WITH ( QUOTE ' quote_character ' , HEADER )"),
+		Parses("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( QUOTE ' quote_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' ( ESCAPE ' escape_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( ESCAPE ' escape_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( ESCAPE ' escape_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' ( ESCAPE ' escape_character ' , HEADER )"),
-		Unimplemented("COPY table_name FROM STDIN ( ESCAPE ' escape_character ' , HEADER )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN ( ESCAPE ' escape_character ' , HEADER )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN ( ESCAPE ' escape_character ' , HEADER )"),
+		Parses("COPY table_name FROM STDIN ( ESCAPE ' escape_character ' , HEADER )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN ( ESCAPE ' escape_character ' , HEADER )"),
+		Parses("COPY table_name ( column_name , column_name ) FROM STDIN ( ESCAPE ' escape_character ' , HEADER )"),
 		Unimplemented("COPY table_name FROM ' filename ' WITH ( ESCAPE ' escape_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' WITH ( ESCAPE ' escape_character ' , HEADER )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' WITH ( ESCAPE ' escape_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' WITH ( ESCAPE ' escape_character ' , HEADER )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN WITH ( ESCAPE ' escape_character ' , HEADER )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( ESCAPE ' escape_character ' , HEADER )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN WITH ( ESCAPE ' escape_character ' , HEADER )"),
+		Parses("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( ESCAPE ' escape_character ' , HEADER )"),
 		Unimplemented("COPY table_name FROM ' filename ' ( FORCE_QUOTE ( column_name ) , HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( FORCE_QUOTE ( column_name ) , HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' ( FORCE_QUOTE ( column_name ) , HEADER )"),
@@ -1163,7 +1163,7 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( DELIMITER ' delimiter_character ' , HEADER true )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' WITH ( DELIMITER ' delimiter_character ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' WITH ( DELIMITER ' delimiter_character ' , HEADER true )"),
-		Unimplemented("COPY table_name FROM STDIN WITH ( DELIMITER ' delimiter_character ' , HEADER true )"),
+		Parses("COPY table_name FROM STDIN WITH ( DELIMITER ' delimiter_character ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' ( NULL ' null_string ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( NULL ' null_string ' , HEADER true )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' ( NULL ' null_string ' , HEADER true )"),
@@ -1172,8 +1172,8 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' WITH ( NULL ' null_string ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' WITH ( NULL ' null_string ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' WITH ( NULL ' null_string ' , HEADER true )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN WITH ( NULL ' null_string ' , HEADER true )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( NULL ' null_string ' , HEADER true )"),
+		Converts("COPY table_name ( column_name ) FROM STDIN WITH ( NULL ' null_string ' , HEADER true )"),
+		Converts("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( NULL ' null_string ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name ) FROM STDIN ( HEADER , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN ( HEADER , HEADER true )"),
 		Unimplemented("COPY table_name FROM ' filename ' WITH ( HEADER , HEADER true )"),
@@ -1196,24 +1196,24 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( HEADER MATCH , HEADER true )"),
 		Unimplemented("COPY table_name FROM ' filename ' ( QUOTE ' quote_character ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( QUOTE ' quote_character ' , HEADER true )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN ( QUOTE ' quote_character ' , HEADER true )"),
+		Parses("COPY table_name ( column_name , column_name ) FROM STDIN ( QUOTE ' quote_character ' , HEADER true )"),
 		Unimplemented("COPY table_name FROM ' filename ' WITH ( QUOTE ' quote_character ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' WITH ( QUOTE ' quote_character ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' WITH ( QUOTE ' quote_character ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' WITH ( QUOTE ' quote_character ' , HEADER true )"),
-		Unimplemented("COPY table_name FROM STDIN WITH ( QUOTE ' quote_character ' , HEADER true )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN WITH ( QUOTE ' quote_character ' , HEADER true )"),
+		Parses("COPY table_name FROM STDIN WITH ( QUOTE ' quote_character ' , HEADER true )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN WITH ( QUOTE ' quote_character ' , HEADER true )"),
 		Unimplemented("COPY table_name FROM ' filename ' ( ESCAPE ' escape_character ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' ( ESCAPE ' escape_character ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( ESCAPE ' escape_character ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( ESCAPE ' escape_character ' , HEADER true )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN ( ESCAPE ' escape_character ' , HEADER true )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN ( ESCAPE ' escape_character ' , HEADER true )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN ( ESCAPE ' escape_character ' , HEADER true )"),
+		Parses("COPY table_name ( column_name , column_name ) FROM STDIN ( ESCAPE ' escape_character ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' WITH ( ESCAPE ' escape_character ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' WITH ( ESCAPE ' escape_character ' , HEADER true )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' WITH ( ESCAPE ' escape_character ' , HEADER true )"),
-		Unimplemented("COPY table_name FROM STDIN WITH ( ESCAPE ' escape_character ' , HEADER true )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( ESCAPE ' escape_character ' , HEADER true )"),
+		Parses("COPY table_name FROM STDIN WITH ( ESCAPE ' escape_character ' , HEADER true )"),
+		Parses("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( ESCAPE ' escape_character ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( FORCE_QUOTE ( column_name ) , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' ( FORCE_QUOTE ( column_name ) , HEADER true )"),
 		Unimplemented("COPY table_name FROM STDIN ( FORCE_QUOTE ( column_name ) , HEADER true )"),
@@ -1477,10 +1477,10 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name FROM STDIN ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name FROM STDIN ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' WITH ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name FROM STDIN WITH ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name FROM STDIN WITH ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name FROM ' filename ' ( NULL ' null_string ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' ( NULL ' null_string ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( NULL ' null_string ' , QUOTE ' quote_character ' )"),
@@ -1490,29 +1490,29 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' WITH ( NULL ' null_string ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' WITH ( NULL ' null_string ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' WITH ( NULL ' null_string ' , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name FROM STDIN WITH ( NULL ' null_string ' , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN WITH ( NULL ' null_string ' , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name FROM STDIN WITH ( NULL ' null_string ' , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN WITH ( NULL ' null_string ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name FROM ' filename ' ( HEADER , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' ( HEADER , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( HEADER , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' ( HEADER , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( HEADER , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN ( HEADER , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) FROM STDIN ( HEADER , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name FROM ' filename ' WITH ( HEADER , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' WITH ( HEADER , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' WITH ( HEADER , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name FROM STDIN WITH ( HEADER , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN WITH ( HEADER , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( HEADER , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name FROM STDIN WITH ( HEADER , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN WITH ( HEADER , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( HEADER , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name FROM ' filename ' ( HEADER true , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' ( HEADER true , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( HEADER true , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' ( HEADER true , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name FROM STDIN ( HEADER true , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN ( HEADER true , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name FROM STDIN ( HEADER true , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN ( HEADER true , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name FROM ' filename ' WITH ( HEADER true , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' WITH ( HEADER true , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name FROM STDIN WITH ( HEADER true , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name FROM STDIN WITH ( HEADER true , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name FROM ' filename ' ( HEADER MATCH , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' ( HEADER MATCH , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( HEADER MATCH , QUOTE ' quote_character ' )"),
@@ -1537,10 +1537,10 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name ) FROM STDIN WITH ( QUOTE ' quote_character ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' ( ESCAPE ' escape_character ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' ( ESCAPE ' escape_character ' , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN ( ESCAPE ' escape_character ' , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) FROM STDIN ( ESCAPE ' escape_character ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' WITH ( ESCAPE ' escape_character ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' WITH ( ESCAPE ' escape_character ' , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN WITH ( ESCAPE ' escape_character ' , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN WITH ( ESCAPE ' escape_character ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' ( FORCE_QUOTE ( column_name ) , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( FORCE_QUOTE ( column_name ) , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' ( FORCE_QUOTE ( column_name ) , QUOTE ' quote_character ' )"),
@@ -1635,7 +1635,7 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name FROM ' filename ' ( DELIMITER ' delimiter_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' ( DELIMITER ' delimiter_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( DELIMITER ' delimiter_character ' , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name FROM STDIN ( DELIMITER ' delimiter_character ' , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name FROM STDIN ( DELIMITER ' delimiter_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name FROM ' filename ' WITH ( DELIMITER ' delimiter_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' WITH ( DELIMITER ' delimiter_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' WITH ( DELIMITER ' delimiter_character ' , ESCAPE ' escape_character ' )"),
@@ -1645,26 +1645,26 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) FROM STDIN ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' WITH ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' WITH ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN WITH ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN WITH ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( HEADER , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' ( HEADER , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( HEADER , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' ( HEADER , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name FROM STDIN ( HEADER , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name FROM STDIN ( HEADER , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' WITH ( HEADER , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' WITH ( HEADER , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' WITH ( HEADER , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' WITH ( HEADER , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( HEADER , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( HEADER , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM PROGRAM ' command ' ( HEADER true , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN ( HEADER true , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN ( HEADER true , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN ( HEADER true , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) FROM STDIN ( HEADER true , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' WITH ( HEADER true , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN WITH ( HEADER true , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN WITH ( HEADER true , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name FROM ' filename ' ( HEADER MATCH , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM ' filename ' ( HEADER MATCH , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name FROM STDIN ( HEADER MATCH , ESCAPE ' escape_character ' )"),
@@ -1679,13 +1679,13 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( HEADER MATCH , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name FROM STDIN ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name FROM STDIN ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name FROM ' filename ' WITH ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM ' filename ' WITH ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' WITH ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name FROM STDIN WITH ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) FROM STDIN WITH ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name FROM STDIN WITH ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name ) FROM STDIN WITH ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name FROM ' filename ' ( ESCAPE ' escape_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name FROM PROGRAM ' command ' ( ESCAPE ' escape_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) FROM PROGRAM ' command ' ( ESCAPE ' escape_character ' , ESCAPE ' escape_character ' )"),
@@ -5972,8 +5972,8 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name , column_name ) FROM STDIN WITH ( ENCODING ' encoding_name ' , ENCODING ' encoding_name ' ) WHERE condition"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename '"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command '"),
-		Unimplemented("COPY table_name TO STDOUT"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT"),
+		Converts("COPY table_name TO STDOUT"),
+		Converts("COPY table_name ( column_name ) TO STDOUT"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' ( FORMAT format_name )"),
 		Unimplemented("COPY table_name TO STDOUT ( FORMAT format_name )"),
 		Unimplemented("COPY table_name ( column_name ) TO STDOUT ( FORMAT format_name )"),
@@ -6016,35 +6016,35 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' ( DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' ( DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' ( DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name TO STDOUT ( DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name TO STDOUT ( DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name TO ' filename ' WITH ( DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' WITH ( DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' WITH ( DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' WITH ( DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' WITH ( DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name TO STDOUT WITH ( DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT WITH ( DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name TO STDOUT WITH ( DELIMITER ' delimiter_character ' )"),
+		Parses("COPY ( SELECT 1 ) TO STDOUT WITH ( DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' ( NULL ' null_string ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' ( NULL ' null_string ' )"),
-		Unimplemented("COPY table_name TO STDOUT ( NULL ' null_string ' )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT ( NULL ' null_string ' )"),
+		Converts("COPY table_name TO STDOUT ( NULL ' null_string ' )"),
+		Converts("COPY ( SELECT 1 ) TO STDOUT ( NULL ' null_string ' )"),
 		Unimplemented("COPY table_name TO ' filename ' WITH ( NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' WITH ( NULL ' null_string ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' WITH ( NULL ' null_string ' )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' WITH ( NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' WITH ( NULL ' null_string ' )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT WITH ( NULL ' null_string ' )"),
+		Converts("COPY ( SELECT 1 ) TO STDOUT WITH ( NULL ' null_string ' )"),
 		Unimplemented("COPY table_name TO ' filename ' ( HEADER )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' ( HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' ( HEADER )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' ( HEADER )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' ( HEADER )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' ( HEADER )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT ( HEADER )"),
+		Converts("COPY table_name ( column_name , column_name ) TO STDOUT ( HEADER )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' WITH ( HEADER )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' WITH ( HEADER )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' WITH ( HEADER )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT WITH ( HEADER )"),
+		Converts("COPY table_name ( column_name ) TO STDOUT WITH ( HEADER )"),
 		Unimplemented("COPY table_name TO ' filename ' ( HEADER true )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' ( HEADER true )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' ( HEADER true )"),
@@ -6052,16 +6052,16 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name TO PROGRAM ' command ' ( HEADER true )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' ( HEADER true )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' ( HEADER true )"),
-		Unimplemented("COPY table_name TO STDOUT ( HEADER true )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT ( HEADER true )"),
+		Converts("COPY table_name TO STDOUT ( HEADER true )"),
+		Converts("COPY table_name ( column_name , column_name ) TO STDOUT ( HEADER true )"),
 		Unimplemented("COPY table_name TO ' filename ' WITH ( HEADER true )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' WITH ( HEADER true )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' WITH ( HEADER true )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' WITH ( HEADER true )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' WITH ( HEADER true )"),
-		Unimplemented("COPY table_name TO STDOUT WITH ( HEADER true )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT WITH ( HEADER true )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( HEADER true )"),
+		Converts("COPY table_name TO STDOUT WITH ( HEADER true )"),
+		Converts("COPY table_name ( column_name ) TO STDOUT WITH ( HEADER true )"),
+		Converts("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( HEADER true )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' ( HEADER MATCH )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' ( HEADER MATCH )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' ( HEADER MATCH )"),
@@ -6072,22 +6072,22 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name TO PROGRAM ' command ' WITH ( HEADER MATCH )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' ( QUOTE ' quote_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' ( QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT ( QUOTE ' quote_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT ( QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name TO ' filename ' WITH ( QUOTE ' quote_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' WITH ( QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' WITH ( QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name TO STDOUT WITH ( QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( QUOTE ' quote_character ' )"),
+		Parses("COPY table_name TO STDOUT WITH ( QUOTE ' quote_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' ( ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' ( ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' ( ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name TO STDOUT ( ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT ( ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT ( ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name TO STDOUT ( ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name ) TO STDOUT ( ESCAPE ' escape_character ' )"),
+		Parses("COPY ( SELECT 1 ) TO STDOUT ( ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' WITH ( ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' WITH ( ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name TO STDOUT WITH ( ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT WITH ( ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name TO STDOUT WITH ( ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name ) TO STDOUT WITH ( ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name TO ' filename ' ( FORCE_QUOTE ( column_name ) )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' ( FORCE_QUOTE ( column_name ) )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' ( FORCE_QUOTE ( column_name ) )"),
@@ -6884,31 +6884,31 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' ( NULL ' null_string ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' ( NULL ' null_string ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' ( NULL ' null_string ' , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT ( NULL ' null_string ' , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT ( NULL ' null_string ' , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name ( column_name ) TO STDOUT ( NULL ' null_string ' , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT ( NULL ' null_string ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' WITH ( NULL ' null_string ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' WITH ( NULL ' null_string ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' WITH ( NULL ' null_string ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' WITH ( NULL ' null_string ' , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( NULL ' null_string ' , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( NULL ' null_string ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' ( HEADER , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' ( HEADER , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' ( HEADER , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' ( HEADER , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' ( HEADER , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' ( HEADER , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name TO STDOUT ( HEADER , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT ( HEADER , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name TO STDOUT ( HEADER , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY ( SELECT 1 ) TO STDOUT ( HEADER , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' WITH ( HEADER , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' ( HEADER true , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' ( HEADER true , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name TO STDOUT ( HEADER true , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT ( HEADER true , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name TO STDOUT ( HEADER true , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT ( HEADER true , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' WITH ( HEADER true , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' WITH ( HEADER true , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' WITH ( HEADER true , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT WITH ( HEADER true , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT WITH ( HEADER true , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name ( column_name ) TO STDOUT WITH ( HEADER true , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY ( SELECT 1 ) TO STDOUT WITH ( HEADER true , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name TO ' filename ' ( HEADER MATCH , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' ( HEADER MATCH , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' ( HEADER MATCH , DELIMITER ' delimiter_character ' )"),
@@ -6924,24 +6924,24 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name TO ' filename ' ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name ( column_name ) TO STDOUT ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY ( SELECT 1 ) TO STDOUT ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' WITH ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' WITH ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' WITH ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' WITH ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' WITH ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name TO STDOUT WITH ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT WITH ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name TO STDOUT WITH ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY ( SELECT 1 ) TO STDOUT WITH ( QUOTE ' quote_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name ( column_name ) TO STDOUT ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY ( SELECT 1 ) TO STDOUT ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' WITH ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' WITH ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT WITH ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
+		Parses("COPY table_name ( column_name ) TO STDOUT WITH ( ESCAPE ' escape_character ' , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' ( FORCE_QUOTE ( column_name ) , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' ( FORCE_QUOTE ( column_name ) , DELIMITER ' delimiter_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' ( FORCE_QUOTE ( column_name ) , DELIMITER ' delimiter_character ' )"),
@@ -7082,12 +7082,12 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' ( DELIMITER ' delimiter_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' ( DELIMITER ' delimiter_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' ( DELIMITER ' delimiter_character ' , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT ( DELIMITER ' delimiter_character ' , NULL ' null_string ' )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT ( DELIMITER ' delimiter_character ' , NULL ' null_string ' )"),
+		Parses("COPY table_name ( column_name ) TO STDOUT ( DELIMITER ' delimiter_character ' , NULL ' null_string ' )"),
+		Parses("COPY ( SELECT 1 ) TO STDOUT ( DELIMITER ' delimiter_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' WITH ( DELIMITER ' delimiter_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' WITH ( DELIMITER ' delimiter_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' WITH ( DELIMITER ' delimiter_character ' , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( DELIMITER ' delimiter_character ' , NULL ' null_string ' )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( DELIMITER ' delimiter_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name TO ' filename ' ( NULL ' null_string ' , NULL ' null_string ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' ( NULL ' null_string ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' ( NULL ' null_string ' , NULL ' null_string ' )"),
@@ -7102,13 +7102,13 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY ( SELECT 1 ) TO STDOUT WITH ( NULL ' null_string ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' ( HEADER , NULL ' null_string ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' ( HEADER , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name TO STDOUT ( HEADER , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT ( HEADER , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT ( HEADER , NULL ' null_string ' )"),
+		Converts("COPY table_name TO STDOUT ( HEADER , NULL ' null_string ' )"),
+		Converts("COPY table_name ( column_name ) TO STDOUT ( HEADER , NULL ' null_string ' )"),
+		Converts("COPY table_name ( column_name , column_name ) TO STDOUT ( HEADER , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name TO ' filename ' WITH ( HEADER , NULL ' null_string ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' WITH ( HEADER , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT WITH ( HEADER , NULL ' null_string ' )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT WITH ( HEADER , NULL ' null_string ' )"),
+		Converts("COPY table_name ( column_name ) TO STDOUT WITH ( HEADER , NULL ' null_string ' )"),
+		Converts("COPY ( SELECT 1 ) TO STDOUT WITH ( HEADER , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name TO ' filename ' ( HEADER true , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' ( HEADER true , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' ( HEADER true , NULL ' null_string ' )"),
@@ -7116,14 +7116,14 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name TO PROGRAM ' command ' ( HEADER true , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' ( HEADER true , NULL ' null_string ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' ( HEADER true , NULL ' null_string ' )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT ( HEADER true , NULL ' null_string ' )"),
+		Converts("COPY ( SELECT 1 ) TO STDOUT ( HEADER true , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' WITH ( HEADER true , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' WITH ( HEADER true , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' WITH ( HEADER true , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' WITH ( HEADER true , NULL ' null_string ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' WITH ( HEADER true , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name TO STDOUT WITH ( HEADER true , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( HEADER true , NULL ' null_string ' )"),
+		Converts("COPY table_name TO STDOUT WITH ( HEADER true , NULL ' null_string ' )"),
+		Converts("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( HEADER true , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' ( HEADER MATCH , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' ( HEADER MATCH , NULL ' null_string ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' ( HEADER MATCH , NULL ' null_string ' )"),
@@ -7142,25 +7142,25 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' WITH ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' WITH ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' WITH ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT WITH ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT WITH ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
+		Parses("COPY table_name ( column_name ) TO STDOUT WITH ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
+		Parses("COPY ( SELECT 1 ) TO STDOUT WITH ( QUOTE ' quote_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name TO STDOUT ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
+		Parses("COPY table_name TO STDOUT ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
+		Parses("COPY ( SELECT 1 ) TO STDOUT ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' WITH ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' WITH ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' WITH ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' WITH ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' WITH ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
-		Unimplemented("COPY table_name TO STDOUT WITH ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT WITH ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
+		Parses("COPY table_name TO STDOUT WITH ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
+		Parses("COPY ( SELECT 1 ) TO STDOUT WITH ( ESCAPE ' escape_character ' , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name TO ' filename ' ( FORCE_QUOTE ( column_name ) , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' ( FORCE_QUOTE ( column_name ) , NULL ' null_string ' )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' ( FORCE_QUOTE ( column_name ) , NULL ' null_string ' )"),
@@ -7289,19 +7289,19 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name TO PROGRAM ' command ' ( DELIMITER ' delimiter_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' ( DELIMITER ' delimiter_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' ( DELIMITER ' delimiter_character ' , HEADER )"),
-		Unimplemented("COPY table_name TO STDOUT ( DELIMITER ' delimiter_character ' , HEADER )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT ( DELIMITER ' delimiter_character ' , HEADER )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT ( DELIMITER ' delimiter_character ' , HEADER )"),
+		Parses("COPY table_name TO STDOUT ( DELIMITER ' delimiter_character ' , HEADER )"),
+		Parses("COPY table_name ( column_name ) TO STDOUT ( DELIMITER ' delimiter_character ' , HEADER )"),
+		Parses("COPY ( SELECT 1 ) TO STDOUT ( DELIMITER ' delimiter_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' WITH ( DELIMITER ' delimiter_character ' , HEADER )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' WITH ( DELIMITER ' delimiter_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' WITH ( DELIMITER ' delimiter_character ' , HEADER )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( DELIMITER ' delimiter_character ' , HEADER )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT WITH ( DELIMITER ' delimiter_character ' , HEADER )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( DELIMITER ' delimiter_character ' , HEADER )"),
+		Parses("COPY ( SELECT 1 ) TO STDOUT WITH ( DELIMITER ' delimiter_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' ( NULL ' null_string ' , HEADER )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' ( NULL ' null_string ' , HEADER )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' ( NULL ' null_string ' , HEADER )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' ( NULL ' null_string ' , HEADER )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT ( NULL ' null_string ' , HEADER )"),
+		Converts("COPY table_name ( column_name ) TO STDOUT ( NULL ' null_string ' , HEADER )"),
 		Unimplemented("COPY table_name TO ' filename ' WITH ( NULL ' null_string ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' WITH ( NULL ' null_string ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' WITH ( NULL ' null_string ' , HEADER )"),
@@ -7309,7 +7309,7 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name TO PROGRAM ' command ' WITH ( NULL ' null_string ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' WITH ( NULL ' null_string ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' WITH ( NULL ' null_string ' , HEADER )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( NULL ' null_string ' , HEADER )"),
+		Converts("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( NULL ' null_string ' , HEADER )"),
 		Unimplemented("COPY table_name TO ' filename ' ( HEADER , HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' ( HEADER , HEADER )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' ( HEADER , HEADER )"),
@@ -7351,9 +7351,9 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' ( QUOTE ' quote_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' ( QUOTE ' quote_character ' , HEADER )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' ( QUOTE ' quote_character ' , HEADER )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT ( QUOTE ' quote_character ' , HEADER )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT ( QUOTE ' quote_character ' , HEADER )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT ( QUOTE ' quote_character ' , HEADER )"),
+		Parses("COPY table_name ( column_name ) TO STDOUT ( QUOTE ' quote_character ' , HEADER )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT ( QUOTE ' quote_character ' , HEADER )"),
+		Parses("COPY ( SELECT 1 ) TO STDOUT ( QUOTE ' quote_character ' , HEADER )"),
 		Unimplemented("COPY table_name TO ' filename ' WITH ( QUOTE ' quote_character ' , HEADER )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' WITH ( QUOTE ' quote_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' WITH ( QUOTE ' quote_character ' , HEADER )"),
@@ -7362,9 +7362,9 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' ( ESCAPE ' escape_character ' , HEADER )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' ( ESCAPE ' escape_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' ( ESCAPE ' escape_character ' , HEADER )"),
-		Unimplemented("COPY table_name TO STDOUT ( ESCAPE ' escape_character ' , HEADER )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT ( ESCAPE ' escape_character ' , HEADER )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT ( ESCAPE ' escape_character ' , HEADER )"),
+		Parses("COPY table_name TO STDOUT ( ESCAPE ' escape_character ' , HEADER )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT ( ESCAPE ' escape_character ' , HEADER )"),
+		Parses("COPY ( SELECT 1 ) TO STDOUT ( ESCAPE ' escape_character ' , HEADER )"),
 		Unimplemented("COPY table_name TO ' filename ' WITH ( ESCAPE ' escape_character ' , HEADER )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' WITH ( ESCAPE ' escape_character ' , HEADER )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' WITH ( ESCAPE ' escape_character ' , HEADER )"),
@@ -7505,24 +7505,24 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' ( DELIMITER ' delimiter_character ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' ( DELIMITER ' delimiter_character ' , HEADER true )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' ( DELIMITER ' delimiter_character ' , HEADER true )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT ( DELIMITER ' delimiter_character ' , HEADER true )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT ( DELIMITER ' delimiter_character ' , HEADER true )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT ( DELIMITER ' delimiter_character ' , HEADER true )"),
+		Parses("COPY table_name ( column_name ) TO STDOUT ( DELIMITER ' delimiter_character ' , HEADER true )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT ( DELIMITER ' delimiter_character ' , HEADER true )"),
+		Parses("COPY ( SELECT 1 ) TO STDOUT ( DELIMITER ' delimiter_character ' , HEADER true )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' WITH ( DELIMITER ' delimiter_character ' , HEADER true )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' WITH ( DELIMITER ' delimiter_character ' , HEADER true )"),
-		Unimplemented("COPY table_name TO STDOUT WITH ( DELIMITER ' delimiter_character ' , HEADER true )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( DELIMITER ' delimiter_character ' , HEADER true )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT WITH ( DELIMITER ' delimiter_character ' , HEADER true )"),
+		Parses("COPY table_name TO STDOUT WITH ( DELIMITER ' delimiter_character ' , HEADER true )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( DELIMITER ' delimiter_character ' , HEADER true )"),
+		Parses("COPY ( SELECT 1 ) TO STDOUT WITH ( DELIMITER ' delimiter_character ' , HEADER true )"),
 		Unimplemented("COPY table_name TO ' filename ' ( NULL ' null_string ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' ( NULL ' null_string ' , HEADER true )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' ( NULL ' null_string ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' ( NULL ' null_string ' , HEADER true )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' ( NULL ' null_string ' , HEADER true )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT ( NULL ' null_string ' , HEADER true )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT ( NULL ' null_string ' , HEADER true )"),
+		Converts("COPY table_name ( column_name ) TO STDOUT ( NULL ' null_string ' , HEADER true )"),
+		Converts("COPY ( SELECT 1 ) TO STDOUT ( NULL ' null_string ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' WITH ( NULL ' null_string ' , HEADER true )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' WITH ( NULL ' null_string ' , HEADER true )"),
-		Unimplemented("COPY table_name TO STDOUT WITH ( NULL ' null_string ' , HEADER true )"),
+		Converts("COPY table_name TO STDOUT WITH ( NULL ' null_string ' , HEADER true )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' ( HEADER , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' ( HEADER , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' ( HEADER , HEADER true )"),
@@ -7562,7 +7562,7 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' ( QUOTE ' quote_character ' , HEADER true )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' ( QUOTE ' quote_character ' , HEADER true )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' ( QUOTE ' quote_character ' , HEADER true )"),
-		Unimplemented("COPY table_name TO STDOUT ( QUOTE ' quote_character ' , HEADER true )"),
+		Parses("COPY table_name TO STDOUT ( QUOTE ' quote_character ' , HEADER true )"),
 		Unimplemented("COPY table_name TO ' filename ' WITH ( QUOTE ' quote_character ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' WITH ( QUOTE ' quote_character ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' WITH ( QUOTE ' quote_character ' , HEADER true )"),
@@ -7570,23 +7570,23 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name TO PROGRAM ' command ' WITH ( QUOTE ' quote_character ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' WITH ( QUOTE ' quote_character ' , HEADER true )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' WITH ( QUOTE ' quote_character ' , HEADER true )"),
-		Unimplemented("COPY table_name TO STDOUT WITH ( QUOTE ' quote_character ' , HEADER true )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT WITH ( QUOTE ' quote_character ' , HEADER true )"),
+		Parses("COPY table_name TO STDOUT WITH ( QUOTE ' quote_character ' , HEADER true )"),
+		Parses("COPY ( SELECT 1 ) TO STDOUT WITH ( QUOTE ' quote_character ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' ( ESCAPE ' escape_character ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' ( ESCAPE ' escape_character ' , HEADER true )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' ( ESCAPE ' escape_character ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' ( ESCAPE ' escape_character ' , HEADER true )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' ( ESCAPE ' escape_character ' , HEADER true )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT ( ESCAPE ' escape_character ' , HEADER true )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT ( ESCAPE ' escape_character ' , HEADER true )"),
 		Unimplemented("COPY table_name TO ' filename ' WITH ( ESCAPE ' escape_character ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' WITH ( ESCAPE ' escape_character ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' WITH ( ESCAPE ' escape_character ' , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' WITH ( ESCAPE ' escape_character ' , HEADER true )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' WITH ( ESCAPE ' escape_character ' , HEADER true )"),
-		Unimplemented("COPY table_name TO STDOUT WITH ( ESCAPE ' escape_character ' , HEADER true )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT WITH ( ESCAPE ' escape_character ' , HEADER true )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( ESCAPE ' escape_character ' , HEADER true )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT WITH ( ESCAPE ' escape_character ' , HEADER true )"),
+		Parses("COPY table_name TO STDOUT WITH ( ESCAPE ' escape_character ' , HEADER true )"),
+		Parses("COPY table_name ( column_name ) TO STDOUT WITH ( ESCAPE ' escape_character ' , HEADER true )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( ESCAPE ' escape_character ' , HEADER true )"),
+		Parses("COPY ( SELECT 1 ) TO STDOUT WITH ( ESCAPE ' escape_character ' , HEADER true )"),
 		Unimplemented("COPY table_name TO ' filename ' ( FORCE_QUOTE ( column_name ) , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' ( FORCE_QUOTE ( column_name ) , HEADER true )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' ( FORCE_QUOTE ( column_name ) , HEADER true )"),
@@ -7950,8 +7950,8 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name ( column_name ) TO STDOUT ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name TO ' filename ' WITH ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' WITH ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' WITH ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
@@ -7959,36 +7959,36 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' WITH ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' WITH ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' WITH ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT WITH ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT WITH ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name ( column_name ) TO STDOUT WITH ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
+		Parses("COPY ( SELECT 1 ) TO STDOUT WITH ( DELIMITER ' delimiter_character ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' ( NULL ' null_string ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' ( NULL ' null_string ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' ( NULL ' null_string ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' ( NULL ' null_string ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' ( NULL ' null_string ' , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT ( NULL ' null_string ' , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT ( NULL ' null_string ' , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name ( column_name ) TO STDOUT ( NULL ' null_string ' , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT ( NULL ' null_string ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' WITH ( NULL ' null_string ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' WITH ( NULL ' null_string ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' WITH ( NULL ' null_string ' , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( NULL ' null_string ' , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( NULL ' null_string ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' ( HEADER , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' ( HEADER , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name TO STDOUT ( HEADER , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT ( HEADER , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name TO STDOUT ( HEADER , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT ( HEADER , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' WITH ( HEADER , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' WITH ( HEADER , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' WITH ( HEADER , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' WITH ( HEADER , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name TO STDOUT WITH ( HEADER , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name TO STDOUT WITH ( HEADER , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name TO ' filename ' ( HEADER true , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' ( HEADER true , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' ( HEADER true , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' ( HEADER true , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' ( HEADER true , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name TO STDOUT ( HEADER true , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT ( HEADER true , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT ( HEADER true , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name TO STDOUT ( HEADER true , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name ( column_name ) TO STDOUT ( HEADER true , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT ( HEADER true , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name TO ' filename ' WITH ( HEADER true , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' WITH ( HEADER true , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' WITH ( HEADER true , QUOTE ' quote_character ' )"),
@@ -8024,7 +8024,7 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' WITH ( ESCAPE ' escape_character ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' WITH ( ESCAPE ' escape_character ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' WITH ( ESCAPE ' escape_character ' , QUOTE ' quote_character ' )"),
-		Unimplemented("COPY table_name TO STDOUT WITH ( ESCAPE ' escape_character ' , QUOTE ' quote_character ' )"),
+		Parses("COPY table_name TO STDOUT WITH ( ESCAPE ' escape_character ' , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' ( FORCE_QUOTE ( column_name ) , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' ( FORCE_QUOTE ( column_name ) , QUOTE ' quote_character ' )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' ( FORCE_QUOTE ( column_name ) , QUOTE ' quote_character ' )"),
@@ -8146,50 +8146,50 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' ( DELIMITER ' delimiter_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' ( DELIMITER ' delimiter_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' ( DELIMITER ' delimiter_character ' , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT ( DELIMITER ' delimiter_character ' , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT ( DELIMITER ' delimiter_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' WITH ( DELIMITER ' delimiter_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' WITH ( DELIMITER ' delimiter_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' WITH ( DELIMITER ' delimiter_character ' , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name TO STDOUT WITH ( DELIMITER ' delimiter_character ' , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( DELIMITER ' delimiter_character ' , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT WITH ( DELIMITER ' delimiter_character ' , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name TO STDOUT WITH ( DELIMITER ' delimiter_character ' , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( DELIMITER ' delimiter_character ' , ESCAPE ' escape_character ' )"),
+		Parses("COPY ( SELECT 1 ) TO STDOUT WITH ( DELIMITER ' delimiter_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name TO STDOUT ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name TO STDOUT ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name ) TO STDOUT ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name TO ' filename ' WITH ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' WITH ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' WITH ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' WITH ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' WITH ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name TO STDOUT WITH ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name TO STDOUT WITH ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( NULL ' null_string ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' ( HEADER , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' ( HEADER , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' ( HEADER , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' ( HEADER , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name TO STDOUT ( HEADER , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT ( HEADER , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT ( HEADER , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name TO STDOUT ( HEADER , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name ) TO STDOUT ( HEADER , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT ( HEADER , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name TO ' filename ' WITH ( HEADER , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' WITH ( HEADER , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' WITH ( HEADER , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' WITH ( HEADER , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' WITH ( HEADER , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name TO STDOUT WITH ( HEADER , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name TO STDOUT WITH ( HEADER , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name TO ' filename ' ( HEADER true , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' ( HEADER true , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' ( HEADER true , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT ( HEADER true , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name ) TO STDOUT ( HEADER true , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name TO ' filename ' WITH ( HEADER true , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' WITH ( HEADER true , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' WITH ( HEADER true , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO PROGRAM ' command ' WITH ( HEADER true , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO PROGRAM ' command ' WITH ( HEADER true , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name TO STDOUT WITH ( HEADER true , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT WITH ( HEADER true , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( HEADER true , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name TO STDOUT WITH ( HEADER true , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name ) TO STDOUT WITH ( HEADER true , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( HEADER true , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' ( HEADER MATCH , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' ( HEADER MATCH , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' ( HEADER MATCH , ESCAPE ' escape_character ' )"),
@@ -8208,14 +8208,14 @@ func TestCopy(t *testing.T) {
 		Unimplemented("COPY table_name TO ' filename ' ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name TO PROGRAM ' command ' ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO PROGRAM ' command ' ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY ( SELECT 1 ) TO STDOUT ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name ) TO STDOUT ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
+		Parses("COPY ( SELECT 1 ) TO STDOUT ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name TO ' filename ' WITH ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' WITH ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name , column_name ) TO ' filename ' WITH ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' WITH ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name ) TO STDOUT WITH ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
-		Unimplemented("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name ) TO STDOUT WITH ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
+		Parses("COPY table_name ( column_name , column_name ) TO STDOUT WITH ( QUOTE ' quote_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name TO ' filename ' ( ESCAPE ' escape_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY table_name ( column_name ) TO ' filename ' ( ESCAPE ' escape_character ' , ESCAPE ' escape_character ' )"),
 		Unimplemented("COPY ( SELECT 1 ) TO ' filename ' ( ESCAPE ' escape_character ' , ESCAPE ' escape_character ' )"),
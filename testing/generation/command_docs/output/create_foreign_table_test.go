@@ -20,19 +20,19 @@ func TestCreateForeignTable(t *testing.T) {
 	tests := []QueryParses{
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CHECK ( expression ) NO INHERIT NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name NULL NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) NULL ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) NULL ) SERVER server_name"),
+		Converts("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) NULL ) SERVER server_name"),
+		Converts("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type COLLATE en_US CHECK ( expression ) ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CHECK ( expression ) NO INHERIT NOT NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type CHECK ( expression ) NO INHERIT ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name"),
+		Converts("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US DEFAULT default_expr ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US DEFAULT default_expr ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US DEFAULT default_expr NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL NOT NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US NULL CONSTRAINT constraint_name NULL , column_name data_type COLLATE en_US NULL NOT NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NULL NOT NULL ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NO INHERIT NOT NULL , column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NOT NULL ) SERVER server_name"),
+		Converts("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NO INHERIT NOT NULL , column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NOT NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NULL CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CHECK ( expression ) NOT NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US DEFAULT default_expr CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CHECK ( expression ) NOT NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US NULL CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NOT NULL ) SERVER server_name"),
@@ -41,12 +41,12 @@ func TestCreateForeignTable(t *testing.T) {
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NULL NOT NULL , column_name data_type CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT NOT NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr NOT NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED NOT NULL ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NOT NULL GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED NOT NULL ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) NULL CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED NOT NULL ) SERVER server_name"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NOT NULL GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED NOT NULL ) SERVER server_name"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) NULL CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED NOT NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US NULL CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US NOT NULL CONSTRAINT constraint_name NOT NULL ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name NOT NULL ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name NULL , column_name data_type CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NOT NULL ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NOT NULL ) SERVER server_name"),
+		Converts("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name NOT NULL ) SERVER server_name"),
+		Converts("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name NULL , column_name data_type CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NOT NULL ) SERVER server_name"),
+		Converts("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NOT NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NOT NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US NOT NULL GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NOT NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US DEFAULT default_expr CONSTRAINT constraint_name NOT NULL ) SERVER server_name"),
@@ -61,12 +61,12 @@ func TestCreateForeignTable(t *testing.T) {
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NULL ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED NOT NULL , column_name data_type CHECK ( expression ) NO INHERIT NULL ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type DEFAULT default_expr CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) NO INHERIT NULL ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) NOT NULL GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) NO INHERIT NULL ) SERVER server_name"),
+		Converts("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED NOT NULL , column_name data_type CHECK ( expression ) NO INHERIT NULL ) SERVER server_name"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type DEFAULT default_expr CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) NO INHERIT NULL ) SERVER server_name"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) NOT NULL GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) NO INHERIT NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NOT NULL NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT NULL ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT NULL ) SERVER server_name"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US NOT NULL CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US DEFAULT default_expr NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr NULL ) SERVER server_name"),
@@ -74,23 +74,23 @@ func TestCreateForeignTable(t *testing.T) {
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED NOT NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' ) NOT NULL CONSTRAINT constraint_name NULL ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NULL CONSTRAINT constraint_name NULL ) SERVER server_name"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NULL CONSTRAINT constraint_name NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US NULL CONSTRAINT constraint_name NULL ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NOT NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type CONSTRAINT constraint_name NULL CONSTRAINT constraint_name NULL ) SERVER server_name"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NOT NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type CONSTRAINT constraint_name NULL CONSTRAINT constraint_name NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) DEFAULT default_expr CONSTRAINT constraint_name NULL , column_name data_type COLLATE en_US CHECK ( expression ) CONSTRAINT constraint_name NULL ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name NULL ) SERVER server_name"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL CHECK ( expression ) , column_name data_type COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name NULL ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) DEFAULT default_expr , column_name data_type CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NULL ) SERVER server_name"),
+		Converts("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) DEFAULT default_expr , column_name data_type CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT NOT NULL , column_name data_type DEFAULT default_expr CONSTRAINT constraint_name NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US DEFAULT default_expr CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NULL , column_name data_type COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NULL ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' ) NOT NULL CHECK ( expression ) ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NOT NULL CHECK ( expression ) ) SERVER server_name"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NOT NULL CHECK ( expression ) ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type COLLATE en_US NOT NULL CHECK ( expression ) ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US NOT NULL CHECK ( expression ) ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name NOT NULL CHECK ( expression ) ) SERVER server_name"),
+		Converts("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name NOT NULL CHECK ( expression ) ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL CHECK ( expression ) ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type NULL CHECK ( expression ) ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US NOT NULL NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NULL CHECK ( expression ) ) SERVER server_name"),
@@ -102,33 +102,33 @@ func TestCreateForeignTable(t *testing.T) {
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CHECK ( expression ) ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US DEFAULT default_expr NOT NULL , column_name data_type COLLATE en_US DEFAULT default_expr CHECK ( expression ) ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CHECK ( expression ) NO INHERIT DEFAULT default_expr , column_name data_type COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr CHECK ( expression ) ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) ) SERVER server_name"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) NO INHERIT CHECK ( expression ) , column_name data_type COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US NOT NULL CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name"),
+		Converts("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CHECK ( expression ) NO INHERIT GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US DEFAULT default_expr NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US NOT NULL CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NULL CHECK ( expression ) NO INHERIT , column_name data_type COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type NOT NULL CHECK ( expression ) NO INHERIT ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NOT NULL CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' ) NOT NULL CHECK ( expression ) NO INHERIT ) SERVER server_name"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type NOT NULL CHECK ( expression ) NO INHERIT ) SERVER server_name"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NOT NULL CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' ) NOT NULL CHECK ( expression ) NO INHERIT ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NOT NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type COLLATE en_US NOT NULL CHECK ( expression ) NO INHERIT ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NULL GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US NOT NULL CHECK ( expression ) NO INHERIT ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL CHECK ( expression ) NO INHERIT ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) DEFAULT default_expr CONSTRAINT constraint_name NULL , column_name data_type COLLATE en_US CONSTRAINT constraint_name NULL CHECK ( expression ) NO INHERIT ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US DEFAULT default_expr CHECK ( expression ) NO INHERIT ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) NO INHERIT ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) NO INHERIT ) SERVER server_name"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) NO INHERIT ) SERVER server_name"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) NO INHERIT ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT NOT NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) NO INHERIT ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) NULL CHECK ( expression ) NO INHERIT , column_name data_type CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) NO INHERIT ) SERVER server_name"),
+		Converts("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) NULL CHECK ( expression ) NO INHERIT , column_name data_type CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) NO INHERIT ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) CHECK ( expression ) NO INHERIT , column_name data_type COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) NO INHERIT ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CHECK ( expression ) NO INHERIT NOT NULL , column_name data_type NOT NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) NOT NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name"),
+		Converts("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CHECK ( expression ) NO INHERIT NOT NULL , column_name data_type NOT NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name"),
+		Converts("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) NOT NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type NOT NULL GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type COLLATE en_US NOT NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) NULL CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US NOT NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name"),
@@ -136,25 +136,25 @@ func TestCreateForeignTable(t *testing.T) {
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US CHECK ( expression ) CHECK ( expression ) , column_name data_type COLLATE en_US NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) DEFAULT default_expr CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name"),
+		Converts("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) DEFAULT default_expr CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name"),
+		Converts("CREATE FOREIGN TABLE table_name ( column_name data_type GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US DEFAULT default_expr CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NULL DEFAULT default_expr ) SERVER server_name"),
+		Converts("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NULL DEFAULT default_expr ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US NOT NULL CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type COLLATE en_US CONSTRAINT constraint_name NULL DEFAULT default_expr ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT DEFAULT default_expr , column_name data_type COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) DEFAULT default_expr ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name NULL DEFAULT default_expr , column_name data_type COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT DEFAULT default_expr ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name NOT NULL GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr DEFAULT default_expr ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED DEFAULT default_expr ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' ) NOT NULL CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NULL CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NULL CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CHECK ( expression ) DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name NULL CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NULL , column_name data_type CHECK ( expression ) CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) , column_name data_type COLLATE en_US CHECK ( expression ) CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CHECK ( expression ) CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL DEFAULT default_expr , column_name data_type COLLATE en_US CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name NULL CONSTRAINT constraint_name NULL , column_name data_type COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name"),
@@ -164,17 +164,17 @@ func TestCreateForeignTable(t *testing.T) {
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US NOT NULL CHECK ( expression ) NO INHERIT , column_name data_type NOT NULL GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NOT NULL GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US NOT NULL DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CHECK ( expression ) GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type GENERATED ALWAYS AS ( generation_expr ) STORED DEFAULT default_expr , column_name data_type CONSTRAINT constraint_name CHECK ( expression ) GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type NOT NULL NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name"),
+		Converts("CREATE FOREIGN TABLE table_name ( column_name data_type GENERATED ALWAYS AS ( generation_expr ) STORED DEFAULT default_expr , column_name data_type CONSTRAINT constraint_name CHECK ( expression ) GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type NOT NULL NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type COLLATE en_US NOT NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US DEFAULT default_expr NULL , column_name data_type NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) NOT NULL NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) NOT NULL NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' ) DEFAULT default_expr CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT DEFAULT default_expr , column_name data_type CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name CHECK ( expression ) GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name"),
+		Converts("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name CHECK ( expression ) GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CHECK ( expression ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US DEFAULT default_expr CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name"),
@@ -528,67 +528,67 @@ func TestCreateForeignTable(t *testing.T) {
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US NOT NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type DEFAULT default_expr CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name NOT NULL CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type COLLATE en_US DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US CHECK ( expression ) NO INHERIT , column_name data_type CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US CHECK ( expression ) NO INHERIT CHECK ( expression ) , column_name data_type CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US NULL CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type COLLATE en_US CONSTRAINT constraint_name NOT NULL NOT NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US NULL NOT NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) , column_name data_type COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr NOT NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' ) NOT NULL CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Converts("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' ) NOT NULL CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type NULL DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name CHECK ( expression ) CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NULL CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NOT NULL NOT NULL , column_name data_type CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NOT NULL NOT NULL , column_name data_type CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US NULL CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US NOT NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type DEFAULT default_expr CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name CHECK ( expression ) GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name CHECK ( expression ) GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US NOT NULL NULL , column_name data_type OPTIONS ( option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NOT NULL NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US CHECK ( expression ) NO INHERIT NULL , column_name data_type COLLATE en_US NOT NULL NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type COLLATE en_US NOT NULL NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CHECK ( expression ) CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US NULL NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type CONSTRAINT constraint_name NULL NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type CONSTRAINT constraint_name NULL NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NULL NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name NOT NULL DEFAULT default_expr , column_name data_type CONSTRAINT constraint_name CHECK ( expression ) NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) NOT NULL CHECK ( expression ) NO INHERIT , column_name data_type CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Converts("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name NOT NULL DEFAULT default_expr , column_name data_type CONSTRAINT constraint_name CHECK ( expression ) NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Converts("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) NOT NULL CHECK ( expression ) NO INHERIT , column_name data_type CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NULL , column_name data_type COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr CHECK ( expression ) , column_name data_type DEFAULT default_expr NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Converts("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr CHECK ( expression ) , column_name data_type DEFAULT default_expr NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US DEFAULT default_expr NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type CONSTRAINT constraint_name DEFAULT default_expr NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type CONSTRAINT constraint_name DEFAULT default_expr NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NULL CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NULL CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name NOT NULL , column_name data_type COLLATE en_US DEFAULT default_expr CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US NULL CHECK ( expression ) NO INHERIT , column_name data_type COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US DEFAULT default_expr NULL , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CHECK ( expression ) NO INHERIT , column_name data_type NOT NULL CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NULL CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type CONSTRAINT constraint_name NOT NULL CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Converts("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CHECK ( expression ) NO INHERIT , column_name data_type NOT NULL CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NULL CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type CONSTRAINT constraint_name NOT NULL CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) NOT NULL NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NULL , column_name data_type COLLATE en_US NULL CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NOT NULL DEFAULT default_expr , column_name data_type COLLATE en_US NULL CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) NOT NULL NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US NULL CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name NOT NULL NOT NULL , column_name data_type CONSTRAINT constraint_name CHECK ( expression ) CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NO INHERIT CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Converts("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name NOT NULL NOT NULL , column_name data_type CONSTRAINT constraint_name CHECK ( expression ) CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NO INHERIT CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name NOT NULL CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name NOT NULL CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CHECK ( expression ) NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' )"),
@@ -609,13 +609,13 @@ func TestCreateForeignTable(t *testing.T) {
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NOT NULL CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NULL CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type COLLATE en_US CONSTRAINT constraint_name NOT NULL CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NULL , column_name data_type COLLATE en_US CHECK ( expression ) CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type CONSTRAINT constraint_name CHECK ( expression ) CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type CONSTRAINT constraint_name CHECK ( expression ) CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US DEFAULT default_expr NULL , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NO INHERIT CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US NOT NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr CHECK ( expression ) , column_name data_type DEFAULT default_expr CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED DEFAULT default_expr , column_name data_type DEFAULT default_expr CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Converts("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED DEFAULT default_expr , column_name data_type DEFAULT default_expr CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US NULL CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type COLLATE en_US DEFAULT default_expr CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US DEFAULT default_expr CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
@@ -623,13 +623,13 @@ func TestCreateForeignTable(t *testing.T) {
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) NO INHERIT , column_name data_type CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) NO INHERIT , column_name data_type CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name CHECK ( expression ) CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NULL CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US NULL CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' ) DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type COLLATE en_US DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED NOT NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
@@ -637,9 +637,9 @@ func TestCreateForeignTable(t *testing.T) {
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CHECK ( expression ) CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US CHECK ( expression ) CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) NOT NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Converts("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) NOT NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US DEFAULT default_expr CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type NOT NULL DEFAULT default_expr , column_name data_type GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Converts("CREATE FOREIGN TABLE table_name ( column_name data_type NOT NULL DEFAULT default_expr , column_name data_type GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT DEFAULT default_expr , column_name data_type NOT NULL DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) NOT NULL DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
@@ -647,27 +647,27 @@ func TestCreateForeignTable(t *testing.T) {
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US DEFAULT default_expr CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) NULL DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US NULL DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name NULL CONSTRAINT constraint_name NOT NULL , column_name data_type COLLATE en_US CHECK ( expression ) NO INHERIT DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US NOT NULL CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US DEFAULT default_expr DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US CHECK ( expression ) CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US NOT NULL DEFAULT default_expr , column_name data_type COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr NULL , column_name data_type CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr NULL , column_name data_type CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US NULL DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NULL CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED NOT NULL , column_name data_type COLLATE en_US NULL CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US CHECK ( expression ) CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NULL CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NULL CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NULL CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CHECK ( expression ) CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US NULL CONSTRAINT constraint_name NULL , column_name data_type COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' )"),
@@ -686,19 +686,19 @@ func TestCreateForeignTable(t *testing.T) {
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type DEFAULT default_expr GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CHECK ( expression ) DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US DEFAULT default_expr GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US NOT NULL CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' ) NOT NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US CHECK ( expression ) CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CHECK ( expression ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US NOT NULL GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name NOT NULL , column_name data_type COLLATE en_US CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US DEFAULT default_expr CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NOT NULL GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NOT NULL GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NOT NULL CONSTRAINT constraint_name NULL , column_name data_type DEFAULT default_expr CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' ) DEFAULT default_expr CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' )"),
+		Converts("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' ) DEFAULT default_expr CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CHECK ( expression ) NO INHERIT , CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name NOT NULL NULL , column_name data_type ) INHERITS ( parent_table ) SERVER server_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US NOT NULL NOT NULL , column_name data_type COLLATE en_US ) INHERITS ( parent_table ) SERVER server_name OPTIONS ( option ' value ' )"),
@@ -1040,7 +1040,7 @@ func TestCreateForeignTable(t *testing.T) {
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CHECK ( expression ) NO INHERIT CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) NO INHERIT NOT NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CHECK ( expression ) DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) DEFAULT default_expr CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NOT NULL NOT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type COLLATE en_US NOT NULL NOT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
@@ -1050,18 +1050,18 @@ func TestCreateForeignTable(t *testing.T) {
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NOT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NOT NULL , column_name data_type COLLATE en_US CHECK ( expression ) NO INHERIT NOT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CHECK ( expression ) NO INHERIT NOT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name NULL DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr NOT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name NULL DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr NOT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr NOT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US NOT NULL CONSTRAINT constraint_name NULL , column_name data_type COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED NOT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED NOT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type NULL CHECK ( expression ) , column_name data_type NOT NULL CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type NULL CHECK ( expression ) , column_name data_type NOT NULL CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NOT NULL CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CHECK ( expression ) GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type COLLATE en_US CONSTRAINT constraint_name NULL CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type DEFAULT default_expr , column_name data_type COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NULL , column_name data_type CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name NULL CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name NULL CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type COLLATE en_US DEFAULT default_expr CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name NOT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type NOT NULL NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NULL , column_name data_type COLLATE en_US CONSTRAINT constraint_name NOT NULL NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
@@ -1069,33 +1069,33 @@ func TestCreateForeignTable(t *testing.T) {
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr NOT NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CHECK ( expression ) NO INHERIT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CHECK ( expression ) NO INHERIT GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CHECK ( expression ) NO INHERIT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US DEFAULT default_expr NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US CHECK ( expression ) NO INHERIT NOT NULL , column_name data_type CONSTRAINT constraint_name DEFAULT default_expr NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name DEFAULT default_expr NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name NULL CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name NULL CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name NULL GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NO INHERIT CHECK ( expression ) NO INHERIT , column_name data_type NOT NULL CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NOT NULL CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NOT NULL CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) DEFAULT default_expr CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name NULL CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) DEFAULT default_expr CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name NULL CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NULL CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US CHECK ( expression ) , column_name data_type CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NULL CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CHECK ( expression ) NO INHERIT , column_name data_type GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CHECK ( expression ) NO INHERIT , column_name data_type GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NOT NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) NOT NULL CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) DEFAULT default_expr GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name NOT NULL CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NOT NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NULL ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) NOT NULL CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) DEFAULT default_expr GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name NOT NULL CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US DEFAULT default_expr CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US NULL CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CHECK ( expression ) CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US NULL CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr , column_name data_type CONSTRAINT constraint_name NULL CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr , column_name data_type CONSTRAINT constraint_name NULL CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CHECK ( expression ) DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) NULL CONSTRAINT constraint_name NOT NULL , column_name data_type COLLATE en_US CHECK ( expression ) CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type COLLATE en_US CHECK ( expression ) CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
@@ -1103,18 +1103,18 @@ func TestCreateForeignTable(t *testing.T) {
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US DEFAULT default_expr CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) NULL NOT NULL , column_name data_type GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type GENERATED ALWAYS AS ( generation_expr ) STORED DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type GENERATED ALWAYS AS ( generation_expr ) STORED DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NOT NULL CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type COLLATE en_US NOT NULL CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US NULL CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NULL , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NULL , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NOT NULL GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name DEFAULT default_expr GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type CONSTRAINT constraint_name DEFAULT default_expr GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NULL , column_name data_type COLLATE en_US CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name NULL DEFAULT default_expr , column_name data_type COLLATE en_US CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) NULL NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) NULL NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) NULL DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NULL NOT NULL , column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CHECK ( expression ) DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
@@ -1122,26 +1122,26 @@ func TestCreateForeignTable(t *testing.T) {
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US NOT NULL CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NOT NULL , column_name data_type NOT NULL CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NULL CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NOT NULL , column_name data_type NOT NULL CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NULL CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type NOT NULL CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NULL CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NULL , column_name data_type COLLATE en_US CHECK ( expression ) CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US NULL GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type CONSTRAINT constraint_name CHECK ( expression ) CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' ) CHECK ( expression ) NO INHERIT CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) NO INHERIT CHECK ( expression ) NO INHERIT , column_name data_type GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) NO INHERIT CHECK ( expression ) NO INHERIT , column_name data_type GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US NULL NOT NULL , column_name data_type COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type DEFAULT default_expr CONSTRAINT constraint_name NOT NULL , column_name data_type NOT NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type DEFAULT default_expr CONSTRAINT constraint_name NOT NULL , column_name data_type NOT NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NOT NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US DEFAULT default_expr GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT , column_name data_type CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' ) GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NULL CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NOT NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NOT NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr CONSTRAINT constraint_name DEFAULT default_expr , column_name data_type CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NULL CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
@@ -1150,9 +1150,9 @@ func TestCreateForeignTable(t *testing.T) {
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US NULL DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type DEFAULT default_expr NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name NULL DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CHECK ( expression ) DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US CHECK ( expression ) CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US DEFAULT default_expr CONSTRAINT constraint_name NULL , column_name data_type OPTIONS ( option ' value ' ) DEFAULT default_expr DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) DEFAULT default_expr DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US NULL CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) DEFAULT default_expr DEFAULT default_expr ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
@@ -1186,14 +1186,14 @@ func TestCreateForeignTable(t *testing.T) {
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US NOT NULL CONSTRAINT constraint_name NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NOT NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NULL CONSTRAINT constraint_name NOT NULL , column_name data_type NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED NULL , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED NULL , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name NULL CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type COLLATE en_US CHECK ( expression ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type DEFAULT default_expr NOT NULL , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) COLLATE en_US CHECK ( expression ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type DEFAULT default_expr CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name CHECK ( expression ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type COLLATE en_US CONSTRAINT constraint_name CHECK ( expression ) NO INHERIT CHECK ( expression ) NO INHERIT , column_name data_type COLLATE en_US CONSTRAINT constraint_name DEFAULT default_expr CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NOT NULL , column_name data_type CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NOT NULL CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CHECK ( expression ) NO INHERIT CONSTRAINT constraint_name NOT NULL , column_name data_type CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NOT NULL CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' ) CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE table_name ( column_name data_type OPTIONS ( option ' value ' , option ' value ' ) CONSTRAINT constraint_name NULL CONSTRAINT constraint_name CHECK ( expression ) , column_name data_type OPTIONS ( option ' value ' ) COLLATE en_US CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED CONSTRAINT constraint_name GENERATED ALWAYS AS ( generation_expr ) STORED ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type CONSTRAINT constraint_name NOT NULL CHECK ( expression ) NO INHERIT , column_name data_type OPTIONS ( option ' value ' , option ' value ' ) ) INHERITS ( parent_table ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE FOREIGN TABLE IF NOT EXISTS table_name ( column_name data_type COLLATE en_US DEFAULT default_expr , column_name data_type COLLATE en_US ) INHERITS ( parent_table ) SERVER server_name OPTIONS ( option ' value ' , option ' value ' )"),
@@ -3400,7 +3400,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , argname FLOAT8 ) RETURNS rettype PARALLEL UNSAFE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 , OUT FLOAT8 ) RETURNS TABLE ( column_name column_type ) PARALLEL UNSAFE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 = default_expr , INOUT argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) PARALLEL UNSAFE BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) PARALLEL RESTRICTED BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) PARALLEL RESTRICTED BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 , IN argname FLOAT8 = default_expr ) RETURNS rettype PARALLEL RESTRICTED BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) PARALLEL RESTRICTED BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) PARALLEL RESTRICTED BEGIN ATOMIC END"),
@@ -3608,7 +3608,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) RETURNS SETOF rettype NOT LEAKPROOF TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 , argname FLOAT8 ) RETURNS SETOF rettype NOT LEAKPROOF TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , OUT FLOAT8 ) RETURNS TABLE ( column_name column_type ) NOT LEAKPROOF TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) CALLED ON NULL INPUT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) CALLED ON NULL INPUT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 , FLOAT8 DEFAULT default_expr ) RETURNS rettype CALLED ON NULL INPUT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 , IN FLOAT8 ) RETURNS TABLE ( column_name column_type ) CALLED ON NULL INPUT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) CALLED ON NULL INPUT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
@@ -3626,7 +3626,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) STRICT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) STRICT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) STRICT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( OUT FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( OUT FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 , VARIADIC argname FLOAT8 ) RETURNS SETOF rettype SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) RETURNS SETOF rettype SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 , FLOAT8 ) RETURNS TABLE ( column_name column_type ) SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
@@ -3659,7 +3659,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) PARALLEL RESTRICTED TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) PARALLEL SAFE TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) PARALLEL SAFE TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) PARALLEL SAFE TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) PARALLEL SAFE TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) PARALLEL SAFE TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) RETURNS rettype PARALLEL SAFE TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) RETURNS SETOF rettype PARALLEL SAFE TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
@@ -3690,7 +3690,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 = default_expr , IN FLOAT8 ) RETURNS SETOF rettype SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 , OUT argname FLOAT8 = default_expr ) RETURNS SETOF rettype SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) RETURNS SETOF rettype SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , argname FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , argname FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS rettype AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
@@ -3756,7 +3756,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 DEFAULT default_expr , FLOAT8 ) RETURNS TABLE ( column_name column_type ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 = default_expr , argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( INOUT FLOAT8 , IN FLOAT8 ) PARALLEL UNSAFE TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( INOUT FLOAT8 , IN FLOAT8 ) PARALLEL UNSAFE TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) RETURNS rettype PARALLEL UNSAFE TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( FLOAT8 , INOUT FLOAT8 ) RETURNS SETOF rettype PARALLEL UNSAFE TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) PARALLEL UNSAFE TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
@@ -3866,7 +3866,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SECURITY DEFINER WINDOW BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SECURITY DEFINER WINDOW BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER WINDOW BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER WINDOW BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER WINDOW BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) RETURNS rettype EXTERNAL SECURITY DEFINER WINDOW BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) EXTERNAL SECURITY DEFINER WINDOW BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) EXTERNAL SECURITY DEFINER WINDOW BEGIN ATOMIC END"),
@@ -3934,9 +3934,9 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) STABLE IMMUTABLE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype VOLATILE IMMUTABLE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype VOLATILE IMMUTABLE BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( IN FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) LEAKPROOF IMMUTABLE BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) LEAKPROOF IMMUTABLE BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( INOUT FLOAT8 = default_expr , IN FLOAT8 = default_expr ) LEAKPROOF IMMUTABLE BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( IN FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) LEAKPROOF IMMUTABLE BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) LEAKPROOF IMMUTABLE BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( INOUT FLOAT8 = default_expr , IN FLOAT8 = default_expr ) LEAKPROOF IMMUTABLE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) LEAKPROOF IMMUTABLE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) LEAKPROOF IMMUTABLE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) RETURNS SETOF rettype NOT LEAKPROOF IMMUTABLE BEGIN ATOMIC END"),
@@ -3950,7 +3950,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( FLOAT8 = default_expr , IN argname FLOAT8 ) RETURNS SETOF rettype RETURNS NULL ON NULL INPUT IMMUTABLE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 , INOUT FLOAT8 ) RETURNS TABLE ( column_name column_type ) RETURNS NULL ON NULL INPUT IMMUTABLE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) RETURNS NULL ON NULL INPUT IMMUTABLE BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( OUT FLOAT8 , IN argname FLOAT8 ) STRICT IMMUTABLE BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( OUT FLOAT8 , IN argname FLOAT8 ) STRICT IMMUTABLE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) STRICT IMMUTABLE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) STRICT IMMUTABLE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SECURITY INVOKER IMMUTABLE BEGIN ATOMIC END"),
@@ -4010,7 +4010,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) SET configuration_parameter FROM CURRENT IMMUTABLE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 , OUT argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) SET configuration_parameter FROM CURRENT IMMUTABLE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SET configuration_parameter FROM CURRENT IMMUTABLE BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , FLOAT8 ) AS ' definition ' IMMUTABLE BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , FLOAT8 ) AS ' definition ' IMMUTABLE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) AS ' definition ' IMMUTABLE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 = default_expr , IN FLOAT8 = default_expr ) RETURNS rettype AS ' definition ' IMMUTABLE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 , INOUT FLOAT8 = default_expr ) RETURNS rettype AS ' definition ' IMMUTABLE BEGIN ATOMIC END"),
@@ -4107,7 +4107,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SUPPORT support_function STABLE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SUPPORT support_function STABLE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SUPPORT support_function STABLE BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , FLOAT8 ) SET configuration_parameter TO value STABLE BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , FLOAT8 ) SET configuration_parameter TO value STABLE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 = default_expr , FLOAT8 ) RETURNS rettype SET configuration_parameter TO value STABLE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS SETOF rettype SET configuration_parameter TO value STABLE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 = default_expr , argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) SET configuration_parameter TO value STABLE BEGIN ATOMIC END"),
@@ -4118,7 +4118,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 = default_expr , IN FLOAT8 ) SET configuration_parameter FROM CURRENT STABLE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT STABLE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 , VARIADIC FLOAT8 ) RETURNS SETOF rettype SET configuration_parameter FROM CURRENT STABLE BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) AS ' definition ' STABLE BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) AS ' definition ' STABLE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 , FLOAT8 ) RETURNS rettype AS ' definition ' STABLE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) RETURNS SETOF rettype AS ' definition ' STABLE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) AS ' definition ' STABLE BEGIN ATOMIC END"),
@@ -4135,7 +4135,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) RETURNS rettype LANGUAGE lang_name VOLATILE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) RETURNS rettype LANGUAGE lang_name VOLATILE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) RETURNS rettype LANGUAGE lang_name VOLATILE BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name VOLATILE BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name VOLATILE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) RETURNS rettype TRANSFORM FOR TYPE type_name VOLATILE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 , argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) TRANSFORM FOR TYPE type_name VOLATILE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) RETURNS rettype TRANSFORM FOR TYPE type_name , FOR TYPE type_name VOLATILE BEGIN ATOMIC END"),
@@ -4160,7 +4160,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) STABLE VOLATILE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 , IN argname FLOAT8 = default_expr ) VOLATILE VOLATILE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype VOLATILE VOLATILE BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) LEAKPROOF VOLATILE BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) LEAKPROOF VOLATILE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , IN FLOAT8 ) RETURNS rettype LEAKPROOF VOLATILE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) LEAKPROOF VOLATILE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) LEAKPROOF VOLATILE BEGIN ATOMIC END"),
@@ -4171,7 +4171,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 = default_expr , IN argname FLOAT8 ) RETURNS SETOF rettype NOT LEAKPROOF VOLATILE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) NOT LEAKPROOF VOLATILE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) CALLED ON NULL INPUT VOLATILE BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( INOUT FLOAT8 , FLOAT8 DEFAULT default_expr ) CALLED ON NULL INPUT VOLATILE BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( INOUT FLOAT8 , FLOAT8 DEFAULT default_expr ) CALLED ON NULL INPUT VOLATILE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) CALLED ON NULL INPUT VOLATILE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 , IN argname FLOAT8 ) RETURNS rettype RETURNS NULL ON NULL INPUT VOLATILE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) RETURNS rettype RETURNS NULL ON NULL INPUT VOLATILE BEGIN ATOMIC END"),
@@ -4317,7 +4317,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) PARALLEL RESTRICTED LEAKPROOF BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) PARALLEL RESTRICTED LEAKPROOF BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) PARALLEL RESTRICTED LEAKPROOF BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , INOUT argname FLOAT8 ) PARALLEL SAFE LEAKPROOF BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , INOUT argname FLOAT8 ) PARALLEL SAFE LEAKPROOF BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) PARALLEL SAFE LEAKPROOF BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) PARALLEL SAFE LEAKPROOF BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 ) COST 10 LEAKPROOF BEGIN ATOMIC END"),
@@ -4328,7 +4328,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 ) RETURNS SETOF rettype SUPPORT support_function LEAKPROOF BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 = default_expr , OUT FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) SUPPORT support_function LEAKPROOF BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value LEAKPROOF BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter TO value LEAKPROOF BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( OUT argname FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter TO value LEAKPROOF BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) SET configuration_parameter TO value LEAKPROOF BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SET configuration_parameter TO value LEAKPROOF BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SET configuration_parameter TO value LEAKPROOF BEGIN ATOMIC END"),
@@ -4369,7 +4369,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , IN FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) WINDOW NOT LEAKPROOF BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) WINDOW NOT LEAKPROOF BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , OUT FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) WINDOW NOT LEAKPROOF BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) IMMUTABLE NOT LEAKPROOF BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) IMMUTABLE NOT LEAKPROOF BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) RETURNS rettype IMMUTABLE NOT LEAKPROOF BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) RETURNS SETOF rettype IMMUTABLE NOT LEAKPROOF BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype IMMUTABLE NOT LEAKPROOF BEGIN ATOMIC END"),
@@ -4409,7 +4409,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) RETURNS SETOF rettype SECURITY DEFINER NOT LEAKPROOF BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 , argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SECURITY DEFINER NOT LEAKPROOF BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 = default_expr , IN argname FLOAT8 ) EXTERNAL SECURITY DEFINER NOT LEAKPROOF BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER NOT LEAKPROOF BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER NOT LEAKPROOF BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) RETURNS rettype EXTERNAL SECURITY DEFINER NOT LEAKPROOF BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) RETURNS rettype EXTERNAL SECURITY DEFINER NOT LEAKPROOF BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype EXTERNAL SECURITY DEFINER NOT LEAKPROOF BEGIN ATOMIC END"),
@@ -4484,7 +4484,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS rettype VOLATILE CALLED ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) VOLATILE CALLED ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) VOLATILE CALLED ON NULL INPUT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) NOT LEAKPROOF CALLED ON NULL INPUT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) NOT LEAKPROOF CALLED ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 , IN FLOAT8 ) RETURNS rettype NOT LEAKPROOF CALLED ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) RETURNS rettype NOT LEAKPROOF CALLED ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype NOT LEAKPROOF CALLED ON NULL INPUT BEGIN ATOMIC END"),
@@ -4517,7 +4517,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 , INOUT argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) SECURITY DEFINER CALLED ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SECURITY DEFINER CALLED ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 , VARIADIC FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SECURITY DEFINER CALLED ON NULL INPUT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER CALLED ON NULL INPUT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER CALLED ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER CALLED ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 , OUT argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) EXTERNAL SECURITY DEFINER CALLED ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) PARALLEL UNSAFE CALLED ON NULL INPUT BEGIN ATOMIC END"),
@@ -4559,7 +4559,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) RETURNS rettype TRANSFORM FOR TYPE type_name RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 , INOUT FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) TRANSFORM FOR TYPE type_name RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( IN FLOAT8 , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( IN FLOAT8 , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( FLOAT8 = default_expr , INOUT FLOAT8 ) RETURNS SETOF rettype TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 , INOUT argname FLOAT8 = default_expr ) WINDOW RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) WINDOW RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
@@ -4586,10 +4586,10 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) VOLATILE RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( FLOAT8 = default_expr , argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) VOLATILE RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) VOLATILE RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) LEAKPROOF RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) LEAKPROOF RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 , VARIADIC FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) LEAKPROOF RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) LEAKPROOF RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 = default_expr , FLOAT8 = default_expr ) NOT LEAKPROOF RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( INOUT argname FLOAT8 = default_expr , FLOAT8 = default_expr ) NOT LEAKPROOF RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) RETURNS rettype NOT LEAKPROOF RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype NOT LEAKPROOF RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) RETURNS SETOF rettype NOT LEAKPROOF RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
@@ -4611,7 +4611,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 , IN FLOAT8 = default_expr ) RETURNS SETOF rettype SECURITY INVOKER RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) SECURITY INVOKER RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 = default_expr , INOUT FLOAT8 ) EXTERNAL SECURITY INVOKER RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) EXTERNAL SECURITY INVOKER RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
@@ -4639,7 +4639,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 = default_expr , OUT argname FLOAT8 ) PARALLEL SAFE RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 , VARIADIC FLOAT8 ) RETURNS rettype COST 10 RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 , OUT FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) COST 10 RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) ROWS 10 RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( INOUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) ROWS 10 RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) RETURNS rettype ROWS 10 RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) RETURNS rettype ROWS 10 RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 , VARIADIC argname FLOAT8 ) RETURNS SETOF rettype ROWS 10 RETURNS NULL ON NULL INPUT BEGIN ATOMIC END"),
@@ -4702,7 +4702,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) RETURNS rettype STABLE STRICT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) STABLE STRICT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 = default_expr , IN argname FLOAT8 ) VOLATILE STRICT BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) VOLATILE STRICT BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( OUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) VOLATILE STRICT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) RETURNS rettype VOLATILE STRICT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) RETURNS rettype VOLATILE STRICT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 = default_expr , INOUT argname FLOAT8 ) RETURNS SETOF rettype VOLATILE STRICT BEGIN ATOMIC END"),
@@ -4749,7 +4749,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( IN FLOAT8 = default_expr , OUT FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) SECURITY DEFINER STRICT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SECURITY DEFINER STRICT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 , OUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER STRICT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER STRICT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER STRICT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype EXTERNAL SECURITY DEFINER STRICT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype EXTERNAL SECURITY DEFINER STRICT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 ) RETURNS TABLE ( column_name column_type ) EXTERNAL SECURITY DEFINER STRICT BEGIN ATOMIC END"),
@@ -4758,7 +4758,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) PARALLEL UNSAFE STRICT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , argname FLOAT8 ) RETURNS rettype PARALLEL UNSAFE STRICT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) PARALLEL UNSAFE STRICT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) PARALLEL RESTRICTED STRICT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) PARALLEL RESTRICTED STRICT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 ) RETURNS SETOF rettype PARALLEL RESTRICTED STRICT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype PARALLEL RESTRICTED STRICT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS SETOF rettype PARALLEL RESTRICTED STRICT BEGIN ATOMIC END"),
@@ -4824,7 +4824,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) IMMUTABLE SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 = default_expr , FLOAT8 ) STABLE SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) STABLE SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 , INOUT FLOAT8 = default_expr ) STABLE SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( FLOAT8 , INOUT FLOAT8 = default_expr ) STABLE SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , argname FLOAT8 ) RETURNS rettype STABLE SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) STABLE SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype VOLATILE SECURITY INVOKER BEGIN ATOMIC END"),
@@ -4998,7 +4998,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) RETURNS rettype COST 10 EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 , argname FLOAT8 = default_expr ) RETURNS SETOF rettype COST 10 EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , IN FLOAT8 ) ROWS 10 EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( INOUT FLOAT8 , IN FLOAT8 = default_expr ) ROWS 10 EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( INOUT FLOAT8 , IN FLOAT8 = default_expr ) ROWS 10 EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) RETURNS rettype ROWS 10 EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 , OUT argname FLOAT8 = default_expr ) RETURNS rettype ROWS 10 EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype ROWS 10 EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
@@ -5008,7 +5008,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) ROWS 10 EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 , FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) ROWS 10 EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 , IN argname FLOAT8 ) SUPPORT support_function EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SUPPORT support_function EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SUPPORT support_function EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 , OUT FLOAT8 = default_expr ) SUPPORT support_function EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS rettype SUPPORT support_function EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype SUPPORT support_function EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
@@ -5130,7 +5130,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 = default_expr , OUT argname FLOAT8 ) RETURNS SETOF rettype ROWS 10 SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 = default_expr , argname FLOAT8 = default_expr ) RETURNS SETOF rettype ROWS 10 SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 ) RETURNS TABLE ( column_name column_type ) ROWS 10 SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SUPPORT support_function SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SUPPORT support_function SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS SETOF rettype SUPPORT support_function SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) SUPPORT support_function SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) SUPPORT support_function SECURITY DEFINER BEGIN ATOMIC END"),
@@ -5166,7 +5166,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS rettype LANGUAGE lang_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) LANGUAGE lang_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( FLOAT8 = default_expr , FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( IN FLOAT8 = default_expr , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( IN FLOAT8 = default_expr , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
@@ -5247,7 +5247,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 , OUT FLOAT8 = default_expr ) ROWS 10 EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype ROWS 10 EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 , argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) ROWS 10 EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SUPPORT support_function EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SUPPORT support_function EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) RETURNS rettype SUPPORT support_function EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 , INOUT FLOAT8 = default_expr ) RETURNS rettype SUPPORT support_function EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) RETURNS SETOF rettype SUPPORT support_function EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
@@ -5330,12 +5330,12 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 ) RETURNS SETOF rettype RETURNS NULL ON NULL INPUT PARALLEL UNSAFE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , IN FLOAT8 ) RETURNS TABLE ( column_name column_type ) RETURNS NULL ON NULL INPUT PARALLEL UNSAFE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) RETURNS NULL ON NULL INPUT PARALLEL UNSAFE BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) STRICT PARALLEL UNSAFE BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) STRICT PARALLEL UNSAFE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) STRICT PARALLEL UNSAFE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 , argname FLOAT8 ) RETURNS SETOF rettype STRICT PARALLEL UNSAFE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype STRICT PARALLEL UNSAFE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) RETURNS TABLE ( column_name column_type ) STRICT PARALLEL UNSAFE BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER PARALLEL UNSAFE BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER PARALLEL UNSAFE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) RETURNS SETOF rettype SECURITY INVOKER PARALLEL UNSAFE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 , FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) SECURITY INVOKER PARALLEL UNSAFE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SECURITY INVOKER PARALLEL UNSAFE BEGIN ATOMIC END"),
@@ -5386,7 +5386,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 , FLOAT8 = default_expr ) RETURNS SETOF rettype SUPPORT support_function PARALLEL UNSAFE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 = default_expr ) RETURNS SETOF rettype SUPPORT support_function PARALLEL UNSAFE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter TO value PARALLEL UNSAFE BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter TO value PARALLEL UNSAFE BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter TO value PARALLEL UNSAFE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 , OUT argname FLOAT8 ) RETURNS rettype SET configuration_parameter TO value PARALLEL UNSAFE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS rettype SET configuration_parameter TO value PARALLEL UNSAFE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) RETURNS rettype SET configuration_parameter TO value PARALLEL UNSAFE BEGIN ATOMIC END"),
@@ -5554,7 +5554,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) VOLATILE PARALLEL SAFE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , IN FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) VOLATILE PARALLEL SAFE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) VOLATILE PARALLEL SAFE BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) LEAKPROOF PARALLEL SAFE BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) LEAKPROOF PARALLEL SAFE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 ) RETURNS rettype LEAKPROOF PARALLEL SAFE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) RETURNS rettype LEAKPROOF PARALLEL SAFE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 = default_expr , OUT argname FLOAT8 ) RETURNS rettype NOT LEAKPROOF PARALLEL SAFE BEGIN ATOMIC END"),
@@ -5591,10 +5591,10 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) RETURNS SETOF rettype EXTERNAL SECURITY INVOKER PARALLEL SAFE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 , INOUT FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) EXTERNAL SECURITY INVOKER PARALLEL SAFE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 , IN argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) EXTERNAL SECURITY INVOKER PARALLEL SAFE BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , FLOAT8 DEFAULT default_expr ) SECURITY DEFINER PARALLEL SAFE BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , FLOAT8 DEFAULT default_expr ) SECURITY DEFINER PARALLEL SAFE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr ) RETURNS rettype SECURITY DEFINER PARALLEL SAFE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) SECURITY DEFINER PARALLEL SAFE BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 , FLOAT8 ) EXTERNAL SECURITY DEFINER PARALLEL SAFE BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 , FLOAT8 ) EXTERNAL SECURITY DEFINER PARALLEL SAFE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER PARALLEL SAFE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER PARALLEL SAFE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 , IN FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype EXTERNAL SECURITY DEFINER PARALLEL SAFE BEGIN ATOMIC END"),
@@ -5615,7 +5615,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) PARALLEL SAFE PARALLEL SAFE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 = default_expr , INOUT argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) PARALLEL SAFE PARALLEL SAFE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) PARALLEL SAFE PARALLEL SAFE BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) COST 10 PARALLEL SAFE BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) COST 10 PARALLEL SAFE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype COST 10 PARALLEL SAFE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype COST 10 PARALLEL SAFE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) COST 10 PARALLEL SAFE BEGIN ATOMIC END"),
@@ -5640,10 +5640,10 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 , OUT FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) SET configuration_parameter = value PARALLEL SAFE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , argname FLOAT8 = default_expr ) RETURNS rettype SET configuration_parameter FROM CURRENT PARALLEL SAFE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SET configuration_parameter FROM CURRENT PARALLEL SAFE BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 , OUT argname FLOAT8 ) AS ' definition ' PARALLEL SAFE BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( FLOAT8 , OUT argname FLOAT8 ) AS ' definition ' PARALLEL SAFE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) AS ' definition ' PARALLEL SAFE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) AS ' definition ' PARALLEL SAFE BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( OUT FLOAT8 , IN argname FLOAT8 = default_expr ) AS ' definition ' PARALLEL SAFE BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( OUT FLOAT8 , IN argname FLOAT8 = default_expr ) AS ' definition ' PARALLEL SAFE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( FLOAT8 , VARIADIC argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) AS ' definition ' PARALLEL SAFE BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) AS ' definition ' PARALLEL SAFE BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 DEFAULT default_expr ) RETURNS rettype AS ' obj_file ' , ' link_symbol ' PARALLEL SAFE BEGIN ATOMIC END"),
@@ -5695,7 +5695,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) RETURNS rettype RETURNS NULL ON NULL INPUT COST 10 BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS rettype STRICT COST 10 BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 , INOUT FLOAT8 = default_expr ) RETURNS SETOF rettype STRICT COST 10 BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( OUT FLOAT8 , FLOAT8 = default_expr ) SECURITY INVOKER COST 10 BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( OUT FLOAT8 , FLOAT8 = default_expr ) SECURITY INVOKER COST 10 BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) RETURNS rettype SECURITY INVOKER COST 10 BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 = default_expr , OUT argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) SECURITY INVOKER COST 10 BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) SECURITY INVOKER COST 10 BEGIN ATOMIC END"),
@@ -5715,9 +5715,9 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 , IN FLOAT8 = default_expr ) PARALLEL RESTRICTED COST 10 BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) RETURNS rettype PARALLEL RESTRICTED COST 10 BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) PARALLEL RESTRICTED COST 10 BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) PARALLEL SAFE COST 10 BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) PARALLEL SAFE COST 10 BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) PARALLEL SAFE COST 10 BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) PARALLEL SAFE COST 10 BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) PARALLEL SAFE COST 10 BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) PARALLEL SAFE COST 10 BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 , INOUT FLOAT8 ) RETURNS rettype PARALLEL SAFE COST 10 BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) RETURNS rettype PARALLEL SAFE COST 10 BEGIN ATOMIC END"),
@@ -5742,7 +5742,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SET configuration_parameter = value COST 10 BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SET configuration_parameter = value COST 10 BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( FLOAT8 = default_expr , argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SET configuration_parameter = value COST 10 BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT COST 10 BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT COST 10 BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , OUT argname FLOAT8 = default_expr ) RETURNS rettype SET configuration_parameter FROM CURRENT COST 10 BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) SET configuration_parameter FROM CURRENT COST 10 BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 , OUT argname FLOAT8 ) RETURNS rettype AS ' definition ' COST 10 BEGIN ATOMIC END"),
@@ -5841,7 +5841,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( FLOAT8 = default_expr , FLOAT8 ) RETURNS TABLE ( column_name column_type ) EXTERNAL SECURITY DEFINER ROWS 10 BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , INOUT argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) EXTERNAL SECURITY DEFINER ROWS 10 BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) PARALLEL UNSAFE ROWS 10 BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) PARALLEL UNSAFE ROWS 10 BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) PARALLEL UNSAFE ROWS 10 BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) RETURNS rettype PARALLEL UNSAFE ROWS 10 BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) RETURNS rettype PARALLEL UNSAFE ROWS 10 BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) PARALLEL UNSAFE ROWS 10 BEGIN ATOMIC END"),
@@ -5858,7 +5858,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 = default_expr , INOUT argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) ROWS 10 ROWS 10 BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) ROWS 10 ROWS 10 BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) ROWS 10 ROWS 10 BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( OUT FLOAT8 , IN FLOAT8 ) SUPPORT support_function ROWS 10 BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( OUT FLOAT8 , IN FLOAT8 ) SUPPORT support_function ROWS 10 BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SUPPORT support_function ROWS 10 BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) RETURNS rettype SUPPORT support_function ROWS 10 BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) RETURNS rettype SUPPORT support_function ROWS 10 BEGIN ATOMIC END"),
@@ -5879,11 +5879,11 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) RETURNS rettype SET configuration_parameter FROM CURRENT ROWS 10 BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) RETURNS rettype SET configuration_parameter FROM CURRENT ROWS 10 BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 , IN FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SET configuration_parameter FROM CURRENT ROWS 10 BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' ROWS 10 BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' ROWS 10 BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) RETURNS rettype AS ' definition ' ROWS 10 BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype AS ' definition ' ROWS 10 BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) AS ' definition ' ROWS 10 BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' ROWS 10 BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' ROWS 10 BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , FLOAT8 ) RETURNS SETOF rettype AS ' obj_file ' , ' link_symbol ' ROWS 10 BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) RETURNS SETOF rettype AS ' obj_file ' , ' link_symbol ' ROWS 10 BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 , OUT FLOAT8 ) LANGUAGE lang_name SUPPORT support_function BEGIN ATOMIC END"),
@@ -5921,10 +5921,10 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) RETURNS rettype VOLATILE SUPPORT support_function BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 , IN FLOAT8 ) RETURNS SETOF rettype LEAKPROOF SUPPORT support_function BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) RETURNS TABLE ( column_name column_type ) LEAKPROOF SUPPORT support_function BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) NOT LEAKPROOF SUPPORT support_function BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) NOT LEAKPROOF SUPPORT support_function BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) RETURNS SETOF rettype NOT LEAKPROOF SUPPORT support_function BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) NOT LEAKPROOF SUPPORT support_function BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) CALLED ON NULL INPUT SUPPORT support_function BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) CALLED ON NULL INPUT SUPPORT support_function BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) RETURNS SETOF rettype CALLED ON NULL INPUT SUPPORT support_function BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , INOUT argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) CALLED ON NULL INPUT SUPPORT support_function BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) RETURNS SETOF rettype RETURNS NULL ON NULL INPUT SUPPORT support_function BEGIN ATOMIC END"),
@@ -5936,7 +5936,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype SECURITY INVOKER SUPPORT support_function BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 , argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype SECURITY INVOKER SUPPORT support_function BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) SECURITY INVOKER SUPPORT support_function BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( IN argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SUPPORT support_function BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( IN argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SUPPORT support_function BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 = default_expr , FLOAT8 = default_expr ) RETURNS rettype EXTERNAL SECURITY INVOKER SUPPORT support_function BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , argname FLOAT8 ) RETURNS SETOF rettype EXTERNAL SECURITY INVOKER SUPPORT support_function BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( FLOAT8 = default_expr , OUT argname FLOAT8 ) RETURNS SETOF rettype EXTERNAL SECURITY INVOKER SUPPORT support_function BEGIN ATOMIC END"),
@@ -5947,11 +5947,11 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 , INOUT argname FLOAT8 ) RETURNS rettype SECURITY DEFINER SUPPORT support_function BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 = default_expr , VARIADIC FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) SECURITY DEFINER SUPPORT support_function BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) SECURITY DEFINER SUPPORT support_function BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( OUT FLOAT8 , argname FLOAT8 ) EXTERNAL SECURITY DEFINER SUPPORT support_function BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( OUT FLOAT8 , argname FLOAT8 ) EXTERNAL SECURITY DEFINER SUPPORT support_function BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 , VARIADIC FLOAT8 = default_expr ) RETURNS rettype EXTERNAL SECURITY DEFINER SUPPORT support_function BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) RETURNS rettype EXTERNAL SECURITY DEFINER SUPPORT support_function BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) PARALLEL UNSAFE SUPPORT support_function BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) PARALLEL UNSAFE SUPPORT support_function BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) PARALLEL UNSAFE SUPPORT support_function BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) RETURNS rettype PARALLEL UNSAFE SUPPORT support_function BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 = default_expr , INOUT FLOAT8 ) RETURNS SETOF rettype PARALLEL UNSAFE SUPPORT support_function BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) RETURNS SETOF rettype PARALLEL UNSAFE SUPPORT support_function BEGIN ATOMIC END"),
@@ -6094,7 +6094,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) ROWS 10 SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) ROWS 10 SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 = default_expr , IN FLOAT8 ) SUPPORT support_function SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( FLOAT8 , INOUT argname FLOAT8 ) SUPPORT support_function SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( FLOAT8 , INOUT argname FLOAT8 ) SUPPORT support_function SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) RETURNS rettype SUPPORT support_function SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) RETURNS SETOF rettype SUPPORT support_function SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 , OUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value SET configuration_parameter TO value BEGIN ATOMIC END"),
@@ -6140,7 +6140,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( IN FLOAT8 = default_expr , IN argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) WINDOW SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 = default_expr , IN FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) WINDOW SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) WINDOW SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) IMMUTABLE SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) IMMUTABLE SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) IMMUTABLE SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) IMMUTABLE SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) STABLE SET configuration_parameter = value BEGIN ATOMIC END"),
@@ -6162,7 +6162,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , VARIADIC argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) CALLED ON NULL INPUT SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) CALLED ON NULL INPUT SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 , VARIADIC argname FLOAT8 ) RETURNS NULL ON NULL INPUT SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( FLOAT8 , INOUT argname FLOAT8 = default_expr ) RETURNS NULL ON NULL INPUT SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( FLOAT8 , INOUT argname FLOAT8 = default_expr ) RETURNS NULL ON NULL INPUT SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) RETURNS rettype RETURNS NULL ON NULL INPUT SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) RETURNS SETOF rettype RETURNS NULL ON NULL INPUT SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 , FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) RETURNS NULL ON NULL INPUT SET configuration_parameter = value BEGIN ATOMIC END"),
@@ -6228,7 +6228,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 , VARIADIC FLOAT8 ) RETURNS SETOF rettype SET configuration_parameter FROM CURRENT SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) RETURNS SETOF rettype AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 = default_expr , IN argname FLOAT8 ) RETURNS SETOF rettype AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) RETURNS rettype AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 , INOUT argname FLOAT8 = default_expr ) RETURNS rettype LANGUAGE lang_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
@@ -6257,7 +6257,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) STABLE SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , FLOAT8 ) VOLATILE SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) VOLATILE SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) VOLATILE SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) VOLATILE SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , FLOAT8 ) RETURNS rettype VOLATILE SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 = default_expr , argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) VOLATILE SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype LEAKPROOF SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
@@ -6267,7 +6267,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 , OUT FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) LEAKPROOF SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 , IN argname FLOAT8 ) NOT LEAKPROOF SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) NOT LEAKPROOF SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( INOUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) CALLED ON NULL INPUT SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( INOUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) CALLED ON NULL INPUT SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 , FLOAT8 = default_expr ) RETURNS SETOF rettype CALLED ON NULL INPUT SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) RETURNS SETOF rettype RETURNS NULL ON NULL INPUT SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) RETURNS rettype STRICT SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
@@ -6307,7 +6307,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 = default_expr , IN FLOAT8 ) RETURNS rettype ROWS 10 SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 , FLOAT8 = default_expr ) RETURNS SETOF rettype ROWS 10 SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 , INOUT FLOAT8 ) RETURNS TABLE ( column_name column_type ) ROWS 10 SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( INOUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SUPPORT support_function SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( INOUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SUPPORT support_function SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) RETURNS rettype SUPPORT support_function SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype SUPPORT support_function SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) SUPPORT support_function SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
@@ -6346,11 +6346,11 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , VARIADIC argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 ) WINDOW AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 ) WINDOW AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) RETURNS TABLE ( column_name column_type ) WINDOW AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 ) RETURNS TABLE ( column_name column_type ) WINDOW AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 = default_expr , OUT argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) WINDOW AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) IMMUTABLE AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) IMMUTABLE AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype IMMUTABLE AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) IMMUTABLE AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) IMMUTABLE AS ' definition ' BEGIN ATOMIC END"),
@@ -6366,7 +6366,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 ) RETURNS SETOF rettype VOLATILE AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 , VARIADIC argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) VOLATILE AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) VOLATILE AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) LEAKPROOF AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) LEAKPROOF AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS rettype LEAKPROOF AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 , IN argname FLOAT8 = default_expr ) RETURNS rettype LEAKPROOF AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 , INOUT FLOAT8 ) RETURNS TABLE ( column_name column_type ) LEAKPROOF AS ' definition ' BEGIN ATOMIC END"),
@@ -6380,7 +6380,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype CALLED ON NULL INPUT AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 = default_expr , INOUT argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) CALLED ON NULL INPUT AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) CALLED ON NULL INPUT AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , INOUT FLOAT8 = default_expr ) RETURNS NULL ON NULL INPUT AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , INOUT FLOAT8 = default_expr ) RETURNS NULL ON NULL INPUT AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 , FLOAT8 DEFAULT default_expr ) RETURNS rettype RETURNS NULL ON NULL INPUT AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype RETURNS NULL ON NULL INPUT AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) RETURNS NULL ON NULL INPUT AS ' definition ' BEGIN ATOMIC END"),
@@ -6419,7 +6419,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) RETURNS SETOF rettype PARALLEL SAFE AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) PARALLEL SAFE AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 = default_expr , IN FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) PARALLEL SAFE AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) COST 10 AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) COST 10 AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) COST 10 AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 , argname FLOAT8 = default_expr ) RETURNS rettype COST 10 AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) RETURNS rettype COST 10 AS ' definition ' BEGIN ATOMIC END"),
@@ -6577,7 +6577,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 = default_expr , INOUT FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE FUNCTION name ( argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE FUNCTION name ( argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 , argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
@@ -6625,7 +6625,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 , INOUT FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) IMMUTABLE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , FLOAT8 ) STABLE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) STABLE RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) STABLE RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) STABLE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) STABLE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype STABLE RETURN 1"),
 		Parses("CREATE FUNCTION name ( FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) STABLE RETURN 1"),
@@ -6895,7 +6895,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 ) RETURNS TABLE ( column_name column_type ) IMMUTABLE TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 = default_expr , IN FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) IMMUTABLE TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) IMMUTABLE TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr ) STABLE TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr ) STABLE TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 , VARIADIC argname FLOAT8 ) RETURNS rettype STABLE TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 = default_expr , argname FLOAT8 ) RETURNS SETOF rettype STABLE TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE FUNCTION name ( FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype STABLE TRANSFORM FOR TYPE type_name RETURN 1"),
@@ -6943,7 +6943,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 , OUT FLOAT8 ) RETURNS SETOF rettype EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE FUNCTION name ( FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE FUNCTION name ( FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) RETURNS rettype SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 , FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
@@ -6956,7 +6956,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) PARALLEL UNSAFE TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE FUNCTION name ( FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype PARALLEL UNSAFE TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) PARALLEL UNSAFE TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE FUNCTION name ( argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) PARALLEL RESTRICTED TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE FUNCTION name ( argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) PARALLEL RESTRICTED TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) PARALLEL RESTRICTED TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) RETURNS rettype PARALLEL RESTRICTED TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 , INOUT argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) PARALLEL RESTRICTED TRANSFORM FOR TYPE type_name RETURN 1"),
@@ -7018,7 +7018,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) RETURNS SETOF rettype WINDOW TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) WINDOW TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) IMMUTABLE TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) IMMUTABLE TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) RETURNS rettype IMMUTABLE TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 , INOUT argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) IMMUTABLE TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) RETURNS rettype VOLATILE TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
@@ -7054,7 +7054,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) RETURNS rettype SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) RETURNS SETOF rettype SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE FUNCTION name ( INOUT FLOAT8 = default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE FUNCTION name ( INOUT FLOAT8 = default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 , VARIADIC argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 , IN argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 = default_expr , FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
@@ -7069,7 +7069,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 , FLOAT8 DEFAULT default_expr ) PARALLEL UNSAFE TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE FUNCTION name ( FLOAT8 = default_expr , IN FLOAT8 = default_expr ) PARALLEL UNSAFE TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) PARALLEL UNSAFE TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) PARALLEL UNSAFE TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) RETURNS rettype PARALLEL UNSAFE TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) RETURNS rettype PARALLEL UNSAFE TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) RETURNS rettype PARALLEL UNSAFE TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
@@ -7105,7 +7105,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 = default_expr , OUT argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) RETURNS rettype SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE FUNCTION name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE FUNCTION name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 ) RETURNS rettype SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) RETURNS SETOF rettype SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 ) RETURNS TABLE ( column_name column_type ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
@@ -7126,7 +7126,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 = default_expr , IN argname FLOAT8 ) RETURNS rettype TRANSFORM FOR TYPE type_name WINDOW RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) RETURNS rettype TRANSFORM FOR TYPE type_name WINDOW RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 , VARIADIC argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) TRANSFORM FOR TYPE type_name WINDOW RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name WINDOW RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name WINDOW RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) RETURNS rettype TRANSFORM FOR TYPE type_name , FOR TYPE type_name WINDOW RETURN 1"),
 		Parses("CREATE FUNCTION name ( FLOAT8 , VARIADIC argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name WINDOW RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) WINDOW WINDOW RETURN 1"),
@@ -7135,8 +7135,8 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) WINDOW WINDOW RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) WINDOW WINDOW RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) IMMUTABLE WINDOW RETURN 1"),
-		Parses("CREATE FUNCTION name ( argname FLOAT8 = default_expr , OUT FLOAT8 ) IMMUTABLE WINDOW RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , IN argname FLOAT8 ) IMMUTABLE WINDOW RETURN 1"),
+		Converts("CREATE FUNCTION name ( argname FLOAT8 = default_expr , OUT FLOAT8 ) IMMUTABLE WINDOW RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , IN argname FLOAT8 ) IMMUTABLE WINDOW RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype IMMUTABLE WINDOW RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 , argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype IMMUTABLE WINDOW RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) IMMUTABLE WINDOW RETURN 1"),
@@ -7156,13 +7156,13 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , VARIADIC FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) LEAKPROOF WINDOW RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) LEAKPROOF WINDOW RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 , IN argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) LEAKPROOF WINDOW RETURN 1"),
-		Parses("CREATE FUNCTION name ( OUT FLOAT8 , IN FLOAT8 ) NOT LEAKPROOF WINDOW RETURN 1"),
+		Converts("CREATE FUNCTION name ( OUT FLOAT8 , IN FLOAT8 ) NOT LEAKPROOF WINDOW RETURN 1"),
 		Parses("CREATE FUNCTION name ( FLOAT8 , IN FLOAT8 = default_expr ) NOT LEAKPROOF WINDOW RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 , OUT argname FLOAT8 ) CALLED ON NULL INPUT WINDOW RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 , argname FLOAT8 ) RETURNS rettype CALLED ON NULL INPUT WINDOW RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) CALLED ON NULL INPUT WINDOW RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) RETURNS NULL ON NULL INPUT WINDOW RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , OUT argname FLOAT8 ) RETURNS NULL ON NULL INPUT WINDOW RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) RETURNS NULL ON NULL INPUT WINDOW RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , OUT argname FLOAT8 ) RETURNS NULL ON NULL INPUT WINDOW RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) RETURNS NULL ON NULL INPUT WINDOW RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) RETURNS NULL ON NULL INPUT WINDOW RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) RETURNS NULL ON NULL INPUT WINDOW RETURN 1"),
@@ -7185,7 +7185,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 , INOUT argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) EXTERNAL SECURITY DEFINER WINDOW RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) EXTERNAL SECURITY DEFINER WINDOW RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 , argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) EXTERNAL SECURITY DEFINER WINDOW RETURN 1"),
-		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) PARALLEL UNSAFE WINDOW RETURN 1"),
+		Converts("CREATE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) PARALLEL UNSAFE WINDOW RETURN 1"),
 		Parses("CREATE FUNCTION name ( FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) PARALLEL UNSAFE WINDOW RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 , argname FLOAT8 = default_expr ) PARALLEL UNSAFE WINDOW RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) RETURNS rettype PARALLEL UNSAFE WINDOW RETURN 1"),
@@ -7228,7 +7228,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype SET configuration_parameter FROM CURRENT WINDOW RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 , VARIADIC argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) SET configuration_parameter FROM CURRENT WINDOW RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SET configuration_parameter FROM CURRENT WINDOW RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) AS ' definition ' WINDOW RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) AS ' definition ' WINDOW RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) AS ' definition ' WINDOW RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) AS ' definition ' WINDOW RETURN 1"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 , OUT argname FLOAT8 ) RETURNS SETOF rettype AS ' obj_file ' , ' link_symbol ' WINDOW RETURN 1"),
@@ -7269,7 +7269,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) RETURNS SETOF rettype VOLATILE IMMUTABLE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype VOLATILE IMMUTABLE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) VOLATILE IMMUTABLE RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , FLOAT8 = default_expr ) LEAKPROOF IMMUTABLE RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , FLOAT8 = default_expr ) LEAKPROOF IMMUTABLE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) RETURNS SETOF rettype LEAKPROOF IMMUTABLE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) LEAKPROOF IMMUTABLE RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) LEAKPROOF IMMUTABLE RETURN 1"),
@@ -7315,8 +7315,8 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 , VARIADIC FLOAT8 ) EXTERNAL SECURITY DEFINER IMMUTABLE RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) EXTERNAL SECURITY DEFINER IMMUTABLE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) EXTERNAL SECURITY DEFINER IMMUTABLE RETURN 1"),
-		Parses("CREATE FUNCTION name ( INOUT FLOAT8 , FLOAT8 DEFAULT default_expr ) PARALLEL UNSAFE IMMUTABLE RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , argname FLOAT8 = default_expr ) PARALLEL UNSAFE IMMUTABLE RETURN 1"),
+		Converts("CREATE FUNCTION name ( INOUT FLOAT8 , FLOAT8 DEFAULT default_expr ) PARALLEL UNSAFE IMMUTABLE RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , argname FLOAT8 = default_expr ) PARALLEL UNSAFE IMMUTABLE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) RETURNS rettype PARALLEL UNSAFE IMMUTABLE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) RETURNS SETOF rettype PARALLEL UNSAFE IMMUTABLE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 ) RETURNS TABLE ( column_name column_type ) PARALLEL UNSAFE IMMUTABLE RETURN 1"),
@@ -7360,7 +7360,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype AS ' definition ' IMMUTABLE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) AS ' definition ' IMMUTABLE RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 = default_expr , FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) AS ' definition ' IMMUTABLE RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' IMMUTABLE RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' IMMUTABLE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) RETURNS rettype AS ' obj_file ' , ' link_symbol ' IMMUTABLE RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) RETURNS rettype AS ' obj_file ' , ' link_symbol ' IMMUTABLE RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype AS ' obj_file ' , ' link_symbol ' IMMUTABLE RETURN 1"),
@@ -7380,7 +7380,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) LANGUAGE lang_name STABLE RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 ) RETURNS rettype TRANSFORM FOR TYPE type_name STABLE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) TRANSFORM FOR TYPE type_name STABLE RETURN 1"),
-		Parses("CREATE FUNCTION name ( INOUT FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name STABLE RETURN 1"),
+		Converts("CREATE FUNCTION name ( INOUT FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name STABLE RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name STABLE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name STABLE RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 = default_expr , IN FLOAT8 ) RETURNS SETOF rettype TRANSFORM FOR TYPE type_name , FOR TYPE type_name STABLE RETURN 1"),
@@ -7420,7 +7420,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , IN argname FLOAT8 ) RETURNS rettype CALLED ON NULL INPUT STABLE RETURN 1"),
 		Parses("CREATE FUNCTION name ( FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) RETURNS NULL ON NULL INPUT STABLE RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) RETURNS NULL ON NULL INPUT STABLE RETURN 1"),
-		Parses("CREATE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) STRICT STABLE RETURN 1"),
+		Converts("CREATE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) STRICT STABLE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 , VARIADIC FLOAT8 = default_expr ) STRICT STABLE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) RETURNS rettype STRICT STABLE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype STRICT STABLE RETURN 1"),
@@ -7476,7 +7476,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SET configuration_parameter TO value STABLE RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SET configuration_parameter = value STABLE RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 , FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SET configuration_parameter = value STABLE RETURN 1"),
-		Parses("CREATE FUNCTION name ( INOUT FLOAT8 , IN argname FLOAT8 ) SET configuration_parameter FROM CURRENT STABLE RETURN 1"),
+		Converts("CREATE FUNCTION name ( INOUT FLOAT8 , IN argname FLOAT8 ) SET configuration_parameter FROM CURRENT STABLE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 = default_expr , IN argname FLOAT8 ) RETURNS rettype SET configuration_parameter FROM CURRENT STABLE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS rettype SET configuration_parameter FROM CURRENT STABLE RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) RETURNS SETOF rettype SET configuration_parameter FROM CURRENT STABLE RETURN 1"),
@@ -7494,7 +7494,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) LANGUAGE lang_name VOLATILE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) LANGUAGE lang_name VOLATILE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) LANGUAGE lang_name VOLATILE RETURN 1"),
-		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name VOLATILE RETURN 1"),
+		Converts("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name VOLATILE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 , OUT FLOAT8 ) RETURNS rettype TRANSFORM FOR TYPE type_name VOLATILE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) TRANSFORM FOR TYPE type_name VOLATILE RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) TRANSFORM FOR TYPE type_name VOLATILE RETURN 1"),
@@ -7544,7 +7544,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) RETURNS TABLE ( column_name column_type ) CALLED ON NULL INPUT VOLATILE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) CALLED ON NULL INPUT VOLATILE RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) CALLED ON NULL INPUT VOLATILE RETURN 1"),
-		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) RETURNS NULL ON NULL INPUT VOLATILE RETURN 1"),
+		Converts("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) RETURNS NULL ON NULL INPUT VOLATILE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 ) RETURNS NULL ON NULL INPUT VOLATILE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) RETURNS NULL ON NULL INPUT VOLATILE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 = default_expr , FLOAT8 ) RETURNS rettype RETURNS NULL ON NULL INPUT VOLATILE RETURN 1"),
@@ -7750,7 +7750,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name NOT LEAKPROOF RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 , FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) TRANSFORM FOR TYPE type_name NOT LEAKPROOF RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) TRANSFORM FOR TYPE type_name NOT LEAKPROOF RETURN 1"),
-		Parses("CREATE FUNCTION name ( IN FLOAT8 , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name NOT LEAKPROOF RETURN 1"),
+		Converts("CREATE FUNCTION name ( IN FLOAT8 , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name NOT LEAKPROOF RETURN 1"),
 		Parses("CREATE FUNCTION name ( FLOAT8 , IN argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name NOT LEAKPROOF RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name NOT LEAKPROOF RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 = default_expr ) WINDOW NOT LEAKPROOF RETURN 1"),
@@ -7771,7 +7771,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) NOT LEAKPROOF NOT LEAKPROOF RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) NOT LEAKPROOF NOT LEAKPROOF RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) NOT LEAKPROOF NOT LEAKPROOF RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) CALLED ON NULL INPUT NOT LEAKPROOF RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) CALLED ON NULL INPUT NOT LEAKPROOF RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 , IN argname FLOAT8 = default_expr ) RETURNS rettype CALLED ON NULL INPUT NOT LEAKPROOF RETURN 1"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 = default_expr , argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) CALLED ON NULL INPUT NOT LEAKPROOF RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 , OUT FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) CALLED ON NULL INPUT NOT LEAKPROOF RETURN 1"),
@@ -7791,7 +7791,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , IN argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) STRICT NOT LEAKPROOF RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 = default_expr , VARIADIC FLOAT8 ) RETURNS rettype SECURITY INVOKER NOT LEAKPROOF RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) SECURITY INVOKER NOT LEAKPROOF RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 ) EXTERNAL SECURITY INVOKER NOT LEAKPROOF RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 ) EXTERNAL SECURITY INVOKER NOT LEAKPROOF RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS rettype EXTERNAL SECURITY INVOKER NOT LEAKPROOF RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) RETURNS rettype EXTERNAL SECURITY INVOKER NOT LEAKPROOF RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) EXTERNAL SECURITY INVOKER NOT LEAKPROOF RETURN 1"),
@@ -7988,7 +7988,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) WINDOW RETURNS NULL ON NULL INPUT RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) IMMUTABLE RETURNS NULL ON NULL INPUT RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS SETOF rettype IMMUTABLE RETURNS NULL ON NULL INPUT RETURN 1"),
-		Parses("CREATE FUNCTION name ( argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) STABLE RETURNS NULL ON NULL INPUT RETURN 1"),
+		Converts("CREATE FUNCTION name ( argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) STABLE RETURNS NULL ON NULL INPUT RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 = default_expr , IN FLOAT8 ) RETURNS TABLE ( column_name column_type ) STABLE RETURNS NULL ON NULL INPUT RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 , INOUT FLOAT8 ) RETURNS TABLE ( column_name column_type ) STABLE RETURNS NULL ON NULL INPUT RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) STABLE RETURNS NULL ON NULL INPUT RETURN 1"),
@@ -8005,7 +8005,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) RETURNS SETOF rettype LEAKPROOF RETURNS NULL ON NULL INPUT RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 , IN FLOAT8 ) RETURNS TABLE ( column_name column_type ) LEAKPROOF RETURNS NULL ON NULL INPUT RETURN 1"),
 		Parses("CREATE FUNCTION name ( FLOAT8 DEFAULT default_expr , argname FLOAT8 ) NOT LEAKPROOF RETURNS NULL ON NULL INPUT RETURN 1"),
-		Parses("CREATE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) NOT LEAKPROOF RETURNS NULL ON NULL INPUT RETURN 1"),
+		Converts("CREATE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) NOT LEAKPROOF RETURNS NULL ON NULL INPUT RETURN 1"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 , argname FLOAT8 ) RETURNS rettype NOT LEAKPROOF RETURNS NULL ON NULL INPUT RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) RETURNS rettype NOT LEAKPROOF RETURNS NULL ON NULL INPUT RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) RETURNS SETOF rettype NOT LEAKPROOF RETURNS NULL ON NULL INPUT RETURN 1"),
@@ -8059,7 +8059,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) RETURNS rettype PARALLEL SAFE RETURNS NULL ON NULL INPUT RETURN 1"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype PARALLEL SAFE RETURNS NULL ON NULL INPUT RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) PARALLEL SAFE RETURNS NULL ON NULL INPUT RETURN 1"),
-		Parses("CREATE FUNCTION name ( FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) COST 10 RETURNS NULL ON NULL INPUT RETURN 1"),
+		Converts("CREATE FUNCTION name ( FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) COST 10 RETURNS NULL ON NULL INPUT RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) COST 10 RETURNS NULL ON NULL INPUT RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) COST 10 RETURNS NULL ON NULL INPUT RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) COST 10 RETURNS NULL ON NULL INPUT RETURN 1"),
@@ -8112,7 +8112,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) WINDOW STRICT RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) WINDOW STRICT RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , FLOAT8 ) IMMUTABLE STRICT RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 , FLOAT8 DEFAULT default_expr ) IMMUTABLE STRICT RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 , FLOAT8 DEFAULT default_expr ) IMMUTABLE STRICT RETURN 1"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) RETURNS rettype IMMUTABLE STRICT RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) RETURNS rettype IMMUTABLE STRICT RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) IMMUTABLE STRICT RETURN 1"),
@@ -8338,7 +8338,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) RETURNS rettype IMMUTABLE EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 = default_expr , IN argname FLOAT8 ) RETURNS SETOF rettype IMMUTABLE EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 = default_expr , argname FLOAT8 = default_expr ) RETURNS SETOF rettype IMMUTABLE EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE FUNCTION name ( IN FLOAT8 = default_expr , OUT FLOAT8 ) STABLE EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE FUNCTION name ( IN FLOAT8 = default_expr , OUT FLOAT8 ) STABLE EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 ) RETURNS SETOF rettype STABLE EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) STABLE EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) STABLE EXTERNAL SECURITY INVOKER RETURN 1"),
@@ -8351,7 +8351,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) RETURNS SETOF rettype VOLATILE EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) VOLATILE EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype LEAKPROOF EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE FUNCTION name ( INOUT FLOAT8 , argname FLOAT8 = default_expr ) NOT LEAKPROOF EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE FUNCTION name ( INOUT FLOAT8 , argname FLOAT8 = default_expr ) NOT LEAKPROOF EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) RETURNS rettype NOT LEAKPROOF EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) NOT LEAKPROOF EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) RETURNS rettype CALLED ON NULL INPUT EXTERNAL SECURITY INVOKER RETURN 1"),
@@ -8408,7 +8408,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) RETURNS SETOF rettype SUPPORT support_function EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 , argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) SUPPORT support_function EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE FUNCTION name ( FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) SUPPORT support_function EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 = default_expr , FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 = default_expr , FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 ) RETURNS rettype SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) RETURNS rettype SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
@@ -8434,7 +8434,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE FUNCTION name ( FLOAT8 , INOUT argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE FUNCTION name ( FLOAT8 , INOUT argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS rettype AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) RETURNS SETOF rettype AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
@@ -8512,7 +8512,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) PARALLEL UNSAFE SECURITY DEFINER RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 , OUT FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) PARALLEL UNSAFE SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) PARALLEL RESTRICTED SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) PARALLEL SAFE SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) PARALLEL SAFE SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) RETURNS SETOF rettype PARALLEL SAFE SECURITY DEFINER RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) RETURNS SETOF rettype PARALLEL SAFE SECURITY DEFINER RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 = default_expr , INOUT argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) PARALLEL SAFE SECURITY DEFINER RETURN 1"),
@@ -8654,7 +8654,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 , OUT FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SUPPORT support_function EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) RETURNS rettype SET configuration_parameter TO value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 = default_expr , IN argname FLOAT8 ) RETURNS SETOF rettype SET configuration_parameter TO value EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE FUNCTION name ( OUT FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE FUNCTION name ( OUT FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 , VARIADIC FLOAT8 ) RETURNS rettype SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 , INOUT FLOAT8 ) RETURNS SETOF rettype SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) RETURNS SETOF rettype SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
@@ -8667,7 +8667,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) RETURNS TABLE ( column_name column_type ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 , FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 = default_expr , IN FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE FUNCTION name ( INOUT FLOAT8 , argname FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE FUNCTION name ( INOUT FLOAT8 , argname FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 , FLOAT8 ) RETURNS rettype AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 ) RETURNS SETOF rettype AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) RETURNS SETOF rettype AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
@@ -8688,7 +8688,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) RETURNS SETOF rettype TRANSFORM FOR TYPE type_name , FOR TYPE type_name PARALLEL UNSAFE RETURN 1"),
 		Parses("CREATE FUNCTION name ( FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) RETURNS SETOF rettype TRANSFORM FOR TYPE type_name , FOR TYPE type_name PARALLEL UNSAFE RETURN 1"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name PARALLEL UNSAFE RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , OUT argname FLOAT8 ) WINDOW PARALLEL UNSAFE RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , OUT argname FLOAT8 ) WINDOW PARALLEL UNSAFE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 , OUT argname FLOAT8 = default_expr ) RETURNS SETOF rettype WINDOW PARALLEL UNSAFE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 = default_expr , OUT FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) WINDOW PARALLEL UNSAFE RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 , FLOAT8 = default_expr ) IMMUTABLE PARALLEL UNSAFE RETURN 1"),
@@ -8775,7 +8775,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) RETURNS SETOF rettype SUPPORT support_function PARALLEL UNSAFE RETURN 1"),
 		Parses("CREATE FUNCTION name ( FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SUPPORT support_function PARALLEL UNSAFE RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SUPPORT support_function PARALLEL UNSAFE RETURN 1"),
-		Parses("CREATE FUNCTION name ( OUT FLOAT8 , FLOAT8 ) SET configuration_parameter TO value PARALLEL UNSAFE RETURN 1"),
+		Converts("CREATE FUNCTION name ( OUT FLOAT8 , FLOAT8 ) SET configuration_parameter TO value PARALLEL UNSAFE RETURN 1"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype SET configuration_parameter TO value PARALLEL UNSAFE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 = default_expr , INOUT FLOAT8 ) RETURNS TABLE ( column_name column_type ) SET configuration_parameter TO value PARALLEL UNSAFE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) SET configuration_parameter TO value PARALLEL UNSAFE RETURN 1"),
@@ -8820,7 +8820,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS SETOF rettype VOLATILE PARALLEL RESTRICTED RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) LEAKPROOF PARALLEL RESTRICTED RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) LEAKPROOF PARALLEL RESTRICTED RETURN 1"),
-		Parses("CREATE FUNCTION name ( INOUT FLOAT8 , IN FLOAT8 = default_expr ) LEAKPROOF PARALLEL RESTRICTED RETURN 1"),
+		Converts("CREATE FUNCTION name ( INOUT FLOAT8 , IN FLOAT8 = default_expr ) LEAKPROOF PARALLEL RESTRICTED RETURN 1"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS rettype LEAKPROOF PARALLEL RESTRICTED RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 , INOUT FLOAT8 = default_expr ) RETURNS SETOF rettype LEAKPROOF PARALLEL RESTRICTED RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 , OUT FLOAT8 ) RETURNS TABLE ( column_name column_type ) LEAKPROOF PARALLEL RESTRICTED RETURN 1"),
@@ -8845,7 +8845,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) STRICT PARALLEL RESTRICTED RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 , IN argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) STRICT PARALLEL RESTRICTED RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 ) SECURITY INVOKER PARALLEL RESTRICTED RETURN 1"),
-		Parses("CREATE FUNCTION name ( INOUT FLOAT8 , IN FLOAT8 ) SECURITY INVOKER PARALLEL RESTRICTED RETURN 1"),
+		Converts("CREATE FUNCTION name ( INOUT FLOAT8 , IN FLOAT8 ) SECURITY INVOKER PARALLEL RESTRICTED RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) RETURNS rettype SECURITY INVOKER PARALLEL RESTRICTED RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SECURITY INVOKER PARALLEL RESTRICTED RETURN 1"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 , INOUT argname FLOAT8 ) EXTERNAL SECURITY INVOKER PARALLEL RESTRICTED RETURN 1"),
@@ -8961,7 +8961,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 ) RETURNS SETOF rettype EXTERNAL SECURITY INVOKER PARALLEL SAFE RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) EXTERNAL SECURITY INVOKER PARALLEL SAFE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 , OUT FLOAT8 ) SECURITY DEFINER PARALLEL SAFE RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER PARALLEL SAFE RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER PARALLEL SAFE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SECURITY DEFINER PARALLEL SAFE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , VARIADIC argname FLOAT8 ) RETURNS SETOF rettype SECURITY DEFINER PARALLEL SAFE RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype SECURITY DEFINER PARALLEL SAFE RETURN 1"),
@@ -8998,7 +8998,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) ROWS 10 PARALLEL SAFE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) ROWS 10 PARALLEL SAFE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) ROWS 10 PARALLEL SAFE RETURN 1"),
-		Parses("CREATE FUNCTION name ( argname FLOAT8 = default_expr , OUT FLOAT8 ) SUPPORT support_function PARALLEL SAFE RETURN 1"),
+		Converts("CREATE FUNCTION name ( argname FLOAT8 = default_expr , OUT FLOAT8 ) SUPPORT support_function PARALLEL SAFE RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SUPPORT support_function PARALLEL SAFE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , VARIADIC FLOAT8 ) RETURNS SETOF rettype SUPPORT support_function PARALLEL SAFE RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) SUPPORT support_function PARALLEL SAFE RETURN 1"),
@@ -9012,7 +9012,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) RETURNS SETOF rettype SET configuration_parameter = value PARALLEL SAFE RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) SET configuration_parameter = value PARALLEL SAFE RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 , INOUT argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SET configuration_parameter = value PARALLEL SAFE RETURN 1"),
-		Parses("CREATE FUNCTION name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT PARALLEL SAFE RETURN 1"),
+		Converts("CREATE FUNCTION name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT PARALLEL SAFE RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) SET configuration_parameter FROM CURRENT PARALLEL SAFE RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS rettype AS ' definition ' PARALLEL SAFE RETURN 1"),
 		Parses("CREATE FUNCTION name ( FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) RETURNS rettype AS ' definition ' PARALLEL SAFE RETURN 1"),
@@ -9033,7 +9033,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) RETURNS SETOF rettype LANGUAGE lang_name COST 10 RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) LANGUAGE lang_name COST 10 RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) LANGUAGE lang_name COST 10 RETURN 1"),
-		Parses("CREATE FUNCTION name ( FLOAT8 = default_expr , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name COST 10 RETURN 1"),
+		Converts("CREATE FUNCTION name ( FLOAT8 = default_expr , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name COST 10 RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) TRANSFORM FOR TYPE type_name COST 10 RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) TRANSFORM FOR TYPE type_name COST 10 RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) TRANSFORM FOR TYPE type_name COST 10 RETURN 1"),
@@ -9078,7 +9078,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 , IN FLOAT8 = default_expr ) RETURNS rettype RETURNS NULL ON NULL INPUT COST 10 RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 = default_expr , IN argname FLOAT8 ) RETURNS SETOF rettype RETURNS NULL ON NULL INPUT COST 10 RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) RETURNS NULL ON NULL INPUT COST 10 RETURN 1"),
-		Parses("CREATE FUNCTION name ( IN argname FLOAT8 , OUT FLOAT8 ) STRICT COST 10 RETURN 1"),
+		Converts("CREATE FUNCTION name ( IN argname FLOAT8 , OUT FLOAT8 ) STRICT COST 10 RETURN 1"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype STRICT COST 10 RETURN 1"),
 		Parses("CREATE FUNCTION name ( FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype STRICT COST 10 RETURN 1"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) STRICT COST 10 RETURN 1"),
@@ -9132,7 +9132,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) SUPPORT support_function COST 10 RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SUPPORT support_function COST 10 RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 , argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SUPPORT support_function COST 10 RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 , argname FLOAT8 ) SET configuration_parameter TO value COST 10 RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 , argname FLOAT8 ) SET configuration_parameter TO value COST 10 RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter TO value COST 10 RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , FLOAT8 ) RETURNS rettype SET configuration_parameter TO value COST 10 RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) RETURNS rettype SET configuration_parameter TO value COST 10 RETURN 1"),
@@ -9168,7 +9168,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name ROWS 10 RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 , argname FLOAT8 = default_expr ) RETURNS SETOF rettype TRANSFORM FOR TYPE type_name ROWS 10 RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 = default_expr ) RETURNS SETOF rettype TRANSFORM FOR TYPE type_name ROWS 10 RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name ROWS 10 RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name ROWS 10 RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 = default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name ROWS 10 RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) RETURNS rettype TRANSFORM FOR TYPE type_name , FOR TYPE type_name ROWS 10 RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name ROWS 10 RETURN 1"),
@@ -9244,7 +9244,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( ) PARALLEL SAFE ROWS 10 RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 , OUT FLOAT8 ) PARALLEL SAFE ROWS 10 RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype PARALLEL SAFE ROWS 10 RETURN 1"),
-		Parses("CREATE FUNCTION name ( INOUT FLOAT8 , FLOAT8 = default_expr ) COST 10 ROWS 10 RETURN 1"),
+		Converts("CREATE FUNCTION name ( INOUT FLOAT8 , FLOAT8 = default_expr ) COST 10 ROWS 10 RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) COST 10 ROWS 10 RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) RETURNS rettype COST 10 ROWS 10 RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 , INOUT FLOAT8 ) RETURNS SETOF rettype COST 10 ROWS 10 RETURN 1"),
@@ -9254,10 +9254,10 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 , OUT argname FLOAT8 = default_expr ) ROWS 10 ROWS 10 RETURN 1"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 = default_expr , INOUT argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) ROWS 10 ROWS 10 RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 ) SUPPORT support_function ROWS 10 RETURN 1"),
-		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SUPPORT support_function ROWS 10 RETURN 1"),
+		Converts("CREATE FUNCTION name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SUPPORT support_function ROWS 10 RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) RETURNS rettype SUPPORT support_function ROWS 10 RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 , IN FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) SUPPORT support_function ROWS 10 RETURN 1"),
-		Parses("CREATE FUNCTION name ( IN FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter TO value ROWS 10 RETURN 1"),
+		Converts("CREATE FUNCTION name ( IN FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter TO value ROWS 10 RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 = default_expr , INOUT FLOAT8 ) RETURNS SETOF rettype SET configuration_parameter TO value ROWS 10 RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype SET configuration_parameter TO value ROWS 10 RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SET configuration_parameter TO value ROWS 10 RETURN 1"),
@@ -9273,13 +9273,13 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) AS ' definition ' ROWS 10 RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype AS ' definition ' ROWS 10 RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) AS ' definition ' ROWS 10 RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 , IN argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' ROWS 10 RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 , IN argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' ROWS 10 RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 , INOUT argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) AS ' obj_file ' , ' link_symbol ' ROWS 10 RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN argname FLOAT8 , INOUT argname FLOAT8 ) LANGUAGE lang_name SUPPORT support_function RETURN 1"),
 		Parses("CREATE FUNCTION name ( FLOAT8 = default_expr , FLOAT8 = default_expr ) LANGUAGE lang_name SUPPORT support_function RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) LANGUAGE lang_name SUPPORT support_function RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 = default_expr , VARIADIC FLOAT8 ) RETURNS TABLE ( column_name column_type ) LANGUAGE lang_name SUPPORT support_function RETURN 1"),
-		Parses("CREATE FUNCTION name ( OUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SUPPORT support_function RETURN 1"),
+		Converts("CREATE FUNCTION name ( OUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SUPPORT support_function RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SUPPORT support_function RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) RETURNS rettype TRANSFORM FOR TYPE type_name SUPPORT support_function RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) RETURNS rettype TRANSFORM FOR TYPE type_name SUPPORT support_function RETURN 1"),
@@ -9314,7 +9314,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype LEAKPROOF SUPPORT support_function RETURN 1"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 = default_expr , IN argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) LEAKPROOF SUPPORT support_function RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) LEAKPROOF SUPPORT support_function RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) NOT LEAKPROOF SUPPORT support_function RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) NOT LEAKPROOF SUPPORT support_function RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , VARIADIC FLOAT8 = default_expr ) RETURNS rettype NOT LEAKPROOF SUPPORT support_function RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) NOT LEAKPROOF SUPPORT support_function RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) NOT LEAKPROOF SUPPORT support_function RETURN 1"),
@@ -9336,7 +9336,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) RETURNS SETOF rettype EXTERNAL SECURITY INVOKER SUPPORT support_function RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 , OUT argname FLOAT8 ) SECURITY DEFINER SUPPORT support_function RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SECURITY DEFINER SUPPORT support_function RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SECURITY DEFINER SUPPORT support_function RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SECURITY DEFINER SUPPORT support_function RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) RETURNS rettype SECURITY DEFINER SUPPORT support_function RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) SECURITY DEFINER SUPPORT support_function RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 , IN FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) SECURITY DEFINER SUPPORT support_function RETURN 1"),
@@ -9559,7 +9559,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) STABLE SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 , FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) STABLE SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 , IN FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) STABLE SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) VOLATILE SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) VOLATILE SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , FLOAT8 ) RETURNS rettype VOLATILE SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) VOLATILE SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 , INOUT FLOAT8 = default_expr ) RETURNS rettype LEAKPROOF SET configuration_parameter = value RETURN 1"),
@@ -9569,7 +9569,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) NOT LEAKPROOF SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) NOT LEAKPROOF SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 , VARIADIC FLOAT8 ) RETURNS rettype NOT LEAKPROOF SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 , IN FLOAT8 ) CALLED ON NULL INPUT SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 , IN FLOAT8 ) CALLED ON NULL INPUT SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS rettype CALLED ON NULL INPUT SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) CALLED ON NULL INPUT SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 , FLOAT8 ) RETURNS TABLE ( column_name column_type ) RETURNS NULL ON NULL INPUT SET configuration_parameter = value RETURN 1"),
@@ -9672,7 +9672,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 ) RETURNS SETOF rettype LEAKPROOF SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS SETOF rettype LEAKPROOF SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) LEAKPROOF SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 , argname FLOAT8 = default_expr ) NOT LEAKPROOF SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 , argname FLOAT8 = default_expr ) NOT LEAKPROOF SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) RETURNS rettype NOT LEAKPROOF SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) RETURNS SETOF rettype NOT LEAKPROOF SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) NOT LEAKPROOF SET configuration_parameter FROM CURRENT RETURN 1"),
@@ -9705,7 +9705,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( argname FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 , IN FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE FUNCTION name ( IN FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE FUNCTION name ( IN FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) RETURNS TABLE ( column_name column_type ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE FUNCTION name ( FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
@@ -9731,7 +9731,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) PARALLEL SAFE SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) PARALLEL SAFE SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) COST 10 SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE FUNCTION name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) COST 10 SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE FUNCTION name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) COST 10 SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE FUNCTION name ( FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) RETURNS rettype COST 10 SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) COST 10 SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype ROWS 10 SET configuration_parameter FROM CURRENT RETURN 1"),
@@ -9780,7 +9780,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 , OUT FLOAT8 = default_expr ) RETURNS SETOF rettype TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
-		Parses("CREATE FUNCTION name ( OUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) WINDOW AS ' definition ' RETURN 1"),
+		Converts("CREATE FUNCTION name ( OUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) WINDOW AS ' definition ' RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) RETURNS rettype WINDOW AS ' definition ' RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype WINDOW AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 , IN argname FLOAT8 = default_expr ) RETURNS SETOF rettype WINDOW AS ' definition ' RETURN 1"),
@@ -9793,7 +9793,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 = default_expr , OUT argname FLOAT8 ) RETURNS TABLE ( column_name column_type , column_name column_type ) IMMUTABLE AS ' definition ' RETURN 1"),
 		Parses("CREATE FUNCTION name ( argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) IMMUTABLE AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 , VARIADIC FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) STABLE AS ' definition ' RETURN 1"),
-		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) VOLATILE AS ' definition ' RETURN 1"),
+		Converts("CREATE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) VOLATILE AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype VOLATILE AS ' definition ' RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 = default_expr , OUT argname FLOAT8 ) RETURNS SETOF rettype VOLATILE AS ' definition ' RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) VOLATILE AS ' definition ' RETURN 1"),
@@ -9802,8 +9802,8 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) RETURNS rettype LEAKPROOF AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) RETURNS rettype LEAKPROOF AS ' definition ' RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT argname FLOAT8 , IN argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) LEAKPROOF AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) NOT LEAKPROOF AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 , INOUT argname FLOAT8 = default_expr ) NOT LEAKPROOF AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) NOT LEAKPROOF AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( FLOAT8 , INOUT argname FLOAT8 = default_expr ) NOT LEAKPROOF AS ' definition ' RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) NOT LEAKPROOF AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) RETURNS SETOF rettype CALLED ON NULL INPUT AS ' definition ' RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype CALLED ON NULL INPUT AS ' definition ' RETURN 1"),
@@ -9829,7 +9829,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) SECURITY INVOKER AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SECURITY INVOKER AS ' definition ' RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SECURITY INVOKER AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 ) EXTERNAL SECURITY INVOKER AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 ) EXTERNAL SECURITY INVOKER AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 DEFAULT default_expr , IN FLOAT8 ) EXTERNAL SECURITY INVOKER AS ' definition ' RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 = default_expr , IN FLOAT8 ) RETURNS SETOF rettype EXTERNAL SECURITY INVOKER AS ' definition ' RETURN 1"),
@@ -9838,7 +9838,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype SECURITY DEFINER AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 , argname FLOAT8 = default_expr ) RETURNS SETOF rettype SECURITY DEFINER AS ' definition ' RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type ) SECURITY DEFINER AS ' definition ' RETURN 1"),
-		Parses("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' RETURN 1"),
+		Converts("CREATE FUNCTION name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) RETURNS rettype EXTERNAL SECURITY DEFINER AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 = default_expr , INOUT FLOAT8 ) RETURNS SETOF rettype EXTERNAL SECURITY DEFINER AS ' definition ' RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype EXTERNAL SECURITY DEFINER AS ' definition ' RETURN 1"),
@@ -9923,7 +9923,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) RETURNS rettype LEAKPROOF AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC FLOAT8 , OUT argname FLOAT8 ) RETURNS SETOF rettype LEAKPROOF AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( IN FLOAT8 , OUT FLOAT8 = default_expr ) RETURNS SETOF rettype LEAKPROOF AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) NOT LEAKPROOF AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) NOT LEAKPROOF AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 , FLOAT8 = default_expr ) NOT LEAKPROOF AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) RETURNS SETOF rettype NOT LEAKPROOF AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE FUNCTION name ( INOUT FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type ) NOT LEAKPROOF AS ' obj_file ' , ' link_symbol ' RETURN 1"),
@@ -9988,7 +9988,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) ROWS 10 AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) RETURNS SETOF rettype ROWS 10 AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) ROWS 10 AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE FUNCTION name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SUPPORT support_function AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE FUNCTION name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SUPPORT support_function AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) RETURNS rettype SUPPORT support_function AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) RETURNS SETOF rettype SUPPORT support_function AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 , OUT FLOAT8 ) RETURNS TABLE ( column_name column_type ) SUPPORT support_function AS ' obj_file ' , ' link_symbol ' RETURN 1"),
@@ -10005,7 +10005,7 @@ func TestCreateFunction(t *testing.T) {
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) RETURNS TABLE ( column_name column_type , column_name column_type ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE FUNCTION name ( IN argname FLOAT8 , OUT argname FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE FUNCTION name ( OUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) RETURNS rettype SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE FUNCTION name ( INOUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE FUNCTION name ( INOUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE FUNCTION name ( OUT FLOAT8 , VARIADIC argname FLOAT8 ) RETURNS SETOF rettype AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) RETURNS SETOF rettype AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE FUNCTION name ( VARIADIC FLOAT8 , INOUT argname FLOAT8 = default_expr ) RETURNS SETOF rettype AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
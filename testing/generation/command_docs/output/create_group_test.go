@@ -18,43 +18,43 @@ import "testing"
 
 func TestCreateGroup(t *testing.T) {
 	tests := []QueryParses{
-		Parses("CREATE GROUP name"),
-		Unimplemented("CREATE GROUP name SUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH SUPERUSER"),
-		Unimplemented("CREATE GROUP name NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH NOSUPERUSER"),
-		Parses("CREATE GROUP name CREATEDB"),
-		Parses("CREATE GROUP name WITH CREATEDB"),
-		Parses("CREATE GROUP name NOCREATEDB"),
-		Parses("CREATE GROUP name WITH NOCREATEDB"),
-		Parses("CREATE GROUP name CREATEROLE"),
-		Parses("CREATE GROUP name WITH CREATEROLE"),
-		Parses("CREATE GROUP name NOCREATEROLE"),
-		Parses("CREATE GROUP name WITH NOCREATEROLE"),
-		Unimplemented("CREATE GROUP name INHERIT"),
-		Unimplemented("CREATE GROUP name WITH INHERIT"),
-		Unimplemented("CREATE GROUP name NOINHERIT"),
-		Unimplemented("CREATE GROUP name WITH NOINHERIT"),
-		Parses("CREATE GROUP name LOGIN"),
-		Parses("CREATE GROUP name WITH LOGIN"),
-		Parses("CREATE GROUP name NOLOGIN"),
-		Parses("CREATE GROUP name WITH NOLOGIN"),
-		Unimplemented("CREATE GROUP name REPLICATION"),
-		Unimplemented("CREATE GROUP name WITH REPLICATION"),
-		Unimplemented("CREATE GROUP name NOREPLICATION"),
-		Unimplemented("CREATE GROUP name WITH NOREPLICATION"),
-		Unimplemented("CREATE GROUP name BYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH BYPASSRLS"),
-		Unimplemented("CREATE GROUP name NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name WITH CONNECTION LIMIT -1"),
-		Parses("CREATE GROUP name PASSWORD ' password '"),
-		Parses("CREATE GROUP name WITH PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password '"),
-		Parses("CREATE GROUP name PASSWORD NULL"),
-		Parses("CREATE GROUP name WITH PASSWORD NULL"),
+		Converts("CREATE GROUP name"),
+		Converts("CREATE GROUP name SUPERUSER"),
+		Converts("CREATE GROUP name WITH SUPERUSER"),
+		Converts("CREATE GROUP name NOSUPERUSER"),
+		Converts("CREATE GROUP name WITH NOSUPERUSER"),
+		Converts("CREATE GROUP name CREATEDB"),
+		Converts("CREATE GROUP name WITH CREATEDB"),
+		Converts("CREATE GROUP name NOCREATEDB"),
+		Converts("CREATE GROUP name WITH NOCREATEDB"),
+		Converts("CREATE GROUP name CREATEROLE"),
+		Converts("CREATE GROUP name WITH CREATEROLE"),
+		Converts("CREATE GROUP name NOCREATEROLE"),
+		Converts("CREATE GROUP name WITH NOCREATEROLE"),
+		Converts("CREATE GROUP name INHERIT"),
+		Converts("CREATE GROUP name WITH INHERIT"),
+		Converts("CREATE GROUP name NOINHERIT"),
+		Converts("CREATE GROUP name WITH NOINHERIT"),
+		Converts("CREATE GROUP name LOGIN"),
+		Converts("CREATE GROUP name WITH LOGIN"),
+		Converts("CREATE GROUP name NOLOGIN"),
+		Converts("CREATE GROUP name WITH NOLOGIN"),
+		Converts("CREATE GROUP name REPLICATION"),
+		Converts("CREATE GROUP name WITH REPLICATION"),
+		Converts("CREATE GROUP name NOREPLICATION"),
+		Converts("CREATE GROUP name WITH NOREPLICATION"),
+		Converts("CREATE GROUP name BYPASSRLS"),
+		Converts("CREATE GROUP name WITH BYPASSRLS"),
+		Converts("CREATE GROUP name NOBYPASSRLS"),
+		Converts("CREATE GROUP name WITH NOBYPASSRLS"),
+		Converts("CREATE GROUP name CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name WITH CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH PASSWORD ' password '"),
+		Converts("CREATE GROUP name ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name PASSWORD NULL"),
+		Converts("CREATE GROUP name WITH PASSWORD NULL"),
 		Parses("CREATE GROUP name VALID UNTIL ' timestamp '"),
 		Parses("CREATE GROUP name WITH VALID UNTIL ' timestamp '"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name"),
@@ -79,44 +79,44 @@ func TestCreateGroup(t *testing.T) {
 		Unimplemented("CREATE GROUP name WITH USER role_name , role_name"),
 		Unimplemented("CREATE GROUP name SYSID 1"),
 		Unimplemented("CREATE GROUP name WITH SYSID 1"),
-		Unimplemented("CREATE GROUP name SUPERUSER SUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH SUPERUSER SUPERUSER"),
-		Unimplemented("CREATE GROUP name NOSUPERUSER SUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH NOSUPERUSER SUPERUSER"),
-		Unimplemented("CREATE GROUP name CREATEDB SUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH CREATEDB SUPERUSER"),
-		Unimplemented("CREATE GROUP name NOCREATEDB SUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH NOCREATEDB SUPERUSER"),
-		Unimplemented("CREATE GROUP name CREATEROLE SUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH CREATEROLE SUPERUSER"),
-		Unimplemented("CREATE GROUP name NOCREATEROLE SUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH NOCREATEROLE SUPERUSER"),
-		Unimplemented("CREATE GROUP name INHERIT SUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH INHERIT SUPERUSER"),
-		Unimplemented("CREATE GROUP name NOINHERIT SUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH NOINHERIT SUPERUSER"),
-		Unimplemented("CREATE GROUP name LOGIN SUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH LOGIN SUPERUSER"),
-		Unimplemented("CREATE GROUP name NOLOGIN SUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH NOLOGIN SUPERUSER"),
-		Unimplemented("CREATE GROUP name REPLICATION SUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH REPLICATION SUPERUSER"),
-		Unimplemented("CREATE GROUP name NOREPLICATION SUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH NOREPLICATION SUPERUSER"),
-		Unimplemented("CREATE GROUP name BYPASSRLS SUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH BYPASSRLS SUPERUSER"),
-		Unimplemented("CREATE GROUP name NOBYPASSRLS SUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH NOBYPASSRLS SUPERUSER"),
-		Unimplemented("CREATE GROUP name CONNECTION LIMIT -1 SUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH CONNECTION LIMIT -1 SUPERUSER"),
-		Unimplemented("CREATE GROUP name PASSWORD ' password ' SUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH PASSWORD ' password ' SUPERUSER"),
-		Unimplemented("CREATE GROUP name ENCRYPTED PASSWORD ' password ' SUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' SUPERUSER"),
-		Unimplemented("CREATE GROUP name PASSWORD NULL SUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH PASSWORD NULL SUPERUSER"),
-		Unimplemented("CREATE GROUP name VALID UNTIL ' timestamp ' SUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH VALID UNTIL ' timestamp ' SUPERUSER"),
+		Parses("CREATE GROUP name SUPERUSER SUPERUSER"),
+		Parses("CREATE GROUP name WITH SUPERUSER SUPERUSER"),
+		Parses("CREATE GROUP name NOSUPERUSER SUPERUSER"),
+		Parses("CREATE GROUP name WITH NOSUPERUSER SUPERUSER"),
+		Converts("CREATE GROUP name CREATEDB SUPERUSER"),
+		Converts("CREATE GROUP name WITH CREATEDB SUPERUSER"),
+		Converts("CREATE GROUP name NOCREATEDB SUPERUSER"),
+		Converts("CREATE GROUP name WITH NOCREATEDB SUPERUSER"),
+		Converts("CREATE GROUP name CREATEROLE SUPERUSER"),
+		Converts("CREATE GROUP name WITH CREATEROLE SUPERUSER"),
+		Converts("CREATE GROUP name NOCREATEROLE SUPERUSER"),
+		Converts("CREATE GROUP name WITH NOCREATEROLE SUPERUSER"),
+		Converts("CREATE GROUP name INHERIT SUPERUSER"),
+		Converts("CREATE GROUP name WITH INHERIT SUPERUSER"),
+		Converts("CREATE GROUP name NOINHERIT SUPERUSER"),
+		Converts("CREATE GROUP name WITH NOINHERIT SUPERUSER"),
+		Converts("CREATE GROUP name LOGIN SUPERUSER"),
+		Converts("CREATE GROUP name WITH LOGIN SUPERUSER"),
+		Converts("CREATE GROUP name NOLOGIN SUPERUSER"),
+		Converts("CREATE GROUP name WITH NOLOGIN SUPERUSER"),
+		Converts("CREATE GROUP name REPLICATION SUPERUSER"),
+		Converts("CREATE GROUP name WITH REPLICATION SUPERUSER"),
+		Converts("CREATE GROUP name NOREPLICATION SUPERUSER"),
+		Converts("CREATE GROUP name WITH NOREPLICATION SUPERUSER"),
+		Converts("CREATE GROUP name BYPASSRLS SUPERUSER"),
+		Converts("CREATE GROUP name WITH BYPASSRLS SUPERUSER"),
+		Converts("CREATE GROUP name NOBYPASSRLS SUPERUSER"),
+		Converts("CREATE GROUP name WITH NOBYPASSRLS SUPERUSER"),
+		Converts("CREATE GROUP name CONNECTION LIMIT -1 SUPERUSER"),
+		Converts("CREATE GROUP name WITH CONNECTION LIMIT -1 SUPERUSER"),
+		Converts("CREATE GROUP name PASSWORD ' password ' SUPERUSER"),
+		Converts("CREATE GROUP name WITH PASSWORD ' password ' SUPERUSER"),
+		Converts("CREATE GROUP name ENCRYPTED PASSWORD ' password ' SUPERUSER"),
+		Converts("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' SUPERUSER"),
+		Converts("CREATE GROUP name PASSWORD NULL SUPERUSER"),
+		Converts("CREATE GROUP name WITH PASSWORD NULL SUPERUSER"),
+		Parses("CREATE GROUP name VALID UNTIL ' timestamp ' SUPERUSER"),
+		Parses("CREATE GROUP name WITH VALID UNTIL ' timestamp ' SUPERUSER"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name SUPERUSER"),
 		Unimplemented("CREATE GROUP name WITH IN ROLE role_name SUPERUSER"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name , role_name SUPERUSER"),
@@ -139,44 +139,44 @@ func TestCreateGroup(t *testing.T) {
 		Unimplemented("CREATE GROUP name WITH USER role_name , role_name SUPERUSER"),
 		Unimplemented("CREATE GROUP name SYSID 1 SUPERUSER"),
 		Unimplemented("CREATE GROUP name WITH SYSID 1 SUPERUSER"),
-		Unimplemented("CREATE GROUP name SUPERUSER NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH SUPERUSER NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name NOSUPERUSER NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH NOSUPERUSER NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name CREATEDB NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH CREATEDB NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name NOCREATEDB NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH NOCREATEDB NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name CREATEROLE NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH CREATEROLE NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name NOCREATEROLE NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH NOCREATEROLE NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name INHERIT NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH INHERIT NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name NOINHERIT NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH NOINHERIT NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name LOGIN NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH LOGIN NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name NOLOGIN NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH NOLOGIN NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name REPLICATION NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH REPLICATION NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name NOREPLICATION NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH NOREPLICATION NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name BYPASSRLS NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH BYPASSRLS NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name NOBYPASSRLS NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH NOBYPASSRLS NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name CONNECTION LIMIT -1 NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH CONNECTION LIMIT -1 NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name PASSWORD ' password ' NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH PASSWORD ' password ' NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name ENCRYPTED PASSWORD ' password ' NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name PASSWORD NULL NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH PASSWORD NULL NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name VALID UNTIL ' timestamp ' NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name WITH VALID UNTIL ' timestamp ' NOSUPERUSER"),
+		Parses("CREATE GROUP name SUPERUSER NOSUPERUSER"),
+		Parses("CREATE GROUP name WITH SUPERUSER NOSUPERUSER"),
+		Parses("CREATE GROUP name NOSUPERUSER NOSUPERUSER"),
+		Parses("CREATE GROUP name WITH NOSUPERUSER NOSUPERUSER"),
+		Converts("CREATE GROUP name CREATEDB NOSUPERUSER"),
+		Converts("CREATE GROUP name WITH CREATEDB NOSUPERUSER"),
+		Converts("CREATE GROUP name NOCREATEDB NOSUPERUSER"),
+		Converts("CREATE GROUP name WITH NOCREATEDB NOSUPERUSER"),
+		Converts("CREATE GROUP name CREATEROLE NOSUPERUSER"),
+		Converts("CREATE GROUP name WITH CREATEROLE NOSUPERUSER"),
+		Converts("CREATE GROUP name NOCREATEROLE NOSUPERUSER"),
+		Converts("CREATE GROUP name WITH NOCREATEROLE NOSUPERUSER"),
+		Converts("CREATE GROUP name INHERIT NOSUPERUSER"),
+		Converts("CREATE GROUP name WITH INHERIT NOSUPERUSER"),
+		Converts("CREATE GROUP name NOINHERIT NOSUPERUSER"),
+		Converts("CREATE GROUP name WITH NOINHERIT NOSUPERUSER"),
+		Converts("CREATE GROUP name LOGIN NOSUPERUSER"),
+		Converts("CREATE GROUP name WITH LOGIN NOSUPERUSER"),
+		Converts("CREATE GROUP name NOLOGIN NOSUPERUSER"),
+		Converts("CREATE GROUP name WITH NOLOGIN NOSUPERUSER"),
+		Converts("CREATE GROUP name REPLICATION NOSUPERUSER"),
+		Converts("CREATE GROUP name WITH REPLICATION NOSUPERUSER"),
+		Converts("CREATE GROUP name NOREPLICATION NOSUPERUSER"),
+		Converts("CREATE GROUP name WITH NOREPLICATION NOSUPERUSER"),
+		Converts("CREATE GROUP name BYPASSRLS NOSUPERUSER"),
+		Converts("CREATE GROUP name WITH BYPASSRLS NOSUPERUSER"),
+		Converts("CREATE GROUP name NOBYPASSRLS NOSUPERUSER"),
+		Converts("CREATE GROUP name WITH NOBYPASSRLS NOSUPERUSER"),
+		Converts("CREATE GROUP name CONNECTION LIMIT -1 NOSUPERUSER"),
+		Converts("CREATE GROUP name WITH CONNECTION LIMIT -1 NOSUPERUSER"),
+		Converts("CREATE GROUP name PASSWORD ' password ' NOSUPERUSER"),
+		Converts("CREATE GROUP name WITH PASSWORD ' password ' NOSUPERUSER"),
+		Converts("CREATE GROUP name ENCRYPTED PASSWORD ' password ' NOSUPERUSER"),
+		Converts("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' NOSUPERUSER"),
+		Converts("CREATE GROUP name PASSWORD NULL NOSUPERUSER"),
+		Converts("CREATE GROUP name WITH PASSWORD NULL NOSUPERUSER"),
+		Parses("CREATE GROUP name VALID UNTIL ' timestamp ' NOSUPERUSER"),
+		Parses("CREATE GROUP name WITH VALID UNTIL ' timestamp ' NOSUPERUSER"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name NOSUPERUSER"),
 		Unimplemented("CREATE GROUP name WITH IN ROLE role_name NOSUPERUSER"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name , role_name NOSUPERUSER"),
@@ -199,42 +199,42 @@ func TestCreateGroup(t *testing.T) {
 		Unimplemented("CREATE GROUP name WITH USER role_name , role_name NOSUPERUSER"),
 		Unimplemented("CREATE GROUP name SYSID 1 NOSUPERUSER"),
 		Unimplemented("CREATE GROUP name WITH SYSID 1 NOSUPERUSER"),
-		Unimplemented("CREATE GROUP name SUPERUSER CREATEDB"),
-		Unimplemented("CREATE GROUP name WITH SUPERUSER CREATEDB"),
-		Unimplemented("CREATE GROUP name NOSUPERUSER CREATEDB"),
-		Unimplemented("CREATE GROUP name WITH NOSUPERUSER CREATEDB"),
+		Converts("CREATE GROUP name SUPERUSER CREATEDB"),
+		Converts("CREATE GROUP name WITH SUPERUSER CREATEDB"),
+		Converts("CREATE GROUP name NOSUPERUSER CREATEDB"),
+		Converts("CREATE GROUP name WITH NOSUPERUSER CREATEDB"),
 		Parses("CREATE GROUP name CREATEDB CREATEDB"),
 		Parses("CREATE GROUP name WITH CREATEDB CREATEDB"),
 		Parses("CREATE GROUP name NOCREATEDB CREATEDB"),
 		Parses("CREATE GROUP name WITH NOCREATEDB CREATEDB"),
-		Parses("CREATE GROUP name CREATEROLE CREATEDB"),
-		Parses("CREATE GROUP name WITH CREATEROLE CREATEDB"),
-		Parses("CREATE GROUP name NOCREATEROLE CREATEDB"),
-		Parses("CREATE GROUP name WITH NOCREATEROLE CREATEDB"),
-		Unimplemented("CREATE GROUP name INHERIT CREATEDB"),
-		Unimplemented("CREATE GROUP name WITH INHERIT CREATEDB"),
-		Unimplemented("CREATE GROUP name NOINHERIT CREATEDB"),
-		Unimplemented("CREATE GROUP name WITH NOINHERIT CREATEDB"),
-		Parses("CREATE GROUP name LOGIN CREATEDB"),
-		Parses("CREATE GROUP name WITH LOGIN CREATEDB"),
-		Parses("CREATE GROUP name NOLOGIN CREATEDB"),
-		Parses("CREATE GROUP name WITH NOLOGIN CREATEDB"),
-		Unimplemented("CREATE GROUP name REPLICATION CREATEDB"),
-		Unimplemented("CREATE GROUP name WITH REPLICATION CREATEDB"),
-		Unimplemented("CREATE GROUP name NOREPLICATION CREATEDB"),
-		Unimplemented("CREATE GROUP name WITH NOREPLICATION CREATEDB"),
-		Unimplemented("CREATE GROUP name BYPASSRLS CREATEDB"),
-		Unimplemented("CREATE GROUP name WITH BYPASSRLS CREATEDB"),
-		Unimplemented("CREATE GROUP name NOBYPASSRLS CREATEDB"),
-		Unimplemented("CREATE GROUP name WITH NOBYPASSRLS CREATEDB"),
-		Unimplemented("CREATE GROUP name CONNECTION LIMIT -1 CREATEDB"),
-		Unimplemented("CREATE GROUP name WITH CONNECTION LIMIT -1 CREATEDB"),
-		Parses("CREATE GROUP name PASSWORD ' password ' CREATEDB"),
-		Parses("CREATE GROUP name WITH PASSWORD ' password ' CREATEDB"),
-		Unimplemented("CREATE GROUP name ENCRYPTED PASSWORD ' password ' CREATEDB"),
-		Unimplemented("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' CREATEDB"),
-		Parses("CREATE GROUP name PASSWORD NULL CREATEDB"),
-		Parses("CREATE GROUP name WITH PASSWORD NULL CREATEDB"),
+		Converts("CREATE GROUP name CREATEROLE CREATEDB"),
+		Converts("CREATE GROUP name WITH CREATEROLE CREATEDB"),
+		Converts("CREATE GROUP name NOCREATEROLE CREATEDB"),
+		Converts("CREATE GROUP name WITH NOCREATEROLE CREATEDB"),
+		Converts("CREATE GROUP name INHERIT CREATEDB"),
+		Converts("CREATE GROUP name WITH INHERIT CREATEDB"),
+		Converts("CREATE GROUP name NOINHERIT CREATEDB"),
+		Converts("CREATE GROUP name WITH NOINHERIT CREATEDB"),
+		Converts("CREATE GROUP name LOGIN CREATEDB"),
+		Converts("CREATE GROUP name WITH LOGIN CREATEDB"),
+		Converts("CREATE GROUP name NOLOGIN CREATEDB"),
+		Converts("CREATE GROUP name WITH NOLOGIN CREATEDB"),
+		Converts("CREATE GROUP name REPLICATION CREATEDB"),
+		Converts("CREATE GROUP name WITH REPLICATION CREATEDB"),
+		Converts("CREATE GROUP name NOREPLICATION CREATEDB"),
+		Converts("CREATE GROUP name WITH NOREPLICATION CREATEDB"),
+		Converts("CREATE GROUP name BYPASSRLS CREATEDB"),
+		Converts("CREATE GROUP name WITH BYPASSRLS CREATEDB"),
+		Converts("CREATE GROUP name NOBYPASSRLS CREATEDB"),
+		Converts("CREATE GROUP name WITH NOBYPASSRLS CREATEDB"),
+		Converts("CREATE GROUP name CONNECTION LIMIT -1 CREATEDB"),
+		Converts("CREATE GROUP name WITH CONNECTION LIMIT -1 CREATEDB"),
+		Converts("CREATE GROUP name PASSWORD ' password ' CREATEDB"),
+		Converts("CREATE GROUP name WITH PASSWORD ' password ' CREATEDB"),
+		Converts("CREATE GROUP name ENCRYPTED PASSWORD ' password ' CREATEDB"),
+		Converts("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' CREATEDB"),
+		Converts("CREATE GROUP name PASSWORD NULL CREATEDB"),
+		Converts("CREATE GROUP name WITH PASSWORD NULL CREATEDB"),
 		Parses("CREATE GROUP name VALID UNTIL ' timestamp ' CREATEDB"),
 		Parses("CREATE GROUP name WITH VALID UNTIL ' timestamp ' CREATEDB"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name CREATEDB"),
@@ -259,42 +259,42 @@ func TestCreateGroup(t *testing.T) {
 		Unimplemented("CREATE GROUP name WITH USER role_name , role_name CREATEDB"),
 		Unimplemented("CREATE GROUP name SYSID 1 CREATEDB"),
 		Unimplemented("CREATE GROUP name WITH SYSID 1 CREATEDB"),
-		Unimplemented("CREATE GROUP name SUPERUSER NOCREATEDB"),
-		Unimplemented("CREATE GROUP name WITH SUPERUSER NOCREATEDB"),
-		Unimplemented("CREATE GROUP name NOSUPERUSER NOCREATEDB"),
-		Unimplemented("CREATE GROUP name WITH NOSUPERUSER NOCREATEDB"),
+		Converts("CREATE GROUP name SUPERUSER NOCREATEDB"),
+		Converts("CREATE GROUP name WITH SUPERUSER NOCREATEDB"),
+		Converts("CREATE GROUP name NOSUPERUSER NOCREATEDB"),
+		Converts("CREATE GROUP name WITH NOSUPERUSER NOCREATEDB"),
 		Parses("CREATE GROUP name CREATEDB NOCREATEDB"),
 		Parses("CREATE GROUP name WITH CREATEDB NOCREATEDB"),
 		Parses("CREATE GROUP name NOCREATEDB NOCREATEDB"),
 		Parses("CREATE GROUP name WITH NOCREATEDB NOCREATEDB"),
-		Parses("CREATE GROUP name CREATEROLE NOCREATEDB"),
-		Parses("CREATE GROUP name WITH CREATEROLE NOCREATEDB"),
-		Parses("CREATE GROUP name NOCREATEROLE NOCREATEDB"),
-		Parses("CREATE GROUP name WITH NOCREATEROLE NOCREATEDB"),
-		Unimplemented("CREATE GROUP name INHERIT NOCREATEDB"),
-		Unimplemented("CREATE GROUP name WITH INHERIT NOCREATEDB"),
-		Unimplemented("CREATE GROUP name NOINHERIT NOCREATEDB"),
-		Unimplemented("CREATE GROUP name WITH NOINHERIT NOCREATEDB"),
-		Parses("CREATE GROUP name LOGIN NOCREATEDB"),
-		Parses("CREATE GROUP name WITH LOGIN NOCREATEDB"),
-		Parses("CREATE GROUP name NOLOGIN NOCREATEDB"),
-		Parses("CREATE GROUP name WITH NOLOGIN NOCREATEDB"),
-		Unimplemented("CREATE GROUP name REPLICATION NOCREATEDB"),
-		Unimplemented("CREATE GROUP name WITH REPLICATION NOCREATEDB"),
-		Unimplemented("CREATE GROUP name NOREPLICATION NOCREATEDB"),
-		Unimplemented("CREATE GROUP name WITH NOREPLICATION NOCREATEDB"),
-		Unimplemented("CREATE GROUP name BYPASSRLS NOCREATEDB"),
-		Unimplemented("CREATE GROUP name WITH BYPASSRLS NOCREATEDB"),
-		Unimplemented("CREATE GROUP name NOBYPASSRLS NOCREATEDB"),
-		Unimplemented("CREATE GROUP name WITH NOBYPASSRLS NOCREATEDB"),
-		Unimplemented("CREATE GROUP name CONNECTION LIMIT -1 NOCREATEDB"),
-		Unimplemented("CREATE GROUP name WITH CONNECTION LIMIT -1 NOCREATEDB"),
-		Parses("CREATE GROUP name PASSWORD ' password ' NOCREATEDB"),
-		Parses("CREATE GROUP name WITH PASSWORD ' password ' NOCREATEDB"),
-		Unimplemented("CREATE GROUP name ENCRYPTED PASSWORD ' password ' NOCREATEDB"),
-		Unimplemented("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' NOCREATEDB"),
-		Parses("CREATE GROUP name PASSWORD NULL NOCREATEDB"),
-		Parses("CREATE GROUP name WITH PASSWORD NULL NOCREATEDB"),
+		Converts("CREATE GROUP name CREATEROLE NOCREATEDB"),
+		Converts("CREATE GROUP name WITH CREATEROLE NOCREATEDB"),
+		Converts("CREATE GROUP name NOCREATEROLE NOCREATEDB"),
+		Converts("CREATE GROUP name WITH NOCREATEROLE NOCREATEDB"),
+		Converts("CREATE GROUP name INHERIT NOCREATEDB"),
+		Converts("CREATE GROUP name WITH INHERIT NOCREATEDB"),
+		Converts("CREATE GROUP name NOINHERIT NOCREATEDB"),
+		Converts("CREATE GROUP name WITH NOINHERIT NOCREATEDB"),
+		Converts("CREATE GROUP name LOGIN NOCREATEDB"),
+		Converts("CREATE GROUP name WITH LOGIN NOCREATEDB"),
+		Converts("CREATE GROUP name NOLOGIN NOCREATEDB"),
+		Converts("CREATE GROUP name WITH NOLOGIN NOCREATEDB"),
+		Converts("CREATE GROUP name REPLICATION NOCREATEDB"),
+		Converts("CREATE GROUP name WITH REPLICATION NOCREATEDB"),
+		Converts("CREATE GROUP name NOREPLICATION NOCREATEDB"),
+		Converts("CREATE GROUP name WITH NOREPLICATION NOCREATEDB"),
+		Converts("CREATE GROUP name BYPASSRLS NOCREATEDB"),
+		Converts("CREATE GROUP name WITH BYPASSRLS NOCREATEDB"),
+		Converts("CREATE GROUP name NOBYPASSRLS NOCREATEDB"),
+		Converts("CREATE GROUP name WITH NOBYPASSRLS NOCREATEDB"),
+		Converts("CREATE GROUP name CONNECTION LIMIT -1 NOCREATEDB"),
+		Converts("CREATE GROUP name WITH CONNECTION LIMIT -1 NOCREATEDB"),
+		Converts("CREATE GROUP name PASSWORD ' password ' NOCREATEDB"),
+		Converts("CREATE GROUP name WITH PASSWORD ' password ' NOCREATEDB"),
+		Converts("CREATE GROUP name ENCRYPTED PASSWORD ' password ' NOCREATEDB"),
+		Converts("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' NOCREATEDB"),
+		Converts("CREATE GROUP name PASSWORD NULL NOCREATEDB"),
+		Converts("CREATE GROUP name WITH PASSWORD NULL NOCREATEDB"),
 		Parses("CREATE GROUP name VALID UNTIL ' timestamp ' NOCREATEDB"),
 		Parses("CREATE GROUP name WITH VALID UNTIL ' timestamp ' NOCREATEDB"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name NOCREATEDB"),
@@ -319,42 +319,42 @@ func TestCreateGroup(t *testing.T) {
 		Unimplemented("CREATE GROUP name WITH USER role_name , role_name NOCREATEDB"),
 		Unimplemented("CREATE GROUP name SYSID 1 NOCREATEDB"),
 		Unimplemented("CREATE GROUP name WITH SYSID 1 NOCREATEDB"),
-		Unimplemented("CREATE GROUP name SUPERUSER CREATEROLE"),
-		Unimplemented("CREATE GROUP name WITH SUPERUSER CREATEROLE"),
-		Unimplemented("CREATE GROUP name NOSUPERUSER CREATEROLE"),
-		Unimplemented("CREATE GROUP name WITH NOSUPERUSER CREATEROLE"),
-		Parses("CREATE GROUP name CREATEDB CREATEROLE"),
-		Parses("CREATE GROUP name WITH CREATEDB CREATEROLE"),
-		Parses("CREATE GROUP name NOCREATEDB CREATEROLE"),
-		Parses("CREATE GROUP name WITH NOCREATEDB CREATEROLE"),
+		Converts("CREATE GROUP name SUPERUSER CREATEROLE"),
+		Converts("CREATE GROUP name WITH SUPERUSER CREATEROLE"),
+		Converts("CREATE GROUP name NOSUPERUSER CREATEROLE"),
+		Converts("CREATE GROUP name WITH NOSUPERUSER CREATEROLE"),
+		Converts("CREATE GROUP name CREATEDB CREATEROLE"),
+		Converts("CREATE GROUP name WITH CREATEDB CREATEROLE"),
+		Converts("CREATE GROUP name NOCREATEDB CREATEROLE"),
+		Converts("CREATE GROUP name WITH NOCREATEDB CREATEROLE"),
 		Parses("CREATE GROUP name CREATEROLE CREATEROLE"),
 		Parses("CREATE GROUP name WITH CREATEROLE CREATEROLE"),
 		Parses("CREATE GROUP name NOCREATEROLE CREATEROLE"),
 		Parses("CREATE GROUP name WITH NOCREATEROLE CREATEROLE"),
-		Unimplemented("CREATE GROUP name INHERIT CREATEROLE"),
-		Unimplemented("CREATE GROUP name WITH INHERIT CREATEROLE"),
-		Unimplemented("CREATE GROUP name NOINHERIT CREATEROLE"),
-		Unimplemented("CREATE GROUP name WITH NOINHERIT CREATEROLE"),
-		Parses("CREATE GROUP name LOGIN CREATEROLE"),
-		Parses("CREATE GROUP name WITH LOGIN CREATEROLE"),
-		Parses("CREATE GROUP name NOLOGIN CREATEROLE"),
-		Parses("CREATE GROUP name WITH NOLOGIN CREATEROLE"),
-		Unimplemented("CREATE GROUP name REPLICATION CREATEROLE"),
-		Unimplemented("CREATE GROUP name WITH REPLICATION CREATEROLE"),
-		Unimplemented("CREATE GROUP name NOREPLICATION CREATEROLE"),
-		Unimplemented("CREATE GROUP name WITH NOREPLICATION CREATEROLE"),
-		Unimplemented("CREATE GROUP name BYPASSRLS CREATEROLE"),
-		Unimplemented("CREATE GROUP name WITH BYPASSRLS CREATEROLE"),
-		Unimplemented("CREATE GROUP name NOBYPASSRLS CREATEROLE"),
-		Unimplemented("CREATE GROUP name WITH NOBYPASSRLS CREATEROLE"),
-		Unimplemented("CREATE GROUP name CONNECTION LIMIT -1 CREATEROLE"),
-		Unimplemented("CREATE GROUP name WITH CONNECTION LIMIT -1 CREATEROLE"),
-		Parses("CREATE GROUP name PASSWORD ' password ' CREATEROLE"),
-		Parses("CREATE GROUP name WITH PASSWORD ' password ' CREATEROLE"),
-		Unimplemented("CREATE GROUP name ENCRYPTED PASSWORD ' password ' CREATEROLE"),
-		Unimplemented("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' CREATEROLE"),
-		Parses("CREATE GROUP name PASSWORD NULL CREATEROLE"),
-		Parses("CREATE GROUP name WITH PASSWORD NULL CREATEROLE"),
+		Converts("CREATE GROUP name INHERIT CREATEROLE"),
+		Converts("CREATE GROUP name WITH INHERIT CREATEROLE"),
+		Converts("CREATE GROUP name NOINHERIT CREATEROLE"),
+		Converts("CREATE GROUP name WITH NOINHERIT CREATEROLE"),
+		Converts("CREATE GROUP name LOGIN CREATEROLE"),
+		Converts("CREATE GROUP name WITH LOGIN CREATEROLE"),
+		Converts("CREATE GROUP name NOLOGIN CREATEROLE"),
+		Converts("CREATE GROUP name WITH NOLOGIN CREATEROLE"),
+		Converts("CREATE GROUP name REPLICATION CREATEROLE"),
+		Converts("CREATE GROUP name WITH REPLICATION CREATEROLE"),
+		Converts("CREATE GROUP name NOREPLICATION CREATEROLE"),
+		Converts("CREATE GROUP name WITH NOREPLICATION CREATEROLE"),
+		Converts("CREATE GROUP name BYPASSRLS CREATEROLE"),
+		Converts("CREATE GROUP name WITH BYPASSRLS CREATEROLE"),
+		Converts("CREATE GROUP name NOBYPASSRLS CREATEROLE"),
+		Converts("CREATE GROUP name WITH NOBYPASSRLS CREATEROLE"),
+		Converts("CREATE GROUP name CONNECTION LIMIT -1 CREATEROLE"),
+		Converts("CREATE GROUP name WITH CONNECTION LIMIT -1 CREATEROLE"),
+		Converts("CREATE GROUP name PASSWORD ' password ' CREATEROLE"),
+		Converts("CREATE GROUP name WITH PASSWORD ' password ' CREATEROLE"),
+		Converts("CREATE GROUP name ENCRYPTED PASSWORD ' password ' CREATEROLE"),
+		Converts("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' CREATEROLE"),
+		Converts("CREATE GROUP name PASSWORD NULL CREATEROLE"),
+		Converts("CREATE GROUP name WITH PASSWORD NULL CREATEROLE"),
 		Parses("CREATE GROUP name VALID UNTIL ' timestamp ' CREATEROLE"),
 		Parses("CREATE GROUP name WITH VALID UNTIL ' timestamp ' CREATEROLE"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name CREATEROLE"),
@@ -379,42 +379,42 @@ func TestCreateGroup(t *testing.T) {
 		Unimplemented("CREATE GROUP name WITH USER role_name , role_name CREATEROLE"),
 		Unimplemented("CREATE GROUP name SYSID 1 CREATEROLE"),
 		Unimplemented("CREATE GROUP name WITH SYSID 1 CREATEROLE"),
-		Unimplemented("CREATE GROUP name SUPERUSER NOCREATEROLE"),
-		Unimplemented("CREATE GROUP name WITH SUPERUSER NOCREATEROLE"),
-		Unimplemented("CREATE GROUP name NOSUPERUSER NOCREATEROLE"),
-		Unimplemented("CREATE GROUP name WITH NOSUPERUSER NOCREATEROLE"),
-		Parses("CREATE GROUP name CREATEDB NOCREATEROLE"),
-		Parses("CREATE GROUP name WITH CREATEDB NOCREATEROLE"),
-		Parses("CREATE GROUP name NOCREATEDB NOCREATEROLE"),
-		Parses("CREATE GROUP name WITH NOCREATEDB NOCREATEROLE"),
+		Converts("CREATE GROUP name SUPERUSER NOCREATEROLE"),
+		Converts("CREATE GROUP name WITH SUPERUSER NOCREATEROLE"),
+		Converts("CREATE GROUP name NOSUPERUSER NOCREATEROLE"),
+		Converts("CREATE GROUP name WITH NOSUPERUSER NOCREATEROLE"),
+		Converts("CREATE GROUP name CREATEDB NOCREATEROLE"),
+		Converts("CREATE GROUP name WITH CREATEDB NOCREATEROLE"),
+		Converts("CREATE GROUP name NOCREATEDB NOCREATEROLE"),
+		Converts("CREATE GROUP name WITH NOCREATEDB NOCREATEROLE"),
 		Parses("CREATE GROUP name CREATEROLE NOCREATEROLE"),
 		Parses("CREATE GROUP name WITH CREATEROLE NOCREATEROLE"),
 		Parses("CREATE GROUP name NOCREATEROLE NOCREATEROLE"),
 		Parses("CREATE GROUP name WITH NOCREATEROLE NOCREATEROLE"),
-		Unimplemented("CREATE GROUP name INHERIT NOCREATEROLE"),
-		Unimplemented("CREATE GROUP name WITH INHERIT NOCREATEROLE"),
-		Unimplemented("CREATE GROUP name NOINHERIT NOCREATEROLE"),
-		Unimplemented("CREATE GROUP name WITH NOINHERIT NOCREATEROLE"),
-		Parses("CREATE GROUP name LOGIN NOCREATEROLE"),
-		Parses("CREATE GROUP name WITH LOGIN NOCREATEROLE"),
-		Parses("CREATE GROUP name NOLOGIN NOCREATEROLE"),
-		Parses("CREATE GROUP name WITH NOLOGIN NOCREATEROLE"),
-		Unimplemented("CREATE GROUP name REPLICATION NOCREATEROLE"),
-		Unimplemented("CREATE GROUP name WITH REPLICATION NOCREATEROLE"),
-		Unimplemented("CREATE GROUP name NOREPLICATION NOCREATEROLE"),
-		Unimplemented("CREATE GROUP name WITH NOREPLICATION NOCREATEROLE"),
-		Unimplemented("CREATE GROUP name BYPASSRLS NOCREATEROLE"),
-		Unimplemented("CREATE GROUP name WITH BYPASSRLS NOCREATEROLE"),
-		Unimplemented("CREATE GROUP name NOBYPASSRLS NOCREATEROLE"),
-		Unimplemented("CREATE GROUP name WITH NOBYPASSRLS NOCREATEROLE"),
-		Unimplemented("CREATE GROUP name CONNECTION LIMIT -1 NOCREATEROLE"),
-		Unimplemented("CREATE GROUP name WITH CONNECTION LIMIT -1 NOCREATEROLE"),
-		Parses("CREATE GROUP name PASSWORD ' password ' NOCREATEROLE"),
-		Parses("CREATE GROUP name WITH PASSWORD ' password ' NOCREATEROLE"),
-		Unimplemented("CREATE GROUP name ENCRYPTED PASSWORD ' password ' NOCREATEROLE"),
-		Unimplemented("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' NOCREATEROLE"),
-		Parses("CREATE GROUP name PASSWORD NULL NOCREATEROLE"),
-		Parses("CREATE GROUP name WITH PASSWORD NULL NOCREATEROLE"),
+		Converts("CREATE GROUP name INHERIT NOCREATEROLE"),
+		Converts("CREATE GROUP name WITH INHERIT NOCREATEROLE"),
+		Converts("CREATE GROUP name NOINHERIT NOCREATEROLE"),
+		Converts("CREATE GROUP name WITH NOINHERIT NOCREATEROLE"),
+		Converts("CREATE GROUP name LOGIN NOCREATEROLE"),
+		Converts("CREATE GROUP name WITH LOGIN NOCREATEROLE"),
+		Converts("CREATE GROUP name NOLOGIN NOCREATEROLE"),
+		Converts("CREATE GROUP name WITH NOLOGIN NOCREATEROLE"),
+		Converts("CREATE GROUP name REPLICATION NOCREATEROLE"),
+		Converts("CREATE GROUP name WITH REPLICATION NOCREATEROLE"),
+		Converts("CREATE GROUP name NOREPLICATION NOCREATEROLE"),
+		Converts("CREATE GROUP name WITH NOREPLICATION NOCREATEROLE"),
+		Converts("CREATE GROUP name BYPASSRLS NOCREATEROLE"),
+		Converts("CREATE GROUP name WITH BYPASSRLS NOCREATEROLE"),
+		Converts("CREATE GROUP name NOBYPASSRLS NOCREATEROLE"),
+		Converts("CREATE GROUP name WITH NOBYPASSRLS NOCREATEROLE"),
+		Converts("CREATE GROUP name CONNECTION LIMIT -1 NOCREATEROLE"),
+		Converts("CREATE GROUP name WITH CONNECTION LIMIT -1 NOCREATEROLE"),
+		Converts("CREATE GROUP name PASSWORD ' password ' NOCREATEROLE"),
+		Converts("CREATE GROUP name WITH PASSWORD ' password ' NOCREATEROLE"),
+		Converts("CREATE GROUP name ENCRYPTED PASSWORD ' password ' NOCREATEROLE"),
+		Converts("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' NOCREATEROLE"),
+		Converts("CREATE GROUP name PASSWORD NULL NOCREATEROLE"),
+		Converts("CREATE GROUP name WITH PASSWORD NULL NOCREATEROLE"),
 		Parses("CREATE GROUP name VALID UNTIL ' timestamp ' NOCREATEROLE"),
 		Parses("CREATE GROUP name WITH VALID UNTIL ' timestamp ' NOCREATEROLE"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name NOCREATEROLE"),
@@ -439,44 +439,44 @@ func TestCreateGroup(t *testing.T) {
 		Unimplemented("CREATE GROUP name WITH USER role_name , role_name NOCREATEROLE"),
 		Unimplemented("CREATE GROUP name SYSID 1 NOCREATEROLE"),
 		Unimplemented("CREATE GROUP name WITH SYSID 1 NOCREATEROLE"),
-		Unimplemented("CREATE GROUP name SUPERUSER INHERIT"),
-		Unimplemented("CREATE GROUP name WITH SUPERUSER INHERIT"),
-		Unimplemented("CREATE GROUP name NOSUPERUSER INHERIT"),
-		Unimplemented("CREATE GROUP name WITH NOSUPERUSER INHERIT"),
-		Unimplemented("CREATE GROUP name CREATEDB INHERIT"),
-		Unimplemented("CREATE GROUP name WITH CREATEDB INHERIT"),
-		Unimplemented("CREATE GROUP name NOCREATEDB INHERIT"),
-		Unimplemented("CREATE GROUP name WITH NOCREATEDB INHERIT"),
-		Unimplemented("CREATE GROUP name CREATEROLE INHERIT"),
-		Unimplemented("CREATE GROUP name WITH CREATEROLE INHERIT"),
-		Unimplemented("CREATE GROUP name NOCREATEROLE INHERIT"),
-		Unimplemented("CREATE GROUP name WITH NOCREATEROLE INHERIT"),
-		Unimplemented("CREATE GROUP name INHERIT INHERIT"),
-		Unimplemented("CREATE GROUP name WITH INHERIT INHERIT"),
-		Unimplemented("CREATE GROUP name NOINHERIT INHERIT"),
-		Unimplemented("CREATE GROUP name WITH NOINHERIT INHERIT"),
-		Unimplemented("CREATE GROUP name LOGIN INHERIT"),
-		Unimplemented("CREATE GROUP name WITH LOGIN INHERIT"),
-		Unimplemented("CREATE GROUP name NOLOGIN INHERIT"),
-		Unimplemented("CREATE GROUP name WITH NOLOGIN INHERIT"),
-		Unimplemented("CREATE GROUP name REPLICATION INHERIT"),
-		Unimplemented("CREATE GROUP name WITH REPLICATION INHERIT"),
-		Unimplemented("CREATE GROUP name NOREPLICATION INHERIT"),
-		Unimplemented("CREATE GROUP name WITH NOREPLICATION INHERIT"),
-		Unimplemented("CREATE GROUP name BYPASSRLS INHERIT"),
-		Unimplemented("CREATE GROUP name WITH BYPASSRLS INHERIT"),
-		Unimplemented("CREATE GROUP name NOBYPASSRLS INHERIT"),
-		Unimplemented("CREATE GROUP name WITH NOBYPASSRLS INHERIT"),
-		Unimplemented("CREATE GROUP name CONNECTION LIMIT -1 INHERIT"),
-		Unimplemented("CREATE GROUP name WITH CONNECTION LIMIT -1 INHERIT"),
-		Unimplemented("CREATE GROUP name PASSWORD ' password ' INHERIT"),
-		Unimplemented("CREATE GROUP name WITH PASSWORD ' password ' INHERIT"),
-		Unimplemented("CREATE GROUP name ENCRYPTED PASSWORD ' password ' INHERIT"),
-		Unimplemented("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' INHERIT"),
-		Unimplemented("CREATE GROUP name PASSWORD NULL INHERIT"),
-		Unimplemented("CREATE GROUP name WITH PASSWORD NULL INHERIT"),
-		Unimplemented("CREATE GROUP name VALID UNTIL ' timestamp ' INHERIT"),
-		Unimplemented("CREATE GROUP name WITH VALID UNTIL ' timestamp ' INHERIT"),
+		Converts("CREATE GROUP name SUPERUSER INHERIT"),
+		Converts("CREATE GROUP name WITH SUPERUSER INHERIT"),
+		Converts("CREATE GROUP name NOSUPERUSER INHERIT"),
+		Converts("CREATE GROUP name WITH NOSUPERUSER INHERIT"),
+		Converts("CREATE GROUP name CREATEDB INHERIT"),
+		Converts("CREATE GROUP name WITH CREATEDB INHERIT"),
+		Converts("CREATE GROUP name NOCREATEDB INHERIT"),
+		Converts("CREATE GROUP name WITH NOCREATEDB INHERIT"),
+		Converts("CREATE GROUP name CREATEROLE INHERIT"),
+		Converts("CREATE GROUP name WITH CREATEROLE INHERIT"),
+		Converts("CREATE GROUP name NOCREATEROLE INHERIT"),
+		Converts("CREATE GROUP name WITH NOCREATEROLE INHERIT"),
+		Parses("CREATE GROUP name INHERIT INHERIT"),
+		Parses("CREATE GROUP name WITH INHERIT INHERIT"),
+		Parses("CREATE GROUP name NOINHERIT INHERIT"),
+		Parses("CREATE GROUP name WITH NOINHERIT INHERIT"),
+		Converts("CREATE GROUP name LOGIN INHERIT"),
+		Converts("CREATE GROUP name WITH LOGIN INHERIT"),
+		Converts("CREATE GROUP name NOLOGIN INHERIT"),
+		Converts("CREATE GROUP name WITH NOLOGIN INHERIT"),
+		Converts("CREATE GROUP name REPLICATION INHERIT"),
+		Converts("CREATE GROUP name WITH REPLICATION INHERIT"),
+		Converts("CREATE GROUP name NOREPLICATION INHERIT"),
+		Converts("CREATE GROUP name WITH NOREPLICATION INHERIT"),
+		Converts("CREATE GROUP name BYPASSRLS INHERIT"),
+		Converts("CREATE GROUP name WITH BYPASSRLS INHERIT"),
+		Converts("CREATE GROUP name NOBYPASSRLS INHERIT"),
+		Converts("CREATE GROUP name WITH NOBYPASSRLS INHERIT"),
+		Converts("CREATE GROUP name CONNECTION LIMIT -1 INHERIT"),
+		Converts("CREATE GROUP name WITH CONNECTION LIMIT -1 INHERIT"),
+		Converts("CREATE GROUP name PASSWORD ' password ' INHERIT"),
+		Converts("CREATE GROUP name WITH PASSWORD ' password ' INHERIT"),
+		Converts("CREATE GROUP name ENCRYPTED PASSWORD ' password ' INHERIT"),
+		Converts("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' INHERIT"),
+		Converts("CREATE GROUP name PASSWORD NULL INHERIT"),
+		Converts("CREATE GROUP name WITH PASSWORD NULL INHERIT"),
+		Parses("CREATE GROUP name VALID UNTIL ' timestamp ' INHERIT"),
+		Parses("CREATE GROUP name WITH VALID UNTIL ' timestamp ' INHERIT"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name INHERIT"),
 		Unimplemented("CREATE GROUP name WITH IN ROLE role_name INHERIT"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name , role_name INHERIT"),
@@ -499,44 +499,44 @@ func TestCreateGroup(t *testing.T) {
 		Unimplemented("CREATE GROUP name WITH USER role_name , role_name INHERIT"),
 		Unimplemented("CREATE GROUP name SYSID 1 INHERIT"),
 		Unimplemented("CREATE GROUP name WITH SYSID 1 INHERIT"),
-		Unimplemented("CREATE GROUP name SUPERUSER NOINHERIT"),
-		Unimplemented("CREATE GROUP name WITH SUPERUSER NOINHERIT"),
-		Unimplemented("CREATE GROUP name NOSUPERUSER NOINHERIT"),
-		Unimplemented("CREATE GROUP name WITH NOSUPERUSER NOINHERIT"),
-		Unimplemented("CREATE GROUP name CREATEDB NOINHERIT"),
-		Unimplemented("CREATE GROUP name WITH CREATEDB NOINHERIT"),
-		Unimplemented("CREATE GROUP name NOCREATEDB NOINHERIT"),
-		Unimplemented("CREATE GROUP name WITH NOCREATEDB NOINHERIT"),
-		Unimplemented("CREATE GROUP name CREATEROLE NOINHERIT"),
-		Unimplemented("CREATE GROUP name WITH CREATEROLE NOINHERIT"),
-		Unimplemented("CREATE GROUP name NOCREATEROLE NOINHERIT"),
-		Unimplemented("CREATE GROUP name WITH NOCREATEROLE NOINHERIT"),
-		Unimplemented("CREATE GROUP name INHERIT NOINHERIT"),
-		Unimplemented("CREATE GROUP name WITH INHERIT NOINHERIT"),
-		Unimplemented("CREATE GROUP name NOINHERIT NOINHERIT"),
-		Unimplemented("CREATE GROUP name WITH NOINHERIT NOINHERIT"),
-		Unimplemented("CREATE GROUP name LOGIN NOINHERIT"),
-		Unimplemented("CREATE GROUP name WITH LOGIN NOINHERIT"),
-		Unimplemented("CREATE GROUP name NOLOGIN NOINHERIT"),
-		Unimplemented("CREATE GROUP name WITH NOLOGIN NOINHERIT"),
-		Unimplemented("CREATE GROUP name REPLICATION NOINHERIT"),
-		Unimplemented("CREATE GROUP name WITH REPLICATION NOINHERIT"),
-		Unimplemented("CREATE GROUP name NOREPLICATION NOINHERIT"),
-		Unimplemented("CREATE GROUP name WITH NOREPLICATION NOINHERIT"),
-		Unimplemented("CREATE GROUP name BYPASSRLS NOINHERIT"),
-		Unimplemented("CREATE GROUP name WITH BYPASSRLS NOINHERIT"),
-		Unimplemented("CREATE GROUP name NOBYPASSRLS NOINHERIT"),
-		Unimplemented("CREATE GROUP name WITH NOBYPASSRLS NOINHERIT"),
-		Unimplemented("CREATE GROUP name CONNECTION LIMIT -1 NOINHERIT"),
-		Unimplemented("CREATE GROUP name WITH CONNECTION LIMIT -1 NOINHERIT"),
-		Unimplemented("CREATE GROUP name PASSWORD ' password ' NOINHERIT"),
-		Unimplemented("CREATE GROUP name WITH PASSWORD ' password ' NOINHERIT"),
-		Unimplemented("CREATE GROUP name ENCRYPTED PASSWORD ' password ' NOINHERIT"),
-		Unimplemented("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' NOINHERIT"),
-		Unimplemented("CREATE GROUP name PASSWORD NULL NOINHERIT"),
-		Unimplemented("CREATE GROUP name WITH PASSWORD NULL NOINHERIT"),
-		Unimplemented("CREATE GROUP name VALID UNTIL ' timestamp ' NOINHERIT"),
-		Unimplemented("CREATE GROUP name WITH VALID UNTIL ' timestamp ' NOINHERIT"),
+		Converts("CREATE GROUP name SUPERUSER NOINHERIT"),
+		Converts("CREATE GROUP name WITH SUPERUSER NOINHERIT"),
+		Converts("CREATE GROUP name NOSUPERUSER NOINHERIT"),
+		Converts("CREATE GROUP name WITH NOSUPERUSER NOINHERIT"),
+		Converts("CREATE GROUP name CREATEDB NOINHERIT"),
+		Converts("CREATE GROUP name WITH CREATEDB NOINHERIT"),
+		Converts("CREATE GROUP name NOCREATEDB NOINHERIT"),
+		Converts("CREATE GROUP name WITH NOCREATEDB NOINHERIT"),
+		Converts("CREATE GROUP name CREATEROLE NOINHERIT"),
+		Converts("CREATE GROUP name WITH CREATEROLE NOINHERIT"),
+		Converts("CREATE GROUP name NOCREATEROLE NOINHERIT"),
+		Converts("CREATE GROUP name WITH NOCREATEROLE NOINHERIT"),
+		Parses("CREATE GROUP name INHERIT NOINHERIT"),
+		Parses("CREATE GROUP name WITH INHERIT NOINHERIT"),
+		Parses("CREATE GROUP name NOINHERIT NOINHERIT"),
+		Parses("CREATE GROUP name WITH NOINHERIT NOINHERIT"),
+		Converts("CREATE GROUP name LOGIN NOINHERIT"),
+		Converts("CREATE GROUP name WITH LOGIN NOINHERIT"),
+		Converts("CREATE GROUP name NOLOGIN NOINHERIT"),
+		Converts("CREATE GROUP name WITH NOLOGIN NOINHERIT"),
+		Converts("CREATE GROUP name REPLICATION NOINHERIT"),
+		Converts("CREATE GROUP name WITH REPLICATION NOINHERIT"),
+		Converts("CREATE GROUP name NOREPLICATION NOINHERIT"),
+		Converts("CREATE GROUP name WITH NOREPLICATION NOINHERIT"),
+		Converts("CREATE GROUP name BYPASSRLS NOINHERIT"),
+		Converts("CREATE GROUP name WITH BYPASSRLS NOINHERIT"),
+		Converts("CREATE GROUP name NOBYPASSRLS NOINHERIT"),
+		Converts("CREATE GROUP name WITH NOBYPASSRLS NOINHERIT"),
+		Converts("CREATE GROUP name CONNECTION LIMIT -1 NOINHERIT"),
+		Converts("CREATE GROUP name WITH CONNECTION LIMIT -1 NOINHERIT"),
+		Converts("CREATE GROUP name PASSWORD ' password ' NOINHERIT"),
+		Converts("CREATE GROUP name WITH PASSWORD ' password ' NOINHERIT"),
+		Converts("CREATE GROUP name ENCRYPTED PASSWORD ' password ' NOINHERIT"),
+		Converts("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' NOINHERIT"),
+		Converts("CREATE GROUP name PASSWORD NULL NOINHERIT"),
+		Converts("CREATE GROUP name WITH PASSWORD NULL NOINHERIT"),
+		Parses("CREATE GROUP name VALID UNTIL ' timestamp ' NOINHERIT"),
+		Parses("CREATE GROUP name WITH VALID UNTIL ' timestamp ' NOINHERIT"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name NOINHERIT"),
 		Unimplemented("CREATE GROUP name WITH IN ROLE role_name NOINHERIT"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name , role_name NOINHERIT"),
@@ -559,42 +559,42 @@ func TestCreateGroup(t *testing.T) {
 		Unimplemented("CREATE GROUP name WITH USER role_name , role_name NOINHERIT"),
 		Unimplemented("CREATE GROUP name SYSID 1 NOINHERIT"),
 		Unimplemented("CREATE GROUP name WITH SYSID 1 NOINHERIT"),
-		Unimplemented("CREATE GROUP name SUPERUSER LOGIN"),
-		Unimplemented("CREATE GROUP name WITH SUPERUSER LOGIN"),
-		Unimplemented("CREATE GROUP name NOSUPERUSER LOGIN"),
-		Unimplemented("CREATE GROUP name WITH NOSUPERUSER LOGIN"),
-		Parses("CREATE GROUP name CREATEDB LOGIN"),
-		Parses("CREATE GROUP name WITH CREATEDB LOGIN"),
-		Parses("CREATE GROUP name NOCREATEDB LOGIN"),
-		Parses("CREATE GROUP name WITH NOCREATEDB LOGIN"),
-		Parses("CREATE GROUP name CREATEROLE LOGIN"),
-		Parses("CREATE GROUP name WITH CREATEROLE LOGIN"),
-		Parses("CREATE GROUP name NOCREATEROLE LOGIN"),
-		Parses("CREATE GROUP name WITH NOCREATEROLE LOGIN"),
-		Unimplemented("CREATE GROUP name INHERIT LOGIN"),
-		Unimplemented("CREATE GROUP name WITH INHERIT LOGIN"),
-		Unimplemented("CREATE GROUP name NOINHERIT LOGIN"),
-		Unimplemented("CREATE GROUP name WITH NOINHERIT LOGIN"),
+		Converts("CREATE GROUP name SUPERUSER LOGIN"),
+		Converts("CREATE GROUP name WITH SUPERUSER LOGIN"),
+		Converts("CREATE GROUP name NOSUPERUSER LOGIN"),
+		Converts("CREATE GROUP name WITH NOSUPERUSER LOGIN"),
+		Converts("CREATE GROUP name CREATEDB LOGIN"),
+		Converts("CREATE GROUP name WITH CREATEDB LOGIN"),
+		Converts("CREATE GROUP name NOCREATEDB LOGIN"),
+		Converts("CREATE GROUP name WITH NOCREATEDB LOGIN"),
+		Converts("CREATE GROUP name CREATEROLE LOGIN"),
+		Converts("CREATE GROUP name WITH CREATEROLE LOGIN"),
+		Converts("CREATE GROUP name NOCREATEROLE LOGIN"),
+		Converts("CREATE GROUP name WITH NOCREATEROLE LOGIN"),
+		Converts("CREATE GROUP name INHERIT LOGIN"),
+		Converts("CREATE GROUP name WITH INHERIT LOGIN"),
+		Converts("CREATE GROUP name NOINHERIT LOGIN"),
+		Converts("CREATE GROUP name WITH NOINHERIT LOGIN"),
 		Parses("CREATE GROUP name LOGIN LOGIN"),
 		Parses("CREATE GROUP name WITH LOGIN LOGIN"),
 		Parses("CREATE GROUP name NOLOGIN LOGIN"),
 		Parses("CREATE GROUP name WITH NOLOGIN LOGIN"),
-		Unimplemented("CREATE GROUP name REPLICATION LOGIN"),
-		Unimplemented("CREATE GROUP name WITH REPLICATION LOGIN"),
-		Unimplemented("CREATE GROUP name NOREPLICATION LOGIN"),
-		Unimplemented("CREATE GROUP name WITH NOREPLICATION LOGIN"),
-		Unimplemented("CREATE GROUP name BYPASSRLS LOGIN"),
-		Unimplemented("CREATE GROUP name WITH BYPASSRLS LOGIN"),
-		Unimplemented("CREATE GROUP name NOBYPASSRLS LOGIN"),
-		Unimplemented("CREATE GROUP name WITH NOBYPASSRLS LOGIN"),
-		Unimplemented("CREATE GROUP name CONNECTION LIMIT -1 LOGIN"),
-		Unimplemented("CREATE GROUP name WITH CONNECTION LIMIT -1 LOGIN"),
-		Parses("CREATE GROUP name PASSWORD ' password ' LOGIN"),
-		Parses("CREATE GROUP name WITH PASSWORD ' password ' LOGIN"),
-		Unimplemented("CREATE GROUP name ENCRYPTED PASSWORD ' password ' LOGIN"),
-		Unimplemented("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' LOGIN"),
-		Parses("CREATE GROUP name PASSWORD NULL LOGIN"),
-		Parses("CREATE GROUP name WITH PASSWORD NULL LOGIN"),
+		Converts("CREATE GROUP name REPLICATION LOGIN"),
+		Converts("CREATE GROUP name WITH REPLICATION LOGIN"),
+		Converts("CREATE GROUP name NOREPLICATION LOGIN"),
+		Converts("CREATE GROUP name WITH NOREPLICATION LOGIN"),
+		Converts("CREATE GROUP name BYPASSRLS LOGIN"),
+		Converts("CREATE GROUP name WITH BYPASSRLS LOGIN"),
+		Converts("CREATE GROUP name NOBYPASSRLS LOGIN"),
+		Converts("CREATE GROUP name WITH NOBYPASSRLS LOGIN"),
+		Converts("CREATE GROUP name CONNECTION LIMIT -1 LOGIN"),
+		Converts("CREATE GROUP name WITH CONNECTION LIMIT -1 LOGIN"),
+		Converts("CREATE GROUP name PASSWORD ' password ' LOGIN"),
+		Converts("CREATE GROUP name WITH PASSWORD ' password ' LOGIN"),
+		Converts("CREATE GROUP name ENCRYPTED PASSWORD ' password ' LOGIN"),
+		Converts("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' LOGIN"),
+		Converts("CREATE GROUP name PASSWORD NULL LOGIN"),
+		Converts("CREATE GROUP name WITH PASSWORD NULL LOGIN"),
 		Parses("CREATE GROUP name VALID UNTIL ' timestamp ' LOGIN"),
 		Parses("CREATE GROUP name WITH VALID UNTIL ' timestamp ' LOGIN"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name LOGIN"),
@@ -619,42 +619,42 @@ func TestCreateGroup(t *testing.T) {
 		Unimplemented("CREATE GROUP name WITH USER role_name , role_name LOGIN"),
 		Unimplemented("CREATE GROUP name SYSID 1 LOGIN"),
 		Unimplemented("CREATE GROUP name WITH SYSID 1 LOGIN"),
-		Unimplemented("CREATE GROUP name SUPERUSER NOLOGIN"),
-		Unimplemented("CREATE GROUP name WITH SUPERUSER NOLOGIN"),
-		Unimplemented("CREATE GROUP name NOSUPERUSER NOLOGIN"),
-		Unimplemented("CREATE GROUP name WITH NOSUPERUSER NOLOGIN"),
-		Parses("CREATE GROUP name CREATEDB NOLOGIN"),
-		Parses("CREATE GROUP name WITH CREATEDB NOLOGIN"),
-		Parses("CREATE GROUP name NOCREATEDB NOLOGIN"),
-		Parses("CREATE GROUP name WITH NOCREATEDB NOLOGIN"),
-		Parses("CREATE GROUP name CREATEROLE NOLOGIN"),
-		Parses("CREATE GROUP name WITH CREATEROLE NOLOGIN"),
-		Parses("CREATE GROUP name NOCREATEROLE NOLOGIN"),
-		Parses("CREATE GROUP name WITH NOCREATEROLE NOLOGIN"),
-		Unimplemented("CREATE GROUP name INHERIT NOLOGIN"),
-		Unimplemented("CREATE GROUP name WITH INHERIT NOLOGIN"),
-		Unimplemented("CREATE GROUP name NOINHERIT NOLOGIN"),
-		Unimplemented("CREATE GROUP name WITH NOINHERIT NOLOGIN"),
+		Converts("CREATE GROUP name SUPERUSER NOLOGIN"),
+		Converts("CREATE GROUP name WITH SUPERUSER NOLOGIN"),
+		Converts("CREATE GROUP name NOSUPERUSER NOLOGIN"),
+		Converts("CREATE GROUP name WITH NOSUPERUSER NOLOGIN"),
+		Converts("CREATE GROUP name CREATEDB NOLOGIN"),
+		Converts("CREATE GROUP name WITH CREATEDB NOLOGIN"),
+		Converts("CREATE GROUP name NOCREATEDB NOLOGIN"),
+		Converts("CREATE GROUP name WITH NOCREATEDB NOLOGIN"),
+		Converts("CREATE GROUP name CREATEROLE NOLOGIN"),
+		Converts("CREATE GROUP name WITH CREATEROLE NOLOGIN"),
+		Converts("CREATE GROUP name NOCREATEROLE NOLOGIN"),
+		Converts("CREATE GROUP name WITH NOCREATEROLE NOLOGIN"),
+		Converts("CREATE GROUP name INHERIT NOLOGIN"),
+		Converts("CREATE GROUP name WITH INHERIT NOLOGIN"),
+		Converts("CREATE GROUP name NOINHERIT NOLOGIN"),
+		Converts("CREATE GROUP name WITH NOINHERIT NOLOGIN"),
 		Parses("CREATE GROUP name LOGIN NOLOGIN"),
 		Parses("CREATE GROUP name WITH LOGIN NOLOGIN"),
 		Parses("CREATE GROUP name NOLOGIN NOLOGIN"),
 		Parses("CREATE GROUP name WITH NOLOGIN NOLOGIN"),
-		Unimplemented("CREATE GROUP name REPLICATION NOLOGIN"),
-		Unimplemented("CREATE GROUP name WITH REPLICATION NOLOGIN"),
-		Unimplemented("CREATE GROUP name NOREPLICATION NOLOGIN"),
-		Unimplemented("CREATE GROUP name WITH NOREPLICATION NOLOGIN"),
-		Unimplemented("CREATE GROUP name BYPASSRLS NOLOGIN"),
-		Unimplemented("CREATE GROUP name WITH BYPASSRLS NOLOGIN"),
-		Unimplemented("CREATE GROUP name NOBYPASSRLS NOLOGIN"),
-		Unimplemented("CREATE GROUP name WITH NOBYPASSRLS NOLOGIN"),
-		Unimplemented("CREATE GROUP name CONNECTION LIMIT -1 NOLOGIN"),
-		Unimplemented("CREATE GROUP name WITH CONNECTION LIMIT -1 NOLOGIN"),
-		Parses("CREATE GROUP name PASSWORD ' password ' NOLOGIN"),
-		Parses("CREATE GROUP name WITH PASSWORD ' password ' NOLOGIN"),
-		Unimplemented("CREATE GROUP name ENCRYPTED PASSWORD ' password ' NOLOGIN"),
-		Unimplemented("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' NOLOGIN"),
-		Parses("CREATE GROUP name PASSWORD NULL NOLOGIN"),
-		Parses("CREATE GROUP name WITH PASSWORD NULL NOLOGIN"),
+		Converts("CREATE GROUP name REPLICATION NOLOGIN"),
+		Converts("CREATE GROUP name WITH REPLICATION NOLOGIN"),
+		Converts("CREATE GROUP name NOREPLICATION NOLOGIN"),
+		Converts("CREATE GROUP name WITH NOREPLICATION NOLOGIN"),
+		Converts("CREATE GROUP name BYPASSRLS NOLOGIN"),
+		Converts("CREATE GROUP name WITH BYPASSRLS NOLOGIN"),
+		Converts("CREATE GROUP name NOBYPASSRLS NOLOGIN"),
+		Converts("CREATE GROUP name WITH NOBYPASSRLS NOLOGIN"),
+		Converts("CREATE GROUP name CONNECTION LIMIT -1 NOLOGIN"),
+		Converts("CREATE GROUP name WITH CONNECTION LIMIT -1 NOLOGIN"),
+		Converts("CREATE GROUP name PASSWORD ' password ' NOLOGIN"),
+		Converts("CREATE GROUP name WITH PASSWORD ' password ' NOLOGIN"),
+		Converts("CREATE GROUP name ENCRYPTED PASSWORD ' password ' NOLOGIN"),
+		Converts("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' NOLOGIN"),
+		Converts("CREATE GROUP name PASSWORD NULL NOLOGIN"),
+		Converts("CREATE GROUP name WITH PASSWORD NULL NOLOGIN"),
 		Parses("CREATE GROUP name VALID UNTIL ' timestamp ' NOLOGIN"),
 		Parses("CREATE GROUP name WITH VALID UNTIL ' timestamp ' NOLOGIN"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name NOLOGIN"),
@@ -679,44 +679,44 @@ func TestCreateGroup(t *testing.T) {
 		Unimplemented("CREATE GROUP name WITH USER role_name , role_name NOLOGIN"),
 		Unimplemented("CREATE GROUP name SYSID 1 NOLOGIN"),
 		Unimplemented("CREATE GROUP name WITH SYSID 1 NOLOGIN"),
-		Unimplemented("CREATE GROUP name SUPERUSER REPLICATION"),
-		Unimplemented("CREATE GROUP name WITH SUPERUSER REPLICATION"),
-		Unimplemented("CREATE GROUP name NOSUPERUSER REPLICATION"),
-		Unimplemented("CREATE GROUP name WITH NOSUPERUSER REPLICATION"),
-		Unimplemented("CREATE GROUP name CREATEDB REPLICATION"),
-		Unimplemented("CREATE GROUP name WITH CREATEDB REPLICATION"),
-		Unimplemented("CREATE GROUP name NOCREATEDB REPLICATION"),
-		Unimplemented("CREATE GROUP name WITH NOCREATEDB REPLICATION"),
-		Unimplemented("CREATE GROUP name CREATEROLE REPLICATION"),
-		Unimplemented("CREATE GROUP name WITH CREATEROLE REPLICATION"),
-		Unimplemented("CREATE GROUP name NOCREATEROLE REPLICATION"),
-		Unimplemented("CREATE GROUP name WITH NOCREATEROLE REPLICATION"),
-		Unimplemented("CREATE GROUP name INHERIT REPLICATION"),
-		Unimplemented("CREATE GROUP name WITH INHERIT REPLICATION"),
-		Unimplemented("CREATE GROUP name NOINHERIT REPLICATION"),
-		Unimplemented("CREATE GROUP name WITH NOINHERIT REPLICATION"),
-		Unimplemented("CREATE GROUP name LOGIN REPLICATION"),
-		Unimplemented("CREATE GROUP name WITH LOGIN REPLICATION"),
-		Unimplemented("CREATE GROUP name NOLOGIN REPLICATION"),
-		Unimplemented("CREATE GROUP name WITH NOLOGIN REPLICATION"),
-		Unimplemented("CREATE GROUP name REPLICATION REPLICATION"),
-		Unimplemented("CREATE GROUP name WITH REPLICATION REPLICATION"),
-		Unimplemented("CREATE GROUP name NOREPLICATION REPLICATION"),
-		Unimplemented("CREATE GROUP name WITH NOREPLICATION REPLICATION"),
-		Unimplemented("CREATE GROUP name BYPASSRLS REPLICATION"),
-		Unimplemented("CREATE GROUP name WITH BYPASSRLS REPLICATION"),
-		Unimplemented("CREATE GROUP name NOBYPASSRLS REPLICATION"),
-		Unimplemented("CREATE GROUP name WITH NOBYPASSRLS REPLICATION"),
-		Unimplemented("CREATE GROUP name CONNECTION LIMIT -1 REPLICATION"),
-		Unimplemented("CREATE GROUP name WITH CONNECTION LIMIT -1 REPLICATION"),
-		Unimplemented("CREATE GROUP name PASSWORD ' password ' REPLICATION"),
-		Unimplemented("CREATE GROUP name WITH PASSWORD ' password ' REPLICATION"),
-		Unimplemented("CREATE GROUP name ENCRYPTED PASSWORD ' password ' REPLICATION"),
-		Unimplemented("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' REPLICATION"),
-		Unimplemented("CREATE GROUP name PASSWORD NULL REPLICATION"),
-		Unimplemented("CREATE GROUP name WITH PASSWORD NULL REPLICATION"),
-		Unimplemented("CREATE GROUP name VALID UNTIL ' timestamp ' REPLICATION"),
-		Unimplemented("CREATE GROUP name WITH VALID UNTIL ' timestamp ' REPLICATION"),
+		Converts("CREATE GROUP name SUPERUSER REPLICATION"),
+		Converts("CREATE GROUP name WITH SUPERUSER REPLICATION"),
+		Converts("CREATE GROUP name NOSUPERUSER REPLICATION"),
+		Converts("CREATE GROUP name WITH NOSUPERUSER REPLICATION"),
+		Converts("CREATE GROUP name CREATEDB REPLICATION"),
+		Converts("CREATE GROUP name WITH CREATEDB REPLICATION"),
+		Converts("CREATE GROUP name NOCREATEDB REPLICATION"),
+		Converts("CREATE GROUP name WITH NOCREATEDB REPLICATION"),
+		Converts("CREATE GROUP name CREATEROLE REPLICATION"),
+		Converts("CREATE GROUP name WITH CREATEROLE REPLICATION"),
+		Converts("CREATE GROUP name NOCREATEROLE REPLICATION"),
+		Converts("CREATE GROUP name WITH NOCREATEROLE REPLICATION"),
+		Converts("CREATE GROUP name INHERIT REPLICATION"),
+		Converts("CREATE GROUP name WITH INHERIT REPLICATION"),
+		Converts("CREATE GROUP name NOINHERIT REPLICATION"),
+		Converts("CREATE GROUP name WITH NOINHERIT REPLICATION"),
+		Converts("CREATE GROUP name LOGIN REPLICATION"),
+		Converts("CREATE GROUP name WITH LOGIN REPLICATION"),
+		Converts("CREATE GROUP name NOLOGIN REPLICATION"),
+		Converts("CREATE GROUP name WITH NOLOGIN REPLICATION"),
+		Parses("CREATE GROUP name REPLICATION REPLICATION"),
+		Parses("CREATE GROUP name WITH REPLICATION REPLICATION"),
+		Parses("CREATE GROUP name NOREPLICATION REPLICATION"),
+		Parses("CREATE GROUP name WITH NOREPLICATION REPLICATION"),
+		Converts("CREATE GROUP name BYPASSRLS REPLICATION"),
+		Converts("CREATE GROUP name WITH BYPASSRLS REPLICATION"),
+		Converts("CREATE GROUP name NOBYPASSRLS REPLICATION"),
+		Converts("CREATE GROUP name WITH NOBYPASSRLS REPLICATION"),
+		Converts("CREATE GROUP name CONNECTION LIMIT -1 REPLICATION"),
+		Converts("CREATE GROUP name WITH CONNECTION LIMIT -1 REPLICATION"),
+		Converts("CREATE GROUP name PASSWORD ' password ' REPLICATION"),
+		Converts("CREATE GROUP name WITH PASSWORD ' password ' REPLICATION"),
+		Converts("CREATE GROUP name ENCRYPTED PASSWORD ' password ' REPLICATION"),
+		Converts("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' REPLICATION"),
+		Converts("CREATE GROUP name PASSWORD NULL REPLICATION"),
+		Converts("CREATE GROUP name WITH PASSWORD NULL REPLICATION"),
+		Parses("CREATE GROUP name VALID UNTIL ' timestamp ' REPLICATION"),
+		Parses("CREATE GROUP name WITH VALID UNTIL ' timestamp ' REPLICATION"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name REPLICATION"),
 		Unimplemented("CREATE GROUP name WITH IN ROLE role_name REPLICATION"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name , role_name REPLICATION"),
@@ -739,44 +739,44 @@ func TestCreateGroup(t *testing.T) {
 		Unimplemented("CREATE GROUP name WITH USER role_name , role_name REPLICATION"),
 		Unimplemented("CREATE GROUP name SYSID 1 REPLICATION"),
 		Unimplemented("CREATE GROUP name WITH SYSID 1 REPLICATION"),
-		Unimplemented("CREATE GROUP name SUPERUSER NOREPLICATION"),
-		Unimplemented("CREATE GROUP name WITH SUPERUSER NOREPLICATION"),
-		Unimplemented("CREATE GROUP name NOSUPERUSER NOREPLICATION"),
-		Unimplemented("CREATE GROUP name WITH NOSUPERUSER NOREPLICATION"),
-		Unimplemented("CREATE GROUP name CREATEDB NOREPLICATION"),
-		Unimplemented("CREATE GROUP name WITH CREATEDB NOREPLICATION"),
-		Unimplemented("CREATE GROUP name NOCREATEDB NOREPLICATION"),
-		Unimplemented("CREATE GROUP name WITH NOCREATEDB NOREPLICATION"),
-		Unimplemented("CREATE GROUP name CREATEROLE NOREPLICATION"),
-		Unimplemented("CREATE GROUP name WITH CREATEROLE NOREPLICATION"),
-		Unimplemented("CREATE GROUP name NOCREATEROLE NOREPLICATION"),
-		Unimplemented("CREATE GROUP name WITH NOCREATEROLE NOREPLICATION"),
-		Unimplemented("CREATE GROUP name INHERIT NOREPLICATION"),
-		Unimplemented("CREATE GROUP name WITH INHERIT NOREPLICATION"),
-		Unimplemented("CREATE GROUP name NOINHERIT NOREPLICATION"),
-		Unimplemented("CREATE GROUP name WITH NOINHERIT NOREPLICATION"),
-		Unimplemented("CREATE GROUP name LOGIN NOREPLICATION"),
-		Unimplemented("CREATE GROUP name WITH LOGIN NOREPLICATION"),
-		Unimplemented("CREATE GROUP name NOLOGIN NOREPLICATION"),
-		Unimplemented("CREATE GROUP name WITH NOLOGIN NOREPLICATION"),
-		Unimplemented("CREATE GROUP name REPLICATION NOREPLICATION"),
-		Unimplemented("CREATE GROUP name WITH REPLICATION NOREPLICATION"),
-		Unimplemented("CREATE GROUP name NOREPLICATION NOREPLICATION"),
-		Unimplemented("CREATE GROUP name WITH NOREPLICATION NOREPLICATION"),
-		Unimplemented("CREATE GROUP name BYPASSRLS NOREPLICATION"),
-		Unimplemented("CREATE GROUP name WITH BYPASSRLS NOREPLICATION"),
-		Unimplemented("CREATE GROUP name NOBYPASSRLS NOREPLICATION"),
-		Unimplemented("CREATE GROUP name WITH NOBYPASSRLS NOREPLICATION"),
-		Unimplemented("CREATE GROUP name CONNECTION LIMIT -1 NOREPLICATION"),
-		Unimplemented("CREATE GROUP name WITH CONNECTION LIMIT -1 NOREPLICATION"),
-		Unimplemented("CREATE GROUP name PASSWORD ' password ' NOREPLICATION"),
-		Unimplemented("CREATE GROUP name WITH PASSWORD ' password ' NOREPLICATION"),
-		Unimplemented("CREATE GROUP name ENCRYPTED PASSWORD ' password ' NOREPLICATION"),
-		Unimplemented("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' NOREPLICATION"),
-		Unimplemented("CREATE GROUP name PASSWORD NULL NOREPLICATION"),
-		Unimplemented("CREATE GROUP name WITH PASSWORD NULL NOREPLICATION"),
-		Unimplemented("CREATE GROUP name VALID UNTIL ' timestamp ' NOREPLICATION"),
-		Unimplemented("CREATE GROUP name WITH VALID UNTIL ' timestamp ' NOREPLICATION"),
+		Converts("CREATE GROUP name SUPERUSER NOREPLICATION"),
+		Converts("CREATE GROUP name WITH SUPERUSER NOREPLICATION"),
+		Converts("CREATE GROUP name NOSUPERUSER NOREPLICATION"),
+		Converts("CREATE GROUP name WITH NOSUPERUSER NOREPLICATION"),
+		Converts("CREATE GROUP name CREATEDB NOREPLICATION"),
+		Converts("CREATE GROUP name WITH CREATEDB NOREPLICATION"),
+		Converts("CREATE GROUP name NOCREATEDB NOREPLICATION"),
+		Converts("CREATE GROUP name WITH NOCREATEDB NOREPLICATION"),
+		Converts("CREATE GROUP name CREATEROLE NOREPLICATION"),
+		Converts("CREATE GROUP name WITH CREATEROLE NOREPLICATION"),
+		Converts("CREATE GROUP name NOCREATEROLE NOREPLICATION"),
+		Converts("CREATE GROUP name WITH NOCREATEROLE NOREPLICATION"),
+		Converts("CREATE GROUP name INHERIT NOREPLICATION"),
+		Converts("CREATE GROUP name WITH INHERIT NOREPLICATION"),
+		Converts("CREATE GROUP name NOINHERIT NOREPLICATION"),
+		Converts("CREATE GROUP name WITH NOINHERIT NOREPLICATION"),
+		Converts("CREATE GROUP name LOGIN NOREPLICATION"),
+		Converts("CREATE GROUP name WITH LOGIN NOREPLICATION"),
+		Converts("CREATE GROUP name NOLOGIN NOREPLICATION"),
+		Converts("CREATE GROUP name WITH NOLOGIN NOREPLICATION"),
+		Parses("CREATE GROUP name REPLICATION NOREPLICATION"),
+		Parses("CREATE GROUP name WITH REPLICATION NOREPLICATION"),
+		Parses("CREATE GROUP name NOREPLICATION NOREPLICATION"),
+		Parses("CREATE GROUP name WITH NOREPLICATION NOREPLICATION"),
+		Converts("CREATE GROUP name BYPASSRLS NOREPLICATION"),
+		Converts("CREATE GROUP name WITH BYPASSRLS NOREPLICATION"),
+		Converts("CREATE GROUP name NOBYPASSRLS NOREPLICATION"),
+		Converts("CREATE GROUP name WITH NOBYPASSRLS NOREPLICATION"),
+		Converts("CREATE GROUP name CONNECTION LIMIT -1 NOREPLICATION"),
+		Converts("CREATE GROUP name WITH CONNECTION LIMIT -1 NOREPLICATION"),
+		Converts("CREATE GROUP name PASSWORD ' password ' NOREPLICATION"),
+		Converts("CREATE GROUP name WITH PASSWORD ' password ' NOREPLICATION"),
+		Converts("CREATE GROUP name ENCRYPTED PASSWORD ' password ' NOREPLICATION"),
+		Converts("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' NOREPLICATION"),
+		Converts("CREATE GROUP name PASSWORD NULL NOREPLICATION"),
+		Converts("CREATE GROUP name WITH PASSWORD NULL NOREPLICATION"),
+		Parses("CREATE GROUP name VALID UNTIL ' timestamp ' NOREPLICATION"),
+		Parses("CREATE GROUP name WITH VALID UNTIL ' timestamp ' NOREPLICATION"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name NOREPLICATION"),
 		Unimplemented("CREATE GROUP name WITH IN ROLE role_name NOREPLICATION"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name , role_name NOREPLICATION"),
@@ -799,44 +799,44 @@ func TestCreateGroup(t *testing.T) {
 		Unimplemented("CREATE GROUP name WITH USER role_name , role_name NOREPLICATION"),
 		Unimplemented("CREATE GROUP name SYSID 1 NOREPLICATION"),
 		Unimplemented("CREATE GROUP name WITH SYSID 1 NOREPLICATION"),
-		Unimplemented("CREATE GROUP name SUPERUSER BYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH SUPERUSER BYPASSRLS"),
-		Unimplemented("CREATE GROUP name NOSUPERUSER BYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH NOSUPERUSER BYPASSRLS"),
-		Unimplemented("CREATE GROUP name CREATEDB BYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH CREATEDB BYPASSRLS"),
-		Unimplemented("CREATE GROUP name NOCREATEDB BYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH NOCREATEDB BYPASSRLS"),
-		Unimplemented("CREATE GROUP name CREATEROLE BYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH CREATEROLE BYPASSRLS"),
-		Unimplemented("CREATE GROUP name NOCREATEROLE BYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH NOCREATEROLE BYPASSRLS"),
-		Unimplemented("CREATE GROUP name INHERIT BYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH INHERIT BYPASSRLS"),
-		Unimplemented("CREATE GROUP name NOINHERIT BYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH NOINHERIT BYPASSRLS"),
-		Unimplemented("CREATE GROUP name LOGIN BYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH LOGIN BYPASSRLS"),
-		Unimplemented("CREATE GROUP name NOLOGIN BYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH NOLOGIN BYPASSRLS"),
-		Unimplemented("CREATE GROUP name REPLICATION BYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH REPLICATION BYPASSRLS"),
-		Unimplemented("CREATE GROUP name NOREPLICATION BYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH NOREPLICATION BYPASSRLS"),
-		Unimplemented("CREATE GROUP name BYPASSRLS BYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH BYPASSRLS BYPASSRLS"),
-		Unimplemented("CREATE GROUP name NOBYPASSRLS BYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH NOBYPASSRLS BYPASSRLS"),
-		Unimplemented("CREATE GROUP name CONNECTION LIMIT -1 BYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH CONNECTION LIMIT -1 BYPASSRLS"),
-		Unimplemented("CREATE GROUP name PASSWORD ' password ' BYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH PASSWORD ' password ' BYPASSRLS"),
-		Unimplemented("CREATE GROUP name ENCRYPTED PASSWORD ' password ' BYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' BYPASSRLS"),
-		Unimplemented("CREATE GROUP name PASSWORD NULL BYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH PASSWORD NULL BYPASSRLS"),
-		Unimplemented("CREATE GROUP name VALID UNTIL ' timestamp ' BYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH VALID UNTIL ' timestamp ' BYPASSRLS"),
+		Converts("CREATE GROUP name SUPERUSER BYPASSRLS"),
+		Converts("CREATE GROUP name WITH SUPERUSER BYPASSRLS"),
+		Converts("CREATE GROUP name NOSUPERUSER BYPASSRLS"),
+		Converts("CREATE GROUP name WITH NOSUPERUSER BYPASSRLS"),
+		Converts("CREATE GROUP name CREATEDB BYPASSRLS"),
+		Converts("CREATE GROUP name WITH CREATEDB BYPASSRLS"),
+		Converts("CREATE GROUP name NOCREATEDB BYPASSRLS"),
+		Converts("CREATE GROUP name WITH NOCREATEDB BYPASSRLS"),
+		Converts("CREATE GROUP name CREATEROLE BYPASSRLS"),
+		Converts("CREATE GROUP name WITH CREATEROLE BYPASSRLS"),
+		Converts("CREATE GROUP name NOCREATEROLE BYPASSRLS"),
+		Converts("CREATE GROUP name WITH NOCREATEROLE BYPASSRLS"),
+		Converts("CREATE GROUP name INHERIT BYPASSRLS"),
+		Converts("CREATE GROUP name WITH INHERIT BYPASSRLS"),
+		Converts("CREATE GROUP name NOINHERIT BYPASSRLS"),
+		Converts("CREATE GROUP name WITH NOINHERIT BYPASSRLS"),
+		Converts("CREATE GROUP name LOGIN BYPASSRLS"),
+		Converts("CREATE GROUP name WITH LOGIN BYPASSRLS"),
+		Converts("CREATE GROUP name NOLOGIN BYPASSRLS"),
+		Converts("CREATE GROUP name WITH NOLOGIN BYPASSRLS"),
+		Converts("CREATE GROUP name REPLICATION BYPASSRLS"),
+		Converts("CREATE GROUP name WITH REPLICATION BYPASSRLS"),
+		Converts("CREATE GROUP name NOREPLICATION BYPASSRLS"),
+		Converts("CREATE GROUP name WITH NOREPLICATION BYPASSRLS"),
+		Parses("CREATE GROUP name BYPASSRLS BYPASSRLS"),
+		Parses("CREATE GROUP name WITH BYPASSRLS BYPASSRLS"),
+		Parses("CREATE GROUP name NOBYPASSRLS BYPASSRLS"),
+		Parses("CREATE GROUP name WITH NOBYPASSRLS BYPASSRLS"),
+		Converts("CREATE GROUP name CONNECTION LIMIT -1 BYPASSRLS"),
+		Converts("CREATE GROUP name WITH CONNECTION LIMIT -1 BYPASSRLS"),
+		Converts("CREATE GROUP name PASSWORD ' password ' BYPASSRLS"),
+		Converts("CREATE GROUP name WITH PASSWORD ' password ' BYPASSRLS"),
+		Converts("CREATE GROUP name ENCRYPTED PASSWORD ' password ' BYPASSRLS"),
+		Converts("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' BYPASSRLS"),
+		Converts("CREATE GROUP name PASSWORD NULL BYPASSRLS"),
+		Converts("CREATE GROUP name WITH PASSWORD NULL BYPASSRLS"),
+		Parses("CREATE GROUP name VALID UNTIL ' timestamp ' BYPASSRLS"),
+		Parses("CREATE GROUP name WITH VALID UNTIL ' timestamp ' BYPASSRLS"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name BYPASSRLS"),
 		Unimplemented("CREATE GROUP name WITH IN ROLE role_name BYPASSRLS"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name , role_name BYPASSRLS"),
@@ -859,44 +859,44 @@ func TestCreateGroup(t *testing.T) {
 		Unimplemented("CREATE GROUP name WITH USER role_name , role_name BYPASSRLS"),
 		Unimplemented("CREATE GROUP name SYSID 1 BYPASSRLS"),
 		Unimplemented("CREATE GROUP name WITH SYSID 1 BYPASSRLS"),
-		Unimplemented("CREATE GROUP name SUPERUSER NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH SUPERUSER NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name NOSUPERUSER NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH NOSUPERUSER NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name CREATEDB NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH CREATEDB NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name NOCREATEDB NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH NOCREATEDB NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name CREATEROLE NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH CREATEROLE NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name NOCREATEROLE NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH NOCREATEROLE NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name INHERIT NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH INHERIT NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name NOINHERIT NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH NOINHERIT NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name LOGIN NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH LOGIN NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name NOLOGIN NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH NOLOGIN NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name REPLICATION NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH REPLICATION NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name NOREPLICATION NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH NOREPLICATION NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name BYPASSRLS NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH BYPASSRLS NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name NOBYPASSRLS NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH NOBYPASSRLS NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name CONNECTION LIMIT -1 NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH CONNECTION LIMIT -1 NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name PASSWORD ' password ' NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH PASSWORD ' password ' NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name ENCRYPTED PASSWORD ' password ' NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name PASSWORD NULL NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH PASSWORD NULL NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name VALID UNTIL ' timestamp ' NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name WITH VALID UNTIL ' timestamp ' NOBYPASSRLS"),
+		Converts("CREATE GROUP name SUPERUSER NOBYPASSRLS"),
+		Converts("CREATE GROUP name WITH SUPERUSER NOBYPASSRLS"),
+		Converts("CREATE GROUP name NOSUPERUSER NOBYPASSRLS"),
+		Converts("CREATE GROUP name WITH NOSUPERUSER NOBYPASSRLS"),
+		Converts("CREATE GROUP name CREATEDB NOBYPASSRLS"),
+		Converts("CREATE GROUP name WITH CREATEDB NOBYPASSRLS"),
+		Converts("CREATE GROUP name NOCREATEDB NOBYPASSRLS"),
+		Converts("CREATE GROUP name WITH NOCREATEDB NOBYPASSRLS"),
+		Converts("CREATE GROUP name CREATEROLE NOBYPASSRLS"),
+		Converts("CREATE GROUP name WITH CREATEROLE NOBYPASSRLS"),
+		Converts("CREATE GROUP name NOCREATEROLE NOBYPASSRLS"),
+		Converts("CREATE GROUP name WITH NOCREATEROLE NOBYPASSRLS"),
+		Converts("CREATE GROUP name INHERIT NOBYPASSRLS"),
+		Converts("CREATE GROUP name WITH INHERIT NOBYPASSRLS"),
+		Converts("CREATE GROUP name NOINHERIT NOBYPASSRLS"),
+		Converts("CREATE GROUP name WITH NOINHERIT NOBYPASSRLS"),
+		Converts("CREATE GROUP name LOGIN NOBYPASSRLS"),
+		Converts("CREATE GROUP name WITH LOGIN NOBYPASSRLS"),
+		Converts("CREATE GROUP name NOLOGIN NOBYPASSRLS"),
+		Converts("CREATE GROUP name WITH NOLOGIN NOBYPASSRLS"),
+		Converts("CREATE GROUP name REPLICATION NOBYPASSRLS"),
+		Converts("CREATE GROUP name WITH REPLICATION NOBYPASSRLS"),
+		Converts("CREATE GROUP name NOREPLICATION NOBYPASSRLS"),
+		Converts("CREATE GROUP name WITH NOREPLICATION NOBYPASSRLS"),
+		Parses("CREATE GROUP name BYPASSRLS NOBYPASSRLS"),
+		Parses("CREATE GROUP name WITH BYPASSRLS NOBYPASSRLS"),
+		Parses("CREATE GROUP name NOBYPASSRLS NOBYPASSRLS"),
+		Parses("CREATE GROUP name WITH NOBYPASSRLS NOBYPASSRLS"),
+		Converts("CREATE GROUP name CONNECTION LIMIT -1 NOBYPASSRLS"),
+		Converts("CREATE GROUP name WITH CONNECTION LIMIT -1 NOBYPASSRLS"),
+		Converts("CREATE GROUP name PASSWORD ' password ' NOBYPASSRLS"),
+		Converts("CREATE GROUP name WITH PASSWORD ' password ' NOBYPASSRLS"),
+		Converts("CREATE GROUP name ENCRYPTED PASSWORD ' password ' NOBYPASSRLS"),
+		Converts("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' NOBYPASSRLS"),
+		Converts("CREATE GROUP name PASSWORD NULL NOBYPASSRLS"),
+		Converts("CREATE GROUP name WITH PASSWORD NULL NOBYPASSRLS"),
+		Parses("CREATE GROUP name VALID UNTIL ' timestamp ' NOBYPASSRLS"),
+		Parses("CREATE GROUP name WITH VALID UNTIL ' timestamp ' NOBYPASSRLS"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name NOBYPASSRLS"),
 		Unimplemented("CREATE GROUP name WITH IN ROLE role_name NOBYPASSRLS"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name , role_name NOBYPASSRLS"),
@@ -919,44 +919,44 @@ func TestCreateGroup(t *testing.T) {
 		Unimplemented("CREATE GROUP name WITH USER role_name , role_name NOBYPASSRLS"),
 		Unimplemented("CREATE GROUP name SYSID 1 NOBYPASSRLS"),
 		Unimplemented("CREATE GROUP name WITH SYSID 1 NOBYPASSRLS"),
-		Unimplemented("CREATE GROUP name SUPERUSER CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name WITH SUPERUSER CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name NOSUPERUSER CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name WITH NOSUPERUSER CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name CREATEDB CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name WITH CREATEDB CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name NOCREATEDB CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name WITH NOCREATEDB CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name CREATEROLE CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name WITH CREATEROLE CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name NOCREATEROLE CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name WITH NOCREATEROLE CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name INHERIT CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name WITH INHERIT CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name NOINHERIT CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name WITH NOINHERIT CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name LOGIN CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name WITH LOGIN CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name NOLOGIN CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name WITH NOLOGIN CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name REPLICATION CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name WITH REPLICATION CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name NOREPLICATION CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name WITH NOREPLICATION CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name BYPASSRLS CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name WITH BYPASSRLS CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name NOBYPASSRLS CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name WITH NOBYPASSRLS CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name WITH CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name PASSWORD ' password ' CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name WITH PASSWORD ' password ' CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name ENCRYPTED PASSWORD ' password ' CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name PASSWORD NULL CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name WITH PASSWORD NULL CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name VALID UNTIL ' timestamp ' CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name WITH VALID UNTIL ' timestamp ' CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name SUPERUSER CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name WITH SUPERUSER CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name NOSUPERUSER CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name WITH NOSUPERUSER CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name CREATEDB CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name WITH CREATEDB CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name NOCREATEDB CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name WITH NOCREATEDB CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name CREATEROLE CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name WITH CREATEROLE CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name NOCREATEROLE CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name WITH NOCREATEROLE CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name INHERIT CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name WITH INHERIT CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name NOINHERIT CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name WITH NOINHERIT CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name LOGIN CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name WITH LOGIN CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name NOLOGIN CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name WITH NOLOGIN CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name REPLICATION CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name WITH REPLICATION CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name NOREPLICATION CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name WITH NOREPLICATION CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name BYPASSRLS CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name WITH BYPASSRLS CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name NOBYPASSRLS CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name WITH NOBYPASSRLS CONNECTION LIMIT -1"),
+		Parses("CREATE GROUP name CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
+		Parses("CREATE GROUP name WITH CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name PASSWORD ' password ' CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name WITH PASSWORD ' password ' CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name ENCRYPTED PASSWORD ' password ' CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name PASSWORD NULL CONNECTION LIMIT -1"),
+		Converts("CREATE GROUP name WITH PASSWORD NULL CONNECTION LIMIT -1"),
+		Parses("CREATE GROUP name VALID UNTIL ' timestamp ' CONNECTION LIMIT -1"),
+		Parses("CREATE GROUP name WITH VALID UNTIL ' timestamp ' CONNECTION LIMIT -1"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name CONNECTION LIMIT -1"),
 		Unimplemented("CREATE GROUP name WITH IN ROLE role_name CONNECTION LIMIT -1"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name , role_name CONNECTION LIMIT -1"),
@@ -979,40 +979,40 @@ func TestCreateGroup(t *testing.T) {
 		Unimplemented("CREATE GROUP name WITH USER role_name , role_name CONNECTION LIMIT -1"),
 		Unimplemented("CREATE GROUP name SYSID 1 CONNECTION LIMIT -1"),
 		Unimplemented("CREATE GROUP name WITH SYSID 1 CONNECTION LIMIT -1"),
-		Unimplemented("CREATE GROUP name SUPERUSER PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH SUPERUSER PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name NOSUPERUSER PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH NOSUPERUSER PASSWORD ' password '"),
-		Parses("CREATE GROUP name CREATEDB PASSWORD ' password '"),
-		Parses("CREATE GROUP name WITH CREATEDB PASSWORD ' password '"),
-		Parses("CREATE GROUP name NOCREATEDB PASSWORD ' password '"),
-		Parses("CREATE GROUP name WITH NOCREATEDB PASSWORD ' password '"),
-		Parses("CREATE GROUP name CREATEROLE PASSWORD ' password '"),
-		Parses("CREATE GROUP name WITH CREATEROLE PASSWORD ' password '"),
-		Parses("CREATE GROUP name NOCREATEROLE PASSWORD ' password '"),
-		Parses("CREATE GROUP name WITH NOCREATEROLE PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name INHERIT PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH INHERIT PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name NOINHERIT PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH NOINHERIT PASSWORD ' password '"),
-		Parses("CREATE GROUP name LOGIN PASSWORD ' password '"),
-		Parses("CREATE GROUP name WITH LOGIN PASSWORD ' password '"),
-		Parses("CREATE GROUP name NOLOGIN PASSWORD ' password '"),
-		Parses("CREATE GROUP name WITH NOLOGIN PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name REPLICATION PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH REPLICATION PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name NOREPLICATION PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH NOREPLICATION PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name BYPASSRLS PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH BYPASSRLS PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name NOBYPASSRLS PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH NOBYPASSRLS PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name CONNECTION LIMIT -1 PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH CONNECTION LIMIT -1 PASSWORD ' password '"),
+		Converts("CREATE GROUP name SUPERUSER PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH SUPERUSER PASSWORD ' password '"),
+		Converts("CREATE GROUP name NOSUPERUSER PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH NOSUPERUSER PASSWORD ' password '"),
+		Converts("CREATE GROUP name CREATEDB PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH CREATEDB PASSWORD ' password '"),
+		Converts("CREATE GROUP name NOCREATEDB PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH NOCREATEDB PASSWORD ' password '"),
+		Converts("CREATE GROUP name CREATEROLE PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH CREATEROLE PASSWORD ' password '"),
+		Converts("CREATE GROUP name NOCREATEROLE PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH NOCREATEROLE PASSWORD ' password '"),
+		Converts("CREATE GROUP name INHERIT PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH INHERIT PASSWORD ' password '"),
+		Converts("CREATE GROUP name NOINHERIT PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH NOINHERIT PASSWORD ' password '"),
+		Converts("CREATE GROUP name LOGIN PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH LOGIN PASSWORD ' password '"),
+		Converts("CREATE GROUP name NOLOGIN PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH NOLOGIN PASSWORD ' password '"),
+		Converts("CREATE GROUP name REPLICATION PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH REPLICATION PASSWORD ' password '"),
+		Converts("CREATE GROUP name NOREPLICATION PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH NOREPLICATION PASSWORD ' password '"),
+		Converts("CREATE GROUP name BYPASSRLS PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH BYPASSRLS PASSWORD ' password '"),
+		Converts("CREATE GROUP name NOBYPASSRLS PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH NOBYPASSRLS PASSWORD ' password '"),
+		Converts("CREATE GROUP name CONNECTION LIMIT -1 PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH CONNECTION LIMIT -1 PASSWORD ' password '"),
 		Parses("CREATE GROUP name PASSWORD ' password ' PASSWORD ' password '"),
 		Parses("CREATE GROUP name WITH PASSWORD ' password ' PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name ENCRYPTED PASSWORD ' password ' PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' PASSWORD ' password '"),
+		Parses("CREATE GROUP name ENCRYPTED PASSWORD ' password ' PASSWORD ' password '"),
+		Parses("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' PASSWORD ' password '"),
 		Parses("CREATE GROUP name PASSWORD NULL PASSWORD ' password '"),
 		Parses("CREATE GROUP name WITH PASSWORD NULL PASSWORD ' password '"),
 		Parses("CREATE GROUP name VALID UNTIL ' timestamp ' PASSWORD ' password '"),
@@ -1039,44 +1039,44 @@ func TestCreateGroup(t *testing.T) {
 		Unimplemented("CREATE GROUP name WITH USER role_name , role_name PASSWORD ' password '"),
 		Unimplemented("CREATE GROUP name SYSID 1 PASSWORD ' password '"),
 		Unimplemented("CREATE GROUP name WITH SYSID 1 PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name SUPERUSER ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH SUPERUSER ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name NOSUPERUSER ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH NOSUPERUSER ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name CREATEDB ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH CREATEDB ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name NOCREATEDB ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH NOCREATEDB ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name CREATEROLE ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH CREATEROLE ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name NOCREATEROLE ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH NOCREATEROLE ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name INHERIT ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH INHERIT ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name NOINHERIT ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH NOINHERIT ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name LOGIN ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH LOGIN ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name NOLOGIN ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH NOLOGIN ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name REPLICATION ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH REPLICATION ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name NOREPLICATION ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH NOREPLICATION ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name BYPASSRLS ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH BYPASSRLS ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name NOBYPASSRLS ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH NOBYPASSRLS ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name CONNECTION LIMIT -1 ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH CONNECTION LIMIT -1 ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name ENCRYPTED PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name PASSWORD NULL ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH PASSWORD NULL ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name VALID UNTIL ' timestamp ' ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name WITH VALID UNTIL ' timestamp ' ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name SUPERUSER ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH SUPERUSER ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name NOSUPERUSER ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH NOSUPERUSER ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name CREATEDB ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH CREATEDB ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name NOCREATEDB ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH NOCREATEDB ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name CREATEROLE ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH CREATEROLE ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name NOCREATEROLE ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH NOCREATEROLE ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name INHERIT ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH INHERIT ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name NOINHERIT ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH NOINHERIT ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name LOGIN ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH LOGIN ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name NOLOGIN ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH NOLOGIN ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name REPLICATION ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH REPLICATION ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name NOREPLICATION ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH NOREPLICATION ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name BYPASSRLS ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH BYPASSRLS ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name NOBYPASSRLS ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH NOBYPASSRLS ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name CONNECTION LIMIT -1 ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE GROUP name WITH CONNECTION LIMIT -1 ENCRYPTED PASSWORD ' password '"),
+		Parses("CREATE GROUP name PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
+		Parses("CREATE GROUP name WITH PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
+		Parses("CREATE GROUP name ENCRYPTED PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
+		Parses("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
+		Parses("CREATE GROUP name PASSWORD NULL ENCRYPTED PASSWORD ' password '"),
+		Parses("CREATE GROUP name WITH PASSWORD NULL ENCRYPTED PASSWORD ' password '"),
+		Parses("CREATE GROUP name VALID UNTIL ' timestamp ' ENCRYPTED PASSWORD ' password '"),
+		Parses("CREATE GROUP name WITH VALID UNTIL ' timestamp ' ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("CREATE GROUP name WITH IN ROLE role_name ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("CREATE GROUP name IN ROLE role_name , role_name ENCRYPTED PASSWORD ' password '"),
@@ -1099,40 +1099,40 @@ func TestCreateGroup(t *testing.T) {
 		Unimplemented("CREATE GROUP name WITH USER role_name , role_name ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("CREATE GROUP name SYSID 1 ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("CREATE GROUP name WITH SYSID 1 ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE GROUP name SUPERUSER PASSWORD NULL"),
-		Unimplemented("CREATE GROUP name WITH SUPERUSER PASSWORD NULL"),
-		Unimplemented("CREATE GROUP name NOSUPERUSER PASSWORD NULL"),
-		Unimplemented("CREATE GROUP name WITH NOSUPERUSER PASSWORD NULL"),
-		Parses("CREATE GROUP name CREATEDB PASSWORD NULL"),
-		Parses("CREATE GROUP name WITH CREATEDB PASSWORD NULL"),
-		Parses("CREATE GROUP name NOCREATEDB PASSWORD NULL"),
-		Parses("CREATE GROUP name WITH NOCREATEDB PASSWORD NULL"),
-		Parses("CREATE GROUP name CREATEROLE PASSWORD NULL"),
-		Parses("CREATE GROUP name WITH CREATEROLE PASSWORD NULL"),
-		Parses("CREATE GROUP name NOCREATEROLE PASSWORD NULL"),
-		Parses("CREATE GROUP name WITH NOCREATEROLE PASSWORD NULL"),
-		Unimplemented("CREATE GROUP name INHERIT PASSWORD NULL"),
-		Unimplemented("CREATE GROUP name WITH INHERIT PASSWORD NULL"),
-		Unimplemented("CREATE GROUP name NOINHERIT PASSWORD NULL"),
-		Unimplemented("CREATE GROUP name WITH NOINHERIT PASSWORD NULL"),
-		Parses("CREATE GROUP name LOGIN PASSWORD NULL"),
-		Parses("CREATE GROUP name WITH LOGIN PASSWORD NULL"),
-		Parses("CREATE GROUP name NOLOGIN PASSWORD NULL"),
-		Parses("CREATE GROUP name WITH NOLOGIN PASSWORD NULL"),
-		Unimplemented("CREATE GROUP name REPLICATION PASSWORD NULL"),
-		Unimplemented("CREATE GROUP name WITH REPLICATION PASSWORD NULL"),
-		Unimplemented("CREATE GROUP name NOREPLICATION PASSWORD NULL"),
-		Unimplemented("CREATE GROUP name WITH NOREPLICATION PASSWORD NULL"),
-		Unimplemented("CREATE GROUP name BYPASSRLS PASSWORD NULL"),
-		Unimplemented("CREATE GROUP name WITH BYPASSRLS PASSWORD NULL"),
-		Unimplemented("CREATE GROUP name NOBYPASSRLS PASSWORD NULL"),
-		Unimplemented("CREATE GROUP name WITH NOBYPASSRLS PASSWORD NULL"),
-		Unimplemented("CREATE GROUP name CONNECTION LIMIT -1 PASSWORD NULL"),
-		Unimplemented("CREATE GROUP name WITH CONNECTION LIMIT -1 PASSWORD NULL"),
+		Converts("CREATE GROUP name SUPERUSER PASSWORD NULL"),
+		Converts("CREATE GROUP name WITH SUPERUSER PASSWORD NULL"),
+		Converts("CREATE GROUP name NOSUPERUSER PASSWORD NULL"),
+		Converts("CREATE GROUP name WITH NOSUPERUSER PASSWORD NULL"),
+		Converts("CREATE GROUP name CREATEDB PASSWORD NULL"),
+		Converts("CREATE GROUP name WITH CREATEDB PASSWORD NULL"),
+		Converts("CREATE GROUP name NOCREATEDB PASSWORD NULL"),
+		Converts("CREATE GROUP name WITH NOCREATEDB PASSWORD NULL"),
+		Converts("CREATE GROUP name CREATEROLE PASSWORD NULL"),
+		Converts("CREATE GROUP name WITH CREATEROLE PASSWORD NULL"),
+		Converts("CREATE GROUP name NOCREATEROLE PASSWORD NULL"),
+		Converts("CREATE GROUP name WITH NOCREATEROLE PASSWORD NULL"),
+		Converts("CREATE GROUP name INHERIT PASSWORD NULL"),
+		Converts("CREATE GROUP name WITH INHERIT PASSWORD NULL"),
+		Converts("CREATE GROUP name NOINHERIT PASSWORD NULL"),
+		Converts("CREATE GROUP name WITH NOINHERIT PASSWORD NULL"),
+		Converts("CREATE GROUP name LOGIN PASSWORD NULL"),
+		Converts("CREATE GROUP name WITH LOGIN PASSWORD NULL"),
+		Converts("CREATE GROUP name NOLOGIN PASSWORD NULL"),
+		Converts("CREATE GROUP name WITH NOLOGIN PASSWORD NULL"),
+		Converts("CREATE GROUP name REPLICATION PASSWORD NULL"),
+		Converts("CREATE GROUP name WITH REPLICATION PASSWORD NULL"),
+		Converts("CREATE GROUP name NOREPLICATION PASSWORD NULL"),
+		Converts("CREATE GROUP name WITH NOREPLICATION PASSWORD NULL"),
+		Converts("CREATE GROUP name BYPASSRLS PASSWORD NULL"),
+		Converts("CREATE GROUP name WITH BYPASSRLS PASSWORD NULL"),
+		Converts("CREATE GROUP name NOBYPASSRLS PASSWORD NULL"),
+		Converts("CREATE GROUP name WITH NOBYPASSRLS PASSWORD NULL"),
+		Converts("CREATE GROUP name CONNECTION LIMIT -1 PASSWORD NULL"),
+		Converts("CREATE GROUP name WITH CONNECTION LIMIT -1 PASSWORD NULL"),
 		Parses("CREATE GROUP name PASSWORD ' password ' PASSWORD NULL"),
 		Parses("CREATE GROUP name WITH PASSWORD ' password ' PASSWORD NULL"),
-		Unimplemented("CREATE GROUP name ENCRYPTED PASSWORD ' password ' PASSWORD NULL"),
-		Unimplemented("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' PASSWORD NULL"),
+		Parses("CREATE GROUP name ENCRYPTED PASSWORD ' password ' PASSWORD NULL"),
+		Parses("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' PASSWORD NULL"),
 		Parses("CREATE GROUP name PASSWORD NULL PASSWORD NULL"),
 		Parses("CREATE GROUP name WITH PASSWORD NULL PASSWORD NULL"),
 		Parses("CREATE GROUP name VALID UNTIL ' timestamp ' PASSWORD NULL"),
@@ -1159,10 +1159,10 @@ func TestCreateGroup(t *testing.T) {
 		Unimplemented("CREATE GROUP name WITH USER role_name , role_name PASSWORD NULL"),
 		Unimplemented("CREATE GROUP name SYSID 1 PASSWORD NULL"),
 		Unimplemented("CREATE GROUP name WITH SYSID 1 PASSWORD NULL"),
-		Unimplemented("CREATE GROUP name SUPERUSER VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE GROUP name WITH SUPERUSER VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE GROUP name NOSUPERUSER VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE GROUP name WITH NOSUPERUSER VALID UNTIL ' timestamp '"),
+		Parses("CREATE GROUP name SUPERUSER VALID UNTIL ' timestamp '"),
+		Parses("CREATE GROUP name WITH SUPERUSER VALID UNTIL ' timestamp '"),
+		Parses("CREATE GROUP name NOSUPERUSER VALID UNTIL ' timestamp '"),
+		Parses("CREATE GROUP name WITH NOSUPERUSER VALID UNTIL ' timestamp '"),
 		Parses("CREATE GROUP name CREATEDB VALID UNTIL ' timestamp '"),
 		Parses("CREATE GROUP name WITH CREATEDB VALID UNTIL ' timestamp '"),
 		Parses("CREATE GROUP name NOCREATEDB VALID UNTIL ' timestamp '"),
@@ -1171,28 +1171,28 @@ func TestCreateGroup(t *testing.T) {
 		Parses("CREATE GROUP name WITH CREATEROLE VALID UNTIL ' timestamp '"),
 		Parses("CREATE GROUP name NOCREATEROLE VALID UNTIL ' timestamp '"),
 		Parses("CREATE GROUP name WITH NOCREATEROLE VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE GROUP name INHERIT VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE GROUP name WITH INHERIT VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE GROUP name NOINHERIT VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE GROUP name WITH NOINHERIT VALID UNTIL ' timestamp '"),
+		Parses("CREATE GROUP name INHERIT VALID UNTIL ' timestamp '"),
+		Parses("CREATE GROUP name WITH INHERIT VALID UNTIL ' timestamp '"),
+		Parses("CREATE GROUP name NOINHERIT VALID UNTIL ' timestamp '"),
+		Parses("CREATE GROUP name WITH NOINHERIT VALID UNTIL ' timestamp '"),
 		Parses("CREATE GROUP name LOGIN VALID UNTIL ' timestamp '"),
 		Parses("CREATE GROUP name WITH LOGIN VALID UNTIL ' timestamp '"),
 		Parses("CREATE GROUP name NOLOGIN VALID UNTIL ' timestamp '"),
 		Parses("CREATE GROUP name WITH NOLOGIN VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE GROUP name REPLICATION VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE GROUP name WITH REPLICATION VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE GROUP name NOREPLICATION VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE GROUP name WITH NOREPLICATION VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE GROUP name BYPASSRLS VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE GROUP name WITH BYPASSRLS VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE GROUP name NOBYPASSRLS VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE GROUP name WITH NOBYPASSRLS VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE GROUP name CONNECTION LIMIT -1 VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE GROUP name WITH CONNECTION LIMIT -1 VALID UNTIL ' timestamp '"),
+		Parses("CREATE GROUP name REPLICATION VALID UNTIL ' timestamp '"),
+		Parses("CREATE GROUP name WITH REPLICATION VALID UNTIL ' timestamp '"),
+		Parses("CREATE GROUP name NOREPLICATION VALID UNTIL ' timestamp '"),
+		Parses("CREATE GROUP name WITH NOREPLICATION VALID UNTIL ' timestamp '"),
+		Parses("CREATE GROUP name BYPASSRLS VALID UNTIL ' timestamp '"),
+		Parses("CREATE GROUP name WITH BYPASSRLS VALID UNTIL ' timestamp '"),
+		Parses("CREATE GROUP name NOBYPASSRLS VALID UNTIL ' timestamp '"),
+		Parses("CREATE GROUP name WITH NOBYPASSRLS VALID UNTIL ' timestamp '"),
+		Parses("CREATE GROUP name CONNECTION LIMIT -1 VALID UNTIL ' timestamp '"),
+		Parses("CREATE GROUP name WITH CONNECTION LIMIT -1 VALID UNTIL ' timestamp '"),
 		Parses("CREATE GROUP name PASSWORD ' password ' VALID UNTIL ' timestamp '"),
 		Parses("CREATE GROUP name WITH PASSWORD ' password ' VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE GROUP name ENCRYPTED PASSWORD ' password ' VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' VALID UNTIL ' timestamp '"),
+		Parses("CREATE GROUP name ENCRYPTED PASSWORD ' password ' VALID UNTIL ' timestamp '"),
+		Parses("CREATE GROUP name WITH ENCRYPTED PASSWORD ' password ' VALID UNTIL ' timestamp '"),
 		Parses("CREATE GROUP name PASSWORD NULL VALID UNTIL ' timestamp '"),
 		Parses("CREATE GROUP name WITH PASSWORD NULL VALID UNTIL ' timestamp '"),
 		Parses("CREATE GROUP name VALID UNTIL ' timestamp ' VALID UNTIL ' timestamp '"),
@@ -22,7 +22,7 @@ func TestCreateIndex(t *testing.T) {
 		Parses("CREATE UNIQUE INDEX CONCURRENTLY ON table_name USING method ( ( expression ) ASC NULLS LAST , ( expression ) )"),
 		Parses("CREATE INDEX name ON table_name ( column_name COLLATE en_US opclass ( opclass_parameter = value , opclass_parameter = value ) DESC NULLS LAST , column_name opclass )"),
 		Parses("CREATE UNIQUE INDEX ON ONLY table_name ( column_name COLLATE en_US ASC NULLS LAST , column_name opclass ( opclass_parameter = value , opclass_parameter = value ) )"),
-		Parses("CREATE UNIQUE INDEX name ON ONLY table_name ( ( expression ) ASC NULLS FIRST , column_name ASC )"),
+		Converts("CREATE UNIQUE INDEX name ON ONLY table_name ( ( expression ) ASC NULLS FIRST , column_name ASC )"),
 		Parses("CREATE INDEX ON table_name ( ( expression ) COLLATE en_US opclass NULLS LAST , ( expression ) COLLATE en_US ASC )"),
 		Parses("CREATE UNIQUE INDEX name ON ONLY table_name USING method ( ( expression ) DESC NULLS LAST , ( expression ) DESC )"),
 		Parses("CREATE UNIQUE INDEX CONCURRENTLY ON ONLY table_name ( column_name COLLATE en_US opclass ASC , ( expression ) opclass DESC )"),
@@ -3365,201 +3365,201 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) LANGUAGE lang_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) LANGUAGE lang_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) LANGUAGE lang_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , IN FLOAT8 ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , IN FLOAT8 ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , IN FLOAT8 ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , OUT FLOAT8 ) SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , OUT FLOAT8 ) SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , IN argname FLOAT8 ) SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , OUT argname FLOAT8 ) SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , FLOAT8 DEFAULT default_expr ) SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , FLOAT8 DEFAULT default_expr ) SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , FLOAT8 = default_expr ) SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 = default_expr ) SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , FLOAT8 = default_expr ) SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 = default_expr ) SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 = default_expr ) SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , FLOAT8 ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , FLOAT8 ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT FLOAT8 ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , INOUT argname FLOAT8 ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , INOUT argname FLOAT8 ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr ) SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr ) SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , FLOAT8 ) SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 ) SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , INOUT argname FLOAT8 ) SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , IN argname FLOAT8 = default_expr ) SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , IN FLOAT8 ) SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , IN FLOAT8 ) SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 ) SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 , OUT FLOAT8 ) SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 ) SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT FLOAT8 ) SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 , OUT FLOAT8 ) SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 ) SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT FLOAT8 ) SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 ) SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , IN argname FLOAT8 ) SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 ) SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 ) SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 ) SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , argname FLOAT8 ) SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 = default_expr ) SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 = default_expr ) SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , OUT argname FLOAT8 = default_expr ) SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 ) SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 ) SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 ) SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , VARIADIC FLOAT8 ) SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , argname FLOAT8 ) SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 ) SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 ) SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 ) SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , IN argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 ) AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 ) AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , IN FLOAT8 ) AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC FLOAT8 ) AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC FLOAT8 ) AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT FLOAT8 ) AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT FLOAT8 ) AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT FLOAT8 ) AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 ) AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 ) AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , FLOAT8 = default_expr ) AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , FLOAT8 = default_expr ) AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , IN FLOAT8 = default_expr ) AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , VARIADIC FLOAT8 = default_expr ) AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) AS ' definition ' BEGIN ATOMIC END"),
@@ -3569,8 +3569,8 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , VARIADIC FLOAT8 ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , IN argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
@@ -3578,18 +3578,18 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , IN argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
@@ -3909,7 +3909,7 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , OUT argname FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , INOUT argname FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT argname FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
@@ -3920,24 +3920,24 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , VARIADIC FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , INOUT FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 , INOUT FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , IN argname FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , OUT argname FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , INOUT argname FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , IN FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , OUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
@@ -3948,8 +3948,8 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
@@ -3957,40 +3957,40 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , IN argname FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , argname FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , argname FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
@@ -3998,7 +3998,7 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , OUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
@@ -4009,51 +4009,51 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , IN FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , argname FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , VARIADIC argname FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , IN argname FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN argname FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC FLOAT8 ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , argname FLOAT8 ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
@@ -4067,24 +4067,24 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , OUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , IN argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , IN argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT argname FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , OUT FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , VARIADIC argname FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , IN FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
@@ -4092,22 +4092,22 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , argname FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , IN argname FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , IN argname FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , VARIADIC FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 ) LANGUAGE lang_name TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , FLOAT8 ) LANGUAGE lang_name TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN FLOAT8 ) LANGUAGE lang_name TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
@@ -4166,45 +4166,45 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , VARIADIC FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , VARIADIC argname FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , OUT argname FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , OUT argname FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , OUT argname FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , IN FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , IN FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , IN argname FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , VARIADIC FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , IN argname FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , OUT FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 , OUT FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , IN argname FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT argname FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
@@ -4212,20 +4212,20 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , VARIADIC FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , argname FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC argname FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
@@ -4234,7 +4234,7 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
@@ -4244,40 +4244,40 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , IN FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , VARIADIC FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT argname FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT argname FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , OUT FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , argname FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , INOUT FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
@@ -4286,20 +4286,20 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC argname FLOAT8 ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
@@ -4307,45 +4307,45 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , OUT FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , OUT FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , IN FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , VARIADIC FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 ) LANGUAGE lang_name SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , argname FLOAT8 ) LANGUAGE lang_name SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 , IN argname FLOAT8 ) LANGUAGE lang_name SECURITY INVOKER BEGIN ATOMIC END"),
@@ -4360,7 +4360,7 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , argname FLOAT8 = default_expr ) LANGUAGE lang_name SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 , IN argname FLOAT8 = default_expr ) LANGUAGE lang_name SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) LANGUAGE lang_name SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
@@ -4370,9 +4370,9 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
@@ -4386,8 +4386,8 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
@@ -4395,7 +4395,7 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) SECURITY INVOKER SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , IN argname FLOAT8 ) SECURITY INVOKER SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , IN argname FLOAT8 ) SECURITY INVOKER SECURITY INVOKER BEGIN ATOMIC END"),
@@ -4476,57 +4476,57 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , INOUT FLOAT8 ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , OUT argname FLOAT8 ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , OUT argname FLOAT8 ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 , OUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 , OUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 ) SET configuration_parameter = value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , INOUT FLOAT8 ) SET configuration_parameter = value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , argname FLOAT8 ) SET configuration_parameter = value SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 ) SET configuration_parameter = value SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 ) SET configuration_parameter = value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , OUT argname FLOAT8 ) SET configuration_parameter = value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SET configuration_parameter = value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , VARIADIC FLOAT8 ) SET configuration_parameter FROM CURRENT SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT FLOAT8 ) SET configuration_parameter FROM CURRENT SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 ) SET configuration_parameter FROM CURRENT SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , OUT argname FLOAT8 ) SET configuration_parameter FROM CURRENT SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , OUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER BEGIN ATOMIC END"),
@@ -4536,8 +4536,8 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , VARIADIC FLOAT8 ) AS ' definition ' SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY INVOKER BEGIN ATOMIC END"),
@@ -4551,14 +4551,14 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) AS ' definition ' SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) AS ' definition ' SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) AS ' definition ' SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT FLOAT8 = default_expr ) AS ' definition ' SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT FLOAT8 = default_expr ) AS ' definition ' SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) AS ' definition ' SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , OUT argname FLOAT8 = default_expr ) AS ' definition ' SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) AS ' definition ' SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , INOUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER BEGIN ATOMIC END"),
@@ -4566,11 +4566,11 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , VARIADIC FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , FLOAT8 ) LANGUAGE lang_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , FLOAT8 ) LANGUAGE lang_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
@@ -4601,53 +4601,53 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 = default_expr ) LANGUAGE lang_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) LANGUAGE lang_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) LANGUAGE lang_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 ) SECURITY INVOKER EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
@@ -4727,31 +4727,31 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 , INOUT FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 , INOUT FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , argname FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
@@ -4759,11 +4759,11 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , IN argname FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT argname FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
@@ -4777,8 +4777,8 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , argname FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , IN argname FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , IN FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , IN FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , VARIADIC FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , argname FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
@@ -4790,13 +4790,13 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , FLOAT8 ) AS ' definition ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC FLOAT8 ) AS ' definition ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
@@ -4804,21 +4804,21 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) AS ' definition ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) AS ' definition ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) AS ' definition ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , VARIADIC FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 , INOUT FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 , INOUT FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , OUT argname FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
@@ -4827,18 +4827,18 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , VARIADIC argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , OUT argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 ) LANGUAGE lang_name SECURITY DEFINER BEGIN ATOMIC END"),
@@ -4870,8 +4870,8 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
@@ -4879,13 +4879,13 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
@@ -4898,9 +4898,9 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 ) SECURITY INVOKER SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr ) SECURITY INVOKER SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 ) SECURITY INVOKER SECURITY DEFINER BEGIN ATOMIC END"),
@@ -4996,27 +4996,27 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , IN argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , OUT FLOAT8 ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , OUT argname FLOAT8 ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , OUT FLOAT8 ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , OUT argname FLOAT8 ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY DEFINER BEGIN ATOMIC END"),
@@ -5033,37 +5033,37 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , OUT argname FLOAT8 ) SET configuration_parameter = value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) SET configuration_parameter = value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 ) SET configuration_parameter = value SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , IN argname FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 ) SET configuration_parameter FROM CURRENT SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 ) SET configuration_parameter FROM CURRENT SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 ) SET configuration_parameter FROM CURRENT SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 ) SET configuration_parameter FROM CURRENT SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , VARIADIC argname FLOAT8 ) SET configuration_parameter FROM CURRENT SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , IN argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER BEGIN ATOMIC END"),
@@ -5081,12 +5081,12 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) AS ' definition ' SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) AS ' definition ' SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) AS ' definition ' SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 = default_expr ) AS ' definition ' SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 = default_expr ) AS ' definition ' SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) AS ' definition ' SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) AS ' definition ' SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER BEGIN ATOMIC END"),
@@ -5104,11 +5104,11 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER BEGIN ATOMIC END"),
@@ -5143,7 +5143,7 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) LANGUAGE lang_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , IN FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , argname FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , argname FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , argname FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
@@ -5151,26 +5151,26 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
@@ -5179,7 +5179,7 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
@@ -5187,7 +5187,7 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 ) SECURITY INVOKER EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , VARIADIC FLOAT8 ) SECURITY INVOKER EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT FLOAT8 ) SECURITY INVOKER EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
@@ -5268,28 +5268,28 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC argname FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , OUT FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , OUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , VARIADIC FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
@@ -5304,7 +5304,7 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , OUT argname FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
@@ -5325,16 +5325,16 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , OUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 ) AS ' definition ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 ) AS ' definition ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) AS ' definition ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT FLOAT8 ) AS ' definition ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN argname FLOAT8 ) AS ' definition ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
@@ -5342,8 +5342,8 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
@@ -5352,22 +5352,22 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , argname FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , OUT argname FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , IN argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER BEGIN ATOMIC END"),
@@ -5407,36 +5407,36 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 ) SECURITY INVOKER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , OUT FLOAT8 ) SECURITY INVOKER SET configuration_parameter TO value BEGIN ATOMIC END"),
@@ -5446,16 +5446,16 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , VARIADIC argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , INOUT FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , INOUT FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , OUT argname FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value BEGIN ATOMIC END"),
@@ -5470,17 +5470,17 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , FLOAT8 ) SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , FLOAT8 ) SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 ) SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 ) SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , OUT argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , OUT argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , INOUT argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , INOUT argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
@@ -5494,12 +5494,12 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value BEGIN ATOMIC END"),
@@ -5574,43 +5574,43 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT FLOAT8 ) AS ' definition ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) AS ' definition ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , IN FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , VARIADIC FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , argname FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 ) LANGUAGE lang_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr ) LANGUAGE lang_name SET configuration_parameter = value BEGIN ATOMIC END"),
@@ -5632,7 +5632,7 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , argname FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
@@ -5640,21 +5640,21 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
@@ -5664,21 +5664,21 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
@@ -5686,19 +5686,19 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , IN argname FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
@@ -5712,7 +5712,7 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC argname FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC argname FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , OUT argname FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
@@ -5726,54 +5726,54 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 ) SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 ) SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , IN argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , argname FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , IN argname FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , IN argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , IN argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , FLOAT8 ) SET configuration_parameter TO value SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SET configuration_parameter TO value SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) SET configuration_parameter TO value SET configuration_parameter = value BEGIN ATOMIC END"),
@@ -5838,29 +5838,29 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , IN argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , FLOAT8 ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , FLOAT8 ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , FLOAT8 ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , FLOAT8 ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN FLOAT8 ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , VARIADIC FLOAT8 ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , OUT FLOAT8 ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , OUT FLOAT8 ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , OUT argname FLOAT8 ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT argname FLOAT8 ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , OUT FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , argname FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 , OUT argname FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value BEGIN ATOMIC END"),
@@ -5873,7 +5873,7 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value BEGIN ATOMIC END"),
@@ -5905,22 +5905,22 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) LANGUAGE lang_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
@@ -5935,62 +5935,62 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , INOUT FLOAT8 ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , INOUT argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , OUT FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , argname FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN argname FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT FLOAT8 ) SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT FLOAT8 ) SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
@@ -5999,11 +5999,11 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , OUT FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , IN FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
@@ -6011,13 +6011,13 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN argname FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , IN argname FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SET configuration_parameter TO value SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SET configuration_parameter TO value SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
@@ -6083,46 +6083,46 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , FLOAT8 ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC FLOAT8 ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , argname FLOAT8 ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , argname FLOAT8 ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , IN argname FLOAT8 ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , INOUT argname FLOAT8 ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , IN FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , IN FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , IN FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , VARIADIC argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr ) LANGUAGE lang_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 ) LANGUAGE lang_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) LANGUAGE lang_name AS ' definition ' BEGIN ATOMIC END"),
@@ -6140,82 +6140,82 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) LANGUAGE lang_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) LANGUAGE lang_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , argname FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , FLOAT8 ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , OUT FLOAT8 ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , OUT FLOAT8 ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , argname FLOAT8 ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , argname FLOAT8 ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , VARIADIC argname FLOAT8 ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , FLOAT8 = default_expr ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 = default_expr ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT argname FLOAT8 = default_expr ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 , IN FLOAT8 ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 , IN FLOAT8 ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT FLOAT8 ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , argname FLOAT8 ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , OUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' BEGIN ATOMIC END"),
@@ -6227,9 +6227,9 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC FLOAT8 ) SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , VARIADIC argname FLOAT8 ) SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
@@ -6238,31 +6238,31 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 = default_expr ) SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , IN FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , IN argname FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN argname FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , OUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' BEGIN ATOMIC END"),
@@ -6280,12 +6280,12 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) SET configuration_parameter TO value AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) SET configuration_parameter TO value AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' definition ' BEGIN ATOMIC END"),
@@ -6296,36 +6296,36 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT FLOAT8 ) SET configuration_parameter = value AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT argname FLOAT8 ) SET configuration_parameter = value AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , INOUT argname FLOAT8 ) SET configuration_parameter = value AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter = value AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter = value AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter = value AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter = value AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter = value AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter = value AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter = value AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 = default_expr ) SET configuration_parameter = value AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 = default_expr ) SET configuration_parameter = value AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter = value AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter = value AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , VARIADIC FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , argname FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , argname FLOAT8 ) AS ' definition ' AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , argname FLOAT8 ) AS ' definition ' AS ' definition ' BEGIN ATOMIC END"),
@@ -6355,26 +6355,26 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC FLOAT8 ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC FLOAT8 ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 ) LANGUAGE lang_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN FLOAT8 ) LANGUAGE lang_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT FLOAT8 ) LANGUAGE lang_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
@@ -6398,60 +6398,60 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , IN FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , VARIADIC FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , argname FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , argname FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
@@ -6471,19 +6471,19 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , OUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , OUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , FLOAT8 ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
@@ -6492,7 +6492,7 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , argname FLOAT8 ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC argname FLOAT8 ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , OUT argname FLOAT8 ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , OUT argname FLOAT8 ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT argname FLOAT8 ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , INOUT argname FLOAT8 ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
@@ -6519,66 +6519,66 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , OUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 , FLOAT8 ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 , FLOAT8 ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , FLOAT8 ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , IN FLOAT8 ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , argname FLOAT8 ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT argname FLOAT8 ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , FLOAT8 ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , OUT FLOAT8 ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , FLOAT8 ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , OUT FLOAT8 ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , IN FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , VARIADIC FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , VARIADIC argname FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
@@ -6593,35 +6593,35 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , IN argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , IN FLOAT8 ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , VARIADIC FLOAT8 ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , OUT FLOAT8 ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , OUT FLOAT8 ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , OUT FLOAT8 ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( OUT argname FLOAT8 , OUT FLOAT8 ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , OUT FLOAT8 ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , OUT FLOAT8 ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , argname FLOAT8 ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC argname FLOAT8 ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT argname FLOAT8 ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' BEGIN ATOMIC END"),
@@ -6675,30 +6675,30 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) LANGUAGE lang_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) LANGUAGE lang_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , FLOAT8 ) TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 ) TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , argname FLOAT8 ) TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
@@ -6706,16 +6706,16 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr ) SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 = default_expr ) SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , FLOAT8 ) SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , IN FLOAT8 ) SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , IN FLOAT8 ) SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , VARIADIC FLOAT8 ) SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 ) SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 ) SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT FLOAT8 ) SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , argname FLOAT8 ) SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , argname FLOAT8 ) SECURITY INVOKER RETURN 1"),
@@ -6723,71 +6723,71 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , FLOAT8 = default_expr ) SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , FLOAT8 = default_expr ) SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC FLOAT8 = default_expr ) SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 ) EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 ) EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 ) EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 ) EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 ) EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 ) EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 ) EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , argname FLOAT8 ) EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN argname FLOAT8 ) EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , IN argname FLOAT8 ) EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT argname FLOAT8 ) EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT argname FLOAT8 ) EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , OUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr ) SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 ) SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 ) SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , FLOAT8 ) SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , VARIADIC FLOAT8 ) SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , OUT FLOAT8 ) SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , OUT FLOAT8 ) SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 ) SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 DEFAULT default_expr ) SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 DEFAULT default_expr ) SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , FLOAT8 = default_expr ) SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , FLOAT8 = default_expr ) SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , IN FLOAT8 = default_expr ) SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , VARIADIC FLOAT8 = default_expr ) SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 = default_expr ) SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 ) EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , VARIADIC FLOAT8 ) EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , IN argname FLOAT8 ) EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , OUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER RETURN 1"),
@@ -6796,28 +6796,28 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , FLOAT8 ) SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 , VARIADIC FLOAT8 ) SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 ) SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 ) SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 ) SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , VARIADIC argname FLOAT8 ) SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , IN argname FLOAT8 = default_expr ) SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter TO value RETURN 1"),
@@ -6825,28 +6825,28 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 ) SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 ) SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr ) SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 ) SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 ) SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 ) SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 ) SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , argname FLOAT8 ) SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , argname FLOAT8 ) SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , OUT argname FLOAT8 ) SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 ) SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , IN argname FLOAT8 = default_expr ) SET configuration_parameter = value RETURN 1"),
@@ -6857,7 +6857,7 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , VARIADIC FLOAT8 ) SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC FLOAT8 ) SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , argname FLOAT8 ) SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) SET configuration_parameter FROM CURRENT RETURN 1"),
@@ -6865,37 +6865,37 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , INOUT argname FLOAT8 ) SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , OUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , IN FLOAT8 ) AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC FLOAT8 ) AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 ) AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 ) AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 ) AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 ) AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT FLOAT8 ) AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN argname FLOAT8 ) AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , OUT argname FLOAT8 ) AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , OUT argname FLOAT8 ) AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 ) AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , argname FLOAT8 = default_expr ) AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , argname FLOAT8 = default_expr ) AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC FLOAT8 ) AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 ) AS ' obj_file ' , ' link_symbol ' RETURN 1"),
@@ -6908,13 +6908,13 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr ) LANGUAGE lang_name LANGUAGE lang_name RETURN 1"),
@@ -7246,23 +7246,23 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 , OUT FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 , OUT FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , OUT argname FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , OUT argname FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , INOUT argname FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , IN argname FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , IN argname FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , INOUT FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
@@ -7273,48 +7273,48 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT argname FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT argname FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , argname FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , IN argname FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , OUT FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , OUT FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , INOUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
@@ -7322,19 +7322,19 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
@@ -7342,39 +7342,39 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , argname FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
@@ -7383,37 +7383,37 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , INOUT argname FLOAT8 ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , INOUT argname FLOAT8 ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , INOUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , INOUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , VARIADIC argname FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , VARIADIC argname FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , IN FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , VARIADIC FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
@@ -7421,18 +7421,18 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , VARIADIC argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name RETURN 1"),
@@ -7506,20 +7506,20 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , IN FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , IN FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , VARIADIC FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , VARIADIC FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
@@ -7527,15 +7527,15 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , OUT FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , OUT FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , argname FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
@@ -7543,7 +7543,7 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
@@ -7558,12 +7558,12 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , VARIADIC FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
@@ -7571,14 +7571,14 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , VARIADIC FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
@@ -7593,38 +7593,38 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , OUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , OUT argname FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , INOUT argname FLOAT8 ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter TO value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , VARIADIC FLOAT8 ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
@@ -7634,33 +7634,33 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , IN argname FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , IN argname FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter = value TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , FLOAT8 ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , FLOAT8 ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC FLOAT8 ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
@@ -7673,27 +7673,27 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , argname FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , argname FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) AS ' definition ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , VARIADIC FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' TRANSFORM FOR TYPE type_name , FOR TYPE type_name RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) LANGUAGE lang_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , IN FLOAT8 ) LANGUAGE lang_name SECURITY INVOKER RETURN 1"),
@@ -7718,23 +7718,23 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) LANGUAGE lang_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) LANGUAGE lang_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) LANGUAGE lang_name SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , VARIADIC FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY INVOKER RETURN 1"),
@@ -7748,14 +7748,14 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY INVOKER RETURN 1"),
@@ -7842,26 +7842,26 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , FLOAT8 ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , FLOAT8 ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , FLOAT8 ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , VARIADIC FLOAT8 ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , IN argname FLOAT8 ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , OUT argname FLOAT8 ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , OUT argname FLOAT8 ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , INOUT argname FLOAT8 ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
@@ -7869,80 +7869,80 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , FLOAT8 ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , VARIADIC FLOAT8 ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , argname FLOAT8 ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN argname FLOAT8 ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , IN argname FLOAT8 ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , argname FLOAT8 ) SET configuration_parameter FROM CURRENT SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) SET configuration_parameter FROM CURRENT SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) SET configuration_parameter FROM CURRENT SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT argname FLOAT8 ) SET configuration_parameter FROM CURRENT SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 ) AS ' definition ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , IN FLOAT8 ) AS ' definition ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , IN argname FLOAT8 ) AS ' definition ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , INOUT argname FLOAT8 ) AS ' definition ' SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , FLOAT8 = default_expr ) AS ' definition ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT FLOAT8 = default_expr ) AS ' definition ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , INOUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY INVOKER RETURN 1"),
@@ -7975,39 +7975,39 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) LANGUAGE lang_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) LANGUAGE lang_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) LANGUAGE lang_name EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , IN FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 , INOUT FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 , INOUT FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , VARIADIC FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
@@ -8019,15 +8019,15 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 ) SECURITY INVOKER EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) SECURITY INVOKER EXTERNAL SECURITY INVOKER RETURN 1"),
@@ -8102,40 +8102,40 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , OUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , argname FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , INOUT argname FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , IN argname FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , IN argname FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , OUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , IN FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , argname FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
@@ -8143,57 +8143,57 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN argname FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , argname FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , IN argname FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN argname FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT argname FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , OUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , INOUT FLOAT8 ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , argname FLOAT8 ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC argname FLOAT8 ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
@@ -8201,20 +8201,20 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , IN FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , IN FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , VARIADIC FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
@@ -8223,16 +8223,16 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY INVOKER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr ) LANGUAGE lang_name SECURITY DEFINER RETURN 1"),
@@ -8256,33 +8256,33 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) LANGUAGE lang_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) LANGUAGE lang_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) LANGUAGE lang_name SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY DEFINER RETURN 1"),
@@ -8291,12 +8291,12 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SECURITY INVOKER SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SECURITY INVOKER SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , argname FLOAT8 ) SECURITY INVOKER SECURITY DEFINER RETURN 1"),
@@ -8377,25 +8377,25 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT FLOAT8 ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT FLOAT8 ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT FLOAT8 ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , argname FLOAT8 ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , argname FLOAT8 ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT argname FLOAT8 ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , argname FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , argname FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , argname FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter TO value SECURITY DEFINER RETURN 1"),
@@ -8407,15 +8407,15 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC FLOAT8 ) SET configuration_parameter = value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , argname FLOAT8 ) SET configuration_parameter = value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) SET configuration_parameter = value SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SET configuration_parameter = value SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SET configuration_parameter = value SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value SECURITY DEFINER RETURN 1"),
@@ -8428,31 +8428,31 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT argname FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter = value SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , argname FLOAT8 ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) AS ' definition ' SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN argname FLOAT8 ) AS ' definition ' SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , OUT argname FLOAT8 ) AS ' definition ' SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SECURITY DEFINER RETURN 1"),
@@ -8464,18 +8464,18 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , VARIADIC FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , OUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SECURITY DEFINER RETURN 1"),
@@ -8495,23 +8495,23 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) LANGUAGE lang_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) LANGUAGE lang_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , argname FLOAT8 = default_expr ) LANGUAGE lang_name EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , IN FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , IN FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , argname FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
@@ -8520,30 +8520,30 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 ) SECURITY INVOKER EXTERNAL SECURITY DEFINER RETURN 1"),
@@ -8651,25 +8651,25 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , OUT FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , argname FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , OUT FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , argname FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , argname FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN argname FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
@@ -8677,55 +8677,55 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter = value EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT argname FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , INOUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , FLOAT8 ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , FLOAT8 ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , VARIADIC FLOAT8 ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , VARIADIC FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) AS ' definition ' EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
@@ -8735,15 +8735,15 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , VARIADIC FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 , OUT argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' EXTERNAL SECURITY DEFINER RETURN 1"),
@@ -8764,24 +8764,24 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) LANGUAGE lang_name SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , OUT argname FLOAT8 = default_expr ) LANGUAGE lang_name SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) LANGUAGE lang_name SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
@@ -8794,25 +8794,25 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , OUT FLOAT8 ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , OUT FLOAT8 ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , VARIADIC argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
@@ -8827,31 +8827,31 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , IN argname FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , VARIADIC argname FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , VARIADIC FLOAT8 ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT FLOAT8 ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT FLOAT8 ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , VARIADIC argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
@@ -8861,27 +8861,27 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 , VARIADIC FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , argname FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , argname FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , OUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter TO value RETURN 1"),
@@ -8945,41 +8945,41 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , IN FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , IN FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , VARIADIC argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , IN argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , IN argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter TO value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr ) LANGUAGE lang_name SET configuration_parameter = value RETURN 1"),
@@ -9003,24 +9003,24 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) LANGUAGE lang_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) LANGUAGE lang_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , OUT argname FLOAT8 = default_expr ) LANGUAGE lang_name SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
@@ -9028,7 +9028,7 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , IN FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , IN FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
@@ -9043,37 +9043,37 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , FLOAT8 ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 , IN FLOAT8 ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , FLOAT8 ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 , IN FLOAT8 ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN FLOAT8 ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , IN argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , INOUT argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
@@ -9081,28 +9081,28 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , IN FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , VARIADIC FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , OUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT FLOAT8 ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
@@ -9110,24 +9110,24 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , IN argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
@@ -9142,14 +9142,14 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 ) SET configuration_parameter TO value SET configuration_parameter = value RETURN 1"),
@@ -9219,53 +9219,53 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , argname FLOAT8 ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , OUT argname FLOAT8 ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , OUT argname FLOAT8 ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , OUT argname FLOAT8 ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , OUT argname FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , IN FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , IN argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , IN argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter = value RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 ) LANGUAGE lang_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) LANGUAGE lang_name SET configuration_parameter FROM CURRENT RETURN 1"),
@@ -9283,21 +9283,21 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
@@ -9305,29 +9305,29 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , FLOAT8 ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
@@ -9335,27 +9335,27 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC FLOAT8 ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC FLOAT8 ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , OUT argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , OUT argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , OUT argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , OUT argname FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 = default_expr ) SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , argname FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT argname FLOAT8 ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
@@ -9363,53 +9363,53 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , FLOAT8 ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT FLOAT8 ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , OUT argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , FLOAT8 ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , OUT FLOAT8 ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT FLOAT8 ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , OUT argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , INOUT argname FLOAT8 ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , IN argname FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 = default_expr ) SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , INOUT FLOAT8 ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER SET configuration_parameter FROM CURRENT RETURN 1"),
@@ -9484,29 +9484,29 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 , IN FLOAT8 ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT FLOAT8 ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 , IN FLOAT8 ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT FLOAT8 ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , VARIADIC argname FLOAT8 ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , INOUT FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , INOUT FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) AS ' definition ' SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
@@ -9516,18 +9516,18 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , VARIADIC FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , OUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' SET configuration_parameter FROM CURRENT RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , FLOAT8 ) LANGUAGE lang_name AS ' definition ' RETURN 1"),
@@ -9548,73 +9548,73 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 = default_expr ) LANGUAGE lang_name AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) LANGUAGE lang_name AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , FLOAT8 ) SECURITY INVOKER AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT FLOAT8 ) SECURITY INVOKER AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SECURITY INVOKER AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT FLOAT8 ) SECURITY INVOKER AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SECURITY INVOKER AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , argname FLOAT8 ) SECURITY INVOKER AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SECURITY INVOKER AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) SECURITY INVOKER AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , OUT argname FLOAT8 = default_expr ) SECURITY INVOKER AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SECURITY INVOKER AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN FLOAT8 ) EXTERNAL SECURITY INVOKER AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT FLOAT8 ) EXTERNAL SECURITY INVOKER AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT FLOAT8 ) EXTERNAL SECURITY INVOKER AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , argname FLOAT8 ) EXTERNAL SECURITY INVOKER AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , VARIADIC argname FLOAT8 ) EXTERNAL SECURITY INVOKER AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 ) EXTERNAL SECURITY INVOKER AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , IN FLOAT8 ) SECURITY DEFINER AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SECURITY DEFINER AS ' definition ' RETURN 1"),
@@ -9623,17 +9623,17 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , VARIADIC argname FLOAT8 ) SECURITY DEFINER AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) SECURITY DEFINER AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) SECURITY DEFINER AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SECURITY DEFINER AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SECURITY DEFINER AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , FLOAT8 = default_expr ) SECURITY DEFINER AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) SECURITY DEFINER AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) SECURITY DEFINER AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) SECURITY DEFINER AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 = default_expr ) SECURITY DEFINER AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , IN argname FLOAT8 = default_expr ) SECURITY DEFINER AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , IN argname FLOAT8 = default_expr ) SECURITY DEFINER AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SECURITY DEFINER AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) SECURITY DEFINER AS ' definition ' RETURN 1"),
@@ -9650,40 +9650,40 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , FLOAT8 ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , IN FLOAT8 ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT FLOAT8 ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT FLOAT8 ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT FLOAT8 ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , IN FLOAT8 ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , VARIADIC FLOAT8 ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , argname FLOAT8 ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
@@ -9692,16 +9692,16 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , OUT argname FLOAT8 ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT argname FLOAT8 ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 , FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 , FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , argname FLOAT8 = default_expr ) SET configuration_parameter = value AS ' definition ' RETURN 1"),
@@ -9716,19 +9716,19 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , INOUT argname FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT argname FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' definition ' RETURN 1"),
@@ -9756,20 +9756,20 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , VARIADIC FLOAT8 = default_expr ) AS ' definition ' AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) AS ' definition ' AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) AS ' definition ' AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , IN argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , VARIADIC argname FLOAT8 DEFAULT default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) AS ' obj_file ' , ' link_symbol ' AS ' definition ' RETURN 1"),
@@ -9795,25 +9795,25 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) LANGUAGE lang_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) LANGUAGE lang_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) LANGUAGE lang_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , OUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
@@ -9823,49 +9823,49 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( FLOAT8 , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT argname FLOAT8 ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) TRANSFORM FOR TYPE type_name , FOR TYPE type_name AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 , IN FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , INOUT FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , argname FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , IN argname FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , OUT argname FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , INOUT argname FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT argname FLOAT8 ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 DEFAULT default_expr , IN FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 = default_expr , INOUT FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , OUT FLOAT8 = default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
@@ -9873,7 +9873,7 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT argname FLOAT8 = default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , INOUT argname FLOAT8 = default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT argname FLOAT8 = default_expr ) SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , INOUT FLOAT8 ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
@@ -9887,18 +9887,18 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , INOUT FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 , INOUT argname FLOAT8 = default_expr ) EXTERNAL SECURITY INVOKER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , FLOAT8 ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , OUT FLOAT8 ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , OUT FLOAT8 ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , INOUT FLOAT8 ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT FLOAT8 ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 DEFAULT default_expr , argname FLOAT8 ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
@@ -9907,29 +9907,29 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , INOUT argname FLOAT8 ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 = default_expr , FLOAT8 = default_expr ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 = default_expr ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 , IN FLOAT8 = default_expr ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 , OUT FLOAT8 = default_expr ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , INOUT FLOAT8 = default_expr ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 , INOUT FLOAT8 = default_expr ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 = default_expr ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , argname FLOAT8 = default_expr ) SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 , FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , IN FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , IN FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 , argname FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 , FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , OUT argname FLOAT8 ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 , FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT FLOAT8 , IN FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , VARIADIC FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 = default_expr ) EXTERNAL SECURITY DEFINER AS ' obj_file ' , ' link_symbol ' RETURN 1"),
@@ -9941,10 +9941,10 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , IN FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 = default_expr , INOUT FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , OUT argname FLOAT8 = default_expr ) SET configuration_parameter TO value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
@@ -9955,51 +9955,51 @@ func TestCreateProcedure(t *testing.T) {
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 = default_expr , INOUT FLOAT8 ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , VARIADIC argname FLOAT8 ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , INOUT argname FLOAT8 ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN argname FLOAT8 = default_expr , IN FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , OUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 = default_expr , INOUT argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 = default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , argname FLOAT8 = default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , argname FLOAT8 = default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 = default_expr , OUT argname FLOAT8 = default_expr ) SET configuration_parameter = value AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , OUT FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , argname FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 , IN argname FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 = default_expr , OUT argname FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 = default_expr , OUT argname FLOAT8 ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT argname FLOAT8 , FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , VARIADIC FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , INOUT FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 = default_expr , IN argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 DEFAULT default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( argname FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( argname FLOAT8 , IN FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( INOUT argname FLOAT8 , IN argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 DEFAULT default_expr , VARIADIC argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( IN FLOAT8 , INOUT argname FLOAT8 = default_expr ) SET configuration_parameter FROM CURRENT AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 DEFAULT default_expr , VARIADIC FLOAT8 ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( OUT argname FLOAT8 , INOUT FLOAT8 ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( FLOAT8 = default_expr , argname FLOAT8 ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 = default_expr , FLOAT8 DEFAULT default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 , VARIADIC FLOAT8 DEFAULT default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC FLOAT8 , argname FLOAT8 DEFAULT default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( FLOAT8 , FLOAT8 = default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( FLOAT8 , FLOAT8 = default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( VARIADIC argname FLOAT8 DEFAULT default_expr , FLOAT8 = default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( OUT FLOAT8 , OUT FLOAT8 = default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT FLOAT8 = default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( INOUT FLOAT8 , INOUT FLOAT8 = default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE OR REPLACE PROCEDURE name ( INOUT FLOAT8 = default_expr , IN argname FLOAT8 = default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE PROCEDURE name ( argname FLOAT8 , VARIADIC argname FLOAT8 = default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT FLOAT8 , OUT argname FLOAT8 = default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC FLOAT8 DEFAULT default_expr , OUT argname FLOAT8 = default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
-		Parses("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
+		Converts("CREATE PROCEDURE name ( IN FLOAT8 DEFAULT default_expr , INOUT argname FLOAT8 = default_expr ) AS ' definition ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( VARIADIC argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( OUT argname FLOAT8 ) AS ' obj_file ' , ' link_symbol ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
 		Parses("CREATE OR REPLACE PROCEDURE name ( IN argname FLOAT8 DEFAULT default_expr , FLOAT8 ) AS ' obj_file ' , ' link_symbol ' AS ' obj_file ' , ' link_symbol ' RETURN 1"),
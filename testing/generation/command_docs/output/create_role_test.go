@@ -18,43 +18,43 @@ import "testing"
 
 func TestCreateRole(t *testing.T) {
 	tests := []QueryParses{
-		Parses("CREATE ROLE name"),
-		Unimplemented("CREATE ROLE name SUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH SUPERUSER"),
-		Unimplemented("CREATE ROLE name NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH NOSUPERUSER"),
-		Parses("CREATE ROLE name CREATEDB"),
-		Parses("CREATE ROLE name WITH CREATEDB"),
-		Parses("CREATE ROLE name NOCREATEDB"),
-		Parses("CREATE ROLE name WITH NOCREATEDB"),
-		Parses("CREATE ROLE name CREATEROLE"),
-		Parses("CREATE ROLE name WITH CREATEROLE"),
-		Parses("CREATE ROLE name NOCREATEROLE"),
-		Parses("CREATE ROLE name WITH NOCREATEROLE"),
-		Unimplemented("CREATE ROLE name INHERIT"),
-		Unimplemented("CREATE ROLE name WITH INHERIT"),
-		Unimplemented("CREATE ROLE name NOINHERIT"),
-		Unimplemented("CREATE ROLE name WITH NOINHERIT"),
-		Parses("CREATE ROLE name LOGIN"),
-		Parses("CREATE ROLE name WITH LOGIN"),
-		Parses("CREATE ROLE name NOLOGIN"),
-		Parses("CREATE ROLE name WITH NOLOGIN"),
-		Unimplemented("CREATE ROLE name REPLICATION"),
-		Unimplemented("CREATE ROLE name WITH REPLICATION"),
-		Unimplemented("CREATE ROLE name NOREPLICATION"),
-		Unimplemented("CREATE ROLE name WITH NOREPLICATION"),
-		Unimplemented("CREATE ROLE name BYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH BYPASSRLS"),
-		Unimplemented("CREATE ROLE name NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name WITH CONNECTION LIMIT -1"),
-		Parses("CREATE ROLE name PASSWORD ' password '"),
-		Parses("CREATE ROLE name WITH PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password '"),
-		Parses("CREATE ROLE name PASSWORD NULL"),
-		Parses("CREATE ROLE name WITH PASSWORD NULL"),
+		Converts("CREATE ROLE name"),
+		Converts("CREATE ROLE name SUPERUSER"),
+		Converts("CREATE ROLE name WITH SUPERUSER"),
+		Converts("CREATE ROLE name NOSUPERUSER"),
+		Converts("CREATE ROLE name WITH NOSUPERUSER"),
+		Converts("CREATE ROLE name CREATEDB"),
+		Converts("CREATE ROLE name WITH CREATEDB"),
+		Converts("CREATE ROLE name NOCREATEDB"),
+		Converts("CREATE ROLE name WITH NOCREATEDB"),
+		Converts("CREATE ROLE name CREATEROLE"),
+		Converts("CREATE ROLE name WITH CREATEROLE"),
+		Converts("CREATE ROLE name NOCREATEROLE"),
+		Converts("CREATE ROLE name WITH NOCREATEROLE"),
+		Converts("CREATE ROLE name INHERIT"),
+		Converts("CREATE ROLE name WITH INHERIT"),
+		Converts("CREATE ROLE name NOINHERIT"),
+		Converts("CREATE ROLE name WITH NOINHERIT"),
+		Converts("CREATE ROLE name LOGIN"),
+		Converts("CREATE ROLE name WITH LOGIN"),
+		Converts("CREATE ROLE name NOLOGIN"),
+		Converts("CREATE ROLE name WITH NOLOGIN"),
+		Converts("CREATE ROLE name REPLICATION"),
+		Converts("CREATE ROLE name WITH REPLICATION"),
+		Converts("CREATE ROLE name NOREPLICATION"),
+		Converts("CREATE ROLE name WITH NOREPLICATION"),
+		Converts("CREATE ROLE name BYPASSRLS"),
+		Converts("CREATE ROLE name WITH BYPASSRLS"),
+		Converts("CREATE ROLE name NOBYPASSRLS"),
+		Converts("CREATE ROLE name WITH NOBYPASSRLS"),
+		Converts("CREATE ROLE name CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name WITH CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH PASSWORD ' password '"),
+		Converts("CREATE ROLE name ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name PASSWORD NULL"),
+		Converts("CREATE ROLE name WITH PASSWORD NULL"),
 		Parses("CREATE ROLE name VALID UNTIL ' timestamp '"),
 		Parses("CREATE ROLE name WITH VALID UNTIL ' timestamp '"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name"),
@@ -79,44 +79,44 @@ func TestCreateRole(t *testing.T) {
 		Unimplemented("CREATE ROLE name WITH USER role_name , role_name"),
 		Unimplemented("CREATE ROLE name SYSID 1"),
 		Unimplemented("CREATE ROLE name WITH SYSID 1"),
-		Unimplemented("CREATE ROLE name SUPERUSER SUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH SUPERUSER SUPERUSER"),
-		Unimplemented("CREATE ROLE name NOSUPERUSER SUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH NOSUPERUSER SUPERUSER"),
-		Unimplemented("CREATE ROLE name CREATEDB SUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH CREATEDB SUPERUSER"),
-		Unimplemented("CREATE ROLE name NOCREATEDB SUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH NOCREATEDB SUPERUSER"),
-		Unimplemented("CREATE ROLE name CREATEROLE SUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH CREATEROLE SUPERUSER"),
-		Unimplemented("CREATE ROLE name NOCREATEROLE SUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH NOCREATEROLE SUPERUSER"),
-		Unimplemented("CREATE ROLE name INHERIT SUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH INHERIT SUPERUSER"),
-		Unimplemented("CREATE ROLE name NOINHERIT SUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH NOINHERIT SUPERUSER"),
-		Unimplemented("CREATE ROLE name LOGIN SUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH LOGIN SUPERUSER"),
-		Unimplemented("CREATE ROLE name NOLOGIN SUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH NOLOGIN SUPERUSER"),
-		Unimplemented("CREATE ROLE name REPLICATION SUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH REPLICATION SUPERUSER"),
-		Unimplemented("CREATE ROLE name NOREPLICATION SUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH NOREPLICATION SUPERUSER"),
-		Unimplemented("CREATE ROLE name BYPASSRLS SUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH BYPASSRLS SUPERUSER"),
-		Unimplemented("CREATE ROLE name NOBYPASSRLS SUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH NOBYPASSRLS SUPERUSER"),
-		Unimplemented("CREATE ROLE name CONNECTION LIMIT -1 SUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH CONNECTION LIMIT -1 SUPERUSER"),
-		Unimplemented("CREATE ROLE name PASSWORD ' password ' SUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH PASSWORD ' password ' SUPERUSER"),
-		Unimplemented("CREATE ROLE name ENCRYPTED PASSWORD ' password ' SUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' SUPERUSER"),
-		Unimplemented("CREATE ROLE name PASSWORD NULL SUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH PASSWORD NULL SUPERUSER"),
-		Unimplemented("CREATE ROLE name VALID UNTIL ' timestamp ' SUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH VALID UNTIL ' timestamp ' SUPERUSER"),
+		Parses("CREATE ROLE name SUPERUSER SUPERUSER"),
+		Parses("CREATE ROLE name WITH SUPERUSER SUPERUSER"),
+		Parses("CREATE ROLE name NOSUPERUSER SUPERUSER"),
+		Parses("CREATE ROLE name WITH NOSUPERUSER SUPERUSER"),
+		Converts("CREATE ROLE name CREATEDB SUPERUSER"),
+		Converts("CREATE ROLE name WITH CREATEDB SUPERUSER"),
+		Converts("CREATE ROLE name NOCREATEDB SUPERUSER"),
+		Converts("CREATE ROLE name WITH NOCREATEDB SUPERUSER"),
+		Converts("CREATE ROLE name CREATEROLE SUPERUSER"),
+		Converts("CREATE ROLE name WITH CREATEROLE SUPERUSER"),
+		Converts("CREATE ROLE name NOCREATEROLE SUPERUSER"),
+		Converts("CREATE ROLE name WITH NOCREATEROLE SUPERUSER"),
+		Converts("CREATE ROLE name INHERIT SUPERUSER"),
+		Converts("CREATE ROLE name WITH INHERIT SUPERUSER"),
+		Converts("CREATE ROLE name NOINHERIT SUPERUSER"),
+		Converts("CREATE ROLE name WITH NOINHERIT SUPERUSER"),
+		Converts("CREATE ROLE name LOGIN SUPERUSER"),
+		Converts("CREATE ROLE name WITH LOGIN SUPERUSER"),
+		Converts("CREATE ROLE name NOLOGIN SUPERUSER"),
+		Converts("CREATE ROLE name WITH NOLOGIN SUPERUSER"),
+		Converts("CREATE ROLE name REPLICATION SUPERUSER"),
+		Converts("CREATE ROLE name WITH REPLICATION SUPERUSER"),
+		Converts("CREATE ROLE name NOREPLICATION SUPERUSER"),
+		Converts("CREATE ROLE name WITH NOREPLICATION SUPERUSER"),
+		Converts("CREATE ROLE name BYPASSRLS SUPERUSER"),
+		Converts("CREATE ROLE name WITH BYPASSRLS SUPERUSER"),
+		Converts("CREATE ROLE name NOBYPASSRLS SUPERUSER"),
+		Converts("CREATE ROLE name WITH NOBYPASSRLS SUPERUSER"),
+		Converts("CREATE ROLE name CONNECTION LIMIT -1 SUPERUSER"),
+		Converts("CREATE ROLE name WITH CONNECTION LIMIT -1 SUPERUSER"),
+		Converts("CREATE ROLE name PASSWORD ' password ' SUPERUSER"),
+		Converts("CREATE ROLE name WITH PASSWORD ' password ' SUPERUSER"),
+		Converts("CREATE ROLE name ENCRYPTED PASSWORD ' password ' SUPERUSER"),
+		Converts("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' SUPERUSER"),
+		Converts("CREATE ROLE name PASSWORD NULL SUPERUSER"),
+		Converts("CREATE ROLE name WITH PASSWORD NULL SUPERUSER"),
+		Parses("CREATE ROLE name VALID UNTIL ' timestamp ' SUPERUSER"),
+		Parses("CREATE ROLE name WITH VALID UNTIL ' timestamp ' SUPERUSER"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name SUPERUSER"),
 		Unimplemented("CREATE ROLE name WITH IN ROLE role_name SUPERUSER"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name , role_name SUPERUSER"),
@@ -139,44 +139,44 @@ func TestCreateRole(t *testing.T) {
 		Unimplemented("CREATE ROLE name WITH USER role_name , role_name SUPERUSER"),
 		Unimplemented("CREATE ROLE name SYSID 1 SUPERUSER"),
 		Unimplemented("CREATE ROLE name WITH SYSID 1 SUPERUSER"),
-		Unimplemented("CREATE ROLE name SUPERUSER NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH SUPERUSER NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name NOSUPERUSER NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH NOSUPERUSER NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name CREATEDB NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH CREATEDB NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name NOCREATEDB NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH NOCREATEDB NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name CREATEROLE NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH CREATEROLE NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name NOCREATEROLE NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH NOCREATEROLE NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name INHERIT NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH INHERIT NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name NOINHERIT NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH NOINHERIT NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name LOGIN NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH LOGIN NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name NOLOGIN NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH NOLOGIN NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name REPLICATION NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH REPLICATION NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name NOREPLICATION NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH NOREPLICATION NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name BYPASSRLS NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH BYPASSRLS NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name NOBYPASSRLS NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH NOBYPASSRLS NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name CONNECTION LIMIT -1 NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH CONNECTION LIMIT -1 NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name PASSWORD ' password ' NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH PASSWORD ' password ' NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name ENCRYPTED PASSWORD ' password ' NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name PASSWORD NULL NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH PASSWORD NULL NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name VALID UNTIL ' timestamp ' NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name WITH VALID UNTIL ' timestamp ' NOSUPERUSER"),
+		Parses("CREATE ROLE name SUPERUSER NOSUPERUSER"),
+		Parses("CREATE ROLE name WITH SUPERUSER NOSUPERUSER"),
+		Parses("CREATE ROLE name NOSUPERUSER NOSUPERUSER"),
+		Parses("CREATE ROLE name WITH NOSUPERUSER NOSUPERUSER"),
+		Converts("CREATE ROLE name CREATEDB NOSUPERUSER"),
+		Converts("CREATE ROLE name WITH CREATEDB NOSUPERUSER"),
+		Converts("CREATE ROLE name NOCREATEDB NOSUPERUSER"),
+		Converts("CREATE ROLE name WITH NOCREATEDB NOSUPERUSER"),
+		Converts("CREATE ROLE name CREATEROLE NOSUPERUSER"),
+		Converts("CREATE ROLE name WITH CREATEROLE NOSUPERUSER"),
+		Converts("CREATE ROLE name NOCREATEROLE NOSUPERUSER"),
+		Converts("CREATE ROLE name WITH NOCREATEROLE NOSUPERUSER"),
+		Converts("CREATE ROLE name INHERIT NOSUPERUSER"),
+		Converts("CREATE ROLE name WITH INHERIT NOSUPERUSER"),
+		Converts("CREATE ROLE name NOINHERIT NOSUPERUSER"),
+		Converts("CREATE ROLE name WITH NOINHERIT NOSUPERUSER"),
+		Converts("CREATE ROLE name LOGIN NOSUPERUSER"),
+		Converts("CREATE ROLE name WITH LOGIN NOSUPERUSER"),
+		Converts("CREATE ROLE name NOLOGIN NOSUPERUSER"),
+		Converts("CREATE ROLE name WITH NOLOGIN NOSUPERUSER"),
+		Converts("CREATE ROLE name REPLICATION NOSUPERUSER"),
+		Converts("CREATE ROLE name WITH REPLICATION NOSUPERUSER"),
+		Converts("CREATE ROLE name NOREPLICATION NOSUPERUSER"),
+		Converts("CREATE ROLE name WITH NOREPLICATION NOSUPERUSER"),
+		Converts("CREATE ROLE name BYPASSRLS NOSUPERUSER"),
+		Converts("CREATE ROLE name WITH BYPASSRLS NOSUPERUSER"),
+		Converts("CREATE ROLE name NOBYPASSRLS NOSUPERUSER"),
+		Converts("CREATE ROLE name WITH NOBYPASSRLS NOSUPERUSER"),
+		Converts("CREATE ROLE name CONNECTION LIMIT -1 NOSUPERUSER"),
+		Converts("CREATE ROLE name WITH CONNECTION LIMIT -1 NOSUPERUSER"),
+		Converts("CREATE ROLE name PASSWORD ' password ' NOSUPERUSER"),
+		Converts("CREATE ROLE name WITH PASSWORD ' password ' NOSUPERUSER"),
+		Converts("CREATE ROLE name ENCRYPTED PASSWORD ' password ' NOSUPERUSER"),
+		Converts("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' NOSUPERUSER"),
+		Converts("CREATE ROLE name PASSWORD NULL NOSUPERUSER"),
+		Converts("CREATE ROLE name WITH PASSWORD NULL NOSUPERUSER"),
+		Parses("CREATE ROLE name VALID UNTIL ' timestamp ' NOSUPERUSER"),
+		Parses("CREATE ROLE name WITH VALID UNTIL ' timestamp ' NOSUPERUSER"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name NOSUPERUSER"),
 		Unimplemented("CREATE ROLE name WITH IN ROLE role_name NOSUPERUSER"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name , role_name NOSUPERUSER"),
@@ -199,42 +199,42 @@ func TestCreateRole(t *testing.T) {
 		Unimplemented("CREATE ROLE name WITH USER role_name , role_name NOSUPERUSER"),
 		Unimplemented("CREATE ROLE name SYSID 1 NOSUPERUSER"),
 		Unimplemented("CREATE ROLE name WITH SYSID 1 NOSUPERUSER"),
-		Unimplemented("CREATE ROLE name SUPERUSER CREATEDB"),
-		Unimplemented("CREATE ROLE name WITH SUPERUSER CREATEDB"),
-		Unimplemented("CREATE ROLE name NOSUPERUSER CREATEDB"),
-		Unimplemented("CREATE ROLE name WITH NOSUPERUSER CREATEDB"),
+		Converts("CREATE ROLE name SUPERUSER CREATEDB"),
+		Converts("CREATE ROLE name WITH SUPERUSER CREATEDB"),
+		Converts("CREATE ROLE name NOSUPERUSER CREATEDB"),
+		Converts("CREATE ROLE name WITH NOSUPERUSER CREATEDB"),
 		Parses("CREATE ROLE name CREATEDB CREATEDB"),
 		Parses("CREATE ROLE name WITH CREATEDB CREATEDB"),
 		Parses("CREATE ROLE name NOCREATEDB CREATEDB"),
 		Parses("CREATE ROLE name WITH NOCREATEDB CREATEDB"),
-		Parses("CREATE ROLE name CREATEROLE CREATEDB"),
-		Parses("CREATE ROLE name WITH CREATEROLE CREATEDB"),
-		Parses("CREATE ROLE name NOCREATEROLE CREATEDB"),
-		Parses("CREATE ROLE name WITH NOCREATEROLE CREATEDB"),
-		Unimplemented("CREATE ROLE name INHERIT CREATEDB"),
-		Unimplemented("CREATE ROLE name WITH INHERIT CREATEDB"),
-		Unimplemented("CREATE ROLE name NOINHERIT CREATEDB"),
-		Unimplemented("CREATE ROLE name WITH NOINHERIT CREATEDB"),
-		Parses("CREATE ROLE name LOGIN CREATEDB"),
-		Parses("CREATE ROLE name WITH LOGIN CREATEDB"),
-		Parses("CREATE ROLE name NOLOGIN CREATEDB"),
-		Parses("CREATE ROLE name WITH NOLOGIN CREATEDB"),
-		Unimplemented("CREATE ROLE name REPLICATION CREATEDB"),
-		Unimplemented("CREATE ROLE name WITH REPLICATION CREATEDB"),
-		Unimplemented("CREATE ROLE name NOREPLICATION CREATEDB"),
-		Unimplemented("CREATE ROLE name WITH NOREPLICATION CREATEDB"),
-		Unimplemented("CREATE ROLE name BYPASSRLS CREATEDB"),
-		Unimplemented("CREATE ROLE name WITH BYPASSRLS CREATEDB"),
-		Unimplemented("CREATE ROLE name NOBYPASSRLS CREATEDB"),
-		Unimplemented("CREATE ROLE name WITH NOBYPASSRLS CREATEDB"),
-		Unimplemented("CREATE ROLE name CONNECTION LIMIT -1 CREATEDB"),
-		Unimplemented("CREATE ROLE name WITH CONNECTION LIMIT -1 CREATEDB"),
-		Parses("CREATE ROLE name PASSWORD ' password ' CREATEDB"),
-		Parses("CREATE ROLE name WITH PASSWORD ' password ' CREATEDB"),
-		Unimplemented("CREATE ROLE name ENCRYPTED PASSWORD ' password ' CREATEDB"),
-		Unimplemented("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' CREATEDB"),
-		Parses("CREATE ROLE name PASSWORD NULL CREATEDB"),
-		Parses("CREATE ROLE name WITH PASSWORD NULL CREATEDB"),
+		Converts("CREATE ROLE name CREATEROLE CREATEDB"),
+		Converts("CREATE ROLE name WITH CREATEROLE CREATEDB"),
+		Converts("CREATE ROLE name NOCREATEROLE CREATEDB"),
+		Converts("CREATE ROLE name WITH NOCREATEROLE CREATEDB"),
+		Converts("CREATE ROLE name INHERIT CREATEDB"),
+		Converts("CREATE ROLE name WITH INHERIT CREATEDB"),
+		Converts("CREATE ROLE name NOINHERIT CREATEDB"),
+		Converts("CREATE ROLE name WITH NOINHERIT CREATEDB"),
+		Converts("CREATE ROLE name LOGIN CREATEDB"),
+		Converts("CREATE ROLE name WITH LOGIN CREATEDB"),
+		Converts("CREATE ROLE name NOLOGIN CREATEDB"),
+		Converts("CREATE ROLE name WITH NOLOGIN CREATEDB"),
+		Converts("CREATE ROLE name REPLICATION CREATEDB"),
+		Converts("CREATE ROLE name WITH REPLICATION CREATEDB"),
+		Converts("CREATE ROLE name NOREPLICATION CREATEDB"),
+		Converts("CREATE ROLE name WITH NOREPLICATION CREATEDB"),
+		Converts("CREATE ROLE name BYPASSRLS CREATEDB"),
+		Converts("CREATE ROLE name WITH BYPASSRLS CREATEDB"),
+		Converts("CREATE ROLE name NOBYPASSRLS CREATEDB"),
+		Converts("CREATE ROLE name WITH NOBYPASSRLS CREATEDB"),
+		Converts("CREATE ROLE name CONNECTION LIMIT -1 CREATEDB"),
+		Converts("CREATE ROLE name WITH CONNECTION LIMIT -1 CREATEDB"),
+		Converts("CREATE ROLE name PASSWORD ' password ' CREATEDB"),
+		Converts("CREATE ROLE name WITH PASSWORD ' password ' CREATEDB"),
+		Converts("CREATE ROLE name ENCRYPTED PASSWORD ' password ' CREATEDB"),
+		Converts("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' CREATEDB"),
+		Converts("CREATE ROLE name PASSWORD NULL CREATEDB"),
+		Converts("CREATE ROLE name WITH PASSWORD NULL CREATEDB"),
 		Parses("CREATE ROLE name VALID UNTIL ' timestamp ' CREATEDB"),
 		Parses("CREATE ROLE name WITH VALID UNTIL ' timestamp ' CREATEDB"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name CREATEDB"),
@@ -259,42 +259,42 @@ func TestCreateRole(t *testing.T) {
 		Unimplemented("CREATE ROLE name WITH USER role_name , role_name CREATEDB"),
 		Unimplemented("CREATE ROLE name SYSID 1 CREATEDB"),
 		Unimplemented("CREATE ROLE name WITH SYSID 1 CREATEDB"),
-		Unimplemented("CREATE ROLE name SUPERUSER NOCREATEDB"),
-		Unimplemented("CREATE ROLE name WITH SUPERUSER NOCREATEDB"),
-		Unimplemented("CREATE ROLE name NOSUPERUSER NOCREATEDB"),
-		Unimplemented("CREATE ROLE name WITH NOSUPERUSER NOCREATEDB"),
+		Converts("CREATE ROLE name SUPERUSER NOCREATEDB"),
+		Converts("CREATE ROLE name WITH SUPERUSER NOCREATEDB"),
+		Converts("CREATE ROLE name NOSUPERUSER NOCREATEDB"),
+		Converts("CREATE ROLE name WITH NOSUPERUSER NOCREATEDB"),
 		Parses("CREATE ROLE name CREATEDB NOCREATEDB"),
 		Parses("CREATE ROLE name WITH CREATEDB NOCREATEDB"),
 		Parses("CREATE ROLE name NOCREATEDB NOCREATEDB"),
 		Parses("CREATE ROLE name WITH NOCREATEDB NOCREATEDB"),
-		Parses("CREATE ROLE name CREATEROLE NOCREATEDB"),
-		Parses("CREATE ROLE name WITH CREATEROLE NOCREATEDB"),
-		Parses("CREATE ROLE name NOCREATEROLE NOCREATEDB"),
-		Parses("CREATE ROLE name WITH NOCREATEROLE NOCREATEDB"),
-		Unimplemented("CREATE ROLE name INHERIT NOCREATEDB"),
-		Unimplemented("CREATE ROLE name WITH INHERIT NOCREATEDB"),
-		Unimplemented("CREATE ROLE name NOINHERIT NOCREATEDB"),
-		Unimplemented("CREATE ROLE name WITH NOINHERIT NOCREATEDB"),
-		Parses("CREATE ROLE name LOGIN NOCREATEDB"),
-		Parses("CREATE ROLE name WITH LOGIN NOCREATEDB"),
-		Parses("CREATE ROLE name NOLOGIN NOCREATEDB"),
-		Parses("CREATE ROLE name WITH NOLOGIN NOCREATEDB"),
-		Unimplemented("CREATE ROLE name REPLICATION NOCREATEDB"),
-		Unimplemented("CREATE ROLE name WITH REPLICATION NOCREATEDB"),
-		Unimplemented("CREATE ROLE name NOREPLICATION NOCREATEDB"),
-		Unimplemented("CREATE ROLE name WITH NOREPLICATION NOCREATEDB"),
-		Unimplemented("CREATE ROLE name BYPASSRLS NOCREATEDB"),
-		Unimplemented("CREATE ROLE name WITH BYPASSRLS NOCREATEDB"),
-		Unimplemented("CREATE ROLE name NOBYPASSRLS NOCREATEDB"),
-		Unimplemented("CREATE ROLE name WITH NOBYPASSRLS NOCREATEDB"),
-		Unimplemented("CREATE ROLE name CONNECTION LIMIT -1 NOCREATEDB"),
-		Unimplemented("CREATE ROLE name WITH CONNECTION LIMIT -1 NOCREATEDB"),
-		Parses("CREATE ROLE name PASSWORD ' password ' NOCREATEDB"),
-		Parses("CREATE ROLE name WITH PASSWORD ' password ' NOCREATEDB"),
-		Unimplemented("CREATE ROLE name ENCRYPTED PASSWORD ' password ' NOCREATEDB"),
-		Unimplemented("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' NOCREATEDB"),
-		Parses("CREATE ROLE name PASSWORD NULL NOCREATEDB"),
-		Parses("CREATE ROLE name WITH PASSWORD NULL NOCREATEDB"),
+		Converts("CREATE ROLE name CREATEROLE NOCREATEDB"),
+		Converts("CREATE ROLE name WITH CREATEROLE NOCREATEDB"),
+		Converts("CREATE ROLE name NOCREATEROLE NOCREATEDB"),
+		Converts("CREATE ROLE name WITH NOCREATEROLE NOCREATEDB"),
+		Converts("CREATE ROLE name INHERIT NOCREATEDB"),
+		Converts("CREATE ROLE name WITH INHERIT NOCREATEDB"),
+		Converts("CREATE ROLE name NOINHERIT NOCREATEDB"),
+		Converts("CREATE ROLE name WITH NOINHERIT NOCREATEDB"),
+		Converts("CREATE ROLE name LOGIN NOCREATEDB"),
+		Converts("CREATE ROLE name WITH LOGIN NOCREATEDB"),
+		Converts("CREATE ROLE name NOLOGIN NOCREATEDB"),
+		Converts("CREATE ROLE name WITH NOLOGIN NOCREATEDB"),
+		Converts("CREATE ROLE name REPLICATION NOCREATEDB"),
+		Converts("CREATE ROLE name WITH REPLICATION NOCREATEDB"),
+		Converts("CREATE ROLE name NOREPLICATION NOCREATEDB"),
+		Converts("CREATE ROLE name WITH NOREPLICATION NOCREATEDB"),
+		Converts("CREATE ROLE name BYPASSRLS NOCREATEDB"),
+		Converts("CREATE ROLE name WITH BYPASSRLS NOCREATEDB"),
+		Converts("CREATE ROLE name NOBYPASSRLS NOCREATEDB"),
+		Converts("CREATE ROLE name WITH NOBYPASSRLS NOCREATEDB"),
+		Converts("CREATE ROLE name CONNECTION LIMIT -1 NOCREATEDB"),
+		Converts("CREATE ROLE name WITH CONNECTION LIMIT -1 NOCREATEDB"),
+		Converts("CREATE ROLE name PASSWORD ' password ' NOCREATEDB"),
+		Converts("CREATE ROLE name WITH PASSWORD ' password ' NOCREATEDB"),
+		Converts("CREATE ROLE name ENCRYPTED PASSWORD ' password ' NOCREATEDB"),
+		Converts("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' NOCREATEDB"),
+		Converts("CREATE ROLE name PASSWORD NULL NOCREATEDB"),
+		Converts("CREATE ROLE name WITH PASSWORD NULL NOCREATEDB"),
 		Parses("CREATE ROLE name VALID UNTIL ' timestamp ' NOCREATEDB"),
 		Parses("CREATE ROLE name WITH VALID UNTIL ' timestamp ' NOCREATEDB"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name NOCREATEDB"),
@@ -319,42 +319,42 @@ func TestCreateRole(t *testing.T) {
 		Unimplemented("CREATE ROLE name WITH USER role_name , role_name NOCREATEDB"),
 		Unimplemented("CREATE ROLE name SYSID 1 NOCREATEDB"),
 		Unimplemented("CREATE ROLE name WITH SYSID 1 NOCREATEDB"),
-		Unimplemented("CREATE ROLE name SUPERUSER CREATEROLE"),
-		Unimplemented("CREATE ROLE name WITH SUPERUSER CREATEROLE"),
-		Unimplemented("CREATE ROLE name NOSUPERUSER CREATEROLE"),
-		Unimplemented("CREATE ROLE name WITH NOSUPERUSER CREATEROLE"),
-		Parses("CREATE ROLE name CREATEDB CREATEROLE"),
-		Parses("CREATE ROLE name WITH CREATEDB CREATEROLE"),
-		Parses("CREATE ROLE name NOCREATEDB CREATEROLE"),
-		Parses("CREATE ROLE name WITH NOCREATEDB CREATEROLE"),
+		Converts("CREATE ROLE name SUPERUSER CREATEROLE"),
+		Converts("CREATE ROLE name WITH SUPERUSER CREATEROLE"),
+		Converts("CREATE ROLE name NOSUPERUSER CREATEROLE"),
+		Converts("CREATE ROLE name WITH NOSUPERUSER CREATEROLE"),
+		Converts("CREATE ROLE name CREATEDB CREATEROLE"),
+		Converts("CREATE ROLE name WITH CREATEDB CREATEROLE"),
+		Converts("CREATE ROLE name NOCREATEDB CREATEROLE"),
+		Converts("CREATE ROLE name WITH NOCREATEDB CREATEROLE"),
 		Parses("CREATE ROLE name CREATEROLE CREATEROLE"),
 		Parses("CREATE ROLE name WITH CREATEROLE CREATEROLE"),
 		Parses("CREATE ROLE name NOCREATEROLE CREATEROLE"),
 		Parses("CREATE ROLE name WITH NOCREATEROLE CREATEROLE"),
-		Unimplemented("CREATE ROLE name INHERIT CREATEROLE"),
-		Unimplemented("CREATE ROLE name WITH INHERIT CREATEROLE"),
-		Unimplemented("CREATE ROLE name NOINHERIT CREATEROLE"),
-		Unimplemented("CREATE ROLE name WITH NOINHERIT CREATEROLE"),
-		Parses("CREATE ROLE name LOGIN CREATEROLE"),
-		Parses("CREATE ROLE name WITH LOGIN CREATEROLE"),
-		Parses("CREATE ROLE name NOLOGIN CREATEROLE"),
-		Parses("CREATE ROLE name WITH NOLOGIN CREATEROLE"),
-		Unimplemented("CREATE ROLE name REPLICATION CREATEROLE"),
-		Unimplemented("CREATE ROLE name WITH REPLICATION CREATEROLE"),
-		Unimplemented("CREATE ROLE name NOREPLICATION CREATEROLE"),
-		Unimplemented("CREATE ROLE name WITH NOREPLICATION CREATEROLE"),
-		Unimplemented("CREATE ROLE name BYPASSRLS CREATEROLE"),
-		Unimplemented("CREATE ROLE name WITH BYPASSRLS CREATEROLE"),
-		Unimplemented("CREATE ROLE name NOBYPASSRLS CREATEROLE"),
-		Unimplemented("CREATE ROLE name WITH NOBYPASSRLS CREATEROLE"),
-		Unimplemented("CREATE ROLE name CONNECTION LIMIT -1 CREATEROLE"),
-		Unimplemented("CREATE ROLE name WITH CONNECTION LIMIT -1 CREATEROLE"),
-		Parses("CREATE ROLE name PASSWORD ' password ' CREATEROLE"),
-		Parses("CREATE ROLE name WITH PASSWORD ' password ' CREATEROLE"),
-		Unimplemented("CREATE ROLE name ENCRYPTED PASSWORD ' password ' CREATEROLE"),
-		Unimplemented("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' CREATEROLE"),
-		Parses("CREATE ROLE name PASSWORD NULL CREATEROLE"),
-		Parses("CREATE ROLE name WITH PASSWORD NULL CREATEROLE"),
+		Converts("CREATE ROLE name INHERIT CREATEROLE"),
+		Converts("CREATE ROLE name WITH INHERIT CREATEROLE"),
+		Converts("CREATE ROLE name NOINHERIT CREATEROLE"),
+		Converts("CREATE ROLE name WITH NOINHERIT CREATEROLE"),
+		Converts("CREATE ROLE name LOGIN CREATEROLE"),
+		Converts("CREATE ROLE name WITH LOGIN CREATEROLE"),
+		Converts("CREATE ROLE name NOLOGIN CREATEROLE"),
+		Converts("CREATE ROLE name WITH NOLOGIN CREATEROLE"),
+		Converts("CREATE ROLE name REPLICATION CREATEROLE"),
+		Converts("CREATE ROLE name WITH REPLICATION CREATEROLE"),
+		Converts("CREATE ROLE name NOREPLICATION CREATEROLE"),
+		Converts("CREATE ROLE name WITH NOREPLICATION CREATEROLE"),
+		Converts("CREATE ROLE name BYPASSRLS CREATEROLE"),
+		Converts("CREATE ROLE name WITH BYPASSRLS CREATEROLE"),
+		Converts("CREATE ROLE name NOBYPASSRLS CREATEROLE"),
+		Converts("CREATE ROLE name WITH NOBYPASSRLS CREATEROLE"),
+		Converts("CREATE ROLE name CONNECTION LIMIT -1 CREATEROLE"),
+		Converts("CREATE ROLE name WITH CONNECTION LIMIT -1 CREATEROLE"),
+		Converts("CREATE ROLE name PASSWORD ' password ' CREATEROLE"),
+		Converts("CREATE ROLE name WITH PASSWORD ' password ' CREATEROLE"),
+		Converts("CREATE ROLE name ENCRYPTED PASSWORD ' password ' CREATEROLE"),
+		Converts("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' CREATEROLE"),
+		Converts("CREATE ROLE name PASSWORD NULL CREATEROLE"),
+		Converts("CREATE ROLE name WITH PASSWORD NULL CREATEROLE"),
 		Parses("CREATE ROLE name VALID UNTIL ' timestamp ' CREATEROLE"),
 		Parses("CREATE ROLE name WITH VALID UNTIL ' timestamp ' CREATEROLE"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name CREATEROLE"),
@@ -379,42 +379,42 @@ func TestCreateRole(t *testing.T) {
 		Unimplemented("CREATE ROLE name WITH USER role_name , role_name CREATEROLE"),
 		Unimplemented("CREATE ROLE name SYSID 1 CREATEROLE"),
 		Unimplemented("CREATE ROLE name WITH SYSID 1 CREATEROLE"),
-		Unimplemented("CREATE ROLE name SUPERUSER NOCREATEROLE"),
-		Unimplemented("CREATE ROLE name WITH SUPERUSER NOCREATEROLE"),
-		Unimplemented("CREATE ROLE name NOSUPERUSER NOCREATEROLE"),
-		Unimplemented("CREATE ROLE name WITH NOSUPERUSER NOCREATEROLE"),
-		Parses("CREATE ROLE name CREATEDB NOCREATEROLE"),
-		Parses("CREATE ROLE name WITH CREATEDB NOCREATEROLE"),
-		Parses("CREATE ROLE name NOCREATEDB NOCREATEROLE"),
-		Parses("CREATE ROLE name WITH NOCREATEDB NOCREATEROLE"),
+		Converts("CREATE ROLE name SUPERUSER NOCREATEROLE"),
+		Converts("CREATE ROLE name WITH SUPERUSER NOCREATEROLE"),
+		Converts("CREATE ROLE name NOSUPERUSER NOCREATEROLE"),
+		Converts("CREATE ROLE name WITH NOSUPERUSER NOCREATEROLE"),
+		Converts("CREATE ROLE name CREATEDB NOCREATEROLE"),
+		Converts("CREATE ROLE name WITH CREATEDB NOCREATEROLE"),
+		Converts("CREATE ROLE name NOCREATEDB NOCREATEROLE"),
+		Converts("CREATE ROLE name WITH NOCREATEDB NOCREATEROLE"),
 		Parses("CREATE ROLE name CREATEROLE NOCREATEROLE"),
 		Parses("CREATE ROLE name WITH CREATEROLE NOCREATEROLE"),
 		Parses("CREATE ROLE name NOCREATEROLE NOCREATEROLE"),
 		Parses("CREATE ROLE name WITH NOCREATEROLE NOCREATEROLE"),
-		Unimplemented("CREATE ROLE name INHERIT NOCREATEROLE"),
-		Unimplemented("CREATE ROLE name WITH INHERIT NOCREATEROLE"),
-		Unimplemented("CREATE ROLE name NOINHERIT NOCREATEROLE"),
-		Unimplemented("CREATE ROLE name WITH NOINHERIT NOCREATEROLE"),
-		Parses("CREATE ROLE name LOGIN NOCREATEROLE"),
-		Parses("CREATE ROLE name WITH LOGIN NOCREATEROLE"),
-		Parses("CREATE ROLE name NOLOGIN NOCREATEROLE"),
-		Parses("CREATE ROLE name WITH NOLOGIN NOCREATEROLE"),
-		Unimplemented("CREATE ROLE name REPLICATION NOCREATEROLE"),
-		Unimplemented("CREATE ROLE name WITH REPLICATION NOCREATEROLE"),
-		Unimplemented("CREATE ROLE name NOREPLICATION NOCREATEROLE"),
-		Unimplemented("CREATE ROLE name WITH NOREPLICATION NOCREATEROLE"),
-		Unimplemented("CREATE ROLE name BYPASSRLS NOCREATEROLE"),
-		Unimplemented("CREATE ROLE name WITH BYPASSRLS NOCREATEROLE"),
-		Unimplemented("CREATE ROLE name NOBYPASSRLS NOCREATEROLE"),
-		Unimplemented("CREATE ROLE name WITH NOBYPASSRLS NOCREATEROLE"),
-		Unimplemented("CREATE ROLE name CONNECTION LIMIT -1 NOCREATEROLE"),
-		Unimplemented("CREATE ROLE name WITH CONNECTION LIMIT -1 NOCREATEROLE"),
-		Parses("CREATE ROLE name PASSWORD ' password ' NOCREATEROLE"),
-		Parses("CREATE ROLE name WITH PASSWORD ' password ' NOCREATEROLE"),
-		Unimplemented("CREATE ROLE name ENCRYPTED PASSWORD ' password ' NOCREATEROLE"),
-		Unimplemented("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' NOCREATEROLE"),
-		Parses("CREATE ROLE name PASSWORD NULL NOCREATEROLE"),
-		Parses("CREATE ROLE name WITH PASSWORD NULL NOCREATEROLE"),
+		Converts("CREATE ROLE name INHERIT NOCREATEROLE"),
+		Converts("CREATE ROLE name WITH INHERIT NOCREATEROLE"),
+		Converts("CREATE ROLE name NOINHERIT NOCREATEROLE"),
+		Converts("CREATE ROLE name WITH NOINHERIT NOCREATEROLE"),
+		Converts("CREATE ROLE name LOGIN NOCREATEROLE"),
+		Converts("CREATE ROLE name WITH LOGIN NOCREATEROLE"),
+		Converts("CREATE ROLE name NOLOGIN NOCREATEROLE"),
+		Converts("CREATE ROLE name WITH NOLOGIN NOCREATEROLE"),
+		Converts("CREATE ROLE name REPLICATION NOCREATEROLE"),
+		Converts("CREATE ROLE name WITH REPLICATION NOCREATEROLE"),
+		Converts("CREATE ROLE name NOREPLICATION NOCREATEROLE"),
+		Converts("CREATE ROLE name WITH NOREPLICATION NOCREATEROLE"),
+		Converts("CREATE ROLE name BYPASSRLS NOCREATEROLE"),
+		Converts("CREATE ROLE name WITH BYPASSRLS NOCREATEROLE"),
+		Converts("CREATE ROLE name NOBYPASSRLS NOCREATEROLE"),
+		Converts("CREATE ROLE name WITH NOBYPASSRLS NOCREATEROLE"),
+		Converts("CREATE ROLE name CONNECTION LIMIT -1 NOCREATEROLE"),
+		Converts("CREATE ROLE name WITH CONNECTION LIMIT -1 NOCREATEROLE"),
+		Converts("CREATE ROLE name PASSWORD ' password ' NOCREATEROLE"),
+		Converts("CREATE ROLE name WITH PASSWORD ' password ' NOCREATEROLE"),
+		Converts("CREATE ROLE name ENCRYPTED PASSWORD ' password ' NOCREATEROLE"),
+		Converts("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' NOCREATEROLE"),
+		Converts("CREATE ROLE name PASSWORD NULL NOCREATEROLE"),
+		Converts("CREATE ROLE name WITH PASSWORD NULL NOCREATEROLE"),
 		Parses("CREATE ROLE name VALID UNTIL ' timestamp ' NOCREATEROLE"),
 		Parses("CREATE ROLE name WITH VALID UNTIL ' timestamp ' NOCREATEROLE"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name NOCREATEROLE"),
@@ -439,44 +439,44 @@ func TestCreateRole(t *testing.T) {
 		Unimplemented("CREATE ROLE name WITH USER role_name , role_name NOCREATEROLE"),
 		Unimplemented("CREATE ROLE name SYSID 1 NOCREATEROLE"),
 		Unimplemented("CREATE ROLE name WITH SYSID 1 NOCREATEROLE"),
-		Unimplemented("CREATE ROLE name SUPERUSER INHERIT"),
-		Unimplemented("CREATE ROLE name WITH SUPERUSER INHERIT"),
-		Unimplemented("CREATE ROLE name NOSUPERUSER INHERIT"),
-		Unimplemented("CREATE ROLE name WITH NOSUPERUSER INHERIT"),
-		Unimplemented("CREATE ROLE name CREATEDB INHERIT"),
-		Unimplemented("CREATE ROLE name WITH CREATEDB INHERIT"),
-		Unimplemented("CREATE ROLE name NOCREATEDB INHERIT"),
-		Unimplemented("CREATE ROLE name WITH NOCREATEDB INHERIT"),
-		Unimplemented("CREATE ROLE name CREATEROLE INHERIT"),
-		Unimplemented("CREATE ROLE name WITH CREATEROLE INHERIT"),
-		Unimplemented("CREATE ROLE name NOCREATEROLE INHERIT"),
-		Unimplemented("CREATE ROLE name WITH NOCREATEROLE INHERIT"),
-		Unimplemented("CREATE ROLE name INHERIT INHERIT"),
-		Unimplemented("CREATE ROLE name WITH INHERIT INHERIT"),
-		Unimplemented("CREATE ROLE name NOINHERIT INHERIT"),
-		Unimplemented("CREATE ROLE name WITH NOINHERIT INHERIT"),
-		Unimplemented("CREATE ROLE name LOGIN INHERIT"),
-		Unimplemented("CREATE ROLE name WITH LOGIN INHERIT"),
-		Unimplemented("CREATE ROLE name NOLOGIN INHERIT"),
-		Unimplemented("CREATE ROLE name WITH NOLOGIN INHERIT"),
-		Unimplemented("CREATE ROLE name REPLICATION INHERIT"),
-		Unimplemented("CREATE ROLE name WITH REPLICATION INHERIT"),
-		Unimplemented("CREATE ROLE name NOREPLICATION INHERIT"),
-		Unimplemented("CREATE ROLE name WITH NOREPLICATION INHERIT"),
-		Unimplemented("CREATE ROLE name BYPASSRLS INHERIT"),
-		Unimplemented("CREATE ROLE name WITH BYPASSRLS INHERIT"),
-		Unimplemented("CREATE ROLE name NOBYPASSRLS INHERIT"),
-		Unimplemented("CREATE ROLE name WITH NOBYPASSRLS INHERIT"),
-		Unimplemented("CREATE ROLE name CONNECTION LIMIT -1 INHERIT"),
-		Unimplemented("CREATE ROLE name WITH CONNECTION LIMIT -1 INHERIT"),
-		Unimplemented("CREATE ROLE name PASSWORD ' password ' INHERIT"),
-		Unimplemented("CREATE ROLE name WITH PASSWORD ' password ' INHERIT"),
-		Unimplemented("CREATE ROLE name ENCRYPTED PASSWORD ' password ' INHERIT"),
-		Unimplemented("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' INHERIT"),
-		Unimplemented("CREATE ROLE name PASSWORD NULL INHERIT"),
-		Unimplemented("CREATE ROLE name WITH PASSWORD NULL INHERIT"),
-		Unimplemented("CREATE ROLE name VALID UNTIL ' timestamp ' INHERIT"),
-		Unimplemented("CREATE ROLE name WITH VALID UNTIL ' timestamp ' INHERIT"),
+		Converts("CREATE ROLE name SUPERUSER INHERIT"),
+		Converts("CREATE ROLE name WITH SUPERUSER INHERIT"),
+		Converts("CREATE ROLE name NOSUPERUSER INHERIT"),
+		Converts("CREATE ROLE name WITH NOSUPERUSER INHERIT"),
+		Converts("CREATE ROLE name CREATEDB INHERIT"),
+		Converts("CREATE ROLE name WITH CREATEDB INHERIT"),
+		Converts("CREATE ROLE name NOCREATEDB INHERIT"),
+		Converts("CREATE ROLE name WITH NOCREATEDB INHERIT"),
+		Converts("CREATE ROLE name CREATEROLE INHERIT"),
+		Converts("CREATE ROLE name WITH CREATEROLE INHERIT"),
+		Converts("CREATE ROLE name NOCREATEROLE INHERIT"),
+		Converts("CREATE ROLE name WITH NOCREATEROLE INHERIT"),
+		Parses("CREATE ROLE name INHERIT INHERIT"),
+		Parses("CREATE ROLE name WITH INHERIT INHERIT"),
+		Parses("CREATE ROLE name NOINHERIT INHERIT"),
+		Parses("CREATE ROLE name WITH NOINHERIT INHERIT"),
+		Converts("CREATE ROLE name LOGIN INHERIT"),
+		Converts("CREATE ROLE name WITH LOGIN INHERIT"),
+		Converts("CREATE ROLE name NOLOGIN INHERIT"),
+		Converts("CREATE ROLE name WITH NOLOGIN INHERIT"),
+		Converts("CREATE ROLE name REPLICATION INHERIT"),
+		Converts("CREATE ROLE name WITH REPLICATION INHERIT"),
+		Converts("CREATE ROLE name NOREPLICATION INHERIT"),
+		Converts("CREATE ROLE name WITH NOREPLICATION INHERIT"),
+		Converts("CREATE ROLE name BYPASSRLS INHERIT"),
+		Converts("CREATE ROLE name WITH BYPASSRLS INHERIT"),
+		Converts("CREATE ROLE name NOBYPASSRLS INHERIT"),
+		Converts("CREATE ROLE name WITH NOBYPASSRLS INHERIT"),
+		Converts("CREATE ROLE name CONNECTION LIMIT -1 INHERIT"),
+		Converts("CREATE ROLE name WITH CONNECTION LIMIT -1 INHERIT"),
+		Converts("CREATE ROLE name PASSWORD ' password ' INHERIT"),
+		Converts("CREATE ROLE name WITH PASSWORD ' password ' INHERIT"),
+		Converts("CREATE ROLE name ENCRYPTED PASSWORD ' password ' INHERIT"),
+		Converts("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' INHERIT"),
+		Converts("CREATE ROLE name PASSWORD NULL INHERIT"),
+		Converts("CREATE ROLE name WITH PASSWORD NULL INHERIT"),
+		Parses("CREATE ROLE name VALID UNTIL ' timestamp ' INHERIT"),
+		Parses("CREATE ROLE name WITH VALID UNTIL ' timestamp ' INHERIT"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name INHERIT"),
 		Unimplemented("CREATE ROLE name WITH IN ROLE role_name INHERIT"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name , role_name INHERIT"),
@@ -499,44 +499,44 @@ func TestCreateRole(t *testing.T) {
 		Unimplemented("CREATE ROLE name WITH USER role_name , role_name INHERIT"),
 		Unimplemented("CREATE ROLE name SYSID 1 INHERIT"),
 		Unimplemented("CREATE ROLE name WITH SYSID 1 INHERIT"),
-		Unimplemented("CREATE ROLE name SUPERUSER NOINHERIT"),
-		Unimplemented("CREATE ROLE name WITH SUPERUSER NOINHERIT"),
-		Unimplemented("CREATE ROLE name NOSUPERUSER NOINHERIT"),
-		Unimplemented("CREATE ROLE name WITH NOSUPERUSER NOINHERIT"),
-		Unimplemented("CREATE ROLE name CREATEDB NOINHERIT"),
-		Unimplemented("CREATE ROLE name WITH CREATEDB NOINHERIT"),
-		Unimplemented("CREATE ROLE name NOCREATEDB NOINHERIT"),
-		Unimplemented("CREATE ROLE name WITH NOCREATEDB NOINHERIT"),
-		Unimplemented("CREATE ROLE name CREATEROLE NOINHERIT"),
-		Unimplemented("CREATE ROLE name WITH CREATEROLE NOINHERIT"),
-		Unimplemented("CREATE ROLE name NOCREATEROLE NOINHERIT"),
-		Unimplemented("CREATE ROLE name WITH NOCREATEROLE NOINHERIT"),
-		Unimplemented("CREATE ROLE name INHERIT NOINHERIT"),
-		Unimplemented("CREATE ROLE name WITH INHERIT NOINHERIT"),
-		Unimplemented("CREATE ROLE name NOINHERIT NOINHERIT"),
-		Unimplemented("CREATE ROLE name WITH NOINHERIT NOINHERIT"),
-		Unimplemented("CREATE ROLE name LOGIN NOINHERIT"),
-		Unimplemented("CREATE ROLE name WITH LOGIN NOINHERIT"),
-		Unimplemented("CREATE ROLE name NOLOGIN NOINHERIT"),
-		Unimplemented("CREATE ROLE name WITH NOLOGIN NOINHERIT"),
-		Unimplemented("CREATE ROLE name REPLICATION NOINHERIT"),
-		Unimplemented("CREATE ROLE name WITH REPLICATION NOINHERIT"),
-		Unimplemented("CREATE ROLE name NOREPLICATION NOINHERIT"),
-		Unimplemented("CREATE ROLE name WITH NOREPLICATION NOINHERIT"),
-		Unimplemented("CREATE ROLE name BYPASSRLS NOINHERIT"),
-		Unimplemented("CREATE ROLE name WITH BYPASSRLS NOINHERIT"),
-		Unimplemented("CREATE ROLE name NOBYPASSRLS NOINHERIT"),
-		Unimplemented("CREATE ROLE name WITH NOBYPASSRLS NOINHERIT"),
-		Unimplemented("CREATE ROLE name CONNECTION LIMIT -1 NOINHERIT"),
-		Unimplemented("CREATE ROLE name WITH CONNECTION LIMIT -1 NOINHERIT"),
-		Unimplemented("CREATE ROLE name PASSWORD ' password ' NOINHERIT"),
-		Unimplemented("CREATE ROLE name WITH PASSWORD ' password ' NOINHERIT"),
-		Unimplemented("CREATE ROLE name ENCRYPTED PASSWORD ' password ' NOINHERIT"),
-		Unimplemented("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' NOINHERIT"),
-		Unimplemented("CREATE ROLE name PASSWORD NULL NOINHERIT"),
-		Unimplemented("CREATE ROLE name WITH PASSWORD NULL NOINHERIT"),
-		Unimplemented("CREATE ROLE name VALID UNTIL ' timestamp ' NOINHERIT"),
-		Unimplemented("CREATE ROLE name WITH VALID UNTIL ' timestamp ' NOINHERIT"),
+		Converts("CREATE ROLE name SUPERUSER NOINHERIT"),
+		Converts("CREATE ROLE name WITH SUPERUSER NOINHERIT"),
+		Converts("CREATE ROLE name NOSUPERUSER NOINHERIT"),
+		Converts("CREATE ROLE name WITH NOSUPERUSER NOINHERIT"),
+		Converts("CREATE ROLE name CREATEDB NOINHERIT"),
+		Converts("CREATE ROLE name WITH CREATEDB NOINHERIT"),
+		Converts("CREATE ROLE name NOCREATEDB NOINHERIT"),
+		Converts("CREATE ROLE name WITH NOCREATEDB NOINHERIT"),
+		Converts("CREATE ROLE name CREATEROLE NOINHERIT"),
+		Converts("CREATE ROLE name WITH CREATEROLE NOINHERIT"),
+		Converts("CREATE ROLE name NOCREATEROLE NOINHERIT"),
+		Converts("CREATE ROLE name WITH NOCREATEROLE NOINHERIT"),
+		Parses("CREATE ROLE name INHERIT NOINHERIT"),
+		Parses("CREATE ROLE name WITH INHERIT NOINHERIT"),
+		Parses("CREATE ROLE name NOINHERIT NOINHERIT"),
+		Parses("CREATE ROLE name WITH NOINHERIT NOINHERIT"),
+		Converts("CREATE ROLE name LOGIN NOINHERIT"),
+		Converts("CREATE ROLE name WITH LOGIN NOINHERIT"),
+		Converts("CREATE ROLE name NOLOGIN NOINHERIT"),
+		Converts("CREATE ROLE name WITH NOLOGIN NOINHERIT"),
+		Converts("CREATE ROLE name REPLICATION NOINHERIT"),
+		Converts("CREATE ROLE name WITH REPLICATION NOINHERIT"),
+		Converts("CREATE ROLE name NOREPLICATION NOINHERIT"),
+		Converts("CREATE ROLE name WITH NOREPLICATION NOINHERIT"),
+		Converts("CREATE ROLE name BYPASSRLS NOINHERIT"),
+		Converts("CREATE ROLE name WITH BYPASSRLS NOINHERIT"),
+		Converts("CREATE ROLE name NOBYPASSRLS NOINHERIT"),
+		Converts("CREATE ROLE name WITH NOBYPASSRLS NOINHERIT"),
+		Converts("CREATE ROLE name CONNECTION LIMIT -1 NOINHERIT"),
+		Converts("CREATE ROLE name WITH CONNECTION LIMIT -1 NOINHERIT"),
+		Converts("CREATE ROLE name PASSWORD ' password ' NOINHERIT"),
+		Converts("CREATE ROLE name WITH PASSWORD ' password ' NOINHERIT"),
+		Converts("CREATE ROLE name ENCRYPTED PASSWORD ' password ' NOINHERIT"),
+		Converts("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' NOINHERIT"),
+		Converts("CREATE ROLE name PASSWORD NULL NOINHERIT"),
+		Converts("CREATE ROLE name WITH PASSWORD NULL NOINHERIT"),
+		Parses("CREATE ROLE name VALID UNTIL ' timestamp ' NOINHERIT"),
+		Parses("CREATE ROLE name WITH VALID UNTIL ' timestamp ' NOINHERIT"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name NOINHERIT"),
 		Unimplemented("CREATE ROLE name WITH IN ROLE role_name NOINHERIT"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name , role_name NOINHERIT"),
@@ -559,42 +559,42 @@ func TestCreateRole(t *testing.T) {
 		Unimplemented("CREATE ROLE name WITH USER role_name , role_name NOINHERIT"),
 		Unimplemented("CREATE ROLE name SYSID 1 NOINHERIT"),
 		Unimplemented("CREATE ROLE name WITH SYSID 1 NOINHERIT"),
-		Unimplemented("CREATE ROLE name SUPERUSER LOGIN"),
-		Unimplemented("CREATE ROLE name WITH SUPERUSER LOGIN"),
-		Unimplemented("CREATE ROLE name NOSUPERUSER LOGIN"),
-		Unimplemented("CREATE ROLE name WITH NOSUPERUSER LOGIN"),
-		Parses("CREATE ROLE name CREATEDB LOGIN"),
-		Parses("CREATE ROLE name WITH CREATEDB LOGIN"),
-		Parses("CREATE ROLE name NOCREATEDB LOGIN"),
-		Parses("CREATE ROLE name WITH NOCREATEDB LOGIN"),
-		Parses("CREATE ROLE name CREATEROLE LOGIN"),
-		Parses("CREATE ROLE name WITH CREATEROLE LOGIN"),
-		Parses("CREATE ROLE name NOCREATEROLE LOGIN"),
-		Parses("CREATE ROLE name WITH NOCREATEROLE LOGIN"),
-		Unimplemented("CREATE ROLE name INHERIT LOGIN"),
-		Unimplemented("CREATE ROLE name WITH INHERIT LOGIN"),
-		Unimplemented("CREATE ROLE name NOINHERIT LOGIN"),
-		Unimplemented("CREATE ROLE name WITH NOINHERIT LOGIN"),
+		Converts("CREATE ROLE name SUPERUSER LOGIN"),
+		Converts("CREATE ROLE name WITH SUPERUSER LOGIN"),
+		Converts("CREATE ROLE name NOSUPERUSER LOGIN"),
+		Converts("CREATE ROLE name WITH NOSUPERUSER LOGIN"),
+		Converts("CREATE ROLE name CREATEDB LOGIN"),
+		Converts("CREATE ROLE name WITH CREATEDB LOGIN"),
+		Converts("CREATE ROLE name NOCREATEDB LOGIN"),
+		Converts("CREATE ROLE name WITH NOCREATEDB LOGIN"),
+		Converts("CREATE ROLE name CREATEROLE LOGIN"),
+		Converts("CREATE ROLE name WITH CREATEROLE LOGIN"),
+		Converts("CREATE ROLE name NOCREATEROLE LOGIN"),
+		Converts("CREATE ROLE name WITH NOCREATEROLE LOGIN"),
+		Converts("CREATE ROLE name INHERIT LOGIN"),
+		Converts("CREATE ROLE name WITH INHERIT LOGIN"),
+		Converts("CREATE ROLE name NOINHERIT LOGIN"),
+		Converts("CREATE ROLE name WITH NOINHERIT LOGIN"),
 		Parses("CREATE ROLE name LOGIN LOGIN"),
 		Parses("CREATE ROLE name WITH LOGIN LOGIN"),
 		Parses("CREATE ROLE name NOLOGIN LOGIN"),
 		Parses("CREATE ROLE name WITH NOLOGIN LOGIN"),
-		Unimplemented("CREATE ROLE name REPLICATION LOGIN"),
-		Unimplemented("CREATE ROLE name WITH REPLICATION LOGIN"),
-		Unimplemented("CREATE ROLE name NOREPLICATION LOGIN"),
-		Unimplemented("CREATE ROLE name WITH NOREPLICATION LOGIN"),
-		Unimplemented("CREATE ROLE name BYPASSRLS LOGIN"),
-		Unimplemented("CREATE ROLE name WITH BYPASSRLS LOGIN"),
-		Unimplemented("CREATE ROLE name NOBYPASSRLS LOGIN"),
-		Unimplemented("CREATE ROLE name WITH NOBYPASSRLS LOGIN"),
-		Unimplemented("CREATE ROLE name CONNECTION LIMIT -1 LOGIN"),
-		Unimplemented("CREATE ROLE name WITH CONNECTION LIMIT -1 LOGIN"),
-		Parses("CREATE ROLE name PASSWORD ' password ' LOGIN"),
-		Parses("CREATE ROLE name WITH PASSWORD ' password ' LOGIN"),
-		Unimplemented("CREATE ROLE name ENCRYPTED PASSWORD ' password ' LOGIN"),
-		Unimplemented("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' LOGIN"),
-		Parses("CREATE ROLE name PASSWORD NULL LOGIN"),
-		Parses("CREATE ROLE name WITH PASSWORD NULL LOGIN"),
+		Converts("CREATE ROLE name REPLICATION LOGIN"),
+		Converts("CREATE ROLE name WITH REPLICATION LOGIN"),
+		Converts("CREATE ROLE name NOREPLICATION LOGIN"),
+		Converts("CREATE ROLE name WITH NOREPLICATION LOGIN"),
+		Converts("CREATE ROLE name BYPASSRLS LOGIN"),
+		Converts("CREATE ROLE name WITH BYPASSRLS LOGIN"),
+		Converts("CREATE ROLE name NOBYPASSRLS LOGIN"),
+		Converts("CREATE ROLE name WITH NOBYPASSRLS LOGIN"),
+		Converts("CREATE ROLE name CONNECTION LIMIT -1 LOGIN"),
+		Converts("CREATE ROLE name WITH CONNECTION LIMIT -1 LOGIN"),
+		Converts("CREATE ROLE name PASSWORD ' password ' LOGIN"),
+		Converts("CREATE ROLE name WITH PASSWORD ' password ' LOGIN"),
+		Converts("CREATE ROLE name ENCRYPTED PASSWORD ' password ' LOGIN"),
+		Converts("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' LOGIN"),
+		Converts("CREATE ROLE name PASSWORD NULL LOGIN"),
+		Converts("CREATE ROLE name WITH PASSWORD NULL LOGIN"),
 		Parses("CREATE ROLE name VALID UNTIL ' timestamp ' LOGIN"),
 		Parses("CREATE ROLE name WITH VALID UNTIL ' timestamp ' LOGIN"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name LOGIN"),
@@ -619,42 +619,42 @@ func TestCreateRole(t *testing.T) {
 		Unimplemented("CREATE ROLE name WITH USER role_name , role_name LOGIN"),
 		Unimplemented("CREATE ROLE name SYSID 1 LOGIN"),
 		Unimplemented("CREATE ROLE name WITH SYSID 1 LOGIN"),
-		Unimplemented("CREATE ROLE name SUPERUSER NOLOGIN"),
-		Unimplemented("CREATE ROLE name WITH SUPERUSER NOLOGIN"),
-		Unimplemented("CREATE ROLE name NOSUPERUSER NOLOGIN"),
-		Unimplemented("CREATE ROLE name WITH NOSUPERUSER NOLOGIN"),
-		Parses("CREATE ROLE name CREATEDB NOLOGIN"),
-		Parses("CREATE ROLE name WITH CREATEDB NOLOGIN"),
-		Parses("CREATE ROLE name NOCREATEDB NOLOGIN"),
-		Parses("CREATE ROLE name WITH NOCREATEDB NOLOGIN"),
-		Parses("CREATE ROLE name CREATEROLE NOLOGIN"),
-		Parses("CREATE ROLE name WITH CREATEROLE NOLOGIN"),
-		Parses("CREATE ROLE name NOCREATEROLE NOLOGIN"),
-		Parses("CREATE ROLE name WITH NOCREATEROLE NOLOGIN"),
-		Unimplemented("CREATE ROLE name INHERIT NOLOGIN"),
-		Unimplemented("CREATE ROLE name WITH INHERIT NOLOGIN"),
-		Unimplemented("CREATE ROLE name NOINHERIT NOLOGIN"),
-		Unimplemented("CREATE ROLE name WITH NOINHERIT NOLOGIN"),
+		Converts("CREATE ROLE name SUPERUSER NOLOGIN"),
+		Converts("CREATE ROLE name WITH SUPERUSER NOLOGIN"),
+		Converts("CREATE ROLE name NOSUPERUSER NOLOGIN"),
+		Converts("CREATE ROLE name WITH NOSUPERUSER NOLOGIN"),
+		Converts("CREATE ROLE name CREATEDB NOLOGIN"),
+		Converts("CREATE ROLE name WITH CREATEDB NOLOGIN"),
+		Converts("CREATE ROLE name NOCREATEDB NOLOGIN"),
+		Converts("CREATE ROLE name WITH NOCREATEDB NOLOGIN"),
+		Converts("CREATE ROLE name CREATEROLE NOLOGIN"),
+		Converts("CREATE ROLE name WITH CREATEROLE NOLOGIN"),
+		Converts("CREATE ROLE name NOCREATEROLE NOLOGIN"),
+		Converts("CREATE ROLE name WITH NOCREATEROLE NOLOGIN"),
+		Converts("CREATE ROLE name INHERIT NOLOGIN"),
+		Converts("CREATE ROLE name WITH INHERIT NOLOGIN"),
+		Converts("CREATE ROLE name NOINHERIT NOLOGIN"),
+		Converts("CREATE ROLE name WITH NOINHERIT NOLOGIN"),
 		Parses("CREATE ROLE name LOGIN NOLOGIN"),
 		Parses("CREATE ROLE name WITH LOGIN NOLOGIN"),
 		Parses("CREATE ROLE name NOLOGIN NOLOGIN"),
 		Parses("CREATE ROLE name WITH NOLOGIN NOLOGIN"),
-		Unimplemented("CREATE ROLE name REPLICATION NOLOGIN"),
-		Unimplemented("CREATE ROLE name WITH REPLICATION NOLOGIN"),
-		Unimplemented("CREATE ROLE name NOREPLICATION NOLOGIN"),
-		Unimplemented("CREATE ROLE name WITH NOREPLICATION NOLOGIN"),
-		Unimplemented("CREATE ROLE name BYPASSRLS NOLOGIN"),
-		Unimplemented("CREATE ROLE name WITH BYPASSRLS NOLOGIN"),
-		Unimplemented("CREATE ROLE name NOBYPASSRLS NOLOGIN"),
-		Unimplemented("CREATE ROLE name WITH NOBYPASSRLS NOLOGIN"),
-		Unimplemented("CREATE ROLE name CONNECTION LIMIT -1 NOLOGIN"),
-		Unimplemented("CREATE ROLE name WITH CONNECTION LIMIT -1 NOLOGIN"),
-		Parses("CREATE ROLE name PASSWORD ' password ' NOLOGIN"),
-		Parses("CREATE ROLE name WITH PASSWORD ' password ' NOLOGIN"),
-		Unimplemented("CREATE ROLE name ENCRYPTED PASSWORD ' password ' NOLOGIN"),
-		Unimplemented("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' NOLOGIN"),
-		Parses("CREATE ROLE name PASSWORD NULL NOLOGIN"),
-		Parses("CREATE ROLE name WITH PASSWORD NULL NOLOGIN"),
+		Converts("CREATE ROLE name REPLICATION NOLOGIN"),
+		Converts("CREATE ROLE name WITH REPLICATION NOLOGIN"),
+		Converts("CREATE ROLE name NOREPLICATION NOLOGIN"),
+		Converts("CREATE ROLE name WITH NOREPLICATION NOLOGIN"),
+		Converts("CREATE ROLE name BYPASSRLS NOLOGIN"),
+		Converts("CREATE ROLE name WITH BYPASSRLS NOLOGIN"),
+		Converts("CREATE ROLE name NOBYPASSRLS NOLOGIN"),
+		Converts("CREATE ROLE name WITH NOBYPASSRLS NOLOGIN"),
+		Converts("CREATE ROLE name CONNECTION LIMIT -1 NOLOGIN"),
+		Converts("CREATE ROLE name WITH CONNECTION LIMIT -1 NOLOGIN"),
+		Converts("CREATE ROLE name PASSWORD ' password ' NOLOGIN"),
+		Converts("CREATE ROLE name WITH PASSWORD ' password ' NOLOGIN"),
+		Converts("CREATE ROLE name ENCRYPTED PASSWORD ' password ' NOLOGIN"),
+		Converts("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' NOLOGIN"),
+		Converts("CREATE ROLE name PASSWORD NULL NOLOGIN"),
+		Converts("CREATE ROLE name WITH PASSWORD NULL NOLOGIN"),
 		Parses("CREATE ROLE name VALID UNTIL ' timestamp ' NOLOGIN"),
 		Parses("CREATE ROLE name WITH VALID UNTIL ' timestamp ' NOLOGIN"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name NOLOGIN"),
@@ -679,44 +679,44 @@ func TestCreateRole(t *testing.T) {
 		Unimplemented("CREATE ROLE name WITH USER role_name , role_name NOLOGIN"),
 		Unimplemented("CREATE ROLE name SYSID 1 NOLOGIN"),
 		Unimplemented("CREATE ROLE name WITH SYSID 1 NOLOGIN"),
-		Unimplemented("CREATE ROLE name SUPERUSER REPLICATION"),
-		Unimplemented("CREATE ROLE name WITH SUPERUSER REPLICATION"),
-		Unimplemented("CREATE ROLE name NOSUPERUSER REPLICATION"),
-		Unimplemented("CREATE ROLE name WITH NOSUPERUSER REPLICATION"),
-		Unimplemented("CREATE ROLE name CREATEDB REPLICATION"),
-		Unimplemented("CREATE ROLE name WITH CREATEDB REPLICATION"),
-		Unimplemented("CREATE ROLE name NOCREATEDB REPLICATION"),
-		Unimplemented("CREATE ROLE name WITH NOCREATEDB REPLICATION"),
-		Unimplemented("CREATE ROLE name CREATEROLE REPLICATION"),
-		Unimplemented("CREATE ROLE name WITH CREATEROLE REPLICATION"),
-		Unimplemented("CREATE ROLE name NOCREATEROLE REPLICATION"),
-		Unimplemented("CREATE ROLE name WITH NOCREATEROLE REPLICATION"),
-		Unimplemented("CREATE ROLE name INHERIT REPLICATION"),
-		Unimplemented("CREATE ROLE name WITH INHERIT REPLICATION"),
-		Unimplemented("CREATE ROLE name NOINHERIT REPLICATION"),
-		Unimplemented("CREATE ROLE name WITH NOINHERIT REPLICATION"),
-		Unimplemented("CREATE ROLE name LOGIN REPLICATION"),
-		Unimplemented("CREATE ROLE name WITH LOGIN REPLICATION"),
-		Unimplemented("CREATE ROLE name NOLOGIN REPLICATION"),
-		Unimplemented("CREATE ROLE name WITH NOLOGIN REPLICATION"),
-		Unimplemented("CREATE ROLE name REPLICATION REPLICATION"),
-		Unimplemented("CREATE ROLE name WITH REPLICATION REPLICATION"),
-		Unimplemented("CREATE ROLE name NOREPLICATION REPLICATION"),
-		Unimplemented("CREATE ROLE name WITH NOREPLICATION REPLICATION"),
-		Unimplemented("CREATE ROLE name BYPASSRLS REPLICATION"),
-		Unimplemented("CREATE ROLE name WITH BYPASSRLS REPLICATION"),
-		Unimplemented("CREATE ROLE name NOBYPASSRLS REPLICATION"),
-		Unimplemented("CREATE ROLE name WITH NOBYPASSRLS REPLICATION"),
-		Unimplemented("CREATE ROLE name CONNECTION LIMIT -1 REPLICATION"),
-		Unimplemented("CREATE ROLE name WITH CONNECTION LIMIT -1 REPLICATION"),
-		Unimplemented("CREATE ROLE name PASSWORD ' password ' REPLICATION"),
-		Unimplemented("CREATE ROLE name WITH PASSWORD ' password ' REPLICATION"),
-		Unimplemented("CREATE ROLE name ENCRYPTED PASSWORD ' password ' REPLICATION"),
-		Unimplemented("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' REPLICATION"),
-		Unimplemented("CREATE ROLE name PASSWORD NULL REPLICATION"),
-		Unimplemented("CREATE ROLE name WITH PASSWORD NULL REPLICATION"),
-		Unimplemented("CREATE ROLE name VALID UNTIL ' timestamp ' REPLICATION"),
-		Unimplemented("CREATE ROLE name WITH VALID UNTIL ' timestamp ' REPLICATION"),
+		Converts("CREATE ROLE name SUPERUSER REPLICATION"),
+		Converts("CREATE ROLE name WITH SUPERUSER REPLICATION"),
+		Converts("CREATE ROLE name NOSUPERUSER REPLICATION"),
+		Converts("CREATE ROLE name WITH NOSUPERUSER REPLICATION"),
+		Converts("CREATE ROLE name CREATEDB REPLICATION"),
+		Converts("CREATE ROLE name WITH CREATEDB REPLICATION"),
+		Converts("CREATE ROLE name NOCREATEDB REPLICATION"),
+		Converts("CREATE ROLE name WITH NOCREATEDB REPLICATION"),
+		Converts("CREATE ROLE name CREATEROLE REPLICATION"),
+		Converts("CREATE ROLE name WITH CREATEROLE REPLICATION"),
+		Converts("CREATE ROLE name NOCREATEROLE REPLICATION"),
+		Converts("CREATE ROLE name WITH NOCREATEROLE REPLICATION"),
+		Converts("CREATE ROLE name INHERIT REPLICATION"),
+		Converts("CREATE ROLE name WITH INHERIT REPLICATION"),
+		Converts("CREATE ROLE name NOINHERIT REPLICATION"),
+		Converts("CREATE ROLE name WITH NOINHERIT REPLICATION"),
+		Converts("CREATE ROLE name LOGIN REPLICATION"),
+		Converts("CREATE ROLE name WITH LOGIN REPLICATION"),
+		Converts("CREATE ROLE name NOLOGIN REPLICATION"),
+		Converts("CREATE ROLE name WITH NOLOGIN REPLICATION"),
+		Parses("CREATE ROLE name REPLICATION REPLICATION"),
+		Parses("CREATE ROLE name WITH REPLICATION REPLICATION"),
+		Parses("CREATE ROLE name NOREPLICATION REPLICATION"),
+		Parses("CREATE ROLE name WITH NOREPLICATION REPLICATION"),
+		Converts("CREATE ROLE name BYPASSRLS REPLICATION"),
+		Converts("CREATE ROLE name WITH BYPASSRLS REPLICATION"),
+		Converts("CREATE ROLE name NOBYPASSRLS REPLICATION"),
+		Converts("CREATE ROLE name WITH NOBYPASSRLS REPLICATION"),
+		Converts("CREATE ROLE name CONNECTION LIMIT -1 REPLICATION"),
+		Converts("CREATE ROLE name WITH CONNECTION LIMIT -1 REPLICATION"),
+		Converts("CREATE ROLE name PASSWORD ' password ' REPLICATION"),
+		Converts("CREATE ROLE name WITH PASSWORD ' password ' REPLICATION"),
+		Converts("CREATE ROLE name ENCRYPTED PASSWORD ' password ' REPLICATION"),
+		Converts("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' REPLICATION"),
+		Converts("CREATE ROLE name PASSWORD NULL REPLICATION"),
+		Converts("CREATE ROLE name WITH PASSWORD NULL REPLICATION"),
+		Parses("CREATE ROLE name VALID UNTIL ' timestamp ' REPLICATION"),
+		Parses("CREATE ROLE name WITH VALID UNTIL ' timestamp ' REPLICATION"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name REPLICATION"),
 		Unimplemented("CREATE ROLE name WITH IN ROLE role_name REPLICATION"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name , role_name REPLICATION"),
@@ -739,44 +739,44 @@ func TestCreateRole(t *testing.T) {
 		Unimplemented("CREATE ROLE name WITH USER role_name , role_name REPLICATION"),
 		Unimplemented("CREATE ROLE name SYSID 1 REPLICATION"),
 		Unimplemented("CREATE ROLE name WITH SYSID 1 REPLICATION"),
-		Unimplemented("CREATE ROLE name SUPERUSER NOREPLICATION"),
-		Unimplemented("CREATE ROLE name WITH SUPERUSER NOREPLICATION"),
-		Unimplemented("CREATE ROLE name NOSUPERUSER NOREPLICATION"),
-		Unimplemented("CREATE ROLE name WITH NOSUPERUSER NOREPLICATION"),
-		Unimplemented("CREATE ROLE name CREATEDB NOREPLICATION"),
-		Unimplemented("CREATE ROLE name WITH CREATEDB NOREPLICATION"),
-		Unimplemented("CREATE ROLE name NOCREATEDB NOREPLICATION"),
-		Unimplemented("CREATE ROLE name WITH NOCREATEDB NOREPLICATION"),
-		Unimplemented("CREATE ROLE name CREATEROLE NOREPLICATION"),
-		Unimplemented("CREATE ROLE name WITH CREATEROLE NOREPLICATION"),
-		Unimplemented("CREATE ROLE name NOCREATEROLE NOREPLICATION"),
-		Unimplemented("CREATE ROLE name WITH NOCREATEROLE NOREPLICATION"),
-		Unimplemented("CREATE ROLE name INHERIT NOREPLICATION"),
-		Unimplemented("CREATE ROLE name WITH INHERIT NOREPLICATION"),
-		Unimplemented("CREATE ROLE name NOINHERIT NOREPLICATION"),
-		Unimplemented("CREATE ROLE name WITH NOINHERIT NOREPLICATION"),
-		Unimplemented("CREATE ROLE name LOGIN NOREPLICATION"),
-		Unimplemented("CREATE ROLE name WITH LOGIN NOREPLICATION"),
-		Unimplemented("CREATE ROLE name NOLOGIN NOREPLICATION"),
-		Unimplemented("CREATE ROLE name WITH NOLOGIN NOREPLICATION"),
-		Unimplemented("CREATE ROLE name REPLICATION NOREPLICATION"),
-		Unimplemented("CREATE ROLE name WITH REPLICATION NOREPLICATION"),
-		Unimplemented("CREATE ROLE name NOREPLICATION NOREPLICATION"),
-		Unimplemented("CREATE ROLE name WITH NOREPLICATION NOREPLICATION"),
-		Unimplemented("CREATE ROLE name BYPASSRLS NOREPLICATION"),
-		Unimplemented("CREATE ROLE name WITH BYPASSRLS NOREPLICATION"),
-		Unimplemented("CREATE ROLE name NOBYPASSRLS NOREPLICATION"),
-		Unimplemented("CREATE ROLE name WITH NOBYPASSRLS NOREPLICATION"),
-		Unimplemented("CREATE ROLE name CONNECTION LIMIT -1 NOREPLICATION"),
-		Unimplemented("CREATE ROLE name WITH CONNECTION LIMIT -1 NOREPLICATION"),
-		Unimplemented("CREATE ROLE name PASSWORD ' password ' NOREPLICATION"),
-		Unimplemented("CREATE ROLE name WITH PASSWORD ' password ' NOREPLICATION"),
-		Unimplemented("CREATE ROLE name ENCRYPTED PASSWORD ' password ' NOREPLICATION"),
-		Unimplemented("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' NOREPLICATION"),
-		Unimplemented("CREATE ROLE name PASSWORD NULL NOREPLICATION"),
-		Unimplemented("CREATE ROLE name WITH PASSWORD NULL NOREPLICATION"),
-		Unimplemented("CREATE ROLE name VALID UNTIL ' timestamp ' NOREPLICATION"),
-		Unimplemented("CREATE ROLE name WITH VALID UNTIL ' timestamp ' NOREPLICATION"),
+		Converts("CREATE ROLE name SUPERUSER NOREPLICATION"),
+		Converts("CREATE ROLE name WITH SUPERUSER NOREPLICATION"),
+		Converts("CREATE ROLE name NOSUPERUSER NOREPLICATION"),
+		Converts("CREATE ROLE name WITH NOSUPERUSER NOREPLICATION"),
+		Converts("CREATE ROLE name CREATEDB NOREPLICATION"),
+		Converts("CREATE ROLE name WITH CREATEDB NOREPLICATION"),
+		Converts("CREATE ROLE name NOCREATEDB NOREPLICATION"),
+		Converts("CREATE ROLE name WITH NOCREATEDB NOREPLICATION"),
+		Converts("CREATE ROLE name CREATEROLE NOREPLICATION"),
+		Converts("CREATE ROLE name WITH CREATEROLE NOREPLICATION"),
+		Converts("CREATE ROLE name NOCREATEROLE NOREPLICATION"),
+		Converts("CREATE ROLE name WITH NOCREATEROLE NOREPLICATION"),
+		Converts("CREATE ROLE name INHERIT NOREPLICATION"),
+		Converts("CREATE ROLE name WITH INHERIT NOREPLICATION"),
+		Converts("CREATE ROLE name NOINHERIT NOREPLICATION"),
+		Converts("CREATE ROLE name WITH NOINHERIT NOREPLICATION"),
+		Converts("CREATE ROLE name LOGIN NOREPLICATION"),
+		Converts("CREATE ROLE name WITH LOGIN NOREPLICATION"),
+		Converts("CREATE ROLE name NOLOGIN NOREPLICATION"),
+		Converts("CREATE ROLE name WITH NOLOGIN NOREPLICATION"),
+		Parses("CREATE ROLE name REPLICATION NOREPLICATION"),
+		Parses("CREATE ROLE name WITH REPLICATION NOREPLICATION"),
+		Parses("CREATE ROLE name NOREPLICATION NOREPLICATION"),
+		Parses("CREATE ROLE name WITH NOREPLICATION NOREPLICATION"),
+		Converts("CREATE ROLE name BYPASSRLS NOREPLICATION"),
+		Converts("CREATE ROLE name WITH BYPASSRLS NOREPLICATION"),
+		Converts("CREATE ROLE name NOBYPASSRLS NOREPLICATION"),
+		Converts("CREATE ROLE name WITH NOBYPASSRLS NOREPLICATION"),
+		Converts("CREATE ROLE name CONNECTION LIMIT -1 NOREPLICATION"),
+		Converts("CREATE ROLE name WITH CONNECTION LIMIT -1 NOREPLICATION"),
+		Converts("CREATE ROLE name PASSWORD ' password ' NOREPLICATION"),
+		Converts("CREATE ROLE name WITH PASSWORD ' password ' NOREPLICATION"),
+		Converts("CREATE ROLE name ENCRYPTED PASSWORD ' password ' NOREPLICATION"),
+		Converts("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' NOREPLICATION"),
+		Converts("CREATE ROLE name PASSWORD NULL NOREPLICATION"),
+		Converts("CREATE ROLE name WITH PASSWORD NULL NOREPLICATION"),
+		Parses("CREATE ROLE name VALID UNTIL ' timestamp ' NOREPLICATION"),
+		Parses("CREATE ROLE name WITH VALID UNTIL ' timestamp ' NOREPLICATION"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name NOREPLICATION"),
 		Unimplemented("CREATE ROLE name WITH IN ROLE role_name NOREPLICATION"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name , role_name NOREPLICATION"),
@@ -799,44 +799,44 @@ func TestCreateRole(t *testing.T) {
 		Unimplemented("CREATE ROLE name WITH USER role_name , role_name NOREPLICATION"),
 		Unimplemented("CREATE ROLE name SYSID 1 NOREPLICATION"),
 		Unimplemented("CREATE ROLE name WITH SYSID 1 NOREPLICATION"),
-		Unimplemented("CREATE ROLE name SUPERUSER BYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH SUPERUSER BYPASSRLS"),
-		Unimplemented("CREATE ROLE name NOSUPERUSER BYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH NOSUPERUSER BYPASSRLS"),
-		Unimplemented("CREATE ROLE name CREATEDB BYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH CREATEDB BYPASSRLS"),
-		Unimplemented("CREATE ROLE name NOCREATEDB BYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH NOCREATEDB BYPASSRLS"),
-		Unimplemented("CREATE ROLE name CREATEROLE BYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH CREATEROLE BYPASSRLS"),
-		Unimplemented("CREATE ROLE name NOCREATEROLE BYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH NOCREATEROLE BYPASSRLS"),
-		Unimplemented("CREATE ROLE name INHERIT BYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH INHERIT BYPASSRLS"),
-		Unimplemented("CREATE ROLE name NOINHERIT BYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH NOINHERIT BYPASSRLS"),
-		Unimplemented("CREATE ROLE name LOGIN BYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH LOGIN BYPASSRLS"),
-		Unimplemented("CREATE ROLE name NOLOGIN BYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH NOLOGIN BYPASSRLS"),
-		Unimplemented("CREATE ROLE name REPLICATION BYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH REPLICATION BYPASSRLS"),
-		Unimplemented("CREATE ROLE name NOREPLICATION BYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH NOREPLICATION BYPASSRLS"),
-		Unimplemented("CREATE ROLE name BYPASSRLS BYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH BYPASSRLS BYPASSRLS"),
-		Unimplemented("CREATE ROLE name NOBYPASSRLS BYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH NOBYPASSRLS BYPASSRLS"),
-		Unimplemented("CREATE ROLE name CONNECTION LIMIT -1 BYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH CONNECTION LIMIT -1 BYPASSRLS"),
-		Unimplemented("CREATE ROLE name PASSWORD ' password ' BYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH PASSWORD ' password ' BYPASSRLS"),
-		Unimplemented("CREATE ROLE name ENCRYPTED PASSWORD ' password ' BYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' BYPASSRLS"),
-		Unimplemented("CREATE ROLE name PASSWORD NULL BYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH PASSWORD NULL BYPASSRLS"),
-		Unimplemented("CREATE ROLE name VALID UNTIL ' timestamp ' BYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH VALID UNTIL ' timestamp ' BYPASSRLS"),
+		Converts("CREATE ROLE name SUPERUSER BYPASSRLS"),
+		Converts("CREATE ROLE name WITH SUPERUSER BYPASSRLS"),
+		Converts("CREATE ROLE name NOSUPERUSER BYPASSRLS"),
+		Converts("CREATE ROLE name WITH NOSUPERUSER BYPASSRLS"),
+		Converts("CREATE ROLE name CREATEDB BYPASSRLS"),
+		Converts("CREATE ROLE name WITH CREATEDB BYPASSRLS"),
+		Converts("CREATE ROLE name NOCREATEDB BYPASSRLS"),
+		Converts("CREATE ROLE name WITH NOCREATEDB BYPASSRLS"),
+		Converts("CREATE ROLE name CREATEROLE BYPASSRLS"),
+		Converts("CREATE ROLE name WITH CREATEROLE BYPASSRLS"),
+		Converts("CREATE ROLE name NOCREATEROLE BYPASSRLS"),
+		Converts("CREATE ROLE name WITH NOCREATEROLE BYPASSRLS"),
+		Converts("CREATE ROLE name INHERIT BYPASSRLS"),
+		Converts("CREATE ROLE name WITH INHERIT BYPASSRLS"),
+		Converts("CREATE ROLE name NOINHERIT BYPASSRLS"),
+		Converts("CREATE ROLE name WITH NOINHERIT BYPASSRLS"),
+		Converts("CREATE ROLE name LOGIN BYPASSRLS"),
+		Converts("CREATE ROLE name WITH LOGIN BYPASSRLS"),
+		Converts("CREATE ROLE name NOLOGIN BYPASSRLS"),
+		Converts("CREATE ROLE name WITH NOLOGIN BYPASSRLS"),
+		Converts("CREATE ROLE name REPLICATION BYPASSRLS"),
+		Converts("CREATE ROLE name WITH REPLICATION BYPASSRLS"),
+		Converts("CREATE ROLE name NOREPLICATION BYPASSRLS"),
+		Converts("CREATE ROLE name WITH NOREPLICATION BYPASSRLS"),
+		Parses("CREATE ROLE name BYPASSRLS BYPASSRLS"),
+		Parses("CREATE ROLE name WITH BYPASSRLS BYPASSRLS"),
+		Parses("CREATE ROLE name NOBYPASSRLS BYPASSRLS"),
+		Parses("CREATE ROLE name WITH NOBYPASSRLS BYPASSRLS"),
+		Converts("CREATE ROLE name CONNECTION LIMIT -1 BYPASSRLS"),
+		Converts("CREATE ROLE name WITH CONNECTION LIMIT -1 BYPASSRLS"),
+		Converts("CREATE ROLE name PASSWORD ' password ' BYPASSRLS"),
+		Converts("CREATE ROLE name WITH PASSWORD ' password ' BYPASSRLS"),
+		Converts("CREATE ROLE name ENCRYPTED PASSWORD ' password ' BYPASSRLS"),
+		Converts("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' BYPASSRLS"),
+		Converts("CREATE ROLE name PASSWORD NULL BYPASSRLS"),
+		Converts("CREATE ROLE name WITH PASSWORD NULL BYPASSRLS"),
+		Parses("CREATE ROLE name VALID UNTIL ' timestamp ' BYPASSRLS"),
+		Parses("CREATE ROLE name WITH VALID UNTIL ' timestamp ' BYPASSRLS"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name BYPASSRLS"),
 		Unimplemented("CREATE ROLE name WITH IN ROLE role_name BYPASSRLS"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name , role_name BYPASSRLS"),
@@ -859,44 +859,44 @@ func TestCreateRole(t *testing.T) {
 		Unimplemented("CREATE ROLE name WITH USER role_name , role_name BYPASSRLS"),
 		Unimplemented("CREATE ROLE name SYSID 1 BYPASSRLS"),
 		Unimplemented("CREATE ROLE name WITH SYSID 1 BYPASSRLS"),
-		Unimplemented("CREATE ROLE name SUPERUSER NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH SUPERUSER NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name NOSUPERUSER NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH NOSUPERUSER NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name CREATEDB NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH CREATEDB NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name NOCREATEDB NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH NOCREATEDB NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name CREATEROLE NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH CREATEROLE NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name NOCREATEROLE NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH NOCREATEROLE NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name INHERIT NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH INHERIT NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name NOINHERIT NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH NOINHERIT NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name LOGIN NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH LOGIN NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name NOLOGIN NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH NOLOGIN NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name REPLICATION NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH REPLICATION NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name NOREPLICATION NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH NOREPLICATION NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name BYPASSRLS NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH BYPASSRLS NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name NOBYPASSRLS NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH NOBYPASSRLS NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name CONNECTION LIMIT -1 NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH CONNECTION LIMIT -1 NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name PASSWORD ' password ' NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH PASSWORD ' password ' NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name ENCRYPTED PASSWORD ' password ' NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name PASSWORD NULL NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH PASSWORD NULL NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name VALID UNTIL ' timestamp ' NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name WITH VALID UNTIL ' timestamp ' NOBYPASSRLS"),
+		Converts("CREATE ROLE name SUPERUSER NOBYPASSRLS"),
+		Converts("CREATE ROLE name WITH SUPERUSER NOBYPASSRLS"),
+		Converts("CREATE ROLE name NOSUPERUSER NOBYPASSRLS"),
+		Converts("CREATE ROLE name WITH NOSUPERUSER NOBYPASSRLS"),
+		Converts("CREATE ROLE name CREATEDB NOBYPASSRLS"),
+		Converts("CREATE ROLE name WITH CREATEDB NOBYPASSRLS"),
+		Converts("CREATE ROLE name NOCREATEDB NOBYPASSRLS"),
+		Converts("CREATE ROLE name WITH NOCREATEDB NOBYPASSRLS"),
+		Converts("CREATE ROLE name CREATEROLE NOBYPASSRLS"),
+		Converts("CREATE ROLE name WITH CREATEROLE NOBYPASSRLS"),
+		Converts("CREATE ROLE name NOCREATEROLE NOBYPASSRLS"),
+		Converts("CREATE ROLE name WITH NOCREATEROLE NOBYPASSRLS"),
+		Converts("CREATE ROLE name INHERIT NOBYPASSRLS"),
+		Converts("CREATE ROLE name WITH INHERIT NOBYPASSRLS"),
+		Converts("CREATE ROLE name NOINHERIT NOBYPASSRLS"),
+		Converts("CREATE ROLE name WITH NOINHERIT NOBYPASSRLS"),
+		Converts("CREATE ROLE name LOGIN NOBYPASSRLS"),
+		Converts("CREATE ROLE name WITH LOGIN NOBYPASSRLS"),
+		Converts("CREATE ROLE name NOLOGIN NOBYPASSRLS"),
+		Converts("CREATE ROLE name WITH NOLOGIN NOBYPASSRLS"),
+		Converts("CREATE ROLE name REPLICATION NOBYPASSRLS"),
+		Converts("CREATE ROLE name WITH REPLICATION NOBYPASSRLS"),
+		Converts("CREATE ROLE name NOREPLICATION NOBYPASSRLS"),
+		Converts("CREATE ROLE name WITH NOREPLICATION NOBYPASSRLS"),
+		Parses("CREATE ROLE name BYPASSRLS NOBYPASSRLS"),
+		Parses("CREATE ROLE name WITH BYPASSRLS NOBYPASSRLS"),
+		Parses("CREATE ROLE name NOBYPASSRLS NOBYPASSRLS"),
+		Parses("CREATE ROLE name WITH NOBYPASSRLS NOBYPASSRLS"),
+		Converts("CREATE ROLE name CONNECTION LIMIT -1 NOBYPASSRLS"),
+		Converts("CREATE ROLE name WITH CONNECTION LIMIT -1 NOBYPASSRLS"),
+		Converts("CREATE ROLE name PASSWORD ' password ' NOBYPASSRLS"),
+		Converts("CREATE ROLE name WITH PASSWORD ' password ' NOBYPASSRLS"),
+		Converts("CREATE ROLE name ENCRYPTED PASSWORD ' password ' NOBYPASSRLS"),
+		Converts("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' NOBYPASSRLS"),
+		Converts("CREATE ROLE name PASSWORD NULL NOBYPASSRLS"),
+		Converts("CREATE ROLE name WITH PASSWORD NULL NOBYPASSRLS"),
+		Parses("CREATE ROLE name VALID UNTIL ' timestamp ' NOBYPASSRLS"),
+		Parses("CREATE ROLE name WITH VALID UNTIL ' timestamp ' NOBYPASSRLS"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name NOBYPASSRLS"),
 		Unimplemented("CREATE ROLE name WITH IN ROLE role_name NOBYPASSRLS"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name , role_name NOBYPASSRLS"),
@@ -919,44 +919,44 @@ func TestCreateRole(t *testing.T) {
 		Unimplemented("CREATE ROLE name WITH USER role_name , role_name NOBYPASSRLS"),
 		Unimplemented("CREATE ROLE name SYSID 1 NOBYPASSRLS"),
 		Unimplemented("CREATE ROLE name WITH SYSID 1 NOBYPASSRLS"),
-		Unimplemented("CREATE ROLE name SUPERUSER CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name WITH SUPERUSER CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name NOSUPERUSER CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name WITH NOSUPERUSER CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name CREATEDB CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name WITH CREATEDB CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name NOCREATEDB CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name WITH NOCREATEDB CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name CREATEROLE CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name WITH CREATEROLE CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name NOCREATEROLE CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name WITH NOCREATEROLE CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name INHERIT CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name WITH INHERIT CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name NOINHERIT CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name WITH NOINHERIT CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name LOGIN CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name WITH LOGIN CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name NOLOGIN CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name WITH NOLOGIN CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name REPLICATION CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name WITH REPLICATION CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name NOREPLICATION CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name WITH NOREPLICATION CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name BYPASSRLS CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name WITH BYPASSRLS CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name NOBYPASSRLS CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name WITH NOBYPASSRLS CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name WITH CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name PASSWORD ' password ' CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name WITH PASSWORD ' password ' CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name ENCRYPTED PASSWORD ' password ' CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name PASSWORD NULL CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name WITH PASSWORD NULL CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name VALID UNTIL ' timestamp ' CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name WITH VALID UNTIL ' timestamp ' CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name SUPERUSER CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name WITH SUPERUSER CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name NOSUPERUSER CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name WITH NOSUPERUSER CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name CREATEDB CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name WITH CREATEDB CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name NOCREATEDB CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name WITH NOCREATEDB CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name CREATEROLE CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name WITH CREATEROLE CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name NOCREATEROLE CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name WITH NOCREATEROLE CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name INHERIT CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name WITH INHERIT CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name NOINHERIT CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name WITH NOINHERIT CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name LOGIN CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name WITH LOGIN CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name NOLOGIN CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name WITH NOLOGIN CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name REPLICATION CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name WITH REPLICATION CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name NOREPLICATION CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name WITH NOREPLICATION CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name BYPASSRLS CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name WITH BYPASSRLS CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name NOBYPASSRLS CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name WITH NOBYPASSRLS CONNECTION LIMIT -1"),
+		Parses("CREATE ROLE name CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
+		Parses("CREATE ROLE name WITH CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name PASSWORD ' password ' CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name WITH PASSWORD ' password ' CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name ENCRYPTED PASSWORD ' password ' CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name PASSWORD NULL CONNECTION LIMIT -1"),
+		Converts("CREATE ROLE name WITH PASSWORD NULL CONNECTION LIMIT -1"),
+		Parses("CREATE ROLE name VALID UNTIL ' timestamp ' CONNECTION LIMIT -1"),
+		Parses("CREATE ROLE name WITH VALID UNTIL ' timestamp ' CONNECTION LIMIT -1"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name CONNECTION LIMIT -1"),
 		Unimplemented("CREATE ROLE name WITH IN ROLE role_name CONNECTION LIMIT -1"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name , role_name CONNECTION LIMIT -1"),
@@ -979,40 +979,40 @@ func TestCreateRole(t *testing.T) {
 		Unimplemented("CREATE ROLE name WITH USER role_name , role_name CONNECTION LIMIT -1"),
 		Unimplemented("CREATE ROLE name SYSID 1 CONNECTION LIMIT -1"),
 		Unimplemented("CREATE ROLE name WITH SYSID 1 CONNECTION LIMIT -1"),
-		Unimplemented("CREATE ROLE name SUPERUSER PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH SUPERUSER PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name NOSUPERUSER PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH NOSUPERUSER PASSWORD ' password '"),
-		Parses("CREATE ROLE name CREATEDB PASSWORD ' password '"),
-		Parses("CREATE ROLE name WITH CREATEDB PASSWORD ' password '"),
-		Parses("CREATE ROLE name NOCREATEDB PASSWORD ' password '"),
-		Parses("CREATE ROLE name WITH NOCREATEDB PASSWORD ' password '"),
-		Parses("CREATE ROLE name CREATEROLE PASSWORD ' password '"),
-		Parses("CREATE ROLE name WITH CREATEROLE PASSWORD ' password '"),
-		Parses("CREATE ROLE name NOCREATEROLE PASSWORD ' password '"),
-		Parses("CREATE ROLE name WITH NOCREATEROLE PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name INHERIT PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH INHERIT PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name NOINHERIT PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH NOINHERIT PASSWORD ' password '"),
-		Parses("CREATE ROLE name LOGIN PASSWORD ' password '"),
-		Parses("CREATE ROLE name WITH LOGIN PASSWORD ' password '"),
-		Parses("CREATE ROLE name NOLOGIN PASSWORD ' password '"),
-		Parses("CREATE ROLE name WITH NOLOGIN PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name REPLICATION PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH REPLICATION PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name NOREPLICATION PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH NOREPLICATION PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name BYPASSRLS PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH BYPASSRLS PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name NOBYPASSRLS PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH NOBYPASSRLS PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name CONNECTION LIMIT -1 PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH CONNECTION LIMIT -1 PASSWORD ' password '"),
+		Converts("CREATE ROLE name SUPERUSER PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH SUPERUSER PASSWORD ' password '"),
+		Converts("CREATE ROLE name NOSUPERUSER PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH NOSUPERUSER PASSWORD ' password '"),
+		Converts("CREATE ROLE name CREATEDB PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH CREATEDB PASSWORD ' password '"),
+		Converts("CREATE ROLE name NOCREATEDB PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH NOCREATEDB PASSWORD ' password '"),
+		Converts("CREATE ROLE name CREATEROLE PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH CREATEROLE PASSWORD ' password '"),
+		Converts("CREATE ROLE name NOCREATEROLE PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH NOCREATEROLE PASSWORD ' password '"),
+		Converts("CREATE ROLE name INHERIT PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH INHERIT PASSWORD ' password '"),
+		Converts("CREATE ROLE name NOINHERIT PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH NOINHERIT PASSWORD ' password '"),
+		Converts("CREATE ROLE name LOGIN PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH LOGIN PASSWORD ' password '"),
+		Converts("CREATE ROLE name NOLOGIN PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH NOLOGIN PASSWORD ' password '"),
+		Converts("CREATE ROLE name REPLICATION PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH REPLICATION PASSWORD ' password '"),
+		Converts("CREATE ROLE name NOREPLICATION PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH NOREPLICATION PASSWORD ' password '"),
+		Converts("CREATE ROLE name BYPASSRLS PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH BYPASSRLS PASSWORD ' password '"),
+		Converts("CREATE ROLE name NOBYPASSRLS PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH NOBYPASSRLS PASSWORD ' password '"),
+		Converts("CREATE ROLE name CONNECTION LIMIT -1 PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH CONNECTION LIMIT -1 PASSWORD ' password '"),
 		Parses("CREATE ROLE name PASSWORD ' password ' PASSWORD ' password '"),
 		Parses("CREATE ROLE name WITH PASSWORD ' password ' PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name ENCRYPTED PASSWORD ' password ' PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' PASSWORD ' password '"),
+		Parses("CREATE ROLE name ENCRYPTED PASSWORD ' password ' PASSWORD ' password '"),
+		Parses("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' PASSWORD ' password '"),
 		Parses("CREATE ROLE name PASSWORD NULL PASSWORD ' password '"),
 		Parses("CREATE ROLE name WITH PASSWORD NULL PASSWORD ' password '"),
 		Parses("CREATE ROLE name VALID UNTIL ' timestamp ' PASSWORD ' password '"),
@@ -1039,44 +1039,44 @@ func TestCreateRole(t *testing.T) {
 		Unimplemented("CREATE ROLE name WITH USER role_name , role_name PASSWORD ' password '"),
 		Unimplemented("CREATE ROLE name SYSID 1 PASSWORD ' password '"),
 		Unimplemented("CREATE ROLE name WITH SYSID 1 PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name SUPERUSER ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH SUPERUSER ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name NOSUPERUSER ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH NOSUPERUSER ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name CREATEDB ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH CREATEDB ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name NOCREATEDB ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH NOCREATEDB ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name CREATEROLE ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH CREATEROLE ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name NOCREATEROLE ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH NOCREATEROLE ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name INHERIT ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH INHERIT ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name NOINHERIT ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH NOINHERIT ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name LOGIN ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH LOGIN ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name NOLOGIN ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH NOLOGIN ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name REPLICATION ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH REPLICATION ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name NOREPLICATION ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH NOREPLICATION ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name BYPASSRLS ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH BYPASSRLS ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name NOBYPASSRLS ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH NOBYPASSRLS ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name CONNECTION LIMIT -1 ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH CONNECTION LIMIT -1 ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name ENCRYPTED PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name PASSWORD NULL ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH PASSWORD NULL ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name VALID UNTIL ' timestamp ' ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name WITH VALID UNTIL ' timestamp ' ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name SUPERUSER ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH SUPERUSER ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name NOSUPERUSER ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH NOSUPERUSER ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name CREATEDB ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH CREATEDB ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name NOCREATEDB ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH NOCREATEDB ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name CREATEROLE ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH CREATEROLE ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name NOCREATEROLE ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH NOCREATEROLE ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name INHERIT ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH INHERIT ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name NOINHERIT ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH NOINHERIT ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name LOGIN ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH LOGIN ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name NOLOGIN ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH NOLOGIN ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name REPLICATION ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH REPLICATION ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name NOREPLICATION ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH NOREPLICATION ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name BYPASSRLS ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH BYPASSRLS ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name NOBYPASSRLS ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH NOBYPASSRLS ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name CONNECTION LIMIT -1 ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE ROLE name WITH CONNECTION LIMIT -1 ENCRYPTED PASSWORD ' password '"),
+		Parses("CREATE ROLE name PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
+		Parses("CREATE ROLE name WITH PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
+		Parses("CREATE ROLE name ENCRYPTED PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
+		Parses("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
+		Parses("CREATE ROLE name PASSWORD NULL ENCRYPTED PASSWORD ' password '"),
+		Parses("CREATE ROLE name WITH PASSWORD NULL ENCRYPTED PASSWORD ' password '"),
+		Parses("CREATE ROLE name VALID UNTIL ' timestamp ' ENCRYPTED PASSWORD ' password '"),
+		Parses("CREATE ROLE name WITH VALID UNTIL ' timestamp ' ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("CREATE ROLE name WITH IN ROLE role_name ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("CREATE ROLE name IN ROLE role_name , role_name ENCRYPTED PASSWORD ' password '"),
@@ -1099,40 +1099,40 @@ func TestCreateRole(t *testing.T) {
 		Unimplemented("CREATE ROLE name WITH USER role_name , role_name ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("CREATE ROLE name SYSID 1 ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("CREATE ROLE name WITH SYSID 1 ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE ROLE name SUPERUSER PASSWORD NULL"),
-		Unimplemented("CREATE ROLE name WITH SUPERUSER PASSWORD NULL"),
-		Unimplemented("CREATE ROLE name NOSUPERUSER PASSWORD NULL"),
-		Unimplemented("CREATE ROLE name WITH NOSUPERUSER PASSWORD NULL"),
-		Parses("CREATE ROLE name CREATEDB PASSWORD NULL"),
-		Parses("CREATE ROLE name WITH CREATEDB PASSWORD NULL"),
-		Parses("CREATE ROLE name NOCREATEDB PASSWORD NULL"),
-		Parses("CREATE ROLE name WITH NOCREATEDB PASSWORD NULL"),
-		Parses("CREATE ROLE name CREATEROLE PASSWORD NULL"),
-		Parses("CREATE ROLE name WITH CREATEROLE PASSWORD NULL"),
-		Parses("CREATE ROLE name NOCREATEROLE PASSWORD NULL"),
-		Parses("CREATE ROLE name WITH NOCREATEROLE PASSWORD NULL"),
-		Unimplemented("CREATE ROLE name INHERIT PASSWORD NULL"),
-		Unimplemented("CREATE ROLE name WITH INHERIT PASSWORD NULL"),
-		Unimplemented("CREATE ROLE name NOINHERIT PASSWORD NULL"),
-		Unimplemented("CREATE ROLE name WITH NOINHERIT PASSWORD NULL"),
-		Parses("CREATE ROLE name LOGIN PASSWORD NULL"),
-		Parses("CREATE ROLE name WITH LOGIN PASSWORD NULL"),
-		Parses("CREATE ROLE name NOLOGIN PASSWORD NULL"),
-		Parses("CREATE ROLE name WITH NOLOGIN PASSWORD NULL"),
-		Unimplemented("CREATE ROLE name REPLICATION PASSWORD NULL"),
-		Unimplemented("CREATE ROLE name WITH REPLICATION PASSWORD NULL"),
-		Unimplemented("CREATE ROLE name NOREPLICATION PASSWORD NULL"),
-		Unimplemented("CREATE ROLE name WITH NOREPLICATION PASSWORD NULL"),
-		Unimplemented("CREATE ROLE name BYPASSRLS PASSWORD NULL"),
-		Unimplemented("CREATE ROLE name WITH BYPASSRLS PASSWORD NULL"),
-		Unimplemented("CREATE ROLE name NOBYPASSRLS PASSWORD NULL"),
-		Unimplemented("CREATE ROLE name WITH NOBYPASSRLS PASSWORD NULL"),
-		Unimplemented("CREATE ROLE name CONNECTION LIMIT -1 PASSWORD NULL"),
-		Unimplemented("CREATE ROLE name WITH CONNECTION LIMIT -1 PASSWORD NULL"),
+		Converts("CREATE ROLE name SUPERUSER PASSWORD NULL"),
+		Converts("CREATE ROLE name WITH SUPERUSER PASSWORD NULL"),
+		Converts("CREATE ROLE name NOSUPERUSER PASSWORD NULL"),
+		Converts("CREATE ROLE name WITH NOSUPERUSER PASSWORD NULL"),
+		Converts("CREATE ROLE name CREATEDB PASSWORD NULL"),
+		Converts("CREATE ROLE name WITH CREATEDB PASSWORD NULL"),
+		Converts("CREATE ROLE name NOCREATEDB PASSWORD NULL"),
+		Converts("CREATE ROLE name WITH NOCREATEDB PASSWORD NULL"),
+		Converts("CREATE ROLE name CREATEROLE PASSWORD NULL"),
+		Converts("CREATE ROLE name WITH CREATEROLE PASSWORD NULL"),
+		Converts("CREATE ROLE name NOCREATEROLE PASSWORD NULL"),
+		Converts("CREATE ROLE name WITH NOCREATEROLE PASSWORD NULL"),
+		Converts("CREATE ROLE name INHERIT PASSWORD NULL"),
+		Converts("CREATE ROLE name WITH INHERIT PASSWORD NULL"),
+		Converts("CREATE ROLE name NOINHERIT PASSWORD NULL"),
+		Converts("CREATE ROLE name WITH NOINHERIT PASSWORD NULL"),
+		Converts("CREATE ROLE name LOGIN PASSWORD NULL"),
+		Converts("CREATE ROLE name WITH LOGIN PASSWORD NULL"),
+		Converts("CREATE ROLE name NOLOGIN PASSWORD NULL"),
+		Converts("CREATE ROLE name WITH NOLOGIN PASSWORD NULL"),
+		Converts("CREATE ROLE name REPLICATION PASSWORD NULL"),
+		Converts("CREATE ROLE name WITH REPLICATION PASSWORD NULL"),
+		Converts("CREATE ROLE name NOREPLICATION PASSWORD NULL"),
+		Converts("CREATE ROLE name WITH NOREPLICATION PASSWORD NULL"),
+		Converts("CREATE ROLE name BYPASSRLS PASSWORD NULL"),
+		Converts("CREATE ROLE name WITH BYPASSRLS PASSWORD NULL"),
+		Converts("CREATE ROLE name NOBYPASSRLS PASSWORD NULL"),
+		Converts("CREATE ROLE name WITH NOBYPASSRLS PASSWORD NULL"),
+		Converts("CREATE ROLE name CONNECTION LIMIT -1 PASSWORD NULL"),
+		Converts("CREATE ROLE name WITH CONNECTION LIMIT -1 PASSWORD NULL"),
 		Parses("CREATE ROLE name PASSWORD ' password ' PASSWORD NULL"),
 		Parses("CREATE ROLE name WITH PASSWORD ' password ' PASSWORD NULL"),
-		Unimplemented("CREATE ROLE name ENCRYPTED PASSWORD ' password ' PASSWORD NULL"),
-		Unimplemented("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' PASSWORD NULL"),
+		Parses("CREATE ROLE name ENCRYPTED PASSWORD ' password ' PASSWORD NULL"),
+		Parses("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' PASSWORD NULL"),
 		Parses("CREATE ROLE name PASSWORD NULL PASSWORD NULL"),
 		Parses("CREATE ROLE name WITH PASSWORD NULL PASSWORD NULL"),
 		Parses("CREATE ROLE name VALID UNTIL ' timestamp ' PASSWORD NULL"),
@@ -1159,10 +1159,10 @@ func TestCreateRole(t *testing.T) {
 		Unimplemented("CREATE ROLE name WITH USER role_name , role_name PASSWORD NULL"),
 		Unimplemented("CREATE ROLE name SYSID 1 PASSWORD NULL"),
 		Unimplemented("CREATE ROLE name WITH SYSID 1 PASSWORD NULL"),
-		Unimplemented("CREATE ROLE name SUPERUSER VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE ROLE name WITH SUPERUSER VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE ROLE name NOSUPERUSER VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE ROLE name WITH NOSUPERUSER VALID UNTIL ' timestamp '"),
+		Parses("CREATE ROLE name SUPERUSER VALID UNTIL ' timestamp '"),
+		Parses("CREATE ROLE name WITH SUPERUSER VALID UNTIL ' timestamp '"),
+		Parses("CREATE ROLE name NOSUPERUSER VALID UNTIL ' timestamp '"),
+		Parses("CREATE ROLE name WITH NOSUPERUSER VALID UNTIL ' timestamp '"),
 		Parses("CREATE ROLE name CREATEDB VALID UNTIL ' timestamp '"),
 		Parses("CREATE ROLE name WITH CREATEDB VALID UNTIL ' timestamp '"),
 		Parses("CREATE ROLE name NOCREATEDB VALID UNTIL ' timestamp '"),
@@ -1171,28 +1171,28 @@ func TestCreateRole(t *testing.T) {
 		Parses("CREATE ROLE name WITH CREATEROLE VALID UNTIL ' timestamp '"),
 		Parses("CREATE ROLE name NOCREATEROLE VALID UNTIL ' timestamp '"),
 		Parses("CREATE ROLE name WITH NOCREATEROLE VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE ROLE name INHERIT VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE ROLE name WITH INHERIT VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE ROLE name NOINHERIT VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE ROLE name WITH NOINHERIT VALID UNTIL ' timestamp '"),
+		Parses("CREATE ROLE name INHERIT VALID UNTIL ' timestamp '"),
+		Parses("CREATE ROLE name WITH INHERIT VALID UNTIL ' timestamp '"),
+		Parses("CREATE ROLE name NOINHERIT VALID UNTIL ' timestamp '"),
+		Parses("CREATE ROLE name WITH NOINHERIT VALID UNTIL ' timestamp '"),
 		Parses("CREATE ROLE name LOGIN VALID UNTIL ' timestamp '"),
 		Parses("CREATE ROLE name WITH LOGIN VALID UNTIL ' timestamp '"),
 		Parses("CREATE ROLE name NOLOGIN VALID UNTIL ' timestamp '"),
 		Parses("CREATE ROLE name WITH NOLOGIN VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE ROLE name REPLICATION VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE ROLE name WITH REPLICATION VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE ROLE name NOREPLICATION VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE ROLE name WITH NOREPLICATION VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE ROLE name BYPASSRLS VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE ROLE name WITH BYPASSRLS VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE ROLE name NOBYPASSRLS VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE ROLE name WITH NOBYPASSRLS VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE ROLE name CONNECTION LIMIT -1 VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE ROLE name WITH CONNECTION LIMIT -1 VALID UNTIL ' timestamp '"),
+		Parses("CREATE ROLE name REPLICATION VALID UNTIL ' timestamp '"),
+		Parses("CREATE ROLE name WITH REPLICATION VALID UNTIL ' timestamp '"),
+		Parses("CREATE ROLE name NOREPLICATION VALID UNTIL ' timestamp '"),
+		Parses("CREATE ROLE name WITH NOREPLICATION VALID UNTIL ' timestamp '"),
+		Parses("CREATE ROLE name BYPASSRLS VALID UNTIL ' timestamp '"),
+		Parses("CREATE ROLE name WITH BYPASSRLS VALID UNTIL ' timestamp '"),
+		Parses("CREATE ROLE name NOBYPASSRLS VALID UNTIL ' timestamp '"),
+		Parses("CREATE ROLE name WITH NOBYPASSRLS VALID UNTIL ' timestamp '"),
+		Parses("CREATE ROLE name CONNECTION LIMIT -1 VALID UNTIL ' timestamp '"),
+		Parses("CREATE ROLE name WITH CONNECTION LIMIT -1 VALID UNTIL ' timestamp '"),
 		Parses("CREATE ROLE name PASSWORD ' password ' VALID UNTIL ' timestamp '"),
 		Parses("CREATE ROLE name WITH PASSWORD ' password ' VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE ROLE name ENCRYPTED PASSWORD ' password ' VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' VALID UNTIL ' timestamp '"),
+		Parses("CREATE ROLE name ENCRYPTED PASSWORD ' password ' VALID UNTIL ' timestamp '"),
+		Parses("CREATE ROLE name WITH ENCRYPTED PASSWORD ' password ' VALID UNTIL ' timestamp '"),
 		Parses("CREATE ROLE name PASSWORD NULL VALID UNTIL ' timestamp '"),
 		Parses("CREATE ROLE name WITH PASSWORD NULL VALID UNTIL ' timestamp '"),
 		Parses("CREATE ROLE name VALID UNTIL ' timestamp ' VALID UNTIL ' timestamp '"),
@@ -6698,7 +6698,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MINVALUE NO MAXVALUE START WITH 0 CACHE 1 NO CYCLE OWNED BY table_name . column_name"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MINVALUE NO MAXVALUE START WITH 0 CACHE 1 NO CYCLE OWNED BY table_name . column_name"),
 		Parses("CREATE TEMP SEQUENCE name OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type OWNED BY NONE"),
@@ -6734,13 +6734,13 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MINVALUE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MINVALUE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name NO MINVALUE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name NO MINVALUE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name NO MINVALUE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name NO MINVALUE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type NO MINVALUE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type NO MINVALUE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type NO MINVALUE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type NO MINVALUE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT 1 NO MINVALUE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT 1 NO MINVALUE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT 1 NO MINVALUE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT 1 NO MINVALUE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT 1 NO MINVALUE OWNED BY NONE"),
@@ -6750,7 +6750,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE OWNED BY NONE"),
@@ -6779,7 +6779,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name MINVALUE 1 MAXVALUE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type MINVALUE 1 MAXVALUE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type MINVALUE 1 MAXVALUE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT 1 MINVALUE 1 MAXVALUE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT 1 MINVALUE 1 MAXVALUE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT 1 MINVALUE 1 MAXVALUE 1 OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type INCREMENT 1 MINVALUE 1 MAXVALUE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT 1 MINVALUE 1 MAXVALUE 1 OWNED BY NONE"),
@@ -6791,9 +6791,9 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name NO MINVALUE MAXVALUE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name NO MINVALUE MAXVALUE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name NO MINVALUE MAXVALUE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name NO MINVALUE MAXVALUE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name NO MINVALUE MAXVALUE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name NO MINVALUE MAXVALUE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name NO MINVALUE MAXVALUE 1 OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type NO MINVALUE MAXVALUE 1 OWNED BY NONE"),
@@ -6813,7 +6813,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMP SEQUENCE name AS data_type INCREMENT BY 1 NO MINVALUE MAXVALUE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MINVALUE MAXVALUE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MINVALUE MAXVALUE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name NO MAXVALUE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name NO MAXVALUE OWNED BY NONE"),
@@ -6826,17 +6826,17 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT BY 1 NO MAXVALUE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MAXVALUE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type INCREMENT BY 1 NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT BY 1 NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MAXVALUE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name MINVALUE 1 NO MAXVALUE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name MINVALUE 1 NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name MINVALUE 1 NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name MINVALUE 1 NO MAXVALUE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name MINVALUE 1 NO MAXVALUE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name MINVALUE 1 NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name MINVALUE 1 NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type MINVALUE 1 NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT 1 MINVALUE 1 NO MAXVALUE OWNED BY NONE"),
@@ -6845,7 +6845,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT 1 MINVALUE 1 NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT BY 1 MINVALUE 1 NO MAXVALUE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MINVALUE 1 NO MAXVALUE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MINVALUE 1 NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MINVALUE 1 NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type INCREMENT BY 1 MINVALUE 1 NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MINVALUE 1 NO MAXVALUE OWNED BY NONE"),
@@ -6855,8 +6855,8 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type NO MINVALUE NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type NO MINVALUE NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type NO MINVALUE NO MAXVALUE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT 1 NO MINVALUE NO MAXVALUE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MINVALUE NO MAXVALUE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT 1 NO MINVALUE NO MAXVALUE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MINVALUE NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MINVALUE NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type INCREMENT 1 NO MINVALUE NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT 1 NO MINVALUE NO MAXVALUE OWNED BY NONE"),
@@ -6867,7 +6867,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT BY 1 NO MINVALUE NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT BY 1 NO MINVALUE NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT BY 1 NO MINVALUE NO MAXVALUE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE NO MAXVALUE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT BY 1 NO MINVALUE NO MAXVALUE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MINVALUE NO MAXVALUE OWNED BY NONE"),
@@ -7268,29 +7268,29 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT 1 CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type INCREMENT 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT BY 1 CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT BY 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT BY 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT BY 1 CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name INCREMENT BY 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT BY 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type INCREMENT BY 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name MINVALUE 1 CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name MINVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name MINVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name MINVALUE 1 CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name MINVALUE 1 CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name MINVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name MINVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name MINVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type MINVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type MINVALUE 1 CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT 1 MINVALUE 1 CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT 1 MINVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT 1 MINVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 CACHE 1 OWNED BY NONE"),
@@ -7310,8 +7310,8 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type NO MINVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type NO MINVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type NO MINVALUE CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT 1 NO MINVALUE CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MINVALUE CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT 1 NO MINVALUE CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MINVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MINVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MINVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT 1 NO MINVALUE CACHE 1 OWNED BY NONE"),
@@ -7321,23 +7321,23 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT BY 1 NO MINVALUE CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT BY 1 NO MINVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MINVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MINVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name MAXVALUE 1 CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name MAXVALUE 1 CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type MAXVALUE 1 CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name INCREMENT 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
@@ -7354,20 +7354,20 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT BY 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name MINVALUE 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name MINVALUE 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name MINVALUE 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name MINVALUE 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name MINVALUE 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name MINVALUE 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name MINVALUE 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type MINVALUE 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type MINVALUE 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type MINVALUE 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type MINVALUE 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type MINVALUE 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT 1 MINVALUE 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT 1 MINVALUE 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT 1 MINVALUE 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT 1 MINVALUE 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
@@ -7381,7 +7381,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name NO MINVALUE MAXVALUE 1 CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name NO MINVALUE MAXVALUE 1 CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name NO MINVALUE MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name NO MINVALUE MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name NO MINVALUE MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name NO MINVALUE MAXVALUE 1 CACHE 1 OWNED BY NONE"),
@@ -7397,11 +7397,11 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT BY 1 NO MINVALUE MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT BY 1 NO MINVALUE MAXVALUE 1 CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE MAXVALUE 1 CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type INCREMENT BY 1 NO MINVALUE MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MINVALUE MAXVALUE 1 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name NO MAXVALUE CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name NO MAXVALUE CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type NO MAXVALUE CACHE 1 OWNED BY NONE"),
@@ -7412,7 +7412,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMP SEQUENCE name AS data_type INCREMENT 1 NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT 1 NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MAXVALUE CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT BY 1 NO MAXVALUE CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT BY 1 NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT BY 1 NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT BY 1 NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT BY 1 NO MAXVALUE CACHE 1 OWNED BY NONE"),
@@ -7424,7 +7424,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MAXVALUE CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name MINVALUE 1 NO MAXVALUE CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name MINVALUE 1 NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name MINVALUE 1 NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type MINVALUE 1 NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type MINVALUE 1 NO MAXVALUE CACHE 1 OWNED BY NONE"),
@@ -7446,17 +7446,17 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MINVALUE 1 NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MINVALUE 1 NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name NO MINVALUE NO MAXVALUE CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name NO MINVALUE NO MAXVALUE CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name NO MINVALUE NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name NO MINVALUE NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type NO MINVALUE NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type NO MINVALUE NO MAXVALUE CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT 1 NO MINVALUE NO MAXVALUE CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT 1 NO MINVALUE NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT 1 NO MINVALUE NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT 1 NO MINVALUE NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE NO MAXVALUE CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT BY 1 NO MINVALUE NO MAXVALUE CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT BY 1 NO MINVALUE NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT BY 1 NO MINVALUE NO MAXVALUE CACHE 1 OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE NO MAXVALUE CACHE 1 OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type INCREMENT BY 1 NO MINVALUE NO MAXVALUE CACHE 1 OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MINVALUE NO MAXVALUE CACHE 1 OWNED BY NONE"),
@@ -7830,7 +7830,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MINVALUE NO MAXVALUE START WITH 0 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MINVALUE NO MAXVALUE START WITH 0 CACHE 1 OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type CYCLE OWNED BY NONE"),
@@ -7842,7 +7842,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT BY 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT BY 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type INCREMENT BY 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name MINVALUE 1 CYCLE OWNED BY NONE"),
@@ -7854,9 +7854,9 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type MINVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type MINVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type MINVALUE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT 1 MINVALUE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT 1 MINVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT 1 MINVALUE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT 1 MINVALUE 1 CYCLE OWNED BY NONE"),
@@ -7868,19 +7868,19 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT BY 1 MINVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MINVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name NO MINVALUE CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name NO MINVALUE CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name NO MINVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name NO MINVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type NO MINVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type NO MINVALUE CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MINVALUE CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MINVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type INCREMENT 1 NO MINVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type INCREMENT 1 NO MINVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT 1 NO MINVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT BY 1 NO MINVALUE CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT BY 1 NO MINVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT BY 1 NO MINVALUE CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT BY 1 NO MINVALUE CYCLE OWNED BY NONE"),
@@ -7888,7 +7888,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MINVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name MAXVALUE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name MAXVALUE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type MAXVALUE 1 CYCLE OWNED BY NONE"),
@@ -7896,23 +7896,23 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT BY 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT BY 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT BY 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name MINVALUE 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name MINVALUE 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name MINVALUE 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name MINVALUE 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name MINVALUE 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name MINVALUE 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type MINVALUE 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type MINVALUE 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type MINVALUE 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type MINVALUE 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT 1 MINVALUE 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT 1 MINVALUE 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type INCREMENT 1 MINVALUE 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT 1 MINVALUE 1 MAXVALUE 1 CYCLE OWNED BY NONE"),
@@ -7926,7 +7926,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name NO MINVALUE MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type NO MINVALUE MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type NO MINVALUE MAXVALUE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT 1 NO MINVALUE MAXVALUE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT 1 NO MINVALUE MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT 1 NO MINVALUE MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type INCREMENT 1 NO MINVALUE MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type INCREMENT 1 NO MINVALUE MAXVALUE 1 CYCLE OWNED BY NONE"),
@@ -7938,7 +7938,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMP SEQUENCE name AS data_type INCREMENT BY 1 NO MINVALUE MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MINVALUE MAXVALUE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MINVALUE MAXVALUE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name NO MAXVALUE CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type NO MAXVALUE CYCLE OWNED BY NONE"),
@@ -7951,7 +7951,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT 1 NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT 1 NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MAXVALUE CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT BY 1 NO MAXVALUE CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT BY 1 NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT BY 1 NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT BY 1 NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MAXVALUE CYCLE OWNED BY NONE"),
@@ -7965,23 +7965,23 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type MINVALUE 1 NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type MINVALUE 1 NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type MINVALUE 1 NO MAXVALUE CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 NO MAXVALUE CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 MINVALUE 1 NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 MINVALUE 1 NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 MINVALUE 1 NO MAXVALUE CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT BY 1 MINVALUE 1 NO MAXVALUE CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT BY 1 MINVALUE 1 NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT BY 1 MINVALUE 1 NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MINVALUE 1 NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type INCREMENT BY 1 MINVALUE 1 NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT BY 1 MINVALUE 1 NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MINVALUE 1 NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MINVALUE 1 NO MAXVALUE CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
@@ -7992,15 +7992,15 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT 1 NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT 1 NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT 1 NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT BY 1 NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT BY 1 NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT BY 1 NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT BY 1 NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT BY 1 NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type INCREMENT BY 1 NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT BY 1 NO MINVALUE NO MAXVALUE CYCLE OWNED BY NONE"),
@@ -8381,7 +8381,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type INCREMENT 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT 1 CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT BY 1 CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT BY 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT BY 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type INCREMENT BY 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT BY 1 CACHE 1 CYCLE OWNED BY NONE"),
@@ -8397,7 +8397,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type MINVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type MINVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type MINVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT 1 MINVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT 1 MINVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT 1 MINVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT 1 MINVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
@@ -8408,7 +8408,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 MINVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 MINVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT BY 1 MINVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MINVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MINVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MINVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type INCREMENT BY 1 MINVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MINVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
@@ -8419,14 +8419,14 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type NO MINVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type NO MINVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type NO MINVALUE CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT 1 NO MINVALUE CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT 1 NO MINVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT 1 NO MINVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT 1 NO MINVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MINVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MINVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type INCREMENT 1 NO MINVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT BY 1 NO MINVALUE CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT BY 1 NO MINVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT BY 1 NO MINVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT BY 1 NO MINVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE CACHE 1 CYCLE OWNED BY NONE"),
@@ -8434,7 +8434,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MINVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MINVALUE CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
@@ -8448,7 +8448,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT BY 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT BY 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT BY 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
@@ -8458,26 +8458,26 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name MINVALUE 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name MINVALUE 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name MINVALUE 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name MINVALUE 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name MINVALUE 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name MINVALUE 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type MINVALUE 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type MINVALUE 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type MINVALUE 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT 1 MINVALUE 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT 1 MINVALUE 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name NO MINVALUE MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name NO MINVALUE MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name NO MINVALUE MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name NO MINVALUE MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name NO MINVALUE MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type NO MINVALUE MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type NO MINVALUE MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT 1 NO MINVALUE MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT 1 NO MINVALUE MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT 1 NO MINVALUE MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MINVALUE MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type INCREMENT 1 NO MINVALUE MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
@@ -8488,7 +8488,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MINVALUE MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MINVALUE MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MINVALUE MAXVALUE 1 CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
@@ -8498,7 +8498,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type INCREMENT 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
@@ -8509,16 +8509,16 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT BY 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT BY 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name MINVALUE 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name MINVALUE 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name MINVALUE 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name MINVALUE 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name MINVALUE 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type MINVALUE 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type MINVALUE 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type MINVALUE 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT 1 MINVALUE 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT 1 MINVALUE 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT 1 MINVALUE 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT 1 MINVALUE 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type INCREMENT 1 MINVALUE 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 MINVALUE 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
@@ -8529,9 +8529,9 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MINVALUE 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MINVALUE 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MINVALUE 1 NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
@@ -8539,17 +8539,17 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT 1 NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT 1 NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT 1 NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT 1 NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT 1 NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type INCREMENT 1 NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT 1 NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT BY 1 NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT BY 1 NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT BY 1 NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT BY 1 NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT BY 1 NO MINVALUE NO MAXVALUE CACHE 1 CYCLE OWNED BY NONE"),
@@ -8920,7 +8920,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMPORARY SEQUENCE name NO CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type NO CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name INCREMENT 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT 1 NO CYCLE OWNED BY NONE"),
@@ -8929,7 +8929,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT BY 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT BY 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT BY 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT BY 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT BY 1 NO CYCLE OWNED BY NONE"),
@@ -8949,7 +8949,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT 1 MINVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 MINVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 MINVALUE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MINVALUE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MINVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MINVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type INCREMENT BY 1 MINVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT BY 1 MINVALUE 1 NO CYCLE OWNED BY NONE"),
@@ -8961,11 +8961,11 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type NO MINVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type NO MINVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type NO MINVALUE NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT 1 NO MINVALUE NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT 1 NO MINVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT BY 1 NO MINVALUE NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT BY 1 NO MINVALUE NO CYCLE OWNED BY NONE"),
@@ -8979,15 +8979,15 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type MAXVALUE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type INCREMENT 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT BY 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT BY 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type INCREMENT BY 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
@@ -8995,43 +8995,43 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name MINVALUE 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name MINVALUE 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name MINVALUE 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name MINVALUE 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type MINVALUE 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type MINVALUE 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type MINVALUE 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT 1 MINVALUE 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT 1 MINVALUE 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT 1 MINVALUE 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 MINVALUE 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 MINVALUE 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT 1 NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT 1 NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT 1 NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT 1 NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type INCREMENT 1 NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT 1 NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT BY 1 NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT BY 1 NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT BY 1 NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT BY 1 NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
@@ -9040,7 +9040,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT BY 1 NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MINVALUE MAXVALUE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name NO MAXVALUE NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name NO MAXVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name NO MAXVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type NO MAXVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type NO MAXVALUE NO CYCLE OWNED BY NONE"),
@@ -9051,14 +9051,14 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMP SEQUENCE name AS data_type INCREMENT 1 NO MAXVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT 1 NO MAXVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT BY 1 NO MAXVALUE NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MAXVALUE NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MAXVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type INCREMENT BY 1 NO MAXVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT BY 1 NO MAXVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT BY 1 NO MAXVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MAXVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MAXVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MAXVALUE NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name MINVALUE 1 NO MAXVALUE NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name MINVALUE 1 NO MAXVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name MINVALUE 1 NO MAXVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name MINVALUE 1 NO MAXVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name MINVALUE 1 NO MAXVALUE NO CYCLE OWNED BY NONE"),
@@ -9072,7 +9072,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT 1 MINVALUE 1 NO MAXVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT 1 MINVALUE 1 NO MAXVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 MINVALUE 1 NO MAXVALUE NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT BY 1 MINVALUE 1 NO MAXVALUE NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT BY 1 MINVALUE 1 NO MAXVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT BY 1 MINVALUE 1 NO MAXVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT BY 1 MINVALUE 1 NO MAXVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MINVALUE 1 NO MAXVALUE NO CYCLE OWNED BY NONE"),
@@ -9094,7 +9094,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE NO MAXVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE NO MAXVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE NO MAXVALUE NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT BY 1 NO MINVALUE NO MAXVALUE NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT BY 1 NO MINVALUE NO MAXVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT BY 1 NO MINVALUE NO MAXVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE NO MAXVALUE NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE NO MAXVALUE NO CYCLE OWNED BY NONE"),
@@ -9479,7 +9479,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type CACHE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 CACHE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name INCREMENT 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name INCREMENT 1 CACHE 1 NO CYCLE OWNED BY NONE"),
@@ -9510,7 +9510,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT BY 1 MINVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT BY 1 MINVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT BY 1 MINVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MINVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MINVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MINVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type INCREMENT BY 1 MINVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type INCREMENT BY 1 MINVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
@@ -9519,12 +9519,12 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMP SEQUENCE name AS data_type NO MINVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type NO MINVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type NO MINVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT 1 NO MINVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT 1 NO MINVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name INCREMENT 1 NO MINVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT BY 1 NO MINVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT BY 1 NO MINVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type INCREMENT BY 1 NO MINVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT BY 1 NO MINVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MINVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
@@ -9541,7 +9541,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT BY 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT BY 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT BY 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT BY 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT BY 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
@@ -9552,7 +9552,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name MINVALUE 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name MINVALUE 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name MINVALUE 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name MINVALUE 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name MINVALUE 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name MINVALUE 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type MINVALUE 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
@@ -9561,13 +9561,13 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT 1 MINVALUE 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT 1 MINVALUE 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT 1 MINVALUE 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT 1 MINVALUE 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT 1 MINVALUE 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 MINVALUE 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 MINVALUE 1 MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
@@ -9582,7 +9582,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT 1 NO MINVALUE MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT 1 NO MINVALUE MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT BY 1 NO MINVALUE MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT BY 1 NO MINVALUE MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT BY 1 NO MINVALUE MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type INCREMENT BY 1 NO MINVALUE MAXVALUE 1 CACHE 1 NO CYCLE OWNED BY NONE"),
@@ -9605,14 +9605,14 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMPORARY SEQUENCE name AS data_type INCREMENT 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type INCREMENT 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT BY 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT BY 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name INCREMENT BY 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT BY 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name MINVALUE 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name MINVALUE 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name MINVALUE 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name MINVALUE 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type MINVALUE 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name AS data_type MINVALUE 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
@@ -9620,10 +9620,10 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type MINVALUE 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type MINVALUE 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type MINVALUE 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE name INCREMENT 1 MINVALUE 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE name INCREMENT 1 MINVALUE 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE name INCREMENT 1 MINVALUE 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE name INCREMENT 1 MINVALUE 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name INCREMENT 1 MINVALUE 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE name AS data_type INCREMENT 1 MINVALUE 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 MINVALUE 1 NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
@@ -9646,7 +9646,7 @@ func TestCreateSequence(t *testing.T) {
 		Parses("CREATE TEMP SEQUENCE name AS data_type INCREMENT 1 NO MINVALUE NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMPORARY SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT 1 NO MINVALUE NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
-		Parses("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
+		Converts("CREATE SEQUENCE IF NOT EXISTS name INCREMENT BY 1 NO MINVALUE NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE UNLOGGED SEQUENCE name AS data_type INCREMENT BY 1 NO MINVALUE NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MINVALUE NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
 		Parses("CREATE TEMP SEQUENCE IF NOT EXISTS name AS data_type INCREMENT BY 1 NO MINVALUE NO MAXVALUE CACHE 1 NO CYCLE OWNED BY NONE"),
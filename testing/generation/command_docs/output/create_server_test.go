@@ -18,24 +18,24 @@ import "testing"
 
 func TestCreateServer(t *testing.T) {
 	tests := []QueryParses{
-		Unimplemented("CREATE SERVER server_name FOREIGN DATA WRAPPER fdw_name"),
-		Unimplemented("CREATE SERVER IF NOT EXISTS server_name FOREIGN DATA WRAPPER fdw_name"),
+		Converts("CREATE SERVER server_name FOREIGN DATA WRAPPER fdw_name"),
+		Converts("CREATE SERVER IF NOT EXISTS server_name FOREIGN DATA WRAPPER fdw_name"),
 		Unimplemented("CREATE SERVER server_name TYPE ' server_type ' FOREIGN DATA WRAPPER fdw_name"),
 		Unimplemented("CREATE SERVER IF NOT EXISTS server_name TYPE ' server_type ' FOREIGN DATA WRAPPER fdw_name"),
 		Unimplemented("CREATE SERVER server_name VERSION ' server_version ' FOREIGN DATA WRAPPER fdw_name"),
 		Unimplemented("CREATE SERVER IF NOT EXISTS server_name VERSION ' server_version ' FOREIGN DATA WRAPPER fdw_name"),
 		Unimplemented("CREATE SERVER server_name TYPE ' server_type ' VERSION ' server_version ' FOREIGN DATA WRAPPER fdw_name"),
 		Unimplemented("CREATE SERVER IF NOT EXISTS server_name TYPE ' server_type ' VERSION ' server_version ' FOREIGN DATA WRAPPER fdw_name"),
-		Unimplemented("CREATE SERVER server_name FOREIGN DATA WRAPPER fdw_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE SERVER IF NOT EXISTS server_name FOREIGN DATA WRAPPER fdw_name OPTIONS ( option ' value ' )"),
+		Converts("CREATE SERVER server_name FOREIGN DATA WRAPPER fdw_name OPTIONS ( option ' value ' )"),
+		Converts("CREATE SERVER IF NOT EXISTS server_name FOREIGN DATA WRAPPER fdw_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE SERVER server_name TYPE ' server_type ' FOREIGN DATA WRAPPER fdw_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE SERVER IF NOT EXISTS server_name TYPE ' server_type ' FOREIGN DATA WRAPPER fdw_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE SERVER server_name VERSION ' server_version ' FOREIGN DATA WRAPPER fdw_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE SERVER IF NOT EXISTS server_name VERSION ' server_version ' FOREIGN DATA WRAPPER fdw_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE SERVER server_name TYPE ' server_type ' VERSION ' server_version ' FOREIGN DATA WRAPPER fdw_name OPTIONS ( option ' value ' )"),
 		Unimplemented("CREATE SERVER IF NOT EXISTS server_name TYPE ' server_type ' VERSION ' server_version ' FOREIGN DATA WRAPPER fdw_name OPTIONS ( option ' value ' )"),
-		Unimplemented("CREATE SERVER server_name FOREIGN DATA WRAPPER fdw_name OPTIONS ( option ' value ' , option ' value ' )"),
-		Unimplemented("CREATE SERVER IF NOT EXISTS server_name FOREIGN DATA WRAPPER fdw_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE SERVER server_name FOREIGN DATA WRAPPER fdw_name OPTIONS ( option ' value ' , option ' value ' )"),
+		Parses("CREATE SERVER IF NOT EXISTS server_name FOREIGN DATA WRAPPER fdw_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE SERVER server_name TYPE ' server_type ' FOREIGN DATA WRAPPER fdw_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE SERVER IF NOT EXISTS server_name TYPE ' server_type ' FOREIGN DATA WRAPPER fdw_name OPTIONS ( option ' value ' , option ' value ' )"),
 		Unimplemented("CREATE SERVER server_name VERSION ' server_version ' FOREIGN DATA WRAPPER fdw_name OPTIONS ( option ' value ' , option ' value ' )"),
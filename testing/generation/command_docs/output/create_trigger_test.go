@@ -687,42 +687,42 @@ func TestCreateTrigger(t *testing.T) {
 		Parses("CREATE TRIGGER name BEFORE UPDATE OR TRUNCATE ON table_name EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR TRUNCATE ON table_name EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE TRUNCATE OR TRUNCATE ON table_name EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR INSERT ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR INSERT ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE INSERT OR UPDATE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR UPDATE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OR UPDATE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR UPDATE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OR UPDATE OF column_name ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR INSERT ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR INSERT ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE INSERT OR UPDATE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR UPDATE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OR UPDATE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR UPDATE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OR UPDATE OF column_name ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE TRUNCATE OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE INSERT OR DELETE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OR DELETE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR DELETE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE INSERT OR DELETE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OR DELETE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR DELETE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE UPDATE OR TRUNCATE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name OR TRUNCATE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE TRUNCATE OR TRUNCATE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name BEFORE TRUNCATE OR TRUNCATE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name OR INSERT ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR INSERT ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR INSERT ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR INSERT ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR UPDATE ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR UPDATE ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE DELETE OR UPDATE ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OF column_name OR INSERT ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR INSERT ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR INSERT ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR INSERT ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR UPDATE ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR UPDATE ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE DELETE OR UPDATE ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE TRUNCATE OR UPDATE ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OR UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE DELETE OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE INSERT OR DELETE ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OR UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE DELETE OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE INSERT OR DELETE ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE INSERT OR TRUNCATE ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE UPDATE OR TRUNCATE ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR TRUNCATE ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
@@ -794,41 +794,41 @@ func TestCreateTrigger(t *testing.T) {
 		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR TRUNCATE ON table_name WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR TRUNCATE ON table_name WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR TRUNCATE ON table_name WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE DELETE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR INSERT ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR INSERT ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE INSERT OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE DELETE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR INSERT ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR INSERT ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE INSERT OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name BEFORE TRUNCATE OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OR UPDATE OF column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR UPDATE OF column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OR UPDATE OF column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR UPDATE OF column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE TRUNCATE OR UPDATE OF column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE DELETE OR UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name OR DELETE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR DELETE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR DELETE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR DELETE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE DELETE OR UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OF column_name OR DELETE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR DELETE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR DELETE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR DELETE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE UPDATE OR TRUNCATE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name OR TRUNCATE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE TRUNCATE OR TRUNCATE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OR INSERT ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR UPDATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR UPDATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR UPDATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OR INSERT ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR UPDATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR UPDATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR UPDATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE TRUNCATE OR UPDATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name BEFORE TRUNCATE OR UPDATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR UPDATE OF column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR UPDATE OF column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name BEFORE TRUNCATE OR UPDATE OF column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE TRUNCATE OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE TRUNCATE OR DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OR TRUNCATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR TRUNCATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
@@ -886,53 +886,53 @@ func TestCreateTrigger(t *testing.T) {
 		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR DELETE ON table_name EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR TRUNCATE ON table_name EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OR TRUNCATE ON table_name EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE INSERT ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE INSERT OR INSERT ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR INSERT ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR INSERT ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE INSERT ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE INSERT OR INSERT ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR INSERT ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR INSERT ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE TRUNCATE OR INSERT ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OR UPDATE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR UPDATE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE INSERT OR UPDATE OF column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR UPDATE OF column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR UPDATE OF column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE DELETE OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OR UPDATE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR UPDATE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE INSERT OR UPDATE OF column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR UPDATE OF column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR UPDATE OF column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE DELETE OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE TRUNCATE OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name BEFORE TRUNCATE OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OR DELETE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name OR DELETE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR DELETE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR DELETE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR DELETE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OR DELETE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OF column_name OR DELETE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR DELETE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR DELETE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR DELETE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR TRUNCATE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR TRUNCATE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE TRUNCATE OR TRUNCATE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE INSERT ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE DELETE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE DELETE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OR INSERT ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name OR INSERT ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR INSERT ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR UPDATE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR UPDATE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE INSERT ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE DELETE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE DELETE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OR INSERT ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OF column_name OR INSERT ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR INSERT ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR UPDATE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR UPDATE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name BEFORE TRUNCATE OR UPDATE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE INSERT OR DELETE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OR DELETE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OR DELETE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR DELETE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE DELETE OR DELETE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE INSERT OR DELETE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OR DELETE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OR DELETE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR DELETE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE DELETE OR DELETE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE UPDATE OR TRUNCATE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR TRUNCATE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR TRUNCATE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
@@ -1006,35 +1006,35 @@ func TestCreateTrigger(t *testing.T) {
 		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name OR DELETE ON table_name WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR DELETE ON table_name WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OR TRUNCATE ON table_name WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR INSERT ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE INSERT OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE DELETE OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR INSERT ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE INSERT OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OF column_name OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE DELETE OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE TRUNCATE OR UPDATE OF column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name BEFORE TRUNCATE OR UPDATE OF column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OR UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR DELETE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OR UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name OR DELETE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE UPDATE OR TRUNCATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name OR TRUNCATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE TRUNCATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OR INSERT ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name OR INSERT ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR INSERT ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OR INSERT ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OF column_name OR INSERT ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR INSERT ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE TRUNCATE OR INSERT ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name BEFORE TRUNCATE OR INSERT ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OR UPDATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR UPDATE OF column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR UPDATE OF column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name BEFORE UPDATE OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OR UPDATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR UPDATE OF column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE DELETE OR UPDATE OF column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name BEFORE UPDATE OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE TRUNCATE OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE INSERT OR DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name BEFORE UPDATE OF column_name , column_name OR DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE TRUNCATE OR DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name BEFORE TRUNCATE OR DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name BEFORE UPDATE OF column_name , column_name OR TRUNCATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
@@ -1506,20 +1506,20 @@ func TestCreateTrigger(t *testing.T) {
 		Parses("CREATE OR REPLACE TRIGGER name AFTER TRUNCATE OR DELETE ON table_name REFERENCING NEW TABLE AS transition_relation_name NEW TABLE AS transition_relation_name EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER INSERT OR TRUNCATE ON table_name REFERENCING NEW TABLE AS transition_relation_name NEW TABLE AS transition_relation_name EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name OR TRUNCATE ON table_name REFERENCING NEW TABLE AS transition_relation_name NEW TABLE AS transition_relation_name EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER DELETE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER INSERT OR INSERT ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR INSERT ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name OR INSERT ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER DELETE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER INSERT OR INSERT ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR INSERT ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name OR INSERT ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER TRUNCATE OR INSERT ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER INSERT OR UPDATE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name OR UPDATE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER INSERT OR UPDATE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name OR UPDATE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER TRUNCATE OR UPDATE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OR UPDATE OF column_name ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR UPDATE OF column_name ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name , column_name OR UPDATE OF column_name ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name , column_name OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OR UPDATE OF column_name ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR UPDATE OF column_name ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name , column_name OR UPDATE OF column_name ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name , column_name OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER TRUNCATE OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER INSERT OR TRUNCATE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER UPDATE OR TRUNCATE ON table_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
@@ -1959,20 +1959,20 @@ func TestCreateTrigger(t *testing.T) {
 		Parses("CREATE OR REPLACE TRIGGER name AFTER DELETE OR TRUNCATE ON table_name REFERENCING NEW TABLE AS transition_relation_name NEW TABLE AS transition_relation_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER TRUNCATE OR TRUNCATE ON table_name REFERENCING NEW TABLE AS transition_relation_name NEW TABLE AS transition_relation_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER TRUNCATE OR TRUNCATE ON table_name REFERENCING NEW TABLE AS transition_relation_name NEW TABLE AS transition_relation_name FOR ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER INSERT ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER INSERT ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER TRUNCATE ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER INSERT OR INSERT ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR INSERT ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name OR INSERT ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER INSERT OR UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER INSERT OR UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name OR UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER DELETE OR UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER INSERT OR INSERT ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR INSERT ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name OR INSERT ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER INSERT OR UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER INSERT OR UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OF column_name OR UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER DELETE OR UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER TRUNCATE OR UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name OR DELETE ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER DELETE OR DELETE ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name OR DELETE ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER DELETE OR DELETE ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER INSERT OR TRUNCATE ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR TRUNCATE ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER DELETE OR TRUNCATE ON table_name FOR EACH ROW EXECUTE FUNCTION function_name ( arguments )"),
@@ -3727,28 +3727,28 @@ func TestCreateTrigger(t *testing.T) {
 		Parses("CREATE TRIGGER name AFTER INSERT OR TRUNCATE ON table_name REFERENCING NEW TABLE AS transition_relation_name NEW TABLE AS transition_relation_name WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name OR TRUNCATE ON table_name REFERENCING NEW TABLE AS transition_relation_name NEW TABLE AS transition_relation_name WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name , column_name OR TRUNCATE ON table_name REFERENCING NEW TABLE AS transition_relation_name NEW TABLE AS transition_relation_name WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER INSERT ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OR INSERT ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name , column_name OR INSERT ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER DELETE OR INSERT ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name , column_name OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER INSERT ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OF column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OR INSERT ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name , column_name OR INSERT ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER DELETE OR INSERT ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name , column_name OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER TRUNCATE OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR UPDATE OF column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name OR UPDATE OF column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name OR UPDATE OF column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR UPDATE OF column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OF column_name OR UPDATE OF column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name OR UPDATE OF column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER TRUNCATE OR UPDATE OF column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER INSERT OR UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OR UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name OR UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name , column_name OR UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER INSERT OR UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OR UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name OR UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name , column_name OR UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER TRUNCATE OR UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name OR DELETE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name , column_name OR DELETE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER DELETE OR DELETE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name OR DELETE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name , column_name OR DELETE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER DELETE OR DELETE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name OR TRUNCATE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER TRUNCATE OR TRUNCATE ON table_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER INSERT ON table_name REFERENCING OLD TABLE transition_relation_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
@@ -4155,25 +4155,25 @@ func TestCreateTrigger(t *testing.T) {
 		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name OR TRUNCATE ON table_name REFERENCING NEW TABLE AS transition_relation_name NEW TABLE AS transition_relation_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER TRUNCATE OR TRUNCATE ON table_name REFERENCING NEW TABLE AS transition_relation_name NEW TABLE AS transition_relation_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER TRUNCATE OR TRUNCATE ON table_name REFERENCING NEW TABLE AS transition_relation_name NEW TABLE AS transition_relation_name FOR ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name , column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name , column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER TRUNCATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER INSERT OR INSERT ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER INSERT OR INSERT ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER TRUNCATE OR INSERT ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER TRUNCATE OR INSERT ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OR UPDATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR UPDATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name OR UPDATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER DELETE OR UPDATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER INSERT OR UPDATE OF column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR UPDATE OF column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER DELETE OR UPDATE OF column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OR UPDATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR UPDATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name OR UPDATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER DELETE OR UPDATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER INSERT OR UPDATE OF column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR UPDATE OF column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER DELETE OR UPDATE OF column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER TRUNCATE OR UPDATE OF column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER INSERT OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER DELETE OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER INSERT OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER DELETE OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER TRUNCATE OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name OR DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name OR DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER INSERT OR TRUNCATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER TRUNCATE OR TRUNCATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER TRUNCATE OR TRUNCATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE FUNCTION function_name ( arguments )"),
@@ -5875,24 +5875,24 @@ func TestCreateTrigger(t *testing.T) {
 		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR TRUNCATE ON table_name REFERENCING NEW TABLE AS transition_relation_name NEW TABLE AS transition_relation_name EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name OR TRUNCATE ON table_name REFERENCING NEW TABLE AS transition_relation_name NEW TABLE AS transition_relation_name EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER TRUNCATE OR TRUNCATE ON table_name REFERENCING NEW TABLE AS transition_relation_name NEW TABLE AS transition_relation_name EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER INSERT OR INSERT ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OR INSERT ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name OR INSERT ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER INSERT OR INSERT ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OR INSERT ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name OR INSERT ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER TRUNCATE OR INSERT ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name OR UPDATE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name OR UPDATE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER DELETE OR UPDATE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OF column_name OR UPDATE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name OR UPDATE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER DELETE OR UPDATE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER TRUNCATE OR UPDATE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER INSERT OR UPDATE OF column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OR UPDATE OF column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR UPDATE OF column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER INSERT OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER DELETE OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER DELETE OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER INSERT OR UPDATE OF column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OR UPDATE OF column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR UPDATE OF column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER INSERT OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER DELETE OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER DELETE OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER TRUNCATE OR UPDATE OF column_name , column_name ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR DELETE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER DELETE OR DELETE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR DELETE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER DELETE OR DELETE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name OR TRUNCATE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name OR TRUNCATE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER DELETE OR TRUNCATE ON table_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
@@ -6328,18 +6328,18 @@ func TestCreateTrigger(t *testing.T) {
 		Parses("CREATE TRIGGER name AFTER INSERT OR DELETE ON table_name REFERENCING NEW TABLE AS transition_relation_name NEW TABLE AS transition_relation_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name OR DELETE ON table_name REFERENCING NEW TABLE AS transition_relation_name NEW TABLE AS transition_relation_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER TRUNCATE OR TRUNCATE ON table_name REFERENCING NEW TABLE AS transition_relation_name NEW TABLE AS transition_relation_name FOR ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER INSERT OR INSERT ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name OR INSERT ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name OR INSERT ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER DELETE OR INSERT ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name OR UPDATE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER INSERT OR INSERT ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OF column_name OR INSERT ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name OR INSERT ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER DELETE OR INSERT ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name OR UPDATE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER TRUNCATE OR UPDATE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER INSERT OR UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER INSERT OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER INSERT OR UPDATE OF column_name ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER INSERT OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OF column_name OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER TRUNCATE OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OR DELETE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OR DELETE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER TRUNCATE OR DELETE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER INSERT OR TRUNCATE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER INSERT OR TRUNCATE ON table_name FOR EACH ROW EXECUTE PROCEDURE function_name ( arguments )"),
@@ -8028,21 +8028,21 @@ func TestCreateTrigger(t *testing.T) {
 		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name OR TRUNCATE ON table_name REFERENCING NEW TABLE AS transition_relation_name NEW TABLE AS transition_relation_name WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER TRUNCATE OR TRUNCATE ON table_name REFERENCING NEW TABLE AS transition_relation_name NEW TABLE AS transition_relation_name WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER TRUNCATE OR TRUNCATE ON table_name REFERENCING NEW TABLE AS transition_relation_name NEW TABLE AS transition_relation_name WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER DELETE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER DELETE OR INSERT ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER DELETE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER DELETE OR INSERT ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER TRUNCATE OR INSERT ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER TRUNCATE OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER TRUNCATE OR UPDATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OR UPDATE OF column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER DELETE OR UPDATE OF column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER INSERT OR UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name OR UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name , column_name OR UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER INSERT OR DELETE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OR UPDATE OF column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER DELETE OR UPDATE OF column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER INSERT OR UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name OR UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name , column_name OR UPDATE OF column_name , column_name ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER INSERT OR DELETE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name OR TRUNCATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name , column_name OR TRUNCATE ON table_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER INSERT ON table_name REFERENCING OLD TABLE transition_relation_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
@@ -8489,19 +8489,19 @@ func TestCreateTrigger(t *testing.T) {
 		Parses("CREATE TRIGGER name AFTER UPDATE OR TRUNCATE ON table_name REFERENCING NEW TABLE AS transition_relation_name NEW TABLE AS transition_relation_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name OR TRUNCATE ON table_name REFERENCING NEW TABLE AS transition_relation_name NEW TABLE AS transition_relation_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER DELETE OR TRUNCATE ON table_name REFERENCING NEW TABLE AS transition_relation_name NEW TABLE AS transition_relation_name FOR ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER INSERT ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER INSERT ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER INSERT ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER INSERT ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER TRUNCATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER TRUNCATE OR UPDATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER INSERT OR UPDATE OF column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OR UPDATE OF column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name OR UPDATE OF column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER INSERT OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE TRIGGER name AFTER UPDATE OF column_name OR DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name , column_name OR DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
-		Parses("CREATE OR REPLACE TRIGGER name AFTER DELETE OR DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER INSERT OR UPDATE OF column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OR UPDATE OF column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OF column_name , column_name OR UPDATE OF column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER INSERT OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name OR UPDATE OF column_name , column_name ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE TRIGGER name AFTER UPDATE OF column_name OR DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name , column_name OR DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
+		Converts("CREATE OR REPLACE TRIGGER name AFTER DELETE OR DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER TRUNCATE OR DELETE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE TRIGGER name AFTER UPDATE OR TRUNCATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
 		Parses("CREATE OR REPLACE TRIGGER name AFTER UPDATE OF column_name OR TRUNCATE ON table_name FOR EACH ROW WHEN ( condition ) EXECUTE PROCEDURE function_name ( arguments )"),
@@ -18,43 +18,43 @@ import "testing"
 
 func TestCreateUser(t *testing.T) {
 	tests := []QueryParses{
-		Parses("CREATE USER name"),
-		Unimplemented("CREATE USER name SUPERUSER"),
-		Unimplemented("CREATE USER name WITH SUPERUSER"),
-		Unimplemented("CREATE USER name NOSUPERUSER"),
-		Unimplemented("CREATE USER name WITH NOSUPERUSER"),
-		Parses("CREATE USER name CREATEDB"),
-		Parses("CREATE USER name WITH CREATEDB"),
-		Parses("CREATE USER name NOCREATEDB"),
-		Parses("CREATE USER name WITH NOCREATEDB"),
-		Parses("CREATE USER name CREATEROLE"),
-		Parses("CREATE USER name WITH CREATEROLE"),
-		Parses("CREATE USER name NOCREATEROLE"),
-		Parses("CREATE USER name WITH NOCREATEROLE"),
-		Unimplemented("CREATE USER name INHERIT"),
-		Unimplemented("CREATE USER name WITH INHERIT"),
-		Unimplemented("CREATE USER name NOINHERIT"),
-		Unimplemented("CREATE USER name WITH NOINHERIT"),
-		Parses("CREATE USER name LOGIN"),
-		Parses("CREATE USER name WITH LOGIN"),
-		Parses("CREATE USER name NOLOGIN"),
-		Parses("CREATE USER name WITH NOLOGIN"),
-		Unimplemented("CREATE USER name REPLICATION"),
-		Unimplemented("CREATE USER name WITH REPLICATION"),
-		Unimplemented("CREATE USER name NOREPLICATION"),
-		Unimplemented("CREATE USER name WITH NOREPLICATION"),
-		Unimplemented("CREATE USER name BYPASSRLS"),
-		Unimplemented("CREATE USER name WITH BYPASSRLS"),
-		Unimplemented("CREATE USER name NOBYPASSRLS"),
-		Unimplemented("CREATE USER name WITH NOBYPASSRLS"),
-		Unimplemented("CREATE USER name CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name WITH CONNECTION LIMIT -1"),
-		Parses("CREATE USER name PASSWORD ' password '"),
-		Parses("CREATE USER name WITH PASSWORD ' password '"),
-		Unimplemented("CREATE USER name ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH ENCRYPTED PASSWORD ' password '"),
-		Parses("CREATE USER name PASSWORD NULL"),
-		Parses("CREATE USER name WITH PASSWORD NULL"),
+		Converts("CREATE USER name"),
+		Converts("CREATE USER name SUPERUSER"),
+		Converts("CREATE USER name WITH SUPERUSER"),
+		Converts("CREATE USER name NOSUPERUSER"),
+		Converts("CREATE USER name WITH NOSUPERUSER"),
+		Converts("CREATE USER name CREATEDB"),
+		Converts("CREATE USER name WITH CREATEDB"),
+		Converts("CREATE USER name NOCREATEDB"),
+		Converts("CREATE USER name WITH NOCREATEDB"),
+		Converts("CREATE USER name CREATEROLE"),
+		Converts("CREATE USER name WITH CREATEROLE"),
+		Converts("CREATE USER name NOCREATEROLE"),
+		Converts("CREATE USER name WITH NOCREATEROLE"),
+		Converts("CREATE USER name INHERIT"),
+		Converts("CREATE USER name WITH INHERIT"),
+		Converts("CREATE USER name NOINHERIT"),
+		Converts("CREATE USER name WITH NOINHERIT"),
+		Converts("CREATE USER name LOGIN"),
+		Converts("CREATE USER name WITH LOGIN"),
+		Converts("CREATE USER name NOLOGIN"),
+		Converts("CREATE USER name WITH NOLOGIN"),
+		Converts("CREATE USER name REPLICATION"),
+		Converts("CREATE USER name WITH REPLICATION"),
+		Converts("CREATE USER name NOREPLICATION"),
+		Converts("CREATE USER name WITH NOREPLICATION"),
+		Converts("CREATE USER name BYPASSRLS"),
+		Converts("CREATE USER name WITH BYPASSRLS"),
+		Converts("CREATE USER name NOBYPASSRLS"),
+		Converts("CREATE USER name WITH NOBYPASSRLS"),
+		Converts("CREATE USER name CONNECTION LIMIT -1"),
+		Converts("CREATE USER name WITH CONNECTION LIMIT -1"),
+		Converts("CREATE USER name PASSWORD ' password '"),
+		Converts("CREATE USER name WITH PASSWORD ' password '"),
+		Converts("CREATE USER name ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name WITH ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name PASSWORD NULL"),
+		Converts("CREATE USER name WITH PASSWORD NULL"),
 		Parses("CREATE USER name VALID UNTIL ' timestamp '"),
 		Parses("CREATE USER name WITH VALID UNTIL ' timestamp '"),
 		Unimplemented("CREATE USER name IN ROLE role_name"),
@@ -79,44 +79,44 @@ func TestCreateUser(t *testing.T) {
 		Unimplemented("CREATE USER name WITH USER role_name , role_name"),
 		Unimplemented("CREATE USER name SYSID 1"),
 		Unimplemented("CREATE USER name WITH SYSID 1"),
-		Unimplemented("CREATE USER name SUPERUSER SUPERUSER"),
-		Unimplemented("CREATE USER name WITH SUPERUSER SUPERUSER"),
-		Unimplemented("CREATE USER name NOSUPERUSER SUPERUSER"),
-		Unimplemented("CREATE USER name WITH NOSUPERUSER SUPERUSER"),
-		Unimplemented("CREATE USER name CREATEDB SUPERUSER"),
-		Unimplemented("CREATE USER name WITH CREATEDB SUPERUSER"),
-		Unimplemented("CREATE USER name NOCREATEDB SUPERUSER"),
-		Unimplemented("CREATE USER name WITH NOCREATEDB SUPERUSER"),
-		Unimplemented("CREATE USER name CREATEROLE SUPERUSER"),
-		Unimplemented("CREATE USER name WITH CREATEROLE SUPERUSER"),
-		Unimplemented("CREATE USER name NOCREATEROLE SUPERUSER"),
-		Unimplemented("CREATE USER name WITH NOCREATEROLE SUPERUSER"),
-		Unimplemented("CREATE USER name INHERIT SUPERUSER"),
-		Unimplemented("CREATE USER name WITH INHERIT SUPERUSER"),
-		Unimplemented("CREATE USER name NOINHERIT SUPERUSER"),
-		Unimplemented("CREATE USER name WITH NOINHERIT SUPERUSER"),
-		Unimplemented("CREATE USER name LOGIN SUPERUSER"),
-		Unimplemented("CREATE USER name WITH LOGIN SUPERUSER"),
-		Unimplemented("CREATE USER name NOLOGIN SUPERUSER"),
-		Unimplemented("CREATE USER name WITH NOLOGIN SUPERUSER"),
-		Unimplemented("CREATE USER name REPLICATION SUPERUSER"),
-		Unimplemented("CREATE USER name WITH REPLICATION SUPERUSER"),
-		Unimplemented("CREATE USER name NOREPLICATION SUPERUSER"),
-		Unimplemented("CREATE USER name WITH NOREPLICATION SUPERUSER"),
-		Unimplemented("CREATE USER name BYPASSRLS SUPERUSER"),
-		Unimplemented("CREATE USER name WITH BYPASSRLS SUPERUSER"),
-		Unimplemented("CREATE USER name NOBYPASSRLS SUPERUSER"),
-		Unimplemented("CREATE USER name WITH NOBYPASSRLS SUPERUSER"),
-		Unimplemented("CREATE USER name CONNECTION LIMIT -1 SUPERUSER"),
-		Unimplemented("CREATE USER name WITH CONNECTION LIMIT -1 SUPERUSER"),
-		Unimplemented("CREATE USER name PASSWORD ' password ' SUPERUSER"),
-		Unimplemented("CREATE USER name WITH PASSWORD ' password ' SUPERUSER"),
-		Unimplemented("CREATE USER name ENCRYPTED PASSWORD ' password ' SUPERUSER"),
-		Unimplemented("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' SUPERUSER"),
-		Unimplemented("CREATE USER name PASSWORD NULL SUPERUSER"),
-		Unimplemented("CREATE USER name WITH PASSWORD NULL SUPERUSER"),
-		Unimplemented("CREATE USER name VALID UNTIL ' timestamp ' SUPERUSER"),
-		Unimplemented("CREATE USER name WITH VALID UNTIL ' timestamp ' SUPERUSER"),
+		Parses("CREATE USER name SUPERUSER SUPERUSER"),
+		Parses("CREATE USER name WITH SUPERUSER SUPERUSER"),
+		Parses("CREATE USER name NOSUPERUSER SUPERUSER"),
+		Parses("CREATE USER name WITH NOSUPERUSER SUPERUSER"),
+		Converts("CREATE USER name CREATEDB SUPERUSER"),
+		Converts("CREATE USER name WITH CREATEDB SUPERUSER"),
+		Converts("CREATE USER name NOCREATEDB SUPERUSER"),
+		Converts("CREATE USER name WITH NOCREATEDB SUPERUSER"),
+		Converts("CREATE USER name CREATEROLE SUPERUSER"),
+		Converts("CREATE USER name WITH CREATEROLE SUPERUSER"),
+		Converts("CREATE USER name NOCREATEROLE SUPERUSER"),
+		Converts("CREATE USER name WITH NOCREATEROLE SUPERUSER"),
+		Converts("CREATE USER name INHERIT SUPERUSER"),
+		Converts("CREATE USER name WITH INHERIT SUPERUSER"),
+		Converts("CREATE USER name NOINHERIT SUPERUSER"),
+		Converts("CREATE USER name WITH NOINHERIT SUPERUSER"),
+		Converts("CREATE USER name LOGIN SUPERUSER"),
+		Converts("CREATE USER name WITH LOGIN SUPERUSER"),
+		Converts("CREATE USER name NOLOGIN SUPERUSER"),
+		Converts("CREATE USER name WITH NOLOGIN SUPERUSER"),
+		Converts("CREATE USER name REPLICATION SUPERUSER"),
+		Converts("CREATE USER name WITH REPLICATION SUPERUSER"),
+		Converts("CREATE USER name NOREPLICATION SUPERUSER"),
+		Converts("CREATE USER name WITH NOREPLICATION SUPERUSER"),
+		Converts("CREATE USER name BYPASSRLS SUPERUSER"),
+		Converts("CREATE USER name WITH BYPASSRLS SUPERUSER"),
+		Converts("CREATE USER name NOBYPASSRLS SUPERUSER"),
+		Converts("CREATE USER name WITH NOBYPASSRLS SUPERUSER"),
+		Converts("CREATE USER name CONNECTION LIMIT -1 SUPERUSER"),
+		Converts("CREATE USER name WITH CONNECTION LIMIT -1 SUPERUSER"),
+		Converts("CREATE USER name PASSWORD ' password ' SUPERUSER"),
+		Converts("CREATE USER name WITH PASSWORD ' password ' SUPERUSER"),
+		Converts("CREATE USER name ENCRYPTED PASSWORD ' password ' SUPERUSER"),
+		Converts("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' SUPERUSER"),
+		Converts("CREATE USER name PASSWORD NULL SUPERUSER"),
+		Converts("CREATE USER name WITH PASSWORD NULL SUPERUSER"),
+		Parses("CREATE USER name VALID UNTIL ' timestamp ' SUPERUSER"),
+		Parses("CREATE USER name WITH VALID UNTIL ' timestamp ' SUPERUSER"),
 		Unimplemented("CREATE USER name IN ROLE role_name SUPERUSER"),
 		Unimplemented("CREATE USER name WITH IN ROLE role_name SUPERUSER"),
 		Unimplemented("CREATE USER name IN ROLE role_name , role_name SUPERUSER"),
@@ -139,44 +139,44 @@ func TestCreateUser(t *testing.T) {
 		Unimplemented("CREATE USER name WITH USER role_name , role_name SUPERUSER"),
 		Unimplemented("CREATE USER name SYSID 1 SUPERUSER"),
 		Unimplemented("CREATE USER name WITH SYSID 1 SUPERUSER"),
-		Unimplemented("CREATE USER name SUPERUSER NOSUPERUSER"),
-		Unimplemented("CREATE USER name WITH SUPERUSER NOSUPERUSER"),
-		Unimplemented("CREATE USER name NOSUPERUSER NOSUPERUSER"),
-		Unimplemented("CREATE USER name WITH NOSUPERUSER NOSUPERUSER"),
-		Unimplemented("CREATE USER name CREATEDB NOSUPERUSER"),
-		Unimplemented("CREATE USER name WITH CREATEDB NOSUPERUSER"),
-		Unimplemented("CREATE USER name NOCREATEDB NOSUPERUSER"),
-		Unimplemented("CREATE USER name WITH NOCREATEDB NOSUPERUSER"),
-		Unimplemented("CREATE USER name CREATEROLE NOSUPERUSER"),
-		Unimplemented("CREATE USER name WITH CREATEROLE NOSUPERUSER"),
-		Unimplemented("CREATE USER name NOCREATEROLE NOSUPERUSER"),
-		Unimplemented("CREATE USER name WITH NOCREATEROLE NOSUPERUSER"),
-		Unimplemented("CREATE USER name INHERIT NOSUPERUSER"),
-		Unimplemented("CREATE USER name WITH INHERIT NOSUPERUSER"),
-		Unimplemented("CREATE USER name NOINHERIT NOSUPERUSER"),
-		Unimplemented("CREATE USER name WITH NOINHERIT NOSUPERUSER"),
-		Unimplemented("CREATE USER name LOGIN NOSUPERUSER"),
-		Unimplemented("CREATE USER name WITH LOGIN NOSUPERUSER"),
-		Unimplemented("CREATE USER name NOLOGIN NOSUPERUSER"),
-		Unimplemented("CREATE USER name WITH NOLOGIN NOSUPERUSER"),
-		Unimplemented("CREATE USER name REPLICATION NOSUPERUSER"),
-		Unimplemented("CREATE USER name WITH REPLICATION NOSUPERUSER"),
-		Unimplemented("CREATE USER name NOREPLICATION NOSUPERUSER"),
-		Unimplemented("CREATE USER name WITH NOREPLICATION NOSUPERUSER"),
-		Unimplemented("CREATE USER name BYPASSRLS NOSUPERUSER"),
-		Unimplemented("CREATE USER name WITH BYPASSRLS NOSUPERUSER"),
-		Unimplemented("CREATE USER name NOBYPASSRLS NOSUPERUSER"),
-		Unimplemented("CREATE USER name WITH NOBYPASSRLS NOSUPERUSER"),
-		Unimplemented("CREATE USER name CONNECTION LIMIT -1 NOSUPERUSER"),
-		Unimplemented("CREATE USER name WITH CONNECTION LIMIT -1 NOSUPERUSER"),
-		Unimplemented("CREATE USER name PASSWORD ' password ' NOSUPERUSER"),
-		Unimplemented("CREATE USER name WITH PASSWORD ' password ' NOSUPERUSER"),
-		Unimplemented("CREATE USER name ENCRYPTED PASSWORD ' password ' NOSUPERUSER"),
-		Unimplemented("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' NOSUPERUSER"),
-		Unimplemented("CREATE USER name PASSWORD NULL NOSUPERUSER"),
-		Unimplemented("CREATE USER name WITH PASSWORD NULL NOSUPERUSER"),
-		Unimplemented("CREATE USER name VALID UNTIL ' timestamp ' NOSUPERUSER"),
-		Unimplemented("CREATE USER name WITH VALID UNTIL ' timestamp ' NOSUPERUSER"),
+		Parses("CREATE USER name SUPERUSER NOSUPERUSER"),
+		Parses("CREATE USER name WITH SUPERUSER NOSUPERUSER"),
+		Parses("CREATE USER name NOSUPERUSER NOSUPERUSER"),
+		Parses("CREATE USER name WITH NOSUPERUSER NOSUPERUSER"),
+		Converts("CREATE USER name CREATEDB NOSUPERUSER"),
+		Converts("CREATE USER name WITH CREATEDB NOSUPERUSER"),
+		Converts("CREATE USER name NOCREATEDB NOSUPERUSER"),
+		Converts("CREATE USER name WITH NOCREATEDB NOSUPERUSER"),
+		Converts("CREATE USER name CREATEROLE NOSUPERUSER"),
+		Converts("CREATE USER name WITH CREATEROLE NOSUPERUSER"),
+		Converts("CREATE USER name NOCREATEROLE NOSUPERUSER"),
+		Converts("CREATE USER name WITH NOCREATEROLE NOSUPERUSER"),
+		Converts("CREATE USER name INHERIT NOSUPERUSER"),
+		Converts("CREATE USER name WITH INHERIT NOSUPERUSER"),
+		Converts("CREATE USER name NOINHERIT NOSUPERUSER"),
+		Converts("CREATE USER name WITH NOINHERIT NOSUPERUSER"),
+		Converts("CREATE USER name LOGIN NOSUPERUSER"),
+		Converts("CREATE USER name WITH LOGIN NOSUPERUSER"),
+		Converts("CREATE USER name NOLOGIN NOSUPERUSER"),
+		Converts("CREATE USER name WITH NOLOGIN NOSUPERUSER"),
+		Converts("CREATE USER name REPLICATION NOSUPERUSER"),
+		Converts("CREATE USER name WITH REPLICATION NOSUPERUSER"),
+		Converts("CREATE USER name NOREPLICATION NOSUPERUSER"),
+		Converts("CREATE USER name WITH NOREPLICATION NOSUPERUSER"),
+		Converts("CREATE USER name BYPASSRLS NOSUPERUSER"),
+		Converts("CREATE USER name WITH BYPASSRLS NOSUPERUSER"),
+		Converts("CREATE USER name NOBYPASSRLS NOSUPERUSER"),
+		Converts("CREATE USER name WITH NOBYPASSRLS NOSUPERUSER"),
+		Converts("CREATE USER name CONNECTION LIMIT -1 NOSUPERUSER"),
+		Converts("CREATE USER name WITH CONNECTION LIMIT -1 NOSUPERUSER"),
+		Converts("CREATE USER name PASSWORD ' password ' NOSUPERUSER"),
+		Converts("CREATE USER name WITH PASSWORD ' password ' NOSUPERUSER"),
+		Converts("CREATE USER name ENCRYPTED PASSWORD ' password ' NOSUPERUSER"),
+		Converts("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' NOSUPERUSER"),
+		Converts("CREATE USER name PASSWORD NULL NOSUPERUSER"),
+		Converts("CREATE USER name WITH PASSWORD NULL NOSUPERUSER"),
+		Parses("CREATE USER name VALID UNTIL ' timestamp ' NOSUPERUSER"),
+		Parses("CREATE USER name WITH VALID UNTIL ' timestamp ' NOSUPERUSER"),
 		Unimplemented("CREATE USER name IN ROLE role_name NOSUPERUSER"),
 		Unimplemented("CREATE USER name WITH IN ROLE role_name NOSUPERUSER"),
 		Unimplemented("CREATE USER name IN ROLE role_name , role_name NOSUPERUSER"),
@@ -199,42 +199,42 @@ func TestCreateUser(t *testing.T) {
 		Unimplemented("CREATE USER name WITH USER role_name , role_name NOSUPERUSER"),
 		Unimplemented("CREATE USER name SYSID 1 NOSUPERUSER"),
 		Unimplemented("CREATE USER name WITH SYSID 1 NOSUPERUSER"),
-		Unimplemented("CREATE USER name SUPERUSER CREATEDB"),
-		Unimplemented("CREATE USER name WITH SUPERUSER CREATEDB"),
-		Unimplemented("CREATE USER name NOSUPERUSER CREATEDB"),
-		Unimplemented("CREATE USER name WITH NOSUPERUSER CREATEDB"),
+		Converts("CREATE USER name SUPERUSER CREATEDB"),
+		Converts("CREATE USER name WITH SUPERUSER CREATEDB"),
+		Converts("CREATE USER name NOSUPERUSER CREATEDB"),
+		Converts("CREATE USER name WITH NOSUPERUSER CREATEDB"),
 		Parses("CREATE USER name CREATEDB CREATEDB"),
 		Parses("CREATE USER name WITH CREATEDB CREATEDB"),
 		Parses("CREATE USER name NOCREATEDB CREATEDB"),
 		Parses("CREATE USER name WITH NOCREATEDB CREATEDB"),
-		Parses("CREATE USER name CREATEROLE CREATEDB"),
-		Parses("CREATE USER name WITH CREATEROLE CREATEDB"),
-		Parses("CREATE USER name NOCREATEROLE CREATEDB"),
-		Parses("CREATE USER name WITH NOCREATEROLE CREATEDB"),
-		Unimplemented("CREATE USER name INHERIT CREATEDB"),
-		Unimplemented("CREATE USER name WITH INHERIT CREATEDB"),
-		Unimplemented("CREATE USER name NOINHERIT CREATEDB"),
-		Unimplemented("CREATE USER name WITH NOINHERIT CREATEDB"),
-		Parses("CREATE USER name LOGIN CREATEDB"),
-		Parses("CREATE USER name WITH LOGIN CREATEDB"),
-		Parses("CREATE USER name NOLOGIN CREATEDB"),
-		Parses("CREATE USER name WITH NOLOGIN CREATEDB"),
-		Unimplemented("CREATE USER name REPLICATION CREATEDB"),
-		Unimplemented("CREATE USER name WITH REPLICATION CREATEDB"),
-		Unimplemented("CREATE USER name NOREPLICATION CREATEDB"),
-		Unimplemented("CREATE USER name WITH NOREPLICATION CREATEDB"),
-		Unimplemented("CREATE USER name BYPASSRLS CREATEDB"),
-		Unimplemented("CREATE USER name WITH BYPASSRLS CREATEDB"),
-		Unimplemented("CREATE USER name NOBYPASSRLS CREATEDB"),
-		Unimplemented("CREATE USER name WITH NOBYPASSRLS CREATEDB"),
-		Unimplemented("CREATE USER name CONNECTION LIMIT -1 CREATEDB"),
-		Unimplemented("CREATE USER name WITH CONNECTION LIMIT -1 CREATEDB"),
-		Parses("CREATE USER name PASSWORD ' password ' CREATEDB"),
-		Parses("CREATE USER name WITH PASSWORD ' password ' CREATEDB"),
-		Unimplemented("CREATE USER name ENCRYPTED PASSWORD ' password ' CREATEDB"),
-		Unimplemented("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' CREATEDB"),
-		Parses("CREATE USER name PASSWORD NULL CREATEDB"),
-		Parses("CREATE USER name WITH PASSWORD NULL CREATEDB"),
+		Converts("CREATE USER name CREATEROLE CREATEDB"),
+		Converts("CREATE USER name WITH CREATEROLE CREATEDB"),
+		Converts("CREATE USER name NOCREATEROLE CREATEDB"),
+		Converts("CREATE USER name WITH NOCREATEROLE CREATEDB"),
+		Converts("CREATE USER name INHERIT CREATEDB"),
+		Converts("CREATE USER name WITH INHERIT CREATEDB"),
+		Converts("CREATE USER name NOINHERIT CREATEDB"),
+		Converts("CREATE USER name WITH NOINHERIT CREATEDB"),
+		Converts("CREATE USER name LOGIN CREATEDB"),
+		Converts("CREATE USER name WITH LOGIN CREATEDB"),
+		Converts("CREATE USER name NOLOGIN CREATEDB"),
+		Converts("CREATE USER name WITH NOLOGIN CREATEDB"),
+		Converts("CREATE USER name REPLICATION CREATEDB"),
+		Converts("CREATE USER name WITH REPLICATION CREATEDB"),
+		Converts("CREATE USER name NOREPLICATION CREATEDB"),
+		Converts("CREATE USER name WITH NOREPLICATION CREATEDB"),
+		Converts("CREATE USER name BYPASSRLS CREATEDB"),
+		Converts("CREATE USER name WITH BYPASSRLS CREATEDB"),
+		Converts("CREATE USER name NOBYPASSRLS CREATEDB"),
+		Converts("CREATE USER name WITH NOBYPASSRLS CREATEDB"),
+		Converts("CREATE USER name CONNECTION LIMIT -1 CREATEDB"),
+		Converts("CREATE USER name WITH CONNECTION LIMIT -1 CREATEDB"),
+		Converts("CREATE USER name PASSWORD ' password ' CREATEDB"),
+		Converts("CREATE USER name WITH PASSWORD ' password ' CREATEDB"),
+		Converts("CREATE USER name ENCRYPTED PASSWORD ' password ' CREATEDB"),
+		Converts("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' CREATEDB"),
+		Converts("CREATE USER name PASSWORD NULL CREATEDB"),
+		Converts("CREATE USER name WITH PASSWORD NULL CREATEDB"),
 		Parses("CREATE USER name VALID UNTIL ' timestamp ' CREATEDB"),
 		Parses("CREATE USER name WITH VALID UNTIL ' timestamp ' CREATEDB"),
 		Unimplemented("CREATE USER name IN ROLE role_name CREATEDB"),
@@ -259,42 +259,42 @@ func TestCreateUser(t *testing.T) {
 		Unimplemented("CREATE USER name WITH USER role_name , role_name CREATEDB"),
 		Unimplemented("CREATE USER name SYSID 1 CREATEDB"),
 		Unimplemented("CREATE USER name WITH SYSID 1 CREATEDB"),
-		Unimplemented("CREATE USER name SUPERUSER NOCREATEDB"),
-		Unimplemented("CREATE USER name WITH SUPERUSER NOCREATEDB"),
-		Unimplemented("CREATE USER name NOSUPERUSER NOCREATEDB"),
-		Unimplemented("CREATE USER name WITH NOSUPERUSER NOCREATEDB"),
+		Converts("CREATE USER name SUPERUSER NOCREATEDB"),
+		Converts("CREATE USER name WITH SUPERUSER NOCREATEDB"),
+		Converts("CREATE USER name NOSUPERUSER NOCREATEDB"),
+		Converts("CREATE USER name WITH NOSUPERUSER NOCREATEDB"),
 		Parses("CREATE USER name CREATEDB NOCREATEDB"),
 		Parses("CREATE USER name WITH CREATEDB NOCREATEDB"),
 		Parses("CREATE USER name NOCREATEDB NOCREATEDB"),
 		Parses("CREATE USER name WITH NOCREATEDB NOCREATEDB"),
-		Parses("CREATE USER name CREATEROLE NOCREATEDB"),
-		Parses("CREATE USER name WITH CREATEROLE NOCREATEDB"),
-		Parses("CREATE USER name NOCREATEROLE NOCREATEDB"),
-		Parses("CREATE USER name WITH NOCREATEROLE NOCREATEDB"),
-		Unimplemented("CREATE USER name INHERIT NOCREATEDB"),
-		Unimplemented("CREATE USER name WITH INHERIT NOCREATEDB"),
-		Unimplemented("CREATE USER name NOINHERIT NOCREATEDB"),
-		Unimplemented("CREATE USER name WITH NOINHERIT NOCREATEDB"),
-		Parses("CREATE USER name LOGIN NOCREATEDB"),
-		Parses("CREATE USER name WITH LOGIN NOCREATEDB"),
-		Parses("CREATE USER name NOLOGIN NOCREATEDB"),
-		Parses("CREATE USER name WITH NOLOGIN NOCREATEDB"),
-		Unimplemented("CREATE USER name REPLICATION NOCREATEDB"),
-		Unimplemented("CREATE USER name WITH REPLICATION NOCREATEDB"),
-		Unimplemented("CREATE USER name NOREPLICATION NOCREATEDB"),
-		Unimplemented("CREATE USER name WITH NOREPLICATION NOCREATEDB"),
-		Unimplemented("CREATE USER name BYPASSRLS NOCREATEDB"),
-		Unimplemented("CREATE USER name WITH BYPASSRLS NOCREATEDB"),
-		Unimplemented("CREATE USER name NOBYPASSRLS NOCREATEDB"),
-		Unimplemented("CREATE USER name WITH NOBYPASSRLS NOCREATEDB"),
-		Unimplemented("CREATE USER name CONNECTION LIMIT -1 NOCREATEDB"),
-		Unimplemented("CREATE USER name WITH CONNECTION LIMIT -1 NOCREATEDB"),
-		Parses("CREATE USER name PASSWORD ' password ' NOCREATEDB"),
-		Parses("CREATE USER name WITH PASSWORD ' password ' NOCREATEDB"),
-		Unimplemented("CREATE USER name ENCRYPTED PASSWORD ' password ' NOCREATEDB"),
-		Unimplemented("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' NOCREATEDB"),
-		Parses("CREATE USER name PASSWORD NULL NOCREATEDB"),
-		Parses("CREATE USER name WITH PASSWORD NULL NOCREATEDB"),
+		Converts("CREATE USER name CREATEROLE NOCREATEDB"),
+		Converts("CREATE USER name WITH CREATEROLE NOCREATEDB"),
+		Converts("CREATE USER name NOCREATEROLE NOCREATEDB"),
+		Converts("CREATE USER name WITH NOCREATEROLE NOCREATEDB"),
+		Converts("CREATE USER name INHERIT NOCREATEDB"),
+		Converts("CREATE USER name WITH INHERIT NOCREATEDB"),
+		Converts("CREATE USER name NOINHERIT NOCREATEDB"),
+		Converts("CREATE USER name WITH NOINHERIT NOCREATEDB"),
+		Converts("CREATE USER name LOGIN NOCREATEDB"),
+		Converts("CREATE USER name WITH LOGIN NOCREATEDB"),
+		Converts("CREATE USER name NOLOGIN NOCREATEDB"),
+		Converts("CREATE USER name WITH NOLOGIN NOCREATEDB"),
+		Converts("CREATE USER name REPLICATION NOCREATEDB"),
+		Converts("CREATE USER name WITH REPLICATION NOCREATEDB"),
+		Converts("CREATE USER name NOREPLICATION NOCREATEDB"),
+		Converts("CREATE USER name WITH NOREPLICATION NOCREATEDB"),
+		Converts("CREATE USER name BYPASSRLS NOCREATEDB"),
+		Converts("CREATE USER name WITH BYPASSRLS NOCREATEDB"),
+		Converts("CREATE USER name NOBYPASSRLS NOCREATEDB"),
+		Converts("CREATE USER name WITH NOBYPASSRLS NOCREATEDB"),
+		Converts("CREATE USER name CONNECTION LIMIT -1 NOCREATEDB"),
+		Converts("CREATE USER name WITH CONNECTION LIMIT -1 NOCREATEDB"),
+		Converts("CREATE USER name PASSWORD ' password ' NOCREATEDB"),
+		Converts("CREATE USER name WITH PASSWORD ' password ' NOCREATEDB"),
+		Converts("CREATE USER name ENCRYPTED PASSWORD ' password ' NOCREATEDB"),
+		Converts("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' NOCREATEDB"),
+		Converts("CREATE USER name PASSWORD NULL NOCREATEDB"),
+		Converts("CREATE USER name WITH PASSWORD NULL NOCREATEDB"),
 		Parses("CREATE USER name VALID UNTIL ' timestamp ' NOCREATEDB"),
 		Parses("CREATE USER name WITH VALID UNTIL ' timestamp ' NOCREATEDB"),
 		Unimplemented("CREATE USER name IN ROLE role_name NOCREATEDB"),
@@ -319,42 +319,42 @@ func TestCreateUser(t *testing.T) {
 		Unimplemented("CREATE USER name WITH USER role_name , role_name NOCREATEDB"),
 		Unimplemented("CREATE USER name SYSID 1 NOCREATEDB"),
 		Unimplemented("CREATE USER name WITH SYSID 1 NOCREATEDB"),
-		Unimplemented("CREATE USER name SUPERUSER CREATEROLE"),
-		Unimplemented("CREATE USER name WITH SUPERUSER CREATEROLE"),
-		Unimplemented("CREATE USER name NOSUPERUSER CREATEROLE"),
-		Unimplemented("CREATE USER name WITH NOSUPERUSER CREATEROLE"),
-		Parses("CREATE USER name CREATEDB CREATEROLE"),
-		Parses("CREATE USER name WITH CREATEDB CREATEROLE"),
-		Parses("CREATE USER name NOCREATEDB CREATEROLE"),
-		Parses("CREATE USER name WITH NOCREATEDB CREATEROLE"),
+		Converts("CREATE USER name SUPERUSER CREATEROLE"),
+		Converts("CREATE USER name WITH SUPERUSER CREATEROLE"),
+		Converts("CREATE USER name NOSUPERUSER CREATEROLE"),
+		Converts("CREATE USER name WITH NOSUPERUSER CREATEROLE"),
+		Converts("CREATE USER name CREATEDB CREATEROLE"),
+		Converts("CREATE USER name WITH CREATEDB CREATEROLE"),
+		Converts("CREATE USER name NOCREATEDB CREATEROLE"),
+		Converts("CREATE USER name WITH NOCREATEDB CREATEROLE"),
 		Parses("CREATE USER name CREATEROLE CREATEROLE"),
 		Parses("CREATE USER name WITH CREATEROLE CREATEROLE"),
 		Parses("CREATE USER name NOCREATEROLE CREATEROLE"),
 		Parses("CREATE USER name WITH NOCREATEROLE CREATEROLE"),
-		Unimplemented("CREATE USER name INHERIT CREATEROLE"),
-		Unimplemented("CREATE USER name WITH INHERIT CREATEROLE"),
-		Unimplemented("CREATE USER name NOINHERIT CREATEROLE"),
-		Unimplemented("CREATE USER name WITH NOINHERIT CREATEROLE"),
-		Parses("CREATE USER name LOGIN CREATEROLE"),
-		Parses("CREATE USER name WITH LOGIN CREATEROLE"),
-		Parses("CREATE USER name NOLOGIN CREATEROLE"),
-		Parses("CREATE USER name WITH NOLOGIN CREATEROLE"),
-		Unimplemented("CREATE USER name REPLICATION CREATEROLE"),
-		Unimplemented("CREATE USER name WITH REPLICATION CREATEROLE"),
-		Unimplemented("CREATE USER name NOREPLICATION CREATEROLE"),
-		Unimplemented("CREATE USER name WITH NOREPLICATION CREATEROLE"),
-		Unimplemented("CREATE USER name BYPASSRLS CREATEROLE"),
-		Unimplemented("CREATE USER name WITH BYPASSRLS CREATEROLE"),
-		Unimplemented("CREATE USER name NOBYPASSRLS CREATEROLE"),
-		Unimplemented("CREATE USER name WITH NOBYPASSRLS CREATEROLE"),
-		Unimplemented("CREATE USER name CONNECTION LIMIT -1 CREATEROLE"),
-		Unimplemented("CREATE USER name WITH CONNECTION LIMIT -1 CREATEROLE"),
-		Parses("CREATE USER name PASSWORD ' password ' CREATEROLE"),
-		Parses("CREATE USER name WITH PASSWORD ' password ' CREATEROLE"),
-		Unimplemented("CREATE USER name ENCRYPTED PASSWORD ' password ' CREATEROLE"),
-		Unimplemented("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' CREATEROLE"),
-		Parses("CREATE USER name PASSWORD NULL CREATEROLE"),
-		Parses("CREATE USER name WITH PASSWORD NULL CREATEROLE"),
+		Converts("CREATE USER name INHERIT CREATEROLE"),
+		Converts("CREATE USER name WITH INHERIT CREATEROLE"),
+		Converts("CREATE USER name NOINHERIT CREATEROLE"),
+		Converts("CREATE USER name WITH NOINHERIT CREATEROLE"),
+		Converts("CREATE USER name LOGIN CREATEROLE"),
+		Converts("CREATE USER name WITH LOGIN CREATEROLE"),
+		Converts("CREATE USER name NOLOGIN CREATEROLE"),
+		Converts("CREATE USER name WITH NOLOGIN CREATEROLE"),
+		Converts("CREATE USER name REPLICATION CREATEROLE"),
+		Converts("CREATE USER name WITH REPLICATION CREATEROLE"),
+		Converts("CREATE USER name NOREPLICATION CREATEROLE"),
+		Converts("CREATE USER name WITH NOREPLICATION CREATEROLE"),
+		Converts("CREATE USER name BYPASSRLS CREATEROLE"),
+		Converts("CREATE USER name WITH BYPASSRLS CREATEROLE"),
+		Converts("CREATE USER name NOBYPASSRLS CREATEROLE"),
+		Converts("CREATE USER name WITH NOBYPASSRLS CREATEROLE"),
+		Converts("CREATE USER name CONNECTION LIMIT -1 CREATEROLE"),
+		Converts("CREATE USER name WITH CONNECTION LIMIT -1 CREATEROLE"),
+		Converts("CREATE USER name PASSWORD ' password ' CREATEROLE"),
+		Converts("CREATE USER name WITH PASSWORD ' password ' CREATEROLE"),
+		Converts("CREATE USER name ENCRYPTED PASSWORD ' password ' CREATEROLE"),
+		Converts("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' CREATEROLE"),
+		Converts("CREATE USER name PASSWORD NULL CREATEROLE"),
+		Converts("CREATE USER name WITH PASSWORD NULL CREATEROLE"),
 		Parses("CREATE USER name VALID UNTIL ' timestamp ' CREATEROLE"),
 		Parses("CREATE USER name WITH VALID UNTIL ' timestamp ' CREATEROLE"),
 		Unimplemented("CREATE USER name IN ROLE role_name CREATEROLE"),
@@ -379,42 +379,42 @@ func TestCreateUser(t *testing.T) {
 		Unimplemented("CREATE USER name WITH USER role_name , role_name CREATEROLE"),
 		Unimplemented("CREATE USER name SYSID 1 CREATEROLE"),
 		Unimplemented("CREATE USER name WITH SYSID 1 CREATEROLE"),
-		Unimplemented("CREATE USER name SUPERUSER NOCREATEROLE"),
-		Unimplemented("CREATE USER name WITH SUPERUSER NOCREATEROLE"),
-		Unimplemented("CREATE USER name NOSUPERUSER NOCREATEROLE"),
-		Unimplemented("CREATE USER name WITH NOSUPERUSER NOCREATEROLE"),
-		Parses("CREATE USER name CREATEDB NOCREATEROLE"),
-		Parses("CREATE USER name WITH CREATEDB NOCREATEROLE"),
-		Parses("CREATE USER name NOCREATEDB NOCREATEROLE"),
-		Parses("CREATE USER name WITH NOCREATEDB NOCREATEROLE"),
+		Converts("CREATE USER name SUPERUSER NOCREATEROLE"),
+		Converts("CREATE USER name WITH SUPERUSER NOCREATEROLE"),
+		Converts("CREATE USER name NOSUPERUSER NOCREATEROLE"),
+		Converts("CREATE USER name WITH NOSUPERUSER NOCREATEROLE"),
+		Converts("CREATE USER name CREATEDB NOCREATEROLE"),
+		Converts("CREATE USER name WITH CREATEDB NOCREATEROLE"),
+		Converts("CREATE USER name NOCREATEDB NOCREATEROLE"),
+		Converts("CREATE USER name WITH NOCREATEDB NOCREATEROLE"),
 		Parses("CREATE USER name CREATEROLE NOCREATEROLE"),
 		Parses("CREATE USER name WITH CREATEROLE NOCREATEROLE"),
 		Parses("CREATE USER name NOCREATEROLE NOCREATEROLE"),
 		Parses("CREATE USER name WITH NOCREATEROLE NOCREATEROLE"),
-		Unimplemented("CREATE USER name INHERIT NOCREATEROLE"),
-		Unimplemented("CREATE USER name WITH INHERIT NOCREATEROLE"),
-		Unimplemented("CREATE USER name NOINHERIT NOCREATEROLE"),
-		Unimplemented("CREATE USER name WITH NOINHERIT NOCREATEROLE"),
-		Parses("CREATE USER name LOGIN NOCREATEROLE"),
-		Parses("CREATE USER name WITH LOGIN NOCREATEROLE"),
-		Parses("CREATE USER name NOLOGIN NOCREATEROLE"),
-		Parses("CREATE USER name WITH NOLOGIN NOCREATEROLE"),
-		Unimplemented("CREATE USER name REPLICATION NOCREATEROLE"),
-		Unimplemented("CREATE USER name WITH REPLICATION NOCREATEROLE"),
-		Unimplemented("CREATE USER name NOREPLICATION NOCREATEROLE"),
-		Unimplemented("CREATE USER name WITH NOREPLICATION NOCREATEROLE"),
-		Unimplemented("CREATE USER name BYPASSRLS NOCREATEROLE"),
-		Unimplemented("CREATE USER name WITH BYPASSRLS NOCREATEROLE"),
-		Unimplemented("CREATE USER name NOBYPASSRLS NOCREATEROLE"),
-		Unimplemented("CREATE USER name WITH NOBYPASSRLS NOCREATEROLE"),
-		Unimplemented("CREATE USER name CONNECTION LIMIT -1 NOCREATEROLE"),
-		Unimplemented("CREATE USER name WITH CONNECTION LIMIT -1 NOCREATEROLE"),
-		Parses("CREATE USER name PASSWORD ' password ' NOCREATEROLE"),
-		Parses("CREATE USER name WITH PASSWORD ' password ' NOCREATEROLE"),
-		Unimplemented("CREATE USER name ENCRYPTED PASSWORD ' password ' NOCREATEROLE"),
-		Unimplemented("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' NOCREATEROLE"),
-		Parses("CREATE USER name PASSWORD NULL NOCREATEROLE"),
-		Parses("CREATE USER name WITH PASSWORD NULL NOCREATEROLE"),
+		Converts("CREATE USER name INHERIT NOCREATEROLE"),
+		Converts("CREATE USER name WITH INHERIT NOCREATEROLE"),
+		Converts("CREATE USER name NOINHERIT NOCREATEROLE"),
+		Converts("CREATE USER name WITH NOINHERIT NOCREATEROLE"),
+		Converts("CREATE USER name LOGIN NOCREATEROLE"),
+		Converts("CREATE USER name WITH LOGIN NOCREATEROLE"),
+		Converts("CREATE USER name NOLOGIN NOCREATEROLE"),
+		Converts("CREATE USER name WITH NOLOGIN NOCREATEROLE"),
+		Converts("CREATE USER name REPLICATION NOCREATEROLE"),
+		Converts("CREATE USER name WITH REPLICATION NOCREATEROLE"),
+		Converts("CREATE USER name NOREPLICATION NOCREATEROLE"),
+		Converts("CREATE USER name WITH NOREPLICATION NOCREATEROLE"),
+		Converts("CREATE USER name BYPASSRLS NOCREATEROLE"),
+		Converts("CREATE USER name WITH BYPASSRLS NOCREATEROLE"),
+		Converts("CREATE USER name NOBYPASSRLS NOCREATEROLE"),
+		Converts("CREATE USER name WITH NOBYPASSRLS NOCREATEROLE"),
+		Converts("CREATE USER name CONNECTION LIMIT -1 NOCREATEROLE"),
+		Converts("CREATE USER name WITH CONNECTION LIMIT -1 NOCREATEROLE"),
+		Converts("CREATE USER name PASSWORD ' password ' NOCREATEROLE"),
+		Converts("CREATE USER name WITH PASSWORD ' password ' NOCREATEROLE"),
+		Converts("CREATE USER name ENCRYPTED PASSWORD ' password ' NOCREATEROLE"),
+		Converts("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' NOCREATEROLE"),
+		Converts("CREATE USER name PASSWORD NULL NOCREATEROLE"),
+		Converts("CREATE USER name WITH PASSWORD NULL NOCREATEROLE"),
 		Parses("CREATE USER name VALID UNTIL ' timestamp ' NOCREATEROLE"),
 		Parses("CREATE USER name WITH VALID UNTIL ' timestamp ' NOCREATEROLE"),
 		Unimplemented("CREATE USER name IN ROLE role_name NOCREATEROLE"),
@@ -439,44 +439,44 @@ func TestCreateUser(t *testing.T) {
 		Unimplemented("CREATE USER name WITH USER role_name , role_name NOCREATEROLE"),
 		Unimplemented("CREATE USER name SYSID 1 NOCREATEROLE"),
 		Unimplemented("CREATE USER name WITH SYSID 1 NOCREATEROLE"),
-		Unimplemented("CREATE USER name SUPERUSER INHERIT"),
-		Unimplemented("CREATE USER name WITH SUPERUSER INHERIT"),
-		Unimplemented("CREATE USER name NOSUPERUSER INHERIT"),
-		Unimplemented("CREATE USER name WITH NOSUPERUSER INHERIT"),
-		Unimplemented("CREATE USER name CREATEDB INHERIT"),
-		Unimplemented("CREATE USER name WITH CREATEDB INHERIT"),
-		Unimplemented("CREATE USER name NOCREATEDB INHERIT"),
-		Unimplemented("CREATE USER name WITH NOCREATEDB INHERIT"),
-		Unimplemented("CREATE USER name CREATEROLE INHERIT"),
-		Unimplemented("CREATE USER name WITH CREATEROLE INHERIT"),
-		Unimplemented("CREATE USER name NOCREATEROLE INHERIT"),
-		Unimplemented("CREATE USER name WITH NOCREATEROLE INHERIT"),
-		Unimplemented("CREATE USER name INHERIT INHERIT"),
-		Unimplemented("CREATE USER name WITH INHERIT INHERIT"),
-		Unimplemented("CREATE USER name NOINHERIT INHERIT"),
-		Unimplemented("CREATE USER name WITH NOINHERIT INHERIT"),
-		Unimplemented("CREATE USER name LOGIN INHERIT"),
-		Unimplemented("CREATE USER name WITH LOGIN INHERIT"),
-		Unimplemented("CREATE USER name NOLOGIN INHERIT"),
-		Unimplemented("CREATE USER name WITH NOLOGIN INHERIT"),
-		Unimplemented("CREATE USER name REPLICATION INHERIT"),
-		Unimplemented("CREATE USER name WITH REPLICATION INHERIT"),
-		Unimplemented("CREATE USER name NOREPLICATION INHERIT"),
-		Unimplemented("CREATE USER name WITH NOREPLICATION INHERIT"),
-		Unimplemented("CREATE USER name BYPASSRLS INHERIT"),
-		Unimplemented("CREATE USER name WITH BYPASSRLS INHERIT"),
-		Unimplemented("CREATE USER name NOBYPASSRLS INHERIT"),
-		Unimplemented("CREATE USER name WITH NOBYPASSRLS INHERIT"),
-		Unimplemented("CREATE USER name CONNECTION LIMIT -1 INHERIT"),
-		Unimplemented("CREATE USER name WITH CONNECTION LIMIT -1 INHERIT"),
-		Unimplemented("CREATE USER name PASSWORD ' password ' INHERIT"),
-		Unimplemented("CREATE USER name WITH PASSWORD ' password ' INHERIT"),
-		Unimplemented("CREATE USER name ENCRYPTED PASSWORD ' password ' INHERIT"),
-		Unimplemented("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' INHERIT"),
-		Unimplemented("CREATE USER name PASSWORD NULL INHERIT"),
-		Unimplemented("CREATE USER name WITH PASSWORD NULL INHERIT"),
-		Unimplemented("CREATE USER name VALID UNTIL ' timestamp ' INHERIT"),
-		Unimplemented("CREATE USER name WITH VALID UNTIL ' timestamp ' INHERIT"),
+		Converts("CREATE USER name SUPERUSER INHERIT"),
+		Converts("CREATE USER name WITH SUPERUSER INHERIT"),
+		Converts("CREATE USER name NOSUPERUSER INHERIT"),
+		Converts("CREATE USER name WITH NOSUPERUSER INHERIT"),
+		Converts("CREATE USER name CREATEDB INHERIT"),
+		Converts("CREATE USER name WITH CREATEDB INHERIT"),
+		Converts("CREATE USER name NOCREATEDB INHERIT"),
+		Converts("CREATE USER name WITH NOCREATEDB INHERIT"),
+		Converts("CREATE USER name CREATEROLE INHERIT"),
+		Converts("CREATE USER name WITH CREATEROLE INHERIT"),
+		Converts("CREATE USER name NOCREATEROLE INHERIT"),
+		Converts("CREATE USER name WITH NOCREATEROLE INHERIT"),
+		Parses("CREATE USER name INHERIT INHERIT"),
+		Parses("CREATE USER name WITH INHERIT INHERIT"),
+		Parses("CREATE USER name NOINHERIT INHERIT"),
+		Parses("CREATE USER name WITH NOINHERIT INHERIT"),
+		Converts("CREATE USER name LOGIN INHERIT"),
+		Converts("CREATE USER name WITH LOGIN INHERIT"),
+		Converts("CREATE USER name NOLOGIN INHERIT"),
+		Converts("CREATE USER name WITH NOLOGIN INHERIT"),
+		Converts("CREATE USER name REPLICATION INHERIT"),
+		Converts("CREATE USER name WITH REPLICATION INHERIT"),
+		Converts("CREATE USER name NOREPLICATION INHERIT"),
+		Converts("CREATE USER name WITH NOREPLICATION INHERIT"),
+		Converts("CREATE USER name BYPASSRLS INHERIT"),
+		Converts("CREATE USER name WITH BYPASSRLS INHERIT"),
+		Converts("CREATE USER name NOBYPASSRLS INHERIT"),
+		Converts("CREATE USER name WITH NOBYPASSRLS INHERIT"),
+		Converts("CREATE USER name CONNECTION LIMIT -1 INHERIT"),
+		Converts("CREATE USER name WITH CONNECTION LIMIT -1 INHERIT"),
+		Converts("CREATE USER name PASSWORD ' password ' INHERIT"),
+		Converts("CREATE USER name WITH PASSWORD ' password ' INHERIT"),
+		Converts("CREATE USER name ENCRYPTED PASSWORD ' password ' INHERIT"),
+		Converts("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' INHERIT"),
+		Converts("CREATE USER name PASSWORD NULL INHERIT"),
+		Converts("CREATE USER name WITH PASSWORD NULL INHERIT"),
+		Parses("CREATE USER name VALID UNTIL ' timestamp ' INHERIT"),
+		Parses("CREATE USER name WITH VALID UNTIL ' timestamp ' INHERIT"),
 		Unimplemented("CREATE USER name IN ROLE role_name INHERIT"),
 		Unimplemented("CREATE USER name WITH IN ROLE role_name INHERIT"),
 		Unimplemented("CREATE USER name IN ROLE role_name , role_name INHERIT"),
@@ -499,44 +499,44 @@ func TestCreateUser(t *testing.T) {
 		Unimplemented("CREATE USER name WITH USER role_name , role_name INHERIT"),
 		Unimplemented("CREATE USER name SYSID 1 INHERIT"),
 		Unimplemented("CREATE USER name WITH SYSID 1 INHERIT"),
-		Unimplemented("CREATE USER name SUPERUSER NOINHERIT"),
-		Unimplemented("CREATE USER name WITH SUPERUSER NOINHERIT"),
-		Unimplemented("CREATE USER name NOSUPERUSER NOINHERIT"),
-		Unimplemented("CREATE USER name WITH NOSUPERUSER NOINHERIT"),
-		Unimplemented("CREATE USER name CREATEDB NOINHERIT"),
-		Unimplemented("CREATE USER name WITH CREATEDB NOINHERIT"),
-		Unimplemented("CREATE USER name NOCREATEDB NOINHERIT"),
-		Unimplemented("CREATE USER name WITH NOCREATEDB NOINHERIT"),
-		Unimplemented("CREATE USER name CREATEROLE NOINHERIT"),
-		Unimplemented("CREATE USER name WITH CREATEROLE NOINHERIT"),
-		Unimplemented("CREATE USER name NOCREATEROLE NOINHERIT"),
-		Unimplemented("CREATE USER name WITH NOCREATEROLE NOINHERIT"),
-		Unimplemented("CREATE USER name INHERIT NOINHERIT"),
-		Unimplemented("CREATE USER name WITH INHERIT NOINHERIT"),
-		Unimplemented("CREATE USER name NOINHERIT NOINHERIT"),
-		Unimplemented("CREATE USER name WITH NOINHERIT NOINHERIT"),
-		Unimplemented("CREATE USER name LOGIN NOINHERIT"),
-		Unimplemented("CREATE USER name WITH LOGIN NOINHERIT"),
-		Unimplemented("CREATE USER name NOLOGIN NOINHERIT"),
-		Unimplemented("CREATE USER name WITH NOLOGIN NOINHERIT"),
-		Unimplemented("CREATE USER name REPLICATION NOINHERIT"),
-		Unimplemented("CREATE USER name WITH REPLICATION NOINHERIT"),
-		Unimplemented("CREATE USER name NOREPLICATION NOINHERIT"),
-		Unimplemented("CREATE USER name WITH NOREPLICATION NOINHERIT"),
-		Unimplemented("CREATE USER name BYPASSRLS NOINHERIT"),
-		Unimplemented("CREATE USER name WITH BYPASSRLS NOINHERIT"),
-		Unimplemented("CREATE USER name NOBYPASSRLS NOINHERIT"),
-		Unimplemented("CREATE USER name WITH NOBYPASSRLS NOINHERIT"),
-		Unimplemented("CREATE USER name CONNECTION LIMIT -1 NOINHERIT"),
-		Unimplemented("CREATE USER name WITH CONNECTION LIMIT -1 NOINHERIT"),
-		Unimplemented("CREATE USER name PASSWORD ' password ' NOINHERIT"),
-		Unimplemented("CREATE USER name WITH PASSWORD ' password ' NOINHERIT"),
-		Unimplemented("CREATE USER name ENCRYPTED PASSWORD ' password ' NOINHERIT"),
-		Unimplemented("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' NOINHERIT"),
-		Unimplemented("CREATE USER name PASSWORD NULL NOINHERIT"),
-		Unimplemented("CREATE USER name WITH PASSWORD NULL NOINHERIT"),
-		Unimplemented("CREATE USER name VALID UNTIL ' timestamp ' NOINHERIT"),
-		Unimplemented("CREATE USER name WITH VALID UNTIL ' timestamp ' NOINHERIT"),
+		Converts("CREATE USER name SUPERUSER NOINHERIT"),
+		Converts("CREATE USER name WITH SUPERUSER NOINHERIT"),
+		Converts("CREATE USER name NOSUPERUSER NOINHERIT"),
+		Converts("CREATE USER name WITH NOSUPERUSER NOINHERIT"),
+		Converts("CREATE USER name CREATEDB NOINHERIT"),
+		Converts("CREATE USER name WITH CREATEDB NOINHERIT"),
+		Converts("CREATE USER name NOCREATEDB NOINHERIT"),
+		Converts("CREATE USER name WITH NOCREATEDB NOINHERIT"),
+		Converts("CREATE USER name CREATEROLE NOINHERIT"),
+		Converts("CREATE USER name WITH CREATEROLE NOINHERIT"),
+		Converts("CREATE USER name NOCREATEROLE NOINHERIT"),
+		Converts("CREATE USER name WITH NOCREATEROLE NOINHERIT"),
+		Parses("CREATE USER name INHERIT NOINHERIT"),
+		Parses("CREATE USER name WITH INHERIT NOINHERIT"),
+		Parses("CREATE USER name NOINHERIT NOINHERIT"),
+		Parses("CREATE USER name WITH NOINHERIT NOINHERIT"),
+		Converts("CREATE USER name LOGIN NOINHERIT"),
+		Converts("CREATE USER name WITH LOGIN NOINHERIT"),
+		Converts("CREATE USER name NOLOGIN NOINHERIT"),
+		Converts("CREATE USER name WITH NOLOGIN NOINHERIT"),
+		Converts("CREATE USER name REPLICATION NOINHERIT"),
+		Converts("CREATE USER name WITH REPLICATION NOINHERIT"),
+		Converts("CREATE USER name NOREPLICATION NOINHERIT"),
+		Converts("CREATE USER name WITH NOREPLICATION NOINHERIT"),
+		Converts("CREATE USER name BYPASSRLS NOINHERIT"),
+		Converts("CREATE USER name WITH BYPASSRLS NOINHERIT"),
+		Converts("CREATE USER name NOBYPASSRLS NOINHERIT"),
+		Converts("CREATE USER name WITH NOBYPASSRLS NOINHERIT"),
+		Converts("CREATE USER name CONNECTION LIMIT -1 NOINHERIT"),
+		Converts("CREATE USER name WITH CONNECTION LIMIT -1 NOINHERIT"),
+		Converts("CREATE USER name PASSWORD ' password ' NOINHERIT"),
+		Converts("CREATE USER name WITH PASSWORD ' password ' NOINHERIT"),
+		Converts("CREATE USER name ENCRYPTED PASSWORD ' password ' NOINHERIT"),
+		Converts("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' NOINHERIT"),
+		Converts("CREATE USER name PASSWORD NULL NOINHERIT"),
+		Converts("CREATE USER name WITH PASSWORD NULL NOINHERIT"),
+		Parses("CREATE USER name VALID UNTIL ' timestamp ' NOINHERIT"),
+		Parses("CREATE USER name WITH VALID UNTIL ' timestamp ' NOINHERIT"),
 		Unimplemented("CREATE USER name IN ROLE role_name NOINHERIT"),
 		Unimplemented("CREATE USER name WITH IN ROLE role_name NOINHERIT"),
 		Unimplemented("CREATE USER name IN ROLE role_name , role_name NOINHERIT"),
@@ -559,42 +559,42 @@ func TestCreateUser(t *testing.T) {
 		Unimplemented("CREATE USER name WITH USER role_name , role_name NOINHERIT"),
 		Unimplemented("CREATE USER name SYSID 1 NOINHERIT"),
 		Unimplemented("CREATE USER name WITH SYSID 1 NOINHERIT"),
-		Unimplemented("CREATE USER name SUPERUSER LOGIN"),
-		Unimplemented("CREATE USER name WITH SUPERUSER LOGIN"),
-		Unimplemented("CREATE USER name NOSUPERUSER LOGIN"),
-		Unimplemented("CREATE USER name WITH NOSUPERUSER LOGIN"),
-		Parses("CREATE USER name CREATEDB LOGIN"),
-		Parses("CREATE USER name WITH CREATEDB LOGIN"),
-		Parses("CREATE USER name NOCREATEDB LOGIN"),
-		Parses("CREATE USER name WITH NOCREATEDB LOGIN"),
-		Parses("CREATE USER name CREATEROLE LOGIN"),
-		Parses("CREATE USER name WITH CREATEROLE LOGIN"),
-		Parses("CREATE USER name NOCREATEROLE LOGIN"),
-		Parses("CREATE USER name WITH NOCREATEROLE LOGIN"),
-		Unimplemented("CREATE USER name INHERIT LOGIN"),
-		Unimplemented("CREATE USER name WITH INHERIT LOGIN"),
-		Unimplemented("CREATE USER name NOINHERIT LOGIN"),
-		Unimplemented("CREATE USER name WITH NOINHERIT LOGIN"),
+		Converts("CREATE USER name SUPERUSER LOGIN"),
+		Converts("CREATE USER name WITH SUPERUSER LOGIN"),
+		Converts("CREATE USER name NOSUPERUSER LOGIN"),
+		Converts("CREATE USER name WITH NOSUPERUSER LOGIN"),
+		Converts("CREATE USER name CREATEDB LOGIN"),
+		Converts("CREATE USER name WITH CREATEDB LOGIN"),
+		Converts("CREATE USER name NOCREATEDB LOGIN"),
+		Converts("CREATE USER name WITH NOCREATEDB LOGIN"),
+		Converts("CREATE USER name CREATEROLE LOGIN"),
+		Converts("CREATE USER name WITH CREATEROLE LOGIN"),
+		Converts("CREATE USER name NOCREATEROLE LOGIN"),
+		Converts("CREATE USER name WITH NOCREATEROLE LOGIN"),
+		Converts("CREATE USER name INHERIT LOGIN"),
+		Converts("CREATE USER name WITH INHERIT LOGIN"),
+		Converts("CREATE USER name NOINHERIT LOGIN"),
+		Converts("CREATE USER name WITH NOINHERIT LOGIN"),
 		Parses("CREATE USER name LOGIN LOGIN"),
 		Parses("CREATE USER name WITH LOGIN LOGIN"),
 		Parses("CREATE USER name NOLOGIN LOGIN"),
 		Parses("CREATE USER name WITH NOLOGIN LOGIN"),
-		Unimplemented("CREATE USER name REPLICATION LOGIN"),
-		Unimplemented("CREATE USER name WITH REPLICATION LOGIN"),
-		Unimplemented("CREATE USER name NOREPLICATION LOGIN"),
-		Unimplemented("CREATE USER name WITH NOREPLICATION LOGIN"),
-		Unimplemented("CREATE USER name BYPASSRLS LOGIN"),
-		Unimplemented("CREATE USER name WITH BYPASSRLS LOGIN"),
-		Unimplemented("CREATE USER name NOBYPASSRLS LOGIN"),
-		Unimplemented("CREATE USER name WITH NOBYPASSRLS LOGIN"),
-		Unimplemented("CREATE USER name CONNECTION LIMIT -1 LOGIN"),
-		Unimplemented("CREATE USER name WITH CONNECTION LIMIT -1 LOGIN"),
-		Parses("CREATE USER name PASSWORD ' password ' LOGIN"),
-		Parses("CREATE USER name WITH PASSWORD ' password ' LOGIN"),
-		Unimplemented("CREATE USER name ENCRYPTED PASSWORD ' password ' LOGIN"),
-		Unimplemented("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' LOGIN"),
-		Parses("CREATE USER name PASSWORD NULL LOGIN"),
-		Parses("CREATE USER name WITH PASSWORD NULL LOGIN"),
+		Converts("CREATE USER name REPLICATION LOGIN"),
+		Converts("CREATE USER name WITH REPLICATION LOGIN"),
+		Converts("CREATE USER name NOREPLICATION LOGIN"),
+		Converts("CREATE USER name WITH NOREPLICATION LOGIN"),
+		Converts("CREATE USER name BYPASSRLS LOGIN"),
+		Converts("CREATE USER name WITH BYPASSRLS LOGIN"),
+		Converts("CREATE USER name NOBYPASSRLS LOGIN"),
+		Converts("CREATE USER name WITH NOBYPASSRLS LOGIN"),
+		Converts("CREATE USER name CONNECTION LIMIT -1 LOGIN"),
+		Converts("CREATE USER name WITH CONNECTION LIMIT -1 LOGIN"),
+		Converts("CREATE USER name PASSWORD ' password ' LOGIN"),
+		Converts("CREATE USER name WITH PASSWORD ' password ' LOGIN"),
+		Converts("CREATE USER name ENCRYPTED PASSWORD ' password ' LOGIN"),
+		Converts("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' LOGIN"),
+		Converts("CREATE USER name PASSWORD NULL LOGIN"),
+		Converts("CREATE USER name WITH PASSWORD NULL LOGIN"),
 		Parses("CREATE USER name VALID UNTIL ' timestamp ' LOGIN"),
 		Parses("CREATE USER name WITH VALID UNTIL ' timestamp ' LOGIN"),
 		Unimplemented("CREATE USER name IN ROLE role_name LOGIN"),
@@ -619,42 +619,42 @@ func TestCreateUser(t *testing.T) {
 		Unimplemented("CREATE USER name WITH USER role_name , role_name LOGIN"),
 		Unimplemented("CREATE USER name SYSID 1 LOGIN"),
 		Unimplemented("CREATE USER name WITH SYSID 1 LOGIN"),
-		Unimplemented("CREATE USER name SUPERUSER NOLOGIN"),
-		Unimplemented("CREATE USER name WITH SUPERUSER NOLOGIN"),
-		Unimplemented("CREATE USER name NOSUPERUSER NOLOGIN"),
-		Unimplemented("CREATE USER name WITH NOSUPERUSER NOLOGIN"),
-		Parses("CREATE USER name CREATEDB NOLOGIN"),
-		Parses("CREATE USER name WITH CREATEDB NOLOGIN"),
-		Parses("CREATE USER name NOCREATEDB NOLOGIN"),
-		Parses("CREATE USER name WITH NOCREATEDB NOLOGIN"),
-		Parses("CREATE USER name CREATEROLE NOLOGIN"),
-		Parses("CREATE USER name WITH CREATEROLE NOLOGIN"),
-		Parses("CREATE USER name NOCREATEROLE NOLOGIN"),
-		Parses("CREATE USER name WITH NOCREATEROLE NOLOGIN"),
-		Unimplemented("CREATE USER name INHERIT NOLOGIN"),
-		Unimplemented("CREATE USER name WITH INHERIT NOLOGIN"),
-		Unimplemented("CREATE USER name NOINHERIT NOLOGIN"),
-		Unimplemented("CREATE USER name WITH NOINHERIT NOLOGIN"),
+		Converts("CREATE USER name SUPERUSER NOLOGIN"),
+		Converts("CREATE USER name WITH SUPERUSER NOLOGIN"),
+		Converts("CREATE USER name NOSUPERUSER NOLOGIN"),
+		Converts("CREATE USER name WITH NOSUPERUSER NOLOGIN"),
+		Converts("CREATE USER name CREATEDB NOLOGIN"),
+		Converts("CREATE USER name WITH CREATEDB NOLOGIN"),
+		Converts("CREATE USER name NOCREATEDB NOLOGIN"),
+		Converts("CREATE USER name WITH NOCREATEDB NOLOGIN"),
+		Converts("CREATE USER name CREATEROLE NOLOGIN"),
+		Converts("CREATE USER name WITH CREATEROLE NOLOGIN"),
+		Converts("CREATE USER name NOCREATEROLE NOLOGIN"),
+		Converts("CREATE USER name WITH NOCREATEROLE NOLOGIN"),
+		Converts("CREATE USER name INHERIT NOLOGIN"),
+		Converts("CREATE USER name WITH INHERIT NOLOGIN"),
+		Converts("CREATE USER name NOINHERIT NOLOGIN"),
+		Converts("CREATE USER name WITH NOINHERIT NOLOGIN"),
 		Parses("CREATE USER name LOGIN NOLOGIN"),
 		Parses("CREATE USER name WITH LOGIN NOLOGIN"),
 		Parses("CREATE USER name NOLOGIN NOLOGIN"),
 		Parses("CREATE USER name WITH NOLOGIN NOLOGIN"),
-		Unimplemented("CREATE USER name REPLICATION NOLOGIN"),
-		Unimplemented("CREATE USER name WITH REPLICATION NOLOGIN"),
-		Unimplemented("CREATE USER name NOREPLICATION NOLOGIN"),
-		Unimplemented("CREATE USER name WITH NOREPLICATION NOLOGIN"),
-		Unimplemented("CREATE USER name BYPASSRLS NOLOGIN"),
-		Unimplemented("CREATE USER name WITH BYPASSRLS NOLOGIN"),
-		Unimplemented("CREATE USER name NOBYPASSRLS NOLOGIN"),
-		Unimplemented("CREATE USER name WITH NOBYPASSRLS NOLOGIN"),
-		Unimplemented("CREATE USER name CONNECTION LIMIT -1 NOLOGIN"),
-		Unimplemented("CREATE USER name WITH CONNECTION LIMIT -1 NOLOGIN"),
-		Parses("CREATE USER name PASSWORD ' password ' NOLOGIN"),
-		Parses("CREATE USER name WITH PASSWORD ' password ' NOLOGIN"),
-		Unimplemented("CREATE USER name ENCRYPTED PASSWORD ' password ' NOLOGIN"),
-		Unimplemented("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' NOLOGIN"),
-		Parses("CREATE USER name PASSWORD NULL NOLOGIN"),
-		Parses("CREATE USER name WITH PASSWORD NULL NOLOGIN"),
+		Converts("CREATE USER name REPLICATION NOLOGIN"),
+		Converts("CREATE USER name WITH REPLICATION NOLOGIN"),
+		Converts("CREATE USER name NOREPLICATION NOLOGIN"),
+		Converts("CREATE USER name WITH NOREPLICATION NOLOGIN"),
+		Converts("CREATE USER name BYPASSRLS NOLOGIN"),
+		Converts("CREATE USER name WITH BYPASSRLS NOLOGIN"),
+		Converts("CREATE USER name NOBYPASSRLS NOLOGIN"),
+		Converts("CREATE USER name WITH NOBYPASSRLS NOLOGIN"),
+		Converts("CREATE USER name CONNECTION LIMIT -1 NOLOGIN"),
+		Converts("CREATE USER name WITH CONNECTION LIMIT -1 NOLOGIN"),
+		Converts("CREATE USER name PASSWORD ' password ' NOLOGIN"),
+		Converts("CREATE USER name WITH PASSWORD ' password ' NOLOGIN"),
+		Converts("CREATE USER name ENCRYPTED PASSWORD ' password ' NOLOGIN"),
+		Converts("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' NOLOGIN"),
+		Converts("CREATE USER name PASSWORD NULL NOLOGIN"),
+		Converts("CREATE USER name WITH PASSWORD NULL NOLOGIN"),
 		Parses("CREATE USER name VALID UNTIL ' timestamp ' NOLOGIN"),
 		Parses("CREATE USER name WITH VALID UNTIL ' timestamp ' NOLOGIN"),
 		Unimplemented("CREATE USER name IN ROLE role_name NOLOGIN"),
@@ -679,44 +679,44 @@ func TestCreateUser(t *testing.T) {
 		Unimplemented("CREATE USER name WITH USER role_name , role_name NOLOGIN"),
 		Unimplemented("CREATE USER name SYSID 1 NOLOGIN"),
 		Unimplemented("CREATE USER name WITH SYSID 1 NOLOGIN"),
-		Unimplemented("CREATE USER name SUPERUSER REPLICATION"),
-		Unimplemented("CREATE USER name WITH SUPERUSER REPLICATION"),
-		Unimplemented("CREATE USER name NOSUPERUSER REPLICATION"),
-		Unimplemented("CREATE USER name WITH NOSUPERUSER REPLICATION"),
-		Unimplemented("CREATE USER name CREATEDB REPLICATION"),
-		Unimplemented("CREATE USER name WITH CREATEDB REPLICATION"),
-		Unimplemented("CREATE USER name NOCREATEDB REPLICATION"),
-		Unimplemented("CREATE USER name WITH NOCREATEDB REPLICATION"),
-		Unimplemented("CREATE USER name CREATEROLE REPLICATION"),
-		Unimplemented("CREATE USER name WITH CREATEROLE REPLICATION"),
-		Unimplemented("CREATE USER name NOCREATEROLE REPLICATION"),
-		Unimplemented("CREATE USER name WITH NOCREATEROLE REPLICATION"),
-		Unimplemented("CREATE USER name INHERIT REPLICATION"),
-		Unimplemented("CREATE USER name WITH INHERIT REPLICATION"),
-		Unimplemented("CREATE USER name NOINHERIT REPLICATION"),
-		Unimplemented("CREATE USER name WITH NOINHERIT REPLICATION"),
-		Unimplemented("CREATE USER name LOGIN REPLICATION"),
-		Unimplemented("CREATE USER name WITH LOGIN REPLICATION"),
-		Unimplemented("CREATE USER name NOLOGIN REPLICATION"),
-		Unimplemented("CREATE USER name WITH NOLOGIN REPLICATION"),
-		Unimplemented("CREATE USER name REPLICATION REPLICATION"),
-		Unimplemented("CREATE USER name WITH REPLICATION REPLICATION"),
-		Unimplemented("CREATE USER name NOREPLICATION REPLICATION"),
-		Unimplemented("CREATE USER name WITH NOREPLICATION REPLICATION"),
-		Unimplemented("CREATE USER name BYPASSRLS REPLICATION"),
-		Unimplemented("CREATE USER name WITH BYPASSRLS REPLICATION"),
-		Unimplemented("CREATE USER name NOBYPASSRLS REPLICATION"),
-		Unimplemented("CREATE USER name WITH NOBYPASSRLS REPLICATION"),
-		Unimplemented("CREATE USER name CONNECTION LIMIT -1 REPLICATION"),
-		Unimplemented("CREATE USER name WITH CONNECTION LIMIT -1 REPLICATION"),
-		Unimplemented("CREATE USER name PASSWORD ' password ' REPLICATION"),
-		Unimplemented("CREATE USER name WITH PASSWORD ' password ' REPLICATION"),
-		Unimplemented("CREATE USER name ENCRYPTED PASSWORD ' password ' REPLICATION"),
-		Unimplemented("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' REPLICATION"),
-		Unimplemented("CREATE USER name PASSWORD NULL REPLICATION"),
-		Unimplemented("CREATE USER name WITH PASSWORD NULL REPLICATION"),
-		Unimplemented("CREATE USER name VALID UNTIL ' timestamp ' REPLICATION"),
-		Unimplemented("CREATE USER name WITH VALID UNTIL ' timestamp ' REPLICATION"),
+		Converts("CREATE USER name SUPERUSER REPLICATION"),
+		Converts("CREATE USER name WITH SUPERUSER REPLICATION"),
+		Converts("CREATE USER name NOSUPERUSER REPLICATION"),
+		Converts("CREATE USER name WITH NOSUPERUSER REPLICATION"),
+		Converts("CREATE USER name CREATEDB REPLICATION"),
+		Converts("CREATE USER name WITH CREATEDB REPLICATION"),
+		Converts("CREATE USER name NOCREATEDB REPLICATION"),
+		Converts("CREATE USER name WITH NOCREATEDB REPLICATION"),
+		Converts("CREATE USER name CREATEROLE REPLICATION"),
+		Converts("CREATE USER name WITH CREATEROLE REPLICATION"),
+		Converts("CREATE USER name NOCREATEROLE REPLICATION"),
+		Converts("CREATE USER name WITH NOCREATEROLE REPLICATION"),
+		Converts("CREATE USER name INHERIT REPLICATION"),
+		Converts("CREATE USER name WITH INHERIT REPLICATION"),
+		Converts("CREATE USER name NOINHERIT REPLICATION"),
+		Converts("CREATE USER name WITH NOINHERIT REPLICATION"),
+		Converts("CREATE USER name LOGIN REPLICATION"),
+		Converts("CREATE USER name WITH LOGIN REPLICATION"),
+		Converts("CREATE USER name NOLOGIN REPLICATION"),
+		Converts("CREATE USER name WITH NOLOGIN REPLICATION"),
+		Parses("CREATE USER name REPLICATION REPLICATION"),
+		Parses("CREATE USER name WITH REPLICATION REPLICATION"),
+		Parses("CREATE USER name NOREPLICATION REPLICATION"),
+		Parses("CREATE USER name WITH NOREPLICATION REPLICATION"),
+		Converts("CREATE USER name BYPASSRLS REPLICATION"),
+		Converts("CREATE USER name WITH BYPASSRLS REPLICATION"),
+		Converts("CREATE USER name NOBYPASSRLS REPLICATION"),
+		Converts("CREATE USER name WITH NOBYPASSRLS REPLICATION"),
+		Converts("CREATE USER name CONNECTION LIMIT -1 REPLICATION"),
+		Converts("CREATE USER name WITH CONNECTION LIMIT -1 REPLICATION"),
+		Converts("CREATE USER name PASSWORD ' password ' REPLICATION"),
+		Converts("CREATE USER name WITH PASSWORD ' password ' REPLICATION"),
+		Converts("CREATE USER name ENCRYPTED PASSWORD ' password ' REPLICATION"),
+		Converts("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' REPLICATION"),
+		Converts("CREATE USER name PASSWORD NULL REPLICATION"),
+		Converts("CREATE USER name WITH PASSWORD NULL REPLICATION"),
+		Parses("CREATE USER name VALID UNTIL ' timestamp ' REPLICATION"),
+		Parses("CREATE USER name WITH VALID UNTIL ' timestamp ' REPLICATION"),
 		Unimplemented("CREATE USER name IN ROLE role_name REPLICATION"),
 		Unimplemented("CREATE USER name WITH IN ROLE role_name REPLICATION"),
 		Unimplemented("CREATE USER name IN ROLE role_name , role_name REPLICATION"),
@@ -739,44 +739,44 @@ func TestCreateUser(t *testing.T) {
 		Unimplemented("CREATE USER name WITH USER role_name , role_name REPLICATION"),
 		Unimplemented("CREATE USER name SYSID 1 REPLICATION"),
 		Unimplemented("CREATE USER name WITH SYSID 1 REPLICATION"),
-		Unimplemented("CREATE USER name SUPERUSER NOREPLICATION"),
-		Unimplemented("CREATE USER name WITH SUPERUSER NOREPLICATION"),
-		Unimplemented("CREATE USER name NOSUPERUSER NOREPLICATION"),
-		Unimplemented("CREATE USER name WITH NOSUPERUSER NOREPLICATION"),
-		Unimplemented("CREATE USER name CREATEDB NOREPLICATION"),
-		Unimplemented("CREATE USER name WITH CREATEDB NOREPLICATION"),
-		Unimplemented("CREATE USER name NOCREATEDB NOREPLICATION"),
-		Unimplemented("CREATE USER name WITH NOCREATEDB NOREPLICATION"),
-		Unimplemented("CREATE USER name CREATEROLE NOREPLICATION"),
-		Unimplemented("CREATE USER name WITH CREATEROLE NOREPLICATION"),
-		Unimplemented("CREATE USER name NOCREATEROLE NOREPLICATION"),
-		Unimplemented("CREATE USER name WITH NOCREATEROLE NOREPLICATION"),
-		Unimplemented("CREATE USER name INHERIT NOREPLICATION"),
-		Unimplemented("CREATE USER name WITH INHERIT NOREPLICATION"),
-		Unimplemented("CREATE USER name NOINHERIT NOREPLICATION"),
-		Unimplemented("CREATE USER name WITH NOINHERIT NOREPLICATION"),
-		Unimplemented("CREATE USER name LOGIN NOREPLICATION"),
-		Unimplemented("CREATE USER name WITH LOGIN NOREPLICATION"),
-		Unimplemented("CREATE USER name NOLOGIN NOREPLICATION"),
-		Unimplemented("CREATE USER name WITH NOLOGIN NOREPLICATION"),
-		Unimplemented("CREATE USER name REPLICATION NOREPLICATION"),
-		Unimplemented("CREATE USER name WITH REPLICATION NOREPLICATION"),
-		Unimplemented("CREATE USER name NOREPLICATION NOREPLICATION"),
-		Unimplemented("CREATE USER name WITH NOREPLICATION NOREPLICATION"),
-		Unimplemented("CREATE USER name BYPASSRLS NOREPLICATION"),
-		Unimplemented("CREATE USER name WITH BYPASSRLS NOREPLICATION"),
-		Unimplemented("CREATE USER name NOBYPASSRLS NOREPLICATION"),
-		Unimplemented("CREATE USER name WITH NOBYPASSRLS NOREPLICATION"),
-		Unimplemented("CREATE USER name CONNECTION LIMIT -1 NOREPLICATION"),
-		Unimplemented("CREATE USER name WITH CONNECTION LIMIT -1 NOREPLICATION"),
-		Unimplemented("CREATE USER name PASSWORD ' password ' NOREPLICATION"),
-		Unimplemented("CREATE USER name WITH PASSWORD ' password ' NOREPLICATION"),
-		Unimplemented("CREATE USER name ENCRYPTED PASSWORD ' password ' NOREPLICATION"),
-		Unimplemented("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' NOREPLICATION"),
-		Unimplemented("CREATE USER name PASSWORD NULL NOREPLICATION"),
-		Unimplemented("CREATE USER name WITH PASSWORD NULL NOREPLICATION"),
-		Unimplemented("CREATE USER name VALID UNTIL ' timestamp ' NOREPLICATION"),
-		Unimplemented("CREATE USER name WITH VALID UNTIL ' timestamp ' NOREPLICATION"),
+		Converts("CREATE USER name SUPERUSER NOREPLICATION"),
+		Converts("CREATE USER name WITH SUPERUSER NOREPLICATION"),
+		Converts("CREATE USER name NOSUPERUSER NOREPLICATION"),
+		Converts("CREATE USER name WITH NOSUPERUSER NOREPLICATION"),
+		Converts("CREATE USER name CREATEDB NOREPLICATION"),
+		Converts("CREATE USER name WITH CREATEDB NOREPLICATION"),
+		Converts("CREATE USER name NOCREATEDB NOREPLICATION"),
+		Converts("CREATE USER name WITH NOCREATEDB NOREPLICATION"),
+		Converts("CREATE USER name CREATEROLE NOREPLICATION"),
+		Converts("CREATE USER name WITH CREATEROLE NOREPLICATION"),
+		Converts("CREATE USER name NOCREATEROLE NOREPLICATION"),
+		Converts("CREATE USER name WITH NOCREATEROLE NOREPLICATION"),
+		Converts("CREATE USER name INHERIT NOREPLICATION"),
+		Converts("CREATE USER name WITH INHERIT NOREPLICATION"),
+		Converts("CREATE USER name NOINHERIT NOREPLICATION"),
+		Converts("CREATE USER name WITH NOINHERIT NOREPLICATION"),
+		Converts("CREATE USER name LOGIN NOREPLICATION"),
+		Converts("CREATE USER name WITH LOGIN NOREPLICATION"),
+		Converts("CREATE USER name NOLOGIN NOREPLICATION"),
+		Converts("CREATE USER name WITH NOLOGIN NOREPLICATION"),
+		Parses("CREATE USER name REPLICATION NOREPLICATION"),
+		Parses("CREATE USER name WITH REPLICATION NOREPLICATION"),
+		Parses("CREATE USER name NOREPLICATION NOREPLICATION"),
+		Parses("CREATE USER name WITH NOREPLICATION NOREPLICATION"),
+		Converts("CREATE USER name BYPASSRLS NOREPLICATION"),
+		Converts("CREATE USER name WITH BYPASSRLS NOREPLICATION"),
+		Converts("CREATE USER name NOBYPASSRLS NOREPLICATION"),
+		Converts("CREATE USER name WITH NOBYPASSRLS NOREPLICATION"),
+		Converts("CREATE USER name CONNECTION LIMIT -1 NOREPLICATION"),
+		Converts("CREATE USER name WITH CONNECTION LIMIT -1 NOREPLICATION"),
+		Converts("CREATE USER name PASSWORD ' password ' NOREPLICATION"),
+		Converts("CREATE USER name WITH PASSWORD ' password ' NOREPLICATION"),
+		Converts("CREATE USER name ENCRYPTED PASSWORD ' password ' NOREPLICATION"),
+		Converts("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' NOREPLICATION"),
+		Converts("CREATE USER name PASSWORD NULL NOREPLICATION"),
+		Converts("CREATE USER name WITH PASSWORD NULL NOREPLICATION"),
+		Parses("CREATE USER name VALID UNTIL ' timestamp ' NOREPLICATION"),
+		Parses("CREATE USER name WITH VALID UNTIL ' timestamp ' NOREPLICATION"),
 		Unimplemented("CREATE USER name IN ROLE role_name NOREPLICATION"),
 		Unimplemented("CREATE USER name WITH IN ROLE role_name NOREPLICATION"),
 		Unimplemented("CREATE USER name IN ROLE role_name , role_name NOREPLICATION"),
@@ -799,44 +799,44 @@ func TestCreateUser(t *testing.T) {
 		Unimplemented("CREATE USER name WITH USER role_name , role_name NOREPLICATION"),
 		Unimplemented("CREATE USER name SYSID 1 NOREPLICATION"),
 		Unimplemented("CREATE USER name WITH SYSID 1 NOREPLICATION"),
-		Unimplemented("CREATE USER name SUPERUSER BYPASSRLS"),
-		Unimplemented("CREATE USER name WITH SUPERUSER BYPASSRLS"),
-		Unimplemented("CREATE USER name NOSUPERUSER BYPASSRLS"),
-		Unimplemented("CREATE USER name WITH NOSUPERUSER BYPASSRLS"),
-		Unimplemented("CREATE USER name CREATEDB BYPASSRLS"),
-		Unimplemented("CREATE USER name WITH CREATEDB BYPASSRLS"),
-		Unimplemented("CREATE USER name NOCREATEDB BYPASSRLS"),
-		Unimplemented("CREATE USER name WITH NOCREATEDB BYPASSRLS"),
-		Unimplemented("CREATE USER name CREATEROLE BYPASSRLS"),
-		Unimplemented("CREATE USER name WITH CREATEROLE BYPASSRLS"),
-		Unimplemented("CREATE USER name NOCREATEROLE BYPASSRLS"),
-		Unimplemented("CREATE USER name WITH NOCREATEROLE BYPASSRLS"),
-		Unimplemented("CREATE USER name INHERIT BYPASSRLS"),
-		Unimplemented("CREATE USER name WITH INHERIT BYPASSRLS"),
-		Unimplemented("CREATE USER name NOINHERIT BYPASSRLS"),
-		Unimplemented("CREATE USER name WITH NOINHERIT BYPASSRLS"),
-		Unimplemented("CREATE USER name LOGIN BYPASSRLS"),
-		Unimplemented("CREATE USER name WITH LOGIN BYPASSRLS"),
-		Unimplemented("CREATE USER name NOLOGIN BYPASSRLS"),
-		Unimplemented("CREATE USER name WITH NOLOGIN BYPASSRLS"),
-		Unimplemented("CREATE USER name REPLICATION BYPASSRLS"),
-		Unimplemented("CREATE USER name WITH REPLICATION BYPASSRLS"),
-		Unimplemented("CREATE USER name NOREPLICATION BYPASSRLS"),
-		Unimplemented("CREATE USER name WITH NOREPLICATION BYPASSRLS"),
-		Unimplemented("CREATE USER name BYPASSRLS BYPASSRLS"),
-		Unimplemented("CREATE USER name WITH BYPASSRLS BYPASSRLS"),
-		Unimplemented("CREATE USER name NOBYPASSRLS BYPASSRLS"),
-		Unimplemented("CREATE USER name WITH NOBYPASSRLS BYPASSRLS"),
-		Unimplemented("CREATE USER name CONNECTION LIMIT -1 BYPASSRLS"),
-		Unimplemented("CREATE USER name WITH CONNECTION LIMIT -1 BYPASSRLS"),
-		Unimplemented("CREATE USER name PASSWORD ' password ' BYPASSRLS"),
-		Unimplemented("CREATE USER name WITH PASSWORD ' password ' BYPASSRLS"),
-		Unimplemented("CREATE USER name ENCRYPTED PASSWORD ' password ' BYPASSRLS"),
-		Unimplemented("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' BYPASSRLS"),
-		Unimplemented("CREATE USER name PASSWORD NULL BYPASSRLS"),
-		Unimplemented("CREATE USER name WITH PASSWORD NULL BYPASSRLS"),
-		Unimplemented("CREATE USER name VALID UNTIL ' timestamp ' BYPASSRLS"),
-		Unimplemented("CREATE USER name WITH VALID UNTIL ' timestamp ' BYPASSRLS"),
+		Converts("CREATE USER name SUPERUSER BYPASSRLS"),
+		Converts("CREATE USER name WITH SUPERUSER BYPASSRLS"),
+		Converts("CREATE USER name NOSUPERUSER BYPASSRLS"),
+		Converts("CREATE USER name WITH NOSUPERUSER BYPASSRLS"),
+		Converts("CREATE USER name CREATEDB BYPASSRLS"),
+		Converts("CREATE USER name WITH CREATEDB BYPASSRLS"),
+		Converts("CREATE USER name NOCREATEDB BYPASSRLS"),
+		Converts("CREATE USER name WITH NOCREATEDB BYPASSRLS"),
+		Converts("CREATE USER name CREATEROLE BYPASSRLS"),
+		Converts("CREATE USER name WITH CREATEROLE BYPASSRLS"),
+		Converts("CREATE USER name NOCREATEROLE BYPASSRLS"),
+		Converts("CREATE USER name WITH NOCREATEROLE BYPASSRLS"),
+		Converts("CREATE USER name INHERIT BYPASSRLS"),
+		Converts("CREATE USER name WITH INHERIT BYPASSRLS"),
+		Converts("CREATE USER name NOINHERIT BYPASSRLS"),
+		Converts("CREATE USER name WITH NOINHERIT BYPASSRLS"),
+		Converts("CREATE USER name LOGIN BYPASSRLS"),
+		Converts("CREATE USER name WITH LOGIN BYPASSRLS"),
+		Converts("CREATE USER name NOLOGIN BYPASSRLS"),
+		Converts("CREATE USER name WITH NOLOGIN BYPASSRLS"),
+		Converts("CREATE USER name REPLICATION BYPASSRLS"),
+		Converts("CREATE USER name WITH REPLICATION BYPASSRLS"),
+		Converts("CREATE USER name NOREPLICATION BYPASSRLS"),
+		Converts("CREATE USER name WITH NOREPLICATION BYPASSRLS"),
+		Parses("CREATE USER name BYPASSRLS BYPASSRLS"),
+		Parses("CREATE USER name WITH BYPASSRLS BYPASSRLS"),
+		Parses("CREATE USER name NOBYPASSRLS BYPASSRLS"),
+		Parses("CREATE USER name WITH NOBYPASSRLS BYPASSRLS"),
+		Converts("CREATE USER name CONNECTION LIMIT -1 BYPASSRLS"),
+		Converts("CREATE USER name WITH CONNECTION LIMIT -1 BYPASSRLS"),
+		Converts("CREATE USER name PASSWORD ' password ' BYPASSRLS"),
+		Converts("CREATE USER name WITH PASSWORD ' password ' BYPASSRLS"),
+		Converts("CREATE USER name ENCRYPTED PASSWORD ' password ' BYPASSRLS"),
+		Converts("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' BYPASSRLS"),
+		Converts("CREATE USER name PASSWORD NULL BYPASSRLS"),
+		Converts("CREATE USER name WITH PASSWORD NULL BYPASSRLS"),
+		Parses("CREATE USER name VALID UNTIL ' timestamp ' BYPASSRLS"),
+		Parses("CREATE USER name WITH VALID UNTIL ' timestamp ' BYPASSRLS"),
 		Unimplemented("CREATE USER name IN ROLE role_name BYPASSRLS"),
 		Unimplemented("CREATE USER name WITH IN ROLE role_name BYPASSRLS"),
 		Unimplemented("CREATE USER name IN ROLE role_name , role_name BYPASSRLS"),
@@ -859,44 +859,44 @@ func TestCreateUser(t *testing.T) {
 		Unimplemented("CREATE USER name WITH USER role_name , role_name BYPASSRLS"),
 		Unimplemented("CREATE USER name SYSID 1 BYPASSRLS"),
 		Unimplemented("CREATE USER name WITH SYSID 1 BYPASSRLS"),
-		Unimplemented("CREATE USER name SUPERUSER NOBYPASSRLS"),
-		Unimplemented("CREATE USER name WITH SUPERUSER NOBYPASSRLS"),
-		Unimplemented("CREATE USER name NOSUPERUSER NOBYPASSRLS"),
-		Unimplemented("CREATE USER name WITH NOSUPERUSER NOBYPASSRLS"),
-		Unimplemented("CREATE USER name CREATEDB NOBYPASSRLS"),
-		Unimplemented("CREATE USER name WITH CREATEDB NOBYPASSRLS"),
-		Unimplemented("CREATE USER name NOCREATEDB NOBYPASSRLS"),
-		Unimplemented("CREATE USER name WITH NOCREATEDB NOBYPASSRLS"),
-		Unimplemented("CREATE USER name CREATEROLE NOBYPASSRLS"),
-		Unimplemented("CREATE USER name WITH CREATEROLE NOBYPASSRLS"),
-		Unimplemented("CREATE USER name NOCREATEROLE NOBYPASSRLS"),
-		Unimplemented("CREATE USER name WITH NOCREATEROLE NOBYPASSRLS"),
-		Unimplemented("CREATE USER name INHERIT NOBYPASSRLS"),
-		Unimplemented("CREATE USER name WITH INHERIT NOBYPASSRLS"),
-		Unimplemented("CREATE USER name NOINHERIT NOBYPASSRLS"),
-		Unimplemented("CREATE USER name WITH NOINHERIT NOBYPASSRLS"),
-		Unimplemented("CREATE USER name LOGIN NOBYPASSRLS"),
-		Unimplemented("CREATE USER name WITH LOGIN NOBYPASSRLS"),
-		Unimplemented("CREATE USER name NOLOGIN NOBYPASSRLS"),
-		Unimplemented("CREATE USER name WITH NOLOGIN NOBYPASSRLS"),
-		Unimplemented("CREATE USER name REPLICATION NOBYPASSRLS"),
-		Unimplemented("CREATE USER name WITH REPLICATION NOBYPASSRLS"),
-		Unimplemented("CREATE USER name NOREPLICATION NOBYPASSRLS"),
-		Unimplemented("CREATE USER name WITH NOREPLICATION NOBYPASSRLS"),
-		Unimplemented("CREATE USER name BYPASSRLS NOBYPASSRLS"),
-		Unimplemented("CREATE USER name WITH BYPASSRLS NOBYPASSRLS"),
-		Unimplemented("CREATE USER name NOBYPASSRLS NOBYPASSRLS"),
-		Unimplemented("CREATE USER name WITH NOBYPASSRLS NOBYPASSRLS"),
-		Unimplemented("CREATE USER name CONNECTION LIMIT -1 NOBYPASSRLS"),
-		Unimplemented("CREATE USER name WITH CONNECTION LIMIT -1 NOBYPASSRLS"),
-		Unimplemented("CREATE USER name PASSWORD ' password ' NOBYPASSRLS"),
-		Unimplemented("CREATE USER name WITH PASSWORD ' password ' NOBYPASSRLS"),
-		Unimplemented("CREATE USER name ENCRYPTED PASSWORD ' password ' NOBYPASSRLS"),
-		Unimplemented("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' NOBYPASSRLS"),
-		Unimplemented("CREATE USER name PASSWORD NULL NOBYPASSRLS"),
-		Unimplemented("CREATE USER name WITH PASSWORD NULL NOBYPASSRLS"),
-		Unimplemented("CREATE USER name VALID UNTIL ' timestamp ' NOBYPASSRLS"),
-		Unimplemented("CREATE USER name WITH VALID UNTIL ' timestamp ' NOBYPASSRLS"),
+		Converts("CREATE USER name SUPERUSER NOBYPASSRLS"),
+		Converts("CREATE USER name WITH SUPERUSER NOBYPASSRLS"),
+		Converts("CREATE USER name NOSUPERUSER NOBYPASSRLS"),
+		Converts("CREATE USER name WITH NOSUPERUSER NOBYPASSRLS"),
+		Converts("CREATE USER name CREATEDB NOBYPASSRLS"),
+		Converts("CREATE USER name WITH CREATEDB NOBYPASSRLS"),
+		Converts("CREATE USER name NOCREATEDB NOBYPASSRLS"),
+		Converts("CREATE USER name WITH NOCREATEDB NOBYPASSRLS"),
+		Converts("CREATE USER name CREATEROLE NOBYPASSRLS"),
+		Converts("CREATE USER name WITH CREATEROLE NOBYPASSRLS"),
+		Converts("CREATE USER name NOCREATEROLE NOBYPASSRLS"),
+		Converts("CREATE USER name WITH NOCREATEROLE NOBYPASSRLS"),
+		Converts("CREATE USER name INHERIT NOBYPASSRLS"),
+		Converts("CREATE USER name WITH INHERIT NOBYPASSRLS"),
+		Converts("CREATE USER name NOINHERIT NOBYPASSRLS"),
+		Converts("CREATE USER name WITH NOINHERIT NOBYPASSRLS"),
+		Converts("CREATE USER name LOGIN NOBYPASSRLS"),
+		Converts("CREATE USER name WITH LOGIN NOBYPASSRLS"),
+		Converts("CREATE USER name NOLOGIN NOBYPASSRLS"),
+		Converts("CREATE USER name WITH NOLOGIN NOBYPASSRLS"),
+		Converts("CREATE USER name REPLICATION NOBYPASSRLS"),
+		Converts("CREATE USER name WITH REPLICATION NOBYPASSRLS"),
+		Converts("CREATE USER name NOREPLICATION NOBYPASSRLS"),
+		Converts("CREATE USER name WITH NOREPLICATION NOBYPASSRLS"),
+		Parses("CREATE USER name BYPASSRLS NOBYPASSRLS"),
+		Parses("CREATE USER name WITH BYPASSRLS NOBYPASSRLS"),
+		Parses("CREATE USER name NOBYPASSRLS NOBYPASSRLS"),
+		Parses("CREATE USER name WITH NOBYPASSRLS NOBYPASSRLS"),
+		Converts("CREATE USER name CONNECTION LIMIT -1 NOBYPASSRLS"),
+		Converts("CREATE USER name WITH CONNECTION LIMIT -1 NOBYPASSRLS"),
+		Converts("CREATE USER name PASSWORD ' password ' NOBYPASSRLS"),
+		Converts("CREATE USER name WITH PASSWORD ' password ' NOBYPASSRLS"),
+		Converts("CREATE USER name ENCRYPTED PASSWORD ' password ' NOBYPASSRLS"),
+		Converts("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' NOBYPASSRLS"),
+		Converts("CREATE USER name PASSWORD NULL NOBYPASSRLS"),
+		Converts("CREATE USER name WITH PASSWORD NULL NOBYPASSRLS"),
+		Parses("CREATE USER name VALID UNTIL ' timestamp ' NOBYPASSRLS"),
+		Parses("CREATE USER name WITH VALID UNTIL ' timestamp ' NOBYPASSRLS"),
 		Unimplemented("CREATE USER name IN ROLE role_name NOBYPASSRLS"),
 		Unimplemented("CREATE USER name WITH IN ROLE role_name NOBYPASSRLS"),
 		Unimplemented("CREATE USER name IN ROLE role_name , role_name NOBYPASSRLS"),
@@ -919,44 +919,44 @@ func TestCreateUser(t *testing.T) {
 		Unimplemented("CREATE USER name WITH USER role_name , role_name NOBYPASSRLS"),
 		Unimplemented("CREATE USER name SYSID 1 NOBYPASSRLS"),
 		Unimplemented("CREATE USER name WITH SYSID 1 NOBYPASSRLS"),
-		Unimplemented("CREATE USER name SUPERUSER CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name WITH SUPERUSER CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name NOSUPERUSER CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name WITH NOSUPERUSER CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name CREATEDB CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name WITH CREATEDB CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name NOCREATEDB CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name WITH NOCREATEDB CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name CREATEROLE CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name WITH CREATEROLE CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name NOCREATEROLE CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name WITH NOCREATEROLE CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name INHERIT CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name WITH INHERIT CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name NOINHERIT CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name WITH NOINHERIT CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name LOGIN CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name WITH LOGIN CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name NOLOGIN CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name WITH NOLOGIN CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name REPLICATION CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name WITH REPLICATION CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name NOREPLICATION CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name WITH NOREPLICATION CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name BYPASSRLS CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name WITH BYPASSRLS CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name NOBYPASSRLS CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name WITH NOBYPASSRLS CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name WITH CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name PASSWORD ' password ' CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name WITH PASSWORD ' password ' CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name ENCRYPTED PASSWORD ' password ' CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name PASSWORD NULL CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name WITH PASSWORD NULL CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name VALID UNTIL ' timestamp ' CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name WITH VALID UNTIL ' timestamp ' CONNECTION LIMIT -1"),
+		Converts("CREATE USER name SUPERUSER CONNECTION LIMIT -1"),
+		Converts("CREATE USER name WITH SUPERUSER CONNECTION LIMIT -1"),
+		Converts("CREATE USER name NOSUPERUSER CONNECTION LIMIT -1"),
+		Converts("CREATE USER name WITH NOSUPERUSER CONNECTION LIMIT -1"),
+		Converts("CREATE USER name CREATEDB CONNECTION LIMIT -1"),
+		Converts("CREATE USER name WITH CREATEDB CONNECTION LIMIT -1"),
+		Converts("CREATE USER name NOCREATEDB CONNECTION LIMIT -1"),
+		Converts("CREATE USER name WITH NOCREATEDB CONNECTION LIMIT -1"),
+		Converts("CREATE USER name CREATEROLE CONNECTION LIMIT -1"),
+		Converts("CREATE USER name WITH CREATEROLE CONNECTION LIMIT -1"),
+		Converts("CREATE USER name NOCREATEROLE CONNECTION LIMIT -1"),
+		Converts("CREATE USER name WITH NOCREATEROLE CONNECTION LIMIT -1"),
+		Converts("CREATE USER name INHERIT CONNECTION LIMIT -1"),
+		Converts("CREATE USER name WITH INHERIT CONNECTION LIMIT -1"),
+		Converts("CREATE USER name NOINHERIT CONNECTION LIMIT -1"),
+		Converts("CREATE USER name WITH NOINHERIT CONNECTION LIMIT -1"),
+		Converts("CREATE USER name LOGIN CONNECTION LIMIT -1"),
+		Converts("CREATE USER name WITH LOGIN CONNECTION LIMIT -1"),
+		Converts("CREATE USER name NOLOGIN CONNECTION LIMIT -1"),
+		Converts("CREATE USER name WITH NOLOGIN CONNECTION LIMIT -1"),
+		Converts("CREATE USER name REPLICATION CONNECTION LIMIT -1"),
+		Converts("CREATE USER name WITH REPLICATION CONNECTION LIMIT -1"),
+		Converts("CREATE USER name NOREPLICATION CONNECTION LIMIT -1"),
+		Converts("CREATE USER name WITH NOREPLICATION CONNECTION LIMIT -1"),
+		Converts("CREATE USER name BYPASSRLS CONNECTION LIMIT -1"),
+		Converts("CREATE USER name WITH BYPASSRLS CONNECTION LIMIT -1"),
+		Converts("CREATE USER name NOBYPASSRLS CONNECTION LIMIT -1"),
+		Converts("CREATE USER name WITH NOBYPASSRLS CONNECTION LIMIT -1"),
+		Parses("CREATE USER name CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
+		Parses("CREATE USER name WITH CONNECTION LIMIT -1 CONNECTION LIMIT -1"),
+		Converts("CREATE USER name PASSWORD ' password ' CONNECTION LIMIT -1"),
+		Converts("CREATE USER name WITH PASSWORD ' password ' CONNECTION LIMIT -1"),
+		Converts("CREATE USER name ENCRYPTED PASSWORD ' password ' CONNECTION LIMIT -1"),
+		Converts("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' CONNECTION LIMIT -1"),
+		Converts("CREATE USER name PASSWORD NULL CONNECTION LIMIT -1"),
+		Converts("CREATE USER name WITH PASSWORD NULL CONNECTION LIMIT -1"),
+		Parses("CREATE USER name VALID UNTIL ' timestamp ' CONNECTION LIMIT -1"),
+		Parses("CREATE USER name WITH VALID UNTIL ' timestamp ' CONNECTION LIMIT -1"),
 		Unimplemented("CREATE USER name IN ROLE role_name CONNECTION LIMIT -1"),
 		Unimplemented("CREATE USER name WITH IN ROLE role_name CONNECTION LIMIT -1"),
 		Unimplemented("CREATE USER name IN ROLE role_name , role_name CONNECTION LIMIT -1"),
@@ -979,40 +979,40 @@ func TestCreateUser(t *testing.T) {
 		Unimplemented("CREATE USER name WITH USER role_name , role_name CONNECTION LIMIT -1"),
 		Unimplemented("CREATE USER name SYSID 1 CONNECTION LIMIT -1"),
 		Unimplemented("CREATE USER name WITH SYSID 1 CONNECTION LIMIT -1"),
-		Unimplemented("CREATE USER name SUPERUSER PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH SUPERUSER PASSWORD ' password '"),
-		Unimplemented("CREATE USER name NOSUPERUSER PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH NOSUPERUSER PASSWORD ' password '"),
-		Parses("CREATE USER name CREATEDB PASSWORD ' password '"),
-		Parses("CREATE USER name WITH CREATEDB PASSWORD ' password '"),
-		Parses("CREATE USER name NOCREATEDB PASSWORD ' password '"),
-		Parses("CREATE USER name WITH NOCREATEDB PASSWORD ' password '"),
-		Parses("CREATE USER name CREATEROLE PASSWORD ' password '"),
-		Parses("CREATE USER name WITH CREATEROLE PASSWORD ' password '"),
-		Parses("CREATE USER name NOCREATEROLE PASSWORD ' password '"),
-		Parses("CREATE USER name WITH NOCREATEROLE PASSWORD ' password '"),
-		Unimplemented("CREATE USER name INHERIT PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH INHERIT PASSWORD ' password '"),
-		Unimplemented("CREATE USER name NOINHERIT PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH NOINHERIT PASSWORD ' password '"),
-		Parses("CREATE USER name LOGIN PASSWORD ' password '"),
-		Parses("CREATE USER name WITH LOGIN PASSWORD ' password '"),
-		Parses("CREATE USER name NOLOGIN PASSWORD ' password '"),
-		Parses("CREATE USER name WITH NOLOGIN PASSWORD ' password '"),
-		Unimplemented("CREATE USER name REPLICATION PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH REPLICATION PASSWORD ' password '"),
-		Unimplemented("CREATE USER name NOREPLICATION PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH NOREPLICATION PASSWORD ' password '"),
-		Unimplemented("CREATE USER name BYPASSRLS PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH BYPASSRLS PASSWORD ' password '"),
-		Unimplemented("CREATE USER name NOBYPASSRLS PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH NOBYPASSRLS PASSWORD ' password '"),
-		Unimplemented("CREATE USER name CONNECTION LIMIT -1 PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH CONNECTION LIMIT -1 PASSWORD ' password '"),
+		Converts("CREATE USER name SUPERUSER PASSWORD ' password '"),
+		Converts("CREATE USER name WITH SUPERUSER PASSWORD ' password '"),
+		Converts("CREATE USER name NOSUPERUSER PASSWORD ' password '"),
+		Converts("CREATE USER name WITH NOSUPERUSER PASSWORD ' password '"),
+		Converts("CREATE USER name CREATEDB PASSWORD ' password '"),
+		Converts("CREATE USER name WITH CREATEDB PASSWORD ' password '"),
+		Converts("CREATE USER name NOCREATEDB PASSWORD ' password '"),
+		Converts("CREATE USER name WITH NOCREATEDB PASSWORD ' password '"),
+		Converts("CREATE USER name CREATEROLE PASSWORD ' password '"),
+		Converts("CREATE USER name WITH CREATEROLE PASSWORD ' password '"),
+		Converts("CREATE USER name NOCREATEROLE PASSWORD ' password '"),
+		Converts("CREATE USER name WITH NOCREATEROLE PASSWORD ' password '"),
+		Converts("CREATE USER name INHERIT PASSWORD ' password '"),
+		Converts("CREATE USER name WITH INHERIT PASSWORD ' password '"),
+		Converts("CREATE USER name NOINHERIT PASSWORD ' password '"),
+		Converts("CREATE USER name WITH NOINHERIT PASSWORD ' password '"),
+		Converts("CREATE USER name LOGIN PASSWORD ' password '"),
+		Converts("CREATE USER name WITH LOGIN PASSWORD ' password '"),
+		Converts("CREATE USER name NOLOGIN PASSWORD ' password '"),
+		Converts("CREATE USER name WITH NOLOGIN PASSWORD ' password '"),
+		Converts("CREATE USER name REPLICATION PASSWORD ' password '"),
+		Converts("CREATE USER name WITH REPLICATION PASSWORD ' password '"),
+		Converts("CREATE USER name NOREPLICATION PASSWORD ' password '"),
+		Converts("CREATE USER name WITH NOREPLICATION PASSWORD ' password '"),
+		Converts("CREATE USER name BYPASSRLS PASSWORD ' password '"),
+		Converts("CREATE USER name WITH BYPASSRLS PASSWORD ' password '"),
+		Converts("CREATE USER name NOBYPASSRLS PASSWORD ' password '"),
+		Converts("CREATE USER name WITH NOBYPASSRLS PASSWORD ' password '"),
+		Converts("CREATE USER name CONNECTION LIMIT -1 PASSWORD ' password '"),
+		Converts("CREATE USER name WITH CONNECTION LIMIT -1 PASSWORD ' password '"),
 		Parses("CREATE USER name PASSWORD ' password ' PASSWORD ' password '"),
 		Parses("CREATE USER name WITH PASSWORD ' password ' PASSWORD ' password '"),
-		Unimplemented("CREATE USER name ENCRYPTED PASSWORD ' password ' PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' PASSWORD ' password '"),
+		Parses("CREATE USER name ENCRYPTED PASSWORD ' password ' PASSWORD ' password '"),
+		Parses("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' PASSWORD ' password '"),
 		Parses("CREATE USER name PASSWORD NULL PASSWORD ' password '"),
 		Parses("CREATE USER name WITH PASSWORD NULL PASSWORD ' password '"),
 		Parses("CREATE USER name VALID UNTIL ' timestamp ' PASSWORD ' password '"),
@@ -1039,44 +1039,44 @@ func TestCreateUser(t *testing.T) {
 		Unimplemented("CREATE USER name WITH USER role_name , role_name PASSWORD ' password '"),
 		Unimplemented("CREATE USER name SYSID 1 PASSWORD ' password '"),
 		Unimplemented("CREATE USER name WITH SYSID 1 PASSWORD ' password '"),
-		Unimplemented("CREATE USER name SUPERUSER ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH SUPERUSER ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name NOSUPERUSER ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH NOSUPERUSER ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name CREATEDB ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH CREATEDB ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name NOCREATEDB ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH NOCREATEDB ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name CREATEROLE ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH CREATEROLE ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name NOCREATEROLE ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH NOCREATEROLE ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name INHERIT ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH INHERIT ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name NOINHERIT ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH NOINHERIT ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name LOGIN ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH LOGIN ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name NOLOGIN ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH NOLOGIN ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name REPLICATION ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH REPLICATION ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name NOREPLICATION ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH NOREPLICATION ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name BYPASSRLS ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH BYPASSRLS ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name NOBYPASSRLS ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH NOBYPASSRLS ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name CONNECTION LIMIT -1 ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH CONNECTION LIMIT -1 ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name ENCRYPTED PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name PASSWORD NULL ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH PASSWORD NULL ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name VALID UNTIL ' timestamp ' ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name WITH VALID UNTIL ' timestamp ' ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name SUPERUSER ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name WITH SUPERUSER ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name NOSUPERUSER ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name WITH NOSUPERUSER ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name CREATEDB ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name WITH CREATEDB ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name NOCREATEDB ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name WITH NOCREATEDB ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name CREATEROLE ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name WITH CREATEROLE ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name NOCREATEROLE ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name WITH NOCREATEROLE ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name INHERIT ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name WITH INHERIT ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name NOINHERIT ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name WITH NOINHERIT ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name LOGIN ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name WITH LOGIN ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name NOLOGIN ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name WITH NOLOGIN ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name REPLICATION ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name WITH REPLICATION ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name NOREPLICATION ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name WITH NOREPLICATION ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name BYPASSRLS ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name WITH BYPASSRLS ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name NOBYPASSRLS ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name WITH NOBYPASSRLS ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name CONNECTION LIMIT -1 ENCRYPTED PASSWORD ' password '"),
+		Converts("CREATE USER name WITH CONNECTION LIMIT -1 ENCRYPTED PASSWORD ' password '"),
+		Parses("CREATE USER name PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
+		Parses("CREATE USER name WITH PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
+		Parses("CREATE USER name ENCRYPTED PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
+		Parses("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' ENCRYPTED PASSWORD ' password '"),
+		Parses("CREATE USER name PASSWORD NULL ENCRYPTED PASSWORD ' password '"),
+		Parses("CREATE USER name WITH PASSWORD NULL ENCRYPTED PASSWORD ' password '"),
+		Parses("CREATE USER name VALID UNTIL ' timestamp ' ENCRYPTED PASSWORD ' password '"),
+		Parses("CREATE USER name WITH VALID UNTIL ' timestamp ' ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("CREATE USER name IN ROLE role_name ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("CREATE USER name WITH IN ROLE role_name ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("CREATE USER name IN ROLE role_name , role_name ENCRYPTED PASSWORD ' password '"),
@@ -1099,40 +1099,40 @@ func TestCreateUser(t *testing.T) {
 		Unimplemented("CREATE USER name WITH USER role_name , role_name ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("CREATE USER name SYSID 1 ENCRYPTED PASSWORD ' password '"),
 		Unimplemented("CREATE USER name WITH SYSID 1 ENCRYPTED PASSWORD ' password '"),
-		Unimplemented("CREATE USER name SUPERUSER PASSWORD NULL"),
-		Unimplemented("CREATE USER name WITH SUPERUSER PASSWORD NULL"),
-		Unimplemented("CREATE USER name NOSUPERUSER PASSWORD NULL"),
-		Unimplemented("CREATE USER name WITH NOSUPERUSER PASSWORD NULL"),
-		Parses("CREATE USER name CREATEDB PASSWORD NULL"),
-		Parses("CREATE USER name WITH CREATEDB PASSWORD NULL"),
-		Parses("CREATE USER name NOCREATEDB PASSWORD NULL"),
-		Parses("CREATE USER name WITH NOCREATEDB PASSWORD NULL"),
-		Parses("CREATE USER name CREATEROLE PASSWORD NULL"),
-		Parses("CREATE USER name WITH CREATEROLE PASSWORD NULL"),
-		Parses("CREATE USER name NOCREATEROLE PASSWORD NULL"),
-		Parses("CREATE USER name WITH NOCREATEROLE PASSWORD NULL"),
-		Unimplemented("CREATE USER name INHERIT PASSWORD NULL"),
-		Unimplemented("CREATE USER name WITH INHERIT PASSWORD NULL"),
-		Unimplemented("CREATE USER name NOINHERIT PASSWORD NULL"),
-		Unimplemented("CREATE USER name WITH NOINHERIT PASSWORD NULL"),
-		Parses("CREATE USER name LOGIN PASSWORD NULL"),
-		Parses("CREATE USER name WITH LOGIN PASSWORD NULL"),
-		Parses("CREATE USER name NOLOGIN PASSWORD NULL"),
-		Parses("CREATE USER name WITH NOLOGIN PASSWORD NULL"),
-		Unimplemented("CREATE USER name REPLICATION PASSWORD NULL"),
-		Unimplemented("CREATE USER name WITH REPLICATION PASSWORD NULL"),
-		Unimplemented("CREATE USER name NOREPLICATION PASSWORD NULL"),
-		Unimplemented("CREATE USER name WITH NOREPLICATION PASSWORD NULL"),
-		Unimplemented("CREATE USER name BYPASSRLS PASSWORD NULL"),
-		Unimplemented("CREATE USER name WITH BYPASSRLS PASSWORD NULL"),
-		Unimplemented("CREATE USER name NOBYPASSRLS PASSWORD NULL"),
-		Unimplemented("CREATE USER name WITH NOBYPASSRLS PASSWORD NULL"),
-		Unimplemented("CREATE USER name CONNECTION LIMIT -1 PASSWORD NULL"),
-		Unimplemented("CREATE USER name WITH CONNECTION LIMIT -1 PASSWORD NULL"),
+		Converts("CREATE USER name SUPERUSER PASSWORD NULL"),
+		Converts("CREATE USER name WITH SUPERUSER PASSWORD NULL"),
+		Converts("CREATE USER name NOSUPERUSER PASSWORD NULL"),
+		Converts("CREATE USER name WITH NOSUPERUSER PASSWORD NULL"),
+		Converts("CREATE USER name CREATEDB PASSWORD NULL"),
+		Converts("CREATE USER name WITH CREATEDB PASSWORD NULL"),
+		Converts("CREATE USER name NOCREATEDB PASSWORD NULL"),
+		Converts("CREATE USER name WITH NOCREATEDB PASSWORD NULL"),
+		Converts("CREATE USER name CREATEROLE PASSWORD NULL"),
+		Converts("CREATE USER name WITH CREATEROLE PASSWORD NULL"),
+		Converts("CREATE USER name NOCREATEROLE PASSWORD NULL"),
+		Converts("CREATE USER name WITH NOCREATEROLE PASSWORD NULL"),
+		Converts("CREATE USER name INHERIT PASSWORD NULL"),
+		Converts("CREATE USER name WITH INHERIT PASSWORD NULL"),
+		Converts("CREATE USER name NOINHERIT PASSWORD NULL"),
+		Converts("CREATE USER name WITH NOINHERIT PASSWORD NULL"),
+		Converts("CREATE USER name LOGIN PASSWORD NULL"),
+		Converts("CREATE USER name WITH LOGIN PASSWORD NULL"),
+		Converts("CREATE USER name NOLOGIN PASSWORD NULL"),
+		Converts("CREATE USER name WITH NOLOGIN PASSWORD NULL"),
+		Converts("CREATE USER name REPLICATION PASSWORD NULL"),
+		Converts("CREATE USER name WITH REPLICATION PASSWORD NULL"),
+		Converts("CREATE USER name NOREPLICATION PASSWORD NULL"),
+		Converts("CREATE USER name WITH NOREPLICATION PASSWORD NULL"),
+		Converts("CREATE USER name BYPASSRLS PASSWORD NULL"),
+		Converts("CREATE USER name WITH BYPASSRLS PASSWORD NULL"),
+		Converts("CREATE USER name NOBYPASSRLS PASSWORD NULL"),
+		Converts("CREATE USER name WITH NOBYPASSRLS PASSWORD NULL"),
+		Converts("CREATE USER name CONNECTION LIMIT -1 PASSWORD NULL"),
+		Converts("CREATE USER name WITH CONNECTION LIMIT -1 PASSWORD NULL"),
 		Parses("CREATE USER name PASSWORD ' password ' PASSWORD NULL"),
 		Parses("CREATE USER name WITH PASSWORD ' password ' PASSWORD NULL"),
-		Unimplemented("CREATE USER name ENCRYPTED PASSWORD ' password ' PASSWORD NULL"),
-		Unimplemented("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' PASSWORD NULL"),
+		Parses("CREATE USER name ENCRYPTED PASSWORD ' password ' PASSWORD NULL"),
+		Parses("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' PASSWORD NULL"),
 		Parses("CREATE USER name PASSWORD NULL PASSWORD NULL"),
 		Parses("CREATE USER name WITH PASSWORD NULL PASSWORD NULL"),
 		Parses("CREATE USER name VALID UNTIL ' timestamp ' PASSWORD NULL"),
@@ -1159,10 +1159,10 @@ func TestCreateUser(t *testing.T) {
 		Unimplemented("CREATE USER name WITH USER role_name , role_name PASSWORD NULL"),
 		Unimplemented("CREATE USER name SYSID 1 PASSWORD NULL"),
 		Unimplemented("CREATE USER name WITH SYSID 1 PASSWORD NULL"),
-		Unimplemented("CREATE USER name SUPERUSER VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE USER name WITH SUPERUSER VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE USER name NOSUPERUSER VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE USER name WITH NOSUPERUSER VALID UNTIL ' timestamp '"),
+		Parses("CREATE USER name SUPERUSER VALID UNTIL ' timestamp '"),
+		Parses("CREATE USER name WITH SUPERUSER VALID UNTIL ' timestamp '"),
+		Parses("CREATE USER name NOSUPERUSER VALID UNTIL ' timestamp '"),
+		Parses("CREATE USER name WITH NOSUPERUSER VALID UNTIL ' timestamp '"),
 		Parses("CREATE USER name CREATEDB VALID UNTIL ' timestamp '"),
 		Parses("CREATE USER name WITH CREATEDB VALID UNTIL ' timestamp '"),
 		Parses("CREATE USER name NOCREATEDB VALID UNTIL ' timestamp '"),
@@ -1171,28 +1171,28 @@ func TestCreateUser(t *testing.T) {
 		Parses("CREATE USER name WITH CREATEROLE VALID UNTIL ' timestamp '"),
 		Parses("CREATE USER name NOCREATEROLE VALID UNTIL ' timestamp '"),
 		Parses("CREATE USER name WITH NOCREATEROLE VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE USER name INHERIT VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE USER name WITH INHERIT VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE USER name NOINHERIT VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE USER name WITH NOINHERIT VALID UNTIL ' timestamp '"),
+		Parses("CREATE USER name INHERIT VALID UNTIL ' timestamp '"),
+		Parses("CREATE USER name WITH INHERIT VALID UNTIL ' timestamp '"),
+		Parses("CREATE USER name NOINHERIT VALID UNTIL ' timestamp '"),
+		Parses("CREATE USER name WITH NOINHERIT VALID UNTIL ' timestamp '"),
 		Parses("CREATE USER name LOGIN VALID UNTIL ' timestamp '"),
 		Parses("CREATE USER name WITH LOGIN VALID UNTIL ' timestamp '"),
 		Parses("CREATE USER name NOLOGIN VALID UNTIL ' timestamp '"),
 		Parses("CREATE USER name WITH NOLOGIN VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE USER name REPLICATION VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE USER name WITH REPLICATION VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE USER name NOREPLICATION VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE USER name WITH NOREPLICATION VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE USER name BYPASSRLS VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE USER name WITH BYPASSRLS VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE USER name NOBYPASSRLS VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE USER name WITH NOBYPASSRLS VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE USER name CONNECTION LIMIT -1 VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE USER name WITH CONNECTION LIMIT -1 VALID UNTIL ' timestamp '"),
+		Parses("CREATE USER name REPLICATION VALID UNTIL ' timestamp '"),
+		Parses("CREATE USER name WITH REPLICATION VALID UNTIL ' timestamp '"),
+		Parses("CREATE USER name NOREPLICATION VALID UNTIL ' timestamp '"),
+		Parses("CREATE USER name WITH NOREPLICATION VALID UNTIL ' timestamp '"),
+		Parses("CREATE USER name BYPASSRLS VALID UNTIL ' timestamp '"),
+		Parses("CREATE USER name WITH BYPASSRLS VALID UNTIL ' timestamp '"),
+		Parses("CREATE USER name NOBYPASSRLS VALID UNTIL ' timestamp '"),
+		Parses("CREATE USER name WITH NOBYPASSRLS VALID UNTIL ' timestamp '"),
+		Parses("CREATE USER name CONNECTION LIMIT -1 VALID UNTIL ' timestamp '"),
+		Parses("CREATE USER name WITH CONNECTION LIMIT -1 VALID UNTIL ' timestamp '"),
 		Parses("CREATE USER name PASSWORD ' password ' VALID UNTIL ' timestamp '"),
 		Parses("CREATE USER name WITH PASSWORD ' password ' VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE USER name ENCRYPTED PASSWORD ' password ' VALID UNTIL ' timestamp '"),
-		Unimplemented("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' VALID UNTIL ' timestamp '"),
+		Parses("CREATE USER name ENCRYPTED PASSWORD ' password ' VALID UNTIL ' timestamp '"),
+		Parses("CREATE USER name WITH ENCRYPTED PASSWORD ' password ' VALID UNTIL ' timestamp '"),
 		Parses("CREATE USER name PASSWORD NULL VALID UNTIL ' timestamp '"),
 		Parses("CREATE USER name WITH PASSWORD NULL VALID UNTIL ' timestamp '"),
 		Parses("CREATE USER name VALID UNTIL ' timestamp ' VALID UNTIL ' timestamp '"),
@@ -18,60 +18,60 @@ import "testing"
 
 func TestDeclare(t *testing.T) {
 	tests := []QueryParses{
-		Unimplemented("DECLARE name CURSOR FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY CURSOR FOR SELECT 1"),
-		Unimplemented("DECLARE name ASENSITIVE CURSOR FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY ASENSITIVE CURSOR FOR SELECT 1"),
-		Unimplemented("DECLARE name INSENSITIVE CURSOR FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY INSENSITIVE CURSOR FOR SELECT 1"),
-		Unimplemented("DECLARE name SCROLL CURSOR FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY SCROLL CURSOR FOR SELECT 1"),
-		Unimplemented("DECLARE name ASENSITIVE SCROLL CURSOR FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY ASENSITIVE SCROLL CURSOR FOR SELECT 1"),
-		Unimplemented("DECLARE name INSENSITIVE SCROLL CURSOR FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY INSENSITIVE SCROLL CURSOR FOR SELECT 1"),
-		Unimplemented("DECLARE name NO SCROLL CURSOR FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY NO SCROLL CURSOR FOR SELECT 1"),
-		Unimplemented("DECLARE name ASENSITIVE NO SCROLL CURSOR FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY ASENSITIVE NO SCROLL CURSOR FOR SELECT 1"),
-		Unimplemented("DECLARE name INSENSITIVE NO SCROLL CURSOR FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY INSENSITIVE NO SCROLL CURSOR FOR SELECT 1"),
-		Unimplemented("DECLARE name CURSOR WITH HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY CURSOR WITH HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name ASENSITIVE CURSOR WITH HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY ASENSITIVE CURSOR WITH HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name INSENSITIVE CURSOR WITH HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY INSENSITIVE CURSOR WITH HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name SCROLL CURSOR WITH HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY SCROLL CURSOR WITH HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name ASENSITIVE SCROLL CURSOR WITH HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY ASENSITIVE SCROLL CURSOR WITH HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name INSENSITIVE SCROLL CURSOR WITH HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY INSENSITIVE SCROLL CURSOR WITH HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name NO SCROLL CURSOR WITH HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY NO SCROLL CURSOR WITH HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name ASENSITIVE NO SCROLL CURSOR WITH HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY ASENSITIVE NO SCROLL CURSOR WITH HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name INSENSITIVE NO SCROLL CURSOR WITH HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY INSENSITIVE NO SCROLL CURSOR WITH HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name CURSOR WITHOUT HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY CURSOR WITHOUT HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name ASENSITIVE CURSOR WITHOUT HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY ASENSITIVE CURSOR WITHOUT HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name INSENSITIVE CURSOR WITHOUT HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY INSENSITIVE CURSOR WITHOUT HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name SCROLL CURSOR WITHOUT HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY SCROLL CURSOR WITHOUT HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name ASENSITIVE SCROLL CURSOR WITHOUT HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY ASENSITIVE SCROLL CURSOR WITHOUT HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name INSENSITIVE SCROLL CURSOR WITHOUT HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY INSENSITIVE SCROLL CURSOR WITHOUT HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name NO SCROLL CURSOR WITHOUT HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY NO SCROLL CURSOR WITHOUT HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name ASENSITIVE NO SCROLL CURSOR WITHOUT HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY ASENSITIVE NO SCROLL CURSOR WITHOUT HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name INSENSITIVE NO SCROLL CURSOR WITHOUT HOLD FOR SELECT 1"),
-		Unimplemented("DECLARE name BINARY INSENSITIVE NO SCROLL CURSOR WITHOUT HOLD FOR SELECT 1"),
+		Converts("DECLARE name CURSOR FOR SELECT 1"),
+		Parses("DECLARE name BINARY CURSOR FOR SELECT 1"),
+		Converts("DECLARE name ASENSITIVE CURSOR FOR SELECT 1"),
+		Parses("DECLARE name BINARY ASENSITIVE CURSOR FOR SELECT 1"),
+		Converts("DECLARE name INSENSITIVE CURSOR FOR SELECT 1"),
+		Parses("DECLARE name BINARY INSENSITIVE CURSOR FOR SELECT 1"),
+		Converts("DECLARE name SCROLL CURSOR FOR SELECT 1"),
+		Parses("DECLARE name BINARY SCROLL CURSOR FOR SELECT 1"),
+		Converts("DECLARE name ASENSITIVE SCROLL CURSOR FOR SELECT 1"),
+		Parses("DECLARE name BINARY ASENSITIVE SCROLL CURSOR FOR SELECT 1"),
+		Converts("DECLARE name INSENSITIVE SCROLL CURSOR FOR SELECT 1"),
+		Parses("DECLARE name BINARY INSENSITIVE SCROLL CURSOR FOR SELECT 1"),
+		Converts("DECLARE name NO SCROLL CURSOR FOR SELECT 1"),
+		Parses("DECLARE name BINARY NO SCROLL CURSOR FOR SELECT 1"),
+		Converts("DECLARE name ASENSITIVE NO SCROLL CURSOR FOR SELECT 1"),
+		Parses("DECLARE name BINARY ASENSITIVE NO SCROLL CURSOR FOR SELECT 1"),
+		Converts("DECLARE name INSENSITIVE NO SCROLL CURSOR FOR SELECT 1"),
+		Parses("DECLARE name BINARY INSENSITIVE NO SCROLL CURSOR FOR SELECT 1"),
+		Converts("DECLARE name CURSOR WITH HOLD FOR SELECT 1"),
+		Parses("DECLARE name BINARY CURSOR WITH HOLD FOR SELECT 1"),
+		Converts("DECLARE name ASENSITIVE CURSOR WITH HOLD FOR SELECT 1"),
+		Parses("DECLARE name BINARY ASENSITIVE CURSOR WITH HOLD FOR SELECT 1"),
+		Converts("DECLARE name INSENSITIVE CURSOR WITH HOLD FOR SELECT 1"),
+		Parses("DECLARE name BINARY INSENSITIVE CURSOR WITH HOLD FOR SELECT 1"),
+		Converts("DECLARE name SCROLL CURSOR WITH HOLD FOR SELECT 1"),
+		Parses("DECLARE name BINARY SCROLL CURSOR WITH HOLD FOR SELECT 1"),
+		Converts("DECLARE name ASENSITIVE SCROLL CURSOR WITH HOLD FOR SELECT 1"),
+		Parses("DECLARE name BINARY ASENSITIVE SCROLL CURSOR WITH HOLD FOR SELECT 1"),
+		Converts("DECLARE name INSENSITIVE SCROLL CURSOR WITH HOLD FOR SELECT 1"),
+		Parses("DECLARE name BINARY INSENSITIVE SCROLL CURSOR WITH HOLD FOR SELECT 1"),
+		Converts("DECLARE name NO SCROLL CURSOR WITH HOLD FOR SELECT 1"),
+		Parses("DECLARE name BINARY NO SCROLL CURSOR WITH HOLD FOR SELECT 1"),
+		Converts("DECLARE name ASENSITIVE NO SCROLL CURSOR WITH HOLD FOR SELECT 1"),
+		Parses("DECLARE name BINARY ASENSITIVE NO SCROLL CURSOR WITH HOLD FOR SELECT 1"),
+		Converts("DECLARE name INSENSITIVE NO SCROLL CURSOR WITH HOLD FOR SELECT 1"),
+		Parses("DECLARE name BINARY INSENSITIVE NO SCROLL CURSOR WITH HOLD FOR SELECT 1"),
+		Converts("DECLARE name CURSOR WITHOUT HOLD FOR SELECT 1"),
+		Parses("DECLARE name BINARY CURSOR WITHOUT HOLD FOR SELECT 1"),
+		Converts("DECLARE name ASENSITIVE CURSOR WITHOUT HOLD FOR SELECT 1"),
+		Parses("DECLARE name BINARY ASENSITIVE CURSOR WITHOUT HOLD FOR SELECT 1"),
+		Converts("DECLARE name INSENSITIVE CURSOR WITHOUT HOLD FOR SELECT 1"),
+		Parses("DECLARE name BINARY INSENSITIVE CURSOR WITHOUT HOLD FOR SELECT 1"),
+		Converts("DECLARE name SCROLL CURSOR WITHOUT HOLD FOR SELECT 1"),
+		Parses("DECLARE name BINARY SCROLL CURSOR WITHOUT HOLD FOR SELECT 1"),
+		Converts("DECLARE name ASENSITIVE SCROLL CURSOR WITHOUT HOLD FOR SELECT 1"),
+		Parses("DECLARE name BINARY ASENSITIVE SCROLL CURSOR WITHOUT HOLD FOR SELECT 1"),
+		Converts("DECLARE name INSENSITIVE SCROLL CURSOR WITHOUT HOLD FOR SELECT 1"),
+		Parses("DECLARE name BINARY INSENSITIVE SCROLL CURSOR WITHOUT HOLD FOR SELECT 1"),
+		Converts("DECLARE name NO SCROLL CURSOR WITHOUT HOLD FOR SELECT 1"),
+		Parses("DECLARE name BINARY NO SCROLL CURSOR WITHOUT HOLD FOR SELECT 1"),
+		Converts("DECLARE name ASENSITIVE NO SCROLL CURSOR WITHOUT HOLD FOR SELECT 1"),
+		Parses("DECLARE name BINARY ASENSITIVE NO SCROLL CURSOR WITHOUT HOLD FOR SELECT 1"),
+		Converts("DECLARE name INSENSITIVE NO SCROLL CURSOR WITHOUT HOLD FOR SELECT 1"),
+		Parses("DECLARE name BINARY INSENSITIVE NO SCROLL CURSOR WITHOUT HOLD FOR SELECT 1"),
 	}
 	RunTests(t, tests)
 }
@@ -428,7 +428,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING *"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING *"),
-		Parses("DELETE FROM table_name RETURNING *"),
+		Converts("DELETE FROM table_name RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name RETURNING *"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name RETURNING *"),
@@ -443,7 +443,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING *"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING *"),
-		Parses("DELETE FROM table_name alias RETURNING *"),
+		Converts("DELETE FROM table_name alias RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias RETURNING *"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias RETURNING *"),
@@ -458,7 +458,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING *"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING *"),
-		Parses("DELETE FROM table_name AS alias RETURNING *"),
+		Converts("DELETE FROM table_name AS alias RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias RETURNING *"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias RETURNING *"),
@@ -563,7 +563,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING *"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING *"),
-		Parses("DELETE FROM table_name WHERE condition RETURNING *"),
+		Converts("DELETE FROM table_name WHERE condition RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING *"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING *"),
@@ -578,7 +578,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING *"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING *"),
-		Parses("DELETE FROM table_name alias WHERE condition RETURNING *"),
+		Converts("DELETE FROM table_name alias WHERE condition RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING *"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING *"),
@@ -593,7 +593,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING *"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING *"),
-		Parses("DELETE FROM table_name AS alias WHERE condition RETURNING *"),
+		Converts("DELETE FROM table_name AS alias WHERE condition RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING *"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING *"),
@@ -833,7 +833,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname"),
-		Parses("DELETE FROM table_name RETURNING colname"),
+		Converts("DELETE FROM table_name RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name RETURNING colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name RETURNING colname"),
@@ -848,7 +848,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname"),
-		Parses("DELETE FROM table_name alias RETURNING colname"),
+		Converts("DELETE FROM table_name alias RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias RETURNING colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias RETURNING colname"),
@@ -863,7 +863,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname"),
-		Parses("DELETE FROM table_name AS alias RETURNING colname"),
+		Converts("DELETE FROM table_name AS alias RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname"),
@@ -968,7 +968,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname"),
-		Parses("DELETE FROM table_name WHERE condition RETURNING colname"),
+		Converts("DELETE FROM table_name WHERE condition RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname"),
@@ -983,7 +983,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname"),
-		Parses("DELETE FROM table_name alias WHERE condition RETURNING colname"),
+		Converts("DELETE FROM table_name alias WHERE condition RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname"),
@@ -998,7 +998,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname"),
-		Parses("DELETE FROM table_name AS alias WHERE condition RETURNING colname"),
+		Converts("DELETE FROM table_name AS alias WHERE condition RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname"),
@@ -1238,7 +1238,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname output_name"),
-		Parses("DELETE FROM table_name RETURNING colname output_name"),
+		Converts("DELETE FROM table_name RETURNING colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name RETURNING colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name RETURNING colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name RETURNING colname output_name"),
@@ -1253,7 +1253,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname output_name"),
-		Parses("DELETE FROM table_name alias RETURNING colname output_name"),
+		Converts("DELETE FROM table_name alias RETURNING colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias RETURNING colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias RETURNING colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias RETURNING colname output_name"),
@@ -1268,7 +1268,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname output_name"),
-		Parses("DELETE FROM table_name AS alias RETURNING colname output_name"),
+		Converts("DELETE FROM table_name AS alias RETURNING colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname output_name"),
@@ -1373,7 +1373,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname output_name"),
-		Parses("DELETE FROM table_name WHERE condition RETURNING colname output_name"),
+		Converts("DELETE FROM table_name WHERE condition RETURNING colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname output_name"),
@@ -1388,7 +1388,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname output_name"),
-		Parses("DELETE FROM table_name alias WHERE condition RETURNING colname output_name"),
+		Converts("DELETE FROM table_name alias WHERE condition RETURNING colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname output_name"),
@@ -1403,7 +1403,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname output_name"),
-		Parses("DELETE FROM table_name AS alias WHERE condition RETURNING colname output_name"),
+		Converts("DELETE FROM table_name AS alias WHERE condition RETURNING colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname output_name"),
@@ -1643,7 +1643,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname AS output_name"),
-		Parses("DELETE FROM table_name RETURNING colname AS output_name"),
+		Converts("DELETE FROM table_name RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name RETURNING colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name RETURNING colname AS output_name"),
@@ -1658,7 +1658,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname AS output_name"),
-		Parses("DELETE FROM table_name alias RETURNING colname AS output_name"),
+		Converts("DELETE FROM table_name alias RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias RETURNING colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias RETURNING colname AS output_name"),
@@ -1673,7 +1673,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname AS output_name"),
-		Parses("DELETE FROM table_name AS alias RETURNING colname AS output_name"),
+		Converts("DELETE FROM table_name AS alias RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname AS output_name"),
@@ -1778,7 +1778,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname AS output_name"),
-		Parses("DELETE FROM table_name WHERE condition RETURNING colname AS output_name"),
+		Converts("DELETE FROM table_name WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname AS output_name"),
@@ -1793,7 +1793,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname AS output_name"),
-		Parses("DELETE FROM table_name alias WHERE condition RETURNING colname AS output_name"),
+		Converts("DELETE FROM table_name alias WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname AS output_name"),
@@ -1808,7 +1808,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname AS output_name"),
-		Parses("DELETE FROM table_name AS alias WHERE condition RETURNING colname AS output_name"),
+		Converts("DELETE FROM table_name AS alias WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname AS output_name"),
@@ -2048,7 +2048,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname , colname"),
-		Parses("DELETE FROM table_name RETURNING colname , colname"),
+		Converts("DELETE FROM table_name RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name RETURNING colname , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name RETURNING colname , colname"),
@@ -2063,7 +2063,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname , colname"),
-		Parses("DELETE FROM table_name alias RETURNING colname , colname"),
+		Converts("DELETE FROM table_name alias RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias RETURNING colname , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias RETURNING colname , colname"),
@@ -2078,7 +2078,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname , colname"),
-		Parses("DELETE FROM table_name AS alias RETURNING colname , colname"),
+		Converts("DELETE FROM table_name AS alias RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname , colname"),
@@ -2183,7 +2183,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname , colname"),
-		Parses("DELETE FROM table_name WHERE condition RETURNING colname , colname"),
+		Converts("DELETE FROM table_name WHERE condition RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname , colname"),
@@ -2198,7 +2198,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname , colname"),
-		Parses("DELETE FROM table_name alias WHERE condition RETURNING colname , colname"),
+		Converts("DELETE FROM table_name alias WHERE condition RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname , colname"),
@@ -2213,7 +2213,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname , colname"),
-		Parses("DELETE FROM table_name AS alias WHERE condition RETURNING colname , colname"),
+		Converts("DELETE FROM table_name AS alias WHERE condition RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname , colname"),
@@ -2453,7 +2453,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname output_name , colname"),
-		Parses("DELETE FROM table_name RETURNING colname output_name , colname"),
+		Converts("DELETE FROM table_name RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name RETURNING colname output_name , colname"),
@@ -2468,7 +2468,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname output_name , colname"),
-		Parses("DELETE FROM table_name alias RETURNING colname output_name , colname"),
+		Converts("DELETE FROM table_name alias RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias RETURNING colname output_name , colname"),
@@ -2483,7 +2483,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname output_name , colname"),
-		Parses("DELETE FROM table_name AS alias RETURNING colname output_name , colname"),
+		Converts("DELETE FROM table_name AS alias RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname output_name , colname"),
@@ -2588,7 +2588,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname output_name , colname"),
-		Parses("DELETE FROM table_name WHERE condition RETURNING colname output_name , colname"),
+		Converts("DELETE FROM table_name WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname output_name , colname"),
@@ -2603,7 +2603,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname output_name , colname"),
-		Parses("DELETE FROM table_name alias WHERE condition RETURNING colname output_name , colname"),
+		Converts("DELETE FROM table_name alias WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname output_name , colname"),
@@ -2618,7 +2618,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname output_name , colname"),
-		Parses("DELETE FROM table_name AS alias WHERE condition RETURNING colname output_name , colname"),
+		Converts("DELETE FROM table_name AS alias WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname output_name , colname"),
@@ -2858,7 +2858,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname AS output_name , colname"),
-		Parses("DELETE FROM table_name RETURNING colname AS output_name , colname"),
+		Converts("DELETE FROM table_name RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name RETURNING colname AS output_name , colname"),
@@ -2873,7 +2873,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname AS output_name , colname"),
-		Parses("DELETE FROM table_name alias RETURNING colname AS output_name , colname"),
+		Converts("DELETE FROM table_name alias RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias RETURNING colname AS output_name , colname"),
@@ -2888,7 +2888,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname AS output_name , colname"),
-		Parses("DELETE FROM table_name AS alias RETURNING colname AS output_name , colname"),
+		Converts("DELETE FROM table_name AS alias RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname AS output_name , colname"),
@@ -2993,7 +2993,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname AS output_name , colname"),
-		Parses("DELETE FROM table_name WHERE condition RETURNING colname AS output_name , colname"),
+		Converts("DELETE FROM table_name WHERE condition RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname AS output_name , colname"),
@@ -3008,7 +3008,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname AS output_name , colname"),
-		Parses("DELETE FROM table_name alias WHERE condition RETURNING colname AS output_name , colname"),
+		Converts("DELETE FROM table_name alias WHERE condition RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname AS output_name , colname"),
@@ -3023,7 +3023,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname AS output_name , colname"),
-		Parses("DELETE FROM table_name AS alias WHERE condition RETURNING colname AS output_name , colname"),
+		Converts("DELETE FROM table_name AS alias WHERE condition RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname AS output_name , colname"),
@@ -3263,7 +3263,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname , colname output_name"),
-		Parses("DELETE FROM table_name RETURNING colname , colname output_name"),
+		Converts("DELETE FROM table_name RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name RETURNING colname , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name RETURNING colname , colname output_name"),
@@ -3278,7 +3278,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname , colname output_name"),
-		Parses("DELETE FROM table_name alias RETURNING colname , colname output_name"),
+		Converts("DELETE FROM table_name alias RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias RETURNING colname , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias RETURNING colname , colname output_name"),
@@ -3293,7 +3293,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname , colname output_name"),
-		Parses("DELETE FROM table_name AS alias RETURNING colname , colname output_name"),
+		Converts("DELETE FROM table_name AS alias RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname , colname output_name"),
@@ -3398,7 +3398,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname , colname output_name"),
-		Parses("DELETE FROM table_name WHERE condition RETURNING colname , colname output_name"),
+		Converts("DELETE FROM table_name WHERE condition RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname , colname output_name"),
@@ -3413,7 +3413,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname , colname output_name"),
-		Parses("DELETE FROM table_name alias WHERE condition RETURNING colname , colname output_name"),
+		Converts("DELETE FROM table_name alias WHERE condition RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname , colname output_name"),
@@ -3428,7 +3428,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname , colname output_name"),
-		Parses("DELETE FROM table_name AS alias WHERE condition RETURNING colname , colname output_name"),
+		Converts("DELETE FROM table_name AS alias WHERE condition RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname , colname output_name"),
@@ -3668,7 +3668,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname output_name , colname output_name"),
-		Parses("DELETE FROM table_name RETURNING colname output_name , colname output_name"),
+		Converts("DELETE FROM table_name RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name RETURNING colname output_name , colname output_name"),
@@ -3683,7 +3683,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname output_name , colname output_name"),
-		Parses("DELETE FROM table_name alias RETURNING colname output_name , colname output_name"),
+		Converts("DELETE FROM table_name alias RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias RETURNING colname output_name , colname output_name"),
@@ -3698,7 +3698,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname output_name , colname output_name"),
-		Parses("DELETE FROM table_name AS alias RETURNING colname output_name , colname output_name"),
+		Converts("DELETE FROM table_name AS alias RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname output_name , colname output_name"),
@@ -3803,7 +3803,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname output_name , colname output_name"),
-		Parses("DELETE FROM table_name WHERE condition RETURNING colname output_name , colname output_name"),
+		Converts("DELETE FROM table_name WHERE condition RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname output_name , colname output_name"),
@@ -3818,7 +3818,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname output_name , colname output_name"),
-		Parses("DELETE FROM table_name alias WHERE condition RETURNING colname output_name , colname output_name"),
+		Converts("DELETE FROM table_name alias WHERE condition RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname output_name , colname output_name"),
@@ -3833,7 +3833,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname output_name , colname output_name"),
-		Parses("DELETE FROM table_name AS alias WHERE condition RETURNING colname output_name , colname output_name"),
+		Converts("DELETE FROM table_name AS alias WHERE condition RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname output_name , colname output_name"),
@@ -4073,7 +4073,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname AS output_name , colname output_name"),
-		Parses("DELETE FROM table_name RETURNING colname AS output_name , colname output_name"),
+		Converts("DELETE FROM table_name RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name RETURNING colname AS output_name , colname output_name"),
@@ -4088,7 +4088,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname AS output_name , colname output_name"),
-		Parses("DELETE FROM table_name alias RETURNING colname AS output_name , colname output_name"),
+		Converts("DELETE FROM table_name alias RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias RETURNING colname AS output_name , colname output_name"),
@@ -4103,7 +4103,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname AS output_name , colname output_name"),
-		Parses("DELETE FROM table_name AS alias RETURNING colname AS output_name , colname output_name"),
+		Converts("DELETE FROM table_name AS alias RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname AS output_name , colname output_name"),
@@ -4208,7 +4208,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname AS output_name , colname output_name"),
-		Parses("DELETE FROM table_name WHERE condition RETURNING colname AS output_name , colname output_name"),
+		Converts("DELETE FROM table_name WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname AS output_name , colname output_name"),
@@ -4223,7 +4223,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname AS output_name , colname output_name"),
-		Parses("DELETE FROM table_name alias WHERE condition RETURNING colname AS output_name , colname output_name"),
+		Converts("DELETE FROM table_name alias WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname AS output_name , colname output_name"),
@@ -4238,7 +4238,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname AS output_name , colname output_name"),
-		Parses("DELETE FROM table_name AS alias WHERE condition RETURNING colname AS output_name , colname output_name"),
+		Converts("DELETE FROM table_name AS alias WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname AS output_name , colname output_name"),
@@ -4478,7 +4478,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname , colname AS output_name"),
-		Parses("DELETE FROM table_name RETURNING colname , colname AS output_name"),
+		Converts("DELETE FROM table_name RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name RETURNING colname , colname AS output_name"),
@@ -4493,7 +4493,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname , colname AS output_name"),
-		Parses("DELETE FROM table_name alias RETURNING colname , colname AS output_name"),
+		Converts("DELETE FROM table_name alias RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias RETURNING colname , colname AS output_name"),
@@ -4508,7 +4508,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname , colname AS output_name"),
-		Parses("DELETE FROM table_name AS alias RETURNING colname , colname AS output_name"),
+		Converts("DELETE FROM table_name AS alias RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname , colname AS output_name"),
@@ -4613,7 +4613,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname , colname AS output_name"),
-		Parses("DELETE FROM table_name WHERE condition RETURNING colname , colname AS output_name"),
+		Converts("DELETE FROM table_name WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname , colname AS output_name"),
@@ -4628,7 +4628,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname , colname AS output_name"),
-		Parses("DELETE FROM table_name alias WHERE condition RETURNING colname , colname AS output_name"),
+		Converts("DELETE FROM table_name alias WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname , colname AS output_name"),
@@ -4643,7 +4643,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname , colname AS output_name"),
-		Parses("DELETE FROM table_name AS alias WHERE condition RETURNING colname , colname AS output_name"),
+		Converts("DELETE FROM table_name AS alias WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname , colname AS output_name"),
@@ -4883,7 +4883,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname output_name , colname AS output_name"),
-		Parses("DELETE FROM table_name RETURNING colname output_name , colname AS output_name"),
+		Converts("DELETE FROM table_name RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name RETURNING colname output_name , colname AS output_name"),
@@ -4898,7 +4898,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname output_name , colname AS output_name"),
-		Parses("DELETE FROM table_name alias RETURNING colname output_name , colname AS output_name"),
+		Converts("DELETE FROM table_name alias RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias RETURNING colname output_name , colname AS output_name"),
@@ -4913,7 +4913,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname output_name , colname AS output_name"),
-		Parses("DELETE FROM table_name AS alias RETURNING colname output_name , colname AS output_name"),
+		Converts("DELETE FROM table_name AS alias RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname output_name , colname AS output_name"),
@@ -5018,7 +5018,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname output_name , colname AS output_name"),
-		Parses("DELETE FROM table_name WHERE condition RETURNING colname output_name , colname AS output_name"),
+		Converts("DELETE FROM table_name WHERE condition RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname output_name , colname AS output_name"),
@@ -5033,7 +5033,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname output_name , colname AS output_name"),
-		Parses("DELETE FROM table_name alias WHERE condition RETURNING colname output_name , colname AS output_name"),
+		Converts("DELETE FROM table_name alias WHERE condition RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname output_name , colname AS output_name"),
@@ -5048,7 +5048,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname output_name , colname AS output_name"),
-		Parses("DELETE FROM table_name AS alias WHERE condition RETURNING colname output_name , colname AS output_name"),
+		Converts("DELETE FROM table_name AS alias WHERE condition RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname output_name , colname AS output_name"),
@@ -5288,7 +5288,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name RETURNING colname AS output_name , colname AS output_name"),
-		Parses("DELETE FROM table_name RETURNING colname AS output_name , colname AS output_name"),
+		Converts("DELETE FROM table_name RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name RETURNING colname AS output_name , colname AS output_name"),
@@ -5303,7 +5303,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias RETURNING colname AS output_name , colname AS output_name"),
-		Parses("DELETE FROM table_name alias RETURNING colname AS output_name , colname AS output_name"),
+		Converts("DELETE FROM table_name alias RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias RETURNING colname AS output_name , colname AS output_name"),
@@ -5318,7 +5318,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias RETURNING colname AS output_name , colname AS output_name"),
-		Parses("DELETE FROM table_name AS alias RETURNING colname AS output_name , colname AS output_name"),
+		Converts("DELETE FROM table_name AS alias RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias RETURNING colname AS output_name , colname AS output_name"),
@@ -5423,7 +5423,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name WHERE condition RETURNING colname AS output_name , colname AS output_name"),
-		Parses("DELETE FROM table_name WHERE condition RETURNING colname AS output_name , colname AS output_name"),
+		Converts("DELETE FROM table_name WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name WHERE condition RETURNING colname AS output_name , colname AS output_name"),
@@ -5438,7 +5438,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name alias WHERE condition RETURNING colname AS output_name , colname AS output_name"),
-		Parses("DELETE FROM table_name alias WHERE condition RETURNING colname AS output_name , colname AS output_name"),
+		Converts("DELETE FROM table_name alias WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name alias WHERE condition RETURNING colname AS output_name , colname AS output_name"),
@@ -5453,7 +5453,7 @@ func TestDelete(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) DELETE FROM ONLY table_name AS alias WHERE condition RETURNING colname AS output_name , colname AS output_name"),
-		Parses("DELETE FROM table_name AS alias WHERE condition RETURNING colname AS output_name , colname AS output_name"),
+		Converts("DELETE FROM table_name AS alias WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) DELETE FROM table_name AS alias WHERE condition RETURNING colname AS output_name , colname AS output_name"),
@@ -18,18 +18,18 @@ import "testing"
 
 func TestDropForeignTable(t *testing.T) {
 	tests := []QueryParses{
-		Unimplemented("DROP FOREIGN TABLE name"),
-		Unimplemented("DROP FOREIGN TABLE IF EXISTS name"),
-		Unimplemented("DROP FOREIGN TABLE name , name"),
-		Unimplemented("DROP FOREIGN TABLE IF EXISTS name , name"),
-		Unimplemented("DROP FOREIGN TABLE name CASCADE"),
-		Unimplemented("DROP FOREIGN TABLE IF EXISTS name CASCADE"),
-		Unimplemented("DROP FOREIGN TABLE name , name CASCADE"),
-		Unimplemented("DROP FOREIGN TABLE IF EXISTS name , name CASCADE"),
-		Unimplemented("DROP FOREIGN TABLE name RESTRICT"),
-		Unimplemented("DROP FOREIGN TABLE IF EXISTS name RESTRICT"),
-		Unimplemented("DROP FOREIGN TABLE name , name RESTRICT"),
-		Unimplemented("DROP FOREIGN TABLE IF EXISTS name , name RESTRICT"),
+		Converts("DROP FOREIGN TABLE name"),
+		Converts("DROP FOREIGN TABLE IF EXISTS name"),
+		Converts("DROP FOREIGN TABLE name , name"),
+		Converts("DROP FOREIGN TABLE IF EXISTS name , name"),
+		Parses("DROP FOREIGN TABLE name CASCADE"),
+		Parses("DROP FOREIGN TABLE IF EXISTS name CASCADE"),
+		Parses("DROP FOREIGN TABLE name , name CASCADE"),
+		Parses("DROP FOREIGN TABLE IF EXISTS name , name CASCADE"),
+		Converts("DROP FOREIGN TABLE name RESTRICT"),
+		Converts("DROP FOREIGN TABLE IF EXISTS name RESTRICT"),
+		Converts("DROP FOREIGN TABLE name , name RESTRICT"),
+		Converts("DROP FOREIGN TABLE IF EXISTS name , name RESTRICT"),
 	}
 	RunTests(t, tests)
 }
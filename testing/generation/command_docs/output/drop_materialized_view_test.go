@@ -22,14 +22,14 @@ func TestDropMaterializedView(t *testing.T) {
 		Converts("DROP MATERIALIZED VIEW IF EXISTS name"),
 		Converts("DROP MATERIALIZED VIEW name , name"),
 		Converts("DROP MATERIALIZED VIEW IF EXISTS name , name"),
-		Parses("DROP MATERIALIZED VIEW name CASCADE"),
-		Parses("DROP MATERIALIZED VIEW IF EXISTS name CASCADE"),
-		Parses("DROP MATERIALIZED VIEW name , name CASCADE"),
-		Parses("DROP MATERIALIZED VIEW IF EXISTS name , name CASCADE"),
-		Parses("DROP MATERIALIZED VIEW name RESTRICT"),
-		Parses("DROP MATERIALIZED VIEW IF EXISTS name RESTRICT"),
-		Parses("DROP MATERIALIZED VIEW name , name RESTRICT"),
-		Parses("DROP MATERIALIZED VIEW IF EXISTS name , name RESTRICT"),
+		Converts("DROP MATERIALIZED VIEW name CASCADE"),
+		Converts("DROP MATERIALIZED VIEW IF EXISTS name CASCADE"),
+		Converts("DROP MATERIALIZED VIEW name , name CASCADE"),
+		Converts("DROP MATERIALIZED VIEW IF EXISTS name , name CASCADE"),
+		Converts("DROP MATERIALIZED VIEW name RESTRICT"),
+		Converts("DROP MATERIALIZED VIEW IF EXISTS name RESTRICT"),
+		Converts("DROP MATERIALIZED VIEW name , name RESTRICT"),
+		Converts("DROP MATERIALIZED VIEW IF EXISTS name , name RESTRICT"),
 	}
 	RunTests(t, tests)
 }
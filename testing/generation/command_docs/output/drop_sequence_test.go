@@ -20,16 +20,16 @@ func TestDropSequence(t *testing.T) {
 	tests := []QueryParses{
 		Converts("DROP SEQUENCE name"),
 		Converts("DROP SEQUENCE IF EXISTS name"),
-		Parses("DROP SEQUENCE name , name"),
-		Parses("DROP SEQUENCE IF EXISTS name , name"),
+		Converts("DROP SEQUENCE name , name"),
+		Converts("DROP SEQUENCE IF EXISTS name , name"),
 		Converts("DROP SEQUENCE name CASCADE"),
 		Converts("DROP SEQUENCE IF EXISTS name CASCADE"),
-		Parses("DROP SEQUENCE name , name CASCADE"),
-		Parses("DROP SEQUENCE IF EXISTS name , name CASCADE"),
+		Converts("DROP SEQUENCE name , name CASCADE"),
+		Converts("DROP SEQUENCE IF EXISTS name , name CASCADE"),
 		Converts("DROP SEQUENCE name RESTRICT"),
 		Converts("DROP SEQUENCE IF EXISTS name RESTRICT"),
-		Parses("DROP SEQUENCE name , name RESTRICT"),
-		Parses("DROP SEQUENCE IF EXISTS name , name RESTRICT"),
+		Converts("DROP SEQUENCE name , name RESTRICT"),
+		Converts("DROP SEQUENCE IF EXISTS name , name RESTRICT"),
 	}
 	RunTests(t, tests)
 }
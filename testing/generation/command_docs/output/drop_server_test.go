@@ -18,18 +18,18 @@ import "testing"
 
 func TestDropServer(t *testing.T) {
 	tests := []QueryParses{
-		Unimplemented("DROP SERVER name"),
-		Unimplemented("DROP SERVER IF EXISTS name"),
-		Unimplemented("DROP SERVER name , name"),
-		Unimplemented("DROP SERVER IF EXISTS name , name"),
-		Unimplemented("DROP SERVER name CASCADE"),
-		Unimplemented("DROP SERVER IF EXISTS name CASCADE"),
-		Unimplemented("DROP SERVER name , name CASCADE"),
-		Unimplemented("DROP SERVER IF EXISTS name , name CASCADE"),
-		Unimplemented("DROP SERVER name RESTRICT"),
-		Unimplemented("DROP SERVER IF EXISTS name RESTRICT"),
-		Unimplemented("DROP SERVER name , name RESTRICT"),
-		Unimplemented("DROP SERVER IF EXISTS name , name RESTRICT"),
+		Converts("DROP SERVER name"),
+		Converts("DROP SERVER IF EXISTS name"),
+		Converts("DROP SERVER name , name"),
+		Converts("DROP SERVER IF EXISTS name , name"),
+		Parses("DROP SERVER name CASCADE"),
+		Parses("DROP SERVER IF EXISTS name CASCADE"),
+		Parses("DROP SERVER name , name CASCADE"),
+		Parses("DROP SERVER IF EXISTS name , name CASCADE"),
+		Converts("DROP SERVER name RESTRICT"),
+		Converts("DROP SERVER IF EXISTS name RESTRICT"),
+		Converts("DROP SERVER name , name RESTRICT"),
+		Converts("DROP SERVER IF EXISTS name , name RESTRICT"),
 	}
 	RunTests(t, tests)
 }
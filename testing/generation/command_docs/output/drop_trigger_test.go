@@ -22,8 +22,8 @@ func TestDropTrigger(t *testing.T) {
 		Converts("DROP TRIGGER IF EXISTS name ON table_name"),
 		Parses("DROP TRIGGER name ON table_name CASCADE"),
 		Parses("DROP TRIGGER IF EXISTS name ON table_name CASCADE"),
-		Parses("DROP TRIGGER name ON table_name RESTRICT"),
-		Parses("DROP TRIGGER IF EXISTS name ON table_name RESTRICT"),
+		Converts("DROP TRIGGER name ON table_name RESTRICT"),
+		Converts("DROP TRIGGER IF EXISTS name ON table_name RESTRICT"),
 	}
 	RunTests(t, tests)
 }
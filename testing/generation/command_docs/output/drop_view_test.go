@@ -22,14 +22,14 @@ func TestDropView(t *testing.T) {
 		Converts("DROP VIEW IF EXISTS name"),
 		Converts("DROP VIEW name , name"),
 		Converts("DROP VIEW IF EXISTS name , name"),
-		Parses("DROP VIEW name CASCADE"),
-		Parses("DROP VIEW IF EXISTS name CASCADE"),
-		Parses("DROP VIEW name , name CASCADE"),
-		Parses("DROP VIEW IF EXISTS name , name CASCADE"),
-		Parses("DROP VIEW name RESTRICT"),
-		Parses("DROP VIEW IF EXISTS name RESTRICT"),
-		Parses("DROP VIEW name , name RESTRICT"),
-		Parses("DROP VIEW IF EXISTS name , name RESTRICT"),
+		Converts("DROP VIEW name CASCADE"),
+		Converts("DROP VIEW IF EXISTS name CASCADE"),
+		Converts("DROP VIEW name , name CASCADE"),
+		Converts("DROP VIEW IF EXISTS name , name CASCADE"),
+		Converts("DROP VIEW name RESTRICT"),
+		Converts("DROP VIEW IF EXISTS name RESTRICT"),
+		Converts("DROP VIEW name , name RESTRICT"),
+		Converts("DROP VIEW IF EXISTS name , name RESTRICT"),
 	}
 	RunTests(t, tests)
 }
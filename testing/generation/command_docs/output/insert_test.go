@@ -268,7 +268,7 @@ func TestInsert(t *testing.T) {
 		Unimplemented("INSERT INTO table_name ( column_name ) VALUES ( expression , expression ) , ( expression , DEFAULT ) ON CONFLICT ( ( index_expression ) , index_column_name opclass ) WHERE index_predicate DO UPDATE SET column_name = DEFAULT , ( column_name ) = ( DEFAULT , expression )"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name ( column_name , column_name ) VALUES ( DEFAULT ) , ( DEFAULT ) ON CONFLICT ( ( index_expression ) COLLATE en_US opclass , index_column_name COLLATE en_US ) WHERE index_predicate DO UPDATE SET ( column_name ) = ( expression ) , ( column_name ) = ( DEFAULT , expression )"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name ( column_name , column_name ) VALUES ( DEFAULT ) , ( DEFAULT , expression ) ON CONFLICT ( ( index_expression ) COLLATE en_US opclass , ( index_expression ) COLLATE en_US opclass ) DO UPDATE SET ( column_name , column_name ) = ( expression ) , ( column_name ) = ( DEFAULT , expression )"),
-		Unimplemented("INSERT INTO table_name ( column_name ) VALUES ( expression ) ON CONFLICT ON CONSTRAINT constraint_name DO UPDATE SET ( column_name , column_name ) = ( expression ) , ( column_name ) = ( DEFAULT , expression )"),
+		Converts("INSERT INTO table_name ( column_name ) VALUES ( expression ) ON CONFLICT ON CONSTRAINT constraint_name DO UPDATE SET ( column_name , column_name ) = ( expression ) , ( column_name ) = ( DEFAULT , expression )"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name AS alias ( column_name , column_name ) VALUES ( expression , expression ) ON CONFLICT ( index_column_name , index_column_name COLLATE en_US opclass ) WHERE index_predicate DO UPDATE SET ( column_name ) = ROW ( expression ) , ( column_name ) = ( DEFAULT , expression )"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) INSERT INTO table_name AS alias ( column_name ) VALUES ( expression , DEFAULT ) , ( expression ) ON CONFLICT ( ( index_expression ) , index_column_name COLLATE en_US ) DO UPDATE SET ( column_name , column_name ) = ( DEFAULT ) , ( column_name ) = ( DEFAULT , expression )"),
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name ( column_name , column_name ) VALUES ( DEFAULT , DEFAULT ) ON CONFLICT ( ( index_expression ) , ( index_expression ) COLLATE en_US ) DO UPDATE SET ( column_name ) = ( expression , expression ) , ( column_name ) = ( DEFAULT , expression )"),
@@ -329,7 +329,7 @@ func TestInsert(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name AS alias ( column_name ) VALUES ( expression ) , ( expression , DEFAULT ) ON CONFLICT ( ( index_expression ) opclass , index_column_name COLLATE en_US ) DO UPDATE SET ( column_name ) = ( DEFAULT ) , ( column_name , column_name ) = ( expression , DEFAULT )"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name ( column_name ) VALUES ( expression ) , ( expression ) ON CONFLICT ( ( index_expression ) COLLATE en_US opclass , ( index_expression ) COLLATE en_US ) WHERE index_predicate DO UPDATE SET ( column_name ) = ROW ( DEFAULT ) , ( column_name , column_name ) = ( expression , DEFAULT )"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) INSERT INTO table_name AS alias ( column_name ) VALUES ( expression , DEFAULT ) ON CONFLICT ( ( index_expression ) opclass , index_column_name COLLATE en_US opclass ) WHERE index_predicate DO UPDATE SET ( column_name ) = ROW ( DEFAULT ) , ( column_name , column_name ) = ( expression , DEFAULT )"),
-		Unimplemented("INSERT INTO table_name ( column_name ) VALUES ( expression , expression ) , ( expression ) ON CONFLICT ON CONSTRAINT constraint_name DO UPDATE SET ( column_name , column_name ) = ( expression , expression ) , ( column_name , column_name ) = ( expression , DEFAULT )"),
+		Converts("INSERT INTO table_name ( column_name ) VALUES ( expression , expression ) , ( expression ) ON CONFLICT ON CONSTRAINT constraint_name DO UPDATE SET ( column_name , column_name ) = ( expression , expression ) , ( column_name , column_name ) = ( expression , DEFAULT )"),
 		Unimplemented("INSERT INTO table_name AS alias VALUES ( DEFAULT , expression ) ON CONFLICT ( index_column_name COLLATE en_US opclass , index_column_name COLLATE en_US ) DO UPDATE SET ( column_name , column_name ) = ROW ( DEFAULT , expression ) , ( column_name , column_name ) = ( expression , DEFAULT )"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) INSERT INTO table_name AS alias VALUES ( expression , expression ) , ( expression , DEFAULT ) ON CONFLICT ( ( index_expression ) , index_column_name COLLATE en_US opclass ) DO UPDATE SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ( expression , DEFAULT )"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name ( column_name ) VALUES ( DEFAULT , DEFAULT ) , ( expression , DEFAULT ) ON CONFLICT ( index_column_name opclass , ( index_expression ) COLLATE en_US opclass ) DO UPDATE SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ( expression , DEFAULT )"),
@@ -791,7 +791,7 @@ func TestInsert(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name ( column_name ) VALUES ( expression ) , ( DEFAULT , expression ) ON CONFLICT ( ( index_expression ) , index_column_name COLLATE en_US ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ( SELECT 1 ) , column_name = DEFAULT RETURNING *"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name AS alias ( column_name , column_name ) VALUES ( expression , expression ) , ( expression , DEFAULT ) ON CONFLICT ( ( index_expression ) COLLATE en_US opclass , ( index_expression ) COLLATE en_US opclass ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ( expression ) , ( column_name ) = ( expression ) RETURNING *"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name AS alias ( column_name , column_name ) VALUES ( expression , expression ) , ( expression , DEFAULT ) ON CONFLICT ( index_column_name opclass , index_column_name opclass ) WHERE index_predicate DO UPDATE SET ( column_name ) = ROW ( expression ) , ( column_name ) = ( expression ) RETURNING *"),
-		Parses("INSERT INTO table_name ( column_name , column_name ) VALUES ( DEFAULT ) , ( DEFAULT ) ON CONFLICT ( index_column_name , index_column_name ) WHERE index_predicate DO UPDATE SET ( column_name ) = ( DEFAULT ) , ( column_name ) = ( expression ) RETURNING *"),
+		Converts("INSERT INTO table_name ( column_name , column_name ) VALUES ( DEFAULT ) , ( DEFAULT ) ON CONFLICT ( index_column_name , index_column_name ) WHERE index_predicate DO UPDATE SET ( column_name ) = ( DEFAULT ) , ( column_name ) = ( expression ) RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name ( column_name ) VALUES ( expression ) ON CONFLICT ( ( index_expression ) opclass , ( index_expression ) COLLATE en_US ) DO UPDATE SET ( column_name , column_name ) = ( DEFAULT ) , ( column_name ) = ( expression ) RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name ( column_name , column_name ) VALUES ( expression , expression ) , ( expression , expression ) ON CONFLICT ( index_column_name COLLATE en_US opclass , index_column_name COLLATE en_US opclass ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ROW ( DEFAULT ) , ( column_name ) = ( expression ) RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name ( column_name ) VALUES ( expression ) , ( expression ) ON CONFLICT ( index_column_name COLLATE en_US opclass , index_column_name COLLATE en_US ) WHERE index_predicate DO UPDATE SET ( column_name ) = ( expression , expression ) , ( column_name ) = ( expression ) RETURNING *"),
@@ -1424,7 +1424,7 @@ func TestInsert(t *testing.T) {
 		Unimplemented("INSERT INTO table_name AS alias ( column_name , column_name ) VALUES ( expression ) , ( expression , expression ) ON CONFLICT ( index_column_name , index_column_name ) WHERE index_predicate DO UPDATE SET ( column_name ) = ROW ( DEFAULT , expression ) RETURNING colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name ( column_name ) VALUES ( DEFAULT , DEFAULT ) , ( expression ) ON CONFLICT ( index_column_name opclass , ( index_expression ) COLLATE en_US opclass ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ROW ( DEFAULT , expression ) RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name VALUES ( DEFAULT ) , ( DEFAULT ) ON CONFLICT ( ( index_expression ) COLLATE en_US , ( index_expression ) ) DO UPDATE SET ( column_name ) = ( expression , DEFAULT ) RETURNING colname"),
-		Parses("INSERT INTO table_name ( column_name , column_name ) VALUES ( DEFAULT , expression ) , ( expression , DEFAULT ) ON CONFLICT ( index_column_name , index_column_name ) DO UPDATE SET ( column_name ) = ( SELECT 1 ) RETURNING colname"),
+		Converts("INSERT INTO table_name ( column_name , column_name ) VALUES ( DEFAULT , expression ) , ( expression , DEFAULT ) ON CONFLICT ( index_column_name , index_column_name ) DO UPDATE SET ( column_name ) = ( SELECT 1 ) RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name ( column_name ) VALUES ( DEFAULT , DEFAULT ) , ( DEFAULT , expression ) ON CONFLICT ( ( index_expression ) opclass , ( index_expression ) ) WHERE index_predicate DO UPDATE SET ( column_name ) = ( SELECT 1 ) RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name VALUES ( DEFAULT , DEFAULT ) , ( expression , expression ) ON CONFLICT ( index_column_name COLLATE en_US , ( index_expression ) COLLATE en_US opclass ) WHERE index_predicate DO UPDATE SET ( column_name ) = ( expression ) , column_name = expression RETURNING colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name AS alias ( column_name ) VALUES ( expression , DEFAULT ) ON CONFLICT ( ( index_expression ) COLLATE en_US , ( index_expression ) opclass ) DO UPDATE SET ( column_name ) = ROW ( DEFAULT ) , column_name = expression RETURNING colname"),
@@ -1951,8 +1951,8 @@ func TestInsert(t *testing.T) {
 		Unimplemented("INSERT INTO table_name AS alias ( column_name ) VALUES ( DEFAULT , expression ) , ( expression ) ON CONFLICT ( ( index_expression ) opclass ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name AS alias ( column_name , column_name ) VALUES ( DEFAULT ) , ( expression , expression ) ON CONFLICT ( ( index_expression ) opclass , index_column_name COLLATE en_US opclass ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name AS alias ( column_name , column_name ) VALUES ( expression ) , ( DEFAULT , expression ) ON CONFLICT ( ( index_expression ) COLLATE en_US opclass , index_column_name COLLATE en_US ) DO UPDATE SET ( column_name ) = ROW ( DEFAULT , DEFAULT ) , ( column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname"),
-		Unimplemented("INSERT INTO table_name ( column_name , column_name ) VALUES ( expression ) ON CONFLICT ON CONSTRAINT constraint_name DO UPDATE SET ( column_name , column_name ) = ( SELECT 1 ) , ( column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname"),
-		Parses("INSERT INTO table_name VALUES ( expression , DEFAULT ) , ( DEFAULT , expression ) ON CONFLICT ( index_column_name , index_column_name ) WHERE index_predicate DO UPDATE SET ( column_name ) = ( expression ) , ( column_name , column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname"),
+		Converts("INSERT INTO table_name ( column_name , column_name ) VALUES ( expression ) ON CONFLICT ON CONSTRAINT constraint_name DO UPDATE SET ( column_name , column_name ) = ( SELECT 1 ) , ( column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname"),
+		Converts("INSERT INTO table_name VALUES ( expression , DEFAULT ) , ( DEFAULT , expression ) ON CONFLICT ( index_column_name , index_column_name ) WHERE index_predicate DO UPDATE SET ( column_name ) = ( expression ) , ( column_name , column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name AS alias ( column_name ) VALUES ( DEFAULT , expression ) , ( expression , DEFAULT ) ON CONFLICT ( index_column_name opclass , ( index_expression ) opclass ) WHERE index_predicate DO UPDATE SET ( column_name ) = ( expression ) , ( column_name , column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname"),
 		Unimplemented("INSERT INTO table_name AS alias VALUES ( DEFAULT , DEFAULT ) , ( expression , DEFAULT ) ON CONFLICT ( index_column_name COLLATE en_US opclass ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ( expression , expression ) , ( column_name , column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name ( column_name ) VALUES ( DEFAULT , expression ) , ( DEFAULT , DEFAULT ) ON CONFLICT ( ( index_expression ) COLLATE en_US opclass , ( index_expression ) COLLATE en_US opclass ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ( expression , expression ) , ( column_name , column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname"),
@@ -2817,7 +2817,7 @@ func TestInsert(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name AS alias ( column_name ) VALUES ( DEFAULT , expression ) , ( DEFAULT , DEFAULT ) ON CONFLICT ( index_column_name COLLATE en_US , ( index_expression ) COLLATE en_US ) WHERE index_predicate DO UPDATE SET ( column_name ) = ROW ( expression , DEFAULT ) , ( column_name , column_name ) = ROW ( DEFAULT ) RETURNING colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name ( column_name ) VALUES ( expression ) ON CONFLICT ( ( index_expression ) opclass , index_column_name opclass ) DO UPDATE SET ( column_name ) = ROW ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ROW ( DEFAULT ) RETURNING colname AS output_name"),
 		Unimplemented("INSERT INTO table_name ( column_name , column_name ) VALUES ( expression , expression ) , ( DEFAULT , DEFAULT ) ON CONFLICT ( ( index_expression ) , index_column_name opclass ) WHERE index_predicate DO UPDATE SET ( column_name ) = ( SELECT 1 ) , ( column_name , column_name ) = ROW ( DEFAULT ) RETURNING colname AS output_name"),
-		Parses("INSERT INTO table_name ( column_name ) VALUES ( DEFAULT , expression ) , ( DEFAULT , DEFAULT ) ON CONFLICT ( index_column_name , index_column_name ) DO UPDATE SET ( column_name , column_name ) = ( expression ) , ( column_name ) = ( expression , expression ) RETURNING colname AS output_name"),
+		Converts("INSERT INTO table_name ( column_name ) VALUES ( DEFAULT , expression ) , ( DEFAULT , DEFAULT ) ON CONFLICT ( index_column_name , index_column_name ) DO UPDATE SET ( column_name , column_name ) = ( expression ) , ( column_name ) = ( expression , expression ) RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name ( column_name ) VALUES ( expression , expression ) ON CONFLICT ( index_column_name , index_column_name opclass ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ROW ( expression ) , ( column_name ) = ( expression , expression ) RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name AS alias ( column_name , column_name ) SELECT 1 ON CONFLICT ( index_column_name opclass , index_column_name COLLATE en_US ) DO UPDATE SET ( column_name ) = ( expression , expression ) , ( column_name ) = ( expression , expression ) RETURNING colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) INSERT INTO table_name AS alias ( column_name , column_name ) VALUES ( expression ) , ( DEFAULT , DEFAULT ) ON CONFLICT ( ( index_expression ) opclass , ( index_expression ) COLLATE en_US ) DO UPDATE SET ( column_name , column_name ) = ( expression , expression ) , ( column_name ) = ( expression , expression ) RETURNING colname AS output_name"),
@@ -5325,7 +5325,7 @@ func TestInsert(t *testing.T) {
 		Unimplemented("INSERT INTO table_name ( column_name , column_name ) VALUES ( DEFAULT , expression ) , ( expression , expression ) ON CONFLICT ( index_column_name COLLATE en_US opclass , index_column_name opclass ) DO UPDATE SET ( column_name , column_name ) = ( expression , expression ) RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name ( column_name ) VALUES ( expression ) , ( expression ) ON CONFLICT ( index_column_name opclass , index_column_name ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ROW ( expression , expression ) RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name AS alias ( column_name , column_name ) VALUES ( DEFAULT , DEFAULT ) , ( DEFAULT , DEFAULT ) ON CONFLICT ( ( index_expression ) opclass , ( index_expression ) opclass ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ROW ( expression , expression ) RETURNING colname , colname output_name"),
-		Parses("INSERT INTO table_name ( column_name , column_name ) VALUES ( expression , expression ) ON CONFLICT ( index_column_name , index_column_name ) DO UPDATE SET ( column_name ) = ( DEFAULT , expression ) RETURNING colname , colname output_name"),
+		Converts("INSERT INTO table_name ( column_name , column_name ) VALUES ( expression , expression ) ON CONFLICT ( index_column_name , index_column_name ) DO UPDATE SET ( column_name ) = ( DEFAULT , expression ) RETURNING colname , colname output_name"),
 		Unimplemented("INSERT INTO table_name VALUES ( expression , DEFAULT ) , ( expression , DEFAULT ) ON CONFLICT ( index_column_name opclass , index_column_name COLLATE en_US ) DO UPDATE SET ( column_name ) = ROW ( DEFAULT , expression ) RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name VALUES ( DEFAULT ) , ( DEFAULT , DEFAULT ) ON CONFLICT ( index_column_name COLLATE en_US ) DO UPDATE SET ( column_name , column_name ) = ROW ( DEFAULT , expression ) RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name AS alias ( column_name , column_name ) VALUES ( expression , DEFAULT ) , ( DEFAULT , expression ) ON CONFLICT ( index_column_name COLLATE en_US opclass ) WHERE index_predicate DO UPDATE SET ( column_name ) = ( expression , DEFAULT ) RETURNING colname , colname output_name"),
@@ -5450,7 +5450,7 @@ func TestInsert(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name AS alias VALUES ( DEFAULT , expression ) , ( DEFAULT , expression ) ON CONFLICT ( index_column_name COLLATE en_US ) WHERE index_predicate DO UPDATE SET ( column_name ) = ( DEFAULT ) , ( column_name ) = ( expression , expression ) RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name AS alias VALUES ( expression , expression ) , ( expression , DEFAULT ) ON CONFLICT ( index_column_name opclass , index_column_name COLLATE en_US ) DO UPDATE SET ( column_name , column_name ) = ( expression , expression ) , ( column_name ) = ( expression , expression ) RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name AS alias ( column_name , column_name ) VALUES ( expression ) , ( DEFAULT , DEFAULT ) ON CONFLICT ( ( index_expression ) opclass , ( index_expression ) COLLATE en_US opclass ) DO UPDATE SET ( column_name , column_name ) = ( expression , expression ) , ( column_name ) = ( expression , expression ) RETURNING colname , colname output_name"),
-		Parses("INSERT INTO table_name ( column_name , column_name ) VALUES ( DEFAULT , DEFAULT ) , ( expression , expression ) ON CONFLICT ( index_column_name ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ( expression , expression ) , ( column_name ) = ( expression , expression ) RETURNING colname , colname output_name"),
+		Converts("INSERT INTO table_name ( column_name , column_name ) VALUES ( DEFAULT , DEFAULT ) , ( expression , expression ) ON CONFLICT ( index_column_name ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ( expression , expression ) , ( column_name ) = ( expression , expression ) RETURNING colname , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) INSERT INTO table_name AS alias VALUES ( expression ) , ( expression ) ON CONFLICT ( index_column_name opclass ) WHERE index_predicate DO UPDATE SET ( column_name ) = ROW ( DEFAULT , expression ) , ( column_name ) = ( expression , expression ) RETURNING colname , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) INSERT INTO table_name VALUES ( expression ) , ( expression , DEFAULT ) ON CONFLICT ( ( index_expression ) COLLATE en_US , ( index_expression ) ) DO UPDATE SET ( column_name ) = ( expression , DEFAULT ) , ( column_name ) = ( expression , expression ) RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name AS alias ( column_name ) VALUES ( DEFAULT , DEFAULT ) , ( expression ) ON CONFLICT ( ( index_expression ) COLLATE en_US opclass , index_column_name opclass ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ROW ( expression , DEFAULT ) , ( column_name ) = ( expression , expression ) RETURNING colname , colname output_name"),
@@ -5870,7 +5870,7 @@ func TestInsert(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name AS alias ( column_name , column_name ) VALUES ( expression ) , ( DEFAULT , DEFAULT ) ON CONFLICT ( index_column_name opclass , ( index_expression ) opclass ) WHERE index_predicate DO UPDATE SET ( column_name ) = ( expression ) , ( column_name , column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name ( column_name ) VALUES ( DEFAULT , DEFAULT ) , ( expression , DEFAULT ) ON CONFLICT ( index_column_name , index_column_name COLLATE en_US opclass ) WHERE index_predicate DO UPDATE SET ( column_name ) = ( expression ) , ( column_name , column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname , colname output_name"),
 		Unimplemented("INSERT INTO table_name AS alias ( column_name , column_name ) VALUES ( expression , DEFAULT ) , ( DEFAULT ) ON CONFLICT ( ( index_expression ) opclass , ( index_expression ) opclass ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ( DEFAULT ) , ( column_name , column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname , colname output_name"),
-		Parses("INSERT INTO table_name VALUES ( DEFAULT , expression ) , ( expression ) ON CONFLICT ( index_column_name , index_column_name ) WHERE index_predicate DO UPDATE SET ( column_name ) = ( expression , expression ) , ( column_name , column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname , colname output_name"),
+		Converts("INSERT INTO table_name VALUES ( DEFAULT , expression ) , ( expression ) ON CONFLICT ( index_column_name , index_column_name ) WHERE index_predicate DO UPDATE SET ( column_name ) = ( expression , expression ) , ( column_name , column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name AS alias VALUES ( expression ) , ( expression , DEFAULT ) ON CONFLICT ( ( index_expression ) COLLATE en_US ) DO UPDATE SET ( column_name , column_name ) = ( expression , expression ) , ( column_name , column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name VALUES ( DEFAULT , DEFAULT ) , ( expression , DEFAULT ) ON CONFLICT ( ( index_expression ) COLLATE en_US , index_column_name COLLATE en_US ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ( expression , expression ) , ( column_name , column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname , colname output_name"),
 		Unimplemented("INSERT INTO table_name ( column_name ) VALUES ( expression , DEFAULT ) , ( expression , expression ) ON CONFLICT ( index_column_name , ( index_expression ) ) WHERE index_predicate DO UPDATE SET ( column_name ) = ROW ( expression , expression ) , ( column_name , column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname , colname output_name"),
@@ -6043,7 +6043,7 @@ func TestInsert(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name ( column_name , column_name ) VALUES ( DEFAULT , DEFAULT ) , ( DEFAULT ) ON CONFLICT ( ( index_expression ) COLLATE en_US , index_column_name COLLATE en_US opclass ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ROW ( expression , expression ) , ( column_name , column_name ) = ( expression ) RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name AS alias VALUES ( expression , expression ) , ( expression , expression ) ON CONFLICT ( index_column_name COLLATE en_US , index_column_name COLLATE en_US ) DO UPDATE SET ( column_name , column_name ) = ( DEFAULT , expression ) , ( column_name , column_name ) = ( expression ) RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name VALUES ( DEFAULT ) , ( DEFAULT , DEFAULT ) ON CONFLICT ( index_column_name COLLATE en_US opclass , index_column_name COLLATE en_US opclass ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ( DEFAULT , expression ) , ( column_name , column_name ) = ( expression ) RETURNING colname output_name , colname output_name"),
-		Parses("INSERT INTO table_name VALUES ( expression , expression ) , ( expression , DEFAULT ) ON CONFLICT ( index_column_name ) DO UPDATE SET ( column_name , column_name ) = ( expression , DEFAULT ) , ( column_name , column_name ) = ( expression ) RETURNING colname output_name , colname output_name"),
+		Converts("INSERT INTO table_name VALUES ( expression , expression ) , ( expression , DEFAULT ) ON CONFLICT ( index_column_name ) DO UPDATE SET ( column_name , column_name ) = ( expression , DEFAULT ) , ( column_name , column_name ) = ( expression ) RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) INSERT INTO table_name ( column_name , column_name ) VALUES ( DEFAULT , DEFAULT ) ON CONFLICT ( ( index_expression ) opclass , index_column_name opclass ) WHERE index_predicate DO UPDATE SET column_name = DEFAULT , ( column_name ) = ROW ( expression ) RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name AS alias VALUES ( expression ) ON CONFLICT ( ( index_expression ) COLLATE en_US opclass , ( index_expression ) COLLATE en_US opclass ) WHERE index_predicate DO UPDATE SET column_name = DEFAULT , ( column_name ) = ROW ( expression ) RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name ( column_name ) VALUES ( expression , expression ) , ( DEFAULT , DEFAULT ) ON CONFLICT ( ( index_expression ) COLLATE en_US opclass , index_column_name COLLATE en_US ) DO UPDATE SET ( column_name ) = ( expression ) , ( column_name ) = ROW ( expression ) RETURNING colname output_name , colname output_name"),
@@ -6353,7 +6353,7 @@ func TestInsert(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name ( column_name , column_name ) VALUES ( expression , DEFAULT ) , ( expression ) ON CONFLICT ( ( index_expression ) , ( index_expression ) opclass ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ROW ( DEFAULT , expression ) , column_name = expression WHERE condition RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) INSERT INTO table_name VALUES ( DEFAULT ) , ( expression , expression ) ON CONFLICT ( ( index_expression ) opclass , index_column_name COLLATE en_US ) DO UPDATE SET ( column_name , column_name ) = ( expression , DEFAULT ) , column_name = expression WHERE condition RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name AS alias VALUES ( expression , expression ) , ( expression , expression ) ON CONFLICT ( ( index_expression ) COLLATE en_US , ( index_expression ) COLLATE en_US opclass ) WHERE index_predicate DO UPDATE SET ( column_name ) = ( DEFAULT , DEFAULT ) , column_name = expression WHERE condition RETURNING colname output_name , colname output_name"),
-		Unimplemented("INSERT INTO table_name AS alias ( column_name ) VALUES ( expression , DEFAULT ) , ( expression , expression ) ON CONFLICT ON CONSTRAINT constraint_name DO UPDATE SET ( column_name ) = ( DEFAULT , DEFAULT ) , column_name = expression WHERE condition RETURNING colname output_name , colname output_name"),
+		Parses("INSERT INTO table_name AS alias ( column_name ) VALUES ( expression , DEFAULT ) , ( expression , expression ) ON CONFLICT ON CONSTRAINT constraint_name DO UPDATE SET ( column_name ) = ( DEFAULT , DEFAULT ) , column_name = expression WHERE condition RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) INSERT INTO table_name ( column_name ) VALUES ( expression , DEFAULT ) , ( DEFAULT , expression ) ON CONFLICT ( index_column_name opclass , ( index_expression ) ) WHERE index_predicate DO UPDATE SET ( column_name ) = ( SELECT 1 ) , column_name = expression WHERE condition RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name AS alias ( column_name , column_name ) VALUES ( DEFAULT , expression ) , ( DEFAULT , expression ) ON CONFLICT ( ( index_expression ) opclass ) WHERE index_predicate DO UPDATE SET ( column_name ) = ( expression ) , column_name = DEFAULT WHERE condition RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name VALUES ( expression , DEFAULT ) , ( expression , expression ) ON CONFLICT ( index_column_name , ( index_expression ) ) WHERE index_predicate DO UPDATE SET ( column_name ) = ROW ( DEFAULT , expression ) , column_name = DEFAULT WHERE condition RETURNING colname output_name , colname output_name"),
@@ -9273,7 +9273,7 @@ func TestInsert(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name AS alias OVERRIDING SYSTEM VALUE SELECT 1 ON CONFLICT ( index_column_name , ( index_expression ) COLLATE en_US opclass ) WHERE index_predicate DO UPDATE SET column_name = DEFAULT , ( column_name ) = ROW ( DEFAULT , DEFAULT ) RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name AS alias ( column_name ) OVERRIDING USER VALUE ( SELECT 1 ) ON CONFLICT ( index_column_name opclass ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name ) = ROW ( DEFAULT , DEFAULT ) RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name ( column_name , column_name ) OVERRIDING USER VALUE ( SELECT 1 ) ON CONFLICT ( index_column_name COLLATE en_US , ( index_expression ) COLLATE en_US opclass ) DO UPDATE SET ( column_name , column_name ) = ROW ( expression ) , ( column_name ) = ( SELECT 1 ) RETURNING *"),
-		Unimplemented("INSERT INTO table_name AS alias OVERRIDING USER VALUE ( SELECT 1 ) ON CONFLICT ( index_column_name ) WHERE index_predicate DO UPDATE SET ( column_name ) = ( DEFAULT , expression ) , ( column_name ) = ( SELECT 1 ) RETURNING *"),
+		Parses("INSERT INTO table_name AS alias OVERRIDING USER VALUE ( SELECT 1 ) ON CONFLICT ( index_column_name ) WHERE index_predicate DO UPDATE SET ( column_name ) = ( DEFAULT , expression ) , ( column_name ) = ( SELECT 1 ) RETURNING *"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) INSERT INTO table_name AS alias ( column_name , column_name ) OVERRIDING SYSTEM VALUE SELECT 1 ON CONFLICT ( index_column_name , index_column_name ) DO UPDATE SET column_name = DEFAULT WHERE condition RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name AS alias OVERRIDING SYSTEM VALUE ( SELECT 1 ) ON CONFLICT ( ( index_expression ) , index_column_name opclass ) DO UPDATE SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) WHERE condition RETURNING *"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name AS alias ( column_name ) OVERRIDING SYSTEM VALUE ( SELECT 1 ) ON CONFLICT ( ( index_expression ) COLLATE en_US ) DO UPDATE SET ( column_name ) = ( expression , expression ) , column_name = expression WHERE condition RETURNING *"),
@@ -9321,7 +9321,7 @@ func TestInsert(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name AS alias ( column_name , column_name ) OVERRIDING SYSTEM VALUE SELECT 1 ON CONFLICT ( index_column_name COLLATE en_US , index_column_name COLLATE en_US ) WHERE index_predicate DO UPDATE SET column_name = DEFAULT , ( column_name ) = ( expression , expression ) RETURNING colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name AS alias OVERRIDING USER VALUE SELECT 1 ON CONFLICT ( index_column_name COLLATE en_US opclass , ( index_expression ) opclass ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ( expression , DEFAULT ) , ( column_name , column_name ) = ( expression , expression ) RETURNING colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) INSERT INTO table_name ( column_name ) OVERRIDING USER VALUE ( SELECT 1 ) ON CONFLICT ( index_column_name , index_column_name COLLATE en_US opclass ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ( DEFAULT , expression ) , ( column_name , column_name ) = ROW ( expression , expression ) RETURNING colname"),
-		Unimplemented("INSERT INTO table_name AS alias ( column_name , column_name ) OVERRIDING SYSTEM VALUE ( SELECT 1 ) ON CONFLICT ( index_column_name ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ( expression ) , ( column_name ) = ( DEFAULT , expression ) RETURNING colname"),
+		Parses("INSERT INTO table_name AS alias ( column_name , column_name ) OVERRIDING SYSTEM VALUE ( SELECT 1 ) ON CONFLICT ( index_column_name ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ( expression ) , ( column_name ) = ( DEFAULT , expression ) RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name ( column_name , column_name ) OVERRIDING SYSTEM VALUE SELECT 1 ON CONFLICT ( index_column_name , index_column_name COLLATE en_US opclass ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ROW ( expression ) , ( column_name ) = ( DEFAULT , expression ) RETURNING colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) INSERT INTO table_name AS alias OVERRIDING USER VALUE SELECT 1 ON CONFLICT ( ( index_expression ) COLLATE en_US ) DO UPDATE SET ( column_name , column_name ) = ( expression , expression ) , ( column_name ) = ( DEFAULT , expression ) RETURNING colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) INSERT INTO table_name OVERRIDING USER VALUE ( SELECT 1 ) ON CONFLICT ( index_column_name , index_column_name COLLATE en_US opclass ) WHERE index_predicate DO UPDATE SET ( column_name ) = ( SELECT 1 ) , ( column_name ) = ( DEFAULT , expression ) RETURNING colname"),
@@ -9588,7 +9588,7 @@ func TestInsert(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) INSERT INTO table_name ( column_name ) OVERRIDING SYSTEM VALUE ( SELECT 1 ) ON CONFLICT ( index_column_name , ( index_expression ) ) DO UPDATE SET ( column_name , column_name ) = ( DEFAULT ) , ( column_name , column_name ) = ( expression , expression ) RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name OVERRIDING SYSTEM VALUE SELECT 1 ON CONFLICT ( ( index_expression ) opclass ) DO UPDATE SET ( column_name ) = ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ( expression , expression ) RETURNING colname output_name , colname"),
 		Unimplemented("INSERT INTO table_name AS alias ( column_name ) OVERRIDING USER VALUE SELECT 1 ON CONFLICT ( index_column_name COLLATE en_US opclass , index_column_name COLLATE en_US ) DO UPDATE SET ( column_name ) = ROW ( expression , DEFAULT ) , ( column_name , column_name ) = ROW ( expression , expression ) RETURNING colname output_name , colname"),
-		Unimplemented("INSERT INTO table_name AS alias ( column_name , column_name ) OVERRIDING USER VALUE SELECT 1 ON CONFLICT ( index_column_name , index_column_name ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ( DEFAULT , expression ) RETURNING colname output_name , colname"),
+		Parses("INSERT INTO table_name AS alias ( column_name , column_name ) OVERRIDING USER VALUE SELECT 1 ON CONFLICT ( index_column_name , index_column_name ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ( DEFAULT , expression ) RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name OVERRIDING USER VALUE SELECT 1 ON CONFLICT ( ( index_expression ) , ( index_expression ) opclass ) WHERE index_predicate DO UPDATE SET ( column_name ) = ROW ( DEFAULT , expression ) , ( column_name , column_name ) = ROW ( DEFAULT , expression ) RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) INSERT INTO table_name ( column_name , column_name ) OVERRIDING SYSTEM VALUE SELECT 1 ON CONFLICT ( index_column_name COLLATE en_US opclass , ( index_expression ) COLLATE en_US opclass ) DO UPDATE SET ( column_name , column_name ) = ROW ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ROW ( DEFAULT , expression ) RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) INSERT INTO table_name ( column_name ) OVERRIDING USER VALUE ( SELECT 1 ) ON CONFLICT ( ( index_expression ) COLLATE en_US ) DO UPDATE SET ( column_name , column_name ) = ROW ( expression , expression ) , ( column_name ) = ( expression , DEFAULT ) RETURNING colname output_name , colname"),
@@ -9647,7 +9647,7 @@ func TestInsert(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name ( column_name ) OVERRIDING SYSTEM VALUE ( SELECT 1 ) ON CONFLICT ( index_column_name opclass , ( index_expression ) COLLATE en_US opclass ) DO UPDATE SET ( column_name , column_name ) = ( DEFAULT , expression ) , ( column_name ) = ( DEFAULT , expression ) RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name ( column_name ) OVERRIDING SYSTEM VALUE ( SELECT 1 ) ON CONFLICT ( ( index_expression ) COLLATE en_US , ( index_expression ) COLLATE en_US opclass ) WHERE index_predicate DO UPDATE SET ( column_name , column_name ) = ( DEFAULT ) , ( column_name , column_name ) = ( DEFAULT , expression ) RETURNING colname AS output_name , colname"),
 		Unimplemented("INSERT INTO table_name AS alias ( column_name ) OVERRIDING USER VALUE SELECT 1 ON CONFLICT ( ( index_expression ) opclass , ( index_expression ) ) DO UPDATE SET ( column_name , column_name ) = ROW ( DEFAULT ) , ( column_name , column_name ) = ( DEFAULT , expression ) RETURNING colname AS output_name , colname"),
-		Unimplemented("INSERT INTO table_name AS alias OVERRIDING SYSTEM VALUE SELECT 1 ON CONFLICT ( index_column_name ) DO UPDATE SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ( DEFAULT , expression ) RETURNING colname AS output_name , colname"),
+		Parses("INSERT INTO table_name AS alias OVERRIDING SYSTEM VALUE SELECT 1 ON CONFLICT ( index_column_name ) DO UPDATE SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ( DEFAULT , expression ) RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name OVERRIDING SYSTEM VALUE ( SELECT 1 ) ON CONFLICT ( ( index_expression ) COLLATE en_US opclass , ( index_expression ) opclass ) DO UPDATE SET column_name = DEFAULT , ( column_name ) = ROW ( DEFAULT , expression ) RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name AS alias ( column_name ) OVERRIDING USER VALUE ( SELECT 1 ) ON CONFLICT ( ( index_expression ) COLLATE en_US , ( index_expression ) COLLATE en_US opclass ) WHERE index_predicate DO UPDATE SET ( column_name ) = ROW ( DEFAULT ) , ( column_name , column_name ) = ( expression , DEFAULT ) RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name AS alias ( column_name ) OVERRIDING SYSTEM VALUE ( SELECT 1 ) ON CONFLICT ( index_column_name COLLATE en_US opclass , ( index_expression ) opclass ) DO UPDATE SET ( column_name ) = ( expression , DEFAULT ) , ( column_name ) = ROW ( expression , DEFAULT ) RETURNING colname AS output_name , colname"),
@@ -10010,7 +10010,7 @@ func TestInsert(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name AS alias ( column_name ) OVERRIDING SYSTEM VALUE SELECT 1 ON CONFLICT ( ( index_expression ) , index_column_name COLLATE en_US opclass ) DO UPDATE SET ( column_name , column_name ) = ROW ( expression , DEFAULT ) , ( column_name , column_name ) = ( expression , expression ) WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name OVERRIDING SYSTEM VALUE SELECT 1 ON CONFLICT ON CONSTRAINT constraint_name DO UPDATE SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name ) = ROW ( expression , expression ) WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name ( column_name , column_name ) OVERRIDING USER VALUE SELECT 1 ON CONFLICT ( ( index_expression ) opclass , index_column_name COLLATE en_US opclass ) WHERE index_predicate DO UPDATE SET ( column_name ) = ROW ( expression ) , ( column_name ) = ( DEFAULT , expression ) WHERE condition RETURNING colname AS output_name , colname AS output_name"),
-		Unimplemented("INSERT INTO table_name AS alias OVERRIDING USER VALUE ( SELECT 1 ) ON CONFLICT ( index_column_name ) WHERE index_predicate DO UPDATE SET column_name = DEFAULT , ( column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname AS output_name , colname AS output_name"),
+		Parses("INSERT INTO table_name AS alias OVERRIDING USER VALUE ( SELECT 1 ) ON CONFLICT ( index_column_name ) WHERE index_predicate DO UPDATE SET column_name = DEFAULT , ( column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) INSERT INTO table_name AS alias ( column_name ) OVERRIDING SYSTEM VALUE SELECT 1 ON CONFLICT ( ( index_expression ) , index_column_name opclass ) WHERE index_predicate DO UPDATE SET ( column_name ) = ( DEFAULT , expression ) , ( column_name , column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name AS alias OVERRIDING USER VALUE ( SELECT 1 ) ON CONFLICT ( ( index_expression ) COLLATE en_US opclass , ( index_expression ) COLLATE en_US ) DO UPDATE SET ( column_name , column_name ) = ( expression , DEFAULT ) , ( column_name , column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) INSERT INTO table_name AS alias ( column_name ) OVERRIDING SYSTEM VALUE ( SELECT 1 ) ON CONFLICT ( ( index_expression ) opclass ) DO UPDATE SET column_name = expression , ( column_name ) = ROW ( expression , DEFAULT ) WHERE condition RETURNING colname AS output_name , colname AS output_name"),
@@ -18,438 +18,438 @@ import "testing"
 
 func TestLock(t *testing.T) {
 	tests := []QueryParses{
-		Unimplemented("LOCK ONLY name"),
-		Unimplemented("LOCK TABLE ONLY name"),
-		Unimplemented("LOCK name"),
-		Unimplemented("LOCK TABLE name"),
-		Unimplemented("LOCK name *"),
-		Unimplemented("LOCK TABLE name *"),
-		Unimplemented("LOCK ONLY name , ONLY name"),
-		Unimplemented("LOCK TABLE ONLY name , ONLY name"),
-		Unimplemented("LOCK name , ONLY name"),
-		Unimplemented("LOCK TABLE name , ONLY name"),
-		Unimplemented("LOCK name * , ONLY name"),
-		Unimplemented("LOCK TABLE name * , ONLY name"),
-		Unimplemented("LOCK ONLY name , name"),
-		Unimplemented("LOCK TABLE ONLY name , name"),
-		Unimplemented("LOCK name , name"),
-		Unimplemented("LOCK TABLE name , name"),
-		Unimplemented("LOCK name * , name"),
-		Unimplemented("LOCK TABLE name * , name"),
-		Unimplemented("LOCK ONLY name , name *"),
-		Unimplemented("LOCK TABLE ONLY name , name *"),
-		Unimplemented("LOCK name , name *"),
-		Unimplemented("LOCK TABLE name , name *"),
-		Unimplemented("LOCK name * , name *"),
-		Unimplemented("LOCK TABLE name * , name *"),
-		Unimplemented("LOCK ONLY name IN ACCESS SHARE MODE"),
-		Unimplemented("LOCK TABLE ONLY name IN ACCESS SHARE MODE"),
-		Unimplemented("LOCK name IN ACCESS SHARE MODE"),
-		Unimplemented("LOCK TABLE name IN ACCESS SHARE MODE"),
-		Unimplemented("LOCK name * IN ACCESS SHARE MODE"),
-		Unimplemented("LOCK TABLE name * IN ACCESS SHARE MODE"),
-		Unimplemented("LOCK ONLY name , ONLY name IN ACCESS SHARE MODE"),
-		Unimplemented("LOCK TABLE ONLY name , ONLY name IN ACCESS SHARE MODE"),
-		Unimplemented("LOCK name , ONLY name IN ACCESS SHARE MODE"),
-		Unimplemented("LOCK TABLE name , ONLY name IN ACCESS SHARE MODE"),
-		Unimplemented("LOCK name * , ONLY name IN ACCESS SHARE MODE"),
-		Unimplemented("LOCK TABLE name * , ONLY name IN ACCESS SHARE MODE"),
-		Unimplemented("LOCK ONLY name , name IN ACCESS SHARE MODE"),
-		Unimplemented("LOCK TABLE ONLY name , name IN ACCESS SHARE MODE"),
-		Unimplemented("LOCK name , name IN ACCESS SHARE MODE"),
-		Unimplemented("LOCK TABLE name , name IN ACCESS SHARE MODE"),
-		Unimplemented("LOCK name * , name IN ACCESS SHARE MODE"),
-		Unimplemented("LOCK TABLE name * , name IN ACCESS SHARE MODE"),
-		Unimplemented("LOCK ONLY name , name * IN ACCESS SHARE MODE"),
-		Unimplemented("LOCK TABLE ONLY name , name * IN ACCESS SHARE MODE"),
-		Unimplemented("LOCK name , name * IN ACCESS SHARE MODE"),
-		Unimplemented("LOCK TABLE name , name * IN ACCESS SHARE MODE"),
-		Unimplemented("LOCK name * , name * IN ACCESS SHARE MODE"),
-		Unimplemented("LOCK TABLE name * , name * IN ACCESS SHARE MODE"),
-		Unimplemented("LOCK ONLY name IN ROW SHARE MODE"),
-		Unimplemented("LOCK TABLE ONLY name IN ROW SHARE MODE"),
-		Unimplemented("LOCK name IN ROW SHARE MODE"),
-		Unimplemented("LOCK TABLE name IN ROW SHARE MODE"),
-		Unimplemented("LOCK name * IN ROW SHARE MODE"),
-		Unimplemented("LOCK TABLE name * IN ROW SHARE MODE"),
-		Unimplemented("LOCK ONLY name , ONLY name IN ROW SHARE MODE"),
-		Unimplemented("LOCK TABLE ONLY name , ONLY name IN ROW SHARE MODE"),
-		Unimplemented("LOCK name , ONLY name IN ROW SHARE MODE"),
-		Unimplemented("LOCK TABLE name , ONLY name IN ROW SHARE MODE"),
-		Unimplemented("LOCK name * , ONLY name IN ROW SHARE MODE"),
-		Unimplemented("LOCK TABLE name * , ONLY name IN ROW SHARE MODE"),
-		Unimplemented("LOCK ONLY name , name IN ROW SHARE MODE"),
-		Unimplemented("LOCK TABLE ONLY name , name IN ROW SHARE MODE"),
-		Unimplemented("LOCK name , name IN ROW SHARE MODE"),
-		Unimplemented("LOCK TABLE name , name IN ROW SHARE MODE"),
-		Unimplemented("LOCK name * , name IN ROW SHARE MODE"),
-		Unimplemented("LOCK TABLE name * , name IN ROW SHARE MODE"),
-		Unimplemented("LOCK ONLY name , name * IN ROW SHARE MODE"),
-		Unimplemented("LOCK TABLE ONLY name , name * IN ROW SHARE MODE"),
-		Unimplemented("LOCK name , name * IN ROW SHARE MODE"),
-		Unimplemented("LOCK TABLE name , name * IN ROW SHARE MODE"),
-		Unimplemented("LOCK name * , name * IN ROW SHARE MODE"),
-		Unimplemented("LOCK TABLE name * , name * IN ROW SHARE MODE"),
-		Unimplemented("LOCK ONLY name IN ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE ONLY name IN ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK name IN ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name IN ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK name * IN ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name * IN ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK ONLY name , ONLY name IN ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE ONLY name , ONLY name IN ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK name , ONLY name IN ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name , ONLY name IN ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK name * , ONLY name IN ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name * , ONLY name IN ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK ONLY name , name IN ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE ONLY name , name IN ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK name , name IN ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name , name IN ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK name * , name IN ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name * , name IN ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK ONLY name , name * IN ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE ONLY name , name * IN ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK name , name * IN ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name , name * IN ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK name * , name * IN ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name * , name * IN ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK ONLY name IN SHARE UPDATE EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE ONLY name IN SHARE UPDATE EXCLUSIVE MODE"),
-		Unimplemented("LOCK name IN SHARE UPDATE EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name IN SHARE UPDATE EXCLUSIVE MODE"),
-		Unimplemented("LOCK name * IN SHARE UPDATE EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name * IN SHARE UPDATE EXCLUSIVE MODE"),
-		Unimplemented("LOCK ONLY name , ONLY name IN SHARE UPDATE EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE ONLY name , ONLY name IN SHARE UPDATE EXCLUSIVE MODE"),
-		Unimplemented("LOCK name , ONLY name IN SHARE UPDATE EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name , ONLY name IN SHARE UPDATE EXCLUSIVE MODE"),
-		Unimplemented("LOCK name * , ONLY name IN SHARE UPDATE EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name * , ONLY name IN SHARE UPDATE EXCLUSIVE MODE"),
-		Unimplemented("LOCK ONLY name , name IN SHARE UPDATE EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE ONLY name , name IN SHARE UPDATE EXCLUSIVE MODE"),
-		Unimplemented("LOCK name , name IN SHARE UPDATE EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name , name IN SHARE UPDATE EXCLUSIVE MODE"),
-		Unimplemented("LOCK name * , name IN SHARE UPDATE EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name * , name IN SHARE UPDATE EXCLUSIVE MODE"),
-		Unimplemented("LOCK ONLY name , name * IN SHARE UPDATE EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE ONLY name , name * IN SHARE UPDATE EXCLUSIVE MODE"),
-		Unimplemented("LOCK name , name * IN SHARE UPDATE EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name , name * IN SHARE UPDATE EXCLUSIVE MODE"),
-		Unimplemented("LOCK name * , name * IN SHARE UPDATE EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name * , name * IN SHARE UPDATE EXCLUSIVE MODE"),
-		Unimplemented("LOCK ONLY name IN SHARE MODE"),
-		Unimplemented("LOCK TABLE ONLY name IN SHARE MODE"),
-		Unimplemented("LOCK name IN SHARE MODE"),
-		Unimplemented("LOCK TABLE name IN SHARE MODE"),
-		Unimplemented("LOCK name * IN SHARE MODE"),
-		Unimplemented("LOCK TABLE name * IN SHARE MODE"),
-		Unimplemented("LOCK ONLY name , ONLY name IN SHARE MODE"),
-		Unimplemented("LOCK TABLE ONLY name , ONLY name IN SHARE MODE"),
-		Unimplemented("LOCK name , ONLY name IN SHARE MODE"),
-		Unimplemented("LOCK TABLE name , ONLY name IN SHARE MODE"),
-		Unimplemented("LOCK name * , ONLY name IN SHARE MODE"),
-		Unimplemented("LOCK TABLE name * , ONLY name IN SHARE MODE"),
-		Unimplemented("LOCK ONLY name , name IN SHARE MODE"),
-		Unimplemented("LOCK TABLE ONLY name , name IN SHARE MODE"),
-		Unimplemented("LOCK name , name IN SHARE MODE"),
-		Unimplemented("LOCK TABLE name , name IN SHARE MODE"),
-		Unimplemented("LOCK name * , name IN SHARE MODE"),
-		Unimplemented("LOCK TABLE name * , name IN SHARE MODE"),
-		Unimplemented("LOCK ONLY name , name * IN SHARE MODE"),
-		Unimplemented("LOCK TABLE ONLY name , name * IN SHARE MODE"),
-		Unimplemented("LOCK name , name * IN SHARE MODE"),
-		Unimplemented("LOCK TABLE name , name * IN SHARE MODE"),
-		Unimplemented("LOCK name * , name * IN SHARE MODE"),
-		Unimplemented("LOCK TABLE name * , name * IN SHARE MODE"),
-		Unimplemented("LOCK ONLY name IN SHARE ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE ONLY name IN SHARE ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK name IN SHARE ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name IN SHARE ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK name * IN SHARE ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name * IN SHARE ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK ONLY name , ONLY name IN SHARE ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE ONLY name , ONLY name IN SHARE ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK name , ONLY name IN SHARE ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name , ONLY name IN SHARE ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK name * , ONLY name IN SHARE ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name * , ONLY name IN SHARE ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK ONLY name , name IN SHARE ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE ONLY name , name IN SHARE ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK name , name IN SHARE ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name , name IN SHARE ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK name * , name IN SHARE ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name * , name IN SHARE ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK ONLY name , name * IN SHARE ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE ONLY name , name * IN SHARE ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK name , name * IN SHARE ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name , name * IN SHARE ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK name * , name * IN SHARE ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name * , name * IN SHARE ROW EXCLUSIVE MODE"),
-		Unimplemented("LOCK ONLY name IN EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE ONLY name IN EXCLUSIVE MODE"),
-		Unimplemented("LOCK name IN EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name IN EXCLUSIVE MODE"),
-		Unimplemented("LOCK name * IN EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name * IN EXCLUSIVE MODE"),
-		Unimplemented("LOCK ONLY name , ONLY name IN EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE ONLY name , ONLY name IN EXCLUSIVE MODE"),
-		Unimplemented("LOCK name , ONLY name IN EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name , ONLY name IN EXCLUSIVE MODE"),
-		Unimplemented("LOCK name * , ONLY name IN EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name * , ONLY name IN EXCLUSIVE MODE"),
-		Unimplemented("LOCK ONLY name , name IN EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE ONLY name , name IN EXCLUSIVE MODE"),
-		Unimplemented("LOCK name , name IN EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name , name IN EXCLUSIVE MODE"),
-		Unimplemented("LOCK name * , name IN EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name * , name IN EXCLUSIVE MODE"),
-		Unimplemented("LOCK ONLY name , name * IN EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE ONLY name , name * IN EXCLUSIVE MODE"),
-		Unimplemented("LOCK name , name * IN EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name , name * IN EXCLUSIVE MODE"),
-		Unimplemented("LOCK name * , name * IN EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name * , name * IN EXCLUSIVE MODE"),
-		Unimplemented("LOCK ONLY name IN ACCESS EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE ONLY name IN ACCESS EXCLUSIVE MODE"),
-		Unimplemented("LOCK name IN ACCESS EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name IN ACCESS EXCLUSIVE MODE"),
-		Unimplemented("LOCK name * IN ACCESS EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name * IN ACCESS EXCLUSIVE MODE"),
-		Unimplemented("LOCK ONLY name , ONLY name IN ACCESS EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE ONLY name , ONLY name IN ACCESS EXCLUSIVE MODE"),
-		Unimplemented("LOCK name , ONLY name IN ACCESS EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name , ONLY name IN ACCESS EXCLUSIVE MODE"),
-		Unimplemented("LOCK name * , ONLY name IN ACCESS EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name * , ONLY name IN ACCESS EXCLUSIVE MODE"),
-		Unimplemented("LOCK ONLY name , name IN ACCESS EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE ONLY name , name IN ACCESS EXCLUSIVE MODE"),
-		Unimplemented("LOCK name , name IN ACCESS EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name , name IN ACCESS EXCLUSIVE MODE"),
-		Unimplemented("LOCK name * , name IN ACCESS EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name * , name IN ACCESS EXCLUSIVE MODE"),
-		Unimplemented("LOCK ONLY name , name * IN ACCESS EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE ONLY name , name * IN ACCESS EXCLUSIVE MODE"),
-		Unimplemented("LOCK name , name * IN ACCESS EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name , name * IN ACCESS EXCLUSIVE MODE"),
-		Unimplemented("LOCK name * , name * IN ACCESS EXCLUSIVE MODE"),
-		Unimplemented("LOCK TABLE name * , name * IN ACCESS EXCLUSIVE MODE"),
-		Unimplemented("LOCK ONLY name NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name NOWAIT"),
-		Unimplemented("LOCK name NOWAIT"),
-		Unimplemented("LOCK TABLE name NOWAIT"),
-		Unimplemented("LOCK name * NOWAIT"),
-		Unimplemented("LOCK TABLE name * NOWAIT"),
-		Unimplemented("LOCK ONLY name , ONLY name NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , ONLY name NOWAIT"),
-		Unimplemented("LOCK name , ONLY name NOWAIT"),
-		Unimplemented("LOCK TABLE name , ONLY name NOWAIT"),
-		Unimplemented("LOCK name * , ONLY name NOWAIT"),
-		Unimplemented("LOCK TABLE name * , ONLY name NOWAIT"),
-		Unimplemented("LOCK ONLY name , name NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , name NOWAIT"),
-		Unimplemented("LOCK name , name NOWAIT"),
-		Unimplemented("LOCK TABLE name , name NOWAIT"),
-		Unimplemented("LOCK name * , name NOWAIT"),
-		Unimplemented("LOCK TABLE name * , name NOWAIT"),
-		Unimplemented("LOCK ONLY name , name * NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , name * NOWAIT"),
-		Unimplemented("LOCK name , name * NOWAIT"),
-		Unimplemented("LOCK TABLE name , name * NOWAIT"),
-		Unimplemented("LOCK name * , name * NOWAIT"),
-		Unimplemented("LOCK TABLE name * , name * NOWAIT"),
-		Unimplemented("LOCK ONLY name IN ACCESS SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name IN ACCESS SHARE MODE NOWAIT"),
-		Unimplemented("LOCK name IN ACCESS SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name IN ACCESS SHARE MODE NOWAIT"),
-		Unimplemented("LOCK name * IN ACCESS SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * IN ACCESS SHARE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name , ONLY name IN ACCESS SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , ONLY name IN ACCESS SHARE MODE NOWAIT"),
-		Unimplemented("LOCK name , ONLY name IN ACCESS SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name , ONLY name IN ACCESS SHARE MODE NOWAIT"),
-		Unimplemented("LOCK name * , ONLY name IN ACCESS SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * , ONLY name IN ACCESS SHARE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name , name IN ACCESS SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , name IN ACCESS SHARE MODE NOWAIT"),
-		Unimplemented("LOCK name , name IN ACCESS SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name , name IN ACCESS SHARE MODE NOWAIT"),
-		Unimplemented("LOCK name * , name IN ACCESS SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * , name IN ACCESS SHARE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name , name * IN ACCESS SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , name * IN ACCESS SHARE MODE NOWAIT"),
-		Unimplemented("LOCK name , name * IN ACCESS SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name , name * IN ACCESS SHARE MODE NOWAIT"),
-		Unimplemented("LOCK name * , name * IN ACCESS SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * , name * IN ACCESS SHARE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name IN ROW SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name IN ROW SHARE MODE NOWAIT"),
-		Unimplemented("LOCK name IN ROW SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name IN ROW SHARE MODE NOWAIT"),
-		Unimplemented("LOCK name * IN ROW SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * IN ROW SHARE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name , ONLY name IN ROW SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , ONLY name IN ROW SHARE MODE NOWAIT"),
-		Unimplemented("LOCK name , ONLY name IN ROW SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name , ONLY name IN ROW SHARE MODE NOWAIT"),
-		Unimplemented("LOCK name * , ONLY name IN ROW SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * , ONLY name IN ROW SHARE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name , name IN ROW SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , name IN ROW SHARE MODE NOWAIT"),
-		Unimplemented("LOCK name , name IN ROW SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name , name IN ROW SHARE MODE NOWAIT"),
-		Unimplemented("LOCK name * , name IN ROW SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * , name IN ROW SHARE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name , name * IN ROW SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , name * IN ROW SHARE MODE NOWAIT"),
-		Unimplemented("LOCK name , name * IN ROW SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name , name * IN ROW SHARE MODE NOWAIT"),
-		Unimplemented("LOCK name * , name * IN ROW SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * , name * IN ROW SHARE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name IN ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name IN ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name IN ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name IN ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name * IN ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * IN ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name , ONLY name IN ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , ONLY name IN ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name , ONLY name IN ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name , ONLY name IN ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name * , ONLY name IN ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * , ONLY name IN ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name , name IN ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , name IN ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name , name IN ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name , name IN ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name * , name IN ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * , name IN ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name , name * IN ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , name * IN ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name , name * IN ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name , name * IN ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name * , name * IN ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * , name * IN ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name * IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name , ONLY name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , ONLY name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name , ONLY name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name , ONLY name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name * , ONLY name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * , ONLY name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name , name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name , name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name , name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name * , name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * , name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name , name * IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , name * IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name , name * IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name , name * IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name * , name * IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * , name * IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name IN SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name IN SHARE MODE NOWAIT"),
-		Unimplemented("LOCK name IN SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name IN SHARE MODE NOWAIT"),
-		Unimplemented("LOCK name * IN SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * IN SHARE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name , ONLY name IN SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , ONLY name IN SHARE MODE NOWAIT"),
-		Unimplemented("LOCK name , ONLY name IN SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name , ONLY name IN SHARE MODE NOWAIT"),
-		Unimplemented("LOCK name * , ONLY name IN SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * , ONLY name IN SHARE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name , name IN SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , name IN SHARE MODE NOWAIT"),
-		Unimplemented("LOCK name , name IN SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name , name IN SHARE MODE NOWAIT"),
-		Unimplemented("LOCK name * , name IN SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * , name IN SHARE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name , name * IN SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , name * IN SHARE MODE NOWAIT"),
-		Unimplemented("LOCK name , name * IN SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name , name * IN SHARE MODE NOWAIT"),
-		Unimplemented("LOCK name * , name * IN SHARE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * , name * IN SHARE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name * IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name , ONLY name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , ONLY name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name , ONLY name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name , ONLY name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name * , ONLY name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * , ONLY name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name , name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name , name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name , name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name * , name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * , name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name , name * IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , name * IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name , name * IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name , name * IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name * , name * IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * , name * IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name IN EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name IN EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name IN EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name IN EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name * IN EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * IN EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name , ONLY name IN EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , ONLY name IN EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name , ONLY name IN EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name , ONLY name IN EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name * , ONLY name IN EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * , ONLY name IN EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name , name IN EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , name IN EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name , name IN EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name , name IN EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name * , name IN EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * , name IN EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name , name * IN EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , name * IN EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name , name * IN EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name , name * IN EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name * , name * IN EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * , name * IN EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name IN ACCESS EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name IN ACCESS EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name IN ACCESS EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name IN ACCESS EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name * IN ACCESS EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * IN ACCESS EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name , ONLY name IN ACCESS EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , ONLY name IN ACCESS EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name , ONLY name IN ACCESS EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name , ONLY name IN ACCESS EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name * , ONLY name IN ACCESS EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * , ONLY name IN ACCESS EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name , name IN ACCESS EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , name IN ACCESS EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name , name IN ACCESS EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name , name IN ACCESS EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name * , name IN ACCESS EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * , name IN ACCESS EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK ONLY name , name * IN ACCESS EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE ONLY name , name * IN ACCESS EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name , name * IN ACCESS EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name , name * IN ACCESS EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK name * , name * IN ACCESS EXCLUSIVE MODE NOWAIT"),
-		Unimplemented("LOCK TABLE name * , name * IN ACCESS EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK ONLY name"),
+		Converts("LOCK TABLE ONLY name"),
+		Converts("LOCK name"),
+		Converts("LOCK TABLE name"),
+		Converts("LOCK name *"),
+		Converts("LOCK TABLE name *"),
+		Converts("LOCK ONLY name , ONLY name"),
+		Converts("LOCK TABLE ONLY name , ONLY name"),
+		Converts("LOCK name , ONLY name"),
+		Converts("LOCK TABLE name , ONLY name"),
+		Converts("LOCK name * , ONLY name"),
+		Converts("LOCK TABLE name * , ONLY name"),
+		Converts("LOCK ONLY name , name"),
+		Converts("LOCK TABLE ONLY name , name"),
+		Converts("LOCK name , name"),
+		Converts("LOCK TABLE name , name"),
+		Converts("LOCK name * , name"),
+		Converts("LOCK TABLE name * , name"),
+		Converts("LOCK ONLY name , name *"),
+		Converts("LOCK TABLE ONLY name , name *"),
+		Converts("LOCK name , name *"),
+		Converts("LOCK TABLE name , name *"),
+		Converts("LOCK name * , name *"),
+		Converts("LOCK TABLE name * , name *"),
+		Converts("LOCK ONLY name IN ACCESS SHARE MODE"),
+		Converts("LOCK TABLE ONLY name IN ACCESS SHARE MODE"),
+		Converts("LOCK name IN ACCESS SHARE MODE"),
+		Converts("LOCK TABLE name IN ACCESS SHARE MODE"),
+		Converts("LOCK name * IN ACCESS SHARE MODE"),
+		Converts("LOCK TABLE name * IN ACCESS SHARE MODE"),
+		Converts("LOCK ONLY name , ONLY name IN ACCESS SHARE MODE"),
+		Converts("LOCK TABLE ONLY name , ONLY name IN ACCESS SHARE MODE"),
+		Converts("LOCK name , ONLY name IN ACCESS SHARE MODE"),
+		Converts("LOCK TABLE name , ONLY name IN ACCESS SHARE MODE"),
+		Converts("LOCK name * , ONLY name IN ACCESS SHARE MODE"),
+		Converts("LOCK TABLE name * , ONLY name IN ACCESS SHARE MODE"),
+		Converts("LOCK ONLY name , name IN ACCESS SHARE MODE"),
+		Converts("LOCK TABLE ONLY name , name IN ACCESS SHARE MODE"),
+		Converts("LOCK name , name IN ACCESS SHARE MODE"),
+		Converts("LOCK TABLE name , name IN ACCESS SHARE MODE"),
+		Converts("LOCK name * , name IN ACCESS SHARE MODE"),
+		Converts("LOCK TABLE name * , name IN ACCESS SHARE MODE"),
+		Converts("LOCK ONLY name , name * IN ACCESS SHARE MODE"),
+		Converts("LOCK TABLE ONLY name , name * IN ACCESS SHARE MODE"),
+		Converts("LOCK name , name * IN ACCESS SHARE MODE"),
+		Converts("LOCK TABLE name , name * IN ACCESS SHARE MODE"),
+		Converts("LOCK name * , name * IN ACCESS SHARE MODE"),
+		Converts("LOCK TABLE name * , name * IN ACCESS SHARE MODE"),
+		Converts("LOCK ONLY name IN ROW SHARE MODE"),
+		Converts("LOCK TABLE ONLY name IN ROW SHARE MODE"),
+		Converts("LOCK name IN ROW SHARE MODE"),
+		Converts("LOCK TABLE name IN ROW SHARE MODE"),
+		Converts("LOCK name * IN ROW SHARE MODE"),
+		Converts("LOCK TABLE name * IN ROW SHARE MODE"),
+		Converts("LOCK ONLY name , ONLY name IN ROW SHARE MODE"),
+		Converts("LOCK TABLE ONLY name , ONLY name IN ROW SHARE MODE"),
+		Converts("LOCK name , ONLY name IN ROW SHARE MODE"),
+		Converts("LOCK TABLE name , ONLY name IN ROW SHARE MODE"),
+		Converts("LOCK name * , ONLY name IN ROW SHARE MODE"),
+		Converts("LOCK TABLE name * , ONLY name IN ROW SHARE MODE"),
+		Converts("LOCK ONLY name , name IN ROW SHARE MODE"),
+		Converts("LOCK TABLE ONLY name , name IN ROW SHARE MODE"),
+		Converts("LOCK name , name IN ROW SHARE MODE"),
+		Converts("LOCK TABLE name , name IN ROW SHARE MODE"),
+		Converts("LOCK name * , name IN ROW SHARE MODE"),
+		Converts("LOCK TABLE name * , name IN ROW SHARE MODE"),
+		Converts("LOCK ONLY name , name * IN ROW SHARE MODE"),
+		Converts("LOCK TABLE ONLY name , name * IN ROW SHARE MODE"),
+		Converts("LOCK name , name * IN ROW SHARE MODE"),
+		Converts("LOCK TABLE name , name * IN ROW SHARE MODE"),
+		Converts("LOCK name * , name * IN ROW SHARE MODE"),
+		Converts("LOCK TABLE name * , name * IN ROW SHARE MODE"),
+		Converts("LOCK ONLY name IN ROW EXCLUSIVE MODE"),
+		Converts("LOCK TABLE ONLY name IN ROW EXCLUSIVE MODE"),
+		Converts("LOCK name IN ROW EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name IN ROW EXCLUSIVE MODE"),
+		Converts("LOCK name * IN ROW EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name * IN ROW EXCLUSIVE MODE"),
+		Converts("LOCK ONLY name , ONLY name IN ROW EXCLUSIVE MODE"),
+		Converts("LOCK TABLE ONLY name , ONLY name IN ROW EXCLUSIVE MODE"),
+		Converts("LOCK name , ONLY name IN ROW EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name , ONLY name IN ROW EXCLUSIVE MODE"),
+		Converts("LOCK name * , ONLY name IN ROW EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name * , ONLY name IN ROW EXCLUSIVE MODE"),
+		Converts("LOCK ONLY name , name IN ROW EXCLUSIVE MODE"),
+		Converts("LOCK TABLE ONLY name , name IN ROW EXCLUSIVE MODE"),
+		Converts("LOCK name , name IN ROW EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name , name IN ROW EXCLUSIVE MODE"),
+		Converts("LOCK name * , name IN ROW EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name * , name IN ROW EXCLUSIVE MODE"),
+		Converts("LOCK ONLY name , name * IN ROW EXCLUSIVE MODE"),
+		Converts("LOCK TABLE ONLY name , name * IN ROW EXCLUSIVE MODE"),
+		Converts("LOCK name , name * IN ROW EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name , name * IN ROW EXCLUSIVE MODE"),
+		Converts("LOCK name * , name * IN ROW EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name * , name * IN ROW EXCLUSIVE MODE"),
+		Converts("LOCK ONLY name IN SHARE UPDATE EXCLUSIVE MODE"),
+		Converts("LOCK TABLE ONLY name IN SHARE UPDATE EXCLUSIVE MODE"),
+		Converts("LOCK name IN SHARE UPDATE EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name IN SHARE UPDATE EXCLUSIVE MODE"),
+		Converts("LOCK name * IN SHARE UPDATE EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name * IN SHARE UPDATE EXCLUSIVE MODE"),
+		Converts("LOCK ONLY name , ONLY name IN SHARE UPDATE EXCLUSIVE MODE"),
+		Converts("LOCK TABLE ONLY name , ONLY name IN SHARE UPDATE EXCLUSIVE MODE"),
+		Converts("LOCK name , ONLY name IN SHARE UPDATE EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name , ONLY name IN SHARE UPDATE EXCLUSIVE MODE"),
+		Converts("LOCK name * , ONLY name IN SHARE UPDATE EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name * , ONLY name IN SHARE UPDATE EXCLUSIVE MODE"),
+		Converts("LOCK ONLY name , name IN SHARE UPDATE EXCLUSIVE MODE"),
+		Converts("LOCK TABLE ONLY name , name IN SHARE UPDATE EXCLUSIVE MODE"),
+		Converts("LOCK name , name IN SHARE UPDATE EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name , name IN SHARE UPDATE EXCLUSIVE MODE"),
+		Converts("LOCK name * , name IN SHARE UPDATE EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name * , name IN SHARE UPDATE EXCLUSIVE MODE"),
+		Converts("LOCK ONLY name , name * IN SHARE UPDATE EXCLUSIVE MODE"),
+		Converts("LOCK TABLE ONLY name , name * IN SHARE UPDATE EXCLUSIVE MODE"),
+		Converts("LOCK name , name * IN SHARE UPDATE EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name , name * IN SHARE UPDATE EXCLUSIVE MODE"),
+		Converts("LOCK name * , name * IN SHARE UPDATE EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name * , name * IN SHARE UPDATE EXCLUSIVE MODE"),
+		Converts("LOCK ONLY name IN SHARE MODE"),
+		Converts("LOCK TABLE ONLY name IN SHARE MODE"),
+		Converts("LOCK name IN SHARE MODE"),
+		Converts("LOCK TABLE name IN SHARE MODE"),
+		Converts("LOCK name * IN SHARE MODE"),
+		Converts("LOCK TABLE name * IN SHARE MODE"),
+		Converts("LOCK ONLY name , ONLY name IN SHARE MODE"),
+		Converts("LOCK TABLE ONLY name , ONLY name IN SHARE MODE"),
+		Converts("LOCK name , ONLY name IN SHARE MODE"),
+		Converts("LOCK TABLE name , ONLY name IN SHARE MODE"),
+		Converts("LOCK name * , ONLY name IN SHARE MODE"),
+		Converts("LOCK TABLE name * , ONLY name IN SHARE MODE"),
+		Converts("LOCK ONLY name , name IN SHARE MODE"),
+		Converts("LOCK TABLE ONLY name , name IN SHARE MODE"),
+		Converts("LOCK name , name IN SHARE MODE"),
+		Converts("LOCK TABLE name , name IN SHARE MODE"),
+		Converts("LOCK name * , name IN SHARE MODE"),
+		Converts("LOCK TABLE name * , name IN SHARE MODE"),
+		Converts("LOCK ONLY name , name * IN SHARE MODE"),
+		Converts("LOCK TABLE ONLY name , name * IN SHARE MODE"),
+		Converts("LOCK name , name * IN SHARE MODE"),
+		Converts("LOCK TABLE name , name * IN SHARE MODE"),
+		Converts("LOCK name * , name * IN SHARE MODE"),
+		Converts("LOCK TABLE name * , name * IN SHARE MODE"),
+		Converts("LOCK ONLY name IN SHARE ROW EXCLUSIVE MODE"),
+		Converts("LOCK TABLE ONLY name IN SHARE ROW EXCLUSIVE MODE"),
+		Converts("LOCK name IN SHARE ROW EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name IN SHARE ROW EXCLUSIVE MODE"),
+		Converts("LOCK name * IN SHARE ROW EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name * IN SHARE ROW EXCLUSIVE MODE"),
+		Converts("LOCK ONLY name , ONLY name IN SHARE ROW EXCLUSIVE MODE"),
+		Converts("LOCK TABLE ONLY name , ONLY name IN SHARE ROW EXCLUSIVE MODE"),
+		Converts("LOCK name , ONLY name IN SHARE ROW EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name , ONLY name IN SHARE ROW EXCLUSIVE MODE"),
+		Converts("LOCK name * , ONLY name IN SHARE ROW EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name * , ONLY name IN SHARE ROW EXCLUSIVE MODE"),
+		Converts("LOCK ONLY name , name IN SHARE ROW EXCLUSIVE MODE"),
+		Converts("LOCK TABLE ONLY name , name IN SHARE ROW EXCLUSIVE MODE"),
+		Converts("LOCK name , name IN SHARE ROW EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name , name IN SHARE ROW EXCLUSIVE MODE"),
+		Converts("LOCK name * , name IN SHARE ROW EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name * , name IN SHARE ROW EXCLUSIVE MODE"),
+		Converts("LOCK ONLY name , name * IN SHARE ROW EXCLUSIVE MODE"),
+		Converts("LOCK TABLE ONLY name , name * IN SHARE ROW EXCLUSIVE MODE"),
+		Converts("LOCK name , name * IN SHARE ROW EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name , name * IN SHARE ROW EXCLUSIVE MODE"),
+		Converts("LOCK name * , name * IN SHARE ROW EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name * , name * IN SHARE ROW EXCLUSIVE MODE"),
+		Converts("LOCK ONLY name IN EXCLUSIVE MODE"),
+		Converts("LOCK TABLE ONLY name IN EXCLUSIVE MODE"),
+		Converts("LOCK name IN EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name IN EXCLUSIVE MODE"),
+		Converts("LOCK name * IN EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name * IN EXCLUSIVE MODE"),
+		Converts("LOCK ONLY name , ONLY name IN EXCLUSIVE MODE"),
+		Converts("LOCK TABLE ONLY name , ONLY name IN EXCLUSIVE MODE"),
+		Converts("LOCK name , ONLY name IN EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name , ONLY name IN EXCLUSIVE MODE"),
+		Converts("LOCK name * , ONLY name IN EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name * , ONLY name IN EXCLUSIVE MODE"),
+		Converts("LOCK ONLY name , name IN EXCLUSIVE MODE"),
+		Converts("LOCK TABLE ONLY name , name IN EXCLUSIVE MODE"),
+		Converts("LOCK name , name IN EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name , name IN EXCLUSIVE MODE"),
+		Converts("LOCK name * , name IN EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name * , name IN EXCLUSIVE MODE"),
+		Converts("LOCK ONLY name , name * IN EXCLUSIVE MODE"),
+		Converts("LOCK TABLE ONLY name , name * IN EXCLUSIVE MODE"),
+		Converts("LOCK name , name * IN EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name , name * IN EXCLUSIVE MODE"),
+		Converts("LOCK name * , name * IN EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name * , name * IN EXCLUSIVE MODE"),
+		Converts("LOCK ONLY name IN ACCESS EXCLUSIVE MODE"),
+		Converts("LOCK TABLE ONLY name IN ACCESS EXCLUSIVE MODE"),
+		Converts("LOCK name IN ACCESS EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name IN ACCESS EXCLUSIVE MODE"),
+		Converts("LOCK name * IN ACCESS EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name * IN ACCESS EXCLUSIVE MODE"),
+		Converts("LOCK ONLY name , ONLY name IN ACCESS EXCLUSIVE MODE"),
+		Converts("LOCK TABLE ONLY name , ONLY name IN ACCESS EXCLUSIVE MODE"),
+		Converts("LOCK name , ONLY name IN ACCESS EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name , ONLY name IN ACCESS EXCLUSIVE MODE"),
+		Converts("LOCK name * , ONLY name IN ACCESS EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name * , ONLY name IN ACCESS EXCLUSIVE MODE"),
+		Converts("LOCK ONLY name , name IN ACCESS EXCLUSIVE MODE"),
+		Converts("LOCK TABLE ONLY name , name IN ACCESS EXCLUSIVE MODE"),
+		Converts("LOCK name , name IN ACCESS EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name , name IN ACCESS EXCLUSIVE MODE"),
+		Converts("LOCK name * , name IN ACCESS EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name * , name IN ACCESS EXCLUSIVE MODE"),
+		Converts("LOCK ONLY name , name * IN ACCESS EXCLUSIVE MODE"),
+		Converts("LOCK TABLE ONLY name , name * IN ACCESS EXCLUSIVE MODE"),
+		Converts("LOCK name , name * IN ACCESS EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name , name * IN ACCESS EXCLUSIVE MODE"),
+		Converts("LOCK name * , name * IN ACCESS EXCLUSIVE MODE"),
+		Converts("LOCK TABLE name * , name * IN ACCESS EXCLUSIVE MODE"),
+		Converts("LOCK ONLY name NOWAIT"),
+		Converts("LOCK TABLE ONLY name NOWAIT"),
+		Converts("LOCK name NOWAIT"),
+		Converts("LOCK TABLE name NOWAIT"),
+		Converts("LOCK name * NOWAIT"),
+		Converts("LOCK TABLE name * NOWAIT"),
+		Converts("LOCK ONLY name , ONLY name NOWAIT"),
+		Converts("LOCK TABLE ONLY name , ONLY name NOWAIT"),
+		Converts("LOCK name , ONLY name NOWAIT"),
+		Converts("LOCK TABLE name , ONLY name NOWAIT"),
+		Converts("LOCK name * , ONLY name NOWAIT"),
+		Converts("LOCK TABLE name * , ONLY name NOWAIT"),
+		Converts("LOCK ONLY name , name NOWAIT"),
+		Converts("LOCK TABLE ONLY name , name NOWAIT"),
+		Converts("LOCK name , name NOWAIT"),
+		Converts("LOCK TABLE name , name NOWAIT"),
+		Converts("LOCK name * , name NOWAIT"),
+		Converts("LOCK TABLE name * , name NOWAIT"),
+		Converts("LOCK ONLY name , name * NOWAIT"),
+		Converts("LOCK TABLE ONLY name , name * NOWAIT"),
+		Converts("LOCK name , name * NOWAIT"),
+		Converts("LOCK TABLE name , name * NOWAIT"),
+		Converts("LOCK name * , name * NOWAIT"),
+		Converts("LOCK TABLE name * , name * NOWAIT"),
+		Converts("LOCK ONLY name IN ACCESS SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name IN ACCESS SHARE MODE NOWAIT"),
+		Converts("LOCK name IN ACCESS SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE name IN ACCESS SHARE MODE NOWAIT"),
+		Converts("LOCK name * IN ACCESS SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE name * IN ACCESS SHARE MODE NOWAIT"),
+		Converts("LOCK ONLY name , ONLY name IN ACCESS SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name , ONLY name IN ACCESS SHARE MODE NOWAIT"),
+		Converts("LOCK name , ONLY name IN ACCESS SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE name , ONLY name IN ACCESS SHARE MODE NOWAIT"),
+		Converts("LOCK name * , ONLY name IN ACCESS SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE name * , ONLY name IN ACCESS SHARE MODE NOWAIT"),
+		Converts("LOCK ONLY name , name IN ACCESS SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name , name IN ACCESS SHARE MODE NOWAIT"),
+		Converts("LOCK name , name IN ACCESS SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE name , name IN ACCESS SHARE MODE NOWAIT"),
+		Converts("LOCK name * , name IN ACCESS SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE name * , name IN ACCESS SHARE MODE NOWAIT"),
+		Converts("LOCK ONLY name , name * IN ACCESS SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name , name * IN ACCESS SHARE MODE NOWAIT"),
+		Converts("LOCK name , name * IN ACCESS SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE name , name * IN ACCESS SHARE MODE NOWAIT"),
+		Converts("LOCK name * , name * IN ACCESS SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE name * , name * IN ACCESS SHARE MODE NOWAIT"),
+		Converts("LOCK ONLY name IN ROW SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name IN ROW SHARE MODE NOWAIT"),
+		Converts("LOCK name IN ROW SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE name IN ROW SHARE MODE NOWAIT"),
+		Converts("LOCK name * IN ROW SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE name * IN ROW SHARE MODE NOWAIT"),
+		Converts("LOCK ONLY name , ONLY name IN ROW SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name , ONLY name IN ROW SHARE MODE NOWAIT"),
+		Converts("LOCK name , ONLY name IN ROW SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE name , ONLY name IN ROW SHARE MODE NOWAIT"),
+		Converts("LOCK name * , ONLY name IN ROW SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE name * , ONLY name IN ROW SHARE MODE NOWAIT"),
+		Converts("LOCK ONLY name , name IN ROW SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name , name IN ROW SHARE MODE NOWAIT"),
+		Converts("LOCK name , name IN ROW SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE name , name IN ROW SHARE MODE NOWAIT"),
+		Converts("LOCK name * , name IN ROW SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE name * , name IN ROW SHARE MODE NOWAIT"),
+		Converts("LOCK ONLY name , name * IN ROW SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name , name * IN ROW SHARE MODE NOWAIT"),
+		Converts("LOCK name , name * IN ROW SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE name , name * IN ROW SHARE MODE NOWAIT"),
+		Converts("LOCK name * , name * IN ROW SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE name * , name * IN ROW SHARE MODE NOWAIT"),
+		Converts("LOCK ONLY name IN ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name IN ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name IN ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name IN ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name * IN ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name * IN ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK ONLY name , ONLY name IN ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name , ONLY name IN ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name , ONLY name IN ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name , ONLY name IN ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name * , ONLY name IN ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name * , ONLY name IN ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK ONLY name , name IN ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name , name IN ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name , name IN ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name , name IN ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name * , name IN ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name * , name IN ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK ONLY name , name * IN ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name , name * IN ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name , name * IN ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name , name * IN ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name * , name * IN ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name * , name * IN ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK ONLY name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name * IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name * IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK ONLY name , ONLY name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name , ONLY name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name , ONLY name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name , ONLY name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name * , ONLY name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name * , ONLY name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK ONLY name , name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name , name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name , name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name , name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name * , name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name * , name IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK ONLY name , name * IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name , name * IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name , name * IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name , name * IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name * , name * IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name * , name * IN SHARE UPDATE EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK ONLY name IN SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name IN SHARE MODE NOWAIT"),
+		Converts("LOCK name IN SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE name IN SHARE MODE NOWAIT"),
+		Converts("LOCK name * IN SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE name * IN SHARE MODE NOWAIT"),
+		Converts("LOCK ONLY name , ONLY name IN SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name , ONLY name IN SHARE MODE NOWAIT"),
+		Converts("LOCK name , ONLY name IN SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE name , ONLY name IN SHARE MODE NOWAIT"),
+		Converts("LOCK name * , ONLY name IN SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE name * , ONLY name IN SHARE MODE NOWAIT"),
+		Converts("LOCK ONLY name , name IN SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name , name IN SHARE MODE NOWAIT"),
+		Converts("LOCK name , name IN SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE name , name IN SHARE MODE NOWAIT"),
+		Converts("LOCK name * , name IN SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE name * , name IN SHARE MODE NOWAIT"),
+		Converts("LOCK ONLY name , name * IN SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name , name * IN SHARE MODE NOWAIT"),
+		Converts("LOCK name , name * IN SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE name , name * IN SHARE MODE NOWAIT"),
+		Converts("LOCK name * , name * IN SHARE MODE NOWAIT"),
+		Converts("LOCK TABLE name * , name * IN SHARE MODE NOWAIT"),
+		Converts("LOCK ONLY name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name * IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name * IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK ONLY name , ONLY name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name , ONLY name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name , ONLY name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name , ONLY name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name * , ONLY name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name * , ONLY name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK ONLY name , name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name , name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name , name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name , name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name * , name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name * , name IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK ONLY name , name * IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name , name * IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name , name * IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name , name * IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name * , name * IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name * , name * IN SHARE ROW EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK ONLY name IN EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name IN EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name IN EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name IN EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name * IN EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name * IN EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK ONLY name , ONLY name IN EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name , ONLY name IN EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name , ONLY name IN EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name , ONLY name IN EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name * , ONLY name IN EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name * , ONLY name IN EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK ONLY name , name IN EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name , name IN EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name , name IN EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name , name IN EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name * , name IN EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name * , name IN EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK ONLY name , name * IN EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name , name * IN EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name , name * IN EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name , name * IN EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name * , name * IN EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name * , name * IN EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK ONLY name IN ACCESS EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name IN ACCESS EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name IN ACCESS EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name IN ACCESS EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name * IN ACCESS EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name * IN ACCESS EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK ONLY name , ONLY name IN ACCESS EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name , ONLY name IN ACCESS EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name , ONLY name IN ACCESS EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name , ONLY name IN ACCESS EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name * , ONLY name IN ACCESS EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name * , ONLY name IN ACCESS EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK ONLY name , name IN ACCESS EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name , name IN ACCESS EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name , name IN ACCESS EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name , name IN ACCESS EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name * , name IN ACCESS EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name * , name IN ACCESS EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK ONLY name , name * IN ACCESS EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE ONLY name , name * IN ACCESS EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name , name * IN ACCESS EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name , name * IN ACCESS EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK name * , name * IN ACCESS EXCLUSIVE MODE NOWAIT"),
+		Converts("LOCK TABLE name * , name * IN ACCESS EXCLUSIVE MODE NOWAIT"),
 	}
 	RunTests(t, tests)
 }
@@ -24,7 +24,7 @@ func TestShow(t *testing.T) {
 		Unimplemented("SHOW LC_CTYPE"),
 		Parses("SHOW IS_SUPERUSER"),
 		Converts("SHOW DateStyle"),
-		Parses("SHOW ALL"),
+		Converts("SHOW ALL"),
 	}
 	RunTests(t, tests)
 }
@@ -19,76 +19,76 @@ import "testing"
 func TestStartTransaction(t *testing.T) {
 	tests := []QueryParses{
 		Converts("START TRANSACTION"),
-		Parses("START TRANSACTION ISOLATION LEVEL SERIALIZABLE"),
-		Parses("START TRANSACTION ISOLATION LEVEL REPEATABLE READ"),
-		Parses("START TRANSACTION ISOLATION LEVEL READ COMMITTED"),
-		Parses("START TRANSACTION ISOLATION LEVEL READ UNCOMMITTED"),
+		Converts("START TRANSACTION ISOLATION LEVEL SERIALIZABLE"),
+		Converts("START TRANSACTION ISOLATION LEVEL REPEATABLE READ"),
+		Converts("START TRANSACTION ISOLATION LEVEL READ COMMITTED"),
+		Converts("START TRANSACTION ISOLATION LEVEL READ UNCOMMITTED"),
 		Converts("START TRANSACTION READ WRITE"),
 		Converts("START TRANSACTION READ ONLY"),
-		Parses("START TRANSACTION DEFERRABLE"),
-		Parses("START TRANSACTION NOT DEFERRABLE"),
+		Converts("START TRANSACTION DEFERRABLE"),
+		Converts("START TRANSACTION NOT DEFERRABLE"),
 		Unimplemented("START TRANSACTION ISOLATION LEVEL SERIALIZABLE , ISOLATION LEVEL SERIALIZABLE"),
 		Unimplemented("START TRANSACTION ISOLATION LEVEL REPEATABLE READ , ISOLATION LEVEL SERIALIZABLE"),
 		Unimplemented("START TRANSACTION ISOLATION LEVEL READ COMMITTED , ISOLATION LEVEL SERIALIZABLE"),
 		Unimplemented("START TRANSACTION ISOLATION LEVEL READ UNCOMMITTED , ISOLATION LEVEL SERIALIZABLE"),
-		Parses("START TRANSACTION READ WRITE , ISOLATION LEVEL SERIALIZABLE"),
-		Parses("START TRANSACTION READ ONLY , ISOLATION LEVEL SERIALIZABLE"),
-		Parses("START TRANSACTION DEFERRABLE , ISOLATION LEVEL SERIALIZABLE"),
-		Parses("START TRANSACTION NOT DEFERRABLE , ISOLATION LEVEL SERIALIZABLE"),
+		Converts("START TRANSACTION READ WRITE , ISOLATION LEVEL SERIALIZABLE"),
+		Converts("START TRANSACTION READ ONLY , ISOLATION LEVEL SERIALIZABLE"),
+		Converts("START TRANSACTION DEFERRABLE , ISOLATION LEVEL SERIALIZABLE"),
+		Converts("START TRANSACTION NOT DEFERRABLE , ISOLATION LEVEL SERIALIZABLE"),
 		Unimplemented("START TRANSACTION ISOLATION LEVEL SERIALIZABLE , ISOLATION LEVEL REPEATABLE READ"),
 		Unimplemented("START TRANSACTION ISOLATION LEVEL REPEATABLE READ , ISOLATION LEVEL REPEATABLE READ"),
 		Unimplemented("START TRANSACTION ISOLATION LEVEL READ COMMITTED , ISOLATION LEVEL REPEATABLE READ"),
 		Unimplemented("START TRANSACTION ISOLATION LEVEL READ UNCOMMITTED , ISOLATION LEVEL REPEATABLE READ"),
-		Parses("START TRANSACTION READ WRITE , ISOLATION LEVEL REPEATABLE READ"),
-		Parses("START TRANSACTION READ ONLY , ISOLATION LEVEL REPEATABLE READ"),
-		Parses("START TRANSACTION DEFERRABLE , ISOLATION LEVEL REPEATABLE READ"),
-		Parses("START TRANSACTION NOT DEFERRABLE , ISOLATION LEVEL REPEATABLE READ"),
+		Converts("START TRANSACTION READ WRITE , ISOLATION LEVEL REPEATABLE READ"),
+		Converts("START TRANSACTION READ ONLY , ISOLATION LEVEL REPEATABLE READ"),
+		Converts("START TRANSACTION DEFERRABLE , ISOLATION LEVEL REPEATABLE READ"),
+		Converts("START TRANSACTION NOT DEFERRABLE , ISOLATION LEVEL REPEATABLE READ"),
 		Unimplemented("START TRANSACTION ISOLATION LEVEL SERIALIZABLE , ISOLATION LEVEL READ COMMITTED"),
 		Unimplemented("START TRANSACTION ISOLATION LEVEL REPEATABLE READ , ISOLATION LEVEL READ COMMITTED"),
 		Unimplemented("START TRANSACTION ISOLATION LEVEL READ COMMITTED , ISOLATION LEVEL READ COMMITTED"),
 		Unimplemented("START TRANSACTION ISOLATION LEVEL READ UNCOMMITTED , ISOLATION LEVEL READ COMMITTED"),
-		Parses("START TRANSACTION READ WRITE , ISOLATION LEVEL READ COMMITTED"),
-		Parses("START TRANSACTION READ ONLY , ISOLATION LEVEL READ COMMITTED"),
-		Parses("START TRANSACTION DEFERRABLE , ISOLATION LEVEL READ COMMITTED"),
-		Parses("START TRANSACTION NOT DEFERRABLE , ISOLATION LEVEL READ COMMITTED"),
+		Converts("START TRANSACTION READ WRITE , ISOLATION LEVEL READ COMMITTED"),
+		Converts("START TRANSACTION READ ONLY , ISOLATION LEVEL READ COMMITTED"),
+		Converts("START TRANSACTION DEFERRABLE , ISOLATION LEVEL READ COMMITTED"),
+		Converts("START TRANSACTION NOT DEFERRABLE , ISOLATION LEVEL READ COMMITTED"),
 		Unimplemented("START TRANSACTION ISOLATION LEVEL SERIALIZABLE , ISOLATION LEVEL READ UNCOMMITTED"),
 		Unimplemented("START TRANSACTION ISOLATION LEVEL REPEATABLE READ , ISOLATION LEVEL READ UNCOMMITTED"),
 		Unimplemented("START TRANSACTION ISOLATION LEVEL READ COMMITTED , ISOLATION LEVEL READ UNCOMMITTED"),
 		Unimplemented("START TRANSACTION ISOLATION LEVEL READ UNCOMMITTED , ISOLATION LEVEL READ UNCOMMITTED"),
-		Parses("START TRANSACTION READ WRITE , ISOLATION LEVEL READ UNCOMMITTED"),
-		Parses("START TRANSACTION READ ONLY , ISOLATION LEVEL READ UNCOMMITTED"),
-		Parses("START TRANSACTION DEFERRABLE , ISOLATION LEVEL READ UNCOMMITTED"),
-		Parses("START TRANSACTION NOT DEFERRABLE , ISOLATION LEVEL READ UNCOMMITTED"),
-		Parses("START TRANSACTION ISOLATION LEVEL SERIALIZABLE , READ WRITE"),
-		Parses("START TRANSACTION ISOLATION LEVEL REPEATABLE READ , READ WRITE"),
-		Parses("START TRANSACTION ISOLATION LEVEL READ COMMITTED , READ WRITE"),
-		Parses("START TRANSACTION ISOLATION LEVEL READ UNCOMMITTED , READ WRITE"),
+		Converts("START TRANSACTION READ WRITE , ISOLATION LEVEL READ UNCOMMITTED"),
+		Converts("START TRANSACTION READ ONLY , ISOLATION LEVEL READ UNCOMMITTED"),
+		Converts("START TRANSACTION DEFERRABLE , ISOLATION LEVEL READ UNCOMMITTED"),
+		Converts("START TRANSACTION NOT DEFERRABLE , ISOLATION LEVEL READ UNCOMMITTED"),
+		Converts("START TRANSACTION ISOLATION LEVEL SERIALIZABLE , READ WRITE"),
+		Converts("START TRANSACTION ISOLATION LEVEL REPEATABLE READ , READ WRITE"),
+		Converts("START TRANSACTION ISOLATION LEVEL READ COMMITTED , READ WRITE"),
+		Converts("START TRANSACTION ISOLATION LEVEL READ UNCOMMITTED , READ WRITE"),
 		Unimplemented("START TRANSACTION READ WRITE , READ WRITE"),
 		Unimplemented("START TRANSACTION READ ONLY , READ WRITE"),
-		Parses("START TRANSACTION DEFERRABLE , READ WRITE"),
-		Parses("START TRANSACTION NOT DEFERRABLE , READ WRITE"),
-		Parses("START TRANSACTION ISOLATION LEVEL SERIALIZABLE , READ ONLY"),
-		Parses("START TRANSACTION ISOLATION LEVEL REPEATABLE READ , READ ONLY"),
-		Parses("START TRANSACTION ISOLATION LEVEL READ COMMITTED , READ ONLY"),
-		Parses("START TRANSACTION ISOLATION LEVEL READ UNCOMMITTED , READ ONLY"),
+		Converts("START TRANSACTION DEFERRABLE , READ WRITE"),
+		Converts("START TRANSACTION NOT DEFERRABLE , READ WRITE"),
+		Converts("START TRANSACTION ISOLATION LEVEL SERIALIZABLE , READ ONLY"),
+		Converts("START TRANSACTION ISOLATION LEVEL REPEATABLE READ , READ ONLY"),
+		Converts("START TRANSACTION ISOLATION LEVEL READ COMMITTED , READ ONLY"),
+		Converts("START TRANSACTION ISOLATION LEVEL READ UNCOMMITTED , READ ONLY"),
 		Unimplemented("START TRANSACTION READ WRITE , READ ONLY"),
 		Unimplemented("START TRANSACTION READ ONLY , READ ONLY"),
-		Parses("START TRANSACTION DEFERRABLE , READ ONLY"),
-		Parses("START TRANSACTION NOT DEFERRABLE , READ ONLY"),
-		Parses("START TRANSACTION ISOLATION LEVEL SERIALIZABLE , DEFERRABLE"),
-		Parses("START TRANSACTION ISOLATION LEVEL REPEATABLE READ , DEFERRABLE"),
-		Parses("START TRANSACTION ISOLATION LEVEL READ COMMITTED , DEFERRABLE"),
-		Parses("START TRANSACTION ISOLATION LEVEL READ UNCOMMITTED , DEFERRABLE"),
-		Parses("START TRANSACTION READ WRITE , DEFERRABLE"),
-		Parses("START TRANSACTION READ ONLY , DEFERRABLE"),
+		Converts("START TRANSACTION DEFERRABLE , READ ONLY"),
+		Converts("START TRANSACTION NOT DEFERRABLE , READ ONLY"),
+		Converts("START TRANSACTION ISOLATION LEVEL SERIALIZABLE , DEFERRABLE"),
+		Converts("START TRANSACTION ISOLATION LEVEL REPEATABLE READ , DEFERRABLE"),
+		Converts("START TRANSACTION ISOLATION LEVEL READ COMMITTED , DEFERRABLE"),
+		Converts("START TRANSACTION ISOLATION LEVEL READ UNCOMMITTED , DEFERRABLE"),
+		Converts("START TRANSACTION READ WRITE , DEFERRABLE"),
+		Converts("START TRANSACTION READ ONLY , DEFERRABLE"),
 		Unimplemented("START TRANSACTION DEFERRABLE , DEFERRABLE"),
 		Unimplemented("START TRANSACTION NOT DEFERRABLE , DEFERRABLE"),
-		Parses("START TRANSACTION ISOLATION LEVEL SERIALIZABLE , NOT DEFERRABLE"),
-		Parses("START TRANSACTION ISOLATION LEVEL REPEATABLE READ , NOT DEFERRABLE"),
-		Parses("START TRANSACTION ISOLATION LEVEL READ COMMITTED , NOT DEFERRABLE"),
-		Parses("START TRANSACTION ISOLATION LEVEL READ UNCOMMITTED , NOT DEFERRABLE"),
-		Parses("START TRANSACTION READ WRITE , NOT DEFERRABLE"),
-		Parses("START TRANSACTION READ ONLY , NOT DEFERRABLE"),
+		Converts("START TRANSACTION ISOLATION LEVEL SERIALIZABLE , NOT DEFERRABLE"),
+		Converts("START TRANSACTION ISOLATION LEVEL REPEATABLE READ , NOT DEFERRABLE"),
+		Converts("START TRANSACTION ISOLATION LEVEL READ COMMITTED , NOT DEFERRABLE"),
+		Converts("START TRANSACTION ISOLATION LEVEL READ UNCOMMITTED , NOT DEFERRABLE"),
+		Converts("START TRANSACTION READ WRITE , NOT DEFERRABLE"),
+		Converts("START TRANSACTION READ ONLY , NOT DEFERRABLE"),
 		Unimplemented("START TRANSACTION DEFERRABLE , NOT DEFERRABLE"),
 		Unimplemented("START TRANSACTION NOT DEFERRABLE , NOT DEFERRABLE"),
 	}
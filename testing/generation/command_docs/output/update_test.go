@@ -747,7 +747,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name * SET ( column_name ) = ( expression , expression ) , ( column_name ) = ( DEFAULT ) RETURNING *"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name ) = ( expression , expression ) , ( column_name ) = ( DEFAULT ) RETURNING *"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * AS alias SET ( column_name , column_name ) = ( DEFAULT , expression ) , ( column_name ) = ( DEFAULT ) RETURNING *"),
-		Parses("UPDATE table_name SET column_name = DEFAULT , ( column_name , column_name ) = ( DEFAULT ) RETURNING *"),
+		Converts("UPDATE table_name SET column_name = DEFAULT , ( column_name , column_name ) = ( DEFAULT ) RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name , column_name ) = ( expression ) , ( column_name ) = ROW ( DEFAULT ) RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name alias SET ( column_name , column_name ) = ( expression , expression ) , ( column_name ) = ROW ( DEFAULT ) RETURNING *"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name SET ( column_name , column_name ) = ROW ( expression , expression ) , ( column_name , column_name ) = ROW ( DEFAULT ) RETURNING *"),
@@ -966,7 +966,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("UPDATE table_name AS alias SET ( column_name , column_name ) = ROW ( expression ) WHERE condition RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name AS alias SET ( column_name ) = ( DEFAULT ) WHERE condition RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE table_name * alias SET ( column_name ) = ROW ( DEFAULT , DEFAULT ) WHERE condition RETURNING *"),
-		Parses("UPDATE table_name SET ( column_name , column_name ) = ( expression ) , column_name = expression WHERE condition RETURNING *"),
+		Converts("UPDATE table_name SET ( column_name , column_name ) = ( expression ) , column_name = expression WHERE condition RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE table_name * alias SET ( column_name ) = ROW ( expression ) , column_name = expression WHERE condition RETURNING *"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name AS alias SET ( column_name , column_name ) = ROW ( DEFAULT ) , column_name = expression WHERE condition RETURNING *"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * alias SET ( column_name ) = ( expression , expression ) , column_name = expression WHERE condition RETURNING *"),
@@ -993,7 +993,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * alias SET ( column_name , column_name ) = ROW ( expression , DEFAULT ) , ( column_name ) = ( DEFAULT ) WHERE condition RETURNING *"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name * AS alias SET ( column_name , column_name ) = ROW ( expression , DEFAULT ) , ( column_name ) = ( DEFAULT ) WHERE condition RETURNING *"),
 		Unimplemented("UPDATE table_name * SET ( column_name ) = ROW ( DEFAULT ) , ( column_name , column_name ) = ( DEFAULT ) WHERE condition RETURNING *"),
-		Parses("UPDATE table_name SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ( DEFAULT ) WHERE condition RETURNING *"),
+		Converts("UPDATE table_name SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ( DEFAULT ) WHERE condition RETURNING *"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name SET column_name = expression , ( column_name ) = ROW ( DEFAULT ) WHERE condition RETURNING *"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name SET ( column_name ) = ROW ( DEFAULT ) , ( column_name ) = ROW ( DEFAULT ) WHERE condition RETURNING *"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name * AS alias SET ( column_name ) = ROW ( expression , expression ) , ( column_name ) = ROW ( DEFAULT ) WHERE condition RETURNING *"),
@@ -1722,7 +1722,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name alias SET ( column_name , column_name ) = ( expression , DEFAULT ) , ( column_name ) = ( expression ) WHERE condition RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE table_name SET ( column_name ) = ROW ( DEFAULT , DEFAULT ) , ( column_name ) = ( expression ) WHERE condition RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE ONLY table_name SET ( column_name , column_name ) = ( SELECT 1 ) , ( column_name ) = ( expression ) WHERE condition RETURNING colname"),
-		Parses("UPDATE table_name AS alias SET column_name = expression , ( column_name , column_name ) = ( expression ) WHERE condition RETURNING colname"),
+		Converts("UPDATE table_name AS alias SET column_name = expression , ( column_name , column_name ) = ( expression ) WHERE condition RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE table_name * SET ( column_name ) = ROW ( expression ) , ( column_name , column_name ) = ( expression ) WHERE condition RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name SET ( column_name , column_name ) = ( expression , expression ) , ( column_name , column_name ) = ( expression ) WHERE condition RETURNING colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name SET ( column_name ) = ( DEFAULT , expression ) , ( column_name , column_name ) = ( expression ) WHERE condition RETURNING colname"),
@@ -1742,7 +1742,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name , column_name ) = ( expression ) , ( column_name ) = ROW ( expression , expression ) WHERE condition RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name SET ( column_name ) = ( expression , DEFAULT ) , ( column_name ) = ROW ( expression , expression ) WHERE condition RETURNING colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name SET ( column_name , column_name ) = ROW ( DEFAULT , expression ) , ( column_name , column_name ) = ROW ( expression , expression ) WHERE condition RETURNING colname"),
-		Parses("UPDATE table_name AS alias SET ( column_name ) = ( DEFAULT , expression ) , ( column_name ) = ( DEFAULT , expression ) WHERE condition RETURNING colname"),
+		Converts("UPDATE table_name AS alias SET ( column_name ) = ( DEFAULT , expression ) , ( column_name ) = ( DEFAULT , expression ) WHERE condition RETURNING colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name * SET ( column_name ) = ROW ( expression , DEFAULT ) , ( column_name ) = ( DEFAULT , expression ) WHERE condition RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name SET ( column_name ) = ( DEFAULT ) , ( column_name , column_name ) = ( DEFAULT , expression ) WHERE condition RETURNING colname"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name ) = ( DEFAULT , expression ) , ( column_name , column_name ) = ( DEFAULT , expression ) WHERE condition RETURNING colname"),
@@ -2161,7 +2161,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name ) = ROW ( expression ) , ( column_name ) = ( DEFAULT ) RETURNING colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name , column_name ) = ( expression , DEFAULT ) , ( column_name ) = ( DEFAULT ) RETURNING colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name SET column_name = expression , ( column_name , column_name ) = ( DEFAULT ) RETURNING colname output_name"),
-		Parses("UPDATE table_name AS alias SET ( column_name ) = ( DEFAULT , expression ) , ( column_name , column_name ) = ( DEFAULT ) RETURNING colname output_name"),
+		Converts("UPDATE table_name AS alias SET ( column_name ) = ( DEFAULT , expression ) , ( column_name , column_name ) = ( DEFAULT ) RETURNING colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * AS alias SET ( column_name , column_name ) = ROW ( DEFAULT , expression ) , ( column_name ) = ROW ( DEFAULT ) RETURNING colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name SET ( column_name ) = ROW ( expression ) , ( column_name , column_name ) = ROW ( DEFAULT ) RETURNING colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name SET ( column_name ) = ROW ( DEFAULT , expression ) , ( column_name , column_name ) = ROW ( DEFAULT ) RETURNING colname output_name"),
@@ -2411,7 +2411,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name alias SET ( column_name ) = ( expression ) , ( column_name , column_name ) = ROW ( expression ) WHERE condition RETURNING colname output_name"),
 		Unimplemented("UPDATE ONLY table_name AS alias SET ( column_name , column_name ) = ( expression , DEFAULT ) , ( column_name , column_name ) = ROW ( expression ) WHERE condition RETURNING colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name ) = ROW ( DEFAULT , DEFAULT ) , ( column_name ) = ( DEFAULT ) WHERE condition RETURNING colname output_name"),
-		Parses("UPDATE table_name alias SET ( column_name ) = ( DEFAULT , expression ) , ( column_name , column_name ) = ( DEFAULT ) WHERE condition RETURNING colname output_name"),
+		Converts("UPDATE table_name alias SET ( column_name ) = ( DEFAULT , expression ) , ( column_name , column_name ) = ( DEFAULT ) WHERE condition RETURNING colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * SET ( column_name , column_name ) = ROW ( DEFAULT , DEFAULT ) , ( column_name ) = ROW ( DEFAULT ) WHERE condition RETURNING colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * AS alias SET ( column_name , column_name ) = ROW ( DEFAULT , DEFAULT ) , ( column_name ) = ROW ( DEFAULT ) WHERE condition RETURNING colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * SET ( column_name ) = ( expression , expression ) , ( column_name , column_name ) = ROW ( DEFAULT ) WHERE condition RETURNING colname output_name"),
@@ -2845,7 +2845,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name ) = ROW ( expression , expression ) , ( column_name , column_name ) = ROW ( expression ) RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE table_name * AS alias SET ( column_name ) = ROW ( expression , DEFAULT ) , ( column_name , column_name ) = ROW ( expression ) RETURNING colname AS output_name"),
 		Unimplemented("UPDATE ONLY table_name alias SET ( column_name ) = ( DEFAULT ) , ( column_name ) = ( DEFAULT ) RETURNING colname AS output_name"),
-		Parses("UPDATE table_name alias SET ( column_name , column_name ) = ( DEFAULT , expression ) , ( column_name ) = ( DEFAULT ) RETURNING colname AS output_name"),
+		Converts("UPDATE table_name alias SET ( column_name , column_name ) = ( DEFAULT , expression ) , ( column_name ) = ( DEFAULT ) RETURNING colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name * alias SET ( column_name , column_name ) = ( DEFAULT , expression ) , ( column_name ) = ( DEFAULT ) RETURNING colname AS output_name"),
 		Unimplemented("UPDATE ONLY table_name AS alias SET ( column_name , column_name ) = ROW ( DEFAULT , expression ) , ( column_name ) = ( DEFAULT ) RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name ) = ROW ( DEFAULT ) , ( column_name , column_name ) = ( DEFAULT ) RETURNING colname AS output_name"),
@@ -2880,7 +2880,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name ) = ROW ( expression , DEFAULT ) RETURNING colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name AS alias SET column_name = expression , ( column_name , column_name ) = ROW ( expression , DEFAULT ) RETURNING colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name alias SET ( column_name , column_name ) = ( expression , expression ) , ( column_name , column_name ) = ROW ( expression , DEFAULT ) RETURNING colname AS output_name"),
-		Parses("UPDATE table_name alias SET ( column_name ) = ( SELECT 1 ) , ( column_name ) = ( DEFAULT , DEFAULT ) RETURNING colname AS output_name"),
+		Converts("UPDATE table_name alias SET ( column_name ) = ( SELECT 1 ) , ( column_name ) = ( DEFAULT , DEFAULT ) RETURNING colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name * AS alias SET ( column_name , column_name ) = ( expression ) , ( column_name , column_name ) = ( DEFAULT , DEFAULT ) RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * AS alias SET ( column_name , column_name ) = ( expression ) , ( column_name , column_name ) = ( DEFAULT , DEFAULT ) RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE table_name AS alias SET ( column_name ) = ( expression , expression ) , ( column_name , column_name ) = ( DEFAULT , DEFAULT ) RETURNING colname AS output_name"),
@@ -2890,7 +2890,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("UPDATE table_name * alias SET ( column_name ) = ( expression ) , ( column_name , column_name ) = ROW ( DEFAULT , DEFAULT ) RETURNING colname AS output_name"),
 		Unimplemented("UPDATE table_name alias SET ( column_name ) = ( DEFAULT , expression ) , ( column_name , column_name ) = ROW ( DEFAULT , DEFAULT ) RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name AS alias SET ( column_name , column_name ) = ROW ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ROW ( DEFAULT , DEFAULT ) RETURNING colname AS output_name"),
-		Parses("UPDATE table_name AS alias SET ( column_name , column_name ) = ( expression , DEFAULT ) , ( column_name ) = ( SELECT 1 ) RETURNING colname AS output_name"),
+		Converts("UPDATE table_name AS alias SET ( column_name , column_name ) = ( expression , DEFAULT ) , ( column_name ) = ( SELECT 1 ) RETURNING colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name , column_name ) = ROW ( expression , DEFAULT ) , ( column_name ) = ( SELECT 1 ) RETURNING colname AS output_name"),
 		Unimplemented("UPDATE table_name AS alias SET ( column_name , column_name ) = ROW ( DEFAULT , DEFAULT ) , ( column_name ) = ( SELECT 1 ) RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name ) = ( DEFAULT ) , ( column_name , column_name ) = ( SELECT 1 ) RETURNING colname AS output_name"),
@@ -3082,7 +3082,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("UPDATE table_name SET ( column_name , column_name ) = ( expression , DEFAULT ) , ( column_name , column_name ) = ROW ( expression ) WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name , column_name ) = ( expression , DEFAULT ) , ( column_name , column_name ) = ROW ( expression ) WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE table_name SET ( column_name ) = ( DEFAULT , expression ) , ( column_name ) = ( DEFAULT ) WHERE condition RETURNING colname AS output_name"),
-		Parses("UPDATE table_name SET ( column_name ) = ( expression , DEFAULT ) , ( column_name ) = ( DEFAULT ) WHERE condition RETURNING colname AS output_name"),
+		Converts("UPDATE table_name SET ( column_name ) = ( expression , DEFAULT ) , ( column_name ) = ( DEFAULT ) WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * AS alias SET ( column_name ) = ( expression ) , ( column_name , column_name ) = ( DEFAULT ) WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * AS alias SET ( column_name , column_name ) = ( DEFAULT , expression ) , ( column_name , column_name ) = ( DEFAULT ) WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE ONLY table_name alias SET column_name = expression , ( column_name ) = ROW ( DEFAULT ) WHERE condition RETURNING colname AS output_name"),
@@ -3140,7 +3140,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name AS alias SET column_name = expression , ( column_name , column_name ) = ROW ( DEFAULT , DEFAULT ) WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * SET ( column_name ) = ( expression , expression ) , ( column_name , column_name ) = ROW ( DEFAULT , DEFAULT ) WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE table_name * alias SET ( column_name ) = ROW ( expression , DEFAULT ) , ( column_name , column_name ) = ROW ( DEFAULT , DEFAULT ) WHERE condition RETURNING colname AS output_name"),
-		Parses("UPDATE table_name AS alias SET ( column_name ) = ( DEFAULT , expression ) , ( column_name ) = ( SELECT 1 ) WHERE condition RETURNING colname AS output_name"),
+		Converts("UPDATE table_name AS alias SET ( column_name ) = ( DEFAULT , expression ) , ( column_name ) = ( SELECT 1 ) WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("UPDATE table_name AS alias SET ( column_name ) = ROW ( expression , DEFAULT ) , ( column_name ) = ( SELECT 1 ) WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE table_name AS alias SET ( column_name ) = ROW ( expression , DEFAULT ) , ( column_name ) = ( SELECT 1 ) WHERE condition RETURNING colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name ) = ROW ( DEFAULT , DEFAULT ) , ( column_name ) = ( SELECT 1 ) WHERE condition RETURNING colname AS output_name"),
@@ -3577,7 +3577,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name , column_name ) = ( expression , DEFAULT ) , ( column_name , column_name ) = ROW ( expression ) RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ROW ( expression ) RETURNING colname , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE ONLY table_name alias SET column_name = DEFAULT , ( column_name ) = ( DEFAULT ) RETURNING colname , colname"),
-		Parses("UPDATE table_name AS alias SET ( column_name , column_name ) = ( expression ) , ( column_name , column_name ) = ( DEFAULT ) RETURNING colname , colname"),
+		Converts("UPDATE table_name AS alias SET ( column_name , column_name ) = ( expression ) , ( column_name , column_name ) = ( DEFAULT ) RETURNING colname , colname"),
 		Unimplemented("UPDATE table_name * alias SET ( column_name , column_name ) = ( DEFAULT , expression ) , ( column_name , column_name ) = ( DEFAULT ) RETURNING colname , colname"),
 		Unimplemented("UPDATE table_name SET ( column_name , column_name ) = ROW ( DEFAULT , expression ) , ( column_name ) = ROW ( DEFAULT ) RETURNING colname , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * AS alias SET ( column_name ) = ( SELECT 1 ) , ( column_name ) = ROW ( DEFAULT ) RETURNING colname , colname"),
@@ -3626,7 +3626,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name ) = ( expression , expression ) , ( column_name ) = ( SELECT 1 ) RETURNING colname , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name alias SET column_name = expression , ( column_name , column_name ) = ( SELECT 1 ) RETURNING colname , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name AS alias SET ( column_name ) = ROW ( expression ) , ( column_name , column_name ) = ( SELECT 1 ) RETURNING colname , colname"),
-		Parses("UPDATE table_name AS alias SET ( column_name , column_name ) = ( DEFAULT ) , ( column_name , column_name ) = ( SELECT 1 ) RETURNING colname , colname"),
+		Converts("UPDATE table_name AS alias SET ( column_name , column_name ) = ( DEFAULT ) , ( column_name , column_name ) = ( SELECT 1 ) RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name ) = ROW ( expression , expression ) , ( column_name , column_name ) = ( SELECT 1 ) RETURNING colname , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name ) = ROW ( expression , expression ) , ( column_name , column_name ) = ( SELECT 1 ) RETURNING colname , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name SET ( column_name , column_name ) = ( DEFAULT , expression ) , ( column_name , column_name ) = ( SELECT 1 ) RETURNING colname , colname"),
@@ -3803,7 +3803,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name * AS alias SET column_name = expression , ( column_name ) = ROW ( DEFAULT ) WHERE condition RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name ) = ROW ( expression , expression ) , ( column_name ) = ROW ( DEFAULT ) WHERE condition RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name ) = ROW ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ROW ( DEFAULT ) WHERE condition RETURNING colname , colname"),
-		Parses("UPDATE table_name SET column_name = DEFAULT , ( column_name , column_name ) = ( expression , expression ) WHERE condition RETURNING colname , colname"),
+		Converts("UPDATE table_name SET column_name = DEFAULT , ( column_name , column_name ) = ( expression , expression ) WHERE condition RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE table_name * SET ( column_name , column_name ) = ROW ( expression ) , ( column_name , column_name ) = ( expression , expression ) WHERE condition RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name ) = ( DEFAULT ) , ( column_name , column_name ) = ( expression , expression ) WHERE condition RETURNING colname , colname"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE table_name * SET ( column_name ) = ROW ( DEFAULT ) , ( column_name , column_name ) = ( expression , expression ) WHERE condition RETURNING colname , colname"),
@@ -4244,12 +4244,12 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name alias SET ( column_name , column_name ) = ( SELECT 1 ) , ( column_name , column_name ) = ( SELECT 1 ) FROM from_item , from_item WHERE CURRENT OF cursor_name RETURNING colname , colname"),
 		Unimplemented("UPDATE table_name AS alias SET ( column_name ) = ROW ( expression ) RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name * alias SET ( column_name ) = ( DEFAULT , DEFAULT ) RETURNING colname output_name , colname"),
-		Parses("UPDATE table_name AS alias SET ( column_name ) = ( DEFAULT , expression ) , column_name = expression RETURNING colname output_name , colname"),
+		Converts("UPDATE table_name AS alias SET ( column_name ) = ( DEFAULT , expression ) , column_name = expression RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name , column_name ) = ( expression , expression ) , column_name = DEFAULT RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name , column_name ) = ( expression ) , ( column_name ) = ( expression ) RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name * AS alias SET ( column_name , column_name ) = ROW ( expression ) , ( column_name ) = ( expression ) RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name SET ( column_name , column_name ) = ROW ( expression , DEFAULT ) , ( column_name ) = ( expression ) RETURNING colname output_name , colname"),
-		Parses("UPDATE table_name SET ( column_name ) = ( DEFAULT , DEFAULT ) , ( column_name ) = ( expression ) RETURNING colname output_name , colname"),
+		Converts("UPDATE table_name SET ( column_name ) = ( DEFAULT , DEFAULT ) , ( column_name ) = ( expression ) RETURNING colname output_name , colname"),
 		Unimplemented("UPDATE table_name * AS alias SET ( column_name , column_name ) = ( expression , expression ) , ( column_name , column_name ) = ( expression ) RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name ) = ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ( expression ) RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name SET ( column_name ) = ( expression ) , ( column_name ) = ROW ( expression ) RETURNING colname output_name , colname"),
@@ -4289,12 +4289,12 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name , column_name ) = ROW ( DEFAULT ) , ( column_name ) = ( expression , DEFAULT ) RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name * SET ( column_name ) = ( SELECT 1 ) , ( column_name ) = ( expression , DEFAULT ) RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name ) = ( SELECT 1 ) , ( column_name ) = ( expression , DEFAULT ) RETURNING colname output_name , colname"),
-		Parses("UPDATE table_name alias SET ( column_name , column_name ) = ( DEFAULT ) , ( column_name , column_name ) = ( expression , DEFAULT ) RETURNING colname output_name , colname"),
+		Converts("UPDATE table_name alias SET ( column_name , column_name ) = ( DEFAULT ) , ( column_name , column_name ) = ( expression , DEFAULT ) RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * SET ( column_name , column_name ) = ROW ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ( expression , DEFAULT ) RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name ) = ( SELECT 1 ) , ( column_name , column_name ) = ( expression , DEFAULT ) RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name alias SET column_name = DEFAULT , ( column_name ) = ROW ( expression , DEFAULT ) RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE ONLY table_name SET ( column_name ) = ( expression ) , ( column_name ) = ROW ( expression , DEFAULT ) RETURNING colname output_name , colname"),
-		Parses("UPDATE table_name alias SET column_name = expression , ( column_name ) = ( DEFAULT , DEFAULT ) RETURNING colname output_name , colname"),
+		Converts("UPDATE table_name alias SET column_name = expression , ( column_name ) = ( DEFAULT , DEFAULT ) RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name SET ( column_name ) = ROW ( DEFAULT , expression ) , ( column_name ) = ( DEFAULT , DEFAULT ) RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name SET ( column_name ) = ( expression ) , ( column_name , column_name ) = ( DEFAULT , DEFAULT ) RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE ONLY table_name SET ( column_name , column_name ) = ROW ( expression , DEFAULT ) , ( column_name , column_name ) = ( DEFAULT , DEFAULT ) RETURNING colname output_name , colname"),
@@ -4503,7 +4503,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name alias SET ( column_name , column_name ) = ( expression ) , ( column_name ) = ( DEFAULT ) WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name ) = ROW ( DEFAULT ) , ( column_name ) = ( DEFAULT ) WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE table_name * AS alias SET ( column_name ) = ROW ( expression , expression ) , ( column_name ) = ( DEFAULT ) WHERE condition RETURNING colname output_name , colname"),
-		Parses("UPDATE table_name alias SET column_name = expression , ( column_name , column_name ) = ( DEFAULT ) WHERE condition RETURNING colname output_name , colname"),
+		Converts("UPDATE table_name alias SET column_name = expression , ( column_name , column_name ) = ( DEFAULT ) WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name , column_name ) = ( expression ) , ( column_name , column_name ) = ( DEFAULT ) WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name AS alias SET ( column_name , column_name ) = ( expression ) , ( column_name , column_name ) = ( DEFAULT ) WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * SET ( column_name ) = ROW ( expression ) , ( column_name , column_name ) = ( DEFAULT ) WHERE condition RETURNING colname output_name , colname"),
@@ -4523,7 +4523,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name SET ( column_name ) = ( expression , expression ) , ( column_name , column_name ) = ( expression , expression ) WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name AS alias SET ( column_name , column_name ) = ( expression , expression ) , ( column_name , column_name ) = ( expression , expression ) WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ( expression , expression ) WHERE condition RETURNING colname output_name , colname"),
-		Parses("UPDATE table_name SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ( expression , expression ) WHERE condition RETURNING colname output_name , colname"),
+		Converts("UPDATE table_name SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ( expression , expression ) WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name AS alias SET column_name = DEFAULT , ( column_name ) = ROW ( expression , expression ) WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name alias SET ( column_name ) = ROW ( expression ) , ( column_name ) = ROW ( expression , expression ) WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("UPDATE table_name * AS alias SET ( column_name ) = ( DEFAULT ) , ( column_name ) = ROW ( expression , expression ) WHERE condition RETURNING colname output_name , colname"),
@@ -4552,7 +4552,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("UPDATE table_name * AS alias SET ( column_name ) = ROW ( expression ) , ( column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("UPDATE table_name AS alias SET ( column_name ) = ROW ( DEFAULT , expression ) , ( column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * AS alias SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname output_name , colname"),
-		Parses("UPDATE table_name AS alias SET ( column_name ) = ( SELECT 1 ) , ( column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname output_name , colname"),
+		Converts("UPDATE table_name AS alias SET ( column_name ) = ( SELECT 1 ) , ( column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * alias SET ( column_name ) = ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("UPDATE ONLY table_name SET ( column_name ) = ROW ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ( expression , DEFAULT ) WHERE condition RETURNING colname output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name * alias SET ( column_name ) = ( DEFAULT , expression ) , ( column_name ) = ROW ( expression , DEFAULT ) WHERE condition RETURNING colname output_name , colname"),
@@ -5008,7 +5008,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name ) = ( expression , DEFAULT ) , ( column_name , column_name ) = ROW ( expression , expression ) RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name AS alias SET ( column_name ) = ( expression ) , ( column_name ) = ( DEFAULT , expression ) RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * SET ( column_name , column_name ) = ROW ( expression , DEFAULT ) , ( column_name ) = ( DEFAULT , expression ) RETURNING colname AS output_name , colname"),
-		Parses("UPDATE table_name SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name ) = ( DEFAULT , expression ) RETURNING colname AS output_name , colname"),
+		Converts("UPDATE table_name SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name ) = ( DEFAULT , expression ) RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE ONLY table_name SET ( column_name , column_name ) = ( expression , expression ) , ( column_name , column_name ) = ( DEFAULT , expression ) RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE table_name SET ( column_name , column_name ) = ROW ( expression , DEFAULT ) , ( column_name , column_name ) = ( DEFAULT , expression ) RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name alias SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ( DEFAULT , expression ) RETURNING colname AS output_name , colname"),
@@ -5020,7 +5020,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("UPDATE ONLY table_name alias SET ( column_name ) = ( expression , DEFAULT ) , ( column_name , column_name ) = ROW ( DEFAULT , expression ) RETURNING colname AS output_name , colname"),
 		Unimplemented("UPDATE table_name SET ( column_name ) = ROW ( expression ) , ( column_name ) = ( expression , DEFAULT ) RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name SET ( column_name ) = ( DEFAULT , expression ) , ( column_name ) = ( expression , DEFAULT ) RETURNING colname AS output_name , colname"),
-		Parses("UPDATE table_name AS alias SET ( column_name ) = ( expression ) , ( column_name , column_name ) = ( expression , DEFAULT ) RETURNING colname AS output_name , colname"),
+		Converts("UPDATE table_name AS alias SET ( column_name ) = ( expression ) , ( column_name , column_name ) = ( expression , DEFAULT ) RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * alias SET ( column_name , column_name ) = ( expression ) , ( column_name , column_name ) = ( expression , DEFAULT ) RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name SET ( column_name , column_name ) = ROW ( expression ) , ( column_name , column_name ) = ( expression , DEFAULT ) RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE ONLY table_name SET ( column_name , column_name ) = ROW ( expression , expression ) , ( column_name , column_name ) = ( expression , DEFAULT ) RETURNING colname AS output_name , colname"),
@@ -5275,7 +5275,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name * SET ( column_name , column_name ) = ( DEFAULT , expression ) , ( column_name ) = ( DEFAULT , DEFAULT ) WHERE condition RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name ) = ROW ( DEFAULT ) , ( column_name , column_name ) = ( DEFAULT , DEFAULT ) WHERE condition RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name SET ( column_name ) = ( SELECT 1 ) , ( column_name , column_name ) = ( DEFAULT , DEFAULT ) WHERE condition RETURNING colname AS output_name , colname"),
-		Parses("UPDATE table_name alias SET ( column_name , column_name ) = ( SELECT 1 ) , ( column_name , column_name ) = ( DEFAULT , DEFAULT ) WHERE condition RETURNING colname AS output_name , colname"),
+		Converts("UPDATE table_name alias SET ( column_name , column_name ) = ( SELECT 1 ) , ( column_name , column_name ) = ( DEFAULT , DEFAULT ) WHERE condition RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name , column_name ) = ( expression ) , ( column_name ) = ROW ( DEFAULT , DEFAULT ) WHERE condition RETURNING colname AS output_name , colname"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name * alias SET ( column_name ) = ( DEFAULT , DEFAULT ) , ( column_name ) = ROW ( DEFAULT , DEFAULT ) WHERE condition RETURNING colname AS output_name , colname"),
 		Unimplemented("UPDATE table_name * alias SET ( column_name ) = ( SELECT 1 ) , ( column_name ) = ROW ( DEFAULT , DEFAULT ) WHERE condition RETURNING colname AS output_name , colname"),
@@ -7047,7 +7047,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * SET ( column_name ) = ( expression ) , ( column_name , column_name ) = ( SELECT 1 ) FROM from_item , from_item WHERE CURRENT OF cursor_name RETURNING colname output_name , colname output_name"),
 		Unimplemented("UPDATE ONLY table_name AS alias SET ( column_name , column_name ) = ROW ( expression , DEFAULT ) , ( column_name , column_name ) = ( SELECT 1 ) FROM from_item , from_item WHERE CURRENT OF cursor_name RETURNING colname output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * AS alias SET ( column_name , column_name ) = ROW ( expression , DEFAULT ) , ( column_name , column_name ) = ( SELECT 1 ) FROM from_item , from_item WHERE CURRENT OF cursor_name RETURNING colname output_name , colname output_name"),
-		Parses("UPDATE table_name alias SET column_name = expression RETURNING colname AS output_name , colname output_name"),
+		Converts("UPDATE table_name alias SET column_name = expression RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name SET ( column_name ) = ROW ( DEFAULT ) RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name , column_name ) = ( expression , expression ) RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name , column_name ) = ROW ( DEFAULT , expression ) RETURNING colname AS output_name , colname output_name"),
@@ -7055,7 +7055,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name SET ( column_name ) = ROW ( DEFAULT ) , column_name = expression RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("UPDATE table_name alias SET ( column_name , column_name ) = ROW ( DEFAULT ) , column_name = expression RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE table_name * alias SET ( column_name , column_name ) = ROW ( DEFAULT ) , column_name = expression RETURNING colname AS output_name , colname output_name"),
-		Parses("UPDATE table_name alias SET ( column_name ) = ( expression , expression ) , column_name = expression RETURNING colname AS output_name , colname output_name"),
+		Converts("UPDATE table_name alias SET ( column_name ) = ( expression , expression ) , column_name = expression RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name ) = ROW ( DEFAULT , DEFAULT ) , column_name = expression RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("UPDATE table_name * alias SET ( column_name ) = ( DEFAULT ) , column_name = DEFAULT RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("UPDATE table_name AS alias SET ( column_name ) = ROW ( expression , expression ) , ( column_name ) = ( expression ) RETURNING colname AS output_name , colname output_name"),
@@ -7101,7 +7101,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name ) = ( expression , expression ) , ( column_name , column_name ) = ROW ( expression , expression ) RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name alias SET ( column_name ) = ( expression ) , ( column_name ) = ( DEFAULT , expression ) RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE ONLY table_name SET ( column_name ) = ( DEFAULT ) , ( column_name ) = ( DEFAULT , expression ) RETURNING colname AS output_name , colname output_name"),
-		Parses("UPDATE table_name SET ( column_name , column_name ) = ( expression , expression ) , ( column_name ) = ( DEFAULT , expression ) RETURNING colname AS output_name , colname output_name"),
+		Converts("UPDATE table_name SET ( column_name , column_name ) = ( expression , expression ) , ( column_name ) = ( DEFAULT , expression ) RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * AS alias SET ( column_name ) = ( DEFAULT , DEFAULT ) , ( column_name ) = ( DEFAULT , expression ) RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name , column_name ) = ROW ( expression , DEFAULT ) , ( column_name , column_name ) = ( DEFAULT , expression ) RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ( DEFAULT , expression ) RETURNING colname AS output_name , colname output_name"),
@@ -7289,12 +7289,12 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * AS alias SET column_name = DEFAULT , column_name = expression WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name SET ( column_name ) = ( expression , DEFAULT ) , column_name = expression WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("UPDATE ONLY table_name SET ( column_name , column_name ) = ( expression ) , column_name = DEFAULT WHERE condition RETURNING colname AS output_name , colname output_name"),
-		Parses("UPDATE table_name SET ( column_name ) = ( DEFAULT ) , column_name = DEFAULT WHERE condition RETURNING colname AS output_name , colname output_name"),
+		Converts("UPDATE table_name SET ( column_name ) = ( DEFAULT ) , column_name = DEFAULT WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name alias SET ( column_name ) = ( expression , DEFAULT ) , column_name = DEFAULT WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("UPDATE table_name AS alias SET ( column_name , column_name ) = ROW ( DEFAULT , DEFAULT ) , column_name = DEFAULT WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name * alias SET column_name = expression , ( column_name ) = ( expression ) WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * AS alias SET ( column_name ) = ROW ( DEFAULT ) , ( column_name ) = ( expression ) WHERE condition RETURNING colname AS output_name , colname output_name"),
-		Parses("UPDATE table_name AS alias SET ( column_name ) = ( expression , expression ) , ( column_name ) = ( expression ) WHERE condition RETURNING colname AS output_name , colname output_name"),
+		Converts("UPDATE table_name AS alias SET ( column_name ) = ( expression , expression ) , ( column_name ) = ( expression ) WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * SET ( column_name ) = ( expression , DEFAULT ) , ( column_name ) = ( expression ) WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE ONLY table_name SET ( column_name ) = ( DEFAULT , DEFAULT ) , ( column_name ) = ( expression ) WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name alias SET ( column_name ) = ( expression ) , ( column_name , column_name ) = ( expression ) WHERE condition RETURNING colname AS output_name , colname output_name"),
@@ -7311,7 +7311,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) UPDATE table_name * alias SET ( column_name ) = ( expression , expression ) , ( column_name , column_name ) = ( DEFAULT ) WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name ) = ROW ( DEFAULT , expression ) , ( column_name , column_name ) = ( DEFAULT ) WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("UPDATE table_name * SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ( DEFAULT ) WHERE condition RETURNING colname AS output_name , colname output_name"),
-		Parses("UPDATE table_name SET ( column_name , column_name ) = ( SELECT 1 ) , ( column_name , column_name ) = ( DEFAULT ) WHERE condition RETURNING colname AS output_name , colname output_name"),
+		Converts("UPDATE table_name SET ( column_name , column_name ) = ( SELECT 1 ) , ( column_name , column_name ) = ( DEFAULT ) WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("UPDATE table_name * AS alias SET ( column_name ) = ( DEFAULT ) , ( column_name , column_name ) = ROW ( DEFAULT ) WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("UPDATE table_name alias SET ( column_name , column_name ) = ROW ( DEFAULT , expression ) , ( column_name , column_name ) = ROW ( DEFAULT ) WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("UPDATE table_name AS alias SET ( column_name , column_name ) = ROW ( expression , DEFAULT ) , ( column_name , column_name ) = ROW ( DEFAULT ) WHERE condition RETURNING colname AS output_name , colname output_name"),
@@ -7365,7 +7365,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name AS alias SET column_name = expression , ( column_name , column_name ) = ( SELECT 1 ) WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name alias SET column_name = DEFAULT , ( column_name , column_name ) = ( SELECT 1 ) WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name ) = ( expression ) , ( column_name , column_name ) = ( SELECT 1 ) WHERE condition RETURNING colname AS output_name , colname output_name"),
-		Parses("UPDATE table_name AS alias SET ( column_name ) = ( expression , DEFAULT ) , ( column_name , column_name ) = ( SELECT 1 ) WHERE condition RETURNING colname AS output_name , colname output_name"),
+		Converts("UPDATE table_name AS alias SET ( column_name ) = ( expression , DEFAULT ) , ( column_name , column_name ) = ( SELECT 1 ) WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("UPDATE ONLY table_name SET ( column_name , column_name ) = ROW ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ( SELECT 1 ) WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE table_name * alias SET column_name = expression FROM from_item WHERE condition RETURNING colname AS output_name , colname output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name alias SET column_name = DEFAULT FROM from_item WHERE condition RETURNING colname AS output_name , colname output_name"),
@@ -7806,7 +7806,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("UPDATE ONLY table_name SET ( column_name ) = ( DEFAULT ) , column_name = DEFAULT RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name , column_name ) = ROW ( expression , expression ) , column_name = DEFAULT RETURNING colname , colname AS output_name"),
 		Unimplemented("UPDATE table_name * SET ( column_name ) = ROW ( DEFAULT , expression ) , column_name = DEFAULT RETURNING colname , colname AS output_name"),
-		Parses("UPDATE table_name AS alias SET ( column_name ) = ( SELECT 1 ) , column_name = DEFAULT RETURNING colname , colname AS output_name"),
+		Converts("UPDATE table_name AS alias SET ( column_name ) = ( SELECT 1 ) , column_name = DEFAULT RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name alias SET ( column_name , column_name ) = ROW ( expression , expression ) , ( column_name ) = ( expression ) RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE table_name * AS alias SET ( column_name ) = ( expression , DEFAULT ) , ( column_name ) = ( expression ) RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name ) = ROW ( expression , DEFAULT ) , ( column_name ) = ( expression ) RETURNING colname , colname AS output_name"),
@@ -7824,13 +7824,13 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name alias SET ( column_name , column_name ) = ( SELECT 1 ) , ( column_name , column_name ) = ROW ( expression ) RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name alias SET column_name = expression , ( column_name ) = ( DEFAULT ) RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * AS alias SET column_name = DEFAULT , ( column_name ) = ( DEFAULT ) RETURNING colname , colname AS output_name"),
-		Parses("UPDATE table_name alias SET ( column_name ) = ( DEFAULT , expression ) , ( column_name ) = ( DEFAULT ) RETURNING colname , colname AS output_name"),
+		Converts("UPDATE table_name alias SET ( column_name ) = ( DEFAULT , expression ) , ( column_name ) = ( DEFAULT ) RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name SET ( column_name , column_name ) = ( expression , DEFAULT ) , ( column_name ) = ( DEFAULT ) RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name ) = ( SELECT 1 ) , ( column_name ) = ( DEFAULT ) RETURNING colname , colname AS output_name"),
 		Unimplemented("UPDATE table_name AS alias SET ( column_name , column_name ) = ( expression ) , ( column_name , column_name ) = ROW ( DEFAULT ) RETURNING colname , colname AS output_name"),
 		Unimplemented("UPDATE table_name alias SET ( column_name ) = ROW ( expression ) , ( column_name , column_name ) = ROW ( DEFAULT ) RETURNING colname , colname AS output_name"),
 		Unimplemented("UPDATE ONLY table_name alias SET ( column_name ) = ROW ( expression , expression ) , ( column_name , column_name ) = ROW ( DEFAULT ) RETURNING colname , colname AS output_name"),
-		Parses("UPDATE table_name AS alias SET ( column_name , column_name ) = ( expression , expression ) , ( column_name ) = ( expression , expression ) RETURNING colname , colname AS output_name"),
+		Converts("UPDATE table_name AS alias SET ( column_name , column_name ) = ( expression , expression ) , ( column_name ) = ( expression , expression ) RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name ) = ROW ( expression , expression ) , ( column_name ) = ( expression , expression ) RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * SET ( column_name , column_name ) = ROW ( expression , expression ) , ( column_name ) = ( expression , expression ) RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name ) = ROW ( DEFAULT , expression ) , ( column_name ) = ( expression , expression ) RETURNING colname , colname AS output_name"),
@@ -7869,7 +7869,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * alias SET ( column_name , column_name ) = ROW ( DEFAULT ) , ( column_name ) = ( expression , DEFAULT ) RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name ) = ROW ( DEFAULT , DEFAULT ) , ( column_name ) = ( expression , DEFAULT ) RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name alias SET ( column_name ) = ROW ( DEFAULT , expression ) , ( column_name , column_name ) = ( expression , DEFAULT ) RETURNING colname , colname AS output_name"),
-		Parses("UPDATE table_name alias SET ( column_name , column_name ) = ( expression , DEFAULT ) , ( column_name , column_name ) = ( expression , DEFAULT ) RETURNING colname , colname AS output_name"),
+		Converts("UPDATE table_name alias SET ( column_name , column_name ) = ( expression , DEFAULT ) , ( column_name , column_name ) = ( expression , DEFAULT ) RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name ) = ( SELECT 1 ) , ( column_name , column_name ) = ( expression , DEFAULT ) RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * AS alias SET column_name = expression , ( column_name ) = ROW ( expression , DEFAULT ) RETURNING colname , colname AS output_name"),
 		Unimplemented("UPDATE table_name * SET ( column_name , column_name ) = ROW ( expression ) , ( column_name ) = ROW ( expression , DEFAULT ) RETURNING colname , colname AS output_name"),
@@ -7884,7 +7884,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name , column_name ) = ( DEFAULT , expression ) , ( column_name , column_name ) = ROW ( expression , DEFAULT ) RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE table_name * alias SET ( column_name ) = ( expression , DEFAULT ) , ( column_name , column_name ) = ROW ( expression , DEFAULT ) RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE table_name * AS alias SET ( column_name , column_name ) = ROW ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ROW ( expression , DEFAULT ) RETURNING colname , colname AS output_name"),
-		Parses("UPDATE table_name SET column_name = DEFAULT , ( column_name ) = ( DEFAULT , DEFAULT ) RETURNING colname , colname AS output_name"),
+		Converts("UPDATE table_name SET column_name = DEFAULT , ( column_name ) = ( DEFAULT , DEFAULT ) RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE table_name * alias SET ( column_name ) = ROW ( DEFAULT ) , ( column_name ) = ( DEFAULT , DEFAULT ) RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name AS alias SET column_name = expression , ( column_name , column_name ) = ( DEFAULT , DEFAULT ) RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name AS alias SET ( column_name , column_name ) = ( DEFAULT ) , ( column_name , column_name ) = ( DEFAULT , DEFAULT ) RETURNING colname , colname AS output_name"),
@@ -8056,10 +8056,10 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) UPDATE table_name * alias SET ( column_name ) = ROW ( expression ) , ( column_name ) = ( SELECT 1 ) FROM from_item , from_item RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name SET ( column_name ) = ROW ( DEFAULT , expression ) , ( column_name , column_name ) = ( SELECT 1 ) FROM from_item , from_item RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * alias SET ( column_name , column_name ) = ROW ( expression , DEFAULT ) WHERE condition RETURNING colname , colname AS output_name"),
-		Parses("UPDATE table_name AS alias SET ( column_name , column_name ) = ( expression ) , column_name = expression WHERE condition RETURNING colname , colname AS output_name"),
+		Converts("UPDATE table_name AS alias SET ( column_name , column_name ) = ( expression ) , column_name = expression WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name , column_name ) = ( SELECT 1 ) , column_name = expression WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name AS alias SET ( column_name , column_name ) = ROW ( expression ) , column_name = DEFAULT WHERE condition RETURNING colname , colname AS output_name"),
-		Parses("UPDATE table_name AS alias SET ( column_name ) = ( expression , DEFAULT ) , column_name = DEFAULT WHERE condition RETURNING colname , colname AS output_name"),
+		Converts("UPDATE table_name AS alias SET ( column_name ) = ( expression , DEFAULT ) , column_name = DEFAULT WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("UPDATE table_name * AS alias SET ( column_name ) = ROW ( expression ) , ( column_name , column_name ) = ( expression ) WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("UPDATE ONLY table_name SET ( column_name ) = ROW ( expression , expression ) , ( column_name , column_name ) = ( expression ) WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name SET ( column_name , column_name ) = ( DEFAULT , expression ) , ( column_name , column_name ) = ( expression ) WHERE condition RETURNING colname , colname AS output_name"),
@@ -8114,7 +8114,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name SET ( column_name , column_name ) = ROW ( expression ) , ( column_name ) = ( DEFAULT , DEFAULT ) WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name ) = ( DEFAULT , DEFAULT ) WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE table_name * SET ( column_name ) = ( SELECT 1 ) , ( column_name ) = ( DEFAULT , DEFAULT ) WHERE condition RETURNING colname , colname AS output_name"),
-		Parses("UPDATE table_name AS alias SET ( column_name , column_name ) = ( SELECT 1 ) , ( column_name ) = ( DEFAULT , DEFAULT ) WHERE condition RETURNING colname , colname AS output_name"),
+		Converts("UPDATE table_name AS alias SET ( column_name , column_name ) = ( SELECT 1 ) , ( column_name ) = ( DEFAULT , DEFAULT ) WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name alias SET column_name = DEFAULT , ( column_name , column_name ) = ( DEFAULT , DEFAULT ) WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name alias SET ( column_name , column_name ) = ( DEFAULT ) , ( column_name , column_name ) = ( DEFAULT , DEFAULT ) WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name * AS alias SET ( column_name ) = ( expression , expression ) , ( column_name , column_name ) = ( DEFAULT , DEFAULT ) WHERE condition RETURNING colname , colname AS output_name"),
@@ -8129,7 +8129,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("UPDATE table_name * alias SET ( column_name ) = ( expression , DEFAULT ) , ( column_name , column_name ) = ( SELECT 1 ) WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("UPDATE table_name * SET ( column_name , column_name ) = ( expression , DEFAULT ) , ( column_name , column_name ) = ( SELECT 1 ) WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * SET ( column_name , column_name ) = ROW ( expression , DEFAULT ) , ( column_name , column_name ) = ( SELECT 1 ) WHERE condition RETURNING colname , colname AS output_name"),
-		Parses("UPDATE table_name AS alias SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ( SELECT 1 ) WHERE condition RETURNING colname , colname AS output_name"),
+		Converts("UPDATE table_name AS alias SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ( SELECT 1 ) WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name ) = ROW ( expression ) FROM from_item WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name AS alias SET ( column_name ) = ROW ( DEFAULT ) FROM from_item WHERE condition RETURNING colname , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name , column_name ) = ROW ( DEFAULT ) FROM from_item WHERE condition RETURNING colname , colname AS output_name"),
@@ -8592,7 +8592,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("UPDATE ONLY table_name SET ( column_name ) = ( expression , expression ) , ( column_name ) = ROW ( expression , DEFAULT ) RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("UPDATE ONLY table_name AS alias SET ( column_name ) = ROW ( expression , expression ) , ( column_name ) = ROW ( expression , DEFAULT ) RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name alias SET ( column_name , column_name ) = ( expression ) , ( column_name , column_name ) = ROW ( expression , DEFAULT ) RETURNING colname output_name , colname AS output_name"),
-		Parses("UPDATE table_name AS alias SET ( column_name ) = ( expression ) , ( column_name ) = ( DEFAULT , DEFAULT ) RETURNING colname output_name , colname AS output_name"),
+		Converts("UPDATE table_name AS alias SET ( column_name ) = ( expression ) , ( column_name ) = ( DEFAULT , DEFAULT ) RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name * SET ( column_name , column_name ) = ( expression ) , ( column_name , column_name ) = ( DEFAULT , DEFAULT ) RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("UPDATE ONLY table_name AS alias SET ( column_name ) = ( DEFAULT , expression ) , ( column_name , column_name ) = ( DEFAULT , DEFAULT ) RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("UPDATE table_name * SET ( column_name ) = ROW ( expression , DEFAULT ) , ( column_name , column_name ) = ( DEFAULT , DEFAULT ) RETURNING colname output_name , colname AS output_name"),
@@ -8824,7 +8824,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) UPDATE ONLY table_name SET ( column_name ) = ROW ( expression , expression ) , ( column_name , column_name ) = ROW ( expression , expression ) WHERE condition RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ROW ( expression , expression ) WHERE condition RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("UPDATE ONLY table_name AS alias SET ( column_name , column_name ) = ( DEFAULT , DEFAULT ) , ( column_name , column_name ) = ROW ( expression , expression ) WHERE condition RETURNING colname output_name , colname AS output_name"),
-		Parses("UPDATE table_name AS alias SET ( column_name ) = ( expression ) , ( column_name ) = ( DEFAULT , expression ) WHERE condition RETURNING colname output_name , colname AS output_name"),
+		Converts("UPDATE table_name AS alias SET ( column_name ) = ( expression ) , ( column_name ) = ( DEFAULT , expression ) WHERE condition RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name , column_name ) = ROW ( DEFAULT , DEFAULT ) , ( column_name ) = ( DEFAULT , expression ) WHERE condition RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name SET ( column_name , column_name ) = ( SELECT 1 ) , ( column_name ) = ( DEFAULT , expression ) WHERE condition RETURNING colname output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE table_name SET ( column_name ) = ( expression ) , ( column_name , column_name ) = ( DEFAULT , expression ) WHERE condition RETURNING colname output_name , colname AS output_name"),
@@ -9321,7 +9321,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("UPDATE table_name AS alias SET ( column_name , column_name ) = ROW ( expression , expression ) , ( column_name ) = ( expression , expression ) RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE table_name SET ( column_name , column_name ) = ROW ( expression , expression ) , ( column_name , column_name ) = ( expression , expression ) RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE ONLY table_name AS alias SET ( column_name ) = ( expression , expression ) , ( column_name , column_name ) = ROW ( expression , expression ) RETURNING colname AS output_name , colname AS output_name"),
-		Parses("UPDATE table_name alias SET column_name = DEFAULT , ( column_name , column_name ) = ( DEFAULT , expression ) RETURNING colname AS output_name , colname AS output_name"),
+		Converts("UPDATE table_name alias SET column_name = DEFAULT , ( column_name , column_name ) = ( DEFAULT , expression ) RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE table_name * alias SET ( column_name , column_name ) = ROW ( DEFAULT ) , ( column_name , column_name ) = ( DEFAULT , expression ) RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("UPDATE table_name SET ( column_name ) = ( expression ) , ( column_name , column_name ) = ROW ( DEFAULT , expression ) RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) UPDATE table_name SET ( column_name , column_name ) = ROW ( expression , expression ) , ( column_name , column_name ) = ROW ( DEFAULT , expression ) RETURNING colname AS output_name , colname AS output_name"),
@@ -9568,7 +9568,7 @@ func TestUpdate(t *testing.T) {
 		Unimplemented("WITH queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name , column_name ) = ROW ( expression , DEFAULT ) , ( column_name ) = ( DEFAULT , expression ) WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("UPDATE table_name * alias SET ( column_name , column_name ) = ROW ( DEFAULT , DEFAULT ) , ( column_name ) = ( DEFAULT , expression ) WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH RECURSIVE queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name SET ( column_name ) = ( SELECT 1 ) , ( column_name ) = ( DEFAULT , expression ) WHERE condition RETURNING colname AS output_name , colname AS output_name"),
-		Parses("UPDATE table_name alias SET ( column_name ) = ( expression , DEFAULT ) , ( column_name , column_name ) = ( DEFAULT , expression ) WHERE condition RETURNING colname AS output_name , colname AS output_name"),
+		Converts("UPDATE table_name alias SET ( column_name ) = ( expression , DEFAULT ) , ( column_name , column_name ) = ( DEFAULT , expression ) WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE ONLY table_name alias SET ( column_name ) = ( expression ) , ( column_name ) = ROW ( DEFAULT , expression ) WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) , queryname AS ( select ) UPDATE table_name alias SET ( column_name ) = ( expression ) , ( column_name , column_name ) = ROW ( DEFAULT , expression ) WHERE condition RETURNING colname AS output_name , colname AS output_name"),
 		Unimplemented("WITH queryname AS ( select ) UPDATE ONLY table_name SET ( column_name ) = ( DEFAULT , expression ) , ( column_name , column_name ) = ROW ( DEFAULT , expression ) WHERE condition RETURNING colname AS output_name , colname AS output_name"),
@@ -0,0 +1,474 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestAlterTable(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "ADD CONSTRAINT NOT VALID and VALIDATE CONSTRAINT",
+			SetUpScript: []string{
+				"CREATE TABLE parent (pk INT8 PRIMARY KEY);",
+				"CREATE TABLE child (pk INT8 PRIMARY KEY, v1 INT8, v2 INT8);",
+				"INSERT INTO parent VALUES (1), (2);",
+				"INSERT INTO child VALUES (1, 1, 10), (2, 2, 20);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "ALTER TABLE child ADD CONSTRAINT v2_check CHECK (v2 > 0) NOT VALID;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER TABLE child ADD CONSTRAINT v1_fk FOREIGN KEY (v1) REFERENCES parent (pk) NOT VALID;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER TABLE child VALIDATE CONSTRAINT v2_check;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER TABLE child VALIDATE CONSTRAINT v1_fk;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "INSERT INTO child VALUES (3, 1, -1);",
+					ExpectedErr: "v2_check",
+				},
+				{
+					Query:       "INSERT INTO child VALUES (3, 3, 30);",
+					ExpectedErr: `insert or update on table "child" violates foreign key constraint "v1_fk"`,
+				},
+				{
+					Query:       "ALTER TABLE child VALIDATE CONSTRAINT missing;",
+					ExpectedErr: `constraint "missing" of relation "child" does not exist`,
+				},
+				{
+					Query:       "ALTER TABLE child ADD CONSTRAINT v1_unique UNIQUE (v1) NOT VALID;",
+					ExpectedErr: "UNIQUE constraints cannot be marked NOT VALID",
+				},
+				{
+					Query:       "ALTER TABLE child ADD COLUMN v3 INT8, ADD CONSTRAINT v2_max CHECK (v2 < 100) NOT VALID;",
+					ExpectedErr: "NOT VALID alongside other ALTER TABLE commands is not yet supported",
+				},
+			},
+		},
+		{
+			Name: "CHECK NOT VALID skips existing rows until validated",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 INT8);",
+				"INSERT INTO test VALUES (1, -1), (2, NULL);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "ALTER TABLE test ADD CONSTRAINT v1_check CHECK (v1 > 0);",
+					ExpectedErr: `check constraint "v1_check" of relation "test" is violated by some row`,
+				},
+				{
+					Query:    "ALTER TABLE test ADD CONSTRAINT v1_check CHECK (v1 > 0) NOT VALID;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "INSERT INTO test VALUES (3, -3);",
+					ExpectedErr: "v1_check",
+				},
+				{
+					Query:    "INSERT INTO test VALUES (3, 3);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "ALTER TABLE test VALIDATE CONSTRAINT v1_check;",
+					ExpectedErr: `check constraint "v1_check" of relation "test" is violated by some row`,
+				},
+				{
+					Query:    "UPDATE test SET v1 = 1 WHERE pk = 1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER TABLE test VALIDATE CONSTRAINT v1_check;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER TABLE test VALIDATE CONSTRAINT v1_check;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER TABLE test ADD CHECK (pk < 100) NOT VALID;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER TABLE test VALIDATE CONSTRAINT test_check;",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "FOREIGN KEY NOT VALID skips existing rows until validated",
+			SetUpScript: []string{
+				"CREATE TABLE parent (pk INT8 PRIMARY KEY);",
+				"CREATE TABLE child (pk INT8 PRIMARY KEY, v1 INT8);",
+				"INSERT INTO parent VALUES (1);",
+				"INSERT INTO child VALUES (1, 1), (2, 2), (3, NULL);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "ALTER TABLE child ADD CONSTRAINT v1_fk FOREIGN KEY (v1) REFERENCES parent (pk);",
+					ExpectedErr: "v1_fk",
+				},
+				{
+					Query:    "ALTER TABLE child ADD CONSTRAINT v1_fk FOREIGN KEY (v1) REFERENCES parent (pk) NOT VALID;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "INSERT INTO child VALUES (4, 4);",
+					ExpectedErr: `insert or update on table "child" violates foreign key constraint "v1_fk"`,
+				},
+				{
+					Query:       "ALTER TABLE child VALIDATE CONSTRAINT v1_fk;",
+					ExpectedErr: `insert or update on table "child" violates foreign key constraint "v1_fk"`,
+				},
+				{
+					Query:    "INSERT INTO parent VALUES (2);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER TABLE child VALIDATE CONSTRAINT v1_fk;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "DELETE FROM parent WHERE pk = 2;",
+					ExpectedErr: "v1_fk",
+				},
+			},
+		},
+		{
+			Name: "ADD COLUMN and DROP COLUMN",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 INT4);",
+				"INSERT INTO test VALUES (1, 10), (2, 20);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "ALTER TABLE test ADD COLUMN v2 TEXT;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER TABLE test ADD COLUMN v3 INT8 NOT NULL DEFAULT 7;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, 10, nil, 7}, {2, 20, nil, 7}},
+				},
+				{
+					Query:    "INSERT INTO test (pk, v1, v2) VALUES (3, 30, 'c');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, 10, nil, 7}, {2, 20, nil, 7}, {3, 30, "c", 7}},
+				},
+				{
+					Query:       "ALTER TABLE test ADD COLUMN v4 INT4 NOT NULL;",
+					ExpectedErr: `column "v4" of relation "test" contains null values`,
+				},
+				{
+					Query:       "ALTER TABLE test ADD COLUMN v1 INT4;",
+					ExpectedErr: "v1",
+				},
+				{
+					Query:    "CREATE INDEX v1_idx ON test (v1);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER TABLE test DROP COLUMN v1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, nil, 7}, {2, nil, 7}, {3, "c", 7}},
+				},
+				{
+					Query:       "ALTER TABLE test DROP COLUMN pk;",
+					ExpectedErr: "not yet supported",
+				},
+				{
+					Query:       "ALTER TABLE test DROP COLUMN missing;",
+					ExpectedErr: "missing",
+				},
+			},
+		},
+		{
+			Name: "ALTER COLUMN TYPE",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 INT4, v2 TEXT);",
+				"CREATE INDEX v1_idx ON test (v1);",
+				"INSERT INTO test VALUES (1, 10, '100'), (2, 20, '200');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "ALTER TABLE test ALTER COLUMN v1 TYPE INT8;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test WHERE v1 = 20;",
+					Expected: []sql.Row{{2, 20, "200"}},
+				},
+				{
+					Query:       "ALTER TABLE test ALTER COLUMN v1 TYPE BOOLEAN;",
+					ExpectedErr: `column "v1" cannot be cast automatically to type boolean`,
+				},
+				{
+					Query:    "DROP INDEX v1_idx;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER TABLE test ALTER COLUMN v1 TYPE TEXT USING v1::TEXT;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test WHERE v1 = '10';",
+					Expected: []sql.Row{{1, "10", "100"}},
+				},
+				{
+					Query:    "ALTER TABLE test ALTER COLUMN v2 TYPE INT4 USING v2::INT4 + pk;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT pk, v2 + 1 FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, 102}, {2, 203}},
+				},
+				{
+					Query:    "ALTER TABLE test ALTER v1 SET DATA TYPE INT2 USING length(v1);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, 2, 101}, {2, 2, 202}},
+				},
+				{
+					Query:       "ALTER TABLE test ALTER COLUMN v1 TYPE BOOLEAN USING v1 + 1;",
+					ExpectedErr: `result of USING clause for column "v1" cannot be cast automatically to type boolean`,
+				},
+			},
+		},
+		{
+			Name: "SET and DROP NOT NULL and DEFAULT",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 INT4);",
+				"INSERT INTO test VALUES (1, NULL);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "ALTER TABLE test ALTER COLUMN v1 SET NOT NULL;",
+					ExpectedErr: `column "v1" of relation "test" contains null values`,
+				},
+				{
+					Query:    "UPDATE test SET v1 = 1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER TABLE test ALTER COLUMN v1 SET NOT NULL;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "INSERT INTO test VALUES (2, NULL);",
+					ExpectedErr: "non-nullable",
+				},
+				{
+					Query:    "ALTER TABLE test ALTER COLUMN v1 SET DEFAULT 5;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test (pk) VALUES (2);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER TABLE test ALTER COLUMN v1 DROP DEFAULT, ALTER COLUMN v1 DROP NOT NULL;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test (pk) VALUES (3);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, 1}, {2, 5}, {3, nil}},
+				},
+				{
+					Query:       "ALTER TABLE test ALTER COLUMN pk DROP NOT NULL;",
+					ExpectedErr: `column "pk" is in a primary key`,
+				},
+			},
+		},
+		{
+			Name: "RENAME COLUMN and RENAME TABLE",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 INT4);",
+				"INSERT INTO test VALUES (1, 10);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "ALTER TABLE test RENAME COLUMN v1 TO v2;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER TABLE test RENAME v2 TO v3;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT v3 FROM test;",
+					Expected: []sql.Row{{10}},
+				},
+				{
+					Query:    "ALTER TABLE test RENAME TO test2;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test2;",
+					Expected: []sql.Row{{1, 10}},
+				},
+				{
+					Query:       "SELECT * FROM test;",
+					ExpectedErr: "not found",
+				},
+				{
+					Query:    "CREATE TABLE test (pk INT4 PRIMARY KEY);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "ALTER TABLE test RENAME TO test2;",
+					ExpectedErr: `relation "test2" already exists`,
+				},
+			},
+		},
+		{
+			Name: "Alterations in a non-public schema",
+			SetUpScript: []string{
+				"CREATE SCHEMA other;",
+				"CREATE TABLE other.test (pk INT4 PRIMARY KEY, v1 INT4);",
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 INT4);",
+				"INSERT INTO other.test VALUES (1, 10), (2, 20);",
+				"INSERT INTO test VALUES (3, 30);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "ALTER TABLE other.test ADD COLUMN v2 INT4 DEFAULT 0;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER TABLE other.test ALTER COLUMN v1 TYPE INT8 USING v1 * 2;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER TABLE other.test RENAME COLUMN v2 TO v3;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM other.test ORDER BY pk;",
+					Expected: []sql.Row{{1, 20, 0}, {2, 40, 0}},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{3, 30}},
+				},
+				{
+					Query:    "ALTER TABLE other.test DROP COLUMN v3;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER TABLE other.test RENAME TO test2;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM other.test2 ORDER BY pk;",
+					Expected: []sql.Row{{1, 20}, {2, 40}},
+				},
+			},
+		},
+		{
+			Name: "SET SCHEMA",
+			SetUpScript: []string{
+				"CREATE SCHEMA other;",
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 INT4);",
+				"INSERT INTO test VALUES (1, 10);",
+				"CREATE TABLE other.existing (pk INT4 PRIMARY KEY);",
+				"CREATE TABLE existing (pk INT4 PRIMARY KEY);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "ALTER TABLE test SET SCHEMA other;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM other.test;",
+					Expected: []sql.Row{{1, 10}},
+				},
+				{
+					Query:       "SELECT * FROM public.test;",
+					ExpectedErr: "not found",
+				},
+				{
+					Query:       "ALTER TABLE existing SET SCHEMA other;",
+					ExpectedErr: `relation "existing" already exists in schema "other"`,
+				},
+				{
+					Query:       "ALTER TABLE other.test SET SCHEMA missing;",
+					ExpectedErr: `schema "missing" does not exist`,
+				},
+				{
+					Query:       "ALTER TABLE missing SET SCHEMA other;",
+					ExpectedErr: `relation "missing" does not exist`,
+				},
+				{
+					Query:    "ALTER TABLE IF EXISTS missing SET SCHEMA other;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER TABLE other.test SET SCHEMA public;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test;",
+					Expected: []sql.Row{{1, 10}},
+				},
+			},
+		},
+		{
+			Name: "VALIDATE CONSTRAINT requires ownership of the table",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 INT8);",
+				"ALTER TABLE test ADD CONSTRAINT v1_check CHECK (v1 > 0) NOT VALID;",
+				"CREATE ROLE alice LOGIN;",
+				"GRANT ALL ON test TO alice;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "ALTER TABLE test VALIDATE CONSTRAINT v1_check;",
+					Username:    "alice",
+					ExpectedErr: "must be owner of table test",
+				},
+				{
+					Query:    "ALTER TABLE test VALIDATE CONSTRAINT v1_check;",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+	})
+}
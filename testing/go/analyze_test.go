@@ -0,0 +1,175 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyze(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "ANALYZE statement forms",
+			SetUpScript: []string{
+				"CREATE TABLE t1 (pk INT PRIMARY KEY, v INT);",
+				"CREATE TABLE t2 (pk INT PRIMARY KEY, v TEXT);",
+				"CREATE INDEX t1_v ON t1 (v);",
+				"INSERT INTO t1 VALUES (1, 1), (2, 2), (3, 2);",
+				"INSERT INTO t2 VALUES (1, 'a');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "ANALYZE t1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ANALYZE t1, public.t2;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ANALYZE;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ANALYSE t2;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "ANALYZE missing;",
+					ExpectedErr: `relation "missing" does not exist`,
+				},
+				{
+					Query:    "SELECT v FROM t1 WHERE v = 2 ORDER BY pk;",
+					Expected: []sql.Row{{2}, {2}},
+				},
+			},
+		},
+		{
+			Name: "ANALYZE skips tables that the role does not own",
+			SetUpScript: []string{
+				"CREATE TABLE owned_elsewhere (pk INT PRIMARY KEY, v INT);",
+				"CREATE ROLE alice LOGIN;",
+				"GRANT ALL ON owned_elsewhere TO alice;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "ANALYZE owned_elsewhere;",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ANALYZE;",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT analyze_count FROM pg_stat_user_tables WHERE relname = 'owned_elsewhere';",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "ANALYZE owned_elsewhere;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT analyze_count FROM pg_stat_user_tables WHERE relname = 'owned_elsewhere';",
+					Expected: []sql.Row{{1}},
+				},
+			},
+		},
+	})
+}
+
+func TestAnalyzeIncremental(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+
+	var mutex sync.Mutex
+	var received []string
+	config, err := pgx.ParseConfig(conn.Config().ConnString())
+	require.NoError(t, err)
+	config.OnNotice = func(_ *pgconn.PgConn, notice *pgconn.Notice) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		received = append(received, notice.Message)
+	}
+	analyzeConn, err := pgx.ConnectConfig(ctx, config)
+	require.NoError(t, err)
+	defer analyzeConn.Close(context.Background())
+	// analyze runs ANALYZE on the table and returns the notice describing the work that was done
+	analyze := func() string {
+		_, err := analyzeConn.Exec(ctx, "ANALYZE test;")
+		require.NoError(t, err)
+		mutex.Lock()
+		defer mutex.Unlock()
+		require.Len(t, received, 1)
+		notice := received[0]
+		received = nil
+		return notice
+	}
+	bucketsRegex := regexp.MustCompile(`^analyzed "public\.test": reused (\d+) of (\d+) histogram buckets$`)
+	// buckets returns the number of reused buckets and the total number of buckets from the given notice
+	buckets := func(notice string) (int, int) {
+		matches := bucketsRegex.FindStringSubmatch(notice)
+		require.NotNil(t, matches, notice)
+		reused, err := strconv.Atoi(matches[1])
+		require.NoError(t, err)
+		total, err := strconv.Atoi(matches[2])
+		require.NoError(t, err)
+		return reused, total
+	}
+
+	for _, query := range []string{
+		"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 INT8);",
+		"INSERT INTO test VALUES (1, 1), (2, 2), (3, 3), (4, 4), (5, 5), (6, 6), (7, 0), (8, 1), (9, 2), (10, 3), (11, 4), (12, 5), (13, 6), (14, 0), (15, 1), (16, 2), (17, 3), (18, 4), (19, 5), (20, 6), (21, 0), (22, 1), (23, 2), (24, 3), (25, 4), (26, 5), (27, 6), (28, 0), (29, 1), (30, 2), (31, 3), (32, 4), (33, 5), (34, 6), (35, 0), (36, 1), (37, 2), (38, 3), (39, 4), (40, 5), (41, 6), (42, 0), (43, 1), (44, 2), (45, 3), (46, 4), (47, 5), (48, 6), (49, 0), (50, 1), (51, 2), (52, 3), (53, 4), (54, 5), (55, 6), (56, 0), (57, 1), (58, 2), (59, 3), (60, 4), (61, 5), (62, 6), (63, 0), (64, 1), (65, 2), (66, 3), (67, 4), (68, 5), (69, 6), (70, 0), (71, 1), (72, 2), (73, 3), (74, 4), (75, 5), (76, 6), (77, 0), (78, 1), (79, 2), (80, 3), (81, 4), (82, 5), (83, 6), (84, 0), (85, 1), (86, 2), (87, 3), (88, 4), (89, 5), (90, 6), (91, 0), (92, 1), (93, 2), (94, 3), (95, 4), (96, 5), (97, 6), (98, 0), (99, 1), (100, 2), (101, 3), (102, 4), (103, 5), (104, 6), (105, 0), (106, 1), (107, 2), (108, 3), (109, 4), (110, 5), (111, 6), (112, 0), (113, 1), (114, 2), (115, 3), (116, 4), (117, 5), (118, 6), (119, 0), (120, 1), (121, 2), (122, 3), (123, 4), (124, 5), (125, 6), (126, 0), (127, 1), (128, 2), (129, 3), (130, 4), (131, 5), (132, 6), (133, 0), (134, 1), (135, 2), (136, 3), (137, 4), (138, 5), (139, 6), (140, 0), (141, 1), (142, 2), (143, 3), (144, 4), (145, 5), (146, 6), (147, 0), (148, 1), (149, 2), (150, 3), (151, 4), (152, 5), (153, 6), (154, 0), (155, 1), (156, 2), (157, 3), (158, 4), (159, 5), (160, 6), (161, 0), (162, 1), (163, 2), (164, 3), (165, 4), (166, 5), (167, 6), (168, 0), (169, 1), (170, 2), (171, 3), (172, 4), (173, 5), (174, 6), (175, 0), (176, 1), (177, 2), (178, 3), (179, 4), (180, 5), (181, 6), (182, 0), (183, 1), (184, 2), (185, 3), (186, 4), (187, 5), (188, 6), (189, 0), (190, 1), (191, 2), (192, 3), (193, 4), (194, 5), (195, 6), (196, 0), (197, 1), (198, 2), (199, 3), (200, 4), (201, 5), (202, 6), (203, 0), (204, 1), (205, 2), (206, 3), (207, 4), (208, 5), (209, 6), (210, 0), (211, 1), (212, 2), (213, 3), (214, 4), (215, 5), (216, 6), (217, 0), (218, 1), (219, 2), (220, 3), (221, 4), (222, 5), (223, 6), (224, 0), (225, 1), (226, 2), (227, 3), (228, 4), (229, 5), (230, 6), (231, 0), (232, 1), (233, 2), (234, 3), (235, 4), (236, 5), (237, 6), (238, 0), (239, 1), (240, 2), (241, 3), (242, 4), (243, 5), (244, 6), (245, 0), (246, 1), (247, 2), (248, 3), (249, 4), (250, 5), (251, 6), (252, 0), (253, 1), (254, 2), (255, 3), (256, 4), (257, 5), (258, 6), (259, 0), (260, 1), (261, 2), (262, 3), (263, 4), (264, 5), (265, 6), (266, 0), (267, 1), (268, 2), (269, 3), (270, 4), (271, 5), (272, 6), (273, 0), (274, 1), (275, 2), (276, 3), (277, 4), (278, 5), (279, 6), (280, 0), (281, 1), (282, 2), (283, 3), (284, 4), (285, 5), (286, 6), (287, 0), (288, 1), (289, 2), (290, 3), (291, 4), (292, 5), (293, 6), (294, 0), (295, 1), (296, 2), (297, 3), (298, 4), (299, 5), (300, 6), (301, 0), (302, 1), (303, 2), (304, 3), (305, 4), (306, 5), (307, 6), (308, 0), (309, 1), (310, 2), (311, 3), (312, 4), (313, 5), (314, 6), (315, 0), (316, 1), (317, 2), (318, 3), (319, 4), (320, 5), (321, 6), (322, 0), (323, 1), (324, 2), (325, 3), (326, 4), (327, 5), (328, 6), (329, 0), (330, 1), (331, 2), (332, 3), (333, 4), (334, 5), (335, 6), (336, 0), (337, 1), (338, 2), (339, 3), (340, 4), (341, 5), (342, 6), (343, 0), (344, 1), (345, 2), (346, 3), (347, 4), (348, 5), (349, 6), (350, 0), (351, 1), (352, 2), (353, 3), (354, 4), (355, 5), (356, 6), (357, 0), (358, 1), (359, 2), (360, 3), (361, 4), (362, 5), (363, 6), (364, 0), (365, 1), (366, 2), (367, 3), (368, 4), (369, 5), (370, 6), (371, 0), (372, 1), (373, 2), (374, 3), (375, 4), (376, 5), (377, 6), (378, 0), (379, 1), (380, 2), (381, 3), (382, 4), (383, 5), (384, 6), (385, 0), (386, 1), (387, 2), (388, 3), (389, 4), (390, 5), (391, 6), (392, 0), (393, 1), (394, 2), (395, 3), (396, 4), (397, 5), (398, 6), (399, 0), (400, 1), (401, 2), (402, 3), (403, 4), (404, 5), (405, 6), (406, 0), (407, 1), (408, 2), (409, 3), (410, 4), (411, 5), (412, 6), (413, 0), (414, 1), (415, 2), (416, 3), (417, 4), (418, 5), (419, 6), (420, 0), (421, 1), (422, 2), (423, 3), (424, 4), (425, 5), (426, 6), (427, 0), (428, 1), (429, 2), (430, 3), (431, 4), (432, 5), (433, 6), (434, 0), (435, 1), (436, 2), (437, 3), (438, 4), (439, 5), (440, 6), (441, 0), (442, 1), (443, 2), (444, 3), (445, 4), (446, 5), (447, 6), (448, 0), (449, 1), (450, 2), (451, 3), (452, 4), (453, 5), (454, 6), (455, 0), (456, 1), (457, 2), (458, 3), (459, 4), (460, 5), (461, 6), (462, 0), (463, 1), (464, 2), (465, 3), (466, 4), (467, 5), (468, 6), (469, 0), (470, 1), (471, 2), (472, 3), (473, 4), (474, 5), (475, 6), (476, 0), (477, 1), (478, 2), (479, 3), (480, 4), (481, 5), (482, 6), (483, 0), (484, 1), (485, 2), (486, 3), (487, 4), (488, 5), (489, 6), (490, 0), (491, 1), (492, 2), (493, 3), (494, 4), (495, 5), (496, 6), (497, 0), (498, 1), (499, 2), (500, 3), (501, 4), (502, 5), (503, 6), (504, 0), (505, 1), (506, 2), (507, 3), (508, 4), (509, 5), (510, 6), (511, 0), (512, 1), (513, 2), (514, 3), (515, 4), (516, 5), (517, 6), (518, 0), (519, 1), (520, 2), (521, 3), (522, 4), (523, 5), (524, 6), (525, 0), (526, 1), (527, 2), (528, 3), (529, 4), (530, 5), (531, 6), (532, 0), (533, 1), (534, 2), (535, 3), (536, 4), (537, 5), (538, 6), (539, 0), (540, 1), (541, 2), (542, 3), (543, 4), (544, 5), (545, 6), (546, 0), (547, 1), (548, 2), (549, 3), (550, 4), (551, 5), (552, 6), (553, 0), (554, 1), (555, 2), (556, 3), (557, 4), (558, 5), (559, 6), (560, 0), (561, 1), (562, 2), (563, 3), (564, 4), (565, 5), (566, 6), (567, 0), (568, 1), (569, 2), (570, 3), (571, 4), (572, 5), (573, 6), (574, 0), (575, 1), (576, 2), (577, 3), (578, 4), (579, 5), (580, 6), (581, 0), (582, 1), (583, 2), (584, 3), (585, 4), (586, 5), (587, 6), (588, 0), (589, 1), (590, 2), (591, 3), (592, 4), (593, 5), (594, 6), (595, 0), (596, 1), (597, 2), (598, 3), (599, 4), (600, 5), (601, 6), (602, 0), (603, 1), (604, 2), (605, 3), (606, 4), (607, 5), (608, 6), (609, 0), (610, 1), (611, 2), (612, 3), (613, 4), (614, 5), (615, 6), (616, 0), (617, 1), (618, 2), (619, 3), (620, 4), (621, 5), (622, 6), (623, 0), (624, 1), (625, 2), (626, 3), (627, 4), (628, 5), (629, 6), (630, 0), (631, 1), (632, 2), (633, 3), (634, 4), (635, 5), (636, 6), (637, 0), (638, 1), (639, 2), (640, 3), (641, 4), (642, 5), (643, 6), (644, 0), (645, 1), (646, 2), (647, 3), (648, 4), (649, 5), (650, 6), (651, 0), (652, 1), (653, 2), (654, 3), (655, 4), (656, 5), (657, 6), (658, 0), (659, 1), (660, 2), (661, 3), (662, 4), (663, 5), (664, 6), (665, 0), (666, 1), (667, 2), (668, 3), (669, 4), (670, 5), (671, 6), (672, 0), (673, 1), (674, 2), (675, 3), (676, 4), (677, 5), (678, 6), (679, 0), (680, 1), (681, 2), (682, 3), (683, 4), (684, 5), (685, 6), (686, 0), (687, 1), (688, 2), (689, 3), (690, 4), (691, 5), (692, 6), (693, 0), (694, 1), (695, 2), (696, 3), (697, 4), (698, 5), (699, 6), (700, 0), (701, 1), (702, 2), (703, 3), (704, 4), (705, 5), (706, 6), (707, 0), (708, 1), (709, 2), (710, 3), (711, 4), (712, 5), (713, 6), (714, 0), (715, 1), (716, 2), (717, 3), (718, 4), (719, 5), (720, 6), (721, 0), (722, 1), (723, 2), (724, 3), (725, 4), (726, 5), (727, 6), (728, 0), (729, 1), (730, 2), (731, 3), (732, 4), (733, 5), (734, 6), (735, 0), (736, 1), (737, 2), (738, 3), (739, 4), (740, 5), (741, 6), (742, 0), (743, 1), (744, 2), (745, 3), (746, 4), (747, 5), (748, 6), (749, 0), (750, 1), (751, 2), (752, 3), (753, 4), (754, 5), (755, 6), (756, 0), (757, 1), (758, 2), (759, 3), (760, 4), (761, 5), (762, 6), (763, 0), (764, 1), (765, 2), (766, 3), (767, 4), (768, 5), (769, 6), (770, 0), (771, 1), (772, 2), (773, 3), (774, 4), (775, 5), (776, 6), (777, 0), (778, 1), (779, 2), (780, 3), (781, 4), (782, 5), (783, 6), (784, 0), (785, 1), (786, 2), (787, 3), (788, 4), (789, 5), (790, 6), (791, 0), (792, 1), (793, 2), (794, 3), (795, 4), (796, 5), (797, 6), (798, 0), (799, 1), (800, 2), (801, 3), (802, 4), (803, 5), (804, 6), (805, 0), (806, 1), (807, 2), (808, 3), (809, 4), (810, 5), (811, 6), (812, 0), (813, 1), (814, 2), (815, 3), (816, 4), (817, 5), (818, 6), (819, 0), (820, 1), (821, 2), (822, 3), (823, 4), (824, 5), (825, 6), (826, 0), (827, 1), (828, 2), (829, 3), (830, 4), (831, 5), (832, 6), (833, 0), (834, 1), (835, 2), (836, 3), (837, 4), (838, 5), (839, 6), (840, 0), (841, 1), (842, 2), (843, 3), (844, 4), (845, 5), (846, 6), (847, 0), (848, 1), (849, 2), (850, 3), (851, 4), (852, 5), (853, 6), (854, 0), (855, 1), (856, 2), (857, 3), (858, 4), (859, 5), (860, 6), (861, 0), (862, 1), (863, 2), (864, 3), (865, 4), (866, 5), (867, 6), (868, 0), (869, 1), (870, 2), (871, 3), (872, 4), (873, 5), (874, 6), (875, 0), (876, 1), (877, 2), (878, 3), (879, 4), (880, 5), (881, 6), (882, 0), (883, 1), (884, 2), (885, 3), (886, 4), (887, 5), (888, 6), (889, 0), (890, 1), (891, 2), (892, 3), (893, 4), (894, 5), (895, 6), (896, 0), (897, 1), (898, 2), (899, 3), (900, 4), (901, 5), (902, 6), (903, 0), (904, 1), (905, 2), (906, 3), (907, 4), (908, 5), (909, 6), (910, 0), (911, 1), (912, 2), (913, 3), (914, 4), (915, 5), (916, 6), (917, 0), (918, 1), (919, 2), (920, 3), (921, 4), (922, 5), (923, 6), (924, 0), (925, 1), (926, 2), (927, 3), (928, 4), (929, 5), (930, 6), (931, 0), (932, 1), (933, 2), (934, 3), (935, 4), (936, 5), (937, 6), (938, 0), (939, 1), (940, 2), (941, 3), (942, 4), (943, 5), (944, 6), (945, 0), (946, 1), (947, 2), (948, 3), (949, 4), (950, 5), (951, 6), (952, 0), (953, 1), (954, 2), (955, 3), (956, 4), (957, 5), (958, 6), (959, 0), (960, 1), (961, 2), (962, 3), (963, 4), (964, 5), (965, 6), (966, 0), (967, 1), (968, 2), (969, 3), (970, 4), (971, 5), (972, 6), (973, 0), (974, 1), (975, 2), (976, 3), (977, 4), (978, 5), (979, 6), (980, 0), (981, 1), (982, 2), (983, 3), (984, 4), (985, 5), (986, 6), (987, 0), (988, 1), (989, 2), (990, 3), (991, 4), (992, 5), (993, 6), (994, 0), (995, 1), (996, 2), (997, 3), (998, 4), (999, 5), (1000, 6), (1001, 0), (1002, 1), (1003, 2), (1004, 3), (1005, 4), (1006, 5), (1007, 6), (1008, 0), (1009, 1), (1010, 2), (1011, 3), (1012, 4), (1013, 5), (1014, 6), (1015, 0), (1016, 1), (1017, 2), (1018, 3), (1019, 4), (1020, 5), (1021, 6), (1022, 0), (1023, 1), (1024, 2), (1025, 3), (1026, 4), (1027, 5), (1028, 6), (1029, 0), (1030, 1), (1031, 2), (1032, 3), (1033, 4), (1034, 5), (1035, 6), (1036, 0), (1037, 1), (1038, 2), (1039, 3), (1040, 4), (1041, 5), (1042, 6), (1043, 0), (1044, 1), (1045, 2), (1046, 3), (1047, 4), (1048, 5), (1049, 6), (1050, 0), (1051, 1), (1052, 2), (1053, 3), (1054, 4), (1055, 5), (1056, 6), (1057, 0), (1058, 1), (1059, 2), (1060, 3), (1061, 4), (1062, 5), (1063, 6), (1064, 0), (1065, 1), (1066, 2), (1067, 3), (1068, 4), (1069, 5), (1070, 6), (1071, 0), (1072, 1), (1073, 2), (1074, 3), (1075, 4), (1076, 5), (1077, 6), (1078, 0), (1079, 1), (1080, 2), (1081, 3), (1082, 4), (1083, 5), (1084, 6), (1085, 0), (1086, 1), (1087, 2), (1088, 3), (1089, 4), (1090, 5), (1091, 6), (1092, 0), (1093, 1), (1094, 2), (1095, 3), (1096, 4), (1097, 5), (1098, 6), (1099, 0), (1100, 1), (1101, 2), (1102, 3), (1103, 4), (1104, 5), (1105, 6), (1106, 0), (1107, 1), (1108, 2), (1109, 3), (1110, 4), (1111, 5), (1112, 6), (1113, 0), (1114, 1), (1115, 2), (1116, 3), (1117, 4), (1118, 5), (1119, 6), (1120, 0), (1121, 1), (1122, 2), (1123, 3), (1124, 4), (1125, 5), (1126, 6), (1127, 0), (1128, 1), (1129, 2), (1130, 3), (1131, 4), (1132, 5), (1133, 6), (1134, 0), (1135, 1), (1136, 2), (1137, 3), (1138, 4), (1139, 5), (1140, 6), (1141, 0), (1142, 1), (1143, 2), (1144, 3), (1145, 4), (1146, 5), (1147, 6), (1148, 0), (1149, 1), (1150, 2), (1151, 3), (1152, 4), (1153, 5), (1154, 6), (1155, 0), (1156, 1), (1157, 2), (1158, 3), (1159, 4), (1160, 5), (1161, 6), (1162, 0), (1163, 1), (1164, 2), (1165, 3), (1166, 4), (1167, 5), (1168, 6), (1169, 0), (1170, 1), (1171, 2), (1172, 3), (1173, 4), (1174, 5), (1175, 6), (1176, 0), (1177, 1), (1178, 2), (1179, 3), (1180, 4), (1181, 5), (1182, 6), (1183, 0), (1184, 1), (1185, 2), (1186, 3), (1187, 4), (1188, 5), (1189, 6), (1190, 0), (1191, 1), (1192, 2), (1193, 3), (1194, 4), (1195, 5), (1196, 6), (1197, 0), (1198, 1), (1199, 2), (1200, 3), (1201, 4), (1202, 5), (1203, 6), (1204, 0), (1205, 1), (1206, 2), (1207, 3), (1208, 4), (1209, 5), (1210, 6), (1211, 0), (1212, 1), (1213, 2), (1214, 3), (1215, 4), (1216, 5), (1217, 6), (1218, 0), (1219, 1), (1220, 2), (1221, 3), (1222, 4), (1223, 5), (1224, 6), (1225, 0), (1226, 1), (1227, 2), (1228, 3), (1229, 4), (1230, 5), (1231, 6), (1232, 0), (1233, 1), (1234, 2), (1235, 3), (1236, 4), (1237, 5), (1238, 6), (1239, 0), (1240, 1), (1241, 2), (1242, 3), (1243, 4), (1244, 5), (1245, 6), (1246, 0), (1247, 1), (1248, 2), (1249, 3), (1250, 4), (1251, 5), (1252, 6), (1253, 0), (1254, 1), (1255, 2), (1256, 3), (1257, 4), (1258, 5), (1259, 6), (1260, 0), (1261, 1), (1262, 2), (1263, 3), (1264, 4), (1265, 5), (1266, 6), (1267, 0), (1268, 1), (1269, 2), (1270, 3), (1271, 4), (1272, 5), (1273, 6), (1274, 0), (1275, 1), (1276, 2), (1277, 3), (1278, 4), (1279, 5), (1280, 6), (1281, 0), (1282, 1), (1283, 2), (1284, 3), (1285, 4), (1286, 5), (1287, 6), (1288, 0), (1289, 1), (1290, 2), (1291, 3), (1292, 4), (1293, 5), (1294, 6), (1295, 0), (1296, 1), (1297, 2), (1298, 3), (1299, 4), (1300, 5), (1301, 6), (1302, 0), (1303, 1), (1304, 2), (1305, 3), (1306, 4), (1307, 5), (1308, 6), (1309, 0), (1310, 1), (1311, 2), (1312, 3), (1313, 4), (1314, 5), (1315, 6), (1316, 0), (1317, 1), (1318, 2), (1319, 3), (1320, 4), (1321, 5), (1322, 6), (1323, 0), (1324, 1), (1325, 2), (1326, 3), (1327, 4), (1328, 5), (1329, 6), (1330, 0), (1331, 1), (1332, 2), (1333, 3), (1334, 4), (1335, 5), (1336, 6), (1337, 0), (1338, 1), (1339, 2), (1340, 3), (1341, 4), (1342, 5), (1343, 6), (1344, 0), (1345, 1), (1346, 2), (1347, 3), (1348, 4), (1349, 5), (1350, 6), (1351, 0), (1352, 1), (1353, 2), (1354, 3), (1355, 4), (1356, 5), (1357, 6), (1358, 0), (1359, 1), (1360, 2), (1361, 3), (1362, 4), (1363, 5), (1364, 6), (1365, 0), (1366, 1), (1367, 2), (1368, 3), (1369, 4), (1370, 5), (1371, 6), (1372, 0), (1373, 1), (1374, 2), (1375, 3), (1376, 4), (1377, 5), (1378, 6), (1379, 0), (1380, 1), (1381, 2), (1382, 3), (1383, 4), (1384, 5), (1385, 6), (1386, 0), (1387, 1), (1388, 2), (1389, 3), (1390, 4), (1391, 5), (1392, 6), (1393, 0), (1394, 1), (1395, 2), (1396, 3), (1397, 4), (1398, 5), (1399, 6), (1400, 0), (1401, 1), (1402, 2), (1403, 3), (1404, 4), (1405, 5), (1406, 6), (1407, 0), (1408, 1), (1409, 2), (1410, 3), (1411, 4), (1412, 5), (1413, 6), (1414, 0), (1415, 1), (1416, 2), (1417, 3), (1418, 4), (1419, 5), (1420, 6), (1421, 0), (1422, 1), (1423, 2), (1424, 3), (1425, 4), (1426, 5), (1427, 6), (1428, 0), (1429, 1), (1430, 2), (1431, 3), (1432, 4), (1433, 5), (1434, 6), (1435, 0), (1436, 1), (1437, 2), (1438, 3), (1439, 4), (1440, 5), (1441, 6), (1442, 0), (1443, 1), (1444, 2), (1445, 3), (1446, 4), (1447, 5), (1448, 6), (1449, 0), (1450, 1), (1451, 2), (1452, 3), (1453, 4), (1454, 5), (1455, 6), (1456, 0), (1457, 1), (1458, 2), (1459, 3), (1460, 4), (1461, 5), (1462, 6), (1463, 0), (1464, 1), (1465, 2), (1466, 3), (1467, 4), (1468, 5), (1469, 6), (1470, 0), (1471, 1), (1472, 2), (1473, 3), (1474, 4), (1475, 5), (1476, 6), (1477, 0), (1478, 1), (1479, 2), (1480, 3), (1481, 4), (1482, 5), (1483, 6), (1484, 0), (1485, 1), (1486, 2), (1487, 3), (1488, 4), (1489, 5), (1490, 6), (1491, 0), (1492, 1), (1493, 2), (1494, 3), (1495, 4), (1496, 5), (1497, 6), (1498, 0), (1499, 1), (1500, 2), (1501, 3), (1502, 4), (1503, 5), (1504, 6), (1505, 0), (1506, 1), (1507, 2), (1508, 3), (1509, 4), (1510, 5), (1511, 6), (1512, 0), (1513, 1), (1514, 2), (1515, 3), (1516, 4), (1517, 5), (1518, 6), (1519, 0), (1520, 1), (1521, 2), (1522, 3), (1523, 4), (1524, 5), (1525, 6), (1526, 0), (1527, 1), (1528, 2), (1529, 3), (1530, 4), (1531, 5), (1532, 6), (1533, 0), (1534, 1), (1535, 2), (1536, 3), (1537, 4), (1538, 5), (1539, 6), (1540, 0), (1541, 1), (1542, 2), (1543, 3), (1544, 4), (1545, 5), (1546, 6), (1547, 0), (1548, 1), (1549, 2), (1550, 3), (1551, 4), (1552, 5), (1553, 6), (1554, 0), (1555, 1), (1556, 2), (1557, 3), (1558, 4), (1559, 5), (1560, 6), (1561, 0), (1562, 1), (1563, 2), (1564, 3), (1565, 4), (1566, 5), (1567, 6), (1568, 0), (1569, 1), (1570, 2), (1571, 3), (1572, 4), (1573, 5), (1574, 6), (1575, 0), (1576, 1), (1577, 2), (1578, 3), (1579, 4), (1580, 5), (1581, 6), (1582, 0), (1583, 1), (1584, 2), (1585, 3), (1586, 4), (1587, 5), (1588, 6), (1589, 0), (1590, 1), (1591, 2), (1592, 3), (1593, 4), (1594, 5), (1595, 6), (1596, 0), (1597, 1), (1598, 2), (1599, 3), (1600, 4), (1601, 5), (1602, 6), (1603, 0), (1604, 1), (1605, 2), (1606, 3), (1607, 4), (1608, 5), (1609, 6), (1610, 0), (1611, 1), (1612, 2), (1613, 3), (1614, 4), (1615, 5), (1616, 6), (1617, 0), (1618, 1), (1619, 2), (1620, 3), (1621, 4), (1622, 5), (1623, 6), (1624, 0), (1625, 1), (1626, 2), (1627, 3), (1628, 4), (1629, 5), (1630, 6), (1631, 0), (1632, 1), (1633, 2), (1634, 3), (1635, 4), (1636, 5), (1637, 6), (1638, 0), (1639, 1), (1640, 2), (1641, 3), (1642, 4), (1643, 5), (1644, 6), (1645, 0), (1646, 1), (1647, 2), (1648, 3), (1649, 4), (1650, 5), (1651, 6), (1652, 0), (1653, 1), (1654, 2), (1655, 3), (1656, 4), (1657, 5), (1658, 6), (1659, 0), (1660, 1), (1661, 2), (1662, 3), (1663, 4), (1664, 5), (1665, 6), (1666, 0), (1667, 1), (1668, 2), (1669, 3), (1670, 4), (1671, 5), (1672, 6), (1673, 0), (1674, 1), (1675, 2), (1676, 3), (1677, 4), (1678, 5), (1679, 6), (1680, 0), (1681, 1), (1682, 2), (1683, 3), (1684, 4), (1685, 5), (1686, 6), (1687, 0), (1688, 1), (1689, 2), (1690, 3), (1691, 4), (1692, 5), (1693, 6), (1694, 0), (1695, 1), (1696, 2), (1697, 3), (1698, 4), (1699, 5), (1700, 6), (1701, 0), (1702, 1), (1703, 2), (1704, 3), (1705, 4), (1706, 5), (1707, 6), (1708, 0), (1709, 1), (1710, 2), (1711, 3), (1712, 4), (1713, 5), (1714, 6), (1715, 0), (1716, 1), (1717, 2), (1718, 3), (1719, 4), (1720, 5), (1721, 6), (1722, 0), (1723, 1), (1724, 2), (1725, 3), (1726, 4), (1727, 5), (1728, 6), (1729, 0), (1730, 1), (1731, 2), (1732, 3), (1733, 4), (1734, 5), (1735, 6), (1736, 0), (1737, 1), (1738, 2), (1739, 3), (1740, 4), (1741, 5), (1742, 6), (1743, 0), (1744, 1), (1745, 2), (1746, 3), (1747, 4), (1748, 5), (1749, 6), (1750, 0), (1751, 1), (1752, 2), (1753, 3), (1754, 4), (1755, 5), (1756, 6), (1757, 0), (1758, 1), (1759, 2), (1760, 3), (1761, 4), (1762, 5), (1763, 6), (1764, 0), (1765, 1), (1766, 2), (1767, 3), (1768, 4), (1769, 5), (1770, 6), (1771, 0), (1772, 1), (1773, 2), (1774, 3), (1775, 4), (1776, 5), (1777, 6), (1778, 0), (1779, 1), (1780, 2), (1781, 3), (1782, 4), (1783, 5), (1784, 6), (1785, 0), (1786, 1), (1787, 2), (1788, 3), (1789, 4), (1790, 5), (1791, 6), (1792, 0), (1793, 1), (1794, 2), (1795, 3), (1796, 4), (1797, 5), (1798, 6), (1799, 0), (1800, 1), (1801, 2), (1802, 3), (1803, 4), (1804, 5), (1805, 6), (1806, 0), (1807, 1), (1808, 2), (1809, 3), (1810, 4), (1811, 5), (1812, 6), (1813, 0), (1814, 1), (1815, 2), (1816, 3), (1817, 4), (1818, 5), (1819, 6), (1820, 0), (1821, 1), (1822, 2), (1823, 3), (1824, 4), (1825, 5), (1826, 6), (1827, 0), (1828, 1), (1829, 2), (1830, 3), (1831, 4), (1832, 5), (1833, 6), (1834, 0), (1835, 1), (1836, 2), (1837, 3), (1838, 4), (1839, 5), (1840, 6), (1841, 0), (1842, 1), (1843, 2), (1844, 3), (1845, 4), (1846, 5), (1847, 6), (1848, 0), (1849, 1), (1850, 2), (1851, 3), (1852, 4), (1853, 5), (1854, 6), (1855, 0), (1856, 1), (1857, 2), (1858, 3), (1859, 4), (1860, 5), (1861, 6), (1862, 0), (1863, 1), (1864, 2), (1865, 3), (1866, 4), (1867, 5), (1868, 6), (1869, 0), (1870, 1), (1871, 2), (1872, 3), (1873, 4), (1874, 5), (1875, 6), (1876, 0), (1877, 1), (1878, 2), (1879, 3), (1880, 4), (1881, 5), (1882, 6), (1883, 0), (1884, 1), (1885, 2), (1886, 3), (1887, 4), (1888, 5), (1889, 6), (1890, 0), (1891, 1), (1892, 2), (1893, 3), (1894, 4), (1895, 5), (1896, 6), (1897, 0), (1898, 1), (1899, 2), (1900, 3), (1901, 4), (1902, 5), (1903, 6), (1904, 0), (1905, 1), (1906, 2), (1907, 3), (1908, 4), (1909, 5), (1910, 6), (1911, 0), (1912, 1), (1913, 2), (1914, 3), (1915, 4), (1916, 5), (1917, 6), (1918, 0), (1919, 1), (1920, 2), (1921, 3), (1922, 4), (1923, 5), (1924, 6), (1925, 0), (1926, 1), (1927, 2), (1928, 3), (1929, 4), (1930, 5), (1931, 6), (1932, 0), (1933, 1), (1934, 2), (1935, 3), (1936, 4), (1937, 5), (1938, 6), (1939, 0), (1940, 1), (1941, 2), (1942, 3), (1943, 4), (1944, 5), (1945, 6), (1946, 0), (1947, 1), (1948, 2), (1949, 3), (1950, 4), (1951, 5), (1952, 6), (1953, 0), (1954, 1), (1955, 2), (1956, 3), (1957, 4), (1958, 5), (1959, 6), (1960, 0), (1961, 1), (1962, 2), (1963, 3), (1964, 4), (1965, 5), (1966, 6), (1967, 0), (1968, 1), (1969, 2), (1970, 3), (1971, 4), (1972, 5), (1973, 6), (1974, 0), (1975, 1), (1976, 2), (1977, 3), (1978, 4), (1979, 5), (1980, 6), (1981, 0), (1982, 1), (1983, 2), (1984, 3), (1985, 4), (1986, 5), (1987, 6), (1988, 0), (1989, 1), (1990, 2), (1991, 3), (1992, 4), (1993, 5), (1994, 6), (1995, 0), (1996, 1), (1997, 2), (1998, 3), (1999, 4), (2000, 5);",
+		"SET client_min_messages = 'debug1';",
+	} {
+		_, err = analyzeConn.Exec(ctx, query)
+		require.NoError(t, err)
+	}
+
+	// The first ANALYZE reads the entire table
+	reused, total := buckets(analyze())
+	assert.Equal(t, 0, reused)
+	assert.Greater(t, total, 1)
+
+	// Nothing is read when the table has not changed
+	assert.Equal(t, `skipping analyze of "public.test" --- unchanged since last analyze`, analyze())
+
+	// Only the buckets covering the changed rows are read
+	_, err = analyzeConn.Exec(ctx, "UPDATE test SET v1 = -1 WHERE pk = 1000;")
+	require.NoError(t, err)
+	reused, total = buckets(analyze())
+	assert.Greater(t, reused, 0)
+	assert.Less(t, reused, total)
+
+	// Committing does not change the table, so the statistics remain current
+	_, err = analyzeConn.Exec(ctx, "CALL dolt_commit('-Am', 'test');")
+	require.NoError(t, err)
+	assert.Equal(t, `skipping analyze of "public.test" --- unchanged since last analyze`, analyze())
+}
@@ -0,0 +1,155 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/doltgresql/server/audit"
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+func TestAuditLog(t *testing.T) {
+	auditFile := filepath.Join(t.TempDir(), "audit", "audit.jsonl")
+	srv := StartServer(t, &servercfg.DoltgresConfig{
+		BehaviorConfig: &servercfg.DoltgresBehaviorConfig{
+			InMemory: ptr(true),
+		},
+		Audit: &servercfg.DoltgresAuditConfig{
+			File:       &auditFile,
+			Database:   ptr("audit"),
+			DoltCommit: ptr(true),
+		},
+	})
+	ExecQueries(t, Connect(t, srv, ""), "CREATE DATABASE app;")
+	conn := Connect(t, srv, "app")
+	connectionID := conn.PgConn().PID()
+
+	for _, query := range []string{
+		"CREATE TABLE audited (pk INT PRIMARY KEY, v1 TEXT);",
+		"INSERT INTO audited VALUES (1, 'one');",
+		"SELECT * FROM audited;",
+		"CREATE ROLE reader;",
+		"GRANT SELECT ON audited TO reader;",
+		"SELECT dolt_commit('-Am', 'initial');",
+		"CALL dolt_branch('feature');",
+	} {
+		ExecQueries(t, conn, query)
+	}
+	// Failed statements are audited along with their error
+	ctx := context.Background()
+	_, err := conn.Exec(ctx, "DROP TABLE missing;")
+	require.Error(t, err)
+	// Statements run through the extended protocol are audited as well
+	_, err = conn.Exec(ctx, "REVOKE SELECT ON audited FROM reader;", pgx.QueryExecModeDescribeExec)
+	require.NoError(t, err)
+
+	type auditedStatement struct {
+		Class     string
+		Tag       string
+		Statement string
+		Succeeded bool
+	}
+	expected := []auditedStatement{
+		{"ddl", "CREATE TABLE", "CREATE TABLE audited (pk INT PRIMARY KEY, v1 TEXT);", true},
+		{"privilege", "CREATE ROLE", "CREATE ROLE reader;", true},
+		{"privilege", "GRANT", "GRANT SELECT ON audited TO reader;", true},
+		{"dolt", "SELECT", "SELECT dolt_commit('-Am', 'initial');", true},
+		{"dolt", "CALL", "CALL dolt_branch('feature');", true},
+		{"ddl", "DROP TABLE", "DROP TABLE missing;", false},
+		{"privilege", "REVOKE", "REVOKE SELECT ON audited FROM reader;", true},
+	}
+
+	// Events are written to the table in the background, and each batch is committed
+	auditConn := Connect(t, srv, "audit")
+	var tableStatements []auditedStatement
+	require.Eventually(t, func() bool {
+		rows, err := auditConn.Query(ctx, "SELECT class, command_tag, statement, succeeded FROM "+audit.TableName+
+			" WHERE connection_id = $1 AND database_name = 'app' ORDER BY id;", int64(connectionID))
+		if err != nil {
+			return false
+		}
+		tableStatements = nil
+		for rows.Next() {
+			// Booleans are returned as text
+			var statement auditedStatement
+			var succeeded string
+			if err = rows.Scan(&statement.Class, &statement.Tag, &statement.Statement, &succeeded); err != nil {
+				return false
+			}
+			statement.Succeeded = succeeded == "t"
+			tableStatements = append(tableStatements, statement)
+		}
+		return rows.Err() == nil && len(tableStatements) == len(expected)
+	}, 10*time.Second, 50*time.Millisecond)
+	assert.Equal(t, expected, tableStatements)
+	var unsucceeded int64
+	require.NoError(t, auditConn.QueryRow(ctx, "SELECT count(*) FROM "+audit.TableName+" WHERE NOT succeeded AND error IS NOT NULL;").Scan(&unsucceeded))
+	assert.Equal(t, int64(1), unsucceeded)
+	var commits int64
+	require.NoError(t, auditConn.QueryRow(ctx, "SELECT count(*) FROM dolt_log WHERE message LIKE 'Record % audit event%';").Scan(&commits))
+	assert.Greater(t, commits, int64(0))
+	var status int64
+	require.NoError(t, auditConn.QueryRow(ctx, "SELECT count(*) FROM dolt_status;").Scan(&status))
+	assert.Equal(t, int64(0), status)
+
+	// Every event is also appended to the file
+	file, err := os.Open(auditFile)
+	require.NoError(t, err)
+	defer file.Close()
+	var fileStatements []auditedStatement
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var event audit.Event
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event), scanner.Text())
+		if event.ConnectionID != connectionID {
+			continue
+		}
+		assert.Equal(t, "postgres", event.User)
+		assert.Equal(t, "app", event.Database)
+		assert.NotEmpty(t, event.RemoteAddr)
+		assert.False(t, event.Time.IsZero())
+		assert.Equal(t, event.Succeeded, len(event.Error) == 0)
+		fileStatements = append(fileStatements, auditedStatement{string(event.Class), event.CommandTag, event.Statement, event.Succeeded})
+	}
+	require.NoError(t, scanner.Err())
+	assert.Equal(t, expected, fileStatements)
+}
+
+func TestAuditLogConfig(t *testing.T) {
+	for _, auditConfig := range []*servercfg.DoltgresAuditConfig{
+		{},
+		{File: ptr(filepath.Join(t.TempDir(), "audit.jsonl")), Classes: []string{"ddl", "select"}},
+		{File: ptr(filepath.Join(t.TempDir(), "audit.jsonl")), DoltCommit: ptr(true)},
+	} {
+		_, err := TryStartServer(t, &servercfg.DoltgresConfig{
+			BehaviorConfig: &servercfg.DoltgresBehaviorConfig{
+				InMemory: ptr(true),
+			},
+			Audit: auditConfig,
+		})
+		require.Error(t, err)
+	}
+}
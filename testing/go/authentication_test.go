@@ -0,0 +1,118 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+func TestAuthenticationRules(t *testing.T) {
+	srv := StartServer(t, &servercfg.DoltgresConfig{
+		BehaviorConfig: &servercfg.DoltgresBehaviorConfig{
+			InMemory: ptr(true),
+		},
+		UserConfig: &servercfg.DoltgresUserConfig{
+			Name:     ptr("admin"),
+			Password: ptr("secret"),
+		},
+		HBA: []servercfg.DoltgresHBAConfig{
+			{Host: ptr("10.0.0.0/8"), Method: ptr("reject")},
+			{Host: ptr("127.0.0.1"), User: ptr("trusted"), Method: ptr("trust")},
+			{Host: ptr("127.0.0.1"), User: ptr("postgres"), Database: ptr("doltgres"), Method: ptr("trust")},
+			{Database: ptr("passworddb"), Method: ptr("password")},
+			{Database: ptr("md5db"), Method: ptr("md5")},
+			{Database: ptr("scramdb"), Method: ptr("scram-sha-256")},
+			{Database: ptr("rejectdb"), Method: ptr("reject")},
+			{Host: ptr("local"), Method: ptr("trust")},
+			// The server connects as the configured user when it creates the doltgres database on startup
+			{Database: ptr("admin"), User: ptr("admin"), Method: ptr("scram-sha-256")},
+		},
+	})
+
+	ctx := context.Background()
+	conn, err := ConnectAs(t, srv, "postgres", "", "doltgres")
+	require.NoError(t, err)
+	// Roles must exist before they may connect, regardless of the authentication method
+	_, err = ConnectAs(t, srv, "trusted", "", "doltgres")
+	require.ErrorContains(t, err, `role "trusted" does not exist`)
+	_, err = conn.Exec(ctx, "CREATE ROLE trusted LOGIN CREATEDB;")
+	require.NoError(t, err)
+	require.NoError(t, conn.Close(ctx))
+
+	conn, err = ConnectAs(t, srv, "trusted", "", "doltgres")
+	require.NoError(t, err)
+	for _, database := range []string{"passworddb", "md5db", "scramdb", "rejectdb"} {
+		_, err = conn.Exec(ctx, fmt.Sprintf("CREATE DATABASE %s;", database))
+		require.NoError(t, err)
+	}
+	require.NoError(t, conn.Close(ctx))
+
+	for _, database := range []string{"passworddb", "md5db", "scramdb"} {
+		t.Run(database, func(t *testing.T) {
+			conn, err := ConnectAs(t, srv, "admin", "secret", database)
+			require.NoError(t, err)
+			var result int32
+			require.NoError(t, conn.QueryRow(ctx, "SELECT 1;").Scan(&result))
+			require.Equal(t, int32(1), result)
+			require.NoError(t, conn.Close(ctx))
+
+			_, err = ConnectAs(t, srv, "admin", "wrong", database)
+			require.ErrorContains(t, err, `password authentication failed for user "admin"`)
+			_, err = ConnectAs(t, srv, "nobody", "secret", database)
+			require.ErrorContains(t, err, `password authentication failed for user "nobody"`)
+		})
+	}
+
+	// Revisions of a database use the same rules as the database
+	conn, err = ConnectAs(t, srv, "admin", "secret", "passworddb/main")
+	require.NoError(t, err)
+	require.NoError(t, conn.Close(ctx))
+	_, err = ConnectAs(t, srv, "admin", "wrong", "passworddb/main")
+	require.ErrorContains(t, err, `password authentication failed for user "admin"`)
+	_, err = ConnectAs(t, srv, "admin", "secret", "rejectdb/main")
+	require.ErrorContains(t, err, `pg_hba.conf rejects connection for host "127.0.0.1", user "admin", database "rejectdb/main"`)
+
+	_, err = ConnectAs(t, srv, "admin", "secret", "rejectdb")
+	require.ErrorContains(t, err, `pg_hba.conf rejects connection for host "127.0.0.1", user "admin", database "rejectdb"`)
+	_, err = ConnectAs(t, srv, "admin", "secret", "doltgres")
+	require.ErrorContains(t, err, `no pg_hba.conf entry for host "127.0.0.1", user "admin", database "doltgres"`)
+}
+
+func TestInvalidAuthenticationRules(t *testing.T) {
+	_, err := TryStartServer(t, &servercfg.DoltgresConfig{
+		BehaviorConfig: &servercfg.DoltgresBehaviorConfig{
+			InMemory: ptr(true),
+		},
+		HBA: []servercfg.DoltgresHBAConfig{
+			{Host: ptr("127.0.0.1/64"), Method: ptr("trust")},
+		},
+	})
+	require.ErrorContains(t, err, `invalid CIDR mask in address "127.0.0.1/64"`)
+	_, err = TryStartServer(t, &servercfg.DoltgresConfig{
+		BehaviorConfig: &servercfg.DoltgresBehaviorConfig{
+			InMemory: ptr(true),
+		},
+		HBA: []servercfg.DoltgresHBAConfig{
+			{Method: ptr("ident")},
+		},
+	})
+	require.ErrorContains(t, err, `invalid authentication method "ident"`)
+}
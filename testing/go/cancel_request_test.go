@@ -0,0 +1,62 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelRequest(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+
+	pid := conn.PgConn().PID()
+	assert.NotZero(t, conn.PgConn().SecretKey())
+	go func() {
+		time.Sleep(500 * time.Millisecond)
+		_ = conn.PgConn().CancelRequest(context.Background())
+	}()
+	start := time.Now()
+	_, err := conn.Exec(ctx, "SELECT pg_sleep(30);")
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 10*time.Second)
+	var pgErr *pgconn.PgError
+	require.True(t, errors.As(err, &pgErr))
+	assert.Equal(t, "57014", pgErr.Code)
+	assert.Equal(t, "canceling statement due to user request", pgErr.Message)
+
+	// The connection remains usable after its query has been canceled
+	var result int32
+	require.NoError(t, conn.QueryRow(ctx, "SELECT 1;").Scan(&result))
+	assert.Equal(t, int32(1), result)
+	assert.Equal(t, pid, conn.PgConn().PID())
+
+	// Canceling when no query is running has no effect on the next query
+	require.NoError(t, conn.PgConn().CancelRequest(context.Background()))
+	time.Sleep(100 * time.Millisecond)
+	_, err = conn.Exec(ctx, "SELECT pg_sleep(0.1);")
+	require.NoError(t, err)
+}
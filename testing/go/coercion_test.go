@@ -120,5 +120,57 @@ func TestCoercion(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "Array casts",
+			SetUpScript: []string{
+				`CREATE TABLE arrays (id INT4 PRIMARY KEY, i8 INT8[], vc VARCHAR(5)[], n NUMERIC(4,1)[]);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT ARRAY[1, NULL, 3]::int8[], ARRAY[1.5, 2.5, -2.5]::int4[], ARRAY['7', '8']::int2[];`,
+					Expected: []sql.Row{{"{1,NULL,3}", "{2,3,-3}", "{7,8}"}},
+				},
+				{
+					Query:    `SELECT ARRAY[1, 2]::int8[]::text[], ARRAY[0, 1]::bool[], ARRAY['1 day']::interval[]::text[];`,
+					Expected: []sql.Row{{"{1,2}", "{f,t}", `{"1 day"}`}},
+				},
+				{
+					Query:    `SELECT ARRAY['abc', 'defghi']::varchar(5)[], '{abc,defghi}'::text[]::varchar(5)[], ARRAY['abcdefg']::varchar(10)[]::varchar(3)[];`,
+					Expected: []sql.Row{{"{abc,defgh}", "{abc,defgh}", "{abc}"}},
+				},
+				{
+					Query:    `SELECT ARRAY[1.25, 10.75]::numeric(4,1)[], ARRAY[1.5]::numeric(3,1)[]::numeric(2,0)[];`,
+					Expected: []sql.Row{{"{1.3,10.8}", "{2}"}},
+				},
+				{
+					Query:       `SELECT ARRAY[1, 300000]::int2[];`,
+					ExpectedErr: "smallint out of range",
+				},
+				{
+					Query:       `SELECT ARRAY[1000.5]::numeric(4,1)[];`,
+					ExpectedErr: "numeric field overflow",
+				},
+				{
+					Query:       `SELECT ARRAY[1]::date[];`,
+					ExpectedErr: "does not exist",
+				},
+				{
+					Query:    `INSERT INTO arrays VALUES (1, ARRAY[1, 2]::int4[], ARRAY['ab']::text[], ARRAY[1.25]), (2, ARRAY[2.5]::numeric[], NULL, ARRAY[7.5]::float8[]);`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       `INSERT INTO arrays VALUES (3, NULL, ARRAY['abcdefg']::text[], NULL);`,
+					ExpectedErr: "value too long for type varchar(5)",
+				},
+				{
+					Query:    `UPDATE arrays SET i8 = ARRAY[9]::int2[] WHERE id = 2;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT id, i8, vc, n FROM arrays ORDER BY id;`,
+					Expected: []sql.Row{{int32(1), "{1,2}", "{ab}", "{1.3}"}, {int32(2), "{9}", nil, "{7.5}"}},
+				},
+			},
+		},
 	})
 }
@@ -0,0 +1,90 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestColumnNames(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "identifier case folding",
+			SetUpScript: []string{
+				`CREATE TABLE Test (Id INT4 PRIMARY KEY, "MixedCase" TEXT, UPPERCASE INT4);`,
+				`INSERT INTO test VALUES (1, 'a', 2);`,
+				`CREATE VIEW TestView AS SELECT Id AS "QuotedId", UpperCase AS Unquoted FROM test;`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:            `SELECT * FROM TEST;`,
+					Expected:         []sql.Row{{1, "a", 2}},
+					ExpectedColNames: []string{"id", "MixedCase", "uppercase"},
+				},
+				{
+					Query:            `SELECT ID, "MixedCase", UpperCase FROM test;`,
+					Expected:         []sql.Row{{1, "a", 2}},
+					ExpectedColNames: []string{"id", "MixedCase", "uppercase"},
+				},
+				{
+					Query:            `SELECT t.ID, t."MixedCase" FROM test AS T;`,
+					Expected:         []sql.Row{{1, "a"}},
+					ExpectedColNames: []string{"id", "MixedCase"},
+				},
+				{
+					Query:            `SELECT id AS Foo, id AS "Bar", id AS "baz" FROM test;`,
+					Expected:         []sql.Row{{1, 1, 1}},
+					ExpectedColNames: []string{"foo", "Bar", "baz"},
+				},
+				{
+					Query:            `SELECT * FROM testview;`,
+					Expected:         []sql.Row{{1, 2}},
+					ExpectedColNames: []string{"QuotedId", "unquoted"},
+				},
+			},
+		},
+		{
+			Name: "labels of unaliased expressions",
+			SetUpScript: []string{
+				`CREATE TABLE test (pk INT4 PRIMARY KEY, v1 TEXT);`,
+				`INSERT INTO test VALUES (1, 'A'), (2, 'B');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:            `SELECT count(*), COUNT(pk), LOWER(v1) FROM test GROUP BY v1 ORDER BY count(*), lower(v1);`,
+					Expected:         []sql.Row{{1, 1, "a"}, {1, 1, "b"}},
+					ExpectedColNames: []string{"count", "count", "lower"},
+				},
+				{
+					Query:            `SELECT 1, pk + 1, NULL, 'str', 1.5 FROM test WHERE pk = 1;`,
+					Expected:         []sql.Row{{1, 2, nil, "str", 1.5}},
+					ExpectedColNames: []string{"?column?", "?column?", "?column?", "?column?", "?column?"},
+				},
+				{
+					Query:            `SELECT pk::INT8, 'x'::TEXT, CAST(1 AS INT2), 1::VARCHAR(5), true, false::BOOLEAN FROM test WHERE pk = 1;`,
+					Expected:         []sql.Row{{1, "x", 1, "1", "t", "f"}},
+					ExpectedColNames: []string{"pk", "text", "int2", "varchar", "bool", "bool"},
+				},
+				{
+					Query:            `SELECT coalesce(v1, 'x'), nullif(pk, 2), CASE WHEN pk = 1 THEN 'one' END, ARRAY[pk] FROM test WHERE pk = 1;`,
+					Expected:         []sql.Row{{"A", 1, "one", "{1}"}},
+					ExpectedColNames: []string{"coalesce", "nullif", "case", "array"},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,184 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitHooks(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "SQL commit hooks",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 INT8);",
+				"CREATE TABLE hook_log (hash TEXT, branch TEXT, tables TEXT, message TEXT);",
+				"CALL dolt_commit('-Am', 'initial');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "CALL dolt_commit_hook_add('bad', 'ftp', 'SELECT 1');",
+					ExpectedErr: `commit hook type must be either "http" or "sql"`,
+				},
+				{
+					Query:       "CALL dolt_commit_hook_add('bad', 'http', 'ftp://example.com');",
+					ExpectedErr: "commit hook URL must be an http or https URL",
+				},
+				{
+					Query:       "CALL dolt_commit_hook_add('bad', 'sql', 'SELECT 1; SELECT 2;');",
+					ExpectedErr: "expected 1 statement",
+				},
+				{
+					Query:    "CALL dolt_commit_hook_add('log', 'sql', 'INSERT INTO hook_log VALUES ({hash}, {branch}, {tables}, {message})');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "CALL dolt_commit_hook_add('release_log', 'sql', 'INSERT INTO hook_log VALUES (''release-'' || {hash}, {branch}, {tables}, {message})', 'release/*');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:       "CALL dolt_commit_hook_add('log', 'sql', 'SELECT 1');",
+					ExpectedErr: `commit hook "log" already exists`,
+				},
+				{
+					Query: "SELECT name, type, branch FROM dolt.commit_hooks ORDER BY id;",
+					Expected: []sql.Row{
+						{"log", "sql", nil},
+						{"release_log", "sql", "release/*"},
+					},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (1, 10);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:            "CALL dolt_add('test');",
+					SkipResultsCheck: true,
+				},
+				{
+					Query:            "CALL dolt_commit('-m', 'it''s a new row');",
+					SkipResultsCheck: true,
+				},
+				{
+					Query: "SELECT branch, tables, message FROM hook_log WHERE hash IN (SELECT commit_hash FROM dolt_log LIMIT 1);",
+					Expected: []sql.Row{
+						{"main", "public.test", "it's a new row"},
+					},
+				},
+				{
+					Query:            "CALL dolt_commit('--allow-empty', '-m', 'empty');",
+					SkipResultsCheck: true,
+				},
+				{
+					Query:    "SELECT message FROM hook_log ORDER BY message;",
+					Expected: []sql.Row{{"empty"}, {"it's a new row"}},
+				},
+				{
+					Query:    "CALL dolt_commit_hook_drop('log');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:       "CALL dolt_commit_hook_drop('log');",
+					ExpectedErr: `commit hook "log" does not exist`,
+				},
+				{
+					Query:            "CALL dolt_commit('--allow-empty', '-m', 'after drop');",
+					SkipResultsCheck: true,
+				},
+				{
+					Query:    "SELECT count(*) FROM hook_log;",
+					Expected: []sql.Row{{2}},
+				},
+			},
+		},
+	})
+}
+
+func TestCommitHooksHTTP(t *testing.T) {
+	events := make(chan map[string]any, 10)
+	endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		var event map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		events <- event
+	}))
+	defer endpoint.Close()
+
+	ctx, conn, controller := CreateServer(t, "hookdb")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+
+	_, err := conn.Exec(ctx, "CREATE TABLE items (pk INT8 PRIMARY KEY, name TEXT);")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "CALL dolt_commit_hook_add('ci', 'http', '"+endpoint.URL+"/commits', 'main');")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "INSERT INTO items VALUES (1, 'one');")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "CALL dolt_commit('-Am', 'first items');")
+	require.NoError(t, err)
+	var commitHash string
+	require.NoError(t, conn.QueryRow(ctx, "SELECT commit_hash FROM dolt_log LIMIT 1;").Scan(&commitHash))
+
+	select {
+	case event := <-events:
+		assert.Equal(t, "hookdb", event["database"])
+		assert.Equal(t, "main", event["branch"])
+		assert.Equal(t, "first items", event["message"])
+		assert.Equal(t, commitHash, event["hash"])
+		assert.Len(t, event["parents"], 1)
+		assert.ElementsMatch(t, []any{"public.items"}, event["tables_changed"])
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "the commit hook was not called")
+	}
+
+	// Commits on other branches do not match the hook's branch
+	_, err = conn.Exec(ctx, "CALL dolt_checkout('-b', 'other');")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "INSERT INTO items VALUES (2, 'two');")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "CALL dolt_commit('-am', 'other items');")
+	require.NoError(t, err)
+
+	// Merging into main moves its head, which calls the hook
+	_, err = conn.Exec(ctx, "CALL dolt_checkout('main');")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "CALL dolt_merge('other');")
+	require.NoError(t, err)
+	select {
+	case event := <-events:
+		assert.Equal(t, "main", event["branch"])
+		assert.Equal(t, "other items", event["message"])
+		assert.ElementsMatch(t, []any{"public.items"}, event["tables_changed"])
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "the commit hook was not called")
+	}
+	select {
+	case event := <-events:
+		assert.FailNow(t, "unexpected commit hook call", "%v", event)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestCommitValidations(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "Commit validations block failing commits",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 INT8);",
+				"INSERT INTO test VALUES (1, 10), (2, 20);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "CALL dolt_commit_validation_add('bad', 'DELETE FROM test');",
+					ExpectedErr: "must be a SELECT statement",
+				},
+				{
+					Query:       "CALL dolt_commit_validation_add('bad', 'SELECT * FROM test; SELECT 1;');",
+					ExpectedErr: "expected 1 statement",
+				},
+				{
+					Query:    "CALL dolt_commit_validation_add('positive_values', 'SELECT * FROM test WHERE v1 <= 0', 'v1 must be positive');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "CALL dolt_commit_validation_add('no_large_values', 'SELECT * FROM test WHERE v1 > 100');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:       "CALL dolt_commit_validation_add('positive_values', 'SELECT 1');",
+					ExpectedErr: `commit validation "positive_values" already exists`,
+				},
+				{
+					Query: "SELECT name, query, message FROM dolt.commit_validations ORDER BY name;",
+					Expected: []sql.Row{
+						{"no_large_values", "SELECT * FROM test WHERE v1 > 100", nil},
+						{"positive_values", "SELECT * FROM test WHERE v1 <= 0", "v1 must be positive"},
+					},
+				},
+				{
+					Query:            "CALL dolt_commit('-Am', 'valid data');",
+					SkipResultsCheck: true,
+				},
+				{
+					Query:    "INSERT INTO test VALUES (3, -30);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "CALL dolt_commit('-am', 'negative data');",
+					ExpectedErr: `commit validation "positive_values" failed: v1 must be positive`,
+				},
+				{
+					Query:    "UPDATE test SET v1 = 300 WHERE pk = 3;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "CALL dolt_commit('-am', 'large data');",
+					ExpectedErr: `commit validation "no_large_values" failed: query returned 1 violating row(s)`,
+				},
+				{
+					Query:    "SELECT message FROM dolt_log LIMIT 1;",
+					Expected: []sql.Row{{"valid data"}},
+				},
+				{
+					Query:    "CALL dolt_commit_validation_drop('no_large_values');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:       "CALL dolt_commit_validation_drop('no_large_values');",
+					ExpectedErr: `commit validation "no_large_values" does not exist`,
+				},
+				{
+					Query:            "CALL dolt_commit('-Am', 'large data');",
+					SkipResultsCheck: true,
+				},
+				{
+					Query:    "SELECT message FROM dolt_log LIMIT 1;",
+					Expected: []sql.Row{{"large data"}},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,167 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+func TestConnectionLimits(t *testing.T) {
+	srv := StartServer(t, &servercfg.DoltgresConfig{
+		BehaviorConfig: &servercfg.DoltgresBehaviorConfig{
+			InMemory: ptr(true),
+		},
+		ListenerConfig: &servercfg.DoltgresListenerConfig{
+			MaxConnections:            ptr(uint64(4)),
+			MaxConnectionsPerUser:     map[string]uint64{"limited": 1},
+			MaxConnectionsPerDatabase: map[string]uint64{"limited_db": 2},
+		},
+	})
+
+	ctx := context.Background()
+	requireLimitError := func(t *testing.T, err error, message string) {
+		require.Error(t, err)
+		var pgErr *pgconn.PgError
+		require.True(t, errors.As(err, &pgErr), err.Error())
+		assert.Equal(t, "53300", pgErr.Code)
+		assert.Equal(t, message, pgErr.Message)
+	}
+
+	first := Connect(t, srv, "doltgres")
+	var maxConnections string
+	require.NoError(t, first.QueryRow(ctx, "SHOW max_connections;").Scan(&maxConnections))
+	assert.Equal(t, "4", maxConnections)
+	ExecQueries(t, first,
+		"CREATE DATABASE limited_db;",
+		"CREATE ROLE limited LOGIN;",
+		"CREATE ROLE capped LOGIN CONNECTION LIMIT 1;",
+	)
+
+	t.Run("total", func(t *testing.T) {
+		// The first connection, along with those opened here, reaches the limit. Each is closed once the test finishes.
+		for i := 0; i < 3; i++ {
+			_, err := ConnectAs(t, srv, "postgres", "password", "doltgres")
+			require.NoError(t, err)
+		}
+		_, err := ConnectAs(t, srv, "postgres", "password", "doltgres")
+		requireLimitError(t, err, "sorry, too many clients already")
+	})
+
+	t.Run("per database", func(t *testing.T) {
+		// The connections from the previous test are released once the server has seen them close
+		var second *pgx.Conn
+		var err error
+		require.Eventually(t, func() bool {
+			second, err = ConnectAs(t, srv, "postgres", "password", "limited_db")
+			return err == nil
+		}, 5*time.Second, 50*time.Millisecond)
+		third, err := ConnectAs(t, srv, "postgres", "password", "limited_db")
+		require.NoError(t, err)
+		defer third.Close(ctx)
+		_, err = ConnectAs(t, srv, "postgres", "password", "limited_db")
+		requireLimitError(t, err, `too many connections for database "limited_db"`)
+		// Closing a connection frees its place
+		require.NoError(t, second.Close(ctx))
+		require.Eventually(t, func() bool {
+			conn, err := ConnectAs(t, srv, "postgres", "password", "limited_db")
+			if err != nil {
+				return false
+			}
+			_ = conn.Close(ctx)
+			return true
+		}, 5*time.Second, 50*time.Millisecond)
+	})
+
+	t.Run("per user", func(t *testing.T) {
+		limited, err := ConnectAs(t, srv, "limited", "password", "doltgres")
+		require.NoError(t, err)
+		defer limited.Close(ctx)
+		_, err = ConnectAs(t, srv, "limited", "password", "doltgres")
+		requireLimitError(t, err, `too many connections for role "limited"`)
+	})
+
+	t.Run("role connection limit", func(t *testing.T) {
+		capped, err := ConnectAs(t, srv, "capped", "password", "doltgres")
+		require.NoError(t, err)
+		defer capped.Close(ctx)
+		_, err = ConnectAs(t, srv, "capped", "password", "doltgres")
+		requireLimitError(t, err, `too many connections for role "capped"`)
+	})
+}
+
+func TestIdleTimeouts(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+	connString := conn.Config().ConnString()
+
+	t.Run("idle session", func(t *testing.T) {
+		conn, err := pgx.Connect(ctx, connString)
+		require.NoError(t, err)
+		defer conn.Close(ctx)
+		_, err = conn.Exec(ctx, "SET idle_session_timeout = 200;")
+		require.NoError(t, err)
+		// Activity within the timeout keeps the session alive
+		for i := 0; i < 3; i++ {
+			time.Sleep(100 * time.Millisecond)
+			_, err = conn.Exec(ctx, "SELECT 1;")
+			require.NoError(t, err)
+		}
+		time.Sleep(500 * time.Millisecond)
+		_, err = conn.Exec(ctx, "SELECT 1;")
+		require.Error(t, err)
+		var pgErr *pgconn.PgError
+		require.True(t, errors.As(err, &pgErr), err.Error())
+		assert.Equal(t, "57P05", pgErr.Code)
+		assert.Equal(t, "terminating connection due to idle-session timeout", pgErr.Message)
+	})
+
+	t.Run("idle in transaction", func(t *testing.T) {
+		conn, err := pgx.Connect(ctx, connString)
+		require.NoError(t, err)
+		defer conn.Close(ctx)
+		_, err = conn.Exec(ctx, "SET idle_in_transaction_session_timeout = 200;")
+		require.NoError(t, err)
+		// The timeout does not apply outside of a transaction
+		time.Sleep(400 * time.Millisecond)
+		_, err = conn.Exec(ctx, "BEGIN;")
+		require.NoError(t, err)
+		time.Sleep(500 * time.Millisecond)
+		_, err = conn.Exec(ctx, "SELECT 1;")
+		require.Error(t, err)
+		var pgErr *pgconn.PgError
+		require.True(t, errors.As(err, &pgErr), err.Error())
+		assert.Equal(t, "25P03", pgErr.Code)
+		assert.Equal(t, "terminating connection due to idle-in-transaction timeout", pgErr.Message)
+	})
+
+	// Connections without timeouts are unaffected
+	var result int32
+	require.NoError(t, conn.QueryRow(ctx, "SELECT 1;").Scan(&result))
+	assert.Equal(t, int32(1), result)
+}
@@ -0,0 +1,279 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopyFrom(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+
+	t.Run("Text format", func(t *testing.T) {
+		_, err := conn.Exec(ctx, "CREATE TABLE text_test (pk INT8 PRIMARY KEY, v1 TEXT, v2 INT4);")
+		require.NoError(t, err)
+		data := "1\tone\t10\n" +
+			"2\t\\N\t20\n" +
+			"3\ttab\\there\t\\N\n" +
+			"4\tnew\\nline\t40\n" +
+			"5\tback\\\\slash\t50\n" +
+			"\\.\n"
+		tag, err := conn.PgConn().CopyFrom(ctx, strings.NewReader(data), "COPY text_test FROM STDIN;")
+		require.NoError(t, err)
+		assert.Equal(t, "COPY 5", tag.String())
+		assert.Equal(t, [][]any{
+			{int64(1), "one", int32(10)},
+			{int64(2), nil, int32(20)},
+			{int64(3), "tab\there", nil},
+			{int64(4), "new\nline", int32(40)},
+			{int64(5), "back\\slash", int32(50)},
+		}, copyTestRows(t, ctx, conn, "SELECT * FROM text_test ORDER BY pk;"))
+	})
+
+	t.Run("Text format with options", func(t *testing.T) {
+		_, err := conn.Exec(ctx, "CREATE TABLE text_options (pk INT8 PRIMARY KEY, v1 TEXT);")
+		require.NoError(t, err)
+		data := "pk|v1\n1|one\n2|NULL\n3|\n"
+		tag, err := conn.PgConn().CopyFrom(ctx, strings.NewReader(data),
+			"COPY text_options FROM STDIN WITH (FORMAT text, DELIMITER '|', NULL 'NULL', HEADER true);")
+		require.NoError(t, err)
+		assert.Equal(t, "COPY 3", tag.String())
+		assert.Equal(t, [][]any{
+			{int64(1), "one"},
+			{int64(2), nil},
+			{int64(3), ""},
+		}, copyTestRows(t, ctx, conn, "SELECT * FROM text_options ORDER BY pk;"))
+	})
+
+	t.Run("CSV format", func(t *testing.T) {
+		_, err := conn.Exec(ctx, "CREATE TABLE csv_test (pk INT8 PRIMARY KEY, v1 TEXT, v2 TEXT);")
+		require.NoError(t, err)
+		data := "pk,v1,v2\n" +
+			"1,plain,\"quoted, with comma\"\n" +
+			"2,,\"\"\n" +
+			"3,\"say \"\"hi\"\"\",\"multi\nline\"\n"
+		tag, err := conn.PgConn().CopyFrom(ctx, strings.NewReader(data), "COPY csv_test FROM STDIN WITH CSV HEADER;")
+		require.NoError(t, err)
+		assert.Equal(t, "COPY 3", tag.String())
+		assert.Equal(t, [][]any{
+			{int64(1), "plain", "quoted, with comma"},
+			{int64(2), nil, ""},
+			{int64(3), `say "hi"`, "multi\nline"},
+		}, copyTestRows(t, ctx, conn, "SELECT * FROM csv_test ORDER BY pk;"))
+	})
+
+	t.Run("CSV format with options", func(t *testing.T) {
+		_, err := conn.Exec(ctx, "CREATE TABLE csv_options (pk INT8 PRIMARY KEY, v1 TEXT);")
+		require.NoError(t, err)
+		data := "1;'it\\'s'\n2;<null>\n"
+		tag, err := conn.PgConn().CopyFrom(ctx, strings.NewReader(data),
+			"COPY csv_options FROM STDIN WITH (FORMAT csv, DELIMITER ';', QUOTE '''', ESCAPE '\\', NULL '<null>');")
+		require.NoError(t, err)
+		assert.Equal(t, "COPY 2", tag.String())
+		assert.Equal(t, [][]any{
+			{int64(1), "it's"},
+			{int64(2), nil},
+		}, copyTestRows(t, ctx, conn, "SELECT * FROM csv_options ORDER BY pk;"))
+	})
+
+	t.Run("Column list", func(t *testing.T) {
+		_, err := conn.Exec(ctx, "CREATE TABLE column_list (pk INT8 PRIMARY KEY, v1 TEXT, v2 INT4);")
+		require.NoError(t, err)
+		tag, err := conn.PgConn().CopyFrom(ctx, strings.NewReader("7\t1\n8\t2\n"), "COPY column_list (v2, pk) FROM STDIN;")
+		require.NoError(t, err)
+		assert.Equal(t, "COPY 2", tag.String())
+		assert.Equal(t, [][]any{
+			{int64(1), nil, int32(7)},
+			{int64(2), nil, int32(8)},
+		}, copyTestRows(t, ctx, conn, "SELECT * FROM column_list ORDER BY pk;"))
+	})
+
+	t.Run("Many rows", func(t *testing.T) {
+		_, err := conn.Exec(ctx, "CREATE TABLE many_rows (pk INT8 PRIMARY KEY, v1 TEXT);")
+		require.NoError(t, err)
+		sb := strings.Builder{}
+		for i := 1; i <= 2500; i++ {
+			sb.WriteString(fmt.Sprintf("%d,row %d\n", i, i))
+		}
+		tag, err := conn.PgConn().CopyFrom(ctx, strings.NewReader(sb.String()), "COPY many_rows FROM STDIN (FORMAT csv);")
+		require.NoError(t, err)
+		assert.Equal(t, "COPY 2500", tag.String())
+		assert.Equal(t, [][]any{{int64(2500)}}, copyTestRows(t, ctx, conn, "SELECT count(*) FROM many_rows;"))
+	})
+
+	t.Run("Errors roll back the entire load", func(t *testing.T) {
+		_, err := conn.Exec(ctx, "CREATE TABLE errors_test (pk INT8 PRIMARY KEY, v1 INT4);")
+		require.NoError(t, err)
+
+		_, err = conn.PgConn().CopyFrom(ctx, strings.NewReader("1\t1\n2\t2\t2\n"), "COPY errors_test FROM STDIN;")
+		require.ErrorContains(t, err, "extra data after last expected column (COPY errors_test, line 2)")
+		_, err = conn.PgConn().CopyFrom(ctx, strings.NewReader("1\t1\n2\n"), "COPY errors_test FROM STDIN;")
+		require.ErrorContains(t, err, "missing data for column 2 (COPY errors_test, line 2)")
+		_, err = conn.PgConn().CopyFrom(ctx, strings.NewReader("1\t1\n2\tabc\n"), "COPY errors_test FROM STDIN;")
+		require.Error(t, err)
+		_, err = conn.PgConn().CopyFrom(ctx, strings.NewReader("1,\"unterminated\n"), "COPY errors_test FROM STDIN CSV;")
+		require.ErrorContains(t, err, "unterminated CSV quoted field")
+		_, err = conn.PgConn().CopyFrom(ctx, strings.NewReader("1\t1\n"), "COPY missing_table FROM STDIN;")
+		require.Error(t, err)
+		_, err = conn.PgConn().CopyFrom(ctx, strings.NewReader("1\t1\n"), "COPY errors_test FROM STDIN WITH (FORMAT binary);")
+		require.ErrorContains(t, err, "COPY FROM with the BINARY format is not yet supported")
+		_, err = conn.PgConn().CopyFrom(ctx, strings.NewReader("1\t1\n"), "COPY errors_test FROM STDIN WITH (QUOTE '\"');")
+		require.ErrorContains(t, err, "COPY quote available only in CSV mode")
+		_, err = conn.PgConn().CopyFrom(ctx, strings.NewReader("1\t1\n"), "COPY errors_test FROM STDIN WITH (DELIMITER '||');")
+		require.ErrorContains(t, err, "COPY delimiter must be a single one-byte character")
+		_, err = conn.PgConn().CopyFrom(ctx, strings.NewReader("1\t1\n"), "COPY errors_test FROM STDIN WITH (UNKNOWN_OPTION 1);")
+		require.ErrorContains(t, err, `option "unknown_option" not recognized`)
+
+		// None of the failed loads may leave rows behind, and the connection must still be usable
+		assert.Equal(t, [][]any{{int64(0)}}, copyTestRows(t, ctx, conn, "SELECT count(*) FROM errors_test;"))
+		tag, err := conn.PgConn().CopyFrom(ctx, strings.NewReader("1\t1\n"), "COPY errors_test FROM STDIN;")
+		require.NoError(t, err)
+		assert.Equal(t, "COPY 1", tag.String())
+	})
+
+	t.Run("Unsupported through the extended protocol", func(t *testing.T) {
+		_, err := conn.Prepare(ctx, "copy_stmt", "COPY errors_test FROM STDIN;")
+		if err == nil {
+			_, err = conn.Exec(ctx, "copy_stmt")
+		}
+		require.Error(t, err)
+		// The connection remains usable
+		assert.Equal(t, [][]any{{int32(1)}}, copyTestRows(t, ctx, conn, "SELECT 1;"))
+	})
+}
+
+func TestCopyTo(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+	for _, query := range []string{
+		"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 TEXT, v2 INT4);",
+		`INSERT INTO test VALUES (1, 'one', 10), (2, NULL, 20), (3, E'tab\there', NULL), (4, 'say "hi", ok', 40), (5, '', 50);`,
+	} {
+		_, err := conn.Exec(ctx, query)
+		require.NoError(t, err)
+	}
+
+	copyTo := func(t *testing.T, query string) (string, string) {
+		sb := strings.Builder{}
+		tag, err := conn.PgConn().CopyTo(ctx, &sb, query)
+		require.NoError(t, err)
+		return tag.String(), sb.String()
+	}
+
+	t.Run("Text format", func(t *testing.T) {
+		tag, data := copyTo(t, "COPY test TO STDOUT;")
+		assert.Equal(t, "COPY 5", tag)
+		assert.Equal(t, "1\tone\t10\n"+
+			"2\t\\N\t20\n"+
+			"3\ttab\\there\t\\N\n"+
+			"4\tsay \"hi\", ok\t40\n"+
+			"5\t\t50\n", data)
+	})
+
+	t.Run("Text format with options", func(t *testing.T) {
+		tag, data := copyTo(t, "COPY test (v2, pk) TO STDOUT WITH (DELIMITER '|', NULL 'NULL', HEADER);")
+		assert.Equal(t, "COPY 5", tag)
+		assert.Equal(t, "v2|pk\n10|1\n20|2\nNULL|3\n40|4\n50|5\n", data)
+	})
+
+	t.Run("CSV format", func(t *testing.T) {
+		tag, data := copyTo(t, "COPY test TO STDOUT WITH (FORMAT csv, HEADER);")
+		assert.Equal(t, "COPY 5", tag)
+		assert.Equal(t, "pk,v1,v2\n"+
+			"1,one,10\n"+
+			"2,,20\n"+
+			"3,tab\there,\n"+
+			"4,\"say \"\"hi\"\", ok\",40\n"+
+			"5,\"\",50\n", data)
+	})
+
+	t.Run("Query", func(t *testing.T) {
+		tag, data := copyTo(t, "COPY (SELECT pk * 10, v1 FROM test WHERE pk < 3 ORDER BY pk DESC) TO STDOUT CSV;")
+		assert.Equal(t, "COPY 2", tag)
+		assert.Equal(t, "20,\n10,one\n", data)
+
+		tag, data = copyTo(t, "COPY (SELECT * FROM test WHERE pk > 100) TO STDOUT;")
+		assert.Equal(t, "COPY 0", tag)
+		assert.Equal(t, "", data)
+	})
+
+	t.Run("Binary format", func(t *testing.T) {
+		tag, data := copyTo(t, "COPY (SELECT pk, v1 FROM test WHERE pk <= 2 ORDER BY pk) TO STDOUT WITH (FORMAT binary);")
+		assert.Equal(t, "COPY 2", tag)
+		expected := []byte("PGCOPY\n\377\r\n\000")
+		expected = append(expected, 0, 0, 0, 0, 0, 0, 0, 0)
+		expected = append(expected, 0, 2, 0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 3, 'o', 'n', 'e')
+		expected = append(expected, 0, 2, 0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 2, 0xFF, 0xFF, 0xFF, 0xFF)
+		expected = append(expected, 0xFF, 0xFF)
+		assert.Equal(t, expected, []byte(data))
+	})
+
+	t.Run("Round trip", func(t *testing.T) {
+		_, err := conn.Exec(ctx, "CREATE TABLE round_trip (pk INT8 PRIMARY KEY, v1 TEXT, v2 INT4);")
+		require.NoError(t, err)
+		for _, format := range []string{"text", "csv"} {
+			_, err = conn.Exec(ctx, "DELETE FROM round_trip;")
+			require.NoError(t, err)
+			_, data := copyTo(t, fmt.Sprintf("COPY test TO STDOUT WITH (FORMAT %s);", format))
+			_, err = conn.PgConn().CopyFrom(ctx, strings.NewReader(data), fmt.Sprintf("COPY round_trip FROM STDIN WITH (FORMAT %s);", format))
+			require.NoError(t, err)
+			assert.Equal(t,
+				copyTestRows(t, ctx, conn, "SELECT * FROM test ORDER BY pk;"),
+				copyTestRows(t, ctx, conn, "SELECT * FROM round_trip ORDER BY pk;"))
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := conn.PgConn().CopyTo(ctx, &strings.Builder{}, "COPY missing_table TO STDOUT;")
+		require.Error(t, err)
+		_, err = conn.PgConn().CopyTo(ctx, &strings.Builder{}, "COPY test (missing_column) TO STDOUT;")
+		require.Error(t, err)
+		_, err = conn.PgConn().CopyTo(ctx, &strings.Builder{}, "COPY test TO STDOUT WITH (FORMAT binary, HEADER);")
+		require.ErrorContains(t, err, "cannot specify HEADER in BINARY mode")
+		// The connection remains usable
+		assert.Equal(t, [][]any{{int64(5)}}, copyTestRows(t, ctx, conn, "SELECT count(*) FROM test;"))
+	})
+}
+
+// copyTestRows returns all rows from the given query.
+func copyTestRows(t *testing.T, ctx context.Context, conn *pgx.Conn, query string) [][]any {
+	rows, err := conn.Query(ctx, query)
+	require.NoError(t, err)
+	defer rows.Close()
+	var result [][]any
+	for rows.Next() {
+		values, err := rows.Values()
+		require.NoError(t, err)
+		result = append(result, values)
+	}
+	require.NoError(t, rows.Err())
+	return result
+}
@@ -140,6 +140,27 @@ var createViewStmts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "views with common table expressions, ordering, and limits",
+		SetUpScript: []string{
+			"create table t1 (pk int primary key, grp int);",
+			"insert into t1 values (1, 1), (2, 1), (3, 2), (4, 3);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "CREATE VIEW v AS WITH grouped AS (SELECT grp, count(*) AS total FROM t1 GROUP BY grp) SELECT grp, total FROM grouped ORDER BY grp DESC LIMIT 2;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT * FROM v;",
+				Expected: []sql.Row{{3, 1}, {2, 1}},
+			},
+			{
+				Query:    "SELECT * FROM v WHERE grp > 2;",
+				Expected: []sql.Row{{3, 1}},
+			},
+		},
+	},
 	{
 		Name: "not yet supported create view queries",
 		Assertions: []ScriptTestAssertion{
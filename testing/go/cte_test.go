@@ -0,0 +1,145 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestCommonTableExpressions(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "WITH",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 INT4);",
+				"INSERT INTO test VALUES (1, 10), (2, 20), (3, 30);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "WITH big AS (SELECT * FROM test WHERE v1 > 15) SELECT pk FROM big ORDER BY pk;",
+					Expected: []sql.Row{{2}, {3}},
+				},
+				{
+					Query:    "WITH a (x) AS (SELECT pk FROM test), b AS (SELECT x * 2 AS y FROM a) SELECT y FROM b ORDER BY y;",
+					Expected: []sql.Row{{2}, {4}, {6}},
+				},
+				{
+					Query:    "WITH m AS MATERIALIZED (SELECT v1 AS v FROM test WHERE pk = 3) SELECT pk FROM test WHERE v1 = (SELECT v FROM m);",
+					Expected: []sql.Row{{3}},
+				},
+				{
+					Query:    "WITH big AS (SELECT pk FROM test WHERE v1 > 15) INSERT INTO test SELECT pk + 10, 0 FROM big;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, 10}, {2, 20}, {3, 30}, {12, 0}, {13, 0}},
+				},
+			},
+		},
+		{
+			Name: "WITH RECURSIVE",
+			SetUpScript: []string{
+				"CREATE TABLE tree (id INT4 PRIMARY KEY, parent INT4);",
+				"INSERT INTO tree VALUES (1, NULL), (2, 1), (3, 1), (4, 2), (5, 4), (6, 3);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "WITH RECURSIVE nums (n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM nums WHERE n < 5) SELECT n FROM nums;",
+					Expected: []sql.Row{{1}, {2}, {3}, {4}, {5}},
+				},
+				{
+					Query:    "WITH RECURSIVE descendants (did) AS (SELECT id FROM tree WHERE id = 2 UNION ALL SELECT id FROM tree, descendants WHERE parent = did) SELECT did FROM descendants ORDER BY did;",
+					Expected: []sql.Row{{2}, {4}, {5}},
+				},
+				{
+					// UNION removes duplicate rows, which ends the recursion once no new rows are found
+					Query:    "WITH RECURSIVE cycle (n) AS (SELECT 1 UNION SELECT (n + 1) % 3 FROM cycle) SELECT n FROM cycle ORDER BY n;",
+					Expected: []sql.Row{{0}, {1}, {2}},
+				},
+			},
+		},
+		{
+			Name: "RETURNING",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 INT4, v2 TEXT);",
+				"INSERT INTO test VALUES (1, 10, 'a');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "INSERT INTO test VALUES (2, 20, 'b'), (3, 30, 'c') RETURNING pk, v1 * 2 AS doubled;",
+					Expected: []sql.Row{{2, 40}, {3, 60}},
+				},
+				{
+					Query:    "UPDATE test SET v1 = v1 + 1 WHERE pk >= 2 RETURNING *;",
+					Expected: []sql.Row{{2, 21, "b"}, {3, 31, "c"}},
+				},
+				{
+					Query:    "DELETE FROM test WHERE pk = 1 RETURNING v2;",
+					Expected: []sql.Row{{"a"}},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (2, 0, 'x'), (4, 40, 'd') ON CONFLICT DO NOTHING RETURNING pk;",
+					Expected: []sql.Row{{4}},
+				},
+				{
+					Query:    "INSERT INTO test VALUES ($1, $2, 'e') RETURNING pk, v1;",
+					BindVars: []any{5, 50},
+					Expected: []sql.Row{{5, 50}},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{2, 21, "b"}, {3, 31, "c"}, {4, 40, "d"}, {5, 50, "e"}},
+				},
+			},
+		},
+		{
+			Name: "data-modifying WITH",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 INT4);",
+				"CREATE TABLE archive (pk INT8 PRIMARY KEY, v1 INT4);",
+				"INSERT INTO test VALUES (1, 10), (2, 20), (3, 30);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "WITH moved AS (DELETE FROM test WHERE v1 >= 20 RETURNING *) INSERT INTO archive SELECT * FROM moved;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, 10}},
+				},
+				{
+					Query:    "SELECT * FROM archive ORDER BY pk;",
+					Expected: []sql.Row{{2, 20}, {3, 30}},
+				},
+				{
+					Query:    "WITH changed AS (UPDATE archive SET v1 = v1 * 10 RETURNING pk, v1) SELECT pk, v1 FROM changed ORDER BY pk;",
+					Expected: []sql.Row{{2, 200}, {3, 300}},
+				},
+				{
+					Query:    "WITH added (id) AS (INSERT INTO test VALUES (4, 40) RETURNING pk) SELECT id + 100 FROM added;",
+					Expected: []sql.Row{{104}},
+				},
+				{
+					Query:       "WITH added AS (INSERT INTO test VALUES (5, 50)) SELECT * FROM added;",
+					ExpectedErr: `WITH query "added" does not have a RETURNING clause`,
+				},
+			},
+		},
+	})
+}
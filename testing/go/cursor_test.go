@@ -0,0 +1,269 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestCursors(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "FETCH and MOVE directions",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 TEXT);",
+				"INSERT INTO test VALUES (1, 'a'), (2, 'b'), (3, 'c'), (4, 'd'), (5, 'e');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "DECLARE c SCROLL CURSOR FOR SELECT * FROM test ORDER BY pk;",
+					ExpectedTag: "DECLARE CURSOR",
+				},
+				{
+					Query:    "FETCH 2 FROM c;",
+					Expected: []sql.Row{{1, "a"}, {2, "b"}},
+				},
+				{
+					Query:    "FETCH NEXT FROM c;",
+					Expected: []sql.Row{{3, "c"}},
+				},
+				{
+					Query:    "FETCH PRIOR FROM c;",
+					Expected: []sql.Row{{2, "b"}},
+				},
+				{
+					Query:    "FETCH ABSOLUTE 5 FROM c;",
+					Expected: []sql.Row{{5, "e"}},
+				},
+				{
+					Query:    "FETCH ABSOLUTE -2 FROM c;",
+					Expected: []sql.Row{{4, "d"}},
+				},
+				{
+					Query:    "FETCH RELATIVE -2 FROM c;",
+					Expected: []sql.Row{{2, "b"}},
+				},
+				{
+					Query:    "FETCH FORWARD ALL FROM c;",
+					Expected: []sql.Row{{3, "c"}, {4, "d"}, {5, "e"}},
+				},
+				{
+					Query:    "FETCH NEXT FROM c;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "FETCH BACKWARD 2 FROM c;",
+					Expected: []sql.Row{{5, "e"}, {4, "d"}},
+				},
+				{
+					Query:    "FETCH FIRST FROM c;",
+					Expected: []sql.Row{{1, "a"}},
+				},
+				{
+					Query:    "FETCH LAST IN c;",
+					Expected: []sql.Row{{5, "e"}},
+				},
+				{
+					Query:       "MOVE ABSOLUTE 0 IN c;",
+					ExpectedTag: "MOVE 0",
+				},
+				{
+					Query:       "MOVE FORWARD 3 IN c;",
+					ExpectedTag: "MOVE 3",
+				},
+				{
+					Query:    "FETCH c;",
+					Expected: []sql.Row{{4, "d"}},
+				},
+				{
+					Query:    "FETCH RELATIVE 0 FROM c;",
+					Expected: []sql.Row{{4, "d"}},
+				},
+				{
+					Query:    "FETCH BACKWARD ALL FROM c;",
+					Expected: []sql.Row{{3, "c"}, {2, "b"}, {1, "a"}},
+				},
+				{
+					Query:       "MOVE LAST FROM c;",
+					ExpectedTag: "MOVE 1",
+				},
+				{
+					Query:    "SELECT name, statement, is_holdable, is_binary, is_scrollable FROM pg_cursors;",
+					Expected: []sql.Row{{"c", "DECLARE c SCROLL CURSOR FOR SELECT * FROM test ORDER BY pk;", "f", "f", "t"}},
+				},
+				{
+					Query:       "CLOSE c;",
+					ExpectedTag: "CLOSE CURSOR",
+				},
+				{
+					Query:       "FETCH NEXT FROM c;",
+					ExpectedErr: `cursor "c" does not exist`,
+				},
+				{
+					Query:    "COMMIT;",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "cursors read the rows from when they were declared",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY);",
+				"INSERT INTO test VALUES (1), (2);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DECLARE c CURSOR FOR SELECT pk FROM test ORDER BY pk;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (3);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "FETCH ALL FROM c;",
+					Expected: []sql.Row{{1}, {2}},
+				},
+				{
+					Query:    "DECLARE e CURSOR FOR SELECT pk FROM test WHERE pk > 10;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "FETCH ALL FROM e;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "COMMIT;",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "cursor options and lifetimes",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY);",
+				"INSERT INTO test VALUES (1), (2), (3), (4), (5);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "DECLARE c CURSOR FOR SELECT pk FROM test;",
+					ExpectedErr: "DECLARE CURSOR can only be used in transaction blocks",
+				},
+				{
+					Query:       "DECLARE c BINARY CURSOR WITH HOLD FOR SELECT pk FROM test;",
+					ExpectedErr: "binary cursors are not yet supported",
+				},
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DECLARE c NO SCROLL CURSOR FOR SELECT pk FROM test ORDER BY pk;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "DECLARE c CURSOR FOR SELECT 1;",
+					ExpectedErr: `cursor "c" already exists`,
+				},
+				{
+					Query:    "FETCH 2 FROM c;",
+					Expected: []sql.Row{{1}, {2}},
+				},
+				{
+					Query:       "FETCH PRIOR FROM c;",
+					ExpectedErr: "cursor can only scan forward",
+				},
+				{
+					Query:       "FETCH FIRST FROM c;",
+					ExpectedErr: "cursor can only scan forward",
+				},
+				{
+					Query:    "FETCH FORWARD 1 FROM c;",
+					Expected: []sql.Row{{3}},
+				},
+				{
+					Query:    "DECLARE h CURSOR WITH HOLD FOR SELECT pk FROM test ORDER BY pk DESC;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT name, is_holdable, is_scrollable FROM pg_cursors ORDER BY name;",
+					Expected: []sql.Row{{"c", "f", "f"}, {"h", "t", "t"}},
+				},
+				{
+					Query:    "COMMIT;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "FETCH c;",
+					ExpectedErr: `cursor "c" does not exist`,
+				},
+				{
+					Query:    "FETCH 2 FROM h;",
+					Expected: []sql.Row{{5}, {4}},
+				},
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DECLARE r CURSOR WITH HOLD FOR SELECT 1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ROLLBACK;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "FETCH r;",
+					ExpectedErr: `cursor "r" does not exist`,
+				},
+				{
+					Query:    "FETCH h;",
+					Expected: []sql.Row{{3}},
+				},
+				{
+					Query:    "DECLARE o CURSOR WITH HOLD FOR SELECT pk FROM test ORDER BY pk;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "FETCH o;",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:       "CLOSE ALL;",
+					ExpectedTag: "CLOSE CURSOR ALL",
+				},
+				{
+					Query:       "FETCH h;",
+					ExpectedErr: `cursor "h" does not exist`,
+				},
+				{
+					Query:       "CLOSE o;",
+					ExpectedErr: `cursor "o" does not exist`,
+				},
+			},
+		},
+	})
+}
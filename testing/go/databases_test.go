@@ -0,0 +1,433 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateDatabaseOptions(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "TEMPLATE",
+			SetUpScript: []string{
+				"CREATE DATABASE tmpl;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE DATABASE fromdefault WITH TEMPLATE = template0 ENCODING = 'UTF8';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "CREATE DATABASE missing TEMPLATE nosuchdb;",
+					ExpectedErr: `template database "nosuchdb" does not exist`,
+				},
+				{
+					Query:       "CREATE DATABASE tmpl TEMPLATE template1;",
+					ExpectedErr: `database "tmpl" already exists`,
+				},
+			},
+		},
+		{
+			Name: "ENCODING",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE DATABASE utf8db ENCODING 'utf-8';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE DATABASE unicodedb ENCODING UNICODE;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "CREATE DATABASE latin1db ENCODING 'LATIN1';",
+					ExpectedErr: `encoding "LATIN1" is not supported`,
+				},
+				{
+					Query:       "CREATE DATABASE latin1db ENCODING 'iso-8859-1';",
+					ExpectedErr: `encoding "LATIN1" is not supported`,
+				},
+				{
+					Query:       "CREATE DATABASE baddb ENCODING 'nonsense';",
+					ExpectedErr: "nonsense is not a valid encoding name",
+				},
+			},
+		},
+		{
+			Name: "OWNER",
+			SetUpScript: []string{
+				"CREATE USER alice PASSWORD 'password' CREATEDB;",
+				"CREATE USER bob PASSWORD 'password';",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE DATABASE bobdb OWNER bob;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "DROP ROLE bob;",
+					ExpectedErr: `role "bob" cannot be dropped because some objects depend on it`,
+				},
+				{
+					Query:       "CREATE DATABASE nobodydb OWNER nobody;",
+					ExpectedErr: `role "nobody" does not exist`,
+				},
+				{
+					Query:       "CREATE DATABASE alicedb OWNER bob;",
+					Username:    "alice",
+					ExpectedErr: `must be able to SET ROLE "bob"`,
+				},
+				{
+					Query:    "CREATE DATABASE alicedb OWNER = CURRENT_USER;",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "DROP DATABASE bobdb;",
+					Username:    "alice",
+					ExpectedErr: "must be owner of database bobdb",
+				},
+				{
+					Query:       "CREATE DATABASE copied TEMPLATE bobdb;",
+					Username:    "alice",
+					ExpectedErr: `permission denied to copy database "bobdb"`,
+				},
+				{
+					Query:    "DROP DATABASE bobdb;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DROP ROLE bob;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DROP DATABASE alicedb;",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "DROP DATABASE",
+			SetUpScript: []string{
+				"CREATE DATABASE dropped;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "DROP DATABASE postgres;",
+					ExpectedErr: "cannot drop the currently open database",
+				},
+				{
+					Query:       "DROP DATABASE nosuchdb;",
+					ExpectedErr: `database "nosuchdb" does not exist`,
+				},
+				{
+					Query:    "DROP DATABASE IF EXISTS nosuchdb;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DROP DATABASE dropped WITH (FORCE);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT * FROM dropped.public.t;",
+					ExpectedErr: "database not found",
+				},
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "CREATE DATABASE inblock;",
+					ExpectedErr: "CREATE DATABASE cannot run inside a transaction block",
+				},
+				{
+					Query:    "ROLLBACK;",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+	})
+}
+
+func TestDropDatabaseForce(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+	_, err := conn.Exec(ctx, "CREATE DATABASE shared;")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "CREATE TABLE shared.public.test (pk INT4 PRIMARY KEY);")
+	require.NoError(t, err)
+	sharedConfig := conn.Config().Copy()
+	sharedConfig.Database = "shared"
+	first, err := pgx.ConnectConfig(ctx, sharedConfig)
+	require.NoError(t, err)
+	defer first.Close(ctx)
+	second, err := pgx.ConnectConfig(ctx, sharedConfig)
+	require.NoError(t, err)
+	defer second.Close(ctx)
+
+	// The database may not be used as a template, or dropped, while other sessions are connected to it
+	_, err = conn.Exec(ctx, "CREATE DATABASE copied TEMPLATE shared;")
+	requireLockError(t, err, "55006", `source database "shared" is being accessed by other users`)
+	_, err = conn.Exec(ctx, "DROP DATABASE shared;")
+	requireLockError(t, err, "55006", `database "shared" is being accessed by other users`)
+	_, err = first.Exec(ctx, "DROP DATABASE shared;")
+	requireLockError(t, err, "55006", "cannot drop the currently open database")
+
+	// FORCE terminates the other sessions, which are told why once they next talk to the server
+	_, err = second.Exec(ctx, "BEGIN;")
+	require.NoError(t, err)
+	_, err = second.Exec(ctx, "INSERT INTO test VALUES (1);")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "DROP DATABASE shared WITH (FORCE);")
+	require.NoError(t, err)
+	for i, terminated := range []*pgx.Conn{first, second} {
+		_, err = terminated.Exec(ctx, "SELECT 1;")
+		require.Error(t, err, fmt.Sprintf("session %d was not terminated", i+1))
+	}
+	_, err = conn.Exec(ctx, "CREATE DATABASE shared;")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "SELECT * FROM shared.public.test;")
+	assert.Error(t, err)
+}
+
+func TestCreateDatabaseTemplate(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+	_, err := conn.Exec(ctx, "CREATE DATABASE tmpl;")
+	require.NoError(t, err)
+	connectTo := func(database string) *pgx.Conn {
+		config := conn.Config().Copy()
+		config.Database = database
+		dbConn, err := pgx.ConnectConfig(ctx, config)
+		require.NoError(t, err)
+		return dbConn
+	}
+	tmpl := connectTo("tmpl")
+	for _, query := range []string{
+		"CREATE TABLE items (pk INT4 PRIMARY KEY, name TEXT);",
+		"INSERT INTO items VALUES (1, 'one'), (2, 'two');",
+		"CREATE SEQUENCE seq START 10;",
+		"CREATE SCHEMA other;",
+		"CREATE TABLE other.things (id INT4);",
+	} {
+		_, err = tmpl.Exec(ctx, query)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tmpl.Close(ctx))
+
+	_, err = conn.Exec(ctx, "CREATE DATABASE copied TEMPLATE tmpl;")
+	require.NoError(t, err)
+	copied := connectTo("copied")
+	defer copied.Close(ctx)
+	var count int64
+	require.NoError(t, copied.QueryRow(ctx, "SELECT count(*) FROM items;").Scan(&count))
+	assert.Equal(t, int64(2), count)
+	require.NoError(t, copied.QueryRow(ctx, "SELECT nextval('seq');").Scan(&count))
+	assert.Equal(t, int64(10), count)
+	require.NoError(t, copied.QueryRow(ctx, "SELECT count(*) FROM other.things;").Scan(&count))
+	assert.Equal(t, int64(0), count)
+
+	// The copy is independent of the template
+	_, err = copied.Exec(ctx, "INSERT INTO items VALUES (3, 'three');")
+	require.NoError(t, err)
+	tmpl = connectTo("tmpl")
+	defer tmpl.Close(ctx)
+	require.NoError(t, tmpl.QueryRow(ctx, "SELECT count(*) FROM items;").Scan(&count))
+	assert.Equal(t, int64(2), count)
+}
+
+func TestRevisionDatabases(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "mydb")
+	defer func() {
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+	for _, query := range []string{
+		"CREATE TABLE tbl (pk INT4 PRIMARY KEY, v TEXT);",
+		"INSERT INTO tbl VALUES (1, 'main');",
+		"SELECT dolt_commit('-Am', 'initial');",
+		"SELECT dolt_tag('v1');",
+		"SELECT dolt_branch('feature-branch');",
+		"SELECT dolt_checkout('feature-branch');",
+		"INSERT INTO tbl VALUES (2, 'feature');",
+		"SELECT dolt_commit('-am', 'feature');",
+		"SELECT dolt_checkout('main');",
+		"CREATE ROLE reader LOGIN PASSWORD 'password';",
+		"GRANT CONNECT ON DATABASE mydb TO reader;",
+		"GRANT SELECT ON tbl TO reader;",
+	} {
+		_, err := conn.Exec(ctx, query)
+		require.NoError(t, err)
+	}
+	var initialCommit string
+	require.NoError(t, conn.QueryRow(ctx, "SELECT commit_hash FROM dolt_log WHERE message = 'initial';").Scan(&initialCommit))
+	connectTo := func(database string, user string) (*pgx.Conn, error) {
+		config := conn.Config().Copy()
+		config.Database = database
+		config.User = user
+		return pgx.ConnectConfig(ctx, config)
+	}
+
+	// Other revisions may be referenced by qualifying their tables with the revision database
+	runScript(t, ctx, ScriptTest{
+		Name: "qualified revision databases",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    `SELECT * FROM "mydb/feature-branch".public.tbl ORDER BY pk;`,
+				Expected: []sql.Row{{1, "main"}, {2, "feature"}},
+			},
+			{
+				Query:    `INSERT INTO "mydb/feature-branch".public.tbl VALUES (3, 'qualified');`,
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT * FROM tbl;",
+				Expected: []sql.Row{{1, "main"}},
+			},
+			{
+				Query:    fmt.Sprintf(`SELECT * FROM "mydb/%s".public.tbl;`, initialCommit),
+				Expected: []sql.Row{{1, "main"}},
+			},
+			{
+				Query:    `SELECT * FROM "mydb/v1".public.tbl;`,
+				Expected: []sql.Row{{1, "main"}},
+			},
+		},
+	}, conn, true)
+
+	// Connections to a branch read and write that branch
+	branchConn, err := connectTo("mydb/feature-branch", "postgres")
+	require.NoError(t, err)
+	defer branchConn.Close(ctx)
+	runScript(t, ctx, ScriptTest{
+		Name: "branch connection",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT active_branch();",
+				Expected: []sql.Row{{"feature-branch"}},
+			},
+			{
+				Query:    "INSERT INTO tbl VALUES (4, 'branch connection');",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT * FROM tbl ORDER BY pk;",
+				Expected: []sql.Row{{1, "main"}, {2, "feature"}, {3, "qualified"}, {4, "branch connection"}},
+			},
+			{
+				Query:    "SELECT relname FROM pg_class WHERE relname = 'tbl';",
+				Expected: []sql.Row{{"tbl"}},
+			},
+		},
+	}, branchConn, true)
+
+	// Connections to a commit are read-only
+	commitConn, err := connectTo("mydb/"+initialCommit, "postgres")
+	require.NoError(t, err)
+	defer commitConn.Close(ctx)
+	runScript(t, ctx, ScriptTest{
+		Name: "commit connection",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM tbl;",
+				Expected: []sql.Row{{1, "main"}},
+			},
+			{
+				Query:    "SELECT relname FROM pg_class WHERE relname = 'tbl';",
+				Expected: []sql.Row{{"tbl"}},
+			},
+			{
+				Query:       "INSERT INTO tbl VALUES (5, 'commit');",
+				ExpectedErr: "read-only",
+			},
+			{
+				Query:    "SELECT * FROM tbl;",
+				Expected: []sql.Row{{1, "main"}},
+			},
+		},
+	}, commitConn, true)
+
+	// Connections to a tag are read-only
+	tagConn, err := connectTo("mydb/v1", "postgres")
+	require.NoError(t, err)
+	defer tagConn.Close(ctx)
+	runScript(t, ctx, ScriptTest{
+		Name: "tag connection",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM tbl;",
+				Expected: []sql.Row{{1, "main"}},
+			},
+			{
+				Query:    "SELECT active_branch();",
+				Expected: []sql.Row{{nil}},
+			},
+			{
+				Query:       "INSERT INTO tbl VALUES (5, 'tag');",
+				ExpectedErr: "read-only",
+			},
+		},
+	}, tagConn, true)
+
+	// Privileges on a database apply to all of its revisions
+	readerConn, err := connectTo("mydb/feature-branch", "reader")
+	require.NoError(t, err)
+	defer readerConn.Close(ctx)
+	runScript(t, ctx, ScriptTest{
+		Name: "revision privileges",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT count(*) FROM tbl;",
+				Expected: []sql.Row{{4}},
+			},
+			{
+				Query:       "INSERT INTO tbl VALUES (6, 'reader');",
+				ExpectedErr: "permission denied for table tbl",
+			},
+		},
+	}, readerConn, true)
+
+	_, err = connectTo("mydb/missing", "postgres")
+	require.ErrorContains(t, err, `database "mydb/missing" does not exist`)
+
+	// Sessions that are connected to a revision are connected to its database
+	otherConn, err := connectTo("doltgres", "postgres")
+	require.NoError(t, err)
+	defer otherConn.Close(ctx)
+	require.NoError(t, conn.Close(ctx))
+	require.NoError(t, readerConn.Close(ctx))
+	require.NoError(t, commitConn.Close(ctx))
+	require.NoError(t, tagConn.Close(ctx))
+	_, err = otherConn.Exec(ctx, "DROP DATABASE mydb;")
+	requireLockError(t, err, "55006", `database "mydb" is being accessed by other users`)
+	require.NoError(t, branchConn.Close(ctx))
+	_, err = otherConn.Exec(ctx, "DROP DATABASE mydb;")
+	require.NoError(t, err)
+}
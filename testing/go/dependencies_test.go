@@ -0,0 +1,293 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestDropDependencies(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "DROP SCHEMA",
+			SetUpScript: []string{
+				"CREATE SCHEMA empty;",
+				"CREATE SCHEMA s;",
+				"CREATE TABLE s.t (pk INT4 PRIMARY KEY, v1 TEXT);",
+				"CREATE SEQUENCE s.seq;",
+				"INSERT INTO s.t VALUES (1, 'one');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "DROP SCHEMA empty;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "CREATE TABLE empty.t (pk INT4);",
+					ExpectedErr: "empty",
+				},
+				{
+					Query:       "DROP SCHEMA s;",
+					ExpectedErr: "cannot drop schema s because other objects depend on it",
+				},
+				{
+					Query:       "DROP SCHEMA s RESTRICT;",
+					ExpectedErr: "cannot drop schema s because other objects depend on it",
+				},
+				{
+					Query:    "SELECT * FROM s.t;",
+					Expected: []sql.Row{{1, "one"}},
+				},
+				{
+					Query:       "DROP SCHEMA missing;",
+					ExpectedErr: `schema "missing" does not exist`,
+				},
+				{
+					Query:    "DROP SCHEMA IF EXISTS missing;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "DROP SCHEMA pg_catalog;",
+					ExpectedErr: "cannot drop schema pg_catalog because it is required by the database system",
+				},
+				{
+					Query:    "DROP SCHEMA s CASCADE;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT * FROM s.t;",
+					ExpectedErr: "not found",
+				},
+				{
+					Query:    "CREATE SCHEMA s;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE SEQUENCE s.seq;",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "DROP SCHEMA with dependents in other schemas",
+			SetUpScript: []string{
+				"CREATE SCHEMA s;",
+				"CREATE SEQUENCE s.ids;",
+				"CREATE TABLE uses_ids (pk INT8 PRIMARY KEY DEFAULT (nextval('s.ids')), v1 INT4);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "DROP SCHEMA s;",
+					ExpectedErr: "cannot drop schema s because other objects depend on it",
+				},
+				{
+					Query:    "DROP SCHEMA s CASCADE;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "INSERT INTO uses_ids (v1) VALUES (1);",
+					ExpectedErr: "doesn't have a default value",
+				},
+				{
+					Query:    "INSERT INTO uses_ids VALUES (1, 1);",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "DROP TABLE",
+			SetUpScript: []string{
+				"CREATE TABLE parent (pk INT4 PRIMARY KEY);",
+				"CREATE TABLE child (pk INT4 PRIMARY KEY, parent_pk INT4, CONSTRAINT child_fk FOREIGN KEY (parent_pk) REFERENCES parent (pk));",
+				"CREATE TABLE viewed (pk INT4 PRIMARY KEY, v1 TEXT);",
+				"CREATE VIEW v AS SELECT v1 FROM viewed;",
+				"CREATE VIEW vv AS SELECT * FROM v;",
+				"INSERT INTO viewed VALUES (1, 'one');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "DROP TABLE parent;",
+					ExpectedErr: "cannot drop table parent because other objects depend on it",
+				},
+				{
+					Query:       "DROP TABLE viewed RESTRICT;",
+					ExpectedErr: "cannot drop table viewed because other objects depend on it",
+				},
+				{
+					Query:       "DROP TABLE parent, viewed;",
+					ExpectedErr: "cannot drop desired object(s) because other objects depend on them",
+				},
+				{
+					Query:    "SELECT * FROM vv;",
+					Expected: []sql.Row{{"one"}},
+				},
+				{
+					Query:    "DROP TABLE parent, child;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DROP TABLE viewed CASCADE;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT * FROM v;",
+					ExpectedErr: "not found",
+				},
+				{
+					Query:       "SELECT * FROM vv;",
+					ExpectedErr: "not found",
+				},
+				{
+					Query:    "DROP TABLE IF EXISTS missing CASCADE;",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "DROP TABLE CASCADE with foreign keys",
+			SetUpScript: []string{
+				"CREATE TABLE parent (pk INT4 PRIMARY KEY);",
+				"CREATE TABLE child (pk INT4 PRIMARY KEY, parent_pk INT4, CONSTRAINT child_fk FOREIGN KEY (parent_pk) REFERENCES parent (pk));",
+				"INSERT INTO parent VALUES (1);",
+				"INSERT INTO child VALUES (1, 1);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "INSERT INTO child VALUES (2, 2);",
+					ExpectedErr: "violates foreign key constraint",
+				},
+				{
+					Query:    "DROP TABLE parent CASCADE;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO child VALUES (2, 2);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM child ORDER BY pk;",
+					Expected: []sql.Row{{1, 1}, {2, 2}},
+				},
+			},
+		},
+		{
+			Name: "DROP VIEW",
+			SetUpScript: []string{
+				"CREATE TABLE t (pk INT4 PRIMARY KEY);",
+				"CREATE VIEW v AS SELECT pk FROM t;",
+				"CREATE VIEW vv AS SELECT pk FROM v;",
+				"INSERT INTO t VALUES (1);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "DROP VIEW v;",
+					ExpectedErr: "cannot drop view v because other objects depend on it",
+				},
+				{
+					Query:    "DROP VIEW v, vv;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE VIEW v AS SELECT pk FROM t;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE VIEW vv AS SELECT pk FROM v;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DROP VIEW v CASCADE;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT * FROM vv;",
+					ExpectedErr: "not found",
+				},
+				{
+					Query:    "SELECT * FROM t;",
+					Expected: []sql.Row{{1}},
+				},
+			},
+		},
+		{
+			Name: "DROP SEQUENCE",
+			SetUpScript: []string{
+				"CREATE SEQUENCE used;",
+				"CREATE SEQUENCE owned;",
+				"CREATE TABLE t (pk INT8 PRIMARY KEY DEFAULT (nextval('used')), v1 INT8);",
+				"ALTER SEQUENCE owned OWNED BY t.v1;",
+				"CREATE TABLE serials (pk SERIAL PRIMARY KEY, v1 INT4);",
+				"CREATE TABLE identities (pk INT4 GENERATED ALWAYS AS IDENTITY PRIMARY KEY, v1 INT4);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "DROP SEQUENCE used;",
+					ExpectedErr: "cannot drop sequence used because other objects depend on it",
+				},
+				{
+					Query:       "DROP SEQUENCE serials_pk_seq;",
+					ExpectedErr: "cannot drop sequence serials_pk_seq because other objects depend on it",
+				},
+				{
+					Query:       "DROP SEQUENCE identities_pk_seq;",
+					ExpectedErr: "cannot drop sequence identities_pk_seq because column pk of table identities requires it",
+				},
+				{
+					Query:    "DROP SEQUENCE owned;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DROP SEQUENCE used CASCADE;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "INSERT INTO t (v1) VALUES (1);",
+					ExpectedErr: "doesn't have a default value",
+				},
+				{
+					Query:    "INSERT INTO t VALUES (1, 1);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DROP SEQUENCE serials_pk_seq CASCADE;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO serials VALUES (1, 1);",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "DROP TABLE drops owned sequences",
+			SetUpScript: []string{
+				"CREATE TABLE serials (pk SERIAL PRIMARY KEY, v1 INT4);",
+				"INSERT INTO serials (v1) VALUES (1);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "DROP TABLE serials;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT nextval('serials_pk_seq');",
+					ExpectedErr: "does not exist",
+				},
+			},
+		},
+	})
+}
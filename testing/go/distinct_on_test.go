@@ -0,0 +1,75 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestDistinctOn(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "DISTINCT ON",
+			SetUpScript: []string{
+				"CREATE TABLE orders (id INT8 PRIMARY KEY, customer TEXT, amount INT4);",
+				"INSERT INTO orders VALUES (1, 'alice', 10), (2, 'alice', 30), (3, 'bob', 5), (4, 'bob', 7), (5, 'carol', 1);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT DISTINCT ON (customer) customer, id, amount FROM orders ORDER BY customer, amount DESC;",
+					Expected: []sql.Row{{"alice", 2, 30}, {"bob", 4, 7}, {"carol", 5, 1}},
+				},
+				{
+					Query:    "SELECT DISTINCT ON (customer) id FROM orders ORDER BY customer, id DESC LIMIT 2;",
+					Expected: []sql.Row{{2}, {4}},
+				},
+				{
+					Query:    "SELECT DISTINCT ON (customer) id FROM orders ORDER BY customer, id LIMIT 2 OFFSET 1;",
+					Expected: []sql.Row{{3}, {5}},
+				},
+				{
+					Query:    "SELECT DISTINCT ON (1) customer, id FROM orders ORDER BY 1, 2 DESC;",
+					Expected: []sql.Row{{"alice", 2}, {"bob", 4}, {"carol", 5}},
+				},
+				{
+					Query:    "SELECT DISTINCT ON (customer, amount > 6) customer, id FROM orders ORDER BY customer, amount > 6, id;",
+					Expected: []sql.Row{{"alice", 1}, {"bob", 3}, {"bob", 4}, {"carol", 5}},
+				},
+				{
+					Query:    "SELECT DISTINCT ON (customer) customer FROM orders ORDER BY customer;",
+					Expected: []sql.Row{{"alice"}, {"bob"}, {"carol"}},
+				},
+				{
+					Query:    "SELECT DISTINCT ON (customer) customer AS c, amount * 2 AS doubled FROM orders ORDER BY customer, amount DESC;",
+					Expected: []sql.Row{{"alice", 60}, {"bob", 14}, {"carol", 2}},
+				},
+				{
+					Query:    "SELECT * FROM (SELECT DISTINCT ON (customer) * FROM orders ORDER BY customer, amount) sq ORDER BY id;",
+					Expected: []sql.Row{{1, "alice", 10}, {3, "bob", 5}, {5, "carol", 1}},
+				},
+				{
+					Query:    "WITH latest AS (SELECT DISTINCT ON (customer) customer, amount FROM orders ORDER BY customer, id DESC) SELECT * FROM latest ORDER BY amount;",
+					Expected: []sql.Row{{"carol", 1}, {"bob", 7}, {"alice", 30}},
+				},
+				{
+					Query:       "SELECT DISTINCT ON (customer) id FROM orders ORDER BY id;",
+					ExpectedErr: "SELECT DISTINCT ON expressions must match initial ORDER BY expressions",
+				},
+			},
+		},
+	})
+}
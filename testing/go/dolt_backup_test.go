@@ -0,0 +1,100 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+func TestDoltBackup(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no destination", func(t *testing.T) {
+		srv := StartServer(t, nil)
+		ExecQueries(t, Connect(t, srv, ""), "CREATE DATABASE nobackup;")
+		_, err := Connect(t, srv, "nobackup").Exec(ctx, "SELECT dolt_backup('sync');")
+		var pgErr *pgconn.PgError
+		require.True(t, errors.As(err, &pgErr), "%v", err)
+		assert.Equal(t, "55000", pgErr.Code)
+		assert.Equal(t, "no backup destination has been configured", pgErr.Message)
+	})
+
+	t.Run("dolt_backup sync", func(t *testing.T) {
+		destination := t.TempDir()
+		srv := StartServer(t, &servercfg.DoltgresConfig{
+			Backup: &servercfg.DoltgresBackupConfig{Destination: ptr(destination)},
+		})
+		ExecQueries(t, Connect(t, srv, ""), "CREATE DATABASE backupdb;")
+		conn := Connect(t, srv, "backupdb")
+		ExecQueries(t, conn,
+			"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 TEXT);",
+			"INSERT INTO test VALUES (1, 'one');",
+			"SELECT dolt_commit('-Am', 'initial');",
+		)
+		assert.Equal(t, []any{int64(0)}, QueryRow(t, conn, "SELECT dolt_backup('sync');"))
+		ExecQueries(t, conn,
+			"INSERT INTO test VALUES (2, 'two');",
+			"SELECT dolt_commit('-am', 'second');",
+		)
+		assert.Equal(t, []any{int64(0)}, QueryRow(t, conn, "CALL dolt_backup('sync');"))
+
+		// The backup holds every commit, so a database may be restored from it
+		backupUrl := "file://" + filepath.ToSlash(filepath.Join(destination, "backupdb"))
+		assert.Equal(t, []any{int64(0)}, QueryRow(t, conn, fmt.Sprintf("SELECT dolt_clone('%s', 'restoreddb');", backupUrl)))
+		restored := Connect(t, srv, "restoreddb")
+		assert.Equal(t, []any{int64(2)}, QueryRow(t, restored, "SELECT count(*) FROM test;"))
+		assert.Equal(t, []any{"second"}, QueryRow(t, restored, "SELECT message FROM dolt_log LIMIT 1;"))
+
+		// Backups may still be synced to any other location
+		otherDir := filepath.Join(t.TempDir(), "other")
+		require.NoError(t, os.MkdirAll(otherDir, 0755))
+		assert.Equal(t, []any{int64(0)}, QueryRow(t, conn, fmt.Sprintf("SELECT dolt_backup('sync-url', 'file://%s');", filepath.ToSlash(otherDir))))
+	})
+
+	t.Run("scheduled backups", func(t *testing.T) {
+		destination := t.TempDir()
+		srv := StartServer(t, &servercfg.DoltgresConfig{
+			Backup: &servercfg.DoltgresBackupConfig{
+				Destination: ptr(filepath.Join(destination, "{database}-backup")),
+				Interval:    ptr("50ms"),
+				Databases:   []string{"scheduled*"},
+			},
+		})
+		ExecQueries(t, Connect(t, srv, ""), "CREATE DATABASE scheduleddb;", "CREATE DATABASE skippeddb;")
+		ExecQueries(t, Connect(t, srv, "scheduleddb"),
+			"CREATE TABLE test (pk INT4 PRIMARY KEY);",
+			"SELECT dolt_commit('-Am', 'initial');",
+		)
+		require.Eventually(t, func() bool {
+			_, err := os.Stat(filepath.Join(destination, "scheduleddb-backup", "manifest"))
+			return err == nil
+		}, 10*time.Second, 50*time.Millisecond)
+		time.Sleep(200 * time.Millisecond)
+		_, err := os.Stat(filepath.Join(destination, "skippeddb-backup"))
+		assert.True(t, os.IsNotExist(err), "%v", err)
+	})
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestDoltBlame(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "dolt_blame_<table>",
+			SetUpScript: []string{
+				"CREATE TABLE test (a INT4, b INT4, v1 INT4, PRIMARY KEY (a, b));",
+				"CREATE TABLE keyless (v1 INT4);",
+				"INSERT INTO test VALUES (1, 1, 1), (1, 2, 2), (2, 1, 3);",
+				"SELECT dolt_commit('-Am', 'initial');",
+				"DELETE FROM test WHERE a = 2;",
+				"UPDATE test SET v1 = 20 WHERE b = 2;",
+				"SELECT dolt_commit('-am', 'update', '--author', 'Jane Doe <jane@example.com>');",
+				"INSERT INTO test VALUES (3, 3, 4);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT a, b, message, committer, email FROM dolt_blame_test;",
+					Expected: []sql.Row{{1, 1, "initial", "postgres", "postgres@127.0.0.1"}, {1, 2, "update", "Jane Doe", "jane@example.com"}},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_blame_test bt JOIN dolt_log dl ON bt.commit = dl.commit_hash AND bt.commit_date = dl.date;",
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:    "SELECT a, b FROM dolt_blame_test WHERE message = 'update';",
+					Expected: []sql.Row{{1, 2}},
+				},
+				{
+					Query:            "SELECT dolt_commit('-am', 'add three');",
+					SkipResultsCheck: true,
+				},
+				{
+					Query:    "SELECT a, b, message FROM dolt_blame_test ORDER BY a, b;",
+					Expected: []sql.Row{{1, 1, "initial"}, {1, 2, "update"}, {3, 3, "add three"}},
+				},
+				{
+					Query:       "SELECT * FROM dolt_blame_keyless;",
+					ExpectedErr: "unable to generate blame view for table without primary key",
+				},
+				{
+					Query:       "SELECT * FROM dolt_blame_missing;",
+					ExpectedErr: "table not found",
+				},
+			},
+		},
+	})
+}
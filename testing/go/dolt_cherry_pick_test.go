@@ -0,0 +1,186 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestDoltCherryPickAndRevert(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "dolt_cherry_pick",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 TEXT);",
+				"INSERT INTO test VALUES (1, 'one');",
+				"SELECT dolt_commit('-Am', 'initial');",
+				"SELECT dolt_checkout('-b', 'hotfix');",
+				"INSERT INTO test VALUES (2, 'two');",
+				"SELECT dolt_commit('-am', 'add two');",
+				"INSERT INTO test VALUES (3, 'three');",
+				"SELECT dolt_commit('-am', 'add three');",
+				"SELECT dolt_checkout('main');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					// The hash of the new commit is followed by the number of data conflicts, schema conflicts, and
+					// constraint violations
+					Query:    "SELECT (dolt_cherry_pick('hotfix~1'))[2];",
+					Expected: []sql.Row{{"0"}},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, "one"}, {2, "two"}},
+				},
+				{
+					Query:    "SELECT message FROM dolt_log LIMIT 1;",
+					Expected: []sql.Row{{"add two"}},
+				},
+				{
+					Query:    "SELECT length((dolt_cherry_pick('hotfix'))[1]);",
+					Expected: []sql.Row{{32}},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, "one"}, {2, "two"}, {3, "three"}},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_status;",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:       "SELECT dolt_cherry_pick('missing');",
+					ExpectedErr: "branch not found",
+				},
+				{
+					Query:       "SELECT dolt_cherry_pick('--abort');",
+					ExpectedErr: "no cherry-pick merge to abort",
+				},
+			},
+		},
+		{
+			Name: "dolt_cherry_pick conflicts",
+			SetUpScript: []string{
+				"CREATE TABLE test (id INT4, v1 TEXT);",
+				"INSERT INTO test VALUES (1, 'one');",
+				"SELECT dolt_commit('-Am', 'initial');",
+				"SELECT dolt_checkout('-b', 'hotfix');",
+				"DELETE FROM test;",
+				"SELECT dolt_commit('-am', 'hotfix');",
+				"SELECT dolt_checkout('main');",
+				"INSERT INTO test VALUES (1, 'one');",
+				"SELECT dolt_commit('-am', 'main');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "SELECT dolt_cherry_pick('hotfix');",
+					ExpectedErr: "Merge conflict detected",
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_conflicts;",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT (dolt_cherry_pick('hotfix'))[2];",
+					Expected: []sql.Row{{"1"}},
+				},
+				{
+					Query:    "SELECT * FROM dolt_conflicts;",
+					Expected: []sql.Row{{"test", Numeric("1")}},
+				},
+				{
+					Query:    "SELECT (dolt_cherry_pick('--abort'))[2];",
+					Expected: []sql.Row{{"0"}},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_conflicts;",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT (dolt_cherry_pick('hotfix'))[2];",
+					Expected: []sql.Row{{"1"}},
+				},
+				{
+					Query:    "SELECT dolt_conflicts_resolve('--theirs', 'test');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT * FROM test;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT length(dolt_commit('-am', 'cherry-picked hotfix'));",
+					Expected: []sql.Row{{32}},
+				},
+				{
+					Query:    "COMMIT;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT message FROM dolt_log LIMIT 1;",
+					Expected: []sql.Row{{"cherry-picked hotfix"}},
+				},
+			},
+		},
+		{
+			Name: "dolt_revert",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 TEXT);",
+				"INSERT INTO test VALUES (1, 'one');",
+				"SELECT dolt_commit('-Am', 'initial');",
+				"INSERT INTO test VALUES (2, 'two');",
+				"SELECT dolt_commit('-am', 'add two');",
+				"UPDATE test SET v1 = 'uno' WHERE pk = 1;",
+				"SELECT dolt_commit('-am', 'update one');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT dolt_revert('HEAD~1');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, "uno"}},
+				},
+				{
+					Query:    `SELECT message FROM dolt_log LIMIT 1;`,
+					Expected: []sql.Row{{`Revert "add two"`}},
+				},
+				{
+					Query:    "SELECT dolt_revert('HEAD');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, "uno"}, {2, "two"}},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_status;",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:       "SELECT dolt_revert('missing');",
+					ExpectedErr: "branch not found",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,173 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestDoltDiff(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "dolt_diff table function",
+			SetUpScript: []string{
+				"CREATE TABLE orders (pk INT4 PRIMARY KEY, v TEXT, n NUMERIC(5,2));",
+				"INSERT INTO orders VALUES (1, 'one', 1.5), (2, 'two', 2);",
+				"SELECT dolt_commit('-Am', 'initial');",
+				"SELECT dolt_checkout('-b', 'feature');",
+				"UPDATE orders SET v = 'uno' WHERE pk = 1;",
+				"DELETE FROM orders WHERE pk = 2;",
+				"INSERT INTO orders VALUES (3, 'three', 3);",
+				"SELECT dolt_commit('-am', 'feature');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT to_pk, to_v, to_n, from_pk, from_v, from_n, diff_type FROM dolt_diff('main', 'feature', 'orders') ORDER BY coalesce(to_pk, from_pk);",
+					Expected: []sql.Row{
+						{1, "uno", Numeric("1.50"), 1, "one", Numeric("1.50"), "modified"},
+						{nil, nil, nil, 2, "two", Numeric("2.00"), "removed"},
+						{3, "three", Numeric("3.00"), nil, nil, nil, "added"},
+					},
+				},
+				{
+					Query:    "SELECT to_commit, from_commit FROM dolt_diff('main', 'feature', 'orders') LIMIT 1;",
+					Expected: []sql.Row{{"feature", "main"}},
+				},
+				{
+					Query: "SELECT to_pk, from_pk, diff_type FROM dolt_diff('feature', 'main', 'orders') ORDER BY coalesce(to_pk, from_pk);",
+					Expected: []sql.Row{
+						{1, 1, "modified"},
+						{2, nil, "added"},
+						{nil, 3, "removed"},
+					},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_diff('main', 'main', 'orders');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT * FROM dolt_diff_summary('main', 'feature');",
+					Expected: []sql.Row{{"orders", "orders", "modified", 1, 0}},
+				},
+				{
+					Query:       "SELECT * FROM dolt_diff('main', 'feature', 'missing');",
+					ExpectedErr: "not found",
+				},
+			},
+		},
+		{
+			Name: "dolt_diff system tables",
+			SetUpScript: []string{
+				"CREATE TABLE orders (pk INT4 PRIMARY KEY, v TEXT);",
+				"INSERT INTO orders VALUES (1, 'one'), (2, 'two');",
+				"SELECT dolt_commit('-Am', 'initial');",
+				"UPDATE orders SET v = 'uno' WHERE pk = 1;",
+				"SELECT dolt_commit('-am', 'update');",
+				"INSERT INTO orders VALUES (3, 'three');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT to_pk, to_v, from_pk, from_v, diff_type FROM dolt_diff_orders WHERE to_commit = 'WORKING';",
+					Expected: []sql.Row{
+						{3, "three", nil, nil, "added"},
+					},
+				},
+				{
+					Query: "SELECT to_pk, to_v, from_pk, from_v, diff_type FROM dolt_diff_orders WHERE diff_type = 'modified';",
+					Expected: []sql.Row{
+						{1, "uno", 1, "one", "modified"},
+					},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_diff_orders WHERE diff_type = 'added';",
+					Expected: []sql.Row{{3}},
+				},
+				{
+					Query: "SELECT table_name, commit_hash, message, data_change, schema_change FROM dolt_diff WHERE commit_hash = 'WORKING';",
+					Expected: []sql.Row{
+						{"orders", "WORKING", nil, 1, 0},
+					},
+				},
+				{
+					Query: "SELECT table_name, message, data_change, schema_change FROM dolt_diff WHERE commit_hash <> 'WORKING' ORDER BY date DESC, message;",
+					Expected: []sql.Row{
+						{"orders", "update", 1, 0},
+						{"orders", "initial", 1, 1},
+					},
+				},
+			},
+		},
+		{
+			Name: "three-dot diffs and merge base",
+			SetUpScript: []string{
+				"CREATE TABLE t (pk INT4 PRIMARY KEY, v INT4);",
+				"INSERT INTO t VALUES (1, 1);",
+				"SELECT dolt_commit('-Am', 'initial');",
+				"SELECT dolt_branch('feature');",
+				"INSERT INTO t VALUES (2, 2);",
+				"SELECT dolt_commit('-am', 'main change');",
+				"SELECT dolt_checkout('feature');",
+				"INSERT INTO t VALUES (3, 3);",
+				"SELECT dolt_commit('-am', 'feature change');",
+				"SELECT dolt_checkout('main');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT message FROM dolt_log WHERE commit_hash = dolt_merge_base('main', 'feature');",
+					Expected: []sql.Row{{"initial"}},
+				},
+				{
+					Query:    "SELECT message FROM dolt_log WHERE commit_hash = dolt_merge_base('feature', 'main');",
+					Expected: []sql.Row{{"initial"}},
+				},
+				{
+					Query:       "SELECT dolt_merge_base('main', 'missing');",
+					ExpectedErr: "branch not found",
+				},
+				{
+					Query: "SELECT from_pk, to_pk, diff_type FROM dolt_diff('main..feature', 't') ORDER BY 1, 2;",
+					Expected: []sql.Row{
+						{nil, 3, "added"},
+						{2, nil, "removed"},
+					},
+				},
+				{
+					Query: "SELECT from_pk, to_pk, diff_type FROM dolt_diff('main...feature', 't');",
+					Expected: []sql.Row{
+						{nil, 3, "added"},
+					},
+				},
+				{
+					Query: "SELECT table_name, rows_added, rows_deleted FROM dolt_diff_stat('main...feature');",
+					Expected: []sql.Row{
+						{"t", 1, 0},
+					},
+				},
+				{
+					Query: "SELECT statement FROM dolt_patch('main...feature');",
+					Expected: []sql.Row{
+						{"INSERT INTO public.t (pk, v) VALUES (3, 3);"},
+					},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_schema_diff('main...feature');",
+					Expected: []sql.Row{{0}},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,100 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoltReflogAndGC(t *testing.T) {
+	// The reflog is read from the storage's journal, so this uses a server that is backed by a data directory rather
+	// than memory
+	srv := StartServer(t, nil)
+	var mutex sync.Mutex
+	var notices []string
+	connect := func(database string) *pgx.Conn {
+		return ConnectWithNoticeHandler(t, srv, database, func(_ *pgconn.PgConn, notice *pgconn.Notice) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			notices = append(notices, notice.Message)
+		})
+	}
+
+	ExecQueries(t, connect(""), "CREATE DATABASE gcdb;")
+	conn := connect("gcdb")
+	ExecQueries(t, conn,
+		"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 TEXT);",
+		"INSERT INTO test VALUES (1, 'one');",
+		"SELECT dolt_commit('-Am', 'initial');",
+		"SELECT dolt_checkout('-b', 'feature');",
+		"INSERT INTO test VALUES (2, 'two');",
+		"SELECT dolt_commit('-am', 'lost work');",
+		"SELECT dolt_checkout('main');",
+		"SELECT dolt_branch('-D', 'feature');",
+	)
+
+	t.Run("dolt_reflog", func(t *testing.T) {
+		assert.Equal(t, [][]any{{"refs/heads/feature", "lost work"}, {"refs/heads/feature", "initial"}},
+			QueryRows(t, conn, "SELECT ref, commit_message FROM dolt_reflog('feature');"))
+		assert.Equal(t, [][]any{{"refs/heads/main", "initial"}, {"refs/heads/main", "Initialize data repository"}},
+			QueryRows(t, conn, "SELECT ref, commit_message FROM dolt_reflog WHERE ref = 'refs/heads/main';"))
+		// A deleted branch may be recovered from the commits that the reflog recorded for it
+		lost := QueryRows(t, conn, "SELECT commit_hash FROM dolt_reflog('feature') LIMIT 1;")
+		require.Len(t, lost, 1)
+		ExecQueries(t, conn, fmt.Sprintf("SELECT dolt_branch('recovered', '%s');", lost[0][0]))
+		assert.Equal(t, [][]any{{"lost work"}}, QueryRows(t, conn, "SELECT message FROM dolt_log('recovered') LIMIT 1;"))
+	})
+
+	t.Run("dolt_gc dry run", func(t *testing.T) {
+		mutex.Lock()
+		notices = nil
+		mutex.Unlock()
+		assert.Equal(t, [][]any{{int64(0)}}, QueryRows(t, conn, "SELECT dolt_gc('--dry-run');"))
+		mutex.Lock()
+		require.Len(t, notices, 1)
+		assert.True(t, strings.HasPrefix(notices[0], "dolt_gc dry run: a full garbage collection of database gcdb would be performed, whose storage currently uses "), notices[0])
+		mutex.Unlock()
+		RequireErrorCode(t, conn, "SELECT dolt_gc('--dry-run', 'extra');", "XX000")
+	})
+
+	t.Run("dolt_gc", func(t *testing.T) {
+		ExecQueries(t, conn, "SELECT dolt_branch('-D', 'recovered');")
+		assert.Equal(t, [][]any{{int64(0)}}, QueryRows(t, conn, "SELECT dolt_gc('--shallow');"))
+		other := connect("gcdb")
+		ExecQueries(t, other, "SELECT 1;")
+		assert.Equal(t, [][]any{{int64(0)}}, QueryRows(t, conn, "CALL dolt_gc();"))
+		// The connection that collected the garbage remains usable, while other connections are closed
+		_, err := other.Exec(context.Background(), "SELECT 1;")
+		assert.Error(t, err)
+		assert.Equal(t, [][]any{{int32(1), "one"}}, QueryRows(t, conn, "SELECT * FROM test;"))
+		assert.Equal(t, [][]any{{"initial"}, {"Initialize data repository"}}, QueryRows(t, conn, "SELECT message FROM dolt_log;"))
+		ExecQueries(t, conn, "INSERT INTO test VALUES (3, 'three');", "SELECT dolt_commit('-am', 'after gc');")
+		assert.Equal(t, [][]any{{int64(0)}}, QueryRows(t, conn, "SELECT dolt_gc();"))
+		assert.Equal(t, [][]any{{"after gc"}, {"initial"}, {"Initialize data repository"}}, QueryRows(t, conn, "SELECT message FROM dolt_log;"))
+
+		ExecQueries(t, conn, "BEGIN;")
+		RequireErrorCode(t, conn, "SELECT dolt_gc();", "25001")
+		ExecQueries(t, conn, "ROLLBACK;")
+	})
+}
@@ -0,0 +1,247 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestDoltMerge(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "fast-forward merge",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 TEXT);",
+				"INSERT INTO test VALUES (1, 'one');",
+				"SELECT dolt_commit('-Am', 'initial');",
+				"SELECT dolt_checkout('-b', 'feature');",
+				"INSERT INTO test VALUES (2, 'two');",
+				"SELECT dolt_commit('-am', 'feature');",
+				"SELECT dolt_checkout('main');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, "one"}},
+				},
+				{
+					Query:    "SELECT (dolt_merge('feature'))[2:4];",
+					Skip:     true, // TODO: array slices are not yet supported
+					Expected: []sql.Row{{`{1,0,"merge successful"}`}},
+				},
+				{
+					Query:    "SELECT (dolt_merge('feature'))[4];",
+					Expected: []sql.Row{{"merge successful"}},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, "one"}, {2, "two"}},
+				},
+				{
+					Query:    "SELECT message FROM dolt_log LIMIT 1;",
+					Expected: []sql.Row{{"feature"}},
+				},
+				{
+					Query:    "SELECT (dolt_merge('feature'))[4];",
+					Expected: []sql.Row{{"Everything up-to-date"}},
+				},
+				{
+					Query:       "SELECT dolt_merge('missing');",
+					ExpectedErr: "branch not found",
+				},
+			},
+		},
+		{
+			Name: "three-way merge",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 TEXT);",
+				"INSERT INTO test VALUES (1, 'one');",
+				"SELECT dolt_commit('-Am', 'initial');",
+				"SELECT dolt_checkout('-b', 'feature');",
+				"INSERT INTO test VALUES (2, 'two');",
+				"SELECT dolt_commit('-am', 'feature');",
+				"SELECT dolt_checkout('main');",
+				"INSERT INTO test VALUES (3, 'three');",
+				"SELECT dolt_commit('-am', 'main');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT (dolt_merge('feature', '-m', 'merge feature'))[2:3];",
+					Skip:     true, // TODO: array slices are not yet supported
+					Expected: []sql.Row{{"{0,0}"}},
+				},
+				{
+					Query:    "SELECT (dolt_merge('feature', '-m', 'merge feature'))[3];",
+					Expected: []sql.Row{{"0"}},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, "one"}, {2, "two"}, {3, "three"}},
+				},
+				{
+					Query:    "SELECT message FROM dolt_log LIMIT 1;",
+					Expected: []sql.Row{{"merge feature"}},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_status;",
+					Expected: []sql.Row{{0}},
+				},
+			},
+		},
+		{
+			Name: "merge conflicts",
+			SetUpScript: []string{
+				"CREATE TABLE test (id INT4, v1 TEXT);",
+				"INSERT INTO test VALUES (1, 'one');",
+				"SELECT dolt_commit('-Am', 'initial');",
+				"SELECT dolt_checkout('-b', 'feature');",
+				"DELETE FROM test;",
+				"SELECT dolt_commit('-am', 'feature');",
+				"SELECT dolt_checkout('main');",
+				"INSERT INTO test VALUES (1, 'one');",
+				"SELECT dolt_commit('-am', 'main');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "SELECT dolt_merge('feature');",
+					ExpectedErr: "Merge conflict detected",
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_conflicts;",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT (dolt_merge('feature'))[3];",
+					Expected: []sql.Row{{"1"}},
+				},
+				{
+					Query:    "SELECT * FROM dolt_conflicts;",
+					Expected: []sql.Row{{"test", Numeric("1")}},
+				},
+				{
+					Query: "SELECT base_id, base_v1, our_id, our_v1, our_diff_type, their_id, their_v1, their_diff_type, " +
+						"base_cardinality, our_cardinality, their_cardinality FROM dolt_conflicts_test;",
+					Expected: []sql.Row{{1, "one", 1, "one", "modified", nil, nil, "removed", Numeric("1"), Numeric("2"), Numeric("0")}},
+				},
+				{
+					Query:       "SELECT dolt_commit('-am', 'merge');",
+					ExpectedErr: "in conflict",
+				},
+				{
+					Query:    "SELECT dolt_conflicts_resolve('--ours', 'test');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_conflicts;",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT * FROM test;",
+					Expected: []sql.Row{{1, "one"}, {1, "one"}},
+				},
+				{
+					Query:    "SELECT length(dolt_commit('-am', 'merge'));",
+					Expected: []sql.Row{{32}},
+				},
+				{
+					Query:    "COMMIT;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT message FROM dolt_log LIMIT 1;",
+					Expected: []sql.Row{{"merge"}},
+				},
+			},
+		},
+		{
+			Name: "merge conflicts on the same row",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 TEXT);",
+				"INSERT INTO test VALUES (1, 'one');",
+				"SELECT dolt_commit('-Am', 'initial');",
+				"SELECT dolt_checkout('-b', 'feature');",
+				"UPDATE test SET v1 = 'feature';",
+				"SELECT dolt_commit('-am', 'feature');",
+				"SELECT dolt_checkout('main');",
+				"UPDATE test SET v1 = 'main';",
+				"SELECT dolt_commit('-am', 'main');",
+				"BEGIN;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT (dolt_merge('feature'))[3];",
+					Expected: []sql.Row{{"1"}},
+				},
+				{
+					Query:    "SELECT base_v1, our_v1, their_v1 FROM dolt_conflicts_test;",
+					Expected: []sql.Row{{"one", "main", "feature"}},
+				},
+				{
+					Query:    "SELECT dolt_conflicts_resolve('--theirs', 'test');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT * FROM test;",
+					Expected: []sql.Row{{1, "feature"}},
+				},
+			},
+		},
+		{
+			Name: "merge changes to different columns of the same row",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 TEXT, v2 INT4);",
+				"CREATE INDEX test_v2_idx ON test (v2);",
+				"INSERT INTO test VALUES (1, 'one', 1), (2, 'two', 2);",
+				"SELECT dolt_commit('-Am', 'initial');",
+				"SELECT dolt_checkout('-b', 'feature');",
+				"UPDATE test SET v2 = 10 WHERE pk = 1;",
+				"UPDATE test SET v1 = 'same' WHERE pk = 2;",
+				"SELECT dolt_commit('-am', 'feature');",
+				"SELECT dolt_checkout('main');",
+				"UPDATE test SET v1 = 'uno' WHERE pk = 1;",
+				"UPDATE test SET v1 = 'same', v2 = 20 WHERE pk = 2;",
+				"SELECT dolt_commit('-am', 'main');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT (dolt_merge('feature', '-m', 'merge feature'))[3];",
+					Expected: []sql.Row{{"0"}},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, "uno", 10}, {2, "same", 20}},
+				},
+				{
+					Query:    "SELECT pk FROM test WHERE v2 = 10;",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "SELECT message FROM dolt_log LIMIT 1;",
+					Expected: []sql.Row{{"merge feature"}},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_status;",
+					Expected: []sql.Row{{0}},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,166 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestDoltSchemaDiffAndPatch(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "dolt_schema_diff",
+			SetUpScript: []string{
+				"CREATE TABLE parent (id INT4 PRIMARY KEY);",
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 TEXT, v2 INT4);",
+				"SELECT dolt_commit('-Am', 'initial');",
+				"ALTER TABLE test ADD COLUMN v3 INT4 NOT NULL DEFAULT 5;",
+				"ALTER TABLE test DROP COLUMN v2;",
+				"CREATE TABLE child (id INT4 PRIMARY KEY, pid INT4 REFERENCES parent(id) ON DELETE CASCADE, v1 INT4, v2 INT4, v3 VARCHAR(10), UNIQUE (v2), CONSTRAINT child_v2_check CHECK (v2 > 0));",
+				"CREATE INDEX child_v1 ON child (v1);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT * FROM dolt_schema_diff('HEAD', 'WORKING', 'test');",
+					Expected: []sql.Row{{
+						"test",
+						"test",
+						"CREATE TABLE public.test (\n    pk integer NOT NULL,\n    v1 text,\n    v2 integer,\n    PRIMARY KEY (pk)\n);",
+						"CREATE TABLE public.test (\n    pk integer NOT NULL,\n    v1 text,\n    v3 integer DEFAULT (5) NOT NULL,\n    PRIMARY KEY (pk)\n);",
+					}},
+				},
+				{
+					Query: "SELECT from_table_name, to_table_name, from_create_statement, to_create_statement FROM dolt_schema_diff('HEAD..WORKING') WHERE to_table_name = 'child';",
+					Expected: []sql.Row{{
+						"",
+						"child",
+						"",
+						"CREATE TABLE public.child (\n    id integer NOT NULL,\n    pid integer,\n    v1 integer,\n    v2 integer,\n    v3 character varying(10),\n    PRIMARY KEY (id),\n    CONSTRAINT v2 UNIQUE (v2),\n    CONSTRAINT child_v2_check CHECK (v2 > 0),\n    CONSTRAINT child_pid_fkey FOREIGN KEY (pid) REFERENCES public.parent(id) ON DELETE CASCADE\n);\nCREATE INDEX child_v1 ON public.child USING btree (v1);",
+					}},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_schema_diff('HEAD', 'HEAD');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT to_table_name FROM dolt_schema_diff('WORKING', 'HEAD') ORDER BY 1;",
+					Expected: []sql.Row{{""}, {"test"}},
+				},
+				{
+					Query:       "SELECT * FROM dolt_schema_diff('HEAD');",
+					ExpectedErr: `two refs, or a single "from..to" argument, must be given`,
+				},
+				{
+					Query:       "SELECT * FROM dolt_schema_diff('HEAD', 'missing');",
+					ExpectedErr: "branch not found",
+				},
+			},
+		},
+		{
+			Name: "dolt_patch",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 TEXT, v2 INT4);",
+				"CREATE TABLE keyless (v1 INT4, v2 TEXT);",
+				"CREATE TABLE dropped (pk INT4 PRIMARY KEY);",
+				"INSERT INTO test VALUES (1, 'one', 1), (2, 'it''s', 2), (3, 'three', 3);",
+				"INSERT INTO keyless VALUES (1, 'one'), (1, 'one');",
+				"SELECT dolt_commit('-Am', 'initial');",
+				"ALTER TABLE test ADD COLUMN v3 BOOLEAN;",
+				"ALTER TABLE test DROP COLUMN v2;",
+				"UPDATE test SET v1 = 'uno' WHERE pk = 1;",
+				"DELETE FROM test WHERE pk = 2;",
+				"INSERT INTO test VALUES (4, NULL, true);",
+				"DELETE FROM keyless;",
+				"INSERT INTO keyless VALUES (2, NULL);",
+				"DROP TABLE dropped;",
+				"CREATE TABLE added (pk INT4 PRIMARY KEY, v1 FLOAT8);",
+				"INSERT INTO added VALUES (1, 1.5);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT statement_order, table_name, diff_type, statement FROM dolt_patch('HEAD', 'WORKING');",
+					Expected: []sql.Row{
+						{1, "added", "schema", "CREATE TABLE public.added (\n    pk integer NOT NULL,\n    v1 double precision,\n    PRIMARY KEY (pk)\n);"},
+						{2, "added", "data", "INSERT INTO public.added (pk, v1) VALUES (1, 1.5);"},
+						{3, "dropped", "schema", "DROP TABLE public.dropped;"},
+						{4, "keyless", "data", "DELETE FROM public.keyless WHERE ctid = (SELECT ctid FROM public.keyless WHERE v1 = 1 AND v2 = 'one' LIMIT 1);"},
+						{5, "keyless", "data", "DELETE FROM public.keyless WHERE ctid = (SELECT ctid FROM public.keyless WHERE v1 = 1 AND v2 = 'one' LIMIT 1);"},
+						{6, "keyless", "data", "INSERT INTO public.keyless (v1, v2) VALUES (2, NULL);"},
+						{7, "test", "schema", "ALTER TABLE public.test DROP COLUMN v2;"},
+						{8, "test", "schema", "ALTER TABLE public.test ADD COLUMN v3 boolean;"},
+						{9, "test", "data", "UPDATE public.test SET v1 = 'uno' WHERE pk = 1;"},
+						{10, "test", "data", "DELETE FROM public.test WHERE pk = 2;"},
+						{11, "test", "data", "INSERT INTO public.test (pk, v1, v3) VALUES (4, NULL, true);"},
+					},
+				},
+				{
+					Query:    "SELECT statement FROM dolt_patch('HEAD..WORKING', 'test') WHERE diff_type = 'schema';",
+					Expected: []sql.Row{{"ALTER TABLE public.test DROP COLUMN v2;"}, {"ALTER TABLE public.test ADD COLUMN v3 boolean;"}},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_patch('HEAD', 'WORKING') p WHERE p.diff_type = 'data';",
+					Expected: []sql.Row{{7}},
+				},
+				{
+					Query:    "SELECT length(dolt_patch.from_commit_hash), dolt_patch.to_commit_hash FROM dolt_patch('HEAD', 'WORKING', 'added') LIMIT 1;",
+					Expected: []sql.Row{{32, "WORKING"}},
+				},
+				{
+					Query:            "SELECT dolt_commit('-Am', 'changes');",
+					SkipResultsCheck: true,
+				},
+				{
+					Query:    "SELECT statement FROM dolt_patch('HEAD~1', 'HEAD', 'added');",
+					Expected: []sql.Row{{"CREATE TABLE public.added (\n    pk integer NOT NULL,\n    v1 double precision,\n    PRIMARY KEY (pk)\n);"}, {"INSERT INTO public.added (pk, v1) VALUES (1, 1.5);"}},
+				},
+				{
+					Query:    "SELECT statement FROM dolt_patch('HEAD', 'HEAD~1', 'added');",
+					Expected: []sql.Row{{"DROP TABLE public.added;"}},
+				},
+			},
+		},
+		{
+			Name: "dolt_patch schema alterations",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 INT4, v2 INT4, v3 INT4);",
+				"CREATE INDEX test_v1 ON test (v1);",
+				"SELECT dolt_commit('-Am', 'initial');",
+				"ALTER TABLE test RENAME COLUMN v2 TO v2_renamed;",
+				"ALTER TABLE test ALTER COLUMN v3 TYPE INT8;",
+				"ALTER TABLE test ALTER COLUMN v1 SET NOT NULL;",
+				"ALTER TABLE test ALTER COLUMN v3 SET DEFAULT 3;",
+				"DROP INDEX test_v1;",
+				"CREATE UNIQUE INDEX test_v3 ON test (v3);",
+				"ALTER TABLE test RENAME TO renamed;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT statement FROM dolt_patch('HEAD', 'WORKING', 'renamed');",
+					Expected: []sql.Row{
+						{"DROP INDEX public.test_v1;"},
+						{"ALTER TABLE public.test RENAME TO renamed;"},
+						{"ALTER TABLE public.renamed ALTER COLUMN v1 SET NOT NULL;"},
+						{"ALTER TABLE public.renamed RENAME COLUMN v2 TO v2_renamed;"},
+						{"ALTER TABLE public.renamed ALTER COLUMN v3 TYPE bigint;"},
+						{"ALTER TABLE public.renamed ALTER COLUMN v3 SET DEFAULT 3;"},
+						{"ALTER TABLE public.renamed ADD CONSTRAINT test_v3 UNIQUE (v3);"},
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,227 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestDoltProcedureFunctions(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "dolt_add, dolt_commit, and dolt_reset as functions",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 INT8);",
+				"INSERT INTO test VALUES (1, 10);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT dolt_add('test');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT table_name, staged FROM dolt_status;",
+					Expected: []sql.Row{{"test", 1}},
+				},
+				{
+					Query:    "SELECT dolt_reset();",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT table_name, staged FROM dolt_status;",
+					Expected: []sql.Row{{"test", 0}},
+				},
+				{
+					Query:    "SELECT length(dolt_commit('-Am', 'first commit'));",
+					Expected: []sql.Row{{32}},
+				},
+				{
+					Query:    "SELECT dolt_commit('-am', 'nothing to commit', '--skip-empty');",
+					Expected: []sql.Row{{nil}},
+				},
+				{
+					Query:    "SELECT message FROM dolt_log LIMIT 1;",
+					Expected: []sql.Row{{"first commit"}},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_status;",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (2, 20);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT length(dolt_commit('-am', 'second commit'));",
+					Expected: []sql.Row{{32}},
+				},
+				{
+					Query:    "SELECT message FROM dolt_log LIMIT 1;",
+					Expected: []sql.Row{{"second commit"}},
+				},
+				{
+					Query:    "SELECT dolt_reset('--hard', 'HEAD~1');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT * FROM test;",
+					Expected: []sql.Row{{1, 10}},
+				},
+				{
+					Query:       "SELECT dolt_commit();",
+					ExpectedErr: "Must provide commit message",
+				},
+				{
+					Query:       "SELECT dolt_verify_constraints();",
+					ExpectedErr: "not found",
+				},
+			},
+		},
+		{
+			Name: "dolt_branch and dolt_checkout as functions",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY);",
+				"INSERT INTO test VALUES (1);",
+				"SELECT dolt_commit('-Am', 'initial');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT dolt_branch('feature');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT dolt_branch('doomed');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT name, latest_commit_message FROM dolt_branches ORDER BY name;",
+					Expected: []sql.Row{{"doomed", "initial"}, {"feature", "initial"}, {"main", "initial"}},
+				},
+				{
+					Query:    "SELECT dolt_branch('-d', 'doomed');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT name FROM dolt_branches ORDER BY name;",
+					Expected: []sql.Row{{"feature"}, {"main"}},
+				},
+				{
+					Query:    "SELECT dolt_checkout('feature');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT active_branch();",
+					Expected: []sql.Row{{"feature"}},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (2);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT length(dolt_commit('-am', 'feature commit'));",
+					Expected: []sql.Row{{32}},
+				},
+				{
+					Query:    "SELECT name, latest_commit_message FROM dolt_branches ORDER BY name;",
+					Expected: []sql.Row{{"feature", "feature commit"}, {"main", "initial"}},
+				},
+				{
+					Query:    "SELECT dolt_checkout('main');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT * FROM test;",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "SELECT dolt_checkout('-b', 'other');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT active_branch();",
+					Expected: []sql.Row{{"other"}},
+				},
+				{
+					Query:       "SELECT dolt_checkout('missing');",
+					ExpectedErr: "missing",
+				},
+			},
+		},
+		{
+			Name: "dolt_tag as a function and dolt_tags",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY);",
+				"INSERT INTO test VALUES (1);",
+				"SELECT dolt_commit('-Am', 'first commit');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT dolt_tag('v1');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "CALL dolt_tag('v1-annotated', 'HEAD', '-m', 'first release');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (2);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT length(dolt_commit('-am', 'second commit'));",
+					Expected: []sql.Row{{32}},
+				},
+				{
+					Query:    "SELECT dolt_tag('v2', 'HEAD');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT tag_name, message FROM dolt_tags ORDER BY tag_name;",
+					Expected: []sql.Row{{"v1", ""}, {"v1-annotated", "first release"}, {"v2", ""}},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_tags t JOIN dolt_log l ON t.tag_hash = l.commit_hash WHERE l.message = 'first commit';",
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:    "SELECT * FROM test AS OF SYSTEM TIME 'v1';",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "SELECT * FROM public.test AS OF SYSTEM TIME 'v2' ORDER BY pk;",
+					Expected: []sql.Row{{1}, {2}},
+				},
+				{
+					Query:    "SELECT dolt_tag('-d', 'v1-annotated');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT tag_name FROM dolt_tags ORDER BY tag_name;",
+					Expected: []sql.Row{{"v1"}, {"v2"}},
+				},
+				{
+					Query:       "SELECT dolt_tag('v1');",
+					ExpectedErr: "already exists",
+				},
+				{
+					Query:       "SELECT dolt_tag('-d', 'missing');",
+					ExpectedErr: "tag not found",
+				},
+			},
+		},
+	})
+}
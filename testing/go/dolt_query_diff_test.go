@@ -0,0 +1,94 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestDoltQueryDiff(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "dolt_query_diff",
+			SetUpScript: []string{
+				"CREATE TABLE t (pk INT8 PRIMARY KEY, v TEXT, n INT4);",
+				"INSERT INTO t VALUES (1, 'a', 10), (2, 'b', 20), (3, 'c', 30);",
+				"SELECT dolt_commit('-Am', 'initial');",
+				"SELECT dolt_tag('v1');",
+				"UPDATE t SET v = 'bb' WHERE pk = 2;",
+				"DELETE FROM t WHERE pk = 3;",
+				"INSERT INTO t VALUES (4, 'd', NULL);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT * FROM dolt_query_diff('SELECT * FROM t AS OF SYSTEM TIME ''v1''', 'SELECT * FROM public.t');",
+					Expected: []sql.Row{
+						{2, "b", 20, 2, "bb", 20, "modified"},
+						{3, "c", 30, nil, nil, nil, "deleted"},
+						{nil, nil, nil, 4, "d", nil, "added"},
+					},
+				},
+				{
+					Query: "SELECT from_v, to_v, diff_type FROM dolt_query_diff('SELECT pk, v FROM t AS OF SYSTEM TIME ''HEAD'' WHERE pk < 3', 'SELECT pk, upper(v) AS v FROM t WHERE pk < 3');",
+					Expected: []sql.Row{
+						{"a", "A", "modified"},
+						{"b", "BB", "modified"},
+					},
+				},
+				{
+					Query: "SELECT from_v, to_v, diff_type FROM dolt_query_diff('SELECT v::text FROM t AS OF SYSTEM TIME ''HEAD''', 'SELECT v::text FROM t') ORDER BY diff_type, from_v, to_v;",
+					Expected: []sql.Row{
+						{nil, "bb", "added"},
+						{nil, "d", "added"},
+						{"b", nil, "deleted"},
+						{"c", nil, "deleted"},
+					},
+				},
+				{
+					Query: "SELECT from_pk, from_v, to_pk, to_n, diff_type FROM dolt_query_diff('SELECT pk, v FROM t WHERE pk = 1', 'SELECT pk, n FROM t WHERE pk = 1');",
+					Expected: []sql.Row{
+						{1, "a", nil, nil, "deleted"},
+						{nil, nil, 1, 10, "added"},
+					},
+				},
+				{
+					Query: "SELECT from_pk, to_pk, to_n, diff_type FROM dolt_query_diff('SELECT pk, v FROM t WHERE pk = 1', 'SELECT pk, v, n FROM t WHERE pk = 1');",
+					Expected: []sql.Row{
+						{1, nil, nil, "deleted"},
+						{nil, 1, 10, "added"},
+					},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_query_diff('SELECT * FROM t', 'SELECT * FROM t');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:       "SELECT * FROM dolt_query_diff('DELETE FROM t', 'SELECT * FROM t');",
+					ExpectedErr: "dolt_query_diff only supports SELECT queries",
+				},
+				{
+					Query:       "SELECT * FROM dolt_query_diff('SELECT * FROM t');",
+					ExpectedErr: "expected 2 arguments",
+				},
+				{
+					Query:    "SELECT count(*) FROM t;",
+					Expected: []sql.Row{{3}},
+				},
+			},
+		},
+	})
+}
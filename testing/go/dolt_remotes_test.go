@@ -0,0 +1,125 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoltRemotes(t *testing.T) {
+	// Remotes are written to disk, so this uses a server that is backed by a data directory rather than memory
+	srv := StartServer(t, nil)
+	remoteUrl := "file://" + filepath.ToSlash(filepath.Join(t.TempDir(), "remote"))
+
+	ExecQueries(t, Connect(t, srv, ""), "CREATE DATABASE origdb;")
+	orig := Connect(t, srv, "origdb")
+	ExecQueries(t, orig,
+		"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 TEXT);",
+		"CREATE TABLE keyless (v1 INT4, v2 TEXT);",
+		"INSERT INTO test VALUES (1, 'one');",
+		"INSERT INTO keyless VALUES (1, 'one');",
+		"SELECT dolt_commit('-Am', 'initial');",
+	)
+
+	t.Run("dolt_remote", func(t *testing.T) {
+		assert.Equal(t, []any{int64(0)}, QueryRow(t, orig, fmt.Sprintf("SELECT dolt_remote('add', 'origin', '%s');", remoteUrl)))
+		assert.Equal(t, []any{"origin", remoteUrl}, QueryRow(t, orig, "SELECT name, url FROM dolt_remotes;"))
+		RequireErrorCode(t, orig, fmt.Sprintf("SELECT dolt_remote('add', 'origin', '%s');", remoteUrl), "42710")
+		RequireErrorCode(t, orig, "SELECT dolt_remote('remove', 'missing');", "42704")
+	})
+
+	t.Run("dolt_push and dolt_clone", func(t *testing.T) {
+		values := QueryRow(t, orig, "SELECT dolt_push('origin', 'main');")
+		require.Len(t, values, 1)
+		assert.Contains(t, fmt.Sprint(values[0]), "[new branch]")
+		assert.Equal(t, []any{"Everything up-to-date"}, QueryRow(t, orig, "SELECT (dolt_push('origin', 'main'))[2];"))
+		RequireErrorCode(t, orig, "SELECT dolt_push('missing', 'main');", "42704")
+
+		assert.Equal(t, []any{int64(0)}, QueryRow(t, orig, fmt.Sprintf("SELECT dolt_clone('%s', 'clonedb');", remoteUrl)))
+		clone := Connect(t, srv, "clonedb")
+		assert.Equal(t, []any{int32(1), "one"}, QueryRow(t, clone, "SELECT * FROM test;"))
+		assert.Equal(t, []any{"initial"}, QueryRow(t, clone, "SELECT message FROM dolt_log LIMIT 1;"))
+	})
+
+	t.Run("dolt_fetch and dolt_pull", func(t *testing.T) {
+		clone := Connect(t, srv, "clonedb")
+		ExecQueries(t, clone,
+			"INSERT INTO test VALUES (2, 'two');",
+			"SELECT dolt_commit('-am', 'from clone');",
+			"SELECT dolt_push('origin', 'main');",
+		)
+		assert.Equal(t, []any{int64(0)}, QueryRow(t, orig, "SELECT dolt_fetch('origin');"))
+		assert.Equal(t, []any{"from clone"}, QueryRow(t, orig, "SELECT message FROM dolt_log('origin/main') LIMIT 1;"))
+		assert.Equal(t, []any{int64(1)}, QueryRow(t, orig, "SELECT count(*) FROM test;"))
+		assert.Equal(t, []any{"1"}, QueryRow(t, orig, "SELECT (dolt_pull('origin', 'main'))[1];"))
+		assert.Equal(t, []any{int64(2)}, QueryRow(t, orig, "SELECT count(*) FROM test;"))
+		RequireErrorCode(t, orig, "SELECT dolt_fetch('missing');", "42704")
+	})
+
+	t.Run("conflicts", func(t *testing.T) {
+		clone := Connect(t, srv, "clonedb")
+		ExecQueries(t, clone,
+			"SELECT dolt_pull('origin', 'main');",
+			"INSERT INTO test VALUES (3, 'three');",
+			"SELECT dolt_commit('-am', 'three from clone');",
+			"SELECT dolt_push('origin', 'main');",
+		)
+		// The remote has changed since the last pull, so the push is rejected until the changes have been pulled
+		ExecQueries(t, orig,
+			"INSERT INTO test VALUES (4, 'four');",
+			"SELECT dolt_commit('-am', 'four from orig');",
+		)
+		RequireErrorCode(t, orig, "SELECT dolt_push('origin', 'main');", "40001")
+		ExecQueries(t, orig, "SELECT dolt_pull('origin', 'main');")
+		assert.Equal(t, []any{int64(4)}, QueryRow(t, orig, "SELECT count(*) FROM test;"))
+		ExecQueries(t, orig, "SELECT dolt_push('origin', 'main');")
+
+		// Uncommitted changes must be committed before pulling
+		ExecQueries(t, orig, "INSERT INTO test VALUES (5, 'five');")
+		RequireErrorCode(t, orig, "SELECT dolt_pull('origin', 'main');", "55000")
+		ExecQueries(t, orig, "SELECT dolt_commit('-am', 'five from orig');", "SELECT dolt_push('origin', 'main');")
+
+		// Merge conflicts roll back the transaction when autocommit is enabled
+		ExecQueries(t, clone,
+			"SELECT dolt_pull('origin', 'main');",
+			"UPDATE keyless SET v2 = 'uno';",
+			"SELECT dolt_commit('-am', 'uno from clone');",
+			"SELECT dolt_push('origin', 'main');",
+		)
+		ExecQueries(t, orig,
+			"UPDATE keyless SET v2 = 'eins';",
+			"SELECT dolt_commit('-am', 'eins from orig');",
+		)
+		RequireErrorCode(t, orig, "SELECT dolt_pull('origin', 'main');", "40000")
+		assert.Equal(t, []any{"eins"}, QueryRow(t, orig, "SELECT v2 FROM keyless;"))
+	})
+
+	t.Run("credentials", func(t *testing.T) {
+		assert.Equal(t, []any{""}, QueryRow(t, orig, "SHOW dolt.remote_user;"))
+		ExecQueries(t, orig,
+			"SET dolt.remote_user = 'remote_user';",
+			"SET dolt.remote_password = 'remote_password';",
+		)
+		assert.Equal(t, []any{"remote_user"}, QueryRow(t, orig, "SHOW dolt.remote_user;"))
+		// File remotes do not authenticate, so the credentials are accepted without being checked
+		assert.Equal(t, []any{int64(0)}, QueryRow(t, orig, "SELECT dolt_fetch('origin');"))
+		assert.Equal(t, []any{int64(0)}, QueryRow(t, orig, "SELECT dolt_fetch('--user', 'other_user', 'origin');"))
+	})
+}
@@ -0,0 +1,191 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestDoltStash(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "dolt_stash push, pop, and list",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 TEXT);",
+				"INSERT INTO test VALUES (1, 'a');",
+				"SELECT dolt_commit('-Am', 'initial');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT dolt_stash('push');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_stashes;",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (2, 'b');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE TABLE untracked (id INT4 PRIMARY KEY);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT dolt_stash('push');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT stash_id, branch, message FROM dolt_stashes;",
+					Expected: []sql.Row{{"stash@{0}", "main", "initial"}},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_stashes s JOIN dolt_log l ON s.commit_hash = l.commit_hash;",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "SELECT * FROM test;",
+					Expected: []sql.Row{{1, "a"}},
+				},
+				{
+					Query:    "SELECT table_name FROM dolt_status;",
+					Expected: []sql.Row{{"untracked"}},
+				},
+				{
+					Query:    "SELECT dolt_checkout('-b', 'other');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT dolt_stash('pop');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, "a"}, {2, "b"}},
+				},
+				{
+					Query:    "SELECT table_name, staged FROM dolt_status;",
+					Expected: []sql.Row{{"test", 0}},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_stashes;",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT length(dolt_commit('-am', 'popped'));",
+					Expected: []sql.Row{{32}},
+				},
+				{
+					Query:    "SELECT dolt_checkout('main');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT dolt_stash('push', '-u', '-m', 'with untracked');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:       "SELECT * FROM untracked;",
+					ExpectedErr: "table not found",
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_status;",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "UPDATE test SET v1 = 'z' WHERE pk = 1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CALL dolt_stash('push');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT stash_id, branch, message FROM dolt_stashes;",
+					Expected: []sql.Row{{"stash@{0}", "main", "initial"}, {"stash@{1}", "main", "with untracked"}},
+				},
+				{
+					Query:    "SELECT dolt_stash('pop', 'stash@{1}');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT * FROM untracked;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test;",
+					Expected: []sql.Row{{1, "a"}},
+				},
+				{
+					Query:    "SELECT stash_id, message FROM dolt_stashes;",
+					Expected: []sql.Row{{"stash@{0}", "initial"}},
+				},
+				{
+					Query:       "SELECT dolt_stash('drop', 'stash@{3}');",
+					ExpectedErr: "stash@{3} is not a valid reference",
+				},
+				{
+					Query:       "SELECT dolt_stash('apply');",
+					ExpectedErr: `unknown dolt_stash subcommand "apply"`,
+				},
+				{
+					Query:    "CALL dolt_stash('drop');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_stashes;",
+					Expected: []sql.Row{{0}},
+				},
+			},
+		},
+		{
+			Name: "dolt_stash pop with conflicting changes",
+			SetUpScript: []string{
+				// Dolt's cell-wise merge cannot yet compare values using Doltgres's extended encodings, so this uses a
+				// keyless table
+				"CREATE TABLE test (id INT4, v1 TEXT);",
+				"INSERT INTO test VALUES (1, 'one');",
+				"SELECT dolt_commit('-Am', 'initial');",
+				"DELETE FROM test;",
+				"SELECT dolt_stash('push');",
+				"INSERT INTO test VALUES (1, 'one');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "SELECT dolt_stash('pop');",
+					ExpectedErr: "the stash entry is kept",
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_stashes;",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "SELECT * FROM test;",
+					Expected: []sql.Row{{1, "one"}, {1, "one"}},
+				},
+				{
+					Query:    "CALL dolt_stash('clear');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_stashes;",
+					Expected: []sql.Row{{0}},
+				},
+			},
+		},
+	})
+}
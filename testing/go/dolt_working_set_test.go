@@ -0,0 +1,134 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestDoltWorkingSet(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "dolt_status, dolt_staged, and dolt_unstaged",
+			SetUpScript: []string{
+				"CREATE TABLE t1 (pk INT4 PRIMARY KEY, v TEXT);",
+				"CREATE TABLE t2 (pk INT4 PRIMARY KEY);",
+				"INSERT INTO t1 VALUES (1, 'one'), (2, 'two'), (3, 'three');",
+				"SELECT dolt_commit('-Am', 'initial');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT * FROM dolt_staged;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM dolt_unstaged;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO t1 VALUES (4, 'four');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "UPDATE t1 SET v = 'uno' WHERE pk = 1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DELETE FROM t1 WHERE pk = 2;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT dolt_add('t1');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "INSERT INTO t1 VALUES (5, 'five'), (6, 'six');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE TABLE t3 (pk INT4 PRIMARY KEY);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO t3 VALUES (1);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DROP TABLE t2;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE SCHEMA other;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE TABLE other.t4 (pk INT4 PRIMARY KEY);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO other.t4 VALUES (1), (2);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT table_name, staged, status FROM dolt_status ORDER BY staged DESC, table_name;",
+					Expected: []sql.Row{{"t1", 1, "modified"}, {"t1", 0, "modified"}, {"t2", 0, "deleted"}, {"t3", 0, "new table"}, {"t4", 0, "new table"}},
+				},
+				{
+					Query:    "SELECT * FROM dolt_staged;",
+					Expected: []sql.Row{{"public", "t1", "modified", 1, 1, 1}},
+				},
+				{
+					Query: "SELECT * FROM dolt_unstaged ORDER BY schema_name, table_name;",
+					Expected: []sql.Row{
+						{"other", "t4", "new table", 2, 0, 0},
+						{"public", "t1", "modified", 2, 0, 0},
+						{"public", "t2", "deleted", 0, 0, 0},
+						{"public", "t3", "new table", 1, 0, 0},
+					},
+				},
+				{
+					Query:    "SELECT table_name, rows_added FROM pg_catalog.dolt_unstaged WHERE rows_added > 1 ORDER BY table_name;",
+					Expected: []sql.Row{{"t1", 2}, {"t4", 2}},
+				},
+				{
+					Query:    "SELECT length(dolt_commit('-m', 'staged changes'));",
+					Expected: []sql.Row{{32}},
+				},
+				{
+					Query:    "SELECT * FROM dolt_staged;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT table_name, status FROM dolt_unstaged ORDER BY table_name;",
+					Expected: []sql.Row{{"t1", "modified"}, {"t2", "deleted"}, {"t3", "new table"}, {"t4", "new table"}},
+				},
+				{
+					Query:    "SELECT dolt_add('t3');",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT * FROM dolt_staged;",
+					Expected: []sql.Row{{"public", "t3", "new table", 1, 0, 0}},
+				},
+				{
+					Query:    "SELECT table_name FROM dolt_unstaged ORDER BY table_name;",
+					Expected: []sql.Row{{"t1"}, {"t2"}, {"t4"}},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,120 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorResponses(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+	for _, statement := range []string{
+		"CREATE TABLE parents (id INT4 PRIMARY KEY);",
+		"CREATE TABLE children (id INT4 PRIMARY KEY, parent_id INT4 NOT NULL, qty INT4, CONSTRAINT children_parent_fk FOREIGN KEY (parent_id) REFERENCES parents (id));",
+		"ALTER TABLE children ADD CONSTRAINT qty_positive CHECK (qty > 0);",
+		"INSERT INTO parents VALUES (1);",
+		"INSERT INTO children VALUES (1, 1, 1);",
+	} {
+		_, err := conn.Exec(ctx, statement)
+		require.NoError(t, err, statement)
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		expected pgconn.PgError
+	}{
+		{
+			name:     "syntax error",
+			query:    "SELECT * FROM parents WHERE id = = 1;",
+			expected: pgconn.PgError{Code: "42601", Position: 34, Hint: `try \h SELECT`},
+		},
+		{
+			name:     "syntax error position counts characters",
+			query:    "SELECT 'ééé' FROM parents WHERE id = = 1;",
+			expected: pgconn.PgError{Code: "42601", Position: 38, Hint: `try \h SELECT`},
+		},
+		{
+			name:     "undefined table",
+			query:    "SELECT * FROM missing_table;",
+			expected: pgconn.PgError{Code: "42P01"},
+		},
+		{
+			name:     "undefined function",
+			query:    "SELECT no_such_function(1);",
+			expected: pgconn.PgError{Code: "42883"},
+		},
+		{
+			name:     "no matching function overload",
+			query:    "SELECT abs(1, 2);",
+			expected: pgconn.PgError{Code: "42883", Hint: "No function matches the given name and argument types. You might need to add explicit type casts."},
+		},
+		{
+			name:     "division by zero",
+			query:    "SELECT 1 / 0;",
+			expected: pgconn.PgError{Code: "22012"},
+		},
+		{
+			name:     "unique violation",
+			query:    "INSERT INTO parents VALUES (1);",
+			expected: pgconn.PgError{Code: "23505"},
+		},
+		{
+			name:     "not null violation",
+			query:    "INSERT INTO children VALUES (2, NULL, 1);",
+			expected: pgconn.PgError{Code: "23502", ColumnName: "parent_id"},
+		},
+		{
+			name:     "foreign key violation",
+			query:    "INSERT INTO children VALUES (2, 2, 1);",
+			expected: pgconn.PgError{Code: "23503", ConstraintName: "children_parent_fk", TableName: "children"},
+		},
+		{
+			name:     "check violation",
+			query:    "INSERT INTO children VALUES (2, 1, 0);",
+			expected: pgconn.PgError{Code: "23514", ConstraintName: "qty_positive"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// Both protocols should report the same fields
+			for _, mode := range []pgx.QueryExecMode{pgx.QueryExecModeSimpleProtocol, pgx.QueryExecModeDescribeExec} {
+				_, err := conn.Exec(ctx, test.query, mode)
+				require.Error(t, err)
+				var pgErr *pgconn.PgError
+				require.True(t, errors.As(err, &pgErr), err.Error())
+				assert.Equal(t, "ERROR", pgErr.Severity)
+				assert.Equal(t, test.expected.Code, pgErr.Code, pgErr.Message)
+				assert.Equal(t, test.expected.Position, pgErr.Position)
+				assert.Equal(t, test.expected.Hint, pgErr.Hint)
+				assert.Equal(t, test.expected.ColumnName, pgErr.ColumnName)
+				assert.Equal(t, test.expected.TableName, pgErr.TableName)
+				assert.Equal(t, test.expected.ConstraintName, pgErr.ConstraintName)
+				assert.NotContains(t, pgErr.Message, "errno")
+			}
+		})
+	}
+}
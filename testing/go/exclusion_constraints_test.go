@@ -0,0 +1,234 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestExclusionConstraints(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "equality exclusion constraint",
+			SetUpScript: []string{
+				"CREATE TABLE bookings (pk INT4 PRIMARY KEY, room INT4, guest TEXT, EXCLUDE (room WITH =));",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "INSERT INTO bookings VALUES (1, 101, 'ann'), (2, 102, 'bob');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "INSERT INTO bookings VALUES (3, 101, 'cat');",
+					ExpectedErr: `conflicting key value violates exclusion constraint "bookings_room_excl"`,
+				},
+				{
+					Query:       "INSERT INTO bookings VALUES (3, 103, 'cat'), (4, 103, 'dan');",
+					ExpectedErr: `conflicting key value violates exclusion constraint "bookings_room_excl"`,
+				},
+				{
+					Query:    "INSERT INTO bookings VALUES (3, NULL, 'cat'), (4, NULL, 'dan');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "UPDATE bookings SET room = 101 WHERE pk = 2;",
+					ExpectedErr: `conflicting key value violates exclusion constraint "bookings_room_excl"`,
+				},
+				{
+					Query:    "UPDATE bookings SET guest = 'amy' WHERE pk = 1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "UPDATE bookings SET room = 104 WHERE pk = 2;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO bookings VALUES (5, 102, 'eve');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO bookings VALUES (6, 101, 'fay'), (7, 106, 'gus') ON CONFLICT DO NOTHING;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "INSERT INTO bookings VALUES (8, 101, 'hal') ON CONFLICT (pk) DO UPDATE SET guest = 'hal';",
+					ExpectedErr: "ON CONFLICT DO UPDATE not supported with exclusion constraints",
+				},
+				{
+					Query: "SELECT * FROM bookings ORDER BY pk;",
+					Expected: []sql.Row{
+						{1, 101, "amy"},
+						{2, 104, "bob"},
+						{3, nil, "cat"},
+						{4, nil, "dan"},
+						{5, 102, "eve"},
+						{7, 106, "gus"},
+					},
+				},
+				{
+					Query:    "DELETE FROM bookings WHERE pk = 1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO bookings VALUES (8, 101, 'hal');",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "overlapping arrays",
+			SetUpScript: []string{
+				"CREATE TABLE schedules (pk INT4 PRIMARY KEY, room INT4, slots INT4[], CONSTRAINT no_double_booking EXCLUDE USING gist (room WITH =, slots WITH &&));",
+				"INSERT INTO schedules VALUES (1, 101, ARRAY[1, 2, 3]);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "INSERT INTO schedules VALUES (2, 101, ARRAY[4, 5]), (3, 102, ARRAY[1, 2]);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "INSERT INTO schedules VALUES (4, 101, ARRAY[5, 6]);",
+					ExpectedErr: `conflicting key value violates exclusion constraint "no_double_booking"`,
+				},
+				{
+					Query:    "UPDATE schedules SET slots = ARRAY[2] WHERE pk = 3;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "UPDATE schedules SET room = 101 WHERE pk = 3;",
+					ExpectedErr: `conflicting key value violates exclusion constraint "no_double_booking"`,
+				},
+				{
+					Query:    "INSERT INTO schedules VALUES (4, 101, ARRAY[6, NULL]), (5, 101, NULL);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT pk FROM schedules ORDER BY pk;",
+					Expected: []sql.Row{{1}, {2}, {3}, {4}, {5}},
+				},
+			},
+		},
+		{
+			Name: "ALTER TABLE ADD CONSTRAINT EXCLUDE",
+			SetUpScript: []string{
+				"CREATE TABLE t (pk INT4 PRIMARY KEY, v1 INT4, v2 TEXT);",
+				"INSERT INTO t VALUES (1, 1, 'a'), (2, 2, 'b'), (3, 2, 'c');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "ALTER TABLE t ADD CONSTRAINT v1_excl EXCLUDE (v1 WITH =);",
+					ExpectedErr: `could not create exclusion constraint "v1_excl"`,
+				},
+				{
+					Query:    "INSERT INTO t VALUES (4, 2, 'c');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "ALTER TABLE t ADD CONSTRAINT v2_excl EXCLUDE (v2 WITH =);",
+					ExpectedErr: `could not create exclusion constraint "v2_excl"`,
+				},
+				{
+					Query:    "DELETE FROM t WHERE pk = 4;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER TABLE t ADD CONSTRAINT v2_excl EXCLUDE (v2 WITH =);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "ALTER TABLE t ADD CONSTRAINT v2_excl EXCLUDE (v2 WITH =);",
+					ExpectedErr: `constraint "v2_excl" for relation "t" already exists`,
+				},
+				{
+					Query:       "INSERT INTO t VALUES (4, 4, 'a');",
+					ExpectedErr: `conflicting key value violates exclusion constraint "v2_excl"`,
+				},
+				{
+					Query:    "ALTER TABLE t RENAME TO t2;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "INSERT INTO t2 VALUES (4, 4, 'a');",
+					ExpectedErr: `conflicting key value violates exclusion constraint "v2_excl"`,
+				},
+				{
+					Query:    "DROP TABLE t2;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE TABLE t2 (pk INT4 PRIMARY KEY, v1 INT4, v2 TEXT);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO t2 VALUES (1, 1, 'a'), (2, 2, 'a');",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "unsupported exclusion constraints",
+			SetUpScript: []string{
+				"CREATE TABLE t (pk INT4 PRIMARY KEY, v1 INT4);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "CREATE TABLE t1 (pk INT4 PRIMARY KEY, v1 INT4, EXCLUDE (v1 WITH <));",
+					ExpectedErr: "operator < is not commutative",
+				},
+				{
+					Query:       "CREATE TABLE t1 (pk INT4 PRIMARY KEY, v1 INT4, EXCLUDE USING gin (v1 WITH =));",
+					ExpectedErr: `access method "gin" does not support exclusion constraints`,
+				},
+				{
+					Query:       "CREATE TABLE t1 (pk INT4 PRIMARY KEY, v1 INT4, EXCLUDE USING gist (v1 WITH &&));",
+					ExpectedErr: "operator does not exist",
+				},
+				{
+					Query:       "CREATE TABLE t1 (pk INT4 PRIMARY KEY, v1 INT4, EXCLUDE (v2 WITH =));",
+					ExpectedErr: `column "v2" named in key does not exist`,
+				},
+				{
+					Query:       "CREATE TABLE t1 (pk INT4 PRIMARY KEY, v1 INT4, EXCLUDE (v1 WITH =) WHERE (v1 > 0));",
+					ExpectedErr: "WHERE for exclusion constraints is not yet supported",
+				},
+				{
+					Query:       "ALTER TABLE t ADD CONSTRAINT v1_excl EXCLUDE (v1 WITH =) NOT VALID;",
+					ExpectedErr: "EXCLUDE constraints cannot be marked NOT VALID",
+				},
+				{
+					Query:       "SELECT * FROM t1;",
+					ExpectedErr: "not found",
+				},
+			},
+		},
+		{
+			Name: "Exclusion constraints require ownership of the table",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 INT4);",
+				"CREATE ROLE alice LOGIN;",
+				"GRANT ALL ON test TO alice;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "ALTER TABLE test ADD CONSTRAINT v1_excl EXCLUDE (v1 WITH =);",
+					Username:    "alice",
+					ExpectedErr: "must be owner of table test",
+				},
+			},
+		},
+	})
+}
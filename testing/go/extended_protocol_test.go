@@ -0,0 +1,338 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtendedProtocol(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+	_, err := conn.Exec(ctx, "CREATE TABLE test (pk INT8 PRIMARY KEY);")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "INSERT INTO test VALUES (1), (2), (3), (4), (5);")
+	require.NoError(t, err)
+
+	// We take over the connection so that we may send the messages ourselves
+	hijacked, err := conn.PgConn().Hijack()
+	require.NoError(t, err)
+	defer hijacked.Conn.Close()
+	frontend := hijacked.Frontend
+
+	// roundTrip sends the given messages followed by a Sync, and returns a summary of each message received in response
+	roundTrip := func(msgs ...pgproto3.FrontendMessage) []string {
+		for _, msg := range msgs {
+			frontend.Send(msg)
+		}
+		if _, ok := msgs[len(msgs)-1].(*pgproto3.Query); !ok {
+			frontend.Send(&pgproto3.Sync{})
+		}
+		require.NoError(t, frontend.Flush())
+		var received []string
+		for {
+			msg, err := frontend.Receive()
+			require.NoError(t, err)
+			switch msg := msg.(type) {
+			case *pgproto3.ParameterDescription:
+				received = append(received, fmt.Sprintf("ParameterDescription %v", msg.ParameterOIDs))
+			case *pgproto3.RowDescription:
+				received = append(received, fmt.Sprintf("RowDescription %s", msg.Fields[0].Name))
+			case *pgproto3.DataRow:
+				received = append(received, fmt.Sprintf("DataRow %s", msg.Values[0]))
+			case *pgproto3.CommandComplete:
+				received = append(received, fmt.Sprintf("CommandComplete %s", msg.CommandTag))
+			case *pgproto3.ErrorResponse:
+				received = append(received, fmt.Sprintf("ErrorResponse %s", msg.Message))
+			case *pgproto3.ReadyForQuery:
+				return append(received, fmt.Sprintf("ReadyForQuery %c", msg.TxStatus))
+			default:
+				received = append(received, fmt.Sprintf("%T", msg)[len("*pgproto3."):])
+			}
+		}
+	}
+
+	t.Run("Execute with a row limit suspends the portal", func(t *testing.T) {
+		require.Equal(t, []string{
+			"ParseComplete",
+			"BindComplete",
+			"RowDescription pk",
+			"DataRow 1",
+			"DataRow 2",
+			"PortalSuspended",
+			"DataRow 3",
+			"DataRow 4",
+			"PortalSuspended",
+			"DataRow 5",
+			"CommandComplete SELECT 1",
+			"ReadyForQuery I",
+		}, roundTrip(
+			&pgproto3.Parse{Query: "SELECT pk FROM test ORDER BY pk;"},
+			&pgproto3.Bind{},
+			&pgproto3.Describe{ObjectType: 'P'},
+			&pgproto3.Execute{MaxRows: 2},
+			&pgproto3.Execute{MaxRows: 2},
+			&pgproto3.Execute{MaxRows: 2},
+		))
+	})
+
+	t.Run("Reaching the row limit exactly still suspends the portal", func(t *testing.T) {
+		require.Equal(t, []string{
+			"ParseComplete",
+			"BindComplete",
+			"DataRow 1",
+			"DataRow 2",
+			"DataRow 3",
+			"DataRow 4",
+			"DataRow 5",
+			"PortalSuspended",
+			"CommandComplete SELECT 0",
+			"ReadyForQuery I",
+		}, roundTrip(
+			&pgproto3.Parse{Query: "SELECT pk FROM test ORDER BY pk;"},
+			&pgproto3.Bind{},
+			&pgproto3.Execute{MaxRows: 5},
+			&pgproto3.Execute{},
+		))
+	})
+
+	t.Run("Named portals outlive a Sync within a transaction", func(t *testing.T) {
+		require.Equal(t, []string{
+			"CommandComplete BEGIN",
+			"ReadyForQuery T",
+		}, roundTrip(&pgproto3.Query{String: "BEGIN;"}))
+		require.Equal(t, []string{
+			"ParseComplete",
+			"BindComplete",
+			"DataRow 1",
+			"DataRow 2",
+			"DataRow 3",
+			"PortalSuspended",
+			"ReadyForQuery T",
+		}, roundTrip(
+			&pgproto3.Parse{Name: "stmt", Query: "SELECT pk FROM test ORDER BY pk;"},
+			&pgproto3.Bind{DestinationPortal: "portal", PreparedStatement: "stmt"},
+			&pgproto3.Execute{Portal: "portal", MaxRows: 3},
+		))
+		require.Equal(t, []string{
+			"ErrorResponse portal \"portal\" already exists",
+			"ReadyForQuery T",
+		}, roundTrip(&pgproto3.Bind{DestinationPortal: "portal", PreparedStatement: "stmt"}))
+		require.Equal(t, []string{
+			"DataRow 4",
+			"DataRow 5",
+			"CommandComplete SELECT 2",
+			"ReadyForQuery T",
+		}, roundTrip(&pgproto3.Execute{Portal: "portal"}))
+		require.Equal(t, []string{
+			"CloseComplete",
+			"ErrorResponse portal portal does not exist",
+			"ReadyForQuery T",
+		}, roundTrip(
+			&pgproto3.Close{ObjectType: 'P', Name: "portal"},
+			&pgproto3.Execute{Portal: "portal"},
+		))
+		require.Equal(t, []string{
+			"BindComplete",
+			"DataRow 1",
+			"PortalSuspended",
+			"ReadyForQuery T",
+		}, roundTrip(
+			&pgproto3.Bind{DestinationPortal: "portal", PreparedStatement: "stmt"},
+			&pgproto3.Execute{Portal: "portal", MaxRows: 1},
+		))
+		require.Equal(t, []string{
+			"CommandComplete COMMIT",
+			"ReadyForQuery I",
+		}, roundTrip(&pgproto3.Query{String: "COMMIT;"}))
+		require.Equal(t, []string{
+			"ErrorResponse portal portal does not exist",
+			"ReadyForQuery I",
+		}, roundTrip(&pgproto3.Execute{Portal: "portal"}))
+	})
+
+	t.Run("Portals are destroyed by a Sync outside of a transaction", func(t *testing.T) {
+		require.Equal(t, []string{
+			"BindComplete",
+			"DataRow 1",
+			"PortalSuspended",
+			"ReadyForQuery I",
+		}, roundTrip(
+			&pgproto3.Bind{DestinationPortal: "other", PreparedStatement: "stmt"},
+			&pgproto3.Execute{Portal: "other", MaxRows: 1},
+		))
+		require.Equal(t, []string{
+			"ErrorResponse portal other does not exist",
+			"ReadyForQuery I",
+		}, roundTrip(&pgproto3.Execute{Portal: "other"}))
+	})
+
+	t.Run("Bind parameters in both formats", func(t *testing.T) {
+		require.Equal(t, []string{
+			"ParseComplete",
+			"BindComplete",
+			"DataRow 3",
+			"DataRow 4",
+			"CommandComplete SELECT 2",
+			"ReadyForQuery I",
+		}, roundTrip(
+			&pgproto3.Parse{Query: "SELECT pk FROM test WHERE pk > $1 AND pk < $2 ORDER BY pk;"},
+			&pgproto3.Bind{
+				ParameterFormatCodes: []int16{0, 1},
+				Parameters:           [][]byte{[]byte("2"), {0, 0, 0, 0, 0, 0, 0, 5}},
+			},
+			&pgproto3.Execute{},
+		))
+		require.Equal(t, []string{
+			"ParseComplete",
+			"BindComplete",
+			"DataRow 5",
+			"CommandComplete SELECT 1",
+			"ReadyForQuery I",
+		}, roundTrip(
+			&pgproto3.Parse{Query: "SELECT pk FROM test WHERE pk = $1;"},
+			&pgproto3.Bind{
+				ParameterFormatCodes: []int16{1},
+				Parameters:           [][]byte{{0, 0, 0, 0, 0, 0, 0, 5}},
+			},
+			&pgproto3.Execute{},
+		))
+		require.Equal(t, []string{
+			"ParseComplete",
+			"ErrorResponse incorrect binary data format in bind parameter 1: invalid length for int8: 4",
+			"ReadyForQuery I",
+		}, roundTrip(
+			&pgproto3.Parse{Query: "SELECT pk FROM test WHERE pk = $1;"},
+			&pgproto3.Bind{
+				ParameterFormatCodes: []int16{1},
+				Parameters:           [][]byte{{0, 0, 0, 5}},
+			},
+			&pgproto3.Execute{},
+		))
+	})
+
+	t.Run("Describe a prepared statement", func(t *testing.T) {
+		require.Equal(t, []string{
+			"ParseComplete",
+			"ParameterDescription [20]",
+			"RowDescription pk",
+			"ReadyForQuery I",
+		}, roundTrip(
+			&pgproto3.Parse{Name: "filtered", Query: "SELECT pk FROM test WHERE pk > $1;"},
+			&pgproto3.Describe{ObjectType: 'S', Name: "filtered"},
+		))
+		require.Equal(t, []string{
+			"ErrorResponse prepared statement \"filtered\" already exists",
+			"ReadyForQuery I",
+		}, roundTrip(&pgproto3.Parse{Name: "filtered", Query: "SELECT 1;"}))
+		require.Equal(t, []string{
+			"CloseComplete",
+			"ParseComplete",
+			"ParameterDescription []",
+			"NoData",
+			"ReadyForQuery I",
+		}, roundTrip(
+			&pgproto3.Close{ObjectType: 'S', Name: "filtered"},
+			&pgproto3.Parse{Name: "filtered", Query: "INSERT INTO test VALUES (6);"},
+			&pgproto3.Describe{ObjectType: 'S', Name: "filtered"},
+		))
+	})
+
+	t.Run("PREPARE and EXECUTE share statements with Parse", func(t *testing.T) {
+		require.Equal(t, []string{
+			"ParseComplete",
+			"BindComplete",
+			"NoData",
+			"CommandComplete PREPARE",
+			"ReadyForQuery I",
+		}, roundTrip(
+			&pgproto3.Parse{Query: "PREPARE below (int8) AS SELECT pk FROM test WHERE pk < $1 ORDER BY pk;"},
+			&pgproto3.Bind{},
+			&pgproto3.Describe{ObjectType: 'P'},
+			&pgproto3.Execute{},
+		))
+		require.Equal(t, []string{
+			"ParseComplete",
+			"BindComplete",
+			"RowDescription pk",
+			"DataRow 1",
+			"DataRow 2",
+			"CommandComplete SELECT 2",
+			"ReadyForQuery I",
+		}, roundTrip(
+			&pgproto3.Parse{Query: "EXECUTE below(3);"},
+			&pgproto3.Bind{},
+			&pgproto3.Describe{ObjectType: 'P'},
+			&pgproto3.Execute{},
+		))
+		require.Equal(t, []string{
+			"ParseComplete",
+			"RowDescription pk",
+			"DataRow 3",
+			"DataRow 4",
+			"CommandComplete SELECT 2",
+			"ReadyForQuery I",
+		}, roundTrip(
+			&pgproto3.Parse{Name: "above", Query: "SELECT pk FROM test WHERE pk > $1 ORDER BY pk LIMIT 2;"},
+			&pgproto3.Query{String: "EXECUTE above(2);"},
+		))
+		require.Equal(t, []string{
+			"RowDescription name",
+			"DataRow above",
+			"DataRow below",
+			"CommandComplete SELECT 2",
+			"ReadyForQuery I",
+		}, roundTrip(&pgproto3.Query{String: "SELECT name FROM pg_prepared_statements WHERE name IN ('above', 'below') ORDER BY from_sql;"}))
+	})
+
+	t.Run("Named portals are listed in pg_cursors", func(t *testing.T) {
+		require.Equal(t, []string{
+			"CommandComplete BEGIN",
+			"ReadyForQuery T",
+		}, roundTrip(&pgproto3.Query{String: "BEGIN;"}))
+		require.Equal(t, []string{
+			"ParseComplete",
+			"BindComplete",
+			"ReadyForQuery T",
+		}, roundTrip(
+			&pgproto3.Parse{Name: "cursor_stmt", Query: "SELECT pk FROM test ORDER BY pk;"},
+			&pgproto3.Bind{DestinationPortal: "cursor", PreparedStatement: "cursor_stmt"},
+		))
+		require.Equal(t, []string{
+			"RowDescription name",
+			"DataRow cursor",
+			"CommandComplete SELECT 1",
+			"ReadyForQuery T",
+		}, roundTrip(&pgproto3.Query{String: "SELECT name, statement, is_holdable FROM pg_cursors;"}))
+		require.Equal(t, []string{
+			"CommandComplete COMMIT",
+			"ReadyForQuery I",
+		}, roundTrip(&pgproto3.Query{String: "COMMIT;"}))
+		require.Equal(t, []string{
+			"RowDescription count",
+			"DataRow 0",
+			"CommandComplete SELECT 1",
+			"ReadyForQuery I",
+		}, roundTrip(&pgproto3.Query{String: "SELECT count(*) FROM pg_cursors;"}))
+	})
+}
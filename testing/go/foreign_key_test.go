@@ -0,0 +1,179 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestForeignKeys(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "Column REFERENCES",
+			SetUpScript: []string{
+				"CREATE TABLE parent (pk INT4 PRIMARY KEY, v1 INT4);",
+				"CREATE TABLE child (pk INT4 PRIMARY KEY, parent_pk INT4 REFERENCES parent);",
+				"INSERT INTO parent VALUES (1, 10), (2, 20);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "INSERT INTO child VALUES (1, 1);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "INSERT INTO child VALUES (2, 3);",
+					ExpectedErr: `insert or update on table "child" violates foreign key constraint "child_parent_pk_fkey"`,
+				},
+				{
+					Query:       "DELETE FROM parent WHERE pk = 1;",
+					ExpectedErr: `update or delete on table "parent" violates foreign key constraint "child_parent_pk_fkey" on table "child"`,
+				},
+				{
+					Query:    "DELETE FROM parent WHERE pk = 2;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO child VALUES (3, NULL);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE TABLE no_pk (v1 INT4);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "CREATE TABLE no_pk_child (pk INT4 PRIMARY KEY, v1 INT4 REFERENCES no_pk);",
+					ExpectedErr: `there is no primary key for referenced table "no_pk"`,
+				},
+			},
+		},
+		{
+			Name: "ON DELETE and ON UPDATE actions",
+			SetUpScript: []string{
+				"CREATE TABLE parent (pk INT4 PRIMARY KEY, v1 INT4);",
+				"CREATE TABLE cascade_child (pk INT4 PRIMARY KEY, parent_pk INT4 REFERENCES parent (pk) ON DELETE CASCADE ON UPDATE CASCADE);",
+				"CREATE TABLE set_null_child (pk INT4 PRIMARY KEY, parent_pk INT4, CONSTRAINT set_null_fk FOREIGN KEY (parent_pk) REFERENCES parent (pk) ON DELETE SET NULL ON UPDATE SET NULL);",
+				"CREATE TABLE restrict_child (pk INT4 PRIMARY KEY, parent_pk INT4 REFERENCES parent ON DELETE RESTRICT);",
+				"INSERT INTO parent VALUES (1, 10), (2, 20), (3, 30), (4, 40);",
+				"INSERT INTO cascade_child VALUES (1, 1), (2, 2);",
+				"INSERT INTO set_null_child VALUES (1, 1), (2, 3);",
+				"INSERT INTO restrict_child VALUES (1, 4);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "UPDATE parent SET pk = 5 WHERE pk = 2;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM cascade_child ORDER BY pk;",
+					Expected: []sql.Row{{1, 1}, {2, 5}},
+				},
+				{
+					Query:    "DELETE FROM parent WHERE pk = 5;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM cascade_child ORDER BY pk;",
+					Expected: []sql.Row{{1, 1}},
+				},
+				{
+					Query:    "UPDATE parent SET pk = 6 WHERE pk = 3;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM set_null_child ORDER BY pk;",
+					Expected: []sql.Row{{1, 1}, {2, nil}},
+				},
+				{
+					Query:    "DELETE FROM parent WHERE pk = 1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM cascade_child ORDER BY pk;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM set_null_child ORDER BY pk;",
+					Expected: []sql.Row{{1, nil}, {2, nil}},
+				},
+				{
+					Query:       "DELETE FROM parent WHERE pk = 4;",
+					ExpectedErr: `update or delete on table "parent" violates foreign key constraint "restrict_child_parent_pk_fkey" on table "restrict_child"`,
+				},
+				{
+					Query:       "CREATE TABLE set_default_child (pk INT4 PRIMARY KEY, parent_pk INT4 DEFAULT 4 REFERENCES parent ON DELETE SET DEFAULT);",
+					ExpectedErr: "ON DELETE SET DEFAULT is not yet supported",
+				},
+			},
+		},
+		{
+			Name: "Self-referencing foreign key",
+			SetUpScript: []string{
+				"CREATE TABLE tree (pk INT4 PRIMARY KEY, parent_pk INT4 REFERENCES tree ON DELETE CASCADE);",
+				"INSERT INTO tree VALUES (1, NULL), (2, 1), (3, 2), (4, NULL);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "DELETE FROM tree WHERE pk = 1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM tree;",
+					Expected: []sql.Row{{4, nil}},
+				},
+			},
+		},
+		{
+			Name: "ALTER TABLE ADD FOREIGN KEY",
+			SetUpScript: []string{
+				"CREATE TABLE parent (pk INT4 PRIMARY KEY, v1 INT4);",
+				"CREATE TABLE child (pk INT4 PRIMARY KEY, parent_pk INT4);",
+				"INSERT INTO parent VALUES (1, 10);",
+				"INSERT INTO child VALUES (1, 1), (2, 2);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "ALTER TABLE child ADD FOREIGN KEY (parent_pk) REFERENCES parent ON DELETE CASCADE;",
+					ExpectedErr: `insert or update on table "child" violates foreign key constraint "child_parent_pk_fkey"`,
+				},
+				{
+					Query:    "DELETE FROM child WHERE pk = 2;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER TABLE child ADD FOREIGN KEY (parent_pk) REFERENCES parent ON DELETE CASCADE;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "INSERT INTO child VALUES (2, 2);",
+					ExpectedErr: `insert or update on table "child" violates foreign key constraint "child_parent_pk_fkey"`,
+				},
+				{
+					Query:    "DELETE FROM parent WHERE pk = 1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM child;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "ALTER TABLE child ADD CONSTRAINT child_fk FOREIGN KEY (parent_pk) REFERENCES parent ON UPDATE SET DEFAULT;",
+					ExpectedErr: "ON UPDATE SET DEFAULT is not yet supported",
+				},
+			},
+		},
+	})
+}
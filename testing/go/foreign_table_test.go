@@ -0,0 +1,275 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestForeignTables(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(foreignTableHandler))
+	defer api.Close()
+	createServer := fmt.Sprintf("CREATE SERVER api FOREIGN DATA WRAPPER http_json OPTIONS (url '%s');", api.URL)
+
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "Foreign tables read paginated JSON endpoints",
+			SetUpScript: []string{
+				createServer,
+				"CREATE FOREIGN TABLE users (id INT4, name TEXT OPTIONS (path 'profile.name'), team TEXT OPTIONS (param 'team')) SERVER api OPTIONS (path '/users', page_param 'page');",
+				"CREATE FOREIGN TABLE orders (id INT4, user_id INT4 OPTIONS (path 'user'), total NUMERIC) SERVER api OPTIONS (path '/orders', rows 'data.items', next 'links.next');",
+				"CREATE TABLE notes (user_id INT4 PRIMARY KEY, note TEXT);",
+				"INSERT INTO notes VALUES (1, 'first'), (3, 'third');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT * FROM users ORDER BY id;",
+					Expected: []sql.Row{
+						{1, "alice", "red"},
+						{2, "bob", "blue"},
+						{3, "carol", "red"},
+					},
+				},
+				{
+					Query: "SELECT id, user_id, total FROM orders ORDER BY id;",
+					Expected: []sql.Row{
+						{10, 1, Numeric("12.50")},
+						{11, 3, Numeric("7")},
+						{12, 1, Numeric("3.25")},
+					},
+				},
+				{
+					Query:    "SELECT id, name FROM users WHERE team = 'red' ORDER BY id;",
+					Expected: []sql.Row{{1, "alice"}, {3, "carol"}},
+				},
+				{
+					Query:    "SELECT u.name, n.note FROM users u JOIN notes n ON u.id = n.user_id ORDER BY u.id;",
+					Expected: []sql.Row{{"alice", "first"}, {"carol", "third"}},
+				},
+				{
+					Query:    "SELECT o.id, u.name, o.total FROM users u JOIN orders o ON u.id = o.user_id WHERE u.team = 'red' ORDER BY o.id;",
+					Expected: []sql.Row{{10, "alice", Numeric("12.50")}, {11, "carol", Numeric("7")}, {12, "alice", Numeric("3.25")}},
+				},
+				{
+					Query:       "INSERT INTO users VALUES (4, 'dave', 'blue');",
+					ExpectedErr: `cannot insert into foreign table "users"`,
+				},
+				{
+					Query:       "UPDATE users SET name = 'dave';",
+					ExpectedErr: `cannot update foreign table "users"`,
+				},
+				{
+					Query:       "DELETE FROM users;",
+					ExpectedErr: `cannot delete from foreign table "users"`,
+				},
+				{
+					Query:       "DROP SERVER api;",
+					ExpectedErr: "cannot drop server api because foreign table",
+				},
+				{
+					Query:       "DROP FOREIGN TABLE notes;",
+					ExpectedErr: `"notes" is not a foreign table`,
+				},
+				{
+					Query:    "DROP FOREIGN TABLE users, orders;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT * FROM users;",
+					ExpectedErr: "not found",
+				},
+				{
+					Query:    "DROP SERVER api;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DROP SERVER IF EXISTS api;",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "Foreign tables push equality filters down as query parameters",
+			SetUpScript: []string{
+				createServer,
+				"CREATE FOREIGN TABLE echo (query TEXT, team TEXT OPTIONS (param 'team'), kind TEXT OPTIONS (param 'kind')) SERVER api OPTIONS (path '/echo');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT query FROM echo;",
+					Expected: []sql.Row{{""}},
+				},
+				{
+					Query:    "SELECT query FROM echo WHERE team = 'red';",
+					Expected: []sql.Row{{"team=red"}},
+				},
+				{
+					Query:    "SELECT e.query FROM echo e WHERE 'x' = e.kind AND e.team = 'red';",
+					Expected: []sql.Row{{"kind=x&team=red"}},
+				},
+				{
+					Query:    "SELECT query FROM echo WHERE team = 'red' OR team = 'blue';",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "Foreign servers and tables are validated",
+			SetUpScript: []string{
+				createServer,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "CREATE SERVER other FOREIGN DATA WRAPPER postgres_fdw OPTIONS (host 'localhost');",
+					ExpectedErr: `foreign-data wrapper "postgres_fdw" does not exist`,
+				},
+				{
+					Query:       "CREATE SERVER other FOREIGN DATA WRAPPER http_json OPTIONS (url 'ftp://example.com');",
+					ExpectedErr: `invalid value for option "url"`,
+				},
+				{
+					Query:       "CREATE SERVER other FOREIGN DATA WRAPPER http_json;",
+					ExpectedErr: `option "url" is required`,
+				},
+				{
+					Query:       createServer,
+					ExpectedErr: `server "api" already exists`,
+				},
+				{
+					Query:    fmt.Sprintf("CREATE SERVER IF NOT EXISTS api FOREIGN DATA WRAPPER http_json OPTIONS (url '%s');", api.URL),
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "CREATE FOREIGN TABLE t1 (id INT4) SERVER missing;",
+					ExpectedErr: `server "missing" does not exist`,
+				},
+				{
+					Query:       "CREATE FOREIGN TABLE t1 (id INT4) SERVER api OPTIONS (color 'red');",
+					ExpectedErr: `invalid option "color"`,
+				},
+				{
+					Query:       "CREATE FOREIGN TABLE t1 (id INT4 OPTIONS (path 'a', path 'b')) SERVER api;",
+					ExpectedErr: `option "path" provided more than once`,
+				},
+				{
+					Query:       "CREATE FOREIGN TABLE t1 (id INT4) SERVER api OPTIONS (max_pages '0');",
+					ExpectedErr: `invalid value for option "max_pages"`,
+				},
+				{
+					Query:    "CREATE FOREIGN TABLE t1 (id INT4) SERVER api OPTIONS (path '/missing');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT * FROM t1;",
+					ExpectedErr: "404",
+				},
+				{
+					Query:       "DROP FOREIGN TABLE t2;",
+					ExpectedErr: `foreign table "t2" does not exist`,
+				},
+				{
+					Query:    "DROP FOREIGN TABLE IF EXISTS t2;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "DROP SERVER missing;",
+					ExpectedErr: `server "missing" does not exist`,
+				},
+			},
+		},
+		{
+			Name: "Foreign servers require superuser or ownership",
+			SetUpScript: []string{
+				createServer,
+				"CREATE ROLE alice LOGIN;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "CREATE SERVER other FOREIGN DATA WRAPPER http_json OPTIONS (url 'http://example.com');",
+					Username:    "alice",
+					ExpectedErr: "permission denied for foreign-data wrapper http_json",
+				},
+				{
+					Query:       "DROP SERVER api;",
+					Username:    "alice",
+					ExpectedErr: "must be owner of foreign server api",
+				},
+				{
+					Query:    "DROP SERVER api;",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+	})
+}
+
+// foreignTableHandler serves the endpoints that the foreign table tests read from.
+func foreignTableHandler(w http.ResponseWriter, r *http.Request) {
+	var response any
+	switch r.URL.Path {
+	case "/users":
+		// Pages are numbered, with the page after the last page being empty
+		pages := [][]map[string]any{
+			{
+				{"id": 1, "profile": map[string]any{"name": "alice"}, "team": "red"},
+				{"id": 2, "profile": map[string]any{"name": "bob"}, "team": "blue"},
+			},
+			{
+				{"id": 3, "profile": map[string]any{"name": "carol"}, "team": "red"},
+			},
+		}
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		rows := []map[string]any{}
+		if page >= 1 && page <= len(pages) {
+			for _, row := range pages[page-1] {
+				if team := r.URL.Query().Get("team"); len(team) == 0 || team == row["team"] {
+					rows = append(rows, row)
+				}
+			}
+		}
+		response = rows
+	case "/orders":
+		// Pages link to the page after them until the last page
+		if r.URL.Query().Get("cursor") == "2" {
+			response = map[string]any{"data": map[string]any{"items": []any{
+				map[string]any{"id": 12, "user": 1, "total": 3.25},
+			}}}
+		} else {
+			response = map[string]any{
+				"data": map[string]any{"items": []any{
+					map[string]any{"id": 10, "user": 1, "total": 12.5},
+					map[string]any{"id": 11, "user": 3, "total": 7},
+				}},
+				"links": map[string]any{"next": "/orders?cursor=2"},
+			}
+		}
+	case "/echo":
+		// Returns the query string that the endpoint received, matching any filters that it was given
+		query := r.URL.Query()
+		response = []map[string]any{{"query": query.Encode(), "team": query.Get("team"), "kind": query.Get("kind")}}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
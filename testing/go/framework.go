@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"net/url"
 	"os"
 	"testing"
 	"time"
@@ -28,6 +29,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/utils/svcs"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
@@ -81,6 +83,13 @@ type ScriptTestAssertion struct {
 	// ExpectedTag is used to check the command tag returned from the server.
 	// This is checked only if no Expected is defined
 	ExpectedTag string
+
+	// ExpectedColNames is used to check the column names (labels) returned from the server. This is checked only if
+	// it is not empty, and is checked alongside Expected.
+	ExpectedColNames []string
+
+	// Username is the role that runs the query. When empty, the query is run by the role that ran the setup script.
+	Username string
 }
 
 // RunScript runs the given script.
@@ -129,12 +138,33 @@ func runScript(t *testing.T, ctx context.Context, script ScriptTest, conn *pgx.C
 		require.NoError(t, err)
 	}
 
+	// Connections for the assertions that run as a different role are opened as they're needed
+	userConns := make(map[string]*pgx.Conn)
+	defer func() {
+		for _, userConn := range userConns {
+			_ = userConn.Close(ctx)
+		}
+	}()
+
 	// Run the assertions
 	for _, assertion := range script.Assertions {
 		t.Run(assertion.Query, func(t *testing.T) {
 			if assertion.Skip {
 				t.Skip("Skip has been set in the assertion")
 			}
+			conn := conn
+			if len(assertion.Username) > 0 {
+				userConn, ok := userConns[assertion.Username]
+				if !ok {
+					config := conn.Config().Copy()
+					config.User = assertion.Username
+					var err error
+					userConn, err = pgx.ConnectConfig(ctx, config)
+					require.NoError(t, err)
+					userConns[assertion.Username] = userConn
+				}
+				conn = userConn
+			}
 			// If we're skipping the results check, then we call Execute, as it uses a simplified message model.
 			if assertion.SkipResultsCheck || assertion.ExpectedErr != "" {
 				_, err := conn.Exec(ctx, assertion.Query, assertion.BindVars...)
@@ -152,6 +182,14 @@ func runScript(t *testing.T, ctx context.Context, script ScriptTest, conn *pgx.C
 			} else {
 				rows, err := conn.Query(ctx, assertion.Query, assertion.BindVars...)
 				require.NoError(t, err)
+				if len(assertion.ExpectedColNames) > 0 {
+					fields := rows.FieldDescriptions()
+					colNames := make([]string, len(fields))
+					for i := range fields {
+						colNames[i] = fields[i].Name
+					}
+					assert.Equal(t, assertion.ExpectedColNames, colNames)
+				}
 				readRows, err := ReadRows(rows, normalizeRows)
 				require.NoError(t, err)
 				if normalizeRows {
@@ -248,6 +286,136 @@ func CreateServer(t *testing.T, database string) (context.Context, *pgx.Conn, *s
 	return ctx, conn, controller
 }
 
+// StartServer starts an embedded server using the given config, which is stopped once the test has finished. Unless the
+// config states otherwise, the server listens on an unused port of the loopback address, and keeps its data and
+// configuration within temporary directories.
+func StartServer(t *testing.T, cfg *servercfg.DoltgresConfig) *dserver.Server {
+	srv, err := TryStartServer(t, cfg)
+	require.NoError(t, err)
+	return srv
+}
+
+// TryStartServer starts a server in the same way as StartServer, returning the error when the server fails to start.
+func TryStartServer(t *testing.T, cfg *servercfg.DoltgresConfig) (*dserver.Server, error) {
+	var serverCfg servercfg.DoltgresConfig
+	if cfg != nil {
+		serverCfg = *cfg
+	}
+	var listenerCfg servercfg.DoltgresListenerConfig
+	if serverCfg.ListenerConfig != nil {
+		listenerCfg = *serverCfg.ListenerConfig
+	}
+	if listenerCfg.PortNumber == nil {
+		listenerCfg.PortNumber = ptr(0)
+	}
+	if listenerCfg.HostStr == nil {
+		listenerCfg.HostStr = ptr("127.0.0.1")
+	}
+	serverCfg.ListenerConfig = &listenerCfg
+	if serverCfg.DataDirStr == nil {
+		serverCfg.DataDirStr = ptr(t.TempDir())
+	}
+	if serverCfg.CfgDirStr == nil {
+		serverCfg.CfgDirStr = ptr(t.TempDir())
+	}
+	srv, err := dserver.NewServer(&serverCfg)
+	if err != nil {
+		return nil, err
+	}
+	if err = srv.Start(context.Background()); err != nil {
+		return nil, err
+	}
+	t.Cleanup(func() {
+		require.NoError(t, srv.Stop())
+	})
+	return srv, nil
+}
+
+// Connect returns a connection to the given database of the server as the default user, which is closed once the test
+// has finished.
+func Connect(t *testing.T, srv *dserver.Server, database string) *pgx.Conn {
+	conn, err := ConnectAs(t, srv, "postgres", "password", database)
+	require.NoError(t, err)
+	return conn
+}
+
+// ConnectAs returns a connection to the given database of the server as the given user, which is closed once the test
+// has finished. Returns the error when the connection fails.
+func ConnectAs(t *testing.T, srv *dserver.Server, user string, password string, database string) (*pgx.Conn, error) {
+	return connectWithNoticeHandler(t, srv, user, password, database, nil)
+}
+
+// ConnectWithNoticeHandler returns a connection to the given database of the server as the default user, which passes
+// every notice that it receives to the given handler. The connection is closed once the test has finished.
+func ConnectWithNoticeHandler(t *testing.T, srv *dserver.Server, database string, handler pgconn.NoticeHandler) *pgx.Conn {
+	conn, err := connectWithNoticeHandler(t, srv, "postgres", "password", database, handler)
+	require.NoError(t, err)
+	return conn
+}
+
+// connectWithNoticeHandler returns a connection in the same way as ConnectAs, using the given notice handler if one
+// is given.
+func connectWithNoticeHandler(t *testing.T, srv *dserver.Server, user string, password string, database string, handler pgconn.NoticeHandler) (*pgx.Conn, error) {
+	ctx := context.Background()
+	connURL := url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(user, password),
+		Host:   fmt.Sprintf("127.0.0.1:%d", srv.Port()),
+		Path:   "/" + database,
+	}
+	config, err := pgx.ParseConfig(connURL.String())
+	if err != nil {
+		return nil, err
+	}
+	config.OnNotice = handler
+	conn, err := pgx.ConnectConfig(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	t.Cleanup(func() {
+		_ = conn.Close(ctx)
+	})
+	return conn, nil
+}
+
+// ExecQueries runs each of the given queries in order, requiring that they succeed.
+func ExecQueries(t *testing.T, conn *pgx.Conn, queries ...string) {
+	for _, query := range queries {
+		_, err := conn.Exec(context.Background(), query)
+		require.NoError(t, err, query)
+	}
+}
+
+// QueryRow runs the given query, returning the values of its first row. The query must return at least one row.
+func QueryRow(t *testing.T, conn *pgx.Conn, query string) []any {
+	rows := QueryRows(t, conn, query)
+	require.NotEmpty(t, rows, query)
+	return rows[0]
+}
+
+// QueryRows runs the given query, returning the values of every row.
+func QueryRows(t *testing.T, conn *pgx.Conn, query string) [][]any {
+	rows, err := conn.Query(context.Background(), query)
+	require.NoError(t, err, query)
+	defer rows.Close()
+	var values [][]any
+	for rows.Next() {
+		row, err := rows.Values()
+		require.NoError(t, err, query)
+		values = append(values, row)
+	}
+	require.NoError(t, rows.Err(), query)
+	return values
+}
+
+// RequireErrorCode runs the given query, requiring that it fails with the given SQLSTATE code.
+func RequireErrorCode(t *testing.T, conn *pgx.Conn, query string, code string) {
+	_, err := conn.Exec(context.Background(), query)
+	var pgErr *pgconn.PgError
+	require.ErrorAs(t, err, &pgErr, query)
+	require.Equal(t, code, pgErr.Code, query)
+}
+
 // ReadRows reads all of the given rows into a slice, then closes the rows. If `normalizeRows` is true, then the rows
 // will be normalized such that all integers are int64, etc.
 func ReadRows(rows pgx.Rows, normalizeRows bool) (readRows []sql.Row, err error) {
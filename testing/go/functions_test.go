@@ -153,3 +153,605 @@ func TestFunctionsMath(t *testing.T) {
 		},
 	})
 }
+
+// https://www.postgresql.org/docs/15/functions-json.html
+func TestFunctionsJSON(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "jsonb_set",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT jsonb_set('[{"f1":1,"f2":null},2,null,3]', '{0,f1}', '[2,3,4]', false);`,
+					Expected: []sql.Row{{`[{"f1": [2, 3, 4], "f2": null}, 2, null, 3]`}},
+				},
+				{
+					Query:    `SELECT jsonb_set('[{"f1":1,"f2":null},2]', '{0,f3}', '[2,3,4]');`,
+					Expected: []sql.Row{{`[{"f1": 1, "f2": null, "f3": [2, 3, 4]}, 2]`}},
+				},
+				{
+					Query:    `SELECT jsonb_set('[{"f1":1,"f2":null},2]', '{0,f3}', '[2,3,4]', false);`,
+					Expected: []sql.Row{{`[{"f1": 1, "f2": null}, 2]`}},
+				},
+				{
+					Query:    `SELECT jsonb_set('{"a":1}', '{bb}', '2'), jsonb_set('{"a":1}', '{b,c}', '2');`,
+					Expected: []sql.Row{{`{"a": 1, "bb": 2}`, `{"a": 1}`}},
+				},
+				{
+					Query:    `SELECT jsonb_set('[1,2,3]', '{-1}', '4'), jsonb_set('[1,2,3]', '{10}', '4'), jsonb_set('[1,2,3]', '{-10}', '4');`,
+					Expected: []sql.Row{{`[1, 2, 4]`, `[1, 2, 3, 4]`, `[4, 1, 2, 3]`}},
+				},
+				{
+					Query:    `SELECT jsonb_set('[1,2,3]', '{10}', '4', false);`,
+					Expected: []sql.Row{{`[1, 2, 3]`}},
+				},
+				{
+					Query:       `SELECT jsonb_set('1', '{a}', '2');`,
+					ExpectedErr: "cannot set path in scalar",
+				},
+				{
+					Query:       `SELECT jsonb_set('[1,2,3]', '{a}', '2');`,
+					ExpectedErr: `path element at position 1 is not an integer: "a"`,
+				},
+				{
+					Query:       `SELECT jsonb_set('{"a":1}', ARRAY['a', NULL], '2');`,
+					ExpectedErr: "path element at position 2 is null",
+				},
+			},
+		},
+		{
+			Name: "jsonb_insert",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT jsonb_insert('{"a": [0,1,2]}', '{a, 1}', '"new_value"');`,
+					Expected: []sql.Row{{`{"a": [0, "new_value", 1, 2]}`}},
+				},
+				{
+					Query:    `SELECT jsonb_insert('{"a": [0,1,2]}', '{a, 1}', '"new_value"', true);`,
+					Expected: []sql.Row{{`{"a": [0, 1, "new_value", 2]}`}},
+				},
+				{
+					Query:    `SELECT jsonb_insert('{"a": [0,1,2]}', '{a, -1}', '"new_value"', true);`,
+					Expected: []sql.Row{{`{"a": [0, 1, 2, "new_value"]}`}},
+				},
+				{
+					Query:    `SELECT jsonb_insert('{"a": [0,1,2]}', '{b}', '"new_value"');`,
+					Expected: []sql.Row{{`{"a": [0, 1, 2], "b": "new_value"}`}},
+				},
+				{
+					Query:       `SELECT jsonb_insert('{"a": [0,1,2]}', '{a}', '"new_value"');`,
+					ExpectedErr: "cannot replace existing key",
+				},
+			},
+		},
+		{
+			Name: "jsonb_strip_nulls",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT jsonb_strip_nulls('[{"f1":1, "f2":null}, 2, null, 3]');`,
+					Expected: []sql.Row{{`[{"f1": 1}, 2, null, 3]`}},
+				},
+				{
+					Query:    `SELECT json_strip_nulls('{"a": {"b": null, "c": 1}, "d": null}');`,
+					Expected: []sql.Row{{`{"a": {"c": 1}}`}},
+				},
+			},
+		},
+		{
+			Name: "jsonb_pretty",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT jsonb_pretty('[{"f1":1,"f2":null}, 2]');`,
+					Expected: []sql.Row{{"[\n    {\n        \"f1\": 1,\n        \"f2\": null\n    },\n    2\n]"}},
+				},
+				{
+					Query:    `SELECT jsonb_pretty('{"a": {}, "b": []}'), jsonb_pretty('"str"');`,
+					Expected: []sql.Row{{"{\n    \"a\": {\n    },\n    \"b\": [\n    ]\n}", `"str"`}},
+				},
+			},
+		},
+		{
+			Name: "json_extract_path",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT json_extract_path('{"f2":{"f3":1},"f4":{"f5":99,"f6":"foo"}}', 'f4', 'f6');`,
+					Expected: []sql.Row{{`"foo"`}},
+				},
+				{
+					Query:    `SELECT jsonb_extract_path('{"f2":{"f3":1},"f4":{"f5":99,"f6":"foo"}}', 'f4');`,
+					Expected: []sql.Row{{`{"f5": 99, "f6": "foo"}`}},
+				},
+				{
+					Query:    `SELECT json_extract_path_text('{"f2":{"f3":1},"f4":{"f5":99,"f6":"foo"}}', 'f4', 'f6');`,
+					Expected: []sql.Row{{"foo"}},
+				},
+				{
+					Query:    `SELECT jsonb_extract_path_text('{"a": [1, 2, {"b": "c"}]}', 'a', '-1', 'b');`,
+					Expected: []sql.Row{{"c"}},
+				},
+				{
+					Query:    `SELECT jsonb_extract_path('{"a": [1, 2]}', 'a', '5'), jsonb_extract_path('{"a": [1, 2]}', 'a', '-5');`,
+					Expected: []sql.Row{{nil, nil}},
+				},
+				{
+					Query:    `SELECT '[1, 2]'::jsonb #> '{5}', '[1, 2]'::jsonb #> '{-1}';`,
+					Expected: []sql.Row{{nil, "2"}},
+				},
+				{
+					Query:    `SELECT jsonb_extract_path_text('{"a": null}', 'a');`,
+					Expected: []sql.Row{{nil}},
+				},
+			},
+		},
+		{
+			Name: "jsonb_path functions",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT jsonb_path_exists('{"a":[1,2,3,4,5]}', '$.a[*] ? (@ >= $min && @ <= $max)', '{"min":2, "max":4}');`,
+					Expected: []sql.Row{{"t"}},
+				},
+				{
+					Query:    `SELECT jsonb_path_exists('{"a":[1,2,3]}', '$.a[*] ? (@ > 5)');`,
+					Expected: []sql.Row{{"f"}},
+				},
+				{
+					Query:    `SELECT jsonb_path_match('{"a":[1,2,3,4,5]}', 'exists($.a[*] ? (@ >= $min && @ <= $max))', '{"min":2, "max":4}');`,
+					Expected: []sql.Row{{"t"}},
+				},
+				{
+					Query:       `SELECT jsonb_path_match('{"a":[1,2]}', '$.a');`,
+					ExpectedErr: "single boolean result is expected",
+				},
+				{
+					Query:    `SELECT jsonb_path_query_array('{"a":[1,2,3,4,5]}', '$.a[*] ? (@ >= $min && @ <= $max)', '{"min":2, "max":4}');`,
+					Expected: []sql.Row{{"[2, 3, 4]"}},
+				},
+				{
+					Query:    `SELECT jsonb_path_query_first('{"a":[1,2,3,4,5]}', '$.a[*] ? (@ >= $min && @ <= $max)', '{"min":2, "max":4}');`,
+					Expected: []sql.Row{{"2"}},
+				},
+				{
+					Query:    `SELECT jsonb_path_query_first('{"a":[1,2]}', '$.b');`,
+					Expected: []sql.Row{{nil}},
+				},
+				{
+					Query:    `SELECT jsonb_path_query_array('{"a":[1,2,3,4]}', '$.a[1 to last]'), jsonb_path_query_array('{"a":[1,2,3,4]}', '$.a.size()');`,
+					Expected: []sql.Row{{"[2, 3, 4]", "[4]"}},
+				},
+				{
+					Query:    `SELECT jsonb_path_query_array('{"a":{"b":{"c":1}},"c":2}', '$.**.c'), jsonb_path_query_array('[1, "a", null]', '$[*].type()');`,
+					Expected: []sql.Row{{"[2, 1]", `["number", "string", "null"]`}},
+				},
+				{
+					Query:    `SELECT jsonb_path_query_array('{"items":[{"n":"apple"},{"n":"banana"}]}', '$.items[*] ? (@.n starts with "b" || @.n like_regex "^A" flag "i").n');`,
+					Expected: []sql.Row{{`["apple", "banana"]`}},
+				},
+				{
+					Query:    `SELECT jsonb_path_query_first('{"a":[1,2]}', '$.a[0] + $.a[1] * 2');`,
+					Expected: []sql.Row{{"5"}},
+				},
+				{
+					Query:       `SELECT jsonb_path_query_first('{"a":1}', 'strict $.b');`,
+					ExpectedErr: `JSON object does not contain key "b"`,
+				},
+				{
+					Query:    `SELECT jsonb_path_query_first('{"a":1}', 'strict $.b', '{}', true), jsonb_path_exists('{"a":1}', 'strict $.b', '{}', true);`,
+					Expected: []sql.Row{{nil, nil}},
+				},
+				{
+					Query:       `SELECT jsonb_path_exists('{"a":1}', '$.a ? (@ >');`,
+					ExpectedErr: "syntax error at end of jsonpath input",
+				},
+				{
+					Query:       `SELECT jsonb_path_exists('{"a":1}', '$.a ? (@ > $x)');`,
+					ExpectedErr: `could not find jsonpath variable "x"`,
+				},
+			},
+		},
+		{
+			Name: "json set-returning and record functions",
+			SetUpScript: []string{
+				`CREATE TABLE jpr (a INT4, b TEXT);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT * FROM json_each('{"a":"foo", "b":"bar"}');`,
+					Expected: []sql.Row{{"a", `"foo"`}, {"b", `"bar"`}},
+				},
+				{
+					Query:    `SELECT * FROM jsonb_each('{"a":"foo", "b":{"c":1}}');`,
+					Expected: []sql.Row{{"a", `"foo"`}, {"b", `{"c": 1}`}},
+				},
+				{
+					Query:    `SELECT * FROM json_each_text('{"a":"foo", "b":"bar"}');`,
+					Expected: []sql.Row{{"a", "foo"}, {"b", "bar"}},
+				},
+				{
+					Query:    `SELECT * FROM jsonb_array_elements('[1, true, [2, false]]');`,
+					Expected: []sql.Row{{"1"}, {"true"}, {"[2, false]"}},
+				},
+				{
+					Query:    `SELECT * FROM json_to_record('{"a":1,"b":"foo"}') AS x(a INT4, b TEXT);`,
+					Expected: []sql.Row{{1, "foo"}},
+				},
+				{
+					Query:    `SELECT * FROM json_populate_record(NULL::jpr, '{"a":1,"b":"foo","c":true}');`,
+					Expected: []sql.Row{{1, "foo"}},
+				},
+				{
+					Query:    `SELECT * FROM jsonb_populate_recordset(NULL::jpr, '[{"a":1,"b":"foo"},{"a":3}]') AS x(c1);`,
+					Expected: []sql.Row{{1, "foo"}, {3, nil}},
+				},
+				{
+					Query:    `SELECT c1, b FROM jsonb_populate_recordset(NULL::jpr, '[{"a":2,"b":"bar"}]') AS x(c1);`,
+					Expected: []sql.Row{{2, "bar"}},
+				},
+				{
+					Query:       `SELECT * FROM json_populate_record(NULL::missing, '{"a":1}');`,
+					ExpectedErr: `type "missing" does not exist`,
+				},
+				{
+					Query:       `SELECT * FROM json_populate_record(ROW(1, 'foo')::jpr, '{"a":1}');`,
+					ExpectedErr: "json_populate_record with a non-NULL base row is not yet supported",
+				},
+			},
+		},
+		{
+			Name: "jsonpath operators",
+			SetUpScript: []string{
+				`CREATE TABLE docs (id INT8 PRIMARY KEY, doc JSONB);`,
+				`INSERT INTO docs VALUES (1, '{"a":[1,2,3]}'), (2, '{"a":[4,5]}'), (3, '{"b":true}');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT id FROM docs WHERE doc @? '$.a[*] ? (@ > 3)' ORDER BY id;`,
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:    `SELECT id FROM docs WHERE doc @@ '$.a[*] > 2' ORDER BY id;`,
+					Expected: []sql.Row{{1}, {2}},
+				},
+				{
+					Query:    `SELECT '{"a":1}'::jsonb @@ 'strict $.b == 1', '{"a":1}'::jsonb @? 'strict $.b';`,
+					Expected: []sql.Row{{nil, nil}},
+				},
+			},
+		},
+	})
+}
+
+func TestFunctionsArray(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "array dimensions",
+			SetUpScript: []string{
+				`CREATE TABLE arrs (id INT4 PRIMARY KEY, v INT4[]);`,
+				`INSERT INTO arrs VALUES (1, ARRAY[1, 2, 3]), (2, '{}'), (3, NULL);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT id, array_length(v, 1), array_lower(v, 1), array_upper(v, 1), cardinality(v) FROM arrs ORDER BY id;`,
+					Expected: []sql.Row{{1, 3, 1, 3, 3}, {2, nil, nil, nil, 0}, {3, nil, nil, nil, nil}},
+				},
+				{
+					Query:    `SELECT array_length(v, 2), array_upper(v, 0) FROM arrs WHERE id = 1;`,
+					Expected: []sql.Row{{nil, nil}},
+				},
+				{
+					Query:    `SELECT array_length(ARRAY['a', 'b'], 1), cardinality(ARRAY[1.5, 2.5]);`,
+					Expected: []sql.Row{{2, 2}},
+				},
+			},
+		},
+		{
+			Name: "array_position and array_positions",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT array_position(ARRAY['sun', 'mon', 'tue', 'mon'], 'mon'), array_position(ARRAY['sun', 'mon', 'tue', 'mon'], 'mon', 3);`,
+					Expected: []sql.Row{{2, 4}},
+				},
+				{
+					Query:    `SELECT array_position(ARRAY[1, 2, 3], 4), array_position(ARRAY[1, NULL, 3], NULL);`,
+					Expected: []sql.Row{{nil, 2}},
+				},
+				{
+					Query:    `SELECT array_positions(ARRAY[1, 4, 3, 1, 3, 4, 2, 1], 1), array_positions(ARRAY[1, 2], 5);`,
+					Expected: []sql.Row{{"{1,4,8}", "{}"}},
+				},
+				{
+					Query:       `SELECT array_position(ARRAY[1, 2], 1, NULL);`,
+					ExpectedErr: "initial position must not be null",
+				},
+			},
+		},
+		{
+			Name: "array_remove, array_replace, and array_cat",
+			SetUpScript: []string{
+				`CREATE TABLE tags (id INT4 PRIMARY KEY, v TEXT[]);`,
+				`INSERT INTO tags VALUES (1, ARRAY['a', 'b', 'a']);`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT array_remove(ARRAY[1, 2, 3, 2], 2), array_remove(ARRAY[1, NULL, 3], NULL);`,
+					Expected: []sql.Row{{"{1,3}", "{1,3}"}},
+				},
+				{
+					Query:    `SELECT array_replace(ARRAY[1, 2, 5, 4], 5, 3), array_replace(v, 'a', 'z') FROM tags;`,
+					Expected: []sql.Row{{"{1,2,3,4}", "{z,b,z}"}},
+				},
+				{
+					Query:    `SELECT array_cat(ARRAY[1, 2], ARRAY[3, 4]), array_cat(v, ARRAY['c']), array_cat(NULL, ARRAY[5]) FROM tags;`,
+					Expected: []sql.Row{{"{1,2,3,4}", "{a,b,a,c}", "{5}"}},
+				},
+				{
+					Query:    `UPDATE tags SET v = array_remove(v, 'a') WHERE id = 1;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    `SELECT v FROM tags;`,
+					Expected: []sql.Row{{"{b}"}},
+				},
+			},
+		},
+		{
+			Name: "array_to_string and string_to_array",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT array_to_string(ARRAY[1, 2, 3, NULL, 5], ','), array_to_string(ARRAY[1, 2, 3, NULL, 5], ',', '*');`,
+					Expected: []sql.Row{{"1,2,3,5", "1,2,3,*,5"}},
+				},
+				{
+					Query:    `SELECT array_to_string(ARRAY['a', 'b'], NULL);`,
+					Expected: []sql.Row{{nil}},
+				},
+				{
+					Query:    `SELECT string_to_array('xx~~yy~~zz', '~~'), string_to_array('xx~~yy~~zz', '~~', 'yy');`,
+					Expected: []sql.Row{{"{xx,yy,zz}", "{xx,NULL,zz}"}},
+				},
+				{
+					Query:    `SELECT string_to_array('abc', NULL), string_to_array('abc', ''), string_to_array('', ',');`,
+					Expected: []sql.Row{{"{a,b,c}", "{abc}", "{}"}},
+				},
+				{
+					Query:    `SELECT array_length(string_to_array('1,2,3', ','), 1), array_to_string(string_to_array('1,2,3', ','), '|');`,
+					Expected: []sql.Row{{3, "1|2|3"}},
+				},
+			},
+		},
+	})
+}
+
+func TestFunctionsInterval(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "interval arithmetic",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT (interval '1 hour' * 3.5)::text, (2 * interval '1 day 1 hour')::text, (interval '1 month' * 1.5)::text;`,
+					Expected: []sql.Row{{"03:30:00", "2 days 02:00:00", "1 mon 15 days"}},
+				},
+				{
+					Query:    `SELECT (interval '1 day' / 4)::text, (interval '1 year' / 5)::text, (interval '-3 hours' / 2)::text;`,
+					Expected: []sql.Row{{"06:00:00", "2 mons 12 days", "-01:30:00"}},
+				},
+				{
+					Query:       `SELECT interval '1 day' / 0;`,
+					ExpectedErr: "division by zero",
+				},
+				{
+					Query:    `SELECT interval '1 day' * NULL, interval '1 day' / NULL;`,
+					Expected: []sql.Row{{nil, nil}},
+				},
+			},
+		},
+		{
+			Name: "extract and date_part",
+			SetUpScript: []string{
+				`CREATE TABLE durations (id INT4 PRIMARY KEY, d INTERVAL);`,
+				`INSERT INTO durations VALUES (1, '1 year 2 months 3 days 04:05:06.5'), (2, '-90 minutes');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT id, extract(epoch FROM d), date_part('epoch', d) FROM durations ORDER BY id;`,
+					Expected: []sql.Row{{1, 37015506.5, 37015506.5}, {2, -5400.0, -5400.0}},
+				},
+				{
+					Query:    `SELECT extract(year FROM d), extract(month FROM d), extract(day FROM d), extract(hour FROM d), extract(minute FROM d), extract(second FROM d) FROM durations WHERE id = 1;`,
+					Expected: []sql.Row{{1.0, 2.0, 3.0, 4.0, 5.0, 6.5}},
+				},
+				{
+					Query:    `SELECT date_part('hour', d), date_part('minute', d) FROM durations WHERE id = 2;`,
+					Expected: []sql.Row{{-1.0, -30.0}},
+				},
+				{
+					Query:       `SELECT extract(dow FROM interval '1 day');`,
+					ExpectedErr: `unit "dow" not supported for type interval`,
+				},
+				{
+					Query:       `SELECT date_part('fortnight', interval '1 day');`,
+					ExpectedErr: `unit "fortnight" not recognized for type interval`,
+				},
+			},
+		},
+		{
+			Name: "justify functions",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    `SELECT justify_days(interval '35 days')::text, justify_days(interval '1 month -35 days')::text, justify_days(interval '-1 month 10 days')::text;`,
+					Expected: []sql.Row{{"1 mon 5 days", "-5 days", "-20 days"}},
+				},
+				{
+					Query:    `SELECT justify_hours(interval '27 hours')::text, justify_hours(interval '1 day -1 hour')::text, justify_hours(interval '-50 hours')::text;`,
+					Expected: []sql.Row{{"1 day 03:00:00", "23:00:00", "-2 days -02:00:00"}},
+				},
+				{
+					Query:    `SELECT justify_interval(interval '1 mon -1 hour')::text, justify_interval(interval '75 days 30 hours')::text;`,
+					Expected: []sql.Row{{"29 days 23:00:00", "2 mons 16 days 06:00:00"}},
+				},
+				{
+					Query:    `SELECT justify_interval(NULL), justify_days(NULL), justify_hours(NULL);`,
+					Expected: []sql.Row{{nil, nil, nil}},
+				},
+			},
+		},
+	})
+}
+
+func TestFunctionsRecord(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "functions returning record in FROM",
+			SetUpScript: []string{
+				`CREATE TABLE test (pk INT primary key, doc JSONB);`,
+				`INSERT INTO test VALUES (1, '{"a": 1, "b": "x"}'), (2, '{"a": 2, "c": [1, 2]}');`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `SELECT * FROM jsonb_to_record('{"a": 1, "b": "x", "c": [1, 2], "d": {"e": null}}') AS x(a int, b text, c int[], d jsonb, f text);`,
+					Expected: []sql.Row{
+						{1, "x", "{1,2}", `{"e": null}`, nil},
+					},
+				},
+				{
+					Query: `SELECT x.b, x.a FROM json_to_record('{"a": 5, "b": null}') AS x(a int, b text);`,
+					Expected: []sql.Row{
+						{nil, 5},
+					},
+				},
+				{
+					Query: `SELECT * FROM jsonb_to_recordset('[{"a": 1, "b": "x"}, {"a": 2}]') AS x(a int, b text) ORDER BY a DESC;`,
+					Expected: []sql.Row{
+						{2, nil},
+						{1, "x"},
+					},
+				},
+				{
+					Query: `SELECT * FROM jsonb_each('{"a": 1, "b": [true, null]}');`,
+					Expected: []sql.Row{
+						{"a", "1"},
+						{"b", "[true, null]"},
+					},
+				},
+				{
+					Query: `SELECT k, v FROM jsonb_each_text('{"a": "str", "b": null}') AS e(k, v);`,
+					Expected: []sql.Row{
+						{"a", "str"},
+						{"b", nil},
+					},
+				},
+				{
+					Query: `SELECT * FROM json_each('{"a": {"b": 2}}') e;`,
+					Expected: []sql.Row{
+						{"a", `{"b": 2}`},
+					},
+				},
+				{
+					Query: `SELECT (jsonb_each('{"a": 1, "b": 2}')).*;`,
+					Expected: []sql.Row{
+						{"a", "1"},
+						{"b", "2"},
+					},
+				},
+				{
+					Query: `SELECT (jsonb_each_text('{"a": 1}')).value;`,
+					Expected: []sql.Row{
+						{"1"},
+					},
+				},
+				{
+					Query: `SELECT * FROM abs(-3);`,
+					Expected: []sql.Row{
+						{3},
+					},
+				},
+				{
+					Query: `SELECT x FROM abs(-3) AS x;`,
+					Expected: []sql.Row{
+						{3},
+					},
+				},
+				{
+					Query: `SELECT t.pk, r.a FROM test t, LATERAL jsonb_to_record(t.doc) AS r(a int) ORDER BY t.pk;`,
+					Expected: []sql.Row{
+						{1, 1},
+						{2, 2},
+					},
+				},
+				{
+					Query: `SELECT ROW(1, 'a b', NULL, '', 'q"t');`,
+					Expected: []sql.Row{
+						{`(1,"a b",,"","q""t")`},
+					},
+				},
+				{
+					Query: `SELECT ROW(pk, doc->'a') FROM test ORDER BY pk;`,
+					Expected: []sql.Row{
+						{"(1,1)"},
+						{"(2,2)"},
+					},
+				},
+				{
+					Query:       `SELECT * FROM jsonb_to_record('{"a": 1}');`,
+					ExpectedErr: `a column definition list is required for functions returning "record"`,
+				},
+				{
+					Query:       `SELECT * FROM jsonb_each('{"a": 1}') AS x(k text, v jsonb);`,
+					ExpectedErr: `a column definition list is redundant for a function with OUT parameters`,
+				},
+				{
+					Query:       `SELECT * FROM abs(-3) AS x(a int);`,
+					ExpectedErr: `a column definition list is only allowed for functions returning "record"`,
+				},
+				{
+					Query:       `SELECT * FROM jsonb_each('{"a": 1}') AS x(a, b, c);`,
+					ExpectedErr: `table "x" has 2 columns available but 3 columns specified`,
+				},
+				{
+					Query:       `SELECT * FROM jsonb_to_record('[1]') AS x(a int);`,
+					ExpectedErr: `cannot call jsonb_to_record on a non-object`,
+				},
+				{
+					Query:       `SELECT jsonb_each('{"a": 1}');`,
+					ExpectedErr: `set-valued function called in context that cannot accept a set`,
+				},
+				{
+					Query:       `SELECT jsonb_to_record('{"a": 1}');`,
+					ExpectedErr: `function returning record called in context that cannot accept type record`,
+				},
+			},
+		},
+	})
+}
+
+func TestFunctionsAggregateAndWindow(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "aggregate and window functions",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, grp INT4, v TEXT);",
+				"INSERT INTO test VALUES (1, 1, 'one'), (2, 1, 'two'), (3, 2, 'three');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT sum(pk), min(pk), max(pk) FROM test;",
+					Expected: []sql.Row{{6.0, 1, 3}},
+				},
+				{
+					Query:    "SELECT grp, sum(pk) FROM test GROUP BY grp ORDER BY grp;",
+					Expected: []sql.Row{{1, 3.0}, {2, 3.0}},
+				},
+				{
+					Query:    "SELECT pk, row_number() OVER (ORDER BY pk DESC) FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, 3}, {2, 2}, {3, 1}},
+				},
+				{
+					Query:    "SELECT pk, row_number() OVER (PARTITION BY grp ORDER BY pk) FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, 1}, {2, 2}, {3, 1}},
+				},
+				{
+					Query:    "SELECT pk, lag(pk) OVER (ORDER BY pk), first_value(v) OVER (PARTITION BY grp ORDER BY pk) FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, nil, "one"}, {2, 1, "one"}, {3, 2, "three"}},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,133 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/doltgresql/server/health"
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+// probeHealth sends a GET request to the health endpoint at the given path, returning the status code and response.
+func probeHealth(t *testing.T, port int, path string) (int, health.Response) {
+	resp, err := http.Get(fmt.Sprintf("http://127.0.0.1:%d%s", port, path))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var response health.Response
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+	return resp.StatusCode, response
+}
+
+func TestHealthEndpoints(t *testing.T) {
+	healthPort := GetUnusedPort(t)
+	srv := StartServer(t, &servercfg.DoltgresConfig{
+		BehaviorConfig: &servercfg.DoltgresBehaviorConfig{
+			InMemory: ptr(true),
+		},
+		Health: &servercfg.DoltgresHealthConfig{
+			Port: &healthPort,
+			Host: ptr("127.0.0.1"),
+		},
+	})
+
+	status, response := probeHealth(t, healthPort, health.LivenessPath)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, health.Response{
+		Status: health.StatusOK,
+		Checks: map[string]string{"listener": health.StatusOK, "storage": health.StatusOK},
+	}, response)
+	status, response = probeHealth(t, healthPort, health.ReadinessPath)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, health.Response{
+		Status: health.StatusOK,
+		Checks: map[string]string{"listener": health.StatusOK, "storage": health.StatusOK, "startup": health.StatusOK},
+	}, response)
+
+	// Probing the listener does not disturb the server
+	ExecQueries(t, Connect(t, srv, "doltgres"), "SELECT 1;")
+
+	resp, err := http.Post(fmt.Sprintf("http://127.0.0.1:%d%s", healthPort, health.ReadinessPath), "", nil)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	resp, err = http.Get(fmt.Sprintf("http://127.0.0.1:%d/missing", healthPort))
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestHealthReadReplica(t *testing.T) {
+	remotesDir := t.TempDir()
+	// Only one server may run at a time, so the primary is stopped before the replica starts
+	primary := StartServer(t, nil)
+	ExecQueries(t, Connect(t, primary, ""), "CREATE DATABASE replicadb;")
+	ExecQueries(t, Connect(t, primary, "replicadb"),
+		"CREATE TABLE test (pk INT4 PRIMARY KEY);",
+		"SELECT dolt_commit('-Am', 'initial');",
+		fmt.Sprintf("SELECT dolt_remote('add', 'origin', 'file://%s');", filepath.ToSlash(filepath.Join(remotesDir, "replicadb"))),
+		"SELECT dolt_push('origin', 'main');",
+	)
+	require.NoError(t, primary.Stop())
+
+	healthPort := GetUnusedPort(t)
+	StartServer(t, &servercfg.DoltgresConfig{
+		ReadReplica: &servercfg.DoltgresReadReplicaConfig{
+			RemoteURL: ptr("file://" + filepath.ToSlash(remotesDir) + "/{database}"),
+			Databases: []string{"replicadb"},
+			Interval:  ptr("1h"),
+		},
+		Health: &servercfg.DoltgresHealthConfig{
+			Port:              &healthPort,
+			Host:              ptr("127.0.0.1"),
+			MaxReplicationLag: ptr("2h"),
+		},
+	})
+
+	status, response := probeHealth(t, healthPort, health.ReadinessPath)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, health.StatusOK, response.Checks["read_replica"])
+	assert.Equal(t, health.StatusOK, response.Checks["startup"])
+	status, _ = probeHealth(t, healthPort, health.LivenessPath)
+	assert.Equal(t, http.StatusOK, status)
+}
+
+func TestHealthConfig(t *testing.T) {
+	for _, cfg := range []*servercfg.DoltgresConfig{
+		{Health: &servercfg.DoltgresHealthConfig{Port: ptr(GetUnusedPort(t)), MaxReplicationLag: ptr("soon")}},
+		{Health: &servercfg.DoltgresHealthConfig{Port: ptr(GetUnusedPort(t)), MaxReplicationLag: ptr("-1s")}},
+		{
+			Health: &servercfg.DoltgresHealthConfig{Port: ptr(GetUnusedPort(t)), MaxReplicationLag: ptr("30s")},
+			ReadReplica: &servercfg.DoltgresReadReplicaConfig{
+				RemoteURL: ptr("file://" + filepath.ToSlash(t.TempDir())),
+				Databases: []string{"replicadb"},
+				Interval:  ptr("1m"),
+			},
+		},
+	} {
+		cfg.BehaviorConfig = &servercfg.DoltgresBehaviorConfig{
+			InMemory: ptr(true),
+		}
+		_, err := TryStartServer(t, cfg)
+		require.Error(t, err)
+	}
+}
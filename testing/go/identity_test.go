@@ -0,0 +1,175 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestIdentityColumns(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "GENERATED ALWAYS AS IDENTITY",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 GENERATED ALWAYS AS IDENTITY PRIMARY KEY, v1 INT4);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "INSERT INTO test (v1) VALUES (1), (2);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test (pk, v1) VALUES (DEFAULT, 3);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "INSERT INTO test (pk, v1) VALUES (10, 4);",
+					ExpectedErr: `cannot insert a non-DEFAULT value into column "pk"`,
+				},
+				{
+					Query:       "INSERT INTO test VALUES (10, 4);",
+					ExpectedErr: `cannot insert a non-DEFAULT value into column "pk"`,
+				},
+				{
+					Query:       "INSERT INTO test (pk, v1) SELECT 10, 4;",
+					ExpectedErr: `cannot insert a non-DEFAULT value into column "pk"`,
+				},
+				{
+					Query:    "INSERT INTO test (pk, v1) OVERRIDING SYSTEM VALUE VALUES (10, 5);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test (pk, v1) OVERRIDING USER VALUE VALUES (10, 6);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query: "SELECT * FROM test ORDER BY v1;",
+					Expected: []sql.Row{
+						{1, 1},
+						{2, 2},
+						{3, 3},
+						{10, 5},
+						{4, 6},
+					},
+				},
+				{
+					Query:    "SELECT nextval('test_pk_seq');",
+					Expected: []sql.Row{{5}},
+				},
+			},
+		},
+		{
+			Name: "GENERATED BY DEFAULT AS IDENTITY",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 GENERATED BY DEFAULT AS IDENTITY, v1 INT4);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "INSERT INTO test (v1) VALUES (1);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test (pk, v1) VALUES (100, 2);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test OVERRIDING USER VALUE VALUES (100, 3);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test (pk, v1) SELECT 200, 4;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "INSERT INTO test (pk, v1) VALUES (NULL, 5);",
+					ExpectedErr: "non-nullable",
+				},
+				{
+					Query: "SELECT * FROM test ORDER BY v1;",
+					Expected: []sql.Row{
+						{1, 1},
+						{100, 2},
+						{2, 3},
+						{200, 4},
+					},
+				},
+			},
+		},
+		{
+			Name: "Identity sequence options",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT2 GENERATED ALWAYS AS IDENTITY (START WITH 10 INCREMENT BY 5) PRIMARY KEY, v1 INT4);",
+				"CREATE TABLE desc_test (pk INT4 GENERATED BY DEFAULT AS IDENTITY (INCREMENT BY -1 MAXVALUE 3) PRIMARY KEY, v1 INT4);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "INSERT INTO test (v1) VALUES (1), (2), (3);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query: "SELECT * FROM test ORDER BY v1;",
+					Expected: []sql.Row{
+						{10, 1},
+						{15, 2},
+						{20, 3},
+					},
+				},
+				{
+					Query:    "INSERT INTO desc_test (v1) VALUES (1), (2);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query: "SELECT * FROM desc_test ORDER BY v1;",
+					Expected: []sql.Row{
+						{3, 1},
+						{2, 2},
+					},
+				},
+				{
+					Query:       "CREATE TABLE bad_type (pk TEXT GENERATED ALWAYS AS IDENTITY);",
+					ExpectedErr: "identity column type must be smallint, integer, or bigint",
+				},
+				{
+					Query:       "CREATE TABLE bad_default (pk INT4 GENERATED ALWAYS AS IDENTITY DEFAULT 5);",
+					ExpectedErr: `multiple default values specified for column "pk"`,
+				},
+				{
+					Query:       "CREATE TABLE bad_range (pk INT2 GENERATED ALWAYS AS IDENTITY (MAXVALUE 100000));",
+					ExpectedErr: "MAXVALUE (100000) is out of range for sequence data type smallint",
+				},
+			},
+		},
+		{
+			Name: "Identity sequence names",
+			SetUpScript: []string{
+				"CREATE SEQUENCE test_pk_seq;",
+				"CREATE TABLE test (pk INT4 GENERATED ALWAYS AS IDENTITY PRIMARY KEY, v1 INT4);",
+				"INSERT INTO test (v1) VALUES (1);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT nextval('test_pk_seq');",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "SELECT nextval('test_pk_seq1');",
+					Expected: []sql.Row{{2}},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,78 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+	"github.com/dolthub/dolt/go/store/types"
+	"github.com/stretchr/testify/require"
+
+	dserver "github.com/dolthub/doltgresql/server"
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+func TestInMemorySnapshot(t *testing.T) {
+	snapshotDir := t.TempDir()
+	ctx := context.Background()
+
+	t.Run("Databases are backed up when the server stops", func(t *testing.T) {
+		srv := StartServer(t, &servercfg.DoltgresConfig{
+			BehaviorConfig: &servercfg.DoltgresBehaviorConfig{
+				InMemory:    ptr(true),
+				SnapshotDir: ptr(snapshotDir),
+			},
+			// The data directory is ignored when running in memory
+			DataDirStr: ptr(t.TempDir()),
+		})
+		ExecQueries(t, Connect(t, srv, ""), "CREATE DATABASE snapshotted;")
+		ExecQueries(t, Connect(t, srv, "snapshotted"),
+			"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 TEXT);",
+			"INSERT INTO test VALUES (1, 'one'), (2, 'two');",
+			"CALL dolt_commit('-Am', 'initial');",
+		)
+
+		require.NoError(t, srv.Stop())
+		require.DirExists(t, filepath.Join(snapshotDir, "doltgres"))
+		require.DirExists(t, filepath.Join(snapshotDir, "snapshotted"))
+	})
+
+	t.Run("Snapshots contain the committed data", func(t *testing.T) {
+		ddb, err := doltdb.LoadDoltDB(ctx, types.Format_Default,
+			"file://"+filepath.ToSlash(filepath.Join(snapshotDir, "snapshotted")), filesys.LocalFS)
+		require.NoError(t, err)
+		defer ddb.Close()
+		commit, err := ddb.ResolveCommitRef(ctx, ref.NewBranchRef("main"))
+		require.NoError(t, err)
+		meta, err := commit.GetCommitMeta(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "initial", meta.Description)
+	})
+
+	t.Run("In-memory configs cannot be run on disk", func(t *testing.T) {
+		_, err := dserver.RunOnDisk(context.Background(), &servercfg.DoltgresConfig{
+			BehaviorConfig: &servercfg.DoltgresBehaviorConfig{
+				InMemory: ptr(true),
+			},
+		}, &env.DoltEnv{})
+		require.ErrorContains(t, err, "in_memory is enabled")
+	})
+}
@@ -0,0 +1,255 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+func TestIndexes(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "CONCURRENTLY",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 INT8, v2 INT8);",
+				"INSERT INTO test VALUES (1, 1, 10), (2, 2, 20);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE INDEX CONCURRENTLY v1_idx ON test (v1);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test WHERE v1 = 2;",
+					Expected: []sql.Row{{2, 2, 20}},
+				},
+				{
+					Query:       "DROP INDEX CONCURRENTLY v1_idx, v2_idx;",
+					ExpectedErr: "DROP INDEX CONCURRENTLY does not support dropping multiple objects",
+				},
+				{
+					Query:       "DROP INDEX CONCURRENTLY v1_idx CASCADE;",
+					ExpectedErr: "DROP INDEX CONCURRENTLY does not support CASCADE",
+				},
+				{
+					Query:    "DROP INDEX CONCURRENTLY v1_idx;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DROP INDEX CONCURRENTLY IF EXISTS v1_idx;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "DROP INDEX CONCURRENTLY v1_idx;",
+					ExpectedErr: `index "v1_idx" does not exist`,
+				},
+				{
+					Query:    "CREATE INDEX ON test (v1, v2);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "DROP INDEX CONCURRENTLY test_v1_v2_idx;",
+					ExpectedErr: "DROP INDEX CONCURRENTLY cannot run inside a transaction block",
+				},
+				{
+					Query:    "ROLLBACK;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "CREATE INDEX CONCURRENTLY v2_idx ON test (v2);",
+					ExpectedErr: "CREATE INDEX CONCURRENTLY cannot run inside a transaction block",
+				},
+				{
+					Query:    "ROLLBACK;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DROP INDEX test_v1_v2_idx;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "DROP INDEX v2_idx;",
+					ExpectedErr: `index "v2_idx" does not exist`,
+				},
+				{
+					Query:       "REFRESH MATERIALIZED VIEW CONCURRENTLY test_view WITH NO DATA;",
+					ExpectedErr: "REFRESH options CONCURRENTLY and WITH NO DATA cannot be used together",
+				},
+				{
+					Query:       "REFRESH MATERIALIZED VIEW CONCURRENTLY test_view;",
+					ExpectedErr: "REFRESH MATERIALIZED VIEW CONCURRENTLY is not yet supported",
+				},
+			},
+		},
+		{
+			Name: "CREATE UNIQUE INDEX on existing rows",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 INT8);",
+				"INSERT INTO test VALUES (1, 1), (2, 1);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "CREATE UNIQUE INDEX v1_idx ON test (v1);",
+					ExpectedErr: "duplicate",
+				},
+				{
+					Query:    "DELETE FROM test WHERE pk = 2;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE UNIQUE INDEX v1_idx ON test (v1);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "INSERT INTO test VALUES (3, 1);",
+					ExpectedErr: "duplicate",
+				},
+			},
+		},
+		{
+			Name: "Column order, partial, and expression indexes",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 INT8, v2 INT8);",
+				"INSERT INTO test VALUES (1, 1, 10), (2, 2, 20), (3, 3, 30);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE INDEX v1_v2_idx ON test (v1 ASC, v2 DESC);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test WHERE v1 = 2 AND v2 = 20;",
+					Expected: []sql.Row{{2, 2, 20}},
+				},
+				{
+					Query:    "CREATE INDEX v2_partial_idx ON test (v2) WHERE v2 > 10;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test WHERE v2 < 20;",
+					Expected: []sql.Row{{1, 1, 10}},
+				},
+				{
+					Query:       "CREATE UNIQUE INDEX v1_partial_idx ON test (v1) WHERE v1 > 1;",
+					ExpectedErr: "unique partial indexes are not yet supported",
+				},
+				{
+					Query:    "CREATE INDEX v1_expr_idx ON test ((v1));",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test WHERE v1 = 3;",
+					Expected: []sql.Row{{3, 3, 30}},
+				},
+				{
+					Query:       "CREATE INDEX v1_plus_idx ON test ((v1 + 1));",
+					ExpectedErr: "expression indexes are not yet supported: v1 + 1",
+				},
+			},
+		},
+		{
+			Name: "Access methods",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 INT8, v2 INT8);",
+				"INSERT INTO test VALUES (1, 1, 10), (2, 2, 20);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE INDEX v1_idx ON test USING btree (v1);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE INDEX v2_idx ON test USING hash (v2);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test WHERE v2 = 20;",
+					Expected: []sql.Row{{2, 2, 20}},
+				},
+				{
+					Query:       "CREATE UNIQUE INDEX v1_hash_idx ON test USING hash (v1);",
+					ExpectedErr: `access method "hash" does not support unique indexes`,
+				},
+				{
+					Query:       "CREATE INDEX v1_v2_hash_idx ON test USING hash (v1, v2);",
+					ExpectedErr: `access method "hash" does not support multicolumn indexes`,
+				},
+				{
+					Query:       "CREATE INDEX v1_gin_idx ON test USING gin (v1);",
+					ExpectedErr: `access method "gin" is not yet supported`,
+				},
+				{
+					Query:       "CREATE INDEX v1_unknown_idx ON test USING unknown (v1);",
+					ExpectedErr: `access method "unknown" does not exist`,
+				},
+			},
+		},
+		{
+			Name: "DROP INDEX requires ownership of the table",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 INT4);",
+				"CREATE INDEX v1_idx ON test (v1);",
+				"CREATE ROLE alice LOGIN;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "DROP INDEX v1_idx;",
+					Username:    "alice",
+					ExpectedErr: "must be owner of index v1_idx",
+				},
+				{
+					Query:       "DROP INDEX missing_idx;",
+					Username:    "alice",
+					ExpectedErr: `index "missing_idx" does not exist`,
+				},
+				{
+					Query:    "DROP INDEX v1_idx;",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+	})
+}
+
+func TestRefreshMaterializedViewConcurrently(t *testing.T) {
+	srv := StartServer(t, &servercfg.DoltgresConfig{
+		BehaviorConfig: &servercfg.DoltgresBehaviorConfig{
+			InMemory: ptr(true),
+		},
+	})
+	conn := Connect(t, srv, "doltgres")
+	ExecQueries(t, conn, "CREATE TABLE test (pk INT8 PRIMARY KEY, v1 INT8);", "CREATE UNIQUE INDEX test_v1_idx ON test (v1);")
+
+	// Materialized views are not supported, so concurrent refreshes are rejected as unsupported features, including
+	// within transaction blocks
+	RequireErrorCode(t, conn, "REFRESH MATERIALIZED VIEW CONCURRENTLY test;", "0A000")
+	RequireErrorCode(t, conn, "REFRESH MATERIALIZED VIEW test;", "0A000")
+	ExecQueries(t, conn, "BEGIN;")
+	RequireErrorCode(t, conn, "REFRESH MATERIALIZED VIEW CONCURRENTLY test;", "0A000")
+	ExecQueries(t, conn, "ROLLBACK;")
+	RequireErrorCode(t, conn, "REFRESH MATERIALIZED VIEW CONCURRENTLY test WITH NO DATA;", "42601")
+}
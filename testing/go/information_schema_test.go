@@ -0,0 +1,144 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestInformationSchema(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "tables and views",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 TEXT);",
+				"CREATE TABLE hidden (pk INT4 PRIMARY KEY);",
+				"CREATE VIEW test_view AS SELECT pk FROM test WHERE pk > 1;",
+				"CREATE ROLE alice LOGIN;",
+				"GRANT SELECT ON test TO alice;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT table_catalog, table_schema, table_name, table_type, is_insertable_into FROM information_schema.tables ORDER BY table_name;",
+					Expected: []sql.Row{
+						{"postgres", "public", "hidden", "BASE TABLE", "YES"},
+						{"postgres", "public", "test", "BASE TABLE", "YES"},
+						{"postgres", "public", "test_view", "VIEW", "NO"},
+					},
+				},
+				{
+					Query:    "SELECT table_name FROM information_schema.tables ORDER BY table_name;",
+					Username: "alice",
+					Expected: []sql.Row{{"test"}},
+				},
+				{
+					Query:    "SELECT table_schema, table_name, view_definition, check_option FROM information_schema.views;",
+					Expected: []sql.Row{{"public", "test_view", "SELECT pk FROM test WHERE pk > 1", "NONE"}},
+				},
+				{
+					Query:       "SELECT * FROM tables;",
+					ExpectedErr: "not found",
+				},
+			},
+		},
+		{
+			Name: "columns",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 VARCHAR(10) NOT NULL, v2 NUMERIC(5, 2) DEFAULT 1, v3 INT8 GENERATED BY DEFAULT AS IDENTITY, v4 INT4[], v5 TIMESTAMPTZ);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT column_name, ordinal_position, is_nullable, data_type, udt_name, character_maximum_length, " +
+						"numeric_precision, numeric_scale, datetime_precision FROM information_schema.columns WHERE table_name = 'test' ORDER BY ordinal_position;",
+					Expected: []sql.Row{
+						{"pk", 1, "NO", "integer", "int4", nil, 32, 0, nil},
+						{"v1", 2, "NO", "character varying", "varchar", 10, nil, nil, nil},
+						{"v2", 3, "YES", "numeric", "numeric", nil, 5, 2, nil},
+						{"v3", 4, "NO", "bigint", "int8", nil, 64, 0, nil},
+						{"v4", 5, "YES", "ARRAY", "_int4", nil, nil, nil, nil},
+						{"v5", 6, "YES", "timestamp with time zone", "timestamptz", nil, nil, nil, 6},
+					},
+				},
+				{
+					Query: "SELECT column_name, column_default, is_identity, identity_generation, identity_start, identity_increment " +
+						"FROM information_schema.columns WHERE table_name = 'test' AND column_name IN ('v2', 'v3') ORDER BY column_name;",
+					Expected: []sql.Row{
+						{"v2", "1", "NO", nil, nil, nil},
+						{"v3", nil, "YES", "BY DEFAULT", "1", "1"},
+					},
+				},
+			},
+		},
+		{
+			Name: "constraints",
+			SetUpScript: []string{
+				"CREATE TABLE parent (a INT4, b INT4, PRIMARY KEY (a, b));",
+				"CREATE TABLE child (pk INT4 PRIMARY KEY, pa INT4, pb INT4, v1 INT4, " +
+					"CONSTRAINT child_parent_fk FOREIGN KEY (pa, pb) REFERENCES parent (a, b) ON UPDATE CASCADE ON DELETE SET NULL);",
+				"CREATE UNIQUE INDEX child_v1_key ON child (v1);",
+				"ALTER TABLE child ADD CONSTRAINT child_v1_check CHECK (v1 > 0);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT constraint_name, table_name, constraint_type, is_deferrable, enforced FROM information_schema.table_constraints " +
+						"ORDER BY table_name, constraint_name;",
+					Expected: []sql.Row{
+						{"child_parent_fk", "child", "FOREIGN KEY", "NO", "YES"},
+						{"child_pkey", "child", "PRIMARY KEY", "NO", "YES"},
+						{"child_v1_check", "child", "CHECK", "NO", "YES"},
+						{"child_v1_key", "child", "UNIQUE", "NO", "YES"},
+						{"parent_pkey", "parent", "PRIMARY KEY", "NO", "YES"},
+					},
+				},
+				{
+					Query: "SELECT constraint_name, table_name, column_name, ordinal_position, position_in_unique_constraint FROM information_schema.key_column_usage " +
+						"ORDER BY table_name, constraint_name, ordinal_position;",
+					Expected: []sql.Row{
+						{"child_parent_fk", "child", "pa", 1, 1},
+						{"child_parent_fk", "child", "pb", 2, 2},
+						{"child_pkey", "child", "pk", 1, nil},
+						{"child_v1_key", "child", "v1", 1, nil},
+						{"parent_pkey", "parent", "a", 1, nil},
+						{"parent_pkey", "parent", "b", 2, nil},
+					},
+				},
+				{
+					Query: "SELECT constraint_name, unique_constraint_schema, unique_constraint_name, match_option, update_rule, delete_rule " +
+						"FROM information_schema.referential_constraints;",
+					Expected: []sql.Row{{"child_parent_fk", "public", "parent_pkey", "NONE", "CASCADE", "SET NULL"}},
+				},
+			},
+		},
+		{
+			Name: "routines",
+			SetUpScript: []string{
+				"CREATE FUNCTION add_one(x INT4) RETURNS INT4 AS $$ SELECT x + 1 $$ LANGUAGE sql;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT routine_schema, routine_name, routine_type, data_type, type_udt_name, routine_body, routine_definition, external_language " +
+						"FROM information_schema.routines WHERE routine_schema = 'public';",
+					Expected: []sql.Row{{"public", "add_one", "FUNCTION", "integer", "int4", "SQL", " SELECT x + 1 ", "SQL"}},
+				},
+				{
+					Query:    "SELECT DISTINCT routine_name, routine_type, external_language FROM information_schema.routines WHERE routine_schema = 'pg_catalog' AND routine_name = 'abs';",
+					Expected: []sql.Row{{"abs", "FUNCTION", "INTERNAL"}},
+				},
+			},
+		},
+	})
+}
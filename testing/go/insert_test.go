@@ -0,0 +1,110 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestInsertOnConflict(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "ON CONFLICT DO NOTHING",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 INT8, v2 TEXT);",
+				"CREATE UNIQUE INDEX v1_idx ON test (v1);",
+				"INSERT INTO test VALUES (1, 10, 'a'), (2, 20, 'b');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "INSERT INTO test VALUES (1, 11, 'c'), (3, 30, 'c') ON CONFLICT DO NOTHING;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (4, 20, 'd') ON CONFLICT (v1) DO NOTHING;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (2, 40, 'e') ON CONFLICT ON CONSTRAINT test_pkey DO NOTHING;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, 10, "a"}, {2, 20, "b"}, {3, 30, "c"}},
+				},
+			},
+		},
+		{
+			Name: "ON CONFLICT DO UPDATE",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 INT4, v2 TEXT);",
+				"INSERT INTO test VALUES (1, 10, 'a'), (2, 20, 'b');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "INSERT INTO test VALUES (1, 11, 'c'), (3, 30, 'c') ON CONFLICT (pk) DO UPDATE SET v1 = excluded.v1, v2 = excluded.v2;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, 11, "c"}, {2, 20, "b"}, {3, 30, "c"}},
+				},
+				{
+					Query:    "INSERT INTO test (pk, v1) VALUES (2, 5) ON CONFLICT (pk) DO UPDATE SET v1 = v1 + EXCLUDED.v1 * 2, v2 = 'updated';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, 11, "c"}, {2, 30, "updated"}, {3, 30, "c"}},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (3, 1, 'x') ON CONFLICT ON CONSTRAINT test_pkey DO UPDATE SET v1 = 1::INT8;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test WHERE pk = 3;",
+					Expected: []sql.Row{{3, 1, "c"}},
+				},
+			},
+		},
+		{
+			Name: "ON CONFLICT DO UPDATE with WHERE",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, version INT4, v1 TEXT);",
+				"INSERT INTO test VALUES (1, 1, 'a'), (2, 5, 'b');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "INSERT INTO test VALUES (1, 2, 'c'), (2, 2, 'd'), (3, 1, 'e') ON CONFLICT (pk) DO UPDATE SET version = excluded.version, v1 = excluded.v1 WHERE test.version < excluded.version;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, 2, "c"}, {2, 5, "b"}, {3, 1, "e"}},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (3, 9, 'f') ON CONFLICT (pk) WHERE version > 0 DO UPDATE SET v1 = excluded.v1 WHERE excluded.v1 <> 'f';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test WHERE pk = 3;",
+					Expected: []sql.Row{{3, 1, "e"}},
+				},
+			},
+		},
+	})
+}
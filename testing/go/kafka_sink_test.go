@@ -0,0 +1,258 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/doltgresql/server/kafkasink"
+)
+
+// recordingWriter is a kafkasink.Writer that keeps every message in memory.
+type recordingWriter struct {
+	mutex    sync.Mutex
+	messages []kafka.Message
+}
+
+// WriteMessages implements the interface kafkasink.Writer.
+func (w *recordingWriter) WriteMessages(ctx context.Context, msgs ...kafka.Message) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.messages = append(w.messages, msgs...)
+	return nil
+}
+
+// Close implements the interface kafkasink.Writer.
+func (w *recordingWriter) Close() error {
+	return nil
+}
+
+// waitForMessages waits until the writer has received the given number of messages since the last call, and returns
+// them.
+func (w *recordingWriter) waitForMessages(t *testing.T, count int) []kafka.Message {
+	require.Eventually(t, func() bool {
+		w.mutex.Lock()
+		defer w.mutex.Unlock()
+		return len(w.messages) >= count
+	}, 10*time.Second, 10*time.Millisecond)
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	messages := w.messages
+	w.messages = nil
+	require.Len(t, messages, count)
+	return messages
+}
+
+func TestKafkaSink(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "sinkdb")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+
+	// Functions that manage the sink fail when it's not running
+	_, err := conn.Exec(ctx, "SELECT doltgres_kafka_sink_subscribe('main', 'items');")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the kafka sink is not running")
+
+	writer := &recordingWriter{}
+	require.NoError(t, kafkasink.Start(kafkasink.Config{
+		Subscriptions: []kafkasink.Subscription{{Database: "sinkdb", Table: "public.items"}},
+	}, writer))
+	defer func() {
+		require.NoError(t, kafkasink.Stop())
+	}()
+
+	for _, query := range []string{
+		"CREATE TABLE items (id INT4 PRIMARY KEY, name TEXT, price FLOAT8, data JSONB);",
+		"CREATE TABLE other (id INT4 PRIMARY KEY);",
+		`INSERT INTO items VALUES (1, 'apple', 1.5, '{"color": "red"}'), (2, 'pear', NULL, NULL);`,
+		"INSERT INTO other VALUES (1);",
+		"CALL dolt_commit('-Am', 'initial');",
+	} {
+		_, err = conn.Exec(ctx, query)
+		require.NoError(t, err, query)
+	}
+
+	// Only the subscribed table is published, and inserted rows only have an after image
+	messages := writer.waitForMessages(t, 2)
+	assert.Equal(t, "doltgres.sinkdb.main.public.items", messages[0].Topic)
+	assert.JSONEq(t, `{"id": 1}`, string(messages[0].Key))
+	value := map[string]any{}
+	require.NoError(t, json.Unmarshal(messages[0].Value, &value))
+	assert.Equal(t, "sinkdb", value["database"])
+	assert.Equal(t, "main", value["branch"])
+	assert.Equal(t, "public", value["schema"])
+	assert.Equal(t, "items", value["table"])
+	assert.Equal(t, "insert", value["op"])
+	assert.Len(t, value["commit"], 32)
+	assert.Nil(t, value["before"])
+	assert.Equal(t, map[string]any{"id": float64(1), "name": "apple", "price": 1.5, "data": map[string]any{"color": "red"}}, value["after"])
+	assert.JSONEq(t, `{"id": 2, "name": "pear", "price": null, "data": null}`, string(jsonField(t, messages[1].Value, "after")))
+
+	// Uncommitted changes are not published, and updates carry both images
+	for _, query := range []string{
+		"UPDATE items SET price = 2.25 WHERE id = 1;",
+		"DELETE FROM items WHERE id = 2;",
+	} {
+		_, err = conn.Exec(ctx, query)
+		require.NoError(t, err, query)
+	}
+	time.Sleep(100 * time.Millisecond)
+	writer.mutex.Lock()
+	assert.Empty(t, writer.messages)
+	writer.mutex.Unlock()
+	_, err = conn.Exec(ctx, "CALL dolt_commit('-am', 'update');")
+	require.NoError(t, err)
+	messages = writer.waitForMessages(t, 2)
+	assert.Equal(t, "update", jsonString(t, messages[0].Value, "op"))
+	assert.JSONEq(t, `{"id": 1, "name": "apple", "price": 1.5, "data": {"color": "red"}}`, string(jsonField(t, messages[0].Value, "before")))
+	assert.JSONEq(t, `{"id": 1, "name": "apple", "price": 2.25, "data": {"color": "red"}}`, string(jsonField(t, messages[0].Value, "after")))
+	assert.Equal(t, "delete", jsonString(t, messages[1].Value, "op"))
+	assert.JSONEq(t, `{"id": 2}`, string(messages[1].Key))
+	assert.Equal(t, "null", string(jsonField(t, messages[1].Value, "after")))
+
+	// Subscriptions may be managed through functions, and apply to the current database
+	var changed string
+	require.NoError(t, conn.QueryRow(ctx, "SELECT doltgres_kafka_sink_subscribe('feature*', 'other');").Scan(&changed))
+	assert.Equal(t, "t", changed)
+	require.NoError(t, conn.QueryRow(ctx, "SELECT doltgres_kafka_sink_subscribe('feature*', 'other');").Scan(&changed))
+	assert.Equal(t, "f", changed)
+	rows, err := conn.Query(ctx, "SELECT * FROM doltgres_kafka_sink_subscriptions();")
+	require.NoError(t, err)
+	readRows, err := ReadRows(rows, true)
+	require.NoError(t, err)
+	assert.Equal(t, [][]any{
+		{"sinkdb", nil, "public.items"},
+		{"sinkdb", "feature*", "other"},
+	}, toAnySlices(readRows))
+
+	// Branches created after the sink started are published from their first commit onward
+	for _, query := range []string{
+		"CALL dolt_checkout('-b', 'feature1');",
+		"INSERT INTO other VALUES (2);",
+		"CALL dolt_commit('-am', 'feature');",
+	} {
+		_, err = conn.Exec(ctx, query)
+		require.NoError(t, err, query)
+	}
+	messages = writer.waitForMessages(t, 1)
+	assert.Equal(t, "doltgres.sinkdb.feature1.public.other", messages[0].Topic)
+	assert.JSONEq(t, `{"id": 2}`, string(jsonField(t, messages[0].Value, "after")))
+
+	require.NoError(t, conn.QueryRow(ctx, "SELECT doltgres_kafka_sink_unsubscribe('feature*', 'other');").Scan(&changed))
+	assert.Equal(t, "t", changed)
+	var running, published, failed string
+	require.NoError(t, conn.QueryRow(ctx, "SELECT running, published, failed FROM doltgres_kafka_sink_status();").Scan(&running, &published, &failed))
+	assert.Equal(t, "t", running)
+	assert.Equal(t, "5", published)
+	assert.Equal(t, "0", failed)
+}
+
+func TestKafkaSinkAvro(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "avrodb")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+
+	writer := &recordingWriter{}
+	require.NoError(t, kafkasink.Start(kafkasink.Config{
+		Topic:         "changes-{table}",
+		KeyFormat:     kafkasink.FormatAvro,
+		ValueFormat:   kafkasink.FormatAvro,
+		Subscriptions: []kafkasink.Subscription{{}},
+	}, writer))
+	defer func() {
+		require.NoError(t, kafkasink.Stop())
+	}()
+
+	for _, query := range []string{
+		"CREATE TABLE t (id INT8 PRIMARY KEY, ok BOOLEAN, note TEXT);",
+		"INSERT INTO t VALUES (-3, true, NULL);",
+		"CALL dolt_commit('-Am', 'initial');",
+	} {
+		_, err := conn.Exec(ctx, query)
+		require.NoError(t, err, query)
+	}
+	messages := writer.waitForMessages(t, 1)
+	message := messages[0]
+	assert.Equal(t, "changes-t", message.Topic)
+	// The key is a record holding the long -3, which is zig-zag encoded as 5
+	assert.Equal(t, []byte{0x05}, message.Key)
+
+	headers := make(map[string]string)
+	for _, header := range message.Headers {
+		headers[header.Key] = string(header.Value)
+	}
+	keySchema := map[string]any{}
+	require.NoError(t, json.Unmarshal([]byte(headers[kafkasink.AvroKeySchemaHeader]), &keySchema))
+	assert.Equal(t, "Key", keySchema["name"])
+	assert.Equal(t, []any{map[string]any{"name": "id", "type": "long"}}, keySchema["fields"])
+	valueSchema := map[string]any{}
+	require.NoError(t, json.Unmarshal([]byte(headers[kafkasink.AvroSchemaHeader]), &valueSchema))
+	assert.Equal(t, "Change", valueSchema["name"])
+	assert.Equal(t, "doltgres.avrodb.public.t", valueSchema["namespace"])
+
+	// The value begins with the database, branch, and commit, which are each a length followed by the string
+	require.Greater(t, len(message.Value), 48)
+	assert.Equal(t, append([]byte{12}, "avrodb"...), message.Value[:7])
+	assert.Equal(t, append([]byte{8}, "main"...), message.Value[7:12])
+	assert.Equal(t, byte(64), message.Value[12])
+	// After the schema, table, and op, the before image is null and the after image is present
+	tail := message.Value[13+32:]
+	expectedTail := []byte{12}
+	expectedTail = append(expectedTail, "public"...)
+	expectedTail = append(expectedTail, 2, 't')
+	expectedTail = append(expectedTail, 12)
+	expectedTail = append(expectedTail, "insert"...)
+	expectedTail = append(expectedTail, 0, 2)
+	// The row's fields are unions: id is -3, ok is true, and note is null
+	expectedTail = append(expectedTail, 2, 0x05, 2, 1, 0)
+	assert.Equal(t, expectedTail, tail)
+}
+
+// jsonField returns the raw JSON of the given field of the JSON object.
+func jsonField(t *testing.T, data []byte, field string) json.RawMessage {
+	object := map[string]json.RawMessage{}
+	require.NoError(t, json.Unmarshal(data, &object))
+	return object[field]
+}
+
+// jsonString returns the given string field of the JSON object.
+func jsonString(t *testing.T, data []byte, field string) string {
+	var s string
+	require.NoError(t, json.Unmarshal(jsonField(t, data, field), &s))
+	return s
+}
+
+// toAnySlices converts the rows into plain slices, which makes them easier to compare.
+func toAnySlices[T ~[]any](rows []T) [][]any {
+	result := make([][]any, len(rows))
+	for i, row := range rows {
+		result[i] = row
+	}
+	return result
+}
@@ -0,0 +1,149 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+func TestKillSwitch(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+
+	// Queries that only differ by their constants share a fingerprint
+	var fingerprint, otherFingerprint string
+	require.NoError(t, conn.QueryRow(ctx, "SELECT doltgres_query_fingerprint('SELECT 1 + 1');").Scan(&fingerprint))
+	require.NoError(t, conn.QueryRow(ctx, "SELECT doltgres_query_fingerprint('select 5 + 7;');").Scan(&otherFingerprint))
+	assert.Len(t, fingerprint, 16)
+	assert.Equal(t, fingerprint, otherFingerprint)
+	require.NoError(t, conn.QueryRow(ctx, "SELECT doltgres_query_fingerprint('SELECT 1 - 1');").Scan(&otherFingerprint))
+	assert.NotEqual(t, fingerprint, otherFingerprint)
+
+	// Reject rules stop matching queries before they run
+	var added string
+	require.NoError(t, conn.QueryRow(ctx, "SELECT doltgres_kill_switch_add('SELECT 1 + 1', 'reject');").Scan(&added))
+	assert.Equal(t, fingerprint, added)
+	_, err := conn.Exec(ctx, "SELECT 5 + 7;")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fmt.Sprintf("query rejected by kill switch (fingerprint %s)", fingerprint))
+	var result int32
+	require.NoError(t, conn.QueryRow(ctx, "SELECT 5 - 7;").Scan(&result))
+	assert.Equal(t, int32(-2), result)
+
+	// Cancel rules stop matching queries once they've run for the given duration
+	var sleepFingerprint string
+	require.NoError(t, conn.QueryRow(ctx, "SELECT doltgres_kill_switch_add('SELECT pg_sleep(0)', 'cancel', 200);").Scan(&sleepFingerprint))
+	start := time.Now()
+	_, err = conn.Exec(ctx, "SELECT pg_sleep(30);")
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 10*time.Second)
+	var pgErr *pgconn.PgError
+	require.True(t, errors.As(err, &pgErr))
+	assert.Equal(t, "57014", pgErr.Code)
+	assert.Equal(t, fmt.Sprintf("canceling statement due to kill switch (fingerprint %s)", sleepFingerprint), pgErr.Message)
+	_, err = conn.Exec(ctx, "SELECT pg_sleep(0.01);")
+	require.NoError(t, err)
+
+	// Rules are listed by fingerprint
+	rows, err := conn.Query(ctx, "SELECT fingerprint, action, cancel_after_ms FROM doltgres_kill_switch_rules();")
+	require.NoError(t, err)
+	readRows, err := ReadRows(rows, true)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, [][]any{{fingerprint, "reject", int64(0)}, {sleepFingerprint, "cancel", int64(200)}}, toAnyRows(readRows))
+
+	// Adding a cancel rule affects queries that are already running
+	go func() {
+		time.Sleep(500 * time.Millisecond)
+		addConn, err := pgx.Connect(context.Background(), conn.Config().ConnString())
+		if err != nil {
+			return
+		}
+		defer addConn.Close(context.Background())
+		_, _ = addConn.Exec(context.Background(), "SELECT doltgres_kill_switch_add('SELECT pg_sleep(0), 1', 'cancel');")
+	}()
+	start = time.Now()
+	_, err = conn.Exec(ctx, "SELECT pg_sleep(30), 1;")
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 10*time.Second)
+	require.True(t, errors.As(err, &pgErr))
+	assert.Equal(t, "57014", pgErr.Code)
+
+	// Rules may be removed by either their query or their fingerprint
+	var removed string
+	require.NoError(t, conn.QueryRow(ctx, fmt.Sprintf("SELECT doltgres_kill_switch_remove('%s');", fingerprint)).Scan(&removed))
+	assert.Equal(t, "t", removed)
+	require.NoError(t, conn.QueryRow(ctx, "SELECT doltgres_kill_switch_remove('SELECT pg_sleep(1)');").Scan(&removed))
+	assert.Equal(t, "t", removed)
+	require.NoError(t, conn.QueryRow(ctx, "SELECT doltgres_kill_switch_remove('SELECT pg_sleep(1), 2');").Scan(&removed))
+	assert.Equal(t, "t", removed)
+	require.NoError(t, conn.QueryRow(ctx, "SELECT doltgres_kill_switch_remove('SELECT pg_sleep(1)');").Scan(&removed))
+	assert.Equal(t, "f", removed)
+	require.NoError(t, conn.QueryRow(ctx, "SELECT 1 + 1;").Scan(&result))
+	assert.Equal(t, int32(2), result)
+
+	// Invalid actions are rejected
+	_, err = conn.Exec(ctx, "SELECT doltgres_kill_switch_add('SELECT 1', 'explode');")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid kill switch action "explode"`)
+}
+
+func TestKillSwitchPersistence(t *testing.T) {
+	killSwitchFile := filepath.Join(t.TempDir(), "kill_switch.json")
+	cfg := &servercfg.DoltgresConfig{
+		BehaviorConfig: &servercfg.DoltgresBehaviorConfig{
+			InMemory: ptr(true),
+		},
+		KillSwitchFile: &killSwitchFile,
+	}
+	ctx := context.Background()
+
+	srv := StartServer(t, cfg)
+	ExecQueries(t, Connect(t, srv, ""), "SELECT doltgres_kill_switch_add('SELECT 1 + 1', 'reject');")
+	require.NoError(t, srv.Stop())
+
+	// The rule is loaded when the server restarts
+	conn := Connect(t, StartServer(t, cfg), "")
+	_, err := conn.Exec(ctx, "SELECT 3 + 4;")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "query rejected by kill switch")
+	var removed string
+	require.NoError(t, conn.QueryRow(ctx, "SELECT doltgres_kill_switch_remove('SELECT 1 + 1');").Scan(&removed))
+	assert.Equal(t, "t", removed)
+}
+
+// toAnyRows converts the given rows to slices, so that they may be compared without regard to their type.
+func toAnyRows[T ~[]any](rows []T) [][]any {
+	anyRows := make([][]any, len(rows))
+	for i, row := range rows {
+		anyRows[i] = row
+	}
+	return anyRows
+}
@@ -0,0 +1,95 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestLateral(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "LATERAL subqueries",
+			SetUpScript: []string{
+				"CREATE TABLE orders (id INT8 PRIMARY KEY, customer TEXT);",
+				"CREATE TABLE items (id INT8 PRIMARY KEY, order_id INT8, price INT4);",
+				"INSERT INTO orders VALUES (1, 'alice'), (2, 'bob'), (3, 'carol');",
+				"INSERT INTO items VALUES (1, 1, 10), (2, 1, 40), (3, 1, 30), (4, 1, 20), (5, 2, 5);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT o.customer, top.price FROM orders o, LATERAL (SELECT price FROM items i WHERE i.order_id = o.id ORDER BY price DESC LIMIT 3) top ORDER BY o.id, top.price DESC;",
+					Expected: []sql.Row{
+						{"alice", 40},
+						{"alice", 30},
+						{"alice", 20},
+						{"bob", 5},
+					},
+				},
+				{
+					Query:    "SELECT o.customer, x.doubled FROM orders o CROSS JOIN LATERAL (SELECT price * 2 FROM items WHERE order_id = o.id AND price < 20) x (doubled) ORDER BY o.id;",
+					Expected: []sql.Row{{"alice", 20}, {"bob", 10}},
+				},
+				{
+					Query:    "SELECT o.customer, top.price FROM orders o LEFT JOIN LATERAL (SELECT price FROM items i WHERE i.order_id = o.id ORDER BY price LIMIT 1) top ON true ORDER BY o.id;",
+					Expected: []sql.Row{{"alice", 10}, {"bob", 5}, {"carol", nil}},
+				},
+				{
+					Query:    "SELECT orders.customer, items.price FROM orders, items WHERE orders.id = items.order_id AND items.price > 25 ORDER BY items.price;",
+					Expected: []sql.Row{{"alice", 30}, {"alice", 40}},
+				},
+			},
+		},
+		{
+			Name: "set-returning functions in FROM",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 INT4);",
+				"INSERT INTO test VALUES (1, 10), (2, 20);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT * FROM generate_series(1, 3);",
+					Expected: []sql.Row{{1}, {2}, {3}},
+				},
+				{
+					Query:    "SELECT g FROM generate_series(5, 1, -2) g;",
+					Expected: []sql.Row{{5}, {3}, {1}},
+				},
+				{
+					Query:    "SELECT g.x FROM generate_series(1.5, 3) AS g(x);",
+					Expected: []sql.Row{{1.5}, {2.5}},
+				},
+				{
+					Query:    "SELECT t.pk, g FROM test t, LATERAL generate_series(1, t.pk) g ORDER BY t.pk, g;",
+					Expected: []sql.Row{{1, 1}, {2, 1}, {2, 2}},
+				},
+				{
+					Query:    "SELECT t.pk, g FROM test t, generate_series(t.pk, 2) g ORDER BY t.pk, g;",
+					Expected: []sql.Row{{1, 1}, {1, 2}, {2, 2}},
+				},
+				{
+					Query:    "SELECT t.pk, e.value FROM test t, jsonb_array_elements('[1, 2]'::jsonb) e ORDER BY t.pk, e.value;",
+					Expected: []sql.Row{{1, "1"}, {1, "2"}, {2, "1"}, {2, "2"}},
+				},
+				{
+					Query:       "SELECT * FROM generate_series(1, 3, 0);",
+					ExpectedErr: "step size cannot equal zero",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,167 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+func TestListenNotify(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "LISTEN, NOTIFY, and UNLISTEN statements",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "LISTEN my_channel;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "NOTIFY my_channel;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "NOTIFY my_channel, 'payload';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT pg_notify('my_channel', 'payload');",
+					Expected: []sql.Row{{""}},
+				},
+				{
+					Query:       "SELECT pg_notify('', 'payload');",
+					ExpectedErr: "channel name cannot be empty",
+				},
+				{
+					Query:       "SELECT pg_notify(NULL, 'payload');",
+					ExpectedErr: "channel name cannot be empty",
+				},
+				{
+					Query:    "UNLISTEN my_channel;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "UNLISTEN *;",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+	})
+}
+
+func TestListenNotifyDelivery(t *testing.T) {
+	srv := StartServer(t, &servercfg.DoltgresConfig{
+		BehaviorConfig: &servercfg.DoltgresBehaviorConfig{
+			InMemory: ptr(true),
+		},
+	})
+	ctx := context.Background()
+	// waitForNotification returns the next notification, or nil if one does not arrive shortly
+	waitForNotification := func(conn *pgx.Conn) *pgconn.Notification {
+		waitCtx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+		defer cancel()
+		notification, err := conn.WaitForNotification(waitCtx)
+		if err != nil {
+			require.ErrorIs(t, err, context.DeadlineExceeded)
+			return nil
+		}
+		return notification
+	}
+
+	listener := Connect(t, srv, "")
+	notifier := Connect(t, srv, "")
+	ExecQueries(t, listener, "LISTEN jobs;")
+	ExecQueries(t, listener, "LISTEN other;")
+
+	t.Run("Notifications are delivered to idle listeners", func(t *testing.T) {
+		ExecQueries(t, notifier, "NOTIFY jobs, 'first';")
+		notification := waitForNotification(listener)
+		require.NotNil(t, notification)
+		assert.Equal(t, "jobs", notification.Channel)
+		assert.Equal(t, "first", notification.Payload)
+		assert.Equal(t, notifier.PgConn().PID(), notification.PID)
+	})
+
+	t.Run("Channels that are not listened on are not delivered", func(t *testing.T) {
+		ExecQueries(t, notifier, "NOTIFY unheard, 'payload';")
+		assert.Nil(t, waitForNotification(listener))
+	})
+
+	t.Run("Notifications are delivered when the transaction commits", func(t *testing.T) {
+		ExecQueries(t, notifier, "BEGIN;")
+		ExecQueries(t, notifier, "NOTIFY jobs, 'second';")
+		ExecQueries(t, notifier, "SELECT pg_notify('other', 'third');")
+		// Duplicate notifications within a transaction are only delivered once
+		ExecQueries(t, notifier, "NOTIFY jobs, 'second';")
+		assert.Nil(t, waitForNotification(listener))
+		ExecQueries(t, notifier, "COMMIT;")
+		notification := waitForNotification(listener)
+		require.NotNil(t, notification)
+		assert.Equal(t, "jobs", notification.Channel)
+		assert.Equal(t, "second", notification.Payload)
+		notification = waitForNotification(listener)
+		require.NotNil(t, notification)
+		assert.Equal(t, "other", notification.Channel)
+		assert.Equal(t, "third", notification.Payload)
+		assert.Nil(t, waitForNotification(listener))
+	})
+
+	t.Run("Notifications are discarded when the transaction rolls back", func(t *testing.T) {
+		ExecQueries(t, notifier, "BEGIN;")
+		ExecQueries(t, notifier, "NOTIFY jobs, 'rolled back';")
+		ExecQueries(t, notifier, "ROLLBACK;")
+		assert.Nil(t, waitForNotification(listener))
+	})
+
+	t.Run("Notifications wait until the listener's transaction ends", func(t *testing.T) {
+		ExecQueries(t, listener, "BEGIN;")
+		ExecQueries(t, notifier, "NOTIFY jobs, 'during transaction';")
+		assert.Nil(t, waitForNotification(listener))
+		ExecQueries(t, listener, "COMMIT;")
+		notification := waitForNotification(listener)
+		require.NotNil(t, notification)
+		assert.Equal(t, "during transaction", notification.Payload)
+	})
+
+	t.Run("Sessions receive their own notifications", func(t *testing.T) {
+		ExecQueries(t, notifier, "LISTEN self;")
+		ExecQueries(t, notifier, "NOTIFY self, 'mine';")
+		notification := waitForNotification(notifier)
+		require.NotNil(t, notification)
+		assert.Equal(t, "mine", notification.Payload)
+	})
+
+	t.Run("UNLISTEN stops delivery", func(t *testing.T) {
+		ExecQueries(t, listener, "UNLISTEN jobs;")
+		ExecQueries(t, notifier, "NOTIFY jobs, 'unheard';")
+		assert.Nil(t, waitForNotification(listener))
+		ExecQueries(t, notifier, "NOTIFY other, 'heard';")
+		notification := waitForNotification(listener)
+		require.NotNil(t, notification)
+		assert.Equal(t, "heard", notification.Payload)
+		ExecQueries(t, listener, "UNLISTEN *;")
+		ExecQueries(t, notifier, "NOTIFY other, 'unheard';")
+		assert.Nil(t, waitForNotification(listener))
+	})
+}
@@ -0,0 +1,405 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocking(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "LOCK TABLE",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 INT4);",
+				"CREATE TABLE other (pk INT4 PRIMARY KEY);",
+				"INSERT INTO test VALUES (1, 10), (2, 20);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "LOCK TABLE test;",
+					ExpectedErr: "LOCK TABLE can only be used in transaction blocks",
+				},
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "LOCK TABLE test IN ACCESS SHARE MODE;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "LOCK test, other IN SHARE ROW EXCLUSIVE MODE NOWAIT;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "LOCK TABLE ONLY public.test;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, 10}, {2, 20}},
+				},
+				{
+					Query:    "UPDATE test SET v1 = 11 WHERE pk = 1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "LOCK TABLE missing IN EXCLUSIVE MODE;",
+					ExpectedErr: `relation "missing" does not exist`,
+				},
+				{
+					Query:    "ROLLBACK;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, 10}, {2, 20}},
+				},
+			},
+		},
+		{
+			Name: "SELECT with locking clauses",
+			SetUpScript: []string{
+				"CREATE TABLE jobs (id INT4 PRIMARY KEY, state VARCHAR(10));",
+				"CREATE TABLE owners (id INT4 PRIMARY KEY, job_id INT4);",
+				"CREATE TABLE keyless (v INT4);",
+				"INSERT INTO jobs VALUES (1, 'new'), (2, 'new'), (3, 'done');",
+				"INSERT INTO owners VALUES (10, 1), (20, 3);",
+				"INSERT INTO keyless VALUES (1), (2);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT * FROM jobs WHERE state = 'new' ORDER BY id FOR UPDATE;",
+					Expected: []sql.Row{{1, "new"}, {2, "new"}},
+				},
+				{
+					Query:    "SELECT id FROM jobs ORDER BY id DESC LIMIT 1 FOR NO KEY UPDATE SKIP LOCKED;",
+					Expected: []sql.Row{{3}},
+				},
+				{
+					Query:    "SELECT j.id, o.id FROM jobs j JOIN owners o ON j.id = o.job_id ORDER BY j.id FOR SHARE OF j NOWAIT;",
+					Expected: []sql.Row{{1, 10}, {3, 20}},
+				},
+				{
+					Query:    "SELECT j.id, o.id FROM jobs j LEFT JOIN owners o ON j.id = o.job_id ORDER BY j.id FOR KEY SHARE OF o FOR UPDATE OF j;",
+					Expected: []sql.Row{{1, 10}, {2, nil}, {3, 20}},
+				},
+				{
+					Query:    "SELECT v FROM keyless ORDER BY v FOR UPDATE;",
+					Expected: []sql.Row{{1}, {2}},
+				},
+				{
+					Query:       "SELECT * FROM jobs FOR UPDATE OF owners;",
+					ExpectedErr: `relation "owners" in FOR UPDATE clause not found in FROM clause`,
+				},
+				{
+					Query:       "SELECT * FROM jobs j FOR UPDATE OF public.j;",
+					ExpectedErr: "FOR UPDATE must specify unqualified relation names",
+				},
+				{
+					Query:       "SELECT DISTINCT state FROM jobs FOR UPDATE;",
+					ExpectedErr: "FOR UPDATE is not allowed with DISTINCT clause",
+				},
+				{
+					Query:       "SELECT state FROM jobs GROUP BY state FOR SHARE;",
+					ExpectedErr: "FOR SHARE is not allowed with GROUP BY clause",
+				},
+				{
+					Query:       "SELECT count(*) FROM jobs FOR UPDATE;",
+					ExpectedErr: "FOR UPDATE is not allowed with aggregate functions",
+				},
+				{
+					Query:       "SELECT id, row_number() OVER (ORDER BY id) FROM jobs FOR UPDATE;",
+					ExpectedErr: "FOR UPDATE is not allowed with window functions",
+				},
+				{
+					Query:       "SELECT id FROM jobs UNION SELECT id FROM owners FOR UPDATE;",
+					ExpectedErr: "FOR UPDATE is not allowed with UNION/INTERSECT/EXCEPT",
+				},
+				{
+					Query:       "VALUES (1) FOR UPDATE;",
+					ExpectedErr: "FOR UPDATE cannot be applied to VALUES",
+				},
+			},
+		},
+	})
+}
+
+// requireLockError requires that the error is a Postgres error with the given code and message.
+func requireLockError(t *testing.T, err error, code string, message string) {
+	require.Error(t, err)
+	var pgErr *pgconn.PgError
+	require.True(t, errors.As(err, &pgErr), err.Error())
+	assert.Equal(t, code, pgErr.Code)
+	assert.Equal(t, message, pgErr.Message)
+}
+
+func TestTableLocks(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+	_, err := conn.Exec(ctx, "CREATE TABLE test (pk INT4 PRIMARY KEY, v1 INT4);")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "INSERT INTO test VALUES (1, 10);")
+	require.NoError(t, err)
+	other, err := pgx.Connect(ctx, conn.Config().ConnString())
+	require.NoError(t, err)
+	defer other.Close(ctx)
+	_, err = other.Exec(ctx, "SET lock_timeout = 200;")
+	require.NoError(t, err)
+
+	// Shared locks do not conflict with each other, and reading takes an ACCESS SHARE lock
+	_, err = conn.Exec(ctx, "BEGIN;")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "LOCK TABLE test IN SHARE MODE;")
+	require.NoError(t, err)
+	var count int64
+	require.NoError(t, other.QueryRow(ctx, "SELECT count(*) FROM test;").Scan(&count))
+	assert.Equal(t, int64(1), count)
+	_, err = other.Exec(ctx, "INSERT INTO test VALUES (2, 20);")
+	requireCanceled(t, err, "canceling statement due to lock timeout")
+	_, err = conn.Exec(ctx, "COMMIT;")
+	require.NoError(t, err)
+	_, err = other.Exec(ctx, "INSERT INTO test VALUES (2, 20);")
+	require.NoError(t, err)
+
+	// An ACCESS EXCLUSIVE lock conflicts with reads
+	_, err = conn.Exec(ctx, "BEGIN;")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "LOCK TABLE test IN ACCESS EXCLUSIVE MODE;")
+	require.NoError(t, err)
+	_, err = other.Exec(ctx, "SELECT * FROM test;")
+	requireCanceled(t, err, "canceling statement due to lock timeout")
+	_, err = other.Exec(ctx, "BEGIN;")
+	require.NoError(t, err)
+	_, err = other.Exec(ctx, "LOCK TABLE test IN ACCESS SHARE MODE NOWAIT;")
+	requireLockError(t, err, "55P03", `could not obtain lock on relation "test"`)
+	_, err = other.Exec(ctx, "ROLLBACK;")
+	require.NoError(t, err)
+
+	// A waiting session continues once the lock is released at the end of the transaction
+	_, err = other.Exec(ctx, "SET lock_timeout = 0;")
+	require.NoError(t, err)
+	released := make(chan error, 1)
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		_, err := conn.Exec(ctx, "ROLLBACK;")
+		released <- err
+	}()
+	require.NoError(t, other.QueryRow(ctx, "SELECT count(*) FROM test;").Scan(&count))
+	assert.Equal(t, int64(2), count)
+	require.NoError(t, <-released)
+
+	// Locks held by a reading transaction block an ACCESS EXCLUSIVE lock until the transaction ends
+	_, err = conn.Exec(ctx, "BEGIN;")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "SELECT * FROM test;")
+	require.NoError(t, err)
+	_, err = other.Exec(ctx, "SET lock_timeout = 200;")
+	require.NoError(t, err)
+	_, err = other.Exec(ctx, "BEGIN;")
+	require.NoError(t, err)
+	_, err = other.Exec(ctx, "LOCK TABLE test;")
+	requireCanceled(t, err, "canceling statement due to lock timeout")
+	_, err = other.Exec(ctx, "ROLLBACK;")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "COMMIT;")
+	require.NoError(t, err)
+	_, err = other.Exec(ctx, "BEGIN;")
+	require.NoError(t, err)
+	_, err = other.Exec(ctx, "LOCK TABLE test;")
+	require.NoError(t, err)
+
+	// Locks are released when their session ends
+	require.NoError(t, other.Close(ctx))
+	require.Eventually(t, func() bool {
+		_, err := conn.Exec(ctx, "SELECT * FROM test;")
+		return err == nil
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestRowLocks(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+	_, err := conn.Exec(ctx, "CREATE TABLE jobs (id INT4 PRIMARY KEY, state VARCHAR(10));")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "INSERT INTO jobs VALUES (1, 'new'), (2, 'new'), (3, 'new');")
+	require.NoError(t, err)
+	other, err := pgx.Connect(ctx, conn.Config().ConnString())
+	require.NoError(t, err)
+	defer other.Close(ctx)
+	_, err = other.Exec(ctx, "SET lock_timeout = 200;")
+	require.NoError(t, err)
+
+	_, err = conn.Exec(ctx, "BEGIN;")
+	require.NoError(t, err)
+	var id int32
+	require.NoError(t, conn.QueryRow(ctx, "SELECT id FROM jobs ORDER BY id LIMIT 1 FOR UPDATE SKIP LOCKED;").Scan(&id))
+	assert.Equal(t, int32(1), id)
+	_, err = conn.Exec(ctx, "SELECT id FROM jobs WHERE id = 3 FOR SHARE;")
+	require.NoError(t, err)
+
+	// Other sessions skip the locked row, or fail to lock it
+	_, err = other.Exec(ctx, "BEGIN;")
+	require.NoError(t, err)
+	require.NoError(t, other.QueryRow(ctx, "SELECT id FROM jobs ORDER BY id LIMIT 1 FOR UPDATE SKIP LOCKED;").Scan(&id))
+	assert.Equal(t, int32(2), id)
+	_, err = other.Exec(ctx, "SELECT * FROM jobs WHERE id = 1 FOR KEY SHARE NOWAIT;")
+	requireLockError(t, err, "55P03", `could not obtain lock on row in relation "jobs"`)
+	_, err = other.Exec(ctx, "ROLLBACK;")
+	require.NoError(t, err)
+	_, err = other.Exec(ctx, "BEGIN;")
+	require.NoError(t, err)
+	_, err = other.Exec(ctx, "SELECT * FROM jobs WHERE id = 1 FOR UPDATE;")
+	requireCanceled(t, err, "canceling statement due to lock timeout")
+	_, err = other.Exec(ctx, "ROLLBACK;")
+	require.NoError(t, err)
+
+	// Shared row locks are compatible, while reads without a locking clause are never blocked
+	_, err = other.Exec(ctx, "BEGIN;")
+	require.NoError(t, err)
+	_, err = other.Exec(ctx, "SELECT * FROM jobs WHERE id = 3 FOR SHARE NOWAIT;")
+	require.NoError(t, err)
+	var count int64
+	require.NoError(t, other.QueryRow(ctx, "SELECT count(*) FROM jobs;").Scan(&count))
+	assert.Equal(t, int64(3), count)
+	_, err = other.Exec(ctx, "SELECT * FROM jobs WHERE id = 3 FOR NO KEY UPDATE NOWAIT;")
+	requireLockError(t, err, "55P03", `could not obtain lock on row in relation "jobs"`)
+	_, err = other.Exec(ctx, "ROLLBACK;")
+	require.NoError(t, err)
+
+	// The row locks are released at the end of the transaction
+	_, err = conn.Exec(ctx, "COMMIT;")
+	require.NoError(t, err)
+	_, err = other.Exec(ctx, "BEGIN;")
+	require.NoError(t, err)
+	_, err = other.Exec(ctx, "SELECT * FROM jobs WHERE id = 1 FOR UPDATE NOWAIT;")
+	require.NoError(t, err)
+	_, err = other.Exec(ctx, "ROLLBACK;")
+	require.NoError(t, err)
+
+	// Sessions that wait on each other's locks are deadlocked, which is reported to one of them
+	_, err = other.Exec(ctx, "SET lock_timeout = 0;")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "BEGIN;")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "SELECT * FROM jobs WHERE id = 1 FOR UPDATE;")
+	require.NoError(t, err)
+	_, err = other.Exec(ctx, "BEGIN;")
+	require.NoError(t, err)
+	_, err = other.Exec(ctx, "SELECT * FROM jobs WHERE id = 2 FOR UPDATE;")
+	require.NoError(t, err)
+	waited := make(chan error, 1)
+	go func() {
+		_, err := other.Exec(ctx, "SELECT * FROM jobs WHERE id = 1 FOR UPDATE;")
+		waited <- err
+	}()
+	time.Sleep(200 * time.Millisecond)
+	_, err = conn.Exec(ctx, "SELECT * FROM jobs WHERE id = 2 FOR UPDATE;")
+	requireLockError(t, err, "40P01", "deadlock detected")
+	_, err = conn.Exec(ctx, "ROLLBACK;")
+	require.NoError(t, err)
+	require.NoError(t, <-waited)
+	_, err = other.Exec(ctx, "COMMIT;")
+	require.NoError(t, err)
+}
+
+func TestPgLocks(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+	_, err := conn.Exec(ctx, "CREATE TABLE test (pk INT4 PRIMARY KEY, v1 INT4);")
+	require.NoError(t, err)
+	other, err := pgx.Connect(ctx, conn.Config().ConnString())
+	require.NoError(t, err)
+	defer other.Close(ctx)
+	lockQuery := "SELECT l.locktype, c.relname, l.mode, l.granted, l.waitstart IS NULL FROM pg_locks l " +
+		"JOIN pg_class c ON l.relation = c.oid WHERE c.relname = 'test' ORDER BY l.granted DESC, l.mode;"
+	queryLocks := func() [][]any {
+		rows, err := conn.Query(ctx, lockQuery)
+		require.NoError(t, err)
+		var locks [][]any
+		for rows.Next() {
+			values, err := rows.Values()
+			require.NoError(t, err)
+			locks = append(locks, values)
+		}
+		require.NoError(t, rows.Err())
+		return locks
+	}
+
+	// Table locks are listed until the transaction ends
+	_, err = conn.Exec(ctx, "BEGIN;")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "LOCK TABLE test IN SHARE MODE;")
+	require.NoError(t, err)
+	assert.Equal(t, [][]any{{"relation", "test", "ShareLock", "t", int16(1)}}, queryLocks())
+
+	// A session waiting on a conflicting lock is listed as not granted, along with the time that it started waiting
+	waited := make(chan error, 1)
+	go func() {
+		_, err := other.Exec(ctx, "INSERT INTO test VALUES (1, 10);")
+		waited <- err
+	}()
+	require.Eventually(t, func() bool {
+		return len(queryLocks()) == 2
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.Equal(t, [][]any{
+		{"relation", "test", "ShareLock", "t", int16(1)},
+		{"relation", "test", "RowExclusiveLock", "f", int16(0)},
+	}, queryLocks())
+	_, err = conn.Exec(ctx, "COMMIT;")
+	require.NoError(t, err)
+	require.NoError(t, <-waited)
+	assert.Empty(t, queryLocks())
+
+	// Advisory locks are listed by their key until they're released
+	_, err = other.Exec(ctx, "SELECT pg_advisory_lock(4294967298);")
+	require.NoError(t, err)
+	var classid, objid uint32
+	var objsubid int16
+	var mode, granted string
+	require.NoError(t, conn.QueryRow(ctx, "SELECT classid, objid, objsubid, mode, granted FROM pg_locks WHERE locktype = 'advisory';").
+		Scan(&classid, &objid, &objsubid, &mode, &granted))
+	assert.Equal(t, uint32(1), classid)
+	assert.Equal(t, uint32(2), objid)
+	assert.Equal(t, int16(1), objsubid)
+	assert.Equal(t, "ExclusiveLock", mode)
+	assert.Equal(t, "t", granted)
+	_, err = other.Exec(ctx, "SELECT pg_advisory_unlock(4294967298);")
+	require.NoError(t, err)
+	var count int64
+	require.NoError(t, conn.QueryRow(ctx, "SELECT count(*) FROM pg_locks WHERE locktype = 'advisory';").Scan(&count))
+	assert.Equal(t, int64(0), count)
+}
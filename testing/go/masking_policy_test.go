@@ -0,0 +1,207 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestMaskingPolicies(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "Masking policies replace column values for a role",
+			SetUpScript: []string{
+				"CREATE TABLE users (id INT4 PRIMARY KEY, name TEXT, email TEXT, ssn TEXT);",
+				"INSERT INTO users VALUES (1, 'alice', 'alice@example.com', '123-45-6789'), (2, 'bob', 'bob@example.com', '987-65-4321');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE MASKING POLICY mask_ssn ON users.ssn FOR postgres USING concat('XXX-XX-', substr(ssn, 8));",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE MASKING POLICY mask_email ON public.users.email FOR postgres USING concat(name, '@***');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE MASKING POLICY mask_name ON users.name FOR someone_else USING 'hidden';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query: "SELECT * FROM users ORDER BY id;",
+					Expected: []sql.Row{
+						{1, "alice", "alice@***", "XXX-XX-6789"},
+						{2, "bob", "bob@***", "XXX-XX-4321"},
+					},
+				},
+				{
+					Query:    "SELECT id FROM users WHERE ssn = '123-45-6789';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT id, ssn FROM users WHERE ssn = 'XXX-XX-4321';",
+					Expected: []sql.Row{{2, "XXX-XX-4321"}},
+				},
+				{
+					Query:    "SELECT u.email, v.ssn FROM users u JOIN users v ON u.id = v.id WHERE v.id = 1;",
+					Expected: []sql.Row{{"alice@***", "XXX-XX-6789"}},
+				},
+				{
+					Query:    "UPDATE users SET name = 'carol' WHERE id = 2;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query: "SELECT id, name, ssn FROM users ORDER BY id;",
+					Expected: []sql.Row{
+						{1, "alice", "XXX-XX-6789"},
+						{2, "carol", "XXX-XX-4321"},
+					},
+				},
+				{
+					Query:    "CREATE TABLE users_copy (id INT4 PRIMARY KEY, ssn TEXT);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO users_copy SELECT id, ssn FROM users;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT ssn FROM users_copy ORDER BY id;",
+					Expected: []sql.Row{{"XXX-XX-6789"}, {"XXX-XX-4321"}},
+				},
+				{
+					Query:    "DROP MASKING POLICY mask_ssn;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query: "SELECT ssn FROM users ORDER BY id;",
+					Expected: []sql.Row{
+						{"123-45-6789"},
+						{"987-65-4321"},
+					},
+				},
+				{
+					Query:    "DROP MASKING POLICY IF EXISTS mask_ssn;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "DROP MASKING POLICY mask_ssn;",
+					ExpectedErr: `masking policy "mask_ssn" does not exist`,
+				},
+			},
+		},
+		{
+			Name: "Masking policies for the public role",
+			SetUpScript: []string{
+				"CREATE TABLE accounts (id INT4 PRIMARY KEY, balance INT8);",
+				"INSERT INTO accounts VALUES (1, 100), (2, 2500);",
+				"CREATE VIEW account_view AS SELECT id, balance FROM accounts;",
+				"CREATE MASKING POLICY round_balance ON accounts.balance FOR public USING (balance / 1000) * 1000;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT * FROM accounts ORDER BY id;",
+					Expected: []sql.Row{{1, 0}, {2, 2000}},
+				},
+				{
+					Query:    "SELECT * FROM account_view ORDER BY id;",
+					Expected: []sql.Row{{1, 0}, {2, 2000}},
+				},
+				{
+					Query:    "SELECT id FROM accounts WHERE balance = 2000;",
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:    "CREATE MASKING POLICY hide_balance ON accounts.balance FOR postgres USING 0;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM accounts ORDER BY id;",
+					Expected: []sql.Row{{1, 0}, {2, 0}},
+				},
+			},
+		},
+		{
+			Name: "Masking policy errors",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 TEXT);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "CREATE MASKING POLICY p1 ON missing.v1 FOR postgres USING 'x';",
+					ExpectedErr: `relation "missing" does not exist`,
+				},
+				{
+					Query:       "CREATE MASKING POLICY p1 ON test.v2 FOR postgres USING 'x';",
+					ExpectedErr: `column "v2" of relation "test" does not exist`,
+				},
+				{
+					Query:    "CREATE MASKING POLICY p1 ON test.v1 FOR postgres USING 'x';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "CREATE MASKING POLICY p1 ON test.pk FOR postgres USING 0;",
+					ExpectedErr: `masking policy "p1" already exists`,
+				},
+				{
+					Query:       "CREATE MASKING POLICY p2 ON test.v1 FOR postgres USING 'y';",
+					ExpectedErr: `is already masked for role "postgres" by policy "p1"`,
+				},
+			},
+		},
+		{
+			Name: "Masking policies require ownership of the table",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 TEXT);",
+				"CREATE ROLE alice LOGIN;",
+				"GRANT CREATE ON SCHEMA public TO alice;",
+				"CREATE MASKING POLICY p1 ON test.v1 FOR alice USING 'x';",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "CREATE MASKING POLICY p2 ON test.v1 FOR postgres USING 'y';",
+					Username:    "alice",
+					ExpectedErr: "must be owner of table test",
+				},
+				{
+					Query:       "DROP MASKING POLICY p1;",
+					Username:    "alice",
+					ExpectedErr: "must be owner of table test",
+				},
+				{
+					Query:    "CREATE TABLE mine (pk INT4 PRIMARY KEY, v1 TEXT);",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE MASKING POLICY p3 ON mine.v1 FOR postgres USING 'z';",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DROP MASKING POLICY p3;",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DROP MASKING POLICY p1;",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+	})
+}
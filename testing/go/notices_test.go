@@ -0,0 +1,157 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotices(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+
+	var mutex sync.Mutex
+	var received []*pgconn.Notice
+	config, err := pgx.ParseConfig(conn.Config().ConnString())
+	require.NoError(t, err)
+	config.OnNotice = func(_ *pgconn.PgConn, notice *pgconn.Notice) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		received = append(received, notice)
+	}
+	noticeConn, err := pgx.ConnectConfig(ctx, config)
+	require.NoError(t, err)
+	defer noticeConn.Close(context.Background())
+	// takeNotices returns the notices received since the last call
+	takeNotices := func() []*pgconn.Notice {
+		mutex.Lock()
+		defer mutex.Unlock()
+		notices := received
+		received = nil
+		return notices
+	}
+
+	t.Run("Skipped drops raise a notice", func(t *testing.T) {
+		ExecQueries(t, noticeConn, "DROP SEQUENCE IF EXISTS missing_seq;")
+		notices := takeNotices()
+		require.Len(t, notices, 1)
+		assert.Equal(t, "NOTICE", notices[0].Severity)
+		assert.Equal(t, "00000", notices[0].Code)
+		assert.Equal(t, `sequence "missing_seq" does not exist, skipping`, notices[0].Message)
+
+		ExecQueries(t, noticeConn, "DROP INDEX IF EXISTS missing_idx;")
+		notices = takeNotices()
+		require.Len(t, notices, 1)
+		assert.Equal(t, `index "missing_idx" does not exist, skipping`, notices[0].Message)
+	})
+
+	t.Run("Skipped creates raise a notice", func(t *testing.T) {
+		ExecQueries(t, noticeConn, "CREATE SEQUENCE existing_seq;")
+		assert.Empty(t, takeNotices())
+		ExecQueries(t, noticeConn, "CREATE SEQUENCE IF NOT EXISTS existing_seq;")
+		notices := takeNotices()
+		require.Len(t, notices, 1)
+		assert.Equal(t, `relation "existing_seq" already exists, skipping`, notices[0].Message)
+	})
+
+	t.Run("Notices are sent with the extended protocol", func(t *testing.T) {
+		_, err := noticeConn.Exec(ctx, "DROP SEQUENCE IF EXISTS missing_seq;", pgx.QueryExecModeCacheStatement)
+		require.NoError(t, err)
+		notices := takeNotices()
+		require.Len(t, notices, 1)
+		assert.Equal(t, `sequence "missing_seq" does not exist, skipping`, notices[0].Message)
+	})
+
+	t.Run("client_min_messages filters notices", func(t *testing.T) {
+		ExecQueries(t, noticeConn, "SET client_min_messages TO warning;")
+		ExecQueries(t, noticeConn, "DROP SEQUENCE IF EXISTS missing_seq;")
+		assert.Empty(t, takeNotices())
+		ExecQueries(t, noticeConn, "SET client_min_messages TO debug1;")
+		ExecQueries(t, noticeConn, "DROP SEQUENCE IF EXISTS missing_seq;")
+		assert.Len(t, takeNotices(), 1)
+		ExecQueries(t, noticeConn, "RESET client_min_messages;")
+		ExecQueries(t, noticeConn, "DROP SEQUENCE IF EXISTS missing_seq;")
+		assert.Len(t, takeNotices(), 1)
+	})
+
+	t.Run("client_min_messages filters warnings sent by the handler", func(t *testing.T) {
+		ExecQueries(t, noticeConn, "SET client_min_messages TO error;")
+		ExecQueries(t, noticeConn, "SET LOCAL search_path TO public;")
+		assert.Empty(t, takeNotices())
+		ExecQueries(t, noticeConn, "RESET client_min_messages;")
+		ExecQueries(t, noticeConn, "SET LOCAL search_path TO public;")
+		notices := takeNotices()
+		require.Len(t, notices, 1)
+		assert.Equal(t, "SET LOCAL can only be used in transaction blocks", notices[0].Message)
+	})
+
+	t.Run("RAISE sends notices from PL/pgSQL", func(t *testing.T) {
+		ExecQueries(t, noticeConn, `CREATE FUNCTION raise_notices(val INT4) RETURNS INT4 LANGUAGE plpgsql AS $$
+BEGIN
+	RAISE NOTICE 'value is %', val USING DETAIL = 'some detail';
+	RAISE WARNING 'warning' USING ERRCODE = 'P0001';
+	RETURN val;
+END;
+$$;`)
+		assert.Empty(t, takeNotices())
+		ExecQueries(t, noticeConn, "SELECT raise_notices(7);")
+		notices := takeNotices()
+		require.Len(t, notices, 2)
+		assert.Equal(t, "NOTICE", notices[0].Severity)
+		assert.Equal(t, "00000", notices[0].Code)
+		assert.Equal(t, "value is 7", notices[0].Message)
+		assert.Equal(t, "some detail", notices[0].Detail)
+		assert.Equal(t, "WARNING", notices[1].Severity)
+		assert.Equal(t, "P0001", notices[1].Code)
+		assert.Equal(t, "warning", notices[1].Message)
+	})
+	t.Run("CASCADE lists the dropped dependents", func(t *testing.T) {
+		ExecQueries(t, noticeConn, "CREATE TABLE cascaded (pk INT4 PRIMARY KEY);")
+		ExecQueries(t, noticeConn, "CREATE VIEW cascaded_view AS SELECT pk FROM cascaded;")
+		_, err := noticeConn.Exec(ctx, "DROP TABLE cascaded;")
+		var pgErr *pgconn.PgError
+		require.ErrorAs(t, err, &pgErr)
+		assert.Equal(t, "2BP01", pgErr.Code)
+		assert.Equal(t, "cannot drop table cascaded because other objects depend on it", pgErr.Message)
+		assert.Equal(t, "view cascaded_view depends on table cascaded", pgErr.Detail)
+		assert.Equal(t, "Use DROP ... CASCADE to drop the dependent objects too.", pgErr.Hint)
+		takeNotices()
+
+		ExecQueries(t, noticeConn, "DROP TABLE cascaded CASCADE;")
+		notices := takeNotices()
+		require.Len(t, notices, 1)
+		assert.Equal(t, "drop cascades to view cascaded_view", notices[0].Message)
+
+		ExecQueries(t, noticeConn, "CREATE SCHEMA cascaded_schema;")
+		ExecQueries(t, noticeConn, "CREATE TABLE cascaded_schema.t1 (pk INT4 PRIMARY KEY);")
+		ExecQueries(t, noticeConn, "CREATE SEQUENCE cascaded_schema.s1;")
+		ExecQueries(t, noticeConn, "DROP SCHEMA cascaded_schema CASCADE;")
+		notices = takeNotices()
+		require.Len(t, notices, 1)
+		assert.Equal(t, "drop cascades to 2 other objects", notices[0].Message)
+		assert.Equal(t, "drop cascades to table cascaded_schema.t1\ndrop cascades to sequence cascaded_schema.s1", notices[0].Detail)
+	})
+}
@@ -0,0 +1,93 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParameterStatus(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+	connect := func(runtimeParams map[string]string) (*pgx.Conn, error) {
+		config, err := pgx.ParseConfig(conn.Config().ConnString())
+		require.NoError(t, err)
+		for name, value := range runtimeParams {
+			config.RuntimeParams[name] = value
+		}
+		return pgx.ConnectConfig(ctx, config)
+	}
+	showValue := func(conn *pgx.Conn, name string) string {
+		var value string
+		require.NoError(t, conn.QueryRow(ctx, "SHOW "+name+";", pgx.QueryExecModeSimpleProtocol).Scan(&value))
+		return value
+	}
+
+	t.Run("Parameters are reported on startup", func(t *testing.T) {
+		pgConn := conn.PgConn()
+		assert.Equal(t, "UTF8", pgConn.ParameterStatus("client_encoding"))
+		assert.Equal(t, "UTF8", pgConn.ParameterStatus("server_encoding"))
+		assert.Equal(t, "on", pgConn.ParameterStatus("integer_datetimes"))
+		assert.Equal(t, "on", pgConn.ParameterStatus("standard_conforming_strings"))
+		assert.Equal(t, "ISO, MDY", pgConn.ParameterStatus("DateStyle"))
+		assert.NotEmpty(t, pgConn.ParameterStatus("TimeZone"))
+		assert.NotEmpty(t, pgConn.ParameterStatus("server_version"))
+		assert.Equal(t, "psql", pgConn.ParameterStatus("application_name"))
+	})
+
+	t.Run("Startup parameters are applied", func(t *testing.T) {
+		newConn, err := connect(map[string]string{
+			"application_name": "my_app",
+			"options":          `-c search_path=custom_schema --DateStyle=ISO,\ DMY`,
+		})
+		require.NoError(t, err)
+		defer newConn.Close(context.Background())
+		assert.Equal(t, "my_app", newConn.PgConn().ParameterStatus("application_name"))
+		assert.Equal(t, "ISO, DMY", newConn.PgConn().ParameterStatus("DateStyle"))
+		assert.Equal(t, "my_app", showValue(newConn, "application_name"))
+		assert.Equal(t, "custom_schema", showValue(newConn, "search_path"))
+	})
+
+	t.Run("Changed parameters are reported", func(t *testing.T) {
+		newConn, err := connect(nil)
+		require.NoError(t, err)
+		defer newConn.Close(context.Background())
+		_, err = newConn.Exec(ctx, "SET application_name TO 'changed';")
+		require.NoError(t, err)
+		assert.Equal(t, "changed", newConn.PgConn().ParameterStatus("application_name"))
+		_, err = newConn.Exec(ctx, "SET standard_conforming_strings TO 'off';")
+		require.NoError(t, err)
+		assert.Equal(t, "off", newConn.PgConn().ParameterStatus("standard_conforming_strings"))
+		_, err = newConn.Exec(ctx, "RESET application_name;")
+		require.NoError(t, err)
+		assert.Equal(t, "psql", newConn.PgConn().ParameterStatus("application_name"))
+	})
+
+	t.Run("Invalid startup parameters are rejected", func(t *testing.T) {
+		_, err := connect(map[string]string{"not_a_parameter": "value"})
+		require.ErrorContains(t, err, `unrecognized configuration parameter "not_a_parameter"`)
+		_, err = connect(map[string]string{"options": "-x"})
+		require.ErrorContains(t, err, "invalid command-line argument for server process: -x")
+	})
+}
@@ -0,0 +1,504 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestPartitions(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "Range partitioning",
+			SetUpScript: []string{
+				"CREATE TABLE measurements (id INT4, logdate DATE, reading INT4) PARTITION BY RANGE (logdate);",
+				"CREATE TABLE measurements_2023 PARTITION OF measurements FOR VALUES FROM ('2023-01-01') TO ('2024-01-01');",
+				"CREATE TABLE measurements_2024 PARTITION OF measurements FOR VALUES FROM ('2024-01-01') TO ('2025-01-01');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "INSERT INTO measurements VALUES (1, '2023-03-01', 10), (2, '2024-06-15', 20), (3, '2023-12-31', 30), (4, '2024-01-01', 40);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM measurements ORDER BY id;",
+					Expected: []sql.Row{{1, "2023-03-01", 10}, {2, "2024-06-15", 20}, {3, "2023-12-31", 30}, {4, "2024-01-01", 40}},
+				},
+				{
+					Query:    "SELECT id FROM measurements_2023 ORDER BY id;",
+					Expected: []sql.Row{{1}, {3}},
+				},
+				{
+					Query:    "SELECT id FROM measurements_2024 ORDER BY id;",
+					Expected: []sql.Row{{2}, {4}},
+				},
+				{
+					Query:    "SELECT id FROM measurements WHERE logdate >= '2024-01-01'::date ORDER BY id;",
+					Expected: []sql.Row{{2}, {4}},
+				},
+				{
+					Query:    "SELECT id FROM measurements WHERE logdate < '2024-01-01'::date AND reading > 10 ORDER BY id;",
+					Expected: []sql.Row{{3}},
+				},
+				{
+					Query:    "SELECT m.id FROM measurements m WHERE m.logdate = '2024-06-15'::date;",
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:    "SELECT id FROM measurements WHERE logdate IN ('2023-03-01'::date, '2024-01-01'::date) ORDER BY id;",
+					Expected: []sql.Row{{1}, {4}},
+				},
+				{
+					Query:       "INSERT INTO measurements VALUES (5, '2025-02-01', 50);",
+					ExpectedErr: `no partition of relation "measurements" found for row`,
+				},
+				{
+					Query:       "INSERT INTO measurements_2023 VALUES (5, '2025-02-01', 50);",
+					ExpectedErr: `new row for relation "measurements_2023" violates partition constraint`,
+				},
+				{
+					Query:       "UPDATE measurements_2023 SET logdate = '2024-03-01' WHERE id = 1;",
+					ExpectedErr: `new row for relation "measurements_2023" violates partition constraint`,
+				},
+				{
+					Query:    "INSERT INTO measurements_2023 VALUES (6, '2023-05-01', 60);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DELETE FROM measurements_2023 WHERE id = 6;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "UPDATE measurements SET reading = reading + 1 WHERE logdate = '2023-03-01'::date;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "UPDATE measurements SET logdate = '2024-03-01' WHERE id = 3;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT id, logdate, reading FROM measurements_2024 ORDER BY id;",
+					Expected: []sql.Row{{2, "2024-06-15", 20}, {3, "2024-03-01", 30}, {4, "2024-01-01", 40}},
+				},
+				{
+					Query:    "SELECT id, reading FROM measurements_2023 ORDER BY id;",
+					Expected: []sql.Row{{1, 11}},
+				},
+				{
+					Query:    "DELETE FROM measurements WHERE logdate >= '2024-06-01'::date;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT id FROM measurements ORDER BY id;",
+					Expected: []sql.Row{{1}, {3}, {4}},
+				},
+				{
+					Query:       "CREATE TABLE measurements_overlap PARTITION OF measurements FOR VALUES FROM ('2023-06-01') TO ('2023-07-01');",
+					ExpectedErr: `partition "measurements_overlap" would overlap partition "measurements_2023"`,
+				},
+				{
+					Query:       "CREATE TABLE measurements_empty PARTITION OF measurements FOR VALUES FROM ('2026-01-01') TO ('2025-01-01');",
+					ExpectedErr: `empty range bound specified for partition "measurements_empty"`,
+				},
+				{
+					Query:       "CREATE TABLE measurements_list PARTITION OF measurements FOR VALUES IN ('2026-01-01');",
+					ExpectedErr: "invalid bound specification for a range partition",
+				},
+				{
+					Query:    "CREATE TABLE measurements_rest PARTITION OF measurements FOR VALUES FROM ('2025-01-01') TO (MAXVALUE);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO measurements VALUES (5, '2031-02-01', 50);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT id FROM measurements_rest;",
+					Expected: []sql.Row{{5}},
+				},
+				{
+					Query:    "SELECT count(*) FROM measurements;",
+					Expected: []sql.Row{{4}},
+				},
+				{
+					Query:    "TRUNCATE measurements_rest;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT count(*) FROM measurements;",
+					Expected: []sql.Row{{3}},
+				},
+				{
+					Query:    "TRUNCATE measurements;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT count(*) FROM measurements_2023;",
+					Expected: []sql.Row{{0}},
+				},
+			},
+		},
+		{
+			Name: "Range partitioning on multiple columns",
+			SetUpScript: []string{
+				"CREATE TABLE sales (region INT4, yr INT4, amount INT4) PARTITION BY RANGE (yr, region);",
+				"CREATE TABLE sales_low PARTITION OF sales FOR VALUES FROM (MINVALUE, MINVALUE) TO (2020, 5);",
+				"CREATE TABLE sales_high PARTITION OF sales FOR VALUES FROM (2020, 5) TO (MAXVALUE, MAXVALUE);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "INSERT INTO sales VALUES (1, 2020, 100), (7, 2020, 200), (1, 2019, 300), (1, 2021, 400);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT region, yr FROM sales_low ORDER BY yr, region;",
+					Expected: []sql.Row{{1, 2019}, {1, 2020}},
+				},
+				{
+					Query:    "SELECT region, yr FROM sales_high ORDER BY yr, region;",
+					Expected: []sql.Row{{7, 2020}, {1, 2021}},
+				},
+				{
+					Query:    "SELECT amount FROM sales WHERE yr = 2020 ORDER BY amount;",
+					Expected: []sql.Row{{100}, {200}},
+				},
+				{
+					Query:    "SELECT amount FROM sales WHERE yr = 2020 AND region = 7;",
+					Expected: []sql.Row{{200}},
+				},
+				{
+					Query:       "INSERT INTO sales VALUES (NULL, 2020, 1);",
+					ExpectedErr: `no partition of relation "sales" found for row`,
+				},
+			},
+		},
+		{
+			Name: "List partitioning with a default partition",
+			SetUpScript: []string{
+				"CREATE TABLE cities (id INT4, name TEXT, region TEXT) PARTITION BY LIST (region);",
+				"CREATE TABLE cities_west PARTITION OF cities FOR VALUES IN ('west', 'pacific');",
+				"CREATE TABLE cities_east PARTITION OF cities FOR VALUES IN ('east', NULL);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "CREATE TABLE cities_bad (id INT4 PRIMARY KEY, region TEXT) PARTITION BY LIST (region);",
+					ExpectedErr: "unique constraint on partitioned table must include all partitioning columns",
+				},
+				{
+					Query:       "CREATE TABLE cities_bad (id INT4, region TEXT) PARTITION BY LIST (id, region);",
+					ExpectedErr: "PARTITION BY LIST must have a single column or expression",
+				},
+				{
+					Query:       "CREATE TABLE cities_bad (id INT4, region TEXT) PARTITION BY LIST (missing);",
+					ExpectedErr: `column "missing" named in partition key does not exist`,
+				},
+				{
+					Query:    "CREATE TABLE regions (id INT4, region INT4, PRIMARY KEY (id, region)) PARTITION BY LIST (region);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE TABLE regions_west PARTITION OF regions FOR VALUES IN (1);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE TABLE regions_other PARTITION OF regions DEFAULT;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO regions VALUES (1, 1), (2, 2), (3, 3);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT id FROM regions_other ORDER BY id;",
+					Expected: []sql.Row{{2}, {3}},
+				},
+				{
+					Query:    "SELECT id FROM regions WHERE region = 2;",
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:    "SELECT id FROM regions WHERE region = 1;",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:       "CREATE TABLE regions_north PARTITION OF regions FOR VALUES IN (2);",
+					ExpectedErr: `updated partition constraint for default partition "regions_other" would be violated by some row`,
+				},
+				{
+					Query:    "CREATE TABLE regions_east PARTITION OF regions FOR VALUES IN (4);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "CREATE TABLE regions_default PARTITION OF regions DEFAULT;",
+					ExpectedErr: `partition "regions_default" conflicts with existing default partition "regions_other"`,
+				},
+				{
+					Query:       "INSERT INTO regions_other VALUES (5, 4);",
+					ExpectedErr: `new row for relation "regions_other" violates partition constraint`,
+				},
+				{
+					Query:    "INSERT INTO regions_other VALUES (5, 5);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO cities VALUES (1, 'Seattle', 'west'), (2, 'Honolulu', 'pacific'), (3, 'Boston', 'east'), (4, 'Nowhere', NULL);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT name FROM cities_west ORDER BY id;",
+					Expected: []sql.Row{{"Seattle"}, {"Honolulu"}},
+				},
+				{
+					Query:    "SELECT name FROM cities_east ORDER BY id;",
+					Expected: []sql.Row{{"Boston"}, {"Nowhere"}},
+				},
+				{
+					Query:    "SELECT name FROM cities WHERE region IN ('pacific', 'east') ORDER BY id;",
+					Expected: []sql.Row{{"Honolulu"}, {"Boston"}},
+				},
+				{
+					Query:    "SELECT name FROM cities WHERE region IS NULL;",
+					Expected: []sql.Row{{"Nowhere"}},
+				},
+				{
+					Query:       "INSERT INTO cities VALUES (5, 'Chicago', 'central');",
+					ExpectedErr: `no partition of relation "cities" found for row`,
+				},
+				{
+					Query:       "CREATE TABLE cities_overlap PARTITION OF cities FOR VALUES IN ('central', 'east');",
+					ExpectedErr: `partition "cities_overlap" would overlap partition "cities_east"`,
+				},
+			},
+		},
+		{
+			Name: "Hash partitioning",
+			SetUpScript: []string{
+				"CREATE TABLE events (id INT4 PRIMARY KEY, payload TEXT) PARTITION BY HASH (id);",
+				"CREATE TABLE events_0 PARTITION OF events FOR VALUES WITH (MODULUS 2, REMAINDER 0);",
+				"CREATE TABLE events_1 PARTITION OF events FOR VALUES WITH (MODULUS 2, REMAINDER 1);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "INSERT INTO events VALUES (1, 'a'), (2, 'b'), (3, 'c'), (4, 'd'), (5, 'e'), (6, 'f');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT count(*) FROM events;",
+					Expected: []sql.Row{{6}},
+				},
+				{
+					Query:    "SELECT id FROM events_0 ORDER BY id;",
+					Expected: []sql.Row{{2}, {4}, {6}},
+				},
+				{
+					Query:    "SELECT id FROM events_1 ORDER BY id;",
+					Expected: []sql.Row{{1}, {3}, {5}},
+				},
+				{
+					Query:    "SELECT payload FROM events WHERE id = 4;",
+					Expected: []sql.Row{{"d"}},
+				},
+				{
+					Query:    "SELECT payload FROM events WHERE id IN (1, 6) ORDER BY id;",
+					Expected: []sql.Row{{"a"}, {"f"}},
+				},
+				{
+					Query:       "CREATE TABLE events_2 PARTITION OF events FOR VALUES WITH (MODULUS 4, REMAINDER 1);",
+					ExpectedErr: `partition "events_2" would overlap partition "events_1"`,
+				},
+				{
+					Query:       "CREATE TABLE events_3 PARTITION OF events FOR VALUES WITH (MODULUS 3, REMAINDER 2);",
+					ExpectedErr: "every hash partition modulus must be a factor of the next larger modulus",
+				},
+				{
+					Query:       "CREATE TABLE events_4 PARTITION OF events FOR VALUES WITH (MODULUS 2, REMAINDER 2);",
+					ExpectedErr: "remainder for hash partition must be less than modulus",
+				},
+				{
+					Query:       "CREATE TABLE events_5 PARTITION OF events DEFAULT;",
+					ExpectedErr: "a hash-partitioned table may not have a default partition",
+				},
+			},
+		},
+		{
+			Name: "ATTACH and DETACH PARTITION",
+			SetUpScript: []string{
+				"CREATE TABLE orders (id INT4, created DATE, total INT4) PARTITION BY RANGE (created);",
+				"CREATE TABLE orders_2023 PARTITION OF orders FOR VALUES FROM ('2023-01-01') TO ('2024-01-01');",
+				"INSERT INTO orders VALUES (1, '2023-05-05', 100);",
+				"CREATE TABLE orders_2024 (total INT4, id INT4, created DATE);",
+				"INSERT INTO orders_2024 VALUES (200, 2, '2024-02-02');",
+				"CREATE TABLE orders_bad (id INT4, created DATE, total INT4);",
+				"INSERT INTO orders_bad VALUES (3, '2022-01-01', 300);",
+				"CREATE TABLE orders_extra (id INT4, created DATE, total INT4, note TEXT);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "ALTER TABLE orders ATTACH PARTITION orders_2024 FOR VALUES FROM ('2024-01-01') TO ('2025-01-01');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT id, created, total FROM orders ORDER BY id;",
+					Expected: []sql.Row{{1, "2023-05-05", 100}, {2, "2024-02-02", 200}},
+				},
+				{
+					Query:    "INSERT INTO orders VALUES (4, '2024-07-07', 400);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM orders_2024 ORDER BY id;",
+					Expected: []sql.Row{{200, 2, "2024-02-02"}, {400, 4, "2024-07-07"}},
+				},
+				{
+					Query:    "SELECT id FROM orders WHERE created > '2024-03-01'::date;",
+					Expected: []sql.Row{{4}},
+				},
+				{
+					Query:       "ALTER TABLE orders ATTACH PARTITION orders_bad FOR VALUES FROM ('2025-01-01') TO ('2026-01-01');",
+					ExpectedErr: `partition constraint of relation "orders_bad" is violated by some row`,
+				},
+				{
+					Query:       "ALTER TABLE orders ATTACH PARTITION orders_extra FOR VALUES FROM ('2025-01-01') TO ('2026-01-01');",
+					ExpectedErr: `table "orders_extra" contains column "note" not found in parent "orders"`,
+				},
+				{
+					Query:       "ALTER TABLE orders ATTACH PARTITION orders_2023 FOR VALUES FROM ('2030-01-01') TO ('2031-01-01');",
+					ExpectedErr: `"orders_2023" is already a partition`,
+				},
+				{
+					Query:       "ALTER TABLE orders_bad ATTACH PARTITION orders_extra FOR VALUES FROM ('2025-01-01') TO ('2026-01-01');",
+					ExpectedErr: `table "orders_bad" is not partitioned`,
+				},
+				{
+					Query:    "ALTER TABLE orders DETACH PARTITION orders_2023;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT id FROM orders ORDER BY id;",
+					Expected: []sql.Row{{2}, {4}},
+				},
+				{
+					Query:    "SELECT id FROM orders_2023;",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:       "INSERT INTO orders VALUES (5, '2023-08-08', 500);",
+					ExpectedErr: `no partition of relation "orders" found for row`,
+				},
+				{
+					Query:       "ALTER TABLE orders DETACH PARTITION orders_2023;",
+					ExpectedErr: `relation "orders_2023" is not a partition of relation "orders"`,
+				},
+				{
+					Query:    "ALTER TABLE orders DETACH PARTITION orders_2024 CONCURRENTLY;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT count(*) FROM orders;",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "ALTER TABLE orders ATTACH PARTITION orders_2023 DEFAULT;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT id FROM orders ORDER BY id;",
+					Expected: []sql.Row{{1}},
+				},
+			},
+		},
+		{
+			Name: "Subpartitions and dropping partitioned tables",
+			SetUpScript: []string{
+				"CREATE TABLE logs (id INT4, category TEXT, logged DATE) PARTITION BY LIST (category);",
+				"CREATE TABLE logs_app PARTITION OF logs FOR VALUES IN ('app') PARTITION BY RANGE (logged);",
+				"CREATE TABLE logs_app_2024 PARTITION OF logs_app FOR VALUES FROM ('2024-01-01') TO ('2025-01-01');",
+				"CREATE TABLE logs_other PARTITION OF logs DEFAULT;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "INSERT INTO logs VALUES (1, 'app', '2024-04-04'), (2, 'db', '2020-01-01');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "INSERT INTO logs VALUES (3, 'app', '2020-01-01');",
+					ExpectedErr: `no partition of relation "logs_app" found for row`,
+				},
+				{
+					Query:    "SELECT id FROM logs_app_2024;",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "SELECT id FROM logs_app;",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "SELECT id FROM logs WHERE category = 'app' AND logged >= '2024-01-01'::date;",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "SELECT id FROM logs ORDER BY id;",
+					Expected: []sql.Row{{1}, {2}},
+				},
+				{
+					Query:       "UPDATE logs_app_2024 SET category = 'db' WHERE id = 1;",
+					ExpectedErr: `new row for relation "logs_app_2024" violates partition constraint`,
+				},
+				{
+					Query:       "INSERT INTO logs_app VALUES (3, 'db', '2024-02-02');",
+					ExpectedErr: `new row for relation "logs_app" violates partition constraint`,
+				},
+				{
+					Query:    "TRUNCATE logs_app;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT id FROM logs ORDER BY id;",
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:    "CREATE TABLE IF NOT EXISTS logs_other PARTITION OF logs FOR VALUES IN ('x');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DROP TABLE logs;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT * FROM logs_app_2024;",
+					ExpectedErr: "not found",
+				},
+				{
+					Query:       "SELECT * FROM logs_other;",
+					ExpectedErr: "not found",
+				},
+				{
+					Query:    "CREATE TABLE logs (id INT4, category TEXT);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "CREATE TABLE logs_app PARTITION OF logs FOR VALUES IN ('app');",
+					ExpectedErr: `table "logs" is not partitioned`,
+				},
+				{
+					Query:       "CREATE TABLE logs_app PARTITION OF missing FOR VALUES IN ('app');",
+					ExpectedErr: `relation "missing" does not exist`,
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,252 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestPgCatalog(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "pg_namespace",
+			SetUpScript: []string{
+				"CREATE SCHEMA other;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT nspname FROM pg_namespace ORDER BY nspname;",
+					Expected: []sql.Row{{"information_schema"}, {"other"}, {"pg_catalog"}, {"public"}},
+				},
+				{
+					Query:    "SELECT oid, nspowner FROM pg_catalog.pg_namespace WHERE nspname IN ('pg_catalog', 'public') ORDER BY oid;",
+					Expected: []sql.Row{{11, 10}, {2200, 10}},
+				},
+			},
+		},
+		{
+			Name: "pg_class and pg_attribute",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 VARCHAR(10) NOT NULL, v2 NUMERIC(5, 2) DEFAULT 1, v3 INT8 GENERATED ALWAYS AS IDENTITY);",
+				"CREATE INDEX test_v3_idx ON test (v3);",
+				"CREATE VIEW test_view AS SELECT pk FROM test;",
+				"CREATE SEQUENCE test_seq;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT c.relname, c.relkind, c.relnatts, c.relhasindex, c.relam FROM pg_class c " +
+						"JOIN pg_namespace n ON c.relnamespace = n.oid WHERE n.nspname = 'public' ORDER BY c.relname;",
+					Expected: []sql.Row{
+						{"test", "r", 4, "t", 2},
+						{"test_pkey", "i", 1, "f", 403},
+						{"test_seq", "S", 3, "f", 0},
+						{"test_v3_idx", "i", 1, "f", 403},
+						{"test_v3_seq", "S", 3, "f", 0},
+						{"test_view", "v", 0, "f", 0},
+					},
+				},
+				{
+					Query: "SELECT a.attname, a.attnum, t.typname, a.atttypmod, a.attnotnull, a.atthasdef, a.attidentity FROM pg_attribute a " +
+						"JOIN pg_class c ON a.attrelid = c.oid JOIN pg_type t ON a.atttypid = t.oid WHERE c.relname = 'test' ORDER BY a.attnum;",
+					Expected: []sql.Row{
+						{"pk", 1, "int4", -1, "t", "f", ""},
+						{"v1", 2, "varchar", 14, "t", "f", ""},
+						{"v2", 3, "numeric", 327686, "f", "t", ""},
+						{"v3", 4, "int8", -1, "t", "f", "a"},
+					},
+				},
+				{
+					Query:    "SELECT count(*) FROM pg_class a JOIN pg_class b ON a.oid = b.oid WHERE a.relname = 'test' AND b.relname = 'test';",
+					Expected: []sql.Row{{1}},
+				},
+			},
+		},
+		{
+			Name: "pg_index",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4, v1 INT4, v2 INT4, PRIMARY KEY (v1, pk));",
+				"CREATE UNIQUE INDEX test_v2_idx ON test (v2);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT c.relname, i.indnatts, i.indisunique, i.indisprimary, i.indkey FROM pg_index i " +
+						"JOIN pg_class c ON i.indexrelid = c.oid JOIN pg_class t ON i.indrelid = t.oid WHERE t.relname = 'test' ORDER BY c.relname;",
+					Expected: []sql.Row{
+						{"test_pkey", 2, "t", "t", "{2,1}"},
+						{"test_v2_idx", 1, "t", "f", "{3}"},
+					},
+				},
+			},
+		},
+		{
+			Name: "pg_constraint",
+			SetUpScript: []string{
+				"CREATE TABLE parent (pk INT4 PRIMARY KEY);",
+				"CREATE TABLE child (pk INT4 PRIMARY KEY, parent_id INT4, v1 INT4, " +
+					"CONSTRAINT child_parent_fk FOREIGN KEY (parent_id) REFERENCES parent (pk) ON DELETE CASCADE);",
+				"ALTER TABLE child ADD CONSTRAINT child_v1_check CHECK (v1 > 0);",
+				"ALTER TABLE child ADD CONSTRAINT child_v1_max CHECK (v1 < 100) NOT VALID;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT con.conname, con.contype, con.convalidated, con.conkey, con.confkey, con.confdeltype FROM pg_constraint con " +
+						"JOIN pg_class c ON con.conrelid = c.oid WHERE c.relname = 'child' ORDER BY con.conname;",
+					Expected: []sql.Row{
+						{"child_parent_fk", "f", "t", "{2}", "{1}", "c"},
+						{"child_pkey", "p", "t", "{1}", nil, " "},
+						{"child_v1_check", "c", "t", nil, nil, " "},
+						{"child_v1_max", "c", "f", nil, nil, " "},
+					},
+				},
+				{
+					Query: "SELECT p.relname FROM pg_constraint con JOIN pg_class p ON con.confrelid = p.oid " +
+						"WHERE con.conname = 'child_parent_fk';",
+					Expected: []sql.Row{{"parent"}},
+				},
+			},
+		},
+		{
+			Name: "pg_type",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT oid, typname, typlen, typbyval, typtype, typcategory, typelem, typarray FROM pg_type " +
+						"WHERE typname IN ('int4', '_int4', 'text', 'bool', 'record') ORDER BY oid;",
+					Expected: []sql.Row{
+						{16, "bool", 1, "t", "b", "B", 0, 1000},
+						{23, "int4", 4, "t", "b", "N", 0, 1007},
+						{25, "text", -1, "f", "b", "S", 0, 1009},
+						{1007, "_int4", -1, "f", "b", "A", 23, 0},
+						{2249, "record", -1, "f", "p", "P", 0, 0},
+					},
+				},
+			},
+		},
+		{
+			Name: "pg_cast, pg_operator, pg_enum, and pg_range",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT castsource, casttarget, castfunc, castcontext FROM pg_cast WHERE castsource = 23 AND casttarget IN (16, 20, 21) ORDER BY casttarget;",
+					Expected: []sql.Row{{23, 16, 0, "e"}, {23, 20, 0, "i"}, {23, 21, 0, "a"}},
+				},
+				{
+					Query: "SELECT o.oprname, o.oprkind, o.oprleft, o.oprright, o.oprresult, o.oprcode FROM pg_operator o " +
+						"JOIN pg_type t ON o.oprright = t.oid WHERE o.oprname IN ('+', '->>') AND t.typname IN ('int4', 'text') ORDER BY o.oprname, o.oprleft;",
+					Expected: []sql.Row{
+						{"+", "l", 0, 23, 23, "int4up"},
+						{"+", "b", 20, 23, 20, "int84pl"},
+						{"+", "b", 21, 23, 23, "int24pl"},
+						{"+", "b", 23, 23, 23, "int4pl"},
+						{"->>", "b", 114, 23, 25, "json_array_element_text"},
+						{"->>", "b", 114, 25, 25, "json_object_field_text"},
+						{"->>", "b", 3802, 23, 25, "jsonb_array_element_text"},
+						{"->>", "b", 3802, 25, 25, "jsonb_object_field_text"},
+					},
+				},
+				{
+					Query:    "SELECT oid FROM pg_operator GROUP BY oid HAVING count(*) > 1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM pg_enum;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM pg_range;",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "pg_proc",
+			SetUpScript: []string{
+				"CREATE FUNCTION add_one(x INT4) RETURNS INT4 AS $$ SELECT x + 1 $$ LANGUAGE sql;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT p.proname, n.nspname, p.prokind, p.pronargs, p.prorettype, p.proargtypes, p.proargnames FROM pg_proc p " +
+						"JOIN pg_namespace n ON p.pronamespace = n.oid WHERE p.proname IN ('add_one', 'abs') AND p.prorettype = 23;",
+					Expected: []sql.Row{
+						{"abs", "pg_catalog", "f", 1, 23, "{23}", nil},
+						{"add_one", "public", "f", 1, 23, "{23}", "{x}"},
+					},
+				},
+				{
+					Query:    "SELECT proretset FROM pg_proc WHERE proname = 'generate_series' LIMIT 1;",
+					Expected: []sql.Row{{"t"}},
+				},
+			},
+		},
+		{
+			Name: "pg_database and pg_tablespace",
+			SetUpScript: []string{
+				"CREATE DATABASE other;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT datname, encoding, datlocprovider, datistemplate, datallowconn, datconnlimit, dattablespace, datcollate " +
+						"FROM pg_database WHERE datname IN ('postgres', 'other') ORDER BY datname;",
+					Expected: []sql.Row{
+						{"other", 6, "c", "f", "t", -1, 1663, "C"},
+						{"postgres", 6, "c", "f", "t", -1, 1663, "C"},
+					},
+				},
+				{
+					Query:    "SELECT r.rolname FROM pg_database d JOIN pg_roles r ON d.datdba = r.oid WHERE d.datname = 'other';",
+					Expected: []sql.Row{{"postgres"}},
+				},
+				{
+					Query:    "SELECT oid, spcname, spcacl FROM pg_tablespace ORDER BY oid;",
+					Expected: []sql.Row{{1663, "pg_default", nil}, {1664, "pg_global", nil}},
+				},
+				{
+					Query:    "SELECT t.spcname FROM pg_database d JOIN pg_tablespace t ON d.dattablespace = t.oid WHERE d.datname = 'postgres';",
+					Expected: []sql.Row{{"pg_default"}},
+				},
+			},
+		},
+		{
+			Name: "pg_settings",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT name, setting, unit, context, vartype, source, min_val, max_val, boot_val, reset_val " +
+						"FROM pg_settings WHERE name = 'statement_timeout';",
+					Expected: []sql.Row{{"statement_timeout", "0", "ms", "user", "integer", "default", "0", "2147483647", "0", "0"}},
+				},
+				{
+					Query:    "SELECT setting, vartype, enumvals FROM pg_settings WHERE name = 'client_min_messages';",
+					Expected: []sql.Row{{"notice", "enum", "{debug5,debug4,debug3,debug2,debug1,log,notice,warning,error}"}},
+				},
+				{
+					Query:    "SELECT setting, vartype, min_val FROM pg_settings WHERE name = 'enable_seqscan';",
+					Expected: []sql.Row{{"on", "bool", nil}},
+				},
+				{
+					Query:    "SET statement_timeout = '5s';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT setting, source, reset_val FROM pg_settings WHERE name = 'statement_timeout';",
+					Expected: []sql.Row{{"5000", "session", "0"}},
+				},
+				{
+					Query:    "SELECT setting FROM pg_catalog.pg_settings WHERE name = 'server_version';",
+					Expected: []sql.Row{{"15.0"}},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,105 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestPgStat(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "pg_stat_user_tables",
+			SetUpScript: []string{
+				"CREATE TABLE stats_test (pk INT8 PRIMARY KEY, v INT8);",
+				"CREATE INDEX stats_test_v ON stats_test (v);",
+				"CREATE TABLE stats_empty (v INT8);",
+				"SELECT pg_stat_reset();",
+				"INSERT INTO stats_test VALUES (1, 10), (2, 20), (3, 30);",
+				"UPDATE stats_test SET v = v + 1 WHERE pk = 2;",
+				"DELETE FROM stats_test WHERE v = 30;",
+				"SELECT * FROM stats_test;",
+				"SELECT * FROM stats_empty;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT relname, seq_scan, seq_tup_read, idx_scan, idx_tup_fetch, n_tup_ins, n_tup_upd, n_tup_del, n_live_tup, n_dead_tup, n_mod_since_analyze " +
+						"FROM pg_stat_user_tables ORDER BY relname;",
+					Expected: []sql.Row{
+						{"stats_empty", 1, 0, nil, nil, 0, 0, 0, 0, 0, 0},
+						{"stats_test", 1, 2, 2, 2, 3, 1, 1, 2, 0, 5},
+					},
+				},
+				{
+					Query:    "SELECT relname, indexrelname, idx_scan, idx_tup_read, idx_tup_fetch FROM pg_stat_user_indexes ORDER BY indexrelname;",
+					Expected: []sql.Row{{"stats_test", "stats_test_pkey", 1, 1, 1}, {"stats_test", "stats_test_v", 1, 1, 1}},
+				},
+				{
+					Query:    "SELECT last_seq_scan IS NOT NULL, last_idx_scan IS NOT NULL, last_analyze IS NULL FROM pg_stat_user_tables WHERE relname = 'stats_test';",
+					Expected: []sql.Row{{1, 1, 1}},
+				},
+				{
+					Query:    "ANALYZE stats_test;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT n_mod_since_analyze, analyze_count, last_analyze IS NOT NULL FROM pg_stat_user_tables WHERE relname = 'stats_test';",
+					Expected: []sql.Row{{0, 1, 1}},
+				},
+				{
+					Query:    "SELECT pg_stat_reset();",
+					Expected: []sql.Row{{""}},
+				},
+				{
+					Query:    "SELECT seq_scan, idx_scan, n_tup_ins, analyze_count FROM pg_stat_user_tables WHERE relname = 'stats_test';",
+					Expected: []sql.Row{{0, 0, 0, 0}},
+				},
+			},
+		},
+		{
+			Name: "pg_statio_user_tables",
+			SetUpScript: []string{
+				"CREATE TABLE statio_test (pk INT8 PRIMARY KEY);",
+				"CREATE TABLE statio_heap (v INT8);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT relname, heap_blks_read, heap_blks_hit, idx_blks_read, idx_blks_hit, toast_blks_read " +
+						"FROM pg_statio_user_tables ORDER BY relname;",
+					Expected: []sql.Row{{"statio_heap", 0, 0, nil, nil, nil}, {"statio_test", 0, 0, 0, 0, nil}},
+				},
+				{
+					Query:    "SELECT relname, indexrelname, idx_blks_read, idx_blks_hit FROM pg_statio_user_indexes;",
+					Expected: []sql.Row{{"statio_test", "statio_test_pkey", 0, 0}},
+				},
+			},
+		},
+		{
+			Name: "pg_stat_reset requires a superuser",
+			SetUpScript: []string{
+				"CREATE USER stats_user PASSWORD 'password';",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "SELECT pg_stat_reset();",
+					Username:    "stats_user",
+					ExpectedErr: "permission denied for function pg_stat_reset",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,239 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineBatch(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+	_, err := conn.Exec(ctx, "CREATE TABLE test (pk INT8 PRIMARY KEY, v1 TEXT);")
+	require.NoError(t, err)
+
+	// A successful batch commits every statement
+	batch := &pgx.Batch{}
+	batch.Queue("INSERT INTO test VALUES ($1, $2);", 1, "a")
+	batch.Queue("INSERT INTO test VALUES ($1, $2);", 2, "b")
+	batch.Queue("SELECT count(*) FROM test;")
+	results := conn.SendBatch(ctx, batch)
+	_, err = results.Exec()
+	require.NoError(t, err)
+	_, err = results.Exec()
+	require.NoError(t, err)
+	var count int64
+	require.NoError(t, results.QueryRow().Scan(&count))
+	assert.Equal(t, int64(2), count)
+	require.NoError(t, results.Close())
+
+	// A failing statement skips the remainder of the batch, and rolls back the statements that already ran
+	batch = &pgx.Batch{}
+	batch.Queue("INSERT INTO test VALUES ($1, $2);", 3, "c")
+	batch.Queue("INSERT INTO test VALUES ($1, $2);", 1, "duplicate")
+	batch.Queue("INSERT INTO test VALUES ($1, $2);", 4, "d")
+	results = conn.SendBatch(ctx, batch)
+	_, err = results.Exec()
+	require.NoError(t, err)
+	_, err = results.Exec()
+	require.Error(t, err)
+	_, err = results.Exec()
+	require.Error(t, err)
+	require.Error(t, results.Close())
+
+	// The connection remains usable, and only the first batch's rows exist
+	rows, err := conn.Query(ctx, "SELECT pk, v1 FROM test ORDER BY pk;")
+	require.NoError(t, err)
+	var read []string
+	for rows.Next() {
+		var pk int64
+		var v1 string
+		require.NoError(t, rows.Scan(&pk, &v1))
+		read = append(read, fmt.Sprintf("%d %s", pk, v1))
+	}
+	require.NoError(t, rows.Err())
+	assert.Equal(t, []string{"1 a", "2 b"}, read)
+
+	// An explicit transaction block is left to the client, even when a statement within the batch fails
+	_, err = conn.Exec(ctx, "BEGIN;")
+	require.NoError(t, err)
+	batch = &pgx.Batch{}
+	batch.Queue("INSERT INTO test VALUES ($1, $2);", 5, "e")
+	results = conn.SendBatch(ctx, batch)
+	_, err = results.Exec()
+	require.NoError(t, err)
+	require.NoError(t, results.Close())
+	_, err = conn.Exec(ctx, "COMMIT;")
+	require.NoError(t, err)
+	require.NoError(t, conn.QueryRow(ctx, "SELECT count(*) FROM test;").Scan(&count))
+	assert.Equal(t, int64(3), count)
+}
+
+func TestPipelineMode(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+	_, err := conn.Exec(ctx, "CREATE TABLE test (pk INT8 PRIMARY KEY);")
+	require.NoError(t, err)
+
+	pipeline := conn.PgConn().StartPipeline(ctx)
+	pipeline.SendQueryParams("INSERT INTO test VALUES (1);", nil, nil, nil, nil)
+	pipeline.SendQueryParams("INSERT INTO test VALUES (2);", nil, nil, nil, nil)
+	require.NoError(t, pipeline.Sync())
+	pipeline.SendQueryParams("INSERT INTO test VALUES (3);", nil, nil, nil, nil)
+	pipeline.SendQueryParams("INSERT INTO test VALUES (3);", nil, nil, nil, nil)
+	pipeline.SendQueryParams("INSERT INTO test VALUES (4);", nil, nil, nil, nil)
+	require.NoError(t, pipeline.Sync())
+	pipeline.SendQueryParams("SELECT count(*) FROM test;", nil, nil, nil, nil)
+	require.NoError(t, pipeline.Sync())
+
+	// Each Sync ends its own implicit transaction, so the failure within the second only affects that one
+	var received []string
+	for countOf(received, "Sync") < 3 {
+		result, err := pipeline.GetResults()
+		if err != nil {
+			var pgErr *pgconn.PgError
+			require.ErrorAs(t, err, &pgErr)
+			received = append(received, "Error")
+			continue
+		}
+		switch result := result.(type) {
+		case *pgconn.ResultReader:
+			values := result.Read()
+			require.NoError(t, values.Err)
+			if len(values.Rows) > 0 {
+				received = append(received, "Row "+string(values.Rows[0][0]))
+			} else {
+				received = append(received, values.CommandTag.String())
+			}
+		case *pgconn.PipelineSync:
+			received = append(received, "Sync")
+		default:
+			require.Failf(t, "unexpected result", "%T", result)
+		}
+	}
+	require.NoError(t, pipeline.Close())
+	assert.Equal(t, []string{
+		"INSERT 0 1",
+		"INSERT 0 1",
+		"Sync",
+		"INSERT 0 1",
+		"Error",
+		"Sync",
+		"Row 2",
+		"Sync",
+	}, received)
+
+	// We take over the connection so that we may send a Flush, which must deliver the results without waiting on a Sync
+	hijacked, err := conn.PgConn().Hijack()
+	require.NoError(t, err)
+	defer hijacked.Conn.Close()
+	frontend := hijacked.Frontend
+	receiveUntil := func(until func(pgproto3.BackendMessage) bool) []string {
+		var received []string
+		for {
+			msg, err := frontend.Receive()
+			require.NoError(t, err)
+			switch msg := msg.(type) {
+			case *pgproto3.CommandComplete:
+				received = append(received, fmt.Sprintf("CommandComplete %s", msg.CommandTag))
+			case *pgproto3.ReadyForQuery:
+				received = append(received, fmt.Sprintf("ReadyForQuery %c", msg.TxStatus))
+			default:
+				received = append(received, fmt.Sprintf("%T", msg)[len("*pgproto3."):])
+			}
+			if until(msg) {
+				return received
+			}
+		}
+	}
+	isType := func(example pgproto3.BackendMessage) func(pgproto3.BackendMessage) bool {
+		return func(msg pgproto3.BackendMessage) bool {
+			return fmt.Sprintf("%T", msg) == fmt.Sprintf("%T", example)
+		}
+	}
+
+	frontend.Send(&pgproto3.Parse{Query: "INSERT INTO test VALUES (5);"})
+	frontend.Send(&pgproto3.Bind{})
+	frontend.Send(&pgproto3.Execute{})
+	frontend.Send(&pgproto3.Flush{})
+	require.NoError(t, frontend.Flush())
+	assert.Equal(t, []string{
+		"ParseComplete",
+		"BindComplete",
+		"CommandComplete INSERT 0 1",
+	}, receiveUntil(isType(&pgproto3.CommandComplete{})))
+
+	// The error is also delivered on a Flush, after which every message up to the Sync is skipped
+	frontend.Send(&pgproto3.Parse{Query: "INSERT INTO test VALUES (5);"})
+	frontend.Send(&pgproto3.Bind{})
+	frontend.Send(&pgproto3.Execute{})
+	frontend.Send(&pgproto3.Flush{})
+	require.NoError(t, frontend.Flush())
+	assert.Equal(t, []string{
+		"ParseComplete",
+		"BindComplete",
+		"ErrorResponse",
+	}, receiveUntil(isType(&pgproto3.ErrorResponse{})))
+	frontend.Send(&pgproto3.Parse{Query: "INSERT INTO test VALUES (6);"})
+	frontend.Send(&pgproto3.Bind{})
+	frontend.Send(&pgproto3.Execute{})
+	frontend.Send(&pgproto3.Sync{})
+	require.NoError(t, frontend.Flush())
+	assert.Equal(t, []string{
+		"ReadyForQuery I",
+	}, receiveUntil(isType(&pgproto3.ReadyForQuery{})))
+
+	// Both the successful statement before the first Flush and the one before the failure were rolled back
+	frontend.Send(&pgproto3.Query{String: "SELECT count(*) FROM test;"})
+	require.NoError(t, frontend.Flush())
+	var count string
+	for {
+		msg, err := frontend.Receive()
+		require.NoError(t, err)
+		if row, ok := msg.(*pgproto3.DataRow); ok {
+			count = string(row.Values[0])
+		}
+		if _, ok := msg.(*pgproto3.ReadyForQuery); ok {
+			break
+		}
+	}
+	assert.Equal(t, "2", count)
+}
+
+// countOf returns the number of times that the value appears in the slice.
+func countOf(values []string, value string) int {
+	count := 0
+	for _, v := range values {
+		if v == value {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,605 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestPLpgSQL(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "Variables and assignment",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `CREATE FUNCTION interpret(a INT4, b INT4) RETURNS INT4 LANGUAGE plpgsql AS $$
+DECLARE
+	total INT4 := a + b;
+	factor CONSTANT INT4 = 2;
+	unset INT4;
+BEGIN
+	total := total * factor;
+	IF unset IS NULL THEN
+		total = total + 1;
+	END IF;
+	RETURN total;
+END;
+$$;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT interpret(1, 2);",
+					Expected: []sql.Row{{7}},
+				},
+				{
+					Query:    "SELECT interpret(NULL, 2);",
+					Expected: []sql.Row{{nil}},
+				},
+				{
+					Query: `CREATE FUNCTION positional(INT4) RETURNS INT4 LANGUAGE plpgsql AS $$
+DECLARE
+	val ALIAS FOR $1;
+BEGIN
+	RETURN val * $1;
+END;
+$$;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT positional(5);",
+					Expected: []sql.Row{{25}},
+				},
+				{
+					Query: `CREATE FUNCTION nested() RETURNS INT4 LANGUAGE plpgsql AS $$
+<<outer_block>>
+DECLARE
+	x INT4 := 1;
+BEGIN
+	DECLARE
+		x INT4 := 10;
+	BEGIN
+		RETURN x + outer_block.x;
+	END;
+END;
+$$;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT nested();",
+					Expected: []sql.Row{{11}},
+				},
+				{
+					Query: `CREATE FUNCTION assign_constant() RETURNS INT4 LANGUAGE plpgsql AS $$
+DECLARE
+	c CONSTANT INT4 := 1;
+BEGIN
+	c := 2;
+	RETURN c;
+END;
+$$;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT assign_constant();",
+					ExpectedErr: `variable "c" is declared CONSTANT`,
+				},
+			},
+		},
+		{
+			Name: "Conditionals",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `CREATE FUNCTION classify(val INT4) RETURNS TEXT LANGUAGE plpgsql AS $$
+BEGIN
+	IF val < 0 THEN
+		RETURN 'negative';
+	ELSIF val = 0 THEN
+		RETURN 'zero';
+	ELSE
+		RETURN 'positive';
+	END IF;
+END;
+$$;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT classify(-5), classify(0), classify(5);",
+					Expected: []sql.Row{{"negative", "zero", "positive"}},
+				},
+				{
+					Query: `CREATE FUNCTION simple_case(val INT4) RETURNS TEXT LANGUAGE plpgsql AS $$
+BEGIN
+	CASE val
+		WHEN 1, 2 THEN
+			RETURN 'small';
+		WHEN 3 THEN
+			RETURN 'three';
+		ELSE
+			RETURN 'other';
+	END CASE;
+END;
+$$;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT simple_case(2), simple_case(3), simple_case(9);",
+					Expected: []sql.Row{{"small", "three", "other"}},
+				},
+				{
+					Query: `CREATE FUNCTION searched_case(val INT4) RETURNS TEXT LANGUAGE plpgsql AS $$
+BEGIN
+	CASE
+		WHEN val > 10 THEN
+			RETURN 'big';
+	END CASE;
+	RETURN 'unreachable';
+END;
+$$;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT searched_case(11);",
+					Expected: []sql.Row{{"big"}},
+				},
+				{
+					Query:       "SELECT searched_case(1);",
+					ExpectedErr: "case not found",
+				},
+			},
+		},
+		{
+			Name: "Loops",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `CREATE FUNCTION loops(n INT4) RETURNS INT4 LANGUAGE plpgsql AS $$
+DECLARE
+	total INT4 := 0;
+	i INT4 := 0;
+BEGIN
+	LOOP
+		i := i + 1;
+		EXIT WHEN i > n;
+		CONTINUE WHEN i % 2 = 0;
+		total := total + i;
+	END LOOP;
+	WHILE i > 0 LOOP
+		i := i - 1;
+	END LOOP;
+	RETURN total;
+END;
+$$;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT loops(5);",
+					Expected: []sql.Row{{9}},
+				},
+				{
+					Query: `CREATE FUNCTION ranges() RETURNS INT8 LANGUAGE plpgsql AS $$
+DECLARE
+	result INT8 := 0;
+BEGIN
+	FOR i IN 1..3 LOOP
+		result := result * 10 + i;
+	END LOOP;
+	FOR i IN REVERSE 10..1 BY 4 LOOP
+		result := result * 100 + i;
+	END LOOP;
+	<<outer>>
+	FOR i IN 1..3 LOOP
+		FOR j IN 1..3 LOOP
+			EXIT outer WHEN j = 2;
+			result := result * 100 + i * 10 + j;
+		END LOOP;
+	END LOOP;
+	RETURN result;
+END;
+$$;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT ranges();",
+					Expected: []sql.Row{{12310060211}},
+				},
+				{
+					Query: `CREATE FUNCTION bad_step() RETURNS INT4 LANGUAGE plpgsql AS $$
+BEGIN
+	FOR i IN 1..3 BY 0 LOOP
+	END LOOP;
+	RETURN 0;
+END;
+$$;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT bad_step();",
+					ExpectedErr: "BY value of FOR loop must be greater than zero",
+				},
+			},
+		},
+		{
+			Name: "Queries",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 TEXT);",
+				"INSERT INTO test VALUES (1, 'one'), (2, 'two'), (3, 'three');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `CREATE FUNCTION sum_rows() RETURNS INT8 LANGUAGE plpgsql AS $$
+DECLARE
+	r RECORD;
+	result INT8 := 0;
+BEGIN
+	FOR r IN SELECT pk, v1 FROM test ORDER BY pk LOOP
+		IF r.v1 = 'two' THEN
+			result := result + r.pk * 10;
+		ELSE
+			result := result + r.pk;
+		END IF;
+	END LOOP;
+	RETURN result;
+END;
+$$;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT sum_rows();",
+					Expected: []sql.Row{{24}},
+				},
+				{
+					Query: `CREATE FUNCTION lookup(id INT8) RETURNS TEXT LANGUAGE plpgsql AS $$
+DECLARE
+	result TEXT;
+BEGIN
+	SELECT v1 INTO STRICT result FROM test WHERE pk = id;
+	RETURN result;
+END;
+$$;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT lookup(2);",
+					Expected: []sql.Row{{"two"}},
+				},
+				{
+					Query:       "SELECT lookup(4);",
+					ExpectedErr: "query returned no rows",
+				},
+				{
+					Query: `CREATE FUNCTION insert_row(id INT8, val TEXT) RETURNS INT4 LANGUAGE plpgsql AS $$
+DECLARE
+	affected INT4;
+BEGIN
+	INSERT INTO test VALUES (id, val);
+	GET DIAGNOSTICS affected = ROW_COUNT;
+	PERFORM pk FROM test WHERE pk = id;
+	IF NOT FOUND THEN
+		RETURN -1;
+	END IF;
+	RETURN affected;
+END;
+$$;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT insert_row(4, 'four');",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "SELECT v1 FROM test WHERE pk = 4;",
+					Expected: []sql.Row{{"four"}},
+				},
+				{
+					Query: `CREATE FUNCTION discarded() RETURNS INT4 LANGUAGE plpgsql AS $$
+BEGIN
+	SELECT 1;
+	RETURN 1;
+END;
+$$;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT discarded();",
+					ExpectedErr: "query has no destination for result data",
+				},
+			},
+		},
+		{
+			Name: "Set-returning functions",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY);",
+				"INSERT INTO test VALUES (1), (2), (3);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `CREATE FUNCTION evens(n INT4) RETURNS SETOF INT4 LANGUAGE plpgsql AS $$
+BEGIN
+	FOR i IN 1..n LOOP
+		IF i % 2 = 0 THEN
+			RETURN NEXT i;
+		END IF;
+	END LOOP;
+	RETURN QUERY SELECT pk::INT4 * 100 FROM test ORDER BY pk;
+END;
+$$;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM evens(5);",
+					Expected: []sql.Row{{2}, {4}, {100}, {200}, {300}},
+				},
+				{
+					Query:    "SELECT e.x FROM evens(4) AS e(x) WHERE e.x < 100;",
+					Expected: []sql.Row{{2}, {4}},
+				},
+				{
+					Query:       "SELECT evens(5);",
+					ExpectedErr: "set-valued function called in context that cannot accept a set",
+				},
+			},
+		},
+		{
+			Name: "RAISE and ASSERT",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `CREATE FUNCTION check_positive(val INT4) RETURNS INT4 LANGUAGE plpgsql AS $$
+BEGIN
+	IF val <= 0 THEN
+		RAISE EXCEPTION 'value % is not positive', val USING HINT = 'Use a larger value.';
+	END IF;
+	RAISE NOTICE 'value % is fine', val;
+	RETURN val;
+END;
+$$;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT check_positive(3);",
+					Expected: []sql.Row{{3}},
+				},
+				{
+					Query:       "SELECT check_positive(-3);",
+					ExpectedErr: "value -3 is not positive",
+				},
+				{
+					Query: `CREATE FUNCTION check_assert(val INT4) RETURNS INT4 LANGUAGE plpgsql AS $$
+BEGIN
+	ASSERT val > 0, 'must be positive';
+	RETURN val;
+END;
+$$;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT check_assert(0);",
+					ExpectedErr: "must be positive",
+				},
+				{
+					Query:       "CREATE FUNCTION bad_raise() RETURNS INT4 LANGUAGE plpgsql AS $$ BEGIN RAISE EXCEPTION '% %', 1; END; $$;",
+					ExpectedErr: "too few parameters specified for RAISE",
+				},
+			},
+		},
+		{
+			Name: "Exception handlers",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 TEXT);",
+				"INSERT INTO test VALUES (1, 'one');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `CREATE FUNCTION safe_insert(id INT8, val TEXT) RETURNS TEXT LANGUAGE plpgsql AS $$
+DECLARE
+	state TEXT;
+	msg TEXT;
+BEGIN
+	INSERT INTO test VALUES (id + 100, val);
+	INSERT INTO test VALUES (id, val);
+	RETURN 'inserted';
+EXCEPTION
+	WHEN unique_violation THEN
+		GET STACKED DIAGNOSTICS state = RETURNED_SQLSTATE, msg = MESSAGE_TEXT;
+		RETURN concat(state, ': ', msg);
+END;
+$$;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT safe_insert(2, 'two');",
+					Expected: []sql.Row{{"inserted"}},
+				},
+				{
+					Query:    "SELECT safe_insert(1, 'uno');",
+					Expected: []sql.Row{{"23505: duplicate primary key given: [1]"}},
+				},
+				{
+					// The row inserted before the error was rolled back along with the rest of the block
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, "one"}, {2, "two"}, {102, "two"}},
+				},
+				{
+					Query: `CREATE FUNCTION divide(a INT4, b INT4) RETURNS TEXT LANGUAGE plpgsql AS $$
+DECLARE
+	attempts INT4 := 0;
+BEGIN
+	BEGIN
+		attempts := attempts + 1;
+		RETURN (a / b)::TEXT;
+	EXCEPTION
+		WHEN SQLSTATE '22012' OR numeric_value_out_of_range THEN
+			RETURN concat('attempt ', attempts, ' failed with ', SQLSTATE, ': ', SQLERRM);
+	END;
+END;
+$$;`,
+					ExpectedErr: `unrecognized exception condition "numeric_value_out_of_range"`,
+				},
+				{
+					Query: `CREATE FUNCTION divide(a INT4, b INT4) RETURNS TEXT LANGUAGE plpgsql AS $$
+DECLARE
+	attempts INT4 := 0;
+BEGIN
+	BEGIN
+		attempts := attempts + 1;
+		RETURN (a / b)::TEXT;
+	EXCEPTION
+		WHEN SQLSTATE '22012' THEN
+			RETURN concat('attempt ', attempts, ' failed with ', SQLSTATE, ': ', SQLERRM);
+	END;
+END;
+$$;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT divide(6, 3);",
+					Expected: []sql.Row{{"2"}},
+				},
+				{
+					Query:    "SELECT divide(6, 0);",
+					Expected: []sql.Row{{"attempt 1 failed with 22012: division by zero"}},
+				},
+				{
+					Query: `CREATE FUNCTION check_detail() RETURNS TEXT LANGUAGE plpgsql AS $$
+DECLARE
+	detail TEXT;
+	hint TEXT;
+	context TEXT;
+BEGIN
+	RAISE EXCEPTION 'failed' USING DETAIL = 'some detail', HINT = 'some hint';
+EXCEPTION
+	WHEN data_exception THEN
+		RETURN 'wrong handler';
+	WHEN OTHERS THEN
+		GET STACKED DIAGNOSTICS detail = PG_EXCEPTION_DETAIL, hint = PG_EXCEPTION_HINT, context = PG_EXCEPTION_CONTEXT;
+		RETURN concat(detail, ', ', hint, ', ', context);
+END;
+$$;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT check_detail();",
+					Expected: []sql.Row{{"some detail, some hint, PL/pgSQL function check_detail() line 7 at RAISE"}},
+				},
+				{
+					Query: `CREATE FUNCTION reraise() RETURNS INT4 LANGUAGE plpgsql AS $$
+BEGIN
+	INSERT INTO test VALUES (200, 'two hundred');
+	PERFORM 1 / 0;
+	RETURN 1;
+EXCEPTION
+	WHEN division_by_zero THEN
+		RAISE NOTICE 'handling %', SQLSTATE;
+		RAISE;
+END;
+$$;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT reraise();",
+					ExpectedErr: "division by zero",
+				},
+				{
+					Query:    "SELECT pk FROM test WHERE pk = 200;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query: `CREATE FUNCTION unhandled() RETURNS INT4 LANGUAGE plpgsql AS $$
+BEGIN
+	PERFORM 1 / 0;
+	RETURN 1;
+EXCEPTION
+	WHEN unique_violation THEN
+		RETURN 0;
+END;
+$$;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT unhandled();",
+					ExpectedErr: "division by zero",
+				},
+				{
+					Query:       "CREATE FUNCTION bad_stacked() RETURNS INT4 LANGUAGE plpgsql AS $$ DECLARE s TEXT; BEGIN GET STACKED DIAGNOSTICS s = RETURNED_SQLSTATE; RETURN 1; END; $$;",
+					ExpectedErr: "GET STACKED DIAGNOSTICS cannot be used outside an exception handler",
+				},
+				{
+					Query:       "CREATE FUNCTION bad_reraise() RETURNS INT4 LANGUAGE plpgsql AS $$ BEGIN RAISE; END; $$;",
+					ExpectedErr: "RAISE without parameters cannot be used outside an exception handler",
+				},
+				{
+					Query:       "CREATE FUNCTION bad_item() RETURNS INT4 LANGUAGE plpgsql AS $$ DECLARE s TEXT; BEGIN RETURN 1; EXCEPTION WHEN OTHERS THEN GET STACKED DIAGNOSTICS s = ROW_COUNT; RETURN 0; END; $$;",
+					ExpectedErr: `unrecognized GET STACKED DIAGNOSTICS item "ROW_COUNT"`,
+				},
+			},
+		},
+		{
+			Name: "Missing RETURN",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE FUNCTION no_return() RETURNS INT4 LANGUAGE plpgsql AS $$ BEGIN NULL; END; $$;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT no_return();",
+					ExpectedErr: "control reached end of function without RETURN",
+				},
+				{
+					Query:       "CREATE FUNCTION missing_end() RETURNS INT4 LANGUAGE plpgsql AS $$ BEGIN RETURN 1; $$;",
+					ExpectedErr: "syntax error",
+				},
+			},
+		},
+		{
+			Name: "Procedures",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 INT4);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: `CREATE PROCEDURE fill(n INT4) LANGUAGE plpgsql AS $$
+BEGIN
+	FOR i IN 1..n LOOP
+		INSERT INTO test VALUES (i, i * 10);
+	END LOOP;
+END;
+$$;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CALL fill(3);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, 10}, {2, 20}, {3, 30}},
+				},
+				{
+					Query: `CREATE PROCEDURE totals(INOUT total INT4, OUT cnt INT4) LANGUAGE plpgsql AS $$
+BEGIN
+	SELECT v1, pk INTO total, cnt FROM test WHERE pk = 3;
+	total := total + 1;
+END;
+$$;`,
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CALL totals(0, NULL);",
+					Expected: []sql.Row{{31, 3}},
+				},
+			},
+		},
+	})
+}
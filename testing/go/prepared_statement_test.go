@@ -16,6 +16,7 @@ package _go
 
 import (
 	"testing"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/stretchr/testify/assert"
@@ -322,6 +323,122 @@ var preparedStatementTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "Binary parameters",
+		SetUpScript: []string{
+			"drop table if exists test",
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY, i2 SMALLINT, i4 INTEGER, f4 REAL, b BOOLEAN, u UUID, ts TIMESTAMP, n NUMERIC(10, 2));",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "INSERT INTO test VALUES ($1, $2, $3, $4, $5, $6, $7, $8);",
+				BindVars: []any{1, int16(2), int32(3), float32(4.5), true,
+					"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11",
+					time.Date(2024, 3, 4, 5, 6, 7, 0, time.UTC),
+					Numeric("12.34")},
+			},
+			{
+				Query:    "INSERT INTO test VALUES ($1, $2, $3, $4, $5, $6, $7, $8);",
+				BindVars: []any{2, nil, nil, nil, false, nil, nil, nil},
+			},
+			{
+				Query: "SELECT pk, i2, i4, f4, b, u::text, ts::text, n::text FROM test ORDER BY pk;",
+				Expected: []sql.Row{
+					{1, 2, 3, 4.5, "t", "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", "2024-03-04 05:06:07", "12.34"},
+					{2, nil, nil, nil, "f", nil, nil, nil},
+				},
+			},
+			{
+				Query:    "SELECT pk FROM test WHERE b = $1::boolean AND u = $2::uuid;",
+				BindVars: []any{true, "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"},
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT pk FROM test WHERE ts = $1::timestamp AND n = $2;",
+				BindVars: []any{time.Date(2024, 3, 4, 5, 6, 7, 0, time.UTC), Numeric("12.34")},
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT pk FROM test WHERE f4 > $1 AND i2 < $2;",
+				BindVars: []any{float32(4.25), int16(3)},
+				Expected: []sql.Row{{1}},
+			},
+			{
+				// Parameters that are cast to a type without a binding type of their own are bound as text
+				Query:    "SELECT $1::jsonb, $2::char;",
+				BindVars: []any{`{"a": 1}`, "x"},
+				Expected: []sql.Row{{`{"a": 1}`, "x"}},
+			},
+		},
+	},
+	{
+		Name: "PREPARE, EXECUTE, and DEALLOCATE",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY, v1 TEXT);",
+			"INSERT INTO test VALUES (1, 'one'), (2, 'two'), (3, 'three');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "PREPARE get_row (int8) AS SELECT v1 FROM test WHERE pk = $1;",
+				ExpectedTag: "PREPARE",
+			},
+			{
+				Query:    "EXECUTE get_row(2);",
+				Expected: []sql.Row{{"two"}},
+			},
+			{
+				Query:    "EXECUTE get_row(1 + 2);",
+				Expected: []sql.Row{{"three"}},
+			},
+			{
+				Query:       "PREPARE add_row AS INSERT INTO test VALUES ($1, $2);",
+				ExpectedTag: "PREPARE",
+			},
+			{
+				Query:       "EXECUTE add_row(4, 'four');",
+				ExpectedTag: "INSERT 0 1",
+			},
+			{
+				Query:    "EXECUTE get_row(4);",
+				Expected: []sql.Row{{"four"}},
+			},
+			{
+				Query: "SELECT name, statement, parameter_types, result_types, from_sql, custom_plans FROM pg_prepared_statements WHERE from_sql ORDER BY name;",
+				Expected: []sql.Row{
+					{"add_row", "PREPARE add_row AS INSERT INTO test VALUES ($1, $2);", "{bigint,text}", nil, "t", 1},
+					{"get_row", "PREPARE get_row (int8) AS SELECT v1 FROM test WHERE pk = $1;", "{bigint}", "{text}", "t", 3},
+				},
+			},
+			{
+				Query:       "EXECUTE get_row(1, 2);",
+				ExpectedErr: `wrong number of parameters for prepared statement "get_row"`,
+			},
+			{
+				Query:       "PREPARE get_row AS SELECT 1;",
+				ExpectedErr: `prepared statement "get_row" already exists`,
+			},
+			{
+				Query:       "DEALLOCATE get_row;",
+				ExpectedTag: "DEALLOCATE",
+			},
+			{
+				Query:       "EXECUTE get_row(1);",
+				ExpectedErr: `prepared statement "get_row" does not exist`,
+			},
+			{
+				Query:       "DEALLOCATE get_row;",
+				ExpectedErr: `prepared statement "get_row" does not exist`,
+			},
+			{
+				Query:       "DEALLOCATE ALL;",
+				ExpectedTag: "DEALLOCATE ALL",
+			},
+			{
+				Query:    "SELECT count(*) FROM pg_catalog.pg_prepared_statements WHERE from_sql;",
+				Expected: []sql.Row{{0}},
+			},
+		},
+	},
 }
 
 func TestPreparedErrorHandling(t *testing.T) {
@@ -0,0 +1,287 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcedures(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "Procedure without output parameters",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 TEXT);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE PROCEDURE add_row(pk INT8, v1 TEXT) LANGUAGE sql AS $$ INSERT INTO test VALUES (pk, v1); $$;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CALL add_row(1, 'one');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CALL add_row(2, 'two');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, "one"}, {2, "two"}},
+				},
+			},
+		},
+		{
+			Name: "OUT and INOUT parameters",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE PROCEDURE sum_and_product(a INT4, b INT4, OUT total INT4, OUT product INT4) LANGUAGE sql AS $$ SELECT a + b, a * b; $$;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CALL sum_and_product(3, 4, NULL, NULL);",
+					Expected: []sql.Row{{7, 12}},
+				},
+				{
+					Query:    "CREATE PROCEDURE double_it(INOUT val INT4) LANGUAGE sql AS $$ SELECT val * 2; $$;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CALL double_it(21);",
+					Expected: []sql.Row{{42}},
+				},
+				{
+					Query:    "CREATE PROCEDURE positional(INOUT INT4, INT4) LANGUAGE sql AS $$ SELECT $1 - $2; $$;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CALL positional(10, 3);",
+					Expected: []sql.Row{{7}},
+				},
+			},
+		},
+		{
+			Name: "Output parameters are cast to their declared types",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 VARCHAR(10));",
+				"INSERT INTO test VALUES (1, 'abc'), (2, 'def');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE PROCEDURE lookup(IN id INT4, OUT val TEXT, OUT cnt INT8) LANGUAGE sql AS $$ SELECT v1, pk FROM test WHERE pk = id; $$;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CALL lookup(2, NULL, NULL);",
+					Expected: []sql.Row{{"def", 2}},
+				},
+				{
+					Query:    "CALL lookup(3, NULL, NULL);",
+					Expected: []sql.Row{{nil, nil}},
+				},
+			},
+		},
+		{
+			Name: "Parameter defaults",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE PROCEDURE add_default(a INT4, b INT4 = 100, INOUT c INT4 DEFAULT 0) LANGUAGE sql AS $$ SELECT a + b + c; $$;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CALL add_default(1);",
+					Expected: []sql.Row{{101}},
+				},
+				{
+					Query:    "CALL add_default(1, 2);",
+					Expected: []sql.Row{{3}},
+				},
+				{
+					Query:    "CALL add_default(1, 2, 3);",
+					Expected: []sql.Row{{6}},
+				},
+				{
+					Query:       "CALL add_default(1, 2, 3, 4);",
+					ExpectedErr: "does not exist",
+				},
+				{
+					Query:       "CREATE PROCEDURE bad_default(a INT4 = 1, b INT4) LANGUAGE sql AS $$ SELECT 1; $$;",
+					ExpectedErr: "input parameters after one with a default value must also have defaults",
+				},
+			},
+		},
+		{
+			Name: "CREATE OR REPLACE PROCEDURE and DROP PROCEDURE",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE PROCEDURE proc(INOUT val INT4) LANGUAGE sql AS $$ SELECT val + 1; $$;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "CREATE PROCEDURE proc(INOUT val INT4) LANGUAGE sql AS $$ SELECT val + 2; $$;",
+					ExpectedErr: "already exists",
+				},
+				{
+					Query:    "CALL proc(1);",
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:    "CREATE OR REPLACE PROCEDURE proc(INOUT val INT4) LANGUAGE sql AS $$ SELECT val + 2; $$;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CALL proc(1);",
+					Expected: []sql.Row{{3}},
+				},
+				{
+					Query:    "DROP PROCEDURE proc;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "CALL proc(1);",
+					ExpectedErr: "procedure proc(integer) does not exist",
+				},
+				{
+					Query:       "DROP PROCEDURE proc;",
+					ExpectedErr: "procedure proc does not exist",
+				},
+				{
+					Query:    "DROP PROCEDURE IF EXISTS proc;",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "Unsupported procedure definitions",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "CREATE PROCEDURE proc(VARIADIC vals INT4[]) LANGUAGE sql AS $$ SELECT 1; $$;",
+					ExpectedErr: "VARIADIC parameters are not yet supported",
+				},
+				{
+					Query:       "CREATE PROCEDURE proc(a INT4) LANGUAGE plpython AS $$ SELECT 1; $$;",
+					ExpectedErr: `language "plpython" is not yet supported`,
+				},
+			},
+		},
+		{
+			Name: "Procedures require ownership or EXECUTE",
+			SetUpScript: []string{
+				"CREATE PROCEDURE proc(INOUT val INT4) LANGUAGE sql AS $$ SELECT val + 1; $$;",
+				"CREATE ROLE alice LOGIN;",
+				"GRANT CREATE ON SCHEMA public TO alice;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CALL proc(1);",
+					Username: "alice",
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:    "REVOKE EXECUTE ON PROCEDURE proc FROM PUBLIC;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "CALL proc(1);",
+					Username:    "alice",
+					ExpectedErr: "permission denied for procedure proc",
+				},
+				{
+					Query:    "GRANT EXECUTE ON PROCEDURE proc TO alice;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CALL proc(1);",
+					Username: "alice",
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:       "CREATE OR REPLACE PROCEDURE proc(INOUT val INT4) LANGUAGE sql AS $$ SELECT val + 2; $$;",
+					Username:    "alice",
+					ExpectedErr: "must be owner of procedure proc",
+				},
+				{
+					Query:       "DROP PROCEDURE proc;",
+					Username:    "alice",
+					ExpectedErr: "must be owner of procedure proc",
+				},
+				{
+					Query:    "CREATE PROCEDURE mine(INOUT val INT4) LANGUAGE sql AS $$ SELECT val + 3; $$;",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE OR REPLACE PROCEDURE mine(INOUT val INT4) LANGUAGE sql AS $$ SELECT val + 4; $$;",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DROP PROCEDURE mine;",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "REVOKE CREATE ON SCHEMA public FROM alice;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "CREATE PROCEDURE mine(INOUT val INT4) LANGUAGE sql AS $$ SELECT val + 3; $$;",
+					Username:    "alice",
+					ExpectedErr: "permission denied for schema public",
+				},
+			},
+		},
+	})
+}
+
+func TestProcedureErrorContext(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+	for _, query := range []string{
+		"CREATE TABLE test (pk INT8 PRIMARY KEY);",
+		"CREATE PROCEDURE insert_twice(val INT8) LANGUAGE sql AS $$ INSERT INTO test VALUES (val); INSERT INTO test VALUES (val); $$;",
+		"CREATE PROCEDURE outer_proc() LANGUAGE sql AS $$ SELECT 1; CALL insert_twice(5); $$;",
+	} {
+		_, err := conn.Exec(ctx, query)
+		require.NoError(t, err)
+	}
+
+	_, err := conn.Exec(ctx, "CALL insert_twice(1);")
+	var pgErr *pgconn.PgError
+	require.True(t, errors.As(err, &pgErr))
+	assert.Contains(t, pgErr.Message, "duplicate primary key")
+	assert.Equal(t, `SQL function "insert_twice" statement 2`, pgErr.Where)
+
+	// Nested calls list the innermost context first
+	_, err = conn.Exec(ctx, "CALL outer_proc();")
+	require.True(t, errors.As(err, &pgErr))
+	assert.Equal(t, "SQL function \"insert_twice\" statement 2\nSQL function \"outer_proc\" statement 2", pgErr.Where)
+
+	// Errors outside of procedures do not have any context
+	_, err = conn.Exec(ctx, "INSERT INTO test VALUES ('abc');")
+	require.True(t, errors.As(err, &pgErr))
+	assert.Empty(t, pgErr.Where)
+}
@@ -0,0 +1,411 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/stretchr/testify/require"
+)
+
+// These are the queries that psql 15 sends for its meta-commands, exactly as psql writes them. The queries of \d that
+// describe a single table are given the table's OID, which psql finds using the first query of \d.
+const (
+	psqlListTablesQuery = `SELECT n.nspname as "Schema",
+  c.relname as "Name",
+  CASE c.relkind WHEN 'r' THEN 'table' WHEN 'v' THEN 'view' WHEN 'm' THEN 'materialized view' WHEN 'i' THEN 'index' WHEN 'S' THEN 'sequence' WHEN 't' THEN 'TOAST table' WHEN 'f' THEN 'foreign table' WHEN 'p' THEN 'partitioned table' WHEN 'I' THEN 'partitioned index' END as "Type",
+  pg_catalog.pg_get_userbyid(c.relowner) as "Owner"
+FROM pg_catalog.pg_class c
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+     LEFT JOIN pg_catalog.pg_am am ON am.oid = c.relam
+WHERE c.relkind IN ('r','p','')
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname !~ '^pg_toast'
+      AND n.nspname <> 'information_schema'
+  AND pg_catalog.pg_table_is_visible(c.oid)
+ORDER BY 1,2;`
+	psqlListRelationsQuery = `SELECT n.nspname as "Schema",
+  c.relname as "Name",
+  CASE c.relkind WHEN 'r' THEN 'table' WHEN 'v' THEN 'view' WHEN 'm' THEN 'materialized view' WHEN 'i' THEN 'index' WHEN 'S' THEN 'sequence' WHEN 't' THEN 'TOAST table' WHEN 'f' THEN 'foreign table' WHEN 'p' THEN 'partitioned table' WHEN 'I' THEN 'partitioned index' END as "Type",
+  pg_catalog.pg_get_userbyid(c.relowner) as "Owner"
+FROM pg_catalog.pg_class c
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+     LEFT JOIN pg_catalog.pg_am am ON am.oid = c.relam
+WHERE c.relkind IN ('r','p','v','m','S','f','')
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname !~ '^pg_toast'
+      AND n.nspname <> 'information_schema'
+  AND pg_catalog.pg_table_is_visible(c.oid)
+ORDER BY 1,2;`
+	psqlListIndexesQuery = `SELECT n.nspname as "Schema",
+  c.relname as "Name",
+  CASE c.relkind WHEN 'r' THEN 'table' WHEN 'v' THEN 'view' WHEN 'm' THEN 'materialized view' WHEN 'i' THEN 'index' WHEN 'S' THEN 'sequence' WHEN 't' THEN 'TOAST table' WHEN 'f' THEN 'foreign table' WHEN 'p' THEN 'partitioned table' WHEN 'I' THEN 'partitioned index' END as "Type",
+  pg_catalog.pg_get_userbyid(c.relowner) as "Owner",
+ c2.relname as "Table"
+FROM pg_catalog.pg_class c
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+     LEFT JOIN pg_catalog.pg_am am ON am.oid = c.relam
+     LEFT JOIN pg_catalog.pg_index i ON i.indexrelid = c.oid
+     LEFT JOIN pg_catalog.pg_class c2 ON i.indrelid = c2.oid
+WHERE c.relkind IN ('i','I','')
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname !~ '^pg_toast'
+      AND n.nspname <> 'information_schema'
+  AND pg_catalog.pg_table_is_visible(c.oid)
+ORDER BY 1,2;`
+	psqlListSchemasQuery = `SELECT n.nspname AS "Name",
+  pg_catalog.pg_get_userbyid(n.nspowner) AS "Owner"
+FROM pg_catalog.pg_namespace n
+WHERE n.nspname !~ '^pg_' AND n.nspname <> 'information_schema'
+ORDER BY 1;`
+	psqlListFunctionsQuery = `SELECT n.nspname as "Schema",
+  p.proname as "Name",
+  pg_catalog.pg_get_function_result(p.oid) as "Result data type",
+  pg_catalog.pg_get_function_arguments(p.oid) as "Argument data types",
+ CASE p.prokind
+  WHEN 'a' THEN 'agg'
+  WHEN 'w' THEN 'window'
+  WHEN 'p' THEN 'proc'
+  ELSE 'func'
+ END as "Type"
+FROM pg_catalog.pg_proc p
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = p.pronamespace
+WHERE pg_catalog.pg_function_is_visible(p.oid)
+      AND n.nspname <> 'pg_catalog'
+      AND n.nspname <> 'information_schema'
+ORDER BY 1, 2, 4;`
+	psqlListRolesQuery = `SELECT r.rolname, r.rolsuper, r.rolinherit,
+  r.rolcreaterole, r.rolcreatedb, r.rolcanlogin,
+  r.rolconnlimit, r.rolvaliduntil,
+  ARRAY(SELECT b.rolname
+        FROM pg_catalog.pg_auth_members m
+        JOIN pg_catalog.pg_roles b ON (m.roleid = b.oid)
+        WHERE m.member = r.oid) as memberof
+, r.rolreplication
+, r.rolbypassrls
+FROM pg_catalog.pg_roles r
+WHERE r.rolname !~ '^pg_'
+ORDER BY 1;`
+	psqlListDatabasesQuery = `SELECT d.datname as "Name",
+       pg_catalog.pg_get_userbyid(d.datdba) as "Owner",
+       pg_catalog.pg_encoding_to_char(d.encoding) as "Encoding",
+       CASE d.datlocprovider WHEN 'c' THEN 'libc' WHEN 'i' THEN 'icu' END AS "Locale Provider",
+       d.datcollate as "Collate",
+       d.datctype as "Ctype",
+       d.daticulocale as "ICU Locale",
+       pg_catalog.array_to_string(d.datacl, E'\n') AS "Access privileges"
+FROM pg_catalog.pg_database d
+ORDER BY 1;`
+	psqlDescribeFindTableQuery = `SELECT c.oid,
+  n.nspname,
+  c.relname
+FROM pg_catalog.pg_class c
+     LEFT JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+WHERE c.relname OPERATOR(pg_catalog.~) '^(test1)$' COLLATE pg_catalog.default
+  AND pg_catalog.pg_table_is_visible(c.oid)
+ORDER BY 2, 3;`
+	psqlDescribeTableInfoQuery = `SELECT c.relchecks, c.relkind, c.relhasindex, c.relhasrules, c.relhastriggers, c.relrowsecurity, c.relforcerowsecurity, false AS relhasoids, c.relispartition, '', c.reltablespace, CASE WHEN c.reloftype = 0 THEN '' ELSE c.reloftype::pg_catalog.regtype::pg_catalog.text END, c.relpersistence, c.relreplident, am.amname
+FROM pg_catalog.pg_class c
+ LEFT JOIN pg_catalog.pg_class tc ON (c.reltoastrelid = tc.oid)
+LEFT JOIN pg_catalog.pg_am am ON (c.relam = am.oid)
+WHERE c.oid = '%d';`
+	psqlDescribeColumnsQuery = `SELECT a.attname,
+  pg_catalog.format_type(a.atttypid, a.atttypmod),
+  (SELECT pg_catalog.pg_get_expr(d.adbin, d.adrelid, true)
+   FROM pg_catalog.pg_attrdef d
+   WHERE d.adrelid = a.attrelid AND d.adnum = a.attnum AND a.atthasdef),
+  a.attnotnull,
+  (SELECT c.collname FROM pg_catalog.pg_collation c, pg_catalog.pg_type t
+   WHERE c.oid = a.attcollation AND t.oid = a.atttypid AND a.attcollation <> t.typcollation) AS attcollation,
+  a.attidentity,
+  a.attgenerated
+FROM pg_catalog.pg_attribute a
+WHERE a.attrelid = '%d' AND a.attnum > 0 AND NOT a.attisdropped
+ORDER BY a.attnum;`
+	psqlDescribeIndexesQuery = `SELECT c2.relname, i.indisprimary, i.indisunique, i.indisclustered, i.indisvalid, pg_catalog.pg_get_indexdef(i.indexrelid, 0, true),
+  pg_catalog.pg_get_constraintdef(con.oid, true), contype, condeferrable, condeferred, i.indisreplident, c2.reltablespace
+FROM pg_catalog.pg_class c, pg_catalog.pg_class c2, pg_catalog.pg_index i
+  LEFT JOIN pg_catalog.pg_constraint con ON (conrelid = i.indrelid AND conindid = i.indexrelid AND contype IN ('p','u','x'))
+WHERE c.oid = '%d' AND c.oid = i.indrelid AND i.indexrelid = c2.oid
+ORDER BY i.indisprimary DESC, c2.relname;`
+	psqlDescribeChecksQuery = `SELECT r.conname, pg_catalog.pg_get_constraintdef(r.oid, true)
+FROM pg_catalog.pg_constraint r
+WHERE r.conrelid = '%d' AND r.contype = 'c'
+ORDER BY 1;`
+	psqlDescribeForeignKeysQuery = `SELECT true as sametable, conname,
+  pg_catalog.pg_get_constraintdef(r.oid, true) as condef,
+  conrelid::pg_catalog.regclass AS ontable
+FROM pg_catalog.pg_constraint r
+WHERE r.conrelid = '%d' AND r.contype = 'f'
+     AND conparentid = 0
+ORDER BY conname;`
+	psqlDescribeReferencedByQuery = `SELECT conname, conrelid::pg_catalog.regclass AS ontable,
+       pg_catalog.pg_get_constraintdef(oid, true) AS condef
+  FROM pg_catalog.pg_constraint c
+ WHERE confrelid IN (SELECT pg_catalog.pg_partition_ancestors('%d')
+                     UNION ALL VALUES ('%d'::pg_catalog.regclass))
+       AND contype = 'f' AND conparentid = 0
+ORDER BY conname;`
+	psqlDescribePoliciesQuery = `SELECT pol.polname, pol.polpermissive,
+  CASE WHEN pol.polroles = '{0}' THEN NULL ELSE pg_catalog.array_to_string(array(select rolname from pg_catalog.pg_roles where oid = any (pol.polroles) order by 1),',') END,
+  pg_catalog.pg_get_expr(pol.polqual, pol.polrelid),
+  pg_catalog.pg_get_expr(pol.polwithcheck, pol.polrelid),
+  CASE pol.polcmd
+    WHEN 'r' THEN 'SELECT'
+    WHEN 'a' THEN 'INSERT'
+    WHEN 'w' THEN 'UPDATE'
+    WHEN 'd' THEN 'DELETE'
+    END AS cmd
+FROM pg_catalog.pg_policy pol
+WHERE pol.polrelid = '%d' ORDER BY 1;`
+	psqlDescribeStatisticsQuery = `SELECT oid, stxrelid::pg_catalog.regclass, stxnamespace::pg_catalog.regnamespace::pg_catalog.text AS nsp, stxname,
+pg_catalog.pg_get_statisticsobjdef_columns(oid) AS columns,
+  'd' = any(stxkind) AS ndist_enabled,
+  'f' = any(stxkind) AS deps_enabled,
+  'm' = any(stxkind) AS mcv_enabled,
+stxstattarget
+FROM pg_catalog.pg_statistic_ext
+WHERE stxrelid = '%d'
+ORDER BY nsp, stxname;`
+	psqlDescribePublicationsQuery = `SELECT pubname
+     , NULL
+     , NULL
+FROM pg_catalog.pg_publication p
+     JOIN pg_catalog.pg_publication_namespace pn ON p.oid = pn.pnpubid
+     JOIN pg_catalog.pg_class pc ON pc.relnamespace = pn.pnnspid
+WHERE pc.oid ='%d' and pg_catalog.pg_relation_is_publishable('%d')
+UNION
+SELECT pubname
+     , pg_get_expr(pr.prqual, c.oid)
+     , (CASE WHEN pr.prattrs IS NOT NULL THEN
+         (SELECT string_agg(attname, ', ')
+           FROM pg_catalog.generate_series(0, pg_catalog.array_upper(pr.prattrs::pg_catalog.int2[], 1)) s,
+                pg_catalog.pg_attribute
+          WHERE attrelid = pr.prrelid AND attnum = prattrs[s])
+        ELSE NULL END) FROM pg_catalog.pg_publication p
+     JOIN pg_catalog.pg_publication_rel pr ON p.oid = pr.prpubid
+     JOIN pg_catalog.pg_class c ON c.oid = pr.prrelid
+WHERE pr.prrelid = '%d'
+UNION
+SELECT pubname
+     , NULL
+     , NULL
+FROM pg_catalog.pg_publication p
+WHERE p.puballtables AND pg_catalog.pg_relation_is_publishable('%d')
+ORDER BY 1;`
+	psqlDescribeInheritsQuery = `SELECT c.oid::pg_catalog.regclass
+FROM pg_catalog.pg_class c, pg_catalog.pg_inherits i
+WHERE c.oid = i.inhparent AND i.inhrelid = '%d'
+  AND c.relkind != 'p' AND c.relkind != 'I'
+ORDER BY inhseqno;`
+	psqlDescribePartitionsQuery = `SELECT c.oid::pg_catalog.regclass, c.relkind, inhdetachpending, pg_catalog.pg_get_expr(c.relpartbound, c.oid)
+FROM pg_catalog.pg_class c, pg_catalog.pg_inherits i
+WHERE c.oid = i.inhrelid AND i.inhparent = '%d'
+ORDER BY pg_catalog.pg_get_expr(c.relpartbound, c.oid) = 'DEFAULT', c.oid::pg_catalog.regclass::pg_catalog.text;`
+	psqlDescribePartitionOfQuery = `SELECT inhparent::pg_catalog.regclass,
+  pg_catalog.pg_get_expr(c.relpartbound, c.oid),
+  inhdetachpending
+FROM pg_catalog.pg_class c JOIN pg_catalog.pg_inherits i ON c.oid = inhrelid
+WHERE c.oid = '%d';`
+	psqlDescribePartitionKeyQuery = `SELECT pg_catalog.pg_get_partkeydef('%d'::pg_catalog.oid);`
+)
+
+func TestPsqlCommands(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "psql meta-commands",
+			SetUpScript: []string{
+				"CREATE TABLE parent (id INT4 PRIMARY KEY);",
+				"CREATE TABLE test1 (pk INT4 PRIMARY KEY, v1 INT4 NOT NULL DEFAULT 5, v2 VARCHAR(10), p INT4 REFERENCES parent (id));",
+				"CREATE UNIQUE INDEX test1_v1_idx ON test1 (v1);",
+				"CREATE VIEW test1_view AS SELECT pk FROM test1;",
+				"CREATE SEQUENCE seq1;",
+				"CREATE SCHEMA s2;",
+				"CREATE TABLE s2.hidden (id INT4 PRIMARY KEY);",
+				"CREATE FUNCTION add1(x INT4) RETURNS INT4 AS $$ BEGIN RETURN x + 1; END; $$ LANGUAGE plpgsql;",
+				"CREATE ROLE r1 LOGIN;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: psqlListTablesQuery,
+					Expected: []sql.Row{
+						{"public", "parent", "table", "postgres"},
+						{"public", "test1", "table", "postgres"},
+					},
+					ExpectedColNames: []string{"Schema", "Name", "Type", "Owner"},
+				},
+				{
+					Query: psqlListRelationsQuery,
+					Expected: []sql.Row{
+						{"public", "parent", "table", "postgres"},
+						{"public", "seq1", "sequence", "postgres"},
+						{"public", "test1", "table", "postgres"},
+						{"public", "test1_view", "view", "postgres"},
+					},
+				},
+				{
+					Query: psqlListIndexesQuery,
+					Expected: []sql.Row{
+						{"public", "parent_pkey", "index", "postgres", "parent"},
+						{"public", "test1_pkey", "index", "postgres", "test1"},
+						{"public", "test1_v1_idx", "index", "postgres", "test1"},
+					},
+				},
+				{
+					Query: psqlListSchemasQuery,
+					Expected: []sql.Row{
+						{"public", "postgres"},
+						{"s2", "postgres"},
+					},
+				},
+				{
+					Query: psqlListFunctionsQuery,
+					Expected: []sql.Row{
+						{"public", "add1", "integer", "x integer", "func"},
+					},
+				},
+				{
+					Query: psqlListRolesQuery,
+					Expected: []sql.Row{
+						{"doltgres", "t", "t", "t", "t", "t", -1, nil, "{}", "t", "t"},
+						{"postgres", "t", "t", "t", "t", "t", -1, nil, "{}", "t", "t"},
+						{"r1", "f", "t", "f", "f", "t", -1, nil, "{}", "f", "f"},
+					},
+				},
+				{
+					Query: psqlListDatabasesQuery,
+					Expected: []sql.Row{
+						{"doltgres", "doltgres", "UTF8", "libc", "C", "C", nil, nil},
+						{"postgres", "postgres", "UTF8", "libc", "C", "C", nil, nil},
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestPsqlDescribeTable(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		_ = conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+	for _, query := range []string{
+		"CREATE TABLE parent (id INT4 PRIMARY KEY);",
+		"CREATE TABLE test1 (pk INT4 PRIMARY KEY, v1 INT4 NOT NULL DEFAULT 5, v2 VARCHAR(10), p INT4 REFERENCES parent (id));",
+		"ALTER TABLE test1 ADD CONSTRAINT chk CHECK (v1 > 0);",
+		"CREATE UNIQUE INDEX test1_v1_idx ON test1 (v1);",
+		"CREATE TABLE child (id INT4 PRIMARY KEY, t INT4 REFERENCES test1 (pk));",
+		"CREATE TABLE measurements (id INT4, logdate DATE) PARTITION BY RANGE (logdate);",
+		"CREATE TABLE measurements_2023 PARTITION OF measurements FOR VALUES FROM ('2023-01-01') TO ('2024-01-01');",
+		"CREATE TABLE measurements_2024 PARTITION OF measurements FOR VALUES FROM ('2024-01-01') TO ('2025-01-01');",
+	} {
+		_, err := conn.Exec(ctx, query)
+		require.NoError(t, err)
+	}
+	// psql finds the OID of the table first, which it then gives to every other query
+	tableOid := func(name string) uint32 {
+		var oid uint32
+		require.NoError(t, conn.QueryRow(ctx, fmt.Sprintf("SELECT oid FROM pg_catalog.pg_class WHERE relname = '%s';", name)).Scan(&oid))
+		return oid
+	}
+	test1 := tableOid("test1")
+	measurements := tableOid("measurements")
+	measurements2023 := tableOid("measurements_2023")
+	runScript(t, ctx, ScriptTest{
+		Name: "\\d test1",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    psqlDescribeFindTableQuery,
+				Expected: []sql.Row{{test1, "public", "test1"}},
+			},
+			{
+				Query:    fmt.Sprintf(psqlDescribeTableInfoQuery, test1),
+				Expected: []sql.Row{{1, "r", "t", "f", "f", "f", "f", "f", "f", "", 0, "", "p", "d", "heap"}},
+			},
+			{
+				Query: fmt.Sprintf(psqlDescribeColumnsQuery, test1),
+				Expected: []sql.Row{
+					{"pk", "integer", nil, "t", nil, "", ""},
+					{"v1", "integer", "5", "t", nil, "", ""},
+					{"v2", "character varying(10)", nil, "f", nil, "", ""},
+					{"p", "integer", nil, "f", nil, "", ""},
+				},
+			},
+			{
+				Query: fmt.Sprintf(psqlDescribeIndexesQuery, test1),
+				Expected: []sql.Row{
+					{"test1_pkey", "t", "t", "f", "t", "CREATE UNIQUE INDEX test1_pkey ON public.test1 USING btree (pk)", "PRIMARY KEY (pk)", "p", "f", "f", "f", 0},
+					{"test1_v1_idx", "f", "t", "f", "t", "CREATE UNIQUE INDEX test1_v1_idx ON public.test1 USING btree (v1)", "UNIQUE (v1)", "u", "f", "f", "f", 0},
+				},
+			},
+			{
+				Query:    fmt.Sprintf(psqlDescribeChecksQuery, test1),
+				Expected: []sql.Row{{"chk", "CHECK (v1 > 0)"}},
+			},
+			{
+				Query:    fmt.Sprintf(psqlDescribeForeignKeysQuery, test1),
+				Expected: []sql.Row{{"t", "test1_p_fkey", "FOREIGN KEY (p) REFERENCES parent(id)", "test1"}},
+			},
+			{
+				Query:    fmt.Sprintf(psqlDescribeReferencedByQuery, test1, test1),
+				Expected: []sql.Row{{"child_t_fkey", "child", "FOREIGN KEY (t) REFERENCES test1(pk)"}},
+			},
+			{
+				Query:    fmt.Sprintf(psqlDescribePoliciesQuery, test1),
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    fmt.Sprintf(psqlDescribeStatisticsQuery, test1),
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    fmt.Sprintf(psqlDescribePublicationsQuery, test1, test1, test1, test1),
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    fmt.Sprintf(psqlDescribeInheritsQuery, test1),
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    fmt.Sprintf(psqlDescribePartitionsQuery, test1),
+				Expected: []sql.Row{},
+			},
+		},
+	}, conn, true)
+	runScript(t, ctx, ScriptTest{
+		Name: "\\d measurements",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    fmt.Sprintf(psqlDescribePartitionKeyQuery, measurements),
+				Expected: []sql.Row{{"RANGE (logdate)"}},
+			},
+			{
+				Query: fmt.Sprintf(psqlDescribePartitionsQuery, measurements),
+				Expected: []sql.Row{
+					{"measurements_2023", "r", "f", "FOR VALUES FROM ('2023-01-01') TO ('2024-01-01')"},
+					{"measurements_2024", "r", "f", "FOR VALUES FROM ('2024-01-01') TO ('2025-01-01')"},
+				},
+			},
+			{
+				Query:    fmt.Sprintf(psqlDescribePartitionOfQuery, measurements2023),
+				Expected: []sql.Row{{"measurements", "FOR VALUES FROM ('2023-01-01') TO ('2024-01-01')", "f"}},
+			},
+		},
+	}, conn, true)
+}
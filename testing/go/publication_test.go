@@ -0,0 +1,297 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestPublications(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "CREATE, ALTER, and DROP PUBLICATION",
+			SetUpScript: []string{
+				"CREATE TABLE t1 (id INT4 PRIMARY KEY, v TEXT);",
+				"CREATE TABLE t2 (id INT4 PRIMARY KEY, v TEXT);",
+				"CREATE SCHEMA other;",
+				"CREATE TABLE other.t3 (id INT4 PRIMARY KEY);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE PUBLICATION pub1 FOR TABLE t1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE PUBLICATION pub2 FOR ALL TABLES WITH (publish = 'insert, delete');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE PUBLICATION pub3;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "CREATE PUBLICATION pub1;",
+					ExpectedErr: `publication "pub1" already exists`,
+				},
+				{
+					Query:       "CREATE PUBLICATION pub4 FOR TABLE missing;",
+					ExpectedErr: `relation "missing" does not exist`,
+				},
+				{
+					Query:       "CREATE PUBLICATION pub4 WITH (publish = 'upsert');",
+					ExpectedErr: `unrecognized value for publication option "publish": "upsert"`,
+				},
+				{
+					Query: "SELECT pubname, puballtables, pubinsert, pubupdate, pubdelete, pubtruncate, pubviaroot FROM pg_catalog.pg_publication ORDER BY pubname;",
+					Expected: []sql.Row{
+						{"pub1", "f", "t", "t", "t", "t", "f"},
+						{"pub2", "t", "t", "f", "t", "f", "f"},
+						{"pub3", "f", "t", "t", "t", "t", "f"},
+					},
+				},
+				{
+					Query: "SELECT p.pubname, c.relname FROM pg_catalog.pg_publication_rel r JOIN pg_catalog.pg_publication p ON r.prpubid = p.oid JOIN pg_catalog.pg_class c ON r.prrelid = c.oid;",
+					Expected: []sql.Row{
+						{"pub1", "t1"},
+					},
+				},
+				{
+					Query: "SELECT pubname, schemaname, tablename, attnames FROM pg_catalog.pg_publication_tables ORDER BY pubname, schemaname, tablename;",
+					Expected: []sql.Row{
+						{"pub1", "public", "t1", "{id,v}"},
+						{"pub2", "other", "t3", "{id}"},
+						{"pub2", "public", "t1", "{id,v}"},
+						{"pub2", "public", "t2", "{id,v}"},
+					},
+				},
+				{
+					Query:    "ALTER PUBLICATION pub1 ADD TABLE t2, other.t3;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "ALTER PUBLICATION pub1 ADD TABLE t2;",
+					ExpectedErr: `relation "t2" is already member of publication "pub1"`,
+				},
+				{
+					Query:    "ALTER PUBLICATION pub1 DROP TABLE t1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "ALTER PUBLICATION pub1 DROP TABLE t1;",
+					ExpectedErr: `relation "t1" is not part of the publication`,
+				},
+				{
+					Query:       "ALTER PUBLICATION pub2 ADD TABLE t1;",
+					ExpectedErr: `publication "pub2" is defined as FOR ALL TABLES`,
+				},
+				{
+					Query: "SELECT schemaname, tablename FROM pg_catalog.pg_publication_tables WHERE pubname = 'pub1' ORDER BY schemaname, tablename;",
+					Expected: []sql.Row{
+						{"other", "t3"},
+						{"public", "t2"},
+					},
+				},
+				{
+					Query:    "ALTER PUBLICATION pub3 SET TABLE t1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER PUBLICATION pub3 SET (publish = 'update');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER PUBLICATION pub3 RENAME TO pub4;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query: "SELECT p.pubname, p.pubinsert, p.pubupdate, t.tablename FROM pg_catalog.pg_publication p JOIN pg_catalog.pg_publication_tables t ON p.pubname = t.pubname WHERE p.pubname = 'pub4';",
+					Expected: []sql.Row{
+						{"pub4", "f", "t", "t1"},
+					},
+				},
+				{
+					Query:       "ALTER PUBLICATION pub3 SET TABLE t2;",
+					ExpectedErr: `publication "pub3" does not exist`,
+				},
+				{
+					Query:    "ALTER TABLE t1 RENAME TO t5;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DROP TABLE t2;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query: "SELECT pubname, schemaname, tablename FROM pg_catalog.pg_publication_tables WHERE pubname <> 'pub2' ORDER BY pubname, schemaname, tablename;",
+					Expected: []sql.Row{
+						{"pub1", "other", "t3"},
+						{"pub4", "public", "t5"},
+					},
+				},
+				{
+					Query:    "DROP PUBLICATION pub1, pub2;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DROP PUBLICATION IF EXISTS pub1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "DROP PUBLICATION pub1;",
+					ExpectedErr: `publication "pub1" does not exist`,
+				},
+				{
+					Query:    "SELECT pubname FROM pg_catalog.pg_publication;",
+					Expected: []sql.Row{{"pub4"}},
+				},
+			},
+		},
+		{
+			Name: "doltgres_publication_changes",
+			SetUpScript: []string{
+				"CREATE TABLE t1 (id INT4 PRIMARY KEY, v TEXT, n FLOAT8);",
+				"CREATE TABLE t2 (id INT4 PRIMARY KEY);",
+				"CREATE TABLE keyless (v INT4);",
+				"CREATE PUBLICATION pub FOR TABLE t1, keyless;",
+				"CREATE PUBLICATION inserts FOR TABLE t1 WITH (publish = 'insert');",
+				"SELECT dolt_commit('-Am', 'initial');",
+				"INSERT INTO t1 VALUES (1, 'one', 1.5), (2, NULL, -2);",
+				"INSERT INTO t2 VALUES (1);",
+				"SELECT dolt_commit('-Am', 'insert');",
+				"INSERT INTO t2 VALUES (2);",
+				"SELECT dolt_commit('-Am', 'only t2');",
+				"UPDATE t1 SET v = 'uno' WHERE id = 1;",
+				"DELETE FROM t1 WHERE id = 2;",
+				"INSERT INTO keyless VALUES (7), (7);",
+				"SELECT dolt_commit('-Am', 'update and delete');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT data FROM doltgres_publication_changes('pub', 'HEAD~3') WHERE data NOT LIKE '{\"action\":\"B\"%' AND data NOT LIKE '{\"action\":\"C\"%';",
+					Expected: []sql.Row{
+						{`{"action":"I","schema":"public","table":"t1","columns":[{"name":"id","type":"integer","value":1},{"name":"v","type":"text","value":"one"},{"name":"n","type":"double precision","value":1.5}]}`},
+						{`{"action":"I","schema":"public","table":"t1","columns":[{"name":"id","type":"integer","value":2},{"name":"v","type":"text","value":null},{"name":"n","type":"double precision","value":-2}]}`},
+						{`{"action":"I","schema":"public","table":"keyless","columns":[{"name":"v","type":"integer","value":7}]}`},
+						{`{"action":"I","schema":"public","table":"keyless","columns":[{"name":"v","type":"integer","value":7}]}`},
+						{`{"action":"U","schema":"public","table":"t1","columns":[{"name":"id","type":"integer","value":1},{"name":"v","type":"text","value":"uno"},{"name":"n","type":"double precision","value":1.5}],"identity":[{"name":"id","type":"integer","value":1}]}`},
+						{`{"action":"D","schema":"public","table":"t1","identity":[{"name":"id","type":"integer","value":2}]}`},
+					},
+				},
+				{
+					Query: "SELECT left(data, 13) FROM doltgres_publication_changes('pub', 'HEAD~3', 'HEAD~1');",
+					Expected: []sql.Row{
+						{`{"action":"B"`},
+						{`{"action":"I"`},
+						{`{"action":"I"`},
+						{`{"action":"C"`},
+					},
+				},
+				{
+					Query: "SELECT count(DISTINCT commit_hash) FROM doltgres_publication_changes('pub', 'HEAD~3');",
+					Expected: []sql.Row{
+						{2},
+					},
+				},
+				{
+					Query: "SELECT left(data, 14) FROM doltgres_publication_changes('inserts', 'HEAD~3') WHERE data NOT LIKE '{\"action\":\"B\"%' AND data NOT LIKE '{\"action\":\"C\"%';",
+					Expected: []sql.Row{
+						{`{"action":"I",`},
+						{`{"action":"I",`},
+					},
+				},
+				{
+					Query:    "SELECT * FROM doltgres_publication_changes('pub', 'HEAD');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT * FROM doltgres_publication_changes('missing', 'HEAD~1');",
+					ExpectedErr: `publication "missing" does not exist`,
+				},
+				{
+					Query:       "SELECT * FROM doltgres_publication_changes('pub', 'HEAD', 'HEAD~1');",
+					ExpectedErr: `"HEAD" is not an ancestor of "HEAD~1"`,
+				},
+			},
+		},
+		{
+			Name: "Publications require ownership",
+			SetUpScript: []string{
+				"CREATE TABLE t1 (pk INT4 PRIMARY KEY);",
+				"CREATE PUBLICATION pub1 FOR TABLE t1;",
+				"CREATE ROLE alice LOGIN;",
+				"GRANT CREATE ON DATABASE postgres TO alice;",
+				"GRANT CREATE ON SCHEMA public TO alice;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "ALTER PUBLICATION pub1 SET (publish = 'insert');",
+					Username:    "alice",
+					ExpectedErr: "must be owner of publication pub1",
+				},
+				{
+					Query:       "DROP PUBLICATION pub1;",
+					Username:    "alice",
+					ExpectedErr: "must be owner of publication pub1",
+				},
+				{
+					Query:       "CREATE PUBLICATION pub2 FOR TABLE t1;",
+					Username:    "alice",
+					ExpectedErr: "must be owner of table t1",
+				},
+				{
+					Query:       "CREATE PUBLICATION pub2 FOR ALL TABLES;",
+					Username:    "alice",
+					ExpectedErr: "must be superuser to create FOR ALL TABLES publication",
+				},
+				{
+					Query:    "CREATE TABLE t2 (pk INT4 PRIMARY KEY);",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE PUBLICATION pub2 FOR TABLE t2;",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "ALTER PUBLICATION pub2 ADD TABLE t1;",
+					Username:    "alice",
+					ExpectedErr: "must be owner of table t1",
+				},
+				{
+					Query:       "ALTER PUBLICATION pub2 OWNER TO postgres;",
+					Username:    "alice",
+					ExpectedErr: `must be able to SET ROLE "postgres"`,
+				},
+				{
+					Query:    "DROP PUBLICATION pub2;",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "REVOKE CREATE ON DATABASE postgres FROM alice;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "CREATE PUBLICATION pub3 FOR TABLE t2;",
+					Username:    "alice",
+					ExpectedErr: "permission denied for database postgres",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,171 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestPullRequests(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "Pull request workflow",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 TEXT);",
+				"INSERT INTO test VALUES (1, 'one'), (2, 'two'), (3, 'three');",
+				"CALL dolt_commit('-Am', 'initial');",
+				"CALL dolt_branch('feature');",
+				"CALL dolt_checkout('feature');",
+				"INSERT INTO test VALUES (4, 'four');",
+				"UPDATE test SET v1 = 'TWO' WHERE pk = 2;",
+				"DELETE FROM test WHERE pk = 3;",
+				"CALL dolt_commit('-am', 'feature changes');",
+				"CALL dolt_checkout('main');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "CALL dolt_pull_request_create('feature', 'feature');",
+					ExpectedErr: "cannot merge branch feature into itself",
+				},
+				{
+					Query:       "CALL dolt_pull_request_create('missing', 'main');",
+					ExpectedErr: "branch not found: missing",
+				},
+				{
+					Query:    "CALL dolt_pull_request_create('feature', 'main', 'Updates the test table');",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query: "SELECT id, source_branch, target_branch, description, status, approved_by, merge_commit FROM dolt.pull_requests;",
+					Expected: []sql.Row{
+						{1, "feature", "main", "Updates the test table", "open", nil, nil},
+					},
+				},
+				{
+					Query:    "CALL dolt_pull_request_preview(1);",
+					Expected: []sql.Row{{0, 1, 1, 1, 1}},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, "one"}, {2, "two"}, {3, "three"}},
+				},
+				{
+					Query:       "CALL dolt_pull_request_merge(1);",
+					ExpectedErr: "must be approved before it can be merged",
+				},
+				{
+					Query:    "CALL dolt_pull_request_approve(1);",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:       "CALL dolt_pull_request_approve(1);",
+					ExpectedErr: "cannot be approved as it is approved",
+				},
+				{
+					Query:    "SELECT status, approved_by IS NOT NULL FROM dolt.pull_requests WHERE id = 1;",
+					Expected: []sql.Row{{"approved", 1}},
+				},
+				{
+					Query:            "CALL dolt_pull_request_merge(1);",
+					SkipResultsCheck: true,
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, "one"}, {2, "TWO"}, {4, "four"}},
+				},
+				{
+					Query:    "SELECT message FROM dolt_log LIMIT 1;",
+					Expected: []sql.Row{{"Merge pull request #1 from feature\n\nUpdates the test table"}},
+				},
+				{
+					Query:    "SELECT status, merge_commit = (SELECT commit_hash FROM dolt_log LIMIT 1) FROM dolt.pull_requests WHERE id = 1;",
+					Expected: []sql.Row{{"merged", "t"}},
+					Skip:     true, // Scalar subqueries are not yet supported
+				},
+				{
+					Query:    "SELECT status, length(merge_commit) FROM dolt.pull_requests WHERE id = 1;",
+					Expected: []sql.Row{{"merged", 32}},
+				},
+				{
+					Query:       "CALL dolt_pull_request_close(1);",
+					ExpectedErr: "cannot be closed as it is merged",
+				},
+				{
+					Query:       "CALL dolt_pull_request_preview(2);",
+					ExpectedErr: "pull request 2 does not exist",
+				},
+			},
+		},
+		{
+			Name: "Pull requests with conflicts cannot be merged",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 TEXT);",
+				"INSERT INTO test VALUES (1, 'one');",
+				"CALL dolt_commit('-Am', 'initial');",
+				"CALL dolt_branch('feature');",
+				"UPDATE test SET v1 = 'main' WHERE pk = 1;",
+				"CALL dolt_commit('-am', 'main change');",
+				"CALL dolt_checkout('feature');",
+				"UPDATE test SET v1 = 'feature' WHERE pk = 1;",
+				"CALL dolt_commit('-am', 'feature change');",
+				"CALL dolt_checkout('main');",
+				"CALL dolt_pull_request_create('feature', 'main');",
+				"CALL dolt_pull_request_approve(1);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CALL dolt_pull_request_preview(1);",
+					Expected: []sql.Row{{1, 1, 0, 0, 1}},
+				},
+				{
+					Query:       "CALL dolt_pull_request_merge(1);",
+					ExpectedErr: "cannot be merged as it has 1 conflicts",
+				},
+				{
+					Query:    "SELECT * FROM test;",
+					Expected: []sql.Row{{1, "main"}},
+				},
+				{
+					Query:    "CALL dolt_pull_request_close(1);",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SELECT status, description FROM dolt.pull_requests;",
+					Expected: []sql.Row{{"closed", nil}},
+				},
+			},
+		},
+		{
+			Name: "Pull requests are merged from their target branch",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY);",
+				"CALL dolt_commit('-Am', 'initial');",
+				"CALL dolt_branch('feature');",
+				"CALL dolt_branch('other');",
+				"CALL dolt_checkout('other');",
+				"CALL dolt_pull_request_create('feature', 'main');",
+				"CALL dolt_pull_request_approve(1);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "CALL dolt_pull_request_merge(1);",
+					ExpectedErr: "must be merged from its target branch main, but the current branch is other",
+				},
+			},
+		},
+	})
+}
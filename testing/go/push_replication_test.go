@@ -0,0 +1,167 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dserver "github.com/dolthub/doltgresql/server"
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+func TestPushReplication(t *testing.T) {
+	ctx := context.Background()
+	var mutex sync.Mutex
+	var warnings []string
+	connect := func(srv *dserver.Server, database string) *pgx.Conn {
+		return ConnectWithNoticeHandler(t, srv, database, func(_ *pgconn.PgConn, notice *pgconn.Notice) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			if notice.Severity == "WARNING" {
+				warnings = append(warnings, notice.Message)
+			}
+		})
+	}
+	takeWarnings := func() []string {
+		mutex.Lock()
+		defer mutex.Unlock()
+		taken := warnings
+		warnings = nil
+		return taken
+	}
+
+	// Each server is stopped at the end of its subtest, as only one server may run at a time
+	t.Run("synchronous", func(t *testing.T) {
+		remotesDir := t.TempDir()
+		srv := StartServer(t, &servercfg.DoltgresConfig{
+			PushReplication: &servercfg.DoltgresPushReplicationConfig{
+				Remotes:   []string{"file://" + filepath.ToSlash(remotesDir) + "/{database}-mirror"},
+				Databases: []string{"pushed*"},
+			},
+		})
+		ExecQueries(t, connect(srv, ""), "CREATE DATABASE pusheddb;", "CREATE DATABASE skippeddb;")
+		conn := connect(srv, "pusheddb")
+		ExecQueries(t, conn,
+			"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 TEXT);",
+			"INSERT INTO test VALUES (1, 'one');",
+			"SELECT dolt_commit('-Am', 'initial');",
+		)
+		ExecQueries(t, connect(srv, "skippeddb"),
+			"CREATE TABLE test (pk INT4 PRIMARY KEY);",
+			"SELECT dolt_commit('-Am', 'initial');",
+		)
+		assert.Empty(t, takeWarnings())
+		// The commit has been pushed by the time that dolt_commit returns
+		mirrorUrl := "file://" + filepath.ToSlash(filepath.Join(remotesDir, "pusheddb-mirror"))
+		ExecQueries(t, conn, fmt.Sprintf("SELECT dolt_clone('%s', 'clonedb');", mirrorUrl))
+		clone := connect(srv, "clonedb")
+		assert.Equal(t, []any{int32(1), "one"}, QueryRow(t, clone, "SELECT * FROM test;"))
+		assert.Equal(t, []any{"initial"}, QueryRow(t, clone, "SELECT message FROM dolt_log LIMIT 1;"))
+		assert.NoDirExists(t, filepath.Join(remotesDir, "skippeddb-mirror"))
+	})
+
+	t.Run("asynchronous", func(t *testing.T) {
+		remotesDir := t.TempDir()
+		srv := StartServer(t, &servercfg.DoltgresConfig{
+			PushReplication: &servercfg.DoltgresPushReplicationConfig{
+				Remotes: []string{"file://" + filepath.ToSlash(remotesDir)},
+				Async:   ptr(true),
+			},
+		})
+		ExecQueries(t, connect(srv, ""), "CREATE DATABASE asyncdb;")
+		conn := connect(srv, "asyncdb")
+		ExecQueries(t, conn,
+			"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 TEXT);",
+			"INSERT INTO test VALUES (1, 'one');",
+			"SELECT dolt_commit('-Am', 'initial');",
+		)
+		mirrorUrl := "file://" + filepath.ToSlash(filepath.Join(remotesDir, "asyncdb"))
+		require.Eventually(t, func() bool {
+			_, err := conn.Exec(ctx, fmt.Sprintf("SELECT dolt_clone('%s', 'clonedb');", mirrorUrl))
+			return err == nil
+		}, 10*time.Second, 100*time.Millisecond)
+		assert.Equal(t, []any{int64(1)}, QueryRow(t, connect(srv, "clonedb"), "SELECT count(*) FROM test;"))
+	})
+
+	t.Run("failure policies", func(t *testing.T) {
+		// Remotes beneath a file cannot be created, and so cannot be loaded
+		unloadable := "file:///dev/null/{database}"
+		_, err := TryStartServer(t, &servercfg.DoltgresConfig{
+			PushReplication: &servercfg.DoltgresPushReplicationConfig{
+				Remotes:       []string{unloadable},
+				FailurePolicy: ptr("unknown"),
+			},
+		})
+		require.ErrorContains(t, err, `invalid push replication failure policy "unknown"`)
+		_, err = TryStartServer(t, &servercfg.DoltgresConfig{
+			PushReplication: &servercfg.DoltgresPushReplicationConfig{
+				Remotes: []string{"origin"},
+			},
+		})
+		require.ErrorContains(t, err, "remotes must be URLs")
+
+		srv := StartServer(t, &servercfg.DoltgresConfig{
+			PushReplication: &servercfg.DoltgresPushReplicationConfig{
+				Remotes:   []string{unloadable},
+				Databases: []string{"strict*"},
+			},
+		})
+		conn := connect(srv, "")
+		_, err = conn.Exec(ctx, "CREATE DATABASE strictdb;")
+		require.ErrorContains(t, err, "error loading push replication remote file:///dev/null/strictdb")
+		ExecQueries(t, conn, "CREATE DATABASE lenientdb;")
+	})
+
+	t.Run("warnings", func(t *testing.T) {
+		remotesDir := t.TempDir()
+		srv := StartServer(t, &servercfg.DoltgresConfig{
+			PushReplication: &servercfg.DoltgresPushReplicationConfig{
+				Remotes:       []string{"file:///dev/null/{database}", "file://" + filepath.ToSlash(remotesDir)},
+				FailurePolicy: ptr("warn"),
+			},
+		})
+		// Remotes that cannot be loaded are skipped, while the remaining remotes are still pushed to
+		ExecQueries(t, connect(srv, ""), "CREATE DATABASE warndb;")
+		conn := connect(srv, "warndb")
+		ExecQueries(t, conn,
+			"CREATE TABLE test (pk INT4 PRIMARY KEY);",
+			"SELECT dolt_commit('-Am', 'initial');",
+		)
+		assert.Empty(t, takeWarnings())
+		assert.DirExists(t, filepath.Join(remotesDir, "warndb"))
+
+		// Removing the remote causes the push to fail, which does not prevent the commit from being made
+		require.NoError(t, os.RemoveAll(filepath.Join(remotesDir, "warndb")))
+		ExecQueries(t, conn,
+			"INSERT INTO test VALUES (1);",
+			"SELECT dolt_commit('-am', 'second');",
+		)
+		assert.Equal(t, []any{"second"}, QueryRow(t, conn, "SELECT message FROM dolt_log LIMIT 1;"))
+		pushWarnings := takeWarnings()
+		require.Len(t, pushWarnings, 1)
+		assert.Contains(t, pushWarnings[0], "the commit was made, but could not be pushed to file://")
+	})
+}
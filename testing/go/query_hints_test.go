@@ -0,0 +1,144 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryHints(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "join hints",
+			SetUpScript: []string{
+				"CREATE TABLE t1 (pk INT8 PRIMARY KEY, v1 INT8);",
+				"CREATE TABLE t2 (pk INT8 PRIMARY KEY, v1 INT8);",
+				"INSERT INTO t1 VALUES (1, 10), (2, 20), (3, 30);",
+				"INSERT INTO t2 VALUES (1, 10), (2, 30), (3, 50);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "/*+ HashJoin(a b) Leading(b a) */ SELECT a.pk, b.pk FROM t1 a JOIN t2 b ON a.v1 = b.v1 ORDER BY a.pk;",
+					Expected: []sql.Row{{1, 1}, {3, 2}},
+				},
+				{
+					Query:    "SET pg_hint_plan.enable_hint TO on;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "/*+ HashJoin(a b) Leading(b a) */ SELECT a.pk, b.pk FROM t1 a JOIN t2 b ON a.v1 = b.v1 ORDER BY a.pk;",
+					Expected: []sql.Row{{1, 1}, {3, 2}},
+				},
+				{
+					Query:    "/*+ MergeJoin(a b) */ SELECT a.pk, b.v1 FROM t1 a JOIN t2 b ON a.pk = b.pk ORDER BY a.pk;",
+					Expected: []sql.Row{{1, 10}, {2, 30}, {3, 50}},
+				},
+				{
+					Query:    "/*+ NestLoop(a b) */ SELECT a.pk FROM t1 a JOIN t2 b ON a.v1 < b.v1 WHERE b.pk = 3 ORDER BY a.pk;",
+					Expected: []sql.Row{{1}, {2}, {3}},
+				},
+				{
+					Query:    "-- leading comment\n/*+ Leading((b a)) */ SELECT count(*) FROM t1 a JOIN t2 b ON a.pk = b.pk;",
+					Expected: []sql.Row{{3}},
+				},
+				{
+					// Malformed hints are ignored, just like pg_hint_plan
+					Query:    "/*+ HashJoin(a b IndexScan(a) SeqScan(b) */ SELECT a.pk FROM t1 a JOIN t2 b ON a.pk = b.pk ORDER BY a.pk;",
+					Expected: []sql.Row{{1}, {2}, {3}},
+				},
+				{
+					Query:    "/*+ IndexScan(t1 t1_pkey) */ SELECT v1 FROM t1 WHERE pk = 2;",
+					Expected: []sql.Row{{20}},
+				},
+			},
+		},
+	})
+}
+
+func TestQueryHintWarnings(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+
+	var mutex sync.Mutex
+	var received []*pgconn.Notice
+	config, err := pgx.ParseConfig(conn.Config().ConnString())
+	require.NoError(t, err)
+	config.OnNotice = func(_ *pgconn.PgConn, notice *pgconn.Notice) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		received = append(received, notice)
+	}
+	noticeConn, err := pgx.ConnectConfig(ctx, config)
+	require.NoError(t, err)
+	defer noticeConn.Close(context.Background())
+	takeNotices := func() []*pgconn.Notice {
+		mutex.Lock()
+		defer mutex.Unlock()
+		notices := received
+		received = nil
+		return notices
+	}
+	ExecQueries(t, noticeConn, "CREATE TABLE t1 (pk INT8 PRIMARY KEY, v1 INT8);")
+	ExecQueries(t, noticeConn, "CREATE TABLE t2 (pk INT8 PRIMARY KEY, v1 INT8);")
+	ExecQueries(t, noticeConn, "CREATE TABLE t3 (pk INT8 PRIMARY KEY, v1 INT8);")
+
+	t.Run("No warnings while hints are disabled", func(t *testing.T) {
+		ExecQueries(t, noticeConn, "/*+ SeqScan(t1) */ SELECT * FROM t1;")
+		assert.Empty(t, takeNotices())
+	})
+
+	ExecQueries(t, noticeConn, "SET pg_hint_plan.enable_hint TO on;")
+	t.Run("Scan method hints are reported", func(t *testing.T) {
+		ExecQueries(t, noticeConn, "/*+ SeqScan(a) IndexScan(b t2_pkey) HashJoin(a b) */ SELECT a.pk FROM t1 a JOIN t2 b ON a.pk = b.pk;")
+		notices := takeNotices()
+		require.Len(t, notices, 2)
+		assert.Equal(t, "WARNING", notices[0].Severity)
+		assert.Equal(t, `hint "SeqScan(a)" is not supported and was ignored`, notices[0].Message)
+		assert.Equal(t, `hint "IndexScan(b t2_pkey)" is not supported and was ignored`, notices[1].Message)
+	})
+
+	t.Run("Join hints over more than two tables are reported", func(t *testing.T) {
+		ExecQueries(t, noticeConn, "/*+ HashJoin(a b c) */ SELECT a.pk FROM t1 a JOIN t2 b ON a.pk = b.pk JOIN t3 c ON b.pk = c.pk;")
+		notices := takeNotices()
+		require.Len(t, notices, 1)
+		assert.Equal(t, `hint "HashJoin(a b c)" is not supported and was ignored`, notices[0].Message)
+	})
+
+	t.Run("Supported hints are not reported", func(t *testing.T) {
+		ExecQueries(t, noticeConn, "/*+ HashJoin(a b) Leading(b a) */ SELECT a.pk FROM t1 a JOIN t2 b ON a.pk = b.pk;")
+		assert.Empty(t, takeNotices())
+	})
+
+	t.Run("Warnings respect client_min_messages", func(t *testing.T) {
+		ExecQueries(t, noticeConn, "SET client_min_messages TO error;")
+		ExecQueries(t, noticeConn, "/*+ SeqScan(t1) */ SELECT * FROM t1;")
+		assert.Empty(t, takeNotices())
+		ExecQueries(t, noticeConn, "RESET client_min_messages;")
+		ExecQueries(t, noticeConn, "/*+ SeqScan(t1) */ SELECT * FROM t1;")
+		assert.Len(t, takeNotices(), 1)
+	})
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+	"github.com/dolthub/dolt/go/store/types"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+func TestReadReplica(t *testing.T) {
+	ctx := context.Background()
+	remotesDir := t.TempDir()
+	remoteUrl := "file://" + filepath.ToSlash(filepath.Join(remotesDir, "replicadb"))
+	webhookListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	webhookAddress := webhookListener.Addr().String()
+	require.NoError(t, webhookListener.Close())
+	readReplica := &servercfg.DoltgresReadReplicaConfig{
+		RemoteURL:      ptr("file://" + filepath.ToSlash(remotesDir) + "/{database}"),
+		Databases:      []string{"replicadb"},
+		Branches:       []string{"main", "release*"},
+		Interval:       ptr("1h"),
+		WebhookAddress: ptr(webhookAddress),
+	}
+
+	// Only one server may run at a time, so the primary is stopped before the replica starts
+	primary := StartServer(t, nil)
+	ExecQueries(t, Connect(t, primary, ""), "CREATE DATABASE replicadb;")
+	conn := Connect(t, primary, "replicadb")
+	ExecQueries(t, conn,
+		"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 TEXT);",
+		"INSERT INTO test VALUES (1, 'one');",
+		"SELECT dolt_commit('-Am', 'initial');",
+		"SELECT dolt_branch('release-1');",
+		"SELECT dolt_branch('scratch');",
+		"SELECT dolt_checkout('-b', 'next');",
+		"INSERT INTO test VALUES (2, 'two');",
+		"SELECT dolt_commit('-am', 'second');",
+		fmt.Sprintf("SELECT dolt_remote('add', 'origin', '%s');", remoteUrl),
+		"SELECT dolt_push('origin', 'main');",
+		"SELECT dolt_push('origin', 'release-1');",
+		"SELECT dolt_push('origin', 'scratch');",
+		"SELECT dolt_push('origin', 'next');",
+	)
+	require.NoError(t, primary.Stop())
+
+	replicaServer := StartServer(t, &servercfg.DoltgresConfig{
+		ReadReplica: readReplica,
+	})
+	replica := Connect(t, replicaServer, "replicadb")
+
+	t.Run("databases are cloned", func(t *testing.T) {
+		assert.Equal(t, []any{int32(1), "one"}, QueryRow(t, replica, "SELECT * FROM test;"))
+		assert.Equal(t, []any{int64(1)}, QueryRow(t, replica, "SELECT count(*) FROM dolt_branches WHERE name = 'release-1';"))
+		assert.Equal(t, []any{int64(0)}, QueryRow(t, replica, "SELECT count(*) FROM dolt_branches WHERE name = 'scratch';"))
+	})
+
+	t.Run("writes are rejected", func(t *testing.T) {
+		for _, query := range []string{
+			"INSERT INTO test VALUES (3, 'three');",
+			"CREATE TABLE other (pk INT4 PRIMARY KEY);",
+		} {
+			_, err := replica.Exec(ctx, query)
+			var pgErr *pgconn.PgError
+			require.True(t, errors.As(err, &pgErr), "%s: %v", query, err)
+			assert.Equal(t, "25006", pgErr.Code, query)
+		}
+	})
+
+	t.Run("webhook", func(t *testing.T) {
+		// Moving the remote's main branch to the commit of another branch is the same as pushing that commit to main
+		remoteDb, err := doltdb.LoadDoltDB(ctx, types.Format_Default, remoteUrl, filesys.LocalFS)
+		require.NoError(t, err)
+		nextCommit, err := remoteDb.ResolveCommitRef(ctx, ref.NewBranchRef("next"))
+		require.NoError(t, err)
+		nextHash, err := nextCommit.HashOf()
+		require.NoError(t, err)
+		require.NoError(t, remoteDb.SetHead(ctx, ref.NewBranchRef("main"), nextHash))
+		assert.Equal(t, []any{int64(1)}, QueryRow(t, replica, "SELECT count(*) FROM test;"))
+
+		resp, err := http.Get("http://" + webhookAddress + "/replicate")
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+		resp, err = http.Post("http://"+webhookAddress+"/replicate?database=missing", "", nil)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		resp, err = http.Post("http://"+webhookAddress+"/replicate?database=replicadb", "", nil)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		assert.Equal(t, []any{int64(2)}, QueryRow(t, replica, "SELECT count(*) FROM test;"))
+		assert.Equal(t, []any{"second"}, QueryRow(t, replica, "SELECT message FROM dolt_log LIMIT 1;"))
+	})
+}
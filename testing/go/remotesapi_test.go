@@ -0,0 +1,157 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/madflojo/testcerts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+func TestRemotesapi(t *testing.T) {
+	ctx := context.Background()
+	// The endpoint authenticates using passwords, so the superuser must have one
+	serverConfig := func(remotesapi *servercfg.DoltgresRemotesapiConfig) *servercfg.DoltgresConfig {
+		return &servercfg.DoltgresConfig{
+			UserConfig: &servercfg.DoltgresUserConfig{
+				Name:     ptr("postgres"),
+				Password: ptr("password"),
+			},
+			RemotesapiConfig: remotesapi,
+		}
+	}
+
+	// A server cannot clone from itself, as cloning blocks access to the server's databases until it finishes, so each
+	// test fetches into a separate database instead
+	t.Run("fetch and push", func(t *testing.T) {
+		port := GetUnusedPort(t)
+		srv := StartServer(t, serverConfig(&servercfg.DoltgresRemotesapiConfig{
+			Port: ptr(port),
+			Host: ptr("127.0.0.1"),
+		}))
+		ExecQueries(t, Connect(t, srv, ""),
+			"CREATE DATABASE sourcedb;",
+			"CREATE DATABASE mirrordb;",
+			"CREATE ROLE reader LOGIN PASSWORD 'readerpass';",
+		)
+		source := Connect(t, srv, "sourcedb")
+		ExecQueries(t, source,
+			"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 TEXT);",
+			"INSERT INTO test VALUES (1, 'one');",
+			"SELECT dolt_commit('-Am', 'initial');",
+		)
+		mirror := Connect(t, srv, "mirrordb")
+		ExecQueries(t, mirror,
+			fmt.Sprintf("SELECT dolt_remote('add', 'origin', 'http://127.0.0.1:%d/sourcedb');", port),
+			fmt.Sprintf("SELECT dolt_remote('add', 'missing', 'http://127.0.0.1:%d/missing');", port),
+		)
+		run := func(user string, password string, query string) error {
+			t.Setenv("DOLT_REMOTE_PASSWORD", password)
+			_, err := mirror.Exec(ctx, fmt.Sprintf(query, user))
+			return err
+		}
+		fetch := "SELECT dolt_fetch('--user', '%s', 'origin', 'main');"
+		push := "SELECT dolt_push('--user', '%s', 'origin', 'copy:main');"
+
+		// Clients authenticate as Doltgres roles, and reading requires either a superuser or the REPLICATION attribute
+		require.ErrorContains(t, run("postgres", "wrong", fetch), `password authentication failed for user "postgres"`)
+		require.ErrorContains(t, run("reader", "readerpass", fetch), "must be a superuser or have the REPLICATION attribute")
+		require.ErrorContains(t, run("postgres", "password", "SELECT dolt_fetch('--user', '%s', 'missing', 'main');"), `database "missing" does not exist`)
+		ExecQueries(t, source, "ALTER ROLE reader REPLICATION;")
+		require.NoError(t, run("reader", "readerpass", fetch))
+		ExecQueries(t, mirror, "SELECT dolt_checkout('-b', 'copy', 'origin/main');")
+		assert.Equal(t, []any{int32(1), "one"}, QueryRow(t, mirror, "SELECT * FROM test;"))
+
+		// Pushing requires a superuser
+		ExecQueries(t, mirror,
+			"INSERT INTO test VALUES (2, 'two');",
+			"SELECT dolt_commit('-am', 'second');",
+		)
+		require.ErrorContains(t, run("reader", "readerpass", push), "must be a superuser to push")
+		require.NoError(t, run("postgres", "password", push))
+		assert.Equal(t, []any{"second"}, QueryRow(t, source, "SELECT message FROM dolt_log LIMIT 1;"))
+		assert.Equal(t, []any{int64(2)}, QueryRow(t, source, "SELECT count(*) FROM test;"))
+	})
+
+	t.Run("read only", func(t *testing.T) {
+		port := GetUnusedPort(t)
+		srv := StartServer(t, serverConfig(&servercfg.DoltgresRemotesapiConfig{
+			Port:     ptr(port),
+			Host:     ptr("127.0.0.1"),
+			ReadOnly: ptr(true),
+		}))
+		ExecQueries(t, Connect(t, srv, ""), "CREATE DATABASE sourcedb;", "CREATE DATABASE mirrordb;")
+		source := Connect(t, srv, "sourcedb")
+		ExecQueries(t, source,
+			"CREATE TABLE test (pk INT4 PRIMARY KEY);",
+			"SELECT dolt_commit('-Am', 'initial');",
+		)
+		t.Setenv("DOLT_REMOTE_PASSWORD", "password")
+		mirror := Connect(t, srv, "mirrordb")
+		ExecQueries(t, mirror,
+			fmt.Sprintf("SELECT dolt_remote('add', 'origin', 'http://127.0.0.1:%d/sourcedb');", port),
+			"SELECT dolt_fetch('--user', 'postgres', 'origin', 'main');",
+			"SELECT dolt_checkout('-b', 'copy', 'origin/main');",
+			"INSERT INTO test VALUES (1);",
+			"SELECT dolt_commit('-am', 'second');",
+		)
+		_, err := mirror.Exec(ctx, "SELECT dolt_push('--user', 'postgres', 'origin', 'copy:main');")
+		require.ErrorContains(t, err, "this server only provides read-only access")
+		assert.Equal(t, []any{"initial"}, QueryRow(t, source, "SELECT message FROM dolt_log LIMIT 1;"))
+	})
+
+	t.Run("tls", func(t *testing.T) {
+		dir := t.TempDir()
+		ca := testcerts.NewCA()
+		keyPair, err := ca.NewKeyPair("localhost")
+		require.NoError(t, err)
+		certFile := filepath.Join(dir, "cert.pem")
+		keyFile := filepath.Join(dir, "key.pem")
+		require.NoError(t, keyPair.ToFile(certFile, keyFile))
+
+		_, err = TryStartServer(t, serverConfig(&servercfg.DoltgresRemotesapiConfig{
+			Port:   ptr(GetUnusedPort(t)),
+			TLSKey: &keyFile,
+		}))
+		require.ErrorContains(t, err, "requires both tls_key and tls_cert to be set")
+
+		port := GetUnusedPort(t)
+		StartServer(t, serverConfig(&servercfg.DoltgresRemotesapiConfig{
+			Port:    ptr(port),
+			Host:    ptr("127.0.0.1"),
+			TLSKey:  &keyFile,
+			TLSCert: &certFile,
+		}))
+		roots := x509.NewCertPool()
+		require.True(t, roots.AppendCertsFromPEM(ca.PublicKey()))
+		conn, err := tls.Dial("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)), &tls.Config{
+			RootCAs:    roots,
+			ServerName: "localhost",
+		})
+		require.NoError(t, err)
+		require.NoError(t, conn.Close())
+	})
+}
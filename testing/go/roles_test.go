@@ -0,0 +1,592 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestRoles(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "CREATE ROLE, ALTER ROLE, and DROP ROLE",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE ROLE alice LOGIN CREATEDB PASSWORD 'secret';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE USER bob CONNECTION LIMIT 3;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE ROLE carol;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "CREATE ROLE alice;",
+					ExpectedErr: `role "alice" already exists`,
+				},
+				{
+					Query:    "CREATE ROLE IF NOT EXISTS alice;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "CREATE ROLE pg_custom;",
+					ExpectedErr: `role name "pg_custom" is reserved`,
+				},
+				{
+					Query:       "CREATE ROLE dave LOGIN NOLOGIN;",
+					ExpectedErr: "conflicting or redundant options",
+				},
+				{
+					Query: "SELECT rolname, rolsuper, rolcreatedb, rolcanlogin, rolconnlimit, rolpassword FROM pg_roles WHERE rolname IN ('alice', 'bob', 'carol') ORDER BY rolname;",
+					Expected: []sql.Row{
+						{"alice", "f", "t", "t", -1, "********"},
+						{"bob", "f", "f", "t", 3, nil},
+						{"carol", "f", "f", "f", -1, nil},
+					},
+				},
+				{
+					Query:    "SELECT rolname, rolsuper FROM pg_catalog.pg_roles WHERE rolname = 'postgres';",
+					Expected: []sql.Row{{"postgres", "t"}},
+				},
+				{
+					Query:    "ALTER ROLE carol LOGIN SUPERUSER;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER USER bob RENAME TO robert;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query: "SELECT rolname, rolsuper, rolcanlogin FROM pg_roles WHERE rolname IN ('bob', 'carol', 'robert') ORDER BY rolname;",
+					Expected: []sql.Row{
+						{"carol", "t", "t"},
+						{"robert", "f", "t"},
+					},
+				},
+				{
+					Query:       "ALTER ROLE nobody LOGIN;",
+					ExpectedErr: `role "nobody" does not exist`,
+				},
+				{
+					Query:    "DROP ROLE robert, carol;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "DROP ROLE robert;",
+					ExpectedErr: `role "robert" does not exist`,
+				},
+				{
+					Query:    "DROP ROLE IF EXISTS robert;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "DROP ROLE postgres;",
+					ExpectedErr: "current user cannot be dropped",
+				},
+				{
+					Query:    "SELECT rolname FROM pg_roles WHERE rolname IN ('alice', 'robert', 'carol');",
+					Expected: []sql.Row{{"alice"}},
+				},
+			},
+		},
+		{
+			Name: "GRANT and REVOKE on tables",
+			SetUpScript: []string{
+				"CREATE TABLE t1 (pk INT PRIMARY KEY, v INT);",
+				"INSERT INTO t1 VALUES (1, 1);",
+				"CREATE TABLE t2 (pk INT PRIMARY KEY);",
+				"CREATE ROLE alice LOGIN;",
+				"CREATE ROLE bob LOGIN;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "SELECT * FROM t1;",
+					Username:    "alice",
+					ExpectedErr: "permission denied for table t1",
+				},
+				{
+					Query:    "GRANT SELECT ON t1 TO alice;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "GRANT INSERT ON TABLE t1 TO alice WITH GRANT OPTION;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM t1;",
+					Username: "alice",
+					Expected: []sql.Row{{1, 1}},
+				},
+				{
+					Query:    "INSERT INTO t1 VALUES (2, 2);",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "UPDATE t1 SET v = 3;",
+					Username:    "alice",
+					ExpectedErr: "permission denied for table t1",
+				},
+				{
+					Query:       "DELETE FROM t1;",
+					Username:    "alice",
+					ExpectedErr: "permission denied for table t1",
+				},
+				{
+					Query:       "SELECT * FROM t2;",
+					Username:    "alice",
+					ExpectedErr: "permission denied for table t2",
+				},
+				{
+					Query:       "DROP TABLE t1;",
+					Username:    "alice",
+					ExpectedErr: "must be owner of table t1",
+				},
+				{
+					Query:       "ALTER TABLE t1 ADD COLUMN v2 INT;",
+					Username:    "alice",
+					ExpectedErr: "must be owner of table t1",
+				},
+				{
+					Query:       "CREATE TABLE t3 (pk INT PRIMARY KEY);",
+					Username:    "alice",
+					ExpectedErr: "permission denied for schema public",
+				},
+				{
+					Query:    "GRANT INSERT, SELECT ON t1 TO bob;",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT grantor, grantee, table_schema, table_name, privilege_type, is_grantable FROM information_schema.table_privileges ORDER BY grantee, privilege_type;",
+					Username: "alice",
+					Expected: []sql.Row{
+						{"postgres", "alice", "public", "t1", "INSERT", "YES"},
+						{"postgres", "alice", "public", "t1", "SELECT", "NO"},
+						{"alice", "bob", "public", "t1", "INSERT", "NO"},
+					},
+				},
+				{
+					Query:    "INSERT INTO t1 VALUES (3, 3);",
+					Username: "bob",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "DROP ROLE alice;",
+					ExpectedErr: `role "alice" cannot be dropped because some objects depend on it`,
+				},
+				{
+					Query:       "REVOKE INSERT ON t1 FROM alice;",
+					ExpectedErr: "dependent privileges exist",
+				},
+				{
+					Query:    "REVOKE INSERT ON t1 FROM alice CASCADE;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "INSERT INTO t1 VALUES (4, 4);",
+					Username:    "bob",
+					ExpectedErr: "permission denied for table t1",
+				},
+				{
+					Query:    "REVOKE ALL ON t1 FROM alice;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT * FROM t1;",
+					Username:    "alice",
+					ExpectedErr: "permission denied for table t1",
+				},
+				{
+					Query:    "DROP ROLE alice;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "GRANT SELECT ON ALL TABLES IN SCHEMA public TO PUBLIC;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM t2;",
+					Username: "bob",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "GRANT SELECT ON missing TO bob;",
+					ExpectedErr: `relation "missing" does not exist`,
+				},
+				{
+					Query:       "GRANT USAGE ON t1 TO bob;",
+					ExpectedErr: "invalid privilege type USAGE for table",
+				},
+				{
+					Query:       "GRANT SELECT ON t1 TO nobody;",
+					ExpectedErr: `role "nobody" does not exist`,
+				},
+			},
+		},
+		{
+			Name: "GRANT and REVOKE on schemas and databases",
+			SetUpScript: []string{
+				"CREATE SCHEMA app;",
+				"CREATE TABLE app.items (pk INT PRIMARY KEY);",
+				"CREATE ROLE alice LOGIN CREATEDB;",
+				"GRANT SELECT ON app.items TO alice;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "SELECT * FROM app.items;",
+					Username:    "alice",
+					ExpectedErr: "permission denied for schema app",
+				},
+				{
+					Query:    "GRANT USAGE, CREATE ON SCHEMA app TO alice;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM app.items;",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE TABLE app.owned (pk INT PRIMARY KEY);",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO app.owned VALUES (1);",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER TABLE app.owned RENAME TO renamed;",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM app.renamed;",
+					Username: "alice",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:       "CREATE SCHEMA other;",
+					Username:    "alice",
+					ExpectedErr: "permission denied for database postgres",
+				},
+				{
+					Query:    "GRANT CREATE ON DATABASE postgres TO alice;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE SCHEMA other;",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE DATABASE alicedb;",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "DROP ROLE alice;",
+					ExpectedErr: `role "alice" cannot be dropped because some objects depend on it`,
+				},
+				{
+					Query:    "REVOKE USAGE ON SCHEMA app FROM alice;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT * FROM app.items;",
+					Username:    "alice",
+					ExpectedErr: "permission denied for schema app",
+				},
+			},
+		},
+	})
+}
+
+func TestRoleMembership(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "GRANT and REVOKE role membership",
+			SetUpScript: []string{
+				"CREATE TABLE t1 (pk INT PRIMARY KEY);",
+				"INSERT INTO t1 VALUES (1);",
+				"CREATE ROLE readers;",
+				"CREATE ROLE alice LOGIN;",
+				"CREATE ROLE bob LOGIN NOINHERIT;",
+				"GRANT SELECT ON t1 TO readers;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "SELECT * FROM t1;",
+					Username:    "alice",
+					ExpectedErr: "permission denied for table t1",
+				},
+				{
+					Query:    "GRANT readers TO alice, bob;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM t1;",
+					Username: "alice",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:       "SELECT * FROM t1;",
+					Username:    "bob",
+					ExpectedErr: "permission denied for table t1",
+				},
+				{
+					Query: "SELECT r.rolname, m.rolname, g.rolname, a.admin_option, a.inherit_option, a.set_option FROM pg_auth_members a " +
+						"JOIN pg_roles r ON a.roleid = r.oid JOIN pg_roles m ON a.member = m.oid JOIN pg_roles g ON a.grantor = g.oid ORDER BY m.rolname;",
+					Expected: []sql.Row{
+						{"readers", "alice", "postgres", "f", "t", "t"},
+						{"readers", "bob", "postgres", "f", "f", "t"},
+					},
+				},
+				{
+					Query:    "SELECT pg_has_role('alice', 'readers', 'MEMBER'), pg_has_role('alice', 'readers', 'USAGE'), pg_has_role('bob', 'readers', 'USAGE');",
+					Expected: []sql.Row{{"t", "t", "f"}},
+				},
+				{
+					Query:    "SELECT pg_has_role('readers', 'member');",
+					Username: "bob",
+					Expected: []sql.Row{{"t"}},
+				},
+				{
+					Query:       "GRANT alice TO readers;",
+					ExpectedErr: `role "alice" is a member of role "readers"`,
+				},
+				{
+					Query:       "GRANT readers TO readers;",
+					ExpectedErr: `role "readers" is a member of role "readers"`,
+				},
+				{
+					Query:       "GRANT readers TO bob;",
+					Username:    "alice",
+					ExpectedErr: `permission denied to grant role "readers"`,
+				},
+				{
+					Query:       "GRANT postgres TO alice;",
+					Username:    "alice",
+					ExpectedErr: `permission denied to grant role "postgres"`,
+				},
+				{
+					Query:    "GRANT readers TO bob WITH INHERIT TRUE;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM t1;",
+					Username: "bob",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "REVOKE readers FROM alice;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT * FROM t1;",
+					Username:    "alice",
+					ExpectedErr: "permission denied for table t1",
+				},
+				{
+					Query:    "REVOKE readers FROM alice;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "GRANT readers TO nobody;",
+					ExpectedErr: `role "nobody" does not exist`,
+				},
+				{
+					Query:       "GRANT nobody TO alice;",
+					ExpectedErr: `role "nobody" does not exist`,
+				},
+				{
+					Query:       "DROP ROLE readers;",
+					ExpectedErr: `role "readers" cannot be dropped because some objects depend on it`,
+				},
+				{
+					Query:    "REVOKE ALL ON t1 FROM readers;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DROP ROLE readers;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT count(*) FROM pg_auth_members;",
+					Expected: []sql.Row{{0}},
+				},
+			},
+		},
+		{
+			Name: "ADMIN OPTION",
+			SetUpScript: []string{
+				"CREATE TABLE t1 (pk INT PRIMARY KEY);",
+				"CREATE ROLE writers;",
+				"CREATE ROLE lead LOGIN;",
+				"CREATE ROLE alice LOGIN;",
+				"GRANT INSERT ON t1 TO writers;",
+				"GRANT writers TO lead WITH ADMIN OPTION;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "GRANT writers TO alice;",
+					Username: "lead",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO t1 VALUES (1);",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "REVOKE ADMIN OPTION FOR writers FROM lead;",
+					ExpectedErr: "dependent privileges exist",
+				},
+				{
+					Query:    "REVOKE ADMIN OPTION FOR writers FROM lead CASCADE;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "INSERT INTO t1 VALUES (2);",
+					Username:    "alice",
+					ExpectedErr: "permission denied for table t1",
+				},
+				{
+					Query:    "INSERT INTO t1 VALUES (2);",
+					Username: "lead",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "GRANT writers TO alice;",
+					Username:    "lead",
+					ExpectedErr: `permission denied to grant role "writers"`,
+				},
+				{
+					Query:    "CREATE ROLE creator LOGIN CREATEROLE;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE ROLE team;",
+					Username: "creator",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "GRANT team TO alice;",
+					Username: "creator",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT pg_has_role('creator', 'team', 'MEMBER WITH ADMIN OPTION'), pg_has_role('creator', 'team', 'USAGE');",
+					Expected: []sql.Row{{"t", "f"}},
+				},
+			},
+		},
+		{
+			Name: "ALTER DEFAULT PRIVILEGES",
+			SetUpScript: []string{
+				"CREATE SCHEMA app;",
+				"CREATE ROLE owner LOGIN;",
+				"CREATE ROLE tenant LOGIN;",
+				"CREATE ROLE auditor LOGIN;",
+				"GRANT USAGE, CREATE ON SCHEMA app TO owner;",
+				"GRANT USAGE ON SCHEMA app TO tenant, auditor;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "ALTER DEFAULT PRIVILEGES IN SCHEMA app GRANT SELECT, INSERT ON TABLES TO tenant;",
+					Username: "owner",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER DEFAULT PRIVILEGES FOR ROLE owner GRANT SELECT ON TABLES TO auditor;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE TABLE app.items (pk INT PRIMARY KEY);",
+					Username: "owner",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO app.items VALUES (1);",
+					Username: "tenant",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM app.items;",
+					Username: "auditor",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:       "INSERT INTO app.items VALUES (2);",
+					Username:    "auditor",
+					ExpectedErr: "permission denied for table items",
+				},
+				{
+					Query:    "CREATE TABLE app.unrelated (pk INT PRIMARY KEY);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT * FROM app.unrelated;",
+					Username:    "tenant",
+					ExpectedErr: "permission denied for table unrelated",
+				},
+				{
+					Query:       "ALTER DEFAULT PRIVILEGES FOR ROLE owner GRANT SELECT ON TABLES TO tenant;",
+					Username:    "tenant",
+					ExpectedErr: "permission denied to change default privileges",
+				},
+				{
+					Query:       "DROP ROLE auditor;",
+					ExpectedErr: `role "auditor" cannot be dropped because some objects depend on it`,
+				},
+				{
+					Query:    "ALTER DEFAULT PRIVILEGES IN SCHEMA app REVOKE INSERT ON TABLES FROM tenant;",
+					Username: "owner",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE TABLE app.extra (pk INT PRIMARY KEY);",
+					Username: "owner",
+					Expected: []sql.Row{},
+				},
+				{
+					Query: "SELECT grantor, grantee, table_name, privilege_type FROM information_schema.table_privileges WHERE grantor = 'owner' AND grantee <> 'owner' ORDER BY table_name, grantee, privilege_type;",
+					Expected: []sql.Row{
+						{"owner", "auditor", "extra", "SELECT"},
+						{"owner", "tenant", "extra", "SELECT"},
+						{"owner", "auditor", "items", "SELECT"},
+						{"owner", "tenant", "items", "INSERT"},
+						{"owner", "tenant", "items", "SELECT"},
+					},
+				},
+				{
+					Query:       "ALTER DEFAULT PRIVILEGES IN SCHEMA missing GRANT SELECT ON TABLES TO tenant;",
+					ExpectedErr: `schema "missing" does not exist`,
+				},
+				{
+					Query:       "ALTER DEFAULT PRIVILEGES GRANT SELECT ON SEQUENCES TO tenant;",
+					ExpectedErr: "not yet supported",
+				},
+			},
+		},
+	})
+}
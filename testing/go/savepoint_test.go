@@ -0,0 +1,304 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestSavepoints(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "partial rollback of data",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (1);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SAVEPOINT s1;",
+					ExpectedTag: "SAVEPOINT",
+				},
+				{
+					Query:    "INSERT INTO test VALUES (2);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SAVEPOINT s2;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (3);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "ROLLBACK TO SAVEPOINT s2;",
+					ExpectedTag: "ROLLBACK",
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1}, {2}},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (4);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ROLLBACK TO s1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:       "ROLLBACK TO s2;",
+					ExpectedErr: `savepoint "s2" does not exist`,
+				},
+				{
+					Query:    "INSERT INTO test VALUES (5);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "RELEASE SAVEPOINT s1;",
+					ExpectedTag: "RELEASE",
+				},
+				{
+					Query:       "ROLLBACK TO s1;",
+					ExpectedErr: `savepoint "s1" does not exist`,
+				},
+				{
+					Query:    "COMMIT;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1}, {5}},
+				},
+			},
+		},
+		{
+			Name: "savepoints with the same name",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SAVEPOINT sp;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (1);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SAVEPOINT sp;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (2);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SAVEPOINT inner_sp;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (3);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "RELEASE sp;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "RELEASE inner_sp;",
+					ExpectedErr: `savepoint "inner_sp" does not exist`,
+				},
+				{
+					Query:    "ROLLBACK TO sp;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ROLLBACK;",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "savepoints outside of transaction blocks",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "SAVEPOINT s1;",
+					ExpectedErr: "SAVEPOINT can only be used in transaction blocks",
+				},
+				{
+					Query:       "ROLLBACK TO SAVEPOINT s1;",
+					ExpectedErr: "ROLLBACK TO SAVEPOINT can only be used in transaction blocks",
+				},
+				{
+					Query:       "RELEASE SAVEPOINT s1;",
+					ExpectedErr: "RELEASE SAVEPOINT can only be used in transaction blocks",
+				},
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SAVEPOINT s1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "COMMIT;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "ROLLBACK TO SAVEPOINT s1;",
+					ExpectedErr: `savepoint "s1" does not exist`,
+				},
+				{
+					Query:    "COMMIT;",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "partial rollback of session state",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY);",
+				"INSERT INTO test VALUES (1), (2);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SET datestyle = 'German';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DECLARE c1 CURSOR FOR SELECT pk FROM test ORDER BY pk;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SAVEPOINT s1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SET search_path = 'other';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SET datestyle = 'SQL, DMY';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DECLARE c2 CURSOR FOR SELECT pk FROM public.test ORDER BY pk;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "FETCH c1;",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "ROLLBACK TO SAVEPOINT s1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW search_path;",
+					Expected: []sql.Row{{`"$user", public`}},
+				},
+				{
+					Query:    "SHOW datestyle;",
+					Expected: []sql.Row{{"German"}},
+				},
+				{
+					Query:    "FETCH c1;",
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:       "FETCH c2;",
+					ExpectedErr: `cursor "c2" does not exist`,
+				},
+				{
+					Query:    "SET search_path = 'other';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "RELEASE SAVEPOINT s1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW search_path;",
+					Expected: []sql.Row{{"other"}},
+				},
+				{
+					Query:    "ROLLBACK;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW search_path;",
+					Expected: []sql.Row{{`"$user", public`}},
+				},
+				{
+					Query:    "SHOW datestyle;",
+					Expected: []sql.Row{{"ISO, MDY"}},
+				},
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SAVEPOINT s1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SET datestyle = 'German';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "COMMIT;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW datestyle;",
+					Expected: []sql.Row{{"German"}},
+				},
+			},
+		},
+	})
+}
@@ -228,6 +228,10 @@ var SchemaTests = []ScriptTest{
 			"set search_path to ''",
 		},
 		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SHOW search_path;",
+				Expected: []sql.Row{{`""`}},
+			},
 			{
 				Query: "INSERT INTO public.test VALUES (1, 1);",
 			},
@@ -541,6 +541,145 @@ func TestSequences(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "ALTER SEQUENCE",
+			SetUpScript: []string{
+				"CREATE SEQUENCE test START 5 INCREMENT 2;",
+				"CREATE TABLE owner_table (pk INT8 PRIMARY KEY, v1 INT8 DEFAULT (nextval('test')));",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT nextval('test');",
+					Expected: []sql.Row{{5}},
+				},
+				{
+					Query:    "ALTER SEQUENCE test INCREMENT BY 10;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT nextval('test');",
+					Expected: []sql.Row{{7}},
+				},
+				{
+					Query:    "SELECT nextval('test');",
+					Expected: []sql.Row{{17}},
+				},
+				{
+					Query:    "ALTER SEQUENCE test RESTART;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT nextval('test');",
+					Expected: []sql.Row{{5}},
+				},
+				{
+					Query:    "ALTER SEQUENCE test RESTART WITH 100 MAXVALUE 110 CYCLE;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT nextval('test'), nextval('test');",
+					Expected: []sql.Row{{100, 110}},
+				},
+				{
+					Query:    "SELECT nextval('test');",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:       "ALTER SEQUENCE test MINVALUE 200;",
+					ExpectedErr: "must be less than MAXVALUE",
+				},
+				{
+					Query:       "ALTER SEQUENCE test CACHE 0;",
+					ExpectedErr: "CACHE (0) must be greater than zero",
+				},
+				{
+					Query:       "ALTER SEQUENCE test INCREMENT 1 INCREMENT 2;",
+					ExpectedErr: "conflicting or redundant options",
+				},
+				{
+					Query:       "ALTER SEQUENCE test OWNED BY owner_table.v2;",
+					ExpectedErr: `column "v2" of relation "owner_table" does not exist`,
+				},
+				{
+					Query:    "ALTER SEQUENCE test OWNED BY owner_table.v1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "DROP SEQUENCE test;",
+					ExpectedErr: "other objects depend on it",
+				},
+				{
+					Query:    "ALTER SEQUENCE test OWNED BY NONE;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "ALTER SEQUENCE missing RESTART;",
+					ExpectedErr: `relation "missing" does not exist`,
+				},
+				{
+					Query:    "ALTER SEQUENCE IF EXISTS missing RESTART;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "ALTER SEQUENCE owner_table RESTART;",
+					ExpectedErr: `"owner_table" is not a sequence`,
+				},
+			},
+		},
+		{
+			Name: "DROP SEQUENCE with multiple names",
+			SetUpScript: []string{
+				"CREATE SEQUENCE test1;",
+				"CREATE SEQUENCE test2;",
+				"CREATE SEQUENCE test3;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "DROP SEQUENCE test1, missing, test2;",
+					ExpectedErr: `sequence "missing" does not exist`,
+				},
+				{
+					Query:    "SELECT nextval('test1');",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "DROP SEQUENCE IF EXISTS test1, missing, test2;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT nextval('test2');",
+					ExpectedErr: "does not exist",
+				},
+				{
+					Query:    "SELECT nextval('test3');",
+					Expected: []sql.Row{{1}},
+				},
+			},
+		},
+		{
+			Name: "pg_sequences",
+			SetUpScript: []string{
+				"CREATE SEQUENCE test1;",
+				"CREATE SEQUENCE test2 AS SMALLINT START 10 INCREMENT -2 MINVALUE 0 MAXVALUE 20 CYCLE CACHE 5;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query: "SELECT schemaname, sequencename, data_type, start_value, min_value, max_value, increment_by, cycle, cache_size, last_value FROM pg_sequences;",
+					Expected: []sql.Row{
+						{"public", "test1", "bigint", 1, 1, 9223372036854775807, 1, "f", 1, nil},
+						{"public", "test2", "smallint", 10, 0, 20, -2, "t", 5, nil},
+					},
+				},
+				{
+					Query:    "SELECT nextval('test1'), nextval('test1'), nextval('test2');",
+					Expected: []sql.Row{{1, 2, 10}},
+				},
+				{
+					Query:    "SELECT sequencename, last_value FROM pg_sequences;",
+					Expected: []sql.Row{{"test1", 2}, {"test2", 10}},
+				},
+			},
+		},
 		{
 			Name: "SERIAL",
 			Assertions: []ScriptTestAssertion{
@@ -627,5 +766,55 @@ func TestSequences(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "Sequences require ownership",
+			SetUpScript: []string{
+				"CREATE SEQUENCE seq1;",
+				"CREATE TABLE test (pk SERIAL PRIMARY KEY, v1 INT4);",
+				"CREATE ROLE alice LOGIN;",
+				"GRANT CREATE ON SCHEMA public TO alice;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "ALTER SEQUENCE seq1 INCREMENT BY 2;",
+					Username:    "alice",
+					ExpectedErr: "must be owner of sequence seq1",
+				},
+				{
+					Query:       "DROP SEQUENCE seq1;",
+					Username:    "alice",
+					ExpectedErr: "must be owner of sequence seq1",
+				},
+				{
+					Query:       "ALTER SEQUENCE test_pk_seq RESTART;",
+					Username:    "alice",
+					ExpectedErr: "must be owner of sequence test_pk_seq",
+				},
+				{
+					Query:    "CREATE SEQUENCE seq2;",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ALTER SEQUENCE seq2 INCREMENT BY 2;",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DROP SEQUENCE seq2;",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "REVOKE CREATE ON SCHEMA public FROM alice;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "CREATE SEQUENCE seq3;",
+					Username:    "alice",
+					ExpectedErr: "permission denied for schema public",
+				},
+			},
+		},
 	})
 }
@@ -0,0 +1,68 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dserver "github.com/dolthub/doltgresql/server"
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+func TestServerVersion(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "default server version",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SHOW server_version;",
+					Expected: []sql.Row{{"15.0"}},
+				},
+				{
+					Query:    "SHOW server_version_num;",
+					Expected: []sql.Row{{150000}},
+				},
+				{
+					Query:    "SELECT doltgres_version();",
+					Expected: []sql.Row{{dserver.Version}},
+				},
+			},
+		},
+	})
+}
+
+func TestConfiguredServerVersion(t *testing.T) {
+	srv := StartServer(t, &servercfg.DoltgresConfig{
+		BehaviorConfig: &servercfg.DoltgresBehaviorConfig{
+			InMemory:      ptr(true),
+			ServerVersion: ptr("15.4"),
+		},
+	})
+	conn := Connect(t, srv, "")
+
+	ctx := context.Background()
+	assert.Equal(t, "15.4", conn.PgConn().ParameterStatus("server_version"))
+	var version string
+	var versionNum int64
+	require.NoError(t, conn.QueryRow(ctx, "SHOW server_version;").Scan(&version))
+	require.NoError(t, conn.QueryRow(ctx, "SHOW server_version_num;").Scan(&versionNum))
+	assert.Equal(t, "15.4", version)
+	assert.Equal(t, int64(150004), versionNum)
+}
@@ -0,0 +1,445 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+func TestSessionSettings(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "SET LOCAL lasts until the end of the transaction",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SET LOCAL datestyle = 'German';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW datestyle;",
+					Expected: []sql.Row{{"ISO, MDY"}},
+				},
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SET LOCAL datestyle = 'German';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW datestyle;",
+					Expected: []sql.Row{{"German"}},
+				},
+				{
+					Query:    "COMMIT;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW datestyle;",
+					Expected: []sql.Row{{"ISO, MDY"}},
+				},
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SET LOCAL datestyle = 'German';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ROLLBACK;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW datestyle;",
+					Expected: []sql.Row{{"ISO, MDY"}},
+				},
+			},
+		},
+		{
+			Name: "SET LOCAL and SET within the same transaction",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SET datestyle = 'SQL, DMY';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SET LOCAL datestyle = 'German';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW datestyle;",
+					Expected: []sql.Row{{"German"}},
+				},
+				{
+					Query:    "COMMIT;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW datestyle;",
+					Expected: []sql.Row{{"SQL, DMY"}},
+				},
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SET LOCAL datestyle = 'German';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SET datestyle = 'ISO, DMY';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "COMMIT;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW datestyle;",
+					Expected: []sql.Row{{"ISO, DMY"}},
+				},
+			},
+		},
+		{
+			Name: "SET LOCAL with savepoints",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SET LOCAL work_mem = 1024;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SAVEPOINT sp;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SET work_mem = 2048;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "ROLLBACK TO SAVEPOINT sp;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW work_mem;",
+					Expected: []sql.Row{{int64(1024)}},
+				},
+				{
+					Query:    "COMMIT;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW work_mem;",
+					Expected: []sql.Row{{int64(4096)}},
+				},
+			},
+		},
+		{
+			Name: "RESET ALL",
+			SetUpScript: []string{
+				"SET datestyle = 'German';",
+				"SET work_mem = '64MB';",
+				"SET enable_seqscan = off;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "RESET ALL;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW datestyle;",
+					Expected: []sql.Row{{"ISO, MDY"}},
+				},
+				{
+					Query:    "SHOW work_mem;",
+					Expected: []sql.Row{{int64(4096)}},
+				},
+				{
+					Query:    "SHOW enable_seqscan;",
+					Expected: []sql.Row{{int8(1)}},
+				},
+				{
+					Query:    "ROLLBACK;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW datestyle;",
+					Expected: []sql.Row{{"German"}},
+				},
+				{
+					Query:    "SHOW work_mem;",
+					Expected: []sql.Row{{int64(65536)}},
+				},
+				{
+					Query:    "SHOW enable_seqscan;",
+					Expected: []sql.Row{{int8(0)}},
+				},
+				{
+					Query:    "RESET ALL;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW datestyle;",
+					Expected: []sql.Row{{"ISO, MDY"}},
+				},
+				{
+					Query:    "SHOW work_mem;",
+					Expected: []sql.Row{{int64(4096)}},
+				},
+				{
+					Query:    "SHOW enable_seqscan;",
+					Expected: []sql.Row{{int8(1)}},
+				},
+			},
+		},
+		{
+			Name: "values with units",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SET statement_timeout = '5s';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW statement_timeout;",
+					Expected: []sql.Row{{int64(5000)}},
+				},
+				{
+					Query:    "SET statement_timeout = '1min';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW statement_timeout;",
+					Expected: []sql.Row{{int64(60000)}},
+				},
+				{
+					Query:    "SET statement_timeout = '250';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW statement_timeout;",
+					Expected: []sql.Row{{int64(250)}},
+				},
+				{
+					Query:    "SET work_mem = '1GB';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW work_mem;",
+					Expected: []sql.Row{{int64(1048576)}},
+				},
+				{
+					Query:       "SET work_mem = '64XB';",
+					ExpectedErr: `invalid value for parameter "work_mem": "64XB"`,
+				},
+				{
+					Query:       "SET statement_timeout = '5MB';",
+					ExpectedErr: `invalid value for parameter "statement_timeout": "5MB"`,
+				},
+			},
+		},
+		{
+			Name: "boolean spellings",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SET enable_seqscan = no;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW enable_seqscan;",
+					Expected: []sql.Row{{int8(0)}},
+				},
+				{
+					Query:    "SET enable_seqscan = 'yes';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW enable_seqscan;",
+					Expected: []sql.Row{{int8(1)}},
+				},
+				{
+					Query:    "SET enable_seqscan = 'f';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT current_setting('enable_seqscan');",
+					Expected: []sql.Row{{"off"}},
+				},
+			},
+		},
+		{
+			Name: "customized parameters",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SET myapp.user_id = '42';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW myapp.user_id;",
+					Expected: []sql.Row{{"42"}},
+				},
+				{
+					Query:    "SELECT current_setting('myapp.user_id');",
+					Expected: []sql.Row{{"42"}},
+				},
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SET LOCAL myapp.user_id = '7';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT current_setting('myapp.user_id');",
+					Expected: []sql.Row{{"7"}},
+				},
+				{
+					Query:    "COMMIT;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW myapp.user_id;",
+					Expected: []sql.Row{{"42"}},
+				},
+				{
+					Query:    "RESET myapp.user_id;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW myapp.user_id;",
+					Expected: []sql.Row{{""}},
+				},
+			},
+		},
+		{
+			Name: "unrecognized parameters",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "SET not_a_parameter = 1;",
+					ExpectedErr: `unrecognized configuration parameter "not_a_parameter"`,
+				},
+				{
+					Query:       "SHOW not_a_parameter;",
+					ExpectedErr: `unrecognized configuration parameter "not_a_parameter"`,
+				},
+				{
+					Query:       "SELECT current_setting('not_a_parameter');",
+					ExpectedErr: `unrecognized configuration parameter "not_a_parameter"`,
+				},
+				{
+					Query:    "SELECT current_setting('not_a_parameter', true);",
+					Expected: []sql.Row{{nil}},
+				},
+				{
+					Query:    "SELECT current_setting('DateStyle');",
+					Expected: []sql.Row{{"ISO, MDY"}},
+				},
+			},
+		},
+	})
+}
+
+func TestShowAll(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+
+	_, err := conn.Exec(ctx, "SET datestyle = 'German';")
+	require.NoError(t, err)
+	rows, err := conn.Query(ctx, "SHOW ALL;")
+	require.NoError(t, err)
+	settings := make(map[string]string)
+	var previous string
+	for rows.Next() {
+		var name, setting, description string
+		require.NoError(t, rows.Scan(&name, &setting, &description))
+		assert.NotEmpty(t, description)
+		// Postgres sorts the parameters without regard to case
+		assert.LessOrEqual(t, previous, strings.ToLower(name))
+		settings[name] = setting
+		previous = strings.ToLower(name)
+	}
+	require.NoError(t, rows.Err())
+	assert.Equal(t, "German", settings["DateStyle"])
+	assert.Equal(t, "on", settings["enable_seqscan"])
+	assert.Equal(t, "4096", settings["work_mem"])
+}
+
+func TestParameterDefaultsConfig(t *testing.T) {
+	srv := StartServer(t, &servercfg.DoltgresConfig{
+		BehaviorConfig: &servercfg.DoltgresBehaviorConfig{
+			InMemory: ptr(true),
+			Parameters: map[string]string{
+				"DateStyle":      "SQL, DMY",
+				"work_mem":       "64MB",
+				"enable_seqscan": "off",
+				"myapp.tenant":   "acme",
+			},
+		},
+	})
+	conn := Connect(t, srv, "doltgres")
+
+	ctx := context.Background()
+	for name, expected := range map[string]string{
+		"datestyle":      "SQL, DMY",
+		"work_mem":       "65536",
+		"enable_seqscan": "off",
+		"myapp.tenant":   "acme",
+	} {
+		var value string
+		require.NoError(t, conn.QueryRow(ctx, fmt.Sprintf("SELECT current_setting('%s');", name)).Scan(&value))
+		assert.Equal(t, expected, value, name)
+	}
+
+	// Sessions may override the configured defaults, and RESET returns to them
+	ExecQueries(t, conn, "SET datestyle = 'German';", "RESET ALL;")
+	var dateStyle string
+	require.NoError(t, conn.QueryRow(ctx, "SHOW datestyle;").Scan(&dateStyle))
+	assert.Equal(t, "SQL, DMY", dateStyle)
+
+	// Invalid defaults prevent the server from starting
+	_, err := TryStartServer(t, &servercfg.DoltgresConfig{
+		BehaviorConfig: &servercfg.DoltgresBehaviorConfig{
+			InMemory:   ptr(true),
+			Parameters: map[string]string{"not_a_parameter": "1"},
+		},
+	})
+	assert.ErrorContains(t, err, `unrecognized configuration parameter "not_a_parameter"`)
+}
@@ -4865,6 +4865,32 @@ var setStmts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name:        "set 'pg_hint_plan.enable_hint' configuration variable",
+		SetUpScript: []string{},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SHOW pg_hint_plan.enable_hint",
+				Expected: []sql.Row{{int8(0)}},
+			},
+			{
+				Query:    "SET pg_hint_plan.enable_hint TO 'on'",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SHOW pg_hint_plan.enable_hint",
+				Expected: []sql.Row{{int8(1)}},
+			},
+			{
+				Query:    "SET pg_hint_plan.enable_hint TO DEFAULT",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SHOW pg_hint_plan.enable_hint",
+				Expected: []sql.Row{{int8(0)}},
+			},
+		},
+	},
 	{
 		Name:        "set 'plan_cache_mode' configuration variable",
 		SetUpScript: []string{},
@@ -5417,10 +5443,10 @@ var setStmts = []ScriptTest{
 		Assertions: []ScriptTestAssertion{
 			{
 				Query:    "SHOW server_version",
-				Expected: []sql.Row{{"16.1 (Homebrew)"}},
+				Expected: []sql.Row{{"15.0"}},
 			},
 			{
-				Query:       "SET server_version TO '16.1 (Homebrew)'",
+				Query:       "SET server_version TO '15.0'",
 				ExpectedErr: "is a read only variable",
 			},
 		},
@@ -5431,10 +5457,10 @@ var setStmts = []ScriptTest{
 		Assertions: []ScriptTestAssertion{
 			{
 				Query:    "SHOW server_version_num",
-				Expected: []sql.Row{{int64(160001)}},
+				Expected: []sql.Row{{int64(150000)}},
 			},
 			{
-				Query:       "SET server_version_num TO '160001'",
+				Query:       "SET server_version_num TO '150000'",
 				ExpectedErr: "is a read only variable",
 			},
 		},
@@ -5806,8 +5832,20 @@ var setStmts = []ScriptTest{
 				Expected: []sql.Row{{int64(0)}},
 			},
 			{
-				Query:       "SET statement_timeout TO '0'",
-				ExpectedErr: "is a read only variable",
+				Query:    "SET statement_timeout TO 20",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SHOW statement_timeout",
+				Expected: []sql.Row{{int64(20)}},
+			},
+			{
+				Query:    "SET statement_timeout TO DEFAULT",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SHOW statement_timeout",
+				Expected: []sql.Row{{int64(0)}},
 			},
 		},
 	},
@@ -6465,6 +6503,10 @@ var setStmts = []ScriptTest{
 				Query:    "SHOW transaction_isolation",
 				Expected: []sql.Row{{"read committed"}},
 			},
+			{
+				Query:    "BEGIN",
+				Expected: []sql.Row{},
+			},
 			{
 				Query:    "SET transaction_isolation TO 'serializable'",
 				Expected: []sql.Row{},
@@ -6481,6 +6523,18 @@ var setStmts = []ScriptTest{
 				Query:    "SHOW transaction_isolation",
 				Expected: []sql.Row{{"read committed"}},
 			},
+			{
+				Query:    "SET transaction_isolation TO 'serializable'",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "COMMIT",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SHOW transaction_isolation",
+				Expected: []sql.Row{{"read committed"}},
+			},
 		},
 	},
 	{
@@ -6491,6 +6545,10 @@ var setStmts = []ScriptTest{
 				Query:    "SHOW transaction_read_only",
 				Expected: []sql.Row{{int8(0)}},
 			},
+			{
+				Query:    "BEGIN",
+				Expected: []sql.Row{},
+			},
 			{
 				Query:    "SET transaction_read_only TO 'on'",
 				Expected: []sql.Row{},
@@ -6507,6 +6565,18 @@ var setStmts = []ScriptTest{
 				Query:    "SHOW transaction_read_only",
 				Expected: []sql.Row{{int8(0)}},
 			},
+			{
+				Query:    "SET transaction_read_only TO 'on'",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "COMMIT",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SHOW transaction_read_only",
+				Expected: []sql.Row{{int8(0)}},
+			},
 		},
 	},
 	{
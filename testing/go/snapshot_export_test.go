@@ -0,0 +1,151 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/reader"
+
+	"github.com/dolthub/doltgresql/server/snapshotexport"
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+func TestSnapshotExport(t *testing.T) {
+	ctx := context.Background()
+	// startServer starts a server that exports the "exportdb" database in the given format, and returns a connection to
+	// that database.
+	startServer := func(t *testing.T, exportDir string, format string) *pgx.Conn {
+		srv := StartServer(t, &servercfg.DoltgresConfig{
+			BehaviorConfig: &servercfg.DoltgresBehaviorConfig{
+				InMemory: ptr(true),
+			},
+			SnapshotExport: &servercfg.DoltgresSnapshotExportConfig{
+				Destination: ptr(filepath.Join(exportDir, "{database}", "{branch}", "{commit}")),
+				Format:      ptr(format),
+				Interval:    ptr("50ms"),
+				Databases:   []string{"exportdb"},
+			},
+		})
+		ExecQueries(t, Connect(t, srv, ""), "CREATE DATABASE exportdb;")
+		return Connect(t, srv, "exportdb")
+	}
+	// readManifest waits for the manifest of the given commit to be written, and returns it.
+	readManifest := func(t *testing.T, snapshotDir string) snapshotexport.Manifest {
+		manifestPath := filepath.Join(snapshotDir, snapshotexport.ManifestFile)
+		require.Eventually(t, func() bool {
+			_, err := os.Stat(manifestPath)
+			return err == nil
+		}, 10*time.Second, 10*time.Millisecond)
+		data, err := os.ReadFile(manifestPath)
+		require.NoError(t, err)
+		var manifest snapshotexport.Manifest
+		require.NoError(t, json.Unmarshal(data, &manifest))
+		return manifest
+	}
+	// commit creates a commit using the given statements, returning the hash of the commit.
+	commit := func(t *testing.T, conn *pgx.Conn, statements ...string) string {
+		ExecQueries(t, conn, statements...)
+		var hash string
+		require.NoError(t, conn.QueryRow(ctx, "CALL dolt_commit('-Am', 'export');").Scan(&hash))
+		return hash
+	}
+
+	t.Run("CSV", func(t *testing.T) {
+		exportDir := t.TempDir()
+		conn := startServer(t, exportDir, "csv")
+		hash := commit(t, conn,
+			"CREATE TABLE items (id INT4 PRIMARY KEY, name TEXT, price NUMERIC(5,2), active BOOLEAN);",
+			"INSERT INTO items VALUES (1, 'apple', 1.50, true), (2, 'pear, green', NULL, false);",
+			"CREATE TABLE tags (tag TEXT);",
+			"INSERT INTO tags VALUES ('a'), ('a'), ('b');",
+		)
+
+		snapshotDir := filepath.Join(exportDir, "exportdb", "main", hash)
+		manifest := readManifest(t, snapshotDir)
+		assert.Equal(t, "exportdb", manifest.Database)
+		assert.Equal(t, "main", manifest.Branch)
+		assert.Equal(t, hash, manifest.Commit)
+		assert.Equal(t, snapshotexport.FormatCSV, manifest.Format)
+		require.Len(t, manifest.Tables, 2)
+		assert.Equal(t, "items", manifest.Tables[0].Name)
+		assert.Equal(t, "public", manifest.Tables[0].Schema)
+		assert.Equal(t, "public.items.csv", manifest.Tables[0].File)
+		assert.Equal(t, int64(2), manifest.Tables[0].Rows)
+		require.Len(t, manifest.Tables[0].Columns, 4)
+		assert.Equal(t, "price", manifest.Tables[0].Columns[2].Name)
+		assert.Equal(t, int64(3), manifest.Tables[1].Rows)
+
+		items, err := os.ReadFile(filepath.Join(snapshotDir, "public.items.csv"))
+		require.NoError(t, err)
+		assert.Equal(t, "id,name,price,active\n1,apple,1.5,true\n2,\"pear, green\",,false\n", string(items))
+		tags, err := os.ReadFile(filepath.Join(snapshotDir, "public.tags.csv"))
+		require.NoError(t, err)
+		// Keyless tables are not ordered by their values, but duplicate rows are each written
+		assert.ElementsMatch(t, []string{"tag", "a", "a", "b"}, strings.Fields(string(tags)))
+
+		// Uncommitted changes are not exported, while new commits and branches are
+		_, err = conn.Exec(ctx, "INSERT INTO items VALUES (3, 'plum', 2.00, true);")
+		require.NoError(t, err)
+		_, err = conn.Exec(ctx, "CALL dolt_branch('feature');")
+		require.NoError(t, err)
+		featureManifest := readManifest(t, filepath.Join(exportDir, "exportdb", "feature", hash))
+		assert.Equal(t, "feature", featureManifest.Branch)
+		newHash := commit(t, conn)
+		newManifest := readManifest(t, filepath.Join(exportDir, "exportdb", "main", newHash))
+		assert.Equal(t, int64(3), newManifest.Tables[0].Rows)
+		items, err = os.ReadFile(filepath.Join(snapshotDir, "public.items.csv"))
+		require.NoError(t, err)
+		assert.NotContains(t, string(items), "plum")
+	})
+
+	t.Run("Parquet", func(t *testing.T) {
+		exportDir := t.TempDir()
+		conn := startServer(t, exportDir, "parquet")
+		hash := commit(t, conn,
+			"CREATE TABLE readings (id INT8 PRIMARY KEY, sensor TEXT, value FLOAT8, ok BOOLEAN);",
+			"INSERT INTO readings VALUES (1, 'a', 1.25, true), (2, NULL, NULL, NULL);",
+		)
+
+		snapshotDir := filepath.Join(exportDir, "exportdb", "main", hash)
+		manifest := readManifest(t, snapshotDir)
+		assert.Equal(t, snapshotexport.FormatParquet, manifest.Format)
+		require.Len(t, manifest.Tables, 1)
+		assert.Equal(t, "public.readings.parquet", manifest.Tables[0].File)
+		assert.Equal(t, int64(2), manifest.Tables[0].Rows)
+
+		data, err := os.ReadFile(filepath.Join(snapshotDir, "public.readings.parquet"))
+		require.NoError(t, err)
+		file, err := buffer.NewBufferFile(data)
+		require.NoError(t, err)
+		parquetReader, err := reader.NewParquetReader(file, nil, 1)
+		require.NoError(t, err)
+		defer parquetReader.ReadStop()
+		assert.Equal(t, int64(2), parquetReader.GetNumRows())
+		values, _, _, err := parquetReader.ReadColumnByPath(parquetReader.SchemaHandler.GetRootExName()+"\x01value", 2)
+		require.NoError(t, err)
+		assert.Equal(t, []any{1.25, nil}, values)
+	})
+}
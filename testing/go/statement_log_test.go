@@ -0,0 +1,123 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+func TestStatementLog(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "logs", "doltgres.log")
+	srv := StartServer(t, &servercfg.DoltgresConfig{
+		BehaviorConfig: &servercfg.DoltgresBehaviorConfig{
+			InMemory: ptr(true),
+		},
+		Logging: &servercfg.DoltgresLoggingConfig{
+			Format:       ptr("json"),
+			File:         &logFile,
+			LogStatement: ptr("ddl"),
+		},
+	})
+	ExecQueries(t, Connect(t, srv, ""), "CREATE DATABASE logs;")
+	conn := Connect(t, srv, "logs")
+	// The process ID that the server reports to the client is the connection's ID
+	connectionID := conn.PgConn().PID()
+
+	// With log_statement set to ddl, only DDL statements are logged
+	for _, query := range []string{
+		"CREATE TABLE logged (pk INT PRIMARY KEY, v1 TEXT);",
+		"INSERT INTO logged VALUES (1, 'one');",
+		"SELECT * FROM logged;",
+		"SET log_statement = 'mod';",
+		"INSERT INTO logged VALUES (2, 'two');",
+		"SELECT v1 FROM logged WHERE pk = 2;",
+		"SET log_statement = 'none';",
+		"SET log_min_duration_statement = 0;",
+		"SELECT pk FROM logged ORDER BY pk;",
+	} {
+		ExecQueries(t, conn, query)
+	}
+	// Statements run through the extended protocol are logged using their portal
+	ctx := context.Background()
+	var v1 string
+	require.NoError(t, conn.QueryRow(ctx, "SELECT v1 FROM logged WHERE pk = $1;", 1).Scan(&v1))
+	require.Equal(t, "one", v1)
+	ExecQueries(t, conn, "SET log_min_duration_statement = -1;", "DROP TABLE logged;")
+	require.NoError(t, srv.Stop())
+
+	file, err := os.Open(logFile)
+	require.NoError(t, err)
+	defer file.Close()
+	var messages []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry), scanner.Text())
+		require.Contains(t, entry, "time")
+		require.Contains(t, entry, "level")
+		msg, _ := entry["msg"].(string)
+		if !strings.HasPrefix(msg, "statement: ") && !strings.HasPrefix(msg, "duration: ") {
+			continue
+		}
+		// Each statement is logged with the connection that ran it, so the statements of other connections, such as the
+		// one that created the database, are skipped
+		if entry["connection_id"] != float64(connectionID) {
+			continue
+		}
+		assert.Equal(t, "postgres", entry["user"], msg)
+		if strings.HasPrefix(msg, "duration: ") {
+			assert.Contains(t, entry, "duration_ms")
+			// Durations vary between runs, so only the statement is compared
+			msg = "duration: " + msg[strings.Index(msg, "  ")+2:]
+		}
+		messages = append(messages, msg)
+	}
+	require.NoError(t, scanner.Err())
+	assert.Equal(t, []string{
+		"statement: CREATE TABLE logged (pk INT PRIMARY KEY, v1 TEXT);",
+		"statement: INSERT INTO logged VALUES (2, 'two');",
+		"duration: statement: SELECT pk FROM logged ORDER BY pk;",
+		"duration: execute <unnamed>: SELECT v1 FROM logged WHERE pk = $1;",
+		"duration: statement: SET log_min_duration_statement = -1;",
+	}, messages)
+}
+
+func TestStatementLogConfig(t *testing.T) {
+	for _, logging := range []*servercfg.DoltgresLoggingConfig{
+		{Format: ptr("xml")},
+		{LogStatement: ptr("some")},
+		{LogMinDurationStatement: ptr(int64(-2))},
+		{File: ptr(filepath.Join(t.TempDir(), "doltgres.log")), MaxSizeMB: ptr(-1)},
+	} {
+		_, err := TryStartServer(t, &servercfg.DoltgresConfig{
+			BehaviorConfig: &servercfg.DoltgresBehaviorConfig{
+				InMemory: ptr(true),
+			},
+			Logging: logging,
+		})
+		require.Error(t, err)
+	}
+}
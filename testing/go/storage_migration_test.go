@@ -0,0 +1,76 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+// TestFeatureVersion7Migration opens a database that was written at feature version 7, before the tables that Dolt
+// creates without a schema (such as dolt_schemas, which holds the views) were stored within the public schema.
+func TestFeatureVersion7Migration(t *testing.T) {
+	dataDir := t.TempDir()
+	require.NoError(t, copyTestData(filepath.Join("testdata", "feature_version_7"), dataDir))
+	srv := StartServer(t, &servercfg.DoltgresConfig{
+		DataDirStr: ptr(dataDir),
+	})
+	conn := Connect(t, srv, "doltgres")
+
+	assert.Equal(t, [][]any{{int32(1), "one"}, {int32(2), "two"}, {int32(3), "three"}},
+		QueryRows(t, conn, "SELECT * FROM test ORDER BY pk;"))
+	assert.Equal(t, [][]any{{"two"}}, QueryRows(t, conn, "SELECT * FROM test_view;"))
+	assert.Equal(t, [][]any{{"test", int16(0), "modified"}},
+		QueryRows(t, conn, "SELECT table_name, staged, status FROM dolt_status;"))
+	assert.Equal(t, [][]any{{"fixture commit"}, {"Initialize data repository"}},
+		QueryRows(t, conn, "SELECT message FROM dolt_log;"))
+	// Writing the migrated root persists it at the current feature version, after which the view remains readable
+	ExecQueries(t, conn,
+		"SELECT dolt_commit('-am', 'migrated commit');",
+		"CREATE VIEW test_view2 AS SELECT v1 FROM test WHERE pk = 3;",
+	)
+	assert.Equal(t, [][]any{{"two"}}, QueryRows(t, conn, "SELECT * FROM test_view;"))
+	assert.Equal(t, [][]any{{"three"}}, QueryRows(t, conn, "SELECT * FROM test_view2;"))
+	assert.Equal(t, [][]any{{"test"}}, QueryRows(t, conn, "SELECT to_table_name FROM dolt_diff_summary('HEAD~1', 'HEAD');"))
+}
+
+// copyTestData copies the given directory of test data into the destination directory.
+func copyTestData(src string, dst string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		dstPath := filepath.Join(dst, relPath)
+		if d.IsDir() {
+			return os.MkdirAll(dstPath, 0755)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(dstPath, data, 0644)
+	})
+}
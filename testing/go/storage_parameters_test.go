@@ -0,0 +1,172 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestStorageParameters(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "Storage parameters on CREATE TABLE and ALTER TABLE",
+			SetUpScript: []string{
+				"CREATE TABLE plain (pk INT4 PRIMARY KEY);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE TABLE archive (pk INT4 PRIMARY KEY, v1 TEXT) WITH (compression = zstd, compression_level = 9);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO archive VALUES (1, 'a'), (2, 'b');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT doltgres_storage_parameters('archive'), doltgres_storage_parameters('public.archive'), doltgres_storage_parameters('plain');",
+					Expected: []sql.Row{{"{compression=zstd,compression_level=9}", "{compression=zstd,compression_level=9}", nil}},
+				},
+				{
+					Query:    "ALTER TABLE plain SET (compression = 'off');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT doltgres_storage_parameters('plain');",
+					Expected: []sql.Row{{"{compression=off}"}},
+				},
+				{
+					Query:    "ALTER TABLE archive RESET (compression_level);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT doltgres_storage_parameters('archive');",
+					Expected: []sql.Row{{"{compression=zstd}"}},
+				},
+				{
+					Query:    "ALTER TABLE archive SET (compression = ZSTD, compression_level = 19);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT doltgres_storage_parameters('archive');",
+					Expected: []sql.Row{{"{compression=zstd,compression_level=19}"}},
+				},
+				{
+					Query:    "SELECT * FROM archive ORDER BY pk;",
+					Expected: []sql.Row{{1, "a"}, {2, "b"}},
+				},
+				{
+					Query:    "DROP TABLE archive;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE TABLE archive (pk INT4 PRIMARY KEY);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT doltgres_storage_parameters('archive');",
+					Expected: []sql.Row{{nil}},
+				},
+			},
+		},
+		{
+			Name: "Storage parameters belong to a branch",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY);",
+				"CALL dolt_commit('-Am', 'initial');",
+				"CALL dolt_branch('other');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:            "CALL dolt_checkout('other');",
+					SkipResultsCheck: true,
+				},
+				{
+					Query:    "ALTER TABLE test SET (compression = zstd);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT doltgres_storage_parameters('test');",
+					Expected: []sql.Row{{"{compression=zstd}"}},
+				},
+				{
+					Query:            "CALL dolt_checkout('main');",
+					SkipResultsCheck: true,
+				},
+				{
+					Query:    "SELECT doltgres_storage_parameters('test');",
+					Expected: []sql.Row{{nil}},
+				},
+			},
+		},
+		{
+			Name: "Storage parameter errors",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "CREATE TABLE bad (pk INT4 PRIMARY KEY) WITH (fillfactor = 70);",
+					ExpectedErr: `unrecognized parameter "fillfactor"`,
+				},
+				{
+					Query:       "ALTER TABLE test SET (compression = lz4);",
+					ExpectedErr: `invalid value for enum option "compression": lz4`,
+				},
+				{
+					Query:       "ALTER TABLE test SET (compression = zstd, compression_level = 23);",
+					ExpectedErr: `value 23 out of bounds for option "compression_level"`,
+				},
+				{
+					Query:       "ALTER TABLE test SET (compression_level = 3);",
+					ExpectedErr: `"compression_level" may only be set when "compression" is zstd`,
+				},
+				{
+					Query:       "ALTER TABLE test RESET (autovacuum_enabled);",
+					ExpectedErr: `unrecognized parameter "autovacuum_enabled"`,
+				},
+				{
+					Query:       "ALTER TABLE missing SET (compression = off);",
+					ExpectedErr: `relation "missing" does not exist`,
+				},
+				{
+					Query:    "SELECT doltgres_storage_parameters('test');",
+					Expected: []sql.Row{{nil}},
+				},
+			},
+		},
+		{
+			Name: "Storage parameters require ownership of the table",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY);",
+				"CREATE ROLE alice LOGIN;",
+				"GRANT ALL ON test TO alice;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "ALTER TABLE test SET (compression = 'off');",
+					Username:    "alice",
+					ExpectedErr: "must be owner of table test",
+				},
+				{
+					Query:       "ALTER TABLE test RESET (compression);",
+					Username:    "alice",
+					ExpectedErr: "must be owner of table test",
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,167 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dserver "github.com/dolthub/doltgresql/server"
+	"github.com/dolthub/doltgresql/server/telemetry"
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+func TestTelemetry(t *testing.T) {
+	ctx := context.Background()
+	// startServer starts an in-memory server using the given telemetry config.
+	startServer := func(t *testing.T, cfg *servercfg.DoltgresTelemetryConfig) *dserver.Server {
+		return StartServer(t, &servercfg.DoltgresConfig{
+			BehaviorConfig: &servercfg.DoltgresBehaviorConfig{
+				InMemory: ptr(true),
+			},
+			Telemetry: cfg,
+		})
+	}
+	// useServer runs a workload that uses COPY, the extended query protocol, and causes an error. Three connections are
+	// made in total, with at most two open at the same time.
+	useServer := func(t *testing.T, srv *dserver.Server) {
+		conn := Connect(t, srv, "")
+		_, err := conn.Exec(ctx, "CREATE DATABASE telemetrydb;")
+		require.NoError(t, err)
+		require.NoError(t, conn.Close(ctx))
+		conn = Connect(t, srv, "telemetrydb")
+		defer conn.Close(ctx)
+		other := Connect(t, srv, "telemetrydb")
+		require.NoError(t, other.Close(ctx))
+		_, err = conn.Exec(ctx, "CREATE TABLE items (id INT4 PRIMARY KEY, name TEXT);")
+		require.NoError(t, err)
+		_, err = conn.PgConn().CopyFrom(ctx, strings.NewReader("1\tapple\n2\tpear\n"), "COPY items FROM STDIN;")
+		require.NoError(t, err)
+		_, err = conn.PgConn().CopyTo(ctx, io.Discard, "COPY items TO STDOUT;")
+		require.NoError(t, err)
+		var count int64
+		require.NoError(t, conn.QueryRow(ctx, "SELECT count(*) FROM items WHERE id > $1;", 0).Scan(&count))
+		assert.Equal(t, int64(2), count)
+		_, err = conn.Exec(ctx, "SELECT * FROM missing_table;", pgx.QueryExecModeSimpleProtocol)
+		require.Error(t, err)
+	}
+	// checkReport verifies that the report matches the usage of useServer.
+	checkReport := func(t *testing.T, report telemetry.Report) {
+		assert.Equal(t, dserver.Version, report.Version)
+		assert.NotEmpty(t, report.InstanceID)
+		// The server makes its own connection on startup to create the default database, so these are lower bounds
+		assert.GreaterOrEqual(t, report.Connections.Peak, int64(2))
+		assert.GreaterOrEqual(t, report.Connections.Total, int64(3))
+		assert.Equal(t, int64(1), report.Features[telemetry.FeatureCopyFrom])
+		assert.Equal(t, int64(1), report.Features[telemetry.FeatureCopyTo])
+		assert.Equal(t, int64(1), report.Features[telemetry.FeatureInMemory])
+		assert.Greater(t, report.Features[telemetry.FeatureExtendedQuery], int64(0))
+		// Selecting from a missing table is an undefined_table error, which is in class 42
+		assert.Equal(t, int64(1), report.ErrorClasses["42"])
+	}
+
+	t.Run("File", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "telemetry.jsonl")
+		srv := startServer(t, &servercfg.DoltgresTelemetryConfig{
+			Enabled:  ptr(true),
+			File:     ptr(file),
+			Interval: ptr("1h"),
+		})
+		useServer(t, srv)
+		require.NoError(t, srv.Stop())
+
+		// The final report is written when the server stops
+		data, err := os.ReadFile(file)
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+		var report telemetry.Report
+		require.NoError(t, json.Unmarshal([]byte(lines[len(lines)-1]), &report))
+		checkReport(t, report)
+		// Reports only contain counts, and never any queries or data
+		assert.NotContains(t, string(data), "telemetrydb")
+		assert.NotContains(t, string(data), "items")
+		assert.NotContains(t, string(data), "apple")
+		assert.False(t, telemetry.Enabled())
+	})
+
+	t.Run("Endpoint", func(t *testing.T) {
+		var mu sync.Mutex
+		var bodies [][]byte
+		endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			bodies = append(bodies, body)
+			mu.Unlock()
+		}))
+		// Cleanups run in reverse, so the endpoint outlives the server when a check fails before the server stops
+		t.Cleanup(endpoint.Close)
+		srv := startServer(t, &servercfg.DoltgresTelemetryConfig{
+			Enabled:  ptr(true),
+			Endpoint: ptr(endpoint.URL),
+			Interval: ptr("50ms"),
+		})
+		useServer(t, srv)
+		// Reports are sent on every interval while the server runs
+		require.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(bodies) > 0
+		}, 10*time.Second, 10*time.Millisecond)
+		require.NoError(t, srv.Stop())
+
+		mu.Lock()
+		defer mu.Unlock()
+		var report telemetry.Report
+		require.NoError(t, json.NewDecoder(bytes.NewReader(bodies[len(bodies)-1])).Decode(&report))
+		checkReport(t, report)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "telemetry.jsonl")
+		// The off switch takes precedence over opting in
+		srv := startServer(t, &servercfg.DoltgresTelemetryConfig{
+			Enabled:  ptr(true),
+			Disabled: ptr(true),
+			File:     ptr(file),
+			Interval: ptr("50ms"),
+		})
+		assert.False(t, telemetry.Enabled())
+		useServer(t, srv)
+		require.NoError(t, srv.Stop())
+		_, err := os.Stat(file)
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("Environment", func(t *testing.T) {
+		t.Setenv(servercfg.DOLTGRES_DISABLE_TELEMETRY, "true")
+		cfg := &servercfg.DoltgresConfig{Telemetry: &servercfg.DoltgresTelemetryConfig{Enabled: ptr(true)}}
+		assert.True(t, cfg.TelemetryDisabled())
+		assert.False(t, cfg.TelemetryEnabled())
+	})
+}
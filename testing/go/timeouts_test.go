@@ -0,0 +1,156 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+// requireCanceled asserts that the error is the given cancellation error.
+func requireCanceled(t *testing.T, err error, message string) {
+	require.Error(t, err)
+	var pgErr *pgconn.PgError
+	require.True(t, errors.As(err, &pgErr), err.Error())
+	assert.Equal(t, "57014", pgErr.Code)
+	assert.Equal(t, message, pgErr.Message)
+}
+
+func TestStatementTimeout(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+
+	_, err := conn.Exec(ctx, "SET statement_timeout = 200;")
+	require.NoError(t, err)
+	var timeout string
+	require.NoError(t, conn.QueryRow(ctx, "SHOW statement_timeout;").Scan(&timeout))
+	assert.Equal(t, "200", timeout)
+
+	// The simple and extended protocols are both covered
+	start := time.Now()
+	_, err = conn.Exec(ctx, "SELECT pg_sleep(10);")
+	requireCanceled(t, err, "canceling statement due to statement timeout")
+	assert.Less(t, time.Since(start), 5*time.Second)
+	_, err = conn.Exec(ctx, "SELECT pg_sleep(10);", pgx.QueryExecModeExec)
+	requireCanceled(t, err, "canceling statement due to statement timeout")
+
+	// Statements that finish within the timeout are unaffected, and the session remains usable
+	_, err = conn.Exec(ctx, "SELECT pg_sleep(0.05);")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "SET statement_timeout = 0;")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "SELECT pg_sleep(0.5);")
+	require.NoError(t, err)
+}
+
+func TestLockTimeout(t *testing.T) {
+	ctx, conn, controller := CreateServer(t, "postgres")
+	defer func() {
+		conn.Close(ctx)
+		controller.Stop()
+		require.NoError(t, controller.WaitForStop())
+	}()
+	other, err := pgx.Connect(ctx, conn.Config().ConnString())
+	require.NoError(t, err)
+	defer other.Close(ctx)
+
+	_, err = conn.Exec(ctx, "SELECT pg_advisory_lock(1);")
+	require.NoError(t, err)
+	var acquired string
+	require.NoError(t, other.QueryRow(ctx, "SELECT pg_try_advisory_lock(1);").Scan(&acquired))
+	assert.Equal(t, "f", acquired)
+
+	_, err = other.Exec(ctx, "SET lock_timeout = 200;")
+	require.NoError(t, err)
+	start := time.Now()
+	_, err = other.Exec(ctx, "SELECT pg_advisory_lock(1);")
+	requireCanceled(t, err, "canceling statement due to lock timeout")
+	assert.Less(t, time.Since(start), 5*time.Second)
+
+	// The statement timeout also ends a lock wait
+	_, err = other.Exec(ctx, "SET lock_timeout = 0;")
+	require.NoError(t, err)
+	_, err = other.Exec(ctx, "SET statement_timeout = 200;")
+	require.NoError(t, err)
+	_, err = other.Exec(ctx, "SELECT pg_advisory_lock(1);")
+	requireCanceled(t, err, "canceling statement due to statement timeout")
+	_, err = other.Exec(ctx, "SET statement_timeout = 0;")
+	require.NoError(t, err)
+
+	// A waiting session acquires the lock once it has been released
+	released := make(chan error, 1)
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		_, err := conn.Exec(ctx, "SELECT pg_advisory_unlock(1);")
+		released <- err
+	}()
+	_, err = other.Exec(ctx, "SELECT pg_advisory_lock(1);")
+	require.NoError(t, err)
+	require.NoError(t, <-released)
+	require.NoError(t, conn.QueryRow(ctx, "SELECT pg_advisory_unlock(1);").Scan(&acquired))
+	assert.Equal(t, "f", acquired)
+
+	// Locks are released when their session ends
+	require.NoError(t, other.Close(ctx))
+	require.Eventually(t, func() bool {
+		err := conn.QueryRow(ctx, "SELECT pg_try_advisory_lock(1);").Scan(&acquired)
+		return err == nil && acquired == "t"
+	}, 5*time.Second, 50*time.Millisecond)
+	_, err = conn.Exec(ctx, "SELECT pg_advisory_unlock_all();")
+	require.NoError(t, err)
+}
+
+func TestTimeoutConfig(t *testing.T) {
+	srv := StartServer(t, &servercfg.DoltgresConfig{
+		BehaviorConfig: &servercfg.DoltgresBehaviorConfig{
+			InMemory:         ptr(true),
+			StatementTimeout: ptr(uint64(200)),
+			LockTimeout:      ptr(uint64(100)),
+		},
+	})
+	conn := Connect(t, srv, "doltgres")
+
+	ctx := context.Background()
+	var statementTimeout, lockTimeout string
+	require.NoError(t, conn.QueryRow(ctx, "SHOW statement_timeout;").Scan(&statementTimeout))
+	require.NoError(t, conn.QueryRow(ctx, "SHOW lock_timeout;").Scan(&lockTimeout))
+	assert.Equal(t, "200", statementTimeout)
+	assert.Equal(t, "100", lockTimeout)
+	_, err := conn.Exec(ctx, "SELECT pg_sleep(10);")
+	requireCanceled(t, err, "canceling statement due to statement timeout")
+
+	// Sessions may override the configured default
+	_, err = conn.Exec(ctx, "SET statement_timeout = 0;")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "SELECT pg_sleep(0.5);")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "RESET statement_timeout;")
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, "SELECT pg_sleep(10);")
+	requireCanceled(t, err, "canceling statement due to statement timeout")
+}
@@ -0,0 +1,304 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestTransactionCharacteristics(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "BEGIN with isolation levels",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SHOW transaction_isolation;",
+					Expected: []sql.Row{{"read committed"}},
+				},
+				{
+					Query:    "BEGIN ISOLATION LEVEL REPEATABLE READ;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW transaction_isolation;",
+					Expected: []sql.Row{{"repeatable read"}},
+				},
+				{
+					Query:    "COMMIT;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW transaction_isolation;",
+					Expected: []sql.Row{{"read committed"}},
+				},
+				{
+					Query:    "START TRANSACTION ISOLATION LEVEL SERIALIZABLE, READ WRITE;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW transaction_isolation;",
+					Expected: []sql.Row{{"serializable"}},
+				},
+				{
+					Query:    "ROLLBACK;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "BEGIN TRANSACTION ISOLATION LEVEL READ UNCOMMITTED NOT DEFERRABLE;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW transaction_isolation;",
+					Expected: []sql.Row{{"read uncommitted"}},
+				},
+				{
+					Query:       "BEGIN;",
+					ExpectedTag: "BEGIN",
+				},
+				{
+					Query:    "SHOW transaction_isolation;",
+					Expected: []sql.Row{{"read uncommitted"}},
+				},
+				{
+					Query:    "COMMIT;",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "SET TRANSACTION",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "SET TRANSACTION ISOLATION LEVEL SERIALIZABLE;",
+					ExpectedTag: "SET",
+				},
+				{
+					Query:    "SHOW transaction_isolation;",
+					Expected: []sql.Row{{"read committed"}},
+				},
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SET TRANSACTION ISOLATION LEVEL SERIALIZABLE, READ ONLY;",
+					ExpectedTag: "SET",
+				},
+				{
+					Query:    "SHOW transaction_isolation;",
+					Expected: []sql.Row{{"serializable"}},
+				},
+				{
+					Query:    "SHOW transaction_read_only;",
+					Expected: []sql.Row{{int8(1)}},
+				},
+				{
+					Query:    "SET TRANSACTION READ WRITE;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SET TRANSACTION ISOLATION LEVEL REPEATABLE READ;",
+					ExpectedErr: "SET TRANSACTION ISOLATION LEVEL must be called before any query",
+				},
+				{
+					Query:    "SET TRANSACTION READ ONLY;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "INSERT INTO test VALUES (1);",
+					ExpectedErr: "cannot execute INSERT in a read-only transaction",
+				},
+				{
+					Query:       "SET TRANSACTION READ WRITE;",
+					ExpectedErr: "transaction read-write mode must be set before any query",
+				},
+				{
+					Query:    "COMMIT;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW transaction_isolation;",
+					Expected: []sql.Row{{"read committed"}},
+				},
+				{
+					Query:    "SHOW transaction_read_only;",
+					Expected: []sql.Row{{int8(0)}},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (1);",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "READ ONLY transactions",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 TEXT);",
+				"INSERT INTO test VALUES (1, 'a');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "BEGIN READ ONLY;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW transaction_read_only;",
+					Expected: []sql.Row{{int8(1)}},
+				},
+				{
+					Query:    "SELECT * FROM test;",
+					Expected: []sql.Row{{1, "a"}},
+				},
+				{
+					Query:       "INSERT INTO test VALUES (2, 'b');",
+					ExpectedErr: "cannot execute INSERT in a read-only transaction",
+				},
+				{
+					Query:       "UPDATE test SET v1 = 'b';",
+					ExpectedErr: "cannot execute UPDATE in a read-only transaction",
+				},
+				{
+					Query:       "DELETE FROM test;",
+					ExpectedErr: "cannot execute DELETE in a read-only transaction",
+				},
+				{
+					Query:       "CREATE TABLE other (pk INT8 PRIMARY KEY);",
+					ExpectedErr: "cannot execute CREATE TABLE in a read-only transaction",
+				},
+				{
+					Query:       "DROP TABLE test;",
+					ExpectedErr: "cannot execute DROP TABLE in a read-only transaction",
+				},
+				{
+					Query:    "ROLLBACK;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW transaction_read_only;",
+					Expected: []sql.Row{{int8(0)}},
+				},
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SAVEPOINT s1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SET TRANSACTION READ ONLY;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "UPDATE test SET v1 = 'b';",
+					ExpectedErr: "cannot execute UPDATE in a read-only transaction",
+				},
+				{
+					Query:    "ROLLBACK TO SAVEPOINT s1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW transaction_read_only;",
+					Expected: []sql.Row{{int8(0)}},
+				},
+				{
+					Query:    "UPDATE test SET v1 = 'b';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "COMMIT;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test;",
+					Expected: []sql.Row{{1, "b"}},
+				},
+			},
+		},
+		{
+			Name: "default transaction characteristics",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "SET SESSION CHARACTERISTICS AS TRANSACTION ISOLATION LEVEL REPEATABLE READ, READ ONLY;",
+					ExpectedTag: "SET",
+				},
+				{
+					Query:    "SHOW default_transaction_isolation;",
+					Expected: []sql.Row{{"repeatable read"}},
+				},
+				{
+					Query:    "SHOW transaction_read_only;",
+					Expected: []sql.Row{{int8(1)}},
+				},
+				{
+					Query:       "INSERT INTO test VALUES (1);",
+					ExpectedErr: "cannot execute INSERT in a read-only transaction",
+				},
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SHOW transaction_isolation;",
+					Expected: []sql.Row{{"repeatable read"}},
+				},
+				{
+					Query:       "INSERT INTO test VALUES (1);",
+					ExpectedErr: "cannot execute INSERT in a read-only transaction",
+				},
+				{
+					Query:    "ROLLBACK;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "BEGIN READ WRITE;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (1);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "COMMIT;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SET default_transaction_read_only TO off;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (2);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1}, {2}},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,145 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pgconfig "github.com/dolthub/doltgresql/server/config"
+	"github.com/dolthub/doltgresql/servercfg"
+)
+
+func TestTransactionCommit(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "Dolt commits for each transaction",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY);",
+				"CALL dolt_commit('-Am', 'initial');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SHOW dolt.transaction_commit;",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (1);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT message FROM dolt_log LIMIT 1;",
+					Expected: []sql.Row{{"initial"}},
+				},
+				{
+					Query:    "SET dolt.transaction_commit = on;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (2);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT message FROM dolt_log LIMIT 1;",
+					Expected: []sql.Row{{"Transaction commit by postgres from psql"}},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_status;",
+					Expected: []sql.Row{{0}},
+				},
+				{
+					Query:    "SET application_name = 'billing';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SET dolt.transaction_commit_message = '{user} changed {database} using {application_name}';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "BEGIN;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (3);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (4);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "COMMIT;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query: "SELECT message FROM dolt_log LIMIT 3;",
+					Expected: []sql.Row{
+						{"postgres changed postgres using billing"},
+						{"Transaction commit by postgres from psql"},
+						{"initial"},
+					},
+				},
+				{
+					Query:    "SELECT count(*) FROM dolt_log;",
+					Expected: []sql.Row{{4}},
+				},
+				{
+					Query:    "SET dolt.transaction_commit = off;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (5);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT message FROM dolt_log LIMIT 1;",
+					Expected: []sql.Row{{"postgres changed postgres using billing"}},
+				},
+			},
+		},
+	})
+}
+
+func TestTransactionCommitConfig(t *testing.T) {
+	t.Cleanup(func() {
+		pgconfig.SetTransactionCommitDefaults(false, "")
+	})
+	srv := StartServer(t, &servercfg.DoltgresConfig{
+		BehaviorConfig: &servercfg.DoltgresBehaviorConfig{
+			InMemory:                     ptr(true),
+			DoltTransactionCommit:        ptr(true),
+			DoltTransactionCommitMessage: ptr("Automatic commit by {user}"),
+		},
+	})
+	conn := Connect(t, srv, "doltgres")
+
+	ctx := context.Background()
+	ExecQueries(t, conn, "CREATE TABLE test (pk INT8 PRIMARY KEY);")
+	var message string
+	require.NoError(t, conn.QueryRow(ctx, "SELECT message FROM dolt_log LIMIT 1;").Scan(&message))
+	assert.Equal(t, "Automatic commit by postgres", message)
+
+	// Sessions may override the configured default
+	ExecQueries(t, conn, "SET dolt.transaction_commit = off;", "INSERT INTO test VALUES (1);")
+	require.NoError(t, conn.QueryRow(ctx, "SELECT message FROM dolt_log LIMIT 1;").Scan(&message))
+	assert.Equal(t, "Automatic commit by postgres", message)
+	var changes int64
+	require.NoError(t, conn.QueryRow(ctx, "SELECT count(*) FROM dolt_status;").Scan(&changes))
+	assert.Equal(t, int64(1), changes)
+}
@@ -0,0 +1,339 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestTriggers(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "BEFORE INSERT modifies and skips rows",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 INT4, v2 TEXT);",
+				`CREATE FUNCTION before_insert() RETURNS TRIGGER LANGUAGE plpgsql AS $$
+BEGIN
+	IF NEW.v1 < 0 THEN
+		RETURN NULL;
+	END IF;
+	NEW.v1 := NEW.v1 * 10;
+	NEW.v2 := TG_OP;
+	RETURN NEW;
+END;
+$$;`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE TRIGGER test_before BEFORE INSERT ON test FOR EACH ROW EXECUTE FUNCTION before_insert();",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (1, 1, 'a'), (2, -2, 'b'), (3, 3, 'c');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, 10, "INSERT"}, {3, 30, "INSERT"}},
+				},
+				{
+					Query:    "UPDATE test SET v1 = 5 WHERE pk = 1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, 5, "INSERT"}, {3, 30, "INSERT"}},
+				},
+				{
+					Query:    "DROP TRIGGER test_before ON test;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (4, -4, 'd');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, 5, "INSERT"}, {3, 30, "INSERT"}, {4, -4, "d"}},
+				},
+				{
+					Query:       "DROP TRIGGER test_before ON test;",
+					ExpectedErr: `trigger "test_before" for table "test" does not exist`,
+				},
+				{
+					Query:    "DROP TRIGGER IF EXISTS test_before ON test;",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "AFTER triggers see OLD and NEW",
+			SetUpScript: []string{
+				"CREATE TABLE items (id INT4 PRIMARY KEY, qty INT4);",
+				"CREATE TABLE audit (op TEXT, old_qty INT4, new_qty INT4, item_count INT8);",
+				`CREATE FUNCTION audit_items() RETURNS TRIGGER LANGUAGE plpgsql AS $$
+DECLARE
+	total INT8;
+BEGIN
+	SELECT count(*) INTO total FROM items;
+	IF TG_OP = 'INSERT' THEN
+		INSERT INTO audit VALUES (TG_OP, NULL, NEW.qty, total);
+	ELSIF TG_OP = 'UPDATE' THEN
+		INSERT INTO audit VALUES (TG_OP, OLD.qty, NEW.qty, total);
+	ELSE
+		INSERT INTO audit VALUES (TG_OP, OLD.qty, NULL, total);
+	END IF;
+	RETURN NULL;
+END;
+$$;`,
+				"CREATE TRIGGER items_audit AFTER INSERT OR UPDATE OR DELETE ON items FOR EACH ROW EXECUTE FUNCTION audit_items();",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "INSERT INTO items VALUES (1, 10), (2, 20);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "UPDATE items SET qty = qty + 1 WHERE id = 2;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DELETE FROM items WHERE id = 1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM items;",
+					Expected: []sql.Row{{2, 21}},
+				},
+				{
+					Query: "SELECT * FROM audit ORDER BY op, old_qty, new_qty;",
+					Expected: []sql.Row{
+						{"DELETE", 10, nil, 1},
+						{"INSERT", nil, 10, 2},
+						{"INSERT", nil, 20, 2},
+						{"UPDATE", 20, 21, 2},
+					},
+				},
+			},
+		},
+		{
+			Name: "Trigger arguments, conditions, and update columns",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 INT4, v2 INT4, label TEXT);",
+				`CREATE FUNCTION set_label() RETURNS TRIGGER LANGUAGE plpgsql AS $$
+BEGIN
+	NEW.label := TG_ARGV[0];
+	IF TG_NARGS > 1 THEN
+		NEW.label := TG_ARGV[1];
+	END IF;
+	RETURN NEW;
+END;
+$$;`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE TRIGGER label_large BEFORE INSERT ON test FOR EACH ROW WHEN (NEW.v1 > 100) EXECUTE FUNCTION set_label('large');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE TRIGGER label_v2 BEFORE UPDATE OF v2 ON test FOR EACH ROW EXECUTE FUNCTION set_label('ignored', 'v2 changed');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (1, 50, 0, 'none'), (2, 500, 0, 'none');",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, 50, 0, "none"}, {2, 500, 0, "large"}},
+				},
+				{
+					Query:    "UPDATE test SET v1 = v1 + 1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, 51, 0, "none"}, {2, 501, 0, "large"}},
+				},
+				{
+					Query:    "UPDATE test SET v2 = 1 WHERE pk = 1;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, 51, 1, "v2 changed"}, {2, 501, 0, "large"}},
+				},
+			},
+		},
+		{
+			Name: "BEFORE DELETE skips rows",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, locked BOOLEAN);",
+				"INSERT INTO test VALUES (1, true), (2, false), (3, true);",
+				`CREATE FUNCTION protect_locked() RETURNS TRIGGER LANGUAGE plpgsql AS $$
+BEGIN
+	IF OLD.locked THEN
+		RETURN NULL;
+	END IF;
+	RETURN OLD;
+END;
+$$;`,
+				"CREATE TRIGGER protect BEFORE DELETE ON test FOR EACH ROW EXECUTE FUNCTION protect_locked();",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "DELETE FROM test;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT pk FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1}, {3}},
+				},
+			},
+		},
+		{
+			Name: "Trigger errors",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 INT4);",
+				"CREATE FUNCTION not_trigger() RETURNS INT4 LANGUAGE plpgsql AS $$ BEGIN RETURN 1; END; $$;",
+				`CREATE FUNCTION reject() RETURNS TRIGGER LANGUAGE plpgsql AS $$
+BEGIN
+	RAISE EXCEPTION 'rejected %', NEW.pk;
+END;
+$$;`,
+				`CREATE FUNCTION bad_return() RETURNS TRIGGER LANGUAGE plpgsql AS $$
+BEGIN
+	RETURN 1;
+END;
+$$;`,
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "CREATE TRIGGER t1 BEFORE INSERT ON test FOR EACH ROW EXECUTE FUNCTION not_trigger();",
+					ExpectedErr: "function not_trigger must return type trigger",
+				},
+				{
+					Query:       "CREATE TRIGGER t1 BEFORE INSERT ON missing FOR EACH ROW EXECUTE FUNCTION reject();",
+					ExpectedErr: `relation "missing" does not exist`,
+				},
+				{
+					Query:       "CREATE FUNCTION sql_trigger() RETURNS TRIGGER LANGUAGE sql AS $$ SELECT 1; $$;",
+					ExpectedErr: "SQL functions cannot return type trigger",
+				},
+				{
+					Query:       "SELECT reject();",
+					ExpectedErr: "trigger functions can only be called as triggers",
+				},
+				{
+					Query:    "CREATE TRIGGER t1 BEFORE INSERT ON test FOR EACH ROW EXECUTE FUNCTION reject();",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "CREATE TRIGGER t1 BEFORE INSERT ON test FOR EACH ROW EXECUTE FUNCTION reject();",
+					ExpectedErr: `trigger "t1" for relation "test" already exists`,
+				},
+				{
+					Query:       "INSERT INTO test VALUES (7, 1);",
+					ExpectedErr: "rejected 7",
+				},
+				{
+					Query:       "DROP FUNCTION reject();",
+					ExpectedErr: "cannot drop function reject() because other objects depend on it",
+				},
+				{
+					Query:    "CREATE OR REPLACE TRIGGER t1 BEFORE INSERT ON test FOR EACH ROW EXECUTE FUNCTION bad_return();",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "INSERT INTO test VALUES (7, 1);",
+					ExpectedErr: "trigger functions may only return a record variable or NULL",
+				},
+				{
+					Query:    "DROP TRIGGER t1 ON test;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "INSERT INTO test VALUES (7, 1);",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE TRIGGER t2 AFTER INSERT ON test FOR EACH ROW WHEN (NEW.v1 > 100) EXECUTE FUNCTION reject();",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "INSERT INTO test VALUES (8, 1), (9, 1000);",
+					ExpectedErr: "rejected 9",
+				},
+				{
+					Query:    "SELECT * FROM test;",
+					Expected: []sql.Row{{7, 1}},
+				},
+			},
+		},
+		{
+			Name: "Triggers require privileges on the table and function",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY, v1 INT4);",
+				`CREATE FUNCTION noop() RETURNS TRIGGER LANGUAGE plpgsql AS $$
+BEGIN
+	RETURN NEW;
+END;
+$$;`,
+				"CREATE TRIGGER test_noop BEFORE INSERT ON test FOR EACH ROW EXECUTE FUNCTION noop();",
+				"CREATE ROLE alice LOGIN;",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "CREATE TRIGGER alice_noop BEFORE INSERT ON test FOR EACH ROW EXECUTE FUNCTION noop();",
+					Username:    "alice",
+					ExpectedErr: "permission denied for table test",
+				},
+				{
+					Query:       "DROP TRIGGER test_noop ON test;",
+					Username:    "alice",
+					ExpectedErr: "must be owner of table test",
+				},
+				{
+					Query:    "GRANT TRIGGER ON test TO alice;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "REVOKE EXECUTE ON FUNCTION noop FROM PUBLIC;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "CREATE TRIGGER alice_noop BEFORE INSERT ON test FOR EACH ROW EXECUTE FUNCTION noop();",
+					Username:    "alice",
+					ExpectedErr: "permission denied for function noop",
+				},
+				{
+					Query:    "GRANT EXECUTE ON FUNCTION noop TO alice;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "CREATE TRIGGER alice_noop BEFORE INSERT ON test FOR EACH ROW EXECUTE FUNCTION noop();",
+					Username: "alice",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "DROP TRIGGER alice_noop ON test;",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+	})
+}
@@ -493,20 +493,108 @@ var typesTests = []ScriptTest{
 	},
 	{
 		Name: "Interval type",
-		Skip: true,
 		SetUpScript: []string{
 			"CREATE TABLE t_interval (id INTEGER primary key, v1 INTERVAL);",
-			"INSERT INTO t_interval VALUES (1, '1 day 3 hours'), (2, '2 hours 30 minutes');",
+			"INSERT INTO t_interval VALUES (1, '1 day 3 hours'), (2, '2 hours 30 minutes'), (3, '-1 year -2 months +3 days -04:05:06.5');",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SELECT id, v1::text FROM t_interval ORDER BY id;",
+				Expected: []sql.Row{
+					{1, "1 day 03:00:00"},
+					{2, "02:30:00"},
+					{3, "-1 years -2 mons +3 days -04:05:06.5"},
+				},
+			},
+			{
+				Query: "SELECT id, v1::text FROM t_interval ORDER BY v1;",
+				Expected: []sql.Row{
+					{3, "-1 years -2 mons +3 days -04:05:06.5"},
+					{2, "02:30:00"},
+					{1, "1 day 03:00:00"},
+				},
+			},
+			{
+				Query:    "SELECT '1.5 weeks'::interval::text, interval '90 minutes'::text, 'P1Y2M3DT4H5M6S'::interval::text;",
+				Expected: []sql.Row{{"10 days 12:00:00", "01:30:00", "1 year 2 mons 3 days 04:05:06"}},
+			},
+			{
+				Query:       "SELECT 'not an interval'::interval;",
+				ExpectedErr: "invalid input syntax for type interval",
+			},
+		},
+	},
+	{
+		Name: "Interval output styles",
+		SetUpScript: []string{
+			"CREATE TABLE t_interval (id INTEGER primary key, v1 INTERVAL);",
+			"INSERT INTO t_interval VALUES (1, '1 year 2 months 3 days 04:05:06.789'), (2, '-1 day +02:03:00'), (3, '1 year 2 months'), (4, '-3 days -04:05:06'), (5, '0');",
 		},
 		Assertions: []ScriptTestAssertion{
 			{
-				// TODO: might need a GMS type here, not a string (psql output is different than below)
-				Query: "SELECT * FROM t_interval ORDER BY id;",
+				Query: "SELECT id, v1::text FROM t_interval ORDER BY id;",
+				Expected: []sql.Row{
+					{1, "1 year 2 mons 3 days 04:05:06.789"},
+					{2, "-1 days +02:03:00"},
+					{3, "1 year 2 mons"},
+					{4, "-3 days -04:05:06"},
+					{5, "00:00:00"},
+				},
+			},
+			{
+				Query:    "SET intervalstyle = 'postgres_verbose';",
+				Expected: []sql.Row{},
+			},
+			{
+				Query: "SELECT id, v1::text FROM t_interval ORDER BY id;",
+				Expected: []sql.Row{
+					{1, "@ 1 year 2 mons 3 days 4 hours 5 mins 6.789 secs"},
+					{2, "@ 1 day -2 hours -3 mins ago"},
+					{3, "@ 1 year 2 mons"},
+					{4, "@ 3 days 4 hours 5 mins 6 secs ago"},
+					{5, "@ 0"},
+				},
+			},
+			{
+				Query:    "SET intervalstyle = 'sql_standard';",
+				Expected: []sql.Row{},
+			},
+			{
+				Query: "SELECT id, v1::text FROM t_interval ORDER BY id;",
+				Expected: []sql.Row{
+					{1, "+1-2 +3 +4:05:06.789"},
+					{2, "+0-0 -1 +2:03:00"},
+					{3, "1-2"},
+					{4, "-3 4:05:06"},
+					{5, "0"},
+				},
+			},
+			{
+				Query:    "SET intervalstyle = 'iso_8601';",
+				Expected: []sql.Row{},
+			},
+			{
+				Query: "SELECT id, v1::text FROM t_interval ORDER BY id;",
 				Expected: []sql.Row{
-					{1, "1 day 3 hours"},
-					{2, "2 hours 30 minutes"},
+					{1, "P1Y2M3DT4H5M6.789S"},
+					{2, "P-1DT2H3M"},
+					{3, "P1Y2M"},
+					{4, "P-3DT-4H-5M-6S"},
+					{5, "PT0S"},
 				},
 			},
+			{
+				Query:    "SELECT v1 FROM t_interval WHERE id = 1;",
+				Expected: []sql.Row{{"P1Y2M3DT4H5M6.789S"}},
+			},
+			{
+				Query:    "RESET intervalstyle;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT v1::varchar FROM t_interval WHERE id = 1;",
+				Expected: []sql.Row{{"1 year 2 mons 3 days 04:05:06.789"}},
+			},
 		},
 	},
 	{
@@ -0,0 +1,331 @@
+// Copyright 2024 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestUserFunctions(t *testing.T) {
+	RunScripts(t, []ScriptTest{
+		{
+			Name: "SQL function with a string body",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 INT4);",
+				"INSERT INTO test VALUES (1, 10), (2, 20), (3, 30);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE FUNCTION add_one(val INT4) RETURNS INT4 LANGUAGE sql AS $$ SELECT val + 1; $$;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT add_one(41);",
+					Expected: []sql.Row{{42}},
+				},
+				{
+					Query:    "SELECT pk, add_one(v1) FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1, 11}, {2, 21}, {3, 31}},
+				},
+				{
+					Query:    "SELECT pk FROM test WHERE add_one(v1) > 20 ORDER BY pk;",
+					Expected: []sql.Row{{2}, {3}},
+				},
+				{
+					Query:    "SELECT add_one(add_one(1));",
+					Expected: []sql.Row{{3}},
+				},
+				{
+					Query:    "SELECT add_one(NULL);",
+					Expected: []sql.Row{{nil}},
+				},
+				{
+					Query:    "CREATE FUNCTION positional(INT4, INT4) RETURNS INT4 LANGUAGE sql AS 'SELECT $1 - $2';",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT positional(10, 3);",
+					Expected: []sql.Row{{7}},
+				},
+				{
+					Query:    "CREATE FUNCTION lookup(id INT8) RETURNS INT4 LANGUAGE sql AS $$ SELECT v1 FROM test WHERE pk = id; $$;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT lookup(2), lookup(4);",
+					Expected: []sql.Row{{20, nil}},
+				},
+			},
+		},
+		{
+			Name: "SQL-standard function bodies",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE FUNCTION multiply(a INT4, b INT4) RETURNS INT4 RETURN a * b;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT multiply(6, 7);",
+					Expected: []sql.Row{{42}},
+				},
+				{
+					Query:    "CREATE FUNCTION insert_and_count(val INT8) RETURNS INT8 LANGUAGE sql BEGIN ATOMIC INSERT INTO test VALUES (val); SELECT count(*) FROM test; END;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT insert_and_count(1);",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "SELECT insert_and_count(2);",
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:    "SELECT * FROM test ORDER BY pk;",
+					Expected: []sql.Row{{1}, {2}},
+				},
+				{
+					Query:    "CREATE FUNCTION classify(val INT4) RETURNS TEXT BEGIN ATOMIC SELECT CASE WHEN val > 0 THEN 'positive' ELSE 'other' END; END;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT classify(5), classify(-5);",
+					Expected: []sql.Row{{"positive", "other"}},
+				},
+				{
+					Query:       "CREATE FUNCTION bad_language(a INT4) RETURNS INT4 LANGUAGE plpgsql RETURN a;",
+					ExpectedErr: "inline SQL function body only valid for language SQL",
+				},
+			},
+		},
+		{
+			Name: "Argument type resolution",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE FUNCTION double_it(val INT8) RETURNS INT8 LANGUAGE sql AS $$ SELECT val * 2; $$;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT double_it(21::INT2);",
+					Expected: []sql.Row{{42}},
+				},
+				{
+					Query:    "SELECT double_it('21');",
+					Expected: []sql.Row{{42}},
+				},
+				{
+					Query:       "SELECT double_it(21.5);",
+					ExpectedErr: "function double_it(numeric) does not exist",
+				},
+				{
+					Query:    "CREATE FUNCTION shout(val TEXT) RETURNS TEXT LANGUAGE sql AS $$ SELECT upper(val); $$;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT shout('hello'), shout('there'::VARCHAR(10));",
+					Expected: []sql.Row{{"HELLO", "THERE"}},
+				},
+				{
+					Query:    "CREATE FUNCTION to_int(val TEXT) RETURNS INT4 LANGUAGE sql AS $$ SELECT length(val); $$;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT to_int('abc');",
+					Expected: []sql.Row{{3}},
+				},
+				{
+					Query:       "SELECT missing_function(1);",
+					ExpectedErr: "function missing_function(integer) does not exist",
+				},
+			},
+		},
+		{
+			Name: "Parameter defaults and output parameters",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE FUNCTION add_default(a INT4, b INT4 = 100) RETURNS INT4 LANGUAGE sql AS $$ SELECT a + b; $$;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT add_default(1), add_default(1, 2);",
+					Expected: []sql.Row{{101, 3}},
+				},
+				{
+					Query:       "SELECT add_default(1, 2, 3);",
+					ExpectedErr: "does not exist",
+				},
+				{
+					Query:    "CREATE FUNCTION with_out(a INT4, OUT doubled INT4) LANGUAGE sql AS $$ SELECT a * 2; $$;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT with_out(4);",
+					Expected: []sql.Row{{8}},
+				},
+				{
+					Query:       "CREATE FUNCTION mismatched(a INT4, OUT b TEXT) RETURNS INT4 LANGUAGE sql AS $$ SELECT 1; $$;",
+					ExpectedErr: "function result type must be text because of OUT parameters",
+				},
+				{
+					Query:       "CREATE FUNCTION no_return(a INT4) LANGUAGE sql AS $$ SELECT 1; $$;",
+					ExpectedErr: "function result type must be specified",
+				},
+			},
+		},
+		{
+			Name: "CREATE OR REPLACE FUNCTION and DROP FUNCTION",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE FUNCTION func(val INT4) RETURNS INT4 LANGUAGE sql AS $$ SELECT val + 1; $$;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "CREATE FUNCTION func(val INT4) RETURNS INT4 LANGUAGE sql AS $$ SELECT val + 2; $$;",
+					ExpectedErr: "already exists",
+				},
+				{
+					Query:    "CREATE OR REPLACE FUNCTION func(val INT4) RETURNS INT4 LANGUAGE sql AS $$ SELECT val + 2; $$;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT func(1);",
+					Expected: []sql.Row{{3}},
+				},
+				{
+					Query:       "CALL func(1);",
+					ExpectedErr: "procedure func(integer) does not exist",
+				},
+				{
+					Query:       "CREATE OR REPLACE PROCEDURE func(val INT4) LANGUAGE sql AS $$ SELECT 1; $$;",
+					ExpectedErr: "cannot change routine kind",
+				},
+				{
+					Query:       "DROP PROCEDURE func;",
+					ExpectedErr: "func is not a procedure",
+				},
+				{
+					Query:    "DROP FUNCTION func;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT func(1);",
+					ExpectedErr: "function func(integer) does not exist",
+				},
+				{
+					Query:       "DROP FUNCTION func;",
+					ExpectedErr: "function func does not exist",
+				},
+				{
+					Query:    "DROP FUNCTION IF EXISTS func;",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "Functions belong to a branch",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT4 PRIMARY KEY);",
+				"CALL dolt_commit('-Am', 'initial');",
+				"CALL dolt_branch('other');",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE FUNCTION versioned() RETURNS INT4 LANGUAGE sql AS $$ SELECT 1; $$;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT versioned();",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:            "CALL dolt_checkout('other');",
+					SkipResultsCheck: true,
+				},
+				{
+					Query:       "SELECT versioned();",
+					ExpectedErr: "function versioned() does not exist",
+				},
+				{
+					Query:    "CREATE FUNCTION versioned() RETURNS INT4 LANGUAGE sql AS $$ SELECT 2; $$;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT versioned();",
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:            "CALL dolt_checkout('main');",
+					SkipResultsCheck: true,
+				},
+				{
+					Query:    "SELECT versioned();",
+					Expected: []sql.Row{{1}},
+				},
+			},
+		},
+		{
+			Name: "Functions that call themselves",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE FUNCTION forever(val INT4) RETURNS INT4 LANGUAGE sql AS $$ SELECT forever(val + 1); $$;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:       "SELECT forever(1);",
+					ExpectedErr: "stack depth limit exceeded",
+				},
+			},
+		},
+		{
+			Name: "SQL function returning a set",
+			SetUpScript: []string{
+				"CREATE TABLE test (pk INT8 PRIMARY KEY, v1 INT4);",
+				"INSERT INTO test VALUES (1, 10), (2, 20), (3, 30);",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "CREATE FUNCTION above(val INT4) RETURNS SETOF INT4 LANGUAGE sql AS $$ SELECT v1 FROM test WHERE v1 > val ORDER BY pk; $$;",
+					Expected: []sql.Row{},
+				},
+				{
+					Query:    "SELECT * FROM above(15);",
+					Expected: []sql.Row{{20}, {30}},
+				},
+				{
+					Query:    "SELECT * FROM above(30);",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+		{
+			Name: "Unsupported function definitions",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:       "CREATE FUNCTION func(a INT4) RETURNS TABLE (b INT4) LANGUAGE sql AS $$ SELECT 1; $$;",
+					ExpectedErr: "functions returning tables are not yet supported",
+				},
+				{
+					Query:       "CREATE FUNCTION func(a INT4) RETURNS INT4 LANGUAGE plpython AS $$ SELECT 1; $$;",
+					ExpectedErr: `language "plpython" is not yet supported`,
+				},
+			},
+		},
+	})
+}
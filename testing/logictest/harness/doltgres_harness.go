@@ -109,7 +109,7 @@ func (h *PostgresqlServerHarness) GetTimeout() int64 {
 func (h *PostgresqlServerHarness) dropAllTables() error {
 	var rows *sql.Rows
 	var err error
-	rows, err = h.db.QueryContext(context.Background(), "SELECT table_name FROM information_schema.tables WHERE table_schema = 'sqllogictest' AND table_type = 'BASE TABLE';")
+	rows, err = h.db.QueryContext(context.Background(), "SELECT table_name FROM information_schema.tables WHERE table_catalog = 'sqllogictest' AND table_type = 'BASE TABLE';")
 	if rows != nil {
 		defer rows.Close()
 	}